@@ -64,6 +64,7 @@ func (c *RemoteIndex) PutObject(ctx context.Context, hostName, indexName,
 	}
 
 	clusterapi.IndicesPayloads.SingleObject.SetContentTypeHeaderReq(req)
+	clusterapi.SetPreconditionHeaderReq(req, obj.ID())
 	res, err := c.client.Do(req)
 	if err != nil {
 		return errors.Wrap(err, "send http request")
@@ -72,13 +73,21 @@ func (c *RemoteIndex) PutObject(ctx context.Context, hostName, indexName,
 	defer res.Body.Close()
 	if res.StatusCode != http.StatusNoContent {
 		body, _ := io.ReadAll(res.Body)
-		return errors.Errorf("unexpected status code %d (%s)", res.StatusCode,
-			body)
+		return writeError(res.StatusCode, body)
 	}
 
 	return nil
 }
 
+// writeError returns the error of a single object write which the remote
+// node answered with an unexpected status code
+func writeError(statusCode int, body []byte) error {
+	if statusCode == http.StatusPreconditionFailed {
+		return objects.NewErrPreconditionFailed("%s", bytes.TrimSpace(body))
+	}
+	return errors.Errorf("unexpected status code %d (%s)", statusCode, body)
+}
+
 func duplicateErr(in error, count int) []error {
 	out := make([]error, count)
 	for i := range out {
@@ -293,6 +302,7 @@ func (c *RemoteIndex) DeleteObject(ctx context.Context, hostName, indexName,
 		return errors.Wrap(err, "open http request")
 	}
 
+	clusterapi.SetPreconditionHeaderReq(req, id)
 	res, err := c.client.Do(req)
 	if err != nil {
 		return errors.Wrap(err, "send http request")
@@ -307,8 +317,7 @@ func (c *RemoteIndex) DeleteObject(ctx context.Context, hostName, indexName,
 
 	if res.StatusCode != http.StatusNoContent {
 		body, _ := io.ReadAll(res.Body)
-		return errors.Errorf("unexpected status code %d (%s)", res.StatusCode,
-			body)
+		return writeError(res.StatusCode, body)
 	}
 
 	return nil
@@ -334,6 +343,7 @@ func (c *RemoteIndex) MergeObject(ctx context.Context, hostName, indexName,
 	}
 
 	clusterapi.IndicesPayloads.MergeDoc.SetContentTypeHeaderReq(req)
+	clusterapi.SetPreconditionHeaderReq(req, mergeDoc.ID)
 	res, err := c.client.Do(req)
 	if err != nil {
 		return errors.Wrap(err, "send http request")
@@ -342,8 +352,7 @@ func (c *RemoteIndex) MergeObject(ctx context.Context, hostName, indexName,
 	defer res.Body.Close()
 	if res.StatusCode != http.StatusNoContent {
 		body, _ := io.ReadAll(res.Body)
-		return errors.Errorf("unexpected status code %d (%s)", res.StatusCode,
-			body)
+		return writeError(res.StatusCode, body)
 	}
 
 	return nil
@@ -408,17 +417,18 @@ func (c *RemoteIndex) MultiGetObjects(ctx context.Context, hostName, indexName,
 }
 
 func (c *RemoteIndex) SearchShard(ctx context.Context, host, index, shard string,
-	vector []float32, limit int,
+	vector []float32, targetVector string, limit int,
 	filters *filters.LocalFilter,
 	keywordRanking *searchparams.KeywordRanking,
 	sort []filters.Sort,
 	cursor *filters.Cursor,
 	groupBy *searchparams.GroupBy,
+	rescore *searchparams.Rescore, exact bool,
 	additional additional.Properties,
 ) ([]*storobj.Object, []float32, error) {
 	// new request
 	body, err := clusterapi.IndicesPayloads.SearchParams.
-		Marshal(vector, limit, filters, keywordRanking, sort, cursor, groupBy, additional)
+		Marshal(vector, targetVector, limit, filters, keywordRanking, sort, cursor, groupBy, rescore, exact, additional)
 	if err != nil {
 		return nil, nil, fmt.Errorf("marshal request payload: %w", err)
 	}
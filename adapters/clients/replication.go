@@ -129,6 +129,7 @@ func (c *replicationClient) PutObject(ctx context.Context, host, index,
 	}
 
 	clusterapi.IndicesPayloads.SingleObject.SetContentTypeHeaderReq(req)
+	clusterapi.SetPreconditionHeaderReq(req, obj.ID())
 	err = c.do(c.timeoutUnit*90, req, body, &resp)
 	return resp, err
 }
@@ -141,6 +142,7 @@ func (c *replicationClient) DeleteObject(ctx context.Context, host, index,
 	if err != nil {
 		return resp, fmt.Errorf("create http request: %w", err)
 	}
+	clusterapi.SetPreconditionHeaderReq(req, uuid)
 
 	err = c.do(c.timeoutUnit*90, req, nil, &resp)
 	return resp, err
@@ -180,6 +182,7 @@ func (c *replicationClient) MergeObject(ctx context.Context, host, index, shard,
 	}
 
 	clusterapi.IndicesPayloads.MergeDoc.SetContentTypeHeaderReq(req)
+	clusterapi.SetPreconditionHeaderReq(req, doc.ID)
 	err = c.do(c.timeoutUnit*90, req, body, &resp)
 	return resp, err
 }
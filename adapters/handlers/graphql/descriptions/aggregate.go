@@ -20,6 +20,15 @@ const (
 
 const GroupBy = "Specify which properties to group by"
 
+const (
+	GroupByProperties      = "Specify several properties to group by, a group is built for every combination of their values"
+	GroupByOrder           = "Specify how the groups are ordered before the limit is applied, by default the groups with the highest count come first"
+	GroupByOrderInpObj     = "An object specifying how the groups are ordered"
+	GroupByOrderProperty   = "The aggregated property to order the groups by, the groups are ordered by their count if it is omitted"
+	GroupByOrderAggregator = "The aggregator of the property to order the groups by, e.g. \"mean\""
+	GroupByOrderOrder      = "Whether the groups are ordered ascending or descending, defaults to descending"
+)
+
 const (
 	AggregatePropertyObject = "An object containing Aggregation information about this property"
 )
@@ -35,6 +44,17 @@ const (
 	AggregateMax       = "Aggregate on the maximum of numeric property values"
 	AggregateCount     = "Aggregate on the total amount of found property values"
 	AggregateGroupedBy = "Indicates the group of returned data"
+
+	AggregateStandardDeviation = "Aggregate on the standard deviation of numeric property values"
+	AggregatePercentile50      = "Aggregate on the estimated 50th percentile of numeric property values"
+	AggregatePercentile90      = "Aggregate on the estimated 90th percentile of numeric property values"
+	AggregatePercentile99      = "Aggregate on the estimated 99th percentile of numeric property values"
+	AggregateDistinctCount     = "Aggregate on the estimated number of distinct property values"
+
+	AggregateHistogram            = "Aggregate the property values in buckets of a fixed interval, only buckets containing values are returned"
+	AggregateHistogramInterval    = "The width of the buckets, a number for numeric properties and a duration like \"24h\" for date properties"
+	AggregateHistogramBucketKey   = "The lower bound of the bucket"
+	AggregateHistogramBucketCount = "The number of values in the bucket"
 )
 
 const AggregateNumericObj = "An object containing the %s of numeric properties"
@@ -46,8 +66,11 @@ const AggregateGroupedByObj = "An object containing the path and value of the gr
 const (
 	AggregateGroupedByGroupedByPath  = "The path of the grouped property"
 	AggregateGroupedByGroupedByValue = "The value of the grouped property"
+	AggregateGroupedByGroupedByKeys  = "The paths and values of all properties the group was grouped by"
 )
 
+const AggregateGroupedByKeyObj = "An object containing the path and value of one of the grouped properties"
+
 // NETWORK
 const NetworkAggregateWeaviateObj = "An object containing Get Objects fields for network Weaviate instance: "
 
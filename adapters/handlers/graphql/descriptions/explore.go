@@ -27,4 +27,8 @@ const (
 	ClassName            = "Name of the Class"
 	ID                   = "Concept identifier in the uuid format"
 	Beacon               = "Concept identifier in the beacon format, such as weaviate://<hostname>/<kind>/id"
+	TargetVector         = "Name of the class's named vector to search, as configured in the class's vectorConfig, or of a property of type vector or vector[]"
+	RescoreLimit         = "Number of candidates to rescore with the uncompressed vectors when the vector index is compressed. Overrides the index setting for this query"
+	Oversampling         = "Factor by which to multiply the limit to retrieve more candidates from a compressed vector index before rescoring. Must be at least 1"
+	Exact                = "Bypass the vector index and compare the query to every vector, after the filters are applied. Meant for ground-truth evaluations and small filtered result sets"
 )
@@ -68,6 +68,8 @@ const (
 	WhereValueRangeDistanceMax             = "The maximum distance from the point specified geoCoordinates."
 	WhereValueText                         = "Specify a Text value that the target property will be compared to"
 	WhereValueDate                         = "Specify a Date value that the target property will be compared to"
+	WhereValueGeoPolygon                   = "Specify a polygon (the latitude and longitude of its corners as decimals). Use it with WithinPolygon to find results located within the polygon, or with Intersects to find results sharing at least one point with it."
+	WhereValueGeoPolygonCoordinates        = "The geoCoordinates of the corners of the polygon. The polygon is closed implicitly."
 )
 
 // Properties and Classes filter elements (used by Fetch and Introspect Where filters)
@@ -141,9 +143,17 @@ const (
 	AfterID = "Show the results after a given ID"
 )
 
+const QueryTimeout = "Abort the query if it runs longer than this duration (e.g. '500ms' or '2s'), overrides the default query timeout of the server"
+
+const Explain = "Break the query down into its stages (e.g. filter evaluation, vector search, fusion) with the time spent and the number of objects produced in each stage, returned through '_additional { explain }'"
+
+const ResolveReferences = "Look up the referenced objects (default). Set to false to only return the class and id of each reference through '_additional { id }', which skips the cross-reference lookup entirely"
+
 const (
 	SortPath  = "Specify the path from the Objects fields to the property name (e.g. ['Get', 'City', 'population'] leads to the 'population' property of a 'City' object)"
 	SortOrder = "Specify the sort order, either ascending (asc) which is default or descending (desc)"
+
+	SortExpression = "Specify an arithmetic expression on numeric properties to sort by instead of a path (e.g. 'price * (1 - discount)')"
 )
 
 const (
@@ -119,13 +119,19 @@ func classField(class *models.Class, description string,
 				Description: descriptions.GroupBy,
 				Type:        graphql.NewList(graphql.String),
 			},
-			"nearVector": nearVectorArgument(class.Class),
-			"nearObject": nearObjectArgument(class.Class),
+			"groupByProperties": groupByPropertiesArgument(),
+			"groupByOrder":      groupByOrderArgument(class.Class),
+			"nearVector":        nearVectorArgument(class.Class),
+			"nearObject":        nearObjectArgument(class.Class),
 			"objectLimit": &graphql.ArgumentConfig{
 				Description: descriptions.First,
 				Type:        graphql.Int,
 			},
 			"hybrid": hybridArgument(fieldsObject, class, modulesProvider),
+			"explain": &graphql.ArgumentConfig{
+				Description: descriptions.Explain,
+				Type:        graphql.Boolean,
+			},
 		},
 		Resolve: makeResolveClass(modulesProvider, class),
 	}
@@ -185,9 +191,48 @@ func classPropertyFields(class *models.Class) (graphql.Fields, error) {
 		},
 	}
 
+	// _additional { explain } is only set if the query is run with explain: true
+	fields[AdditionalFieldName] = &graphql.Field{
+		Description: descriptions.Explain,
+		Type:        additionalObject(fmt.Sprintf("Aggregate%s", class.Class)),
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			// pass-through
+			return p.Source, nil
+		},
+	}
+
 	return fields, nil
 }
 
+func additionalObject(prefix string) *graphql.Object {
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name: fmt.Sprintf("%sAdditional", prefix),
+		Fields: graphql.Fields{
+			"explain": &graphql.Field{
+				Type: graphql.NewList(graphql.NewObject(graphql.ObjectConfig{
+					Name: fmt.Sprintf("%sAdditionalExplain", prefix),
+					Fields: graphql.Fields{
+						"stage":       &graphql.Field{Type: graphql.String},
+						"tookMs":      &graphql.Field{Type: graphql.Float},
+						"cardinality": &graphql.Field{Type: graphql.Int},
+						"runs":        &graphql.Field{Type: graphql.Int},
+					},
+				})),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					switch typed := p.Source.(type) {
+					case aggregation.Group:
+						return typed.Explain, nil
+					case map[string]interface{}:
+						return typed["explain"], nil
+					default:
+						return nil, fmt.Errorf("explain: unsupported type %T", p.Source)
+					}
+				},
+			},
+		},
+	})
+}
+
 func metaObject(prefix string) *graphql.Object {
 	return graphql.NewObject(graphql.ObjectConfig{
 		Name: fmt.Sprintf("%sMetaObject", prefix),
@@ -215,15 +260,21 @@ func classPropertyField(dataType schema.DataType, class *models.Class, property
 		return makePropertyField(class, property, numericPropertyFields)
 	case schema.DataTypeNumber:
 		return makePropertyField(class, property, numericPropertyFields)
+	case schema.DataTypeDuration:
+		// aggregated as the number of nanoseconds
+		return makePropertyField(class, property, numericPropertyFields)
 	case schema.DataTypeBoolean:
 		return makePropertyField(class, property, booleanPropertyFields)
 	case schema.DataTypeDate:
 		return makePropertyField(class, property, datePropertyFields)
 	case schema.DataTypeCRef:
 		return makePropertyField(class, property, referencePropertyFields)
-	case schema.DataTypeGeoCoordinates:
+	case schema.DataTypeGeoCoordinates, schema.DataTypeGeoPolygon, schema.DataTypeGeoShape:
 		// simply skip for now, see gh-729
 		return nil, nil
+	case schema.DataTypeSparseVector:
+		// sparse vectors can't be aggregated
+		return nil, nil
 	case schema.DataTypePhoneNumber:
 		// skipping for now, see gh-1088 where it was outscoped
 		return nil, nil
@@ -237,7 +288,9 @@ func classPropertyField(dataType schema.DataType, class *models.Class, property
 		return makePropertyField(class, property, booleanPropertyFields)
 	case schema.DataTypeDateArray:
 		return makePropertyField(class, property, datePropertyFields)
-	case schema.DataTypeUUID, schema.DataTypeUUIDArray:
+	case schema.DataTypeUUID, schema.DataTypeUUIDArray, schema.DataTypeIP, schema.DataTypeDecimal,
+		schema.DataTypeVector, schema.DataTypeVectorArray,
+		schema.DataTypeObject, schema.DataTypeObjectArray:
 		// not aggregatable
 		return nil, nil
 	default:
@@ -0,0 +1,63 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package aggregate
+
+import (
+	"fmt"
+
+	"github.com/tailor-inc/graphql"
+	"github.com/weaviate/weaviate/adapters/handlers/graphql/descriptions"
+)
+
+func groupByPropertiesArgument() *graphql.ArgumentConfig {
+	return &graphql.ArgumentConfig{
+		Description: descriptions.GroupByProperties,
+		Type:        graphql.NewList(graphql.String),
+	}
+}
+
+func groupByOrderArgument(className string) *graphql.ArgumentConfig {
+	prefix := fmt.Sprintf("AggregateObjects%s", className)
+	return &graphql.ArgumentConfig{
+		Description: descriptions.GroupByOrder,
+		Type: graphql.NewInputObject(
+			graphql.InputObjectConfig{
+				Name:        fmt.Sprintf("%sGroupByOrderInpObj", prefix),
+				Fields:      groupByOrderFields(prefix),
+				Description: descriptions.GroupByOrderInpObj,
+			},
+		),
+	}
+}
+
+func groupByOrderFields(prefix string) graphql.InputObjectConfigFieldMap {
+	return graphql.InputObjectConfigFieldMap{
+		"property": &graphql.InputObjectFieldConfig{
+			Description: descriptions.GroupByOrderProperty,
+			Type:        graphql.String,
+		},
+		"aggregator": &graphql.InputObjectFieldConfig{
+			Description: descriptions.GroupByOrderAggregator,
+			Type:        graphql.String,
+		},
+		"order": &graphql.InputObjectFieldConfig{
+			Description: descriptions.GroupByOrderOrder,
+			Type: graphql.NewEnum(graphql.EnumConfig{
+				Name: fmt.Sprintf("%sGroupByOrderInpObjOrderEnum", prefix),
+				Values: graphql.EnumValueConfigMap{
+					"asc":  &graphql.EnumValueConfig{},
+					"desc": &graphql.EnumValueConfig{},
+				},
+			}),
+		},
+	}
+}
@@ -46,6 +46,14 @@ func hybridOperands(classObject *graphql.Object,
 			Description: "Query string",
 			Type:        graphql.String,
 		},
+		"keywordQuery": &graphql.InputObjectFieldConfig{
+			Description: "Query string of the keyword search, defaults to the query",
+			Type:        graphql.String,
+		},
+		"vectorQuery": &graphql.InputObjectFieldConfig{
+			Description: "Query string which is vectorized for the vector search, defaults to the query",
+			Type:        graphql.String,
+		},
 		"alpha": &graphql.InputObjectFieldConfig{
 			Description: "Search weight",
 			Type:        graphql.Float,
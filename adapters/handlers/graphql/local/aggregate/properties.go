@@ -58,6 +58,48 @@ func numericPropertyFields(class *models.Class, property *models.Property, prefi
 			Type:        graphql.Float,
 			Resolve:     makeResolveNumericFieldAggregator("median"),
 		},
+		"standardDeviation": &graphql.Field{
+			Name:        fmt.Sprintf("%s%s%sStandardDeviation", prefix, class.Class, property.Name),
+			Description: descriptions.AggregateStandardDeviation,
+			Type:        graphql.Float,
+			Resolve:     makeResolveNumericFieldAggregator("standardDeviation"),
+		},
+		"p50": &graphql.Field{
+			Name:        fmt.Sprintf("%s%s%sP50", prefix, class.Class, property.Name),
+			Description: descriptions.AggregatePercentile50,
+			Type:        graphql.Float,
+			Resolve:     makeResolveNumericFieldAggregator("p50"),
+		},
+		"p90": &graphql.Field{
+			Name:        fmt.Sprintf("%s%s%sP90", prefix, class.Class, property.Name),
+			Description: descriptions.AggregatePercentile90,
+			Type:        graphql.Float,
+			Resolve:     makeResolveNumericFieldAggregator("p90"),
+		},
+		"p99": &graphql.Field{
+			Name:        fmt.Sprintf("%s%s%sP99", prefix, class.Class, property.Name),
+			Description: descriptions.AggregatePercentile99,
+			Type:        graphql.Float,
+			Resolve:     makeResolveNumericFieldAggregator("p99"),
+		},
+		"distinctCount": &graphql.Field{
+			Name:        fmt.Sprintf("%s%s%sDistinctCount", prefix, class.Class, property.Name),
+			Description: descriptions.AggregateDistinctCount,
+			Type:        graphql.Int,
+			Resolve:     makeResolveNumericFieldAggregator("distinctCount"),
+		},
+		"histogram": &graphql.Field{
+			Name:        fmt.Sprintf("%s%s%sHistogram", prefix, class.Class, property.Name),
+			Description: descriptions.AggregateHistogram,
+			Type:        graphql.NewList(histogramBucket(class, property, prefix, graphql.Float)),
+			Resolve:     makeResolveNumericFieldAggregator("histogram"),
+			Args: graphql.FieldConfigArgument{
+				"interval": &graphql.ArgumentConfig{
+					Description: descriptions.AggregateHistogramInterval,
+					Type:        graphql.NewNonNull(graphql.Float),
+				},
+			},
+		},
 		"count": &graphql.Field{
 			Name:        fmt.Sprintf("%s%s%sCount", prefix, class.Class, property.Name),
 			Description: descriptions.AggregateCount,
@@ -120,6 +162,18 @@ func datePropertyFields(class *models.Class,
 			Type:        graphql.String,
 			Resolve:     makeResolveDateFieldAggregator("median"),
 		},
+		"histogram": &graphql.Field{
+			Name:        fmt.Sprintf("%s%s%sHistogram", prefix, class.Class, property.Name),
+			Description: descriptions.AggregateHistogram,
+			Type:        graphql.NewList(histogramBucket(class, property, prefix, graphql.String)),
+			Resolve:     makeResolveDateFieldAggregator("histogram"),
+			Args: graphql.FieldConfigArgument{
+				"interval": &graphql.ArgumentConfig{
+					Description: descriptions.AggregateHistogramInterval,
+					Type:        graphql.NewNonNull(graphql.String),
+				},
+			},
+		},
 	}
 
 	return graphql.NewObject(graphql.ObjectConfig{
@@ -129,6 +183,43 @@ func datePropertyFields(class *models.Class,
 	})
 }
 
+func histogramBucket(class *models.Class, property *models.Property,
+	prefix string, keyType graphql.Output,
+) *graphql.Object {
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name: fmt.Sprintf("%s%s%sHistogramBucketObj", prefix, class.Class, property.Name),
+		Fields: graphql.Fields{
+			"key": &graphql.Field{
+				Name:        fmt.Sprintf("%s%s%sHistogramBucketKey", prefix, class.Class, property.Name),
+				Description: descriptions.AggregateHistogramBucketKey,
+				Type:        keyType,
+				Resolve:     histogramBucketResolver(func(b aggregation.HistogramBucket) interface{} { return b.Key }),
+			},
+			"count": &graphql.Field{
+				Name:        fmt.Sprintf("%s%s%sHistogramBucketCount", prefix, class.Class, property.Name),
+				Description: descriptions.AggregateHistogramBucketCount,
+				Type:        graphql.Int,
+				Resolve:     histogramBucketResolver(func(b aggregation.HistogramBucket) interface{} { return b.Count }),
+			},
+		},
+		Description: descriptions.AggregateHistogram,
+	})
+}
+
+type histogramBucketExtractorFunc func(aggregation.HistogramBucket) interface{}
+
+func histogramBucketResolver(extractor histogramBucketExtractorFunc) func(p graphql.ResolveParams) (interface{}, error) {
+	return func(p graphql.ResolveParams) (interface{}, error) {
+		bucket, ok := p.Source.(aggregation.HistogramBucket)
+		if !ok {
+			return nil, fmt.Errorf("histogram bucket: %s: expected aggregation.HistogramBucket, but got %T",
+				p.Info.FieldName, p.Source)
+		}
+
+		return extractor(bucket), nil
+	}
+}
+
 func referencePropertyFields(class *models.Class,
 	property *models.Property, prefix string,
 ) *graphql.Object {
@@ -277,6 +368,14 @@ func stringPropertyFields(class *models.Class,
 				return text.Count, nil
 			}),
 		},
+		"distinctCount": &graphql.Field{
+			Name:        fmt.Sprintf("%s%s%sDistinctCount", prefix, class.Class, property.Name),
+			Description: descriptions.AggregateDistinctCount,
+			Type:        graphql.Int,
+			Resolve: textResolver(func(text aggregation.Text) (interface{}, error) {
+				return text.DistinctCount, nil
+			}),
+		},
 		"type": &graphql.Field{
 			Name:        fmt.Sprintf("%s%s%sType", prefix, class.Class, property.Name),
 			Description: descriptions.AggregateCount,
@@ -404,6 +503,11 @@ func groupedByProperty(class *models.Class) *graphql.Object {
 			Type:        graphql.String,
 			Resolve:     groupedByResolver(func(g *aggregation.GroupedBy) interface{} { return g.Value }),
 		},
+		"keys": &graphql.Field{
+			Description: descriptions.AggregateGroupedByGroupedByKeys,
+			Type:        graphql.NewList(groupedByKeyObject(class)),
+			Resolve:     groupedByResolver(groupedByKeys),
+		},
 	}
 
 	classPropertiesObj := graphql.NewObject(graphql.ObjectConfig{
@@ -415,6 +519,47 @@ func groupedByProperty(class *models.Class) *graphql.Object {
 	return classPropertiesObj
 }
 
+// groupedByKeys returns the keys of a group, groups of a single groupBy path
+// have their only key in the path and value
+func groupedByKeys(g *aggregation.GroupedBy) interface{} {
+	if len(g.Keys) == 0 && g.Path != nil {
+		return []aggregation.GroupedByKey{{Path: g.Path, Value: g.Value}}
+	}
+	return g.Keys
+}
+
+func groupedByKeyObject(class *models.Class) *graphql.Object {
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name: fmt.Sprintf("Aggregate%sGroupedByKeyObj", class.Class),
+		Fields: graphql.Fields{
+			"path": &graphql.Field{
+				Description: descriptions.AggregateGroupedByGroupedByPath,
+				Type:        graphql.NewList(graphql.String),
+				Resolve:     groupedByKeyResolver(func(k aggregation.GroupedByKey) interface{} { return k.Path }),
+			},
+			"value": &graphql.Field{
+				Description: descriptions.AggregateGroupedByGroupedByValue,
+				Type:        graphql.String,
+				Resolve:     groupedByKeyResolver(func(k aggregation.GroupedByKey) interface{} { return k.Value }),
+			},
+		},
+		Description: descriptions.AggregateGroupedByKeyObj,
+	})
+}
+
+func groupedByKeyResolver(extractor func(aggregation.GroupedByKey) interface{},
+) func(p graphql.ResolveParams) (interface{}, error) {
+	return func(p graphql.ResolveParams) (interface{}, error) {
+		key, ok := p.Source.(aggregation.GroupedByKey)
+		if !ok {
+			return nil, fmt.Errorf("groupedBy keys: %s: expected aggregation.GroupedByKey, but got %T",
+				p.Info.FieldName, p.Source)
+		}
+
+		return extractor(key), nil
+	}
+}
+
 type groupedByExtractorFunc func(*aggregation.GroupedBy) interface{}
 
 func groupedByResolver(extractor groupedByExtractorFunc) func(p graphql.ResolveParams) (interface{}, error) {
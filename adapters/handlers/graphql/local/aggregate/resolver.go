@@ -17,9 +17,11 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/tailor-inc/graphql"
 	"github.com/tailor-inc/graphql/language/ast"
+	"github.com/tailor-inc/graphql/language/kinds"
 	"github.com/weaviate/weaviate/adapters/handlers/graphql/local/common_filters"
 	"github.com/weaviate/weaviate/entities/aggregation"
 	enterrors "github.com/weaviate/weaviate/entities/errors"
@@ -34,6 +36,10 @@ import (
 // itself, as it just displays meta info about the overall aggregation.
 const GroupedByFieldName = "groupedBy"
 
+// AdditionalFieldName is the special field holding the explain output of
+// the aggregation, it is not a property either.
+const AdditionalFieldName = "_additional"
+
 // Resolver is a local interface that can be composed with other interfaces to
 // form the overall GraphQL API main interface. All data-base connectors that
 // want to support the Meta feature must implement this interface.
@@ -85,6 +91,19 @@ func resolveAggregate(p graphql.ResolveParams, modulesProvider ModulesProvider,
 		return nil, fmt.Errorf("could not extract groupBy path: %w", err)
 	}
 
+	groupByProperties, err := extractGroupByProperties(p.Args, p.Info.FieldName)
+	if err != nil {
+		return nil, fmt.Errorf("could not extract groupByProperties: %w", err)
+	}
+	if groupBy != nil && len(groupByProperties) > 0 {
+		return nil, fmt.Errorf("groupBy and groupByProperties can't be used together")
+	}
+
+	groupByOrder, err := extractGroupByOrder(p.Args)
+	if err != nil {
+		return nil, fmt.Errorf("could not extract groupByOrder: %w", err)
+	}
+
 	limit, err := extractLimit(p.Args)
 	if err != nil {
 		return nil, fmt.Errorf("could not extract limit: %w", err)
@@ -146,6 +165,8 @@ func resolveAggregate(p graphql.ResolveParams, modulesProvider ModulesProvider,
 		tenant = tk.(string)
 	}
 
+	explain, _ := p.Args["explain"].(bool)
+
 	params := &aggregation.Params{
 		Filters:          filters,
 		ClassName:        className,
@@ -159,6 +180,14 @@ func resolveAggregate(p graphql.ResolveParams, modulesProvider ModulesProvider,
 		ModuleParams:     moduleParams,
 		Hybrid:           hybridParams,
 		Tenant:           tenant,
+		Explain:          explain,
+
+		GroupByProperties: groupByProperties,
+		GroupByOrder:      groupByOrder,
+	}
+
+	if err := validateGroupByOrder(params); err != nil {
+		return nil, err
 	}
 
 	// we might support objectLimit without nearMedia filters later, e.g. with sort
@@ -202,7 +231,7 @@ func extractProperties(selections *ast.SelectionSet) ([]aggregation.ParamPropert
 			continue
 		}
 
-		if name == "__typename" {
+		if name == "__typename" || name == AdditionalFieldName {
 			continue
 		}
 
@@ -243,6 +272,14 @@ func extractAggregators(selections *ast.SelectionSet) ([]aggregation.Aggregator,
 			}
 		}
 
+		if property.Type == aggregation.HistogramType {
+			interval, err := extractIntervalFromArgs(field.Arguments)
+			if err != nil {
+				return nil, err
+			}
+			property.Interval = interval
+		}
+
 		analyses = append(analyses, property)
 	}
 
@@ -264,6 +301,96 @@ func extractGroupBy(args map[string]interface{}, rootClass string) (*filters.Pat
 	return filters.ParsePath(pathSegments, rootClass)
 }
 
+func extractGroupByProperties(args map[string]interface{}, rootClass string) ([]*filters.Path, error) {
+	groupByProperties, ok := args["groupByProperties"]
+	if !ok {
+		return nil, nil
+	}
+
+	names, ok := groupByProperties.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("groupByProperties must be a list, instead got: %#v", groupByProperties)
+	}
+	if len(names) == 0 {
+		return nil, fmt.Errorf("groupByProperties must contain at least one property")
+	}
+
+	paths := make([]*filters.Path, len(names))
+	for i, name := range names {
+		path, err := filters.ParsePath([]interface{}{name}, rootClass)
+		if err != nil {
+			return nil, err
+		}
+		paths[i] = path
+	}
+
+	return paths, nil
+}
+
+func extractGroupByOrder(args map[string]interface{}) (*aggregation.GroupByOrder, error) {
+	groupByOrder, ok := args["groupByOrder"]
+	if !ok {
+		return nil, nil
+	}
+
+	source, ok := groupByOrder.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("groupByOrder must be an object, instead got: %#v", groupByOrder)
+	}
+
+	out := &aggregation.GroupByOrder{}
+	if property, ok := source["property"].(string); ok && property != "" {
+		property = strings.ToLower(property[0:1]) + property[1:]
+		out.Property = schema.PropertyName(property)
+	}
+	if aggregator, ok := source["aggregator"].(string); ok {
+		out.Aggregator = aggregator
+	}
+	if order, ok := source["order"].(string); ok {
+		out.Ascending = order == "asc"
+	}
+
+	return out, nil
+}
+
+// validateGroupByOrder makes sure the groups are ordered by an aggregation
+// which is part of the query, as it is computed with the groups
+func validateGroupByOrder(params *aggregation.Params) error {
+	order := params.GroupByOrder
+	if order == nil {
+		return nil
+	}
+
+	if !params.Grouped() {
+		return fmt.Errorf("groupByOrder can only be used with groupBy or groupByProperties")
+	}
+
+	if order.Property == "" {
+		if order.Aggregator != "" {
+			return fmt.Errorf("groupByOrder: aggregator '%s' requires a property", order.Aggregator)
+		}
+		return nil
+	}
+
+	if order.Aggregator == "" {
+		return fmt.Errorf("groupByOrder: property '%s' requires an aggregator", order.Property)
+	}
+
+	for _, prop := range params.Properties {
+		if prop.Name != order.Property {
+			continue
+		}
+		for _, agg := range prop.Aggregators {
+			if agg.String() == order.Aggregator {
+				return nil
+			}
+		}
+	}
+
+	return fmt.Errorf("groupByOrder: aggregator '%s' of property '%s' must be part of the query",
+		order.Aggregator, order.Property)
+}
+
 func principalFromContext(ctx context.Context) *models.Principal {
 	principal := ctx.Value("principal")
 	if principal == nil {
@@ -318,6 +445,40 @@ func extractLimitFromArgs(args []*ast.Argument) *int {
 	return nil
 }
 
+// extractIntervalFromArgs parses the interval of a histogram, which is a
+// number for numeric props and a duration like "24h" for date props. The
+// interval of dates is returned in seconds.
+func extractIntervalFromArgs(args []*ast.Argument) (*float64, error) {
+	for _, arg := range args {
+		if arg.Name.Value != "interval" {
+			continue
+		}
+
+		v, _ := arg.Value.GetValue().(string)
+		var interval float64
+		if arg.Value.GetKind() == kinds.StringValue {
+			duration, err := time.ParseDuration(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid histogram interval %q: %w", v, err)
+			}
+			interval = duration.Seconds()
+		} else {
+			var err error
+			interval, err = strconv.ParseFloat(v, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid histogram interval %q: %w", v, err)
+			}
+		}
+
+		if interval <= 0 {
+			return nil, fmt.Errorf("histogram interval must be greater than 0, got %q", v)
+		}
+		return &interval, nil
+	}
+
+	return nil, fmt.Errorf("histogram requires an interval")
+}
+
 func validateObjectLimitUsage(params *aggregation.Params) bool {
 	return params.NearObject != nil ||
 		params.NearVector != nil ||
@@ -16,6 +16,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/weaviate/weaviate/entities/aggregation"
+	"github.com/weaviate/weaviate/entities/explain"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/searchparams"
@@ -35,6 +36,9 @@ type testCase struct {
 	expectedIncludeMetaCount bool
 	expectedLimit            *int
 	expectedObjectLimit      *int
+	expectedGroupByProps     []*filters.Path
+	expectedGroupByOrder     *aggregation.GroupByOrder
+	expectedExplain          bool
 }
 
 type testCases []testCase
@@ -178,6 +182,51 @@ func Test_Resolve(t *testing.T) {
 				},
 			}},
 		},
+		testCase{
+			name:  "with explain",
+			query: `{ Aggregate { Car(explain:true) { horsepower { mean } _additional { explain { stage cardinality runs } } } } }`,
+			expectedProps: []aggregation.ParamProperty{
+				{
+					Name:        "horsepower",
+					Aggregators: []aggregation.Aggregator{aggregation.MeanAggregator},
+				},
+			},
+			resolverReturn: []aggregation.Group{
+				{
+					Properties: map[string]aggregation.Property{
+						"horsepower": {
+							Type: aggregation.PropertyTypeNumerical,
+							NumericalAggregations: map[string]interface{}{
+								"mean": 275.7773,
+							},
+						},
+					},
+					Explain: []explain.Stage{
+						{Name: explain.StageAggregation, TookMs: 1.5, Cardinality: 1, Runs: 1},
+					},
+				},
+			},
+
+			expectedGroupBy: nil,
+			expectedExplain: true,
+			expectedResults: []result{{
+				pathToField: []string{"Aggregate", "Car"},
+				expectedValue: []interface{}{
+					map[string]interface{}{
+						"horsepower": map[string]interface{}{"mean": 275.7773},
+						"_additional": map[string]interface{}{
+							"explain": []interface{}{
+								map[string]interface{}{
+									"stage":       "aggregation",
+									"cardinality": 1,
+									"runs":        1,
+								},
+							},
+						},
+					},
+				},
+			}},
+		},
 		testCase{
 			name:  "setting limits overall",
 			query: `{ Aggregate { Car(limit:20) { horsepower { mean } } } }`,
@@ -464,6 +513,36 @@ func Test_Resolve(t *testing.T) {
 			}},
 		},
 
+		testCase{
+			name:          "single prop: groupedBy keys of a groupBy path",
+			query:         `{ Aggregate { Car(groupBy:["madeBy", "Manufacturer", "name"]) { groupedBy { keys { path value } } } } }`,
+			expectedProps: []aggregation.ParamProperty{},
+			resolverReturn: []aggregation.Group{
+				{
+					GroupedBy: &aggregation.GroupedBy{
+						Path:  []string{"madeBy", "Manufacturer", "name"},
+						Value: "best-manufacturer",
+					},
+				},
+			},
+			expectedGroupBy: groupCarByMadeByManufacturerName(),
+			expectedResults: []result{{
+				pathToField: []string{"Aggregate", "Car"},
+				expectedValue: []interface{}{
+					map[string]interface{}{
+						"groupedBy": map[string]interface{}{
+							"keys": []interface{}{
+								map[string]interface{}{
+									"path":  []interface{}{"madeBy", "Manufacturer", "name"},
+									"value": "best-manufacturer",
+								},
+							},
+						},
+					},
+				},
+			}},
+		},
+
 		testCase{
 			name: "single prop: mean with a where filter",
 			query: `{
@@ -620,6 +699,244 @@ func Test_Resolve(t *testing.T) {
 			}},
 		},
 
+		testCase{
+			name:  "percentiles, standard deviation and distinct count",
+			query: `{ Aggregate { Car(groupBy:["madeBy", "Manufacturer", "name"]) { horsepower { p50, p90, p99, standardDeviation, distinctCount } } } }`,
+			expectedProps: []aggregation.ParamProperty{
+				{
+					Name: "horsepower",
+					Aggregators: []aggregation.Aggregator{
+						aggregation.Percentile50Aggregator, aggregation.Percentile90Aggregator,
+						aggregation.Percentile99Aggregator, aggregation.StandardDeviationAggregator,
+						aggregation.DistinctCountAggregator,
+					},
+				},
+			},
+			resolverReturn: []aggregation.Group{
+				{
+					GroupedBy: &aggregation.GroupedBy{
+						Path:  []string{"madeBy", "Manufacturer", "name"},
+						Value: "best-manufacturer",
+					},
+					Properties: map[string]aggregation.Property{
+						"horsepower": {
+							Type: aggregation.PropertyTypeNumerical,
+							NumericalAggregations: map[string]interface{}{
+								"p50":               289.0,
+								"p90":               540.5,
+								"p99":               605.2,
+								"standardDeviation": 131.4,
+								"distinctCount":     19,
+							},
+						},
+					},
+				},
+			},
+			expectedGroupBy: groupCarByMadeByManufacturerName(),
+			expectedResults: []result{{
+				pathToField: []string{"Aggregate", "Car"},
+				expectedValue: []interface{}{
+					map[string]interface{}{
+						"horsepower": map[string]interface{}{
+							"p50":               289.0,
+							"p90":               540.5,
+							"p99":               605.2,
+							"standardDeviation": 131.4,
+							"distinctCount":     19,
+						},
+					},
+				},
+			}},
+		},
+
+		testCase{
+			name:  "distinct count of string prop",
+			query: `{ Aggregate { Car(groupBy:["madeBy", "Manufacturer", "name"]) { modelName { count distinctCount } } } }`,
+			expectedProps: []aggregation.ParamProperty{
+				{
+					Name:        "modelName",
+					Aggregators: []aggregation.Aggregator{aggregation.CountAggregator, aggregation.DistinctCountAggregator},
+				},
+			},
+			resolverReturn: []aggregation.Group{
+				{
+					GroupedBy: &aggregation.GroupedBy{
+						Path:  []string{"madeBy", "Manufacturer", "name"},
+						Value: "best-manufacturer",
+					},
+					Properties: map[string]aggregation.Property{
+						"modelName": {
+							Type: aggregation.PropertyTypeText,
+							TextAggregation: aggregation.Text{
+								Count:         7,
+								DistinctCount: 4,
+							},
+						},
+					},
+				},
+			},
+			expectedGroupBy: groupCarByMadeByManufacturerName(),
+			expectedResults: []result{{
+				pathToField: []string{"Aggregate", "Car"},
+				expectedValue: []interface{}{
+					map[string]interface{}{
+						"modelName": map[string]interface{}{
+							"count":         7,
+							"distinctCount": 4,
+						},
+					},
+				},
+			}},
+		},
+
+		testCase{
+			name:  "histograms of number and date props",
+			query: `{ Aggregate { Car { horsepower { histogram(interval: 100) { key count } } startOfProduction { histogram(interval: "24h") { key count } } } } }`,
+			expectedProps: []aggregation.ParamProperty{
+				{
+					Name:        "horsepower",
+					Aggregators: []aggregation.Aggregator{aggregation.NewHistogramAggregator(ptFloat64(100))},
+				},
+				{
+					Name:        "startOfProduction",
+					Aggregators: []aggregation.Aggregator{aggregation.NewHistogramAggregator(ptFloat64(86400))},
+				},
+			},
+			resolverReturn: []aggregation.Group{
+				{
+					Properties: map[string]aggregation.Property{
+						"horsepower": {
+							Type: aggregation.PropertyTypeNumerical,
+							NumericalAggregations: map[string]interface{}{
+								"histogram": []aggregation.HistogramBucket{
+									{Key: 100.0, Count: 3},
+									{Key: 300.0, Count: 1},
+								},
+							},
+						},
+						"startOfProduction": {
+							Type: aggregation.PropertyTypeDate,
+							DateAggregations: map[string]interface{}{
+								"histogram": []aggregation.HistogramBucket{
+									{Key: "2020-01-01T00:00:00Z", Count: 2},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedResults: []result{{
+				pathToField: []string{"Aggregate", "Car"},
+				expectedValue: []interface{}{
+					map[string]interface{}{
+						"horsepower": map[string]interface{}{
+							"histogram": []interface{}{
+								map[string]interface{}{"key": 100.0, "count": 3},
+								map[string]interface{}{"key": 300.0, "count": 1},
+							},
+						},
+						"startOfProduction": map[string]interface{}{
+							"histogram": []interface{}{
+								map[string]interface{}{"key": "2020-01-01T00:00:00Z", "count": 2},
+							},
+						},
+					},
+				},
+			}},
+		},
+
+		testCase{
+			name: "multiple groupByProperties with keys",
+			query: `{ Aggregate { Car(groupByProperties:["modelName", "stillInProduction"], limit: 10) {
+				meta { count } groupedBy { keys { path value } } } } }`,
+			expectedProps:            []aggregation.ParamProperty{},
+			expectedIncludeMetaCount: true,
+			expectedLimit:            ptInt(10),
+			expectedGroupByProps: []*filters.Path{
+				{Class: "Car", Property: "modelName"},
+				{Class: "Car", Property: "stillInProduction"},
+			},
+			resolverReturn: []aggregation.Group{
+				{
+					GroupedBy: &aggregation.GroupedBy{
+						Keys: []aggregation.GroupedByKey{
+							{Path: []string{"modelName"}, Value: "beetle"},
+							{Path: []string{"stillInProduction"}, Value: false},
+						},
+					},
+					Count: 3,
+				},
+			},
+			expectedResults: []result{{
+				pathToField: []string{"Aggregate", "Car"},
+				expectedValue: []interface{}{
+					map[string]interface{}{
+						"meta": map[string]interface{}{"count": 3},
+						"groupedBy": map[string]interface{}{
+							"keys": []interface{}{
+								map[string]interface{}{"path": []interface{}{"modelName"}, "value": "beetle"},
+								map[string]interface{}{"path": []interface{}{"stillInProduction"}, "value": "false"},
+							},
+						},
+					},
+				},
+			}},
+		},
+
+		testCase{
+			name: "groupByOrder by a metric",
+			query: `{ Aggregate { Car(groupByProperties:["modelName"],
+				groupByOrder: {property: "horsepower", aggregator: "mean", order: asc}) {
+				horsepower { mean } } } }`,
+			expectedProps: []aggregation.ParamProperty{
+				{
+					Name:        "horsepower",
+					Aggregators: []aggregation.Aggregator{aggregation.MeanAggregator},
+				},
+			},
+			expectedGroupByProps: []*filters.Path{{Class: "Car", Property: "modelName"}},
+			expectedGroupByOrder: &aggregation.GroupByOrder{
+				Property:   "horsepower",
+				Aggregator: "mean",
+				Ascending:  true,
+			},
+			resolverReturn: []aggregation.Group{
+				{
+					Properties: map[string]aggregation.Property{
+						"horsepower": {
+							Type:                  aggregation.PropertyTypeNumerical,
+							NumericalAggregations: map[string]interface{}{"mean": 120.0},
+						},
+					},
+				},
+			},
+			expectedResults: []result{{
+				pathToField: []string{"Aggregate", "Car"},
+				expectedValue: []interface{}{
+					map[string]interface{}{
+						"horsepower": map[string]interface{}{"mean": 120.0},
+					},
+				},
+			}},
+		},
+
+		testCase{
+			name: "groupByOrder by count",
+			query: `{ Aggregate { Car(groupBy:["madeBy", "Manufacturer", "name"], groupByOrder: {order: asc}) {
+				meta { count } } } }`,
+			expectedProps:            []aggregation.ParamProperty{},
+			expectedIncludeMetaCount: true,
+			expectedGroupBy:          groupCarByMadeByManufacturerName(),
+			expectedGroupByOrder:     &aggregation.GroupByOrder{Ascending: true},
+			resolverReturn:           []aggregation.Group{{Count: 1}},
+			expectedResults: []result{{
+				pathToField: []string{"Aggregate", "Car"},
+				expectedValue: []interface{}{
+					map[string]interface{}{"meta": map[string]interface{}{"count": 1}},
+				},
+			}},
+		},
+
 		testCase{
 			name: "with objectLimit + nearObject (distance)",
 			query: `
@@ -946,6 +1263,10 @@ func (tests testCases) AssertExtraction(t *testing.T, className string) {
 				IncludeMetaCount: testCase.expectedIncludeMetaCount,
 				Limit:            testCase.expectedLimit,
 				ObjectLimit:      testCase.expectedObjectLimit,
+
+				GroupByProperties: testCase.expectedGroupByProps,
+				GroupByOrder:      testCase.expectedGroupByOrder,
+				Explain:           testCase.expectedExplain,
 			}
 
 			resolver.On("Aggregate", expectedParams).
@@ -965,3 +1286,37 @@ func (tests testCases) AssertExtraction(t *testing.T, className string) {
 func ptInt(in int) *int {
 	return &in
 }
+
+func ptFloat64(in float64) *float64 {
+	return &in
+}
+
+func Test_ResolveHistogramWithInvalidInterval(t *testing.T) {
+	resolver := newMockResolver(config.Config{})
+
+	resolver.AssertFailToResolve(t,
+		`{ Aggregate { Car { horsepower { histogram(interval: 0) { key } } } } }`,
+		`could not extract properties for class 'Car': histogram interval must be greater than 0, got "0"`)
+	resolver.AssertFailToResolve(t,
+		`{ Aggregate { Car { startOfProduction { histogram(interval: "daily") { key } } } } }`,
+		`could not extract properties for class 'Car': invalid histogram interval "daily": time: invalid duration "daily"`)
+}
+
+func Test_ResolveWithInvalidGroupByOrder(t *testing.T) {
+	resolver := newMockResolver(config.Config{})
+
+	resolver.AssertFailToResolve(t,
+		`{ Aggregate { Car(groupBy:["modelName"], groupByProperties:["modelName"]) { meta { count } } } }`,
+		`groupBy and groupByProperties can't be used together`)
+	resolver.AssertFailToResolve(t,
+		`{ Aggregate { Car(groupByOrder: {order: asc}) { meta { count } } } }`,
+		`groupByOrder can only be used with groupBy or groupByProperties`)
+	resolver.AssertFailToResolve(t,
+		`{ Aggregate { Car(groupByProperties:["modelName"], groupByOrder: {property: "horsepower", aggregator: "mean"}) {
+			horsepower { maximum } } } }`,
+		`groupByOrder: aggregator 'mean' of property 'horsepower' must be part of the query`)
+	resolver.AssertFailToResolve(t,
+		`{ Aggregate { Car(groupByProperties:["modelName"], groupByOrder: {property: "horsepower"}) {
+			horsepower { mean } } } }`,
+		`groupByOrder: property 'horsepower' requires an aggregator`)
+}
@@ -31,8 +31,40 @@ func ExtractBM25(source map[string]interface{}, explainScore bool) searchparams.
 		args.Query = query.(string)
 	}
 
+	fuzzy, ok := source["fuzzy"]
+	if ok {
+		args.Fuzzy = fuzzy.(int)
+	}
+
+	highlight, ok := source["highlight"]
+	if ok {
+		args.Highlight = ExtractHighlight(highlight.(map[string]interface{}))
+	}
+
 	args.AdditionalExplanations = explainScore
 	args.Type = "bm25"
 
 	return args
 }
+
+// ExtractHighlight
+func ExtractHighlight(source map[string]interface{}) *searchparams.Highlight {
+	var args searchparams.Highlight
+
+	fragmentSize, ok := source["fragmentSize"]
+	if ok {
+		args.FragmentSize = fragmentSize.(int)
+	}
+
+	preTag, ok := source["preTag"]
+	if ok {
+		args.PreTag = preTag.(string)
+	}
+
+	postTag, ok := source["postTag"]
+	if ok {
+		args.PostTag = postTag.(string)
+	}
+
+	return &args
+}
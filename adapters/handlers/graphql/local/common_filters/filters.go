@@ -40,6 +40,10 @@ func BuildNew(path string) graphql.InputObjectConfigFieldMap {
 					"IsNull":           &graphql.EnumValueConfig{},
 					"ContainsAny":      &graphql.EnumValueConfig{},
 					"ContainsAll":      &graphql.EnumValueConfig{},
+					"WithinCIDR":       &graphql.EnumValueConfig{},
+					"WithinPolygon":    &graphql.EnumValueConfig{},
+					"Intersects":       &graphql.EnumValueConfig{},
+					"FuzzyMatch":       &graphql.EnumValueConfig{},
 				},
 				Description: descriptions.WhereOperatorEnum,
 			}),
@@ -77,6 +81,10 @@ func BuildNew(path string) graphql.InputObjectConfigFieldMap {
 			Type:        newGeoRangeInputObject(path),
 			Description: descriptions.WhereValueRange,
 		},
+		"valueGeoPolygon": &graphql.InputObjectFieldConfig{
+			Type:        newGeoPolygonInputObject(path),
+			Description: descriptions.WhereValueGeoPolygon,
+		},
 	}
 
 	// Recurse into the same time.
@@ -128,6 +136,33 @@ func newGeoRangeGeoCoordinatesInputObject(path string) *graphql.InputObject {
 	})
 }
 
+func newGeoPolygonInputObject(path string) *graphql.InputObject {
+	return graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: fmt.Sprintf("%sWhereGeoPolygonInpObj", path),
+		Fields: graphql.InputObjectConfigFieldMap{
+			"coordinates": &graphql.InputObjectFieldConfig{
+				Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(
+					newGeoPolygonGeoCoordinatesInputObject(path)))),
+				Description: descriptions.WhereValueGeoPolygonCoordinates,
+			},
+		},
+	})
+}
+
+func newGeoPolygonGeoCoordinatesInputObject(path string) *graphql.InputObject {
+	return graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: fmt.Sprintf("%sWhereGeoPolygonGeoCoordinatesInpObj", path),
+		Fields: graphql.InputObjectConfigFieldMap{
+			"latitude": &graphql.InputObjectFieldConfig{
+				Type: graphql.NewNonNull(graphql.Float),
+			},
+			"longitude": &graphql.InputObjectFieldConfig{
+				Type: graphql.NewNonNull(graphql.Float),
+			},
+		},
+	})
+}
+
 func newGeoRangeDistanceInputObject(path string) *graphql.InputObject {
 	return graphql.NewInputObject(graphql.InputObjectConfig{
 		Name: fmt.Sprintf("%sWhereGeoRangeDistanceInpObj", path),
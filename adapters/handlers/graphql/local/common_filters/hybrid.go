@@ -21,6 +21,7 @@ const DefaultAlpha = float64(0.75)
 const (
 	HybridRankedFusion = iota
 	HybridRelativeScoreFusion
+	HybridZScoreFusion
 )
 
 func ExtractHybridSearch(source map[string]interface{}, explainScore bool) (*searchparams.HybridSearch, error) {
@@ -91,12 +92,33 @@ func ExtractHybridSearch(source map[string]interface{}, explainScore bool) (*sea
 		args.Query = query.(string)
 	}
 
+	if keywordQuery, ok := source["keywordQuery"]; ok {
+		args.KeywordQuery = keywordQuery.(string)
+	}
+
+	if vectorQuery, ok := source["vectorQuery"]; ok {
+		args.VectorQuery = vectorQuery.(string)
+	}
+
 	fusionType, ok := source["fusionType"]
 	if ok {
 		args.FusionAlgorithm = fusionType.(int)
 	} else {
 		args.FusionAlgorithm = HybridRankedFusion
 	}
+
+	if rankConstant, ok := source["rankConstant"]; ok {
+		args.RankConstant = rankConstant.(int)
+		if args.RankConstant <= 0 {
+			return nil, fmt.Errorf("rankConstant should be greater than 0")
+		}
+	}
+
+	exact, ok := source["exact"]
+	if ok {
+		args.Exact = exact.(bool)
+	}
+
 	if _, ok := source["vector"]; ok {
 		vector := source["vector"].([]interface{})
 		args.Vector = make([]float32, len(vector))
@@ -113,6 +135,18 @@ func ExtractHybridSearch(source map[string]interface{}, explainScore bool) (*sea
 		}
 	}
 
+	if highlight, ok := source["highlight"]; ok {
+		args.Highlight = ExtractHighlight(highlight.(map[string]interface{}))
+	}
+
+	if sparseVector, ok := source["sparseVector"]; ok {
+		nearSparseVector, err := ExtractNearSparseVector(sparseVector.(map[string]interface{}))
+		if err != nil {
+			return nil, err
+		}
+		args.SparseVector = nearSparseVector
+	}
+
 	args.Type = "hybrid"
 	return &args, nil
 }
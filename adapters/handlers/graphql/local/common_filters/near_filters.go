@@ -45,6 +45,22 @@ func nearVectorFields(prefix string) graphql.InputObjectConfigFieldMap {
 			Description: descriptions.Distance,
 			Type:        graphql.Float,
 		},
+		"targetVector": &graphql.InputObjectFieldConfig{
+			Description: descriptions.TargetVector,
+			Type:        graphql.String,
+		},
+		"rescoreLimit": &graphql.InputObjectFieldConfig{
+			Description: descriptions.RescoreLimit,
+			Type:        graphql.Int,
+		},
+		"oversampling": &graphql.InputObjectFieldConfig{
+			Description: descriptions.Oversampling,
+			Type:        graphql.Float,
+		},
+		"exact": &graphql.InputObjectFieldConfig{
+			Description: descriptions.Exact,
+			Type:        graphql.Boolean,
+		},
 	}
 }
 
@@ -78,5 +94,41 @@ func nearObjectFields(prefix string) graphql.InputObjectConfigFieldMap {
 			Description: descriptions.Distance,
 			Type:        graphql.Float,
 		},
+		"targetVector": &graphql.InputObjectFieldConfig{
+			Description: descriptions.TargetVector,
+			Type:        graphql.String,
+		},
 	}
 }
+
+func NearSparseVectorArgument(argumentPrefix, className string) *graphql.ArgumentConfig {
+	prefix := fmt.Sprintf("%s%s", argumentPrefix, className)
+	return &graphql.ArgumentConfig{
+		Type: NearSparseVectorInputObject(fmt.Sprintf("%sNearSparseVectorInpObj", prefix)),
+	}
+}
+
+// NearSparseVectorInputObject is the input of a search of the sparse vector
+// index of a property
+func NearSparseVectorInputObject(name string) *graphql.InputObject {
+	return graphql.NewInputObject(
+		graphql.InputObjectConfig{
+			Name:        name,
+			Description: "Rank by the dot product with the sparse vectors of a property",
+			Fields: graphql.InputObjectConfigFieldMap{
+				"property": &graphql.InputObjectFieldConfig{
+					Description: "The sparseVector property to search in",
+					Type:        graphql.NewNonNull(graphql.String),
+				},
+				"indices": &graphql.InputObjectFieldConfig{
+					Description: "The indices of the non-zero dimensions of the query vector",
+					Type:        graphql.NewNonNull(graphql.NewList(graphql.Int)),
+				},
+				"values": &graphql.InputObjectFieldConfig{
+					Description: "The values of the non-zero dimensions of the query vector",
+					Type:        graphql.NewNonNull(graphql.NewList(graphql.Float)),
+				},
+			},
+		},
+	)
+}
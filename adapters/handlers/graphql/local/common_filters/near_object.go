@@ -42,6 +42,11 @@ func ExtractNearObject(source map[string]interface{}) (searchparams.NearObject,
 		args.WithDistance = true
 	}
 
+	targetVector, ok := source["targetVector"]
+	if ok {
+		args.TargetVector = targetVector.(string)
+	}
+
 	if certaintyOK && distanceOK {
 		return searchparams.NearObject{},
 			fmt.Errorf("cannot provide distance and certainty")
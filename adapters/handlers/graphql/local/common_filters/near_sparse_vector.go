@@ -0,0 +1,43 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package common_filters
+
+import (
+	"github.com/weaviate/weaviate/entities/searchparams"
+)
+
+// ExtractNearSparseVector arguments, i.e. the "property" and the "indices"
+// and "values" of the query vector
+func ExtractNearSparseVector(source map[string]interface{}) (*searchparams.NearSparseVector, error) {
+	var args searchparams.NearSparseVector
+
+	// all fields are required, so we don't need to check for their existence
+	args.Property = source["property"].(string)
+
+	indices := source["indices"].([]interface{})
+	args.Indices = make([]int64, len(indices))
+	for i, value := range indices {
+		args.Indices[i] = int64(value.(int))
+	}
+
+	values := source["values"].([]interface{})
+	args.Values = make([]float32, len(values))
+	for i, value := range values {
+		args.Values[i] = float32(value.(float64))
+	}
+
+	if err := args.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &args, nil
+}
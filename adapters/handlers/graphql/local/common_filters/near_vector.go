@@ -39,10 +39,34 @@ func ExtractNearVector(source map[string]interface{}) (searchparams.NearVector,
 		args.WithDistance = true
 	}
 
+	targetVector, ok := source["targetVector"]
+	if ok {
+		args.TargetVector = targetVector.(string)
+	}
+
+	rescoreLimit, ok := source["rescoreLimit"]
+	if ok {
+		args.RescoreLimit = rescoreLimit.(int)
+	}
+
+	oversampling, ok := source["oversampling"]
+	if ok {
+		args.Oversampling = oversampling.(float64)
+	}
+
+	exact, ok := source["exact"]
+	if ok {
+		args.Exact = exact.(bool)
+	}
+
 	if certaintyOK && distanceOK {
 		return searchparams.NearVector{},
 			fmt.Errorf("cannot provide distance and certainty")
 	}
 
+	if err := args.GetRescore().Validate(); err != nil {
+		return searchparams.NearVector{}, err
+	}
+
 	return args, nil
 }
@@ -134,6 +134,9 @@ func (c *converter) do(in *WhereFilter) (*models.WhereFilter, error) {
 	if in.ValueGeoRange != nil {
 		whereFilter.ValueGeoRange = in.ValueGeoRange
 	}
+	if in.ValueGeoPolygon != nil {
+		whereFilter.ValueGeoPolygon = in.ValueGeoPolygon
+	}
 
 	// recursively build operands
 	for i, op := range in.Operands {
@@ -163,14 +166,15 @@ func (c *converter) parseString(in interface{}) (value *string, valueArray []str
 }
 
 type WhereFilter struct {
-	Operands      []*WhereFilter              `json:"operands"`
-	Operator      string                      `json:"operator,omitempty"`
-	Path          []string                    `json:"path"`
-	ValueBoolean  interface{}                 `json:"valueBoolean,omitempty"`
-	ValueDate     interface{}                 `json:"valueDate,omitempty"`
-	ValueInt      interface{}                 `json:"valueInt,omitempty"`
-	ValueNumber   interface{}                 `json:"valueNumber,omitempty"`
-	ValueString   interface{}                 `json:"valueString,omitempty"`
-	ValueText     interface{}                 `json:"valueText,omitempty"`
-	ValueGeoRange *models.WhereFilterGeoRange `json:"valueGeoRange,omitempty"`
+	Operands        []*WhereFilter              `json:"operands"`
+	Operator        string                      `json:"operator,omitempty"`
+	Path            []string                    `json:"path"`
+	ValueBoolean    interface{}                 `json:"valueBoolean,omitempty"`
+	ValueDate       interface{}                 `json:"valueDate,omitempty"`
+	ValueInt        interface{}                 `json:"valueInt,omitempty"`
+	ValueNumber     interface{}                 `json:"valueNumber,omitempty"`
+	ValueString     interface{}                 `json:"valueString,omitempty"`
+	ValueText       interface{}                 `json:"valueText,omitempty"`
+	ValueGeoRange   *models.WhereFilterGeoRange `json:"valueGeoRange,omitempty"`
+	ValueGeoPolygon *models.GeoPolygon          `json:"valueGeoPolygon,omitempty"`
 }
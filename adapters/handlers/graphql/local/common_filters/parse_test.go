@@ -233,6 +233,43 @@ func TestExtractFilterGeoLocation(t *testing.T) {
 	})
 }
 
+func TestExtractFilterGeoPolygon(t *testing.T) {
+	t.Parallel()
+
+	resolver := newMockResolver(t, mockParams{reportFilter: true})
+	expectedParams := &filters.LocalFilter{Root: &filters.Clause{
+		Operator: filters.OperatorWithinPolygon,
+		On: &filters.Path{
+			Class:    schema.AssertValidClassName("SomeAction"),
+			Property: schema.AssertValidPropertyName("location"),
+		},
+		Value: &filters.Value{
+			Value: filters.GeoPolygon{GeoPolygon: &models.GeoPolygon{
+				Coordinates: []*models.GeoCoordinates{
+					{Latitude: ptFloat32(0.5), Longitude: ptFloat32(0.5)},
+					{Latitude: ptFloat32(0.5), Longitude: ptFloat32(1.5)},
+					{Latitude: ptFloat32(1.5), Longitude: ptFloat32(1.0)},
+				},
+			}},
+			Type: schema.DataTypeGeoPolygon,
+		},
+	}}
+
+	resolver.On("ReportFilters", expectedParams).
+		Return(test_helper.EmptyList(), nil).Once()
+
+	query := `{ SomeAction(where: {
+			path: ["location"],
+			operator: WithinPolygon,
+			valueGeoPolygon: { coordinates: [
+				{ latitude: 0.5, longitude: 0.5 },
+				{ latitude: 0.5, longitude: 1.5 },
+				{ latitude: 1.5, longitude: 1.0 }
+			] }
+		}) }`
+	resolver.AssertResolve(t, query)
+}
+
 func TestExtractFilterNestedField(t *testing.T) {
 	t.Parallel()
 
@@ -308,6 +345,92 @@ func TestExtractOperand(t *testing.T) {
 	resolver.AssertResolve(t, query)
 }
 
+func TestExtractNotOperand(t *testing.T) {
+	t.Parallel()
+
+	resolver := newMockResolver(t, mockParams{reportFilter: true})
+
+	expectedParams := &filters.LocalFilter{Root: &filters.Clause{
+		Operator: filters.OperatorNot,
+		Operands: []filters.Clause{
+			{
+				Operator: filters.OperatorEqual,
+				On: &filters.Path{
+					Class:    schema.AssertValidClassName("SomeAction"),
+					Property: schema.AssertValidPropertyName("intField"),
+				},
+				Value: &filters.Value{
+					Value: 42,
+					Type:  schema.DataTypeInt,
+				},
+			},
+		},
+	}}
+
+	resolver.On("ReportFilters", expectedParams).
+		Return(test_helper.EmptyList(), nil).Once()
+
+	query := `{ SomeAction(where: { operator: Not, operands: [
+      { operator: Equal, valueInt: 42, path: ["intField"]}
+    ]}) }`
+	resolver.AssertResolve(t, query)
+}
+
+func TestExtractOperandWithoutOperator(t *testing.T) {
+	t.Parallel()
+
+	resolver := newMockResolver(t, mockParams{reportFilter: true})
+
+	expectedParams := &filters.LocalFilter{Root: &filters.Clause{
+		Operator: filters.OperatorAnd,
+		Operands: []filters.Clause{
+			{
+				Operator: filters.OperatorEqual,
+				On: &filters.Path{
+					Class:    schema.AssertValidClassName("SomeAction"),
+					Property: schema.AssertValidPropertyName("intField"),
+				},
+				Value: &filters.Value{
+					Value: 42,
+					Type:  schema.DataTypeInt,
+				},
+			},
+			{
+				Operator: filters.OperatorLessThan,
+				On: &filters.Path{
+					Class:    schema.AssertValidClassName("SomeAction"),
+					Property: schema.AssertValidPropertyName("intField"),
+				},
+				Value: &filters.Value{
+					Value: 4242,
+					Type:  schema.DataTypeInt,
+				},
+			},
+		},
+	}}
+
+	resolver.On("ReportFilters", expectedParams).
+		Return(test_helper.EmptyList(), nil).Once()
+
+	query := `{ SomeAction(where: { operands: [
+      { operator: Equal,    valueInt: 42,   path: ["intField"]},
+      { operator: LessThan, valueInt: 4242, path: ["intField"]}
+    ]}) }`
+	resolver.AssertResolve(t, query)
+}
+
+func TestExtractNotFailsWithMultipleOperands(t *testing.T) {
+	t.Parallel()
+
+	resolver := newMockResolver(t, mockParams{reportFilter: true})
+
+	query := `{ SomeAction(where: { operator: Not, operands: [
+      { operator: Equal, valueInt: 42,   path: ["intField"]},
+      { operator: Equal, valueInt: 4242, path: ["intField"]}
+    ]}) }`
+	resolver.AssertFailToResolve(t, query)
+}
+
 func TestExtractCompareOpFailsIfOperandPresent(t *testing.T) {
 	t.Parallel()
 
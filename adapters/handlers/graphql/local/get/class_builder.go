@@ -77,6 +77,9 @@ func (b *classBuilder) kinds(kindSchema *models.Schema) (*graphql.Object, error)
 			"relativeScoreFusion": &graphql.EnumValueConfig{
 				Value: common_filters.HybridRelativeScoreFusion,
 			},
+			"zScoreFusion": &graphql.EnumValueConfig{
+				Value: common_filters.HybridZScoreFusion,
+			},
 		},
 	})
 	classFields := graphql.Fields{}
@@ -157,7 +160,11 @@ func (b *classBuilder) additionalFields(classProperties graphql.Fields, class *m
 	additionalProperties["lastUpdateTimeUnix"] = b.additionalLastUpdateTimeUnix()
 	additionalProperties["score"] = b.additionalScoreField()
 	additionalProperties["explainScore"] = b.additionalExplainScoreField()
+	additionalProperties["keywordScore"] = b.additionalRawScoreField()
+	additionalProperties["vectorScore"] = b.additionalRawScoreField()
 	additionalProperties["group"] = b.additionalGroupField(classProperties, class)
+	additionalProperties["highlights"] = b.additionalHighlightsField(class)
+	additionalProperties["explain"] = b.additionalExplainField(class)
 	if replicationEnabled(class) {
 		additionalProperties["isConsistent"] = b.isConsistentField()
 	}
@@ -233,6 +240,43 @@ func (b *classBuilder) additionalExplainScoreField() *graphql.Field {
 	}
 }
 
+// additionalRawScoreField is the score of the keyword or vector search
+// of a hybrid search before the results were fused
+func (b *classBuilder) additionalRawScoreField() *graphql.Field {
+	return &graphql.Field{
+		Type: graphql.Float,
+	}
+}
+
+func (b *classBuilder) additionalHighlightsField(class *models.Class) *graphql.Field {
+	return &graphql.Field{
+		Type: graphql.NewList(graphql.NewObject(graphql.ObjectConfig{
+			Name: fmt.Sprintf("%sAdditionalHighlights", class.Class),
+			Fields: graphql.Fields{
+				"property":  &graphql.Field{Type: graphql.String},
+				"fragments": &graphql.Field{Type: graphql.NewList(graphql.String)},
+			},
+		})),
+	}
+}
+
+// additionalExplainField is the breakdown of the stages of the query, see
+// explain.Stage
+func (b *classBuilder) additionalExplainField(class *models.Class) *graphql.Field {
+	return &graphql.Field{
+		Description: descriptions.Explain,
+		Type: graphql.NewList(graphql.NewObject(graphql.ObjectConfig{
+			Name: fmt.Sprintf("%sAdditionalExplain", class.Class),
+			Fields: graphql.Fields{
+				"stage":       &graphql.Field{Type: graphql.String},
+				"tookMs":      &graphql.Field{Type: graphql.Float},
+				"cardinality": &graphql.Field{Type: graphql.Int},
+				"runs":        &graphql.Field{Type: graphql.Int},
+			},
+		})),
+	}
+}
+
 func (b *classBuilder) additionalLastUpdateTimeUnix() *graphql.Field {
 	return &graphql.Field{
 		Type: graphql.String,
@@ -13,9 +13,11 @@ package get
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/tailor-inc/graphql"
 	"github.com/tailor-inc/graphql/language/ast"
@@ -66,6 +68,24 @@ func (b *classBuilder) primitiveField(propertyType schema.PropertyDataType,
 			Name:        property.Name,
 			Type:        graphql.String, // String since no graphql date datatype exists
 		}
+	case schema.DataTypeDuration:
+		return &graphql.Field{
+			Description: property.Description,
+			Name:        property.Name,
+			Type:        graphql.String, // Always return duration as string representation to the user
+		}
+	case schema.DataTypeIP:
+		return &graphql.Field{
+			Description: property.Description,
+			Name:        property.Name,
+			Type:        graphql.String, // Always return ip as string representation to the user
+		}
+	case schema.DataTypeDecimal:
+		return &graphql.Field{
+			Description: property.Description,
+			Name:        property.Name,
+			Type:        graphql.String, // String since a graphql Float would lose precision
+		}
 	case schema.DataTypeGeoCoordinates:
 		obj := newGeoCoordinatesObject(className, property.Name)
 
@@ -75,6 +95,24 @@ func (b *classBuilder) primitiveField(propertyType schema.PropertyDataType,
 			Type:        obj,
 			Resolve:     resolveGeoCoordinates,
 		}
+	case schema.DataTypeGeoPolygon, schema.DataTypeGeoShape:
+		obj := newGeoShapeObject(className, property.Name, propertyType.AsPrimitive())
+
+		return &graphql.Field{
+			Description: property.Description,
+			Name:        property.Name,
+			Type:        obj,
+			Resolve:     resolveGeoShape,
+		}
+	case schema.DataTypeSparseVector:
+		obj := newSparseVectorObject(className, property.Name)
+
+		return &graphql.Field{
+			Description: property.Description,
+			Name:        property.Name,
+			Type:        obj,
+			Resolve:     resolveSparseVector,
+		}
 	case schema.DataTypePhoneNumber:
 		obj := newPhoneNumberObject(className, property.Name)
 
@@ -132,12 +170,64 @@ func (b *classBuilder) primitiveField(propertyType schema.PropertyDataType,
 			Name:        property.Name,
 			Type:        graphql.String, // Always return UUID as string representation to the user
 		}
+	case schema.DataTypeObject:
+		return &graphql.Field{
+			Description: property.Description,
+			Name:        property.Name,
+			Type:        b.nestedObject(className+schema.UppercaseClassName(property.Name), property.NestedProperties),
+		}
+	case schema.DataTypeObjectArray:
+		return &graphql.Field{
+			Description: property.Description,
+			Name:        property.Name,
+			Type:        graphql.NewList(b.nestedObject(className+schema.UppercaseClassName(property.Name), property.NestedProperties)),
+		}
+	case schema.DataTypeVector:
+		return &graphql.Field{
+			Description: property.Description,
+			Name:        property.Name,
+			Type:        graphql.NewList(graphql.Float),
+		}
+	case schema.DataTypeVectorArray:
+		return &graphql.Field{
+			Description: property.Description,
+			Name:        property.Name,
+			Type:        graphql.NewList(graphql.NewList(graphql.Float)),
+		}
 	default:
 		panic(fmt.Sprintf("buildGetClass: unknown primitive type for %s.%s; %s",
 			className, property.Name, propertyType.AsPrimitive()))
 	}
 }
 
+// nestedObject builds a graphql object for the nested properties of an
+// object or object[] property. The name needs to be unique across the whole
+// graphql schema, so it is derived from the class and the full property path
+func (b *classBuilder) nestedObject(name string, nestedProps []*models.NestedProperty) *graphql.Object {
+	fields := graphql.Fields{}
+	for _, nestedProp := range nestedProps {
+		dataType, ok := schema.AsPrimitive(nestedProp.DataType)
+		if !ok || dataType == "" {
+			continue
+		}
+
+		property := &models.Property{
+			Name:             nestedProp.Name,
+			Description:      nestedProp.Description,
+			DataType:         nestedProp.DataType,
+			NestedProperties: nestedProp.NestedProperties,
+		}
+		fields[nestedProp.Name] = b.primitiveField(schema.NewPrimitivePropertyDataType(dataType),
+			property, name)
+	}
+
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name:        fmt.Sprintf("%sObject", name),
+		Fields:      fields,
+		Description: "Nested object of " + name,
+	})
+}
+
 func newGeoCoordinatesObject(className string, propertyName string) *graphql.Object {
 	return graphql.NewObject(graphql.ObjectConfig{
 		Description: "GeoCoordinates as latitude and longitude in decimal form",
@@ -157,6 +247,63 @@ func newGeoCoordinatesObject(className string, propertyName string) *graphql.Obj
 	})
 }
 
+func newGeoShapeObject(className string, propertyName string, dataType schema.DataType) *graphql.Object {
+	fields := graphql.Fields{
+		"coordinates": &graphql.Field{
+			Name:        "Coordinates",
+			Description: "The coordinates of the points making up the shape.",
+			Type: graphql.NewList(graphql.NewObject(graphql.ObjectConfig{
+				Description: "GeoCoordinates as latitude and longitude in decimal form",
+				Name:        fmt.Sprintf("%s%sGeoShapeCoordinatesObj", className, propertyName),
+				Fields: graphql.Fields{
+					"latitude": &graphql.Field{
+						Name:        "Latitude",
+						Description: "The Latitude of the point in decimal form.",
+						Type:        graphql.Float,
+					},
+					"longitude": &graphql.Field{
+						Name:        "Longitude",
+						Description: "The Longitude of the point in decimal form.",
+						Type:        graphql.Float,
+					},
+				},
+			})),
+		},
+	}
+	if dataType == schema.DataTypeGeoShape {
+		fields["type"] = &graphql.Field{
+			Name:        "Type",
+			Description: "The type of the shape, i.e. point, lineString or polygon.",
+			Type:        graphql.String,
+		}
+	}
+
+	return graphql.NewObject(graphql.ObjectConfig{
+		Description: "A point, line string or polygon on earth",
+		Name:        fmt.Sprintf("%s%sGeoShapeObj", className, propertyName),
+		Fields:      fields,
+	})
+}
+
+func newSparseVectorObject(className string, propertyName string) *graphql.Object {
+	return graphql.NewObject(graphql.ObjectConfig{
+		Description: "A sparse vector, given by its non-zero dimensions",
+		Name:        fmt.Sprintf("%s%sSparseVectorObj", className, propertyName),
+		Fields: graphql.Fields{
+			"indices": &graphql.Field{
+				Name:        "Indices",
+				Description: "The indices of the non-zero dimensions",
+				Type:        graphql.NewList(graphql.Int),
+			},
+			"values": &graphql.Field{
+				Name:        "Values",
+				Description: "The values of the non-zero dimensions",
+				Type:        graphql.NewList(graphql.Float),
+			},
+		},
+	})
+}
+
 func newPhoneNumberObject(className string, propertyName string) *graphql.Object {
 	return graphql.NewObject(graphql.ObjectConfig{
 		Description: "PhoneNumber in various parsed formats",
@@ -224,6 +371,18 @@ func buildGetClassField(classObject *graphql.Object,
 				Description: "Cut off number of results after the Nth extrema. Off by default, negative numbers mean off.",
 				Type:        graphql.Int,
 			},
+			"resolveReferences": &graphql.ArgumentConfig{
+				Description: descriptions.ResolveReferences,
+				Type:        graphql.Boolean,
+			},
+			"timeout": &graphql.ArgumentConfig{
+				Description: descriptions.QueryTimeout,
+				Type:        graphql.String,
+			},
+			"explain": &graphql.ArgumentConfig{
+				Description: descriptions.Explain,
+				Type:        graphql.Boolean,
+			},
 
 			"sort":       sortArgument(class.Class),
 			"nearVector": nearVectorArgument(class.Class),
@@ -236,6 +395,7 @@ func buildGetClassField(classObject *graphql.Object,
 	}
 
 	field.Args["bm25"] = bm25Argument(class.Class)
+	field.Args["nearSparseVector"] = nearSparseVectorArgument(class.Class)
 	field.Args["hybrid"] = hybridArgument(classObject, class, modulesProvider, fusionEnum)
 
 	if modulesProvider != nil {
@@ -272,6 +432,45 @@ func resolveGeoCoordinates(p graphql.ResolveParams) (interface{}, error) {
 	}, nil
 }
 
+func resolveGeoShape(p graphql.ResolveParams) (interface{}, error) {
+	switch field := p.Source.(map[string]interface{})[p.Info.FieldName].(type) {
+	case nil:
+		return nil, nil
+	case map[string]interface{}:
+		// geo shapes are stored as they are, like nested objects
+		return field, nil
+	case *models.GeoPolygon, *models.GeoShape:
+		b, err := json.Marshal(field)
+		if err != nil {
+			return nil, err
+		}
+		var shape map[string]interface{}
+		if err := json.Unmarshal(b, &shape); err != nil {
+			return nil, err
+		}
+		return shape, nil
+	default:
+		return nil, fmt.Errorf("expected a geo shape, but got: %T", field)
+	}
+}
+
+func resolveSparseVector(p graphql.ResolveParams) (interface{}, error) {
+	switch field := p.Source.(map[string]interface{})[p.Info.FieldName].(type) {
+	case nil:
+		return nil, nil
+	case map[string]interface{}:
+		// sparse vectors are stored as they are, like nested objects
+		return field, nil
+	case *models.SparseVector:
+		return map[string]interface{}{
+			"indices": field.Indices,
+			"values":  field.Values,
+		}, nil
+	default:
+		return nil, fmt.Errorf("expected a sparse vector, but got: %T", field)
+	}
+}
+
 func resolvePhoneNumber(p graphql.ResolveParams) (interface{}, error) {
 	field := p.Source.(map[string]interface{})[p.Info.FieldName]
 	if field == nil {
@@ -359,6 +558,23 @@ func (r *resolver) resolveGet(p graphql.ResolveParams, className string) (interf
 		return nil, err
 	}
 
+	if resolveRefs, ok := p.Args["resolveReferences"]; ok && !resolveRefs.(bool) {
+		addlProps.NoRefResolution = true
+	}
+
+	var timeout time.Duration
+	if timeoutArg, ok := p.Args["timeout"]; ok {
+		timeout, err = time.ParseDuration(timeoutArg.(string))
+		if err != nil {
+			return nil, fmt.Errorf("invalid timeout: %w", err)
+		}
+		if timeout <= 0 {
+			return nil, fmt.Errorf("invalid timeout: must be positive, got %s", timeout)
+		}
+	}
+
+	explain, _ := p.Args["explain"].(bool)
+
 	var sort []filters.Sort
 	if sortArg, ok := p.Args["sort"]; ok {
 		sort = filters.ExtractSortFromArgs(sortArg.([]interface{}))
@@ -398,13 +614,27 @@ func (r *resolver) resolveGet(p graphql.ResolveParams, className string) (interf
 	// extracts bm25 (sparseSearch) from the query
 	var keywordRankingParams *searchparams.KeywordRanking
 	if bm25, ok := p.Args["bm25"]; ok {
-		if len(sort) > 0 {
-			return nil, fmt.Errorf("bm25 search is not compatible with sort")
-		}
 		p := common_filters.ExtractBM25(bm25.(map[string]interface{}), addlProps.ExplainScore)
+		p.Highlight = highlightParams(p.Highlight, addlProps)
 		keywordRankingParams = &p
 	}
 
+	// a search of a sparse vector index is a keyword ranking of its own
+	if nearSparseVector, ok := p.Args["nearSparseVector"]; ok {
+		if keywordRankingParams != nil {
+			return nil, fmt.Errorf("nearSparseVector search is not compatible with bm25")
+		}
+		p, err := common_filters.ExtractNearSparseVector(nearSparseVector.(map[string]interface{}))
+		if err != nil {
+			return nil, fmt.Errorf("failed to extract nearSparseVector params: %w", err)
+		}
+		keywordRankingParams = &searchparams.KeywordRanking{
+			Type:         "sparseVector",
+			Properties:   []string{p.Property},
+			SparseVector: p,
+		}
+	}
+
 	// Extract hybrid search params from the processed query
 	// Everything hybrid can go in another namespace AFTER modulesprovider is
 	// refactored
@@ -417,6 +647,7 @@ func (r *resolver) resolveGet(p graphql.ResolveParams, className string) (interf
 		if err != nil {
 			return nil, fmt.Errorf("failed to extract hybrid params: %w", err)
 		}
+		p.Highlight = highlightParams(p.Highlight, addlProps)
 		hybridParams = p
 	}
 
@@ -457,6 +688,8 @@ func (r *resolver) resolveGet(p graphql.ResolveParams, className string) (interf
 		ReplicationProperties: replProps,
 		GroupBy:               groupByParams,
 		Tenant:                tenant,
+		Timeout:               timeout,
+		Explain:               explain,
 	}
 
 	// need to perform vector search by distance
@@ -556,6 +789,41 @@ func isPrimitive(selectionSet *ast.SelectionSet) bool {
 	return false
 }
 
+// isNestedObjectSelection checks whether a selection set selects fields of
+// a nested object property. Reference props can only be selected using
+// fragments, so any regular sub-field (other than __typename) indicates a
+// nested object
+func isNestedObjectSelection(selectionSet *ast.SelectionSet) bool {
+	if selectionSet == nil {
+		return false
+	}
+
+	for _, subSelection := range selectionSet.Selections {
+		if subsectionField, ok := subSelection.(*ast.Field); ok {
+			if subsectionField.Name.Value != "__typename" {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// highlightParams returns the highlight params of a keyword search if the
+// highlights are selected, the defaults of the searcher apply if they were
+// not configured
+func highlightParams(highlight *searchparams.Highlight,
+	addlProps additional.Properties,
+) *searchparams.Highlight {
+	if !addlProps.Highlights {
+		return nil
+	}
+	if highlight == nil {
+		return &searchparams.Highlight{}
+	}
+	return highlight
+}
+
 type additionalCheck struct {
 	modulesProvider ModulesProvider
 }
@@ -565,7 +833,8 @@ func (ac *additionalCheck) isAdditional(name string) bool {
 		name == "distance" || name == "id" || name == "vector" ||
 		name == "creationTimeUnix" || name == "lastUpdateTimeUnix" ||
 		name == "score" || name == "explainScore" || name == "isConsistent" ||
-		name == "group" {
+		name == "group" || name == "highlights" || name == "explain" ||
+		name == "keywordScore" || name == "vectorScore" {
 		return true
 	}
 	if ac.isModuleAdditional(name) {
@@ -614,7 +883,8 @@ func extractProperties(className string, selections *ast.SelectionSet,
 		name := field.Name.Value
 		property := search.SelectProperty{Name: name}
 
-		property.IsPrimitive = isPrimitive(field.SelectionSet)
+		property.IsPrimitive = isPrimitive(field.SelectionSet) ||
+			(name != "_additional" && isNestedObjectSelection(field.SelectionSet))
 		if !property.IsPrimitive {
 			// We can interpret this property in different ways
 			for _, subSelection := range field.SelectionSet.Selections {
@@ -666,6 +936,14 @@ func extractProperties(className string, selections *ast.SelectionSet,
 							additionalProps.IsConsistent = true
 							continue
 						}
+						if additionalProperty == "highlights" {
+							additionalProps.Highlights = true
+							continue
+						}
+						if additionalProperty == "explain" {
+							// only returned if the query is run with explain: true
+							continue
+						}
 						if additionalProperty == "group" {
 							additionalProps.Group = true
 							additionalGroupHitProperties, err := extractGroupHitProperties(className, additionalProps, subSelection, fragments, modulesProvider)
@@ -691,6 +969,9 @@ func extractProperties(className string, selections *ast.SelectionSet,
 					if err != nil {
 						return nil, additionalProps, err
 					}
+					if err := validateRefAdditionalProperties(ref); err != nil {
+						return nil, additionalProps, err
+					}
 
 					property.Refs = append(property.Refs, ref)
 
@@ -699,6 +980,9 @@ func extractProperties(className string, selections *ast.SelectionSet,
 					if err != nil {
 						return nil, additionalProps, err
 					}
+					if err := validateRefAdditionalProperties(ref); err != nil {
+						return nil, additionalProps, err
+					}
 
 					property.Refs = append(property.Refs, ref)
 
@@ -828,6 +1112,21 @@ func extractFragmentSpread(class string, spread *ast.FragmentSpread,
 	return result, nil
 }
 
+// Cross-references are looked up by id, so only the _additional fields stored
+// with the referenced object itself can be projected on them. Anything else
+// would silently be returned as null.
+func validateRefAdditionalProperties(ref search.SelectClass) error {
+	addl := ref.AdditionalProperties
+	if addl.Classification || addl.Certainty || addl.Distance || addl.Score ||
+		addl.ExplainScore || addl.IsConsistent || addl.Group || addl.Highlights ||
+		len(addl.ModuleParams) > 0 {
+		return fmt.Errorf("cross-reference to %s: only id, vector, creationTimeUnix "+
+			"and lastUpdateTimeUnix can be selected in _additional", ref.ClassName)
+	}
+
+	return nil
+}
+
 // It seems there's no proper way to extract this info unfortunately:
 // https://github.com/tailor-inc/graphql/issues/455
 func hackyWorkaroundToExtractClassName(def ast.Definition, name string) (string, error) {
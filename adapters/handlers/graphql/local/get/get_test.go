@@ -23,9 +23,11 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/tailor-inc/graphql/language/ast"
+	"github.com/weaviate/weaviate/adapters/handlers/graphql/local/common_filters"
 	test_helper "github.com/weaviate/weaviate/adapters/handlers/graphql/test/helper"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/explain"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/search"
@@ -1015,6 +1017,48 @@ func TestNearCustomTextRanker(t *testing.T) {
 		resolver.AssertResolve(t, query)
 	})
 
+	t.Run("for things with rescore overrides set", func(t *testing.T) {
+		query := `{ Get { SomeThing(nearVector: {
+								vector: [0.123, 0.984]
+								rescoreLimit: 100
+								oversampling: 2.5
+							}) { intField } } }`
+
+		expectedParams := dto.GetParams{
+			ClassName:  "SomeThing",
+			Properties: []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+			NearVector: &searchparams.NearVector{
+				Vector:       []float32{0.123, 0.984},
+				RescoreLimit: 100,
+				Oversampling: 2.5,
+			},
+		}
+		resolver.On("GetClass", expectedParams).
+			Return([]interface{}{}, nil).Once()
+
+		resolver.AssertResolve(t, query)
+	})
+
+	t.Run("for things with an exact search", func(t *testing.T) {
+		query := `{ Get { SomeThing(nearVector: {
+								vector: [0.123, 0.984]
+								exact: true
+							}) { intField } } }`
+
+		expectedParams := dto.GetParams{
+			ClassName:  "SomeThing",
+			Properties: []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+			NearVector: &searchparams.NearVector{
+				Vector: []float32{0.123, 0.984},
+				Exact:  true,
+			},
+		}
+		resolver.On("GetClass", expectedParams).
+			Return([]interface{}{}, nil).Once()
+
+		resolver.AssertResolve(t, query)
+	})
+
 	t.Run("for things with optional distance and limit set", func(t *testing.T) {
 		query := `{ Get { SomeThing(
 							limit: 6
@@ -1583,6 +1627,107 @@ func TestGetRelation(t *testing.T) {
 			{ Get { SomeAction { hasAction { ...actionFragment } } } }`
 		resolver.AssertResolve(t, query)
 	})
+
+	t.Run("without resolving references", func(t *testing.T) {
+		resolver := newMockResolver()
+
+		expectedParams := dto.GetParams{
+			ClassName: "SomeAction",
+			Properties: []search.SelectProperty{
+				{
+					Name:        "hasAction",
+					IsPrimitive: false,
+					Refs: []search.SelectClass{
+						{
+							ClassName:            "SomeAction",
+							AdditionalProperties: additional.Properties{ID: true},
+						},
+					},
+				},
+			},
+			AdditionalProperties: additional.Properties{NoRefResolution: true},
+		}
+
+		resolver.On("GetClass", expectedParams).
+			Return(test_helper.EmptyList(), nil).Once()
+
+		query := "{ Get { SomeAction(resolveReferences: false) { hasAction { ... on SomeAction { _additional { id } } } } } }"
+		resolver.AssertResolve(t, query)
+	})
+
+	t.Run("with unsupported _additional fields on a reference", func(t *testing.T) {
+		resolver := newMockResolver()
+
+		query := "{ Get { SomeAction { hasAction { ... on SomeAction { _additional { distance } } } } } }"
+		resolver.AssertFailToResolve(t, query, "cross-reference to SomeAction: only id, vector, "+
+			"creationTimeUnix and lastUpdateTimeUnix can be selected in _additional")
+	})
+}
+
+func TestGetWithTimeout(t *testing.T) {
+	t.Parallel()
+
+	t.Run("with a valid timeout", func(t *testing.T) {
+		resolver := newMockResolver()
+
+		expectedParams := dto.GetParams{
+			ClassName:  "SomeAction",
+			Properties: []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+			Timeout:    1500 * time.Millisecond,
+		}
+
+		resolver.On("GetClass", expectedParams).
+			Return(test_helper.EmptyList(), nil).Once()
+
+		query := `{ Get { SomeAction(timeout: "1.5s") { intField } } }`
+		resolver.AssertResolve(t, query)
+	})
+
+	t.Run("with an unparsable timeout", func(t *testing.T) {
+		resolver := newMockResolver()
+
+		query := `{ Get { SomeAction(timeout: "soon") { intField } } }`
+		resolver.AssertFailToResolve(t, query,
+			`invalid timeout: time: invalid duration "soon"`)
+	})
+
+	t.Run("with a negative timeout", func(t *testing.T) {
+		resolver := newMockResolver()
+
+		query := `{ Get { SomeAction(timeout: "-1s") { intField } } }`
+		resolver.AssertFailToResolve(t, query, "invalid timeout: must be positive, got -1s")
+	})
+}
+
+func TestGetWithExplain(t *testing.T) {
+	t.Parallel()
+	resolver := newMockResolver()
+
+	expectedParams := dto.GetParams{
+		ClassName:  "SomeAction",
+		Properties: []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+		Explain:    true,
+	}
+
+	resolver.On("GetClass", expectedParams).
+		Return([]interface{}{
+			map[string]interface{}{
+				"intField": 7,
+				"_additional": map[string]interface{}{
+					"explain": []explain.Stage{
+						{Name: explain.StageVectorSearch, TookMs: 2, Cardinality: 10, Runs: 1},
+					},
+				},
+			},
+		}, nil).Once()
+
+	query := `{ Get { SomeAction(explain: true) { intField _additional { explain { stage cardinality runs } } } } }`
+	result := resolver.AssertResolve(t, query)
+
+	stages := result.Get("Get", "SomeAction").Result.([]interface{})[0].(map[string]interface{})["_additional"].(map[string]interface{})["explain"]
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"stage": "vectorSearch", "cardinality": 10, "runs": 1},
+	}, stages)
 }
 
 func TestNearObject(t *testing.T) {
@@ -1610,6 +1755,28 @@ func TestNearObject(t *testing.T) {
 		resolver.AssertResolve(t, query)
 	})
 
+	t.Run("for objects with beacon and target vector", func(t *testing.T) {
+		query := `{ Get { SomeAction(
+								nearObject: {
+									beacon: "weaviate://localhost/some-uuid"
+									targetVector: "title"
+								}) { intField } } }`
+
+		expectedParams := dto.GetParams{
+			ClassName:  "SomeAction",
+			Properties: []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+			NearObject: &searchparams.NearObject{
+				Beacon:       "weaviate://localhost/some-uuid",
+				TargetVector: "title",
+			},
+		}
+
+		resolver.On("GetClass", expectedParams).
+			Return([]interface{}{}, nil).Once()
+
+		resolver.AssertResolve(t, query)
+	})
+
 	t.Run("for objects with beacon and optional distance set", func(t *testing.T) {
 		query := `{ Get { SomeThing(
 								nearObject: {
@@ -1848,8 +2015,22 @@ func TestNearTextNoNoModules(t *testing.T) {
 func TestBM25WithSort(t *testing.T) {
 	t.Parallel()
 	resolver := newMockResolverWithNoModules()
-	query := `{Get{SomeAction(bm25:{query:"apple",properties:["name"]},sort:[{path:["name"],order:desc}]){intField}}}`
-	resolver.AssertFailToResolve(t, query, "bm25 search is not compatible with sort")
+	query := `{Get{SomeAction(bm25:{query:"apple",properties:["name"]},sort:[{path:["_additional","score"],order:asc}]){intField}}}`
+
+	expectedParams := dto.GetParams{
+		ClassName:  "SomeAction",
+		Properties: []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+		KeywordRanking: &searchparams.KeywordRanking{
+			Query:      "apple",
+			Type:       "bm25",
+			Properties: []string{"name"},
+		},
+		Sort: []filters.Sort{{Path: []string{"_additional", "score"}, Order: "asc"}},
+	}
+	resolver.On("GetClass", expectedParams).
+		Return([]interface{}{}, nil).Once()
+
+	resolver.AssertResolve(t, query)
 }
 
 func TestHybridWithSort(t *testing.T) {
@@ -1859,6 +2040,168 @@ func TestHybridWithSort(t *testing.T) {
 	resolver.AssertFailToResolve(t, query, "hybrid search is not compatible with sort")
 }
 
+func TestHybridExact(t *testing.T) {
+	t.Parallel()
+	resolver := newMockResolverWithNoModules()
+	query := `{Get{SomeAction(hybrid:{query:"apple", exact: true}){intField}}}`
+
+	expectedParams := dto.GetParams{
+		ClassName:  "SomeAction",
+		Properties: []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+		HybridSearch: &searchparams.HybridSearch{
+			SubSearches:     []searchparams.WeightedSearchResult(nil),
+			Query:           "apple",
+			Alpha:           common_filters.DefaultAlpha,
+			FusionAlgorithm: common_filters.HybridRankedFusion,
+			Type:            "hybrid",
+			Exact:           true,
+		},
+	}
+	resolver.On("GetClass", expectedParams).
+		Return([]interface{}{}, nil).Once()
+
+	resolver.AssertResolve(t, query)
+}
+
+func TestHybridFusion(t *testing.T) {
+	t.Parallel()
+	resolver := newMockResolverWithNoModules()
+
+	t.Run("z-score fusion", func(t *testing.T) {
+		query := `{Get{SomeAction(hybrid:{query:"apple", fusionType: zScoreFusion}){intField _additional{keywordScore vectorScore}}}}`
+
+		expectedParams := dto.GetParams{
+			ClassName:  "SomeAction",
+			Properties: []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+			HybridSearch: &searchparams.HybridSearch{
+				SubSearches:     []searchparams.WeightedSearchResult(nil),
+				Query:           "apple",
+				Alpha:           common_filters.DefaultAlpha,
+				FusionAlgorithm: common_filters.HybridZScoreFusion,
+				Type:            "hybrid",
+			},
+		}
+		resolver.On("GetClass", expectedParams).
+			Return([]interface{}{}, nil).Once()
+
+		resolver.AssertResolve(t, query)
+	})
+
+	t.Run("ranked fusion with rank constant", func(t *testing.T) {
+		query := `{Get{SomeAction(hybrid:{query:"apple", fusionType: rankedFusion, rankConstant: 20}){intField}}}`
+
+		expectedParams := dto.GetParams{
+			ClassName:  "SomeAction",
+			Properties: []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+			HybridSearch: &searchparams.HybridSearch{
+				SubSearches:     []searchparams.WeightedSearchResult(nil),
+				Query:           "apple",
+				Alpha:           common_filters.DefaultAlpha,
+				FusionAlgorithm: common_filters.HybridRankedFusion,
+				RankConstant:    20,
+				Type:            "hybrid",
+			},
+		}
+		resolver.On("GetClass", expectedParams).
+			Return([]interface{}{}, nil).Once()
+
+		resolver.AssertResolve(t, query)
+	})
+
+	t.Run("invalid rank constant", func(t *testing.T) {
+		query := `{Get{SomeAction(hybrid:{query:"apple", rankConstant: 0}){intField}}}`
+		resolver.AssertFailToResolve(t, query, "failed to extract hybrid params: rankConstant should be greater than 0")
+	})
+}
+
+func TestHybridDistinctQueries(t *testing.T) {
+	t.Parallel()
+	resolver := newMockResolverWithNoModules()
+	query := `{Get{SomeAction(hybrid:{query:"apple", keywordQuery:"red apple", vectorQuery:"fruit"}){intField}}}`
+
+	expectedParams := dto.GetParams{
+		ClassName:  "SomeAction",
+		Properties: []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+		HybridSearch: &searchparams.HybridSearch{
+			SubSearches:     []searchparams.WeightedSearchResult(nil),
+			Query:           "apple",
+			KeywordQuery:    "red apple",
+			VectorQuery:     "fruit",
+			Alpha:           common_filters.DefaultAlpha,
+			FusionAlgorithm: common_filters.HybridRankedFusion,
+			Type:            "hybrid",
+		},
+	}
+	resolver.On("GetClass", expectedParams).
+		Return([]interface{}{}, nil).Once()
+
+	resolver.AssertResolve(t, query)
+}
+
+func TestBM25Highlights(t *testing.T) {
+	t.Parallel()
+	resolver := newMockResolverWithNoModules()
+
+	t.Run("with defaults", func(t *testing.T) {
+		query := `{Get{SomeAction(bm25:{query:"apple"}){intField _additional{highlights{property fragments}}}}}`
+
+		expectedParams := dto.GetParams{
+			ClassName:            "SomeAction",
+			Properties:           []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+			AdditionalProperties: additional.Properties{Highlights: true},
+			KeywordRanking: &searchparams.KeywordRanking{
+				Query:     "apple",
+				Type:      "bm25",
+				Highlight: &searchparams.Highlight{},
+			},
+		}
+		resolver.On("GetClass", expectedParams).
+			Return([]interface{}{}, nil).Once()
+
+		resolver.AssertResolve(t, query)
+	})
+
+	t.Run("configured", func(t *testing.T) {
+		query := `{Get{SomeAction(bm25:{query:"apple", highlight:{fragmentSize: 50, preTag: "<b>", postTag: "</b>"}})
+			{intField _additional{highlights{property fragments}}}}}`
+
+		expectedParams := dto.GetParams{
+			ClassName:            "SomeAction",
+			Properties:           []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+			AdditionalProperties: additional.Properties{Highlights: true},
+			KeywordRanking: &searchparams.KeywordRanking{
+				Query:     "apple",
+				Type:      "bm25",
+				Highlight: &searchparams.Highlight{FragmentSize: 50, PreTag: "<b>", PostTag: "</b>"},
+			},
+		}
+		resolver.On("GetClass", expectedParams).
+			Return([]interface{}{}, nil).Once()
+
+		resolver.AssertResolve(t, query)
+	})
+
+	t.Run("not selected", func(t *testing.T) {
+		query := `{Get{SomeAction(hybrid:{query:"apple", highlight:{fragmentSize: 50}}){intField}}}`
+
+		expectedParams := dto.GetParams{
+			ClassName:  "SomeAction",
+			Properties: []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+			HybridSearch: &searchparams.HybridSearch{
+				SubSearches:     []searchparams.WeightedSearchResult(nil),
+				Query:           "apple",
+				Alpha:           common_filters.DefaultAlpha,
+				FusionAlgorithm: common_filters.HybridRankedFusion,
+				Type:            "hybrid",
+			},
+		}
+		resolver.On("GetClass", expectedParams).
+			Return([]interface{}{}, nil).Once()
+
+		resolver.AssertResolve(t, query)
+	})
+}
+
 func TestNearObjectNoModules(t *testing.T) {
 	t.Parallel()
 
@@ -2220,6 +2563,23 @@ func TestSort(t *testing.T) {
 				tt.resolver.AssertResolve(t, query)
 			})
 
+			t.Run("sort by expression", func(t *testing.T) {
+				query := `{ Get { SomeAction(sort:[{
+										expression: "price * (1 - discount)" order: desc
+									}]) { intField } } }`
+
+				expectedParams := dto.GetParams{
+					ClassName:  "SomeAction",
+					Properties: []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+					Sort:       []filters.Sort{{Expression: "price * (1 - discount)", Order: "desc"}},
+				}
+
+				tt.resolver.On("GetClass", expectedParams).
+					Return([]interface{}{}, nil).Once()
+
+				tt.resolver.AssertResolve(t, query)
+			})
+
 			t.Run("simple sort with two sort filters", func(t *testing.T) {
 				query := `{ Get { SomeAction(sort:[{
 										path: ["first1", "first2", "first3", "first4"] order: asc
@@ -2298,3 +2658,67 @@ func timeMust(t strfmt.DateTime, err error) strfmt.DateTime {
 
 	return t
 }
+
+func TestNearSparseVector(t *testing.T) {
+	t.Parallel()
+	resolver := newMockResolverWithNoModules()
+
+	t.Run("search", func(t *testing.T) {
+		query := `{Get{SomeAction(nearSparseVector:{property:"terms", indices:[4, 2048], values:[0.5, 1.25]}){intField}}}`
+
+		nearSparseVector := &searchparams.NearSparseVector{
+			Property: "terms",
+			Indices:  []int64{4, 2048},
+			Values:   []float32{0.5, 1.25},
+		}
+		expectedParams := dto.GetParams{
+			ClassName:  "SomeAction",
+			Properties: []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+			KeywordRanking: &searchparams.KeywordRanking{
+				Type:         "sparseVector",
+				Properties:   []string{"terms"},
+				SparseVector: nearSparseVector,
+			},
+		}
+		resolver.On("GetClass", expectedParams).
+			Return([]interface{}{}, nil).Once()
+
+		resolver.AssertResolve(t, query)
+	})
+
+	t.Run("in hybrid", func(t *testing.T) {
+		query := `{Get{SomeAction(hybrid:{query:"apple", sparseVector:{property:"terms", indices:[4], values:[0.5]}}){intField}}}`
+
+		expectedParams := dto.GetParams{
+			ClassName:  "SomeAction",
+			Properties: []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+			HybridSearch: &searchparams.HybridSearch{
+				SubSearches:     []searchparams.WeightedSearchResult(nil),
+				Query:           "apple",
+				Alpha:           common_filters.DefaultAlpha,
+				FusionAlgorithm: common_filters.HybridRankedFusion,
+				Type:            "hybrid",
+				SparseVector: &searchparams.NearSparseVector{
+					Property: "terms",
+					Indices:  []int64{4},
+					Values:   []float32{0.5},
+				},
+			},
+		}
+		resolver.On("GetClass", expectedParams).
+			Return([]interface{}{}, nil).Once()
+
+		resolver.AssertResolve(t, query)
+	})
+
+	t.Run("with bm25", func(t *testing.T) {
+		query := `{Get{SomeAction(bm25:{query:"apple"}, nearSparseVector:{property:"terms", indices:[4], values:[0.5]}){intField}}}`
+		resolver.AssertFailToResolve(t, query, "nearSparseVector search is not compatible with bm25")
+	})
+
+	t.Run("invalid vector", func(t *testing.T) {
+		query := `{Get{SomeAction(nearSparseVector:{property:"terms", indices:[4, 4], values:[0.5, 1]}){intField}}}`
+		resolver.AssertFailToResolve(t, query,
+			"failed to extract nearSparseVector params: invalid sparse vector: duplicate index 4")
+	})
+}
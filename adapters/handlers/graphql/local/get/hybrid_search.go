@@ -17,6 +17,7 @@ import (
 
 	"github.com/tailor-inc/graphql"
 	"github.com/weaviate/weaviate/adapters/handlers/graphql/descriptions"
+	"github.com/weaviate/weaviate/adapters/handlers/graphql/local/common_filters"
 	"github.com/weaviate/weaviate/entities/models"
 )
 
@@ -48,6 +49,14 @@ func hybridOperands(classObject *graphql.Object,
 			Description: "Query string",
 			Type:        graphql.String,
 		},
+		"keywordQuery": &graphql.InputObjectFieldConfig{
+			Description: "Query string of the keyword search, defaults to the query",
+			Type:        graphql.String,
+		},
+		"vectorQuery": &graphql.InputObjectFieldConfig{
+			Description: "Query string which is vectorized for the vector search, defaults to the query",
+			Type:        graphql.String,
+		},
 		"alpha": &graphql.InputObjectFieldConfig{
 			Description: "Search weight",
 			Type:        graphql.Float,
@@ -64,6 +73,20 @@ func hybridOperands(classObject *graphql.Object,
 			Description: "Algorithm used for fusing results from vector and keyword search",
 			Type:        fusionEnum,
 		},
+		"rankConstant": &graphql.InputObjectFieldConfig{
+			Description: "The constant k of rankedFusion, every result contributes 1 / (rank + k + 1)",
+			Type:        graphql.Int,
+		},
+		"exact": &graphql.InputObjectFieldConfig{
+			Description: descriptions.Exact,
+			Type:        graphql.Boolean,
+		},
+		"highlight": highlightField(fmt.Sprintf("GetObjects%sHybridHighlightInpObj", class.Class)),
+		"sparseVector": &graphql.InputObjectFieldConfig{
+			Description: "Search the sparse vector index of a property instead of the keyword search",
+			Type: common_filters.NearSparseVectorInputObject(
+				fmt.Sprintf("GetObjects%sHybridSparseVectorInpObj", class.Class)),
+		},
 	}
 
 	if os.Getenv("ENABLE_EXPERIMENTAL_HYBRID_OPERANDS") != "" {
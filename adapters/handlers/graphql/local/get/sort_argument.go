@@ -49,5 +49,9 @@ func sortFields(prefix string) graphql.InputObjectConfigFieldMap {
 				},
 			}),
 		},
+		"expression": &graphql.InputObjectFieldConfig{
+			Description: descriptions.SortExpression,
+			Type:        graphql.String,
+		},
 	}
 }
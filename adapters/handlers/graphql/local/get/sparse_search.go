@@ -39,5 +39,37 @@ func bm25Fields(prefix string) graphql.InputObjectConfigFieldMap {
 			Description: "The properties to search in",
 			Type:        graphql.NewList(graphql.String),
 		},
+		"fuzzy": &graphql.InputObjectFieldConfig{
+			Description: "The edit distance within which terms match the terms of the query",
+			Type:        graphql.Int,
+		},
+		"highlight": highlightField(fmt.Sprintf("%sHighlightInpObj", prefix)),
+	}
+}
+
+// highlightField configures the fragments returned in the highlights
+// additional property
+func highlightField(name string) *graphql.InputObjectFieldConfig {
+	return &graphql.InputObjectFieldConfig{
+		Description: "Configure the highlighted fragments of the matching properties",
+		Type: graphql.NewInputObject(
+			graphql.InputObjectConfig{
+				Name: name,
+				Fields: graphql.InputObjectConfigFieldMap{
+					"fragmentSize": &graphql.InputObjectFieldConfig{
+						Description: "The maximum number of characters of a fragment",
+						Type:        graphql.Int,
+					},
+					"preTag": &graphql.InputObjectFieldConfig{
+						Description: "The tag inserted before a matching term",
+						Type:        graphql.String,
+					},
+					"postTag": &graphql.InputObjectFieldConfig{
+						Description: "The tag inserted after a matching term",
+						Type:        graphql.String,
+					},
+				},
+			},
+		),
 	}
 }
@@ -0,0 +1,133 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package grpc
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/schema"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol"
+	"google.golang.org/protobuf/proto"
+)
+
+// batchGetQuery fetches all objects of a batch read which share the same
+// selection with a single search.
+type batchGetQuery struct {
+	params dto.GetParams
+	// ids are the normalized uuids of the objects at the same positions in
+	// indexes
+	ids     []string
+	indexes []int
+}
+
+// batchGetQueriesFromProto groups the requested objects by their selection,
+// so that a batch read results in one search per distinct selection instead
+// of one per object.
+func batchGetQueriesFromProto(req *pb.BatchGetObjectsRequest, scheme schema.Schema) ([]*batchGetQuery, error) {
+	class, err := schema.GetClassByName(scheme.Objects, req.ClassName)
+	if err != nil {
+		return nil, err
+	}
+	replicationProperties := extractReplicationProperties(req.ConsistencyLevel)
+
+	var queries []*batchGetQuery
+	byKey := map[string]*batchGetQuery{}
+	for i, obj := range req.Objects {
+		id, err := uuid.Parse(obj.Uuid)
+		if err != nil {
+			return nil, fmt.Errorf("objects[%d]: invalid uuid %q: %w", i, obj.Uuid, err)
+		}
+
+		reqProps, reqAdditional := req.Properties, req.AdditionalProperties
+		if obj.Properties != nil {
+			reqProps = obj.Properties
+		}
+		if obj.AdditionalProperties != nil {
+			reqAdditional = obj.AdditionalProperties
+		}
+
+		key, err := selectionKey(reqProps, reqAdditional)
+		if err != nil {
+			return nil, fmt.Errorf("objects[%d]: %w", i, err)
+		}
+
+		query, ok := byKey[key]
+		if !ok {
+			props, addProps, err := extractSelection(reqProps, reqAdditional, scheme, class)
+			if err != nil {
+				return nil, fmt.Errorf("objects[%d]: %w", i, err)
+			}
+			// the id is needed to match the results to the requested objects
+			addProps.ID = true
+
+			query = &batchGetQuery{params: dto.GetParams{
+				ClassName:             req.ClassName,
+				Tenant:                req.Tenant,
+				ReplicationProperties: replicationProperties,
+				Properties:            props,
+				AdditionalProperties:  addProps,
+			}}
+			byKey[key] = query
+			queries = append(queries, query)
+		}
+		query.ids = append(query.ids, id.String())
+		query.indexes = append(query.indexes, i)
+	}
+
+	for _, query := range queries {
+		ids := uniqueStrings(query.ids)
+		query.params.Pagination = &filters.Pagination{Limit: len(ids)}
+		query.params.Filters = &filters.LocalFilter{Root: &filters.Clause{
+			Operator: filters.ContainsAny,
+			On: &filters.Path{
+				Class:    schema.ClassName(req.ClassName),
+				Property: filters.InternalPropID,
+			},
+			Value: &filters.Value{Type: schema.DataTypeText, Value: ids},
+		}}
+	}
+
+	return queries, nil
+}
+
+// selectionKey returns a key which is identical for equal selections
+func selectionKey(props *pb.Properties, addProps *pb.AdditionalProperties) (string, error) {
+	opts := proto.MarshalOptions{Deterministic: true}
+	propsBytes, err := opts.Marshal(props)
+	if err != nil {
+		return "", fmt.Errorf("marshal properties: %w", err)
+	}
+	addPropsBytes, err := opts.Marshal(addProps)
+	if err != nil {
+		return "", fmt.Errorf("marshal additional properties: %w", err)
+	}
+
+	// a nil selection is different from an empty one, as nothing selected at
+	// all returns all properties and metadata
+	return fmt.Sprintf("%t%t%x:%x", props == nil, addProps == nil, propsBytes, addPropsBytes), nil
+}
+
+func uniqueStrings(in []string) []string {
+	seen := make(map[string]struct{}, len(in))
+	out := make([]string, 0, len(in))
+	for _, s := range in {
+		if _, ok := seen[s]; ok {
+			continue
+		}
+		seen[s] = struct{}{}
+		out = append(out, s)
+	}
+	return out
+}
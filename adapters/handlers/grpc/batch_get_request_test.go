@@ -0,0 +1,155 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package grpc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol"
+)
+
+func TestGRPCBatchGetRequest(t *testing.T) {
+	classname := "TestClass"
+	scheme := schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{
+				{
+					Class: classname,
+					Properties: []*models.Property{
+						{Name: "name", DataType: schema.DataTypeText.PropString()},
+						{Name: "number", DataType: schema.DataTypeInt.PropString()},
+					},
+					VectorIndexConfig: hnsw.UserConfig{Distance: hnsw.DefaultDistanceMetric},
+				},
+			},
+		},
+	}
+
+	idFilter := func(ids ...string) *filters.LocalFilter {
+		return &filters.LocalFilter{Root: &filters.Clause{
+			Operator: filters.ContainsAny,
+			On:       &filters.Path{Class: schema.ClassName(classname), Property: filters.InternalPropID},
+			Value:    &filters.Value{Type: schema.DataTypeText, Value: ids},
+		}}
+	}
+	uuid5 := "f8f2b0a4-34de-4c57-9c1a-4b3d4a1b2c3d"
+	nameOnly := &pb.Properties{NonRefProperties: []string{"name"}}
+	vectorOnly := &pb.AdditionalProperties{Vector: true}
+
+	t.Run("objects with the same selection are read together", func(t *testing.T) {
+		req := &pb.BatchGetObjectsRequest{
+			ClassName:  classname,
+			Tenant:     "tenant",
+			Properties: nameOnly,
+			Objects:    []*pb.BatchGetObjectsRequest_Object{{Uuid: UUID3}, {Uuid: UUID4}},
+		}
+
+		queries, err := batchGetQueriesFromProto(req, scheme)
+		require.Nil(t, err)
+		require.Len(t, queries, 1)
+
+		query := queries[0]
+		assert.Equal(t, []string{UUID3, UUID4}, query.ids)
+		assert.Equal(t, []int{0, 1}, query.indexes)
+		assert.Equal(t, classname, query.params.ClassName)
+		assert.Equal(t, "tenant", query.params.Tenant)
+		assert.Equal(t, search.SelectProperties{{Name: "name", IsPrimitive: true}}, query.params.Properties)
+		assert.Equal(t, additional.Properties{ID: true}, query.params.AdditionalProperties)
+		assert.Equal(t, &filters.Pagination{Limit: 2}, query.params.Pagination)
+		assert.Equal(t, idFilter(UUID3, UUID4), query.params.Filters)
+	})
+
+	t.Run("per-object selection overrides the request selection", func(t *testing.T) {
+		req := &pb.BatchGetObjectsRequest{
+			ClassName:  classname,
+			Properties: nameOnly,
+			Objects: []*pb.BatchGetObjectsRequest_Object{
+				{Uuid: UUID3},
+				{Uuid: UUID4, AdditionalProperties: vectorOnly},
+				{Uuid: uuid5, Properties: &pb.Properties{NonRefProperties: []string{"name"}}},
+			},
+		}
+
+		queries, err := batchGetQueriesFromProto(req, scheme)
+		require.Nil(t, err)
+		require.Len(t, queries, 2)
+
+		assert.Equal(t, []int{0, 2}, queries[0].indexes)
+		assert.Equal(t, idFilter(UUID3, uuid5), queries[0].params.Filters)
+
+		assert.Equal(t, []int{1}, queries[1].indexes)
+		assert.Equal(t, search.SelectProperties{{Name: "name", IsPrimitive: true}}, queries[1].params.Properties)
+		assert.Equal(t, additional.Properties{ID: true, Vector: true}, queries[1].params.AdditionalProperties)
+		assert.Equal(t, idFilter(UUID4), queries[1].params.Filters)
+	})
+
+	t.Run("without selection all properties are returned", func(t *testing.T) {
+		req := &pb.BatchGetObjectsRequest{
+			ClassName: classname,
+			Objects:   []*pb.BatchGetObjectsRequest_Object{{Uuid: UUID3}},
+		}
+
+		queries, err := batchGetQueriesFromProto(req, scheme)
+		require.Nil(t, err)
+		require.Len(t, queries, 1)
+		assert.Equal(t, search.SelectProperties{
+			{Name: "name", IsPrimitive: true},
+			{Name: "number", IsPrimitive: true},
+		}, queries[0].params.Properties)
+	})
+
+	t.Run("duplicate and non-canonical uuids", func(t *testing.T) {
+		req := &pb.BatchGetObjectsRequest{
+			ClassName: classname,
+			Objects: []*pb.BatchGetObjectsRequest_Object{
+				{Uuid: UUID3},
+				{Uuid: "A4DE3CA0-6975-464F-B23B-ADDDD83630D7"},
+			},
+		}
+
+		queries, err := batchGetQueriesFromProto(req, scheme)
+		require.Nil(t, err)
+		require.Len(t, queries, 1)
+		assert.Equal(t, []string{UUID3, UUID3}, queries[0].ids)
+		assert.Equal(t, &filters.Pagination{Limit: 1}, queries[0].params.Pagination)
+		assert.Equal(t, idFilter(UUID3), queries[0].params.Filters)
+	})
+
+	t.Run("invalid uuid", func(t *testing.T) {
+		req := &pb.BatchGetObjectsRequest{
+			ClassName: classname,
+			Objects:   []*pb.BatchGetObjectsRequest_Object{{Uuid: UUID3}, {Uuid: "not-a-uuid"}},
+		}
+
+		_, err := batchGetQueriesFromProto(req, scheme)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "objects[1]: invalid uuid")
+	})
+
+	t.Run("unknown class", func(t *testing.T) {
+		req := &pb.BatchGetObjectsRequest{
+			ClassName: "Unknown",
+			Objects:   []*pb.BatchGetObjectsRequest_Object{{Uuid: UUID3}},
+		}
+
+		_, err := batchGetQueriesFromProto(req, scheme)
+		require.NotNil(t, err)
+	})
+}
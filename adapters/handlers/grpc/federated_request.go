@@ -0,0 +1,76 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package grpc
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/schema"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol"
+	"github.com/weaviate/weaviate/usecases/traverser"
+)
+
+// federatedSearchParamsFromProto parses every target like a search of its
+// own class, which shares the query, the metadata selection and the
+// consistency level with all other targets
+func federatedSearchParamsFromProto(req *pb.FederatedSearchRequest, scheme schema.Schema) (traverser.FederatedSearchParams, error) {
+	out := traverser.FederatedSearchParams{
+		Targets: make([]dto.GetParams, len(req.Targets)),
+		Limit:   int(req.Limit),
+	}
+
+	for i, target := range req.Targets {
+		params, err := searchParamsFromProto(&pb.SearchRequest{
+			ClassName:            target.ClassName,
+			Properties:           target.Properties,
+			Tenant:               target.Tenant,
+			Filters:              target.Filters,
+			NearVector:           req.NearVector,
+			HybridSearch:         req.HybridSearch,
+			AdditionalProperties: req.AdditionalProperties,
+			ConsistencyLevel:     req.ConsistencyLevel,
+		}, scheme)
+		if err != nil {
+			return traverser.FederatedSearchParams{}, fmt.Errorf("targets[%d]: %w", i, err)
+		}
+		out.Targets[i] = params
+	}
+
+	return out, nil
+}
+
+// federatedResultsToProto replies with the hits in the order of the federated
+// search. The score of every hit is its normalized score, the class it was
+// found in is the class name of its properties.
+func federatedResultsToProto(res []traverser.FederatedResult, start time.Time,
+	params traverser.FederatedSearchParams, scheme schema.Schema,
+) (*pb.SearchReply, error) {
+	out := &pb.SearchReply{
+		Results: make([]*pb.SearchResult, len(res)),
+	}
+
+	for i, hit := range res {
+		results, _, err := extractObjectsToResults([]interface{}{hit.Object}, params.Targets[hit.Target], scheme, false)
+		if err != nil {
+			return nil, err
+		}
+		result := results[0]
+		result.AdditionalProperties.Score = hit.Score
+		result.AdditionalProperties.ScorePresent = true
+		out.Results[i] = result
+	}
+
+	out.Took = float32(time.Since(start).Seconds())
+	return out, nil
+}
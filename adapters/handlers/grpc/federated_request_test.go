@@ -0,0 +1,127 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package grpc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol"
+	"github.com/weaviate/weaviate/usecases/traverser"
+)
+
+func TestGRPCFederatedSearch(t *testing.T) {
+	scheme := schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{
+				{
+					Class: "Article",
+					Properties: []*models.Property{
+						{Name: "title", DataType: schema.DataTypeText.PropString()},
+					},
+					VectorIndexConfig: hnsw.UserConfig{Distance: hnsw.DefaultDistanceMetric},
+				},
+				{
+					Class: "Paragraph",
+					Properties: []*models.Property{
+						{Name: "text", DataType: schema.DataTypeText.PropString()},
+					},
+					VectorIndexConfig: hnsw.UserConfig{Distance: hnsw.DefaultDistanceMetric},
+				},
+			},
+		},
+	}
+
+	req := &pb.FederatedSearchRequest{
+		Targets: []*pb.FederatedSearchRequest_Target{
+			{ClassName: "Article", Properties: &pb.Properties{NonRefProperties: []string{"title"}}},
+			{ClassName: "Paragraph", Properties: &pb.Properties{NonRefProperties: []string{"text"}}, Tenant: "tenant"},
+		},
+		Limit:                5,
+		NearVector:           &pb.NearVectorParams{Vector: []float32{1, 2, 3}},
+		AdditionalProperties: &pb.AdditionalProperties{Uuid: true},
+	}
+
+	params, err := federatedSearchParamsFromProto(req, scheme)
+	require.Nil(t, err)
+	assert.Equal(t, 5, params.Limit)
+	require.Len(t, params.Targets, 2)
+
+	assert.Equal(t, "Article", params.Targets[0].ClassName)
+	assert.Equal(t, search.SelectProperties{{Name: "title", IsPrimitive: true}}, params.Targets[0].Properties)
+	assert.Equal(t, &searchparams.NearVector{Vector: []float32{1, 2, 3}}, params.Targets[0].NearVector)
+	assert.Equal(t, additional.Properties{ID: true}, params.Targets[0].AdditionalProperties)
+
+	assert.Equal(t, "Paragraph", params.Targets[1].ClassName)
+	assert.Equal(t, "tenant", params.Targets[1].Tenant)
+	assert.Equal(t, search.SelectProperties{{Name: "text", IsPrimitive: true}}, params.Targets[1].Properties)
+	assert.Equal(t, &searchparams.NearVector{Vector: []float32{1, 2, 3}}, params.Targets[1].NearVector)
+	assert.Equal(t, &filters.Pagination{Limit: 10}, params.Targets[1].Pagination)
+
+	res := []traverser.FederatedResult{
+		{
+			Target:    1,
+			ClassName: "Paragraph",
+			Score:     1,
+			Object: map[string]interface{}{
+				"text":        "some text",
+				"id":          UUID1,
+				"_additional": map[string]interface{}{"distance": float32(0.1)},
+			},
+		},
+		{
+			Target:    0,
+			ClassName: "Article",
+			Score:     0.25,
+			Object: map[string]interface{}{
+				"title":       "a title",
+				"id":          UUID2,
+				"_additional": map[string]interface{}{"distance": float32(0.4)},
+			},
+		},
+	}
+
+	reply, err := federatedResultsToProto(res, time.Now(), params, scheme)
+	require.Nil(t, err)
+	require.Len(t, reply.Results, 2)
+
+	assert.Equal(t, "Paragraph", reply.Results[0].Properties.ClassName)
+	assert.Equal(t, "some text", reply.Results[0].Properties.NonRefProperties.AsMap()["text"])
+	assert.Equal(t, string(UUID1), reply.Results[0].AdditionalProperties.Id)
+	assert.Equal(t, float32(1), reply.Results[0].AdditionalProperties.Score)
+	assert.True(t, reply.Results[0].AdditionalProperties.ScorePresent)
+
+	assert.Equal(t, "Article", reply.Results[1].Properties.ClassName)
+	assert.Equal(t, "a title", reply.Results[1].Properties.NonRefProperties.AsMap()["title"])
+	assert.Equal(t, string(UUID2), reply.Results[1].AdditionalProperties.Id)
+	assert.Equal(t, float32(0.25), reply.Results[1].AdditionalProperties.Score)
+}
+
+func TestGRPCFederatedSearchUnknownClass(t *testing.T) {
+	req := &pb.FederatedSearchRequest{
+		Targets:    []*pb.FederatedSearchRequest_Target{{ClassName: "Unknown"}},
+		NearVector: &pb.NearVectorParams{Vector: []float32{1, 2, 3}},
+	}
+
+	_, err := federatedSearchParamsFromProto(req, schema.Schema{Objects: &models.Schema{}})
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "targets[0]")
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/weaviate/weaviate/entities/models"
 
 	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/vectorindex"
 	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 
 	"github.com/weaviate/weaviate/usecases/modulecomponents/additional/generate"
@@ -56,41 +57,10 @@ func searchParamsFromProto(req *pb.SearchRequest, scheme schema.Schema) (dto.Get
 
 	out.Tenant = req.Tenant
 
-	if req.AdditionalProperties != nil {
-		out.AdditionalProperties.ID = req.AdditionalProperties.Uuid
-		out.AdditionalProperties.Vector = req.AdditionalProperties.Vector
-		out.AdditionalProperties.Certainty = req.AdditionalProperties.Certainty
-		out.AdditionalProperties.Distance = req.AdditionalProperties.Distance
-		out.AdditionalProperties.LastUpdateTimeUnix = req.AdditionalProperties.LastUpdateTimeUnix
-		out.AdditionalProperties.CreationTimeUnix = req.AdditionalProperties.CreationTimeUnix
-		out.AdditionalProperties.Score = req.AdditionalProperties.Score
-		out.AdditionalProperties.ExplainScore = req.AdditionalProperties.ExplainScore
-		out.AdditionalProperties.IsConsistent = req.AdditionalProperties.IsConsistent
-	}
-
-	out.Properties, err = extractPropertiesRequest(req.Properties, scheme, req.ClassName)
+	out.Properties, out.AdditionalProperties, err = extractSelection(req.Properties, req.AdditionalProperties, scheme, class)
 	if err != nil {
 		return dto.GetParams{}, err
 	}
-	if len(out.Properties) == 0 && req.AdditionalProperties != nil {
-		// This is a pure-ID query without any props. Indicate this to the DB, so
-		// it can optimize accordingly
-		out.AdditionalProperties.NoProps = true
-	} else if len(out.Properties) == 0 && req.AdditionalProperties == nil {
-		// no return values selected, return all properties and metadata. Ignore blobs and refs to not overload the
-		// response
-		returnProps, err := getAllNonRefNonBlobProperties(scheme, req.ClassName)
-		if err != nil {
-			return dto.GetParams{}, err
-		}
-
-		addProps, err := setAllCheapAdditionalPropsToTrue(class)
-		if err != nil {
-			return dto.GetParams{}, err
-		}
-		out.AdditionalProperties = addProps
-		out.Properties = returnProps
-	}
 
 	if hs := req.HybridSearch; hs != nil {
 		fusionType := common_filters.HybridRankedFusion // default value
@@ -263,6 +233,50 @@ func searchParamsFromProto(req *pb.SearchRequest, scheme schema.Schema) (dto.Get
 	return out, nil
 }
 
+// extractSelection returns the properties and metadata to return for each
+// object. Without any selection all non-ref, non-blob properties and all cheap
+// metadata are returned.
+func extractSelection(reqProps *pb.Properties, reqAdditional *pb.AdditionalProperties,
+	scheme schema.Schema, class *models.Class,
+) ([]search.SelectProperty, additional.Properties, error) {
+	var addProps additional.Properties
+	if reqAdditional != nil {
+		addProps.ID = reqAdditional.Uuid
+		addProps.Vector = reqAdditional.Vector
+		addProps.Certainty = reqAdditional.Certainty
+		addProps.Distance = reqAdditional.Distance
+		addProps.LastUpdateTimeUnix = reqAdditional.LastUpdateTimeUnix
+		addProps.CreationTimeUnix = reqAdditional.CreationTimeUnix
+		addProps.Score = reqAdditional.Score
+		addProps.ExplainScore = reqAdditional.ExplainScore
+		addProps.IsConsistent = reqAdditional.IsConsistent
+	}
+
+	props, err := extractPropertiesRequest(reqProps, scheme, class.Class)
+	if err != nil {
+		return nil, additional.Properties{}, err
+	}
+	if len(props) == 0 && reqAdditional != nil {
+		// This is a pure-ID query without any props. Indicate this to the DB, so
+		// it can optimize accordingly
+		addProps.NoProps = true
+	} else if len(props) == 0 && reqAdditional == nil {
+		// no return values selected, return all properties and metadata. Ignore blobs and refs to not overload the
+		// response
+		props, err = getAllNonRefNonBlobProperties(scheme, class.Class)
+		if err != nil {
+			return nil, additional.Properties{}, err
+		}
+
+		addProps, err = setAllCheapAdditionalPropsToTrue(class)
+		if err != nil {
+			return nil, additional.Properties{}, err
+		}
+	}
+
+	return props, addProps, nil
+}
+
 func extractGroupBy(groupIn *pb.GroupBy, out *dto.GetParams) (*searchparams.GroupBy, error) {
 	if len(groupIn.Path) != 1 {
 		return nil, fmt.Errorf("groupby path can only have one entry, received %v", groupIn.Path)
@@ -398,8 +412,9 @@ func extractFilters(filterIn *pb.Filters, scheme schema.Schema, className string
 			return filters.Clause{}, err
 		}
 
-		// datatype UUID is just a string
-		if dataType == schema.DataTypeUUID {
+		// datatypes UUID, duration, ip and decimal are just strings
+		if dataType == schema.DataTypeUUID || dataType == schema.DataTypeDuration ||
+			dataType == schema.DataTypeIP || dataType == schema.DataTypeDecimal {
 			dataType = schema.DataTypeText
 		}
 
@@ -632,12 +647,12 @@ func setAllCheapAdditionalPropsToTrue(class *models.Class) (additional.Propertie
 	}
 
 	// certainty is not compatible with dot distance
-	vectorIndex, err := hnsw.TypeAssertVectorIndex(class)
+	vectorIndex, err := vectorindex.TypeAssertVectorIndex(class)
 	if err != nil {
 		return out, err
 	}
 
-	if vectorIndex.Distance == hnsw.DistanceCosine {
+	if vectorIndex.DistanceName() == hnsw.DistanceCosine {
 		out.Certainty = true
 	}
 
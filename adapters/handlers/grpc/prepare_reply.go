@@ -391,7 +391,7 @@ func extractArrayTypes(scheme schema.Schema, className string, props *pb.ResultP
 			}
 			props.IntArrayProperties = append(props.IntArrayProperties, &pb.IntArrayProperties{PropName: propName, Values: propInt})
 			delete(nonRefProps, propName)
-		case schema.DataTypeNumberArray:
+		case schema.DataTypeNumberArray, schema.DataTypeVector:
 			propIntAsFloat, ok := prop.([]float64)
 			if !ok {
 				return fmt.Errorf("property %v with datatype %v needs to be []float64, got %T", propName, dataType, prop)
@@ -411,6 +411,22 @@ func extractArrayTypes(scheme schema.Schema, className string, props *pb.ResultP
 			}
 			props.TextArrayProperties = append(props.TextArrayProperties, &pb.TextArrayProperties{PropName: propName, Values: propString})
 			delete(nonRefProps, propName)
+		case schema.DataTypeVectorArray:
+			// there are no nested array properties, the vectors are returned as
+			// a list of lists in the non-ref properties instead
+			propVectors, ok := prop.([][]float64)
+			if !ok {
+				return fmt.Errorf("property %v with datatype %v needs to be [][]float64, got %T", propName, dataType, prop)
+			}
+			vectors := make([]interface{}, len(propVectors))
+			for i, vector := range propVectors {
+				values := make([]interface{}, len(vector))
+				for j := range vector {
+					values[j] = vector[j]
+				}
+				vectors[i] = values
+			}
+			nonRefProps[propName] = vectors
 		case schema.DataTypeBooleanArray:
 			propBool, ok := prop.([]bool)
 			if !ok {
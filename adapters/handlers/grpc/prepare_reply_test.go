@@ -68,6 +68,8 @@ func TestGRPCReply(t *testing.T) {
 						{Name: "other", DataType: []string{"int"}},
 						{Name: "age", DataType: []string{"int"}},
 						{Name: "nums", DataType: schema.DataTypeIntArray.PropString()},
+						{Name: "embedding", DataType: schema.DataTypeVector.PropString()},
+						{Name: "crops", DataType: schema.DataTypeVectorArray.PropString()},
 						{Name: "ref", DataType: []string{refClass1}},
 						{Name: "multiRef", DataType: []string{refClass1, refClass2}},
 					},
@@ -247,6 +249,37 @@ func TestGRPCReply(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "vector properties",
+			res: []interface{}{
+				map[string]interface{}{
+					"embedding": []float64{1, 2},
+					"crops":     [][]float64{{1, 2}, {3, 4}},
+				},
+			},
+			searchParams: dto.GetParams{
+				ClassName: className,
+				Properties: search.SelectProperties{
+					{Name: "embedding", IsPrimitive: true},
+					{Name: "crops", IsPrimitive: true},
+				},
+			},
+			outSearch: []*pb.SearchResult{
+				{
+					AdditionalProperties: &pb.ResultAdditionalProps{},
+					Properties: &pb.ResultProperties{
+						ClassName: className,
+						NonRefProperties: newStruct(t, map[string]interface{}{
+							"crops": []interface{}{
+								[]interface{}{1.0, 2.0},
+								[]interface{}{3.0, 4.0},
+							},
+						}),
+						NumberArrayProperties: []*pb.NumberArrayProperties{{PropName: "embedding", Values: []float64{1, 2}}},
+					},
+				},
+			},
+		},
 		{
 			name: "primitive and ref properties",
 			res: []interface{}{
@@ -29,10 +29,16 @@ import (
 	schemaManager "github.com/weaviate/weaviate/usecases/schema"
 	"github.com/weaviate/weaviate/usecases/traverser"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 const maxMsgSize = 104858000 // 10mb, needs to be synchronized with clients
 
+// streamBatchSize is the maximum number of results sent in a single reply of
+// a streamed search
+const streamBatchSize = 100
+
 func CreateGRPCServer(state *state.State) *GRPCServer {
 	s := grpc.NewServer(
 		grpc.MaxRecvMsgSize(maxMsgSize),
@@ -46,6 +52,7 @@ func CreateGRPCServer(state *state.State) *GRPCServer {
 		allowAnonymousAccess: state.ServerConfig.Config.Authentication.AnonymousAccess.Enabled,
 		schemaManager:        state.SchemaManager,
 		batchManager:         state.BatchManager,
+		vectorUpdater:        state.ObjectsManager,
 	})
 
 	return &GRPCServer{s}
@@ -77,6 +84,7 @@ type Server struct {
 	allowAnonymousAccess bool
 	schemaManager        *schemaManager.Manager
 	batchManager         *objects.BatchManager
+	vectorUpdater        vectorUpdater
 }
 
 func (s *Server) BatchObjects(ctx context.Context, req *pb.BatchObjectsRequest) (*pb.BatchObjectsReply, error) {
@@ -95,7 +103,8 @@ func (s *Server) BatchObjects(ctx context.Context, req *pb.BatchObjectsRequest)
 	replicationProperties := extractReplicationProperties(req.ConsistencyLevel)
 
 	all := "ALL"
-	response, err := s.batchManager.AddObjects(ctx, principal, objs, []*string{&all}, replicationProperties)
+	response, err := s.batchManager.AddObjects(ctx, principal, objs, []*string{&all},
+		replicationProperties, objects.VectorizeIfMissing, req.GetUpsertBy())
 	if err != nil {
 		return nil, err
 	}
@@ -173,6 +182,183 @@ func (s *Server) Search(ctx context.Context, req *pb.SearchRequest) (*pb.SearchR
 	return res.Result, res.Error
 }
 
+// SearchStream sends the results of a search in batches of at most
+// streamBatchSize results. Plain list queries are searched shard by shard, so
+// the first batch is sent as soon as the first shard is done. Each reply's
+// took is the time since the request was received.
+func (s *Server) SearchStream(req *pb.SearchRequest, stream pb.Weaviate_SearchStreamServer) (err error) {
+	before := time.Now()
+	ctx := stream.Context()
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic occurred: %v", r)
+		}
+	}()
+
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return fmt.Errorf("extract auth: %w", err)
+	}
+
+	scheme := s.schemaManager.GetSchemaSkipAuth()
+
+	searchParams, err := searchParamsFromProto(req, scheme)
+	if err != nil {
+		return fmt.Errorf("extract params: %w", err)
+	}
+
+	if err := s.validateClassAndProperty(searchParams); err != nil {
+		return err
+	}
+
+	return s.traverser.GetClassStream(ctx, principal, searchParams, func(res []interface{}) error {
+		for len(res) > 0 {
+			n := len(res)
+			if n > streamBatchSize {
+				n = streamBatchSize
+			}
+
+			reply, err := searchResultsToProto(res[:n], before, searchParams, scheme)
+			if err != nil {
+				return err
+			}
+			if err := stream.Send(reply); err != nil {
+				return fmt.Errorf("send search reply: %w", err)
+			}
+			res = res[n:]
+		}
+		return nil
+	})
+}
+
+// BatchGetObjects fetches many objects of a single class by their uuid. Objects
+// sharing the same selection are read with a single search. The reply contains
+// one result per requested object in the order of the request, objects which
+// do not exist are marked as not found.
+func (s *Server) BatchGetObjects(ctx context.Context, req *pb.BatchGetObjectsRequest) (reply *pb.BatchGetObjectsReply, err error) {
+	before := time.Now()
+
+	defer func() {
+		if r := recover(); r != nil {
+			reply, err = nil, fmt.Errorf("panic occurred: %v", r)
+		}
+	}()
+
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+
+	scheme := s.schemaManager.GetSchemaSkipAuth()
+
+	queries, err := batchGetQueriesFromProto(req, scheme)
+	if err != nil {
+		return nil, fmt.Errorf("extract params: %w", err)
+	}
+
+	results := make([]*pb.BatchGetObjectsReply_BatchResult, len(req.Objects))
+	for i, obj := range req.Objects {
+		results[i] = &pb.BatchGetObjectsReply_BatchResult{Index: int32(i), Uuid: obj.Uuid}
+	}
+
+	for _, query := range queries {
+		if err := s.validateClassAndProperty(query.params); err != nil {
+			return nil, err
+		}
+
+		res, err := s.traverser.GetClass(ctx, principal, query.params)
+		if err != nil {
+			return nil, err
+		}
+
+		objects, _, err := extractObjectsToResults(res, query.params, scheme, false)
+		if err != nil {
+			return nil, err
+		}
+
+		byID := make(map[string]*pb.SearchResult, len(objects))
+		for _, obj := range objects {
+			byID[obj.AdditionalProperties.Id] = obj
+		}
+		for j, id := range query.ids {
+			if obj, ok := byID[id]; ok {
+				results[query.indexes[j]].Found = true
+				results[query.indexes[j]].Result = obj
+			}
+		}
+	}
+
+	return &pb.BatchGetObjectsReply{
+		Results: results,
+		Took:    float32(time.Since(before).Seconds()),
+	}, nil
+}
+
+// UpdateVectors replaces the vector and named vectors of an existing object
+// without sending its properties again. Named vectors which are not part of
+// the request are kept.
+func (s *Server) UpdateVectors(ctx context.Context, req *pb.UpdateVectorsRequest) (*pb.UpdateVectorsReply, error) {
+	before := time.Now()
+
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+
+	update, err := vectorUpdateFromProto(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("extract params: %v", err))
+	}
+
+	if objErr := s.vectorUpdater.UpdateObjectVector(ctx, principal, update.className,
+		update.id, update.vector, update.vectors, update.repl, update.tenant); objErr != nil {
+		return nil, vectorUpdateStatus(objErr)
+	}
+
+	return &pb.UpdateVectorsReply{
+		Took: float32(time.Since(before).Seconds()),
+	}, nil
+}
+
+// FederatedSearch runs the same nearVector or hybrid search on several
+// classes and replies with a single list of hits, ranked by their normalized
+// score.
+func (s *Server) FederatedSearch(ctx context.Context, req *pb.FederatedSearchRequest) (reply *pb.SearchReply, err error) {
+	before := time.Now()
+
+	defer func() {
+		if r := recover(); r != nil {
+			reply, err = nil, fmt.Errorf("panic occurred: %v", r)
+		}
+	}()
+
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+
+	scheme := s.schemaManager.GetSchemaSkipAuth()
+
+	params, err := federatedSearchParamsFromProto(req, scheme)
+	if err != nil {
+		return nil, fmt.Errorf("extract params: %w", err)
+	}
+
+	for _, target := range params.Targets {
+		if err := s.validateClassAndProperty(target); err != nil {
+			return nil, err
+		}
+	}
+
+	res, err := s.traverser.FederatedSearch(ctx, principal, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return federatedResultsToProto(res, before, params, scheme)
+}
+
 func (s *Server) validateClassAndProperty(searchParams dto.GetParams) error {
 	scheme := s.schemaManager.GetSchemaSkipAuth()
 	class, err := schema.GetClassByName(scheme.Objects, searchParams.ClassName)
@@ -0,0 +1,92 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package grpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol"
+	"github.com/weaviate/weaviate/usecases/objects"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// vectorUpdater replaces the vectors of an existing object, it is
+// implemented by the objects manager
+type vectorUpdater interface {
+	UpdateObjectVector(ctx context.Context, principal *models.Principal,
+		className string, id strfmt.UUID, vector []float32, vectors models.Vectors,
+		repl *additional.ReplicationProperties, tenant string) *objects.Error
+}
+
+type vectorUpdate struct {
+	className string
+	id        strfmt.UUID
+	vector    []float32
+	vectors   models.Vectors
+	repl      *additional.ReplicationProperties
+	tenant    string
+}
+
+func vectorUpdateFromProto(req *pb.UpdateVectorsRequest) (vectorUpdate, error) {
+	if req.ClassName == "" {
+		return vectorUpdate{}, fmt.Errorf("class name is required")
+	}
+	if !strfmt.IsUUID(req.Uuid) {
+		return vectorUpdate{}, fmt.Errorf("invalid uuid %q", req.Uuid)
+	}
+
+	update := vectorUpdate{
+		className: req.ClassName,
+		id:        strfmt.UUID(req.Uuid),
+		vector:    req.Vector,
+		repl:      extractReplicationProperties(req.ConsistencyLevel),
+		tenant:    req.Tenant,
+	}
+
+	if len(req.Vectors) > 0 {
+		update.vectors = make(models.Vectors, len(req.Vectors))
+		for i, named := range req.Vectors {
+			if named.Name == "" {
+				return vectorUpdate{}, fmt.Errorf("vectors[%d]: name is required", i)
+			}
+			if _, ok := update.vectors[named.Name]; ok {
+				return vectorUpdate{}, fmt.Errorf("vectors[%d]: vector %q is sent more than once",
+					i, named.Name)
+			}
+			update.vectors[named.Name] = named.Vector
+		}
+	}
+
+	return update, nil
+}
+
+// vectorUpdateStatus maps the error of a vector update to a grpc status, so
+// that clients can tell missing objects and invalid vectors apart
+func vectorUpdateStatus(err *objects.Error) error {
+	code := codes.Internal
+	switch {
+	case err.Forbidden():
+		code = codes.PermissionDenied
+	case err.NotFound():
+		code = codes.NotFound
+	case err.BadRequest(), err.UnprocessableEntity():
+		code = codes.InvalidArgument
+	case err.Locked():
+		code = codes.FailedPrecondition
+	}
+	return status.Error(code, err.Error())
+}
@@ -0,0 +1,153 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package grpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol"
+	"github.com/weaviate/weaviate/usecases/objects"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type fakeVectorUpdater struct {
+	update vectorUpdate
+	err    *objects.Error
+}
+
+func (f *fakeVectorUpdater) UpdateObjectVector(ctx context.Context, principal *models.Principal,
+	className string, id strfmt.UUID, vector []float32, vectors models.Vectors,
+	repl *additional.ReplicationProperties, tenant string,
+) *objects.Error {
+	f.update = vectorUpdate{
+		className: className,
+		id:        id,
+		vector:    vector,
+		vectors:   vectors,
+		repl:      repl,
+		tenant:    tenant,
+	}
+	return f.err
+}
+
+func TestGRPCUpdateVectors(t *testing.T) {
+	id := "73f2eb5f-5abf-447a-81ca-74b1dd168247"
+	quorum := pb.ConsistencyLevel_CONSISTENCY_LEVEL_QUORUM
+
+	t.Run("vectors are passed to the objects manager", func(t *testing.T) {
+		updater := &fakeVectorUpdater{}
+		s := &Server{allowAnonymousAccess: true, vectorUpdater: updater}
+
+		reply, err := s.UpdateVectors(context.Background(), &pb.UpdateVectorsRequest{
+			ClassName:        "Article",
+			Uuid:             id,
+			Tenant:           "tenant",
+			ConsistencyLevel: &quorum,
+			Vector:           []float32{1, 2, 3},
+			Vectors: []*pb.UpdateVectorsRequest_NamedVector{
+				{Name: "title", Vector: []float32{4, 5}},
+			},
+		})
+		require.Nil(t, err)
+		require.NotNil(t, reply)
+
+		assert.Equal(t, vectorUpdate{
+			className: "Article",
+			id:        strfmt.UUID(id),
+			vector:    []float32{1, 2, 3},
+			vectors:   models.Vectors{"title": {4, 5}},
+			repl:      &additional.ReplicationProperties{ConsistencyLevel: "QUORUM"},
+			tenant:    "tenant",
+		}, updater.update)
+	})
+
+	t.Run("errors of the objects manager are mapped to status codes", func(t *testing.T) {
+		tests := []struct {
+			objErr *objects.Error
+			code   codes.Code
+		}{
+			{&objects.Error{Code: objects.StatusNotFound, Err: errors.New("not found")}, codes.NotFound},
+			{&objects.Error{Code: objects.StatusUnprocessableEntity, Err: errors.New("dims")}, codes.InvalidArgument},
+			{&objects.Error{Code: objects.StatusForbidden, Err: errors.New("forbidden")}, codes.PermissionDenied},
+			{&objects.Error{Code: objects.StatusInternalServerError, Err: errors.New("oops")}, codes.Internal},
+		}
+		for _, test := range tests {
+			s := &Server{allowAnonymousAccess: true, vectorUpdater: &fakeVectorUpdater{err: test.objErr}}
+
+			_, err := s.UpdateVectors(context.Background(), &pb.UpdateVectorsRequest{
+				ClassName: "Article",
+				Uuid:      id,
+				Vector:    []float32{1, 2, 3},
+			})
+			require.NotNil(t, err)
+			assert.Equal(t, test.code, status.Code(err), test.objErr.Error())
+		}
+	})
+
+	t.Run("invalid requests", func(t *testing.T) {
+		tests := []struct {
+			name string
+			req  *pb.UpdateVectorsRequest
+			err  string
+		}{
+			{
+				name: "missing class",
+				req:  &pb.UpdateVectorsRequest{Uuid: id, Vector: []float32{1}},
+				err:  "class name is required",
+			},
+			{
+				name: "invalid uuid",
+				req:  &pb.UpdateVectorsRequest{ClassName: "Article", Uuid: "1", Vector: []float32{1}},
+				err:  `invalid uuid "1"`,
+			},
+			{
+				name: "unnamed vector",
+				req: &pb.UpdateVectorsRequest{
+					ClassName: "Article", Uuid: id,
+					Vectors: []*pb.UpdateVectorsRequest_NamedVector{{Vector: []float32{1}}},
+				},
+				err: "vectors[0]: name is required",
+			},
+			{
+				name: "duplicate named vector",
+				req: &pb.UpdateVectorsRequest{
+					ClassName: "Article", Uuid: id,
+					Vectors: []*pb.UpdateVectorsRequest_NamedVector{
+						{Name: "title", Vector: []float32{1}},
+						{Name: "title", Vector: []float32{2}},
+					},
+				},
+				err: `vectors[1]: vector "title" is sent more than once`,
+			},
+		}
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				updater := &fakeVectorUpdater{}
+				s := &Server{allowAnonymousAccess: true, vectorUpdater: updater}
+
+				_, err := s.UpdateVectors(context.Background(), test.req)
+				require.NotNil(t, err)
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+				assert.Contains(t, err.Error(), test.err)
+				assert.Empty(t, updater.update.className)
+			})
+		}
+	})
+}
@@ -65,6 +65,19 @@ func (f *fakeRepo) DeleteShards(ctx context.Context, class string, shards []stri
 	return nil
 }
 
+func (f *fakeRepo) History(ctx context.Context, after uint64, limit int) (*models.SchemaVersions, error) {
+	return &models.SchemaVersions{}, nil
+}
+
+func (f *fakeRepo) SchemaAtVersion(ctx context.Context, version uint64) (*models.Schema, error) {
+	return nil, ucs.ErrNotFound
+}
+
+func (f *fakeRepo) UpdateStopwordSets(ctx context.Context, sets []*models.StopwordSet) error {
+	f.schema.ObjectSchema.StopwordSets = sets
+	return nil
+}
+
 type fakeAuthorizer struct{}
 
 func (f *fakeAuthorizer) Authorize(principal *models.Principal, verb, resource string) error {
@@ -77,7 +90,11 @@ func (f fakeVectorConfig) IndexType() string {
 	return "fake"
 }
 
-func dummyParseVectorConfig(in interface{}) (schemaent.VectorIndexConfig, error) {
+func (f fakeVectorConfig) DistanceName() string {
+	return ""
+}
+
+func dummyParseVectorConfig(in interface{}, vectorIndexType string) (schemaent.VectorIndexConfig, error) {
 	return fakeVectorConfig(in.(map[string]interface{})), nil
 }
 
@@ -226,6 +243,42 @@ func (n *NilMigrator) UpdateProperty(ctx context.Context, className string, prop
 	return nil
 }
 
+func (n *NilMigrator) UpdatePropertyTokenization(ctx context.Context, className string, prop *models.Property, tokenization string, onCommit func(), onFailure func(err error)) error {
+	return nil
+}
+
+func (n *NilMigrator) GetPropertyMigrations(ctx context.Context, className string) ([]*models.PropertyMigration, error) {
+	return nil, nil
+}
+
+func (n *NilMigrator) CheckVectorIndexIntegrity(ctx context.Context, className string, repair bool) ([]*models.VectorIndexIntegrity, error) {
+	return nil, nil
+}
+
+func (n *NilMigrator) MeasureVectorIndexRecall(ctx context.Context, className string, sampleSize, k int) ([]*models.VectorIndexRecall, error) {
+	return nil, nil
+}
+
+func (n *NilMigrator) ExportVectorIndexSnapshot(ctx context.Context, className, shardName, targetVector, path string) (*models.VectorIndexSnapshot, error) {
+	return nil, nil
+}
+
+func (n *NilMigrator) ImportVectorIndexSnapshot(ctx context.Context, className, shardName, targetVector, path string) (*models.VectorIndexSnapshot, error) {
+	return nil, nil
+}
+
+func (n *NilMigrator) BuildVectorIndex(ctx context.Context, className, shardName, targetVector string) (*models.VectorIndexBuild, error) {
+	return nil, nil
+}
+
+func (n *NilMigrator) CompactShard(ctx context.Context, className, shardName string) (*models.ShardCompaction, error) {
+	return nil, nil
+}
+
+func (n *NilMigrator) VectorIndexTombstoneCleanup(ctx context.Context, className string, paused *bool) ([]*models.VectorIndexTombstoneCleanup, error) {
+	return nil, nil
+}
+
 func (n *NilMigrator) UpdatePropertyAddDataType(ctx context.Context, className string, propName string, newDataType string) error {
 	return nil
 }
@@ -250,6 +303,10 @@ func (n *NilMigrator) UpdateInvertedIndexConfig(ctx context.Context, className s
 	return nil
 }
 
+func (n *NilMigrator) UpdateMemtableConfig(ctx context.Context, className string, updated *models.MemtableConfig) error {
+	return nil
+}
+
 func (n *NilMigrator) RecalculateVectorDimensions(ctx context.Context) error {
 	return nil
 }
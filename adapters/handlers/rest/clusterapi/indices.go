@@ -101,9 +101,9 @@ type shards interface {
 	MultiGetObjects(ctx context.Context, indexName, shardName string,
 		id []strfmt.UUID) ([]*storobj.Object, error)
 	Search(ctx context.Context, indexName, shardName string,
-		vector []float32, distance float32, limit int, filters *filters.LocalFilter,
+		vector []float32, targetVector string, distance float32, limit int, filters *filters.LocalFilter,
 		keywordRanking *searchparams.KeywordRanking, sort []filters.Sort,
-		cursor *filters.Cursor, groupBy *searchparams.GroupBy,
+		cursor *filters.Cursor, groupBy *searchparams.GroupBy, rescore *searchparams.Rescore, exact bool,
 		additional additional.Properties,
 	) ([]*storobj.Object, []float32, error)
 	Aggregate(ctx context.Context, indexName, shardName string,
@@ -326,8 +326,9 @@ func (i *indices) postObjectSingle(w http.ResponseWriter, r *http.Request,
 		return
 	}
 
-	if err := i.shards.PutObject(r.Context(), index, shard, obj); err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+	ctx := preconditionContext(r, obj.ID())
+	if err := i.shards.PutObject(ctx, index, shard, obj); err != nil {
+		http.Error(w, err.Error(), writeStatus(err))
 		return
 	}
 
@@ -473,9 +474,11 @@ func (i *indices) deleteObject() http.Handler {
 
 		defer r.Body.Close()
 
-		err := i.shards.DeleteObject(r.Context(), index, shard, strfmt.UUID(id))
+		ctx := preconditionContext(r, strfmt.UUID(id))
+		err := i.shards.DeleteObject(ctx, index, shard, strfmt.UUID(id))
 		if err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+			http.Error(w, err.Error(), writeStatus(err))
+			return
 		}
 
 		w.WriteHeader(http.StatusNoContent)
@@ -512,8 +515,9 @@ func (i *indices) mergeObject() http.Handler {
 			return
 		}
 
-		if err := i.shards.MergeObject(r.Context(), index, shard, mergeDoc); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		ctx := preconditionContext(r, mergeDoc.ID)
+		if err := i.shards.MergeObject(ctx, index, shard, mergeDoc); err != nil {
+			http.Error(w, err.Error(), writeStatus(err))
 			return
 		}
 
@@ -594,7 +598,7 @@ func (i *indices) postSearchObjects() http.Handler {
 			return
 		}
 
-		vector, certainty, limit, filters, keywordRanking, sort, cursor, groupBy, additional, err := IndicesPayloads.SearchParams.
+		vector, targetVector, certainty, limit, filters, keywordRanking, sort, cursor, groupBy, rescore, exact, additional, err := IndicesPayloads.SearchParams.
 			Unmarshal(reqPayload)
 		if err != nil {
 			http.Error(w, "unmarshal search params from json: "+err.Error(),
@@ -603,7 +607,7 @@ func (i *indices) postSearchObjects() http.Handler {
 		}
 
 		results, dists, err := i.shards.Search(r.Context(), index, shard,
-			vector, certainty, limit, filters, keywordRanking, sort, cursor, groupBy, additional)
+			vector, targetVector, certainty, limit, filters, keywordRanking, sort, cursor, groupBy, rescore, exact, additional)
 		if err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 			return
@@ -322,7 +322,8 @@ func (i *replicatedIndices) patchObject() http.Handler {
 			return
 		}
 
-		resp := i.shards.ReplicateUpdate(r.Context(), index, shard, requestID, &mergeDoc)
+		ctx := preconditionContext(r, mergeDoc.ID)
+		resp := i.shards.ReplicateUpdate(ctx, index, shard, requestID, &mergeDoc)
 		if localIndexNotReady(resp) {
 			http.Error(w, resp.FirstError().Error(), http.StatusServiceUnavailable)
 			return
@@ -439,7 +440,8 @@ func (i *replicatedIndices) deleteObject() http.Handler {
 
 		defer r.Body.Close()
 
-		resp := i.shards.ReplicateDeletion(r.Context(), index, shard, requestID, strfmt.UUID(id))
+		ctx := preconditionContext(r, strfmt.UUID(id))
+		resp := i.shards.ReplicateDeletion(ctx, index, shard, requestID, strfmt.UUID(id))
 		if localIndexNotReady(resp) {
 			http.Error(w, resp.FirstError().Error(), http.StatusServiceUnavailable)
 			return
@@ -515,7 +517,8 @@ func (i *replicatedIndices) postObjectSingle(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
-	resp := i.shards.ReplicateObject(r.Context(), index, shard, requestID, obj)
+	ctx := preconditionContext(r, obj.ID())
+	resp := i.shards.ReplicateObject(ctx, index, shard, requestID, obj)
 	if localIndexNotReady(resp) {
 		http.Error(w, resp.FirstError().Error(), http.StatusServiceUnavailable)
 		return
@@ -0,0 +1,51 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clusterapi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+const headerIfMatch = "If-Match"
+
+// SetPreconditionHeaderReq sets the If-Match header of req if its context
+// makes the write of the object id conditional
+func SetPreconditionHeaderReq(req *http.Request, id strfmt.UUID) {
+	if p := objects.PreconditionFromContext(req.Context(), id); p != nil {
+		req.Header.Set(headerIfMatch, p.IfMatch())
+	}
+}
+
+// preconditionContext returns the context of r, which makes the write of the
+// object id conditional if r has an If-Match header
+func preconditionContext(r *http.Request, id strfmt.UUID) context.Context {
+	ifMatch, ok := r.Header[headerIfMatch]
+	if !ok {
+		return r.Context()
+	}
+	precondition := objects.ParseIfMatch(strings.Join(ifMatch, ","))
+	return objects.WithPrecondition(r.Context(), id, precondition)
+}
+
+// writeStatus returns the status code of a write which failed with err
+func writeStatus(err error) int {
+	if errors.As(err, &objects.ErrPreconditionFailed{}) {
+		return http.StatusPreconditionFailed
+	}
+	return http.StatusInternalServerError
+}
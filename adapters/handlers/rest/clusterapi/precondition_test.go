@@ -0,0 +1,66 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clusterapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+func TestPreconditionHeader(t *testing.T) {
+	var (
+		id    = strfmt.UUID("34e9df15-0c3b-468d-ab99-f929662834c7")
+		other = strfmt.UUID("c4a38f05-69c7-4ef4-a0f3-8c2a1a4f0f8e")
+	)
+	request := func(ctx context.Context) *http.Request {
+		req, err := http.NewRequestWithContext(ctx, http.MethodDelete, "http://node/", nil)
+		require.Nil(t, err)
+		return req
+	}
+
+	t.Run("unconditional write", func(t *testing.T) {
+		req := request(context.Background())
+		SetPreconditionHeaderReq(req, id)
+		assert.Empty(t, req.Header.Get(headerIfMatch))
+		assert.Nil(t, objects.PreconditionFromContext(preconditionContext(req, id), id))
+	})
+
+	for _, p := range []*objects.VersionPrecondition{
+		{Versions: []int64{12, 13}},
+		{},
+	} {
+		t.Run(fmt.Sprintf("conditional write %v", p.Versions), func(t *testing.T) {
+			req := request(objects.WithPrecondition(context.Background(), id, p))
+			SetPreconditionHeaderReq(req, id)
+
+			// the receiving node only knows the header
+			received := request(context.Background())
+			received.Header = req.Header
+			ctx := preconditionContext(received, id)
+			assert.Equal(t, p, objects.PreconditionFromContext(ctx, id))
+			assert.Nil(t, objects.PreconditionFromContext(ctx, other))
+		})
+	}
+
+	t.Run("status", func(t *testing.T) {
+		err := fmt.Errorf("put: %w", objects.NewErrPreconditionFailed("changed"))
+		assert.Equal(t, http.StatusPreconditionFailed, writeStatus(err))
+		assert.Equal(t, http.StatusInternalServerError, writeStatus(fmt.Errorf("put")))
+	})
+}
@@ -36,12 +36,14 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/classifications"
 	"github.com/weaviate/weaviate/adapters/repos/db"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
 	modulestorage "github.com/weaviate/weaviate/adapters/repos/modules"
 	schemarepo "github.com/weaviate/weaviate/adapters/repos/schema"
 	txstore "github.com/weaviate/weaviate/adapters/repos/transactions"
+	"github.com/weaviate/weaviate/entities/diskio"
 	"github.com/weaviate/weaviate/entities/moduletools"
 	"github.com/weaviate/weaviate/entities/replication"
-	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/entities/vectorindex"
 	modstgazure "github.com/weaviate/weaviate/modules/backup-azure"
 	modstgfs "github.com/weaviate/weaviate/modules/backup-filesystem"
 	modstggcs "github.com/weaviate/weaviate/modules/backup-gcs"
@@ -67,6 +69,7 @@ import (
 	modopenai "github.com/weaviate/weaviate/modules/text2vec-openai"
 	modtext2vecpalm "github.com/weaviate/weaviate/modules/text2vec-palm"
 	modtransformers "github.com/weaviate/weaviate/modules/text2vec-transformers"
+	"github.com/weaviate/weaviate/usecases/admission"
 	"github.com/weaviate/weaviate/usecases/auth/authentication/composer"
 	"github.com/weaviate/weaviate/usecases/backup"
 	"github.com/weaviate/weaviate/usecases/classification"
@@ -75,6 +78,7 @@ import (
 	"github.com/weaviate/weaviate/usecases/modules"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 	"github.com/weaviate/weaviate/usecases/objects"
+	"github.com/weaviate/weaviate/usecases/querycache"
 	"github.com/weaviate/weaviate/usecases/replica"
 	"github.com/weaviate/weaviate/usecases/scaler"
 	schemaUC "github.com/weaviate/weaviate/usecases/schema"
@@ -140,6 +144,12 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 	api.ServeError = openapierrors.ServeError
 
 	api.JSONConsumer = runtime.JSONConsumer()
+	// patch documents are plain json, their media type only selects how
+	// objects are patched
+	api.RegisterConsumer(mediaTypeJSONPatch, runtime.JSONConsumer())
+	api.RegisterConsumer(mediaTypeMergePatch, runtime.JSONConsumer())
+	api.YamlConsumer = yamlConsumer()
+	api.YamlProducer = yamlProducer()
 
 	api.OidcAuth = composer.New(
 		appState.ServerConfig.Config.Authentication,
@@ -172,6 +182,8 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 		MemtablesMaxSizeMB:        appState.ServerConfig.Config.Persistence.MemtablesMaxSizeMB,
 		MemtablesMinActiveSeconds: appState.ServerConfig.Config.Persistence.MemtablesMinActiveDurationSeconds,
 		MemtablesMaxActiveSeconds: appState.ServerConfig.Config.Persistence.MemtablesMaxActiveDurationSeconds,
+		MemtablesMaxAgeSeconds:    appState.ServerConfig.Config.Persistence.MemtablesMaxAgeSeconds,
+		MemtablesMaxWALSizeMB:     appState.ServerConfig.Config.Persistence.MemtablesMaxWALSizeMB,
 		RootPath:                  appState.ServerConfig.Config.Persistence.DataPath,
 		QueryLimit:                appState.ServerConfig.Config.QueryDefaults.Limit,
 		QueryMaximumResults:       appState.ServerConfig.Config.QueryMaximumResults,
@@ -180,6 +192,22 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 		TrackVectorDimensions:     appState.ServerConfig.Config.TrackVectorDimensions,
 		ResourceUsage:             appState.ServerConfig.Config.ResourceUsage,
 		AvoidMMap:                 appState.ServerConfig.Config.AvoidMmap,
+		LazyLoadShards:            appState.ServerConfig.Config.LazyLoadShards,
+		HNSWCheckpointInterval: time.Duration(appState.ServerConfig.Config.Persistence.
+			HNSWCheckpointIntervalSeconds) * time.Second,
+		AsyncIndexing:          appState.ServerConfig.Config.AsyncIndexing,
+		LSMCompactionStrategy:  appState.ServerConfig.Config.Persistence.LSMCompactionStrategy,
+		LSMCompactionSizeRatio: appState.ServerConfig.Config.Persistence.LSMCompactionSizeRatio,
+		LSMBlockCacheSizeMB:    appState.ServerConfig.Config.Persistence.LSMBlockCacheSizeMB,
+		LSMDirectIO:            appState.ServerConfig.Config.Persistence.LSMDirectIO,
+		LSMScrubInterval: time.Duration(appState.ServerConfig.Config.Persistence.
+			LSMScrubIntervalHours) * time.Hour,
+		OffloadBackend: appState.ServerConfig.Config.Persistence.OffloadBackend,
+		WALSyncPolicy: diskio.SyncPolicy{
+			Mode: appState.ServerConfig.Config.Persistence.WALSyncMode,
+			MaxDelay: time.Duration(appState.ServerConfig.Config.Persistence.
+				WALGroupCommitMaxDelayMs) * time.Millisecond,
+		},
 		// Pass dummy replication config with minimum factor 1. Otherwise the
 		// setting is not backward-compatible. The user may have created a class
 		// with factor=1 before the change was introduced. Now their setup would no
@@ -241,7 +269,7 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 
 	schemaManager, err := schemaUC.NewManager(migrator, schemaRepo,
 		appState.Logger, appState.Authorizer, appState.ServerConfig.Config,
-		enthnsw.ParseAndValidateConfig, appState.Modules, inverted.ValidateConfig,
+		vectorindex.ParseAndValidateConfig, appState.Modules, inverted.ValidateConfig,
 		appState.Modules, appState.Cluster, schemaTxClient,
 		schemaTxPersistence, scaler,
 	)
@@ -297,14 +325,31 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 		schemaManager, appState.ServerConfig, appState.Logger,
 		appState.Authorizer, vectorRepo, appState.Modules,
 		objects.NewMetrics(appState.Metrics))
+	appState.ObjectsManager = objectsManager
+	admissionCfg := appState.ServerConfig.Config.AdmissionControl
+	admissionController := admission.New(admission.Config{
+		MaxConcurrent: admissionCfg.MaxConcurrent,
+		MaxQueued:     admissionCfg.MaxQueued,
+		QueueTimeout:  admissionCfg.QueueTimeout,
+		Priorities: map[admission.Kind]int{
+			admission.VectorSearch: admissionCfg.VectorSearchPriority,
+			admission.Aggregation:  admissionCfg.AggregationPriority,
+			admission.BatchWrite:   admissionCfg.BatchWritePriority,
+		},
+	})
 	batchObjectsManager := objects.NewBatchManager(vectorRepo, appState.Modules,
 		appState.Locks, schemaManager, appState.ServerConfig, appState.Logger,
-		appState.Authorizer, appState.Metrics)
+		appState.Authorizer, appState.Metrics, admissionController)
 	appState.BatchManager = batchObjectsManager
+	queryCache := querycache.New(querycache.Config{
+		MaxEntries: appState.ServerConfig.Config.QueryCache.MaxEntries,
+		TTL:        appState.ServerConfig.Config.QueryCache.TTL,
+	})
 	objectsTraverser := traverser.NewTraverser(appState.ServerConfig, appState.Locks,
 		appState.Logger, appState.Authorizer, vectorRepo, explorer, schemaManager,
 		appState.Modules, traverser.NewMetrics(appState.Metrics),
-		appState.ServerConfig.Config.MaximumConcurrentGetRequests)
+		appState.ServerConfig.Config.MaximumConcurrentGetRequests, admissionController,
+		queryCache)
 	appState.Traverser = objectsTraverser
 
 	classifier := classification.New(schemaManager, classifierRepo, vectorRepo, appState.Authorizer,
@@ -342,6 +387,9 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 	if appState.ServerConfig.Config.ReindexSetToRoaringsetAtStartup {
 		reindexTaskNames = append(reindexTaskNames, "ShardInvertedReindexTaskSetToRoaringSet")
 	}
+	if appState.ServerConfig.Config.MigrateSetToRoaringsetOnline {
+		reindexTaskNames = append(reindexTaskNames, "ShardMigrateSetToRoaringSetOnline")
+	}
 	if appState.ServerConfig.Config.IndexMissingTextFilterableAtStartup {
 		reindexTaskNames = append(reindexTaskNames, "ShardInvertedReindexTaskMissingTextFilterable")
 	}
@@ -392,6 +440,7 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 			WithField("action", "startup").WithError(err).
 			Fatal("modules didn't initialize")
 	}
+	repo.SetOffloadBackendProvider(appState.Modules)
 
 	// manually update schema once
 	schema := schemaManager.GetSchemaSkipAuth()
@@ -410,6 +459,21 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 		migrator.RecountProperties(ctx)
 	}
 
+	// Validate the vector index graphs of all local shards, if requested by the user
+	if appState.ServerConfig.Config.CheckHNSWIntegrityAtStartup {
+		repair := appState.ServerConfig.Config.RepairHNSWIntegrityAtStartup
+		appState.Logger.
+			WithField("action", "startup").
+			WithField("repair", repair).
+			Info("Checking vector index integrity")
+		if _, err := migrator.CheckVectorIndexIntegrity(ctx, "", repair); err != nil {
+			appState.Logger.
+				WithField("action", "startup").
+				WithError(err).
+				Error("could not check vector index integrity")
+		}
+	}
+
 	startGrpcServer(grpcServer, appState)
 
 	return setupGlobalMiddleware(api.Serve(setupMiddlewares))
@@ -445,6 +509,20 @@ func startupRoutine(ctx context.Context) *state.State {
 			"before reaching general availability")
 	}
 
+	if serverConfig.Config.ForceScalarDistancer {
+		distancer.ForceScalar()
+		logger.WithFields(logrus.Fields{
+			"action":                 "startup",
+			"force_scalar_distancer": true,
+		}).Warnf("SIMD distance kernels disabled, all vector distances are " +
+			"calculated in pure go. This is meant for debugging and slows down " +
+			"vector searches considerably")
+	}
+	logger.WithFields(logrus.Fields{
+		"action":    "startup",
+		"distancer": distancer.Kernels(),
+	}).Debugf("vector distances are calculated with %s kernels", distancer.Kernels())
+
 	logger.WithFields(logrus.Fields{
 		"action":                    "startup",
 		"default_vectorizer_module": serverConfig.Config.DefaultVectorizerModule,
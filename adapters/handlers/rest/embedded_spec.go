@@ -431,6 +431,19 @@ func init() {
                   "items": {
                     "$ref": "#/definitions/Object"
                   }
+                },
+                "upsertBy": {
+                  "description": "Name of a property which is a ` + "`" + `uniqueKey` + "`" + ` of the classes of the objects in the batch. The objects get a UUID derived from their class and the value of this property, so importing an object with the same value again updates it instead of creating a duplicate. Objects must be sent without an id.",
+                  "type": "string"
+                },
+                "vectorizePolicy": {
+                  "description": "Controls which objects of the batch are vectorized by the vectorizer modules of their class. ` + "`" + `ifMissing` + "`" + ` (default) vectorizes objects which are sent without a vector, ` + "`" + `always` + "`" + ` vectorizes all objects and ignores the vectors sent with them for vector spaces with a vectorizer, ` + "`" + `never` + "`" + ` vectorizes no object, objects which already exist keep their stored vectors unless new ones are sent.",
+                  "type": "string",
+                  "enum": [
+                    "always",
+                    "ifMissing",
+                    "never"
+                  ]
                 }
               }
             }
@@ -470,6 +483,24 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "423": {
+            "description": "The class or the node is in read-only mode and rejects writes",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "429": {
+            "description": "Too many heavy operations are running or queued on the node. Retry after the number of seconds in the Retry-After header.",
+            "headers": {
+              "Retry-After": {
+                "type": "integer",
+                "description": "Number of seconds after which the request can be retried"
+              }
+            },
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -535,6 +566,12 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "423": {
+            "description": "The class or the node is in read-only mode and rejects writes",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -606,6 +643,12 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "423": {
+            "description": "The class or the node is in read-only mode and rejects writes",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -762,6 +805,18 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "429": {
+            "description": "Too many heavy operations are running or queued on the node. Retry after the number of seconds in the Retry-After header.",
+            "headers": {
+              "Retry-After": {
+                "type": "integer",
+                "description": "Number of seconds after which the request can be retried"
+              }
+            },
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -922,6 +977,64 @@ func init() {
         ]
       }
     },
+    "/nodes/read-only": {
+      "put": {
+        "description": "Rejects all writes to the node, or to a class on this node, with 423 Locked until read-only mode is disabled again, e.g. during migrations or a blue/green cutover. The node is also put into read-only mode automatically when its disk or memory usage exceeds the configured read-only thresholds. Disabling read-only mode for the node also lifts the automatic read-only mode.",
+        "tags": [
+          "nodes"
+        ],
+        "summary": "Put this node or a class into read-only mode",
+        "operationId": "nodes.readOnly.update",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ReadOnlyMode"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Read-only mode was updated successfully",
+            "schema": {
+              "$ref": "#/definitions/NodeReadOnlyStatus"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Class to be updated does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid update attempt",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      }
+    },
     "/nodes/{className}": {
       "get": {
         "description": "Returns status of Weaviate DB.",
@@ -1009,6 +1122,18 @@ func init() {
           },
           {
             "$ref": "#/parameters/CommonTenantParameterQuery"
+          },
+          {
+            "type": "string",
+            "description": "Starts or keeps alive a scroll session for the given duration, e.g. '5m'. All pages of a scroll session are read from the same snapshot of the class, which is not affected by concurrent writes. Requires the class and limit parameters.",
+            "name": "scroll",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The ID of the scroll session to continue, as returned with the first page.",
+            "name": "scrollId",
+            "in": "query"
           }
         ],
         "responses": {
@@ -1103,6 +1228,12 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "423": {
+            "description": "The class or the node is in read-only mode and rejects writes",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -1207,6 +1338,12 @@ func init() {
         "responses": {
           "200": {
             "description": "Successful response.",
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "Version of the object, can be sent in the If-Match header of a write to only apply the write if the object has not changed since"
+              }
+            },
             "schema": {
               "$ref": "#/definitions/Object"
             }
@@ -1280,6 +1417,9 @@ func init() {
           },
           {
             "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonIfMatchParameterHeader"
           }
         ],
         "responses": {
@@ -1301,12 +1441,24 @@ func init() {
           "404": {
             "description": "Successful query result but no resource was found."
           },
+          "412": {
+            "description": "The object has changed since it was read, its current ETag does not match the If-Match header.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "422": {
             "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "423": {
+            "description": "The class or the node is in read-only mode and rejects writes",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -1347,6 +1499,9 @@ func init() {
           },
           {
             "$ref": "#/parameters/CommonTenantParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonIfMatchParameterHeader"
           }
         ],
         "responses": {
@@ -1371,12 +1526,24 @@ func init() {
           "404": {
             "description": "Successful query result but no resource was found."
           },
+          "412": {
+            "description": "The object has changed since it was read, its current ETag does not match the If-Match header.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "422": {
             "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "423": {
+            "description": "The class or the node is in read-only mode and rejects writes",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -1456,7 +1623,13 @@ func init() {
         ]
       },
       "patch": {
-        "description": "Update an individual data object based on its class and uuid. This method supports json-merge style patch semantics (RFC 7396). Provided meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
+        "description": "Update an individual data object based on its class and uuid. This method supports json-merge style patch semantics (RFC 7396) as well as JSON Patch (RFC 6902), depending on the content type. With application/json every given property replaces its previous value as a whole, with application/merge-patch+json nested objects are merged recursively, and with application/json-patch+json the body is a list of JSON Patch operations. Patches apply to the JSON representation of the object, for example the JSON Patch path of a property is /properties/{propertyName}, and can only change its properties and vector. Provided meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
+        "consumes": [
+          "application/json",
+          "application/yaml",
+          "application/merge-patch+json",
+          "application/json-patch+json"
+        ],
         "tags": [
           "objects"
         ],
@@ -1479,15 +1652,19 @@ func init() {
             "required": true
           },
           {
-            "description": "RFC 7396-style patch, the body contains the object to merge into the existing object.",
+            "description": "RFC 7396-style patch, the body contains the object to merge into the existing object. With the content type application/json-patch+json the body is a RFC 6902 JSON Patch instead.",
             "name": "body",
             "in": "body",
-            "schema": {
-              "$ref": "#/definitions/Object"
-            }
+            "schema": {}
           },
           {
             "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonTenantParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonIfMatchParameterHeader"
           }
         ],
         "responses": {
@@ -1512,12 +1689,24 @@ func init() {
           "404": {
             "description": "Successful query result but no resource was found."
           },
+          "412": {
+            "description": "The object has changed since it was read, its current ETag does not match the If-Match header.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "422": {
             "description": "The patch-JSON is valid but unprocessable.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "423": {
+            "description": "The class or the node is in read-only mode and rejects writes",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -1532,14 +1721,17 @@ func init() {
         ]
       }
     },
-    "/objects/{className}/{id}/references/{propertyName}": {
-      "put": {
-        "description": "Update all references of a property of a data object.",
+    "/objects/{className}/{id}/blobs/{propertyName}": {
+      "get": {
+        "description": "Stream the contents of a blob property of a data object. Blobs stored out-of-band as well as inline blobs are returned as raw bytes, so they don't need to pass through the JSON representation of the object.",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
           "objects"
         ],
-        "summary": "Replace all references to a class-property.",
-        "operationId": "objects.class.references.put",
+        "summary": "Download the blob of a class-property.",
+        "operationId": "objects.class.blobs.get",
         "parameters": [
           {
             "type": "string",
@@ -1558,19 +1750,11 @@ func init() {
           },
           {
             "type": "string",
-            "description": "Unique name of the property related to the Object.",
+            "description": "Name of the blob property of the Object.",
             "name": "propertyName",
             "in": "path",
             "required": true
           },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/MultipleRef"
-            }
-          },
           {
             "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
           },
@@ -1580,7 +1764,11 @@ func init() {
         ],
         "responses": {
           "200": {
-            "description": "Successfully replaced all the references."
+            "description": "Successful response containing the blob.",
+            "schema": {
+              "type": "string",
+              "format": "binary"
+            }
           },
           "400": {
             "description": "Malformed request.",
@@ -1598,10 +1786,10 @@ func init() {
             }
           },
           "404": {
-            "description": "Source object doesn't exist."
+            "description": "The object, its class or the blob property doesn't exist."
           },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+            "description": "Request is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property is of type blob?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -1616,16 +1804,19 @@ func init() {
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.query"
         ]
       },
-      "post": {
-        "description": "Add a single reference to a class-property.",
+      "put": {
+        "description": "Stream the contents of a blob property of an existing data object. The blob is stored out-of-band, so large media doesn't need to pass through the JSON representation of the object. The property is set to a reference to the stored blob.",
+        "consumes": [
+          "application/octet-stream"
+        ],
         "tags": [
           "objects"
         ],
-        "summary": "Add a single reference to a class-property.",
-        "operationId": "objects.class.references.create",
+        "summary": "Upload the blob of a class-property.",
+        "operationId": "objects.class.blobs.put",
         "parameters": [
           {
             "type": "string",
@@ -1644,7 +1835,7 @@ func init() {
           },
           {
             "type": "string",
-            "description": "Unique name of the property related to the Object.",
+            "description": "Name of the blob property of the Object.",
             "name": "propertyName",
             "in": "path",
             "required": true
@@ -1654,7 +1845,8 @@ func init() {
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/SingleRef"
+              "type": "string",
+              "format": "binary"
             }
           },
           {
@@ -1665,8 +1857,8 @@ func init() {
           }
         ],
         "responses": {
-          "200": {
-            "description": "Successfully added the reference."
+          "204": {
+            "description": "Successfully stored the blob."
           },
           "400": {
             "description": "Malformed request.",
@@ -1684,10 +1876,16 @@ func init() {
             }
           },
           "404": {
-            "description": "Source object doesn't exist."
+            "description": "The object, its class or the blob property doesn't exist."
           },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+            "description": "Request is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property is of type blob?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "423": {
+            "description": "The class or the node is in read-only mode and rejects writes",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -1704,14 +1902,16 @@ func init() {
         "x-serviceIds": [
           "weaviate.local.manipulate"
         ]
-      },
-      "delete": {
-        "description": "Delete the single reference that is given in the body from the list of references that this property of a data object has",
+      }
+    },
+    "/objects/{className}/{id}/references/{propertyName}": {
+      "put": {
+        "description": "Update all references of a property of a data object.",
         "tags": [
           "objects"
         ],
-        "summary": "Delete the single reference that is given in the body from the list of references that this property has.",
-        "operationId": "objects.class.references.delete",
+        "summary": "Replace all references to a class-property.",
+        "operationId": "objects.class.references.put",
         "parameters": [
           {
             "type": "string",
@@ -1740,7 +1940,7 @@ func init() {
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/SingleRef"
+              "$ref": "#/definitions/MultipleRef"
             }
           },
           {
@@ -1751,8 +1951,8 @@ func init() {
           }
         ],
         "responses": {
-          "204": {
-            "description": "Successfully deleted."
+          "200": {
+            "description": "Successfully replaced all the references."
           },
           "400": {
             "description": "Malformed request.",
@@ -1770,13 +1970,16 @@ func init() {
             }
           },
           "404": {
-            "description": "Successful query result but no resource was found.",
+            "description": "Source object doesn't exist."
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+          "423": {
+            "description": "The class or the node is in read-only mode and rejects writes",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -1793,18 +1996,22 @@ func init() {
         "x-serviceIds": [
           "weaviate.local.manipulate"
         ]
-      }
-    },
-    "/objects/{id}": {
-      "get": {
-        "description": "Lists Objects.",
+      },
+      "post": {
+        "description": "Add a single reference to a class-property.",
         "tags": [
           "objects"
         ],
-        "summary": "Get a specific Object based on its UUID and a Object UUID. Also available as Websocket bus.",
-        "operationId": "objects.get",
-        "deprecated": true,
+        "summary": "Add a single reference to a class-property.",
+        "operationId": "objects.class.references.create",
         "parameters": [
+          {
+            "type": "string",
+            "description": "The class name as defined in the schema",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
           {
             "type": "string",
             "format": "uuid",
@@ -1814,15 +2021,30 @@ func init() {
             "required": true
           },
           {
-            "$ref": "#/parameters/CommonIncludeParameterQuery"
+            "type": "string",
+            "description": "Unique name of the property related to the Object.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/SingleRef"
+            }
+          },
+          {
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonTenantParameterQuery"
           }
         ],
         "responses": {
           "200": {
-            "description": "Successful response.",
-            "schema": {
-              "$ref": "#/definitions/Object"
-            }
+            "description": "Successfully added the reference."
           },
           "400": {
             "description": "Malformed request.",
@@ -1840,30 +2062,48 @@ func init() {
             }
           },
           "404": {
-            "description": "Successful query result but no resource was found."
+            "description": "Source object doesn't exist."
           },
-          "500": {
-            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
-          }
-        },
-        "x-available-in-mqtt": false,
+          },
+          "423": {
+            "description": "The class or the node is in read-only mode and rejects writes",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query"
+          "weaviate.local.manipulate"
         ]
       },
-      "put": {
-        "description": "Updates an Object's data. Given meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
+      "delete": {
+        "description": "Delete the single reference that is given in the body from the list of references that this property of a data object has",
         "tags": [
           "objects"
         ],
-        "summary": "Update an Object based on its UUID.",
-        "operationId": "objects.update",
-        "deprecated": true,
+        "summary": "Delete the single reference that is given in the body from the list of references that this property has.",
+        "operationId": "objects.class.references.delete",
         "parameters": [
+          {
+            "type": "string",
+            "description": "The class name as defined in the schema",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
           {
             "type": "string",
             "format": "uuid",
@@ -1872,23 +2112,36 @@ func init() {
             "in": "path",
             "required": true
           },
+          {
+            "type": "string",
+            "description": "Unique name of the property related to the Object.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Object"
+              "$ref": "#/definitions/SingleRef"
             }
           },
           {
             "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonTenantParameterQuery"
           }
         ],
         "responses": {
-          "200": {
-            "description": "Successfully received.",
+          "204": {
+            "description": "Successfully deleted."
+          },
+          "400": {
+            "description": "Malformed request.",
             "schema": {
-              "$ref": "#/definitions/Object"
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
           "401": {
@@ -1901,10 +2154,19 @@ func init() {
             }
           },
           "404": {
-            "description": "Successful query result but no resource was found."
+            "description": "Successful query result but no resource was found.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "423": {
+            "description": "The class or the node is in read-only mode and rejects writes",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -1921,16 +2183,24 @@ func init() {
         "x-serviceIds": [
           "weaviate.local.manipulate"
         ]
-      },
-      "delete": {
-        "description": "Deletes an Object from the system.",
+      }
+    },
+    "/objects/{className}/{id}/vector": {
+      "put": {
+        "description": "Replace the vector(s) of an existing data object without resending its properties. The vector index entries and the internal document version are updated, the properties are kept as they are. This is useful when re-embedding objects with a new model.",
         "tags": [
           "objects"
         ],
-        "summary": "Delete an Object based on its UUID.",
-        "operationId": "objects.delete",
-        "deprecated": true,
+        "summary": "Replace the vector(s) of an Object based on its UUID.",
+        "operationId": "objects.class.vector.put",
         "parameters": [
+          {
+            "type": "string",
+            "description": "The class name as defined in the schema",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
           {
             "type": "string",
             "format": "uuid",
@@ -1939,6 +2209,15 @@ func init() {
             "in": "path",
             "required": true
           },
+          {
+            "description": "The vector(s) to replace the existing ones with.",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ObjectVectorsUpdate"
+            }
+          },
           {
             "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
           },
@@ -1948,7 +2227,13 @@ func init() {
         ],
         "responses": {
           "204": {
-            "description": "Successfully deleted."
+            "description": "Successfully replaced the vector(s)."
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -1960,7 +2245,19 @@ func init() {
             }
           },
           "404": {
-            "description": "Successful query result but no resource was found."
+            "description": "The object or its class doesn't exist."
+          },
+          "422": {
+            "description": "Request is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the vector dimensions match the class?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "423": {
+            "description": "The class or the node is in read-only mode and rejects writes",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -1969,19 +2266,21 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": true,
-        "x-available-in-websocket": true,
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
           "weaviate.local.manipulate"
         ]
-      },
-      "head": {
-        "description": "Checks if an Object exists in the system.",
+      }
+    },
+    "/objects/{id}": {
+      "get": {
+        "description": "Lists Objects.",
         "tags": [
           "objects"
         ],
-        "summary": "Checks Object's existence based on its UUID.",
-        "operationId": "objects.head",
+        "summary": "Get a specific Object based on its UUID and a Object UUID. Also available as Websocket bus.",
+        "operationId": "objects.get",
         "deprecated": true,
         "parameters": [
           {
@@ -1991,11 +2290,29 @@ func init() {
             "name": "id",
             "in": "path",
             "required": true
+          },
+          {
+            "$ref": "#/parameters/CommonIncludeParameterQuery"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Object exists."
+          "200": {
+            "description": "Successful response.",
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "Version of the object, can be sent in the If-Match header of a write to only apply the write if the object has not changed since"
+              }
+            },
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -2007,7 +2324,7 @@ func init() {
             }
           },
           "404": {
-            "description": "Object doesn't exist."
+            "description": "Successful query result but no resource was found."
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -2016,19 +2333,19 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": true,
-        "x-available-in-websocket": true,
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.query"
         ]
       },
-      "patch": {
-        "description": "Updates an Object. This method supports json-merge style patch semantics (RFC 7396). Provided meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
+      "put": {
+        "description": "Updates an Object's data. Given meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
         "tags": [
           "objects"
         ],
-        "summary": "Update an Object based on its UUID (using patch semantics).",
-        "operationId": "objects.patch",
+        "summary": "Update an Object based on its UUID.",
+        "operationId": "objects.update",
         "deprecated": true,
         "parameters": [
           {
@@ -2040,23 +2357,26 @@ func init() {
             "required": true
           },
           {
-            "description": "RFC 7396-style patch, the body contains the object to merge into the existing object.",
             "name": "body",
             "in": "body",
+            "required": true,
             "schema": {
               "$ref": "#/definitions/Object"
             }
           },
           {
             "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonIfMatchParameterHeader"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Successfully applied. No content provided."
-          },
-          "400": {
-            "description": "The patch-JSON is malformed."
+          "200": {
+            "description": "Successfully received.",
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -2070,8 +2390,20 @@ func init() {
           "404": {
             "description": "Successful query result but no resource was found."
           },
+          "412": {
+            "description": "The object has changed since it was read, its current ETag does not match the If-Match header.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "422": {
-            "description": "The patch-JSON is valid but unprocessable.",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "423": {
+            "description": "The class or the node is in read-only mode and rejects writes",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2088,16 +2420,14 @@ func init() {
         "x-serviceIds": [
           "weaviate.local.manipulate"
         ]
-      }
-    },
-    "/objects/{id}/references/{propertyName}": {
-      "put": {
-        "description": "Replace all references to a class-property.",
+      },
+      "delete": {
+        "description": "Deletes an Object from the system.",
         "tags": [
           "objects"
         ],
-        "summary": "Replace all references to a class-property.",
-        "operationId": "objects.references.update",
+        "summary": "Delete an Object based on its UUID.",
+        "operationId": "objects.delete",
         "deprecated": true,
         "parameters": [
           {
@@ -2109,27 +2439,18 @@ func init() {
             "required": true
           },
           {
-            "type": "string",
-            "description": "Unique name of the property related to the Object.",
-            "name": "propertyName",
-            "in": "path",
-            "required": true
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
           },
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/MultipleRef"
-            }
+            "$ref": "#/parameters/CommonTenantParameterQuery"
           },
           {
-            "$ref": "#/parameters/CommonTenantParameterQuery"
+            "$ref": "#/parameters/CommonIfMatchParameterHeader"
           }
         ],
         "responses": {
-          "200": {
-            "description": "Successfully replaced all the references."
+          "204": {
+            "description": "Successfully deleted."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -2140,8 +2461,17 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "412": {
+            "description": "The object has changed since it was read, its current ETag does not match the If-Match header.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "423": {
+            "description": "The class or the node is in read-only mode and rejects writes",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2153,19 +2483,19 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
+        "x-available-in-mqtt": true,
+        "x-available-in-websocket": true,
         "x-serviceIds": [
           "weaviate.local.manipulate"
         ]
       },
-      "post": {
-        "description": "Add a single reference to a class-property.",
+      "head": {
+        "description": "Checks if an Object exists in the system.",
         "tags": [
           "objects"
         ],
-        "summary": "Add a single reference to a class-property.",
-        "operationId": "objects.references.create",
+        "summary": "Checks Object's existence based on its UUID.",
+        "operationId": "objects.head",
         "deprecated": true,
         "parameters": [
           {
@@ -2175,29 +2505,11 @@ func init() {
             "name": "id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "description": "Unique name of the property related to the Object.",
-            "name": "propertyName",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/SingleRef"
-            }
-          },
-          {
-            "$ref": "#/parameters/CommonTenantParameterQuery"
           }
         ],
         "responses": {
-          "200": {
-            "description": "Successfully added the reference."
+          "204": {
+            "description": "Object exists."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -2208,12 +2520,241 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
+          "404": {
+            "description": "Object doesn't exist."
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": true,
+        "x-available-in-websocket": true,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      },
+      "patch": {
+        "description": "Updates an Object. This method supports json-merge style patch semantics (RFC 7396). Provided meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Update an Object based on its UUID (using patch semantics).",
+        "operationId": "objects.patch",
+        "deprecated": true,
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "RFC 7396-style patch, the body contains the object to merge into the existing object.",
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
+          },
+          {
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonIfMatchParameterHeader"
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Successfully applied. No content provided."
+          },
+          "400": {
+            "description": "The patch-JSON is malformed."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "412": {
+            "description": "The object has changed since it was read, its current ETag does not match the If-Match header.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "The patch-JSON is valid but unprocessable.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "423": {
+            "description": "The class or the node is in read-only mode and rejects writes",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      }
+    },
+    "/objects/{id}/references/{propertyName}": {
+      "put": {
+        "description": "Replace all references to a class-property.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Replace all references to a class-property.",
+        "operationId": "objects.references.update",
+        "deprecated": true,
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Unique name of the property related to the Object.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/MultipleRef"
+            }
+          },
+          {
+            "$ref": "#/parameters/CommonTenantParameterQuery"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully replaced all the references."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "423": {
+            "description": "The class or the node is in read-only mode and rejects writes",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      },
+      "post": {
+        "description": "Add a single reference to a class-property.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Add a single reference to a class-property.",
+        "operationId": "objects.references.create",
+        "deprecated": true,
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Unique name of the property related to the Object.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/SingleRef"
+            }
+          },
+          {
+            "$ref": "#/parameters/CommonTenantParameterQuery"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully added the reference."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "423": {
+            "description": "The class or the node is in read-only mode and rejects writes",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -2282,6 +2823,12 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "423": {
+            "description": "The class or the node is in read-only mode and rejects writes",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -2402,26 +2949,29 @@ func init() {
         }
       }
     },
-    "/schema/{className}": {
-      "get": {
+    "/schema/diff": {
+      "post": {
+        "description": "Computes the differences between the current schema and the provided target schema without applying any change. Use this endpoint to validate a schema migration before applying it.",
         "tags": [
           "schema"
         ],
-        "summary": "Get a single class from the schema",
-        "operationId": "schema.objects.get",
+        "summary": "Compare the current schema with a target schema.",
+        "operationId": "schema.diff",
         "parameters": [
           {
-            "type": "string",
-            "name": "className",
-            "in": "path",
-            "required": true
+            "name": "targetSchema",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Schema"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Found the Class, returned as body",
+            "description": "The differences between the current and the target schema.",
             "schema": {
-              "$ref": "#/definitions/Class"
+              "$ref": "#/definitions/SchemaDiff"
             }
           },
           "401": {
@@ -2433,8 +2983,11 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "This class does not exist"
+          "422": {
+            "description": "Invalid target schema",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -2444,37 +2997,40 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.local.get.meta"
+          "weaviate.local.query.meta"
         ]
-      },
-      "put": {
-        "description": "Use this endpoint to alter an existing class in the schema. Note that not all settings are mutable. If an error about immutable fields is returned and you still need to update this particular setting, you will have to delete the class (and the underlying data) and recreate. This endpoint cannot be used to modify properties. Instead use POST /v1/schema/{className}/properties. A typical use case for this endpoint is to update configuration, such as the vectorIndexConfig. Note that even in mutable sections, such as vectorIndexConfig, some fields may be immutable.",
+      }
+    },
+    "/schema/export": {
+      "get": {
+        "description": "Serializes all classes, including their module, vector index, sharding and replication configs, and the tenants of multi-tenant classes to a single document. Import it with POST /schema/import to recreate the schema on another cluster.",
+        "produces": [
+          "application/json",
+          "application/yaml"
+        ],
         "tags": [
           "schema"
         ],
-        "summary": "Update settings of an existing schema class",
-        "operationId": "schema.objects.update",
+        "summary": "Export the full schema as a portable document.",
+        "operationId": "schema.export",
         "parameters": [
           {
             "type": "string",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "objectClass",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/Class"
-            }
+            "default": "json",
+            "description": "The format of the document, either json or yaml. Default value is json.",
+            "name": "format",
+            "in": "query",
+            "enum": [
+              "json",
+              "yaml"
+            ]
           }
         ],
         "responses": {
           "200": {
-            "description": "Class was updated successfully",
+            "description": "The exported schema.",
             "schema": {
-              "$ref": "#/definitions/Class"
+              "$ref": "#/definitions/SchemaExport"
             }
           },
           "401": {
@@ -2486,14 +3042,56 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Class to be updated does not exist",
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.query.meta"
+        ]
+      }
+    },
+    "/schema/history": {
+      "get": {
+        "description": "Every mutation of the schema is recorded with a monotonically increasing version and a timestamp. The versions are local to each node, compare them across the nodes of a cluster to debug schema drift.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Get the history of the schema mutations of this node.",
+        "operationId": "schema.history",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "default": 0,
+            "description": "Only return versions after this version. Default value is 0.",
+            "name": "after",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "default": 100,
+            "description": "The maximum number of versions to be returned. Default value is 100.",
+            "name": "limit",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The history of the schema mutations.",
+            "schema": {
+              "$ref": "#/definitions/SchemaVersions"
+            }
           },
-          "422": {
-            "description": "Invalid update attempt",
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2506,31 +3104,31 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.local.manipulate.meta"
+          "weaviate.local.query.meta"
         ]
-      },
-      "delete": {
+      }
+    },
+    "/schema/history/{version}": {
+      "get": {
         "tags": [
           "schema"
         ],
-        "summary": "Remove an Object class (and all data in the instances) from the schema.",
-        "operationId": "schema.objects.delete",
+        "summary": "Get the schema as of a given version.",
+        "operationId": "schema.history.get",
         "parameters": [
           {
-            "type": "string",
-            "name": "className",
+            "type": "integer",
+            "format": "int64",
+            "name": "version",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "Removed the Object class from the schema."
-          },
-          "400": {
-            "description": "Could not delete the Object class.",
+            "description": "The schema as of the given version.",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/Schema"
             }
           },
           "401": {
@@ -2542,6 +3140,9 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "This schema version does not exist on this node."
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -2550,38 +3151,37 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.local.manipulate.meta"
+          "weaviate.local.query.meta"
         ]
       }
     },
-    "/schema/{className}/properties": {
+    "/schema/import": {
       "post": {
+        "description": "Creates all classes and tenants of the document which do not exist yet and adds missing properties and updated settings to existing classes. Classes which are not part of the document are left untouched, so importing the same document again does not change anything. The import is rejected if it contains changes which can not be applied to the existing schema, use POST /schema/diff to inspect them upfront.",
+        "consumes": [
+          "application/json",
+          "application/yaml"
+        ],
         "tags": [
           "schema"
         ],
-        "summary": "Add a property to an Object class.",
-        "operationId": "schema.objects.properties.add",
+        "summary": "Import a schema exported with GET /schema/export.",
+        "operationId": "schema.import",
         "parameters": [
-          {
-            "type": "string",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Property"
+              "$ref": "#/definitions/SchemaExport"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Added the property.",
+            "description": "The schema was imported.",
             "schema": {
-              "$ref": "#/definitions/Property"
+              "$ref": "#/definitions/SchemaImportResult"
             }
           },
           "401": {
@@ -2594,7 +3194,7 @@ func init() {
             }
           },
           "422": {
-            "description": "Invalid property.",
+            "description": "The document is invalid or contains changes which can not be applied to the existing schema.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2611,27 +3211,64 @@ func init() {
         ]
       }
     },
-    "/schema/{className}/shards": {
+    "/schema/stopwords": {
       "get": {
+        "description": "Custom stopword sets are named lists of words which classes can reference in invertedIndexConfig.stopwords.sets, in addition to the preset and the additions.",
         "tags": [
           "schema"
         ],
-        "summary": "Get the shards status of an Object class",
-        "operationId": "schema.objects.shards.get",
+        "summary": "Get the custom stopword sets.",
+        "operationId": "schema.stopwords.list",
+        "responses": {
+          "200": {
+            "description": "The custom stopword sets.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/StopwordSet"
+              }
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.query.meta"
+        ]
+      }
+    },
+    "/schema/stopwords/{setName}": {
+      "delete": {
+        "tags": [
+          "schema"
+        ],
+        "summary": "Delete a custom stopword set.",
+        "operationId": "schema.stopwords.delete",
         "parameters": [
           {
             "type": "string",
-            "name": "className",
+            "description": "The name of the stopword set.",
+            "name": "setName",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "Found the status of the shards, returned as body",
-            "schema": {
-              "$ref": "#/definitions/ShardStatusList"
-            }
+            "description": "The stopword set was deleted or did not exist."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -2642,8 +3279,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "This class does not exist",
+          "422": {
+            "description": "The stopword set is still referenced by a class.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2656,27 +3293,21 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.local.get.meta"
+          "weaviate.local.manipulate.meta"
         ]
-      }
-    },
-    "/schema/{className}/shards/{shardName}": {
+      },
       "put": {
-        "description": "Update shard status of an Object Class",
+        "description": "The stopwords of all classes referencing the set change right away, as stopwords are only considered at query time.",
         "tags": [
           "schema"
         ],
-        "operationId": "schema.objects.shards.update",
+        "summary": "Create or replace a custom stopword set.",
+        "operationId": "schema.stopwords.put",
         "parameters": [
           {
             "type": "string",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "shardName",
+            "description": "The name of the stopword set.",
+            "name": "setName",
             "in": "path",
             "required": true
           },
@@ -2685,15 +3316,15 @@ func init() {
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/ShardStatus"
+              "$ref": "#/definitions/StopwordSet"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Shard status was updated successfully",
+            "description": "The stopword set was stored.",
             "schema": {
-              "$ref": "#/definitions/ShardStatus"
+              "$ref": "#/definitions/StopwordSet"
             }
           },
           "401": {
@@ -2705,14 +3336,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Shard to be updated does not exist",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
           "422": {
-            "description": "Invalid update attempt",
+            "description": "Invalid stopword set.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2729,13 +3354,13 @@ func init() {
         ]
       }
     },
-    "/schema/{className}/tenants": {
+    "/schema/{className}": {
       "get": {
-        "description": "get all tenants from a specific class",
         "tags": [
           "schema"
         ],
-        "operationId": "tenants.get",
+        "summary": "Get a single class from the schema",
+        "operationId": "schema.objects.get",
         "parameters": [
           {
             "type": "string",
@@ -2746,12 +3371,9 @@ func init() {
         ],
         "responses": {
           "200": {
-            "description": "tenants from specified class.",
+            "description": "Found the Class, returned as body",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/Tenant"
-              }
+              "$ref": "#/definitions/Class"
             }
           },
           "401": {
@@ -2763,11 +3385,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "422": {
-            "description": "Invalid Tenant class",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+          "404": {
+            "description": "This class does not exist"
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -2775,14 +3394,18 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        }
+        },
+        "x-serviceIds": [
+          "weaviate.local.get.meta"
+        ]
       },
       "put": {
-        "description": "Update tenant of a specific class",
+        "description": "Use this endpoint to alter an existing class in the schema. Note that not all settings are mutable. If an error about immutable fields is returned and you still need to update this particular setting, you will have to delete the class (and the underlying data) and recreate. This endpoint cannot be used to modify properties. Instead use POST /v1/schema/{className}/properties. A typical use case for this endpoint is to update configuration, such as the vectorIndexConfig. Note that even in mutable sections, such as vectorIndexConfig, some fields may be immutable.",
         "tags": [
           "schema"
         ],
-        "operationId": "tenants.update",
+        "summary": "Update settings of an existing schema class",
+        "operationId": "schema.objects.update",
         "parameters": [
           {
             "type": "string",
@@ -2791,25 +3414,27 @@ func init() {
             "required": true
           },
           {
-            "name": "body",
+            "name": "objectClass",
             "in": "body",
             "required": true,
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/Tenant"
-              }
+              "$ref": "#/definitions/Class"
             }
+          },
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "If true, the update is only validated, but not applied.",
+            "name": "dryRun",
+            "in": "query",
+            "required": false
           }
         ],
         "responses": {
           "200": {
-            "description": "Updated tenants of the specified class",
+            "description": "Class was updated successfully",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/Tenant"
-              }
+              "$ref": "#/definitions/Class"
             }
           },
           "401": {
@@ -2821,8 +3446,14 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Class to be updated does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "422": {
-            "description": "Invalid Tenant class",
+            "description": "Invalid update attempt",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2833,41 +3464,33 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        }
+        },
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
       },
-      "post": {
-        "description": "Create a new tenant for a specific class",
+      "delete": {
         "tags": [
           "schema"
         ],
-        "operationId": "tenants.create",
+        "summary": "Remove an Object class (and all data in the instances) from the schema.",
+        "operationId": "schema.objects.delete",
         "parameters": [
           {
             "type": "string",
             "name": "className",
             "in": "path",
             "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/Tenant"
-              }
-            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Added new tenants to the specified class",
+            "description": "Removed the Object class from the schema."
+          },
+          "400": {
+            "description": "Could not delete the Object class.",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/Tenant"
-              }
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
           "401": {
@@ -2879,9 +3502,55 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "422": {
-            "description": "Invalid Tenant class",
-            "schema": {
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      }
+    },
+    "/schema/{className}/migrations": {
+      "get": {
+        "tags": [
+          "schema"
+        ],
+        "summary": "Get the progress of the property migrations of an Object class on this node",
+        "operationId": "schema.objects.migrations.get",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Found the property migrations, returned as body",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/PropertyMigration"
+              }
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "This class does not exist",
+            "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
@@ -2891,14 +3560,19 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        }
-      },
-      "delete": {
-        "description": "delete tenants from a specific class",
+        },
+        "x-serviceIds": [
+          "weaviate.local.get.meta"
+        ]
+      }
+    },
+    "/schema/{className}/properties": {
+      "post": {
         "tags": [
           "schema"
         ],
-        "operationId": "tenants.delete",
+        "summary": "Add a property to an Object class.",
+        "operationId": "schema.objects.properties.add",
         "parameters": [
           {
             "type": "string",
@@ -2907,20 +3581,20 @@ func init() {
             "required": true
           },
           {
-            "name": "tenants",
+            "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "type": "array",
-              "items": {
-                "type": "string"
-              }
+              "$ref": "#/definitions/Property"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Deleted tenants from specified class."
+            "description": "Added the property.",
+            "schema": {
+              "$ref": "#/definitions/Property"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -2932,7 +3606,7 @@ func init() {
             }
           },
           "422": {
-            "description": "Invalid Tenant class",
+            "description": "Invalid property.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2943,2331 +3617,7457 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        }
-      }
-    }
-  },
-  "definitions": {
-    "AdditionalProperties": {
-      "description": "Additional Meta information about a single object object.",
-      "type": "object",
-      "additionalProperties": {
-        "type": "object"
-      }
-    },
-    "BM25Config": {
-      "description": "tuning parameters for the BM25 algorithm",
-      "type": "object",
-      "properties": {
-        "b": {
-          "description": "calibrates term-weight scaling based on the document length",
-          "type": "number",
-          "format": "float"
         },
-        "k1": {
-          "description": "calibrates term-weight scaling based on the term frequency within a document",
-          "type": "number",
-          "format": "float"
-        }
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
       }
     },
-    "BackupCreateRequest": {
-      "description": "Request body for creating a backup of a set of classes",
-      "properties": {
-        "config": {
-          "description": "Custom configuration for the backup creation process",
-          "type": "object"
-        },
-        "exclude": {
-          "description": "List of classes to exclude from the backup creation process",
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/schema/{className}/properties/{propertyName}/rename": {
+      "post": {
+        "description": "Renames the property in the schema and its indexes right away. The stored objects are rewritten in the background, until this has completed writes to the class are rejected. The progress can be retrieved with the migrations endpoint of the class.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Rename a property of an Object class.",
+        "operationId": "schema.objects.properties.rename",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/PropertyRename"
+            }
           }
-        },
-        "id": {
-          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
-          "type": "string"
-        },
-        "include": {
-          "description": "List of classes to include in the backup creation process",
-          "type": "array",
-          "items": {
-            "type": "string"
+        ],
+        "responses": {
+          "200": {
+            "description": "Renamed the property.",
+            "schema": {
+              "$ref": "#/definitions/Property"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid rename of the property.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
-        }
+        },
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
       }
     },
-    "BackupCreateResponse": {
-      "description": "The definition of a backup create response body",
-      "properties": {
-        "backend": {
-          "description": "Backup backend name e.g. filesystem, gcs, s3.",
-          "type": "string"
-        },
-        "classes": {
-          "description": "The list of classes for which the backup creation process was started",
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/schema/{className}/properties/{propertyName}/tokenization": {
+      "put": {
+        "description": "Changes the tokenization in the schema and rebuilds the inverted index of the property in the background, until this has completed writes to the class are rejected. The progress can be retrieved with the migrations endpoint of the class. If the index can not be rebuilt, the previous tokenization is restored.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Change the tokenization of a text property of an Object class.",
+        "operationId": "schema.objects.properties.tokenization.update",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/PropertyTokenizationUpdate"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Changed the tokenization of the property.",
+            "schema": {
+              "$ref": "#/definitions/Property"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid change of the tokenization.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
         },
-        "error": {
-          "description": "error message if creation failed",
-          "type": "string"
-        },
-        "id": {
-          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
-          "type": "string"
-        },
-        "path": {
-          "description": "destination path of backup files proper to selected backend",
-          "type": "string"
-        },
-        "status": {
-          "description": "phase of backup creation process",
-          "type": "string",
-          "default": "STARTED",
-          "enum": [
-            "STARTED",
-            "TRANSFERRING",
-            "TRANSFERRED",
-            "SUCCESS",
-            "FAILED"
-          ]
-        }
-      }
-    },
-    "BackupCreateStatusResponse": {
-      "description": "The definition of a backup create metadata",
-      "properties": {
-        "backend": {
-          "description": "Backup backend name e.g. filesystem, gcs, s3.",
-          "type": "string"
-        },
-        "error": {
-          "description": "error message if creation failed",
-          "type": "string"
-        },
-        "id": {
-          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
-          "type": "string"
-        },
-        "path": {
-          "description": "destination path of backup files proper to selected backend",
-          "type": "string"
-        },
-        "status": {
-          "description": "phase of backup creation process",
-          "type": "string",
-          "default": "STARTED",
-          "enum": [
-            "STARTED",
-            "TRANSFERRING",
-            "TRANSFERRED",
-            "SUCCESS",
-            "FAILED"
-          ]
-        }
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
       }
     },
-    "BackupRestoreRequest": {
-      "description": "Request body for restoring a backup for a set of classes",
-      "properties": {
-        "config": {
-          "description": "Custom configuration for the backup restoration process",
-          "type": "object"
-        },
-        "exclude": {
-          "description": "List of classes to exclude from the backup restoration process",
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
-        },
-        "include": {
-          "description": "List of classes to include in the backup restoration process",
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/schema/{className}/references/audit": {
+      "post": {
+        "description": "Scans all reference properties of the objects of a class and reports every beacon pointing at an object which does not exist. If prune is set, the dangling references are removed from their objects.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Find and optionally remove references pointing at nonexistent objects",
+        "operationId": "schema.objects.references.audit",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "Remove the dangling references from their objects. Default value is false.",
+            "name": "prune",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Specifies the tenant to audit, required for multi-tenant classes.",
+            "name": "tenant",
+            "in": "query"
           }
-        }
-      }
-    },
-    "BackupRestoreResponse": {
-      "description": "The definition of a backup restore response body",
-      "properties": {
-        "backend": {
-          "description": "Backup backend name e.g. filesystem, gcs, s3.",
-          "type": "string"
-        },
-        "classes": {
-          "description": "The list of classes for which the backup restoration process was started",
-          "type": "array",
-          "items": {
-            "type": "string"
+        ],
+        "responses": {
+          "200": {
+            "description": "The class has been audited, the result is returned as body",
+            "schema": {
+              "$ref": "#/definitions/ReferenceAudit"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "This class does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid tenant for the class.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
         },
-        "error": {
-          "description": "error message if restoration failed",
-          "type": "string"
-        },
-        "id": {
-          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
-          "type": "string"
-        },
-        "path": {
-          "description": "destination path of backup files proper to selected backend",
-          "type": "string"
-        },
-        "status": {
-          "description": "phase of backup restoration process",
-          "type": "string",
-          "default": "STARTED",
-          "enum": [
-            "STARTED",
-            "TRANSFERRING",
-            "TRANSFERRED",
-            "SUCCESS",
-            "FAILED"
-          ]
-        }
-      }
-    },
-    "BackupRestoreStatusResponse": {
-      "description": "The definition of a backup restore metadata",
-      "properties": {
-        "backend": {
-          "description": "Backup backend name e.g. filesystem, gcs, s3.",
-          "type": "string"
-        },
-        "error": {
-          "description": "error message if restoration failed",
-          "type": "string"
-        },
-        "id": {
-          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
-          "type": "string"
-        },
-        "path": {
-          "description": "destination path of backup files proper to selected backup backend",
-          "type": "string"
-        },
-        "status": {
-          "description": "phase of backup restoration process",
-          "type": "string",
-          "default": "STARTED",
-          "enum": [
-            "STARTED",
-            "TRANSFERRING",
-            "TRANSFERRED",
-            "SUCCESS",
-            "FAILED"
-          ]
-        }
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
       }
     },
-    "BatchDelete": {
-      "type": "object",
-      "properties": {
-        "dryRun": {
-          "description": "If true, objects will not be deleted yet, but merely listed. Defaults to false.",
-          "type": "boolean",
-          "default": false
-        },
-        "match": {
-          "description": "Outlines how to find the objects to be deleted.",
-          "type": "object",
-          "properties": {
-            "class": {
-              "description": "Class (name) which objects will be deleted.",
-              "type": "string",
-              "example": "City"
-            },
-            "where": {
-              "description": "Filter to limit the objects to be deleted.",
-              "type": "object",
-              "$ref": "#/definitions/WhereFilter"
+    "/schema/{className}/shards": {
+      "get": {
+        "tags": [
+          "schema"
+        ],
+        "summary": "Get the shards status of an Object class",
+        "operationId": "schema.objects.shards.get",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Found the status of the shards, returned as body",
+            "schema": {
+              "$ref": "#/definitions/ShardStatusList"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "This class does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           }
         },
-        "output": {
-          "description": "Controls the verbosity of the output, possible values are: \"minimal\", \"verbose\". Defaults to \"minimal\".",
-          "type": "string",
-          "default": "minimal"
-        }
+        "x-serviceIds": [
+          "weaviate.local.get.meta"
+        ]
       }
     },
-    "BatchDeleteResponse": {
-      "description": "Delete Objects response.",
-      "type": "object",
-      "properties": {
-        "dryRun": {
-          "description": "If true, objects will not be deleted yet, but merely listed. Defaults to false.",
-          "type": "boolean",
-          "default": false
-        },
-        "match": {
-          "description": "Outlines how to find the objects to be deleted.",
-          "type": "object",
-          "properties": {
-            "class": {
-              "description": "Class (name) which objects will be deleted.",
-              "type": "string",
-              "example": "City"
-            },
-            "where": {
-              "description": "Filter to limit the objects to be deleted.",
-              "type": "object",
-              "$ref": "#/definitions/WhereFilter"
+    "/schema/{className}/shards/{shardName}": {
+      "put": {
+        "description": "Update shard status of an Object Class",
+        "tags": [
+          "schema"
+        ],
+        "operationId": "schema.objects.shards.update",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "shardName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ShardStatus"
             }
           }
-        },
-        "output": {
-          "description": "Controls the verbosity of the output, possible values are: \"minimal\", \"verbose\". Defaults to \"minimal\".",
-          "type": "string",
-          "default": "minimal"
-        },
-        "results": {
-          "type": "object",
-          "properties": {
-            "failed": {
-              "description": "How many objects should have been deleted but could not be deleted.",
-              "type": "number",
-              "format": "int64",
-              "x-omitempty": false
-            },
-            "limit": {
-              "description": "The most amount of objects that can be deleted in a single query, equals QUERY_MAXIMUM_RESULTS.",
-              "type": "number",
-              "format": "int64",
-              "x-omitempty": false
-            },
-            "matches": {
-              "description": "How many objects were matched by the filter.",
-              "type": "number",
-              "format": "int64",
-              "x-omitempty": false
-            },
-            "objects": {
-              "description": "With output set to \"minimal\" only objects with error occurred will the be described. Successfully deleted objects would be omitted. Output set to \"verbose\" will list all of the objets with their respective statuses.",
-              "type": "array",
-              "items": {
-                "description": "Results for this specific Object.",
-                "format": "object",
-                "properties": {
-                  "errors": {
-                    "$ref": "#/definitions/ErrorResponse"
-                  },
-                  "id": {
-                    "description": "ID of the Object.",
-                    "type": "string",
-                    "format": "uuid"
-                  },
-                  "status": {
-                    "type": "string",
-                    "default": "SUCCESS",
-                    "enum": [
-                      "SUCCESS",
-                      "DRYRUN",
-                      "FAILED"
-                    ]
-                  }
-                }
-              }
-            },
-            "successful": {
-              "description": "How many objects were successfully deleted in this round.",
-              "type": "number",
-              "format": "int64",
-              "x-omitempty": false
+        ],
+        "responses": {
+          "200": {
+            "description": "Shard status was updated successfully",
+            "schema": {
+              "$ref": "#/definitions/ShardStatus"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Shard to be updated does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid update attempt",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           }
-        }
-      }
-    },
-    "BatchReference": {
-      "properties": {
-        "from": {
-          "description": "Long-form beacon-style URI to identify the source of the cross-ref including the property name. Should be in the form of weaviate://localhost/\u003ckinds\u003e/\u003cuuid\u003e/\u003cclassName\u003e/\u003cpropertyName\u003e, where \u003ckinds\u003e must be one of 'objects', 'objects' and \u003cclassName\u003e and \u003cpropertyName\u003e must represent the cross-ref property of source class to be used.",
-          "type": "string",
-          "format": "uri",
-          "example": "weaviate://localhost/Zoo/a5d09582-4239-4702-81c9-92a6e0122bb4/hasAnimals"
-        },
-        "tenant": {
-          "description": "Name of the reference tenant.",
-          "type": "string"
         },
-        "to": {
-          "description": "Short-form URI to point to the cross-ref. Should be in the form of weaviate://localhost/\u003cuuid\u003e for the example of a local cross-ref to an object",
-          "type": "string",
-          "format": "uri",
-          "example": "weaviate://localhost/97525810-a9a5-4eb0-858a-71449aeb007f"
-        }
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
       }
     },
-    "BatchReferenceResponse": {
-      "type": "object",
-      "allOf": [
-        {
-          "$ref": "#/definitions/BatchReference"
-        },
-        {
-          "properties": {
-            "result": {
-              "description": "Results for this specific reference.",
-              "format": "object",
-              "properties": {
-                "errors": {
-                  "$ref": "#/definitions/ErrorResponse"
-                },
-                "status": {
-                  "type": "string",
-                  "default": "SUCCESS",
-                  "enum": [
-                    "SUCCESS",
-                    "PENDING",
-                    "FAILED"
-                  ]
-                }
-              }
+    "/schema/{className}/shards/{shardName}/compact": {
+      "post": {
+        "description": "Forces a full compaction of all LSM buckets and the vector index commit logs of a shard held by this node, e.g. to reclaim disk space after mass deletions, and reports the reclaimed bytes. The shard remains available during the compaction.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Compact the storage of a shard",
+        "operationId": "schema.objects.shards.compact",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "shardName",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The shard has been compacted, the reclaimed space is returned as body",
+            "schema": {
+              "$ref": "#/definitions/ShardCompaction"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "This class does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           }
-        }
-      ]
-    },
-    "BatchStats": {
-      "description": "The summary of a nodes batch queue congestion status.",
-      "properties": {
-        "queueLength": {
-          "description": "How many objects are currently in the batch queue.",
-          "type": "number",
-          "format": "int",
-          "x-omitempty": false
         },
-        "ratePerSecond": {
-          "description": "How many objects are approximately processed from the batch queue per second.",
-          "type": "number",
-          "format": "int",
-          "x-omitempty": false
-        }
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
       }
     },
-    "C11yExtension": {
-      "description": "A resource describing an extension to the contextinoary, containing both the identifier and the definition of the extension",
-      "properties": {
-        "concept": {
-          "description": "The new concept you want to extend. Must be an all-lowercase single word, or a space delimited compound word. Examples: 'foobarium', 'my custom concept'",
-          "type": "string",
-          "example": "foobarium"
-        },
-        "definition": {
-          "description": "A list of space-delimited words or a sentence describing what the custom concept is about. Avoid using the custom concept itself. An Example definition for the custom concept 'foobarium': would be 'a naturally occurring element which can only be seen by programmers'",
-          "type": "string"
+    "/schema/{className}/shards/{shardName}/vector-index/build": {
+      "post": {
+        "description": "Builds the graph of the empty vector index of a shard held by this node in bulk from the vectors of its objects. Classes with the vector index setting indexBuild \"gpu\" defer their inserts until the graph has been built on the GPU, which requires weaviate to be built with cuVS support. The shard has to be READONLY during the build.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Build the vector index of a shard in bulk",
+        "operationId": "schema.objects.shards.vectorIndex.build",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "shardName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Name of the target vector whose index to build. The class-level vector index is used if empty.",
+            "name": "targetVector",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The vector index has been built, the result is returned as body",
+            "schema": {
+              "$ref": "#/definitions/VectorIndexBuild"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "This class does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "weight": {
-          "description": "Weight of the definition of the new concept where 1='override existing definition entirely' and 0='ignore custom definition'. Note that if the custom concept is not present in the contextionary yet, the weight cannot be less than 1.",
-          "type": "number",
-          "format": "float"
-        }
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
       }
     },
-    "C11yNearestNeighbors": {
-      "description": "C11y function to show the nearest neighbors to a word.",
-      "type": "array",
-      "items": {
-        "type": "object",
-        "properties": {
-          "distance": {
-            "type": "number",
-            "format": "float"
+    "/schema/{className}/shards/{shardName}/vector-index/export": {
+      "post": {
+        "description": "Writes the graph and the vectors of the vector index of a shard held by this node to a versioned, portable snapshot file in the snapshot directory configured with PERSISTENCE_VECTOR_INDEX_SNAPSHOT_PATH. The snapshot can be imported into an empty shard of another class or cluster using the same distance metric.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Export the vector index of a shard to a snapshot file",
+        "operationId": "schema.objects.shards.vectorIndex.export",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
           },
-          "word": {
-            "type": "string"
+          {
+            "type": "string",
+            "name": "shardName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Name of the snapshot file within the snapshot directory.",
+            "name": "file",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Name of the target vector whose index to export. The class-level vector index is used if empty.",
+            "name": "targetVector",
+            "in": "query"
           }
-        }
-      }
-    },
-    "C11yVector": {
-      "description": "A Vector in the Contextionary",
-      "type": "array",
-      "items": {
-        "type": "number",
-        "format": "float"
-      }
-    },
-    "C11yVectorBasedQuestion": {
-      "description": "Receive question based on array of classes, properties and values.",
-      "type": "array",
-      "items": {
-        "type": "object",
-        "properties": {
-          "classProps": {
-            "description": "Vectorized properties.",
-            "type": "array",
-            "maxItems": 300,
-            "minItems": 300,
-            "items": {
-              "type": "object",
-              "properties": {
-                "propsVectors": {
-                  "type": "array",
-                  "items": {
-                    "type": "number",
-                    "format": "float"
-                  }
-                },
-                "value": {
-                  "description": "String with valuename.",
-                  "type": "string"
-                }
-              }
+        ],
+        "responses": {
+          "200": {
+            "description": "The snapshot has been written, its description is returned as body",
+            "schema": {
+              "$ref": "#/definitions/VectorIndexSnapshot"
             }
           },
-          "classVectors": {
-            "description": "Vectorized classname.",
-            "type": "array",
-            "maxItems": 300,
-            "minItems": 300,
-            "items": {
-              "type": "number",
-              "format": "float"
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "This class does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Snapshots are disabled or the file name is invalid.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           }
-        }
+        },
+        "x-serviceIds": [
+          "weaviate.local.query"
+        ]
       }
     },
-    "C11yWordsResponse": {
-      "description": "An array of available words and contexts.",
-      "properties": {
-        "concatenatedWord": {
-          "description": "Weighted results for all words",
-          "type": "object",
-          "properties": {
-            "concatenatedNearestNeighbors": {
-              "$ref": "#/definitions/C11yNearestNeighbors"
-            },
-            "concatenatedVector": {
-              "$ref": "#/definitions/C11yVector"
-            },
-            "concatenatedWord": {
-              "type": "string"
-            },
-            "singleWords": {
-              "type": "array",
-              "items": {
-                "format": "string"
-              }
+    "/schema/{className}/shards/{shardName}/vector-index/import": {
+      "post": {
+        "description": "Attaches a portable snapshot file from the snapshot directory configured with PERSISTENCE_VECTOR_INDEX_SNAPSHOT_PATH to the empty vector index of a shard held by this node. This allows indexes built offline, e.g. in a batch pipeline, to be used without re-inserting the vectors. The objects the snapshot refers to are not imported and need to be present in the shard with matching doc ids.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Import a snapshot file into the vector index of a shard",
+        "operationId": "schema.objects.shards.vectorIndex.import",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "shardName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Name of the snapshot file within the snapshot directory.",
+            "name": "file",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Name of the target vector whose index to import. The class-level vector index is used if empty.",
+            "name": "targetVector",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The snapshot has been imported, its description is returned as body",
+            "schema": {
+              "$ref": "#/definitions/VectorIndexSnapshot"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "This class does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Snapshots are disabled or the file name is invalid.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           }
         },
-        "individualWords": {
-          "description": "Weighted results for per individual word",
-          "type": "array",
-          "items": {
-            "type": "object",
-            "properties": {
-              "info": {
-                "type": "object",
-                "properties": {
-                  "nearestNeighbors": {
-                    "$ref": "#/definitions/C11yNearestNeighbors"
-                  },
-                  "vector": {
-                    "$ref": "#/definitions/C11yVector"
-                  }
-                }
-              },
-              "present": {
-                "type": "boolean"
-              },
-              "word": {
-                "type": "string"
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      }
+    },
+    "/schema/{className}/synonyms": {
+      "get": {
+        "description": "Get the synonym rules of an Object class",
+        "tags": [
+          "schema"
+        ],
+        "operationId": "schema.objects.synonyms.get",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Found the synonym rules of the class, returned as body",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/SynonymRule"
               }
             }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "This class does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
-        }
-      }
-    },
-    "Class": {
-      "type": "object",
-      "properties": {
-        "class": {
-          "description": "Name of the class as URI relative to the schema URL.",
-          "type": "string"
-        },
-        "description": {
-          "description": "Description of the class.",
-          "type": "string"
-        },
-        "invertedIndexConfig": {
-          "$ref": "#/definitions/InvertedIndexConfig"
-        },
-        "moduleConfig": {
-          "description": "Configuration specific to modules this Weaviate instance has installed",
-          "type": "object"
-        },
-        "multiTenancyConfig": {
-          "$ref": "#/definitions/MultiTenancyConfig"
         },
-        "properties": {
-          "description": "The properties of the class.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/Property"
+        "x-serviceIds": [
+          "weaviate.local.get.meta"
+        ]
+      },
+      "put": {
+        "description": "Replace the synonym rules of an Object class",
+        "tags": [
+          "schema"
+        ],
+        "operationId": "schema.objects.synonyms.update",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/SynonymRule"
+              }
+            }
           }
-        },
-        "replicationConfig": {
-          "$ref": "#/definitions/ReplicationConfig"
-        },
-        "shardingConfig": {
-          "description": "Manage how the index should be sharded and distributed in the cluster",
-          "type": "object"
-        },
-        "vectorIndexConfig": {
-          "description": "Vector-index config, that is specific to the type of index selected in vectorIndexType",
-          "type": "object"
-        },
-        "vectorIndexType": {
-          "description": "Name of the vector index to use, eg. (HNSW)",
-          "type": "string"
-        },
-        "vectorizer": {
-          "description": "Specify how the vectors for this class should be determined. The options are either 'none' - this means you have to import a vector with each object yourself - or the name of a module that provides vectorization capabilities, such as 'text2vec-contextionary'. If left empty, it will use the globally configured default which can itself either be 'none' or a specific module.",
-          "type": "string"
-        }
-      }
-    },
-    "Classification": {
-      "description": "Manage classifications, trigger them and view status of past classifications.",
-      "type": "object",
-      "properties": {
-        "basedOnProperties": {
-          "description": "base the text-based classification on these fields (of type text)",
-          "type": "array",
-          "items": {
-            "type": "string"
+        ],
+        "responses": {
+          "200": {
+            "description": "Replaced the synonym rules of the class",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/SynonymRule"
+              }
+            }
           },
-          "example": [
-            "description"
-          ]
-        },
-        "class": {
-          "description": "class (name) which is used in this classification",
-          "type": "string",
-          "example": "City"
-        },
-        "classifyProperties": {
-          "description": "which ref-property to set as part of the classification",
-          "type": "array",
-          "items": {
-            "type": "string"
+          "401": {
+            "description": "Unauthorized or invalid credentials."
           },
-          "example": [
-            "inCountry"
-          ]
-        },
-        "error": {
-          "description": "error message if status == failed",
-          "type": "string",
-          "default": "",
-          "example": "classify xzy: something went wrong"
-        },
-        "filters": {
-          "type": "object",
-          "properties": {
-            "sourceWhere": {
-              "description": "limit the objects to be classified",
-              "type": "object",
-              "$ref": "#/definitions/WhereFilter"
-            },
-            "targetWhere": {
-              "description": "Limit the possible sources when using an algorithm which doesn't really on training data, e.g. 'contextual'. When using an algorithm with a training set, such as 'knn', limit the training set instead",
-              "type": "object",
-              "$ref": "#/definitions/WhereFilter"
-            },
-            "trainingSetWhere": {
-              "description": "Limit the training objects to be considered during the classification. Can only be used on types with explicit training sets, such as 'knn'",
-              "type": "object",
-              "$ref": "#/definitions/WhereFilter"
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "This class does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid synonym rules",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           }
         },
-        "id": {
-          "description": "ID to uniquely identify this classification run",
-          "type": "string",
-          "format": "uuid",
-          "example": "ee722219-b8ec-4db1-8f8d-5150bb1a9e0c"
-        },
-        "meta": {
-          "description": "additional meta information about the classification",
-          "type": "object",
-          "$ref": "#/definitions/ClassificationMeta"
-        },
-        "settings": {
-          "description": "classification-type specific settings",
-          "type": "object"
-        },
-        "status": {
-          "description": "status of this classification",
-          "type": "string",
-          "enum": [
-            "running",
-            "completed",
-            "failed"
-          ],
-          "example": "running"
-        },
-        "type": {
-          "description": "which algorithm to use for classifications",
-          "type": "string"
-        }
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
       }
     },
-    "ClassificationMeta": {
-      "description": "Additional information to a specific classification",
-      "type": "object",
-      "properties": {
-        "completed": {
-          "description": "time when this classification finished",
-          "type": "string",
-          "format": "date-time",
-          "example": "2017-07-21T17:32:28Z"
-        },
-        "count": {
-          "description": "number of objects which were taken into consideration for classification",
-          "type": "integer",
-          "example": 147
-        },
-        "countFailed": {
-          "description": "number of objects which could not be classified - see error message for details",
-          "type": "integer",
-          "example": 7
-        },
-        "countSucceeded": {
-          "description": "number of objects successfully classified",
-          "type": "integer",
-          "example": 140
-        },
-        "started": {
-          "description": "time when this classification was started",
-          "type": "string",
-          "format": "date-time",
-          "example": "2017-07-21T17:32:28Z"
-        }
-      }
-    },
-    "Deprecation": {
-      "type": "object",
-      "properties": {
-        "apiType": {
-          "description": "Describes which API is effected, usually one of: REST, GraphQL",
-          "type": "string"
-        },
-        "id": {
-          "description": "The id that uniquely identifies this particular deprecations (mostly used internally)",
-          "type": "string"
-        },
-        "locations": {
-          "description": "The locations within the specified API affected by this deprecation",
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/schema/{className}/tenants": {
+      "get": {
+        "description": "get all tenants from a specific class",
+        "tags": [
+          "schema"
+        ],
+        "operationId": "tenants.get",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
           }
-        },
-        "mitigation": {
-          "description": "User-required object to not be affected by the (planned) removal",
-          "type": "string"
-        },
-        "msg": {
-          "description": "What this deprecation is about",
-          "type": "string"
-        },
-        "plannedRemovalVersion": {
-          "description": "A best-effort guess of which upcoming version will remove the feature entirely",
-          "type": "string"
-        },
-        "removedIn": {
-          "description": "If the feature has already been removed, it was removed in this version",
-          "type": "string",
-          "x-nullable": true
-        },
-        "removedTime": {
-          "description": "If the feature has already been removed, it was removed at this timestamp",
-          "type": "string",
-          "format": "date-time",
-          "x-nullable": true
-        },
-        "sinceTime": {
-          "description": "The deprecation was introduced in this version",
-          "type": "string",
-          "format": "date-time"
-        },
-        "sinceVersion": {
-          "description": "The deprecation was introduced in this version",
-          "type": "string"
-        },
-        "status": {
-          "description": "Whether the problematic API functionality is deprecated (planned to be removed) or already removed",
-          "type": "string"
-        }
-      }
-    },
-    "ErrorResponse": {
-      "description": "An error response given by Weaviate end-points.",
-      "type": "object",
-      "properties": {
-        "error": {
-          "type": "array",
-          "items": {
-            "type": "object",
-            "properties": {
-              "message": {
-                "type": "string"
+        ],
+        "responses": {
+          "200": {
+            "description": "tenants from specified class.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Tenant"
               }
             }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid Tenant class",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
         }
-      }
-    },
-    "GeoCoordinates": {
-      "properties": {
-        "latitude": {
-          "description": "The latitude of the point on earth in decimal form",
-          "type": "number",
-          "format": "float",
-          "x-nullable": true
-        },
-        "longitude": {
-          "description": "The longitude of the point on earth in decimal form",
-          "type": "number",
-          "format": "float",
-          "x-nullable": true
-        }
-      }
-    },
-    "GraphQLError": {
-      "description": "An error response caused by a GraphQL query.",
-      "properties": {
-        "locations": {
-          "type": "array",
-          "items": {
-            "type": "object",
-            "properties": {
-              "column": {
-                "type": "integer",
-                "format": "int64"
-              },
-              "line": {
-                "type": "integer",
-                "format": "int64"
+      },
+      "put": {
+        "description": "Update tenant of a specific class",
+        "tags": [
+          "schema"
+        ],
+        "operationId": "tenants.update",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Tenant"
               }
             }
           }
-        },
-        "message": {
-          "type": "string"
-        },
-        "path": {
-          "type": "array",
-          "items": {
-            "type": "string"
+        ],
+        "responses": {
+          "200": {
+            "description": "Updated tenants of the specified class",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Tenant"
+              }
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid Tenant class",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
         }
-      }
-    },
-    "GraphQLQueries": {
-      "description": "A list of GraphQL queries.",
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/GraphQLQuery"
-      }
-    },
-    "GraphQLQuery": {
-      "description": "GraphQL query based on: http://facebook.github.io/graphql/.",
-      "type": "object",
-      "properties": {
-        "operationName": {
-          "description": "The name of the operation if multiple exist in the query.",
-          "type": "string"
-        },
-        "query": {
-          "description": "Query based on GraphQL syntax.",
-          "type": "string"
-        },
-        "variables": {
-          "description": "Additional variables for the query.",
-          "type": "object"
-        }
-      }
-    },
-    "GraphQLResponse": {
-      "description": "GraphQL based response: http://facebook.github.io/graphql/.",
-      "properties": {
-        "data": {
-          "description": "GraphQL data object.",
-          "type": "object",
-          "additionalProperties": {
-            "$ref": "#/definitions/JsonObject"
+      },
+      "post": {
+        "description": "Create a new tenant for a specific class",
+        "tags": [
+          "schema"
+        ],
+        "operationId": "tenants.create",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Tenant"
+              }
+            }
           }
-        },
-        "errors": {
-          "description": "Array with errors.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/GraphQLError"
+        ],
+        "responses": {
+          "200": {
+            "description": "Added new tenants to the specified class",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Tenant"
+              }
+            }
           },
-          "x-omitempty": true
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid Tenant class",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      },
+      "delete": {
+        "description": "delete tenants from a specific class",
+        "tags": [
+          "schema"
+        ],
+        "operationId": "tenants.delete",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "tenants",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Deleted tenants from specified class."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid Tenant class",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         }
       }
     },
-    "GraphQLResponses": {
-      "description": "A list of GraphQL responses.",
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/GraphQLResponse"
+    "/schema/{className}/vector-index/integrity": {
+      "post": {
+        "description": "Checks the HNSW graphs of the shards of a class on this node for orphan nodes, broken links and nodes which cannot be reached from the entrypoint. If repair is set, broken links are removed, an invalid entrypoint is replaced and unreachable nodes are reconnected. Only the shards held by the node receiving the request are checked.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Validate and optionally repair the vector index graphs of a class",
+        "operationId": "schema.objects.vectorIndex.integrity",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "Repair the damaged portions of the graphs. Default value is false.",
+            "name": "repair",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The vector indexes have been checked, the result is returned as body",
+            "schema": {
+              "$ref": "#/definitions/VectorIndexIntegrityReport"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "This class does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
       }
     },
-    "InvertedIndexConfig": {
-      "description": "Configure the inverted index built into Weaviate",
-      "type": "object",
-      "properties": {
-        "bm25": {
-          "$ref": "#/definitions/BM25Config"
-        },
-        "cleanupIntervalSeconds": {
-          "description": "Asynchronous index clean up happens every n seconds",
-          "type": "number",
-          "format": "int"
-        },
-        "indexNullState": {
-          "description": "Index each object with the null state",
-          "type": "boolean"
-        },
-        "indexPropertyLength": {
-          "description": "Index length of properties",
-          "type": "boolean"
-        },
-        "indexTimestamps": {
-          "description": "Index each object by its internal timestamps",
-          "type": "boolean"
+    "/schema/{className}/vector-index/recall": {
+      "post": {
+        "description": "Samples indexed vectors of the shards of a class on this node and uses them as queries. The results of a regular search with the current ef settings are compared to those of an exact search to report the measured recall@k, e.g. to verify the health of an index after compression or large deletes. Only the shards held by the node receiving the request are measured.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Measure the recall of the vector indexes of a class",
+        "operationId": "schema.objects.vectorIndex.recall",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "default": 100,
+            "description": "The number of indexed vectors per shard to use as queries. Default value is 100.",
+            "name": "sampleSize",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "default": 10,
+            "description": "The number of results per query to compute the recall for. Default value is 10.",
+            "name": "k",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The recall has been measured, the result is returned as body",
+            "schema": {
+              "$ref": "#/definitions/VectorIndexRecallReport"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "This class does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid sample size or k.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "stopwords": {
-          "$ref": "#/definitions/StopwordConfig"
-        }
+        "x-serviceIds": [
+          "weaviate.local.query"
+        ]
       }
     },
-    "JsonObject": {
-      "description": "JSON object value.",
-      "type": "object"
-    },
-    "Link": {
-      "type": "object",
-      "properties": {
-        "documentationHref": {
-          "description": "weaviate documentation about this resource group",
-          "type": "string"
-        },
-        "href": {
-          "description": "target of the link",
-          "type": "string"
-        },
-        "name": {
-          "description": "human readable name of the resource group",
-          "type": "string"
+    "/schema/{className}/vector-index/tombstone-cleanup": {
+      "get": {
+        "description": "Returns per shard and target vector whether the scheduled cleanup of deleted nodes is paused, how many deleted nodes remain and how fast the last cleanup cycle was. Only the shards held by the node receiving the request are reported.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Get the state of the tombstone cleanup of the vector indexes of a class",
+        "operationId": "schema.objects.vectorIndex.tombstoneCleanup",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The state of the tombstone cleanup is returned as body",
+            "schema": {
+              "$ref": "#/definitions/VectorIndexTombstoneCleanupReport"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "This class does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "rel": {
-          "description": "relationship if both resources are related, e.g. 'next', 'previous', 'parent', etc.",
-          "type": "string"
-        }
+        "x-serviceIds": [
+          "weaviate.local.get.meta"
+        ]
       }
     },
-    "Meta": {
-      "description": "Contains meta information of the current Weaviate instance.",
-      "type": "object",
-      "properties": {
-        "hostname": {
-          "description": "The url of the host.",
-          "type": "string",
-          "format": "url"
-        },
-        "modules": {
-          "description": "Module-specific meta information",
-          "type": "object"
+    "/schema/{className}/vector-index/tombstone-cleanup/pause": {
+      "post": {
+        "description": "Stops scheduling cleanup cycles for deleted nodes in the vector indexes of the shards of a class on this node. A running cycle is aborted. Deletes keep working, deleted nodes accumulate until the cleanup is resumed. The pause is not persisted and ends when the shard is reloaded.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Pause the tombstone cleanup of the vector indexes of a class",
+        "operationId": "schema.objects.vectorIndex.tombstoneCleanupPause",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The tombstone cleanup has been paused, the new state is returned as body",
+            "schema": {
+              "$ref": "#/definitions/VectorIndexTombstoneCleanupReport"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "This class does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "version": {
-          "description": "Version of weaviate you are currently running",
-          "type": "string"
-        }
-      }
-    },
-    "MultiTenancyConfig": {
-      "description": "Configuration related to multi-tenancy within a class",
-      "properties": {
-        "enabled": {
-          "description": "Whether or not multi-tenancy is enabled for this class",
-          "type": "boolean",
-          "x-omitempty": false
-        }
-      }
-    },
-    "MultipleRef": {
-      "description": "Multiple instances of references to other objects.",
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/SingleRef"
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
       }
     },
-    "NodeShardStatus": {
-      "description": "The definition of a node shard status response body",
-      "properties": {
-        "class": {
-          "description": "The name of shard's class.",
-          "type": "string",
-          "x-omitempty": false
-        },
-        "name": {
-          "description": "The name of the shard.",
-          "type": "string",
-          "x-omitempty": false
+    "/schema/{className}/vector-index/tombstone-cleanup/resume": {
+      "post": {
+        "description": "Resumes scheduling cleanup cycles for deleted nodes in the vector indexes of the shards of a class on this node.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Resume the tombstone cleanup of the vector indexes of a class",
+        "operationId": "schema.objects.vectorIndex.tombstoneCleanupResume",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The tombstone cleanup has been resumed, the new state is returned as body",
+            "schema": {
+              "$ref": "#/definitions/VectorIndexTombstoneCleanupReport"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "This class does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "objectCount": {
-          "description": "The number of objects in shard.",
-          "type": "number",
-          "format": "int64",
-          "x-omitempty": false
-        }
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      }
+    }
+  },
+  "definitions": {
+    "AdditionalProperties": {
+      "description": "Additional Meta information about a single object object.",
+      "type": "object",
+      "additionalProperties": {
+        "type": "object"
       }
     },
-    "NodeStats": {
-      "description": "The summary of Weaviate's statistics.",
+    "BM25Config": {
+      "description": "tuning parameters for the BM25 algorithm",
+      "type": "object",
       "properties": {
-        "objectCount": {
-          "description": "The total number of objects in DB.",
+        "b": {
+          "description": "calibrates term-weight scaling based on the document length",
           "type": "number",
-          "format": "int64",
-          "x-omitempty": false
+          "format": "float"
         },
-        "shardCount": {
-          "description": "The count of Weaviate's shards.",
+        "k1": {
+          "description": "calibrates term-weight scaling based on the term frequency within a document",
           "type": "number",
-          "format": "int",
-          "x-omitempty": false
+          "format": "float"
+        },
+        "propertyBoosts": {
+          "description": "default weights of properties in keyword searches by property name. A weight set in the query takes precedence.",
+          "type": "object",
+          "additionalProperties": {
+            "type": "number",
+            "format": "float"
+          }
         }
       }
     },
-    "NodeStatus": {
-      "description": "The definition of a backup node status response body",
+    "BackupCreateRequest": {
+      "description": "Request body for creating a backup of a set of classes",
       "properties": {
-        "batchStats": {
-          "description": "Weaviate batch statistics.",
-          "type": "object",
-          "$ref": "#/definitions/BatchStats"
+        "config": {
+          "description": "Custom configuration for the backup creation process",
+          "type": "object"
         },
-        "gitHash": {
-          "description": "The gitHash of Weaviate.",
-          "type": "string"
+        "exclude": {
+          "description": "List of classes to exclude from the backup creation process",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "name": {
-          "description": "The name of the node.",
+        "id": {
+          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
           "type": "string"
         },
-        "shards": {
-          "description": "The list of the shards with it's statistics.",
+        "include": {
+          "description": "List of classes to include in the backup creation process",
           "type": "array",
           "items": {
-            "$ref": "#/definitions/NodeShardStatus"
+            "type": "string"
           }
-        },
-        "stats": {
-          "description": "Weaviate overall statistics.",
-          "type": "object",
-          "$ref": "#/definitions/NodeStats"
-        },
-        "status": {
-          "description": "Node's status.",
-          "type": "string",
-          "default": "HEALTHY",
-          "enum": [
-            "HEALTHY",
-            "UNHEALTHY",
-            "UNAVAILABLE"
-          ]
-        },
-        "version": {
-          "description": "The version of Weaviate.",
-          "type": "string"
         }
       }
     },
-    "NodesStatusResponse": {
-      "description": "The status of all of the Weaviate nodes",
-      "type": "object",
+    "BackupCreateResponse": {
+      "description": "The definition of a backup create response body",
       "properties": {
-        "nodes": {
+        "backend": {
+          "description": "Backup backend name e.g. filesystem, gcs, s3.",
+          "type": "string"
+        },
+        "classes": {
+          "description": "The list of classes for which the backup creation process was started",
           "type": "array",
           "items": {
-            "$ref": "#/definitions/NodeStatus"
+            "type": "string"
           }
-        }
-      }
-    },
-    "Object": {
-      "type": "object",
-      "properties": {
-        "additional": {
-          "$ref": "#/definitions/AdditionalProperties"
         },
-        "class": {
-          "description": "Class of the Object, defined in the schema.",
+        "error": {
+          "description": "error message if creation failed",
           "type": "string"
         },
-        "creationTimeUnix": {
-          "description": "Timestamp of creation of this Object in milliseconds since epoch UTC.",
-          "type": "integer",
-          "format": "int64"
-        },
         "id": {
-          "description": "ID of the Object.",
-          "type": "string",
-          "format": "uuid"
-        },
-        "lastUpdateTimeUnix": {
-          "description": "Timestamp of the last Object update in milliseconds since epoch UTC.",
-          "type": "integer",
-          "format": "int64"
-        },
-        "properties": {
-          "$ref": "#/definitions/PropertySchema"
-        },
-        "tenant": {
-          "description": "Name of the Objects tenant.",
+          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
           "type": "string"
         },
-        "vector": {
-          "description": "This object's position in the Contextionary vector space. Read-only if using a vectorizer other than 'none'. Writable and required if using 'none' as vectorizer.",
-          "$ref": "#/definitions/C11yVector"
+        "path": {
+          "description": "destination path of backup files proper to selected backend",
+          "type": "string"
         },
-        "vectorWeights": {
-          "$ref": "#/definitions/VectorWeights"
+        "status": {
+          "description": "phase of backup creation process",
+          "type": "string",
+          "default": "STARTED",
+          "enum": [
+            "STARTED",
+            "TRANSFERRING",
+            "TRANSFERRED",
+            "SUCCESS",
+            "FAILED"
+          ]
         }
       }
     },
-    "ObjectsGetResponse": {
-      "type": "object",
-      "allOf": [
-        {
-          "$ref": "#/definitions/Object"
+    "BackupCreateStatusResponse": {
+      "description": "The definition of a backup create metadata",
+      "properties": {
+        "backend": {
+          "description": "Backup backend name e.g. filesystem, gcs, s3.",
+          "type": "string"
         },
-        {
-          "properties": {
-            "deprecations": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/Deprecation"
-              }
-            }
-          }
+        "error": {
+          "description": "error message if creation failed",
+          "type": "string"
         },
-        {
-          "properties": {
-            "result": {
-              "description": "Results for this specific Object.",
-              "format": "object",
-              "properties": {
-                "errors": {
-                  "$ref": "#/definitions/ErrorResponse"
-                },
-                "status": {
-                  "type": "string",
-                  "default": "SUCCESS",
-                  "enum": [
-                    "SUCCESS",
-                    "PENDING",
-                    "FAILED"
-                  ]
-                }
-              }
-            }
-          }
+        "id": {
+          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+          "type": "string"
+        },
+        "path": {
+          "description": "destination path of backup files proper to selected backend",
+          "type": "string"
+        },
+        "status": {
+          "description": "phase of backup creation process",
+          "type": "string",
+          "default": "STARTED",
+          "enum": [
+            "STARTED",
+            "TRANSFERRING",
+            "TRANSFERRED",
+            "SUCCESS",
+            "FAILED"
+          ]
         }
-      ]
+      }
     },
-    "ObjectsListResponse": {
-      "description": "List of Objects.",
-      "type": "object",
+    "BackupRestoreRequest": {
+      "description": "Request body for restoring a backup for a set of classes",
       "properties": {
-        "deprecations": {
+        "config": {
+          "description": "Custom configuration for the backup restoration process",
+          "type": "object"
+        },
+        "exclude": {
+          "description": "List of classes to exclude from the backup restoration process",
           "type": "array",
           "items": {
-            "$ref": "#/definitions/Deprecation"
+            "type": "string"
           }
         },
-        "objects": {
-          "description": "The actual list of Objects.",
+        "include": {
+          "description": "List of classes to include in the backup restoration process",
           "type": "array",
           "items": {
-            "$ref": "#/definitions/Object"
+            "type": "string"
           }
-        },
-        "totalResults": {
-          "description": "The total number of Objects for the query. The number of items in a response may be smaller due to paging.",
-          "type": "integer",
-          "format": "int64"
         }
       }
     },
-    "PatchDocumentAction": {
-      "description": "Either a JSONPatch document as defined by RFC 6902 (from, op, path, value), or a merge document (RFC 7396).",
-      "required": [
-        "op",
-        "path"
-      ],
+    "BackupRestoreResponse": {
+      "description": "The definition of a backup restore response body",
       "properties": {
-        "from": {
-          "description": "A string containing a JSON Pointer value.",
+        "backend": {
+          "description": "Backup backend name e.g. filesystem, gcs, s3.",
           "type": "string"
         },
-        "merge": {
-          "$ref": "#/definitions/Object"
+        "classes": {
+          "description": "The list of classes for which the backup restoration process was started",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "op": {
-          "description": "The operation to be performed.",
-          "type": "string",
-          "enum": [
-            "add",
-            "remove",
-            "replace",
-            "move",
-            "copy",
-            "test"
-          ]
+        "error": {
+          "description": "error message if restoration failed",
+          "type": "string"
+        },
+        "id": {
+          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+          "type": "string"
         },
         "path": {
-          "description": "A JSON-Pointer.",
+          "description": "destination path of backup files proper to selected backend",
           "type": "string"
         },
-        "value": {
-          "description": "The value to be used within the operations.",
-          "type": "object"
+        "status": {
+          "description": "phase of backup restoration process",
+          "type": "string",
+          "default": "STARTED",
+          "enum": [
+            "STARTED",
+            "TRANSFERRING",
+            "TRANSFERRED",
+            "SUCCESS",
+            "FAILED"
+          ]
         }
       }
     },
-    "PatchDocumentObject": {
-      "description": "Either a JSONPatch document as defined by RFC 6902 (from, op, path, value), or a merge document (RFC 7396).",
-      "required": [
-        "op",
-        "path"
-      ],
+    "BackupRestoreStatusResponse": {
+      "description": "The definition of a backup restore metadata",
       "properties": {
-        "from": {
-          "description": "A string containing a JSON Pointer value.",
+        "backend": {
+          "description": "Backup backend name e.g. filesystem, gcs, s3.",
           "type": "string"
         },
-        "merge": {
-          "$ref": "#/definitions/Object"
+        "error": {
+          "description": "error message if restoration failed",
+          "type": "string"
         },
-        "op": {
-          "description": "The operation to be performed.",
-          "type": "string",
-          "enum": [
-            "add",
-            "remove",
-            "replace",
-            "move",
-            "copy",
-            "test"
-          ]
+        "id": {
+          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+          "type": "string"
         },
         "path": {
-          "description": "A JSON-Pointer.",
+          "description": "destination path of backup files proper to selected backup backend",
           "type": "string"
         },
-        "value": {
-          "description": "The value to be used within the operations.",
-          "type": "object"
+        "status": {
+          "description": "phase of backup restoration process",
+          "type": "string",
+          "default": "STARTED",
+          "enum": [
+            "STARTED",
+            "TRANSFERRING",
+            "TRANSFERRED",
+            "SUCCESS",
+            "FAILED"
+          ]
         }
       }
     },
-    "PeerUpdate": {
-      "description": "A single peer in the network.",
+    "BatchDelete": {
+      "type": "object",
       "properties": {
-        "id": {
-          "description": "The session ID of the peer.",
-          "type": "string",
-          "format": "uuid"
-        },
-        "name": {
-          "description": "Human readable name.",
-          "type": "string"
+        "dryRun": {
+          "description": "If true, objects will not be deleted yet, but merely listed. Defaults to false.",
+          "type": "boolean",
+          "default": false
         },
-        "schemaHash": {
-          "description": "The latest known hash of the peer's schema.",
-          "type": "string"
+        "match": {
+          "description": "Outlines how to find the objects to be deleted.",
+          "type": "object",
+          "properties": {
+            "class": {
+              "description": "Class (name) which objects will be deleted.",
+              "type": "string",
+              "example": "City"
+            },
+            "where": {
+              "description": "Filter to limit the objects to be deleted.",
+              "type": "object",
+              "$ref": "#/definitions/WhereFilter"
+            }
+          }
         },
-        "uri": {
-          "description": "The location where the peer is exposed to the internet.",
+        "output": {
+          "description": "Controls the verbosity of the output, possible values are: \"minimal\", \"verbose\". Defaults to \"minimal\".",
           "type": "string",
-          "format": "uri"
+          "default": "minimal"
         }
       }
     },
-    "PeerUpdateList": {
-      "description": "List of known peers.",
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/PeerUpdate"
+    "BatchDeleteResponse": {
+      "description": "Delete Objects response.",
+      "type": "object",
+      "properties": {
+        "dryRun": {
+          "description": "If true, objects will not be deleted yet, but merely listed. Defaults to false.",
+          "type": "boolean",
+          "default": false
+        },
+        "match": {
+          "description": "Outlines how to find the objects to be deleted.",
+          "type": "object",
+          "properties": {
+            "class": {
+              "description": "Class (name) which objects will be deleted.",
+              "type": "string",
+              "example": "City"
+            },
+            "where": {
+              "description": "Filter to limit the objects to be deleted.",
+              "type": "object",
+              "$ref": "#/definitions/WhereFilter"
+            }
+          }
+        },
+        "output": {
+          "description": "Controls the verbosity of the output, possible values are: \"minimal\", \"verbose\". Defaults to \"minimal\".",
+          "type": "string",
+          "default": "minimal"
+        },
+        "results": {
+          "type": "object",
+          "properties": {
+            "failed": {
+              "description": "How many objects should have been deleted but could not be deleted.",
+              "type": "number",
+              "format": "int64",
+              "x-omitempty": false
+            },
+            "limit": {
+              "description": "The most amount of objects that can be deleted in a single query, equals QUERY_MAXIMUM_RESULTS.",
+              "type": "number",
+              "format": "int64",
+              "x-omitempty": false
+            },
+            "matches": {
+              "description": "How many objects were matched by the filter.",
+              "type": "number",
+              "format": "int64",
+              "x-omitempty": false
+            },
+            "objects": {
+              "description": "With output set to \"minimal\" only objects with error occurred will the be described. Successfully deleted objects would be omitted. Output set to \"verbose\" will list all of the objets with their respective statuses.",
+              "type": "array",
+              "items": {
+                "description": "Results for this specific Object.",
+                "format": "object",
+                "properties": {
+                  "errors": {
+                    "$ref": "#/definitions/ErrorResponse"
+                  },
+                  "id": {
+                    "description": "ID of the Object.",
+                    "type": "string",
+                    "format": "uuid"
+                  },
+                  "status": {
+                    "type": "string",
+                    "default": "SUCCESS",
+                    "enum": [
+                      "SUCCESS",
+                      "DRYRUN",
+                      "FAILED"
+                    ]
+                  }
+                }
+              }
+            },
+            "successful": {
+              "description": "How many objects were successfully deleted in this round.",
+              "type": "number",
+              "format": "int64",
+              "x-omitempty": false
+            }
+          }
+        }
       }
     },
-    "PhoneNumber": {
+    "BatchReference": {
       "properties": {
-        "countryCode": {
-          "description": "Read-only. The numerical country code (e.g. 49)",
-          "type": "number",
-          "format": "uint64"
-        },
-        "defaultCountry": {
-          "description": "Optional. The ISO 3166-1 alpha-2 country code. This is used to figure out the correct countryCode and international format if only a national number (e.g. 0123 4567) is provided",
-          "type": "string"
+        "from": {
+          "description": "Long-form beacon-style URI to identify the source of the cross-ref including the property name. Should be in the form of weaviate://localhost/\u003ckinds\u003e/\u003cuuid\u003e/\u003cclassName\u003e/\u003cpropertyName\u003e, where \u003ckinds\u003e must be one of 'objects', 'objects' and \u003cclassName\u003e and \u003cpropertyName\u003e must represent the cross-ref property of source class to be used.",
+          "type": "string",
+          "format": "uri",
+          "example": "weaviate://localhost/Zoo/a5d09582-4239-4702-81c9-92a6e0122bb4/hasAnimals"
         },
-        "input": {
-          "description": "The raw input as the phone number is present in your raw data set. It will be parsed into the standardized formats if valid.",
+        "tenant": {
+          "description": "Name of the reference tenant.",
           "type": "string"
         },
-        "internationalFormatted": {
-          "description": "Read-only. Parsed result in the international format (e.g. +49 123 ...)",
-          "type": "string"
+        "to": {
+          "description": "Short-form URI to point to the cross-ref. Should be in the form of weaviate://localhost/\u003cuuid\u003e for the example of a local cross-ref to an object",
+          "type": "string",
+          "format": "uri",
+          "example": "weaviate://localhost/97525810-a9a5-4eb0-858a-71449aeb007f"
+        }
+      }
+    },
+    "BatchReferenceResponse": {
+      "type": "object",
+      "allOf": [
+        {
+          "$ref": "#/definitions/BatchReference"
         },
-        "national": {
-          "description": "Read-only. The numerical representation of the national part",
+        {
+          "properties": {
+            "result": {
+              "description": "Results for this specific reference.",
+              "format": "object",
+              "properties": {
+                "errors": {
+                  "$ref": "#/definitions/ErrorResponse"
+                },
+                "status": {
+                  "type": "string",
+                  "default": "SUCCESS",
+                  "enum": [
+                    "SUCCESS",
+                    "PENDING",
+                    "FAILED"
+                  ]
+                }
+              }
+            }
+          }
+        }
+      ]
+    },
+    "BatchStats": {
+      "description": "The summary of a nodes batch queue congestion status.",
+      "properties": {
+        "queueLength": {
+          "description": "How many objects are currently in the batch queue.",
           "type": "number",
-          "format": "uint64"
+          "format": "int",
+          "x-omitempty": false
         },
-        "nationalFormatted": {
-          "description": "Read-only. Parsed result in the national format (e.g. 0123 456789)",
+        "ratePerSecond": {
+          "description": "How many objects are approximately processed from the batch queue per second.",
+          "type": "number",
+          "format": "int",
+          "x-omitempty": false
+        }
+      }
+    },
+    "BucketDiskUsage": {
+      "description": "The disk space used by the segments of an lsm bucket.",
+      "properties": {
+        "bucket": {
+          "description": "The name of the bucket.",
           "type": "string"
         },
-        "valid": {
-          "description": "Read-only. Indicates whether the parsed number is a valid phone number",
-          "type": "boolean"
+        "bytes": {
+          "description": "The size of the segments of the bucket in bytes.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
         }
       }
     },
-    "Principal": {
-      "type": "object",
+    "C11yExtension": {
+      "description": "A resource describing an extension to the contextinoary, containing both the identifier and the definition of the extension",
       "properties": {
-        "groups": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
+        "concept": {
+          "description": "The new concept you want to extend. Must be an all-lowercase single word, or a space delimited compound word. Examples: 'foobarium', 'my custom concept'",
+          "type": "string",
+          "example": "foobarium"
         },
-        "username": {
-          "description": "The username that was extracted either from the authentication information",
+        "definition": {
+          "description": "A list of space-delimited words or a sentence describing what the custom concept is about. Avoid using the custom concept itself. An Example definition for the custom concept 'foobarium': would be 'a naturally occurring element which can only be seen by programmers'",
           "type": "string"
+        },
+        "weight": {
+          "description": "Weight of the definition of the new concept where 1='override existing definition entirely' and 0='ignore custom definition'. Note that if the custom concept is not present in the contextionary yet, the weight cannot be less than 1.",
+          "type": "number",
+          "format": "float"
         }
       }
     },
-    "Property": {
-      "type": "object",
+    "C11yNearestNeighbors": {
+      "description": "C11y function to show the nearest neighbors to a word.",
+      "type": "array",
+      "items": {
+        "type": "object",
+        "properties": {
+          "distance": {
+            "type": "number",
+            "format": "float"
+          },
+          "word": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "C11yVector": {
+      "description": "A Vector in the Contextionary",
+      "type": "array",
+      "items": {
+        "type": "number",
+        "format": "float"
+      }
+    },
+    "C11yVectorBasedQuestion": {
+      "description": "Receive question based on array of classes, properties and values.",
+      "type": "array",
+      "items": {
+        "type": "object",
+        "properties": {
+          "classProps": {
+            "description": "Vectorized properties.",
+            "type": "array",
+            "maxItems": 300,
+            "minItems": 300,
+            "items": {
+              "type": "object",
+              "properties": {
+                "propsVectors": {
+                  "type": "array",
+                  "items": {
+                    "type": "number",
+                    "format": "float"
+                  }
+                },
+                "value": {
+                  "description": "String with valuename.",
+                  "type": "string"
+                }
+              }
+            }
+          },
+          "classVectors": {
+            "description": "Vectorized classname.",
+            "type": "array",
+            "maxItems": 300,
+            "minItems": 300,
+            "items": {
+              "type": "number",
+              "format": "float"
+            }
+          }
+        }
+      }
+    },
+    "C11yWordsResponse": {
+      "description": "An array of available words and contexts.",
       "properties": {
-        "dataType": {
-          "description": "Can be a reference to another type when it starts with a capital (for example Person), otherwise \"string\" or \"int\".",
+        "concatenatedWord": {
+          "description": "Weighted results for all words",
+          "type": "object",
+          "properties": {
+            "concatenatedNearestNeighbors": {
+              "$ref": "#/definitions/C11yNearestNeighbors"
+            },
+            "concatenatedVector": {
+              "$ref": "#/definitions/C11yVector"
+            },
+            "concatenatedWord": {
+              "type": "string"
+            },
+            "singleWords": {
+              "type": "array",
+              "items": {
+                "format": "string"
+              }
+            }
+          }
+        },
+        "individualWords": {
+          "description": "Weighted results for per individual word",
           "type": "array",
           "items": {
-            "type": "string"
+            "type": "object",
+            "properties": {
+              "info": {
+                "type": "object",
+                "properties": {
+                  "nearestNeighbors": {
+                    "$ref": "#/definitions/C11yNearestNeighbors"
+                  },
+                  "vector": {
+                    "$ref": "#/definitions/C11yVector"
+                  }
+                }
+              },
+              "present": {
+                "type": "boolean"
+              },
+              "word": {
+                "type": "string"
+              }
+            }
           }
+        }
+      }
+    },
+    "Class": {
+      "type": "object",
+      "properties": {
+        "class": {
+          "description": "Name of the class as URI relative to the schema URL.",
+          "type": "string"
         },
         "description": {
-          "description": "Description of the property.",
+          "description": "Description of the class.",
           "type": "string"
         },
-        "indexFilterable": {
-          "description": "Optional. Should this property be indexed in the inverted index. Defaults to true. If you choose false, you will not be able to use this property in where filters. This property has no affect on vectorization decisions done by modules",
-          "type": "boolean",
-          "x-nullable": true
-        },
-        "indexInverted": {
-          "description": "Optional. Should this property be indexed in the inverted index. Defaults to true. If you choose false, you will not be able to use this property in where filters, bm25 or hybrid search. This property has no affect on vectorization decisions done by modules (deprecated as of v1.19; use indexFilterable or/and indexSearchable instead)",
-          "type": "boolean",
-          "x-nullable": true
+        "invertedIndexConfig": {
+          "$ref": "#/definitions/InvertedIndexConfig"
         },
-        "indexSearchable": {
-          "description": "Optional. Should this property be indexed in the inverted index. Defaults to true. Applicable only to properties of data type text and text[]. If you choose false, you will not be able to use this property in bm25 or hybrid search. This property has no affect on vectorization decisions done by modules",
-          "type": "boolean",
-          "x-nullable": true
+        "memtableConfig": {
+          "$ref": "#/definitions/MemtableConfig"
         },
         "moduleConfig": {
           "description": "Configuration specific to modules this Weaviate instance has installed",
           "type": "object"
         },
-        "name": {
-          "description": "Name of the property as URI relative to the schema URL.",
-          "type": "string"
+        "multiTenancyConfig": {
+          "$ref": "#/definitions/MultiTenancyConfig"
         },
-        "tokenization": {
-          "description": "Determines tokenization of the property as separate words or whole field. Optional. Applies to text and text[] data types. Allowed values are ` + "`" + `word` + "`" + ` (default; splits on any non-alphanumerical, lowercases), ` + "`" + `lowercase` + "`" + ` (splits on white spaces, lowercases), ` + "`" + `whitespace` + "`" + ` (splits on white spaces), ` + "`" + `field` + "`" + ` (trims). Not supported for remaining data types",
-          "type": "string",
-          "enum": [
-            "word",
-            "lowercase",
-            "whitespace",
-            "field"
-          ]
-        }
-      }
-    },
-    "PropertySchema": {
-      "description": "This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value OR a SingleRef definition.",
-      "type": "object"
-    },
-    "ReferenceMetaClassification": {
-      "description": "This meta field contains additional info about the classified reference property",
-      "properties": {
-        "closestLosingDistance": {
-          "description": "The lowest distance of a neighbor in the losing group. Optional. If k equals the size of the winning group, there is no losing group",
-          "type": "number",
-          "format": "float32",
-          "x-nullable": true
+        "objectTTL": {
+          "$ref": "#/definitions/ObjectTTLConfig"
         },
-        "closestOverallDistance": {
-          "description": "The lowest distance of any neighbor, regardless of whether they were in the winning or losing group",
-          "type": "number",
-          "format": "float32"
+        "properties": {
+          "description": "The properties of the class.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Property"
+          }
         },
-        "closestWinningDistance": {
-          "description": "Closest distance of a neighbor from the winning group",
-          "type": "number",
-          "format": "float32"
+        "quotaConfig": {
+          "$ref": "#/definitions/QuotaConfig"
         },
-        "losingCount": {
-          "description": "size of the losing group, can be 0 if the winning group size equals k",
-          "type": "number",
-          "format": "int64"
+        "replicationConfig": {
+          "$ref": "#/definitions/ReplicationConfig"
         },
-        "losingDistance": {
-          "description": "deprecated - do not use, to be removed in 0.23.0",
-          "type": "number",
-          "format": "float32",
-          "x-nullable": true
+        "shardingConfig": {
+          "description": "Manage how the index should be sharded and distributed in the cluster",
+          "type": "object"
         },
-        "meanLosingDistance": {
-          "description": "Mean distance of all neighbors from the losing group. Optional. If k equals the size of the winning group, there is no losing group.",
-          "type": "number",
-          "format": "float32",
-          "x-nullable": true
+        "strictProperties": {
+          "description": "Reject objects containing properties that are not part of the class instead of adding them through auto-schema.",
+          "type": "boolean"
         },
-        "meanWinningDistance": {
-          "description": "Mean distance of all neighbors from the winning group",
-          "type": "number",
-          "format": "float32"
+        "vectorConfig": {
+          "description": "Named vector spaces of this class, each with its own vector index and vectorizer",
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/VectorConfig"
+          }
         },
-        "overallCount": {
-          "description": "overall neighbors checked as part of the classification. In most cases this will equal k, but could be lower than k - for example if not enough data was present",
-          "type": "number",
+        "vectorDimensions": {
+          "description": "Length of the vectors of this class. If left empty, it is taken from the 'dimensions' setting of the vectorizer module or recorded on the first insert. Vectors of a different length are rejected. Named vector spaces record their own dimensions in vectorConfig.",
+          "type": "integer",
           "format": "int64"
         },
-        "winningCount": {
-          "description": "size of the winning group, a number between 1..k",
-          "type": "number",
-          "format": "int64"
+        "vectorIndexConfig": {
+          "description": "Vector-index config, that is specific to the type of index selected in vectorIndexType",
+          "type": "object"
         },
-        "winningDistance": {
-          "description": "deprecated - do not use, to be removed in 0.23.0",
-          "type": "number",
-          "format": "float32"
-        }
-      }
-    },
-    "ReplicationConfig": {
-      "description": "Configure how replication is executed in a cluster",
-      "type": "object",
-      "properties": {
-        "factor": {
-          "description": "Number of times a class is replicated",
-          "type": "integer"
+        "vectorIndexType": {
+          "description": "Name of the vector index to use, eg. (HNSW)",
+          "type": "string"
+        },
+        "vectorizer": {
+          "description": "Specify how the vectors for this class should be determined. The options are either 'none' - this means you have to import a vector with each object yourself - or the name of a module that provides vectorization capabilities, such as 'text2vec-contextionary'. If left empty, it will use the globally configured default which can itself either be 'none' or a specific module.",
+          "type": "string"
         }
       }
     },
-    "Schema": {
-      "description": "Definitions of semantic schemas (also see: https://github.com/weaviate/weaviate-semantic-schemas).",
+    "Classification": {
+      "description": "Manage classifications, trigger them and view status of past classifications.",
       "type": "object",
       "properties": {
-        "classes": {
-          "description": "Semantic classes that are available.",
+        "basedOnProperties": {
+          "description": "base the text-based classification on these fields (of type text)",
           "type": "array",
           "items": {
-            "$ref": "#/definitions/Class"
-          }
+            "type": "string"
+          },
+          "example": [
+            "description"
+          ]
         },
-        "maintainer": {
-          "description": "Email of the maintainer.",
+        "class": {
+          "description": "class (name) which is used in this classification",
           "type": "string",
-          "format": "email"
+          "example": "City"
+        },
+        "classifyProperties": {
+          "description": "which ref-property to set as part of the classification",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "example": [
+            "inCountry"
+          ]
         },
-        "name": {
-          "description": "Name of the schema.",
-          "type": "string"
-        }
-      }
-    },
-    "SchemaClusterStatus": {
-      "description": "Indicates the health of the schema in a cluster.",
-      "type": "object",
-      "properties": {
         "error": {
-          "description": "Contains the sync check error if one occurred",
+          "description": "error message if status == failed",
           "type": "string",
-          "x-omitempty": true
+          "default": "",
+          "example": "classify xzy: something went wrong"
         },
-        "healthy": {
-          "description": "True if the cluster is in sync, false if there is an issue (see error).",
-          "type": "boolean",
-          "x-omitempty": false
+        "filters": {
+          "type": "object",
+          "properties": {
+            "sourceWhere": {
+              "description": "limit the objects to be classified",
+              "type": "object",
+              "$ref": "#/definitions/WhereFilter"
+            },
+            "targetWhere": {
+              "description": "Limit the possible sources when using an algorithm which doesn't really on training data, e.g. 'contextual'. When using an algorithm with a training set, such as 'knn', limit the training set instead",
+              "type": "object",
+              "$ref": "#/definitions/WhereFilter"
+            },
+            "trainingSetWhere": {
+              "description": "Limit the training objects to be considered during the classification. Can only be used on types with explicit training sets, such as 'knn'",
+              "type": "object",
+              "$ref": "#/definitions/WhereFilter"
+            }
+          }
         },
-        "hostname": {
-          "description": "Hostname of the coordinating node, i.e. the one that received the cluster. This can be useful information if the error message contains phrases such as 'other nodes agree, but local does not', etc.",
-          "type": "string"
+        "id": {
+          "description": "ID to uniquely identify this classification run",
+          "type": "string",
+          "format": "uuid",
+          "example": "ee722219-b8ec-4db1-8f8d-5150bb1a9e0c"
         },
-        "ignoreSchemaSync": {
-          "description": "The cluster check at startup can be ignored (to recover from an out-of-sync situation).",
-          "type": "boolean",
-          "x-omitempty": false
+        "meta": {
+          "description": "additional meta information about the classification",
+          "type": "object",
+          "$ref": "#/definitions/ClassificationMeta"
+        },
+        "settings": {
+          "description": "classification-type specific settings",
+          "type": "object"
         },
-        "nodeCount": {
-          "description": "Number of nodes that participated in the sync check",
-          "type": "number",
-          "format": "int"
-        }
-      }
-    },
-    "SchemaHistory": {
-      "description": "This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value OR a SingleRef definition.",
-      "type": "object"
-    },
-    "ShardStatus": {
-      "description": "The status of a single shard",
-      "properties": {
         "status": {
-          "description": "Status of the shard",
+          "description": "status of this classification",
+          "type": "string",
+          "enum": [
+            "running",
+            "completed",
+            "failed"
+          ],
+          "example": "running"
+        },
+        "type": {
+          "description": "which algorithm to use for classifications",
           "type": "string"
         }
       }
     },
-    "ShardStatusGetResponse": {
-      "description": "Response body of shard status get request",
+    "ClassificationMeta": {
+      "description": "Additional information to a specific classification",
+      "type": "object",
       "properties": {
-        "name": {
-          "description": "Name of the shard",
-          "type": "string"
+        "completed": {
+          "description": "time when this classification finished",
+          "type": "string",
+          "format": "date-time",
+          "example": "2017-07-21T17:32:28Z"
         },
-        "status": {
-          "description": "Status of the shard",
-          "type": "string"
+        "count": {
+          "description": "number of objects which were taken into consideration for classification",
+          "type": "integer",
+          "example": 147
+        },
+        "countFailed": {
+          "description": "number of objects which could not be classified - see error message for details",
+          "type": "integer",
+          "example": 7
+        },
+        "countSucceeded": {
+          "description": "number of objects successfully classified",
+          "type": "integer",
+          "example": 140
+        },
+        "started": {
+          "description": "time when this classification was started",
+          "type": "string",
+          "format": "date-time",
+          "example": "2017-07-21T17:32:28Z"
         }
       }
     },
-    "ShardStatusList": {
-      "description": "The status of all the shards of a Class",
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/ShardStatusGetResponse"
-      }
-    },
-    "SingleRef": {
-      "description": "Either set beacon (direct reference) or set class and schema (concept reference)",
+    "DanglingReference": {
+      "description": "A cross-reference pointing at an object which does not exist.",
+      "type": "object",
       "properties": {
         "beacon": {
-          "description": "If using a direct reference, specify the URI to point to the cross-ref here. Should be in the form of weaviate://localhost/\u003cuuid\u003e for the example of a local cross-ref to an object",
-          "type": "string",
-          "format": "uri"
-        },
-        "class": {
-          "description": "If using a concept reference (rather than a direct reference), specify the desired class name here",
+          "description": "The beacon of the missing object.",
           "type": "string",
           "format": "uri"
         },
-        "classification": {
-          "description": "Additional Meta information about classifications if the item was part of one",
-          "$ref": "#/definitions/ReferenceMetaClassification"
-        },
-        "href": {
-          "description": "If using a direct reference, this read-only fields provides a link to the referenced resource. If 'origin' is globally configured, an absolute URI is shown - a relative URI otherwise.",
+        "id": {
+          "description": "ID of the object holding the reference.",
           "type": "string",
-          "format": "uri"
+          "format": "uuid"
         },
-        "schema": {
-          "description": "If using a concept reference (rather than a direct reference), specify the desired properties here",
-          "$ref": "#/definitions/PropertySchema"
+        "property": {
+          "description": "Name of the reference property holding the reference.",
+          "type": "string"
         }
       }
     },
-    "StopwordConfig": {
-      "description": "fine-grained control over stopword list usage",
+    "Deprecation": {
       "type": "object",
       "properties": {
-        "additions": {
-          "description": "stopwords to be considered additionally",
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
+        "apiType": {
+          "description": "Describes which API is effected, usually one of: REST, GraphQL",
+          "type": "string"
         },
-        "preset": {
-          "description": "pre-existing list of common words by language",
+        "id": {
+          "description": "The id that uniquely identifies this particular deprecations (mostly used internally)",
           "type": "string"
         },
-        "removals": {
-          "description": "stopwords to be removed from consideration",
+        "locations": {
+          "description": "The locations within the specified API affected by this deprecation",
           "type": "array",
           "items": {
             "type": "string"
           }
-        }
-      }
-    },
-    "Tenant": {
-      "description": "attributes representing a single tenant within weaviate",
-      "type": "object",
-      "properties": {
-        "activityStatus": {
-          "description": "activity status of the tenant's shard. Optional for creating tenant (implicit ` + "`" + `HOT` + "`" + `) and required for updating tenant. Allowed values are ` + "`" + `HOT` + "`" + ` - tenant is fully active, ` + "`" + `WARM` + "`" + ` - tenant is active, some restrictions are imposed (TBD; not supported yet), ` + "`" + `COLD` + "`" + ` - tenant is inactive; no actions can be performed on tenant, tenant's files are stored locally, ` + "`" + `FROZEN` + "`" + ` - as COLD, but files are stored on cloud storage (not supported yet)",
+        },
+        "mitigation": {
+          "description": "User-required object to not be affected by the (planned) removal",
+          "type": "string"
+        },
+        "msg": {
+          "description": "What this deprecation is about",
+          "type": "string"
+        },
+        "plannedRemovalVersion": {
+          "description": "A best-effort guess of which upcoming version will remove the feature entirely",
+          "type": "string"
+        },
+        "removedIn": {
+          "description": "If the feature has already been removed, it was removed in this version",
           "type": "string",
-          "enum": [
-            "HOT",
-            "WARM",
-            "COLD",
-            "FROZEN"
-          ]
+          "x-nullable": true
         },
-        "name": {
-          "description": "name of the tenant",
+        "removedTime": {
+          "description": "If the feature has already been removed, it was removed at this timestamp",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "sinceTime": {
+          "description": "The deprecation was introduced in this version",
+          "type": "string",
+          "format": "date-time"
+        },
+        "sinceVersion": {
+          "description": "The deprecation was introduced in this version",
+          "type": "string"
+        },
+        "status": {
+          "description": "Whether the problematic API functionality is deprecated (planned to be removed) or already removed",
           "type": "string"
         }
       }
     },
-    "VectorWeights": {
-      "description": "Allow custom overrides of vector weights as math expressions. E.g. \"pancake\": \"7\" will set the weight for the word pancake to 7 in the vectorization, whereas \"w * 3\" would triple the originally calculated word. This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value (string/string) object.",
-      "type": "object"
-    },
-    "WhereFilter": {
-      "description": "Filter search results using a where filter",
+    "ErrorResponse": {
+      "description": "An error response given by Weaviate end-points.",
       "type": "object",
       "properties": {
-        "operands": {
-          "description": "combine multiple where filters, requires 'And' or 'Or' operator",
+        "error": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/WhereFilter"
+            "type": "object",
+            "properties": {
+              "message": {
+                "type": "string"
+              }
+            }
           }
+        }
+      }
+    },
+    "GeoCoordinates": {
+      "properties": {
+        "latitude": {
+          "description": "The latitude of the point on earth in decimal form",
+          "type": "number",
+          "format": "float",
+          "x-nullable": true
         },
-        "operator": {
-          "description": "operator to use",
-          "type": "string",
-          "enum": [
-            "And",
-            "Or",
-            "Equal",
-            "Like",
-            "NotEqual",
-            "GreaterThan",
-            "GreaterThanEqual",
-            "LessThan",
-            "LessThanEqual",
-            "WithinGeoRange",
-            "IsNull",
-            "ContainsAny",
-            "ContainsAll"
-          ],
-          "example": "GreaterThanEqual"
-        },
-        "path": {
-          "description": "path to the property currently being filtered",
+        "longitude": {
+          "description": "The longitude of the point on earth in decimal form",
+          "type": "number",
+          "format": "float",
+          "x-nullable": true
+        }
+      }
+    },
+    "GeoPolygon": {
+      "description": "A polygon on earth given by the coordinates of its ring",
+      "properties": {
+        "coordinates": {
+          "description": "The coordinates of the ring of the polygon. The ring is closed implicitly, the last coordinate does not need to repeat the first one.",
           "type": "array",
           "items": {
-            "type": "string"
-          },
-          "example": [
-            "inCity",
-            "City",
-            "name"
-          ]
-        },
-        "valueBoolean": {
-          "description": "value as boolean",
-          "type": "boolean",
-          "x-nullable": true,
-          "example": false
-        },
-        "valueBooleanArray": {
-          "description": "value as boolean",
+            "$ref": "#/definitions/GeoCoordinates"
+          }
+        }
+      }
+    },
+    "GeoShape": {
+      "description": "A point, line string or polygon on earth",
+      "properties": {
+        "coordinates": {
+          "description": "The coordinates of the points making up the shape. The ring of a polygon is closed implicitly.",
           "type": "array",
           "items": {
-            "type": "boolean"
-          },
-          "x-nullable": true,
-          "x-omitempty": true,
-          "example": [
-            true,
-            false
-          ]
+            "$ref": "#/definitions/GeoCoordinates"
+          }
         },
-        "valueDate": {
-          "description": "value as date (as string)",
+        "type": {
+          "description": "The type of the shape",
           "type": "string",
-          "x-nullable": true,
-          "example": "TODO"
+          "enum": [
+            "point",
+            "lineString",
+            "polygon"
+          ]
+        }
+      }
+    },
+    "GraphQLError": {
+      "description": "An error response caused by a GraphQL query.",
+      "properties": {
+        "locations": {
+          "type": "array",
+          "items": {
+            "type": "object",
+            "properties": {
+              "column": {
+                "type": "integer",
+                "format": "int64"
+              },
+              "line": {
+                "type": "integer",
+                "format": "int64"
+              }
+            }
+          }
         },
-        "valueDateArray": {
-          "description": "value as date (as string)",
+        "message": {
+          "type": "string"
+        },
+        "path": {
           "type": "array",
           "items": {
             "type": "string"
-          },
-          "x-nullable": true,
-          "x-omitempty": true,
-          "example": "TODO"
+          }
+        }
+      }
+    },
+    "GraphQLQueries": {
+      "description": "A list of GraphQL queries.",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/GraphQLQuery"
+      }
+    },
+    "GraphQLQuery": {
+      "description": "GraphQL query based on: http://facebook.github.io/graphql/.",
+      "type": "object",
+      "properties": {
+        "operationName": {
+          "description": "The name of the operation if multiple exist in the query.",
+          "type": "string"
         },
-        "valueGeoRange": {
-          "description": "value as geo coordinates and distance",
-          "type": "object",
-          "x-nullable": true,
-          "$ref": "#/definitions/WhereFilterGeoRange"
+        "query": {
+          "description": "Query based on GraphQL syntax.",
+          "type": "string"
         },
-        "valueInt": {
-          "description": "value as integer",
-          "type": "integer",
-          "format": "int64",
-          "x-nullable": true,
-          "example": 2000
+        "variables": {
+          "description": "Additional variables for the query.",
+          "type": "object"
+        }
+      }
+    },
+    "GraphQLResponse": {
+      "description": "GraphQL based response: http://facebook.github.io/graphql/.",
+      "properties": {
+        "data": {
+          "description": "GraphQL data object.",
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/JsonObject"
+          }
         },
-        "valueIntArray": {
-          "description": "value as integer",
+        "errors": {
+          "description": "Array with errors.",
           "type": "array",
           "items": {
-            "type": "integer",
-            "format": "int64"
+            "$ref": "#/definitions/GraphQLError"
           },
-          "x-nullable": true,
-          "x-omitempty": true,
-          "example": "[100, 200]"
+          "x-omitempty": true
+        }
+      }
+    },
+    "GraphQLResponses": {
+      "description": "A list of GraphQL responses.",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/GraphQLResponse"
+      }
+    },
+    "InvertedIndexConfig": {
+      "description": "Configure the inverted index built into Weaviate",
+      "type": "object",
+      "properties": {
+        "bm25": {
+          "$ref": "#/definitions/BM25Config"
         },
-        "valueNumber": {
-          "description": "value as number/float",
+        "cleanupIntervalSeconds": {
+          "description": "Asynchronous index clean up happens every n seconds",
           "type": "number",
-          "format": "float64",
-          "x-nullable": true,
-          "example": 3.14
+          "format": "int"
         },
-        "valueNumberArray": {
-          "description": "value as number/float",
-          "type": "array",
-          "items": {
-            "type": "number",
-            "format": "float64"
-          },
-          "x-nullable": true,
-          "x-omitempty": true,
-          "example": [
-            3.14
-          ]
+        "compression": {
+          "description": "Block compression of the object and inverted index segments on disk. Options: 'none' (default), 'snappy' or 'zstd'",
+          "type": "string"
         },
-        "valueString": {
-          "description": "value as text (deprecated as of v1.19; alias for valueText)",
-          "type": "string",
-          "x-nullable": true,
-          "example": "my search term"
+        "indexNullState": {
+          "description": "Index each object with the null state",
+          "type": "boolean"
         },
-        "valueStringArray": {
-          "description": "value as text (deprecated as of v1.19; alias for valueText)",
-          "type": "array",
-          "items": {
-            "type": "string"
-          },
-          "x-nullable": true,
-          "x-omitempty": true,
-          "example": [
-            "my search term"
-          ]
+        "indexPropertyLength": {
+          "description": "Index length of properties",
+          "type": "boolean"
         },
-        "valueText": {
-          "description": "value as text",
-          "type": "string",
-          "x-nullable": true,
-          "example": "my search term"
+        "indexTimestampRangeFilters": {
+          "description": "Index the internal timestamps of each object in a range index, which serves range filters and sorting on them much faster. Requires indexTimestamps",
+          "type": "boolean"
         },
-        "valueTextArray": {
-          "description": "value as text",
+        "indexTimestamps": {
+          "description": "Index each object by its internal timestamps",
+          "type": "boolean"
+        },
+        "stopwords": {
+          "$ref": "#/definitions/StopwordConfig"
+        },
+        "synonyms": {
+          "description": "Synonyms the terms of keyword queries are expanded with",
           "type": "array",
           "items": {
-            "type": "string"
-          },
-          "x-nullable": true,
-          "x-omitempty": true,
-          "example": [
-            "my search term"
-          ]
+            "$ref": "#/definitions/SynonymRule"
+          }
         }
       }
     },
-    "WhereFilterGeoRange": {
-      "description": "filter within a distance of a georange",
+    "JsonObject": {
+      "description": "JSON object value.",
+      "type": "object"
+    },
+    "Link": {
       "type": "object",
       "properties": {
-        "distance": {
-          "type": "object",
-          "properties": {
-            "max": {
-              "type": "number",
-              "format": "float64"
-            }
-          }
+        "documentationHref": {
+          "description": "weaviate documentation about this resource group",
+          "type": "string"
         },
-        "geoCoordinates": {
-          "x-nullable": false,
-          "$ref": "#/definitions/GeoCoordinates"
+        "href": {
+          "description": "target of the link",
+          "type": "string"
+        },
+        "name": {
+          "description": "human readable name of the resource group",
+          "type": "string"
+        },
+        "rel": {
+          "description": "relationship if both resources are related, e.g. 'next', 'previous', 'parent', etc.",
+          "type": "string"
         }
       }
-    }
-  },
-  "parameters": {
-    "CommonAfterParameterQuery": {
-      "type": "string",
-      "description": "The starting ID of the result window.",
-      "name": "after",
-      "in": "query"
-    },
-    "CommonClassParameterQuery": {
-      "type": "string",
-      "description": "Class parameter specifies the class from which to query objects",
-      "name": "class",
-      "in": "query"
-    },
-    "CommonConsistencyLevelParameterQuery": {
-      "type": "string",
-      "description": "Determines how many replicas must acknowledge a request before it is considered successful",
-      "name": "consistency_level",
-      "in": "query"
-    },
-    "CommonIncludeParameterQuery": {
-      "type": "string",
-      "description": "Include additional information, such as classification infos. Allowed values include: classification, vector, interpretation",
-      "name": "include",
-      "in": "query"
-    },
-    "CommonLimitParameterQuery": {
-      "type": "integer",
-      "format": "int64",
-      "description": "The maximum number of items to be returned per page. Default value is set in Weaviate config.",
-      "name": "limit",
-      "in": "query"
-    },
-    "CommonNodeNameParameterQuery": {
-      "type": "string",
-      "description": "The target node which should fulfill the request",
-      "name": "node_name",
-      "in": "query"
-    },
-    "CommonOffsetParameterQuery": {
-      "type": "integer",
-      "format": "int64",
-      "default": 0,
-      "description": "The starting index of the result window. Default value is 0.",
-      "name": "offset",
-      "in": "query"
-    },
-    "CommonOrderParameterQuery": {
-      "type": "string",
-      "description": "Order parameter to tell how to order (asc or desc) data within given field",
-      "name": "order",
-      "in": "query"
-    },
-    "CommonSortParameterQuery": {
-      "type": "string",
-      "description": "Sort parameter to pass an information about the names of the sort fields",
-      "name": "sort",
-      "in": "query"
-    },
-    "CommonTenantParameterQuery": {
-      "type": "string",
-      "description": "Specifies the tenant in a request targeting a multi-tenant class",
-      "name": "tenant",
-      "in": "query"
-    }
-  },
-  "securityDefinitions": {
-    "oidc": {
-      "description": "OIDC (OpenConnect ID - based on OAuth2)",
-      "type": "oauth2",
-      "flow": "implicit",
-      "authorizationUrl": "http://to-be-configured-in-the-application-config"
-    }
-  },
-  "security": [
-    {},
-    {
-      "oidc": []
-    }
-  ],
-  "tags": [
-    {
-      "name": "objects"
-    },
-    {
-      "description": "These operations allow to execute batch requests for Objects and Objects. Mostly used for importing large datasets.",
-      "name": "batch"
-    },
-    {
-      "name": "graphql"
     },
-    {
-      "name": "meta"
+    "MemtableConfig": {
+      "description": "Thresholds at which the memtables of the shards of a class are flushed to disk. A memtable is flushed as soon as any of them is reached. Unset values fall back to the global configuration.",
+      "properties": {
+        "maxAgeSeconds": {
+          "description": "Time in seconds since a memtable was created",
+          "type": "integer",
+          "format": "int64"
+        },
+        "maxSizeMB": {
+          "description": "Size of a memtable in MB",
+          "type": "integer",
+          "format": "int64"
+        },
+        "maxWALSizeMB": {
+          "description": "Size of the write-ahead log of a memtable in MB, which bounds the time it takes to recover it after a crash",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
     },
-    {
-      "name": "P2P"
+    "Meta": {
+      "description": "Contains meta information of the current Weaviate instance.",
+      "type": "object",
+      "properties": {
+        "hostname": {
+          "description": "The url of the host.",
+          "type": "string",
+          "format": "url"
+        },
+        "modules": {
+          "description": "Module-specific meta information",
+          "type": "object"
+        },
+        "version": {
+          "description": "Version of weaviate you are currently running",
+          "type": "string"
+        }
+      }
     },
-    {
-      "description": "All functions related to the Contextionary.",
-      "name": "contextionary-API"
+    "MultiTenancyConfig": {
+      "description": "Configuration related to multi-tenancy within a class",
+      "properties": {
+        "enabled": {
+          "description": "Whether or not multi-tenancy is enabled for this class",
+          "type": "boolean",
+          "x-omitempty": false
+        }
+      }
     },
-    {
-      "description": "These operations enable manipulation of the schema in Weaviate schema.",
-      "name": "schema"
-    }
-  ],
-  "externalDocs": {
-    "url": "https://github.com/weaviate/weaviate"
-  }
-}`))
-	FlatSwaggerJSON = json.RawMessage([]byte(`{
-  "consumes": [
-    "application/json",
-    "application/yaml"
-  ],
-  "produces": [
-    "application/json"
-  ],
-  "schemes": [
-    "https"
-  ],
-  "swagger": "2.0",
-  "info": {
-    "description": "Cloud-native, modular vector database",
-    "title": "Weaviate",
-    "contact": {
-      "name": "Weaviate",
-      "url": "https://github.com/weaviate",
-      "email": "hello@weaviate.io"
+    "MultipleRef": {
+      "description": "Multiple instances of references to other objects.",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/SingleRef"
+      }
     },
-    "version": "1.21.3"
-  },
-  "basePath": "/v1",
-  "paths": {
-    "/": {
-      "get": {
-        "description": "Home. Discover the REST API",
-        "operationId": "weaviate.root",
-        "responses": {
-          "200": {
-            "description": "Weaviate is alive and ready to serve content",
-            "schema": {
-              "type": "object",
-              "properties": {
-                "links": {
-                  "type": "array",
-                  "items": {
-                    "$ref": "#/definitions/Link"
-                  }
-                }
-              }
-            }
+    "NestedProperty": {
+      "type": "object",
+      "properties": {
+        "dataType": {
+          "description": "Data type of the nested property. Can be any primitive data type or \"object\"/\"object[]\" for further nesting.",
+          "type": "array",
+          "items": {
+            "type": "string"
           }
+        },
+        "description": {
+          "description": "Description of the nested property.",
+          "type": "string"
+        },
+        "name": {
+          "description": "Name of the nested property.",
+          "type": "string"
+        },
+        "nestedProperties": {
+          "description": "The properties of the nested object. Only allowed for data types \"object\" and \"object[]\".",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/NestedProperty"
+          },
+          "x-omitempty": true
+        },
+        "tokenization": {
+          "description": "Determines tokenization of the nested property. Applies to text and text[] data types. See ` + "`" + `tokenization` + "`" + ` on properties for allowed values.",
+          "type": "string",
+          "enum": [
+            "word",
+            "lowercase",
+            "whitespace",
+            "field",
+            "lowercase_keyword",
+            "lowercase_keyword_tr",
+            "lowercase_keyword_de",
+            "cjk",
+            "stem_en",
+            "stem_de",
+            "stem_fr",
+            "stem_es",
+            "stem_it"
+          ]
         }
       }
     },
-    "/.well-known/live": {
-      "get": {
-        "description": "Determines whether the application is alive. Can be used for kubernetes liveness probe",
-        "operationId": "weaviate.wellknown.liveness",
-        "responses": {
-          "200": {
-            "description": "The application is able to respond to HTTP requests"
+    "NodeReadOnlyStatus": {
+      "description": "The read-only mode of a node and of the classes on it",
+      "properties": {
+        "classes": {
+          "description": "The classes which are in read-only mode on this node",
+          "type": "array",
+          "items": {
+            "type": "string"
           }
+        },
+        "readOnly": {
+          "description": "Whether all writes to the node are rejected",
+          "type": "boolean"
+        },
+        "reason": {
+          "description": "Why the node is in read-only mode. MANUAL if it was put into read-only mode explicitly, DISK_USAGE or MEMORY_USAGE if a resource usage threshold was exceeded.",
+          "type": "string"
         }
       }
     },
-    "/.well-known/openid-configuration": {
-      "get": {
-        "description": "OIDC Discovery page, redirects to the token issuer if one is configured",
-        "tags": [
-          "well-known",
-          "oidc",
-          "discovery"
-        ],
-        "summary": "OIDC discovery information if OIDC auth is enabled",
-        "responses": {
-          "200": {
-            "description": "Successful response, inspect body",
-            "schema": {
-              "type": "object",
-              "properties": {
-                "clientId": {
-                  "description": "OAuth Client ID",
-                  "type": "string"
-                },
-                "href": {
-                  "description": "The Location to redirect to",
-                  "type": "string"
-                },
-                "scopes": {
-                  "description": "OAuth Scopes",
-                  "type": "array",
-                  "items": {
-                    "type": "string"
-                  },
-                  "x-omitempty": true
-                }
-              }
-            }
-          },
-          "404": {
-            "description": "Not found, no oidc provider present"
-          },
-          "500": {
-            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+    "NodeShardStatus": {
+      "description": "The definition of a node shard status response body",
+      "properties": {
+        "class": {
+          "description": "The name of shard's class.",
+          "type": "string",
+          "x-omitempty": false
+        },
+        "diskUsage": {
+          "description": "The disk space used by the shard, broken down by its components.",
+          "type": "object",
+          "$ref": "#/definitions/ShardDiskUsage"
+        },
+        "loadStatus": {
+          "description": "Whether the shard is LOADED, LOADING or still UNLOADED, if shards are loaded lazily at startup.",
+          "type": "string",
+          "x-omitempty": false
+        },
+        "name": {
+          "description": "The name of the shard.",
+          "type": "string",
+          "x-omitempty": false
+        },
+        "objectCount": {
+          "description": "The number of objects in shard.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "quarantinedSegments": {
+          "description": "The number of LSM segments of the shard which failed checksum verification and were quarantined. Their data is no longer served.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "vectorCacheBytes": {
+          "description": "The estimated memory used by the vector caches of the shard, in bytes.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "vectorCacheMaxBytes": {
+          "description": "The memory budget of the vector caches of the shard in bytes, 0 if the caches are only limited by vectorCacheMaxObjects.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "vectorIndexIntegrity": {
+          "description": "The results of the latest vector index integrity check of the shard, if any.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/VectorIndexIntegrity"
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false
+        "vectorIndexTombstoneCleanup": {
+          "description": "The state of the tombstone cleanup of the vector indexes of the shard.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/VectorIndexTombstoneCleanup"
+          }
+        },
+        "vectorQueueLagMs": {
+          "description": "How long the oldest vector of the shard which is not indexed yet has been queued, in milliseconds. Only set if async indexing is enabled.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "vectorQueueLength": {
+          "description": "The number of vectors of the shard which are queued and not indexed yet. Only set if async indexing is enabled.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        }
       }
     },
-    "/.well-known/ready": {
-      "get": {
-        "description": "Determines whether the application is ready to receive traffic. Can be used for kubernetes readiness probe.",
-        "operationId": "weaviate.wellknown.readiness",
-        "responses": {
-          "200": {
-            "description": "The application has completed its start-up routine and is ready to accept traffic."
-          },
-          "503": {
-            "description": "The application is currently not able to serve traffic. If other horizontal replicas of weaviate are available and they are capable of receiving traffic, all traffic should be redirected there instead."
-          }
+    "NodeStats": {
+      "description": "The summary of Weaviate's statistics.",
+      "properties": {
+        "objectCount": {
+          "description": "The total number of objects in DB.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "shardCount": {
+          "description": "The count of Weaviate's shards.",
+          "type": "number",
+          "format": "int",
+          "x-omitempty": false
         }
       }
     },
-    "/backups/{backend}": {
-      "post": {
-        "description": "Starts a process of creating a backup for a set of classes",
-        "tags": [
-          "backups"
-        ],
-        "operationId": "backups.create",
-        "parameters": [
-          {
-            "type": "string",
-            "description": "Backup backend name e.g. filesystem, gcs, s3.",
-            "name": "backend",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/BackupCreateRequest"
-            }
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "Backup create process successfully started.",
-            "schema": {
-              "$ref": "#/definitions/BackupCreateResponse"
-            }
-          },
-          "401": {
-            "description": "Unauthorized or invalid credentials."
-          },
-          "403": {
-            "description": "Forbidden",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "422": {
-            "description": "Invalid backup creation attempt.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "500": {
-            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+    "NodeStatus": {
+      "description": "The definition of a backup node status response body",
+      "properties": {
+        "batchStats": {
+          "description": "Weaviate batch statistics.",
+          "type": "object",
+          "$ref": "#/definitions/BatchStats"
+        },
+        "gitHash": {
+          "description": "The gitHash of Weaviate.",
+          "type": "string"
+        },
+        "name": {
+          "description": "The name of the node.",
+          "type": "string"
+        },
+        "readOnly": {
+          "description": "The read-only mode of the node and of the classes on it.",
+          "type": "object",
+          "$ref": "#/definitions/NodeReadOnlyStatus"
+        },
+        "shards": {
+          "description": "The list of the shards with it's statistics.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/NodeShardStatus"
           }
         },
-        "x-serviceIds": [
-          "weaviate.local.backup"
-        ]
+        "stats": {
+          "description": "Weaviate overall statistics.",
+          "type": "object",
+          "$ref": "#/definitions/NodeStats"
+        },
+        "status": {
+          "description": "Node's status.",
+          "type": "string",
+          "default": "HEALTHY",
+          "enum": [
+            "HEALTHY",
+            "UNHEALTHY",
+            "UNAVAILABLE"
+          ]
+        },
+        "version": {
+          "description": "The version of Weaviate.",
+          "type": "string"
+        }
       }
     },
-    "/backups/{backend}/{id}": {
-      "get": {
-        "description": "Returns status of backup creation attempt for a set of classes",
-        "tags": [
-          "backups"
-        ],
-        "operationId": "backups.create.status",
-        "parameters": [
-          {
-            "type": "string",
-            "description": "Backup backend name e.g. filesystem, gcs, s3.",
-            "name": "backend",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "The ID of a backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "Backup creation status successfully returned",
-            "schema": {
-              "$ref": "#/definitions/BackupCreateStatusResponse"
-            }
-          },
-          "401": {
-            "description": "Unauthorized or invalid credentials."
-          },
-          "403": {
-            "description": "Forbidden",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "404": {
-            "description": "Not Found - Backup does not exist",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "422": {
-            "description": "Invalid backup restoration status attempt.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "500": {
-            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+    "NodesStatusResponse": {
+      "description": "The status of all of the Weaviate nodes",
+      "type": "object",
+      "properties": {
+        "nodes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/NodeStatus"
           }
+        }
+      }
+    },
+    "Object": {
+      "type": "object",
+      "properties": {
+        "additional": {
+          "$ref": "#/definitions/AdditionalProperties"
         },
-        "x-serviceIds": [
-          "weaviate.local.backup"
-        ]
+        "class": {
+          "description": "Class of the Object, defined in the schema.",
+          "type": "string"
+        },
+        "creationTimeUnix": {
+          "description": "Timestamp of creation of this Object in milliseconds since epoch UTC.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "id": {
+          "description": "ID of the Object.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "lastUpdateTimeUnix": {
+          "description": "Timestamp of the last Object update in milliseconds since epoch UTC.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "properties": {
+          "$ref": "#/definitions/PropertySchema"
+        },
+        "tenant": {
+          "description": "Name of the Objects tenant.",
+          "type": "string"
+        },
+        "vector": {
+          "description": "This object's position in the Contextionary vector space. Read-only if using a vectorizer other than 'none'. Writable and required if using 'none' as vectorizer.",
+          "$ref": "#/definitions/C11yVector"
+        },
+        "vectorWeights": {
+          "$ref": "#/definitions/VectorWeights"
+        },
+        "vectors": {
+          "$ref": "#/definitions/Vectors",
+          "description": "This object's positions in the named vector spaces of its class. Read-only for vector spaces using a vectorizer module."
+        }
       }
     },
-    "/backups/{backend}/{id}/restore": {
-      "get": {
-        "description": "Returns status of a backup restoration attempt for a set of classes",
-        "tags": [
-          "backups"
-        ],
-        "operationId": "backups.restore.status",
-        "parameters": [
-          {
-            "type": "string",
-            "description": "Backup backend name e.g. filesystem, gcs, s3.",
-            "name": "backend",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "The ID of a backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "Backup restoration status successfully returned",
-            "schema": {
-              "$ref": "#/definitions/BackupRestoreStatusResponse"
-            }
-          },
-          "401": {
-            "description": "Unauthorized or invalid credentials."
-          },
-          "403": {
-            "description": "Forbidden",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "404": {
-            "description": "Not Found - Backup does not exist",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "500": {
-            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+    "ObjectTTLConfig": {
+      "description": "Configuration of the automatic expiry of the objects of a class",
+      "properties": {
+        "enabled": {
+          "description": "Whether or not objects of this class are deleted automatically once they have expired",
+          "type": "boolean",
+          "x-omitempty": false
+        },
+        "ttlSeconds": {
+          "description": "Time to live of an object in seconds, counted from its creation time",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "ObjectVectorsUpdate": {
+      "description": "The vectors of an object to replace, without resending its properties.",
+      "type": "object",
+      "properties": {
+        "vector": {
+          "description": "The new position of the object in the vector space of its class.",
+          "$ref": "#/definitions/C11yVector"
+        },
+        "vectors": {
+          "description": "The new positions of the object in the named vector spaces of its class. Named vectors which are not provided are kept.",
+          "$ref": "#/definitions/Vectors"
+        }
+      }
+    },
+    "ObjectsGetResponse": {
+      "type": "object",
+      "allOf": [
+        {
+          "$ref": "#/definitions/Object"
+        },
+        {
+          "properties": {
+            "deprecations": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Deprecation"
+              }
             }
           }
         },
-        "x-serviceIds": [
-          "weaviate.local.backup"
-        ]
-      },
-      "post": {
-        "description": "Starts a process of restoring a backup for a set of classes",
-        "tags": [
-          "backups"
-        ],
-        "operationId": "backups.restore",
-        "parameters": [
-          {
-            "type": "string",
-            "description": "Backup backend name e.g. filesystem, gcs, s3.",
-            "name": "backend",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "The ID of a backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/BackupRestoreRequest"
+        {
+          "properties": {
+            "result": {
+              "description": "Results for this specific Object.",
+              "format": "object",
+              "properties": {
+                "errors": {
+                  "$ref": "#/definitions/ErrorResponse"
+                },
+                "status": {
+                  "type": "string",
+                  "default": "SUCCESS",
+                  "enum": [
+                    "SUCCESS",
+                    "PENDING",
+                    "FAILED"
+                  ]
+                }
+              }
             }
           }
-        ],
-        "responses": {
-          "200": {
-            "description": "Backup restoration process successfully started.",
-            "schema": {
-              "$ref": "#/definitions/BackupRestoreResponse"
-            }
-          },
-          "401": {
-            "description": "Unauthorized or invalid credentials."
-          },
-          "403": {
-            "description": "Forbidden",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "404": {
-            "description": "Not Found - Backup does not exist",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "422": {
-            "description": "Invalid backup restoration attempt.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "500": {
-            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+        }
+      ]
+    },
+    "ObjectsListResponse": {
+      "description": "List of Objects.",
+      "type": "object",
+      "properties": {
+        "deprecations": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Deprecation"
           }
         },
-        "x-serviceIds": [
-          "weaviate.local.backup"
-        ]
+        "objects": {
+          "description": "The actual list of Objects.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Object"
+          }
+        },
+        "scrollId": {
+          "description": "The ID of the scroll session, if the objects were listed with the scroll parameter.",
+          "type": "string"
+        },
+        "totalResults": {
+          "description": "The total number of Objects for the query. The number of items in a response may be smaller due to paging.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "PatchDocumentAction": {
+      "description": "Either a JSONPatch document as defined by RFC 6902 (from, op, path, value), or a merge document (RFC 7396).",
+      "required": [
+        "op",
+        "path"
+      ],
+      "properties": {
+        "from": {
+          "description": "A string containing a JSON Pointer value.",
+          "type": "string"
+        },
+        "merge": {
+          "$ref": "#/definitions/Object"
+        },
+        "op": {
+          "description": "The operation to be performed.",
+          "type": "string",
+          "enum": [
+            "add",
+            "remove",
+            "replace",
+            "move",
+            "copy",
+            "test"
+          ]
+        },
+        "path": {
+          "description": "A JSON-Pointer.",
+          "type": "string"
+        },
+        "value": {
+          "description": "The value to be used within the operations.",
+          "type": "object"
+        }
+      }
+    },
+    "PatchDocumentObject": {
+      "description": "Either a JSONPatch document as defined by RFC 6902 (from, op, path, value), or a merge document (RFC 7396).",
+      "required": [
+        "op",
+        "path"
+      ],
+      "properties": {
+        "from": {
+          "description": "A string containing a JSON Pointer value.",
+          "type": "string"
+        },
+        "merge": {
+          "$ref": "#/definitions/Object"
+        },
+        "op": {
+          "description": "The operation to be performed.",
+          "type": "string",
+          "enum": [
+            "add",
+            "remove",
+            "replace",
+            "move",
+            "copy",
+            "test"
+          ]
+        },
+        "path": {
+          "description": "A JSON-Pointer.",
+          "type": "string"
+        },
+        "value": {
+          "description": "The value to be used within the operations.",
+          "type": "object"
+        }
+      }
+    },
+    "PeerUpdate": {
+      "description": "A single peer in the network.",
+      "properties": {
+        "id": {
+          "description": "The session ID of the peer.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "name": {
+          "description": "Human readable name.",
+          "type": "string"
+        },
+        "schemaHash": {
+          "description": "The latest known hash of the peer's schema.",
+          "type": "string"
+        },
+        "uri": {
+          "description": "The location where the peer is exposed to the internet.",
+          "type": "string",
+          "format": "uri"
+        }
+      }
+    },
+    "PeerUpdateList": {
+      "description": "List of known peers.",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/PeerUpdate"
+      }
+    },
+    "PhoneNumber": {
+      "properties": {
+        "countryCode": {
+          "description": "Read-only. The numerical country code (e.g. 49)",
+          "type": "number",
+          "format": "uint64"
+        },
+        "defaultCountry": {
+          "description": "Optional. The ISO 3166-1 alpha-2 country code. This is used to figure out the correct countryCode and international format if only a national number (e.g. 0123 4567) is provided",
+          "type": "string"
+        },
+        "input": {
+          "description": "The raw input as the phone number is present in your raw data set. It will be parsed into the standardized formats if valid.",
+          "type": "string"
+        },
+        "internationalFormatted": {
+          "description": "Read-only. Parsed result in the international format (e.g. +49 123 ...)",
+          "type": "string"
+        },
+        "national": {
+          "description": "Read-only. The numerical representation of the national part",
+          "type": "number",
+          "format": "uint64"
+        },
+        "nationalFormatted": {
+          "description": "Read-only. Parsed result in the national format (e.g. 0123 456789)",
+          "type": "string"
+        },
+        "valid": {
+          "description": "Read-only. Indicates whether the parsed number is a valid phone number",
+          "type": "boolean"
+        }
+      }
+    },
+    "Principal": {
+      "type": "object",
+      "properties": {
+        "groups": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "username": {
+          "description": "The username that was extracted either from the authentication information",
+          "type": "string"
+        }
+      }
+    },
+    "Property": {
+      "type": "object",
+      "properties": {
+        "cardinality": {
+          "description": "Optional. Restricts how many objects a cross-reference property can point to. ` + "`" + `one` + "`" + ` allows at most a single reference, ` + "`" + `many` + "`" + ` (default) allows any number of references up to ` + "`" + `maxCount` + "`" + `. Only applicable to cross-reference properties.",
+          "type": "string",
+          "enum": [
+            "one",
+            "many"
+          ]
+        },
+        "dataType": {
+          "description": "Can be a reference to another type when it starts with a capital (for example Person), otherwise \"string\" or \"int\".",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "defaultCountryProperty": {
+          "description": "Optional. Name of a text property of the same class, which holds the ISO 3166-1 alpha-2 country code to use as the default country when parsing this phone number. A defaultCountry set on the phone number itself takes precedence. Only applicable to phoneNumber properties.",
+          "type": "string"
+        },
+        "defaultValue": {
+          "description": "Optional. Value which is used for this property when an object is created without it. Must match the data type of the property. Not supported for cross-references."
+        },
+        "description": {
+          "description": "Description of the property.",
+          "type": "string"
+        },
+        "expression": {
+          "description": "Optional. Expression which derives the value of this property from other properties of the same object whenever the object is written, e.g. ` + "`" + `concat(firstName, \" \", lastName)` + "`" + `. Supports text and number literals, property names, the arithmetic operators + - * / %, text functions (concat, lower, upper, trim), number functions (abs, ceil, floor, round) and date extraction (year, month, day, hour, minute, weekday, dayOfYear). Only allowed for the data types text, int and number. A value set explicitly for a computed property is ignored and replaced by the computed value.",
+          "type": "string"
+        },
+        "indexFilterable": {
+          "description": "Optional. Should this property be indexed in the inverted index. Defaults to true. If you choose false, you will not be able to use this property in where filters. This property has no affect on vectorization decisions done by modules",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "indexInverted": {
+          "description": "Optional. Should this property be indexed in the inverted index. Defaults to true. If you choose false, you will not be able to use this property in where filters, bm25 or hybrid search. This property has no affect on vectorization decisions done by modules (deprecated as of v1.19; use indexFilterable or/and indexSearchable instead)",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "indexPositions": {
+          "description": "Optional. Should the positions of the terms be stored in the inverted index. Defaults to false. Applicable only to searchable properties of data type text and text[]. Phrase (\"exact phrase\") and proximity (\"exact phrase\"~2) queries in bm25 or hybrid search only match properties which store the positions, without any of them the terms of a phrase are searched for individually.",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "indexRangeFilters": {
+          "description": "Optional. Should this property be indexed in a range index, which serves the range filters GreaterThan, GreaterThanEqual, LessThan and LessThanEqual much faster than the filterable index on large ranges. Defaults to false. Applicable only to properties of data type int, number and date.",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "indexSearchable": {
+          "description": "Optional. Should this property be indexed in the inverted index. Defaults to true. Applicable only to properties of data type text and text[]. If you choose false, you will not be able to use this property in bm25 or hybrid search. This property has no affect on vectorization decisions done by modules",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "maxCount": {
+          "description": "Optional. The maximum number of references of a cross-reference property with cardinality ` + "`" + `many` + "`" + `. Unlimited if not set.",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1,
+          "x-nullable": true
+        },
+        "moduleConfig": {
+          "description": "Configuration specific to modules this Weaviate instance has installed",
+          "type": "object"
+        },
+        "name": {
+          "description": "Name of the property as URI relative to the schema URL.",
+          "type": "string"
+        },
+        "nestedProperties": {
+          "description": "The properties of the nested object. Only allowed and required for data types \"object\" and \"object[]\".",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/NestedProperty"
+          },
+          "x-omitempty": true
+        },
+        "onDelete": {
+          "description": "Optional. Controls what happens to this cross-reference when a referenced object is deleted. ` + "`" + `cascade` + "`" + ` deletes the referring object, ` + "`" + `setNull` + "`" + ` removes the reference from the referring object and ` + "`" + `restrict` + "`" + ` rejects the deletion while the object is still referenced. Dangling references are kept if not set. Only applicable to cross-reference properties which are filterable.",
+          "type": "string",
+          "enum": [
+            "cascade",
+            "setNull",
+            "restrict"
+          ]
+        },
+        "required": {
+          "description": "Optional. If set to true, objects can not be created or updated without a value for this property. Defaults to false.",
+          "type": "boolean"
+        },
+        "tokenization": {
+          "description": "Determines tokenization of the property as separate words or whole field. Optional. Applies to text and text[] data types. Allowed values are ` + "`" + `word` + "`" + ` (default; splits on any non-alphanumerical, lowercases), ` + "`" + `lowercase` + "`" + ` (splits on white spaces, lowercases), ` + "`" + `whitespace` + "`" + ` (splits on white spaces), ` + "`" + `field` + "`" + ` (trims), ` + "`" + `lowercase_keyword` + "`" + ` (trims, lowercases), ` + "`" + `lowercase_keyword_tr` + "`" + ` and ` + "`" + `lowercase_keyword_de` + "`" + ` (like ` + "`" + `lowercase_keyword` + "`" + ` with the case folding rules of Turkish or German), ` + "`" + `cjk` + "`" + ` (splits Chinese, Japanese and Korean text into bigrams of characters, other text like ` + "`" + `word` + "`" + `), ` + "`" + `stem_en` + "`" + `, ` + "`" + `stem_de` + "`" + `, ` + "`" + `stem_fr` + "`" + `, ` + "`" + `stem_es` + "`" + ` and ` + "`" + `stem_it` + "`" + ` (like ` + "`" + `word` + "`" + `, reduces the words to their stem in English, German, French, Spanish or Italian). Not supported for remaining data types",
+          "type": "string",
+          "enum": [
+            "word",
+            "lowercase",
+            "whitespace",
+            "field",
+            "lowercase_keyword",
+            "lowercase_keyword_tr",
+            "lowercase_keyword_de",
+            "cjk",
+            "stem_en",
+            "stem_de",
+            "stem_fr",
+            "stem_es",
+            "stem_it"
+          ]
+        },
+        "uniqueKey": {
+          "description": "Optional. If set to true, the value of this property identifies an object of the class. Batch imports with ` + "`" + `upsertBy` + "`" + ` set to this property derive the id of objects sent without one from this value, so importing an object with the same value again updates it instead of creating a duplicate. Defaults to false. Applicable only to properties of data type text, int, number, boolean, date and uuid.",
+          "type": "boolean"
+        }
+      }
+    },
+    "PropertyMigration": {
+      "description": "The progress of a background migration of the stored data of a property on this node.",
+      "type": "object",
+      "properties": {
+        "className": {
+          "description": "name of the class the property belongs to",
+          "type": "string"
+        },
+        "error": {
+          "description": "error which caused the migration to fail",
+          "type": "string"
+        },
+        "newValue": {
+          "description": "value of the migrated setting after the migration",
+          "type": "string"
+        },
+        "objectsProcessed": {
+          "description": "number of objects which have been migrated so far",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "previousValue": {
+          "description": "value of the migrated setting before the migration",
+          "type": "string"
+        },
+        "property": {
+          "description": "current name of the property",
+          "type": "string"
+        },
+        "shardsCompleted": {
+          "description": "number of local shards which have been migrated completely",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "shardsTotal": {
+          "description": "number of local shards which need to be migrated",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "status": {
+          "description": "status of the migration",
+          "type": "string",
+          "enum": [
+            "STARTED",
+            "SUCCESS",
+            "FAILED"
+          ]
+        },
+        "type": {
+          "description": "kind of the migration",
+          "type": "string",
+          "enum": [
+            "rename",
+            "tokenization"
+          ]
+        }
+      }
+    },
+    "PropertyRename": {
+      "description": "Request to rename an existing property of a class.",
+      "type": "object",
+      "required": [
+        "name"
+      ],
+      "properties": {
+        "name": {
+          "description": "new name of the property",
+          "type": "string"
+        }
+      }
+    },
+    "PropertySchema": {
+      "description": "This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value OR a SingleRef definition.",
+      "type": "object"
+    },
+    "PropertyTokenizationUpdate": {
+      "description": "Request to change the tokenization of an existing text property of a class.",
+      "type": "object",
+      "required": [
+        "tokenization"
+      ],
+      "properties": {
+        "tokenization": {
+          "description": "new tokenization of the property",
+          "type": "string",
+          "enum": [
+            "word",
+            "lowercase",
+            "whitespace",
+            "field",
+            "lowercase_keyword",
+            "lowercase_keyword_tr",
+            "lowercase_keyword_de",
+            "cjk",
+            "stem_en",
+            "stem_de",
+            "stem_fr",
+            "stem_es",
+            "stem_it"
+          ]
+        }
+      }
+    },
+    "QuotaConfig": {
+      "description": "Storage quotas of a class and its tenants",
+      "properties": {
+        "maxDiskBytes": {
+          "description": "Maximum number of bytes the shards of this class may occupy on the disk of a node. Writes adding data are rejected once it is reached. 0 means unlimited.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "maxObjects": {
+          "description": "Maximum number of objects of this class on a node. Writes creating objects beyond it are rejected. 0 means unlimited.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "tenantMaxDiskBytes": {
+          "description": "Maximum number of bytes a single tenant may occupy on disk. Writes adding data to the tenant are rejected once it is reached. Requires multi-tenancy. 0 means unlimited.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "tenantMaxObjects": {
+          "description": "Maximum number of objects of a single tenant. Writes creating objects beyond it are rejected. Requires multi-tenancy. 0 means unlimited.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "warningPercentage": {
+          "description": "Percentage of a quota above which its usage is reported as nearing the quota. 0 means 80.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "ReadOnlyMode": {
+      "description": "Puts this node or a single class on this node into read-only mode, or lifts it",
+      "properties": {
+        "class": {
+          "description": "Name of the class to put into read-only mode. The entire node if omitted.",
+          "type": "string"
+        },
+        "readOnly": {
+          "description": "Whether writes are rejected",
+          "type": "boolean"
+        }
+      }
+    },
+    "ReferenceAudit": {
+      "description": "The result of scanning the reference properties of a class for references to nonexistent objects.",
+      "type": "object",
+      "properties": {
+        "className": {
+          "description": "Name of the audited class.",
+          "type": "string"
+        },
+        "danglingReferences": {
+          "description": "The references pointing at nonexistent objects.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/DanglingReference"
+          }
+        },
+        "objectsScanned": {
+          "description": "Number of objects which have been scanned.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "pruned": {
+          "description": "Whether the dangling references have been removed from their objects.",
+          "type": "boolean",
+          "x-omitempty": false
+        },
+        "referencesScanned": {
+          "description": "Number of references which have been checked.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "tenant": {
+          "description": "Name of the audited tenant, if the class is multi-tenant.",
+          "type": "string"
+        }
+      }
+    },
+    "ReferenceMetaClassification": {
+      "description": "This meta field contains additional info about the classified reference property",
+      "properties": {
+        "closestLosingDistance": {
+          "description": "The lowest distance of a neighbor in the losing group. Optional. If k equals the size of the winning group, there is no losing group",
+          "type": "number",
+          "format": "float32",
+          "x-nullable": true
+        },
+        "closestOverallDistance": {
+          "description": "The lowest distance of any neighbor, regardless of whether they were in the winning or losing group",
+          "type": "number",
+          "format": "float32"
+        },
+        "closestWinningDistance": {
+          "description": "Closest distance of a neighbor from the winning group",
+          "type": "number",
+          "format": "float32"
+        },
+        "losingCount": {
+          "description": "size of the losing group, can be 0 if the winning group size equals k",
+          "type": "number",
+          "format": "int64"
+        },
+        "losingDistance": {
+          "description": "deprecated - do not use, to be removed in 0.23.0",
+          "type": "number",
+          "format": "float32",
+          "x-nullable": true
+        },
+        "meanLosingDistance": {
+          "description": "Mean distance of all neighbors from the losing group. Optional. If k equals the size of the winning group, there is no losing group.",
+          "type": "number",
+          "format": "float32",
+          "x-nullable": true
+        },
+        "meanWinningDistance": {
+          "description": "Mean distance of all neighbors from the winning group",
+          "type": "number",
+          "format": "float32"
+        },
+        "overallCount": {
+          "description": "overall neighbors checked as part of the classification. In most cases this will equal k, but could be lower than k - for example if not enough data was present",
+          "type": "number",
+          "format": "int64"
+        },
+        "winningCount": {
+          "description": "size of the winning group, a number between 1..k",
+          "type": "number",
+          "format": "int64"
+        },
+        "winningDistance": {
+          "description": "deprecated - do not use, to be removed in 0.23.0",
+          "type": "number",
+          "format": "float32"
+        }
+      }
+    },
+    "ReplicationConfig": {
+      "description": "Configure how replication is executed in a cluster",
+      "type": "object",
+      "properties": {
+        "factor": {
+          "description": "Number of times a class is replicated",
+          "type": "integer"
+        }
+      }
+    },
+    "Schema": {
+      "description": "Definitions of semantic schemas (also see: https://github.com/weaviate/weaviate-semantic-schemas).",
+      "type": "object",
+      "properties": {
+        "classes": {
+          "description": "Semantic classes that are available.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Class"
+          }
+        },
+        "maintainer": {
+          "description": "Email of the maintainer.",
+          "type": "string",
+          "format": "email"
+        },
+        "name": {
+          "description": "Name of the schema.",
+          "type": "string"
+        },
+        "stopwordSets": {
+          "description": "Custom stopword sets which classes can reference in their stopword config.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/StopwordSet"
+          }
+        }
+      }
+    },
+    "SchemaClassDiff": {
+      "description": "The differences between an existing class and its target definition.",
+      "type": "object",
+      "properties": {
+        "class": {
+          "description": "Name of the class.",
+          "type": "string"
+        },
+        "incompatibleChanges": {
+          "description": "Changes which can not be applied to the existing class. A class with incompatible changes can only be migrated by deleting and recreating it.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "propertiesAdded": {
+          "description": "Properties which are only present in the target class.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "propertiesChanged": {
+          "description": "Properties which are present in both classes, but differ.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "propertiesRemoved": {
+          "description": "Properties which are only present in the existing class.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "settingsChanged": {
+          "description": "Class level settings (e.g. vectorIndexConfig) which differ.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "SchemaClusterStatus": {
+      "description": "Indicates the health of the schema in a cluster.",
+      "type": "object",
+      "properties": {
+        "error": {
+          "description": "Contains the sync check error if one occurred",
+          "type": "string",
+          "x-omitempty": true
+        },
+        "healthy": {
+          "description": "True if the cluster is in sync, false if there is an issue (see error).",
+          "type": "boolean",
+          "x-omitempty": false
+        },
+        "hostname": {
+          "description": "Hostname of the coordinating node, i.e. the one that received the cluster. This can be useful information if the error message contains phrases such as 'other nodes agree, but local does not', etc.",
+          "type": "string"
+        },
+        "ignoreSchemaSync": {
+          "description": "The cluster check at startup can be ignored (to recover from an out-of-sync situation).",
+          "type": "boolean",
+          "x-omitempty": false
+        },
+        "nodeCount": {
+          "description": "Number of nodes that participated in the sync check",
+          "type": "number",
+          "format": "int"
+        }
+      }
+    },
+    "SchemaDiff": {
+      "description": "The differences between the current schema and a target schema.",
+      "type": "object",
+      "properties": {
+        "classesAdded": {
+          "description": "Classes which are only present in the target schema.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "classesChanged": {
+          "description": "Classes which are present in both schemas, but differ.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/SchemaClassDiff"
+          }
+        },
+        "classesRemoved": {
+          "description": "Classes which are only present in the current schema.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "compatible": {
+          "description": "Whether the target schema can be reached without deleting and recreating any class.",
+          "type": "boolean"
+        }
+      }
+    },
+    "SchemaExport": {
+      "description": "Portable definition of the full schema, including the module, vector index, sharding and replication configs of all classes and the tenants of multi-tenant classes. It can be imported into another cluster to recreate the schema.",
+      "type": "object",
+      "properties": {
+        "classes": {
+          "description": "The classes of the schema.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Class"
+          }
+        },
+        "tenants": {
+          "description": "The tenants of the multi-tenant classes by class name.",
+          "type": "object",
+          "additionalProperties": {
+            "type": "array",
+            "items": {
+              "$ref": "#/definitions/Tenant"
+            }
+          }
+        },
+        "version": {
+          "description": "Version of the export format.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "SchemaHistory": {
+      "description": "This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value OR a SingleRef definition.",
+      "type": "object"
+    },
+    "SchemaImportResult": {
+      "description": "The changes applied by a schema import.",
+      "type": "object",
+      "properties": {
+        "classesCreated": {
+          "description": "Classes which did not exist and were created.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "classesUnchanged": {
+          "description": "Existing classes which already matched the imported definition.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "classesUpdated": {
+          "description": "Existing classes to which properties were added or whose settings were updated.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "tenantsCreated": {
+          "description": "Number of tenants which did not exist and were created.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "SchemaVersion": {
+      "description": "A single mutation of the schema as recorded by the schema history of this node.",
+      "type": "object",
+      "properties": {
+        "class": {
+          "description": "name of the class affected by the mutation, empty for snapshots",
+          "type": "string"
+        },
+        "operation": {
+          "description": "kind of the mutation. A snapshot records the complete schema, e.g. when the schema was replaced during a cluster sync or a restore.",
+          "type": "string",
+          "enum": [
+            "snapshot",
+            "addClass",
+            "updateClass",
+            "deleteClass",
+            "addShards",
+            "updateShards",
+            "deleteShards"
+          ]
+        },
+        "shards": {
+          "description": "names of the shards affected by the mutation, only set for shard mutations",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "timestamp": {
+          "description": "time of the mutation in ms since epoch",
+          "type": "integer",
+          "format": "int64"
+        },
+        "version": {
+          "description": "monotonically increasing version of the schema after this mutation",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "SchemaVersions": {
+      "description": "The history of the schema mutations of this node.",
+      "type": "object",
+      "properties": {
+        "currentVersion": {
+          "description": "version of the current schema of this node",
+          "type": "integer",
+          "format": "int64"
+        },
+        "versions": {
+          "description": "recorded schema versions in ascending order",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/SchemaVersion"
+          }
+        }
+      }
+    },
+    "ShardCompaction": {
+      "description": "The result of forcing a full compaction of the storage of a shard.",
+      "type": "object",
+      "properties": {
+        "buckets": {
+          "description": "Number of LSM buckets that have been compacted.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "bytesAfter": {
+          "description": "Size of the shard on disk in bytes after the compaction.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "bytesBefore": {
+          "description": "Size of the shard on disk in bytes before the compaction.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "className": {
+          "description": "Name of the class of the shard.",
+          "type": "string"
+        },
+        "node": {
+          "description": "Name of the node which holds the shard.",
+          "type": "string"
+        },
+        "reclaimedBytes": {
+          "description": "Disk space reclaimed by the compaction in bytes. Zero if concurrent writes outgrew the reclaimed space.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "shard": {
+          "description": "Name of the shard.",
+          "type": "string"
+        },
+        "tookMs": {
+          "description": "Duration of the compaction in ms.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        }
+      }
+    },
+    "ShardDiskUsage": {
+      "description": "The disk space used by a shard in bytes, broken down by its components.",
+      "properties": {
+        "compactionTempBytes": {
+          "description": "The size of the temporary files of ongoing or aborted compactions.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "invertedIndexBuckets": {
+          "description": "The size of the segments of each inverted index bucket.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/BucketDiskUsage"
+          }
+        },
+        "invertedIndexBytes": {
+          "description": "The size of the segments of all inverted index buckets.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "objectsBytes": {
+          "description": "The size of the segments of the objects bucket.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "otherBytes": {
+          "description": "The size of all other files, such as the remaining buckets and the metadata of the shard.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "tombstonesBytes": {
+          "description": "The estimated size of the deleted nodes of the vector indexes which are not cleaned up yet. Part of vectorIndexBytes.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "totalBytes": {
+          "description": "The size of all files of the shard.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "vectorIndexBytes": {
+          "description": "The size of the vector indexes, including their commit logs, compressed vectors and indexing queues.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "walBytes": {
+          "description": "The size of the write-ahead logs of the memtables which are not flushed yet.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        }
+      }
+    },
+    "ShardStatus": {
+      "description": "The status of a single shard",
+      "properties": {
+        "status": {
+          "description": "Status of the shard",
+          "type": "string"
+        }
+      }
+    },
+    "ShardStatusGetResponse": {
+      "description": "Response body of shard status get request",
+      "properties": {
+        "name": {
+          "description": "Name of the shard",
+          "type": "string"
+        },
+        "status": {
+          "description": "Status of the shard",
+          "type": "string"
+        }
+      }
+    },
+    "ShardStatusList": {
+      "description": "The status of all the shards of a Class",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/ShardStatusGetResponse"
+      }
+    },
+    "SingleRef": {
+      "description": "Either set beacon (direct reference) or set class and schema (concept reference)",
+      "properties": {
+        "beacon": {
+          "description": "If using a direct reference, specify the URI to point to the cross-ref here. Should be in the form of weaviate://localhost/\u003cuuid\u003e for the example of a local cross-ref to an object",
+          "type": "string",
+          "format": "uri"
+        },
+        "class": {
+          "description": "If using a concept reference (rather than a direct reference), specify the desired class name here",
+          "type": "string",
+          "format": "uri"
+        },
+        "classification": {
+          "description": "Additional Meta information about classifications if the item was part of one",
+          "$ref": "#/definitions/ReferenceMetaClassification"
+        },
+        "href": {
+          "description": "If using a direct reference, this read-only fields provides a link to the referenced resource. If 'origin' is globally configured, an absolute URI is shown - a relative URI otherwise.",
+          "type": "string",
+          "format": "uri"
+        },
+        "schema": {
+          "description": "If using a concept reference (rather than a direct reference), specify the desired properties here",
+          "$ref": "#/definitions/PropertySchema"
+        }
+      }
+    },
+    "SparseVector": {
+      "description": "A sparse vector, given by the indices of its non-zero dimensions and their values, as produced by learned sparse retrieval models like SPLADE",
+      "properties": {
+        "indices": {
+          "description": "The indices of the non-zero dimensions",
+          "type": "array",
+          "items": {
+            "type": "integer",
+            "format": "int64"
+          }
+        },
+        "values": {
+          "description": "The values of the non-zero dimensions, in the order of the indices",
+          "type": "array",
+          "items": {
+            "type": "number",
+            "format": "float"
+          }
+        }
+      }
+    },
+    "StopwordConfig": {
+      "description": "fine-grained control over stopword list usage",
+      "type": "object",
+      "properties": {
+        "additions": {
+          "description": "stopwords to be considered additionally",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "preset": {
+          "description": "pre-existing list of common words by language",
+          "type": "string"
+        },
+        "removals": {
+          "description": "stopwords to be removed from consideration",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "sets": {
+          "description": "names of custom stopword sets to be considered additionally, see /schema/stopwords",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "StopwordSet": {
+      "description": "a named list of custom stopwords which can be referenced in the stopword config of classes",
+      "type": "object",
+      "properties": {
+        "name": {
+          "description": "name of the stopword set",
+          "type": "string"
+        },
+        "words": {
+          "description": "the stopwords of the set",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "SynonymRule": {
+      "description": "a rule for expanding the terms of keyword queries. Without synonyms all terms are equivalent (two-way), otherwise the terms are expanded to the synonyms only (one-way)",
+      "type": "object",
+      "properties": {
+        "synonyms": {
+          "description": "the terms the query is expanded to if it contains one of the terms",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "terms": {
+          "description": "the terms of the rule",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "Tenant": {
+      "description": "attributes representing a single tenant within weaviate",
+      "type": "object",
+      "properties": {
+        "activityStatus": {
+          "description": "activity status of the tenant's shard. Optional for creating tenant (implicit ` + "`" + `HOT` + "`" + `) and required for updating tenant. Allowed values are ` + "`" + `HOT` + "`" + ` - tenant is fully active, ` + "`" + `WARM` + "`" + ` - tenant is active, some restrictions are imposed (TBD; not supported yet), ` + "`" + `COLD` + "`" + ` - tenant is inactive; no actions can be performed on tenant, tenant's files are stored locally, ` + "`" + `FROZEN` + "`" + ` - as COLD, but files are stored on cloud storage (not supported yet)",
+          "type": "string",
+          "enum": [
+            "HOT",
+            "WARM",
+            "COLD",
+            "FROZEN"
+          ]
+        },
+        "name": {
+          "description": "name of the tenant",
+          "type": "string"
+        }
+      }
+    },
+    "VectorConfig": {
+      "description": "Configuration of a single named vector space of a class",
+      "type": "object",
+      "properties": {
+        "dimensions": {
+          "description": "Length of the vectors of this vector space. If left empty, it is taken from the 'dimensions' setting of the vectorizer module or recorded on the first insert. Vectors of a different length are rejected.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "vectorIndexConfig": {
+          "description": "Vector-index config, that is specific to the type of index selected in vectorIndexType",
+          "type": "object"
+        },
+        "vectorIndexType": {
+          "description": "Name of the vector index to use, eg. (HNSW)",
+          "type": "string"
+        },
+        "vectorizer": {
+          "description": "Configuration of the vectorizer module used for this vector space, keyed by the module name. Set to {\"none\": {}} to import vectors yourself.",
+          "type": "object"
+        }
+      }
+    },
+    "VectorIndexBuild": {
+      "description": "The result of building the vector index of a shard in bulk.",
+      "type": "object",
+      "properties": {
+        "className": {
+          "description": "Name of the class of the shard.",
+          "type": "string"
+        },
+        "dimensions": {
+          "description": "Dimensions of the indexed vectors, zero for an empty shard.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "node": {
+          "description": "Name of the node which holds the shard.",
+          "type": "string"
+        },
+        "nodes": {
+          "description": "Number of vectors the graph has been built from.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "shard": {
+          "description": "Name of the shard.",
+          "type": "string"
+        },
+        "targetVector": {
+          "description": "Name of the target vector, empty for the class-level vector.",
+          "type": "string"
+        },
+        "tookMs": {
+          "description": "Duration of reading the vectors and building the graph in ms.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        }
+      }
+    },
+    "VectorIndexIntegrity": {
+      "description": "The result of validating the graph of the vector index of a shard.",
+      "type": "object",
+      "properties": {
+        "brokenLinks": {
+          "description": "Number of links pointing at missing nodes or at layers the target node is not part of.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "checkedAt": {
+          "description": "Time of the check in ms since epoch.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "entrypointValid": {
+          "description": "Whether the entrypoint exists and is on the top layer of the graph.",
+          "type": "boolean",
+          "x-omitempty": false
+        },
+        "nodes": {
+          "description": "Number of nodes in the graph.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "orphanNodes": {
+          "description": "Number of nodes which are not linked to by any other node.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "repaired": {
+          "description": "Whether the damaged portions of the graph have been repaired.",
+          "type": "boolean",
+          "x-omitempty": false
+        },
+        "shard": {
+          "description": "Name of the checked shard.",
+          "type": "string"
+        },
+        "targetVector": {
+          "description": "Name of the target vector, empty for the class-level vector.",
+          "type": "string"
+        },
+        "unreachableNodes": {
+          "description": "Number of nodes which cannot be reached from the entrypoint.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        }
+      }
+    },
+    "VectorIndexIntegrityReport": {
+      "description": "The results of validating the vector index graphs of the shards of a class on one node.",
+      "type": "object",
+      "properties": {
+        "className": {
+          "description": "Name of the checked class.",
+          "type": "string"
+        },
+        "node": {
+          "description": "Name of the node the shards have been checked on.",
+          "type": "string"
+        },
+        "results": {
+          "description": "The results per shard and target vector.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/VectorIndexIntegrity"
+          }
+        }
+      }
+    },
+    "VectorIndexRecall": {
+      "description": "The result of measuring the recall of the vector index of a shard.",
+      "type": "object",
+      "properties": {
+        "ef": {
+          "description": "The search time ef the approximate searches were run with.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "k": {
+          "description": "Number of results requested per query.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "measuredAt": {
+          "description": "Time of the measurement in ms since epoch.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "recall": {
+          "description": "Share of the exact top k results which were also returned by the approximate search, averaged over all queries.",
+          "type": "number",
+          "format": "double",
+          "x-omitempty": false
+        },
+        "sampleSize": {
+          "description": "Number of indexed vectors which were used as queries.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "shard": {
+          "description": "Name of the measured shard.",
+          "type": "string"
+        },
+        "targetVector": {
+          "description": "Name of the target vector, empty for the class-level vector.",
+          "type": "string"
+        }
+      }
+    },
+    "VectorIndexRecallReport": {
+      "description": "The results of measuring the recall of the vector indexes of the shards of a class on one node.",
+      "type": "object",
+      "properties": {
+        "className": {
+          "description": "Name of the measured class.",
+          "type": "string"
+        },
+        "node": {
+          "description": "Name of the node the shards have been measured on.",
+          "type": "string"
+        },
+        "results": {
+          "description": "The results per shard and target vector.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/VectorIndexRecall"
+          }
+        }
+      }
+    },
+    "VectorIndexSnapshot": {
+      "description": "A portable snapshot of the vector index of a shard.",
+      "type": "object",
+      "properties": {
+        "className": {
+          "description": "Name of the class of the shard.",
+          "type": "string"
+        },
+        "dimensions": {
+          "description": "Dimensions of the vectors in the snapshot, zero for an empty index.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "distance": {
+          "description": "Name of the distance metric of the vector index.",
+          "type": "string"
+        },
+        "file": {
+          "description": "Name of the snapshot file in the snapshot directory of the node.",
+          "type": "string"
+        },
+        "node": {
+          "description": "Name of the node which holds the shard.",
+          "type": "string"
+        },
+        "nodes": {
+          "description": "Number of nodes in the graph, including deleted ones.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "shard": {
+          "description": "Name of the shard.",
+          "type": "string"
+        },
+        "targetVector": {
+          "description": "Name of the target vector, empty for the class-level vector.",
+          "type": "string"
+        }
+      }
+    },
+    "VectorIndexTombstoneCleanup": {
+      "description": "The state of the tombstone cleanup of the vector index of a shard.",
+      "type": "object",
+      "properties": {
+        "concurrency": {
+          "description": "Number of workers used to reassign the neighbors of deleted nodes.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "lastCycleAt": {
+          "description": "Time the last cleanup cycle completed in ms since epoch.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "lastCycleDurationMs": {
+          "description": "Duration of the last completed cleanup cycle in ms.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "lastCycleRemovedTombstones": {
+          "description": "Number of deleted nodes which have been cleaned up by the last completed cleanup cycle.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "lastCycleThroughput": {
+          "description": "Number of deleted nodes cleaned up per second by the last completed cleanup cycle.",
+          "type": "number",
+          "format": "double",
+          "x-omitempty": false
+        },
+        "paused": {
+          "description": "Whether the scheduled tombstone cleanup is paused.",
+          "type": "boolean",
+          "x-omitempty": false
+        },
+        "remainingTombstones": {
+          "description": "Number of deleted nodes which have not been cleaned up yet.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "removedTombstones": {
+          "description": "Number of deleted nodes which have been cleaned up since the shard was loaded.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "shard": {
+          "description": "Name of the shard.",
+          "type": "string"
+        },
+        "targetVector": {
+          "description": "Name of the target vector, empty for the class-level vector.",
+          "type": "string"
+        }
+      }
+    },
+    "VectorIndexTombstoneCleanupReport": {
+      "description": "The state of the tombstone cleanup of the vector indexes of the shards of a class on one node.",
+      "type": "object",
+      "properties": {
+        "className": {
+          "description": "Name of the class.",
+          "type": "string"
+        },
+        "node": {
+          "description": "Name of the node holding the shards.",
+          "type": "string"
+        },
+        "results": {
+          "description": "The state per shard and target vector.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/VectorIndexTombstoneCleanup"
+          }
+        }
+      }
+    },
+    "VectorWeights": {
+      "description": "Allow custom overrides of vector weights as math expressions. E.g. \"pancake\": \"7\" will set the weight for the word pancake to 7 in the vectorization, whereas \"w * 3\" would triple the originally calculated word. This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value (string/string) object.",
+      "type": "object"
+    },
+    "Vectors": {
+      "description": "A map of named vectors, keyed by the name of the vector space",
+      "type": "object",
+      "additionalProperties": {
+        "$ref": "#/definitions/C11yVector"
+      }
+    },
+    "WhereFilter": {
+      "description": "Filter search results using a where filter",
+      "type": "object",
+      "properties": {
+        "operands": {
+          "description": "combine multiple where filters, requires 'And' or 'Or' operator, or negate a single where filter with the 'Not' operator. Operands without an operator are combined with 'And'",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/WhereFilter"
+          }
+        },
+        "operator": {
+          "description": "operator to use",
+          "type": "string",
+          "enum": [
+            "And",
+            "Or",
+            "Not",
+            "Equal",
+            "Like",
+            "NotEqual",
+            "GreaterThan",
+            "GreaterThanEqual",
+            "LessThan",
+            "LessThanEqual",
+            "WithinGeoRange",
+            "IsNull",
+            "ContainsAny",
+            "ContainsAll",
+            "WithinCIDR",
+            "WithinPolygon",
+            "Intersects",
+            "FuzzyMatch"
+          ],
+          "example": "GreaterThanEqual"
+        },
+        "path": {
+          "description": "path to the property currently being filtered",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "example": [
+            "inCity",
+            "City",
+            "name"
+          ]
+        },
+        "valueBoolean": {
+          "description": "value as boolean",
+          "type": "boolean",
+          "x-nullable": true,
+          "example": false
+        },
+        "valueBooleanArray": {
+          "description": "value as boolean",
+          "type": "array",
+          "items": {
+            "type": "boolean"
+          },
+          "x-nullable": true,
+          "x-omitempty": true,
+          "example": [
+            true,
+            false
+          ]
+        },
+        "valueDate": {
+          "description": "value as date (as string)",
+          "type": "string",
+          "x-nullable": true,
+          "example": "TODO"
+        },
+        "valueDateArray": {
+          "description": "value as date (as string)",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-nullable": true,
+          "x-omitempty": true,
+          "example": "TODO"
+        },
+        "valueGeoPolygon": {
+          "description": "value as geo polygon",
+          "type": "object",
+          "x-nullable": true,
+          "$ref": "#/definitions/GeoPolygon"
+        },
+        "valueGeoRange": {
+          "description": "value as geo coordinates and distance",
+          "type": "object",
+          "x-nullable": true,
+          "$ref": "#/definitions/WhereFilterGeoRange"
+        },
+        "valueInt": {
+          "description": "value as integer",
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": true,
+          "example": 2000
+        },
+        "valueIntArray": {
+          "description": "value as integer",
+          "type": "array",
+          "items": {
+            "type": "integer",
+            "format": "int64"
+          },
+          "x-nullable": true,
+          "x-omitempty": true,
+          "example": "[100, 200]"
+        },
+        "valueNumber": {
+          "description": "value as number/float",
+          "type": "number",
+          "format": "float64",
+          "x-nullable": true,
+          "example": 3.14
+        },
+        "valueNumberArray": {
+          "description": "value as number/float",
+          "type": "array",
+          "items": {
+            "type": "number",
+            "format": "float64"
+          },
+          "x-nullable": true,
+          "x-omitempty": true,
+          "example": [
+            3.14
+          ]
+        },
+        "valueString": {
+          "description": "value as text (deprecated as of v1.19; alias for valueText)",
+          "type": "string",
+          "x-nullable": true,
+          "example": "my search term"
+        },
+        "valueStringArray": {
+          "description": "value as text (deprecated as of v1.19; alias for valueText)",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-nullable": true,
+          "x-omitempty": true,
+          "example": [
+            "my search term"
+          ]
+        },
+        "valueText": {
+          "description": "value as text",
+          "type": "string",
+          "x-nullable": true,
+          "example": "my search term"
+        },
+        "valueTextArray": {
+          "description": "value as text",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-nullable": true,
+          "x-omitempty": true,
+          "example": [
+            "my search term"
+          ]
+        }
+      }
+    },
+    "WhereFilterGeoRange": {
+      "description": "filter within a distance of a georange",
+      "type": "object",
+      "properties": {
+        "distance": {
+          "type": "object",
+          "properties": {
+            "max": {
+              "type": "number",
+              "format": "float64"
+            }
+          }
+        },
+        "geoCoordinates": {
+          "x-nullable": false,
+          "$ref": "#/definitions/GeoCoordinates"
+        }
+      }
+    }
+  },
+  "parameters": {
+    "CommonAfterParameterQuery": {
+      "type": "string",
+      "description": "The starting ID of the result window.",
+      "name": "after",
+      "in": "query"
+    },
+    "CommonClassParameterQuery": {
+      "type": "string",
+      "description": "Class parameter specifies the class from which to query objects",
+      "name": "class",
+      "in": "query"
+    },
+    "CommonConsistencyLevelParameterQuery": {
+      "type": "string",
+      "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+      "name": "consistency_level",
+      "in": "query"
+    },
+    "CommonIfMatchParameterHeader": {
+      "type": "string",
+      "description": "Only apply the write if the object has not changed since it was read: the ETag of the object as returned by GET /objects/{className}/{id}. Multiple comma-separated ETags are allowed..",
+      "name": "If-Match",
+      "in": "header"
+    },
+    "CommonIncludeParameterQuery": {
+      "type": "string",
+      "description": "Include additional information, such as classification infos. Allowed values include: classification, vector, interpretation",
+      "name": "include",
+      "in": "query"
+    },
+    "CommonLimitParameterQuery": {
+      "type": "integer",
+      "format": "int64",
+      "description": "The maximum number of items to be returned per page. Default value is set in Weaviate config.",
+      "name": "limit",
+      "in": "query"
+    },
+    "CommonNodeNameParameterQuery": {
+      "type": "string",
+      "description": "The target node which should fulfill the request",
+      "name": "node_name",
+      "in": "query"
+    },
+    "CommonOffsetParameterQuery": {
+      "type": "integer",
+      "format": "int64",
+      "default": 0,
+      "description": "The starting index of the result window. Default value is 0.",
+      "name": "offset",
+      "in": "query"
+    },
+    "CommonOrderParameterQuery": {
+      "type": "string",
+      "description": "Order parameter to tell how to order (asc or desc) data within given field",
+      "name": "order",
+      "in": "query"
+    },
+    "CommonSortParameterQuery": {
+      "type": "string",
+      "description": "Sort parameter to pass an information about the names of the sort fields",
+      "name": "sort",
+      "in": "query"
+    },
+    "CommonTenantParameterQuery": {
+      "type": "string",
+      "description": "Specifies the tenant in a request targeting a multi-tenant class",
+      "name": "tenant",
+      "in": "query"
+    }
+  },
+  "securityDefinitions": {
+    "oidc": {
+      "description": "OIDC (OpenConnect ID - based on OAuth2)",
+      "type": "oauth2",
+      "flow": "implicit",
+      "authorizationUrl": "http://to-be-configured-in-the-application-config"
+    }
+  },
+  "security": [
+    {},
+    {
+      "oidc": []
+    }
+  ],
+  "tags": [
+    {
+      "name": "objects"
+    },
+    {
+      "description": "These operations allow to execute batch requests for Objects and Objects. Mostly used for importing large datasets.",
+      "name": "batch"
+    },
+    {
+      "name": "graphql"
+    },
+    {
+      "name": "meta"
+    },
+    {
+      "name": "P2P"
+    },
+    {
+      "description": "All functions related to the Contextionary.",
+      "name": "contextionary-API"
+    },
+    {
+      "description": "These operations enable manipulation of the schema in Weaviate schema.",
+      "name": "schema"
+    }
+  ],
+  "externalDocs": {
+    "url": "https://github.com/weaviate/weaviate"
+  }
+}`))
+	FlatSwaggerJSON = json.RawMessage([]byte(`{
+  "consumes": [
+    "application/json",
+    "application/yaml"
+  ],
+  "produces": [
+    "application/json"
+  ],
+  "schemes": [
+    "https"
+  ],
+  "swagger": "2.0",
+  "info": {
+    "description": "Cloud-native, modular vector database",
+    "title": "Weaviate",
+    "contact": {
+      "name": "Weaviate",
+      "url": "https://github.com/weaviate",
+      "email": "hello@weaviate.io"
+    },
+    "version": "1.21.3"
+  },
+  "basePath": "/v1",
+  "paths": {
+    "/": {
+      "get": {
+        "description": "Home. Discover the REST API",
+        "operationId": "weaviate.root",
+        "responses": {
+          "200": {
+            "description": "Weaviate is alive and ready to serve content",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "links": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/Link"
+                  }
+                }
+              }
+            }
+          }
+        }
+      }
+    },
+    "/.well-known/live": {
+      "get": {
+        "description": "Determines whether the application is alive. Can be used for kubernetes liveness probe",
+        "operationId": "weaviate.wellknown.liveness",
+        "responses": {
+          "200": {
+            "description": "The application is able to respond to HTTP requests"
+          }
+        }
+      }
+    },
+    "/.well-known/openid-configuration": {
+      "get": {
+        "description": "OIDC Discovery page, redirects to the token issuer if one is configured",
+        "tags": [
+          "well-known",
+          "oidc",
+          "discovery"
+        ],
+        "summary": "OIDC discovery information if OIDC auth is enabled",
+        "responses": {
+          "200": {
+            "description": "Successful response, inspect body",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "clientId": {
+                  "description": "OAuth Client ID",
+                  "type": "string"
+                },
+                "href": {
+                  "description": "The Location to redirect to",
+                  "type": "string"
+                },
+                "scopes": {
+                  "description": "OAuth Scopes",
+                  "type": "array",
+                  "items": {
+                    "type": "string"
+                  },
+                  "x-omitempty": true
+                }
+              }
+            }
+          },
+          "404": {
+            "description": "Not found, no oidc provider present"
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false
+      }
+    },
+    "/.well-known/ready": {
+      "get": {
+        "description": "Determines whether the application is ready to receive traffic. Can be used for kubernetes readiness probe.",
+        "operationId": "weaviate.wellknown.readiness",
+        "responses": {
+          "200": {
+            "description": "The application has completed its start-up routine and is ready to accept traffic."
+          },
+          "503": {
+            "description": "The application is currently not able to serve traffic. If other horizontal replicas of weaviate are available and they are capable of receiving traffic, all traffic should be redirected there instead."
+          }
+        }
+      }
+    },
+    "/backups/{backend}": {
+      "post": {
+        "description": "Starts a process of creating a backup for a set of classes",
+        "tags": [
+          "backups"
+        ],
+        "operationId": "backups.create",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Backup backend name e.g. filesystem, gcs, s3.",
+            "name": "backend",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/BackupCreateRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Backup create process successfully started.",
+            "schema": {
+              "$ref": "#/definitions/BackupCreateResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid backup creation attempt.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.backup"
+        ]
+      }
+    },
+    "/backups/{backend}/{id}": {
+      "get": {
+        "description": "Returns status of backup creation attempt for a set of classes",
+        "tags": [
+          "backups"
+        ],
+        "operationId": "backups.create.status",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Backup backend name e.g. filesystem, gcs, s3.",
+            "name": "backend",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The ID of a backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Backup creation status successfully returned",
+            "schema": {
+              "$ref": "#/definitions/BackupCreateStatusResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found - Backup does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid backup restoration status attempt.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.backup"
+        ]
+      }
+    },
+    "/backups/{backend}/{id}/restore": {
+      "get": {
+        "description": "Returns status of a backup restoration attempt for a set of classes",
+        "tags": [
+          "backups"
+        ],
+        "operationId": "backups.restore.status",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Backup backend name e.g. filesystem, gcs, s3.",
+            "name": "backend",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The ID of a backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Backup restoration status successfully returned",
+            "schema": {
+              "$ref": "#/definitions/BackupRestoreStatusResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found - Backup does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.backup"
+        ]
+      },
+      "post": {
+        "description": "Starts a process of restoring a backup for a set of classes",
+        "tags": [
+          "backups"
+        ],
+        "operationId": "backups.restore",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Backup backend name e.g. filesystem, gcs, s3.",
+            "name": "backend",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The ID of a backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/BackupRestoreRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Backup restoration process successfully started.",
+            "schema": {
+              "$ref": "#/definitions/BackupRestoreResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found - Backup does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid backup restoration attempt.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.backup"
+        ]
       }
     },
     "/batch/objects": {
       "post": {
-        "description": "Register new Objects in bulk. Provided meta-data and schema values are validated.",
+        "description": "Register new Objects in bulk. Provided meta-data and schema values are validated.",
+        "tags": [
+          "batch",
+          "objects"
+        ],
+        "summary": "Creates new Objects based on a Object template as a batch.",
+        "operationId": "batch.objects.create",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "properties": {
+                "fields": {
+                  "description": "Define which fields need to be returned. Default value is ALL",
+                  "type": "array",
+                  "items": {
+                    "type": "string",
+                    "default": "ALL",
+                    "enum": [
+                      "ALL",
+                      "class",
+                      "schema",
+                      "id",
+                      "creationTimeUnix"
+                    ]
+                  }
+                },
+                "objects": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/Object"
+                  }
+                },
+                "upsertBy": {
+                  "description": "Name of a property which is a ` + "`" + `uniqueKey` + "`" + ` of the classes of the objects in the batch. The objects get a UUID derived from their class and the value of this property, so importing an object with the same value again updates it instead of creating a duplicate. Objects must be sent without an id.",
+                  "type": "string"
+                },
+                "vectorizePolicy": {
+                  "description": "Controls which objects of the batch are vectorized by the vectorizer modules of their class. ` + "`" + `ifMissing` + "`" + ` (default) vectorizes objects which are sent without a vector, ` + "`" + `always` + "`" + ` vectorizes all objects and ignores the vectors sent with them for vector spaces with a vectorizer, ` + "`" + `never` + "`" + ` vectorizes no object, objects which already exist keep their stored vectors unless new ones are sent.",
+                  "type": "string",
+                  "enum": [
+                    "always",
+                    "ifMissing",
+                    "never"
+                  ]
+                }
+              }
+            }
+          },
+          {
+            "type": "string",
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+            "name": "consistency_level",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Request succeeded, see response body to get detailed information about each batched item.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/ObjectsGetResponse"
+              }
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "423": {
+            "description": "The class or the node is in read-only mode and rejects writes",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "429": {
+            "description": "Too many heavy operations are running or queued on the node. Retry after the number of seconds in the Retry-After header.",
+            "headers": {
+              "Retry-After": {
+                "type": "integer",
+                "description": "Number of seconds after which the request can be retried"
+              }
+            },
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.add"
+        ]
+      },
+      "delete": {
+        "description": "Delete Objects in bulk that match a certain filter.",
+        "tags": [
+          "batch",
+          "objects"
+        ],
+        "summary": "Deletes Objects based on a match filter as a batch.",
+        "operationId": "batch.objects.delete",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/BatchDelete"
+            }
+          },
+          {
+            "type": "string",
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+            "name": "consistency_level",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Specifies the tenant in a request targeting a multi-tenant class",
+            "name": "tenant",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Request succeeded, see response body to get detailed information about each batched item.",
+            "schema": {
+              "$ref": "#/definitions/BatchDeleteResponse"
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "423": {
+            "description": "The class or the node is in read-only mode and rejects writes",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      }
+    },
+    "/batch/references": {
+      "post": {
+        "description": "Register cross-references between any class items (objects or objects) in bulk.",
+        "tags": [
+          "batch",
+          "references"
+        ],
+        "summary": "Creates new Cross-References between arbitrary classes in bulk.",
+        "operationId": "batch.references.create",
+        "parameters": [
+          {
+            "description": "A list of references to be batched. The ideal size depends on the used database connector. Please see the documentation of the used connector for help",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/BatchReference"
+              }
+            }
+          },
+          {
+            "type": "string",
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+            "name": "consistency_level",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Request Successful. Warning: A successful request does not guarantee that every batched reference was successfully created. Inspect the response body to see which references succeeded and which failed.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/BatchReferenceResponse"
+              }
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "423": {
+            "description": "The class or the node is in read-only mode and rejects writes",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.add"
+        ]
+      }
+    },
+    "/classifications/": {
+      "post": {
+        "description": "Trigger a classification based on the specified params. Classifications will run in the background, use GET /classifications/\u003cid\u003e to retrieve the status of your classification.",
+        "tags": [
+          "classifications"
+        ],
+        "summary": "Starts a classification.",
+        "operationId": "classifications.post",
+        "parameters": [
+          {
+            "description": "parameters to start a classification",
+            "name": "params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Classification"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Successfully started classification.",
+            "schema": {
+              "$ref": "#/definitions/Classification"
+            }
+          },
+          "400": {
+            "description": "Incorrect request",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.classifications.post"
+        ]
+      }
+    },
+    "/classifications/{id}": {
+      "get": {
+        "description": "Get status, results and metadata of a previously created classification",
+        "tags": [
+          "classifications"
+        ],
+        "summary": "View previously created classification",
+        "operationId": "classifications.get",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "classification id",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Found the classification, returned as body",
+            "schema": {
+              "$ref": "#/definitions/Classification"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found - Classification does not exist"
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.classifications.get"
+        ]
+      }
+    },
+    "/graphql": {
+      "post": {
+        "description": "Get an object based on GraphQL",
+        "tags": [
+          "graphql"
+        ],
+        "summary": "Get a response based on GraphQL",
+        "operationId": "graphql.post",
+        "parameters": [
+          {
+            "description": "The GraphQL query request parameters.",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/GraphQLQuery"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successful query (with select).",
+            "schema": {
+              "$ref": "#/definitions/GraphQLResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "429": {
+            "description": "Too many heavy operations are running or queued on the node. Retry after the number of seconds in the Retry-After header.",
+            "headers": {
+              "Retry-After": {
+                "type": "integer",
+                "description": "Number of seconds after which the request can be retried"
+              }
+            },
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.query",
+          "weaviate.local.query.meta",
+          "weaviate.network.query",
+          "weaviate.network.query.meta"
+        ]
+      }
+    },
+    "/graphql/batch": {
+      "post": {
+        "description": "Perform a batched GraphQL query",
+        "tags": [
+          "graphql"
+        ],
+        "summary": "Get a response based on GraphQL.",
+        "operationId": "graphql.batch",
+        "parameters": [
+          {
+            "description": "The GraphQL queries.",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/GraphQLQueries"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successful query (with select).",
+            "schema": {
+              "$ref": "#/definitions/GraphQLResponses"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.query",
+          "weaviate.local.query.meta",
+          "weaviate.network.query",
+          "weaviate.network.query.meta"
+        ]
+      }
+    },
+    "/meta": {
+      "get": {
+        "description": "Gives meta information about the server and can be used to provide information to another Weaviate instance that wants to interact with the current instance.",
+        "tags": [
+          "meta"
+        ],
+        "summary": "Returns meta information of the current Weaviate instance.",
+        "operationId": "meta.get",
+        "responses": {
+          "200": {
+            "description": "Successful response.",
+            "schema": {
+              "$ref": "#/definitions/Meta"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.query.meta"
+        ]
+      }
+    },
+    "/nodes": {
+      "get": {
+        "description": "Returns status of Weaviate DB.",
+        "tags": [
+          "nodes"
+        ],
+        "operationId": "nodes.get",
+        "responses": {
+          "200": {
+            "description": "Nodes status successfully returned",
+            "schema": {
+              "$ref": "#/definitions/NodesStatusResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found - Backup does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid backup restoration status attempt.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.nodes.status.get"
+        ]
+      }
+    },
+    "/nodes/read-only": {
+      "put": {
+        "description": "Rejects all writes to the node, or to a class on this node, with 423 Locked until read-only mode is disabled again, e.g. during migrations or a blue/green cutover. The node is also put into read-only mode automatically when its disk or memory usage exceeds the configured read-only thresholds. Disabling read-only mode for the node also lifts the automatic read-only mode.",
+        "tags": [
+          "nodes"
+        ],
+        "summary": "Put this node or a class into read-only mode",
+        "operationId": "nodes.readOnly.update",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ReadOnlyMode"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Read-only mode was updated successfully",
+            "schema": {
+              "$ref": "#/definitions/NodeReadOnlyStatus"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Class to be updated does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid update attempt",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      }
+    },
+    "/nodes/{className}": {
+      "get": {
+        "description": "Returns status of Weaviate DB.",
+        "tags": [
+          "nodes"
+        ],
+        "operationId": "nodes.get.class",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Nodes status successfully returned",
+            "schema": {
+              "$ref": "#/definitions/NodesStatusResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found - Backup does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid backup restoration status attempt.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.nodes.status.get.class"
+        ]
+      }
+    },
+    "/objects": {
+      "get": {
+        "description": "Lists all Objects in reverse order of creation, owned by the user that belongs to the used token.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Get a list of Objects.",
+        "operationId": "objects.list",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The starting ID of the result window.",
+            "name": "after",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "default": 0,
+            "description": "The starting index of the result window. Default value is 0.",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "The maximum number of items to be returned per page. Default value is set in Weaviate config.",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Include additional information, such as classification infos. Allowed values include: classification, vector, interpretation",
+            "name": "include",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Sort parameter to pass an information about the names of the sort fields",
+            "name": "sort",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Order parameter to tell how to order (asc or desc) data within given field",
+            "name": "order",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Class parameter specifies the class from which to query objects",
+            "name": "class",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Specifies the tenant in a request targeting a multi-tenant class",
+            "name": "tenant",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Starts or keeps alive a scroll session for the given duration, e.g. '5m'. All pages of a scroll session are read from the same snapshot of the class, which is not affected by concurrent writes. Requires the class and limit parameters.",
+            "name": "scroll",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The ID of the scroll session to continue, as returned with the first page.",
+            "name": "scrollId",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successful response.",
+            "schema": {
+              "$ref": "#/definitions/ObjectsListResponse"
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.query"
+        ]
+      },
+      "post": {
+        "description": "Registers a new Object. Provided meta-data and schema values are validated.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Create Objects between two Objects (object and subject).",
+        "operationId": "objects.create",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
+          },
+          {
+            "type": "string",
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+            "name": "consistency_level",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Object created.",
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "423": {
+            "description": "The class or the node is in read-only mode and rejects writes",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.add"
+        ]
+      }
+    },
+    "/objects/validate": {
+      "post": {
+        "description": "Validate an Object's schema and meta-data. It has to be based on a schema, which is related to the given Object to be accepted by this validation.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Validate an Object based on a schema.",
+        "operationId": "objects.validate",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully validated."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.query.meta"
+        ]
+      }
+    },
+    "/objects/{className}/{id}": {
+      "get": {
+        "description": "Get a single data object",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Get a specific Object based on its class and UUID. Also available as Websocket bus.",
+        "operationId": "objects.class.get",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Include additional information, such as classification infos. Allowed values include: classification, vector, interpretation",
+            "name": "include",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+            "name": "consistency_level",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The target node which should fulfill the request",
+            "name": "node_name",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Specifies the tenant in a request targeting a multi-tenant class",
+            "name": "tenant",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successful response.",
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "Version of the object, can be sent in the If-Match header of a write to only apply the write if the object has not changed since"
+              }
+            },
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "422": {
+            "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.query"
+        ]
+      },
+      "put": {
+        "description": "Update an individual data object based on its class and uuid.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Update a class object based on its uuid",
+        "operationId": "objects.class.put",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The uuid of the data object to update.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
+          },
+          {
+            "type": "string",
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+            "name": "consistency_level",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Only apply the write if the object has not changed since it was read: the ETag of the object as returned by GET /objects/{className}/{id}. Multiple comma-separated ETags are allowed..",
+            "name": "If-Match",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully received.",
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "412": {
+            "description": "The object has changed since it was read, its current ETag does not match the If-Match header.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "423": {
+            "description": "The class or the node is in read-only mode and rejects writes",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      },
+      "delete": {
+        "description": "Delete a single data object.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Delete object based on its class and UUID.",
+        "operationId": "objects.class.delete",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+            "name": "consistency_level",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Specifies the tenant in a request targeting a multi-tenant class",
+            "name": "tenant",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Only apply the write if the object has not changed since it was read: the ETag of the object as returned by GET /objects/{className}/{id}. Multiple comma-separated ETags are allowed..",
+            "name": "If-Match",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Successfully deleted."
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "412": {
+            "description": "The object has changed since it was read, its current ETag does not match the If-Match header.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "423": {
+            "description": "The class or the node is in read-only mode and rejects writes",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": true,
+        "x-available-in-websocket": true,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      },
+      "head": {
+        "description": "Checks if a data object exists without retrieving it.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Checks object's existence based on its class and uuid.",
+        "operationId": "objects.class.head",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The class name as defined in the schema",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The uuid of the data object",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+            "name": "consistency_level",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Specifies the tenant in a request targeting a multi-tenant class",
+            "name": "tenant",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Object exists."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Object doesn't exist."
+          },
+          "422": {
+            "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": true,
+        "x-available-in-websocket": true,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      },
+      "patch": {
+        "description": "Update an individual data object based on its class and uuid. This method supports json-merge style patch semantics (RFC 7396) as well as JSON Patch (RFC 6902), depending on the content type. With application/json every given property replaces its previous value as a whole, with application/merge-patch+json nested objects are merged recursively, and with application/json-patch+json the body is a list of JSON Patch operations. Patches apply to the JSON representation of the object, for example the JSON Patch path of a property is /properties/{propertyName}, and can only change its properties and vector. Provided meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
+        "consumes": [
+          "application/json",
+          "application/yaml",
+          "application/merge-patch+json",
+          "application/json-patch+json"
+        ],
+        "tags": [
+          "objects"
+        ],
+        "summary": "Update an Object based on its UUID (using patch semantics).",
+        "operationId": "objects.class.patch",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The class name as defined in the schema",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The uuid of the data object to update.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "RFC 7396-style patch, the body contains the object to merge into the existing object. With the content type application/json-patch+json the body is a RFC 6902 JSON Patch instead.",
+            "name": "body",
+            "in": "body",
+            "schema": {}
+          },
+          {
+            "type": "string",
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+            "name": "consistency_level",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Specifies the tenant in a request targeting a multi-tenant class",
+            "name": "tenant",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Only apply the write if the object has not changed since it was read: the ETag of the object as returned by GET /objects/{className}/{id}. Multiple comma-separated ETags are allowed..",
+            "name": "If-Match",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Successfully applied. No content provided."
+          },
+          "400": {
+            "description": "The patch-JSON is malformed.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "412": {
+            "description": "The object has changed since it was read, its current ETag does not match the If-Match header.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "The patch-JSON is valid but unprocessable.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "423": {
+            "description": "The class or the node is in read-only mode and rejects writes",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      }
+    },
+    "/objects/{className}/{id}/blobs/{propertyName}": {
+      "get": {
+        "description": "Stream the contents of a blob property of a data object. Blobs stored out-of-band as well as inline blobs are returned as raw bytes, so they don't need to pass through the JSON representation of the object.",
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "objects"
+        ],
+        "summary": "Download the blob of a class-property.",
+        "operationId": "objects.class.blobs.get",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The class name as defined in the schema",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Name of the blob property of the Object.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonTenantParameterQuery"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successful response containing the blob.",
+            "schema": {
+              "type": "string",
+              "format": "binary"
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "The object, its class or the blob property doesn't exist."
+          },
+          "422": {
+            "description": "Request is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property is of type blob?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.query"
+        ]
+      },
+      "put": {
+        "description": "Stream the contents of a blob property of an existing data object. The blob is stored out-of-band, so large media doesn't need to pass through the JSON representation of the object. The property is set to a reference to the stored blob.",
+        "consumes": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "objects"
+        ],
+        "summary": "Upload the blob of a class-property.",
+        "operationId": "objects.class.blobs.put",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The class name as defined in the schema",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Name of the blob property of the Object.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "string",
+              "format": "binary"
+            }
+          },
+          {
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonTenantParameterQuery"
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Successfully stored the blob."
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "The object, its class or the blob property doesn't exist."
+          },
+          "422": {
+            "description": "Request is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property is of type blob?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "423": {
+            "description": "The class or the node is in read-only mode and rejects writes",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      }
+    },
+    "/objects/{className}/{id}/references/{propertyName}": {
+      "put": {
+        "description": "Update all references of a property of a data object.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Replace all references to a class-property.",
+        "operationId": "objects.class.references.put",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The class name as defined in the schema",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Unique name of the property related to the Object.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/MultipleRef"
+            }
+          },
+          {
+            "type": "string",
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+            "name": "consistency_level",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Specifies the tenant in a request targeting a multi-tenant class",
+            "name": "tenant",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully replaced all the references."
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Source object doesn't exist."
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "423": {
+            "description": "The class or the node is in read-only mode and rejects writes",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      },
+      "post": {
+        "description": "Add a single reference to a class-property.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Add a single reference to a class-property.",
+        "operationId": "objects.class.references.create",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The class name as defined in the schema",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Unique name of the property related to the Object.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/SingleRef"
+            }
+          },
+          {
+            "type": "string",
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+            "name": "consistency_level",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Specifies the tenant in a request targeting a multi-tenant class",
+            "name": "tenant",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully added the reference."
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Source object doesn't exist."
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "423": {
+            "description": "The class or the node is in read-only mode and rejects writes",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      },
+      "delete": {
+        "description": "Delete the single reference that is given in the body from the list of references that this property of a data object has",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Delete the single reference that is given in the body from the list of references that this property has.",
+        "operationId": "objects.class.references.delete",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The class name as defined in the schema",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Unique name of the property related to the Object.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/SingleRef"
+            }
+          },
+          {
+            "type": "string",
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+            "name": "consistency_level",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Specifies the tenant in a request targeting a multi-tenant class",
+            "name": "tenant",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Successfully deleted."
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "423": {
+            "description": "The class or the node is in read-only mode and rejects writes",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      }
+    },
+    "/objects/{className}/{id}/vector": {
+      "put": {
+        "description": "Replace the vector(s) of an existing data object without resending its properties. The vector index entries and the internal document version are updated, the properties are kept as they are. This is useful when re-embedding objects with a new model.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Replace the vector(s) of an Object based on its UUID.",
+        "operationId": "objects.class.vector.put",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The class name as defined in the schema",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The vector(s) to replace the existing ones with.",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ObjectVectorsUpdate"
+            }
+          },
+          {
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonTenantParameterQuery"
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Successfully replaced the vector(s)."
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "The object or its class doesn't exist."
+          },
+          "422": {
+            "description": "Request is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the vector dimensions match the class?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "423": {
+            "description": "The class or the node is in read-only mode and rejects writes",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      }
+    },
+    "/objects/{id}": {
+      "get": {
+        "description": "Lists Objects.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Get a specific Object based on its UUID and a Object UUID. Also available as Websocket bus.",
+        "operationId": "objects.get",
+        "deprecated": true,
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Include additional information, such as classification infos. Allowed values include: classification, vector, interpretation",
+            "name": "include",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successful response.",
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "Version of the object, can be sent in the If-Match header of a write to only apply the write if the object has not changed since"
+              }
+            },
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.query"
+        ]
+      },
+      "put": {
+        "description": "Updates an Object's data. Given meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Update an Object based on its UUID.",
+        "operationId": "objects.update",
+        "deprecated": true,
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
+          },
+          {
+            "type": "string",
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+            "name": "consistency_level",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Only apply the write if the object has not changed since it was read: the ETag of the object as returned by GET /objects/{className}/{id}. Multiple comma-separated ETags are allowed..",
+            "name": "If-Match",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully received.",
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "412": {
+            "description": "The object has changed since it was read, its current ETag does not match the If-Match header.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "423": {
+            "description": "The class or the node is in read-only mode and rejects writes",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      },
+      "delete": {
+        "description": "Deletes an Object from the system.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Delete an Object based on its UUID.",
+        "operationId": "objects.delete",
+        "deprecated": true,
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+            "name": "consistency_level",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Specifies the tenant in a request targeting a multi-tenant class",
+            "name": "tenant",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Only apply the write if the object has not changed since it was read: the ETag of the object as returned by GET /objects/{className}/{id}. Multiple comma-separated ETags are allowed..",
+            "name": "If-Match",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Successfully deleted."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "412": {
+            "description": "The object has changed since it was read, its current ETag does not match the If-Match header.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "423": {
+            "description": "The class or the node is in read-only mode and rejects writes",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": true,
+        "x-available-in-websocket": true,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      },
+      "head": {
+        "description": "Checks if an Object exists in the system.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Checks Object's existence based on its UUID.",
+        "operationId": "objects.head",
+        "deprecated": true,
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Object exists."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Object doesn't exist."
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": true,
+        "x-available-in-websocket": true,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      },
+      "patch": {
+        "description": "Updates an Object. This method supports json-merge style patch semantics (RFC 7396). Provided meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Update an Object based on its UUID (using patch semantics).",
+        "operationId": "objects.patch",
+        "deprecated": true,
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "RFC 7396-style patch, the body contains the object to merge into the existing object.",
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
+          },
+          {
+            "type": "string",
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+            "name": "consistency_level",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Only apply the write if the object has not changed since it was read: the ETag of the object as returned by GET /objects/{className}/{id}. Multiple comma-separated ETags are allowed..",
+            "name": "If-Match",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Successfully applied. No content provided."
+          },
+          "400": {
+            "description": "The patch-JSON is malformed."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "412": {
+            "description": "The object has changed since it was read, its current ETag does not match the If-Match header.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "The patch-JSON is valid but unprocessable.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "423": {
+            "description": "The class or the node is in read-only mode and rejects writes",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      }
+    },
+    "/objects/{id}/references/{propertyName}": {
+      "put": {
+        "description": "Replace all references to a class-property.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Replace all references to a class-property.",
+        "operationId": "objects.references.update",
+        "deprecated": true,
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Unique name of the property related to the Object.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/MultipleRef"
+            }
+          },
+          {
+            "type": "string",
+            "description": "Specifies the tenant in a request targeting a multi-tenant class",
+            "name": "tenant",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully replaced all the references."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "423": {
+            "description": "The class or the node is in read-only mode and rejects writes",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      },
+      "post": {
+        "description": "Add a single reference to a class-property.",
         "tags": [
-          "batch",
           "objects"
         ],
-        "summary": "Creates new Objects based on a Object template as a batch.",
-        "operationId": "batch.objects.create",
+        "summary": "Add a single reference to a class-property.",
+        "operationId": "objects.references.create",
+        "deprecated": true,
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Unique name of the property related to the Object.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/SingleRef"
+            }
+          },
+          {
+            "type": "string",
+            "description": "Specifies the tenant in a request targeting a multi-tenant class",
+            "name": "tenant",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully added the reference."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "423": {
+            "description": "The class or the node is in read-only mode and rejects writes",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      },
+      "delete": {
+        "description": "Delete the single reference that is given in the body from the list of references that this property has.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Delete the single reference that is given in the body from the list of references that this property has.",
+        "operationId": "objects.references.delete",
+        "deprecated": true,
         "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Unique name of the property related to the Object.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "type": "object",
-              "properties": {
-                "fields": {
-                  "description": "Define which fields need to be returned. Default value is ALL",
-                  "type": "array",
-                  "items": {
-                    "type": "string",
-                    "default": "ALL",
-                    "enum": [
-                      "ALL",
-                      "class",
-                      "schema",
-                      "id",
-                      "creationTimeUnix"
-                    ]
-                  }
-                },
-                "objects": {
-                  "type": "array",
-                  "items": {
-                    "$ref": "#/definitions/Object"
-                  }
-                }
-              }
+              "$ref": "#/definitions/SingleRef"
             }
           },
           {
             "type": "string",
-            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
-            "name": "consistency_level",
+            "description": "Specifies the tenant in a request targeting a multi-tenant class",
+            "name": "tenant",
             "in": "query"
           }
         ],
+        "responses": {
+          "204": {
+            "description": "Successfully deleted."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "423": {
+            "description": "The class or the node is in read-only mode and rejects writes",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      }
+    },
+    "/schema": {
+      "get": {
+        "tags": [
+          "schema"
+        ],
+        "summary": "Dump the current the database schema.",
+        "operationId": "schema.dump",
         "responses": {
           "200": {
-            "description": "Request succeeded, see response body to get detailed information about each batched item.",
+            "description": "Successfully dumped the database schema.",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/ObjectsGetResponse"
-              }
+              "$ref": "#/definitions/Schema"
             }
           },
-          "400": {
-            "description": "Malformed request.",
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.query.meta"
+        ]
+      },
+      "post": {
+        "tags": [
+          "schema"
+        ],
+        "summary": "Create a new Object class in the schema.",
+        "operationId": "schema.objects.create",
+        "parameters": [
+          {
+            "name": "objectClass",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Class"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Added the new Object class to the schema.",
+            "schema": {
+              "$ref": "#/definitions/Class"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid Object class",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.add.meta"
+        ]
+      }
+    },
+    "/schema/cluster-status": {
+      "get": {
+        "tags": [
+          "schema"
+        ],
+        "operationId": "schema.cluster.status",
+        "responses": {
+          "200": {
+            "description": "The schema in the cluster is in sync.",
+            "schema": {
+              "$ref": "#/definitions/SchemaClusterStatus"
+            }
+          },
+          "500": {
+            "description": "The schema is either out of sync (see response body) or the sync check could not be completed.",
+            "schema": {
+              "$ref": "#/definitions/SchemaClusterStatus"
+            }
+          }
+        }
+      }
+    },
+    "/schema/diff": {
+      "post": {
+        "description": "Computes the differences between the current schema and the provided target schema without applying any change. Use this endpoint to validate a schema migration before applying it.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Compare the current schema with a target schema.",
+        "operationId": "schema.diff",
+        "parameters": [
+          {
+            "name": "targetSchema",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Schema"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The differences between the current and the target schema.",
+            "schema": {
+              "$ref": "#/definitions/SchemaDiff"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -5279,7 +11079,7 @@ func init() {
             }
           },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Invalid target schema",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -5291,53 +11091,95 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.add"
+          "weaviate.local.query.meta"
         ]
-      },
-      "delete": {
-        "description": "Delete Objects in bulk that match a certain filter.",
+      }
+    },
+    "/schema/export": {
+      "get": {
+        "description": "Serializes all classes, including their module, vector index, sharding and replication configs, and the tenants of multi-tenant classes to a single document. Import it with POST /schema/import to recreate the schema on another cluster.",
+        "produces": [
+          "application/json",
+          "application/yaml"
+        ],
         "tags": [
-          "batch",
-          "objects"
+          "schema"
+        ],
+        "summary": "Export the full schema as a portable document.",
+        "operationId": "schema.export",
+        "parameters": [
+          {
+            "type": "string",
+            "default": "json",
+            "description": "The format of the document, either json or yaml. Default value is json.",
+            "name": "format",
+            "in": "query",
+            "enum": [
+              "json",
+              "yaml"
+            ]
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The exported schema.",
+            "schema": {
+              "$ref": "#/definitions/SchemaExport"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.query.meta"
+        ]
+      }
+    },
+    "/schema/history": {
+      "get": {
+        "description": "Every mutation of the schema is recorded with a monotonically increasing version and a timestamp. The versions are local to each node, compare them across the nodes of a cluster to debug schema drift.",
+        "tags": [
+          "schema"
         ],
-        "summary": "Deletes Objects based on a match filter as a batch.",
-        "operationId": "batch.objects.delete",
+        "summary": "Get the history of the schema mutations of this node.",
+        "operationId": "schema.history",
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/BatchDelete"
-            }
-          },
-          {
-            "type": "string",
-            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
-            "name": "consistency_level",
+            "type": "integer",
+            "format": "int64",
+            "default": 0,
+            "description": "Only return versions after this version. Default value is 0.",
+            "name": "after",
             "in": "query"
           },
           {
-            "type": "string",
-            "description": "Specifies the tenant in a request targeting a multi-tenant class",
-            "name": "tenant",
+            "type": "integer",
+            "format": "int64",
+            "default": 100,
+            "description": "The maximum number of versions to be returned. Default value is 100.",
+            "name": "limit",
             "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Request succeeded, see response body to get detailed information about each batched item.",
-            "schema": {
-              "$ref": "#/definitions/BatchDeleteResponse"
-            }
-          },
-          "400": {
-            "description": "Malformed request.",
+            "description": "The history of the schema mutations.",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/SchemaVersions"
             }
           },
           "401": {
@@ -5349,12 +11191,6 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -5362,56 +11198,32 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.query.meta"
         ]
       }
     },
-    "/batch/references": {
-      "post": {
-        "description": "Register cross-references between any class items (objects or objects) in bulk.",
+    "/schema/history/{version}": {
+      "get": {
         "tags": [
-          "batch",
-          "references"
+          "schema"
         ],
-        "summary": "Creates new Cross-References between arbitrary classes in bulk.",
-        "operationId": "batch.references.create",
+        "summary": "Get the schema as of a given version.",
+        "operationId": "schema.history.get",
         "parameters": [
           {
-            "description": "A list of references to be batched. The ideal size depends on the used database connector. Please see the documentation of the used connector for help",
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/BatchReference"
-              }
-            }
-          },
-          {
-            "type": "string",
-            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
-            "name": "consistency_level",
-            "in": "query"
+            "type": "integer",
+            "format": "int64",
+            "name": "version",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "Request Successful. Warning: A successful request does not guarantee that every batched reference was successfully created. Inspect the response body to see which references succeeded and which failed.",
-            "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/BatchReferenceResponse"
-              }
-            }
-          },
-          "400": {
-            "description": "Malformed request.",
+            "description": "The schema as of the given version.",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/Schema"
             }
           },
           "401": {
@@ -5423,11 +11235,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+          "404": {
+            "description": "This schema version does not exist on this node."
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -5436,43 +11245,38 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.add"
+          "weaviate.local.query.meta"
         ]
       }
     },
-    "/classifications/": {
+    "/schema/import": {
       "post": {
-        "description": "Trigger a classification based on the specified params. Classifications will run in the background, use GET /classifications/\u003cid\u003e to retrieve the status of your classification.",
+        "description": "Creates all classes and tenants of the document which do not exist yet and adds missing properties and updated settings to existing classes. Classes which are not part of the document are left untouched, so importing the same document again does not change anything. The import is rejected if it contains changes which can not be applied to the existing schema, use POST /schema/diff to inspect them upfront.",
+        "consumes": [
+          "application/json",
+          "application/yaml"
+        ],
         "tags": [
-          "classifications"
+          "schema"
         ],
-        "summary": "Starts a classification.",
-        "operationId": "classifications.post",
+        "summary": "Import a schema exported with GET /schema/export.",
+        "operationId": "schema.import",
         "parameters": [
           {
-            "description": "parameters to start a classification",
-            "name": "params",
+            "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Classification"
+              "$ref": "#/definitions/SchemaExport"
             }
           }
         ],
         "responses": {
-          "201": {
-            "description": "Successfully started classification.",
-            "schema": {
-              "$ref": "#/definitions/Classification"
-            }
-          },
-          "400": {
-            "description": "Incorrect request",
+          "200": {
+            "description": "The schema was imported.",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/SchemaImportResult"
             }
           },
           "401": {
@@ -5484,6 +11288,12 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "422": {
+            "description": "The document is invalid or contains changes which can not be applied to the existing schema.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -5492,32 +11302,26 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.classifications.post"
+          "weaviate.local.manipulate.meta"
         ]
       }
     },
-    "/classifications/{id}": {
+    "/schema/stopwords": {
       "get": {
-        "description": "Get status, results and metadata of a previously created classification",
+        "description": "Custom stopword sets are named lists of words which classes can reference in invertedIndexConfig.stopwords.sets, in addition to the preset and the additions.",
         "tags": [
-          "classifications"
-        ],
-        "summary": "View previously created classification",
-        "operationId": "classifications.get",
-        "parameters": [
-          {
-            "type": "string",
-            "description": "classification id",
-            "name": "id",
-            "in": "path",
-            "required": true
-          }
+          "schema"
         ],
+        "summary": "Get the custom stopword sets.",
+        "operationId": "schema.stopwords.list",
         "responses": {
           "200": {
-            "description": "Found the classification, returned as body",
+            "description": "The custom stopword sets.",
             "schema": {
-              "$ref": "#/definitions/Classification"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/StopwordSet"
+              }
             }
           },
           "401": {
@@ -5529,9 +11333,6 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Not Found - Classification does not exist"
-          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -5540,35 +11341,29 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.classifications.get"
+          "weaviate.local.query.meta"
         ]
       }
     },
-    "/graphql": {
-      "post": {
-        "description": "Get an object based on GraphQL",
+    "/schema/stopwords/{setName}": {
+      "delete": {
         "tags": [
-          "graphql"
+          "schema"
         ],
-        "summary": "Get a response based on GraphQL",
-        "operationId": "graphql.post",
+        "summary": "Delete a custom stopword set.",
+        "operationId": "schema.stopwords.delete",
         "parameters": [
           {
-            "description": "The GraphQL query request parameters.",
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/GraphQLQuery"
-            }
+            "type": "string",
+            "description": "The name of the stopword set.",
+            "name": "setName",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "Successful query (with select).",
-            "schema": {
-              "$ref": "#/definitions/GraphQLResponse"
-            }
+            "description": "The stopword set was deleted or did not exist."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -5580,7 +11375,7 @@ func init() {
             }
           },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "The stopword set is still referenced by a class.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -5592,40 +11387,39 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query",
-          "weaviate.local.query.meta",
-          "weaviate.network.query",
-          "weaviate.network.query.meta"
+          "weaviate.local.manipulate.meta"
         ]
-      }
-    },
-    "/graphql/batch": {
-      "post": {
-        "description": "Perform a batched GraphQL query",
+      },
+      "put": {
+        "description": "The stopwords of all classes referencing the set change right away, as stopwords are only considered at query time.",
         "tags": [
-          "graphql"
+          "schema"
         ],
-        "summary": "Get a response based on GraphQL.",
-        "operationId": "graphql.batch",
+        "summary": "Create or replace a custom stopword set.",
+        "operationId": "schema.stopwords.put",
         "parameters": [
           {
-            "description": "The GraphQL queries.",
+            "type": "string",
+            "description": "The name of the stopword set.",
+            "name": "setName",
+            "in": "path",
+            "required": true
+          },
+          {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/GraphQLQueries"
+              "$ref": "#/definitions/StopwordSet"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Successful query (with select).",
+            "description": "The stopword set was stored.",
             "schema": {
-              "$ref": "#/definitions/GraphQLResponses"
+              "$ref": "#/definitions/StopwordSet"
             }
           },
           "401": {
@@ -5638,7 +11432,7 @@ func init() {
             }
           },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Invalid stopword set.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -5650,29 +11444,31 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query",
-          "weaviate.local.query.meta",
-          "weaviate.network.query",
-          "weaviate.network.query.meta"
+          "weaviate.local.manipulate.meta"
         ]
       }
     },
-    "/meta": {
+    "/schema/{className}": {
       "get": {
-        "description": "Gives meta information about the server and can be used to provide information to another Weaviate instance that wants to interact with the current instance.",
         "tags": [
-          "meta"
+          "schema"
+        ],
+        "summary": "Get a single class from the schema",
+        "operationId": "schema.objects.get",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          }
         ],
-        "summary": "Returns meta information of the current Weaviate instance.",
-        "operationId": "meta.get",
         "responses": {
           "200": {
-            "description": "Successful response.",
+            "description": "Found the Class, returned as body",
             "schema": {
-              "$ref": "#/definitions/Meta"
+              "$ref": "#/definitions/Class"
             }
           },
           "401": {
@@ -5684,32 +11480,56 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "This class does not exist"
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
-        "x-serviceIds": [
-          "weaviate.local.query.meta"
-        ]
-      }
-    },
-    "/nodes": {
-      "get": {
-        "description": "Returns status of Weaviate DB.",
-        "tags": [
-          "nodes"
+        },
+        "x-serviceIds": [
+          "weaviate.local.get.meta"
+        ]
+      },
+      "put": {
+        "description": "Use this endpoint to alter an existing class in the schema. Note that not all settings are mutable. If an error about immutable fields is returned and you still need to update this particular setting, you will have to delete the class (and the underlying data) and recreate. This endpoint cannot be used to modify properties. Instead use POST /v1/schema/{className}/properties. A typical use case for this endpoint is to update configuration, such as the vectorIndexConfig. Note that even in mutable sections, such as vectorIndexConfig, some fields may be immutable.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Update settings of an existing schema class",
+        "operationId": "schema.objects.update",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "objectClass",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Class"
+            }
+          },
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "If true, the update is only validated, but not applied.",
+            "name": "dryRun",
+            "in": "query",
+            "required": false
+          }
         ],
-        "operationId": "nodes.get",
         "responses": {
           "200": {
-            "description": "Nodes status successfully returned",
+            "description": "Class was updated successfully",
             "schema": {
-              "$ref": "#/definitions/NodesStatusResponse"
+              "$ref": "#/definitions/Class"
             }
           },
           "401": {
@@ -5722,13 +11542,13 @@ func init() {
             }
           },
           "404": {
-            "description": "Not Found - Backup does not exist",
+            "description": "Class to be updated does not exist",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
           "422": {
-            "description": "Invalid backup restoration status attempt.",
+            "description": "Invalid update attempt",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -5741,17 +11561,15 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.nodes.status.get"
+          "weaviate.local.manipulate.meta"
         ]
-      }
-    },
-    "/nodes/{className}": {
-      "get": {
-        "description": "Returns status of Weaviate DB.",
+      },
+      "delete": {
         "tags": [
-          "nodes"
+          "schema"
         ],
-        "operationId": "nodes.get.class",
+        "summary": "Remove an Object class (and all data in the instances) from the schema.",
+        "operationId": "schema.objects.delete",
         "parameters": [
           {
             "type": "string",
@@ -5762,9 +11580,12 @@ func init() {
         ],
         "responses": {
           "200": {
-            "description": "Nodes status successfully returned",
+            "description": "Removed the Object class from the schema."
+          },
+          "400": {
+            "description": "Could not delete the Object class.",
             "schema": {
-              "$ref": "#/definitions/NodesStatusResponse"
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
           "401": {
@@ -5776,18 +11597,6 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Not Found - Backup does not exist",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "422": {
-            "description": "Invalid backup restoration status attempt.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -5796,82 +11605,33 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.nodes.status.get.class"
+          "weaviate.local.manipulate.meta"
         ]
       }
     },
-    "/objects": {
+    "/schema/{className}/migrations": {
       "get": {
-        "description": "Lists all Objects in reverse order of creation, owned by the user that belongs to the used token.",
         "tags": [
-          "objects"
+          "schema"
         ],
-        "summary": "Get a list of Objects.",
-        "operationId": "objects.list",
+        "summary": "Get the progress of the property migrations of an Object class on this node",
+        "operationId": "schema.objects.migrations.get",
         "parameters": [
           {
             "type": "string",
-            "description": "The starting ID of the result window.",
-            "name": "after",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "format": "int64",
-            "default": 0,
-            "description": "The starting index of the result window. Default value is 0.",
-            "name": "offset",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "format": "int64",
-            "description": "The maximum number of items to be returned per page. Default value is set in Weaviate config.",
-            "name": "limit",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "Include additional information, such as classification infos. Allowed values include: classification, vector, interpretation",
-            "name": "include",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "Sort parameter to pass an information about the names of the sort fields",
-            "name": "sort",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "Order parameter to tell how to order (asc or desc) data within given field",
-            "name": "order",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "Class parameter specifies the class from which to query objects",
-            "name": "class",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "Specifies the tenant in a request targeting a multi-tenant class",
-            "name": "tenant",
-            "in": "query"
+            "name": "className",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "Successful response.",
-            "schema": {
-              "$ref": "#/definitions/ObjectsListResponse"
-            }
-          },
-          "400": {
-            "description": "Malformed request.",
+            "description": "Found the property migrations, returned as body",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/PropertyMigration"
+              }
             }
           },
           "401": {
@@ -5884,10 +11644,7 @@ func init() {
             }
           },
           "404": {
-            "description": "Successful query result but no resource was found."
-          },
-          "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "This class does not exist",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -5899,46 +11656,39 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query"
+          "weaviate.local.get.meta"
         ]
-      },
+      }
+    },
+    "/schema/{className}/properties": {
       "post": {
-        "description": "Registers a new Object. Provided meta-data and schema values are validated.",
         "tags": [
-          "objects"
+          "schema"
         ],
-        "summary": "Create Objects between two Objects (object and subject).",
-        "operationId": "objects.create",
+        "summary": "Add a property to an Object class.",
+        "operationId": "schema.objects.properties.add",
         "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Object"
+              "$ref": "#/definitions/Property"
             }
-          },
-          {
-            "type": "string",
-            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
-            "name": "consistency_level",
-            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Object created.",
-            "schema": {
-              "$ref": "#/definitions/Object"
-            }
-          },
-          "400": {
-            "description": "Malformed request.",
+            "description": "Added the property.",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/Property"
             }
           },
           "401": {
@@ -5951,7 +11701,7 @@ func init() {
             }
           },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Invalid property.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -5963,34 +11713,47 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.add"
+          "weaviate.local.manipulate.meta"
         ]
       }
     },
-    "/objects/validate": {
+    "/schema/{className}/properties/{propertyName}/rename": {
       "post": {
-        "description": "Validate an Object's schema and meta-data. It has to be based on a schema, which is related to the given Object to be accepted by this validation.",
+        "description": "Renames the property in the schema and its indexes right away. The stored objects are rewritten in the background, until this has completed writes to the class are rejected. The progress can be retrieved with the migrations endpoint of the class.",
         "tags": [
-          "objects"
+          "schema"
         ],
-        "summary": "Validate an Object based on a schema.",
-        "operationId": "objects.validate",
+        "summary": "Rename a property of an Object class.",
+        "operationId": "schema.objects.properties.rename",
         "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Object"
+              "$ref": "#/definitions/PropertyRename"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Successfully validated."
+            "description": "Renamed the property.",
+            "schema": {
+              "$ref": "#/definitions/Property"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -6002,7 +11765,7 @@ func init() {
             }
           },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Invalid rename of the property.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -6014,21 +11777,19 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query.meta"
+          "weaviate.local.manipulate.meta"
         ]
       }
     },
-    "/objects/{className}/{id}": {
-      "get": {
-        "description": "Get a single data object",
+    "/schema/{className}/properties/{propertyName}/tokenization": {
+      "put": {
+        "description": "Changes the tokenization in the schema and rebuilds the inverted index of the property in the background, until this has completed writes to the class are rejected. The progress can be retrieved with the migrations endpoint of the class. If the index can not be rebuilt, the previous tokenization is restored.",
         "tags": [
-          "objects"
+          "schema"
         ],
-        "summary": "Get a specific Object based on its class and UUID. Also available as Websocket bus.",
-        "operationId": "objects.class.get",
+        "summary": "Change the tokenization of a text property of an Object class.",
+        "operationId": "schema.objects.properties.tokenization.update",
         "parameters": [
           {
             "type": "string",
@@ -6038,48 +11799,24 @@ func init() {
           },
           {
             "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
+            "name": "propertyName",
             "in": "path",
             "required": true
           },
           {
-            "type": "string",
-            "description": "Include additional information, such as classification infos. Allowed values include: classification, vector, interpretation",
-            "name": "include",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
-            "name": "consistency_level",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "The target node which should fulfill the request",
-            "name": "node_name",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "Specifies the tenant in a request targeting a multi-tenant class",
-            "name": "tenant",
-            "in": "query"
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/PropertyTokenizationUpdate"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Successful response.",
-            "schema": {
-              "$ref": "#/definitions/Object"
-            }
-          },
-          "400": {
-            "description": "Malformed request.",
+            "description": "Changed the tokenization of the property.",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/Property"
             }
           },
           "401": {
@@ -6091,11 +11828,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Successful query result but no resource was found."
-          },
           "422": {
-            "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
+            "description": "Invalid change of the tokenization.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -6107,19 +11841,19 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query"
+          "weaviate.local.manipulate.meta"
         ]
-      },
-      "put": {
-        "description": "Update an individual data object based on its class and uuid.",
+      }
+    },
+    "/schema/{className}/references/audit": {
+      "post": {
+        "description": "Scans all reference properties of the objects of a class and reports every beacon pointing at an object which does not exist. If prune is set, the dangling references are removed from their objects.",
         "tags": [
-          "objects"
+          "schema"
         ],
-        "summary": "Update a class object based on its uuid",
-        "operationId": "objects.class.put",
+        "summary": "Find and optionally remove references pointing at nonexistent objects",
+        "operationId": "schema.objects.references.audit",
         "parameters": [
           {
             "type": "string",
@@ -6128,33 +11862,24 @@ func init() {
             "required": true
           },
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "The uuid of the data object to update.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/Object"
-            }
+            "type": "boolean",
+            "default": false,
+            "description": "Remove the dangling references from their objects. Default value is false.",
+            "name": "prune",
+            "in": "query"
           },
           {
             "type": "string",
-            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
-            "name": "consistency_level",
+            "description": "Specifies the tenant to audit, required for multi-tenant classes.",
+            "name": "tenant",
             "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Successfully received.",
+            "description": "The class has been audited, the result is returned as body",
             "schema": {
-              "$ref": "#/definitions/Object"
+              "$ref": "#/definitions/ReferenceAudit"
             }
           },
           "401": {
@@ -6167,10 +11892,13 @@ func init() {
             }
           },
           "404": {
-            "description": "Successful query result but no resource was found."
+            "description": "This class does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Invalid tenant for the class.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -6182,55 +11910,31 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
           "weaviate.local.manipulate"
         ]
-      },
-      "delete": {
-        "description": "Delete a single data object.",
+      }
+    },
+    "/schema/{className}/shards": {
+      "get": {
         "tags": [
-          "objects"
+          "schema"
         ],
-        "summary": "Delete object based on its class and UUID.",
-        "operationId": "objects.class.delete",
+        "summary": "Get the shards status of an Object class",
+        "operationId": "schema.objects.shards.get",
         "parameters": [
           {
             "type": "string",
             "name": "className",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
-            "name": "consistency_level",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "Specifies the tenant in a request targeting a multi-tenant class",
-            "name": "tenant",
-            "in": "query"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Successfully deleted."
-          },
-          "400": {
-            "description": "Malformed request.",
+          "200": {
+            "description": "Found the status of the shards, returned as body",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/ShardStatusList"
             }
           },
           "401": {
@@ -6243,10 +11947,7 @@ func init() {
             }
           },
           "404": {
-            "description": "Successful query result but no resource was found."
-          },
-          "422": {
-            "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
+            "description": "This class does not exist",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -6258,51 +11959,46 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": true,
-        "x-available-in-websocket": true,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.get.meta"
         ]
-      },
-      "head": {
-        "description": "Checks if a data object exists without retrieving it.",
+      }
+    },
+    "/schema/{className}/shards/{shardName}": {
+      "put": {
+        "description": "Update shard status of an Object Class",
         "tags": [
-          "objects"
+          "schema"
         ],
-        "summary": "Checks object's existence based on its class and uuid.",
-        "operationId": "objects.class.head",
+        "operationId": "schema.objects.shards.update",
         "parameters": [
           {
             "type": "string",
-            "description": "The class name as defined in the schema",
             "name": "className",
             "in": "path",
             "required": true
           },
           {
             "type": "string",
-            "format": "uuid",
-            "description": "The uuid of the data object",
-            "name": "id",
+            "name": "shardName",
             "in": "path",
             "required": true
           },
           {
-            "type": "string",
-            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
-            "name": "consistency_level",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "Specifies the tenant in a request targeting a multi-tenant class",
-            "name": "tenant",
-            "in": "query"
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ShardStatus"
+            }
           }
         ],
         "responses": {
-          "204": {
-            "description": "Object exists."
+          "200": {
+            "description": "Shard status was updated successfully",
+            "schema": {
+              "$ref": "#/definitions/ShardStatus"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -6314,10 +12010,13 @@ func init() {
             }
           },
           "404": {
-            "description": "Object doesn't exist."
+            "description": "Shard to be updated does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "422": {
-            "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
+            "description": "Invalid update attempt",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -6329,58 +12028,38 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": true,
-        "x-available-in-websocket": true,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.manipulate.meta"
         ]
-      },
-      "patch": {
-        "description": "Update an individual data object based on its class and uuid. This method supports json-merge style patch semantics (RFC 7396). Provided meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
+      }
+    },
+    "/schema/{className}/shards/{shardName}/compact": {
+      "post": {
+        "description": "Forces a full compaction of all LSM buckets and the vector index commit logs of a shard held by this node, e.g. to reclaim disk space after mass deletions, and reports the reclaimed bytes. The shard remains available during the compaction.",
         "tags": [
-          "objects"
+          "schema"
         ],
-        "summary": "Update an Object based on its UUID (using patch semantics).",
-        "operationId": "objects.class.patch",
+        "summary": "Compact the storage of a shard",
+        "operationId": "schema.objects.shards.compact",
         "parameters": [
           {
             "type": "string",
-            "description": "The class name as defined in the schema",
             "name": "className",
             "in": "path",
             "required": true
           },
           {
             "type": "string",
-            "format": "uuid",
-            "description": "The uuid of the data object to update.",
-            "name": "id",
+            "name": "shardName",
             "in": "path",
             "required": true
-          },
-          {
-            "description": "RFC 7396-style patch, the body contains the object to merge into the existing object.",
-            "name": "body",
-            "in": "body",
-            "schema": {
-              "$ref": "#/definitions/Object"
-            }
-          },
-          {
-            "type": "string",
-            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
-            "name": "consistency_level",
-            "in": "query"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Successfully applied. No content provided."
-          },
-          "400": {
-            "description": "The patch-JSON is malformed.",
+          "200": {
+            "description": "The shard has been compacted, the reclaimed space is returned as body",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/ShardCompaction"
             }
           },
           "401": {
@@ -6393,10 +12072,7 @@ func init() {
             }
           },
           "404": {
-            "description": "Successful query result but no resource was found."
-          },
-          "422": {
-            "description": "The patch-JSON is valid but unprocessable.",
+            "description": "This class does not exist",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -6408,73 +12084,44 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.manipulate.meta"
         ]
       }
     },
-    "/objects/{className}/{id}/references/{propertyName}": {
-      "put": {
-        "description": "Update all references of a property of a data object.",
+    "/schema/{className}/shards/{shardName}/vector-index/build": {
+      "post": {
+        "description": "Builds the graph of the empty vector index of a shard held by this node in bulk from the vectors of its objects. Classes with the vector index setting indexBuild \"gpu\" defer their inserts until the graph has been built on the GPU, which requires weaviate to be built with cuVS support. The shard has to be READONLY during the build.",
         "tags": [
-          "objects"
+          "schema"
         ],
-        "summary": "Replace all references to a class-property.",
-        "operationId": "objects.class.references.put",
+        "summary": "Build the vector index of a shard in bulk",
+        "operationId": "schema.objects.shards.vectorIndex.build",
         "parameters": [
           {
             "type": "string",
-            "description": "The class name as defined in the schema",
             "name": "className",
             "in": "path",
             "required": true
           },
           {
             "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "Unique name of the property related to the Object.",
-            "name": "propertyName",
+            "name": "shardName",
             "in": "path",
             "required": true
           },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/MultipleRef"
-            }
-          },
-          {
-            "type": "string",
-            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
-            "name": "consistency_level",
-            "in": "query"
-          },
           {
             "type": "string",
-            "description": "Specifies the tenant in a request targeting a multi-tenant class",
-            "name": "tenant",
+            "description": "Name of the target vector whose index to build. The class-level vector index is used if empty.",
+            "name": "targetVector",
             "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Successfully replaced all the references."
-          },
-          "400": {
-            "description": "Malformed request.",
+            "description": "The vector index has been built, the result is returned as body",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/VectorIndexBuild"
             }
           },
           "401": {
@@ -6487,10 +12134,7 @@ func init() {
             }
           },
           "404": {
-            "description": "Source object doesn't exist."
-          },
-          "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+            "description": "This class does not exist",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -6502,71 +12146,51 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.manipulate.meta"
         ]
-      },
+      }
+    },
+    "/schema/{className}/shards/{shardName}/vector-index/export": {
       "post": {
-        "description": "Add a single reference to a class-property.",
+        "description": "Writes the graph and the vectors of the vector index of a shard held by this node to a versioned, portable snapshot file in the snapshot directory configured with PERSISTENCE_VECTOR_INDEX_SNAPSHOT_PATH. The snapshot can be imported into an empty shard of another class or cluster using the same distance metric.",
         "tags": [
-          "objects"
+          "schema"
         ],
-        "summary": "Add a single reference to a class-property.",
-        "operationId": "objects.class.references.create",
+        "summary": "Export the vector index of a shard to a snapshot file",
+        "operationId": "schema.objects.shards.vectorIndex.export",
         "parameters": [
           {
             "type": "string",
-            "description": "The class name as defined in the schema",
             "name": "className",
             "in": "path",
             "required": true
           },
           {
             "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "Unique name of the property related to the Object.",
-            "name": "propertyName",
+            "name": "shardName",
             "in": "path",
             "required": true
           },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/SingleRef"
-            }
-          },
           {
             "type": "string",
-            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
-            "name": "consistency_level",
-            "in": "query"
+            "description": "Name of the snapshot file within the snapshot directory.",
+            "name": "file",
+            "in": "query",
+            "required": true
           },
           {
             "type": "string",
-            "description": "Specifies the tenant in a request targeting a multi-tenant class",
-            "name": "tenant",
+            "description": "Name of the target vector whose index to export. The class-level vector index is used if empty.",
+            "name": "targetVector",
             "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Successfully added the reference."
-          },
-          "400": {
-            "description": "Malformed request.",
+            "description": "The snapshot has been written, its description is returned as body",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/VectorIndexSnapshot"
             }
           },
           "401": {
@@ -6579,10 +12203,13 @@ func init() {
             }
           },
           "404": {
-            "description": "Source object doesn't exist."
+            "description": "This class does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+            "description": "Snapshots are disabled or the file name is invalid.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -6594,71 +12221,51 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.query"
         ]
-      },
-      "delete": {
-        "description": "Delete the single reference that is given in the body from the list of references that this property of a data object has",
+      }
+    },
+    "/schema/{className}/shards/{shardName}/vector-index/import": {
+      "post": {
+        "description": "Attaches a portable snapshot file from the snapshot directory configured with PERSISTENCE_VECTOR_INDEX_SNAPSHOT_PATH to the empty vector index of a shard held by this node. This allows indexes built offline, e.g. in a batch pipeline, to be used without re-inserting the vectors. The objects the snapshot refers to are not imported and need to be present in the shard with matching doc ids.",
         "tags": [
-          "objects"
+          "schema"
         ],
-        "summary": "Delete the single reference that is given in the body from the list of references that this property has.",
-        "operationId": "objects.class.references.delete",
+        "summary": "Import a snapshot file into the vector index of a shard",
+        "operationId": "schema.objects.shards.vectorIndex.import",
         "parameters": [
           {
             "type": "string",
-            "description": "The class name as defined in the schema",
             "name": "className",
             "in": "path",
             "required": true
           },
           {
             "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
+            "name": "shardName",
             "in": "path",
             "required": true
           },
           {
             "type": "string",
-            "description": "Unique name of the property related to the Object.",
-            "name": "propertyName",
-            "in": "path",
+            "description": "Name of the snapshot file within the snapshot directory.",
+            "name": "file",
+            "in": "query",
             "required": true
           },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/SingleRef"
-            }
-          },
-          {
-            "type": "string",
-            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
-            "name": "consistency_level",
-            "in": "query"
-          },
           {
             "type": "string",
-            "description": "Specifies the tenant in a request targeting a multi-tenant class",
-            "name": "tenant",
+            "description": "Name of the target vector whose index to import. The class-level vector index is used if empty.",
+            "name": "targetVector",
             "in": "query"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Successfully deleted."
-          },
-          "400": {
-            "description": "Malformed request.",
+          "200": {
+            "description": "The snapshot has been imported, its description is returned as body",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/VectorIndexSnapshot"
             }
           },
           "401": {
@@ -6671,13 +12278,13 @@ func init() {
             }
           },
           "404": {
-            "description": "Successful query result but no resource was found.",
+            "description": "This class does not exist",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+            "description": "Snapshots are disabled or the file name is invalid.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -6689,49 +12296,34 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.manipulate.meta"
         ]
       }
     },
-    "/objects/{id}": {
+    "/schema/{className}/synonyms": {
       "get": {
-        "description": "Lists Objects.",
+        "description": "Get the synonym rules of an Object class",
         "tags": [
-          "objects"
+          "schema"
         ],
-        "summary": "Get a specific Object based on its UUID and a Object UUID. Also available as Websocket bus.",
-        "operationId": "objects.get",
-        "deprecated": true,
+        "operationId": "schema.objects.synonyms.get",
         "parameters": [
           {
             "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
+            "name": "className",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "description": "Include additional information, such as classification infos. Allowed values include: classification, vector, interpretation",
-            "name": "include",
-            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Successful response.",
-            "schema": {
-              "$ref": "#/definitions/Object"
-            }
-          },
-          "400": {
-            "description": "Malformed request.",
+            "description": "Found the synonym rules of the class, returned as body",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/SynonymRule"
+              }
             }
           },
           "401": {
@@ -6744,7 +12336,10 @@ func init() {
             }
           },
           "404": {
-            "description": "Successful query result but no resource was found."
+            "description": "This class does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -6753,26 +12348,20 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query"
+          "weaviate.local.get.meta"
         ]
       },
       "put": {
-        "description": "Updates an Object's data. Given meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
+        "description": "Replace the synonym rules of an Object class",
         "tags": [
-          "objects"
+          "schema"
         ],
-        "summary": "Update an Object based on its UUID.",
-        "operationId": "objects.update",
-        "deprecated": true,
+        "operationId": "schema.objects.synonyms.update",
         "parameters": [
           {
             "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
+            "name": "className",
             "in": "path",
             "required": true
           },
@@ -6781,21 +12370,21 @@ func init() {
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Object"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/SynonymRule"
+              }
             }
-          },
-          {
-            "type": "string",
-            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
-            "name": "consistency_level",
-            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Successfully received.",
+            "description": "Replaced the synonym rules of the class",
             "schema": {
-              "$ref": "#/definitions/Object"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/SynonymRule"
+              }
             }
           },
           "401": {
@@ -6808,10 +12397,13 @@ func init() {
             }
           },
           "404": {
-            "description": "Successful query result but no resource was found."
+            "description": "This class does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Invalid synonym rules",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -6823,45 +12415,93 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.manipulate.meta"
         ]
-      },
-      "delete": {
-        "description": "Deletes an Object from the system.",
+      }
+    },
+    "/schema/{className}/tenants": {
+      "get": {
+        "description": "get all tenants from a specific class",
         "tags": [
-          "objects"
+          "schema"
         ],
-        "summary": "Delete an Object based on its UUID.",
-        "operationId": "objects.delete",
-        "deprecated": true,
+        "operationId": "tenants.get",
         "parameters": [
           {
             "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
+            "name": "className",
             "in": "path",
             "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "tenants from specified class.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Tenant"
+              }
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid Tenant class",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      },
+      "put": {
+        "description": "Update tenant of a specific class",
+        "tags": [
+          "schema"
+        ],
+        "operationId": "tenants.update",
+        "parameters": [
           {
             "type": "string",
-            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
-            "name": "consistency_level",
-            "in": "query"
+            "name": "className",
+            "in": "path",
+            "required": true
           },
           {
-            "type": "string",
-            "description": "Specifies the tenant in a request targeting a multi-tenant class",
-            "name": "tenant",
-            "in": "query"
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Tenant"
+              }
+            }
           }
         ],
         "responses": {
-          "204": {
-            "description": "Successfully deleted."
+          "200": {
+            "description": "Updated tenants of the specified class",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Tenant"
+              }
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -6872,43 +12512,54 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Successful query result but no resource was found."
+          "422": {
+            "description": "Invalid Tenant class",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          }
-        },
-        "x-available-in-mqtt": true,
-        "x-available-in-websocket": true,
-        "x-serviceIds": [
-          "weaviate.local.manipulate"
-        ]
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
       },
-      "head": {
-        "description": "Checks if an Object exists in the system.",
+      "post": {
+        "description": "Create a new tenant for a specific class",
         "tags": [
-          "objects"
+          "schema"
         ],
-        "summary": "Checks Object's existence based on its UUID.",
-        "operationId": "objects.head",
-        "deprecated": true,
+        "operationId": "tenants.create",
         "parameters": [
           {
             "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
+            "name": "className",
             "in": "path",
             "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Tenant"
+              }
+            }
           }
         ],
         "responses": {
-          "204": {
-            "description": "Object exists."
+          "200": {
+            "description": "Added new tenants to the specified class",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Tenant"
+              }
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -6919,8 +12570,11 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Object doesn't exist."
+          "422": {
+            "description": "Invalid Tenant class",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -6928,51 +12582,36 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        },
-        "x-available-in-mqtt": true,
-        "x-available-in-websocket": true,
-        "x-serviceIds": [
-          "weaviate.local.manipulate"
-        ]
+        }
       },
-      "patch": {
-        "description": "Updates an Object. This method supports json-merge style patch semantics (RFC 7396). Provided meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
+      "delete": {
+        "description": "delete tenants from a specific class",
         "tags": [
-          "objects"
+          "schema"
         ],
-        "summary": "Update an Object based on its UUID (using patch semantics).",
-        "operationId": "objects.patch",
-        "deprecated": true,
+        "operationId": "tenants.delete",
         "parameters": [
           {
             "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
+            "name": "className",
             "in": "path",
             "required": true
           },
           {
-            "description": "RFC 7396-style patch, the body contains the object to merge into the existing object.",
-            "name": "body",
+            "name": "tenants",
             "in": "body",
+            "required": true,
             "schema": {
-              "$ref": "#/definitions/Object"
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
             }
-          },
-          {
-            "type": "string",
-            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
-            "name": "consistency_level",
-            "in": "query"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Successfully applied. No content provided."
-          },
-          "400": {
-            "description": "The patch-JSON is malformed."
+          "200": {
+            "description": "Deleted tenants from specified class."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -6983,11 +12622,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Successful query result but no resource was found."
-          },
           "422": {
-            "description": "The patch-JSON is valid but unprocessable.",
+            "description": "Invalid Tenant class",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -6998,57 +12634,38 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
-        "x-serviceIds": [
-          "weaviate.local.manipulate"
-        ]
+        }
       }
     },
-    "/objects/{id}/references/{propertyName}": {
-      "put": {
-        "description": "Replace all references to a class-property.",
+    "/schema/{className}/vector-index/integrity": {
+      "post": {
+        "description": "Checks the HNSW graphs of the shards of a class on this node for orphan nodes, broken links and nodes which cannot be reached from the entrypoint. If repair is set, broken links are removed, an invalid entrypoint is replaced and unreachable nodes are reconnected. Only the shards held by the node receiving the request are checked.",
         "tags": [
-          "objects"
+          "schema"
         ],
-        "summary": "Replace all references to a class-property.",
-        "operationId": "objects.references.update",
-        "deprecated": true,
+        "summary": "Validate and optionally repair the vector index graphs of a class",
+        "operationId": "schema.objects.vectorIndex.integrity",
         "parameters": [
           {
             "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "Unique name of the property related to the Object.",
-            "name": "propertyName",
+            "name": "className",
             "in": "path",
             "required": true
           },
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/MultipleRef"
-            }
-          },
-          {
-            "type": "string",
-            "description": "Specifies the tenant in a request targeting a multi-tenant class",
-            "name": "tenant",
+            "type": "boolean",
+            "default": false,
+            "description": "Repair the damaged portions of the graphs. Default value is false.",
+            "name": "repair",
             "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Successfully replaced all the references."
+            "description": "The vector indexes have been checked, the result is returned as body",
+            "schema": {
+              "$ref": "#/definitions/VectorIndexIntegrityReport"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -7059,8 +12676,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+          "404": {
+            "description": "This class does not exist",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -7072,54 +12689,49 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
           "weaviate.local.manipulate"
         ]
-      },
+      }
+    },
+    "/schema/{className}/vector-index/recall": {
       "post": {
-        "description": "Add a single reference to a class-property.",
+        "description": "Samples indexed vectors of the shards of a class on this node and uses them as queries. The results of a regular search with the current ef settings are compared to those of an exact search to report the measured recall@k, e.g. to verify the health of an index after compression or large deletes. Only the shards held by the node receiving the request are measured.",
         "tags": [
-          "objects"
+          "schema"
         ],
-        "summary": "Add a single reference to a class-property.",
-        "operationId": "objects.references.create",
-        "deprecated": true,
+        "summary": "Measure the recall of the vector indexes of a class",
+        "operationId": "schema.objects.vectorIndex.recall",
         "parameters": [
           {
             "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "Unique name of the property related to the Object.",
-            "name": "propertyName",
+            "name": "className",
             "in": "path",
             "required": true
           },
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/SingleRef"
-            }
+            "type": "integer",
+            "format": "int64",
+            "default": 100,
+            "description": "The number of indexed vectors per shard to use as queries. Default value is 100.",
+            "name": "sampleSize",
+            "in": "query"
           },
           {
-            "type": "string",
-            "description": "Specifies the tenant in a request targeting a multi-tenant class",
-            "name": "tenant",
+            "type": "integer",
+            "format": "int64",
+            "default": 10,
+            "description": "The number of results per query to compute the recall for. Default value is 10.",
+            "name": "k",
             "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Successfully added the reference."
+            "description": "The recall has been measured, the result is returned as body",
+            "schema": {
+              "$ref": "#/definitions/VectorIndexRecallReport"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -7130,8 +12742,14 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "This class does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+            "description": "Invalid sample size or k.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -7143,54 +12761,33 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.query"
         ]
-      },
-      "delete": {
-        "description": "Delete the single reference that is given in the body from the list of references that this property has.",
+      }
+    },
+    "/schema/{className}/vector-index/tombstone-cleanup": {
+      "get": {
+        "description": "Returns per shard and target vector whether the scheduled cleanup of deleted nodes is paused, how many deleted nodes remain and how fast the last cleanup cycle was. Only the shards held by the node receiving the request are reported.",
         "tags": [
-          "objects"
+          "schema"
         ],
-        "summary": "Delete the single reference that is given in the body from the list of references that this property has.",
-        "operationId": "objects.references.delete",
-        "deprecated": true,
+        "summary": "Get the state of the tombstone cleanup of the vector indexes of a class",
+        "operationId": "schema.objects.vectorIndex.tombstoneCleanup",
         "parameters": [
           {
             "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "Unique name of the property related to the Object.",
-            "name": "propertyName",
+            "name": "className",
             "in": "path",
             "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/SingleRef"
-            }
-          },
-          {
-            "type": "string",
-            "description": "Specifies the tenant in a request targeting a multi-tenant class",
-            "name": "tenant",
-            "in": "query"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Successfully deleted."
+          "200": {
+            "description": "The state of the tombstone cleanup is returned as body",
+            "schema": {
+              "$ref": "#/definitions/VectorIndexTombstoneCleanupReport"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -7202,7 +12799,7 @@ func init() {
             }
           },
           "404": {
-            "description": "Successful query result but no resource was found.",
+            "description": "This class does not exist",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -7214,25 +12811,32 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.get.meta"
         ]
       }
     },
-    "/schema": {
-      "get": {
+    "/schema/{className}/vector-index/tombstone-cleanup/pause": {
+      "post": {
+        "description": "Stops scheduling cleanup cycles for deleted nodes in the vector indexes of the shards of a class on this node. A running cycle is aborted. Deletes keep working, deleted nodes accumulate until the cleanup is resumed. The pause is not persisted and ends when the shard is reloaded.",
         "tags": [
           "schema"
         ],
-        "summary": "Dump the current the database schema.",
-        "operationId": "schema.dump",
+        "summary": "Pause the tombstone cleanup of the vector indexes of a class",
+        "operationId": "schema.objects.vectorIndex.tombstoneCleanupPause",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          }
+        ],
         "responses": {
           "200": {
-            "description": "Successfully dumped the database schema.",
+            "description": "The tombstone cleanup has been paused, the new state is returned as body",
             "schema": {
-              "$ref": "#/definitions/Schema"
+              "$ref": "#/definitions/VectorIndexTombstoneCleanupReport"
             }
           },
           "401": {
@@ -7244,6 +12848,12 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "This class does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -7252,30 +12862,31 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.local.query.meta"
+          "weaviate.local.manipulate"
         ]
-      },
+      }
+    },
+    "/schema/{className}/vector-index/tombstone-cleanup/resume": {
       "post": {
+        "description": "Resumes scheduling cleanup cycles for deleted nodes in the vector indexes of the shards of a class on this node.",
         "tags": [
           "schema"
         ],
-        "summary": "Create a new Object class in the schema.",
-        "operationId": "schema.objects.create",
+        "summary": "Resume the tombstone cleanup of the vector indexes of a class",
+        "operationId": "schema.objects.vectorIndex.tombstoneCleanupResume",
         "parameters": [
           {
-            "name": "objectClass",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/Class"
-            }
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "Added the new Object class to the schema.",
+            "description": "The tombstone cleanup has been resumed, the new state is returned as body",
             "schema": {
-              "$ref": "#/definitions/Class"
+              "$ref": "#/definitions/VectorIndexTombstoneCleanupReport"
             }
           },
           "401": {
@@ -7287,8 +12898,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "422": {
-            "description": "Invalid Object class",
+          "404": {
+            "description": "This class does not exist",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -7300,718 +12911,1081 @@ func init() {
             }
           }
         },
-        "x-serviceIds": [
-          "weaviate.local.add.meta"
-        ]
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      }
+    }
+  },
+  "definitions": {
+    "AdditionalProperties": {
+      "description": "Additional Meta information about a single object object.",
+      "type": "object",
+      "additionalProperties": {
+        "type": "object"
+      }
+    },
+    "BM25Config": {
+      "description": "tuning parameters for the BM25 algorithm",
+      "type": "object",
+      "properties": {
+        "b": {
+          "description": "calibrates term-weight scaling based on the document length",
+          "type": "number",
+          "format": "float"
+        },
+        "k1": {
+          "description": "calibrates term-weight scaling based on the term frequency within a document",
+          "type": "number",
+          "format": "float"
+        },
+        "propertyBoosts": {
+          "description": "default weights of properties in keyword searches by property name. A weight set in the query takes precedence.",
+          "type": "object",
+          "additionalProperties": {
+            "type": "number",
+            "format": "float"
+          }
+        }
+      }
+    },
+    "BackupCreateRequest": {
+      "description": "Request body for creating a backup of a set of classes",
+      "properties": {
+        "config": {
+          "description": "Custom configuration for the backup creation process",
+          "type": "object"
+        },
+        "exclude": {
+          "description": "List of classes to exclude from the backup creation process",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "id": {
+          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+          "type": "string"
+        },
+        "include": {
+          "description": "List of classes to include in the backup creation process",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "BackupCreateResponse": {
+      "description": "The definition of a backup create response body",
+      "properties": {
+        "backend": {
+          "description": "Backup backend name e.g. filesystem, gcs, s3.",
+          "type": "string"
+        },
+        "classes": {
+          "description": "The list of classes for which the backup creation process was started",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "error": {
+          "description": "error message if creation failed",
+          "type": "string"
+        },
+        "id": {
+          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+          "type": "string"
+        },
+        "path": {
+          "description": "destination path of backup files proper to selected backend",
+          "type": "string"
+        },
+        "status": {
+          "description": "phase of backup creation process",
+          "type": "string",
+          "default": "STARTED",
+          "enum": [
+            "STARTED",
+            "TRANSFERRING",
+            "TRANSFERRED",
+            "SUCCESS",
+            "FAILED"
+          ]
+        }
+      }
+    },
+    "BackupCreateStatusResponse": {
+      "description": "The definition of a backup create metadata",
+      "properties": {
+        "backend": {
+          "description": "Backup backend name e.g. filesystem, gcs, s3.",
+          "type": "string"
+        },
+        "error": {
+          "description": "error message if creation failed",
+          "type": "string"
+        },
+        "id": {
+          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+          "type": "string"
+        },
+        "path": {
+          "description": "destination path of backup files proper to selected backend",
+          "type": "string"
+        },
+        "status": {
+          "description": "phase of backup creation process",
+          "type": "string",
+          "default": "STARTED",
+          "enum": [
+            "STARTED",
+            "TRANSFERRING",
+            "TRANSFERRED",
+            "SUCCESS",
+            "FAILED"
+          ]
+        }
+      }
+    },
+    "BackupRestoreRequest": {
+      "description": "Request body for restoring a backup for a set of classes",
+      "properties": {
+        "config": {
+          "description": "Custom configuration for the backup restoration process",
+          "type": "object"
+        },
+        "exclude": {
+          "description": "List of classes to exclude from the backup restoration process",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "include": {
+          "description": "List of classes to include in the backup restoration process",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "BackupRestoreResponse": {
+      "description": "The definition of a backup restore response body",
+      "properties": {
+        "backend": {
+          "description": "Backup backend name e.g. filesystem, gcs, s3.",
+          "type": "string"
+        },
+        "classes": {
+          "description": "The list of classes for which the backup restoration process was started",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "error": {
+          "description": "error message if restoration failed",
+          "type": "string"
+        },
+        "id": {
+          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+          "type": "string"
+        },
+        "path": {
+          "description": "destination path of backup files proper to selected backend",
+          "type": "string"
+        },
+        "status": {
+          "description": "phase of backup restoration process",
+          "type": "string",
+          "default": "STARTED",
+          "enum": [
+            "STARTED",
+            "TRANSFERRING",
+            "TRANSFERRED",
+            "SUCCESS",
+            "FAILED"
+          ]
+        }
+      }
+    },
+    "BackupRestoreStatusResponse": {
+      "description": "The definition of a backup restore metadata",
+      "properties": {
+        "backend": {
+          "description": "Backup backend name e.g. filesystem, gcs, s3.",
+          "type": "string"
+        },
+        "error": {
+          "description": "error message if restoration failed",
+          "type": "string"
+        },
+        "id": {
+          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+          "type": "string"
+        },
+        "path": {
+          "description": "destination path of backup files proper to selected backup backend",
+          "type": "string"
+        },
+        "status": {
+          "description": "phase of backup restoration process",
+          "type": "string",
+          "default": "STARTED",
+          "enum": [
+            "STARTED",
+            "TRANSFERRING",
+            "TRANSFERRED",
+            "SUCCESS",
+            "FAILED"
+          ]
+        }
+      }
+    },
+    "BatchDelete": {
+      "type": "object",
+      "properties": {
+        "dryRun": {
+          "description": "If true, objects will not be deleted yet, but merely listed. Defaults to false.",
+          "type": "boolean",
+          "default": false
+        },
+        "match": {
+          "description": "Outlines how to find the objects to be deleted.",
+          "type": "object",
+          "properties": {
+            "class": {
+              "description": "Class (name) which objects will be deleted.",
+              "type": "string",
+              "example": "City"
+            },
+            "where": {
+              "description": "Filter to limit the objects to be deleted.",
+              "type": "object",
+              "$ref": "#/definitions/WhereFilter"
+            }
+          }
+        },
+        "output": {
+          "description": "Controls the verbosity of the output, possible values are: \"minimal\", \"verbose\". Defaults to \"minimal\".",
+          "type": "string",
+          "default": "minimal"
+        }
       }
     },
-    "/schema/cluster-status": {
-      "get": {
-        "tags": [
-          "schema"
-        ],
-        "operationId": "schema.cluster.status",
-        "responses": {
-          "200": {
-            "description": "The schema in the cluster is in sync.",
-            "schema": {
-              "$ref": "#/definitions/SchemaClusterStatus"
-            }
-          },
-          "500": {
-            "description": "The schema is either out of sync (see response body) or the sync check could not be completed.",
-            "schema": {
-              "$ref": "#/definitions/SchemaClusterStatus"
-            }
-          }
+    "BatchDeleteMatch": {
+      "description": "Outlines how to find the objects to be deleted.",
+      "type": "object",
+      "properties": {
+        "class": {
+          "description": "Class (name) which objects will be deleted.",
+          "type": "string",
+          "example": "City"
+        },
+        "where": {
+          "description": "Filter to limit the objects to be deleted.",
+          "type": "object",
+          "$ref": "#/definitions/WhereFilter"
         }
       }
     },
-    "/schema/{className}": {
-      "get": {
-        "tags": [
-          "schema"
-        ],
-        "summary": "Get a single class from the schema",
-        "operationId": "schema.objects.get",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "className",
-            "in": "path",
-            "required": true
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "Found the Class, returned as body",
-            "schema": {
-              "$ref": "#/definitions/Class"
-            }
-          },
-          "401": {
-            "description": "Unauthorized or invalid credentials."
-          },
-          "403": {
-            "description": "Forbidden",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "404": {
-            "description": "This class does not exist"
-          },
-          "500": {
-            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          }
+    "BatchDeleteResponse": {
+      "description": "Delete Objects response.",
+      "type": "object",
+      "properties": {
+        "dryRun": {
+          "description": "If true, objects will not be deleted yet, but merely listed. Defaults to false.",
+          "type": "boolean",
+          "default": false
         },
-        "x-serviceIds": [
-          "weaviate.local.get.meta"
-        ]
-      },
-      "put": {
-        "description": "Use this endpoint to alter an existing class in the schema. Note that not all settings are mutable. If an error about immutable fields is returned and you still need to update this particular setting, you will have to delete the class (and the underlying data) and recreate. This endpoint cannot be used to modify properties. Instead use POST /v1/schema/{className}/properties. A typical use case for this endpoint is to update configuration, such as the vectorIndexConfig. Note that even in mutable sections, such as vectorIndexConfig, some fields may be immutable.",
-        "tags": [
-          "schema"
-        ],
-        "summary": "Update settings of an existing schema class",
-        "operationId": "schema.objects.update",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "objectClass",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/Class"
-            }
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "Class was updated successfully",
-            "schema": {
-              "$ref": "#/definitions/Class"
-            }
-          },
-          "401": {
-            "description": "Unauthorized or invalid credentials."
-          },
-          "403": {
-            "description": "Forbidden",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "404": {
-            "description": "Class to be updated does not exist",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "422": {
-            "description": "Invalid update attempt",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "500": {
-            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+        "match": {
+          "description": "Outlines how to find the objects to be deleted.",
+          "type": "object",
+          "properties": {
+            "class": {
+              "description": "Class (name) which objects will be deleted.",
+              "type": "string",
+              "example": "City"
+            },
+            "where": {
+              "description": "Filter to limit the objects to be deleted.",
+              "type": "object",
+              "$ref": "#/definitions/WhereFilter"
             }
           }
         },
-        "x-serviceIds": [
-          "weaviate.local.manipulate.meta"
-        ]
-      },
-      "delete": {
-        "tags": [
-          "schema"
-        ],
-        "summary": "Remove an Object class (and all data in the instances) from the schema.",
-        "operationId": "schema.objects.delete",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "className",
-            "in": "path",
-            "required": true
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "Removed the Object class from the schema."
-          },
-          "400": {
-            "description": "Could not delete the Object class.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "401": {
-            "description": "Unauthorized or invalid credentials."
-          },
-          "403": {
-            "description": "Forbidden",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "500": {
-            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+        "output": {
+          "description": "Controls the verbosity of the output, possible values are: \"minimal\", \"verbose\". Defaults to \"minimal\".",
+          "type": "string",
+          "default": "minimal"
+        },
+        "results": {
+          "type": "object",
+          "properties": {
+            "failed": {
+              "description": "How many objects should have been deleted but could not be deleted.",
+              "type": "number",
+              "format": "int64",
+              "x-omitempty": false
+            },
+            "limit": {
+              "description": "The most amount of objects that can be deleted in a single query, equals QUERY_MAXIMUM_RESULTS.",
+              "type": "number",
+              "format": "int64",
+              "x-omitempty": false
+            },
+            "matches": {
+              "description": "How many objects were matched by the filter.",
+              "type": "number",
+              "format": "int64",
+              "x-omitempty": false
+            },
+            "objects": {
+              "description": "With output set to \"minimal\" only objects with error occurred will the be described. Successfully deleted objects would be omitted. Output set to \"verbose\" will list all of the objets with their respective statuses.",
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/BatchDeleteResponseResultsObjectsItems0"
+              }
+            },
+            "successful": {
+              "description": "How many objects were successfully deleted in this round.",
+              "type": "number",
+              "format": "int64",
+              "x-omitempty": false
             }
           }
+        }
+      }
+    },
+    "BatchDeleteResponseMatch": {
+      "description": "Outlines how to find the objects to be deleted.",
+      "type": "object",
+      "properties": {
+        "class": {
+          "description": "Class (name) which objects will be deleted.",
+          "type": "string",
+          "example": "City"
         },
-        "x-serviceIds": [
-          "weaviate.local.manipulate.meta"
-        ]
+        "where": {
+          "description": "Filter to limit the objects to be deleted.",
+          "type": "object",
+          "$ref": "#/definitions/WhereFilter"
+        }
       }
     },
-    "/schema/{className}/properties": {
-      "post": {
-        "tags": [
-          "schema"
-        ],
-        "summary": "Add a property to an Object class.",
-        "operationId": "schema.objects.properties.add",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/Property"
-            }
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "Added the property.",
-            "schema": {
-              "$ref": "#/definitions/Property"
-            }
-          },
-          "401": {
-            "description": "Unauthorized or invalid credentials."
-          },
-          "403": {
-            "description": "Forbidden",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "422": {
-            "description": "Invalid property.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "500": {
-            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+    "BatchDeleteResponseResults": {
+      "type": "object",
+      "properties": {
+        "failed": {
+          "description": "How many objects should have been deleted but could not be deleted.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "limit": {
+          "description": "The most amount of objects that can be deleted in a single query, equals QUERY_MAXIMUM_RESULTS.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "matches": {
+          "description": "How many objects were matched by the filter.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "objects": {
+          "description": "With output set to \"minimal\" only objects with error occurred will the be described. Successfully deleted objects would be omitted. Output set to \"verbose\" will list all of the objets with their respective statuses.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/BatchDeleteResponseResultsObjectsItems0"
           }
         },
-        "x-serviceIds": [
-          "weaviate.local.manipulate.meta"
-        ]
+        "successful": {
+          "description": "How many objects were successfully deleted in this round.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        }
       }
     },
-    "/schema/{className}/shards": {
-      "get": {
-        "tags": [
-          "schema"
-        ],
-        "summary": "Get the shards status of an Object class",
-        "operationId": "schema.objects.shards.get",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "className",
-            "in": "path",
-            "required": true
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "Found the status of the shards, returned as body",
-            "schema": {
-              "$ref": "#/definitions/ShardStatusList"
-            }
-          },
-          "401": {
-            "description": "Unauthorized or invalid credentials."
-          },
-          "403": {
-            "description": "Forbidden",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "404": {
-            "description": "This class does not exist",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "500": {
-            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+    "BatchDeleteResponseResultsObjectsItems0": {
+      "description": "Results for this specific Object.",
+      "format": "object",
+      "properties": {
+        "errors": {
+          "$ref": "#/definitions/ErrorResponse"
+        },
+        "id": {
+          "description": "ID of the Object.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "status": {
+          "type": "string",
+          "default": "SUCCESS",
+          "enum": [
+            "SUCCESS",
+            "DRYRUN",
+            "FAILED"
+          ]
+        }
+      }
+    },
+    "BatchReference": {
+      "properties": {
+        "from": {
+          "description": "Long-form beacon-style URI to identify the source of the cross-ref including the property name. Should be in the form of weaviate://localhost/\u003ckinds\u003e/\u003cuuid\u003e/\u003cclassName\u003e/\u003cpropertyName\u003e, where \u003ckinds\u003e must be one of 'objects', 'objects' and \u003cclassName\u003e and \u003cpropertyName\u003e must represent the cross-ref property of source class to be used.",
+          "type": "string",
+          "format": "uri",
+          "example": "weaviate://localhost/Zoo/a5d09582-4239-4702-81c9-92a6e0122bb4/hasAnimals"
+        },
+        "tenant": {
+          "description": "Name of the reference tenant.",
+          "type": "string"
+        },
+        "to": {
+          "description": "Short-form URI to point to the cross-ref. Should be in the form of weaviate://localhost/\u003cuuid\u003e for the example of a local cross-ref to an object",
+          "type": "string",
+          "format": "uri",
+          "example": "weaviate://localhost/97525810-a9a5-4eb0-858a-71449aeb007f"
+        }
+      }
+    },
+    "BatchReferenceResponse": {
+      "type": "object",
+      "allOf": [
+        {
+          "$ref": "#/definitions/BatchReference"
+        },
+        {
+          "properties": {
+            "result": {
+              "description": "Results for this specific reference.",
+              "format": "object",
+              "properties": {
+                "errors": {
+                  "$ref": "#/definitions/ErrorResponse"
+                },
+                "status": {
+                  "type": "string",
+                  "default": "SUCCESS",
+                  "enum": [
+                    "SUCCESS",
+                    "PENDING",
+                    "FAILED"
+                  ]
+                }
+              }
             }
           }
+        }
+      ]
+    },
+    "BatchReferenceResponseAO1Result": {
+      "description": "Results for this specific reference.",
+      "format": "object",
+      "properties": {
+        "errors": {
+          "$ref": "#/definitions/ErrorResponse"
+        },
+        "status": {
+          "type": "string",
+          "default": "SUCCESS",
+          "enum": [
+            "SUCCESS",
+            "PENDING",
+            "FAILED"
+          ]
+        }
+      }
+    },
+    "BatchStats": {
+      "description": "The summary of a nodes batch queue congestion status.",
+      "properties": {
+        "queueLength": {
+          "description": "How many objects are currently in the batch queue.",
+          "type": "number",
+          "format": "int",
+          "x-omitempty": false
+        },
+        "ratePerSecond": {
+          "description": "How many objects are approximately processed from the batch queue per second.",
+          "type": "number",
+          "format": "int",
+          "x-omitempty": false
+        }
+      }
+    },
+    "BucketDiskUsage": {
+      "description": "The disk space used by the segments of an lsm bucket.",
+      "properties": {
+        "bucket": {
+          "description": "The name of the bucket.",
+          "type": "string"
         },
-        "x-serviceIds": [
-          "weaviate.local.get.meta"
-        ]
+        "bytes": {
+          "description": "The size of the segments of the bucket in bytes.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        }
       }
     },
-    "/schema/{className}/shards/{shardName}": {
-      "put": {
-        "description": "Update shard status of an Object Class",
-        "tags": [
-          "schema"
-        ],
-        "operationId": "schema.objects.shards.update",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "shardName",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/ShardStatus"
-            }
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "Shard status was updated successfully",
-            "schema": {
-              "$ref": "#/definitions/ShardStatus"
-            }
-          },
-          "401": {
-            "description": "Unauthorized or invalid credentials."
-          },
-          "403": {
-            "description": "Forbidden",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "404": {
-            "description": "Shard to be updated does not exist",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "422": {
-            "description": "Invalid update attempt",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "500": {
-            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          }
+    "C11yExtension": {
+      "description": "A resource describing an extension to the contextinoary, containing both the identifier and the definition of the extension",
+      "properties": {
+        "concept": {
+          "description": "The new concept you want to extend. Must be an all-lowercase single word, or a space delimited compound word. Examples: 'foobarium', 'my custom concept'",
+          "type": "string",
+          "example": "foobarium"
         },
-        "x-serviceIds": [
-          "weaviate.local.manipulate.meta"
-        ]
+        "definition": {
+          "description": "A list of space-delimited words or a sentence describing what the custom concept is about. Avoid using the custom concept itself. An Example definition for the custom concept 'foobarium': would be 'a naturally occurring element which can only be seen by programmers'",
+          "type": "string"
+        },
+        "weight": {
+          "description": "Weight of the definition of the new concept where 1='override existing definition entirely' and 0='ignore custom definition'. Note that if the custom concept is not present in the contextionary yet, the weight cannot be less than 1.",
+          "type": "number",
+          "format": "float"
+        }
       }
     },
-    "/schema/{className}/tenants": {
-      "get": {
-        "description": "get all tenants from a specific class",
-        "tags": [
-          "schema"
-        ],
-        "operationId": "tenants.get",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "className",
-            "in": "path",
-            "required": true
+    "C11yNearestNeighbors": {
+      "description": "C11y function to show the nearest neighbors to a word.",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/C11yNearestNeighborsItems0"
+      }
+    },
+    "C11yNearestNeighborsItems0": {
+      "type": "object",
+      "properties": {
+        "distance": {
+          "type": "number",
+          "format": "float"
+        },
+        "word": {
+          "type": "string"
+        }
+      }
+    },
+    "C11yVector": {
+      "description": "A Vector in the Contextionary",
+      "type": "array",
+      "items": {
+        "type": "number",
+        "format": "float"
+      }
+    },
+    "C11yVectorBasedQuestion": {
+      "description": "Receive question based on array of classes, properties and values.",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/C11yVectorBasedQuestionItems0"
+      }
+    },
+    "C11yVectorBasedQuestionItems0": {
+      "type": "object",
+      "properties": {
+        "classProps": {
+          "description": "Vectorized properties.",
+          "type": "array",
+          "maxItems": 300,
+          "minItems": 300,
+          "items": {
+            "$ref": "#/definitions/C11yVectorBasedQuestionItems0ClassPropsItems0"
           }
-        ],
-        "responses": {
-          "200": {
-            "description": "tenants from specified class.",
-            "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/Tenant"
-              }
-            }
-          },
-          "401": {
-            "description": "Unauthorized or invalid credentials."
-          },
-          "403": {
-            "description": "Forbidden",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "422": {
-            "description": "Invalid Tenant class",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "500": {
-            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+        },
+        "classVectors": {
+          "description": "Vectorized classname.",
+          "type": "array",
+          "maxItems": 300,
+          "minItems": 300,
+          "items": {
+            "type": "number",
+            "format": "float"
           }
         }
-      },
-      "put": {
-        "description": "Update tenant of a specific class",
-        "tags": [
-          "schema"
-        ],
-        "operationId": "tenants.update",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/Tenant"
-              }
-            }
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "Updated tenants of the specified class",
-            "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/Tenant"
-              }
-            }
-          },
-          "401": {
-            "description": "Unauthorized or invalid credentials."
-          },
-          "403": {
-            "description": "Forbidden",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "422": {
-            "description": "Invalid Tenant class",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "500": {
-            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+      }
+    },
+    "C11yVectorBasedQuestionItems0ClassPropsItems0": {
+      "type": "object",
+      "properties": {
+        "propsVectors": {
+          "type": "array",
+          "items": {
+            "type": "number",
+            "format": "float"
           }
+        },
+        "value": {
+          "description": "String with valuename.",
+          "type": "string"
         }
-      },
-      "post": {
-        "description": "Create a new tenant for a specific class",
-        "tags": [
-          "schema"
-        ],
-        "operationId": "tenants.create",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
+      }
+    },
+    "C11yWordsResponse": {
+      "description": "An array of available words and contexts.",
+      "properties": {
+        "concatenatedWord": {
+          "description": "Weighted results for all words",
+          "type": "object",
+          "properties": {
+            "concatenatedNearestNeighbors": {
+              "$ref": "#/definitions/C11yNearestNeighbors"
+            },
+            "concatenatedVector": {
+              "$ref": "#/definitions/C11yVector"
+            },
+            "concatenatedWord": {
+              "type": "string"
+            },
+            "singleWords": {
               "type": "array",
               "items": {
-                "$ref": "#/definitions/Tenant"
+                "format": "string"
               }
             }
           }
-        ],
-        "responses": {
-          "200": {
-            "description": "Added new tenants to the specified class",
-            "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/Tenant"
-              }
-            }
-          },
-          "401": {
-            "description": "Unauthorized or invalid credentials."
-          },
-          "403": {
-            "description": "Forbidden",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "422": {
-            "description": "Invalid Tenant class",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "500": {
-            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+        },
+        "individualWords": {
+          "description": "Weighted results for per individual word",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/C11yWordsResponseIndividualWordsItems0"
           }
         }
-      },
-      "delete": {
-        "description": "delete tenants from a specific class",
-        "tags": [
-          "schema"
-        ],
-        "operationId": "tenants.delete",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "tenants",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "type": "array",
-              "items": {
-                "type": "string"
-              }
-            }
+      }
+    },
+    "C11yWordsResponseConcatenatedWord": {
+      "description": "Weighted results for all words",
+      "type": "object",
+      "properties": {
+        "concatenatedNearestNeighbors": {
+          "$ref": "#/definitions/C11yNearestNeighbors"
+        },
+        "concatenatedVector": {
+          "$ref": "#/definitions/C11yVector"
+        },
+        "concatenatedWord": {
+          "type": "string"
+        },
+        "singleWords": {
+          "type": "array",
+          "items": {
+            "format": "string"
           }
-        ],
-        "responses": {
-          "200": {
-            "description": "Deleted tenants from specified class."
-          },
-          "401": {
-            "description": "Unauthorized or invalid credentials."
-          },
-          "403": {
-            "description": "Forbidden",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+        }
+      }
+    },
+    "C11yWordsResponseIndividualWordsItems0": {
+      "type": "object",
+      "properties": {
+        "info": {
+          "type": "object",
+          "properties": {
+            "nearestNeighbors": {
+              "$ref": "#/definitions/C11yNearestNeighbors"
+            },
+            "vector": {
+              "$ref": "#/definitions/C11yVector"
             }
+          }
+        },
+        "present": {
+          "type": "boolean"
+        },
+        "word": {
+          "type": "string"
+        }
+      }
+    },
+    "C11yWordsResponseIndividualWordsItems0Info": {
+      "type": "object",
+      "properties": {
+        "nearestNeighbors": {
+          "$ref": "#/definitions/C11yNearestNeighbors"
+        },
+        "vector": {
+          "$ref": "#/definitions/C11yVector"
+        }
+      }
+    },
+    "Class": {
+      "type": "object",
+      "properties": {
+        "class": {
+          "description": "Name of the class as URI relative to the schema URL.",
+          "type": "string"
+        },
+        "description": {
+          "description": "Description of the class.",
+          "type": "string"
+        },
+        "invertedIndexConfig": {
+          "$ref": "#/definitions/InvertedIndexConfig"
+        },
+        "memtableConfig": {
+          "$ref": "#/definitions/MemtableConfig"
+        },
+        "moduleConfig": {
+          "description": "Configuration specific to modules this Weaviate instance has installed",
+          "type": "object"
+        },
+        "multiTenancyConfig": {
+          "$ref": "#/definitions/MultiTenancyConfig"
+        },
+        "objectTTL": {
+          "$ref": "#/definitions/ObjectTTLConfig"
+        },
+        "properties": {
+          "description": "The properties of the class.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Property"
+          }
+        },
+        "quotaConfig": {
+          "$ref": "#/definitions/QuotaConfig"
+        },
+        "replicationConfig": {
+          "$ref": "#/definitions/ReplicationConfig"
+        },
+        "shardingConfig": {
+          "description": "Manage how the index should be sharded and distributed in the cluster",
+          "type": "object"
+        },
+        "strictProperties": {
+          "description": "Reject objects containing properties that are not part of the class instead of adding them through auto-schema.",
+          "type": "boolean"
+        },
+        "vectorConfig": {
+          "description": "Named vector spaces of this class, each with its own vector index and vectorizer",
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/VectorConfig"
+          }
+        },
+        "vectorDimensions": {
+          "description": "Length of the vectors of this class. If left empty, it is taken from the 'dimensions' setting of the vectorizer module or recorded on the first insert. Vectors of a different length are rejected. Named vector spaces record their own dimensions in vectorConfig.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "vectorIndexConfig": {
+          "description": "Vector-index config, that is specific to the type of index selected in vectorIndexType",
+          "type": "object"
+        },
+        "vectorIndexType": {
+          "description": "Name of the vector index to use, eg. (HNSW)",
+          "type": "string"
+        },
+        "vectorizer": {
+          "description": "Specify how the vectors for this class should be determined. The options are either 'none' - this means you have to import a vector with each object yourself - or the name of a module that provides vectorization capabilities, such as 'text2vec-contextionary'. If left empty, it will use the globally configured default which can itself either be 'none' or a specific module.",
+          "type": "string"
+        }
+      }
+    },
+    "Classification": {
+      "description": "Manage classifications, trigger them and view status of past classifications.",
+      "type": "object",
+      "properties": {
+        "basedOnProperties": {
+          "description": "base the text-based classification on these fields (of type text)",
+          "type": "array",
+          "items": {
+            "type": "string"
           },
-          "422": {
-            "description": "Invalid Tenant class",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+          "example": [
+            "description"
+          ]
+        },
+        "class": {
+          "description": "class (name) which is used in this classification",
+          "type": "string",
+          "example": "City"
+        },
+        "classifyProperties": {
+          "description": "which ref-property to set as part of the classification",
+          "type": "array",
+          "items": {
+            "type": "string"
           },
-          "500": {
-            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+          "example": [
+            "inCountry"
+          ]
+        },
+        "error": {
+          "description": "error message if status == failed",
+          "type": "string",
+          "default": "",
+          "example": "classify xzy: something went wrong"
+        },
+        "filters": {
+          "type": "object",
+          "properties": {
+            "sourceWhere": {
+              "description": "limit the objects to be classified",
+              "type": "object",
+              "$ref": "#/definitions/WhereFilter"
+            },
+            "targetWhere": {
+              "description": "Limit the possible sources when using an algorithm which doesn't really on training data, e.g. 'contextual'. When using an algorithm with a training set, such as 'knn', limit the training set instead",
+              "type": "object",
+              "$ref": "#/definitions/WhereFilter"
+            },
+            "trainingSetWhere": {
+              "description": "Limit the training objects to be considered during the classification. Can only be used on types with explicit training sets, such as 'knn'",
+              "type": "object",
+              "$ref": "#/definitions/WhereFilter"
             }
           }
+        },
+        "id": {
+          "description": "ID to uniquely identify this classification run",
+          "type": "string",
+          "format": "uuid",
+          "example": "ee722219-b8ec-4db1-8f8d-5150bb1a9e0c"
+        },
+        "meta": {
+          "description": "additional meta information about the classification",
+          "type": "object",
+          "$ref": "#/definitions/ClassificationMeta"
+        },
+        "settings": {
+          "description": "classification-type specific settings",
+          "type": "object"
+        },
+        "status": {
+          "description": "status of this classification",
+          "type": "string",
+          "enum": [
+            "running",
+            "completed",
+            "failed"
+          ],
+          "example": "running"
+        },
+        "type": {
+          "description": "which algorithm to use for classifications",
+          "type": "string"
         }
       }
-    }
-  },
-  "definitions": {
-    "AdditionalProperties": {
-      "description": "Additional Meta information about a single object object.",
+    },
+    "ClassificationFilters": {
       "type": "object",
-      "additionalProperties": {
-        "type": "object"
+      "properties": {
+        "sourceWhere": {
+          "description": "limit the objects to be classified",
+          "type": "object",
+          "$ref": "#/definitions/WhereFilter"
+        },
+        "targetWhere": {
+          "description": "Limit the possible sources when using an algorithm which doesn't really on training data, e.g. 'contextual'. When using an algorithm with a training set, such as 'knn', limit the training set instead",
+          "type": "object",
+          "$ref": "#/definitions/WhereFilter"
+        },
+        "trainingSetWhere": {
+          "description": "Limit the training objects to be considered during the classification. Can only be used on types with explicit training sets, such as 'knn'",
+          "type": "object",
+          "$ref": "#/definitions/WhereFilter"
+        }
       }
     },
-    "BM25Config": {
-      "description": "tuning parameters for the BM25 algorithm",
+    "ClassificationMeta": {
+      "description": "Additional information to a specific classification",
       "type": "object",
       "properties": {
-        "b": {
-          "description": "calibrates term-weight scaling based on the document length",
-          "type": "number",
-          "format": "float"
+        "completed": {
+          "description": "time when this classification finished",
+          "type": "string",
+          "format": "date-time",
+          "example": "2017-07-21T17:32:28Z"
         },
-        "k1": {
-          "description": "calibrates term-weight scaling based on the term frequency within a document",
-          "type": "number",
-          "format": "float"
+        "count": {
+          "description": "number of objects which were taken into consideration for classification",
+          "type": "integer",
+          "example": 147
+        },
+        "countFailed": {
+          "description": "number of objects which could not be classified - see error message for details",
+          "type": "integer",
+          "example": 7
+        },
+        "countSucceeded": {
+          "description": "number of objects successfully classified",
+          "type": "integer",
+          "example": 140
+        },
+        "started": {
+          "description": "time when this classification was started",
+          "type": "string",
+          "format": "date-time",
+          "example": "2017-07-21T17:32:28Z"
         }
       }
     },
-    "BackupCreateRequest": {
-      "description": "Request body for creating a backup of a set of classes",
+    "DanglingReference": {
+      "description": "A cross-reference pointing at an object which does not exist.",
+      "type": "object",
       "properties": {
-        "config": {
-          "description": "Custom configuration for the backup creation process",
-          "type": "object"
-        },
-        "exclude": {
-          "description": "List of classes to exclude from the backup creation process",
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
+        "beacon": {
+          "description": "The beacon of the missing object.",
+          "type": "string",
+          "format": "uri"
         },
         "id": {
-          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
-          "type": "string"
+          "description": "ID of the object holding the reference.",
+          "type": "string",
+          "format": "uuid"
         },
-        "include": {
-          "description": "List of classes to include in the backup creation process",
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
+        "property": {
+          "description": "Name of the reference property holding the reference.",
+          "type": "string"
         }
       }
     },
-    "BackupCreateResponse": {
-      "description": "The definition of a backup create response body",
+    "Deprecation": {
+      "type": "object",
       "properties": {
-        "backend": {
-          "description": "Backup backend name e.g. filesystem, gcs, s3.",
+        "apiType": {
+          "description": "Describes which API is effected, usually one of: REST, GraphQL",
           "type": "string"
         },
-        "classes": {
-          "description": "The list of classes for which the backup creation process was started",
+        "id": {
+          "description": "The id that uniquely identifies this particular deprecations (mostly used internally)",
+          "type": "string"
+        },
+        "locations": {
+          "description": "The locations within the specified API affected by this deprecation",
           "type": "array",
           "items": {
             "type": "string"
           }
         },
-        "error": {
-          "description": "error message if creation failed",
+        "mitigation": {
+          "description": "User-required object to not be affected by the (planned) removal",
           "type": "string"
         },
-        "id": {
-          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+        "msg": {
+          "description": "What this deprecation is about",
           "type": "string"
         },
-        "path": {
-          "description": "destination path of backup files proper to selected backend",
+        "plannedRemovalVersion": {
+          "description": "A best-effort guess of which upcoming version will remove the feature entirely",
           "type": "string"
         },
-        "status": {
-          "description": "phase of backup creation process",
+        "removedIn": {
+          "description": "If the feature has already been removed, it was removed in this version",
           "type": "string",
-          "default": "STARTED",
-          "enum": [
-            "STARTED",
-            "TRANSFERRING",
-            "TRANSFERRED",
-            "SUCCESS",
-            "FAILED"
-          ]
+          "x-nullable": true
+        },
+        "removedTime": {
+          "description": "If the feature has already been removed, it was removed at this timestamp",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "sinceTime": {
+          "description": "The deprecation was introduced in this version",
+          "type": "string",
+          "format": "date-time"
+        },
+        "sinceVersion": {
+          "description": "The deprecation was introduced in this version",
+          "type": "string"
+        },
+        "status": {
+          "description": "Whether the problematic API functionality is deprecated (planned to be removed) or already removed",
+          "type": "string"
         }
       }
     },
-    "BackupCreateStatusResponse": {
-      "description": "The definition of a backup create metadata",
+    "ErrorResponse": {
+      "description": "An error response given by Weaviate end-points.",
+      "type": "object",
       "properties": {
-        "backend": {
-          "description": "Backup backend name e.g. filesystem, gcs, s3.",
-          "type": "string"
-        },
         "error": {
-          "description": "error message if creation failed",
-          "type": "string"
-        },
-        "id": {
-          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ErrorResponseErrorItems0"
+          }
+        }
+      }
+    },
+    "ErrorResponseErrorItems0": {
+      "type": "object",
+      "properties": {
+        "message": {
           "type": "string"
+        }
+      }
+    },
+    "GeoCoordinates": {
+      "properties": {
+        "latitude": {
+          "description": "The latitude of the point on earth in decimal form",
+          "type": "number",
+          "format": "float",
+          "x-nullable": true
         },
-        "path": {
-          "description": "destination path of backup files proper to selected backend",
-          "type": "string"
+        "longitude": {
+          "description": "The longitude of the point on earth in decimal form",
+          "type": "number",
+          "format": "float",
+          "x-nullable": true
+        }
+      }
+    },
+    "GeoPolygon": {
+      "description": "A polygon on earth given by the coordinates of its ring",
+      "properties": {
+        "coordinates": {
+          "description": "The coordinates of the ring of the polygon. The ring is closed implicitly, the last coordinate does not need to repeat the first one.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/GeoCoordinates"
+          }
+        }
+      }
+    },
+    "GeoShape": {
+      "description": "A point, line string or polygon on earth",
+      "properties": {
+        "coordinates": {
+          "description": "The coordinates of the points making up the shape. The ring of a polygon is closed implicitly.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/GeoCoordinates"
+          }
         },
-        "status": {
-          "description": "phase of backup creation process",
+        "type": {
+          "description": "The type of the shape",
           "type": "string",
-          "default": "STARTED",
           "enum": [
-            "STARTED",
-            "TRANSFERRING",
-            "TRANSFERRED",
-            "SUCCESS",
-            "FAILED"
+            "point",
+            "lineString",
+            "polygon"
           ]
         }
       }
     },
-    "BackupRestoreRequest": {
-      "description": "Request body for restoring a backup for a set of classes",
+    "GraphQLError": {
+      "description": "An error response caused by a GraphQL query.",
       "properties": {
-        "config": {
-          "description": "Custom configuration for the backup restoration process",
-          "type": "object"
-        },
-        "exclude": {
-          "description": "List of classes to exclude from the backup restoration process",
+        "locations": {
           "type": "array",
           "items": {
-            "type": "string"
+            "$ref": "#/definitions/GraphQLErrorLocationsItems0"
           }
         },
-        "include": {
-          "description": "List of classes to include in the backup restoration process",
+        "message": {
+          "type": "string"
+        },
+        "path": {
           "type": "array",
           "items": {
             "type": "string"
@@ -8019,1632 +13993,1953 @@ func init() {
         }
       }
     },
-    "BackupRestoreResponse": {
-      "description": "The definition of a backup restore response body",
+    "GraphQLErrorLocationsItems0": {
+      "type": "object",
       "properties": {
-        "backend": {
-          "description": "Backup backend name e.g. filesystem, gcs, s3.",
+        "column": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "line": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "GraphQLQueries": {
+      "description": "A list of GraphQL queries.",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/GraphQLQuery"
+      }
+    },
+    "GraphQLQuery": {
+      "description": "GraphQL query based on: http://facebook.github.io/graphql/.",
+      "type": "object",
+      "properties": {
+        "operationName": {
+          "description": "The name of the operation if multiple exist in the query.",
           "type": "string"
         },
-        "classes": {
-          "description": "The list of classes for which the backup restoration process was started",
+        "query": {
+          "description": "Query based on GraphQL syntax.",
+          "type": "string"
+        },
+        "variables": {
+          "description": "Additional variables for the query.",
+          "type": "object"
+        }
+      }
+    },
+    "GraphQLResponse": {
+      "description": "GraphQL based response: http://facebook.github.io/graphql/.",
+      "properties": {
+        "data": {
+          "description": "GraphQL data object.",
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/JsonObject"
+          }
+        },
+        "errors": {
+          "description": "Array with errors.",
           "type": "array",
           "items": {
-            "type": "string"
-          }
+            "$ref": "#/definitions/GraphQLError"
+          },
+          "x-omitempty": true
+        }
+      }
+    },
+    "GraphQLResponses": {
+      "description": "A list of GraphQL responses.",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/GraphQLResponse"
+      }
+    },
+    "InvertedIndexConfig": {
+      "description": "Configure the inverted index built into Weaviate",
+      "type": "object",
+      "properties": {
+        "bm25": {
+          "$ref": "#/definitions/BM25Config"
         },
-        "error": {
-          "description": "error message if restoration failed",
-          "type": "string"
+        "cleanupIntervalSeconds": {
+          "description": "Asynchronous index clean up happens every n seconds",
+          "type": "number",
+          "format": "int"
         },
-        "id": {
-          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+        "compression": {
+          "description": "Block compression of the object and inverted index segments on disk. Options: 'none' (default), 'snappy' or 'zstd'",
           "type": "string"
         },
-        "path": {
-          "description": "destination path of backup files proper to selected backend",
-          "type": "string"
+        "indexNullState": {
+          "description": "Index each object with the null state",
+          "type": "boolean"
         },
-        "status": {
-          "description": "phase of backup restoration process",
-          "type": "string",
-          "default": "STARTED",
-          "enum": [
-            "STARTED",
-            "TRANSFERRING",
-            "TRANSFERRED",
-            "SUCCESS",
-            "FAILED"
-          ]
+        "indexPropertyLength": {
+          "description": "Index length of properties",
+          "type": "boolean"
+        },
+        "indexTimestampRangeFilters": {
+          "description": "Index the internal timestamps of each object in a range index, which serves range filters and sorting on them much faster. Requires indexTimestamps",
+          "type": "boolean"
+        },
+        "indexTimestamps": {
+          "description": "Index each object by its internal timestamps",
+          "type": "boolean"
+        },
+        "stopwords": {
+          "$ref": "#/definitions/StopwordConfig"
+        },
+        "synonyms": {
+          "description": "Synonyms the terms of keyword queries are expanded with",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/SynonymRule"
+          }
         }
       }
     },
-    "BackupRestoreStatusResponse": {
-      "description": "The definition of a backup restore metadata",
+    "JsonObject": {
+      "description": "JSON object value.",
+      "type": "object"
+    },
+    "Link": {
+      "type": "object",
       "properties": {
-        "backend": {
-          "description": "Backup backend name e.g. filesystem, gcs, s3.",
+        "documentationHref": {
+          "description": "weaviate documentation about this resource group",
           "type": "string"
         },
-        "error": {
-          "description": "error message if restoration failed",
+        "href": {
+          "description": "target of the link",
           "type": "string"
         },
-        "id": {
-          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+        "name": {
+          "description": "human readable name of the resource group",
           "type": "string"
         },
-        "path": {
-          "description": "destination path of backup files proper to selected backup backend",
+        "rel": {
+          "description": "relationship if both resources are related, e.g. 'next', 'previous', 'parent', etc.",
           "type": "string"
+        }
+      }
+    },
+    "MemtableConfig": {
+      "description": "Thresholds at which the memtables of the shards of a class are flushed to disk. A memtable is flushed as soon as any of them is reached. Unset values fall back to the global configuration.",
+      "properties": {
+        "maxAgeSeconds": {
+          "description": "Time in seconds since a memtable was created",
+          "type": "integer",
+          "format": "int64"
         },
-        "status": {
-          "description": "phase of backup restoration process",
-          "type": "string",
-          "default": "STARTED",
-          "enum": [
-            "STARTED",
-            "TRANSFERRING",
-            "TRANSFERRED",
-            "SUCCESS",
-            "FAILED"
-          ]
+        "maxSizeMB": {
+          "description": "Size of a memtable in MB",
+          "type": "integer",
+          "format": "int64"
+        },
+        "maxWALSizeMB": {
+          "description": "Size of the write-ahead log of a memtable in MB, which bounds the time it takes to recover it after a crash",
+          "type": "integer",
+          "format": "int64"
         }
       }
     },
-    "BatchDelete": {
+    "Meta": {
+      "description": "Contains meta information of the current Weaviate instance.",
       "type": "object",
       "properties": {
-        "dryRun": {
-          "description": "If true, objects will not be deleted yet, but merely listed. Defaults to false.",
-          "type": "boolean",
-          "default": false
+        "hostname": {
+          "description": "The url of the host.",
+          "type": "string",
+          "format": "url"
         },
-        "match": {
-          "description": "Outlines how to find the objects to be deleted.",
-          "type": "object",
-          "properties": {
-            "class": {
-              "description": "Class (name) which objects will be deleted.",
-              "type": "string",
-              "example": "City"
-            },
-            "where": {
-              "description": "Filter to limit the objects to be deleted.",
-              "type": "object",
-              "$ref": "#/definitions/WhereFilter"
-            }
-          }
+        "modules": {
+          "description": "Module-specific meta information",
+          "type": "object"
         },
-        "output": {
-          "description": "Controls the verbosity of the output, possible values are: \"minimal\", \"verbose\". Defaults to \"minimal\".",
-          "type": "string",
-          "default": "minimal"
+        "version": {
+          "description": "Version of weaviate you are currently running",
+          "type": "string"
         }
       }
     },
-    "BatchDeleteMatch": {
-      "description": "Outlines how to find the objects to be deleted.",
+    "MultiTenancyConfig": {
+      "description": "Configuration related to multi-tenancy within a class",
+      "properties": {
+        "enabled": {
+          "description": "Whether or not multi-tenancy is enabled for this class",
+          "type": "boolean",
+          "x-omitempty": false
+        }
+      }
+    },
+    "MultipleRef": {
+      "description": "Multiple instances of references to other objects.",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/SingleRef"
+      }
+    },
+    "NestedProperty": {
       "type": "object",
       "properties": {
-        "class": {
-          "description": "Class (name) which objects will be deleted.",
-          "type": "string",
-          "example": "City"
+        "dataType": {
+          "description": "Data type of the nested property. Can be any primitive data type or \"object\"/\"object[]\" for further nesting.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "where": {
-          "description": "Filter to limit the objects to be deleted.",
-          "type": "object",
-          "$ref": "#/definitions/WhereFilter"
+        "description": {
+          "description": "Description of the nested property.",
+          "type": "string"
+        },
+        "name": {
+          "description": "Name of the nested property.",
+          "type": "string"
+        },
+        "nestedProperties": {
+          "description": "The properties of the nested object. Only allowed for data types \"object\" and \"object[]\".",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/NestedProperty"
+          },
+          "x-omitempty": true
+        },
+        "tokenization": {
+          "description": "Determines tokenization of the nested property. Applies to text and text[] data types. See ` + "`" + `tokenization` + "`" + ` on properties for allowed values.",
+          "type": "string",
+          "enum": [
+            "word",
+            "lowercase",
+            "whitespace",
+            "field",
+            "lowercase_keyword",
+            "lowercase_keyword_tr",
+            "lowercase_keyword_de",
+            "cjk",
+            "stem_en",
+            "stem_de",
+            "stem_fr",
+            "stem_es",
+            "stem_it"
+          ]
         }
       }
     },
-    "BatchDeleteResponse": {
-      "description": "Delete Objects response.",
-      "type": "object",
+    "NodeReadOnlyStatus": {
+      "description": "The read-only mode of a node and of the classes on it",
       "properties": {
-        "dryRun": {
-          "description": "If true, objects will not be deleted yet, but merely listed. Defaults to false.",
-          "type": "boolean",
-          "default": false
-        },
-        "match": {
-          "description": "Outlines how to find the objects to be deleted.",
-          "type": "object",
-          "properties": {
-            "class": {
-              "description": "Class (name) which objects will be deleted.",
-              "type": "string",
-              "example": "City"
-            },
-            "where": {
-              "description": "Filter to limit the objects to be deleted.",
-              "type": "object",
-              "$ref": "#/definitions/WhereFilter"
-            }
+        "classes": {
+          "description": "The classes which are in read-only mode on this node",
+          "type": "array",
+          "items": {
+            "type": "string"
           }
         },
-        "output": {
-          "description": "Controls the verbosity of the output, possible values are: \"minimal\", \"verbose\". Defaults to \"minimal\".",
-          "type": "string",
-          "default": "minimal"
+        "readOnly": {
+          "description": "Whether all writes to the node are rejected",
+          "type": "boolean"
         },
-        "results": {
-          "type": "object",
-          "properties": {
-            "failed": {
-              "description": "How many objects should have been deleted but could not be deleted.",
-              "type": "number",
-              "format": "int64",
-              "x-omitempty": false
-            },
-            "limit": {
-              "description": "The most amount of objects that can be deleted in a single query, equals QUERY_MAXIMUM_RESULTS.",
-              "type": "number",
-              "format": "int64",
-              "x-omitempty": false
-            },
-            "matches": {
-              "description": "How many objects were matched by the filter.",
-              "type": "number",
-              "format": "int64",
-              "x-omitempty": false
-            },
-            "objects": {
-              "description": "With output set to \"minimal\" only objects with error occurred will the be described. Successfully deleted objects would be omitted. Output set to \"verbose\" will list all of the objets with their respective statuses.",
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/BatchDeleteResponseResultsObjectsItems0"
-              }
-            },
-            "successful": {
-              "description": "How many objects were successfully deleted in this round.",
-              "type": "number",
-              "format": "int64",
-              "x-omitempty": false
-            }
-          }
+        "reason": {
+          "description": "Why the node is in read-only mode. MANUAL if it was put into read-only mode explicitly, DISK_USAGE or MEMORY_USAGE if a resource usage threshold was exceeded.",
+          "type": "string"
         }
       }
     },
-    "BatchDeleteResponseMatch": {
-      "description": "Outlines how to find the objects to be deleted.",
-      "type": "object",
+    "NodeShardStatus": {
+      "description": "The definition of a node shard status response body",
       "properties": {
         "class": {
-          "description": "Class (name) which objects will be deleted.",
+          "description": "The name of shard's class.",
           "type": "string",
-          "example": "City"
+          "x-omitempty": false
         },
-        "where": {
-          "description": "Filter to limit the objects to be deleted.",
+        "diskUsage": {
+          "description": "The disk space used by the shard, broken down by its components.",
           "type": "object",
-          "$ref": "#/definitions/WhereFilter"
-        }
-      }
-    },
-    "BatchDeleteResponseResults": {
-      "type": "object",
-      "properties": {
-        "failed": {
-          "description": "How many objects should have been deleted but could not be deleted.",
+          "$ref": "#/definitions/ShardDiskUsage"
+        },
+        "loadStatus": {
+          "description": "Whether the shard is LOADED, LOADING or still UNLOADED, if shards are loaded lazily at startup.",
+          "type": "string",
+          "x-omitempty": false
+        },
+        "name": {
+          "description": "The name of the shard.",
+          "type": "string",
+          "x-omitempty": false
+        },
+        "objectCount": {
+          "description": "The number of objects in shard.",
           "type": "number",
           "format": "int64",
           "x-omitempty": false
         },
-        "limit": {
-          "description": "The most amount of objects that can be deleted in a single query, equals QUERY_MAXIMUM_RESULTS.",
+        "quarantinedSegments": {
+          "description": "The number of LSM segments of the shard which failed checksum verification and were quarantined. Their data is no longer served.",
           "type": "number",
           "format": "int64",
           "x-omitempty": false
         },
-        "matches": {
-          "description": "How many objects were matched by the filter.",
+        "vectorCacheBytes": {
+          "description": "The estimated memory used by the vector caches of the shard, in bytes.",
           "type": "number",
           "format": "int64",
           "x-omitempty": false
         },
-        "objects": {
-          "description": "With output set to \"minimal\" only objects with error occurred will the be described. Successfully deleted objects would be omitted. Output set to \"verbose\" will list all of the objets with their respective statuses.",
+        "vectorCacheMaxBytes": {
+          "description": "The memory budget of the vector caches of the shard in bytes, 0 if the caches are only limited by vectorCacheMaxObjects.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "vectorIndexIntegrity": {
+          "description": "The results of the latest vector index integrity check of the shard, if any.",
           "type": "array",
           "items": {
-            "$ref": "#/definitions/BatchDeleteResponseResultsObjectsItems0"
+            "$ref": "#/definitions/VectorIndexIntegrity"
           }
         },
-        "successful": {
-          "description": "How many objects were successfully deleted in this round.",
+        "vectorIndexTombstoneCleanup": {
+          "description": "The state of the tombstone cleanup of the vector indexes of the shard.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/VectorIndexTombstoneCleanup"
+          }
+        },
+        "vectorQueueLagMs": {
+          "description": "How long the oldest vector of the shard which is not indexed yet has been queued, in milliseconds. Only set if async indexing is enabled.",
           "type": "number",
           "format": "int64",
           "x-omitempty": false
-        }
-      }
-    },
-    "BatchDeleteResponseResultsObjectsItems0": {
-      "description": "Results for this specific Object.",
-      "format": "object",
-      "properties": {
-        "errors": {
-          "$ref": "#/definitions/ErrorResponse"
-        },
-        "id": {
-          "description": "ID of the Object.",
-          "type": "string",
-          "format": "uuid"
-        },
-        "status": {
-          "type": "string",
-          "default": "SUCCESS",
-          "enum": [
-            "SUCCESS",
-            "DRYRUN",
-            "FAILED"
-          ]
-        }
-      }
-    },
-    "BatchReference": {
-      "properties": {
-        "from": {
-          "description": "Long-form beacon-style URI to identify the source of the cross-ref including the property name. Should be in the form of weaviate://localhost/\u003ckinds\u003e/\u003cuuid\u003e/\u003cclassName\u003e/\u003cpropertyName\u003e, where \u003ckinds\u003e must be one of 'objects', 'objects' and \u003cclassName\u003e and \u003cpropertyName\u003e must represent the cross-ref property of source class to be used.",
-          "type": "string",
-          "format": "uri",
-          "example": "weaviate://localhost/Zoo/a5d09582-4239-4702-81c9-92a6e0122bb4/hasAnimals"
-        },
-        "tenant": {
-          "description": "Name of the reference tenant.",
-          "type": "string"
-        },
-        "to": {
-          "description": "Short-form URI to point to the cross-ref. Should be in the form of weaviate://localhost/\u003cuuid\u003e for the example of a local cross-ref to an object",
-          "type": "string",
-          "format": "uri",
-          "example": "weaviate://localhost/97525810-a9a5-4eb0-858a-71449aeb007f"
-        }
-      }
-    },
-    "BatchReferenceResponse": {
-      "type": "object",
-      "allOf": [
-        {
-          "$ref": "#/definitions/BatchReference"
-        },
-        {
-          "properties": {
-            "result": {
-              "description": "Results for this specific reference.",
-              "format": "object",
-              "properties": {
-                "errors": {
-                  "$ref": "#/definitions/ErrorResponse"
-                },
-                "status": {
-                  "type": "string",
-                  "default": "SUCCESS",
-                  "enum": [
-                    "SUCCESS",
-                    "PENDING",
-                    "FAILED"
-                  ]
-                }
-              }
-            }
-          }
-        }
-      ]
-    },
-    "BatchReferenceResponseAO1Result": {
-      "description": "Results for this specific reference.",
-      "format": "object",
-      "properties": {
-        "errors": {
-          "$ref": "#/definitions/ErrorResponse"
         },
-        "status": {
-          "type": "string",
-          "default": "SUCCESS",
-          "enum": [
-            "SUCCESS",
-            "PENDING",
-            "FAILED"
-          ]
+        "vectorQueueLength": {
+          "description": "The number of vectors of the shard which are queued and not indexed yet. Only set if async indexing is enabled.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
         }
       }
     },
-    "BatchStats": {
-      "description": "The summary of a nodes batch queue congestion status.",
+    "NodeStats": {
+      "description": "The summary of Weaviate's statistics.",
       "properties": {
-        "queueLength": {
-          "description": "How many objects are currently in the batch queue.",
+        "objectCount": {
+          "description": "The total number of objects in DB.",
           "type": "number",
-          "format": "int",
+          "format": "int64",
           "x-omitempty": false
         },
-        "ratePerSecond": {
-          "description": "How many objects are approximately processed from the batch queue per second.",
+        "shardCount": {
+          "description": "The count of Weaviate's shards.",
           "type": "number",
           "format": "int",
           "x-omitempty": false
         }
       }
     },
-    "C11yExtension": {
-      "description": "A resource describing an extension to the contextinoary, containing both the identifier and the definition of the extension",
+    "NodeStatus": {
+      "description": "The definition of a backup node status response body",
       "properties": {
-        "concept": {
-          "description": "The new concept you want to extend. Must be an all-lowercase single word, or a space delimited compound word. Examples: 'foobarium', 'my custom concept'",
-          "type": "string",
-          "example": "foobarium"
+        "batchStats": {
+          "description": "Weaviate batch statistics.",
+          "type": "object",
+          "$ref": "#/definitions/BatchStats"
         },
-        "definition": {
-          "description": "A list of space-delimited words or a sentence describing what the custom concept is about. Avoid using the custom concept itself. An Example definition for the custom concept 'foobarium': would be 'a naturally occurring element which can only be seen by programmers'",
+        "gitHash": {
+          "description": "The gitHash of Weaviate.",
           "type": "string"
         },
-        "weight": {
-          "description": "Weight of the definition of the new concept where 1='override existing definition entirely' and 0='ignore custom definition'. Note that if the custom concept is not present in the contextionary yet, the weight cannot be less than 1.",
-          "type": "number",
-          "format": "float"
-        }
-      }
-    },
-    "C11yNearestNeighbors": {
-      "description": "C11y function to show the nearest neighbors to a word.",
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/C11yNearestNeighborsItems0"
-      }
-    },
-    "C11yNearestNeighborsItems0": {
-      "type": "object",
-      "properties": {
-        "distance": {
-          "type": "number",
-          "format": "float"
+        "name": {
+          "description": "The name of the node.",
+          "type": "string"
         },
-        "word": {
+        "readOnly": {
+          "description": "The read-only mode of the node and of the classes on it.",
+          "type": "object",
+          "$ref": "#/definitions/NodeReadOnlyStatus"
+        },
+        "shards": {
+          "description": "The list of the shards with it's statistics.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/NodeShardStatus"
+          }
+        },
+        "stats": {
+          "description": "Weaviate overall statistics.",
+          "type": "object",
+          "$ref": "#/definitions/NodeStats"
+        },
+        "status": {
+          "description": "Node's status.",
+          "type": "string",
+          "default": "HEALTHY",
+          "enum": [
+            "HEALTHY",
+            "UNHEALTHY",
+            "UNAVAILABLE"
+          ]
+        },
+        "version": {
+          "description": "The version of Weaviate.",
           "type": "string"
         }
       }
     },
-    "C11yVector": {
-      "description": "A Vector in the Contextionary",
-      "type": "array",
-      "items": {
-        "type": "number",
-        "format": "float"
-      }
-    },
-    "C11yVectorBasedQuestion": {
-      "description": "Receive question based on array of classes, properties and values.",
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/C11yVectorBasedQuestionItems0"
-      }
-    },
-    "C11yVectorBasedQuestionItems0": {
+    "NodesStatusResponse": {
+      "description": "The status of all of the Weaviate nodes",
       "type": "object",
       "properties": {
-        "classProps": {
-          "description": "Vectorized properties.",
-          "type": "array",
-          "maxItems": 300,
-          "minItems": 300,
-          "items": {
-            "$ref": "#/definitions/C11yVectorBasedQuestionItems0ClassPropsItems0"
-          }
-        },
-        "classVectors": {
-          "description": "Vectorized classname.",
+        "nodes": {
           "type": "array",
-          "maxItems": 300,
-          "minItems": 300,
           "items": {
-            "type": "number",
-            "format": "float"
+            "$ref": "#/definitions/NodeStatus"
           }
         }
       }
     },
-    "C11yVectorBasedQuestionItems0ClassPropsItems0": {
+    "Object": {
       "type": "object",
       "properties": {
-        "propsVectors": {
-          "type": "array",
-          "items": {
-            "type": "number",
-            "format": "float"
-          }
+        "additional": {
+          "$ref": "#/definitions/AdditionalProperties"
         },
-        "value": {
-          "description": "String with valuename.",
+        "class": {
+          "description": "Class of the Object, defined in the schema.",
+          "type": "string"
+        },
+        "creationTimeUnix": {
+          "description": "Timestamp of creation of this Object in milliseconds since epoch UTC.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "id": {
+          "description": "ID of the Object.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "lastUpdateTimeUnix": {
+          "description": "Timestamp of the last Object update in milliseconds since epoch UTC.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "properties": {
+          "$ref": "#/definitions/PropertySchema"
+        },
+        "tenant": {
+          "description": "Name of the Objects tenant.",
           "type": "string"
+        },
+        "vector": {
+          "description": "This object's position in the Contextionary vector space. Read-only if using a vectorizer other than 'none'. Writable and required if using 'none' as vectorizer.",
+          "$ref": "#/definitions/C11yVector"
+        },
+        "vectorWeights": {
+          "$ref": "#/definitions/VectorWeights"
+        },
+        "vectors": {
+          "$ref": "#/definitions/Vectors",
+          "description": "This object's positions in the named vector spaces of its class. Read-only for vector spaces using a vectorizer module."
         }
       }
     },
-    "C11yWordsResponse": {
-      "description": "An array of available words and contexts.",
+    "ObjectTTLConfig": {
+      "description": "Configuration of the automatic expiry of the objects of a class",
       "properties": {
-        "concatenatedWord": {
-          "description": "Weighted results for all words",
-          "type": "object",
-          "properties": {
-            "concatenatedNearestNeighbors": {
-              "$ref": "#/definitions/C11yNearestNeighbors"
-            },
-            "concatenatedVector": {
-              "$ref": "#/definitions/C11yVector"
-            },
-            "concatenatedWord": {
-              "type": "string"
-            },
-            "singleWords": {
-              "type": "array",
-              "items": {
-                "format": "string"
-              }
-            }
-          }
+        "enabled": {
+          "description": "Whether or not objects of this class are deleted automatically once they have expired",
+          "type": "boolean",
+          "x-omitempty": false
         },
-        "individualWords": {
-          "description": "Weighted results for per individual word",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/C11yWordsResponseIndividualWordsItems0"
-          }
+        "ttlSeconds": {
+          "description": "Time to live of an object in seconds, counted from its creation time",
+          "type": "integer",
+          "format": "int64"
         }
       }
     },
-    "C11yWordsResponseConcatenatedWord": {
-      "description": "Weighted results for all words",
+    "ObjectVectorsUpdate": {
+      "description": "The vectors of an object to replace, without resending its properties.",
       "type": "object",
       "properties": {
-        "concatenatedNearestNeighbors": {
-          "$ref": "#/definitions/C11yNearestNeighbors"
-        },
-        "concatenatedVector": {
+        "vector": {
+          "description": "The new position of the object in the vector space of its class.",
           "$ref": "#/definitions/C11yVector"
         },
-        "concatenatedWord": {
-          "type": "string"
-        },
-        "singleWords": {
-          "type": "array",
-          "items": {
-            "format": "string"
-          }
+        "vectors": {
+          "description": "The new positions of the object in the named vector spaces of its class. Named vectors which are not provided are kept.",
+          "$ref": "#/definitions/Vectors"
         }
       }
     },
-    "C11yWordsResponseIndividualWordsItems0": {
+    "ObjectsGetResponse": {
       "type": "object",
-      "properties": {
-        "info": {
-          "type": "object",
+      "allOf": [
+        {
+          "$ref": "#/definitions/Object"
+        },
+        {
           "properties": {
-            "nearestNeighbors": {
-              "$ref": "#/definitions/C11yNearestNeighbors"
-            },
-            "vector": {
-              "$ref": "#/definitions/C11yVector"
+            "deprecations": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Deprecation"
+              }
             }
           }
         },
-        "present": {
-          "type": "boolean"
-        },
-        "word": {
-          "type": "string"
+        {
+          "properties": {
+            "result": {
+              "description": "Results for this specific Object.",
+              "format": "object",
+              "properties": {
+                "errors": {
+                  "$ref": "#/definitions/ErrorResponse"
+                },
+                "status": {
+                  "type": "string",
+                  "default": "SUCCESS",
+                  "enum": [
+                    "SUCCESS",
+                    "PENDING",
+                    "FAILED"
+                  ]
+                }
+              }
+            }
+          }
         }
-      }
+      ]
     },
-    "C11yWordsResponseIndividualWordsItems0Info": {
-      "type": "object",
+    "ObjectsGetResponseAO2Result": {
+      "description": "Results for this specific Object.",
+      "format": "object",
       "properties": {
-        "nearestNeighbors": {
-          "$ref": "#/definitions/C11yNearestNeighbors"
+        "errors": {
+          "$ref": "#/definitions/ErrorResponse"
         },
-        "vector": {
-          "$ref": "#/definitions/C11yVector"
+        "status": {
+          "type": "string",
+          "default": "SUCCESS",
+          "enum": [
+            "SUCCESS",
+            "PENDING",
+            "FAILED"
+          ]
         }
       }
     },
-    "Class": {
+    "ObjectsListResponse": {
+      "description": "List of Objects.",
       "type": "object",
       "properties": {
-        "class": {
-          "description": "Name of the class as URI relative to the schema URL.",
-          "type": "string"
-        },
-        "description": {
-          "description": "Description of the class.",
-          "type": "string"
-        },
-        "invertedIndexConfig": {
-          "$ref": "#/definitions/InvertedIndexConfig"
-        },
-        "moduleConfig": {
-          "description": "Configuration specific to modules this Weaviate instance has installed",
-          "type": "object"
-        },
-        "multiTenancyConfig": {
-          "$ref": "#/definitions/MultiTenancyConfig"
-        },
-        "properties": {
-          "description": "The properties of the class.",
+        "deprecations": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/Property"
+            "$ref": "#/definitions/Deprecation"
           }
         },
-        "replicationConfig": {
-          "$ref": "#/definitions/ReplicationConfig"
-        },
-        "shardingConfig": {
-          "description": "Manage how the index should be sharded and distributed in the cluster",
-          "type": "object"
-        },
-        "vectorIndexConfig": {
-          "description": "Vector-index config, that is specific to the type of index selected in vectorIndexType",
-          "type": "object"
+        "objects": {
+          "description": "The actual list of Objects.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Object"
+          }
         },
-        "vectorIndexType": {
-          "description": "Name of the vector index to use, eg. (HNSW)",
+        "scrollId": {
+          "description": "The ID of the scroll session, if the objects were listed with the scroll parameter.",
           "type": "string"
         },
-        "vectorizer": {
-          "description": "Specify how the vectors for this class should be determined. The options are either 'none' - this means you have to import a vector with each object yourself - or the name of a module that provides vectorization capabilities, such as 'text2vec-contextionary'. If left empty, it will use the globally configured default which can itself either be 'none' or a specific module.",
-          "type": "string"
+        "totalResults": {
+          "description": "The total number of Objects for the query. The number of items in a response may be smaller due to paging.",
+          "type": "integer",
+          "format": "int64"
         }
       }
     },
-    "Classification": {
-      "description": "Manage classifications, trigger them and view status of past classifications.",
-      "type": "object",
+    "PatchDocumentAction": {
+      "description": "Either a JSONPatch document as defined by RFC 6902 (from, op, path, value), or a merge document (RFC 7396).",
+      "required": [
+        "op",
+        "path"
+      ],
       "properties": {
-        "basedOnProperties": {
-          "description": "base the text-based classification on these fields (of type text)",
-          "type": "array",
-          "items": {
-            "type": "string"
-          },
-          "example": [
-            "description"
-          ]
-        },
-        "class": {
-          "description": "class (name) which is used in this classification",
-          "type": "string",
-          "example": "City"
-        },
-        "classifyProperties": {
-          "description": "which ref-property to set as part of the classification",
-          "type": "array",
-          "items": {
-            "type": "string"
-          },
-          "example": [
-            "inCountry"
-          ]
-        },
-        "error": {
-          "description": "error message if status == failed",
-          "type": "string",
-          "default": "",
-          "example": "classify xzy: something went wrong"
-        },
-        "filters": {
-          "type": "object",
-          "properties": {
-            "sourceWhere": {
-              "description": "limit the objects to be classified",
-              "type": "object",
-              "$ref": "#/definitions/WhereFilter"
-            },
-            "targetWhere": {
-              "description": "Limit the possible sources when using an algorithm which doesn't really on training data, e.g. 'contextual'. When using an algorithm with a training set, such as 'knn', limit the training set instead",
-              "type": "object",
-              "$ref": "#/definitions/WhereFilter"
-            },
-            "trainingSetWhere": {
-              "description": "Limit the training objects to be considered during the classification. Can only be used on types with explicit training sets, such as 'knn'",
-              "type": "object",
-              "$ref": "#/definitions/WhereFilter"
-            }
-          }
-        },
-        "id": {
-          "description": "ID to uniquely identify this classification run",
-          "type": "string",
-          "format": "uuid",
-          "example": "ee722219-b8ec-4db1-8f8d-5150bb1a9e0c"
-        },
-        "meta": {
-          "description": "additional meta information about the classification",
-          "type": "object",
-          "$ref": "#/definitions/ClassificationMeta"
+        "from": {
+          "description": "A string containing a JSON Pointer value.",
+          "type": "string"
         },
-        "settings": {
-          "description": "classification-type specific settings",
-          "type": "object"
+        "merge": {
+          "$ref": "#/definitions/Object"
         },
-        "status": {
-          "description": "status of this classification",
+        "op": {
+          "description": "The operation to be performed.",
           "type": "string",
           "enum": [
-            "running",
-            "completed",
-            "failed"
-          ],
-          "example": "running"
+            "add",
+            "remove",
+            "replace",
+            "move",
+            "copy",
+            "test"
+          ]
         },
-        "type": {
-          "description": "which algorithm to use for classifications",
+        "path": {
+          "description": "A JSON-Pointer.",
           "type": "string"
-        }
-      }
-    },
-    "ClassificationFilters": {
-      "type": "object",
-      "properties": {
-        "sourceWhere": {
-          "description": "limit the objects to be classified",
-          "type": "object",
-          "$ref": "#/definitions/WhereFilter"
-        },
-        "targetWhere": {
-          "description": "Limit the possible sources when using an algorithm which doesn't really on training data, e.g. 'contextual'. When using an algorithm with a training set, such as 'knn', limit the training set instead",
-          "type": "object",
-          "$ref": "#/definitions/WhereFilter"
         },
-        "trainingSetWhere": {
-          "description": "Limit the training objects to be considered during the classification. Can only be used on types with explicit training sets, such as 'knn'",
-          "type": "object",
-          "$ref": "#/definitions/WhereFilter"
+        "value": {
+          "description": "The value to be used within the operations.",
+          "type": "object"
         }
       }
     },
-    "ClassificationMeta": {
-      "description": "Additional information to a specific classification",
-      "type": "object",
+    "PatchDocumentObject": {
+      "description": "Either a JSONPatch document as defined by RFC 6902 (from, op, path, value), or a merge document (RFC 7396).",
+      "required": [
+        "op",
+        "path"
+      ],
       "properties": {
-        "completed": {
-          "description": "time when this classification finished",
-          "type": "string",
-          "format": "date-time",
-          "example": "2017-07-21T17:32:28Z"
+        "from": {
+          "description": "A string containing a JSON Pointer value.",
+          "type": "string"
         },
-        "count": {
-          "description": "number of objects which were taken into consideration for classification",
-          "type": "integer",
-          "example": 147
+        "merge": {
+          "$ref": "#/definitions/Object"
         },
-        "countFailed": {
-          "description": "number of objects which could not be classified - see error message for details",
-          "type": "integer",
-          "example": 7
+        "op": {
+          "description": "The operation to be performed.",
+          "type": "string",
+          "enum": [
+            "add",
+            "remove",
+            "replace",
+            "move",
+            "copy",
+            "test"
+          ]
         },
-        "countSucceeded": {
-          "description": "number of objects successfully classified",
-          "type": "integer",
-          "example": 140
+        "path": {
+          "description": "A JSON-Pointer.",
+          "type": "string"
         },
-        "started": {
-          "description": "time when this classification was started",
-          "type": "string",
-          "format": "date-time",
-          "example": "2017-07-21T17:32:28Z"
+        "value": {
+          "description": "The value to be used within the operations.",
+          "type": "object"
         }
       }
     },
-    "Deprecation": {
-      "type": "object",
+    "PeerUpdate": {
+      "description": "A single peer in the network.",
       "properties": {
-        "apiType": {
-          "description": "Describes which API is effected, usually one of: REST, GraphQL",
-          "type": "string"
-        },
         "id": {
-          "description": "The id that uniquely identifies this particular deprecations (mostly used internally)",
-          "type": "string"
-        },
-        "locations": {
-          "description": "The locations within the specified API affected by this deprecation",
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
-        },
-        "mitigation": {
-          "description": "User-required object to not be affected by the (planned) removal",
-          "type": "string"
+          "description": "The session ID of the peer.",
+          "type": "string",
+          "format": "uuid"
         },
-        "msg": {
-          "description": "What this deprecation is about",
+        "name": {
+          "description": "Human readable name.",
           "type": "string"
         },
-        "plannedRemovalVersion": {
-          "description": "A best-effort guess of which upcoming version will remove the feature entirely",
+        "schemaHash": {
+          "description": "The latest known hash of the peer's schema.",
           "type": "string"
         },
-        "removedIn": {
-          "description": "If the feature has already been removed, it was removed in this version",
+        "uri": {
+          "description": "The location where the peer is exposed to the internet.",
           "type": "string",
-          "x-nullable": true
+          "format": "uri"
+        }
+      }
+    },
+    "PeerUpdateList": {
+      "description": "List of known peers.",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/PeerUpdate"
+      }
+    },
+    "PhoneNumber": {
+      "properties": {
+        "countryCode": {
+          "description": "Read-only. The numerical country code (e.g. 49)",
+          "type": "number",
+          "format": "uint64"
         },
-        "removedTime": {
-          "description": "If the feature has already been removed, it was removed at this timestamp",
-          "type": "string",
-          "format": "date-time",
-          "x-nullable": true
+        "defaultCountry": {
+          "description": "Optional. The ISO 3166-1 alpha-2 country code. This is used to figure out the correct countryCode and international format if only a national number (e.g. 0123 4567) is provided",
+          "type": "string"
         },
-        "sinceTime": {
-          "description": "The deprecation was introduced in this version",
-          "type": "string",
-          "format": "date-time"
+        "input": {
+          "description": "The raw input as the phone number is present in your raw data set. It will be parsed into the standardized formats if valid.",
+          "type": "string"
         },
-        "sinceVersion": {
-          "description": "The deprecation was introduced in this version",
+        "internationalFormatted": {
+          "description": "Read-only. Parsed result in the international format (e.g. +49 123 ...)",
           "type": "string"
         },
-        "status": {
-          "description": "Whether the problematic API functionality is deprecated (planned to be removed) or already removed",
+        "national": {
+          "description": "Read-only. The numerical representation of the national part",
+          "type": "number",
+          "format": "uint64"
+        },
+        "nationalFormatted": {
+          "description": "Read-only. Parsed result in the national format (e.g. 0123 456789)",
           "type": "string"
+        },
+        "valid": {
+          "description": "Read-only. Indicates whether the parsed number is a valid phone number",
+          "type": "boolean"
         }
       }
     },
-    "ErrorResponse": {
-      "description": "An error response given by Weaviate end-points.",
+    "Principal": {
       "type": "object",
       "properties": {
-        "error": {
+        "groups": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/ErrorResponseErrorItems0"
+            "type": "string"
           }
-        }
-      }
-    },
-    "ErrorResponseErrorItems0": {
-      "type": "object",
-      "properties": {
-        "message": {
+        },
+        "username": {
+          "description": "The username that was extracted either from the authentication information",
           "type": "string"
         }
       }
     },
-    "GeoCoordinates": {
+    "Property": {
+      "type": "object",
       "properties": {
-        "latitude": {
-          "description": "The latitude of the point on earth in decimal form",
-          "type": "number",
-          "format": "float",
-          "x-nullable": true
+        "cardinality": {
+          "description": "Optional. Restricts how many objects a cross-reference property can point to. ` + "`" + `one` + "`" + ` allows at most a single reference, ` + "`" + `many` + "`" + ` (default) allows any number of references up to ` + "`" + `maxCount` + "`" + `. Only applicable to cross-reference properties.",
+          "type": "string",
+          "enum": [
+            "one",
+            "many"
+          ]
         },
-        "longitude": {
-          "description": "The longitude of the point on earth in decimal form",
-          "type": "number",
-          "format": "float",
-          "x-nullable": true
-        }
-      }
-    },
-    "GraphQLError": {
-      "description": "An error response caused by a GraphQL query.",
-      "properties": {
-        "locations": {
+        "dataType": {
+          "description": "Can be a reference to another type when it starts with a capital (for example Person), otherwise \"string\" or \"int\".",
           "type": "array",
           "items": {
-            "$ref": "#/definitions/GraphQLErrorLocationsItems0"
+            "type": "string"
           }
         },
-        "message": {
+        "defaultCountryProperty": {
+          "description": "Optional. Name of a text property of the same class, which holds the ISO 3166-1 alpha-2 country code to use as the default country when parsing this phone number. A defaultCountry set on the phone number itself takes precedence. Only applicable to phoneNumber properties.",
           "type": "string"
         },
-        "path": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
-        }
-      }
-    },
-    "GraphQLErrorLocationsItems0": {
-      "type": "object",
-      "properties": {
-        "column": {
-          "type": "integer",
-          "format": "int64"
+        "defaultValue": {
+          "description": "Optional. Value which is used for this property when an object is created without it. Must match the data type of the property. Not supported for cross-references."
         },
-        "line": {
-          "type": "integer",
-          "format": "int64"
-        }
-      }
-    },
-    "GraphQLQueries": {
-      "description": "A list of GraphQL queries.",
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/GraphQLQuery"
-      }
-    },
-    "GraphQLQuery": {
-      "description": "GraphQL query based on: http://facebook.github.io/graphql/.",
-      "type": "object",
-      "properties": {
-        "operationName": {
-          "description": "The name of the operation if multiple exist in the query.",
+        "description": {
+          "description": "Description of the property.",
           "type": "string"
         },
-        "query": {
-          "description": "Query based on GraphQL syntax.",
+        "expression": {
+          "description": "Optional. Expression which derives the value of this property from other properties of the same object whenever the object is written, e.g. ` + "`" + `concat(firstName, \" \", lastName)` + "`" + `. Supports text and number literals, property names, the arithmetic operators + - * / %, text functions (concat, lower, upper, trim), number functions (abs, ceil, floor, round) and date extraction (year, month, day, hour, minute, weekday, dayOfYear). Only allowed for the data types text, int and number. A value set explicitly for a computed property is ignored and replaced by the computed value.",
           "type": "string"
         },
-        "variables": {
-          "description": "Additional variables for the query.",
+        "indexFilterable": {
+          "description": "Optional. Should this property be indexed in the inverted index. Defaults to true. If you choose false, you will not be able to use this property in where filters. This property has no affect on vectorization decisions done by modules",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "indexInverted": {
+          "description": "Optional. Should this property be indexed in the inverted index. Defaults to true. If you choose false, you will not be able to use this property in where filters, bm25 or hybrid search. This property has no affect on vectorization decisions done by modules (deprecated as of v1.19; use indexFilterable or/and indexSearchable instead)",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "indexPositions": {
+          "description": "Optional. Should the positions of the terms be stored in the inverted index. Defaults to false. Applicable only to searchable properties of data type text and text[]. Phrase (\"exact phrase\") and proximity (\"exact phrase\"~2) queries in bm25 or hybrid search only match properties which store the positions, without any of them the terms of a phrase are searched for individually.",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "indexRangeFilters": {
+          "description": "Optional. Should this property be indexed in a range index, which serves the range filters GreaterThan, GreaterThanEqual, LessThan and LessThanEqual much faster than the filterable index on large ranges. Defaults to false. Applicable only to properties of data type int, number and date.",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "indexSearchable": {
+          "description": "Optional. Should this property be indexed in the inverted index. Defaults to true. Applicable only to properties of data type text and text[]. If you choose false, you will not be able to use this property in bm25 or hybrid search. This property has no affect on vectorization decisions done by modules",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "maxCount": {
+          "description": "Optional. The maximum number of references of a cross-reference property with cardinality ` + "`" + `many` + "`" + `. Unlimited if not set.",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1,
+          "x-nullable": true
+        },
+        "moduleConfig": {
+          "description": "Configuration specific to modules this Weaviate instance has installed",
           "type": "object"
-        }
-      }
-    },
-    "GraphQLResponse": {
-      "description": "GraphQL based response: http://facebook.github.io/graphql/.",
-      "properties": {
-        "data": {
-          "description": "GraphQL data object.",
-          "type": "object",
-          "additionalProperties": {
-            "$ref": "#/definitions/JsonObject"
-          }
         },
-        "errors": {
-          "description": "Array with errors.",
+        "name": {
+          "description": "Name of the property as URI relative to the schema URL.",
+          "type": "string"
+        },
+        "nestedProperties": {
+          "description": "The properties of the nested object. Only allowed and required for data types \"object\" and \"object[]\".",
           "type": "array",
           "items": {
-            "$ref": "#/definitions/GraphQLError"
+            "$ref": "#/definitions/NestedProperty"
           },
           "x-omitempty": true
+        },
+        "onDelete": {
+          "description": "Optional. Controls what happens to this cross-reference when a referenced object is deleted. ` + "`" + `cascade` + "`" + ` deletes the referring object, ` + "`" + `setNull` + "`" + ` removes the reference from the referring object and ` + "`" + `restrict` + "`" + ` rejects the deletion while the object is still referenced. Dangling references are kept if not set. Only applicable to cross-reference properties which are filterable.",
+          "type": "string",
+          "enum": [
+            "cascade",
+            "setNull",
+            "restrict"
+          ]
+        },
+        "required": {
+          "description": "Optional. If set to true, objects can not be created or updated without a value for this property. Defaults to false.",
+          "type": "boolean"
+        },
+        "tokenization": {
+          "description": "Determines tokenization of the property as separate words or whole field. Optional. Applies to text and text[] data types. Allowed values are ` + "`" + `word` + "`" + ` (default; splits on any non-alphanumerical, lowercases), ` + "`" + `lowercase` + "`" + ` (splits on white spaces, lowercases), ` + "`" + `whitespace` + "`" + ` (splits on white spaces), ` + "`" + `field` + "`" + ` (trims), ` + "`" + `lowercase_keyword` + "`" + ` (trims, lowercases), ` + "`" + `lowercase_keyword_tr` + "`" + ` and ` + "`" + `lowercase_keyword_de` + "`" + ` (like ` + "`" + `lowercase_keyword` + "`" + ` with the case folding rules of Turkish or German), ` + "`" + `cjk` + "`" + ` (splits Chinese, Japanese and Korean text into bigrams of characters, other text like ` + "`" + `word` + "`" + `), ` + "`" + `stem_en` + "`" + `, ` + "`" + `stem_de` + "`" + `, ` + "`" + `stem_fr` + "`" + `, ` + "`" + `stem_es` + "`" + ` and ` + "`" + `stem_it` + "`" + ` (like ` + "`" + `word` + "`" + `, reduces the words to their stem in English, German, French, Spanish or Italian). Not supported for remaining data types",
+          "type": "string",
+          "enum": [
+            "word",
+            "lowercase",
+            "whitespace",
+            "field",
+            "lowercase_keyword",
+            "lowercase_keyword_tr",
+            "lowercase_keyword_de",
+            "cjk",
+            "stem_en",
+            "stem_de",
+            "stem_fr",
+            "stem_es",
+            "stem_it"
+          ]
+        },
+        "uniqueKey": {
+          "description": "Optional. If set to true, the value of this property identifies an object of the class. Batch imports with ` + "`" + `upsertBy` + "`" + ` set to this property derive the id of objects sent without one from this value, so importing an object with the same value again updates it instead of creating a duplicate. Defaults to false. Applicable only to properties of data type text, int, number, boolean, date and uuid.",
+          "type": "boolean"
         }
       }
     },
-    "GraphQLResponses": {
-      "description": "A list of GraphQL responses.",
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/GraphQLResponse"
-      }
-    },
-    "InvertedIndexConfig": {
-      "description": "Configure the inverted index built into Weaviate",
+    "PropertyMigration": {
+      "description": "The progress of a background migration of the stored data of a property on this node.",
       "type": "object",
       "properties": {
-        "bm25": {
-          "$ref": "#/definitions/BM25Config"
+        "className": {
+          "description": "name of the class the property belongs to",
+          "type": "string"
         },
-        "cleanupIntervalSeconds": {
-          "description": "Asynchronous index clean up happens every n seconds",
-          "type": "number",
-          "format": "int"
+        "error": {
+          "description": "error which caused the migration to fail",
+          "type": "string"
         },
-        "indexNullState": {
-          "description": "Index each object with the null state",
-          "type": "boolean"
+        "newValue": {
+          "description": "value of the migrated setting after the migration",
+          "type": "string"
         },
-        "indexPropertyLength": {
-          "description": "Index length of properties",
-          "type": "boolean"
+        "objectsProcessed": {
+          "description": "number of objects which have been migrated so far",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
         },
-        "indexTimestamps": {
-          "description": "Index each object by its internal timestamps",
-          "type": "boolean"
+        "previousValue": {
+          "description": "value of the migrated setting before the migration",
+          "type": "string"
         },
-        "stopwords": {
-          "$ref": "#/definitions/StopwordConfig"
+        "property": {
+          "description": "current name of the property",
+          "type": "string"
+        },
+        "shardsCompleted": {
+          "description": "number of local shards which have been migrated completely",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "shardsTotal": {
+          "description": "number of local shards which need to be migrated",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "status": {
+          "description": "status of the migration",
+          "type": "string",
+          "enum": [
+            "STARTED",
+            "SUCCESS",
+            "FAILED"
+          ]
+        },
+        "type": {
+          "description": "kind of the migration",
+          "type": "string",
+          "enum": [
+            "rename",
+            "tokenization"
+          ]
         }
       }
     },
-    "JsonObject": {
-      "description": "JSON object value.",
-      "type": "object"
-    },
-    "Link": {
+    "PropertyRename": {
+      "description": "Request to rename an existing property of a class.",
       "type": "object",
+      "required": [
+        "name"
+      ],
       "properties": {
-        "documentationHref": {
-          "description": "weaviate documentation about this resource group",
-          "type": "string"
-        },
-        "href": {
-          "description": "target of the link",
-          "type": "string"
-        },
         "name": {
-          "description": "human readable name of the resource group",
-          "type": "string"
-        },
-        "rel": {
-          "description": "relationship if both resources are related, e.g. 'next', 'previous', 'parent', etc.",
+          "description": "new name of the property",
           "type": "string"
         }
       }
     },
-    "Meta": {
-      "description": "Contains meta information of the current Weaviate instance.",
+    "PropertySchema": {
+      "description": "This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value OR a SingleRef definition.",
+      "type": "object"
+    },
+    "PropertyTokenizationUpdate": {
+      "description": "Request to change the tokenization of an existing text property of a class.",
       "type": "object",
+      "required": [
+        "tokenization"
+      ],
       "properties": {
-        "hostname": {
-          "description": "The url of the host.",
+        "tokenization": {
+          "description": "new tokenization of the property",
           "type": "string",
-          "format": "url"
-        },
-        "modules": {
-          "description": "Module-specific meta information",
-          "type": "object"
-        },
-        "version": {
-          "description": "Version of weaviate you are currently running",
-          "type": "string"
+          "enum": [
+            "word",
+            "lowercase",
+            "whitespace",
+            "field",
+            "lowercase_keyword",
+            "lowercase_keyword_tr",
+            "lowercase_keyword_de",
+            "cjk",
+            "stem_en",
+            "stem_de",
+            "stem_fr",
+            "stem_es",
+            "stem_it"
+          ]
         }
       }
     },
-    "MultiTenancyConfig": {
-      "description": "Configuration related to multi-tenancy within a class",
+    "QuotaConfig": {
+      "description": "Storage quotas of a class and its tenants",
       "properties": {
-        "enabled": {
-          "description": "Whether or not multi-tenancy is enabled for this class",
-          "type": "boolean",
-          "x-omitempty": false
+        "maxDiskBytes": {
+          "description": "Maximum number of bytes the shards of this class may occupy on the disk of a node. Writes adding data are rejected once it is reached. 0 means unlimited.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "maxObjects": {
+          "description": "Maximum number of objects of this class on a node. Writes creating objects beyond it are rejected. 0 means unlimited.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "tenantMaxDiskBytes": {
+          "description": "Maximum number of bytes a single tenant may occupy on disk. Writes adding data to the tenant are rejected once it is reached. Requires multi-tenancy. 0 means unlimited.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "tenantMaxObjects": {
+          "description": "Maximum number of objects of a single tenant. Writes creating objects beyond it are rejected. Requires multi-tenancy. 0 means unlimited.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "warningPercentage": {
+          "description": "Percentage of a quota above which its usage is reported as nearing the quota. 0 means 80.",
+          "type": "integer",
+          "format": "int64"
         }
       }
     },
-    "MultipleRef": {
-      "description": "Multiple instances of references to other objects.",
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/SingleRef"
+    "ReadOnlyMode": {
+      "description": "Puts this node or a single class on this node into read-only mode, or lifts it",
+      "properties": {
+        "class": {
+          "description": "Name of the class to put into read-only mode. The entire node if omitted.",
+          "type": "string"
+        },
+        "readOnly": {
+          "description": "Whether writes are rejected",
+          "type": "boolean"
+        }
       }
     },
-    "NodeShardStatus": {
-      "description": "The definition of a node shard status response body",
+    "ReferenceAudit": {
+      "description": "The result of scanning the reference properties of a class for references to nonexistent objects.",
+      "type": "object",
       "properties": {
-        "class": {
-          "description": "The name of shard's class.",
-          "type": "string",
+        "className": {
+          "description": "Name of the audited class.",
+          "type": "string"
+        },
+        "danglingReferences": {
+          "description": "The references pointing at nonexistent objects.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/DanglingReference"
+          }
+        },
+        "objectsScanned": {
+          "description": "Number of objects which have been scanned.",
+          "type": "integer",
+          "format": "int64",
           "x-omitempty": false
         },
-        "name": {
-          "description": "The name of the shard.",
-          "type": "string",
+        "pruned": {
+          "description": "Whether the dangling references have been removed from their objects.",
+          "type": "boolean",
           "x-omitempty": false
         },
-        "objectCount": {
-          "description": "The number of objects in shard.",
-          "type": "number",
+        "referencesScanned": {
+          "description": "Number of references which have been checked.",
+          "type": "integer",
           "format": "int64",
           "x-omitempty": false
+        },
+        "tenant": {
+          "description": "Name of the audited tenant, if the class is multi-tenant.",
+          "type": "string"
         }
       }
     },
-    "NodeStats": {
-      "description": "The summary of Weaviate's statistics.",
+    "ReferenceMetaClassification": {
+      "description": "This meta field contains additional info about the classified reference property",
       "properties": {
-        "objectCount": {
-          "description": "The total number of objects in DB.",
+        "closestLosingDistance": {
+          "description": "The lowest distance of a neighbor in the losing group. Optional. If k equals the size of the winning group, there is no losing group",
           "type": "number",
-          "format": "int64",
-          "x-omitempty": false
+          "format": "float32",
+          "x-nullable": true
         },
-        "shardCount": {
-          "description": "The count of Weaviate's shards.",
+        "closestOverallDistance": {
+          "description": "The lowest distance of any neighbor, regardless of whether they were in the winning or losing group",
           "type": "number",
-          "format": "int",
-          "x-omitempty": false
+          "format": "float32"
+        },
+        "closestWinningDistance": {
+          "description": "Closest distance of a neighbor from the winning group",
+          "type": "number",
+          "format": "float32"
+        },
+        "losingCount": {
+          "description": "size of the losing group, can be 0 if the winning group size equals k",
+          "type": "number",
+          "format": "int64"
+        },
+        "losingDistance": {
+          "description": "deprecated - do not use, to be removed in 0.23.0",
+          "type": "number",
+          "format": "float32",
+          "x-nullable": true
+        },
+        "meanLosingDistance": {
+          "description": "Mean distance of all neighbors from the losing group. Optional. If k equals the size of the winning group, there is no losing group.",
+          "type": "number",
+          "format": "float32",
+          "x-nullable": true
+        },
+        "meanWinningDistance": {
+          "description": "Mean distance of all neighbors from the winning group",
+          "type": "number",
+          "format": "float32"
+        },
+        "overallCount": {
+          "description": "overall neighbors checked as part of the classification. In most cases this will equal k, but could be lower than k - for example if not enough data was present",
+          "type": "number",
+          "format": "int64"
+        },
+        "winningCount": {
+          "description": "size of the winning group, a number between 1..k",
+          "type": "number",
+          "format": "int64"
+        },
+        "winningDistance": {
+          "description": "deprecated - do not use, to be removed in 0.23.0",
+          "type": "number",
+          "format": "float32"
         }
       }
     },
-    "NodeStatus": {
-      "description": "The definition of a backup node status response body",
+    "ReplicationConfig": {
+      "description": "Configure how replication is executed in a cluster",
+      "type": "object",
       "properties": {
-        "batchStats": {
-          "description": "Weaviate batch statistics.",
-          "type": "object",
-          "$ref": "#/definitions/BatchStats"
+        "factor": {
+          "description": "Number of times a class is replicated",
+          "type": "integer"
+        }
+      }
+    },
+    "Schema": {
+      "description": "Definitions of semantic schemas (also see: https://github.com/weaviate/weaviate-semantic-schemas).",
+      "type": "object",
+      "properties": {
+        "classes": {
+          "description": "Semantic classes that are available.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Class"
+          }
         },
-        "gitHash": {
-          "description": "The gitHash of Weaviate.",
-          "type": "string"
+        "maintainer": {
+          "description": "Email of the maintainer.",
+          "type": "string",
+          "format": "email"
         },
         "name": {
-          "description": "The name of the node.",
+          "description": "Name of the schema.",
           "type": "string"
         },
-        "shards": {
-          "description": "The list of the shards with it's statistics.",
+        "stopwordSets": {
+          "description": "Custom stopword sets which classes can reference in their stopword config.",
           "type": "array",
           "items": {
-            "$ref": "#/definitions/NodeShardStatus"
+            "$ref": "#/definitions/StopwordSet"
           }
+        }
+      }
+    },
+    "SchemaClassDiff": {
+      "description": "The differences between an existing class and its target definition.",
+      "type": "object",
+      "properties": {
+        "class": {
+          "description": "Name of the class.",
+          "type": "string"
         },
-        "stats": {
-          "description": "Weaviate overall statistics.",
-          "type": "object",
-          "$ref": "#/definitions/NodeStats"
+        "incompatibleChanges": {
+          "description": "Changes which can not be applied to the existing class. A class with incompatible changes can only be migrated by deleting and recreating it.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "status": {
-          "description": "Node's status.",
+        "propertiesAdded": {
+          "description": "Properties which are only present in the target class.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "propertiesChanged": {
+          "description": "Properties which are present in both classes, but differ.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "propertiesRemoved": {
+          "description": "Properties which are only present in the existing class.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "settingsChanged": {
+          "description": "Class level settings (e.g. vectorIndexConfig) which differ.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "SchemaClusterStatus": {
+      "description": "Indicates the health of the schema in a cluster.",
+      "type": "object",
+      "properties": {
+        "error": {
+          "description": "Contains the sync check error if one occurred",
           "type": "string",
-          "default": "HEALTHY",
-          "enum": [
-            "HEALTHY",
-            "UNHEALTHY",
-            "UNAVAILABLE"
-          ]
+          "x-omitempty": true
+        },
+        "healthy": {
+          "description": "True if the cluster is in sync, false if there is an issue (see error).",
+          "type": "boolean",
+          "x-omitempty": false
         },
-        "version": {
-          "description": "The version of Weaviate.",
+        "hostname": {
+          "description": "Hostname of the coordinating node, i.e. the one that received the cluster. This can be useful information if the error message contains phrases such as 'other nodes agree, but local does not', etc.",
           "type": "string"
+        },
+        "ignoreSchemaSync": {
+          "description": "The cluster check at startup can be ignored (to recover from an out-of-sync situation).",
+          "type": "boolean",
+          "x-omitempty": false
+        },
+        "nodeCount": {
+          "description": "Number of nodes that participated in the sync check",
+          "type": "number",
+          "format": "int"
         }
       }
     },
-    "NodesStatusResponse": {
-      "description": "The status of all of the Weaviate nodes",
+    "SchemaDiff": {
+      "description": "The differences between the current schema and a target schema.",
       "type": "object",
       "properties": {
-        "nodes": {
+        "classesAdded": {
+          "description": "Classes which are only present in the target schema.",
           "type": "array",
           "items": {
-            "$ref": "#/definitions/NodeStatus"
+            "type": "string"
+          }
+        },
+        "classesChanged": {
+          "description": "Classes which are present in both schemas, but differ.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/SchemaClassDiff"
+          }
+        },
+        "classesRemoved": {
+          "description": "Classes which are only present in the current schema.",
+          "type": "array",
+          "items": {
+            "type": "string"
           }
+        },
+        "compatible": {
+          "description": "Whether the target schema can be reached without deleting and recreating any class.",
+          "type": "boolean"
         }
       }
     },
-    "Object": {
+    "SchemaExport": {
+      "description": "Portable definition of the full schema, including the module, vector index, sharding and replication configs of all classes and the tenants of multi-tenant classes. It can be imported into another cluster to recreate the schema.",
       "type": "object",
       "properties": {
-        "additional": {
-          "$ref": "#/definitions/AdditionalProperties"
-        },
-        "class": {
-          "description": "Class of the Object, defined in the schema.",
-          "type": "string"
-        },
-        "creationTimeUnix": {
-          "description": "Timestamp of creation of this Object in milliseconds since epoch UTC.",
-          "type": "integer",
-          "format": "int64"
+        "classes": {
+          "description": "The classes of the schema.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Class"
+          }
         },
-        "id": {
-          "description": "ID of the Object.",
-          "type": "string",
-          "format": "uuid"
+        "tenants": {
+          "description": "The tenants of the multi-tenant classes by class name.",
+          "type": "object",
+          "additionalProperties": {
+            "type": "array",
+            "items": {
+              "$ref": "#/definitions/Tenant"
+            }
+          }
         },
-        "lastUpdateTimeUnix": {
-          "description": "Timestamp of the last Object update in milliseconds since epoch UTC.",
+        "version": {
+          "description": "Version of the export format.",
           "type": "integer",
           "format": "int64"
-        },
-        "properties": {
-          "$ref": "#/definitions/PropertySchema"
-        },
-        "tenant": {
-          "description": "Name of the Objects tenant.",
-          "type": "string"
-        },
-        "vector": {
-          "description": "This object's position in the Contextionary vector space. Read-only if using a vectorizer other than 'none'. Writable and required if using 'none' as vectorizer.",
-          "$ref": "#/definitions/C11yVector"
-        },
-        "vectorWeights": {
-          "$ref": "#/definitions/VectorWeights"
         }
       }
     },
-    "ObjectsGetResponse": {
+    "SchemaHistory": {
+      "description": "This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value OR a SingleRef definition.",
+      "type": "object"
+    },
+    "SchemaImportResult": {
+      "description": "The changes applied by a schema import.",
       "type": "object",
-      "allOf": [
-        {
-          "$ref": "#/definitions/Object"
+      "properties": {
+        "classesCreated": {
+          "description": "Classes which did not exist and were created.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        {
-          "properties": {
-            "deprecations": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/Deprecation"
-              }
-            }
+        "classesUnchanged": {
+          "description": "Existing classes which already matched the imported definition.",
+          "type": "array",
+          "items": {
+            "type": "string"
           }
         },
-        {
-          "properties": {
-            "result": {
-              "description": "Results for this specific Object.",
-              "format": "object",
-              "properties": {
-                "errors": {
-                  "$ref": "#/definitions/ErrorResponse"
-                },
-                "status": {
-                  "type": "string",
-                  "default": "SUCCESS",
-                  "enum": [
-                    "SUCCESS",
-                    "PENDING",
-                    "FAILED"
-                  ]
-                }
-              }
-            }
+        "classesUpdated": {
+          "description": "Existing classes to which properties were added or whose settings were updated.",
+          "type": "array",
+          "items": {
+            "type": "string"
           }
+        },
+        "tenantsCreated": {
+          "description": "Number of tenants which did not exist and were created.",
+          "type": "integer",
+          "format": "int64"
         }
-      ]
+      }
     },
-    "ObjectsGetResponseAO2Result": {
-      "description": "Results for this specific Object.",
-      "format": "object",
+    "SchemaVersion": {
+      "description": "A single mutation of the schema as recorded by the schema history of this node.",
+      "type": "object",
       "properties": {
-        "errors": {
-          "$ref": "#/definitions/ErrorResponse"
+        "class": {
+          "description": "name of the class affected by the mutation, empty for snapshots",
+          "type": "string"
         },
-        "status": {
+        "operation": {
+          "description": "kind of the mutation. A snapshot records the complete schema, e.g. when the schema was replaced during a cluster sync or a restore.",
           "type": "string",
-          "default": "SUCCESS",
           "enum": [
-            "SUCCESS",
-            "PENDING",
-            "FAILED"
+            "snapshot",
+            "addClass",
+            "updateClass",
+            "deleteClass",
+            "addShards",
+            "updateShards",
+            "deleteShards"
           ]
+        },
+        "shards": {
+          "description": "names of the shards affected by the mutation, only set for shard mutations",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "timestamp": {
+          "description": "time of the mutation in ms since epoch",
+          "type": "integer",
+          "format": "int64"
+        },
+        "version": {
+          "description": "monotonically increasing version of the schema after this mutation",
+          "type": "integer",
+          "format": "int64"
         }
       }
     },
-    "ObjectsListResponse": {
-      "description": "List of Objects.",
+    "SchemaVersions": {
+      "description": "The history of the schema mutations of this node.",
       "type": "object",
       "properties": {
-        "deprecations": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/Deprecation"
-          }
+        "currentVersion": {
+          "description": "version of the current schema of this node",
+          "type": "integer",
+          "format": "int64"
         },
-        "objects": {
-          "description": "The actual list of Objects.",
+        "versions": {
+          "description": "recorded schema versions in ascending order",
           "type": "array",
           "items": {
-            "$ref": "#/definitions/Object"
+            "$ref": "#/definitions/SchemaVersion"
           }
-        },
-        "totalResults": {
-          "description": "The total number of Objects for the query. The number of items in a response may be smaller due to paging.",
-          "type": "integer",
-          "format": "int64"
         }
       }
     },
-    "PatchDocumentAction": {
-      "description": "Either a JSONPatch document as defined by RFC 6902 (from, op, path, value), or a merge document (RFC 7396).",
-      "required": [
-        "op",
-        "path"
-      ],
+    "ShardCompaction": {
+      "description": "The result of forcing a full compaction of the storage of a shard.",
+      "type": "object",
       "properties": {
-        "from": {
-          "description": "A string containing a JSON Pointer value.",
+        "buckets": {
+          "description": "Number of LSM buckets that have been compacted.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "bytesAfter": {
+          "description": "Size of the shard on disk in bytes after the compaction.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "bytesBefore": {
+          "description": "Size of the shard on disk in bytes before the compaction.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "className": {
+          "description": "Name of the class of the shard.",
           "type": "string"
         },
-        "merge": {
-          "$ref": "#/definitions/Object"
+        "node": {
+          "description": "Name of the node which holds the shard.",
+          "type": "string"
         },
-        "op": {
-          "description": "The operation to be performed.",
-          "type": "string",
-          "enum": [
-            "add",
-            "remove",
-            "replace",
-            "move",
-            "copy",
-            "test"
-          ]
+        "reclaimedBytes": {
+          "description": "Disk space reclaimed by the compaction in bytes. Zero if concurrent writes outgrew the reclaimed space.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
         },
-        "path": {
-          "description": "A JSON-Pointer.",
+        "shard": {
+          "description": "Name of the shard.",
           "type": "string"
         },
-        "value": {
-          "description": "The value to be used within the operations.",
-          "type": "object"
+        "tookMs": {
+          "description": "Duration of the compaction in ms.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
         }
       }
     },
-    "PatchDocumentObject": {
-      "description": "Either a JSONPatch document as defined by RFC 6902 (from, op, path, value), or a merge document (RFC 7396).",
-      "required": [
-        "op",
-        "path"
-      ],
+    "ShardDiskUsage": {
+      "description": "The disk space used by a shard in bytes, broken down by its components.",
       "properties": {
-        "from": {
-          "description": "A string containing a JSON Pointer value.",
-          "type": "string"
+        "compactionTempBytes": {
+          "description": "The size of the temporary files of ongoing or aborted compactions.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
         },
-        "merge": {
-          "$ref": "#/definitions/Object"
+        "invertedIndexBuckets": {
+          "description": "The size of the segments of each inverted index bucket.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/BucketDiskUsage"
+          }
+        },
+        "invertedIndexBytes": {
+          "description": "The size of the segments of all inverted index buckets.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "objectsBytes": {
+          "description": "The size of the segments of the objects bucket.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "otherBytes": {
+          "description": "The size of all other files, such as the remaining buckets and the metadata of the shard.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "tombstonesBytes": {
+          "description": "The estimated size of the deleted nodes of the vector indexes which are not cleaned up yet. Part of vectorIndexBytes.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "totalBytes": {
+          "description": "The size of all files of the shard.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
         },
-        "op": {
-          "description": "The operation to be performed.",
-          "type": "string",
-          "enum": [
-            "add",
-            "remove",
-            "replace",
-            "move",
-            "copy",
-            "test"
-          ]
+        "vectorIndexBytes": {
+          "description": "The size of the vector indexes, including their commit logs, compressed vectors and indexing queues.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
         },
-        "path": {
-          "description": "A JSON-Pointer.",
+        "walBytes": {
+          "description": "The size of the write-ahead logs of the memtables which are not flushed yet.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        }
+      }
+    },
+    "ShardStatus": {
+      "description": "The status of a single shard",
+      "properties": {
+        "status": {
+          "description": "Status of the shard",
           "type": "string"
-        },
-        "value": {
-          "description": "The value to be used within the operations.",
-          "type": "object"
         }
       }
     },
-    "PeerUpdate": {
-      "description": "A single peer in the network.",
+    "ShardStatusGetResponse": {
+      "description": "Response body of shard status get request",
       "properties": {
-        "id": {
-          "description": "The session ID of the peer.",
-          "type": "string",
-          "format": "uuid"
-        },
         "name": {
-          "description": "Human readable name.",
+          "description": "Name of the shard",
           "type": "string"
         },
-        "schemaHash": {
-          "description": "The latest known hash of the peer's schema.",
+        "status": {
+          "description": "Status of the shard",
           "type": "string"
-        },
-        "uri": {
-          "description": "The location where the peer is exposed to the internet.",
-          "type": "string",
-          "format": "uri"
         }
       }
     },
-    "PeerUpdateList": {
-      "description": "List of known peers.",
+    "ShardStatusList": {
+      "description": "The status of all the shards of a Class",
       "type": "array",
       "items": {
-        "$ref": "#/definitions/PeerUpdate"
+        "$ref": "#/definitions/ShardStatusGetResponse"
       }
     },
-    "PhoneNumber": {
+    "SingleRef": {
+      "description": "Either set beacon (direct reference) or set class and schema (concept reference)",
       "properties": {
-        "countryCode": {
-          "description": "Read-only. The numerical country code (e.g. 49)",
-          "type": "number",
-          "format": "uint64"
-        },
-        "defaultCountry": {
-          "description": "Optional. The ISO 3166-1 alpha-2 country code. This is used to figure out the correct countryCode and international format if only a national number (e.g. 0123 4567) is provided",
-          "type": "string"
-        },
-        "input": {
-          "description": "The raw input as the phone number is present in your raw data set. It will be parsed into the standardized formats if valid.",
-          "type": "string"
+        "beacon": {
+          "description": "If using a direct reference, specify the URI to point to the cross-ref here. Should be in the form of weaviate://localhost/\u003cuuid\u003e for the example of a local cross-ref to an object",
+          "type": "string",
+          "format": "uri"
         },
-        "internationalFormatted": {
-          "description": "Read-only. Parsed result in the international format (e.g. +49 123 ...)",
-          "type": "string"
+        "class": {
+          "description": "If using a concept reference (rather than a direct reference), specify the desired class name here",
+          "type": "string",
+          "format": "uri"
         },
-        "national": {
-          "description": "Read-only. The numerical representation of the national part",
-          "type": "number",
-          "format": "uint64"
+        "classification": {
+          "description": "Additional Meta information about classifications if the item was part of one",
+          "$ref": "#/definitions/ReferenceMetaClassification"
         },
-        "nationalFormatted": {
-          "description": "Read-only. Parsed result in the national format (e.g. 0123 456789)",
-          "type": "string"
+        "href": {
+          "description": "If using a direct reference, this read-only fields provides a link to the referenced resource. If 'origin' is globally configured, an absolute URI is shown - a relative URI otherwise.",
+          "type": "string",
+          "format": "uri"
         },
-        "valid": {
-          "description": "Read-only. Indicates whether the parsed number is a valid phone number",
-          "type": "boolean"
+        "schema": {
+          "description": "If using a concept reference (rather than a direct reference), specify the desired properties here",
+          "$ref": "#/definitions/PropertySchema"
         }
       }
     },
-    "Principal": {
-      "type": "object",
+    "SparseVector": {
+      "description": "A sparse vector, given by the indices of its non-zero dimensions and their values, as produced by learned sparse retrieval models like SPLADE",
       "properties": {
-        "groups": {
+        "indices": {
+          "description": "The indices of the non-zero dimensions",
           "type": "array",
           "items": {
-            "type": "string"
+            "type": "integer",
+            "format": "int64"
           }
         },
-        "username": {
-          "description": "The username that was extracted either from the authentication information",
-          "type": "string"
+        "values": {
+          "description": "The values of the non-zero dimensions, in the order of the indices",
+          "type": "array",
+          "items": {
+            "type": "number",
+            "format": "float"
+          }
         }
       }
     },
-    "Property": {
+    "StopwordConfig": {
+      "description": "fine-grained control over stopword list usage",
       "type": "object",
       "properties": {
-        "dataType": {
-          "description": "Can be a reference to another type when it starts with a capital (for example Person), otherwise \"string\" or \"int\".",
+        "additions": {
+          "description": "stopwords to be considered additionally",
           "type": "array",
           "items": {
             "type": "string"
           }
         },
-        "description": {
-          "description": "Description of the property.",
+        "preset": {
+          "description": "pre-existing list of common words by language",
           "type": "string"
         },
-        "indexFilterable": {
-          "description": "Optional. Should this property be indexed in the inverted index. Defaults to true. If you choose false, you will not be able to use this property in where filters. This property has no affect on vectorization decisions done by modules",
-          "type": "boolean",
-          "x-nullable": true
-        },
-        "indexInverted": {
-          "description": "Optional. Should this property be indexed in the inverted index. Defaults to true. If you choose false, you will not be able to use this property in where filters, bm25 or hybrid search. This property has no affect on vectorization decisions done by modules (deprecated as of v1.19; use indexFilterable or/and indexSearchable instead)",
-          "type": "boolean",
-          "x-nullable": true
-        },
-        "indexSearchable": {
-          "description": "Optional. Should this property be indexed in the inverted index. Defaults to true. Applicable only to properties of data type text and text[]. If you choose false, you will not be able to use this property in bm25 or hybrid search. This property has no affect on vectorization decisions done by modules",
-          "type": "boolean",
-          "x-nullable": true
-        },
-        "moduleConfig": {
-          "description": "Configuration specific to modules this Weaviate instance has installed",
-          "type": "object"
+        "removals": {
+          "description": "stopwords to be removed from consideration",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
+        "sets": {
+          "description": "names of custom stopword sets to be considered additionally, see /schema/stopwords",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "StopwordSet": {
+      "description": "a named list of custom stopwords which can be referenced in the stopword config of classes",
+      "type": "object",
+      "properties": {
         "name": {
-          "description": "Name of the property as URI relative to the schema URL.",
+          "description": "name of the stopword set",
           "type": "string"
         },
-        "tokenization": {
-          "description": "Determines tokenization of the property as separate words or whole field. Optional. Applies to text and text[] data types. Allowed values are ` + "`" + `word` + "`" + ` (default; splits on any non-alphanumerical, lowercases), ` + "`" + `lowercase` + "`" + ` (splits on white spaces, lowercases), ` + "`" + `whitespace` + "`" + ` (splits on white spaces), ` + "`" + `field` + "`" + ` (trims). Not supported for remaining data types",
-          "type": "string",
-          "enum": [
-            "word",
-            "lowercase",
-            "whitespace",
-            "field"
-          ]
+        "words": {
+          "description": "the stopwords of the set",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         }
       }
     },
-    "PropertySchema": {
-      "description": "This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value OR a SingleRef definition.",
-      "type": "object"
-    },
-    "ReferenceMetaClassification": {
-      "description": "This meta field contains additional info about the classified reference property",
+    "SynonymRule": {
+      "description": "a rule for expanding the terms of keyword queries. Without synonyms all terms are equivalent (two-way), otherwise the terms are expanded to the synonyms only (one-way)",
+      "type": "object",
       "properties": {
-        "closestLosingDistance": {
-          "description": "The lowest distance of a neighbor in the losing group. Optional. If k equals the size of the winning group, there is no losing group",
-          "type": "number",
-          "format": "float32",
-          "x-nullable": true
-        },
-        "closestOverallDistance": {
-          "description": "The lowest distance of any neighbor, regardless of whether they were in the winning or losing group",
-          "type": "number",
-          "format": "float32"
-        },
-        "closestWinningDistance": {
-          "description": "Closest distance of a neighbor from the winning group",
-          "type": "number",
-          "format": "float32"
-        },
-        "losingCount": {
-          "description": "size of the losing group, can be 0 if the winning group size equals k",
-          "type": "number",
-          "format": "int64"
-        },
-        "losingDistance": {
-          "description": "deprecated - do not use, to be removed in 0.23.0",
-          "type": "number",
-          "format": "float32",
-          "x-nullable": true
-        },
-        "meanLosingDistance": {
-          "description": "Mean distance of all neighbors from the losing group. Optional. If k equals the size of the winning group, there is no losing group.",
-          "type": "number",
-          "format": "float32",
-          "x-nullable": true
-        },
-        "meanWinningDistance": {
-          "description": "Mean distance of all neighbors from the winning group",
-          "type": "number",
-          "format": "float32"
-        },
-        "overallCount": {
-          "description": "overall neighbors checked as part of the classification. In most cases this will equal k, but could be lower than k - for example if not enough data was present",
-          "type": "number",
-          "format": "int64"
+        "synonyms": {
+          "description": "the terms the query is expanded to if it contains one of the terms",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "winningCount": {
-          "description": "size of the winning group, a number between 1..k",
-          "type": "number",
-          "format": "int64"
+        "terms": {
+          "description": "the terms of the rule",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "Tenant": {
+      "description": "attributes representing a single tenant within weaviate",
+      "type": "object",
+      "properties": {
+        "activityStatus": {
+          "description": "activity status of the tenant's shard. Optional for creating tenant (implicit ` + "`" + `HOT` + "`" + `) and required for updating tenant. Allowed values are ` + "`" + `HOT` + "`" + ` - tenant is fully active, ` + "`" + `WARM` + "`" + ` - tenant is active, some restrictions are imposed (TBD; not supported yet), ` + "`" + `COLD` + "`" + ` - tenant is inactive; no actions can be performed on tenant, tenant's files are stored locally, ` + "`" + `FROZEN` + "`" + ` - as COLD, but files are stored on cloud storage (not supported yet)",
+          "type": "string",
+          "enum": [
+            "HOT",
+            "WARM",
+            "COLD",
+            "FROZEN"
+          ]
         },
-        "winningDistance": {
-          "description": "deprecated - do not use, to be removed in 0.23.0",
-          "type": "number",
-          "format": "float32"
+        "name": {
+          "description": "name of the tenant",
+          "type": "string"
         }
       }
     },
-    "ReplicationConfig": {
-      "description": "Configure how replication is executed in a cluster",
+    "VectorConfig": {
+      "description": "Configuration of a single named vector space of a class",
       "type": "object",
       "properties": {
-        "factor": {
-          "description": "Number of times a class is replicated",
-          "type": "integer"
+        "dimensions": {
+          "description": "Length of the vectors of this vector space. If left empty, it is taken from the 'dimensions' setting of the vectorizer module or recorded on the first insert. Vectors of a different length are rejected.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "vectorIndexConfig": {
+          "description": "Vector-index config, that is specific to the type of index selected in vectorIndexType",
+          "type": "object"
+        },
+        "vectorIndexType": {
+          "description": "Name of the vector index to use, eg. (HNSW)",
+          "type": "string"
+        },
+        "vectorizer": {
+          "description": "Configuration of the vectorizer module used for this vector space, keyed by the module name. Set to {\"none\": {}} to import vectors yourself.",
+          "type": "object"
         }
       }
     },
-    "Schema": {
-      "description": "Definitions of semantic schemas (also see: https://github.com/weaviate/weaviate-semantic-schemas).",
+    "VectorIndexBuild": {
+      "description": "The result of building the vector index of a shard in bulk.",
       "type": "object",
       "properties": {
-        "classes": {
-          "description": "Semantic classes that are available.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/Class"
-          }
+        "className": {
+          "description": "Name of the class of the shard.",
+          "type": "string"
         },
-        "maintainer": {
-          "description": "Email of the maintainer.",
-          "type": "string",
-          "format": "email"
+        "dimensions": {
+          "description": "Dimensions of the indexed vectors, zero for an empty shard.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
         },
-        "name": {
-          "description": "Name of the schema.",
+        "node": {
+          "description": "Name of the node which holds the shard.",
+          "type": "string"
+        },
+        "nodes": {
+          "description": "Number of vectors the graph has been built from.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "shard": {
+          "description": "Name of the shard.",
           "type": "string"
+        },
+        "targetVector": {
+          "description": "Name of the target vector, empty for the class-level vector.",
+          "type": "string"
+        },
+        "tookMs": {
+          "description": "Duration of reading the vectors and building the graph in ms.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
         }
       }
     },
-    "SchemaClusterStatus": {
-      "description": "Indicates the health of the schema in a cluster.",
+    "VectorIndexIntegrity": {
+      "description": "The result of validating the graph of the vector index of a shard.",
       "type": "object",
       "properties": {
-        "error": {
-          "description": "Contains the sync check error if one occurred",
-          "type": "string",
-          "x-omitempty": true
+        "brokenLinks": {
+          "description": "Number of links pointing at missing nodes or at layers the target node is not part of.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
         },
-        "healthy": {
-          "description": "True if the cluster is in sync, false if there is an issue (see error).",
+        "checkedAt": {
+          "description": "Time of the check in ms since epoch.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "entrypointValid": {
+          "description": "Whether the entrypoint exists and is on the top layer of the graph.",
           "type": "boolean",
           "x-omitempty": false
         },
-        "hostname": {
-          "description": "Hostname of the coordinating node, i.e. the one that received the cluster. This can be useful information if the error message contains phrases such as 'other nodes agree, but local does not', etc.",
-          "type": "string"
+        "nodes": {
+          "description": "Number of nodes in the graph.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
         },
-        "ignoreSchemaSync": {
-          "description": "The cluster check at startup can be ignored (to recover from an out-of-sync situation).",
+        "orphanNodes": {
+          "description": "Number of nodes which are not linked to by any other node.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "repaired": {
+          "description": "Whether the damaged portions of the graph have been repaired.",
           "type": "boolean",
           "x-omitempty": false
         },
-        "nodeCount": {
-          "description": "Number of nodes that participated in the sync check",
-          "type": "number",
-          "format": "int"
+        "shard": {
+          "description": "Name of the checked shard.",
+          "type": "string"
+        },
+        "targetVector": {
+          "description": "Name of the target vector, empty for the class-level vector.",
+          "type": "string"
+        },
+        "unreachableNodes": {
+          "description": "Number of nodes which cannot be reached from the entrypoint.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
         }
       }
     },
-    "SchemaHistory": {
-      "description": "This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value OR a SingleRef definition.",
-      "type": "object"
-    },
-    "ShardStatus": {
-      "description": "The status of a single shard",
+    "VectorIndexIntegrityReport": {
+      "description": "The results of validating the vector index graphs of the shards of a class on one node.",
+      "type": "object",
       "properties": {
-        "status": {
-          "description": "Status of the shard",
+        "className": {
+          "description": "Name of the checked class.",
+          "type": "string"
+        },
+        "node": {
+          "description": "Name of the node the shards have been checked on.",
           "type": "string"
+        },
+        "results": {
+          "description": "The results per shard and target vector.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/VectorIndexIntegrity"
+          }
         }
       }
     },
-    "ShardStatusGetResponse": {
-      "description": "Response body of shard status get request",
+    "VectorIndexRecall": {
+      "description": "The result of measuring the recall of the vector index of a shard.",
+      "type": "object",
       "properties": {
-        "name": {
-          "description": "Name of the shard",
+        "ef": {
+          "description": "The search time ef the approximate searches were run with.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "k": {
+          "description": "Number of results requested per query.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "measuredAt": {
+          "description": "Time of the measurement in ms since epoch.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "recall": {
+          "description": "Share of the exact top k results which were also returned by the approximate search, averaged over all queries.",
+          "type": "number",
+          "format": "double",
+          "x-omitempty": false
+        },
+        "sampleSize": {
+          "description": "Number of indexed vectors which were used as queries.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "shard": {
+          "description": "Name of the measured shard.",
           "type": "string"
         },
-        "status": {
-          "description": "Status of the shard",
+        "targetVector": {
+          "description": "Name of the target vector, empty for the class-level vector.",
           "type": "string"
         }
       }
     },
-    "ShardStatusList": {
-      "description": "The status of all the shards of a Class",
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/ShardStatusGetResponse"
+    "VectorIndexRecallReport": {
+      "description": "The results of measuring the recall of the vector indexes of the shards of a class on one node.",
+      "type": "object",
+      "properties": {
+        "className": {
+          "description": "Name of the measured class.",
+          "type": "string"
+        },
+        "node": {
+          "description": "Name of the node the shards have been measured on.",
+          "type": "string"
+        },
+        "results": {
+          "description": "The results per shard and target vector.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/VectorIndexRecall"
+          }
+        }
       }
     },
-    "SingleRef": {
-      "description": "Either set beacon (direct reference) or set class and schema (concept reference)",
+    "VectorIndexSnapshot": {
+      "description": "A portable snapshot of the vector index of a shard.",
+      "type": "object",
       "properties": {
-        "beacon": {
-          "description": "If using a direct reference, specify the URI to point to the cross-ref here. Should be in the form of weaviate://localhost/\u003cuuid\u003e for the example of a local cross-ref to an object",
-          "type": "string",
-          "format": "uri"
+        "className": {
+          "description": "Name of the class of the shard.",
+          "type": "string"
         },
-        "class": {
-          "description": "If using a concept reference (rather than a direct reference), specify the desired class name here",
-          "type": "string",
-          "format": "uri"
+        "dimensions": {
+          "description": "Dimensions of the vectors in the snapshot, zero for an empty index.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
         },
-        "classification": {
-          "description": "Additional Meta information about classifications if the item was part of one",
-          "$ref": "#/definitions/ReferenceMetaClassification"
+        "distance": {
+          "description": "Name of the distance metric of the vector index.",
+          "type": "string"
         },
-        "href": {
-          "description": "If using a direct reference, this read-only fields provides a link to the referenced resource. If 'origin' is globally configured, an absolute URI is shown - a relative URI otherwise.",
-          "type": "string",
-          "format": "uri"
+        "file": {
+          "description": "Name of the snapshot file in the snapshot directory of the node.",
+          "type": "string"
         },
-        "schema": {
-          "description": "If using a concept reference (rather than a direct reference), specify the desired properties here",
-          "$ref": "#/definitions/PropertySchema"
+        "node": {
+          "description": "Name of the node which holds the shard.",
+          "type": "string"
+        },
+        "nodes": {
+          "description": "Number of nodes in the graph, including deleted ones.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "shard": {
+          "description": "Name of the shard.",
+          "type": "string"
+        },
+        "targetVector": {
+          "description": "Name of the target vector, empty for the class-level vector.",
+          "type": "string"
         }
       }
     },
-    "StopwordConfig": {
-      "description": "fine-grained control over stopword list usage",
+    "VectorIndexTombstoneCleanup": {
+      "description": "The state of the tombstone cleanup of the vector index of a shard.",
       "type": "object",
       "properties": {
-        "additions": {
-          "description": "stopwords to be considered additionally",
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
+        "concurrency": {
+          "description": "Number of workers used to reassign the neighbors of deleted nodes.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
         },
-        "preset": {
-          "description": "pre-existing list of common words by language",
+        "lastCycleAt": {
+          "description": "Time the last cleanup cycle completed in ms since epoch.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "lastCycleDurationMs": {
+          "description": "Duration of the last completed cleanup cycle in ms.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "lastCycleRemovedTombstones": {
+          "description": "Number of deleted nodes which have been cleaned up by the last completed cleanup cycle.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "lastCycleThroughput": {
+          "description": "Number of deleted nodes cleaned up per second by the last completed cleanup cycle.",
+          "type": "number",
+          "format": "double",
+          "x-omitempty": false
+        },
+        "paused": {
+          "description": "Whether the scheduled tombstone cleanup is paused.",
+          "type": "boolean",
+          "x-omitempty": false
+        },
+        "remainingTombstones": {
+          "description": "Number of deleted nodes which have not been cleaned up yet.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "removedTombstones": {
+          "description": "Number of deleted nodes which have been cleaned up since the shard was loaded.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "shard": {
+          "description": "Name of the shard.",
           "type": "string"
         },
-        "removals": {
-          "description": "stopwords to be removed from consideration",
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
+        "targetVector": {
+          "description": "Name of the target vector, empty for the class-level vector.",
+          "type": "string"
         }
       }
     },
-    "Tenant": {
-      "description": "attributes representing a single tenant within weaviate",
+    "VectorIndexTombstoneCleanupReport": {
+      "description": "The state of the tombstone cleanup of the vector indexes of the shards of a class on one node.",
       "type": "object",
       "properties": {
-        "activityStatus": {
-          "description": "activity status of the tenant's shard. Optional for creating tenant (implicit ` + "`" + `HOT` + "`" + `) and required for updating tenant. Allowed values are ` + "`" + `HOT` + "`" + ` - tenant is fully active, ` + "`" + `WARM` + "`" + ` - tenant is active, some restrictions are imposed (TBD; not supported yet), ` + "`" + `COLD` + "`" + ` - tenant is inactive; no actions can be performed on tenant, tenant's files are stored locally, ` + "`" + `FROZEN` + "`" + ` - as COLD, but files are stored on cloud storage (not supported yet)",
-          "type": "string",
-          "enum": [
-            "HOT",
-            "WARM",
-            "COLD",
-            "FROZEN"
-          ]
+        "className": {
+          "description": "Name of the class.",
+          "type": "string"
         },
-        "name": {
-          "description": "name of the tenant",
+        "node": {
+          "description": "Name of the node holding the shards.",
           "type": "string"
+        },
+        "results": {
+          "description": "The state per shard and target vector.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/VectorIndexTombstoneCleanup"
+          }
         }
       }
     },
@@ -9652,12 +15947,19 @@ func init() {
       "description": "Allow custom overrides of vector weights as math expressions. E.g. \"pancake\": \"7\" will set the weight for the word pancake to 7 in the vectorization, whereas \"w * 3\" would triple the originally calculated word. This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value (string/string) object.",
       "type": "object"
     },
+    "Vectors": {
+      "description": "A map of named vectors, keyed by the name of the vector space",
+      "type": "object",
+      "additionalProperties": {
+        "$ref": "#/definitions/C11yVector"
+      }
+    },
     "WhereFilter": {
       "description": "Filter search results using a where filter",
       "type": "object",
       "properties": {
         "operands": {
-          "description": "combine multiple where filters, requires 'And' or 'Or' operator",
+          "description": "combine multiple where filters, requires 'And' or 'Or' operator, or negate a single where filter with the 'Not' operator. Operands without an operator are combined with 'And'",
           "type": "array",
           "items": {
             "$ref": "#/definitions/WhereFilter"
@@ -9669,6 +15971,7 @@ func init() {
           "enum": [
             "And",
             "Or",
+            "Not",
             "Equal",
             "Like",
             "NotEqual",
@@ -9679,7 +15982,11 @@ func init() {
             "WithinGeoRange",
             "IsNull",
             "ContainsAny",
-            "ContainsAll"
+            "ContainsAll",
+            "WithinCIDR",
+            "WithinPolygon",
+            "Intersects",
+            "FuzzyMatch"
           ],
           "example": "GreaterThanEqual"
         },
@@ -9730,6 +16037,12 @@ func init() {
           "x-omitempty": true,
           "example": "TODO"
         },
+        "valueGeoPolygon": {
+          "description": "value as geo polygon",
+          "type": "object",
+          "x-nullable": true,
+          "$ref": "#/definitions/GeoPolygon"
+        },
         "valueGeoRange": {
           "description": "value as geo coordinates and distance",
           "type": "object",
@@ -9860,6 +16173,12 @@ func init() {
       "name": "consistency_level",
       "in": "query"
     },
+    "CommonIfMatchParameterHeader": {
+      "type": "string",
+      "description": "Only apply the write if the object has not changed since it was read: the ETag of the object as returned by GET /objects/{className}/{id}. Multiple comma-separated ETags are allowed..",
+      "name": "If-Match",
+      "in": "header"
+    },
     "CommonIncludeParameterQuery": {
       "type": "string",
       "description": "Include additional information, such as classification infos. Allowed values include: classification, vector, interpretation",
@@ -24,7 +24,13 @@ func Parse(in *models.WhereFilter, rootClass string) (*filters.LocalFilter, erro
 		return nil, nil
 	}
 
-	operator, err := parseOperator(in.Operator)
+	operatorName := in.Operator
+	if operatorName == "" && len(in.Operands) > 0 {
+		// shorthand: operands without an operator are combined with And
+		operatorName = models.WhereFilterOperatorAnd
+	}
+
+	operator, err := parseOperator(operatorName)
 	if err != nil {
 		return nil, err
 	}
@@ -90,6 +96,12 @@ func parseNestedFilter(in *models.WhereFilter,
 			operator.Name())
 	}
 
+	if operator == filters.OperatorNot && len(in.Operands) != 1 {
+		return nil, fmt.Errorf(
+			"operator 'Not' requires exactly one operand, got %d - combine "+
+				"several operands with 'And' or 'Or' first", len(in.Operands))
+	}
+
 	operands, err := parseOperands(in.Operands, rootClass)
 	if err != nil {
 		return nil, err
@@ -139,12 +151,22 @@ func parseOperator(in string) (filters.Operator, error) {
 		return filters.OperatorAnd, nil
 	case models.WhereFilterOperatorOr:
 		return filters.OperatorOr, nil
+	case models.WhereFilterOperatorNot:
+		return filters.OperatorNot, nil
 	case models.WhereFilterOperatorIsNull:
 		return filters.OperatorIsNull, nil
 	case models.WhereFilterOperatorContainsAny:
 		return filters.ContainsAny, nil
 	case models.WhereFilterOperatorContainsAll:
 		return filters.ContainsAll, nil
+	case models.WhereFilterOperatorWithinCIDR:
+		return filters.OperatorWithinCIDR, nil
+	case models.WhereFilterOperatorWithinPolygon:
+		return filters.OperatorWithinPolygon, nil
+	case models.WhereFilterOperatorIntersects:
+		return filters.OperatorIntersects, nil
+	case models.WhereFilterOperatorFuzzyMatch:
+		return filters.OperatorFuzzyMatch, nil
 	default:
 		return -1, fmt.Errorf("unrecognized operator: %s", in)
 	}
@@ -171,6 +193,7 @@ func allValuesNil(in *models.WhereFilter) bool {
 		in.ValueInt == nil &&
 		in.ValueNumber == nil &&
 		in.ValueGeoRange == nil &&
+		in.ValueGeoPolygon == nil &&
 		len(in.ValueBooleanArray) == 0 &&
 		len(in.ValueDateArray) == 0 &&
 		len(in.ValueStringArray) == 0 &&
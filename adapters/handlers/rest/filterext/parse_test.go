@@ -13,6 +13,7 @@ package filterext
 
 import (
 	"fmt"
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -156,6 +157,25 @@ func Test_ExtractFlatFilters(t *testing.T) {
 					},
 				}},
 			},
+			{
+				name: "valid geo polygon filter",
+				input: &models.WhereFilter{
+					Operator:        "WithinPolygon",
+					ValueGeoPolygon: inputGeoPolygonFilter(3),
+					Path:            []string{"geoField"},
+				},
+				expectedFilter: &filters.LocalFilter{Root: &filters.Clause{
+					Operator: filters.OperatorWithinPolygon,
+					On: &filters.Path{
+						Class:    schema.AssertValidClassName("Todo"),
+						Property: schema.AssertValidPropertyName("geoField"),
+					},
+					Value: &filters.Value{
+						Value: filters.GeoPolygon{GeoPolygon: inputGeoPolygonFilter(3)},
+						Type:  schema.DataTypeGeoPolygon,
+					},
+				}},
+			},
 			{
 				name: "[deprecated string] valid string filter",
 				input: &models.WhereFilter{
@@ -235,6 +255,16 @@ func Test_ExtractFlatFilters(t *testing.T) {
 				expectedErr: fmt.Errorf("invalid where filter: valueGeoRange: " +
 					"field 'distance.max' must be a positive number"),
 			},
+			{
+				name: "geo polygon with too few coordinates",
+				input: &models.WhereFilter{
+					Operator:        "Intersects",
+					ValueGeoPolygon: inputGeoPolygonFilter(2),
+					Path:            []string{"geoField"},
+				},
+				expectedErr: fmt.Errorf("invalid where filter: valueGeoPolygon: " +
+					"field 'coordinates' must contain at least 3 coordinates"),
+			},
 			{
 				name: "and operator and path set",
 				input: &models.WhereFilter{
@@ -432,6 +462,90 @@ func Test_ExtractFlatFilters(t *testing.T) {
 					},
 				},
 			},
+			{
+				name: "negated using not",
+				input: &models.WhereFilter{
+					Operator: "Not",
+					Operands: []*models.WhereFilter{
+						inputIntFilterWithValue(42),
+					},
+				},
+				expectedFilter: &filters.LocalFilter{
+					Root: &filters.Clause{
+						Operator: filters.OperatorNot,
+						Operands: []filters.Clause{
+							{
+								Operator: filters.OperatorEqual,
+								On: &filters.Path{
+									Class:    schema.AssertValidClassName("Todo"),
+									Property: schema.AssertValidPropertyName("intField"),
+								},
+								Value: &filters.Value{
+									Value: 42,
+									Type:  schema.DataTypeInt,
+								},
+							},
+						},
+					},
+				},
+			},
+			{
+				name: "operands without operator default to and",
+				input: &models.WhereFilter{
+					Operands: []*models.WhereFilter{
+						inputIntFilterWithValue(42),
+						{
+							Operator: "Not",
+							Operands: []*models.WhereFilter{inputIntFilterWithValue(43)},
+						},
+					},
+				},
+				expectedFilter: &filters.LocalFilter{
+					Root: &filters.Clause{
+						Operator: filters.OperatorAnd,
+						Operands: []filters.Clause{
+							{
+								Operator: filters.OperatorEqual,
+								On: &filters.Path{
+									Class:    schema.AssertValidClassName("Todo"),
+									Property: schema.AssertValidPropertyName("intField"),
+								},
+								Value: &filters.Value{
+									Value: 42,
+									Type:  schema.DataTypeInt,
+								},
+							},
+							{
+								Operator: filters.OperatorNot,
+								Operands: []filters.Clause{
+									{
+										Operator: filters.OperatorEqual,
+										On: &filters.Path{
+											Class:    schema.AssertValidClassName("Todo"),
+											Property: schema.AssertValidPropertyName("intField"),
+										},
+										Value: &filters.Value{
+											Value: 43,
+											Type:  schema.DataTypeInt,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			{
+				name: "not with more than one operand",
+				input: &models.WhereFilter{
+					Operator: "Not",
+					Operands: []*models.WhereFilter{
+						inputIntFilterWithValue(42),
+						inputIntFilterWithValue(43),
+					},
+				},
+				expectedErr: fmt.Errorf("invalid where filter: operator 'Not' requires exactly one operand, got 2 - combine several operands with 'And' or 'Or' first"),
+			},
 		}
 
 		for _, test := range tests {
@@ -515,6 +629,19 @@ func inputGeoRangeFilter(lat, lon, max float64) *models.WhereFilterGeoRange {
 	}
 }
 
+// inputGeoPolygonFilter returns a polygon with n corners on a circle around 0,0
+func inputGeoPolygonFilter(n int) *models.GeoPolygon {
+	polygon := &models.GeoPolygon{}
+	for i := 0; i < n; i++ {
+		angle := 2 * math.Pi * float64(i) / float64(n)
+		polygon.Coordinates = append(polygon.Coordinates, &models.GeoCoordinates{
+			Latitude:  ptFloat32(float32(math.Sin(angle))),
+			Longitude: ptFloat32(float32(math.Cos(angle))),
+		})
+	}
+	return polygon
+}
+
 func ptFloat32(in float32) *float32 {
 	return &in
 }
@@ -162,6 +162,20 @@ var valueExtractors = []valueExtractorFunc{
 			},
 		}, schema.DataTypeGeoCoordinates), nil
 	},
+	// geo polygon
+	func(in *models.WhereFilter) (*filters.Value, error) {
+		if in.ValueGeoPolygon == nil {
+			return nil, nil
+		}
+
+		if len(in.ValueGeoPolygon.Coordinates) < 3 {
+			return nil, fmt.Errorf("valueGeoPolygon: field 'coordinates' must contain at least 3 coordinates")
+		}
+
+		return valueFilter(filters.GeoPolygon{
+			GeoPolygon: in.ValueGeoPolygon,
+		}, schema.DataTypeGeoPolygon), nil
+	},
 	// deprecated string
 	func(in *models.WhereFilter) (*filters.Value, error) {
 		if in.ValueString == nil {
@@ -19,6 +19,7 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/batch"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
 	"github.com/weaviate/weaviate/entities/models"
 	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
 	"github.com/weaviate/weaviate/usecases/monitoring"
@@ -41,19 +42,27 @@ func (h *batchObjectHandlers) addObjects(params batch.BatchObjectsCreateParams,
 	}
 
 	objs, err := h.manager.AddObjects(params.HTTPRequest.Context(), principal,
-		params.Body.Objects, params.Body.Fields, repl)
+		params.Body.Objects, params.Body.Fields, repl,
+		objects.VectorizePolicy(params.Body.VectorizePolicy), params.Body.UpsertBy)
 	if err != nil {
 		h.metricRequestsTotal.logError("", err)
-		switch err.(type) {
+		switch e := err.(type) {
 		case autherrs.Forbidden:
 			return batch.NewBatchObjectsCreateForbidden().
 				WithPayload(errPayloadFromSingleErr(err))
 		case objects.ErrInvalidUserInput:
 			return batch.NewBatchObjectsCreateUnprocessableEntity().
 				WithPayload(errPayloadFromSingleErr(err))
-		case objects.ErrMultiTenancy:
+		case objects.ErrMultiTenancy, objects.ErrQuotaExceeded:
 			return batch.NewBatchObjectsCreateUnprocessableEntity().
 				WithPayload(errPayloadFromSingleErr(err))
+		case objects.ErrReadOnly:
+			return batch.NewBatchObjectsCreateLocked().
+				WithPayload(errPayloadFromSingleErr(err))
+		case enterrors.ErrOverloaded:
+			return batch.NewBatchObjectsCreateTooManyRequests().
+				WithRetryAfter(retryAfterSeconds(e)).
+				WithPayload(errPayloadFromSingleErr(err))
 		default:
 			return batch.NewBatchObjectsCreateInternalServerError().
 				WithPayload(errPayloadFromSingleErr(err))
@@ -108,9 +117,12 @@ func (h *batchObjectHandlers) addReferences(params batch.BatchReferencesCreatePa
 		case objects.ErrInvalidUserInput:
 			return batch.NewBatchReferencesCreateUnprocessableEntity().
 				WithPayload(errPayloadFromSingleErr(err))
-		case objects.ErrMultiTenancy:
+		case objects.ErrMultiTenancy, objects.ErrQuotaExceeded:
 			return batch.NewBatchReferencesCreateUnprocessableEntity().
 				WithPayload(errPayloadFromSingleErr(err))
+		case objects.ErrReadOnly:
+			return batch.NewBatchReferencesCreateLocked().
+				WithPayload(errPayloadFromSingleErr(err))
 		default:
 			return batch.NewBatchReferencesCreateInternalServerError().
 				WithPayload(errPayloadFromSingleErr(err))
@@ -174,6 +186,9 @@ func (h *batchObjectHandlers) deleteObjects(params batch.BatchObjectsDeleteParam
 		} else if errors.As(err, &autherrs.Forbidden{}) {
 			return batch.NewBatchObjectsDeleteForbidden().
 				WithPayload(errPayloadFromSingleErr(err))
+		} else if errors.As(err, &objects.ErrReadOnly{}) {
+			return batch.NewBatchObjectsDeleteLocked().
+				WithPayload(errPayloadFromSingleErr(err))
 		} else {
 			return batch.NewBatchObjectsDeleteInternalServerError().
 				WithPayload(errPayloadFromSingleErr(err))
@@ -262,10 +277,15 @@ func (e *batchRequestsTotal) logError(className string, err error) {
 		e.logUserError(className)
 	case autherrs.Forbidden, objects.ErrInvalidUserInput:
 		e.logUserError(className)
-	case objects.ErrMultiTenancy:
+	case objects.ErrMultiTenancy, objects.ErrReadOnly, objects.ErrQuotaExceeded:
+		e.logUserError(className)
+	case enterrors.ErrOverloaded:
+		// rejected on purpose, the client is expected to retry
 		e.logUserError(className)
 	default:
 		if errors.As(err, &objects.ErrMultiTenancy{}) ||
+			errors.As(err, &objects.ErrReadOnly{}) ||
+			errors.As(err, &objects.ErrQuotaExceeded{}) ||
 			errors.As(err, &objects.ErrInvalidUserInput{}) ||
 			errors.As(err, &autherrs.Forbidden{}) {
 			e.logUserError(className)
@@ -145,6 +145,13 @@ func setupGraphQLHandlers(
 			return graphql.NewGraphqlPostUnprocessableEntity().WithPayload(errorResponse)
 		}
 
+		if overloaded, ok := metricRequestsTotal.getErrOverloaded(result); ok {
+			metricRequestsTotal.logUserError()
+			return graphql.NewGraphqlPostTooManyRequests().
+				WithRetryAfter(retryAfterSeconds(*overloaded)).
+				WithPayload(errPayloadFromSingleErr(overloaded))
+		}
+
 		metricRequestsTotal.log(result)
 		// Return the response
 		return graphql.NewGraphqlPostOK().WithPayload(graphQLResponse)
@@ -323,6 +330,19 @@ func (e *graphqlRequestsTotal) getErrGraphQLUser(gqlError gqlerrors.FormattedErr
 	return false, nil
 }
 
+// getErrOverloaded returns the error of a query which was rejected by the
+// admission control, the whole request is answered with a 429 then
+func (e *graphqlRequestsTotal) getErrOverloaded(result *tailorincgraphql.Result) (*enterrors.ErrOverloaded, bool) {
+	for _, gqlErr := range result.Errors {
+		if isUserError, err := e.getErrGraphQLUser(gqlErr); isUserError {
+			if overloaded, ok := err.OriginalError().(enterrors.ErrOverloaded); ok {
+				return &overloaded, true
+			}
+		}
+	}
+	return nil, false
+}
+
 func (e *graphqlRequestsTotal) isSyntaxRelatedError(gqlError gqlerrors.FormattedError) bool {
 	for _, prefix := range []string{"Syntax Error ", "Cannot query field"} {
 		if strings.HasPrefix(gqlError.Message, prefix) {
@@ -79,6 +79,26 @@ func (s *nodesHandlers) handleGetNodesError(err error) middleware.Responder {
 		WithPayload(errPayloadFromSingleErr(err))
 }
 
+func (s *nodesHandlers) updateReadOnly(params nodes.NodesReadOnlyUpdateParams, principal *models.Principal) middleware.Responder {
+	status, err := s.manager.UpdateReadOnly(params.HTTPRequest.Context(), principal, params.Body)
+	if err != nil {
+		s.metricRequestsTotal.logError(params.Body.Class, err)
+		if errors.As(err, &enterrors.ErrNotFound{}) {
+			return nodes.NewNodesReadOnlyUpdateNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+		if errors.As(err, &autherrs.Forbidden{}) {
+			return nodes.NewNodesReadOnlyUpdateForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+		return nodes.NewNodesReadOnlyUpdateInternalServerError().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	s.metricRequestsTotal.logOk(params.Body.Class)
+	return nodes.NewNodesReadOnlyUpdateOK().WithPayload(status)
+}
+
 func setupNodesHandlers(api *operations.WeaviateAPI,
 	schemaManger *schemaUC.Manager, repo *db.DB, appState *state.State,
 ) {
@@ -90,6 +110,8 @@ func setupNodesHandlers(api *operations.WeaviateAPI,
 		NodesGetHandlerFunc(h.getNodesStatus)
 	api.NodesNodesGetClassHandler = nodes.
 		NodesGetClassHandlerFunc(h.getNodesStatusByClass)
+	api.NodesNodesReadOnlyUpdateHandler = nodes.
+		NodesReadOnlyUpdateHandlerFunc(h.updateReadOnly)
 }
 
 type nodesRequestsTotal struct {
@@ -12,16 +12,24 @@
 package rest
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
 	"strings"
 
+	"github.com/go-openapi/runtime"
 	middleware "github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/strfmt"
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/objects"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/schema"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema/crossref"
@@ -52,11 +60,11 @@ type objectsManager interface {
 	ValidateObject(context.Context, *models.Principal,
 		*models.Object, *additional.ReplicationProperties) error
 	GetObject(context.Context, *models.Principal, string, strfmt.UUID,
-		additional.Properties, *additional.ReplicationProperties, string) (*models.Object, error)
+		additional.Properties, *additional.ReplicationProperties, string) (*models.Object, int64, error)
 	DeleteObject(context.Context, *models.Principal, string,
-		strfmt.UUID, *additional.ReplicationProperties, string) error
+		strfmt.UUID, *additional.ReplicationProperties, string, *uco.VersionPrecondition) error
 	UpdateObject(context.Context, *models.Principal, string, strfmt.UUID,
-		*models.Object, *additional.ReplicationProperties) (*models.Object, error)
+		*models.Object, *additional.ReplicationProperties, *uco.VersionPrecondition) (*models.Object, error)
 	HeadObject(ctx context.Context, principal *models.Principal, class string, id strfmt.UUID,
 		repl *additional.ReplicationProperties, tenant string) (bool, *uco.Error)
 	GetObjects(context.Context, *models.Principal, *int64, *int64,
@@ -64,7 +72,9 @@ type objectsManager interface {
 	Query(ctx context.Context, principal *models.Principal,
 		params *uco.QueryParams) ([]*models.Object, *uco.Error)
 	MergeObject(context.Context, *models.Principal, *models.Object,
-		*additional.ReplicationProperties) *uco.Error
+		*additional.ReplicationProperties, *uco.VersionPrecondition) *uco.Error
+	PatchObject(context.Context, *models.Principal, string, strfmt.UUID, uco.ObjectPatch,
+		*additional.ReplicationProperties, string, *uco.VersionPrecondition) *uco.Error
 	AddObjectReference(context.Context, *models.Principal, *uco.AddReferenceInput,
 		*additional.ReplicationProperties, string) *uco.Error
 	UpdateObjectReferences(context.Context, *models.Principal,
@@ -73,6 +83,17 @@ type objectsManager interface {
 		*additional.ReplicationProperties, string) *uco.Error
 	GetObjectsClass(ctx context.Context, principal *models.Principal, id strfmt.UUID) (*models.Class, error)
 	GetObjectClassFromName(ctx context.Context, principal *models.Principal, className string) (*models.Class, error)
+	AuditReferences(ctx context.Context, principal *models.Principal,
+		className, tenant string, prune bool) (*models.ReferenceAudit, *uco.Error)
+	PutObjectBlob(ctx context.Context, principal *models.Principal, className string,
+		id strfmt.UUID, propName string, body io.Reader,
+		repl *additional.ReplicationProperties, tenant string) *uco.Error
+	GetObjectBlob(ctx context.Context, principal *models.Principal, className string,
+		id strfmt.UUID, propName string, repl *additional.ReplicationProperties,
+		tenant string) (io.ReadCloser, int64, *uco.Error)
+	UpdateObjectVector(ctx context.Context, principal *models.Principal, className string,
+		id strfmt.UUID, vector []float32, vectors models.Vectors,
+		repl *additional.ReplicationProperties, tenant string) *uco.Error
 }
 
 func (h *objectHandlers) addObject(params objects.ObjectsCreateParams,
@@ -96,9 +117,15 @@ func (h *objectHandlers) addObject(params objects.ObjectsCreateParams,
 		} else if errors.As(err, &uco.ErrMultiTenancy{}) {
 			return objects.NewObjectsCreateUnprocessableEntity().
 				WithPayload(errPayloadFromSingleErr(err))
+		} else if errors.As(err, &uco.ErrQuotaExceeded{}) {
+			return objects.NewObjectsCreateUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
 		} else if errors.As(err, &autherrs.Forbidden{}) {
 			return objects.NewObjectsCreateForbidden().
 				WithPayload(errPayloadFromSingleErr(err))
+		} else if errors.As(err, &uco.ErrReadOnly{}) {
+			return objects.NewObjectsCreateLocked().
+				WithPayload(errPayloadFromSingleErr(err))
 		} else {
 			return objects.NewObjectsCreateInternalServerError().
 				WithPayload(errPayloadFromSingleErr(err))
@@ -184,7 +211,7 @@ func (h *objectHandlers) getObject(params objects.ObjectsClassGetParams,
 
 	tenant := getTenant(params.Tenant)
 
-	object, err := h.manager.GetObject(params.HTTPRequest.Context(), principal,
+	object, version, err := h.manager.GetObject(params.HTTPRequest.Context(), principal,
 		params.ClassName, params.ID, additional, replProps, tenant)
 	if err != nil {
 		h.metricRequestsTotal.logError(getClassName(object), err)
@@ -209,7 +236,7 @@ func (h *objectHandlers) getObject(params objects.ObjectsClassGetParams,
 	}
 
 	h.metricRequestsTotal.logOk(getClassName(object))
-	return objects.NewObjectsClassGetOK().WithPayload(object)
+	return objects.NewObjectsClassGetOK().WithETag(uco.ETag(version)).WithPayload(object)
 }
 
 func (h *objectHandlers) getObjects(params objects.ObjectsListParams,
@@ -218,6 +245,12 @@ func (h *objectHandlers) getObjects(params objects.ObjectsListParams,
 	if params.Class != nil && *params.Class != "" {
 		return h.query(params, principal)
 	}
+	if params.Scroll != nil || params.ScrollID != nil {
+		err := fmt.Errorf("scroll sessions are specific to one class, set class query param")
+		h.metricRequestsTotal.logError("", err)
+		return objects.NewObjectsListUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
 	additional, err := parseIncludeParam(params.Include, h.modulesProvider, h.shouldIncludeGetObjectsModuleParams(), nil)
 	if err != nil {
 		h.metricRequestsTotal.logError("", err)
@@ -279,6 +312,8 @@ func (h *objectHandlers) query(params objects.ObjectsListParams,
 		Order:      params.Order,
 		Tenant:     params.Tenant,
 		Additional: additional,
+		Scroll:     params.Scroll,
+		ScrollID:   params.ScrollID,
 	}
 	resultSet, rerr := h.manager.Query(params.HTTPRequest.Context(), principal, &req)
 	if rerr != nil {
@@ -308,12 +343,18 @@ func (h *objectHandlers) query(params objects.ObjectsListParams,
 		}
 	}
 
+	var scrollID string
+	if req.ScrollID != nil {
+		scrollID = *req.ScrollID
+	}
+
 	h.metricRequestsTotal.logOk(req.Class)
 	return objects.NewObjectsListOK().
 		WithPayload(&models.ObjectsListResponse{
 			Objects:      resultSet,
 			TotalResults: int64(len(resultSet)),
 			Deprecations: []*models.Deprecation{},
+			ScrollID:     scrollID,
 		})
 }
 
@@ -331,7 +372,7 @@ func (h *objectHandlers) deleteObject(params objects.ObjectsClassDeleteParams,
 	tenant := getTenant(params.Tenant)
 
 	err = h.manager.DeleteObject(params.HTTPRequest.Context(),
-		principal, params.ClassName, params.ID, repl, tenant)
+		principal, params.ClassName, params.ID, repl, tenant, getPrecondition(params.IfMatch))
 	if err != nil {
 		h.metricRequestsTotal.logError(params.ClassName, err)
 		switch err.(type) {
@@ -340,9 +381,18 @@ func (h *objectHandlers) deleteObject(params objects.ObjectsClassDeleteParams,
 				WithPayload(errPayloadFromSingleErr(err))
 		case uco.ErrNotFound:
 			return objects.NewObjectsClassDeleteNotFound()
+		case uco.ErrPreconditionFailed:
+			return objects.NewObjectsClassDeletePreconditionFailed().
+				WithPayload(errPayloadFromSingleErr(err))
 		case uco.ErrMultiTenancy:
 			return objects.NewObjectsClassDeleteUnprocessableEntity().
 				WithPayload(errPayloadFromSingleErr(err))
+		case uco.ErrInvalidUserInput:
+			return objects.NewObjectsClassDeleteUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		case uco.ErrReadOnly:
+			return objects.NewObjectsClassDeleteLocked().
+				WithPayload(errPayloadFromSingleErr(err))
 		default:
 			return objects.NewObjectsClassDeleteInternalServerError().
 				WithPayload(errPayloadFromSingleErr(err))
@@ -365,7 +415,7 @@ func (h *objectHandlers) updateObject(params objects.ObjectsClassPutParams,
 	}
 
 	object, err := h.manager.UpdateObject(params.HTTPRequest.Context(),
-		principal, params.ClassName, params.ID, params.Body, repl)
+		principal, params.ClassName, params.ID, params.Body, repl, getPrecondition(params.IfMatch))
 	if err != nil {
 		h.metricRequestsTotal.logError(className, err)
 		if errors.As(err, &uco.ErrInvalidUserInput{}) {
@@ -374,9 +424,18 @@ func (h *objectHandlers) updateObject(params objects.ObjectsClassPutParams,
 		} else if errors.As(err, &uco.ErrMultiTenancy{}) {
 			return objects.NewObjectsClassPutUnprocessableEntity().
 				WithPayload(errPayloadFromSingleErr(err))
+		} else if errors.As(err, &uco.ErrQuotaExceeded{}) {
+			return objects.NewObjectsClassPutUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
 		} else if errors.As(err, &autherrs.Forbidden{}) {
 			return objects.NewObjectsClassPutForbidden().
 				WithPayload(errPayloadFromSingleErr(err))
+		} else if errors.As(err, &uco.ErrReadOnly{}) {
+			return objects.NewObjectsClassPutLocked().
+				WithPayload(errPayloadFromSingleErr(err))
+		} else if errors.As(err, &uco.ErrPreconditionFailed{}) {
+			return objects.NewObjectsClassPutPreconditionFailed().
+				WithPayload(errPayloadFromSingleErr(err))
 		} else {
 			return objects.NewObjectsClassPutInternalServerError().
 				WithPayload(errPayloadFromSingleErr(err))
@@ -429,20 +488,45 @@ func (h *objectHandlers) headObject(params objects.ObjectsClassHeadParams,
 }
 
 func (h *objectHandlers) patchObject(params objects.ObjectsClassPatchParams, principal *models.Principal) middleware.Responder {
-	updates := params.Body
-	updates.ID = params.ID
-	updates.Class = params.ClassName
-
 	repl, err := getReplicationProperties(params.ConsistencyLevel, nil)
 	if err != nil {
-		h.metricRequestsTotal.logError(getClassName(updates), err)
+		h.metricRequestsTotal.logError(params.ClassName, err)
 		return objects.NewObjectsCreateBadRequest().
 			WithPayload(errPayloadFromSingleErr(err))
 	}
 
-	objErr := h.manager.MergeObject(params.HTTPRequest.Context(), principal, updates, repl)
+	ctx := params.HTTPRequest.Context()
+	precondition := getPrecondition(params.IfMatch)
+	tenant := getTenant(params.Tenant)
+
+	var objErr *uco.Error
+	switch mediaType := patchMediaType(params.HTTPRequest); mediaType {
+	case mediaTypeJSONPatch, mediaTypeMergePatch:
+		patch, err := getObjectPatch(mediaType, params.Body)
+		if err != nil {
+			h.metricRequestsTotal.logUserError(params.ClassName)
+			return objects.NewObjectsClassPatchBadRequest().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+		objErr = h.manager.PatchObject(ctx, principal, params.ClassName, params.ID,
+			patch, repl, tenant, precondition)
+	default:
+		updates, err := getObjectUpdates(params.Body)
+		if err != nil {
+			h.metricRequestsTotal.logUserError(params.ClassName)
+			return objects.NewObjectsClassPatchUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+		updates.ID = params.ID
+		updates.Class = params.ClassName
+		if updates.Tenant == "" {
+			updates.Tenant = tenant
+		}
+		objErr = h.manager.MergeObject(ctx, principal, updates, repl, precondition)
+	}
+
 	if objErr != nil {
-		h.metricRequestsTotal.logError(getClassName(updates), objErr)
+		h.metricRequestsTotal.logError(params.ClassName, objErr)
 		switch {
 		case objErr.NotFound():
 			return objects.NewObjectsClassPatchNotFound()
@@ -455,13 +539,19 @@ func (h *objectHandlers) patchObject(params objects.ObjectsClassPatchParams, pri
 		case objErr.UnprocessableEntity():
 			return objects.NewObjectsClassPatchUnprocessableEntity().
 				WithPayload(errPayloadFromSingleErr(objErr))
+		case objErr.Locked():
+			return objects.NewObjectsClassPatchLocked().
+				WithPayload(errPayloadFromSingleErr(objErr))
+		case objErr.PreconditionFailed():
+			return objects.NewObjectsClassPatchPreconditionFailed().
+				WithPayload(errPayloadFromSingleErr(objErr))
 		default:
 			return objects.NewObjectsClassPatchInternalServerError().
 				WithPayload(errPayloadFromSingleErr(objErr))
 		}
 	}
 
-	h.metricRequestsTotal.logOk(getClassName(updates))
+	h.metricRequestsTotal.logOk(params.ClassName)
 	return objects.NewObjectsClassPatchNoContent()
 }
 
@@ -499,6 +589,9 @@ func (h *objectHandlers) addObjectReference(
 		case objErr.UnprocessableEntity():
 			return objects.NewObjectsClassReferencesCreateUnprocessableEntity().
 				WithPayload(errPayloadFromSingleErr(objErr))
+		case objErr.Locked():
+			return objects.NewObjectsClassReferencesCreateLocked().
+				WithPayload(errPayloadFromSingleErr(objErr))
 		default:
 			return objects.NewObjectsClassReferencesCreateInternalServerError().
 				WithPayload(errPayloadFromSingleErr(objErr))
@@ -543,6 +636,9 @@ func (h *objectHandlers) putObjectReferences(params objects.ObjectsClassReferenc
 		case objErr.UnprocessableEntity():
 			return objects.NewObjectsClassReferencesPutUnprocessableEntity().
 				WithPayload(errPayloadFromSingleErr(objErr))
+		case objErr.Locked():
+			return objects.NewObjectsClassReferencesPutLocked().
+				WithPayload(errPayloadFromSingleErr(objErr))
 		default:
 			return objects.NewObjectsClassReferencesPutInternalServerError().
 				WithPayload(errPayloadFromSingleErr(objErr))
@@ -586,6 +682,9 @@ func (h *objectHandlers) deleteObjectReference(params objects.ObjectsClassRefere
 		case uco.StatusUnprocessableEntity:
 			return objects.NewObjectsClassReferencesDeleteUnprocessableEntity().
 				WithPayload(errPayloadFromSingleErr(objErr))
+		case uco.StatusLocked:
+			return objects.NewObjectsClassReferencesDeleteLocked().
+				WithPayload(errPayloadFromSingleErr(objErr))
 		default:
 			return objects.NewObjectsClassReferencesDeleteInternalServerError().
 				WithPayload(errPayloadFromSingleErr(objErr))
@@ -596,6 +695,155 @@ func (h *objectHandlers) deleteObjectReference(params objects.ObjectsClassRefere
 	return objects.NewObjectsClassReferencesDeleteNoContent()
 }
 
+func (h *objectHandlers) auditReferences(params schema.SchemaObjectsReferencesAuditParams,
+	principal *models.Principal,
+) middleware.Responder {
+	prune := params.Prune != nil && *params.Prune
+	tenant := getTenant(params.Tenant)
+
+	audit, objErr := h.manager.AuditReferences(params.HTTPRequest.Context(),
+		principal, params.ClassName, tenant, prune)
+	if objErr != nil {
+		h.metricRequestsTotal.logError(params.ClassName, objErr)
+		switch {
+		case objErr.Forbidden():
+			return schema.NewSchemaObjectsReferencesAuditForbidden().
+				WithPayload(errPayloadFromSingleErr(objErr))
+		case objErr.NotFound():
+			return schema.NewSchemaObjectsReferencesAuditNotFound().
+				WithPayload(errPayloadFromSingleErr(objErr))
+		case objErr.BadRequest(), objErr.UnprocessableEntity():
+			return schema.NewSchemaObjectsReferencesAuditUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(objErr))
+		default:
+			return schema.NewSchemaObjectsReferencesAuditInternalServerError().
+				WithPayload(errPayloadFromSingleErr(objErr))
+		}
+	}
+
+	h.metricRequestsTotal.logOk(params.ClassName)
+	return schema.NewSchemaObjectsReferencesAuditOK().WithPayload(audit)
+}
+
+func (h *objectHandlers) putObjectBlob(params objects.ObjectsClassBlobsPutParams,
+	principal *models.Principal,
+) middleware.Responder {
+	defer params.Body.Close()
+
+	repl, err := getReplicationProperties(params.ConsistencyLevel, nil)
+	if err != nil {
+		h.metricRequestsTotal.logError(params.ClassName, err)
+		return objects.NewObjectsClassBlobsPutBadRequest().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	tenant := getTenant(params.Tenant)
+
+	objErr := h.manager.PutObjectBlob(params.HTTPRequest.Context(), principal,
+		params.ClassName, params.ID, params.PropertyName, params.Body, repl, tenant)
+	if objErr != nil {
+		h.metricRequestsTotal.logError(params.ClassName, objErr)
+		switch {
+		case objErr.Forbidden():
+			return objects.NewObjectsClassBlobsPutForbidden().
+				WithPayload(errPayloadFromSingleErr(objErr))
+		case objErr.NotFound():
+			return objects.NewObjectsClassBlobsPutNotFound()
+		case objErr.BadRequest(), objErr.UnprocessableEntity():
+			return objects.NewObjectsClassBlobsPutUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(objErr))
+		case objErr.Locked():
+			return objects.NewObjectsClassBlobsPutLocked().
+				WithPayload(errPayloadFromSingleErr(objErr))
+		default:
+			return objects.NewObjectsClassBlobsPutInternalServerError().
+				WithPayload(errPayloadFromSingleErr(objErr))
+		}
+	}
+
+	h.metricRequestsTotal.logOk(params.ClassName)
+	return objects.NewObjectsClassBlobsPutNoContent()
+}
+
+func (h *objectHandlers) getObjectBlob(params objects.ObjectsClassBlobsGetParams,
+	principal *models.Principal,
+) middleware.Responder {
+	repl, err := getReplicationProperties(params.ConsistencyLevel, nil)
+	if err != nil {
+		h.metricRequestsTotal.logError(params.ClassName, err)
+		return objects.NewObjectsClassBlobsGetBadRequest().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	tenant := getTenant(params.Tenant)
+
+	blob, size, objErr := h.manager.GetObjectBlob(params.HTTPRequest.Context(), principal,
+		params.ClassName, params.ID, params.PropertyName, repl, tenant)
+	if objErr != nil {
+		h.metricRequestsTotal.logError(params.ClassName, objErr)
+		switch {
+		case objErr.Forbidden():
+			return objects.NewObjectsClassBlobsGetForbidden().
+				WithPayload(errPayloadFromSingleErr(objErr))
+		case objErr.NotFound():
+			return objects.NewObjectsClassBlobsGetNotFound()
+		case objErr.BadRequest(), objErr.UnprocessableEntity():
+			return objects.NewObjectsClassBlobsGetUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(objErr))
+		default:
+			return objects.NewObjectsClassBlobsGetInternalServerError().
+				WithPayload(errPayloadFromSingleErr(objErr))
+		}
+	}
+
+	h.metricRequestsTotal.logOk(params.ClassName)
+	return middleware.ResponderFunc(func(rw http.ResponseWriter, p runtime.Producer) {
+		rw.Header().Set("Content-Length", strconv.FormatInt(size, 10))
+		objects.NewObjectsClassBlobsGetOK().WithPayload(blob).WriteResponse(rw, p)
+	})
+}
+
+func (h *objectHandlers) putObjectVector(params objects.ObjectsClassVectorPutParams,
+	principal *models.Principal,
+) middleware.Responder {
+	repl, err := getReplicationProperties(params.ConsistencyLevel, nil)
+	if err != nil {
+		h.metricRequestsTotal.logError(params.ClassName, err)
+		return objects.NewObjectsClassVectorPutBadRequest().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	tenant := getTenant(params.Tenant)
+
+	objErr := h.manager.UpdateObjectVector(params.HTTPRequest.Context(), principal,
+		params.ClassName, params.ID, params.Body.Vector, params.Body.Vectors, repl, tenant)
+	if objErr != nil {
+		h.metricRequestsTotal.logError(params.ClassName, objErr)
+		switch {
+		case objErr.Forbidden():
+			return objects.NewObjectsClassVectorPutForbidden().
+				WithPayload(errPayloadFromSingleErr(objErr))
+		case objErr.NotFound():
+			return objects.NewObjectsClassVectorPutNotFound()
+		case objErr.BadRequest():
+			return objects.NewObjectsClassVectorPutBadRequest().
+				WithPayload(errPayloadFromSingleErr(objErr))
+		case objErr.UnprocessableEntity():
+			return objects.NewObjectsClassVectorPutUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(objErr))
+		case objErr.Locked():
+			return objects.NewObjectsClassVectorPutLocked().
+				WithPayload(errPayloadFromSingleErr(objErr))
+		default:
+			return objects.NewObjectsClassVectorPutInternalServerError().
+				WithPayload(errPayloadFromSingleErr(objErr))
+		}
+	}
+
+	h.metricRequestsTotal.logOk(params.ClassName)
+	return objects.NewObjectsClassVectorPutNoContent()
+}
+
 func setupObjectHandlers(api *operations.WeaviateAPI,
 	manager *uco.Manager, config config.Config, logger logrus.FieldLogger,
 	modulesProvider ModulesProvider, metrics *monitoring.PrometheusMetrics,
@@ -623,6 +871,14 @@ func setupObjectHandlers(api *operations.WeaviateAPI,
 		ObjectsClassReferencesDeleteHandlerFunc(h.deleteObjectReference)
 	api.ObjectsObjectsClassReferencesPutHandler = objects.
 		ObjectsClassReferencesPutHandlerFunc(h.putObjectReferences)
+	api.SchemaSchemaObjectsReferencesAuditHandler = schema.
+		SchemaObjectsReferencesAuditHandlerFunc(h.auditReferences)
+	api.ObjectsObjectsClassBlobsPutHandler = objects.
+		ObjectsClassBlobsPutHandlerFunc(h.putObjectBlob)
+	api.ObjectsObjectsClassBlobsGetHandler = objects.
+		ObjectsClassBlobsGetHandlerFunc(h.getObjectBlob)
+	api.ObjectsObjectsClassVectorPutHandler = objects.
+		ObjectsClassVectorPutHandlerFunc(h.putObjectVector)
 	// deprecated handlers
 	api.ObjectsObjectsGetHandler = objects.
 		ObjectsGetHandlerFunc(h.getObjectDeprecated)
@@ -671,6 +927,7 @@ func (h *objectHandlers) patchObjectDeprecated(params objects.ObjectsPatchParams
 		HTTPRequest: params.HTTPRequest,
 		ID:          params.ID,
 		Body:        params.Body,
+		IfMatch:     params.IfMatch,
 	}
 	if params.Body != nil {
 		args.ClassName = params.Body.Class
@@ -687,6 +944,7 @@ func (h *objectHandlers) updateObjectDeprecated(params objects.ObjectsUpdatePara
 		ClassName:   params.Body.Class,
 		Body:        params.Body,
 		ID:          params.ID,
+		IfMatch:     params.IfMatch,
 	}
 	return h.updateObject(ps, principal)
 }
@@ -698,6 +956,7 @@ func (h *objectHandlers) deleteObjectDeprecated(params objects.ObjectsDeletePara
 	ps := objects.ObjectsClassDeleteParams{
 		HTTPRequest: params.HTTPRequest,
 		ID:          params.ID,
+		IfMatch:     params.IfMatch,
 	}
 	return h.deleteObject(ps, principal)
 }
@@ -804,7 +1063,8 @@ func (e *objectsRequestsTotal) logError(className string, err error) {
 		e.logUserError(className)
 	case autherrs.Forbidden:
 		e.logUserError(className)
-	case uco.ErrInvalidUserInput, uco.ErrNotFound:
+	case uco.ErrInvalidUserInput, uco.ErrNotFound, uco.ErrReadOnly,
+		uco.ErrQuotaExceeded:
 		e.logUserError(className)
 	case *uco.Error:
 		switch err.Code {
@@ -816,6 +1076,8 @@ func (e *objectsRequestsTotal) logError(className string, err error) {
 	default:
 		if errors.As(err, &uco.ErrInvalidUserInput{}) ||
 			errors.As(err, &uco.ErrMultiTenancy{}) ||
+			errors.As(err, &uco.ErrReadOnly{}) ||
+			errors.As(err, &uco.ErrQuotaExceeded{}) ||
 			errors.As(err, &autherrs.Forbidden{}) {
 			e.logUserError(className)
 		} else {
@@ -911,6 +1173,69 @@ func getTenant(maybeKey *string) string {
 	return ""
 }
 
+func getPrecondition(ifMatch *string) *uco.VersionPrecondition {
+	if ifMatch == nil {
+		return nil
+	}
+	return uco.ParseIfMatch(*ifMatch)
+}
+
+const (
+	mediaTypeJSONPatch  = "application/json-patch+json"
+	mediaTypeMergePatch = "application/merge-patch+json"
+)
+
+// patchMediaType returns the media type of the body of a PATCH request
+func patchMediaType(r *http.Request) string {
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return ""
+	}
+	return mediaType
+}
+
+// getObjectPatch parses the generic body of a PATCH request with one of the
+// patch media types
+func getObjectPatch(mediaType string, body interface{}) (uco.ObjectPatch, error) {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return nil, fmt.Errorf("invalid patch: %w", err)
+	}
+	if mediaType == mediaTypeJSONPatch {
+		return uco.ParseJSONPatch(data)
+	}
+	return uco.ParseJSONMergePatch(data)
+}
+
+// getObjectUpdates converts the generic body of a PATCH request with the
+// object to merge into the existing object
+func getObjectUpdates(body interface{}) (*models.Object, error) {
+	switch obj := body.(type) {
+	case nil:
+		return &models.Object{}, nil
+	case *models.Object:
+		if obj == nil {
+			return &models.Object{}, nil
+		}
+		return obj, nil
+	}
+
+	data, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var obj models.Object
+	if err := dec.Decode(&obj); err != nil {
+		return nil, fmt.Errorf("invalid object: %w", err)
+	}
+	if err := obj.Validate(strfmt.Default); err != nil {
+		return nil, err
+	}
+	return &obj, nil
+}
+
 func getClassName(obj *models.Object) string {
 	if obj != nil {
 		return obj.Class
@@ -14,12 +14,17 @@ package rest
 import (
 	"context"
 	stderrors "errors"
+	"io"
+	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
+	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/strfmt"
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/objects"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/schema"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/usecases/auth/authorization/errors"
@@ -30,6 +35,14 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
+func TestGetPrecondition(t *testing.T) {
+	header := func(s string) *string { return &s }
+
+	assert.Nil(t, getPrecondition(nil))
+	assert.Nil(t, getPrecondition(header("*")))
+	assert.Equal(t, &uco.VersionPrecondition{Versions: []int64{12}}, getPrecondition(header(`"12"`)))
+}
+
 func TestEnrichObjectsWithLinks(t *testing.T) {
 	t.Run("add object", func(t *testing.T) {
 		type test struct {
@@ -675,6 +688,77 @@ func TestEnrichObjectsWithLinks(t *testing.T) {
 		}
 	})
 
+	t.Run("PatchObject with patch documents", func(t *testing.T) {
+		tenant := "tenant1"
+		request := func(contentType string, body interface{}) objects.ObjectsClassPatchParams {
+			r := httptest.NewRequest("PATCH", "/v1/objects/MyClass/123", nil)
+			r.Header.Set("Content-Type", contentType)
+			return objects.ObjectsClassPatchParams{
+				HTTPRequest: r,
+				ClassName:   "MyClass",
+				ID:          "123",
+				Body:        body,
+				Tenant:      &tenant,
+			}
+		}
+		newHandler := func(m *fakeManager) *objectHandlers {
+			return &objectHandlers{
+				manager:             m,
+				logger:              &logrus.Logger{},
+				metricRequestsTotal: &fakeMetricRequestsTotal{},
+			}
+		}
+
+		t.Run("json patch", func(t *testing.T) {
+			m := &fakeManager{}
+			res := newHandler(m).patchObject(request("application/json-patch+json", []interface{}{
+				map[string]interface{}{"op": "replace", "path": "/properties/name", "value": "hello"},
+			}), nil)
+			assert.IsType(t, &objects.ObjectsClassPatchNoContent{}, res)
+			require.IsType(t, uco.JSONPatch{}, m.patch)
+			assert.Len(t, m.patch, 1)
+			assert.Equal(t, tenant, m.patchTenant)
+		})
+
+		t.Run("merge patch", func(t *testing.T) {
+			m := &fakeManager{}
+			res := newHandler(m).patchObject(request("application/merge-patch+json; charset=utf-8",
+				map[string]interface{}{"properties": map[string]interface{}{"name": nil}}), nil)
+			assert.IsType(t, &objects.ObjectsClassPatchNoContent{}, res)
+			assert.IsType(t, uco.JSONMergePatch{}, m.patch)
+		})
+
+		t.Run("invalid json patch", func(t *testing.T) {
+			m := &fakeManager{}
+			res := newHandler(m).patchObject(request("application/json-patch+json",
+				map[string]interface{}{"op": "remove", "path": "/properties/name"}), nil)
+			assert.IsType(t, &objects.ObjectsClassPatchBadRequest{}, res)
+			assert.Nil(t, m.patch)
+		})
+
+		t.Run("object", func(t *testing.T) {
+			m := &fakeManager{}
+			res := newHandler(m).patchObject(request("application/json",
+				map[string]interface{}{"properties": map[string]interface{}{"name": "hello"}}), nil)
+			assert.IsType(t, &objects.ObjectsClassPatchNoContent{}, res)
+			require.NotNil(t, m.mergeUpdates)
+			assert.Equal(t, &models.Object{
+				Class:      "MyClass",
+				ID:         "123",
+				Tenant:     tenant,
+				Properties: map[string]interface{}{"name": "hello"},
+			}, m.mergeUpdates)
+		})
+
+		t.Run("invalid object", func(t *testing.T) {
+			m := &fakeManager{}
+			res := newHandler(m).patchObject(request("application/json",
+				map[string]interface{}{"properties": map[string]interface{}{}, "id": "not-a-uuid"}), nil)
+			assert.IsType(t, &objects.ObjectsClassPatchUnprocessableEntity{}, res)
+			assert.Nil(t, m.mergeUpdates)
+		})
+	})
+
 	t.Run("GetObject", func(t *testing.T) {
 		cls := "MyClass"
 		type test struct {
@@ -808,6 +892,76 @@ func TestEnrichObjectsWithLinks(t *testing.T) {
 		}
 	})
 
+	t.Run("conditional writes", func(t *testing.T) {
+		cls := "MyClass"
+		ifMatch := `"6", "7"`
+		expected := &uco.VersionPrecondition{Versions: []int64{6, 7}}
+		newHandler := func(m *fakeManager) *objectHandlers {
+			return &objectHandlers{manager: m, logger: &logrus.Logger{}, metricRequestsTotal: &fakeMetricRequestsTotal{}}
+		}
+
+		t.Run("get returns the version as ETag", func(t *testing.T) {
+			m := &fakeManager{getObjectReturn: &models.Object{Class: cls}, getObjectVersion: 7}
+			res := newHandler(m).getObject(objects.ObjectsClassGetParams{
+				HTTPRequest: httptest.NewRequest("GET", "/v1/objects/MyClass/123", nil),
+				ClassName:   cls,
+				ID:          "123",
+			}, nil)
+			parsed, ok := res.(*objects.ObjectsClassGetOK)
+			require.True(t, ok)
+			assert.Equal(t, `"7"`, parsed.ETag)
+		})
+
+		t.Run("put", func(t *testing.T) {
+			m := &fakeManager{updateObjectErr: uco.NewErrPreconditionFailed("changed")}
+			res := newHandler(m).updateObject(objects.ObjectsClassPutParams{
+				HTTPRequest: httptest.NewRequest("PUT", "/v1/objects/MyClass/123", nil),
+				ClassName:   cls,
+				ID:          "123",
+				Body:        &models.Object{Class: cls, ID: "123"},
+				IfMatch:     &ifMatch,
+			}, nil)
+			assert.IsType(t, &objects.ObjectsClassPutPreconditionFailed{}, res)
+			assert.Equal(t, expected, m.precondition)
+		})
+
+		t.Run("patch", func(t *testing.T) {
+			m := &fakeManager{patchObjectReturn: &uco.Error{Code: uco.StatusPreconditionFailed}}
+			res := newHandler(m).patchObject(objects.ObjectsClassPatchParams{
+				HTTPRequest: httptest.NewRequest("PATCH", "/v1/objects/MyClass/123", nil),
+				ClassName:   cls,
+				ID:          "123",
+				Body:        &models.Object{},
+				IfMatch:     &ifMatch,
+			}, nil)
+			assert.IsType(t, &objects.ObjectsClassPatchPreconditionFailed{}, res)
+			assert.Equal(t, expected, m.precondition)
+		})
+
+		t.Run("delete", func(t *testing.T) {
+			m := &fakeManager{deleteObjectReturn: uco.NewErrPreconditionFailed("changed")}
+			res := newHandler(m).deleteObject(objects.ObjectsClassDeleteParams{
+				HTTPRequest: httptest.NewRequest("DELETE", "/v1/objects/MyClass/123", nil),
+				ClassName:   cls,
+				ID:          "123",
+				IfMatch:     &ifMatch,
+			}, nil)
+			assert.IsType(t, &objects.ObjectsClassDeletePreconditionFailed{}, res)
+			assert.Equal(t, expected, m.precondition)
+		})
+
+		t.Run("unconditional delete", func(t *testing.T) {
+			m := &fakeManager{}
+			res := newHandler(m).deleteObject(objects.ObjectsClassDeleteParams{
+				HTTPRequest: httptest.NewRequest("DELETE", "/v1/objects/MyClass/123", nil),
+				ClassName:   cls,
+				ID:          "123",
+			}, nil)
+			assert.IsType(t, &objects.ObjectsClassDeleteNoContent{}, res)
+			assert.Nil(t, m.precondition)
+		})
+	})
+
 	t.Run("HeadObject", func(t *testing.T) {
 		m := &fakeManager{
 			headObjectReturn: true,
@@ -1037,11 +1191,194 @@ func TestEnrichObjectsWithLinks(t *testing.T) {
 			t.Errorf("expected: %T got: %T", objects.ObjectsListInternalServerError{}, res)
 		}
 	})
+
+	t.Run("Scroll", func(t *testing.T) {
+		var (
+			cls      = "MyClass"
+			scrollID = "my-scroll"
+			h        = &objectHandlers{
+				manager:             &fakeManager{queryResult: []*models.Object{}},
+				logger:              &logrus.Logger{},
+				metricRequestsTotal: &fakeMetricRequestsTotal{},
+			}
+		)
+
+		res := h.getObjects(objects.ObjectsListParams{
+			HTTPRequest: httptest.NewRequest("GET", "/v1/objects", nil),
+			Class:       &cls,
+			ScrollID:    &scrollID,
+		}, nil)
+		if parsed, ok := res.(*objects.ObjectsListOK); !ok {
+			t.Errorf("unexpected result %v", res)
+		} else {
+			assert.Equal(t, scrollID, parsed.Payload.ScrollID)
+		}
+
+		res = h.getObjects(objects.ObjectsListParams{
+			HTTPRequest: httptest.NewRequest("GET", "/v1/objects", nil),
+			ScrollID:    &scrollID,
+		}, nil)
+		if _, ok := res.(*objects.ObjectsListUnprocessableEntity); !ok {
+			t.Errorf("expected: %T got: %T", objects.ObjectsListUnprocessableEntity{}, res)
+		}
+	})
+
+	t.Run("AuditReferences", func(t *testing.T) {
+		m := &fakeManager{auditRefsReturn: &models.ReferenceAudit{ClassName: "MyClass"}}
+		h := &objectHandlers{manager: m, logger: &logrus.Logger{}, metricRequestsTotal: &fakeMetricRequestsTotal{}}
+		req := schema.SchemaObjectsReferencesAuditParams{
+			HTTPRequest: httptest.NewRequest("POST", "/v1/schema/MyClass/references/audit", nil),
+			ClassName:   "MyClass",
+		}
+		res := h.auditReferences(req, nil)
+		if parsed, ok := res.(*schema.SchemaObjectsReferencesAuditOK); !ok {
+			t.Errorf("unexpected result %v", res)
+		} else {
+			assert.Equal(t, m.auditRefsReturn, parsed.Payload)
+		}
+
+		m.auditRefsErr = &uco.Error{Code: uco.StatusForbidden}
+		res = h.auditReferences(req, nil)
+		if _, ok := res.(*schema.SchemaObjectsReferencesAuditForbidden); !ok {
+			t.Errorf("expected: %T got: %T", schema.SchemaObjectsReferencesAuditForbidden{}, res)
+		}
+		m.auditRefsErr = &uco.Error{Code: uco.StatusNotFound}
+		res = h.auditReferences(req, nil)
+		if _, ok := res.(*schema.SchemaObjectsReferencesAuditNotFound); !ok {
+			t.Errorf("expected: %T got: %T", schema.SchemaObjectsReferencesAuditNotFound{}, res)
+		}
+		m.auditRefsErr = &uco.Error{Code: uco.StatusUnprocessableEntity}
+		res = h.auditReferences(req, nil)
+		if _, ok := res.(*schema.SchemaObjectsReferencesAuditUnprocessableEntity); !ok {
+			t.Errorf("expected: %T got: %T", schema.SchemaObjectsReferencesAuditUnprocessableEntity{}, res)
+		}
+		m.auditRefsErr = &uco.Error{Code: uco.StatusInternalServerError}
+		res = h.auditReferences(req, nil)
+		if _, ok := res.(*schema.SchemaObjectsReferencesAuditInternalServerError); !ok {
+			t.Errorf("expected: %T got: %T", schema.SchemaObjectsReferencesAuditInternalServerError{}, res)
+		}
+	})
+
+	t.Run("PutObjectBlob", func(t *testing.T) {
+		m := &fakeManager{}
+		h := &objectHandlers{manager: m, logger: &logrus.Logger{}, metricRequestsTotal: &fakeMetricRequestsTotal{}}
+		req := func() objects.ObjectsClassBlobsPutParams {
+			return objects.ObjectsClassBlobsPutParams{
+				HTTPRequest:  httptest.NewRequest("PUT", "/v1/objects/MyClass/123/blobs/image", nil),
+				ClassName:    "MyClass",
+				PropertyName: "image",
+				Body:         io.NopCloser(strings.NewReader("some blob")),
+			}
+		}
+		res := h.putObjectBlob(req(), nil)
+		if _, ok := res.(*objects.ObjectsClassBlobsPutNoContent); !ok {
+			t.Errorf("unexpected result %v", res)
+		}
+
+		m.putBlobErr = &uco.Error{Code: uco.StatusForbidden}
+		res = h.putObjectBlob(req(), nil)
+		if _, ok := res.(*objects.ObjectsClassBlobsPutForbidden); !ok {
+			t.Errorf("expected: %T got: %T", objects.ObjectsClassBlobsPutForbidden{}, res)
+		}
+		m.putBlobErr = &uco.Error{Code: uco.StatusNotFound}
+		res = h.putObjectBlob(req(), nil)
+		if _, ok := res.(*objects.ObjectsClassBlobsPutNotFound); !ok {
+			t.Errorf("expected: %T got: %T", objects.ObjectsClassBlobsPutNotFound{}, res)
+		}
+		m.putBlobErr = &uco.Error{Code: uco.StatusUnprocessableEntity}
+		res = h.putObjectBlob(req(), nil)
+		if _, ok := res.(*objects.ObjectsClassBlobsPutUnprocessableEntity); !ok {
+			t.Errorf("expected: %T got: %T", objects.ObjectsClassBlobsPutUnprocessableEntity{}, res)
+		}
+		m.putBlobErr = &uco.Error{Code: uco.StatusInternalServerError}
+		res = h.putObjectBlob(req(), nil)
+		if _, ok := res.(*objects.ObjectsClassBlobsPutInternalServerError); !ok {
+			t.Errorf("expected: %T got: %T", objects.ObjectsClassBlobsPutInternalServerError{}, res)
+		}
+	})
+
+	t.Run("GetObjectBlob", func(t *testing.T) {
+		m := &fakeManager{getBlobReturn: "some blob"}
+		h := &objectHandlers{manager: m, logger: &logrus.Logger{}, metricRequestsTotal: &fakeMetricRequestsTotal{}}
+		req := objects.ObjectsClassBlobsGetParams{
+			HTTPRequest:  httptest.NewRequest("GET", "/v1/objects/MyClass/123/blobs/image", nil),
+			ClassName:    "MyClass",
+			PropertyName: "image",
+		}
+		res := h.getObjectBlob(req, nil)
+		rec := httptest.NewRecorder()
+		res.WriteResponse(rec, runtime.ByteStreamProducer())
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "9", rec.Header().Get("Content-Length"))
+		assert.Equal(t, "some blob", rec.Body.String())
+
+		m.getBlobErr = &uco.Error{Code: uco.StatusForbidden}
+		res = h.getObjectBlob(req, nil)
+		if _, ok := res.(*objects.ObjectsClassBlobsGetForbidden); !ok {
+			t.Errorf("expected: %T got: %T", objects.ObjectsClassBlobsGetForbidden{}, res)
+		}
+		m.getBlobErr = &uco.Error{Code: uco.StatusNotFound}
+		res = h.getObjectBlob(req, nil)
+		if _, ok := res.(*objects.ObjectsClassBlobsGetNotFound); !ok {
+			t.Errorf("expected: %T got: %T", objects.ObjectsClassBlobsGetNotFound{}, res)
+		}
+		m.getBlobErr = &uco.Error{Code: uco.StatusUnprocessableEntity}
+		res = h.getObjectBlob(req, nil)
+		if _, ok := res.(*objects.ObjectsClassBlobsGetUnprocessableEntity); !ok {
+			t.Errorf("expected: %T got: %T", objects.ObjectsClassBlobsGetUnprocessableEntity{}, res)
+		}
+		m.getBlobErr = &uco.Error{Code: uco.StatusInternalServerError}
+		res = h.getObjectBlob(req, nil)
+		if _, ok := res.(*objects.ObjectsClassBlobsGetInternalServerError); !ok {
+			t.Errorf("expected: %T got: %T", objects.ObjectsClassBlobsGetInternalServerError{}, res)
+		}
+	})
+
+	t.Run("PutObjectVector", func(t *testing.T) {
+		m := &fakeManager{}
+		h := &objectHandlers{manager: m, logger: &logrus.Logger{}, metricRequestsTotal: &fakeMetricRequestsTotal{}}
+		req := objects.ObjectsClassVectorPutParams{
+			HTTPRequest: httptest.NewRequest("PUT", "/v1/objects/MyClass/123/vector", nil),
+			ClassName:   "MyClass",
+			Body:        &models.ObjectVectorsUpdate{Vector: models.C11yVector{1, 2, 3}},
+		}
+		res := h.putObjectVector(req, nil)
+		if _, ok := res.(*objects.ObjectsClassVectorPutNoContent); !ok {
+			t.Errorf("unexpected result %v", res)
+		}
+
+		m.putVectorErr = &uco.Error{Code: uco.StatusBadRequest}
+		res = h.putObjectVector(req, nil)
+		if _, ok := res.(*objects.ObjectsClassVectorPutBadRequest); !ok {
+			t.Errorf("expected: %T got: %T", objects.ObjectsClassVectorPutBadRequest{}, res)
+		}
+		m.putVectorErr = &uco.Error{Code: uco.StatusForbidden}
+		res = h.putObjectVector(req, nil)
+		if _, ok := res.(*objects.ObjectsClassVectorPutForbidden); !ok {
+			t.Errorf("expected: %T got: %T", objects.ObjectsClassVectorPutForbidden{}, res)
+		}
+		m.putVectorErr = &uco.Error{Code: uco.StatusNotFound}
+		res = h.putObjectVector(req, nil)
+		if _, ok := res.(*objects.ObjectsClassVectorPutNotFound); !ok {
+			t.Errorf("expected: %T got: %T", objects.ObjectsClassVectorPutNotFound{}, res)
+		}
+		m.putVectorErr = &uco.Error{Code: uco.StatusUnprocessableEntity}
+		res = h.putObjectVector(req, nil)
+		if _, ok := res.(*objects.ObjectsClassVectorPutUnprocessableEntity); !ok {
+			t.Errorf("expected: %T got: %T", objects.ObjectsClassVectorPutUnprocessableEntity{}, res)
+		}
+		m.putVectorErr = &uco.Error{Code: uco.StatusInternalServerError}
+		res = h.putObjectVector(req, nil)
+		if _, ok := res.(*objects.ObjectsClassVectorPutInternalServerError); !ok {
+			t.Errorf("expected: %T got: %T", objects.ObjectsClassVectorPutInternalServerError{}, res)
+		}
+	})
 }
 
 type fakeManager struct {
-	getObjectReturn *models.Object
-	getObjectErr    error
+	getObjectReturn  *models.Object
+	getObjectVersion int64
+	getObjectErr     error
 
 	addObjectReturn    *models.Object
 	queryResult        []*models.Object
@@ -1055,6 +1392,17 @@ type fakeManager struct {
 	addRefErr          *uco.Error
 	putRefErr          *uco.Error
 	deleteRefErr       *uco.Error
+	auditRefsReturn    *models.ReferenceAudit
+	auditRefsErr       *uco.Error
+	putBlobErr         *uco.Error
+	getBlobReturn      string
+	getBlobErr         *uco.Error
+	putVectorErr       *uco.Error
+
+	precondition *uco.VersionPrecondition
+	mergeUpdates *models.Object
+	patch        uco.ObjectPatch
+	patchTenant  string
 }
 
 func (f *fakeManager) HeadObject(context.Context, *models.Principal,
@@ -1077,8 +1425,8 @@ func (f *fakeManager) ValidateObject(_ context.Context, _ *models.Principal,
 
 func (f *fakeManager) GetObject(_ context.Context, _ *models.Principal, class string,
 	_ strfmt.UUID, _ additional.Properties, _ *additional.ReplicationProperties, _ string,
-) (*models.Object, error) {
-	return f.getObjectReturn, f.getObjectErr
+) (*models.Object, int64, error) {
+	return f.getObjectReturn, f.getObjectVersion, f.getObjectErr
 }
 
 func (f *fakeManager) GetObjectsClass(ctx context.Context,
@@ -1113,19 +1461,35 @@ func (f *fakeManager) Query(_ context.Context,
 
 func (f *fakeManager) UpdateObject(_ context.Context, _ *models.Principal, _ string,
 	_ strfmt.UUID, updates *models.Object, _ *additional.ReplicationProperties,
+	precondition *uco.VersionPrecondition,
 ) (*models.Object, error) {
+	f.precondition = precondition
 	return updates, f.updateObjectErr
 }
 
 func (f *fakeManager) MergeObject(_ context.Context, _ *models.Principal,
-	_ *models.Object, _ *additional.ReplicationProperties,
+	updates *models.Object, _ *additional.ReplicationProperties, precondition *uco.VersionPrecondition,
 ) *uco.Error {
+	f.mergeUpdates = updates
+	f.precondition = precondition
+	return f.patchObjectReturn
+}
+
+func (f *fakeManager) PatchObject(_ context.Context, _ *models.Principal,
+	_ string, _ strfmt.UUID, patch uco.ObjectPatch, _ *additional.ReplicationProperties,
+	tenant string, precondition *uco.VersionPrecondition,
+) *uco.Error {
+	f.patch = patch
+	f.patchTenant = tenant
+	f.precondition = precondition
 	return f.patchObjectReturn
 }
 
 func (f *fakeManager) DeleteObject(_ context.Context, _ *models.Principal,
 	class string, _ strfmt.UUID, _ *additional.ReplicationProperties, _ string,
+	precondition *uco.VersionPrecondition,
 ) error {
+	f.precondition = precondition
 	return f.deleteObjectReturn
 }
 
@@ -1147,6 +1511,36 @@ func (f *fakeManager) DeleteObjectReference(context.Context, *models.Principal,
 	return f.deleteRefErr
 }
 
+func (f *fakeManager) AuditReferences(context.Context, *models.Principal,
+	string, string, bool,
+) (*models.ReferenceAudit, *uco.Error) {
+	return f.auditRefsReturn, f.auditRefsErr
+}
+
+func (f *fakeManager) PutObjectBlob(_ context.Context, _ *models.Principal,
+	_ string, _ strfmt.UUID, _ string, body io.Reader,
+	_ *additional.ReplicationProperties, _ string,
+) *uco.Error {
+	io.Copy(io.Discard, body)
+	return f.putBlobErr
+}
+
+func (f *fakeManager) GetObjectBlob(context.Context, *models.Principal,
+	string, strfmt.UUID, string, *additional.ReplicationProperties, string,
+) (io.ReadCloser, int64, *uco.Error) {
+	if f.getBlobErr != nil {
+		return nil, 0, f.getBlobErr
+	}
+	return io.NopCloser(strings.NewReader(f.getBlobReturn)), int64(len(f.getBlobReturn)), nil
+}
+
+func (f *fakeManager) UpdateObjectVector(context.Context, *models.Principal,
+	string, strfmt.UUID, []float32, models.Vectors,
+	*additional.ReplicationProperties, string,
+) *uco.Error {
+	return f.putVectorErr
+}
+
 type fakeMetricRequestsTotal struct{}
 
 func (f *fakeMetricRequestsTotal) logError(className string, err error)       {}
@@ -12,6 +12,10 @@
 package rest
 
 import (
+	stderrors "errors"
+	"net/http"
+
+	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
@@ -51,8 +55,14 @@ func (s *schemaHandlers) addClass(params schema.SchemaObjectsCreateParams,
 func (s *schemaHandlers) updateClass(params schema.SchemaObjectsUpdateParams,
 	principal *models.Principal,
 ) middleware.Responder {
-	err := s.manager.UpdateClass(params.HTTPRequest.Context(), principal, params.ClassName,
-		params.ObjectClass)
+	var err error
+	if params.DryRun != nil && *params.DryRun {
+		err = s.manager.ValidateUpdateClass(params.HTTPRequest.Context(), principal,
+			params.ClassName, params.ObjectClass)
+	} else {
+		err = s.manager.UpdateClass(params.HTTPRequest.Context(), principal,
+			params.ClassName, params.ObjectClass)
+	}
 	if err != nil {
 		s.metricRequestsTotal.logError(params.ClassName, err)
 		if err == schemaUC.ErrNotFound {
@@ -135,6 +145,48 @@ func (s *schemaHandlers) addClassProperty(params schema.SchemaObjectsPropertiesA
 	return schema.NewSchemaObjectsPropertiesAddOK().WithPayload(params.Body)
 }
 
+func (s *schemaHandlers) renameClassProperty(params schema.SchemaObjectsPropertiesRenameParams,
+	principal *models.Principal,
+) middleware.Responder {
+	prop, err := s.manager.RenameClassProperty(params.HTTPRequest.Context(), principal,
+		params.ClassName, params.PropertyName, *params.Body.Name)
+	if err != nil {
+		s.metricRequestsTotal.logError(params.ClassName, err)
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewSchemaObjectsPropertiesRenameForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaObjectsPropertiesRenameUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk(params.ClassName)
+	return schema.NewSchemaObjectsPropertiesRenameOK().WithPayload(prop)
+}
+
+func (s *schemaHandlers) updateClassPropertyTokenization(params schema.SchemaObjectsPropertiesTokenizationUpdateParams,
+	principal *models.Principal,
+) middleware.Responder {
+	prop, err := s.manager.UpdateClassPropertyTokenization(params.HTTPRequest.Context(), principal,
+		params.ClassName, params.PropertyName, *params.Body.Tokenization)
+	if err != nil {
+		s.metricRequestsTotal.logError(params.ClassName, err)
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewSchemaObjectsPropertiesTokenizationUpdateForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaObjectsPropertiesTokenizationUpdateUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk(params.ClassName)
+	return schema.NewSchemaObjectsPropertiesTokenizationUpdateOK().WithPayload(prop)
+}
+
 func (s *schemaHandlers) getSchema(params schema.SchemaDumpParams, principal *models.Principal) middleware.Responder {
 	dbSchema, err := s.manager.GetSchema(principal)
 	if err != nil {
@@ -154,6 +206,120 @@ func (s *schemaHandlers) getSchema(params schema.SchemaDumpParams, principal *mo
 	return schema.NewSchemaDumpOK().WithPayload(payload)
 }
 
+func (s *schemaHandlers) getSchemaHistory(params schema.SchemaHistoryParams,
+	principal *models.Principal,
+) middleware.Responder {
+	history, err := s.manager.GetSchemaHistory(params.HTTPRequest.Context(), principal,
+		*params.After, *params.Limit)
+	if err != nil {
+		s.metricRequestsTotal.logError("", err)
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewSchemaHistoryForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaHistoryInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk("")
+	return schema.NewSchemaHistoryOK().WithPayload(history)
+}
+
+func (s *schemaHandlers) getSchemaAtVersion(params schema.SchemaHistoryGetParams,
+	principal *models.Principal,
+) middleware.Responder {
+	versioned, err := s.manager.GetSchemaAtVersion(params.HTTPRequest.Context(), principal,
+		params.Version)
+	if err != nil {
+		if err == schemaUC.ErrNotFound {
+			s.metricRequestsTotal.logUserError("")
+			return schema.NewSchemaHistoryGetNotFound()
+		}
+		s.metricRequestsTotal.logError("", err)
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewSchemaHistoryGetForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaHistoryGetInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk("")
+	return schema.NewSchemaHistoryGetOK().WithPayload(versioned)
+}
+
+func (s *schemaHandlers) diffSchema(params schema.SchemaDiffParams, principal *models.Principal) middleware.Responder {
+	diff, err := s.manager.DiffSchema(params.HTTPRequest.Context(), principal, params.TargetSchema)
+	if err != nil {
+		s.metricRequestsTotal.logError("", err)
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewSchemaDiffForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaDiffUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk("")
+	return schema.NewSchemaDiffOK().WithPayload(diff)
+}
+
+func (s *schemaHandlers) exportSchema(params schema.SchemaExportParams,
+	principal *models.Principal,
+) middleware.Responder {
+	doc, err := s.manager.ExportSchema(params.HTTPRequest.Context(), principal)
+	if err != nil {
+		s.metricRequestsTotal.logError("", err)
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewSchemaExportForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaExportInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk("")
+	if params.Format != nil && *params.Format == "yaml" {
+		// the format param takes precedence over the accept header
+		return middleware.ResponderFunc(func(rw http.ResponseWriter, _ runtime.Producer) {
+			rw.Header().Set(runtime.HeaderContentType, "application/yaml")
+			rw.WriteHeader(http.StatusOK)
+			if err := yamlProducer().Produce(rw, doc); err != nil {
+				panic(err) // let the recovery middleware deal with this
+			}
+		})
+	}
+	return schema.NewSchemaExportOK().WithPayload(doc)
+}
+
+func (s *schemaHandlers) importSchema(params schema.SchemaImportParams,
+	principal *models.Principal,
+) middleware.Responder {
+	res, err := s.manager.ImportSchema(params.HTTPRequest.Context(), principal, params.Body)
+	if err != nil {
+		s.metricRequestsTotal.logError("", err)
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewSchemaImportForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaImportUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk("")
+	return schema.NewSchemaImportOK().WithPayload(res)
+}
+
 func (s *schemaHandlers) getClusterStatus(params schema.SchemaClusterStatusParams, principal *models.Principal) middleware.Responder {
 	status, err := s.manager.ClusterStatus(params.HTTPRequest.Context())
 	if err == nil {
@@ -187,6 +353,169 @@ func (s *schemaHandlers) getShardsStatus(params schema.SchemaObjectsShardsGetPar
 	return schema.NewSchemaObjectsShardsGetOK().WithPayload(payload)
 }
 
+func (s *schemaHandlers) getPropertyMigrations(params schema.SchemaObjectsMigrationsGetParams,
+	principal *models.Principal,
+) middleware.Responder {
+	migrations, err := s.manager.GetPropertyMigrations(params.HTTPRequest.Context(), principal, params.ClassName)
+	if err != nil {
+		s.metricRequestsTotal.logError(params.ClassName, err)
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewSchemaObjectsMigrationsGetForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaObjectsMigrationsGetNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk(params.ClassName)
+	return schema.NewSchemaObjectsMigrationsGetOK().WithPayload(migrations)
+}
+
+func (s *schemaHandlers) checkVectorIndexIntegrity(params schema.SchemaObjectsVectorIndexIntegrityParams,
+	principal *models.Principal,
+) middleware.Responder {
+	repair := params.Repair != nil && *params.Repair
+	report, err := s.manager.CheckVectorIndexIntegrity(params.HTTPRequest.Context(), principal,
+		params.ClassName, repair)
+	if err != nil {
+		if err == schemaUC.ErrNotFound {
+			s.metricRequestsTotal.logUserError(params.ClassName)
+			return schema.NewSchemaObjectsVectorIndexIntegrityNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+		s.metricRequestsTotal.logError(params.ClassName, err)
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewSchemaObjectsVectorIndexIntegrityForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaObjectsVectorIndexIntegrityInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk(params.ClassName)
+	return schema.NewSchemaObjectsVectorIndexIntegrityOK().WithPayload(report)
+}
+
+func (s *schemaHandlers) measureVectorIndexRecall(params schema.SchemaObjectsVectorIndexRecallParams,
+	principal *models.Principal,
+) middleware.Responder {
+	sampleSize, k := 100, 10
+	if params.SampleSize != nil {
+		sampleSize = int(*params.SampleSize)
+	}
+	if params.K != nil {
+		k = int(*params.K)
+	}
+	report, err := s.manager.MeasureVectorIndexRecall(params.HTTPRequest.Context(), principal,
+		params.ClassName, sampleSize, k)
+	if err != nil {
+		switch err {
+		case schemaUC.ErrNotFound:
+			s.metricRequestsTotal.logUserError(params.ClassName)
+			return schema.NewSchemaObjectsVectorIndexRecallNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		case schemaUC.ErrInvalidRecallParams:
+			s.metricRequestsTotal.logUserError(params.ClassName)
+			return schema.NewSchemaObjectsVectorIndexRecallUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+		s.metricRequestsTotal.logError(params.ClassName, err)
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewSchemaObjectsVectorIndexRecallForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaObjectsVectorIndexRecallInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk(params.ClassName)
+	return schema.NewSchemaObjectsVectorIndexRecallOK().WithPayload(report)
+}
+
+func (s *schemaHandlers) getVectorIndexTombstoneCleanup(params schema.SchemaObjectsVectorIndexTombstoneCleanupParams,
+	principal *models.Principal,
+) middleware.Responder {
+	report, err := s.manager.VectorIndexTombstoneCleanup(params.HTTPRequest.Context(), principal,
+		params.ClassName)
+	if err != nil {
+		if err == schemaUC.ErrNotFound {
+			s.metricRequestsTotal.logUserError(params.ClassName)
+			return schema.NewSchemaObjectsVectorIndexTombstoneCleanupNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+		s.metricRequestsTotal.logError(params.ClassName, err)
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewSchemaObjectsVectorIndexTombstoneCleanupForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaObjectsVectorIndexTombstoneCleanupInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk(params.ClassName)
+	return schema.NewSchemaObjectsVectorIndexTombstoneCleanupOK().WithPayload(report)
+}
+
+func (s *schemaHandlers) pauseVectorIndexTombstoneCleanup(params schema.SchemaObjectsVectorIndexTombstoneCleanupPauseParams,
+	principal *models.Principal,
+) middleware.Responder {
+	report, err := s.manager.PauseVectorIndexTombstoneCleanup(params.HTTPRequest.Context(), principal,
+		params.ClassName)
+	if err != nil {
+		if err == schemaUC.ErrNotFound {
+			s.metricRequestsTotal.logUserError(params.ClassName)
+			return schema.NewSchemaObjectsVectorIndexTombstoneCleanupPauseNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+		s.metricRequestsTotal.logError(params.ClassName, err)
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewSchemaObjectsVectorIndexTombstoneCleanupPauseForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaObjectsVectorIndexTombstoneCleanupPauseInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk(params.ClassName)
+	return schema.NewSchemaObjectsVectorIndexTombstoneCleanupPauseOK().WithPayload(report)
+}
+
+func (s *schemaHandlers) resumeVectorIndexTombstoneCleanup(params schema.SchemaObjectsVectorIndexTombstoneCleanupResumeParams,
+	principal *models.Principal,
+) middleware.Responder {
+	report, err := s.manager.ResumeVectorIndexTombstoneCleanup(params.HTTPRequest.Context(), principal,
+		params.ClassName)
+	if err != nil {
+		if err == schemaUC.ErrNotFound {
+			s.metricRequestsTotal.logUserError(params.ClassName)
+			return schema.NewSchemaObjectsVectorIndexTombstoneCleanupResumeNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+		s.metricRequestsTotal.logError(params.ClassName, err)
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewSchemaObjectsVectorIndexTombstoneCleanupResumeForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaObjectsVectorIndexTombstoneCleanupResumeInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk(params.ClassName)
+	return schema.NewSchemaObjectsVectorIndexTombstoneCleanupResumeOK().WithPayload(report)
+}
+
 func (s *schemaHandlers) updateShardStatus(params schema.SchemaObjectsShardsUpdateParams,
 	principal *models.Principal,
 ) middleware.Responder {
@@ -210,6 +539,198 @@ func (s *schemaHandlers) updateShardStatus(params schema.SchemaObjectsShardsUpda
 	return schema.NewSchemaObjectsShardsUpdateOK().WithPayload(payload)
 }
 
+func (s *schemaHandlers) compactShard(params schema.SchemaObjectsShardsCompactParams,
+	principal *models.Principal,
+) middleware.Responder {
+	compaction, err := s.manager.CompactShard(params.HTTPRequest.Context(), principal,
+		params.ClassName, params.ShardName)
+	if err != nil {
+		if err == schemaUC.ErrNotFound {
+			s.metricRequestsTotal.logUserError(params.ClassName)
+			return schema.NewSchemaObjectsShardsCompactNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+		s.metricRequestsTotal.logError(params.ClassName, err)
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewSchemaObjectsShardsCompactForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaObjectsShardsCompactInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk(params.ClassName)
+	return schema.NewSchemaObjectsShardsCompactOK().WithPayload(compaction)
+}
+
+func (s *schemaHandlers) getStopwordSets(params schema.SchemaStopwordsListParams,
+	principal *models.Principal,
+) middleware.Responder {
+	sets, err := s.manager.StopwordSets(params.HTTPRequest.Context(), principal)
+	if err != nil {
+		s.metricRequestsTotal.logError("", err)
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewSchemaStopwordsListForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaStopwordsListInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk("")
+	return schema.NewSchemaStopwordsListOK().WithPayload(sets)
+}
+
+func (s *schemaHandlers) putStopwordSet(params schema.SchemaStopwordsPutParams,
+	principal *models.Principal,
+) middleware.Responder {
+	set, err := s.manager.PutStopwordSet(params.HTTPRequest.Context(), principal,
+		params.SetName, params.Body)
+	if err != nil {
+		s.metricRequestsTotal.logError("", err)
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewSchemaStopwordsPutForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaStopwordsPutUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk("")
+	return schema.NewSchemaStopwordsPutOK().WithPayload(set)
+}
+
+func (s *schemaHandlers) deleteStopwordSet(params schema.SchemaStopwordsDeleteParams,
+	principal *models.Principal,
+) middleware.Responder {
+	err := s.manager.DeleteStopwordSet(params.HTTPRequest.Context(), principal, params.SetName)
+	if err != nil {
+		if stderrors.Is(err, schemaUC.ErrStopwordSetInUse) {
+			s.metricRequestsTotal.logUserError("")
+			return schema.NewSchemaStopwordsDeleteUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+		s.metricRequestsTotal.logError("", err)
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewSchemaStopwordsDeleteForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaStopwordsDeleteInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk("")
+	return schema.NewSchemaStopwordsDeleteOK()
+}
+
+func (s *schemaHandlers) buildVectorIndex(params schema.SchemaObjectsShardsVectorIndexBuildParams,
+	principal *models.Principal,
+) middleware.Responder {
+	var targetVector string
+	if params.TargetVector != nil {
+		targetVector = *params.TargetVector
+	}
+	build, err := s.manager.BuildVectorIndex(params.HTTPRequest.Context(), principal,
+		params.ClassName, params.ShardName, targetVector)
+	if err != nil {
+		if err == schemaUC.ErrNotFound {
+			s.metricRequestsTotal.logUserError(params.ClassName)
+			return schema.NewSchemaObjectsShardsVectorIndexBuildNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+		s.metricRequestsTotal.logError(params.ClassName, err)
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewSchemaObjectsShardsVectorIndexBuildForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaObjectsShardsVectorIndexBuildInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk(params.ClassName)
+	return schema.NewSchemaObjectsShardsVectorIndexBuildOK().WithPayload(build)
+}
+
+func (s *schemaHandlers) exportVectorIndexSnapshot(params schema.SchemaObjectsShardsVectorIndexExportParams,
+	principal *models.Principal,
+) middleware.Responder {
+	var targetVector string
+	if params.TargetVector != nil {
+		targetVector = *params.TargetVector
+	}
+	snapshot, err := s.manager.ExportVectorIndexSnapshot(params.HTTPRequest.Context(), principal,
+		params.ClassName, params.ShardName, targetVector, params.File)
+	if err != nil {
+		switch err {
+		case schemaUC.ErrNotFound:
+			s.metricRequestsTotal.logUserError(params.ClassName)
+			return schema.NewSchemaObjectsShardsVectorIndexExportNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		case schemaUC.ErrSnapshotsDisabled, schemaUC.ErrInvalidSnapshotFile:
+			s.metricRequestsTotal.logUserError(params.ClassName)
+			return schema.NewSchemaObjectsShardsVectorIndexExportUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+		s.metricRequestsTotal.logError(params.ClassName, err)
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewSchemaObjectsShardsVectorIndexExportForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaObjectsShardsVectorIndexExportInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk(params.ClassName)
+	return schema.NewSchemaObjectsShardsVectorIndexExportOK().WithPayload(snapshot)
+}
+
+func (s *schemaHandlers) importVectorIndexSnapshot(params schema.SchemaObjectsShardsVectorIndexImportParams,
+	principal *models.Principal,
+) middleware.Responder {
+	var targetVector string
+	if params.TargetVector != nil {
+		targetVector = *params.TargetVector
+	}
+	snapshot, err := s.manager.ImportVectorIndexSnapshot(params.HTTPRequest.Context(), principal,
+		params.ClassName, params.ShardName, targetVector, params.File)
+	if err != nil {
+		switch err {
+		case schemaUC.ErrNotFound:
+			s.metricRequestsTotal.logUserError(params.ClassName)
+			return schema.NewSchemaObjectsShardsVectorIndexImportNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		case schemaUC.ErrSnapshotsDisabled, schemaUC.ErrInvalidSnapshotFile:
+			s.metricRequestsTotal.logUserError(params.ClassName)
+			return schema.NewSchemaObjectsShardsVectorIndexImportUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+		s.metricRequestsTotal.logError(params.ClassName, err)
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewSchemaObjectsShardsVectorIndexImportForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaObjectsShardsVectorIndexImportInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk(params.ClassName)
+	return schema.NewSchemaObjectsShardsVectorIndexImportOK().WithPayload(snapshot)
+}
+
 func (s *schemaHandlers) createTenants(params schema.TenantsCreateParams,
 	principal *models.Principal,
 ) middleware.Responder {
@@ -295,6 +816,55 @@ func (s *schemaHandlers) getTenants(params schema.TenantsGetParams,
 	return schema.NewTenantsGetOK().WithPayload(tenants)
 }
 
+func (s *schemaHandlers) getSynonyms(params schema.SchemaObjectsSynonymsGetParams,
+	principal *models.Principal,
+) middleware.Responder {
+	rules, err := s.manager.Synonyms(params.HTTPRequest.Context(), principal, params.ClassName)
+	if err != nil {
+		s.metricRequestsTotal.logError(params.ClassName, err)
+		if stderrors.Is(err, schemaUC.ErrNotFound) {
+			return schema.NewSchemaObjectsSynonymsGetNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewSchemaObjectsSynonymsGetForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaObjectsSynonymsGetInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk(params.ClassName)
+	return schema.NewSchemaObjectsSynonymsGetOK().WithPayload(rules)
+}
+
+func (s *schemaHandlers) updateSynonyms(params schema.SchemaObjectsSynonymsUpdateParams,
+	principal *models.Principal,
+) middleware.Responder {
+	rules, err := s.manager.UpdateSynonyms(params.HTTPRequest.Context(), principal,
+		params.ClassName, params.Body)
+	if err != nil {
+		s.metricRequestsTotal.logError(params.ClassName, err)
+		if stderrors.Is(err, schemaUC.ErrNotFound) {
+			return schema.NewSchemaObjectsSynonymsUpdateNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewSchemaObjectsSynonymsUpdateForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaObjectsSynonymsUpdateUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk(params.ClassName)
+	return schema.NewSchemaObjectsSynonymsUpdateOK().WithPayload(rules)
+}
+
 func setupSchemaHandlers(api *operations.WeaviateAPI, manager *schemaUC.Manager, metrics *monitoring.PrometheusMetrics, logger logrus.FieldLogger) {
 	h := &schemaHandlers{manager, newSchemaRequestsTotal(metrics, logger)}
 
@@ -304,6 +874,10 @@ func setupSchemaHandlers(api *operations.WeaviateAPI, manager *schemaUC.Manager,
 		SchemaObjectsDeleteHandlerFunc(h.deleteClass)
 	api.SchemaSchemaObjectsPropertiesAddHandler = schema.
 		SchemaObjectsPropertiesAddHandlerFunc(h.addClassProperty)
+	api.SchemaSchemaObjectsPropertiesRenameHandler = schema.
+		SchemaObjectsPropertiesRenameHandlerFunc(h.renameClassProperty)
+	api.SchemaSchemaObjectsPropertiesTokenizationUpdateHandler = schema.
+		SchemaObjectsPropertiesTokenizationUpdateHandlerFunc(h.updateClassPropertyTokenization)
 
 	api.SchemaSchemaObjectsUpdateHandler = schema.
 		SchemaObjectsUpdateHandlerFunc(h.updateClass)
@@ -314,11 +888,52 @@ func setupSchemaHandlers(api *operations.WeaviateAPI, manager *schemaUC.Manager,
 		SchemaDumpHandlerFunc(h.getSchema)
 	api.SchemaSchemaClusterStatusHandler = schema.
 		SchemaClusterStatusHandlerFunc(h.getClusterStatus)
+	api.SchemaSchemaDiffHandler = schema.
+		SchemaDiffHandlerFunc(h.diffSchema)
+	api.SchemaSchemaHistoryHandler = schema.
+		SchemaHistoryHandlerFunc(h.getSchemaHistory)
+	api.SchemaSchemaHistoryGetHandler = schema.
+		SchemaHistoryGetHandlerFunc(h.getSchemaAtVersion)
+	api.SchemaSchemaExportHandler = schema.
+		SchemaExportHandlerFunc(h.exportSchema)
+	api.SchemaSchemaImportHandler = schema.
+		SchemaImportHandlerFunc(h.importSchema)
 
 	api.SchemaSchemaObjectsShardsGetHandler = schema.
 		SchemaObjectsShardsGetHandlerFunc(h.getShardsStatus)
 	api.SchemaSchemaObjectsShardsUpdateHandler = schema.
 		SchemaObjectsShardsUpdateHandlerFunc(h.updateShardStatus)
+	api.SchemaSchemaObjectsSynonymsGetHandler = schema.
+		SchemaObjectsSynonymsGetHandlerFunc(h.getSynonyms)
+	api.SchemaSchemaObjectsSynonymsUpdateHandler = schema.
+		SchemaObjectsSynonymsUpdateHandlerFunc(h.updateSynonyms)
+	api.SchemaSchemaObjectsShardsCompactHandler = schema.
+		SchemaObjectsShardsCompactHandlerFunc(h.compactShard)
+	api.SchemaSchemaObjectsShardsVectorIndexBuildHandler = schema.
+		SchemaObjectsShardsVectorIndexBuildHandlerFunc(h.buildVectorIndex)
+	api.SchemaSchemaObjectsShardsVectorIndexExportHandler = schema.
+		SchemaObjectsShardsVectorIndexExportHandlerFunc(h.exportVectorIndexSnapshot)
+	api.SchemaSchemaObjectsShardsVectorIndexImportHandler = schema.
+		SchemaObjectsShardsVectorIndexImportHandlerFunc(h.importVectorIndexSnapshot)
+	api.SchemaSchemaObjectsMigrationsGetHandler = schema.
+		SchemaObjectsMigrationsGetHandlerFunc(h.getPropertyMigrations)
+	api.SchemaSchemaObjectsVectorIndexIntegrityHandler = schema.
+		SchemaObjectsVectorIndexIntegrityHandlerFunc(h.checkVectorIndexIntegrity)
+	api.SchemaSchemaObjectsVectorIndexRecallHandler = schema.
+		SchemaObjectsVectorIndexRecallHandlerFunc(h.measureVectorIndexRecall)
+	api.SchemaSchemaObjectsVectorIndexTombstoneCleanupHandler = schema.
+		SchemaObjectsVectorIndexTombstoneCleanupHandlerFunc(h.getVectorIndexTombstoneCleanup)
+	api.SchemaSchemaObjectsVectorIndexTombstoneCleanupPauseHandler = schema.
+		SchemaObjectsVectorIndexTombstoneCleanupPauseHandlerFunc(h.pauseVectorIndexTombstoneCleanup)
+	api.SchemaSchemaObjectsVectorIndexTombstoneCleanupResumeHandler = schema.
+		SchemaObjectsVectorIndexTombstoneCleanupResumeHandlerFunc(h.resumeVectorIndexTombstoneCleanup)
+
+	api.SchemaSchemaStopwordsListHandler = schema.
+		SchemaStopwordsListHandlerFunc(h.getStopwordSets)
+	api.SchemaSchemaStopwordsPutHandler = schema.
+		SchemaStopwordsPutHandlerFunc(h.putStopwordSet)
+	api.SchemaSchemaStopwordsDeleteHandler = schema.
+		SchemaStopwordsDeleteHandlerFunc(h.deleteStopwordSet)
 
 	api.SchemaTenantsCreateHandler = schema.TenantsCreateHandlerFunc(h.createTenants)
 	api.SchemaTenantsUpdateHandler = schema.TenantsUpdateHandlerFunc(h.updateTenants)
@@ -13,7 +13,9 @@ package rest
 
 import (
 	"fmt"
+	"math"
 
+	enterrors "github.com/weaviate/weaviate/entities/errors"
 	"github.com/weaviate/weaviate/entities/models"
 )
 
@@ -37,3 +39,9 @@ func errPayloadFromSingleErr(err error) *models.ErrorResponse {
 		Message: fmt.Sprintf("%s", err),
 	}}}
 }
+
+// retryAfterSeconds is the value of the Retry-After header of a rejected
+// request, rounded up to full seconds
+func retryAfterSeconds(err enterrors.ErrOverloaded) int64 {
+	return int64(math.Ceil(err.RetryAfter().Seconds()))
+}
@@ -100,6 +100,13 @@ type BatchObjectsCreateBody struct {
 
 	// objects
 	Objects []*models.Object `json:"objects" yaml:"objects"`
+
+	// Name of a property which is a `uniqueKey` of the classes of the objects in the batch. The objects get a UUID derived from their class and the value of this property, so importing an object with the same value again updates it instead of creating a duplicate. Objects must be sent without an id.
+	UpsertBy string `json:"upsertBy,omitempty" yaml:"upsertBy,omitempty"`
+
+	// Controls which objects of the batch are vectorized by the vectorizer modules of their class. `ifMissing` (default) vectorizes objects which are sent without a vector, `always` vectorizes all objects and ignores the vectors sent with them for vector spaces with a vectorizer, `never` vectorizes no object, objects which already exist keep their stored vectors unless new ones are sent.
+	// Enum: [always ifMissing never]
+	VectorizePolicy string `json:"vectorizePolicy,omitempty" yaml:"vectorizePolicy,omitempty"`
 }
 
 // Validate validates this batch objects create body
@@ -114,6 +121,10 @@ func (o *BatchObjectsCreateBody) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := o.validateVectorizePolicy(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -185,6 +196,51 @@ func (o *BatchObjectsCreateBody) validateObjects(formats strfmt.Registry) error
 	return nil
 }
 
+var batchObjectsCreateBodyTypeVectorizePolicyPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["always","ifMissing","never"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		batchObjectsCreateBodyTypeVectorizePolicyPropEnum = append(batchObjectsCreateBodyTypeVectorizePolicyPropEnum, v)
+	}
+}
+
+const (
+
+	// BatchObjectsCreateBodyVectorizePolicyAlways captures enum value "always"
+	BatchObjectsCreateBodyVectorizePolicyAlways string = "always"
+
+	// BatchObjectsCreateBodyVectorizePolicyIfMissing captures enum value "ifMissing"
+	BatchObjectsCreateBodyVectorizePolicyIfMissing string = "ifMissing"
+
+	// BatchObjectsCreateBodyVectorizePolicyNever captures enum value "never"
+	BatchObjectsCreateBodyVectorizePolicyNever string = "never"
+)
+
+// prop value enum
+func (o *BatchObjectsCreateBody) validateVectorizePolicyEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, batchObjectsCreateBodyTypeVectorizePolicyPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *BatchObjectsCreateBody) validateVectorizePolicy(formats strfmt.Registry) error {
+	if swag.IsZero(o.VectorizePolicy) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := o.validateVectorizePolicyEnum("body"+"."+"vectorizePolicy", "body", o.VectorizePolicy); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // ContextValidate validate this batch objects create body based on the context it is used
 func (o *BatchObjectsCreateBody) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
@@ -20,6 +20,7 @@ import (
 	"net/http"
 
 	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/swag"
 
 	"github.com/weaviate/weaviate/entities/models"
 )
@@ -232,6 +233,118 @@ func (o *BatchObjectsCreateUnprocessableEntity) WriteResponse(rw http.ResponseWr
 	}
 }
 
+// BatchObjectsCreateLockedCode is the HTTP code returned for type BatchObjectsCreateLocked
+const BatchObjectsCreateLockedCode int = 423
+
+/*
+BatchObjectsCreateLocked The class or the node is in read-only mode and rejects writes
+
+swagger:response batchObjectsCreateLocked
+*/
+type BatchObjectsCreateLocked struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewBatchObjectsCreateLocked creates BatchObjectsCreateLocked with default headers values
+func NewBatchObjectsCreateLocked() *BatchObjectsCreateLocked {
+
+	return &BatchObjectsCreateLocked{}
+}
+
+// WithPayload adds the payload to the batch objects create locked response
+func (o *BatchObjectsCreateLocked) WithPayload(payload *models.ErrorResponse) *BatchObjectsCreateLocked {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the batch objects create locked response
+func (o *BatchObjectsCreateLocked) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *BatchObjectsCreateLocked) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(423)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// BatchObjectsCreateTooManyRequestsCode is the HTTP code returned for type BatchObjectsCreateTooManyRequests
+const BatchObjectsCreateTooManyRequestsCode int = 429
+
+/*
+BatchObjectsCreateTooManyRequests Too many heavy operations are running or queued on the node. Retry after the number of seconds in the Retry-After header.
+
+swagger:response batchObjectsCreateTooManyRequests
+*/
+type BatchObjectsCreateTooManyRequests struct {
+	/*Number of seconds after which the request can be retried
+
+	 */
+	RetryAfter int64 `json:"Retry-After"`
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewBatchObjectsCreateTooManyRequests creates BatchObjectsCreateTooManyRequests with default headers values
+func NewBatchObjectsCreateTooManyRequests() *BatchObjectsCreateTooManyRequests {
+
+	return &BatchObjectsCreateTooManyRequests{}
+}
+
+// WithRetryAfter adds the retryAfter to the batch objects create too many requests response
+func (o *BatchObjectsCreateTooManyRequests) WithRetryAfter(retryAfter int64) *BatchObjectsCreateTooManyRequests {
+	o.RetryAfter = retryAfter
+	return o
+}
+
+// SetRetryAfter sets the retryAfter to the batch objects create too many requests response
+func (o *BatchObjectsCreateTooManyRequests) SetRetryAfter(retryAfter int64) {
+	o.RetryAfter = retryAfter
+}
+
+// WithPayload adds the payload to the batch objects create too many requests response
+func (o *BatchObjectsCreateTooManyRequests) WithPayload(payload *models.ErrorResponse) *BatchObjectsCreateTooManyRequests {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the batch objects create too many requests response
+func (o *BatchObjectsCreateTooManyRequests) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *BatchObjectsCreateTooManyRequests) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Retry-After
+
+	retryAfter := swag.FormatInt64(o.RetryAfter)
+	if retryAfter != "" {
+		rw.Header().Set("Retry-After", retryAfter)
+	}
+
+	rw.WriteHeader(429)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // BatchObjectsCreateInternalServerErrorCode is the HTTP code returned for type BatchObjectsCreateInternalServerError
 const BatchObjectsCreateInternalServerErrorCode int = 500
 
@@ -229,6 +229,51 @@ func (o *BatchObjectsDeleteUnprocessableEntity) WriteResponse(rw http.ResponseWr
 	}
 }
 
+// BatchObjectsDeleteLockedCode is the HTTP code returned for type BatchObjectsDeleteLocked
+const BatchObjectsDeleteLockedCode int = 423
+
+/*
+BatchObjectsDeleteLocked The class or the node is in read-only mode and rejects writes
+
+swagger:response batchObjectsDeleteLocked
+*/
+type BatchObjectsDeleteLocked struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewBatchObjectsDeleteLocked creates BatchObjectsDeleteLocked with default headers values
+func NewBatchObjectsDeleteLocked() *BatchObjectsDeleteLocked {
+
+	return &BatchObjectsDeleteLocked{}
+}
+
+// WithPayload adds the payload to the batch objects delete locked response
+func (o *BatchObjectsDeleteLocked) WithPayload(payload *models.ErrorResponse) *BatchObjectsDeleteLocked {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the batch objects delete locked response
+func (o *BatchObjectsDeleteLocked) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *BatchObjectsDeleteLocked) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(423)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // BatchObjectsDeleteInternalServerErrorCode is the HTTP code returned for type BatchObjectsDeleteInternalServerError
 const BatchObjectsDeleteInternalServerErrorCode int = 500
 
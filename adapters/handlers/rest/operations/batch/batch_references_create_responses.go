@@ -232,6 +232,51 @@ func (o *BatchReferencesCreateUnprocessableEntity) WriteResponse(rw http.Respons
 	}
 }
 
+// BatchReferencesCreateLockedCode is the HTTP code returned for type BatchReferencesCreateLocked
+const BatchReferencesCreateLockedCode int = 423
+
+/*
+BatchReferencesCreateLocked The class or the node is in read-only mode and rejects writes
+
+swagger:response batchReferencesCreateLocked
+*/
+type BatchReferencesCreateLocked struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewBatchReferencesCreateLocked creates BatchReferencesCreateLocked with default headers values
+func NewBatchReferencesCreateLocked() *BatchReferencesCreateLocked {
+
+	return &BatchReferencesCreateLocked{}
+}
+
+// WithPayload adds the payload to the batch references create locked response
+func (o *BatchReferencesCreateLocked) WithPayload(payload *models.ErrorResponse) *BatchReferencesCreateLocked {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the batch references create locked response
+func (o *BatchReferencesCreateLocked) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *BatchReferencesCreateLocked) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(423)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // BatchReferencesCreateInternalServerErrorCode is the HTTP code returned for type BatchReferencesCreateInternalServerError
 const BatchReferencesCreateInternalServerErrorCode int = 500
 
@@ -20,6 +20,7 @@ import (
 	"net/http"
 
 	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/swag"
 
 	"github.com/weaviate/weaviate/entities/models"
 )
@@ -184,6 +185,73 @@ func (o *GraphqlPostUnprocessableEntity) WriteResponse(rw http.ResponseWriter, p
 	}
 }
 
+// GraphqlPostTooManyRequestsCode is the HTTP code returned for type GraphqlPostTooManyRequests
+const GraphqlPostTooManyRequestsCode int = 429
+
+/*
+GraphqlPostTooManyRequests Too many heavy operations are running or queued on the node. Retry after the number of seconds in the Retry-After header.
+
+swagger:response graphqlPostTooManyRequests
+*/
+type GraphqlPostTooManyRequests struct {
+	/*Number of seconds after which the request can be retried
+
+	 */
+	RetryAfter int64 `json:"Retry-After"`
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewGraphqlPostTooManyRequests creates GraphqlPostTooManyRequests with default headers values
+func NewGraphqlPostTooManyRequests() *GraphqlPostTooManyRequests {
+
+	return &GraphqlPostTooManyRequests{}
+}
+
+// WithRetryAfter adds the retryAfter to the graphql post too many requests response
+func (o *GraphqlPostTooManyRequests) WithRetryAfter(retryAfter int64) *GraphqlPostTooManyRequests {
+	o.RetryAfter = retryAfter
+	return o
+}
+
+// SetRetryAfter sets the retryAfter to the graphql post too many requests response
+func (o *GraphqlPostTooManyRequests) SetRetryAfter(retryAfter int64) {
+	o.RetryAfter = retryAfter
+}
+
+// WithPayload adds the payload to the graphql post too many requests response
+func (o *GraphqlPostTooManyRequests) WithPayload(payload *models.ErrorResponse) *GraphqlPostTooManyRequests {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the graphql post too many requests response
+func (o *GraphqlPostTooManyRequests) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GraphqlPostTooManyRequests) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Retry-After
+
+	retryAfter := swag.FormatInt64(o.RetryAfter)
+	if retryAfter != "" {
+		rw.Header().Set("Retry-After", retryAfter)
+	}
+
+	rw.WriteHeader(429)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // GraphqlPostInternalServerErrorCode is the HTTP code returned for type GraphqlPostInternalServerError
 const GraphqlPostInternalServerErrorCode int = 500
 
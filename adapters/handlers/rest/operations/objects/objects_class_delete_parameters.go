@@ -57,6 +57,10 @@ type ObjectsClassDeleteParams struct {
 	  In: path
 	*/
 	ID strfmt.UUID
+	/*Only apply the write if the object has not changed since it was read: the ETag of the object as returned by GET /objects/{className}/{id}. Multiple comma-separated ETags are allowed..
+	  In: header
+	*/
+	IfMatch *string
 	/*Specifies the tenant in a request targeting a multi-tenant class
 	  In: query
 	*/
@@ -89,6 +93,10 @@ func (o *ObjectsClassDeleteParams) BindRequest(r *http.Request, route *middlewar
 		res = append(res, err)
 	}
 
+	if err := o.bindIfMatch(r.Header[http.CanonicalHeaderKey("If-Match")], true, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	qTenant, qhkTenant, _ := qs.GetOK("tenant")
 	if err := o.bindTenant(qTenant, qhkTenant, route.Formats); err != nil {
 		res = append(res, err)
@@ -164,6 +172,23 @@ func (o *ObjectsClassDeleteParams) validateID(formats strfmt.Registry) error {
 	return nil
 }
 
+// bindIfMatch binds and validates parameter IfMatch from header.
+func (o *ObjectsClassDeleteParams) bindIfMatch(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.IfMatch = &raw
+
+	return nil
+}
+
 // bindTenant binds and validates parameter Tenant from query.
 func (o *ObjectsClassDeleteParams) bindTenant(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
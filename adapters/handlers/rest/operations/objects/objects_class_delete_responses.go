@@ -189,6 +189,51 @@ func (o *ObjectsClassDeleteNotFound) WriteResponse(rw http.ResponseWriter, produ
 	rw.WriteHeader(404)
 }
 
+// ObjectsClassDeletePreconditionFailedCode is the HTTP code returned for type ObjectsClassDeletePreconditionFailed
+const ObjectsClassDeletePreconditionFailedCode int = 412
+
+/*
+ObjectsClassDeletePreconditionFailed The object has changed since it was read, its current ETag does not match the If-Match header.
+
+swagger:response objectsClassDeletePreconditionFailed
+*/
+type ObjectsClassDeletePreconditionFailed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewObjectsClassDeletePreconditionFailed creates ObjectsClassDeletePreconditionFailed with default headers values
+func NewObjectsClassDeletePreconditionFailed() *ObjectsClassDeletePreconditionFailed {
+
+	return &ObjectsClassDeletePreconditionFailed{}
+}
+
+// WithPayload adds the payload to the objects class delete precondition failed response
+func (o *ObjectsClassDeletePreconditionFailed) WithPayload(payload *models.ErrorResponse) *ObjectsClassDeletePreconditionFailed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the objects class delete precondition failed response
+func (o *ObjectsClassDeletePreconditionFailed) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ObjectsClassDeletePreconditionFailed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(412)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // ObjectsClassDeleteUnprocessableEntityCode is the HTTP code returned for type ObjectsClassDeleteUnprocessableEntity
 const ObjectsClassDeleteUnprocessableEntityCode int = 422
 
@@ -234,6 +279,51 @@ func (o *ObjectsClassDeleteUnprocessableEntity) WriteResponse(rw http.ResponseWr
 	}
 }
 
+// ObjectsClassDeleteLockedCode is the HTTP code returned for type ObjectsClassDeleteLocked
+const ObjectsClassDeleteLockedCode int = 423
+
+/*
+ObjectsClassDeleteLocked The class or the node is in read-only mode and rejects writes
+
+swagger:response objectsClassDeleteLocked
+*/
+type ObjectsClassDeleteLocked struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewObjectsClassDeleteLocked creates ObjectsClassDeleteLocked with default headers values
+func NewObjectsClassDeleteLocked() *ObjectsClassDeleteLocked {
+
+	return &ObjectsClassDeleteLocked{}
+}
+
+// WithPayload adds the payload to the objects class delete locked response
+func (o *ObjectsClassDeleteLocked) WithPayload(payload *models.ErrorResponse) *ObjectsClassDeleteLocked {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the objects class delete locked response
+func (o *ObjectsClassDeleteLocked) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ObjectsClassDeleteLocked) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(423)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // ObjectsClassDeleteInternalServerErrorCode is the HTTP code returned for type ObjectsClassDeleteInternalServerError
 const ObjectsClassDeleteInternalServerErrorCode int = 500
 
@@ -47,7 +47,7 @@ func NewObjectsClassPatch(ctx *middleware.Context, handler ObjectsClassPatchHand
 
 Update an Object based on its UUID (using patch semantics).
 
-Update an individual data object based on its class and uuid. This method supports json-merge style patch semantics (RFC 7396). Provided meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.
+Update an individual data object based on its class and uuid. This method supports json-merge style patch semantics (RFC 7396) as well as JSON Patch (RFC 6902), depending on the content type. With application/json every given property replaces its previous value as a whole, with application/merge-patch+json nested objects are merged recursively, and with application/json-patch+json the body is a list of JSON Patch operations. Patches apply to the JSON representation of the object, for example the JSON Patch path of a property is /properties/{propertyName}, and can only change its properties and vector. Provided meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.
 */
 type ObjectsClassPatch struct {
 	Context *middleware.Context
@@ -24,8 +24,6 @@ import (
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/validate"
-
-	"github.com/weaviate/weaviate/entities/models"
 )
 
 // NewObjectsClassPatchParams creates a new ObjectsClassPatchParams object
@@ -45,10 +43,10 @@ type ObjectsClassPatchParams struct {
 	// HTTP Request Object
 	HTTPRequest *http.Request `json:"-"`
 
-	/*RFC 7396-style patch, the body contains the object to merge into the existing object.
+	/*RFC 7396-style patch, the body contains the object to merge into the existing object. With the content type application/json-patch+json the body is a RFC 6902 JSON Patch instead.
 	  In: body
 	*/
-	Body *models.Object
+	Body interface{}
 	/*The class name as defined in the schema
 	  Required: true
 	  In: path
@@ -63,6 +61,14 @@ type ObjectsClassPatchParams struct {
 	  In: path
 	*/
 	ID strfmt.UUID
+	/*Only apply the write if the object has not changed since it was read: the ETag of the object as returned by GET /objects/{className}/{id}. Multiple comma-separated ETags are allowed..
+	  In: header
+	*/
+	IfMatch *string
+	/*Specifies the tenant in a request targeting a multi-tenant class
+	  In: query
+	*/
+	Tenant *string
 }
 
 // BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
@@ -78,23 +84,12 @@ func (o *ObjectsClassPatchParams) BindRequest(r *http.Request, route *middleware
 
 	if runtime.HasBody(r) {
 		defer r.Body.Close()
-		var body models.Object
+		var body interface{}
 		if err := route.Consumer.Consume(r.Body, &body); err != nil {
 			res = append(res, errors.NewParseError("body", "body", "", err))
 		} else {
-			// validate body object
-			if err := body.Validate(route.Formats); err != nil {
-				res = append(res, err)
-			}
-
-			ctx := validate.WithOperationRequest(r.Context())
-			if err := body.ContextValidate(ctx, route.Formats); err != nil {
-				res = append(res, err)
-			}
-
-			if len(res) == 0 {
-				o.Body = &body
-			}
+			// no validation on generic interface
+			o.Body = body
 		}
 	}
 
@@ -112,6 +107,15 @@ func (o *ObjectsClassPatchParams) BindRequest(r *http.Request, route *middleware
 	if err := o.bindID(rID, rhkID, route.Formats); err != nil {
 		res = append(res, err)
 	}
+
+	if err := o.bindIfMatch(r.Header[http.CanonicalHeaderKey("If-Match")], true, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qTenant, qhkTenant, _ := qs.GetOK("tenant")
+	if err := o.bindTenant(qTenant, qhkTenant, route.Formats); err != nil {
+		res = append(res, err)
+	}
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -182,3 +186,38 @@ func (o *ObjectsClassPatchParams) validateID(formats strfmt.Registry) error {
 	}
 	return nil
 }
+
+// bindIfMatch binds and validates parameter IfMatch from header.
+func (o *ObjectsClassPatchParams) bindIfMatch(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.IfMatch = &raw
+
+	return nil
+}
+
+// bindTenant binds and validates parameter Tenant from query.
+func (o *ObjectsClassPatchParams) bindTenant(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.Tenant = &raw
+
+	return nil
+}
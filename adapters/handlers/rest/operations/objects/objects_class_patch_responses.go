@@ -189,6 +189,51 @@ func (o *ObjectsClassPatchNotFound) WriteResponse(rw http.ResponseWriter, produc
 	rw.WriteHeader(404)
 }
 
+// ObjectsClassPatchPreconditionFailedCode is the HTTP code returned for type ObjectsClassPatchPreconditionFailed
+const ObjectsClassPatchPreconditionFailedCode int = 412
+
+/*
+ObjectsClassPatchPreconditionFailed The object has changed since it was read, its current ETag does not match the If-Match header.
+
+swagger:response objectsClassPatchPreconditionFailed
+*/
+type ObjectsClassPatchPreconditionFailed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewObjectsClassPatchPreconditionFailed creates ObjectsClassPatchPreconditionFailed with default headers values
+func NewObjectsClassPatchPreconditionFailed() *ObjectsClassPatchPreconditionFailed {
+
+	return &ObjectsClassPatchPreconditionFailed{}
+}
+
+// WithPayload adds the payload to the objects class patch precondition failed response
+func (o *ObjectsClassPatchPreconditionFailed) WithPayload(payload *models.ErrorResponse) *ObjectsClassPatchPreconditionFailed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the objects class patch precondition failed response
+func (o *ObjectsClassPatchPreconditionFailed) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ObjectsClassPatchPreconditionFailed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(412)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // ObjectsClassPatchUnprocessableEntityCode is the HTTP code returned for type ObjectsClassPatchUnprocessableEntity
 const ObjectsClassPatchUnprocessableEntityCode int = 422
 
@@ -234,6 +279,51 @@ func (o *ObjectsClassPatchUnprocessableEntity) WriteResponse(rw http.ResponseWri
 	}
 }
 
+// ObjectsClassPatchLockedCode is the HTTP code returned for type ObjectsClassPatchLocked
+const ObjectsClassPatchLockedCode int = 423
+
+/*
+ObjectsClassPatchLocked The class or the node is in read-only mode and rejects writes
+
+swagger:response objectsClassPatchLocked
+*/
+type ObjectsClassPatchLocked struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewObjectsClassPatchLocked creates ObjectsClassPatchLocked with default headers values
+func NewObjectsClassPatchLocked() *ObjectsClassPatchLocked {
+
+	return &ObjectsClassPatchLocked{}
+}
+
+// WithPayload adds the payload to the objects class patch locked response
+func (o *ObjectsClassPatchLocked) WithPayload(payload *models.ErrorResponse) *ObjectsClassPatchLocked {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the objects class patch locked response
+func (o *ObjectsClassPatchLocked) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ObjectsClassPatchLocked) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(423)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // ObjectsClassPatchInternalServerErrorCode is the HTTP code returned for type ObjectsClassPatchInternalServerError
 const ObjectsClassPatchInternalServerErrorCode int = 500
 
@@ -31,6 +31,7 @@ type ObjectsClassPatchURL struct {
 	ID        strfmt.UUID
 
 	ConsistencyLevel *string
+	Tenant           *string
 
 	_basePath string
 	// avoid unkeyed usage
@@ -88,6 +89,14 @@ func (o *ObjectsClassPatchURL) Build() (*url.URL, error) {
 		qs.Set("consistency_level", consistencyLevelQ)
 	}
 
+	var tenantQ string
+	if o.Tenant != nil {
+		tenantQ = *o.Tenant
+	}
+	if tenantQ != "" {
+		qs.Set("tenant", tenantQ)
+	}
+
 	_result.RawQuery = qs.Encode()
 
 	return &_result, nil
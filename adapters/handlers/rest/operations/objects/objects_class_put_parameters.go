@@ -65,6 +65,10 @@ type ObjectsClassPutParams struct {
 	  In: path
 	*/
 	ID strfmt.UUID
+	/*Only apply the write if the object has not changed since it was read: the ETag of the object as returned by GET /objects/{className}/{id}. Multiple comma-separated ETags are allowed..
+	  In: header
+	*/
+	IfMatch *string
 }
 
 // BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
@@ -120,6 +124,10 @@ func (o *ObjectsClassPutParams) BindRequest(r *http.Request, route *middleware.M
 	if err := o.bindID(rID, rhkID, route.Formats); err != nil {
 		res = append(res, err)
 	}
+
+	if err := o.bindIfMatch(r.Header[http.CanonicalHeaderKey("If-Match")], true, route.Formats); err != nil {
+		res = append(res, err)
+	}
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -190,3 +198,20 @@ func (o *ObjectsClassPutParams) validateID(formats strfmt.Registry) error {
 	}
 	return nil
 }
+
+// bindIfMatch binds and validates parameter IfMatch from header.
+func (o *ObjectsClassPutParams) bindIfMatch(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.IfMatch = &raw
+
+	return nil
+}
@@ -164,6 +164,51 @@ func (o *ObjectsClassPutNotFound) WriteResponse(rw http.ResponseWriter, producer
 	rw.WriteHeader(404)
 }
 
+// ObjectsClassPutPreconditionFailedCode is the HTTP code returned for type ObjectsClassPutPreconditionFailed
+const ObjectsClassPutPreconditionFailedCode int = 412
+
+/*
+ObjectsClassPutPreconditionFailed The object has changed since it was read, its current ETag does not match the If-Match header.
+
+swagger:response objectsClassPutPreconditionFailed
+*/
+type ObjectsClassPutPreconditionFailed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewObjectsClassPutPreconditionFailed creates ObjectsClassPutPreconditionFailed with default headers values
+func NewObjectsClassPutPreconditionFailed() *ObjectsClassPutPreconditionFailed {
+
+	return &ObjectsClassPutPreconditionFailed{}
+}
+
+// WithPayload adds the payload to the objects class put precondition failed response
+func (o *ObjectsClassPutPreconditionFailed) WithPayload(payload *models.ErrorResponse) *ObjectsClassPutPreconditionFailed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the objects class put precondition failed response
+func (o *ObjectsClassPutPreconditionFailed) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ObjectsClassPutPreconditionFailed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(412)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // ObjectsClassPutUnprocessableEntityCode is the HTTP code returned for type ObjectsClassPutUnprocessableEntity
 const ObjectsClassPutUnprocessableEntityCode int = 422
 
@@ -209,6 +254,51 @@ func (o *ObjectsClassPutUnprocessableEntity) WriteResponse(rw http.ResponseWrite
 	}
 }
 
+// ObjectsClassPutLockedCode is the HTTP code returned for type ObjectsClassPutLocked
+const ObjectsClassPutLockedCode int = 423
+
+/*
+ObjectsClassPutLocked The class or the node is in read-only mode and rejects writes
+
+swagger:response objectsClassPutLocked
+*/
+type ObjectsClassPutLocked struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewObjectsClassPutLocked creates ObjectsClassPutLocked with default headers values
+func NewObjectsClassPutLocked() *ObjectsClassPutLocked {
+
+	return &ObjectsClassPutLocked{}
+}
+
+// WithPayload adds the payload to the objects class put locked response
+func (o *ObjectsClassPutLocked) WithPayload(payload *models.ErrorResponse) *ObjectsClassPutLocked {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the objects class put locked response
+func (o *ObjectsClassPutLocked) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ObjectsClassPutLocked) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(423)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // ObjectsClassPutInternalServerErrorCode is the HTTP code returned for type ObjectsClassPutInternalServerError
 const ObjectsClassPutInternalServerErrorCode int = 500
 
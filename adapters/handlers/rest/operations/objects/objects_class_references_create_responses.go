@@ -234,6 +234,51 @@ func (o *ObjectsClassReferencesCreateUnprocessableEntity) WriteResponse(rw http.
 	}
 }
 
+// ObjectsClassReferencesCreateLockedCode is the HTTP code returned for type ObjectsClassReferencesCreateLocked
+const ObjectsClassReferencesCreateLockedCode int = 423
+
+/*
+ObjectsClassReferencesCreateLocked The class or the node is in read-only mode and rejects writes
+
+swagger:response objectsClassReferencesCreateLocked
+*/
+type ObjectsClassReferencesCreateLocked struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewObjectsClassReferencesCreateLocked creates ObjectsClassReferencesCreateLocked with default headers values
+func NewObjectsClassReferencesCreateLocked() *ObjectsClassReferencesCreateLocked {
+
+	return &ObjectsClassReferencesCreateLocked{}
+}
+
+// WithPayload adds the payload to the objects class references create locked response
+func (o *ObjectsClassReferencesCreateLocked) WithPayload(payload *models.ErrorResponse) *ObjectsClassReferencesCreateLocked {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the objects class references create locked response
+func (o *ObjectsClassReferencesCreateLocked) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ObjectsClassReferencesCreateLocked) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(423)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // ObjectsClassReferencesCreateInternalServerErrorCode is the HTTP code returned for type ObjectsClassReferencesCreateInternalServerError
 const ObjectsClassReferencesCreateInternalServerErrorCode int = 500
 
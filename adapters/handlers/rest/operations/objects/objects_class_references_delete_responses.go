@@ -254,6 +254,51 @@ func (o *ObjectsClassReferencesDeleteUnprocessableEntity) WriteResponse(rw http.
 	}
 }
 
+// ObjectsClassReferencesDeleteLockedCode is the HTTP code returned for type ObjectsClassReferencesDeleteLocked
+const ObjectsClassReferencesDeleteLockedCode int = 423
+
+/*
+ObjectsClassReferencesDeleteLocked The class or the node is in read-only mode and rejects writes
+
+swagger:response objectsClassReferencesDeleteLocked
+*/
+type ObjectsClassReferencesDeleteLocked struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewObjectsClassReferencesDeleteLocked creates ObjectsClassReferencesDeleteLocked with default headers values
+func NewObjectsClassReferencesDeleteLocked() *ObjectsClassReferencesDeleteLocked {
+
+	return &ObjectsClassReferencesDeleteLocked{}
+}
+
+// WithPayload adds the payload to the objects class references delete locked response
+func (o *ObjectsClassReferencesDeleteLocked) WithPayload(payload *models.ErrorResponse) *ObjectsClassReferencesDeleteLocked {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the objects class references delete locked response
+func (o *ObjectsClassReferencesDeleteLocked) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ObjectsClassReferencesDeleteLocked) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(423)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // ObjectsClassReferencesDeleteInternalServerErrorCode is the HTTP code returned for type ObjectsClassReferencesDeleteInternalServerError
 const ObjectsClassReferencesDeleteInternalServerErrorCode int = 500
 
@@ -234,6 +234,51 @@ func (o *ObjectsClassReferencesPutUnprocessableEntity) WriteResponse(rw http.Res
 	}
 }
 
+// ObjectsClassReferencesPutLockedCode is the HTTP code returned for type ObjectsClassReferencesPutLocked
+const ObjectsClassReferencesPutLockedCode int = 423
+
+/*
+ObjectsClassReferencesPutLocked The class or the node is in read-only mode and rejects writes
+
+swagger:response objectsClassReferencesPutLocked
+*/
+type ObjectsClassReferencesPutLocked struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewObjectsClassReferencesPutLocked creates ObjectsClassReferencesPutLocked with default headers values
+func NewObjectsClassReferencesPutLocked() *ObjectsClassReferencesPutLocked {
+
+	return &ObjectsClassReferencesPutLocked{}
+}
+
+// WithPayload adds the payload to the objects class references put locked response
+func (o *ObjectsClassReferencesPutLocked) WithPayload(payload *models.ErrorResponse) *ObjectsClassReferencesPutLocked {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the objects class references put locked response
+func (o *ObjectsClassReferencesPutLocked) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ObjectsClassReferencesPutLocked) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(423)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // ObjectsClassReferencesPutInternalServerErrorCode is the HTTP code returned for type ObjectsClassReferencesPutInternalServerError
 const ObjectsClassReferencesPutInternalServerErrorCode int = 500
 
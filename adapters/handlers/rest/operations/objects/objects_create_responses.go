@@ -229,6 +229,51 @@ func (o *ObjectsCreateUnprocessableEntity) WriteResponse(rw http.ResponseWriter,
 	}
 }
 
+// ObjectsCreateLockedCode is the HTTP code returned for type ObjectsCreateLocked
+const ObjectsCreateLockedCode int = 423
+
+/*
+ObjectsCreateLocked The class or the node is in read-only mode and rejects writes
+
+swagger:response objectsCreateLocked
+*/
+type ObjectsCreateLocked struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewObjectsCreateLocked creates ObjectsCreateLocked with default headers values
+func NewObjectsCreateLocked() *ObjectsCreateLocked {
+
+	return &ObjectsCreateLocked{}
+}
+
+// WithPayload adds the payload to the objects create locked response
+func (o *ObjectsCreateLocked) WithPayload(payload *models.ErrorResponse) *ObjectsCreateLocked {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the objects create locked response
+func (o *ObjectsCreateLocked) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ObjectsCreateLocked) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(423)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // ObjectsCreateInternalServerErrorCode is the HTTP code returned for type ObjectsCreateInternalServerError
 const ObjectsCreateInternalServerErrorCode int = 500
 
@@ -52,6 +52,10 @@ type ObjectsDeleteParams struct {
 	  In: path
 	*/
 	ID strfmt.UUID
+	/*Only apply the write if the object has not changed since it was read: the ETag of the object as returned by GET /objects/{className}/{id}. Multiple comma-separated ETags are allowed..
+	  In: header
+	*/
+	IfMatch *string
 	/*Specifies the tenant in a request targeting a multi-tenant class
 	  In: query
 	*/
@@ -79,6 +83,10 @@ func (o *ObjectsDeleteParams) BindRequest(r *http.Request, route *middleware.Mat
 		res = append(res, err)
 	}
 
+	if err := o.bindIfMatch(r.Header[http.CanonicalHeaderKey("If-Match")], true, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	qTenant, qhkTenant, _ := qs.GetOK("tenant")
 	if err := o.bindTenant(qTenant, qhkTenant, route.Formats); err != nil {
 		res = append(res, err)
@@ -140,6 +148,23 @@ func (o *ObjectsDeleteParams) validateID(formats strfmt.Registry) error {
 	return nil
 }
 
+// bindIfMatch binds and validates parameter IfMatch from header.
+func (o *ObjectsDeleteParams) bindIfMatch(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.IfMatch = &raw
+
+	return nil
+}
+
 // bindTenant binds and validates parameter Tenant from query.
 func (o *ObjectsDeleteParams) bindTenant(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
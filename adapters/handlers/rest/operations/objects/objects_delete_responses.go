@@ -144,6 +144,96 @@ func (o *ObjectsDeleteNotFound) WriteResponse(rw http.ResponseWriter, producer r
 	rw.WriteHeader(404)
 }
 
+// ObjectsDeletePreconditionFailedCode is the HTTP code returned for type ObjectsDeletePreconditionFailed
+const ObjectsDeletePreconditionFailedCode int = 412
+
+/*
+ObjectsDeletePreconditionFailed The object has changed since it was read, its current ETag does not match the If-Match header.
+
+swagger:response objectsDeletePreconditionFailed
+*/
+type ObjectsDeletePreconditionFailed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewObjectsDeletePreconditionFailed creates ObjectsDeletePreconditionFailed with default headers values
+func NewObjectsDeletePreconditionFailed() *ObjectsDeletePreconditionFailed {
+
+	return &ObjectsDeletePreconditionFailed{}
+}
+
+// WithPayload adds the payload to the objects delete precondition failed response
+func (o *ObjectsDeletePreconditionFailed) WithPayload(payload *models.ErrorResponse) *ObjectsDeletePreconditionFailed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the objects delete precondition failed response
+func (o *ObjectsDeletePreconditionFailed) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ObjectsDeletePreconditionFailed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(412)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ObjectsDeleteLockedCode is the HTTP code returned for type ObjectsDeleteLocked
+const ObjectsDeleteLockedCode int = 423
+
+/*
+ObjectsDeleteLocked The class or the node is in read-only mode and rejects writes
+
+swagger:response objectsDeleteLocked
+*/
+type ObjectsDeleteLocked struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewObjectsDeleteLocked creates ObjectsDeleteLocked with default headers values
+func NewObjectsDeleteLocked() *ObjectsDeleteLocked {
+
+	return &ObjectsDeleteLocked{}
+}
+
+// WithPayload adds the payload to the objects delete locked response
+func (o *ObjectsDeleteLocked) WithPayload(payload *models.ErrorResponse) *ObjectsDeleteLocked {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the objects delete locked response
+func (o *ObjectsDeleteLocked) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ObjectsDeleteLocked) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(423)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // ObjectsDeleteInternalServerErrorCode is the HTTP code returned for type ObjectsDeleteInternalServerError
 const ObjectsDeleteInternalServerErrorCode int = 500
 
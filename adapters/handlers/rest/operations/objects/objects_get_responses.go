@@ -33,6 +33,10 @@ ObjectsGetOK Successful response.
 swagger:response objectsGetOK
 */
 type ObjectsGetOK struct {
+	/*Version of the object, can be sent in the If-Match header of a write to only apply the write if the object has not changed since
+
+	 */
+	ETag string `json:"ETag"`
 
 	/*
 	  In: Body
@@ -46,6 +50,17 @@ func NewObjectsGetOK() *ObjectsGetOK {
 	return &ObjectsGetOK{}
 }
 
+// WithETag adds the eTag to the objects get o k response
+func (o *ObjectsGetOK) WithETag(eTag string) *ObjectsGetOK {
+	o.ETag = eTag
+	return o
+}
+
+// SetETag sets the eTag to the objects get o k response
+func (o *ObjectsGetOK) SetETag(eTag string) {
+	o.ETag = eTag
+}
+
 // WithPayload adds the payload to the objects get o k response
 func (o *ObjectsGetOK) WithPayload(payload *models.Object) *ObjectsGetOK {
 	o.Payload = payload
@@ -60,6 +75,13 @@ func (o *ObjectsGetOK) SetPayload(payload *models.Object) {
 // WriteResponse to the client
 func (o *ObjectsGetOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
+	// response header ETag
+
+	eTag := o.ETag
+	if eTag != "" {
+		rw.Header().Set("ETag", eTag)
+	}
+
 	rw.WriteHeader(200)
 	if o.Payload != nil {
 		payload := o.Payload
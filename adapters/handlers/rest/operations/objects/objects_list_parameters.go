@@ -75,6 +75,14 @@ type ObjectsListParams struct {
 	  In: query
 	*/
 	Order *string
+	/*Starts or keeps alive a scroll session for the given duration, e.g. '5m'. All pages of a scroll session are read from the same snapshot of the class, which is not affected by concurrent writes. Requires the class and limit parameters.
+	  In: query
+	*/
+	Scroll *string
+	/*The ID of the scroll session to continue, as returned with the first page.
+	  In: query
+	*/
+	ScrollID *string
 	/*Sort parameter to pass an information about the names of the sort fields
 	  In: query
 	*/
@@ -126,6 +134,16 @@ func (o *ObjectsListParams) BindRequest(r *http.Request, route *middleware.Match
 		res = append(res, err)
 	}
 
+	qScroll, qhkScroll, _ := qs.GetOK("scroll")
+	if err := o.bindScroll(qScroll, qhkScroll, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qScrollID, qhkScrollID, _ := qs.GetOK("scrollId")
+	if err := o.bindScrollID(qScrollID, qhkScrollID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	qSort, qhkSort, _ := qs.GetOK("sort")
 	if err := o.bindSort(qSort, qhkSort, route.Formats); err != nil {
 		res = append(res, err)
@@ -260,6 +278,42 @@ func (o *ObjectsListParams) bindOrder(rawData []string, hasKey bool, formats str
 	return nil
 }
 
+// bindScroll binds and validates parameter Scroll from query.
+func (o *ObjectsListParams) bindScroll(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.Scroll = &raw
+
+	return nil
+}
+
+// bindScrollID binds and validates parameter ScrollID from query.
+func (o *ObjectsListParams) bindScrollID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.ScrollID = &raw
+
+	return nil
+}
+
 // bindSort binds and validates parameter Sort from query.
 func (o *ObjectsListParams) bindSort(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
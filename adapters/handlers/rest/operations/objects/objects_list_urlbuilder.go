@@ -26,14 +26,16 @@ import (
 
 // ObjectsListURL generates an URL for the objects list operation
 type ObjectsListURL struct {
-	After   *string
-	Class   *string
-	Include *string
-	Limit   *int64
-	Offset  *int64
-	Order   *string
-	Sort    *string
-	Tenant  *string
+	After    *string
+	Class    *string
+	Include  *string
+	Limit    *int64
+	Offset   *int64
+	Order    *string
+	Scroll   *string
+	ScrollID *string
+	Sort     *string
+	Tenant   *string
 
 	_basePath string
 	// avoid unkeyed usage
@@ -117,6 +119,22 @@ func (o *ObjectsListURL) Build() (*url.URL, error) {
 		qs.Set("order", orderQ)
 	}
 
+	var scrollQ string
+	if o.Scroll != nil {
+		scrollQ = *o.Scroll
+	}
+	if scrollQ != "" {
+		qs.Set("scroll", scrollQ)
+	}
+
+	var scrollIDQ string
+	if o.ScrollID != nil {
+		scrollIDQ = *o.ScrollID
+	}
+	if scrollIDQ != "" {
+		qs.Set("scrollId", scrollIDQ)
+	}
+
 	var sortQ string
 	if o.Sort != nil {
 		sortQ = *o.Sort
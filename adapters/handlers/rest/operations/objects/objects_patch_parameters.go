@@ -58,6 +58,10 @@ type ObjectsPatchParams struct {
 	  In: path
 	*/
 	ID strfmt.UUID
+	/*Only apply the write if the object has not changed since it was read: the ETag of the object as returned by GET /objects/{className}/{id}. Multiple comma-separated ETags are allowed..
+	  In: header
+	*/
+	IfMatch *string
 }
 
 // BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
@@ -102,6 +106,10 @@ func (o *ObjectsPatchParams) BindRequest(r *http.Request, route *middleware.Matc
 	if err := o.bindID(rID, rhkID, route.Formats); err != nil {
 		res = append(res, err)
 	}
+
+	if err := o.bindIfMatch(r.Header[http.CanonicalHeaderKey("If-Match")], true, route.Formats); err != nil {
+		res = append(res, err)
+	}
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -158,3 +166,20 @@ func (o *ObjectsPatchParams) validateID(formats strfmt.Registry) error {
 	}
 	return nil
 }
+
+// bindIfMatch binds and validates parameter IfMatch from header.
+func (o *ObjectsPatchParams) bindIfMatch(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.IfMatch = &raw
+
+	return nil
+}
@@ -169,6 +169,51 @@ func (o *ObjectsPatchNotFound) WriteResponse(rw http.ResponseWriter, producer ru
 	rw.WriteHeader(404)
 }
 
+// ObjectsPatchPreconditionFailedCode is the HTTP code returned for type ObjectsPatchPreconditionFailed
+const ObjectsPatchPreconditionFailedCode int = 412
+
+/*
+ObjectsPatchPreconditionFailed The object has changed since it was read, its current ETag does not match the If-Match header.
+
+swagger:response objectsPatchPreconditionFailed
+*/
+type ObjectsPatchPreconditionFailed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewObjectsPatchPreconditionFailed creates ObjectsPatchPreconditionFailed with default headers values
+func NewObjectsPatchPreconditionFailed() *ObjectsPatchPreconditionFailed {
+
+	return &ObjectsPatchPreconditionFailed{}
+}
+
+// WithPayload adds the payload to the objects patch precondition failed response
+func (o *ObjectsPatchPreconditionFailed) WithPayload(payload *models.ErrorResponse) *ObjectsPatchPreconditionFailed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the objects patch precondition failed response
+func (o *ObjectsPatchPreconditionFailed) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ObjectsPatchPreconditionFailed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(412)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // ObjectsPatchUnprocessableEntityCode is the HTTP code returned for type ObjectsPatchUnprocessableEntity
 const ObjectsPatchUnprocessableEntityCode int = 422
 
@@ -214,6 +259,51 @@ func (o *ObjectsPatchUnprocessableEntity) WriteResponse(rw http.ResponseWriter,
 	}
 }
 
+// ObjectsPatchLockedCode is the HTTP code returned for type ObjectsPatchLocked
+const ObjectsPatchLockedCode int = 423
+
+/*
+ObjectsPatchLocked The class or the node is in read-only mode and rejects writes
+
+swagger:response objectsPatchLocked
+*/
+type ObjectsPatchLocked struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewObjectsPatchLocked creates ObjectsPatchLocked with default headers values
+func NewObjectsPatchLocked() *ObjectsPatchLocked {
+
+	return &ObjectsPatchLocked{}
+}
+
+// WithPayload adds the payload to the objects patch locked response
+func (o *ObjectsPatchLocked) WithPayload(payload *models.ErrorResponse) *ObjectsPatchLocked {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the objects patch locked response
+func (o *ObjectsPatchLocked) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ObjectsPatchLocked) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(423)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // ObjectsPatchInternalServerErrorCode is the HTTP code returned for type ObjectsPatchInternalServerError
 const ObjectsPatchInternalServerErrorCode int = 500
 
@@ -164,6 +164,51 @@ func (o *ObjectsReferencesCreateUnprocessableEntity) WriteResponse(rw http.Respo
 	}
 }
 
+// ObjectsReferencesCreateLockedCode is the HTTP code returned for type ObjectsReferencesCreateLocked
+const ObjectsReferencesCreateLockedCode int = 423
+
+/*
+ObjectsReferencesCreateLocked The class or the node is in read-only mode and rejects writes
+
+swagger:response objectsReferencesCreateLocked
+*/
+type ObjectsReferencesCreateLocked struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewObjectsReferencesCreateLocked creates ObjectsReferencesCreateLocked with default headers values
+func NewObjectsReferencesCreateLocked() *ObjectsReferencesCreateLocked {
+
+	return &ObjectsReferencesCreateLocked{}
+}
+
+// WithPayload adds the payload to the objects references create locked response
+func (o *ObjectsReferencesCreateLocked) WithPayload(payload *models.ErrorResponse) *ObjectsReferencesCreateLocked {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the objects references create locked response
+func (o *ObjectsReferencesCreateLocked) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ObjectsReferencesCreateLocked) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(423)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // ObjectsReferencesCreateInternalServerErrorCode is the HTTP code returned for type ObjectsReferencesCreateInternalServerError
 const ObjectsReferencesCreateInternalServerErrorCode int = 500
 
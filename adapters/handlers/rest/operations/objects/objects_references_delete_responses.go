@@ -164,6 +164,51 @@ func (o *ObjectsReferencesDeleteNotFound) WriteResponse(rw http.ResponseWriter,
 	}
 }
 
+// ObjectsReferencesDeleteLockedCode is the HTTP code returned for type ObjectsReferencesDeleteLocked
+const ObjectsReferencesDeleteLockedCode int = 423
+
+/*
+ObjectsReferencesDeleteLocked The class or the node is in read-only mode and rejects writes
+
+swagger:response objectsReferencesDeleteLocked
+*/
+type ObjectsReferencesDeleteLocked struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewObjectsReferencesDeleteLocked creates ObjectsReferencesDeleteLocked with default headers values
+func NewObjectsReferencesDeleteLocked() *ObjectsReferencesDeleteLocked {
+
+	return &ObjectsReferencesDeleteLocked{}
+}
+
+// WithPayload adds the payload to the objects references delete locked response
+func (o *ObjectsReferencesDeleteLocked) WithPayload(payload *models.ErrorResponse) *ObjectsReferencesDeleteLocked {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the objects references delete locked response
+func (o *ObjectsReferencesDeleteLocked) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ObjectsReferencesDeleteLocked) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(423)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // ObjectsReferencesDeleteInternalServerErrorCode is the HTTP code returned for type ObjectsReferencesDeleteInternalServerError
 const ObjectsReferencesDeleteInternalServerErrorCode int = 500
 
@@ -164,6 +164,51 @@ func (o *ObjectsReferencesUpdateUnprocessableEntity) WriteResponse(rw http.Respo
 	}
 }
 
+// ObjectsReferencesUpdateLockedCode is the HTTP code returned for type ObjectsReferencesUpdateLocked
+const ObjectsReferencesUpdateLockedCode int = 423
+
+/*
+ObjectsReferencesUpdateLocked The class or the node is in read-only mode and rejects writes
+
+swagger:response objectsReferencesUpdateLocked
+*/
+type ObjectsReferencesUpdateLocked struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewObjectsReferencesUpdateLocked creates ObjectsReferencesUpdateLocked with default headers values
+func NewObjectsReferencesUpdateLocked() *ObjectsReferencesUpdateLocked {
+
+	return &ObjectsReferencesUpdateLocked{}
+}
+
+// WithPayload adds the payload to the objects references update locked response
+func (o *ObjectsReferencesUpdateLocked) WithPayload(payload *models.ErrorResponse) *ObjectsReferencesUpdateLocked {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the objects references update locked response
+func (o *ObjectsReferencesUpdateLocked) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ObjectsReferencesUpdateLocked) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(423)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // ObjectsReferencesUpdateInternalServerErrorCode is the HTTP code returned for type ObjectsReferencesUpdateInternalServerError
 const ObjectsReferencesUpdateInternalServerErrorCode int = 500
 
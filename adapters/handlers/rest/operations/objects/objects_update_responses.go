@@ -164,6 +164,51 @@ func (o *ObjectsUpdateNotFound) WriteResponse(rw http.ResponseWriter, producer r
 	rw.WriteHeader(404)
 }
 
+// ObjectsUpdatePreconditionFailedCode is the HTTP code returned for type ObjectsUpdatePreconditionFailed
+const ObjectsUpdatePreconditionFailedCode int = 412
+
+/*
+ObjectsUpdatePreconditionFailed The object has changed since it was read, its current ETag does not match the If-Match header.
+
+swagger:response objectsUpdatePreconditionFailed
+*/
+type ObjectsUpdatePreconditionFailed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewObjectsUpdatePreconditionFailed creates ObjectsUpdatePreconditionFailed with default headers values
+func NewObjectsUpdatePreconditionFailed() *ObjectsUpdatePreconditionFailed {
+
+	return &ObjectsUpdatePreconditionFailed{}
+}
+
+// WithPayload adds the payload to the objects update precondition failed response
+func (o *ObjectsUpdatePreconditionFailed) WithPayload(payload *models.ErrorResponse) *ObjectsUpdatePreconditionFailed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the objects update precondition failed response
+func (o *ObjectsUpdatePreconditionFailed) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ObjectsUpdatePreconditionFailed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(412)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // ObjectsUpdateUnprocessableEntityCode is the HTTP code returned for type ObjectsUpdateUnprocessableEntity
 const ObjectsUpdateUnprocessableEntityCode int = 422
 
@@ -209,6 +254,51 @@ func (o *ObjectsUpdateUnprocessableEntity) WriteResponse(rw http.ResponseWriter,
 	}
 }
 
+// ObjectsUpdateLockedCode is the HTTP code returned for type ObjectsUpdateLocked
+const ObjectsUpdateLockedCode int = 423
+
+/*
+ObjectsUpdateLocked The class or the node is in read-only mode and rejects writes
+
+swagger:response objectsUpdateLocked
+*/
+type ObjectsUpdateLocked struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewObjectsUpdateLocked creates ObjectsUpdateLocked with default headers values
+func NewObjectsUpdateLocked() *ObjectsUpdateLocked {
+
+	return &ObjectsUpdateLocked{}
+}
+
+// WithPayload adds the payload to the objects update locked response
+func (o *ObjectsUpdateLocked) WithPayload(payload *models.ErrorResponse) *ObjectsUpdateLocked {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the objects update locked response
+func (o *ObjectsUpdateLocked) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ObjectsUpdateLocked) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(423)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // ObjectsUpdateInternalServerErrorCode is the HTTP code returned for type ObjectsUpdateInternalServerError
 const ObjectsUpdateInternalServerErrorCode int = 500
 
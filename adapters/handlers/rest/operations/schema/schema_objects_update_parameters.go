@@ -24,17 +24,25 @@ import (
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 	"github.com/go-openapi/validate"
 
 	"github.com/weaviate/weaviate/entities/models"
 )
 
 // NewSchemaObjectsUpdateParams creates a new SchemaObjectsUpdateParams object
-//
-// There are no default values defined in the spec.
+// with the default values initialized.
 func NewSchemaObjectsUpdateParams() SchemaObjectsUpdateParams {
 
-	return SchemaObjectsUpdateParams{}
+	var (
+		// initialize parameters with default values
+
+		dryRunDefault = bool(false)
+	)
+
+	return SchemaObjectsUpdateParams{
+		DryRun: &dryRunDefault,
+	}
 }
 
 // SchemaObjectsUpdateParams contains all the bound params for the schema objects update operation
@@ -51,6 +59,11 @@ type SchemaObjectsUpdateParams struct {
 	  In: path
 	*/
 	ClassName string
+	/*If true, the update is only validated, but not applied.
+	  In: query
+	  Default: false
+	*/
+	DryRun *bool
 	/*
 	  Required: true
 	  In: body
@@ -67,11 +80,18 @@ func (o *SchemaObjectsUpdateParams) BindRequest(r *http.Request, route *middlewa
 
 	o.HTTPRequest = r
 
+	qs := runtime.Values(r.URL.Query())
+
 	rClassName, rhkClassName, _ := route.Params.GetOK("className")
 	if err := o.bindClassName(rClassName, rhkClassName, route.Formats); err != nil {
 		res = append(res, err)
 	}
 
+	qDryRun, qhkDryRun, _ := qs.GetOK("dryRun")
+	if err := o.bindDryRun(qDryRun, qhkDryRun, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	if runtime.HasBody(r) {
 		defer r.Body.Close()
 		var body models.Class
@@ -118,3 +138,27 @@ func (o *SchemaObjectsUpdateParams) bindClassName(rawData []string, hasKey bool,
 
 	return nil
 }
+
+// bindDryRun binds and validates parameter DryRun from query.
+func (o *SchemaObjectsUpdateParams) bindDryRun(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		// Default values have been previously initialized by NewSchemaObjectsUpdateParams()
+		return nil
+	}
+
+	value, err := swag.ConvertBool(raw)
+	if err != nil {
+		return errors.InvalidType("dryRun", "query", "bool", raw)
+	}
+	o.DryRun = &value
+
+	return nil
+}
@@ -61,10 +61,13 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		APIKeyAuthenticator: security.APIKeyAuth,
 		BearerAuthenticator: security.BearerAuth,
 
+		BinConsumer:  runtime.ByteStreamConsumer(),
 		JSONConsumer: runtime.JSONConsumer(),
 		YamlConsumer: yamlpc.YAMLConsumer(),
 
+		BinProducer:  runtime.ByteStreamProducer(),
 		JSONProducer: runtime.JSONProducer(),
+		YamlProducer: yamlpc.YAMLProducer(),
 
 		WellKnownGetWellKnownOpenidConfigurationHandler: well_known.GetWellKnownOpenidConfigurationHandlerFunc(func(params well_known.GetWellKnownOpenidConfigurationParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation well_known.GetWellKnownOpenidConfiguration has not yet been implemented")
@@ -111,6 +114,15 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		NodesNodesGetClassHandler: nodes.NodesGetClassHandlerFunc(func(params nodes.NodesGetClassParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation nodes.NodesGetClass has not yet been implemented")
 		}),
+		NodesNodesReadOnlyUpdateHandler: nodes.NodesReadOnlyUpdateHandlerFunc(func(params nodes.NodesReadOnlyUpdateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation nodes.NodesReadOnlyUpdate has not yet been implemented")
+		}),
+		ObjectsObjectsClassBlobsGetHandler: objects.ObjectsClassBlobsGetHandlerFunc(func(params objects.ObjectsClassBlobsGetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation objects.ObjectsClassBlobsGet has not yet been implemented")
+		}),
+		ObjectsObjectsClassBlobsPutHandler: objects.ObjectsClassBlobsPutHandlerFunc(func(params objects.ObjectsClassBlobsPutParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation objects.ObjectsClassBlobsPut has not yet been implemented")
+		}),
 		ObjectsObjectsClassDeleteHandler: objects.ObjectsClassDeleteHandlerFunc(func(params objects.ObjectsClassDeleteParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation objects.ObjectsClassDelete has not yet been implemented")
 		}),
@@ -135,6 +147,9 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		ObjectsObjectsClassReferencesPutHandler: objects.ObjectsClassReferencesPutHandlerFunc(func(params objects.ObjectsClassReferencesPutParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation objects.ObjectsClassReferencesPut has not yet been implemented")
 		}),
+		ObjectsObjectsClassVectorPutHandler: objects.ObjectsClassVectorPutHandlerFunc(func(params objects.ObjectsClassVectorPutParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation objects.ObjectsClassVectorPut has not yet been implemented")
+		}),
 		ObjectsObjectsCreateHandler: objects.ObjectsCreateHandlerFunc(func(params objects.ObjectsCreateParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation objects.ObjectsCreate has not yet been implemented")
 		}),
@@ -171,9 +186,24 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		SchemaSchemaClusterStatusHandler: schema.SchemaClusterStatusHandlerFunc(func(params schema.SchemaClusterStatusParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation schema.SchemaClusterStatus has not yet been implemented")
 		}),
+		SchemaSchemaDiffHandler: schema.SchemaDiffHandlerFunc(func(params schema.SchemaDiffParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaDiff has not yet been implemented")
+		}),
 		SchemaSchemaDumpHandler: schema.SchemaDumpHandlerFunc(func(params schema.SchemaDumpParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation schema.SchemaDump has not yet been implemented")
 		}),
+		SchemaSchemaExportHandler: schema.SchemaExportHandlerFunc(func(params schema.SchemaExportParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaExport has not yet been implemented")
+		}),
+		SchemaSchemaHistoryHandler: schema.SchemaHistoryHandlerFunc(func(params schema.SchemaHistoryParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaHistory has not yet been implemented")
+		}),
+		SchemaSchemaHistoryGetHandler: schema.SchemaHistoryGetHandlerFunc(func(params schema.SchemaHistoryGetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaHistoryGet has not yet been implemented")
+		}),
+		SchemaSchemaImportHandler: schema.SchemaImportHandlerFunc(func(params schema.SchemaImportParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaImport has not yet been implemented")
+		}),
 		SchemaSchemaObjectsCreateHandler: schema.SchemaObjectsCreateHandlerFunc(func(params schema.SchemaObjectsCreateParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation schema.SchemaObjectsCreate has not yet been implemented")
 		}),
@@ -183,18 +213,72 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		SchemaSchemaObjectsGetHandler: schema.SchemaObjectsGetHandlerFunc(func(params schema.SchemaObjectsGetParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation schema.SchemaObjectsGet has not yet been implemented")
 		}),
+		SchemaSchemaObjectsMigrationsGetHandler: schema.SchemaObjectsMigrationsGetHandlerFunc(func(params schema.SchemaObjectsMigrationsGetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaObjectsMigrationsGet has not yet been implemented")
+		}),
 		SchemaSchemaObjectsPropertiesAddHandler: schema.SchemaObjectsPropertiesAddHandlerFunc(func(params schema.SchemaObjectsPropertiesAddParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation schema.SchemaObjectsPropertiesAdd has not yet been implemented")
 		}),
+		SchemaSchemaObjectsPropertiesRenameHandler: schema.SchemaObjectsPropertiesRenameHandlerFunc(func(params schema.SchemaObjectsPropertiesRenameParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaObjectsPropertiesRename has not yet been implemented")
+		}),
+		SchemaSchemaObjectsPropertiesTokenizationUpdateHandler: schema.SchemaObjectsPropertiesTokenizationUpdateHandlerFunc(func(params schema.SchemaObjectsPropertiesTokenizationUpdateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaObjectsPropertiesTokenizationUpdate has not yet been implemented")
+		}),
+		SchemaSchemaObjectsReferencesAuditHandler: schema.SchemaObjectsReferencesAuditHandlerFunc(func(params schema.SchemaObjectsReferencesAuditParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaObjectsReferencesAudit has not yet been implemented")
+		}),
+		SchemaSchemaObjectsShardsCompactHandler: schema.SchemaObjectsShardsCompactHandlerFunc(func(params schema.SchemaObjectsShardsCompactParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaObjectsShardsCompact has not yet been implemented")
+		}),
 		SchemaSchemaObjectsShardsGetHandler: schema.SchemaObjectsShardsGetHandlerFunc(func(params schema.SchemaObjectsShardsGetParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation schema.SchemaObjectsShardsGet has not yet been implemented")
 		}),
 		SchemaSchemaObjectsShardsUpdateHandler: schema.SchemaObjectsShardsUpdateHandlerFunc(func(params schema.SchemaObjectsShardsUpdateParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation schema.SchemaObjectsShardsUpdate has not yet been implemented")
 		}),
+		SchemaSchemaObjectsShardsVectorIndexBuildHandler: schema.SchemaObjectsShardsVectorIndexBuildHandlerFunc(func(params schema.SchemaObjectsShardsVectorIndexBuildParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaObjectsShardsVectorIndexBuild has not yet been implemented")
+		}),
+		SchemaSchemaObjectsShardsVectorIndexExportHandler: schema.SchemaObjectsShardsVectorIndexExportHandlerFunc(func(params schema.SchemaObjectsShardsVectorIndexExportParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaObjectsShardsVectorIndexExport has not yet been implemented")
+		}),
+		SchemaSchemaObjectsShardsVectorIndexImportHandler: schema.SchemaObjectsShardsVectorIndexImportHandlerFunc(func(params schema.SchemaObjectsShardsVectorIndexImportParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaObjectsShardsVectorIndexImport has not yet been implemented")
+		}),
+		SchemaSchemaObjectsSynonymsGetHandler: schema.SchemaObjectsSynonymsGetHandlerFunc(func(params schema.SchemaObjectsSynonymsGetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaObjectsSynonymsGet has not yet been implemented")
+		}),
+		SchemaSchemaObjectsSynonymsUpdateHandler: schema.SchemaObjectsSynonymsUpdateHandlerFunc(func(params schema.SchemaObjectsSynonymsUpdateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaObjectsSynonymsUpdate has not yet been implemented")
+		}),
 		SchemaSchemaObjectsUpdateHandler: schema.SchemaObjectsUpdateHandlerFunc(func(params schema.SchemaObjectsUpdateParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation schema.SchemaObjectsUpdate has not yet been implemented")
 		}),
+		SchemaSchemaObjectsVectorIndexIntegrityHandler: schema.SchemaObjectsVectorIndexIntegrityHandlerFunc(func(params schema.SchemaObjectsVectorIndexIntegrityParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaObjectsVectorIndexIntegrity has not yet been implemented")
+		}),
+		SchemaSchemaObjectsVectorIndexRecallHandler: schema.SchemaObjectsVectorIndexRecallHandlerFunc(func(params schema.SchemaObjectsVectorIndexRecallParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaObjectsVectorIndexRecall has not yet been implemented")
+		}),
+		SchemaSchemaObjectsVectorIndexTombstoneCleanupHandler: schema.SchemaObjectsVectorIndexTombstoneCleanupHandlerFunc(func(params schema.SchemaObjectsVectorIndexTombstoneCleanupParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaObjectsVectorIndexTombstoneCleanup has not yet been implemented")
+		}),
+		SchemaSchemaObjectsVectorIndexTombstoneCleanupPauseHandler: schema.SchemaObjectsVectorIndexTombstoneCleanupPauseHandlerFunc(func(params schema.SchemaObjectsVectorIndexTombstoneCleanupPauseParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaObjectsVectorIndexTombstoneCleanupPause has not yet been implemented")
+		}),
+		SchemaSchemaObjectsVectorIndexTombstoneCleanupResumeHandler: schema.SchemaObjectsVectorIndexTombstoneCleanupResumeHandlerFunc(func(params schema.SchemaObjectsVectorIndexTombstoneCleanupResumeParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaObjectsVectorIndexTombstoneCleanupResume has not yet been implemented")
+		}),
+		SchemaSchemaStopwordsDeleteHandler: schema.SchemaStopwordsDeleteHandlerFunc(func(params schema.SchemaStopwordsDeleteParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaStopwordsDelete has not yet been implemented")
+		}),
+		SchemaSchemaStopwordsListHandler: schema.SchemaStopwordsListHandlerFunc(func(params schema.SchemaStopwordsListParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaStopwordsList has not yet been implemented")
+		}),
+		SchemaSchemaStopwordsPutHandler: schema.SchemaStopwordsPutHandlerFunc(func(params schema.SchemaStopwordsPutParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaStopwordsPut has not yet been implemented")
+		}),
 		SchemaTenantsCreateHandler: schema.TenantsCreateHandlerFunc(func(params schema.TenantsCreateParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation schema.TenantsCreate has not yet been implemented")
 		}),
@@ -250,6 +334,9 @@ type WeaviateAPI struct {
 	// It has a default implementation in the security package, however you can replace it for your particular usage.
 	BearerAuthenticator func(string, security.ScopedTokenAuthentication) runtime.Authenticator
 
+	// BinConsumer registers a consumer for the following mime types:
+	//   - application/octet-stream
+	BinConsumer runtime.Consumer
 	// JSONConsumer registers a consumer for the following mime types:
 	//   - application/json
 	JSONConsumer runtime.Consumer
@@ -257,9 +344,15 @@ type WeaviateAPI struct {
 	//   - application/yaml
 	YamlConsumer runtime.Consumer
 
+	// BinProducer registers a producer for the following mime types:
+	//   - application/octet-stream
+	BinProducer runtime.Producer
 	// JSONProducer registers a producer for the following mime types:
 	//   - application/json
 	JSONProducer runtime.Producer
+	// YamlProducer registers a producer for the following mime types:
+	//   - application/yaml
+	YamlProducer runtime.Producer
 
 	// OidcAuth registers a function that takes an access token and a collection of required scopes and returns a principal
 	// it performs authentication based on an oauth2 bearer token provided in the request
@@ -298,6 +391,12 @@ type WeaviateAPI struct {
 	NodesNodesGetHandler nodes.NodesGetHandler
 	// NodesNodesGetClassHandler sets the operation handler for the nodes get class operation
 	NodesNodesGetClassHandler nodes.NodesGetClassHandler
+	// NodesNodesReadOnlyUpdateHandler sets the operation handler for the nodes read only update operation
+	NodesNodesReadOnlyUpdateHandler nodes.NodesReadOnlyUpdateHandler
+	// ObjectsObjectsClassBlobsGetHandler sets the operation handler for the objects class blobs get operation
+	ObjectsObjectsClassBlobsGetHandler objects.ObjectsClassBlobsGetHandler
+	// ObjectsObjectsClassBlobsPutHandler sets the operation handler for the objects class blobs put operation
+	ObjectsObjectsClassBlobsPutHandler objects.ObjectsClassBlobsPutHandler
 	// ObjectsObjectsClassDeleteHandler sets the operation handler for the objects class delete operation
 	ObjectsObjectsClassDeleteHandler objects.ObjectsClassDeleteHandler
 	// ObjectsObjectsClassGetHandler sets the operation handler for the objects class get operation
@@ -314,6 +413,8 @@ type WeaviateAPI struct {
 	ObjectsObjectsClassReferencesDeleteHandler objects.ObjectsClassReferencesDeleteHandler
 	// ObjectsObjectsClassReferencesPutHandler sets the operation handler for the objects class references put operation
 	ObjectsObjectsClassReferencesPutHandler objects.ObjectsClassReferencesPutHandler
+	// ObjectsObjectsClassVectorPutHandler sets the operation handler for the objects class vector put operation
+	ObjectsObjectsClassVectorPutHandler objects.ObjectsClassVectorPutHandler
 	// ObjectsObjectsCreateHandler sets the operation handler for the objects create operation
 	ObjectsObjectsCreateHandler objects.ObjectsCreateHandler
 	// ObjectsObjectsDeleteHandler sets the operation handler for the objects delete operation
@@ -338,22 +439,68 @@ type WeaviateAPI struct {
 	ObjectsObjectsValidateHandler objects.ObjectsValidateHandler
 	// SchemaSchemaClusterStatusHandler sets the operation handler for the schema cluster status operation
 	SchemaSchemaClusterStatusHandler schema.SchemaClusterStatusHandler
+	// SchemaSchemaDiffHandler sets the operation handler for the schema diff operation
+	SchemaSchemaDiffHandler schema.SchemaDiffHandler
 	// SchemaSchemaDumpHandler sets the operation handler for the schema dump operation
 	SchemaSchemaDumpHandler schema.SchemaDumpHandler
+	// SchemaSchemaExportHandler sets the operation handler for the schema export operation
+	SchemaSchemaExportHandler schema.SchemaExportHandler
+	// SchemaSchemaHistoryHandler sets the operation handler for the schema history operation
+	SchemaSchemaHistoryHandler schema.SchemaHistoryHandler
+	// SchemaSchemaHistoryGetHandler sets the operation handler for the schema history get operation
+	SchemaSchemaHistoryGetHandler schema.SchemaHistoryGetHandler
+	// SchemaSchemaImportHandler sets the operation handler for the schema import operation
+	SchemaSchemaImportHandler schema.SchemaImportHandler
 	// SchemaSchemaObjectsCreateHandler sets the operation handler for the schema objects create operation
 	SchemaSchemaObjectsCreateHandler schema.SchemaObjectsCreateHandler
 	// SchemaSchemaObjectsDeleteHandler sets the operation handler for the schema objects delete operation
 	SchemaSchemaObjectsDeleteHandler schema.SchemaObjectsDeleteHandler
 	// SchemaSchemaObjectsGetHandler sets the operation handler for the schema objects get operation
 	SchemaSchemaObjectsGetHandler schema.SchemaObjectsGetHandler
+	// SchemaSchemaObjectsMigrationsGetHandler sets the operation handler for the schema objects migrations get operation
+	SchemaSchemaObjectsMigrationsGetHandler schema.SchemaObjectsMigrationsGetHandler
 	// SchemaSchemaObjectsPropertiesAddHandler sets the operation handler for the schema objects properties add operation
 	SchemaSchemaObjectsPropertiesAddHandler schema.SchemaObjectsPropertiesAddHandler
+	// SchemaSchemaObjectsPropertiesRenameHandler sets the operation handler for the schema objects properties rename operation
+	SchemaSchemaObjectsPropertiesRenameHandler schema.SchemaObjectsPropertiesRenameHandler
+	// SchemaSchemaObjectsPropertiesTokenizationUpdateHandler sets the operation handler for the schema objects properties tokenization update operation
+	SchemaSchemaObjectsPropertiesTokenizationUpdateHandler schema.SchemaObjectsPropertiesTokenizationUpdateHandler
+	// SchemaSchemaObjectsReferencesAuditHandler sets the operation handler for the schema objects references audit operation
+	SchemaSchemaObjectsReferencesAuditHandler schema.SchemaObjectsReferencesAuditHandler
+	// SchemaSchemaObjectsShardsCompactHandler sets the operation handler for the schema objects shards compact operation
+	SchemaSchemaObjectsShardsCompactHandler schema.SchemaObjectsShardsCompactHandler
 	// SchemaSchemaObjectsShardsGetHandler sets the operation handler for the schema objects shards get operation
 	SchemaSchemaObjectsShardsGetHandler schema.SchemaObjectsShardsGetHandler
 	// SchemaSchemaObjectsShardsUpdateHandler sets the operation handler for the schema objects shards update operation
 	SchemaSchemaObjectsShardsUpdateHandler schema.SchemaObjectsShardsUpdateHandler
+	// SchemaSchemaObjectsShardsVectorIndexBuildHandler sets the operation handler for the schema objects shards vector index build operation
+	SchemaSchemaObjectsShardsVectorIndexBuildHandler schema.SchemaObjectsShardsVectorIndexBuildHandler
+	// SchemaSchemaObjectsShardsVectorIndexExportHandler sets the operation handler for the schema objects shards vector index export operation
+	SchemaSchemaObjectsShardsVectorIndexExportHandler schema.SchemaObjectsShardsVectorIndexExportHandler
+	// SchemaSchemaObjectsShardsVectorIndexImportHandler sets the operation handler for the schema objects shards vector index import operation
+	SchemaSchemaObjectsShardsVectorIndexImportHandler schema.SchemaObjectsShardsVectorIndexImportHandler
+	// SchemaSchemaObjectsSynonymsGetHandler sets the operation handler for the schema objects synonyms get operation
+	SchemaSchemaObjectsSynonymsGetHandler schema.SchemaObjectsSynonymsGetHandler
+	// SchemaSchemaObjectsSynonymsUpdateHandler sets the operation handler for the schema objects synonyms update operation
+	SchemaSchemaObjectsSynonymsUpdateHandler schema.SchemaObjectsSynonymsUpdateHandler
 	// SchemaSchemaObjectsUpdateHandler sets the operation handler for the schema objects update operation
 	SchemaSchemaObjectsUpdateHandler schema.SchemaObjectsUpdateHandler
+	// SchemaSchemaObjectsVectorIndexIntegrityHandler sets the operation handler for the schema objects vector index integrity operation
+	SchemaSchemaObjectsVectorIndexIntegrityHandler schema.SchemaObjectsVectorIndexIntegrityHandler
+	// SchemaSchemaObjectsVectorIndexRecallHandler sets the operation handler for the schema objects vector index recall operation
+	SchemaSchemaObjectsVectorIndexRecallHandler schema.SchemaObjectsVectorIndexRecallHandler
+	// SchemaSchemaObjectsVectorIndexTombstoneCleanupHandler sets the operation handler for the schema objects vector index tombstone cleanup operation
+	SchemaSchemaObjectsVectorIndexTombstoneCleanupHandler schema.SchemaObjectsVectorIndexTombstoneCleanupHandler
+	// SchemaSchemaObjectsVectorIndexTombstoneCleanupPauseHandler sets the operation handler for the schema objects vector index tombstone cleanup pause operation
+	SchemaSchemaObjectsVectorIndexTombstoneCleanupPauseHandler schema.SchemaObjectsVectorIndexTombstoneCleanupPauseHandler
+	// SchemaSchemaObjectsVectorIndexTombstoneCleanupResumeHandler sets the operation handler for the schema objects vector index tombstone cleanup resume operation
+	SchemaSchemaObjectsVectorIndexTombstoneCleanupResumeHandler schema.SchemaObjectsVectorIndexTombstoneCleanupResumeHandler
+	// SchemaSchemaStopwordsDeleteHandler sets the operation handler for the schema stopwords delete operation
+	SchemaSchemaStopwordsDeleteHandler schema.SchemaStopwordsDeleteHandler
+	// SchemaSchemaStopwordsListHandler sets the operation handler for the schema stopwords list operation
+	SchemaSchemaStopwordsListHandler schema.SchemaStopwordsListHandler
+	// SchemaSchemaStopwordsPutHandler sets the operation handler for the schema stopwords put operation
+	SchemaSchemaStopwordsPutHandler schema.SchemaStopwordsPutHandler
 	// SchemaTenantsCreateHandler sets the operation handler for the tenants create operation
 	SchemaTenantsCreateHandler schema.TenantsCreateHandler
 	// SchemaTenantsDeleteHandler sets the operation handler for the tenants delete operation
@@ -437,6 +584,9 @@ func (o *WeaviateAPI) RegisterFormat(name string, format strfmt.Format, validato
 func (o *WeaviateAPI) Validate() error {
 	var unregistered []string
 
+	if o.BinConsumer == nil {
+		unregistered = append(unregistered, "BinConsumer")
+	}
 	if o.JSONConsumer == nil {
 		unregistered = append(unregistered, "JSONConsumer")
 	}
@@ -444,9 +594,15 @@ func (o *WeaviateAPI) Validate() error {
 		unregistered = append(unregistered, "YamlConsumer")
 	}
 
+	if o.BinProducer == nil {
+		unregistered = append(unregistered, "BinProducer")
+	}
 	if o.JSONProducer == nil {
 		unregistered = append(unregistered, "JSONProducer")
 	}
+	if o.YamlProducer == nil {
+		unregistered = append(unregistered, "YamlProducer")
+	}
 
 	if o.OidcAuth == nil {
 		unregistered = append(unregistered, "OidcAuth")
@@ -497,6 +653,15 @@ func (o *WeaviateAPI) Validate() error {
 	if o.NodesNodesGetClassHandler == nil {
 		unregistered = append(unregistered, "nodes.NodesGetClassHandler")
 	}
+	if o.NodesNodesReadOnlyUpdateHandler == nil {
+		unregistered = append(unregistered, "nodes.NodesReadOnlyUpdateHandler")
+	}
+	if o.ObjectsObjectsClassBlobsGetHandler == nil {
+		unregistered = append(unregistered, "objects.ObjectsClassBlobsGetHandler")
+	}
+	if o.ObjectsObjectsClassBlobsPutHandler == nil {
+		unregistered = append(unregistered, "objects.ObjectsClassBlobsPutHandler")
+	}
 	if o.ObjectsObjectsClassDeleteHandler == nil {
 		unregistered = append(unregistered, "objects.ObjectsClassDeleteHandler")
 	}
@@ -521,6 +686,9 @@ func (o *WeaviateAPI) Validate() error {
 	if o.ObjectsObjectsClassReferencesPutHandler == nil {
 		unregistered = append(unregistered, "objects.ObjectsClassReferencesPutHandler")
 	}
+	if o.ObjectsObjectsClassVectorPutHandler == nil {
+		unregistered = append(unregistered, "objects.ObjectsClassVectorPutHandler")
+	}
 	if o.ObjectsObjectsCreateHandler == nil {
 		unregistered = append(unregistered, "objects.ObjectsCreateHandler")
 	}
@@ -557,9 +725,24 @@ func (o *WeaviateAPI) Validate() error {
 	if o.SchemaSchemaClusterStatusHandler == nil {
 		unregistered = append(unregistered, "schema.SchemaClusterStatusHandler")
 	}
+	if o.SchemaSchemaDiffHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaDiffHandler")
+	}
 	if o.SchemaSchemaDumpHandler == nil {
 		unregistered = append(unregistered, "schema.SchemaDumpHandler")
 	}
+	if o.SchemaSchemaExportHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaExportHandler")
+	}
+	if o.SchemaSchemaHistoryHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaHistoryHandler")
+	}
+	if o.SchemaSchemaHistoryGetHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaHistoryGetHandler")
+	}
+	if o.SchemaSchemaImportHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaImportHandler")
+	}
 	if o.SchemaSchemaObjectsCreateHandler == nil {
 		unregistered = append(unregistered, "schema.SchemaObjectsCreateHandler")
 	}
@@ -569,18 +752,72 @@ func (o *WeaviateAPI) Validate() error {
 	if o.SchemaSchemaObjectsGetHandler == nil {
 		unregistered = append(unregistered, "schema.SchemaObjectsGetHandler")
 	}
+	if o.SchemaSchemaObjectsMigrationsGetHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaObjectsMigrationsGetHandler")
+	}
 	if o.SchemaSchemaObjectsPropertiesAddHandler == nil {
 		unregistered = append(unregistered, "schema.SchemaObjectsPropertiesAddHandler")
 	}
+	if o.SchemaSchemaObjectsPropertiesRenameHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaObjectsPropertiesRenameHandler")
+	}
+	if o.SchemaSchemaObjectsPropertiesTokenizationUpdateHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaObjectsPropertiesTokenizationUpdateHandler")
+	}
+	if o.SchemaSchemaObjectsReferencesAuditHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaObjectsReferencesAuditHandler")
+	}
+	if o.SchemaSchemaObjectsShardsCompactHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaObjectsShardsCompactHandler")
+	}
 	if o.SchemaSchemaObjectsShardsGetHandler == nil {
 		unregistered = append(unregistered, "schema.SchemaObjectsShardsGetHandler")
 	}
 	if o.SchemaSchemaObjectsShardsUpdateHandler == nil {
 		unregistered = append(unregistered, "schema.SchemaObjectsShardsUpdateHandler")
 	}
+	if o.SchemaSchemaObjectsShardsVectorIndexBuildHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaObjectsShardsVectorIndexBuildHandler")
+	}
+	if o.SchemaSchemaObjectsShardsVectorIndexExportHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaObjectsShardsVectorIndexExportHandler")
+	}
+	if o.SchemaSchemaObjectsShardsVectorIndexImportHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaObjectsShardsVectorIndexImportHandler")
+	}
+	if o.SchemaSchemaObjectsSynonymsGetHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaObjectsSynonymsGetHandler")
+	}
+	if o.SchemaSchemaObjectsSynonymsUpdateHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaObjectsSynonymsUpdateHandler")
+	}
 	if o.SchemaSchemaObjectsUpdateHandler == nil {
 		unregistered = append(unregistered, "schema.SchemaObjectsUpdateHandler")
 	}
+	if o.SchemaSchemaObjectsVectorIndexIntegrityHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaObjectsVectorIndexIntegrityHandler")
+	}
+	if o.SchemaSchemaObjectsVectorIndexRecallHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaObjectsVectorIndexRecallHandler")
+	}
+	if o.SchemaSchemaObjectsVectorIndexTombstoneCleanupHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaObjectsVectorIndexTombstoneCleanupHandler")
+	}
+	if o.SchemaSchemaObjectsVectorIndexTombstoneCleanupPauseHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaObjectsVectorIndexTombstoneCleanupPauseHandler")
+	}
+	if o.SchemaSchemaObjectsVectorIndexTombstoneCleanupResumeHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaObjectsVectorIndexTombstoneCleanupResumeHandler")
+	}
+	if o.SchemaSchemaStopwordsDeleteHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaStopwordsDeleteHandler")
+	}
+	if o.SchemaSchemaStopwordsListHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaStopwordsListHandler")
+	}
+	if o.SchemaSchemaStopwordsPutHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaStopwordsPutHandler")
+	}
 	if o.SchemaTenantsCreateHandler == nil {
 		unregistered = append(unregistered, "schema.TenantsCreateHandler")
 	}
@@ -643,6 +880,8 @@ func (o *WeaviateAPI) ConsumersFor(mediaTypes []string) map[string]runtime.Consu
 		switch mt {
 		case "application/json":
 			result["application/json"] = o.JSONConsumer
+		case "application/octet-stream":
+			result["application/octet-stream"] = o.BinConsumer
 		case "application/yaml":
 			result["application/yaml"] = o.YamlConsumer
 		}
@@ -662,6 +901,10 @@ func (o *WeaviateAPI) ProducersFor(mediaTypes []string) map[string]runtime.Produ
 		switch mt {
 		case "application/json":
 			result["application/json"] = o.JSONProducer
+		case "application/octet-stream":
+			result["application/octet-stream"] = o.BinProducer
+		case "application/yaml":
+			result["application/yaml"] = o.YamlProducer
 		}
 
 		if p, ok := o.customProducers[mt]; ok {
@@ -762,6 +1005,18 @@ func (o *WeaviateAPI) initHandlerCache() {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/nodes/{className}"] = nodes.NewNodesGetClass(o.context, o.NodesNodesGetClassHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/nodes/read-only"] = nodes.NewNodesReadOnlyUpdate(o.context, o.NodesNodesReadOnlyUpdateHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/objects/{className}/{id}/blobs/{propertyName}"] = objects.NewObjectsClassBlobsGet(o.context, o.ObjectsObjectsClassBlobsGetHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/objects/{className}/{id}/blobs/{propertyName}"] = objects.NewObjectsClassBlobsPut(o.context, o.ObjectsObjectsClassBlobsPutHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
@@ -794,6 +1049,10 @@ func (o *WeaviateAPI) initHandlerCache() {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
 	o.handlers["PUT"]["/objects/{className}/{id}/references/{propertyName}"] = objects.NewObjectsClassReferencesPut(o.context, o.ObjectsObjectsClassReferencesPutHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/objects/{className}/{id}/vector"] = objects.NewObjectsClassVectorPut(o.context, o.ObjectsObjectsClassVectorPutHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -842,10 +1101,30 @@ func (o *WeaviateAPI) initHandlerCache() {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/schema/cluster-status"] = schema.NewSchemaClusterStatus(o.context, o.SchemaSchemaClusterStatusHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/schema/diff"] = schema.NewSchemaDiff(o.context, o.SchemaSchemaDiffHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/schema"] = schema.NewSchemaDump(o.context, o.SchemaSchemaDumpHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/schema/export"] = schema.NewSchemaExport(o.context, o.SchemaSchemaExportHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/schema/history"] = schema.NewSchemaHistory(o.context, o.SchemaSchemaHistoryHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/schema/history/{version}"] = schema.NewSchemaHistoryGet(o.context, o.SchemaSchemaHistoryGetHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/schema/import"] = schema.NewSchemaImport(o.context, o.SchemaSchemaImportHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -858,10 +1137,26 @@ func (o *WeaviateAPI) initHandlerCache() {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/schema/{className}"] = schema.NewSchemaObjectsGet(o.context, o.SchemaSchemaObjectsGetHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/schema/{className}/migrations"] = schema.NewSchemaObjectsMigrationsGet(o.context, o.SchemaSchemaObjectsMigrationsGetHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/schema/{className}/properties"] = schema.NewSchemaObjectsPropertiesAdd(o.context, o.SchemaSchemaObjectsPropertiesAddHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/schema/{className}/properties/{propertyName}/rename"] = schema.NewSchemaObjectsPropertiesRename(o.context, o.SchemaSchemaObjectsPropertiesRenameHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/schema/{className}/properties/{propertyName}/tokenization"] = schema.NewSchemaObjectsPropertiesTokenizationUpdate(o.context, o.SchemaSchemaObjectsPropertiesTokenizationUpdateHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/schema/{className}/references/audit"] = schema.NewSchemaObjectsReferencesAudit(o.context, o.SchemaSchemaObjectsReferencesAuditHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -870,6 +1165,30 @@ func (o *WeaviateAPI) initHandlerCache() {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
 	o.handlers["PUT"]["/schema/{className}/shards/{shardName}"] = schema.NewSchemaObjectsShardsUpdate(o.context, o.SchemaSchemaObjectsShardsUpdateHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/schema/{className}/shards/{shardName}/compact"] = schema.NewSchemaObjectsShardsCompact(o.context, o.SchemaSchemaObjectsShardsCompactHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/schema/{className}/shards/{shardName}/vector-index/build"] = schema.NewSchemaObjectsShardsVectorIndexBuild(o.context, o.SchemaSchemaObjectsShardsVectorIndexBuildHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/schema/{className}/shards/{shardName}/vector-index/export"] = schema.NewSchemaObjectsShardsVectorIndexExport(o.context, o.SchemaSchemaObjectsShardsVectorIndexExportHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/schema/{className}/shards/{shardName}/vector-index/import"] = schema.NewSchemaObjectsShardsVectorIndexImport(o.context, o.SchemaSchemaObjectsShardsVectorIndexImportHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/schema/{className}/synonyms"] = schema.NewSchemaObjectsSynonymsGet(o.context, o.SchemaSchemaObjectsSynonymsGetHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/schema/{className}/synonyms"] = schema.NewSchemaObjectsSynonymsUpdate(o.context, o.SchemaSchemaObjectsSynonymsUpdateHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
@@ -877,6 +1196,38 @@ func (o *WeaviateAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/schema/{className}/vector-index/integrity"] = schema.NewSchemaObjectsVectorIndexIntegrity(o.context, o.SchemaSchemaObjectsVectorIndexIntegrityHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/schema/{className}/vector-index/recall"] = schema.NewSchemaObjectsVectorIndexRecall(o.context, o.SchemaSchemaObjectsVectorIndexRecallHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/schema/{className}/vector-index/tombstone-cleanup"] = schema.NewSchemaObjectsVectorIndexTombstoneCleanup(o.context, o.SchemaSchemaObjectsVectorIndexTombstoneCleanupHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/schema/{className}/vector-index/tombstone-cleanup/pause"] = schema.NewSchemaObjectsVectorIndexTombstoneCleanupPause(o.context, o.SchemaSchemaObjectsVectorIndexTombstoneCleanupPauseHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/schema/{className}/vector-index/tombstone-cleanup/resume"] = schema.NewSchemaObjectsVectorIndexTombstoneCleanupResume(o.context, o.SchemaSchemaObjectsVectorIndexTombstoneCleanupResumeHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/schema/stopwords/{setName}"] = schema.NewSchemaStopwordsDelete(o.context, o.SchemaSchemaStopwordsDeleteHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/schema/stopwords"] = schema.NewSchemaStopwordsList(o.context, o.SchemaSchemaStopwordsListHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/schema/stopwords/{setName}"] = schema.NewSchemaStopwordsPut(o.context, o.SchemaSchemaStopwordsPutHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/schema/{className}/tenants"] = schema.NewTenantsCreate(o.context, o.SchemaTenantsCreateHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
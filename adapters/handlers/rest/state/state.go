@@ -60,6 +60,7 @@ type State struct {
 	BackupManager      *backup.Handler
 	DB                 *db.DB
 	BatchManager       *objects.BatchManager
+	ObjectsManager     *objects.Manager
 }
 
 // GetGraphQL is the safe way to retrieve GraphQL from the state as it can be
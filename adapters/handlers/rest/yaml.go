@@ -0,0 +1,75 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"gopkg.in/yaml.v3"
+)
+
+// yamlConsumer decodes yaml bodies into the generated models. Unlike the
+// default consumer it honors the json tags of the models, so that a yaml
+// document uses the same field names as its json counterpart.
+func yamlConsumer() runtime.Consumer {
+	return runtime.ConsumerFunc(func(r io.Reader, data interface{}) error {
+		var doc interface{}
+		if err := yaml.NewDecoder(r).Decode(&doc); err != nil && err != io.EOF {
+			return fmt.Errorf("decode yaml: %w", err)
+		}
+		b, err := json.Marshal(doc)
+		if err != nil {
+			return fmt.Errorf("convert yaml to json: %w", err)
+		}
+		dec := json.NewDecoder(bytes.NewReader(b))
+		dec.UseNumber()
+		return dec.Decode(data)
+	})
+}
+
+// yamlProducer encodes the generated models as yaml using the field names of
+// their json tags
+func yamlProducer() runtime.Producer {
+	return runtime.ProducerFunc(func(w io.Writer, data interface{}) error {
+		b, err := json.Marshal(data)
+		if err != nil {
+			return err
+		}
+		// json is valid yaml, parsing it into a node keeps the order of the
+		// fields and the exact representation of numbers
+		var node yaml.Node
+		if err := yaml.Unmarshal(b, &node); err != nil {
+			return fmt.Errorf("convert json to yaml: %w", err)
+		}
+		resetYamlStyle(&node)
+
+		enc := yaml.NewEncoder(w)
+		enc.SetIndent(2)
+		if err := enc.Encode(&node); err != nil {
+			return err
+		}
+		return enc.Close()
+	})
+}
+
+// resetYamlStyle replaces the flow style and quoting of parsed json with the
+// default block style of yaml
+func resetYamlStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		resetYamlStyle(child)
+	}
+}
@@ -0,0 +1,59 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestYamlRoundTrip(t *testing.T) {
+	doc := &models.SchemaExport{
+		Version: 1,
+		Classes: []*models.Class{{
+			Class:           "Article",
+			VectorIndexType: "hnsw",
+			VectorIndexConfig: map[string]interface{}{
+				"efConstruction": 128,
+			},
+			Properties: []*models.Property{
+				{Name: "title", DataType: []string{"text"}, Description: "true"},
+			},
+		}},
+		Tenants: map[string][]*models.Tenant{
+			"Article": {{Name: "tenant1", ActivityStatus: models.TenantActivityStatusHOT}},
+		},
+	}
+
+	var buf bytes.Buffer
+	require.Nil(t, yamlProducer().Produce(&buf, doc))
+
+	out := buf.String()
+	assert.True(t, strings.HasPrefix(out, "classes:\n"), "block style expected, got:\n%s", out)
+	assert.Contains(t, out, "vectorIndexType: hnsw")
+	assert.Contains(t, out, "efConstruction: 128")
+	assert.Contains(t, out, `description: "true"`)
+
+	var decoded models.SchemaExport
+	require.Nil(t, yamlConsumer().Consume(&buf, &decoded))
+	assert.Equal(t, doc.Version, decoded.Version)
+	assert.Equal(t, doc.Tenants, decoded.Tenants)
+	require.Len(t, decoded.Classes, 1)
+	assert.Equal(t, "hnsw", decoded.Classes[0].VectorIndexType)
+	assert.Equal(t, "true", decoded.Classes[0].Properties[0].Description)
+	assert.NotNil(t, decoded.Classes[0].VectorIndexConfig.(map[string]interface{})["efConstruction"])
+}
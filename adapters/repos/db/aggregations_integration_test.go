@@ -360,6 +360,75 @@ func testNumericalAggregationsWithGrouping(repo *DB, exact bool) func(t *testing
 				epsilon)
 		})
 
+		t.Run("grouped by multiple props", func(t *testing.T) {
+			params := aggregation.Params{
+				ClassName: schema.ClassName(companyClass.Class),
+				GroupByProperties: []*filters.Path{
+					{
+						Class:    schema.ClassName(companyClass.Class),
+						Property: schema.PropertyName("sector"),
+					},
+					{
+						Class:    schema.ClassName(companyClass.Class),
+						Property: schema.PropertyName("listedInIndex"),
+					},
+				},
+				Properties: []aggregation.ParamProperty{
+					{
+						Name:        schema.PropertyName("dividendYield"),
+						Aggregators: []aggregation.Aggregator{aggregation.MeanAggregator},
+					},
+				},
+			}
+			keys := func(sector string, listedInIndex bool) []aggregation.GroupedByKey {
+				return []aggregation.GroupedByKey{
+					{Path: []string{"sector"}, Value: sector},
+					{Path: []string{"listedInIndex"}, Value: listedInIndex},
+				}
+			}
+
+			t.Run("ordered by count", func(t *testing.T) {
+				res, err := repo.Aggregate(context.Background(), params)
+				require.Nil(t, err)
+
+				expected := []struct {
+					keys  []aggregation.GroupedByKey
+					count int
+					mean  float64
+				}{
+					{keys: keys("Food", true), count: 50, mean: 2.48},
+					{keys: keys("Financials", true), count: 30, mean: 2.2},
+					{keys: keys("Food", false), count: 10, mean: 0},
+				}
+
+				require.Len(t, res.Groups, len(expected))
+				for i, group := range res.Groups {
+					assert.Equal(t, expected[i].keys, group.GroupedBy.Keys)
+					assert.Nil(t, group.GroupedBy.Value)
+					assert.Equal(t, expected[i].count, group.Count)
+					assert.InDelta(t, expected[i].mean,
+						group.Properties["dividendYield"].NumericalAggregations["mean"], epsilon)
+				}
+			})
+
+			t.Run("ordered by mean with limit", func(t *testing.T) {
+				params := params
+				params.GroupByOrder = &aggregation.GroupByOrder{
+					Property:   "dividendYield",
+					Aggregator: aggregation.MeanAggregator.String(),
+					Ascending:  true,
+				}
+				params.Limit = ptInt(2)
+
+				res, err := repo.Aggregate(context.Background(), params)
+				require.Nil(t, err)
+
+				require.Len(t, res.Groups, 2)
+				assert.Equal(t, keys("Food", false), res.Groups[0].GroupedBy.Keys)
+				assert.Equal(t, keys("Financials", true), res.Groups[1].GroupedBy.Keys)
+			})
+		})
+
 		t.Run("multiple fields, multiple aggregators, grouped by string", func(t *testing.T) {
 			params := aggregation.Params{
 				ClassName: schema.ClassName(companyClass.Class),
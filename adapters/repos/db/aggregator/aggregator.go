@@ -73,7 +73,7 @@ func New(store *lsmkv.Store, params aggregation.Params,
 }
 
 func (a *Aggregator) Do(ctx context.Context) (*aggregation.Result, error) {
-	if a.params.GroupBy != nil {
+	if a.params.Grouped() {
 		return newGroupedAggregator(a).Do(ctx)
 	}
 
@@ -100,7 +100,8 @@ func (a *Aggregator) aggTypeOfProperty(
 	dt := schema.DataType(schemaProp.DataType[0])
 	switch dt {
 	case schema.DataTypeInt, schema.DataTypeNumber, schema.DataTypeIntArray,
-		schema.DataTypeNumberArray:
+		schema.DataTypeNumberArray, schema.DataTypeDuration:
+		// durations are aggregated as their number of nanoseconds
 		return aggregation.PropertyTypeNumerical, dt, nil
 	case schema.DataTypeBoolean, schema.DataTypeBooleanArray:
 		return aggregation.PropertyTypeBoolean, dt, nil
@@ -112,6 +113,12 @@ func (a *Aggregator) aggTypeOfProperty(
 		return "", "", fmt.Errorf("dataType geoCoordinates can't be aggregated")
 	case schema.DataTypePhoneNumber:
 		return "", "", fmt.Errorf("dataType phoneNumber can't be aggregated")
+	case schema.DataTypeIP:
+		return "", "", fmt.Errorf("dataType ip can't be aggregated")
+	case schema.DataTypeDecimal:
+		return "", "", fmt.Errorf("dataType decimal can't be aggregated")
+	case schema.DataTypeGeoPolygon, schema.DataTypeGeoShape, schema.DataTypeSparseVector:
+		return "", "", fmt.Errorf("dataType %s can't be aggregated", dt)
 	default:
 		return "", "", fmt.Errorf("unrecoginzed dataType %v", schemaProp.DataType[0])
 	}
@@ -52,6 +52,12 @@ func addDateAggregations(prop *aggregation.Property,
 	}
 
 	for _, aProp := range aggs {
+		if aProp.Type == aggregation.HistogramType && aProp.Interval != nil {
+			prop.DateAggregations[aProp.Type] = dateHistogram(agg.pairs,
+				intervalSecondsToDuration(*aProp.Interval))
+			continue
+		}
+
 		switch aProp {
 		case aggregation.MinimumAggregator:
 			prop.DateAggregations[aProp.String()] = agg.Min()
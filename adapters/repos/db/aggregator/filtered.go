@@ -238,6 +238,18 @@ func (fa *filteredAggregator) addPropValue(prop propAgg, value interface{}) erro
 					return err
 				}
 			}
+		case schema.DataTypeDuration:
+			asString, ok := value.(string)
+			if !ok {
+				return fmt.Errorf("expected property type duration, received %T", value)
+			}
+			d, err := schema.ParseDuration(asString)
+			if err != nil {
+				return err
+			}
+			if err := prop.numericalAgg.AddFloat64(float64(d)); err != nil {
+				return err
+			}
 		default:
 			return fmt.Errorf("unknown datatype %v for aggregation %v", prop.dataType, aggregation.PropertyTypeText)
 		}
@@ -406,6 +418,8 @@ func (pa propAggs) results() (map[string]aggregation.Property, error) {
 			out[prop.name.String()] = aggProp
 		case aggregation.PropertyTypeText:
 			aggProp.TextAggregation = prop.textAgg.Res()
+			addTextDistinctCount(&aggProp.TextAggregation, prop.specifiedAggregators,
+				prop.textAgg)
 			out[prop.name.String()] = aggProp
 		case aggregation.PropertyTypeNumerical:
 			addNumericalAggregations(&aggProp, prop.specifiedAggregators,
@@ -0,0 +1,135 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package aggregator
+
+import (
+	"sort"
+	"time"
+
+	"github.com/weaviate/weaviate/entities/aggregation"
+)
+
+// OrderGroups orders the combined groups of all shards and selects the top
+// groups. Groups ordered by their count in descending order are already
+// ordered by the ShardCombiner. Groups without a result for the metric they
+// are ordered by are placed last.
+func OrderGroups(res *aggregation.Result, order *aggregation.GroupByOrder,
+	limit *int,
+) *aggregation.Result {
+	if res == nil || len(res.Groups) == 0 || res.Groups[0].GroupedBy == nil {
+		// ungrouped aggregation
+		return res
+	}
+
+	if !order.ByCountDescending() {
+		metrics := make(map[*aggregation.Group]float64, len(res.Groups))
+		for i := range res.Groups {
+			if metric, ok := groupMetric(res.Groups[i], order); ok {
+				metrics[&res.Groups[i]] = metric
+			}
+		}
+
+		less := func(a, b *aggregation.Group) bool {
+			aMetric, aOk := metrics[a]
+			bMetric, bOk := metrics[b]
+			if !aOk || !bOk {
+				return aOk
+			}
+			if order.Ascending {
+				return aMetric < bMetric
+			}
+			return aMetric > bMetric
+		}
+
+		// sort the pointers, so the metrics can be looked up while sorting
+		groups := make([]*aggregation.Group, len(res.Groups))
+		for i := range res.Groups {
+			groups[i] = &res.Groups[i]
+		}
+		sort.SliceStable(groups, func(a, b int) bool {
+			return less(groups[a], groups[b])
+		})
+
+		sorted := make([]aggregation.Group, len(groups))
+		for i := range groups {
+			sorted[i] = *groups[i]
+		}
+		res.Groups = sorted
+	}
+
+	if limit != nil && len(res.Groups) > *limit {
+		res.Groups = res.Groups[:*limit]
+	}
+
+	return res
+}
+
+func groupMetric(group aggregation.Group, order *aggregation.GroupByOrder,
+) (float64, bool) {
+	if order.Property == "" {
+		return float64(group.Count), true
+	}
+
+	prop, ok := group.Properties[order.Property.String()]
+	if !ok {
+		return 0, false
+	}
+
+	switch prop.Type {
+	case aggregation.PropertyTypeNumerical:
+		return metricToFloat64(prop.NumericalAggregations[order.Aggregator])
+	case aggregation.PropertyTypeDate:
+		return metricToFloat64(prop.DateAggregations[order.Aggregator])
+	case aggregation.PropertyTypeBoolean:
+		switch order.Aggregator {
+		case aggregation.CountAggregator.String():
+			return float64(prop.BooleanAggregation.Count), true
+		case aggregation.TotalTrueAggregator.String():
+			return float64(prop.BooleanAggregation.TotalTrue), true
+		case aggregation.TotalFalseAggregator.String():
+			return float64(prop.BooleanAggregation.TotalFalse), true
+		case aggregation.PercentageTrueAggregator.String():
+			return prop.BooleanAggregation.PercentageTrue, true
+		case aggregation.PercentageFalseAggregator.String():
+			return prop.BooleanAggregation.PercentageFalse, true
+		}
+	case aggregation.PropertyTypeText:
+		switch order.Aggregator {
+		case aggregation.CountAggregator.String():
+			return float64(prop.TextAggregation.Count), true
+		case aggregation.DistinctCountAggregator.String():
+			return float64(prop.TextAggregation.DistinctCount), true
+		}
+	}
+
+	return 0, false
+}
+
+func metricToFloat64(value interface{}) (float64, bool) {
+	switch typed := value.(type) {
+	case float64:
+		return typed, true
+	case int:
+		return float64(typed), true
+	case int64:
+		return float64(typed), true
+	case string:
+		// dates are compared by their unix time
+		t, err := time.Parse(time.RFC3339Nano, typed)
+		if err != nil {
+			return 0, false
+		}
+		return float64(t.UnixNano()), true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,115 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package aggregator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/aggregation"
+)
+
+func TestOrderGroups(t *testing.T) {
+	ptLimit := func(l int) *int { return &l }
+	group := func(value string, count int, mean interface{}) aggregation.Group {
+		g := aggregation.Group{
+			GroupedBy: &aggregation.GroupedBy{Value: value, Path: []string{"name"}},
+			Count:     count,
+		}
+		if mean != nil {
+			g.Properties = map[string]aggregation.Property{
+				"price": {
+					Type:                  aggregation.PropertyTypeNumerical,
+					NumericalAggregations: map[string]interface{}{"mean": mean},
+				},
+			}
+		}
+		return g
+	}
+	groups := func() []aggregation.Group {
+		return []aggregation.Group{
+			group("a", 3, 10.0), group("b", 2, nil), group("c", 1, 30.0), group("d", 1, 20.0),
+		}
+	}
+	values := func(res *aggregation.Result) []interface{} {
+		out := make([]interface{}, len(res.Groups))
+		for i, g := range res.Groups {
+			out[i] = g.GroupedBy.Value
+		}
+		return out
+	}
+
+	tests := []struct {
+		name     string
+		order    *aggregation.GroupByOrder
+		limit    *int
+		expected []interface{}
+	}{
+		{
+			name:     "default order keeps the order of the combiner",
+			expected: []interface{}{"a", "b", "c", "d"},
+		},
+		{
+			name:     "default order with limit",
+			limit:    ptLimit(2),
+			expected: []interface{}{"a", "b"},
+		},
+		{
+			name:     "by count ascending",
+			order:    &aggregation.GroupByOrder{Ascending: true},
+			expected: []interface{}{"c", "d", "b", "a"},
+		},
+		{
+			name:     "by metric descending, missing metrics last",
+			order:    &aggregation.GroupByOrder{Property: "price", Aggregator: "mean"},
+			expected: []interface{}{"c", "d", "a", "b"},
+		},
+		{
+			name:     "by metric ascending with limit",
+			order:    &aggregation.GroupByOrder{Property: "price", Aggregator: "mean", Ascending: true},
+			limit:    ptLimit(3),
+			expected: []interface{}{"a", "d", "c"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res := OrderGroups(&aggregation.Result{Groups: groups()}, test.order, test.limit)
+			assert.Equal(t, test.expected, values(res))
+		})
+	}
+
+	t.Run("ungrouped results are not limited", func(t *testing.T) {
+		res := OrderGroups(&aggregation.Result{Groups: []aggregation.Group{{Count: 3}}}, nil, ptLimit(0))
+		assert.Len(t, res.Groups, 1)
+	})
+
+	t.Run("by date", func(t *testing.T) {
+		dateGroup := func(value, min string) aggregation.Group {
+			return aggregation.Group{
+				GroupedBy: &aggregation.GroupedBy{Value: value},
+				Properties: map[string]aggregation.Property{
+					"released": {
+						Type:             aggregation.PropertyTypeDate,
+						DateAggregations: map[string]interface{}{"minimum": min},
+					},
+				},
+			}
+		}
+		res := OrderGroups(&aggregation.Result{Groups: []aggregation.Group{
+			dateGroup("a", "2022-06-16T00:00:00Z"),
+			dateGroup("b", "2022-06-16T00:00:00.5Z"),
+			dateGroup("c", "2021-01-01T00:00:00+02:00"),
+		}}, &aggregation.GroupByOrder{Property: "released", Aggregator: "minimum"}, nil)
+		assert.Equal(t, []interface{}{"b", "a", "c"}, values(res))
+	})
+}
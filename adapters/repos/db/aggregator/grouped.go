@@ -65,6 +65,11 @@ func (ga *groupedAggregator) identifyGroups(ctx context.Context) ([]group, error
 	if ga.params.Limit != nil {
 		limit = *ga.params.Limit
 	}
+	if !ga.params.GroupByOrder.ByCountDescending() {
+		// the top groups can only be selected once all groups are aggregated,
+		// and the groups of all shards are combined, see OrderGroups
+		limit = -1
+	}
 	return newGrouper(ga.Aggregator, limit).Do(ctx)
 }
 
@@ -20,6 +20,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
 	"github.com/weaviate/weaviate/entities/aggregation"
+	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/storobj"
 	"github.com/weaviate/weaviate/usecases/traverser/hybrid"
@@ -31,22 +32,29 @@ import (
 // additionally performs an aggregation for each group.
 type grouper struct {
 	*Aggregator
+	paths     []*filters.Path
 	values    map[interface{}]map[uint64]struct{} // map[value][docID]struct, to keep docIds unique
+	keys      map[interface{}][]interface{}       // the values of all paths of a group
 	topGroups []group
-	limit     int
+	// the number of groups to select, all groups are selected if it is negative
+	limit int
 }
 
 func newGrouper(a *Aggregator, limit int) *grouper {
 	return &grouper{
 		Aggregator: a,
+		paths:      a.params.GroupByPaths(),
 		values:     map[interface{}]map[uint64]struct{}{},
+		keys:       map[interface{}][]interface{}{},
 		limit:      limit,
 	}
 }
 
 func (g *grouper) Do(ctx context.Context) ([]group, error) {
-	if len(g.params.GroupBy.Slice()) > 1 {
-		return nil, fmt.Errorf("grouping by cross-refs not supported")
+	for _, path := range g.paths {
+		if len(path.Slice()) > 1 {
+			return nil, fmt.Errorf("grouping by cross-refs not supported")
+		}
 	}
 
 	if g.params.Filters == nil && len(g.params.SearchVector) == 0 && g.params.Hybrid == nil {
@@ -76,7 +84,7 @@ func (g *grouper) groupFiltered(ctx context.Context) ([]group, error) {
 	if err := docid.ScanObjectsLSM(g.store, ids,
 		func(prop *models.PropertySchema, docID uint64) (bool, error) {
 			return true, g.addElementById(prop, docID)
-		}, []string{g.params.GroupBy.Property.String()}); err != nil {
+		}, g.propNames()); err != nil {
 		return nil, err
 	}
 
@@ -152,53 +160,102 @@ func (g *grouper) hybrid(ctx context.Context, allowList helpers.AllowList) ([]ui
 	return ids, nil
 }
 
+func (g *grouper) propNames() []string {
+	names := make([]string, len(g.paths))
+	for i, path := range g.paths {
+		names[i] = path.Property.String()
+	}
+	return names
+}
+
 func (g *grouper) addElementById(s *models.PropertySchema, docID uint64) error {
 	if s == nil {
 		return nil
 	}
 
-	item, ok := (*s).(map[string]interface{})[g.params.GroupBy.Property.String()]
-	if !ok {
-		return nil
+	// a doc is added to every combination of the values of its props, as
+	// props with multiple values belong to a group for each of their values
+	combinations := [][]interface{}{{}}
+	for _, path := range g.paths {
+		item, ok := (*s).(map[string]interface{})[path.Property.String()]
+		if !ok {
+			return nil
+		}
+
+		values := groupValues(item)
+		next := make([][]interface{}, 0, len(combinations)*len(values))
+		for _, combination := range combinations {
+			for _, value := range values {
+				next = append(next, append(combination[:len(combination):len(combination)], value))
+			}
+		}
+		combinations = next
+	}
+
+	for _, combination := range combinations {
+		g.addItem(combination, docID)
 	}
 
+	return nil
+}
+
+func groupValues(item interface{}) []interface{} {
 	switch val := item.(type) {
 	case []string:
+		out := make([]interface{}, len(val))
 		for i := range val {
-			g.addItem(val[i], docID)
+			out[i] = val[i]
 		}
+		return out
 	case []float64:
+		out := make([]interface{}, len(val))
 		for i := range val {
-			g.addItem(val[i], docID)
+			out[i] = val[i]
 		}
+		return out
 	case []bool:
+		out := make([]interface{}, len(val))
 		for i := range val {
-			g.addItem(val[i], docID)
+			out[i] = val[i]
 		}
+		return out
 	case []interface{}:
-		for i := range val {
-			g.addItem(val[i], docID)
-		}
+		return val
 	case models.MultipleRef:
+		out := make([]interface{}, len(val))
 		for i := range val {
-			g.addItem(val[i].Beacon, docID)
+			out[i] = val[i].Beacon
 		}
+		return out
 	default:
-		g.addItem(val, docID)
+		return []interface{}{val}
 	}
-
-	return nil
 }
 
-func (g *grouper) addItem(item interface{}, docID uint64) {
+func (g *grouper) addItem(values []interface{}, docID uint64) {
+	// a single value is used as the key directly, combinations of several
+	// values are not comparable and need a key representing them
+	var item interface{} = values[0]
+	if len(values) > 1 {
+		item = groupKey(values)
+	}
+
 	idsMap, ok := g.values[item]
 	if !ok {
 		idsMap = map[uint64]struct{}{}
+		g.keys[item] = values
 	}
 	idsMap[docID] = struct{}{}
 	g.values[item] = idsMap
 }
 
+// groupKey identifies a group of several values. The values are formatted
+// with their types, so that e.g. the text "1" and the number 1 are not
+// considered equal.
+func groupKey(values []interface{}) string {
+	return fmt.Sprintf("%#v", values)
+}
+
 func (g *grouper) aggregateAndSelect() ([]group, error) {
 	for value, idsMap := range g.values {
 		count := len(idsMap)
@@ -210,21 +267,40 @@ func (g *grouper) aggregateAndSelect() ([]group, error) {
 			i++
 		}
 
-		g.insertOrdered(group{
+		elem := group{
 			res: aggregation.Group{
-				GroupedBy: &aggregation.GroupedBy{
-					Path:  g.params.GroupBy.Slice(),
-					Value: value,
-				},
-				Count: count,
+				GroupedBy: g.groupedBy(value),
+				Count:     count,
 			},
 			docIDs: ids,
-		})
+		}
+		if g.limit < 0 {
+			g.topGroups = append(g.topGroups, elem)
+		} else {
+			g.insertOrdered(elem)
+		}
 	}
 
 	return g.topGroups, nil
 }
 
+func (g *grouper) groupedBy(value interface{}) *aggregation.GroupedBy {
+	out := &aggregation.GroupedBy{}
+	if len(g.paths) == 1 {
+		out.Path = g.paths[0].Slice()
+		out.Value = value
+	}
+
+	if len(g.params.GroupByProperties) > 0 {
+		values := g.keys[value]
+		out.Keys = make([]aggregation.GroupedByKey, len(g.paths))
+		for i, path := range g.paths {
+			out.Keys[i] = aggregation.GroupedByKey{Path: path.Slice(), Value: values[i]}
+		}
+	}
+	return out
+}
+
 func (g *grouper) insertOrdered(elem group) {
 	if len(g.topGroups) == 0 {
 		g.topGroups = []group{elem}
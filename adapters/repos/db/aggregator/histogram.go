@@ -0,0 +1,110 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package aggregator
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/weaviate/weaviate/entities/aggregation"
+)
+
+// numericalHistogram counts the values in buckets of a fixed interval. The
+// key of a bucket is its lower bound, which is a multiple of the interval.
+// Only buckets containing values are returned, so the number of buckets is
+// bounded by the number of distinct values. The pairs must be sorted.
+func numericalHistogram(pairs []floatCountPair, interval float64,
+) []aggregation.HistogramBucket {
+	out := []aggregation.HistogramBucket{}
+	for _, pair := range pairs {
+		key := math.Floor(pair.value/interval) * interval
+		if len(out) > 0 && out[len(out)-1].Key == key {
+			out[len(out)-1].Count += int(pair.count)
+			continue
+		}
+		out = append(out, aggregation.HistogramBucket{Key: key, Count: int(pair.count)})
+	}
+	return out
+}
+
+// dateHistogram is the equivalent of numericalHistogram for dates, the
+// buckets start at multiples of the interval since the unix epoch
+func dateHistogram(pairs []timestampCountPair, interval time.Duration,
+) []aggregation.HistogramBucket {
+	out := []aggregation.HistogramBucket{}
+	prevStart := int64(math.MinInt64)
+	for _, pair := range pairs {
+		start := floorDiv(pair.value.epochNano, int64(interval)) * int64(interval)
+		if len(out) > 0 && start == prevStart {
+			out[len(out)-1].Count += int(pair.count)
+			continue
+		}
+		out = append(out, aggregation.HistogramBucket{
+			Key:   newTimestamp(start).rfc3339,
+			Count: int(pair.count),
+		})
+		prevStart = start
+	}
+	return out
+}
+
+func floorDiv(a, b int64) int64 {
+	q := a / b
+	if a%b != 0 && (a < 0) != (b < 0) {
+		q--
+	}
+	return q
+}
+
+// intervalSecondsToDuration converts the interval of a date histogram
+func intervalSecondsToDuration(seconds float64) time.Duration {
+	return time.Duration(math.Round(seconds * float64(time.Second)))
+}
+
+// mergeHistograms adds up the buckets of the histograms of several shards.
+// As the buckets of all shards start at multiples of the same interval, the
+// buckets with the same key cover the same values.
+func mergeHistograms(first, second []aggregation.HistogramBucket,
+) []aggregation.HistogramBucket {
+	counts := make(map[interface{}]int, len(first)+len(second))
+	for _, bucket := range first {
+		counts[bucket.Key] += bucket.Count
+	}
+	for _, bucket := range second {
+		counts[bucket.Key] += bucket.Count
+	}
+
+	out := make([]aggregation.HistogramBucket, 0, len(counts))
+	for key, count := range counts {
+		out = append(out, aggregation.HistogramBucket{Key: key, Count: count})
+	}
+	sort.Slice(out, func(a, b int) bool {
+		return histogramKeyLess(out[a].Key, out[b].Key)
+	})
+	return out
+}
+
+func histogramKeyLess(a, b interface{}) bool {
+	switch aTyped := a.(type) {
+	case float64:
+		return aTyped < b.(float64)
+	case string:
+		// the keys of date buckets are formatted as RFC3339 with nanoseconds,
+		// which omits trailing zeros, so they can't be compared as strings
+		aTime, _ := time.Parse(time.RFC3339Nano, aTyped)
+		bTime, _ := time.Parse(time.RFC3339Nano, b.(string))
+		return aTime.Before(bTime)
+	default:
+		return false
+	}
+}
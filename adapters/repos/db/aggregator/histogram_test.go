@@ -0,0 +1,106 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package aggregator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/aggregation"
+)
+
+func TestNumericalHistogram(t *testing.T) {
+	agg := newNumericalAggregator()
+	for _, num := range []float64{-7, -0.5, 0, 3, 9.99, 10, 42, 42} {
+		agg.AddFloat64(num)
+	}
+	agg.buildPairsFromCounts()
+
+	interval := 10.0
+	prop := aggregation.Property{}
+	addNumericalAggregations(&prop,
+		[]aggregation.Aggregator{aggregation.NewHistogramAggregator(&interval)}, agg)
+
+	assert.Equal(t, []aggregation.HistogramBucket{
+		{Key: -10.0, Count: 2},
+		{Key: 0.0, Count: 3},
+		{Key: 10.0, Count: 1},
+		{Key: 40.0, Count: 2},
+	}, prop.NumericalAggregations["histogram"])
+}
+
+func TestDateHistogram(t *testing.T) {
+	agg := newDateAggregator()
+	for _, date := range []string{
+		"1969-12-31T23:00:00Z",
+		"2022-06-16T00:00:00Z",
+		"2022-06-16T18:30:00.451235Z",
+		"2022-06-18T10:00:00+02:00",
+	} {
+		require.Nil(t, agg.AddTimestamp(date))
+	}
+	agg.buildPairsFromCounts()
+
+	interval := (24 * time.Hour).Seconds()
+	prop := aggregation.Property{}
+	addDateAggregations(&prop,
+		[]aggregation.Aggregator{aggregation.NewHistogramAggregator(&interval)}, agg)
+
+	assert.Equal(t, []aggregation.HistogramBucket{
+		{Key: "1969-12-31T00:00:00Z", Count: 1},
+		{Key: "2022-06-16T00:00:00Z", Count: 2},
+		{Key: "2022-06-18T00:00:00Z", Count: 1},
+	}, prop.DateAggregations["histogram"])
+}
+
+func TestShardCombinerMergeHistograms(t *testing.T) {
+	t.Run("numbers", func(t *testing.T) {
+		first := map[string]interface{}{
+			"histogram": []aggregation.HistogramBucket{{Key: 0.0, Count: 1}, {Key: 20.0, Count: 2}},
+		}
+		second := map[string]interface{}{
+			"histogram": []aggregation.HistogramBucket{{Key: 10.0, Count: 3}, {Key: 20.0, Count: 4}},
+		}
+
+		NewShardCombiner().mergeNumericalProp(first, second)
+		assert.Equal(t, []aggregation.HistogramBucket{
+			{Key: 0.0, Count: 1},
+			{Key: 10.0, Count: 3},
+			{Key: 20.0, Count: 6},
+		}, first["histogram"])
+	})
+
+	t.Run("dates", func(t *testing.T) {
+		first := map[string]interface{}{}
+		second := map[string]interface{}{
+			"histogram": []aggregation.HistogramBucket{
+				{Key: "2022-06-16T00:00:00.5Z", Count: 1},
+			},
+		}
+		third := map[string]interface{}{
+			"histogram": []aggregation.HistogramBucket{
+				{Key: "2022-06-16T00:00:00Z", Count: 2},
+				{Key: "2022-06-16T00:00:00.5Z", Count: 3},
+			},
+		}
+
+		sc := NewShardCombiner()
+		sc.mergeDateProp(first, second)
+		sc.mergeDateProp(first, third)
+		assert.Equal(t, []aggregation.HistogramBucket{
+			{Key: "2022-06-16T00:00:00Z", Count: 2},
+			{Key: "2022-06-16T00:00:00.5Z", Count: 4},
+		}, first["histogram"])
+	})
+}
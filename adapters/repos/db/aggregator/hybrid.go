@@ -25,7 +25,7 @@ import (
 func (a *Aggregator) buildHybridKeywordRanking() (*searchparams.KeywordRanking, error) {
 	kw := &searchparams.KeywordRanking{
 		Type:  "bm25",
-		Query: a.params.Hybrid.Query,
+		Query: a.params.Hybrid.GetKeywordQuery(),
 	}
 
 	cl, err := schema.GetClassByName(
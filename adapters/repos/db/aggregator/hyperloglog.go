@@ -0,0 +1,99 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package aggregator
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"math/bits"
+
+	"github.com/spaolacci/murmur3"
+)
+
+// hyperLogLogPrecision is the number of bits of the hash used to select a
+// register. 2^12 registers have a standard error of about 1.6%.
+const hyperLogLogPrecision = 12
+
+const hyperLogLogRegisters = 1 << hyperLogLogPrecision
+
+// hyperLogLog estimates the number of distinct values from the maximum number
+// of leading zeros of their hashes. Unlike a set of the values, sketches of
+// the same size can be merged, e.g. to combine the distinct counts of several
+// shards.
+type hyperLogLog struct {
+	registers []uint8
+}
+
+func newHyperLogLog() *hyperLogLog {
+	return &hyperLogLog{registers: make([]uint8, hyperLogLogRegisters)}
+}
+
+func (h *hyperLogLog) AddString(value string) {
+	h.addHash(murmur3.Sum64([]byte(value)))
+}
+
+func (h *hyperLogLog) AddFloat64(value float64) {
+	buf := make([]byte, 8)
+	binary.LittleEndian.PutUint64(buf, math.Float64bits(value))
+	h.addHash(murmur3.Sum64(buf))
+}
+
+func (h *hyperLogLog) addHash(hash uint64) {
+	register := hash >> (64 - hyperLogLogPrecision)
+	// the remaining bits, with a sentinel bit so the rank is bounded
+	rest := hash<<hyperLogLogPrecision | 1<<(hyperLogLogPrecision-1)
+	rank := uint8(bits.LeadingZeros64(rest)) + 1
+	if rank > h.registers[register] {
+		h.registers[register] = rank
+	}
+}
+
+func (h *hyperLogLog) Merge(other *hyperLogLog) {
+	for i, rank := range other.registers {
+		if rank > h.registers[i] {
+			h.registers[i] = rank
+		}
+	}
+}
+
+// Count estimates the number of distinct values. Small cardinalities, for
+// which many registers are still empty, are estimated by linear counting.
+func (h *hyperLogLog) Count() int {
+	m := float64(hyperLogLogRegisters)
+	sum, empty := 0.0, 0
+	for _, rank := range h.registers {
+		sum += math.Ldexp(1, -int(rank))
+		if rank == 0 {
+			empty++
+		}
+	}
+
+	alpha := 0.7213 / (1 + 1.079/m)
+	estimate := alpha * m * m / sum
+	if estimate <= 2.5*m && empty > 0 {
+		estimate = m * math.Log(m/float64(empty))
+	}
+	return int(math.Round(estimate))
+}
+
+func (h *hyperLogLog) Marshal() []byte {
+	return append([]byte{}, h.registers...)
+}
+
+func unmarshalHyperLogLog(data []byte) (*hyperLogLog, error) {
+	if len(data) != hyperLogLogRegisters {
+		return nil, fmt.Errorf("hyperloglog sketch has %d registers, expected %d",
+			len(data), hyperLogLogRegisters)
+	}
+	return &hyperLogLog{registers: append([]uint8{}, data...)}, nil
+}
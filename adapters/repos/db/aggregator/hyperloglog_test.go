@@ -0,0 +1,65 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package aggregator
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHyperLogLog(t *testing.T) {
+	t.Run("empty", func(t *testing.T) {
+		assert.Equal(t, 0, newHyperLogLog().Count())
+	})
+
+	t.Run("duplicates are counted once", func(t *testing.T) {
+		h := newHyperLogLog()
+		for i := 0; i < 100; i++ {
+			h.AddString("a")
+			h.AddString("b")
+			h.AddFloat64(1.5)
+		}
+		assert.Equal(t, 3, h.Count())
+	})
+
+	for _, n := range []int{1000, 100_000} {
+		t.Run(fmt.Sprintf("%d distinct values", n), func(t *testing.T) {
+			h := newHyperLogLog()
+			for i := 0; i < n; i++ {
+				h.AddString(fmt.Sprintf("value-%d", i))
+			}
+			assert.InEpsilon(t, n, h.Count(), 0.05)
+		})
+	}
+
+	t.Run("merge", func(t *testing.T) {
+		first, second := newHyperLogLog(), newHyperLogLog()
+		for i := 0; i < 10_000; i++ {
+			first.AddFloat64(float64(i))
+			// half of the values overlap
+			second.AddFloat64(float64(i + 5_000))
+		}
+
+		marshalled, err := unmarshalHyperLogLog(second.Marshal())
+		require.Nil(t, err)
+		first.Merge(marshalled)
+		assert.InEpsilon(t, 15_000, first.Count(), 0.05)
+	})
+
+	t.Run("invalid sketch", func(t *testing.T) {
+		_, err := unmarshalHyperLogLog([]byte{1, 2, 3})
+		assert.NotNil(t, err)
+	})
+}
@@ -45,13 +45,21 @@ func addNumericalAggregations(prop *aggregation.Property,
 loop:
 	for _, aProp := range aggs {
 		switch aProp {
-		case aggregation.ModeAggregator, aggregation.MedianAggregator, aggregation.MeanAggregator:
+		case aggregation.ModeAggregator, aggregation.MedianAggregator, aggregation.MeanAggregator,
+			aggregation.StandardDeviationAggregator, aggregation.Percentile50Aggregator,
+			aggregation.Percentile90Aggregator, aggregation.Percentile99Aggregator,
+			aggregation.DistinctCountAggregator:
 			prop.NumericalAggregations["_numericalAggregator"] = agg
 			break loop
 		}
 	}
 
 	for _, aProp := range aggs {
+		if aProp.Type == aggregation.HistogramType && aProp.Interval != nil {
+			prop.NumericalAggregations[aProp.Type] = numericalHistogram(agg.pairs, *aProp.Interval)
+			continue
+		}
+
 		switch aProp {
 		case aggregation.MeanAggregator:
 			prop.NumericalAggregations[aProp.String()] = agg.Mean()
@@ -67,12 +75,27 @@ loop:
 			prop.NumericalAggregations[aProp.String()] = agg.Sum()
 		case aggregation.CountAggregator:
 			prop.NumericalAggregations[aProp.String()] = agg.Count()
+		case aggregation.StandardDeviationAggregator:
+			prop.NumericalAggregations[aProp.String()] = agg.StandardDeviation()
+		case aggregation.Percentile50Aggregator, aggregation.Percentile90Aggregator,
+			aggregation.Percentile99Aggregator:
+			prop.NumericalAggregations[aProp.String()] = agg.Percentile(percentiles[aProp.String()])
+		case aggregation.DistinctCountAggregator:
+			prop.NumericalAggregations[aProp.String()] = agg.DistinctCount()
 		default:
 			continue
 		}
 	}
 }
 
+// percentiles maps the percentile aggregators to the share of values below
+// the percentile
+var percentiles = map[string]float64{
+	aggregation.Percentile50Aggregator.String(): 0.5,
+	aggregation.Percentile90Aggregator.String(): 0.9,
+	aggregation.Percentile99Aggregator.String(): 0.99,
+}
+
 func newNumericalAggregator() *numericalAggregator {
 	return &numericalAggregator{
 		min:          math.MaxFloat64,
@@ -91,6 +114,7 @@ type numericalAggregator struct {
 	mode         float64
 	pairs        []floatCountPair   // for row-based median calculation
 	valueCounter map[float64]uint64 // for individual median calculation
+	digest       *tDigest           // for percentiles, built from the pairs
 }
 
 type floatCountPair struct {
@@ -105,6 +129,7 @@ func (a *numericalAggregator) AddFloat64(value float64) error {
 // turns the value counter into a sorted list, as well as identifying the mode. Must be called before calling median etc
 func (a *numericalAggregator) buildPairsFromCounts() {
 	a.pairs = a.pairs[:0] // clear out old values in case this function called more than once
+	a.digest = nil
 	a.pairs = append(a.pairs, make([]floatCountPair, 0, len(a.valueCounter))...)
 
 	for value, count := range a.valueCounter {
@@ -224,3 +249,42 @@ func (a *numericalAggregator) Median() float64 {
 	}
 	panic("Couldn't determine median. This should never happen. Did you add values and call buildRows before?")
 }
+
+// StandardDeviation is the population standard deviation. Like Median, it
+// requires the pairs to be built.
+func (a *numericalAggregator) StandardDeviation() float64 {
+	if a.count == 0 {
+		return 0
+	}
+
+	mean := a.Mean()
+	squaredDiffs := 0.0
+	for _, pair := range a.pairs {
+		diff := pair.value - mean
+		squaredDiffs += diff * diff * float64(pair.count)
+	}
+	return math.Sqrt(squaredDiffs / float64(a.count))
+}
+
+// Percentile estimates the value below which the share p of all values lies
+// using a t-digest, so that it doesn't have to be calculated from the exact
+// distribution. Like Median, it requires the pairs to be built.
+func (a *numericalAggregator) Percentile(p float64) float64 {
+	if a.digest == nil {
+		a.digest = newTDigest()
+		for _, pair := range a.pairs {
+			a.digest.Add(pair.value, pair.count)
+		}
+	}
+	return a.digest.Quantile(p)
+}
+
+// DistinctCount estimates the number of distinct values using HyperLogLog,
+// the same way as for text props
+func (a *numericalAggregator) DistinctCount() float64 {
+	hll := newHyperLogLog()
+	for value := range a.valueCounter {
+		hll.AddFloat64(value)
+	}
+	return float64(hll.Count())
+}
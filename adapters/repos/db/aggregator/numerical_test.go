@@ -202,3 +202,27 @@ func TestNumericalAggregator_MinMaxCalculation(t *testing.T) {
 		})
 	}
 }
+
+func TestNumericalAggregator_StandardDeviationAndPercentiles(t *testing.T) {
+	agg := newNumericalAggregator()
+	for _, num := range []float64{2, 4, 4, 4, 5, 5, 7, 9} {
+		agg.AddFloat64(num)
+	}
+	agg.buildPairsFromCounts() // needed to populate all required info
+
+	assert.Equal(t, 2.0, agg.StandardDeviation())
+	assert.Equal(t, 4.5, agg.Percentile(0.5))
+	assert.Equal(t, 9.0, agg.Percentile(1))
+	assert.Equal(t, 5.0, agg.DistinctCount())
+
+	t.Run("rows", func(t *testing.T) {
+		agg := newNumericalAggregator()
+		agg.AddNumberRow(1, 99)
+		agg.AddNumberRow(100, 1)
+		agg.buildPairsFromCounts()
+
+		assert.InDelta(t, 9.8504, agg.StandardDeviation(), 0.0001)
+		assert.Equal(t, 1.0, agg.Percentile(0.9))
+		assert.Equal(t, 2.0, agg.DistinctCount())
+	})
+}
@@ -67,7 +67,7 @@ func (sc *ShardCombiner) combineGrouped(results []*aggregation.Result) *aggregat
 
 	for _, shard := range results {
 		for _, shardGroup := range shard.Groups {
-			pos := getPosOfGroup(combined.Groups, shardGroup.GroupedBy.Value)
+			pos := getPosOfGroup(combined.Groups, groupedByKey(shardGroup.GroupedBy))
 			if pos < 0 {
 				combined.Groups = append(combined.Groups, shardGroup)
 			} else {
@@ -192,6 +192,8 @@ func (sc *ShardCombiner) mergeDateProp(first, second map[string]interface{}) {
 					first["maximum"] = value
 				}
 			}
+		case "histogram":
+			sc.mergeHistogram(first, value)
 		case "_dateAggregator":
 			continue
 		default:
@@ -236,6 +238,15 @@ func (sc *ShardCombiner) mergeNumericalProp(first, second map[string]interface{}
 		case "mode":
 			numAggFirst := first["_numericalAggregator"].(*numericalAggregator)
 			first[propType] = numAggFirst.Mode()
+		case "standardDeviation":
+			numAggFirst := first["_numericalAggregator"].(*numericalAggregator)
+			first[propType] = numAggFirst.StandardDeviation()
+		case "p50", "p90", "p99":
+			numAggFirst := first["_numericalAggregator"].(*numericalAggregator)
+			first[propType] = numAggFirst.Percentile(percentiles[propType])
+		case "distinctCount":
+			numAggFirst := first["_numericalAggregator"].(*numericalAggregator)
+			first[propType] = numAggFirst.DistinctCount()
 		case "mean":
 			numAggFirst := first["_numericalAggregator"].(*numericalAggregator)
 			first[propType] = numAggFirst.Mean()
@@ -250,6 +261,8 @@ func (sc *ShardCombiner) mergeNumericalProp(first, second map[string]interface{}
 			if _, ok := first["maximum"]; !ok || value.(float64) > first["maximum"].(float64) {
 				first["maximum"] = value
 			}
+		case "histogram":
+			sc.mergeHistogram(first, value)
 		case "_numericalAggregator":
 			continue
 		default:
@@ -258,6 +271,15 @@ func (sc *ShardCombiner) mergeNumericalProp(first, second map[string]interface{}
 	}
 }
 
+func (sc *ShardCombiner) mergeHistogram(first map[string]interface{}, value interface{}) {
+	second := value.([]aggregation.HistogramBucket)
+	if val, ok := first["histogram"]; ok {
+		first["histogram"] = mergeHistograms(val.([]aggregation.HistogramBucket), second)
+	} else {
+		first["histogram"] = second
+	}
+}
+
 func (sc *ShardCombiner) finalizeDateProp(combined map[string]interface{}) {
 	delete(combined, "_dateAggregator")
 }
@@ -279,6 +301,7 @@ func (sc *ShardCombiner) finalizeBoolean(combined *aggregation.Boolean) {
 
 func (sc *ShardCombiner) mergeTextProp(first, second *aggregation.Text) {
 	first.Count += second.Count
+	sc.mergeTextDistinctCount(first, second)
 
 	for _, textOcc := range second.Items {
 		pos := getPosOfTextOcc(first.Items, textOcc.Value)
@@ -290,11 +313,37 @@ func (sc *ShardCombiner) mergeTextProp(first, second *aggregation.Text) {
 	}
 }
 
+func (sc *ShardCombiner) mergeTextDistinctCount(first, second *aggregation.Text) {
+	if second.DistinctCountSketch == nil {
+		return
+	}
+
+	if first.DistinctCountSketch == nil {
+		first.DistinctCount = second.DistinctCount
+		first.DistinctCountSketch = second.DistinctCountSketch
+		return
+	}
+
+	combined, err := unmarshalHyperLogLog(first.DistinctCountSketch)
+	if err != nil {
+		panic("invalid distinct count sketch: " + err.Error())
+	}
+	source, err := unmarshalHyperLogLog(second.DistinctCountSketch)
+	if err != nil {
+		panic("invalid distinct count sketch: " + err.Error())
+	}
+	combined.Merge(source)
+
+	first.DistinctCount = combined.Count()
+	first.DistinctCountSketch = combined.Marshal()
+}
+
 func (sc *ShardCombiner) mergeRefProp(first, second *aggregation.Reference) {
 	first.PointingTo = append(first.PointingTo, second.PointingTo...)
 }
 
 func (sc *ShardCombiner) finalizeText(combined *aggregation.Text) {
+	combined.DistinctCountSketch = nil
 	sort.Slice(combined.Items, func(a, b int) bool {
 		return combined.Items[a].Occurs > combined.Items[b].Occurs
 	})
@@ -330,9 +379,22 @@ func (sc *ShardCombiner) finalizeGroup(group *aggregation.Group) {
 	}
 }
 
+// groupedByKey identifies a group across shards, see grouper.addItem
+func groupedByKey(groupedBy *aggregation.GroupedBy) interface{} {
+	if len(groupedBy.Keys) < 2 {
+		return groupedBy.Value
+	}
+
+	values := make([]interface{}, len(groupedBy.Keys))
+	for i, key := range groupedBy.Keys {
+		values[i] = key.Value
+	}
+	return groupKey(values)
+}
+
 func getPosOfGroup(haystack []aggregation.Group, needle interface{}) int {
 	for i, elem := range haystack {
-		if elem.GroupedBy.Value == needle {
+		if groupedByKey(elem.GroupedBy) == needle {
 			return i
 		}
 	}
@@ -132,6 +132,34 @@ func TestShardCombinerMergeNumerical(t *testing.T) {
 	}
 }
 
+func TestShardCombinerMergeTextDistinctCount(t *testing.T) {
+	aggs := []aggregation.Aggregator{aggregation.DistinctCountAggregator}
+	shardResult := func(texts ...string) *aggregation.Result {
+		agg := newTextAggregator(5)
+		for _, text := range texts {
+			agg.AddText(text)
+		}
+		res := agg.Res()
+		addTextDistinctCount(&res, aggs, agg)
+		return &aggregation.Result{Groups: []aggregation.Group{{
+			Count: len(texts),
+			Properties: map[string]aggregation.Property{
+				"name": {Type: aggregation.PropertyTypeText, TextAggregation: res},
+			},
+		}}}
+	}
+
+	combined := NewShardCombiner().Do([]*aggregation.Result{
+		shardResult("a", "b", "c", "c"),
+		shardResult("c", "d"),
+		shardResult(),
+	})
+
+	text := combined.Groups[0].Properties["name"].TextAggregation
+	assert.Equal(t, 4, text.DistinctCount)
+	assert.Nil(t, text.DistinctCountSketch)
+}
+
 func TestShardCombinerMergeNil(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -221,6 +249,9 @@ func testNumbers(t *testing.T, numbers1, numbers2 []float64, testMode bool) {
 	assert.Equal(t, len(numbers1)+len(numbers2), int(numberMap1["count"].(float64)))
 	assert.InDelta(t, combinedMap["mean"], numberMap1["mean"], 0.0001)
 	assert.InDelta(t, combinedMap["median"], numberMap1["median"], 0.0001)
+	assert.InDelta(t, combinedMap["standardDeviation"], numberMap1["standardDeviation"], 0.0001)
+	assert.InDelta(t, combinedMap["p90"], numberMap1["p90"], 0.0001)
+	assert.Equal(t, combinedMap["distinctCount"], numberMap1["distinctCount"])
 	if testMode { // for random numbers the mode is flaky as there is no guaranteed order if several values have the same count
 		assert.Equal(t, combinedMap["mode"], numberMap1["mode"])
 	}
@@ -234,7 +265,10 @@ func createNumericalAgg(numbers []float64) map[string]interface{} {
 	agg.buildPairsFromCounts() // needed to populate all required info
 
 	prop := aggregation.Property{}
-	aggs := []aggregation.Aggregator{aggregation.MedianAggregator, aggregation.MeanAggregator, aggregation.ModeAggregator, aggregation.CountAggregator}
+	aggs := []aggregation.Aggregator{
+		aggregation.MedianAggregator, aggregation.MeanAggregator, aggregation.ModeAggregator, aggregation.CountAggregator,
+		aggregation.StandardDeviationAggregator, aggregation.Percentile90Aggregator, aggregation.DistinctCountAggregator,
+	}
 	addNumericalAggregations(&prop, aggs, agg)
 	return prop.NumericalAggregations
 }
@@ -247,3 +281,23 @@ func createRandomSlice() []float64 {
 	}
 	return array
 }
+
+func TestShardCombinerGroupedByMultipleProperties(t *testing.T) {
+	group := func(name string, inStock bool, count int) aggregation.Group {
+		return aggregation.Group{
+			GroupedBy: &aggregation.GroupedBy{Keys: []aggregation.GroupedByKey{
+				{Path: []string{"name"}, Value: name},
+				{Path: []string{"inStock"}, Value: inStock},
+			}},
+			Count: count,
+		}
+	}
+
+	combined := NewShardCombiner().Do([]*aggregation.Result{
+		{Groups: []aggregation.Group{group("a", true, 1), group("a", false, 2)}},
+		{Groups: []aggregation.Group{group("a", false, 3), group("b", true, 1)}},
+	})
+
+	assert.Len(t, combined.Groups, 3)
+	assert.Equal(t, group("a", false, 5), combined.Groups[0])
+}
@@ -0,0 +1,154 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package aggregator
+
+import (
+	"math"
+	"sort"
+)
+
+// tDigestCompression bounds the number of centroids of a t-digest. Higher
+// values make the estimates more accurate at the cost of more memory.
+const tDigestCompression = 100
+
+// tDigest is a merging t-digest (Dunning & Ertl), which estimates quantiles
+// from a bounded number of centroids. Centroids close to the extreme
+// quantiles are kept small, so estimates of high percentiles like p99 stay
+// accurate.
+type tDigest struct {
+	centroids []centroid
+	buffer    []centroid
+	count     float64
+	min       float64
+	max       float64
+}
+
+type centroid struct {
+	mean   float64
+	weight float64
+	// whether all values of the centroid are equal, as the values are added
+	// with their counts
+	exact bool
+}
+
+func newTDigest() *tDigest {
+	return &tDigest{
+		min: math.MaxFloat64,
+		max: -math.MaxFloat64,
+	}
+}
+
+func (d *tDigest) Add(value float64, weight uint64) {
+	if weight == 0 {
+		return
+	}
+
+	d.buffer = append(d.buffer, centroid{mean: value, weight: float64(weight), exact: true})
+	d.count += float64(weight)
+	if value < d.min {
+		d.min = value
+	}
+	if value > d.max {
+		d.max = value
+	}
+
+	if len(d.buffer) >= 5*tDigestCompression {
+		d.compress()
+	}
+}
+
+// compress merges the buffered values into the centroids. Neighbouring
+// centroids are merged as long as they span less than one unit of the k1
+// scale function, which allows larger centroids around the median.
+func (d *tDigest) compress() {
+	if len(d.buffer) == 0 {
+		return
+	}
+
+	all := append(d.centroids, d.buffer...)
+	d.buffer = d.buffer[:0]
+	sort.Slice(all, func(a, b int) bool {
+		return all[a].mean < all[b].mean
+	})
+
+	merged := make([]centroid, 0, len(all))
+	current := all[0]
+	cumulative := 0.0
+	kLeft := tDigestScale(0)
+	for _, next := range all[1:] {
+		q := (cumulative + current.weight + next.weight) / d.count
+		if tDigestScale(q)-kLeft <= 1 {
+			current.exact = current.exact && next.exact && current.mean == next.mean
+			current.mean += (next.mean - current.mean) * next.weight /
+				(current.weight + next.weight)
+			current.weight += next.weight
+			continue
+		}
+
+		cumulative += current.weight
+		kLeft = tDigestScale(cumulative / d.count)
+		merged = append(merged, current)
+		current = next
+	}
+	d.centroids = append(merged, current)
+}
+
+func tDigestScale(q float64) float64 {
+	return tDigestCompression / (2 * math.Pi) * math.Asin(2*math.Min(q, 1)-1)
+}
+
+// Quantile estimates the value below which the share q of all values lies.
+// Each value is considered to be at the center of its rank, so the values of
+// a centroid spread around its center, unless they are known to be equal.
+// The estimate is interpolated linearly between these positions, and the
+// minimum and maximum at the ends. It returns NaN if no values were added.
+func (d *tDigest) Quantile(q float64) float64 {
+	d.compress()
+	if len(d.centroids) == 0 {
+		return math.NaN()
+	}
+	if q <= 0 {
+		return d.min
+	}
+	if q >= 1 {
+		return d.max
+	}
+
+	target := q * d.count
+	prevPos, prevValue := 0.0, d.min
+	cumulative := 0.0
+	for _, c := range d.centroids {
+		first, last := cumulative+c.weight/2, cumulative+c.weight/2
+		if c.exact {
+			first, last = cumulative+0.5, cumulative+c.weight-0.5
+		}
+
+		if target < first {
+			return interpolate(prevPos, prevValue, first, c.mean, target)
+		}
+		if target <= last {
+			return c.mean
+		}
+
+		prevPos, prevValue = last, c.mean
+		cumulative += c.weight
+	}
+
+	return interpolate(prevPos, prevValue, d.count, d.max, target)
+}
+
+func interpolate(fromPos, fromValue, toPos, toValue, pos float64) float64 {
+	if toPos <= fromPos {
+		return toValue
+	}
+	return fromValue + (toValue-fromValue)*(pos-fromPos)/(toPos-fromPos)
+}
@@ -0,0 +1,69 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package aggregator
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTDigest(t *testing.T) {
+	t.Run("without values", func(t *testing.T) {
+		assert.True(t, math.IsNaN(newTDigest().Quantile(0.5)))
+	})
+
+	t.Run("single value", func(t *testing.T) {
+		d := newTDigest()
+		d.Add(42, 3)
+		assert.Equal(t, 42.0, d.Quantile(0.5))
+		assert.Equal(t, 42.0, d.Quantile(0.99))
+	})
+
+	t.Run("few values are interpolated exactly", func(t *testing.T) {
+		d := newTDigest()
+		for i := 1; i <= 100; i++ {
+			d.Add(float64(i), 1)
+		}
+		assert.Equal(t, 50.5, d.Quantile(0.5))
+		assert.Equal(t, 1.0, d.Quantile(0))
+		assert.Equal(t, 100.0, d.Quantile(1))
+	})
+
+	t.Run("many values", func(t *testing.T) {
+		r := rand.New(rand.NewSource(7))
+		values := make([]float64, 100_000)
+		d := newTDigest()
+		for i := range values {
+			values[i] = r.NormFloat64()*10 + 100
+			d.Add(values[i], 1)
+		}
+		sort.Float64s(values)
+
+		assert.Less(t, len(d.centroids), 2*tDigestCompression)
+		for _, q := range []float64{0.5, 0.9, 0.99} {
+			exact := values[int(q*float64(len(values)))]
+			assert.InDelta(t, exact, d.Quantile(q), 0.2, "quantile %v", q)
+		}
+	})
+
+	t.Run("weighted values", func(t *testing.T) {
+		d := newTDigest()
+		d.Add(1, 90)
+		d.Add(1000, 10)
+		assert.Equal(t, 1.0, d.Quantile(0.5))
+		assert.Equal(t, 1000.0, d.Quantile(0.99))
+	})
+}
@@ -147,3 +147,24 @@ func (a *textAggregator) Res() aggregation.Text {
 	out.Count = int(a.count)
 	return out
 }
+
+// addTextDistinctCount estimates the number of distinct texts, if requested.
+// The sketch is kept along with the estimate, so that the distinct counts of
+// several shards can be merged without knowing their texts.
+func addTextDistinctCount(text *aggregation.Text, aggs []aggregation.Aggregator,
+	agg *textAggregator,
+) {
+	for _, aProp := range aggs {
+		if aProp != aggregation.DistinctCountAggregator {
+			continue
+		}
+
+		hll := newHyperLogLog()
+		for value := range agg.itemCounter {
+			hll.AddString(value)
+		}
+		text.DistinctCount = hll.Count()
+		text.DistinctCountSketch = hll.Marshal()
+		return
+	}
+}
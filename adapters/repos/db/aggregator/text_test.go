@@ -96,3 +96,24 @@ func TestTextAggregator_TopOccurrencesCalculation(t *testing.T) {
 		})
 	}
 }
+
+func TestTextAggregator_DistinctCount(t *testing.T) {
+	agg := newTextAggregator(5)
+	for _, text := range []string{"a", "b", "a", "c", "b", "a"} {
+		agg.AddText(text)
+	}
+
+	t.Run("not requested", func(t *testing.T) {
+		res := agg.Res()
+		addTextDistinctCount(&res, []aggregation.Aggregator{aggregation.CountAggregator}, agg)
+		assert.Equal(t, 0, res.DistinctCount)
+		assert.Nil(t, res.DistinctCountSketch)
+	})
+
+	t.Run("requested", func(t *testing.T) {
+		res := agg.Res()
+		addTextDistinctCount(&res, []aggregation.Aggregator{aggregation.DistinctCountAggregator}, agg)
+		assert.Equal(t, 3, res.DistinctCount)
+		assert.NotNil(t, res.DistinctCountSketch)
+	})
+}
@@ -469,6 +469,7 @@ func (ua unfilteredAggregator) textProperty(ctx context.Context,
 	}
 
 	out.TextAggregation = agg.Res()
+	addTextDistinctCount(&out.TextAggregation, prop.Aggregators, agg)
 
 	return &out, nil
 }
@@ -75,7 +75,9 @@ func (a *Aggregator) objectVectorSearch(searchVector []float32,
 	}
 
 	bucket := a.store.Bucket(helpers.ObjectsBucketLSM)
-	objs, err := storobj.ObjectsByDocID(bucket, ids, additional.Properties{})
+	sch := a.getSchema.GetSchemaSkipAuth()
+	class := sch.GetClass(a.params.ClassName)
+	objs, err := storobj.ObjectsByDocID(bucket, ids, additional.Properties{}, class)
 	if err != nil {
 		return nil, nil, fmt.Errorf("get objects by doc id: %w", err)
 	}
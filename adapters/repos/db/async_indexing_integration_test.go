@@ -0,0 +1,194 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+func TestAsyncIndexing(t *testing.T) {
+	dirName := t.TempDir()
+	logger, _ := test.NewNullLogger()
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	newRepo := func(t *testing.T) *DB {
+		repo, err := New(logger, Config{
+			MemtablesFlushIdleAfter:   60,
+			RootPath:                  dirName,
+			QueryMaximumResults:       10000,
+			MaxImportGoroutinesFactor: 1,
+			AsyncIndexing: config.AsyncIndexing{
+				Enabled:         true,
+				QueueMaxSize:    1000,
+				BatchSize:       50,
+				SearchUnindexed: true,
+			},
+		}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+		require.Nil(t, err)
+		repo.SetSchemaGetter(schemaGetter)
+		require.Nil(t, repo.WaitForStartup(testCtx()))
+		return repo
+	}
+	repo := newRepo(t)
+	defer func() { repo.Shutdown(context.Background()) }()
+
+	vectorIndexConfig := enthnsw.NewDefaultUserConfig()
+	vectorIndexConfig.Distance = enthnsw.DistanceL2Squared
+	class := &models.Class{
+		Class:               "AsyncArticle",
+		VectorIndexConfig:   vectorIndexConfig,
+		InvertedIndexConfig: invertedConfig(),
+		Properties: []*models.Property{
+			{
+				Name:     "headline",
+				DataType: schema.DataTypeText.PropString(),
+			},
+		},
+	}
+
+	vectors := map[strfmt.UUID][]float32{}
+	randomVector := func() []float32 {
+		vector := make([]float32, 8)
+		for j := range vector {
+			vector[j] = rand.Float32()
+		}
+		return vector
+	}
+	search := func(t *testing.T, vector []float32) []strfmt.UUID {
+		res, err := repo.VectorSearch(context.Background(), dto.GetParams{
+			ClassName:    class.Class,
+			SearchVector: vector,
+			Pagination:   &filters.Pagination{Limit: 1},
+		})
+		require.Nil(t, err)
+		ids := make([]strfmt.UUID, len(res))
+		for i := range res {
+			ids[i] = res[i].ID
+		}
+		return ids
+	}
+	shardStatus := func(t *testing.T) *models.NodeShardStatus {
+		status, err := repo.GetNodeStatus(context.Background(), class.Class)
+		require.Nil(t, err)
+		require.Len(t, status, 1)
+		require.Len(t, status[0].Shards, 1)
+		return status[0].Shards[0]
+	}
+	waitUntilIndexed := func(t *testing.T) {
+		require.Eventually(t, func() bool {
+			return shardStatus(t).VectorQueueLength == 0
+		}, 30*time.Second, 10*time.Millisecond)
+	}
+
+	t.Run("creating the class", func(t *testing.T) {
+		require.Nil(t, NewMigrator(repo, logger).
+			AddClass(context.Background(), class, schemaGetter.shardState))
+		schemaGetter.schema.Objects = &models.Schema{
+			Classes: []*models.Class{class},
+		}
+	})
+
+	t.Run("importing objects", func(t *testing.T) {
+		batch := make(objects.BatchObjects, 500)
+		for i := range batch {
+			id := strfmt.UUID(uuid.NewString())
+			vectors[id] = randomVector()
+			batch[i] = objects.BatchObject{
+				OriginalIndex: i,
+				Object: &models.Object{
+					Class:      class.Class,
+					ID:         id,
+					Properties: map[string]interface{}{"headline": "article"},
+				},
+				UUID:   id,
+				Vector: vectors[id],
+			}
+		}
+		res, err := repo.BatchPutObjects(context.Background(), batch, nil)
+		require.Nil(t, err)
+		for _, obj := range res {
+			require.Nil(t, obj.Err)
+		}
+
+		for i := 0; i < 10; i++ {
+			id := strfmt.UUID(uuid.NewString())
+			vectors[id] = randomVector()
+			require.Nil(t, repo.PutObject(context.Background(), &models.Object{
+				Class:      class.Class,
+				ID:         id,
+				Properties: map[string]interface{}{"headline": "article"},
+			}, vectors[id], nil))
+		}
+	})
+
+	t.Run("searches include the vectors which are not indexed yet", func(t *testing.T) {
+		for id, vector := range vectors {
+			assert.Equal(t, []strfmt.UUID{id}, search(t, vector))
+		}
+	})
+
+	t.Run("the queue is drained", func(t *testing.T) {
+		waitUntilIndexed(t)
+		assert.Equal(t, int64(510), shardStatus(t).ObjectCount)
+		assert.Equal(t, int64(0), shardStatus(t).VectorQueueLagMs)
+	})
+
+	t.Run("updating and deleting objects", func(t *testing.T) {
+		i := 0
+		for id := range vectors {
+			if i == 5 {
+				break
+			}
+			if i%2 == 0 {
+				require.Nil(t, repo.DeleteObject(context.Background(), class.Class, id, nil, ""))
+				assert.NotEqual(t, []strfmt.UUID{id}, search(t, vectors[id]))
+				delete(vectors, id)
+			} else {
+				vectors[id] = randomVector()
+				require.Nil(t, repo.PutObject(context.Background(), &models.Object{
+					Class:      class.Class,
+					ID:         id,
+					Properties: map[string]interface{}{"headline": "updated"},
+				}, vectors[id], nil))
+			}
+			i++
+		}
+		waitUntilIndexed(t)
+	})
+
+	t.Run("the vectors are indexed after a restart", func(t *testing.T) {
+		require.Nil(t, repo.Shutdown(context.Background()))
+		repo = newRepo(t)
+		waitUntilIndexed(t)
+
+		for id, vector := range vectors {
+			assert.Equal(t, []strfmt.UUID{id}, search(t, vector))
+		}
+	})
+}
@@ -101,7 +101,7 @@ func (db *DB) ShardsBackup(
 
 	sm := make(map[string]*Shard, len(shards))
 	for _, shardName := range shards {
-		shard := idx.shards.Load(shardName)
+		shard := idx.localShard(ctx, shardName)
 		if shard == nil {
 			return cd, fmt.Errorf("no shard %q for class %q", shardName, class)
 		}
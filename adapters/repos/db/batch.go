@@ -30,6 +30,10 @@ type batchQueue struct {
 func (db *DB) BatchPutObjects(ctx context.Context, objs objects.BatchObjects,
 	repl *additional.ReplicationProperties,
 ) (objects.BatchObjects, error) {
+	if err := db.readOnly.checkNodeWritable(); err != nil {
+		return nil, err
+	}
+
 	objectByClass := make(map[string]batchQueue)
 	indexByClass := make(map[string]*Index)
 
@@ -65,6 +69,12 @@ func (db *DB) BatchPutObjects(ctx context.Context, objs objects.BatchObjects,
 				}
 				continue
 			}
+			if err := db.readOnly.checkWritable(schema.ClassName(class)); err != nil {
+				for _, origIdx := range queue.originalIndex {
+					objs[origIdx].Err = err
+				}
+				continue
+			}
 			index.dropIndex.RLock()
 			indexByClass[class] = index
 		}
@@ -98,6 +108,10 @@ func (db *DB) BatchPutObjects(ctx context.Context, objs objects.BatchObjects,
 func (db *DB) AddBatchReferences(ctx context.Context, references objects.BatchReferences,
 	repl *additional.ReplicationProperties,
 ) (objects.BatchReferences, error) {
+	if err := db.readOnly.checkNodeWritable(); err != nil {
+		return nil, err
+	}
+
 	refByClass := make(map[schema.ClassName]objects.BatchReferences)
 	indexByClass := make(map[schema.ClassName]*Index)
 
@@ -122,6 +136,12 @@ func (db *DB) AddBatchReferences(ctx context.Context, references objects.BatchRe
 				}
 				continue
 			}
+			if err := db.readOnly.checkWritable(class); err != nil {
+				for _, item := range queue {
+					references[item.OriginalIndex].Err = err
+				}
+				continue
+			}
 			index.dropIndex.RLock()
 			indexByClass[class] = index
 		}
@@ -161,6 +181,9 @@ func (db *DB) BatchDeleteObjects(ctx context.Context, params objects.BatchDelete
 	if idx == nil {
 		return objects.BatchDeleteResult{}, errors.Errorf("cannot find index for class %v", className)
 	}
+	if err := db.readOnly.checkWritable(className); err != nil {
+		return objects.BatchDeleteResult{}, err
+	}
 
 	// find all DocIDs in all shards that match the filter
 	shardDocIDs, err := idx.findDocIDs(ctx, params.Filters, tenant)
@@ -183,6 +206,12 @@ func (db *DB) BatchDeleteObjects(ctx context.Context, params objects.BatchDelete
 		}
 		matches += docIDsLength
 	}
+	if !params.DryRun {
+		err = db.applyBatchOnDeletePolicies(ctx, idx, className.String(), toDelete, repl, tenant)
+		if err != nil {
+			return objects.BatchDeleteResult{}, errors.Wrapf(err, "cannot delete objects")
+		}
+	}
 	// delete the DocIDs in given shards
 	deletedObjects, err := idx.batchDeleteObjects(ctx, toDelete, params.DryRun, repl)
 	if err != nil {
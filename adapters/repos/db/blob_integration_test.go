@@ -0,0 +1,190 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"io"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestBlobProps(t *testing.T) {
+	dirName := t.TempDir()
+
+	logger, _ := test.NewNullLogger()
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer repo.Shutdown(context.Background())
+
+	migrator := NewMigrator(repo, logger)
+	class := &models.Class{
+		Class:               "Photo",
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		Properties: []*models.Property{
+			{
+				Name:     "title",
+				DataType: schema.DataTypeText.PropString(),
+			},
+			{
+				Name:     "image",
+				DataType: schema.DataTypeBlob.PropString(),
+			},
+		},
+	}
+
+	var (
+		inlineID   = strfmt.UUID("5b6a2f3e-2c1d-4a8e-9f0b-1a2b3c4d0001")
+		largeID    = strfmt.UUID("5b6a2f3e-2c1d-4a8e-9f0b-1a2b3c4d0002")
+		inlineBlob = []byte("a small blob")
+		largeBlob  = randomBytes(t, 2*blobInlineLimit)
+		streamed   = randomBytes(t, 3*1024*1024+5)
+	)
+
+	shard := func() *Shard {
+		var out *Shard
+		repo.GetIndex(schema.ClassName(class.Class)).ForEachShard(func(name string, shard *Shard) error {
+			out = shard
+			return nil
+		})
+		return out
+	}
+
+	storedValue := func(t *testing.T, id strfmt.UUID) (string, []float32) {
+		res, err := repo.ObjectByID(context.Background(), id, search.SelectProperties{},
+			additional.Properties{}, "")
+		require.Nil(t, err)
+		require.NotNil(t, res)
+		value, _ := res.Schema.(map[string]interface{})["image"].(string)
+		return value, res.Vector
+	}
+
+	readBlob := func(t *testing.T, id strfmt.UUID) []byte {
+		r, size, err := repo.ObjectBlob(context.Background(), class.Class, id, "image", nil, "")
+		require.Nil(t, err)
+		require.NotNil(t, r)
+		defer r.Close()
+		blob, err := io.ReadAll(r)
+		require.Nil(t, err)
+		assert.Equal(t, int64(len(blob)), size)
+		return blob
+	}
+
+	t.Run("create the class and add objects", func(t *testing.T) {
+		require.Nil(t,
+			migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+		schemaGetter.schema.Objects = &models.Schema{
+			Classes: []*models.Class{class},
+		}
+
+		for id, blob := range map[strfmt.UUID][]byte{inlineID: inlineBlob, largeID: largeBlob} {
+			obj := &models.Object{
+				Class: class.Class,
+				ID:    id,
+				Properties: map[string]interface{}{
+					"title": "photo",
+					"image": base64.StdEncoding.EncodeToString(blob),
+				},
+			}
+			require.Nil(t, repo.PutObject(context.Background(), obj, []float32{1, 2, 3}, nil))
+		}
+	})
+
+	t.Run("small blobs are kept inline", func(t *testing.T) {
+		value, _ := storedValue(t, inlineID)
+		assert.Equal(t, base64.StdEncoding.EncodeToString(inlineBlob), value)
+		assert.Equal(t, inlineBlob, readBlob(t, inlineID))
+	})
+
+	t.Run("large blobs are stored out-of-band", func(t *testing.T) {
+		digest := sha256.Sum256(largeBlob)
+		value, _ := storedValue(t, largeID)
+		assert.Equal(t, schema.BlobReference(digest[:]), value)
+		assert.True(t, bytes.Equal(largeBlob, readBlob(t, largeID)))
+	})
+
+	t.Run("stream a blob into an existing object", func(t *testing.T) {
+		require.Nil(t, repo.PutObjectBlob(context.Background(), class.Class, inlineID,
+			"image", bytes.NewReader(streamed), 1000, ""))
+
+		digest := sha256.Sum256(streamed)
+		value, vector := storedValue(t, inlineID)
+		assert.Equal(t, schema.BlobReference(digest[:]), value)
+		assert.Equal(t, []float32{1, 2, 3}, vector)
+		assert.True(t, bytes.Equal(streamed, readBlob(t, inlineID)))
+	})
+
+	t.Run("updating an object keeps its blob", func(t *testing.T) {
+		value, _ := storedValue(t, largeID)
+		obj := &models.Object{
+			Class: class.Class,
+			ID:    largeID,
+			Properties: map[string]interface{}{
+				"title": "updated photo",
+				"image": value,
+			},
+		}
+		require.Nil(t, repo.PutObject(context.Background(), obj, []float32{1, 2, 3}, nil))
+		assert.True(t, bytes.Equal(largeBlob, readBlob(t, largeID)))
+	})
+
+	t.Run("deleting objects releases their blobs", func(t *testing.T) {
+		store := shard().blobStore()
+		require.NotNil(t, store)
+
+		for id, blob := range map[strfmt.UUID][]byte{inlineID: streamed, largeID: largeBlob} {
+			require.Nil(t, repo.DeleteObject(context.Background(), class.Class, id, nil, ""))
+
+			digest := sha256.Sum256(blob)
+			exists, err := store.Exists(digest[:])
+			require.Nil(t, err)
+			assert.False(t, exists)
+		}
+	})
+
+	t.Run("reading the blob of a deleted object", func(t *testing.T) {
+		r, _, err := repo.ObjectBlob(context.Background(), class.Class, largeID, "image", nil, "")
+		require.Nil(t, err)
+		assert.Nil(t, r)
+	})
+}
+
+func randomBytes(t *testing.T, size int) []byte {
+	out := make([]byte, size)
+	_, err := rand.Read(out)
+	require.Nil(t, err)
+	return out
+}
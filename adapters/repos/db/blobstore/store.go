@@ -0,0 +1,301 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package blobstore keeps the values of large blob properties outside of the
+// object store of a shard. Blobs are split into content-addressed chunks, so
+// that identical blobs (and identical parts of blobs) are only stored once.
+// Every object version which uses a blob holds a reference on it by its doc
+// id. Once the last reference is released, the blob and its chunks are
+// deleted.
+package blobstore
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/sroar"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+)
+
+// ChunkSize is the maximum size of a single chunk stored in the data bucket
+const ChunkSize = 1024 * 1024
+
+const (
+	chunkPrefix    = 'c'
+	manifestPrefix = 'm'
+)
+
+// Store persists blobs in two buckets. The data bucket (replace strategy)
+// contains the chunks as well as one manifest per blob, which lists the
+// digests of its chunks. The refs bucket (roaring set strategy) contains the
+// doc ids referencing a chunk or manifest, using the same keys.
+type Store struct {
+	// mutations of the refs need to be serialized, otherwise a chunk could
+	// be deleted while a concurrent Put still relies on it
+	sync.Mutex
+	data *lsmkv.Bucket
+	refs *lsmkv.Bucket
+}
+
+func New(data, refs *lsmkv.Bucket) *Store {
+	return &Store{data: data, refs: refs}
+}
+
+// Put streams the contents of r into the store and references the resulting
+// blob by docID. It returns the sha256 digest and the size of the blob.
+func (s *Store) Put(r io.Reader, docID uint64) ([]byte, int64, error) {
+	var (
+		size     int64
+		chunks   [][]byte
+		blobHash = sha256.New()
+	)
+
+	for {
+		// the bucket holds on to the value, so each chunk needs its own buffer
+		buf := make([]byte, ChunkSize)
+		n, err := io.ReadFull(r, buf)
+		if n > 0 {
+			chunk := buf[:n]
+			blobHash.Write(chunk)
+			digest := sha256.Sum256(chunk)
+			if err := s.putChunk(digest[:], chunk, docID); err != nil {
+				s.releaseChunks(chunks, docID)
+				return nil, 0, err
+			}
+			chunks = append(chunks, digest[:])
+			size += int64(n)
+		}
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			break
+		}
+		if err != nil {
+			s.releaseChunks(chunks, docID)
+			return nil, 0, errors.Wrap(err, "read blob")
+		}
+	}
+
+	digest := blobHash.Sum(nil)
+
+	s.Lock()
+	defer s.Unlock()
+
+	key := manifestKey(digest)
+	if err := s.data.Put(key, encodeManifest(size, chunks)); err != nil {
+		s.releaseChunksLocked(chunks, docID)
+		return nil, 0, errors.Wrap(err, "store blob manifest")
+	}
+	if err := s.refs.RoaringSetAddOne(key, docID); err != nil {
+		s.releaseChunksLocked(chunks, docID)
+		return nil, 0, errors.Wrap(err, "reference blob")
+	}
+
+	return digest, size, nil
+}
+
+// Ref adds docID as a reference to the already stored blob with the given
+// digest
+func (s *Store) Ref(digest []byte, docID uint64) error {
+	s.Lock()
+	defer s.Unlock()
+
+	key := manifestKey(digest)
+	_, chunks, err := s.manifest(digest)
+	if err != nil {
+		return err
+	}
+
+	for _, chunk := range chunks {
+		if err := s.refs.RoaringSetAddOne(chunkKey(chunk), docID); err != nil {
+			return errors.Wrap(err, "reference blob chunk")
+		}
+	}
+
+	return errors.Wrap(s.refs.RoaringSetAddOne(key, docID), "reference blob")
+}
+
+// Release removes docID as a reference from all given blobs. All blobs
+// referenced by a doc id need to be released together, as blobs may share
+// chunks. Blobs and chunks which are no longer referenced are deleted.
+func (s *Store) Release(digests [][]byte, docID uint64) error {
+	s.Lock()
+	defer s.Unlock()
+
+	var chunks [][]byte
+	seen := map[string]struct{}{}
+	for _, digest := range digests {
+		_, blobChunks, err := s.manifest(digest)
+		if err != nil {
+			return err
+		}
+		for _, chunk := range blobChunks {
+			if _, ok := seen[string(chunk)]; ok {
+				continue
+			}
+			seen[string(chunk)] = struct{}{}
+			chunks = append(chunks, chunk)
+		}
+
+		if err := s.release(manifestKey(digest), docID); err != nil {
+			return errors.Wrap(err, "release blob")
+		}
+	}
+
+	return s.releaseChunksLocked(chunks, docID)
+}
+
+// Open returns a reader streaming the blob with the given digest as well as
+// its size
+func (s *Store) Open(digest []byte) (io.ReadCloser, int64, error) {
+	s.Lock()
+	size, chunks, err := s.manifest(digest)
+	s.Unlock()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return &reader{data: s.data, chunks: chunks}, size, nil
+}
+
+// Exists returns true if a blob with the given digest is stored
+func (s *Store) Exists(digest []byte) (bool, error) {
+	manifest, err := s.data.Get(manifestKey(digest))
+	if err != nil {
+		return false, err
+	}
+	return manifest != nil, nil
+}
+
+func (s *Store) putChunk(digest, chunk []byte, docID uint64) error {
+	s.Lock()
+	defer s.Unlock()
+
+	key := chunkKey(digest)
+	if err := s.data.Put(key, chunk); err != nil {
+		return errors.Wrap(err, "store blob chunk")
+	}
+	return errors.Wrap(s.refs.RoaringSetAddOne(key, docID), "reference blob chunk")
+}
+
+func (s *Store) releaseChunks(chunks [][]byte, docID uint64) error {
+	s.Lock()
+	defer s.Unlock()
+
+	return s.releaseChunksLocked(chunks, docID)
+}
+
+func (s *Store) releaseChunksLocked(chunks [][]byte, docID uint64) error {
+	for _, chunk := range chunks {
+		if err := s.release(chunkKey(chunk), docID); err != nil {
+			return errors.Wrap(err, "release blob chunk")
+		}
+	}
+	return nil
+}
+
+// release removes docID from the references of key and deletes key from the
+// data bucket, if nothing references it anymore
+func (s *Store) release(key []byte, docID uint64) error {
+	if err := s.refs.RoaringSetRemoveOne(key, docID); err != nil {
+		return err
+	}
+
+	remaining, err := s.refs.RoaringSetGet(key)
+	if err != nil {
+		return err
+	}
+	if !isEmpty(remaining) {
+		return nil
+	}
+
+	return s.data.Delete(key)
+}
+
+func (s *Store) manifest(digest []byte) (int64, [][]byte, error) {
+	manifest, err := s.data.Get(manifestKey(digest))
+	if err != nil {
+		return 0, nil, errors.Wrap(err, "get blob manifest")
+	}
+	if manifest == nil {
+		return 0, nil, fmt.Errorf("blob %x not found", digest)
+	}
+	return decodeManifest(manifest)
+}
+
+type reader struct {
+	data    *lsmkv.Bucket
+	chunks  [][]byte
+	current *bytes.Reader
+}
+
+func (r *reader) Read(p []byte) (int, error) {
+	for r.current == nil || r.current.Len() == 0 {
+		if len(r.chunks) == 0 {
+			return 0, io.EOF
+		}
+
+		chunk, err := r.data.Get(chunkKey(r.chunks[0]))
+		if err != nil {
+			return 0, errors.Wrap(err, "get blob chunk")
+		}
+		if chunk == nil {
+			return 0, fmt.Errorf("blob chunk %x not found", r.chunks[0])
+		}
+		r.chunks = r.chunks[1:]
+		r.current = bytes.NewReader(chunk)
+	}
+
+	return r.current.Read(p)
+}
+
+func (r *reader) Close() error {
+	r.chunks = nil
+	r.current = nil
+	return nil
+}
+
+func chunkKey(digest []byte) []byte {
+	return append([]byte{chunkPrefix}, digest...)
+}
+
+func manifestKey(digest []byte) []byte {
+	return append([]byte{manifestPrefix}, digest...)
+}
+
+func encodeManifest(size int64, chunks [][]byte) []byte {
+	out := make([]byte, 8, 8+len(chunks)*sha256.Size)
+	binary.LittleEndian.PutUint64(out, uint64(size))
+	for _, chunk := range chunks {
+		out = append(out, chunk...)
+	}
+	return out
+}
+
+func decodeManifest(in []byte) (int64, [][]byte, error) {
+	if len(in) < 8 || (len(in)-8)%sha256.Size != 0 {
+		return 0, nil, fmt.Errorf("invalid blob manifest of length %d", len(in))
+	}
+
+	size := int64(binary.LittleEndian.Uint64(in[:8]))
+	chunks := make([][]byte, 0, (len(in)-8)/sha256.Size)
+	for pos := 8; pos < len(in); pos += sha256.Size {
+		chunks = append(chunks, in[pos:pos+sha256.Size])
+	}
+	return size, chunks, nil
+}
+
+func isEmpty(bm *sroar.Bitmap) bool {
+	return bm == nil || bm.IsEmpty()
+}
@@ -0,0 +1,143 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package blobstore
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"io"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+)
+
+func newTestStore(t *testing.T) *Store {
+	dirName := t.TempDir()
+
+	logger, _ := test.NewNullLogger()
+	store, err := lsmkv.New(dirName, dirName, logger, nil,
+		cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop())
+	require.Nil(t, err)
+	t.Cleanup(func() { store.Shutdown(context.Background()) })
+
+	require.Nil(t, store.CreateOrLoadBucket(context.Background(), "blobs",
+		lsmkv.WithStrategy(lsmkv.StrategyReplace)))
+	require.Nil(t, store.CreateOrLoadBucket(context.Background(), "blobs_refs",
+		lsmkv.WithStrategy(lsmkv.StrategyRoaringSet)))
+
+	return New(store.Bucket("blobs"), store.Bucket("blobs_refs"))
+}
+
+func randomBlob(t *testing.T, size int) []byte {
+	blob := make([]byte, size)
+	_, err := rand.Read(blob)
+	require.Nil(t, err)
+	return blob
+}
+
+func readBlob(t *testing.T, s *Store, digest []byte) []byte {
+	r, size, err := s.Open(digest)
+	require.Nil(t, err)
+	defer r.Close()
+
+	blob, err := io.ReadAll(r)
+	require.Nil(t, err)
+	assert.Equal(t, size, int64(len(blob)))
+	return blob
+}
+
+func TestStore(t *testing.T) {
+	s := newTestStore(t)
+
+	// spans three chunks, the last one being partial
+	blob := randomBlob(t, 2*ChunkSize+17)
+	expectedDigest := sha256.Sum256(blob)
+
+	digest, size, err := s.Put(bytes.NewReader(blob), 1)
+	require.Nil(t, err)
+	assert.Equal(t, expectedDigest[:], digest)
+	assert.Equal(t, int64(len(blob)), size)
+
+	t.Run("read blob", func(t *testing.T) {
+		assert.True(t, bytes.Equal(blob, readBlob(t, s, digest)))
+	})
+
+	t.Run("reference from another doc id", func(t *testing.T) {
+		require.Nil(t, s.Ref(digest, 2))
+	})
+
+	t.Run("release first reference", func(t *testing.T) {
+		require.Nil(t, s.Release([][]byte{digest}, 1))
+		assert.True(t, bytes.Equal(blob, readBlob(t, s, digest)))
+	})
+
+	t.Run("release last reference", func(t *testing.T) {
+		require.Nil(t, s.Release([][]byte{digest}, 2))
+
+		exists, err := s.Exists(digest)
+		require.Nil(t, err)
+		assert.False(t, exists)
+
+		_, _, err = s.Open(digest)
+		assert.NotNil(t, err)
+
+		for _, key := range [][]byte{chunkKey(digest), manifestKey(digest)} {
+			value, err := s.data.Get(key)
+			require.Nil(t, err)
+			assert.Nil(t, value)
+		}
+	})
+
+	t.Run("reference unknown blob", func(t *testing.T) {
+		assert.NotNil(t, s.Ref(digest, 3))
+	})
+}
+
+func TestStore_SharedChunks(t *testing.T) {
+	s := newTestStore(t)
+
+	first := randomBlob(t, ChunkSize)
+	second := append(append([]byte{}, first...), []byte("suffix")...)
+
+	firstDigest, _, err := s.Put(bytes.NewReader(first), 1)
+	require.Nil(t, err)
+	secondDigest, _, err := s.Put(bytes.NewReader(second), 2)
+	require.Nil(t, err)
+
+	sharedChunk := sha256.Sum256(first)
+	stored, err := s.data.Get(chunkKey(sharedChunk[:]))
+	require.Nil(t, err)
+	assert.True(t, bytes.Equal(first, stored))
+
+	require.Nil(t, s.Release([][]byte{firstDigest}, 1))
+	assert.True(t, bytes.Equal(second, readBlob(t, s, secondDigest)))
+
+	require.Nil(t, s.Release([][]byte{secondDigest}, 2))
+	stored, err = s.data.Get(chunkKey(sharedChunk[:]))
+	require.Nil(t, err)
+	assert.Nil(t, stored)
+}
+
+func TestStore_EmptyBlob(t *testing.T) {
+	s := newTestStore(t)
+
+	digest, size, err := s.Put(bytes.NewReader(nil), 1)
+	require.Nil(t, err)
+	assert.Equal(t, int64(0), size)
+	assert.Empty(t, readBlob(t, s, digest))
+}
@@ -0,0 +1,135 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestBM25FPhrases(t *testing.T) {
+	dirName := t.TempDir()
+
+	logger := logrus.New()
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, nil, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(context.TODO()))
+	defer repo.Shutdown(context.Background())
+
+	vTrue := true
+	class := &models.Class{
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: BM25FinvertedConfig(1.2, 0.75, "none"),
+		Class:               "PhraseClass",
+		Properties: []*models.Property{
+			{
+				Name:           "title",
+				DataType:       schema.DataTypeText.PropString(),
+				Tokenization:   models.PropertyTokenizationWord,
+				IndexPositions: &vTrue,
+			},
+			{
+				Name:           "tags",
+				DataType:       schema.DataTypeTextArray.PropString(),
+				Tokenization:   models.PropertyTokenizationWord,
+				IndexPositions: &vTrue,
+			},
+			{
+				Name:         "description",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationWord,
+			},
+		},
+	}
+	schemaGetter.schema = schema.Schema{
+		Objects: &models.Schema{Classes: []*models.Class{class}},
+	}
+	migrator := NewMigrator(repo, logger)
+	require.Nil(t, migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+
+	testData := []map[string]interface{}{
+		{"title": "the quick brown fox", "description": "brown quick"},
+		{"title": "the quick red fox", "description": "quick brown fox"},
+		{"title": "fox brown quick", "tags": []string{"quick", "brown"}},
+		{"title": "quick and very brown fox", "tags": []string{"lazy dog"}},
+	}
+	for i, data := range testData {
+		id := strfmt.UUID(uuid.MustParse(fmt.Sprintf("%032d", i)).String())
+		obj := &models.Object{Class: class.Class, ID: id, Properties: data}
+		require.Nil(t, repo.PutObject(context.Background(), obj, []float32{1, 3, 5, 0.4}, nil))
+	}
+
+	idx := repo.GetIndex(schema.ClassName(class.Class))
+	require.NotNil(t, idx)
+
+	search := func(t *testing.T, query string, properties ...string) []uint64 {
+		kwr := &searchparams.KeywordRanking{Type: "bm25", Query: query, Properties: properties}
+		res, _, err := idx.objectSearch(context.TODO(), 10, nil, kwr, nil, nil,
+			additional.Properties{}, nil, "", 0)
+		require.Nil(t, err)
+
+		docIDs := make([]uint64, len(res))
+		for i := range res {
+			docIDs[i] = res[i].DocID()
+		}
+		sort.Slice(docIDs, func(i, j int) bool { return docIDs[i] < docIDs[j] })
+		return docIDs
+	}
+
+	for _, tc := range []struct {
+		name       string
+		query      string
+		properties []string
+		expected   []uint64
+	}{
+		{"exact phrase", `"quick brown"`, nil, []uint64{0}},
+		{"exact phrase of a single term", `"red"`, nil, []uint64{1}},
+		{"phrase with proximity", `"quick fox"~1`, []string{"title"}, []uint64{0, 1}},
+		{"phrase with larger proximity", `"quick fox"~3`, []string{"title"}, []uint64{0, 1, 3}},
+		{"terms out of order", `"brown fox"~5 "fox quick"`, nil, []uint64{}},
+		{"phrase and plain terms", `"brown fox" lazy`, nil, []uint64{0, 3}},
+		{"several phrases", `"quick brown" "brown fox"`, nil, []uint64{0}},
+		{"phrase within an array element", `"lazy dog"`, nil, []uint64{3}},
+		{"phrase across array elements", `"quick brown"~10`, []string{"tags"}, []uint64{}},
+		{"unbalanced quote", `"red`, nil, []uint64{1}},
+		{
+			"phrase without positional properties", `"brown fox"`,
+			[]string{"description"}, []uint64{0, 1},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, search(t, tc.query, tc.properties...))
+		})
+	}
+}
@@ -328,6 +328,31 @@ func TestBM25FJourney(t *testing.T) {
 		require.Equal(t, uint64(3), res[6].DocID())
 	})
 
+	t.Run("bm25f journey boosted in schema", func(t *testing.T) {
+		queryBoosted := &searchparams.KeywordRanking{Type: "bm25", Properties: []string{"title^3", "description"}, Query: "journey"}
+		expected, _, err := idx.objectSearch(context.TODO(), 1000, nil, queryBoosted, nil, nil, addit, nil, "", 0)
+		require.Nil(t, err)
+
+		cfg := BM25FinvertedConfig(1.2, 0.75, "none")
+		cfg.Bm25.PropertyBoosts = map[string]float32{"title": 3, "description": 5}
+		require.Nil(t, idx.updateInvertedIndexConfig(context.TODO(), inverted.ConfigFromModel(cfg)))
+		defer func() {
+			require.Nil(t, idx.updateInvertedIndexConfig(context.TODO(),
+				inverted.ConfigFromModel(BM25FinvertedConfig(1.2, 0.75, "none"))))
+		}()
+
+		// the boost of the query takes precedence over the one of the schema
+		kwr := &searchparams.KeywordRanking{Type: "bm25", Properties: []string{"title", "description^1"}, Query: "journey"}
+		res, _, err := idx.objectSearch(context.TODO(), 1000, nil, kwr, nil, nil, addit, nil, "", 0)
+		require.Nil(t, err)
+
+		require.Len(t, res, len(expected))
+		for i := range expected {
+			assert.Equal(t, expected[i].DocID(), res[i].DocID())
+			assert.Equal(t, expected[i].Score(), res[i].Score())
+		}
+	})
+
 	t.Run("Check search with two terms", func(t *testing.T) {
 		kwr := &searchparams.KeywordRanking{Type: "bm25", Properties: []string{"title", "description"}, Query: "journey somewhere"}
 		res, _, err := idx.objectSearch(context.TODO(), 1000, nil, kwr, nil, nil, addit, nil, "", 0)
@@ -297,6 +297,12 @@ func (f *fakeBackupBackend) Read(ctx context.Context, backupID, key string, w io
 	return 0, nil
 }
 
+func (f *fakeBackupBackend) Delete(ctx context.Context, backupID, key string) error {
+	f.Lock()
+	defer f.Unlock()
+	return nil
+}
+
 func (f *fakeBackupBackend) SourceDataPath() string {
 	f.Lock()
 	defer f.Unlock()
@@ -14,6 +14,7 @@ package db
 import (
 	"context"
 	"fmt"
+	"io"
 	"time"
 
 	"github.com/go-openapi/strfmt"
@@ -37,6 +38,9 @@ func (db *DB) PutObject(ctx context.Context, obj *models.Object,
 	if idx == nil {
 		return fmt.Errorf("import into non-existing index for %s", object.Class())
 	}
+	if err := db.readOnly.checkWritable(object.Class()); err != nil {
+		return err
+	}
 
 	if err := idx.putObject(ctx, object, repl); err != nil {
 		return fmt.Errorf("import into index %s: %w", idx.ID(), err)
@@ -48,11 +52,25 @@ func (db *DB) PutObject(ctx context.Context, obj *models.Object,
 // DeleteObject from of a specific class giving its ID
 func (db *DB) DeleteObject(ctx context.Context, class string, id strfmt.UUID,
 	repl *additional.ReplicationProperties, tenant string,
+) error {
+	return db.deleteObject(ctx, class, id, repl, tenant, map[string]struct{}{})
+}
+
+func (db *DB) deleteObject(ctx context.Context, class string, id strfmt.UUID,
+	repl *additional.ReplicationProperties, tenant string, visited map[string]struct{},
 ) error {
 	idx := db.GetIndex(schema.ClassName(class))
 	if idx == nil {
 		return fmt.Errorf("delete from non-existing index for %s", class)
 	}
+	if err := db.readOnly.checkWritable(schema.ClassName(class)); err != nil {
+		return err
+	}
+
+	visited[onDeleteKey(class, id, tenant)] = struct{}{}
+	if err := db.applyOnDeletePolicies(ctx, class, []strfmt.UUID{id}, repl, tenant, visited); err != nil {
+		return err
+	}
 
 	err := idx.deleteObject(ctx, id, repl, tenant)
 	if err != nil {
@@ -254,6 +272,43 @@ func (db *DB) AddReference(ctx context.Context, source *crossref.RefSource, targ
 	}, repl, tenant)
 }
 
+// PutObjectBlob streams r into the blob property of an existing object
+func (db *DB) PutObjectBlob(ctx context.Context, class string, id strfmt.UUID,
+	propName string, r io.Reader, updateTime int64, tenant string,
+) error {
+	idx := db.GetIndex(schema.ClassName(class))
+	if idx == nil {
+		return fmt.Errorf("put blob into non-existing index for %s", class)
+	}
+	if err := db.readOnly.checkWritable(schema.ClassName(class)); err != nil {
+		return err
+	}
+
+	if err := idx.putObjectBlob(ctx, id, propName, r, updateTime, tenant); err != nil {
+		return errors.Wrapf(err, "put blob into index %s", idx.ID())
+	}
+
+	return nil
+}
+
+// ObjectBlob returns a reader for the blob property of an object and the size
+// of the blob. The reader is nil, if the object or property doesn't exist.
+func (db *DB) ObjectBlob(ctx context.Context, class string, id strfmt.UUID,
+	propName string, repl *additional.ReplicationProperties, tenant string,
+) (io.ReadCloser, int64, error) {
+	idx := db.GetIndex(schema.ClassName(class))
+	if idx == nil {
+		return nil, 0, nil
+	}
+
+	r, size, err := idx.objectBlob(ctx, id, propName, repl, tenant)
+	if err != nil {
+		return nil, 0, errors.Wrapf(err, "get blob from index %s", idx.ID())
+	}
+
+	return r, size, nil
+}
+
 func (db *DB) Merge(ctx context.Context, merge objects.MergeDocument,
 	repl *additional.ReplicationProperties, tenant string,
 ) error {
@@ -261,6 +316,9 @@ func (db *DB) Merge(ctx context.Context, merge objects.MergeDocument,
 	if idx == nil {
 		return fmt.Errorf("merge from non-existing index for %s", merge.Class)
 	}
+	if err := db.readOnly.checkWritable(schema.ClassName(merge.Class)); err != nil {
+		return err
+	}
 
 	err := idx.mergeObject(ctx, merge, repl, tenant)
 	if err != nil {
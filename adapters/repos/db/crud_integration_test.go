@@ -1268,6 +1268,30 @@ func TestCRUD(t *testing.T) {
 
 			assert.Equal(t, expected, res)
 		})
+
+		t.Run("perform search with contains any id filter", func(t *testing.T) {
+			res, err := repo.Search(context.Background(), dto.GetParams{
+				Pagination: &filters.Pagination{Limit: 10},
+				ClassName:  "TheBestActionClass",
+				Filters: &filters.LocalFilter{
+					Root: &filters.Clause{
+						Operator: filters.ContainsAny,
+						On: &filters.Path{
+							Class:    "TheBestActionClass",
+							Property: filters.InternalPropID,
+						},
+						Value: &filters.Value{
+							Value: []string{id.String(), "9b9cbea5-e87e-4cd0-89af-e2f424fd52d6"},
+							Type:  schema.DataTypeText,
+						},
+					},
+				},
+			})
+
+			require.Nil(t, err)
+			require.Len(t, res, 1)
+			assert.Equal(t, id, res[0].ID)
+		})
 	})
 }
 
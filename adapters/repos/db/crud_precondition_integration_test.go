@@ -0,0 +1,137 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+func TestCRUD_ConditionalWrites(t *testing.T) {
+	dirName := t.TempDir()
+
+	logger, _ := test.NewNullLogger()
+	class := &models.Class{
+		Class:               "ConditionalWrites",
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		Properties: []*models.Property{{
+			Name:         "name",
+			DataType:     schema.DataTypeText.PropString(),
+			Tokenization: models.PropertyTokenizationWhitespace,
+		}},
+	}
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	repo, err := New(logger, Config{
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+		MemtablesFlushIdleAfter:   60,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer repo.Shutdown(context.Background())
+
+	migrator := NewMigrator(repo, logger)
+	require.Nil(t, migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+	schemaGetter.schema = schema.Schema{
+		Objects: &models.Schema{Classes: []*models.Class{class}},
+	}
+
+	id := strfmt.UUID("5a7cd96a-b1d6-4c1b-9b63-02bd7f34fb6a")
+	precondition := func(versions ...int64) context.Context {
+		return objects.WithPrecondition(context.Background(), id,
+			&objects.VersionPrecondition{Versions: versions})
+	}
+	object := func(name string, updated int64) *models.Object {
+		return &models.Object{
+			ID:                 id,
+			Class:              class.Class,
+			CreationTimeUnix:   1000,
+			LastUpdateTimeUnix: updated,
+			Properties:         map[string]interface{}{"name": name},
+		}
+	}
+	name := func(t *testing.T) string {
+		res, err := repo.ObjectByID(context.Background(), id, nil, additional.Properties{}, "")
+		require.Nil(t, err)
+		require.NotNil(t, res)
+		return res.Schema.(map[string]interface{})["name"].(string)
+	}
+	isPreconditionFailed := func(t *testing.T, err error) {
+		require.NotNil(t, err)
+		assert.True(t, errors.As(err, &objects.ErrPreconditionFailed{}), "got %v", err)
+	}
+
+	t.Run("put a missing object", func(t *testing.T) {
+		err := repo.PutObject(precondition(1000), object("first", 1000), []float32{1, 2, 3}, nil)
+		isPreconditionFailed(t, err)
+
+		require.Nil(t, repo.PutObject(context.Background(), object("first", 1000), []float32{1, 2, 3}, nil))
+	})
+
+	t.Run("put", func(t *testing.T) {
+		err := repo.PutObject(precondition(999), object("second", 2000), []float32{1, 2, 3}, nil)
+		isPreconditionFailed(t, err)
+		assert.Equal(t, "first", name(t))
+
+		require.Nil(t, repo.PutObject(precondition(999, 1000), object("second", 2000), []float32{1, 2, 3}, nil))
+		assert.Equal(t, "second", name(t))
+	})
+
+	t.Run("merge", func(t *testing.T) {
+		merge := objects.MergeDocument{
+			Class:           class.Class,
+			ID:              id,
+			PrimitiveSchema: map[string]interface{}{"name": "third"},
+			UpdateTime:      3000,
+		}
+		isPreconditionFailed(t, repo.Merge(precondition(1000), merge, nil, ""))
+		assert.Equal(t, "second", name(t))
+
+		require.Nil(t, repo.Merge(precondition(2000), merge, nil, ""))
+		assert.Equal(t, "third", name(t))
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		isPreconditionFailed(t, repo.DeleteObject(precondition(2000), class.Class, id, nil, ""))
+		assert.Equal(t, "third", name(t))
+
+		require.Nil(t, repo.DeleteObject(precondition(3000), class.Class, id, nil, ""))
+		ok, err := repo.Exists(context.Background(), class.Class, id, nil, "")
+		require.Nil(t, err)
+		assert.False(t, ok)
+
+		isPreconditionFailed(t, repo.DeleteObject(precondition(3000), class.Class, id, nil, ""))
+	})
+
+	t.Run("preconditions of other objects are ignored", func(t *testing.T) {
+		other := object("other", 4000)
+		other.ID = "0b27a7a0-9c2b-4bb6-8d1a-2cf3fe6c4d36"
+		require.Nil(t, repo.PutObject(precondition(1), other, []float32{1, 2, 3}, nil))
+	})
+}
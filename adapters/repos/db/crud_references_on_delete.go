@@ -0,0 +1,337 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/schema/crossref"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+// onDeleteProperty is a reference property with an onDelete policy which
+// can point to objects of the class being deleted from
+type onDeleteProperty struct {
+	class *models.Class
+	prop  *models.Property
+}
+
+// onDeleteProperties returns all reference properties with an onDelete
+// policy that can point to objects of the given class
+func onDeleteProperties(sch schema.Schema, className string) []onDeleteProperty {
+	if sch.Objects == nil {
+		return nil
+	}
+
+	var out []onDeleteProperty
+	for _, class := range sch.Objects.Classes {
+		for _, prop := range class.Properties {
+			if prop.OnDelete == "" {
+				continue
+			}
+			for _, dt := range prop.DataType {
+				if dt == className {
+					out = append(out, onDeleteProperty{class: class, prop: prop})
+					break
+				}
+			}
+		}
+	}
+	return out
+}
+
+// applyOnDeletePolicies enforces the onDelete policies of every reference
+// pointing to the given objects before they are deleted. All restricting
+// references are checked first, so nothing is changed if the deletion is
+// rejected. visited holds the objects which are already being deleted, they
+// neither restrict the deletion nor are they updated, which also makes
+// cyclic cascades terminate.
+func (db *DB) applyOnDeletePolicies(ctx context.Context, className string,
+	ids []strfmt.UUID, repl *additional.ReplicationProperties, tenant string,
+	visited map[string]struct{},
+) error {
+	props := onDeleteProperties(db.schemaGetter.GetSchemaSkipAuth(), className)
+	if len(props) == 0 {
+		return nil
+	}
+
+	for _, restrict := range []bool{true, false} {
+		for _, p := range props {
+			if (p.prop.OnDelete == models.PropertyOnDeleteRestrict) != restrict {
+				continue
+			}
+			for _, id := range ids {
+				if err := db.applyOnDeletePolicy(ctx, p, className, id, repl, tenant, visited); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (db *DB) applyOnDeletePolicy(ctx context.Context, p onDeleteProperty,
+	className string, id strfmt.UUID, repl *additional.ReplicationProperties,
+	tenant string, visited map[string]struct{},
+) error {
+	referring, err := db.findReferringObjects(ctx, p, className, id, tenant)
+	if err != nil {
+		return fmt.Errorf("find objects referencing %s/%s via %s.%s: %w",
+			className, id, p.class.Class, p.prop.Name, err)
+	}
+
+	for _, res := range referring {
+		if _, ok := visited[onDeleteKey(res.ClassName, res.ID, res.Tenant)]; ok {
+			continue
+		}
+
+		switch p.prop.OnDelete {
+		case models.PropertyOnDeleteRestrict:
+			return objects.NewErrInvalidUserInput(
+				"object %s/%s is still referenced by %s/%s via property '%s'",
+				className, id, res.ClassName, res.ID, p.prop.Name)
+		case models.PropertyOnDeleteCascade:
+			if err := db.deleteObject(ctx, res.ClassName, res.ID, repl, res.Tenant, visited); err != nil {
+				return fmt.Errorf("cascade delete %s/%s: %w", res.ClassName, res.ID, err)
+			}
+		case models.PropertyOnDeleteSetNull:
+			if err := db.removeReferencesTo(ctx, res, p.prop.Name, id, repl); err != nil {
+				return fmt.Errorf("remove reference from %s/%s: %w", res.ClassName, res.ID, err)
+			}
+		}
+	}
+	return nil
+}
+
+// applyBatchOnDeletePolicies enforces the onDelete policies for all objects
+// of a batch delete. The objects of the batch are looked up with a dry run
+// and marked as visited, so references among them are ignored.
+func (db *DB) applyBatchOnDeletePolicies(ctx context.Context, idx *Index,
+	className string, shardDocIDs map[string][]uint64,
+	repl *additional.ReplicationProperties, tenant string,
+) error {
+	if len(onDeleteProperties(db.schemaGetter.GetSchemaSkipAuth(), className)) == 0 {
+		return nil
+	}
+
+	found, err := idx.batchDeleteObjects(ctx, shardDocIDs, true, repl)
+	if err != nil {
+		return err
+	}
+
+	ids := make([]strfmt.UUID, 0, len(found))
+	visited := make(map[string]struct{}, len(found))
+	for _, obj := range found {
+		if obj.Err != nil {
+			continue
+		}
+		ids = append(ids, obj.UUID)
+		visited[onDeleteKey(className, obj.UUID, tenant)] = struct{}{}
+	}
+
+	return db.applyOnDeletePolicies(ctx, className, ids, repl, tenant, visited)
+}
+
+// findReferringObjects returns the objects whose given reference property
+// holds a beacon to the object. Referring objects of multi-tenant classes
+// are only searched for in the same tenant, or in all active tenants if the
+// deleted object does not belong to a tenant.
+func (db *DB) findReferringObjects(ctx context.Context, p onDeleteProperty,
+	className string, id strfmt.UUID, tenant string,
+) ([]search.Result, error) {
+	tenants := []string{""}
+	if schema.MultiTenancyEnabled(p.class) {
+		tenants = db.activeTenants(p.class.Class)
+		if tenant != "" {
+			tenants = filterTenant(tenants, tenant)
+		}
+	}
+
+	filter := &filters.LocalFilter{Root: &filters.Clause{
+		Operator: filters.OperatorOr,
+		Operands: []filters.Clause{
+			referenceBeaconClause(p, crossref.NewLocalhost(className, id).String()),
+			referenceBeaconClause(p, crossref.NewLocalhost("", id).String()),
+		},
+	}}
+
+	var out []search.Result
+	for _, tenant := range tenants {
+		res, err := db.searchAllPages(ctx, p.class.Class, filter, tenant)
+		if err != nil {
+			return nil, err
+		}
+		for i := range res {
+			res[i].Tenant = tenant
+		}
+		out = append(out, res...)
+	}
+	return out, nil
+}
+
+// searchAllPages returns all objects matching the filter. A single search is
+// limited to the maximum number of query results, so the objects are
+// searched in pages ordered by id, each page starting after the last id of
+// the previous one.
+func (db *DB) searchAllPages(ctx context.Context, className string,
+	filter *filters.LocalFilter, tenant string,
+) ([]search.Result, error) {
+	pageSize := int(db.config.QueryMaximumResults)
+
+	var out []search.Result
+	pageFilter := filter
+	for {
+		res, err := db.Search(ctx, dto.GetParams{
+			ClassName:  className,
+			Filters:    pageFilter,
+			Sort:       []filters.Sort{{Path: []string{filters.InternalPropID}, Order: "asc"}},
+			Pagination: &filters.Pagination{Limit: pageSize},
+			Tenant:     tenant,
+		})
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, res...)
+		if len(res) < pageSize {
+			return out, nil
+		}
+
+		pageFilter = &filters.LocalFilter{Root: &filters.Clause{
+			Operator: filters.OperatorAnd,
+			Operands: []filters.Clause{
+				*filter.Root,
+				{
+					Operator: filters.OperatorGreaterThan,
+					On: &filters.Path{
+						Class:    schema.ClassName(className),
+						Property: filters.InternalPropID,
+					},
+					Value: &filters.Value{
+						Value: res[len(res)-1].ID.String(),
+						Type:  schema.DataTypeText,
+					},
+				},
+			},
+		}}
+	}
+}
+
+func referenceBeaconClause(p onDeleteProperty, beacon string) filters.Clause {
+	return filters.Clause{
+		Operator: filters.OperatorEqual,
+		On: &filters.Path{
+			Class:    schema.ClassName(p.class.Class),
+			Property: schema.PropertyName(p.prop.Name),
+		},
+		Value: &filters.Value{Value: beacon, Type: schema.DataTypeText},
+	}
+}
+
+// activeTenants returns the tenants of the class which can currently be
+// searched
+func (db *DB) activeTenants(className string) []string {
+	state := db.schemaGetter.CopyShardingState(className)
+	if state == nil {
+		return nil
+	}
+
+	var tenants []string
+	for name, physical := range state.Physical {
+		if physical.ActivityStatus() == models.TenantActivityStatusHOT {
+			tenants = append(tenants, name)
+		}
+	}
+	sort.Strings(tenants)
+	return tenants
+}
+
+func filterTenant(tenants []string, tenant string) []string {
+	for _, t := range tenants {
+		if t == tenant {
+			return []string{tenant}
+		}
+	}
+	return nil
+}
+
+// removeReferencesTo removes every reference to the given id from the
+// property of the referring object. The property is removed entirely if no
+// other references remain.
+func (db *DB) removeReferencesTo(ctx context.Context, res search.Result,
+	propName string, id strfmt.UUID, repl *additional.ReplicationProperties,
+) error {
+	obj := res.Object()
+	props, ok := obj.Properties.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	remaining, err := referencesWithout(props[propName], id)
+	if err != nil {
+		return err
+	}
+	if len(remaining) == 0 {
+		delete(props, propName)
+	} else {
+		props[propName] = remaining
+	}
+	obj.LastUpdateTimeUnix = time.Now().UnixMilli()
+
+	return db.PutObject(ctx, obj, res.Vector, repl)
+}
+
+// referencesWithout returns the references of the given property value
+// which do not point to id
+func referencesWithout(value interface{}, id strfmt.UUID) (models.MultipleRef, error) {
+	var refs models.MultipleRef
+	switch v := value.(type) {
+	case models.MultipleRef:
+		refs = v
+	case []interface{}:
+		for _, elem := range v {
+			asMap, ok := elem.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("unexpected reference type %T", elem)
+			}
+			beacon, _ := asMap["beacon"].(string)
+			refs = append(refs, &models.SingleRef{Beacon: strfmt.URI(beacon)})
+		}
+	case nil:
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("unexpected references type %T", value)
+	}
+
+	out := make(models.MultipleRef, 0, len(refs))
+	for _, ref := range refs {
+		parsed, err := crossref.ParseSingleRef(ref)
+		if err == nil && parsed.TargetID == id {
+			continue
+		}
+		out = append(out, ref)
+	}
+	return out, nil
+}
+
+func onDeleteKey(className string, id strfmt.UUID, tenant string) string {
+	return className + "/" + id.String() + "/" + tenant
+}
@@ -0,0 +1,189 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/schema/crossref"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+func TestCRUD_ReferencesOnDelete(t *testing.T) {
+	dirName := t.TempDir()
+
+	logger, _ := test.NewNullLogger()
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer repo.Shutdown(context.Background())
+
+	migrator := NewMigrator(repo, logger)
+
+	newClass := func(name string, props ...*models.Property) *models.Class {
+		return &models.Class{
+			Class:               name,
+			VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+			InvertedIndexConfig: invertedConfig(),
+			Properties: append([]*models.Property{{
+				Name:         "name",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationWhitespace,
+			}}, props...),
+		}
+	}
+	classes := []*models.Class{
+		newClass("Person"),
+		newClass("Team", &models.Property{
+			Name: "members", DataType: []string{"Person"}, OnDelete: models.PropertyOnDeleteRestrict,
+		}),
+		newClass("Post", &models.Property{
+			Name: "author", DataType: []string{"Person"}, OnDelete: models.PropertyOnDeleteCascade,
+		}),
+		newClass("Comment", &models.Property{
+			Name: "posts", DataType: []string{"Post"}, OnDelete: models.PropertyOnDeleteSetNull,
+		}),
+	}
+
+	var (
+		alice   = strfmt.UUID("a3b1c9e4-5d6f-4a1b-8c2d-000000000001")
+		bob     = strfmt.UUID("a3b1c9e4-5d6f-4a1b-8c2d-000000000002")
+		team    = strfmt.UUID("a3b1c9e4-5d6f-4a1b-8c2d-000000000003")
+		post1   = strfmt.UUID("a3b1c9e4-5d6f-4a1b-8c2d-000000000004")
+		post2   = strfmt.UUID("a3b1c9e4-5d6f-4a1b-8c2d-000000000005")
+		post3   = strfmt.UUID("a3b1c9e4-5d6f-4a1b-8c2d-000000000006")
+		comment = strfmt.UUID("a3b1c9e4-5d6f-4a1b-8c2d-000000000007")
+	)
+
+	refs := func(class string, ids ...strfmt.UUID) models.MultipleRef {
+		out := make(models.MultipleRef, len(ids))
+		for i, id := range ids {
+			out[i] = crossref.NewLocalhost(class, id).SingleRef()
+		}
+		return out
+	}
+	put := func(t *testing.T, class string, id strfmt.UUID, props map[string]interface{}) {
+		props["name"] = id.String()
+		obj := &models.Object{Class: class, ID: id, Properties: props}
+		require.Nil(t, repo.PutObject(context.Background(), obj, []float32{1, 2, 3}, nil))
+	}
+	exists := func(t *testing.T, class string, id strfmt.UUID) bool {
+		ok, err := repo.Exists(context.Background(), class, id, nil, "")
+		require.Nil(t, err)
+		return ok
+	}
+
+	t.Run("creating the classes and objects", func(t *testing.T) {
+		schemaGetter.schema.Objects = &models.Schema{Classes: classes}
+		for _, class := range classes {
+			require.Nil(t, migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+		}
+
+		put(t, "Person", alice, map[string]interface{}{})
+		put(t, "Person", bob, map[string]interface{}{})
+		put(t, "Team", team, map[string]interface{}{"members": refs("Person", alice)})
+		put(t, "Post", post1, map[string]interface{}{"author": refs("Person", alice)})
+		put(t, "Post", post2, map[string]interface{}{"author": refs("Person", alice, bob)})
+		put(t, "Post", post3, map[string]interface{}{"author": refs("Person", bob)})
+		put(t, "Comment", comment, map[string]interface{}{"posts": refs("Post", post1, post3)})
+	})
+
+	t.Run("restricted deletion is rejected", func(t *testing.T) {
+		err := repo.DeleteObject(context.Background(), "Person", alice, nil, "")
+		require.NotNil(t, err)
+		var errInput objects.ErrInvalidUserInput
+		assert.True(t, errors.As(err, &errInput))
+		assert.Contains(t, err.Error(), "still referenced by Team/"+team.String())
+
+		assert.True(t, exists(t, "Person", alice))
+		assert.True(t, exists(t, "Post", post1))
+	})
+
+	t.Run("deletion cascades and removes references", func(t *testing.T) {
+		require.Nil(t, repo.DeleteObject(context.Background(), "Team", team, nil, ""))
+		require.Nil(t, repo.DeleteObject(context.Background(), "Person", alice, nil, ""))
+
+		assert.False(t, exists(t, "Person", alice))
+		assert.False(t, exists(t, "Post", post1))
+		assert.False(t, exists(t, "Post", post2))
+		assert.True(t, exists(t, "Post", post3))
+
+		res, err := repo.Object(context.Background(), "Comment", comment, nil, additional.Properties{}, nil, "")
+		require.Nil(t, err)
+		require.NotNil(t, res)
+		props := res.Schema.(map[string]interface{})
+		assert.Equal(t, refs("Post", post3), props["posts"])
+	})
+
+	t.Run("batch deletion cascades and removes the last reference", func(t *testing.T) {
+		res, err := repo.BatchDeleteObjects(context.Background(), objects.BatchDeleteParams{
+			ClassName: "Person",
+			Filters: &filters.LocalFilter{Root: &filters.Clause{
+				Operator: filters.OperatorEqual,
+				On:       &filters.Path{Class: "Person", Property: "name"},
+				Value:    &filters.Value{Value: bob.String(), Type: schema.DataTypeText},
+			}},
+		}, nil, "")
+		require.Nil(t, err)
+		assert.Equal(t, int64(1), res.Matches)
+
+		assert.False(t, exists(t, "Person", bob))
+		assert.False(t, exists(t, "Post", post3))
+
+		obj, err := repo.Object(context.Background(), "Comment", comment, nil, additional.Properties{}, nil, "")
+		require.Nil(t, err)
+		require.NotNil(t, obj)
+		props := obj.Schema.(map[string]interface{})
+		_, ok := props["posts"]
+		assert.False(t, ok)
+	})
+
+	t.Run("deletion cascades beyond the maximum query results", func(t *testing.T) {
+		carol := strfmt.UUID("a3b1c9e4-5d6f-4a1b-8c2d-000000000008")
+		put(t, "Person", carol, map[string]interface{}{})
+		posts := make([]strfmt.UUID, 7)
+		for i := range posts {
+			posts[i] = strfmt.UUID(fmt.Sprintf("a3b1c9e4-5d6f-4a1b-8c2d-1000000000%02d", i))
+			put(t, "Post", posts[i], map[string]interface{}{"author": refs("Person", carol)})
+		}
+
+		maxResults := repo.config.QueryMaximumResults
+		repo.config.QueryMaximumResults = 3
+		defer func() { repo.config.QueryMaximumResults = maxResults }()
+
+		require.Nil(t, repo.DeleteObject(context.Background(), "Person", carol, nil, ""))
+		for _, post := range posts {
+			assert.False(t, exists(t, "Post", post))
+		}
+	})
+}
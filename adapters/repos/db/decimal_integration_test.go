@@ -0,0 +1,152 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestDecimalProps(t *testing.T) {
+	dirName := t.TempDir()
+
+	logger, _ := test.NewNullLogger()
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer repo.Shutdown(context.Background())
+
+	migrator := NewMigrator(repo, logger)
+	class := &models.Class{
+		Class:               "Invoice",
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		Properties: []*models.Property{
+			{
+				Name:     "amount",
+				DataType: schema.DataTypeDecimal.PropString(),
+			},
+		},
+	}
+
+	ids := []strfmt.UUID{
+		"8d5a3aa2-3c8d-4589-9ae1-3f638f50600b",
+		"8d5a3aa2-3c8d-4589-9ae1-3f638f50600c",
+		"8d5a3aa2-3c8d-4589-9ae1-3f638f50600d",
+		"8d5a3aa2-3c8d-4589-9ae1-3f638f50600e",
+	}
+	// the last two can not be told apart as float64
+	amounts := []string{"-20.5", "0", "9007199254740993.01", "9007199254740993.02"}
+
+	t.Run("creating the class and add objects", func(t *testing.T) {
+		require.Nil(t,
+			migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+		schemaGetter.schema.Objects = &models.Schema{
+			Classes: []*models.Class{class},
+		}
+		for i, id := range ids {
+			obj := &models.Object{
+				Class:      class.Class,
+				ID:         id,
+				Properties: map[string]interface{}{"amount": amounts[i]},
+			}
+			require.Nil(t, repo.PutObject(context.Background(), obj, []float32{1, 2, 3}, nil))
+		}
+	})
+
+	search := func(t *testing.T, filter *filters.LocalFilter, sort []filters.Sort) []strfmt.UUID {
+		res, err := repo.Search(context.Background(), dto.GetParams{
+			ClassName:  class.Class,
+			Pagination: &filters.Pagination{Limit: 10},
+			Filters:    filter,
+			Sort:       sort,
+		})
+		require.Nil(t, err)
+
+		found := make([]strfmt.UUID, len(res))
+		for i := range res {
+			found[i] = res[i].ID
+		}
+		return found
+	}
+
+	t.Run("filtering", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			value    string
+			operator filters.Operator
+			expected []strfmt.UUID
+		}{
+			{
+				name:     "equal, exact",
+				value:    "9007199254740993.02",
+				operator: eq,
+				expected: []strfmt.UUID{ids[3]},
+			},
+			{
+				name:     "equal, different notation",
+				value:    "-2.050e1",
+				operator: eq,
+				expected: []strfmt.UUID{ids[0]},
+			},
+			{
+				name:     "greater than",
+				value:    "9007199254740993.01",
+				operator: filters.OperatorGreaterThan,
+				expected: []strfmt.UUID{ids[3]},
+			},
+			{
+				name:     "greater than equal",
+				value:    "0",
+				operator: filters.OperatorGreaterThanEqual,
+				expected: []strfmt.UUID{ids[1], ids[2], ids[3]},
+			},
+			{
+				name:     "less than",
+				value:    "-0.000001",
+				operator: filters.OperatorLessThan,
+				expected: []strfmt.UUID{ids[0]},
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				filter := buildFilter("amount", tt.value, tt.operator, schema.DataTypeText)
+				assert.ElementsMatch(t, tt.expected, search(t, filter, nil))
+			})
+		}
+	})
+
+	t.Run("sorting", func(t *testing.T) {
+		found := search(t, nil, []filters.Sort{buildSortFilter([]string{"amount"}, "desc")})
+		assert.Equal(t, []strfmt.UUID{ids[3], ids[2], ids[1], ids[0]}, found)
+	})
+}
@@ -0,0 +1,182 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/aggregation"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestDurationProps(t *testing.T) {
+	dirName := t.TempDir()
+
+	logger, _ := test.NewNullLogger()
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer repo.Shutdown(context.Background())
+
+	migrator := NewMigrator(repo, logger)
+	class := &models.Class{
+		Class:               "Flight",
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		Properties: []*models.Property{
+			{
+				Name:     "length",
+				DataType: schema.DataTypeDuration.PropString(),
+			},
+		},
+	}
+
+	ids := []strfmt.UUID{
+		"8d5a3aa2-3c8d-4589-9ae1-3f638f506003",
+		"8d5a3aa2-3c8d-4589-9ae1-3f638f506004",
+		"8d5a3aa2-3c8d-4589-9ae1-3f638f506005",
+	}
+	lengths := []time.Duration{45 * time.Minute, 2 * time.Hour, 11*time.Hour + 30*time.Minute}
+
+	t.Run("creating the class and add objects", func(t *testing.T) {
+		require.Nil(t,
+			migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+		schemaGetter.schema.Objects = &models.Schema{
+			Classes: []*models.Class{class},
+		}
+		for i, id := range ids {
+			obj := &models.Object{
+				Class:      class.Class,
+				ID:         id,
+				Properties: map[string]interface{}{"length": lengths[i].String()},
+			}
+			require.Nil(t, repo.PutObject(context.Background(), obj, []float32{1, 2, 3}, nil))
+		}
+	})
+
+	search := func(t *testing.T, filter *filters.LocalFilter, sort []filters.Sort) []strfmt.UUID {
+		res, err := repo.Search(context.Background(), dto.GetParams{
+			ClassName:  class.Class,
+			Pagination: &filters.Pagination{Limit: 10},
+			Filters:    filter,
+			Sort:       sort,
+		})
+		require.Nil(t, err)
+
+		found := make([]strfmt.UUID, len(res))
+		for i := range res {
+			found[i] = res[i].ID
+		}
+		return found
+	}
+
+	t.Run("filtering", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			value    string
+			operator filters.Operator
+			expected []strfmt.UUID
+		}{
+			{
+				name:     "equal, ISO-8601",
+				value:    "PT2H",
+				operator: eq,
+				expected: []strfmt.UUID{ids[1]},
+			},
+			{
+				name:     "not equal",
+				value:    "2h",
+				operator: filters.OperatorNotEqual,
+				expected: []strfmt.UUID{ids[0], ids[2]},
+			},
+			{
+				name:     "greater than",
+				value:    "1h",
+				operator: filters.OperatorGreaterThan,
+				expected: []strfmt.UUID{ids[1], ids[2]},
+			},
+			{
+				name:     "less than equal",
+				value:    "P0DT2H",
+				operator: filters.OperatorLessThanEqual,
+				expected: []strfmt.UUID{ids[0], ids[1]},
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				filter := buildFilter("length", tt.value, tt.operator, schema.DataTypeText)
+				assert.ElementsMatch(t, tt.expected, search(t, filter, nil))
+			})
+		}
+	})
+
+	t.Run("sorting", func(t *testing.T) {
+		found := search(t, nil, []filters.Sort{buildSortFilter([]string{"length"}, "desc")})
+		assert.Equal(t, []strfmt.UUID{ids[2], ids[1], ids[0]}, found)
+	})
+
+	t.Run("aggregating", func(t *testing.T) {
+		aggregators := []aggregation.Aggregator{
+			aggregation.MinimumAggregator,
+			aggregation.MaximumAggregator,
+		}
+		expected := map[string]interface{}{
+			"minimum": float64(45 * time.Minute),
+			"maximum": float64(11*time.Hour + 30*time.Minute),
+		}
+
+		t.Run("without filter", func(t *testing.T) {
+			res, err := repo.Aggregate(context.Background(), aggregation.Params{
+				ClassName: schema.ClassName(class.Class),
+				Properties: []aggregation.ParamProperty{
+					{Name: "length", Aggregators: aggregators},
+				},
+			})
+			require.Nil(t, err)
+			require.Len(t, res.Groups, 1)
+			assert.Equal(t, expected, res.Groups[0].Properties["length"].NumericalAggregations)
+		})
+
+		t.Run("with filter", func(t *testing.T) {
+			res, err := repo.Aggregate(context.Background(), aggregation.Params{
+				ClassName: schema.ClassName(class.Class),
+				Filters:   buildFilter("length", "0s", filters.OperatorGreaterThan, schema.DataTypeText),
+				Properties: []aggregation.ParamProperty{
+					{Name: "length", Aggregators: aggregators},
+				},
+			})
+			require.Nil(t, err)
+			require.Len(t, res.Groups, 1)
+			assert.Equal(t, expected, res.Groups[0].Properties["length"].NumericalAggregations)
+		})
+	})
+}
@@ -0,0 +1,166 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	entdynamic "github.com/weaviate/weaviate/entities/vectorindex/dynamic"
+)
+
+func TestDynamicVectorIndex(t *testing.T) {
+	dirName := t.TempDir()
+
+	logger, _ := test.NewNullLogger()
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	newRepo := func() *DB {
+		repo, err := New(logger, Config{
+			MemtablesFlushIdleAfter:   60,
+			RootPath:                  dirName,
+			QueryMaximumResults:       10000,
+			MaxImportGoroutinesFactor: 1,
+		}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+		require.Nil(t, err)
+		repo.SetSchemaGetter(schemaGetter)
+		require.Nil(t, repo.WaitForStartup(testCtx()))
+		return repo
+	}
+	repo := newRepo()
+
+	vectorIndexConfig := entdynamic.NewDefaultUserConfig()
+	vectorIndexConfig.Threshold = 50
+	vectorIndexConfig.Distance = "l2-squared"
+	vectorIndexConfig.HNSW.Distance = "l2-squared"
+	vectorIndexConfig.Flat.Distance = "l2-squared"
+
+	migrator := NewMigrator(repo, logger)
+	class := &models.Class{
+		Class:               "GrowingArticle",
+		VectorIndexType:     "dynamic",
+		VectorIndexConfig:   vectorIndexConfig,
+		InvertedIndexConfig: invertedConfig(),
+		Properties: []*models.Property{
+			{
+				Name:     "headline",
+				DataType: schema.DataTypeText.PropString(),
+			},
+		},
+	}
+
+	id := func(i int) strfmt.UUID {
+		return strfmt.UUID(fmt.Sprintf("7b2e1d3f-4c5a-4b6e-9f70-%012d", i))
+	}
+	vector := func(i int) []float32 {
+		return []float32{float32(i%7) + 1, float32(i%11) + 1, float32(i%13) + 1}
+	}
+
+	putObjects := func(t *testing.T, from, to int) {
+		for i := from; i < to; i++ {
+			require.Nil(t, repo.PutObject(context.Background(), &models.Object{
+				Class:      class.Class,
+				ID:         id(i),
+				Properties: map[string]interface{}{"headline": "article"},
+			}, vector(i), nil))
+		}
+	}
+
+	vectorSearch := func(t *testing.T, vector []float32) []strfmt.UUID {
+		res, err := repo.VectorSearch(context.Background(), dto.GetParams{
+			ClassName:    class.Class,
+			SearchVector: vector,
+			Pagination:   &filters.Pagination{Limit: 1},
+		})
+		require.Nil(t, err)
+
+		found := make([]strfmt.UUID, len(res))
+		for i := range res {
+			found[i] = res[i].ID
+		}
+		return found
+	}
+
+	state := func() string {
+		matches, err := filepath.Glob(filepath.Join(dirName, "*.dynamic"))
+		require.Nil(t, err)
+		if len(matches) != 1 {
+			return ""
+		}
+		contents, err := os.ReadFile(matches[0])
+		require.Nil(t, err)
+		return string(contents)
+	}
+
+	t.Run("creating the class and adding objects below the threshold", func(t *testing.T) {
+		require.Nil(t,
+			migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+		schemaGetter.schema.Objects = &models.Schema{
+			Classes: []*models.Class{class},
+		}
+
+		putObjects(t, 0, 49)
+		assert.Equal(t, "flat", state())
+		assert.Equal(t, []strfmt.UUID{id(10)}, vectorSearch(t, vector(10)))
+	})
+
+	t.Run("growing past the threshold upgrades to hnsw", func(t *testing.T) {
+		putObjects(t, 49, 300)
+
+		assert.Eventually(t, func() bool { return state() == "hnsw" },
+			30*time.Second, 50*time.Millisecond)
+
+		matches, err := filepath.Glob(filepath.Join(dirName, "*.flat.d"))
+		require.Nil(t, err)
+		assert.Empty(t, matches)
+		matches, err = filepath.Glob(filepath.Join(dirName, "*.hnsw.commitlog.d"))
+		require.Nil(t, err)
+		assert.Len(t, matches, 1)
+	})
+
+	t.Run("searching after the upgrade", func(t *testing.T) {
+		// vectors repeat after 7*11*13 objects, so every vector is unique
+		for _, i := range []int{0, 48, 49, 150, 299} {
+			assert.Equal(t, []strfmt.UUID{id(i)}, vectorSearch(t, vector(i)))
+		}
+	})
+
+	t.Run("deleting after the upgrade", func(t *testing.T) {
+		require.Nil(t, repo.DeleteObject(context.Background(), class.Class, id(150), nil, ""))
+		assert.NotEqual(t, []strfmt.UUID{id(150)}, vectorSearch(t, vector(150)))
+	})
+
+	t.Run("restarting keeps the hnsw index", func(t *testing.T) {
+		require.Nil(t, repo.Shutdown(context.Background()))
+		repo = newRepo()
+
+		assert.Equal(t, "hnsw", state())
+		assert.Equal(t, []strfmt.UUID{id(299)}, vectorSearch(t, vector(299)))
+		putObjects(t, 300, 310)
+		assert.Equal(t, []strfmt.UUID{id(305)}, vectorSearch(t, vector(305)))
+	})
+
+	require.Nil(t, repo.Shutdown(context.Background()))
+}
@@ -0,0 +1,202 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestFilterPlanner(t *testing.T) {
+	dirName := t.TempDir()
+
+	logger := logrus.New()
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, nil, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(context.TODO()))
+	defer repo.Shutdown(context.Background())
+
+	vectorIndexConfig := enthnsw.NewDefaultUserConfig()
+	vectorIndexConfig.Distance = enthnsw.DistanceL2Squared
+	class := &models.Class{
+		VectorIndexConfig:   vectorIndexConfig,
+		InvertedIndexConfig: invertedConfig(),
+		Class:               "FilterPlannerClass",
+		Properties: []*models.Property{
+			{
+				Name:     "category",
+				DataType: schema.DataTypeInt.PropString(),
+			},
+			{
+				Name:     "position",
+				DataType: schema.DataTypeInt.PropString(),
+			},
+		},
+	}
+	schemaGetter.schema = schema.Schema{
+		Objects: &models.Schema{Classes: []*models.Class{class}},
+	}
+	migrator := NewMigrator(repo, logger)
+	require.Nil(t, migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+
+	// 190 of the 200 objects are in category 0, the others in category 1
+	const total = 200
+	for i := 0; i < total; i++ {
+		category := 0
+		if i >= 190 {
+			category = 1
+		}
+		obj := &models.Object{
+			Class: class.Class,
+			ID:    strfmt.UUID(uuid.MustParse(fmt.Sprintf("%032d", i)).String()),
+			Properties: map[string]interface{}{
+				"category": int64(category),
+				"position": int64(i),
+			},
+		}
+		require.Nil(t, repo.PutObject(context.Background(), obj,
+			[]float32{float32(i), 1}, nil))
+	}
+
+	idx := repo.GetIndex(schema.ClassName(class.Class))
+	require.NotNil(t, idx)
+
+	var shard *Shard
+	idx.ForEachShard(func(name string, s *Shard) error {
+		shard = s
+		return nil
+	})
+	require.NotNil(t, shard)
+
+	// the stats are kept for disk segments only
+	require.Nil(t, shard.store.FlushMemtables(context.Background()))
+
+	clause := func(prop string, operator filters.Operator, value int) filters.Clause {
+		return filters.Clause{
+			Operator: operator,
+			On:       &filters.Path{Class: schema.ClassName(class.Class), Property: schema.PropertyName(prop)},
+			Value:    &filters.Value{Type: schema.DataTypeInt, Value: value},
+		}
+	}
+
+	t.Run("unselective filters are applied after the vector search", func(t *testing.T) {
+		c := clause("category", filters.OperatorEqual, 0)
+		selectivity, ok := shard.preferPostFiltering(&filters.LocalFilter{Root: &c})
+		assert.True(t, ok)
+		assert.InDelta(t, 0.95, selectivity, 0.05)
+	})
+
+	t.Run("selective filters restrict the vector search", func(t *testing.T) {
+		c := clause("category", filters.OperatorEqual, 1)
+		_, ok := shard.preferPostFiltering(&filters.LocalFilter{Root: &c})
+		assert.False(t, ok)
+	})
+
+	vectorSearch := func(t *testing.T, vector []float32, filter *filters.LocalFilter,
+		limit int, exact bool,
+	) []int64 {
+		res, _, err := shard.objectVectorSearch(context.TODO(), vector, "", 0,
+			limit, filter, nil, nil, nil, exact, additional.Properties{})
+		require.Nil(t, err)
+
+		positions := make([]int64, len(res))
+		for i, obj := range res {
+			positions[i] = int64(obj.Properties().(map[string]interface{})["position"].(float64))
+		}
+		return positions
+	}
+
+	t.Run("post-filtered vector search", func(t *testing.T) {
+		filter := &filters.LocalFilter{Root: &filters.Clause{
+			Operator: filters.OperatorAnd,
+			Operands: []filters.Clause{
+				clause("category", filters.OperatorEqual, 0),
+				clause("position", filters.OperatorGreaterThanEqual, 5),
+			},
+		}}
+
+		_, ok := shard.preferPostFiltering(filter)
+		require.True(t, ok)
+
+		expected := vectorSearch(t, []float32{0, 1}, filter, 10, true)
+		assert.Equal(t, []int64{5, 6, 7, 8, 9, 10, 11, 12, 13, 14}, expected)
+		assert.Equal(t, expected, vectorSearch(t, []float32{0, 1}, filter, 10, false))
+	})
+
+	t.Run("post-filtered vector search with too few matches", func(t *testing.T) {
+		// estimated as unselective, but the nearest objects don't match
+		filter := &filters.LocalFilter{Root: &filters.Clause{
+			Operator: filters.OperatorAnd,
+			Operands: []filters.Clause{
+				clause("category", filters.OperatorEqual, 0),
+				clause("position", filters.OperatorLessThan, 185),
+			},
+		}}
+
+		_, ok := shard.preferPostFiltering(filter)
+		require.True(t, ok)
+
+		assert.Equal(t, []int64{184, 183, 182},
+			vectorSearch(t, []float32{199, 1}, filter, 3, false))
+	})
+
+	t.Run("and with a child matching nothing", func(t *testing.T) {
+		filter := &filters.LocalFilter{Root: &filters.Clause{
+			Operator: filters.OperatorAnd,
+			Operands: []filters.Clause{
+				clause("position", filters.OperatorGreaterThanEqual, 0),
+				clause("category", filters.OperatorEqual, 2),
+			},
+		}}
+
+		res, _, err := idx.objectSearch(context.TODO(), 100, filter, nil, nil, nil,
+			additional.Properties{}, nil, "", 0)
+		require.Nil(t, err)
+		assert.Len(t, res, 0)
+	})
+
+	t.Run("or of selective and unselective children", func(t *testing.T) {
+		filter := &filters.LocalFilter{Root: &filters.Clause{
+			Operator: filters.OperatorOr,
+			Operands: []filters.Clause{
+				clause("category", filters.OperatorEqual, 1),
+				clause("position", filters.OperatorLessThan, 5),
+			},
+		}}
+
+		res, _, err := idx.objectSearch(context.TODO(), 100, filter, nil, nil, nil,
+			additional.Properties{}, nil, "", 0)
+		require.Nil(t, err)
+		assert.Len(t, res, 15)
+	})
+}
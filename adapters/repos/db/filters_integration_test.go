@@ -16,6 +16,7 @@ package db
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -399,6 +400,23 @@ func testPrimitiveProps(repo *DB) func(t *testing.T) {
 				filter:      buildFilter("id", carPoloID.String(), gt, dtText),
 				expectedIDs: []strfmt.UUID{carSprinterID, carNilID, carEmpty},
 			},
+			{
+				name: "by id contains any",
+				filter: buildFilter("id", []string{
+					carPoloID.String(),
+					strings.ToUpper(carSprinterID.String()),
+					"9b9cbea5-e87e-4cd0-89af-e2f424fd52d6", // no such object
+					"not-a-uuid",
+				}, filters.ContainsAny, dtText),
+				expectedIDs: []strfmt.UUID{carPoloID, carSprinterID},
+			},
+			{
+				name: "by id contains any and prop",
+				filter: compoundFilter(and,
+					buildFilter("id", []string{carPoloID.String(), carE63sID.String()}, filters.ContainsAny, dtText),
+					buildFilter("horsepower", 200, lt, dtInt)),
+				expectedIDs: []strfmt.UUID{carPoloID},
+			},
 			{
 				name: "within 600km of San Francisco",
 				filter: buildFilter("parkedAt", filters.GeoRange{
@@ -651,22 +669,24 @@ func testChainedPrimitiveProps(repo *DB,
 				),
 				expectedIDs: []strfmt.UUID{carSprinterID, carE63sID},
 			},
-			// test{
-			// 	name: "NOT modelName == sprinter, modelName == e63s",
-			// 	filter: filterNot(
-			// 		buildFilter("modelName", "sprinter", eq, dtText),
-			// 		buildFilter("modelName", "e63s", eq, dtText),
-			// 	),
-			// 	expectedIDs: []strfmt.UUID{carPoloID},
-			// },
-			// test{
-			// 	name: "NOT horsepower < 200 , weight > 3000",
-			// 	filter: filterNot(
-			// 		buildFilter("horsepower", 200, lt, dtNumber),
-			// 		buildFilter("weight", 3000, gt, dtNumber),
-			// 	),
-			// 	expectedIDs: []strfmt.UUID{carE63sID},
-			// },
+			{
+				name: "NOT (modelName == sprinter OR modelName == e63s)",
+				filter: filterNot(
+					filterOr(
+						buildFilter("modelName", "sprinter", eq, dtText),
+						buildFilter("modelName", "e63s", eq, dtText),
+					),
+				),
+				expectedIDs: []strfmt.UUID{carPoloID, carNilID, carEmpty},
+			},
+			{
+				name: "NOT horsepower < 200 AND NOT weight > 3000",
+				filter: filterAnd(
+					filterNot(buildFilter("horsepower", 200, lt, dtInt)),
+					filterNot(buildFilter("weight", float64(3000), gt, dtNumber)),
+				),
+				expectedIDs: []strfmt.UUID{carE63sID, carNilID, carEmpty},
+			},
 			{
 				name: "(heavy AND powerful) OR light",
 				filter: filterOr(
@@ -757,6 +777,10 @@ func filterOr(operands ...*filters.LocalFilter) *filters.LocalFilter {
 	return compoundFilter(filters.OperatorOr, operands...)
 }
 
+func filterNot(operand *filters.LocalFilter) *filters.LocalFilter {
+	return compoundFilter(filters.OperatorNot, operand)
+}
+
 // test data
 var carClass = &models.Class{
 	Class:               "FilterTestCar",
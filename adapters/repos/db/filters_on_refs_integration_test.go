@@ -445,6 +445,76 @@ func TestRefFilters(t *testing.T) {
 				assert.ElementsMatch(t, expectedNames, extractNames(res))
 			})
 		})
+
+		t.Run("by count of matching references", func(t *testing.T) {
+			type test struct {
+				name          string
+				count         *filters.ReferenceCount
+				expectedNames []string
+			}
+			tests := []test{
+				{
+					name:  "at least one",
+					count: nil,
+					expectedNames: []string{
+						"Car which is parked in a garage",
+						"Car which is parked in two places at the same time (magic!)",
+					},
+				},
+				{
+					name:  "equal to one",
+					count: &filters.ReferenceCount{Operator: filters.OperatorEqual, Count: 1},
+					expectedNames: []string{
+						"Car which is parked in a garage",
+						"Car which is parked in two places at the same time (magic!)",
+					},
+				},
+				{
+					name:  "equal to zero",
+					count: &filters.ReferenceCount{Operator: filters.OperatorEqual, Count: 0},
+					expectedNames: []string{
+						"Car which is parked no where",
+						"Car which is parked in a lot",
+					},
+				},
+				{
+					name:          "greater than one",
+					count:         &filters.ReferenceCount{Operator: filters.OperatorGreaterThan, Count: 1},
+					expectedNames: []string{},
+				},
+				{
+					name:  "not equal to one",
+					count: &filters.ReferenceCount{Operator: filters.OperatorNotEqual, Count: 1},
+					expectedNames: []string{
+						"Car which is parked no where",
+						"Car which is parked in a lot",
+					},
+				},
+				{
+					name:  "less than or equal one",
+					count: &filters.ReferenceCount{Operator: filters.OperatorLessThanEqual, Count: 1},
+					expectedNames: []string{
+						"Car which is parked no where",
+						"Car which is parked in a garage",
+						"Car which is parked in a lot",
+						"Car which is parked in two places at the same time (magic!)",
+					},
+				},
+			}
+
+			for _, test := range tests {
+				t.Run(test.name, func(t *testing.T) {
+					filter := filterCarParkedAtGarage(schema.DataTypeText,
+						"name", filters.OperatorLike, "*Garage*")
+					filter.Root.ReferenceCount = test.count
+					params := getParamsWithFilter("MultiRefCar", filter)
+
+					res, err := repo.Search(context.Background(), params)
+					require.Nil(t, err)
+					assert.ElementsMatch(t, test.expectedNames, extractNames(res))
+				})
+			}
+		})
 	})
 }
 
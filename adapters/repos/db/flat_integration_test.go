@@ -0,0 +1,141 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	entflat "github.com/weaviate/weaviate/entities/vectorindex/flat"
+)
+
+func TestFlatVectorIndex(t *testing.T) {
+	dirName := t.TempDir()
+
+	logger, _ := test.NewNullLogger()
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer repo.Shutdown(context.Background())
+
+	vectorIndexConfig := entflat.NewDefaultUserConfig()
+	vectorIndexConfig.MaxObjects = 3
+	vectorIndexConfig.BQ.Enabled = true
+
+	migrator := NewMigrator(repo, logger)
+	class := &models.Class{
+		Class:               "SmallArticle",
+		VectorIndexType:     "flat",
+		VectorIndexConfig:   vectorIndexConfig,
+		InvertedIndexConfig: invertedConfig(),
+		Properties: []*models.Property{
+			{
+				Name:     "headline",
+				DataType: schema.DataTypeText.PropString(),
+			},
+		},
+	}
+
+	ids := []strfmt.UUID{
+		"6a1f0c2e-2f4b-4c61-8d1e-3b7f9a5e4c01",
+		"6a1f0c2e-2f4b-4c61-8d1e-3b7f9a5e4c02",
+		"6a1f0c2e-2f4b-4c61-8d1e-3b7f9a5e4c03",
+	}
+	vectors := [][]float32{{1, 0, 0}, {0.8, 0.2, 0}, {0, 0, 1}}
+
+	t.Run("creating the class and adding objects", func(t *testing.T) {
+		require.Nil(t,
+			migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+		schemaGetter.schema.Objects = &models.Schema{
+			Classes: []*models.Class{class},
+		}
+
+		for i, id := range ids {
+			require.Nil(t, repo.PutObject(context.Background(), &models.Object{
+				Class:      class.Class,
+				ID:         id,
+				Properties: map[string]interface{}{"headline": "article"},
+			}, vectors[i], nil))
+		}
+	})
+
+	vectorSearch := func(t *testing.T, vector []float32) []strfmt.UUID {
+		res, err := repo.VectorSearch(context.Background(), dto.GetParams{
+			ClassName:    class.Class,
+			SearchVector: vector,
+			Pagination:   &filters.Pagination{Limit: 10},
+		})
+		require.Nil(t, err)
+
+		found := make([]strfmt.UUID, len(res))
+		for i := range res {
+			found[i] = res[i].ID
+		}
+		return found
+	}
+
+	t.Run("the index is persisted in its own directory", func(t *testing.T) {
+		matches, err := filepath.Glob(filepath.Join(dirName, "*.flat.d", "vectors"))
+		require.Nil(t, err)
+		require.Len(t, matches, 1)
+
+		_, err = os.Stat(filepath.Join(filepath.Dir(matches[0]), "vectors_compressed"))
+		assert.Nil(t, err)
+	})
+
+	t.Run("searching", func(t *testing.T) {
+		assert.Equal(t, ids, vectorSearch(t, []float32{1, 0, 0}))
+	})
+
+	t.Run("updating an object of a full index", func(t *testing.T) {
+		require.Nil(t, repo.PutObject(context.Background(), &models.Object{
+			Class:      class.Class,
+			ID:         ids[2],
+			Properties: map[string]interface{}{"headline": "updated article"},
+		}, []float32{0, 1, 0}, nil))
+		assert.Equal(t, ids, vectorSearch(t, []float32{1, 0, 0}))
+	})
+
+	t.Run("adding an object beyond maxObjects", func(t *testing.T) {
+		err := repo.PutObject(context.Background(), &models.Object{
+			Class:      class.Class,
+			ID:         "6a1f0c2e-2f4b-4c61-8d1e-3b7f9a5e4c04",
+			Properties: map[string]interface{}{"headline": "article"},
+		}, []float32{0, 0, 1}, nil)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "maxObjects")
+	})
+
+	t.Run("deleted objects are not returned", func(t *testing.T) {
+		require.Nil(t, repo.DeleteObject(context.Background(), class.Class, ids[0], nil, ""))
+		assert.Equal(t, ids[1:], vectorSearch(t, []float32{1, 0, 0}))
+	})
+}
@@ -0,0 +1,157 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	"github.com/weaviate/weaviate/entities/storobj"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestFuzzyMatch(t *testing.T) {
+	dirName := t.TempDir()
+
+	logger := logrus.New()
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, nil, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(context.TODO()))
+	defer repo.Shutdown(context.Background())
+
+	class := &models.Class{
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: BM25FinvertedConfig(1.2, 0.75, "none"),
+		Class:               "FuzzyClass",
+		Properties: []*models.Property{
+			{
+				Name:         "title",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationWord,
+			},
+			{
+				Name:         "tags",
+				DataType:     schema.DataTypeTextArray.PropString(),
+				Tokenization: models.PropertyTokenizationWord,
+			},
+		},
+	}
+	schemaGetter.schema = schema.Schema{
+		Objects: &models.Schema{Classes: []*models.Class{class}},
+	}
+	migrator := NewMigrator(repo, logger)
+	require.Nil(t, migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+
+	testData := []map[string]interface{}{
+		{"title": "weaviate vector database"},
+		{"title": "weviate is a typo"},
+		{"title": "vector search engine"},
+		{"title": "vectors and databases", "tags": []string{"search"}},
+	}
+	for i, data := range testData {
+		id := strfmt.UUID(uuid.MustParse(fmt.Sprintf("%032d", i)).String())
+		obj := &models.Object{Class: class.Class, ID: id, Properties: data}
+		require.Nil(t, repo.PutObject(context.Background(), obj, []float32{1, 3, 5, 0.4}, nil))
+	}
+
+	idx := repo.GetIndex(schema.ClassName(class.Class))
+	require.NotNil(t, idx)
+
+	docIDsOf := func(res []*storobj.Object) []uint64 {
+		docIDs := make([]uint64, len(res))
+		for i := range res {
+			docIDs[i] = res[i].DocID()
+		}
+		sort.Slice(docIDs, func(i, j int) bool { return docIDs[i] < docIDs[j] })
+		return docIDs
+	}
+
+	filterCases := []struct {
+		name     string
+		prop     string
+		value    string
+		expected []uint64
+	}{
+		{"one edit", "title", "weaviate~1", []uint64{0, 1}},
+		{"no edit", "title", "weaviate~0", []uint64{0}},
+		{"insertion", "title", "vector~1", []uint64{0, 2, 3}},
+		{"two edits", "title", "databse~2", []uint64{0, 3}},
+		{"distance depending on the length", "title", "vectr", []uint64{0, 2}},
+		{"all terms have to match", "title", "vectr serch", []uint64{2}},
+		{"text array", "tags", "serch~1", []uint64{3}},
+		{"no match", "title", "graph~2", []uint64{}},
+	}
+
+	bm25Cases := []struct {
+		name     string
+		query    string
+		fuzzy    int
+		expected []uint64
+	}{
+		{"exact", "weviate", 0, []uint64{1}},
+		{"one edit", "weviate", 1, []uint64{0, 1}},
+		{"two edits", "databse", 2, []uint64{0, 3}},
+		{"several terms", "weviate serch", 1, []uint64{0, 1, 2, 3}},
+	}
+
+	run := func(t *testing.T) {
+		for _, tc := range filterCases {
+			t.Run("filter "+tc.name, func(t *testing.T) {
+				filter := buildFilter(tc.prop, tc.value, filters.OperatorFuzzyMatch, schema.DataTypeText)
+				res, _, err := idx.objectSearch(context.TODO(), 10, filter, nil, nil, nil,
+					additional.Properties{}, nil, "", 0)
+				require.Nil(t, err)
+				assert.Equal(t, tc.expected, docIDsOf(res))
+			})
+		}
+
+		for _, tc := range bm25Cases {
+			t.Run("bm25 "+tc.name, func(t *testing.T) {
+				kwr := &searchparams.KeywordRanking{Type: "bm25", Query: tc.query, Fuzzy: tc.fuzzy}
+				res, _, err := idx.objectSearch(context.TODO(), 10, nil, kwr, nil, nil,
+					additional.Properties{}, nil, "", 0)
+				require.Nil(t, err)
+				assert.Equal(t, tc.expected, docIDsOf(res))
+			})
+		}
+	}
+
+	t.Run("terms in the memtables", run)
+
+	t.Run("terms in the segments", func(t *testing.T) {
+		require.Nil(t, idx.ForEachShard(func(name string, shard *Shard) error {
+			return shard.store.FlushMemtables(context.Background())
+		}))
+		run(t)
+	})
+}
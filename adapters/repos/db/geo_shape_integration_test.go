@@ -0,0 +1,194 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestGeoShapeProps(t *testing.T) {
+	dirName := t.TempDir()
+
+	logger, _ := test.NewNullLogger()
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer repo.Shutdown(context.Background())
+
+	migrator := NewMigrator(repo, logger)
+	class := &models.Class{
+		Class:               "Region",
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		Properties: []*models.Property{
+			{
+				Name:     "area",
+				DataType: schema.DataTypeGeoPolygon.PropString(),
+			},
+			{
+				Name:     "route",
+				DataType: schema.DataTypeGeoShape.PropString(),
+			},
+			{
+				Name:     "location",
+				DataType: schema.DataTypeGeoCoordinates.PropString(),
+			},
+		},
+	}
+
+	coordinates := func(points ...[2]float32) []*models.GeoCoordinates {
+		out := make([]*models.GeoCoordinates, len(points))
+		for i := range points {
+			out[i] = &models.GeoCoordinates{Latitude: &points[i][0], Longitude: &points[i][1]}
+		}
+		return out
+	}
+	square := func(lat, lon, size float32) *models.GeoPolygon {
+		return &models.GeoPolygon{Coordinates: coordinates(
+			[2]float32{lat, lon}, [2]float32{lat, lon + size},
+			[2]float32{lat + size, lon + size}, [2]float32{lat + size, lon},
+		)}
+	}
+
+	ids := []strfmt.UUID{
+		"8d5a3aa2-3c8d-4589-9ae1-3f638f506006",
+		"8d5a3aa2-3c8d-4589-9ae1-3f638f506007",
+		"8d5a3aa2-3c8d-4589-9ae1-3f638f506008",
+	}
+	areas := []*models.GeoPolygon{square(10, 10, 1), square(0, 0, 30), square(40, 40, 1)}
+	routes := []*models.GeoShape{
+		{Type: models.GeoShapeTypePoint, Coordinates: coordinates([2]float32{10.5, 10.5})},
+		{Type: models.GeoShapeTypeLineString, Coordinates: coordinates([2]float32{10.5, 5}, [2]float32{10.5, 15})},
+		{Type: models.GeoShapeTypePoint, Coordinates: coordinates([2]float32{40.5, 40.5})},
+	}
+	locations := coordinates([2]float32{10.5, 10.5}, [2]float32{20, 20}, [2]float32{40.5, 40.5})
+
+	t.Run("creating the class and add objects", func(t *testing.T) {
+		require.Nil(t,
+			migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+		schemaGetter.schema.Objects = &models.Schema{
+			Classes: []*models.Class{class},
+		}
+		for i, id := range ids {
+			obj := &models.Object{
+				Class: class.Class,
+				ID:    id,
+				Properties: map[string]interface{}{
+					"area":     areas[i],
+					"route":    routes[i],
+					"location": locations[i],
+				},
+			}
+			require.Nil(t, repo.PutObject(context.Background(), obj, []float32{1, 2, 3}, nil))
+		}
+	})
+
+	t.Run("filtering", func(t *testing.T) {
+		nearby := filters.GeoPolygon{GeoPolygon: square(9, 9, 3)}
+		elsewhere := filters.GeoPolygon{GeoPolygon: square(50, 50, 1)}
+
+		tests := []struct {
+			name     string
+			prop     string
+			value    filters.GeoPolygon
+			operator filters.Operator
+			expected []strfmt.UUID
+		}{
+			{
+				name:     "polygons within",
+				prop:     "area",
+				value:    nearby,
+				operator: filters.OperatorWithinPolygon,
+				expected: []strfmt.UUID{ids[0]},
+			},
+			{
+				name:     "polygons intersecting",
+				prop:     "area",
+				value:    nearby,
+				operator: filters.OperatorIntersects,
+				expected: []strfmt.UUID{ids[0], ids[1]},
+			},
+			{
+				name:     "shapes within",
+				prop:     "route",
+				value:    nearby,
+				operator: filters.OperatorWithinPolygon,
+				expected: []strfmt.UUID{ids[0]},
+			},
+			{
+				name:     "shapes intersecting",
+				prop:     "route",
+				value:    nearby,
+				operator: filters.OperatorIntersects,
+				expected: []strfmt.UUID{ids[0], ids[1]},
+			},
+			{
+				name:     "coordinates within",
+				prop:     "location",
+				value:    nearby,
+				operator: filters.OperatorWithinPolygon,
+				expected: []strfmt.UUID{ids[0]},
+			},
+			{
+				name:     "coordinates intersecting",
+				prop:     "location",
+				value:    nearby,
+				operator: filters.OperatorIntersects,
+				expected: []strfmt.UUID{ids[0]},
+			},
+			{
+				name:     "polygons intersecting elsewhere",
+				prop:     "area",
+				value:    elsewhere,
+				operator: filters.OperatorIntersects,
+				expected: []strfmt.UUID{},
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				res, err := repo.Search(context.Background(), dto.GetParams{
+					ClassName:  class.Class,
+					Pagination: &filters.Pagination{Limit: 10},
+					Filters:    buildFilter(tt.prop, tt.value, tt.operator, schema.DataTypeGeoPolygon),
+				})
+				require.Nil(t, err)
+
+				found := make([]strfmt.UUID, len(res))
+				for i := range res {
+					found[i] = res[i].ID
+				}
+				assert.ElementsMatch(t, tt.expected, found)
+			})
+		}
+	})
+}
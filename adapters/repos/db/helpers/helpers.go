@@ -22,6 +22,9 @@ var (
 	ObjectsBucketLSM           = "objects"
 	CompressedObjectsBucketLSM = "compressed_objects"
 	DimensionsBucketLSM        = "dimensions"
+	BlobsBucketLSM             = "blobs"
+	BlobRefsBucketLSM          = "blob_refs"
+	VectorsQueueBucketLSM      = "vectors_queue"
 	DocIDBucket                = []byte("doc_ids")
 )
 
@@ -71,3 +74,15 @@ func TempBucketFromBucketName(bucketName string) string {
 func BucketSearchableFromPropNameLSM(propName string) string {
 	return BucketFromPropNameLSM(propName + "_searchable")
 }
+
+// BucketRangeableFromPropNameLSM creates the name of the bucket which holds
+// the bit slices of the range index of a prop
+func BucketRangeableFromPropNameLSM(propName string) string {
+	return BucketFromPropNameLSM(propName + "_rangeable")
+}
+
+// BucketSparseVectorFromPropNameLSM creates the name of the bucket which holds
+// the posting lists of a sparseVector prop, one per dimension
+func BucketSparseVectorFromPropNameLSM(propName string) string {
+	return BucketFromPropNameLSM(propName + "_sparse")
+}
@@ -0,0 +1,192 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package helpers
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// stemmer reduces a lowercased word to its stem
+type stemmer func(word []rune) []rune
+
+// stemmers are the stemmers of the stem_* tokenizations
+var stemmers = map[string]stemmer{
+	models.PropertyTokenizationStemEn: stemEnglish,
+	models.PropertyTokenizationStemDe: stemGerman,
+	models.PropertyTokenizationStemFr: stemFrench,
+	models.PropertyTokenizationStemEs: stemSpanish,
+	models.PropertyTokenizationStemIt: stemItalian,
+}
+
+// tokenizeStemmed splits like word and reduces every term to its stem
+func tokenizeStemmed(in string, stem stemmer) []string {
+	terms := tokenizeWord(in)
+	for i := range terms {
+		terms[i] = string(stem([]rune(terms[i])))
+	}
+	return terms
+}
+
+// tokenizeStemmedWithWildcards splits like word with wildcards. Terms
+// containing wildcards are kept as they are, as their stem is unknown.
+func tokenizeStemmedWithWildcards(in string, stem stemmer) []string {
+	terms := tokenizeWordWithWildcards(in)
+	for i := range terms {
+		if !strings.ContainsAny(terms[i], "*?") {
+			terms[i] = string(stem([]rune(terms[i])))
+		}
+	}
+	return terms
+}
+
+// The stemmers for German, French, Spanish and Italian are the light and
+// minimal stemmers described by Jacques Savoy in "Light Stemming Approaches
+// for the French, Portuguese, German and Hungarian Languages" and "Report on
+// CLEF-2001 Experiments". They only remove the most common inflectional
+// suffixes, which works well for search.
+
+func stemGerman(s []rune) []rune {
+	s = normalizeAccents(s)
+	s = s[:stemGermanStep1(s)]
+	return s[:stemGermanStep2(s)]
+}
+
+func stemGermanStep1(s []rune) int {
+	n := len(s)
+	switch {
+	case n > 5 && hasSuffix(s, "ern"):
+		return n - 3
+	case n > 4 && (hasSuffix(s, "em") || hasSuffix(s, "en") ||
+		hasSuffix(s, "er") || hasSuffix(s, "es")):
+		return n - 2
+	case n > 3 && s[n-1] == 'e':
+		return n - 1
+	case n > 3 && s[n-1] == 's' && isGermanStEnding(s[n-2]):
+		return n - 1
+	}
+	return n
+}
+
+func stemGermanStep2(s []rune) int {
+	n := len(s)
+	switch {
+	case n > 5 && hasSuffix(s, "est"):
+		return n - 3
+	case n > 4 && (hasSuffix(s, "er") || hasSuffix(s, "en")):
+		return n - 2
+	case n > 4 && hasSuffix(s, "st") && isGermanStEnding(s[n-3]):
+		return n - 2
+	}
+	return n
+}
+
+// isGermanStEnding returns whether r is a valid ending before the suffix st
+func isGermanStEnding(r rune) bool {
+	switch r {
+	case 'b', 'd', 'f', 'g', 'h', 'k', 'l', 'm', 'n', 't':
+		return true
+	}
+	return false
+}
+
+func stemFrench(s []rune) []rune {
+	n := len(s)
+	if n < 6 {
+		return s
+	}
+
+	if s[n-1] == 'x' {
+		if s[n-3] == 'a' && s[n-2] == 'u' {
+			s[n-2] = 'l'
+		}
+		return s[:n-1]
+	}
+
+	for _, suffix := range []rune{'s', 'r', 'e', 'é'} {
+		if s[n-1] == suffix {
+			n--
+		}
+	}
+	if s[n-1] == s[n-2] && unicode.IsLetter(s[n-1]) {
+		n--
+	}
+	return s[:n]
+}
+
+func stemSpanish(s []rune) []rune {
+	n := len(s)
+	if n < 5 {
+		return s
+	}
+	s = normalizeAccents(s)
+
+	switch s[n-1] {
+	case 'o', 'a', 'e':
+		return s[:n-1]
+	case 's':
+		switch {
+		case s[n-2] == 'e' && s[n-3] == 's' && s[n-4] == 'e':
+			return s[:n-2]
+		case s[n-2] == 'e' && s[n-3] == 'c':
+			s[n-3] = 'z'
+			return s[:n-2]
+		case s[n-2] == 'o' || s[n-2] == 'a' || s[n-2] == 'e':
+			return s[:n-2]
+		}
+	}
+	return s
+}
+
+func stemItalian(s []rune) []rune {
+	n := len(s)
+	if n < 6 {
+		return s
+	}
+	s = normalizeAccents(s)
+
+	switch s[n-1] {
+	case 'e', 'i':
+		return s[:n-1]
+	case 'a', 'o':
+		if s[n-2] == 'i' {
+			return s[:n-2]
+		}
+		return s[:n-1]
+	}
+	return s
+}
+
+// normalizeAccents replaces the accented vowels of s with their base letter
+func normalizeAccents(s []rune) []rune {
+	for i, r := range s {
+		switch r {
+		case 'à', 'á', 'â', 'ä':
+			s[i] = 'a'
+		case 'è', 'é', 'ê', 'ë':
+			s[i] = 'e'
+		case 'ì', 'í', 'î', 'ï':
+			s[i] = 'i'
+		case 'ò', 'ó', 'ô', 'ö':
+			s[i] = 'o'
+		case 'ù', 'ú', 'û', 'ü':
+			s[i] = 'u'
+		}
+	}
+	return s
+}
+
+func hasSuffix(s []rune, suffix string) bool {
+	return strings.HasSuffix(string(s), suffix)
+}
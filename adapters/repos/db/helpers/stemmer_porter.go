@@ -0,0 +1,230 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package helpers
+
+// stemEnglish implements the stemming algorithm of Martin Porter, "An
+// algorithm for suffix stripping", 1980. Words containing other letters than
+// a-z are kept as they are.
+func stemEnglish(s []rune) []rune {
+	if len(s) <= 2 {
+		return s
+	}
+	for _, r := range s {
+		if r < 'a' || r > 'z' {
+			return s
+		}
+	}
+
+	p := &porter{b: s}
+	p.step1a()
+	p.step1b()
+	p.step1c()
+	p.step2()
+	p.step3()
+	p.step4()
+	p.step5()
+	return p.b
+}
+
+type porter struct {
+	b []rune
+}
+
+// isConsonant returns whether b[i] is a consonant. y is a consonant unless
+// it follows another consonant.
+func (p *porter) isConsonant(i int) bool {
+	switch p.b[i] {
+	case 'a', 'e', 'i', 'o', 'u':
+		return false
+	case 'y':
+		return i == 0 || !p.isConsonant(i-1)
+	}
+	return true
+}
+
+// measure returns m of the stem b[:n], which has the form [C](VC){m}[V]
+func (p *porter) measure(n int) int {
+	m, i := 0, 0
+	for i < n && p.isConsonant(i) {
+		i++
+	}
+	for i < n {
+		for i < n && !p.isConsonant(i) {
+			i++
+		}
+		if i >= n {
+			break
+		}
+		for i < n && p.isConsonant(i) {
+			i++
+		}
+		m++
+	}
+	return m
+}
+
+// hasVowel returns whether the stem b[:n] contains a vowel
+func (p *porter) hasVowel(n int) bool {
+	for i := 0; i < n; i++ {
+		if !p.isConsonant(i) {
+			return true
+		}
+	}
+	return false
+}
+
+// endsDoubleConsonant returns whether the stem b[:n] ends with a double
+// consonant
+func (p *porter) endsDoubleConsonant(n int) bool {
+	return n >= 2 && p.b[n-1] == p.b[n-2] && p.isConsonant(n-1)
+}
+
+// endsCVC returns whether the stem b[:n] ends with consonant-vowel-consonant
+// where the last consonant is not w, x or y
+func (p *porter) endsCVC(n int) bool {
+	if n < 3 || !p.isConsonant(n-3) || p.isConsonant(n-2) || !p.isConsonant(n-1) {
+		return false
+	}
+	switch p.b[n-1] {
+	case 'w', 'x', 'y':
+		return false
+	}
+	return true
+}
+
+func (p *porter) endsWith(suffix string) bool {
+	return hasSuffix(p.b, suffix)
+}
+
+// stem returns the length of the stem before suffix
+func (p *porter) stem(suffix string) int {
+	return len(p.b) - len([]rune(suffix))
+}
+
+func (p *porter) replace(suffix, replacement string) {
+	p.b = append(p.b[:p.stem(suffix)], []rune(replacement)...)
+}
+
+// replaceFirst replaces the first matching suffix of rules if the measure of
+// its stem is greater than min
+func (p *porter) replaceFirst(rules [][2]string, min int) {
+	for _, rule := range rules {
+		if p.endsWith(rule[0]) {
+			if p.measure(p.stem(rule[0])) > min {
+				p.replace(rule[0], rule[1])
+			}
+			return
+		}
+	}
+}
+
+func (p *porter) step1a() {
+	switch {
+	case p.endsWith("sses"):
+		p.replace("sses", "ss")
+	case p.endsWith("ies"):
+		p.replace("ies", "i")
+	case p.endsWith("ss"):
+	case p.endsWith("s"):
+		p.replace("s", "")
+	}
+}
+
+func (p *porter) step1b() {
+	if p.endsWith("eed") {
+		if p.measure(p.stem("eed")) > 0 {
+			p.replace("eed", "ee")
+		}
+		return
+	}
+
+	removed := false
+	for _, suffix := range []string{"ed", "ing"} {
+		if p.endsWith(suffix) && p.hasVowel(p.stem(suffix)) {
+			p.replace(suffix, "")
+			removed = true
+			break
+		}
+	}
+	if !removed {
+		return
+	}
+
+	n := len(p.b)
+	switch {
+	case p.endsWith("at"), p.endsWith("bl"), p.endsWith("iz"):
+		p.b = append(p.b, 'e')
+	case p.endsDoubleConsonant(n):
+		switch p.b[n-1] {
+		case 'l', 's', 'z':
+		default:
+			p.b = p.b[:n-1]
+		}
+	case p.measure(n) == 1 && p.endsCVC(n):
+		p.b = append(p.b, 'e')
+	}
+}
+
+func (p *porter) step1c() {
+	if p.endsWith("y") && p.hasVowel(p.stem("y")) {
+		p.b[len(p.b)-1] = 'i'
+	}
+}
+
+func (p *porter) step2() {
+	p.replaceFirst([][2]string{
+		{"ational", "ate"}, {"tional", "tion"}, {"enci", "ence"}, {"anci", "ance"},
+		{"izer", "ize"}, {"abli", "able"}, {"alli", "al"}, {"entli", "ent"},
+		{"eli", "e"}, {"ousli", "ous"}, {"ization", "ize"}, {"ation", "ate"},
+		{"ator", "ate"}, {"alism", "al"}, {"iveness", "ive"}, {"fulness", "ful"},
+		{"ousness", "ous"}, {"aliti", "al"}, {"iviti", "ive"}, {"biliti", "ble"},
+	}, 0)
+}
+
+func (p *porter) step3() {
+	p.replaceFirst([][2]string{
+		{"icate", "ic"}, {"ative", ""}, {"alize", "al"}, {"iciti", "ic"},
+		{"ical", "ic"}, {"ful", ""}, {"ness", ""},
+	}, 0)
+}
+
+func (p *porter) step4() {
+	for _, suffix := range []string{
+		"al", "ance", "ence", "er", "ic", "able", "ible", "ant", "ement",
+		"ment", "ent", "ion", "ou", "ism", "ate", "iti", "ous", "ive", "ize",
+	} {
+		if !p.endsWith(suffix) {
+			continue
+		}
+		n := p.stem(suffix)
+		if suffix == "ion" && (n == 0 || (p.b[n-1] != 's' && p.b[n-1] != 't')) {
+			return
+		}
+		if p.measure(n) > 1 {
+			p.b = p.b[:n]
+		}
+		return
+	}
+}
+
+func (p *porter) step5() {
+	n := len(p.b)
+	if p.b[n-1] == 'e' {
+		if m := p.measure(n - 1); m > 1 || (m == 1 && !p.endsCVC(n-1)) {
+			p.b = p.b[:n-1]
+			n--
+		}
+	}
+	if n > 1 && p.b[n-1] == 'l' && p.endsDoubleConsonant(n) && p.measure(n) > 1 {
+		p.b = p.b[:n-1]
+	}
+}
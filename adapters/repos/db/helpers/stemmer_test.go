@@ -0,0 +1,88 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package helpers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestStemmers(t *testing.T) {
+	type testCase struct {
+		word     string
+		expected string
+	}
+
+	testCasesByTokenization := map[string][]testCase{
+		models.PropertyTokenizationStemEn: {
+			{"caresses", "caress"},
+			{"ponies", "poni"},
+			{"cats", "cat"},
+			{"feed", "feed"},
+			{"agreed", "agre"},
+			{"motoring", "motor"},
+			{"sing", "sing"},
+			{"conflated", "conflat"},
+			{"sized", "size"},
+			{"hopping", "hop"},
+			{"falling", "fall"},
+			{"filing", "file"},
+			{"happy", "happi"},
+			{"relational", "relat"},
+			{"conditional", "condit"},
+			{"rational", "ration"},
+			{"digitizer", "digit"},
+			{"generalization", "gener"},
+			{"oscillators", "oscil"},
+			{"hopeful", "hope"},
+			{"goodness", "good"},
+			{"controlling", "control"},
+			{"probate", "probat"},
+			{"cease", "ceas"},
+			{"café", "café"},
+		},
+		models.PropertyTokenizationStemDe: {
+			{"häuser", "haus"},
+			{"kindern", "kind"},
+			{"schönsten", "schon"},
+			{"katzen", "katz"},
+		},
+		models.PropertyTokenizationStemFr: {
+			{"chevaux", "cheval"},
+			{"chanteuses", "chanteus"},
+			{"arrivée", "arriv"},
+			{"gros", "gros"},
+		},
+		models.PropertyTokenizationStemEs: {
+			{"canciones", "cancion"},
+			{"luces", "luz"},
+			{"perros", "perr"},
+			{"casa", "casa"},
+		},
+		models.PropertyTokenizationStemIt: {
+			{"ragazzi", "ragazz"},
+			{"camicia", "camic"},
+			{"libro", "libro"},
+			{"università", "universit"},
+		},
+	}
+
+	for tokenization, testCases := range testCasesByTokenization {
+		for _, tc := range testCases {
+			t.Run(tokenization+" "+tc.word, func(t *testing.T) {
+				assert.Equal(t, []string{tc.expected}, Tokenize(tokenization, tc.word))
+			})
+		}
+	}
+}
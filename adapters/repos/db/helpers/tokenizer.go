@@ -16,6 +16,8 @@ import (
 	"unicode"
 
 	"github.com/weaviate/weaviate/entities/models"
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
 )
 
 var Tokenizations []string = []string{
@@ -23,6 +25,15 @@ var Tokenizations []string = []string{
 	models.PropertyTokenizationLowercase,
 	models.PropertyTokenizationWhitespace,
 	models.PropertyTokenizationField,
+	models.PropertyTokenizationLowercaseKeyword,
+	models.PropertyTokenizationLowercaseKeywordTr,
+	models.PropertyTokenizationLowercaseKeywordDe,
+	models.PropertyTokenizationCjk,
+	models.PropertyTokenizationStemEn,
+	models.PropertyTokenizationStemDe,
+	models.PropertyTokenizationStemFr,
+	models.PropertyTokenizationStemEs,
+	models.PropertyTokenizationStemIt,
 }
 
 func Tokenize(tokenization string, in string) []string {
@@ -35,7 +46,18 @@ func Tokenize(tokenization string, in string) []string {
 		return tokenizeWhitespace(in)
 	case models.PropertyTokenizationField:
 		return tokenizeField(in)
+	case models.PropertyTokenizationLowercaseKeyword:
+		return tokenizeLowercaseKeyword(in)
+	case models.PropertyTokenizationLowercaseKeywordTr:
+		return tokenizeLowercaseKeywordTr(in)
+	case models.PropertyTokenizationLowercaseKeywordDe:
+		return tokenizeLowercaseKeywordDe(in)
+	case models.PropertyTokenizationCjk:
+		return tokenizeCJK(in)
 	default:
+		if stem, ok := stemmers[tokenization]; ok {
+			return tokenizeStemmed(in, stem)
+		}
 		return []string{}
 	}
 }
@@ -50,7 +72,18 @@ func TokenizeWithWildcards(tokenization string, in string) []string {
 		return tokenizeWhitespace(in)
 	case models.PropertyTokenizationField:
 		return tokenizeField(in)
+	case models.PropertyTokenizationLowercaseKeyword:
+		return tokenizeLowercaseKeyword(in)
+	case models.PropertyTokenizationLowercaseKeywordTr:
+		return tokenizeLowercaseKeywordTr(in)
+	case models.PropertyTokenizationLowercaseKeywordDe:
+		return tokenizeLowercaseKeywordDe(in)
+	case models.PropertyTokenizationCjk:
+		return tokenizeCJK(in)
 	default:
+		if stem, ok := stemmers[tokenization]; ok {
+			return tokenizeStemmedWithWildcards(in, stem)
+		}
 		return []string{}
 	}
 }
@@ -61,6 +94,25 @@ func tokenizeField(in string) []string {
 	return []string{strings.TrimFunc(in, unicode.IsSpace)}
 }
 
+// tokenizeLowercaseKeyword trims white spaces and lowercases the whole value
+func tokenizeLowercaseKeyword(in string) []string {
+	return []string{strings.ToLower(strings.TrimFunc(in, unicode.IsSpace))}
+}
+
+// tokenizeLowercaseKeywordTr trims white spaces and lowercases the whole value
+// following the Turkish rules, e.g. "I" becomes dotless "ı" and "İ" becomes "i"
+func tokenizeLowercaseKeywordTr(in string) []string {
+	// casers are stateful, so they can not be shared
+	return []string{cases.Lower(language.Turkish).String(strings.TrimFunc(in, unicode.IsSpace))}
+}
+
+// tokenizeLowercaseKeywordDe trims white spaces and case folds the whole value,
+// so that e.g. "Straße" and "STRASSE" result in the same term
+func tokenizeLowercaseKeywordDe(in string) []string {
+	// casers are stateful, so they can not be shared
+	return []string{cases.Fold().String(strings.TrimFunc(in, unicode.IsSpace))}
+}
+
 // tokenizeWhitespace splits on white spaces, does not alter casing
 // (former DataTypeString/Word)
 func tokenizeWhitespace(in string) []string {
@@ -0,0 +1,71 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package helpers
+
+import (
+	"strings"
+	"unicode"
+)
+
+// tokenizeCJK splits Chinese, Japanese and Korean text into overlapping
+// bigrams of characters, as there are no white spaces between their words.
+// Segmenting them without a dictionary this way matches any word of two or
+// more characters. Runs of other scripts are tokenized like word.
+func tokenizeCJK(in string) []string {
+	var terms []string
+	var run []rune
+
+	flushCJK := func() {
+		switch len(run) {
+		case 0:
+		case 1:
+			terms = append(terms, string(run))
+		default:
+			for i := 0; i < len(run)-1; i++ {
+				terms = append(terms, string(run[i:i+2]))
+			}
+		}
+		run = run[:0]
+	}
+
+	var word strings.Builder
+	flushWord := func() {
+		if word.Len() > 0 {
+			terms = append(terms, strings.ToLower(word.String()))
+			word.Reset()
+		}
+	}
+
+	for _, r := range in {
+		switch {
+		case isCJK(r):
+			flushWord()
+			run = append(run, r)
+		case unicode.IsLetter(r) || unicode.IsNumber(r):
+			flushCJK()
+			word.WriteRune(r)
+		default:
+			flushCJK()
+			flushWord()
+		}
+	}
+	flushCJK()
+	flushWord()
+
+	return terms
+}
+
+func isCJK(r rune) bool {
+	// the prolonged sound mark is shared by hiragana and katakana, so it is
+	// not part of either script
+	return r == 'ー' || unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul)
+}
@@ -0,0 +1,91 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package helpers
+
+import (
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// TokenSpan is a part of a text together with the terms it is tokenized to.
+// Start and End are byte offsets into the text.
+type TokenSpan struct {
+	Start int
+	End   int
+	Terms []string
+}
+
+// TokenSpans splits the text into the parts which are tokenized separately.
+// The terms of all spans are the terms Tokenize returns for the text. Spans
+// of CJK text can result in several terms, spans of the keyword
+// tokenizations cover the whole trimmed text.
+func TokenSpans(tokenization string, in string) []TokenSpan {
+	var isSeparator func(r rune) bool
+	switch tokenization {
+	case models.PropertyTokenizationField,
+		models.PropertyTokenizationLowercaseKeyword,
+		models.PropertyTokenizationLowercaseKeywordTr,
+		models.PropertyTokenizationLowercaseKeywordDe:
+		isSeparator = nil
+	case models.PropertyTokenizationWhitespace,
+		models.PropertyTokenizationLowercase,
+		models.PropertyTokenizationCjk:
+		isSeparator = unicode.IsSpace
+	default:
+		isSeparator = func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+		}
+	}
+
+	if isSeparator == nil {
+		start, end := 0, len(in)
+		for start < end {
+			r, size := utf8.DecodeRuneInString(in[start:])
+			if !unicode.IsSpace(r) {
+				break
+			}
+			start += size
+		}
+		for end > start {
+			r, size := utf8.DecodeLastRuneInString(in[:end])
+			if !unicode.IsSpace(r) {
+				break
+			}
+			end -= size
+		}
+		return []TokenSpan{{Start: start, End: end, Terms: Tokenize(tokenization, in)}}
+	}
+
+	var spans []TokenSpan
+	start := -1
+	flush := func(end int) {
+		if start >= 0 {
+			spans = append(spans, TokenSpan{
+				Start: start, End: end,
+				Terms: Tokenize(tokenization, in[start:end]),
+			})
+			start = -1
+		}
+	}
+	for i, r := range in {
+		if isSeparator(r) {
+			flush(i)
+		} else if start < 0 {
+			start = i
+		}
+	}
+	flush(len(in))
+
+	return spans
+}
@@ -44,6 +44,18 @@ func TestTokenize(t *testing.T) {
 				tokenization: models.PropertyTokenizationWord,
 				expected:     []string{"hello", "you", "beautiful", "world"},
 			},
+			{
+				tokenization: models.PropertyTokenizationLowercaseKeyword,
+				expected:     []string{"hello you*-beautiful_world?!"},
+			},
+			{
+				tokenization: models.PropertyTokenizationLowercaseKeywordTr,
+				expected:     []string{"hello you*-beautiful_world?!"},
+			},
+			{
+				tokenization: models.PropertyTokenizationLowercaseKeywordDe,
+				expected:     []string{"hello you*-beautiful_world?!"},
+			},
 		}
 
 		for _, tc := range testCases {
@@ -70,6 +82,10 @@ func TestTokenize(t *testing.T) {
 				tokenization: models.PropertyTokenizationWord,
 				expected:     []string{"hello", "you*", "beautiful", "world?"},
 			},
+			{
+				tokenization: models.PropertyTokenizationLowercaseKeyword,
+				expected:     []string{"hello you*-beautiful_world?!"},
+			},
 		}
 
 		for _, tc := range testCases {
@@ -79,6 +95,93 @@ func TestTokenize(t *testing.T) {
 	})
 }
 
+func TestTokenizeLowercaseKeywordLocales(t *testing.T) {
+	type testCase struct {
+		tokenization string
+		input        string
+		expected     string
+	}
+
+	testCases := []testCase{
+		{
+			tokenization: models.PropertyTokenizationLowercaseKeyword,
+			input:        " DIYARBAKIR Straße ",
+			expected:     "diyarbakir straße",
+		},
+		{
+			tokenization: models.PropertyTokenizationLowercaseKeywordTr,
+			input:        " İSTANBUL ",
+			expected:     "istanbul",
+		},
+		{
+			tokenization: models.PropertyTokenizationLowercaseKeywordTr,
+			input:        "DİYARBAKIR",
+			expected:     "diyarbakır",
+		},
+		{
+			tokenization: models.PropertyTokenizationLowercaseKeywordDe,
+			input:        " Straße ",
+			expected:     "strasse",
+		},
+		{
+			tokenization: models.PropertyTokenizationLowercaseKeywordDe,
+			input:        "STRASSE",
+			expected:     "strasse",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.tokenization+" "+tc.input, func(t *testing.T) {
+			assert.Equal(t, []string{tc.expected}, Tokenize(tc.tokenization, tc.input))
+		})
+	}
+}
+
+func TestTokenizeCJK(t *testing.T) {
+	type testCase struct {
+		input    string
+		expected []string
+	}
+
+	testCases := []testCase{
+		{
+			input:    "東京都",
+			expected: []string{"東京", "京都"},
+		},
+		{
+			input:    "我爱北京",
+			expected: []string{"我爱", "爱北", "北京"},
+		},
+		{
+			input:    "コーヒー",
+			expected: []string{"コー", "ーヒ", "ヒー"},
+		},
+		{
+			input:    "猫",
+			expected: []string{"猫"},
+		},
+		{
+			input:    "Weaviateは東京で2023年",
+			expected: []string{"weaviate", "は東", "東京", "京で", "2023", "年"},
+		},
+		{
+			input:    "서울 특별시",
+			expected: []string{"서울", "특별", "별시"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			assert.Equal(t, tc.expected, Tokenize(models.PropertyTokenizationCjk, tc.input))
+		})
+	}
+}
+
+func TestTokenizeStemmedWithWildcards(t *testing.T) {
+	terms := TokenizeWithWildcards(models.PropertyTokenizationStemEn, "Running connect* horses")
+	assert.Equal(t, []string{"run", "connect*", "hors"}, terms)
+}
+
 func TestTokenizeAndCountDuplicates(t *testing.T) {
 	input := "Hello You Beautiful World! hello you beautiful world!"
 
@@ -141,3 +244,47 @@ func TestTokenizeAndCountDuplicates(t *testing.T) {
 		})
 	}
 }
+
+func TestTokenSpans(t *testing.T) {
+	text := " Hello, wörld-wide  Web "
+
+	for _, tc := range []struct {
+		tokenization string
+		expected     []TokenSpan
+	}{
+		{
+			models.PropertyTokenizationWord,
+			[]TokenSpan{
+				{Start: 1, End: 6, Terms: []string{"hello"}},
+				{Start: 8, End: 14, Terms: []string{"wörld"}},
+				{Start: 15, End: 19, Terms: []string{"wide"}},
+				{Start: 21, End: 24, Terms: []string{"web"}},
+			},
+		},
+		{
+			models.PropertyTokenizationWhitespace,
+			[]TokenSpan{
+				{Start: 1, End: 7, Terms: []string{"Hello,"}},
+				{Start: 8, End: 19, Terms: []string{"wörld-wide"}},
+				{Start: 21, End: 24, Terms: []string{"Web"}},
+			},
+		},
+		{
+			models.PropertyTokenizationField,
+			[]TokenSpan{
+				{Start: 1, End: 24, Terms: []string{"Hello, wörld-wide  Web"}},
+			},
+		},
+	} {
+		t.Run(tc.tokenization, func(t *testing.T) {
+			spans := TokenSpans(tc.tokenization, text)
+			assert.Equal(t, tc.expected, spans)
+
+			var terms []string
+			for _, span := range spans {
+				terms = append(terms, span.Terms...)
+			}
+			assert.Equal(t, Tokenize(tc.tokenization, text), terms)
+		})
+	}
+}
@@ -14,6 +14,7 @@ package db
 import (
 	"context"
 	"fmt"
+	"io"
 	"os"
 	"runtime"
 	"runtime/debug"
@@ -31,11 +32,13 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted/stopwords"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
 	"github.com/weaviate/weaviate/adapters/repos/db/sorter"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/aggregation"
 	"github.com/weaviate/weaviate/entities/autocut"
+	"github.com/weaviate/weaviate/entities/diskio"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/multi"
@@ -119,15 +122,21 @@ type Index struct {
 	shards                shardMap
 	Config                IndexConfig
 	vectorIndexUserConfig schema.VectorIndexConfig
-	getSchema             schemaUC.SchemaGetter
-	logger                logrus.FieldLogger
-	remote                *sharding.RemoteIndex
-	stopwords             *stopwords.Detector
-	replicator            *replica.Replicator
+	// vector index configs of the named vector spaces of the class
+	targetVectorIndexUserConfigs map[string]schema.VectorIndexConfig
+	getSchema                    schemaUC.SchemaGetter
+	logger                       logrus.FieldLogger
+	remote                       *sharding.RemoteIndex
+	stopwords                    *stopwords.Detector
+	replicator                   *replica.Replicator
 
 	invertedIndexConfig     schema.InvertedIndexConfig
 	invertedIndexConfigLock sync.Mutex
 
+	// per-class overrides of the memtable flush thresholds
+	memtableConfig     *models.MemtableConfig
+	memtableConfigLock sync.Mutex
+
 	// This lock should be used together with the db indexLock.
 	//
 	// The db indexlock locks the map that contains all indices against changes and should be used while iterating.
@@ -144,13 +153,36 @@ type Index struct {
 
 	metrics         *Metrics
 	centralJobQueue chan job
+	promMetrics     *monitoring.PrometheusMetrics
 
 	partitioningEnabled bool
+	quotaUsage          quotaUsageCache
 
 	cycleCallbacks *indexCycleCallbacks
 
 	backupMutex backupMutex
 	lastBackup  atomic.Pointer[BackupState]
+
+	// lazyShards holds the shards which are not loaded yet, if shards are
+	// loaded lazily at startup. Values are *lazyShard.
+	lazyShards sync.Map
+
+	// frozenShardsLock serializes offloading and restoring shards of FROZEN
+	// tenants, it also guards pendingOffloads
+	frozenShardsLock sync.Mutex
+	pendingOffloads  map[string]*pendingOffload
+
+	propertyMigrations propertyMigrations
+
+	// geoProps are the names of the geo properties of the class. The files of
+	// their indexes are named after them, so they are needed to drop a shard
+	// even when the class is no longer part of the schema.
+	geoProps     []string
+	geoPropsLock sync.RWMutex
+
+	// dataVersion changes with every write to a local shard and every change
+	// of the class, see DB.DataVersion
+	dataVersion atomic.Uint64
 }
 
 func (i *Index) ID() string {
@@ -171,7 +203,8 @@ func NewIndex(ctx context.Context, cfg IndexConfig,
 	replicaClient replica.Client,
 	promMetrics *monitoring.PrometheusMetrics, class *models.Class, jobQueueCh chan job,
 ) (*Index, error) {
-	sd, err := stopwords.NewDetectorFromConfig(invertedIndexConfig.Stopwords)
+	sd, err := stopwords.NewDetectorFromConfigWithSets(invertedIndexConfig.Stopwords,
+		stopwordSets(sg))
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to create new index")
 	}
@@ -184,22 +217,31 @@ func NewIndex(ctx context.Context, cfg IndexConfig,
 	}
 
 	index := &Index{
-		Config:                cfg,
-		getSchema:             sg,
-		logger:                logger,
-		classSearcher:         cs,
-		vectorIndexUserConfig: vectorIndexUserConfig,
-		invertedIndexConfig:   invertedIndexConfig,
-		stopwords:             sd,
-		replicator:            repl,
+		Config:                       cfg,
+		getSchema:                    sg,
+		logger:                       logger,
+		classSearcher:                cs,
+		vectorIndexUserConfig:        vectorIndexUserConfig,
+		targetVectorIndexUserConfigs: targetVectorIndexConfigs(class),
+		invertedIndexConfig:          invertedIndexConfig,
+		memtableConfig:               memtableConfigFromClass(class),
+		stopwords:                    sd,
+		replicator:                   repl,
 		remote: sharding.NewRemoteIndex(cfg.ClassName.String(), sg,
 			nodeResolver, remoteClient),
 		metrics:             NewMetrics(logger, promMetrics, cfg.ClassName.String(), "n/a"),
+		promMetrics:         promMetrics,
 		centralJobQueue:     jobQueueCh,
 		partitioningEnabled: shardState.PartitioningEnabled,
 		backupMutex:         backupMutex{log: logger, retryDuration: mutexRetryDuration, notifyDuration: mutexNotifyDuration},
 	}
 	index.initCycleCallbacks()
+	index.bumpDataVersion()
+	if class != nil {
+		for _, prop := range class.Properties {
+			index.trackGeoProp(prop)
+		}
+	}
 
 	if err := index.checkSingleShardMigration(shardState); err != nil {
 		return nil, errors.Wrap(err, "migrating sharding state from previous version")
@@ -216,6 +258,11 @@ func NewIndex(ctx context.Context, cfg IndexConfig,
 			continue
 		}
 
+		if cfg.LazyLoadShards {
+			index.registerLazyShard(shardName)
+			continue
+		}
+
 		shard, err := NewShard(ctx, promMetrics, shardName, index, class, jobQueueCh)
 		if err != nil {
 			return nil, errors.Wrapf(err, "init shard %s of index %s", shardName, index.ID())
@@ -226,6 +273,7 @@ func NewIndex(ctx context.Context, cfg IndexConfig,
 
 	index.cycleCallbacks.compactionCycle.Start()
 	index.cycleCallbacks.flushCycle.Start()
+	index.cycleCallbacks.objectTTLCycle.Start()
 
 	return index, nil
 }
@@ -241,7 +289,16 @@ func (i *Index) IterateObjects(ctx context.Context, cb func(index *Index, shard
 	})
 }
 
+// ForEachShard applies f to all shards of the index. Shards which are not
+// loaded yet are loaded first.
 func (i *Index) ForEachShard(f func(name string, shard *Shard) error) error {
+	i.loadLazyShards(context.Background())
+	return i.shards.Range(f)
+}
+
+// ForEachLoadedShard applies f to the shards which are loaded, without
+// loading lazily registered shards
+func (i *Index) ForEachLoadedShard(f func(name string, shard *Shard) error) error {
 	return i.shards.Range(f)
 }
 
@@ -253,6 +310,8 @@ func (i *Index) IterateShards(ctx context.Context, cb func(index *Index, shard *
 }
 
 func (i *Index) addProperty(ctx context.Context, prop *models.Property) error {
+	i.trackGeoProp(prop)
+
 	eg := &errgroup.Group{}
 	eg.SetLimit(_NUMCPU)
 
@@ -298,7 +357,7 @@ func (i *Index) updateVectorIndexConfig(ctx context.Context,
 	updated schema.VectorIndexConfig,
 ) error {
 	// an updated is not specific to one shard, but rather all
-	return i.ForEachShard(func(name string, shard *Shard) error {
+	err := i.ForEachShard(func(name string, shard *Shard) error {
 		// At the moment, we don't do anything in an update that could fail, but
 		// technically this should be part of some sort of a two-phase commit  or
 		// have another way to rollback if we have updates that could potentially
@@ -308,6 +367,11 @@ func (i *Index) updateVectorIndexConfig(ctx context.Context,
 		}
 		return nil
 	})
+	if err != nil {
+		return err
+	}
+
+	return i.updateVectorTombstoneCleanupInterval(ctx, updated)
 }
 
 func (i *Index) getInvertedIndexConfig() schema.InvertedIndexConfig {
@@ -320,14 +384,85 @@ func (i *Index) getInvertedIndexConfig() schema.InvertedIndexConfig {
 func (i *Index) updateInvertedIndexConfig(ctx context.Context,
 	updated schema.InvertedIndexConfig,
 ) error {
+	sd, err := stopwords.NewDetectorFromConfigWithSets(updated.Stopwords,
+		stopwordSets(i.getSchema))
+	if err != nil {
+		return errors.Wrap(err, "update inverted index config")
+	}
+
 	i.invertedIndexConfigLock.Lock()
 	defer i.invertedIndexConfigLock.Unlock()
 
 	i.invertedIndexConfig = updated
+	// the detector is shared with the shards, so it is updated in place
+	i.stopwords.Replace(sd)
 
 	return nil
 }
 
+// stopwordSets returns the custom stopword sets which the stopword config of
+// a class can reference
+func stopwordSets(sg schemaUC.SchemaGetter) []*models.StopwordSet {
+	if sg == nil {
+		return nil
+	}
+	if objects := sg.GetSchemaSkipAuth().Objects; objects != nil {
+		return objects.StopwordSets
+	}
+	return nil
+}
+
+func memtableConfigFromClass(class *models.Class) *models.MemtableConfig {
+	if class == nil {
+		return nil
+	}
+	return class.MemtableConfig
+}
+
+// flushThresholds combines the global memtable flush thresholds with the
+// overrides of the class. The global max size is not part of them, as it is
+// already enforced through dynamic memtable sizing.
+func (i *Index) flushThresholds() lsmkv.FlushThresholds {
+	i.memtableConfigLock.Lock()
+	cfg := i.memtableConfig
+	i.memtableConfigLock.Unlock()
+
+	maxSizeMB := int64(0)
+	maxAgeSeconds := int64(i.Config.MemtablesMaxAgeSeconds)
+	maxWALSizeMB := int64(i.Config.MemtablesMaxWALSizeMB)
+	if cfg != nil {
+		if cfg.MaxSizeMB > 0 {
+			maxSizeMB = cfg.MaxSizeMB
+		}
+		if cfg.MaxAgeSeconds > 0 {
+			maxAgeSeconds = cfg.MaxAgeSeconds
+		}
+		if cfg.MaxWALSizeMB > 0 {
+			maxWALSizeMB = cfg.MaxWALSizeMB
+		}
+	}
+
+	return lsmkv.FlushThresholds{
+		MaxSize:    uint64(maxSizeMB) * 1024 * 1024,
+		MaxAge:     time.Duration(maxAgeSeconds) * time.Second,
+		MaxWALSize: uint64(maxWALSizeMB) * 1024 * 1024,
+	}
+}
+
+func (i *Index) updateMemtableConfig(ctx context.Context,
+	updated *models.MemtableConfig,
+) error {
+	i.memtableConfigLock.Lock()
+	i.memtableConfig = updated
+	i.memtableConfigLock.Unlock()
+
+	thresholds := i.flushThresholds()
+	return i.ForEachShard(func(name string, shard *Shard) error {
+		shard.store.SetFlushThresholds(thresholds)
+		return nil
+	})
+}
+
 type IndexConfig struct {
 	RootPath                  string
 	ClassName                 schema.ClassName
@@ -339,8 +474,22 @@ type IndexConfig struct {
 	MemtablesMaxSizeMB        int
 	MemtablesMinActiveSeconds int
 	MemtablesMaxActiveSeconds int
+	MemtablesMaxAgeSeconds    int
+	MemtablesMaxWALSizeMB     int
 	ReplicationFactor         int64
 	AvoidMMap                 bool
+	HNSWCheckpointInterval    time.Duration
+	AsyncIndexing             config.AsyncIndexing
+	LSMCompactionStrategy     string
+	LSMCompactionSizeRatio    float64
+	WALSyncPolicy             diskio.SyncPolicy
+	BlockCache                *lsmkv.BlockCache
+	LSMDirectIO               bool
+	LSMScrubInterval          time.Duration
+	Offloader                 *Offloader
+	Trash                     *Trash
+	ReadOnly                  *ReadOnlyMode
+	LazyLoadShards            bool
 
 	TrackVectorDimensions bool
 }
@@ -349,11 +498,18 @@ func indexID(class schema.ClassName) string {
 	return strings.ToLower(string(class))
 }
 
+// tenantAccessible reports whether the shard of a tenant may be accessed.
+// Shards of FROZEN tenants are restored on their first access.
+func tenantAccessible(status string) bool {
+	return status == models.TenantActivityStatusHOT ||
+		status == models.TenantActivityStatusFROZEN
+}
+
 func (i *Index) determineObjectShard(id strfmt.UUID, tenant string) (string, error) {
 	className := i.Config.ClassName.String()
 	if tenant != "" {
 		if shard, status := i.getSchema.TenantShard(className, tenant); shard != "" {
-			if status == models.TenantActivityStatusHOT {
+			if tenantAccessible(status) {
 				return shard, nil
 			}
 			return "", objects.NewErrMultiTenancy(fmt.Errorf("%w: '%s'", errTenantNotActive, tenant))
@@ -397,13 +553,13 @@ func (i *Index) putObject(ctx context.Context, object *storobj.Object,
 		}
 		cl := replica.ConsistencyLevel(replProps.ConsistencyLevel)
 		if err := i.replicator.PutObject(ctx, shardName, object, cl); err != nil {
-			return fmt.Errorf("replicate insertion: shard=%q: %w", shardName, err)
+			return fmt.Errorf("replicate insertion: shard=%q: %w", shardName, preconditionError(err))
 		}
 		return nil
 	}
 
 	// no replication, remote shard
-	if i.localShard(shardName) == nil {
+	if i.localShard(ctx, shardName) == nil {
 		if err := i.remote.PutObject(ctx, shardName, object); err != nil {
 			return fmt.Errorf("put remote object: shard=%q: %w", shardName, err)
 		}
@@ -414,7 +570,7 @@ func (i *Index) putObject(ctx context.Context, object *storobj.Object,
 	i.backupMutex.RLock()
 	defer i.backupMutex.RUnlock()
 	err = errShardNotFound
-	if shard := i.localShard(shardName); shard != nil { // does shard still exist
+	if shard := i.localShard(ctx, shardName); shard != nil { // does shard still exist
 		err = shard.putObject(ctx, object)
 	}
 	if err != nil {
@@ -429,7 +585,7 @@ func (i *Index) IncomingPutObject(ctx context.Context, shardName string,
 ) error {
 	i.backupMutex.RLock()
 	defer i.backupMutex.RUnlock()
-	localShard := i.localShard(shardName)
+	localShard := i.localShard(ctx, shardName)
 	if localShard == nil {
 		return errShardNotFound
 	}
@@ -594,11 +750,11 @@ func (i *Index) putObjectBatch(ctx context.Context, objects []*storobj.Object,
 			if replProps != nil {
 				errs = i.replicator.PutObjects(ctx, shardName, group.objects,
 					replica.ConsistencyLevel(replProps.ConsistencyLevel))
-			} else if i.localShard(shardName) == nil {
+			} else if i.localShard(ctx, shardName) == nil {
 				errs = i.remote.BatchPutObjects(ctx, shardName, group.objects)
 			} else {
 				i.backupMutex.RLockGuard(func() error {
-					if shard := i.localShard(shardName); shard != nil {
+					if shard := i.localShard(ctx, shardName); shard != nil {
 						errs = shard.putObjectBatch(ctx, group.objects)
 					} else {
 						errs = duplicateErr(errShardNotFound, len(group.objects))
@@ -632,7 +788,7 @@ func (i *Index) IncomingBatchPutObjects(ctx context.Context, shardName string,
 ) []error {
 	i.backupMutex.RLock()
 	defer i.backupMutex.RUnlock()
-	localShard := i.localShard(shardName)
+	localShard := i.localShard(ctx, shardName)
 	if localShard == nil {
 		return duplicateErr(errShardNotFound, len(objects))
 	}
@@ -690,11 +846,11 @@ func (i *Index) addReferencesBatch(ctx context.Context, refs objects.BatchRefere
 			}
 			errs = i.replicator.AddReferences(ctx, shardName, group.refs,
 				replica.ConsistencyLevel(replProps.ConsistencyLevel))
-		} else if i.localShard(shardName) == nil {
+		} else if i.localShard(ctx, shardName) == nil {
 			errs = i.remote.BatchAddReferences(ctx, shardName, group.refs)
 		} else {
 			i.backupMutex.RLockGuard(func() error {
-				if shard := i.localShard(shardName); shard != nil {
+				if shard := i.localShard(ctx, shardName); shard != nil {
 					errs = shard.addReferencesBatch(ctx, group.refs)
 				} else {
 					errs = duplicateErr(errShardNotFound, len(group.refs))
@@ -716,7 +872,7 @@ func (i *Index) IncomingBatchAddReferences(ctx context.Context, shardName string
 ) []error {
 	i.backupMutex.RLock()
 	defer i.backupMutex.RUnlock()
-	localShard := i.localShard(shardName)
+	localShard := i.localShard(ctx, shardName)
 	if localShard == nil {
 		return duplicateErr(errShardNotFound, len(refs))
 	}
@@ -757,7 +913,7 @@ func (i *Index) objectByID(ctx context.Context, id strfmt.UUID,
 		return obj, err
 	}
 
-	if shard := i.localShard(shardName); shard != nil {
+	if shard := i.localShard(ctx, shardName); shard != nil {
 		obj, err = shard.objectByID(ctx, id, props, addl)
 		if err != nil {
 			return obj, fmt.Errorf("get local object: shard=%s: %w", shardName, err)
@@ -776,7 +932,7 @@ func (i *Index) IncomingGetObject(ctx context.Context, shardName string,
 	id strfmt.UUID, props search.SelectProperties,
 	additional additional.Properties,
 ) (*storobj.Object, error) {
-	shard := i.localShard(shardName)
+	shard := i.localShard(ctx, shardName)
 	if shard == nil {
 		return nil, errShardNotFound
 	}
@@ -787,7 +943,7 @@ func (i *Index) IncomingGetObject(ctx context.Context, shardName string,
 func (i *Index) IncomingMultiGetObjects(ctx context.Context, shardName string,
 	ids []strfmt.UUID,
 ) ([]*storobj.Object, error) {
-	shard := i.localShard(shardName)
+	shard := i.localShard(ctx, shardName)
 	if shard == nil {
 		return nil, errShardNotFound
 	}
@@ -827,7 +983,7 @@ func (i *Index) multiObjectByID(ctx context.Context,
 		var objects []*storobj.Object
 		var err error
 
-		if shard := i.localShard(shardName); shard != nil {
+		if shard := i.localShard(ctx, shardName); shard != nil {
 			objects, err = shard.multiObjectByID(ctx, group.ids)
 			if err != nil {
 				return nil, errors.Wrapf(err, "shard %s", shard.ID())
@@ -894,7 +1050,7 @@ func (i *Index) exists(ctx context.Context, id strfmt.UUID,
 		return i.replicator.Exists(ctx, cl, shardName, id)
 
 	}
-	if shard := i.localShard(shardName); shard != nil {
+	if shard := i.localShard(ctx, shardName); shard != nil {
 		exists, err = shard.exists(ctx, id)
 		if err != nil {
 			err = fmt.Errorf("exists locally: shard=%q: %w", shardName, err)
@@ -911,7 +1067,7 @@ func (i *Index) exists(ctx context.Context, id strfmt.UUID,
 func (i *Index) IncomingExists(ctx context.Context, shardName string,
 	id strfmt.UUID,
 ) (bool, error) {
-	shard := i.localShard(shardName)
+	shard := i.localShard(ctx, shardName)
 	if shard == nil {
 		return false, errShardNotFound
 	}
@@ -966,7 +1122,7 @@ func (i *Index) objectSearch(ctx context.Context, limit int, filters *filters.Lo
 	}
 
 	if len(outObjects) == len(outScores) {
-		if keywordRanking != nil && keywordRanking.Type == "bm25" {
+		if keywordRanking != nil && (keywordRanking.Type == "bm25" || keywordRanking.Type == "sparseVector") {
 			for ii := range outObjects {
 				oo := outObjects[ii]
 				os := outScores[ii]
@@ -1045,6 +1201,61 @@ func (i *Index) objectSearch(ctx context.Context, limit int, filters *filters.Lo
 	return outObjects, outScores, nil
 }
 
+// objectSearchStream is the unordered counterpart of objectSearch. Instead of
+// querying all shards concurrently and merging their results, the shards are
+// searched one after another and the results of each shard are handed to fn
+// as soon as they are available. This way only a single shard's results are
+// held in memory at a time. Results are ordered by id within a shard only.
+func (i *Index) objectSearchStream(ctx context.Context, limit int, filters *filters.LocalFilter,
+	addlProps additional.Properties, replProps *additional.ReplicationProperties, tenant string,
+	fn func(objs []*storobj.Object) error,
+) error {
+	if err := i.validateMultiTenancy(tenant); err != nil {
+		return err
+	}
+
+	shardNames, err := i.targetShardNames(tenant)
+	if err != nil {
+		return err
+	}
+
+	for _, shardName := range shardNames {
+		if limit <= 0 {
+			return nil
+		}
+
+		objs, _, err := i.objectSearchByShard(ctx, limit, filters, nil, nil, nil,
+			addlProps, []string{shardName})
+		if err != nil {
+			return err
+		}
+		if len(objs) > limit {
+			objs = objs[:limit]
+		}
+		limit -= len(objs)
+		if len(objs) == 0 {
+			continue
+		}
+
+		if i.replicationEnabled() {
+			if replProps == nil {
+				replProps = defaultConsistency(replica.One)
+			}
+			l := replica.ConsistencyLevel(replProps.ConsistencyLevel)
+			if err := i.replicator.CheckConsistency(ctx, l, objs); err != nil {
+				i.logger.WithField("action", "object_search_stream").
+					Errorf("failed to check consistency of search results: %v", err)
+			}
+		}
+
+		if err := fn(objs); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (i *Index) objectSearchByShard(ctx context.Context, limit int, filters *filters.LocalFilter,
 	keywordRanking *searchparams.KeywordRanking, sort []filters.Sort, cursor *filters.Cursor,
 	addlProps additional.Properties, shards []string,
@@ -1062,7 +1273,7 @@ func (i *Index) objectSearchByShard(ctx context.Context, limit int, filters *fil
 			var scores []float32
 			var err error
 
-			if shard := i.localShard(shardName); shard != nil {
+			if shard := i.localShard(ctx, shardName); shard != nil {
 				objs, scores, err = shard.objectSearch(ctx, limit, filters, keywordRanking, sort, cursor, addlProps)
 				if err != nil {
 					return fmt.Errorf(
@@ -1073,8 +1284,8 @@ func (i *Index) objectSearchByShard(ctx context.Context, limit int, filters *fil
 				}
 			} else {
 				objs, scores, err = i.remote.SearchShard(
-					ctx, shardName, nil, limit, filters, keywordRanking,
-					sort, cursor, nil, addlProps, i.replicationEnabled())
+					ctx, shardName, nil, "", limit, filters, keywordRanking,
+					sort, cursor, nil, nil, false, addlProps, i.replicationEnabled())
 				if err != nil {
 					return fmt.Errorf(
 						"remote shard object search %s: %w", shardName, err)
@@ -1093,7 +1304,9 @@ func (i *Index) objectSearchByShard(ctx context.Context, limit int, filters *fil
 		return nil, nil, err
 	}
 
-	if len(resultObjects) == len(resultScores) {
+	// sorted results are already in the order of the sort parameters, the
+	// results of several shards are merged by Index.sort
+	if len(sort) == 0 && len(resultObjects) == len(resultScores) {
 
 		// Force a stable sort order by UUID
 
@@ -1158,13 +1371,13 @@ func (i *Index) mergeGroups(objects []*storobj.Object, dists []float32,
 }
 
 func (i *Index) singleLocalShardObjectVectorSearch(ctx context.Context, searchVector []float32,
-	dist float32, limit int, filters *filters.LocalFilter,
-	sort []filters.Sort, groupBy *searchparams.GroupBy, additional additional.Properties,
-	shardName string,
+	targetVector string, dist float32, limit int, filters *filters.LocalFilter,
+	sort []filters.Sort, groupBy *searchparams.GroupBy, rescore *searchparams.Rescore, exact bool,
+	additional additional.Properties, shardName string,
 ) ([]*storobj.Object, []float32, error) {
-	shard := i.localShard(shardName)
+	shard := i.localShard(ctx, shardName)
 	res, resDists, err := shard.objectVectorSearch(
-		ctx, searchVector, dist, limit, filters, sort, groupBy, additional)
+		ctx, searchVector, targetVector, dist, limit, filters, sort, groupBy, rescore, exact, additional)
 	if err != nil {
 		return nil, nil, errors.Wrapf(err, "shard %s", shard.ID())
 	}
@@ -1181,7 +1394,7 @@ func (i *Index) targetShardNames(tenant string) ([]string, error) {
 	}
 	if tenant != "" {
 		if shard, status := i.getSchema.TenantShard(className, tenant); shard != "" {
-			if status == models.TenantActivityStatusHOT {
+			if tenantAccessible(status) {
 				return []string{shard}, nil
 			}
 			return nil, objects.NewErrMultiTenancy(fmt.Errorf("%w: '%s'", errTenantNotActive, tenant))
@@ -1191,8 +1404,8 @@ func (i *Index) targetShardNames(tenant string) ([]string, error) {
 }
 
 func (i *Index) objectVectorSearch(ctx context.Context, searchVector []float32,
-	dist float32, limit int, filters *filters.LocalFilter, sort []filters.Sort,
-	groupBy *searchparams.GroupBy, additional additional.Properties,
+	targetVector string, dist float32, limit int, filters *filters.LocalFilter, sort []filters.Sort,
+	groupBy *searchparams.GroupBy, rescore *searchparams.Rescore, exact bool, additional additional.Properties,
 	replProps *additional.ReplicationProperties, tenant string,
 ) ([]*storobj.Object, []float32, error) {
 	if err := i.validateMultiTenancy(tenant); err != nil {
@@ -1204,9 +1417,9 @@ func (i *Index) objectVectorSearch(ctx context.Context, searchVector []float32,
 	}
 
 	if len(shardNames) == 1 {
-		if i.localShard(shardNames[0]) != nil {
-			return i.singleLocalShardObjectVectorSearch(ctx, searchVector, dist, limit, filters,
-				sort, groupBy, additional, shardNames[0])
+		if i.localShard(ctx, shardNames[0]) != nil {
+			return i.singleLocalShardObjectVectorSearch(ctx, searchVector, targetVector, dist, limit, filters,
+				sort, groupBy, rescore, exact, additional, shardNames[0])
 		}
 	}
 
@@ -1232,9 +1445,9 @@ func (i *Index) objectVectorSearch(ctx context.Context, searchVector []float32,
 			var resDists []float32
 			var err error
 
-			if shard := i.localShard(shardName); shard != nil {
+			if shard := i.localShard(ctx, shardName); shard != nil {
 				res, resDists, err = shard.objectVectorSearch(
-					ctx, searchVector, dist, limit, filters, sort, groupBy, additional)
+					ctx, searchVector, targetVector, dist, limit, filters, sort, groupBy, rescore, exact, additional)
 				if err != nil {
 					return errors.Wrapf(err, "shard %s", shard.ID())
 				}
@@ -1243,8 +1456,8 @@ func (i *Index) objectVectorSearch(ctx context.Context, searchVector []float32,
 				}
 			} else {
 				res, resDists, err = i.remote.SearchShard(ctx,
-					shardName, searchVector, limit, filters,
-					nil, sort, nil, groupBy, additional, i.replicationEnabled())
+					shardName, searchVector, targetVector, limit, filters,
+					nil, sort, nil, groupBy, rescore, exact, additional, i.replicationEnabled())
 				if err != nil {
 					return errors.Wrapf(err, "remote shard %s", shardName)
 				}
@@ -1297,12 +1510,12 @@ func (i *Index) objectVectorSearch(ctx context.Context, searchVector []float32,
 }
 
 func (i *Index) IncomingSearch(ctx context.Context, shardName string,
-	searchVector []float32, distance float32, limit int, filters *filters.LocalFilter,
+	searchVector []float32, targetVector string, distance float32, limit int, filters *filters.LocalFilter,
 	keywordRanking *searchparams.KeywordRanking, sort []filters.Sort,
-	cursor *filters.Cursor, groupBy *searchparams.GroupBy,
+	cursor *filters.Cursor, groupBy *searchparams.GroupBy, rescore *searchparams.Rescore, exact bool,
 	additional additional.Properties,
 ) ([]*storobj.Object, []float32, error) {
-	shard := i.localShard(shardName)
+	shard := i.localShard(ctx, shardName)
 	if shard == nil {
 		return nil, nil, errShardNotFound
 	}
@@ -1317,7 +1530,7 @@ func (i *Index) IncomingSearch(ctx context.Context, shardName string,
 	}
 
 	res, resDists, err := shard.objectVectorSearch(
-		ctx, searchVector, distance, limit, filters, sort, groupBy, additional)
+		ctx, searchVector, targetVector, distance, limit, filters, sort, groupBy, rescore, exact, additional)
 	if err != nil {
 		return nil, nil, errors.Wrapf(err, "shard %s", shard.ID())
 	}
@@ -1343,13 +1556,13 @@ func (i *Index) deleteObject(ctx context.Context, id strfmt.UUID,
 		}
 		cl := replica.ConsistencyLevel(replProps.ConsistencyLevel)
 		if err := i.replicator.DeleteObject(ctx, shardName, id, cl); err != nil {
-			return fmt.Errorf("replicate deletion: shard=%q %w", shardName, err)
+			return fmt.Errorf("replicate deletion: shard=%q %w", shardName, preconditionError(err))
 		}
 		return nil
 	}
 
 	// no replication, remote shard
-	if i.localShard(shardName) == nil {
+	if i.localShard(ctx, shardName) == nil {
 		if err := i.remote.DeleteObject(ctx, shardName, id); err != nil {
 			return fmt.Errorf("delete remote object: shard=%q: %w", shardName, err)
 		}
@@ -1360,7 +1573,7 @@ func (i *Index) deleteObject(ctx context.Context, id strfmt.UUID,
 	i.backupMutex.RLock()
 	defer i.backupMutex.RUnlock()
 	err = errShardNotFound
-	if shard := i.localShard(shardName); shard != nil {
+	if shard := i.localShard(ctx, shardName); shard != nil {
 		err = shard.deleteObject(ctx, id)
 	}
 	if err != nil {
@@ -1374,15 +1587,45 @@ func (i *Index) IncomingDeleteObject(ctx context.Context, shardName string,
 ) error {
 	i.backupMutex.RLock()
 	defer i.backupMutex.RUnlock()
-	shard := i.localShard(shardName)
+	shard := i.localShard(ctx, shardName)
 	if shard == nil {
 		return errShardNotFound
 	}
 	return shard.deleteObject(ctx, id)
 }
 
-func (i *Index) localShard(name string) *Shard {
-	return i.shards.Load(name)
+func (i *Index) localShard(ctx context.Context, name string) *Shard {
+	shard := i.shards.Load(name)
+	if shard != nil {
+		return shard
+	}
+
+	if i.isLazyShard(name) {
+		shard, err := i.loadLazyShard(ctx, name)
+		if err != nil {
+			i.logger.WithField("action", "load_lazy_shard").
+				WithField("shard", i.shardID(name)).
+				Error(err)
+		}
+		return shard
+	}
+
+	if !i.partitioningEnabled {
+		return nil
+	}
+
+	_, status := i.getSchema.TenantShard(i.Config.ClassName.String(), name)
+	if status != models.TenantActivityStatusFROZEN {
+		return nil
+	}
+	shard, err := i.loadFrozenShard(ctx, name)
+	if err != nil {
+		i.logger.WithField("action", "load_frozen_shard").
+			WithField("shard", i.shardID(name)).
+			Error(err)
+		return nil
+	}
+	return shard
 }
 
 func (i *Index) mergeObject(ctx context.Context, merge objects.MergeDocument,
@@ -1403,13 +1646,13 @@ func (i *Index) mergeObject(ctx context.Context, merge objects.MergeDocument,
 		}
 		cl := replica.ConsistencyLevel(replProps.ConsistencyLevel)
 		if err := i.replicator.MergeObject(ctx, shardName, &merge, cl); err != nil {
-			return fmt.Errorf("replicate single update: %w", err)
+			return fmt.Errorf("replicate single update: %w", preconditionError(err))
 		}
 		return nil
 	}
 
 	// no replication, remote shard
-	if i.localShard(shardName) == nil {
+	if i.localShard(ctx, shardName) == nil {
 		if err := i.remote.MergeObject(ctx, shardName, merge); err != nil {
 			return fmt.Errorf("update remote object: shard=%q: %w", shardName, err)
 		}
@@ -1420,7 +1663,7 @@ func (i *Index) mergeObject(ctx context.Context, merge objects.MergeDocument,
 	i.backupMutex.RLock()
 	defer i.backupMutex.RUnlock()
 	err = errShardNotFound
-	if shard := i.localShard(shardName); shard != nil {
+	if shard := i.localShard(ctx, shardName); shard != nil {
 		err = shard.mergeObject(ctx, merge)
 	}
 	if err != nil {
@@ -1430,12 +1673,84 @@ func (i *Index) mergeObject(ctx context.Context, merge objects.MergeDocument,
 	return nil
 }
 
+// putObjectBlob streams a blob into the blob store of the object's shard and
+// points the blob property of the object to it
+func (i *Index) putObjectBlob(ctx context.Context, id strfmt.UUID, propName string,
+	r io.Reader, updateTime int64, tenant string,
+) error {
+	if err := i.validateMultiTenancy(tenant); err != nil {
+		return err
+	}
+
+	shardName, err := i.determineObjectShard(id, tenant)
+	if err != nil {
+		return objects.NewErrInvalidUserInput("determine shard: %v", err)
+	}
+
+	if i.replicationEnabled() {
+		return fmt.Errorf("blob uploads are not supported for replicated classes")
+	}
+
+	i.backupMutex.RLock()
+	defer i.backupMutex.RUnlock()
+	shard := i.localShard(ctx, shardName)
+	if shard == nil {
+		return fmt.Errorf("blob uploads are not supported for remote shard %q", shardName)
+	}
+
+	if err := shard.putObjectBlob(ctx, id, propName, r, updateTime); err != nil {
+		return fmt.Errorf("put local blob: shard=%q: %w", shardName, err)
+	}
+	return nil
+}
+
+// objectBlob returns a reader for the blob property of an object. Inline
+// blobs can be read from any replica, blobs stored out-of-band only from a
+// local shard. The reader is nil, if the object or property doesn't exist.
+func (i *Index) objectBlob(ctx context.Context, id strfmt.UUID, propName string,
+	replProps *additional.ReplicationProperties, tenant string,
+) (io.ReadCloser, int64, error) {
+	obj, err := i.objectByID(ctx, id, search.SelectProperties{}, additional.Properties{},
+		replProps, tenant)
+	if err != nil || obj == nil {
+		return nil, 0, err
+	}
+
+	props, _ := obj.Properties().(map[string]interface{})
+	value, ok := props[propName].(string)
+	if !ok {
+		return nil, 0, nil
+	}
+
+	if !schema.IsBlobReference(value) {
+		return openInlineBlob(value)
+	}
+
+	shardName, err := i.determineObjectShard(id, tenant)
+	if err != nil {
+		return nil, 0, objects.NewErrInvalidUserInput("determine shard: %v", err)
+	}
+
+	i.backupMutex.RLock()
+	defer i.backupMutex.RUnlock()
+	shard := i.localShard(ctx, shardName)
+	if shard == nil {
+		return nil, 0, fmt.Errorf("blob downloads are not supported for remote shard %q", shardName)
+	}
+
+	r, size, err := shard.openBlob(value)
+	if err != nil {
+		return nil, 0, fmt.Errorf("open local blob: shard=%q: %w", shardName, err)
+	}
+	return r, size, nil
+}
+
 func (i *Index) IncomingMergeObject(ctx context.Context, shardName string,
 	mergeDoc objects.MergeDocument,
 ) error {
 	i.backupMutex.RLock()
 	defer i.backupMutex.RUnlock()
-	shard := i.localShard(shardName)
+	shard := i.localShard(ctx, shardName)
 	if shard == nil {
 		return errShardNotFound
 	}
@@ -1459,7 +1774,7 @@ func (i *Index) aggregate(ctx context.Context,
 	for j, shardName := range shardNames {
 		var err error
 		var res *aggregation.Result
-		if shard := i.localShard(shardName); shard != nil {
+		if shard := i.localShard(ctx, shardName); shard != nil {
 			res, err = shard.aggregate(ctx, params)
 		} else {
 			res, err = i.remote.Aggregate(ctx, shardName, params)
@@ -1471,13 +1786,14 @@ func (i *Index) aggregate(ctx context.Context,
 		results[j] = res
 	}
 
-	return aggregator.NewShardCombiner().Do(results), nil
+	return aggregator.OrderGroups(aggregator.NewShardCombiner().Do(results),
+		params.GroupByOrder, params.Limit), nil
 }
 
 func (i *Index) IncomingAggregate(ctx context.Context, shardName string,
 	params aggregation.Params,
 ) (*aggregation.Result, error) {
-	shard := i.localShard(shardName)
+	shard := i.localShard(ctx, shardName)
 	if shard == nil {
 		return nil, errShardNotFound
 	}
@@ -1486,6 +1802,8 @@ func (i *Index) IncomingAggregate(ctx context.Context, shardName string,
 }
 
 func (i *Index) drop() error {
+	i.cancelOffloads()
+
 	var eg errgroup.Group
 	eg.SetLimit(_NUMCPU * 2)
 	fields := logrus.Fields{"action": "drop_shard", "class": i.Config.ClassName}
@@ -1506,6 +1824,21 @@ func (i *Index) drop() error {
 	defer i.backupMutex.RUnlock()
 
 	i.shards.Range(dropShard)
+	i.lazyShards.Range(func(key, _ any) bool {
+		name := key.(string)
+		if !i.unregisterLazyShard(name) {
+			// loaded meanwhile
+			dropShard(name, i.shards.Load(name))
+			return true
+		}
+		eg.Go(func() error {
+			if err := i.dropShardFiles(name); err != nil {
+				logrus.WithFields(fields).WithField("id", i.shardID(name)).Error(err)
+			}
+			return nil
+		})
+		return true
+	})
 	return eg.Wait()
 }
 
@@ -1517,6 +1850,15 @@ func (i *Index) dropShards(names []string) (commit func(success bool), err error
 	i.backupMutex.RLock()
 	defer i.backupMutex.RUnlock()
 
+	// shards which were never loaded are unregistered, so they are not
+	// loaded while being deleted
+	lazyShards := make([]string, 0)
+	for _, name := range names {
+		if i.unregisterLazyShard(name) {
+			lazyShards = append(lazyShards, name)
+		}
+	}
+
 	// mark deleted shards
 	for _, name := range names {
 		prev, ok := i.shards.Swap(name, nil) // mark
@@ -1533,6 +1875,9 @@ func (i *Index) dropShards(names []string) (commit func(success bool), err error
 		for name, shard := range shards {
 			i.shards.CompareAndSwap(name, nil, shard)
 		}
+		for _, name := range lazyShards {
+			i.registerLazyShard(name)
+		}
 	}
 
 	var eg errgroup.Group
@@ -1558,6 +1903,25 @@ func (i *Index) dropShards(names []string) (commit func(success bool), err error
 				return nil
 			})
 		}
+
+		for _, name := range lazyShards {
+			name := name
+			eg.Go(func() error {
+				if err := i.dropShardFiles(name); err != nil {
+					i.logger.WithField("action", "drop_shard").
+						WithField("shard", i.shardID(name)).Error(err)
+				}
+				return nil
+			})
+		}
+
+		// remove offloaded shards
+		for _, name := range names {
+			if err := i.dropOffloadedShard(name); err != nil {
+				i.logger.WithField("action", "drop_shard").
+					WithField("shard", i.shardID(name)).Error(err)
+			}
+		}
 	}
 
 	return commit, eg.Wait()
@@ -1567,9 +1931,11 @@ func (i *Index) Shutdown(ctx context.Context) error {
 	i.backupMutex.RLock()
 	defer i.backupMutex.RUnlock()
 
+	i.cancelOffloads()
+
 	// TODO run in parallel?
 	// TODO allow every resource cleanup to run, before returning early with error
-	if err := i.ForEachShard(func(name string, shard *Shard) error {
+	if err := i.ForEachLoadedShard(func(name string, shard *Shard) error {
 		if err := shard.shutdown(ctx); err != nil {
 			return errors.Wrapf(err, "shutdown shard %q", name)
 		}
@@ -1586,7 +1952,7 @@ func (i *Index) Shutdown(ctx context.Context) error {
 	if err := i.cycleCallbacks.vectorCommitLoggerCycle.StopAndWait(ctx); err != nil {
 		return fmt.Errorf("stop vector commit logger cycle: %w", err)
 	}
-	if err := i.cycleCallbacks.vectorTombstoneCleanupCycle.StopAndWait(ctx); err != nil {
+	if err := i.cycleCallbacks.stopVectorTombstoneCleanupCycle(ctx); err != nil {
 		return fmt.Errorf("stop vector tombstone cleanup cycle: %w", err)
 	}
 	if err := i.cycleCallbacks.geoPropsCommitLoggerCycle.StopAndWait(ctx); err != nil {
@@ -1595,6 +1961,9 @@ func (i *Index) Shutdown(ctx context.Context) error {
 	if err := i.cycleCallbacks.geoPropsTombstoneCleanupCycle.StopAndWait(ctx); err != nil {
 		return fmt.Errorf("stop geo props tombsobe cleanup cycle: %w", err)
 	}
+	if err := i.cycleCallbacks.objectTTLCycle.StopAndWait(ctx); err != nil {
+		return fmt.Errorf("stop object ttl cycle: %w", err)
+	}
 
 	return nil
 }
@@ -1611,7 +1980,7 @@ func (i *Index) getShardsStatus(ctx context.Context) (map[string]string, error)
 		if !shardState.IsLocalShard(shardName) {
 			status, err = i.remote.GetShardStatus(ctx, shardName)
 		} else {
-			shard := i.localShard(shardName)
+			shard := i.localShard(ctx, shardName)
 			if shard == nil {
 				err = errors.Errorf("shard %s does not exist", shardName)
 			} else {
@@ -1629,7 +1998,7 @@ func (i *Index) getShardsStatus(ctx context.Context) (map[string]string, error)
 }
 
 func (i *Index) IncomingGetShardStatus(ctx context.Context, shardName string) (string, error) {
-	shard := i.localShard(shardName)
+	shard := i.localShard(ctx, shardName)
 	if shard == nil {
 		return "", errShardNotFound
 	}
@@ -1637,14 +2006,14 @@ func (i *Index) IncomingGetShardStatus(ctx context.Context, shardName string) (s
 }
 
 func (i *Index) updateShardStatus(ctx context.Context, shardName, targetStatus string) error {
-	if shard := i.localShard(shardName); shard != nil {
+	if shard := i.localShard(ctx, shardName); shard != nil {
 		return shard.updateStatus(targetStatus)
 	}
 	return i.remote.UpdateShardStatus(ctx, shardName, targetStatus)
 }
 
 func (i *Index) IncomingUpdateShardStatus(ctx context.Context, shardName, targetStatus string) error {
-	shard := i.localShard(shardName)
+	shard := i.localShard(ctx, shardName)
 	if shard == nil {
 		return errShardNotFound
 	}
@@ -1652,7 +2021,7 @@ func (i *Index) IncomingUpdateShardStatus(ctx context.Context, shardName, target
 }
 
 func (i *Index) notifyReady() {
-	i.ForEachShard(func(name string, shard *Shard) error {
+	i.ForEachLoadedShard(func(name string, shard *Shard) error {
 		shard.notifyReady()
 		return nil
 	})
@@ -1677,7 +2046,7 @@ func (i *Index) findDocIDs(ctx context.Context,
 	for _, shardName := range shardNames {
 		var err error
 		var res []uint64
-		if shard := i.localShard(shardName); shard != nil {
+		if shard := i.localShard(ctx, shardName); shard != nil {
 			res, err = shard.findDocIDs(ctx, filters)
 		} else {
 			res, err = i.remote.FindDocIDs(ctx, shardName, filters)
@@ -1695,7 +2064,7 @@ func (i *Index) findDocIDs(ctx context.Context,
 func (i *Index) IncomingFindDocIDs(ctx context.Context, shardName string,
 	filters *filters.LocalFilter,
 ) ([]uint64, error) {
-	shard := i.localShard(shardName)
+	shard := i.localShard(ctx, shardName)
 	if shard == nil {
 		return nil, errShardNotFound
 	}
@@ -1727,11 +2096,11 @@ func (i *Index) batchDeleteObjects(ctx context.Context, shardDocIDs map[string][
 				}
 				objs = i.replicator.DeleteObjects(ctx, shardName, docIDs,
 					dryRun, replica.ConsistencyLevel(replProps.ConsistencyLevel))
-			} else if i.localShard(shardName) == nil {
+			} else if i.localShard(ctx, shardName) == nil {
 				objs = i.remote.DeleteObjectBatch(ctx, shardName, docIDs, dryRun)
 			} else {
 				i.backupMutex.RLockGuard(func() error {
-					if shard := i.localShard(shardName); shard != nil {
+					if shard := i.localShard(ctx, shardName); shard != nil {
 						objs = shard.deleteObjectBatch(ctx, docIDs, dryRun)
 					} else {
 						objs = objects.BatchSimpleObjects{objects.BatchSimpleObject{Err: errShardNotFound}}
@@ -1759,7 +2128,7 @@ func (i *Index) IncomingDeleteObjectBatch(ctx context.Context, shardName string,
 ) objects.BatchSimpleObjects {
 	i.backupMutex.RLock()
 	defer i.backupMutex.RUnlock()
-	shard := i.localShard(shardName)
+	shard := i.localShard(ctx, shardName)
 	if shard == nil {
 		return objects.BatchSimpleObjects{
 			objects.BatchSimpleObject{Err: errShardNotFound},
@@ -1794,7 +2163,7 @@ func objectSearchPreallocate(limit int, shards []string) ([]*storobj.Object, []f
 func (i *Index) addNewShard(ctx context.Context,
 	class *models.Class, shardName string,
 ) error {
-	if shard := i.localShard(shardName); shard != nil {
+	if shard := i.localShard(ctx, shardName); shard != nil {
 		return fmt.Errorf("shard %q exists already", shardName)
 	}
 
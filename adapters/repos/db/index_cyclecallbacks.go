@@ -12,12 +12,15 @@
 package db
 
 import (
+	"context"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/weaviate/weaviate/entities/cyclemanager"
 	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/entities/vectorindex/vamana"
 )
 
 type indexCycleCallbacks struct {
@@ -30,18 +33,28 @@ type indexCycleCallbacks struct {
 	vectorCommitLoggerCallbacks     cyclemanager.CycleCallbackGroup
 	vectorCommitLoggerCycle         cyclemanager.CycleManager
 	vectorTombstoneCleanupCallbacks cyclemanager.CycleCallbackGroup
-	vectorTombstoneCleanupCycle     cyclemanager.CycleManager
+	// the cycle is replaced if the cleanup interval is updated, always access
+	// it while holding vectorTombstoneCleanupLock
+	vectorTombstoneCleanupCycle    cyclemanager.CycleManager
+	vectorTombstoneCleanupInterval time.Duration
+	vectorTombstoneCleanupLock     sync.Mutex
 
 	geoPropsCommitLoggerCallbacks     cyclemanager.CycleCallbackGroup
 	geoPropsCommitLoggerCycle         cyclemanager.CycleManager
 	geoPropsTombstoneCleanupCallbacks cyclemanager.CycleCallbackGroup
 	geoPropsTombstoneCleanupCycle     cyclemanager.CycleManager
+
+	objectTTLCallbacks cyclemanager.CycleCallbackGroup
+	objectTTLCycle     cyclemanager.CycleManager
 }
 
 func (index *Index) initCycleCallbacks() {
 	vectorTombstoneCleanupIntervalSeconds := hnsw.DefaultCleanupIntervalSeconds
-	if hnswUserConfig, ok := index.vectorIndexUserConfig.(hnsw.UserConfig); ok {
-		vectorTombstoneCleanupIntervalSeconds = hnswUserConfig.CleanupIntervalSeconds
+	switch userConfig := index.vectorIndexUserConfig.(type) {
+	case hnsw.UserConfig:
+		vectorTombstoneCleanupIntervalSeconds = userConfig.CleanupIntervalSeconds
+	case vamana.UserConfig:
+		vectorTombstoneCleanupIntervalSeconds = userConfig.CleanupIntervalSeconds
 	}
 
 	id := func(elems ...string) string {
@@ -95,6 +108,11 @@ func (index *Index) initCycleCallbacks() {
 		cyclemanager.NewFixedTicker(enthnsw.DefaultCleanupIntervalSeconds*time.Second),
 		geoPropsTombstoneCleanupCallbacks.CycleCallback)
 
+	objectTTLCallbacks := cyclemanager.NewCallbackGroup(id("object_ttl"), index.logger, _NUMCPU)
+	objectTTLCycle := cyclemanager.NewManager(
+		cyclemanager.NewFixedTicker(objectTTLCycleInterval),
+		objectTTLCallbacks.CycleCallback)
+
 	index.cycleCallbacks = &indexCycleCallbacks{
 		compactionCallbacks: compactionCallbacks,
 		compactionCycle:     compactionCycle,
@@ -105,11 +123,15 @@ func (index *Index) initCycleCallbacks() {
 		vectorCommitLoggerCycle:         vectorCommitLoggerCycle,
 		vectorTombstoneCleanupCallbacks: vectorTombstoneCleanupCallbacks,
 		vectorTombstoneCleanupCycle:     vectorTombstoneCleanupCycle,
+		vectorTombstoneCleanupInterval:  time.Duration(vectorTombstoneCleanupIntervalSeconds) * time.Second,
 
 		geoPropsCommitLoggerCallbacks:     geoPropsCommitLoggerCallbacks,
 		geoPropsCommitLoggerCycle:         geoPropsCommitLoggerCycle,
 		geoPropsTombstoneCleanupCallbacks: geoPropsTombstoneCleanupCallbacks,
 		geoPropsTombstoneCleanupCycle:     geoPropsTombstoneCleanupCycle,
+
+		objectTTLCallbacks: objectTTLCallbacks,
+		objectTTLCycle:     objectTTLCycle,
 	}
 }
 
@@ -129,5 +151,22 @@ func (index *Index) initCycleCallbacksNoop() {
 		geoPropsCommitLoggerCycle:         cyclemanager.NewManagerNoop(),
 		geoPropsTombstoneCleanupCallbacks: cyclemanager.NewCallbackGroupNoop(),
 		geoPropsTombstoneCleanupCycle:     cyclemanager.NewManagerNoop(),
+
+		objectTTLCallbacks: cyclemanager.NewCallbackGroupNoop(),
+		objectTTLCycle:     cyclemanager.NewManagerNoop(),
 	}
 }
+
+func (c *indexCycleCallbacks) startVectorTombstoneCleanupCycle() {
+	c.vectorTombstoneCleanupLock.Lock()
+	defer c.vectorTombstoneCleanupLock.Unlock()
+
+	c.vectorTombstoneCleanupCycle.Start()
+}
+
+func (c *indexCycleCallbacks) stopVectorTombstoneCleanupCycle(ctx context.Context) error {
+	c.vectorTombstoneCleanupLock.Lock()
+	defer c.vectorTombstoneCleanupLock.Unlock()
+
+	return c.vectorTombstoneCleanupCycle.StopAndWait(ctx)
+}
@@ -0,0 +1,61 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"sync/atomic"
+
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// dataVersionClock hands out the data versions of all indexes. A single clock
+// makes sure that a class which is dropped and created again never reuses a
+// version of its predecessor.
+var dataVersionClock atomic.Uint64
+
+// bumpDataVersion marks that the data or the schema of the index changed,
+// results of queries which ran before are outdated
+func (i *Index) bumpDataVersion() {
+	i.dataVersion.Store(dataVersionClock.Add(1))
+}
+
+func (s *Shard) bumpDataVersion() {
+	if s.index != nil {
+		s.index.bumpDataVersion()
+	}
+}
+
+// DataVersion returns the version of the data and the schema of the class on
+// this node. The version changes after every write to a local shard and every
+// change of the class, so it can be used to tell whether the results of a
+// query are still up to date. Writes to shards of other nodes are not seen,
+// the version is only reported if all shards of the class are local.
+func (db *DB) DataVersion(className string) (uint64, bool) {
+	index := db.GetIndex(schema.ClassName(className))
+	if index == nil {
+		return 0, false
+	}
+
+	if len(index.getSchema.Nodes()) > 1 {
+		shardingState := index.getSchema.CopyShardingState(className)
+		if shardingState == nil {
+			return 0, false
+		}
+		for name := range shardingState.Physical {
+			if !shardingState.IsLocalShard(name) {
+				return 0, false
+			}
+		}
+	}
+
+	return index.dataVersion.Load(), true
+}
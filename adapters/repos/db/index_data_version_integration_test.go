@@ -0,0 +1,120 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestDataVersion(t *testing.T) {
+	dirName := t.TempDir()
+
+	logger, _ := test.NewNullLogger()
+	class := &models.Class{
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		Class:               "DataVersionClass",
+		Properties: []*models.Property{
+			{
+				Name:     "name",
+				DataType: schema.DataTypeText.PropString(),
+			},
+		},
+	}
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       100,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer repo.Shutdown(context.Background())
+	migrator := NewMigrator(repo, logger)
+
+	_, ok := repo.DataVersion(class.Class)
+	assert.False(t, ok, "class does not exist yet")
+
+	require.Nil(t, migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+	schemaGetter.schema = schema.Schema{
+		Objects: &models.Schema{Classes: []*models.Class{class}},
+	}
+
+	version := func(t *testing.T) uint64 {
+		v, ok := repo.DataVersion(class.Class)
+		require.True(t, ok)
+		return v
+	}
+
+	id := strfmt.UUID("8d5a3aa2-3c8d-4b0e-9b6e-6f5d1e5b6a01")
+	last := version(t)
+
+	t.Run("writing an object changes the version", func(t *testing.T) {
+		require.Nil(t, repo.PutObject(context.Background(), &models.Object{
+			ID:         id,
+			Class:      class.Class,
+			Properties: map[string]interface{}{"name": "foo"},
+		}, []float32{1, 2, 3}, nil))
+
+		v := version(t)
+		assert.Greater(t, v, last)
+		last = v
+	})
+
+	t.Run("reading an object keeps the version", func(t *testing.T) {
+		res, err := repo.ObjectByID(context.Background(), id, nil, additional.Properties{}, "")
+		require.Nil(t, err)
+		require.NotNil(t, res)
+
+		assert.Equal(t, last, version(t))
+	})
+
+	t.Run("updating the class changes the version", func(t *testing.T) {
+		require.Nil(t, migrator.UpdateInvertedIndexConfig(context.Background(),
+			class.Class, invertedConfig()))
+
+		v := version(t)
+		assert.Greater(t, v, last)
+		last = v
+	})
+
+	t.Run("deleting an object changes the version", func(t *testing.T) {
+		require.Nil(t, repo.DeleteObject(context.Background(), class.Class, id, nil, ""))
+
+		v := version(t)
+		assert.Greater(t, v, last)
+		last = v
+	})
+
+	t.Run("a recreated class does not reuse versions", func(t *testing.T) {
+		require.Nil(t, migrator.DropClass(context.Background(), class.Class))
+		_, ok := repo.DataVersion(class.Class)
+		assert.False(t, ok)
+
+		require.Nil(t, migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+		assert.Greater(t, version(t), last)
+	})
+}
@@ -0,0 +1,392 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"golang.org/x/sync/errgroup"
+)
+
+// propertyMigrations keeps track of the background migrations of the stored
+// data of properties. Only the latest migration of each property is kept and
+// the state is not persisted, so it is lost on restart.
+type propertyMigrations struct {
+	sync.Mutex
+	byProperty map[string]*models.PropertyMigration
+
+	// running migrations which can be interrupted by a newer migration of
+	// the same property
+	running map[string]*interruptibleMigration
+	// the value the stored data still matches after a migration failed
+	kept map[string]string
+}
+
+type interruptibleMigration struct {
+	cancel context.CancelCauseFunc
+	done   chan struct{}
+}
+
+// start registers a new migration. Only one migration can run at a time,
+// as writes to the shards are blocked while it is running. Migrations of
+// previousProps are forgotten, as these properties no longer exist.
+func (p *propertyMigrations) start(migration *models.PropertyMigration,
+	previousProps ...string,
+) error {
+	p.Lock()
+	defer p.Unlock()
+
+	if p.byProperty == nil {
+		p.byProperty = map[string]*models.PropertyMigration{}
+	}
+	for _, running := range p.byProperty {
+		if running.Status == models.PropertyMigrationStatusSTARTED {
+			return fmt.Errorf("migration of property %q is still running", running.Property)
+		}
+	}
+
+	for _, prop := range previousProps {
+		delete(p.byProperty, prop)
+		delete(p.kept, prop)
+	}
+	p.byProperty[migration.Property] = migration
+	delete(p.kept, migration.Property)
+	return nil
+}
+
+// registerInterruptible registers a running migration of prop which can be
+// stopped with interrupt. The returned function must be called once it has finished.
+func (p *propertyMigrations) registerInterruptible(prop string,
+	cancel context.CancelCauseFunc,
+) (finished func()) {
+	p.Lock()
+	defer p.Unlock()
+
+	if p.running == nil {
+		p.running = map[string]*interruptibleMigration{}
+	}
+	running := &interruptibleMigration{cancel: cancel, done: make(chan struct{})}
+	p.running[prop] = running
+
+	return func() {
+		p.Lock()
+		defer p.Unlock()
+
+		delete(p.running, prop)
+		close(running.done)
+	}
+}
+
+// interrupt stops the running migration of prop, if there is one which can
+// be interrupted, and waits until it has finished
+func (p *propertyMigrations) interrupt(prop string, cause error) {
+	p.Lock()
+	running, ok := p.running[prop]
+	p.Unlock()
+
+	if !ok {
+		return
+	}
+	running.cancel(cause)
+	<-running.done
+}
+
+func (p *propertyMigrations) update(prop string, update func(m *models.PropertyMigration)) {
+	p.Lock()
+	defer p.Unlock()
+
+	if migration, ok := p.byProperty[prop]; ok {
+		update(migration)
+	}
+}
+
+func (p *propertyMigrations) fail(prop string, err error) {
+	p.update(prop, func(m *models.PropertyMigration) {
+		m.Status = models.PropertyMigrationStatusFAILED
+		m.Error = err.Error()
+	})
+}
+
+// failAndKeep marks the migration of prop as failed, while the stored data
+// still matches its previous value
+func (p *propertyMigrations) failAndKeep(prop string, err error) {
+	p.Lock()
+	defer p.Unlock()
+
+	migration, ok := p.byProperty[prop]
+	if !ok {
+		return
+	}
+	migration.Status = models.PropertyMigrationStatusFAILED
+	migration.Error = err.Error()
+
+	if p.kept == nil {
+		p.kept = map[string]string{}
+	}
+	p.kept[prop] = migration.PreviousValue
+}
+
+// keeps returns whether the latest migration of prop failed and the stored
+// data still matches value
+func (p *propertyMigrations) keeps(prop, value string) bool {
+	p.Lock()
+	defer p.Unlock()
+
+	kept, ok := p.kept[prop]
+	return ok && kept == value
+}
+
+// list returns copies of all migrations ordered by property name
+func (p *propertyMigrations) list() []*models.PropertyMigration {
+	p.Lock()
+	defer p.Unlock()
+
+	out := make([]*models.PropertyMigration, 0, len(p.byProperty))
+	for _, migration := range p.byProperty {
+		copied := *migration
+		out = append(out, &copied)
+	}
+
+	sort.Slice(out, func(a, b int) bool {
+		return out[a].Property < out[b].Property
+	})
+	return out
+}
+
+// renameProperty renames the inverted index buckets of the property in all
+// local shards right away and then rewrites the payloads of the stored
+// objects in the background. Writes to the shards are blocked until their
+// objects have been rewritten, the progress can be retrieved with
+// propertyMigrations.list.
+func (i *Index) renameProperty(ctx context.Context, propName, newName string) error {
+	var shards []*Shard
+	i.ForEachShard(func(_ string, shard *Shard) error {
+		shards = append(shards, shard)
+		return nil
+	})
+
+	migration := &models.PropertyMigration{
+		ClassName:     i.Config.ClassName.String(),
+		Property:      newName,
+		Type:          models.PropertyMigrationTypeRename,
+		Status:        models.PropertyMigrationStatusSTARTED,
+		PreviousValue: propName,
+		NewValue:      newName,
+		ShardsTotal:   int64(len(shards)),
+	}
+	if err := i.propertyMigrations.start(migration, propName); err != nil {
+		return errors.Wrapf(err, "rename property '%s' of idx '%s'", propName, i.ID())
+	}
+
+	restoreWrites := make([]func(), len(shards))
+	for pos, shard := range shards {
+		restoreWrites[pos] = shard.blockWrites()
+		if err := shard.renamePropertyIndexes(ctx, propName, newName); err != nil {
+			for _, restore := range restoreWrites[:pos+1] {
+				restore()
+			}
+			err = errors.Wrapf(err, "rename property '%s' on shard '%s'", propName, shard.ID())
+			i.propertyMigrations.fail(newName, err)
+			return err
+		}
+	}
+
+	go i.renamePropertyInObjects(shards, restoreWrites, propName, newName)
+	return nil
+}
+
+func (i *Index) renamePropertyInObjects(shards []*Shard, restoreWrites []func(),
+	propName, newName string,
+) {
+	eg := &errgroup.Group{}
+	eg.SetLimit(_NUMCPU)
+
+	for pos := range shards {
+		shard, restore := shards[pos], restoreWrites[pos]
+		eg.Go(func() error {
+			defer restore()
+
+			err := shard.renamePropertyInObjects(context.Background(), propName, newName,
+				func(processed int) {
+					i.propertyMigrations.update(newName, func(m *models.PropertyMigration) {
+						m.ObjectsProcessed += int64(processed)
+					})
+				})
+			if err != nil {
+				return errors.Wrapf(err, "shard '%s'", shard.ID())
+			}
+
+			i.propertyMigrations.update(newName, func(m *models.PropertyMigration) {
+				m.ShardsCompleted++
+			})
+			return nil
+		})
+	}
+
+	if err := eg.Wait(); err != nil {
+		i.logger.WithField("action", "rename_property").
+			WithField("class", i.Config.ClassName).
+			WithField("property", newName).
+			WithError(err).
+			Error("rewriting objects with renamed property failed")
+		i.propertyMigrations.fail(newName, err)
+		return
+	}
+
+	i.propertyMigrations.update(newName, func(m *models.PropertyMigration) {
+		m.Status = models.PropertyMigrationStatusSUCCESS
+	})
+}
+
+// errTokenizationInterrupted is the cause of a reindex which was stopped by a
+// newer change of the tokenization, e.g. because the reindex failed on
+// another node and the change is rolled back
+var errTokenizationInterrupted = errors.New("interrupted by another change of the tokenization")
+
+// updatePropertyTokenization rebuilds the filterable and searchable index of
+// a text property with a new tokenization in the background. Writes to the
+// shards are blocked until all of them have been rebuilt. The new index is
+// only used once it was built on all local shards, then onCommit is called
+// before writes are accepted again, so that the schema can be changed to the
+// new tokenization. Otherwise the previous index is kept and onFailure is
+// called. The progress can be retrieved with propertyMigrations.list.
+func (i *Index) updatePropertyTokenization(ctx context.Context, prop *models.Property,
+	tokenization string, onCommit func(), onFailure func(err error),
+) error {
+	i.propertyMigrations.interrupt(prop.Name, errTokenizationInterrupted)
+	if i.propertyMigrations.keeps(prop.Name, tokenization) {
+		// a failed reindex left the index as it is, e.g. when the change is
+		// rolled back. The schema was not changed either
+		return nil
+	}
+
+	var shards []*Shard
+	i.ForEachShard(func(_ string, shard *Shard) error {
+		shards = append(shards, shard)
+		return nil
+	})
+
+	migration := &models.PropertyMigration{
+		ClassName:     i.Config.ClassName.String(),
+		Property:      prop.Name,
+		Type:          models.PropertyMigrationTypeTokenization,
+		Status:        models.PropertyMigrationStatusSTARTED,
+		PreviousValue: prop.Tokenization,
+		NewValue:      tokenization,
+		ShardsTotal:   int64(len(shards)),
+	}
+	if err := i.propertyMigrations.start(migration); err != nil {
+		return errors.Wrapf(err, "update tokenization of property '%s' of idx '%s'",
+			prop.Name, i.ID())
+	}
+
+	restoreWrites := make([]func(), len(shards))
+	for pos, shard := range shards {
+		restoreWrites[pos] = shard.blockWrites()
+	}
+
+	retokenized := *prop
+	retokenized.Tokenization = tokenization
+
+	reindexCtx, cancel := context.WithCancelCause(context.Background())
+	finished := i.propertyMigrations.registerInterruptible(prop.Name, cancel)
+	go func() {
+		defer cancel(nil)
+		defer func() {
+			for _, restore := range restoreWrites {
+				restore()
+			}
+		}()
+
+		err := i.reindexPropertyTokenization(reindexCtx, shards, &retokenized)
+		// the new index is in place and can no longer be interrupted,
+		// onCommit may have to wait for a newer change of the tokenization
+		finished()
+		if err != nil {
+			if reindexCtx.Err() == nil && onFailure != nil {
+				onFailure(err)
+			}
+			return
+		}
+
+		i.bumpDataVersion()
+		if onCommit != nil {
+			onCommit()
+		}
+	}()
+	return nil
+}
+
+func (i *Index) reindexPropertyTokenization(ctx context.Context, shards []*Shard,
+	prop *models.Property,
+) error {
+	logger := i.logger.WithField("action", "update_property_tokenization").
+		WithField("class", i.Config.ClassName).
+		WithField("property", prop.Name)
+
+	eg, egCtx := errgroup.WithContext(ctx)
+	eg.SetLimit(_NUMCPU)
+	for _, shard := range shards {
+		shard := shard
+		eg.Go(func() error {
+			err := shard.reindexPropertyTokenization(egCtx, prop, func(processed int) {
+				i.propertyMigrations.update(prop.Name, func(m *models.PropertyMigration) {
+					m.ObjectsProcessed += int64(processed)
+				})
+			})
+			if err != nil {
+				return errors.Wrapf(err, "shard '%s'", shard.ID())
+			}
+
+			i.propertyMigrations.update(prop.Name, func(m *models.PropertyMigration) {
+				m.ShardsCompleted++
+			})
+			return nil
+		})
+	}
+
+	if err := eg.Wait(); err != nil {
+		if cause := context.Cause(ctx); cause != nil {
+			err = cause
+		}
+		for _, shard := range shards {
+			if err := shard.abortPropertyTokenization(context.Background(), prop.Name); err != nil {
+				logger.WithField("shard", shard.ID()).WithError(err).
+					Error("removing the partially rebuilt index failed")
+			}
+		}
+
+		err = fmt.Errorf("%w, the previous index was kept", err)
+		logger.WithError(err).Error("rebuilding the index of the property failed")
+		i.propertyMigrations.failAndKeep(prop.Name, err)
+		return err
+	}
+
+	for _, shard := range shards {
+		if err := shard.commitPropertyTokenization(context.Background(), prop.Name); err != nil {
+			err = errors.Wrapf(err, "shard '%s'", shard.ID())
+			logger.WithError(err).Error("replacing the index of the property failed")
+			i.propertyMigrations.fail(prop.Name, err)
+			return err
+		}
+	}
+
+	i.propertyMigrations.update(prop.Name, func(m *models.PropertyMigration) {
+		m.Status = models.PropertyMigrationStatusSUCCESS
+	})
+	return nil
+}
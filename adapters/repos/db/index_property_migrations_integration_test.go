@@ -0,0 +1,327 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/storagestate"
+)
+
+func TestIndex_RenameProperty(t *testing.T) {
+	ctx := testCtx()
+	className := "TestClass"
+	shd, idx := testShard(t, ctx, className)
+	amount := 2*renamePropertyBatchSize + 10
+
+	t.Run("insert data into shard", func(t *testing.T) {
+		err := shd.store.CreateOrLoadBucket(ctx, helpers.BucketFromPropNameLSM("title"),
+			lsmkv.WithStrategy(lsmkv.StrategyMapCollection))
+		require.Nil(t, err)
+		shd.propLengths.TrackProperty("title", 3)
+
+		for i := 0; i < amount; i++ {
+			obj := testObject(className)
+			obj.Object.Properties = map[string]interface{}{"title": "foo", "other": "bar"}
+			require.Nil(t, shd.putObject(ctx, obj))
+		}
+	})
+
+	t.Run("rename property", func(t *testing.T) {
+		require.Nil(t, idx.renameProperty(ctx, "title", "headline"))
+
+		assert.Nil(t, shd.store.Bucket(helpers.BucketFromPropNameLSM("title")))
+		assert.NotNil(t, shd.store.Bucket(helpers.BucketFromPropNameLSM("headline")))
+		mean, err := shd.propLengths.PropertyMean("headline")
+		require.Nil(t, err)
+		assert.Greater(t, mean, float32(0))
+	})
+
+	t.Run("wait for the migration to complete", func(t *testing.T) {
+		var migrations []*models.PropertyMigration
+		assert.Eventually(t, func() bool {
+			migrations = idx.propertyMigrations.list()
+			return migrations[0].Status != models.PropertyMigrationStatusSTARTED
+		}, 10*time.Second, 10*time.Millisecond)
+
+		require.Len(t, migrations, 1)
+		assert.Equal(t, &models.PropertyMigration{
+			ClassName:        className,
+			Property:         "headline",
+			Type:             models.PropertyMigrationTypeRename,
+			Status:           models.PropertyMigrationStatusSUCCESS,
+			PreviousValue:    "title",
+			NewValue:         "headline",
+			ShardsTotal:      1,
+			ShardsCompleted:  1,
+			ObjectsProcessed: int64(amount),
+		}, migrations[0])
+	})
+
+	t.Run("objects contain the new name", func(t *testing.T) {
+		objs, err := shd.objectList(context.Background(), amount, nil, nil,
+			additional.Properties{}, shd.index.Config.ClassName)
+		require.Nil(t, err)
+		require.Len(t, objs, amount)
+		for _, obj := range objs {
+			assert.Equal(t, map[string]interface{}{"headline": "foo", "other": "bar"},
+				obj.Properties())
+		}
+	})
+
+	t.Run("shard accepts writes again", func(t *testing.T) {
+		assert.False(t, shd.isReadOnly())
+		require.Nil(t, shd.putObject(ctx, testObject(className)))
+	})
+
+	require.Nil(t, idx.drop())
+}
+
+func TestShard_ReadRenamedPropertyBeforeRewrite(t *testing.T) {
+	ctx := testCtx()
+	className := "TestClass"
+	shd, idx := testShard(t, ctx, className)
+
+	obj := testObject(className)
+	obj.Object.Properties = map[string]interface{}{"title": "foo", "other": "bar"}
+	require.Nil(t, shd.putObject(ctx, obj))
+	expected := map[string]interface{}{"headline": "foo", "other": "bar"}
+
+	require.Nil(t, shd.renamePropertyIndexes(ctx, "title", "headline"))
+
+	t.Run("object by id", func(t *testing.T) {
+		found, err := shd.objectByID(ctx, obj.ID(), nil, additional.Properties{})
+		require.Nil(t, err)
+		assert.Equal(t, expected, found.Properties())
+	})
+
+	t.Run("object search", func(t *testing.T) {
+		found, _, err := shd.objectSearch(ctx, 10, nil, nil, nil, nil,
+			additional.Properties{})
+		require.Nil(t, err)
+		require.Len(t, found, 1)
+		assert.Equal(t, expected, found[0].Properties())
+	})
+
+	t.Run("a second rename before the rewrite", func(t *testing.T) {
+		require.Nil(t, shd.renamePropertyIndexes(ctx, "headline", "subject"))
+		assert.Equal(t, []string{"headline", "title"}, shd.renamedProperties.of("subject"))
+
+		found, err := shd.objectByID(ctx, obj.ID(), nil, additional.Properties{})
+		require.Nil(t, err)
+		assert.Equal(t, map[string]interface{}{"subject": "foo", "other": "bar"},
+			found.Properties())
+	})
+
+	t.Run("rewrite the objects", func(t *testing.T) {
+		require.Nil(t, shd.renamePropertyInObjects(ctx, "headline", "subject",
+			func(int) {}))
+		assert.Nil(t, shd.renamedProperties.all())
+
+		found, err := shd.objectByID(ctx, obj.ID(), nil, additional.Properties{})
+		require.Nil(t, err)
+		assert.Equal(t, map[string]interface{}{"subject": "foo", "other": "bar"},
+			found.Properties())
+	})
+
+	require.Nil(t, idx.drop())
+}
+
+func TestShard_BlockWrites(t *testing.T) {
+	ctx := testCtx()
+	className := "TestClass"
+	shd, idx := testShard(t, ctx, className)
+
+	restore := shd.blockWrites()
+	assert.Equal(t, storagestate.ErrStatusReadOnly, shd.putObject(ctx, testObject(className)))
+
+	restore()
+	require.Nil(t, shd.putObject(ctx, testObject(className)))
+
+	t.Run("overlapping blocks", func(t *testing.T) {
+		first := shd.blockWrites()
+		second := shd.blockWrites()
+
+		first()
+		first()
+		assert.True(t, shd.isReadOnly())
+
+		second()
+		assert.False(t, shd.isReadOnly())
+	})
+
+	require.Nil(t, idx.drop())
+}
+
+func TestPropertyMigrations_OnlyOneRunning(t *testing.T) {
+	migrations := propertyMigrations{}
+
+	require.Nil(t, migrations.start(&models.PropertyMigration{
+		Property: "headline",
+		Status:   models.PropertyMigrationStatusSTARTED,
+	}, "title"))
+	assert.NotNil(t, migrations.start(&models.PropertyMigration{
+		Property: "body",
+		Status:   models.PropertyMigrationStatusSTARTED,
+	}, "text"))
+
+	migrations.fail("headline", errors.New("oops"))
+	require.Nil(t, migrations.start(&models.PropertyMigration{
+		Property: "body",
+		Status:   models.PropertyMigrationStatusSTARTED,
+	}, "text"))
+
+	list := migrations.list()
+	require.Len(t, list, 2)
+	assert.Equal(t, "body", list[0].Property)
+	assert.Equal(t, "headline", list[1].Property)
+	assert.Equal(t, models.PropertyMigrationStatusFAILED, list[1].Status)
+	assert.Equal(t, "oops", list[1].Error)
+}
+
+func TestIndex_UpdatePropertyTokenization(t *testing.T) {
+	ctx := testCtx()
+	className := "TestClass"
+	shd, idx := testShard(t, ctx, className)
+	amount := 2*retokenizeProgressInterval + 10
+	prop := &models.Property{
+		Name:         "title",
+		DataType:     schema.DataTypeText.PropString(),
+		Tokenization: models.PropertyTokenizationWord,
+	}
+
+	t.Run("insert data into shard", func(t *testing.T) {
+		require.Nil(t, shd.createPropertyValueIndex(ctx, prop))
+		for i := 0; i < amount; i++ {
+			obj := testObject(className)
+			obj.Object.Properties = map[string]interface{}{"title": "Hello World"}
+			require.Nil(t, shd.putObject(ctx, obj))
+		}
+	})
+
+	t.Run("an aborted reindex keeps the current index", func(t *testing.T) {
+		retokenized := *prop
+		retokenized.Tokenization = models.PropertyTokenizationField
+		require.Nil(t, shd.reindexPropertyTokenization(ctx, &retokenized, func(int) {}))
+		require.Nil(t, shd.abortPropertyTokenization(ctx, prop.Name))
+
+		for _, bucketName := range shd.tokenizationBuckets(prop.Name) {
+			assert.Nil(t, shd.store.Bucket(retokenizeBucketName(bucketName)))
+		}
+		docIDs, err := shd.store.Bucket(helpers.BucketFromPropNameLSM(prop.Name)).
+			RoaringSetGet([]byte("Hello World"))
+		require.Nil(t, err)
+		assert.Equal(t, 0, docIDs.GetCardinality())
+	})
+
+	t.Run("update tokenization", func(t *testing.T) {
+		var failed error
+		committed := make(chan bool, 1)
+		onCommit := func() { committed <- shd.isReadOnly() }
+		require.Nil(t, idx.updatePropertyTokenization(ctx, prop,
+			models.PropertyTokenizationField, onCommit, func(err error) { failed = err }))
+
+		// the schema is changed while writes are still blocked
+		select {
+		case readOnly := <-committed:
+			assert.True(t, readOnly)
+		case <-time.After(10 * time.Second):
+			t.Fatal("the change was not committed")
+		}
+
+		var migrations []*models.PropertyMigration
+		assert.Eventually(t, func() bool {
+			migrations = idx.propertyMigrations.list()
+			return migrations[0].Status != models.PropertyMigrationStatusSTARTED
+		}, 10*time.Second, 10*time.Millisecond)
+
+		require.Nil(t, failed)
+		require.Len(t, migrations, 1)
+		assert.Equal(t, &models.PropertyMigration{
+			ClassName:        className,
+			Property:         prop.Name,
+			Type:             models.PropertyMigrationTypeTokenization,
+			Status:           models.PropertyMigrationStatusSUCCESS,
+			PreviousValue:    models.PropertyTokenizationWord,
+			NewValue:         models.PropertyTokenizationField,
+			ShardsTotal:      1,
+			ShardsCompleted:  1,
+			ObjectsProcessed: int64(amount),
+		}, migrations[0])
+	})
+
+	t.Run("index contains the new tokens", func(t *testing.T) {
+		docIDs, err := shd.store.Bucket(helpers.BucketFromPropNameLSM(prop.Name)).
+			RoaringSetGet([]byte("Hello World"))
+		require.Nil(t, err)
+		assert.Equal(t, amount, docIDs.GetCardinality())
+
+		pairs, err := shd.store.Bucket(helpers.BucketSearchableFromPropNameLSM(prop.Name)).
+			MapList([]byte("Hello World"))
+		require.Nil(t, err)
+		assert.Len(t, pairs, amount)
+
+		mean, err := shd.propLengths.PropertyMean(prop.Name)
+		require.Nil(t, err)
+		assert.Equal(t, float32(1), mean)
+	})
+
+	t.Run("shard accepts writes again", func(t *testing.T) {
+		assert.False(t, shd.isReadOnly())
+		require.Nil(t, shd.putObject(ctx, testObject(className)))
+	})
+
+	require.Nil(t, idx.drop())
+}
+
+func TestPropertyMigrations_Interrupt(t *testing.T) {
+	migrations := propertyMigrations{}
+	require.Nil(t, migrations.start(&models.PropertyMigration{
+		Property:      "title",
+		Status:        models.PropertyMigrationStatusSTARTED,
+		PreviousValue: models.PropertyTokenizationWord,
+	}))
+
+	ctx, cancel := context.WithCancelCause(context.Background())
+	finished := migrations.registerInterruptible("title", cancel)
+	go func() {
+		<-ctx.Done()
+		migrations.failAndKeep("title", context.Cause(ctx))
+		finished()
+	}()
+
+	migrations.interrupt("title", errTokenizationInterrupted)
+	assert.True(t, migrations.keeps("title", models.PropertyTokenizationWord))
+	assert.False(t, migrations.keeps("title", models.PropertyTokenizationField))
+
+	list := migrations.list()
+	require.Len(t, list, 1)
+	assert.Equal(t, models.PropertyMigrationStatusFAILED, list[0].Status)
+	assert.Equal(t, errTokenizationInterrupted.Error(), list[0].Error)
+
+	t.Run("nothing to interrupt", func(t *testing.T) {
+		migrations.interrupt("title", errTokenizationInterrupted)
+	})
+}
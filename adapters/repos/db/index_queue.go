@@ -0,0 +1,443 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"encoding/binary"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+var errIndexQueueClosed = errors.New("index queue is closed")
+
+// IndexQueue decouples the insertion of vectors into a vector index from the
+// ingestion of the objects. Queued vectors are persisted in a bucket of the
+// shard, so they are as durable as the object itself, and inserted into the
+// vector index in batches by a background worker. Writes block while the
+// queue is full, which slows the ingestion down to the pace of the vector
+// index.
+type IndexQueue struct {
+	index     VectorIndex
+	bucket    *lsmkv.Bucket
+	distancer distancer.Provider
+	maxSize   int
+	batchSize int
+	// onIndexed is called once a batch of vectors is in the vector index, as
+	// the results of vector searches change
+	onIndexed func()
+	logger    logrus.FieldLogger
+
+	sync.Mutex
+	// cond is signalled when vectors are queued or indexed and when the queue
+	// is closed
+	cond *sync.Cond
+	// vectors holds every vector which is not in the vector index yet,
+	// including the ones the worker is currently inserting
+	vectors map[uint64]*queuedVector
+	// order of the queued ids, it may contain ids which have been deleted
+	order []uint64
+	// indexing holds the ids the worker is currently inserting
+	indexing map[uint64]struct{}
+	// deleted holds the ids which were deleted while the worker was inserting
+	// them, they are removed from the vector index once it is done
+	deleted []uint64
+	closed  bool
+	done    chan struct{}
+}
+
+type queuedVector struct {
+	vector []float32
+	// searchVector is normalized if the distance requires it
+	searchVector []float32
+	queuedAt     time.Time
+}
+
+// newIndexQueue loads the vectors a previous run left in the bucket and
+// starts the worker
+func newIndexQueue(index VectorIndex, bucket *lsmkv.Bucket,
+	distProv distancer.Provider, cfg config.AsyncIndexing, onIndexed func(),
+	logger logrus.FieldLogger,
+) *IndexQueue {
+	q := &IndexQueue{
+		index:     index,
+		bucket:    bucket,
+		distancer: distProv,
+		maxSize:   cfg.QueueMaxSize,
+		batchSize: cfg.BatchSize,
+		onIndexed: onIndexed,
+		logger:    logger,
+		vectors:   map[uint64]*queuedVector{},
+		indexing:  map[uint64]struct{}{},
+		done:      make(chan struct{}),
+	}
+	q.cond = sync.NewCond(&q.Mutex)
+
+	now := time.Now()
+	c := bucket.Cursor()
+	for k, v := c.First(); k != nil; k, v = c.Next() {
+		q.enqueue(binary.BigEndian.Uint64(k), bytesToQueuedVector(v), now)
+	}
+	c.Close()
+
+	go q.work()
+	return q
+}
+
+// Push queues the vectors for insertion, it blocks while the queue is full.
+// The vectors are validated right away, as errors of the vector index are
+// only logged once the vectors are inserted.
+func (q *IndexQueue) Push(ids []uint64, vectors [][]float32) []error {
+	errs := make([]error, len(ids))
+	for i := range ids {
+		errs[i] = q.index.ValidateBeforeInsert(vectors[i])
+	}
+
+	q.Lock()
+	defer q.Unlock()
+
+	for len(q.vectors) >= q.maxSize && !q.closed {
+		q.cond.Wait()
+	}
+	if q.closed {
+		for i := range errs {
+			errs[i] = errIndexQueueClosed
+		}
+		return errs
+	}
+
+	now := time.Now()
+	for i, id := range ids {
+		if errs[i] != nil {
+			continue
+		}
+		if err := q.bucket.Put(queueKey(id), queuedVectorToBytes(vectors[i])); err != nil {
+			errs[i] = errors.Wrapf(err, "queue doc id %d", id)
+			continue
+		}
+		q.enqueue(id, vectors[i], now)
+	}
+	q.cond.Broadcast()
+
+	return errs
+}
+
+func (q *IndexQueue) enqueue(id uint64, vector []float32, queuedAt time.Time) {
+	searchVector := vector
+	if q.distancer.Type() == "cosine-dot" {
+		searchVector = distancer.Normalize(vector)
+	}
+
+	q.vectors[id] = &queuedVector{
+		vector:       vector,
+		searchVector: searchVector,
+		queuedAt:     queuedAt,
+	}
+	q.order = append(q.order, id)
+}
+
+// Delete removes the ids from the queue and deletes the ones which have
+// already been inserted from the vector index
+func (q *IndexQueue) Delete(ids ...uint64) error {
+	var indexed []uint64
+
+	q.Lock()
+	for _, id := range ids {
+		if _, ok := q.vectors[id]; !ok {
+			indexed = append(indexed, id)
+			continue
+		}
+
+		delete(q.vectors, id)
+		if err := q.bucket.Delete(queueKey(id)); err != nil {
+			q.Unlock()
+			return errors.Wrapf(err, "delete doc id %d from queue", id)
+		}
+		if _, ok := q.indexing[id]; ok {
+			q.deleted = append(q.deleted, id)
+		}
+	}
+	q.cond.Broadcast()
+	q.Unlock()
+
+	if len(indexed) == 0 {
+		return nil
+	}
+	return q.index.Delete(indexed...)
+}
+
+func (q *IndexQueue) work() {
+	defer close(q.done)
+
+	for {
+		ids, vectors, ok := q.next()
+		if !ok {
+			return
+		}
+		q.insert(ids, vectors)
+	}
+}
+
+// next blocks until vectors are queued and returns the next batch, it
+// returns false once the queue is closed
+func (q *IndexQueue) next() ([]uint64, [][]float32, bool) {
+	q.Lock()
+	defer q.Unlock()
+
+	for len(q.vectors) == 0 && !q.closed {
+		q.cond.Wait()
+	}
+	if q.closed {
+		return nil, nil, false
+	}
+
+	ids := make([]uint64, 0, q.batchSize)
+	vectors := make([][]float32, 0, q.batchSize)
+	n := 0
+	for ; n < len(q.order) && len(ids) < q.batchSize; n++ {
+		id := q.order[n]
+		qv, ok := q.vectors[id]
+		if !ok {
+			continue
+		}
+		ids = append(ids, id)
+		vectors = append(vectors, qv.vector)
+		q.indexing[id] = struct{}{}
+	}
+	q.order = q.order[n:]
+
+	return ids, vectors, true
+}
+
+func (q *IndexQueue) insert(ids []uint64, vectors [][]float32) {
+	var errs []error
+	if index, ok := q.index.(batchVectorIndex); ok {
+		errs = index.AddBatch(ids, vectors)
+	} else {
+		errs = make([]error, len(ids))
+		for i := range ids {
+			errs[i] = q.index.Add(ids[i], vectors[i])
+		}
+	}
+	for i, err := range errs {
+		if err != nil {
+			q.logger.WithField("action", "async_indexing").WithError(err).
+				Errorf("insert doc id %d to vector index", ids[i])
+		}
+	}
+	if err := q.index.Flush(); err != nil {
+		q.logger.WithField("action", "async_indexing").WithError(err).
+			Error("flush vector index")
+	}
+
+	q.Lock()
+	defer q.Unlock()
+
+	for _, id := range ids {
+		delete(q.indexing, id)
+		if _, ok := q.vectors[id]; !ok {
+			// deleted while it was inserted
+			continue
+		}
+		delete(q.vectors, id)
+		if err := q.bucket.Delete(queueKey(id)); err != nil {
+			q.logger.WithField("action", "async_indexing").WithError(err).
+				Errorf("delete doc id %d from queue", id)
+		}
+	}
+
+	if len(q.deleted) > 0 {
+		if err := q.index.Delete(q.deleted...); err != nil {
+			q.logger.WithField("action", "async_indexing").WithError(err).
+				Error("delete from vector index")
+		}
+		q.deleted = nil
+	}
+	if q.onIndexed != nil {
+		q.onIndexed()
+	}
+	q.cond.Broadcast()
+}
+
+// Stats returns the number of vectors which are not indexed yet and how long
+// the oldest of them has been waiting
+func (q *IndexQueue) Stats() (int64, time.Duration) {
+	q.Lock()
+	defer q.Unlock()
+
+	var oldest time.Time
+	for id := range q.indexing {
+		if qv, ok := q.vectors[id]; ok && (oldest.IsZero() || qv.queuedAt.Before(oldest)) {
+			oldest = qv.queuedAt
+		}
+	}
+	for _, id := range q.order {
+		if qv, ok := q.vectors[id]; ok {
+			if oldest.IsZero() || qv.queuedAt.Before(oldest) {
+				oldest = qv.queuedAt
+			}
+			break
+		}
+	}
+
+	if oldest.IsZero() {
+		return int64(len(q.vectors)), 0
+	}
+	return int64(len(q.vectors)), time.Since(oldest)
+}
+
+// waitUntilIndexed blocks until every queued vector has been inserted
+func (q *IndexQueue) waitUntilIndexed(ctx context.Context) error {
+	t := time.NewTicker(10 * time.Millisecond)
+	defer t.Stop()
+
+	for {
+		if size, _ := q.Stats(); size == 0 {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-t.C:
+		}
+	}
+}
+
+// Close stops the worker, the vectors which are not indexed yet remain in the
+// bucket and are queued again on the next startup
+func (q *IndexQueue) Close() {
+	q.Lock()
+	if q.closed {
+		q.Unlock()
+		return
+	}
+	q.closed = true
+	q.cond.Broadcast()
+	q.Unlock()
+
+	<-q.done
+}
+
+// searchByVector brute-forces the queued vectors and merges the k closest
+// into the results of the vector index
+func (q *IndexQueue) searchByVector(vector []float32, k int,
+	allow helpers.AllowList, ids []uint64, dists []float32,
+) ([]uint64, []float32) {
+	return q.mergeSearchResults(vector, allow, ids, dists, k, math.MaxFloat32)
+}
+
+// searchByVectorDistance is the counterpart of searchByVector for searches by
+// distance
+func (q *IndexQueue) searchByVectorDistance(vector []float32, maxDist float32,
+	maxLimit int64, allow helpers.AllowList, ids []uint64, dists []float32,
+) ([]uint64, []float32) {
+	return q.mergeSearchResults(vector, allow, ids, dists, int(maxLimit), maxDist)
+}
+
+func (q *IndexQueue) mergeSearchResults(vector []float32,
+	allow helpers.AllowList, ids []uint64, dists []float32, limit int,
+	maxDist float32,
+) ([]uint64, []float32) {
+	type result struct {
+		id   uint64
+		dist float32
+	}
+
+	found := make(map[uint64]struct{}, len(ids))
+	results := make([]result, len(ids))
+	for i := range ids {
+		found[ids[i]] = struct{}{}
+		results[i] = result{id: ids[i], dist: dists[i]}
+	}
+
+	q.Lock()
+	queued := make(map[uint64][]float32, len(q.vectors))
+	for id, qv := range q.vectors {
+		if _, ok := found[id]; ok {
+			// inserted while the vector index was searched
+			continue
+		}
+		if allow != nil && !allow.Contains(id) {
+			continue
+		}
+		queued[id] = qv.searchVector
+	}
+	q.Unlock()
+
+	if len(queued) == 0 {
+		return ids, dists
+	}
+
+	if q.distancer.Type() == "cosine-dot" {
+		vector = distancer.Normalize(vector)
+	}
+	for id, queuedVector := range queued {
+		dist, ok, err := q.distancer.SingleDist(vector, queuedVector)
+		if err != nil || !ok || dist > maxDist {
+			// the dimensions of the vector do not match, the vector index
+			// rejects it as well
+			continue
+		}
+		results = append(results, result{id: id, dist: dist})
+	}
+
+	sort.Slice(results, func(a, b int) bool {
+		if results[a].dist == results[b].dist {
+			return results[a].id < results[b].id
+		}
+		return results[a].dist < results[b].dist
+	})
+	if limit > 0 && len(results) > limit {
+		results = results[:limit]
+	}
+
+	ids = make([]uint64, len(results))
+	dists = make([]float32, len(results))
+	for i := range results {
+		ids[i] = results[i].id
+		dists[i] = results[i].dist
+	}
+	return ids, dists
+}
+
+// ids are encoded big endian, so the cursor iterates them in ascending order
+func queueKey(id uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, id)
+	return key
+}
+
+func queuedVectorToBytes(vector []float32) []byte {
+	out := make([]byte, len(vector)*4)
+	for i, v := range vector {
+		binary.LittleEndian.PutUint32(out[i*4:], math.Float32bits(v))
+	}
+	return out
+}
+
+func bytesToQueuedVector(in []byte) []float32 {
+	out := make([]float32, len(in)/4)
+	for i := range out {
+		out[i] = math.Float32frombits(binary.LittleEndian.Uint32(in[i*4:]))
+	}
+	return out
+}
@@ -0,0 +1,259 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/noop"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+type fakeQueueVectorIndex struct {
+	*noop.Index
+	sync.Mutex
+	// inserts block until gate is closed, if set
+	gate    chan struct{}
+	vectors map[uint64][]float32
+}
+
+func newFakeQueueVectorIndex(gate chan struct{}) *fakeQueueVectorIndex {
+	return &fakeQueueVectorIndex{
+		Index:   noop.NewIndex(),
+		gate:    gate,
+		vectors: map[uint64][]float32{},
+	}
+}
+
+func (f *fakeQueueVectorIndex) Add(id uint64, vector []float32) error {
+	if f.gate != nil {
+		<-f.gate
+	}
+	f.Lock()
+	defer f.Unlock()
+	f.vectors[id] = vector
+	return nil
+}
+
+func (f *fakeQueueVectorIndex) Delete(ids ...uint64) error {
+	f.Lock()
+	defer f.Unlock()
+	for _, id := range ids {
+		delete(f.vectors, id)
+	}
+	return nil
+}
+
+func (f *fakeQueueVectorIndex) ValidateBeforeInsert(vector []float32) error {
+	if len(vector) != 2 {
+		return fmt.Errorf("vector has %d dimensions instead of 2", len(vector))
+	}
+	return nil
+}
+
+func (f *fakeQueueVectorIndex) ids() []uint64 {
+	f.Lock()
+	defer f.Unlock()
+	ids := make([]uint64, 0, len(f.vectors))
+	for id := range f.vectors {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+func TestIndexQueue(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+	cfg := config.AsyncIndexing{Enabled: true, QueueMaxSize: 10, BatchSize: 4}
+
+	newBucket := func(t *testing.T, dir string) (*lsmkv.Store, *lsmkv.Bucket) {
+		store, err := lsmkv.New(dir, dir, logger, nil,
+			cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop())
+		require.Nil(t, err)
+		require.Nil(t, store.CreateOrLoadBucket(ctx, "vectors_queue",
+			lsmkv.WithStrategy(lsmkv.StrategyReplace)))
+		return store, store.Bucket("vectors_queue")
+	}
+	push := func(t *testing.T, q *IndexQueue, from, to uint64) {
+		for id := from; id < to; id++ {
+			require.Nil(t, q.Push([]uint64{id}, [][]float32{{float32(id), 0}})[0])
+		}
+	}
+
+	t.Run("queued vectors are indexed in the background", func(t *testing.T) {
+		store, bucket := newBucket(t, t.TempDir())
+		defer store.Shutdown(ctx)
+		index := newFakeQueueVectorIndex(nil)
+		var indexed atomic.Int32
+		q := newIndexQueue(index, bucket, distancer.NewL2SquaredProvider(), cfg,
+			func() { indexed.Add(1) }, logger)
+		defer q.Close()
+
+		push(t, q, 0, 25)
+		require.Nil(t, q.waitUntilIndexed(ctx))
+		assert.Greater(t, indexed.Load(), int32(0))
+
+		assert.ElementsMatch(t, []uint64{
+			0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12,
+			13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24,
+		}, index.ids())
+		size, lag := q.Stats()
+		assert.Equal(t, int64(0), size)
+		assert.Equal(t, time.Duration(0), lag)
+	})
+
+	t.Run("invalid vectors are rejected right away", func(t *testing.T) {
+		store, bucket := newBucket(t, t.TempDir())
+		defer store.Shutdown(ctx)
+		q := newIndexQueue(newFakeQueueVectorIndex(nil), bucket,
+			distancer.NewL2SquaredProvider(), cfg, nil, logger)
+		defer q.Close()
+
+		errs := q.Push([]uint64{1, 2}, [][]float32{{1, 2}, {1, 2, 3}})
+		assert.Nil(t, errs[0])
+		assert.ErrorContains(t, errs[1], "3 dimensions")
+	})
+
+	t.Run("writes block while the queue is full", func(t *testing.T) {
+		store, bucket := newBucket(t, t.TempDir())
+		defer store.Shutdown(ctx)
+		gate := make(chan struct{})
+		q := newIndexQueue(newFakeQueueVectorIndex(gate), bucket,
+			distancer.NewL2SquaredProvider(), cfg, nil, logger)
+		defer q.Close()
+
+		push(t, q, 0, 10)
+		size, _ := q.Stats()
+		assert.Equal(t, int64(10), size)
+
+		pushed := make(chan struct{})
+		go func() {
+			push(t, q, 10, 11)
+			close(pushed)
+		}()
+
+		select {
+		case <-pushed:
+			t.Fatal("push did not block on a full queue")
+		case <-time.After(50 * time.Millisecond):
+		}
+
+		close(gate)
+		select {
+		case <-pushed:
+		case <-time.After(5 * time.Second):
+			t.Fatal("push is still blocked after the queue was drained")
+		}
+		require.Nil(t, q.waitUntilIndexed(ctx))
+	})
+
+	t.Run("deletes", func(t *testing.T) {
+		store, bucket := newBucket(t, t.TempDir())
+		defer store.Shutdown(ctx)
+		gate := make(chan struct{})
+		index := newFakeQueueVectorIndex(gate)
+		q := newIndexQueue(index, bucket, distancer.NewL2SquaredProvider(), cfg, nil, logger)
+		defer q.Close()
+
+		push(t, q, 0, 8)
+		// the worker blocks on the first batch, ids 0 to 3
+		require.Eventually(t, func() bool {
+			q.Lock()
+			defer q.Unlock()
+			return len(q.indexing) == 4
+		}, 5*time.Second, time.Millisecond)
+
+		// 1 is being indexed and 6 is still queued
+		require.Nil(t, q.Delete(1, 6))
+		close(gate)
+		require.Nil(t, q.waitUntilIndexed(ctx))
+		assert.ElementsMatch(t, []uint64{0, 2, 3, 4, 5, 7}, index.ids())
+
+		// 7 has been indexed
+		require.Nil(t, q.Delete(7))
+		assert.ElementsMatch(t, []uint64{0, 2, 3, 4, 5}, index.ids())
+	})
+
+	t.Run("queued vectors survive a restart", func(t *testing.T) {
+		dir := t.TempDir()
+		store, bucket := newBucket(t, dir)
+		gate := make(chan struct{})
+		q := newIndexQueue(newFakeQueueVectorIndex(gate), bucket,
+			distancer.NewL2SquaredProvider(), cfg, nil, logger)
+
+		push(t, q, 0, 6)
+		require.Nil(t, q.Delete(5))
+
+		// close the queue while the worker is blocked on the first batch
+		require.Eventually(t, func() bool {
+			q.Lock()
+			defer q.Unlock()
+			return len(q.indexing) == 4
+		}, 5*time.Second, time.Millisecond)
+		closed := make(chan struct{})
+		go func() {
+			q.Close()
+			close(closed)
+		}()
+		require.Eventually(t, func() bool {
+			q.Lock()
+			defer q.Unlock()
+			return q.closed
+		}, 5*time.Second, time.Millisecond)
+		close(gate)
+		<-closed
+		require.Nil(t, store.Shutdown(ctx))
+
+		store, bucket = newBucket(t, dir)
+		defer store.Shutdown(ctx)
+		index := newFakeQueueVectorIndex(nil)
+		q = newIndexQueue(index, bucket, distancer.NewL2SquaredProvider(), cfg, nil, logger)
+		defer q.Close()
+
+		require.Nil(t, q.waitUntilIndexed(ctx))
+		// the first batch was indexed before the queue was closed
+		assert.ElementsMatch(t, []uint64{4}, index.ids())
+	})
+
+	t.Run("searches merge the queued vectors", func(t *testing.T) {
+		store, bucket := newBucket(t, t.TempDir())
+		defer store.Shutdown(ctx)
+		gate := make(chan struct{})
+		defer close(gate)
+		q := newIndexQueue(newFakeQueueVectorIndex(gate), bucket,
+			distancer.NewL2SquaredProvider(), cfg, nil, logger)
+		defer q.Close()
+
+		push(t, q, 1, 6)
+
+		// the vector index found 100 with a distance of 2.5
+		ids, dists := q.searchByVector([]float32{3, 0}, 3, nil,
+			[]uint64{100}, []float32{2.5})
+		assert.Equal(t, []uint64{3, 2, 4}, ids)
+		assert.Equal(t, []float32{0, 1, 1}, dists)
+
+		ids, _ = q.searchByVectorDistance([]float32{3, 0}, 2, 100, nil,
+			[]uint64{100}, []float32{0.5})
+		assert.ElementsMatch(t, []uint64{100, 3, 2, 4}, ids)
+	})
+}
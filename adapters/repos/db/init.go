@@ -32,6 +32,15 @@ func (db *DB) init(ctx context.Context) error {
 		return errors.Wrapf(err, "create root path directory at %s", db.config.RootPath)
 	}
 
+	// complete the drops which were interrupted before any files are loaded
+	if err := db.trash.recover(); err != nil {
+		return errors.Wrap(err, "recover dropped classes and shards")
+	}
+
+	if err := db.readOnly.load(); err != nil {
+		return errors.Wrap(err, "load read-only mode")
+	}
+
 	objects := db.schemaGetter.GetSchemaSkipAuth().Objects
 	if objects != nil {
 		for _, class := range objects.Classes {
@@ -65,8 +74,22 @@ func (db *DB) init(ctx context.Context) error {
 				MemtablesMaxSizeMB:        db.config.MemtablesMaxSizeMB,
 				MemtablesMinActiveSeconds: db.config.MemtablesMinActiveSeconds,
 				MemtablesMaxActiveSeconds: db.config.MemtablesMaxActiveSeconds,
+				MemtablesMaxAgeSeconds:    db.config.MemtablesMaxAgeSeconds,
+				MemtablesMaxWALSizeMB:     db.config.MemtablesMaxWALSizeMB,
 				TrackVectorDimensions:     db.config.TrackVectorDimensions,
 				AvoidMMap:                 db.config.AvoidMMap,
+				HNSWCheckpointInterval:    db.config.HNSWCheckpointInterval,
+				AsyncIndexing:             db.config.AsyncIndexing,
+				LSMCompactionStrategy:     db.config.LSMCompactionStrategy,
+				LSMCompactionSizeRatio:    db.config.LSMCompactionSizeRatio,
+				WALSyncPolicy:             db.config.WALSyncPolicy,
+				BlockCache:                db.blockCache,
+				LSMDirectIO:               db.config.LSMDirectIO,
+				LSMScrubInterval:          db.config.LSMScrubInterval,
+				Offloader:                 db.offloader,
+				Trash:                     db.trash,
+				ReadOnly:                  db.readOnly,
+				LazyLoadShards:            db.config.LazyLoadShards,
 				ReplicationFactor:         class.ReplicationConfig.Factor,
 			}, db.schemaGetter.CopyShardingState(class.Class),
 				inverted.ConfigFromModel(invertedConfig),
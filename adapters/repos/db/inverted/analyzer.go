@@ -14,10 +14,13 @@ package inverted
 import (
 	"bytes"
 	"encoding/binary"
+	"net/netip"
 
 	"github.com/google/uuid"
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/entities/geometry"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
 )
 
 type IsFallbackToSearchable func() bool
@@ -25,14 +28,19 @@ type IsFallbackToSearchable func() bool
 type Countable struct {
 	Data          []byte
 	TermFrequency float32
+	// Positions of the term within the property in ascending order, only set
+	// for properties which store positions
+	Positions []uint32
 }
 
 type Property struct {
-	Name               string
-	Items              []Countable
-	Length             int
-	HasFilterableIndex bool // roaring set index
-	HasSearchableIndex bool // map index (with frequencies)
+	Name                 string
+	Items                []Countable
+	Length               int
+	HasFilterableIndex   bool // roaring set index
+	HasSearchableIndex   bool // map index (with frequencies)
+	HasSparseVectorIndex bool // map index (with sparse vector weights)
+	HasRangeableIndex    bool // bit-sliced roaring set index
 }
 
 type Analyzer struct {
@@ -70,6 +78,45 @@ func (a *Analyzer) TextArray(tokenization string, inArr []string) []Countable {
 	return countable
 }
 
+// textArrayPositionGap is added to the positions of the terms of every
+// element of a text array, so that phrases do not span two elements
+const textArrayPositionGap = 100
+
+// TextPositions is like Text, but also records the positions of the terms
+func (a *Analyzer) TextPositions(tokenization, in string) []Countable {
+	return a.TextArrayPositions(tokenization, []string{in})
+}
+
+// TextArrayPositions is like TextArray, but also records the positions of
+// the terms
+func (a *Analyzer) TextArrayPositions(tokenization string, inArr []string) []Countable {
+	positions := map[string][]uint32{}
+	var order []string
+	pos := uint32(0)
+	for i, in := range inArr {
+		if i > 0 {
+			pos += textArrayPositionGap
+		}
+		for _, term := range helpers.Tokenize(tokenization, in) {
+			if _, ok := positions[term]; !ok {
+				order = append(order, term)
+			}
+			positions[term] = append(positions[term], pos)
+			pos++
+		}
+	}
+
+	countable := make([]Countable, len(order))
+	for i, term := range order {
+		countable[i] = Countable{
+			Data:          []byte(term),
+			TermFrequency: float32(len(positions[term])),
+			Positions:     positions[term],
+		}
+	}
+	return countable
+}
+
 // Int requires no analysis, so it's actually just a simple conversion to a
 // string-formatted byte slice of the int
 func (a *Analyzer) Int(in int64) ([]Countable, error) {
@@ -85,6 +132,16 @@ func (a *Analyzer) Int(in int64) ([]Countable, error) {
 	}, nil
 }
 
+// Decimal requires no analysis, so it's just dumping the sortable
+// representation
+func (a *Analyzer) Decimal(in schema.Decimal) ([]Countable, error) {
+	return []Countable{
+		{
+			Data: LexicographicallySortableDecimal(in),
+		},
+	}, nil
+}
+
 // UUID requires no analysis, so it's just dumping the raw binary representation
 func (a *Analyzer) UUID(in uuid.UUID) ([]Countable, error) {
 	return []Countable{
@@ -94,6 +151,67 @@ func (a *Analyzer) UUID(in uuid.UUID) ([]Countable, error) {
 	}, nil
 }
 
+// IP requires no analysis, so it's just dumping the 16 byte binary
+// representation, which sorts IPv4 and IPv6 addresses alike
+func (a *Analyzer) IP(in netip.Addr) ([]Countable, error) {
+	return []Countable{
+		{
+			Data: schema.IPBytes(in),
+		},
+	}, nil
+}
+
+// GeoShape is indexed as the geohash cells covering the shape. A cell may be
+// a prefix of, or equal to, the cells of other shapes, so the searcher can
+// find candidates sharing an area with a polygon by prefix.
+func (a *Analyzer) GeoShape(in geometry.Shape) ([]Countable, error) {
+	cells := geometry.Cover(in)
+	out := make([]Countable, len(cells))
+	for i := range cells {
+		out[i] = Countable{
+			Data: []byte(cells[i]),
+		}
+	}
+
+	return out, nil
+}
+
+// SparseVector is indexed by its non-zero dimensions, with the value of a
+// dimension as its frequency
+func (a *Analyzer) SparseVector(in *models.SparseVector) []Countable {
+	out := make([]Countable, len(in.Indices))
+	for i := range in.Indices {
+		out[i] = Countable{
+			Data:          SparseVectorDimensionKey(uint32(in.Indices[i])),
+			TermFrequency: in.Values[i],
+		}
+	}
+
+	return out
+}
+
+// SparseVectorDimensionKey is the key of the posting list of a dimension in
+// the sparse vector index
+func SparseVectorDimensionKey(dim uint32) []byte {
+	key := make([]byte, 4)
+	binary.BigEndian.PutUint32(key, dim)
+	return key
+}
+
+// PhoneNumber is indexed by its parsed representations, so it can be found by
+// either of them
+func (a *Analyzer) PhoneNumber(in *models.PhoneNumber) ([]Countable, error) {
+	reprs := schema.PhoneNumberRepresentations(in)
+	out := make([]Countable, len(reprs))
+	for i := range reprs {
+		out[i] = Countable{
+			Data: []byte(reprs[i]),
+		}
+	}
+
+	return out, nil
+}
+
 // UUID array requires no analysis, so it's just dumping the raw binary
 // representation of each contained element
 func (a *Analyzer) UUIDArray(in []uuid.UUID) ([]Countable, error) {
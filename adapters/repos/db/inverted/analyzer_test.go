@@ -20,6 +20,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
 )
 
 func TestAnalyzer(t *testing.T) {
@@ -212,6 +213,24 @@ func TestAnalyzer(t *testing.T) {
 		}
 	})
 
+	t.Run("with text positions", func(t *testing.T) {
+		res := a.TextPositions(models.PropertyTokenizationWord, "Du. Du hast. Du hast mich.")
+		assert.Equal(t, []Countable{
+			{Data: []byte("du"), TermFrequency: 3, Positions: []uint32{0, 1, 3}},
+			{Data: []byte("hast"), TermFrequency: 2, Positions: []uint32{2, 4}},
+			{Data: []byte("mich"), TermFrequency: 1, Positions: []uint32{5}},
+		}, res)
+	})
+
+	t.Run("with text array positions", func(t *testing.T) {
+		res := a.TextArrayPositions(models.PropertyTokenizationWord, []string{"Du hast", "mich, du"})
+		assert.Equal(t, []Countable{
+			{Data: []byte("du"), TermFrequency: 2, Positions: []uint32{0, 103}},
+			{Data: []byte("hast"), TermFrequency: 1, Positions: []uint32{1}},
+			{Data: []byte("mich"), TermFrequency: 1, Positions: []uint32{102}},
+		}, res)
+	})
+
 	t.Run("with int it stays sortable", func(t *testing.T) {
 		getData := func(in []Countable, err error) []byte {
 			require.Nil(t, err)
@@ -292,6 +311,53 @@ func TestAnalyzer(t *testing.T) {
 		assert.Equal(t, results, afterSort)
 	})
 
+	t.Run("with decimal it stays sortable", func(t *testing.T) {
+		getData := func(in string) []byte {
+			d, err := schema.ParseDecimal(in)
+			require.Nil(t, err)
+			countable, err := a.Decimal(d)
+			require.Nil(t, err)
+			return countable[0].Data
+		}
+
+		results := [][]byte{
+			getData("-1e999"),
+			getData("-1000000"),
+			getData("-9000.9"),
+			getData("-9000.8999"),
+			getData("-9000.89989999999999999999"),
+			getData("-9000.8998"),
+			getData("-301"),
+			getData("-300"),
+			getData("-299.99999999999999999999"),
+			getData("-1"),
+			getData("-0.09"),
+			getData("-0.01"),
+			getData("-0.009"),
+			getData("0"),
+			getData("1e-1000"),
+			getData("0.009"),
+			getData("0.01"),
+			getData("0.09"),
+			getData("0.1"),
+			getData("0.10000000000000000001"),
+			getData("0.9"),
+			getData("1"),
+			getData("299"),
+			getData("299.00000000000000000001"),
+			getData("300"),
+			getData("301"),
+			getData("9000"),
+			getData("1000000"),
+			getData("1e999"),
+		}
+
+		afterSort := make([][]byte, len(results))
+		copy(afterSort, results)
+		sort.Slice(afterSort, func(a, b int) bool { return bytes.Compare(afterSort[a], afterSort[b]) == -1 })
+		assert.Equal(t, results, afterSort)
+	})
+
 	t.Run("with refCount it stays sortable", func(t *testing.T) {
 		getData := func(in []Countable, err error) []byte {
 			require.Nil(t, err)
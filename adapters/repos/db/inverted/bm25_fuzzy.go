@@ -0,0 +1,85 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+)
+
+// expandFuzzyTerms replaces every query term with the terms of the
+// properties within the edit distance of it, each of which is then scored
+// like a term of the query. Terms reached from several query terms are
+// boosted by all of them.
+func (b *BM25Searcher) expandFuzzyTerms(propNames []string, queryTerms []string,
+	duplicateBoosts []int, distance int,
+) ([]string, []int, error) {
+	boosts := map[string]int{}
+	for i, queryTerm := range queryTerms {
+		// the term itself is kept, even if it is not contained in any of the
+		// properties
+		matches := map[string]struct{}{queryTerm: {}}
+
+		automaton := newLevenshteinAutomaton(queryTerm, distance)
+		for _, propName := range propNames {
+			bucket := b.store.Bucket(helpers.BucketSearchableFromPropNameLSM(propName))
+			if bucket == nil {
+				return nil, nil, fmt.Errorf("could not find bucket for property %v", propName)
+			}
+
+			keys, err := fuzzyTermsOfBucket(bucket, automaton)
+			if err != nil {
+				return nil, nil, err
+			}
+			for _, key := range keys {
+				matches[string(key)] = struct{}{}
+			}
+		}
+
+		for term := range matches {
+			boosts[term] += duplicateBoosts[i]
+		}
+	}
+
+	terms := make([]string, 0, len(boosts))
+	for term := range boosts {
+		terms = append(terms, term)
+	}
+	sort.Strings(terms)
+
+	outBoosts := make([]int, len(terms))
+	for i, term := range terms {
+		outBoosts[i] = boosts[term]
+	}
+	return terms, outBoosts, nil
+}
+
+func fuzzyTermsOfBucket(bucket *lsmkv.Bucket, automaton lsmkv.Automaton) ([][]byte, error) {
+	if bucket.HasTermDictionary() {
+		return bucket.MatchingKeys(automaton)
+	}
+
+	// properties indexed before the term dictionaries were introduced
+	c := bucket.MapCursorKeyOnly()
+	defer c.Close()
+
+	var keys [][]byte
+	for k, _ := c.First(); k != nil; k, _ = c.Next() {
+		if lsmkv.AutomatonMatches(automaton, k) {
+			keys = append(keys, append([]byte{}, k...))
+		}
+	}
+	return keys, nil
+}
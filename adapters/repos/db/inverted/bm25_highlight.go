@@ -0,0 +1,181 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+const (
+	DefaultHighlightFragmentSize = 100
+	DefaultHighlightPreTag       = "<em>"
+	DefaultHighlightPostTag      = "</em>"
+
+	// maxHighlightFragments is the maximum number of fragments per property
+	maxHighlightFragments = 3
+)
+
+// addHighlights marks the query terms in the searched properties of the
+// objects. The offsets of the terms are taken from tokenizing the stored
+// text again, so that the marked parts are exactly the ones which were
+// indexed as the matching terms.
+func addHighlights(objects []*storobj.Object, props []*models.Property,
+	queryTermsByTokenization map[string][]string, params *searchparams.Highlight,
+) {
+	fragmentSize := params.FragmentSize
+	if fragmentSize <= 0 {
+		fragmentSize = DefaultHighlightFragmentSize
+	}
+	preTag, postTag := params.PreTag, params.PostTag
+	if preTag == "" && postTag == "" {
+		preTag, postTag = DefaultHighlightPreTag, DefaultHighlightPostTag
+	}
+
+	termsByTokenization := make(map[string]map[string]struct{}, len(queryTermsByTokenization))
+	for tokenization, queryTerms := range queryTermsByTokenization {
+		terms := make(map[string]struct{}, len(queryTerms))
+		for _, term := range queryTerms {
+			terms[term] = struct{}{}
+		}
+		termsByTokenization[tokenization] = terms
+	}
+
+	for _, obj := range objects {
+		properties, ok := obj.Properties().(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		highlights := []*additional.Highlight{}
+		for _, prop := range props {
+			terms := termsByTokenization[prop.Tokenization]
+			if len(terms) == 0 {
+				continue
+			}
+
+			var fragments []string
+			for _, text := range textsOfValue(properties[prop.Name]) {
+				if len(fragments) >= maxHighlightFragments {
+					break
+				}
+				fragments = append(fragments, highlightText(text, prop.Tokenization, terms,
+					fragmentSize, preTag, postTag, maxHighlightFragments-len(fragments))...)
+			}
+			if len(fragments) > 0 {
+				highlights = append(highlights, &additional.Highlight{
+					Property:  prop.Name,
+					Fragments: fragments,
+				})
+			}
+		}
+
+		if obj.AdditionalProperties() == nil {
+			obj.Object.Additional = make(map[string]interface{})
+		}
+		obj.Object.Additional["highlights"] = highlights
+	}
+}
+
+func textsOfValue(value interface{}) []string {
+	switch v := value.(type) {
+	case string:
+		return []string{v}
+	case []string:
+		return v
+	case []interface{}:
+		texts := make([]string, 0, len(v))
+		for _, elem := range v {
+			if text, ok := elem.(string); ok {
+				texts = append(texts, text)
+			}
+		}
+		return texts
+	default:
+		return nil
+	}
+}
+
+// highlightText returns up to maxFragments fragments of the text with the
+// spans containing one of the terms enclosed in the tags. Fragments are
+// grown around their first match by whole spans as long as they do not exceed
+// the fragment size, a single span larger than the fragment size is
+// returned as is.
+func highlightText(text, tokenization string, terms map[string]struct{},
+	fragmentSize int, preTag, postTag string, maxFragments int,
+) []string {
+	spans := helpers.TokenSpans(tokenization, text)
+
+	matches := make([]bool, len(spans))
+	var matchIndices []int
+	for i, span := range spans {
+		for _, term := range span.Terms {
+			if _, ok := terms[term]; ok {
+				matches[i] = true
+				matchIndices = append(matchIndices, i)
+				break
+			}
+		}
+	}
+	if len(matchIndices) == 0 {
+		return nil
+	}
+
+	length := func(lo, hi int) int {
+		return utf8.RuneCountInString(text[spans[lo].Start:spans[hi].End])
+	}
+
+	var fragments []string
+	minLo := 0
+	for m := 0; m < len(matchIndices) && len(fragments) < maxFragments; {
+		lo, hi := matchIndices[m], matchIndices[m]
+		for grown := true; grown; {
+			grown = false
+			if hi+1 < len(spans) && length(lo, hi+1) <= fragmentSize {
+				hi++
+				grown = true
+			}
+			if lo-1 >= minLo && length(lo-1, hi) <= fragmentSize {
+				lo--
+				grown = true
+			}
+		}
+
+		var sb strings.Builder
+		pos := spans[lo].Start
+		for i := lo; i <= hi; i++ {
+			if !matches[i] {
+				continue
+			}
+			sb.WriteString(text[pos:spans[i].Start])
+			sb.WriteString(preTag)
+			sb.WriteString(text[spans[i].Start:spans[i].End])
+			sb.WriteString(postTag)
+			pos = spans[i].End
+		}
+		sb.WriteString(text[pos:spans[hi].End])
+		fragments = append(fragments, sb.String())
+
+		minLo = hi + 1
+		for m < len(matchIndices) && matchIndices[m] <= hi {
+			m++
+		}
+	}
+
+	return fragments
+}
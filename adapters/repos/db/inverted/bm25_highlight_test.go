@@ -0,0 +1,122 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+func TestHighlightText(t *testing.T) {
+	terms := map[string]struct{}{"fox": {}, "dog": {}}
+
+	for _, tc := range []struct {
+		name         string
+		text         string
+		tokenization string
+		fragmentSize int
+		maxFragments int
+		expected     []string
+	}{
+		{
+			name:         "no match",
+			text:         "the quick brown cat",
+			tokenization: models.PropertyTokenizationWord,
+			fragmentSize: 100,
+			maxFragments: 3,
+			expected:     nil,
+		},
+		{
+			name:         "whole text fits",
+			text:         "The quick brown Fox jumps over the lazy dog.",
+			tokenization: models.PropertyTokenizationWord,
+			fragmentSize: 100,
+			maxFragments: 3,
+			expected:     []string{"The quick brown [Fox] jumps over the lazy [dog]"},
+		},
+		{
+			name:         "fragments around the matches",
+			text:         "The quick brown fox jumps over the lazy dog",
+			tokenization: models.PropertyTokenizationWord,
+			fragmentSize: 15,
+			maxFragments: 3,
+			expected:     []string{"brown [fox] jumps", "the lazy [dog]"},
+		},
+		{
+			name:         "limited number of fragments",
+			text:         "The quick brown fox jumps over the lazy dog",
+			tokenization: models.PropertyTokenizationWord,
+			fragmentSize: 15,
+			maxFragments: 1,
+			expected:     []string{"brown [fox] jumps"},
+		},
+		{
+			name:         "tokenization of the property",
+			text:         "fox, dog and fox",
+			tokenization: models.PropertyTokenizationWhitespace,
+			fragmentSize: 100,
+			maxFragments: 3,
+			expected:     []string{"fox, [dog] and [fox]"},
+		},
+		{
+			name:         "span larger than the fragment size",
+			text:         "a multibyte fööx dog",
+			tokenization: models.PropertyTokenizationWord,
+			fragmentSize: 2,
+			maxFragments: 3,
+			expected:     []string{"[dog]"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			fragments := highlightText(tc.text, tc.tokenization, terms, tc.fragmentSize,
+				"[", "]", tc.maxFragments)
+			assert.Equal(t, tc.expected, fragments)
+		})
+	}
+}
+
+func TestAddHighlights(t *testing.T) {
+	objects := []*storobj.Object{
+		storobj.FromObject(&models.Object{
+			Class: "Article",
+			Properties: map[string]interface{}{
+				"title": "Foxes and a fox",
+				"tags":  []interface{}{"Fox", "dog"},
+			},
+		}, nil),
+		storobj.FromObject(&models.Object{
+			Class:      "Article",
+			Properties: map[string]interface{}{"title": "cats"},
+		}, nil),
+	}
+	props := []*models.Property{
+		{Name: "title", Tokenization: models.PropertyTokenizationWord},
+		{Name: "tags", Tokenization: models.PropertyTokenizationField},
+	}
+	queryTerms := map[string][]string{
+		models.PropertyTokenizationWord:  {"fox"},
+		models.PropertyTokenizationField: {"Fox"},
+	}
+
+	addHighlights(objects, props, queryTerms, &searchparams.Highlight{})
+
+	assert.Equal(t, []*additional.Highlight{
+		{Property: "title", Fragments: []string{"Foxes and a <em>fox</em>"}},
+		{Property: "tags", Fragments: []string{"<em>Fox</em>"}},
+	}, objects[0].Highlights())
+	assert.Equal(t, []*additional.Highlight{}, objects[1].Highlights())
+}
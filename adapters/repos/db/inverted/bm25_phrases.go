@@ -0,0 +1,235 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/weaviate/sroar"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// keywordPhrase is a quoted part of a keyword query. Its terms have to occur
+// in the same order within a property, with at most slop other terms in
+// between them.
+type keywordPhrase struct {
+	text string
+	slop int
+}
+
+// parseKeywordPhrases extracts the phrases of a keyword query. A phrase is
+// quoted and may be followed by a proximity, e.g. `"exact phrase"~2`. The
+// returned query still holds the terms of the phrases, so that they are
+// scored like all other terms. An unbalanced quote is kept as is.
+func parseKeywordPhrases(query string) (string, []keywordPhrase) {
+	if !strings.Contains(query, `"`) {
+		return query, nil
+	}
+
+	var out strings.Builder
+	var phrases []keywordPhrase
+	rest := query
+	for {
+		start := strings.IndexByte(rest, '"')
+		if start < 0 {
+			break
+		}
+		length := strings.IndexByte(rest[start+1:], '"')
+		if length < 0 {
+			break
+		}
+
+		text := rest[start+1 : start+1+length]
+		out.WriteString(rest[:start])
+		out.WriteString(" " + text + " ")
+		rest = rest[start+length+2:]
+
+		slop := 0
+		if strings.HasPrefix(rest, "~") {
+			digits := 0
+			for digits+1 < len(rest) && rest[digits+1] >= '0' && rest[digits+1] <= '9' {
+				digits++
+			}
+			if digits > 0 {
+				var err error
+				if slop, err = strconv.Atoi(rest[1 : digits+1]); err != nil {
+					slop = math.MaxInt32
+				}
+				rest = rest[digits+1:]
+			}
+		}
+
+		if strings.TrimSpace(text) != "" {
+			phrases = append(phrases, keywordPhrase{text: text, slop: slop})
+		}
+	}
+	out.WriteString(rest)
+
+	return out.String(), phrases
+}
+
+// positionalProperties returns the names of the properties which store the
+// positions of their terms
+func positionalProperties(class *models.Class, propertiesWithBoost []string) []string {
+	var out []string
+	for _, propertyWithBoost := range propertiesWithBoost {
+		propName := strings.Split(propertyWithBoost, "^")[0]
+		prop, err := schema.GetPropertyByName(class, propName)
+		if err != nil {
+			continue
+		}
+		if HasPositionsIndex(prop) {
+			out = append(out, propName)
+		}
+	}
+	return out
+}
+
+// phraseDocIDs returns the ids of the documents which contain every phrase in
+// at least one of the properties
+func (b *BM25Searcher) phraseDocIDs(class *models.Class, propNames []string,
+	phrases []keywordPhrase,
+) (*sroar.Bitmap, error) {
+	var out *sroar.Bitmap
+	for _, phrase := range phrases {
+		matches := sroar.NewBitmap()
+		for _, propName := range propNames {
+			prop, err := schema.GetPropertyByName(class, propName)
+			if err != nil {
+				return nil, err
+			}
+			propMatches, err := b.phraseDocIDsOfProp(prop, phrase)
+			if err != nil {
+				return nil, err
+			}
+			matches.Or(propMatches)
+		}
+
+		if out == nil {
+			out = matches
+		} else {
+			out.And(matches)
+		}
+		if out.IsEmpty() {
+			break
+		}
+	}
+	return out, nil
+}
+
+func (b *BM25Searcher) phraseDocIDsOfProp(prop *models.Property,
+	phrase keywordPhrase,
+) (*sroar.Bitmap, error) {
+	out := sroar.NewBitmap()
+	terms := helpers.Tokenize(prop.Tokenization, phrase.text)
+	if len(terms) == 0 {
+		return out, nil
+	}
+
+	bucket := b.store.Bucket(helpers.BucketSearchableFromPropNameLSM(prop.Name))
+	if bucket == nil {
+		return nil, fmt.Errorf("could not find bucket for property %v", prop.Name)
+	}
+
+	// the encoded positions of every term by document, only documents which
+	// contain all terms are kept
+	var candidates map[uint64][][]byte
+	for i, term := range terms {
+		pairs, err := bucket.MapList([]byte(term))
+		if err != nil {
+			return nil, err
+		}
+
+		next := make(map[uint64][][]byte, len(pairs))
+		for _, pair := range pairs {
+			if len(pair.Value) < 8 {
+				continue
+			}
+			docID := binary.BigEndian.Uint64(pair.Key)
+			positions := candidates[docID]
+			if i == 0 {
+				positions = make([][]byte, len(terms))
+			} else if positions == nil {
+				continue
+			}
+			positions[i] = pair.Value[8:]
+			next[docID] = positions
+		}
+		candidates = next
+		if len(candidates) == 0 {
+			return out, nil
+		}
+	}
+
+	for docID, positions := range candidates {
+		if containsPhrase(positions, phrase.slop) {
+			out.Set(docID)
+		}
+	}
+	return out, nil
+}
+
+// containsPhrase checks whether the terms occur in order, with at most slop
+// other positions in between them. The positions of every term are encoded
+// as uint32 in ascending order.
+func containsPhrase(positions [][]byte, slop int) bool {
+	first := positions[0]
+	for p := 0; p+4 <= len(first); p += 4 {
+		start := binary.LittleEndian.Uint32(first[p:])
+		prev := start
+		for _, termPositions := range positions[1:] {
+			next, ok := nextPosition(termPositions, prev)
+			if !ok {
+				// a later start can not be followed by the term either
+				return false
+			}
+			prev = next
+		}
+
+		// choosing the closest following position of every term minimizes the
+		// distance to the start
+		if int(prev-start)-(len(positions)-1) <= slop {
+			return true
+		}
+	}
+	return false
+}
+
+// nextPosition returns the first encoded position after the given one
+func nextPosition(encoded []byte, after uint32) (uint32, bool) {
+	count := len(encoded) / 4
+	i := sort.Search(count, func(i int) bool {
+		return binary.LittleEndian.Uint32(encoded[4*i:]) > after
+	})
+	if i == count {
+		return 0, false
+	}
+	return binary.LittleEndian.Uint32(encoded[4*i:]), true
+}
+
+// intersectAllowList keeps only the ids which are also allowed by the filter
+func intersectAllowList(docIDs *sroar.Bitmap, allowList helpers.AllowList) *sroar.Bitmap {
+	out := sroar.NewBitmap()
+	for _, docID := range docIDs.ToArray() {
+		if allowList.Contains(docID) {
+			out.Set(docID)
+		}
+	}
+	return out
+}
@@ -0,0 +1,87 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"encoding/binary"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseKeywordPhrases(t *testing.T) {
+	for _, tc := range []struct {
+		name            string
+		query           string
+		expectedQuery   string
+		expectedPhrases []keywordPhrase
+	}{
+		{"without phrases", "quick brown fox", "quick brown fox", nil},
+		{
+			"exact phrase", `the "quick brown" fox`, "the  quick brown  fox",
+			[]keywordPhrase{{text: "quick brown"}},
+		},
+		{
+			"proximity", `"quick fox"~2 jumps`, " quick fox  jumps",
+			[]keywordPhrase{{text: "quick fox", slop: 2}},
+		},
+		{
+			"several phrases", `"quick brown"~1"lazy dog"`, " quick brown  lazy dog ",
+			[]keywordPhrase{{text: "quick brown", slop: 1}, {text: "lazy dog"}},
+		},
+		{
+			"tilde without proximity", `"quick fox"~ jumps`, " quick fox ~ jumps",
+			[]keywordPhrase{{text: "quick fox"}},
+		},
+		{
+			"proximity which overflows", `"quick fox"~99999999999999999999`, " quick fox ",
+			[]keywordPhrase{{text: "quick fox", slop: math.MaxInt32}},
+		},
+		{"empty phrase", `"" fox`, "   fox", nil},
+		{"unbalanced quote", `"quick brown" "fox`, ` quick brown  "fox`, []keywordPhrase{{text: "quick brown"}}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			query, phrases := parseKeywordPhrases(tc.query)
+			assert.Equal(t, tc.expectedQuery, query)
+			assert.Equal(t, tc.expectedPhrases, phrases)
+		})
+	}
+}
+
+func TestContainsPhrase(t *testing.T) {
+	encode := func(positions ...uint32) []byte {
+		out := make([]byte, 4*len(positions))
+		for i, pos := range positions {
+			binary.LittleEndian.PutUint32(out[4*i:], pos)
+		}
+		return out
+	}
+
+	for _, tc := range []struct {
+		name      string
+		positions [][]byte
+		slop      int
+		expected  bool
+	}{
+		{"single term", [][]byte{encode(3)}, 0, true},
+		{"consecutive terms", [][]byte{encode(1, 7), encode(8)}, 0, true},
+		{"terms out of order", [][]byte{encode(8), encode(1, 7)}, 5, false},
+		{"terms too far apart", [][]byte{encode(1), encode(3), encode(6)}, 2, false},
+		{"terms within the slop", [][]byte{encode(1), encode(3), encode(6)}, 3, true},
+		{"closest occurrence is used", [][]byte{encode(1, 10), encode(3, 11), encode(4, 12)}, 0, true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, containsPhrase(tc.positions, tc.slop))
+		})
+	}
+}
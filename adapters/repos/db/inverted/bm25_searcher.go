@@ -103,49 +103,68 @@ func (b *BM25Searcher) wand(
 	var stopWordDetector *stopwords.Detector
 	if class.InvertedIndexConfig != nil && class.InvertedIndexConfig.Stopwords != nil {
 		var err error
-		stopWordDetector, err = stopwords.NewDetectorFromConfig(*(class.InvertedIndexConfig.Stopwords))
+		var sets []*models.StopwordSet
+		if b.schema.Objects != nil {
+			sets = b.schema.Objects.StopwordSets
+		}
+		stopWordDetector, err = stopwords.NewDetectorFromConfigWithSets(
+			*(class.InvertedIndexConfig.Stopwords), sets)
 		if err != nil {
 			return nil, nil, err
 		}
 	}
 
-	// There are currently cases, for different tokenization:
-	// word, lowercase, whitespace and field.
+	// There are currently cases for every tokenization, see helpers.Tokenizations.
 	// Query is tokenized and respective properties are then searched for the search terms,
 	// results at the end are combined using WAND
-	tokenizationsOrdered := []string{
-		models.PropertyTokenizationWord,
-		models.PropertyTokenizationLowercase,
-		models.PropertyTokenizationWhitespace,
-		models.PropertyTokenizationField,
-	}
+	tokenizationsOrdered := helpers.Tokenizations
 
 	queryTermsByTokenization := map[string][]string{}
 	duplicateBoostsByTokenization := map[string][]int{}
 	propNamesByTokenization := map[string][]string{}
 	propertyBoosts := make(map[string]float32, len(params.Properties))
 
+	// phrases are matched only against properties which store the positions
+	// of their terms, without any of them the query is searched for as is
+	query, phrases := parseKeywordPhrases(params.Query)
+	var positionalProps []string
+	if len(phrases) > 0 {
+		if positionalProps = positionalProperties(class, params.Properties); len(positionalProps) == 0 {
+			query, phrases = params.Query, nil
+		}
+	}
+
 	for _, tokenization := range tokenizationsOrdered {
-		queryTermsByTokenization[tokenization], duplicateBoostsByTokenization[tokenization] = helpers.TokenizeAndCountDuplicates(tokenization, params.Query)
+		queryTermsByTokenization[tokenization], duplicateBoostsByTokenization[tokenization] = helpers.TokenizeAndCountDuplicates(tokenization, query)
 
 		// stopword filtering for word tokenization
 		if tokenization == models.PropertyTokenizationWord {
 			queryTermsByTokenization[tokenization], duplicateBoostsByTokenization[tokenization] = b.removeStopwordsFromQueryTerms(queryTermsByTokenization[tokenization], duplicateBoostsByTokenization[tokenization], stopWordDetector)
 		}
 
+		if class.InvertedIndexConfig != nil {
+			queryTermsByTokenization[tokenization], duplicateBoostsByTokenization[tokenization] = expandSynonyms(class.InvertedIndexConfig.Synonyms, tokenization, queryTermsByTokenization[tokenization], duplicateBoostsByTokenization[tokenization])
+		}
+
 		propNamesByTokenization[tokenization] = make([]string, 0)
 	}
 
 	averagePropLength := 0.
+	textProps := make([]*models.Property, 0, len(params.Properties))
 	for _, propertyWithBoost := range params.Properties {
 		property := propertyWithBoost
-		propBoost := 1
+		propBoost := float32(1)
 		if strings.Contains(propertyWithBoost, "^") {
 			property = strings.Split(propertyWithBoost, "^")[0]
 			boostStr := strings.Split(propertyWithBoost, "^")[1]
-			propBoost, _ = strconv.Atoi(boostStr)
+			boost, _ := strconv.Atoi(boostStr)
+			propBoost = float32(boost)
+		} else if boost, ok := b.config.PropertyBoosts[property]; ok {
+			// the weight configured in the schema applies unless the query
+			// sets one
+			propBoost = float32(boost)
 		}
-		propertyBoosts[property] = float32(propBoost)
+		propertyBoosts[property] = propBoost
 
 		propMean, err := b.propLengths.PropertyMean(property)
 		if err != nil {
@@ -165,6 +184,7 @@ func (b *BM25Searcher) wand(
 					prop.Tokenization, prop.Name)
 			}
 			propNamesByTokenization[prop.Tokenization] = append(propNamesByTokenization[prop.Tokenization], property)
+			textProps = append(textProps, prop)
 		default:
 			return nil, nil, fmt.Errorf("cannot handle datatype '%v' of property '%s'", dt, prop.Name)
 		}
@@ -172,6 +192,35 @@ func (b *BM25Searcher) wand(
 
 	averagePropLength = averagePropLength / float64(len(params.Properties))
 
+	if len(phrases) > 0 {
+		phraseDocIDs, err := b.phraseDocIDs(class, positionalProps, phrases)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "match phrases")
+		}
+		if filterDocIds != nil {
+			phraseDocIDs = intersectAllowList(phraseDocIDs, filterDocIds)
+		}
+		if phraseDocIDs.IsEmpty() {
+			return []*storobj.Object{}, []float32{}, nil
+		}
+		filterDocIds = helpers.NewAllowListFromBitmap(phraseDocIDs)
+	}
+
+	if params.Fuzzy > 0 {
+		for tokenization, propNames := range propNamesByTokenization {
+			if len(propNames) == 0 {
+				continue
+			}
+			queryTerms, duplicateBoosts, err := b.expandFuzzyTerms(propNames,
+				queryTermsByTokenization[tokenization], duplicateBoostsByTokenization[tokenization], params.Fuzzy)
+			if err != nil {
+				return nil, nil, errors.Wrap(err, "expand fuzzy terms")
+			}
+			queryTermsByTokenization[tokenization] = queryTerms
+			duplicateBoostsByTokenization[tokenization] = duplicateBoosts
+		}
+	}
+
 	// preallocate the results
 	lengthAllResults := 0
 	for tokenization, propNames := range propNamesByTokenization {
@@ -226,7 +275,15 @@ func (b *BM25Searcher) wand(
 	copy(resultsOriginalOrder, results)
 
 	topKHeap := b.getTopKHeap(limit, results, averagePropLength)
-	return b.getTopKObjects(topKHeap, resultsOriginalOrder, indices, params.AdditionalExplanations)
+	objects, scores, err := b.getTopKObjects(topKHeap, resultsOriginalOrder, indices, params.AdditionalExplanations, class)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if params.Highlight != nil {
+		addHighlights(objects, textProps, queryTermsByTokenization, params.Highlight)
+	}
+	return objects, scores, nil
 }
 
 func (b *BM25Searcher) removeStopwordsFromQueryTerms(queryTerms []string, duplicateBoost []int, detector *stopwords.Detector) ([]string, []int) {
@@ -254,7 +311,7 @@ WordLoop:
 	}
 }
 
-func (b *BM25Searcher) getTopKObjects(topKHeap *priorityqueue.Queue, results terms, indices []map[uint64]int, additionalExplanations bool) ([]*storobj.Object, []float32, error) {
+func (b *BM25Searcher) getTopKObjects(topKHeap *priorityqueue.Queue, results terms, indices []map[uint64]int, additionalExplanations bool, class *models.Class) ([]*storobj.Object, []float32, error) {
 	objectsBucket := b.store.Bucket(helpers.ObjectsBucketLSM)
 	if objectsBucket == nil {
 		return nil, nil, errors.Errorf("objects bucket not found")
@@ -277,7 +334,7 @@ func (b *BM25Searcher) getTopKObjects(topKHeap *priorityqueue.Queue, results ter
 			continue
 		}
 
-		obj, err := storobj.FromBinary(objectByte)
+		obj, err := storobj.FromBinaryWithClass(objectByte, class)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -0,0 +1,95 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"sort"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// expandSynonyms adds the synonyms of the query terms to the query. A term of
+// a two-way rule is expanded to all other terms of the rule, a term of a
+// one-way rule to the synonyms of the rule. The words of the rules are
+// tokenized like the query, terms which are not a single token never match.
+// Added terms are boosted like the query terms they were reached from.
+func expandSynonyms(rules []*models.SynonymRule, tokenization string,
+	queryTerms []string, duplicateBoosts []int,
+) ([]string, []int) {
+	if len(rules) == 0 || len(queryTerms) == 0 {
+		return queryTerms, duplicateBoosts
+	}
+
+	expansions := map[string][]string{}
+	for _, rule := range rules {
+		if rule == nil {
+			continue
+		}
+
+		var ruleTerms []string
+		for _, term := range rule.Terms {
+			if tokens := helpers.Tokenize(tokenization, term); len(tokens) == 1 {
+				ruleTerms = append(ruleTerms, tokens[0])
+			}
+		}
+
+		targets := rule.Terms
+		if len(rule.Synonyms) > 0 {
+			targets = rule.Synonyms
+		}
+		var targetTerms []string
+		for _, target := range targets {
+			targetTerms = append(targetTerms, helpers.Tokenize(tokenization, target)...)
+		}
+
+		for _, term := range ruleTerms {
+			expansions[term] = append(expansions[term], targetTerms...)
+		}
+	}
+
+	expanded := false
+	for _, queryTerm := range queryTerms {
+		if len(expansions[queryTerm]) > 0 {
+			expanded = true
+			break
+		}
+	}
+	if !expanded {
+		return queryTerms, duplicateBoosts
+	}
+
+	boosts := make(map[string]int, len(queryTerms))
+	for i, queryTerm := range queryTerms {
+		// the term itself is kept, the query is not rewritten to the synonyms
+		matches := map[string]struct{}{queryTerm: {}}
+		for _, term := range expansions[queryTerm] {
+			matches[term] = struct{}{}
+		}
+
+		for term := range matches {
+			boosts[term] += duplicateBoosts[i]
+		}
+	}
+
+	terms := make([]string, 0, len(boosts))
+	for term := range boosts {
+		terms = append(terms, term)
+	}
+	sort.Strings(terms)
+
+	outBoosts := make([]int, len(terms))
+	for i, term := range terms {
+		outBoosts[i] = boosts[term]
+	}
+	return terms, outBoosts
+}
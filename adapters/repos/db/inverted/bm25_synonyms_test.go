@@ -0,0 +1,78 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestExpandSynonyms(t *testing.T) {
+	rules := []*models.SynonymRule{
+		{Terms: []string{"car", "Automobile", "auto"}},
+		{Terms: []string{"laptop"}, Synonyms: []string{"notebook", "portable computer"}},
+		{Terms: []string{"motor vehicle", "truck"}},
+	}
+
+	for _, tc := range []struct {
+		name           string
+		tokenization   string
+		queryTerms     []string
+		boosts         []int
+		expectedTerms  []string
+		expectedBoosts []int
+	}{
+		{
+			"no synonyms", models.PropertyTokenizationWord,
+			[]string{"red", "bike"}, []int{1, 1},
+			[]string{"red", "bike"}, []int{1, 1},
+		},
+		{
+			"two-way", models.PropertyTokenizationWord,
+			[]string{"red", "automobile"}, []int{1, 2},
+			[]string{"auto", "automobile", "car", "red"}, []int{2, 2, 2, 1},
+		},
+		{
+			"one-way", models.PropertyTokenizationWord,
+			[]string{"laptop"}, []int{1},
+			[]string{"computer", "laptop", "notebook", "portable"}, []int{1, 1, 1, 1},
+		},
+		{
+			"one-way does not expand the synonyms", models.PropertyTokenizationWord,
+			[]string{"notebook"}, []int{1},
+			[]string{"notebook"}, []int{1},
+		},
+		{
+			"terms of several tokens do not match", models.PropertyTokenizationWord,
+			[]string{"truck"}, []int{1},
+			[]string{"motor", "truck", "vehicle"}, []int{1, 1, 1},
+		},
+		{
+			"terms reached several times", models.PropertyTokenizationWord,
+			[]string{"car", "auto"}, []int{1, 1},
+			[]string{"auto", "automobile", "car"}, []int{2, 2, 2},
+		},
+		{
+			"rules tokenized like the query", models.PropertyTokenizationField,
+			[]string{"motor vehicle"}, []int{1},
+			[]string{"motor vehicle", "truck"}, []int{1, 1},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			terms, boosts := expandSynonyms(rules, tc.tokenization, tc.queryTerms, tc.boosts)
+			assert.Equal(t, tc.expectedTerms, terms)
+			assert.Equal(t, tc.expectedBoosts, boosts)
+		})
+	}
+}
@@ -17,6 +17,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted/stopwords"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/usecases/config"
@@ -39,6 +40,20 @@ func ValidateConfig(conf *models.InvertedIndexConfig) error {
 		return err
 	}
 
+	err = validateCompression(conf.Compression)
+	if err != nil {
+		return err
+	}
+
+	err = validateSynonyms(conf.Synonyms)
+	if err != nil {
+		return err
+	}
+
+	if conf.IndexTimestampRangeFilters && !conf.IndexTimestamps {
+		return errors.Errorf("indexTimestampRangeFilters requires indexTimestamps to be enabled")
+	}
+
 	return nil
 }
 
@@ -46,15 +61,27 @@ func ConfigFromModel(iicm *models.InvertedIndexConfig) schema.InvertedIndexConfi
 	var conf schema.InvertedIndexConfig
 
 	conf.IndexTimestamps = iicm.IndexTimestamps
+	conf.IndexTimestampRangeFilters = iicm.IndexTimestampRangeFilters
 	conf.IndexNullState = iicm.IndexNullState
 	conf.IndexPropertyLength = iicm.IndexPropertyLength
 
+	conf.Compression = iicm.Compression
+	if conf.Compression == "" {
+		conf.Compression = lsmkv.CompressionNone
+	}
+
 	if iicm.Bm25 == nil {
 		conf.BM25.K1 = float64(config.DefaultBM25k1)
 		conf.BM25.B = float64(config.DefaultBM25b)
 	} else {
 		conf.BM25.K1 = float64(iicm.Bm25.K1)
 		conf.BM25.B = float64(iicm.Bm25.B)
+		if len(iicm.Bm25.PropertyBoosts) > 0 {
+			conf.BM25.PropertyBoosts = make(map[string]float64, len(iicm.Bm25.PropertyBoosts))
+			for prop, boost := range iicm.Bm25.PropertyBoosts {
+				conf.BM25.PropertyBoosts[prop] = float64(boost)
+			}
+		}
 	}
 
 	if iicm.Stopwords == nil {
@@ -65,6 +92,7 @@ func ConfigFromModel(iicm *models.InvertedIndexConfig) schema.InvertedIndexConfi
 		conf.Stopwords.Preset = iicm.Stopwords.Preset
 		conf.Stopwords.Additions = iicm.Stopwords.Additions
 		conf.Stopwords.Removals = iicm.Stopwords.Removals
+		conf.Stopwords.Sets = iicm.Stopwords.Sets
 	}
 
 	return conf
@@ -81,6 +109,23 @@ func validateBM25Config(conf *models.BM25Config) error {
 	if conf.B < 0 || conf.B > 1 {
 		return errors.Errorf("BM25.b must be <= 0 and <= 1")
 	}
+	for prop, boost := range conf.PropertyBoosts {
+		if boost <= 0 {
+			return errors.Errorf("BM25.propertyBoosts of property %q must be > 0", prop)
+		}
+	}
+
+	return nil
+}
+
+func validateCompression(compression string) error {
+	if compression == "" {
+		return nil
+	}
+
+	if err := lsmkv.ValidCompression(compression); err != nil {
+		return errors.Wrap(err, "invertedIndexConfig.compression")
+	}
 
 	return nil
 }
@@ -103,6 +148,33 @@ func validateStopwordConfig(conf *models.StopwordConfig) error {
 		return err
 	}
 
+	for _, set := range conf.Sets {
+		if strings.TrimSpace(set) == "" {
+			return errors.Errorf("cannot use whitespace in stopword.sets")
+		}
+	}
+
+	return nil
+}
+
+func validateSynonyms(rules []*models.SynonymRule) error {
+	for i, rule := range rules {
+		if rule == nil || len(rule.Terms) == 0 {
+			return errors.Errorf("synonyms[%d] must have at least one term", i)
+		}
+		if len(rule.Synonyms) == 0 && len(rule.Terms) < 2 {
+			return errors.Errorf("synonyms[%d] must have at least two terms "+
+				"or at least one synonym", i)
+		}
+		for _, words := range [][]string{rule.Terms, rule.Synonyms} {
+			for _, word := range words {
+				if strings.TrimSpace(word) == "" {
+					return errors.Errorf("cannot use whitespace in synonyms[%d]", i)
+				}
+			}
+		}
+	}
+
 	return nil
 }
 
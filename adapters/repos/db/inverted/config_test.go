@@ -45,6 +45,15 @@ func TestValidateConfig(t *testing.T) {
 		assert.EqualError(t, err, "BM25.k1 must be >= 0")
 	})
 
+	t.Run("with timestamp range filters but without timestamps", func(t *testing.T) {
+		in := &models.InvertedIndexConfig{
+			IndexTimestampRangeFilters: true,
+		}
+
+		err := ValidateConfig(in)
+		assert.EqualError(t, err, "indexTimestampRangeFilters requires indexTimestamps to be enabled")
+	})
+
 	t.Run("with invalid BM25.b", func(t *testing.T) {
 		in := &models.InvertedIndexConfig{
 			Bm25: &models.BM25Config{
@@ -57,11 +66,25 @@ func TestValidateConfig(t *testing.T) {
 		assert.EqualError(t, err, "BM25.b must be <= 0 and <= 1")
 	})
 
+	t.Run("with invalid BM25.propertyBoosts", func(t *testing.T) {
+		in := &models.InvertedIndexConfig{
+			Bm25: &models.BM25Config{
+				K1:             1,
+				B:              0.7,
+				PropertyBoosts: map[string]float32{"title": 0},
+			},
+		}
+
+		err := ValidateConfig(in)
+		assert.EqualError(t, err, "BM25.propertyBoosts of property \"title\" must be > 0")
+	})
+
 	t.Run("with valid config", func(t *testing.T) {
 		in := &models.InvertedIndexConfig{
 			Bm25: &models.BM25Config{
-				K1: 1,
-				B:  0.1,
+				K1:             1,
+				B:              0.1,
+				PropertyBoosts: map[string]float32{"title": 2.5},
 			},
 		}
 
@@ -131,6 +154,69 @@ func TestValidateConfig(t *testing.T) {
 			"found 'some' in both stopwords.additions and stopwords.removals")
 	})
 
+	t.Run("with unknown compression", func(t *testing.T) {
+		in := &models.InvertedIndexConfig{
+			Compression: "lz4",
+		}
+
+		err := ValidateConfig(in)
+		assert.EqualError(t, err, "invertedIndexConfig.compression: unrecognized compression \"lz4\"")
+	})
+
+	t.Run("with zstd compression", func(t *testing.T) {
+		in := &models.InvertedIndexConfig{
+			Compression: "zstd",
+		}
+
+		err := ValidateConfig(in)
+		assert.Nil(t, err)
+	})
+
+	t.Run("with invalid synonyms", func(t *testing.T) {
+		tests := []struct {
+			rule          *models.SynonymRule
+			expectedError string
+		}{
+			{
+				rule:          &models.SynonymRule{Synonyms: []string{"car"}},
+				expectedError: "synonyms[0] must have at least one term",
+			},
+			{
+				rule:          &models.SynonymRule{Terms: []string{"car"}},
+				expectedError: "synonyms[0] must have at least two terms or at least one synonym",
+			},
+			{
+				rule:          &models.SynonymRule{Terms: []string{"car", " "}},
+				expectedError: "cannot use whitespace in synonyms[0]",
+			},
+			{
+				rule:          &models.SynonymRule{Terms: []string{"car"}, Synonyms: []string{""}},
+				expectedError: "cannot use whitespace in synonyms[0]",
+			},
+		}
+
+		for _, test := range tests {
+			in := &models.InvertedIndexConfig{
+				Synonyms: []*models.SynonymRule{test.rule},
+			}
+
+			err := ValidateConfig(in)
+			assert.EqualError(t, err, test.expectedError)
+		}
+	})
+
+	t.Run("with valid synonyms", func(t *testing.T) {
+		in := &models.InvertedIndexConfig{
+			Synonyms: []*models.SynonymRule{
+				{Terms: []string{"car", "automobile"}},
+				{Terms: []string{"laptop"}, Synonyms: []string{"notebook"}},
+			},
+		}
+
+		err := ValidateConfig(in)
+		assert.Nil(t, err)
+	})
+
 	t.Run("with additions that exist in preset", func(t *testing.T) {
 		tests := []struct {
 			additions      []string
@@ -172,8 +258,9 @@ func TestConfigFromModel(t *testing.T) {
 
 		in := &models.InvertedIndexConfig{
 			Bm25: &models.BM25Config{
-				K1: float32(k1),
-				B:  float32(b),
+				K1:             float32(k1),
+				B:              float32(b),
+				PropertyBoosts: map[string]float32{"title": 2},
 			},
 			Stopwords: &models.StopwordConfig{
 				Preset: "en",
@@ -182,8 +269,9 @@ func TestConfigFromModel(t *testing.T) {
 
 		expected := schema.InvertedIndexConfig{
 			BM25: schema.BM25Config{
-				K1: k1,
-				B:  b,
+				K1:             k1,
+				B:              b,
+				PropertyBoosts: map[string]float64{"title": 2},
 			},
 			Stopwords: models.StopwordConfig{
 				Preset: "en",
@@ -193,6 +281,7 @@ func TestConfigFromModel(t *testing.T) {
 		conf := ConfigFromModel(in)
 		assert.True(t, almostEqual(t, conf.BM25.K1, expected.BM25.K1))
 		assert.True(t, almostEqual(t, conf.BM25.B, expected.BM25.B))
+		assert.Equal(t, expected.BM25.PropertyBoosts, conf.BM25.PropertyBoosts)
 		assert.Equal(t, expected.Stopwords, conf.Stopwords)
 	})
 
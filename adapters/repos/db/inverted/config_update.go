@@ -36,14 +36,20 @@ func ValidateUserConfigUpdate(initial, updated *models.InvertedIndexConfig) erro
 		return err
 	}
 
+	err = validateSynonymsUpdate(initial, updated)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
 func validateBM25ConfigUpdate(initial, updated *models.InvertedIndexConfig) error {
 	if updated.Bm25 == nil {
 		updated.Bm25 = &models.BM25Config{
-			K1: initial.Bm25.K1,
-			B:  initial.Bm25.B,
+			K1:             initial.Bm25.K1,
+			B:              initial.Bm25.B,
+			PropertyBoosts: initial.Bm25.PropertyBoosts,
 		}
 		return nil
 	}
@@ -65,6 +71,17 @@ func validateInvertedIndexConfigUpdate(initial, updated *models.InvertedIndexCon
 		return errors.New("IndexNullState cannot be changed when updating a schema")
 	}
 
+	if updated.IndexTimestampRangeFilters != initial.IndexTimestampRangeFilters {
+		return errors.New("IndexTimestampRangeFilters cannot be changed when updating a schema")
+	}
+
+	if updated.Compression == "" {
+		updated.Compression = initial.Compression
+	}
+	if updated.Compression != initial.Compression {
+		return errors.New("Compression cannot be changed when updating a schema")
+	}
+
 	return nil
 }
 
@@ -74,6 +91,7 @@ func validateStopwordsConfigUpdate(initial, updated *models.InvertedIndexConfig)
 			Preset:    initial.Stopwords.Preset,
 			Additions: initial.Stopwords.Additions,
 			Removals:  initial.Stopwords.Removals,
+			Sets:      initial.Stopwords.Sets,
 		}
 		return nil
 	}
@@ -85,3 +103,12 @@ func validateStopwordsConfigUpdate(initial, updated *models.InvertedIndexConfig)
 
 	return nil
 }
+
+func validateSynonymsUpdate(initial, updated *models.InvertedIndexConfig) error {
+	if updated.Synonyms == nil {
+		updated.Synonyms = initial.Synonyms
+		return nil
+	}
+
+	return validateSynonyms(updated.Synonyms)
+}
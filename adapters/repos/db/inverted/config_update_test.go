@@ -74,6 +74,24 @@ func TestValidateUserConfigUpdate(t *testing.T) {
 		assert.Equal(t, validInitial.Stopwords.Removals, updated.Stopwords.Removals)
 	})
 
+	t.Run("with valid updated config missing Synonyms", func(t *testing.T) {
+		initial := &models.InvertedIndexConfig{
+			CleanupIntervalSeconds: 1,
+			Bm25:                   validInitial.Bm25,
+			Stopwords:              validInitial.Stopwords,
+			Synonyms: []*models.SynonymRule{
+				{Terms: []string{"car", "automobile"}},
+			},
+		}
+		updated := &models.InvertedIndexConfig{
+			CleanupIntervalSeconds: 2,
+		}
+
+		err := ValidateUserConfigUpdate(initial, updated)
+		require.Nil(t, err)
+		assert.Equal(t, initial.Synonyms, updated.Synonyms)
+	})
+
 	t.Run("with invalid cleanup interval", func(t *testing.T) {
 		updated := &models.InvertedIndexConfig{
 			CleanupIntervalSeconds: -1,
@@ -130,6 +148,15 @@ func TestValidateUserConfigUpdate(t *testing.T) {
 		require.EqualError(t, err, "IndexNullState cannot be changed when updating a schema")
 	})
 
+	t.Run("with invalid updated inverted index timestamp range filters change", func(t *testing.T) {
+		updated := &models.InvertedIndexConfig{
+			IndexTimestampRangeFilters: true,
+		}
+
+		err := ValidateUserConfigUpdate(validInitial, updated)
+		require.EqualError(t, err, "IndexTimestampRangeFilters cannot be changed when updating a schema")
+	})
+
 	t.Run("with invalid updated inverted index property length change", func(t *testing.T) {
 		updated := &models.InvertedIndexConfig{
 			IndexPropertyLength: true,
@@ -49,18 +49,22 @@ func Delta(previous, next []Property) DeltaResults {
 		toAdd, toDelete := countableDelta(prev.Items, nextProp.Items)
 		if len(toAdd) > 0 {
 			out.ToAdd = append(out.ToAdd, Property{
-				Name:               nextProp.Name,
-				Items:              toAdd,
-				HasFilterableIndex: nextProp.HasFilterableIndex,
-				HasSearchableIndex: nextProp.HasSearchableIndex,
+				Name:                 nextProp.Name,
+				Items:                toAdd,
+				HasFilterableIndex:   nextProp.HasFilterableIndex,
+				HasSearchableIndex:   nextProp.HasSearchableIndex,
+				HasSparseVectorIndex: nextProp.HasSparseVectorIndex,
+				HasRangeableIndex:    nextProp.HasRangeableIndex,
 			})
 		}
 		if len(toDelete) > 0 {
 			out.ToDelete = append(out.ToDelete, Property{
-				Name:               nextProp.Name,
-				Items:              toDelete,
-				HasFilterableIndex: nextProp.HasFilterableIndex,
-				HasSearchableIndex: nextProp.HasSearchableIndex,
+				Name:                 nextProp.Name,
+				Items:                toDelete,
+				HasFilterableIndex:   nextProp.HasFilterableIndex,
+				HasSearchableIndex:   nextProp.HasSearchableIndex,
+				HasSparseVectorIndex: nextProp.HasSparseVectorIndex,
+				HasRangeableIndex:    nextProp.HasRangeableIndex,
 			})
 		}
 	}
@@ -82,7 +86,8 @@ func countableDelta(prev, next []Countable) ([]Countable, []Countable) {
 
 	for _, nextItem := range next {
 		prev, ok := seenInPrev[string(nextItem.Data)]
-		if ok && prev.TermFrequency == nextItem.TermFrequency {
+		if ok && prev.TermFrequency == nextItem.TermFrequency &&
+			positionsEqual(prev.Positions, nextItem.Positions) {
 			// we have an identical overlap, delete from old list
 			delete(seenInPrev, string(nextItem.Data))
 			// don't add to new list
@@ -111,7 +116,8 @@ func listsIdentical(a []Countable, b []Countable) bool {
 
 	for i := range a {
 		if !bytes.Equal(a[i].Data, b[i].Data) ||
-			a[i].TermFrequency != b[i].TermFrequency {
+			a[i].TermFrequency != b[i].TermFrequency ||
+			!positionsEqual(a[i].Positions, b[i].Positions) {
 			// return as soon as an item didn't match
 			return false
 		}
@@ -122,3 +128,15 @@ func listsIdentical(a []Countable, b []Countable) bool {
 	// considerably more expensive merge
 	return true
 }
+
+func positionsEqual(a, b []uint32) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,155 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"unicode/utf8"
+
+	"github.com/weaviate/weaviate/entities/filters"
+)
+
+// levenshteinAutomaton selects the keys of a term dictionary which are
+// within a maximum edit distance of a term, i.e. which can be turned into the
+// term by inserting, deleting or substituting at most that many characters,
+// see lsmkv.Automaton.
+//
+// A state is the last row of the edit distance table of the term and the
+// characters of the key read so far, capped at the maximum distance plus one,
+// together with the leading bytes of a multi-byte character that is not
+// complete yet. As for the likeAutomaton the states are created lazily, the
+// capping keeps their number small.
+type levenshteinAutomaton struct {
+	term     []rune
+	distance int
+
+	// the dfa state 0 can not match
+	rows        [][]byte
+	pending     [][]byte
+	ids         map[string]int
+	transitions []map[byte]int
+}
+
+func newLevenshteinAutomaton(term string, distance int) *levenshteinAutomaton {
+	a := &levenshteinAutomaton{
+		term:     []rune(term),
+		distance: distance,
+		ids:      map[string]int{},
+	}
+	a.rows = append(a.rows, nil)
+	a.pending = append(a.pending, nil)
+	a.transitions = append(a.transitions, map[byte]int{})
+	return a
+}
+
+// parseFuzzyAutomaton creates the automaton of a FuzzyMatch value
+func parseFuzzyAutomaton(in []byte) (*levenshteinAutomaton, error) {
+	term, distance, err := filters.ParseFuzzyValue(string(in))
+	if err != nil {
+		return nil, err
+	}
+	return newLevenshteinAutomaton(term, filters.FuzzyDistance(term, distance)), nil
+}
+
+func (a *levenshteinAutomaton) Start() int {
+	row := make([]byte, len(a.term)+1)
+	for i := range row {
+		row[i] = a.capped(i)
+	}
+	return a.intern(row, nil)
+}
+
+func (a *levenshteinAutomaton) IsMatch(state int) bool {
+	return state != 0 && len(a.pending[state]) == 0 &&
+		int(a.rows[state][len(a.term)]) <= a.distance
+}
+
+func (a *levenshteinAutomaton) CanMatch(state int) bool {
+	return state != 0
+}
+
+func (a *levenshteinAutomaton) Accept(state int, b byte) int {
+	if state == 0 {
+		return 0
+	}
+	if next, ok := a.transitions[state][b]; ok {
+		return next
+	}
+
+	row := a.rows[state]
+	pending := append(append([]byte{}, a.pending[state]...), b)
+	for len(pending) > 0 && utf8.FullRune(pending) {
+		r, size := utf8.DecodeRune(pending)
+		row = a.step(row, r)
+		pending = pending[size:]
+	}
+
+	id := a.intern(row, pending)
+	a.transitions[state][b] = id
+	return id
+}
+
+// step computes the next row of the edit distance table for the character r
+func (a *levenshteinAutomaton) step(row []byte, r rune) []byte {
+	next := make([]byte, len(row))
+	next[0] = a.capped(int(row[0]) + 1)
+	for i := 1; i < len(row); i++ {
+		substitution := int(row[i-1])
+		if a.term[i-1] != r {
+			substitution++
+		}
+		insertion, deletion := int(row[i])+1, int(next[i-1])+1
+		distance := substitution
+		if insertion < distance {
+			distance = insertion
+		}
+		if deletion < distance {
+			distance = deletion
+		}
+		next[i] = a.capped(distance)
+	}
+	return next
+}
+
+func (a *levenshteinAutomaton) capped(distance int) byte {
+	if distance > a.distance {
+		return byte(a.distance + 1)
+	}
+	return byte(distance)
+}
+
+// intern returns the dfa state of the row and the pending bytes, rows
+// exceeding the maximum distance in every column can not match anymore
+func (a *levenshteinAutomaton) intern(row, pending []byte) int {
+	dead := true
+	for _, d := range row {
+		if int(d) <= a.distance {
+			dead = false
+			break
+		}
+	}
+	if dead {
+		return 0
+	}
+
+	// the rows all have the same length
+	key := string(row) + string(pending)
+	if id, ok := a.ids[key]; ok {
+		return id
+	}
+
+	id := len(a.rows)
+	a.ids[key] = id
+	a.rows = append(a.rows, row)
+	a.pending = append(a.pending, pending)
+	a.transitions = append(a.transitions, map[byte]int{})
+	return id
+}
@@ -0,0 +1,84 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+)
+
+func TestLevenshteinAutomaton(t *testing.T) {
+	subjects := []string{
+		"", "c", "ca", "car", "cat", "cart", "care", "scar", "arc", "cars",
+		"carpet", "bar", "crab", "cär", "cäär", "c😀r", "😀", "carcar",
+	}
+
+	for _, term := range []string{"car", "cär", "", "c😀", "carpet"} {
+		for distance := 0; distance <= 2; distance++ {
+			t.Run(fmt.Sprintf("term %q distance %d", term, distance), func(t *testing.T) {
+				a := newLevenshteinAutomaton(term, distance)
+				for _, subject := range subjects {
+					expected := levenshteinDistance(term, subject) <= distance
+					assert.Equal(t, expected, lsmkv.AutomatonMatches(a, []byte(subject)),
+						"subject %q", subject)
+				}
+			})
+		}
+	}
+
+	t.Run("parse fuzzy value", func(t *testing.T) {
+		a, err := parseFuzzyAutomaton([]byte("carpet~1"))
+		require.Nil(t, err)
+		assert.Equal(t, "carpet", string(a.term))
+		assert.Equal(t, 1, a.distance)
+
+		a, err = parseFuzzyAutomaton([]byte("car"))
+		require.Nil(t, err)
+		assert.Equal(t, 1, a.distance)
+
+		_, err = parseFuzzyAutomaton([]byte("car~3"))
+		assert.NotNil(t, err)
+	})
+}
+
+// levenshteinDistance is the textbook computation of the edit distance of
+// two strings of characters
+func levenshteinDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		curr := make([]int, len(rb)+1)
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = prev[j] + 1
+			if curr[j-1]+1 < curr[j] {
+				curr[j] = curr[j-1] + 1
+			}
+			if prev[j-1]+cost < curr[j] {
+				curr[j] = prev[j-1] + cost
+			}
+		}
+		prev = curr
+	}
+	return prev[len(rb)]
+}
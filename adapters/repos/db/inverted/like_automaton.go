@@ -0,0 +1,159 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"bytes"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// likeAutomaton selects the keys of a term dictionary which can match a
+// 'like' value, see lsmkv.Automaton. It accepts a superset of the keys the
+// regexp matches, e.g. '*' also matches line breaks and '?' a single byte of
+// a multi-byte character, so the keys still have to be checked against the
+// regexp.
+//
+// The pattern is run as a nondeterministic automaton whose state is the
+// position in the pattern, with the remaining continuation bytes of a
+// multi-byte character matched by '?'. The sets of states are determinized
+// lazily, as only the states reached by the keys of the dictionary are
+// needed.
+type likeAutomaton struct {
+	pattern []byte
+
+	// the nfa states of every dfa state, the dfa state 0 can not match
+	sets        [][]int
+	ids         map[string]int
+	transitions []map[byte]int
+}
+
+// newLikeAutomaton returns false if the value contains regexp syntax other
+// than the wildcards, as it is then matched as a regexp
+func newLikeAutomaton(in []byte) (*likeAutomaton, bool) {
+	if bytes.ContainsAny(in, `\.+()|[]{}^$`) {
+		return nil, false
+	}
+
+	a := &likeAutomaton{
+		pattern: in,
+		ids:     map[string]int{},
+	}
+	a.intern(nil)
+	return a, true
+}
+
+// the nfa state is the position in the pattern, followed by the remaining
+// continuation bytes
+func likeState(pos, continuations int) int {
+	return pos<<2 | continuations
+}
+
+func (a *likeAutomaton) Start() int {
+	return a.intern([]int{likeState(0, 0)})
+}
+
+func (a *likeAutomaton) IsMatch(state int) bool {
+	for _, s := range a.sets[state] {
+		if s == likeState(len(a.pattern), 0) {
+			return true
+		}
+	}
+	return false
+}
+
+func (a *likeAutomaton) CanMatch(state int) bool {
+	return state != 0
+}
+
+func (a *likeAutomaton) Accept(state int, b byte) int {
+	if next, ok := a.transitions[state][b]; ok {
+		return next
+	}
+
+	var next []int
+	for _, s := range a.sets[state] {
+		pos, continuations := s>>2, s&3
+		if continuations > 0 {
+			if b&0xC0 == 0x80 {
+				if continuations == 1 {
+					next = append(next, likeState(pos+1, 0))
+				} else {
+					next = append(next, likeState(pos, continuations-1))
+				}
+			}
+			continue
+		}
+		if pos == len(a.pattern) {
+			continue
+		}
+
+		switch a.pattern[pos] {
+		case '*':
+			next = append(next, s)
+		case '?':
+			// a single character, which may span up to 4 bytes
+			next = append(next, likeState(pos+1, 0))
+			switch {
+			case b >= 0xF0:
+				next = append(next, likeState(pos, 3))
+			case b >= 0xE0:
+				next = append(next, likeState(pos, 2))
+			case b >= 0xC0:
+				next = append(next, likeState(pos, 1))
+			}
+		default:
+			if a.pattern[pos] == b {
+				next = append(next, likeState(pos+1, 0))
+			}
+		}
+	}
+
+	id := a.intern(next)
+	a.transitions[state][b] = id
+	return id
+}
+
+// intern returns the dfa state of the nfa states
+func (a *likeAutomaton) intern(states []int) int {
+	// a '*' may match nothing at all
+	for i := 0; i < len(states); i++ {
+		pos, continuations := states[i]>>2, states[i]&3
+		if continuations == 0 && pos < len(a.pattern) && a.pattern[pos] == '*' {
+			states = append(states, likeState(pos+1, 0))
+		}
+	}
+
+	sort.Ints(states)
+	unique := states[:0]
+	for i, s := range states {
+		if i == 0 || s != states[i-1] {
+			unique = append(unique, s)
+		}
+	}
+
+	var key strings.Builder
+	for _, s := range unique {
+		key.WriteString(strconv.Itoa(s))
+		key.WriteByte(',')
+	}
+	if id, ok := a.ids[key.String()]; ok {
+		return id
+	}
+
+	id := len(a.sets)
+	a.ids[key.String()] = id
+	a.sets = append(a.sets, unique)
+	a.transitions = append(a.transitions, map[byte]int{})
+	return id
+}
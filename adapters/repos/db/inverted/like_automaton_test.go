@@ -0,0 +1,75 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"fmt"
+	"testing"
+	"unicode"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLikeAutomaton(t *testing.T) {
+	accepts := func(a *likeAutomaton, subject []byte) bool {
+		state := a.Start()
+		for _, b := range subject {
+			if state = a.Accept(state, b); !a.CanMatch(state) {
+				return false
+			}
+		}
+		return a.IsMatch(state)
+	}
+
+	subjects := []string{
+		"", "c", "car", "care", "cars", "supercar", "scar", "cat", "caar",
+		"cär", "cä", "c€r", "c😀r", "ca😀", "😀", "car car", "racecar",
+	}
+
+	for _, pattern := range []string{
+		"car", "car?", "?ar", "c?r", "car*", "*car", "*car*", "c*r", "c*?",
+		"??", "*", "**", "?*?", "c??", "ca*r*",
+	} {
+		t.Run(fmt.Sprintf("pattern %q", pattern), func(t *testing.T) {
+			like, err := parseLikeRegexp([]byte(pattern))
+			require.Nil(t, err)
+			require.NotNil(t, like.automaton)
+
+			for _, subject := range subjects {
+				if like.regexp.MatchString(subject) {
+					assert.True(t, accepts(like.automaton, []byte(subject)), "subject %q", subject)
+				} else if isASCII(subject) {
+					// non-ascii subjects may be accepted nonetheless, as the keys are
+					// checked against the regexp anyway
+					assert.False(t, accepts(like.automaton, []byte(subject)), "subject %q", subject)
+				}
+			}
+		})
+	}
+
+	t.Run("values with regexp syntax are not supported", func(t *testing.T) {
+		for _, pattern := range []string{"c.r", "ca+", "(car)", "c[a]r", `c\?`, "^car$"} {
+			_, ok := newLikeAutomaton([]byte(pattern))
+			assert.False(t, ok, pattern)
+		}
+	})
+}
+
+func isASCII(in string) bool {
+	for _, r := range in {
+		if r > unicode.MaxASCII {
+			return false
+		}
+	}
+	return true
+}
@@ -13,15 +13,20 @@ package inverted
 
 import (
 	"bytes"
+	"context"
 	"regexp"
 
 	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
 )
 
 type likeRegexp struct {
 	optimizable bool
 	min         []byte
 	regexp      *regexp.Regexp
+
+	// nil if the value can not be looked up in a term dictionary
+	automaton *likeAutomaton
 }
 
 func parseLikeRegexp(in []byte) (*likeRegexp, error) {
@@ -31,13 +36,48 @@ func parseLikeRegexp(in []byte) (*likeRegexp, error) {
 	}
 
 	min, ok := optimizable(in)
+	automaton, _ := newLikeAutomaton(in)
 	return &likeRegexp{
 		regexp:      r,
 		min:         min,
 		optimizable: ok,
+		automaton:   automaton,
 	}, nil
 }
 
+// readMatchingKeys looks up the keys accepted by the automaton in the term
+// dictionary of a bucket, which unlike a cursor visits only the keys that
+// can match, and calls readFn with each of them. If the automaton accepts a
+// superset of the keys, match selects the actual ones.
+func readMatchingKeys(ctx context.Context,
+	matchingKeys func(lsmkv.Automaton) ([][]byte, error),
+	automaton lsmkv.Automaton, match func(k []byte) bool,
+	readFn func(k []byte) (bool, error),
+) error {
+	keys, err := matchingKeys(automaton)
+	if err != nil {
+		return errors.Wrap(err, "look up keys in term dictionary")
+	}
+
+	for _, k := range keys {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		if match != nil && !match(k) {
+			continue
+		}
+
+		if continueReading, err := readFn(k); err != nil {
+			return err
+		} else if !continueReading {
+			break
+		}
+	}
+
+	return nil
+}
+
 func transformLikeStringToRegexp(in []byte) string {
 	in = bytes.ReplaceAll(in, []byte("?"), []byte("."))
 	in = bytes.ReplaceAll(in, []byte("*"), []byte(".*"))
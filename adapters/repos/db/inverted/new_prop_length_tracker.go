@@ -208,6 +208,44 @@ func (t *JsonPropertyLengthTracker) UnTrackProperty(propName string, value float
 	return nil
 }
 
+// Moves all tracked values of a property to a new name, existing values of
+// the new name are replaced
+func (t *JsonPropertyLengthTracker) RenameProperty(propName, newName string) {
+	t.Lock()
+	defer t.Unlock()
+
+	if t.data == nil || propName == newName {
+		return
+	}
+
+	if buckets, ok := t.data.BucketedData[propName]; ok {
+		t.data.BucketedData[newName] = buckets
+		delete(t.data.BucketedData, propName)
+	}
+	if sum, ok := t.data.SumData[propName]; ok {
+		t.data.SumData[newName] = sum
+		delete(t.data.SumData, propName)
+	}
+	if count, ok := t.data.CountData[propName]; ok {
+		t.data.CountData[newName] = count
+		delete(t.data.CountData, propName)
+	}
+}
+
+// Removes all tracked values of a property
+func (t *JsonPropertyLengthTracker) DeleteProperty(propName string) {
+	t.Lock()
+	defer t.Unlock()
+
+	if t.data == nil {
+		return
+	}
+
+	delete(t.data.BucketedData, propName)
+	delete(t.data.SumData, propName)
+	delete(t.data.CountData, propName)
+}
+
 // Returns the bucket that the given value belongs to
 func (t *JsonPropertyLengthTracker) bucketFromValue(value float32) int {
 	if t.UnlimitedBuckets {
@@ -68,7 +68,14 @@ func (a *Analyzer) analyzeProps(propsMap map[string]*models.Property,
 			return nil, fmt.Errorf("prop %q has no datatype", prop.Name)
 		}
 
-		if !HasInvertedIndex(prop) {
+		if HasSparseVectorIndex(prop) {
+			if err := a.extendPropertiesWithSparseVector(&out, prop, input, key); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		if !HasInvertedIndex(prop) && !HasRangeableIndex(prop) {
 			continue
 		}
 
@@ -76,6 +83,18 @@ func (a *Analyzer) analyzeProps(propsMap map[string]*models.Property,
 			continue
 		}
 
+		if schema.IsVectorDataType(prop.DataType) {
+			// raw vectors are not meaningful as filter or search terms
+			continue
+		}
+
+		if schema.IsNestedDataType(prop.DataType) {
+			if err := a.extendPropertiesWithNested(&out, prop, input, key); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
 		if schema.IsRefDataType(prop.DataType) {
 			if err := a.extendPropertiesWithReference(&out, prop, input, key); err != nil {
 				return nil, err
@@ -145,6 +164,32 @@ func (a *Analyzer) analyzeTimestampProps(input map[string]any) ([]Property, erro
 	return props, nil
 }
 
+// TimestampRangeableProps returns the props of the internal timestamps which
+// are added to their range indexes. Unlike the filterable index, which holds
+// the timestamps as strings, the range index requires sortable values.
+func (a *Analyzer) TimestampRangeableProps(createTime, updateTime int64) ([]Property, error) {
+	props := make([]Property, 0, 2)
+	for _, ts := range []struct {
+		name  string
+		value int64
+	}{
+		{filters.InternalPropCreationTimeUnix, createTime},
+		{filters.InternalPropLastUpdateTimeUnix, updateTime},
+	} {
+		items, err := a.Int(ts.value)
+		if err != nil {
+			return nil, fmt.Errorf("analyze %s prop: %w", ts.name, err)
+		}
+		props = append(props, Property{
+			Name:              ts.name,
+			Items:             items,
+			HasRangeableIndex: true,
+		})
+	}
+
+	return props, nil
+}
+
 func (a *Analyzer) extendPropertiesWithArrayType(properties *[]Property,
 	prop *models.Property, input map[string]any, propName string,
 ) error {
@@ -178,6 +223,31 @@ func (a *Analyzer) extendPropertiesWithArrayType(properties *[]Property,
 	return nil
 }
 
+// extendPropertiesWithNested mutates the passed in properties, by extending
+// it with the leaves of a nested property, see schema.NestedLeafProperties
+func (a *Analyzer) extendPropertiesWithNested(properties *[]Property,
+	prop *models.Property, input map[string]any, propName string,
+) error {
+	value, ok := input[propName]
+	if !ok {
+		// skip any nested prop that's not set
+		return nil
+	}
+
+	leaves := map[string]*models.Property{}
+	for _, leaf := range schema.NestedLeafProperties(prop) {
+		leaves[leaf.Name] = leaf
+	}
+
+	nested, err := a.analyzeProps(leaves, schema.FlattenNestedValue(prop, value))
+	if err != nil {
+		return fmt.Errorf("analyze nested prop %s: %w", prop.Name, err)
+	}
+
+	*properties = append(*properties, nested...)
+	return nil
+}
+
 // extendPropertiesWithPrimitive mutates the passed in properties, by extending
 // it with an additional property - if applicable
 func (a *Analyzer) extendPropertiesWithPrimitive(properties *[]Property,
@@ -203,6 +273,29 @@ func (a *Analyzer) extendPropertiesWithPrimitive(properties *[]Property,
 	return nil
 }
 
+func (a *Analyzer) extendPropertiesWithSparseVector(properties *[]Property,
+	prop *models.Property, input map[string]any, propName string,
+) error {
+	value, ok := input[propName]
+	if !ok {
+		// skip any sparse vector that's not set
+		return nil
+	}
+
+	vector, err := schema.ParseSparseVector(value)
+	if err != nil {
+		return fmt.Errorf("analyze property %s: %w", prop.Name, err)
+	}
+
+	*properties = append(*properties, Property{
+		Name:                 prop.Name,
+		Items:                a.SparseVector(vector),
+		Length:               -1,
+		HasSparseVectorIndex: true,
+	})
+	return nil
+}
+
 func (a *Analyzer) analyzeArrayProp(prop *models.Property, values []any) (*Property, error) {
 	var items []Countable
 	hasFilterableIndex := HasFilterableIndex(prop)
@@ -215,7 +308,11 @@ func (a *Analyzer) analyzeArrayProp(prop *models.Property, values []any) (*Prope
 		if err != nil {
 			return nil, err
 		}
-		items = a.TextArray(prop.Tokenization, in)
+		if HasPositionsIndex(prop) {
+			items = a.TextArrayPositions(prop.Tokenization, in)
+		} else {
+			items = a.TextArray(prop.Tokenization, in)
+		}
 	case schema.DataTypeIntArray:
 		in := make([]int64, len(values))
 		for i, value := range values {
@@ -355,7 +452,11 @@ func (a *Analyzer) analyzePrimitiveProp(prop *models.Property, value any) (*Prop
 		if !ok {
 			return nil, fmt.Errorf("expected property %s to be of type string, but got %T", prop.Name, value)
 		}
-		items = a.Text(prop.Tokenization, asString)
+		if HasPositionsIndex(prop) {
+			items = a.TextPositions(prop.Tokenization, asString)
+		} else {
+			items = a.Text(prop.Tokenization, asString)
+		}
 		propertyLength = utf8.RuneCountInString(asString)
 	case schema.DataTypeInt:
 		if asFloat, ok := value.(float64); ok {
@@ -419,6 +520,69 @@ func (a *Analyzer) analyzePrimitiveProp(prop *models.Property, value any) (*Prop
 		if err != nil {
 			return nil, fmt.Errorf("analyze property %s: %w", prop.Name, err)
 		}
+	case schema.DataTypeDuration:
+		asString, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("expected property %s to be of type string, but got %T", prop.Name, value)
+		}
+		asDuration, err := schema.ParseDuration(asString)
+		if err != nil {
+			return nil, fmt.Errorf("analyze property %s: %w", prop.Name, err)
+		}
+
+		// indexed like an int, so the index can be filtered by range
+		items, err = a.Int(int64(asDuration))
+		if err != nil {
+			return nil, fmt.Errorf("analyze property %s: %w", prop.Name, err)
+		}
+	case schema.DataTypeDecimal:
+		asString, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("expected property %s to be of type string, but got %T", prop.Name, value)
+		}
+		asDecimal, err := schema.ParseDecimal(asString)
+		if err != nil {
+			return nil, fmt.Errorf("analyze property %s: %w", prop.Name, err)
+		}
+
+		items, err = a.Decimal(asDecimal)
+		if err != nil {
+			return nil, fmt.Errorf("analyze property %s: %w", prop.Name, err)
+		}
+	case schema.DataTypeIP:
+		asString, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("expected property %s to be of type string, but got %T", prop.Name, value)
+		}
+		asAddr, err := schema.ParseIP(asString)
+		if err != nil {
+			return nil, fmt.Errorf("analyze property %s: %w", prop.Name, err)
+		}
+
+		items, err = a.IP(asAddr)
+		if err != nil {
+			return nil, fmt.Errorf("analyze property %s: %w", prop.Name, err)
+		}
+	case schema.DataTypeGeoPolygon, schema.DataTypeGeoShape:
+		shape, err := schema.ParseGeoShape(dt, value)
+		if err != nil {
+			return nil, fmt.Errorf("analyze property %s: %w", prop.Name, err)
+		}
+
+		items, err = a.GeoShape(shape)
+		if err != nil {
+			return nil, fmt.Errorf("analyze property %s: %w", prop.Name, err)
+		}
+	case schema.DataTypePhoneNumber:
+		phone, err := schema.ParsePhoneNumber(value)
+		if err != nil {
+			return nil, fmt.Errorf("analyze property %s: %w", prop.Name, err)
+		}
+
+		items, err = a.PhoneNumber(phone)
+		if err != nil {
+			return nil, fmt.Errorf("analyze property %s: %w", prop.Name, err)
+		}
 	case schema.DataTypeUUID:
 		var err error
 
@@ -450,13 +614,14 @@ func (a *Analyzer) analyzePrimitiveProp(prop *models.Property, value any) (*Prop
 		Length:             propertyLength,
 		HasFilterableIndex: hasFilterableIndex,
 		HasSearchableIndex: hasSearchableIndex,
+		HasRangeableIndex:  HasRangeableIndex(prop),
 	}, nil
 }
 
 // extendPropertiesWithReference extends the specified properties arrays with
-// either 1 or 2 entries: If the ref is not set, only the ref-count property
-// will be added. If the ref is set the ref-prop itself will also be added and
-// contain all references as values
+// 2 entries: the ref-count property and the ref-prop itself, which contains
+// all references as values. The ref-prop is added even if the ref is not set,
+// so that its length (the number of references) is indexed as 0
 func (a *Analyzer) extendPropertiesWithReference(properties *[]Property,
 	prop *models.Property, input map[string]any, propName string,
 ) error {
@@ -473,13 +638,13 @@ func (a *Analyzer) extendPropertiesWithReference(properties *[]Property,
 		// MultipleRef's can appear as empty []any when no actual refs are provided for
 		// an object's reference property.
 		//
-		// if we encounter []any, assume it indicates an empty ref prop, and skip it.
+		// if we encounter []any, assume it indicates an empty ref prop.
 		_, ok := value.([]any)
 		if !ok {
 			return fmt.Errorf("expected property %q to be of type models.MutlipleRef,"+
 				" but got %T", prop.Name, value)
 		}
-		return nil
+		asRefs = make(models.MultipleRef, 0)
 	}
 
 	property, err := a.analyzeRefPropCount(prop, asRefs)
@@ -489,10 +654,6 @@ func (a *Analyzer) extendPropertiesWithReference(properties *[]Property,
 
 	*properties = append(*properties, *property)
 
-	if len(asRefs) == 0 {
-		return nil
-	}
-
 	property, err = a.analyzeRefProp(prop, asRefs)
 	if err != nil {
 		return fmt.Errorf("refs: %w", err)
@@ -530,6 +691,7 @@ func (a *Analyzer) analyzeRefProp(prop *models.Property,
 	return &Property{
 		Name:               prop.Name,
 		Items:              items,
+		Length:             len(value),
 		HasFilterableIndex: HasFilterableIndex(prop),
 		HasSearchableIndex: HasSearchableIndex(prop),
 	}, nil
@@ -582,6 +744,12 @@ func HasSearchableIndex(prop *models.Property) bool {
 	}
 }
 
+// Indicates whether the searchable index of the property also holds the
+// positions of the terms, which phrase and proximity queries require
+func HasPositionsIndex(prop *models.Property) bool {
+	return HasSearchableIndex(prop) && prop.IndexPositions != nil && *prop.IndexPositions
+}
+
 // Indicates whether property should be indexed
 // Index holds document ids with property of/containing particular value
 // (index created using bucket of StrategyRoaringSet)
@@ -593,6 +761,26 @@ func HasFilterableIndex(prop *models.Property) bool {
 	return *prop.IndexFilterable
 }
 
+// HasSparseVectorIndex is true for sparseVector props, which are always
+// indexed in a sparse vector index instead of the filterable or searchable one
+func HasSparseVectorIndex(prop *models.Property) bool {
+	return schema.IsSparseVectorDataType(prop.DataType)
+}
+
+// HasRangeableIndex is true for int, number and date props which are indexed
+// in a range index in addition to (or instead of) the filterable one
+func HasRangeableIndex(prop *models.Property) bool {
+	if prop.IndexRangeFilters == nil || !*prop.IndexRangeFilters {
+		return false
+	}
+	switch dt, _ := schema.AsPrimitive(prop.DataType); dt {
+	case schema.DataTypeInt, schema.DataTypeNumber, schema.DataTypeDate:
+		return true
+	default:
+		return false
+	}
+}
+
 func HasInvertedIndex(prop *models.Property) bool {
 	return HasFilterableIndex(prop) || HasSearchableIndex(prop)
 }
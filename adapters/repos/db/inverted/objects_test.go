@@ -534,6 +534,7 @@ func TestAnalyzeObject(t *testing.T) {
 			var actualRefCount []Countable
 			var actualUUID []Countable
 			var actualRef []Countable
+			var actualRefLength int
 
 			for _, elem := range res {
 				switch elem.Name {
@@ -543,12 +544,14 @@ func TestAnalyzeObject(t *testing.T) {
 					actualUUID = elem.Items
 				case "myRef":
 					actualRef = elem.Items
+					actualRefLength = elem.Length
 				}
 			}
 
 			assert.ElementsMatch(t, expectedRefCount, actualRefCount, res)
 			assert.ElementsMatch(t, expectedUUID, actualUUID, res)
 			assert.ElementsMatch(t, expectedRef, actualRef, res)
+			assert.Equal(t, 2, actualRefLength)
 		})
 
 		t.Run("with the ref omitted in the object schema", func(t *testing.T) {
@@ -575,21 +578,27 @@ func TestAnalyzeObject(t *testing.T) {
 				},
 			}
 
-			require.Len(t, res, 2)
+			require.Len(t, res, 3)
 			var actualRefCount []Countable
 			var actualUUID []Countable
+			var actualRef *Property
 
-			for _, elem := range res {
-				if elem.Name == helpers.MetaCountProp("myRef") {
+			for i, elem := range res {
+				switch elem.Name {
+				case helpers.MetaCountProp("myRef"):
 					actualRefCount = elem.Items
-				}
-				if elem.Name == "_id" {
+				case "_id":
 					actualUUID = elem.Items
+				case "myRef":
+					actualRef = &res[i]
 				}
 			}
 
 			assert.ElementsMatch(t, expectedRefCount, actualRefCount, res)
 			assert.ElementsMatch(t, expectedUUID, actualUUID, res)
+			require.NotNil(t, actualRef)
+			assert.Empty(t, actualRef.Items)
+			assert.Equal(t, 0, actualRef.Length)
 		})
 
 		// due to the fix introduced in https://github.com/weaviate/weaviate/pull/2320,
@@ -634,21 +643,27 @@ func TestAnalyzeObject(t *testing.T) {
 				},
 			}
 
-			require.Len(t, res, 2)
+			require.Len(t, res, 4)
 			var actualUUID []Countable
 			var actualName []Countable
+			var actualRef *Property
 
-			for _, elem := range res {
+			for i, elem := range res {
 				switch elem.Name {
 				case "_id":
 					actualUUID = elem.Items
 				case "name":
 					actualName = elem.Items
+				case "reference":
+					actualRef = &res[i]
 				}
 			}
 
 			assert.ElementsMatch(t, expectedUUID, actualUUID, res)
 			assert.ElementsMatch(t, expectedName, actualName, res)
+			require.NotNil(t, actualRef)
+			assert.Empty(t, actualRef.Items)
+			assert.Equal(t, 0, actualRef.Length)
 		})
 	})
 
@@ -713,6 +728,80 @@ func TestAnalyzeObject(t *testing.T) {
 			assert.ElementsMatch(t, expected[i].Items, res[i].Items)
 		}
 	})
+
+	t.Run("with nested properties", func(t *testing.T) {
+		sch := map[string]interface{}{
+			"address": map[string]interface{}{
+				"city":  "Amsterdam",
+				"zip":   float64(1011),
+				"notes": "not indexed",
+			},
+			"visits": []interface{}{
+				map[string]interface{}{"city": "Berlin", "tags": []string{"work"}},
+				map[string]interface{}{"city": "Paris", "tags": []string{"holiday"}},
+			},
+		}
+
+		uuid := strfmt.UUID("2609f1bc-7693-48f3-b531-6ddc52cd2501")
+		vFalse := false
+		props := []*models.Property{
+			{
+				Name:     "address",
+				DataType: schema.DataTypeObject.PropString(),
+				NestedProperties: []*models.NestedProperty{
+					{Name: "city", DataType: schema.DataTypeText.PropString(), Tokenization: models.PropertyTokenizationField},
+					{Name: "zip", DataType: schema.DataTypeInt.PropString()},
+					{Name: "position", DataType: schema.DataTypeGeoCoordinates.PropString()},
+				},
+			},
+			{
+				Name:     "visits",
+				DataType: schema.DataTypeObjectArray.PropString(),
+				NestedProperties: []*models.NestedProperty{
+					{Name: "city", DataType: schema.DataTypeText.PropString(), Tokenization: models.PropertyTokenizationField},
+					{Name: "tags", DataType: schema.DataTypeTextArray.PropString(), Tokenization: models.PropertyTokenizationField},
+				},
+			},
+			{
+				Name:            "unindexed",
+				DataType:        schema.DataTypeObject.PropString(),
+				IndexFilterable: &vFalse,
+				NestedProperties: []*models.NestedProperty{
+					{Name: "city", DataType: schema.DataTypeText.PropString()},
+				},
+			},
+		}
+
+		res, err := a.Object(sch, props, uuid)
+		require.Nil(t, err)
+
+		byName := map[string]Property{}
+		for _, prop := range res {
+			byName[prop.Name] = prop
+		}
+		require.Len(t, byName, 5)
+
+		zip, err := a.Int(1011)
+		require.Nil(t, err)
+
+		assert.ElementsMatch(t, []Countable{{Data: []byte("Amsterdam"), TermFrequency: 1}},
+			byName["address.city"].Items)
+		assert.ElementsMatch(t, zip, byName["address.zip"].Items)
+		assert.ElementsMatch(t, []Countable{
+			{Data: []byte("Berlin"), TermFrequency: 1},
+			{Data: []byte("Paris"), TermFrequency: 1},
+		}, byName["visits.city"].Items)
+		assert.ElementsMatch(t, []Countable{
+			{Data: []byte("work"), TermFrequency: 1},
+			{Data: []byte("holiday"), TermFrequency: 1},
+		}, byName["visits.tags"].Items)
+		for _, name := range []string{"address.city", "address.zip", "visits.city", "visits.tags"} {
+			assert.True(t, byName[name].HasFilterableIndex, name)
+			assert.False(t, byName[name].HasSearchableIndex, name)
+		}
+		assert.NotContains(t, byName, "address")
+		assert.NotContains(t, byName, "unindexed.city")
+	})
 }
 
 func TestConvertSliceToUntyped(t *testing.T) {
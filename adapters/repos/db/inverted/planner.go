@@ -0,0 +1,201 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// estimateCardinality estimates the number of docs matched by the prop value
+// pair from the stats of the roaring set buckets, without reading any bitmap.
+// It returns false if the number can't be estimated, e.g. because the filter
+// is not served by a roaring set bucket.
+func (pv *propValuePair) estimateCardinality(s *Searcher) (int, bool) {
+	if !pv.operator.OnValue() {
+		return pv.estimateChildrenCardinality(s)
+	}
+
+	if pv.primaryKeys != nil {
+		// every uuid matches at most one object
+		return len(pv.primaryKeys), true
+	}
+
+	if pv.refJoin != nil || pv.valueGeoRange != nil || pv.valueGeoPolygon != nil ||
+		pv.servedByRangeableIndex() || !pv.hasFilterableIndex {
+		return 0, false
+	}
+
+	b := s.store.Bucket(helpers.BucketFromPropNameLSM(pv.prop))
+	if b == nil || b.Strategy() != lsmkv.StrategyRoaringSet {
+		return 0, false
+	}
+
+	estimate := func(from, to []byte) (int, bool) {
+		n, err := b.RoaringSetEstimateCardinality(from, to)
+		return n, err == nil
+	}
+
+	switch pv.operator {
+	case filters.OperatorEqual, filters.OperatorIsNull:
+		return estimate(pv.value, pv.value)
+	case filters.OperatorNotEqual:
+		all, ok := estimate(nil, nil)
+		if !ok {
+			return 0, false
+		}
+		equal, ok := estimate(pv.value, pv.value)
+		return all - equal, ok
+	case filters.OperatorGreaterThan, filters.OperatorGreaterThanEqual:
+		return estimate(pv.value, nil)
+	case filters.OperatorLessThan, filters.OperatorLessThanEqual:
+		return estimate(nil, pv.value)
+	default:
+		return 0, false
+	}
+}
+
+// estimateChildrenCardinality estimates an AND by its most selective child,
+// an OR by the sum of its children and a NOT by the docs its child does not
+// match
+func (pv *propValuePair) estimateChildrenCardinality(s *Searcher) (int, bool) {
+	if pv.operator == filters.OperatorNot {
+		if len(pv.children) != 1 {
+			return 0, false
+		}
+		n, ok := pv.children[0].estimateCardinality(s)
+		if !ok {
+			return 0, false
+		}
+		b := s.store.Bucket(helpers.BucketFromPropNameLSM(filters.InternalPropID))
+		if b == nil || b.Strategy() != lsmkv.StrategyRoaringSet {
+			return 0, false
+		}
+		all, err := b.RoaringSetEstimateCardinality(nil, nil)
+		if err != nil {
+			return 0, false
+		}
+		if n > all {
+			return 0, true
+		}
+		return all - n, true
+	}
+
+	out, known := 0, false
+	for _, child := range pv.children {
+		n, ok := child.estimateCardinality(s)
+		switch pv.operator {
+		case filters.OperatorAnd:
+			if ok && (!known || n < out) {
+				out, known = n, true
+			}
+		case filters.OperatorOr:
+			if !ok {
+				return 0, false
+			}
+			out, known = out+n, true
+		default:
+			return 0, false
+		}
+	}
+	return out, known
+}
+
+// planChildren orders the children of an AND or OR by their estimated
+// cardinality. The children of an AND are ordered from the most selective to
+// the least selective one, children which can't be estimated come last. The
+// children of an OR are ordered the other way round, so the smaller bitmaps
+// are merged into the largest one. It returns whether the first child could
+// be estimated.
+func (pv *propValuePair) planChildren(s *Searcher) bool {
+	type estimated struct {
+		child *propValuePair
+		n     int
+		ok    bool
+	}
+
+	children := make([]estimated, len(pv.children))
+	for i, child := range pv.children {
+		n, ok := child.estimateCardinality(s)
+		children[i] = estimated{child: child, n: n, ok: ok}
+	}
+
+	sort.SliceStable(children, func(i, j int) bool {
+		a, b := children[i], children[j]
+		if a.ok != b.ok {
+			return a.ok
+		}
+		if pv.operator == filters.OperatorOr {
+			return a.n > b.n
+		}
+		return a.n < b.n
+	})
+
+	for i := range children {
+		pv.children[i] = children[i].child
+	}
+	return len(children) > 0 && children[0].ok
+}
+
+// EstimateSelectivity estimates the share of all objects matched by the
+// filter, between 0 and 1. It returns false if the filter can't be estimated.
+// Filters on the props of referenced objects are never estimated, as that
+// would require to search the referenced class.
+func (s *Searcher) EstimateSelectivity(filter *filters.LocalFilter,
+	className schema.ClassName,
+) (float64, bool, error) {
+	if filter == nil || filter.Root == nil || onReferencedProps(filter.Root) {
+		return 0, false, nil
+	}
+
+	objects := s.store.Bucket(helpers.ObjectsBucketLSM)
+	if objects == nil {
+		return 0, false, errors.Errorf("objects bucket not found")
+	}
+	total := objects.Count()
+	if total <= 0 {
+		return 0, false, nil
+	}
+
+	pv, err := s.extractPropValuePair(filter.Root, className)
+	if err != nil {
+		return 0, false, err
+	}
+
+	n, ok := pv.estimateCardinality(s)
+	if !ok {
+		return 0, false, nil
+	}
+
+	selectivity := float64(n) / float64(total)
+	if selectivity > 1 {
+		selectivity = 1
+	}
+	return selectivity, true, nil
+}
+
+func onReferencedProps(clause *filters.Clause) bool {
+	if clause.On != nil && clause.On.Child != nil {
+		return true
+	}
+	for i := range clause.Operands {
+		if onReferencedProps(&clause.Operands[i]) {
+			return true
+		}
+	}
+	return false
+}
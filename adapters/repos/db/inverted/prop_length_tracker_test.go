@@ -287,6 +287,46 @@ func Test_PropertyLengthTracker_Persistence(t *testing.T) {
 	})
 }
 
+func Test_PropertyLengthTracker_RenameProperty(t *testing.T) {
+	tracker, err := NewJsonPropertyLengthTracker(path.Join(t.TempDir(), "my_test_shard"), logrus.New())
+	require.Nil(t, err)
+
+	require.Nil(t, tracker.TrackProperty("title", 2))
+	require.Nil(t, tracker.TrackProperty("title", 4))
+	tracker.RenameProperty("title", "headline")
+
+	mean, err := tracker.PropertyMean("title")
+	require.Nil(t, err)
+	assert.Equal(t, float32(0), mean)
+
+	mean, err = tracker.PropertyMean("headline")
+	require.Nil(t, err)
+	assert.Equal(t, float32(3), mean)
+
+	sum, count, _, err := tracker.PropertyTally("headline")
+	require.Nil(t, err)
+	assert.Equal(t, 6, sum)
+	assert.Equal(t, 2, count)
+}
+
+func Test_PropertyLengthTracker_DeleteProperty(t *testing.T) {
+	tracker, err := NewJsonPropertyLengthTracker(path.Join(t.TempDir(), "my_test_shard"), logrus.New())
+	require.Nil(t, err)
+
+	require.Nil(t, tracker.TrackProperty("title", 2))
+	require.Nil(t, tracker.TrackProperty("description", 4))
+	tracker.DeleteProperty("title")
+
+	sum, count, _, err := tracker.PropertyTally("title")
+	require.Nil(t, err)
+	assert.Equal(t, 0, sum)
+	assert.Equal(t, 0, count)
+
+	mean, err := tracker.PropertyMean("description")
+	require.Nil(t, err)
+	assert.Equal(t, float32(4), mean)
+}
+
 // Testing the switch from the old property length tracker to the new one
 func TestFormatConversion(t *testing.T) {
 	dirName := t.TempDir()
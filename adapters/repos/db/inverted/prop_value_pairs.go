@@ -14,6 +14,7 @@ package inverted
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -34,12 +35,29 @@ type propValuePair struct {
 
 	// only set if operator=OperatorWithinGeoRange, as that cannot be served by a
 	// byte value from an inverted index
-	valueGeoRange      *filters.GeoRange
+	valueGeoRange *filters.GeoRange
+	// only set if operator=OperatorWithinPolygon or operator=OperatorIntersects.
+	// On geoCoordinates props it is served by the geo index, on geoPolygon and
+	// geoShape props by the geohash cells in the inverted index
+	valueGeoPolygon    *filters.GeoPolygon
 	docIDs             docBitmap
 	children           []*propValuePair
 	hasFilterableIndex bool
 	hasSearchableIndex bool
+	hasRangeableIndex  bool
 	Class              *models.Class // The schema
+
+	// only set for filters on the props of referenced objects, which are
+	// served by a join with the refs bucket
+	refJoin *refJoin
+
+	// only set for a ContainsAny filter on the id, holds the binary uuids
+	// which are looked up in the objects bucket directly
+	primaryKeys [][]byte
+
+	// set by the planner if an AND is known to match nothing before all of
+	// its children were fetched
+	matchesNothing bool
 }
 
 func newPropValuePair(class *models.Class) (*propValuePair, error) {
@@ -49,7 +67,7 @@ func newPropValuePair(class *models.Class) (*propValuePair, error) {
 	return &propValuePair{docIDs: newDocBitmap(), Class: class}, nil
 }
 
-func (pv *propValuePair) fetchDocIDs(s *Searcher, limit int) error {
+func (pv *propValuePair) fetchDocIDs(ctx context.Context, s *Searcher, limit int) error {
 	if pv.operator.OnValue() {
 
 		// TODO text_rbm_inverted_index find better way check whether prop len
@@ -69,7 +87,9 @@ func (pv *propValuePair) fetchDocIDs(s *Searcher, limit int) error {
 		}
 
 		var bucketName string
-		if pv.hasFilterableIndex {
+		if pv.servedByRangeableIndex() {
+			bucketName = helpers.BucketRangeableFromPropNameLSM(pv.prop)
+		} else if pv.hasFilterableIndex {
 			bucketName = helpers.BucketFromPropNameLSM(pv.prop)
 		} else if pv.hasSearchableIndex {
 			bucketName = helpers.BucketSearchableFromPropNameLSM(pv.prop)
@@ -80,32 +100,55 @@ func (pv *propValuePair) fetchDocIDs(s *Searcher, limit int) error {
 		b := s.store.Bucket(bucketName)
 
 		// TODO:  I think we can delete this check entirely.  The bucket will never be nill, and routines should now check if their particular feature is active in the schema.  However, not all those routines have checks yet.
-		if b == nil && pv.operator != filters.OperatorWithinGeoRange {
+		if b == nil && pv.operator != filters.OperatorWithinGeoRange && pv.valueGeoPolygon == nil {
 			// a nil bucket is ok for a WithinGeoRange filter, as this query is not
 			// served by the inverted index, but propagated to a secondary index in
-			// .docPointers()
+			// .docPointers(). The same applies to polygons on geoCoordinates props
 			return errors.Errorf("bucket for prop %s not found - is it indexed?", pv.prop)
 		}
 
-		ctx := context.TODO() // TODO: pass through instead of spawning new
 		dbm, err := s.docBitmap(ctx, b, limit, pv)
 		if err != nil {
 			return err
 		}
 		pv.docIDs = dbm
 	} else {
+		if pv.operator == filters.OperatorNot {
+			all, err := s.docBitmapAll(ctx)
+			if err != nil {
+				return errors.Wrap(err, "read all doc ids to negate")
+			}
+			pv.docIDs = all
+		}
+
+		children := pv.children
+		estimated := pv.planChildren(s)
+		if pv.operator == filters.OperatorAnd && estimated && len(children) > 1 &&
+			children[0].operator.OnValue() {
+			// the most selective child is fetched first. If it matches nothing,
+			// neither does the AND, and the other children don't need to be read
+			if err := children[0].fetchDocIDs(ctx, s, 0); err != nil {
+				return errors.Wrap(err, "nested child 0")
+			}
+			if children[0].docIDs.docIDs.IsEmpty() {
+				pv.matchesNothing = true
+				return nil
+			}
+			children = children[1:]
+		}
+
 		eg := errgroup.Group{}
 		// prevent unbounded concurrency, see
 		// https://github.com/weaviate/weaviate/issues/3179 for details
 		eg.SetLimit(2 * _NUMCPU)
-		for i, child := range pv.children {
+		for i, child := range children {
 			i, child := i, child
 			eg.Go(func() error {
 				// Explicitly set the limit to 0 (=unlimited) as this is a nested filter,
 				// otherwise we run into situations where each subfilter on their own
 				// runs into the limit, possibly yielding in "less than limit" results
 				// after merging.
-				err := child.fetchDocIDs(s, 0)
+				err := child.fetchDocIDs(ctx, s, 0)
 				if err != nil {
 					return errors.Wrapf(err, "nested child %d", i)
 				}
@@ -121,35 +164,74 @@ func (pv *propValuePair) fetchDocIDs(s *Searcher, limit int) error {
 	return nil
 }
 
-func (pv *propValuePair) mergeDocIDs() (*docBitmap, error) {
+func (pv *propValuePair) mergeDocIDs(ctx context.Context) (*docBitmap, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
 	if pv.operator.OnValue() {
 		return &pv.docIDs, nil
 	}
 
-	if pv.operator != filters.OperatorAnd && pv.operator != filters.OperatorOr {
+	if pv.operator != filters.OperatorAnd && pv.operator != filters.OperatorOr &&
+		pv.operator != filters.OperatorNot {
 		return nil, fmt.Errorf("unsupported operator: %s", pv.operator.Name())
 	}
+	if pv.matchesNothing {
+		dbm := newDocBitmap()
+		return &dbm, nil
+	}
 	if len(pv.children) == 0 {
 		return nil, fmt.Errorf("no children for operator: %s", pv.operator.Name())
 	}
 
+	if pv.operator == filters.OperatorNot {
+		if len(pv.children) != 1 {
+			return nil, fmt.Errorf("operator Not requires exactly one child, got %d",
+				len(pv.children))
+		}
+		dbm, err := pv.children[0].mergeDocIDs(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "retrieve doc bitmap of child 0")
+		}
+		pv.docIDs.docIDs.AndNot(dbm.docIDs)
+		return &docBitmap{
+			docIDs: roaringset.Condense(pv.docIDs.docIDs),
+		}, nil
+	}
+
 	dbms := make([]*docBitmap, len(pv.children))
 	for i, child := range pv.children {
-		dbm, err := child.mergeDocIDs()
+		dbm, err := child.mergeDocIDs(ctx)
 		if err != nil {
 			return nil, errors.Wrapf(err, "retrieve doc bitmap of child %d", i)
 		}
 		dbms[i] = dbm
 	}
 
-	mergeRes := dbms[0].docIDs.Clone()
-	mergeFn := mergeRes.And
-	if pv.operator == filters.OperatorOr {
-		mergeFn = mergeRes.Or
+	// the bitmaps of the children are read for this query only, so they are
+	// merged in place instead of being copied first
+	if pv.operator == filters.OperatorAnd {
+		// intersecting the smallest bitmaps first keeps the intermediate
+		// result small, and allows to stop as soon as it is empty
+		sort.Slice(dbms, func(i, j int) bool {
+			return dbms[i].docIDs.GetCardinality() < dbms[j].docIDs.GetCardinality()
+		})
 	}
 
+	mergeRes := dbms[0].docIDs
 	for i := 1; i < len(dbms); i++ {
-		mergeFn(dbms[i].docIDs)
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if pv.operator == filters.OperatorOr {
+			mergeRes.Or(dbms[i].docIDs)
+			continue
+		}
+		if mergeRes.IsEmpty() {
+			break
+		}
+		mergeRes.And(dbms[i].docIDs)
 	}
 
 	return &docBitmap{
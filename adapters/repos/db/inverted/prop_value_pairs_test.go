@@ -12,6 +12,7 @@
 package inverted
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -98,7 +99,7 @@ func TestPropValuePairs_Merging(t *testing.T) {
 					}
 				}
 
-				dbm, err := pv.mergeDocIDs()
+				dbm, err := pv.mergeDocIDs(context.Background())
 
 				require.Nil(t, err)
 				assert.ElementsMatch(t, tc.expectedIds, dbm.IDs())
@@ -109,3 +110,25 @@ func TestPropValuePairs_Merging(t *testing.T) {
 		}
 	})
 }
+
+func TestPropValuePairs_MergingCancelled(t *testing.T) {
+	pv := &propValuePair{
+		operator: filters.OperatorOr,
+		children: []*propValuePair{
+			{
+				operator: filters.OperatorEqual,
+				docIDs:   docBitmap{docIDs: roaringset.NewBitmap(7, 8)},
+			},
+			{
+				operator: filters.OperatorEqual,
+				docIDs:   docBitmap{docIDs: roaringset.NewBitmap(9, 10)},
+			},
+		},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := pv.mergeDocIDs(ctx)
+	require.ErrorIs(t, err, context.Canceled)
+}
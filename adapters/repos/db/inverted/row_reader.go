@@ -79,6 +79,8 @@ func (rr *RowReader) Read(ctx context.Context, readFn ReadFn) error {
 		return rr.lessThan(ctx, readFn, true)
 	case filters.OperatorLike:
 		return rr.like(ctx, readFn)
+	case filters.OperatorFuzzyMatch:
+		return rr.fuzzy(ctx, readFn)
 	case filters.OperatorIsNull: // we need to fetch a row with a given value (there is only nil and !nil) and can reuse equal to get the correct row
 		return rr.equal(ctx, readFn)
 	default:
@@ -197,6 +199,18 @@ func (rr *RowReader) like(ctx context.Context, readFn ReadFn) error {
 		return errors.Wrapf(err, "parse like value")
 	}
 
+	if like.automaton != nil && rr.bucket.HasTermDictionary() {
+		return readMatchingKeys(ctx, rr.bucket.MatchingKeys, like.automaton, like.regexp.Match, func(k []byte) (bool, error) {
+			var v [][]byte
+			if !rr.keyOnly {
+				if v, err = rr.bucket.SetList(k); err != nil {
+					return false, err
+				}
+			}
+			return readFn(k, v)
+		})
+	}
+
 	c := rr.newCursor()
 	defer c.Close()
 
@@ -246,6 +260,49 @@ func (rr *RowReader) like(ctx context.Context, readFn ReadFn) error {
 	return nil
 }
 
+func (rr *RowReader) fuzzy(ctx context.Context, readFn ReadFn) error {
+	fuzzy, err := parseFuzzyAutomaton(rr.value)
+	if err != nil {
+		return errors.Wrapf(err, "parse fuzzy value")
+	}
+
+	if rr.bucket.HasTermDictionary() {
+		return readMatchingKeys(ctx, rr.bucket.MatchingKeys, fuzzy, nil, func(k []byte) (bool, error) {
+			var v [][]byte
+			if !rr.keyOnly {
+				if v, err = rr.bucket.SetList(k); err != nil {
+					return false, err
+				}
+			}
+			return readFn(k, v)
+		})
+	}
+
+	c := rr.newCursor()
+	defer c.Close()
+
+	for k, v := c.First(); k != nil; k, v = c.Next() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		if !lsmkv.AutomatonMatches(fuzzy, k) {
+			continue
+		}
+
+		continueReading, err := readFn(k, v)
+		if err != nil {
+			return err
+		}
+
+		if !continueReading {
+			break
+		}
+	}
+
+	return nil
+}
+
 // newCursor will either return a regular cursor - or a key-only cursor if
 // keyOnly==true
 func (rr *RowReader) newCursor() *lsmkv.CursorSet {
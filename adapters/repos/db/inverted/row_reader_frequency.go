@@ -74,6 +74,8 @@ func (rr *RowReaderFrequency) Read(ctx context.Context, readFn ReadFnFrequency)
 		return rr.lessThan(ctx, readFn, true)
 	case filters.OperatorLike:
 		return rr.like(ctx, readFn)
+	case filters.OperatorFuzzyMatch:
+		return rr.fuzzy(ctx, readFn)
 	default:
 		return fmt.Errorf("operator %v supported", rr.operator)
 	}
@@ -86,19 +88,9 @@ func (rr *RowReaderFrequency) equal(ctx context.Context, readFn ReadFnFrequency)
 		return err
 	}
 
-	var v []lsmkv.MapPair
-	var err error
-	if rr.shardVersion < 2 {
-		v, err = rr.bucket.MapList(rr.value, lsmkv.MapListAcceptDuplicates(),
-			lsmkv.MapListLegacySortingRequired())
-		if err != nil {
-			return err
-		}
-	} else {
-		v, err = rr.bucket.MapList(rr.value, lsmkv.MapListAcceptDuplicates())
-		if err != nil {
-			return err
-		}
+	v, err := rr.mapList(rr.value)
+	if err != nil {
+		return err
 	}
 	// TODO: don't we need to check here if this is a doc id vs a object search?
 	// Or is this not a problem because the latter removes duplicates anyway?
@@ -202,6 +194,18 @@ func (rr *RowReaderFrequency) like(ctx context.Context, readFn ReadFnFrequency)
 		return errors.Wrapf(err, "parse like value")
 	}
 
+	if like.automaton != nil && rr.bucket.HasTermDictionary() {
+		return readMatchingKeys(ctx, rr.bucket.MatchingKeys, like.automaton, like.regexp.Match, func(k []byte) (bool, error) {
+			var v []lsmkv.MapPair
+			if !rr.keyOnly {
+				if v, err = rr.mapList(k); err != nil {
+					return false, err
+				}
+			}
+			return readFn(k, v)
+		})
+	}
+
 	// TODO: don't we need to check here if this is a doc id vs a object search?
 	// Or is this not a problem because the latter removes duplicates anyway?
 	c := rr.newCursor(lsmkv.MapListAcceptDuplicates())
@@ -253,6 +257,57 @@ func (rr *RowReaderFrequency) like(ctx context.Context, readFn ReadFnFrequency)
 	return nil
 }
 
+func (rr *RowReaderFrequency) fuzzy(ctx context.Context, readFn ReadFnFrequency) error {
+	fuzzy, err := parseFuzzyAutomaton(rr.value)
+	if err != nil {
+		return errors.Wrapf(err, "parse fuzzy value")
+	}
+
+	if rr.bucket.HasTermDictionary() {
+		return readMatchingKeys(ctx, rr.bucket.MatchingKeys, fuzzy, nil, func(k []byte) (bool, error) {
+			var v []lsmkv.MapPair
+			if !rr.keyOnly {
+				if v, err = rr.mapList(k); err != nil {
+					return false, err
+				}
+			}
+			return readFn(k, v)
+		})
+	}
+
+	c := rr.newCursor(lsmkv.MapListAcceptDuplicates())
+	defer c.Close()
+
+	for k, v := c.First(); k != nil; k, v = c.Next() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		if !lsmkv.AutomatonMatches(fuzzy, k) {
+			continue
+		}
+
+		continueReading, err := readFn(k, v)
+		if err != nil {
+			return err
+		}
+
+		if !continueReading {
+			break
+		}
+	}
+
+	return nil
+}
+
+func (rr *RowReaderFrequency) mapList(key []byte) ([]lsmkv.MapPair, error) {
+	if rr.shardVersion < 2 {
+		return rr.bucket.MapList(key, lsmkv.MapListAcceptDuplicates(),
+			lsmkv.MapListLegacySortingRequired())
+	}
+	return rr.bucket.MapList(key, lsmkv.MapListAcceptDuplicates())
+}
+
 // newCursor will either return a regular cursor - or a key-only cursor if
 // keyOnly==true
 func (rr *RowReaderFrequency) newCursor(
@@ -29,6 +29,10 @@ type RowReaderRoaringSet struct {
 	operator  filters.Operator
 	newCursor func() lsmkv.CursorRoaringSet
 	getter    func(key []byte) (*sroar.Bitmap, error)
+	keyOnly   bool
+
+	// nil if the bucket has no term dictionary
+	matchingKeys func(lsmkv.Automaton) ([][]byte, error)
 }
 
 // If keyOnly is set, the RowReaderRoaringSet will request key-only cursors
@@ -43,11 +47,18 @@ func NewRowReaderRoaringSet(bucket *lsmkv.Bucket, value []byte,
 		newCursor = bucket.CursorRoaringSetKeyOnly
 	}
 
+	var matchingKeys func(lsmkv.Automaton) ([][]byte, error)
+	if bucket.HasTermDictionary() {
+		matchingKeys = bucket.MatchingKeys
+	}
+
 	return &RowReaderRoaringSet{
-		value:     value,
-		operator:  operator,
-		newCursor: newCursor,
-		getter:    getter,
+		value:        value,
+		operator:     operator,
+		newCursor:    newCursor,
+		getter:       getter,
+		keyOnly:      keyOnly,
+		matchingKeys: matchingKeys,
 	}
 }
 
@@ -85,6 +96,8 @@ func (rr *RowReaderRoaringSet) Read(ctx context.Context, readFn RoaringSetReadFn
 		return rr.lessThan(ctx, readFn, true)
 	case filters.OperatorLike:
 		return rr.like(ctx, readFn)
+	case filters.OperatorFuzzyMatch:
+		return rr.fuzzy(ctx, readFn)
 	default:
 		return fmt.Errorf("operator %v not supported", rr.operator)
 	}
@@ -198,6 +211,18 @@ func (rr *RowReaderRoaringSet) like(ctx context.Context,
 		return errors.Wrapf(err, "parse like value")
 	}
 
+	if like.automaton != nil && rr.matchingKeys != nil {
+		return readMatchingKeys(ctx, rr.matchingKeys, like.automaton, like.regexp.Match, func(k []byte) (bool, error) {
+			var v *sroar.Bitmap
+			if !rr.keyOnly {
+				if v, err = rr.getter(k); err != nil {
+					return false, err
+				}
+			}
+			return readFn(k, v)
+		})
+	}
+
 	c := rr.newCursor()
 	defer c.Close()
 
@@ -244,3 +269,45 @@ func (rr *RowReaderRoaringSet) like(ctx context.Context,
 
 	return nil
 }
+
+func (rr *RowReaderRoaringSet) fuzzy(ctx context.Context,
+	readFn RoaringSetReadFn,
+) error {
+	fuzzy, err := parseFuzzyAutomaton(rr.value)
+	if err != nil {
+		return errors.Wrapf(err, "parse fuzzy value")
+	}
+
+	if rr.matchingKeys != nil {
+		return readMatchingKeys(ctx, rr.matchingKeys, fuzzy, nil, func(k []byte) (bool, error) {
+			var v *sroar.Bitmap
+			if !rr.keyOnly {
+				if v, err = rr.getter(k); err != nil {
+					return false, err
+				}
+			}
+			return readFn(k, v)
+		})
+	}
+
+	c := rr.newCursor()
+	defer c.Close()
+
+	for k, v := c.First(); k != nil; k, v = c.Next() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		if !lsmkv.AutomatonMatches(fuzzy, k) {
+			continue
+		}
+
+		if continueReading, err := readFn(k, v); err != nil {
+			return err
+		} else if !continueReading {
+			break
+		}
+	}
+
+	return nil
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/propertyspecific"
 	"github.com/weaviate/weaviate/adapters/repos/db/sorter"
 	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/explain"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/inverted"
 	"github.com/weaviate/weaviate/entities/models"
@@ -49,6 +50,9 @@ type Searcher struct {
 	tenant                 string
 	// nestedCrossRefLimit limits the number of nested cross refs returned for a query
 	nestedCrossRefLimit int64
+	// previous names of renamed properties by their current name, which are
+	// still looked up when sorting
+	previousPropertyNames map[string][]string
 }
 
 type DeletedDocIDChecker interface {
@@ -77,6 +81,13 @@ func NewSearcher(logger logrus.FieldLogger, store *lsmkv.Store,
 	}
 }
 
+// WithPreviousPropertyNames sets the previous names of renamed properties,
+// whose objects may not have been rewritten yet
+func (s *Searcher) WithPreviousPropertyNames(previousNames map[string][]string) *Searcher {
+	s.previousPropertyNames = previousNames
+	return s
+}
+
 // Objects returns a list of full objects
 func (s *Searcher) Objects(ctx context.Context, limit int,
 	filter *filters.LocalFilter, sort []filters.Sort, additional additional.Properties,
@@ -98,21 +109,34 @@ func (s *Searcher) Objects(ctx context.Context, limit int,
 		it = allowList.LimitedIterator(limit)
 	}
 
-	return s.objectsByDocID(it, additional)
+	return s.objectsByDocID(it, additional, s.schema.GetClass(className))
 }
 
 func (s *Searcher) sort(ctx context.Context, limit int, sort []filters.Sort, docIDs helpers.AllowList,
 	additional additional.Properties, className schema.ClassName,
 ) ([]uint64, error) {
-	lsmSorter, err := sorter.NewLSMSorter(s.store, s.schema, className)
+	lsmSorter, err := sorter.NewLSMSorter(s.store, s.schema, className, s.previousPropertyNames)
 	if err != nil {
 		return nil, err
 	}
+
+	class := s.schema.GetClass(className)
+	if class != nil && class.InvertedIndexConfig != nil {
+		candidates, ok, err := TimestampSortCandidates(ctx, s.store,
+			class.InvertedIndexConfig.IndexTimestampRangeFilters, limit, sort, docIDs)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			docIDs = candidates
+		}
+	}
+
 	return lsmSorter.SortDocIDs(ctx, limit, sort, docIDs)
 }
 
 func (s *Searcher) objectsByDocID(it docIDsIterator,
-	additional additional.Properties,
+	additional additional.Properties, class *models.Class,
 ) ([]*storobj.Object, error) {
 	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
 	if bucket == nil {
@@ -138,7 +162,7 @@ func (s *Searcher) objectsByDocID(it docIDsIterator,
 		if additional.ReferenceQuery {
 			unmarshalled, err = storobj.FromBinaryUUIDOnly(res)
 		} else {
-			unmarshalled, err = storobj.FromBinaryOptional(res, additional)
+			unmarshalled, err = storobj.FromBinaryOptional(res, additional, class)
 		}
 		if err != nil {
 			return nil, errors.Wrapf(err, "unmarshal data object at position %d", i)
@@ -171,21 +195,25 @@ func (s *Searcher) docIDs(ctx context.Context, filter *filters.LocalFilter,
 	additional additional.Properties, className schema.ClassName,
 	limit int,
 ) (helpers.AllowList, error) {
+	before := time.Now()
+
 	pv, err := s.extractPropValuePair(filter.Root, className)
 	if err != nil {
 		return nil, err
 	}
 
-	if err := pv.fetchDocIDs(s, limit); err != nil {
+	if err := pv.fetchDocIDs(ctx, s, limit); err != nil {
 		return nil, errors.Wrap(err, "fetch doc ids for prop/value pair")
 	}
 
-	dbm, err := pv.mergeDocIDs()
+	dbm, err := pv.mergeDocIDs(ctx)
 	if err != nil {
 		return nil, errors.Wrap(err, "merge doc ids by operator")
 	}
 
-	return helpers.NewAllowListFromBitmap(dbm.docIDs), nil
+	allowList := helpers.NewAllowListFromBitmap(dbm.docIDs)
+	explain.FromContext(ctx).Track(explain.StageFilter, before, allowList.Len())
+	return allowList, nil
 }
 
 func (s *Searcher) extractPropValuePair(filter *filters.Clause,
@@ -210,6 +238,10 @@ func (s *Searcher) extractPropValuePair(filter *filters.Clause,
 		return out, nil
 	}
 
+	if filter.Operator == filters.ContainsAny && s.onIDProp(filter.On.Slice()[0]) {
+		return s.extractContainsAnyID(filter.Value.Type, filter.Value.Value, class)
+	}
+
 	if filter.Operator == filters.ContainsAny || filter.Operator == filters.ContainsAll {
 		return s.extractContains(filter.On, filter.Value.Type, filter.Value.Value, filter.Operator, class)
 	}
@@ -245,11 +277,17 @@ func (s *Searcher) extractPropValuePair(filter *filters.Clause,
 		return s.extractReferenceCount(property, filter.Value.Value, filter.Operator, class)
 	}
 
+	if s.onRefProp(property) && filter.Value.Type == schema.DataTypeText {
+		// ref prop and text type matches the references by their beacon as
+		// opposed to the content of the referenced objects
+		return s.extractReferenceBeacon(property, filter.Value.Value, filter.Operator, class)
+	}
+
 	if filter.Operator == filters.OperatorIsNull {
 		return s.extractPropertyNull(property, filter.Value.Type, filter.Value.Value, filter.Operator, class)
 	}
 
-	if s.onGeoProp(property) {
+	if s.onGeoProp(property) || s.onGeoShapeProp(property) {
 		return s.extractGeoFilter(property, filter.Value.Value, filter.Value.Type, filter.Operator, class)
 	}
 
@@ -257,6 +295,22 @@ func (s *Searcher) extractPropValuePair(filter *filters.Clause,
 		return s.extractUUIDFilter(property, filter.Value.Value, filter.Value.Type, filter.Operator, class)
 	}
 
+	if s.onDurationProp(property) {
+		return s.extractDurationFilter(property, filter.Value.Value, filter.Value.Type, filter.Operator, class)
+	}
+
+	if s.onDecimalProp(property) {
+		return s.extractDecimalFilter(property, filter.Value.Value, filter.Value.Type, filter.Operator, class)
+	}
+
+	if s.onIPProp(property) {
+		return s.extractIPFilter(property, filter.Value.Value, filter.Value.Type, filter.Operator, class)
+	}
+
+	if s.onPhoneNumberProp(property) {
+		return s.extractPhoneNumberFilter(property, filter.Value.Value, filter.Value.Type, filter.Operator, class)
+	}
+
 	if s.onTokenizableProp(property) {
 		return s.extractTokenizableProp(property, filter.Value.Type, filter.Value.Value, filter.Operator, class)
 	}
@@ -324,18 +378,19 @@ func (s *Searcher) extractPrimitiveProp(prop *models.Property, propType schema.D
 	hasFilterableIndex := HasFilterableIndex(prop)
 	hasSearchableIndex := HasSearchableIndex(prop)
 
-	if !hasFilterableIndex && !hasSearchableIndex {
-		return nil, inverted.NewMissingFilterableIndexError(prop.Name)
-	}
-
-	return &propValuePair{
+	pv := &propValuePair{
 		value:              byteValue,
 		prop:               prop.Name,
 		operator:           operator,
 		hasFilterableIndex: hasFilterableIndex,
 		hasSearchableIndex: hasSearchableIndex,
+		hasRangeableIndex:  HasRangeableIndex(prop),
 		Class:              class,
-	}, nil
+	}
+	if !hasFilterableIndex && !hasSearchableIndex && !pv.servedByRangeableIndex() {
+		return nil, inverted.NewMissingFilterableIndexError(prop.Name)
+	}
+	return pv, nil
 }
 
 func (s *Searcher) extractReferenceCount(prop *models.Property, value interface{},
@@ -363,12 +418,44 @@ func (s *Searcher) extractReferenceCount(prop *models.Property, value interface{
 	}, nil
 }
 
+func (s *Searcher) extractReferenceBeacon(prop *models.Property, value interface{},
+	operator filters.Operator, class *models.Class,
+) (*propValuePair, error) {
+	beacon, ok := value.(string)
+	if !ok {
+		return nil, fmt.Errorf("expected value to be string, got '%T'", value)
+	}
+	if operator != filters.OperatorEqual && operator != filters.OperatorNotEqual {
+		return nil, fmt.Errorf("operator %s not supported for reference beacons", operator.Name())
+	}
+
+	hasFilterableIndex := HasFilterableIndex(prop)
+	hasSearchableIndex := HasSearchableIndex(prop)
+
+	if !hasFilterableIndex && !hasSearchableIndex {
+		return nil, inverted.NewMissingFilterableIndexError(prop.Name)
+	}
+
+	return &propValuePair{
+		value:              []byte(beacon),
+		prop:               prop.Name,
+		operator:           operator,
+		hasFilterableIndex: hasFilterableIndex,
+		hasSearchableIndex: hasSearchableIndex,
+		Class:              class,
+	}, nil
+}
+
 func (s *Searcher) extractGeoFilter(prop *models.Property, value interface{},
 	valueType schema.DataType, operator filters.Operator, class *models.Class,
 ) (*propValuePair, error) {
-	if valueType != schema.DataTypeGeoCoordinates {
-		return nil, fmt.Errorf("prop %q is of type geoCoordinates, it can only"+
-			"be used with geoRange filters", prop.Name)
+	if valueType == schema.DataTypeGeoPolygon {
+		return s.extractGeoPolygonFilter(prop, value, operator, class)
+	}
+
+	if valueType != schema.DataTypeGeoCoordinates || s.onGeoShapeProp(prop) {
+		return nil, fmt.Errorf("prop %q is of type %s, it can only "+
+			"be used with geoRange filters", prop.Name, prop.DataType[0])
 	}
 
 	parsed := value.(filters.GeoRange)
@@ -384,6 +471,34 @@ func (s *Searcher) extractGeoFilter(prop *models.Property, value interface{},
 	}, nil
 }
 
+func (s *Searcher) extractGeoPolygonFilter(prop *models.Property, value interface{},
+	operator filters.Operator, class *models.Class,
+) (*propValuePair, error) {
+	if operator != filters.OperatorWithinPolygon && operator != filters.OperatorIntersects {
+		return nil, fmt.Errorf("operator %s not supported for geo polygons", operator.Name())
+	}
+	parsed, ok := value.(filters.GeoPolygon)
+	if !ok {
+		return nil, fmt.Errorf("expected to see geo polygon in filter, got %T", value)
+	}
+
+	hasFilterableIndex := HasFilterableIndex(prop)
+	if s.onGeoShapeProp(prop) && !hasFilterableIndex {
+		// the geohash cells of geo shapes are only stored in the filterable index
+		return nil, inverted.NewMissingFilterableIndexError(prop.Name)
+	}
+
+	return &propValuePair{
+		value:              nil, // cells are derived from the polygon when searching
+		valueGeoPolygon:    &parsed,
+		prop:               prop.Name,
+		operator:           operator,
+		hasFilterableIndex: hasFilterableIndex,
+		hasSearchableIndex: HasSearchableIndex(prop),
+		Class:              class,
+	}, nil
+}
+
 func (s *Searcher) extractUUIDFilter(prop *models.Property, value interface{},
 	valueType schema.DataType, operator filters.Operator, class *models.Class,
 ) (*propValuePair, error) {
@@ -422,6 +537,169 @@ func (s *Searcher) extractUUIDFilter(prop *models.Property, value interface{},
 	}, nil
 }
 
+func (s *Searcher) extractDurationFilter(prop *models.Property, value interface{},
+	valueType schema.DataType, operator filters.Operator, class *models.Class,
+) (*propValuePair, error) {
+	var byteValue []byte
+
+	switch valueType {
+	case schema.DataTypeText:
+		asStr, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("expected to see duration as string in filter, got %T", value)
+		}
+		parsed, err := schema.ParseDuration(asStr)
+		if err != nil {
+			return nil, fmt.Errorf("parse duration string: %w", err)
+		}
+		byteValue, err = LexicographicallySortableInt64(int64(parsed))
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("prop %q is of type duration, the duration to filter "+
+			"on must be specified as a string (e.g. valueText:<duration>)", prop.Name)
+	}
+
+	hasFilterableIndex := HasFilterableIndex(prop)
+	hasSearchableIndex := HasSearchableIndex(prop)
+
+	if !hasFilterableIndex && !hasSearchableIndex {
+		return nil, inverted.NewMissingFilterableIndexError(prop.Name)
+	}
+
+	return &propValuePair{
+		value:              byteValue,
+		prop:               prop.Name,
+		operator:           operator,
+		hasFilterableIndex: hasFilterableIndex,
+		hasSearchableIndex: hasSearchableIndex,
+		Class:              class,
+	}, nil
+}
+
+func (s *Searcher) extractDecimalFilter(prop *models.Property, value interface{},
+	valueType schema.DataType, operator filters.Operator, class *models.Class,
+) (*propValuePair, error) {
+	var byteValue []byte
+
+	switch valueType {
+	case schema.DataTypeText:
+		asStr, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("expected to see decimal as string in filter, got %T", value)
+		}
+		parsed, err := schema.ParseDecimal(asStr)
+		if err != nil {
+			return nil, fmt.Errorf("parse decimal string: %w", err)
+		}
+		byteValue = LexicographicallySortableDecimal(parsed)
+	default:
+		return nil, fmt.Errorf("prop %q is of type decimal, the decimal to filter "+
+			"on must be specified as a string (e.g. valueText:<decimal>)", prop.Name)
+	}
+
+	hasFilterableIndex := HasFilterableIndex(prop)
+	hasSearchableIndex := HasSearchableIndex(prop)
+
+	if !hasFilterableIndex && !hasSearchableIndex {
+		return nil, inverted.NewMissingFilterableIndexError(prop.Name)
+	}
+
+	return &propValuePair{
+		value:              byteValue,
+		prop:               prop.Name,
+		operator:           operator,
+		hasFilterableIndex: hasFilterableIndex,
+		hasSearchableIndex: hasSearchableIndex,
+		Class:              class,
+	}, nil
+}
+
+func (s *Searcher) extractIPFilter(prop *models.Property, value interface{},
+	valueType schema.DataType, operator filters.Operator, class *models.Class,
+) (*propValuePair, error) {
+	if valueType != schema.DataTypeText {
+		return nil, fmt.Errorf("prop %q is of type ip, the address or CIDR block to filter "+
+			"on must be specified as a string (e.g. valueText:<ip>)", prop.Name)
+	}
+	asStr, ok := value.(string)
+	if !ok {
+		return nil, fmt.Errorf("expected to see ip as string in filter, got %T", value)
+	}
+
+	hasFilterableIndex := HasFilterableIndex(prop)
+	hasSearchableIndex := HasSearchableIndex(prop)
+
+	if !hasFilterableIndex && !hasSearchableIndex {
+		return nil, inverted.NewMissingFilterableIndexError(prop.Name)
+	}
+
+	pair := func(value []byte, operator filters.Operator) *propValuePair {
+		return &propValuePair{
+			value:              value,
+			prop:               prop.Name,
+			operator:           operator,
+			hasFilterableIndex: hasFilterableIndex,
+			hasSearchableIndex: hasSearchableIndex,
+			Class:              class,
+		}
+	}
+
+	if operator == filters.OperatorWithinCIDR {
+		// all addresses of a block are adjacent in the index, so the block is
+		// served as a range of its first and its last address
+		first, last, err := schema.CIDRRange(asStr)
+		if err != nil {
+			return nil, err
+		}
+		out, err := newPropValuePair(class)
+		if err != nil {
+			return nil, errors.Wrap(err, "new prop value pair")
+		}
+		out.operator = filters.OperatorAnd
+		out.children = []*propValuePair{
+			pair(first, filters.OperatorGreaterThanEqual),
+			pair(last, filters.OperatorLessThanEqual),
+		}
+		return out, nil
+	}
+
+	parsed, err := schema.ParseIP(asStr)
+	if err != nil {
+		return nil, fmt.Errorf("parse ip string: %w", err)
+	}
+	return pair(schema.IPBytes(parsed), operator), nil
+}
+
+func (s *Searcher) extractPhoneNumberFilter(prop *models.Property, value interface{},
+	valueType schema.DataType, operator filters.Operator, class *models.Class,
+) (*propValuePair, error) {
+	if valueType != schema.DataTypeText {
+		return nil, fmt.Errorf("prop %q is of type phoneNumber, the number to filter on "+
+			"must be specified as a string (e.g. valueText:<number>)", prop.Name)
+	}
+	asStr, ok := value.(string)
+	if !ok {
+		return nil, fmt.Errorf("expected to see phone number as string in filter, got %T", value)
+	}
+
+	hasFilterableIndex := HasFilterableIndex(prop)
+	if !hasFilterableIndex {
+		return nil, inverted.NewMissingFilterableIndexError(prop.Name)
+	}
+
+	// phone numbers are indexed by their international and national format,
+	// so either of them matches as is
+	return &propValuePair{
+		value:              []byte(asStr),
+		prop:               prop.Name,
+		operator:           operator,
+		hasFilterableIndex: hasFilterableIndex,
+		Class:              class,
+	}, nil
+}
+
 func (s *Searcher) extractInternalProp(propName string, propType schema.DataType, value interface{},
 	operator filters.Operator, class *models.Class,
 ) (*propValuePair, error) {
@@ -463,6 +741,47 @@ func (s *Searcher) extractIDProp(propName string, propType schema.DataType,
 	}, nil
 }
 
+// extractContainsAnyID serves a list of ids from the objects bucket, which
+// is keyed by the uuid, instead of matching each id as text against the
+// filterable index of the id prop
+func (s *Searcher) extractContainsAnyID(propType schema.DataType, value interface{},
+	class *models.Class,
+) (*propValuePair, error) {
+	if propType != schema.DataTypeText {
+		return nil, fmt.Errorf(
+			"failed to extract id prop, unsupported type '%T' for '%v' operator", propType, filters.ContainsAny)
+	}
+	ids, ok := value.([]string)
+	if !ok {
+		return nil, fmt.Errorf("value type should be []string but is %T", value)
+	}
+
+	keys := make([][]byte, 0, len(ids))
+	for _, id := range ids {
+		parsed, err := uuid.Parse(id)
+		if err != nil {
+			// not a valid uuid, so there can't be any object with this id
+			continue
+		}
+		key, err := parsed.MarshalBinary()
+		if err != nil {
+			return nil, errors.Wrapf(err, "marshal id %q", id)
+		}
+		keys = append(keys, key)
+	}
+
+	out, err := newPropValuePair(class)
+	if err != nil {
+		return nil, errors.Wrap(err, "new prop value pair")
+	}
+	out.prop = filters.InternalPropID
+	out.operator = filters.ContainsAny
+	out.primaryKeys = keys
+	out.hasFilterableIndex = HasFilterableIndexIdProp
+	out.hasSearchableIndex = HasSearchableIndexIdProp
+	return out, nil
+}
+
 func (s *Searcher) extractTimestampProp(propName string, propType schema.DataType, value interface{},
 	operator filters.Operator, class *models.Class,
 ) (*propValuePair, error) {
@@ -498,14 +817,28 @@ func (s *Searcher) extractTimestampProp(propName string, propType schema.DataTyp
 			"failed to extract timestamp prop, unsupported type '%T' for prop '%s'", propType, propName)
 	}
 
-	return &propValuePair{
+	pv := &propValuePair{
 		value:              byteValue,
 		prop:               propName,
 		operator:           operator,
 		hasFilterableIndex: HasFilterableIndexTimestampProp, // TODO text_rbm_inverted_index & with settings
 		hasSearchableIndex: HasSearchableIndexTimestampProp, // TODO text_rbm_inverted_index & with settings
+		hasRangeableIndex:  class.InvertedIndexConfig != nil && class.InvertedIndexConfig.IndexTimestampRangeFilters,
 		Class:              class,
-	}, nil
+	}
+
+	if pv.servedByRangeableIndex() {
+		// the range index holds sortable values instead of strings
+		ts, err := strconv.ParseInt(string(byteValue), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("expected value to be timestamp, got '%s'", byteValue)
+		}
+		if pv.value, err = LexicographicallySortableInt64(ts); err != nil {
+			return nil, err
+		}
+	}
+
+	return pv, nil
 }
 
 func (s *Searcher) extractTokenizableProp(prop *models.Property, propType schema.DataType,
@@ -522,9 +855,20 @@ func (s *Searcher) extractTokenizableProp(prop *models.Property, propType schema
 	case schema.DataTypeText:
 		// if the operator is like, we cannot apply the regular text-splitting
 		// logic as it would remove all wildcard symbols
-		if operator == filters.OperatorLike {
+		switch operator {
+		case filters.OperatorLike:
 			terms = helpers.TokenizeWithWildcards(prop.Tokenization, valueString)
-		} else {
+		case filters.OperatorFuzzyMatch:
+			// the edit distance applies to every single term
+			term, distance, err := filters.ParseFuzzyValue(valueString)
+			if err != nil {
+				return nil, err
+			}
+			terms = helpers.Tokenize(prop.Tokenization, term)
+			for i := range terms {
+				terms[i] = fmt.Sprintf("%s~%d", terms[i], filters.FuzzyDistance(terms[i], distance))
+			}
+		default:
 			terms = helpers.Tokenize(prop.Tokenization, valueString)
 		}
 	default:
@@ -673,6 +1017,11 @@ func (s *Searcher) extractContains(path *filters.Path, propType schema.DataType,
 	return out, nil
 }
 
+func (s *Searcher) onIDProp(propName string) bool {
+	return propName == filters.InternalPropID ||
+		propName == filters.InternalPropBackwardsCompatID
+}
+
 // TODO: repeated calls to on... aren't too efficient because we iterate over
 // the schema each time, might be smarter to have a single method that
 // determines the type and then we switch based on the result. However, the
@@ -689,6 +1038,10 @@ func (s *Searcher) onGeoProp(prop *models.Property) bool {
 	return schema.DataType(prop.DataType[0]) == schema.DataTypeGeoCoordinates
 }
 
+func (s *Searcher) onGeoShapeProp(prop *models.Property) bool {
+	return schema.IsGeoShapeDataType(schema.DataType(prop.DataType[0]))
+}
+
 // Note: A UUID prop is a user-specified prop of type UUID. This has nothing to
 // do with the primary ID of an object which happens to always be a UUID in
 // Weaviate v1
@@ -706,6 +1059,22 @@ func (s *Searcher) onUUIDProp(prop *models.Property) bool {
 	}
 }
 
+func (s *Searcher) onDurationProp(prop *models.Property) bool {
+	return schema.DataType(prop.DataType[0]) == schema.DataTypeDuration
+}
+
+func (s *Searcher) onDecimalProp(prop *models.Property) bool {
+	return schema.DataType(prop.DataType[0]) == schema.DataTypeDecimal
+}
+
+func (s *Searcher) onIPProp(prop *models.Property) bool {
+	return schema.DataType(prop.DataType[0]) == schema.DataTypeIP
+}
+
+func (s *Searcher) onPhoneNumberProp(prop *models.Property) bool {
+	return schema.DataType(prop.DataType[0]) == schema.DataTypePhoneNumber
+}
+
 func (s *Searcher) onInternalProp(propName string) bool {
 	return filters.IsInternalProperty(schema.PropertyName(propName))
 }
@@ -12,14 +12,20 @@
 package inverted
 
 import (
+	"bytes"
 	"context"
 	"encoding/binary"
 	"fmt"
 
 	"github.com/pkg/errors"
 	"github.com/weaviate/sroar"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/geometry"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/storobj"
 )
 
 func (s *Searcher) docBitmap(ctx context.Context, b *lsmkv.Bucket, limit int,
@@ -31,9 +37,22 @@ func (s *Searcher) docBitmap(ctx context.Context, b *lsmkv.Bucket, limit int,
 	if pv.operator == filters.OperatorWithinGeoRange {
 		return s.docBitmapGeo(ctx, pv)
 	}
+	if pv.valueGeoPolygon != nil {
+		return s.docBitmapGeoPolygon(ctx, b, limit, pv)
+	}
+	if pv.refJoin != nil {
+		return s.docBitmapReferenceJoin(ctx, b, pv)
+	}
+	if pv.primaryKeys != nil {
+		return s.docBitmapPrimaryKeys(ctx, pv)
+	}
 	// all other operators perform operations on the inverted index which we
 	// can serve directly
 
+	if pv.servedByRangeableIndex() {
+		return s.docBitmapRangeable(ctx, b, pv)
+	}
+
 	if pv.hasFilterableIndex {
 		// bucket with strategy roaring set serves bitmaps directly
 		if b.Strategy() == lsmkv.StrategyRoaringSet {
@@ -53,6 +72,57 @@ func (s *Searcher) docBitmap(ctx context.Context, b *lsmkv.Bucket, limit int,
 	return docBitmap{}, fmt.Errorf("property '%s' is neither filterable nor searchable", pv.prop)
 }
 
+// docBitmapAll reads the doc ids of all objects from the filterable index of
+// their ids. It is the set a Not operator negates its operand against.
+func (s *Searcher) docBitmapAll(ctx context.Context) (docBitmap, error) {
+	b := s.store.Bucket(helpers.BucketFromPropNameLSM(filters.InternalPropID))
+	if b == nil {
+		return docBitmap{}, errors.Errorf("bucket for prop %s not found", filters.InternalPropID)
+	}
+
+	// the ids are read from the lowest one to the end
+	return s.docBitmap(ctx, b, 0, &propValuePair{
+		prop:               filters.InternalPropID,
+		operator:           filters.OperatorGreaterThanEqual,
+		value:              []byte{},
+		hasFilterableIndex: HasFilterableIndexIdProp,
+		hasSearchableIndex: HasSearchableIndexIdProp,
+	})
+}
+
+// docBitmapPrimaryKeys reads the doc ids of the objects with the given uuids
+// from the objects bucket, with a single point lookup per uuid. Uuids of
+// objects which don't exist (anymore) are skipped.
+func (s *Searcher) docBitmapPrimaryKeys(ctx context.Context, pv *propValuePair) (docBitmap, error) {
+	b := s.store.Bucket(helpers.ObjectsBucketLSM)
+	if b == nil {
+		return docBitmap{}, errors.Errorf("objects bucket not found")
+	}
+
+	out := newDocBitmap()
+	for _, key := range pv.primaryKeys {
+		if err := ctx.Err(); err != nil {
+			return out, err
+		}
+
+		data, err := b.Get(key)
+		if err != nil {
+			return out, errors.Wrap(err, "get object by uuid")
+		}
+		if data == nil {
+			continue
+		}
+
+		docID, err := storobj.DocIDFromBinary(data)
+		if err != nil {
+			return out, errors.Wrap(err, "read doc id from object")
+		}
+		out.docIDs.Set(docID)
+	}
+
+	return out, nil
+}
+
 func (s *Searcher) docBitmapInvertedRoaringSet(ctx context.Context, b *lsmkv.Bucket,
 	limit int, pv *propValuePair,
 ) (docBitmap, error) {
@@ -151,3 +221,128 @@ func (s *Searcher) docBitmapGeo(ctx context.Context, pv *propValuePair) (docBitm
 	out.docIDs.SetMany(res)
 	return out, nil
 }
+
+func (s *Searcher) docBitmapGeoPolygon(ctx context.Context, b *lsmkv.Bucket,
+	limit int, pv *propValuePair,
+) (docBitmap, error) {
+	polygon, err := schema.GeoPolygonShape(pv.valueGeoPolygon.GeoPolygon)
+	if err != nil {
+		return docBitmap{}, fmt.Errorf("prop %q: invalid polygon: %w", pv.prop, err)
+	}
+
+	// geoCoordinates props are served by their geo index, the point of a
+	// geoCoordinates prop intersects the polygon exactly if it lies within it
+	if propIndex, ok := s.propIndices.ByProp(pv.prop); ok {
+		out := newDocBitmap()
+		res, err := propIndex.GeoIndex.WithinPolygon(ctx, polygon)
+		if err != nil {
+			return out, errors.Wrapf(err, "geo index polygon search on prop %q", pv.prop)
+		}
+		out.docIDs.SetMany(res)
+		return out, nil
+	}
+
+	if b == nil {
+		return docBitmap{}, errors.Errorf("bucket for prop %s not found - is it indexed?", pv.prop)
+	}
+
+	candidates, err := s.geoShapeCandidates(b, polygon)
+	if err != nil {
+		return docBitmap{}, errors.Wrapf(err, "geohash cells of prop %q", pv.prop)
+	}
+
+	return s.refineGeoShapeCandidates(ctx, candidates, limit, pv, polygon)
+}
+
+// geoShapeCandidates returns all docs with a shape sharing at least one
+// geohash cell with the polygon. A stored cell shares an area with a cell of
+// the polygon, if it is a prefix of it, or if it has the cell as its prefix.
+func (s *Searcher) geoShapeCandidates(b *lsmkv.Bucket, polygon geometry.Shape) (*sroar.Bitmap, error) {
+	out := sroar.NewBitmap()
+	cells := geometry.Cover(polygon)
+
+	seen := map[string]struct{}{}
+	for _, cell := range cells {
+		for i := 1; i < len(cell); i++ {
+			prefix := cell[:i]
+			if _, ok := seen[prefix]; ok {
+				continue
+			}
+			seen[prefix] = struct{}{}
+
+			docIDs, err := b.RoaringSetGet([]byte(prefix))
+			if err != nil {
+				return nil, err
+			}
+			out.Or(docIDs)
+		}
+	}
+
+	// the cursor holds a read lock on the bucket until it is closed, so it is
+	// only opened once all prefixes have been read
+	cursor := b.CursorRoaringSet()
+	defer cursor.Close()
+
+	for _, cell := range cells {
+		prefix := []byte(cell)
+		for k, docIDs := cursor.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, docIDs = cursor.Next() {
+			out.Or(docIDs)
+		}
+	}
+
+	return out, nil
+}
+
+// refineGeoShapeCandidates checks the shapes of the candidates against the
+// polygon, as the geohash cells only approximate the area of both
+func (s *Searcher) refineGeoShapeCandidates(ctx context.Context, candidates *sroar.Bitmap,
+	limit int, pv *propValuePair, polygon geometry.Shape,
+) (docBitmap, error) {
+	out := newDocBitmap()
+	if candidates.IsEmpty() {
+		return out, nil
+	}
+
+	prop, err := schema.GetPropertyByName(pv.Class, pv.prop)
+	if err != nil {
+		return out, err
+	}
+	dataType := schema.DataType(prop.DataType[0])
+
+	objects, err := s.objectsByDocID(newSliceDocIDsIterator(candidates.ToArray()),
+		additional.Properties{}, pv.Class)
+	if err != nil {
+		return out, errors.Wrap(err, "load candidates")
+	}
+
+	for _, obj := range objects {
+		if err := ctx.Err(); err != nil {
+			return out, err
+		}
+
+		props, ok := obj.Properties().(map[string]interface{})
+		if !ok || props[pv.prop] == nil {
+			continue
+		}
+		shape, err := schema.ParseGeoShape(dataType, props[pv.prop])
+		if err != nil {
+			return out, errors.Wrapf(err, "object %s", obj.ID())
+		}
+
+		var match bool
+		if pv.operator == filters.OperatorWithinPolygon {
+			match = shape.Within(polygon)
+		} else {
+			match = shape.Intersects(polygon)
+		}
+		if match {
+			out.docIDs.Set(obj.DocID())
+		}
+
+		if limit > 0 && out.docIDs.GetCardinality() >= limit {
+			break
+		}
+	}
+
+	return out, nil
+}
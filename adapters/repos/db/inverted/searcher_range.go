@@ -0,0 +1,242 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/sroar"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/filters"
+)
+
+// The range index is a bit-sliced index of the lexicographically sortable
+// 8 byte values of int, number and date props. For every bit of the values
+// it holds the bitmap of the docs which have that bit set, plus the bitmap of
+// all docs with a value. A range filter is then answered with 64 bitmap
+// operations, no matter how many distinct values it covers.
+const rangeableBits = 64
+
+// rangeableExistenceKey is the key of the bitmap of all docs with a value, it
+// follows the keys of the bit slices 0 to 63
+var rangeableExistenceKey = []byte{rangeableBits}
+
+func rangeableBitKey(bit int) []byte {
+	return []byte{byte(bit)}
+}
+
+// RangeableIndexKeys returns the keys of the range index a doc with the given
+// value is added to: the existence key and the keys of all bits set in it
+func RangeableIndexKeys(value []byte) ([][]byte, error) {
+	if len(value) != 8 {
+		return nil, fmt.Errorf("range index requires values of 8 bytes, got %d", len(value))
+	}
+
+	v := binary.BigEndian.Uint64(value)
+	keys := [][]byte{rangeableExistenceKey}
+	for bit := 0; bit < rangeableBits; bit++ {
+		if v&(1<<bit) != 0 {
+			keys = append(keys, rangeableBitKey(bit))
+		}
+	}
+	return keys, nil
+}
+
+// servedByRangeableIndex is true if the filter is served by the range index
+// of the prop. Range filters always are, equality filters only if the prop
+// has no filterable index.
+func (pv *propValuePair) servedByRangeableIndex() bool {
+	if !pv.hasRangeableIndex {
+		return false
+	}
+
+	switch pv.operator {
+	case filters.OperatorGreaterThan, filters.OperatorGreaterThanEqual,
+		filters.OperatorLessThan, filters.OperatorLessThanEqual:
+		return true
+	case filters.OperatorEqual, filters.OperatorNotEqual:
+		return !pv.hasFilterableIndex
+	default:
+		return false
+	}
+}
+
+func (s *Searcher) docBitmapRangeable(ctx context.Context, b *lsmkv.Bucket,
+	pv *propValuePair,
+) (docBitmap, error) {
+	if len(pv.value) != 8 {
+		return docBitmap{}, fmt.Errorf("range index requires values of 8 bytes, got %d",
+			len(pv.value))
+	}
+
+	existing, slices, err := readBitSlices(ctx, b)
+	if err != nil {
+		return docBitmap{}, err
+	}
+
+	docIDs, err := bitSlicedCompare(existing, slices,
+		binary.BigEndian.Uint64(pv.value), pv.operator)
+	if err != nil {
+		return docBitmap{}, err
+	}
+	return docBitmap{docIDs: docIDs}, nil
+}
+
+// readBitSlices reads the existence bitmap and the bit slices of a range index
+func readBitSlices(ctx context.Context, b *lsmkv.Bucket,
+) (*sroar.Bitmap, [rangeableBits]*sroar.Bitmap, error) {
+	var slices [rangeableBits]*sroar.Bitmap
+
+	existing, err := b.RoaringSetGet(rangeableExistenceKey)
+	if err != nil {
+		return nil, slices, errors.Wrap(err, "read existence bitmap")
+	}
+
+	for bit := range slices {
+		if err := ctx.Err(); err != nil {
+			return nil, slices, err
+		}
+
+		slices[bit], err = b.RoaringSetGet(rangeableBitKey(bit))
+		if err != nil {
+			return nil, slices, errors.Wrapf(err, "read bit slice %d", bit)
+		}
+	}
+
+	return existing, slices, nil
+}
+
+// bitSlicedCompare returns the docs whose value compares to the given one as
+// the operator requires. The bits are compared from the most significant one
+// on: docs which have a different bit than the value are smaller or greater,
+// all others stay equal for the next bit.
+func bitSlicedCompare(existing *sroar.Bitmap, slices [rangeableBits]*sroar.Bitmap,
+	value uint64, operator filters.Operator,
+) (*sroar.Bitmap, error) {
+	lt, gt := sroar.NewBitmap(), sroar.NewBitmap()
+	eq := existing.Clone()
+
+	for bit := rangeableBits - 1; bit >= 0; bit-- {
+		if value&(1<<bit) != 0 {
+			notSet := eq.Clone()
+			notSet.AndNot(slices[bit])
+			lt.Or(notSet)
+			eq.And(slices[bit])
+		} else {
+			gt.Or(sroar.And(eq, slices[bit]))
+			eq.AndNot(slices[bit])
+		}
+	}
+
+	switch operator {
+	case filters.OperatorEqual:
+		return eq, nil
+	case filters.OperatorNotEqual:
+		notEqual := existing.Clone()
+		notEqual.AndNot(eq)
+		return notEqual, nil
+	case filters.OperatorGreaterThan:
+		return gt, nil
+	case filters.OperatorGreaterThanEqual:
+		gt.Or(eq)
+		return gt, nil
+	case filters.OperatorLessThan:
+		return lt, nil
+	case filters.OperatorLessThanEqual:
+		lt.Or(eq)
+		return lt, nil
+	default:
+		return nil, fmt.Errorf("operator %s not supported by the range index", operator.Name())
+	}
+}
+
+// TimestampSortCandidates narrows the docs down to the ones which can be among
+// the first limit results when sorting by an internal timestamp, using its
+// range index. The docs still have to be sorted, but only the candidates
+// need to be read. It returns false if the sort can't be served by a range
+// index, in which case all docs have to be considered. A nil allow list
+// stands for all docs.
+func TimestampSortCandidates(ctx context.Context, store *lsmkv.Store,
+	indexTimestampRangeFilters bool, limit int, sort []filters.Sort,
+	allowList helpers.AllowList,
+) (helpers.AllowList, bool, error) {
+	if !indexTimestampRangeFilters || limit <= 0 || len(sort) == 0 || len(sort[0].Path) != 1 {
+		return nil, false, nil
+	}
+	propName := sort[0].Path[0]
+	if propName != filters.InternalPropCreationTimeUnix &&
+		propName != filters.InternalPropLastUpdateTimeUnix {
+		return nil, false, nil
+	}
+
+	b := store.Bucket(helpers.BucketRangeableFromPropNameLSM(propName))
+	if b == nil {
+		return nil, false, nil
+	}
+
+	existing, slices, err := readBitSlices(ctx, b)
+	if err != nil {
+		return nil, false, errors.Wrapf(err, "read range index of %s", propName)
+	}
+	if allowList != nil {
+		existing.And(sroar.FromSortedList(allowList.Slice()))
+	}
+
+	candidates := bitSlicedTopK(existing, slices, limit, sort[0].Order == "desc")
+	return helpers.NewAllowListFromBitmap(candidates), true, nil
+}
+
+// bitSlicedTopK returns the docs with the limit greatest (or smallest) values,
+// plus all docs which tie with the last of them. Going from the most
+// significant bit on, the docs which are certainly in the result are
+// collected, while the remaining candidates are narrowed down to the ones
+// which share the bits with the value at the limit.
+func bitSlicedTopK(existing *sroar.Bitmap, slices [rangeableBits]*sroar.Bitmap,
+	limit int, descending bool,
+) *sroar.Bitmap {
+	if existing.GetCardinality() <= limit {
+		return existing.Clone()
+	}
+
+	found := sroar.NewBitmap()
+	candidates := existing.Clone()
+
+	for bit := rangeableBits - 1; bit >= 0; bit-- {
+		// the candidates which are ahead of the others in this bit
+		ahead := candidates.Clone()
+		if descending {
+			ahead.And(slices[bit])
+		} else {
+			ahead.AndNot(slices[bit])
+		}
+
+		count := found.GetCardinality() + ahead.GetCardinality()
+		if count > limit {
+			candidates = ahead
+			continue
+		}
+
+		found.Or(ahead)
+		if count == limit {
+			return found
+		}
+		candidates.AndNot(ahead)
+	}
+
+	// the remaining candidates all have the same value
+	found.Or(candidates)
+	return found
+}
@@ -0,0 +1,176 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"bytes"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/sroar"
+	"github.com/weaviate/weaviate/entities/filters"
+)
+
+func TestBitSlicedCompare(t *testing.T) {
+	r := rand.New(rand.NewSource(7))
+
+	values := map[uint64][]byte{}
+	for docID := uint64(0); docID < 500; docID++ {
+		if docID%10 == 0 {
+			// docs without a value are not in the existence bitmap
+			continue
+		}
+
+		var value []byte
+		var err error
+		switch docID % 3 {
+		case 0:
+			value, err = LexicographicallySortableInt64(r.Int63n(200) - 100)
+		case 1:
+			value, err = LexicographicallySortableFloat64(r.NormFloat64() * 1e6)
+		default:
+			value, err = LexicographicallySortableInt64(r.Int63())
+		}
+		require.Nil(t, err)
+		values[docID] = value
+	}
+
+	existing := sroar.NewBitmap()
+	var slices [rangeableBits]*sroar.Bitmap
+	for bit := range slices {
+		slices[bit] = sroar.NewBitmap()
+	}
+	for docID, value := range values {
+		keys, err := RangeableIndexKeys(value)
+		require.Nil(t, err)
+		for _, key := range keys {
+			if bytes.Equal(key, rangeableExistenceKey) {
+				existing.Set(docID)
+			} else {
+				slices[key[0]].Set(docID)
+			}
+		}
+	}
+
+	operators := map[filters.Operator]func(cmp int) bool{
+		filters.OperatorEqual:            func(cmp int) bool { return cmp == 0 },
+		filters.OperatorNotEqual:         func(cmp int) bool { return cmp != 0 },
+		filters.OperatorGreaterThan:      func(cmp int) bool { return cmp > 0 },
+		filters.OperatorGreaterThanEqual: func(cmp int) bool { return cmp >= 0 },
+		filters.OperatorLessThan:         func(cmp int) bool { return cmp < 0 },
+		filters.OperatorLessThanEqual:    func(cmp int) bool { return cmp <= 0 },
+	}
+
+	queries := [][]byte{values[1], values[2], values[3], values[457]}
+	for _, v := range []int64{-1000, 0, 50} {
+		query, err := LexicographicallySortableInt64(v)
+		require.Nil(t, err)
+		queries = append(queries, query)
+	}
+
+	for _, query := range queries {
+		for operator, matches := range operators {
+			var expected []uint64
+			for docID, value := range values {
+				if matches(bytes.Compare(value, query)) {
+					expected = append(expected, docID)
+				}
+			}
+
+			queryValue := uint64(0)
+			for _, b := range query {
+				queryValue = queryValue<<8 | uint64(b)
+			}
+			actual, err := bitSlicedCompare(existing, slices, queryValue, operator)
+			require.Nil(t, err)
+			assert.ElementsMatch(t, expected, actual.ToArray(), operator.Name())
+		}
+	}
+
+	t.Run("keeps the existence bitmap unchanged", func(t *testing.T) {
+		assert.Equal(t, len(values), existing.GetCardinality())
+	})
+
+	t.Run("unsupported operator", func(t *testing.T) {
+		_, err := bitSlicedCompare(existing, slices, 0, filters.OperatorLike)
+		assert.NotNil(t, err)
+	})
+}
+
+func TestRangeableIndexKeys(t *testing.T) {
+	keys, err := RangeableIndexKeys([]byte{0, 0, 0, 0, 0, 0, 1, 5})
+	require.Nil(t, err)
+	assert.Equal(t, [][]byte{{64}, {0}, {2}, {8}}, keys)
+
+	_, err = RangeableIndexKeys([]byte("12"))
+	assert.NotNil(t, err)
+}
+
+func TestBitSlicedTopK(t *testing.T) {
+	r := rand.New(rand.NewSource(11))
+
+	values := map[uint64]int64{}
+	existing := sroar.NewBitmap()
+	var slices [rangeableBits]*sroar.Bitmap
+	for bit := range slices {
+		slices[bit] = sroar.NewBitmap()
+	}
+	for docID := uint64(0); docID < 300; docID++ {
+		// few distinct values, so there are plenty of ties
+		value := r.Int63n(40) - 20 + 1_700_000_000_000
+		values[docID] = value
+
+		data, err := LexicographicallySortableInt64(value)
+		require.Nil(t, err)
+		keys, err := RangeableIndexKeys(data)
+		require.Nil(t, err)
+		for _, key := range keys {
+			if bytes.Equal(key, rangeableExistenceKey) {
+				existing.Set(docID)
+			} else {
+				slices[key[0]].Set(docID)
+			}
+		}
+	}
+
+	sorted := make([]int64, 0, len(values))
+	for _, value := range values {
+		sorted = append(sorted, value)
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	for _, limit := range []int{1, 7, 50, 299, 300, 1000} {
+		for _, descending := range []bool{false, true} {
+			// all docs which are at least as good as the doc at the limit
+			threshold := sorted[len(sorted)-1]
+			if limit < len(sorted) {
+				threshold = sorted[limit-1]
+				if descending {
+					threshold = sorted[len(sorted)-limit]
+				}
+			}
+			var expected []uint64
+			for docID, value := range values {
+				if limit >= len(sorted) || (!descending && value <= threshold) ||
+					(descending && value >= threshold) {
+					expected = append(expected, docID)
+				}
+			}
+
+			actual := bitSlicedTopK(existing, slices, limit, descending)
+			assert.ElementsMatch(t, expected, actual.ToArray())
+		}
+	}
+}
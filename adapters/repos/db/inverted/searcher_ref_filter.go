@@ -132,112 +132,46 @@ func (r *refFilterExtractor) fetchIDs(ctx context.Context) ([]classUUIDPair, err
 
 func (r *refFilterExtractor) resultsToPropValuePairs(ids []classUUIDPair,
 ) (*propValuePair, error) {
-	switch len(ids) {
-	case 0:
-		return r.emptyPropValuePair(), nil
-	case 1:
-		return r.backwardCompatibleIDToPropValuePair(ids[0])
-	default:
-		return r.chainedIDsToPropValuePair(ids)
-	}
-}
-
-func (r *refFilterExtractor) emptyPropValuePair() *propValuePair {
 	return &propValuePair{
-		prop:               r.property.Name,
-		value:              nil,
-		operator:           filters.OperatorEqual,
+		prop:     r.property.Name,
+		operator: filters.OperatorEqual,
+		refJoin: &refJoin{
+			beacons: r.beacons(ids),
+			count:   r.filter.ReferenceCount,
+		},
 		hasFilterableIndex: HasFilterableIndex(r.property),
 		hasSearchableIndex: HasSearchableIndex(r.property),
 		Class:              r.class,
-	}
+	}, nil
 }
 
 // Because we still support the old beacon format that did not include the
 // class yet, we cannot be sure about which format we will find in the
-// database. Typically we would now build a filter, such as value==beacon.
-// However, this beacon would either have the old format or the new format.
-// Depending on which format was used during importing, one would match and the
-// other wouldn't.
+// database. Therefore both formats are joined with the refs bucket, a
+// reference can only have one of them, so a doc is never counted twice for
+// the same referenced object.
 //
-// As a workaround we can use an OR filter to allow both, such as
-// ( value==beacon_old_format OR value==beacon_new_format )
-func (r *refFilterExtractor) backwardCompatibleIDToPropValuePair(p classUUIDPair) (*propValuePair, error) {
-	// this workaround is already implemented in the chained ID case, so we can
-	// simply pass it through:
-	return r.chainedIDsToPropValuePair([]classUUIDPair{p})
-}
-
-func (r *refFilterExtractor) idToPropValuePairWithValue(v []byte,
-	hasFilterableIndex, hasSearchableIndex bool,
-) (*propValuePair, error) {
-	return &propValuePair{
-		prop:               r.property.Name,
-		value:              v,
-		operator:           filters.OperatorEqual,
-		hasFilterableIndex: hasFilterableIndex,
-		hasSearchableIndex: hasSearchableIndex,
-		Class:              r.class,
-	}, nil
-}
-
-// chain multiple alternatives using an OR operator
-func (r *refFilterExtractor) chainedIDsToPropValuePair(ids []classUUIDPair) (*propValuePair, error) {
-	hasFilterableIndex := HasFilterableIndex(r.property)
-	hasSearchableIndex := HasSearchableIndex(r.property)
-
-	children, err := r.idsToPropValuePairs(ids, hasFilterableIndex, hasSearchableIndex)
-	if err != nil {
-		return nil, err
-	}
-
-	return &propValuePair{
-		prop:               r.property.Name,
-		operator:           filters.OperatorOr,
-		children:           children,
-		hasFilterableIndex: hasFilterableIndex,
-		hasSearchableIndex: hasSearchableIndex,
-		Class:              r.class,
-	}, nil
-}
-
-// Use both new format with class name in the beacon, as well as the old
-// format. Since the results will be OR'ed anyway, this is safe todo.
-//
-// The additional lookups and OR-merge operations have a cost, therefore this
-// backward-compatible logic should be removed, as soon as we can be sure that
-// no more class-less beacons exist. Most likely this will be the case with the
-// next breaking change, such as v2.0.0.
-func (r *refFilterExtractor) idsToPropValuePairs(ids []classUUIDPair,
-	hasFilterableIndex, hasSearchableIndex bool,
-) ([]*propValuePair, error) {
+// The additional lookups have a cost, therefore this backward-compatible
+// logic should be removed, as soon as we can be sure that no more class-less
+// beacons exist. Most likely this will be the case with the next breaking
+// change, such as v2.0.0.
+func (r *refFilterExtractor) beacons(ids []classUUIDPair) [][]byte {
 	// This makes it safe to access the first element later on without further
 	// checks
 	if len(ids) == 0 {
-		return nil, nil
+		return nil
 	}
 
-	out := make([]*propValuePair, len(ids)*2)
+	out := make([][]byte, len(ids)*2)
 	bb := crossref.NewBulkBuilderWithEstimates(len(ids)*2, ids[0].class, 1.25)
 	for i, id := range ids {
 		// future-proof way
-		pv, err := r.idToPropValuePairWithValue(bb.ClassAndID(id.class, id.id), hasFilterableIndex, hasSearchableIndex)
-		if err != nil {
-			return nil, err
-		}
-
-		out[i*2] = pv
-
+		out[i*2] = bb.ClassAndID(id.class, id.id)
 		// backward-compatible way
-		pv, err = r.idToPropValuePairWithValue(bb.LegacyIDOnly(id.id), hasFilterableIndex, hasSearchableIndex)
-		if err != nil {
-			return nil, err
-		}
-
-		out[(i*2)+1] = pv
+		out[(i*2)+1] = bb.LegacyIDOnly(id.id)
 	}
 
-	return out, nil
+	return out
 }
 
 func (r *refFilterExtractor) validate() error {
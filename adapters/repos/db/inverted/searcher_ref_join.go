@@ -0,0 +1,152 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"context"
+	"encoding/binary"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/sroar"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/filters"
+)
+
+// refJoin is a filter on the props of referenced objects, which is served by
+// looking up the beacons of the matching objects in the refs bucket, so the
+// references of the candidate docs never have to be resolved
+type refJoin struct {
+	beacons [][]byte
+	// nil matches the docs with at least one matching reference
+	count *filters.ReferenceCount
+}
+
+var refJoinAtLeastOne = &filters.ReferenceCount{
+	Operator: filters.OperatorGreaterThanEqual,
+	Count:    1,
+}
+
+func (s *Searcher) docBitmapReferenceJoin(ctx context.Context, b *lsmkv.Bucket,
+	pv *propValuePair,
+) (docBitmap, error) {
+	count := pv.refJoin.count
+	if count == nil {
+		count = refJoinAtLeastOne
+	}
+
+	matching := make([]*sroar.Bitmap, 0, len(pv.refJoin.beacons))
+	for _, beacon := range pv.refJoin.beacons {
+		if err := ctx.Err(); err != nil {
+			return docBitmap{}, err
+		}
+
+		docIDs, err := readReferencingDocs(b, beacon)
+		if err != nil {
+			return docBitmap{}, errors.Wrapf(err, "read docs referencing %s", beacon)
+		}
+		matching = append(matching, docIDs)
+	}
+
+	atLeast := countReferences(matching, count.Count+1)
+
+	out := sroar.NewBitmap()
+	for n := 1; n <= count.Count; n++ {
+		if count.Matches(n) {
+			exactly := atLeast[n].Clone()
+			exactly.AndNot(atLeast[n+1])
+			out.Or(exactly)
+		}
+	}
+	if count.Matches(count.Count + 1) {
+		out.Or(atLeast[count.Count+1])
+	}
+
+	if count.Matches(0) {
+		all, err := s.docsWithReferenceCount(ctx, pv)
+		if err != nil {
+			return docBitmap{}, err
+		}
+		all.AndNot(atLeast[1])
+		out.Or(all)
+	}
+
+	return docBitmap{docIDs: out}, nil
+}
+
+// countReferences returns for every n from 1 to maxCount the docs which are
+// contained in at least n of the bitmaps, i.e. which have at least n matching
+// references. Counting stops at maxCount, as larger counts can't change the
+// result of the filter anymore. The index 0 is unused.
+func countReferences(matching []*sroar.Bitmap, maxCount int) []*sroar.Bitmap {
+	atLeast := make([]*sroar.Bitmap, maxCount+1)
+	for n := 1; n <= maxCount; n++ {
+		atLeast[n] = sroar.NewBitmap()
+	}
+
+	for _, docIDs := range matching {
+		// the higher counts have to be updated first, so that they are based on
+		// the counts before this bitmap was added
+		for n := maxCount; n > 1; n-- {
+			atLeast[n].Or(sroar.And(atLeast[n-1], docIDs))
+		}
+		atLeast[1].Or(docIDs)
+	}
+
+	return atLeast
+}
+
+func readReferencingDocs(b *lsmkv.Bucket, beacon []byte) (*sroar.Bitmap, error) {
+	if b.Strategy() == lsmkv.StrategyRoaringSet {
+		return b.RoaringSetGet(beacon)
+	}
+
+	ids, err := b.SetList(beacon)
+	if err != nil {
+		return nil, err
+	}
+	out := sroar.NewBitmap()
+	for _, asBytes := range ids {
+		out.Set(binary.LittleEndian.Uint64(asBytes))
+	}
+	return out, nil
+}
+
+// docsWithReferenceCount returns all docs which have the reference prop. Docs
+// without any reference are not in the refs bucket, but their reference count
+// of 0 is indexed.
+func (s *Searcher) docsWithReferenceCount(ctx context.Context,
+	pv *propValuePair,
+) (*sroar.Bitmap, error) {
+	prop := helpers.MetaCountProp(pv.prop)
+	b := s.store.Bucket(helpers.BucketFromPropNameLSM(prop))
+	if b == nil {
+		return nil, errors.Errorf("bucket for prop %s not found - is it indexed?", prop)
+	}
+
+	zero, err := s.extractIntCountValue(0)
+	if err != nil {
+		return nil, err
+	}
+
+	dbm, err := s.docBitmap(ctx, b, 0, &propValuePair{
+		prop:               prop,
+		value:              zero,
+		operator:           filters.OperatorGreaterThanEqual,
+		hasFilterableIndex: true,
+		Class:              pv.Class,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "read docs with reference count")
+	}
+	return dbm.docIDs, nil
+}
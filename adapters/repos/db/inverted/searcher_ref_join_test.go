@@ -0,0 +1,46 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/sroar"
+)
+
+func TestCountReferences(t *testing.T) {
+	// the docs referencing each of the matching objects, doc 1 references
+	// three of them, doc 2 two and doc 3 one
+	matching := []*sroar.Bitmap{
+		sroar.FromSortedList([]uint64{1, 2, 3}),
+		sroar.FromSortedList([]uint64{1, 2}),
+		sroar.FromSortedList([]uint64{1}),
+		sroar.NewBitmap(),
+	}
+
+	atLeast := countReferences(matching, 3)
+	assert.ElementsMatch(t, []uint64{1, 2, 3}, atLeast[1].ToArray())
+	assert.ElementsMatch(t, []uint64{1, 2}, atLeast[2].ToArray())
+	assert.ElementsMatch(t, []uint64{1}, atLeast[3].ToArray())
+
+	t.Run("counting stops at the max count", func(t *testing.T) {
+		atLeast := countReferences(matching, 2)
+		assert.Len(t, atLeast, 3)
+		assert.ElementsMatch(t, []uint64{1, 2}, atLeast[2].ToArray())
+	})
+
+	t.Run("without matching references", func(t *testing.T) {
+		atLeast := countReferences(nil, 1)
+		assert.True(t, atLeast[1].IsEmpty())
+	})
+}
@@ -18,6 +18,7 @@ import (
 	"math"
 
 	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/schema"
 )
 
 // LexicographicallySortableFloat64 transforms a conversion to a
@@ -153,3 +154,34 @@ func ParseLexicographicallySortableUint64(in []byte) (uint64, error) {
 
 	return value, nil
 }
+
+// LexicographicallySortableDecimal performs a conversion of an arbitrary
+// precision decimal to a lexicographically sortable byte slice. The first byte
+// orders negative numbers before zero before positive numbers. It is followed
+// by the sortable exponent and one byte per significant digit. For negative
+// numbers exponent and digits are inverted, and a terminator is appended, so
+// that a longer digit sequence sorts first.
+func LexicographicallySortableDecimal(in schema.Decimal) []byte {
+	if in.IsZero() {
+		return []byte{0x01}
+	}
+
+	digits := in.Digits()
+	out := make([]byte, 5, 6+len(digits))
+	exponent := uint32(int32(in.Exponent())) ^ 0x80000000
+	if in.Negative() {
+		out[0] = 0x00
+		binary.BigEndian.PutUint32(out[1:], ^exponent)
+		for i := 0; i < len(digits); i++ {
+			out = append(out, 9-(digits[i]-'0'))
+		}
+		return append(out, 0xFF)
+	}
+
+	out[0] = 0x02
+	binary.BigEndian.PutUint32(out[1:], exponent)
+	for i := 0; i < len(digits); i++ {
+		out = append(out, digits[i]-'0')
+	}
+	return out
+}
@@ -0,0 +1,298 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/priorityqueue"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+// sparseVectorBlockSize is the number of postings which share an upper bound
+// of their scores in a posting list of the sparse vector index
+const sparseVectorBlockSize = 128
+
+// SparseVectorSearch ranks the objects by the dot product of their sparse
+// vector in the given property with the query vector. The posting lists of
+// the query dimensions are traversed with block-max WAND, which skips the
+// blocks of postings that can't make it into the top results.
+func (b *BM25Searcher) SparseVectorSearch(ctx context.Context, filterDocIds helpers.AllowList,
+	className schema.ClassName, limit int, params searchparams.NearSparseVector,
+) ([]*storobj.Object, []float32, error) {
+	class, err := schema.GetClassByName(b.schema.Objects, string(className))
+	if err != nil {
+		return nil, nil, err
+	}
+	prop, err := schema.GetPropertyByName(class, params.Property)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !schema.IsSparseVectorDataType(prop.DataType) {
+		return nil, nil, fmt.Errorf("property '%s' is of type %q, but sparse vector "+
+			"search requires type %q", prop.Name, prop.DataType[0], schema.DataTypeSparseVector)
+	}
+
+	bucket := b.store.Bucket(helpers.BucketSparseVectorFromPropNameLSM(prop.Name))
+	if bucket == nil {
+		return nil, nil, fmt.Errorf("could not find sparse vector bucket for property %v", prop.Name)
+	}
+
+	lists := make([]*sparsePostingList, 0, len(params.Indices))
+	for i, index := range params.Indices {
+		if params.Values[i] == 0 {
+			// the dimension does not contribute to any score
+			continue
+		}
+
+		pairs, err := bucket.MapList(SparseVectorDimensionKey(uint32(index)))
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "read posting list of dimension %d", index)
+		}
+
+		list := newSparsePostingList(params.Values[i], len(pairs))
+		for _, pair := range pairs {
+			if len(pair.Key) != 8 || len(pair.Value) < 4 {
+				b.logger.Warnf("Skipping pair in sparse vector search: unexpected key "+
+					"length %d or value length %d.", len(pair.Key), len(pair.Value))
+				continue
+			}
+			docID := binary.BigEndian.Uint64(pair.Key)
+			if filterDocIds != nil && !filterDocIds.Contains(docID) {
+				continue
+			}
+			list.append(docID, math.Float32frombits(binary.LittleEndian.Uint32(pair.Value)))
+		}
+		if len(list.docIDs) > 0 {
+			lists = append(lists, list)
+		}
+	}
+
+	if limit == 0 {
+		// like for bm25, all matches are returned without a limit
+		for _, list := range lists {
+			limit += len(list.docIDs)
+		}
+	}
+	if limit == 0 {
+		return []*storobj.Object{}, []float32{}, nil
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	topKHeap := blockMaxWand(lists, limit)
+	return b.getTopKObjects(topKHeap, nil, nil, false, class)
+}
+
+// sparsePostingList is the posting list of a single query dimension, sorted by
+// doc id, with the upper bounds of the scores of its blocks
+type sparsePostingList struct {
+	docIDs  []uint64
+	weights []float32
+
+	// queryWeight is the value of the dimension in the query vector
+	queryWeight float64
+	// maxScore is the upper bound of the scores of all postings
+	maxScore float64
+	// blockMaxScores and blockLastDocIDs hold the upper bound of the scores
+	// and the last doc id of every block of postings
+	blockMaxScores  []float64
+	blockLastDocIDs []uint64
+
+	pos int
+}
+
+func newSparsePostingList(queryWeight float32, capacity int) *sparsePostingList {
+	return &sparsePostingList{
+		docIDs:      make([]uint64, 0, capacity),
+		weights:     make([]float32, 0, capacity),
+		queryWeight: float64(queryWeight),
+	}
+}
+
+func (l *sparsePostingList) append(docID uint64, weight float32) {
+	score := l.queryWeight * float64(weight)
+	if len(l.docIDs)%sparseVectorBlockSize == 0 {
+		l.blockMaxScores = append(l.blockMaxScores, score)
+		l.blockLastDocIDs = append(l.blockLastDocIDs, docID)
+	}
+
+	block := len(l.blockMaxScores) - 1
+	if score > l.blockMaxScores[block] {
+		l.blockMaxScores[block] = score
+	}
+	l.blockLastDocIDs[block] = docID
+	if score > l.maxScore {
+		l.maxScore = score
+	}
+
+	l.docIDs = append(l.docIDs, docID)
+	l.weights = append(l.weights, weight)
+}
+
+func (l *sparsePostingList) exhausted() bool {
+	return l.pos >= len(l.docIDs)
+}
+
+func (l *sparsePostingList) docID() uint64 {
+	return l.docIDs[l.pos]
+}
+
+func (l *sparsePostingList) score() float64 {
+	return l.queryWeight * float64(l.weights[l.pos])
+}
+
+// blockFor returns the first block from the current position on which could
+// contain the given doc id
+func (l *sparsePostingList) blockFor(docID uint64) int {
+	first := l.pos / sparseVectorBlockSize
+	return first + sort.Search(len(l.blockLastDocIDs)-first, func(i int) bool {
+		return l.blockLastDocIDs[first+i] >= docID
+	})
+}
+
+// blockBound returns the upper bound of the scores of the block which could
+// contain the given doc id, and the last doc id of that block
+func (l *sparsePostingList) blockBound(docID uint64) (float64, uint64) {
+	block := l.blockFor(docID)
+	if block == len(l.blockLastDocIDs) {
+		return 0, math.MaxUint64
+	}
+	return l.blockMaxScores[block], l.blockLastDocIDs[block]
+}
+
+// advance moves to the first posting with a doc id of at least the given one,
+// skipping whole blocks on the way
+func (l *sparsePostingList) advance(docID uint64) {
+	if l.exhausted() || l.docID() >= docID {
+		return
+	}
+
+	block := l.blockFor(docID)
+	if block == len(l.blockLastDocIDs) {
+		l.pos = len(l.docIDs)
+		return
+	}
+
+	start := block * sparseVectorBlockSize
+	if start < l.pos {
+		start = l.pos
+	}
+	end := (block + 1) * sparseVectorBlockSize
+	if end > len(l.docIDs) {
+		end = len(l.docIDs)
+	}
+	l.pos = start + sort.Search(end-start, func(i int) bool {
+		return l.docIDs[start+i] >= docID
+	})
+}
+
+// blockMaxWand returns the limit docs with the highest sum of scores over all
+// posting lists. A doc is only scored if the upper bounds of its blocks
+// exceed the lowest score in the full heap, otherwise the lists skip ahead
+// to the end of the shortest of these blocks.
+func blockMaxWand(lists []*sparsePostingList, limit int) *priorityqueue.Queue {
+	topKHeap := priorityqueue.NewMin(limit)
+	// scores are never negative, so every doc is a candidate until the heap
+	// is full
+	threshold := float64(-1)
+
+	for {
+		sort.Slice(lists, func(i, j int) bool {
+			if lists[i].exhausted() || lists[j].exhausted() {
+				return !lists[i].exhausted()
+			}
+			return lists[i].docID() < lists[j].docID()
+		})
+
+		// the pivot is the first doc which could exceed the threshold based on
+		// the upper bounds of the whole lists
+		pivot := -1
+		upperBound := float64(0)
+		for i, list := range lists {
+			if list.exhausted() {
+				break
+			}
+			upperBound += list.maxScore
+			if upperBound > threshold {
+				pivot = i
+				break
+			}
+		}
+		if pivot == -1 {
+			return topKHeap
+		}
+
+		pivotDocID := lists[pivot].docID()
+		for pivot+1 < len(lists) && !lists[pivot+1].exhausted() &&
+			lists[pivot+1].docID() == pivotDocID {
+			pivot++
+		}
+
+		blockUpperBound := float64(0)
+		next := uint64(math.MaxUint64)
+		for _, list := range lists[:pivot+1] {
+			bound, lastDocID := list.blockBound(pivotDocID)
+			blockUpperBound += bound
+			if lastDocID < next-1 {
+				next = lastDocID + 1
+			}
+		}
+
+		if blockUpperBound <= threshold {
+			// no doc up to the end of the shortest block can exceed the
+			// threshold, unless it's in one of the lists after the pivot
+			if pivot+1 < len(lists) && !lists[pivot+1].exhausted() &&
+				lists[pivot+1].docID() < next {
+				next = lists[pivot+1].docID()
+			}
+			for _, list := range lists[:pivot+1] {
+				list.advance(next)
+			}
+			continue
+		}
+
+		if lists[0].docID() != pivotDocID {
+			// the docs before the pivot can't exceed the threshold
+			for _, list := range lists[:pivot] {
+				list.advance(pivotDocID)
+			}
+			continue
+		}
+
+		score := float64(0)
+		for _, list := range lists[:pivot+1] {
+			score += list.score()
+			list.pos++
+		}
+
+		if topKHeap.Len() < limit || float64(topKHeap.Top().Dist) < score {
+			topKHeap.Insert(pivotDocID, float32(score))
+			for topKHeap.Len() > limit {
+				topKHeap.Pop()
+			}
+			if topKHeap.Len() >= limit {
+				threshold = float64(topKHeap.Top().Dist)
+			}
+		}
+	}
+}
@@ -0,0 +1,97 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlockMaxWand(t *testing.T) {
+	for _, tc := range []struct {
+		name       string
+		dimensions int
+		docs       int
+		density    float64
+		limit      int
+	}{
+		{"single dimension", 1, 1000, 0.5, 10},
+		{"few dense dimensions", 4, 2000, 0.6, 10},
+		{"many sparse dimensions", 30, 5000, 0.02, 25},
+		{"limit above matches", 5, 200, 0.05, 500},
+		{"limit of one", 10, 3000, 0.1, 1},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			r := rand.New(rand.NewSource(int64(tc.docs)))
+
+			lists := make([]*sparsePostingList, tc.dimensions)
+			expected := map[uint64]float64{}
+			for i := range lists {
+				lists[i] = newSparsePostingList(r.Float32()*2, 0)
+				for docID := uint64(0); docID < uint64(tc.docs); docID++ {
+					if r.Float64() >= tc.density {
+						continue
+					}
+					weight := r.Float32() * 3
+					lists[i].append(docID, weight)
+					expected[docID] += lists[i].queryWeight * float64(weight)
+				}
+			}
+
+			expectedScores := make([]float64, 0, len(expected))
+			for _, score := range expected {
+				expectedScores = append(expectedScores, score)
+			}
+			sort.Sort(sort.Reverse(sort.Float64Slice(expectedScores)))
+			if len(expectedScores) > tc.limit {
+				expectedScores = expectedScores[:tc.limit]
+			}
+
+			heap := blockMaxWand(lists, tc.limit)
+			require.Equal(t, len(expectedScores), heap.Len())
+
+			scores := make([]float64, heap.Len())
+			for i := len(scores) - 1; i >= 0; i-- {
+				res := heap.Pop()
+				scores[i] = float64(res.Dist)
+				assert.InDelta(t, expected[res.ID], scores[i], 1e-5)
+			}
+			assert.InDeltaSlice(t, expectedScores, scores, 1e-5)
+		})
+	}
+}
+
+func TestSparsePostingListAdvance(t *testing.T) {
+	list := newSparsePostingList(1, 0)
+	for docID := uint64(0); docID < 1000; docID += 3 {
+		list.append(docID, float32(docID))
+	}
+	require.Len(t, list.blockMaxScores, 3)
+	assert.Equal(t, []float64{381, 765, 999}, list.blockMaxScores)
+
+	list.advance(100)
+	assert.Equal(t, uint64(102), list.docID())
+
+	list.advance(400)
+	assert.Equal(t, uint64(402), list.docID())
+
+	score, last := list.blockBound(500)
+	assert.Equal(t, float64(765), score)
+	assert.Equal(t, uint64(765), last)
+
+	list.advance(1000)
+	assert.True(t, list.exhausted())
+}
@@ -29,12 +29,28 @@ type Detector struct {
 }
 
 func NewDetectorFromConfig(config models.StopwordConfig) (*Detector, error) {
+	return NewDetectorFromConfigWithSets(config, nil)
+}
+
+// NewDetectorFromConfigWithSets creates a detector which additionally
+// considers the words of the custom stopword sets referenced by the config.
+// Sets which are referenced but not contained in sets are ignored.
+func NewDetectorFromConfigWithSets(config models.StopwordConfig,
+	sets []*models.StopwordSet,
+) (*Detector, error) {
 	d, err := NewDetectorFromPreset(config.Preset)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to create new detector from config")
 	}
 
 	d.SetAdditions(config.Additions)
+	for _, name := range config.Sets {
+		for _, set := range sets {
+			if set != nil && set.Name == name {
+				d.SetAdditions(set.Words)
+			}
+		}
+	}
 	d.SetRemovals(config.Removals)
 
 	return d, nil
@@ -80,6 +96,19 @@ func (d *Detector) SetRemovals(removals []string) {
 	}
 }
 
+// Replace swaps the stopwords of the detector for the ones of other, so that
+// users holding on to d see a changed config without being recreated
+func (d *Detector) Replace(other *Detector) {
+	other.Lock()
+	stopwords := other.stopwords
+	other.Unlock()
+
+	d.Lock()
+	defer d.Unlock()
+
+	d.stopwords = stopwords
+}
+
 func (d *Detector) IsStopword(word string) bool {
 	d.Lock()
 	defer d.Unlock()
@@ -14,6 +14,7 @@ package stopwords
 import (
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/entities/models"
 )
@@ -156,4 +157,83 @@ func TestStopwordDetector(t *testing.T) {
 
 		runTest(t, tests)
 	})
+
+	t.Run("with language presets", func(t *testing.T) {
+		tests := []testcase{
+			{
+				cfg:               models.StopwordConfig{Preset: "de"},
+				input:             []string{"der", "hund", "und", "die", "katze"},
+				expectedCountable: 2,
+			},
+			{
+				cfg:               models.StopwordConfig{Preset: "fr"},
+				input:             []string{"le", "chien", "et", "le", "chat"},
+				expectedCountable: 2,
+			},
+			{
+				cfg:               models.StopwordConfig{Preset: "es"},
+				input:             []string{"el", "perro", "y", "el", "gato"},
+				expectedCountable: 2,
+			},
+		}
+
+		runTest(t, tests)
+	})
+}
+
+func TestStopwordDetectorWithSets(t *testing.T) {
+	sets := []*models.StopwordSet{
+		{Name: "animals", Words: []string{"dog", "cat"}},
+		{Name: "colors", Words: []string{"red", "blue"}},
+	}
+
+	t.Run("referenced sets are added", func(t *testing.T) {
+		sd, err := NewDetectorFromConfigWithSets(models.StopwordConfig{
+			Preset: "none",
+			Sets:   []string{"animals"},
+		}, sets)
+		require.Nil(t, err)
+
+		assert.True(t, sd.IsStopword("dog"))
+		assert.True(t, sd.IsStopword("cat"))
+		assert.False(t, sd.IsStopword("red"))
+	})
+
+	t.Run("removals take precedence over sets", func(t *testing.T) {
+		sd, err := NewDetectorFromConfigWithSets(models.StopwordConfig{
+			Preset:   "en",
+			Sets:     []string{"animals", "colors"},
+			Removals: []string{"cat"},
+		}, sets)
+		require.Nil(t, err)
+
+		assert.True(t, sd.IsStopword("the"))
+		assert.True(t, sd.IsStopword("dog"))
+		assert.True(t, sd.IsStopword("blue"))
+		assert.False(t, sd.IsStopword("cat"))
+	})
+
+	t.Run("unknown sets are ignored", func(t *testing.T) {
+		sd, err := NewDetectorFromConfigWithSets(models.StopwordConfig{
+			Preset: "none",
+			Sets:   []string{"unknown"},
+		}, sets)
+		require.Nil(t, err)
+
+		assert.False(t, sd.IsStopword("dog"))
+	})
+
+	t.Run("replace", func(t *testing.T) {
+		sd, err := NewDetectorFromConfig(models.StopwordConfig{Preset: "none"})
+		require.Nil(t, err)
+		other, err := NewDetectorFromConfigWithSets(models.StopwordConfig{
+			Preset: "none",
+			Sets:   []string{"colors"},
+		}, sets)
+		require.Nil(t, err)
+
+		assert.False(t, sd.IsStopword("red"))
+		sd.Replace(other)
+		assert.True(t, sd.IsStopword("red"))
+	})
 }
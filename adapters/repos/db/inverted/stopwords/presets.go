@@ -12,8 +12,14 @@
 package stopwords
 
 const (
-	EnglishPreset = "en"
-	NoPreset      = "none"
+	EnglishPreset    = "en"
+	GermanPreset     = "de"
+	FrenchPreset     = "fr"
+	SpanishPreset    = "es"
+	ItalianPreset    = "it"
+	DutchPreset      = "nl"
+	PortuguesePreset = "pt"
+	NoPreset         = "none"
 )
 
 var Presets = map[string][]string{
@@ -23,5 +29,52 @@ var Presets = map[string][]string{
 		"the", "their", "then", "there", "these", "they", "this", "to", "was", "will",
 		"with",
 	},
+	GermanPreset: {
+		"aber", "als", "am", "an", "auch", "auf", "aus", "bei", "bin", "bis",
+		"bist", "da", "dann", "das", "dass", "dem", "den", "der", "des", "die",
+		"dies", "doch", "du", "durch", "ein", "eine", "einem", "einen", "einer",
+		"eines", "er", "es", "für", "hat", "ich", "ihr", "im", "in", "ist", "ja",
+		"mit", "nach", "nicht", "noch", "nur", "oder", "sich", "sie", "sind", "so",
+		"um", "und", "uns", "vom", "von", "vor", "war", "was", "wie", "wir", "zu",
+		"zum", "zur",
+	},
+	FrenchPreset: {
+		"à", "au", "aux", "avec", "ce", "ces", "dans", "de", "des", "du", "elle",
+		"en", "est", "et", "eux", "il", "ils", "je", "la", "le", "les", "leur",
+		"lui", "ma", "mais", "me", "même", "mes", "moi", "mon", "ne", "nos",
+		"notre", "nous", "on", "ou", "par", "pas", "pour", "qu", "que", "qui",
+		"sa", "se", "ses", "son", "sur", "ta", "te", "tes", "toi", "ton", "tu",
+		"un", "une", "vos", "votre", "vous",
+	},
+	SpanishPreset: {
+		"a", "al", "como", "con", "de", "del", "el", "ella", "ellos", "en", "es",
+		"esta", "este", "fue", "ha", "la", "las", "le", "les", "lo", "los", "más",
+		"me", "mi", "no", "nos", "o", "para", "pero", "por", "que", "se", "ser",
+		"si", "sin", "sobre", "su", "sus", "también", "te", "tu", "un", "una",
+		"uno", "y", "ya", "yo",
+	},
+	ItalianPreset: {
+		"a", "ad", "al", "alla", "alle", "che", "chi", "ci", "con", "da", "dal",
+		"dalla", "degli", "dei", "del", "della", "delle", "di", "e", "è", "ed",
+		"gli", "ha", "i", "il", "in", "io", "la", "le", "lei", "lo", "lui", "ma",
+		"mi", "ne", "nei", "nel", "nella", "noi", "non", "o", "per", "più", "se",
+		"si", "sono", "su", "sua", "suo", "sul", "sulla", "ti", "tra", "tu", "un",
+		"una", "uno", "voi",
+	},
+	DutchPreset: {
+		"aan", "al", "als", "bij", "dan", "dat", "de", "die", "dit", "door",
+		"een", "en", "er", "had", "heb", "heeft", "hem", "het", "hij", "hoe",
+		"hun", "ik", "in", "is", "je", "kan", "maar", "me", "met", "mij", "na",
+		"naar", "niet", "nog", "nu", "of", "om", "ook", "op", "over", "te", "tot",
+		"uit", "van", "voor", "was", "wat", "we", "wel", "werd", "wij", "zal",
+		"ze", "zich", "zij", "zijn", "zo",
+	},
+	PortuguesePreset: {
+		"a", "ao", "aos", "as", "com", "como", "da", "das", "de", "do", "dos",
+		"e", "é", "ela", "ele", "em", "entre", "era", "eu", "foi", "há", "isso",
+		"já", "lhe", "mais", "mas", "me", "na", "nas", "no", "nos", "não", "o",
+		"os", "ou", "para", "pela", "pelo", "por", "qual", "que", "se", "sem",
+		"seu", "sua", "também", "te", "um", "uma", "você",
+	},
 	NoPreset: {},
 }
@@ -445,6 +445,48 @@ func TestIndexNullState_GetClass(t *testing.T) {
 			})
 		}
 	})
+
+	t.Run("null state index is cleaned up on update and delete", func(t *testing.T) {
+		nullStateCardinalities := func(t *testing.T) (int, int) {
+			var isNull, isNotNull int
+			repo.indices["testclass"].ForEachShard(func(_ string, shard *Shard) error {
+				bucketNull := shard.store.Bucket(helpers.BucketFromPropNameNullLSM("name"))
+				require.NotNil(t, bucketNull)
+
+				bm, err := bucketNull.RoaringSetGet([]byte{uint8(filters.InternalNullState)})
+				require.Nil(t, err)
+				isNull += bm.GetCardinality()
+				bm, err = bucketNull.RoaringSetGet([]byte{uint8(filters.InternalNotNullState)})
+				require.Nil(t, err)
+				isNotNull += bm.GetCardinality()
+				return nil
+			})
+			return isNull, isNotNull
+		}
+
+		isNull, isNotNull := nullStateCardinalities(t)
+		assert.Equal(t, 1, isNull)
+		assert.Equal(t, 1, isNotNull)
+
+		err := repo.PutObject(context.Background(), &models.Object{
+			ID:    testID2,
+			Class: "TestClass",
+			Properties: map[string]interface{}{
+				"name": "object2",
+			},
+		}, []float32{1, 2, 3}, nil)
+		require.Nil(t, err)
+
+		isNull, isNotNull = nullStateCardinalities(t)
+		assert.Equal(t, 0, isNull)
+		assert.Equal(t, 2, isNotNull)
+
+		require.Nil(t, repo.DeleteObject(context.Background(), "TestClass", testID1, nil, ""))
+
+		isNull, isNotNull = nullStateCardinalities(t)
+		assert.Equal(t, 0, isNull)
+		assert.Equal(t, 1, isNotNull)
+	})
 }
 
 func TestIndexPropLength_GetClass(t *testing.T) {
@@ -795,6 +837,181 @@ func TestIndexPropLength_GetClass(t *testing.T) {
 	})
 }
 
+func TestIndexPropLength_References(t *testing.T) {
+	dirName := t.TempDir()
+
+	authorIDs := []strfmt.UUID{
+		"2f8b2a2e-6f33-4d53-a0ad-55b0e4c6b2a1",
+		"7d3b6ac4-b0e4-4c2e-9b8e-1f3b4d55c0a2",
+		"c1e56a0f-7a4e-4d3a-8c34-43a0d8f52ba3",
+		"e9a4d5b2-6c71-4f0b-9d2f-0b2f6ee1e7a4",
+	}
+	bookNoRefs := strfmt.UUID("5b0e3a5d-6b4a-4b8e-9f42-6d0b2b8f1a01")
+	bookEmptyRefs := strfmt.UUID("0c6f1a1c-3b2d-4f6e-8a55-1e2b3c4d5a02")
+	bookTwoRefs := strfmt.UUID("9a8b7c6d-5e4f-4a3b-8c2d-1e0f9a8b7c03")
+	bookFourRefs := strfmt.UUID("3d2c1b0a-9f8e-4d7c-8b6a-5f4e3d2c1b04")
+
+	var repo *DB
+	var schemaGetter *fakeSchemaGetter
+
+	t.Run("init repo", func(t *testing.T) {
+		schemaGetter = &fakeSchemaGetter{
+			shardState: singleShardState(),
+			schema: schema.Schema{
+				Objects: &models.Schema{},
+			},
+		}
+		var err error
+		repo, err = New(logrus.New(), Config{
+			MemtablesFlushIdleAfter:   60,
+			RootPath:                  dirName,
+			QueryMaximumResults:       10000,
+			MaxImportGoroutinesFactor: 1,
+		}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+		require.Nil(t, err)
+		repo.SetSchemaGetter(schemaGetter)
+		require.Nil(t, repo.WaitForStartup(testCtx()))
+	})
+
+	defer repo.Shutdown(testCtx())
+
+	t.Run("add classes", func(t *testing.T) {
+		authorClass := &models.Class{
+			Class:               "Author",
+			VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+			InvertedIndexConfig: &models.InvertedIndexConfig{},
+			Properties: []*models.Property{
+				{
+					Name:         "name",
+					DataType:     schema.DataTypeText.PropString(),
+					Tokenization: models.PropertyTokenizationField,
+				},
+			},
+		}
+		bookClass := &models.Class{
+			Class:             "Book",
+			VectorIndexConfig: enthnsw.NewDefaultUserConfig(),
+			InvertedIndexConfig: &models.InvertedIndexConfig{
+				IndexPropertyLength: true,
+				IndexNullState:      true,
+			},
+			Properties: []*models.Property{
+				{
+					Name:     "authors",
+					DataType: []string{"Author"},
+				},
+			},
+		}
+
+		migrator := NewMigrator(repo, repo.logger)
+		for _, class := range []*models.Class{authorClass, bookClass} {
+			err := migrator.AddClass(context.Background(), class, schemaGetter.shardState)
+			require.Nil(t, err)
+			schemaGetter.schema.Objects.Classes = append(schemaGetter.schema.Objects.Classes, class)
+		}
+	})
+
+	refs := func(n int) models.MultipleRef {
+		out := make(models.MultipleRef, n)
+		for i := range out {
+			out[i] = &models.SingleRef{
+				Beacon: strfmt.URI(fmt.Sprintf("weaviate://localhost/Author/%s", authorIDs[i])),
+			}
+		}
+		return out
+	}
+
+	t.Run("insert test objects", func(t *testing.T) {
+		vec := []float32{1, 2, 3}
+		for i, id := range authorIDs {
+			err := repo.PutObject(context.Background(), &models.Object{
+				ID:         id,
+				Class:      "Author",
+				Properties: map[string]interface{}{"name": fmt.Sprintf("author%d", i)},
+			}, vec, nil)
+			require.Nil(t, err)
+		}
+
+		for _, obj := range []*models.Object{
+			{ID: bookNoRefs, Class: "Book", Properties: map[string]interface{}{}},
+			{ID: bookEmptyRefs, Class: "Book", Properties: map[string]interface{}{"authors": refs(0)}},
+			{ID: bookTwoRefs, Class: "Book", Properties: map[string]interface{}{"authors": refs(2)}},
+			{ID: bookFourRefs, Class: "Book", Properties: map[string]interface{}{"authors": refs(4)}},
+		} {
+			err := repo.PutObject(context.Background(), obj, vec, nil)
+			require.Nil(t, err)
+		}
+	})
+
+	search := func(t *testing.T, operator filters.Operator, value interface{},
+		valueType schema.DataType, property schema.PropertyName,
+	) []strfmt.UUID {
+		res, err := repo.Search(context.Background(), dto.GetParams{
+			ClassName:  "Book",
+			Pagination: &filters.Pagination{Limit: 10},
+			Filters: &filters.LocalFilter{
+				Root: &filters.Clause{
+					Operator: operator,
+					On: &filters.Path{
+						Class:    "Book",
+						Property: property,
+					},
+					Value: &filters.Value{
+						Value: value,
+						Type:  valueType,
+					},
+				},
+			},
+		})
+		require.Nil(t, err)
+
+		ids := make([]strfmt.UUID, len(res))
+		for i := range res {
+			ids[i] = res[i].ID
+		}
+		return ids
+	}
+	searchLength := func(t *testing.T, operator filters.Operator, length int) []strfmt.UUID {
+		return search(t, operator, length, schema.DataTypeInt, "len(authors)")
+	}
+
+	t.Run("filter by reference count", func(t *testing.T) {
+		assert.ElementsMatch(t, []strfmt.UUID{bookFourRefs},
+			searchLength(t, filters.OperatorGreaterThan, 3))
+		assert.ElementsMatch(t, []strfmt.UUID{bookTwoRefs, bookFourRefs},
+			searchLength(t, filters.OperatorGreaterThanEqual, 2))
+		assert.ElementsMatch(t, []strfmt.UUID{bookNoRefs, bookEmptyRefs},
+			searchLength(t, filters.OperatorEqual, 0))
+		assert.ElementsMatch(t, []strfmt.UUID{bookNoRefs, bookEmptyRefs, bookTwoRefs},
+			searchLength(t, filters.OperatorLessThan, 3))
+		assert.ElementsMatch(t, []strfmt.UUID{bookNoRefs, bookEmptyRefs, bookTwoRefs},
+			searchLength(t, filters.OperatorNotEqual, 4))
+	})
+
+	t.Run("filter by null state of references", func(t *testing.T) {
+		assert.ElementsMatch(t, []strfmt.UUID{bookNoRefs, bookEmptyRefs},
+			search(t, filters.OperatorIsNull, true, schema.DataTypeBoolean, "authors"))
+		assert.ElementsMatch(t, []strfmt.UUID{bookTwoRefs, bookFourRefs},
+			search(t, filters.OperatorIsNull, false, schema.DataTypeBoolean, "authors"))
+	})
+
+	t.Run("reference count follows updates and deletes", func(t *testing.T) {
+		err := repo.PutObject(context.Background(), &models.Object{
+			ID:         bookFourRefs,
+			Class:      "Book",
+			Properties: map[string]interface{}{"authors": refs(1)},
+		}, []float32{1, 2, 3}, nil)
+		require.Nil(t, err)
+		require.Nil(t, repo.DeleteObject(context.Background(), "Book", bookTwoRefs, nil, ""))
+
+		assert.Empty(t, searchLength(t, filters.OperatorGreaterThan, 3))
+		assert.ElementsMatch(t, []strfmt.UUID{bookFourRefs},
+			searchLength(t, filters.OperatorEqual, 1))
+		assert.ElementsMatch(t, []strfmt.UUID{bookNoRefs, bookEmptyRefs, bookFourRefs},
+			searchLength(t, filters.OperatorLessThanEqual, 2))
+	})
+}
+
 func TestIndexByTimestamps_GetClass(t *testing.T) {
 	dirName := t.TempDir()
 
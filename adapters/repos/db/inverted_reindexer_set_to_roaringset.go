@@ -16,6 +16,7 @@ import (
 	"time"
 
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/filters"
 )
 
 type ShardInvertedReindexTaskSetToRoaringSet struct{}
@@ -34,6 +35,11 @@ func (t *ShardInvertedReindexTaskSetToRoaringSet) GetPropertiesToReindex(ctx con
 			bucket.DesiredStrategy() == lsmkv.StrategyRoaringSet {
 
 			propName, indexType := GetPropNameAndIndexTypeFromBucketName(name)
+			if propName == filters.InternalPropID {
+				// not a schema property, it is migrated online,
+				// see Shard.migrateToRoaringSet
+				continue
+			}
 			switch indexType {
 			case IndexTypePropValue:
 				reindexableProperties = append(reindexableProperties,
@@ -0,0 +1,153 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestIPProps(t *testing.T) {
+	dirName := t.TempDir()
+
+	logger, _ := test.NewNullLogger()
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer repo.Shutdown(context.Background())
+
+	migrator := NewMigrator(repo, logger)
+	class := &models.Class{
+		Class:               "Connection",
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		Properties: []*models.Property{
+			{
+				Name:     "source",
+				DataType: schema.DataTypeIP.PropString(),
+			},
+		},
+	}
+
+	ids := []strfmt.UUID{
+		"8d5a3aa2-3c8d-4589-9ae1-3f638f506006",
+		"8d5a3aa2-3c8d-4589-9ae1-3f638f506007",
+		"8d5a3aa2-3c8d-4589-9ae1-3f638f506008",
+		"8d5a3aa2-3c8d-4589-9ae1-3f638f506009",
+		"8d5a3aa2-3c8d-4589-9ae1-3f638f50600a",
+	}
+	sources := []string{"10.0.0.1", "10.255.255.255", "11.0.0.0", "192.168.1.20", "2001:db8::1"}
+
+	t.Run("creating the class and add objects", func(t *testing.T) {
+		require.Nil(t,
+			migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+		schemaGetter.schema.Objects = &models.Schema{
+			Classes: []*models.Class{class},
+		}
+		for i, id := range ids {
+			obj := &models.Object{
+				Class:      class.Class,
+				ID:         id,
+				Properties: map[string]interface{}{"source": sources[i]},
+			}
+			require.Nil(t, repo.PutObject(context.Background(), obj, []float32{1, 2, 3}, nil))
+		}
+	})
+
+	t.Run("filtering", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			value    string
+			operator filters.Operator
+			expected []strfmt.UUID
+		}{
+			{
+				name:     "equal",
+				value:    "10.0.0.1",
+				operator: eq,
+				expected: []strfmt.UUID{ids[0]},
+			},
+			{
+				name:     "equal, IPv4-mapped IPv6",
+				value:    "::ffff:192.168.1.20",
+				operator: eq,
+				expected: []strfmt.UUID{ids[3]},
+			},
+			{
+				name:     "greater than",
+				value:    "10.255.255.255",
+				operator: filters.OperatorGreaterThan,
+				expected: []strfmt.UUID{ids[2], ids[3], ids[4]},
+			},
+			{
+				name:     "within IPv4 CIDR block",
+				value:    "10.0.0.0/8",
+				operator: filters.OperatorWithinCIDR,
+				expected: []strfmt.UUID{ids[0], ids[1]},
+			},
+			{
+				name:     "within host CIDR block",
+				value:    "192.168.1.20/32",
+				operator: filters.OperatorWithinCIDR,
+				expected: []strfmt.UUID{ids[3]},
+			},
+			{
+				name:     "within IPv6 CIDR block",
+				value:    "2001:db8::/32",
+				operator: filters.OperatorWithinCIDR,
+				expected: []strfmt.UUID{ids[4]},
+			},
+			{
+				name:     "within empty CIDR block",
+				value:    "172.16.0.0/12",
+				operator: filters.OperatorWithinCIDR,
+				expected: []strfmt.UUID{},
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				res, err := repo.Search(context.Background(), dto.GetParams{
+					ClassName:  class.Class,
+					Pagination: &filters.Pagination{Limit: 10},
+					Filters:    buildFilter("source", tt.value, tt.operator, schema.DataTypeText),
+				})
+				require.Nil(t, err)
+
+				found := make([]strfmt.UUID, len(res))
+				for i := range res {
+					found[i] = res[i].ID
+				}
+				assert.ElementsMatch(t, tt.expected, found)
+			})
+		}
+	})
+}
@@ -82,6 +82,11 @@ func (t *binarySearchTree) flattenInOrder() []*binarySearchNode {
 	return t.root.flattenInOrder()
 }
 
+// visitKeys calls fn with the keys in order, they must not be modified
+func (t *binarySearchTree) visitKeys(fn func(key []byte)) {
+	t.root.visitKeys(fn)
+}
+
 type countStats struct {
 	upsertKeys     [][]byte
 	tombstonedKeys [][]byte
@@ -378,3 +383,13 @@ func binarySearchNodeFromRB(rbNode rbtree.Node) (bsNode *binarySearchNode) {
 	bsNode = rbNode.(*binarySearchNode)
 	return
 }
+
+func (n *binarySearchNode) visitKeys(fn func(key []byte)) {
+	if n == nil {
+		return
+	}
+
+	n.left.visitKeys(fn)
+	fn(n.key)
+	n.right.visitKeys(fn)
+}
@@ -55,6 +55,11 @@ func (t *binarySearchTreeMap) flattenInOrder() []*binarySearchNodeMap {
 	return t.root.flattenInOrder()
 }
 
+// visitKeys calls fn with the keys in order, they must not be modified
+func (t *binarySearchTreeMap) visitKeys(fn func(key []byte)) {
+	t.root.visitKeys(fn)
+}
+
 type binarySearchNodeMap struct {
 	key         []byte
 	values      []MapPair
@@ -258,3 +263,13 @@ func binarySearchNodeMapFromRB(rbNode rbtree.Node) (bsNode *binarySearchNodeMap)
 	bsNode = rbNode.(*binarySearchNodeMap)
 	return
 }
+
+func (n *binarySearchNodeMap) visitKeys(fn func(key []byte)) {
+	if n == nil {
+		return
+	}
+
+	n.left.visitKeys(fn)
+	fn(n.key)
+	n.right.visitKeys(fn)
+}
@@ -76,6 +76,11 @@ func (t *binarySearchTreeMulti) flattenInOrder() []*binarySearchNodeMulti {
 	return t.root.flattenInOrder()
 }
 
+// visitKeys calls fn with the keys in order, they must not be modified
+func (t *binarySearchTreeMulti) visitKeys(fn func(key []byte)) {
+	t.root.visitKeys(fn)
+}
+
 type binarySearchNodeMulti struct {
 	key         []byte
 	values      []value
@@ -274,3 +279,13 @@ func (n *binarySearchNodeMulti) flattenInOrder() []*binarySearchNodeMulti {
 	right = append([]*binarySearchNodeMulti{n}, right...)
 	return append(left, right...)
 }
+
+func (n *binarySearchNodeMulti) visitKeys(fn func(key []byte)) {
+	if n == nil {
+		return
+	}
+
+	n.left.visitKeys(fn)
+	fn(n.key)
+	n.right.visitKeys(fn)
+}
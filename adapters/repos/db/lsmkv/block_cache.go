@@ -0,0 +1,228 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"container/list"
+	"sync"
+	"sync/atomic"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/weaviate/weaviate/usecases/monitoring"
+)
+
+type blockCacheKind uint8
+
+const (
+	// bloom filters of disk segments, primary as well as secondary
+	blockCacheKindBloomFilter blockCacheKind = iota
+
+	// raw nodes read from segments that are not memory-mapped. Memory-mapped
+	// segments are already cached by the OS page cache.
+	blockCacheKindNode
+)
+
+func (k blockCacheKind) String() string {
+	switch k {
+	case blockCacheKindBloomFilter:
+		return "bloom_filter"
+	case blockCacheKindNode:
+		return "node"
+	default:
+		return "unknown"
+	}
+}
+
+// segment ids are unique for the lifetime of the process. Unlike paths they
+// are never reused, e.g. by a compacted segment that takes over the name of
+// one of its inputs, so a cache entry can never be served for the wrong
+// segment.
+var segmentIDs atomic.Uint64
+
+func nextSegmentID() uint64 {
+	return segmentIDs.Add(1)
+}
+
+type blockCacheKey struct {
+	segment uint64
+	kind    blockCacheKind
+
+	// pos is the secondary index of a bloom filter, -1 for the primary one
+	pos int
+
+	// start is the offset of a node in the segment
+	start uint64
+}
+
+type blockCacheEntry struct {
+	key   blockCacheKey
+	value any
+	size  int64
+}
+
+// BlockCache holds bloom filters and nodes of disk segments up to a memory
+// budget. A single cache is meant to be shared by all buckets of all shards of
+// a node, so that the memory used for these structures no longer grows with
+// the number of segments. The least recently used entries are evicted first.
+type BlockCache struct {
+	budget  int64
+	metrics *blockCacheMetrics
+
+	sync.Mutex
+	size      int64
+	lru       *list.List // front is the most recently used entry
+	entries   map[blockCacheKey]*list.Element
+	bySegment map[uint64]map[blockCacheKey]struct{}
+}
+
+// NewBlockCache creates a cache that holds at most budget bytes. promMetrics
+// may be nil.
+func NewBlockCache(budget int64, promMetrics *monitoring.PrometheusMetrics) *BlockCache {
+	return &BlockCache{
+		budget:    budget,
+		metrics:   newBlockCacheMetrics(promMetrics),
+		lru:       list.New(),
+		entries:   map[blockCacheKey]*list.Element{},
+		bySegment: map[uint64]map[blockCacheKey]struct{}{},
+	}
+}
+
+func (c *BlockCache) get(key blockCacheKey) (any, bool) {
+	c.Lock()
+	defer c.Unlock()
+
+	elem, ok := c.entries[key]
+	if !ok {
+		c.metrics.miss(key.kind)
+		return nil, false
+	}
+
+	c.lru.MoveToFront(elem)
+	c.metrics.hit(key.kind)
+	return elem.Value.(*blockCacheEntry).value, true
+}
+
+// put adds the value to the cache, evicting the least recently used entries
+// if the budget is exceeded. Values larger than the entire budget are not
+// cached at all.
+func (c *BlockCache) put(key blockCacheKey, value any, size int64) {
+	if size > c.budget {
+		return
+	}
+
+	c.Lock()
+	defer c.Unlock()
+
+	if elem, ok := c.entries[key]; ok {
+		c.removeElement(elem)
+	}
+
+	c.entries[key] = c.lru.PushFront(&blockCacheEntry{key: key, value: value, size: size})
+	if c.bySegment[key.segment] == nil {
+		c.bySegment[key.segment] = map[blockCacheKey]struct{}{}
+	}
+	c.bySegment[key.segment][key] = struct{}{}
+	c.size += size
+
+	for c.size > c.budget {
+		oldest := c.lru.Back()
+		c.metrics.evicted(oldest.Value.(*blockCacheEntry).key.kind)
+		c.removeElement(oldest)
+	}
+
+	c.metrics.setSize(c.size)
+}
+
+// removeSegment drops all entries of a segment, e.g. because it was closed
+func (c *BlockCache) removeSegment(segment uint64) {
+	c.Lock()
+	defer c.Unlock()
+
+	for key := range c.bySegment[segment] {
+		c.removeElement(c.entries[key])
+	}
+
+	c.metrics.setSize(c.size)
+}
+
+// not thread-safe on its own, as the assumption is that this is called from a
+// lockholder
+func (c *BlockCache) removeElement(elem *list.Element) {
+	entry := elem.Value.(*blockCacheEntry)
+	c.lru.Remove(elem)
+	delete(c.entries, entry.key)
+	c.size -= entry.size
+
+	keys := c.bySegment[entry.key.segment]
+	delete(keys, entry.key)
+	if len(keys) == 0 {
+		delete(c.bySegment, entry.key.segment)
+	}
+}
+
+// Size returns the memory currently held by the cache in bytes
+func (c *BlockCache) Size() int64 {
+	c.Lock()
+	defer c.Unlock()
+
+	return c.size
+}
+
+type blockCacheMetrics struct {
+	requests  *prometheus.CounterVec
+	evictions *prometheus.CounterVec
+	size      prometheus.Gauge
+}
+
+func newBlockCacheMetrics(promMetrics *monitoring.PrometheusMetrics) *blockCacheMetrics {
+	if promMetrics == nil {
+		return nil
+	}
+
+	return &blockCacheMetrics{
+		requests:  promMetrics.LSMBlockCacheRequests,
+		evictions: promMetrics.LSMBlockCacheEvictions,
+		size:      promMetrics.LSMBlockCacheSize,
+	}
+}
+
+func (m *blockCacheMetrics) hit(kind blockCacheKind) {
+	if m == nil {
+		return
+	}
+
+	m.requests.With(prometheus.Labels{"kind": kind.String(), "result": "hit"}).Inc()
+}
+
+func (m *blockCacheMetrics) miss(kind blockCacheKind) {
+	if m == nil {
+		return
+	}
+
+	m.requests.With(prometheus.Labels{"kind": kind.String(), "result": "miss"}).Inc()
+}
+
+func (m *blockCacheMetrics) evicted(kind blockCacheKind) {
+	if m == nil {
+		return
+	}
+
+	m.evictions.With(prometheus.Labels{"kind": kind.String()}).Inc()
+}
+
+func (m *blockCacheMetrics) setSize(size int64) {
+	if m == nil {
+		return
+	}
+
+	m.size.Set(float64(size))
+}
@@ -0,0 +1,152 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+)
+
+func TestBlockCache(t *testing.T) {
+	node := func(segment, start uint64) blockCacheKey {
+		return blockCacheKey{segment: segment, kind: blockCacheKindNode, start: start}
+	}
+
+	t.Run("get what was put", func(t *testing.T) {
+		c := NewBlockCache(100, nil)
+		c.put(node(1, 0), []byte("foo"), 3)
+
+		val, ok := c.get(node(1, 0))
+		require.True(t, ok)
+		assert.Equal(t, []byte("foo"), val)
+
+		_, ok = c.get(node(1, 3))
+		assert.False(t, ok)
+		assert.Equal(t, int64(3), c.Size())
+	})
+
+	t.Run("evict the least recently used entries", func(t *testing.T) {
+		c := NewBlockCache(100, nil)
+		c.put(node(1, 0), "a", 40)
+		c.put(node(1, 40), "b", 40)
+
+		// mark the first entry as used, so that the second one is evicted
+		_, ok := c.get(node(1, 0))
+		require.True(t, ok)
+
+		c.put(node(2, 0), "c", 40)
+
+		_, ok = c.get(node(1, 0))
+		assert.True(t, ok)
+		_, ok = c.get(node(1, 40))
+		assert.False(t, ok)
+		_, ok = c.get(node(2, 0))
+		assert.True(t, ok)
+		assert.Equal(t, int64(80), c.Size())
+	})
+
+	t.Run("replace an existing entry", func(t *testing.T) {
+		c := NewBlockCache(100, nil)
+		c.put(node(1, 0), "a", 40)
+		c.put(node(1, 0), "b", 10)
+
+		val, ok := c.get(node(1, 0))
+		require.True(t, ok)
+		assert.Equal(t, "b", val)
+		assert.Equal(t, int64(10), c.Size())
+	})
+
+	t.Run("do not cache entries larger than the budget", func(t *testing.T) {
+		c := NewBlockCache(100, nil)
+		c.put(node(1, 0), "a", 40)
+		c.put(node(1, 40), "b", 101)
+
+		_, ok := c.get(node(1, 0))
+		assert.True(t, ok)
+		_, ok = c.get(node(1, 40))
+		assert.False(t, ok)
+	})
+
+	t.Run("remove all entries of a segment", func(t *testing.T) {
+		c := NewBlockCache(100, nil)
+		c.put(node(1, 0), "a", 10)
+		c.put(blockCacheKey{segment: 1, kind: blockCacheKindBloomFilter, pos: primaryBloomFilter}, "b", 10)
+		c.put(node(2, 0), "c", 10)
+
+		c.removeSegment(1)
+
+		_, ok := c.get(node(1, 0))
+		assert.False(t, ok)
+		_, ok = c.get(node(2, 0))
+		assert.True(t, ok)
+		assert.Equal(t, int64(10), c.Size())
+	})
+}
+
+func TestBucketsSharingBlockCache(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+
+	for _, budget := range []int64{1024 * 1024, 64} {
+		t.Run(fmt.Sprintf("budget %d", budget), func(t *testing.T) {
+			cache := NewBlockCache(budget, nil)
+
+			buckets := make([]*Bucket, 2)
+			for i := range buckets {
+				b, err := NewBucket(ctx, t.TempDir(), "", logger, nil,
+					cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+					WithStrategy(StrategyReplace), WithSecondaryIndices(1),
+					WithPread(true), WithBlockCache(cache))
+				require.Nil(t, err)
+				defer b.Shutdown(ctx)
+				buckets[i] = b
+
+				for j := 0; j < 10; j++ {
+					key := []byte(fmt.Sprintf("key-%d-%d", i, j))
+					require.Nil(t, b.Put(key, key,
+						WithSecondaryKey(0, []byte(fmt.Sprintf("secondary-%d-%d", i, j)))))
+				}
+				require.Nil(t, b.FlushMemtable())
+			}
+
+			// read everything twice, the second time from the cache unless it was
+			// evicted
+			for round := 0; round < 2; round++ {
+				for i, b := range buckets {
+					for j := 0; j < 10; j++ {
+						key := []byte(fmt.Sprintf("key-%d-%d", i, j))
+
+						val, err := b.Get(key)
+						require.Nil(t, err)
+						assert.Equal(t, key, val)
+
+						val, err = b.GetBySecondary(0, []byte(fmt.Sprintf("secondary-%d-%d", i, j)))
+						require.Nil(t, err)
+						assert.Equal(t, key, val)
+					}
+
+					val, err := b.Get([]byte("missing"))
+					require.Nil(t, err)
+					assert.Nil(t, val)
+				}
+			}
+
+			assert.LessOrEqual(t, cache.Size(), budget)
+		})
+	}
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
 	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/diskio"
 	"github.com/weaviate/weaviate/entities/lsmkv"
 	"github.com/weaviate/weaviate/entities/storagestate"
 	"github.com/weaviate/weaviate/entities/storobj"
@@ -47,6 +48,7 @@ type Bucket struct {
 	flushAfterIdle    time.Duration
 	memtableThreshold uint64
 	memtableResizer   *memtableSizeAdvisor
+	flushThresholds   FlushThresholds
 	strategy          string
 	// Strategy inverted index is supposed to be created with, but existing
 	// segment files were created with different one.
@@ -60,6 +62,28 @@ type Bucket struct {
 	// Optional to avoid syscalls
 	mmapContents bool
 
+	// compression of new disk segments, see WithCompression
+	compression string
+
+	// see WithCompactionStrategy and WithCompactionSizeRatio
+	compactionStrategy  string
+	compactionSizeRatio float64
+
+	// see WithWALSyncPolicy
+	walSyncPolicy diskio.SyncPolicy
+
+	// see WithBlockCache
+	blockCache *BlockCache
+
+	// see WithDirectIO
+	directIO bool
+
+	// see WithTermDictionary
+	useTermDictionary bool
+
+	// see WithScrubInterval
+	scrubInterval time.Duration
+
 	// for backward compatibility
 	legacyMapSortingBeforeCompaction bool
 
@@ -108,6 +132,9 @@ func NewBucket(ctx context.Context, dir, rootDir string, logger logrus.FieldLogg
 		mmapContents:      true,
 		logger:            logger,
 		metrics:           metrics,
+
+		compactionStrategy:  CompactionStrategyLeveled,
+		compactionSizeRatio: DefaultCompactionSizeRatio,
 	}
 
 	for _, opt := range opts {
@@ -121,7 +148,9 @@ func NewBucket(ctx context.Context, dir, rootDir string, logger logrus.FieldLogg
 	}
 
 	sg, err := newSegmentGroup(dir, logger, b.legacyMapSortingBeforeCompaction,
-		metrics, b.strategy, b.monitorCount, compactionCallbacks, b.mmapContents)
+		metrics, b.strategy, b.monitorCount, compactionCallbacks, b.mmapContents,
+		b.compression, b.compactionStrategy, b.compactionSizeRatio, b.blockCache,
+		b.directIO, b.useTermDictionary, b.scrubInterval)
 	if err != nil {
 		return nil, errors.Wrap(err, "init disk segments")
 	}
@@ -130,13 +159,25 @@ func NewBucket(ctx context.Context, dir, rootDir string, logger logrus.FieldLogg
 	// while new implementation uses bitmaps and supposed to be RoaringSet,
 	// bucket and segmentgroup strategy is changed back to SetCollection
 	// (memtables will be created later on, with already modified strategy)
-	// TODO what if only WAL files exists, and there is no segment to get actual strategy?
 	if b.strategy == StrategyRoaringSet && len(sg.segments) > 0 &&
 		sg.segments[0].strategy == segmentindex.StrategySetCollection {
 		b.strategy = StrategySetCollection
 		b.desiredStrategy = StrategyRoaringSet
 		sg.strategy = StrategySetCollection
 	}
+	// If there is no segment yet, the actual strategy is taken from the
+	// write-ahead-logs, which are only recovered later on
+	if b.strategy == StrategyRoaringSet && len(sg.segments) == 0 {
+		commitType, ok, err := walCommitType(dir)
+		if err != nil {
+			return nil, errors.Wrap(err, "determine strategy of write-ahead-log")
+		}
+		if ok && commitType == CommitTypeCollection {
+			b.strategy = StrategySetCollection
+			b.desiredStrategy = StrategyRoaringSet
+			sg.strategy = StrategySetCollection
+		}
+	}
 	// As of v1.19 property's IndexInterval setting is replaced with
 	// IndexFilterable (roaring set) + IndexSearchable (map) and enabled by default.
 	// Buckets for text/text[] inverted indexes created before 1.19 have strategy
@@ -655,6 +696,8 @@ func (b *Bucket) setNewActiveMemtable() error {
 	if err != nil {
 		return err
 	}
+	mt.compression = b.compression
+	mt.commitlog.setSyncPolicy(b.walSyncPolicy)
 
 	b.active = mt
 	return nil
@@ -764,7 +807,10 @@ func (b *Bucket) flushAndSwitchIfThresholdsMet(shouldAbort cyclemanager.ShouldAb
 	walTooLarge := uint64(commitLogSize) >= b.walThreshold
 	dirtyButIdle := (b.active.Size() > 0 || commitLogSize > 0) &&
 		b.active.IdleDuration() >= b.flushAfterIdle
-	shouldSwitch := memtableTooLarge || walTooLarge || dirtyButIdle
+	thresholdExceeded := b.flushThresholds.exceeded(b.active.Size(),
+		uint64(commitLogSize), b.active.ActiveDuration())
+	shouldSwitch := memtableTooLarge || walTooLarge || dirtyButIdle ||
+		thresholdExceeded != ""
 
 	// If true, the parent shard has indicated that it has
 	// entered an immutable state. During this time, the
@@ -783,6 +829,13 @@ func (b *Bucket) flushAndSwitchIfThresholdsMet(shouldAbort cyclemanager.ShouldAb
 
 	b.flushLock.RUnlock()
 	if shouldSwitch {
+		if thresholdExceeded != "" {
+			b.logger.WithField("action", "lsm_memtable_flush").
+				WithField("path", b.dir).
+				WithField("threshold", thresholdExceeded).
+				Trace("flush threshold exceeded")
+		}
+
 		cycleLength := b.active.ActiveDuration()
 		if err := b.FlushAndSwitch(); err != nil {
 			b.logger.WithField("action", "lsm_memtable_flush").
@@ -76,6 +76,10 @@ func (b *Bucket) ListFiles(ctx context.Context) ([]string, error) {
 
 	err := filepath.WalkDir(bucketRoot, func(path string, d fs.DirEntry, err error) error {
 		if d.IsDir() {
+			if d.Name() == QuarantineDir {
+				// corrupt segments must not be backed up
+				return filepath.SkipDir
+			}
 			return nil
 		}
 		path, err2 := filepath.Rel(b.rootDir, path)
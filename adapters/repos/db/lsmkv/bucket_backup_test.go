@@ -79,15 +79,16 @@ func bucketBackup_ListFiles(ctx context.Context, t *testing.T, opts []BucketOpti
 	t.Run("assert expected bucket contents", func(t *testing.T) {
 		files, err := b.ListFiles(ctx)
 		assert.Nil(t, err)
-		assert.Len(t, files, 3)
+		assert.Len(t, files, 4)
 
-		exts := make([]string, 3)
+		exts := make([]string, 4)
 		for i, file := range files {
 			exts[i] = filepath.Ext(file)
 		}
 		assert.Contains(t, exts, ".db")    // the segment itself
 		assert.Contains(t, exts, ".bloom") // the segment's bloom filter
 		assert.Contains(t, exts, ".cna")   // the segment's count net additions
+		assert.Contains(t, exts, ".crc")   // the segment's checksums
 	})
 
 	err = b.Shutdown(context.Background())
@@ -0,0 +1,84 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import "time"
+
+// FlushThresholds bound the memory usage of a memtable and the time it takes
+// to recover it from its WAL. The memtable is flushed as soon as any of the
+// thresholds is reached. A zero value disables the respective threshold,
+// which leaves the regular thresholds of the bucket (see
+// WithMemtableThreshold, WithWalThreshold and WithIdleThreshold) in place.
+type FlushThresholds struct {
+	// MaxSize is the size of the memtable in bytes
+	MaxSize uint64
+
+	// MaxAge is the time since the memtable was created, regardless of
+	// whether it is still written to
+	MaxAge time.Duration
+
+	// MaxWALSize is the size of the commit log in bytes
+	MaxWALSize uint64
+}
+
+// exceeded returns the name of the first threshold that has been reached, or
+// an empty string if none has been reached yet.
+func (t FlushThresholds) exceeded(memtableSize, walSize uint64,
+	age time.Duration,
+) string {
+	if t.MaxSize > 0 && memtableSize >= t.MaxSize {
+		return "max_size"
+	}
+	if t.MaxWALSize > 0 && walSize >= t.MaxWALSize {
+		return "max_wal_size"
+	}
+	// an empty memtable has nothing to flush, no matter how old it is
+	if t.MaxAge > 0 && age >= t.MaxAge && (memtableSize > 0 || walSize > 0) {
+		return "max_age"
+	}
+	return ""
+}
+
+// WithFlushThresholds flushes the memtable as soon as any of the thresholds
+// is reached, in addition to the regular thresholds of the bucket.
+func WithFlushThresholds(thresholds FlushThresholds) BucketOption {
+	return func(b *Bucket) error {
+		b.flushThresholds = thresholds
+		return nil
+	}
+}
+
+// SetFlushThresholds replaces the thresholds set through WithFlushThresholds.
+// They are checked from the next flush cycle on.
+func (b *Bucket) SetFlushThresholds(thresholds FlushThresholds) {
+	b.flushLock.Lock()
+	defer b.flushLock.Unlock()
+
+	b.flushThresholds = thresholds
+}
+
+// SetFlushThresholds sets the flush thresholds of all buckets of the store,
+// including the ones created or loaded afterwards. Buckets created afterwards
+// keep their own thresholds if the store's thresholds are all zero.
+func (s *Store) SetFlushThresholds(thresholds FlushThresholds) {
+	s.bucketAccessLock.Lock()
+	defer s.bucketAccessLock.Unlock()
+
+	s.flushThresholds = thresholds
+	for _, b := range s.bucketsByName {
+		if b == nil {
+			continue
+		}
+
+		b.SetFlushThresholds(thresholds)
+	}
+}
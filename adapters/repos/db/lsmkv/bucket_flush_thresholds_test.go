@@ -0,0 +1,93 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlushThresholds(t *testing.T) {
+	thresholds := FlushThresholds{
+		MaxSize:    100,
+		MaxAge:     time.Minute,
+		MaxWALSize: 1000,
+	}
+
+	type test struct {
+		name         string
+		thresholds   FlushThresholds
+		memtableSize uint64
+		walSize      uint64
+		age          time.Duration
+		expected     string
+	}
+
+	tests := []test{
+		{
+			name:     "all zero",
+			expected: "",
+		},
+		{
+			name:         "disabled thresholds",
+			memtableSize: 1 << 30,
+			walSize:      1 << 30,
+			age:          time.Hour,
+			expected:     "",
+		},
+		{
+			name:         "below all thresholds",
+			thresholds:   thresholds,
+			memtableSize: 99,
+			walSize:      999,
+			age:          time.Second,
+			expected:     "",
+		},
+		{
+			name:         "max size",
+			thresholds:   thresholds,
+			memtableSize: 100,
+			walSize:      100,
+			expected:     "max_size",
+		},
+		{
+			name:         "max wal size",
+			thresholds:   thresholds,
+			memtableSize: 10,
+			walSize:      1000,
+			expected:     "max_wal_size",
+		},
+		{
+			name:         "max age",
+			thresholds:   thresholds,
+			memtableSize: 10,
+			walSize:      10,
+			age:          time.Minute,
+			expected:     "max_age",
+		},
+		{
+			name:       "max age with an empty memtable",
+			thresholds: thresholds,
+			age:        time.Hour,
+			expected:   "",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, test.thresholds.exceeded(
+				test.memtableSize, test.walSize, test.age))
+		})
+	}
+}
@@ -15,6 +15,7 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/diskio"
 )
 
 type BucketOption func(b *Bucket) error
@@ -75,6 +76,98 @@ func WithPread(with bool) BucketOption {
 	}
 }
 
+// WithCompression compresses the nodes of disk segments created by flushes
+// and compactions. Existing segments are converted once they are compacted.
+// Roaring set buckets are not affected, as their bitmaps are compressed
+// already.
+func WithCompression(compression string) BucketOption {
+	return func(b *Bucket) error {
+		if err := ValidCompression(compression); err != nil {
+			return err
+		}
+
+		b.compression = compression
+		return nil
+	}
+}
+
+// WithCompactionStrategy selects how segments are picked for compaction, see
+// CompactionStrategyLeveled and CompactionStrategyTiered. The strategy can be
+// changed between restarts, as both only merge neighboring segments.
+func WithCompactionStrategy(strategy string) BucketOption {
+	return func(b *Bucket) error {
+		if err := ValidCompactionStrategy(strategy); err != nil {
+			return err
+		}
+
+		b.compactionStrategy = strategy
+		return nil
+	}
+}
+
+// WithCompactionSizeRatio sets the largest size ratio between two segments
+// that the tiered compaction strategy still merges. It has no effect on the
+// leveled strategy.
+func WithCompactionSizeRatio(ratio float64) BucketOption {
+	return func(b *Bucket) error {
+		if ratio < 1 {
+			return errors.Errorf("compaction size ratio must be >= 1, got %v", ratio)
+		}
+
+		b.compactionSizeRatio = ratio
+		return nil
+	}
+}
+
+// WithWALSyncPolicy controls when writes to the WAL are fsynced, see
+// diskio.SyncModeNone, diskio.SyncModeAlways and diskio.SyncModeGroup. It
+// trades ingest throughput for durability in case of a machine crash.
+func WithWALSyncPolicy(policy diskio.SyncPolicy) BucketOption {
+	return func(b *Bucket) error {
+		if err := diskio.ValidSyncMode(policy.Mode); err != nil {
+			return err
+		}
+		if policy.MaxDelay < 0 {
+			return errors.Errorf("wal sync max delay must not be negative, got %v",
+				policy.MaxDelay)
+		}
+
+		b.walSyncPolicy = policy
+		return nil
+	}
+}
+
+// WithBlockCache keeps the bloom filters of the disk segments, and the nodes
+// of segments that are not memory-mapped, in a cache that can be shared with
+// other buckets. Without it, each segment holds its bloom filters itself.
+func WithBlockCache(cache *BlockCache) BucketOption {
+	return func(b *Bucket) error {
+		b.blockCache = cache
+		return nil
+	}
+}
+
+// WithDirectIO makes compactions and full cursor scans read the disk segments
+// with direct io, bypassing the page cache, so that these large sequential
+// reads do not evict the pages of hot data. Point reads and seeks are not
+// affected. Falls back to regular reads where direct io is not supported.
+func WithDirectIO(with bool) BucketOption {
+	return func(b *Bucket) error {
+		b.directIO = with
+		return nil
+	}
+}
+
+// WithTermDictionary keeps the keys of every disk segment in a term
+// dictionary, which allows MatchingKeys to find the keys matching a prefix or
+// wildcard pattern without scanning all keys of the bucket.
+func WithTermDictionary(with bool) BucketOption {
+	return func(b *Bucket) error {
+		b.useTermDictionary = with
+		return nil
+	}
+}
+
 func WithDynamicMemtableSizing(
 	initialMB, maxMB, minActiveSeconds, maxActiveSeconds int,
 ) BucketOption {
@@ -118,3 +211,13 @@ func WithMonitorCount() BucketOption {
 		return nil
 	}
 }
+
+// WithScrubInterval makes the bucket verify the checksums of all of its disk
+// segments in the background roughly once per interval. Corrupt segments are
+// quarantined, see QuarantineDir. A zero interval disables scrubbing.
+func WithScrubInterval(interval time.Duration) BucketOption {
+	return func(b *Bucket) error {
+		b.scrubInterval = interval
+		return nil
+	}
+}
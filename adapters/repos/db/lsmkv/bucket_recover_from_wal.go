@@ -13,6 +13,7 @@ package lsmkv
 
 import (
 	"context"
+	"encoding/binary"
 	"io"
 	"os"
 	"path/filepath"
@@ -115,3 +116,37 @@ func (b *Bucket) parseWALIntoMemtable(fname string) error {
 
 	return err
 }
+
+// walCommitType returns the type of the first commit found in the
+// write-ahead-logs of the bucket dir. The type tells the strategy which the
+// logs were written with.
+func walCommitType(dir string) (CommitType, bool, error) {
+	list, err := os.ReadDir(dir)
+	if err != nil {
+		return 0, false, err
+	}
+
+	for _, fileInfo := range list {
+		if filepath.Ext(fileInfo.Name()) != ".wal" {
+			continue
+		}
+
+		f, err := os.Open(filepath.Join(dir, fileInfo.Name()))
+		if err != nil {
+			return 0, false, err
+		}
+		var commitType CommitType
+		err = binary.Read(f, binary.LittleEndian, &commitType)
+		f.Close()
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			// an empty or truncated log does not tell the strategy
+			continue
+		}
+		if err != nil {
+			return 0, false, errors.Wrapf(err, "read commit type of %q", fileInfo.Name())
+		}
+		return commitType, true, nil
+	}
+
+	return 0, false, nil
+}
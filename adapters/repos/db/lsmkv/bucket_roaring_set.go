@@ -98,6 +98,18 @@ func (b *Bucket) RoaringSetGet(key []byte) (*sroar.Bitmap, error) {
 	return segments.Flatten(), nil
 }
 
+// RoaringSetEstimateCardinality estimates the number of docs stored for the
+// keys between from and to, both inclusive, where a nil bound is unbounded.
+// The estimate is based on the stats kept for every disk segment, the docs
+// in the memtables are not considered.
+func (b *Bucket) RoaringSetEstimateCardinality(from, to []byte) (int, error) {
+	if err := checkStrategyRoaringSet(b.strategy); err != nil {
+		return 0, err
+	}
+
+	return int(b.disk.roaringSetEstimate(from, to)), nil
+}
+
 func checkStrategyRoaringSet(bucketStrat string) error {
 	if bucketStrat == StrategyRoaringSet {
 		return nil
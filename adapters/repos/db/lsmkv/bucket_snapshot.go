@@ -0,0 +1,163 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// BucketSnapshot is a consistent view of a bucket at the time it was taken.
+// It is not affected by later writes, flushes or compactions. The segments of
+// the snapshot stay open until it is released, so snapshots should be short
+// lived. It needs to be released using the .Release() method.
+type BucketSnapshot struct {
+	segments  []*segment
+	memtables [][]*binarySearchNode
+
+	releaseOnce sync.Once
+}
+
+// Snapshot pins the current disk segments and copies the contents of the
+// memtables. It is only supported for the 'replace' strategy.
+func (b *Bucket) Snapshot() (*BucketSnapshot, error) {
+	if b.strategy != StrategyReplace {
+		return nil, errors.Errorf("Snapshot() called on strategy other than 'replace'")
+	}
+
+	b.flushLock.RLock()
+	defer b.flushLock.RUnlock()
+
+	snapshot := &BucketSnapshot{segments: b.disk.pinSegments()}
+
+	// the flushing memtable may already be part of the pinned segments, which
+	// is not a problem, as the memtables take precedence over the segments
+	if b.flushing != nil {
+		snapshot.memtables = append(snapshot.memtables, b.flushing.copyNodes())
+	}
+	snapshot.memtables = append(snapshot.memtables, b.active.copyNodes())
+
+	return snapshot, nil
+}
+
+// Cursor iterates over the snapshot. Unlike Bucket.Cursor it does not hold
+// any locks on the bucket. It needs to be closed using the .Close() method,
+// and must not be used after the snapshot was released.
+func (s *BucketSnapshot) Cursor() *CursorReplace {
+	innerCursors := make([]innerCursorReplace, 0, len(s.segments)+len(s.memtables))
+	segmentCursors := make([]*segmentCursorReplace, len(s.segments))
+	for i, seg := range s.segments {
+		segmentCursors[i] = seg.newCursor()
+		// a compaction may have replaced the file of the segment, so it must
+		// only be read through the memory that is still mapped
+		segmentCursors[i].noDirectIO = true
+		innerCursors = append(innerCursors, segmentCursors[i])
+	}
+
+	for _, nodes := range s.memtables {
+		innerCursors = append(innerCursors, &memtableCursor{
+			data:   nodes,
+			lock:   func() {},
+			unlock: func() {},
+		})
+	}
+
+	return &CursorReplace{
+		// cursor are in order from oldest to newest, with the memtable cursors
+		// being at the very top
+		innerCursors: innerCursors,
+		unlock: func() {
+			for _, c := range segmentCursors {
+				c.close()
+			}
+		},
+	}
+}
+
+// Release unpins the segments of the snapshot. Segments which were replaced
+// in the meantime are closed.
+func (s *BucketSnapshot) Release() error {
+	var err error
+	s.releaseOnce.Do(func() {
+		for _, seg := range s.segments {
+			if unpinErr := seg.unpin(); unpinErr != nil && err == nil {
+				err = errors.Wrapf(unpinErr, "release segment %s", seg.path)
+			}
+		}
+		s.segments = nil
+		s.memtables = nil
+	})
+	return err
+}
+
+func (sg *SegmentGroup) pinSegments() []*segment {
+	sg.maintenanceLock.RLock()
+	defer sg.maintenanceLock.RUnlock()
+
+	segments := make([]*segment, len(sg.segments))
+	for i, seg := range sg.segments {
+		seg.pin()
+		segments[i] = seg
+	}
+	return segments
+}
+
+// copyNodes copies the nodes of a 'replace' memtable in order. The copies are
+// not affected by later writes to the memtable.
+func (m *Memtable) copyNodes() []*binarySearchNode {
+	m.RLock()
+	defer m.RUnlock()
+
+	nodes := m.key.flattenInOrder()
+	out := make([]*binarySearchNode, len(nodes))
+	for i, node := range nodes {
+		out[i] = &binarySearchNode{
+			key:       node.key,
+			value:     node.value,
+			tombstone: node.tombstone,
+		}
+	}
+	return out
+}
+
+func (s *segment) pin() {
+	s.pinLock.Lock()
+	defer s.pinLock.Unlock()
+
+	s.pins++
+}
+
+func (s *segment) unpin() error {
+	s.pinLock.Lock()
+	defer s.pinLock.Unlock()
+
+	s.pins--
+	if s.pins == 0 && s.closeOnUnpin {
+		return s.close()
+	}
+	return nil
+}
+
+// closeUnlessPinned closes the segment or, if it is pinned by a snapshot,
+// defers closing it to the last unpin. The files of a pinned segment can still
+// be dropped, as its contents stay accessible until it is closed.
+func (s *segment) closeUnlessPinned() error {
+	s.pinLock.Lock()
+	defer s.pinLock.Unlock()
+
+	if s.pins > 0 {
+		s.closeOnUnpin = true
+		return nil
+	}
+	return s.close()
+}
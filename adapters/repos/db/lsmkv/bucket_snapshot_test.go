@@ -0,0 +1,120 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+)
+
+func TestBucketSnapshot(t *testing.T) {
+	t.Run("mmap", func(t *testing.T) {
+		testBucketSnapshot(t, []BucketOption{WithStrategy(StrategyReplace)})
+	})
+	t.Run("pread", func(t *testing.T) {
+		testBucketSnapshot(t, []BucketOption{WithStrategy(StrategyReplace), WithPread(true)})
+	})
+}
+
+func testBucketSnapshot(t *testing.T, opts []BucketOption) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+	b, err := NewBucket(ctx, t.TempDir(), "", logger, nil,
+		cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(), opts...)
+	require.Nil(t, err)
+	defer b.Shutdown(ctx)
+
+	key := func(i int) []byte { return []byte(fmt.Sprintf("key-%03d", i)) }
+
+	// two segments and the active memtable
+	for i := 0; i < 25; i++ {
+		require.Nil(t, b.Put(key(i), []byte(fmt.Sprintf("value-%d", i))))
+		if i == 9 || i == 19 {
+			require.Nil(t, b.FlushAndSwitch())
+		}
+	}
+
+	snapshot, err := b.Snapshot()
+	require.Nil(t, err)
+
+	// update, delete and add keys and compact the segments
+	require.Nil(t, b.Put(key(0), []byte("updated")))
+	require.Nil(t, b.Put(key(20), []byte("updated")))
+	require.Nil(t, b.Delete(key(1)))
+	require.Nil(t, b.Put(key(30), []byte("value-30")))
+	require.Nil(t, b.FlushAndSwitch())
+	require.Equal(t, 3, b.disk.Len())
+	for b.disk.eligibleForCompaction() {
+		require.Nil(t, b.disk.compactOnce())
+	}
+	require.Less(t, b.disk.Len(), 3)
+
+	readAll := func(c *CursorReplace) ([]string, []string) {
+		defer c.Close()
+		var keys, values []string
+		for k, v := c.First(); k != nil; k, v = c.Next() {
+			keys = append(keys, string(k))
+			values = append(values, string(v))
+		}
+		return keys, values
+	}
+
+	t.Run("the snapshot is not affected by writes and compactions", func(t *testing.T) {
+		keys, values := readAll(snapshot.Cursor())
+		require.Len(t, keys, 25)
+		for i := range keys {
+			assert.Equal(t, string(key(i)), keys[i])
+			assert.Equal(t, fmt.Sprintf("value-%d", i), values[i])
+		}
+	})
+
+	t.Run("seek in the snapshot", func(t *testing.T) {
+		c := snapshot.Cursor()
+		defer c.Close()
+		k, v := c.Seek(key(20))
+		assert.Equal(t, key(20), k)
+		assert.Equal(t, []byte("value-20"), v)
+		k, _ = c.Next()
+		assert.Equal(t, key(21), k)
+	})
+
+	require.Nil(t, snapshot.Release())
+	// releasing twice is a noop
+	require.Nil(t, snapshot.Release())
+
+	t.Run("the bucket contains the new state", func(t *testing.T) {
+		keys, values := readAll(b.Cursor())
+		require.Len(t, keys, 25)
+		assert.Equal(t, "updated", values[0])
+		assert.Equal(t, string(key(2)), keys[1])
+		assert.Equal(t, string(key(30)), keys[24])
+	})
+}
+
+func TestBucketSnapshotOnlyForReplaceStrategy(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+	b, err := NewBucket(ctx, t.TempDir(), "", logger, nil,
+		cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+		WithStrategy(StrategySetCollection))
+	require.Nil(t, err)
+	defer b.Shutdown(ctx)
+
+	_, err = b.Snapshot()
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,101 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/pkg/errors"
+)
+
+// HasTermDictionary is true if the disk segments of the bucket keep a term
+// dictionary, see WithTermDictionary
+func (b *Bucket) HasTermDictionary() bool {
+	return b.useTermDictionary
+}
+
+// MatchingKeys returns the keys of the bucket which are accepted by the
+// automaton, in sorted order. Only the parts of the term dictionaries of the
+// disk segments the automaton can match are visited, instead of all keys.
+//
+// Keys are returned regardless of their values, the values of a key may have
+// been deleted in the meantime.
+func (b *Bucket) MatchingKeys(automaton Automaton) ([][]byte, error) {
+	if !b.useTermDictionary {
+		return nil, errors.Errorf("bucket %s has no term dictionary", b.dir)
+	}
+
+	b.flushLock.RLock()
+	defer b.flushLock.RUnlock()
+
+	keys, err := b.disk.matchingKeys(automaton)
+	if err != nil {
+		return nil, err
+	}
+
+	if b.flushing != nil {
+		keys = b.flushing.matchingKeys(automaton, keys)
+	}
+	keys = b.active.matchingKeys(automaton, keys)
+
+	sort.Slice(keys, func(i, j int) bool {
+		return bytes.Compare(keys[i], keys[j]) < 0
+	})
+
+	// the same key may be contained in several segments and memtables
+	unique := keys[:0]
+	for i, key := range keys {
+		if i > 0 && bytes.Equal(key, keys[i-1]) {
+			continue
+		}
+		unique = append(unique, key)
+	}
+	return unique, nil
+}
+
+func (sg *SegmentGroup) matchingKeys(automaton Automaton) ([][]byte, error) {
+	sg.maintenanceLock.RLock()
+	defer sg.maintenanceLock.RUnlock()
+
+	var out [][]byte
+	for _, segment := range sg.segments {
+		if segment.termDictionary == nil {
+			return nil, errors.Errorf("segment %s has no term dictionary", segment.path)
+		}
+		out = segment.termDictionary.matchingKeys(automaton, out)
+	}
+	return out, nil
+}
+
+func (m *Memtable) matchingKeys(automaton Automaton, out [][]byte) [][]byte {
+	m.RLock()
+	defer m.RUnlock()
+
+	visit := func(key []byte) {
+		if AutomatonMatches(automaton, key) {
+			out = append(out, append([]byte{}, key...))
+		}
+	}
+
+	switch m.strategy {
+	case StrategyReplace:
+		m.key.visitKeys(visit)
+	case StrategySetCollection:
+		m.keyMulti.visitKeys(visit)
+	case StrategyMapCollection:
+		m.keyMap.visitKeys(visit)
+	case StrategyRoaringSet:
+		m.roaringSet.VisitKeys(visit)
+	}
+	return out
+}
@@ -19,6 +19,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/roaringset"
+	"github.com/weaviate/weaviate/entities/diskio"
 )
 
 type commitLogger struct {
@@ -26,6 +27,7 @@ type commitLogger struct {
 	writer *bufio.Writer
 	n      atomic.Int64
 	path   string
+	syncer *diskio.Syncer
 
 	// e.g. when recovering from an existing log, we do not want to write into a
 	// new log again
@@ -71,6 +73,7 @@ func newCommitLogger(path string) (*commitLogger, error) {
 	}
 
 	out.file = f
+	out.syncer = diskio.NewSyncer(f, diskio.SyncPolicy{Mode: diskio.SyncModeNone})
 
 	out.writer = bufio.NewWriter(f)
 	return out, nil
@@ -157,6 +160,10 @@ func (cl *commitLogger) close() error {
 		return err
 	}
 
+	if err := cl.syncer.Close(); err != nil {
+		return err
+	}
+
 	return cl.file.Close()
 }
 
@@ -175,3 +182,8 @@ func (cl *commitLogger) delete() error {
 func (cl *commitLogger) flushBuffers() error {
 	return cl.writer.Flush()
 }
+
+// setSyncPolicy must be called before the first write
+func (cl *commitLogger) setSyncPolicy(policy diskio.SyncPolicy) {
+	cl.syncer = diskio.NewSyncer(cl.file, policy)
+}
@@ -86,6 +86,15 @@ func TestCompaction(t *testing.T) {
 				WithStrategy(StrategyReplace),
 			},
 		},
+		{
+			name: "compactionReplaceStrategy_Tiered",
+			f:    compactionReplaceStrategy,
+			opts: []BucketOption{
+				WithStrategy(StrategyReplace),
+				WithCompactionStrategy(CompactionStrategyTiered),
+				WithCompactionSizeRatio(100),
+			},
+		},
 		{
 			name: "compactionSetStrategy",
 			f:    compactionSetStrategy,
@@ -100,6 +109,15 @@ func TestCompaction(t *testing.T) {
 				WithStrategy(StrategySetCollection),
 			},
 		},
+		{
+			name: "compactionSetStrategy_Tiered",
+			f:    compactionSetStrategy,
+			opts: []BucketOption{
+				WithStrategy(StrategySetCollection),
+				WithCompactionStrategy(CompactionStrategyTiered),
+				WithCompactionSizeRatio(100),
+			},
+		},
 		{
 			name: "compactionMapStrategy",
 			f:    compactionMapStrategy,
@@ -143,6 +161,47 @@ func TestCompaction(t *testing.T) {
 				WithStrategy(StrategyMapCollection),
 			},
 		},
+		{
+			name: "compactionReplaceStrategy_Zstd",
+			f:    compactionReplaceStrategy,
+			opts: []BucketOption{
+				WithStrategy(StrategyReplace),
+				WithCompression(CompressionZstd),
+			},
+		},
+		{
+			name: "compactionReplaceStrategy_WithSecondaryKeys_Snappy",
+			f:    compactionReplaceStrategy_WithSecondaryKeys,
+			opts: []BucketOption{
+				WithStrategy(StrategyReplace),
+				WithSecondaryIndices(1),
+				WithCompression(CompressionSnappy),
+			},
+		},
+		{
+			name: "compactionSetStrategy_Zstd",
+			f:    compactionSetStrategy,
+			opts: []BucketOption{
+				WithStrategy(StrategySetCollection),
+				WithCompression(CompressionZstd),
+			},
+		},
+		{
+			name: "compactionMapStrategy_Snappy",
+			f:    compactionMapStrategy,
+			opts: []BucketOption{
+				WithStrategy(StrategyMapCollection),
+				WithCompression(CompressionSnappy),
+			},
+		},
+		{
+			name: "compactionMapStrategy_FrequentPutDeleteOperations_Zstd",
+			f:    compactionMapStrategy_FrequentPutDeleteOperations,
+			opts: []BucketOption{
+				WithStrategy(StrategyMapCollection),
+				WithCompression(CompressionZstd),
+			},
+		},
 		{
 			name: "compactionRoaringSet",
 			f:    compactionRoaringSet,
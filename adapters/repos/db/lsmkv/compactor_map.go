@@ -36,6 +36,9 @@ type compactorMap struct {
 
 	scratchSpacePath string
 
+	// nil if the new segment is not compressed
+	compressor *nodeCompressor
+
 	// for backward-compatibility with states where the disk state for maps was
 	// not guaranteed to be sorted yet
 	requiresSorting bool
@@ -43,7 +46,7 @@ type compactorMap struct {
 
 func newCompactorMapCollection(w io.WriteSeeker,
 	c1, c2 *segmentCursorCollectionReusable, level, secondaryIndexCount uint16,
-	scratchSpacePath string, requiresSorting bool,
+	scratchSpacePath string, requiresSorting bool, compressor *nodeCompressor,
 ) *compactorMap {
 	return &compactorMap{
 		c1:                  c1,
@@ -54,6 +57,7 @@ func newCompactorMapCollection(w io.WriteSeeker,
 		secondaryIndexCount: secondaryIndexCount,
 		scratchSpacePath:    scratchSpacePath,
 		requiresSorting:     requiresSorting,
+		compressor:          compressor,
 	}
 }
 
@@ -78,7 +82,7 @@ func (c *compactorMap) do() error {
 
 	dataEnd := uint64(kis[len(kis)-1].ValueEnd)
 
-	if err := c.writeHeader(c.currentLevel+1, 0, c.secondaryIndexCount,
+	if err := c.writeHeader(c.currentLevel+1, c.compressor.version(), c.secondaryIndexCount,
 		dataEnd); err != nil {
 		return errors.Wrap(err, "write header")
 	}
@@ -197,11 +201,16 @@ func (c *compactorMap) writeKeys() ([]segmentindex.Key, error) {
 func (c *compactorMap) writeIndividualNode(offset int, key []byte,
 	values []value,
 ) (segmentindex.Key, error) {
-	return segmentCollectionNode{
+	// the reusable cursors overwrite the key buffer when they advance, while
+	// the returned index key is held until all keys are written
+	keyCopy := make([]byte, len(key))
+	copy(keyCopy, key)
+
+	return c.compressor.writeNode(c.bufw, offset, segmentCollectionNode{
 		values:     values,
-		primaryKey: key,
+		primaryKey: keyCopy,
 		offset:     offset,
-	}.KeyIndexAndWriteTo(c.bufw)
+	})
 }
 
 func (c *compactorMap) writeIndices(keys []segmentindex.Key) error {
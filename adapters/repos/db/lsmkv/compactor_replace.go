@@ -35,11 +35,14 @@ type compactorReplace struct {
 	w                io.WriteSeeker
 	bufw             *bufio.Writer
 	scratchSpacePath string
+
+	// nil if the new segment is not compressed
+	compressor *nodeCompressor
 }
 
 func newCompactorReplace(w io.WriteSeeker,
 	c1, c2 *segmentCursorReplace, level, secondaryIndexCount uint16,
-	scratchSpacePath string,
+	scratchSpacePath string, compressor *nodeCompressor,
 ) *compactorReplace {
 	return &compactorReplace{
 		c1:                  c1,
@@ -49,6 +52,7 @@ func newCompactorReplace(w io.WriteSeeker,
 		currentLevel:        level,
 		secondaryIndexCount: secondaryIndexCount,
 		scratchSpacePath:    scratchSpacePath,
+		compressor:          compressor,
 	}
 }
 
@@ -73,7 +77,8 @@ func (c *compactorReplace) do() error {
 
 	dataEnd := uint64(kis[len(kis)-1].ValueEnd)
 
-	if err := c.writeHeader(c.currentLevel+1, 0, c.secondaryIndexCount, dataEnd); err != nil {
+	if err := c.writeHeader(c.currentLevel+1, c.compressor.version(),
+		c.secondaryIndexCount, dataEnd); err != nil {
 		return errors.Wrap(err, "write header")
 	}
 
@@ -162,7 +167,7 @@ func (c *compactorReplace) writeIndividualNode(offset int, key, value []byte,
 		secondaryKeys:       secondaryKeys,
 	}
 
-	return segNode.KeyIndexAndWriteTo(c.bufw)
+	return c.compressor.writeNode(c.bufw, offset, &segNode)
 }
 
 func (c *compactorReplace) writeIndices(keys []segmentindex.Key) error {
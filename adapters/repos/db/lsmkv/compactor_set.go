@@ -34,11 +34,14 @@ type compactorSet struct {
 	bufw *bufio.Writer
 
 	scratchSpacePath string
+
+	// nil if the new segment is not compressed
+	compressor *nodeCompressor
 }
 
 func newCompactorSetCollection(w io.WriteSeeker,
 	c1, c2 *segmentCursorCollection, level, secondaryIndexCount uint16,
-	scratchSpacePath string,
+	scratchSpacePath string, compressor *nodeCompressor,
 ) *compactorSet {
 	return &compactorSet{
 		c1:                  c1,
@@ -48,6 +51,7 @@ func newCompactorSetCollection(w io.WriteSeeker,
 		currentLevel:        level,
 		secondaryIndexCount: secondaryIndexCount,
 		scratchSpacePath:    scratchSpacePath,
+		compressor:          compressor,
 	}
 }
 
@@ -72,7 +76,7 @@ func (c *compactorSet) do() error {
 
 	dataEnd := uint64(kis[len(kis)-1].ValueEnd)
 
-	if err := c.writeHeader(c.currentLevel+1, 0, c.secondaryIndexCount,
+	if err := c.writeHeader(c.currentLevel+1, c.compressor.version(), c.secondaryIndexCount,
 		dataEnd); err != nil {
 		return errors.Wrap(err, "write header")
 	}
@@ -153,11 +157,11 @@ func (c *compactorSet) writeKeys() ([]segmentindex.Key, error) {
 func (c *compactorSet) writeIndividualNode(offset int, key []byte,
 	values []value,
 ) (segmentindex.Key, error) {
-	return (&segmentCollectionNode{
+	return c.compressor.writeNode(c.bufw, offset, &segmentCollectionNode{
 		values:     values,
 		primaryKey: key,
 		offset:     offset,
-	}).KeyIndexAndWriteTo(c.bufw)
+	})
 }
 
 func (c *compactorSet) writeIndices(keys []segmentindex.Key) error {
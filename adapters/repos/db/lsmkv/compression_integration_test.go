@@ -0,0 +1,216 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package lsmkv
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+)
+
+func TestCompressionMixedSegments(t *testing.T) {
+	ctx := testCtx()
+	tests := bucketIntegrationTests{
+		{
+			name: "compressionMixedSegmentsReplace",
+			f:    compressionMixedSegmentsReplace,
+			opts: []BucketOption{
+				WithStrategy(StrategyReplace),
+				WithSecondaryIndices(1),
+			},
+		},
+		{
+			name: "compressionMixedSegmentsSet",
+			f:    compressionMixedSegmentsSet,
+			opts: []BucketOption{
+				WithStrategy(StrategySetCollection),
+			},
+		},
+	}
+	tests.run(ctx, t)
+}
+
+// compressionMixedSegmentsReplace writes one segment per compression setting
+// into the same bucket, reopening it in between, and verifies that segments
+// of different versions can be read and compacted together.
+func compressionMixedSegmentsReplace(ctx context.Context, t *testing.T, opts []BucketOption) {
+	dirName := t.TempDir()
+	compressions := []string{CompressionNone, CompressionZstd, CompressionSnappy}
+	perSegment := 50
+
+	var bucket *Bucket
+	openBucket := func(t *testing.T, compression string) {
+		b, err := NewBucket(ctx, dirName, "", nullLogger(), nil,
+			cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+			append(opts, WithCompression(compression))...)
+		require.Nil(t, err)
+
+		// so big it effectively never triggers as part of this test
+		b.SetMemtableThreshold(1e9)
+		bucket = b
+	}
+
+	key := func(i int) []byte { return []byte(fmt.Sprintf("key-%03d", i)) }
+	secondary := func(i int) []byte { return []byte(fmt.Sprintf("secondary-%03d", i)) }
+	value := func(i, segment int) []byte {
+		return []byte(fmt.Sprintf("value-%03d-written-in-segment-%d", i, segment))
+	}
+	total := perSegment * (len(compressions) + 1) / 2
+
+	for segment, compression := range compressions {
+		t.Run(fmt.Sprintf("import segment with compression %q", compression), func(t *testing.T) {
+			openBucket(t, compression)
+
+			// each segment overlaps with half of the previous one
+			start := segment * perSegment / 2
+			for i := start; i < start+perSegment; i++ {
+				require.Nil(t, bucket.Put(key(i), value(i, segment),
+					WithSecondaryKey(0, secondary(i))))
+			}
+			require.Nil(t, bucket.Delete(key(start), WithSecondaryKey(0, secondary(start))))
+
+			require.Nil(t, bucket.FlushAndSwitch())
+			require.Nil(t, bucket.Shutdown(ctx))
+		})
+	}
+
+	verify := func(t *testing.T) {
+		lastSegment := func(i int) int {
+			last := 0
+			for segment := range compressions {
+				start := segment * perSegment / 2
+				if i >= start && i < start+perSegment {
+					last = segment
+				}
+			}
+			return last
+		}
+		deleted := func(i int) bool {
+			return i%(perSegment/2) == 0 && i/(perSegment/2) < len(compressions) &&
+				lastSegment(i) == i/(perSegment/2)
+		}
+
+		expectedCount := 0
+		for i := 0; i < total; i++ {
+			v, err := bucket.Get(key(i))
+			require.Nil(t, err)
+			v2, err := bucket.GetBySecondary(0, secondary(i))
+			require.Nil(t, err)
+			if deleted(i) {
+				assert.Nil(t, v)
+				assert.Nil(t, v2)
+				continue
+			}
+			expectedCount++
+			assert.Equal(t, value(i, lastSegment(i)), v)
+			assert.Equal(t, value(i, lastSegment(i)), v2)
+		}
+
+		c := bucket.Cursor()
+		defer c.Close()
+		seen := 0
+		for k, v := c.First(); k != nil; k, v = c.Next() {
+			seen++
+			assert.NotNil(t, v)
+		}
+		assert.Equal(t, expectedCount, seen)
+		assert.Equal(t, expectedCount, bucket.Count())
+	}
+
+	t.Run("verify before compaction", func(t *testing.T) {
+		openBucket(t, CompressionZstd)
+		verify(t)
+	})
+
+	t.Run("compact until no longer eligible", func(t *testing.T) {
+		for bucket.disk.eligibleForCompaction() {
+			require.Nil(t, bucket.disk.compactOnce())
+		}
+	})
+
+	t.Run("verify after compaction", verify)
+
+	t.Run("verify after reopening without compression", func(t *testing.T) {
+		require.Nil(t, bucket.Shutdown(ctx))
+		openBucket(t, CompressionNone)
+		verify(t)
+		require.Nil(t, bucket.Shutdown(ctx))
+	})
+}
+
+func compressionMixedSegmentsSet(ctx context.Context, t *testing.T, opts []BucketOption) {
+	dirName := t.TempDir()
+	compressions := []string{CompressionSnappy, CompressionNone, CompressionZstd}
+	key := []byte("the-key")
+
+	var bucket *Bucket
+	openBucket := func(t *testing.T, compression string) {
+		b, err := NewBucket(ctx, dirName, "", nullLogger(), nil,
+			cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+			append(opts, WithCompression(compression))...)
+		require.Nil(t, err)
+
+		// so big it effectively never triggers as part of this test
+		b.SetMemtableThreshold(1e9)
+		bucket = b
+	}
+
+	for segment, compression := range compressions {
+		t.Run(fmt.Sprintf("import segment with compression %q", compression), func(t *testing.T) {
+			openBucket(t, compression)
+			require.Nil(t, bucket.SetAdd(key, [][]byte{
+				[]byte(fmt.Sprintf("value-%d", segment)),
+			}))
+			if segment > 0 {
+				require.Nil(t, bucket.SetDeleteSingle(key, []byte("value-0")))
+			}
+			require.Nil(t, bucket.FlushAndSwitch())
+			require.Nil(t, bucket.Shutdown(ctx))
+		})
+	}
+
+	expected := [][]byte{[]byte("value-1"), []byte("value-2")}
+
+	t.Run("verify before compaction", func(t *testing.T) {
+		openBucket(t, CompressionZstd)
+		values, err := bucket.SetList(key)
+		require.Nil(t, err)
+		assert.ElementsMatch(t, expected, values)
+	})
+
+	t.Run("compact until no longer eligible", func(t *testing.T) {
+		for bucket.disk.eligibleForCompaction() {
+			require.Nil(t, bucket.disk.compactOnce())
+		}
+	})
+
+	t.Run("verify after compaction", func(t *testing.T) {
+		values, err := bucket.SetList(key)
+		require.Nil(t, err)
+		assert.ElementsMatch(t, expected, values)
+
+		c := bucket.SetCursor()
+		k, v := c.First()
+		assert.Equal(t, key, k)
+		assert.ElementsMatch(t, expected, v)
+		c.Close()
+
+		require.Nil(t, bucket.Shutdown(ctx))
+	})
+}
@@ -12,12 +12,17 @@
 package lsmkv
 
 import (
+	"github.com/weaviate/weaviate/entities/diskio"
 	"github.com/weaviate/weaviate/entities/lsmkv"
 )
 
 type segmentCursorCollection struct {
 	segment    *segment
 	nextOffset uint64
+
+	// set once a full scan starts on a segment with direct io, see
+	// WithDirectIO
+	direct *diskio.DirectReader
 }
 
 func (s *segment) newCollectionCursor() *segmentCursorCollection {
@@ -30,11 +35,18 @@ func (sg *SegmentGroup) newCollectionCursors() ([]innerCursorCollection, func())
 	sg.maintenanceLock.RLock()
 	out := make([]innerCursorCollection, len(sg.segments))
 
+	cursors := make([]*segmentCursorCollection, len(sg.segments))
 	for i, segment := range sg.segments {
-		out[i] = segment.newCollectionCursor()
+		cursors[i] = segment.newCollectionCursor()
+		out[i] = cursors[i]
 	}
 
-	return out, sg.maintenanceLock.RUnlock
+	return out, func() {
+		for _, c := range cursors {
+			c.close()
+		}
+		sg.maintenanceLock.RUnlock()
+	}
 }
 
 func (s *segmentCursorCollection) seek(key []byte) ([]byte, []value, error) {
@@ -73,6 +85,7 @@ func (s *segmentCursorCollection) next() ([]byte, []value, error) {
 }
 
 func (s *segmentCursorCollection) first() ([]byte, []value, error) {
+	s.openDirect()
 	s.nextOffset = s.segment.dataStartPos
 
 	parsed, err := s.parseCollectionNode(nodeOffset{start: s.nextOffset})
@@ -88,10 +101,22 @@ func (s *segmentCursorCollection) first() ([]byte, []value, error) {
 }
 
 func (s *segmentCursorCollection) parseCollectionNode(offset nodeOffset) (segmentCollectionNode, error) {
-	r, err := s.segment.newNodeReader(offset)
-	if err != nil {
-		return segmentCollectionNode{}, err
+	if s.direct == nil {
+		return s.segment.parseCollectionNode(offset)
 	}
+	return s.segment.parseCollectionNodeFrom(directNodeReader(s.direct, offset.start))
+}
+
+// openDirect makes the scan which is about to start bypass the page cache if
+// the segment is configured to do so
+func (s *segmentCursorCollection) openDirect() {
+	if s.direct == nil {
+		s.direct = s.segment.newDirectReader()
+	}
+}
 
-	return ParseCollectionNode(r)
+// close releases the file opened for direct io, if any
+func (s *segmentCursorCollection) close() {
+	closeDirectReader(s.direct)
+	s.direct = nil
 }
@@ -12,6 +12,7 @@
 package lsmkv
 
 import (
+	"github.com/weaviate/weaviate/entities/diskio"
 	"github.com/weaviate/weaviate/entities/lsmkv"
 )
 
@@ -19,6 +20,10 @@ type segmentCursorCollectionReusable struct {
 	segment    *segment
 	nextOffset uint64
 	nodeBuf    segmentCollectionNode
+
+	// set once a full scan starts on a segment with direct io, see
+	// WithDirectIO
+	direct *diskio.DirectReader
 }
 
 func (s *segment) newCollectionCursorReusable() *segmentCursorCollectionReusable {
@@ -61,6 +66,7 @@ func (s *segmentCursorCollectionReusable) next() ([]byte, []value, error) {
 }
 
 func (s *segmentCursorCollectionReusable) first() ([]byte, []value, error) {
+	s.openDirect()
 	s.nextOffset = s.segment.dataStartPos
 
 	err := s.parseCollectionNodeInto(nodeOffset{start: s.nextOffset})
@@ -74,10 +80,23 @@ func (s *segmentCursorCollectionReusable) first() ([]byte, []value, error) {
 }
 
 func (s *segmentCursorCollectionReusable) parseCollectionNodeInto(offset nodeOffset) error {
-	r, err := s.segment.newNodeReader(offset)
-	if err != nil {
-		return err
+	if s.direct == nil {
+		return s.segment.parseCollectionNodeInto(offset, &s.nodeBuf)
+	}
+	return s.segment.parseCollectionNodeFromInto(
+		directNodeReader(s.direct, offset.start), &s.nodeBuf)
+}
+
+// openDirect makes the scan which is about to start bypass the page cache if
+// the segment is configured to do so
+func (s *segmentCursorCollectionReusable) openDirect() {
+	if s.direct == nil {
+		s.direct = s.segment.newDirectReader()
 	}
+}
 
-	return ParseCollectionNodeInto(r, &s.nodeBuf)
+// close releases the file opened for direct io, if any
+func (s *segmentCursorCollectionReusable) close() {
+	closeDirectReader(s.direct)
+	s.direct = nil
 }
@@ -11,11 +11,18 @@
 
 package lsmkv
 
-import "github.com/weaviate/weaviate/entities/lsmkv"
+import (
+	"github.com/weaviate/weaviate/entities/diskio"
+	"github.com/weaviate/weaviate/entities/lsmkv"
+)
 
 type segmentCursorMap struct {
 	segment    *segment
 	nextOffset uint64
+
+	// set once a full scan starts on a segment with direct io, see
+	// WithDirectIO
+	direct *diskio.DirectReader
 }
 
 func (s *segment) newMapCursor() *segmentCursorMap {
@@ -28,11 +35,18 @@ func (sg *SegmentGroup) newMapCursors() ([]innerCursorMap, func()) {
 	sg.maintenanceLock.RLock()
 	out := make([]innerCursorMap, len(sg.segments))
 
+	cursors := make([]*segmentCursorMap, len(sg.segments))
 	for i, segment := range sg.segments {
-		out[i] = segment.newMapCursor()
+		cursors[i] = segment.newMapCursor()
+		out[i] = cursors[i]
 	}
 
-	return out, sg.maintenanceLock.RUnlock
+	return out, func() {
+		for _, c := range cursors {
+			c.close()
+		}
+		sg.maintenanceLock.RUnlock()
+	}
 }
 
 func (s *segmentCursorMap) seek(key []byte) ([]byte, []MapPair, error) {
@@ -87,6 +101,7 @@ func (s *segmentCursorMap) next() ([]byte, []MapPair, error) {
 }
 
 func (s *segmentCursorMap) first() ([]byte, []MapPair, error) {
+	s.openDirect()
 	s.nextOffset = s.segment.dataStartPos
 
 	parsed, err := s.parseCollectionNode(nodeOffset{start: s.nextOffset})
@@ -110,9 +125,22 @@ func (s *segmentCursorMap) first() ([]byte, []MapPair, error) {
 }
 
 func (s *segmentCursorMap) parseCollectionNode(offset nodeOffset) (segmentCollectionNode, error) {
-	r, err := s.segment.newNodeReader(offset)
-	if err != nil {
-		return segmentCollectionNode{}, err
+	if s.direct == nil {
+		return s.segment.parseCollectionNode(offset)
 	}
-	return ParseCollectionNode(r)
+	return s.segment.parseCollectionNodeFrom(directNodeReader(s.direct, offset.start))
+}
+
+// openDirect makes the scan which is about to start bypass the page cache if
+// the segment is configured to do so
+func (s *segmentCursorMap) openDirect() {
+	if s.direct == nil {
+		s.direct = s.segment.newDirectReader()
+	}
+}
+
+// close releases the file opened for direct io, if any
+func (s *segmentCursorMap) close() {
+	closeDirectReader(s.direct)
+	s.direct = nil
 }
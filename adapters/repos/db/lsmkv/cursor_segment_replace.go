@@ -12,6 +12,7 @@
 package lsmkv
 
 import (
+	"github.com/weaviate/weaviate/entities/diskio"
 	"github.com/weaviate/weaviate/entities/lsmkv"
 )
 
@@ -19,6 +20,12 @@ type segmentCursorReplace struct {
 	segment      *segment
 	nextOffset   uint64
 	reusableNode *segmentReplaceNode
+
+	// set once a full scan starts on a segment with direct io, see
+	// WithDirectIO
+	direct *diskio.DirectReader
+	// snapshots must not reopen the segment by its path, see BucketSnapshot
+	noDirectIO bool
 }
 
 func (s *segment) newCursor() *segmentCursorReplace {
@@ -32,11 +39,18 @@ func (sg *SegmentGroup) newCursors() ([]innerCursorReplace, func()) {
 	sg.maintenanceLock.RLock()
 	out := make([]innerCursorReplace, len(sg.segments))
 
+	cursors := make([]*segmentCursorReplace, len(sg.segments))
 	for i, segment := range sg.segments {
-		out[i] = segment.newCursor()
+		cursors[i] = segment.newCursor()
+		out[i] = cursors[i]
 	}
 
-	return out, sg.maintenanceLock.RUnlock
+	return out, func() {
+		for _, c := range cursors {
+			c.close()
+		}
+		sg.maintenanceLock.RUnlock()
+	}
 }
 
 func (s *segmentCursorReplace) seek(key []byte) ([]byte, []byte, error) {
@@ -65,8 +79,7 @@ func (s *segmentCursorReplace) next() ([]byte, []byte, error) {
 		return nil, nil, lsmkv.NotFound
 	}
 
-	err := s.segment.replaceStratParseDataWithKeyInto(
-		s.segment.contents[s.nextOffset:], s.reusableNode)
+	err := s.parseInto(s.nextOffset)
 
 	// make sure to set the next offset before checking the error. The error
 	// could be 'Deleted' which would require that the offset is still advanced
@@ -80,9 +93,9 @@ func (s *segmentCursorReplace) next() ([]byte, []byte, error) {
 }
 
 func (s *segmentCursorReplace) first() ([]byte, []byte, error) {
+	s.openDirect()
 	s.nextOffset = s.segment.dataStartPos
-	err := s.segment.replaceStratParseDataWithKeyInto(
-		s.segment.contents[s.nextOffset:], s.reusableNode)
+	err := s.parseInto(s.nextOffset)
 
 	// make sure to set the next offset before checking the error. The error
 	// could be 'Deleted' which would require that the offset is still advanced
@@ -101,8 +114,7 @@ func (s *segmentCursorReplace) nextWithAllKeys() (segmentReplaceNode, error) {
 		return out, lsmkv.NotFound
 	}
 
-	parsed, err := s.segment.replaceStratParseDataWithKey(
-		s.segment.contents[s.nextOffset:])
+	parsed, err := s.parse(s.nextOffset)
 
 	// make sure to set the next offset before checking the error. The error
 	// could be 'Deleted' which would require that the offset is still advanced
@@ -116,9 +128,9 @@ func (s *segmentCursorReplace) nextWithAllKeys() (segmentReplaceNode, error) {
 }
 
 func (s *segmentCursorReplace) firstWithAllKeys() (segmentReplaceNode, error) {
+	s.openDirect()
 	s.nextOffset = s.segment.dataStartPos
-	parsed, err := s.segment.replaceStratParseDataWithKey(
-		s.segment.contents[s.nextOffset:])
+	parsed, err := s.parse(s.nextOffset)
 
 	// make sure to set the next offset before checking the error. The error
 	// could be 'Deleted' which would require that the offset is still advanced
@@ -130,3 +142,34 @@ func (s *segmentCursorReplace) firstWithAllKeys() (segmentReplaceNode, error) {
 
 	return parsed, nil
 }
+
+// openDirect makes the scan which is about to start bypass the page cache if
+// the segment is configured to do so. Seeks keep using the memory-mapped
+// contents.
+func (s *segmentCursorReplace) openDirect() {
+	if s.direct == nil && !s.noDirectIO {
+		s.direct = s.segment.newDirectReader()
+	}
+}
+
+func (s *segmentCursorReplace) parse(offset uint64) (segmentReplaceNode, error) {
+	if s.direct == nil {
+		return s.segment.replaceStratParseDataWithKey(s.segment.contents[offset:])
+	}
+	return s.segment.replaceStratParseReaderWithKey(directNodeReader(s.direct, offset))
+}
+
+func (s *segmentCursorReplace) parseInto(offset uint64) error {
+	if s.direct == nil {
+		return s.segment.replaceStratParseDataWithKeyInto(
+			s.segment.contents[offset:], s.reusableNode)
+	}
+	return s.segment.replaceStratParseReaderWithKeyInto(
+		directNodeReader(s.direct, offset), s.reusableNode)
+}
+
+// close releases the file opened for direct io, if any
+func (s *segmentCursorReplace) close() {
+	closeDirectReader(s.direct)
+	s.direct = nil
+}
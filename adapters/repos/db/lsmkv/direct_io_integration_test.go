@@ -0,0 +1,287 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package lsmkv
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/diskio"
+)
+
+func TestDirectIO(t *testing.T) {
+	ctx := testCtx()
+	tests := bucketIntegrationTests{
+		{
+			name: "directIOReplace",
+			f:    directIOReplace,
+			opts: []BucketOption{
+				WithStrategy(StrategyReplace),
+				WithSecondaryIndices(1),
+			},
+		},
+		{
+			name: "directIOSet",
+			f:    directIOSet,
+			opts: []BucketOption{
+				WithStrategy(StrategySetCollection),
+			},
+		},
+		{
+			name: "directIOMap",
+			f:    directIOMap,
+			opts: []BucketOption{
+				WithStrategy(StrategyMapCollection),
+			},
+		},
+	}
+	tests.run(ctx, t)
+}
+
+const (
+	directIOSegments   = 4
+	directIOPerSegment = 200
+)
+
+// directIOBuckets runs f against a bucket with direct io for every
+// compression setting. The bucket holds directIOSegments segments, each of
+// which overlaps with half of the previous one.
+func directIOBuckets(ctx context.Context, t *testing.T, opts []BucketOption,
+	write func(b *Bucket, segment, start int), verify func(t *testing.T, b *Bucket),
+) {
+	for _, compression := range []string{CompressionNone, CompressionZstd} {
+		t.Run(fmt.Sprintf("compression %q", compression), func(t *testing.T) {
+			b, err := NewBucket(ctx, t.TempDir(), "", nullLogger(), nil,
+				cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+				append(opts, WithDirectIO(true), WithCompression(compression))...)
+			require.Nil(t, err)
+			defer b.Shutdown(ctx)
+
+			// so big it effectively never triggers as part of this test
+			b.SetMemtableThreshold(1e9)
+
+			for segment := 0; segment < directIOSegments; segment++ {
+				write(b, segment, segment*directIOPerSegment/2)
+				require.Nil(t, b.FlushAndSwitch())
+			}
+
+			t.Run("scans bypass the page cache", func(t *testing.T) {
+				seg := b.disk.segments[0]
+				if r, err := diskio.NewDirectReader(seg.path, directIOWindowSize); err != nil {
+					t.Skipf("direct io not supported: %v", err)
+				} else {
+					r.Close()
+				}
+
+				c := seg.newCollectionCursor()
+				if b.strategy == StrategyReplace {
+					rc := seg.newCursor()
+					rc.first()
+					assert.NotNil(t, rc.direct)
+					rc.close()
+				} else {
+					c.first()
+					assert.NotNil(t, c.direct)
+				}
+				c.close()
+			})
+
+			t.Run("verify before compaction", func(t *testing.T) {
+				verify(t, b)
+			})
+
+			t.Run("compact", func(t *testing.T) {
+				for b.disk.eligibleForCompaction() {
+					require.Nil(t, b.disk.compactOnce())
+				}
+				require.Len(t, b.disk.segments, 1)
+			})
+
+			t.Run("verify after compaction", func(t *testing.T) {
+				verify(t, b)
+			})
+		})
+	}
+}
+
+func directIOKey(i int) []byte {
+	return []byte(fmt.Sprintf("key-%04d", i))
+}
+
+// directIOLastSegment returns the last segment which wrote key i
+func directIOLastSegment(i int) int {
+	last := -1
+	for segment := 0; segment < directIOSegments; segment++ {
+		start := segment * directIOPerSegment / 2
+		if i >= start && i < start+directIOPerSegment {
+			last = segment
+		}
+	}
+	return last
+}
+
+func directIOTotal() int {
+	return (directIOSegments + 1) * directIOPerSegment / 2
+}
+
+func directIOReplace(ctx context.Context, t *testing.T, opts []BucketOption) {
+	secondary := func(i int) []byte { return []byte(fmt.Sprintf("secondary-%04d", i)) }
+	value := func(i, segment int) []byte {
+		return []byte(fmt.Sprintf("value-%04d-written-in-segment-%d", i, segment))
+	}
+	// every segment deletes the first key it wrote
+	deleted := func(i int) bool {
+		segment := directIOLastSegment(i)
+		return i == segment*directIOPerSegment/2
+	}
+
+	write := func(b *Bucket, segment, start int) {
+		for i := start; i < start+directIOPerSegment; i++ {
+			require.Nil(t, b.Put(directIOKey(i), value(i, segment),
+				WithSecondaryKey(0, secondary(i))))
+		}
+		require.Nil(t, b.Delete(directIOKey(start), WithSecondaryKey(0, secondary(start))))
+	}
+
+	verify := func(t *testing.T, b *Bucket) {
+		expected := 0
+		for i := 0; i < directIOTotal(); i++ {
+			v, err := b.Get(directIOKey(i))
+			require.Nil(t, err)
+			if deleted(i) {
+				assert.Nil(t, v)
+				continue
+			}
+			expected++
+			assert.Equal(t, value(i, directIOLastSegment(i)), v)
+		}
+
+		c := b.Cursor()
+		defer c.Close()
+		seen := 0
+		for k, v := c.First(); k != nil; k, v = c.Next() {
+			var i int
+			fmt.Sscanf(string(k), "key-%04d", &i)
+			assert.Equal(t, value(i, directIOLastSegment(i)), v)
+			seen++
+		}
+		assert.Equal(t, expected, seen)
+	}
+
+	directIOBuckets(ctx, t, opts, write, verify)
+}
+
+func directIOSet(ctx context.Context, t *testing.T, opts []BucketOption) {
+	value := func(segment int) []byte { return []byte(fmt.Sprintf("value-%d", segment)) }
+
+	write := func(b *Bucket, segment, start int) {
+		for i := start; i < start+directIOPerSegment; i++ {
+			require.Nil(t, b.SetAdd(directIOKey(i), [][]byte{value(segment)}))
+			if segment > 0 {
+				require.Nil(t, b.SetDeleteSingle(directIOKey(i), value(segment-1)))
+			}
+		}
+	}
+
+	expected := func(i int) [][]byte {
+		var out [][]byte
+		for segment := 0; segment < directIOSegments; segment++ {
+			start := segment * directIOPerSegment / 2
+			if i >= start && i < start+directIOPerSegment {
+				out = append(out, value(segment))
+			}
+		}
+		// the value of a previous segment is deleted by an overlapping one
+		if len(out) > 1 {
+			out = out[len(out)-1:]
+		}
+		return out
+	}
+
+	verify := func(t *testing.T, b *Bucket) {
+		for i := 0; i < directIOTotal(); i++ {
+			values, err := b.SetList(directIOKey(i))
+			require.Nil(t, err)
+			assert.ElementsMatch(t, expected(i), values)
+		}
+
+		c := b.SetCursor()
+		defer c.Close()
+		seen := 0
+		for k, v := c.First(); k != nil; k, v = c.Next() {
+			var i int
+			fmt.Sscanf(string(k), "key-%04d", &i)
+			assert.ElementsMatch(t, expected(i), v)
+			seen++
+		}
+		assert.Equal(t, directIOTotal(), seen)
+	}
+
+	directIOBuckets(ctx, t, opts, write, verify)
+}
+
+func directIOMap(ctx context.Context, t *testing.T, opts []BucketOption) {
+	value := func(i, segment int) []byte {
+		return []byte(fmt.Sprintf("value-%04d-written-in-segment-%d", i, segment))
+	}
+
+	write := func(b *Bucket, segment, start int) {
+		for i := start; i < start+directIOPerSegment; i++ {
+			require.Nil(t, b.MapSet(directIOKey(i), MapPair{
+				Key:   []byte(fmt.Sprintf("segment-%d", segment)),
+				Value: value(i, segment),
+			}))
+		}
+	}
+
+	expected := func(i int) []MapPair {
+		var out []MapPair
+		for segment := 0; segment < directIOSegments; segment++ {
+			start := segment * directIOPerSegment / 2
+			if i >= start && i < start+directIOPerSegment {
+				out = append(out, MapPair{
+					Key:   []byte(fmt.Sprintf("segment-%d", segment)),
+					Value: value(i, segment),
+				})
+			}
+		}
+		return out
+	}
+
+	verify := func(t *testing.T, b *Bucket) {
+		for i := 0; i < directIOTotal(); i++ {
+			pairs, err := b.MapList(directIOKey(i))
+			require.Nil(t, err)
+			assert.ElementsMatch(t, expected(i), pairs)
+		}
+
+		c := b.MapCursor()
+		defer c.Close()
+		seen := 0
+		for k, v := c.First(); k != nil; k, v = c.Next() {
+			var i int
+			fmt.Sscanf(string(k), "key-%04d", &i)
+			assert.ElementsMatch(t, expected(i), v)
+			seen++
+		}
+		assert.Equal(t, directIOTotal(), seen)
+	}
+
+	directIOBuckets(ctx, t, opts, write, verify)
+}
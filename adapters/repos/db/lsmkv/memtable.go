@@ -37,6 +37,9 @@ type Memtable struct {
 	lastWrite          time.Time
 	createdAt          time.Time
 	metrics            *memtableMetrics
+
+	// compression of the segment the memtable is flushed to
+	compression string
 }
 
 func newMemtable(path string, strategy string,
@@ -343,9 +346,18 @@ func (m *Memtable) countStats() *countStats {
 // on the WAL just once. This does not make a batch atomic, but it guarantees
 // that the WAL is written before a successful response is returned to the
 // user.
+//
+// Depending on the sync policy of the WAL, writeWAL additionally waits until
+// the WAL is on disk. The memtable is not locked while waiting, so that
+// concurrent writers can share an fsync.
 func (m *Memtable) writeWAL() error {
 	m.Lock()
-	defer m.Unlock()
+	if err := m.commitlog.flushBuffers(); err != nil {
+		m.Unlock()
+		return err
+	}
+	syncer := m.commitlog.syncer
+	m.Unlock()
 
-	return m.commitlog.flushBuffers()
+	return syncer.Sync()
 }
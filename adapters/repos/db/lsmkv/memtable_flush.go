@@ -13,6 +13,7 @@ package lsmkv
 
 import (
 	"bufio"
+	"bytes"
 	"fmt"
 	"io"
 	"os"
@@ -47,7 +48,10 @@ func (m *Memtable) flush() error {
 		return err
 	}
 
-	w := bufio.NewWriterSize(f, int(float64(m.size)*1.3)) // calculate 30% overhead for disk representation
+	// the segment is checksummed while it is written, so that it does not
+	// need to be read again
+	checksums := newBlockChecksumWriter()
+	w := bufio.NewWriterSize(io.MultiWriter(f, checksums), int(float64(m.size)*1.3)) // calculate 30% overhead for disk representation
 
 	var keys []segmentindex.Key
 	switch m.strategy {
@@ -93,6 +97,11 @@ func (m *Memtable) flush() error {
 		return err
 	}
 
+	if err := storeBlockChecksums(checksumPathFromSegmentPath(m.path+".db"),
+		checksums.checksums()); err != nil {
+		return errors.Wrap(err, "store segment checksums")
+	}
+
 	// only now that the file has been flushed is it safe to delete the commit log
 	// TODO: there might be an interest in keeping the commit logs around for
 	// longer as they might come in handy for replication
@@ -102,6 +111,24 @@ func (m *Memtable) flush() error {
 func (m *Memtable) flushDataReplace(f io.Writer) ([]segmentindex.Key, error) {
 	flat := m.key.flattenInOrder()
 
+	compressor, err := newNodeCompressor(m.compression)
+	if err != nil {
+		return nil, err
+	}
+	if compressor != nil {
+		nodes := make([]keyIndexWriter, len(flat))
+		for i, node := range flat {
+			nodes[i] = &segmentReplaceNode{
+				tombstone:           node.tombstone,
+				value:               node.value,
+				primaryKey:          node.key,
+				secondaryKeys:       node.secondaryKeys,
+				secondaryIndexCount: m.secondaryIndices,
+			}
+		}
+		return m.flushCompressed(f, compressor, nodes)
+	}
+
 	totalDataLength := totalKeyAndValueSize(flat)
 	perObjectAdditions := len(flat) * (1 + 8 + 4 + int(m.secondaryIndices)*4) // 1 byte for the tombstone, 8 bytes value length encoding, 4 bytes key length encoding, + 4 bytes key encoding for every secondary index
 	headerSize := segmentindex.HeaderSize
@@ -181,6 +208,21 @@ func (m *Memtable) flushDataMap(f io.Writer) ([]segmentindex.Key, error) {
 func (m *Memtable) flushDataCollection(f io.Writer,
 	flat []*binarySearchNodeMulti,
 ) ([]segmentindex.Key, error) {
+	compressor, err := newNodeCompressor(m.compression)
+	if err != nil {
+		return nil, err
+	}
+	if compressor != nil {
+		nodes := make([]keyIndexWriter, len(flat))
+		for i, node := range flat {
+			nodes[i] = &segmentCollectionNode{
+				values:     node.values,
+				primaryKey: node.key,
+			}
+		}
+		return m.flushCompressed(f, compressor, nodes)
+	}
+
 	totalDataLength := totalValueSizeCollection(flat)
 	header := segmentindex.Header{
 		IndexStart:       uint64(totalDataLength + segmentindex.HeaderSize),
@@ -215,6 +257,45 @@ func (m *Memtable) flushDataCollection(f io.Writer,
 	return keys, nil
 }
 
+// flushCompressed compresses all nodes before anything is written, as the
+// header contains the start of the index, which is only known once the
+// compressed size of every node is known.
+func (m *Memtable) flushCompressed(f io.Writer, compressor *nodeCompressor,
+	nodes []keyIndexWriter,
+) ([]segmentindex.Key, error) {
+	var data bytes.Buffer
+	keys := make([]segmentindex.Key, len(nodes))
+
+	totalWritten := segmentindex.HeaderSize
+	for i, node := range nodes {
+		ki, err := compressor.writeNode(&data, totalWritten, node)
+		if err != nil {
+			return nil, errors.Wrapf(err, "write node %d", i)
+		}
+
+		keys[i] = ki
+		totalWritten = ki.ValueEnd
+	}
+
+	header := segmentindex.Header{
+		IndexStart:       uint64(totalWritten),
+		Level:            0, // always level zero on a new one
+		Version:          compressor.version(),
+		SecondaryIndices: m.secondaryIndices,
+		Strategy:         SegmentStrategyFromString(m.strategy),
+	}
+
+	if _, err := header.WriteTo(f); err != nil {
+		return nil, err
+	}
+
+	if _, err := data.WriteTo(f); err != nil {
+		return nil, err
+	}
+
+	return keys, nil
+}
+
 func totalKeyAndValueSize(in []*binarySearchNode) int {
 	var sum int
 	for _, n := range in {
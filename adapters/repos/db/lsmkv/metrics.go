@@ -40,6 +40,8 @@ type Metrics struct {
 	memtableDurations    prometheus.ObserverVec
 	memtableSize         *prometheus.GaugeVec
 	DimensionSum         *prometheus.GaugeVec
+	checksumFailures     *prometheus.CounterVec
+	quarantinedSegments  *prometheus.GaugeVec
 
 	groupClasses bool
 }
@@ -126,6 +128,14 @@ func NewMetrics(promMetrics *monitoring.PrometheusMetrics, className,
 			"class_name": className,
 			"shard_name": shardName,
 		}),
+		checksumFailures: promMetrics.LSMSegmentChecksumFailures.MustCurryWith(prometheus.Labels{
+			"class_name": className,
+			"shard_name": shardName,
+		}),
+		quarantinedSegments: promMetrics.LSMQuarantinedSegments.MustCurryWith(prometheus.Labels{
+			"class_name": className,
+			"shard_name": shardName,
+		}),
 	}
 }
 
@@ -229,3 +239,31 @@ func (m *Metrics) ObjectCount(count int) {
 
 	m.objectCount.Set(float64(count))
 }
+
+func (m *Metrics) SegmentChecksumFailure(strategy, path string) {
+	if m == nil {
+		return
+	}
+
+	if m.groupClasses {
+		path = "n/a"
+	}
+
+	m.checksumFailures.With(prometheus.Labels{
+		"strategy": strategy,
+		"path":     path,
+	}).Inc()
+}
+
+func (m *Metrics) QuarantinedSegments(strategy, path string, count int) {
+	if m == nil || m.groupClasses {
+		// this metric would set absolute values, that's not possible in
+		// grouped mode, each call would essentially overwrite the last
+		return
+	}
+
+	m.quarantinedSegments.With(prometheus.Labels{
+		"strategy": strategy,
+		"path":     path,
+	}).Set(float64(count))
+}
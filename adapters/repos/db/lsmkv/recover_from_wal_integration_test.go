@@ -22,11 +22,14 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/diskio"
 )
 
 func TestReplaceStrategy_RecoverFromWAL(t *testing.T) {
@@ -475,6 +478,41 @@ func TestSetStrategy_RecoverFromWAL(t *testing.T) {
 	})
 }
 
+func TestSetStrategy_RecoverFromWALAsDesiredRoaringSet(t *testing.T) {
+	dirNameOriginal := t.TempDir()
+	dirNameRecovered := t.TempDir()
+
+	b, err := NewBucket(testCtx(), dirNameOriginal, "", nullLogger(), nil,
+		cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+		WithStrategy(StrategySetCollection))
+	require.Nil(t, err)
+
+	// so big it effectively never triggers as part of this test
+	b.SetMemtableThreshold(1e9)
+
+	key := []byte("test1-key-1")
+	values := [][]byte{[]byte("value 1.1"), []byte("value 1.2")}
+	require.Nil(t, b.SetAdd(key, values))
+	require.Nil(t, b.WriteWAL())
+
+	cmd := exec.Command("/bin/bash", "-c", fmt.Sprintf("cp -r %s/*.wal %s",
+		dirNameOriginal, dirNameRecovered))
+	require.Nil(t, cmd.Run())
+
+	// the bucket is opened as roaring set, but without a segment only the
+	// write-ahead-log tells that it was written as a set
+	bRec, err := NewBucket(testCtx(), dirNameRecovered, "", nullLogger(), nil,
+		cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+		WithStrategy(StrategyRoaringSet))
+	require.Nil(t, err)
+
+	assert.Equal(t, StrategySetCollection, bRec.Strategy())
+	assert.Equal(t, StrategyRoaringSet, bRec.DesiredStrategy())
+	res, err := bRec.SetList(key)
+	require.Nil(t, err)
+	assert.Equal(t, values, res)
+}
+
 func TestMapStrategy_RecoverFromWAL(t *testing.T) {
 	dirNameOriginal := t.TempDir()
 	dirNameRecovered := t.TempDir()
@@ -662,3 +700,71 @@ func TestMapStrategy_RecoverFromWAL(t *testing.T) {
 		})
 	})
 }
+
+func TestReplaceStrategy_RecoverFromWAL_SyncPolicies(t *testing.T) {
+	policies := []diskio.SyncPolicy{
+		{Mode: diskio.SyncModeNone},
+		{Mode: diskio.SyncModeAlways},
+		{Mode: diskio.SyncModeGroup, MaxDelay: 5 * time.Millisecond},
+	}
+
+	for _, policy := range policies {
+		t.Run(policy.Mode, func(t *testing.T) {
+			dirNameOriginal := t.TempDir()
+			dirNameRecovered := t.TempDir()
+
+			b, err := NewBucket(testCtx(), dirNameOriginal, "", nullLogger(), nil,
+				cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+				WithStrategy(StrategyReplace), WithWALSyncPolicy(policy))
+			require.Nil(t, err)
+
+			// so big it effectively never triggers as part of this test
+			b.SetMemtableThreshold(1e9)
+
+			t.Run("write concurrently", func(t *testing.T) {
+				wg := sync.WaitGroup{}
+				for i := 0; i < 20; i++ {
+					wg.Add(1)
+					go func(i int) {
+						defer wg.Done()
+						key := []byte(fmt.Sprintf("key-%d", i))
+						assert.Nil(t, b.Put(key, key))
+						assert.Nil(t, b.WriteWAL())
+					}(i)
+				}
+				wg.Wait()
+			})
+
+			t.Run("copy wals into recovery folder", func(t *testing.T) {
+				wals, err := filepath.Glob(filepath.Join(dirNameOriginal, "*.wal"))
+				require.Nil(t, err)
+				require.Len(t, wals, 1)
+
+				for _, wal := range wals {
+					contents, err := os.ReadFile(wal)
+					require.Nil(t, err)
+					require.Nil(t, os.WriteFile(filepath.Join(dirNameRecovered,
+						filepath.Base(wal)), contents, 0o600))
+				}
+			})
+
+			t.Run("recover and verify", func(t *testing.T) {
+				bRec, err := NewBucket(testCtx(), dirNameRecovered, "", nullLogger(), nil,
+					cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+					WithStrategy(StrategyReplace), WithWALSyncPolicy(policy))
+				require.Nil(t, err)
+
+				for i := 0; i < 20; i++ {
+					key := []byte(fmt.Sprintf("key-%d", i))
+					res, err := bRec.Get(key)
+					require.Nil(t, err)
+					assert.Equal(t, key, res)
+				}
+
+				require.Nil(t, bRec.Shutdown(context.Background()))
+			})
+
+			require.Nil(t, b.Shutdown(context.Background()))
+		})
+	}
+}
@@ -66,6 +66,11 @@ func (t *BinarySearchTree) FlattenInOrder() []*BinarySearchNode {
 	return t.root.flattenInOrder()
 }
 
+// VisitKeys calls fn with the keys in order, they must not be modified
+func (t *BinarySearchTree) VisitKeys(fn func(key []byte)) {
+	t.root.visitKeys(fn)
+}
+
 type BinarySearchNode struct {
 	Key         []byte
 	Value       BitmapLayer
@@ -266,3 +271,13 @@ func (n *BinarySearchNode) flattenInOrder() []*BinarySearchNode {
 	}}, right...)
 	return append(left, right...)
 }
+
+func (n *BinarySearchNode) visitKeys(fn func(key []byte)) {
+	if n == nil {
+		return
+	}
+
+	n.left.visitKeys(fn)
+	fn(n.Key)
+	n.right.visitKeys(fn)
+}
@@ -17,6 +17,8 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"sync"
+	"time"
 
 	"github.com/edsrzf/mmap-go"
 	"github.com/sirupsen/logrus"
@@ -47,8 +49,34 @@ type segment struct {
 	size                  int64
 	mmapContents          bool
 
+	// id identifies the segment in the block cache, which holds its bloom
+	// filters and, if not memory-mapped, its nodes. The cache is optional.
+	id         uint64
+	blockCache *BlockCache
+
+	// sequential scans read the file with direct io, see WithDirectIO
+	directIO bool
+
 	// the net addition this segment adds with respect to all previous segments
 	countNetAdditions int
+
+	// optional, see WithTermDictionary
+	termDictionary *termDictionary
+
+	// only set for roaring set segments, see roaringSetStats
+	roaringSetStats *roaringSetStats
+
+	// when the contents were last verified against the checksums, zero for
+	// segments loaded from disk which were not verified yet. Only accessed by
+	// compactions and scrubs, which never run concurrently for a segment group.
+	verifiedAt time.Time
+
+	// pins counts the snapshots which read from the segment, see
+	// Bucket.Snapshot. A segment which is closed while it is pinned, e.g.
+	// because it was compacted, is only closed by its last unpin.
+	pinLock      sync.Mutex
+	pins         int
+	closeOnUnpin bool
 }
 
 type diskIndex interface {
@@ -68,7 +96,8 @@ type diskIndex interface {
 }
 
 func newSegment(path string, logger logrus.FieldLogger, metrics *Metrics,
-	existsLower existsOnLowerSegmentsFn, mmapContents bool,
+	existsLower existsOnLowerSegmentsFn, mmapContents bool, blockCache *BlockCache,
+	directIO, useTermDictionary bool,
 ) (*segment, error) {
 	file, err := os.Open(path)
 	if err != nil {
@@ -121,6 +150,9 @@ func newSegment(path string, logger logrus.FieldLogger, metrics *Metrics,
 		bloomFilterMetrics:  newBloomFilterMetrics(metrics),
 		size:                fileInfo.Size(),
 		mmapContents:        mmapContents,
+		id:                  nextSegmentID(),
+		blockCache:          blockCache,
+		directIO:            directIO,
 	}
 
 	// Using pread strategy requires file to remain open for segment lifetime
@@ -150,10 +182,24 @@ func newSegment(path string, logger logrus.FieldLogger, metrics *Metrics,
 		return nil, err
 	}
 
+	if seg.blockCache != nil {
+		seg.moveBloomFiltersToCache()
+	}
+
+	if useTermDictionary {
+		if err := seg.initTermDictionary(); err != nil {
+			return nil, fmt.Errorf("init term dictionary: %w", err)
+		}
+	}
+
 	if err := seg.initCountNetAdditions(existsLower); err != nil {
 		return nil, err
 	}
 
+	if err := seg.initRoaringSetStats(); err != nil {
+		return nil, fmt.Errorf("init roaring set stats: %w", err)
+	}
+
 	return seg, nil
 }
 
@@ -165,6 +211,9 @@ func (s *segment) close() error {
 	if s.contentFile != nil {
 		fileCloseErr = s.contentFile.Close()
 	}
+	if s.blockCache != nil {
+		s.blockCache.removeSegment(s.id)
+	}
 
 	if munmapErr != nil || fileCloseErr != nil {
 		return fmt.Errorf("close segment: munmap: %v, close contents file: %w", munmapErr, fileCloseErr)
@@ -174,9 +223,9 @@ func (s *segment) close() error {
 }
 
 func (s *segment) drop() error {
-	// support for persisting bloom filters and cnas was added in v1.17,
-	// therefore the files may not be present on segments created with previous
-	// versions. By using RemoveAll, which does not error on NotExists, these
+	// support for persisting bloom filters and cnas was added in v1.17, and
+	// checksums were added later, therefore the files may not be present on
+	// segments created with previous versions. By using RemoveAll, which does not error on NotExists, these
 	// drop calls are backward-compatible:
 	if err := os.RemoveAll(s.bloomFilterPath()); err != nil {
 		return fmt.Errorf("drop bloom filter: %w", err)
@@ -192,6 +241,18 @@ func (s *segment) drop() error {
 		return fmt.Errorf("drop count net additions file: %w", err)
 	}
 
+	if err := os.RemoveAll(s.checksumPath()); err != nil {
+		return fmt.Errorf("drop checksums file: %w", err)
+	}
+
+	if err := os.RemoveAll(s.termDictionaryPath()); err != nil {
+		return fmt.Errorf("drop term dictionary: %w", err)
+	}
+
+	if err := os.RemoveAll(s.roaringSetStatsPath()); err != nil {
+		return fmt.Errorf("drop roaring set stats: %w", err)
+	}
+
 	// for the segment itself, we're not using RemoveAll, but Remove. If there
 	// was a NotExists error here, something would be seriously wrong, and we
 	// don't want to ignore it.
@@ -202,6 +263,17 @@ func (s *segment) drop() error {
 	return nil
 }
 
+// files returns the paths of the segment and all the files pre-computed for
+// it, some of which may not exist
+func (s *segment) files() []string {
+	out := []string{s.path, s.bloomFilterPath()}
+	for i := 0; i < int(s.secondaryIndexCount); i++ {
+		out = append(out, s.bloomFilterSecondaryPath(i))
+	}
+	return append(out, s.countNetPath(), s.checksumPath(), s.termDictionaryPath(),
+		s.roaringSetStatsPath())
+}
+
 // Size returns the total size of the segment in bytes, including the header
 // and index
 func (s *segment) Size() int {
@@ -213,6 +285,11 @@ func (s *segment) PayloadSize() int {
 	return int(s.dataEndPos)
 }
 
+// compressed segments wrap each node in a frame, see segment_compression.go
+func (s *segment) compressed() bool {
+	return s.version == segmentindex.VersionCompressed
+}
+
 type nodeReader struct {
 	r io.Reader
 }
@@ -250,6 +327,14 @@ func (s *segment) copyNode(b []byte, offset nodeOffset) error {
 		copy(b, s.contents[offset.start:offset.end])
 		return nil
 	}
+	if s.blockCache != nil {
+		contents, err := s.readNodeCached(offset)
+		if err != nil {
+			return fmt.Errorf("copy node: %w", err)
+		}
+		copy(b, contents)
+		return nil
+	}
 	n, err := s.newNodeReader(offset)
 	if err != nil {
 		return fmt.Errorf("copy node: %w", err)
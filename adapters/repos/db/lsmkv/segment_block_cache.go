@@ -0,0 +1,130 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	"github.com/willf/bloom"
+)
+
+// primaryBloomFilter is the pos of the primary bloom filter in a
+// blockCacheKey, secondary bloom filters use the position of their index
+const primaryBloomFilter = -1
+
+// moveBloomFiltersToCache hands the bloom filters that were loaded or built
+// when the segment was initialized over to the block cache. From then on the
+// segment no longer holds them itself and reloads them from disk if they
+// have been evicted.
+func (s *segment) moveBloomFiltersToCache() {
+	s.putBloomFilter(primaryBloomFilter, s.bloomFilter)
+	s.bloomFilter = nil
+
+	for pos, bf := range s.secondaryBloomFilters {
+		s.putBloomFilter(pos, bf)
+		s.secondaryBloomFilters[pos] = nil
+	}
+}
+
+func (s *segment) putBloomFilter(pos int, bf *bloom.BloomFilter) {
+	// Cap() is the size of the filter in bits
+	s.blockCache.put(s.bloomFilterCacheKey(pos), bf, int64(bf.Cap()/8))
+}
+
+func (s *segment) bloomFilterCacheKey(pos int) blockCacheKey {
+	return blockCacheKey{segment: s.id, kind: blockCacheKindBloomFilter, pos: pos}
+}
+
+// mayContain is false if the key is guaranteed not to be contained in the
+// segment
+func (s *segment) mayContain(key []byte) bool {
+	bf := s.bloomFilter
+	if s.blockCache != nil {
+		bf = s.cachedBloomFilter(primaryBloomFilter)
+	}
+
+	return bf == nil || bf.Test(key)
+}
+
+// secondaryMayContain is false if the key is guaranteed not to be contained
+// in the secondary index at pos
+func (s *segment) secondaryMayContain(pos int, key []byte) bool {
+	bf := s.secondaryBloomFilters[pos]
+	if s.blockCache != nil {
+		bf = s.cachedBloomFilter(pos)
+	}
+
+	return bf == nil || bf.Test(key)
+}
+
+// cachedBloomFilter returns nil if the bloom filter was evicted and cannot be
+// reloaded. The lookup then falls back to the disk index, which is slower, but
+// still correct.
+func (s *segment) cachedBloomFilter(pos int) *bloom.BloomFilter {
+	if bf, ok := s.blockCache.get(s.bloomFilterCacheKey(pos)); ok {
+		return bf.(*bloom.BloomFilter)
+	}
+
+	path := s.bloomFilterPath()
+	if pos != primaryBloomFilter {
+		path = s.bloomFilterSecondaryPath(pos)
+	}
+
+	bf, err := readBloomFilter(path)
+	if err != nil {
+		s.logger.WithField("action", "lsm_block_cache_load_bloom_filter").
+			WithField("path", path).
+			WithError(err).
+			Warn("reload evicted bloom filter, falling back to disk index")
+		return nil
+	}
+
+	s.putBloomFilter(pos, bf)
+	return bf
+}
+
+func readBloomFilter(path string) (*bloom.BloomFilter, error) {
+	data, err := loadWithChecksum(path, -1)
+	if err != nil {
+		return nil, err
+	}
+
+	bf := new(bloom.BloomFilter)
+	if _, err := bf.ReadFrom(bytes.NewReader(data)); err != nil {
+		return nil, fmt.Errorf("read bloom filter from disk: %w", err)
+	}
+
+	return bf, nil
+}
+
+// readNodeCached reads a node of a segment that is not memory-mapped through
+// the block cache. The returned bytes are shared and must not be modified.
+func (s *segment) readNodeCached(offset nodeOffset) ([]byte, error) {
+	key := blockCacheKey{segment: s.id, kind: blockCacheKindNode, start: offset.start}
+	if contents, ok := s.blockCache.get(key); ok {
+		return contents.([]byte), nil
+	}
+
+	contents := make([]byte, offset.end-offset.start)
+	r, err := s.bufferedReaderAt(offset.start)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := io.ReadFull(r, contents); err != nil {
+		return nil, err
+	}
+
+	s.blockCache.put(key, contents, int64(len(contents)))
+	return contents, nil
+}
@@ -124,17 +124,12 @@ func (s *segment) storeBloomFilterOnDisk(path string) error {
 }
 
 func (s *segment) loadBloomFilterFromDisk() error {
-	data, err := loadWithChecksum(s.bloomFilterPath(), -1)
+	bf, err := readBloomFilter(s.bloomFilterPath())
 	if err != nil {
 		return err
 	}
 
-	s.bloomFilter = new(bloom.BloomFilter)
-	_, err = s.bloomFilter.ReadFrom(bytes.NewReader(data))
-	if err != nil {
-		return fmt.Errorf("read bloom filter from disk: %w", err)
-	}
-
+	s.bloomFilter = bf
 	return nil
 }
 
@@ -230,17 +225,12 @@ func (s *segment) storeBloomFilterSecondaryOnDisk(path string, pos int) error {
 }
 
 func (s *segment) loadBloomFilterSecondaryFromDisk(pos int) error {
-	data, err := loadWithChecksum(s.bloomFilterSecondaryPath(pos), -1)
+	bf, err := readBloomFilter(s.bloomFilterSecondaryPath(pos))
 	if err != nil {
 		return err
 	}
 
-	s.secondaryBloomFilters[pos] = new(bloom.BloomFilter)
-	_, err = s.secondaryBloomFilters[pos].ReadFrom(bytes.NewReader(data))
-	if err != nil {
-		return fmt.Errorf("read bloom filter from disk: %w", err)
-	}
-
+	s.secondaryBloomFilters[pos] = bf
 	return nil
 }
 
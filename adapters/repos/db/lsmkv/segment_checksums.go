@@ -0,0 +1,214 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ErrSegmentCorrupt indicates that the contents of a segment no longer match
+// the checksums written alongside it. Unlike ErrInvalidChecksum on
+// pre-computed data, this cannot be recovered from, the segment must not be
+// read anymore.
+var ErrSegmentCorrupt = errors.New("segment checksum mismatch")
+
+// segmentChecksumBlockSize is the size of the blocks of a segment file which
+// are checksummed individually, so that a mismatch can be narrowed down to a
+// region of the file
+const segmentChecksumBlockSize = 64 * 1024
+
+var castagnoliTable = crc32.MakeTable(crc32.Castagnoli)
+
+// blockChecksums holds the CRC32C of every block of a segment file. The
+// last block may be shorter than the block size.
+type blockChecksums struct {
+	blockSize uint32
+	size      uint64
+	sums      []uint32
+}
+
+func (bc *blockChecksums) marshal() []byte {
+	buf := make([]byte, 12+4*len(bc.sums))
+	binary.LittleEndian.PutUint32(buf[0:4], bc.blockSize)
+	binary.LittleEndian.PutUint64(buf[4:12], bc.size)
+	for i, sum := range bc.sums {
+		binary.LittleEndian.PutUint32(buf[12+4*i:], sum)
+	}
+	return buf
+}
+
+func unmarshalBlockChecksums(data []byte) (*blockChecksums, error) {
+	if len(data) < 12 || (len(data)-12)%4 != 0 {
+		return nil, ErrInvalidChecksum
+	}
+
+	bc := &blockChecksums{
+		blockSize: binary.LittleEndian.Uint32(data[0:4]),
+		size:      binary.LittleEndian.Uint64(data[4:12]),
+		sums:      make([]uint32, (len(data)-12)/4),
+	}
+	if bc.blockSize == 0 {
+		return nil, ErrInvalidChecksum
+	}
+	for i := range bc.sums {
+		bc.sums[i] = binary.LittleEndian.Uint32(data[12+4*i:])
+	}
+
+	return bc, nil
+}
+
+// blockChecksumWriter computes the block checksums of everything written to
+// it, so that a segment can be checksummed while it is being written
+type blockChecksumWriter struct {
+	blockSize int
+	current   hash.Hash32
+	inBlock   int
+	size      uint64
+	sums      []uint32
+}
+
+func newBlockChecksumWriter() *blockChecksumWriter {
+	return &blockChecksumWriter{
+		blockSize: segmentChecksumBlockSize,
+		current:   crc32.New(castagnoliTable),
+	}
+}
+
+func (w *blockChecksumWriter) Write(p []byte) (int, error) {
+	n := len(p)
+	for len(p) > 0 {
+		chunk := w.blockSize - w.inBlock
+		if chunk > len(p) {
+			chunk = len(p)
+		}
+
+		w.current.Write(p[:chunk])
+		w.inBlock += chunk
+		p = p[chunk:]
+
+		if w.inBlock == w.blockSize {
+			w.sums = append(w.sums, w.current.Sum32())
+			w.current.Reset()
+			w.inBlock = 0
+		}
+	}
+
+	w.size += uint64(n)
+	return n, nil
+}
+
+func (w *blockChecksumWriter) checksums() *blockChecksums {
+	sums := w.sums
+	if w.inBlock > 0 {
+		sums = append(sums, w.current.Sum32())
+	}
+
+	return &blockChecksums{
+		blockSize: uint32(w.blockSize),
+		size:      w.size,
+		sums:      sums,
+	}
+}
+
+func (s *segment) checksumPath() string {
+	return checksumPathFromSegmentPath(s.path)
+}
+
+func checksumPathFromSegmentPath(segPath string) string {
+	extless := strings.TrimSuffix(segPath, filepath.Ext(segPath))
+	return fmt.Sprintf("%s.crc", extless)
+}
+
+func storeBlockChecksums(path string, bc *blockChecksums) error {
+	return writeWithChecksum(bc.marshal(), path)
+}
+
+func loadBlockChecksums(path string) (*blockChecksums, error) {
+	data, err := loadWithChecksum(path, -1)
+	if err != nil {
+		return nil, err
+	}
+
+	return unmarshalBlockChecksums(data)
+}
+
+// computeBlockChecksums checksums the contents of an already written segment
+func computeBlockChecksums(contents []byte) *blockChecksums {
+	w := newBlockChecksumWriter()
+	w.Write(contents)
+	return w.checksums()
+}
+
+// verifyChecksums reads the entire segment file and compares every block
+// against the checksums stored next to it. It returns an error wrapping
+// ErrSegmentCorrupt on a mismatch.
+//
+// The file is read instead of the memory-mapped contents, so that an
+// unreadable block surfaces as an error rather than a SIGBUS. Segments
+// written before checksums were introduced, or whose checksum file is
+// damaged, have their checksums computed from the current contents instead,
+// so that any change from now on is detected.
+func (s *segment) verifyChecksums() error {
+	expected, err := loadBlockChecksums(s.checksumPath())
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) && !errors.Is(err, ErrInvalidChecksum) {
+			return fmt.Errorf("load segment checksums: %w", err)
+		}
+
+		s.logger.WithField("action", "lsm_segment_checksums").
+			WithField("path", s.path).
+			Debug("no valid checksums for segment, computing them from its contents")
+		return storeBlockChecksums(s.checksumPath(), computeBlockChecksums(s.contents))
+	}
+
+	if expected.size != uint64(s.size) {
+		return fmt.Errorf("%w: %s has a size of %d bytes, but %d bytes were "+
+			"checksummed", ErrSegmentCorrupt, s.path, s.size, expected.size)
+	}
+
+	var r io.Reader
+	if direct := s.newDirectReader(); direct != nil {
+		defer direct.Close()
+		r = io.NewSectionReader(direct, 0, direct.Size())
+	} else {
+		f, err := os.Open(s.path)
+		if err != nil {
+			return fmt.Errorf("open segment: %w", err)
+		}
+		defer f.Close()
+		r = bufio.NewReaderSize(f, int(expected.blockSize))
+	}
+
+	block := make([]byte, expected.blockSize)
+	for i, sum := range expected.sums {
+		n, err := io.ReadFull(r, block)
+		if err != nil && !(errors.Is(err, io.ErrUnexpectedEOF) && i == len(expected.sums)-1) {
+			return fmt.Errorf("read block %d of segment %s: %w", i, s.path, err)
+		}
+
+		if actual := crc32.Checksum(block[:n], castagnoliTable); actual != sum {
+			return fmt.Errorf("%w: block %d at offset %d of %s", ErrSegmentCorrupt,
+				i, uint64(i)*uint64(expected.blockSize), s.path)
+		}
+	}
+
+	return nil
+}
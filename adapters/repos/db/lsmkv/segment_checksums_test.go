@@ -0,0 +1,242 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"context"
+	"math/rand"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+)
+
+func TestBlockChecksumWriter(t *testing.T) {
+	data := make([]byte, 3*segmentChecksumBlockSize+123)
+	rand.New(rand.NewSource(7)).Read(data)
+
+	w := newBlockChecksumWriter()
+	for rest := data; len(rest) > 0; {
+		n := 1000
+		if n > len(rest) {
+			n = len(rest)
+		}
+		w.Write(rest[:n])
+		rest = rest[n:]
+	}
+
+	streamed := w.checksums()
+	assert.Equal(t, computeBlockChecksums(data), streamed)
+	assert.Len(t, streamed.sums, 4)
+	assert.Equal(t, uint64(len(data)), streamed.size)
+
+	unmarshalled, err := unmarshalBlockChecksums(streamed.marshal())
+	require.Nil(t, err)
+	assert.Equal(t, streamed, unmarshalled)
+
+	_, err = unmarshalBlockChecksums([]byte{1, 2, 3})
+	assert.ErrorIs(t, err, ErrInvalidChecksum)
+}
+
+func TestSegmentChecksums(t *testing.T) {
+	ctx := context.Background()
+	tests := bucketTests{
+		{
+			name: "createChecksumsOnFlush",
+			f:    createChecksumsOnFlush,
+			opts: []BucketOption{
+				WithStrategy(StrategyReplace),
+			},
+		},
+		{
+			name: "createChecksumsForSegmentsWithout",
+			f:    createChecksumsForSegmentsWithout,
+			opts: []BucketOption{
+				WithStrategy(StrategyReplace),
+			},
+		},
+		{
+			name: "quarantineCorruptSegment",
+			f:    quarantineCorruptSegment,
+			opts: []BucketOption{
+				WithStrategy(StrategyReplace),
+				WithSecondaryIndices(1),
+			},
+		},
+		{
+			name: "verifySegmentsBeforeCompaction",
+			f:    verifySegmentsBeforeCompaction,
+			opts: []BucketOption{
+				WithStrategy(StrategyReplace),
+				WithScrubInterval(time.Hour),
+			},
+		},
+	}
+	tests.run(ctx, t)
+}
+
+func createChecksumsOnFlush(ctx context.Context, t *testing.T, opts []BucketOption) {
+	dirName := t.TempDir()
+	logger, _ := test.NewNullLogger()
+
+	b, err := NewBucket(ctx, dirName, "", logger, nil,
+		cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(), opts...)
+	require.Nil(t, err)
+	defer b.Shutdown(ctx)
+
+	require.Nil(t, b.Put([]byte("hello"), []byte("world")))
+	require.Nil(t, b.FlushMemtable())
+
+	files, err := os.ReadDir(dirName)
+	require.Nil(t, err)
+	_, ok := findFileWithExt(files, ".crc")
+	assert.True(t, ok)
+
+	seg := b.disk.segments[0]
+	assert.False(t, seg.verifiedAt.IsZero(), "flushed segments count as verified")
+	assert.Nil(t, seg.verifyChecksums())
+}
+
+func createChecksumsForSegmentsWithout(ctx context.Context, t *testing.T, opts []BucketOption) {
+	dirName := t.TempDir()
+	logger, _ := test.NewNullLogger()
+
+	b, err := NewBucket(ctx, dirName, "", logger, nil,
+		cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(), opts...)
+	require.Nil(t, err)
+	defer b.Shutdown(ctx)
+
+	require.Nil(t, b.Put([]byte("hello"), []byte("world")))
+	require.Nil(t, b.FlushMemtable())
+
+	// segments written by previous versions do not have checksums
+	seg := b.disk.segments[0]
+	require.Nil(t, os.Remove(seg.checksumPath()))
+
+	require.Nil(t, b.disk.verifySegment(seg))
+	assert.FileExists(t, seg.checksumPath())
+	assert.Nil(t, seg.verifyChecksums())
+}
+
+func quarantineCorruptSegment(ctx context.Context, t *testing.T, opts []BucketOption) {
+	dirName := t.TempDir()
+	logger, _ := test.NewNullLogger()
+
+	b, err := NewBucket(ctx, dirName, "", logger, nil,
+		cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(), opts...)
+	require.Nil(t, err)
+
+	require.Nil(t, b.Put([]byte("key-1"), []byte("value-1"),
+		WithSecondaryKey(0, []byte("secondary-1"))))
+	require.Nil(t, b.FlushMemtable())
+	require.Nil(t, b.Put([]byte("key-2"), []byte("value-2"),
+		WithSecondaryKey(0, []byte("secondary-2"))))
+	require.Nil(t, b.FlushMemtable())
+	require.Len(t, b.disk.segments, 2)
+
+	seg := b.disk.segments[1]
+	corruptSegment(t, seg.path)
+
+	err = b.disk.verifySegment(seg)
+	require.ErrorIs(t, err, ErrSegmentCorrupt)
+
+	t.Run("the segment is no longer served", func(t *testing.T) {
+		assert.Len(t, b.disk.segments, 1)
+
+		v, err := b.Get([]byte("key-1"))
+		require.Nil(t, err)
+		assert.Equal(t, []byte("value-1"), v)
+
+		v, err = b.Get([]byte("key-2"))
+		require.Nil(t, err)
+		assert.Nil(t, v)
+	})
+
+	t.Run("the files are moved to quarantine", func(t *testing.T) {
+		files, err := os.ReadDir(path.Join(dirName, QuarantineDir))
+		require.Nil(t, err)
+		for _, ext := range []string{".db", ".bloom", ".secondary.0.bloom", ".cna", ".crc"} {
+			_, ok := findFileWithExt(files, ext)
+			assert.True(t, ok, ext)
+		}
+
+		count, err := countQuarantinedSegments(dirName)
+		require.Nil(t, err)
+		assert.Equal(t, 1, count)
+	})
+
+	require.Nil(t, b.Shutdown(ctx))
+
+	t.Run("quarantined segments are not loaded again", func(t *testing.T) {
+		b, err := NewBucket(ctx, dirName, "", logger, nil,
+			cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(), opts...)
+		require.Nil(t, err)
+		defer b.Shutdown(ctx)
+
+		assert.Len(t, b.disk.segments, 1)
+	})
+}
+
+func verifySegmentsBeforeCompaction(ctx context.Context, t *testing.T, opts []BucketOption) {
+	dirName := t.TempDir()
+	logger, _ := test.NewNullLogger()
+
+	b, err := NewBucket(ctx, dirName, "", logger, nil,
+		cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(), opts...)
+	require.Nil(t, err)
+
+	for _, key := range []string{"key-1", "key-2"} {
+		require.Nil(t, b.Put([]byte(key), []byte("value")))
+		require.Nil(t, b.FlushMemtable())
+	}
+	require.Nil(t, b.Shutdown(ctx))
+
+	b, err = NewBucket(ctx, dirName, "", logger, nil,
+		cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(), opts...)
+	require.Nil(t, err)
+	defer b.Shutdown(ctx)
+
+	// segments loaded from disk have not been verified yet
+	require.Len(t, b.disk.segments, 2)
+	assert.True(t, b.disk.segments[0].verifiedAt.IsZero())
+	corruptSegment(t, b.disk.segments[0].path)
+
+	err = b.disk.compactOnce()
+	require.ErrorIs(t, err, ErrSegmentCorrupt)
+
+	assert.Len(t, b.disk.segments, 1)
+	count, err := countQuarantinedSegments(dirName)
+	require.Nil(t, err)
+	assert.Equal(t, 1, count)
+}
+
+// corruptSegment flips a byte of the first node of the segment
+func corruptSegment(t *testing.T, segmentPath string) {
+	f, err := os.OpenFile(segmentPath, os.O_RDWR, 0o600)
+	require.Nil(t, err)
+	defer f.Close()
+
+	b := make([]byte, 1)
+	offset := int64(segmentindex.HeaderSize + 9)
+	_, err = f.ReadAt(b, offset)
+	require.Nil(t, err)
+
+	b[0] ^= 0xff
+	_, err = f.WriteAt(b, offset)
+	require.Nil(t, err)
+}
@@ -12,8 +12,10 @@
 package lsmkv
 
 import (
+	"bytes"
 	"encoding/binary"
 	"fmt"
+	"io"
 
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
 	"github.com/weaviate/weaviate/entities/lsmkv"
@@ -26,7 +28,7 @@ func (s *segment) getCollection(key []byte) ([]value, error) {
 			StrategySetCollection, StrategyMapCollection)
 	}
 
-	if !s.bloomFilter.Test(key) {
+	if !s.mayContain(key) {
 		return nil, lsmkv.NotFound
 	}
 
@@ -53,9 +55,75 @@ func (s *segment) getCollection(key []byte) ([]value, error) {
 		return nil, err
 	}
 
+	if s.compressed() {
+		if contentsCopy, _, err = decodeNodeFrame(contentsCopy); err != nil {
+			return nil, err
+		}
+	}
+
 	return s.collectionStratParseData(contentsCopy)
 }
 
+// parseCollectionNode parses the node at offset. On compressed segments the
+// frame is decompressed first, the offset of the returned node is the size of
+// the frame on disk, so that cursors can advance to the next node.
+func (s *segment) parseCollectionNode(offset nodeOffset) (segmentCollectionNode, error) {
+	r, err := s.newNodeReader(offset)
+	if err != nil {
+		return segmentCollectionNode{}, err
+	}
+
+	return s.parseCollectionNodeFrom(r)
+}
+
+// parseCollectionNodeFrom parses the node at the beginning of r, see
+// parseCollectionNode
+func (s *segment) parseCollectionNodeFrom(r io.Reader) (segmentCollectionNode, error) {
+	if !s.compressed() {
+		return ParseCollectionNode(r)
+	}
+
+	node, frameSize, err := readNodeFrame(r)
+	if err != nil {
+		return segmentCollectionNode{}, err
+	}
+
+	out, err := ParseCollectionNode(bytes.NewReader(node))
+	out.offset = frameSize
+	return out, err
+}
+
+// parseCollectionNodeInto is the reusable counterpart of parseCollectionNode
+func (s *segment) parseCollectionNodeInto(offset nodeOffset,
+	out *segmentCollectionNode,
+) error {
+	r, err := s.newNodeReader(offset)
+	if err != nil {
+		return err
+	}
+
+	return s.parseCollectionNodeFromInto(r, out)
+}
+
+// parseCollectionNodeFromInto is the reusable counterpart of
+// parseCollectionNodeFrom
+func (s *segment) parseCollectionNodeFromInto(r io.Reader,
+	out *segmentCollectionNode,
+) error {
+	if !s.compressed() {
+		return ParseCollectionNodeInto(r, out)
+	}
+
+	node, frameSize, err := readNodeFrame(r)
+	if err != nil {
+		return err
+	}
+
+	err = ParseCollectionNodeInto(bytes.NewReader(node), out)
+	out.offset = frameSize
+	return err
+}
+
 func (s *segment) collectionStratParseData(in []byte) ([]value, error) {
 	if len(in) == 0 {
 		return nil, lsmkv.NotFound
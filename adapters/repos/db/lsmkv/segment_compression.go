@@ -0,0 +1,216 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/klauspost/compress/snappy"
+	"github.com/klauspost/compress/zstd"
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
+)
+
+const (
+	CompressionNone   = "none"
+	CompressionSnappy = "snappy"
+	CompressionZstd   = "zstd"
+)
+
+// codec ids as they are written in front of every compressed node
+const (
+	codecNone byte = iota
+	codecSnappy
+	codecZstd
+)
+
+// a compressed node is written as a frame of
+//
+//	byte      meaning
+//	0         codec id
+//	1-8       length of the compressed payload as Little Endian uint64
+//	9-length  compressed payload, i.e. the regular (version 0) node
+//
+// Only version 1 segments contain frames. Since the codec is recorded for
+// every node, segments written with different compression settings can be
+// read and compacted alongside each other.
+const nodeFrameHeaderSize = 9
+
+var (
+	zstdOnce    sync.Once
+	zstdEncoder *zstd.Encoder
+	zstdDecoder *zstd.Decoder
+	zstdErr     error
+)
+
+// initZstd lazily creates the shared zstd encoder and decoder. Both are safe
+// for concurrent use through EncodeAll and DecodeAll.
+func initZstd() error {
+	zstdOnce.Do(func() {
+		zstdEncoder, zstdErr = zstd.NewWriter(nil,
+			zstd.WithEncoderLevel(zstd.SpeedDefault))
+		if zstdErr != nil {
+			return
+		}
+		zstdDecoder, zstdErr = zstd.NewReader(nil,
+			zstd.WithDecoderConcurrency(0))
+	})
+	return zstdErr
+}
+
+// ValidCompression returns an error if compression is not a known algorithm
+func ValidCompression(compression string) error {
+	switch compression {
+	case "", CompressionNone, CompressionSnappy, CompressionZstd:
+		return nil
+	default:
+		return errors.Errorf("unrecognized compression %q", compression)
+	}
+}
+
+// nodeCompressor wraps the serialized nodes of a segment in compressed
+// frames. A nil *nodeCompressor writes the nodes uncompressed, so callers
+// don't need to distinguish between the two cases.
+type nodeCompressor struct {
+	codec byte
+	buf   *bytes.Buffer
+	out   []byte
+}
+
+func newNodeCompressor(compression string) (*nodeCompressor, error) {
+	switch compression {
+	case "", CompressionNone:
+		return nil, nil
+	case CompressionSnappy:
+		return &nodeCompressor{codec: codecSnappy, buf: &bytes.Buffer{}}, nil
+	case CompressionZstd:
+		if err := initZstd(); err != nil {
+			return nil, errors.Wrap(err, "init zstd")
+		}
+		return &nodeCompressor{codec: codecZstd, buf: &bytes.Buffer{}}, nil
+	default:
+		return nil, errors.Errorf("unrecognized compression %q", compression)
+	}
+}
+
+// version is the segment version the compressor produces
+func (c *nodeCompressor) version() uint16 {
+	if c == nil {
+		return segmentindex.VersionUncompressed
+	}
+	return segmentindex.VersionCompressed
+}
+
+type keyIndexWriter interface {
+	KeyIndexAndWriteTo(w io.Writer) (segmentindex.Key, error)
+}
+
+// writeNode writes the node to w. The returned key spans the frame, so that
+// the index points to the compressed representation of the node.
+func (c *nodeCompressor) writeNode(w io.Writer, offset int,
+	node keyIndexWriter,
+) (segmentindex.Key, error) {
+	if c == nil {
+		return node.KeyIndexAndWriteTo(w)
+	}
+
+	c.buf.Reset()
+	ki, err := node.KeyIndexAndWriteTo(c.buf)
+	if err != nil {
+		return ki, err
+	}
+
+	switch c.codec {
+	case codecSnappy:
+		c.out = snappy.Encode(c.out[:cap(c.out)], c.buf.Bytes())
+	case codecZstd:
+		c.out = zstdEncoder.EncodeAll(c.buf.Bytes(), c.out[:0])
+	}
+
+	var header [nodeFrameHeaderSize]byte
+	header[0] = c.codec
+	binary.LittleEndian.PutUint64(header[1:], uint64(len(c.out)))
+	if _, err := w.Write(header[:]); err != nil {
+		return ki, errors.Wrap(err, "write frame header")
+	}
+	if _, err := w.Write(c.out); err != nil {
+		return ki, errors.Wrap(err, "write compressed node")
+	}
+
+	ki.ValueStart = offset
+	ki.ValueEnd = offset + nodeFrameHeaderSize + len(c.out)
+	return ki, nil
+}
+
+// decodeNodeFrame decompresses the frame at the beginning of in. It returns
+// the regular node as well as the size of the frame on disk.
+func decodeNodeFrame(in []byte) ([]byte, int, error) {
+	if len(in) < nodeFrameHeaderSize {
+		return nil, 0, fmt.Errorf("node frame of %d bytes is too short", len(in))
+	}
+
+	payloadLen := binary.LittleEndian.Uint64(in[1:nodeFrameHeaderSize])
+	end := nodeFrameHeaderSize + payloadLen
+	if uint64(len(in)) < end {
+		return nil, 0, fmt.Errorf("node frame of %d bytes is truncated, expected %d",
+			len(in), end)
+	}
+
+	node, err := decompressNode(in[0], in[nodeFrameHeaderSize:end])
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return node, int(end), nil
+}
+
+// readNodeFrame is the io.Reader counterpart of decodeNodeFrame
+func readNodeFrame(r io.Reader) ([]byte, int, error) {
+	var header [nodeFrameHeaderSize]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		return nil, 0, errors.Wrap(err, "read frame header")
+	}
+
+	payload := make([]byte, binary.LittleEndian.Uint64(header[1:]))
+	if _, err := io.ReadFull(r, payload); err != nil {
+		return nil, 0, errors.Wrap(err, "read compressed node")
+	}
+
+	node, err := decompressNode(header[0], payload)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return node, nodeFrameHeaderSize + len(payload), nil
+}
+
+func decompressNode(codec byte, payload []byte) ([]byte, error) {
+	switch codec {
+	case codecNone:
+		return payload, nil
+	case codecSnappy:
+		node, err := snappy.Decode(nil, payload)
+		return node, errors.Wrap(err, "snappy decode node")
+	case codecZstd:
+		if err := initZstd(); err != nil {
+			return nil, errors.Wrap(err, "init zstd")
+		}
+		node, err := zstdDecoder.DecodeAll(payload, nil)
+		return node, errors.Wrap(err, "zstd decode node")
+	default:
+		return nil, fmt.Errorf("unknown compression codec %d", codec)
+	}
+}
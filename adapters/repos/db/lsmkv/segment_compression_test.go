@@ -0,0 +1,87 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
+)
+
+func TestNodeCompressor(t *testing.T) {
+	node := segmentCollectionNode{
+		primaryKey: []byte("my-primary-key"),
+		values: []value{{
+			value: bytes.Repeat([]byte("compressible"), 32),
+		}, {
+			value:     []byte("deleted"),
+			tombstone: true,
+		}},
+	}
+
+	var plain bytes.Buffer
+	_, err := node.KeyIndexAndWriteTo(&plain)
+	require.Nil(t, err)
+
+	t.Run("nil compressor writes plain nodes", func(t *testing.T) {
+		c, err := newNodeCompressor(CompressionNone)
+		require.Nil(t, err)
+		assert.Nil(t, c)
+		assert.Equal(t, segmentindex.VersionUncompressed, c.version())
+
+		var buf bytes.Buffer
+		ki, err := c.writeNode(&buf, 0, &node)
+		require.Nil(t, err)
+		assert.Equal(t, plain.Bytes(), buf.Bytes())
+		assert.Equal(t, plain.Len(), ki.ValueEnd)
+	})
+
+	for _, compression := range []string{CompressionSnappy, CompressionZstd} {
+		t.Run(compression, func(t *testing.T) {
+			c, err := newNodeCompressor(compression)
+			require.Nil(t, err)
+			assert.Equal(t, segmentindex.VersionCompressed, c.version())
+
+			offset := 16
+			var buf bytes.Buffer
+			ki, err := c.writeNode(&buf, offset, &node)
+			require.Nil(t, err)
+			assert.Equal(t, []byte("my-primary-key"), ki.Key)
+			assert.Equal(t, offset, ki.ValueStart)
+			assert.Equal(t, offset+buf.Len(), ki.ValueEnd)
+			assert.Less(t, buf.Len(), plain.Len())
+
+			decoded, size, err := decodeNodeFrame(buf.Bytes())
+			require.Nil(t, err)
+			assert.Equal(t, buf.Len(), size)
+			assert.Equal(t, plain.Bytes(), decoded)
+
+			read, size, err := readNodeFrame(bytes.NewReader(buf.Bytes()))
+			require.Nil(t, err)
+			assert.Equal(t, buf.Len(), size)
+			assert.Equal(t, plain.Bytes(), read)
+
+			_, _, err = decodeNodeFrame(buf.Bytes()[:buf.Len()-1])
+			assert.NotNil(t, err)
+		})
+	}
+
+	t.Run("unknown compression", func(t *testing.T) {
+		_, err := newNodeCompressor("lz4")
+		assert.NotNil(t, err)
+		assert.NotNil(t, ValidCompression("lz4"))
+		assert.Nil(t, ValidCompression(CompressionZstd))
+	})
+}
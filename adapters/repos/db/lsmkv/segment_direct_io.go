@@ -0,0 +1,57 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"io"
+
+	"github.com/weaviate/weaviate/entities/diskio"
+)
+
+// directIOWindowSize is the size of the reads issued by scans that bypass the
+// page cache. Every such cursor holds one window per segment.
+const directIOWindowSize = 1024 * 1024
+
+// newDirectReader opens the segment for a sequential scan which bypasses the
+// page cache. It returns nil if direct io is not enabled for the segment or
+// not supported by the filesystem, in which case the scan reads from the
+// memory-mapped contents instead.
+//
+// Roaring set segments are always scanned through the memory-mapped
+// contents, as their cursors hand out bitmaps backed by them.
+func (s *segment) newDirectReader() *diskio.DirectReader {
+	if !s.directIO {
+		return nil
+	}
+
+	r, err := diskio.NewDirectReader(s.path, directIOWindowSize)
+	if err != nil {
+		s.logger.WithField("action", "lsm_segment_direct_io").
+			WithField("path", s.path).
+			WithError(err).
+			Debug("direct io not supported, falling back to mmap")
+		return nil
+	}
+
+	return r
+}
+
+// directNodeReader returns a reader which starts at the node at offset
+func directNodeReader(r *diskio.DirectReader, offset uint64) io.Reader {
+	return io.NewSectionReader(r, int64(offset), r.Size()-int64(offset))
+}
+
+func closeDirectReader(r *diskio.DirectReader) {
+	if r != nil {
+		r.Close()
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"path/filepath"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
@@ -56,11 +57,36 @@ type SegmentGroup struct {
 	monitorCount bool
 
 	mmapContents bool
+
+	// compression of the segments created through compaction
+	compression string
+
+	// compactionStrategy selects the segments that are merged next, see
+	// CompactionStrategyLeveled and CompactionStrategyTiered
+	compactionStrategy  string
+	compactionSizeRatio float64
+
+	// optional, shared with the segment groups of other buckets
+	blockCache *BlockCache
+
+	// compactions and full scans bypass the page cache, see WithDirectIO
+	directIO bool
+
+	// see WithTermDictionary
+	useTermDictionary bool
+
+	// segments are verified against their checksums in the background, see
+	// WithScrubInterval
+	scrubInterval time.Duration
+	lastScrub     time.Time
 }
 
 func newSegmentGroup(dir string, logger logrus.FieldLogger,
 	mapRequiresSorting bool, metrics *Metrics, strategy string,
 	monitorCount bool, compactionCallbacks cyclemanager.CycleCallbackGroup, mmapContents bool,
+	compression, compactionStrategy string, compactionSizeRatio float64,
+	blockCache *BlockCache, directIO, useTermDictionary bool,
+	scrubInterval time.Duration,
 ) (*SegmentGroup, error) {
 	list, err := os.ReadDir(dir)
 	if err != nil {
@@ -76,6 +102,14 @@ func newSegmentGroup(dir string, logger logrus.FieldLogger,
 		mapRequiresSorting: mapRequiresSorting,
 		strategy:           strategy,
 		mmapContents:       mmapContents,
+		compression:        compression,
+
+		compactionStrategy:  compactionStrategy,
+		compactionSizeRatio: compactionSizeRatio,
+		blockCache:          blockCache,
+		directIO:            directIO,
+		useTermDictionary:   useTermDictionary,
+		scrubInterval:       scrubInterval,
 	}
 
 	segmentIndex := 0
@@ -119,7 +153,8 @@ func newSegmentGroup(dir string, logger logrus.FieldLogger,
 		}
 
 		segment, err := newSegment(filepath.Join(dir, entry.Name()), logger,
-			metrics, out.makeExistsOnLower(segmentIndex), mmapContents)
+			metrics, out.makeExistsOnLower(segmentIndex), mmapContents, blockCache,
+			directIO, useTermDictionary)
 		if err != nil {
 			return nil, errors.Wrapf(err, "init segment %s", entry.Name())
 		}
@@ -133,9 +168,11 @@ func newSegmentGroup(dir string, logger logrus.FieldLogger,
 	if out.monitorCount {
 		out.metrics.ObjectCount(out.count())
 	}
+	out.reportQuarantined()
 
 	id := "segmentgroup/compaction/" + out.dir
-	out.compactionCallbackCtrl = compactionCallbacks.Register(id, out.compactIfLevelsMatch)
+	out.compactionCallbackCtrl = compactionCallbacks.Register(id, out.compactIfLevelsMatch,
+		cyclemanager.WithPriority(out.readAmplification))
 
 	return out, nil
 }
@@ -164,10 +201,13 @@ func (sg *SegmentGroup) add(path string) error {
 
 	newSegmentIndex := len(sg.segments)
 	segment, err := newSegment(path, sg.logger, sg.metrics,
-		sg.makeExistsOnLower(newSegmentIndex), sg.mmapContents)
+		sg.makeExistsOnLower(newSegmentIndex), sg.mmapContents, sg.blockCache,
+		sg.directIO, sg.useTermDictionary)
 	if err != nil {
 		return errors.Wrapf(err, "init segment %s", path)
 	}
+	// the checksums were computed while flushing
+	segment.verifiedAt = time.Now()
 
 	sg.segments = append(sg.segments, segment)
 	return nil
@@ -338,7 +378,7 @@ func (sg *SegmentGroup) shutdown(ctx context.Context) error {
 	defer sg.maintenanceLock.Unlock()
 
 	for i, seg := range sg.segments {
-		if err := seg.close(); err != nil {
+		if err := seg.closeUnlessPinned(); err != nil {
 			return err
 		}
 
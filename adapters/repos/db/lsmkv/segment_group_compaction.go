@@ -13,21 +13,20 @@ package lsmkv
 
 import (
 	"fmt"
-	"math"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/roaringset"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
 	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/diskio"
+	"github.com/weaviate/weaviate/entities/storagestate"
 )
 
 func (sg *SegmentGroup) eligibleForCompaction() bool {
-	sg.maintenanceLock.RLock()
-	defer sg.maintenanceLock.RUnlock()
-
 	// if true, the parent shard has indicated that it has
 	// entered an immutable state. During this time, the
 	// SegmentGroup should refrain from flushing until its
@@ -36,63 +35,20 @@ func (sg *SegmentGroup) eligibleForCompaction() bool {
 		return false
 	}
 
-	// if there are at least two segments of the same level a regular compaction
-	// can be performed
-
-	levels := map[uint16]int{}
-
-	for _, segment := range sg.segments {
-		levels[segment.level]++
-		if levels[segment.level] > 1 {
-			return true
-		}
-	}
-
-	return false
+	_, ok := sg.bestCompactionCandidate()
+	return ok
 }
 
-func (sg *SegmentGroup) bestCompactionCandidatePair() []int {
+// bestCompactionCandidate picks the next pair of segments to merge according
+// to the compaction strategy of the segment group
+func (sg *SegmentGroup) bestCompactionCandidate() (compactionCandidate, bool) {
 	sg.maintenanceLock.RLock()
 	defer sg.maintenanceLock.RUnlock()
 
-	// first determine the lowest level with candidates
-	levels := map[uint16]int{}
-
-	for _, segment := range sg.segments {
-		levels[segment.level]++
-	}
-
-	currLowestLevel := uint16(math.MaxUint16)
-	found := false
-	for level, count := range levels {
-		if count < 2 {
-			continue
-		}
-
-		if level < currLowestLevel {
-			currLowestLevel = level
-			found = true
-		}
-	}
-
-	if !found {
-		return nil
+	if sg.compactionStrategy == CompactionStrategyTiered {
+		return sg.tieredCompactionCandidate()
 	}
-
-	// now pick any two segments which match the level
-	var res []int
-
-	for i, segment := range sg.segments {
-		if len(res) >= 2 {
-			break
-		}
-
-		if segment.level == currLowestLevel {
-			res = append(res, i)
-		}
-	}
-
-	return res
+	return sg.leveledCompactionCandidate()
 }
 
 // segmentAtPos retrieves the segment for the given position using a read-lock
@@ -110,11 +66,62 @@ func (sg *SegmentGroup) compactOnce() error {
 	// that the array contents stay stable over the duration of an entire
 	// compaction. We do however need to protect against a read-while-write (race
 	// condition) on the array. Thus any read from sg.segments need to protected
-	pair := sg.bestCompactionCandidatePair()
-	if pair == nil {
+	candidate, ok := sg.bestCompactionCandidate()
+	if !ok {
 		// nothing to do
 		return nil
 	}
+	return sg.compact(candidate)
+}
+
+// compactFully merges all segments that exist when it is called into a single
+// one, regardless of the compaction strategy. The newest segments are merged
+// first, as they are typically the smallest. Segments added by flushes in the
+// meantime are left untouched, so that this terminates under ongoing writes.
+// Background compactions must be paused by the caller.
+func (sg *SegmentGroup) compactFully() error {
+	if sg.isReadyOnly() {
+		return errors.Wrap(storagestate.ErrStatusReadOnly, "compact segments")
+	}
+
+	// flushed segments are only ever appended, so the positions of the
+	// segments existing at this point are stable
+	for right := sg.Len() - 1; right > 0; right-- {
+		sg.maintenanceLock.RLock()
+		level := sg.segments[right-1].level
+		if sg.segments[right].level > level {
+			level = sg.segments[right].level
+		}
+		sg.maintenanceLock.RUnlock()
+
+		if err := sg.compact(compactionCandidate{
+			left: right - 1, right: right, level: level,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (sg *SegmentGroup) compact(candidate compactionCandidate) error {
+	pair := []int{candidate.left, candidate.right}
+
+	compressor, err := newNodeCompressor(sg.compression)
+	if err != nil {
+		return err
+	}
+
+	// with scrubbing enabled, a corrupt segment must not be compacted, as the
+	// compacted segment would carry valid checksums for the corrupt data.
+	// Segments created since startup were checksummed while being written.
+	for _, pos := range pair {
+		if seg := sg.segmentAtPos(pos); sg.scrubInterval > 0 && seg.verifiedAt.IsZero() {
+			if err := sg.verifySegment(seg); err != nil {
+				return errors.Wrap(err, "verify segment before compaction")
+			}
+		}
+	}
 
 	path := fmt.Sprintf("%s.tmp", sg.segmentAtPos(pair[1]).path)
 	f, err := os.Create(path)
@@ -124,10 +131,10 @@ func (sg *SegmentGroup) compactOnce() error {
 
 	scratchSpacePath := sg.segmentAtPos(pair[1]).path + "compaction.scratch.d"
 
-	// the assumption is that both pairs are of the same level, so we can just
-	// take either value. If we want to support asymmetric compaction, then we
-	// might have to choose this value more intelligently
-	level := sg.segmentAtPos(pair[0]).level
+	// with the leveled strategy both segments are of the same level, the tiered
+	// strategy may merge segments of different levels, in which case the
+	// candidate carries the higher of the two
+	level := candidate.level
 	secondaryIndices := sg.segmentAtPos(pair[0]).secondaryIndexCount
 
 	strategy := sg.segmentAtPos(pair[0]).strategy
@@ -141,8 +148,13 @@ func (sg *SegmentGroup) compactOnce() error {
 	// TODO: call metrics just once with variable strategy label
 
 	case segmentindex.StrategyReplace:
-		c := newCompactorReplace(f, sg.segmentAtPos(pair[0]).newCursor(),
-			sg.segmentAtPos(pair[1]).newCursor(), level, secondaryIndices, scratchSpacePath)
+		leftCursor := sg.segmentAtPos(pair[0]).newCursor()
+		rightCursor := sg.segmentAtPos(pair[1]).newCursor()
+		defer leftCursor.close()
+		defer rightCursor.close()
+
+		c := newCompactorReplace(f, leftCursor, rightCursor, level,
+			secondaryIndices, scratchSpacePath, compressor)
 
 		if sg.metrics != nil {
 			sg.metrics.CompactionReplace.With(prometheus.Labels{"path": pathLabel}).Inc()
@@ -153,9 +165,13 @@ func (sg *SegmentGroup) compactOnce() error {
 			return err
 		}
 	case segmentindex.StrategySetCollection:
-		c := newCompactorSetCollection(f, sg.segmentAtPos(pair[0]).newCollectionCursor(),
-			sg.segmentAtPos(pair[1]).newCollectionCursor(), level, secondaryIndices,
-			scratchSpacePath)
+		leftCursor := sg.segmentAtPos(pair[0]).newCollectionCursor()
+		rightCursor := sg.segmentAtPos(pair[1]).newCollectionCursor()
+		defer leftCursor.close()
+		defer rightCursor.close()
+
+		c := newCompactorSetCollection(f, leftCursor, rightCursor, level,
+			secondaryIndices, scratchSpacePath, compressor)
 
 		if sg.metrics != nil {
 			sg.metrics.CompactionSet.With(prometheus.Labels{"path": pathLabel}).Inc()
@@ -166,10 +182,14 @@ func (sg *SegmentGroup) compactOnce() error {
 			return err
 		}
 	case segmentindex.StrategyMapCollection:
-		c := newCompactorMapCollection(f,
-			sg.segmentAtPos(pair[0]).newCollectionCursorReusable(),
-			sg.segmentAtPos(pair[1]).newCollectionCursorReusable(),
-			level, secondaryIndices, scratchSpacePath, sg.mapRequiresSorting)
+		leftCursor := sg.segmentAtPos(pair[0]).newCollectionCursorReusable()
+		rightCursor := sg.segmentAtPos(pair[1]).newCollectionCursorReusable()
+		defer leftCursor.close()
+		defer rightCursor.close()
+
+		c := newCompactorMapCollection(f, leftCursor, rightCursor,
+			level, secondaryIndices, scratchSpacePath, sg.mapRequiresSorting,
+			compressor)
 
 		if sg.metrics != nil {
 			sg.metrics.CompactionMap.With(prometheus.Labels{"path": pathLabel}).Inc()
@@ -202,6 +222,20 @@ func (sg *SegmentGroup) compactOnce() error {
 		return errors.Errorf("unrecognized strategy %v", strategy)
 	}
 
+	if sg.directIO {
+		// the pages written by the compaction would otherwise stay cached and
+		// evict hot data, they can only be dropped once they are on disk
+		if err := f.Sync(); err != nil {
+			return errors.Wrap(err, "sync compacted segment file")
+		}
+		if err := diskio.DropPageCache(f); err != nil {
+			sg.logger.WithField("action", "lsm_compaction").
+				WithField("path", path).
+				WithError(err).
+				Debug("drop page cache of compacted segment")
+		}
+	}
+
 	if err := f.Close(); err != nil {
 		return errors.Wrap(err, "close compacted segment file")
 	}
@@ -222,7 +256,7 @@ func (sg *SegmentGroup) replaceCompactedSegments(old1, old2 int,
 	sg.maintenanceLock.RUnlock()
 
 	precomputedFiles, err := preComputeSegmentMeta(newPathTmp,
-		updatedCountNetAdditions, sg.logger)
+		updatedCountNetAdditions, sg.useTermDictionary, sg.logger)
 	if err != nil {
 		return fmt.Errorf("precompute segment meta: %w", err)
 	}
@@ -230,11 +264,11 @@ func (sg *SegmentGroup) replaceCompactedSegments(old1, old2 int,
 	sg.maintenanceLock.Lock()
 	defer sg.maintenanceLock.Unlock()
 
-	if err := sg.segments[old1].close(); err != nil {
+	if err := sg.segments[old1].closeUnlessPinned(); err != nil {
 		return errors.Wrap(err, "close disk segment")
 	}
 
-	if err := sg.segments[old2].close(); err != nil {
+	if err := sg.segments[old2].closeUnlessPinned(); err != nil {
 		return errors.Wrap(err, "close disk segment")
 	}
 
@@ -265,10 +299,13 @@ func (sg *SegmentGroup) replaceCompactedSegments(old1, old2 int,
 		}
 	}
 
-	seg, err := newSegment(newPath, sg.logger, sg.metrics, nil, sg.mmapContents)
+	seg, err := newSegment(newPath, sg.logger, sg.metrics, nil, sg.mmapContents,
+		sg.blockCache, sg.directIO, sg.useTermDictionary)
 	if err != nil {
 		return errors.Wrap(err, "create new segment")
 	}
+	// the checksums were computed from the compacted contents
+	seg.verifiedAt = time.Now()
 
 	sg.segments[old2] = seg
 
@@ -304,6 +341,12 @@ func (sg *SegmentGroup) compactIfLevelsMatch(shouldAbort cyclemanager.ShouldAbor
 		return true
 	}
 
+	// scrubs run as part of the compaction cycle, so that they never run
+	// concurrently with a compaction of the same segments
+	if sg.scrubIfDue() {
+		return true
+	}
+
 	sg.logger.WithField("action", "lsm_compaction").
 		WithField("path", sg.dir).
 		Trace("no segment eligible for compaction")
@@ -0,0 +1,129 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"math"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// CompactionStrategyLeveled merges two neighboring segments of the same
+	// level, starting with the lowest level. Every segment is rewritten once
+	// per level, which keeps the number of segments low at the cost of write
+	// amplification.
+	CompactionStrategyLeveled = "leveled"
+
+	// CompactionStrategyTiered merges two neighboring segments of similar size,
+	// regardless of their level. Segments are rewritten less often than with
+	// the leveled strategy, which suits write-heavy buckets.
+	CompactionStrategyTiered = "tiered"
+
+	// DefaultCompactionSizeRatio is the largest size ratio between two
+	// segments the tiered strategy considers similar enough to merge
+	DefaultCompactionSizeRatio = 4.0
+)
+
+// ValidCompactionStrategy returns an error if strategy is not a known
+// compaction strategy. An empty strategy is valid and means leveled.
+func ValidCompactionStrategy(strategy string) error {
+	switch strategy {
+	case "", CompactionStrategyLeveled, CompactionStrategyTiered:
+		return nil
+	default:
+		return errors.Errorf("unrecognized compaction strategy %q", strategy)
+	}
+}
+
+// compactionCandidate is a pair of neighboring segments. Only neighbors are
+// ever merged, so that the order of segments, and therefore which write takes
+// precedence, is preserved regardless of the strategy that wrote them.
+type compactionCandidate struct {
+	left, right int
+
+	// level is passed to the compactors, which write the merged segment with
+	// level+1
+	level uint16
+}
+
+// not thread-safe on its own, as the assumption is that this is called from a
+// lockholder, e.g. within .bestCompactionCandidate()
+func (sg *SegmentGroup) leveledCompactionCandidate() (compactionCandidate, bool) {
+	// determine the lowest level that has two neighbors of the same level
+	var (
+		best  compactionCandidate
+		found bool
+	)
+
+	for i := 0; i+1 < len(sg.segments); i++ {
+		level := sg.segments[i].level
+		if level != sg.segments[i+1].level {
+			continue
+		}
+
+		if !found || level < best.level {
+			best = compactionCandidate{left: i, right: i + 1, level: level}
+			found = true
+		}
+	}
+
+	return best, found
+}
+
+// not thread-safe on its own, as the assumption is that this is called from a
+// lockholder, e.g. within .bestCompactionCandidate()
+func (sg *SegmentGroup) tieredCompactionCandidate() (compactionCandidate, bool) {
+	ratio := sg.compactionSizeRatio
+	if ratio < 1 {
+		ratio = DefaultCompactionSizeRatio
+	}
+
+	// out of all neighbors of similar size pick the smallest pair, which is
+	// the cheapest to merge and reduces the number of segments just the same
+	var (
+		best     compactionCandidate
+		bestSize = int64(math.MaxInt64)
+		found    bool
+	)
+
+	for i := 0; i+1 < len(sg.segments); i++ {
+		left, right := sg.segments[i], sg.segments[i+1]
+
+		smaller, larger := left.size, right.size
+		if smaller > larger {
+			smaller, larger = larger, smaller
+		}
+		if smaller <= 0 || float64(larger)/float64(smaller) > ratio {
+			continue
+		}
+
+		if size := left.size + right.size; size < bestSize {
+			level := left.level
+			if right.level > level {
+				level = right.level
+			}
+
+			best = compactionCandidate{left: i, right: i + 1, level: level}
+			bestSize = size
+			found = true
+		}
+	}
+
+	return best, found
+}
+
+// readAmplification is the number of disk segments a read may have to
+// consult. It is used to compact the buckets with the most segments first.
+func (sg *SegmentGroup) readAmplification() float64 {
+	return float64(sg.Len())
+}
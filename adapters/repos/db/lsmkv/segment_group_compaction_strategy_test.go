@@ -0,0 +1,92 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompactionCandidates(t *testing.T) {
+	type seg struct {
+		level uint16
+		size  int64
+	}
+
+	segmentGroup := func(strategy string, segs ...seg) *SegmentGroup {
+		sg := &SegmentGroup{
+			compactionStrategy:  strategy,
+			compactionSizeRatio: 2,
+		}
+		for _, s := range segs {
+			sg.segments = append(sg.segments, &segment{level: s.level, size: s.size})
+		}
+		return sg
+	}
+
+	tests := []struct {
+		name     string
+		strategy string
+		segments []seg
+		expected *compactionCandidate
+	}{
+		{
+			name:     "leveled, single segment",
+			strategy: CompactionStrategyLeveled,
+			segments: []seg{{0, 10}},
+		},
+		{
+			name:     "leveled, lowest level first",
+			strategy: CompactionStrategyLeveled,
+			segments: []seg{{2, 400}, {2, 400}, {1, 200}, {0, 100}, {0, 100}},
+			expected: &compactionCandidate{left: 3, right: 4, level: 0},
+		},
+		{
+			name:     "leveled, same level but not neighbors",
+			strategy: CompactionStrategyLeveled,
+			segments: []seg{{1, 200}, {0, 100}, {1, 200}},
+		},
+		{
+			name:     "tiered, sizes too different",
+			strategy: CompactionStrategyTiered,
+			segments: []seg{{0, 1000}, {0, 10}},
+		},
+		{
+			name:     "tiered, smallest similar pair regardless of level",
+			strategy: CompactionStrategyTiered,
+			segments: []seg{{3, 1000}, {3, 900}, {1, 100}, {0, 10}, {0, 15}},
+			expected: &compactionCandidate{left: 3, right: 4, level: 0},
+		},
+		{
+			name:     "tiered, neighbors of different levels",
+			strategy: CompactionStrategyTiered,
+			segments: []seg{{3, 1000}, {1, 600}, {0, 10}},
+			expected: &compactionCandidate{left: 0, right: 1, level: 3},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			sg := segmentGroup(test.strategy, test.segments...)
+
+			candidate, ok := sg.bestCompactionCandidate()
+			if test.expected == nil {
+				assert.False(t, ok)
+				return
+			}
+
+			assert.True(t, ok)
+			assert.Equal(t, *test.expected, candidate)
+		})
+	}
+}
@@ -0,0 +1,162 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// QuarantineDir is the directory inside a bucket that corrupt segments are
+// moved to. They are kept for inspection and never loaded again.
+const QuarantineDir = "quarantine"
+
+// scrubIfDue verifies the checksums of the segment that was verified least
+// recently, if that was longer than the scrub interval ago. Only one segment
+// is verified per call and the calls are spread out, so that a full pass
+// takes roughly the scrub interval. Returns true if a segment was verified.
+func (sg *SegmentGroup) scrubIfDue() bool {
+	if sg.scrubInterval <= 0 {
+		return false
+	}
+
+	sg.maintenanceLock.RLock()
+	var next *segment
+	for _, seg := range sg.segments {
+		if next == nil || seg.verifiedAt.Before(next.verifiedAt) {
+			next = seg
+		}
+	}
+	count := len(sg.segments)
+	sg.maintenanceLock.RUnlock()
+
+	if next == nil || time.Since(next.verifiedAt) < sg.scrubInterval {
+		return false
+	}
+
+	if time.Since(sg.lastScrub) < sg.scrubInterval/time.Duration(count) {
+		return false
+	}
+	sg.lastScrub = time.Now()
+
+	if err := sg.verifySegment(next); err != nil {
+		sg.logger.WithField("action", "lsm_segment_scrub").
+			WithField("path", next.path).
+			WithError(err).
+			Error("segment scrub failed")
+	}
+
+	return true
+}
+
+// verifySegment verifies the checksums of a segment of the group. A corrupt
+// segment is quarantined, so that its data is not served anymore. Must not be
+// called concurrently with a compaction.
+func (sg *SegmentGroup) verifySegment(seg *segment) error {
+	err := seg.verifyChecksums()
+	if err == nil {
+		seg.verifiedAt = time.Now()
+		return nil
+	}
+
+	if !errors.Is(err, ErrSegmentCorrupt) {
+		return err
+	}
+
+	sg.metrics.SegmentChecksumFailure(sg.strategy, sg.dir)
+	sg.logger.WithField("action", "lsm_segment_scrub").
+		WithField("path", seg.path).
+		WithError(err).
+		Error("segment is corrupt and will be quarantined, its data is no " +
+			"longer served")
+
+	if qerr := sg.quarantine(seg); qerr != nil {
+		return errors.Wrapf(qerr, "quarantine segment after %v", err)
+	}
+
+	return err
+}
+
+// quarantine removes the segment from the group and moves its files to the
+// quarantine directory of the bucket
+func (sg *SegmentGroup) quarantine(seg *segment) error {
+	sg.maintenanceLock.Lock()
+	defer sg.maintenanceLock.Unlock()
+
+	pos := -1
+	for i := range sg.segments {
+		if sg.segments[i] == seg {
+			pos = i
+			break
+		}
+	}
+	if pos == -1 {
+		return errors.Errorf("segment %s is not part of the segment group", seg.path)
+	}
+
+	if err := seg.closeUnlessPinned(); err != nil {
+		return errors.Wrap(err, "close corrupt segment")
+	}
+	sg.segments = append(sg.segments[:pos], sg.segments[pos+1:]...)
+
+	dir := filepath.Join(sg.dir, QuarantineDir)
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return errors.Wrap(err, "create quarantine dir")
+	}
+
+	for _, path := range seg.files() {
+		err := os.Rename(path, filepath.Join(dir, filepath.Base(path)))
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return errors.Wrapf(err, "move %s to quarantine", path)
+		}
+	}
+
+	if sg.monitorCount {
+		count := 0
+		for _, seg := range sg.segments {
+			count += seg.countNetAdditions
+		}
+		sg.metrics.ObjectCount(count)
+	}
+	sg.reportQuarantined()
+
+	return nil
+}
+
+func (sg *SegmentGroup) reportQuarantined() {
+	count, err := countQuarantinedSegments(sg.dir)
+	if err != nil {
+		sg.logger.WithField("action", "lsm_segment_scrub").
+			WithField("path", sg.dir).
+			WithError(err).
+			Warn("count quarantined segments")
+		return
+	}
+
+	sg.metrics.QuarantinedSegments(sg.strategy, sg.dir, count)
+}
+
+func countQuarantinedSegments(bucketDir string) (int, error) {
+	matches, err := filepath.Glob(filepath.Join(bucketDir, QuarantineDir, "*.db"))
+	return len(matches), err
+}
+
+// CountQuarantinedSegments returns the number of segments that were
+// quarantined across all buckets of the store in dir. It does not require the
+// store to be loaded.
+func CountQuarantinedSegments(dir string) (int, error) {
+	matches, err := filepath.Glob(filepath.Join(dir, "*", QuarantineDir, "*.db"))
+	return len(matches), err
+}
@@ -20,6 +20,7 @@ import (
 	"strings"
 
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
+	"github.com/weaviate/weaviate/entities/lsmkv"
 )
 
 // ErrInvalidChecksum indicates that the read file should not be trusted. For
@@ -84,10 +85,16 @@ func (s *segment) initCountNetAdditions(exists existsOnLowerSegmentsFn) error {
 		}
 	}
 
-	extr := newBufferedKeyAndTombstoneExtractor(s.contents, s.dataStartPos,
-		s.dataEndPos, 10e6, s.secondaryIndexCount, cb)
+	if s.compressed() {
+		if err := s.compressedKeysAndTombstones(cb); err != nil {
+			return fmt.Errorf("extract keys and tombstones: %w", err)
+		}
+	} else {
+		extr := newBufferedKeyAndTombstoneExtractor(s.contents, s.dataStartPos,
+			s.dataEndPos, 10e6, s.secondaryIndexCount, cb)
 
-	extr.do()
+		extr.do()
+	}
 
 	s.countNetAdditions = countNet
 
@@ -102,6 +109,25 @@ func (s *segment) initCountNetAdditions(exists existsOnLowerSegmentsFn) error {
 	return nil
 }
 
+// compressedKeysAndTombstones replaces the bufferedKeyAndTombstoneExtractor
+// on compressed segments. The extractor skips over the values of the raw
+// nodes, which isn't possible once they are compressed, so every node is
+// decompressed through a regular cursor instead.
+func (s *segment) compressedKeysAndTombstones(cb keyAndTombstoneCallbackFn) error {
+	c := s.newCursor()
+	defer c.close()
+
+	for key, _, err := c.first(); key != nil; key, _, err = c.next() {
+		if err != nil && !errors.Is(err, lsmkv.Deleted) {
+			return err
+		}
+
+		cb(key, errors.Is(err, lsmkv.Deleted))
+	}
+
+	return nil
+}
+
 func (s *segment) storeCountNetOnDisk() error {
 	return storeCountNetOnDisk(s.countNetPath(), s.countNetAdditions)
 }
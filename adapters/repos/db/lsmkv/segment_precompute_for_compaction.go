@@ -29,7 +29,7 @@ import (
 // created will have a .tmp suffix so they don't interfere with existing
 // segments that might have a similar name.
 func preComputeSegmentMeta(path string, updatedCountNetAdditions int,
-	logger logrus.FieldLogger,
+	useTermDictionary bool, logger logrus.FieldLogger,
 ) ([]string, error) {
 	out := []string{path}
 
@@ -122,6 +122,29 @@ func preComputeSegmentMeta(path string, updatedCountNetAdditions int,
 
 	out = append(out, fmt.Sprintf("%s.tmp", ind.bloomFilterPath()))
 
+	crcPath := fmt.Sprintf("%s.tmp", ind.checksumPath())
+	if err := storeBlockChecksums(crcPath, computeBlockChecksums(contents)); err != nil {
+		return nil, err
+	}
+
+	out = append(out, crcPath)
+
+	if useTermDictionary {
+		if err := ind.precomputeTermDictionary(); err != nil {
+			return nil, err
+		}
+
+		out = append(out, fmt.Sprintf("%s.tmp", ind.termDictionaryPath()))
+	}
+
+	if ind.strategy == segmentindex.StrategyRoaringSet {
+		if err := ind.precomputeRoaringSetStats(); err != nil {
+			return nil, err
+		}
+
+		out = append(out, fmt.Sprintf("%s.tmp", ind.roaringSetStatsPath()))
+	}
+
 	if ind.strategy != segmentindex.StrategyReplace {
 		// only "replace" has count net additions, so we are done
 		return out, nil
@@ -91,15 +91,16 @@ func precomputeSegmentMeta_Replace(ctx context.Context, t *testing.T, opts []Buc
 	err = os.Rename(path.Join(dirName, fname), segmentTmp)
 	require.Nil(t, err)
 
-	fileNames, err := preComputeSegmentMeta(segmentTmp, 1, logger)
+	fileNames, err := preComputeSegmentMeta(segmentTmp, 1, false, logger)
 	require.Nil(t, err)
 
-	// there should be 4 files and they should all have a .tmp suffix:
+	// there should be 5 files and they should all have a .tmp suffix:
 	// segment.db.tmp
 	// segment.cna.tmp
 	// segment.bloom.tmp
 	// segment.secondary.0.bloom.tmp
-	assert.Len(t, fileNames, 4)
+	// segment.crc.tmp
+	assert.Len(t, fileNames, 5)
 	for _, fName := range fileNames {
 		assert.True(t, strings.HasSuffix(fName, ".tmp"))
 	}
@@ -148,13 +149,14 @@ func precomputeSegmentMeta_Set(ctx context.Context, t *testing.T, opts []BucketO
 	err = os.Rename(path.Join(dirName, fname), segmentTmp)
 	require.Nil(t, err)
 
-	fileNames, err := preComputeSegmentMeta(segmentTmp, 1, logger)
+	fileNames, err := preComputeSegmentMeta(segmentTmp, 1, false, logger)
 	require.Nil(t, err)
 
-	// there should be 2 files and they should all have a .tmp suffix:
+	// there should be 3 files and they should all have a .tmp suffix:
 	// segment.db.tmp
 	// segment.bloom.tmp
-	assert.Len(t, fileNames, 2)
+	// segment.crc.tmp
+	assert.Len(t, fileNames, 3)
 	for _, fName := range fileNames {
 		assert.True(t, strings.HasSuffix(fName, ".tmp"))
 	}
@@ -163,14 +165,14 @@ func precomputeSegmentMeta_Set(ctx context.Context, t *testing.T, opts []BucketO
 func TestPrecomputeSegmentMeta_UnhappyPaths(t *testing.T) {
 	t.Run("file without .tmp suffix", func(t *testing.T) {
 		logger, _ := test.NewNullLogger()
-		_, err := preComputeSegmentMeta("a-path-without-the-required-suffix", 7, logger)
+		_, err := preComputeSegmentMeta("a-path-without-the-required-suffix", 7, false, logger)
 		require.NotNil(t, err)
 		assert.Contains(t, err.Error(), "expects a .tmp segment")
 	})
 
 	t.Run("file does not exist", func(t *testing.T) {
 		logger, _ := test.NewNullLogger()
-		_, err := preComputeSegmentMeta("i-dont-exist.tmp", 7, logger)
+		_, err := preComputeSegmentMeta("i-dont-exist.tmp", 7, false, logger)
 		require.NotNil(t, err)
 		unixErr := "no such file or directory"
 		windowsErr := "The system cannot find the file specified."
@@ -195,7 +197,7 @@ func TestPrecomputeSegmentMeta_UnhappyPaths(t *testing.T) {
 		err = f.Close()
 		require.Nil(t, err)
 
-		_, err = preComputeSegmentMeta(segmentName, 7, logger)
+		_, err = preComputeSegmentMeta(segmentName, 7, false, logger)
 		require.NotNil(t, err)
 		assert.Contains(t, err.Error(), "parse header")
 	})
@@ -219,7 +221,7 @@ func TestPrecomputeSegmentMeta_UnhappyPaths(t *testing.T) {
 		err = f.Close()
 		require.Nil(t, err)
 
-		_, err = preComputeSegmentMeta(segmentName, 7, logger)
+		_, err = preComputeSegmentMeta(segmentName, 7, false, logger)
 		require.NotNil(t, err)
 		assert.Contains(t, err.Error(), "unsupported strategy")
 	})
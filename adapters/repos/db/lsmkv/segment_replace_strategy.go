@@ -14,6 +14,7 @@ package lsmkv
 import (
 	"bytes"
 	"encoding/binary"
+	"io"
 	"time"
 
 	"github.com/pkg/errors"
@@ -28,7 +29,7 @@ func (s *segment) get(key []byte) ([]byte, error) {
 
 	before := time.Now()
 
-	if !s.bloomFilter.Test(key) {
+	if !s.mayContain(key) {
 		s.bloomFilterMetrics.trueNegative(before)
 		return nil, lsmkv.NotFound
 	}
@@ -61,6 +62,12 @@ func (s *segment) get(key []byte) ([]byte, error) {
 		return nil, err
 	}
 
+	if s.compressed() {
+		if contentsCopy, _, err = decodeNodeFrame(contentsCopy); err != nil {
+			return nil, err
+		}
+	}
+
 	return s.replaceStratParseData(contentsCopy)
 }
 
@@ -73,7 +80,7 @@ func (s *segment) getBySecondaryIntoMemory(pos int, key []byte, buffer []byte) (
 		return nil, errors.Errorf("no secondary index at pos %d", pos), nil
 	}
 
-	if !s.secondaryBloomFilters[pos].Test(key) {
+	if !s.secondaryMayContain(pos, key) {
 		return nil, lsmkv.NotFound, nil
 	}
 
@@ -102,7 +109,13 @@ func (s *segment) getBySecondaryIntoMemory(pos int, key []byte, buffer []byte) (
 	if err = s.copyNode(contentsCopy, nodeOffset{node.Start, node.End}); err != nil {
 		return nil, err, nil
 	}
-	currContent, err := s.replaceStratParseData(contentsCopy)
+	nodeContents := contentsCopy
+	if s.compressed() {
+		if nodeContents, _, err = decodeNodeFrame(contentsCopy); err != nil {
+			return nil, err, nil
+		}
+	}
+	currContent, err := s.replaceStratParseData(nodeContents)
 	return currContent, err, contentsCopy
 }
 
@@ -131,9 +144,15 @@ func (s *segment) replaceStratParseDataWithKey(in []byte) (segmentReplaceNode, e
 		return segmentReplaceNode{}, lsmkv.NotFound
 	}
 
-	r := bytes.NewReader(in)
+	r, frameSize, err := s.replaceStratNodeReader(in)
+	if err != nil {
+		return segmentReplaceNode{}, err
+	}
 
 	out, err := ParseReplaceNode(r, s.secondaryIndexCount)
+	if frameSize > 0 {
+		out.offset = frameSize
+	}
 	if err != nil {
 		return out, err
 	}
@@ -152,9 +171,15 @@ func (s *segment) replaceStratParseDataWithKeyInto(in []byte,
 		return lsmkv.NotFound
 	}
 
-	r := bytes.NewReader(in)
+	r, frameSize, err := s.replaceStratNodeReader(in)
+	if err != nil {
+		return err
+	}
 
-	err := ParseReplaceNodeInto(r, s.secondaryIndexCount, node)
+	err = ParseReplaceNodeInto(r, s.secondaryIndexCount, node)
+	if frameSize > 0 {
+		node.offset = frameSize
+	}
 	if err != nil {
 		return err
 	}
@@ -165,3 +190,83 @@ func (s *segment) replaceStratParseDataWithKeyInto(in []byte,
 
 	return nil
 }
+
+// replaceStratParseReaderWithKey is the io.Reader counterpart of
+// replaceStratParseDataWithKey, used by scans which bypass the page cache
+func (s *segment) replaceStratParseReaderWithKey(in io.Reader) (segmentReplaceNode, error) {
+	r, frameSize, err := s.replaceStratNodeReaderFrom(in)
+	if err != nil {
+		return segmentReplaceNode{}, err
+	}
+
+	out, err := ParseReplaceNode(r, s.secondaryIndexCount)
+	if frameSize > 0 {
+		out.offset = frameSize
+	}
+	if err != nil {
+		return out, err
+	}
+
+	if out.tombstone {
+		return out, lsmkv.Deleted
+	}
+
+	return out, nil
+}
+
+// replaceStratParseReaderWithKeyInto is the io.Reader counterpart of
+// replaceStratParseDataWithKeyInto
+func (s *segment) replaceStratParseReaderWithKeyInto(in io.Reader,
+	node *segmentReplaceNode,
+) error {
+	r, frameSize, err := s.replaceStratNodeReaderFrom(in)
+	if err != nil {
+		return err
+	}
+
+	err = ParseReplaceNodeInto(r, s.secondaryIndexCount, node)
+	if frameSize > 0 {
+		node.offset = frameSize
+	}
+	if err != nil {
+		return err
+	}
+
+	if node.tombstone {
+		return lsmkv.Deleted
+	}
+
+	return nil
+}
+
+// replaceStratNodeReaderFrom is the io.Reader counterpart of
+// replaceStratNodeReader
+func (s *segment) replaceStratNodeReaderFrom(in io.Reader) (io.Reader, int, error) {
+	if !s.compressed() {
+		return in, 0, nil
+	}
+
+	node, frameSize, err := readNodeFrame(in)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return bytes.NewReader(node), frameSize, nil
+}
+
+// replaceStratNodeReader returns a reader for the node at the beginning of in.
+// On compressed segments, the frame is decompressed first and its size on
+// disk is returned, as the offset of the parsed node only covers the
+// decompressed node.
+func (s *segment) replaceStratNodeReader(in []byte) (*bytes.Reader, int, error) {
+	if !s.compressed() {
+		return bytes.NewReader(in), 0, nil
+	}
+
+	node, frameSize, err := decodeNodeFrame(in)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return bytes.NewReader(node), frameSize, nil
+}
@@ -0,0 +1,269 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
+)
+
+// roaringSetStatsRanges is the maximum number of key ranges of the histogram
+// of a roaring set segment
+const roaringSetStatsRanges = 64
+
+// roaringSetStats is an equi-depth histogram of the keys of a roaring set
+// segment: the keys are divided into ranges of about the same number of keys,
+// and for each range the number of docs stored for its keys is kept. It is
+// used to estimate the selectivity of filters without reading their bitmaps.
+type roaringSetStats struct {
+	ranges []roaringSetStatsRange
+}
+
+type roaringSetStatsRange struct {
+	firstKey []byte
+	lastKey  []byte
+	keys     int
+	// the additions of all keys in the range. Deletions are not subtracted,
+	// as they remove docs from previous segments, and are kept even after
+	// they were applied in a compaction. Docs which were removed in a later
+	// segment are therefore counted too.
+	docs int
+}
+
+type roaringSetKeyCount struct {
+	key  []byte
+	docs int
+}
+
+func newRoaringSetStats(keyCounts []roaringSetKeyCount) *roaringSetStats {
+	perRange := (len(keyCounts) + roaringSetStatsRanges - 1) / roaringSetStatsRanges
+
+	st := &roaringSetStats{}
+	for start := 0; start < len(keyCounts); start += perRange {
+		end := start + perRange
+		if end > len(keyCounts) {
+			end = len(keyCounts)
+		}
+
+		r := roaringSetStatsRange{
+			firstKey: keyCounts[start].key,
+			lastKey:  keyCounts[end-1].key,
+			keys:     end - start,
+		}
+		for _, kc := range keyCounts[start:end] {
+			r.docs += kc.docs
+		}
+		st.ranges = append(st.ranges, r)
+	}
+
+	return st
+}
+
+// estimate returns the estimated number of docs stored for the keys between
+// from and to, both inclusive. A nil bound is unbounded. Ranges which only
+// partially overlap contribute the docs of an average key if a single key is
+// requested, and half of their docs otherwise.
+func (st *roaringSetStats) estimate(from, to []byte) float64 {
+	out := 0.0
+	for _, r := range st.ranges {
+		if from != nil && bytes.Compare(r.lastKey, from) < 0 {
+			continue
+		}
+		if to != nil && bytes.Compare(r.firstKey, to) > 0 {
+			continue
+		}
+
+		containsFrom := from == nil || bytes.Compare(from, r.firstKey) <= 0
+		containsTo := to == nil || bytes.Compare(r.lastKey, to) <= 0
+		switch {
+		case containsFrom && containsTo:
+			out += float64(r.docs)
+		case from != nil && to != nil && bytes.Equal(from, to):
+			out += float64(r.docs) / float64(r.keys)
+		default:
+			out += float64(r.docs) / 2
+		}
+	}
+	return out
+}
+
+func (st *roaringSetStats) marshal() []byte {
+	buf := new(bytes.Buffer)
+	binary.Write(buf, binary.LittleEndian, uint32(len(st.ranges)))
+	for _, r := range st.ranges {
+		binary.Write(buf, binary.LittleEndian, uint32(len(r.firstKey)))
+		buf.Write(r.firstKey)
+		binary.Write(buf, binary.LittleEndian, uint32(len(r.lastKey)))
+		buf.Write(r.lastKey)
+		binary.Write(buf, binary.LittleEndian, uint64(r.keys))
+		binary.Write(buf, binary.LittleEndian, uint64(r.docs))
+	}
+	return buf.Bytes()
+}
+
+func unmarshalRoaringSetStats(data []byte) (*roaringSetStats, error) {
+	r := bytes.NewReader(data)
+	var count uint32
+	if err := binary.Read(r, binary.LittleEndian, &count); err != nil {
+		return nil, fmt.Errorf("read number of ranges: %w", err)
+	}
+
+	readKey := func() ([]byte, error) {
+		var keyLen uint32
+		if err := binary.Read(r, binary.LittleEndian, &keyLen); err != nil {
+			return nil, err
+		}
+		key := make([]byte, keyLen)
+		if _, err := r.Read(key); err != nil && keyLen > 0 {
+			return nil, err
+		}
+		return key, nil
+	}
+
+	st := &roaringSetStats{ranges: make([]roaringSetStatsRange, count)}
+	for i := range st.ranges {
+		var err error
+		if st.ranges[i].firstKey, err = readKey(); err != nil {
+			return nil, fmt.Errorf("read first key of range %d: %w", i, err)
+		}
+		if st.ranges[i].lastKey, err = readKey(); err != nil {
+			return nil, fmt.Errorf("read last key of range %d: %w", i, err)
+		}
+		var keys, docs uint64
+		if err := binary.Read(r, binary.LittleEndian, &keys); err != nil {
+			return nil, fmt.Errorf("read keys of range %d: %w", i, err)
+		}
+		if err := binary.Read(r, binary.LittleEndian, &docs); err != nil {
+			return nil, fmt.Errorf("read docs of range %d: %w", i, err)
+		}
+		st.ranges[i].keys, st.ranges[i].docs = int(keys), int(docs)
+	}
+	return st, nil
+}
+
+func (s *segment) roaringSetStatsPath() string {
+	extless := strings.TrimSuffix(s.path, filepath.Ext(s.path))
+	return fmt.Sprintf("%s.rstats", extless)
+}
+
+func (s *segment) initRoaringSetStats() error {
+	if s.strategy != segmentindex.StrategyRoaringSet {
+		return nil
+	}
+
+	path := s.roaringSetStatsPath()
+	ok, err := fileExists(path)
+	if err != nil {
+		return err
+	}
+
+	if ok {
+		err = s.loadRoaringSetStatsFromDisk()
+		if err == nil {
+			return nil
+		}
+
+		if err != ErrInvalidChecksum {
+			// not a recoverable error
+			return err
+		}
+
+		// now continue re-calculating
+	}
+
+	before := time.Now()
+	if err := s.computeAndStoreRoaringSetStats(path); err != nil {
+		return err
+	}
+
+	took := time.Since(before)
+	s.logger.WithField("action", "lsm_init_disk_segment_build_roaring_set_stats").
+		WithField("path", s.path).
+		WithField("took", took).
+		Debugf("building roaring set stats took %s\n", took)
+	return nil
+}
+
+func (s *segment) computeAndStoreRoaringSetStats(path string) error {
+	var keyCounts []roaringSetKeyCount
+	c := s.newRoaringSetCursor()
+	for key, layer, err := c.First(); key != nil; key, layer, err = c.Next() {
+		if err != nil {
+			return fmt.Errorf("read key: %w", err)
+		}
+
+		keyCounts = append(keyCounts, roaringSetKeyCount{
+			// the key points into the contents of the segment, which are unmapped
+			// once the segment is closed
+			key:  append([]byte{}, key...),
+			docs: layer.Additions.GetCardinality(),
+		})
+	}
+
+	s.roaringSetStats = newRoaringSetStats(keyCounts)
+	if err := writeWithChecksum(s.roaringSetStats.marshal(), path); err != nil {
+		return fmt.Errorf("store roaring set stats on disk: %w", err)
+	}
+
+	return nil
+}
+
+// precomputeRoaringSetStats builds the stats of a compacted segment before it
+// replaces the segments it was compacted from
+func (s *segment) precomputeRoaringSetStats() error {
+	path := fmt.Sprintf("%s.tmp", s.roaringSetStatsPath())
+	ok, err := fileExists(path)
+	if err != nil {
+		return err
+	}
+
+	if ok {
+		return fmt.Errorf("roaring set stats already exist with path %s", path)
+	}
+
+	return s.computeAndStoreRoaringSetStats(path)
+}
+
+func (s *segment) loadRoaringSetStatsFromDisk() error {
+	data, err := loadWithChecksum(s.roaringSetStatsPath(), -1)
+	if err != nil {
+		return err
+	}
+
+	st, err := unmarshalRoaringSetStats(data)
+	if err != nil {
+		return err
+	}
+	s.roaringSetStats = st
+	return nil
+}
+
+// roaringSetEstimate sums up the estimates of all segments, see
+// roaringSetStats.estimate
+func (sg *SegmentGroup) roaringSetEstimate(from, to []byte) float64 {
+	sg.maintenanceLock.RLock()
+	defer sg.maintenanceLock.RUnlock()
+
+	out := 0.0
+	for _, seg := range sg.segments {
+		if seg.roaringSetStats != nil {
+			out += seg.roaringSetStats.estimate(from, to)
+		}
+	}
+	return out
+}
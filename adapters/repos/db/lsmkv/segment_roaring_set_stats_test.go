@@ -0,0 +1,157 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"context"
+	"encoding/binary"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+)
+
+func statsKey(i int) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, uint64(i))
+	return key
+}
+
+func TestRoaringSetStats(t *testing.T) {
+	// 640 keys, key i holds i%10 docs
+	keyCounts := make([]roaringSetKeyCount, 640)
+	total := 0
+	for i := range keyCounts {
+		keyCounts[i] = roaringSetKeyCount{key: statsKey(i), docs: i % 10}
+		total += i % 10
+	}
+
+	st := newRoaringSetStats(keyCounts)
+	require.Len(t, st.ranges, roaringSetStatsRanges)
+
+	t.Run("all keys", func(t *testing.T) {
+		assert.Equal(t, float64(total), st.estimate(nil, nil))
+	})
+
+	t.Run("single key", func(t *testing.T) {
+		// the average of the range, as the exact count isn't kept
+		assert.Equal(t, 4.5, st.estimate(statsKey(13), statsKey(13)))
+	})
+
+	t.Run("key range", func(t *testing.T) {
+		// half of the keys hold about half of the docs
+		estimate := st.estimate(nil, statsKey(319))
+		assert.InDelta(t, float64(total)/2, estimate, float64(total)/20)
+	})
+
+	t.Run("keys outside of the segment", func(t *testing.T) {
+		assert.Equal(t, 0.0, st.estimate(statsKey(1000), nil))
+	})
+
+	t.Run("marshal and unmarshal", func(t *testing.T) {
+		unmarshalled, err := unmarshalRoaringSetStats(st.marshal())
+		require.Nil(t, err)
+		assert.Equal(t, st, unmarshalled)
+	})
+
+	t.Run("fewer keys than ranges", func(t *testing.T) {
+		st := newRoaringSetStats(keyCounts[:3])
+		assert.Len(t, st.ranges, 3)
+		assert.Equal(t, 3.0, st.estimate(nil, nil))
+	})
+}
+
+func TestRoaringSetEstimateCardinality(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+	dirName := t.TempDir()
+
+	b, err := NewBucket(ctx, dirName, "", logger, nil,
+		cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+		WithStrategy(StrategyRoaringSet))
+	require.Nil(t, err)
+	defer b.Shutdown(ctx)
+
+	t.Run("memtables are not considered", func(t *testing.T) {
+		require.Nil(t, b.RoaringSetAddList(statsKey(1), []uint64{1, 2, 3}))
+		estimate, err := b.RoaringSetEstimateCardinality(nil, nil)
+		require.Nil(t, err)
+		assert.Equal(t, 0, estimate)
+	})
+
+	t.Run("stats are created on flush", func(t *testing.T) {
+		require.Nil(t, b.FlushAndSwitch())
+
+		estimate, err := b.RoaringSetEstimateCardinality(statsKey(1), statsKey(1))
+		require.Nil(t, err)
+		assert.Equal(t, 3, estimate)
+
+		files, err := os.ReadDir(dirName)
+		require.Nil(t, err)
+		_, ok := findFileWithExt(files, ".rstats")
+		assert.True(t, ok)
+	})
+
+	t.Run("stats are maintained during compaction", func(t *testing.T) {
+		require.Nil(t, b.RoaringSetAddList(statsKey(2), []uint64{4, 5}))
+		require.Nil(t, b.RoaringSetRemoveOne(statsKey(1), 1))
+		require.Nil(t, b.FlushAndSwitch())
+
+		require.True(t, b.disk.eligibleForCompaction())
+		require.Nil(t, b.disk.compactOnce())
+		require.Len(t, b.disk.segments, 1)
+
+		// the deletion was applied in the compaction
+		estimate, err := b.RoaringSetEstimateCardinality(nil, nil)
+		require.Nil(t, err)
+		assert.Equal(t, 4, estimate)
+
+		files, err := os.ReadDir(dirName)
+		require.Nil(t, err)
+		count := 0
+		for _, file := range files {
+			if strings.HasSuffix(file.Name(), ".rstats") {
+				count++
+			}
+		}
+		assert.Equal(t, 1, count, "stats of the compacted segments are dropped")
+	})
+
+	t.Run("stats are loaded on init", func(t *testing.T) {
+		require.Nil(t, b.Shutdown(ctx))
+
+		b2, err := NewBucket(ctx, dirName, "", logger, nil,
+			cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+			WithStrategy(StrategyRoaringSet))
+		require.Nil(t, err)
+		defer b2.Shutdown(ctx)
+
+		estimate, err := b2.RoaringSetEstimateCardinality(statsKey(2), nil)
+		require.Nil(t, err)
+		assert.Equal(t, 2, estimate)
+	})
+
+	t.Run("other strategies", func(t *testing.T) {
+		b, err := NewBucket(ctx, t.TempDir(), "", logger, nil,
+			cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+			WithStrategy(StrategyReplace))
+		require.Nil(t, err)
+		defer b.Shutdown(ctx)
+
+		_, err = b.RoaringSetEstimateCardinality(nil, nil)
+		assert.NotNil(t, err)
+	})
+}
@@ -26,7 +26,7 @@ func (s *segment) roaringSetGet(key []byte) (roaringset.BitmapLayer, error) {
 		return out, fmt.Errorf("need strategy %s", StrategyRoaringSet)
 	}
 
-	if !s.bloomFilter.Test(key) {
+	if !s.mayContain(key) {
 		return out, lsmkv.NotFound
 	}
 
@@ -52,6 +52,11 @@ func (s *segment) segmentNodeFromBuffer(offset nodeOffset) (*roaringset.SegmentN
 	var contents []byte
 	if s.mmapContents {
 		contents = s.contents[offset.start:offset.end]
+	} else if s.blockCache != nil {
+		var err error
+		if contents, err = s.readNodeCached(offset); err != nil {
+			return nil, err
+		}
 	} else {
 		contents = make([]byte, offset.end-offset.start)
 		r, err := s.bufferedReaderAt(offset.start)
@@ -0,0 +1,114 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+func (s *segment) termDictionaryPath() string {
+	extless := strings.TrimSuffix(s.path, filepath.Ext(s.path))
+	return fmt.Sprintf("%s.tdict", extless)
+}
+
+func (s *segment) initTermDictionary() error {
+	path := s.termDictionaryPath()
+	ok, err := fileExists(path)
+	if err != nil {
+		return err
+	}
+
+	if ok {
+		err = s.loadTermDictionaryFromDisk()
+		if err == nil {
+			return nil
+		}
+
+		if err != ErrInvalidChecksum {
+			// not a recoverable error
+			return err
+		}
+
+		// now continue re-calculating
+	}
+
+	before := time.Now()
+	if err := s.computeAndStoreTermDictionary(path); err != nil {
+		return err
+	}
+
+	took := time.Since(before)
+	s.logger.WithField("action", "lsm_init_disk_segment_build_term_dictionary").
+		WithField("path", s.path).
+		WithField("took", took).
+		Debugf("building term dictionary took %s\n", took)
+	return nil
+}
+
+func (s *segment) computeAndStoreTermDictionary(path string) error {
+	keys, err := s.index.AllKeys()
+	if err != nil {
+		return err
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		return bytes.Compare(keys[i], keys[j]) < 0
+	})
+	s.termDictionary = newTermDictionary(keys)
+
+	if err := writeWithChecksum(s.termDictionary.data, path); err != nil {
+		return fmt.Errorf("store term dictionary on disk: %w", err)
+	}
+
+	return nil
+}
+
+func (s *segment) precomputeTermDictionary() error {
+	before := time.Now()
+
+	path := fmt.Sprintf("%s.tmp", s.termDictionaryPath())
+	ok, err := fileExists(path)
+	if err != nil {
+		return err
+	}
+
+	if ok {
+		return fmt.Errorf("a term dictionary already exists with path %s", path)
+	}
+
+	if err := s.computeAndStoreTermDictionary(path); err != nil {
+		return err
+	}
+
+	took := time.Since(before)
+	s.logger.WithField("action", "lsm_precompute_disk_segment_build_term_dictionary").
+		WithField("path", s.path).
+		WithField("took", took).
+		Debugf("building term dictionary took %s\n", took)
+
+	return nil
+}
+
+func (s *segment) loadTermDictionaryFromDisk() error {
+	data, err := loadWithChecksum(s.termDictionaryPath(), -1)
+	if err != nil {
+		return err
+	}
+
+	s.termDictionary = &termDictionary{data: data}
+	return nil
+}
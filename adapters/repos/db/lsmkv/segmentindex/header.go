@@ -25,6 +25,14 @@ import (
 // for the pointer to the index part
 const HeaderSize = 16
 
+const (
+	// VersionUncompressed segments store their nodes as they are
+	VersionUncompressed uint16 = 0
+
+	// VersionCompressed segments wrap every node in a compressed frame
+	VersionCompressed uint16 = 1
+)
+
 type Header struct {
 	Level            uint16
 	Version          uint16
@@ -121,7 +129,7 @@ func ParseHeader(r io.Reader) (*Header, error) {
 		return nil, err
 	}
 
-	if out.Version != 0 {
+	if out.Version > VersionCompressed {
 		return nil, fmt.Errorf("unsupported version %d", out.Version)
 	}
 
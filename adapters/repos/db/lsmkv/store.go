@@ -18,6 +18,7 @@ import (
 	"path"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
@@ -37,6 +38,18 @@ type Store struct {
 
 	cycleCallbacks *storeCycleCallbacks
 
+	// applied to every bucket, see SetFlushThresholds
+	flushThresholds FlushThresholds
+
+	// shared by all buckets, see SetBlockCache
+	blockCache *BlockCache
+
+	// applied to every bucket, see SetDirectIO
+	directIO bool
+
+	// applied to every bucket, see SetScrubInterval
+	scrubInterval time.Duration
+
 	// Prevent concurrent manipulations to the bucketsByNameMap, most notably
 	// when initializing buckets in parallel
 	bucketAccessLock sync.RWMutex
@@ -119,7 +132,8 @@ func (s *Store) CreateOrLoadBucket(ctx context.Context, bucketName string,
 	}
 
 	b, err := NewBucket(ctx, s.bucketDir(bucketName), s.rootDir, s.logger, s.metrics,
-		s.cycleCallbacks.compactionCallbacks, s.cycleCallbacks.flushCallbacks, opts...)
+		s.cycleCallbacks.compactionCallbacks, s.cycleCallbacks.flushCallbacks,
+		s.bucketOptions(opts)...)
 	if err != nil {
 		return err
 	}
@@ -128,10 +142,51 @@ func (s *Store) CreateOrLoadBucket(ctx context.Context, bucketName string,
 	return nil
 }
 
+// SetBlockCache makes all buckets created or loaded afterwards use the cache,
+// see WithBlockCache
+func (s *Store) SetBlockCache(cache *BlockCache) {
+	s.blockCache = cache
+}
+
+// SetDirectIO makes all buckets created or loaded afterwards bypass the page
+// cache for compactions and full scans, see WithDirectIO
+func (s *Store) SetDirectIO(with bool) {
+	s.directIO = with
+}
+
+// SetScrubInterval makes all buckets created or loaded afterwards verify
+// their segments in the background, see WithScrubInterval
+func (s *Store) SetScrubInterval(interval time.Duration) {
+	s.scrubInterval = interval
+}
+
+func (s *Store) bucketOptions(opts []BucketOption) []BucketOption {
+	var defaults []BucketOption
+	if s.blockCache != nil {
+		defaults = append(defaults, WithBlockCache(s.blockCache))
+	}
+	if s.directIO {
+		defaults = append(defaults, WithDirectIO(true))
+	}
+	if s.scrubInterval > 0 {
+		defaults = append(defaults, WithScrubInterval(s.scrubInterval))
+	}
+	if len(defaults) == 0 {
+		return opts
+	}
+
+	// the store's defaults come first, so that they can be overridden per
+	// bucket
+	return append(defaults, opts...)
+}
+
 func (s *Store) setBucket(name string, b *Bucket) {
 	s.bucketAccessLock.Lock()
 	defer s.bucketAccessLock.Unlock()
 
+	if s.flushThresholds != (FlushThresholds{}) {
+		b.SetFlushThresholds(s.flushThresholds)
+	}
 	s.bucketsByName[name] = b
 }
 
@@ -282,7 +337,8 @@ func (s *Store) CreateBucket(ctx context.Context, bucketName string,
 	}
 
 	b, err := NewBucket(ctx, bucketDir, s.rootDir, s.logger, s.metrics,
-		s.cycleCallbacks.compactionCallbacks, s.cycleCallbacks.flushCallbacks, opts...)
+		s.cycleCallbacks.compactionCallbacks, s.cycleCallbacks.flushCallbacks,
+		s.bucketOptions(opts)...)
 	if err != nil {
 		return err
 	}
@@ -364,6 +420,27 @@ func (s *Store) RenameBucket(ctx context.Context, bucketName, newBucketName stri
 	return nil
 }
 
+// DropBucket shuts down the bucket with the given name and removes its
+// directory. Any state left on disk for this name is removed as well, even if
+// the bucket is not loaded.
+func (s *Store) DropBucket(ctx context.Context, bucketName string) error {
+	s.bucketAccessLock.Lock()
+	defer s.bucketAccessLock.Unlock()
+
+	if bucket := s.bucketsByName[bucketName]; bucket != nil {
+		delete(s.bucketsByName, bucketName)
+		if err := bucket.Shutdown(ctx); err != nil {
+			return errors.Wrapf(err, "failed shutting down bucket '%s'", bucketName)
+		}
+	}
+
+	bucketDir := s.bucketDir(bucketName)
+	if err := os.RemoveAll(bucketDir); err != nil {
+		return errors.Wrapf(err, "failed removing dir '%s'", bucketDir)
+	}
+	return nil
+}
+
 func (s *Store) updateBucketDir(bucket *Bucket, bucketDir, newBucketDir string) {
 	updatePath := func(src string) string {
 		return strings.Replace(src, bucketDir, newBucketDir, 1)
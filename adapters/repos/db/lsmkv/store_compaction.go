@@ -0,0 +1,44 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+// CompactFully flushes the memtables of all buckets and merges the disk
+// segments of each bucket into a single one, e.g. to reclaim disk space after
+// mass deletions. The buckets remain readable and writable meanwhile, only the
+// background compactions are paused. It returns the number of buckets that
+// have been compacted.
+func (s *Store) CompactFully(ctx context.Context) (int, error) {
+	if err := s.PauseCompaction(ctx); err != nil {
+		return 0, errors.Wrap(err, "pause compaction")
+	}
+	defer s.ResumeCompaction(ctx)
+
+	if err := s.FlushMemtables(ctx); err != nil {
+		return 0, errors.Wrap(err, "flush memtables")
+	}
+
+	compact := func(ctx context.Context, b *Bucket) (interface{}, error) {
+		return nil, b.disk.compactFully()
+	}
+	res, err := s.runJobOnBuckets(ctx, compact, nil)
+	if err != nil {
+		return 0, errors.Wrap(err, "compact buckets")
+	}
+
+	return len(res), nil
+}
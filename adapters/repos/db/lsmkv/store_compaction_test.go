@@ -0,0 +1,88 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+)
+
+func TestStoreCompactFully(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+	dir := t.TempDir()
+
+	store, err := New(dir, dir, logger, nil,
+		cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop())
+	require.Nil(t, err)
+	defer store.Shutdown(ctx)
+
+	require.Nil(t, store.CreateOrLoadBucket(ctx, "replace", WithStrategy(StrategyReplace)))
+	require.Nil(t, store.CreateOrLoadBucket(ctx, "set", WithStrategy(StrategySetCollection)))
+	replace := store.Bucket("replace")
+	set := store.Bucket("set")
+
+	// three segments plus a fourth batch left in the memtable, the leveled
+	// strategy alone would end up with segments of different levels
+	for i := 0; i < 4; i++ {
+		for j := 0; j < 10; j++ {
+			key := []byte(fmt.Sprintf("key-%d", j))
+			require.Nil(t, replace.Put(key, []byte(fmt.Sprintf("value-%d-%d", i, j))))
+			require.Nil(t, set.SetAdd(key, [][]byte{[]byte(fmt.Sprintf("value-%d", i))}))
+		}
+		if i < 3 {
+			require.Nil(t, replace.FlushAndSwitch())
+			require.Nil(t, set.FlushAndSwitch())
+		}
+	}
+	for j := 0; j < 5; j++ {
+		require.Nil(t, replace.Delete([]byte(fmt.Sprintf("key-%d", j))))
+	}
+
+	buckets, err := store.CompactFully(ctx)
+	require.Nil(t, err)
+	assert.Equal(t, 2, buckets)
+
+	assert.Equal(t, 1, replace.disk.Len())
+	assert.Equal(t, 1, set.disk.Len())
+
+	for j := 0; j < 10; j++ {
+		key := []byte(fmt.Sprintf("key-%d", j))
+
+		val, err := replace.Get(key)
+		require.Nil(t, err)
+		if j < 5 {
+			assert.Nil(t, val)
+		} else {
+			assert.Equal(t, []byte(fmt.Sprintf("value-3-%d", j)), val)
+		}
+
+		vals, err := set.SetList(key)
+		require.Nil(t, err)
+		assert.ElementsMatch(t, [][]byte{
+			[]byte("value-0"), []byte("value-1"), []byte("value-2"), []byte("value-3"),
+		}, vals)
+	}
+
+	t.Run("a single segment is left as is", func(t *testing.T) {
+		buckets, err := store.CompactFully(ctx)
+		require.Nil(t, err)
+		assert.Equal(t, 2, buckets)
+		assert.Equal(t, 1, replace.disk.Len())
+	})
+}
@@ -97,3 +97,33 @@ func testStoreLifecycle(ctx context.Context, t *testing.T, opts []BucketOption)
 		require.Nil(t, err)
 	})
 }
+
+func TestStoreDropBucket(t *testing.T) {
+	dirName := t.TempDir()
+
+	store, err := New(dirName, dirName, nullLogger(), nil,
+		cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop())
+	require.Nil(t, err)
+	defer store.Shutdown(context.Background())
+
+	require.Nil(t, store.CreateOrLoadBucket(testCtx(), "bucket1",
+		WithStrategy(StrategyReplace)))
+	require.Nil(t, store.Bucket("bucket1").Put([]byte("name"), []byte("Jane Doe")))
+	require.Nil(t, store.Bucket("bucket1").FlushAndSwitch())
+
+	require.Nil(t, store.DropBucket(testCtx(), "bucket1"))
+	assert.Nil(t, store.Bucket("bucket1"))
+	assert.NoDirExists(t, store.bucketDir("bucket1"))
+
+	t.Run("state of a dropped bucket is not loaded again", func(t *testing.T) {
+		require.Nil(t, store.CreateOrLoadBucket(testCtx(), "bucket1",
+			WithStrategy(StrategyReplace)))
+		res, err := store.Bucket("bucket1").Get([]byte("name"))
+		require.Nil(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("dropping a bucket which is not loaded", func(t *testing.T) {
+		assert.Nil(t, store.DropBucket(testCtx(), "unknown"))
+	})
+}
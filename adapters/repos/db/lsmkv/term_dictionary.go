@@ -0,0 +1,168 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"encoding/binary"
+)
+
+// Automaton selects the keys of a term dictionary, see Bucket.MatchingKeys.
+// It is fed the bytes of a key one after another, starting in the Start
+// state. Once a state can no longer lead to a match, all keys sharing the
+// prefix read so far are skipped.
+type Automaton interface {
+	Start() int
+	Accept(state int, b byte) int
+	// IsMatch is true if a key ending in this state is selected
+	IsMatch(state int) bool
+	// CanMatch is false if no key reaching this state is selected, regardless
+	// of its remaining bytes
+	CanMatch(state int) bool
+}
+
+// AutomatonMatches runs the automaton on a single key, e.g. to select keys
+// of a bucket without a term dictionary
+func AutomatonMatches(automaton Automaton, key []byte) bool {
+	state := automaton.Start()
+	for _, b := range key {
+		if state = automaton.Accept(state, b); !automaton.CanMatch(state) {
+			return false
+		}
+	}
+	return automaton.IsMatch(state)
+}
+
+const termDictionaryIsKey = 1
+
+// termDictionary holds the keys of a segment in a radix tree, so that the
+// keys matching an automaton, e.g. a prefix or a wildcard pattern, are found
+// without reading all of them.
+//
+// The tree is serialized bottom up, every node is encoded as
+//
+//	flags (1 byte) | prefix length (uvarint) | prefix | child count (uvarint)
+//	| child count * (label (1 byte) | child offset (uint32))
+//
+// with the children ordered by their label. The last 4 bytes hold the offset
+// of the root. An empty dictionary has no data at all.
+type termDictionary struct {
+	data []byte
+}
+
+// newTermDictionary builds the dictionary of the given keys, which must be
+// sorted and unique
+func newTermDictionary(keys [][]byte) *termDictionary {
+	if len(keys) == 0 {
+		return &termDictionary{}
+	}
+
+	b := &termDictionaryBuilder{}
+	root := b.node(keys, 0)
+	b.data = binary.LittleEndian.AppendUint32(b.data, root)
+	return &termDictionary{data: b.data}
+}
+
+type termDictionaryBuilder struct {
+	data []byte
+}
+
+func (b *termDictionaryBuilder) node(keys [][]byte, depth int) uint32 {
+	// as the keys are sorted, all of them share the common prefix of the first
+	// and the last one
+	first, last := keys[0], keys[len(keys)-1]
+	end := depth
+	for end < len(first) && end < len(last) && first[end] == last[end] {
+		end++
+	}
+
+	var flags byte
+	if len(first) == end {
+		flags |= termDictionaryIsKey
+		keys = keys[1:]
+	}
+
+	var labels []byte
+	var children []uint32
+	for len(keys) > 0 {
+		label := keys[0][end]
+		n := 1
+		for n < len(keys) && keys[n][end] == label {
+			n++
+		}
+		labels = append(labels, label)
+		children = append(children, b.node(keys[:n], end+1))
+		keys = keys[n:]
+	}
+
+	offset := uint32(len(b.data))
+	b.data = append(b.data, flags)
+	b.data = binary.AppendUvarint(b.data, uint64(end-depth))
+	b.data = append(b.data, first[depth:end]...)
+	b.data = binary.AppendUvarint(b.data, uint64(len(children)))
+	for i := range children {
+		b.data = append(b.data, labels[i])
+		b.data = binary.LittleEndian.AppendUint32(b.data, children[i])
+	}
+	return offset
+}
+
+// matchingKeys appends the keys accepted by the automaton to out, in sorted
+// order
+func (d *termDictionary) matchingKeys(automaton Automaton, out [][]byte) [][]byte {
+	if len(d.data) == 0 {
+		return out
+	}
+
+	root := binary.LittleEndian.Uint32(d.data[len(d.data)-4:])
+	return d.walk(root, automaton, automaton.Start(), nil, out)
+}
+
+func (d *termDictionary) walk(offset uint32, automaton Automaton, state int,
+	key []byte, out [][]byte,
+) [][]byte {
+	data := d.data[offset:]
+	flags := data[0]
+	pos := 1
+
+	prefixLen, n := binary.Uvarint(data[pos:])
+	pos += n
+	prefix := data[pos : pos+int(prefixLen)]
+	pos += int(prefixLen)
+	for _, b := range prefix {
+		if state = automaton.Accept(state, b); !automaton.CanMatch(state) {
+			return out
+		}
+	}
+	key = append(key, prefix...)
+
+	if flags&termDictionaryIsKey != 0 && automaton.IsMatch(state) {
+		out = append(out, append([]byte{}, key...))
+	}
+
+	childCount, n := binary.Uvarint(data[pos:])
+	pos += n
+	for i := uint64(0); i < childCount; i++ {
+		label := data[pos]
+		child := binary.LittleEndian.Uint32(data[pos+1:])
+		pos += 5
+
+		if next := automaton.Accept(state, label); automaton.CanMatch(next) {
+			out = d.walk(child, automaton, next, append(key, label), out)
+		}
+	}
+	return out
+}
+
+// size of the dictionary in bytes
+func (d *termDictionary) size() int {
+	return len(d.data)
+}
@@ -0,0 +1,158 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+)
+
+// prefixAutomaton matches all keys starting with the prefix, its state is the
+// number of bytes of the prefix matched so far plus one
+type prefixAutomaton []byte
+
+func (p prefixAutomaton) Start() int { return 1 }
+
+func (p prefixAutomaton) Accept(state int, b byte) int {
+	switch {
+	case state == 0:
+		return 0
+	case state > len(p):
+		return state
+	case p[state-1] == b:
+		return state + 1
+	default:
+		return 0
+	}
+}
+
+func (p prefixAutomaton) IsMatch(state int) bool  { return state > len(p) }
+func (p prefixAutomaton) CanMatch(state int) bool { return state != 0 }
+
+func TestTermDictionary(t *testing.T) {
+	keys := [][]byte{
+		[]byte("a"), []byte("car"), []byte("care"), []byte("careful"),
+		[]byte("cars"), []byte("cat"), []byte("dog"), []byte("doghouse"),
+	}
+	dict := newTermDictionary(keys)
+
+	for _, tc := range []struct {
+		prefix   string
+		expected []string
+	}{
+		{"", []string{"a", "car", "care", "careful", "cars", "cat", "dog", "doghouse"}},
+		{"car", []string{"car", "care", "careful", "cars"}},
+		{"care", []string{"care", "careful"}},
+		{"ca", []string{"car", "care", "careful", "cars", "cat"}},
+		{"doghouse", []string{"doghouse"}},
+		{"doghouses", nil},
+		{"b", nil},
+	} {
+		t.Run("prefix "+tc.prefix, func(t *testing.T) {
+			var actual []string
+			for _, key := range dict.matchingKeys(prefixAutomaton(tc.prefix), nil) {
+				actual = append(actual, string(key))
+			}
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+
+	t.Run("empty dictionary", func(t *testing.T) {
+		assert.Empty(t, newTermDictionary(nil).matchingKeys(prefixAutomaton(""), nil))
+	})
+}
+
+func TestBucketMatchingKeys(t *testing.T) {
+	ctx := context.Background()
+	dirName := t.TempDir()
+	logger, _ := test.NewNullLogger()
+
+	b, err := NewBucket(ctx, dirName, "", logger, nil,
+		cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+		WithStrategy(StrategyRoaringSet), WithTermDictionary(true))
+	require.Nil(t, err)
+	defer func() { b.Shutdown(ctx) }()
+
+	matchingKeys := func(t *testing.T, prefix string) []string {
+		keys, err := b.MatchingKeys(prefixAutomaton(prefix))
+		require.Nil(t, err)
+
+		var out []string
+		for _, key := range keys {
+			out = append(out, string(key))
+		}
+		return out
+	}
+
+	require.Nil(t, b.RoaringSetAddOne([]byte("car"), 1))
+	require.Nil(t, b.RoaringSetAddOne([]byte("cat"), 2))
+	require.Nil(t, b.FlushMemtable())
+	require.Nil(t, b.RoaringSetAddOne([]byte("car"), 3))
+	require.Nil(t, b.RoaringSetAddOne([]byte("care"), 4))
+	require.Nil(t, b.FlushMemtable())
+	require.Nil(t, b.RoaringSetAddOne([]byte("cars"), 5))
+	require.Nil(t, b.RoaringSetAddOne([]byte("dog"), 6))
+
+	t.Run("keys of the segments and the memtable", func(t *testing.T) {
+		assert.Equal(t, []string{"car", "care", "cars"}, matchingKeys(t, "car"))
+	})
+
+	t.Run("the dictionaries are stored next to the segments", func(t *testing.T) {
+		files, err := filepath.Glob(filepath.Join(dirName, "*.tdict"))
+		require.Nil(t, err)
+		assert.Len(t, files, 2)
+	})
+
+	t.Run("keys after compaction", func(t *testing.T) {
+		require.Nil(t, b.FlushMemtable())
+		require.Nil(t, b.disk.compactFully())
+
+		files, err := filepath.Glob(filepath.Join(dirName, "*.tdict"))
+		require.Nil(t, err)
+		assert.Len(t, files, 1)
+		assert.Equal(t, []string{"car", "care", "cars", "cat"}, matchingKeys(t, "ca"))
+	})
+
+	t.Run("a corrupt dictionary is rebuilt", func(t *testing.T) {
+		require.Nil(t, b.Shutdown(ctx))
+
+		files, err := filepath.Glob(filepath.Join(dirName, "*.tdict"))
+		require.Nil(t, err)
+		require.Len(t, files, 1)
+		require.Nil(t, os.WriteFile(files[0], []byte("corrupt"), 0o600))
+
+		b, err = NewBucket(ctx, dirName, "", logger, nil,
+			cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+			WithStrategy(StrategyRoaringSet), WithTermDictionary(true))
+		require.Nil(t, err)
+		assert.Equal(t, []string{"dog"}, matchingKeys(t, "d"))
+	})
+
+	t.Run("a bucket without a dictionary", func(t *testing.T) {
+		other, err := NewBucket(ctx, t.TempDir(), "", logger, nil,
+			cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+			WithStrategy(StrategyRoaringSet))
+		require.Nil(t, err)
+		defer other.Shutdown(ctx)
+
+		assert.False(t, other.HasTermDictionary())
+		_, err = other.MatchingKeys(prefixAutomaton("car"))
+		assert.NotNil(t, err)
+	})
+}
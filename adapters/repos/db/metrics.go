@@ -30,6 +30,8 @@ type Metrics struct {
 	filteredVectorVector  prometheus.Observer
 	filteredVectorObjects prometheus.Observer
 	filteredVectorSort    prometheus.Observer
+	quotaUsage            *prometheus.GaugeVec
+	quotaRejectedWrites   *prometheus.CounterVec
 }
 
 func NewMetrics(
@@ -66,6 +68,14 @@ func NewMetrics(
 		"class_name": className,
 		"shard_name": shardName,
 	})
+	m.quotaUsage = prom.QuotaUsage.MustCurryWith(prometheus.Labels{
+		"class_name": className,
+		"shard_name": shardName,
+	})
+	m.quotaRejectedWrites = prom.QuotaRejectedWrites.MustCurryWith(prometheus.Labels{
+		"class_name": className,
+		"shard_name": shardName,
+	})
 
 	m.filteredVectorFilter = prom.QueriesFilteredVectorDurations.With(prometheus.Labels{
 		"class_name": className,
@@ -286,3 +296,19 @@ func (m *Metrics) FilteredVectorSort(dur time.Duration) {
 
 	m.filteredVectorSort.Observe(float64(dur) / float64(time.Millisecond))
 }
+
+func (m *Metrics) QuotaUsage(quota string, ratio float64) {
+	if !m.monitoring {
+		return
+	}
+
+	m.quotaUsage.With(prometheus.Labels{"quota": quota}).Set(ratio)
+}
+
+func (m *Metrics) QuotaRejectedWrites(quota string, count int) {
+	if !m.monitoring {
+		return
+	}
+
+	m.quotaRejectedWrites.With(prometheus.Labels{"quota": quota}).Add(float64(count))
+}
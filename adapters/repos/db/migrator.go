@@ -19,11 +19,15 @@ import (
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/dynamic"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/flat"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/vamana"
 	"github.com/weaviate/weaviate/entities/errorcompounder"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/entities/vectorindex"
 	"github.com/weaviate/weaviate/usecases/replica"
 	"github.com/weaviate/weaviate/usecases/schema/migrate"
 	"github.com/weaviate/weaviate/usecases/sharding"
@@ -54,8 +58,21 @@ func (m *Migrator) AddClass(ctx context.Context, class *models.Class,
 			MemtablesMaxSizeMB:        m.db.config.MemtablesMaxSizeMB,
 			MemtablesMinActiveSeconds: m.db.config.MemtablesMinActiveSeconds,
 			MemtablesMaxActiveSeconds: m.db.config.MemtablesMaxActiveSeconds,
+			MemtablesMaxAgeSeconds:    m.db.config.MemtablesMaxAgeSeconds,
+			MemtablesMaxWALSizeMB:     m.db.config.MemtablesMaxWALSizeMB,
 			TrackVectorDimensions:     m.db.config.TrackVectorDimensions,
 			AvoidMMap:                 m.db.config.AvoidMMap,
+			HNSWCheckpointInterval:    m.db.config.HNSWCheckpointInterval,
+			AsyncIndexing:             m.db.config.AsyncIndexing,
+			LSMCompactionStrategy:     m.db.config.LSMCompactionStrategy,
+			LSMCompactionSizeRatio:    m.db.config.LSMCompactionSizeRatio,
+			WALSyncPolicy:             m.db.config.WALSyncPolicy,
+			BlockCache:                m.db.blockCache,
+			LSMDirectIO:               m.db.config.LSMDirectIO,
+			LSMScrubInterval:          m.db.config.LSMScrubInterval,
+			Offloader:                 m.db.offloader,
+			Trash:                     m.db.trash,
+			ReadOnly:                  m.db.readOnly,
 			ReplicationFactor:         class.ReplicationConfig.Factor,
 		},
 		shardState,
@@ -69,14 +86,35 @@ func (m *Migrator) AddClass(ctx context.Context, class *models.Class,
 		return errors.Wrap(err, "create index")
 	}
 
-	err = idx.addUUIDProperty(ctx)
-	if err != nil {
+	if err := m.initClassProperties(ctx, idx, class); err != nil {
+		// do not leave the files of a partially created class behind, they
+		// would be picked up when the class is created again
+		if dropErr := idx.drop(); dropErr != nil {
+			m.logger.WithField("action", "add_class").
+				WithField("class", class.Class).
+				WithError(dropErr).
+				Error("could not drop partially created class")
+		}
+		return err
+	}
+
+	m.db.indexLock.Lock()
+	m.db.indices[idx.ID()] = idx
+	idx.notifyReady()
+	m.db.indexLock.Unlock()
+
+	return nil
+}
+
+func (m *Migrator) initClassProperties(ctx context.Context, idx *Index,
+	class *models.Class,
+) error {
+	if err := idx.addUUIDProperty(ctx); err != nil {
 		return errors.Wrapf(err, "extend idx '%s' with uuid property", idx.ID())
 	}
 
 	if class.InvertedIndexConfig.IndexTimestamps {
-		err = idx.addTimestampProperties(ctx)
-		if err != nil {
+		if err := idx.addTimestampProperties(ctx); err != nil {
 			return errors.Wrapf(err, "extend idx '%s' with timestamp properties", idx.ID())
 		}
 	}
@@ -87,11 +125,6 @@ func (m *Migrator) AddClass(ctx context.Context, class *models.Class,
 		}
 	}
 
-	m.db.indexLock.Lock()
-	m.db.indices[idx.ID()] = idx
-	idx.notifyReady()
-	m.db.indexLock.Unlock()
-
 	return nil
 }
 
@@ -112,6 +145,7 @@ func (m *Migrator) AddProperty(ctx context.Context, className string, prop *mode
 	if idx == nil {
 		return errors.Errorf("cannot add property to a non-existing index for %s", className)
 	}
+	defer idx.bumpDataVersion()
 
 	return idx.addProperty(ctx, prop)
 }
@@ -122,12 +156,41 @@ func (m *Migrator) DropProperty(ctx context.Context, className string, propertyN
 	return nil
 }
 
+// UpdateProperty renames a property. The inverted index is renamed right
+// away, the stored objects are rewritten in the background, see
+// GetPropertyMigrations for the progress
 func (m *Migrator) UpdateProperty(ctx context.Context, className string, propName string, newName *string) error {
-	if newName != nil {
-		return errors.New("weaviate does not support renaming of properties")
+	if newName == nil {
+		return nil
 	}
 
-	return nil
+	idx := m.db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return errors.Errorf("cannot rename property of a non-existing index for %s", className)
+	}
+	defer idx.bumpDataVersion()
+
+	return idx.renameProperty(ctx, propName, *newName)
+}
+
+func (m *Migrator) UpdatePropertyTokenization(ctx context.Context, className string,
+	prop *models.Property, tokenization string, onCommit func(), onFailure func(err error),
+) error {
+	idx := m.db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return errors.Errorf("cannot update tokenization of property of a non-existing index for %s", className)
+	}
+
+	return idx.updatePropertyTokenization(ctx, prop, tokenization, onCommit, onFailure)
+}
+
+func (m *Migrator) GetPropertyMigrations(ctx context.Context, className string) ([]*models.PropertyMigration, error) {
+	idx := m.db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return nil, errors.Errorf("cannot get property migrations of a non-existing index for %s", className)
+	}
+
+	return idx.propertyMigrations.list(), nil
 }
 
 func (m *Migrator) GetShardsStatus(ctx context.Context, className string) (map[string]string, error) {
@@ -144,6 +207,7 @@ func (m *Migrator) UpdateShardStatus(ctx context.Context, className, shardName,
 	if idx == nil {
 		return errors.Errorf("cannot update shard status to a non-existing index for %s", className)
 	}
+	defer idx.bumpDataVersion()
 
 	return idx.updateShardStatus(ctx, shardName, targetStatus)
 }
@@ -209,8 +273,10 @@ func (m *Migrator) UpdateTenants(ctx context.Context, class *models.Class, updat
 
 	shardsToHot := make([]string, 0, len(updates))
 	shardsToCold := make([]string, 0, len(updates))
+	shardsToFreeze := make([]string, 0, len(updates))
 	shardsHotted := make(map[string]*Shard)
 	shardsColded := make(map[string]*Shard)
+	lazyShardsColded := make([]string, 0)
 
 	rollbackHotted := func() {
 		eg := new(errgroup.Group)
@@ -232,6 +298,9 @@ func (m *Migrator) UpdateTenants(ctx context.Context, class *models.Class, updat
 		for name, shard := range shardsColded {
 			idx.shards.CompareAndSwap(name, nil, shard)
 		}
+		for _, name := range lazyShardsColded {
+			idx.registerLazyShard(name)
+		}
 	}
 	rollback := func() {
 		rollbackHotted()
@@ -262,8 +331,16 @@ func (m *Migrator) UpdateTenants(ctx context.Context, class *models.Class, updat
 			})
 		}
 		eg.Wait()
+
+		// frozen shards are offloaded in the background once they are shut
+		// down. Until then, or if that fails, they are loaded from their local
+		// files.
+		for _, name := range shardsToFreeze {
+			idx.scheduleOffload(name)
+		}
 	}
 	commit = func(success bool) {
+		defer idx.bumpDataVersion()
 		if !success {
 			rollback()
 			return
@@ -275,10 +352,19 @@ func (m *Migrator) UpdateTenants(ctx context.Context, class *models.Class, updat
 	applyHot := func() error {
 		for _, name := range shardsToHot {
 			// shard already hot
-			if shard := idx.shards.Load(name); shard != nil {
+			if shard := idx.shards.Load(name); shard != nil || idx.isLazyShard(name) {
+				continue
+			}
+			// shard was frozen, it is restored and loaded right away as it
+			// could be loaded lazily anyway
+			shard, err := idx.loadFrozenShard(ctx, name)
+			if err != nil {
+				return fmt.Errorf("cannot activate shard '%s': %w", name, err)
+			}
+			if shard != nil {
 				continue
 			}
-			shard, err := NewShard(ctx, m.db.promMetrics, name, idx, class, idx.centralJobQueue)
+			shard, err = NewShard(ctx, m.db.promMetrics, name, idx, class, idx.centralJobQueue)
 			if err != nil {
 				return fmt.Errorf("cannot activate shard '%s': %w", name, err)
 			}
@@ -291,6 +377,18 @@ func (m *Migrator) UpdateTenants(ctx context.Context, class *models.Class, updat
 		defer idx.backupMutex.RUnlock()
 
 		for _, name := range shardsToCold {
+			if err := idx.restoreShard(ctx, name); err != nil {
+				return fmt.Errorf("cannot restore frozen shard '%s': %w", name, err)
+			}
+		}
+
+		for _, name := range append(shardsToCold, shardsToFreeze...) {
+			// not loaded yet, so there is nothing to shut down
+			if idx.unregisterLazyShard(name) {
+				lazyShardsColded = append(lazyShardsColded, name)
+				continue
+			}
+
 			shard, ok := idx.shards.Swap(name, nil) // mark as deactivated
 			if !ok {                                // shard doesn't exit (already cold)
 				idx.shards.LoadAndDelete(name) // rollback nil value created by swap()
@@ -309,6 +407,14 @@ func (m *Migrator) UpdateTenants(ctx context.Context, class *models.Class, updat
 			shardsToHot = append(shardsToHot, tu.Name)
 		case models.TenantActivityStatusCOLD:
 			shardsToCold = append(shardsToCold, tu.Name)
+		case models.TenantActivityStatusFROZEN:
+			shardsToFreeze = append(shardsToFreeze, tu.Name)
+		}
+	}
+
+	if len(shardsToFreeze) > 0 {
+		if _, err := idx.Config.Offloader.store(); err != nil {
+			return nil, err
 		}
 	}
 
@@ -335,7 +441,14 @@ func (m *Migrator) DeleteTenants(ctx context.Context, class *models.Class, tenan
 	if idx == nil {
 		return func(bool) {}, nil
 	}
-	return idx.dropShards(tenants)
+	commit, err = idx.dropShards(tenants)
+	if err != nil {
+		return commit, err
+	}
+	return func(success bool) {
+		defer idx.bumpDataVersion()
+		commit(success)
+	}, nil
 }
 
 func NewMigrator(db *DB, logger logrus.FieldLogger) *Migrator {
@@ -349,6 +462,7 @@ func (m *Migrator) UpdateVectorIndexConfig(ctx context.Context,
 	if idx == nil {
 		return errors.Errorf("cannot update vector index config of non-existing index for %s", className)
 	}
+	defer idx.bumpDataVersion()
 
 	return idx.updateVectorIndexConfig(ctx, updated)
 }
@@ -356,10 +470,20 @@ func (m *Migrator) UpdateVectorIndexConfig(ctx context.Context,
 func (m *Migrator) ValidateVectorIndexConfigUpdate(ctx context.Context,
 	old, updated schema.VectorIndexConfig,
 ) error {
-	// hnsw is the only supported vector index type at the moment, so no need
-	// to check, we can always use that an hnsw-specific validation should be
-	// used for now.
-	return hnsw.ValidateUserConfigUpdate(old, updated)
+	// the vector index type itself is immutable, so the type of the old
+	// config determines which validation applies
+	switch old.IndexType() {
+	case vectorindex.VectorIndexTypeHNSW:
+		return hnsw.ValidateUserConfigUpdate(old, updated)
+	case vectorindex.VectorIndexTypeVamana:
+		return vamana.ValidateUserConfigUpdate(old, updated)
+	case vectorindex.VectorIndexTypeFlat:
+		return flat.ValidateUserConfigUpdate(old, updated)
+	case vectorindex.VectorIndexTypeDynamic:
+		return dynamic.ValidateUserConfigUpdate(old, updated)
+	default:
+		return errors.Errorf("unsupported vector index type: %q", old.IndexType())
+	}
 }
 
 func (m *Migrator) ValidateInvertedIndexConfigUpdate(ctx context.Context,
@@ -375,12 +499,24 @@ func (m *Migrator) UpdateInvertedIndexConfig(ctx context.Context, className stri
 	if idx == nil {
 		return errors.Errorf("cannot update inverted index config of non-existing index for %s", className)
 	}
+	defer idx.bumpDataVersion()
 
 	conf := inverted.ConfigFromModel(updated)
 
 	return idx.updateInvertedIndexConfig(ctx, conf)
 }
 
+func (m *Migrator) UpdateMemtableConfig(ctx context.Context, className string,
+	updated *models.MemtableConfig,
+) error {
+	idx := m.db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return errors.Errorf("cannot update memtable config of non-existing index for %s", className)
+	}
+
+	return idx.updateMemtableConfig(ctx, updated)
+}
+
 func (m *Migrator) RecalculateVectorDimensions(ctx context.Context) error {
 	count := 0
 	m.logger.
@@ -471,6 +607,7 @@ func (m *Migrator) InvertedReindex(ctx context.Context, taskNames ...string) err
 	var errs errorcompounder.ErrorCompounder
 	errs.Add(m.doInvertedReindex(ctx, taskNames...))
 	errs.Add(m.doInvertedIndexMissingTextFilterable(ctx, taskNames...))
+	errs.Add(m.doMigrateSetToRoaringSetOnline(ctx, taskNames...))
 	return errs.ToError()
 }
 
@@ -612,6 +749,42 @@ func (m *Migrator) doInvertedIndexMissingTextFilterable(ctx context.Context, tas
 	return nil
 }
 
+// doMigrateSetToRoaringSetOnline migrates the set buckets of all shards to
+// roaring sets. Unlike ShardInvertedReindexTaskSetToRoaringSet, the shards
+// stay writable while they are migrated.
+func (m *Migrator) doMigrateSetToRoaringSetOnline(ctx context.Context, taskNames ...string) error {
+	taskName := "ShardMigrateSetToRoaringSetOnline"
+	taskFound := false
+	for _, name := range taskNames {
+		if name == taskName {
+			taskFound = true
+			break
+		}
+	}
+	if !taskFound {
+		return nil
+	}
+
+	eg := &errgroup.Group{}
+	eg.SetLimit(_NUMCPU)
+	for _, index := range m.db.indices {
+		index.ForEachShard(func(name string, shard *Shard) error {
+			eg.Go(func() error {
+				if err := shard.migrateToRoaringSet(ctx); err != nil {
+					m.logInvertedReindexShard(shard).
+						WithField("task", taskName).
+						WithError(err).
+						Error("failed migrating to roaring sets")
+					return errors.Wrapf(err, "failed migrating shard '%s'", shard.ID())
+				}
+				return nil
+			})
+			return nil
+		})
+	}
+	return eg.Wait()
+}
+
 func (m *Migrator) logInvertedReindex() *logrus.Entry {
 	return m.logger.WithField("action", "inverted_reindex")
 }
@@ -0,0 +1,196 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestNestedProperties(t *testing.T) {
+	dirName := t.TempDir()
+
+	logger, _ := test.NewNullLogger()
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer repo.Shutdown(context.Background())
+
+	migrator := NewMigrator(repo, logger)
+	class := &models.Class{
+		Class:               "Shop",
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		Properties: []*models.Property{
+			{
+				Name:     "address",
+				DataType: schema.DataTypeObject.PropString(),
+				NestedProperties: []*models.NestedProperty{
+					{
+						Name:         "city",
+						DataType:     schema.DataTypeText.PropString(),
+						Tokenization: models.PropertyTokenizationField,
+					},
+					{Name: "zip", DataType: schema.DataTypeInt.PropString()},
+					{
+						Name:     "location",
+						DataType: schema.DataTypeObject.PropString(),
+						NestedProperties: []*models.NestedProperty{
+							{Name: "latitude", DataType: schema.DataTypeNumber.PropString()},
+							{Name: "longitude", DataType: schema.DataTypeNumber.PropString()},
+						},
+					},
+				},
+			},
+			{
+				Name:     "visits",
+				DataType: schema.DataTypeObjectArray.PropString(),
+				NestedProperties: []*models.NestedProperty{
+					{
+						Name:         "city",
+						DataType:     schema.DataTypeText.PropString(),
+						Tokenization: models.PropertyTokenizationField,
+					},
+				},
+			},
+		},
+	}
+
+	ids := []strfmt.UUID{
+		"c5e1c3d6-63b5-4e3e-9b8a-7a4d3f7c2a01",
+		"c5e1c3d6-63b5-4e3e-9b8a-7a4d3f7c2a02",
+	}
+	location := map[string]interface{}{"latitude": 52.37, "longitude": 4.89}
+
+	t.Run("creating the class and add objects", func(t *testing.T) {
+		require.Nil(t,
+			migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+		schemaGetter.schema.Objects = &models.Schema{
+			Classes: []*models.Class{class},
+		}
+
+		objs := []*models.Object{
+			{
+				Class: class.Class,
+				ID:    ids[0],
+				Properties: map[string]interface{}{
+					"address": map[string]interface{}{
+						"city":     "Amsterdam",
+						"zip":      float64(1011),
+						"location": location,
+					},
+					"visits": []interface{}{
+						map[string]interface{}{"city": "Berlin"},
+						map[string]interface{}{"city": "Paris"},
+					},
+				},
+			},
+			{
+				Class: class.Class,
+				ID:    ids[1],
+				Properties: map[string]interface{}{
+					"address": map[string]interface{}{
+						"city": "Utrecht",
+						"zip":  float64(3511),
+					},
+					"visits": []interface{}{
+						map[string]interface{}{"city": "Paris"},
+					},
+				},
+			},
+		}
+		for _, obj := range objs {
+			require.Nil(t, repo.PutObject(context.Background(), obj, []float32{1, 2, 3}, nil))
+		}
+	})
+
+	t.Run("nested objects are loaded based on their schema", func(t *testing.T) {
+		res, err := repo.ObjectByID(context.Background(), ids[0], nil, additional.Properties{}, "")
+		require.Nil(t, err)
+		require.NotNil(t, res)
+
+		address := res.Schema.(map[string]interface{})["address"].(map[string]interface{})
+		assert.Equal(t, location, address["location"])
+	})
+
+	search := func(t *testing.T, path string, operator filters.Operator,
+		dt schema.DataType, value interface{},
+	) []strfmt.UUID {
+		res, err := repo.Search(context.Background(), dto.GetParams{
+			ClassName:  class.Class,
+			Pagination: &filters.Pagination{Limit: 10},
+			Filters: &filters.LocalFilter{
+				Root: &filters.Clause{
+					Operator: operator,
+					Value:    &filters.Value{Type: dt, Value: value},
+					On: &filters.Path{
+						Class:    schema.ClassName(class.Class),
+						Property: schema.PropertyName(path),
+					},
+				},
+			},
+		})
+		require.Nil(t, err)
+
+		found := make([]strfmt.UUID, len(res))
+		for i := range res {
+			found[i] = res[i].ID
+		}
+		return found
+	}
+
+	t.Run("filtering by a nested property", func(t *testing.T) {
+		found := search(t, "address.city", filters.OperatorEqual, schema.DataTypeText, "Amsterdam")
+		assert.ElementsMatch(t, []strfmt.UUID{ids[0]}, found)
+
+		found = search(t, "address.zip", filters.OperatorGreaterThan, schema.DataTypeInt, 2000)
+		assert.ElementsMatch(t, []strfmt.UUID{ids[1]}, found)
+
+		found = search(t, "address.location.latitude", filters.OperatorGreaterThan, schema.DataTypeNumber, 50.0)
+		assert.ElementsMatch(t, []strfmt.UUID{ids[0]}, found)
+	})
+
+	t.Run("filtering by a property of nested object arrays", func(t *testing.T) {
+		found := search(t, "visits.city", filters.OperatorEqual, schema.DataTypeText, "Paris")
+		assert.ElementsMatch(t, ids, found)
+
+		found = search(t, "visits.city", filters.OperatorEqual, schema.DataTypeText, "Berlin")
+		assert.ElementsMatch(t, []strfmt.UUID{ids[0]}, found)
+	})
+
+	t.Run("deleted objects are removed from the nested indexes", func(t *testing.T) {
+		require.Nil(t, repo.DeleteObject(context.Background(), class.Class, ids[0], nil, ""))
+
+		found := search(t, "visits.city", filters.OperatorEqual, schema.DataTypeText, "Paris")
+		assert.ElementsMatch(t, []strfmt.UUID{ids[1]}, found)
+	})
+}
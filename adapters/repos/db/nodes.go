@@ -16,6 +16,7 @@ import (
 	"fmt"
 	"sort"
 
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
 	enterrors "github.com/weaviate/weaviate/entities/errors"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
@@ -103,6 +104,7 @@ func (db *DB) localNodeStatus(className string) *models.NodeStatus {
 			QueueLength:   int64(len(db.jobQueueCh)),
 			RatePerSecond: int64(rate),
 		},
+		ReadOnly: db.readOnly.status(),
 	}
 }
 
@@ -133,16 +135,65 @@ func (db *DB) localNodeStatusForClass(status *[]*models.NodeShardStatus,
 }
 
 func (i *Index) getShardsNodeStatus(status *[]*models.NodeShardStatus) (totalCount int64) {
-	i.ForEachShard(func(name string, shard *Shard) error {
+	i.ForEachLoadedShard(func(name string, shard *Shard) error {
 		objectCount := int64(shard.objectCount())
+		cacheBytes, cacheMaxBytes := shard.vectorCacheStats()
+		// without a pause or resume requested, collecting the state cannot fail
+		tombstoneCleanup, _ := shard.vectorIndexTombstoneCleanup(context.Background(), nil)
+		queueLength, queueLag := shard.vectorIndexQueueStats()
+		diskUsage, err := shard.diskUsageBreakdown(objectCount, tombstoneCleanup)
+		if err != nil {
+			i.logger.WithField("action", "shard_disk_usage").
+				WithField("shard", shard.ID()).
+				Warn(err)
+		}
 		shardStatus := &models.NodeShardStatus{
-			Name:        name,
-			Class:       shard.index.Config.ClassName.String(),
-			ObjectCount: objectCount,
+			Name:                        name,
+			Class:                       shard.index.Config.ClassName.String(),
+			LoadStatus:                  shardLoadStatusLoaded,
+			ObjectCount:                 objectCount,
+			DiskUsage:                   diskUsage,
+			QuarantinedSegments:         i.quarantinedSegments(shard.ID()),
+			VectorCacheBytes:            cacheBytes,
+			VectorCacheMaxBytes:         cacheMaxBytes,
+			VectorIndexIntegrity:        shard.vectorIndexIntegrity(),
+			VectorIndexTombstoneCleanup: tombstoneCleanup,
+			VectorQueueLength:           queueLength,
+			VectorQueueLagMs:            queueLag.Milliseconds(),
 		}
 		totalCount += objectCount
 		*status = append(*status, shardStatus)
 		return nil
 	})
+	for name, loadStatus := range i.lazyShardLoadStatuses() {
+		// the files of shards which are not loaded yet are known nonetheless
+		diskUsage, err := shardDiskUsage(i.Config.RootPath,
+			i.Config.ClassName.String(), name, i.shardID(name))
+		if err != nil {
+			i.logger.WithField("action", "shard_disk_usage").
+				WithField("shard", i.shardID(name)).
+				Warn(err)
+		}
+		*status = append(*status, &models.NodeShardStatus{
+			Name:                name,
+			Class:               i.Config.ClassName.String(),
+			LoadStatus:          loadStatus,
+			DiskUsage:           diskUsage,
+			QuarantinedSegments: i.quarantinedSegments(i.shardID(name)),
+		})
+	}
 	return
 }
+
+// quarantinedSegments counts the corrupt segments of a shard. They are known
+// from its files, whether the shard is loaded or not.
+func (i *Index) quarantinedSegments(shardID string) int64 {
+	count, err := lsmkv.CountQuarantinedSegments(
+		fmt.Sprintf("%s/%s_lsm", i.Config.RootPath, shardID))
+	if err != nil {
+		i.logger.WithField("action", "shard_quarantined_segments").
+			WithField("shard", shardID).
+			Warn(err)
+	}
+	return int64(count)
+}
@@ -131,6 +131,15 @@ func TestNodesAPI_Journey(t *testing.T) {
 	assert.Equal(t, "ClassNodesAPI", nodeStatus.Shards[0].Class)
 	assert.True(t, len(nodeStatus.Shards[0].Name) > 0)
 	assert.Equal(t, int64(2), nodeStatus.Shards[0].ObjectCount)
+	assert.Equal(t, int64(0), nodeStatus.Shards[0].VectorCacheMaxBytes)
 	assert.Equal(t, int64(2), nodeStatus.Stats.ObjectCount)
 	assert.Equal(t, int64(1), nodeStatus.Stats.ShardCount)
+
+	// the objects are only in the WALs of the memtables so far
+	diskUsage := nodeStatus.Shards[0].DiskUsage
+	require.NotNil(t, diskUsage)
+	assert.Greater(t, diskUsage.WalBytes, int64(0))
+	assert.Equal(t, diskUsage.TotalBytes, diskUsage.ObjectsBytes+diskUsage.InvertedIndexBytes+
+		diskUsage.VectorIndexBytes+diskUsage.WalBytes+diskUsage.CompactionTempBytes+
+		diskUsage.OtherBytes)
 }
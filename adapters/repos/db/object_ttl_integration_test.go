@@ -0,0 +1,176 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestObjectTTL(t *testing.T) {
+	dirName := t.TempDir()
+
+	logger, _ := test.NewNullLogger()
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer repo.Shutdown(context.Background())
+
+	migrator := NewMigrator(repo, logger)
+	class := &models.Class{
+		Class:               "Session",
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		ObjectTTL:           &models.ObjectTTLConfig{Enabled: false, TTLSeconds: 3600},
+		Properties: []*models.Property{
+			{
+				Name:         "user",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationField,
+			},
+		},
+	}
+
+	now := time.Now()
+	expiredIDs := []strfmt.UUID{
+		"6f1f2d5e-2a3e-4a4c-8b1e-000000000001",
+		"6f1f2d5e-2a3e-4a4c-8b1e-000000000002",
+	}
+	validIDs := []strfmt.UUID{
+		"6f1f2d5e-2a3e-4a4c-8b1e-000000000003",
+		"6f1f2d5e-2a3e-4a4c-8b1e-000000000004",
+	}
+
+	t.Run("creating the class and add objects", func(t *testing.T) {
+		require.Nil(t,
+			migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+		schemaGetter.schema.Objects = &models.Schema{
+			Classes: []*models.Class{class},
+		}
+
+		put := func(id strfmt.UUID, created time.Time) {
+			obj := &models.Object{
+				Class:              class.Class,
+				ID:                 id,
+				CreationTimeUnix:   created.UnixMilli(),
+				LastUpdateTimeUnix: created.UnixMilli(),
+				Properties:         map[string]interface{}{"user": "alice"},
+			}
+			require.Nil(t, repo.PutObject(context.Background(), obj, []float32{1, 2, 3}, nil))
+		}
+		for _, id := range expiredIDs {
+			put(id, now.Add(-2*time.Hour))
+		}
+		for _, id := range validIDs {
+			put(id, now.Add(-time.Minute))
+		}
+	})
+
+	shard := func(t *testing.T) *Shard {
+		var shard *Shard
+		repo.GetIndex(schema.ClassName(class.Class)).ForEachShard(func(name string, s *Shard) error {
+			shard = s
+			return nil
+		})
+		require.NotNil(t, shard)
+		return shard
+	}
+	neverAbort := func() bool { return false }
+
+	remaining := func(t *testing.T) []strfmt.UUID {
+		res, err := repo.Search(context.Background(), dto.GetParams{
+			ClassName:  class.Class,
+			Pagination: &filters.Pagination{Limit: 10},
+			Filters: &filters.LocalFilter{
+				Root: &filters.Clause{
+					Operator: filters.OperatorEqual,
+					On: &filters.Path{
+						Class:    schema.ClassName(class.Class),
+						Property: "user",
+					},
+					Value: &filters.Value{Value: "alice", Type: schema.DataTypeText},
+				},
+			},
+		})
+		require.Nil(t, err)
+
+		found := make([]strfmt.UUID, len(res))
+		for i := range res {
+			found[i] = res[i].ID
+		}
+		return found
+	}
+
+	t.Run("disabled ttl does not delete anything", func(t *testing.T) {
+		assert.False(t, shard(t).expireObjects(neverAbort))
+		assert.ElementsMatch(t, append(expiredIDs, validIDs...), remaining(t))
+	})
+
+	t.Run("enabled ttl deletes expired objects", func(t *testing.T) {
+		class.ObjectTTL.Enabled = true
+		assert.True(t, shard(t).expireObjects(neverAbort))
+
+		assert.ElementsMatch(t, validIDs, remaining(t))
+		for _, id := range expiredIDs {
+			exists, err := repo.Exists(context.Background(), class.Class, id, nil, "")
+			require.Nil(t, err)
+			assert.False(t, exists)
+		}
+		for _, id := range validIDs {
+			exists, err := repo.Exists(context.Background(), class.Class, id, nil, "")
+			require.Nil(t, err)
+			assert.True(t, exists)
+		}
+	})
+
+	t.Run("expired objects are removed from the vector index", func(t *testing.T) {
+		res, err := repo.VectorSearch(context.Background(), dto.GetParams{
+			ClassName:    class.Class,
+			SearchVector: []float32{1, 2, 3},
+			Pagination:   &filters.Pagination{Limit: 10},
+			AdditionalProperties: additional.Properties{
+				Distance: true,
+			},
+		})
+		require.Nil(t, err)
+		found := make([]strfmt.UUID, len(res))
+		for i := range res {
+			found[i] = res[i].ID
+		}
+		assert.ElementsMatch(t, validIDs, found)
+	})
+
+	t.Run("nothing left to expire", func(t *testing.T) {
+		assert.False(t, shard(t).expireObjects(neverAbort))
+	})
+}
@@ -0,0 +1,177 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestPhoneNumberProps(t *testing.T) {
+	dirName := t.TempDir()
+
+	logger, _ := test.NewNullLogger()
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer repo.Shutdown(context.Background())
+
+	migrator := NewMigrator(repo, logger)
+	class := &models.Class{
+		Class:               "Contact",
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		Properties: []*models.Property{
+			{
+				Name:     "countryCode",
+				DataType: schema.DataTypeText.PropString(),
+			},
+			{
+				Name:                   "phone",
+				DataType:               schema.DataTypePhoneNumber.PropString(),
+				DefaultCountryProperty: "countryCode",
+			},
+		},
+	}
+
+	ids := []strfmt.UUID{
+		"9b2a4a33-3b4d-4b9c-8a54-6f5a3e2c0001",
+		"9b2a4a33-3b4d-4b9c-8a54-6f5a3e2c0002",
+		"9b2a4a33-3b4d-4b9c-8a54-6f5a3e2c0003",
+	}
+	phones := []*models.PhoneNumber{
+		{
+			Input: "0171 1234567", DefaultCountry: "DE", CountryCode: 49, National: 1711234567,
+			NationalFormatted: "0171 1234567", InternationalFormatted: "+49 171 1234567", Valid: true,
+		},
+		{
+			Input: "+49 30 123456", CountryCode: 49, National: 30123456,
+			NationalFormatted: "030 123456", InternationalFormatted: "+49 30 123456", Valid: true,
+		},
+		{
+			Input: "020 7946 0018", DefaultCountry: "GB", CountryCode: 44, National: 2079460018,
+			NationalFormatted: "020 7946 0018", InternationalFormatted: "+44 20 7946 0018", Valid: true,
+		},
+	}
+
+	t.Run("creating the class and add objects", func(t *testing.T) {
+		require.Nil(t,
+			migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+		schemaGetter.schema.Objects = &models.Schema{
+			Classes: []*models.Class{class},
+		}
+		for i, id := range ids {
+			obj := &models.Object{
+				Class: class.Class,
+				ID:    id,
+				Properties: map[string]interface{}{
+					"phone": phones[i],
+				},
+			}
+			require.Nil(t, repo.PutObject(context.Background(), obj, []float32{1, 2, 3}, nil))
+		}
+	})
+
+	t.Run("filtering", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			value    string
+			operator filters.Operator
+			expected []strfmt.UUID
+		}{
+			{
+				name:     "international format",
+				value:    "+49 171 1234567",
+				operator: filters.OperatorEqual,
+				expected: []strfmt.UUID{ids[0]},
+			},
+			{
+				name:     "national format",
+				value:    "020 7946 0018",
+				operator: filters.OperatorEqual,
+				expected: []strfmt.UUID{ids[2]},
+			},
+			{
+				name:     "wildcard",
+				value:    "* 123*",
+				operator: filters.OperatorLike,
+				expected: []strfmt.UUID{ids[0], ids[1]},
+			},
+			{
+				name:     "national prefix",
+				value:    "030*",
+				operator: filters.OperatorLike,
+				expected: []strfmt.UUID{ids[1]},
+			},
+			{
+				name:     "raw input is not indexed",
+				value:    "+49 30 123456 ",
+				operator: filters.OperatorEqual,
+				expected: []strfmt.UUID{},
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				res, err := repo.Search(context.Background(), dto.GetParams{
+					ClassName:  class.Class,
+					Pagination: &filters.Pagination{Limit: 10},
+					Filters:    buildFilter("phone", tt.value, tt.operator, schema.DataTypeText),
+				})
+				require.Nil(t, err)
+
+				found := make([]strfmt.UUID, len(res))
+				for i := range res {
+					found[i] = res[i].ID
+				}
+				assert.ElementsMatch(t, tt.expected, found)
+			})
+		}
+	})
+
+	t.Run("filtering for missing phone numbers", func(t *testing.T) {
+		id := strfmt.UUID("9b2a4a33-3b4d-4b9c-8a54-6f5a3e2c0004")
+		require.Nil(t, repo.PutObject(context.Background(), &models.Object{
+			Class:      class.Class,
+			ID:         id,
+			Properties: map[string]interface{}{"countryCode": "DE"},
+		}, []float32{1, 2, 3}, nil))
+
+		res, err := repo.Search(context.Background(), dto.GetParams{
+			ClassName:  class.Class,
+			Pagination: &filters.Pagination{Limit: 10},
+			Filters:    buildFilter("phone", true, filters.OperatorIsNull, schema.DataTypeBoolean),
+		})
+		require.Nil(t, err)
+		require.Len(t, res, 1)
+		assert.Equal(t, id, res[0].ID)
+	})
+}
@@ -0,0 +1,165 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestPropertyVectorSearch(t *testing.T) {
+	dirName := t.TempDir()
+
+	logger, _ := test.NewNullLogger()
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer repo.Shutdown(context.Background())
+
+	migrator := NewMigrator(repo, logger)
+	vFalse := false
+	class := &models.Class{
+		Class:               "Photo",
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		Properties: []*models.Property{
+			{
+				Name:         "caption",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationWord,
+			},
+			{
+				Name:            "embedding",
+				DataType:        schema.DataTypeVector.PropString(),
+				IndexFilterable: &vFalse,
+			},
+			{
+				Name:            "crops",
+				DataType:        schema.DataTypeVectorArray.PropString(),
+				IndexFilterable: &vFalse,
+			},
+		},
+	}
+
+	ids := []strfmt.UUID{
+		"b5e1c3d6-63b5-4e3e-9b8a-7a4d3f7c2a01",
+		"b5e1c3d6-63b5-4e3e-9b8a-7a4d3f7c2a02",
+		"b5e1c3d6-63b5-4e3e-9b8a-7a4d3f7c2a03",
+	}
+
+	t.Run("creating the class and add objects", func(t *testing.T) {
+		require.Nil(t,
+			migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+		schemaGetter.schema.Objects = &models.Schema{
+			Classes: []*models.Class{class},
+		}
+
+		objs := []*models.Object{
+			{
+				Class: class.Class,
+				ID:    ids[0],
+				Properties: map[string]interface{}{
+					"caption":   "alpha",
+					"embedding": []float64{1, 0, 0},
+					"crops":     [][]float64{{0, 0, 1}, {0, 1, 0}},
+				},
+			},
+			{
+				Class: class.Class,
+				ID:    ids[1],
+				Properties: map[string]interface{}{
+					"caption":   "beta",
+					"embedding": []float64{0, 1, 0},
+					"crops":     [][]float64{{1, 0, 0}},
+				},
+			},
+			{
+				// objects without the property are not part of its results
+				Class:      class.Class,
+				ID:         ids[2],
+				Properties: map[string]interface{}{"caption": "gamma"},
+			},
+		}
+		for _, obj := range objs {
+			require.Nil(t, repo.PutObject(context.Background(), obj, []float32{1, 2, 3}, nil))
+		}
+	})
+
+	vectorSearch := func(t *testing.T, targetVector string, vector []float32,
+		filter *filters.LocalFilter,
+	) []strfmt.UUID {
+		res, err := repo.VectorSearch(context.Background(), dto.GetParams{
+			ClassName:    class.Class,
+			SearchVector: vector,
+			TargetVector: targetVector,
+			Filters:      filter,
+			Pagination:   &filters.Pagination{Limit: 10},
+		})
+		require.Nil(t, err)
+
+		found := make([]strfmt.UUID, len(res))
+		for i := range res {
+			found[i] = res[i].ID
+		}
+		return found
+	}
+
+	t.Run("searching a vector property", func(t *testing.T) {
+		found := vectorSearch(t, "embedding", []float32{0, 1, 0}, nil)
+		assert.Equal(t, []strfmt.UUID{ids[1], ids[0]}, found)
+	})
+
+	t.Run("searching a vector[] property uses the closest vector", func(t *testing.T) {
+		found := vectorSearch(t, "crops", []float32{0, 1, 0}, nil)
+		assert.Equal(t, []strfmt.UUID{ids[0], ids[1]}, found)
+
+		found = vectorSearch(t, "crops", []float32{1, 0, 0}, nil)
+		assert.Equal(t, []strfmt.UUID{ids[1], ids[0]}, found)
+	})
+
+	t.Run("searching a vector property with a filter", func(t *testing.T) {
+		filter := &filters.LocalFilter{
+			Root: &filters.Clause{
+				Operator: filters.OperatorEqual,
+				Value: &filters.Value{
+					Type:  schema.DataTypeText,
+					Value: "alpha",
+				},
+				On: &filters.Path{
+					Class:    schema.ClassName(class.Class),
+					Property: "caption",
+				},
+			},
+		}
+		found := vectorSearch(t, "embedding", []float32{0, 1, 0}, filter)
+		assert.Equal(t, []strfmt.UUID{ids[0]}, found)
+	})
+}
@@ -0,0 +1,290 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+// quotaUsageMaxAge limits how often the usage of a class or tenant is
+// computed. Computing the disk usage walks the files of the shards, which is
+// too expensive to do on every write.
+const quotaUsageMaxAge = 10 * time.Second
+
+// defaultQuotaWarningPercentage is used if the quota config does not set one
+const defaultQuotaWarningPercentage = 80
+
+// quotas as reported in the metrics
+const (
+	quotaObjects         = "objects"
+	quotaDiskBytes       = "disk_bytes"
+	quotaTenantObjects   = "tenant_objects"
+	quotaTenantDiskBytes = "tenant_disk_bytes"
+)
+
+type quotaUsage struct {
+	objects   int64
+	diskBytes int64
+}
+
+// quotaUsageCache holds the last computed usage of a class or tenant. The
+// objects written since are added to the count, so that the quota can not be
+// exceeded by much between two computations.
+type quotaUsageCache struct {
+	sync.Mutex
+	usage     quotaUsage
+	updatedAt time.Time
+}
+
+// get returns the cached usage, or computes it if it is outdated or if
+// refresh is set
+func (c *quotaUsageCache) get(refresh bool, compute func() quotaUsage) quotaUsage {
+	c.Lock()
+	defer c.Unlock()
+
+	if refresh || time.Since(c.updatedAt) > quotaUsageMaxAge {
+		c.usage = compute()
+		c.updatedAt = time.Now()
+	}
+	return c.usage
+}
+
+func (c *quotaUsageCache) addObjects(count int64) {
+	c.Lock()
+	defer c.Unlock()
+
+	c.usage.objects += count
+}
+
+// quotaConfig returns nil if the class has no quotas configured
+func (s *Shard) quotaConfig() *models.QuotaConfig {
+	sch := s.index.getSchema.GetSchemaSkipAuth()
+	class := sch.GetClass(s.index.Config.ClassName)
+	if class == nil || class.QuotaConfig == nil {
+		return nil
+	}
+	return class.QuotaConfig
+}
+
+// tenantQuotas are only enforced for multi-tenant classes, in which every
+// shard holds the objects of one tenant
+func (s *Shard) tenantQuotas() bool {
+	return s.index.partitioningEnabled
+}
+
+// checkObjectsQuota checks whether the objects can be written without
+// exceeding the quotas of the class or tenant. It returns an error for every
+// object which would exceed them, or nil if all objects can be written.
+// Updates of existing objects are only rejected if a disk quota is exhausted.
+//
+// Quotas are enforced on the objects which the loaded shards on this node
+// hold.
+func (s *Shard) checkObjectsQuota(ctx context.Context, objs []*storobj.Object) []error {
+	cfg := s.quotaConfig()
+	if cfg == nil || len(objs) == 0 {
+		return nil
+	}
+
+	if err := s.checkDiskQuotaWithConfig(cfg, len(objs)); err != nil {
+		errs := make([]error, len(objs))
+		for i := range errs {
+			errs[i] = err
+		}
+		return errs
+	}
+
+	remaining, quota, limit := s.remainingObjects(cfg, false)
+	if remaining >= int64(len(objs)) {
+		s.index.quotaUsage.addObjects(int64(len(objs)))
+		return nil
+	}
+
+	// the objects may not fit, make sure the decision is based on the
+	// current usage and do not count updates of existing objects
+	remaining, quota, limit = s.remainingObjects(cfg, true)
+	var errs []error
+	accepted, rejected := int64(0), 0
+	for i, obj := range objs {
+		exists, err := s.exists(ctx, obj.ID())
+		if err == nil && !exists {
+			if accepted < remaining {
+				accepted++
+				continue
+			}
+			err = s.quotaExceeded(quota, "quota of %d objects exceeded", limit)
+			rejected++
+		}
+		if err != nil {
+			if errs == nil {
+				errs = make([]error, len(objs))
+			}
+			errs[i] = err
+		}
+	}
+
+	s.index.quotaUsage.addObjects(accepted)
+	s.quotaRejectedWrites(quota, rejected)
+	return errs
+}
+
+// checkDiskQuota returns an error if a disk quota of the class or tenant is
+// exhausted. It guards writes which change existing objects.
+func (s *Shard) checkDiskQuota() error {
+	cfg := s.quotaConfig()
+	if cfg == nil {
+		return nil
+	}
+	return s.checkDiskQuotaWithConfig(cfg, 1)
+}
+
+func (s *Shard) checkDiskQuotaWithConfig(cfg *models.QuotaConfig, writes int) error {
+	if cfg.MaxDiskBytes > 0 {
+		if usage := s.index.classQuotaUsage(cfg, false); usage.diskBytes >= cfg.MaxDiskBytes {
+			s.quotaRejectedWrites(quotaDiskBytes, writes)
+			return s.quotaExceeded(quotaDiskBytes, "quota of %d bytes exceeded", cfg.MaxDiskBytes)
+		}
+	}
+	if cfg.TenantMaxDiskBytes > 0 && s.tenantQuotas() {
+		if usage := s.tenantQuotaUsage(cfg, false); usage.diskBytes >= cfg.TenantMaxDiskBytes {
+			s.quotaRejectedWrites(quotaTenantDiskBytes, writes)
+			return s.quotaExceeded(quotaTenantDiskBytes, "quota of %d bytes exceeded",
+				cfg.TenantMaxDiskBytes)
+		}
+	}
+	return nil
+}
+
+// remainingObjects returns how many objects can still be added, and the
+// quota which limits them
+func (s *Shard) remainingObjects(cfg *models.QuotaConfig, refresh bool,
+) (remaining int64, quota string, limit int64) {
+	remaining = math.MaxInt64
+	if cfg.MaxObjects > 0 {
+		usage := s.index.classQuotaUsage(cfg, refresh)
+		remaining, quota, limit = cfg.MaxObjects-usage.objects, quotaObjects, cfg.MaxObjects
+	}
+	if cfg.TenantMaxObjects > 0 && s.tenantQuotas() {
+		// the cached usage is only reported, the quota is enforced on the
+		// current count, as counting the objects of a single shard is cheap
+		s.tenantQuotaUsage(cfg, false)
+		if r := cfg.TenantMaxObjects - int64(s.objectCount()); r < remaining {
+			remaining, quota, limit = r, quotaTenantObjects, cfg.TenantMaxObjects
+		}
+	}
+	if remaining < 0 {
+		remaining = 0
+	}
+	return remaining, quota, limit
+}
+
+func (s *Shard) quotaExceeded(quota, format string, limit int64) error {
+	if quota == quotaTenantObjects || quota == quotaTenantDiskBytes {
+		return objects.NewErrQuotaExceeded("tenant %s of class %s: "+format,
+			s.name, s.index.Config.ClassName, limit)
+	}
+	return objects.NewErrQuotaExceeded("class %s: "+format,
+		s.index.Config.ClassName, limit)
+}
+
+func (s *Shard) quotaRejectedWrites(quota string, count int) {
+	if count == 0 {
+		return
+	}
+	if quota == quotaTenantObjects || quota == quotaTenantDiskBytes {
+		s.metrics.QuotaRejectedWrites(quota, count)
+		return
+	}
+	s.index.metrics.QuotaRejectedWrites(quota, count)
+}
+
+// classQuotaUsage sums up the usage of the loaded shards of the class. Only
+// the usage a quota is configured for is computed.
+func (i *Index) classQuotaUsage(cfg *models.QuotaConfig, refresh bool) quotaUsage {
+	return i.quotaUsage.get(refresh, func() quotaUsage {
+		var usage quotaUsage
+		i.ForEachLoadedShard(func(name string, shard *Shard) error {
+			if cfg.MaxObjects > 0 {
+				usage.objects += int64(shard.objectCount())
+			}
+			if cfg.MaxDiskBytes > 0 {
+				usage.diskBytes += shard.diskBytes()
+			}
+			return nil
+		})
+
+		i.reportQuotaUsage(i.metrics, cfg.WarningPercentage, quotaObjects,
+			usage.objects, cfg.MaxObjects)
+		i.reportQuotaUsage(i.metrics, cfg.WarningPercentage, quotaDiskBytes,
+			usage.diskBytes, cfg.MaxDiskBytes)
+		return usage
+	})
+}
+
+func (s *Shard) tenantQuotaUsage(cfg *models.QuotaConfig, refresh bool) quotaUsage {
+	return s.quotaUsage.get(refresh, func() quotaUsage {
+		usage := quotaUsage{objects: int64(s.objectCount())}
+		if cfg.TenantMaxDiskBytes > 0 {
+			usage.diskBytes = s.diskBytes()
+		}
+
+		s.index.reportQuotaUsage(s.metrics, cfg.WarningPercentage, quotaTenantObjects,
+			usage.objects, cfg.TenantMaxObjects)
+		s.index.reportQuotaUsage(s.metrics, cfg.WarningPercentage, quotaTenantDiskBytes,
+			usage.diskBytes, cfg.TenantMaxDiskBytes)
+		return usage
+	})
+}
+
+func (s *Shard) diskBytes() int64 {
+	usage, err := shardDiskUsage(s.index.Config.RootPath,
+		s.index.Config.ClassName.String(), s.name, s.ID())
+	if err != nil {
+		s.index.logger.WithField("action", "quota_disk_usage").
+			WithField("shard", s.ID()).
+			Warn(err)
+		return 0
+	}
+	return usage.TotalBytes
+}
+
+// reportQuotaUsage exports the usage of a quota and warns once the usage
+// reaches the warning percentage. It is called whenever the usage is
+// computed, which limits how often the warning is logged.
+func (i *Index) reportQuotaUsage(metrics *Metrics, warningPercentage int64,
+	quota string, used, limit int64,
+) {
+	if limit <= 0 {
+		return
+	}
+
+	ratio := float64(used) / float64(limit)
+	metrics.QuotaUsage(quota, ratio)
+
+	if warningPercentage == 0 {
+		warningPercentage = defaultQuotaWarningPercentage
+	}
+	if ratio*100 >= float64(warningPercentage) {
+		i.logger.WithField("action", "quota_usage").
+			WithField("class", i.Config.ClassName).
+			WithField("quota", quota).
+			WithField("used", used).
+			WithField("limit", limit).
+			Warnf("%s quota of class %s is %.0f%% used", quota, i.Config.ClassName, ratio*100)
+	}
+}
@@ -0,0 +1,151 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+func TestStorageQuota(t *testing.T) {
+	dirName := t.TempDir()
+
+	logger, _ := test.NewNullLogger()
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer repo.Shutdown(context.Background())
+
+	migrator := NewMigrator(repo, logger)
+	class := &models.Class{
+		Class:               "Invoice",
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		QuotaConfig:         &models.QuotaConfig{MaxObjects: 3},
+		Properties: []*models.Property{
+			{
+				Name:         "customer",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationField,
+			},
+		},
+	}
+	require.Nil(t,
+		migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+	schemaGetter.schema.Objects = &models.Schema{
+		Classes: []*models.Class{class},
+	}
+
+	ids := []strfmt.UUID{
+		"8c5a4b7e-1d2f-4e3a-9b6c-000000000001",
+		"8c5a4b7e-1d2f-4e3a-9b6c-000000000002",
+		"8c5a4b7e-1d2f-4e3a-9b6c-000000000003",
+		"8c5a4b7e-1d2f-4e3a-9b6c-000000000004",
+		"8c5a4b7e-1d2f-4e3a-9b6c-000000000005",
+	}
+	object := func(id strfmt.UUID, customer string) *models.Object {
+		return &models.Object{
+			Class:      class.Class,
+			ID:         id,
+			Properties: map[string]interface{}{"customer": customer},
+		}
+	}
+	assertQuotaExceeded := func(t *testing.T, err error) {
+		t.Helper()
+		assert.True(t, errors.As(err, &objects.ErrQuotaExceeded{}), err)
+	}
+
+	t.Run("objects within the quota are written", func(t *testing.T) {
+		for _, id := range ids[:2] {
+			require.Nil(t, repo.PutObject(context.Background(),
+				object(id, "acme"), []float32{1, 2, 3}, nil))
+		}
+	})
+
+	t.Run("a batch is written up to the quota", func(t *testing.T) {
+		batch := objects.BatchObjects{}
+		for i, id := range []strfmt.UUID{ids[0], ids[2], ids[3]} {
+			batch = append(batch, objects.BatchObject{
+				OriginalIndex: i,
+				Object:        object(id, "globex"),
+				Vector:        []float32{1, 2, 3},
+				UUID:          id,
+			})
+		}
+
+		res, err := repo.BatchPutObjects(context.Background(), batch, nil)
+		require.Nil(t, err)
+		// the update of an existing object does not count towards the quota
+		assert.Nil(t, res[0].Err)
+		assert.Nil(t, res[1].Err)
+		assertQuotaExceeded(t, res[2].Err)
+
+		exists, err := repo.Exists(context.Background(), class.Class, ids[3], nil, "")
+		require.Nil(t, err)
+		assert.False(t, exists)
+	})
+
+	t.Run("a single object exceeding the quota is rejected", func(t *testing.T) {
+		err := repo.PutObject(context.Background(),
+			object(ids[4], "initech"), []float32{1, 2, 3}, nil)
+		assertQuotaExceeded(t, err)
+	})
+
+	t.Run("existing objects can still be updated", func(t *testing.T) {
+		require.Nil(t, repo.PutObject(context.Background(),
+			object(ids[1], "initech"), []float32{1, 2, 3}, nil))
+	})
+
+	t.Run("an exhausted disk quota rejects all writes", func(t *testing.T) {
+		class.QuotaConfig = &models.QuotaConfig{MaxDiskBytes: 1}
+		index := repo.GetIndex(schema.ClassName(class.Class))
+		index.quotaUsage.updatedAt = time.Time{}
+
+		err := repo.PutObject(context.Background(),
+			object(ids[0], "umbrella"), []float32{1, 2, 3}, nil)
+		assertQuotaExceeded(t, err)
+
+		err = repo.Merge(context.Background(), objects.MergeDocument{
+			Class:           class.Class,
+			ID:              ids[0],
+			PrimitiveSchema: map[string]interface{}{"customer": "umbrella"},
+			UpdateTime:      time.Now().UnixMilli(),
+		}, nil, "")
+		assertQuotaExceeded(t, err)
+	})
+
+	t.Run("writes are accepted once the quota is removed", func(t *testing.T) {
+		class.QuotaConfig = nil
+		require.Nil(t, repo.PutObject(context.Background(),
+			object(ids[4], "initech"), []float32{1, 2, 3}, nil))
+	})
+}
@@ -0,0 +1,41 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQuotaUsageCache(t *testing.T) {
+	computed := 0
+	compute := func() quotaUsage {
+		computed++
+		return quotaUsage{objects: 10, diskBytes: 100}
+	}
+
+	var c quotaUsageCache
+	assert.Equal(t, quotaUsage{objects: 10, diskBytes: 100}, c.get(false, compute))
+	assert.Equal(t, 1, computed)
+
+	t.Run("the usage is cached", func(t *testing.T) {
+		c.addObjects(2)
+		assert.Equal(t, quotaUsage{objects: 12, diskBytes: 100}, c.get(false, compute))
+		assert.Equal(t, 1, computed)
+	})
+
+	t.Run("the usage is computed again on refresh", func(t *testing.T) {
+		assert.Equal(t, quotaUsage{objects: 10, diskBytes: 100}, c.get(true, compute))
+		assert.Equal(t, 2, computed)
+	})
+}
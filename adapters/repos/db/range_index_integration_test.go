@@ -0,0 +1,315 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestRangeIndexFilters(t *testing.T) {
+	dirName := t.TempDir()
+
+	logger := logrus.New()
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, nil, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(context.TODO()))
+	defer repo.Shutdown(context.Background())
+
+	vTrue, vFalse := true, false
+	class := &models.Class{
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		Class:               "RangeIndexClass",
+		Properties: []*models.Property{
+			{
+				Name:              "count",
+				DataType:          schema.DataTypeInt.PropString(),
+				IndexRangeFilters: &vTrue,
+			},
+			{
+				// served by the range index only
+				Name:              "price",
+				DataType:          schema.DataTypeNumber.PropString(),
+				IndexFilterable:   &vFalse,
+				IndexRangeFilters: &vTrue,
+			},
+			{
+				Name:              "released",
+				DataType:          schema.DataTypeDate.PropString(),
+				IndexRangeFilters: &vTrue,
+			},
+		},
+	}
+	schemaGetter.schema = schema.Schema{
+		Objects: &models.Schema{Classes: []*models.Class{class}},
+	}
+	migrator := NewMigrator(repo, logger)
+	require.Nil(t, migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+
+	id := func(i int) strfmt.UUID {
+		return strfmt.UUID(uuid.MustParse(fmt.Sprintf("%032d", i)).String())
+	}
+	date := func(day int) time.Time {
+		return time.Date(2023, 1, day, 0, 0, 0, 0, time.UTC)
+	}
+	put := func(t *testing.T, i int, count int64, price float64, released time.Time) {
+		obj := &models.Object{Class: class.Class, ID: id(i), Properties: map[string]interface{}{
+			"count":    count,
+			"price":    price,
+			"released": released,
+		}}
+		require.Nil(t, repo.PutObject(context.Background(), obj, []float32{1, 3, 5, 0.4}, nil))
+	}
+
+	put(t, 0, -20, -1.5, date(1))
+	put(t, 1, 0, 0, date(2))
+	put(t, 2, 7, 2.25, date(3))
+	put(t, 3, 1000, 99.9, date(4))
+	put(t, 4, 7, 1e6, date(5))
+
+	idx := repo.GetIndex(schema.ClassName(class.Class))
+	require.NotNil(t, idx)
+
+	search := func(t *testing.T, prop string, operator filters.Operator,
+		dataType schema.DataType, value interface{},
+	) []strfmt.UUID {
+		filter := &filters.LocalFilter{
+			Root: &filters.Clause{
+				Operator: operator,
+				On:       &filters.Path{Class: schema.ClassName(class.Class), Property: schema.PropertyName(prop)},
+				Value:    &filters.Value{Type: dataType, Value: value},
+			},
+		}
+		res, _, err := idx.objectSearch(context.TODO(), 100, filter, nil, nil, nil,
+			additional.Properties{}, nil, "", 0)
+		require.Nil(t, err)
+
+		ids := make([]strfmt.UUID, len(res))
+		for i := range res {
+			ids[i] = res[i].ID()
+		}
+		return ids
+	}
+
+	t.Run("int", func(t *testing.T) {
+		assert.ElementsMatch(t, []strfmt.UUID{id(2), id(3), id(4)},
+			search(t, "count", filters.OperatorGreaterThan, schema.DataTypeInt, 0))
+		assert.ElementsMatch(t, []strfmt.UUID{id(1), id(2), id(3), id(4)},
+			search(t, "count", filters.OperatorGreaterThanEqual, schema.DataTypeInt, 0))
+		assert.ElementsMatch(t, []strfmt.UUID{id(0), id(1)},
+			search(t, "count", filters.OperatorLessThan, schema.DataTypeInt, 7))
+		assert.ElementsMatch(t, []strfmt.UUID{id(0), id(1), id(2), id(4)},
+			search(t, "count", filters.OperatorLessThanEqual, schema.DataTypeInt, 7))
+		assert.ElementsMatch(t, []strfmt.UUID{id(2), id(4)},
+			search(t, "count", filters.OperatorEqual, schema.DataTypeInt, 7))
+	})
+
+	t.Run("number without filterable index", func(t *testing.T) {
+		assert.ElementsMatch(t, []strfmt.UUID{id(0), id(1)},
+			search(t, "price", filters.OperatorLessThan, schema.DataTypeNumber, 0.5))
+		assert.ElementsMatch(t, []strfmt.UUID{id(3), id(4)},
+			search(t, "price", filters.OperatorGreaterThanEqual, schema.DataTypeNumber, 99.9))
+		assert.ElementsMatch(t, []strfmt.UUID{id(2)},
+			search(t, "price", filters.OperatorEqual, schema.DataTypeNumber, 2.25))
+		assert.ElementsMatch(t, []strfmt.UUID{id(0), id(1), id(3), id(4)},
+			search(t, "price", filters.OperatorNotEqual, schema.DataTypeNumber, 2.25))
+	})
+
+	t.Run("date", func(t *testing.T) {
+		assert.ElementsMatch(t, []strfmt.UUID{id(3), id(4)},
+			search(t, "released", filters.OperatorGreaterThan, schema.DataTypeDate,
+				date(3).Format(time.RFC3339)))
+		assert.ElementsMatch(t, []strfmt.UUID{id(0), id(1), id(2)},
+			search(t, "released", filters.OperatorLessThanEqual, schema.DataTypeDate,
+				date(3).Format(time.RFC3339)))
+	})
+
+	t.Run("after update", func(t *testing.T) {
+		put(t, 2, 5000, 3, date(10))
+
+		assert.ElementsMatch(t, []strfmt.UUID{id(3), id(2)},
+			search(t, "count", filters.OperatorGreaterThan, schema.DataTypeInt, 7))
+		assert.ElementsMatch(t, []strfmt.UUID{id(4)},
+			search(t, "count", filters.OperatorEqual, schema.DataTypeInt, 7))
+		assert.ElementsMatch(t, []strfmt.UUID{id(0), id(1), id(2)},
+			search(t, "price", filters.OperatorLessThanEqual, schema.DataTypeNumber, 3.0))
+		assert.ElementsMatch(t, []strfmt.UUID{id(2)},
+			search(t, "released", filters.OperatorGreaterThan, schema.DataTypeDate,
+				date(5).Format(time.RFC3339)))
+	})
+
+	t.Run("after delete", func(t *testing.T) {
+		require.Nil(t, repo.DeleteObject(context.Background(), class.Class, id(3), nil, ""))
+
+		assert.ElementsMatch(t, []strfmt.UUID{id(2)},
+			search(t, "count", filters.OperatorGreaterThan, schema.DataTypeInt, 7))
+		assert.ElementsMatch(t, []strfmt.UUID{id(0), id(1), id(2), id(4)},
+			search(t, "price", filters.OperatorNotEqual, schema.DataTypeNumber, 99.9))
+	})
+}
+
+func TestTimestampRangeIndex(t *testing.T) {
+	dirName := t.TempDir()
+
+	logger := logrus.New()
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, nil, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(context.TODO()))
+	defer repo.Shutdown(context.Background())
+
+	invertedIndexConfig := invertedConfig()
+	invertedIndexConfig.IndexTimestamps = true
+	invertedIndexConfig.IndexTimestampRangeFilters = true
+	class := &models.Class{
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedIndexConfig,
+		Class:               "TimestampRangeIndexClass",
+		Properties: []*models.Property{
+			{
+				Name:         "category",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationField,
+			},
+		},
+	}
+	schemaGetter.schema = schema.Schema{
+		Objects: &models.Schema{Classes: []*models.Class{class}},
+	}
+	migrator := NewMigrator(repo, logger)
+	require.Nil(t, migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+
+	id := func(i int) strfmt.UUID {
+		return strfmt.UUID(uuid.MustParse(fmt.Sprintf("%032d", i)).String())
+	}
+	const base = int64(1_700_000_000_000)
+	put := func(t *testing.T, i int, category string, created, updated int64) {
+		obj := &models.Object{
+			Class: class.Class, ID: id(i),
+			CreationTimeUnix:   base + created,
+			LastUpdateTimeUnix: base + updated,
+			Properties:         map[string]interface{}{"category": category},
+		}
+		require.Nil(t, repo.PutObject(context.Background(), obj, []float32{1, 3, 5, 0.4}, nil))
+	}
+
+	put(t, 0, "a", 0, 500)
+	put(t, 1, "b", 100, 100)
+	put(t, 2, "a", 200, 300)
+	put(t, 3, "b", 300, 300)
+	put(t, 4, "a", 400, 400)
+
+	timestampFilter := func(prop string, operator filters.Operator, ts int64) *filters.LocalFilter {
+		return &filters.LocalFilter{
+			Root: &filters.Clause{
+				Operator: operator,
+				On:       &filters.Path{Class: schema.ClassName(class.Class), Property: schema.PropertyName(prop)},
+				Value:    &filters.Value{Type: schema.DataTypeText, Value: strconv.FormatInt(base+ts, 10)},
+			},
+		}
+	}
+	search := func(t *testing.T, limit int, filter *filters.LocalFilter, sort []filters.Sort) []strfmt.UUID {
+		res, err := repo.Search(context.Background(), dto.GetParams{
+			ClassName:  class.Class,
+			Pagination: &filters.Pagination{Limit: limit},
+			Filters:    filter,
+			Sort:       sort,
+		})
+		require.Nil(t, err)
+
+		ids := make([]strfmt.UUID, len(res))
+		for i := range res {
+			ids[i] = res[i].ID
+		}
+		return ids
+	}
+
+	t.Run("range filters", func(t *testing.T) {
+		assert.ElementsMatch(t, []strfmt.UUID{id(3), id(4)},
+			search(t, 10, timestampFilter(filters.InternalPropCreationTimeUnix, filters.OperatorGreaterThan, 200), nil))
+		assert.ElementsMatch(t, []strfmt.UUID{id(0), id(1), id(2)},
+			search(t, 10, timestampFilter(filters.InternalPropCreationTimeUnix, filters.OperatorLessThanEqual, 200), nil))
+		assert.ElementsMatch(t, []strfmt.UUID{id(2), id(3)},
+			search(t, 10, timestampFilter(filters.InternalPropLastUpdateTimeUnix, filters.OperatorEqual, 300), nil))
+		assert.ElementsMatch(t, []strfmt.UUID{id(0), id(4)},
+			search(t, 10, timestampFilter(filters.InternalPropLastUpdateTimeUnix, filters.OperatorGreaterThanEqual, 400), nil))
+	})
+
+	t.Run("sort", func(t *testing.T) {
+		byCreated := []filters.Sort{{Path: []string{filters.InternalPropCreationTimeUnix}, Order: "desc"}}
+		assert.Equal(t, []strfmt.UUID{id(4), id(3)}, search(t, 2, nil, byCreated))
+
+		byCreated = []filters.Sort{{Path: []string{filters.InternalPropCreationTimeUnix}, Order: "asc"}}
+		assert.Equal(t, []strfmt.UUID{id(0), id(1), id(2)}, search(t, 3, nil, byCreated))
+
+		// ties in the first sort key are broken by the second one
+		byUpdated := []filters.Sort{
+			{Path: []string{filters.InternalPropLastUpdateTimeUnix}, Order: "asc"},
+			{Path: []string{filters.InternalPropCreationTimeUnix}, Order: "desc"},
+		}
+		assert.Equal(t, []strfmt.UUID{id(1), id(3), id(2)}, search(t, 3, nil, byUpdated))
+	})
+
+	t.Run("sort with filter", func(t *testing.T) {
+		filter := &filters.LocalFilter{
+			Root: &filters.Clause{
+				Operator: filters.OperatorEqual,
+				On:       &filters.Path{Class: schema.ClassName(class.Class), Property: "category"},
+				Value:    &filters.Value{Type: schema.DataTypeText, Value: "a"},
+			},
+		}
+		byUpdated := []filters.Sort{{Path: []string{filters.InternalPropLastUpdateTimeUnix}, Order: "desc"}}
+		assert.Equal(t, []strfmt.UUID{id(0), id(4)}, search(t, 2, filter, byUpdated))
+	})
+
+	t.Run("after update and delete", func(t *testing.T) {
+		put(t, 1, "b", 100, 1000)
+		require.Nil(t, repo.DeleteObject(context.Background(), class.Class, id(0), nil, ""))
+
+		assert.ElementsMatch(t, []strfmt.UUID{id(1), id(4)},
+			search(t, 10, timestampFilter(filters.InternalPropLastUpdateTimeUnix, filters.OperatorGreaterThan, 300), nil))
+
+		byUpdated := []filters.Sort{{Path: []string{filters.InternalPropLastUpdateTimeUnix}, Order: "desc"}}
+		assert.Equal(t, []strfmt.UUID{id(1), id(4)}, search(t, 2, nil, byUpdated))
+	})
+}
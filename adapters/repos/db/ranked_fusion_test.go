@@ -832,6 +832,10 @@ func (f *fakeObjectSearcher) Search(context.Context, dto.GetParams) ([]search.Re
 	return nil, nil
 }
 
+func (f *fakeObjectSearcher) SearchStream(context.Context, dto.GetParams, func(search.Results) error) error {
+	return nil
+}
+
 func (f *fakeObjectSearcher) VectorSearch(context.Context, dto.GetParams) ([]search.Result, error) {
 	return nil, nil
 }
@@ -872,7 +876,7 @@ func (f *fakeObjectSearcher) SparseObjectSearch(ctx context.Context, params dto.
 	return out[:lim], []float32{0.008, 0.001}[:lim], nil
 }
 
-func (f *fakeObjectSearcher) DenseObjectSearch(ctx context.Context, class string, vector []float32, offset int, limit int, filters *filters.LocalFilter, additinal additional.Properties, tenant string) ([]*storobj.Object, []float32, error) {
+func (f *fakeObjectSearcher) DenseObjectSearch(ctx context.Context, class string, vector []float32, offset int, limit int, filters *filters.LocalFilter, exact bool, additinal additional.Properties, tenant string) ([]*storobj.Object, []float32, error) {
 	out := []*storobj.Object{
 		{
 			Object: models.Object{
@@ -0,0 +1,265 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/storagestate"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+// reasons for which the node is in read-only mode
+const (
+	ReadOnlyReasonManual      = "MANUAL"
+	ReadOnlyReasonDiskUsage   = "DISK_USAGE"
+	ReadOnlyReasonMemoryUsage = "MEMORY_USAGE"
+)
+
+// ReadOnlyMode rejects writes to the entire node or to single classes, e.g.
+// during migrations. The mode set by an admin is persisted, so that it
+// survives restarts. The mode set automatically, when a resource usage
+// threshold is exceeded, is not persisted, as the usage is scanned again
+// after a restart.
+type ReadOnlyMode struct {
+	path string
+
+	sync.RWMutex
+	node      bool
+	automatic string
+	classes   map[string]struct{}
+}
+
+// readOnlyState is persisted in the root path
+type readOnlyState struct {
+	Node    bool     `json:"node"`
+	Classes []string `json:"classes"`
+}
+
+func NewReadOnlyMode(rootPath string) *ReadOnlyMode {
+	return &ReadOnlyMode{
+		path:    filepath.Join(rootPath, "read_only.json"),
+		classes: map[string]struct{}{},
+	}
+}
+
+// load restores the read-only mode set by an admin before the last shutdown
+func (m *ReadOnlyMode) load() error {
+	data, err := os.ReadFile(m.path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+		return err
+	}
+
+	var state readOnlyState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return fmt.Errorf("parse %s: %w", m.path, err)
+	}
+
+	m.Lock()
+	defer m.Unlock()
+
+	m.node = state.Node
+	for _, class := range state.Classes {
+		m.classes[class] = struct{}{}
+	}
+	return nil
+}
+
+// persist must be called with the lock held
+func (m *ReadOnlyMode) persist() error {
+	data, err := json.Marshal(readOnlyState{Node: m.node, Classes: m.classNames()})
+	if err != nil {
+		return fmt.Errorf("marshal read-only mode: %w", err)
+	}
+
+	tmp := m.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o666); err != nil {
+		return fmt.Errorf("write read-only mode: %w", err)
+	}
+	if err := os.Rename(tmp, m.path); err != nil {
+		return fmt.Errorf("write read-only mode: %w", err)
+	}
+	return nil
+}
+
+// checkNodeWritable returns an error, if the node rejects all writes
+func (m *ReadOnlyMode) checkNodeWritable() error {
+	if m == nil {
+		return nil
+	}
+
+	m.RLock()
+	defer m.RUnlock()
+
+	if reason := m.reason(); reason != "" {
+		return objects.NewErrReadOnly("node is in read-only mode (%s)", reason)
+	}
+	return nil
+}
+
+// checkWritable returns an error, if writes to the class are rejected
+func (m *ReadOnlyMode) checkWritable(className schema.ClassName) error {
+	if m == nil {
+		return nil
+	}
+	if err := m.checkNodeWritable(); err != nil {
+		return err
+	}
+
+	m.RLock()
+	defer m.RUnlock()
+
+	if _, ok := m.classes[className.String()]; ok {
+		return objects.NewErrReadOnly("class %s is in read-only mode", className)
+	}
+	return nil
+}
+
+// reason must be called with the lock held
+func (m *ReadOnlyMode) reason() string {
+	if m.node {
+		return ReadOnlyReasonManual
+	}
+	return m.automatic
+}
+
+func (m *ReadOnlyMode) setNode(readOnly bool) error {
+	m.Lock()
+	defer m.Unlock()
+
+	m.node = readOnly
+	if !readOnly {
+		m.automatic = ""
+	}
+	return m.persist()
+}
+
+func (m *ReadOnlyMode) setClass(className schema.ClassName, readOnly bool) error {
+	m.Lock()
+	defer m.Unlock()
+
+	if readOnly {
+		m.classes[className.String()] = struct{}{}
+	} else {
+		delete(m.classes, className.String())
+	}
+	return m.persist()
+}
+
+// setAutomatic puts the node into read-only mode because a resource usage
+// threshold was exceeded
+func (m *ReadOnlyMode) setAutomatic(reason string) {
+	m.Lock()
+	defer m.Unlock()
+
+	m.automatic = reason
+}
+
+func (m *ReadOnlyMode) isAutomatic() bool {
+	m.RLock()
+	defer m.RUnlock()
+
+	return m.automatic != ""
+}
+
+// dropClass forgets about the read-only mode of a deleted class, so that a
+// class of the same name which is created later is writable
+func (m *ReadOnlyMode) dropClass(className schema.ClassName) error {
+	m.Lock()
+	defer m.Unlock()
+
+	if _, ok := m.classes[className.String()]; !ok {
+		return nil
+	}
+	delete(m.classes, className.String())
+	return m.persist()
+}
+
+func (m *ReadOnlyMode) status() *models.NodeReadOnlyStatus {
+	m.RLock()
+	defer m.RUnlock()
+
+	reason := m.reason()
+	return &models.NodeReadOnlyStatus{
+		ReadOnly: reason != "",
+		Reason:   reason,
+		Classes:  m.classNames(),
+	}
+}
+
+// classNames must be called with the lock held
+func (m *ReadOnlyMode) classNames() []string {
+	names := make([]string, 0, len(m.classes))
+	for name := range m.classes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// SetReadOnly puts the entire node, or a class on this node if className is
+// set, into read-only mode or lifts it. Lifting the read-only mode of the
+// node also lifts the automatic read-only mode, and makes the shards which
+// were set to READONLY because of it writable again.
+func (db *DB) SetReadOnly(className string, readOnly bool,
+) (*models.NodeReadOnlyStatus, error) {
+	if className != "" {
+		if db.GetIndex(schema.ClassName(className)) == nil {
+			return nil, enterrors.NewErrNotFound(
+				fmt.Errorf("class %s not found", className))
+		}
+		if err := db.readOnly.setClass(schema.ClassName(className), readOnly); err != nil {
+			return nil, err
+		}
+		return db.readOnly.status(), nil
+	}
+
+	wasAutomatic := db.readOnly.isAutomatic()
+	if err := db.readOnly.setNode(readOnly); err != nil {
+		return nil, err
+	}
+	if !readOnly && wasAutomatic {
+		db.setShardsReady()
+	}
+
+	return db.readOnly.status(), nil
+}
+
+func (db *DB) setShardsReady() {
+	db.indexLock.RLock()
+	defer db.indexLock.RUnlock()
+
+	for _, index := range db.indices {
+		index.ForEachLoadedShard(func(name string, shard *Shard) error {
+			if err := shard.updateStatus(storagestate.StatusReady.String()); err != nil {
+				db.logger.WithField("action", "lift_read_only").
+					WithField("shard", shard.ID()).
+					WithError(err).
+					Error("could not set shard to READY")
+			}
+			return nil
+		})
+	}
+}
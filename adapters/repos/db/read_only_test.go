@@ -0,0 +1,108 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+func TestReadOnlyMode(t *testing.T) {
+	assertReadOnly := func(t *testing.T, err error) {
+		t.Helper()
+		assert.True(t, errors.As(err, &objects.ErrReadOnly{}), err)
+	}
+
+	t.Run("a nil mode accepts all writes", func(t *testing.T) {
+		var m *ReadOnlyMode
+		assert.Nil(t, m.checkWritable("Article"))
+	})
+
+	t.Run("class", func(t *testing.T) {
+		m := NewReadOnlyMode(t.TempDir())
+		require.Nil(t, m.setClass("Article", true))
+
+		assertReadOnly(t, m.checkWritable("Article"))
+		assert.Nil(t, m.checkWritable("Author"))
+		assert.Nil(t, m.checkNodeWritable())
+
+		status := m.status()
+		assert.False(t, status.ReadOnly)
+		assert.Equal(t, []string{"Article"}, status.Classes)
+
+		require.Nil(t, m.setClass("Article", false))
+		assert.Nil(t, m.checkWritable("Article"))
+	})
+
+	t.Run("node", func(t *testing.T) {
+		m := NewReadOnlyMode(t.TempDir())
+		require.Nil(t, m.setNode(true))
+
+		assertReadOnly(t, m.checkNodeWritable())
+		assertReadOnly(t, m.checkWritable("Article"))
+		assert.Equal(t, ReadOnlyReasonManual, m.status().Reason)
+
+		require.Nil(t, m.setNode(false))
+		assert.Nil(t, m.checkWritable("Article"))
+	})
+
+	t.Run("automatic mode is lifted with the node mode", func(t *testing.T) {
+		m := NewReadOnlyMode(t.TempDir())
+		m.setAutomatic(ReadOnlyReasonDiskUsage)
+
+		assertReadOnly(t, m.checkWritable("Article"))
+		status := m.status()
+		assert.True(t, status.ReadOnly)
+		assert.Equal(t, ReadOnlyReasonDiskUsage, status.Reason)
+
+		require.Nil(t, m.setNode(false))
+		assert.False(t, m.isAutomatic())
+		assert.Nil(t, m.checkWritable("Article"))
+	})
+
+	t.Run("the mode set by an admin survives restarts", func(t *testing.T) {
+		root := t.TempDir()
+		m := NewReadOnlyMode(root)
+		require.Nil(t, m.setNode(true))
+		require.Nil(t, m.setClass("Article", true))
+		m.setAutomatic(ReadOnlyReasonMemoryUsage)
+
+		restarted := NewReadOnlyMode(root)
+		require.Nil(t, restarted.load())
+		assert.Equal(t, m.classNames(), restarted.classNames())
+		assert.True(t, restarted.node)
+		assert.False(t, restarted.isAutomatic())
+	})
+
+	t.Run("a dropped class is forgotten", func(t *testing.T) {
+		root := t.TempDir()
+		m := NewReadOnlyMode(root)
+		require.Nil(t, m.setClass("Article", true))
+		require.Nil(t, m.dropClass(schema.ClassName("Article")))
+		assert.Nil(t, m.checkWritable("Article"))
+
+		restarted := NewReadOnlyMode(root)
+		require.Nil(t, restarted.load())
+		assert.Empty(t, restarted.classNames())
+	})
+
+	t.Run("load without a persisted mode", func(t *testing.T) {
+		m := NewReadOnlyMode(t.TempDir())
+		assert.Nil(t, m.load())
+		assert.Nil(t, m.checkWritable("Article"))
+	})
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/multi"
+	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/schema/crossref"
 	"github.com/weaviate/weaviate/entities/search"
 )
@@ -28,6 +29,8 @@ type Resolver struct {
 	// for groupBy feature
 	withGroup                bool
 	getGroupSelectProperties func(properties search.SelectProperties) search.SelectProperties
+	// shallow resolvers don't look up referenced objects at all
+	shallow bool
 }
 
 type cacher interface {
@@ -48,11 +51,30 @@ func NewResolverWithGroup(cacher cacher) *Resolver {
 	}
 }
 
+// NewShallowResolver returns a resolver which turns the selected references
+// into search.LocalRef items holding only the target class and id. No
+// referenced objects are looked up, so nested selections are ignored. Beacons
+// without a class can't be matched against the selection and are skipped.
+func NewShallowResolver() *Resolver {
+	return &Resolver{shallow: true}
+}
+
+func NewShallowResolverWithGroup() *Resolver {
+	return &Resolver{
+		shallow: true,
+		// for groupBy feature
+		withGroup:                true,
+		getGroupSelectProperties: getGroupSelectProperties,
+	}
+}
+
 func (r *Resolver) Do(ctx context.Context, objects []search.Result,
 	properties search.SelectProperties, additional additional.Properties,
 ) ([]search.Result, error) {
-	if err := r.cacher.Build(ctx, objects, properties, additional); err != nil {
-		return nil, errors.Wrap(err, "build reference cache")
+	if !r.shallow {
+		if err := r.cacher.Build(ctx, objects, properties, additional); err != nil {
+			return nil, errors.Wrap(err, "build reference cache")
+		}
 	}
 
 	return r.parseObjects(objects, properties, additional)
@@ -152,6 +174,10 @@ func (r *Resolver) parseSchema(schema map[string]interface{},
 func (r *Resolver) parseRefs(input models.MultipleRef, prop string,
 	selectProp search.SelectProperty,
 ) ([]interface{}, error) {
+	if r.shallow {
+		return r.resolveRefsShallow(input, selectProp)
+	}
+
 	var refs []interface{}
 	for _, selectPropRef := range selectProp.Refs {
 		innerProperties := selectPropRef.RefProperties
@@ -237,3 +263,26 @@ func (r *Resolver) resolveRef(item *models.SingleRef, desiredClass string,
 
 	return &out, nil
 }
+
+func (r *Resolver) resolveRefsShallow(input models.MultipleRef,
+	selectProp search.SelectProperty,
+) ([]interface{}, error) {
+	var output []interface{}
+	for i, item := range input {
+		ref, err := crossref.Parse(item.Beacon.String())
+		if err != nil {
+			return nil, errors.Wrapf(err, "at position %d", i)
+		}
+
+		if ref.Class == "" || selectProp.FindSelectClass(schema.ClassName(ref.Class)) == nil {
+			continue
+		}
+
+		output = append(output, search.LocalRef{
+			Class:  ref.Class,
+			Fields: map[string]interface{}{"id": ref.TargetID},
+		})
+	}
+
+	return output, nil
+}
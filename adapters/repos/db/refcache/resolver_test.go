@@ -549,3 +549,63 @@ func (f *fakeCacher) Get(si multi.Identifier) (search.Result, bool) {
 	res, ok := f.lookup[si]
 	return res, ok
 }
+
+func TestShallowResolver(t *testing.T) {
+	id1 := "df5d4e49-0c56-4b87-ade1-3d46cc9b425f"
+	id2 := "3a08d808-8eb5-49ee-86b2-68b6035e8b69"
+	id3 := "9e3a4bbb-2ef4-4f5b-8a67-fd4b4a8e0c3a"
+
+	input := []search.Result{
+		{
+			ID:        "foo",
+			ClassName: "BestClass",
+			Schema: map[string]interface{}{
+				"refProp": models.MultipleRef{
+					&models.SingleRef{
+						Beacon: strfmt.URI(fmt.Sprintf("weaviate://localhost/SomeClass/%s", id1)),
+					},
+					&models.SingleRef{
+						Beacon: strfmt.URI(fmt.Sprintf("weaviate://localhost/OtherClass/%s", id2)),
+					},
+					&models.SingleRef{
+						// without a class the beacon can't be matched
+						Beacon: strfmt.URI(fmt.Sprintf("weaviate://localhost/%s", id3)),
+					},
+				},
+			},
+		},
+	}
+	selectProps := search.SelectProperties{
+		search.SelectProperty{
+			Name: "refProp",
+			Refs: []search.SelectClass{
+				{
+					ClassName: "SomeClass",
+					RefProperties: search.SelectProperties{
+						search.SelectProperty{Name: "bar", IsPrimitive: true},
+					},
+				},
+			},
+		},
+	}
+
+	res, err := NewShallowResolver().Do(context.Background(), input, selectProps,
+		additional.Properties{NoRefResolution: true})
+	require.Nil(t, err)
+
+	expected := []search.Result{
+		{
+			ID:        "foo",
+			ClassName: "BestClass",
+			Schema: map[string]interface{}{
+				"refProp": []interface{}{
+					search.LocalRef{
+						Class:  "SomeClass",
+						Fields: map[string]interface{}{"id": strfmt.UUID(id1)},
+					},
+				},
+			},
+		},
+	}
+	assert.Equal(t, expected, res)
+}
@@ -20,12 +20,10 @@ import (
 	"path/filepath"
 
 	"github.com/go-openapi/strfmt"
-	"github.com/weaviate/weaviate/adapters/repos/db/vector/noop"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/multi"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/storobj"
-	hnswent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 	"github.com/weaviate/weaviate/usecases/objects"
 	"github.com/weaviate/weaviate/usecases/replica"
 )
@@ -152,14 +150,14 @@ func (db *DB) replicatedIndex(name string) (idx *Index, resp *replica.SimpleResp
 	return
 }
 
-func (i *Index) writableShard(name string) (*Shard, *replica.SimpleResponse) {
-	localShard := i.localShard(name)
+func (i *Index) writableShard(ctx context.Context, name string) (*Shard, *replica.SimpleResponse) {
+	localShard := i.localShard(ctx, name)
 	if localShard == nil {
 		return nil, &replica.SimpleResponse{Errors: []replica.Error{
 			{Code: replica.StatusShardNotFound, Msg: name},
 		}}
 	}
-	if localShard.isReadOnly() {
+	if localShard.checkWritable() != nil {
 		return nil, &replica.SimpleResponse{Errors: []replica.Error{{
 			Code: replica.StatusReadOnly, Msg: name,
 		}}}
@@ -168,7 +166,7 @@ func (i *Index) writableShard(name string) (*Shard, *replica.SimpleResponse) {
 }
 
 func (i *Index) ReplicateObject(ctx context.Context, shard, requestID string, object *storobj.Object) replica.SimpleResponse {
-	localShard, pr := i.writableShard(shard)
+	localShard, pr := i.writableShard(ctx, shard)
 	if pr != nil {
 		return *pr
 	}
@@ -176,7 +174,7 @@ func (i *Index) ReplicateObject(ctx context.Context, shard, requestID string, ob
 }
 
 func (i *Index) ReplicateUpdate(ctx context.Context, shard, requestID string, doc *objects.MergeDocument) replica.SimpleResponse {
-	localShard, pr := i.writableShard(shard)
+	localShard, pr := i.writableShard(ctx, shard)
 	if pr != nil {
 		return *pr
 	}
@@ -184,7 +182,7 @@ func (i *Index) ReplicateUpdate(ctx context.Context, shard, requestID string, do
 }
 
 func (i *Index) ReplicateDeletion(ctx context.Context, shard, requestID string, uuid strfmt.UUID) replica.SimpleResponse {
-	localShard, pr := i.writableShard(shard)
+	localShard, pr := i.writableShard(ctx, shard)
 	if pr != nil {
 		return *pr
 	}
@@ -192,7 +190,7 @@ func (i *Index) ReplicateDeletion(ctx context.Context, shard, requestID string,
 }
 
 func (i *Index) ReplicateObjects(ctx context.Context, shard, requestID string, objects []*storobj.Object) replica.SimpleResponse {
-	localShard, pr := i.writableShard(shard)
+	localShard, pr := i.writableShard(ctx, shard)
 	if pr != nil {
 		return *pr
 	}
@@ -200,7 +198,7 @@ func (i *Index) ReplicateObjects(ctx context.Context, shard, requestID string, o
 }
 
 func (i *Index) ReplicateDeletions(ctx context.Context, shard, requestID string, docIDs []uint64, dryRun bool) replica.SimpleResponse {
-	localShard, pr := i.writableShard(shard)
+	localShard, pr := i.writableShard(ctx, shard)
 	if pr != nil {
 		return *pr
 	}
@@ -208,7 +206,7 @@ func (i *Index) ReplicateDeletions(ctx context.Context, shard, requestID string,
 }
 
 func (i *Index) ReplicateReferences(ctx context.Context, shard, requestID string, refs []objects.BatchReference) replica.SimpleResponse {
-	localShard, pr := i.writableShard(shard)
+	localShard, pr := i.writableShard(ctx, shard)
 	if pr != nil {
 		return *pr
 	}
@@ -216,15 +214,16 @@ func (i *Index) ReplicateReferences(ctx context.Context, shard, requestID string
 }
 
 func (i *Index) CommitReplication(shard, requestID string) interface{} {
-	localShard := i.localShard(shard)
+	ctx := context.Background()
+	localShard := i.localShard(ctx, shard)
 	if localShard == nil {
 		return nil
 	}
-	return localShard.commit(context.Background(), requestID, &i.backupMutex)
+	return localShard.commit(ctx, requestID, &i.backupMutex)
 }
 
 func (i *Index) AbortReplication(shard, requestID string) interface{} {
-	localShard := i.localShard(shard)
+	localShard := i.localShard(context.Background(), shard)
 	if localShard == nil {
 		return replica.SimpleResponse{Errors: []replica.Error{
 			{Code: replica.StatusShardNotFound, Msg: shard},
@@ -236,7 +235,7 @@ func (i *Index) AbortReplication(shard, requestID string) interface{} {
 func (i *Index) IncomingFilePutter(ctx context.Context, shardName,
 	filePath string,
 ) (io.WriteCloser, error) {
-	localShard := i.localShard(shardName)
+	localShard := i.localShard(ctx, shardName)
 	if localShard == nil {
 		return nil, fmt.Errorf("shard %q does not exist locally", shardName)
 	}
@@ -256,7 +255,7 @@ func (i *Index) IncomingCreateShard(ctx context.Context,
 func (i *Index) IncomingReinitShard(ctx context.Context,
 	shardName string,
 ) error {
-	shard := i.localShard(shardName)
+	shard := i.localShard(ctx, shardName)
 	if shard == nil {
 		return fmt.Errorf("shard %q does not exist locally", shardName)
 	}
@@ -288,21 +287,16 @@ func (s *Shard) reinit(ctx context.Context) error {
 		return fmt.Errorf("shutdown shard: %w", err)
 	}
 
-	hnswUserConfig, ok := s.index.vectorIndexUserConfig.(hnswent.UserConfig)
-	if !ok {
-		return fmt.Errorf("hnsw vector index: config is not hnsw.UserConfig: %T",
-			s.index.vectorIndexUserConfig)
+	if err := s.initVectorIndex(ctx, s.index.vectorIndexUserConfig); err != nil {
+		return fmt.Errorf("init vector index: %w", err)
 	}
+	defer s.vectorIndex.PostStartup()
 
-	if hnswUserConfig.Skip {
-		s.vectorIndex = noop.NewIndex()
-	} else {
-		if err := s.initVectorIndex(ctx, hnswUserConfig); err != nil {
-			return fmt.Errorf("init vector index: %w", err)
-		}
-
-		defer s.vectorIndex.PostStartup()
+	postStartupTargetVectors, err := s.initTargetVectors(ctx)
+	if err != nil {
+		return fmt.Errorf("init target vectors: %w", err)
 	}
+	defer postStartupTargetVectors()
 
 	if err := s.initNonVector(ctx, nil); err != nil {
 		return fmt.Errorf("init non-vector: %w", err)
@@ -325,7 +319,7 @@ func (i *Index) overwriteObjects(ctx context.Context,
 	shard string, updates []*objects.VObject,
 ) ([]replica.RepairResponse, error) {
 	result := make([]replica.RepairResponse, 0, len(updates)/2)
-	s := i.localShard(shard)
+	s := i.localShard(ctx, shard)
 	if s == nil {
 		return nil, fmt.Errorf("shard %q not found locally", shard)
 	}
@@ -386,7 +380,7 @@ func (i *Index) digestObjects(ctx context.Context,
 	shardName string, ids []strfmt.UUID,
 ) (result []replica.RepairResponse, err error) {
 	result = make([]replica.RepairResponse, len(ids))
-	s := i.localShard(shardName)
+	s := i.localShard(ctx, shardName)
 	if s == nil {
 		return nil, fmt.Errorf("shard %q not found locally", shardName)
 	}
@@ -442,7 +436,7 @@ func (db *DB) FetchObject(ctx context.Context,
 func (i *Index) readRepairGetObject(ctx context.Context,
 	shardName string, id strfmt.UUID,
 ) (objects.Replica, error) {
-	shard := i.localShard(shardName)
+	shard := i.localShard(ctx, shardName)
 	if shard == nil {
 		return objects.Replica{}, fmt.Errorf("shard %q does not exist locally", shardName)
 	}
@@ -479,7 +473,7 @@ func (db *DB) FetchObjects(ctx context.Context,
 func (i *Index) fetchObjects(ctx context.Context,
 	shardName string, ids []strfmt.UUID,
 ) ([]objects.Replica, error) {
-	shard := i.localShard(shardName)
+	shard := i.localShard(ctx, shardName)
 	if shard == nil {
 		return nil, fmt.Errorf("shard %q does not exist locally", shardName)
 	}
@@ -21,6 +21,8 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/diskio"
 	"github.com/weaviate/weaviate/entities/replication"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/storobj"
@@ -45,6 +47,23 @@ type DB struct {
 	startupComplete   atomic.Bool
 	resourceScanState *resourceScanState
 
+	// blockCache is shared by the lsm stores of all shards, nil if disabled
+	blockCache *lsmkv.BlockCache
+
+	// cancels and awaits the background loading of lazily registered shards
+	warmerCancel context.CancelFunc
+	warmerWg     sync.WaitGroup
+
+	// offloader moves the files of FROZEN shards to the offload backend
+	offloader *Offloader
+
+	// trash holds the files of dropped classes and shards until they are
+	// purged in the background
+	trash *Trash
+
+	// readOnly rejects writes to the entire node or to single classes
+	readOnly *ReadOnlyMode
+
 	// indexLock is an RWMutex which allows concurrent access to various indexes,
 	// but only one modification at a time. R/W can be a bit confusing here,
 	// because it does not refer to write or read requests from a user's
@@ -89,6 +108,16 @@ func (db *DB) WaitForStartup(ctx context.Context) error {
 	db.startupComplete.Store(true)
 	db.scanResourceUsage()
 
+	if db.config.LazyLoadShards {
+		warmerCtx, cancel := context.WithCancel(context.Background())
+		db.warmerCancel = cancel
+		db.warmerWg.Add(1)
+		go func() {
+			defer db.warmerWg.Done()
+			db.warmLazyShards(warmerCtx)
+		}()
+	}
+
 	return nil
 }
 
@@ -112,10 +141,17 @@ func New(logger logrus.FieldLogger, config Config,
 		jobQueueCh:          make(chan job, 100000),
 		maxNumberGoroutines: int(math.Round(config.MaxImportGoroutinesFactor * float64(runtime.GOMAXPROCS(0)))),
 		resourceScanState:   newResourceScanState(),
+		offloader:           NewOffloader(config.OffloadBackend, config.RootPath, logger),
+		trash:               NewTrash(config.RootPath, logger),
+		readOnly:            NewReadOnlyMode(config.RootPath),
 	}
 	if db.maxNumberGoroutines == 0 {
 		return db, errors.New("no workers to add batch-jobs configured.")
 	}
+	if config.LSMBlockCacheSizeMB > 0 {
+		db.blockCache = lsmkv.NewBlockCache(
+			int64(config.LSMBlockCacheSizeMB)*1024*1024, promMetrics)
+	}
 	db.shutDownWg.Add(db.maxNumberGoroutines)
 	for i := 0; i < db.maxNumberGoroutines; i++ {
 		go db.worker(i == 0)
@@ -136,13 +172,31 @@ type Config struct {
 	MemtablesMaxSizeMB        int
 	MemtablesMinActiveSeconds int
 	MemtablesMaxActiveSeconds int
+	MemtablesMaxAgeSeconds    int
+	MemtablesMaxWALSizeMB     int
 	TrackVectorDimensions     bool
+	HNSWCheckpointInterval    time.Duration
+	AsyncIndexing             config.AsyncIndexing
+	LSMCompactionStrategy     string
+	LSMCompactionSizeRatio    float64
+	WALSyncPolicy             diskio.SyncPolicy
+	LSMBlockCacheSizeMB       int
+	LSMDirectIO               bool
+	LSMScrubInterval          time.Duration
+	OffloadBackend            string
+	LazyLoadShards            bool
 	ServerVersion             string
 	GitHash                   string
 	AvoidMMap                 bool
 	Replication               replication.GlobalConfig
 }
 
+// SetOffloadBackendProvider sets the provider of the backup backend FROZEN
+// shards are offloaded to. Modules are initialized after the DB.
+func (db *DB) SetOffloadBackendProvider(provider OffloadBackendProvider) {
+	db.offloader.SetBackendProvider(provider)
+}
+
 // GetIndex returns the index if it exists or nil if it doesn't
 func (db *DB) GetIndex(className schema.ClassName) *Index {
 	db.indexLock.RLock()
@@ -200,6 +254,9 @@ func (db *DB) DeleteIndex(className schema.ClassName) error {
 		db.logger.WithField("action", "delete_index").WithField("class", className).Error(err)
 	}
 	delete(db.indices, id)
+	if err := db.readOnly.dropClass(className); err != nil {
+		db.logger.WithField("action", "delete_index").WithField("class", className).Error(err)
+	}
 	return nil
 }
 
@@ -213,6 +270,11 @@ func (db *DB) Shutdown(ctx context.Context) error {
 		}
 	}
 
+	if db.warmerCancel != nil {
+		db.warmerCancel()
+		db.warmerWg.Wait()
+	}
+
 	db.indexLock.Lock()
 	defer db.indexLock.Unlock()
 	for id, index := range db.indices {
@@ -222,6 +284,7 @@ func (db *DB) Shutdown(ctx context.Context) error {
 	}
 
 	db.shutDownWg.Wait() // wait until job queue shutdown is completed
+	db.trash.wait()
 
 	return nil
 }
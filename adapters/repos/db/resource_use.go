@@ -54,7 +54,7 @@ func (d *DB) scanResourceUsage() {
 			case <-d.shutdown:
 				return
 			case <-t.C:
-				if !d.resourceScanState.isReadOnly {
+				if !d.readOnly.isAutomatic() {
 					du := d.getDiskUse(d.config.RootPath)
 					d.resourceUseWarn(memMonitor, du)
 					d.resourceUseReadonly(memMonitor, du)
@@ -65,9 +65,8 @@ func (d *DB) scanResourceUsage() {
 }
 
 type resourceScanState struct {
-	disk       *scanState
-	mem        *scanState
-	isReadOnly bool
+	disk *scanState
+	mem  *scanState
 }
 
 type scanState struct {
@@ -164,7 +163,7 @@ func (d *DB) diskUseReadonly(du diskUse) {
 	diskROPercent := d.config.ResourceUsage.DiskUse.ReadOnlyPercentage
 	if diskROPercent > 0 {
 		if pu := du.percentUsed(); pu > float64(diskROPercent) {
-			d.setShardsReadOnly()
+			d.setShardsReadOnly(ReadOnlyReasonDiskUsage)
 			d.logger.WithField("action", "set_shard_read_only").
 				WithField("path", d.config.RootPath).
 				Warnf("Set READONLY, disk usage currently at %.2f%%, threshold set to %.2f%%",
@@ -177,7 +176,7 @@ func (d *DB) memUseReadonly(mon *memwatch.Monitor) {
 	memROPercent := d.config.ResourceUsage.MemUse.ReadOnlyPercentage
 	if memROPercent > 0 {
 		if pu := mon.Ratio() * 100; pu > float64(memROPercent) {
-			d.setShardsReadOnly()
+			d.setShardsReadOnly(ReadOnlyReasonMemoryUsage)
 			d.logger.WithField("action", "set_shard_read_only").
 				WithField("path", d.config.RootPath).
 				Warnf("Set READONLY, memory usage currently at %.2f%%, threshold set to %.2f%%",
@@ -186,10 +185,13 @@ func (d *DB) memUseReadonly(mon *memwatch.Monitor) {
 	}
 }
 
-func (d *DB) setShardsReadOnly() {
+// setShardsReadOnly sets all loaded shards to READONLY and puts the node into
+// read-only mode, so that shards which are loaded later reject writes, too.
+// Both are lifted by lifting the read-only mode of the node.
+func (d *DB) setShardsReadOnly(reason string) {
 	d.indexLock.Lock()
 	for _, index := range d.indices {
-		index.ForEachShard(func(name string, shard *Shard) error {
+		index.ForEachLoadedShard(func(name string, shard *Shard) error {
 			err := shard.updateStatus(storagestate.StatusReadOnly.String())
 			if err != nil {
 				d.logger.WithField("action", "set_shard_read_only").
@@ -201,5 +203,5 @@ func (d *DB) setShardsReadOnly() {
 		})
 	}
 	d.indexLock.Unlock()
-	d.resourceScanState.isReadOnly = true
+	d.readOnly.setAutomatic(reason)
 }
@@ -0,0 +1,185 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+func TestQueryScrollSession(t *testing.T) {
+	dirName := t.TempDir()
+
+	logger, _ := test.NewNullLogger()
+	class := &models.Class{
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		Class:               "ScrollClass",
+		Properties: []*models.Property{
+			{
+				Name:         "name",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationWhitespace,
+			},
+		},
+	}
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer repo.Shutdown(context.Background())
+	migrator := NewMigrator(repo, logger)
+	require.Nil(t, migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+	schemaGetter.schema = schema.Schema{
+		Objects: &models.Schema{Classes: []*models.Class{class}},
+	}
+
+	id := func(i int) strfmt.UUID {
+		return strfmt.UUID(fmt.Sprintf("7c8183ae-150d-433f-92b6-ed095b%06d", i))
+	}
+	put := func(t *testing.T, i int, name string) {
+		require.Nil(t, repo.PutObject(context.Background(), &models.Object{
+			ID:         id(i),
+			Class:      class.Class,
+			Properties: map[string]interface{}{"name": name},
+		}, []float32{1, 2, 3}, nil))
+	}
+	for i := 1; i <= 7; i++ {
+		put(t, i, fmt.Sprintf("original %d", i))
+	}
+
+	query := func(cursor *filters.Cursor) ([]*models.Object, error) {
+		res, err := repo.Query(context.Background(), &objects.QueryInput{
+			Class:      class.Class,
+			Limit:      cursor.Limit,
+			Cursor:     cursor,
+			Additional: additional.Properties{},
+		})
+		if err != nil {
+			return nil, err
+		}
+		return res.Objects(), nil
+	}
+
+	scroll := &filters.Scroll{ID: "export", TTL: time.Minute}
+
+	t.Run("writes after the first page are not visible", func(t *testing.T) {
+		page, err := query(&filters.Cursor{Limit: 3, Scroll: scroll})
+		require.Nil(t, err)
+		require.Len(t, page, 3)
+
+		// update, delete and add objects and flush the memtable into a segment
+		put(t, 5, "updated 5")
+		put(t, 8, "added 8")
+		require.Nil(t, repo.DeleteObject(context.Background(), class.Class, id(6), nil, ""))
+		repo.GetIndex(schema.ClassName(class.Class)).ForEachShard(func(name string, shard *Shard) error {
+			require.Nil(t, shard.store.Bucket(helpers.ObjectsBucketLSM).FlushAndSwitch())
+			return nil
+		})
+
+		all := page
+		for len(page) > 0 {
+			page, err = query(&filters.Cursor{
+				After: page[len(page)-1].ID.String(), Limit: 3, Scroll: scroll,
+			})
+			require.Nil(t, err)
+			all = append(all, page...)
+		}
+
+		require.Len(t, all, 7)
+		for i, obj := range all {
+			assert.Equal(t, id(i+1), obj.ID)
+			assert.Equal(t, fmt.Sprintf("original %d", i+1),
+				obj.Properties.(map[string]interface{})["name"])
+		}
+	})
+
+	t.Run("a cursor without scroll session sees the writes", func(t *testing.T) {
+		res, err := query(&filters.Cursor{Limit: 10})
+		require.Nil(t, err)
+		require.Len(t, res, 7)
+		assert.Equal(t, "updated 5", res[4].Properties.(map[string]interface{})["name"])
+		assert.Equal(t, id(8), res[6].ID)
+	})
+
+	t.Run("unknown scroll session", func(t *testing.T) {
+		_, err := query(&filters.Cursor{
+			After: id(3).String(), Limit: 3,
+			Scroll: &filters.Scroll{ID: "unknown", TTL: time.Minute},
+		})
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), `scroll session "unknown" not found`)
+	})
+
+	t.Run("expired scroll session", func(t *testing.T) {
+		expiring := &filters.Scroll{ID: "expiring", TTL: 50 * time.Millisecond}
+		page, err := query(&filters.Cursor{Limit: 3, Scroll: expiring})
+		require.Nil(t, err)
+		require.Len(t, page, 3)
+
+		time.Sleep(200 * time.Millisecond)
+		_, err = query(&filters.Cursor{
+			After: page[2].ID.String(), Limit: 3, Scroll: expiring,
+		})
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "it may have expired")
+	})
+
+	t.Run("too many open scroll sessions", func(t *testing.T) {
+		// the export session is still open
+		for i := 1; i < filters.MaxScrollSessions; i++ {
+			_, err := query(&filters.Cursor{
+				Limit: 1, Scroll: &filters.Scroll{ID: fmt.Sprintf("session-%d", i), TTL: time.Minute},
+			})
+			require.Nil(t, err)
+		}
+
+		_, err := query(&filters.Cursor{
+			Limit: 1, Scroll: &filters.Scroll{ID: "one-too-many", TTL: time.Minute},
+		})
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "open scroll sessions already")
+
+		// open sessions can still be continued
+		_, err = query(&filters.Cursor{After: id(3).String(), Limit: 3, Scroll: scroll})
+		require.Nil(t, err)
+	})
+
+	t.Run("invalid scroll duration", func(t *testing.T) {
+		_, err := query(&filters.Cursor{
+			Limit: 3, Scroll: &filters.Scroll{ID: "long", TTL: 2 * filters.MaxScrollTTL},
+		})
+		require.NotNil(t, err)
+	})
+}
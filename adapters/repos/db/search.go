@@ -17,12 +17,14 @@ import (
 	"sort"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/adapters/repos/db/refcache"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/aggregation"
 	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/explain"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/search"
@@ -92,6 +94,44 @@ func (db *DB) Search(ctx context.Context, params dto.GetParams) ([]search.Result
 		params.Properties, params.GroupBy, params.AdditionalProperties, params.Tenant)
 }
 
+// SearchStream runs an unordered list query shard by shard and calls fn with
+// the results of every shard, references already resolved. It must only be
+// used for queries without any ranking or sorting, as results of different
+// shards are not merged. Returning an error from fn stops the search.
+func (db *DB) SearchStream(ctx context.Context, params dto.GetParams,
+	fn func(res search.Results) error,
+) error {
+	idx := db.GetIndex(schema.ClassName(params.ClassName))
+	if idx == nil {
+		return fmt.Errorf("tried to browse non-existing index for %s", params.ClassName)
+	}
+
+	if params.Pagination == nil {
+		return fmt.Errorf("invalid params, pagination object is nil")
+	}
+
+	totalLimit, err := db.getTotalLimit(params.Pagination, params.AdditionalProperties)
+	if err != nil {
+		return errors.Wrapf(err, "invalid pagination params")
+	}
+
+	err = idx.objectSearchStream(ctx, totalLimit, params.Filters, params.AdditionalProperties,
+		params.ReplicationProperties, params.Tenant, func(objs []*storobj.Object) error {
+			res, err := db.ResolveReferences(ctx,
+				storobj.SearchResults(objs, params.AdditionalProperties, params.Tenant),
+				params.Properties, nil, params.AdditionalProperties, params.Tenant)
+			if err != nil {
+				return err
+			}
+			return fn(res)
+		})
+	if err != nil {
+		return errors.Wrapf(err, "object search stream at index %s", idx.ID())
+	}
+
+	return nil
+}
+
 func (db *DB) VectorSearch(ctx context.Context,
 	params dto.GetParams,
 ) ([]search.Result, error) {
@@ -111,8 +151,8 @@ func (db *DB) VectorSearch(ctx context.Context,
 
 	targetDist := extractDistanceFromParams(params)
 	res, dists, err := idx.objectVectorSearch(ctx, params.SearchVector,
-		targetDist, totalLimit, params.Filters, params.Sort, params.GroupBy,
-		params.AdditionalProperties, params.ReplicationProperties, params.Tenant)
+		params.TargetVector, targetDist, totalLimit, params.Filters, params.Sort, params.GroupBy,
+		params.Rescore, params.Exact, params.AdditionalProperties, params.ReplicationProperties, params.Tenant)
 	if err != nil {
 		return nil, errors.Wrapf(err, "object vector search at index %s", idx.ID())
 	}
@@ -143,8 +183,8 @@ func extractDistanceFromParams(params dto.GetParams) float32 {
 // Class VectorSearch method fit this need. Later on, other use cases presented the need
 // for the raw storage objects, such as hybrid search.
 func (db *DB) DenseObjectSearch(ctx context.Context, class string, vector []float32,
-	offset int, limit int, filters *filters.LocalFilter, addl additional.Properties,
-	tenant string,
+	offset int, limit int, filters *filters.LocalFilter, exact bool,
+	addl additional.Properties, tenant string,
 ) ([]*storobj.Object, []float32, error) {
 	totalLimit := offset + limit
 
@@ -154,8 +194,8 @@ func (db *DB) DenseObjectSearch(ctx context.Context, class string, vector []floa
 	}
 
 	// TODO: groupBy think of this
-	objs, dist, err := index.objectVectorSearch(ctx, vector, 0,
-		totalLimit, filters, nil, nil, addl, nil, tenant)
+	objs, dist, err := index.objectVectorSearch(ctx, vector, "", 0,
+		totalLimit, filters, nil, nil, nil, exact, addl, nil, tenant)
 	if err != nil {
 		return nil, nil, fmt.Errorf("search index %s: %w", index.ID(), err)
 	}
@@ -180,7 +220,7 @@ func (db *DB) CrossClassVectorSearch(ctx context.Context, vector []float32, offs
 			defer wg.Done()
 
 			objs, dist, err := index.objectVectorSearch(ctx, vector,
-				0, totalLimit, filters, nil, nil,
+				"", 0, totalLimit, filters, nil, nil, nil, false,
 				additional.Properties{}, nil, "")
 			if err != nil {
 				mutex.Lock()
@@ -328,6 +368,21 @@ func (db *DB) ResolveReferences(ctx context.Context, objs search.Results,
 		return objs, nil
 	}
 
+	before := time.Now()
+	defer explain.FromContext(ctx).Track(explain.StageRefResolution, before, len(objs))
+
+	if addl.NoRefResolution {
+		resolver := refcache.NewShallowResolver()
+		if groupBy != nil {
+			resolver = refcache.NewShallowResolverWithGroup()
+		}
+		res, err := resolver.Do(ctx, objs, props, addl)
+		if err != nil {
+			return nil, fmt.Errorf("resolve cross-refs: %w", err)
+		}
+		return res, nil
+	}
+
 	if groupBy != nil {
 		res, err := refcache.NewResolverWithGroup(refcache.NewCacherWithGroup(db, db.logger, tenant)).
 			Do(ctx, objs, props, addl)
@@ -0,0 +1,146 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestSearchStream(t *testing.T) {
+	dirName := t.TempDir()
+
+	logger, _ := test.NewNullLogger()
+	class := &models.Class{
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		Class:               "StreamClass",
+		Properties: []*models.Property{
+			{
+				Name:     "count",
+				DataType: schema.DataTypeInt.PropString(),
+			},
+		},
+	}
+	schemaGetter := &fakeSchemaGetter{shardState: multiShardState()}
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       100,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer repo.Shutdown(context.Background())
+	migrator := NewMigrator(repo, logger)
+	require.Nil(t, migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+	schemaGetter.schema = schema.Schema{
+		Objects: &models.Schema{Classes: []*models.Class{class}},
+	}
+
+	for i := 0; i < 30; i++ {
+		require.Nil(t, repo.PutObject(context.Background(), &models.Object{
+			ID:         strfmt.UUID(fmt.Sprintf("a1c1d6f4-2c5a-4c8e-9d4b-%012d", i)),
+			Class:      class.Class,
+			Properties: map[string]interface{}{"count": int64(i)},
+		}, []float32{1, 2, 3}, nil))
+	}
+
+	stream := func(t *testing.T, params dto.GetParams) ([]search.Results, error) {
+		var batches []search.Results
+		err := repo.SearchStream(context.Background(), params, func(res search.Results) error {
+			batches = append(batches, res)
+			return nil
+		})
+		return batches, err
+	}
+
+	t.Run("all results, one batch per shard", func(t *testing.T) {
+		batches, err := stream(t, dto.GetParams{
+			ClassName:  class.Class,
+			Pagination: &filters.Pagination{Limit: 100},
+		})
+		require.Nil(t, err)
+		assert.Greater(t, len(batches), 1)
+		assert.LessOrEqual(t, len(batches), 3)
+
+		ids := map[strfmt.UUID]struct{}{}
+		for _, batch := range batches {
+			for _, res := range batch {
+				ids[res.ID] = struct{}{}
+			}
+		}
+		assert.Len(t, ids, 30)
+	})
+
+	t.Run("limit across shards", func(t *testing.T) {
+		batches, err := stream(t, dto.GetParams{
+			ClassName:  class.Class,
+			Pagination: &filters.Pagination{Limit: 15},
+		})
+		require.Nil(t, err)
+
+		count := 0
+		for _, batch := range batches {
+			count += len(batch)
+		}
+		assert.Equal(t, 15, count)
+	})
+
+	t.Run("with filter", func(t *testing.T) {
+		batches, err := stream(t, dto.GetParams{
+			ClassName:  class.Class,
+			Pagination: &filters.Pagination{Limit: 100},
+			Filters:    buildFilter("count", 20, lt, dtInt),
+		})
+		require.Nil(t, err)
+
+		count := 0
+		for _, batch := range batches {
+			for _, res := range batch {
+				assert.Less(t, res.Schema.(map[string]interface{})["count"], float64(20))
+			}
+			count += len(batch)
+		}
+		assert.Equal(t, 20, count)
+	})
+
+	t.Run("error returned by fn stops the search", func(t *testing.T) {
+		calls := 0
+		stop := errors.New("stop")
+		err := repo.SearchStream(context.Background(), dto.GetParams{
+			ClassName:  class.Class,
+			Pagination: &filters.Pagination{Limit: 100},
+		}, func(res search.Results) error {
+			calls++
+			return stop
+		})
+		assert.ErrorIs(t, err, stop)
+		assert.Equal(t, 1, calls)
+	})
+}
@@ -14,28 +14,34 @@ package db
 import (
 	"context"
 	"fmt"
-	"os"
 	"path"
 	"sync"
 	"time"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/blobstore"
 	"github.com/weaviate/weaviate/adapters/repos/db/docid"
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/indexcounter"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
 	"github.com/weaviate/weaviate/adapters/repos/db/propertyspecific"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/dynamic"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/flat"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/noop"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/vamana"
 	"github.com/weaviate/weaviate/entities/cyclemanager"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/storagestate"
+	dynamicent "github.com/weaviate/weaviate/entities/vectorindex/dynamic"
+	flatent "github.com/weaviate/weaviate/entities/vectorindex/flat"
 	hnswent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	vamanaent "github.com/weaviate/weaviate/entities/vectorindex/vamana"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 	"golang.org/x/sync/errgroup"
 )
@@ -46,21 +52,27 @@ const IdLockPoolSize = 128
 // database files for all the objects it owns. How a shard is determined for a
 // target object (e.g. Murmur hash, etc.) is still open at this point
 type Shard struct {
-	index           *Index // a reference to the underlying index, which in turn contains schema information
-	name            string
-	store           *lsmkv.Store
-	counter         *indexcounter.Counter
-	vectorIndex     VectorIndex
-	metrics         *Metrics
-	promMetrics     *monitoring.PrometheusMetrics
-	propertyIndices propertyspecific.Indices
-	deletedDocIDs   *docid.InMemDeletedTracker
-	propLengths     *inverted.JsonPropertyLengthTracker
-	versioner       *shardVersioner
+	index       *Index // a reference to the underlying index, which in turn contains schema information
+	name        string
+	store       *lsmkv.Store
+	counter     *indexcounter.Counter
+	vectorIndex VectorIndex
+	// vector indexes of the named vector spaces of the class
+	targetVectorIndexes map[string]VectorIndex
+	metrics             *Metrics
+	promMetrics         *monitoring.PrometheusMetrics
+	propertyIndices     propertyspecific.Indices
+	deletedDocIDs       *docid.InMemDeletedTracker
+	propLengths         *inverted.JsonPropertyLengthTracker
+	versioner           *shardVersioner
+	quotaUsage          quotaUsageCache
+	roaringSetMigration roaringSetMigration
 
 	status              storagestate.Status
 	statusLock          sync.Mutex
 	propertyIndicesLock sync.RWMutex
+	blobs               *blobstore.Store
+	blobsLock           sync.Mutex
 	stopMetrics         chan struct{}
 
 	centralJobQueue chan job // reference to queue used by all shards
@@ -81,6 +93,25 @@ type Shard struct {
 	fallbackToSearchable bool
 
 	cycleCallbacks *shardCycleCallbacks
+
+	// results of the latest vector index integrity check
+	integrity     []*models.VectorIndexIntegrity
+	integrityLock sync.Mutex
+
+	// queues of the vector indexes by target vector if async indexing is
+	// enabled, the class-level vector index is keyed by ""
+	indexQueues map[string]*IndexQueue
+
+	scrolls scrollSessions
+
+	// number of blockWrites which have not been released yet and the status
+	// they restore, guarded by statusLock
+	writeBlocks             int
+	statusBeforeWriteBlocks storagestate.Status
+
+	// previous names of renamed properties, as long as the stored objects
+	// may still contain them
+	renamedProperties renamedProperties
 }
 
 func NewShard(ctx context.Context, promMetrics *monitoring.PrometheusMetrics,
@@ -105,76 +136,213 @@ func NewShard(ctx context.Context, promMetrics *monitoring.PrometheusMetrics,
 
 	defer s.metrics.ShardStartup(before)
 
-	hnswUserConfig, ok := index.vectorIndexUserConfig.(hnswent.UserConfig)
-	if !ok {
-		return nil, errors.Errorf("hnsw vector index: config is not hnsw.UserConfig: %T",
-			index.vectorIndexUserConfig)
+	if err := s.initVectorIndex(ctx, index.vectorIndexUserConfig); err != nil {
+		return nil, fmt.Errorf("init vector index: %w", err)
 	}
+	defer s.vectorIndex.PostStartup()
 
-	if hnswUserConfig.Skip {
-		s.vectorIndex = noop.NewIndex()
-	} else {
-		if err := s.initVectorIndex(ctx, hnswUserConfig); err != nil {
-			return nil, fmt.Errorf("init vector index: %w", err)
-		}
-
-		defer s.vectorIndex.PostStartup()
+	postStartupTargetVectors, err := s.initTargetVectors(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("init target vectors: %w", err)
 	}
+	defer postStartupTargetVectors()
 
 	if err := s.initNonVector(ctx, class); err != nil {
 		return nil, errors.Wrapf(err, "init shard %q", s.ID())
 	}
 
+	if err := s.initIndexQueues(ctx); err != nil {
+		return nil, errors.Wrapf(err, "init shard %q: index queues", s.ID())
+	}
+
+	if err := s.cycleCallbacks.objectTTLCallbacksCtrl.Activate(); err != nil {
+		return nil, errors.Wrapf(err, "init shard %q: activate object ttl", s.ID())
+	}
+
 	return s, nil
 }
 
 func (s *Shard) initVectorIndex(
-	ctx context.Context, hnswUserConfig hnswent.UserConfig,
+	ctx context.Context, vectorIndexUserConfig schema.VectorIndexConfig,
 ) error {
-	var distProv distancer.Provider
+	vi, err := s.newVectorIndex(ctx, s.ID(), vectorIndexUserConfig,
+		s.vectorByIndexID, s.readVectorByIndexIDIntoSlice)
+	if err != nil {
+		return err
+	}
+	s.vectorIndex = vi
+
+	return nil
+}
 
-	switch hnswUserConfig.Distance {
+// newVectorIndex creates a vector index of the configured type with the given
+// id, which determines where the index is persisted. The thunks read the
+// vectors of the index from the objects bucket, they are only used by hnsw as
+// vamana and flat keep their own copy of the vectors on disk.
+func (s *Shard) newVectorIndex(ctx context.Context, id string,
+	vectorIndexUserConfig schema.VectorIndexConfig, vectorForID hnsw.VectorForID,
+	tempVectorForID hnsw.TempVectorForID,
+) (VectorIndex, error) {
+	switch userConfig := vectorIndexUserConfig.(type) {
+	case hnswent.UserConfig:
+		if userConfig.Skip {
+			return noop.NewIndex(), nil
+		}
+		return s.newHnswIndex(ctx, id, userConfig, vectorForID, tempVectorForID)
+	case vamanaent.UserConfig:
+		if userConfig.Skip {
+			return noop.NewIndex(), nil
+		}
+		return s.newVamanaIndex(ctx, id, userConfig)
+	case flatent.UserConfig:
+		if userConfig.Skip {
+			return noop.NewIndex(), nil
+		}
+		return s.newFlatIndex(ctx, id, userConfig)
+	case dynamicent.UserConfig:
+		return s.newDynamicIndex(ctx, id, userConfig, vectorForID, tempVectorForID)
+	default:
+		return nil, errors.Errorf("unsupported vector index config: %T", vectorIndexUserConfig)
+	}
+}
+
+func distancerProviderFromName(distance string) (distancer.Provider, error) {
+	switch distance {
 	case "", hnswent.DistanceCosine:
-		distProv = distancer.NewCosineDistanceProvider()
+		return distancer.NewCosineDistanceProvider(), nil
 	case hnswent.DistanceDot:
-		distProv = distancer.NewDotProductProvider()
+		return distancer.NewDotProductProvider(), nil
 	case hnswent.DistanceL2Squared:
-		distProv = distancer.NewL2SquaredProvider()
+		return distancer.NewL2SquaredProvider(), nil
 	case hnswent.DistanceManhattan:
-		distProv = distancer.NewManhattanProvider()
+		return distancer.NewManhattanProvider(), nil
 	case hnswent.DistanceHamming:
-		distProv = distancer.NewHammingProvider()
+		return distancer.NewHammingProvider(), nil
+	case hnswent.DistanceJaccard:
+		return distancer.NewJaccardProvider(), nil
 	default:
-		return errors.Errorf("unrecognized distance metric %q,"+
-			"choose one of [\"cosine\", \"dot\", \"l2-squared\", \"manhattan\",\"hamming\"]", hnswUserConfig.Distance)
+		return nil, errors.Errorf("unrecognized distance metric %q,"+
+			"choose one of [\"cosine\", \"dot\", \"l2-squared\", \"manhattan\",\"hamming\",\"jaccard\"]", distance)
+	}
+}
+
+func (s *Shard) newHnswIndex(ctx context.Context, id string,
+	hnswUserConfig hnswent.UserConfig, vectorForID hnsw.VectorForID,
+	tempVectorForID hnsw.TempVectorForID,
+) (VectorIndex, error) {
+	distProv, err := distancerProviderFromName(hnswUserConfig.Distance)
+	if err != nil {
+		return nil, err
 	}
 
 	// starts vector cycles if vector is configured
 	s.index.cycleCallbacks.vectorCommitLoggerCycle.Start()
-	s.index.cycleCallbacks.vectorTombstoneCleanupCycle.Start()
+	s.index.cycleCallbacks.startVectorTombstoneCleanupCycle()
 
 	vi, err := hnsw.New(hnsw.Config{
 		Logger:               s.index.logger,
 		RootPath:             s.index.Config.RootPath,
-		ID:                   s.ID(),
+		ID:                   id,
 		ShardName:            s.name,
 		ClassName:            s.index.Config.ClassName.String(),
 		PrometheusMetrics:    s.promMetrics,
-		VectorForIDThunk:     s.vectorByIndexID,
-		TempVectorForIDThunk: s.readVectorByIndexIDIntoSlice,
+		VectorForIDThunk:     vectorForID,
+		TempVectorForIDThunk: tempVectorForID,
 		DistanceProvider:     distProv,
 		MakeCommitLoggerThunk: func() (hnsw.CommitLogger, error) {
-			return hnsw.NewCommitLogger(s.index.Config.RootPath, s.ID(),
-				s.index.logger, s.cycleCallbacks.vectorCommitLoggerCallbacks)
+			return hnsw.NewCommitLogger(s.index.Config.RootPath, id,
+				s.index.logger, s.cycleCallbacks.vectorCommitLoggerCallbacks,
+				hnsw.WithCheckpointInterval(s.index.Config.HNSWCheckpointInterval),
+				hnsw.WithSyncPolicy(s.index.Config.WALSyncPolicy))
 		},
 	}, hnswUserConfig,
 		s.cycleCallbacks.vectorTombstoneCleanupCallbacks, s.cycleCallbacks.compactionCallbacks, s.cycleCallbacks.flushCallbacks)
 	if err != nil {
-		return errors.Wrapf(err, "init shard %q: hnsw index", s.ID())
+		return nil, errors.Wrapf(err, "init shard %q: hnsw index", s.ID())
 	}
-	s.vectorIndex = vi
 
-	return nil
+	return vi, nil
+}
+
+func (s *Shard) newVamanaIndex(ctx context.Context, id string,
+	vamanaUserConfig vamanaent.UserConfig,
+) (VectorIndex, error) {
+	distProv, err := distancerProviderFromName(vamanaUserConfig.Distance)
+	if err != nil {
+		return nil, err
+	}
+
+	// vamana has no commit log, only the tombstone cleanup cycle is needed
+	s.index.cycleCallbacks.startVectorTombstoneCleanupCycle()
+
+	vi, err := vamana.New(vamana.Config{
+		Logger:           s.index.logger,
+		RootPath:         s.index.Config.RootPath,
+		ID:               id,
+		ShardName:        s.name,
+		ClassName:        s.index.Config.ClassName.String(),
+		DistanceProvider: distProv,
+	}, vamanaUserConfig, s.cycleCallbacks.vectorTombstoneCleanupCallbacks)
+	if err != nil {
+		return nil, errors.Wrapf(err, "init shard %q: vamana index", s.ID())
+	}
+
+	return vi, nil
+}
+
+func (s *Shard) newFlatIndex(ctx context.Context, id string,
+	flatUserConfig flatent.UserConfig,
+) (VectorIndex, error) {
+	distProv, err := distancerProviderFromName(flatUserConfig.Distance)
+	if err != nil {
+		return nil, err
+	}
+
+	// flat deletes right away and stores its vectors in an lsmkv store, so
+	// neither the commit log nor the tombstone cleanup cycle is needed
+	vi, err := flat.New(flat.Config{
+		Logger:           s.index.logger,
+		RootPath:         s.index.Config.RootPath,
+		ID:               id,
+		ShardName:        s.name,
+		ClassName:        s.index.Config.ClassName.String(),
+		DistanceProvider: distProv,
+	}, flatUserConfig, s.cycleCallbacks.compactionCallbacks, s.cycleCallbacks.flushCallbacks)
+	if err != nil {
+		return nil, errors.Wrapf(err, "init shard %q: flat index", s.ID())
+	}
+
+	return vi, nil
+}
+
+// newDynamicIndex creates a dynamic index whose flat and hnsw indexes share
+// its id, their files do not overlap
+func (s *Shard) newDynamicIndex(ctx context.Context, id string,
+	dynamicUserConfig dynamicent.UserConfig, vectorForID hnsw.VectorForID,
+	tempVectorForID hnsw.TempVectorForID,
+) (VectorIndex, error) {
+	vi, err := dynamic.New(dynamic.Config{
+		Logger:    s.index.logger,
+		RootPath:  s.index.Config.RootPath,
+		ID:        id,
+		ShardName: s.name,
+		ClassName: s.index.Config.ClassName.String(),
+		MakeFlat: func(uc flatent.UserConfig) (dynamic.FlatIndex, error) {
+			vi, err := s.newFlatIndex(ctx, id, uc)
+			if err != nil {
+				return nil, err
+			}
+			return vi.(dynamic.FlatIndex), nil
+		},
+		MakeHNSW: func(uc hnswent.UserConfig) (dynamic.VectorIndex, error) {
+			return s.newHnswIndex(ctx, id, uc, vectorForID, tempVectorForID)
+		},
+	}, dynamicUserConfig)
+	if err != nil {
+		return nil, errors.Wrapf(err, "init shard %q: dynamic index", s.ID())
+	}
+
+	return vi, nil
 }
 
 func (s *Shard) initNonVector(ctx context.Context, class *models.Class) error {
@@ -217,6 +385,17 @@ func (s *Shard) ID() string {
 	return fmt.Sprintf("%s_%s", s.index.ID(), s.name)
 }
 
+// class returns the current definition of the shard's class, or nil if it
+// cannot be found in the schema
+func (s *Shard) class() *models.Class {
+	class, err := schema.GetClassByName(s.index.getSchema.GetSchemaSkipAuth().Objects,
+		s.index.Config.ClassName.String())
+	if err != nil {
+		return nil
+	}
+	return class
+}
+
 func (s *Shard) DBPathLSM() string {
 	return fmt.Sprintf("%s/%s_lsm", s.index.Config.RootPath, s.ID())
 }
@@ -243,14 +422,21 @@ func (s *Shard) initLSMStore(ctx context.Context) error {
 	if err != nil {
 		return errors.Wrapf(err, "init lsmkv store at %s", s.DBPathLSM())
 	}
+	store.SetFlushThresholds(s.index.flushThresholds())
+	store.SetBlockCache(s.index.Config.BlockCache)
+	store.SetDirectIO(s.index.Config.LSMDirectIO)
+	store.SetScrubInterval(s.index.Config.LSMScrubInterval)
 
 	err = store.CreateOrLoadBucket(ctx, helpers.ObjectsBucketLSM,
 		lsmkv.WithStrategy(lsmkv.StrategyReplace),
 		lsmkv.WithSecondaryIndices(1),
 		lsmkv.WithMonitorCount(),
 		lsmkv.WithPread(s.index.Config.AvoidMMap),
+		lsmkv.WithCompression(s.index.invertedIndexConfig.Compression),
 		s.dynamicMemtableSizing(),
 		s.memtableIdleConfig(),
+		s.compactionConfig(),
+		lsmkv.WithWALSyncPolicy(s.index.Config.WALSyncPolicy),
 	)
 	if err != nil {
 		return errors.Wrap(err, "create objects bucket")
@@ -261,72 +447,36 @@ func (s *Shard) initLSMStore(ctx context.Context) error {
 	return nil
 }
 
+// drop shuts the shard down and moves its files to the trash, see Trash
 func (s *Shard) drop() error {
 	s.replicationMap.clear()
 
-	if s.index.Config.TrackVectorDimensions {
-		// tracking vector dimensions goroutine only works when tracking is enabled
-		// that's why we are trying to stop it only in this case
-		s.stopMetrics <- struct{}{}
-		if s.promMetrics != nil {
-			// send 0 in when index gets dropped
-			s.sendVectorDimensionsMetric(0)
-		}
-	}
-
 	ctx, cancel := context.WithTimeout(context.TODO(), 5*time.Second)
 	defer cancel()
 
-	// unregister all callbacks at once, in parallel
-	if err := cyclemanager.NewCombinedCallbackCtrl(0,
-		s.cycleCallbacks.compactionCallbacksCtrl,
-		s.cycleCallbacks.flushCallbacksCtrl,
-		s.cycleCallbacks.vectorCombinedCallbacksCtrl,
-		s.cycleCallbacks.geoPropsCombinedCallbacksCtrl,
-	).Unregister(ctx); err != nil {
+	if err := s.shutdown(ctx); err != nil {
 		return err
 	}
 
-	if err := s.store.Shutdown(ctx); err != nil {
-		return errors.Wrap(err, "stop lsmkv store")
+	if s.index.Config.TrackVectorDimensions && s.promMetrics != nil {
+		// send 0 in when index gets dropped
+		s.sendVectorDimensionsMetric(0)
 	}
 
-	if _, err := os.Stat(s.DBPathLSM()); err == nil {
-		err := os.RemoveAll(s.DBPathLSM())
-		if err != nil {
-			return errors.Wrapf(err, "remove lsm store at %s", s.DBPathLSM())
+	s.propertyIndicesLock.Lock()
+	for propName, index := range s.propertyIndices {
+		if index.GeoIndex == nil {
+			continue
+		}
+		if err := index.GeoIndex.Shutdown(ctx); err != nil {
+			s.propertyIndicesLock.Unlock()
+			return errors.Wrapf(err, "shut down geo index of property %s", propName)
 		}
 	}
-	// delete indexcount
-	err := s.counter.Drop()
-	if err != nil {
-		return errors.Wrapf(err, "remove indexcount at %s", s.DBPathLSM())
-	}
-
-	// delete indexcount
-	err = s.versioner.Drop()
-	if err != nil {
-		return errors.Wrapf(err, "remove indexcount at %s", s.DBPathLSM())
-	}
-	// remove vector index
-	err = s.vectorIndex.Drop(ctx)
-	if err != nil {
-		return errors.Wrapf(err, "remove vector index at %s", s.DBPathLSM())
-	}
-
-	// delete indexcount
-	err = s.propLengths.Drop()
-	if err != nil {
-		return errors.Wrapf(err, "remove prop length tracker at %s", s.DBPathLSM())
-	}
-
-	// TODO: can we remove this?
-	s.deletedDocIDs.BulkRemove(s.deletedDocIDs.GetAll())
-	s.propertyIndicesLock.Lock()
-	err = s.propertyIndices.DropAll(ctx)
 	s.propertyIndicesLock.Unlock()
-	if err != nil {
-		return errors.Wrapf(err, "remove property specific indices at %s", s.DBPathLSM())
+
+	if err := s.index.dropShardFiles(s.name); err != nil {
+		return errors.Wrapf(err, "remove files of shard %s", s.ID())
 	}
 
 	return nil
@@ -340,8 +490,9 @@ func (s *Shard) addIDProperty(ctx context.Context) error {
 	return s.store.CreateOrLoadBucket(ctx,
 		helpers.BucketFromPropNameLSM(filters.InternalPropID),
 		lsmkv.WithIdleThreshold(time.Duration(s.index.Config.MemtablesFlushIdleAfter)*time.Second),
-		lsmkv.WithStrategy(lsmkv.StrategySetCollection),
-		lsmkv.WithPread(s.index.Config.AvoidMMap))
+		lsmkv.WithStrategy(lsmkv.StrategyRoaringSet),
+		lsmkv.WithPread(s.index.Config.AvoidMMap),
+		lsmkv.WithCompression(s.index.invertedIndexConfig.Compression))
 }
 
 func (s *Shard) addDimensionsProperty(ctx context.Context) error {
@@ -374,6 +525,21 @@ func (s *Shard) addTimestampProperties(ctx context.Context) error {
 		return err
 	}
 
+	if s.index.invertedIndexConfig.IndexTimestampRangeFilters {
+		for _, propName := range []string{
+			filters.InternalPropCreationTimeUnix,
+			filters.InternalPropLastUpdateTimeUnix,
+		} {
+			if err := s.store.CreateOrLoadBucket(ctx,
+				helpers.BucketRangeableFromPropNameLSM(propName),
+				s.memtableIdleConfig(),
+				lsmkv.WithStrategy(lsmkv.StrategyRoaringSet),
+				lsmkv.WithPread(s.index.Config.AvoidMMap)); err != nil {
+				return err
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -398,6 +564,21 @@ func (s *Shard) memtableIdleConfig() lsmkv.BucketOption {
 		time.Duration(s.index.Config.MemtablesFlushIdleAfter) * time.Second)
 }
 
+func (s *Shard) compactionConfig() lsmkv.BucketOption {
+	strategy := lsmkv.WithCompactionStrategy(s.index.Config.LSMCompactionStrategy)
+	if s.index.Config.LSMCompactionSizeRatio == 0 {
+		return strategy
+	}
+
+	sizeRatio := lsmkv.WithCompactionSizeRatio(s.index.Config.LSMCompactionSizeRatio)
+	return func(b *lsmkv.Bucket) error {
+		if err := strategy(b); err != nil {
+			return err
+		}
+		return sizeRatio(b)
+	}
+}
+
 func (s *Shard) dynamicMemtableSizing() lsmkv.BucketOption {
 	return lsmkv.WithDynamicMemtableSizing(
 		s.index.Config.MemtablesInitialSizeMB,
@@ -408,7 +589,31 @@ func (s *Shard) dynamicMemtableSizing() lsmkv.BucketOption {
 }
 
 func (s *Shard) createPropertyIndex(ctx context.Context, prop *models.Property, eg *errgroup.Group) {
-	if !inverted.HasInvertedIndex(prop) {
+	if isBlobProp(prop) {
+		eg.Go(func() error {
+			return errors.Wrapf(s.initBlobStore(ctx), "create blob store on shard '%s'", s.ID())
+		})
+	}
+
+	if inverted.HasSparseVectorIndex(prop) {
+		eg.Go(func() error {
+			if err := s.createPropertySparseVectorIndex(ctx, prop); err != nil {
+				return errors.Wrapf(err, "create property '%s' sparse vector index on shard '%s'", prop.Name, s.ID())
+			}
+			return nil
+		})
+		return
+	}
+
+	if !inverted.HasInvertedIndex(prop) && !inverted.HasRangeableIndex(prop) {
+		return
+	}
+
+	if schema.IsNestedDataType(prop.DataType) {
+		// the nested object itself has no index, only its leaves
+		for _, leaf := range schema.NestedLeafProperties(prop) {
+			s.createPropertyIndex(ctx, leaf, eg)
+		}
 		return
 	}
 
@@ -448,6 +653,18 @@ func (s *Shard) createPropertyValueIndex(ctx context.Context, prop *models.Prope
 		s.memtableIdleConfig(),
 		s.dynamicMemtableSizing(),
 		lsmkv.WithPread(s.index.Config.AvoidMMap),
+		lsmkv.WithCompression(s.index.invertedIndexConfig.Compression),
+		s.compactionConfig(),
+		lsmkv.WithWALSyncPolicy(s.index.Config.WALSyncPolicy),
+	}
+
+	switch dt, _ := schema.AsPrimitive(prop.DataType); dt {
+	case schema.DataTypeText, schema.DataTypeTextArray,
+		schema.DataTypeString, schema.DataTypeStringArray:
+		// like and fuzzy filters as well as fuzzy bm25 queries look up the
+		// matching terms in a term dictionary instead of scanning all terms of
+		// the property
+		bucketOpts = append(bucketOpts, lsmkv.WithTermDictionary(true))
 	}
 
 	if inverted.HasFilterableIndex(prop) {
@@ -487,9 +704,44 @@ func (s *Shard) createPropertyValueIndex(ctx context.Context, prop *models.Prope
 		}
 	}
 
+	if inverted.HasRangeableIndex(prop) {
+		if err := s.store.CreateOrLoadBucket(ctx,
+			helpers.BucketRangeableFromPropNameLSM(prop.Name),
+			append(bucketOpts, lsmkv.WithStrategy(lsmkv.StrategyRoaringSet))...,
+		); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
+// createPropertySparseVectorIndex creates the bucket of a sparseVector prop,
+// which maps every dimension to the doc ids with a value in it
+func (s *Shard) createPropertySparseVectorIndex(ctx context.Context, prop *models.Property) error {
+	if s.isReadOnly() {
+		return storagestate.ErrStatusReadOnly
+	}
+
+	bucketOpts := []lsmkv.BucketOption{
+		s.memtableIdleConfig(),
+		s.dynamicMemtableSizing(),
+		lsmkv.WithPread(s.index.Config.AvoidMMap),
+		lsmkv.WithCompression(s.index.invertedIndexConfig.Compression),
+		s.compactionConfig(),
+		lsmkv.WithWALSyncPolicy(s.index.Config.WALSyncPolicy),
+		lsmkv.WithStrategy(lsmkv.StrategyMapCollection),
+	}
+	if s.versioner.Version() < 2 {
+		bucketOpts = append(bucketOpts, lsmkv.WithLegacyMapSorting())
+	}
+
+	return s.store.CreateOrLoadBucket(ctx,
+		helpers.BucketSparseVectorFromPropNameLSM(prop.Name),
+		bucketOpts...,
+	)
+}
+
 func (s *Shard) createPropertyLengthIndex(ctx context.Context, prop *models.Property) error {
 	if s.isReadOnly() {
 		return storagestate.ErrStatusReadOnly
@@ -498,7 +750,9 @@ func (s *Shard) createPropertyLengthIndex(ctx context.Context, prop *models.Prop
 	// some datatypes are not added to the inverted index, so we can skip them here
 	switch schema.DataType(prop.DataType[0]) {
 	case schema.DataTypeGeoCoordinates, schema.DataTypePhoneNumber, schema.DataTypeBlob, schema.DataTypeInt,
-		schema.DataTypeNumber, schema.DataTypeBoolean, schema.DataTypeDate:
+		schema.DataTypeNumber, schema.DataTypeBoolean, schema.DataTypeDate, schema.DataTypeDuration,
+		schema.DataTypeIP, schema.DataTypeDecimal, schema.DataTypeGeoPolygon, schema.DataTypeGeoShape,
+		schema.DataTypeSparseVector:
 		return nil
 	default:
 	}
@@ -547,16 +801,24 @@ func (s *Shard) shutdown(ctx context.Context) error {
 		return errors.Wrap(err, "close prop length tracker")
 	}
 
+	// stop indexing before the vector indexes are shut down, the vectors
+	// which are not indexed yet are queued again on the next startup
+	s.closeIndexQueues()
+
 	// to ensure that all commitlog entries are written to disk.
 	// otherwise in some cases the tombstone cleanup process'
 	// 'RemoveTombstone' entry is not picked up on restarts
 	// resulting in perpetually attempting to remove a tombstone
 	// which doesn't actually exist anymore
-	if err := s.vectorIndex.Flush(); err != nil {
+	if err := s.forEachVectorIndex(func(_ string, vi VectorIndex) error {
+		return vi.Flush()
+	}); err != nil {
 		return errors.Wrap(err, "flush vector index commitlog")
 	}
 
-	if err := s.vectorIndex.Shutdown(ctx); err != nil {
+	if err := s.forEachVectorIndex(func(_ string, vi VectorIndex) error {
+		return vi.Shutdown(ctx)
+	}); err != nil {
 		return errors.Wrap(err, "shut down vector index")
 	}
 
@@ -566,10 +828,16 @@ func (s *Shard) shutdown(ctx context.Context) error {
 		s.cycleCallbacks.flushCallbacksCtrl,
 		s.cycleCallbacks.vectorCombinedCallbacksCtrl,
 		s.cycleCallbacks.geoPropsCombinedCallbacksCtrl,
+		s.cycleCallbacks.objectTTLCallbacksCtrl,
 	).Unregister(ctx); err != nil {
 		return err
 	}
 
+	// the snapshots of scroll sessions keep segments open
+	if err := s.closeScrollSessions(); err != nil {
+		return errors.Wrap(err, "close scroll sessions")
+	}
+
 	if err := s.store.Shutdown(ctx); err != nil {
 		return errors.Wrap(err, "stop lsmkv store")
 	}
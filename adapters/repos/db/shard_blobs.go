@@ -0,0 +1,287 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/blobstore"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+// blobInlineLimit is the maximum decoded size of a blob value which is kept
+// inline in the object. Larger values are moved to the blob store of the
+// shard and replaced with a reference, so they don't bloat the object store.
+const blobInlineLimit = 64 * 1024
+
+func isBlobProp(prop *models.Property) bool {
+	return len(prop.DataType) == 1 && prop.DataType[0] == string(schema.DataTypeBlob)
+}
+
+// initBlobStore creates the blob buckets, if they don't exist yet. It is
+// called for every blob property, so it needs to be idempotent.
+func (s *Shard) initBlobStore(ctx context.Context) error {
+	s.blobsLock.Lock()
+	defer s.blobsLock.Unlock()
+
+	if s.blobs != nil {
+		return nil
+	}
+
+	if err := s.store.CreateOrLoadBucket(ctx, helpers.BlobsBucketLSM,
+		lsmkv.WithStrategy(lsmkv.StrategyReplace),
+		lsmkv.WithPread(s.index.Config.AvoidMMap),
+		s.memtableIdleConfig(),
+	); err != nil {
+		return errors.Wrap(err, "create blobs bucket")
+	}
+
+	if err := s.store.CreateOrLoadBucket(ctx, helpers.BlobRefsBucketLSM,
+		lsmkv.WithStrategy(lsmkv.StrategyRoaringSet),
+		lsmkv.WithPread(s.index.Config.AvoidMMap),
+		s.memtableIdleConfig(),
+	); err != nil {
+		return errors.Wrap(err, "create blob refs bucket")
+	}
+
+	s.blobs = blobstore.New(s.store.Bucket(helpers.BlobsBucketLSM),
+		s.store.Bucket(helpers.BlobRefsBucketLSM))
+	return nil
+}
+
+func (s *Shard) blobStore() *blobstore.Store {
+	s.blobsLock.Lock()
+	defer s.blobsLock.Unlock()
+
+	return s.blobs
+}
+
+func (s *Shard) blobProps(className string) ([]*models.Property, error) {
+	class, err := schema.GetClassByName(s.index.getSchema.GetSchemaSkipAuth().Objects,
+		className)
+	if err != nil {
+		return nil, err
+	}
+
+	var props []*models.Property
+	for _, prop := range class.Properties {
+		if isBlobProp(prop) {
+			props = append(props, prop)
+		}
+	}
+	return props, nil
+}
+
+// storeBlobs moves large inline blob values of the object to the blob store
+// and references all stored blobs the object points to by docID. It needs to
+// be called before the object is marshalled.
+func (s *Shard) storeBlobs(object *storobj.Object, docID uint64) error {
+	props, ok := object.Properties().(map[string]interface{})
+	if !ok || len(props) == 0 {
+		return nil
+	}
+
+	blobProps, err := s.blobProps(object.Class().String())
+	if err != nil || len(blobProps) == 0 {
+		return err
+	}
+
+	store := s.blobStore()
+	var (
+		stored [][]byte
+		next   map[string]interface{}
+	)
+	for _, prop := range blobProps {
+		value, ok := props[prop.Name].(string)
+		if !ok {
+			continue
+		}
+
+		if schema.IsBlobReference(value) {
+			digest, err := schema.ParseBlobReference(value)
+			if err == nil && store == nil {
+				err = fmt.Errorf("no blob store on shard %s", s.ID())
+			}
+			if err == nil {
+				err = store.Ref(digest, docID)
+			}
+			if err != nil {
+				s.releaseStoredBlobs(stored, docID)
+				return errors.Wrapf(err, "property '%s'", prop.Name)
+			}
+			stored = append(stored, digest)
+			continue
+		}
+
+		if store == nil || base64.StdEncoding.DecodedLen(len(value)) <= blobInlineLimit {
+			continue
+		}
+
+		digest, _, err := store.Put(base64.NewDecoder(base64.StdEncoding,
+			strings.NewReader(value)), docID)
+		if err != nil {
+			s.releaseStoredBlobs(stored, docID)
+			return errors.Wrapf(err, "store blob of property '%s'", prop.Name)
+		}
+		stored = append(stored, digest)
+
+		// the properties may be shared with the caller, so they must not be
+		// altered in place
+		if next == nil {
+			next = make(map[string]interface{}, len(props))
+			for key, value := range props {
+				next[key] = value
+			}
+		}
+		next[prop.Name] = schema.BlobReference(digest)
+	}
+
+	if next != nil {
+		object.Object.Properties = next
+	}
+	return nil
+}
+
+func (s *Shard) releaseStoredBlobs(digests [][]byte, docID uint64) {
+	if len(digests) == 0 {
+		return
+	}
+
+	if err := s.blobStore().Release(digests, docID); err != nil {
+		s.index.logger.WithField("action", "release_blobs").
+			WithField("shard", s.ID()).WithError(err).
+			Error("could not release blobs")
+	}
+}
+
+// releaseBlobs drops the references of docID on all stored blobs of the
+// given object, i.e. a previous version of an object which was updated or
+// deleted
+func (s *Shard) releaseBlobs(object *storobj.Object, docID uint64) error {
+	props, ok := object.Properties().(map[string]interface{})
+	if !ok || len(props) == 0 {
+		return nil
+	}
+
+	blobProps, err := s.blobProps(object.Class().String())
+	if err != nil || len(blobProps) == 0 {
+		return err
+	}
+
+	var digests [][]byte
+	for _, prop := range blobProps {
+		value, ok := props[prop.Name].(string)
+		if !ok || !schema.IsBlobReference(value) {
+			continue
+		}
+		digest, err := schema.ParseBlobReference(value)
+		if err != nil {
+			return errors.Wrapf(err, "property '%s'", prop.Name)
+		}
+		digests = append(digests, digest)
+	}
+
+	if len(digests) == 0 {
+		return nil
+	}
+
+	store := s.blobStore()
+	if store == nil {
+		return fmt.Errorf("no blob store on shard %s", s.ID())
+	}
+	return store.Release(digests, docID)
+}
+
+// putBlob streams a blob into the blob store. The blob is referenced by a
+// fresh doc id, which isn't used by any object, to protect it until the
+// object points to it. The returned release func drops this reference.
+func (s *Shard) putBlob(r io.Reader) (string, func(), error) {
+	store := s.blobStore()
+	if store == nil {
+		return "", nil, fmt.Errorf("no blob store on shard %s", s.ID())
+	}
+
+	pendingDocID, err := s.counter.GetAndInc()
+	if err != nil {
+		return "", nil, errors.Wrap(err, "get pending doc id from counter")
+	}
+
+	digest, _, err := store.Put(r, pendingDocID)
+	if err != nil {
+		return "", nil, err
+	}
+
+	release := func() {
+		s.releaseStoredBlobs([][]byte{digest}, pendingDocID)
+	}
+	return schema.BlobReference(digest), release, nil
+}
+
+// putObjectBlob streams r into the blob store and sets the blob property of
+// the object to reference it. The vector of the object is kept.
+func (s *Shard) putObjectBlob(ctx context.Context, id strfmt.UUID, propName string,
+	r io.Reader, updateTime int64,
+) error {
+	if err := s.checkWritable(); err != nil {
+		return err
+	}
+	defer s.bumpDataVersion()
+
+	ref, release, err := s.putBlob(r)
+	if err != nil {
+		return errors.Wrap(err, "store blob")
+	}
+	defer release()
+
+	return s.mergeObject(ctx, objects.MergeDocument{
+		Class:           s.index.Config.ClassName.String(),
+		ID:              id,
+		PrimitiveSchema: map[string]interface{}{propName: ref},
+		UpdateTime:      updateTime,
+	})
+}
+
+func openInlineBlob(value string) (io.ReadCloser, int64, error) {
+	r := base64.NewDecoder(base64.StdEncoding, strings.NewReader(value))
+	size := int64(base64.StdEncoding.DecodedLen(len(value)) - strings.Count(value, "="))
+	return io.NopCloser(r), size, nil
+}
+
+// openBlob returns a reader for the blob value of an object, no matter
+// if it is stored inline or out-of-band
+func (s *Shard) openBlob(value string) (io.ReadCloser, int64, error) {
+	if !schema.IsBlobReference(value) {
+		return openInlineBlob(value)
+	}
+
+	digest, err := schema.ParseBlobReference(value)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	store := s.blobStore()
+	if store == nil {
+		return nil, 0, fmt.Errorf("no blob store on shard %s", s.ID())
+	}
+	return store.Open(digest)
+}
@@ -0,0 +1,159 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// compactableVectorIndex is implemented by vector indexes whose commit logs
+// can be condensed on demand
+type compactableVectorIndex interface {
+	CompactCommitLogs(ctx context.Context) error
+}
+
+// compact forces a full compaction of all LSM buckets and the commit logs of
+// all vector indexes of the shard. The shard remains readable and writable
+// meanwhile.
+func (s *Shard) compact(ctx context.Context) (*models.ShardCompaction, error) {
+	if s.isReadOnly() {
+		return nil, fmt.Errorf("shard %s is READONLY and cannot be compacted", s.name)
+	}
+
+	bytesBefore, err := s.diskUsage()
+	if err != nil {
+		return nil, fmt.Errorf("shard %s: disk usage: %w", s.name, err)
+	}
+
+	before := time.Now()
+	buckets, err := s.store.CompactFully(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("shard %s: %w", s.name, err)
+	}
+
+	if err := s.forEachVectorIndex(func(_ string, vi VectorIndex) error {
+		if index, ok := vi.(compactableVectorIndex); ok {
+			return index.CompactCommitLogs(ctx)
+		}
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("shard %s: vector index: %w", s.name, err)
+	}
+	took := time.Since(before)
+
+	bytesAfter, err := s.diskUsage()
+	if err != nil {
+		return nil, fmt.Errorf("shard %s: disk usage: %w", s.name, err)
+	}
+
+	// concurrent writes may outgrow the reclaimed space
+	reclaimed := bytesBefore - bytesAfter
+	if reclaimed < 0 {
+		reclaimed = 0
+	}
+
+	s.index.logger.WithField("action", "shard_compaction").
+		WithField("class", s.index.Config.ClassName.String()).
+		WithField("shard", s.name).
+		WithField("buckets", buckets).
+		WithField("reclaimed_bytes", reclaimed).
+		WithField("took", took).
+		Info("compacted shard")
+
+	return &models.ShardCompaction{
+		Shard:          s.name,
+		Buckets:        int64(buckets),
+		BytesBefore:    bytesBefore,
+		BytesAfter:     bytesAfter,
+		ReclaimedBytes: reclaimed,
+		TookMs:         took.Milliseconds(),
+	}, nil
+}
+
+// diskUsage is the size in bytes of all files and directories of the shard
+func (s *Shard) diskUsage() (int64, error) {
+	names, err := shardEntries(s.index.Config.RootPath, s.ID())
+	if err != nil {
+		return 0, err
+	}
+
+	var size int64
+	err = walkShardFiles(s.index.Config.RootPath, names, func(_ string, fileSize int64) {
+		size += fileSize
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return size, nil
+}
+
+// shardEntries returns the names of the files and directories of a shard,
+// whether or not it is loaded. They are all placed in the root path and named
+// after the shard id.
+func shardEntries(rootPath, shardID string) ([]string, error) {
+	entries, err := os.ReadDir(rootPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, entry := range entries {
+		name := entry.Name()
+		if name == shardID+"_lsm" || strings.HasPrefix(name, shardID+".") ||
+			strings.HasPrefix(name, targetVectorIndexID(shardID, "")) {
+			names = append(names, name)
+		}
+	}
+	return names, nil
+}
+
+// compactShard forces a full compaction of the storage of a local shard. No
+// backup can be started meanwhile, as it would list files that are replaced
+// by the compaction.
+func (i *Index) compactShard(ctx context.Context, shardName string,
+) (*models.ShardCompaction, error) {
+	i.backupMutex.RLock()
+	defer i.backupMutex.RUnlock()
+
+	if i.lastBackup.Load() != nil {
+		return nil, fmt.Errorf("cannot compact shard %s while a backup of class %s is in progress",
+			shardName, i.Config.ClassName)
+	}
+
+	shard := i.localShard(ctx, shardName)
+	if shard == nil {
+		return nil, fmt.Errorf("shard %s of class %s is not held by this node",
+			shardName, i.Config.ClassName)
+	}
+	return shard.compact(ctx)
+}
+
+// CompactShard forces a full compaction of all LSM buckets and vector index
+// commit logs of a local shard, e.g. to reclaim disk space after mass
+// deletions
+func (m *Migrator) CompactShard(ctx context.Context, className, shardName string,
+) (*models.ShardCompaction, error) {
+	idx := m.db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return nil, fmt.Errorf("cannot compact shard of a non-existing index for %s", className)
+	}
+
+	return idx.compactShard(ctx, shardName)
+}
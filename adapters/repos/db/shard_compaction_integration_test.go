@@ -0,0 +1,131 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestCompactShard(t *testing.T) {
+	dirName := t.TempDir()
+	logger, _ := test.NewNullLogger()
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer repo.Shutdown(context.Background())
+
+	migrator := NewMigrator(repo, logger)
+	class := &models.Class{
+		Class:               "CompactionArticle",
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		Properties: []*models.Property{
+			{
+				Name:     "headline",
+				DataType: schema.DataTypeText.PropString(),
+			},
+		},
+	}
+	shardName := schemaGetter.shardState.AllPhysicalShards()[0]
+
+	var ids []strfmt.UUID
+	t.Run("adding and deleting objects across several segments", func(t *testing.T) {
+		require.Nil(t,
+			migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+		schemaGetter.schema.Objects = &models.Schema{
+			Classes: []*models.Class{class},
+		}
+		shard := repo.GetIndex(schema.ClassName(class.Class)).localShard(context.Background(), shardName)
+
+		for round := 0; round < 4; round++ {
+			for i := 0; i < 50; i++ {
+				id := strfmt.UUID(uuid.NewString())
+				vector := make([]float32, 8)
+				for j := range vector {
+					vector[j] = rand.Float32()
+				}
+				require.Nil(t, repo.PutObject(context.Background(), &models.Object{
+					Class:      class.Class,
+					ID:         id,
+					Properties: map[string]interface{}{"headline": strings.Repeat("article ", 50)},
+				}, vector, nil))
+				ids = append(ids, id)
+			}
+			require.Nil(t, shard.store.FlushMemtables(context.Background()))
+		}
+
+		for _, id := range ids[:150] {
+			require.Nil(t, repo.DeleteObject(context.Background(), class.Class, id, nil, ""))
+		}
+	})
+
+	t.Run("compacting a non-existing class or shard", func(t *testing.T) {
+		_, err := migrator.CompactShard(context.Background(), "WrongClass", shardName)
+		assert.NotNil(t, err)
+
+		_, err = migrator.CompactShard(context.Background(), class.Class, "wrongShard")
+		assert.NotNil(t, err)
+	})
+
+	t.Run("compacting the shard", func(t *testing.T) {
+		res, err := migrator.CompactShard(context.Background(), class.Class, shardName)
+		require.Nil(t, err)
+		assert.Equal(t, shardName, res.Shard)
+		assert.Greater(t, res.Buckets, int64(0))
+		assert.Greater(t, res.ReclaimedBytes, int64(0))
+		assert.Equal(t, res.BytesBefore-res.BytesAfter, res.ReclaimedBytes)
+	})
+
+	t.Run("objects are still available after the compaction", func(t *testing.T) {
+		for i, id := range ids {
+			obj, err := repo.ObjectByID(context.Background(), id, search.SelectProperties{},
+				additional.Properties{}, "")
+			require.Nil(t, err)
+			if i < 150 {
+				assert.Nil(t, obj)
+			} else {
+				assert.NotNil(t, obj)
+			}
+		}
+	})
+
+	t.Run("compacting a READONLY shard", func(t *testing.T) {
+		require.Nil(t, migrator.UpdateShardStatus(context.Background(),
+			class.Class, shardName, "READONLY"))
+
+		_, err := migrator.CompactShard(context.Background(), class.Class, shardName)
+		assert.ErrorContains(t, err, "READONLY")
+	})
+}
@@ -31,6 +31,8 @@ type shardCycleCallbacks struct {
 	geoPropsCommitLoggerCallbacks     cyclemanager.CycleCallbackGroup
 	geoPropsTombstoneCleanupCallbacks cyclemanager.CycleCallbackGroup
 	geoPropsCombinedCallbacksCtrl     cyclemanager.CycleCallbackCtrl
+
+	objectTTLCallbacksCtrl cyclemanager.CycleCallbackCtrl
 }
 
 func (s *Shard) initCycleCallbacks() {
@@ -81,6 +83,10 @@ func (s *Shard) initCycleCallbacks() {
 	geoPropsCombinedCallbacksCtrl := cyclemanager.NewCombinedCallbackCtrl(2,
 		geoPropsCommitLoggerCallbacksCtrl, geoPropsTombstoneCleanupCallbacksCtrl)
 
+	// registered inactive, activated once the shard is fully initialized
+	objectTTLCallbacksCtrl := s.index.cycleCallbacks.objectTTLCallbacks.Register(
+		id("object_ttl"), s.expireObjects, cyclemanager.AsInactive())
+
 	s.cycleCallbacks = &shardCycleCallbacks{
 		compactionCallbacks:     compactionCallbacks,
 		compactionCallbacksCtrl: compactionCallbacksCtrl,
@@ -95,5 +101,7 @@ func (s *Shard) initCycleCallbacks() {
 		geoPropsCommitLoggerCallbacks:     geoPropsCommitLoggerCallbacks,
 		geoPropsTombstoneCleanupCallbacks: geoPropsTombstoneCleanupCallbacks,
 		geoPropsCombinedCallbacksCtrl:     geoPropsCombinedCallbacksCtrl,
+
+		objectTTLCallbacksCtrl: objectTTLCallbacksCtrl,
 	}
 }
@@ -0,0 +1,204 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// walkShardFiles calls fn with the path relative to the root path and the
+// size of every file of the shard. Files which are removed concurrently, e.g.
+// by a compaction, are skipped.
+func walkShardFiles(rootPath string, entries []string,
+	fn func(rel string, size int64),
+) error {
+	for _, name := range entries {
+		err := filepath.WalkDir(filepath.Join(rootPath, name),
+			func(path string, d fs.DirEntry, err error) error {
+				if err != nil {
+					if errors.Is(err, fs.ErrNotExist) {
+						return nil
+					}
+					return err
+				}
+				if d.IsDir() {
+					return nil
+				}
+				info, err := d.Info()
+				if err != nil {
+					if errors.Is(err, fs.ErrNotExist) {
+						return nil
+					}
+					return err
+				}
+				rel, err := filepath.Rel(rootPath, path)
+				if err != nil {
+					return err
+				}
+				fn(rel, info.Size())
+				return nil
+			})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// shardDiskUsage breaks down the disk usage of a shard, whether or not it is
+// loaded. The tombstones are only estimated for loaded shards, see
+// estimateTombstonesBytes.
+func shardDiskUsage(rootPath, className, shardName, shardID string,
+) (*models.ShardDiskUsage, error) {
+	entries, err := shardEntries(rootPath, shardID)
+	if err != nil {
+		return nil, err
+	}
+
+	// the store of the compressed vectors of an hnsw index is not named after
+	// the shard id
+	compressedVectors := filepath.Join(className, shardName)
+	if _, err := os.Stat(filepath.Join(rootPath, compressedVectors)); err == nil {
+		entries = append(entries, compressedVectors)
+	}
+
+	usage := &models.ShardDiskUsage{}
+	buckets := map[string]int64{}
+	err = walkShardFiles(rootPath, entries, func(rel string, size int64) {
+		usage.TotalBytes += size
+
+		switch category, bucket := classifyShardFile(shardID, compressedVectors, rel); category {
+		case diskUsageObjects:
+			usage.ObjectsBytes += size
+		case diskUsageInverted:
+			usage.InvertedIndexBytes += size
+			buckets[bucket] += size
+		case diskUsageVector:
+			usage.VectorIndexBytes += size
+		case diskUsageWAL:
+			usage.WalBytes += size
+		case diskUsageCompactionTemp:
+			usage.CompactionTempBytes += size
+		default:
+			usage.OtherBytes += size
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	usage.InvertedIndexBuckets = make([]*models.BucketDiskUsage, 0, len(buckets))
+	for bucket, size := range buckets {
+		usage.InvertedIndexBuckets = append(usage.InvertedIndexBuckets,
+			&models.BucketDiskUsage{Bucket: bucket, Bytes: size})
+	}
+	sort.Slice(usage.InvertedIndexBuckets, func(a, b int) bool {
+		return usage.InvertedIndexBuckets[a].Bucket < usage.InvertedIndexBuckets[b].Bucket
+	})
+
+	return usage, nil
+}
+
+const (
+	diskUsageObjects = iota
+	diskUsageInverted
+	diskUsageVector
+	diskUsageWAL
+	diskUsageCompactionTemp
+	diskUsageOther
+)
+
+// classifyShardFile returns which part of the shard a file belongs to and, for
+// inverted index buckets, the name of the bucket
+func classifyShardFile(shardID, compressedVectors, rel string) (int, string) {
+	parts := strings.Split(filepath.ToSlash(rel), "/")
+	for _, part := range parts {
+		// compacted segments, their scratch space and condensed commit logs
+		// are written to temporary files first
+		if strings.HasSuffix(part, ".tmp") || strings.HasSuffix(part, "compaction.scratch.d") {
+			return diskUsageCompactionTemp, ""
+		}
+	}
+	if filepath.Ext(rel) == ".wal" {
+		return diskUsageWAL, ""
+	}
+
+	if parts[0] == shardID+"_lsm" && len(parts) > 2 {
+		bucket := parts[1]
+		switch {
+		case parts[2] == lsmkv.QuarantineDir:
+			// corrupt segments are no longer part of their bucket
+			return diskUsageOther, ""
+		case bucket == helpers.ObjectsBucketLSM:
+			return diskUsageObjects, ""
+		case strings.HasPrefix(bucket, "property_"):
+			return diskUsageInverted, bucket
+		case strings.HasPrefix(bucket, helpers.VectorsQueueBucketLSM):
+			return diskUsageVector, ""
+		default:
+			return diskUsageOther, ""
+		}
+	}
+
+	if strings.HasPrefix(parts[0], shardID+".hnsw.") ||
+		strings.HasPrefix(parts[0], shardID+".flat.") ||
+		strings.HasPrefix(parts[0], targetVectorIndexID(shardID, "")) ||
+		strings.HasPrefix(filepath.ToSlash(rel), filepath.ToSlash(compressedVectors)+"/") {
+		return diskUsageVector, ""
+	}
+
+	return diskUsageOther, ""
+}
+
+// estimateTombstonesBytes estimates the part of the vector indexes which is
+// taken by deleted nodes that are not cleaned up yet, assuming that all nodes
+// take the same space
+func estimateTombstonesBytes(vectorIndexBytes, objectCount int64,
+	cleanups []*models.VectorIndexTombstoneCleanup,
+) int64 {
+	var tombstones int64
+	for _, cleanup := range cleanups {
+		tombstones += cleanup.RemainingTombstones
+	}
+
+	nodes := objectCount*int64(len(cleanups)) + tombstones
+	if tombstones == 0 || nodes == 0 {
+		return 0
+	}
+	return int64(float64(vectorIndexBytes) * float64(tombstones) / float64(nodes))
+}
+
+// diskUsageBreakdown breaks down the disk usage of the shard, see
+// shardDiskUsage
+func (s *Shard) diskUsageBreakdown(objectCount int64,
+	cleanups []*models.VectorIndexTombstoneCleanup,
+) (*models.ShardDiskUsage, error) {
+	usage, err := shardDiskUsage(s.index.Config.RootPath,
+		s.index.Config.ClassName.String(), s.name, s.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	usage.TombstonesBytes = estimateTombstonesBytes(usage.VectorIndexBytes,
+		objectCount, cleanups)
+	return usage, nil
+}
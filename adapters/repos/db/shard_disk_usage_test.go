@@ -0,0 +1,84 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestShardDiskUsage(t *testing.T) {
+	root := t.TempDir()
+	shardID := "article_abc"
+
+	files := map[string]int{
+		"article_abc_lsm/objects/segment-1.db":                             100,
+		"article_abc_lsm/objects/segment-1.bloom":                          10,
+		"article_abc_lsm/objects/segment-2.wal":                            20,
+		"article_abc_lsm/objects/segment-1.db.tmp":                         30,
+		"article_abc_lsm/objects/segment-1.dbcompaction.scratch.d/scratch": 5,
+		"article_abc_lsm/property_title/segment-1.db":                      40,
+		"article_abc_lsm/property_title_searchable/segment-1.db":           50,
+		"article_abc_lsm/property_title_searchable/segment-2.wal":          1,
+		"article_abc_lsm/vectors_queue/segment-1.db":                       2,
+		"article_abc_lsm/dimensions/segment-1.db":                          3,
+		"article_abc.hnsw.commitlog.d/1700000000":                          60,
+		"article_abc.hnsw.commitlog.d/1700000001.condensed.combined.tmp":   6,
+		"article_abc_vector_title.hnsw.commitlog.d/1700000000":             70,
+		"Article/abc/compressed_objects/segment-1.db":                      80,
+		"article_abc.indexcount":                                           4,
+		"article_abc.version":                                              2,
+		"article_other_lsm/objects/segment-1.db":                           1000,
+		"article_abcd_lsm/objects/segment-1.db":                            1000,
+	}
+	for name, size := range files {
+		path := filepath.Join(root, name)
+		require.Nil(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.Nil(t, os.WriteFile(path, make([]byte, size), 0o644))
+	}
+
+	usage, err := shardDiskUsage(root, "Article", "abc", shardID)
+	require.Nil(t, err)
+
+	assert.Equal(t, int64(110), usage.ObjectsBytes)
+	assert.Equal(t, int64(90), usage.InvertedIndexBytes)
+	assert.Equal(t, []*models.BucketDiskUsage{
+		{Bucket: "property_title", Bytes: 40},
+		{Bucket: "property_title_searchable", Bytes: 50},
+	}, usage.InvertedIndexBuckets)
+	assert.Equal(t, int64(212), usage.VectorIndexBytes)
+	assert.Equal(t, int64(21), usage.WalBytes)
+	assert.Equal(t, int64(41), usage.CompactionTempBytes)
+	assert.Equal(t, int64(9), usage.OtherBytes)
+	assert.Equal(t, int64(483), usage.TotalBytes)
+}
+
+func TestEstimateTombstonesBytes(t *testing.T) {
+	cleanup := func(remaining int64) *models.VectorIndexTombstoneCleanup {
+		return &models.VectorIndexTombstoneCleanup{RemainingTombstones: remaining}
+	}
+
+	assert.Equal(t, int64(0), estimateTombstonesBytes(1000, 10, nil))
+	assert.Equal(t, int64(0), estimateTombstonesBytes(1000, 10,
+		[]*models.VectorIndexTombstoneCleanup{cleanup(0)}))
+	// 30 live and 10 deleted nodes
+	assert.Equal(t, int64(250), estimateTombstonesBytes(1000, 30,
+		[]*models.VectorIndexTombstoneCleanup{cleanup(10)}))
+	// 2 * 20 live and 10 deleted nodes across two target vectors
+	assert.Equal(t, int64(200), estimateTombstonesBytes(1000, 20,
+		[]*models.VectorIndexTombstoneCleanup{cleanup(4), cleanup(6)}))
+}
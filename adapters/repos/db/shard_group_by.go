@@ -26,25 +26,81 @@ import (
 	"github.com/weaviate/weaviate/entities/storobj"
 )
 
+// groupedSearchGrowth is the factor by which the number of candidates of a
+// grouped vector search is widened if the groups could not be filled
+const groupedSearchGrowth = 4
+
+// groupedVectorSearch pushes the grouping down into the vector search. Rather
+// than grouping a fixed number of candidates, the search starts with just
+// enough candidates to fill all groups and is widened until every group is
+// full, the index is exhausted or the maximum number of results is reached.
+func (s *Shard) groupedVectorSearch(ctx context.Context,
+	search func(k int) ([]uint64, []float32, error),
+	groupBy *searchparams.GroupBy, additional additional.Properties,
+) ([]*storobj.Object, []float32, error) {
+	maxK := int(s.index.Config.QueryMaximumResults)
+	k := groupBy.Groups * groupBy.ObjectsPerGroup
+	if k < 1 {
+		k = 1
+	}
+
+	for {
+		if k > maxK {
+			k = maxK
+		}
+
+		ids, dists, err := search(k)
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(ids) == 0 {
+			return nil, nil, nil
+		}
+
+		g, err := s.newGrouper(ids, dists, groupBy, additional)
+		if err != nil {
+			return nil, nil, err
+		}
+		objs, objDists, err := g.Do(ctx)
+		if err != nil || g.complete || len(ids) < k || k >= maxK {
+			return objs, objDists, err
+		}
+
+		k *= groupedSearchGrowth
+	}
+}
+
 func (s *Shard) groupResults(ctx context.Context, ids []uint64,
 	dists []float32, groupBy *searchparams.GroupBy,
 	additional additional.Properties,
 ) ([]*storobj.Object, []float32, error) {
+	g, err := s.newGrouper(ids, dists, groupBy, additional)
+	if err != nil {
+		return nil, nil, err
+	}
+	return g.Do(ctx)
+}
+
+func (s *Shard) newGrouper(ids []uint64, dists []float32,
+	groupBy *searchparams.GroupBy, additional additional.Properties,
+) (*grouper, error) {
 	objsBucket := s.store.Bucket(helpers.ObjectsBucketLSM)
 	className := s.index.Config.ClassName
 	sch := s.index.getSchema.GetSchemaSkipAuth()
 	prop, err := sch.GetProperty(className, schema.PropertyName(groupBy.Property))
 	if err != nil {
-		return nil, nil, fmt.Errorf("%w: unrecognized property: %s",
+		return nil, fmt.Errorf("%w: unrecognized property: %s",
 			err, groupBy.Property)
 	}
 	dt, err := sch.FindPropertyDataType(prop.DataType)
 	if err != nil {
-		return nil, nil, fmt.Errorf("%w: unrecognized data type for property: %s",
+		return nil, fmt.Errorf("%w: unrecognized data type for property: %s",
 			err, groupBy.Property)
 	}
 
-	return newGrouper(ids, dists, groupBy, objsBucket, dt, additional).Do(ctx)
+	g := newGrouper(ids, dists, groupBy, objsBucket, dt, additional, s.class())
+	g.previousNames = s.renamedProperties.of(groupBy.Property)
+	return g, nil
 }
 
 type grouper struct {
@@ -54,12 +110,20 @@ type grouper struct {
 	additional       additional.Properties
 	propertyDataType schema.PropertyDataType
 	objBucket        *lsmkv.Bucket
+	class            *models.Class
+	// previous names of the property, objects which have not been rewritten
+	// since it was renamed still contain it under one of them
+	previousNames []string
+
+	// complete is set by Do if all groups have been filled, the remaining
+	// candidates are not considered then
+	complete bool
 }
 
 func newGrouper(ids []uint64, dists []float32,
 	groupBy *searchparams.GroupBy, objBucket *lsmkv.Bucket,
 	propertyDataType schema.PropertyDataType,
-	additional additional.Properties,
+	additional additional.Properties, class *models.Class,
 ) *grouper {
 	return &grouper{
 		ids:              ids,
@@ -68,6 +132,7 @@ func newGrouper(ids []uint64, dists []float32,
 		objBucket:        objBucket,
 		propertyDataType: propertyDataType,
 		additional:       additional,
+		class:            class,
 	}
 }
 
@@ -78,9 +143,17 @@ func (g *grouper) Do(ctx context.Context) ([]*storobj.Object, []float32, error)
 	groups := map[string][]uint64{}
 	docIDObject := map[uint64]*storobj.Object{}
 	docIDDistance := map[uint64]float32{}
+	fullGroups := 0
+	complete := func() bool {
+		return len(groups) >= g.groupBy.Groups && fullGroups == len(groups)
+	}
 
 DOCS_LOOP:
 	for i, docID := range g.ids {
+		if complete() {
+			break
+		}
+
 		binary.LittleEndian.PutUint64(docIDBytes, docID)
 		objData, err := g.objBucket.GetBySecondary(0, docIDBytes)
 		if err != nil {
@@ -90,6 +163,12 @@ DOCS_LOOP:
 			continue
 		}
 		value, ok, _ := storobj.ParseAndExtractProperty(objData, g.groupBy.Property)
+		for _, previous := range g.previousNames {
+			if len(value) > 0 {
+				break
+			}
+			value, ok, _ = storobj.ParseAndExtractProperty(objData, previous)
+		}
 		if !ok {
 			continue
 		}
@@ -110,6 +189,9 @@ DOCS_LOOP:
 			}
 
 			groups[val] = append(current, docID)
+			if len(groups[val]) == g.groupBy.ObjectsPerGroup {
+				fullGroups++
+			}
 
 			if !groupExists {
 				// this group doesn't exist add it to the ordered list
@@ -118,7 +200,7 @@ DOCS_LOOP:
 
 			if _, ok := docIDObject[docID]; !ok {
 				// whole object, might be that we only need value and ID to be extracted
-				unmarshalled, err := storobj.FromBinaryOptional(objData, g.additional)
+				unmarshalled, err := storobj.FromBinaryOptional(objData, g.additional, g.class)
 				if err != nil {
 					return nil, nil, fmt.Errorf("%w: unmarshal data object at position %d", err, i)
 				}
@@ -128,6 +210,8 @@ DOCS_LOOP:
 		}
 	}
 
+	g.complete = complete()
+
 	objs := make([]*storobj.Object, len(groupsOrdered))
 	dists := make([]float32, len(groupsOrdered))
 	objIDs := []uint64{}
@@ -197,7 +281,7 @@ func (g *grouper) getUnmarshalled(docID uint64,
 		if err != nil {
 			return nil, fmt.Errorf("%w: could not get obj by doc id %d", err, docID)
 		}
-		unmarshalled, err := storobj.FromBinaryOptional(objData, g.additional)
+		unmarshalled, err := storobj.FromBinaryOptional(objData, g.additional, g.class)
 		if err != nil {
 			return nil, fmt.Errorf("%w: unmarshal data object doc id %d", err, docID)
 		}
@@ -0,0 +1,135 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"os"
+	"path"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/noop"
+)
+
+func vectorsQueueBucketName(targetVector string) string {
+	if targetVector == "" {
+		return helpers.VectorsQueueBucketLSM
+	}
+	return helpers.VectorsQueueBucketLSM + "_" + targetVector
+}
+
+// initIndexQueues starts an index queue per vector index if async indexing
+// is enabled. Otherwise the queues a previous run left behind are drained, so
+// that no vector is lost when async indexing is turned off.
+func (s *Shard) initIndexQueues(ctx context.Context) error {
+	cfg := s.index.Config.AsyncIndexing
+	s.indexQueues = map[string]*IndexQueue{}
+
+	return s.forEachVectorIndex(func(targetVector string, vi VectorIndex) error {
+		if _, ok := vi.(*noop.Index); ok {
+			return nil
+		}
+
+		bucketName := vectorsQueueBucketName(targetVector)
+		if !cfg.Enabled {
+			if _, err := os.Stat(path.Join(s.DBPathLSM(), bucketName)); err != nil {
+				return nil
+			}
+		}
+
+		if err := s.store.CreateOrLoadBucket(ctx, bucketName,
+			lsmkv.WithStrategy(lsmkv.StrategyReplace),
+			lsmkv.WithPread(s.index.Config.AvoidMMap),
+			s.memtableIdleConfig(),
+		); err != nil {
+			return errors.Wrap(err, "create vectors queue bucket")
+		}
+
+		userConfig := s.index.vectorIndexUserConfig
+		if targetVector != "" {
+			userConfig = s.index.targetVectorIndexUserConfigs[targetVector]
+		}
+		distProv, err := distancerProviderFromName(userConfig.DistanceName())
+		if err != nil {
+			return err
+		}
+
+		q := newIndexQueue(vi, s.store.Bucket(bucketName), distProv, cfg, s.bumpDataVersion,
+			s.index.logger.WithField("shard", s.name).WithField("target_vector", targetVector))
+		if cfg.Enabled {
+			s.indexQueues[targetVector] = q
+			return nil
+		}
+
+		if size, _ := q.Stats(); size > 0 {
+			s.index.logger.WithField("action", "async_indexing").
+				WithField("shard", s.name).
+				Infof("async indexing is disabled, indexing %d queued vectors", size)
+		}
+		defer q.Close()
+		return q.waitUntilIndexed(ctx)
+	})
+}
+
+func (s *Shard) closeIndexQueues() {
+	for _, q := range s.indexQueues {
+		q.Close()
+	}
+}
+
+// addToVectorIndex queues the vector if async indexing is enabled and inserts
+// it right away otherwise
+func (s *Shard) addToVectorIndex(targetVector string, vi VectorIndex,
+	docID uint64, vector []float32,
+) error {
+	if q, ok := s.indexQueues[targetVector]; ok {
+		return q.Push([]uint64{docID}, [][]float32{vector})[0]
+	}
+	return vi.Add(docID, vector)
+}
+
+// deleteFromVectorIndex is the counterpart of addToVectorIndex for deletes
+func (s *Shard) deleteFromVectorIndex(targetVector string, vi VectorIndex,
+	docIDs ...uint64,
+) error {
+	if q, ok := s.indexQueues[targetVector]; ok {
+		return q.Delete(docIDs...)
+	}
+	return vi.Delete(docIDs...)
+}
+
+// vectorIndexQueueStats returns the number of vectors of the shard which are
+// not indexed yet and how long the oldest of them has been waiting
+func (s *Shard) vectorIndexQueueStats() (int64, time.Duration) {
+	var size int64
+	var lag time.Duration
+	for _, q := range s.indexQueues {
+		qSize, qLag := q.Stats()
+		size += qSize
+		if qLag > lag {
+			lag = qLag
+		}
+	}
+	return size, lag
+}
+
+// searchIndexQueue returns the queue of the vector index whose vectors are
+// brute-forced by vector searches, nil if the queued vectors are not searched
+func (s *Shard) searchIndexQueue(targetVector string) *IndexQueue {
+	if !s.index.Config.AsyncIndexing.SearchUnindexed {
+		return nil
+	}
+	return s.indexQueues[targetVector]
+}
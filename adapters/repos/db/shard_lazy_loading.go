@@ -0,0 +1,176 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	shardLoadStatusLoaded   = "LOADED"
+	shardLoadStatusLoading  = "LOADING"
+	shardLoadStatusUnloaded = "UNLOADED"
+)
+
+// lazyShard is a shard which was registered at startup, but whose stores and
+// vector indexes are only opened on first access or by the background
+// warmer. The lock is held while the shard is loaded.
+type lazyShard struct {
+	sync.Mutex
+	loading atomic.Bool
+}
+
+func (l *lazyShard) loadStatus() string {
+	if l.loading.Load() {
+		return shardLoadStatusLoading
+	}
+	return shardLoadStatusUnloaded
+}
+
+func (i *Index) registerLazyShard(name string) {
+	i.lazyShards.Store(name, &lazyShard{})
+}
+
+func (i *Index) isLazyShard(name string) bool {
+	_, ok := i.lazyShards.Load(name)
+	return ok
+}
+
+// loadLazyShard loads a shard which was registered lazily. If the shard was
+// loaded concurrently, the loaded shard is returned.
+func (i *Index) loadLazyShard(ctx context.Context, name string) (*Shard, error) {
+	v, ok := i.lazyShards.Load(name)
+	if !ok {
+		return i.shards.Load(name), nil
+	}
+	lazy := v.(*lazyShard)
+
+	lazy.Lock()
+	defer lazy.Unlock()
+
+	if !i.isLazyShard(name) {
+		// loaded or dropped while waiting for the lock
+		return i.shards.Load(name), nil
+	}
+
+	lazy.loading.Store(true)
+	defer lazy.loading.Store(false)
+
+	sch := i.getSchema.GetSchemaSkipAuth()
+	class := sch.GetClass(i.Config.ClassName)
+	if class == nil {
+		return nil, fmt.Errorf("class %s not found", i.Config.ClassName)
+	}
+
+	before := time.Now()
+	shard, err := NewShard(ctx, i.promMetrics, name, i, class, i.centralJobQueue)
+	if err != nil {
+		return nil, fmt.Errorf("load shard %q: %w", name, err)
+	}
+	shard.notifyReady()
+
+	// stored before it is unregistered, so that concurrent accesses always
+	// find it in either of both
+	i.shards.Store(name, shard)
+	i.lazyShards.Delete(name)
+
+	i.logger.WithField("action", "load_lazy_shard").
+		WithField("shard", shard.ID()).
+		WithField("took", time.Since(before)).
+		Debug("loaded shard lazily")
+	return shard, nil
+}
+
+// loadLazyShards loads all lazily registered shards of the index. Failed
+// shards are logged and stay registered, so their loading is retried on
+// their next access.
+func (i *Index) loadLazyShards(ctx context.Context) {
+	eg := new(errgroup.Group)
+	eg.SetLimit(_NUMCPU)
+
+	i.lazyShards.Range(func(key, _ any) bool {
+		if ctx.Err() != nil {
+			return false
+		}
+		name := key.(string)
+		eg.Go(func() error {
+			if ctx.Err() != nil {
+				return nil
+			}
+			if _, err := i.loadLazyShard(ctx, name); err != nil {
+				i.logger.WithField("action", "load_lazy_shard").
+					WithField("shard", i.shardID(name)).
+					Error(err)
+			}
+			return nil
+		})
+		return true
+	})
+	eg.Wait()
+}
+
+// unregisterLazyShard removes a shard which is not loaded yet, e.g. because
+// its tenant is deactivated or dropped. It waits for a concurrent load and
+// reports false if the shard is not (or no longer) registered lazily.
+func (i *Index) unregisterLazyShard(name string) bool {
+	v, ok := i.lazyShards.Load(name)
+	if !ok {
+		return false
+	}
+	lazy := v.(*lazyShard)
+
+	lazy.Lock()
+	defer lazy.Unlock()
+
+	_, ok = i.lazyShards.LoadAndDelete(name)
+	return ok
+}
+
+// lazyShardLoadStatuses returns the load status of the shards which are not
+// loaded yet by their name
+func (i *Index) lazyShardLoadStatuses() map[string]string {
+	statuses := map[string]string{}
+	i.lazyShards.Range(func(key, value any) bool {
+		statuses[key.(string)] = value.(*lazyShard).loadStatus()
+		return true
+	})
+	return statuses
+}
+
+// warmLazyShards loads all lazily registered shards in the background after
+// startup, so that only the first accesses during warm-up pay for loading.
+func (db *DB) warmLazyShards(ctx context.Context) {
+	db.indexLock.RLock()
+	indexes := make([]*Index, 0, len(db.indices))
+	for _, index := range db.indices {
+		indexes = append(indexes, index)
+	}
+	db.indexLock.RUnlock()
+
+	before := time.Now()
+	for _, index := range indexes {
+		if ctx.Err() != nil {
+			return
+		}
+		index.loadLazyShards(ctx)
+	}
+
+	db.logger.WithField("action", "warm_lazy_shards").
+		WithField("took", time.Since(before)).
+		Info("loaded all lazily registered shards")
+}
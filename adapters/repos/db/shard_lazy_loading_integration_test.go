@@ -0,0 +1,142 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestLazyLoadShards(t *testing.T) {
+	dirName := t.TempDir()
+	logger, _ := test.NewNullLogger()
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	shardName := schemaGetter.shardState.AllPhysicalShards()[0]
+	class := &models.Class{
+		Class:               "LazyArticle",
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		Properties: []*models.Property{
+			{
+				Name:     "headline",
+				DataType: schema.DataTypeText.PropString(),
+			},
+		},
+	}
+
+	// mirrors WaitForStartup without starting the background warmer, so that
+	// the shard stays unloaded until it is accessed
+	newRepo := func(t *testing.T, lazy bool) *DB {
+		repo, err := New(logger, Config{
+			MemtablesFlushIdleAfter:   60,
+			RootPath:                  dirName,
+			QueryMaximumResults:       10000,
+			MaxImportGoroutinesFactor: 1,
+			LazyLoadShards:            lazy,
+		}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+		require.Nil(t, err)
+		repo.SetSchemaGetter(schemaGetter)
+		require.Nil(t, repo.init(testCtx()))
+		repo.scanResourceUsage()
+		return repo
+	}
+
+	loadStatus := func(idx *Index) string {
+		var status []*models.NodeShardStatus
+		idx.getShardsNodeStatus(&status)
+		require.Len(t, status, 1)
+		return status[0].LoadStatus
+	}
+
+	var ids []strfmt.UUID
+	t.Run("importing objects", func(t *testing.T) {
+		repo := newRepo(t, false)
+		require.Nil(t, NewMigrator(repo, logger).
+			AddClass(context.Background(), class, schemaGetter.shardState))
+		schemaGetter.schema.Objects = &models.Schema{
+			Classes: []*models.Class{class},
+		}
+
+		for i := 0; i < 20; i++ {
+			id := strfmt.UUID(uuid.NewString())
+			require.Nil(t, repo.PutObject(context.Background(), &models.Object{
+				Class:      class.Class,
+				ID:         id,
+				Properties: map[string]interface{}{"headline": "lazy"},
+			}, []float32{1, 2, 3}, nil))
+			ids = append(ids, id)
+		}
+		require.Nil(t, repo.Shutdown(context.Background()))
+	})
+
+	t.Run("shard is loaded on first access", func(t *testing.T) {
+		repo := newRepo(t, true)
+		defer repo.Shutdown(context.Background())
+
+		idx := repo.GetIndex(schema.ClassName(class.Class))
+		require.NotNil(t, idx)
+		assert.Nil(t, idx.shards.Load(shardName))
+		assert.True(t, idx.isLazyShard(shardName))
+		assert.Equal(t, shardLoadStatusUnloaded, loadStatus(idx))
+
+		for _, id := range ids {
+			obj, err := repo.ObjectByID(context.Background(), id, search.SelectProperties{},
+				additional.Properties{}, "")
+			require.Nil(t, err)
+			assert.NotNil(t, obj)
+		}
+
+		assert.NotNil(t, idx.shards.Load(shardName))
+		assert.False(t, idx.isLazyShard(shardName))
+		assert.Equal(t, shardLoadStatusLoaded, loadStatus(idx))
+	})
+
+	t.Run("shard is loaded by the warmer", func(t *testing.T) {
+		repo := newRepo(t, true)
+		defer repo.Shutdown(context.Background())
+
+		idx := repo.GetIndex(schema.ClassName(class.Class))
+		require.True(t, idx.isLazyShard(shardName))
+
+		repo.warmLazyShards(context.Background())
+		assert.False(t, idx.isLazyShard(shardName))
+		assert.Equal(t, shardLoadStatusLoaded, loadStatus(idx))
+	})
+
+	t.Run("dropping a class with an unloaded shard", func(t *testing.T) {
+		repo := newRepo(t, true)
+		defer repo.Shutdown(context.Background())
+
+		idx := repo.GetIndex(schema.ClassName(class.Class))
+		require.True(t, idx.isLazyShard(shardName))
+		shardID := idx.shardID(shardName)
+
+		require.Nil(t, NewMigrator(repo, logger).DropClass(context.Background(), class.Class))
+
+		entries, err := shardEntries(dirName, shardID)
+		require.Nil(t, err)
+		assert.Empty(t, entries)
+	})
+}
@@ -0,0 +1,105 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+// interval in which every shard of a class with an enabled object TTL is
+// scanned for expired objects
+const objectTTLCycleInterval = time.Minute
+
+// expireObjects is the cycle callback of the object TTL janitor. It scans the
+// objects bucket for objects whose creation time lies further back than the
+// TTL of the class and deletes them including their vectors and inverted
+// index entries. It returns true if any object was deleted.
+func (s *Shard) expireObjects(shouldAbort cyclemanager.ShouldAbortCallback) bool {
+	sch := s.index.getSchema.GetSchemaSkipAuth()
+	class := sch.GetClass(s.index.Config.ClassName)
+	if class == nil || class.ObjectTTL == nil || !class.ObjectTTL.Enabled ||
+		class.ObjectTTL.TTLSeconds <= 0 {
+		return false
+	}
+	if s.checkWritable() != nil {
+		return false
+	}
+
+	ttl := time.Duration(class.ObjectTTL.TTLSeconds) * time.Second
+	expired, err := s.expireObjectsCreatedBefore(context.Background(),
+		time.Now().Add(-ttl).UnixMilli(), shouldAbort)
+	if expired > 0 {
+		s.index.logger.WithFields(logrus.Fields{
+			"action": "object_ttl_expire",
+			"class":  s.index.Config.ClassName,
+			"shard":  s.name,
+			"count":  expired,
+		}).Debug("deleted expired objects")
+	}
+	if err != nil {
+		s.index.logger.WithField("action", "object_ttl_expire").
+			WithField("shard", s.name).
+			WithError(err).
+			Error("failed to delete expired objects")
+	}
+
+	return expired > 0
+}
+
+// expireObjectsCreatedBefore deletes all objects with a creation time (unix
+// millis) before threshold and returns how many were deleted
+func (s *Shard) expireObjectsCreatedBefore(ctx context.Context, threshold int64,
+	shouldAbort cyclemanager.ShouldAbortCallback,
+) (int, error) {
+	deleted := 0
+	var lastKey []byte
+	for !shouldAbort() {
+		keys, values := s.nextObjectsBatch(lastKey)
+		if len(keys) == 0 {
+			return deleted, nil
+		}
+		lastKey = keys[len(keys)-1]
+
+		docIDs := make([]uint64, 0, len(values))
+		for _, value := range values {
+			created, err := storobj.CreationTimeFromBinary(value)
+			if err != nil {
+				return deleted, err
+			}
+			if created >= threshold {
+				continue
+			}
+			docID, err := storobj.DocIDFromBinary(value)
+			if err != nil {
+				return deleted, err
+			}
+			docIDs = append(docIDs, docID)
+		}
+		if len(docIDs) == 0 {
+			continue
+		}
+
+		for _, res := range s.deleteObjectBatch(ctx, docIDs, false) {
+			if res.Err != nil {
+				return deleted, res.Err
+			}
+			deleted++
+		}
+	}
+
+	return deleted, nil
+}
@@ -0,0 +1,495 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+)
+
+// offloadBackupID is the "backup" of the backend all offloaded shards are
+// stored in
+const offloadBackupID = "offload"
+
+var errOffloadingDisabled = errors.New("no offload backend configured, " +
+	"set PERSISTENCE_OFFLOAD_BACKEND to freeze tenants")
+
+var errOffloadCanceled = errors.New("offload canceled, the shard was accessed")
+
+// OffloadBackendProvider resolves the backup backend which FROZEN shards are
+// offloaded to
+type OffloadBackendProvider interface {
+	BackupBackend(backend string) (modulecapabilities.BackupBackend, error)
+}
+
+// Offloader moves the files of FROZEN shards to an object storage, which
+// releases the local disk, and restores them before the shard is loaded
+// again. The backend is resolved lazily, as modules are initialized after the
+// DB.
+type Offloader struct {
+	backend  string
+	rootPath string
+	logger   logrus.FieldLogger
+
+	sync.Mutex
+	provider OffloadBackendProvider
+}
+
+func NewOffloader(backend, rootPath string, logger logrus.FieldLogger) *Offloader {
+	return &Offloader{backend: backend, rootPath: rootPath, logger: logger}
+}
+
+func (o *Offloader) SetBackendProvider(provider OffloadBackendProvider) {
+	o.Lock()
+	defer o.Unlock()
+
+	o.provider = provider
+}
+
+func (o *Offloader) store() (modulecapabilities.BackupBackend, error) {
+	if o == nil || o.backend == "" {
+		return nil, errOffloadingDisabled
+	}
+
+	o.Lock()
+	provider := o.provider
+	o.Unlock()
+
+	if provider == nil {
+		return nil, fmt.Errorf("offload backend %q is not initialized yet", o.backend)
+	}
+	return provider.BackupBackend(o.backend)
+}
+
+// frozenShard lists the files of an offloaded shard relative to the root
+// path. It is kept as a marker next to the shard until the files are
+// restored.
+type frozenShard struct {
+	Files []string `json:"files"`
+}
+
+func (o *Offloader) markerPath(shardID string) string {
+	return filepath.Join(o.rootPath, shardID+".frozen")
+}
+
+func offloadKey(node, file string) string {
+	return path.Join(node, filepath.ToSlash(file))
+}
+
+// offload uploads all files of a shard which is not loaded and then removes
+// them locally. The files are only removed once all of them are uploaded, so
+// a failed offload leaves the shard intact.
+func (o *Offloader) offload(ctx context.Context, node, shardID string) error {
+	frozen, err := o.upload(ctx, node, shardID)
+	if err != nil {
+		o.discard(node, shardID, frozen.Files)
+		return err
+	}
+	return o.freeze(shardID, frozen)
+}
+
+// upload uploads all files of a shard which is not loaded. It returns the
+// files uploaded so far, also if it fails. None are returned if the shard is
+// offloaded already or not held by this node.
+func (o *Offloader) upload(ctx context.Context, node, shardID string) (frozenShard, error) {
+	var frozen frozenShard
+
+	store, err := o.store()
+	if err != nil {
+		return frozen, err
+	}
+
+	if _, err := os.Stat(o.markerPath(shardID)); err == nil {
+		// already offloaded
+		return frozen, nil
+	}
+
+	entries, err := shardEntries(o.rootPath, shardID)
+	if err != nil {
+		return frozen, fmt.Errorf("list shard files: %w", err)
+	}
+
+	marker := filepath.Base(o.markerPath(shardID))
+	for _, entry := range entries {
+		if entry == marker {
+			continue
+		}
+
+		err := filepath.WalkDir(filepath.Join(o.rootPath, entry),
+			func(path string, d fs.DirEntry, err error) error {
+				if err != nil || d.IsDir() {
+					return err
+				}
+				rel, err := filepath.Rel(o.rootPath, path)
+				if err != nil {
+					return err
+				}
+				if err := o.uploadFile(ctx, store, node, rel); err != nil {
+					return fmt.Errorf("upload %s: %w", rel, err)
+				}
+				frozen.Files = append(frozen.Files, rel)
+				return nil
+			})
+		if err != nil {
+			return frozen, err
+		}
+	}
+
+	return frozen, nil
+}
+
+// freeze writes the marker of an uploaded shard and removes its local files.
+// A shard without uploaded files is not held by this node and left as is.
+func (o *Offloader) freeze(shardID string, frozen frozenShard) error {
+	if len(frozen.Files) == 0 {
+		return nil
+	}
+
+	entries, err := shardEntries(o.rootPath, shardID)
+	if err != nil {
+		return fmt.Errorf("list shard files: %w", err)
+	}
+
+	if err := o.writeMarker(shardID, frozen); err != nil {
+		return err
+	}
+
+	marker := filepath.Base(o.markerPath(shardID))
+	for _, entry := range entries {
+		if entry == marker {
+			continue
+		}
+		if err := os.RemoveAll(filepath.Join(o.rootPath, entry)); err != nil {
+			return fmt.Errorf("remove offloaded %s: %w", entry, err)
+		}
+	}
+
+	return nil
+}
+
+func (o *Offloader) uploadFile(ctx context.Context, store modulecapabilities.BackupBackend,
+	node, rel string,
+) error {
+	f, err := os.Open(filepath.Join(o.rootPath, rel))
+	if err != nil {
+		return err
+	}
+
+	// Write closes the file
+	_, err = store.Write(ctx, offloadBackupID, offloadKey(node, rel), f)
+	return err
+}
+
+func (o *Offloader) writeMarker(shardID string, frozen frozenShard) error {
+	data, err := json.Marshal(frozen)
+	if err != nil {
+		return fmt.Errorf("marshal frozen shard: %w", err)
+	}
+
+	// written to a temporary file first, so that a crash never leaves a
+	// partial list of files behind
+	tmp := o.markerPath(shardID) + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o666); err != nil {
+		return fmt.Errorf("write frozen shard marker: %w", err)
+	}
+	if err := os.Rename(tmp, o.markerPath(shardID)); err != nil {
+		return fmt.Errorf("write frozen shard marker: %w", err)
+	}
+	return nil
+}
+
+// restore downloads the files of an offloaded shard. It reports false if the
+// shard was not offloaded by this node.
+func (o *Offloader) restore(ctx context.Context, node, shardID string) (bool, error) {
+	data, err := os.ReadFile(o.markerPath(shardID))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return false, nil
+		}
+		return false, fmt.Errorf("read frozen shard marker: %w", err)
+	}
+
+	var frozen frozenShard
+	if err := json.Unmarshal(data, &frozen); err != nil {
+		return false, fmt.Errorf("unmarshal frozen shard marker: %w", err)
+	}
+
+	store, err := o.store()
+	if err != nil {
+		return false, err
+	}
+
+	for _, rel := range frozen.Files {
+		if err := o.downloadFile(ctx, store, node, rel); err != nil {
+			return false, fmt.Errorf("download %s: %w", rel, err)
+		}
+	}
+
+	if err := os.Remove(o.markerPath(shardID)); err != nil {
+		return false, fmt.Errorf("remove frozen shard marker: %w", err)
+	}
+
+	// the shard is written to again, so the offloaded files are outdated
+	o.discard(node, shardID, frozen.Files)
+	return true, nil
+}
+
+func (o *Offloader) downloadFile(ctx context.Context, store modulecapabilities.BackupBackend,
+	node, rel string,
+) error {
+	dest := filepath.Join(o.rootPath, rel)
+	if err := os.MkdirAll(filepath.Dir(dest), os.ModePerm); err != nil {
+		return err
+	}
+
+	f, err := os.Create(dest)
+	if err != nil {
+		return err
+	}
+
+	// Read closes the file
+	_, err = store.Read(ctx, offloadBackupID, offloadKey(node, rel), f)
+	return err
+}
+
+// isOffloaded reports whether the files of the shard are held by the backend
+func (o *Offloader) isOffloaded(shardID string) bool {
+	if o == nil {
+		return false
+	}
+	_, err := os.Stat(o.markerPath(shardID))
+	return err == nil
+}
+
+// drop removes the offloaded files of a shard and forgets about it, e.g.
+// because its tenant was deleted
+func (o *Offloader) drop(node, shardID string) error {
+	if o == nil {
+		return nil
+	}
+
+	data, err := os.ReadFile(o.markerPath(shardID))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+		return fmt.Errorf("read frozen shard marker: %w", err)
+	}
+
+	var frozen frozenShard
+	if err := json.Unmarshal(data, &frozen); err != nil {
+		return fmt.Errorf("unmarshal frozen shard marker: %w", err)
+	}
+	o.discard(node, shardID, frozen.Files)
+
+	if err := os.Remove(o.markerPath(shardID)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// discard removes offloaded files from the backend. Files which cannot be
+// removed are only logged, they are overwritten when the shard is offloaded
+// again.
+func (o *Offloader) discard(node, shardID string, files []string) {
+	if len(files) == 0 {
+		return
+	}
+
+	store, err := o.store()
+	if err == nil {
+		// runs to completion, even if the request which triggered it ends
+		ctx := context.Background()
+		for _, rel := range files {
+			if e := store.Delete(ctx, offloadBackupID, offloadKey(node, rel)); e != nil {
+				err = errors.Join(err, fmt.Errorf("delete %s: %w", rel, e))
+			}
+		}
+	}
+	if err != nil {
+		o.logger.WithField("action", "discard_offloaded_shard").
+			WithField("shard", shardID).
+			Warnf("cannot remove offloaded files from the backend: %s", err)
+	}
+}
+
+func (i *Index) shardID(name string) string {
+	return fmt.Sprintf("%s_%s", i.ID(), name)
+}
+
+// pendingOffload is an offload running in the background
+type pendingOffload struct {
+	cancel context.CancelFunc
+}
+
+// scheduleOffload moves the files of a shard of a FROZEN tenant, which has
+// been shut down, to the offload backend in the background. The tenant stays
+// reachable meanwhile: accessing the shard cancels the offload and loads it
+// from its local files, which are also kept if the offload fails.
+func (i *Index) scheduleOffload(name string) {
+	ctx, cancel := context.WithCancel(context.Background())
+	pending := &pendingOffload{cancel: cancel}
+
+	i.frozenShardsLock.Lock()
+	i.cancelOffload(name)
+	if i.pendingOffloads == nil {
+		i.pendingOffloads = map[string]*pendingOffload{}
+	}
+	i.pendingOffloads[name] = pending
+	i.frozenShardsLock.Unlock()
+
+	go func() {
+		defer func() {
+			i.frozenShardsLock.Lock()
+			if i.pendingOffloads[name] == pending {
+				delete(i.pendingOffloads, name)
+			}
+			i.frozenShardsLock.Unlock()
+			cancel()
+		}()
+
+		if err := i.offloadShard(ctx, name, pending); err != nil {
+			i.logger.WithField("action", "offload_shard").
+				WithField("shard", i.shardID(name)).
+				Errorf("cannot offload shard %q, keeping its local files: %s", name, err)
+		}
+	}()
+}
+
+// offloadShard uploads the files of a shard without holding the lock, so
+// that the shard can be accessed meanwhile. They are only removed locally if
+// the offload was not canceled in the meantime.
+func (i *Index) offloadShard(ctx context.Context, name string, pending *pendingOffload) error {
+	node, shardID := i.getSchema.NodeName(), i.shardID(name)
+
+	frozen, err := i.Config.Offloader.upload(ctx, node, shardID)
+	if err == nil {
+		err = i.freezeShard(ctx, name, pending, frozen)
+	}
+	if err != nil {
+		i.Config.Offloader.discard(node, shardID, frozen.Files)
+		return err
+	}
+	return nil
+}
+
+func (i *Index) freezeShard(ctx context.Context, name string, pending *pendingOffload,
+	frozen frozenShard,
+) error {
+	i.frozenShardsLock.Lock()
+	defer i.frozenShardsLock.Unlock()
+
+	if i.pendingOffloads[name] != pending {
+		return errOffloadCanceled
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return i.Config.Offloader.freeze(i.shardID(name), frozen)
+}
+
+// cancelOffload cancels a pending offload of a shard, the caller must hold
+// frozenShardsLock
+func (i *Index) cancelOffload(name string) {
+	if pending, ok := i.pendingOffloads[name]; ok {
+		pending.cancel()
+		delete(i.pendingOffloads, name)
+	}
+}
+
+// cancelOffloads cancels all pending offloads, e.g. on shutdown
+func (i *Index) cancelOffloads() {
+	i.frozenShardsLock.Lock()
+	defer i.frozenShardsLock.Unlock()
+
+	for name := range i.pendingOffloads {
+		i.cancelOffload(name)
+	}
+}
+
+// restoreShard downloads the files of an offloaded shard without loading it
+func (i *Index) restoreShard(ctx context.Context, name string) error {
+	i.frozenShardsLock.Lock()
+	defer i.frozenShardsLock.Unlock()
+
+	i.cancelOffload(name)
+	if !i.Config.Offloader.isOffloaded(i.shardID(name)) {
+		return nil
+	}
+	_, err := i.Config.Offloader.restore(ctx, i.getSchema.NodeName(), i.shardID(name))
+	return err
+}
+
+// dropOffloadedShard cancels a pending offload of a shard and removes its
+// offloaded files
+func (i *Index) dropOffloadedShard(name string) error {
+	i.frozenShardsLock.Lock()
+	defer i.frozenShardsLock.Unlock()
+
+	i.cancelOffload(name)
+	return i.Config.Offloader.drop(i.getSchema.NodeName(), i.shardID(name))
+}
+
+// loadFrozenShard restores and loads a shard of a FROZEN tenant on its first
+// access. A shard which has not been offloaded, because the offload is still
+// pending or has failed, is loaded from its local files. It returns nil if
+// the shard is not held by this node.
+func (i *Index) loadFrozenShard(ctx context.Context, name string) (*Shard, error) {
+	i.frozenShardsLock.Lock()
+	defer i.frozenShardsLock.Unlock()
+
+	if shard := i.shards.Load(name); shard != nil {
+		// loaded concurrently
+		return shard, nil
+	}
+
+	i.cancelOffload(name)
+	if i.Config.Offloader.isOffloaded(i.shardID(name)) {
+		if _, err := i.Config.Offloader.restore(ctx, i.getSchema.NodeName(), i.shardID(name)); err != nil {
+			return nil, fmt.Errorf("restore frozen shard %q: %w", name, err)
+		}
+	} else {
+		entries, err := shardEntries(i.Config.RootPath, i.shardID(name))
+		if err != nil {
+			return nil, fmt.Errorf("list files of frozen shard %q: %w", name, err)
+		}
+		if len(entries) == 0 {
+			return nil, nil
+		}
+	}
+
+	sch := i.getSchema.GetSchemaSkipAuth()
+	class := sch.GetClass(i.Config.ClassName)
+	if class == nil {
+		return nil, fmt.Errorf("class %s not found", i.Config.ClassName)
+	}
+	shard, err := NewShard(ctx, i.promMetrics, name, i, class, i.centralJobQueue)
+	if err != nil {
+		return nil, fmt.Errorf("load frozen shard %q: %w", name, err)
+	}
+
+	i.shards.Store(name, shard)
+	i.logger.WithField("action", "load_frozen_shard").
+		WithField("shard", shard.ID()).
+		Info("loaded shard of frozen tenant")
+	return shard, nil
+}
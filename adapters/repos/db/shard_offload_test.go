@@ -0,0 +1,286 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/entities/schema"
+	schemaUC "github.com/weaviate/weaviate/usecases/schema"
+)
+
+const offloadTestShardID = "class_tenant"
+
+func createOffloadTestShard(t *testing.T, dir string) map[string]string {
+	shardID := offloadTestShardID
+	files := map[string]string{
+		shardID + "_lsm/objects/segment-1.db":  "objects",
+		shardID + "_lsm/property_name/x.bloom": "bloom",
+		shardID + ".hnsw.commitlog.d/1":        "commitlog",
+		shardID + ".version":                   "2",
+		shardID + ".indexcount":                "7",
+	}
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		require.Nil(t, os.MkdirAll(filepath.Dir(path), os.ModePerm))
+		require.Nil(t, os.WriteFile(path, []byte(content), os.ModePerm))
+	}
+	// belongs to another shard with the same prefix
+	require.Nil(t, os.WriteFile(filepath.Join(dir, "class_tenant2.version"),
+		[]byte("2"), os.ModePerm))
+	return files
+}
+
+func TestOffloader(t *testing.T) {
+	ctx := context.Background()
+	shardID := offloadTestShardID
+	logger, _ := test.NewNullLogger()
+	createShard := createOffloadTestShard
+
+	t.Run("disabled", func(t *testing.T) {
+		dir := t.TempDir()
+		createShard(t, dir)
+
+		o := NewOffloader("", dir, logger)
+		assert.ErrorIs(t, o.offload(ctx, "node1", shardID), errOffloadingDisabled)
+		assert.False(t, o.isOffloaded(shardID))
+
+		var nilOffloader *Offloader
+		assert.False(t, nilOffloader.isOffloaded(shardID))
+		assert.Nil(t, nilOffloader.drop("node1", shardID))
+	})
+
+	t.Run("offload and restore", func(t *testing.T) {
+		dir := t.TempDir()
+		files := createShard(t, dir)
+		backend := newFakeOffloadBackend()
+
+		o := NewOffloader("fake", dir, logger)
+		o.SetBackendProvider(fakeOffloadProvider{backend})
+
+		require.Nil(t, o.offload(ctx, "node1", shardID))
+		assert.True(t, o.isOffloaded(shardID))
+
+		entries, err := shardEntries(dir, shardID)
+		require.Nil(t, err)
+		assert.Equal(t, []string{shardID + ".frozen"}, entries)
+		assert.FileExists(t, filepath.Join(dir, "class_tenant2.version"))
+		assert.Len(t, backend.objects, len(files))
+
+		// offloading again is a no-op
+		require.Nil(t, o.offload(ctx, "node1", shardID))
+
+		restored, err := o.restore(ctx, "node1", shardID)
+		require.Nil(t, err)
+		assert.True(t, restored)
+		assert.False(t, o.isOffloaded(shardID))
+		assert.Empty(t, backend.objects)
+
+		for name, content := range files {
+			data, err := os.ReadFile(filepath.Join(dir, name))
+			require.Nil(t, err)
+			assert.Equal(t, content, string(data))
+		}
+
+		restored, err = o.restore(ctx, "node1", shardID)
+		require.Nil(t, err)
+		assert.False(t, restored)
+	})
+
+	t.Run("failed upload keeps local files", func(t *testing.T) {
+		dir := t.TempDir()
+		files := createShard(t, dir)
+		backend := newFakeOffloadBackend()
+		backend.failWrites = true
+
+		o := NewOffloader("fake", dir, logger)
+		o.SetBackendProvider(fakeOffloadProvider{backend})
+
+		assert.NotNil(t, o.offload(ctx, "node1", shardID))
+		assert.False(t, o.isOffloaded(shardID))
+		for name := range files {
+			assert.FileExists(t, filepath.Join(dir, name))
+		}
+	})
+
+	t.Run("shard not held by node", func(t *testing.T) {
+		o := NewOffloader("fake", t.TempDir(), logger)
+		o.SetBackendProvider(fakeOffloadProvider{newFakeOffloadBackend()})
+
+		require.Nil(t, o.offload(ctx, "node1", shardID))
+		assert.False(t, o.isOffloaded(shardID))
+	})
+
+	t.Run("drop", func(t *testing.T) {
+		dir := t.TempDir()
+		createShard(t, dir)
+		backend := newFakeOffloadBackend()
+
+		o := NewOffloader("fake", dir, logger)
+		o.SetBackendProvider(fakeOffloadProvider{backend})
+
+		require.Nil(t, o.offload(ctx, "node1", shardID))
+		require.Nil(t, o.drop("node1", shardID))
+		assert.False(t, o.isOffloaded(shardID))
+		assert.Empty(t, backend.objects)
+		require.Nil(t, o.drop("node1", shardID))
+	})
+}
+
+func TestIndex_OffloadShard(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+
+	newIndex := func(t *testing.T, backend *fakeOffloadBackend) (*Index, string) {
+		dir := t.TempDir()
+		o := NewOffloader("fake", dir, logger)
+		o.SetBackendProvider(fakeOffloadProvider{backend})
+		return &Index{
+			Config: IndexConfig{
+				ClassName: schema.ClassName("Class"),
+				RootPath:  dir,
+				Offloader: o,
+			},
+			getSchema: offloadTestSchemaGetter{},
+			logger:    logger,
+		}, dir
+	}
+	pend := func(idx *Index, name string) *pendingOffload {
+		pending := &pendingOffload{cancel: func() {}}
+		idx.pendingOffloads = map[string]*pendingOffload{name: pending}
+		return pending
+	}
+
+	t.Run("offload", func(t *testing.T) {
+		backend := newFakeOffloadBackend()
+		idx, dir := newIndex(t, backend)
+		files := createOffloadTestShard(t, dir)
+
+		require.Nil(t, idx.offloadShard(ctx, "tenant", pend(idx, "tenant")))
+		assert.True(t, idx.Config.Offloader.isOffloaded(offloadTestShardID))
+		assert.Len(t, backend.objects, len(files))
+	})
+
+	t.Run("accessing the shard cancels the offload", func(t *testing.T) {
+		backend := newFakeOffloadBackend()
+		idx, dir := newIndex(t, backend)
+		files := createOffloadTestShard(t, dir)
+		pending := pend(idx, "tenant")
+		backend.onWrite = func() {
+			idx.frozenShardsLock.Lock()
+			idx.cancelOffload("tenant")
+			idx.frozenShardsLock.Unlock()
+		}
+
+		err := idx.offloadShard(ctx, "tenant", pending)
+		assert.ErrorIs(t, err, errOffloadCanceled)
+		assert.False(t, idx.Config.Offloader.isOffloaded(offloadTestShardID))
+		assert.Empty(t, backend.objects)
+		for name := range files {
+			assert.FileExists(t, filepath.Join(dir, name))
+		}
+	})
+
+	t.Run("failed offload keeps the local files", func(t *testing.T) {
+		backend := newFakeOffloadBackend()
+		backend.failWrites = true
+		idx, dir := newIndex(t, backend)
+		files := createOffloadTestShard(t, dir)
+
+		assert.NotNil(t, idx.offloadShard(ctx, "tenant", pend(idx, "tenant")))
+		assert.False(t, idx.Config.Offloader.isOffloaded(offloadTestShardID))
+		for name := range files {
+			assert.FileExists(t, filepath.Join(dir, name))
+		}
+	})
+}
+
+type offloadTestSchemaGetter struct {
+	schemaUC.SchemaGetter
+}
+
+func (offloadTestSchemaGetter) NodeName() string {
+	return "node1"
+}
+
+// fakeOffloadBackend keeps objects in memory. It only implements the stream
+// methods used by the Offloader.
+type fakeOffloadBackend struct {
+	modulecapabilities.BackupBackend
+
+	sync.Mutex
+	objects    map[string][]byte
+	failWrites bool
+	onWrite    func()
+}
+
+func newFakeOffloadBackend() *fakeOffloadBackend {
+	return &fakeOffloadBackend{objects: map[string][]byte{}}
+}
+
+type fakeOffloadProvider struct {
+	backend *fakeOffloadBackend
+}
+
+func (p fakeOffloadProvider) BackupBackend(string) (modulecapabilities.BackupBackend, error) {
+	return p.backend, nil
+}
+
+func (f *fakeOffloadBackend) Write(ctx context.Context, backupID, key string, r io.ReadCloser) (int64, error) {
+	defer r.Close()
+	if f.failWrites {
+		return 0, fmt.Errorf("write failed")
+	}
+	if f.onWrite != nil {
+		f.onWrite()
+	}
+
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return 0, err
+	}
+
+	f.Lock()
+	defer f.Unlock()
+	f.objects[backupID+"/"+key] = data
+	return int64(len(data)), nil
+}
+
+func (f *fakeOffloadBackend) Read(ctx context.Context, backupID, key string, w io.WriteCloser) (int64, error) {
+	defer w.Close()
+
+	f.Lock()
+	data, ok := f.objects[backupID+"/"+key]
+	f.Unlock()
+	if !ok {
+		return 0, fmt.Errorf("object %q not found", key)
+	}
+	return io.Copy(w, bytes.NewReader(data))
+}
+
+func (f *fakeOffloadBackend) Delete(ctx context.Context, backupID, key string) error {
+	f.Lock()
+	defer f.Unlock()
+	delete(f.objects, backupID+"/"+key)
+	return nil
+}
@@ -0,0 +1,254 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"bytes"
+	"context"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+// number of objects which are read from the objects bucket at once while
+// rewriting them. The cursor holds a flush lock, so it is closed in between
+const renamePropertyBatchSize = 1000
+
+// renamedProperties keeps the previous names of renamed properties by their
+// new name until the payloads of all stored objects have been rewritten.
+// Reads look up the property under its previous names as well, so it does
+// not go missing for objects which have not been rewritten yet.
+type renamedProperties struct {
+	sync.RWMutex
+	previousNames map[string][]string
+}
+
+// add registers the rename of propName. If propName was renamed itself and
+// its objects have not all been rewritten, its previous names are kept.
+func (r *renamedProperties) add(propName, newName string) {
+	r.Lock()
+	defer r.Unlock()
+
+	if r.previousNames == nil {
+		r.previousNames = map[string][]string{}
+	}
+	names := append([]string{propName}, r.previousNames[propName]...)
+	delete(r.previousNames, propName)
+	r.previousNames[newName] = names
+}
+
+func (r *renamedProperties) remove(newName string) {
+	r.Lock()
+	defer r.Unlock()
+
+	delete(r.previousNames, newName)
+}
+
+// of returns the previous names of a property, newest first
+func (r *renamedProperties) of(name string) []string {
+	r.RLock()
+	defer r.RUnlock()
+
+	return r.previousNames[name]
+}
+
+// all returns a copy of the previous names by the new name of the
+// properties, it is nil if there are no pending renames
+func (r *renamedProperties) all() map[string][]string {
+	r.RLock()
+	defer r.RUnlock()
+
+	if len(r.previousNames) == 0 {
+		return nil
+	}
+	out := make(map[string][]string, len(r.previousNames))
+	for name, previous := range r.previousNames {
+		out[name] = previous
+	}
+	return out
+}
+
+// resolveRenamedProperties moves the values of renamed properties, which
+// are still stored under one of their previous names, to the current name
+func (s *Shard) resolveRenamedProperties(objs ...*storobj.Object) {
+	previousNames := s.renamedProperties.all()
+	if previousNames == nil {
+		return
+	}
+
+	for _, obj := range objs {
+		if obj == nil {
+			continue
+		}
+		props, ok := obj.Properties().(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for name, previous := range previousNames {
+			movePreviousProperty(props, name, previous)
+		}
+	}
+}
+
+// movePreviousProperty moves the value of the first of previousNames which
+// is present in props to name, unless props already contains name. It
+// returns whether props were changed.
+func movePreviousProperty(props map[string]interface{}, name string,
+	previousNames []string,
+) bool {
+	changed := false
+	for _, previous := range previousNames {
+		value, ok := props[previous]
+		if !ok {
+			continue
+		}
+		if _, ok := props[name]; !ok {
+			props[name] = value
+		}
+		delete(props, previous)
+		changed = true
+	}
+	return changed
+}
+
+// renamePropertyIndexes moves all inverted index buckets and the tracked
+// property lengths of a property to the new name. The buckets are renamed on
+// disk, so the indexed data is retained. Until renamePropertyInObjects has
+// finished, reads also look up the property under its previous name.
+func (s *Shard) renamePropertyIndexes(ctx context.Context, propName, newName string) error {
+	bucketNames := []func(string) string{
+		helpers.BucketFromPropNameLSM,
+		helpers.BucketSearchableFromPropNameLSM,
+		helpers.BucketSparseVectorFromPropNameLSM,
+		helpers.BucketRangeableFromPropNameLSM,
+		helpers.BucketFromPropNameLengthLSM,
+		helpers.BucketFromPropNameNullLSM,
+		helpers.BucketFromPropNameMetaCountLSM,
+	}
+
+	for _, bucketName := range bucketNames {
+		if s.store.Bucket(bucketName(propName)) == nil {
+			continue
+		}
+		if err := s.store.RenameBucket(ctx, bucketName(propName), bucketName(newName)); err != nil {
+			return errors.Wrapf(err, "rename bucket of property '%s'", propName)
+		}
+	}
+
+	s.propLengths.RenameProperty(propName, newName)
+	if err := s.propLengths.Flush(false); err != nil {
+		return errors.Wrap(err, "flush prop length tracker")
+	}
+
+	s.renamedProperties.add(propName, newName)
+	return nil
+}
+
+// renamePropertyInObjects rewrites all stored objects, so that their payload
+// contains the property under its new name. onProgress is called with the
+// number of objects processed after each batch. Writes must be blocked while
+// this runs, otherwise the inverted index would be updated based on outdated
+// objects.
+func (s *Shard) renamePropertyInObjects(ctx context.Context, propName, newName string,
+	onProgress func(processed int),
+) error {
+	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
+	if bucket == nil {
+		return errors.Errorf("objects bucket not found")
+	}
+
+	// objects may still contain the property under an older name, if a
+	// previous rename of it did not finish
+	previousNames := s.renamedProperties.of(newName)
+	if len(previousNames) == 0 {
+		previousNames = []string{propName}
+	}
+
+	var lastKey []byte
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		keys, values := s.nextObjectsBatch(lastKey)
+		if len(keys) == 0 {
+			s.renamedProperties.remove(newName)
+			return nil
+		}
+
+		for i := range keys {
+			if err := s.renamePropertyInObject(keys[i], values[i], newName, previousNames); err != nil {
+				s.bumpDataVersion()
+				return errors.Wrapf(err, "object %s", keys[i])
+			}
+		}
+		// the payloads of the objects changed
+		s.bumpDataVersion()
+
+		lastKey = keys[len(keys)-1]
+		onProgress(len(keys))
+	}
+}
+
+// nextObjectsBatch returns copies of the keys and values of the objects
+// following after. A nil after starts at the first object.
+func (s *Shard) nextObjectsBatch(after []byte) ([][]byte, [][]byte) {
+	cursor := s.store.Bucket(helpers.ObjectsBucketLSM).Cursor()
+	defer cursor.Close()
+
+	var k, v []byte
+	if after == nil {
+		k, v = cursor.First()
+	} else {
+		k, v = cursor.Seek(after)
+		if k != nil && bytes.Equal(k, after) {
+			k, v = cursor.Next()
+		}
+	}
+
+	keys := make([][]byte, 0, renamePropertyBatchSize)
+	values := make([][]byte, 0, renamePropertyBatchSize)
+	for ; k != nil && len(keys) < renamePropertyBatchSize; k, v = cursor.Next() {
+		keys = append(keys, append([]byte{}, k...))
+		values = append(values, append([]byte{}, v...))
+	}
+
+	return keys, values
+}
+
+func (s *Shard) renamePropertyInObject(key, data []byte, newName string,
+	previousNames []string,
+) error {
+	obj, err := storobj.FromBinary(data)
+	if err != nil {
+		return errors.Wrap(err, "unmarshal object")
+	}
+
+	props, ok := obj.Properties().(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	if !movePreviousProperty(props, newName, previousNames) {
+		return nil
+	}
+	obj.SetProperties(props)
+
+	updated, err := obj.MarshalBinary()
+	if err != nil {
+		return errors.Wrap(err, "marshal object")
+	}
+
+	return s.upsertObjectDataLSM(s.store.Bucket(helpers.ObjectsBucketLSM), key,
+		updated, obj.DocID())
+}
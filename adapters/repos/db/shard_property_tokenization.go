@@ -0,0 +1,198 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+// number of objects after which the progress of a reindex is reported
+const retokenizeProgressInterval = 1000
+
+// retokenizeBucketName is the name of the bucket the index of a property is
+// rebuilt into. It contains a character which is not allowed in property
+// names, so it can never clash with the buckets of another property.
+func retokenizeBucketName(bucketName string) string {
+	return bucketName + ".retokenize"
+}
+
+func retokenizePropLengthName(propName string) string {
+	return propName + ".retokenize"
+}
+
+// tokenizationBuckets returns the names of the existing buckets of a property
+// whose contents depend on its tokenization
+func (s *Shard) tokenizationBuckets(propName string) []string {
+	var bucketNames []string
+	for _, bucketName := range []string{
+		helpers.BucketFromPropNameLSM(propName),
+		helpers.BucketSearchableFromPropNameLSM(propName),
+	} {
+		if s.store.Bucket(bucketName) != nil {
+			bucketNames = append(bucketNames, bucketName)
+		}
+	}
+	return bucketNames
+}
+
+// reindexPropertyTokenization builds the filterable and searchable index of
+// prop with its tokenization into temporary buckets. The current index is
+// left untouched, the temporary buckets are swapped in with
+// commitPropertyTokenization or removed with abortPropertyTokenization.
+// onProgress is called with the number of objects processed. Writes must be
+// blocked while this runs, otherwise the temporary buckets would miss them.
+func (s *Shard) reindexPropertyTokenization(ctx context.Context, prop *models.Property,
+	onProgress func(processed int),
+) error {
+	bucketNames := s.tokenizationBuckets(prop.Name)
+	if len(bucketNames) == 0 {
+		return nil
+	}
+
+	// a previous attempt might have been interrupted by a restart
+	if err := s.abortPropertyTokenization(ctx, prop.Name); err != nil {
+		return err
+	}
+
+	for _, bucketName := range bucketNames {
+		bucketOpts := []lsmkv.BucketOption{
+			s.memtableIdleConfig(),
+			s.dynamicMemtableSizing(),
+			lsmkv.WithPread(s.index.Config.AvoidMMap),
+			lsmkv.WithTermDictionary(true),
+		}
+		if bucketName == helpers.BucketSearchableFromPropNameLSM(prop.Name) {
+			bucketOpts = append(bucketOpts, lsmkv.WithStrategy(lsmkv.StrategyMapCollection))
+			if s.versioner.Version() < 2 {
+				bucketOpts = append(bucketOpts, lsmkv.WithLegacyMapSorting())
+			}
+		} else {
+			bucketOpts = append(bucketOpts, lsmkv.WithStrategy(lsmkv.StrategyRoaringSet))
+		}
+
+		if err := s.store.CreateOrLoadBucket(ctx, retokenizeBucketName(bucketName),
+			bucketOpts...); err != nil {
+			return errors.Wrapf(err, "create temporary bucket of '%s'", bucketName)
+		}
+	}
+
+	analyzer := inverted.NewAnalyzer(s.isFallbackToSearchable)
+	processed := 0
+	err := s.store.Bucket(helpers.ObjectsBucketLSM).IterateObjects(ctx, func(object *storobj.Object) error {
+		if processed%retokenizeProgressInterval == 0 && processed != 0 {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			onProgress(retokenizeProgressInterval)
+		}
+		processed++
+
+		props, ok := object.Properties().(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		value, ok := props[prop.Name]
+		if !ok {
+			return nil
+		}
+
+		analyzed, err := analyzer.Object(map[string]interface{}{prop.Name: value},
+			[]*models.Property{prop}, object.ID())
+		if err != nil {
+			return errors.Wrap(err, "analyze object")
+		}
+		for _, property := range analyzed {
+			if property.Name != prop.Name {
+				continue
+			}
+			if err := s.addToRetokenizedIndex(object.DocID(), property); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	onProgress(processed % retokenizeProgressInterval)
+	return nil
+}
+
+func (s *Shard) addToRetokenizedIndex(docID uint64, property inverted.Property) error {
+	if property.HasFilterableIndex {
+		bucket := s.store.Bucket(retokenizeBucketName(helpers.BucketFromPropNameLSM(property.Name)))
+		for _, item := range property.Items {
+			if err := s.addToPropertySetBucket(bucket, docID, item.Data); err != nil {
+				return errors.Wrapf(err, "failed adding to prop '%s' value bucket", property.Name)
+			}
+		}
+	}
+
+	if property.HasSearchableIndex {
+		bucket := s.store.Bucket(retokenizeBucketName(helpers.BucketSearchableFromPropNameLSM(property.Name)))
+		propLen := float32(len(property.Items))
+		for _, item := range property.Items {
+			pair := s.pairPropertyWithFrequency(docID, item.TermFrequency, propLen, item.Positions)
+			if err := s.addToPropertyMapBucket(bucket, pair, item.Data); err != nil {
+				return errors.Wrapf(err, "failed adding to prop '%s' searchable bucket", property.Name)
+			}
+		}
+		if err := s.propLengths.TrackProperty(retokenizePropLengthName(property.Name), propLen); err != nil {
+			return errors.Wrap(err, "track property length")
+		}
+	}
+
+	return nil
+}
+
+// commitPropertyTokenization replaces the index of a property with the one
+// built by reindexPropertyTokenization
+func (s *Shard) commitPropertyTokenization(ctx context.Context, propName string) error {
+	for _, bucketName := range s.tokenizationBuckets(propName) {
+		if err := s.store.ReplaceBuckets(ctx, bucketName, retokenizeBucketName(bucketName)); err != nil {
+			return errors.Wrapf(err, "replace bucket '%s'", bucketName)
+		}
+	}
+
+	if s.store.Bucket(helpers.BucketSearchableFromPropNameLSM(propName)) != nil {
+		s.propLengths.RenameProperty(retokenizePropLengthName(propName), propName)
+		if err := s.propLengths.Flush(false); err != nil {
+			return errors.Wrap(err, "flush prop length tracker")
+		}
+	}
+
+	return nil
+}
+
+// abortPropertyTokenization removes everything built by
+// reindexPropertyTokenization, the current index is kept
+func (s *Shard) abortPropertyTokenization(ctx context.Context, propName string) error {
+	for _, bucketName := range []string{
+		helpers.BucketFromPropNameLSM(propName),
+		helpers.BucketSearchableFromPropNameLSM(propName),
+	} {
+		if err := s.store.DropBucket(ctx, retokenizeBucketName(bucketName)); err != nil {
+			return errors.Wrapf(err, "drop temporary bucket of '%s'", bucketName)
+		}
+	}
+
+	s.propLengths.DeleteProperty(retokenizePropLengthName(propName))
+	return nil
+}
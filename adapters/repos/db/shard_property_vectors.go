@@ -0,0 +1,180 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/priorityqueue"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+// propertyVectorTarget returns the property of type vector or vector[] a
+// vector search on targetVector is performed on, or nil if targetVector is
+// the class-level vector or a named vector space of the class
+func (s *Shard) propertyVectorTarget(targetVector string) (*models.Property, error) {
+	if targetVector == "" {
+		return nil, nil
+	}
+	if _, ok := s.targetVectorIndexes[targetVector]; ok {
+		return nil, nil
+	}
+
+	class, err := schema.GetClassByName(s.index.getSchema.GetSchemaSkipAuth().Objects,
+		s.index.Config.ClassName.String())
+	if err != nil {
+		return nil, err
+	}
+	prop, err := schema.GetPropertyByName(class, targetVector)
+	if err != nil || !schema.IsVectorDataType(prop.DataType) {
+		// not a property vector, let the lookup of the named vector space fail
+		return nil, nil
+	}
+	return prop, nil
+}
+
+// searchByPropertyVector is the vector index search for properties of type
+// vector and vector[]. Property vectors are not indexed, so the search is
+// brute force: all objects of the shard are scanned and compared using the
+// distance metric of the class vector index, its cost grows linearly with
+// the number of objects on every query. Only the searched property is
+// decoded. The distance of an object with a vector[] property is the
+// distance of its closest vector. If k is negative, all objects within
+// maxDist are returned, up to the maximum number of query results.
+func (s *Shard) searchByPropertyVector(ctx context.Context, prop *models.Property,
+	searchVector []float32, k int, maxDist float32, allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	distProv, err := distancerProviderFromName(s.index.vectorIndexUserConfig.DistanceName())
+	if err != nil {
+		return nil, nil, err
+	}
+	normalize := distProv.Type() == "cosine-dot"
+	if normalize {
+		searchVector = distancer.Normalize(searchVector)
+	}
+
+	byDistance := k < 0
+	if byDistance {
+		k = int(s.index.Config.QueryMaximumResults)
+	}
+	if k == 0 {
+		return nil, nil, nil
+	}
+
+	cursor := s.store.Bucket(helpers.ObjectsBucketLSM).Cursor()
+	defer cursor.Close()
+
+	// objects which were not migrated yet store a renamed property under one
+	// of its previous names
+	propNames := append([]string{prop.Name}, s.renamedProperties.of(prop.Name)...)
+
+	results := priorityqueue.NewMax(k)
+	i := 0
+	for key, val := cursor.First(); key != nil; key, val = cursor.Next() {
+		if i%1000 == 0 {
+			if err := ctx.Err(); err != nil {
+				return nil, nil, err
+			}
+		}
+		i++
+
+		docID, err := storobj.DocIDFromBinary(val)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "unmarshal doc id of object %d", i)
+		}
+		if allow != nil && !allow.Contains(docID) {
+			continue
+		}
+
+		vectors, err := propertyVectorsFromBinary(val, propNames)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "property %q of object %d",
+				prop.Name, docID)
+		}
+		dist, ok, err := propertyVectorDistance(distProv, vectors, searchVector, normalize)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "property %q of object %d",
+				prop.Name, docID)
+		}
+		if !ok || (byDistance && dist > maxDist) {
+			continue
+		}
+		insertPropertyVectorResult(results, k, docID, dist)
+	}
+
+	ids := make([]uint64, results.Len())
+	dists := make([]float32, results.Len())
+	for j := len(ids) - 1; j >= 0; j-- {
+		item := results.Pop()
+		ids[j] = item.ID
+		dists[j] = item.Dist
+	}
+	return ids, dists, nil
+}
+
+// propertyVectorsFromBinary decodes the vectors of the first of propNames
+// which is set in the binary object
+func propertyVectorsFromBinary(data []byte, propNames []string) ([][]float64, error) {
+	for _, name := range propNames {
+		vectors, ok, err := storobj.ParseAndExtractVectorProp(data, name)
+		if err != nil || ok {
+			return vectors, err
+		}
+	}
+	return nil, nil
+}
+
+// propertyVectorDistance returns the smallest distance of the vectors of a
+// property to searchVector. It returns false if there is no vector of the
+// same dimensions.
+func propertyVectorDistance(distProv distancer.Provider, vectors [][]float64,
+	searchVector []float32, normalize bool,
+) (float32, bool, error) {
+	var (
+		best  float32
+		found bool
+	)
+	for _, vector := range vectors {
+		if len(vector) != len(searchVector) {
+			continue
+		}
+		candidate := make([]float32, len(vector))
+		for i := range vector {
+			candidate[i] = float32(vector[i])
+		}
+		if normalize {
+			candidate = distancer.Normalize(candidate)
+		}
+		dist, _, err := distProv.SingleDist(searchVector, candidate)
+		if err != nil {
+			return 0, false, err
+		}
+		if !found || dist < best {
+			best, found = dist, true
+		}
+	}
+	return best, found, nil
+}
+
+func insertPropertyVectorResult(q *priorityqueue.Queue, k int, id uint64, dist float32) {
+	if q.Len() < k {
+		q.Insert(id, dist)
+	} else if q.Top().Dist > dist {
+		q.Pop()
+		q.Insert(id, dist)
+	}
+}
@@ -22,9 +22,11 @@ import (
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
 	"github.com/weaviate/weaviate/adapters/repos/db/sorter"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
 	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/explain"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/multi"
 	"github.com/weaviate/weaviate/entities/schema"
@@ -51,10 +53,11 @@ func (s *Shard) objectByID(ctx context.Context, id strfmt.UUID,
 		return nil, nil
 	}
 
-	obj, err := storobj.FromBinary(bytes)
+	obj, err := storobj.FromBinaryWithClass(bytes, s.class())
 	if err != nil {
 		return nil, errors.Wrap(err, "unmarshal object")
 	}
+	s.resolveRenamedProperties(obj)
 
 	return obj, nil
 }
@@ -75,6 +78,7 @@ func (s *Shard) multiObjectByID(ctx context.Context,
 	}
 
 	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
+	class := s.class()
 	for i, id := range ids {
 		bytes, err := bucket.Get(id)
 		if err != nil {
@@ -85,12 +89,13 @@ func (s *Shard) multiObjectByID(ctx context.Context,
 			continue
 		}
 
-		obj, err := storobj.FromBinary(bytes)
+		obj, err := storobj.FromBinaryWithClass(bytes, class)
 		if err != nil {
 			return nil, errors.Wrap(err, "unmarshal kind object")
 		}
 		objects[i] = obj
 	}
+	s.resolveRenamedProperties(objects...)
 
 	return objects, nil
 }
@@ -135,10 +140,11 @@ func (s *Shard) objectByIndexID(ctx context.Context,
 			"uuid found for docID, but object is nil")
 	}
 
-	obj, err := storobj.FromBinary(bytes)
+	obj, err := storobj.FromBinaryWithClass(bytes, s.class())
 	if err != nil {
 		return nil, errors.Wrap(err, "unmarshal kind object")
 	}
+	s.resolveRenamedProperties(obj)
 
 	return obj, nil
 }
@@ -194,13 +200,26 @@ func (s *Shard) objectSearch(ctx context.Context, limit int, filters *filters.Lo
 			filterDocIds = objs
 		}
 
+		beforeKeyword := time.Now()
 		className := s.index.Config.ClassName
 		bm25Config := s.index.getInvertedIndexConfig().BM25
 		bm25searcher := inverted.NewBM25Searcher(bm25Config, s.store, s.index.getSchema.GetSchemaSkipAuth(), s.propertyIndices, s.index.classSearcher, s.deletedDocIDs, s.propLengths, s.index.logger, s.versioner.Version())
-		bm25objs, bm25count, err = bm25searcher.BM25F(ctx, filterDocIds, className, limit, *keywordRanking)
+		if keywordRanking.Type == "sparseVector" && keywordRanking.SparseVector != nil {
+			bm25objs, bm25count, err = bm25searcher.SparseVectorSearch(ctx, filterDocIds, className, limit, *keywordRanking.SparseVector)
+		} else {
+			bm25objs, bm25count, err = bm25searcher.BM25F(ctx, filterDocIds, className, limit, *keywordRanking)
+		}
 		if err != nil {
 			return nil, nil, err
 		}
+		explain.FromContext(ctx).Track(explain.StageKeywordSearch, beforeKeyword, len(bm25objs))
+		s.resolveRenamedProperties(bm25objs...)
+
+		if len(sort) > 0 {
+			// sorted with the scores, so the results can also be sorted by them
+			return sorter.NewObjectsSorter(s.index.getSchema.GetSchemaSkipAuth()).
+				Sort(bm25objs, bm25count, limit, sort)
+		}
 
 		return bm25objs, bm25count, nil
 	}
@@ -208,6 +227,7 @@ func (s *Shard) objectSearch(ctx context.Context, limit int, filters *filters.Lo
 	if filters == nil {
 		objs, err := s.objectList(ctx, limit, sort,
 			cursor, additional, s.index.Config.ClassName)
+		s.resolveRenamedProperties(objs...)
 		return objs, nil, err
 	}
 	objs, err := inverted.NewSearcher(s.index.logger, s.store,
@@ -215,13 +235,16 @@ func (s *Shard) objectSearch(ctx context.Context, limit int, filters *filters.Lo
 		s.propertyIndices, s.index.classSearcher, s.deletedDocIDs,
 		s.index.stopwords, s.versioner.Version(), s.isFallbackToSearchable,
 		s.tenant(), s.index.Config.QueryNestedRefLimit).
+		WithPreviousPropertyNames(s.renamedProperties.all()).
 		Objects(ctx, limit, filters, sort, additional, s.index.Config.ClassName)
+	s.resolveRenamedProperties(objs...)
 	return objs, nil, err
 }
 
 func (s *Shard) objectVectorSearch(ctx context.Context,
-	searchVector []float32, targetDist float32, limit int, filters *filters.LocalFilter,
-	sort []filters.Sort, groupBy *searchparams.GroupBy, additional additional.Properties,
+	searchVector []float32, targetVector string, targetDist float32, limit int,
+	filters *filters.LocalFilter, sort []filters.Sort, groupBy *searchparams.GroupBy,
+	rescore *searchparams.Rescore, exact bool, additional additional.Properties,
 ) ([]*storobj.Object, []float32, error) {
 	var (
 		ids       []uint64
@@ -230,7 +253,26 @@ func (s *Shard) objectVectorSearch(ctx context.Context,
 		allowList helpers.AllowList
 	)
 
-	if filters != nil {
+	propVector, err := s.propertyVectorTarget(targetVector)
+	if err != nil {
+		return nil, nil, err
+	}
+	var vectorIndex VectorIndex
+	if propVector == nil {
+		vectorIndex, err = s.searchVectorIndex(targetVector)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	// filters which match most objects are applied to the results of the
+	// vector search instead of restricting it, see postFilteredVectorSearch
+	postFilterSelectivity, postFilter := 0.0, false
+	if filters != nil && limit > 0 && groupBy == nil && !exact && propVector == nil {
+		postFilterSelectivity, postFilter = s.preferPostFiltering(filters)
+	}
+
+	if filters != nil && !postFilter {
 		beforeFilter := time.Now()
 		list, err := s.buildAllowList(ctx, filters, additional)
 		if err != nil {
@@ -240,19 +282,82 @@ func (s *Shard) objectVectorSearch(ctx context.Context,
 		s.metrics.FilteredVectorFilter(time.Since(beforeFilter))
 	}
 
+	queue := s.searchIndexQueue(targetVector)
+	search := func(k int) ([]uint64, []float32, error) {
+		var (
+			ids   []uint64
+			dists []float32
+			err   error
+		)
+		if propVector != nil {
+			ids, dists, err = s.searchByPropertyVector(ctx, propVector, searchVector, k, 0, allowList)
+			if err != nil {
+				return nil, nil, errors.Wrap(err, "property vector search")
+			}
+		} else if exact {
+			ids, dists, err = searchByVectorExact(vectorIndex, searchVector, k, allowList)
+			if err != nil {
+				return nil, nil, errors.Wrap(err, "exact vector search")
+			}
+		} else {
+			ids, dists, err = searchByVectorWithContext(ctx, vectorIndex, searchVector, k, allowList, rescore)
+			if err != nil {
+				return nil, nil, errors.Wrap(err, "vector search")
+			}
+		}
+		if queue != nil {
+			ids, dists = queue.searchByVector(searchVector, k, allowList, ids, dists)
+		}
+		return ids, dists, nil
+	}
+
 	beforeVector := time.Now()
-	if limit < 0 {
-		ids, dists, err = s.vectorIndex.SearchByVectorDistance(
+	if limit < 0 && propVector != nil {
+		ids, dists, err = s.searchByPropertyVector(ctx, propVector, searchVector, -1,
+			targetDist, allowList)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "property vector search by distance")
+		}
+	} else if limit < 0 {
+		ids, dists, err = vectorIndex.SearchByVectorDistance(
 			searchVector, targetDist, s.index.Config.QueryMaximumResults, allowList)
 		if err != nil {
 			return nil, nil, errors.Wrap(err, "vector search by distance")
 		}
+		if queue != nil {
+			ids, dists = queue.searchByVectorDistance(searchVector, targetDist,
+				s.index.Config.QueryMaximumResults, allowList, ids, dists)
+		}
+	} else if groupBy != nil {
+		objs, dists, err := s.groupedVectorSearch(ctx, search, groupBy, additional)
+		s.resolveRenamedProperties(objs...)
+		if filters != nil {
+			s.metrics.FilteredVectorVector(time.Since(beforeVector))
+		}
+		explain.FromContext(ctx).Track(explain.StageVectorSearch, beforeVector, len(objs))
+		return objs, dists, err
+	} else if postFilter {
+		var complete bool
+		ids, dists, allowList, complete, err = s.postFilteredVectorSearch(ctx, search,
+			filters, additional, limit, postFilterSelectivity)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !complete {
+			// too few results matched the filter, the allow list is set now so
+			// the vector search is restricted to the matching objects
+			ids, dists, err = search(limit)
+			if err != nil {
+				return nil, nil, err
+			}
+		}
 	} else {
-		ids, dists, err = s.vectorIndex.SearchByVector(searchVector, limit, allowList)
+		ids, dists, err = search(limit)
 		if err != nil {
-			return nil, nil, errors.Wrap(err, "vector search")
+			return nil, nil, err
 		}
 	}
+	explain.FromContext(ctx).Track(explain.StageVectorSearch, beforeVector, len(ids))
 	if len(ids) == 0 {
 		return nil, nil, nil
 	}
@@ -262,7 +367,9 @@ func (s *Shard) objectVectorSearch(ctx context.Context,
 	}
 
 	if groupBy != nil {
-		return s.groupResults(ctx, ids, dists, groupBy, additional)
+		objs, dists, err := s.groupResults(ctx, ids, dists, groupBy, additional)
+		s.resolveRenamedProperties(objs...)
+		return objs, dists, err
 	}
 
 	if len(sort) > 0 {
@@ -280,10 +387,11 @@ func (s *Shard) objectVectorSearch(ctx context.Context,
 	beforeObjects := time.Now()
 
 	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
-	objs, err := storobj.ObjectsByDocID(bucket, ids, additional)
+	objs, err := storobj.ObjectsByDocID(bucket, ids, additional, s.class())
 	if err != nil {
 		return nil, nil, err
 	}
+	s.resolveRenamedProperties(objs...)
 
 	if filters != nil {
 		s.metrics.FilteredVectorObjects(time.Since(beforeObjects))
@@ -302,7 +410,7 @@ func (s *Shard) objectList(ctx context.Context, limit int,
 			return nil, err
 		}
 		bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
-		return storobj.ObjectsByDocID(bucket, docIDs, additional)
+		return storobj.ObjectsByDocID(bucket, docIDs, additional, s.class())
 	}
 
 	if cursor == nil {
@@ -315,7 +423,17 @@ func (s *Shard) cursorObjectList(ctx context.Context, c *filters.Cursor,
 	additional additional.Properties,
 	className schema.ClassName,
 ) ([]*storobj.Object, error) {
-	cursor := s.store.Bucket(helpers.ObjectsBucketLSM).Cursor()
+	var cursor *lsmkv.CursorReplace
+	if c.Scroll != nil {
+		snapshot, done, err := s.scrollSnapshot(c.Scroll, c.After == "")
+		if err != nil {
+			return nil, err
+		}
+		defer done()
+		cursor = snapshot.Cursor()
+	} else {
+		cursor = s.store.Bucket(helpers.ObjectsBucketLSM).Cursor()
+	}
 	defer cursor.Close()
 
 	var key, val []byte
@@ -335,9 +453,10 @@ func (s *Shard) cursorObjectList(ctx context.Context, c *filters.Cursor,
 
 	i := 0
 	out := make([]*storobj.Object, c.Limit)
+	class := s.class()
 
 	for ; key != nil && i < c.Limit; key, val = cursor.Next() {
-		obj, err := storobj.FromBinary(val)
+		obj, err := storobj.FromBinaryWithClass(val, class)
 		if err != nil {
 			return nil, errors.Wrapf(err, "unmarhsal item %d", i)
 		}
@@ -352,11 +471,26 @@ func (s *Shard) cursorObjectList(ctx context.Context, c *filters.Cursor,
 func (s *Shard) sortedObjectList(ctx context.Context, limit int, sort []filters.Sort,
 	className schema.ClassName,
 ) ([]uint64, error) {
-	lsmSorter, err := sorter.NewLSMSorter(s.store, s.index.getSchema.GetSchemaSkipAuth(), className)
+	lsmSorter, err := sorter.NewLSMSorter(s.store, s.index.getSchema.GetSchemaSkipAuth(), className,
+		s.renamedProperties.all())
 	if err != nil {
 		return nil, errors.Wrap(err, "sort object list")
 	}
-	docIDs, err := lsmSorter.Sort(ctx, limit, sort)
+
+	// sorting by a timestamp with a range index only needs to read the
+	// objects which can make it into the results
+	candidates, ok, err := inverted.TimestampSortCandidates(ctx, s.store,
+		s.index.invertedIndexConfig.IndexTimestampRangeFilters, limit, sort, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "sort object list")
+	}
+
+	var docIDs []uint64
+	if ok {
+		docIDs, err = lsmSorter.SortDocIDs(ctx, limit, sort, candidates)
+	} else {
+		docIDs, err = lsmSorter.Sort(ctx, limit, sort)
+	}
 	if err != nil {
 		return nil, errors.Wrap(err, "sort object list")
 	}
@@ -366,7 +500,8 @@ func (s *Shard) sortedObjectList(ctx context.Context, limit int, sort []filters.
 func (s *Shard) sortDocIDsAndDists(ctx context.Context, limit int, sort []filters.Sort,
 	className schema.ClassName, docIDs []uint64, dists []float32,
 ) ([]uint64, []float32, error) {
-	lsmSorter, err := sorter.NewLSMSorter(s.store, s.index.getSchema.GetSchemaSkipAuth(), className)
+	lsmSorter, err := sorter.NewLSMSorter(s.store, s.index.getSchema.GetSchemaSkipAuth(), className,
+		s.renamedProperties.all())
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "sort objects with distances")
 	}
@@ -454,7 +589,11 @@ func (s *Shard) batchDeleteObject(ctx context.Context, id strfmt.UUID) error {
 	// TODO: do we still need this?
 	s.deletedDocIDs.Add(docID)
 
-	if err := s.vectorIndex.Delete(docID); err != nil {
+	if err := s.deleteFromVectorIndex("", s.vectorIndex, docID); err != nil {
+		return errors.Wrap(err, "delete from vector index")
+	}
+
+	if err := s.deleteFromTargetVectorIndexes(docID); err != nil {
 		return errors.Wrap(err, "delete from vector index")
 	}
 
@@ -0,0 +1,110 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/filters"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	// postFilteringMinSelectivity is the share of objects a filter has to be
+	// estimated to match, so it is applied after the vector search instead of
+	// restricting it. A restricted search hardly skips any node of the vector
+	// index for such filters anyway.
+	postFilteringMinSelectivity = 0.8
+	// postFilteringOversampling is how many more results than required are
+	// searched for, so that enough of them are left after filtering
+	postFilteringOversampling = 1.5
+)
+
+// preferPostFiltering estimates the selectivity of the filters from the
+// stats of the inverted index, and returns it along with whether the filters
+// should be applied after the vector search
+func (s *Shard) preferPostFiltering(filters *filters.LocalFilter) (float64, bool) {
+	selectivity, ok, err := inverted.NewSearcher(s.index.logger, s.store,
+		s.index.getSchema.GetSchemaSkipAuth(),
+		s.propertyIndices, s.index.classSearcher, s.deletedDocIDs,
+		s.index.stopwords, s.versioner.Version(), s.isFallbackToSearchable,
+		s.tenant(), s.index.Config.QueryNestedRefLimit).
+		EstimateSelectivity(filters, s.index.Config.ClassName)
+	if err != nil {
+		// the filters are built again for the allow list, which reports the
+		// error, so it is enough to fall back to pre-filtering here
+		s.index.logger.WithField("action", "estimate_filter_selectivity").
+			WithError(err).Debug("estimate selectivity of filters")
+		return 0, false
+	}
+	return selectivity, ok && selectivity >= postFilteringMinSelectivity
+}
+
+// postFilteredVectorSearch searches the vector index without restricting it
+// to the objects matching the filters, while the allow list is built
+// concurrently. The results which don't match the filters are dropped
+// afterwards. It returns false if fewer than limit results are left although
+// the vector index may hold more matching objects, in which case the search
+// has to be repeated with the returned allow list.
+func (s *Shard) postFilteredVectorSearch(ctx context.Context,
+	search func(k int) ([]uint64, []float32, error), filters *filters.LocalFilter,
+	additional additional.Properties, limit int, selectivity float64,
+) ([]uint64, []float32, helpers.AllowList, bool, error) {
+	k := int(math.Ceil(float64(limit) / selectivity * postFilteringOversampling))
+
+	var (
+		allowList  helpers.AllowList
+		candidates []uint64
+		dists      []float32
+	)
+
+	eg := errgroup.Group{}
+	eg.Go(func() error {
+		beforeFilter := time.Now()
+		list, err := s.buildAllowList(ctx, filters, additional)
+		if err != nil {
+			return err
+		}
+		allowList = list
+		s.metrics.FilteredVectorFilter(time.Since(beforeFilter))
+		return nil
+	})
+	eg.Go(func() error {
+		var err error
+		candidates, dists, err = search(k)
+		return err
+	})
+	if err := eg.Wait(); err != nil {
+		return nil, nil, nil, false, err
+	}
+
+	ids := make([]uint64, 0, limit)
+	idDists := make([]float32, 0, limit)
+	for i, id := range candidates {
+		if !allowList.Contains(id) {
+			continue
+		}
+		ids = append(ids, id)
+		idDists = append(idDists, dists[i])
+		if len(ids) == limit {
+			break
+		}
+	}
+
+	complete := len(ids) == limit || len(candidates) < k
+	return ids, idDists, allowList, complete, nil
+}
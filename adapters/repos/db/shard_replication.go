@@ -13,6 +13,7 @@ package db
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sync"
 
@@ -80,11 +81,14 @@ func (s *Shard) preparePutObject(ctx context.Context, requestID string, object *
 			Code: replica.StatusPreconditionFailed, Msg: err.Error(),
 		}}}
 	}
+	// the commit runs with a context of its own
+	precondition := objects.PreconditionFromContext(ctx, object.ID())
 	task := func(ctx context.Context) interface{} {
 		resp := replica.SimpleResponse{}
-		if err := s.putOne(ctx, uuid, object); err != nil {
+		ctx = objects.WithPrecondition(ctx, object.ID(), precondition)
+		if err := s.putOneWithinQuota(ctx, uuid, object); err != nil {
 			resp.Errors = []replica.Error{
-				{Code: replica.StatusConflict, Msg: err.Error()},
+				{Code: replicaStatusCode(err), Msg: err.Error()},
 			}
 		}
 		return resp
@@ -100,11 +104,16 @@ func (s *Shard) prepareMergeObject(ctx context.Context, requestID string, doc *o
 			{Code: replica.StatusPreconditionFailed, Msg: err.Error()},
 		}}
 	}
+	precondition := objects.PreconditionFromContext(ctx, doc.ID)
 	task := func(ctx context.Context) interface{} {
 		resp := replica.SimpleResponse{}
-		if err := s.merge(ctx, uuid, *doc); err != nil {
+		err := s.checkDiskQuota()
+		if err == nil {
+			err = s.merge(objects.WithPrecondition(ctx, doc.ID, precondition), uuid, *doc)
+		}
+		if err != nil {
 			resp.Errors = []replica.Error{
-				{Code: replica.StatusConflict, Msg: err.Error()},
+				{Code: replicaStatusCode(err), Msg: err.Error()},
 			}
 		}
 		return resp
@@ -114,6 +123,22 @@ func (s *Shard) prepareMergeObject(ctx context.Context, requestID string, doc *o
 }
 
 func (s *Shard) prepareDeleteObject(ctx context.Context, requestID string, uuid strfmt.UUID) replica.SimpleResponse {
+	if precondition := objects.PreconditionFromContext(ctx, uuid); precondition != nil {
+		// the object is read again under its lock to check the precondition
+		task := func(ctx context.Context) interface{} {
+			resp := replica.SimpleResponse{}
+			ctx = objects.WithPrecondition(ctx, uuid, precondition)
+			if err := s.deleteObject(ctx, uuid); err != nil {
+				resp.Errors = []replica.Error{
+					{Code: replicaStatusCode(err), Msg: err.Error()},
+				}
+			}
+			return resp
+		}
+		s.replicationMap.set(requestID, task)
+		return replica.SimpleResponse{}
+	}
+
 	bucket, obj, idBytes, docID, err := s.canDeleteOne(ctx, uuid)
 	if err != nil {
 		return replica.SimpleResponse{
@@ -135,9 +160,28 @@ func (s *Shard) prepareDeleteObject(ctx context.Context, requestID string, uuid
 	return replica.SimpleResponse{}
 }
 
+// replicaStatusCode returns the status code of err which failed the commit
+// of a replication request
+func replicaStatusCode(err error) replica.StatusCode {
+	if errors.As(err, &objects.ErrPreconditionFailed{}) {
+		return replica.StatusPreconditionFailed
+	}
+	return replica.StatusConflict
+}
+
+// preconditionError turns a precondition which failed on a replica back into
+// objects.ErrPreconditionFailed, other errors are returned as they are
+func preconditionError(err error) error {
+	var replicaErr *replica.Error
+	if errors.As(err, &replicaErr) && replicaErr.Code == replica.StatusPreconditionFailed {
+		return objects.NewErrPreconditionFailed("%s", replicaErr.Msg)
+	}
+	return err
+}
+
 func (s *Shard) preparePutObjects(ctx context.Context, requestID string, objects []*storobj.Object) replica.SimpleResponse {
 	task := func(ctx context.Context) interface{} {
-		rawErrs := s.putBatch(ctx, objects)
+		rawErrs := s.putBatchWithinQuota(ctx, objects)
 		resp := replica.SimpleResponse{Errors: make([]replica.Error, len(rawErrs))}
 		for i, err := range rawErrs {
 			if err != nil {
@@ -172,7 +216,12 @@ func (s *Shard) prepareDeleteObjects(ctx context.Context, requestID string, docI
 
 func (s *Shard) prepareAddReferences(ctx context.Context, requestID string, refs []objects.BatchReference) replica.SimpleResponse {
 	task := func(ctx context.Context) interface{} {
-		rawErrs := newReferencesBatcher(s).References(ctx, refs)
+		var rawErrs []error
+		if err := s.checkDiskQuota(); err != nil {
+			rawErrs = duplicateErr(err, len(refs))
+		} else {
+			rawErrs = newReferencesBatcher(s).References(ctx, refs)
+		}
 		resp := replica.SimpleResponse{Errors: make([]replica.Error, len(rawErrs))}
 		for i, err := range rawErrs {
 			if err != nil {
@@ -0,0 +1,321 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/storagestate"
+)
+
+// roaringSetMigrationChunkSize is the number of keys which are copied at once.
+// Writes to the set buckets of the shard wait while a chunk is copied.
+const roaringSetMigrationChunkSize = 100
+
+// roaringSetMigration migrates the inverted buckets of a shard, which still
+// store their postings as sets of doc ids, to roaring bitmaps while the shard
+// keeps serving reads and writes. The postings are copied into a temporary
+// roaring set bucket, which receives all writes to the set bucket in the
+// meantime, and eventually replaces it.
+type roaringSetMigration struct {
+	sync.RWMutex
+	// mirrors holds the roaring set bucket for every set bucket which is being
+	// migrated
+	mirrors map[*lsmkv.Bucket]*lsmkv.Bucket
+	// replaced holds the roaring set bucket for every set bucket which was
+	// migrated. Writers which looked up a set bucket before it was replaced
+	// are redirected to the roaring set bucket.
+	replaced map[*lsmkv.Bucket]*lsmkv.Bucket
+}
+
+// setAdd adds the doc ids to the set bucket, and to the roaring set bucket it
+// is migrated to
+func (m *roaringSetMigration) setAdd(bucket *lsmkv.Bucket, key []byte, docIDs []uint64) error {
+	m.RLock()
+	defer m.RUnlock()
+
+	if replacement := m.replaced[bucket]; replacement != nil {
+		return replacement.RoaringSetAddList(key, docIDs)
+	}
+
+	values := make([][]byte, len(docIDs))
+	for i, docID := range docIDs {
+		values[i] = make([]byte, 8)
+		binary.LittleEndian.PutUint64(values[i], docID)
+	}
+	if err := bucket.SetAdd(key, values); err != nil {
+		return err
+	}
+
+	if mirror := m.mirrors[bucket]; mirror != nil {
+		return mirror.RoaringSetAddList(key, docIDs)
+	}
+	return nil
+}
+
+// setDelete removes the doc id from the set bucket, and from the roaring set
+// bucket it is migrated to
+func (m *roaringSetMigration) setDelete(bucket *lsmkv.Bucket, key []byte, docID uint64) error {
+	m.RLock()
+	defer m.RUnlock()
+
+	if replacement := m.replaced[bucket]; replacement != nil {
+		return replacement.RoaringSetRemoveOne(key, docID)
+	}
+
+	docIDBytes := make([]byte, 8)
+	binary.LittleEndian.PutUint64(docIDBytes, docID)
+	if err := bucket.SetDeleteSingle(key, docIDBytes); err != nil {
+		return err
+	}
+
+	if mirror := m.mirrors[bucket]; mirror != nil {
+		return mirror.RoaringSetRemoveOne(key, docID)
+	}
+	return nil
+}
+
+func (m *roaringSetMigration) start(bucket, mirror *lsmkv.Bucket) {
+	m.Lock()
+	defer m.Unlock()
+
+	if m.mirrors == nil {
+		m.mirrors = map[*lsmkv.Bucket]*lsmkv.Bucket{}
+	}
+	m.mirrors[bucket] = mirror
+}
+
+func (m *roaringSetMigration) abort(bucket *lsmkv.Bucket) {
+	m.Lock()
+	defer m.Unlock()
+
+	delete(m.mirrors, bucket)
+}
+
+// copyChunk copies the postings of the keys while writes to the set buckets
+// wait, so that the copy does not miss a write, nor overwrites one
+func (m *roaringSetMigration) copyChunk(bucket, mirror *lsmkv.Bucket, keys [][]byte) error {
+	m.Lock()
+	defer m.Unlock()
+
+	for _, key := range keys {
+		values, err := bucket.SetList(key)
+		if err != nil {
+			return errors.Wrapf(err, "read postings of key %q", key)
+		}
+		if len(values) == 0 {
+			continue
+		}
+
+		docIDs := make([]uint64, len(values))
+		for i, value := range values {
+			docIDs[i] = binary.LittleEndian.Uint64(value)
+		}
+		if err := mirror.RoaringSetAddList(key, docIDs); err != nil {
+			return errors.Wrapf(err, "write postings of key %q", key)
+		}
+	}
+	return nil
+}
+
+// setBucketsToMigrate returns the names of the inverted buckets of the shard
+// which still store their postings as sets, but are supposed to store roaring
+// bitmaps
+func (s *Shard) setBucketsToMigrate() []string {
+	var names []string
+	for name, bucket := range s.store.GetBucketsByName() {
+		if bucket.Strategy() == lsmkv.StrategySetCollection &&
+			bucket.DesiredStrategy() == lsmkv.StrategyRoaringSet {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// migrateToRoaringSet migrates all inverted buckets of the shard which still
+// store their postings as sets to roaring bitmaps. The shard stays writable
+// during the migration, writes only wait while a chunk of keys is copied and
+// while a bucket is replaced.
+func (s *Shard) migrateToRoaringSet(ctx context.Context) error {
+	if s.isReadOnly() {
+		return storagestate.ErrStatusReadOnly
+	}
+
+	for _, name := range s.setBucketsToMigrate() {
+		if err := ctx.Err(); err != nil {
+			return errors.Wrap(err, "migration to roaring sets stopped")
+		}
+
+		before := time.Now()
+		if err := s.migrateBucketToRoaringSet(ctx, name); err != nil {
+			return errors.Wrapf(err, "migrate bucket %q to roaring set", name)
+		}
+		s.index.logger.
+			WithField("action", "migrate_to_roaring_set").
+			WithField("shard", s.ID()).
+			WithField("bucket", name).
+			WithField("took", time.Since(before)).
+			Info("migrated bucket to roaring set")
+	}
+	return nil
+}
+
+func (s *Shard) migrateBucketToRoaringSet(ctx context.Context, name string) error {
+	if err := s.checkNoBackupInProgress(); err != nil {
+		return err
+	}
+	bucket := s.store.Bucket(name)
+	if bucket == nil {
+		return errors.Errorf("bucket %q not found", name)
+	}
+
+	tempName := helpers.TempBucketFromBucketName(name)
+	if err := s.store.CreateBucket(ctx, tempName,
+		lsmkv.WithStrategy(lsmkv.StrategyRoaringSet),
+		lsmkv.WithIdleThreshold(time.Duration(s.index.Config.MemtablesFlushIdleAfter)*time.Second),
+		lsmkv.WithPread(s.index.Config.AvoidMMap),
+	); err != nil {
+		return errors.Wrap(err, "create temporary bucket")
+	}
+	mirror := s.store.Bucket(tempName)
+
+	abort := func() {
+		s.roaringSetMigration.abort(bucket)
+		if err := s.store.DropBucket(ctx, tempName); err != nil {
+			s.index.logger.WithField("action", "migrate_to_roaring_set").
+				WithField("shard", s.ID()).
+				WithField("bucket", tempName).
+				WithError(err).
+				Error("could not drop temporary bucket")
+		}
+	}
+
+	s.roaringSetMigration.start(bucket, mirror)
+	if err := s.copyToRoaringSet(ctx, bucket, mirror); err != nil {
+		abort()
+		return err
+	}
+	if err := s.replaceWithRoaringSet(ctx, name, bucket, mirror); err != nil {
+		// the temporary bucket is only dropped, if it did not replace the
+		// set bucket yet
+		if s.store.Bucket(tempName) == mirror {
+			abort()
+		}
+		return err
+	}
+	return nil
+}
+
+// copyToRoaringSet copies the postings key by key. The keys are read in
+// chunks, as a cursor blocks flushing the bucket for as long as it is open.
+func (s *Shard) copyToRoaringSet(ctx context.Context, bucket, mirror *lsmkv.Bucket) error {
+	var last []byte
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		keys := s.nextSetKeys(bucket, last)
+		if len(keys) == 0 {
+			return nil
+		}
+		if err := s.roaringSetMigration.copyChunk(bucket, mirror, keys); err != nil {
+			return err
+		}
+		last = keys[len(keys)-1]
+	}
+}
+
+// nextSetKeys returns the keys following after the last one
+func (s *Shard) nextSetKeys(bucket *lsmkv.Bucket, last []byte) [][]byte {
+	cursor := bucket.SetCursorKeyOnly()
+	defer cursor.Close()
+
+	var k []byte
+	if last == nil {
+		k, _ = cursor.First()
+	} else {
+		k, _ = cursor.Seek(last)
+		if bytes.Equal(k, last) {
+			k, _ = cursor.Next()
+		}
+	}
+
+	keys := make([][]byte, 0, roaringSetMigrationChunkSize)
+	for ; k != nil && len(keys) < roaringSetMigrationChunkSize; k, _ = cursor.Next() {
+		keys = append(keys, append([]byte(nil), k...))
+	}
+	return keys
+}
+
+// replaceWithRoaringSet replaces the set bucket with the roaring set bucket
+// while writes to the index wait. Compactions are paused, as the files of the
+// set bucket are removed.
+func (s *Shard) replaceWithRoaringSet(ctx context.Context, name string,
+	bucket, mirror *lsmkv.Bucket,
+) error {
+	if err := s.index.backupMutex.LockWithContext(ctx); err != nil {
+		return errors.Wrap(err, "wait for writes to finish")
+	}
+	defer s.index.backupMutex.Unlock()
+
+	// a backup resumes compactions once it is released, they must not be
+	// resumed in between
+	if err := s.checkNoBackupInProgress(); err != nil {
+		return err
+	}
+	if err := s.store.PauseCompaction(ctx); err != nil {
+		return errors.Wrap(err, "pause compaction")
+	}
+	defer func() {
+		if err := s.store.ResumeCompaction(ctx); err != nil {
+			s.index.logger.WithField("action", "migrate_to_roaring_set").
+				WithField("shard", s.ID()).
+				WithError(err).
+				Error("could not resume compaction")
+		}
+	}()
+
+	m := &s.roaringSetMigration
+	m.Lock()
+	defer m.Unlock()
+
+	if err := mirror.FlushMemtable(); err != nil {
+		return errors.Wrap(err, "flush temporary bucket")
+	}
+	if err := s.store.ReplaceBuckets(ctx, name, helpers.TempBucketFromBucketName(name)); err != nil {
+		return errors.Wrap(err, "replace bucket")
+	}
+
+	delete(m.mirrors, bucket)
+	if m.replaced == nil {
+		m.replaced = map[*lsmkv.Bucket]*lsmkv.Bucket{}
+	}
+	m.replaced[bucket] = mirror
+	return nil
+}
+
+func (s *Shard) checkNoBackupInProgress() error {
+	if backup := s.index.lastBackup.Load(); backup != nil {
+		return errors.Errorf("backup %q is in progress, try again later", backup.BackupID)
+	}
+	return nil
+}
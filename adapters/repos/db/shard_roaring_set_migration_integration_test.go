@@ -0,0 +1,114 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+func TestShard_MigrateToRoaringSet(t *testing.T) {
+	ctx := testCtx()
+	className := "TestClass"
+	shd, idx := testShard(t, ctx, className)
+	bucketName := helpers.BucketFromPropNameLSM(filters.InternalPropID)
+	amount := 3*roaringSetMigrationChunkSize + 10
+
+	var objs []*storobj.Object
+	t.Run("insert data into a legacy set bucket", func(t *testing.T) {
+		require.Nil(t, shd.store.DropBucket(ctx, bucketName))
+		require.Nil(t, shd.store.CreateBucket(ctx, bucketName,
+			lsmkv.WithStrategy(lsmkv.StrategySetCollection)))
+
+		for i := 0; i < amount; i++ {
+			obj := testObject(className)
+			require.Nil(t, shd.putObject(ctx, obj))
+			objs = append(objs, obj)
+		}
+	})
+
+	t.Run("the set bucket is to be migrated after a restart", func(t *testing.T) {
+		require.Nil(t, shd.shutdown(ctx))
+
+		var err error
+		shd, err = NewShard(ctx, nil, shd.name, idx, &models.Class{Class: className},
+			shd.centralJobQueue)
+		require.Nil(t, err)
+		idx.shards.Store(shd.name, shd)
+
+		bucket := shd.store.Bucket(bucketName)
+		assert.Equal(t, lsmkv.StrategySetCollection, bucket.Strategy())
+		assert.Equal(t, lsmkv.StrategyRoaringSet, bucket.DesiredStrategy())
+		assert.Equal(t, []string{bucketName}, shd.setBucketsToMigrate())
+	})
+
+	var deleted []*storobj.Object
+	t.Run("migrate while writing", func(t *testing.T) {
+		wg := sync.WaitGroup{}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < amount; i++ {
+				obj := testObject(className)
+				assert.Nil(t, shd.putObject(ctx, obj))
+				objs = append(objs, obj)
+			}
+			for _, obj := range objs[:amount/2] {
+				assert.Nil(t, shd.deleteObject(ctx, obj.ID()))
+				deleted = append(deleted, obj)
+			}
+			objs = objs[amount/2:]
+		}()
+
+		require.Nil(t, shd.migrateToRoaringSet(ctx))
+		wg.Wait()
+	})
+
+	t.Run("the postings are stored as roaring set", func(t *testing.T) {
+		bucket := shd.store.Bucket(bucketName)
+		assert.Equal(t, lsmkv.StrategyRoaringSet, bucket.Strategy())
+		assert.Nil(t, shd.store.Bucket(helpers.TempBucketFromBucketName(bucketName)))
+		assert.Empty(t, shd.setBucketsToMigrate())
+
+		for _, obj := range objs {
+			docIDs, err := bucket.RoaringSetGet([]byte(obj.ID()))
+			require.Nil(t, err)
+			assert.Equal(t, []uint64{obj.DocID()}, docIDs.ToArray())
+		}
+		for _, obj := range deleted {
+			docIDs, err := bucket.RoaringSetGet([]byte(obj.ID()))
+			require.Nil(t, err)
+			assert.True(t, docIDs.IsEmpty())
+		}
+	})
+
+	t.Run("the shard accepts writes to the roaring set", func(t *testing.T) {
+		obj := testObject(className)
+		require.Nil(t, shd.putObject(ctx, obj))
+
+		docIDs, err := shd.store.Bucket(bucketName).RoaringSetGet([]byte(obj.ID()))
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{obj.DocID()}, docIDs.ToArray())
+	})
+
+	require.Nil(t, idx.drop())
+}
@@ -0,0 +1,130 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/errorcompounder"
+	"github.com/weaviate/weaviate/entities/filters"
+)
+
+// scrollSessions holds the snapshots of the objects bucket which are read by
+// the scroll sessions of a shard, see filters.Scroll
+type scrollSessions struct {
+	sync.Mutex
+	sessions map[string]*scrollSession
+	closed   bool
+}
+
+type scrollSession struct {
+	// reads hold a RLock, so the snapshot is not released while it is read
+	sync.RWMutex
+	snapshot  *lsmkv.BucketSnapshot
+	timer     *time.Timer
+	expiresAt time.Time
+}
+
+// scrollSnapshot returns the snapshot of the scroll session and keeps the
+// session alive for its TTL. The session is opened on its first page, later
+// pages fail if it expired in the meantime. No session is opened if
+// filters.MaxScrollSessions are open already. The returned func needs to be
+// called once the snapshot is no longer read.
+func (s *Shard) scrollSnapshot(scroll *filters.Scroll, firstPage bool,
+) (*lsmkv.BucketSnapshot, func(), error) {
+	s.scrolls.Lock()
+	defer s.scrolls.Unlock()
+
+	if s.scrolls.closed {
+		return nil, nil, errors.Errorf("shard %s is shut down", s.ID())
+	}
+
+	session, ok := s.scrolls.sessions[scroll.ID]
+	if !ok {
+		if !firstPage {
+			return nil, nil, fmt.Errorf("scroll session %q not found, it may have expired",
+				scroll.ID)
+		}
+		if len(s.scrolls.sessions) >= filters.MaxScrollSessions {
+			return nil, nil, fmt.Errorf("cannot open scroll session %q, shard %s has %d "+
+				"open scroll sessions already, retry once one of them expired",
+				scroll.ID, s.ID(), len(s.scrolls.sessions))
+		}
+
+		snapshot, err := s.store.Bucket(helpers.ObjectsBucketLSM).Snapshot()
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "open scroll session")
+		}
+
+		session = &scrollSession{snapshot: snapshot}
+		session.timer = time.AfterFunc(scroll.TTL, func() {
+			s.expireScrollSession(scroll.ID, session)
+		})
+		if s.scrolls.sessions == nil {
+			s.scrolls.sessions = map[string]*scrollSession{}
+		}
+		s.scrolls.sessions[scroll.ID] = session
+	} else {
+		session.timer.Reset(scroll.TTL)
+	}
+	session.expiresAt = time.Now().Add(scroll.TTL)
+
+	session.RLock()
+	return session.snapshot, session.RUnlock, nil
+}
+
+func (s *Shard) expireScrollSession(id string, session *scrollSession) {
+	s.scrolls.Lock()
+	if s.scrolls.sessions[id] != session || time.Now().Before(session.expiresAt) {
+		// the session was closed or kept alive while the timer fired
+		s.scrolls.Unlock()
+		return
+	}
+	delete(s.scrolls.sessions, id)
+	s.scrolls.Unlock()
+
+	if err := session.release(); err != nil {
+		s.index.logger.WithField("action", "scroll_session_expire").
+			WithField("shard", s.ID()).
+			WithError(err).
+			Error("failed to release scroll session")
+	}
+}
+
+// closeScrollSessions releases the snapshots of all scroll sessions, no new
+// sessions can be opened afterwards
+func (s *Shard) closeScrollSessions() error {
+	s.scrolls.Lock()
+	sessions := s.scrolls.sessions
+	s.scrolls.sessions = nil
+	s.scrolls.closed = true
+	s.scrolls.Unlock()
+
+	var errs errorcompounder.ErrorCompounder
+	for _, session := range sessions {
+		session.timer.Stop()
+		errs.Add(session.release())
+	}
+	return errs.ToError()
+}
+
+func (s *scrollSession) release() error {
+	s.Lock()
+	defer s.Unlock()
+
+	return s.snapshot.Release()
+}
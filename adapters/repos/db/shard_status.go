@@ -13,6 +13,7 @@ package db
 
 import (
 	"strings"
+	"sync"
 
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/entities/storagestate"
@@ -36,6 +37,15 @@ func (s *Shard) isReadOnly() bool {
 	return s.getStatus() == storagestate.StatusReadOnly
 }
 
+// checkWritable returns an error, if the shard is READONLY, or if the class
+// or the node it belongs to is in read-only mode
+func (s *Shard) checkWritable() error {
+	if s.isReadOnly() {
+		return storagestate.ErrStatusReadOnly
+	}
+	return s.index.Config.ReadOnly.checkWritable(s.index.Config.ClassName)
+}
+
 func (s *Shard) updateStatus(in string) error {
 	s.statusLock.Lock()
 	defer s.statusLock.Unlock()
@@ -54,3 +64,31 @@ func (s *Shard) updateStatus(in string) error {
 func (s *Shard) updateStoreStatus(targetStatus storagestate.Status) {
 	s.store.UpdateBucketsStatus(targetStatus)
 }
+
+// blockWrites rejects writes to the shard the same way the READONLY status
+// does, but keeps the buckets flushing, so that internal migrations can still
+// write to them. The returned func restores the previous status once all
+// blocks have been released, unless it has been changed in the meantime.
+func (s *Shard) blockWrites() (restore func()) {
+	s.statusLock.Lock()
+	defer s.statusLock.Unlock()
+
+	if s.writeBlocks == 0 {
+		s.statusBeforeWriteBlocks = s.status
+	}
+	s.writeBlocks++
+	s.status = storagestate.StatusReadOnly
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			s.statusLock.Lock()
+			defer s.statusLock.Unlock()
+
+			s.writeBlocks--
+			if s.writeBlocks == 0 && s.status == storagestate.StatusReadOnly {
+				s.status = s.statusBeforeWriteBlocks
+			}
+		})
+	}
+}
@@ -0,0 +1,197 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+// targetVectorIndexConfigs returns the parsed vector index configs of the
+// named vector spaces of the class
+func targetVectorIndexConfigs(class *models.Class) map[string]schema.VectorIndexConfig {
+	if class == nil || len(class.VectorConfig) == 0 {
+		return nil
+	}
+
+	configs := make(map[string]schema.VectorIndexConfig, len(class.VectorConfig))
+	for name, cfg := range class.VectorConfig {
+		if parsed, ok := cfg.VectorIndexConfig.(schema.VectorIndexConfig); ok {
+			configs[name] = parsed
+		}
+	}
+	return configs
+}
+
+func targetVectorIndexID(shardID, targetVector string) string {
+	return fmt.Sprintf("%s_vector_%s", shardID, targetVector)
+}
+
+// initTargetVectors creates one vector index per named vector space of the
+// class. The returned func must be called once the shard is initialized.
+func (s *Shard) initTargetVectors(ctx context.Context) (func(), error) {
+	indexes := make(map[string]VectorIndex, len(s.index.targetVectorIndexUserConfigs))
+	for name, cfg := range s.index.targetVectorIndexUserConfigs {
+		vi, err := s.newVectorIndex(ctx, targetVectorIndexID(s.ID(), name), cfg,
+			s.targetVectorByIndexID(name), s.readTargetVectorByIndexIDIntoSlice(name))
+		if err != nil {
+			return nil, fmt.Errorf("target vector %q: %w", name, err)
+		}
+		indexes[name] = vi
+	}
+	s.targetVectorIndexes = indexes
+
+	return func() {
+		for _, vi := range indexes {
+			vi.PostStartup()
+		}
+	}, nil
+}
+
+// targetVectorIndex returns the vector index of the named vector space
+func (s *Shard) targetVectorIndex(targetVector string) (VectorIndex, error) {
+	vi, ok := s.targetVectorIndexes[targetVector]
+	if !ok {
+		return nil, errors.Errorf("class %s has no target vector %q",
+			s.index.Config.ClassName, targetVector)
+	}
+	return vi, nil
+}
+
+// searchVectorIndex returns the vector index a vector search is performed
+// on. The class-level vector index is used if no target vector is given.
+func (s *Shard) searchVectorIndex(targetVector string) (VectorIndex, error) {
+	if targetVector == "" {
+		return s.vectorIndex, nil
+	}
+	return s.targetVectorIndex(targetVector)
+}
+
+// forEachVectorIndex calls f for the class-level vector index and every
+// vector index of a named vector space
+func (s *Shard) forEachVectorIndex(f func(targetVector string, vi VectorIndex) error) error {
+	if err := f("", s.vectorIndex); err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(s.targetVectorIndexes))
+	for name := range s.targetVectorIndexes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if err := f(name, s.targetVectorIndexes[name]); err != nil {
+			return fmt.Errorf("target vector %q: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// forEachTargetVectorIndex is like forEachVectorIndex, but skips the
+// class-level vector index
+func (s *Shard) forEachTargetVectorIndex(f func(targetVector string, vi VectorIndex) error) error {
+	return s.forEachVectorIndex(func(targetVector string, vi VectorIndex) error {
+		if targetVector == "" {
+			return nil
+		}
+		return f(targetVector, vi)
+	})
+}
+
+func (s *Shard) validateTargetVectorsBeforeInsert(vectors map[string][]float32) error {
+	for name, vector := range vectors {
+		vi, err := s.targetVectorIndex(name)
+		if err != nil {
+			return err
+		}
+		if len(vector) == 0 {
+			continue
+		}
+		if err := vi.ValidateBeforeInsert(vector); err != nil {
+			return fmt.Errorf("target vector %q: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// updateTargetVectorIndexes is the named vector counterpart of
+// updateVectorIndex
+func (s *Shard) updateTargetVectorIndexes(vectors map[string][]float32,
+	status objectInsertStatus,
+) error {
+	return s.forEachTargetVectorIndex(func(targetVector string, vi VectorIndex) error {
+		if status.docIDChanged {
+			if err := s.deleteFromVectorIndex(targetVector, vi, status.oldDocID); err != nil {
+				return errors.Wrapf(err, "delete doc id %d from vector index", status.oldDocID)
+			}
+		}
+
+		vector := vectors[targetVector]
+		if len(vector) == 0 {
+			return nil
+		}
+
+		if err := s.addToVectorIndex(targetVector, vi, status.docID, vector); err != nil {
+			return errors.Wrapf(err, "insert doc id %d to vector index", status.docID)
+		}
+		return nil
+	})
+}
+
+func (s *Shard) deleteFromTargetVectorIndexes(docIDs ...uint64) error {
+	return s.forEachTargetVectorIndex(func(targetVector string, vi VectorIndex) error {
+		return s.deleteFromVectorIndex(targetVector, vi, docIDs...)
+	})
+}
+
+func (s *Shard) flushTargetVectorIndexes() error {
+	return s.forEachTargetVectorIndex(func(_ string, vi VectorIndex) error {
+		return vi.Flush()
+	})
+}
+
+func (s *Shard) targetVectorByIndexID(targetVector string) hnsw.VectorForID {
+	return func(ctx context.Context, indexID uint64) ([]float32, error) {
+		keyBuf := make([]byte, 8)
+		return s.readTargetVectorByIndexIDIntoSlice(targetVector)(ctx, indexID,
+			&hnsw.VectorSlice{Buff8: keyBuf})
+	}
+}
+
+func (s *Shard) readTargetVectorByIndexIDIntoSlice(targetVector string) hnsw.TempVectorForID {
+	return func(ctx context.Context, indexID uint64, container *hnsw.VectorSlice) ([]float32, error) {
+		binary.LittleEndian.PutUint64(container.Buff8, indexID)
+
+		bytes, newBuff, err := s.store.Bucket(helpers.ObjectsBucketLSM).
+			GetBySecondaryIntoMemory(0, container.Buff8, container.Buff)
+		if err != nil {
+			return nil, err
+		}
+
+		if bytes == nil {
+			return nil, storobj.NewErrNotFoundf(indexID,
+				"no object for doc id, it could have been deleted")
+		}
+
+		container.Buff = newBuff
+		return storobj.TargetVectorFromBinary(bytes, targetVector)
+	}
+}
@@ -0,0 +1,143 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+// graphBuildVectorIndex is implemented by vector indexes whose graph can be
+// built in bulk instead of inserting the vectors one by one
+type graphBuildVectorIndex interface {
+	BuildGraph(ctx context.Context, builder hnsw.GraphBuilder, ids []uint64,
+		vectors [][]float32) (hnsw.BuildInfo, error)
+}
+
+// newGraphBuilder creates the builder used for bulk builds of vector indexes
+var newGraphBuilder = hnsw.NewGPUGraphBuilder
+
+// buildVectorIndex builds the graph of the empty vector index for
+// targetVector in bulk from the vectors of the objects of the shard. The shard
+// has to be READONLY, so that no object is written while the vectors are
+// read and the graph is built.
+func (s *Shard) buildVectorIndex(ctx context.Context, targetVector string,
+) (*models.VectorIndexBuild, error) {
+	if !s.isReadOnly() {
+		return nil, fmt.Errorf("shard %s must be READONLY while its vector index is built", s.name)
+	}
+
+	vi, err := s.searchVectorIndex(targetVector)
+	if err != nil {
+		return nil, err
+	}
+	index, ok := vi.(graphBuildVectorIndex)
+	if !ok {
+		return nil, fmt.Errorf("vector index of shard %s cannot be built in bulk", s.name)
+	}
+
+	builder, err := newGraphBuilder()
+	if err != nil {
+		return nil, err
+	}
+
+	before := time.Now()
+	ids, vectors, err := s.vectorsForBuild(ctx, targetVector)
+	if err != nil {
+		return nil, fmt.Errorf("shard %s: %w", s.name, err)
+	}
+
+	info, err := index.BuildGraph(ctx, builder, ids, vectors)
+	if err != nil {
+		return nil, fmt.Errorf("shard %s: %w", s.name, err)
+	}
+	took := time.Since(before)
+
+	s.index.logger.WithField("action", "vector_index_build").
+		WithField("class", s.index.Config.ClassName.String()).
+		WithField("shard", s.name).
+		WithField("target_vector", targetVector).
+		WithField("nodes", info.Nodes).
+		WithField("dimensions", info.Dimensions).
+		WithField("took", took).
+		Info("built vector index in bulk")
+
+	return &models.VectorIndexBuild{
+		Shard:        s.name,
+		TargetVector: targetVector,
+		Nodes:        int64(info.Nodes),
+		Dimensions:   int64(info.Dimensions),
+		TookMs:       took.Milliseconds(),
+	}, nil
+}
+
+// vectorsForBuild reads the doc ids and vectors for targetVector of all
+// objects of the shard, objects without a vector are skipped
+func (s *Shard) vectorsForBuild(ctx context.Context, targetVector string,
+) ([]uint64, [][]float32, error) {
+	cursor := s.store.Bucket(helpers.ObjectsBucketLSM).Cursor()
+	defer cursor.Close()
+
+	var ids []uint64
+	var vectors [][]float32
+	i := 0
+	for key, val := cursor.First(); key != nil; key, val = cursor.Next() {
+		if i%1000 == 0 {
+			if err := ctx.Err(); err != nil {
+				return nil, nil, err
+			}
+		}
+		i++
+
+		obj, err := storobj.FromBinary(val)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "unmarshal object %d", i)
+		}
+
+		vec := obj.Vector
+		if targetVector != "" {
+			vec = obj.Vectors[targetVector]
+		}
+		if len(vec) == 0 {
+			continue
+		}
+		ids = append(ids, obj.DocID())
+		vectors = append(vectors, vec)
+	}
+
+	return ids, vectors, nil
+}
+
+// BuildVectorIndex builds the graph of the empty vector index of a local shard
+// in bulk, e.g. on the GPU, from the vectors of its objects
+func (m *Migrator) BuildVectorIndex(ctx context.Context,
+	className, shardName, targetVector string,
+) (*models.VectorIndexBuild, error) {
+	idx := m.db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return nil, fmt.Errorf("cannot build vector index of a non-existing index for %s", className)
+	}
+
+	shard := idx.localShard(ctx, shardName)
+	if shard == nil {
+		return nil, fmt.Errorf("shard %s of class %s is not held by this node", shardName, className)
+	}
+	return shard.buildVectorIndex(ctx, targetVector)
+}
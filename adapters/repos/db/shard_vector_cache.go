@@ -0,0 +1,35 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+// vectorCacheStatsReporter is implemented by vector indexes which keep their
+// vectors in an in-memory cache
+type vectorCacheStatsReporter interface {
+	VectorCacheStats() (bytes, maxBytes int64)
+}
+
+// vectorCacheStats sums up the estimated memory used by the vector caches of
+// all vector indexes of the shard and their byte budgets
+func (s *Shard) vectorCacheStats() (bytes, maxBytes int64) {
+	s.forEachVectorIndex(func(targetVector string, vi VectorIndex) error {
+		reporter, ok := vi.(vectorCacheStatsReporter)
+		if !ok {
+			return nil
+		}
+
+		b, mb := reporter.VectorCacheStats()
+		bytes += b
+		maxBytes += mb
+		return nil
+	})
+	return bytes, maxBytes
+}
@@ -0,0 +1,146 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// vectorIndexIntegrityChecker is implemented by vector indexes with a graph
+// that can be validated and repaired, vector indexes without such a graph
+// are skipped by the integrity check
+type vectorIndexIntegrityChecker interface {
+	CheckIntegrity() hnsw.IntegrityReport
+	RepairIntegrity() (hnsw.IntegrityReport, error)
+}
+
+// checkVectorIndexIntegrity validates the graphs of all vector indexes of
+// the shard and repairs them if requested. The results are kept on the shard
+// to be reported by the nodes API.
+func (s *Shard) checkVectorIndexIntegrity(repair bool) ([]*models.VectorIndexIntegrity, error) {
+	var results []*models.VectorIndexIntegrity
+	err := s.forEachVectorIndex(func(targetVector string, vi VectorIndex) error {
+		checker, ok := vi.(vectorIndexIntegrityChecker)
+		if !ok {
+			return nil
+		}
+
+		var report hnsw.IntegrityReport
+		if repair {
+			var err error
+			if report, err = checker.RepairIntegrity(); err != nil {
+				return err
+			}
+		} else {
+			report = checker.CheckIntegrity()
+		}
+
+		if !report.Healthy() {
+			s.index.logger.WithField("action", "vector_index_integrity").
+				WithField("class", s.index.Config.ClassName.String()).
+				WithField("shard", s.name).
+				WithField("target_vector", targetVector).
+				WithField("orphan_nodes", len(report.OrphanNodes)).
+				WithField("broken_links", report.BrokenLinks).
+				WithField("unreachable_nodes", len(report.UnreachableNodes)).
+				WithField("entrypoint_valid", report.EntrypointValid).
+				WithField("repaired", report.Repaired).
+				Warn("vector index graph is damaged")
+		}
+
+		results = append(results, &models.VectorIndexIntegrity{
+			Shard:            s.name,
+			TargetVector:     targetVector,
+			Nodes:            int64(report.Nodes),
+			OrphanNodes:      int64(len(report.OrphanNodes)),
+			BrokenLinks:      int64(report.BrokenLinks),
+			UnreachableNodes: int64(len(report.UnreachableNodes)),
+			EntrypointValid:  report.EntrypointValid,
+			Repaired:         report.Repaired,
+			CheckedAt:        time.Now().UnixMilli(),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("shard %s: %w", s.name, err)
+	}
+
+	s.integrityLock.Lock()
+	s.integrity = results
+	s.integrityLock.Unlock()
+
+	return results, nil
+}
+
+// vectorIndexIntegrity returns the results of the latest integrity check of
+// the shard, nil if the shard has not been checked yet
+func (s *Shard) vectorIndexIntegrity() []*models.VectorIndexIntegrity {
+	s.integrityLock.Lock()
+	defer s.integrityLock.Unlock()
+
+	return s.integrity
+}
+
+func (i *Index) checkVectorIndexIntegrity(ctx context.Context, repair bool) ([]*models.VectorIndexIntegrity, error) {
+	var results []*models.VectorIndexIntegrity
+	err := i.ForEachShard(func(name string, shard *Shard) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		shardResults, err := shard.checkVectorIndexIntegrity(repair)
+		if err != nil {
+			return err
+		}
+		results = append(results, shardResults...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// CheckVectorIndexIntegrity validates the vector index graphs of the local
+// shards of a class and repairs them if requested. All classes are checked
+// if className is empty.
+func (m *Migrator) CheckVectorIndexIntegrity(ctx context.Context, className string,
+	repair bool,
+) ([]*models.VectorIndexIntegrity, error) {
+	if className != "" {
+		idx := m.db.GetIndex(schema.ClassName(className))
+		if idx == nil {
+			return nil, fmt.Errorf("cannot check vector index integrity of a non-existing index for %s", className)
+		}
+		return idx.checkVectorIndexIntegrity(ctx, repair)
+	}
+
+	m.db.indexLock.RLock()
+	defer m.db.indexLock.RUnlock()
+
+	var results []*models.VectorIndexIntegrity
+	for _, idx := range m.db.indices {
+		idxResults, err := idx.checkVectorIndexIntegrity(ctx, repair)
+		if err != nil {
+			return nil, fmt.Errorf("class %s: %w", idx.Config.ClassName, err)
+		}
+		results = append(results, idxResults...)
+	}
+	return results, nil
+}
@@ -0,0 +1,123 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// recallMeasurer is implemented by approximate vector indexes which can
+// compare their results to an exact search, other vector indexes are skipped
+// by the recall measurement
+type recallMeasurer interface {
+	MeasureRecall(ctx context.Context, sampleSize, k int) (hnsw.RecallReport, error)
+}
+
+// measureVectorIndexRecall measures the recall@k of all vector indexes of the
+// shard using up to sampleSize indexed vectors as queries
+func (s *Shard) measureVectorIndexRecall(ctx context.Context, sampleSize, k int,
+) ([]*models.VectorIndexRecall, error) {
+	var results []*models.VectorIndexRecall
+	err := s.forEachVectorIndex(func(targetVector string, vi VectorIndex) error {
+		measurer, ok := vi.(recallMeasurer)
+		if !ok {
+			return nil
+		}
+
+		report, err := measurer.MeasureRecall(ctx, sampleSize, k)
+		if err != nil {
+			return err
+		}
+
+		s.index.logger.WithField("action", "vector_index_recall").
+			WithField("class", s.index.Config.ClassName.String()).
+			WithField("shard", s.name).
+			WithField("target_vector", targetVector).
+			WithField("sample_size", report.SampleSize).
+			WithField("k", report.K).
+			WithField("ef", report.EF).
+			WithField("recall", report.Recall).
+			Info("measured vector index recall")
+
+		results = append(results, &models.VectorIndexRecall{
+			Shard:        s.name,
+			TargetVector: targetVector,
+			SampleSize:   int64(report.SampleSize),
+			K:            int64(report.K),
+			Ef:           int64(report.EF),
+			Recall:       report.Recall,
+			MeasuredAt:   time.Now().UnixMilli(),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("shard %s: %w", s.name, err)
+	}
+
+	return results, nil
+}
+
+func (i *Index) measureVectorIndexRecall(ctx context.Context, sampleSize, k int,
+) ([]*models.VectorIndexRecall, error) {
+	var results []*models.VectorIndexRecall
+	err := i.ForEachShard(func(name string, shard *Shard) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		shardResults, err := shard.measureVectorIndexRecall(ctx, sampleSize, k)
+		if err != nil {
+			return err
+		}
+		results = append(results, shardResults...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// MeasureVectorIndexRecall measures the recall@k of the vector indexes of the
+// local shards of a class with the current ef settings. All classes are
+// measured if className is empty.
+func (m *Migrator) MeasureVectorIndexRecall(ctx context.Context, className string,
+	sampleSize, k int,
+) ([]*models.VectorIndexRecall, error) {
+	if className != "" {
+		idx := m.db.GetIndex(schema.ClassName(className))
+		if idx == nil {
+			return nil, fmt.Errorf("cannot measure vector index recall of a non-existing index for %s", className)
+		}
+		return idx.measureVectorIndexRecall(ctx, sampleSize, k)
+	}
+
+	m.db.indexLock.RLock()
+	defer m.db.indexLock.RUnlock()
+
+	var results []*models.VectorIndexRecall
+	for _, idx := range m.db.indices {
+		idxResults, err := idx.measureVectorIndexRecall(ctx, sampleSize, k)
+		if err != nil {
+			return nil, fmt.Errorf("class %s: %w", idx.Config.ClassName, err)
+		}
+		results = append(results, idxResults...)
+	}
+	return results, nil
+}
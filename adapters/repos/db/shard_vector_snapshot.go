@@ -0,0 +1,136 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// snapshotVectorIndex is implemented by vector indexes which can export
+// their graph and vectors to a portable snapshot file and attach such a
+// snapshot instead of re-inserting the vectors
+type snapshotVectorIndex interface {
+	ExportSnapshot(ctx context.Context, path string) (hnsw.SnapshotInfo, error)
+	ImportSnapshot(ctx context.Context, path string) (hnsw.SnapshotInfo, error)
+}
+
+func (s *Shard) snapshotVectorIndex(targetVector string) (snapshotVectorIndex, error) {
+	vi, err := s.searchVectorIndex(targetVector)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshotter, ok := vi.(snapshotVectorIndex)
+	if !ok {
+		return nil, fmt.Errorf("vector index of shard %s does not support snapshots", s.name)
+	}
+	return snapshotter, nil
+}
+
+// exportVectorIndexSnapshot writes the graph and vectors of the vector index
+// for targetVector to the snapshot file at path
+func (s *Shard) exportVectorIndexSnapshot(ctx context.Context, targetVector, path string,
+) (*models.VectorIndexSnapshot, error) {
+	snapshotter, err := s.snapshotVectorIndex(targetVector)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := snapshotter.ExportSnapshot(ctx, path)
+	if err != nil {
+		return nil, fmt.Errorf("shard %s: %w", s.name, err)
+	}
+
+	s.logSnapshot(targetVector, path, info).Info("exported vector index snapshot")
+	return snapshotModel(s.name, targetVector, info), nil
+}
+
+// importVectorIndexSnapshot attaches the snapshot file at path to the empty
+// vector index for targetVector
+func (s *Shard) importVectorIndexSnapshot(ctx context.Context, targetVector, path string,
+) (*models.VectorIndexSnapshot, error) {
+	snapshotter, err := s.snapshotVectorIndex(targetVector)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := snapshotter.ImportSnapshot(ctx, path)
+	if err != nil {
+		return nil, fmt.Errorf("shard %s: %w", s.name, err)
+	}
+
+	s.logSnapshot(targetVector, path, info).Info("imported vector index snapshot")
+	return snapshotModel(s.name, targetVector, info), nil
+}
+
+func (s *Shard) logSnapshot(targetVector, path string, info hnsw.SnapshotInfo) *logrus.Entry {
+	return s.index.logger.WithField("action", "vector_index_snapshot").
+		WithField("class", s.index.Config.ClassName.String()).
+		WithField("shard", s.name).
+		WithField("target_vector", targetVector).
+		WithField("path", path).
+		WithField("nodes", info.Nodes).
+		WithField("dimensions", info.Dimensions)
+}
+
+func snapshotModel(shardName, targetVector string, info hnsw.SnapshotInfo) *models.VectorIndexSnapshot {
+	return &models.VectorIndexSnapshot{
+		Shard:        shardName,
+		TargetVector: targetVector,
+		Nodes:        int64(info.Nodes),
+		Dimensions:   int64(info.Dimensions),
+		Distance:     info.Distance,
+	}
+}
+
+func (m *Migrator) snapshotShard(ctx context.Context, className, shardName string) (*Shard, error) {
+	idx := m.db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return nil, fmt.Errorf("cannot snapshot vector index of a non-existing index for %s", className)
+	}
+
+	shard := idx.localShard(ctx, shardName)
+	if shard == nil {
+		return nil, fmt.Errorf("shard %s of class %s is not held by this node", shardName, className)
+	}
+	return shard, nil
+}
+
+// ExportVectorIndexSnapshot writes the vector index of a local shard to a
+// portable snapshot file at path
+func (m *Migrator) ExportVectorIndexSnapshot(ctx context.Context,
+	className, shardName, targetVector, path string,
+) (*models.VectorIndexSnapshot, error) {
+	shard, err := m.snapshotShard(ctx, className, shardName)
+	if err != nil {
+		return nil, err
+	}
+	return shard.exportVectorIndexSnapshot(ctx, targetVector, path)
+}
+
+// ImportVectorIndexSnapshot attaches the portable snapshot file at path to
+// the empty vector index of a local shard
+func (m *Migrator) ImportVectorIndexSnapshot(ctx context.Context,
+	className, shardName, targetVector, path string,
+) (*models.VectorIndexSnapshot, error) {
+	shard, err := m.snapshotShard(ctx, className, shardName)
+	if err != nil {
+		return nil, err
+	}
+	return shard.importVectorIndexSnapshot(ctx, targetVector, path)
+}
@@ -0,0 +1,163 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+// vectorIndexTombstoneCleanupController is implemented by vector indexes
+// which clean up deleted nodes in a scheduled background cycle, other vector
+// indexes are skipped
+type vectorIndexTombstoneCleanupController interface {
+	PauseTombstoneCleanup(ctx context.Context) error
+	ResumeTombstoneCleanup() error
+	TombstoneCleanupStatus() hnsw.TombstoneCleanupStatus
+}
+
+// vectorIndexTombstoneCleanup pauses or resumes the tombstone cleanup of all
+// vector indexes of the shard if paused is set and returns their state
+func (s *Shard) vectorIndexTombstoneCleanup(ctx context.Context,
+	paused *bool,
+) ([]*models.VectorIndexTombstoneCleanup, error) {
+	var results []*models.VectorIndexTombstoneCleanup
+	err := s.forEachVectorIndex(func(targetVector string, vi VectorIndex) error {
+		ctrl, ok := vi.(vectorIndexTombstoneCleanupController)
+		if !ok {
+			return nil
+		}
+
+		if paused != nil {
+			var err error
+			if *paused {
+				err = ctrl.PauseTombstoneCleanup(ctx)
+			} else {
+				err = ctrl.ResumeTombstoneCleanup()
+			}
+			if err != nil {
+				return err
+			}
+		}
+
+		results = append(results, tombstoneCleanupToModel(s.name, targetVector,
+			ctrl.TombstoneCleanupStatus()))
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("shard %s: %w", s.name, err)
+	}
+
+	return results, nil
+}
+
+func tombstoneCleanupToModel(shard, targetVector string,
+	status hnsw.TombstoneCleanupStatus,
+) *models.VectorIndexTombstoneCleanup {
+	res := &models.VectorIndexTombstoneCleanup{
+		Shard:                      shard,
+		TargetVector:               targetVector,
+		Paused:                     status.Paused,
+		Concurrency:                int64(status.Concurrency),
+		RemainingTombstones:        int64(status.RemainingTombstones),
+		RemovedTombstones:          status.RemovedTombstones,
+		LastCycleRemovedTombstones: int64(status.LastCycleRemovedTombstones),
+		LastCycleDurationMs:        status.LastCycleDuration.Milliseconds(),
+		LastCycleThroughput:        status.LastCycleThroughput(),
+	}
+	if !status.LastCycleAt.IsZero() {
+		res.LastCycleAt = status.LastCycleAt.UnixMilli()
+	}
+	return res
+}
+
+func (i *Index) vectorIndexTombstoneCleanup(ctx context.Context,
+	paused *bool,
+) ([]*models.VectorIndexTombstoneCleanup, error) {
+	var results []*models.VectorIndexTombstoneCleanup
+	err := i.ForEachShard(func(name string, shard *Shard) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		shardResults, err := shard.vectorIndexTombstoneCleanup(ctx, paused)
+		if err != nil {
+			return err
+		}
+		results = append(results, shardResults...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// updateVectorTombstoneCleanupInterval restarts the tombstone cleanup cycle
+// of the index if the configured interval changed. The cycle is shared by all
+// shards of the index, paused vector indexes stay paused as their callbacks
+// remain deactivated.
+func (i *Index) updateVectorTombstoneCleanupInterval(ctx context.Context,
+	updated schema.VectorIndexConfig,
+) error {
+	hnswConfig, ok := updated.(enthnsw.UserConfig)
+	if !ok {
+		return nil
+	}
+	interval := time.Duration(hnswConfig.CleanupIntervalSeconds) * time.Second
+
+	cb := i.cycleCallbacks
+	cb.vectorTombstoneCleanupLock.Lock()
+	defer cb.vectorTombstoneCleanupLock.Unlock()
+
+	// the noop cycle callbacks of indexes without a vector index have no
+	// interval
+	if cb.vectorTombstoneCleanupInterval == 0 || cb.vectorTombstoneCleanupInterval == interval {
+		return nil
+	}
+
+	running := cb.vectorTombstoneCleanupCycle.Running()
+	if err := cb.vectorTombstoneCleanupCycle.StopAndWait(ctx); err != nil {
+		return fmt.Errorf("stop vector tombstone cleanup cycle: %w", err)
+	}
+
+	cb.vectorTombstoneCleanupCycle = cyclemanager.NewManager(
+		cyclemanager.NewFixedTicker(interval),
+		cb.vectorTombstoneCleanupCallbacks.CycleCallback)
+	cb.vectorTombstoneCleanupInterval = interval
+	if running {
+		cb.vectorTombstoneCleanupCycle.Start()
+	}
+
+	return nil
+}
+
+// VectorIndexTombstoneCleanup pauses or resumes the tombstone cleanup of the
+// vector indexes of the local shards of a class if paused is set and returns
+// their state
+func (m *Migrator) VectorIndexTombstoneCleanup(ctx context.Context, className string,
+	paused *bool,
+) ([]*models.VectorIndexTombstoneCleanup, error) {
+	idx := m.db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return nil, fmt.Errorf("cannot control the tombstone cleanup of a non-existing index for %s", className)
+	}
+	return idx.vectorIndexTombstoneCleanup(ctx, paused)
+}
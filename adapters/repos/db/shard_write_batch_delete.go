@@ -20,7 +20,6 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/filters"
-	"github.com/weaviate/weaviate/entities/storagestate"
 	"github.com/weaviate/weaviate/usecases/objects"
 )
 
@@ -28,9 +27,9 @@ import (
 func (s *Shard) deleteObjectBatch(ctx context.Context,
 	docIDs []uint64, dryRun bool,
 ) objects.BatchSimpleObjects {
-	if s.isReadOnly() {
+	if err := s.checkWritable(); err != nil {
 		return objects.BatchSimpleObjects{
-			objects.BatchSimpleObject{Err: storagestate.ErrStatusReadOnly},
+			objects.BatchSimpleObject{Err: err},
 		}
 	}
 	return newDeleteObjectsBatcher(s).Delete(ctx, docIDs, dryRun)
@@ -49,6 +48,10 @@ func newDeleteObjectsBatcher(shard *Shard) *deleteObjectsBatcher {
 func (b *deleteObjectsBatcher) Delete(ctx context.Context,
 	docIDs []uint64, dryRun bool,
 ) objects.BatchSimpleObjects {
+	if !dryRun {
+		defer b.shard.bumpDataVersion()
+	}
+
 	b.delete(ctx, docIDs, dryRun)
 	b.flushWALs(ctx)
 	return b.objects
@@ -129,6 +132,12 @@ func (b *deleteObjectsBatcher) flushWALs(ctx context.Context) {
 		}
 	}
 
+	if err := b.shard.flushTargetVectorIndexes(); err != nil {
+		for i := range b.objects {
+			b.setErrorAtIndex(err, i)
+		}
+	}
+
 	if err := b.shard.propLengths.Flush(false); err != nil {
 		for i := range b.objects {
 			b.setErrorAtIndex(err, i)
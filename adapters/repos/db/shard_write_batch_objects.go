@@ -22,7 +22,6 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
-	"github.com/weaviate/weaviate/entities/storagestate"
 	"github.com/weaviate/weaviate/entities/storobj"
 )
 
@@ -30,11 +29,40 @@ import (
 func (s *Shard) putObjectBatch(ctx context.Context,
 	objects []*storobj.Object,
 ) []error {
-	if s.isReadOnly() {
-		return []error{storagestate.ErrStatusReadOnly}
+	if err := s.checkWritable(); err != nil {
+		return []error{err}
 	}
 
-	return s.putBatch(ctx, objects)
+	return s.putBatchWithinQuota(ctx, objects)
+}
+
+// putBatchWithinQuota only writes the objects which do not exceed the quotas
+// of the class or tenant. The others are rejected with an error at their
+// position.
+func (s *Shard) putBatchWithinQuota(ctx context.Context,
+	objects []*storobj.Object,
+) []error {
+	errs := s.checkObjectsQuota(ctx, objects)
+	if errs == nil {
+		return s.putBatch(ctx, objects)
+	}
+
+	accepted := make([]*storobj.Object, 0, len(objects))
+	positions := make([]int, 0, len(objects))
+	for i, err := range errs {
+		if err == nil {
+			accepted = append(accepted, objects[i])
+			positions = append(positions, i)
+		}
+	}
+	if len(accepted) == 0 {
+		return errs
+	}
+
+	for i, err := range s.putBatch(ctx, accepted) {
+		errs[positions[i]] = err
+	}
+	return errs
 }
 
 // Workers are started with the first batch and keep working as there are objects to add from any batch. Each batch
@@ -46,6 +74,8 @@ func (s *Shard) putBatch(ctx context.Context,
 	// Workers are started with the first batch and keep working as there are objects to add from any batch. Each batch
 	// adds its jobs (that contain the respective object) to a single queue that is then processed by the workers.
 	// When the last batch finishes, all workers receive a shutdown signal and exit
+	defer s.bumpDataVersion()
+
 	batcher := newObjectsBatcher(s)
 	err := batcher.Objects(ctx, objects)
 
@@ -68,6 +98,15 @@ type objectsBatcher struct {
 	objects        []*storobj.Object
 	wg             sync.WaitGroup
 	batchStartTime time.Time
+	// vectorsInBatch indicates that the vectors of the main vector index have
+	// been inserted in bulk and must be skipped by the workers
+	vectorsInBatch bool
+}
+
+// batchVectorIndex is implemented by vector indexes which can insert many
+// vectors at once more efficiently than one at a time
+type batchVectorIndex interface {
+	AddBatch(ids []uint64, vectors [][]float32) []error
 }
 
 func newObjectsBatcher(s *Shard) *objectsBatcher {
@@ -84,6 +123,7 @@ func (ob *objectsBatcher) Objects(ctx context.Context,
 	ob.init(objects)
 	ob.storeInObjectStore(ctx)
 	ob.markDeletedInVectorStorage(ctx)
+	ob.storeVectorsInBatch(ctx)
 	ob.storeAdditionalStorageWithWorkers(ctx)
 	ob.flushWALs(ctx)
 	return ob.errs
@@ -160,7 +200,11 @@ func (ob *objectsBatcher) storeObjectOfBatchInLSM(ctx context.Context,
 		return err
 	}
 
-	status, err := ob.shard.putObjectLSM(object, idBytes)
+	if err := ob.shard.validateTargetVectorsBeforeInsert(object.Vectors); err != nil {
+		return errors.Wrap(err, "validate vector index")
+	}
+
+	status, err := ob.shard.putObjectLSM(object, idBytes, nil)
 	if err != nil {
 		return err
 	}
@@ -196,13 +240,67 @@ func (ob *objectsBatcher) markDeletedInVectorStorage(ctx context.Context) {
 		return
 	}
 
-	if err := ob.shard.vectorIndex.Delete(docIDsToDelete...); err != nil {
+	if err := ob.shard.deleteFromVectorIndex("", ob.shard.vectorIndex, docIDsToDelete...); err != nil {
+		for _, pos := range positions {
+			ob.setErrorAtIndex(err, pos)
+		}
+	}
+
+	if err := ob.shard.deleteFromTargetVectorIndexes(docIDsToDelete...); err != nil {
 		for _, pos := range positions {
 			ob.setErrorAtIndex(err, pos)
 		}
 	}
 }
 
+// storeVectorsInBatch inserts the vectors of all objects into the main vector
+// index in a single call if the index supports it, or queues them all at once
+// if async indexing is enabled. Like the deletes above, this happens after the
+// objects have been stored and before the remaining indexes are updated by
+// the workers.
+func (ob *objectsBatcher) storeVectorsInBatch(ctx context.Context) {
+	var addBatch func(ids []uint64, vectors [][]float32) []error
+	if q, ok := ob.shard.indexQueues[""]; ok {
+		addBatch = q.Push
+	} else if index, ok := ob.shard.vectorIndex.(batchVectorIndex); ok {
+		addBatch = index.AddBatch
+	} else {
+		return
+	}
+
+	if ok := ob.checkContext(ctx); !ok {
+		return
+	}
+
+	ob.batchStartTime = time.Now()
+
+	var ids []uint64
+	var vectors [][]float32
+	var positions []int
+	for i, object := range ob.objects {
+		if ob.shouldSkipInAdditionalStorage(i) || len(object.Vector) == 0 {
+			continue
+		}
+
+		ids = append(ids, ob.statuses[object.ID()].docID)
+		vectors = append(vectors, object.Vector)
+		positions = append(positions, i)
+	}
+
+	ob.vectorsInBatch = true
+	if len(ids) == 0 {
+		return
+	}
+
+	for i, err := range addBatch(ids, vectors) {
+		if err != nil {
+			ob.setErrorAtIndex(errors.Wrapf(err,
+				"insert to vector index: insert doc id %d to vector index", ids[i]),
+				positions[i])
+		}
+	}
+}
+
 // storeAdditionalStorageWithWorkers stores the object in all non-key-value
 // stores, such as the main vector index as well as the property-specific
 // indices, such as the geo-index.
@@ -213,7 +311,9 @@ func (ob *objectsBatcher) storeAdditionalStorageWithWorkers(ctx context.Context)
 		return
 	}
 
-	ob.batchStartTime = time.Now()
+	if !ob.vectorsInBatch {
+		ob.batchStartTime = time.Now()
+	}
 
 	for i, object := range ob.objects {
 		if ob.shouldSkipInAdditionalStorage(i) {
@@ -265,7 +365,7 @@ func (ob *objectsBatcher) storeSingleObjectInAdditionalStorage(ctx context.Conte
 		return
 	}
 
-	if object.Vector != nil {
+	if object.Vector != nil && !ob.vectorsInBatch {
 		// By this time all required deletes (e.g. because of DocID changes) have
 		// already been grouped and performed in bulk. Only the insertions are
 		// left. The motivation for this change is explained in
@@ -285,12 +385,24 @@ func (ob *objectsBatcher) storeSingleObjectInAdditionalStorage(ctx context.Conte
 		// shard.updateVectorIndex which would also handle the delete as required
 		// for a non-batch update. Instead a new method has been introduced that
 		// ignores deletes.
+		//
+		// If the vector index supports batch insertion, the vectors have been
+		// inserted in bulk in storeVectorsInBatch already.
 		if err := ob.shard.updateVectorIndexIgnoreDelete(object.Vector, status); err != nil {
 			ob.setErrorAtIndex(errors.Wrap(err, "insert to vector index"), index)
 			return
 		}
 	}
 
+	if len(object.Vectors) > 0 {
+		// deletes have been performed in bulk as well, see above
+		if err := ob.shard.updateTargetVectorIndexes(object.Vectors,
+			objectInsertStatus{docID: status.docID}); err != nil {
+			ob.setErrorAtIndex(errors.Wrap(err, "insert to vector index"), index)
+			return
+		}
+	}
+
 	if err := ob.shard.updatePropertySpecificIndices(object, status); err != nil {
 		ob.setErrorAtIndex(errors.Wrap(err, "update prop-specific indices"), index)
 		return
@@ -347,6 +459,12 @@ func (ob *objectsBatcher) flushWALs(ctx context.Context) {
 		}
 	}
 
+	if err := ob.shard.flushTargetVectorIndexes(); err != nil {
+		for i := range ob.objects {
+			ob.setErrorAtIndex(err, i)
+		}
+	}
+
 	if err := ob.shard.propLengths.Flush(false); err != nil {
 		for i := range ob.objects {
 			ob.setErrorAtIndex(err, i)
@@ -31,8 +31,11 @@ import (
 func (s *Shard) addReferencesBatch(ctx context.Context,
 	refs objects.BatchReferences,
 ) []error {
-	if s.isReadOnly() {
-		return []error{errors.Errorf("shard is read-only")}
+	if err := s.checkWritable(); err != nil {
+		return []error{err}
+	}
+	if err := s.checkDiskQuota(); err != nil {
+		return []error{err}
 	}
 
 	return newReferencesBatcher(s).References(ctx, refs)
@@ -57,6 +60,8 @@ func newReferencesBatcher(s *Shard) *referencesBatcher {
 func (b *referencesBatcher) References(ctx context.Context,
 	refs objects.BatchReferences,
 ) []error {
+	defer b.shard.bumpDataVersion()
+
 	b.init(refs)
 	b.storeInObjectStore(ctx)
 	b.flushWALs(ctx)
@@ -343,6 +348,12 @@ func (b *referencesBatcher) flushWALs(ctx context.Context) {
 			b.setErrorAtIndex(err, i)
 		}
 	}
+
+	if err := b.shard.flushTargetVectorIndexes(); err != nil {
+		for i := range b.refs {
+			b.setErrorAtIndex(err, i)
+		}
+	}
 }
 
 func (b *referencesBatcher) getSchemaPropsByName() (map[string]*models.Property, error) {
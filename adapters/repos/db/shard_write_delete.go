@@ -19,13 +19,13 @@ import (
 	"github.com/google/uuid"
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
-	"github.com/weaviate/weaviate/entities/storagestate"
 	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/objects"
 )
 
 func (s *Shard) deleteObject(ctx context.Context, id strfmt.UUID) error {
-	if s.isReadOnly() {
-		return storagestate.ErrStatusReadOnly
+	if err := s.checkWritable(); err != nil {
+		return err
 	}
 
 	idBytes, err := uuid.MustParse(id.String()).MarshalBinary()
@@ -35,24 +35,39 @@ func (s *Shard) deleteObject(ctx context.Context, id strfmt.UUID) error {
 
 	var docID uint64
 	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
+
+	// see comment in shard_write_put.go::putObjectLSM
+	lock := &s.docIdLock[s.uuidToIdLockPoolId(idBytes)]
+	lock.Lock()
 	existing, err := bucket.Get([]byte(idBytes))
 	if err != nil {
+		lock.Unlock()
 		return fmt.Errorf("unexpected error on previous lookup: %w", err)
 	}
 
+	precondition := objects.PreconditionFromContext(ctx, id)
+	if err := s.checkPrecondition(precondition, id, existing); err != nil {
+		lock.Unlock()
+		return err
+	}
+
 	if existing == nil {
 		// nothing to do
+		lock.Unlock()
 		return nil
 	}
+	defer s.bumpDataVersion()
 
 	// we need the doc ID so we can clean up inverted indices currently
 	// pointing to this object
 	docID, err = storobj.DocIDFromBinary(existing)
 	if err != nil {
+		lock.Unlock()
 		return fmt.Errorf("get existing doc id from object binary: %w", err)
 	}
 
 	err = bucket.Delete(idBytes)
+	lock.Unlock()
 	if err != nil {
 		return fmt.Errorf("delete object from bucket: %w", err)
 	}
@@ -66,7 +81,11 @@ func (s *Shard) deleteObject(ctx context.Context, id strfmt.UUID) error {
 	// TODO: do we still need this?
 	s.deletedDocIDs.Add(docID)
 
-	if err = s.vectorIndex.Delete(docID); err != nil {
+	if err = s.deleteFromVectorIndex("", s.vectorIndex, docID); err != nil {
+		return fmt.Errorf("delete from vector index: %w", err)
+	}
+
+	if err = s.deleteFromTargetVectorIndexes(docID); err != nil {
 		return fmt.Errorf("delete from vector index: %w", err)
 	}
 
@@ -78,6 +97,10 @@ func (s *Shard) deleteObject(ctx context.Context, id strfmt.UUID) error {
 		return fmt.Errorf("flush all vector index buffered WALs: %w", err)
 	}
 
+	if err = s.flushTargetVectorIndexes(); err != nil {
+		return fmt.Errorf("flush all vector index buffered WALs: %w", err)
+	}
+
 	return nil
 }
 
@@ -109,6 +132,8 @@ func (s *Shard) deleteOne(ctx context.Context, bucket *lsmkv.Bucket, obj, idByte
 	if obj == nil || bucket == nil {
 		return nil
 	}
+	defer s.bumpDataVersion()
+
 	err := bucket.Delete(idBytes)
 	if err != nil {
 		return fmt.Errorf("delete object from bucket: %w", err)
@@ -123,7 +148,11 @@ func (s *Shard) deleteOne(ctx context.Context, bucket *lsmkv.Bucket, obj, idByte
 	// TODO: do we still need this?
 	s.deletedDocIDs.Add(docID)
 
-	if err = s.vectorIndex.Delete(docID); err != nil {
+	if err = s.deleteFromVectorIndex("", s.vectorIndex, docID); err != nil {
+		return fmt.Errorf("delete from vector index: %w", err)
+	}
+
+	if err = s.deleteFromTargetVectorIndexes(docID); err != nil {
 		return fmt.Errorf("delete from vector index: %w", err)
 	}
 
@@ -135,17 +164,24 @@ func (s *Shard) deleteOne(ctx context.Context, bucket *lsmkv.Bucket, obj, idByte
 		return fmt.Errorf("flush all vector index buffered WALs: %w", err)
 	}
 
+	if err = s.flushTargetVectorIndexes(); err != nil {
+		return fmt.Errorf("flush all vector index buffered WALs: %w", err)
+	}
+
 	return nil
 }
 
 func (s *Shard) cleanupInvertedIndexOnDelete(previous []byte, docID uint64) error {
-	previousObject, err := storobj.FromBinary(previous)
+	previousObject, err := storobj.FromBinaryWithClass(previous, s.class())
 	if err != nil {
 		return fmt.Errorf("unmarshal previous object: %w", err)
 	}
 
-	// TODO text_rbm_inverted_index null props cleanup?
-	previousInvertProps, _, err := s.analyzeObject(previousObject)
+	if err := s.releaseBlobs(previousObject, docID); err != nil {
+		return fmt.Errorf("release blobs: %w", err)
+	}
+
+	previousInvertProps, previousNilProps, err := s.analyzeObject(previousObject)
 	if err != nil {
 		return fmt.Errorf("analyze previous object: %w", err)
 	}
@@ -154,7 +190,7 @@ func (s *Shard) cleanupInvertedIndexOnDelete(previous []byte, docID uint64) erro
 		return fmt.Errorf("subtract prop lengths: %w", err)
 	}
 
-	err = s.deleteFromInvertedIndicesLSM(previousInvertProps, docID)
+	err = s.deleteFromInvertedIndicesLSM(previousInvertProps, previousNilProps, docID)
 	if err != nil {
 		return fmt.Errorf("put inverted indices props: %w", err)
 	}
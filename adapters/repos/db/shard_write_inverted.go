@@ -16,6 +16,7 @@ import (
 
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
 	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/storobj"
 )
@@ -27,13 +28,44 @@ type nilProp struct {
 
 func isPropertyForLength(dt schema.DataType) bool {
 	switch dt {
-	case schema.DataTypeInt, schema.DataTypeNumber, schema.DataTypeBoolean, schema.DataTypeDate:
+	case schema.DataTypeInt, schema.DataTypeNumber, schema.DataTypeBoolean, schema.DataTypeDate,
+		schema.DataTypeDuration, schema.DataTypeIP, schema.DataTypeDecimal,
+		schema.DataTypeGeoPolygon, schema.DataTypeGeoShape, schema.DataTypePhoneNumber,
+		schema.DataTypeSparseVector:
 		return false
 	default:
 		return true
 	}
 }
 
+// nullStateProps returns the properties which have a null state, i.e. the
+// top level properties and, instead of the nested properties, their leaves.
+// Alongside, the names of the properties which are set on the object are
+// returned.
+func nullStateProps(props []*models.Property, schemaMap map[string]interface{},
+) ([]*models.Property, map[string]struct{}) {
+	out := make([]*models.Property, 0, len(props))
+	set := make(map[string]struct{}, len(schemaMap))
+	for name := range schemaMap {
+		set[name] = struct{}{}
+	}
+
+	for _, prop := range props {
+		if !schema.IsNestedDataType(prop.DataType) {
+			out = append(out, prop)
+			continue
+		}
+
+		out = append(out, schema.NestedLeafProperties(prop)...)
+		if value, ok := schemaMap[prop.Name]; ok {
+			for name := range schema.FlattenNestedValue(prop, value) {
+				set[name] = struct{}{}
+			}
+		}
+	}
+	return out, set
+}
+
 func (s *Shard) analyzeObject(object *storobj.Object) ([]inverted.Property, []nilProp, error) {
 	schemaModel := s.index.getSchema.GetSchemaSkipAuth().Objects
 	c, err := schema.GetClassByName(schemaModel, object.Class().String())
@@ -57,18 +89,20 @@ func (s *Shard) analyzeObject(object *storobj.Object) ([]inverted.Property, []ni
 	// the null state (if enabled)
 	var nilProps []nilProp
 	if s.index.invertedIndexConfig.IndexNullState {
-		for _, prop := range c.Properties {
+		props, setProps := nullStateProps(c.Properties, schemaMap)
+		for _, prop := range props {
 			dt := schema.DataType(prop.DataType[0])
 			// some datatypes are not added to the inverted index, so we can skip them here
-			if dt == schema.DataTypeGeoCoordinates || dt == schema.DataTypePhoneNumber || dt == schema.DataTypeBlob {
+			if dt == schema.DataTypeGeoCoordinates || dt == schema.DataTypeBlob ||
+				dt == schema.DataTypeSparseVector {
 				continue
 			}
 
 			// Add props as nil props if
 			// 1. They are not in the schema map ( == nil)
 			// 2. Their inverted index is enabled
-			_, ok := schemaMap[prop.Name]
-			if !ok && inverted.HasInvertedIndex(prop) {
+			_, ok := setProps[prop.Name]
+			if !ok && (inverted.HasInvertedIndex(prop) || inverted.HasRangeableIndex(prop)) {
 				nilProps = append(nilProps, nilProp{
 					Name:                prop.Name,
 					AddToPropertyLength: isPropertyForLength(dt),
@@ -85,6 +119,21 @@ func (s *Shard) analyzeObject(object *storobj.Object) ([]inverted.Property, []ni
 		schemaMap[filters.InternalPropLastUpdateTimeUnix] = object.Object.LastUpdateTimeUnix
 	}
 
-	props, err := inverted.NewAnalyzer(s.isFallbackToSearchable).Object(schemaMap, c.Properties, object.ID())
-	return props, nilProps, err
+	analyzer := inverted.NewAnalyzer(s.isFallbackToSearchable)
+	props, err := analyzer.Object(schemaMap, c.Properties, object.ID())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if s.index.invertedIndexConfig.IndexTimestamps &&
+		s.index.invertedIndexConfig.IndexTimestampRangeFilters {
+		timestampProps, err := analyzer.TimestampRangeableProps(
+			object.Object.CreationTimeUnix, object.Object.LastUpdateTimeUnix)
+		if err != nil {
+			return nil, nil, err
+		}
+		props = append(props, timestampProps...)
+	}
+
+	return props, nilProps, nil
 }
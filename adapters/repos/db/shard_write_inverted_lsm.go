@@ -36,6 +36,12 @@ func (s *Shard) extendInvertedIndicesLSM(props []inverted.Property, nilProps []n
 			continue
 		}
 
+		// sparse vectors are only used for ranking, they have neither a
+		// length nor a null-state index
+		if prop.HasSparseVectorIndex {
+			continue
+		}
+
 		// properties where defining a length does not make sense (floats etc.) have a negative entry as length
 		if s.index.invertedIndexConfig.IndexPropertyLength && prop.Length >= 0 {
 			if err := s.addToPropertyLengthIndex(prop.Name, docID, prop.Length); err != nil {
@@ -92,13 +98,46 @@ func (s *Shard) addToPropertyValueIndex(docID uint64, property inverted.Property
 		propLen := float32(len(property.Items))
 		for _, item := range property.Items {
 			key := item.Data
-			pair := s.pairPropertyWithFrequency(docID, item.TermFrequency, propLen)
+			pair := s.pairPropertyWithFrequency(docID, item.TermFrequency, propLen, item.Positions)
 			if err := s.addToPropertyMapBucket(bucketValue, pair, key); err != nil {
 				return errors.Wrapf(err, "failed adding to prop '%s' value bucket", property.Name)
 			}
 		}
 	}
 
+	if property.HasRangeableIndex {
+		bucketValue := s.store.Bucket(helpers.BucketRangeableFromPropNameLSM(property.Name))
+		if bucketValue == nil {
+			return errors.Errorf("no bucket rangeable for prop '%s' found", property.Name)
+		}
+
+		for _, item := range property.Items {
+			keys, err := inverted.RangeableIndexKeys(item.Data)
+			if err != nil {
+				return errors.Wrapf(err, "failed creating keys for prop '%s' range index", property.Name)
+			}
+			for _, key := range keys {
+				if err := bucketValue.RoaringSetAddOne(key, docID); err != nil {
+					return errors.Wrapf(err, "failed adding to prop '%s' rangeable bucket", property.Name)
+				}
+			}
+		}
+	}
+
+	if property.HasSparseVectorIndex {
+		bucketValue := s.store.Bucket(helpers.BucketSparseVectorFromPropNameLSM(property.Name))
+		if bucketValue == nil {
+			return errors.Errorf("no bucket sparse vector for prop '%s' found", property.Name)
+		}
+
+		for _, item := range property.Items {
+			pair := s.pairSparseVectorWeight(docID, item.TermFrequency)
+			if err := s.addToPropertyMapBucket(bucketValue, pair, item.Data); err != nil {
+				return errors.Wrapf(err, "failed adding to prop '%s' sparse vector bucket", property.Name)
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -134,9 +173,13 @@ func (s *Shard) addToPropertyNullIndex(propName string, docID uint64, isNull boo
 	return nil
 }
 
-func (s *Shard) pairPropertyWithFrequency(docID uint64, freq, propLen float32) lsmkv.MapPair {
-	// 8 bytes for doc id, 4 bytes for frequency, 4 bytes for prop term length
-	buf := make([]byte, 16)
+func (s *Shard) pairPropertyWithFrequency(docID uint64, freq, propLen float32,
+	positions []uint32,
+) lsmkv.MapPair {
+	// 8 bytes for doc id, 4 bytes for frequency, 4 bytes for prop term length,
+	// followed by 4 bytes for every position of the term if the property
+	// stores positions
+	buf := make([]byte, 16+4*len(positions))
 
 	// Shard Index version 2 requires BigEndian for sorting, if the shard was
 	// built prior assume it uses LittleEndian
@@ -147,6 +190,28 @@ func (s *Shard) pairPropertyWithFrequency(docID uint64, freq, propLen float32) l
 	}
 	binary.LittleEndian.PutUint32(buf[8:12], math.Float32bits(freq))
 	binary.LittleEndian.PutUint32(buf[12:16], math.Float32bits(propLen))
+	for i, pos := range positions {
+		binary.LittleEndian.PutUint32(buf[16+4*i:], pos)
+	}
+
+	return lsmkv.MapPair{
+		Key:   buf[:8],
+		Value: buf[8:],
+	}
+}
+
+// pairSparseVectorWeight encodes the posting of a document in the posting list
+// of a sparse vector dimension, with the weight of the dimension as value
+func (s *Shard) pairSparseVectorWeight(docID uint64, weight float32) lsmkv.MapPair {
+	// 8 bytes for doc id, 4 bytes for the weight
+	buf := make([]byte, 12)
+
+	if s.versioner.Version() < 2 {
+		binary.LittleEndian.PutUint64(buf[0:8], docID)
+	} else {
+		binary.BigEndian.PutUint64(buf[0:8], docID)
+	}
+	binary.LittleEndian.PutUint32(buf[8:12], math.Float32bits(weight))
 
 	return lsmkv.MapPair{
 		Key:   buf[:8],
@@ -175,10 +240,7 @@ func (s *Shard) addToPropertySetBucket(bucket *lsmkv.Bucket, docID uint64, key [
 	lsmkv.CheckExpectedStrategy(bucket.Strategy(), lsmkv.StrategySetCollection, lsmkv.StrategyRoaringSet)
 
 	if bucket.Strategy() == lsmkv.StrategySetCollection {
-		docIDBytes := make([]byte, 8)
-		binary.LittleEndian.PutUint64(docIDBytes, docID)
-
-		return bucket.SetAdd(key, [][]byte{docIDBytes})
+		return s.roaringSetMigration.setAdd(bucket, key, []uint64{docID})
 	}
 
 	return bucket.RoaringSetAddOne(key, docID)
@@ -199,13 +261,12 @@ func (s *Shard) batchExtendInvertedIndexItemsLSMNoFrequency(b *lsmkv.Bucket,
 		return b.RoaringSetAddList(item.Data, docIDs)
 	}
 
-	docIDs := make([][]byte, len(item.DocIDs))
+	docIDs := make([]uint64, len(item.DocIDs))
 	for i, idTuple := range item.DocIDs {
-		docIDs[i] = make([]byte, 8)
-		binary.LittleEndian.PutUint64(docIDs[i], idTuple.DocID)
+		docIDs[i] = idTuple.DocID
 	}
 
-	return b.SetAdd(item.Data, docIDs)
+	return s.roaringSetMigration.setAdd(b, item.Data, docIDs)
 }
 
 func (s *Shard) addPropLengths(props []inverted.Property) error {
@@ -21,7 +21,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
 )
 
-func (s *Shard) deleteFromInvertedIndicesLSM(props []inverted.Property,
+func (s *Shard) deleteFromInvertedIndicesLSM(props []inverted.Property, nilProps []nilProp,
 	docID uint64,
 ) error {
 	for _, prop := range props {
@@ -54,11 +54,111 @@ func (s *Shard) deleteFromInvertedIndicesLSM(props []inverted.Property,
 				}
 			}
 		}
+
+		if prop.HasRangeableIndex {
+			bucket := s.store.Bucket(helpers.BucketRangeableFromPropNameLSM(prop.Name))
+			if bucket == nil {
+				return fmt.Errorf("no bucket rangeable for prop '%s' found", prop.Name)
+			}
+
+			for _, item := range prop.Items {
+				keys, err := inverted.RangeableIndexKeys(item.Data)
+				if err != nil {
+					return errors.Wrapf(err, "delete item '%x' from range index", item.Data)
+				}
+				for _, key := range keys {
+					if err := bucket.RoaringSetRemoveOne(key, docID); err != nil {
+						return errors.Wrapf(err, "delete item '%x' from range index", item.Data)
+					}
+				}
+			}
+		}
+
+		if prop.HasSparseVectorIndex {
+			bucket := s.store.Bucket(helpers.BucketSparseVectorFromPropNameLSM(prop.Name))
+			if bucket == nil {
+				return fmt.Errorf("no bucket sparse vector for prop '%s' found", prop.Name)
+			}
+
+			// the postings of sparse vectors are keyed by doc id like the ones
+			// with frequencies
+			for _, item := range prop.Items {
+				if err := s.deleteInvertedIndexItemWithFrequencyLSM(bucket, item,
+					docID); err != nil {
+					return errors.Wrapf(err, "delete item '%x' from sparse vector index",
+						item.Data)
+				}
+			}
+		}
+
+		// the length and null-state indexes are extended with the same props
+		// in extendInvertedIndicesLSM
+		if isMetaCountProperty(prop) || isInternalProperty(prop) || prop.HasSparseVectorIndex {
+			continue
+		}
+
+		if s.index.invertedIndexConfig.IndexPropertyLength && prop.Length >= 0 {
+			if err := s.deleteFromPropertyLengthIndex(prop.Name, docID, prop.Length); err != nil {
+				return errors.Wrap(err, "delete indexed property length")
+			}
+		}
+
+		if s.index.invertedIndexConfig.IndexNullState {
+			if err := s.deleteFromPropertyNullIndex(prop.Name, docID, prop.Length == 0); err != nil {
+				return errors.Wrap(err, "delete indexed null state")
+			}
+		}
+	}
+
+	for _, nilProperty := range nilProps {
+		if s.index.invertedIndexConfig.IndexPropertyLength && nilProperty.AddToPropertyLength {
+			if err := s.deleteFromPropertyLengthIndex(nilProperty.Name, docID, 0); err != nil {
+				return errors.Wrap(err, "delete indexed property length")
+			}
+		}
+
+		if s.index.invertedIndexConfig.IndexNullState {
+			if err := s.deleteFromPropertyNullIndex(nilProperty.Name, docID, true); err != nil {
+				return errors.Wrap(err, "delete indexed null state")
+			}
+		}
 	}
 
 	return nil
 }
 
+func (s *Shard) deleteFromPropertyLengthIndex(propName string, docID uint64, length int) error {
+	bucketLength := s.store.Bucket(helpers.BucketFromPropNameLengthLSM(propName))
+	if bucketLength == nil {
+		return errors.Errorf("no bucket for prop '%s' length found", propName)
+	}
+
+	key, err := s.keyPropertyLength(length)
+	if err != nil {
+		return errors.Wrapf(err, "failed creating key for prop '%s' length", propName)
+	}
+	if err := s.deleteInvertedIndexItemLSM(bucketLength, inverted.Countable{Data: key}, docID); err != nil {
+		return errors.Wrapf(err, "failed deleting from prop '%s' length bucket", propName)
+	}
+	return nil
+}
+
+func (s *Shard) deleteFromPropertyNullIndex(propName string, docID uint64, isNull bool) error {
+	bucketNull := s.store.Bucket(helpers.BucketFromPropNameNullLSM(propName))
+	if bucketNull == nil {
+		return errors.Errorf("no bucket for prop '%s' null found", propName)
+	}
+
+	key, err := s.keyPropertyNull(isNull)
+	if err != nil {
+		return errors.Wrapf(err, "failed creating key for prop '%s' null", propName)
+	}
+	if err := s.deleteInvertedIndexItemLSM(bucketNull, inverted.Countable{Data: key}, docID); err != nil {
+		return errors.Wrapf(err, "failed deleting from prop '%s' null bucket", propName)
+	}
+	return nil
+}
+
 func (s *Shard) deleteInvertedIndexItemWithFrequencyLSM(bucket *lsmkv.Bucket,
 	item inverted.Countable, docID uint64,
 ) error {
@@ -85,8 +185,5 @@ func (s *Shard) deleteInvertedIndexItemLSM(bucket *lsmkv.Bucket,
 		return bucket.RoaringSetRemoveOne(item.Data, docID)
 	}
 
-	docIDBytes := make([]byte, 8)
-	binary.LittleEndian.PutUint64(docIDBytes, docID)
-
-	return bucket.SetDeleteSingle(item.Data, docIDBytes)
+	return s.roaringSetMigration.setDelete(bucket, item.Data, docID)
 }
@@ -17,15 +17,18 @@ import (
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
 	"github.com/weaviate/weaviate/entities/models"
-	"github.com/weaviate/weaviate/entities/storagestate"
 	"github.com/weaviate/weaviate/entities/storobj"
 	"github.com/weaviate/weaviate/usecases/objects"
 )
 
 func (s *Shard) mergeObject(ctx context.Context, merge objects.MergeDocument) error {
-	if s.isReadOnly() {
-		return storagestate.ErrStatusReadOnly
+	if err := s.checkWritable(); err != nil {
+		return err
+	}
+	if err := s.checkDiskQuota(); err != nil {
+		return err
 	}
 
 	if merge.Vector != nil {
@@ -35,6 +38,9 @@ func (s *Shard) mergeObject(ctx context.Context, merge objects.MergeDocument) er
 			return errors.Wrapf(err, "Validate vector index for update of %v", merge.ID)
 		}
 	}
+	if err := s.validateTargetVectorsBeforeInsert(merge.Vectors); err != nil {
+		return errors.Wrapf(err, "Validate vector index for update of %v", merge.ID)
+	}
 
 	idBytes, err := uuid.MustParse(merge.ID.String()).MarshalBinary()
 	if err != nil {
@@ -45,7 +51,18 @@ func (s *Shard) mergeObject(ctx context.Context, merge objects.MergeDocument) er
 }
 
 func (s *Shard) merge(ctx context.Context, idBytes []byte, doc objects.MergeDocument) error {
-	next, status, err := s.mergeObjectInStorage(doc, idBytes)
+	defer s.bumpDataVersion()
+
+	if s.mergesVectorsOnly(doc) {
+		merged, err := s.mergeVectorsInPlace(ctx, idBytes, doc)
+		if err != nil || merged {
+			return err
+		}
+		// the object doesn't exist, it is created like by any other merge
+	}
+
+	precondition := objects.PreconditionFromContext(ctx, doc.ID)
+	next, status, err := s.mergeObjectInStorage(doc, idBytes, precondition)
 	if err != nil {
 		return err
 	}
@@ -54,6 +71,10 @@ func (s *Shard) merge(ctx context.Context, idBytes []byte, doc objects.MergeDocu
 		return errors.Wrap(err, "update vector index")
 	}
 
+	if err := s.updateTargetVectorIndexes(next.Vectors, status); err != nil {
+		return errors.Wrap(err, "update vector index")
+	}
+
 	if err := s.updatePropertySpecificIndices(next, status); err != nil {
 		return errors.Wrap(err, "update property-specific indices")
 	}
@@ -66,11 +87,167 @@ func (s *Shard) merge(ctx context.Context, idBytes []byte, doc objects.MergeDocu
 		return errors.Wrap(err, "flush all vector index buffered WALs")
 	}
 
+	if err := s.flushTargetVectorIndexes(); err != nil {
+		return errors.Wrap(err, "flush all vector index buffered WALs")
+	}
+
 	return nil
 }
 
+// mergesVectorsOnly is true if the merge replaces vectors of an object, but
+// doesn't change its properties and all affected vector indexes can update
+// vectors in place. Removing a vector can't be done in place, neither can
+// vectors be replaced which are indexed asynchronously, as the queue can only
+// add them.
+func (s *Shard) mergesVectorsOnly(merge objects.MergeDocument) bool {
+	if len(merge.PrimitiveSchema) > 0 || len(merge.References) > 0 ||
+		len(merge.PropertiesToDelete) > 0 {
+		return false
+	}
+	if merge.Vector == nil && len(merge.Vectors) == 0 {
+		return false
+	}
+
+	if merge.Vector != nil && !s.updatesVectorsInPlace("", s.vectorIndex, merge.Vector) {
+		return false
+	}
+	for name, vector := range merge.Vectors {
+		vi, err := s.targetVectorIndex(name)
+		if err != nil || !s.updatesVectorsInPlace(name, vi, vector) {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *Shard) updatesVectorsInPlace(targetVector string, vi VectorIndex,
+	vector []float32,
+) bool {
+	if len(vector) == 0 {
+		return false
+	}
+	if _, ok := s.indexQueues[targetVector]; ok {
+		return false
+	}
+	_, ok := vi.(updatableVectorIndex)
+	return ok
+}
+
+// mergeVectorsInPlace replaces the vectors of an existing object without
+// changing its doc id. As none of the properties change, the inverted index
+// is not rebuilt, only the indexed update time is replaced. It returns false
+// if the object doesn't exist.
+func (s *Shard) mergeVectorsInPlace(ctx context.Context, idBytes []byte,
+	merge objects.MergeDocument,
+) (bool, error) {
+	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
+
+	// unlike other writes, the lock is held until the vector indexes are
+	// updated. As the doc id stays the same, concurrent updates of the object
+	// could otherwise leave the vector indexes with a different vector than
+	// the object.
+	lock := &s.docIdLock[s.uuidToIdLockPoolId(idBytes)]
+	lock.Lock()
+	defer lock.Unlock()
+
+	previous, err := bucket.Get(idBytes)
+	if err != nil {
+		return false, errors.Wrap(err, "get bucket")
+	}
+	if previous == nil {
+		return false, nil
+	}
+
+	precondition := objects.PreconditionFromContext(ctx, merge.ID)
+	if err := s.checkPrecondition(precondition, merge.ID, previous); err != nil {
+		return false, err
+	}
+
+	nextObj, previousObj, err := s.mergeObjectData(previous, merge)
+	if err != nil {
+		return false, errors.Wrap(err, "merge object data")
+	}
+
+	docID := previousObj.DocID()
+	nextObj.SetDocID(docID)
+	nextBytes, err := nextObj.MarshalBinary()
+	if err != nil {
+		return false, errors.Wrapf(err, "marshal object %s to binary", nextObj.ID())
+	}
+
+	if err := s.upsertObjectDataLSM(bucket, idBytes, nextBytes, docID); err != nil {
+		return false, errors.Wrap(err, "upsert object data")
+	}
+
+	if s.index.invertedIndexConfig.IndexTimestamps {
+		if err := s.updateIndexedTimestampsLSM(previousObj, nextObj, docID); err != nil {
+			return false, errors.Wrap(err, "update indexed timestamps")
+		}
+	}
+
+	if s.index.Config.TrackVectorDimensions && len(previousObj.Vector) != len(nextObj.Vector) {
+		if len(previousObj.Vector) > 0 {
+			if err := s.removeDimensionsLSM(len(previousObj.Vector), docID); err != nil {
+				return false, errors.Wrap(err, "track dimensions")
+			}
+		}
+		if err := s.extendDimensionTrackerLSM(len(nextObj.Vector), docID); err != nil {
+			return false, errors.Wrap(err, "track dimensions")
+		}
+	}
+
+	if merge.Vector != nil {
+		if err := s.vectorIndex.(updatableVectorIndex).Update(docID, nextObj.Vector); err != nil {
+			return false, errors.Wrapf(err, "update doc id %d in vector index", docID)
+		}
+	}
+	for name := range merge.Vectors {
+		vi, err := s.targetVectorIndex(name)
+		if err != nil {
+			return false, err
+		}
+		if err := vi.(updatableVectorIndex).Update(docID, nextObj.Vectors[name]); err != nil {
+			return false, errors.Wrapf(err, "update doc id %d in vector index %q", docID, name)
+		}
+	}
+
+	if err := s.store.WriteWALs(); err != nil {
+		return false, errors.Wrap(err, "flush all buffered WALs")
+	}
+
+	if err := s.vectorIndex.Flush(); err != nil {
+		return false, errors.Wrap(err, "flush all vector index buffered WALs")
+	}
+
+	if err := s.flushTargetVectorIndexes(); err != nil {
+		return false, errors.Wrap(err, "flush all vector index buffered WALs")
+	}
+
+	return true, nil
+}
+
+// updateIndexedTimestampsLSM replaces the indexed timestamps of an object
+// which keeps its doc id
+func (s *Shard) updateIndexedTimestampsLSM(previous, next *storobj.Object,
+	docID uint64,
+) error {
+	var previousProps, nextProps []inverted.Property
+	if err := s.addIndexedTimestampsToProps(previous, &previousProps); err != nil {
+		return err
+	}
+	if err := s.addIndexedTimestampsToProps(next, &nextProps); err != nil {
+		return err
+	}
+
+	delta := inverted.Delta(previousProps, nextProps)
+	if err := s.deleteFromInvertedIndicesLSM(delta.ToDelete, nil, docID); err != nil {
+		return errors.Wrap(err, "delete previous timestamps")
+	}
+	return s.extendInvertedIndicesLSM(delta.ToAdd, nil, docID)
+}
+
 func (s *Shard) mergeObjectInStorage(merge objects.MergeDocument,
-	idBytes []byte,
+	idBytes []byte, precondition *objects.VersionPrecondition,
 ) (*storobj.Object, objectInsertStatus, error) {
 	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
 
@@ -83,6 +260,11 @@ func (s *Shard) mergeObjectInStorage(merge objects.MergeDocument,
 		return nil, objectInsertStatus{}, errors.Wrap(err, "get bucket")
 	}
 
+	if err := s.checkPrecondition(precondition, merge.ID, previous); err != nil {
+		lock.Unlock()
+		return nil, objectInsertStatus{}, err
+	}
+
 	nextObj, _, err := s.mergeObjectData(previous, merge)
 	if err != nil {
 		lock.Unlock()
@@ -95,6 +277,11 @@ func (s *Shard) mergeObjectInStorage(merge objects.MergeDocument,
 		return nil, status, errors.Wrap(err, "check insert/update status")
 	}
 
+	if err := s.storeBlobs(nextObj, status.docID); err != nil {
+		lock.Unlock()
+		return nil, status, errors.Wrap(err, "store blobs")
+	}
+
 	nextObj.SetDocID(status.docID)
 	nextBytes, err := nextObj.MarshalBinary()
 	if err != nil {
@@ -197,7 +384,7 @@ func (s *Shard) mergeObjectData(previous []byte,
 		previousObj.SetClass(merge.Class)
 		previousObj.SetID(merge.ID)
 	} else {
-		p, err := storobj.FromBinary(previous)
+		p, err := storobj.FromBinaryWithClass(previous, s.class())
 		if err != nil {
 			return nil, nil, errors.Wrap(err, "unmarshal previous")
 		}
@@ -244,6 +431,18 @@ func mergeProps(previous *storobj.Object,
 		next.Vector = merge.Vector
 	}
 
+	if len(merge.Vectors) > 0 {
+		next.Vectors = make(map[string][]float32, len(previous.Vectors)+len(merge.Vectors))
+		for name, vector := range previous.Vectors {
+			next.Vectors[name] = vector
+		}
+		for name, vector := range merge.Vectors {
+			next.Vectors[name] = vector
+		}
+	} else {
+		next.Vectors = previous.Vectors
+	}
+
 	next.Object.LastUpdateTimeUnix = merge.UpdateTime
 	next.SetProperties(properties)
 
@@ -18,28 +18,40 @@ import (
 	"encoding/json"
 	"time"
 
+	"github.com/go-openapi/strfmt"
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
 	"github.com/weaviate/weaviate/entities/filters"
-	"github.com/weaviate/weaviate/entities/storagestate"
 	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/objects"
 )
 
 func (s *Shard) putObject(ctx context.Context, object *storobj.Object) error {
-	if s.isReadOnly() {
-		return storagestate.ErrStatusReadOnly
+	if err := s.checkWritable(); err != nil {
+		return err
 	}
 	uuid, err := uuid.MustParse(object.ID().String()).MarshalBinary()
 	if err != nil {
 		return err
 	}
+	return s.putOneWithinQuota(ctx, uuid, object)
+}
+
+func (s *Shard) putOneWithinQuota(ctx context.Context, uuid []byte, object *storobj.Object) error {
+	if errs := s.checkObjectsQuota(ctx, []*storobj.Object{object}); errs != nil {
+		return errs[0]
+	}
 	return s.putOne(ctx, uuid, object)
 }
 
 func (s *Shard) putOne(ctx context.Context, uuid []byte, object *storobj.Object) error {
+	// outdates cached query results once the object is written, even if the
+	// write failed half way
+	defer s.bumpDataVersion()
+
 	if object.Vector != nil {
 		// validation needs to happen before any changes are done. Otherwise, insertion is aborted somewhere in-between.
 		err := s.vectorIndex.ValidateBeforeInsert(object.Vector)
@@ -47,8 +59,12 @@ func (s *Shard) putOne(ctx context.Context, uuid []byte, object *storobj.Object)
 			return errors.Wrapf(err, "Validate vector index for %v", uuid)
 		}
 	}
+	if err := s.validateTargetVectorsBeforeInsert(object.Vectors); err != nil {
+		return errors.Wrapf(err, "Validate vector index for %v", uuid)
+	}
 
-	status, err := s.putObjectLSM(object, uuid)
+	precondition := objects.PreconditionFromContext(ctx, object.ID())
+	status, err := s.putObjectLSM(object, uuid, precondition)
 	if err != nil {
 		return errors.Wrap(err, "store object in LSM store")
 	}
@@ -57,6 +73,10 @@ func (s *Shard) putOne(ctx context.Context, uuid []byte, object *storobj.Object)
 		return errors.Wrap(err, "update vector index")
 	}
 
+	if err := s.updateTargetVectorIndexes(object.Vectors, status); err != nil {
+		return errors.Wrap(err, "update vector index")
+	}
+
 	if err := s.updatePropertySpecificIndices(object, status); err != nil {
 		return errors.Wrap(err, "update property-specific indices")
 	}
@@ -73,6 +93,10 @@ func (s *Shard) putOne(ctx context.Context, uuid []byte, object *storobj.Object)
 		return errors.Wrap(err, "flush all vector index buffered WALs")
 	}
 
+	if err := s.flushTargetVectorIndexes(); err != nil {
+		return errors.Wrap(err, "flush all vector index buffered WALs")
+	}
+
 	return nil
 }
 
@@ -88,7 +112,7 @@ func (s *Shard) updateVectorIndexIgnoreDelete(vector []float32,
 		return nil
 	}
 
-	if err := s.vectorIndex.Add(status.docID, vector); err != nil {
+	if err := s.addToVectorIndex("", s.vectorIndex, status.docID, vector); err != nil {
 		return errors.Wrapf(err, "insert doc id %d to vector index", status.docID)
 	}
 
@@ -103,7 +127,7 @@ func (s *Shard) updateVectorIndex(vector []float32,
 	// exists. otherwise, the associated doc id is left dangling,
 	// resulting in failed attempts to merge an object on restarts.
 	if status.docIDChanged {
-		if err := s.vectorIndex.Delete(status.oldDocID); err != nil {
+		if err := s.deleteFromVectorIndex("", s.vectorIndex, status.oldDocID); err != nil {
 			return errors.Wrapf(err, "delete doc id %d from vector index", status.oldDocID)
 		}
 	}
@@ -114,14 +138,17 @@ func (s *Shard) updateVectorIndex(vector []float32,
 		return nil
 	}
 
-	if err := s.vectorIndex.Add(status.docID, vector); err != nil {
+	if err := s.addToVectorIndex("", s.vectorIndex, status.docID, vector); err != nil {
 		return errors.Wrapf(err, "insert doc id %d to vector index", status.docID)
 	}
 
 	return nil
 }
 
+// putObjectLSM stores object unless it fails the precondition, which is
+// checked against the previous object under the lock of the object
 func (s *Shard) putObjectLSM(object *storobj.Object, idBytes []byte,
+	precondition *objects.VersionPrecondition,
 ) (objectInsertStatus, error) {
 	before := time.Now()
 	defer s.metrics.PutObject(before)
@@ -138,6 +165,11 @@ func (s *Shard) putObjectLSM(object *storobj.Object, idBytes []byte,
 		return objectInsertStatus{}, err
 	}
 
+	if err := s.checkPrecondition(precondition, object.ID(), previous_object_bytes); err != nil {
+		lock.Unlock()
+		return objectInsertStatus{}, err
+	}
+
 	status, err := s.determineInsertStatus(previous_object_bytes, object)
 	if err != nil {
 		lock.Unlock()
@@ -145,6 +177,11 @@ func (s *Shard) putObjectLSM(object *storobj.Object, idBytes []byte,
 	}
 	s.metrics.PutObjectDetermineStatus(before)
 
+	if err := s.storeBlobs(object, status.docID); err != nil {
+		lock.Unlock()
+		return status, errors.Wrap(err, "store blobs")
+	}
+
 	object.SetDocID(status.docID)
 	data, err := object.MarshalBinary()
 	if err != nil {
@@ -170,6 +207,25 @@ func (s *Shard) putObjectLSM(object *storobj.Object, idBytes []byte,
 	return status, nil
 }
 
+// checkPrecondition checks the precondition of a write of the object id
+// against its stored binary representation previous, nil if the object
+// does not exist. The caller must hold the lock of the object.
+func (s *Shard) checkPrecondition(precondition *objects.VersionPrecondition,
+	id strfmt.UUID, previous []byte,
+) error {
+	if precondition == nil {
+		return nil
+	}
+	if previous == nil {
+		return precondition.Check(id, false, 0)
+	}
+	updated, err := storobj.LastUpdateTimeFromBinary(previous)
+	if err != nil {
+		return errors.Wrap(err, "get last update time of previous object")
+	}
+	return precondition.Check(id, true, updated)
+}
+
 type objectInsertStatus struct {
 	docID        uint64
 	docIDChanged bool
@@ -260,7 +316,7 @@ func (s *Shard) updateInvertedIndexLSM(object *storobj.Object,
 	}
 
 	if status.docIDChanged {
-		oldObject, err := storobj.FromBinary(previous)
+		oldObject, err := storobj.FromBinaryWithClass(previous, s.class())
 		if err == nil {
 
 			oldProps, _, err := s.analyzeObject(oldObject)
@@ -352,18 +408,21 @@ func (s *Shard) updateInvertedIndexCleanupOldLSM(status objectInsertStatus,
 	// NOTE: Since Doc IDs are immutable, there is no need to use a
 	// DeltaAnalyzer. docIDChanged==true, therefore the old docID is
 	// "worthless" and can be cleaned up in the inverted index fully.
-	previousObject, err := storobj.FromBinary(previous)
+	previousObject, err := storobj.FromBinaryWithClass(previous, s.class())
 	if err != nil {
 		return errors.Wrap(err, "unmarshal previous object")
 	}
 
-	// TODO text_rbm_inverted_index null props cleanup?
-	previousInvertProps, _, err := s.analyzeObject(previousObject)
+	if err := s.releaseBlobs(previousObject, status.oldDocID); err != nil {
+		return errors.Wrap(err, "release blobs of previous object")
+	}
+
+	previousInvertProps, previousNilProps, err := s.analyzeObject(previousObject)
 	if err != nil {
 		return errors.Wrap(err, "analyze previous object")
 	}
 
-	err = s.deleteFromInvertedIndicesLSM(previousInvertProps, status.oldDocID)
+	err = s.deleteFromInvertedIndicesLSM(previousInvertProps, previousNilProps, status.oldDocID)
 	if err != nil {
 		return errors.Wrap(err, "put inverted indices props")
 	}
@@ -0,0 +1,159 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestSortBySearchValuesAndExpressions(t *testing.T) {
+	dirName := t.TempDir()
+
+	logger := logrus.New()
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, nil, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(context.TODO()))
+	defer repo.Shutdown(context.Background())
+
+	vectorIndexConfig := enthnsw.NewDefaultUserConfig()
+	vectorIndexConfig.Distance = enthnsw.DistanceL2Squared
+	class := &models.Class{
+		VectorIndexConfig:   vectorIndexConfig,
+		InvertedIndexConfig: invertedConfig(),
+		Class:               "SortBySearchClass",
+		Properties: []*models.Property{
+			{
+				Name:         "description",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationWhitespace,
+			},
+			{
+				Name:     "price",
+				DataType: schema.DataTypeNumber.PropString(),
+			},
+			{
+				Name:     "quantity",
+				DataType: schema.DataTypeInt.PropString(),
+			},
+		},
+	}
+	schemaGetter.schema = schema.Schema{
+		Objects: &models.Schema{Classes: []*models.Class{class}},
+	}
+	migrator := NewMigrator(repo, logger)
+	require.Nil(t, migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+
+	// the objects are the further away from the search vector the higher their
+	// position, and their description mentions "apple" the more often the lower
+	// their position
+	descriptions := []string{"apple apple apple", "apple apple", "apple", "pear"}
+	prices := []float64{10, 20, 5, 30}
+	quantities := []int64{1, 3, 10, 2}
+	for i := range descriptions {
+		obj := &models.Object{
+			Class: class.Class,
+			ID:    strfmt.UUID(uuid.MustParse(fmt.Sprintf("%032d", i)).String()),
+			Properties: map[string]interface{}{
+				"description": descriptions[i],
+				"price":       prices[i],
+				"quantity":    quantities[i],
+			},
+		}
+		require.Nil(t, repo.PutObject(context.Background(), obj,
+			[]float32{float32(i), 1}, nil))
+	}
+
+	extractPrices := func(res []search.Result) []float64 {
+		out := make([]float64, len(res))
+		for i := range res {
+			out[i] = res[i].Schema.(map[string]interface{})["price"].(float64)
+		}
+		return out
+	}
+
+	vectorSearch := func(t *testing.T, sort []filters.Sort, limit int) []search.Result {
+		res, err := repo.VectorSearch(context.Background(), dto.GetParams{
+			ClassName:    class.Class,
+			SearchVector: []float32{0, 1},
+			Pagination:   &filters.Pagination{Limit: limit},
+			Sort:         sort,
+		})
+		require.Nil(t, err)
+		return res
+	}
+
+	t.Run("vector search sorted by distance desc", func(t *testing.T) {
+		res := vectorSearch(t, []filters.Sort{{Path: []string{"_additional", "distance"}, Order: "desc"}}, 4)
+		assert.Equal(t, []float64{30, 5, 20, 10}, extractPrices(res))
+		for i := 1; i < len(res); i++ {
+			assert.GreaterOrEqual(t, res[i-1].Dist, res[i].Dist)
+		}
+	})
+
+	t.Run("vector search sorted by expression", func(t *testing.T) {
+		// the total value of the stock: 10, 60, 50, 60 with ties ordered by
+		// the distance
+		res := vectorSearch(t, []filters.Sort{
+			{Expression: "price * quantity", Order: "desc"},
+			{Path: []string{"_additional", "distance"}, Order: "asc"},
+		}, 4)
+		assert.Equal(t, []float64{20, 30, 5, 10}, extractPrices(res))
+	})
+
+	t.Run("vector search sorted by expression before the limit", func(t *testing.T) {
+		// only the 3 closest objects are found, and then sorted
+		res := vectorSearch(t, []filters.Sort{{Expression: "price * quantity", Order: "asc"}}, 3)
+		assert.Equal(t, []float64{10, 5, 20}, extractPrices(res))
+	})
+
+	t.Run("bm25 search sorted by score asc", func(t *testing.T) {
+		res, err := repo.Search(context.Background(), dto.GetParams{
+			ClassName:  class.Class,
+			Pagination: &filters.Pagination{Limit: 10},
+			KeywordRanking: &searchparams.KeywordRanking{
+				Type:       "bm25",
+				Query:      "apple",
+				Properties: []string{"description"},
+			},
+			Sort: []filters.Sort{{Path: []string{"_additional", "score"}, Order: "asc"}},
+		})
+		require.Nil(t, err)
+		assert.Equal(t, []float64{5, 20, 10}, extractPrices(res))
+		for i := 1; i < len(res); i++ {
+			assert.LessOrEqual(t, res[i-1].Score, res[i].Score)
+		}
+	})
+}
@@ -28,12 +28,14 @@ func (bcp *basicComparatorProvider) provide(dataType schema.DataType, order stri
 		return newStringComparator(order)
 	case schema.DataTypeTextArray:
 		return newStringArrayComparator(order)
-	case schema.DataTypeNumber, schema.DataTypeInt:
+	case schema.DataTypeNumber, schema.DataTypeInt, schema.DataTypeDuration:
 		return newFloat64Comparator(order)
 	case schema.DataTypeNumberArray, schema.DataTypeIntArray:
 		return newFloat64ArrayComparator(order)
 	case schema.DataTypeDate:
 		return newDateComparator(order)
+	case schema.DataTypeDecimal:
+		return newDecimalComparator(order)
 	case schema.DataTypeDateArray:
 		return newDateArrayComparator(order)
 	case schema.DataTypeBoolean:
@@ -216,6 +218,36 @@ func (dc *dateComparator) untypedNil(x interface{}) interface{} {
 	return x
 }
 
+type decimalComparator struct {
+	lessValue int
+}
+
+func newDecimalComparator(order string) *decimalComparator {
+	return &decimalComparator{lessValue(order)}
+}
+
+func (dc *decimalComparator) compare(a, b interface{}) int {
+	a, b = dc.untypedNil(a), dc.untypedNil(b)
+	if a != nil && b != nil {
+		switch a.(*schema.Decimal).Cmp(*(b.(*schema.Decimal))) {
+		case 0:
+			return 0
+		case -1:
+			return dc.lessValue
+		default:
+			return -dc.lessValue
+		}
+	}
+	return handleNils(a == nil, b == nil, dc.lessValue)
+}
+
+func (dc *decimalComparator) untypedNil(x interface{}) interface{} {
+	if x == (*schema.Decimal)(nil) {
+		return nil
+	}
+	return x
+}
+
 type dateArrayComparator struct {
 	dc *dateComparator
 	ic *intComparator
@@ -17,6 +17,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/schema"
 )
 
 func TestBasicComparator_String(t *testing.T) {
@@ -309,6 +310,69 @@ func TestBasicComparator_Date(t *testing.T) {
 	})
 }
 
+func TestBasicComparator_Decimal(t *testing.T) {
+	mustParse := func(in string) schema.Decimal {
+		d, err := schema.ParseDecimal(in)
+		if err != nil {
+			panic(err)
+		}
+		return d
+	}
+	d1 := mustParse("-12.5")
+	d2 := mustParse("0.10000000000000000001")
+	d3 := mustParse("0.10000000000000000002")
+
+	t.Run("decimals asc", func(t *testing.T) {
+		comp := newDecimalComparator("asc")
+
+		params := []struct {
+			a        *schema.Decimal
+			b        *schema.Decimal
+			expected int
+		}{
+			{&d1, &d1, 0},
+			{&d3, &d3, 0},
+			{&d2, &d2, 0},
+			{&d3, &d1, 1},
+			{&d2, &d3, -1},
+			{nil, &d1, -1},
+			{&d2, nil, 1},
+			{nil, nil, 0},
+		}
+
+		for i, p := range params {
+			t.Run(fmt.Sprintf("data #%d", i), func(t *testing.T) {
+				assert.Equal(t, p.expected, comp.compare(p.a, p.b))
+			})
+		}
+	})
+
+	t.Run("decimals desc", func(t *testing.T) {
+		comp := newDecimalComparator("desc")
+
+		params := []struct {
+			a        *schema.Decimal
+			b        *schema.Decimal
+			expected int
+		}{
+			{&d1, &d1, 0},
+			{&d3, &d3, 0},
+			{&d2, &d2, 0},
+			{&d3, &d1, -1},
+			{&d2, &d3, 1},
+			{nil, &d1, 1},
+			{&d2, nil, -1},
+			{nil, nil, 0},
+		}
+
+		for i, p := range params {
+			t.Run(fmt.Sprintf("data #%d", i), func(t *testing.T) {
+				assert.Equal(t, p.expected, comp.compare(p.a, p.b))
+			})
+		}
+	})
+}
+
 func TestBasicComparator_DateArray(t *testing.T) {
 	t1 := time.Now()
 	t2 := time.Now().Add(time.Second)
@@ -11,7 +11,10 @@
 
 package sorter
 
-import "github.com/weaviate/weaviate/entities/storobj"
+import (
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
 
 type comparable struct {
 	docID uint64
@@ -25,20 +28,32 @@ type comparable struct {
 type comparableCreator struct {
 	extractor *comparableValueExtractor
 	propNames []string
+	// the expressions of the levels sorted by an expression
+	expressions []*filters.SortExpression
 }
 
-func newComparableCreator(extractor *comparableValueExtractor, propNames []string) *comparableCreator {
-	return &comparableCreator{extractor, propNames}
+func newComparableCreator(extractor *comparableValueExtractor, propNames []string,
+	expressions []*filters.SortExpression,
+) *comparableCreator {
+	return &comparableCreator{extractor, propNames, expressions}
 }
 
 func (c *comparableCreator) createFromBytes(docID uint64, objData []byte) *comparable {
-	return c.createFromBytesWithPayload(docID, objData, nil)
+	return c.createFromBytesWithPayload(docID, objData, nil, nil)
 }
 
-func (c *comparableCreator) createFromBytesWithPayload(docID uint64, objData []byte, payload interface{}) *comparable {
+// createFromBytesWithPayload creates a comparable of an object. The search
+// value is the distance or score of the object, if it is the result of a
+// search.
+func (c *comparableCreator) createFromBytesWithPayload(docID uint64, objData []byte,
+	searchValue *float32, payload interface{},
+) *comparable {
+	extract := func(propName string) interface{} {
+		return c.extractor.extractFromBytes(objData, propName)
+	}
 	values := make([]interface{}, len(c.propNames))
-	for level, propName := range c.propNames {
-		values[level] = c.extractor.extractFromBytes(objData, propName)
+	for level := range c.propNames {
+		values[level] = c.extractValue(level, searchValue, extract)
 	}
 	return &comparable{docID, values, payload}
 }
@@ -47,14 +62,48 @@ func (c *comparableCreator) createFromBytesWithPayload(docID uint64, objData []b
 // 	return c.createFromObjectWithPayload(object, nil)
 // }
 
-func (c *comparableCreator) createFromObjectWithPayload(object *storobj.Object, payload interface{}) *comparable {
+func (c *comparableCreator) createFromObjectWithPayload(object *storobj.Object,
+	searchValue *float32, payload interface{},
+) *comparable {
+	extract := func(propName string) interface{} {
+		return c.extractor.extractFromObject(object, propName)
+	}
 	values := make([]interface{}, len(c.propNames))
-	for level, propName := range c.propNames {
-		values[level] = c.extractor.extractFromObject(object, propName)
+	for level := range c.propNames {
+		values[level] = c.extractValue(level, searchValue, extract)
 	}
 	return &comparable{object.DocID(), values, payload}
 }
 
+func (c *comparableCreator) extractValue(level int, searchValue *float32,
+	extract func(propName string) interface{},
+) interface{} {
+	switch c.propNames[level] {
+	case propNameDistance, propNameScore:
+		// the search value is the distance of vector searches and the score of
+		// keyword searches, sorting by the other one is rejected by validation
+		if searchValue == nil {
+			return nil
+		}
+		value := float64(*searchValue)
+		return &value
+	case propNameExpression:
+		value, ok := c.expressions[level].Evaluate(func(propName string) (float64, bool) {
+			number, ok := extract(propName).(*float64)
+			if !ok || number == nil {
+				return 0, false
+			}
+			return *number, true
+		})
+		if !ok {
+			return nil
+		}
+		return &value
+	default:
+		return extract(c.propNames[level])
+	}
+}
+
 func (c *comparableCreator) extractDocIDs(comparables []*comparable) []uint64 {
 	docIDs := make([]uint64, len(comparables))
 	for i, comparable := range comparables {
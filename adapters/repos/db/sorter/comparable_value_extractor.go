@@ -24,14 +24,23 @@ import (
 
 type comparableValueExtractor struct {
 	dataTypesHelper *dataTypesHelper
+	// previous names of renamed properties, which are still looked up in
+	// the stored objects
+	previousNames map[string][]string
 }
 
 func newComparableValueExtractor(dataTypesHelper *dataTypesHelper) *comparableValueExtractor {
-	return &comparableValueExtractor{dataTypesHelper}
+	return &comparableValueExtractor{dataTypesHelper: dataTypesHelper}
 }
 
 func (e *comparableValueExtractor) extractFromBytes(objData []byte, propName string) interface{} {
 	value, success, _ := storobj.ParseAndExtractProperty(objData, propName)
+	for _, previous := range e.previousNames[propName] {
+		if len(value) > 0 {
+			break
+		}
+		value, success, _ = storobj.ParseAndExtractProperty(objData, previous)
+	}
 	// in case the property does not exist for the object return nil
 	if len(value) == 0 {
 		return nil
@@ -53,6 +62,12 @@ func (e *comparableValueExtractor) extractFromBytes(objData []byte, propName str
 		case schema.DataTypeNumber, schema.DataTypeInt:
 			n := e.mustExtractNumbers(value[:1])[0]
 			return &n
+		case schema.DataTypeDuration:
+			n := e.mustExtractDurations(value[:1])[0]
+			return &n
+		case schema.DataTypeDecimal:
+			d := e.mustExtractDecimal(value[0])
+			return &d
 		case schema.DataTypeNumberArray, schema.DataTypeIntArray:
 			na := e.mustExtractNumbers(value)
 			return &na
@@ -117,6 +132,12 @@ func (e *comparableValueExtractor) extractFromObject(object *storobj.Object, pro
 	case schema.DataTypeNumber, schema.DataTypeInt:
 		n := value.(float64)
 		return &n
+	case schema.DataTypeDuration:
+		n := e.mustExtractDurations([]string{value.(string)})[0]
+		return &n
+	case schema.DataTypeDecimal:
+		d := e.mustExtractDecimal(value.(string))
+		return &d
 	case schema.DataTypeNumberArray, schema.DataTypeIntArray:
 		na := value.([]float64)
 		return &na
@@ -176,6 +197,27 @@ func (e *comparableValueExtractor) mustExtractDates(value []string) []time.Time
 	return dates
 }
 
+// durations are compared by their number of nanoseconds
+func (e *comparableValueExtractor) mustExtractDurations(value []string) []float64 {
+	durations := make([]float64, len(value))
+	for i := range value {
+		duration, err := schema.ParseDuration(value[i])
+		if err != nil {
+			panic("sorter: not a duration")
+		}
+		durations[i] = float64(duration)
+	}
+	return durations
+}
+
+func (e *comparableValueExtractor) mustExtractDecimal(value string) schema.Decimal {
+	decimal, err := schema.ParseDecimal(value)
+	if err != nil {
+		panic("sorter: not a decimal")
+	}
+	return decimal
+}
+
 func (e *comparableValueExtractor) mustExtractPhoneNumber(value []string) *models.PhoneNumber {
 	if len(value) == 1 {
 		var phoneNumber *models.PhoneNumber
@@ -42,6 +42,9 @@ func (h *dataTypesHelper) find(propName string) []string {
 	if propName == filters.InternalPropCreationTimeUnix || propName == filters.InternalPropLastUpdateTimeUnix {
 		return []string{string(schema.DataTypeInt)}
 	}
+	if propName == propNameDistance || propName == propNameScore || propName == propNameExpression {
+		return []string{string(schema.DataTypeNumber)}
+	}
 	for _, property := range h.class.Properties {
 		if property.Name == propName {
 			return property.DataType
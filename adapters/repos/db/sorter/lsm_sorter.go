@@ -37,7 +37,12 @@ type lsmSorter struct {
 	valueExtractor  *comparableValueExtractor
 }
 
-func NewLSMSorter(store *lsmkv.Store, sch schema.Schema, className schema.ClassName) (LSMSorter, error) {
+// NewLSMSorter creates a sorter of the objects of the class. previousNames are
+// the previous names of renamed properties by their current name, objects
+// which still contain a property under one of them are sorted by that value.
+func NewLSMSorter(store *lsmkv.Store, sch schema.Schema, className schema.ClassName,
+	previousNames map[string][]string,
+) (LSMSorter, error) {
 	bucket := store.Bucket(helpers.ObjectsBucketLSM)
 	if bucket == nil {
 		return nil, fmt.Errorf("lsm sorter - bucket %s for class %s not found", helpers.ObjectsBucketLSM, className)
@@ -48,6 +53,7 @@ func NewLSMSorter(store *lsmkv.Store, sch schema.Schema, className schema.ClassN
 	}
 	dataTypesHelper := newDataTypesHelper(class)
 	comparableValuesExtractor := newComparableValueExtractor(dataTypesHelper)
+	comparableValuesExtractor.previousNames = previousNames
 
 	return &lsmSorter{bucket, dataTypesHelper, comparableValuesExtractor}, nil
 }
@@ -79,13 +85,13 @@ func (s *lsmSorter) SortDocIDsAndDists(ctx context.Context, limit int, sort []fi
 }
 
 func (s *lsmSorter) createHelper(sort []filters.Sort, limit int) (*lsmSorterHelper, error) {
-	propNames, orders, err := extractPropNamesAndOrders(sort)
+	propNames, orders, expressions, err := extractPropNamesAndOrders(sort)
 	if err != nil {
 		return nil, err
 	}
 
 	comparator := newComparator(s.dataTypesHelper, propNames, orders)
-	creator := newComparableCreator(s.valueExtractor, propNames)
+	creator := newComparableCreator(s.valueExtractor, propNames, expressions)
 	return newLsmSorterHelper(s.bucket, comparator, creator, limit), nil
 }
 
@@ -158,7 +164,7 @@ func (h *lsmSorterHelper) getSortedDocIDsAndDistances(ctx context.Context, docID
 			continue
 		}
 
-		comparable := h.creator.createFromBytesWithPayload(docID, objData, distances[i])
+		comparable := h.creator.createFromBytesWithPayload(docID, objData, &distances[i], distances[i])
 		sorter.addComparable(comparable)
 	}
 
@@ -39,7 +39,7 @@ func (s objectsSorter) Sort(objects []*storobj.Object,
 	}
 
 	limit = validateLimit(limit, count)
-	propNames, orders, err := extractPropNamesAndOrders(sort)
+	propNames, orders, expressions, err := extractPropNamesAndOrders(sort)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -48,7 +48,7 @@ func (s objectsSorter) Sort(objects []*storobj.Object,
 	dataTypesHelper := newDataTypesHelper(class)
 	valueExtractor := newComparableValueExtractor(dataTypesHelper)
 	comparator := newComparator(dataTypesHelper, propNames, orders)
-	creator := newComparableCreator(valueExtractor, propNames)
+	creator := newComparableCreator(valueExtractor, propNames, expressions)
 
 	return newObjectsSorterHelper(comparator, creator, limit).
 		sort(objects, scores)
@@ -71,10 +71,12 @@ func (h *objectsSorterHelper) sort(objects []*storobj.Object, distances []float3
 
 	for i := range objects {
 		payload := objectDistancePayload{o: objects[i]}
+		var searchValue *float32
 		if withDistances {
 			payload.d = distances[i]
+			searchValue = &payload.d
 		}
-		comparable := h.creator.createFromObjectWithPayload(objects[i], payload)
+		comparable := h.creator.createFromObjectWithPayload(objects[i], searchValue, payload)
 		sorter.addComparable(comparable)
 	}
 
@@ -377,6 +377,42 @@ func TestObjectsSorter(t *testing.T) {
 	}
 }
 
+func TestObjectsSorterByDistanceAndExpression(t *testing.T) {
+	t.Run("by distance desc", func(t *testing.T) {
+		sort := []filters.Sort{{Path: []string{"_additional", "distance"}, Order: "desc"}}
+
+		gotObjs, gotDists, err := NewObjectsSorter(sorterCitySchema()).
+			Sort(sorterCitySchemaObjects(), sorterCitySchemaDistances(), 4, sort)
+		require.Nil(t, err)
+
+		require.Equal(t, []*storobj.Object{cityAmsterdam, cityNewYork, cityBerlin, cityWroclaw}, gotObjs)
+		require.Equal(t, []float32{0.4, 0.3, 0.2, 0.1}, gotDists)
+	})
+
+	t.Run("by expression desc", func(t *testing.T) {
+		// the population density, objects without the props come last
+		sort := []filters.Sort{{Expression: "population / cityArea", Order: "desc"}}
+
+		gotObjs, gotDists, err := NewObjectsSorter(sorterCitySchema()).
+			Sort(sorterCitySchemaObjects(), sorterCitySchemaDistances(), 0, sort)
+		require.Nil(t, err)
+
+		require.Equal(t, []*storobj.Object{cityNewYork, cityAmsterdam, cityBerlin, cityWroclaw, cityNil2, cityNil}, gotObjs)
+		require.Equal(t, []float32{0.3, 0.4, 0.2, 0.1, 0.0, 0.0}, gotDists)
+	})
+
+	t.Run("by expression asc", func(t *testing.T) {
+		sort := []filters.Sort{{Expression: "population / cityArea", Order: "asc"}}
+
+		gotObjs, _, err := NewObjectsSorter(sorterCitySchema()).
+			Sort(sorterCitySchemaObjects(), nil, 0, sort)
+		require.Nil(t, err)
+
+		require.Equal(t, []string{"Nil2", "Nil", "Wroclaw", "Berlin", "Amsterdam", "New York"},
+			extractCityNames(gotObjs))
+	})
+}
+
 func createSort(property, order string) filters.Sort {
 	return filters.Sort{Path: []string{property}, Order: order}
 }
@@ -16,21 +16,45 @@ import (
 	"github.com/weaviate/weaviate/entities/filters"
 )
 
-func extractPropNamesAndOrders(sort []filters.Sort) ([]string, []string, error) {
+// the prop names of the values which are not stored with the objects, but
+// are results of the search or computed from several props
+const (
+	propNameDistance   = "_additional." + filters.SortAdditionalDistance
+	propNameScore      = "_additional." + filters.SortAdditionalScore
+	propNameExpression = "_expression"
+)
+
+func extractPropNamesAndOrders(sort []filters.Sort,
+) ([]string, []string, []*filters.SortExpression, error) {
 	propNames := make([]string, len(sort))
 	orders := make([]string, len(sort))
+	expressions := make([]*filters.SortExpression, len(sort))
 
 	for i, srt := range sort {
+		orders[i] = srt.Order
+		if srt.Expression != "" {
+			expression, err := filters.ParseSortExpression(srt.Expression)
+			if err != nil {
+				return nil, nil, nil, err
+			}
+			propNames[i] = propNameExpression
+			expressions[i] = expression
+			continue
+		}
+		if additional := srt.AdditionalProp(); additional != "" {
+			propNames[i] = "_additional." + additional
+			continue
+		}
+
 		if len(srt.Path) == 0 {
-			return nil, nil, errors.New("path parameter cannot be empty")
+			return nil, nil, nil, errors.New("path parameter cannot be empty")
 		}
 		if len(srt.Path) > 1 {
-			return nil, nil, errors.New("sorting by reference not supported, path must have exactly one argument")
+			return nil, nil, nil, errors.New("sorting by reference not supported, path must have exactly one argument")
 		}
 		propNames[i] = srt.Path[0]
-		orders[i] = srt.Order
 	}
-	return propNames, orders, nil
+	return propNames, orders, expressions, nil
 }
 
 func validateLimit(limit, elementsCount int) int {
@@ -0,0 +1,178 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestSparseVectorSearch(t *testing.T) {
+	dirName := t.TempDir()
+
+	logger := logrus.New()
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, nil, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(context.TODO()))
+	defer repo.Shutdown(context.Background())
+
+	class := &models.Class{
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		Class:               "SparseVectorClass",
+		Properties: []*models.Property{
+			{
+				Name:         "category",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationField,
+			},
+			{
+				Name:     "terms",
+				DataType: schema.DataTypeSparseVector.PropString(),
+			},
+		},
+	}
+	schemaGetter.schema = schema.Schema{
+		Objects: &models.Schema{Classes: []*models.Class{class}},
+	}
+	migrator := NewMigrator(repo, logger)
+	require.Nil(t, migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+
+	id := func(i int) strfmt.UUID {
+		return strfmt.UUID(uuid.MustParse(fmt.Sprintf("%032d", i)).String())
+	}
+	testData := []struct {
+		category string
+		terms    interface{}
+	}{
+		{"fruit", &models.SparseVector{Indices: []int64{1, 2}, Values: []float32{1, 2}}},
+		{"fruit", &models.SparseVector{Indices: []int64{2, 3}, Values: []float32{0.5, 4}}},
+		{"vegetable", &models.SparseVector{Indices: []int64{1, 3}, Values: []float32{3, 1}}},
+		// stored objects hold the sparse vector as a map
+		{"vegetable", map[string]interface{}{"indices": []interface{}{4.0}, "values": []interface{}{1.0}}},
+	}
+	for i, data := range testData {
+		obj := &models.Object{Class: class.Class, ID: id(i), Properties: map[string]interface{}{
+			"category": data.category,
+			"terms":    data.terms,
+		}}
+		require.Nil(t, repo.PutObject(context.Background(), obj, []float32{1, 3, 5, 0.4}, nil))
+	}
+
+	idx := repo.GetIndex(schema.ClassName(class.Class))
+	require.NotNil(t, idx)
+
+	search := func(t *testing.T, limit int, filter *filters.LocalFilter,
+		indices []int64, values []float32,
+	) ([]strfmt.UUID, []float32) {
+		kwr := &searchparams.KeywordRanking{
+			Type:       "sparseVector",
+			Properties: []string{"terms"},
+			SparseVector: &searchparams.NearSparseVector{
+				Property: "terms", Indices: indices, Values: values,
+			},
+		}
+		res, scores, err := idx.objectSearch(context.TODO(), limit, filter, kwr, nil, nil,
+			additional.Properties{}, nil, "", 0)
+		require.Nil(t, err)
+
+		ids := make([]strfmt.UUID, len(res))
+		for i := range res {
+			ids[i] = res[i].ID()
+			assert.Equal(t, scores[i], res[i].Object.Additional["score"])
+		}
+		return ids, scores
+	}
+
+	t.Run("ranked by dot product", func(t *testing.T) {
+		ids, scores := search(t, 10, nil, []int64{1, 3}, []float32{1, 0.5})
+		assert.Equal(t, []strfmt.UUID{id(2), id(1), id(0)}, ids)
+		assert.Equal(t, []float32{3.5, 2, 1}, scores)
+	})
+
+	t.Run("with limit", func(t *testing.T) {
+		ids, _ := search(t, 1, nil, []int64{1, 3}, []float32{1, 0.5})
+		assert.Equal(t, []strfmt.UUID{id(2)}, ids)
+	})
+
+	t.Run("stored as map", func(t *testing.T) {
+		ids, scores := search(t, 10, nil, []int64{4}, []float32{2})
+		assert.Equal(t, []strfmt.UUID{id(3)}, ids)
+		assert.Equal(t, []float32{2}, scores)
+	})
+
+	t.Run("with filter", func(t *testing.T) {
+		filter := &filters.LocalFilter{
+			Root: &filters.Clause{
+				Operator: filters.OperatorEqual,
+				On:       &filters.Path{Class: schema.ClassName(class.Class), Property: "category"},
+				Value:    &filters.Value{Type: schema.DataTypeText, Value: "fruit"},
+			},
+		}
+		ids, _ := search(t, 10, filter, []int64{1, 3}, []float32{1, 0.5})
+		assert.Equal(t, []strfmt.UUID{id(1), id(0)}, ids)
+	})
+
+	t.Run("after update", func(t *testing.T) {
+		obj := &models.Object{Class: class.Class, ID: id(2), Properties: map[string]interface{}{
+			"category": "vegetable",
+			"terms":    &models.SparseVector{Indices: []int64{3}, Values: []float32{1}},
+		}}
+		require.Nil(t, repo.PutObject(context.Background(), obj, []float32{1, 3, 5, 0.4}, nil))
+
+		ids, scores := search(t, 10, nil, []int64{1, 3}, []float32{1, 0.5})
+		assert.Equal(t, []strfmt.UUID{id(1), id(0), id(2)}, ids)
+		assert.Equal(t, []float32{2, 1, 0.5}, scores)
+	})
+
+	t.Run("after delete", func(t *testing.T) {
+		require.Nil(t, repo.DeleteObject(context.Background(), class.Class, id(1), nil, ""))
+
+		ids, _ := search(t, 10, nil, []int64{1, 3}, []float32{1, 0.5})
+		assert.Equal(t, []strfmt.UUID{id(0), id(2)}, ids)
+	})
+
+	t.Run("on a property of another type", func(t *testing.T) {
+		kwr := &searchparams.KeywordRanking{
+			Type:       "sparseVector",
+			Properties: []string{"category"},
+			SparseVector: &searchparams.NearSparseVector{
+				Property: "category", Indices: []int64{1}, Values: []float32{1},
+			},
+		}
+		_, _, err := idx.objectSearch(context.TODO(), 10, nil, kwr, nil, nil,
+			additional.Properties{}, nil, "", 0)
+		assert.NotNil(t, err)
+	})
+}
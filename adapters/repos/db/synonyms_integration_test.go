@@ -0,0 +1,124 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestBM25Synonyms(t *testing.T) {
+	dirName := t.TempDir()
+
+	logger := logrus.New()
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, nil, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(context.TODO()))
+	defer repo.Shutdown(context.Background())
+
+	invertedConfig := BM25FinvertedConfig(1.2, 0.75, "none")
+	invertedConfig.Synonyms = []*models.SynonymRule{
+		{Terms: []string{"car", "automobile"}},
+		{Terms: []string{"laptop"}, Synonyms: []string{"notebook"}},
+	}
+	class := &models.Class{
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig,
+		Class:               "SynonymClass",
+		Properties: []*models.Property{
+			{
+				Name:         "title",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationWord,
+			},
+		},
+	}
+	schemaGetter.schema = schema.Schema{
+		Objects: &models.Schema{Classes: []*models.Class{class}},
+	}
+	migrator := NewMigrator(repo, logger)
+	require.Nil(t, migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+
+	testData := []string{
+		"a red car",
+		"vintage automobile",
+		"cheap laptop",
+		"paper notebook",
+		"bicycle",
+	}
+	for i, title := range testData {
+		id := strfmt.UUID(uuid.MustParse(fmt.Sprintf("%032d", i)).String())
+		obj := &models.Object{Class: class.Class, ID: id, Properties: map[string]interface{}{"title": title}}
+		require.Nil(t, repo.PutObject(context.Background(), obj, []float32{1, 3, 5, 0.4}, nil))
+	}
+
+	idx := repo.GetIndex(schema.ClassName(class.Class))
+	require.NotNil(t, idx)
+
+	search := func(t *testing.T, query string) []uint64 {
+		kwr := &searchparams.KeywordRanking{Type: "bm25", Query: query}
+		res, _, err := idx.objectSearch(context.TODO(), 10, nil, kwr, nil, nil,
+			additional.Properties{}, nil, "", 0)
+		require.Nil(t, err)
+
+		docIDs := make([]uint64, len(res))
+		for i := range res {
+			docIDs[i] = res[i].DocID()
+		}
+		sort.Slice(docIDs, func(i, j int) bool { return docIDs[i] < docIDs[j] })
+		return docIDs
+	}
+
+	t.Run("two-way", func(t *testing.T) {
+		assert.Equal(t, []uint64{0, 1}, search(t, "car"))
+		assert.Equal(t, []uint64{0, 1}, search(t, "automobile"))
+	})
+
+	t.Run("one-way", func(t *testing.T) {
+		assert.Equal(t, []uint64{2, 3}, search(t, "laptop"))
+		assert.Equal(t, []uint64{3}, search(t, "notebook"))
+	})
+
+	t.Run("without synonyms", func(t *testing.T) {
+		assert.Equal(t, []uint64{4}, search(t, "bicycle"))
+	})
+
+	t.Run("updated synonyms", func(t *testing.T) {
+		class.InvertedIndexConfig.Synonyms = []*models.SynonymRule{
+			{Terms: []string{"bicycle", "bike"}},
+		}
+		assert.Equal(t, []uint64{0}, search(t, "car"))
+		assert.Equal(t, []uint64{4}, search(t, "bike"))
+	})
+}
@@ -0,0 +1,201 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+func TestTargetVectors(t *testing.T) {
+	dirName := t.TempDir()
+
+	logger, _ := test.NewNullLogger()
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer repo.Shutdown(context.Background())
+
+	migrator := NewMigrator(repo, logger)
+	class := &models.Class{
+		Class:               "Article",
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		VectorConfig: map[string]models.VectorConfig{
+			"title": {
+				VectorIndexType:   "hnsw",
+				VectorIndexConfig: enthnsw.NewDefaultUserConfig(),
+			},
+			"image": {
+				VectorIndexType:   "hnsw",
+				VectorIndexConfig: enthnsw.NewDefaultUserConfig(),
+			},
+		},
+		Properties: []*models.Property{
+			{
+				Name:     "headline",
+				DataType: schema.DataTypeText.PropString(),
+			},
+		},
+	}
+
+	ids := []strfmt.UUID{
+		"a4e1c3d6-63b5-4e3e-9b8a-7a4d3f7c2a01",
+		"a4e1c3d6-63b5-4e3e-9b8a-7a4d3f7c2a02",
+	}
+
+	t.Run("creating the class and add objects", func(t *testing.T) {
+		require.Nil(t,
+			migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+		schemaGetter.schema.Objects = &models.Schema{
+			Classes: []*models.Class{class},
+		}
+
+		// the title vectors rank the objects in the opposite order of the
+		// image vectors
+		objs := []*models.Object{
+			{
+				Class:      class.Class,
+				ID:         ids[0],
+				Properties: map[string]interface{}{"headline": "first"},
+				Vectors: models.Vectors{
+					"title": {1, 0, 0},
+					"image": {0, 0, 1},
+				},
+			},
+			{
+				Class:      class.Class,
+				ID:         ids[1],
+				Properties: map[string]interface{}{"headline": "second"},
+				Vectors: models.Vectors{
+					"title": {0, 0, 1},
+					"image": {1, 0, 0},
+				},
+			},
+		}
+		for _, obj := range objs {
+			require.Nil(t, repo.PutObject(context.Background(), obj, []float32{1, 2, 3}, nil))
+		}
+	})
+
+	vectorSearch := func(t *testing.T, targetVector string, vector []float32) ([]strfmt.UUID, error) {
+		res, err := repo.VectorSearch(context.Background(), dto.GetParams{
+			ClassName:    class.Class,
+			SearchVector: vector,
+			TargetVector: targetVector,
+			Pagination:   &filters.Pagination{Limit: 10},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		found := make([]strfmt.UUID, len(res))
+		for i := range res {
+			found[i] = res[i].ID
+		}
+		return found, nil
+	}
+
+	t.Run("searching each target vector", func(t *testing.T) {
+		found, err := vectorSearch(t, "title", []float32{1, 0, 0})
+		require.Nil(t, err)
+		assert.Equal(t, ids, found)
+
+		found, err = vectorSearch(t, "image", []float32{1, 0, 0})
+		require.Nil(t, err)
+		assert.Equal(t, []strfmt.UUID{ids[1], ids[0]}, found)
+	})
+
+	t.Run("searching an unknown target vector", func(t *testing.T) {
+		_, err := vectorSearch(t, "audio", []float32{1, 0, 0})
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "audio")
+	})
+
+	t.Run("the named vectors are returned with the object", func(t *testing.T) {
+		res, err := repo.ObjectByID(context.Background(), ids[0], search.SelectProperties{},
+			additional.Properties{Vector: true}, "")
+		require.Nil(t, err)
+		require.NotNil(t, res)
+		assert.Equal(t, map[string][]float32{
+			"title": {1, 0, 0},
+			"image": {0, 0, 1},
+		}, res.Vectors)
+	})
+
+	t.Run("adding an object with an unknown target vector", func(t *testing.T) {
+		obj := &models.Object{
+			Class:   class.Class,
+			ID:      "a4e1c3d6-63b5-4e3e-9b8a-7a4d3f7c2a03",
+			Vectors: models.Vectors{"audio": {1, 0, 0}},
+		}
+		err := repo.PutObject(context.Background(), obj, []float32{1, 2, 3}, nil)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "audio")
+	})
+
+	t.Run("merging a single target vector", func(t *testing.T) {
+		err := repo.Merge(context.Background(), objects.MergeDocument{
+			Class:           class.Class,
+			ID:              ids[0],
+			PrimitiveSchema: map[string]interface{}{},
+			Vector:          []float32{1, 2, 3},
+			Vectors:         map[string][]float32{"image": {1, 0, 0}},
+		}, nil, "")
+		require.Nil(t, err)
+
+		res, err := repo.ObjectByID(context.Background(), ids[0], search.SelectProperties{},
+			additional.Properties{Vector: true}, "")
+		require.Nil(t, err)
+		require.NotNil(t, res)
+		assert.Equal(t, map[string][]float32{
+			"title": {1, 0, 0},
+			"image": {1, 0, 0},
+		}, res.Vectors)
+
+		found, err := vectorSearch(t, "image", []float32{1, 0, 0})
+		require.Nil(t, err)
+		require.Len(t, found, 2)
+	})
+
+	t.Run("deleted objects are removed from every target vector", func(t *testing.T) {
+		require.Nil(t, repo.DeleteObject(context.Background(), class.Class, ids[1], nil, ""))
+
+		for _, targetVector := range []string{"title", "image"} {
+			found, err := vectorSearch(t, targetVector, []float32{1, 0, 0})
+			require.Nil(t, err)
+			assert.Equal(t, []strfmt.UUID{ids[0]}, found)
+		}
+	})
+}
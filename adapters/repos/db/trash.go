@@ -0,0 +1,304 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// trashDir is the directory inside the root path that holds the files of
+// dropped classes and shards until they are purged
+const trashDir = ".trash"
+
+// Trash makes dropping classes and shards crash-safe. Dropping happens in two
+// phases: The files are first moved into the trash, which frees their names
+// right away, e.g. for a class of the same name which is created next. They
+// are then purged in the background.
+//
+// The files to move are recorded before the first one is moved. A drop that
+// is interrupted by a crash is therefore completed on the next startup, and
+// no half-removed files are ever picked up by a new class or shard.
+type Trash struct {
+	rootPath string
+	logger   logrus.FieldLogger
+
+	seq atomic.Uint64
+	wg  sync.WaitGroup
+}
+
+func NewTrash(rootPath string, logger logrus.FieldLogger) *Trash {
+	return &Trash{rootPath: rootPath, logger: logger}
+}
+
+// trashIntent is persisted in every directory of the trash. Until it is
+// complete, entries may still be left in the root path.
+type trashIntent struct {
+	// paths relative to the root path
+	Entries  []string `json:"entries"`
+	Complete bool     `json:"complete"`
+}
+
+// drop moves the entries, given relative to the root path, to the trash and
+// purges them in the background
+func (t *Trash) drop(name string, entries []string) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	dir := filepath.Join(t.rootPath, trashDir, fmt.Sprintf("%s.%d.%d", name,
+		time.Now().UnixNano(), t.seq.Add(1)))
+	if err := os.MkdirAll(filepath.Join(dir, "data"), 0o777); err != nil {
+		return fmt.Errorf("create trash dir: %w", err)
+	}
+
+	intent := trashIntent{Entries: entries}
+	if err := writeTrashIntent(dir, intent); err != nil {
+		return err
+	}
+
+	if err := t.moveEntries(dir, entries); err != nil {
+		return err
+	}
+
+	intent.Complete = true
+	if err := writeTrashIntent(dir, intent); err != nil {
+		return err
+	}
+
+	t.purge(dir)
+	return nil
+}
+
+// moveEntries moves the entries into the data dir of the trash dir. Entries
+// which do not exist anymore were moved before a crash, or never existed.
+func (t *Trash) moveEntries(dir string, entries []string) error {
+	for i, entry := range entries {
+		// entries may be nested, e.g. the compressed vectors of a shard, so
+		// they are named after their position instead
+		dst := filepath.Join(dir, "data", strconv.Itoa(i))
+		err := os.Rename(filepath.Join(t.rootPath, entry), dst)
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return fmt.Errorf("move %s to trash: %w", entry, err)
+		}
+	}
+
+	// the renames must be durable before the intent is completed
+	return syncDir(t.rootPath)
+}
+
+// purge removes the trash dir in the background. The data is removed before
+// the intent, so that an interrupted purge is simply repeated on the next
+// startup.
+func (t *Trash) purge(dir string) {
+	t.wg.Add(1)
+	go func() {
+		defer t.wg.Done()
+
+		if err := os.RemoveAll(filepath.Join(dir, "data")); err != nil {
+			t.logger.WithField("action", "purge_trash").
+				WithField("path", dir).
+				WithError(err).
+				Error("could not purge dropped files, retrying on the next startup")
+			return
+		}
+		if err := os.RemoveAll(dir); err != nil {
+			t.logger.WithField("action", "purge_trash").
+				WithField("path", dir).
+				WithError(err).
+				Warn("could not remove trash dir")
+		}
+	}()
+}
+
+// recover completes the drops which were interrupted by a crash and purges
+// everything left in the trash. It must run before any index is loaded.
+func (t *Trash) recover() error {
+	if t == nil {
+		return nil
+	}
+
+	dirs, err := os.ReadDir(filepath.Join(t.rootPath, trashDir))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+		return fmt.Errorf("list trash: %w", err)
+	}
+
+	for _, d := range dirs {
+		dir := filepath.Join(t.rootPath, trashDir, d.Name())
+
+		// without a readable intent, either the drop had not started moving
+		// files yet, or the purge had already removed them
+		intent, err := readTrashIntent(dir)
+		if err == nil && !intent.Complete {
+			if err := t.moveEntries(dir, intent.Entries); err != nil {
+				return fmt.Errorf("complete interrupted drop %s: %w", d.Name(), err)
+			}
+
+			t.logger.WithField("action", "recover_trash").
+				WithField("path", dir).
+				Info("completed drop which was interrupted by a crash")
+		}
+
+		t.purge(dir)
+	}
+
+	return nil
+}
+
+// wait blocks until all purges which are running in the background are done
+func (t *Trash) wait() {
+	if t != nil {
+		t.wg.Wait()
+	}
+}
+
+func trashIntentPath(dir string) string {
+	return filepath.Join(dir, "intent.json")
+}
+
+func writeTrashIntent(dir string, intent trashIntent) error {
+	data, err := json.Marshal(intent)
+	if err != nil {
+		return fmt.Errorf("marshal trash intent: %w", err)
+	}
+
+	// written to a temporary file first, so that a crash never leaves a
+	// partial list of entries behind
+	tmp := trashIntentPath(dir) + ".tmp"
+	f, err := os.Create(tmp)
+	if err != nil {
+		return fmt.Errorf("write trash intent: %w", err)
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return fmt.Errorf("write trash intent: %w", err)
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return fmt.Errorf("sync trash intent: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("close trash intent: %w", err)
+	}
+
+	if err := os.Rename(tmp, trashIntentPath(dir)); err != nil {
+		return fmt.Errorf("rename trash intent: %w", err)
+	}
+	return syncDir(dir)
+}
+
+func readTrashIntent(dir string) (trashIntent, error) {
+	var intent trashIntent
+	data, err := os.ReadFile(trashIntentPath(dir))
+	if err != nil {
+		return intent, err
+	}
+	err = json.Unmarshal(data, &intent)
+	return intent, err
+}
+
+func syncDir(dir string) error {
+	f, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if err := f.Sync(); err != nil {
+		return fmt.Errorf("sync dir %s: %w", dir, err)
+	}
+	return nil
+}
+
+// dropShardFiles moves all files of a shard to the trash. The shard must not
+// be loaded, or must have been shut down. Without a trash, e.g. in tests, the
+// files are removed right away.
+func (i *Index) dropShardFiles(name string) error {
+	entries, err := i.shardFiles(name)
+	if err != nil {
+		return err
+	}
+
+	if i.Config.Trash == nil {
+		for _, entry := range entries {
+			if err := os.RemoveAll(filepath.Join(i.Config.RootPath, entry)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	return i.Config.Trash.drop(i.shardID(name), entries)
+}
+
+// shardFiles lists the files of a shard relative to the root path, including
+// the ones of its geo indexes and the compressed vectors, which are not
+// matched by shardEntries
+func (i *Index) shardFiles(name string) ([]string, error) {
+	shardID := i.shardID(name)
+	entries, err := shardEntries(i.Config.RootPath, shardID)
+	if err != nil {
+		return nil, err
+	}
+
+	i.geoPropsLock.RLock()
+	geoProps := i.geoProps
+	i.geoPropsLock.RUnlock()
+
+	if len(geoProps) > 0 {
+		all, err := os.ReadDir(i.Config.RootPath)
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range all {
+			for _, prop := range geoProps {
+				if strings.HasPrefix(entry.Name(), geoPropID(shardID, prop)+".") {
+					entries = append(entries, entry.Name())
+					break
+				}
+			}
+		}
+	}
+
+	compressedVectors := filepath.Join(i.Config.ClassName.String(), name)
+	if _, err := os.Stat(filepath.Join(i.Config.RootPath, compressedVectors)); err == nil {
+		entries = append(entries, compressedVectors)
+	}
+
+	return entries, nil
+}
+
+func (i *Index) trackGeoProp(prop *models.Property) {
+	if dt, _ := schema.AsPrimitive(prop.DataType); dt != schema.DataTypeGeoCoordinates {
+		return
+	}
+
+	i.geoPropsLock.Lock()
+	defer i.geoPropsLock.Unlock()
+	i.geoProps = append(i.geoProps, prop.Name)
+}
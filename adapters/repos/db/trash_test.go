@@ -0,0 +1,105 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrash(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+
+	writeShard := func(t *testing.T, root string) []string {
+		entries := []string{"idx_shard_lsm", "idx_shard.indexcount", "Class/shard"}
+		for _, entry := range entries {
+			require.Nil(t, os.MkdirAll(filepath.Join(root, entry), 0o777))
+			require.Nil(t, os.WriteFile(filepath.Join(root, entry, "data"), []byte("x"), 0o666))
+		}
+		return entries
+	}
+
+	assertTrashEmpty := func(t *testing.T, root string) {
+		dirs, err := os.ReadDir(filepath.Join(root, trashDir))
+		require.Nil(t, err)
+		assert.Empty(t, dirs)
+	}
+
+	t.Run("drop frees the names and purges in the background", func(t *testing.T) {
+		root := t.TempDir()
+		entries := writeShard(t, root)
+
+		trash := NewTrash(root, logger)
+		require.Nil(t, trash.drop("idx_shard", append(entries, "idx_shard.missing")))
+
+		for _, entry := range entries {
+			assert.NoDirExists(t, filepath.Join(root, entry))
+		}
+
+		// a shard of the same name can be created right away
+		writeShard(t, root)
+
+		trash.wait()
+		assertTrashEmpty(t, root)
+		for _, entry := range entries {
+			assert.FileExists(t, filepath.Join(root, entry, "data"))
+		}
+	})
+
+	t.Run("an interrupted drop is completed on startup", func(t *testing.T) {
+		root := t.TempDir()
+		entries := writeShard(t, root)
+
+		// simulate a crash after the intent was written and the first entry
+		// was moved
+		dir := filepath.Join(root, trashDir, "idx_shard.1.1")
+		require.Nil(t, os.MkdirAll(filepath.Join(dir, "data"), 0o777))
+		require.Nil(t, writeTrashIntent(dir, trashIntent{Entries: entries}))
+		require.Nil(t, os.Rename(filepath.Join(root, entries[0]),
+			filepath.Join(dir, "data", "0")))
+
+		trash := NewTrash(root, logger)
+		require.Nil(t, trash.recover())
+		trash.wait()
+
+		for _, entry := range entries {
+			assert.NoDirExists(t, filepath.Join(root, entry))
+		}
+		assertTrashEmpty(t, root)
+	})
+
+	t.Run("an interrupted purge is repeated on startup", func(t *testing.T) {
+		root := t.TempDir()
+		dir := filepath.Join(root, trashDir, "idx_shard.1.1")
+		require.Nil(t, os.MkdirAll(filepath.Join(dir, "data", "0"), 0o777))
+		require.Nil(t, writeTrashIntent(dir, trashIntent{
+			Entries:  []string{"idx_shard_lsm"},
+			Complete: true,
+		}))
+
+		trash := NewTrash(root, logger)
+		require.Nil(t, trash.recover())
+		trash.wait()
+
+		assertTrashEmpty(t, root)
+	})
+
+	t.Run("recover without a trash", func(t *testing.T) {
+		trash := NewTrash(t.TempDir(), logger)
+		assert.Nil(t, trash.recover())
+	})
+}
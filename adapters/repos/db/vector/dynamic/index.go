@@ -0,0 +1,640 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package dynamic
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/dynamic"
+	flatent "github.com/weaviate/weaviate/entities/vectorindex/flat"
+	hnswent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+// VectorIndex is the subset of the shard's vector index interface which the
+// dynamic index needs from the indexes it wraps
+type VectorIndex interface {
+	Dump(labels ...string)
+	Add(id uint64, vector []float32) error
+	Delete(id ...uint64) error
+	SearchByVector(vector []float32, k int, allow helpers.AllowList) ([]uint64, []float32, error)
+	SearchByVectorDistance(vector []float32, dist float32,
+		maxLimit int64, allow helpers.AllowList) ([]uint64, []float32, error)
+	UpdateUserConfig(updated schema.VectorIndexConfig, callback func()) error
+	Drop(ctx context.Context) error
+	Shutdown(ctx context.Context) error
+	Flush() error
+	SwitchCommitLogs(ctx context.Context) error
+	ListFiles(ctx context.Context) ([]string, error)
+	PostStartup()
+	ValidateBeforeInsert(vector []float32) error
+}
+
+// FlatIndex is the index used until the threshold is reached. Its vectors are
+// copied into the hnsw index during the upgrade.
+type FlatIndex interface {
+	VectorIndex
+	Count() int
+	Iterate(fn func(id uint64, vector []float32) bool) error
+}
+
+type rescoringIndex interface {
+	SearchByVectorWithRescore(vector []float32, k int, allow helpers.AllowList,
+		rescore *searchparams.Rescore) ([]uint64, []float32, error)
+}
+
+type exactIndex interface {
+	SearchByVectorExact(vector []float32, k int,
+		allow helpers.AllowList) ([]uint64, []float32, error)
+}
+
+type updatableIndex interface {
+	Update(id uint64, vector []float32) error
+}
+
+// The state file records which index is authoritative, so that a restart
+// never loads a partially built hnsw index. "upgraded" means the hnsw index
+// is complete, but the flat index might not have been removed yet.
+const (
+	stateFlat     = "flat"
+	stateUpgraded = "upgraded"
+	stateHNSW     = "hnsw"
+)
+
+var errUpgradeAborted = errors.New("upgrade aborted")
+
+// pendingOp is a write which hit the flat index while the hnsw index was being
+// built. A nil vector marks a delete, update marks a vector which replaces
+// the one of an existing id.
+type pendingOp struct {
+	id     uint64
+	vector []float32
+	update bool
+}
+
+// dynamic starts out as a flat index and builds an hnsw index in the
+// background once the flat index holds the configured number of vectors.
+// Reads and writes keep being served by the flat index until the hnsw index
+// has caught up, then the indexes are swapped under the write lock.
+type dynamic struct {
+	sync.RWMutex
+	id       string
+	rootPath string
+	logger   logrus.FieldLogger
+	makeFlat func(uc flatent.UserConfig) (FlatIndex, error)
+	makeHNSW func(uc hnswent.UserConfig) (VectorIndex, error)
+
+	uc    ent.UserConfig
+	state string
+	flat  FlatIndex
+	index VectorIndex
+
+	// upgrading is only changed under the write lock, writers which see it
+	// record their operation in pending for the upgrade to replay
+	upgrading      bool
+	upgradeStarted atomic.Bool
+	pendingLock    sync.Mutex
+	pending        []pendingOp
+
+	shutdown     chan struct{}
+	shutdownOnce sync.Once
+	wg           sync.WaitGroup
+}
+
+// New creates a new dynamic index or loads an existing one from disk
+func New(cfg Config, uc ent.UserConfig) (*dynamic, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid config")
+	}
+
+	if cfg.Logger == nil {
+		logger := logrus.New()
+		logger.Out = io.Discard
+		cfg.Logger = logger
+	}
+
+	index := &dynamic{
+		id:       cfg.ID,
+		rootPath: cfg.RootPath,
+		logger: cfg.Logger.WithFields(logrus.Fields{
+			"class": cfg.ClassName,
+			"shard": cfg.ShardName,
+		}),
+		makeFlat: cfg.MakeFlat,
+		makeHNSW: cfg.MakeHNSW,
+		uc:       uc,
+		shutdown: make(chan struct{}),
+	}
+
+	if err := index.init(); err != nil {
+		return nil, errors.Wrapf(err, "init index %q", index.id)
+	}
+
+	return index, nil
+}
+
+func (d *dynamic) init() error {
+	state, err := d.readState()
+	if err != nil {
+		return err
+	}
+
+	switch state {
+	case stateFlat:
+		flat, err := d.makeFlat(d.uc.Flat)
+		if err != nil {
+			return errors.Wrap(err, "flat index")
+		}
+		d.flat = flat
+		d.index = flat
+	case stateUpgraded:
+		// the upgrade completed, but the flat index was not removed yet
+		flat, err := d.makeFlat(d.uc.Flat)
+		if err != nil {
+			return errors.Wrap(err, "flat index")
+		}
+		if err := flat.Drop(context.Background()); err != nil {
+			return errors.Wrap(err, "drop flat index")
+		}
+		state = stateHNSW
+		fallthrough
+	case stateHNSW:
+		index, err := d.makeHNSW(d.uc.HNSW)
+		if err != nil {
+			return errors.Wrap(err, "hnsw index")
+		}
+		d.index = index
+		d.upgradeStarted.Store(true)
+	default:
+		return errors.Errorf("unrecognized state %q", state)
+	}
+
+	d.state = state
+	return d.writeState(state)
+}
+
+func (d *dynamic) statePath() string {
+	return filepath.Join(d.rootPath, d.stateFileName())
+}
+
+func (d *dynamic) stateFileName() string {
+	return fmt.Sprintf("%s.dynamic", d.id)
+}
+
+// readState returns stateFlat if the index was never persisted
+func (d *dynamic) readState() (string, error) {
+	contents, err := os.ReadFile(d.statePath())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return stateFlat, nil
+		}
+		return "", errors.Wrap(err, "read state")
+	}
+
+	return strings.TrimSpace(string(contents)), nil
+}
+
+// writeState replaces the state file atomically, so that a crash leaves
+// either the previous or the new state behind
+func (d *dynamic) writeState(state string) error {
+	tmp := d.statePath() + ".tmp"
+	f, err := os.Create(tmp)
+	if err != nil {
+		return errors.Wrap(err, "write state")
+	}
+	if _, err := f.WriteString(state); err != nil {
+		f.Close()
+		return errors.Wrap(err, "write state")
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return errors.Wrap(err, "write state")
+	}
+	if err := f.Close(); err != nil {
+		return errors.Wrap(err, "write state")
+	}
+
+	if err := os.Rename(tmp, d.statePath()); err != nil {
+		return errors.Wrap(err, "write state")
+	}
+	return nil
+}
+
+func (d *dynamic) Add(id uint64, vector []float32) error {
+	d.RLock()
+	defer d.RUnlock()
+
+	if err := d.index.Add(id, vector); err != nil {
+		return err
+	}
+
+	if d.upgrading {
+		d.record(pendingOp{id: id, vector: vector})
+	} else if d.flat != nil && d.flat.Count() >= d.uc.Threshold {
+		d.startUpgrade()
+	}
+	return nil
+}
+
+// Update replaces the vector of an existing id in place
+func (d *dynamic) Update(id uint64, vector []float32) error {
+	d.RLock()
+	defer d.RUnlock()
+
+	if err := update(d.index, id, vector); err != nil {
+		return err
+	}
+
+	if d.upgrading {
+		d.record(pendingOp{id: id, vector: vector, update: true})
+	}
+	return nil
+}
+
+// update uses the in-place update of the index if it has one. Indexes which
+// store vectors by id, like the flat index, simply overwrite them on add.
+func update(index VectorIndex, id uint64, vector []float32) error {
+	if ui, ok := index.(updatableIndex); ok {
+		return ui.Update(id, vector)
+	}
+	return index.Add(id, vector)
+}
+
+func (d *dynamic) Delete(ids ...uint64) error {
+	d.RLock()
+	defer d.RUnlock()
+
+	if err := d.index.Delete(ids...); err != nil {
+		return err
+	}
+
+	if d.upgrading {
+		for _, id := range ids {
+			d.record(pendingOp{id: id})
+		}
+	}
+	return nil
+}
+
+func (d *dynamic) record(op pendingOp) {
+	d.pendingLock.Lock()
+	defer d.pendingLock.Unlock()
+
+	d.pending = append(d.pending, op)
+}
+
+func (d *dynamic) takePending() []pendingOp {
+	d.pendingLock.Lock()
+	defer d.pendingLock.Unlock()
+
+	ops := d.pending
+	d.pending = nil
+	return ops
+}
+
+// startUpgrade starts building the hnsw index in the background, unless an
+// upgrade has already been started
+func (d *dynamic) startUpgrade() {
+	if !d.upgradeStarted.CompareAndSwap(false, true) {
+		return
+	}
+
+	d.wg.Add(1)
+	go func() {
+		defer d.wg.Done()
+
+		if err := d.upgrade(); err != nil {
+			if errors.Is(err, errUpgradeAborted) {
+				return
+			}
+			d.logger.WithField("action", "dynamic_index_upgrade").
+				WithError(err).Error("failed to upgrade flat index to hnsw")
+			// the next write past the threshold tries again
+			d.upgradeStarted.Store(false)
+		}
+	}()
+}
+
+func (d *dynamic) aborted() bool {
+	select {
+	case <-d.shutdown:
+		return true
+	default:
+		return false
+	}
+}
+
+// upgrade builds the hnsw index from the vectors of the flat index and swaps
+// it in. Writes which happen in the meantime are recorded and replayed, the
+// last batch of them under the write lock right before the swap.
+func (d *dynamic) upgrade() (err error) {
+	d.Lock()
+	d.upgrading = true
+	uc := d.uc
+	d.Unlock()
+
+	d.logger.WithField("action", "dynamic_index_upgrade").
+		WithField("threshold", uc.Threshold).
+		Info("flat index reached threshold, building hnsw index")
+
+	var index VectorIndex
+	defer func() {
+		if err == nil {
+			return
+		}
+
+		d.Lock()
+		d.upgrading = false
+		d.Unlock()
+		d.takePending()
+
+		if index != nil {
+			if dropErr := index.Drop(context.Background()); dropErr != nil {
+				d.logger.WithField("action", "dynamic_index_upgrade").
+					WithError(dropErr).Error("failed to drop partial hnsw index")
+			}
+		}
+	}()
+
+	// a crash during an earlier upgrade can leave a partial hnsw index behind
+	leftover, err := d.makeHNSW(uc.HNSW)
+	if err != nil {
+		return errors.Wrap(err, "hnsw index")
+	}
+	if err := leftover.Drop(context.Background()); err != nil {
+		return errors.Wrap(err, "drop leftover hnsw index")
+	}
+
+	index, err = d.makeHNSW(uc.HNSW)
+	if err != nil {
+		return errors.Wrap(err, "hnsw index")
+	}
+	index.PostStartup()
+
+	var addErr error
+	if err := d.flat.Iterate(func(id uint64, vector []float32) bool {
+		if d.aborted() {
+			addErr = errUpgradeAborted
+			return false
+		}
+		if err := index.Add(id, vector); err != nil {
+			addErr = errors.Wrapf(err, "add vector %d", id)
+			return false
+		}
+		return true
+	}); err != nil {
+		return errors.Wrap(err, "iterate flat index")
+	}
+	if addErr != nil {
+		return addErr
+	}
+
+	// catch up without blocking writers until only a few operations remain
+	for {
+		if d.aborted() {
+			return errUpgradeAborted
+		}
+		ops := d.takePending()
+		if err := replay(index, ops); err != nil {
+			return err
+		}
+		if len(ops) < 100 {
+			break
+		}
+	}
+
+	d.Lock()
+	defer d.Unlock()
+
+	if err := replay(index, d.takePending()); err != nil {
+		return err
+	}
+	if err := index.Flush(); err != nil {
+		return errors.Wrap(err, "flush hnsw index")
+	}
+	// the config might have been updated while the index was built
+	if err := index.UpdateUserConfig(d.uc.HNSW, func() {}); err != nil {
+		return errors.Wrap(err, "update hnsw config")
+	}
+	if err := d.writeState(stateUpgraded); err != nil {
+		return err
+	}
+
+	flat := d.flat
+	d.index = index
+	d.flat = nil
+	d.state = stateHNSW
+	d.upgrading = false
+
+	if err := flat.Drop(context.Background()); err != nil {
+		// the flat index is dropped again on the next startup
+		d.logger.WithField("action", "dynamic_index_upgrade").
+			WithError(err).Error("failed to drop flat index")
+		return nil
+	}
+	if err := d.writeState(stateHNSW); err != nil {
+		d.logger.WithField("action", "dynamic_index_upgrade").
+			WithError(err).Error("failed to persist state")
+		return nil
+	}
+
+	d.logger.WithField("action", "dynamic_index_upgrade").
+		Info("swapped in hnsw index")
+	return nil
+}
+
+func replay(index VectorIndex, ops []pendingOp) error {
+	for _, op := range ops {
+		if op.vector == nil {
+			if err := index.Delete(op.id); err != nil {
+				return errors.Wrapf(err, "replay delete of %d", op.id)
+			}
+			continue
+		}
+		if op.update {
+			if err := update(index, op.id, op.vector); err != nil {
+				return errors.Wrapf(err, "replay update of %d", op.id)
+			}
+			continue
+		}
+		if err := index.Add(op.id, op.vector); err != nil {
+			return errors.Wrapf(err, "replay add of %d", op.id)
+		}
+	}
+	return nil
+}
+
+// Upgraded returns whether the hnsw index has been swapped in
+func (d *dynamic) Upgraded() bool {
+	d.RLock()
+	defer d.RUnlock()
+
+	return d.state == stateHNSW
+}
+
+func (d *dynamic) SearchByVector(vector []float32, k int, allow helpers.AllowList) ([]uint64, []float32, error) {
+	d.RLock()
+	defer d.RUnlock()
+
+	return d.index.SearchByVector(vector, k, allow)
+}
+
+func (d *dynamic) SearchByVectorWithRescore(vector []float32, k int, allow helpers.AllowList,
+	rescore *searchparams.Rescore,
+) ([]uint64, []float32, error) {
+	d.RLock()
+	defer d.RUnlock()
+
+	if index, ok := d.index.(rescoringIndex); ok {
+		return index.SearchByVectorWithRescore(vector, k, allow, rescore)
+	}
+	return d.index.SearchByVector(vector, k, allow)
+}
+
+func (d *dynamic) SearchByVectorExact(vector []float32, k int, allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	d.RLock()
+	defer d.RUnlock()
+
+	index, ok := d.index.(exactIndex)
+	if !ok {
+		return nil, nil, errors.Errorf("vector index %T does not support exact search", d.index)
+	}
+	return index.SearchByVectorExact(vector, k, allow)
+}
+
+func (d *dynamic) SearchByVectorDistance(vector []float32, dist float32, maxLimit int64,
+	allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	d.RLock()
+	defer d.RUnlock()
+
+	return d.index.SearchByVectorDistance(vector, dist, maxLimit, allow)
+}
+
+func (d *dynamic) ValidateBeforeInsert(vector []float32) error {
+	d.RLock()
+	defer d.RUnlock()
+
+	return d.index.ValidateBeforeInsert(vector)
+}
+
+func (d *dynamic) UpdateUserConfig(updated schema.VectorIndexConfig, callback func()) error {
+	parsed, ok := updated.(ent.UserConfig)
+	if !ok {
+		callback()
+		return errors.Errorf("config is not UserConfig, but %T", updated)
+	}
+
+	d.Lock()
+	defer d.Unlock()
+
+	d.uc = parsed
+	if d.flat != nil {
+		return d.flat.UpdateUserConfig(parsed.Flat, callback)
+	}
+	return d.index.UpdateUserConfig(parsed.HNSW, callback)
+}
+
+// PostStartup starts the upgrade if the threshold was reached before the
+// index was last shut down
+func (d *dynamic) PostStartup() {
+	d.RLock()
+	defer d.RUnlock()
+
+	d.index.PostStartup()
+	if d.flat != nil && d.flat.Count() >= d.uc.Threshold {
+		d.startUpgrade()
+	}
+}
+
+func (d *dynamic) Flush() error {
+	d.RLock()
+	defer d.RUnlock()
+
+	return d.index.Flush()
+}
+
+func (d *dynamic) SwitchCommitLogs(ctx context.Context) error {
+	d.RLock()
+	defer d.RUnlock()
+
+	return d.index.SwitchCommitLogs(ctx)
+}
+
+// ListFiles lists the files of the active index and the state file relative
+// to the root path of the shard
+func (d *dynamic) ListFiles(ctx context.Context) ([]string, error) {
+	d.RLock()
+	defer d.RUnlock()
+
+	files, err := d.index.ListFiles(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(files, d.stateFileName()), nil
+}
+
+// stopUpgrade aborts a running upgrade and waits for it to clean up
+func (d *dynamic) stopUpgrade() {
+	d.shutdownOnce.Do(func() {
+		close(d.shutdown)
+	})
+	d.wg.Wait()
+}
+
+func (d *dynamic) Shutdown(ctx context.Context) error {
+	d.stopUpgrade()
+
+	d.Lock()
+	defer d.Unlock()
+
+	if err := d.index.Shutdown(ctx); err != nil {
+		return errors.Wrap(err, "dynamic shutdown")
+	}
+	return nil
+}
+
+func (d *dynamic) Drop(ctx context.Context) error {
+	d.stopUpgrade()
+
+	d.Lock()
+	defer d.Unlock()
+
+	if err := d.index.Drop(ctx); err != nil {
+		return errors.Wrap(err, "dynamic drop")
+	}
+	if err := os.Remove(d.statePath()); err != nil && !os.IsNotExist(err) {
+		return errors.Wrap(err, "dynamic drop")
+	}
+	return nil
+}
+
+// Dump to stdout for debugging purposes
+func (d *dynamic) Dump(labels ...string) {
+	d.RLock()
+	defer d.RUnlock()
+
+	d.index.Dump(append(labels, "dynamic: "+d.state)...)
+}
@@ -0,0 +1,240 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package dynamic
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/flat"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/schema"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/dynamic"
+	flatent "github.com/weaviate/weaviate/entities/vectorindex/flat"
+	hnswent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+// newTestIndex uses a second flat index in place of hnsw, so that the test
+// covers the upgrade itself without depending on the graph
+func newTestIndex(t *testing.T, rootPath string, uc ent.UserConfig) *dynamic {
+	newFlat := func(id string) (FlatIndex, error) {
+		index, err := flat.New(flat.Config{
+			RootPath:         rootPath,
+			ID:               id,
+			ClassName:        "Class",
+			ShardName:        "shard",
+			DistanceProvider: distancer.NewL2SquaredProvider(),
+		}, flatent.NewDefaultUserConfig(),
+			cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop())
+		if err != nil {
+			return nil, err
+		}
+		return index, nil
+	}
+
+	index, err := New(Config{
+		RootPath:  rootPath,
+		ID:        "dynamic-test",
+		ClassName: "Class",
+		ShardName: "shard",
+		MakeFlat: func(uc flatent.UserConfig) (FlatIndex, error) {
+			return newFlat("dynamic-test")
+		},
+		MakeHNSW: func(uc hnswent.UserConfig) (VectorIndex, error) {
+			index, err := newFlat("dynamic-test-hnsw")
+			if err != nil {
+				return nil, err
+			}
+			return &fakeHNSW{FlatIndex: index}, nil
+		},
+	}, uc)
+	require.Nil(t, err)
+	return index
+}
+
+type fakeHNSW struct {
+	FlatIndex
+}
+
+// UpdateUserConfig ignores the hnsw config which the flat index can't parse
+func (f *fakeHNSW) UpdateUserConfig(updated schema.VectorIndexConfig, callback func()) error {
+	callback()
+	return nil
+}
+
+func testUserConfig(threshold int) ent.UserConfig {
+	uc := ent.NewDefaultUserConfig()
+	uc.Distance = hnswent.DistanceL2Squared
+	uc.Threshold = threshold
+	return uc
+}
+
+func allIDs(t *testing.T, index *dynamic) []uint64 {
+	ids, _, err := index.SearchByVector([]float32{0, 0}, 10000, nil)
+	require.Nil(t, err)
+	return ids
+}
+
+func TestDynamicUpgrade(t *testing.T) {
+	ctx := context.Background()
+	rootPath := t.TempDir()
+	index := newTestIndex(t, rootPath, testUserConfig(100))
+
+	t.Run("below the threshold", func(t *testing.T) {
+		for i := 0; i < 99; i++ {
+			require.Nil(t, index.Add(uint64(i), []float32{float32(i), 1}))
+		}
+		assert.False(t, index.Upgraded())
+		assert.Len(t, allIDs(t, index), 99)
+	})
+
+	t.Run("reaching the threshold with concurrent writes", func(t *testing.T) {
+		require.Nil(t, index.Add(99, []float32{99, 1}))
+
+		wg := sync.WaitGroup{}
+		for w := 0; w < 4; w++ {
+			wg.Add(1)
+			go func(w int) {
+				defer wg.Done()
+				for i := 0; i < 250; i++ {
+					id := uint64(100 + w*250 + i)
+					require.Nil(t, index.Add(id, []float32{float32(id), 1}))
+					if id%10 == 0 {
+						require.Nil(t, index.Delete(id))
+					}
+					_, _, err := index.SearchByVector([]float32{1, 1}, 3, nil)
+					require.Nil(t, err)
+				}
+			}(w)
+		}
+		wg.Wait()
+
+		assert.Eventually(t, index.Upgraded, 10*time.Second, 10*time.Millisecond)
+
+		ids := allIDs(t, index)
+		assert.Len(t, ids, 1100-100)
+		for _, id := range ids {
+			if id >= 100 {
+				assert.NotZero(t, id%10)
+			}
+		}
+
+		_, err := os.Stat(filepath.Join(rootPath, "dynamic-test.flat.d"))
+		assert.True(t, os.IsNotExist(err))
+	})
+
+	t.Run("writes after the upgrade", func(t *testing.T) {
+		require.Nil(t, index.Add(5000, []float32{-1, -1}))
+		ids, _, err := index.SearchByVector([]float32{-1, -1}, 1, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{5000}, ids)
+	})
+
+	t.Run("files include the state", func(t *testing.T) {
+		require.Nil(t, index.SwitchCommitLogs(ctx))
+		files, err := index.ListFiles(ctx)
+		require.Nil(t, err)
+		assert.Contains(t, files, "dynamic-test.dynamic")
+	})
+
+	require.Nil(t, index.Shutdown(ctx))
+
+	t.Run("restart", func(t *testing.T) {
+		index := newTestIndex(t, rootPath, testUserConfig(100))
+		defer index.Shutdown(ctx)
+
+		assert.True(t, index.Upgraded())
+		assert.Len(t, allIDs(t, index), 1001)
+	})
+}
+
+func TestDynamicUpdate(t *testing.T) {
+	ctx := context.Background()
+	index := newTestIndex(t, t.TempDir(), testUserConfig(100))
+	defer index.Shutdown(ctx)
+
+	nearest := func(vector []float32) []uint64 {
+		ids, _, err := index.SearchByVector(vector, 1, nil)
+		require.Nil(t, err)
+		return ids
+	}
+
+	t.Run("before the upgrade", func(t *testing.T) {
+		for i := 0; i < 50; i++ {
+			require.Nil(t, index.Add(uint64(i), []float32{float32(i), 1}))
+		}
+		require.Nil(t, index.Update(7, []float32{-7, -7}))
+
+		assert.Equal(t, []uint64{7}, nearest([]float32{-7, -7}))
+		assert.Len(t, allIDs(t, index), 50)
+	})
+
+	t.Run("during the upgrade", func(t *testing.T) {
+		for i := 50; i < 500; i++ {
+			require.Nil(t, index.Add(uint64(i), []float32{float32(i), 1}))
+			if i%50 == 0 {
+				require.Nil(t, index.Update(uint64(i), []float32{float32(-i), -1}))
+			}
+		}
+		assert.Eventually(t, index.Upgraded, 10*time.Second, 10*time.Millisecond)
+
+		for i := 100; i < 500; i += 50 {
+			assert.Equal(t, []uint64{uint64(i)}, nearest([]float32{float32(-i), -1}))
+		}
+		assert.Equal(t, []uint64{7}, nearest([]float32{-7, -7}))
+		assert.Len(t, allIDs(t, index), 500)
+	})
+}
+
+func TestDynamicRestartAfterSwap(t *testing.T) {
+	ctx := context.Background()
+	rootPath := t.TempDir()
+
+	// simulate a crash after the swap, but before the flat index was dropped
+	index := newTestIndex(t, rootPath, testUserConfig(10))
+	require.Nil(t, index.Add(1, []float32{1, 1}))
+	require.Nil(t, index.Shutdown(ctx))
+	require.Nil(t, index.writeState(stateUpgraded))
+
+	index = newTestIndex(t, rootPath, testUserConfig(10))
+	defer index.Shutdown(ctx)
+
+	assert.True(t, index.Upgraded())
+	assert.Empty(t, allIDs(t, index))
+	_, err := os.Stat(filepath.Join(rootPath, "dynamic-test.flat.d"))
+	assert.True(t, os.IsNotExist(err))
+
+	state, err := index.readState()
+	require.Nil(t, err)
+	assert.Equal(t, stateHNSW, state)
+}
+
+func TestDynamicDrop(t *testing.T) {
+	ctx := context.Background()
+	rootPath := t.TempDir()
+	index := newTestIndex(t, rootPath, testUserConfig(10))
+
+	for i := 0; i < 20; i++ {
+		require.Nil(t, index.Add(uint64(i), []float32{float32(i), 1}))
+	}
+	require.Nil(t, index.Drop(ctx))
+
+	_, err := os.Stat(filepath.Join(rootPath, "dynamic-test.dynamic"))
+	assert.True(t, os.IsNotExist(err))
+}
@@ -0,0 +1,56 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package flat
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+// encodeBQ binary quantizes the vector, every dimension is reduced to a
+// single bit which is set if the value is positive
+func encodeBQ(vector []float32) []uint64 {
+	code := make([]uint64, (len(vector)+63)/64)
+	for i, v := range vector {
+		if v > 0 {
+			code[i/64] |= 1 << (i % 64)
+		}
+	}
+	return code
+}
+
+// hammingDistance between two codes of the same length. It is only an
+// approximation of the actual distance, which is why candidates found with
+// it are rescored using the full vectors.
+func hammingDistance(a, b []uint64) float32 {
+	dist := 0
+	for i := range a {
+		dist += bits.OnesCount64(a[i] ^ b[i])
+	}
+	return float32(dist)
+}
+
+func codeToBytes(code []uint64) []byte {
+	out := make([]byte, len(code)*8)
+	for i, c := range code {
+		binary.LittleEndian.PutUint64(out[i*8:], c)
+	}
+	return out
+}
+
+func bytesToCode(in []byte) []uint64 {
+	out := make([]uint64, len(in)/8)
+	for i := range out {
+		out[i] = binary.LittleEndian.Uint64(in[i*8:])
+	}
+	return out
+}
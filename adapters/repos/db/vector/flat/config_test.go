@@ -0,0 +1,70 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package flat
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/schema"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/flat"
+)
+
+func TestUserConfigUpdates(t *testing.T) {
+	type test struct {
+		name          string
+		initial       schema.VectorIndexConfig
+		update        schema.VectorIndexConfig
+		expectedError string
+	}
+
+	tests := []test{
+		{
+			name:    "attempting to change the distance",
+			initial: ent.UserConfig{Distance: "cosine"},
+			update:  ent.UserConfig{Distance: "l2-squared"},
+			expectedError: "distance is immutable: " +
+				"attempted change from \"cosine\" to \"l2-squared\"",
+		},
+		{
+			name:    "attempting to enable bq",
+			initial: ent.UserConfig{BQ: ent.BQConfig{Enabled: false}},
+			update:  ent.UserConfig{BQ: ent.BQConfig{Enabled: true}},
+			expectedError: "bq.enabled is immutable: " +
+				"attempted change from \"false\" to \"true\"",
+		},
+		{
+			name: "changing the rescore limit and the maximum number of objects",
+			initial: ent.UserConfig{
+				MaxObjects: 100,
+				BQ:         ent.BQConfig{Enabled: true, RescoreLimit: 100},
+			},
+			update: ent.UserConfig{
+				MaxObjects: 1000,
+				BQ:         ent.BQConfig{Enabled: true, RescoreLimit: 500},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := ValidateUserConfigUpdate(test.initial, test.update)
+			if test.expectedError == "" {
+				assert.Nil(t, err)
+			} else {
+				require.NotNil(t, err)
+				assert.Equal(t, test.expectedError, err.Error())
+			}
+		})
+	}
+}
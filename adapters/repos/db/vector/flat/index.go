@@ -0,0 +1,417 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package flat
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/schema"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/flat"
+)
+
+const (
+	vectorsBucket           = "vectors"
+	compressedVectorsBucket = "vectors_compressed"
+)
+
+// flat is a vector index without any graph structure. Every search scans all
+// vectors and calculates the exact distance, so recall is always perfect and
+// imports are as fast as writing the vector to disk. This makes it a good
+// fit for small classes, where a graph would cost more than it saves.
+//
+// The full vectors are kept in their own lsmkv store. With bq enabled, a
+// binary quantized copy of each vector is additionally held in memory. The
+// scan then compares the much smaller codes and only the closest candidates
+// are rescored with their full vectors.
+type flat struct {
+	sync.RWMutex
+
+	id        string
+	rootPath  string
+	className string
+	shardName string
+	logger    logrus.FieldLogger
+
+	distancerProvider distancer.Provider
+
+	store      *lsmkv.Store
+	dimensions int
+	count      int
+
+	bqEnabled    bool
+	bqCodes      map[uint64][]uint64
+	rescoreLimit int
+	maxObjects   int
+}
+
+// New creates a new flat index or loads an existing one from disk
+func New(cfg Config, uc ent.UserConfig,
+	compactionCallbacks, flushCallbacks cyclemanager.CycleCallbackGroup,
+) (*flat, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid config")
+	}
+
+	if cfg.Logger == nil {
+		logger := logrus.New()
+		logger.Out = io.Discard
+		cfg.Logger = logger
+	}
+
+	index := &flat{
+		id:                cfg.ID,
+		rootPath:          cfg.RootPath,
+		className:         cfg.ClassName,
+		shardName:         cfg.ShardName,
+		logger:            cfg.Logger,
+		distancerProvider: cfg.DistanceProvider,
+		bqEnabled:         uc.BQ.Enabled,
+		bqCodes:           map[uint64][]uint64{},
+		rescoreLimit:      uc.BQ.RescoreLimit,
+		maxObjects:        uc.MaxObjects,
+	}
+
+	if err := index.init(compactionCallbacks, flushCallbacks); err != nil {
+		return nil, errors.Wrapf(err, "init index %q", index.id)
+	}
+
+	return index, nil
+}
+
+func (f *flat) init(compactionCallbacks, flushCallbacks cyclemanager.CycleCallbackGroup) error {
+	store, err := lsmkv.New(f.dir(), f.rootPath, f.logger, nil,
+		compactionCallbacks, flushCallbacks)
+	if err != nil {
+		return errors.Wrap(err, "init lsmkv store")
+	}
+	f.store = store
+
+	ctx := context.Background()
+	if err := store.CreateOrLoadBucket(ctx, vectorsBucket); err != nil {
+		return errors.Wrap(err, "create or load vectors bucket")
+	}
+
+	bucket := store.Bucket(vectorsBucket)
+	f.count = bucket.Count()
+
+	c := bucket.Cursor()
+	if _, v := c.First(); v != nil {
+		f.dimensions = len(v) / 4
+	}
+	c.Close()
+
+	if !f.bqEnabled {
+		return nil
+	}
+
+	if err := store.CreateOrLoadBucket(ctx, compressedVectorsBucket); err != nil {
+		return errors.Wrap(err, "create or load compressed vectors bucket")
+	}
+
+	// the codes are small enough to be held in memory, so they are only read
+	// from disk once on startup
+	c = store.Bucket(compressedVectorsBucket).Cursor()
+	defer c.Close()
+	for k, v := c.First(); k != nil; k, v = c.Next() {
+		f.bqCodes[binary.BigEndian.Uint64(k)] = bytesToCode(v)
+	}
+
+	return nil
+}
+
+func (f *flat) dir() string {
+	return filepath.Join(f.rootPath, fmt.Sprintf("%s.flat.d", f.id))
+}
+
+func (f *flat) Add(id uint64, vector []float32) error {
+	if len(vector) == 0 {
+		return errors.Errorf("insert called with nil-vector")
+	}
+
+	if f.distancerProvider.Type() == "cosine-dot" {
+		// cosine-dot requires normalized vectors, as the dot product and cosine
+		// similarity are only identical if the vector is normalized
+		vector = distancer.Normalize(vector)
+	}
+
+	f.Lock()
+	defer f.Unlock()
+
+	if f.dimensions != 0 && f.dimensions != len(vector) {
+		return fmt.Errorf("new node has a vector with length %v. "+
+			"Existing nodes have vectors with length %v", len(vector), f.dimensions)
+	}
+
+	key := idToKey(id)
+	bucket := f.store.Bucket(vectorsBucket)
+	existing, err := bucket.Get(key)
+	if err != nil {
+		return errors.Wrapf(err, "check for existing vector %d", id)
+	}
+
+	if existing == nil && f.maxObjects > 0 && f.count >= f.maxObjects {
+		return errors.Errorf("flat index is full: it already contains the "+
+			"maximum of %d objects set in maxObjects", f.maxObjects)
+	}
+
+	if err := bucket.Put(key, vectorToBytes(vector)); err != nil {
+		return errors.Wrapf(err, "put vector %d", id)
+	}
+
+	if f.bqEnabled {
+		code := encodeBQ(vector)
+		if err := f.store.Bucket(compressedVectorsBucket).Put(key, codeToBytes(code)); err != nil {
+			return errors.Wrapf(err, "put compressed vector %d", id)
+		}
+		f.bqCodes[id] = code
+	}
+
+	if existing == nil {
+		f.count++
+	}
+	f.dimensions = len(vector)
+	return nil
+}
+
+// Update replaces the vector of id. Vectors are stored by id, so this is the
+// same as adding it again.
+func (f *flat) Update(id uint64, vector []float32) error {
+	return f.Add(id, vector)
+}
+
+// Delete removes the vectors right away, as there is no graph that would
+// need to be repaired there is no need for tombstones
+func (f *flat) Delete(ids ...uint64) error {
+	f.Lock()
+	defer f.Unlock()
+
+	bucket := f.store.Bucket(vectorsBucket)
+	for _, id := range ids {
+		key := idToKey(id)
+		existing, err := bucket.Get(key)
+		if err != nil {
+			return errors.Wrapf(err, "check for existing vector %d", id)
+		}
+		if existing == nil {
+			continue
+		}
+
+		if err := bucket.Delete(key); err != nil {
+			return errors.Wrapf(err, "delete vector %d", id)
+		}
+
+		if f.bqEnabled {
+			if err := f.store.Bucket(compressedVectorsBucket).Delete(key); err != nil {
+				return errors.Wrapf(err, "delete compressed vector %d", id)
+			}
+			delete(f.bqCodes, id)
+		}
+
+		f.count--
+	}
+
+	return nil
+}
+
+// Count returns the number of vectors in the index
+func (f *flat) Count() int {
+	f.RLock()
+	defer f.RUnlock()
+	return f.count
+}
+
+// Iterate calls fn for every vector of the index in ascending order of the
+// ids until fn returns false. The vectors are read in chunks and fn is called
+// without holding a cursor, so neither writes nor flushes are blocked while
+// fn is running.
+func (f *flat) Iterate(fn func(id uint64, vector []float32) bool) error {
+	const chunkSize = 1000
+
+	type entry struct {
+		id     uint64
+		vector []float32
+	}
+
+	var next []byte
+	for {
+		chunk := make([]entry, 0, chunkSize)
+		c := f.store.Bucket(vectorsBucket).Cursor()
+		k, v := c.First()
+		if next != nil {
+			k, v = c.Seek(next)
+		}
+		for ; k != nil && len(chunk) < chunkSize; k, v = c.Next() {
+			chunk = append(chunk, entry{
+				id:     binary.BigEndian.Uint64(k),
+				vector: bytesToVector(v),
+			})
+		}
+		c.Close()
+
+		for _, e := range chunk {
+			if !fn(e.id, e.vector) {
+				return nil
+			}
+		}
+
+		if len(chunk) < chunkSize {
+			return nil
+		}
+		next = idToKey(chunk[len(chunk)-1].id + 1)
+	}
+}
+
+// vectorByID returns nil if there is no vector with the given id
+func (f *flat) vectorByID(id uint64) ([]float32, error) {
+	v, err := f.store.Bucket(vectorsBucket).Get(idToKey(id))
+	if err != nil || v == nil {
+		return nil, err
+	}
+
+	return bytesToVector(v), nil
+}
+
+func (f *flat) ValidateBeforeInsert(vector []float32) error {
+	f.RLock()
+	defer f.RUnlock()
+
+	if f.dimensions == 0 {
+		return nil
+	}
+
+	if f.dimensions != len(vector) {
+		return fmt.Errorf("new node has a vector with length %v. "+
+			"Existing nodes have vectors with length %v", len(vector), f.dimensions)
+	}
+
+	return nil
+}
+
+func (f *flat) UpdateUserConfig(updated schema.VectorIndexConfig, callback func()) error {
+	defer callback()
+
+	parsed, ok := updated.(ent.UserConfig)
+	if !ok {
+		return errors.Errorf("config is not UserConfig, but %T", updated)
+	}
+
+	f.Lock()
+	defer f.Unlock()
+
+	f.rescoreLimit = parsed.BQ.RescoreLimit
+	f.maxObjects = parsed.MaxObjects
+
+	return nil
+}
+
+// SwitchCommitLogs flushes the memtables, so that all vectors are contained
+// in the segments returned by ListFiles
+func (f *flat) SwitchCommitLogs(ctx context.Context) error {
+	return f.store.FlushMemtables(ctx)
+}
+
+// ListFiles lists all files of the index relative to the root path of the
+// shard
+func (f *flat) ListFiles(ctx context.Context) ([]string, error) {
+	files, err := f.store.ListFiles(ctx)
+	if err != nil {
+		return nil, errors.Errorf("failed to list files for flat index: %s", err)
+	}
+
+	return files, nil
+}
+
+// PostStartup is a no-op, everything is loaded in New
+func (f *flat) PostStartup() {}
+
+func (f *flat) Flush() error {
+	return f.store.WriteWALs()
+}
+
+func (f *flat) Shutdown(ctx context.Context) error {
+	f.Lock()
+	defer f.Unlock()
+
+	if err := f.store.Shutdown(ctx); err != nil {
+		return errors.Wrap(err, "flat shutdown")
+	}
+
+	return nil
+}
+
+func (f *flat) Drop(ctx context.Context) error {
+	f.Lock()
+	defer f.Unlock()
+
+	if err := f.store.Shutdown(ctx); err != nil {
+		return errors.Wrap(err, "flat drop")
+	}
+
+	if err := os.RemoveAll(f.dir()); err != nil {
+		return errors.Wrap(err, "flat drop")
+	}
+
+	return nil
+}
+
+// Dump to stdout for debugging purposes
+func (f *flat) Dump(labels ...string) {
+	f.RLock()
+	defer f.RUnlock()
+
+	if len(labels) > 0 {
+		fmt.Printf("--------------------------------------------------\n")
+		fmt.Printf("--  %s\n", strings.Join(labels, ", "))
+	}
+	fmt.Printf("--------------------------------------------------\n")
+	fmt.Printf("ID: %s\n", f.id)
+	fmt.Printf("Dimensions: %d\n", f.dimensions)
+	fmt.Printf("Vectors: %d\n", f.count)
+	fmt.Printf("BQ enabled: %t\n", f.bqEnabled)
+	fmt.Printf("--------------------------------------------------\n")
+}
+
+// ids are encoded big endian, so the cursor iterates them in ascending order
+func idToKey(id uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, id)
+	return key
+}
+
+func vectorToBytes(vector []float32) []byte {
+	out := make([]byte, len(vector)*4)
+	for i, v := range vector {
+		binary.LittleEndian.PutUint32(out[i*4:], math.Float32bits(v))
+	}
+	return out
+}
+
+func bytesToVector(in []byte) []float32 {
+	out := make([]float32, len(in)/4)
+	for i := range out {
+		out[i] = math.Float32frombits(binary.LittleEndian.Uint32(in[i*4:]))
+	}
+	return out
+}
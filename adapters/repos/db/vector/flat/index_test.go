@@ -0,0 +1,317 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package flat
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/flat"
+)
+
+func newTestIndex(t *testing.T, rootPath string, uc ent.UserConfig,
+	provider distancer.Provider,
+) *flat {
+	index, err := New(Config{
+		RootPath:         rootPath,
+		ID:               "flat-test",
+		ClassName:        "Class",
+		ShardName:        "shard",
+		DistanceProvider: provider,
+	}, uc, cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop())
+	require.Nil(t, err)
+	return index
+}
+
+func distanceWrapper(provider distancer.Provider) testinghelpers.DistanceFunction {
+	return func(a, b []float32) float32 {
+		d, _, _ := provider.SingleDist(a, b)
+		return d
+	}
+}
+
+func recall(t *testing.T, index *flat, vectors, queries [][]float32, k int) float32 {
+	var relevant, retrieved uint64
+	for _, query := range queries {
+		truth := testinghelpers.BruteForce(vectors, query, k,
+			distanceWrapper(index.distancerProvider))
+		results, _, err := index.SearchByVector(query, k, nil)
+		require.Nil(t, err)
+		retrieved += uint64(len(truth))
+		relevant += testinghelpers.MatchesInLists(truth, results)
+	}
+
+	return float32(relevant) / float32(retrieved)
+}
+
+func TestFlat(t *testing.T) {
+	ctx := context.Background()
+	rootPath := t.TempDir()
+	vectors, queries := testinghelpers.RandomVecs(500, 20, 32)
+	k := 10
+
+	uc := ent.NewDefaultUserConfig()
+	provider := distancer.NewL2SquaredProvider()
+	index := newTestIndex(t, rootPath, uc, provider)
+
+	t.Run("searching the empty index", func(t *testing.T) {
+		ids, dists, err := index.SearchByVector(queries[0], k, nil)
+		require.Nil(t, err)
+		assert.Len(t, ids, 0)
+		assert.Len(t, dists, 0)
+	})
+
+	t.Run("importing", func(t *testing.T) {
+		for i, vec := range vectors {
+			require.Nil(t, index.Add(uint64(i), vec))
+		}
+	})
+
+	t.Run("vectors of a different length are rejected", func(t *testing.T) {
+		err := index.ValidateBeforeInsert(make([]float32, 16))
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "new node has a vector with length 16")
+
+		err = index.Add(uint64(len(vectors)), make([]float32, 16))
+		require.NotNil(t, err)
+	})
+
+	t.Run("recall is exact", func(t *testing.T) {
+		assert.Equal(t, float32(1), recall(t, index, vectors, queries, k))
+	})
+
+	t.Run("results are ordered by distance", func(t *testing.T) {
+		ids, dists, err := index.SearchByVector(queries[0], k, nil)
+		require.Nil(t, err)
+		require.Len(t, ids, k)
+		for i := range ids {
+			expected, _, err := provider.SingleDist(queries[0], vectors[ids[i]])
+			require.Nil(t, err)
+			assert.InDelta(t, expected, dists[i], 1e-5)
+			if i > 0 {
+				assert.LessOrEqual(t, dists[i-1], dists[i])
+			}
+		}
+	})
+
+	t.Run("search with an allow list", func(t *testing.T) {
+		allowList := helpers.NewAllowList()
+		for i := uint64(0); i < uint64(len(vectors)); i += 2 {
+			allowList.Insert(i)
+		}
+		results, _, err := index.SearchByVector(queries[0], k, allowList)
+		require.Nil(t, err)
+		require.Len(t, results, k)
+		for _, id := range results {
+			assert.Equal(t, uint64(0), id%2)
+		}
+	})
+
+	t.Run("search by distance", func(t *testing.T) {
+		_, dists, err := index.SearchByVector(queries[0], 5, nil)
+		require.Nil(t, err)
+		target := dists[4]
+
+		ids, dists, err := index.SearchByVectorDistance(queries[0], target, -1, nil)
+		require.Nil(t, err)
+		assert.Len(t, ids, 5)
+		for _, d := range dists {
+			assert.LessOrEqual(t, d, target)
+		}
+
+		ids, _, err = index.SearchByVectorDistance(queries[0], target, 3, nil)
+		require.Nil(t, err)
+		assert.Len(t, ids, 3)
+	})
+
+	t.Run("restarting the index from disk", func(t *testing.T) {
+		require.Nil(t, index.Shutdown(ctx))
+		index = newTestIndex(t, rootPath, uc, provider)
+		assert.Equal(t, len(vectors), index.count)
+		assert.Equal(t, 32, index.dimensions)
+		assert.Equal(t, float32(1), recall(t, index, vectors, queries, k))
+	})
+
+	t.Run("deleted vectors are not returned", func(t *testing.T) {
+		results, _, err := index.SearchByVector(queries[0], k, nil)
+		require.Nil(t, err)
+		require.Nil(t, index.Delete(results[0], results[1]))
+		assert.Equal(t, len(vectors)-2, index.count)
+
+		updated, _, err := index.SearchByVector(queries[0], k, nil)
+		require.Nil(t, err)
+		assert.Equal(t, results[2:], updated[:k-2])
+	})
+
+	t.Run("listing files", func(t *testing.T) {
+		require.Nil(t, index.SwitchCommitLogs(ctx))
+		files, err := index.ListFiles(ctx)
+		require.Nil(t, err)
+		require.NotEmpty(t, files)
+		for _, file := range files {
+			assert.Contains(t, file, filepath.Join("flat-test.flat.d", vectorsBucket))
+		}
+	})
+
+	t.Run("dropping the index", func(t *testing.T) {
+		require.Nil(t, index.Drop(ctx))
+		_, err := os.Stat(filepath.Join(rootPath, "flat-test.flat.d"))
+		assert.True(t, os.IsNotExist(err))
+	})
+}
+
+func TestFlatBQ(t *testing.T) {
+	ctx := context.Background()
+	rootPath := t.TempDir()
+	vectors, queries := testinghelpers.RandomVecs(500, 20, 64)
+	k := 10
+
+	uc := ent.NewDefaultUserConfig()
+	uc.BQ.Enabled = true
+	uc.BQ.RescoreLimit = len(vectors)
+	provider := distancer.NewCosineDistanceProvider()
+	index := newTestIndex(t, rootPath, uc, provider)
+
+	for i, vec := range vectors {
+		require.Nil(t, index.Add(uint64(i), vec))
+	}
+	testinghelpers.Normalize(vectors)
+	testinghelpers.Normalize(queries)
+
+	t.Run("rescoring all candidates is exact", func(t *testing.T) {
+		assert.Equal(t, float32(1), recall(t, index, vectors, queries, k))
+	})
+
+	t.Run("rescoring the closest candidates", func(t *testing.T) {
+		require.Nil(t, index.UpdateUserConfig(ent.UserConfig{
+			Distance: uc.Distance,
+			BQ:       ent.BQConfig{Enabled: true, RescoreLimit: 200},
+		}, func() {}))
+		assert.Greater(t, recall(t, index, vectors, queries, k), float32(0.8))
+	})
+
+	t.Run("a query can override the rescore limit", func(t *testing.T) {
+		for _, rescore := range []*searchparams.Rescore{
+			{Limit: len(vectors)},
+			{Oversampling: float64(len(vectors) / k)},
+		} {
+			for _, query := range queries {
+				truth := testinghelpers.BruteForce(vectors, query, k, distanceWrapper(provider))
+				results, _, err := index.SearchByVectorWithRescore(query, k, nil, rescore)
+				require.Nil(t, err)
+				assert.Equal(t, uint64(k), testinghelpers.MatchesInLists(truth, results))
+			}
+		}
+	})
+
+	t.Run("an exact query ignores the codes", func(t *testing.T) {
+		for _, query := range queries {
+			truth := testinghelpers.BruteForce(vectors, query, k, distanceWrapper(provider))
+			results, _, err := index.SearchByVectorExact(query, k, nil)
+			require.Nil(t, err)
+			assert.Equal(t, uint64(k), testinghelpers.MatchesInLists(truth, results))
+		}
+	})
+
+	t.Run("the codes are restored after a restart", func(t *testing.T) {
+		require.Nil(t, index.Shutdown(ctx))
+		index = newTestIndex(t, rootPath, uc, provider)
+		assert.Len(t, index.bqCodes, len(vectors))
+		assert.Equal(t, float32(1), recall(t, index, vectors, queries, k))
+	})
+
+	t.Run("deleting removes the codes", func(t *testing.T) {
+		require.Nil(t, index.Delete(0, 1, 2))
+		assert.Len(t, index.bqCodes, len(vectors)-3)
+
+		allowList := helpers.NewAllowList(0, 1, 2, 3)
+		ids, _, err := index.SearchByVector(queries[0], k, allowList)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{3}, ids)
+	})
+
+	require.Nil(t, index.Shutdown(ctx))
+}
+
+func TestFlatMaxObjects(t *testing.T) {
+	ctx := context.Background()
+	uc := ent.NewDefaultUserConfig()
+	uc.MaxObjects = 10
+	index := newTestIndex(t, t.TempDir(), uc, distancer.NewL2SquaredProvider())
+
+	vectors, _ := testinghelpers.RandomVecs(11, 0, 8)
+	for i := 0; i < 10; i++ {
+		require.Nil(t, index.Add(uint64(i), vectors[i]))
+	}
+
+	t.Run("inserting beyond the limit fails", func(t *testing.T) {
+		err := index.Add(10, vectors[10])
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "maximum of 10 objects")
+	})
+
+	t.Run("overwriting an existing vector is allowed", func(t *testing.T) {
+		require.Nil(t, index.Add(0, vectors[10]))
+	})
+
+	t.Run("deleting makes room again", func(t *testing.T) {
+		require.Nil(t, index.Delete(1))
+		require.Nil(t, index.Add(10, vectors[10]))
+	})
+
+	require.Nil(t, index.Shutdown(ctx))
+}
+
+func TestFlatIterate(t *testing.T) {
+	index := newTestIndex(t, t.TempDir(), ent.NewDefaultUserConfig(),
+		distancer.NewL2SquaredProvider())
+	defer index.Shutdown(context.Background())
+
+	// spans several chunks
+	for i := 0; i < 2500; i++ {
+		require.Nil(t, index.Add(uint64(i), []float32{float32(i), 1}))
+	}
+	require.Nil(t, index.Delete(7))
+	assert.Equal(t, 2499, index.Count())
+
+	t.Run("all vectors", func(t *testing.T) {
+		var ids []uint64
+		require.Nil(t, index.Iterate(func(id uint64, vector []float32) bool {
+			assert.Equal(t, []float32{float32(id), 1}, vector)
+			ids = append(ids, id)
+			return true
+		}))
+		require.Len(t, ids, 2499)
+		assert.NotContains(t, ids, uint64(7))
+		for i := 1; i < len(ids); i++ {
+			assert.Less(t, ids[i-1], ids[i])
+		}
+	})
+
+	t.Run("stopping early", func(t *testing.T) {
+		calls := 0
+		require.Nil(t, index.Iterate(func(id uint64, vector []float32) bool {
+			calls++
+			return calls < 1500
+		}))
+		assert.Equal(t, 1500, calls)
+	})
+}
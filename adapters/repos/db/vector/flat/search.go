@@ -0,0 +1,264 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package flat
+
+import (
+	"encoding/binary"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/priorityqueue"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	"github.com/weaviate/weaviate/usecases/floatcomp"
+)
+
+func (f *flat) SearchByVector(vector []float32, k int, allowList helpers.AllowList) ([]uint64, []float32, error) {
+	return f.SearchByVectorWithRescore(vector, k, allowList, nil)
+}
+
+// SearchByVectorWithRescore searches like SearchByVector, but lets the query
+// override how many binary quantized candidates are rescored. It has no
+// effect without binary quantization as every vector is compared anyway.
+func (f *flat) SearchByVectorWithRescore(vector []float32, k int, allowList helpers.AllowList,
+	rescore *searchparams.Rescore,
+) ([]uint64, []float32, error) {
+	if f.distancerProvider.Type() == "cosine-dot" {
+		// cosine-dot requires normalized vectors, as the dot product and cosine
+		// similarity are only identical if the vector is normalized
+		vector = distancer.Normalize(vector)
+	}
+
+	f.RLock()
+	defer f.RUnlock()
+
+	if k <= 0 {
+		return nil, nil, nil
+	}
+
+	if f.bqEnabled {
+		return f.searchBQ(vector, k, allowList, rescore)
+	}
+
+	return f.searchFull(vector, k, allowList)
+}
+
+// SearchByVectorExact compares the query to the full vectors, even if binary
+// quantization is enabled
+func (f *flat) SearchByVectorExact(vector []float32, k int, allowList helpers.AllowList,
+) ([]uint64, []float32, error) {
+	if f.distancerProvider.Type() == "cosine-dot" {
+		vector = distancer.Normalize(vector)
+	}
+
+	f.RLock()
+	defer f.RUnlock()
+
+	if k <= 0 {
+		return nil, nil, nil
+	}
+
+	return f.searchFull(vector, k, allowList)
+}
+
+// searchFull ranks the full vectors by their distance to the query
+func (f *flat) searchFull(vector []float32, k int, allowList helpers.AllowList,
+) ([]uint64, []float32, error) {
+	results := priorityqueue.NewMax(k)
+	if err := f.scan(vector, allowList, func(id uint64, dist float32) {
+		insertBounded(results, k, id, dist)
+	}); err != nil {
+		return nil, nil, err
+	}
+
+	ids, dists := drain(results)
+	return ids, dists, nil
+}
+
+// SearchByVectorDistance returns all vectors within the target distance. As
+// every search is a full scan anyway, there is no need to search with an
+// increasing limit.
+//
+// The maxLimit param places an upper bound on the number of search results
+// returned, a maxLimit of -1 returns all results within the target distance.
+func (f *flat) SearchByVectorDistance(vector []float32, targetDistance float32, maxLimit int64,
+	allowList helpers.AllowList,
+) ([]uint64, []float32, error) {
+	if f.distancerProvider.Type() == "cosine-dot" {
+		vector = distancer.Normalize(vector)
+	}
+
+	f.RLock()
+	defer f.RUnlock()
+
+	var ids []uint64
+	var dists []float32
+	// the full vectors are used even with bq enabled, the approximate
+	// distances of the codes can't be compared to the target distance
+	if err := f.scan(vector, allowList, func(id uint64, dist float32) {
+		if dist > targetDistance &&
+			!floatcomp.InDelta(float64(dist), float64(targetDistance), 1e-6) {
+			return
+		}
+		ids = append(ids, id)
+		dists = append(dists, dist)
+	}); err != nil {
+		return nil, nil, err
+	}
+
+	sort.Sort(byDistance{ids: ids, dists: dists})
+
+	if maxLimit >= 0 && int64(len(ids)) > maxLimit {
+		f.logger.
+			WithField("action", "unlimited_vector_search").
+			Warnf("maximum search limit of %d results has been reached", maxLimit)
+		ids, dists = ids[:maxLimit], dists[:maxLimit]
+	}
+
+	return ids, dists, nil
+}
+
+// scan calculates the distance from the query to every vector in the index,
+// or only to the allowed ones if an allow list is set
+func (f *flat) scan(query []float32, allowList helpers.AllowList,
+	fn func(id uint64, dist float32),
+) error {
+	dist := f.distancerProvider.New(query)
+
+	if allowList != nil {
+		it := allowList.Iterator()
+		for id, ok := it.Next(); ok; id, ok = it.Next() {
+			vec, err := f.vectorByID(id)
+			if err != nil {
+				return errors.Wrapf(err, "read vector %d", id)
+			}
+			if vec == nil {
+				continue
+			}
+
+			d, _, err := dist.Distance(vec)
+			if err != nil {
+				return errors.Wrapf(err, "calculate distance to vector %d", id)
+			}
+			fn(id, d)
+		}
+
+		return nil
+	}
+
+	c := f.store.Bucket(vectorsBucket).Cursor()
+	defer c.Close()
+
+	for k, v := c.First(); k != nil; k, v = c.Next() {
+		id := binary.BigEndian.Uint64(k)
+		d, _, err := dist.Distance(bytesToVector(v))
+		if err != nil {
+			return errors.Wrapf(err, "calculate distance to vector %d", id)
+		}
+		fn(id, d)
+	}
+
+	return nil
+}
+
+// searchBQ ranks all codes by their hamming distance to the query and
+// rescores the closest rescoreLimit candidates with their full vectors
+func (f *flat) searchBQ(query []float32, k int, allowList helpers.AllowList,
+	rescore *searchparams.Rescore,
+) ([]uint64, []float32, error) {
+	candidateLimit := k
+	if limit := rescore.RescoreLimit(k, f.rescoreLimit); limit > candidateLimit {
+		candidateLimit = limit
+	}
+
+	queryCode := encodeBQ(query)
+	candidates := priorityqueue.NewMax(candidateLimit)
+	if allowList != nil {
+		it := allowList.Iterator()
+		for id, ok := it.Next(); ok; id, ok = it.Next() {
+			code, ok := f.bqCodes[id]
+			if !ok {
+				continue
+			}
+			insertBounded(candidates, candidateLimit, id, hammingDistance(queryCode, code))
+		}
+	} else {
+		for id, code := range f.bqCodes {
+			insertBounded(candidates, candidateLimit, id, hammingDistance(queryCode, code))
+		}
+	}
+
+	dist := f.distancerProvider.New(query)
+	results := priorityqueue.NewMax(k)
+	for candidates.Len() > 0 {
+		id := candidates.Pop().ID
+		vec, err := f.vectorByID(id)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "read vector %d", id)
+		}
+		if vec == nil {
+			continue
+		}
+
+		d, _, err := dist.Distance(vec)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "calculate distance to vector %d", id)
+		}
+		insertBounded(results, k, id, d)
+	}
+
+	ids, dists := drain(results)
+	return ids, dists, nil
+}
+
+// insertBounded adds the item to the max queue if it has fewer than limit
+// elements or if the item is closer than the furthest one in it
+func insertBounded(q *priorityqueue.Queue, limit int, id uint64, dist float32) {
+	if q.Len() < limit {
+		q.Insert(id, dist)
+	} else if q.Top().Dist > dist {
+		q.Pop()
+		q.Insert(id, dist)
+	}
+}
+
+// drain empties the max queue, returning its items ordered by ascending
+// distance
+func drain(q *priorityqueue.Queue) ([]uint64, []float32) {
+	ids := make([]uint64, q.Len())
+	dists := make([]float32, q.Len())
+
+	// the queue is ordered in reverse, we need to flip the order before
+	// presenting to the user!
+	i := len(ids) - 1
+	for q.Len() > 0 {
+		res := q.Pop()
+		ids[i] = res.ID
+		dists[i] = res.Dist
+		i--
+	}
+
+	return ids, dists
+}
+
+type byDistance struct {
+	ids   []uint64
+	dists []float32
+}
+
+func (b byDistance) Len() int           { return len(b.ids) }
+func (b byDistance) Less(i, j int) bool { return b.dists[i] < b.dists[j] }
+func (b byDistance) Swap(i, j int) {
+	b.ids[i], b.ids[j] = b.ids[j], b.ids[i]
+	b.dists[i], b.dists[j] = b.dists[j], b.dists[i]
+}
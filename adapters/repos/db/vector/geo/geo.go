@@ -22,6 +22,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
 	"github.com/weaviate/weaviate/entities/cyclemanager"
 	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/geometry"
 	"github.com/weaviate/weaviate/entities/models"
 	hnswent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 )
@@ -45,6 +46,7 @@ type vectorIndex interface {
 	Delete(id ...uint64) error
 	Dump(...string)
 	Drop(ctx context.Context) error
+	Shutdown(ctx context.Context) error
 	PostStartup()
 }
 
@@ -94,6 +96,11 @@ func (i *Index) Drop(ctx context.Context) error {
 	return nil
 }
 
+// Shutdown releases the index and its commit log, but keeps the files
+func (i *Index) Shutdown(ctx context.Context) error {
+	return i.vectorIndex.Shutdown(ctx)
+}
+
 func (i *Index) PostStartup() {
 	i.vectorIndex.PostStartup()
 }
@@ -137,6 +144,63 @@ func (i *Index) WithinRange(ctx context.Context,
 	return i.vectorIndex.KnnSearchByVectorMaxDist(query, geoRange.Distance, 800, nil)
 }
 
+// WithinPolygon searches the index for points within the polygon. The index
+// is searched by the smallest circle around the bounding box of the polygon,
+// each candidate is then checked against the polygon itself. It is
+// thread-safe and can be called concurrently.
+func (i *Index) WithinPolygon(ctx context.Context,
+	polygon geometry.Shape,
+) ([]uint64, error) {
+	bounds := polygon.Bounds()
+	center := []float32{
+		float32((bounds.MinLat + bounds.MaxLat) / 2),
+		float32((bounds.MinLon + bounds.MaxLon) / 2),
+	}
+
+	var radius float32
+	for _, corner := range [][]float32{
+		{float32(bounds.MinLat), float32(bounds.MinLon)},
+		{float32(bounds.MinLat), float32(bounds.MaxLon)},
+		{float32(bounds.MaxLat), float32(bounds.MinLon)},
+		{float32(bounds.MaxLat), float32(bounds.MaxLon)},
+	} {
+		dist, _, err := distancer.NewGeoProvider().SingleDist(center, corner)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid arguments")
+		}
+		if dist > radius {
+			radius = dist
+		}
+	}
+
+	// the corners are not necessarily the points furthest from the center on a
+	// sphere, so leave some headroom
+	candidates, err := i.vectorIndex.KnnSearchByVectorMaxDist(center, radius*1.01+1, 800, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]uint64, 0, len(candidates))
+	for _, id := range candidates {
+		coordinates, err := i.config.CoordinatesForID(ctx, id)
+		if err != nil {
+			return nil, errors.Wrapf(err, "coordinates for id %d", id)
+		}
+		if coordinates.Latitude == nil || coordinates.Longitude == nil {
+			continue
+		}
+
+		if polygon.Contains(geometry.Point{
+			Lat: float64(*coordinates.Latitude),
+			Lon: float64(*coordinates.Longitude),
+		}) {
+			out = append(out, id)
+		}
+	}
+
+	return out, nil
+}
+
 func (i *Index) Delete(id uint64) error {
 	return i.vectorIndex.Delete(id)
 }
@@ -19,6 +19,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/entities/cyclemanager"
 	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/geometry"
 	"github.com/weaviate/weaviate/entities/models"
 )
 
@@ -110,6 +111,29 @@ func TestGeoJourney(t *testing.T) {
 		expectedResults := []uint64{0}
 		assert.Equal(t, expectedResults, results)
 	})
+
+	t.Run("searching within a polygon around munich", func(t *testing.T) {
+		polygon := geometry.NewPolygon([]geometry.Point{
+			{Lat: 48.0, Lon: 11.4}, {Lat: 48.0, Lon: 11.8},
+			{Lat: 48.3, Lon: 11.8}, {Lat: 48.3, Lon: 11.4},
+		})
+		results, err := geoIndex.WithinPolygon(context.Background(), polygon)
+		require.Nil(t, err)
+
+		expectedResults := []uint64{0}
+		assert.Equal(t, expectedResults, results)
+	})
+
+	t.Run("searching within a polygon around both cities", func(t *testing.T) {
+		polygon := geometry.NewPolygon([]geometry.Point{
+			{Lat: 47.5, Lon: 8.5}, {Lat: 47.5, Lon: 12.5},
+			{Lat: 49.5, Lon: 12.5}, {Lat: 49.5, Lon: 8.5},
+		})
+		results, err := geoIndex.WithinPolygon(context.Background(), polygon)
+		require.Nil(t, err)
+
+		assert.ElementsMatch(t, []uint64{0, 1}, results)
+	})
 }
 
 func ptFloat32(in float32) *float32 {
@@ -0,0 +1,169 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"bufio"
+	"container/list"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+)
+
+// the access log never holds more than this many ids, regardless of the size
+// of the vector cache
+const accessLogMaxSize = 100_000
+
+// accessLog keeps the ids of the most recently accessed nodes, so the hot
+// working set can be restored into the vector cache after a restart. Only
+// the results of searches are recorded, the nodes visited on the way are
+// restored by the layer based prefilling.
+type accessLog struct {
+	enabled atomic.Bool
+
+	sync.Mutex
+	capacity int
+	// the front is the most recently accessed id
+	order *list.List
+	elems map[uint64]*list.Element
+}
+
+// init must be called before the log is enabled, the zero value is disabled
+func (l *accessLog) init(capacity int) {
+	if capacity > accessLogMaxSize || capacity < 1 {
+		capacity = accessLogMaxSize
+	}
+
+	l.capacity = capacity
+	l.order = list.New()
+	l.elems = map[uint64]*list.Element{}
+}
+
+func accessLogPath(rootPath, id string) string {
+	return filepath.Join(rootPath, fmt.Sprintf("%s.hnsw.access", id))
+}
+
+func (l *accessLog) record(ids []uint64) {
+	l.Lock()
+	defer l.Unlock()
+
+	for _, id := range ids {
+		l.touch(id)
+	}
+}
+
+func (l *accessLog) touch(id uint64) {
+	if elem, ok := l.elems[id]; ok {
+		l.order.MoveToFront(elem)
+		return
+	}
+
+	l.elems[id] = l.order.PushFront(id)
+	if l.order.Len() > l.capacity {
+		oldest := l.order.Back()
+		l.order.Remove(oldest)
+		delete(l.elems, oldest.Value.(uint64))
+	}
+}
+
+// snapshot returns the ids starting with the most recently accessed one
+func (l *accessLog) snapshot() []uint64 {
+	l.Lock()
+	defer l.Unlock()
+
+	ids := make([]uint64, 0, l.order.Len())
+	for elem := l.order.Front(); elem != nil; elem = elem.Next() {
+		ids = append(ids, elem.Value.(uint64))
+	}
+	return ids
+}
+
+// restore adds ids read from a persisted log, the most recently accessed one
+// first. Ids which have been accessed since are kept in front.
+func (l *accessLog) restore(ids []uint64) {
+	l.Lock()
+	defer l.Unlock()
+
+	for _, id := range ids {
+		if _, ok := l.elems[id]; ok {
+			continue
+		}
+		if l.order.Len() >= l.capacity {
+			return
+		}
+		l.elems[id] = l.order.PushBack(id)
+	}
+}
+
+// persist writes the log to the given path, replacing a previous log
+// atomically
+func (l *accessLog) persist(path string) error {
+	ids := l.snapshot()
+
+	tmpPath := path + ".tmp"
+	f, err := os.Create(tmpPath)
+	if err != nil {
+		return errors.Wrap(err, "create access log")
+	}
+
+	w := bufio.NewWriter(f)
+	buf := make([]byte, 8)
+	for _, id := range ids {
+		binary.LittleEndian.PutUint64(buf, id)
+		if _, err := w.Write(buf); err != nil {
+			f.Close()
+			return errors.Wrap(err, "write access log")
+		}
+	}
+
+	if err := w.Flush(); err != nil {
+		f.Close()
+		return errors.Wrap(err, "flush access log")
+	}
+	if err := f.Close(); err != nil {
+		return errors.Wrap(err, "close access log")
+	}
+
+	return os.Rename(tmpPath, path)
+}
+
+// readAccessLog reads a persisted log, a missing log is not an error
+func readAccessLog(path string) ([]uint64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "open access log")
+	}
+	defer f.Close()
+
+	r := bufio.NewReader(f)
+	buf := make([]byte, 8)
+	var ids []uint64
+	for {
+		if _, err := io.ReadFull(r, buf); err != nil {
+			if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+				// a truncated entry at the end is dropped, the log is only a hint
+				return ids, nil
+			}
+			return nil, errors.Wrap(err, "read access log")
+		}
+		ids = append(ids, binary.LittleEndian.Uint64(buf))
+	}
+}
@@ -0,0 +1,132 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestAccessLog(t *testing.T) {
+	t.Run("keeps the most recently accessed ids", func(t *testing.T) {
+		var l accessLog
+		l.init(3)
+
+		l.record([]uint64{1, 2, 3})
+		assert.Equal(t, []uint64{3, 2, 1}, l.snapshot())
+
+		l.record([]uint64{1})
+		assert.Equal(t, []uint64{1, 3, 2}, l.snapshot())
+
+		l.record([]uint64{4})
+		assert.Equal(t, []uint64{4, 1, 3}, l.snapshot())
+	})
+
+	t.Run("the capacity is bounded", func(t *testing.T) {
+		var l accessLog
+		l.init(1e12)
+		assert.Equal(t, accessLogMaxSize, l.capacity)
+	})
+
+	t.Run("restoring keeps ids accessed since in front", func(t *testing.T) {
+		var l accessLog
+		l.init(4)
+
+		l.record([]uint64{7})
+		l.restore([]uint64{1, 7, 2, 3, 4})
+		assert.Equal(t, []uint64{7, 1, 2, 3}, l.snapshot())
+	})
+
+	t.Run("persisting and reading", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "test.hnsw.access")
+
+		ids, err := readAccessLog(path)
+		require.Nil(t, err)
+		assert.Nil(t, ids)
+
+		var l accessLog
+		l.init(10)
+		l.record([]uint64{5, 6, 7})
+		require.Nil(t, l.persist(path))
+
+		ids, err = readAccessLog(path)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{7, 6, 5}, ids)
+	})
+
+	t.Run("a truncated entry is dropped", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "test.hnsw.access")
+
+		var l accessLog
+		l.init(10)
+		l.record([]uint64{5, 6})
+		require.Nil(t, l.persist(path))
+		require.Nil(t, os.Truncate(path, 12))
+
+		ids, err := readAccessLog(path)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{6}, ids)
+	})
+
+	t.Run("the hot set survives a restart of the index", func(t *testing.T) {
+		rootPath := t.TempDir()
+		vectors, queries := testinghelpers.RandomVecs(100, 1, 16)
+		uc := ent.NewDefaultUserConfig()
+		uc.VectorCachePrefill = ent.VectorCachePrefillRecentlyAccessed
+
+		newIndex := func() *hnsw {
+			index, err := New(Config{
+				RootPath:              rootPath,
+				ID:                    "access-log-test",
+				MakeCommitLoggerThunk: MakeNoopCommitLogger,
+				DistanceProvider:      distancer.NewL2SquaredProvider(),
+				VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+					return vectors[int(id)], nil
+				},
+				TempVectorForIDThunk: TempVectorForIDThunk(vectors),
+			}, uc, cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+				cyclemanager.NewCallbackGroupNoop())
+			require.Nil(t, err)
+			return index
+		}
+
+		index := newIndex()
+		for i, vec := range vectors {
+			require.Nil(t, index.Add(uint64(i), vec))
+		}
+
+		ids, _, err := index.SearchByVector(queries[0], 5, nil)
+		require.Nil(t, err)
+		require.Nil(t, index.Shutdown(context.Background()))
+
+		restarted := newIndex()
+		expected := make([]uint64, len(ids))
+		for i := range ids {
+			// the best match has been recorded first
+			expected[len(ids)-1-i] = ids[i]
+		}
+		assert.Equal(t, expected, restarted.accessLog.snapshot())
+
+		require.Nil(t, restarted.Drop(context.Background()))
+		_, err = os.Stat(accessLogPath(rootPath, "access-log-test"))
+		assert.True(t, os.IsNotExist(err))
+	})
+}
@@ -0,0 +1,102 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"math"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/visited"
+)
+
+// acornSeeds is the number of allowed nodes which are added as additional
+// entrypoints to a filtered traversal. The regular entrypoint is often not
+// part of the allow list, and the allowed nodes around it may not connect to
+// the rest of the allowed nodes.
+const acornSeeds = 10
+
+// useAcorn decides whether a filtered search only expands allowed nodes
+// (ACORN-style) instead of traversing the whole graph and discarding
+// disallowed results. Small allow lists never reach this point as they are
+// searched with a flat search instead.
+//
+// The number of nodes is an estimate, as the node slice grows ahead of the
+// inserts and still contains deleted nodes until they are cleaned up. The
+// estimate is only off by a lot for small indexes, which are below the flat
+// search cutoff anyway.
+func (h *hnsw) useAcorn(allowList helpers.AllowList) bool {
+	if allowList == nil {
+		return false
+	}
+
+	ratio := math.Float64frombits(h.acornFilterRatio.Load())
+	if ratio <= 0 {
+		return false
+	}
+
+	h.RLock()
+	size := len(h.nodes)
+	h.RUnlock()
+	if size == 0 {
+		return false
+	}
+
+	return float64(allowList.Len()) < ratio*float64(size)
+}
+
+// acornNeighbors returns the allowed neighbors of a node on level 0.
+// Disallowed neighbors are not returned, instead their own neighbors are
+// explored, so the search can cross regions of the graph excluded by the
+// filter. At most limit neighbors are collected through a second hop, the
+// direct neighbors are always included. Disallowed nodes are marked as
+// visited, so they are expanded at most once per search.
+func (h *hnsw) acornNeighbors(connections []uint64, allowList helpers.AllowList,
+	visitedList visited.ListSet, limit int, out []uint64,
+) []uint64 {
+	out = out[:0]
+	for _, id := range connections {
+		if allowList.Contains(id) {
+			out = append(out, id)
+		}
+	}
+
+	for _, id := range connections {
+		if len(out) >= limit {
+			break
+		}
+
+		if visitedList.Visited(id) || allowList.Contains(id) {
+			continue
+		}
+		visitedList.Visit(id)
+
+		node := h.nodeByID(id)
+		if node == nil {
+			continue
+		}
+
+		node.Lock()
+		if len(node.connections) > 0 {
+			for _, secondHop := range node.connections[0] {
+				if len(out) >= limit {
+					break
+				}
+				if allowList.Contains(secondHop) {
+					out = append(out, secondHop)
+				}
+			}
+		}
+		node.Unlock()
+	}
+
+	return out
+}
@@ -0,0 +1,116 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/ssdhelpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestAcornFilteredSearch(t *testing.T) {
+	dimensions := 32
+	vectorsSize := 2000
+	k := 10
+	vectors, queries := testinghelpers.RandomVecs(vectorsSize, 20, dimensions)
+	distanceProvider := distancer.NewL2SquaredProvider()
+
+	uc := ent.NewDefaultUserConfig()
+	uc.MaxConnections = 16
+	uc.EFConstruction = 64
+	// make sure the filtered searches use the graph
+	uc.FlatSearchCutoff = 1
+
+	index, err := New(Config{
+		RootPath:              t.TempDir(),
+		ID:                    "acorn",
+		MakeCommitLoggerThunk: MakeNoopCommitLogger,
+		DistanceProvider:      distanceProvider,
+		VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+			return vectors[int(id)], nil
+		},
+		TempVectorForIDThunk: TempVectorForIDThunk(vectors),
+	}, uc, cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+		cyclemanager.NewCallbackGroupNoop())
+	require.Nil(t, err)
+	defer index.Shutdown(context.Background())
+
+	ssdhelpers.Concurrently(uint64(vectorsSize), func(id uint64) {
+		require.Nil(t, index.Add(id, vectors[id]))
+	})
+
+	// every 20th vector matches the filter
+	allowList := helpers.NewAllowList()
+	var allowed [][]float32
+	var allowedIDs []uint64
+	for i := 0; i < vectorsSize; i += 20 {
+		allowList.Insert(uint64(i))
+		allowed = append(allowed, vectors[i])
+		allowedIDs = append(allowedIDs, uint64(i))
+	}
+
+	distance := func(x, y []float32) float32 {
+		dist, _, _ := distanceProvider.SingleDist(x, y)
+		return dist
+	}
+	recall := func() float32 {
+		var relevant uint64
+		for _, q := range queries {
+			truth := testinghelpers.BruteForce(allowed, q, k, distance)
+			for i := range truth {
+				truth[i] = allowedIDs[truth[i]]
+			}
+			res, _, err := index.SearchByVector(q, k, allowList)
+			require.Nil(t, err)
+			for _, id := range res {
+				require.True(t, allowList.Contains(id))
+			}
+			relevant += testinghelpers.MatchesInLists(truth, res)
+		}
+		return float32(relevant) / float32(k*len(queries))
+	}
+
+	t.Run("selecting the strategy", func(t *testing.T) {
+		assert.False(t, index.useAcorn(nil))
+		assert.True(t, index.useAcorn(allowList))
+
+		// the node count is estimated from the size of the node slice, which
+		// grows ahead of the inserts
+		permissive := helpers.NewAllowList()
+		for i := 0; i < vectorsSize; i++ {
+			if i%4 != 0 {
+				permissive.Insert(uint64(i))
+			}
+		}
+		assert.False(t, index.useAcorn(permissive))
+	})
+
+	t.Run("with the filter-aware traversal", func(t *testing.T) {
+		require.True(t, index.useAcorn(allowList))
+		assert.Greater(t, recall(), float32(0.9))
+	})
+
+	t.Run("with the filter-aware traversal disabled", func(t *testing.T) {
+		uc.AcornFilterRatio = 0
+		require.Nil(t, index.UpdateUserConfig(uc, func() {}))
+		require.False(t, index.useAcorn(allowList))
+		assert.Greater(t, recall(), float32(0.9))
+	})
+}
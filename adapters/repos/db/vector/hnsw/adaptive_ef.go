@@ -0,0 +1,132 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+// number of latency samples which are collected before ef is adjusted
+const adaptiveEFWindow = 64
+
+// adaptiveEF picks the search time ef based on the observed query latency.
+// It backs off quickly when the latency percentile misses the target and
+// probes slowly for a higher ef, and thus a higher recall, while there is
+// headroom. The zero value is disabled.
+type adaptiveEF struct {
+	enabled atomic.Bool
+
+	// current ef, read on every search without holding the lock
+	ef int64
+
+	sync.Mutex
+	target     time.Duration
+	percentile int
+	min        int
+	max        int
+	samples    []time.Duration
+}
+
+// configure (re-)applies the user config. The ef is kept across updates,
+// but clamped to the new bounds.
+func (a *adaptiveEF) configure(cfg ent.AdaptiveEFConfig, min, max int) {
+	a.Lock()
+	defer a.Unlock()
+
+	if min < 1 {
+		min = 1
+	}
+	if max < min {
+		max = min
+	}
+
+	a.target = time.Duration(cfg.TargetLatencyMs) * time.Millisecond
+	a.percentile = cfg.TargetPercentile
+	a.min = min
+	a.max = max
+	a.samples = a.samples[:0]
+	atomic.StoreInt64(&a.ef, int64(clampInt(int(atomic.LoadInt64(&a.ef)), min, max)))
+	a.enabled.Store(cfg.Enabled)
+}
+
+// searchEF returns the ef to use for a search with the given limit, the
+// results would be cut off early with an ef lower than k
+func (a *adaptiveEF) searchEF(k int) int {
+	ef := int(atomic.LoadInt64(&a.ef))
+	if ef < k {
+		ef = k
+	}
+	return ef
+}
+
+// recordSince records the latency of a search started at the given time. It
+// returns the new ef and true if the ef has been adjusted.
+func (a *adaptiveEF) recordSince(start time.Time) (int, bool) {
+	took := time.Since(start)
+
+	a.Lock()
+	defer a.Unlock()
+
+	a.samples = append(a.samples, took)
+	if len(a.samples) < adaptiveEFWindow {
+		return 0, false
+	}
+
+	observed := latencyPercentile(a.samples, a.percentile)
+	a.samples = a.samples[:0]
+
+	current := int(atomic.LoadInt64(&a.ef))
+	next := current
+	switch {
+	case observed > a.target:
+		next = current * 3 / 4
+	case observed < a.target*3/4:
+		step := current / 8
+		if step < 1 {
+			step = 1
+		}
+		next = current + step
+	}
+	next = clampInt(next, a.min, a.max)
+	if next == current {
+		return current, false
+	}
+
+	atomic.StoreInt64(&a.ef, int64(next))
+	return next, true
+}
+
+// latencyPercentile sorts the samples in place and returns the sample at the
+// given percentile
+func latencyPercentile(samples []time.Duration, percentile int) time.Duration {
+	sort.Slice(samples, func(i, j int) bool { return samples[i] < samples[j] })
+	pos := (len(samples)*percentile+99)/100 - 1
+	if pos < 0 {
+		pos = 0
+	}
+	return samples[pos]
+}
+
+func clampInt(v, min, max int) int {
+	if v < min {
+		return min
+	}
+	if v > max {
+		return max
+	}
+	return v
+}
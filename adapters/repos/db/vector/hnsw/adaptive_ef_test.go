@@ -0,0 +1,169 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestAdaptiveEF(t *testing.T) {
+	cfg := ent.AdaptiveEFConfig{
+		Enabled:          true,
+		TargetLatencyMs:  50,
+		TargetPercentile: 95,
+	}
+
+	// feed a full window of samples of the given latency
+	feed := func(a *adaptiveEF, took time.Duration) (int, bool) {
+		var ef int
+		var adjusted bool
+		for i := 0; i < adaptiveEFWindow; i++ {
+			ef, adjusted = a.recordSince(time.Now().Add(-took))
+		}
+		return ef, adjusted
+	}
+
+	t.Run("the zero value is disabled", func(t *testing.T) {
+		a := &adaptiveEF{}
+		assert.False(t, a.enabled.Load())
+	})
+
+	t.Run("starts at the minimum", func(t *testing.T) {
+		a := &adaptiveEF{}
+		a.configure(cfg, 100, 500)
+		assert.True(t, a.enabled.Load())
+		assert.Equal(t, 100, a.searchEF(10))
+		assert.Equal(t, 200, a.searchEF(200))
+	})
+
+	t.Run("probes for a higher ef while below the target", func(t *testing.T) {
+		a := &adaptiveEF{}
+		a.configure(cfg, 100, 500)
+
+		ef, adjusted := feed(a, time.Millisecond)
+		assert.True(t, adjusted)
+		assert.Equal(t, 112, ef)
+
+		for i := 0; i < 50; i++ {
+			feed(a, time.Millisecond)
+		}
+		assert.Equal(t, 500, a.searchEF(10))
+	})
+
+	t.Run("backs off while above the target", func(t *testing.T) {
+		a := &adaptiveEF{}
+		a.configure(cfg, 100, 500)
+		for i := 0; i < 50; i++ {
+			feed(a, time.Millisecond)
+		}
+		require.Equal(t, 500, a.searchEF(10))
+
+		ef, adjusted := feed(a, 100*time.Millisecond)
+		assert.True(t, adjusted)
+		assert.Equal(t, 375, ef)
+
+		for i := 0; i < 10; i++ {
+			feed(a, 100*time.Millisecond)
+		}
+		assert.Equal(t, 100, a.searchEF(10))
+	})
+
+	t.Run("keeps the ef close to the target", func(t *testing.T) {
+		a := &adaptiveEF{}
+		a.configure(cfg, 100, 500)
+
+		ef, adjusted := feed(a, 45*time.Millisecond)
+		assert.False(t, adjusted)
+		assert.Equal(t, 100, ef)
+	})
+
+	t.Run("only the configured percentile matters", func(t *testing.T) {
+		a := &adaptiveEF{}
+		a.configure(cfg, 100, 500)
+
+		// 3 out of 64 samples are slow, which is below the 95th percentile
+		for i := 0; i < 3; i++ {
+			a.recordSince(time.Now().Add(-time.Second))
+		}
+		var ef int
+		for i := 3; i < adaptiveEFWindow; i++ {
+			ef, _ = a.recordSince(time.Now().Add(-time.Millisecond))
+		}
+		assert.Equal(t, 112, ef)
+	})
+
+	t.Run("updates clamp the ef to the new bounds", func(t *testing.T) {
+		a := &adaptiveEF{}
+		a.configure(cfg, 100, 500)
+		for i := 0; i < 50; i++ {
+			feed(a, time.Millisecond)
+		}
+		require.Equal(t, 500, a.searchEF(10))
+
+		a.configure(cfg, 50, 200)
+		assert.Equal(t, 200, a.searchEF(10))
+
+		cfg := cfg
+		cfg.Enabled = false
+		a.configure(cfg, 50, 200)
+		assert.False(t, a.enabled.Load())
+	})
+
+	t.Run("searches on an index with adaptive ef", func(t *testing.T) {
+		vectors, queries := testinghelpers.RandomVecs(500, 10, 16)
+		uc := ent.NewDefaultUserConfig()
+		uc.MaxConnections = 16
+		uc.EFConstruction = 64
+		uc.DynamicEFMin = 20
+		uc.DynamicEFMax = 200
+		uc.AdaptiveEF = cfg
+		index, err := New(Config{
+			RootPath:              "doesnt-matter-as-committlogger-is-mocked-out",
+			ID:                    "adaptive-ef-test",
+			MakeCommitLoggerThunk: MakeNoopCommitLogger,
+			DistanceProvider:      distancer.NewL2SquaredProvider(),
+			VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+				return vectors[int(id)], nil
+			},
+			TempVectorForIDThunk: TempVectorForIDThunk(vectors),
+		}, uc, cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+			cyclemanager.NewCallbackGroupNoop())
+		require.Nil(t, err)
+
+		for i, vec := range vectors {
+			require.Nil(t, index.Add(uint64(i), vec))
+		}
+
+		for i := 0; i < 2*adaptiveEFWindow; i++ {
+			ids, _, err := index.SearchByVector(queries[i%len(queries)], 10, nil)
+			require.Nil(t, err)
+			require.Len(t, ids, 10)
+		}
+
+		// searches on a small index are way below the target latency
+		assert.Greater(t, index.searchTimeEF(10), 20)
+		assert.LessOrEqual(t, index.searchTimeEF(10), 200)
+
+		uc.AdaptiveEF.Enabled = false
+		require.Nil(t, index.UpdateUserConfig(uc, func() {}))
+		assert.Equal(t, 80, index.searchTimeEF(10))
+	})
+}
@@ -82,6 +82,27 @@ func (h *hnsw) ListFiles(ctx context.Context) ([]string, error) {
 	}
 	delete(found, path)
 
+	// the checkpoint holds the state of all commit logs it has replaced
+	ckpt := checkpointPath(h.commitLog.RootPath(), h.commitLog.ID())
+	if st, err := os.Stat(ckpt); err == nil && st.Size() > 0 {
+		rel, err := filepath.Rel(h.commitLog.RootPath(), ckpt)
+		if err != nil {
+			return nil, errors.Wrap(err, "checkpoint")
+		}
+		found[rel] = struct{}{}
+	}
+
+	// the empty marker keeps the inserts of a restored GPU index from being
+	// deferred again
+	marker := builtMarkerPath(h.commitLog.RootPath(), h.commitLog.ID())
+	if _, err := os.Stat(marker); err == nil {
+		rel, err := filepath.Rel(h.commitLog.RootPath(), marker)
+		if err != nil {
+			return nil, errors.Wrap(err, "built marker")
+		}
+		found[rel] = struct{}{}
+	}
+
 	files, i := make([]string, len(found)), 0
 	for file := range found {
 		files[i] = file
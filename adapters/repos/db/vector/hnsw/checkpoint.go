@@ -0,0 +1,268 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// A checkpoint is a full snapshot of the graph as of a specific commit log.
+// It is created in the commit log maintenance cycle from the oldest condensed
+// logs, which are deleted once they are part of the checkpoint. On startup
+// only the logs newer than the checkpoint need to be replayed.
+//
+// The file starts with a fixed-size header, followed by the state of the
+// graph in the commit log format:
+//
+//	version        uint8
+//	coveredThrough int64  (time stamp of the last commit log in the checkpoint)
+//	bodyLength     uint64
+//	checksum       uint32 (crc32 of the body)
+const (
+	checkpointVersion    = uint8(1)
+	checkpointHeaderSize = 1 + 8 + 8 + 4
+)
+
+func checkpointPath(rootPath, id string) string {
+	return fmt.Sprintf("%s/%s.hnsw.checkpoint", rootPath, id)
+}
+
+type checkpointHeader struct {
+	version        uint8
+	coveredThrough int64
+	bodyLength     uint64
+	checksum       uint32
+}
+
+func (h checkpointHeader) bytes() []byte {
+	out := make([]byte, checkpointHeaderSize)
+	out[0] = h.version
+	binary.LittleEndian.PutUint64(out[1:9], uint64(h.coveredThrough))
+	binary.LittleEndian.PutUint64(out[9:17], h.bodyLength)
+	binary.LittleEndian.PutUint32(out[17:21], h.checksum)
+	return out
+}
+
+func parseCheckpointHeader(in []byte) checkpointHeader {
+	return checkpointHeader{
+		version:        in[0],
+		coveredThrough: int64(binary.LittleEndian.Uint64(in[1:9])),
+		bodyLength:     binary.LittleEndian.Uint64(in[9:17]),
+		checksum:       binary.LittleEndian.Uint32(in[17:21]),
+	}
+}
+
+// readCheckpoint returns the state stored in the checkpoint and the time
+// stamp of the last commit log it contains. If there is no checkpoint, the
+// state is nil.
+func readCheckpoint(path string, logger logrus.FieldLogger,
+) (*DeserializationResult, int64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, 0, nil
+		}
+		return nil, 0, errors.Wrapf(err, "open checkpoint %q", path)
+	}
+	defer f.Close()
+
+	r := bufio.NewReaderSize(f, 256*1024)
+	headerBytes := make([]byte, checkpointHeaderSize)
+	if _, err := io.ReadFull(r, headerBytes); err != nil {
+		return nil, 0, errors.Wrapf(err, "read header of checkpoint %q", path)
+	}
+
+	header := parseCheckpointHeader(headerBytes)
+	if header.version != checkpointVersion {
+		return nil, 0, errors.Errorf("checkpoint %q has unsupported version %d",
+			path, header.version)
+	}
+
+	hash := crc32.NewIEEE()
+	limited := &io.LimitedReader{R: r, N: int64(header.bodyLength)}
+	state, _, err := NewDeserializer(logger).
+		Do(bufio.NewReaderSize(io.TeeReader(limited, hash), 256*1024), nil, false)
+	if err != nil {
+		return nil, 0, errors.Wrapf(err, "deserialize checkpoint %q", path)
+	}
+
+	// the deserializer reads until EOF, so the full body must have been read
+	if limited.N != 0 || hash.Sum32() != header.checksum {
+		return nil, 0, errors.Errorf("checkpoint %q is corrupt: %d of %d bytes "+
+			"missing, checksum %d, expected %d", path, limited.N, header.bodyLength,
+			hash.Sum32(), header.checksum)
+	}
+
+	return state, header.coveredThrough, nil
+}
+
+// writeCheckpoint atomically replaces the checkpoint with the given state
+func writeCheckpoint(path string, state *DeserializationResult,
+	coveredThrough int64, logger logrus.FieldLogger,
+) error {
+	tmpPath := path + ".tmp"
+	f, err := os.OpenFile(tmpPath, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0o666)
+	if err != nil {
+		return errors.Wrapf(err, "create checkpoint %q", tmpPath)
+	}
+	defer f.Close()
+
+	// the header is written last, once the length and checksum of the body
+	// are known
+	if _, err := f.Seek(checkpointHeaderSize, io.SeekStart); err != nil {
+		return errors.Wrapf(err, "seek checkpoint %q", tmpPath)
+	}
+
+	c := &MemoryCondensor{logger: logger, newLogFile: f}
+	c.newLog = NewWriterSize(f, 1*1024*1024)
+	if err := c.writeState(state, true); err != nil {
+		return errors.Wrapf(err, "write checkpoint %q", tmpPath)
+	}
+	if err := c.newLog.Flush(); err != nil {
+		return errors.Wrapf(err, "write checkpoint %q", tmpPath)
+	}
+
+	if _, err := f.Seek(checkpointHeaderSize, io.SeekStart); err != nil {
+		return errors.Wrapf(err, "seek checkpoint %q", tmpPath)
+	}
+	hash := crc32.NewIEEE()
+	bodyLength, err := io.Copy(hash, bufio.NewReaderSize(f, 256*1024))
+	if err != nil {
+		return errors.Wrapf(err, "checksum checkpoint %q", tmpPath)
+	}
+
+	header := checkpointHeader{
+		version:        checkpointVersion,
+		coveredThrough: coveredThrough,
+		bodyLength:     uint64(bodyLength),
+		checksum:       hash.Sum32(),
+	}
+	if _, err := f.WriteAt(header.bytes(), 0); err != nil {
+		return errors.Wrapf(err, "write header of checkpoint %q", tmpPath)
+	}
+
+	if err := f.Sync(); err != nil {
+		return errors.Wrapf(err, "sync checkpoint %q", tmpPath)
+	}
+	if err := f.Close(); err != nil {
+		return errors.Wrapf(err, "close checkpoint %q", tmpPath)
+	}
+
+	return os.Rename(tmpPath, path)
+}
+
+// createCheckpoint adds the commit logs to the existing checkpoint, or
+// creates a new one, and removes the logs afterwards. The logs must be
+// immutable and in order.
+func createCheckpoint(rootPath, id string, fileNames []string,
+	logger logrus.FieldLogger,
+) error {
+	path := checkpointPath(rootPath, id)
+	state, coveredThrough, err := readCheckpoint(path, logger)
+	if err != nil {
+		return err
+	}
+
+	absorbed := make([]string, 0, len(fileNames))
+	for _, fileName := range fileNames {
+		ts, err := asTimeStamp(filepath.Base(fileName))
+		if err != nil {
+			return errors.Wrapf(err, "commit log %q", fileName)
+		}
+
+		absorbed = append(absorbed, fileName)
+		if ts <= coveredThrough {
+			// left over from a previous checkpoint which did not finish cleaning
+			// up its logs
+			continue
+		}
+
+		state, err = deserializeCommitLog(fileName, state, logger)
+		if err != nil {
+			return err
+		}
+		coveredThrough = ts
+	}
+
+	if state != nil {
+		if err := writeCheckpoint(path, state, coveredThrough, logger); err != nil {
+			return err
+		}
+	}
+
+	for _, fileName := range absorbed {
+		if err := os.Remove(fileName); err != nil {
+			return errors.Wrapf(err, "remove commit log %q after checkpoint", fileName)
+		}
+	}
+
+	logger.WithField("action", "hnsw_checkpoint").
+		WithField("id", id).
+		WithField("covered_through", coveredThrough).
+		WithField("absorbed_commit_logs", len(absorbed)).
+		Debug("updated hnsw checkpoint")
+
+	return nil
+}
+
+func deserializeCommitLog(fileName string, state *DeserializationResult,
+	logger logrus.FieldLogger,
+) (*DeserializationResult, error) {
+	fd, err := os.Open(fileName)
+	if err != nil {
+		return nil, errors.Wrapf(err, "open commit log %q for reading", fileName)
+	}
+	defer fd.Close()
+
+	state, _, err = NewDeserializer(logger).
+		Do(bufio.NewReaderSize(fd, 256*1024), state, false)
+	if err != nil {
+		return nil, errors.Wrapf(err, "deserialize commit log %q", fileName)
+	}
+
+	return state, nil
+}
+
+// commitLogsAfterCheckpoint removes the commit logs which are already part
+// of the checkpoint. Such logs only exist if the process stopped between
+// writing the checkpoint and removing the logs.
+func commitLogsAfterCheckpoint(fileNames []string, coveredThrough int64,
+) ([]string, error) {
+	out := make([]string, 0, len(fileNames))
+	for _, fileName := range fileNames {
+		ts, err := asTimeStamp(filepath.Base(fileName))
+		if err != nil {
+			return nil, errors.Wrapf(err, "commit log %q", fileName)
+		}
+
+		if ts <= coveredThrough {
+			if err := os.Remove(fileName); err != nil {
+				return nil, errors.Wrapf(err,
+					"remove commit log %q which is part of the checkpoint", fileName)
+			}
+			continue
+		}
+
+		out = append(out, fileName)
+	}
+
+	return out, nil
+}
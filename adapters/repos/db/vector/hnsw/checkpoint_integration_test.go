@@ -0,0 +1,204 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package hnsw
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/commitlog"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+)
+
+func TestCheckpoint(t *testing.T) {
+	rootPath := t.TempDir()
+	id := "checkpointed"
+	logger, _ := test.NewNullLogger()
+	dir := commitLogDirectory(rootPath, id)
+	require.Nil(t, os.MkdirAll(dir, os.ModePerm))
+
+	var err error
+	var cl *hnswCommitLogger
+	writeLog := func(name string, condense bool, write func(l *commitlog.Logger)) {
+		l := commitlog.NewLogger(commitLogFileName(rootPath, id, name))
+		write(l)
+		require.Nil(t, l.Flush())
+		require.Nil(t, l.Close())
+		if condense {
+			require.Nil(t, NewMemoryCondensor(logger).Do(commitLogFileName(rootPath, id, name)))
+		}
+	}
+
+	writeLog("1000", true, func(l *commitlog.Logger) {
+		for i := uint64(0); i < 3; i++ {
+			l.AddNode(i, 1)
+		}
+		l.ReplaceLinksAtLevel(0, 0, []uint64{1, 2})
+		l.ReplaceLinksAtLevel(0, 1, []uint64{1})
+		l.ReplaceLinksAtLevel(1, 0, []uint64{0})
+		l.ReplaceLinksAtLevel(2, 0, []uint64{0, 1})
+		l.SetEntryPointWithMaxLayer(0, 1)
+	})
+	writeLog("2000", true, func(l *commitlog.Logger) {
+		l.AddLinksAtLevel(1, 0, []uint64{2})
+		l.AddTombstone(2)
+	})
+	writeLog("3000", false, func(l *commitlog.Logger) {
+		l.AddNode(3, 0)
+		l.ReplaceLinksAtLevel(3, 0, []uint64{0, 1})
+		l.AddLinkAtLevel(0, 0, 3)
+	})
+
+	// replay all logs without a checkpoint to get the expected state
+	var expected *DeserializationResult
+	for _, name := range []string{"1000.condensed", "2000.condensed", "3000"} {
+		expected, err = deserializeCommitLog(commitLogFileName(rootPath, id, name),
+			expected, logger)
+		require.Nil(t, err)
+	}
+
+	cl, err = NewCommitLogger(rootPath, id, logger, cyclemanager.NewCallbackGroupNoop(),
+		WithCheckpointInterval(time.Nanosecond))
+	require.Nil(t, err)
+	defer cl.Shutdown(context.Background())
+
+	t.Run("the condensed logs are moved into the checkpoint", func(t *testing.T) {
+		executed, err := cl.checkpoint()
+		require.Nil(t, err)
+		assert.True(t, executed)
+
+		files, err := getCommitFileNames(rootPath, id)
+		require.Nil(t, err)
+		assert.Equal(t, []string{commitLogFileName(rootPath, id, "3000")}, files)
+
+		state, coveredThrough, err := readCheckpoint(checkpointPath(rootPath, id), logger)
+		require.Nil(t, err)
+		assert.Equal(t, int64(2000), coveredThrough)
+
+		state, err = deserializeCommitLog(commitLogFileName(rootPath, id, "3000"), state, logger)
+		require.Nil(t, err)
+		assertSameGraphState(t, expected, state)
+	})
+
+	t.Run("nothing happens without new condensed logs", func(t *testing.T) {
+		executed, err := cl.checkpoint()
+		require.Nil(t, err)
+		assert.False(t, executed)
+	})
+
+	t.Run("the index is restored from the checkpoint and the newer logs", func(t *testing.T) {
+		index := newTestIndex(t, nil, withTestRootPath(rootPath, id),
+			withTestVectorForID(checkpointTestVectorForID))
+		assertSameGraphState(t, expected, &DeserializationResult{
+			Nodes:      index.nodes,
+			Entrypoint: index.entryPointID,
+			Level:      uint16(index.currentMaximumLayer),
+			Tombstones: index.tombstones,
+		})
+	})
+
+	t.Run("later checkpoints extend the existing one", func(t *testing.T) {
+		require.Nil(t, NewMemoryCondensor(logger).Do(commitLogFileName(rootPath, id, "3000")))
+		writeLog("4000", false, func(l *commitlog.Logger) {
+			l.RemoveTombstone(2)
+			l.DeleteNode(2)
+		})
+		expected, err := deserializeCommitLog(commitLogFileName(rootPath, id, "4000"),
+			expected, logger)
+		require.Nil(t, err)
+
+		executed, err := cl.checkpoint()
+		require.Nil(t, err)
+		assert.True(t, executed)
+
+		state, coveredThrough, err := readCheckpoint(checkpointPath(rootPath, id), logger)
+		require.Nil(t, err)
+		assert.Equal(t, int64(3000), coveredThrough)
+
+		state, err = deserializeCommitLog(commitLogFileName(rootPath, id, "4000"), state, logger)
+		require.Nil(t, err)
+		assertSameGraphState(t, expected, state)
+	})
+
+	t.Run("logs left over from a checkpoint are removed on startup", func(t *testing.T) {
+		writeLog("2500", false, func(l *commitlog.Logger) {
+			l.AddTombstone(1)
+		})
+
+		index := newTestIndex(t, nil, withTestRootPath(rootPath, id),
+			withTestVectorForID(checkpointTestVectorForID))
+		_, tombstoned := index.tombstones[1]
+		assert.False(t, tombstoned)
+
+		_, err := os.Stat(commitLogFileName(rootPath, id, "2500"))
+		assert.True(t, os.IsNotExist(err))
+	})
+
+	t.Run("a corrupt checkpoint is detected", func(t *testing.T) {
+		path := checkpointPath(rootPath, id)
+		content, err := os.ReadFile(path)
+		require.Nil(t, err)
+		content[len(content)-1] ^= 0xff
+		require.Nil(t, os.WriteFile(path, content, 0o666))
+
+		_, _, err = readCheckpoint(path, logger)
+		assert.ErrorContains(t, err, "is corrupt")
+	})
+
+	t.Run("the checkpoint is removed with the commit logs", func(t *testing.T) {
+		require.Nil(t, cl.Drop(context.Background()))
+		_, err := os.Stat(checkpointPath(rootPath, id))
+		assert.True(t, os.IsNotExist(err))
+		_, err = os.Stat(filepath.Join(dir))
+		assert.True(t, os.IsNotExist(err))
+	})
+}
+
+// checkpointTestVectorForID returns a distinct vector for every id, the
+// vectors are only needed to restore the graph
+func checkpointTestVectorForID(ctx context.Context, id uint64) ([]float32, error) {
+	return []float32{float32(id)}, nil
+}
+
+func assertSameGraphState(t *testing.T, expected, actual *DeserializationResult) {
+	nodes := func(in []*vertex) map[uint64][][]uint64 {
+		out := map[uint64][][]uint64{}
+		for _, node := range in {
+			if node == nil {
+				continue
+			}
+			// links without any connections are written as empty replacements
+			conns := make([][]uint64, len(node.connections))
+			for level, links := range node.connections {
+				if len(links) > 0 {
+					conns[level] = links
+				}
+			}
+			out[node.id] = conns
+		}
+		return out
+	}
+
+	assert.Equal(t, nodes(expected.Nodes), nodes(actual.Nodes))
+	assert.Equal(t, expected.Entrypoint, actual.Entrypoint)
+	assert.Equal(t, expected.Level, actual.Level)
+	assert.Equal(t, expected.Tombstones, actual.Tombstones)
+}
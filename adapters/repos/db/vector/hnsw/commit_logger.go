@@ -27,6 +27,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/commitlog"
 	ssdhelpers "github.com/weaviate/weaviate/adapters/repos/db/vector/ssdhelpers"
 	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/diskio"
 	"github.com/weaviate/weaviate/entities/errorcompounder"
 )
 
@@ -70,7 +71,7 @@ func NewCommitLogger(rootPath, name string, logger logrus.FieldLogger,
 		elems = append(elems, l.id)
 		return strings.Join(elems, "/")
 	}
-	l.commitLogger = commitlog.NewLoggerWithFile(fd)
+	l.commitLogger = commitlog.NewLoggerWithFileAndSyncPolicy(fd, l.syncPolicy)
 	l.switchLogsCallbackCtrl = maintenanceCallbacks.Register(id("switch_logs"), l.startSwitchLogs)
 	l.condenseLogsCallbackCtrl = maintenanceCallbacks.Register(id("condense_logs"), l.startCombineAndCondenseLogs)
 
@@ -255,6 +256,14 @@ type hnswCommitLogger struct {
 	maxSizeCombining  int64
 	commitLogger      *commitlog.Logger
 
+	// checkpointInterval is the minimum time between two checkpoints, 0
+	// disables them. lastCheckpoint is only accessed in the maintenance cycle.
+	checkpointInterval time.Duration
+	lastCheckpoint     time.Time
+
+	// syncPolicy controls whether Flush waits for the writes to be on disk
+	syncPolicy diskio.SyncPolicy
+
 	switchLogsCallbackCtrl   cyclemanager.CycleCallbackCtrl
 	condenseLogsCallbackCtrl cyclemanager.CycleCallbackCtrl
 }
@@ -274,6 +283,7 @@ const (
 	ClearLinksAtLevel // added in v1.8.0-rc.1, see https://github.com/weaviate/weaviate/issues/1701
 	AddLinksAtLevel   // added in v1.8.0-rc.1, see https://github.com/weaviate/weaviate/issues/1705
 	AddPQ
+	AddSQ
 )
 
 func (t HnswCommitType) String() string {
@@ -302,6 +312,8 @@ func (t HnswCommitType) String() string {
 		return "ClearLinksAtLevel"
 	case AddPQ:
 		return "AddProductQuantizer"
+	case AddSQ:
+		return "AddScalarQuantizer"
 	}
 	return "unknown commit type"
 }
@@ -317,6 +329,13 @@ func (l *hnswCommitLogger) AddPQ(data ssdhelpers.PQData) error {
 	return l.commitLogger.AddPQ(data)
 }
 
+func (l *hnswCommitLogger) AddSQ(data ssdhelpers.SQData) error {
+	l.Lock()
+	defer l.Unlock()
+
+	return l.commitLogger.AddSQ(data)
+}
+
 // AddNode adds an empty node
 func (l *hnswCommitLogger) AddNode(node *vertex) error {
 	l.Lock()
@@ -431,7 +450,14 @@ func (l *hnswCommitLogger) startCombineAndCondenseLogs(shouldAbort cyclemanager.
 			WithField("action", "hnsw_commit_log_condensing").
 			Error("hnsw commit log maintenance (condensing) failed")
 	}
-	return executed1 || executed2
+
+	executed3, err := l.checkpoint()
+	if err != nil {
+		l.logger.WithError(err).
+			WithField("action", "hnsw_checkpoint").
+			Error("hnsw commit log maintenance (checkpoint) failed")
+	}
+	return executed1 || executed2 || executed3
 }
 
 func (l *hnswCommitLogger) SwitchCommitLogs(force bool) error {
@@ -486,7 +512,7 @@ func (l *hnswCommitLogger) switchCommitLogs(force bool) (bool, error) {
 		return true, errors.Wrap(err, "create commit log file")
 	}
 
-	l.commitLogger = commitlog.NewLoggerWithFile(fd)
+	l.commitLogger = commitlog.NewLoggerWithFileAndSyncPolicy(fd, l.syncPolicy)
 
 	return true, nil
 }
@@ -519,6 +545,45 @@ func (l *hnswCommitLogger) condenseOldLogs() (bool, error) {
 	return false, nil
 }
 
+// checkpoint moves the oldest condensed logs into the checkpoint once the
+// checkpoint interval has passed
+func (l *hnswCommitLogger) checkpoint() (bool, error) {
+	if l.checkpointInterval <= 0 || time.Since(l.lastCheckpoint) < l.checkpointInterval {
+		return false, nil
+	}
+
+	files, err := getCommitFileNames(l.rootPath, l.id)
+	if err != nil {
+		return false, err
+	}
+
+	if len(files) <= 1 {
+		return false, nil
+	}
+
+	// the last file is still in use. Of the others only the leading condensed
+	// ones are candidates, so the checkpoint is always followed by the
+	// remaining logs in order.
+	var candidates []string
+	for _, file := range files[:len(files)-1] {
+		if !strings.HasSuffix(file, ".condensed") {
+			break
+		}
+		candidates = append(candidates, file)
+	}
+
+	if len(candidates) == 0 {
+		return false, nil
+	}
+
+	if err := createCheckpoint(l.rootPath, l.id, candidates, l.logger); err != nil {
+		return true, err
+	}
+
+	l.lastCheckpoint = time.Now()
+	return true, nil
+}
+
 func (l *hnswCommitLogger) combineLogs() (bool, error) {
 	// maxSize is the desired final size, since we assume a lot of redundancy we
 	// can set the combining threshold higher than the final threshold under the
@@ -546,12 +611,23 @@ func (l *hnswCommitLogger) Drop(ctx context.Context) error {
 			return errors.Wrap(err, "delete commit files directory")
 		}
 	}
+
+	if err := os.Remove(checkpointPath(l.rootPath, l.id)); err != nil && !os.IsNotExist(err) {
+		return errors.Wrap(err, "delete checkpoint")
+	}
 	return nil
 }
 
 func (l *hnswCommitLogger) Flush() error {
 	l.Lock()
-	defer l.Unlock()
+	if err := l.commitLogger.Flush(); err != nil {
+		l.Unlock()
+		return err
+	}
+	// the logger might be switched while waiting for the sync, in which case
+	// closing the old one completes the sync
+	cl := l.commitLogger
+	l.Unlock()
 
-	return l.commitLogger.Flush()
+	return cl.Sync()
 }
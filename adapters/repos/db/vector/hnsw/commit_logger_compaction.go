@@ -0,0 +1,73 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+// compactableCommitLogger is implemented by commit loggers whose logs can be
+// condensed on demand
+type compactableCommitLogger interface {
+	Compact(ctx context.Context) error
+}
+
+// CompactCommitLogs condenses all commit logs of the index, including the
+// one currently written to, to reclaim the space taken up by redundant and
+// deleted entries. It is a no-op for commit loggers without logs on disk.
+func (h *hnsw) CompactCommitLogs(ctx context.Context) error {
+	cl, ok := h.commitLog.(compactableCommitLogger)
+	if !ok {
+		return nil
+	}
+
+	if err := cl.Compact(ctx); err != nil {
+		return errors.Wrap(err, "compact commit logs")
+	}
+	return nil
+}
+
+// Compact switches to a new commit log and then combines and condenses all
+// previous logs until nothing is left to do. The periodic condensing is
+// paused meanwhile, so that both do not work on the same files.
+func (l *hnswCommitLogger) Compact(ctx context.Context) error {
+	if err := l.condenseLogsCallbackCtrl.Deactivate(ctx); err != nil {
+		return errors.Wrap(err, "pause commit log maintenance")
+	}
+	defer l.condenseLogsCallbackCtrl.Activate()
+
+	if err := l.SwitchCommitLogs(true); err != nil {
+		return errors.Wrap(err, "switch commit log")
+	}
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		combined, err := l.combineLogs()
+		if err != nil {
+			return errors.Wrap(err, "combine")
+		}
+
+		condensed, err := l.condenseOldLogs()
+		if err != nil {
+			return errors.Wrap(err, "condense")
+		}
+
+		if !combined && !condensed {
+			return nil
+		}
+	}
+}
@@ -11,6 +11,12 @@
 
 package hnsw
 
+import (
+	"time"
+
+	"github.com/weaviate/weaviate/entities/diskio"
+)
+
 type CommitlogOption func(l *hnswCommitLogger) error
 
 func WithCommitlogThreshold(size int64) CommitlogOption {
@@ -26,3 +32,25 @@ func WithCommitlogThresholdForCombining(size int64) CommitlogOption {
 		return nil
 	}
 }
+
+// WithCheckpointInterval enables checkpoints of the graph, a value of 0
+// disables them
+func WithCheckpointInterval(interval time.Duration) CommitlogOption {
+	return func(l *hnswCommitLogger) error {
+		l.checkpointInterval = interval
+		return nil
+	}
+}
+
+// WithSyncPolicy controls whether Flush waits until the commit log is on disk,
+// see diskio.SyncModeNone, diskio.SyncModeAlways and diskio.SyncModeGroup
+func WithSyncPolicy(policy diskio.SyncPolicy) CommitlogOption {
+	return func(l *hnswCommitLogger) error {
+		if err := diskio.ValidSyncMode(policy.Mode); err != nil {
+			return err
+		}
+
+		l.syncPolicy = policy
+		return nil
+	}
+}
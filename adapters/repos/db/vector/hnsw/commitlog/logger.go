@@ -13,15 +13,18 @@ package commitlog
 
 import (
 	"encoding/binary"
+	"math"
 	"os"
 
 	"github.com/pkg/errors"
 	ssdhelpers "github.com/weaviate/weaviate/adapters/repos/db/vector/ssdhelpers"
+	"github.com/weaviate/weaviate/entities/diskio"
 )
 
 type Logger struct {
-	file *os.File
-	bufw *bufWriter
+	file   *os.File
+	bufw   *bufWriter
+	syncer *diskio.Syncer
 }
 
 // TODO: these are duplicates with the hnsw package, unify them
@@ -41,6 +44,7 @@ const (
 	ClearLinksAtLevel // added in v1.8.0-rc.1, see https://github.com/weaviate/weaviate/issues/1701
 	AddLinksAtLevel   // added in v1.8.0-rc.1, see https://github.com/weaviate/weaviate/issues/1705
 	AddPQ
+	AddSQ
 )
 
 func NewLogger(fileName string) *Logger {
@@ -49,11 +53,26 @@ func NewLogger(fileName string) *Logger {
 		panic(err)
 	}
 
-	return &Logger{file: file, bufw: NewWriter(file)}
+	return &Logger{
+		file:   file,
+		bufw:   NewWriter(file),
+		syncer: diskio.NewSyncer(file, diskio.SyncPolicy{Mode: diskio.SyncModeNone}),
+	}
 }
 
 func NewLoggerWithFile(file *os.File) *Logger {
-	return &Logger{file: file, bufw: NewWriterSize(file, 32*1024)}
+	return NewLoggerWithFileAndSyncPolicy(file,
+		diskio.SyncPolicy{Mode: diskio.SyncModeNone})
+}
+
+// NewLoggerWithFileAndSyncPolicy creates a logger whose Sync makes flushed
+// writes durable according to the policy
+func NewLoggerWithFileAndSyncPolicy(file *os.File, policy diskio.SyncPolicy) *Logger {
+	return &Logger{
+		file:   file,
+		bufw:   NewWriterSize(file, 32*1024),
+		syncer: diskio.NewSyncer(file, policy),
+	}
 }
 
 func (l *Logger) SetEntryPointWithMaxLayer(id uint64, level int) error {
@@ -95,6 +114,16 @@ func (l *Logger) AddPQ(data ssdhelpers.PQData) error {
 	return err
 }
 
+func (l *Logger) AddSQ(data ssdhelpers.SQData) error {
+	toWrite := make([]byte, 11)
+	toWrite[0] = byte(AddSQ)
+	binary.LittleEndian.PutUint32(toWrite[1:5], math.Float32bits(data.A))
+	binary.LittleEndian.PutUint32(toWrite[5:9], math.Float32bits(data.B))
+	binary.LittleEndian.PutUint16(toWrite[9:11], data.Dimensions)
+	_, err := l.bufw.Write(toWrite)
+	return err
+}
+
 func (l *Logger) AddLinkAtLevel(id uint64, level int, target uint64) error {
 	toWrite := make([]byte, 19)
 	toWrite[0] = byte(AddLinkAtLevel)
@@ -237,11 +266,23 @@ func (l *Logger) Flush() error {
 	return l.bufw.Flush()
 }
 
+// Sync waits until everything flushed so far is durable according to the sync
+// policy of the logger. Unlike the other methods it is safe to be called
+// concurrently, and should be called without holding locks, so that
+// concurrent writers can share an fsync.
+func (l *Logger) Sync() error {
+	return l.syncer.Sync()
+}
+
 func (l *Logger) Close() error {
 	if err := l.bufw.Flush(); err != nil {
 		return err
 	}
 
+	if err := l.syncer.Close(); err != nil {
+		return err
+	}
+
 	if err := l.file.Close(); err != nil {
 		return err
 	}
@@ -40,55 +40,111 @@ func (h *hnsw) initCompressedStore() error {
 }
 
 func (h *hnsw) Compress(cfg ent.PQConfig) error {
-	if h.nodes[0] == nil {
-		return errors.New("Compress command cannot be executed before inserting some data. Please, insert your data first.")
-	}
-	err := h.initCompressedStore()
-	if err != nil {
-		return errors.Wrap(err, "Initializing compressed vector store")
-	}
+	return h.compress(cfg, nil)
+}
 
-	vec, err := h.vectorForID(context.Background(), h.nodes[0].id)
+// compress trains the product quantizer on the sample, or on all cached
+// vectors if there is no sample, and then switches the index to compressed
+// vectors.
+func (h *hnsw) compress(cfg ent.PQConfig, sample [][]float32) error {
+	dims, err := h.prepareCompression()
 	if err != nil {
-		return errors.Wrap(err, "Inferring data dimensions")
+		return err
 	}
-	dims := len(vec)
 
 	// segments == 0 (default value) means use as many segments as dimensions
 	if cfg.Segments <= 0 {
 		cfg.Segments = dims
 	}
 
-	h.pq, err = ssdhelpers.NewProductQuantizer(cfg, h.distancerProvider, dims)
+	pq, err := ssdhelpers.NewProductQuantizer(cfg, h.distancerProvider, dims)
 	if err != nil {
 		return errors.Wrap(err, "Compressing vectors.")
 	}
 
-	data := h.cache.all()
-	cleanData := make([][]float32, 0, len(data))
-	for _, point := range data {
+	if sample == nil {
+		sample = h.cachedVectors()
+	}
+	pq.Fit(sample)
+
+	return h.switchToCompressed(pq, func() error {
+		return errors.Wrap(h.commitLog.AddPQ(pq.ExposeFields()),
+			"Adding PQ to the commit logger")
+	})
+}
+
+// CompressSQ learns the range of the scalar quantizer from the cached vectors
+// and then switches the index to compressed vectors
+func (h *hnsw) CompressSQ(cfg ent.SQConfig) error {
+	dims, err := h.prepareCompression()
+	if err != nil {
+		return err
+	}
+
+	sq, err := ssdhelpers.NewScalarQuantizer(cfg, h.distancerProvider, dims)
+	if err != nil {
+		return errors.Wrap(err, "Compressing vectors.")
+	}
+	sq.Fit(h.cachedVectors())
+
+	return h.switchToCompressed(sq, func() error {
+		return errors.Wrap(h.commitLog.AddSQ(sq.ExposeFields()),
+			"Adding SQ to the commit logger")
+	})
+}
+
+// prepareCompression initializes the compressed store and returns the
+// dimensions of the vectors
+func (h *hnsw) prepareCompression() (int, error) {
+	if h.isEmpty() {
+		return 0, errors.New("Compress command cannot be executed before inserting some data. Please, insert your data first.")
+	}
+	err := h.initCompressedStore()
+	if err != nil {
+		return 0, errors.Wrap(err, "Initializing compressed vector store")
+	}
+
+	vec, err := h.vectorForID(context.Background(), h.getEntrypoint())
+	if err != nil {
+		return 0, errors.Wrap(err, "Inferring data dimensions")
+	}
+	return len(vec), nil
+}
+
+func (h *hnsw) cachedVectors() [][]float32 {
+	var vectors [][]float32
+	for _, point := range h.cache.all() {
 		if point == nil {
 			continue
 		}
-		cleanData = append(cleanData, point)
+		vectors = append(vectors, point)
 	}
-	h.compressedVectorsCache.grow(uint64(len(data)))
-	h.pq.Fit(cleanData)
+	return vectors
+}
 
+// switchToCompressed encodes all cached vectors with the trained quantizer.
+// Inserts and searches are blocked while the vectors are encoded, they either
+// see the uncompressed or the fully compressed index.
+func (h *hnsw) switchToCompressed(quantizer ssdhelpers.Quantizer,
+	persist func() error,
+) error {
 	h.compressActionLock.Lock()
 	defer h.compressActionLock.Unlock()
+	h.quantizer = quantizer
+	data := h.cache.all()
+	h.compressedVectorsCache.grow(uint64(len(data)))
 	ssdhelpers.Concurrently(uint64(len(data)),
 		func(index uint64) {
 			if data[index] == nil {
 				return
 			}
 
-			encoded := h.pq.Encode(data[index])
+			encoded := h.quantizer.Encode(data[index])
 			h.storeCompressedVector(index, encoded)
 			h.compressedVectorsCache.preload(index, encoded)
 		})
-	if err := h.commitLog.AddPQ(h.pq.ExposeFields()); err != nil {
-		return errors.Wrap(err, "Adding PQ to the commit logger")
+	if err := persist(); err != nil {
+		return err
 	}
 
 	h.compressed.Store(true)
@@ -118,5 +174,5 @@ func (h *hnsw) getCompressedVectorForID(ctx context.Context, id uint64) ([]byte,
 		vec = distancer.Normalize(vec)
 	}
 
-	return h.pq.Encode(vec), nil
+	return h.quantizer.Encode(vec), nil
 }
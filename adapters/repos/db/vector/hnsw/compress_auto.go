@@ -0,0 +1,211 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+	"math/rand"
+	"sync"
+
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+// pqAutoTrainer keeps a uniform sample of the vectors imported into an
+// uncompressed index. Once the configured threshold of vectors has been
+// imported, the product quantizer is trained on the sample in the background
+// and the index switches to compressed vectors without user action.
+type pqAutoTrainer struct {
+	sync.Mutex
+	cfg ent.PQConfig
+	// number of vectors the sample has been drawn from
+	seen int
+	// number of vectors which have been in the index before sampling started,
+	// they count towards the threshold but are not part of the sample
+	existing int
+	sample   [][]float32
+	// started is set once the threshold is reached, a failed training is only
+	// retried after the pq config changed
+	started  bool
+	running  bool
+	randFunc func(n int) int
+}
+
+// configure applies the pq config of the index. existing is the number of
+// vectors already in the index, it is only considered if sampling has not
+// started before. It returns true if the threshold is already reached and the
+// training should be started.
+func (a *pqAutoTrainer) configure(cfg ent.PQConfig, existing int) bool {
+	a.Lock()
+	defer a.Unlock()
+
+	wasEnabled := a.cfg.AutoTrain
+	if cfg != a.cfg && !a.running {
+		a.started = false
+	}
+	a.cfg = cfg
+	if a.randFunc == nil {
+		a.randFunc = rand.Intn
+	}
+	if !cfg.AutoTrain || cfg.Enabled {
+		// compression is either off or triggered manually
+		a.seen, a.existing, a.sample = 0, 0, nil
+		return false
+	}
+	if !wasEnabled {
+		a.existing = existing
+	}
+
+	return a.readyUnlocked()
+}
+
+// observe adds an imported vector to the sample, the sample is a reservoir
+// of up to trainingLimit vectors. It returns true exactly once, when the
+// threshold is reached and the training should be started.
+func (a *pqAutoTrainer) observe(vec []float32) bool {
+	a.Lock()
+	defer a.Unlock()
+
+	if !a.cfg.AutoTrain || a.cfg.Enabled || a.started {
+		return false
+	}
+
+	a.seen++
+	limit := a.cfg.TrainingLimit
+	if limit <= 0 || len(a.sample) < limit {
+		a.sample = append(a.sample, vec)
+	} else if i := a.randFunc(a.seen); i < limit {
+		a.sample[i] = vec
+	}
+
+	return a.readyUnlocked()
+}
+
+func (a *pqAutoTrainer) readyUnlocked() bool {
+	if a.started || a.existing+a.seen < a.cfg.AutoTrainThreshold {
+		return false
+	}
+	a.started = true
+	return true
+}
+
+// takeSample hands the sample and the config to train on over to the
+// training, the trainer does not keep a reference to the sample. missing is
+// the number of vectors the sample lacks because they have been in the index
+// before sampling started.
+func (a *pqAutoTrainer) takeSample() (cfg ent.PQConfig, sample [][]float32, missing int) {
+	a.Lock()
+	defer a.Unlock()
+
+	target := a.existing + a.seen
+	if a.cfg.TrainingLimit > 0 && target > a.cfg.TrainingLimit {
+		target = a.cfg.TrainingLimit
+	}
+
+	sample = a.sample
+	a.sample = nil
+	a.running = true
+	return a.cfg, sample, target - len(sample)
+}
+
+func (a *pqAutoTrainer) done() {
+	a.Lock()
+	defer a.Unlock()
+
+	a.running = false
+}
+
+// configurePQAutoTrain applies the pq config and starts the training right
+// away if the index already holds enough vectors
+func (h *hnsw) configurePQAutoTrain(cfg ent.PQConfig) {
+	if h.compressed.Load() {
+		return
+	}
+
+	if h.pqAutoTrain.configure(cfg, h.nodeCount()) {
+		go h.autoTrainPQ()
+	}
+}
+
+func (h *hnsw) nodeCount() int {
+	h.RLock()
+	defer h.RUnlock()
+
+	count := 0
+	for _, node := range h.nodes {
+		if node != nil {
+			count++
+		}
+	}
+	return count
+}
+
+// observeForPQAutoTrain must be called with the compressActionLock held for
+// reading
+func (h *hnsw) observeForPQAutoTrain(vec []float32) {
+	if h.compressed.Load() {
+		return
+	}
+
+	if h.pqAutoTrain.observe(vec) {
+		go h.autoTrainPQ()
+	}
+}
+
+// autoTrainPQ trains the product quantizer on the sample and compresses the
+// index. If the sample is smaller than the training limit, because the index
+// already held vectors when sampling started, it is topped up with vectors of
+// random nodes.
+func (h *hnsw) autoTrainPQ() {
+	cfg, sample, missing := h.pqAutoTrain.takeSample()
+	defer h.pqAutoTrain.done()
+	if h.compressed.Load() {
+		return
+	}
+
+	sample = h.topUpPQSample(sample, missing)
+	h.logger.WithField("action", "compress").
+		WithField("sample_size", len(sample)).
+		Info("automatically training product quantizer")
+
+	if err := h.compress(cfg, sample); err != nil {
+		h.logger.WithField("action", "compress").WithError(err).
+			Error("automatic vector compression failed")
+		return
+	}
+	h.logger.WithField("action", "compress").Info("automatic vector compression complete")
+}
+
+func (h *hnsw) topUpPQSample(sample [][]float32, missing int) [][]float32 {
+	h.RLock()
+	size := len(h.nodes)
+	h.RUnlock()
+
+	// give up after a bounded number of draws, the graph may be sparse
+	for attempts := 0; missing > 0 && attempts < 4*missing; attempts++ {
+		id := uint64(rand.Intn(size))
+		h.shardedNodeLocks[id%NodeLockStripe].RLock()
+		exists := h.nodes[id] != nil
+		h.shardedNodeLocks[id%NodeLockStripe].RUnlock()
+		if !exists {
+			continue
+		}
+
+		vec, err := h.cache.get(context.Background(), id)
+		if err != nil || vec == nil {
+			continue
+		}
+		sample = append(sample, vec)
+		missing--
+	}
+
+	return sample
+}
@@ -0,0 +1,132 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/ssdhelpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestPQAutoTrainer(t *testing.T) {
+	cfg := ent.PQConfig{AutoTrain: true, AutoTrainThreshold: 10, TrainingLimit: 4}
+
+	t.Run("disabled", func(t *testing.T) {
+		a := &pqAutoTrainer{}
+		assert.False(t, a.configure(ent.PQConfig{AutoTrainThreshold: 1}, 5))
+		assert.False(t, a.observe([]float32{1}))
+		assert.Nil(t, a.sample)
+	})
+
+	t.Run("reservoir is capped and the threshold is reached once", func(t *testing.T) {
+		a := &pqAutoTrainer{}
+		require.False(t, a.configure(cfg, 0))
+		for i := 0; i < 9; i++ {
+			require.False(t, a.observe([]float32{float32(i)}))
+		}
+		assert.Len(t, a.sample, 4)
+		assert.True(t, a.observe([]float32{9}))
+		assert.False(t, a.observe([]float32{10}))
+
+		_, sample, missing := a.takeSample()
+		assert.Len(t, sample, 4)
+		assert.Equal(t, 0, missing)
+	})
+
+	t.Run("existing vectors count towards the threshold", func(t *testing.T) {
+		a := &pqAutoTrainer{}
+		require.False(t, a.configure(cfg, 8))
+		assert.False(t, a.observe([]float32{1}))
+		assert.True(t, a.observe([]float32{2}))
+
+		_, sample, missing := a.takeSample()
+		assert.Len(t, sample, 2)
+		assert.Equal(t, 2, missing)
+	})
+
+	t.Run("threshold already reached when enabled", func(t *testing.T) {
+		a := &pqAutoTrainer{}
+		assert.True(t, a.configure(cfg, 10))
+	})
+
+	t.Run("failed training is retried after a config change", func(t *testing.T) {
+		a := &pqAutoTrainer{}
+		require.True(t, a.configure(cfg, 10))
+		a.takeSample()
+		a.done()
+
+		assert.False(t, a.configure(cfg, 10))
+		updated := cfg
+		updated.Segments = 2
+		assert.True(t, a.configure(updated, 10))
+	})
+}
+
+func TestPQAutoTrainCompressesIndex(t *testing.T) {
+	dimensions := 16
+	vectorsSize := 1500
+	vectors, queries := testinghelpers.RandomVecs(vectorsSize, 10, dimensions)
+
+	uc := ent.NewDefaultUserConfig()
+	uc.MaxConnections = 16
+	uc.EFConstruction = 64
+	uc.EF = 64
+	uc.VectorCacheMaxObjects = 10e6
+	uc.PQ.AutoTrain = true
+	uc.PQ.AutoTrainThreshold = 1000
+	uc.PQ.TrainingLimit = 500
+	uc.PQ.Segments = dimensions / 2
+
+	index, err := New(Config{
+		RootPath:              t.TempDir(),
+		ID:                    "pq-auto-train",
+		MakeCommitLoggerThunk: MakeNoopCommitLogger,
+		DistanceProvider:      distancer.NewL2SquaredProvider(),
+		VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+			return vectors[int(id)], nil
+		},
+		TempVectorForIDThunk: TempVectorForIDThunk(vectors),
+	}, uc, cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+		cyclemanager.NewCallbackGroupNoop())
+	require.Nil(t, err)
+	defer index.Shutdown(context.Background())
+	index.PostStartup()
+
+	ssdhelpers.Concurrently(uint64(uc.PQ.AutoTrainThreshold-1), func(id uint64) {
+		require.Nil(t, index.Add(id, vectors[id]))
+	})
+	assert.False(t, index.compressed.Load())
+
+	// the remaining inserts race with the background training and the switch
+	// to compressed vectors
+	ssdhelpers.Concurrently(uint64(vectorsSize-uc.PQ.AutoTrainThreshold+1), func(i uint64) {
+		id := uint64(uc.PQ.AutoTrainThreshold-1) + i
+		require.Nil(t, index.Add(id, vectors[id]))
+	})
+
+	require.Eventually(t, index.compressed.Load, 30*time.Second, 10*time.Millisecond)
+	assert.Equal(t, dimensions/2, int(index.quantizer.(*ssdhelpers.ProductQuantizer).ExposeFields().M))
+
+	for _, q := range queries {
+		res, _, err := index.SearchByVector(q, 10, nil)
+		require.Nil(t, err)
+		assert.Len(t, res, 10)
+	}
+}
@@ -0,0 +1,127 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/ssdhelpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestCompressSQ(t *testing.T) {
+	dimensions := 32
+	vectorsSize := 1000
+	k := 10
+	vectors, queries := testinghelpers.RandomVecs(vectorsSize, 20, dimensions)
+	distanceProvider := distancer.NewL2SquaredProvider()
+
+	uc := ent.NewDefaultUserConfig()
+	uc.MaxConnections = 16
+	uc.EFConstruction = 64
+	uc.EF = 64
+	uc.VectorCacheMaxObjects = 10e6
+	uc.SQ.Enabled = true
+
+	index, err := New(Config{
+		RootPath:              t.TempDir(),
+		ID:                    "sq",
+		MakeCommitLoggerThunk: MakeNoopCommitLogger,
+		DistanceProvider:      distanceProvider,
+		VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+			return vectors[int(id)], nil
+		},
+		TempVectorForIDThunk: TempVectorForIDThunk(vectors),
+	}, uc, cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+		cyclemanager.NewCallbackGroupNoop())
+	require.Nil(t, err)
+	defer index.Shutdown(context.Background())
+
+	ssdhelpers.Concurrently(uint64(vectorsSize), func(id uint64) {
+		require.Nil(t, index.Add(id, vectors[id]))
+	})
+
+	require.Nil(t, index.CompressSQ(uc.SQ))
+	require.True(t, index.compressed.Load())
+	_, ok := index.quantizer.(*ssdhelpers.ScalarQuantizer)
+	require.True(t, ok)
+
+	distance := func(x, y []float32) float32 {
+		dist, _, _ := distanceProvider.SingleDist(x, y)
+		return dist
+	}
+	recall := func(rescore *searchparams.Rescore) float32 {
+		var relevant uint64
+		for _, q := range queries {
+			truth := testinghelpers.BruteForce(vectors, q, k, distance)
+			res, _, err := index.SearchByVectorWithRescore(q, k, nil, rescore)
+			require.Nil(t, err)
+			relevant += testinghelpers.MatchesInLists(truth, res)
+		}
+		return float32(relevant) / float32(k*len(queries))
+	}
+
+	t.Run("with rescoring", func(t *testing.T) {
+		require.True(t, index.shouldRescore(nil))
+		assert.Greater(t, recall(nil), float32(0.9))
+	})
+
+	t.Run("without rescoring", func(t *testing.T) {
+		uc.SQ.RescoreLimit = 0
+		require.Nil(t, index.UpdateUserConfig(uc, func() {}))
+		require.False(t, index.shouldRescore(nil))
+		assert.Greater(t, recall(nil), float32(0.8))
+	})
+
+	t.Run("with rescoring requested by the query", func(t *testing.T) {
+		rescore := &searchparams.Rescore{Limit: 20, Oversampling: 2}
+		require.True(t, index.shouldRescore(rescore))
+		assert.Equal(t, 20, index.rescoreLimit(k, 64, rescore))
+		assert.Greater(t, recall(rescore), float32(0.9))
+	})
+
+	t.Run("with an exact query", func(t *testing.T) {
+		for _, q := range queries {
+			truth := testinghelpers.BruteForce(vectors, q, k, distance)
+			res, _, err := index.SearchByVectorExact(q, k, nil)
+			require.Nil(t, err)
+			assert.Equal(t, truth, res)
+		}
+	})
+
+	t.Run("with an exact query and an allow list", func(t *testing.T) {
+		allowList := helpers.NewAllowList(1, 3, 5, 7)
+		res, dists, err := index.SearchByVectorExact(vectors[5], 2, allowList)
+		require.Nil(t, err)
+		require.Len(t, res, 2)
+		assert.Equal(t, uint64(5), res[0])
+		assert.Equal(t, float32(0), dists[0])
+		assert.Contains(t, []uint64{1, 3, 7}, res[1])
+	})
+
+	t.Run("inserts after the compression", func(t *testing.T) {
+		vec := vectors[0]
+		vectors = append(vectors, vec)
+		require.Nil(t, index.Add(uint64(vectorsSize), vec))
+		res, _, err := index.SearchByVector(vec, 2, nil)
+		require.Nil(t, err)
+		assert.ElementsMatch(t, []uint64{0, uint64(vectorsSize)}, res)
+	})
+}
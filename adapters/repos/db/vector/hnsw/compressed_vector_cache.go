@@ -25,6 +25,7 @@ type compressedShardedLockCache struct {
 	shardedLocks        []sync.RWMutex
 	cache               [][]byte
 	maxSize             int64
+	maxBytes            int64
 	count               int64
 	cancel              chan bool
 	vectorForID         CompressedVectorForID
@@ -209,7 +210,7 @@ func (c *compressedShardedLockCache) watchForDeletion() {
 }
 
 func (c *compressedShardedLockCache) replaceIfFull() {
-	if atomic.LoadInt64(&c.count) >= atomic.LoadInt64(&c.maxSize) {
+	if atomic.LoadInt64(&c.count) >= c.copyMaxSize() {
 		c.maintenanceLock.Lock()
 		defer c.maintenanceLock.Unlock()
 
@@ -250,6 +251,27 @@ func (c *compressedShardedLockCache) updateMaxSize(size int64) {
 
 //nolint:unused
 func (c *compressedShardedLockCache) copyMaxSize() int64 {
-	sizeCopy := atomic.LoadInt64(&c.maxSize)
-	return sizeCopy
+	return effectiveMaxSize(atomic.LoadInt64(&c.maxSize),
+		atomic.LoadInt64(&c.maxBytes), c.vectorBytes())
+}
+
+//nolint:unused
+func (c *compressedShardedLockCache) updateMaxBytes(size int64) {
+	atomic.StoreInt64(&c.maxBytes, size)
+}
+
+//nolint:unused
+func (c *compressedShardedLockCache) copyMaxBytes() int64 {
+	return atomic.LoadInt64(&c.maxBytes)
+}
+
+// vectorBytes is the footprint of a single cached vector, the dimensions of
+// the compressed cache are the length of the encoded vector in bytes
+func (c *compressedShardedLockCache) vectorBytes() int64 {
+	return vectorFootprint(atomic.LoadInt32(&c.dims), 1)
+}
+
+//nolint:unused
+func (c *compressedShardedLockCache) estimatedBytes() int64 {
+	return atomic.LoadInt64(&c.count) * c.vectorBytes()
 }
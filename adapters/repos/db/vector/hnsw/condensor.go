@@ -53,7 +53,36 @@ func (c *MemoryCondensor) Do(fileName string) error {
 
 	c.newLog = NewWriterSize(c.newLogFile, 1*1024*1024)
 
-	if res.Compressed {
+	if err := c.writeState(res, false); err != nil {
+		return err
+	}
+
+	if err := c.newLog.Flush(); err != nil {
+		return errors.Wrap(err, "close new commit log")
+	}
+
+	if err := c.newLogFile.Close(); err != nil {
+		return errors.Wrap(err, "close new commit log")
+	}
+
+	if err := os.Remove(fileName); err != nil {
+		return errors.Wrap(err, "cleanup old (uncondensed) commit log")
+	}
+
+	return nil
+}
+
+// writeState writes the state to the new log. If replaceAll is set, all links
+// are written as replacements, so the log holds the full state of the graph
+// regardless of any prior logs.
+func (c *MemoryCondensor) writeState(res *DeserializationResult,
+	replaceAll bool,
+) error {
+	if res.Compressed && res.SQCompressed {
+		if err := c.AddSQ(res.SQData); err != nil {
+			return fmt.Errorf("write sq data: %w", err)
+		}
+	} else if res.Compressed {
 		if err := c.AddPQ(res.PQData); err != nil {
 			return fmt.Errorf("write pq data: %w", err)
 		}
@@ -75,7 +104,7 @@ func (c *MemoryCondensor) Do(fileName string) error {
 		}
 
 		for level, links := range node.connections {
-			if res.ReplaceLinks(node.id, uint16(level)) {
+			if replaceAll || res.ReplaceLinks(node.id, uint16(level)) {
 				if err := c.SetLinksAtLevel(node.id, level, links); err != nil {
 					return errors.Wrapf(err,
 						"write links for node %d at level %d to commit log", node.id, level)
@@ -103,18 +132,6 @@ func (c *MemoryCondensor) Do(fileName string) error {
 		}
 	}
 
-	if err := c.newLog.Flush(); err != nil {
-		return errors.Wrap(err, "close new commit log")
-	}
-
-	if err := c.newLogFile.Close(); err != nil {
-		return errors.Wrap(err, "close new commit log")
-	}
-
-	if err := os.Remove(fileName); err != nil {
-		return errors.Wrap(err, "cleanup old (uncondensed) commit log")
-	}
-
 	return nil
 }
 
@@ -256,6 +273,16 @@ func (c *MemoryCondensor) AddPQ(data ssdhelpers.PQData) error {
 	return err
 }
 
+func (c *MemoryCondensor) AddSQ(data ssdhelpers.SQData) error {
+	toWrite := make([]byte, 11)
+	toWrite[0] = byte(AddSQ)
+	binary.LittleEndian.PutUint32(toWrite[1:5], math.Float32bits(data.A))
+	binary.LittleEndian.PutUint32(toWrite[5:9], math.Float32bits(data.B))
+	binary.LittleEndian.PutUint16(toWrite[9:11], data.Dimensions)
+	_, err := c.newLog.Write(toWrite)
+	return err
+}
+
 func NewMemoryCondensor(logger logrus.FieldLogger) *MemoryCondensor {
 	return &MemoryCondensor{logger: logger}
 }
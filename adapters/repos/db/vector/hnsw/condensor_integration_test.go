@@ -565,6 +565,54 @@ func TestCondensorWithPQInformation(t *testing.T) {
 	})
 }
 
+func TestCondensorWithSQInformation(t *testing.T) {
+	rootPath := t.TempDir()
+	ctx := context.Background()
+
+	logger, _ := test.NewNullLogger()
+	uncondensed, err := NewCommitLogger(rootPath, "uncondensed", logger,
+		cyclemanager.NewCallbackGroupNoop())
+	require.Nil(t, err)
+	defer uncondensed.Shutdown(ctx)
+
+	data := ssdhelpers.SQData{
+		A:          -0.5,
+		B:          2.25,
+		Dimensions: 128,
+	}
+
+	t.Run("add sq info", func(t *testing.T) {
+		require.Nil(t, uncondensed.AddSQ(data))
+		require.Nil(t, uncondensed.Flush())
+	})
+
+	t.Run("condense the original and verify the SQ info is present", func(t *testing.T) {
+		input, ok, err := getCurrentCommitLogFileName(commitLogDirectory(rootPath, "uncondensed"))
+		require.Nil(t, err)
+		require.True(t, ok)
+
+		err = NewMemoryCondensor(logger).Do(commitLogFileName(rootPath, "uncondensed", input))
+		require.Nil(t, err)
+
+		actual, ok, err := getCurrentCommitLogFileName(
+			commitLogDirectory(rootPath, "uncondensed"))
+		require.Nil(t, err)
+		require.True(t, ok)
+
+		initialState := DeserializationResult{}
+		fd, err := os.Open(commitLogFileName(rootPath, "uncondensed", actual))
+		require.Nil(t, err)
+
+		bufr := bufio.NewReader(fd)
+		res, _, err := NewDeserializer(logger).Do(bufr, &initialState, false)
+		require.Nil(t, err)
+
+		assert.True(t, res.Compressed)
+		assert.True(t, res.SQCompressed)
+		assert.Equal(t, data, res.SQData)
+	})
+}
+
 func assertIndicesFromCommitLogsMatch(t *testing.T, fileNameControl string,
 	fileNames []string,
 ) {
@@ -12,6 +12,7 @@
 package hnsw
 
 import (
+	"math"
 	"sync/atomic"
 
 	"github.com/pkg/errors"
@@ -30,26 +31,27 @@ func ValidateUserConfigUpdate(initial, updated schema.VectorIndexConfig) error {
 		return errors.Errorf("updated is not UserConfig, but %T", updated)
 	}
 
+	// efConstruction is mutable and maxConnections can be increased, they
+	// only apply to subsequent inserts. Existing nodes keep their connections
+	// until they are touched by an insert or a delete. cleanupIntervalSeconds
+	// is mutable as well, the owner of the cleanup cycle restarts it with the
+	// new interval.
 	immutableFields := []immutableParameter{
 		{
-			name:     "efConstruction",
-			accessor: func(c ent.UserConfig) interface{} { return c.EFConstruction },
-		},
-		{
-			name:     "maxConnections",
-			accessor: func(c ent.UserConfig) interface{} { return c.MaxConnections },
+			name:     "distance",
+			accessor: func(c ent.UserConfig) interface{} { return c.Distance },
 		},
 		{
-			// NOTE: There isn't a technical reason for this to be immutable, it
-			// simply hasn't been implemented yet. It would require to stop the
-			// current timer and start a new one. Certainly possible, but let's see
-			// if anyone actually needs this before implementing it.
-			name:     "cleanupIntervalSeconds",
-			accessor: func(c ent.UserConfig) interface{} { return c.CleanupIntervalSeconds },
+			// the cache is created with the index, switching its representation
+			// would require rebuilding it from scratch
+			name:     "vectorCacheDType",
+			accessor: func(c ent.UserConfig) interface{} { return c.VectorCacheDType },
 		},
 		{
-			name:     "distance",
-			accessor: func(c ent.UserConfig) interface{} { return c.Distance },
+			// switching to cpu inserts before the graph has been built would
+			// leave the deferred vectors out of the graph
+			name:     "indexBuild",
+			accessor: func(c ent.UserConfig) interface{} { return c.IndexBuild },
 		},
 	}
 
@@ -59,6 +61,14 @@ func ValidateUserConfigUpdate(initial, updated schema.VectorIndexConfig) error {
 		}
 	}
 
+	// existing nodes keep their connections, with a lower maximum they would
+	// exceed it until they are touched again
+	if updatedParsed.MaxConnections < initialParsed.MaxConnections {
+		return errors.Errorf("maxConnections can only be increased: "+
+			"attempted change from \"%v\" to \"%v\"",
+			initialParsed.MaxConnections, updatedParsed.MaxConnections)
+	}
+
 	return nil
 }
 
@@ -94,15 +104,34 @@ func (h *hnsw) UpdateUserConfig(updated schema.VectorIndexConfig, callback func(
 	atomic.StoreInt64(&h.efMax, int64(parsed.DynamicEFMax))
 	atomic.StoreInt64(&h.efFactor, int64(parsed.DynamicEFFactor))
 	atomic.StoreInt64(&h.flatSearchCutoff, int64(parsed.FlatSearchCutoff))
+	h.acornFilterRatio.Store(math.Float64bits(parsed.AcornFilterRatio))
+	h.adaptiveEF.configure(parsed.AdaptiveEF, parsed.DynamicEFMin, parsed.DynamicEFMax)
+	h.accessLog.enabled.Store(parsed.VectorCachePrefill == ent.VectorCachePrefillRecentlyAccessed)
+	atomic.StoreInt64(&h.efConstruction, int64(parsed.EFConstruction))
+	atomic.StoreInt64(&h.maximumConnections, int64(parsed.MaxConnections))
+	atomic.StoreInt64(&h.maximumConnectionsLayerZero, int64(2*parsed.MaxConnections))
+	atomic.StoreInt64(&h.cleanupConcurrency, int64(parsed.CleanupConcurrency))
+	atomic.StoreInt64(&h.sqRescoreLimit, int64(parsed.SQ.RescoreLimit))
+	h.cache.updateMaxBytes(int64(parsed.VectorCacheMaxBytes))
+	if h.compressedVectorsCache != (*compressedShardedLockCache)(nil) {
+		h.compressedVectorsCache.updateMaxBytes(int64(parsed.VectorCacheMaxBytes))
+	}
 
-	if !parsed.PQ.Enabled {
+	if !parsed.PQ.Enabled && !parsed.SQ.Enabled {
+		if parsed.PQ.AutoTrain && h.compressedVectorsCache == (*compressedShardedLockCache)(nil) {
+			h.compressedVectorsCache = newCompressedShardedLockCache(h.getCompressedVectorForID, parsed.VectorCacheMaxObjects, h.logger)
+			h.compressedVectorsCache.updateMaxBytes(int64(parsed.VectorCacheMaxBytes))
+		}
+		h.configurePQAutoTrain(parsed.PQ)
 		callback()
 		return nil
 	}
+	h.configurePQAutoTrain(parsed.PQ)
 
 	// compression got enabled in this update
 	if h.compressedVectorsCache == (*compressedShardedLockCache)(nil) {
 		h.compressedVectorsCache = newCompressedShardedLockCache(h.getCompressedVectorForID, parsed.VectorCacheMaxObjects, h.logger)
+		h.compressedVectorsCache.updateMaxBytes(int64(parsed.VectorCacheMaxBytes))
 	} else {
 		if h.compressed.Load() {
 			h.compressedVectorsCache.updateMaxSize(int64(parsed.VectorCacheMaxObjects))
@@ -126,7 +155,12 @@ func (h *hnsw) UpdateUserConfig(updated schema.VectorIndexConfig, callback func(
 func (h *hnsw) turnOnCompression(cfg ent.UserConfig, callback func()) error {
 	h.logger.WithField("action", "compress").Info("switching to compressed vectors")
 
-	err := ent.ValidatePQConfig(cfg.PQ)
+	var err error
+	if cfg.SQ.Enabled {
+		err = ent.ValidateSQConfig(cfg.SQ)
+	} else {
+		err = ent.ValidatePQConfig(cfg.PQ)
+	}
 	if err != nil {
 		callback()
 		return err
@@ -140,7 +174,13 @@ func (h *hnsw) turnOnCompression(cfg ent.UserConfig, callback func()) error {
 func (h *hnsw) compressThenCallback(cfg ent.UserConfig, callback func()) {
 	defer callback()
 
-	if err := h.Compress(cfg.PQ); err != nil {
+	var err error
+	if cfg.SQ.Enabled {
+		err = h.CompressSQ(cfg.SQ)
+	} else {
+		err = h.Compress(cfg.PQ)
+	}
+	if err != nil {
 		h.logger.Error(err)
 		return
 	}
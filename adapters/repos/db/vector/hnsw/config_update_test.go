@@ -12,11 +12,15 @@
 package hnsw
 
 import (
+	"context"
 	"testing"
 
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
 	"github.com/weaviate/weaviate/entities/schema"
 	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 )
@@ -32,28 +36,36 @@ func TestUserConfigUpdates(t *testing.T) {
 
 		tests := []test{
 			{
-				name:    "attempting to change ef construction",
-				initial: ent.UserConfig{EFConstruction: 64},
-				update:  ent.UserConfig{EFConstruction: 128},
-				expectedError: errors.Errorf(
-					"efConstruction is immutable: " +
-						"attempted change from \"64\" to \"128\""),
+				name:          "changing ef construction",
+				initial:       ent.UserConfig{EFConstruction: 64},
+				update:        ent.UserConfig{EFConstruction: 128},
+				expectedError: nil,
 			},
 			{
-				name:    "attempting to change ef construction",
-				initial: ent.UserConfig{MaxConnections: 10},
-				update:  ent.UserConfig{MaxConnections: 15},
-				expectedError: errors.Errorf(
-					"maxConnections is immutable: " +
-						"attempted change from \"10\" to \"15\""),
+				name:          "changing max connections",
+				initial:       ent.UserConfig{MaxConnections: 10},
+				update:        ent.UserConfig{MaxConnections: 15},
+				expectedError: nil,
 			},
 			{
-				name:    "attempting to change cleanup interval seconds",
-				initial: ent.UserConfig{CleanupIntervalSeconds: 60},
-				update:  ent.UserConfig{CleanupIntervalSeconds: 90},
+				name:    "attempting to decrease max connections",
+				initial: ent.UserConfig{MaxConnections: 15},
+				update:  ent.UserConfig{MaxConnections: 10},
 				expectedError: errors.Errorf(
-					"cleanupIntervalSeconds is immutable: " +
-						"attempted change from \"60\" to \"90\""),
+					"maxConnections can only be increased: " +
+						"attempted change from \"15\" to \"10\""),
+			},
+			{
+				name:          "changing cleanup interval seconds",
+				initial:       ent.UserConfig{CleanupIntervalSeconds: 60},
+				update:        ent.UserConfig{CleanupIntervalSeconds: 90},
+				expectedError: nil,
+			},
+			{
+				name:          "changing cleanup concurrency",
+				initial:       ent.UserConfig{CleanupConcurrency: 1},
+				update:        ent.UserConfig{CleanupConcurrency: 4},
+				expectedError: nil,
 			},
 			{
 				name:    "attempting to change distance",
@@ -63,6 +75,22 @@ func TestUserConfigUpdates(t *testing.T) {
 					"distance is immutable: " +
 						"attempted change from \"cosine\" to \"l2-squared\""),
 			},
+			{
+				name:    "attempting to change vector cache dtype",
+				initial: ent.UserConfig{VectorCacheDType: "float32"},
+				update:  ent.UserConfig{VectorCacheDType: "float16"},
+				expectedError: errors.Errorf(
+					"vectorCacheDType is immutable: " +
+						"attempted change from \"float32\" to \"float16\""),
+			},
+			{
+				name:    "attempting to change index build",
+				initial: ent.UserConfig{IndexBuild: "gpu"},
+				update:  ent.UserConfig{IndexBuild: "cpu"},
+				expectedError: errors.Errorf(
+					"indexBuild is immutable: " +
+						"attempted change from \"gpu\" to \"cpu\""),
+			},
 			{
 				name:          "changing ef",
 				initial:       ent.UserConfig{EF: 100},
@@ -109,4 +137,62 @@ func TestUserConfigUpdates(t *testing.T) {
 			})
 		}
 	})
+	t.Run("applying tuning parameters to a live index", func(t *testing.T) {
+		vectors, queries := testinghelpers.RandomVecs(200, 1, 16)
+		uc := ent.UserConfig{
+			MaxConnections:        8,
+			EFConstruction:        32,
+			EF:                    32,
+			VectorCacheMaxObjects: 100000,
+		}
+		index, err := New(Config{
+			RootPath:              "doesnt-matter-as-committlogger-is-mocked-out",
+			ID:                    "config-update-test",
+			MakeCommitLoggerThunk: MakeNoopCommitLogger,
+			DistanceProvider:      distancer.NewL2SquaredProvider(),
+			VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+				return vectors[int(id)], nil
+			},
+			TempVectorForIDThunk: TempVectorForIDThunk(vectors),
+		}, uc, cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+			cyclemanager.NewCallbackGroupNoop())
+		require.Nil(t, err)
+
+		for i := 0; i < 100; i++ {
+			require.Nil(t, index.Add(uint64(i), vectors[i]))
+		}
+
+		uc.MaxConnections = 16
+		uc.EFConstruction = 64
+		uc.EF = 64
+		uc.FlatSearchCutoff = 10
+		require.Nil(t, index.UpdateUserConfig(uc, func() {}))
+
+		assert.Equal(t, int64(16), index.maximumConnections)
+		assert.Equal(t, int64(32), index.maximumConnectionsLayerZero)
+		assert.Equal(t, int64(64), index.efConstruction)
+		assert.Equal(t, int64(64), index.ef)
+		assert.Equal(t, int64(10), index.flatSearchCutoff)
+
+		for i := 100; i < len(vectors); i++ {
+			require.Nil(t, index.Add(uint64(i), vectors[i]))
+		}
+
+		for _, node := range index.nodes[100:len(vectors)] {
+			for level, conns := range node.connections {
+				max := 16
+				if level == 0 {
+					max = 32
+				}
+				assert.LessOrEqual(t, len(conns), max)
+			}
+		}
+
+		report := index.CheckIntegrity()
+		assert.True(t, report.Healthy())
+
+		ids, _, err := index.SearchByVector(queries[0], 10, nil)
+		require.Nil(t, err)
+		assert.Len(t, ids, 10)
+	})
 }
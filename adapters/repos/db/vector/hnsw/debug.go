@@ -15,6 +15,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"sync/atomic"
 
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
 	"github.com/weaviate/weaviate/entities/cyclemanager"
@@ -199,9 +200,9 @@ func (h *hnsw) ValidateLinkIntegrity() {
 		}
 
 		for level, conns := range node.connections {
-			m := h.maximumConnections
+			m := int(atomic.LoadInt64(&h.maximumConnections))
 			if level == 0 {
-				m = h.maximumConnectionsLayerZero
+				m = int(atomic.LoadInt64(&h.maximumConnectionsLayerZero))
 			}
 
 			if len(conns) > m {
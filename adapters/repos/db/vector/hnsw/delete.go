@@ -16,6 +16,7 @@ import (
 	"fmt"
 	"runtime/debug"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/pkg/errors"
@@ -29,6 +30,11 @@ type breakCleanUpTombstonedNodesFunc func() bool
 // Delete attaches a tombstone to an item so it can be periodically cleaned up
 // later and the edges reassigned
 func (h *hnsw) Delete(ids ...uint64) error {
+	if h.deferInserts() {
+		// none of the ids can be part of the graph yet
+		return nil
+	}
+
 	h.compressActionLock.RLock()
 	defer h.compressActionLock.RUnlock()
 
@@ -190,15 +196,17 @@ func (h *hnsw) cleanUpTombstonedNodes(shouldAbort cyclemanager.ShouldAbortCallba
 		}
 	}()
 
-	h.metrics.StartCleanup(1)
-	defer h.metrics.EndCleanup(1)
+	threads := int(atomic.LoadInt64(&h.cleanupConcurrency))
+	h.metrics.StartCleanup(threads)
+	defer h.metrics.EndCleanup(threads)
+	started := time.Now()
 
 	h.resetLock.Lock()
 	resetCtx := h.resetCtx
 	h.resetLock.Unlock()
 
 	breakCleanUpTombstonedNodes := func() bool {
-		return resetCtx.Err() != nil || shouldAbort()
+		return resetCtx.Err() != nil || h.cleanupPaused.Load() || shouldAbort()
 	}
 
 	executed := false
@@ -225,6 +233,7 @@ func (h *hnsw) cleanUpTombstonedNodes(shouldAbort cyclemanager.ShouldAbortCallba
 	} else if !ok {
 		return executed, nil
 	}
+	h.cleanupStats.cycleCompleted(deleteList.Len(), time.Since(started), h.metrics)
 
 	if _, err := h.resetIfEmpty(); err != nil {
 		return executed, err
@@ -267,20 +276,65 @@ func (h *hnsw) reassignNeighborsOf(deleteList helpers.AllowList, breakCleanUpTom
 	size := len(h.nodes)
 	h.RUnlock()
 
-	for n := 0; n < size; n++ {
-		if ok, err := h.reassignNeighbor(uint64(n), deleteList, breakCleanUpTombstonedNodes); err != nil {
-			return false, errors.Wrap(err, "reassign neighbor edges")
-		} else if !ok {
-			return false, nil
+	workers := int(atomic.LoadInt64(&h.cleanupConcurrency))
+	if workers < 1 {
+		workers = 1
+	}
+	if workers > size {
+		workers = size
+	}
+
+	// the neighbors are independent of each other, so they can be reassigned
+	// concurrently just like parallel inserts. The first worker to fail or to
+	// be aborted stops all others.
+	var next int64 = -1
+	var stopped atomic.Bool
+	errs := make([]error, workers)
+	wg := &sync.WaitGroup{}
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			defer func() {
+				if r := recover(); r != nil {
+					errs[w] = fmt.Errorf("reassign neighbor edges: %v", r)
+					stopped.Store(true)
+				}
+			}()
+
+			for !stopped.Load() {
+				n := atomic.AddInt64(&next, 1)
+				if n >= int64(size) {
+					return
+				}
+
+				ok, err := h.reassignNeighbor(uint64(n), deleteList, breakCleanUpTombstonedNodes)
+				if err != nil {
+					errs[w] = errors.Wrap(err, "reassign neighbor edges")
+				}
+				if err != nil || !ok {
+					stopped.Store(true)
+					return
+				}
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return false, err
 		}
 	}
 
-	return true, nil
+	return !stopped.Load(), nil
 }
 
 func (h *hnsw) reassignNeighbor(neighbor uint64, deleteList helpers.AllowList, breakCleanUpTombstonedNodes breakCleanUpTombstonedNodesFunc) (ok bool, err error) {
-	h.resetLock.Lock()
-	defer h.resetLock.Unlock()
+	// neighbors are reassigned concurrently, a reset needs to wait for all of
+	// them
+	h.resetLock.RLock()
+	defer h.resetLock.RUnlock()
 
 	if breakCleanUpTombstonedNodes() {
 		return false, nil
@@ -296,17 +350,7 @@ func (h *hnsw) reassignNeighbor(neighbor uint64, deleteList helpers.AllowList, b
 		return true, nil
 	}
 
-	var neighborVec []float32
-	if h.compressed.Load() {
-		var vec []byte
-		vec, err = h.compressedVectorsCache.get(context.Background(), neighbor)
-		if err == nil {
-			neighborVec = h.pq.Decode(vec)
-		}
-	} else {
-		neighborVec, err = h.cache.get(context.Background(), neighbor)
-	}
-
+	neighborVec, err := h.nodeVector(neighbor)
 	if err != nil {
 		var e storobj.ErrNotFound
 		if errors.As(err, &e) {
@@ -386,6 +430,20 @@ func (h *hnsw) reassignNeighbor(neighbor uint64, deleteList helpers.AllowList, b
 	return true, nil
 }
 
+// nodeVector returns the vector of the node, decoded if the index is
+// compressed
+func (h *hnsw) nodeVector(id uint64) ([]float32, error) {
+	if h.compressed.Load() {
+		vec, err := h.compressedVectorsCache.get(context.Background(), id)
+		if err != nil {
+			return nil, err
+		}
+		return h.quantizer.Decode(vec), nil
+	}
+
+	return h.cache.get(context.Background(), id)
+}
+
 func connectionsPointTo(connections [][]uint64, needles helpers.AllowList) bool {
 	for _, atLevel := range connections {
 		for _, pointer := range atLevel {
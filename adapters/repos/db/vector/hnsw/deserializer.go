@@ -35,7 +35,11 @@ type DeserializationResult struct {
 	Tombstones        map[uint64]struct{}
 	EntrypointChanged bool
 	PQData            ssdhelpers.PQData
+	SQData            ssdhelpers.SQData
 	Compressed        bool
+	// SQCompressed is set together with Compressed if the vectors are
+	// compressed with the scalar quantizer rather than the product quantizer
+	SQCompressed bool
 
 	// If there is no entry for the links at a level to be replaced, we must
 	// assume that all links were appended and prior state must exist
@@ -143,6 +147,9 @@ func (d *Deserializer) Do(fd *bufio.Reader,
 		case AddPQ:
 			err = d.ReadPQ(fd, out)
 			readThisRound = 9
+		case AddSQ:
+			err = d.ReadSQ(fd, out)
+			readThisRound = 10
 		default:
 			err = errors.Errorf("unrecognized commit type %d", ct)
 		}
@@ -574,6 +581,30 @@ func (d *Deserializer) ReadPQ(r io.Reader, res *DeserializationResult) error {
 	return nil
 }
 
+func (d *Deserializer) ReadSQ(r io.Reader, res *DeserializationResult) error {
+	a, err := d.readFloat32(r)
+	if err != nil {
+		return err
+	}
+	b, err := d.readFloat32(r)
+	if err != nil {
+		return err
+	}
+	dims, err := d.readUint16(r)
+	if err != nil {
+		return err
+	}
+	res.SQData = ssdhelpers.SQData{
+		A:          a,
+		B:          b,
+		Dimensions: dims,
+	}
+	res.Compressed = true
+	res.SQCompressed = true
+
+	return nil
+}
+
 func (d *Deserializer) readUint64(r io.Reader) (uint64, error) {
 	var value uint64
 	d.resetResusableBuffer(8)
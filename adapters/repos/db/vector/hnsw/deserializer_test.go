@@ -110,6 +110,7 @@ func TestDeserializer2ReadCommitType(t *testing.T) {
 		DeleteNode,
 		ResetIndex,
 		AddPQ,
+		AddSQ,
 	}
 	for _, commitType := range commitTypes {
 		b := make([]byte, 1)
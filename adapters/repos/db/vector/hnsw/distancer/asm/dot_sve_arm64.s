@@ -0,0 +1,74 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build !noasm && arm64
+
+// dot_sve calculates the dot product of a and b for any length. It uses four
+// accumulators while whole vectors fit and predicated loads for the
+// remaining elements. The Go assembler has no SVE mnemonics, so the
+// instructions are encoded as WORDs, each annotated with its assembly.
+
+TEXT ·dot_sve(SB), $0-32
+	MOVD a+0(FP), R0
+	MOVD b+8(FP), R1
+	MOVD res+16(FP), R2
+	MOVD len+24(FP), R3
+	WORD $0xf9400068 // ldr x8, [x3]
+	WORD $0xaa1f03e9 // mov x9, xzr
+	WORD $0x04a3e3ea // cntw x10, all, mul #4
+	WORD $0x25b8c000 // mov z0.s, #0
+	WORD $0x25b8c001 // mov z1.s, #0
+	WORD $0x25b8c002 // mov z2.s, #0
+	WORD $0x25b8c003 // mov z3.s, #0
+	WORD $0x2598e3e1 // ptrue p1.s
+
+LBB0_1:
+	WORD $0x8b0a012b // add x11, x9, x10
+	WORD $0xeb08017f // cmp x11, x8
+	WORD $0x54000228 // b.hi .LBB0_3
+	WORD $0xa540a404 // ld1w { z4.s }, p1/z, [x0]
+	WORD $0xa541a405 // ld1w { z5.s }, p1/z, [x0, #1, mul vl]
+	WORD $0xa542a406 // ld1w { z6.s }, p1/z, [x0, #2, mul vl]
+	WORD $0xa543a407 // ld1w { z7.s }, p1/z, [x0, #3, mul vl]
+	WORD $0xa540a430 // ld1w { z16.s }, p1/z, [x1]
+	WORD $0xa541a431 // ld1w { z17.s }, p1/z, [x1, #1, mul vl]
+	WORD $0xa542a432 // ld1w { z18.s }, p1/z, [x1, #2, mul vl]
+	WORD $0xa543a433 // ld1w { z19.s }, p1/z, [x1, #3, mul vl]
+	WORD $0x65b00480 // fmla z0.s, p1/m, z4.s, z16.s
+	WORD $0x65b104a1 // fmla z1.s, p1/m, z5.s, z17.s
+	WORD $0x65b204c2 // fmla z2.s, p1/m, z6.s, z18.s
+	WORD $0x65b304e3 // fmla z3.s, p1/m, z7.s, z19.s
+	WORD $0x04205080 // addvl x0, x0, #4
+	WORD $0x04215081 // addvl x1, x1, #4
+	WORD $0xaa0b03e9 // mov x9, x11
+	WORD $0x17ffffee // b .LBB0_1
+
+LBB0_3:
+	WORD $0xcb090108 // sub x8, x8, x9
+	WORD $0xaa1f03e9 // mov x9, xzr
+	WORD $0x25a81d20 // whilelo p0.s, x9, x8
+	WORD $0x540000e0 // b.none .LBB0_5
+
+LBB0_4:
+	WORD $0xa5494004 // ld1w { z4.s }, p0/z, [x0, x9, lsl #2]
+	WORD $0xa5494030 // ld1w { z16.s }, p0/z, [x1, x9, lsl #2]
+	WORD $0x65b00080 // fmla z0.s, p0/m, z4.s, z16.s
+	WORD $0x04b0e3e9 // incw x9
+	WORD $0x25a81d20 // whilelo p0.s, x9, x8
+	WORD $0x54ffff64 // b.first .LBB0_4
+
+LBB0_5:
+	WORD $0x65810000 // fadd z0.s, z0.s, z1.s
+	WORD $0x65830042 // fadd z2.s, z2.s, z3.s
+	WORD $0x65820000 // fadd z0.s, z0.s, z2.s
+	WORD $0x65802400 // faddv s0, p1, z0.s
+	WORD $0xbd000040 // str s0, [x2]
+	WORD $0xd65f03c0 // ret
@@ -0,0 +1,127 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	. "github.com/mmcloughlin/avo/build"
+	. "github.com/mmcloughlin/avo/operand"
+	. "github.com/mmcloughlin/avo/reg"
+)
+
+var unroll = 4
+
+// The same approach as the AVX2 kernel in l2.go, on 512 bit registers. The
+// remaining elements are not handled one by one, but loaded with a mask.
+func main() {
+	TEXT("L2AVX512", NOSPLIT, "func(x []float32, y []float32) float32")
+	x := Mem{Base: Load(Param("x").Base(), GP64())}
+	y := Mem{Base: Load(Param("y").Base(), GP64())}
+	n := Load(Param("x").Len(), GP64())
+
+	acc := make([]VecVirtual, unroll)
+	for i := 0; i < unroll; i++ {
+		acc[i] = ZMM()
+	}
+
+	for i := 0; i < unroll; i++ {
+		VXORPS(acc[i], acc[i], acc[i])
+	}
+
+	blockitems := 16 * unroll
+	blocksize := 4 * blockitems
+	Label("blockloop")
+	CMPQ(n, U32(blockitems))
+	JL(LabelRef("vectorloop"))
+
+	xs := make([]VecVirtual, unroll)
+	for i := 0; i < unroll; i++ {
+		xs[i] = ZMM()
+	}
+
+	for i := 0; i < unroll; i++ {
+		VMOVUPS(x.Offset(64*i), xs[i])
+	}
+
+	for i := 0; i < unroll; i++ {
+		VSUBPS(y.Offset(64*i), xs[i], xs[i])
+	}
+
+	for i := 0; i < unroll; i++ {
+		VFMADD231PS(xs[i], xs[i], acc[i])
+	}
+
+	ADDQ(U32(blocksize), x.Base)
+	ADDQ(U32(blocksize), y.Base)
+	SUBQ(U32(blockitems), n)
+	JMP(LabelRef("blockloop"))
+
+	// Process single registers until fewer than 16 elements are left.
+	Label("vectorloop")
+	CMPQ(n, U32(16))
+	JL(LabelRef("tail"))
+
+	xv := ZMM()
+	VMOVUPS(x, xv)
+	VSUBPS(y, xv, xv)
+	VFMADD231PS(xv, xv, acc[0])
+
+	ADDQ(U32(64), x.Base)
+	ADDQ(U32(64), y.Base)
+	SUBQ(U32(16), n)
+	JMP(LabelRef("vectorloop"))
+
+	// Load the remaining elements with the mask (1<<n)-1, masked out lanes
+	// are zeroed and do not contribute to the sum.
+	Label("tail")
+	bits := GP32()
+	MOVL(U32(1), bits)
+	SHLXL(n.As32(), bits, bits)
+	DECL(bits)
+	mask := K()
+	KMOVW(bits, mask)
+
+	xt := ZMM()
+	yt := ZMM()
+	VMOVUPS_Z(x, mask, xt)
+	VMOVUPS_Z(y, mask, yt)
+	VSUBPS(yt, xt, xt)
+	VFMADD231PS(xt, xt, acc[1])
+
+	// Reduce the lanes to one.
+	if unroll != 4 {
+		panic("addition is hard-coded")
+	}
+
+	VADDPS(acc[0], acc[1], acc[0])
+	VADDPS(acc[2], acc[3], acc[2])
+	VADDPS(acc[0], acc[2], acc[0])
+
+	half := YMM()
+	VEXTRACTF64X4(U8(1), acc[0], half)
+	VADDPS(acc[0].AsY(), half, half)
+
+	result := half.AsX()
+	quarter := XMM()
+	VEXTRACTF128(U8(1), half, quarter)
+	VADDPS(result, quarter, result)
+	VHADDPS(result, result, result)
+	VHADDPS(result, result, result)
+	VZEROUPPER()
+	Store(result, ReturnIndex(0))
+
+	RET()
+
+	Generate()
+}
@@ -0,0 +1,124 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	. "github.com/mmcloughlin/avo/build"
+	. "github.com/mmcloughlin/avo/operand"
+	. "github.com/mmcloughlin/avo/reg"
+)
+
+var unroll = 4
+
+// Same structure as dot_byte.go, but the words are subtracted before
+// VPMADDWD squares them. The difference of two bytes always fits into a
+// signed word.
+func main() {
+	TEXT("L2Byte", NOSPLIT, "func(x []uint8, y []uint8) uint32")
+	x := Mem{Base: Load(Param("x").Base(), GP64())}
+	y := Mem{Base: Load(Param("y").Base(), GP64())}
+	n := Load(Param("x").Len(), GP64())
+
+	acc := make([]VecVirtual, unroll)
+	xv := make([]VecVirtual, unroll)
+	yv := make([]VecVirtual, unroll)
+	for i := 0; i < unroll; i++ {
+		acc[i] = YMM()
+		xv[i] = YMM()
+		yv[i] = YMM()
+	}
+
+	for i := 0; i < unroll; i++ {
+		VPXOR(acc[i], acc[i], acc[i])
+	}
+
+	blockitems := 16 * unroll
+	Label("blockloop")
+	CMPQ(n, U32(blockitems))
+	JL(LabelRef("tail"))
+
+	for i := 0; i < unroll; i++ {
+		VPMOVZXBW(x.Offset(16*i), xv[i])
+		VPMOVZXBW(y.Offset(16*i), yv[i])
+	}
+
+	for i := 0; i < unroll; i++ {
+		VPSUBW(yv[i], xv[i], xv[i])
+	}
+
+	for i := 0; i < unroll; i++ {
+		VPMADDWD(xv[i], xv[i], xv[i])
+	}
+
+	for i := 0; i < unroll; i++ {
+		VPADDD(xv[i], acc[i], acc[i])
+	}
+
+	ADDQ(U32(blockitems), x.Base)
+	ADDQ(U32(blockitems), y.Base)
+	SUBQ(U32(blockitems), n)
+	JMP(LabelRef("blockloop"))
+
+	// Process any trailing entries.
+	Label("tail")
+	tail := GP32()
+	XORL(tail, tail)
+
+	Label("tailloop")
+	CMPQ(n, U32(0))
+	JE(LabelRef("reduce"))
+
+	xt := GP32()
+	yt := GP32()
+	MOVBLZX(x, xt)
+	MOVBLZX(y, yt)
+	SUBL(yt, xt)
+	IMULL(xt, xt)
+	ADDL(xt, tail)
+
+	INCQ(x.Base)
+	INCQ(y.Base)
+	DECQ(n)
+	JMP(LabelRef("tailloop"))
+
+	// Reduce the lanes to one.
+	Label("reduce")
+	if unroll != 4 {
+		// we have hard-coded the reduction for this specific unrolling as it
+		// allows us to do 0+1 and 2+3 and only then have a addition which
+		// touches both.
+		panic("addition is hard-coded")
+	}
+
+	VPADDD(acc[0], acc[1], acc[0])
+	VPADDD(acc[2], acc[3], acc[2])
+	VPADDD(acc[0], acc[2], acc[0])
+
+	result := acc[0].AsX()
+	top := XMM()
+	VEXTRACTI128(U8(1), acc[0], top)
+	VPADDD(result, top, result)
+	VPHADDD(result, result, result)
+	VPHADDD(result, result, result)
+	sum := GP32()
+	VMOVD(result, sum)
+	ADDL(tail, sum)
+	Store(sum, ReturnIndex(0))
+
+	VZEROUPPER()
+	RET()
+
+	Generate()
+}
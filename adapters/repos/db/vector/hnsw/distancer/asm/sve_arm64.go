@@ -0,0 +1,62 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build !noasm && arm64
+
+package asm
+
+import (
+	"reflect"
+	"unsafe"
+)
+
+//go:noescape
+func dot_sve(a, b, res, len unsafe.Pointer)
+
+//go:noescape
+func l2_sve(a, b, res, len unsafe.Pointer)
+
+// DotSVE calculates the dot product between two vectors using the scalable
+// vector extension. The kernels are vector length agnostic and handle any
+// vector length, so unlike Dot there are no special cases for short vectors.
+func DotSVE(x []float32, y []float32) float32 {
+	var res float32
+
+	hdrx := (*reflect.SliceHeader)(unsafe.Pointer(&x))
+	hdry := (*reflect.SliceHeader)(unsafe.Pointer(&y))
+
+	l := len(x)
+	dot_sve(
+		unsafe.Pointer(hdrx.Data),
+		unsafe.Pointer(hdry.Data),
+		unsafe.Pointer(&res),
+		unsafe.Pointer(&l))
+
+	return res
+}
+
+// L2SVE calculates the squared euclidean distance between two vectors using
+// the scalable vector extension
+func L2SVE(x []float32, y []float32) float32 {
+	var res float32
+
+	hdrx := (*reflect.SliceHeader)(unsafe.Pointer(&x))
+	hdry := (*reflect.SliceHeader)(unsafe.Pointer(&y))
+
+	l := len(x)
+	l2_sve(
+		unsafe.Pointer(hdrx.Data),
+		unsafe.Pointer(hdry.Data),
+		unsafe.Pointer(&res),
+		unsafe.Pointer(&l))
+
+	return res
+}
@@ -0,0 +1,53 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package distancer
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer/asm"
+)
+
+func Test_AVX512_DistanceImplementation(t *testing.T) {
+	if !hasAVX512 {
+		t.Skip("AVX-512 is not supported")
+	}
+
+	lengths := []int{0, 1, 4, 15, 16, 17, 31, 32, 35, 63, 64, 67, 128, 130, 256, 260, 384, 390, 768, 777, 1536}
+	r := getRandomSeed()
+
+	for _, length := range lengths {
+		t.Run(fmt.Sprintf("with vector l=%d", length), func(t *testing.T) {
+			x := make([]float32, length)
+			y := make([]float32, length)
+			for i := range x {
+				x[i] = r.Float32() - 0.5
+				y[i] = r.Float32()
+			}
+
+			assert.InDelta(t, -DotProductGo(x, y), asm.DotAVX512(x, y), 0.001)
+			assert.InDelta(t, L2PureGo(x, y), asm.L2AVX512(x, y), 0.001)
+		})
+	}
+
+	t.Run("does not read past the end of the vectors", func(t *testing.T) {
+		// the masked loads of the remainder must ignore the elements behind
+		// the slice, even if they are part of the same backing array
+		x := []float32{1, 2, 3, 100, 100}
+		y := []float32{4, 5, 6, 100, 100}
+
+		assert.Equal(t, float32(32), asm.DotAVX512(x[:3], y[:3]))
+		assert.Equal(t, float32(27), asm.L2AVX512(x[:3], y[:3]))
+	})
+}
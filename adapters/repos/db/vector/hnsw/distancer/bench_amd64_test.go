@@ -50,12 +50,32 @@ func benchmarkDotAVX(b *testing.B, dims int) {
 	}
 }
 
+func benchmarkDotAVX512(b *testing.B, dims int) {
+	if !hasAVX512 {
+		b.Skip("AVX-512 is not supported")
+	}
+	r := getRandomSeed()
+
+	vec1 := make([]float32, dims)
+	vec2 := make([]float32, dims)
+	for i := range vec1 {
+		vec1[i] = r.Float32()
+		vec2[i] = r.Float32()
+	}
+
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		asm.DotAVX512(vec1, vec2)
+	}
+}
+
 func BenchmarkDot(b *testing.B) {
 	dims := []int{30, 32, 128, 256, 300, 384, 600, 768, 1024}
 	for _, dim := range dims {
 		b.Run(fmt.Sprintf("%d dimensions", dim), func(b *testing.B) {
 			b.Run("pure go", func(b *testing.B) { benchmarkDotGo(b, dim) })
 			b.Run("avx", func(b *testing.B) { benchmarkDotAVX(b, dim) })
+			b.Run("avx512", func(b *testing.B) { benchmarkDotAVX512(b, dim) })
 		})
 	}
 }
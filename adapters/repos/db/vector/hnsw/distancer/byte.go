@@ -0,0 +1,49 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package distancer
+
+// Byte vectors hold 8-bit codes, such as the codes of a scalar quantizer.
+// The kernels work on the raw codes and return integer results, it is up to
+// the caller to scale them back into the space of the original vectors.
+
+// can be set depending on architecture, e.g. pure go, AVX-enabled assembly,
+// etc.
+var dotByteImpl func(a, b []uint8) uint32 = func(a, b []uint8) uint32 {
+	var sum uint32
+	for i := range a {
+		sum += uint32(a[i]) * uint32(b[i])
+	}
+
+	return sum
+}
+
+var l2SquaredByteImpl func(a, b []uint8) uint32 = func(a, b []uint8) uint32 {
+	var sum uint32
+	for i := range a {
+		diff := int32(a[i]) - int32(b[i])
+		sum += uint32(diff * diff)
+	}
+
+	return sum
+}
+
+// DotByte returns the dot product of two byte vectors of the same length.
+// The result does not overflow for vectors with up to 66051 dimensions.
+func DotByte(a, b []uint8) uint32 {
+	return dotByteImpl(a, b)
+}
+
+// L2SquaredByte returns the squared euclidean distance of two byte vectors
+// of the same length
+func L2SquaredByte(a, b []uint8) uint32 {
+	return l2SquaredByteImpl(a, b)
+}
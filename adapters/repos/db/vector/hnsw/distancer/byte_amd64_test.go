@@ -0,0 +1,99 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package distancer
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer/asm"
+)
+
+func DotBytePureGo(a, b []uint8) uint32 {
+	var sum uint32
+	for i := range a {
+		sum += uint32(a[i]) * uint32(b[i])
+	}
+
+	return sum
+}
+
+func L2BytePureGo(a, b []uint8) uint32 {
+	var sum uint32
+	for i := range a {
+		diff := int32(a[i]) - int32(b[i])
+		sum += uint32(diff * diff)
+	}
+
+	return sum
+}
+
+func Test_Byte_DistanceImplementation(t *testing.T) {
+	lengths := []int{1, 4, 16, 31, 32, 35, 64, 67, 128, 130, 256, 260, 384, 390, 768, 777, 4096}
+	r := getRandomSeed()
+
+	for _, length := range lengths {
+		t.Run(fmt.Sprintf("with vector l=%d", length), func(t *testing.T) {
+			x := make([]uint8, length)
+			y := make([]uint8, length)
+			for i := range x {
+				x[i] = uint8(r.Intn(256))
+				y[i] = uint8(r.Intn(256))
+			}
+
+			assert.Equal(t, DotBytePureGo(x, y), asm.DotByte(x, y))
+			assert.Equal(t, L2BytePureGo(x, y), asm.L2Byte(x, y))
+		})
+	}
+
+	t.Run("with extreme values", func(t *testing.T) {
+		x := make([]uint8, 1000)
+		y := make([]uint8, 1000)
+		for i := range x {
+			x[i] = 255
+		}
+
+		assert.Equal(t, uint32(0), asm.DotByte(x, y))
+		assert.Equal(t, uint32(1000*255*255), asm.L2Byte(x, y))
+		assert.Equal(t, uint32(1000*255*255), asm.DotByte(x, x))
+	})
+}
+
+func Benchmark_Byte_PureGo_VS_AVX(b *testing.B) {
+	r := getRandomSeed()
+	lengths := []int{30, 32, 128, 256, 300, 384, 600, 768, 1024}
+	for _, length := range lengths {
+		b.Run(fmt.Sprintf("vector dim=%d", length), func(b *testing.B) {
+			x := make([]uint8, length)
+			y := make([]uint8, length)
+			for i := range x {
+				x[i] = uint8(r.Intn(256))
+				y[i] = uint8(r.Intn(256))
+			}
+
+			b.ResetTimer()
+
+			b.Run("pure go", func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					DotBytePureGo(x, y)
+				}
+			})
+
+			b.Run("avx", func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					asm.DotByte(x, y)
+				}
+			})
+		})
+	}
+}
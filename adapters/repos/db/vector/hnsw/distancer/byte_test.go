@@ -0,0 +1,26 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package distancer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestByteDistances(t *testing.T) {
+	a := []uint8{0, 1, 2, 255, 128}
+	b := []uint8{255, 1, 0, 255, 64}
+
+	assert.Equal(t, uint32(0+1+0+255*255+128*64), DotByte(a, b))
+	assert.Equal(t, uint32(255*255+0+4+0+64*64), L2SquaredByte(a, b))
+}
@@ -17,7 +17,19 @@ import (
 )
 
 func init() {
-	if cpu.X86.HasAVX2 {
+	switch {
+	case hasAVX512:
+		dotProductImplementation = dotAVX512
+	case cpu.X86.HasAVX2:
 		dotProductImplementation = asm.Dot
 	}
 }
+
+// dotAVX512 uses the AVX2 kernel for vectors which do not fill a single 512
+// bit register, the wider registers only pay off for longer vectors
+func dotAVX512(a, b []float32) float32 {
+	if len(a) < 16 {
+		return asm.Dot(a, b)
+	}
+	return asm.DotAVX512(a, b)
+}
@@ -17,7 +17,10 @@ import (
 )
 
 func init() {
-	if cpu.ARM64.HasASIMD {
+	switch {
+	case cpu.ARM64.HasSVE:
+		dotProductImplementation = asm.DotSVE
+	case cpu.ARM64.HasASIMD:
 		dotProductImplementation = asm.Dot
 	}
 }
@@ -0,0 +1,171 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package distancer
+
+import (
+	"math"
+
+	"github.com/pkg/errors"
+)
+
+// Float16 vectors are stored as IEEE 754 half-precision values in their raw
+// uint16 representation. The query vector always stays in float32, so each
+// distance calculation converts the stored half of the comparison on the fly.
+
+// can be set depending on architecture, e.g. pure go, AVX-enabled assembly,
+// etc. Just like dotProductImplementation this is the pure product, not the
+// dot product distance.
+var dotProductFloat16Implementation func(a []float32, b []uint16) float32 = func(a []float32, b []uint16) float32 {
+	var sum float32
+	for i := range a {
+		sum += a[i] * Float16ToFloat32(b[i])
+	}
+
+	return sum
+}
+
+var l2SquaredFloat16Impl func(a []float32, b []uint16) float32 = func(a []float32, b []uint16) float32 {
+	var sum float32
+	for i := range a {
+		diff := a[i] - Float16ToFloat32(b[i])
+		sum += diff * diff
+	}
+
+	return sum
+}
+
+// Float16Distancer is implemented by distancers which can calculate the
+// distance to a float16 vector without converting it to float32 first
+type Float16Distancer interface {
+	DistanceToFloat16(vec []uint16) (float32, bool, error)
+}
+
+func (d *CosineDistance) DistanceToFloat16(b []uint16) (float32, bool, error) {
+	if len(d.a) != len(b) {
+		return 0, false, errors.Errorf("vector lengths don't match: %d vs %d",
+			len(d.a), len(b))
+	}
+
+	return 1 - dotProductFloat16Implementation(d.a, b), true, nil
+}
+
+func (d *DotProduct) DistanceToFloat16(b []uint16) (float32, bool, error) {
+	if len(d.a) != len(b) {
+		return 0, false, errors.Errorf("vector lengths don't match: %d vs %d",
+			len(d.a), len(b))
+	}
+
+	return -dotProductFloat16Implementation(d.a, b), true, nil
+}
+
+func (l L2Squared) DistanceToFloat16(b []uint16) (float32, bool, error) {
+	if len(l.a) != len(b) {
+		return 0, false, errors.Errorf("vector lengths don't match: %d vs %d",
+			len(l.a), len(b))
+	}
+
+	return l2SquaredFloat16Impl(l.a, b), true, nil
+}
+
+// EncodeFloat16 converts a float32 vector into its float16 representation,
+// rounding each value to the nearest half-precision value
+func EncodeFloat16(vec []float32) []uint16 {
+	out := make([]uint16, len(vec))
+	for i, v := range vec {
+		out[i] = Float32ToFloat16(v)
+	}
+	return out
+}
+
+// DecodeFloat16 converts a float16 vector back into float32
+func DecodeFloat16(vec []uint16) []float32 {
+	out := make([]float32, len(vec))
+	for i, v := range vec {
+		out[i] = Float16ToFloat32(v)
+	}
+	return out
+}
+
+// Float32ToFloat16 rounds f to the nearest half-precision value (ties to
+// even). Values outside of the float16 range become infinity, values too
+// small to be represented become zero.
+func Float32ToFloat16(f float32) uint16 {
+	bits := math.Float32bits(f)
+	sign := uint16(bits>>16) & 0x8000
+	exp := int32(bits>>23) & 0xff
+	mant := bits & 0x7fffff
+
+	if exp == 0xff {
+		if mant != 0 {
+			// NaN, keep it quiet
+			return sign | 0x7e00
+		}
+		return sign | 0x7c00
+	}
+
+	exp = exp - 127 + 15
+	if exp >= 0x1f {
+		return sign | 0x7c00
+	}
+
+	if exp <= 0 {
+		// subnormal half or underflow to zero
+		if exp < -10 {
+			return sign
+		}
+		mant |= 0x800000
+		shift := uint32(14 - exp)
+		half := mant >> shift
+		rem := mant & (1<<shift - 1)
+		halfway := uint32(1) << (shift - 1)
+		if rem > halfway || (rem == halfway && half&1 == 1) {
+			half++
+		}
+		return sign | uint16(half)
+	}
+
+	// rounding up may carry into the exponent, which correctly produces the
+	// next power of two or infinity
+	half := uint32(exp)<<10 | mant>>13
+	rem := mant & 0x1fff
+	if rem > 0x1000 || (rem == 0x1000 && half&1 == 1) {
+		half++
+	}
+	return sign | uint16(half)
+}
+
+// Float16ToFloat32 converts a half-precision value to float32, this
+// conversion is lossless
+func Float16ToFloat32(h uint16) float32 {
+	sign := uint32(h&0x8000) << 16
+	exp := uint32(h>>10) & 0x1f
+	mant := uint32(h & 0x3ff)
+
+	switch exp {
+	case 0:
+		if mant == 0 {
+			return math.Float32frombits(sign)
+		}
+		// subnormal half, normalize it for float32
+		e := uint32(127 - 15 + 1)
+		for mant&0x400 == 0 {
+			mant <<= 1
+			e--
+		}
+		mant &= 0x3ff
+		return math.Float32frombits(sign | e<<23 | mant<<13)
+	case 0x1f:
+		return math.Float32frombits(sign | 0x7f800000 | mant<<13)
+	default:
+		return math.Float32frombits(sign | (exp+127-15)<<23 | mant<<13)
+	}
+}
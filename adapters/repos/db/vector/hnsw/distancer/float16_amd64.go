@@ -0,0 +1,25 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package distancer
+
+import (
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer/asm"
+	"golang.org/x/sys/cpu"
+)
+
+func init() {
+	// cpu does not expose F16C, but every CPU with AVX2 and FMA supports it
+	if cpu.X86.HasAVX2 && cpu.X86.HasFMA {
+		dotProductFloat16Implementation = asm.DotFloat16
+		l2SquaredFloat16Impl = asm.L2Float16
+	}
+}
@@ -0,0 +1,87 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package distancer
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer/asm"
+)
+
+func DotFloat16PureGo(a []float32, b []uint16) float32 {
+	var sum float32
+	for i := range a {
+		sum += a[i] * Float16ToFloat32(b[i])
+	}
+
+	return sum
+}
+
+func L2Float16PureGo(a []float32, b []uint16) float32 {
+	var sum float32
+	for i := range a {
+		diff := a[i] - Float16ToFloat32(b[i])
+		sum += diff * diff
+	}
+
+	return sum
+}
+
+func Test_Float16_DistanceImplementation(t *testing.T) {
+	lengths := []int{1, 4, 16, 31, 32, 35, 64, 67, 128, 130, 256, 260, 384, 390, 768, 777}
+	r := getRandomSeed()
+
+	for _, length := range lengths {
+		t.Run(fmt.Sprintf("with vector l=%d", length), func(t *testing.T) {
+			x := make([]float32, length)
+			y := make([]uint16, length)
+			for i := range x {
+				x[i] = r.Float32()
+				y[i] = Float32ToFloat16(-r.Float32())
+			}
+
+			assert.InEpsilon(t, DotFloat16PureGo(x, y), asm.DotFloat16(x, y), 0.01)
+			assert.InEpsilon(t, L2Float16PureGo(x, y), asm.L2Float16(x, y), 0.01)
+		})
+	}
+}
+
+func Benchmark_Float16_PureGo_VS_AVX(b *testing.B) {
+	r := getRandomSeed()
+	lengths := []int{30, 32, 128, 256, 300, 384, 600, 768, 1024}
+	for _, length := range lengths {
+		b.Run(fmt.Sprintf("vector dim=%d", length), func(b *testing.B) {
+			x := make([]float32, length)
+			y := make([]uint16, length)
+			for i := range x {
+				x[i] = -r.Float32()
+				y[i] = Float32ToFloat16(r.Float32())
+			}
+
+			b.ResetTimer()
+
+			b.Run("pure go", func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					DotFloat16PureGo(x, y)
+				}
+			})
+
+			b.Run("avx", func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					asm.DotFloat16(x, y)
+				}
+			})
+		})
+	}
+}
@@ -0,0 +1,102 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package distancer
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFloat16Conversion(t *testing.T) {
+	tests := []struct {
+		name     string
+		in       float32
+		expected uint16
+	}{
+		{name: "zero", in: 0, expected: 0x0000},
+		{name: "negative zero", in: float32(math.Copysign(0, -1)), expected: 0x8000},
+		{name: "one", in: 1, expected: 0x3c00},
+		{name: "minus two", in: -2, expected: 0xc000},
+		{name: "one third", in: 1.0 / 3, expected: 0x3555},
+		{name: "largest half", in: 65504, expected: 0x7bff},
+		{name: "overflow", in: 1e6, expected: 0x7c00},
+		{name: "negative overflow", in: -1e6, expected: 0xfc00},
+		{name: "smallest normal", in: float32(math.Ldexp(1, -14)), expected: 0x0400},
+		{name: "smallest subnormal", in: float32(math.Ldexp(1, -24)), expected: 0x0001},
+		{name: "underflow", in: float32(math.Ldexp(1, -26)), expected: 0x0000},
+		{name: "infinity", in: float32(math.Inf(1)), expected: 0x7c00},
+		{name: "tie rounds to even", in: 1 + float32(math.Ldexp(1, -11)), expected: 0x3c00},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, Float32ToFloat16(test.in))
+		})
+	}
+
+	t.Run("nan", func(t *testing.T) {
+		half := Float32ToFloat16(float32(math.NaN()))
+		assert.True(t, math.IsNaN(float64(Float16ToFloat32(half))))
+	})
+
+	t.Run("every half survives a round trip", func(t *testing.T) {
+		for h := 0; h <= math.MaxUint16; h++ {
+			f := Float16ToFloat32(uint16(h))
+			if math.IsNaN(float64(f)) {
+				continue
+			}
+			require.Equal(t, uint16(h), Float32ToFloat16(f), "half %#04x", h)
+		}
+	})
+}
+
+func TestFloat16Distancers(t *testing.T) {
+	lengths := []int{1, 4, 16, 31, 32, 35, 64, 67, 128, 130, 256, 260, 384, 390, 768, 777}
+	r := getRandomSeed()
+
+	for _, length := range lengths {
+		query := make([]float32, length)
+		vec := make([]float32, length)
+		for i := range query {
+			query[i] = r.Float32()*2 - 1
+			vec[i] = r.Float32()*2 - 1
+		}
+		half := EncodeFloat16(vec)
+		// the control compares against the decoded vector, so the only
+		// difference is the order of the additions
+		decoded := DecodeFloat16(half)
+
+		providers := []Provider{
+			NewCosineDistanceProvider(),
+			NewDotProductProvider(),
+			NewL2SquaredProvider(),
+		}
+		for _, provider := range providers {
+			control, _, err := provider.SingleDist(query, decoded)
+			require.Nil(t, err)
+
+			dist, ok, err := provider.New(query).(Float16Distancer).DistanceToFloat16(half)
+			require.Nil(t, err)
+			require.True(t, ok)
+			assert.InDelta(t, control, dist, 1e-3, "%s with l=%d", provider.Type(), length)
+		}
+	}
+
+	t.Run("without matching dimensions", func(t *testing.T) {
+		_, _, err := NewL2SquaredProvider().New([]float32{1, 2, 3}).(Float16Distancer).
+			DistanceToFloat16(EncodeFloat16([]float32{1, 2}))
+		assert.NotNil(t, err)
+	})
+}
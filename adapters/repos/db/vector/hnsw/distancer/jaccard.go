@@ -0,0 +1,86 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package distancer
+
+import (
+	"github.com/pkg/errors"
+)
+
+// jaccardImpl calculates the weighted Jaccard distance 1 - sum(min)/sum(max).
+// For binary vectors this is exactly 1 - |a ∩ b| / |a ∪ b|. Vectors are
+// expected to be non-negative. Two all-zero vectors are considered identical.
+var jaccardImpl func(a, b []float32) float32 = func(a, b []float32) float32 {
+	var intersection, union float32
+
+	for i := range a {
+		if a[i] < b[i] {
+			intersection += a[i]
+			union += b[i]
+		} else {
+			intersection += b[i]
+			union += a[i]
+		}
+	}
+
+	if union == 0 {
+		return 0
+	}
+
+	return 1 - intersection/union
+}
+
+type Jaccard struct {
+	a []float32
+}
+
+func (l Jaccard) Distance(b []float32) (float32, bool, error) {
+	if len(l.a) != len(b) {
+		return 0, false, errors.Errorf("vector lengths don't match: %d vs %d",
+			len(l.a), len(b))
+	}
+
+	return jaccardImpl(l.a, b), true, nil
+}
+
+type JaccardProvider struct{}
+
+func NewJaccardProvider() JaccardProvider {
+	return JaccardProvider{}
+}
+
+func (l JaccardProvider) SingleDist(a, b []float32) (float32, bool, error) {
+	if len(a) != len(b) {
+		return 0, false, errors.Errorf("vector lengths don't match: %d vs %d",
+			len(a), len(b))
+	}
+
+	return jaccardImpl(a, b), true, nil
+}
+
+func (l JaccardProvider) Type() string {
+	return "jaccard"
+}
+
+func (l JaccardProvider) New(a []float32) Distancer {
+	return &Jaccard{a: a}
+}
+
+// Step returns the distance of a single segment. Unlike the other metrics the
+// Jaccard distance is not additive across segments, which is why it cannot be
+// combined with product quantization.
+func (l JaccardProvider) Step(x, y []float32) float32 {
+	return jaccardImpl(x, y)
+}
+
+func (l JaccardProvider) Wrap(x float32) float32 {
+	return x
+}
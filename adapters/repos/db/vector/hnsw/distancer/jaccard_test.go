@@ -0,0 +1,110 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package distancer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJaccardDistancer(t *testing.T) {
+	t.Run("identical vectors", func(t *testing.T) {
+		vec1 := []float32{1, 0, 1, 1}
+		vec2 := []float32{1, 0, 1, 1}
+		expectedDistance := float32(0)
+
+		dist, ok, err := NewJaccardProvider().New(vec1).Distance(vec2)
+		require.Nil(t, err)
+		require.True(t, ok)
+		control, ok, err := NewJaccardProvider().SingleDist(vec1, vec2)
+		require.True(t, ok)
+		require.Nil(t, err)
+		assert.Equal(t, control, dist)
+		assert.Equal(t, expectedDistance, dist)
+	})
+
+	t.Run("disjoint binary vectors", func(t *testing.T) {
+		vec1 := []float32{1, 1, 0, 0}
+		vec2 := []float32{0, 0, 1, 1}
+		expectedDistance := float32(1)
+
+		dist, ok, err := NewJaccardProvider().New(vec1).Distance(vec2)
+		require.Nil(t, err)
+		require.True(t, ok)
+		control, ok, err := NewJaccardProvider().SingleDist(vec1, vec2)
+		require.True(t, ok)
+		require.Nil(t, err)
+		assert.Equal(t, control, dist)
+		assert.Equal(t, expectedDistance, dist)
+	})
+
+	t.Run("overlapping binary vectors", func(t *testing.T) {
+		vec1 := []float32{1, 1, 1, 0, 0}
+		vec2 := []float32{0, 1, 1, 1, 0}
+		expectedDistance := float32(0.5) // 2 shared out of 4 set positions
+
+		dist, ok, err := NewJaccardProvider().New(vec1).Distance(vec2)
+		require.Nil(t, err)
+		require.True(t, ok)
+		control, ok, err := NewJaccardProvider().SingleDist(vec1, vec2)
+		require.True(t, ok)
+		require.Nil(t, err)
+		assert.Equal(t, control, dist)
+		assert.Equal(t, expectedDistance, dist)
+	})
+
+	t.Run("weighted vectors", func(t *testing.T) {
+		vec1 := []float32{2, 1, 0}
+		vec2 := []float32{1, 1, 1}
+		expectedDistance := float32(0.5) // min sum 2, max sum 4
+
+		dist, ok, err := NewJaccardProvider().New(vec1).Distance(vec2)
+		require.Nil(t, err)
+		require.True(t, ok)
+		assert.Equal(t, expectedDistance, dist)
+	})
+
+	t.Run("all-zero vectors", func(t *testing.T) {
+		vec1 := []float32{0, 0, 0}
+		vec2 := []float32{0, 0, 0}
+
+		dist, ok, err := NewJaccardProvider().New(vec1).Distance(vec2)
+		require.Nil(t, err)
+		require.True(t, ok)
+		assert.Equal(t, float32(0), dist)
+	})
+}
+
+func TestJaccardDistancerStepbyStep(t *testing.T) {
+	t.Run("step equals the distance of the full vector", func(t *testing.T) {
+		vec1 := []float32{1, 1, 1, 0, 0}
+		vec2 := []float32{0, 1, 1, 1, 0}
+
+		expectedDistance, ok, err := NewJaccardProvider().New(vec1).Distance(vec2)
+		require.Nil(t, err)
+		require.True(t, ok)
+
+		distanceProvider := NewJaccardProvider()
+		dist := distanceProvider.Wrap(distanceProvider.Step(vec1, vec2))
+		assert.Equal(t, expectedDistance, dist)
+	})
+}
+
+func TestJaccardDistancerLengthMismatch(t *testing.T) {
+	_, _, err := NewJaccardProvider().New([]float32{1, 0}).Distance([]float32{1})
+	assert.NotNil(t, err)
+
+	_, _, err = NewJaccardProvider().SingleDist([]float32{1, 0}, []float32{1})
+	assert.NotNil(t, err)
+}
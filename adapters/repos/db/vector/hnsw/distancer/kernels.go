@@ -0,0 +1,48 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package distancer
+
+// The pure go implementations are kept to switch back to them. Package level
+// variables are initialized before the architecture specific init functions
+// swap in SIMD kernels.
+var (
+	dotProductPureGo        = dotProductImplementation
+	l2SquaredPureGo         = l2SquaredImpl
+	dotBytePureGo           = dotByteImpl
+	l2SquaredBytePureGo     = l2SquaredByteImpl
+	dotProductFloat16PureGo = dotProductFloat16Implementation
+	l2SquaredFloat16PureGo  = l2SquaredFloat16Impl
+)
+
+// kernels names the instruction set of the float32 kernels in use, it is set
+// by the architecture specific init functions
+var kernels = "pure go"
+
+// Kernels returns the instruction set the float32 distances are calculated
+// with, e.g. "avx512", "avx2", "sve", "neon" or "pure go"
+func Kernels() string {
+	return kernels
+}
+
+// ForceScalar switches all distance calculations back to their pure go
+// implementations. This is meant for debugging, to rule out the SIMD kernels
+// as the cause of unexpected distances. It is not safe to call concurrently
+// with distance calculations, so it must be called at startup.
+func ForceScalar() {
+	dotProductImplementation = dotProductPureGo
+	l2SquaredImpl = l2SquaredPureGo
+	dotByteImpl = dotBytePureGo
+	l2SquaredByteImpl = l2SquaredBytePureGo
+	dotProductFloat16Implementation = dotProductFloat16PureGo
+	l2SquaredFloat16Impl = l2SquaredFloat16PureGo
+	kernels = "pure go"
+}
@@ -0,0 +1,28 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package distancer
+
+import "golang.org/x/sys/cpu"
+
+// hasAVX512 reports whether the AVX-512 kernels can be used. Building the
+// mask for the remaining elements of a vector requires BMI2 in addition to
+// AVX-512F.
+var hasAVX512 = cpu.X86.HasAVX512F && cpu.X86.HasBMI2
+
+func init() {
+	switch {
+	case hasAVX512:
+		kernels = "avx512"
+	case cpu.X86.HasAVX2:
+		kernels = "avx2"
+	}
+}
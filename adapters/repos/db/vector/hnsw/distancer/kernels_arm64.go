@@ -0,0 +1,23 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package distancer
+
+import "golang.org/x/sys/cpu"
+
+func init() {
+	switch {
+	case cpu.ARM64.HasSVE:
+		kernels = "sve"
+	case cpu.ARM64.HasASIMD:
+		kernels = "neon"
+	}
+}
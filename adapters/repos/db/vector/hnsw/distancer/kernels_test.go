@@ -0,0 +1,41 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package distancer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestForceScalar(t *testing.T) {
+	dot, l2, kernelsBefore := dotProductImplementation, l2SquaredImpl, kernels
+	dotByte, l2Byte := dotByteImpl, l2SquaredByteImpl
+	dotFloat16, l2Float16 := dotProductFloat16Implementation, l2SquaredFloat16Impl
+	t.Cleanup(func() {
+		dotProductImplementation, l2SquaredImpl, kernels = dot, l2, kernelsBefore
+		dotByteImpl, l2SquaredByteImpl = dotByte, l2Byte
+		dotProductFloat16Implementation, l2SquaredFloat16Impl = dotFloat16, l2Float16
+	})
+
+	x := []float32{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17}
+	y := []float32{17, 16, 15, 14, 13, 12, 11, 10, 9, 8, 7, 6, 5, 4, 3, 2, 1}
+	expectedDot := dotProductImplementation(x, y)
+	expectedL2 := l2SquaredImpl(x, y)
+
+	ForceScalar()
+
+	assert.Equal(t, "pure go", Kernels())
+	assert.Equal(t, expectedDot, dotProductImplementation(x, y))
+	assert.Equal(t, expectedL2, l2SquaredImpl(x, y))
+	assert.Equal(t, uint32(14), DotByte([]uint8{1, 2, 3}, []uint8{1, 2, 3}))
+}
@@ -17,7 +17,19 @@ import (
 )
 
 func init() {
-	if cpu.X86.HasAVX2 {
+	switch {
+	case hasAVX512:
+		l2SquaredImpl = l2AVX512
+	case cpu.X86.HasAVX2:
 		l2SquaredImpl = asm.L2
 	}
 }
+
+// l2AVX512 uses the AVX2 kernel for vectors which do not fill a single 512
+// bit register, see dotAVX512
+func l2AVX512(a, b []float32) float32 {
+	if len(a) < 16 {
+		return asm.L2(a, b)
+	}
+	return asm.L2AVX512(a, b)
+}
@@ -94,6 +94,15 @@ func Benchmark_L2_PureGo_VS_AVX(b *testing.B) {
 					asm.L2(x, y)
 				}
 			})
+
+			b.Run("asm AVX-512", func(b *testing.B) {
+				if !hasAVX512 {
+					b.Skip("AVX-512 is not supported")
+				}
+				for i := 0; i < b.N; i++ {
+					asm.L2AVX512(x, y)
+				}
+			})
 		})
 	}
 }
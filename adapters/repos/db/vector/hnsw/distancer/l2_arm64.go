@@ -17,7 +17,10 @@ import (
 )
 
 func init() {
-	if cpu.ARM64.HasASIMD {
+	switch {
+	case cpu.ARM64.HasSVE:
+		l2SquaredImpl = asm.L2SVE
+	case cpu.ARM64.HasASIMD:
 		l2SquaredImpl = asm.L2
 	}
 }
@@ -0,0 +1,44 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package distancer
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer/asm"
+	"golang.org/x/sys/cpu"
+)
+
+func Test_SVE_DistanceImplementation(t *testing.T) {
+	if !cpu.ARM64.HasSVE {
+		t.Skip("SVE is not supported")
+	}
+
+	lengths := []int{0, 1, 4, 15, 16, 17, 31, 32, 35, 63, 64, 67, 128, 130, 256, 260, 384, 390, 768, 777, 1536}
+	r := getRandomSeed()
+
+	for _, length := range lengths {
+		t.Run(fmt.Sprintf("with vector l=%d", length), func(t *testing.T) {
+			x := make([]float32, length)
+			y := make([]float32, length)
+			for i := range x {
+				x[i] = r.Float32() - 0.5
+				y[i] = r.Float32()
+			}
+
+			assert.InDelta(t, -DotProductGo(x, y), asm.DotSVE(x, y), 0.001)
+			assert.InDelta(t, L2PureGo(x, y), asm.L2SVE(x, y), 0.001)
+		})
+	}
+}
@@ -13,15 +13,51 @@ package hnsw
 
 import (
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/priorityqueue"
 )
 
 func (h *hnsw) flatSearch(queryVector []float32, limit int,
 	allowList helpers.AllowList,
+) ([]uint64, []float32, error) {
+	return h.flatSearchWithDistance(queryVector, limit, allowList.Iterator(),
+		h.distBetweenNodeAndVec)
+}
+
+// SearchByVectorExact bypasses the graph and compares the query to the full
+// vector of every node, or of every node in the allow list. It is meant for
+// ground-truth evaluations and small filtered result sets.
+func (h *hnsw) SearchByVectorExact(vector []float32, k int,
+	allowList helpers.AllowList,
+) ([]uint64, []float32, error) {
+	h.compressActionLock.RLock()
+	defer h.compressActionLock.RUnlock()
+
+	if h.distancerProvider.Type() == "cosine-dot" {
+		// cosine-dot requires normalized vectors, as the dot product and cosine
+		// similarity are only identical if the vector is normalized
+		vector = distancer.Normalize(vector)
+	}
+
+	var it helpers.AllowListIterator
+	if allowList != nil {
+		it = allowList.Iterator()
+	} else {
+		h.RLock()
+		it = &nodesIterator{len: uint64(len(h.nodes))}
+		h.RUnlock()
+	}
+
+	return h.flatSearchWithDistance(vector, k, it,
+		h.distBetweenUncompressedNodeAndVec)
+}
+
+func (h *hnsw) flatSearchWithDistance(queryVector []float32, limit int,
+	it helpers.AllowListIterator,
+	distFn func(node uint64, vec []float32) (float32, bool, error),
 ) ([]uint64, []float32, error) {
 	results := priorityqueue.NewMax(limit)
 
-	it := allowList.Iterator()
 	for candidate, ok := it.Next(); ok; candidate, ok = it.Next() {
 		h.RLock()
 		// Hot fix for https://github.com/weaviate/weaviate/issues/1937
@@ -45,7 +81,7 @@ func (h *hnsw) flatSearch(queryVector []float32, limit int,
 			continue
 		}
 		h.RUnlock()
-		dist, ok, err := h.distBetweenNodeAndVec(candidate, queryVector)
+		dist, ok, err := distFn(candidate, queryVector)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -78,3 +114,24 @@ func (h *hnsw) flatSearch(queryVector []float32, limit int,
 
 	return ids, dists, nil
 }
+
+// nodesIterator iterates over all node ids up to the length of the index at
+// the time of its creation. Empty and deleted nodes are skipped by the
+// search itself.
+type nodesIterator struct {
+	next uint64
+	len  uint64
+}
+
+func (it *nodesIterator) Next() (uint64, bool) {
+	if it.next >= it.len {
+		return 0, false
+	}
+	id := it.next
+	it.next++
+	return id, true
+}
+
+func (it *nodesIterator) Len() int {
+	return int(it.len)
+}
@@ -0,0 +1,293 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+	"unsafe"
+
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+)
+
+// float16ShardedLockCache is a drop-in replacement for the shardedLockCache
+// which keeps the vectors as float16, halving the memory used by the cache.
+// Distancers which support it can calculate distances against the float16
+// vectors directly using getFloat16, all other callers receive the vectors
+// converted back to float32.
+type float16ShardedLockCache struct {
+	shardedLocks        []sync.RWMutex
+	cache               [][]uint16
+	vectorForID         VectorForID
+	normalizeOnRead     bool
+	maxSize             int64
+	maxBytes            int64
+	count               int64
+	cancel              chan bool
+	logger              logrus.FieldLogger
+	dims                int32
+	trackDimensionsOnce sync.Once
+	deletionInterval    time.Duration
+
+	// The maintenanceLock makes sure that only one maintenance operation, such
+	// as growing the cache or clearing the cache happens at the same time.
+	maintenanceLock sync.Mutex
+}
+
+func newFloat16ShardedLockCache(vecForID VectorForID, maxSize int,
+	logger logrus.FieldLogger, normalizeOnRead bool, deletionInterval time.Duration,
+) *float16ShardedLockCache {
+	vc := &float16ShardedLockCache{
+		vectorForID:      vecForID,
+		cache:            make([][]uint16, initialSize),
+		normalizeOnRead:  normalizeOnRead,
+		count:            0,
+		maxSize:          int64(maxSize),
+		cancel:           make(chan bool),
+		logger:           logger,
+		shardedLocks:     make([]sync.RWMutex, shardFactor),
+		maintenanceLock:  sync.Mutex{},
+		deletionInterval: deletionInterval,
+	}
+
+	for i := uint64(0); i < shardFactor; i++ {
+		vc.shardedLocks[i] = sync.RWMutex{}
+	}
+	vc.watchForDeletion()
+	return vc
+}
+
+//nolint:unused
+func (s *float16ShardedLockCache) all() [][]float32 {
+	s.obtainAllLocks()
+	defer s.releaseAllLocks()
+
+	out := make([][]float32, len(s.cache))
+	for i, vec := range s.cache {
+		if vec != nil {
+			out[i] = distancer.DecodeFloat16(vec)
+		}
+	}
+	return out
+}
+
+func (s *float16ShardedLockCache) get(ctx context.Context, id uint64) ([]float32, error) {
+	vec, err := s.getFloat16(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return distancer.DecodeFloat16(vec), nil
+}
+
+func (s *float16ShardedLockCache) getFloat16(ctx context.Context, id uint64) ([]uint16, error) {
+	s.shardedLocks[id%shardFactor].RLock()
+	vec := s.cache[id]
+	s.shardedLocks[id%shardFactor].RUnlock()
+
+	if vec != nil {
+		return vec, nil
+	}
+
+	return s.handleCacheMiss(ctx, id)
+}
+
+//nolint:unused
+func (s *float16ShardedLockCache) delete(ctx context.Context, id uint64) {
+	s.shardedLocks[id%shardFactor].Lock()
+	defer s.shardedLocks[id%shardFactor].Unlock()
+
+	if int(id) >= len(s.cache) || s.cache[id] == nil {
+		return
+	}
+
+	s.cache[id] = nil
+	atomic.AddInt64(&s.count, -1)
+}
+
+func (s *float16ShardedLockCache) handleCacheMiss(ctx context.Context, id uint64) ([]uint16, error) {
+	vec, err := s.vectorForID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	s.trackDimensionsOnce.Do(func() {
+		atomic.StoreInt32(&s.dims, int32(len(vec)))
+	})
+
+	if s.normalizeOnRead {
+		vec = distancer.Normalize(vec)
+	}
+	half := distancer.EncodeFloat16(vec)
+
+	atomic.AddInt64(&s.count, 1)
+	s.shardedLocks[id%shardFactor].Lock()
+	s.cache[id] = half
+	s.shardedLocks[id%shardFactor].Unlock()
+
+	return half, nil
+}
+
+func (s *float16ShardedLockCache) multiGet(ctx context.Context, ids []uint64) ([][]float32, []error) {
+	out := make([][]float32, len(ids))
+	errs := make([]error, len(ids))
+
+	for i, id := range ids {
+		vec, err := s.get(ctx, id)
+		errs[i] = err
+		out[i] = vec
+	}
+
+	return out, errs
+}
+
+//nolint:unused
+func (s *float16ShardedLockCache) prefetch(id uint64) {
+	s.shardedLocks[id%shardFactor].RLock()
+	defer s.shardedLocks[id%shardFactor].RUnlock()
+
+	prefetchFunc(uintptr(unsafe.Pointer(&s.cache[id])))
+}
+
+func (s *float16ShardedLockCache) preload(id uint64, vec []float32) {
+	half := distancer.EncodeFloat16(vec)
+
+	s.shardedLocks[id%shardFactor].Lock()
+	defer s.shardedLocks[id%shardFactor].Unlock()
+
+	atomic.AddInt64(&s.count, 1)
+	s.trackDimensionsOnce.Do(func() {
+		atomic.StoreInt32(&s.dims, int32(len(vec)))
+	})
+
+	s.cache[id] = half
+}
+
+func (s *float16ShardedLockCache) grow(node uint64) {
+	if node < uint64(len(s.cache)) {
+		return
+	}
+	s.maintenanceLock.Lock()
+	defer s.maintenanceLock.Unlock()
+
+	s.obtainAllLocks()
+	defer s.releaseAllLocks()
+
+	newSize := node + minimumIndexGrowthDelta
+	newCache := make([][]uint16, newSize)
+	copy(newCache, s.cache)
+	atomic.StoreInt64(&s.count, int64(newSize))
+	s.cache = newCache
+}
+
+//nolint:unused
+func (s *float16ShardedLockCache) len() int32 {
+	return int32(len(s.cache))
+}
+
+func (s *float16ShardedLockCache) countVectors() int64 {
+	return atomic.LoadInt64(&s.count)
+}
+
+func (s *float16ShardedLockCache) drop() {
+	s.deleteAllVectors()
+	s.cancel <- true
+}
+
+func (s *float16ShardedLockCache) deleteAllVectors() {
+	s.obtainAllLocks()
+	defer s.releaseAllLocks()
+
+	s.logger.WithField("action", "hnsw_delete_vector_cache").
+		Debug("deleting full vector cache")
+	for i := range s.cache {
+		s.cache[i] = nil
+	}
+
+	atomic.StoreInt64(&s.count, 0)
+}
+
+func (s *float16ShardedLockCache) watchForDeletion() {
+	go func() {
+		t := time.NewTicker(s.deletionInterval)
+		defer t.Stop()
+		for {
+			select {
+			case <-s.cancel:
+				return
+			case <-t.C:
+				s.replaceIfFull()
+			}
+		}
+	}()
+}
+
+func (s *float16ShardedLockCache) replaceIfFull() {
+	if atomic.LoadInt64(&s.count) >= s.copyMaxSize() {
+		s.maintenanceLock.Lock()
+		s.deleteAllVectors()
+		s.maintenanceLock.Unlock()
+	}
+}
+
+func (s *float16ShardedLockCache) obtainAllLocks() {
+	wg := &sync.WaitGroup{}
+	for i := uint64(0); i < shardFactor; i++ {
+		wg.Add(1)
+		go func(index uint64) {
+			defer wg.Done()
+			s.shardedLocks[index].Lock()
+		}(i)
+	}
+
+	wg.Wait()
+}
+
+func (s *float16ShardedLockCache) releaseAllLocks() {
+	for i := uint64(0); i < shardFactor; i++ {
+		s.shardedLocks[i].Unlock()
+	}
+}
+
+//nolint:unused
+func (s *float16ShardedLockCache) updateMaxSize(size int64) {
+	atomic.StoreInt64(&s.maxSize, size)
+}
+
+//nolint:unused
+func (s *float16ShardedLockCache) copyMaxSize() int64 {
+	return effectiveMaxSize(atomic.LoadInt64(&s.maxSize),
+		atomic.LoadInt64(&s.maxBytes), s.vectorBytes())
+}
+
+//nolint:unused
+func (s *float16ShardedLockCache) updateMaxBytes(size int64) {
+	atomic.StoreInt64(&s.maxBytes, size)
+}
+
+//nolint:unused
+func (s *float16ShardedLockCache) copyMaxBytes() int64 {
+	return atomic.LoadInt64(&s.maxBytes)
+}
+
+// vectorBytes is the footprint of a single cached vector, float16 takes 2 bytes per dimension
+func (s *float16ShardedLockCache) vectorBytes() int64 {
+	return vectorFootprint(atomic.LoadInt32(&s.dims), 2)
+}
+
+//nolint:unused
+func (s *float16ShardedLockCache) estimatedBytes() int64 {
+	return atomic.LoadInt64(&s.count) * s.vectorBytes()
+}
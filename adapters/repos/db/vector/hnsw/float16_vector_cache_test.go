@@ -0,0 +1,110 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestFloat16VectorCache(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	vecForID := func(ctx context.Context, id uint64) ([]float32, error) {
+		return []float32{3, 4}, nil
+	}
+
+	t.Run("preloaded vectors are stored as float16", func(t *testing.T) {
+		cache := newFloat16ShardedLockCache(vecForID, 1000, logger, false, time.Hour)
+		defer cache.drop()
+
+		cache.preload(1, []float32{0.1, 0.2, 0.3})
+
+		half, err := cache.getFloat16(context.Background(), 1)
+		require.Nil(t, err)
+		assert.Equal(t, distancer.EncodeFloat16([]float32{0.1, 0.2, 0.3}), half)
+
+		vec, err := cache.get(context.Background(), 1)
+		require.Nil(t, err)
+		assert.InDeltaSlice(t, []float32{0.1, 0.2, 0.3}, vec, 1e-3)
+		assert.Equal(t, int64(1), cache.countVectors())
+	})
+
+	t.Run("cache misses are normalized before being stored", func(t *testing.T) {
+		cache := newFloat16ShardedLockCache(vecForID, 1000, logger, true, time.Hour)
+		defer cache.drop()
+
+		vec, err := cache.get(context.Background(), 7)
+		require.Nil(t, err)
+		assert.InDeltaSlice(t, []float32{0.6, 0.8}, vec, 1e-3)
+		assert.Equal(t, int64(1), cache.countVectors())
+	})
+
+	t.Run("deleted vectors are read again", func(t *testing.T) {
+		cache := newFloat16ShardedLockCache(vecForID, 1000, logger, false, time.Hour)
+		defer cache.drop()
+
+		cache.preload(2, []float32{1, 1})
+		cache.delete(context.Background(), 2)
+		assert.Equal(t, int64(0), cache.countVectors())
+
+		vec, err := cache.get(context.Background(), 2)
+		require.Nil(t, err)
+		assert.Equal(t, []float32{3, 4}, vec)
+	})
+}
+
+func TestHnswIndexWithFloat16Cache(t *testing.T) {
+	index, err := New(Config{
+		RootPath:              "doesnt-matter-as-committlogger-is-mocked-out",
+		ID:                    "unittest",
+		MakeCommitLoggerThunk: MakeNoopCommitLogger,
+		DistanceProvider:      distancer.NewCosineDistanceProvider(),
+		VectorForIDThunk:      testVectorForID,
+	}, ent.UserConfig{
+		MaxConnections:        30,
+		EFConstruction:        60,
+		VectorCacheMaxObjects: 100000,
+		VectorCacheDType:      ent.VectorCacheDTypeFloat16,
+	},
+		cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop())
+	require.Nil(t, err)
+	require.NotNil(t, index.float16Cache)
+
+	for i, vec := range testVectors {
+		err := index.Add(uint64(i), vec)
+		require.Nil(t, err)
+	}
+
+	t.Run("searching within cluster 1", func(t *testing.T) {
+		res, _, err := index.knnSearchByVector(testVectors[0], 3, 36, nil)
+		require.Nil(t, err)
+		assert.ElementsMatch(t, []uint64{0, 1, 2}, res)
+	})
+
+	t.Run("searching within cluster 2 with a scope larger than the cluster", func(t *testing.T) {
+		res, _, err := index.knnSearchByVector(testVectors[3], 50, 36, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{
+			3, 5, 4, // cluster 2
+			7, 8, 6, // cluster 3
+			2, 1, 0, // cluster 1
+		}, res)
+	})
+}
@@ -0,0 +1,20 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build !cuvs
+
+package hnsw
+
+// NewGPUGraphBuilder returns a GraphBuilder which builds the graph on the
+// GPU. It is only available if weaviate is built with the cuvs tag.
+func NewGPUGraphBuilder() (GraphBuilder, error) {
+	return nil, ErrGPUBuildUnavailable
+}
@@ -0,0 +1,159 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build cuvs
+
+package hnsw
+
+// The graph is built with CAGRA from NVIDIA cuVS (25.02 or newer) through its
+// C API. Building requires the cuVS headers and libcuvs_c at compile time and
+// a CUDA capable GPU at runtime:
+//
+//	CGO_CFLAGS="-I$CONDA_PREFIX/include" CGO_LDFLAGS="-L$CONDA_PREFIX/lib" \
+//		go build -tags cuvs ./cmd/weaviate-server
+
+/*
+#cgo LDFLAGS: -lcuvs_c
+#include <stdlib.h>
+#include <cuvs/core/c_api.h>
+#include <cuvs/neighbors/cagra.h>
+#include <dlpack/dlpack.h>
+
+static DLManagedTensor* weaviate_host_tensor(void* data, int64_t rows,
+	int64_t cols, uint8_t code) {
+	DLManagedTensor* t = (DLManagedTensor*)calloc(1, sizeof(DLManagedTensor));
+	int64_t* shape = (int64_t*)malloc(2 * sizeof(int64_t));
+	shape[0] = rows;
+	shape[1] = cols;
+	t->dl_tensor.data = data;
+	t->dl_tensor.device.device_type = kDLCPU;
+	t->dl_tensor.device.device_id = 0;
+	t->dl_tensor.ndim = 2;
+	t->dl_tensor.dtype.code = code;
+	t->dl_tensor.dtype.bits = 32;
+	t->dl_tensor.dtype.lanes = 1;
+	t->dl_tensor.shape = shape;
+	return t;
+}
+
+static void weaviate_free_tensor(DLManagedTensor* t) {
+	free(t->dl_tensor.shape);
+	free(t);
+}
+*/
+import "C"
+
+import (
+	"context"
+	"unsafe"
+
+	"github.com/pkg/errors"
+)
+
+type cuvsGraphBuilder struct{}
+
+// NewGPUGraphBuilder returns a GraphBuilder which builds the graph on the
+// GPU with CAGRA
+func NewGPUGraphBuilder() (GraphBuilder, error) {
+	return &cuvsGraphBuilder{}, nil
+}
+
+func (b *cuvsGraphBuilder) Build(ctx context.Context, vectors [][]float32,
+	degree int, distance string,
+) ([][]uint32, error) {
+	var metric C.cuvsDistanceType
+	switch distance {
+	case "l2-squared", "cosine-dot":
+		// cosine vectors are normalized, so their euclidean neighbors are the
+		// same as their cosine neighbors
+		metric = C.L2Expanded
+	case "dot":
+		metric = C.InnerProduct
+	default:
+		return nil, errors.Errorf("distance %q is not supported by the gpu index build",
+			distance)
+	}
+
+	rows, dims := len(vectors), len(vectors[0])
+	// CAGRA needs fewer neighbors per node than there are nodes
+	if degree >= rows {
+		degree = rows - 1
+	}
+	if degree < 1 {
+		return make([][]uint32, rows), nil
+	}
+
+	var res C.cuvsResources_t
+	if err := cuvsCheck(C.cuvsResourcesCreate(&res), "create resources"); err != nil {
+		return nil, err
+	}
+	defer C.cuvsResourcesDestroy(res)
+
+	// cgo does not allow C to hold on to Go memory, so the dataset is copied
+	// into C memory
+	data := C.malloc(C.size_t(rows * dims * 4))
+	defer C.free(data)
+	flat := unsafe.Slice((*float32)(data), rows*dims)
+	for i, vec := range vectors {
+		copy(flat[i*dims:(i+1)*dims], vec)
+	}
+	dataset := C.weaviate_host_tensor(data, C.int64_t(rows), C.int64_t(dims), C.kDLFloat)
+	defer C.weaviate_free_tensor(dataset)
+
+	var params C.cuvsCagraIndexParams_t
+	if err := cuvsCheck(C.cuvsCagraIndexParamsCreate(&params), "create index params"); err != nil {
+		return nil, err
+	}
+	defer C.cuvsCagraIndexParamsDestroy(params)
+	params.metric = metric
+	params.graph_degree = C.size_t(degree)
+	params.intermediate_graph_degree = C.size_t(2 * degree)
+
+	var index C.cuvsCagraIndex_t
+	if err := cuvsCheck(C.cuvsCagraIndexCreate(&index), "create index"); err != nil {
+		return nil, err
+	}
+	defer C.cuvsCagraIndexDestroy(index)
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if err := cuvsCheck(C.cuvsCagraBuild(res, params, dataset, index), "build index"); err != nil {
+		return nil, err
+	}
+
+	graphData := C.malloc(C.size_t(rows * degree * 4))
+	defer C.free(graphData)
+	graphTensor := C.weaviate_host_tensor(graphData, C.int64_t(rows), C.int64_t(degree), C.kDLUInt)
+	defer C.weaviate_free_tensor(graphTensor)
+
+	if err := cuvsCheck(C.cuvsCagraIndexGetGraph(index, graphTensor), "get graph"); err != nil {
+		return nil, err
+	}
+	if err := cuvsCheck(C.cuvsStreamSync(res), "sync stream"); err != nil {
+		return nil, err
+	}
+
+	neighbors := unsafe.Slice((*uint32)(graphData), rows*degree)
+	graph := make([][]uint32, rows)
+	for i := range graph {
+		graph[i] = make([]uint32, degree)
+		copy(graph[i], neighbors[i*degree:(i+1)*degree])
+	}
+	return graph, nil
+}
+
+func cuvsCheck(status C.cuvsError_t, action string) error {
+	if status == C.CUVS_SUCCESS {
+		return nil
+	}
+	return errors.Errorf("cuvs: %s: %s", action, C.GoString(C.cuvsGetLastErrorText()))
+}
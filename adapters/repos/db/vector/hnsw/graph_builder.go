@@ -0,0 +1,193 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+)
+
+// ErrGPUBuildUnavailable is returned if a graph should be built on the GPU,
+// but weaviate has been built without the cuvs tag
+var ErrGPUBuildUnavailable = errors.New("gpu index build is not available, " +
+	"weaviate needs to be built with the cuvs tag")
+
+// GraphBuilder constructs the neighborhood graph of a set of vectors in bulk
+// instead of inserting them one by one. The rows of the returned graph line up
+// with the vectors and hold the positions of up to degree neighbors each.
+type GraphBuilder interface {
+	Build(ctx context.Context, vectors [][]float32, degree int,
+		distance string) ([][]uint32, error)
+}
+
+// BuildInfo describes a graph which has been built in bulk
+type BuildInfo struct {
+	Nodes      int
+	Dimensions int
+}
+
+// deferInserts is true while the inserts into an index which is built on the
+// GPU are deferred. The vectors stay with the objects until BuildGraph reads
+// them, so there is nothing to insert or delete. Once the graph has been built
+// the inserts are never deferred again, even if all nodes are deleted later.
+func (h *hnsw) deferInserts() bool {
+	return h.buildOnGPU && !h.built.Load()
+}
+
+func builtMarkerPath(rootPath, id string) string {
+	return filepath.Join(rootPath, fmt.Sprintf("%s.hnsw.built", id))
+}
+
+// restoreBuilt restores whether the graph has been built on startup. A graph
+// which has been attached, but whose marker was not written before a crash,
+// is recognized by the index not being empty.
+func (h *hnsw) restoreBuilt() error {
+	if _, err := os.Stat(builtMarkerPath(h.rootPath, h.id)); err == nil {
+		h.built.Store(true)
+		return nil
+	} else if !os.IsNotExist(err) {
+		return errors.Wrap(err, "stat built marker")
+	}
+
+	h.built.Store(!h.isEmpty())
+	return nil
+}
+
+// markBuilt persists that the graph has been built, so that the inserts are
+// not deferred again after a restart
+func (h *hnsw) markBuilt() error {
+	f, err := os.Create(builtMarkerPath(h.rootPath, h.id))
+	if err != nil {
+		return errors.Wrap(err, "create built marker")
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return errors.Wrap(err, "sync built marker")
+	}
+	if err := f.Close(); err != nil {
+		return errors.Wrap(err, "close built marker")
+	}
+
+	h.built.Store(true)
+	return nil
+}
+
+// BuildGraph builds the graph of the empty index from the vectors with the
+// builder and attaches it as if the vectors had been inserted one by one. The
+// graph only has a single layer, which is all that graphs built for GPU
+// search have. Later inserts add the upper layers as usual. Vectors of a
+// cosine index are normalized in place.
+func (h *hnsw) BuildGraph(ctx context.Context, builder GraphBuilder,
+	ids []uint64, vectors [][]float32,
+) (BuildInfo, error) {
+	if len(ids) != len(vectors) {
+		return BuildInfo{}, errors.Errorf("%d ids do not match %d vectors",
+			len(ids), len(vectors))
+	}
+	if len(ids) == 0 {
+		return BuildInfo{}, h.markBuilt()
+	}
+
+	dims := len(vectors[0])
+	var maxID uint64
+	for i, vec := range vectors {
+		if len(vec) == 0 || len(vec) != dims {
+			return BuildInfo{}, errors.Errorf("vector of node %d has %d dimensions, expected %d",
+				ids[i], len(vec), dims)
+		}
+		if h.distancerProvider.Type() == "cosine-dot" {
+			vectors[i] = distancer.Normalize(vec)
+		}
+		if ids[i] > maxID {
+			maxID = ids[i]
+		}
+	}
+
+	degree := int(atomic.LoadInt64(&h.maximumConnectionsLayerZero))
+	graph, err := builder.Build(ctx, vectors, degree, h.distancerProvider.Type())
+	if err != nil {
+		return BuildInfo{}, errors.Wrap(err, "build graph")
+	}
+	if len(graph) != len(vectors) {
+		return BuildInfo{}, errors.Errorf("graph has %d rows for %d vectors",
+			len(graph), len(vectors))
+	}
+
+	state := &DeserializationResult{
+		Nodes:      make([]*vertex, maxID+1),
+		Entrypoint: ids[h.medoid(vectors)],
+		Tombstones: map[uint64]struct{}{},
+	}
+	for pos, row := range graph {
+		links := make([]uint64, 0, len(row))
+		for _, neighbor := range row {
+			if int(neighbor) >= len(ids) || int(neighbor) == pos {
+				continue
+			}
+			links = append(links, ids[neighbor])
+			if len(links) == degree {
+				break
+			}
+		}
+		state.Nodes[ids[pos]] = &vertex{
+			id:          ids[pos],
+			connections: [][]uint64{links},
+		}
+	}
+	if err := ctx.Err(); err != nil {
+		return BuildInfo{}, err
+	}
+
+	err = h.attachGraph(state, dims, func() {
+		for i, id := range ids {
+			h.cache.preload(id, vectors[i])
+		}
+	})
+	if err != nil {
+		return BuildInfo{}, errors.Wrap(err, "attach graph")
+	}
+	if err := h.markBuilt(); err != nil {
+		return BuildInfo{}, err
+	}
+
+	return BuildInfo{Nodes: len(ids), Dimensions: dims}, nil
+}
+
+// medoid returns the position of the vector closest to the centroid, it makes
+// a better entrypoint for a single-layer graph than an arbitrary node
+func (h *hnsw) medoid(vectors [][]float32) int {
+	centroid := make([]float32, len(vectors[0]))
+	for _, vec := range vectors {
+		for i, v := range vec {
+			centroid[i] += v
+		}
+	}
+	for i := range centroid {
+		centroid[i] /= float32(len(vectors))
+	}
+
+	best, bestDist := 0, float32(math.MaxFloat32)
+	for pos, vec := range vectors {
+		dist, _, err := h.distancerProvider.SingleDist(centroid, vec)
+		if err == nil && dist < bestDist {
+			best, bestDist = pos, dist
+		}
+	}
+	return best
+}
@@ -0,0 +1,158 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+)
+
+// exactGraphBuilder builds the exact k nearest neighbor graph on the CPU, it
+// stands in for the GPU builder in tests
+type exactGraphBuilder struct {
+	provider distancer.Provider
+	err      error
+}
+
+func (b *exactGraphBuilder) Build(ctx context.Context, vectors [][]float32,
+	degree int, distance string,
+) ([][]uint32, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+
+	graph := make([][]uint32, len(vectors))
+	for i := range vectors {
+		candidates := make([]uint32, 0, len(vectors)-1)
+		dists := make(map[uint32]float32, len(vectors)-1)
+		for j := range vectors {
+			if i == j {
+				continue
+			}
+			dist, _, err := b.provider.SingleDist(vectors[i], vectors[j])
+			if err != nil {
+				return nil, err
+			}
+			candidates = append(candidates, uint32(j))
+			dists[uint32(j)] = dist
+		}
+		sort.Slice(candidates, func(a, b int) bool {
+			return dists[candidates[a]] < dists[candidates[b]]
+		})
+		if len(candidates) > degree {
+			candidates = candidates[:degree]
+		}
+		graph[i] = candidates
+	}
+	return graph, nil
+}
+
+func TestBuildGraph(t *testing.T) {
+	ctx := context.Background()
+	vectors, queries := testinghelpers.RandomVecs(500, 10, 16)
+	builder := &exactGraphBuilder{provider: distancer.NewL2SquaredProvider()}
+	rootPath := t.TempDir()
+
+	index := newTestIndex(t, vectors, withTestRootPath(rootPath, "snapshot-test"), withTestCommitLog())
+	index.buildOnGPU = true
+
+	ids := make([]uint64, len(vectors))
+	for i := range ids {
+		ids[i] = uint64(i)
+	}
+
+	t.Run("inserts and deletes are deferred until the graph is built", func(t *testing.T) {
+		for i, vec := range vectors {
+			require.Nil(t, index.Add(uint64(i), vec))
+		}
+		for _, err := range index.AddBatch(ids[:10], vectors[:10]) {
+			require.Nil(t, err)
+		}
+		require.Nil(t, index.Delete(3))
+		assert.True(t, index.isEmpty())
+	})
+
+	t.Run("a failing builder leaves the index empty", func(t *testing.T) {
+		_, err := index.BuildGraph(ctx, &exactGraphBuilder{err: errors.New("out of memory")},
+			ids, vectors)
+		assert.ErrorContains(t, err, "out of memory")
+		assert.True(t, index.isEmpty())
+	})
+
+	t.Run("ids and vectors must line up", func(t *testing.T) {
+		_, err := index.BuildGraph(ctx, builder, ids[:10], vectors)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("building the graph", func(t *testing.T) {
+		info, err := index.BuildGraph(ctx, builder, ids, vectors)
+		require.Nil(t, err)
+		assert.Equal(t, BuildInfo{Nodes: 500, Dimensions: 16}, info)
+		assert.False(t, index.isEmpty())
+
+		report, err := index.MeasureRecall(ctx, 100, 10)
+		require.Nil(t, err)
+		assert.Greater(t, report.Recall, 0.9)
+	})
+
+	t.Run("building the graph of a non-empty index", func(t *testing.T) {
+		_, err := index.BuildGraph(ctx, builder, ids, vectors)
+		assert.ErrorContains(t, err, "non-empty index")
+	})
+
+	t.Run("inserts after the build are no longer deferred", func(t *testing.T) {
+		vectors = append(vectors, queries[0])
+		require.Nil(t, index.Add(500, queries[0]))
+
+		res, _, err := index.SearchByVector(queries[0], 1, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{500}, res)
+	})
+
+	t.Run("inserts are not deferred once all nodes have been deleted", func(t *testing.T) {
+		emptied := newTestIndex(t, vectors, withTestRootPath(t.TempDir(), "emptied"), withTestCommitLog())
+		emptied.buildOnGPU = true
+
+		_, err := emptied.BuildGraph(ctx, builder, ids[:2], vectors[:2])
+		require.Nil(t, err)
+		require.Nil(t, emptied.Delete(ids[:2]...))
+		require.Nil(t, emptied.CleanUpTombstonedNodes(func() bool { return false }))
+		require.True(t, emptied.isEmpty())
+
+		require.Nil(t, emptied.Add(2, vectors[2]))
+		assert.False(t, emptied.isEmpty())
+	})
+
+	t.Run("the built graph is restored on startup", func(t *testing.T) {
+		expected, _, err := index.SearchByVector(queries[1], 10, nil)
+		require.Nil(t, err)
+		require.Nil(t, index.Flush())
+		require.Nil(t, index.Shutdown(ctx))
+
+		restored := newTestIndex(t, vectors, withTestRootPath(rootPath, "snapshot-test"), withTestCommitLog())
+		defer restored.Shutdown(ctx)
+		restored.buildOnGPU = true
+		assert.False(t, restored.isEmpty())
+		assert.False(t, restored.deferInserts())
+
+		res, _, err := restored.SearchByVector(queries[1], 10, nil)
+		require.Nil(t, err)
+		assert.Equal(t, expected, res)
+	})
+}
@@ -12,10 +12,18 @@
 package hnsw
 
 import (
+	"context"
 	"fmt"
 	"math/rand"
 	"strings"
+	"testing"
 	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 )
 
 func dumpIndex(index *hnsw, labels ...string) {
@@ -46,3 +54,98 @@ func dumpIndex(index *hnsw, labels ...string) {
 func getRandomSeed() *rand.Rand {
 	return rand.New(rand.NewSource(time.Now().UnixNano()))
 }
+
+type testIndexOptions struct {
+	rootPath    string
+	id          string
+	commitLog   bool
+	distancer   distancer.Provider
+	vectorForID func(ctx context.Context, id uint64) ([]float32, error)
+	imported    bool
+}
+
+type testIndexOption func(o *testIndexOptions)
+
+// withTestRootPath stores the index with the given id in rootPath
+func withTestRootPath(rootPath, id string) testIndexOption {
+	return func(o *testIndexOptions) {
+		o.rootPath = rootPath
+		o.id = id
+	}
+}
+
+// withTestCommitLog writes the commit log to the root path of the index,
+// instead of discarding it
+func withTestCommitLog() testIndexOption {
+	return func(o *testIndexOptions) {
+		o.commitLog = true
+	}
+}
+
+func withTestDistancer(provider distancer.Provider) testIndexOption {
+	return func(o *testIndexOptions) {
+		o.distancer = provider
+	}
+}
+
+func withTestVectorForID(vectorForID func(ctx context.Context, id uint64) ([]float32, error)) testIndexOption {
+	return func(o *testIndexOptions) {
+		o.vectorForID = vectorForID
+	}
+}
+
+// withTestImport adds all vectors to the index, their position is their id
+func withTestImport() testIndexOption {
+	return func(o *testIndexOptions) {
+		o.imported = true
+	}
+}
+
+// newTestIndex creates an index whose vectors are looked up by their
+// position in vectors. By default the commit log is discarded and the
+// l2-squared distance is used.
+func newTestIndex(t *testing.T, vectors [][]float32, opts ...testIndexOption) *hnsw {
+	o := testIndexOptions{
+		rootPath:  "doesnt-matter-as-committlogger-is-mocked-out",
+		id:        "test-index",
+		distancer: distancer.NewL2SquaredProvider(),
+		vectorForID: func(ctx context.Context, id uint64) ([]float32, error) {
+			return vectors[int(id)], nil
+		},
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	makeCommitLogger := MakeNoopCommitLogger
+	if o.commitLog {
+		logger, _ := test.NewNullLogger()
+		makeCommitLogger = func() (CommitLogger, error) {
+			return NewCommitLogger(o.rootPath, o.id, logger,
+				cyclemanager.NewCallbackGroupNoop())
+		}
+	}
+
+	index, err := New(Config{
+		RootPath:              o.rootPath,
+		ID:                    o.id,
+		MakeCommitLoggerThunk: makeCommitLogger,
+		DistanceProvider:      o.distancer,
+		VectorForIDThunk:      o.vectorForID,
+		TempVectorForIDThunk:  TempVectorForIDThunk(vectors),
+	}, ent.UserConfig{
+		MaxConnections:        16,
+		EFConstruction:        64,
+		EF:                    64,
+		VectorCacheMaxObjects: 100000,
+	},
+		cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop())
+	require.Nil(t, err)
+
+	if o.imported {
+		for i, vec := range vectors {
+			require.Nil(t, index.Add(uint64(i), vec))
+		}
+	}
+	return index
+}
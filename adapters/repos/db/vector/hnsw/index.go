@@ -17,6 +17,7 @@ import (
 	"io"
 	"math"
 	"math/rand"
+	"os"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -46,7 +47,7 @@ type hnsw struct {
 	tombstoneLock *sync.RWMutex
 
 	// prevents tombstones cleanup to be performed in parallel with index reset operation
-	resetLock *sync.Mutex
+	resetLock *sync.RWMutex
 	// indicates whether reset operation occurred or not - if so tombstones cleanup method
 	// is aborted as it makes no sense anymore
 	resetCtx       context.Context
@@ -57,12 +58,13 @@ type hnsw struct {
 	// empty graph
 	initialInsertOnce *sync.Once
 
-	// Each node should not have more edges than this number
-	maximumConnections int
+	// Each node should not have more edges than this number. It can be updated
+	// at runtime, always access it atomically.
+	maximumConnections int64
 
 	// Nodes in the lowest level have a separate (usually higher) max connection
 	// limit
-	maximumConnectionsLayerZero int
+	maximumConnectionsLayerZero int64
 
 	// the current maximum can be smaller than the configured maximum because of
 	// the exponentially decaying layer function. The initial entry is started at
@@ -74,8 +76,9 @@ type hnsw struct {
 	// this point is always currentMaximumLayer
 	entryPointID uint64
 
-	// ef parameter used in construction phases, should be higher than ef during querying
-	efConstruction int
+	// ef parameter used in construction phases, should be higher than ef during
+	// querying. It can be updated at runtime, always access it atomically.
+	efConstruction int64
 
 	// ef at search time
 	ef int64
@@ -88,7 +91,24 @@ type hnsw struct {
 	// on filtered searches with less than n elements, perform flat search
 	flatSearchCutoff int64
 
-	levelNormalizer float64
+	// on filtered searches matching less than this share of the nodes, only
+	// traverse allowed nodes, stored as float64 bits
+	acornFilterRatio atomic.Uint64
+
+	// inserts into the empty index are deferred until its graph is built in
+	// bulk with a GraphBuilder
+	buildOnGPU bool
+	// set once the graph has been built in bulk, persisted as a marker file
+	built atomic.Bool
+
+	// picks ef based on the observed query latency, if enabled it takes
+	// precedence over ef and the dynamic ef settings
+	adaptiveEF adaptiveEF
+
+	// records the results of searches to prefill the vector cache with the hot
+	// working set after a restart, only enabled with the recentlyAccessed
+	// prefill policy
+	accessLog accessLog
 
 	nodes []*vertex
 
@@ -100,6 +120,10 @@ type hnsw struct {
 
 	cache cache[float32]
 
+	// float16Cache is set instead of a float32 cache if the vectorCacheDType
+	// is float16, it is the same instance as cache
+	float16Cache *float16ShardedLockCache
+
 	commitLog CommitLogger
 
 	// a lookup of current tombstones (i.e. nodes that have received a tombstone,
@@ -109,8 +133,14 @@ type hnsw struct {
 	tombstones map[uint64]struct{}
 
 	tombstoneCleanupCallbackCtrl cyclemanager.CycleCallbackCtrl
-	shardCompactionCallbacks     cyclemanager.CycleCallbackGroup
-	shardFlushCallbacks          cyclemanager.CycleCallbackGroup
+	// number of workers reassigning the neighbors of tombstoned nodes. It can
+	// be updated at runtime, always access it atomically.
+	cleanupConcurrency int64
+	cleanupStats       tombstoneCleanupStats
+	// aborts running cleanup cycles while the cleanup is paused
+	cleanupPaused            atomic.Bool
+	shardCompactionCallbacks cyclemanager.CycleCallbackGroup
+	shardFlushCallbacks      cyclemanager.CycleCallbackGroup
 
 	// // for distributed spike, can be used to call a insertExternal on a different graph
 	// insertHook func(node, targetLevel int, neighborsAtLevel map[int][]uint32)
@@ -150,10 +180,15 @@ type hnsw struct {
 	// negative impact on performance.
 	deleteVsInsertLock sync.RWMutex
 
-	compressed             atomic.Bool
-	doNotRescore           bool
-	pq                     *ssdhelpers.ProductQuantizer
-	pqConfig               ent.PQConfig
+	compressed   atomic.Bool
+	doNotRescore bool
+	quantizer    ssdhelpers.Quantizer
+	pqConfig     ent.PQConfig
+	sqConfig     ent.SQConfig
+	// number of results of a scalar quantized search which are rescored with
+	// the uncompressed vectors, always access it atomically
+	sqRescoreLimit         int64
+	pqAutoTrain            pqAutoTrainer
 	compressedVectorsCache cache[byte]
 	compressedStore        *lsmkv.Store
 	compressActionLock     *sync.RWMutex
@@ -181,6 +216,7 @@ type CommitLogger interface {
 	RootPath() string
 	SwitchCommitLogs(bool) error
 	AddPQ(ssdhelpers.PQData) error
+	AddSQ(ssdhelpers.SQData) error
 }
 
 type BufferedLinksLogger interface {
@@ -222,24 +258,37 @@ func New(cfg Config, uc ent.UserConfig,
 		normalizeOnRead = true
 	}
 
-	vectorCache := newShardedLockCache(cfg.VectorForIDThunk, uc.VectorCacheMaxObjects,
-		cfg.Logger, normalizeOnRead, defaultDeletionInterval)
+	var vectorCache interface {
+		cache[float32]
+		multiGet(ctx context.Context, ids []uint64) ([][]float32, []error)
+	}
+	var float16Cache *float16ShardedLockCache
+	if uc.VectorCacheDType == ent.VectorCacheDTypeFloat16 {
+		float16Cache = newFloat16ShardedLockCache(cfg.VectorForIDThunk, uc.VectorCacheMaxObjects,
+			cfg.Logger, normalizeOnRead, defaultDeletionInterval)
+		vectorCache = float16Cache
+	} else {
+		vectorCache = newShardedLockCache(cfg.VectorForIDThunk, uc.VectorCacheMaxObjects,
+			cfg.Logger, normalizeOnRead, defaultDeletionInterval)
+	}
+	vectorCache.updateMaxBytes(int64(uc.VectorCacheMaxBytes))
 
 	var compressedVectorsCache *compressedShardedLockCache
 
 	resetCtx, resetCtxCancel := context.WithCancel(context.Background())
 	index := &hnsw{
-		maximumConnections: uc.MaxConnections,
+		maximumConnections: int64(uc.MaxConnections),
 
 		// inspired by original paper and other implementations
-		maximumConnectionsLayerZero: 2 * uc.MaxConnections,
+		maximumConnectionsLayerZero: int64(2 * uc.MaxConnections),
 
-		// inspired by c++ implementation
-		levelNormalizer:        1 / math.Log(float64(uc.MaxConnections)),
-		efConstruction:         uc.EFConstruction,
+		efConstruction:         int64(uc.EFConstruction),
+		cleanupConcurrency:     int64(uc.CleanupConcurrency),
 		flatSearchCutoff:       int64(uc.FlatSearchCutoff),
+		buildOnGPU:             uc.IndexBuild == ent.IndexBuildGPU,
 		nodes:                  make([]*vertex, initialSize),
 		cache:                  vectorCache,
+		float16Cache:           float16Cache,
 		vectorForID:            vectorCache.get,
 		multiVectorForID:       vectorCache.multiGet,
 		compressedVectorsCache: compressedVectorsCache,
@@ -250,7 +299,7 @@ func New(cfg Config, uc ent.UserConfig,
 		distancerProvider:      cfg.DistanceProvider,
 		deleteLock:             &sync.Mutex{},
 		tombstoneLock:          &sync.RWMutex{},
-		resetLock:              &sync.Mutex{},
+		resetLock:              &sync.RWMutex{},
 		resetCtx:               resetCtx,
 		resetCtxCancel:         resetCtxCancel,
 		initialInsertOnce:      &sync.Once{},
@@ -269,14 +318,24 @@ func New(cfg Config, uc ent.UserConfig,
 		VectorForIDThunk:     cfg.VectorForIDThunk,
 		TempVectorForIDThunk: cfg.TempVectorForIDThunk,
 		pqConfig:             uc.PQ,
+		sqConfig:             uc.SQ,
+		sqRescoreLimit:       int64(uc.SQ.RescoreLimit),
 		shardedNodeLocks:     make([]sync.RWMutex, NodeLockStripe),
 
 		shardCompactionCallbacks: shardCompactionCallbacks,
 		shardFlushCallbacks:      shardFlushCallbacks,
 	}
 
-	if uc.PQ.Enabled {
+	index.adaptiveEF.configure(uc.AdaptiveEF, uc.DynamicEFMin, uc.DynamicEFMax)
+	index.accessLog.init(uc.VectorCacheMaxObjects)
+	index.accessLog.enabled.Store(uc.VectorCachePrefill == ent.VectorCachePrefillRecentlyAccessed)
+	index.acornFilterRatio.Store(math.Float64bits(uc.AcornFilterRatio))
+
+	// an automatically trained index needs the compressed cache once it is
+	// restored or trained
+	if uc.PQ.Enabled || uc.PQ.AutoTrain || uc.SQ.Enabled {
 		index.compressedVectorsCache = newCompressedShardedLockCache(index.getCompressedVectorForID, uc.VectorCacheMaxObjects, cfg.Logger)
+		index.compressedVectorsCache.updateMaxBytes(int64(uc.VectorCacheMaxBytes))
 	}
 
 	// TODO common_cycle_manager move to poststartup?
@@ -477,7 +536,7 @@ func (h *hnsw) distBetweenNodes(a, b uint64) (float32, bool, error) {
 			return 0, false, fmt.Errorf("got a nil or zero-length vector at docID %d", b)
 		}
 
-		return h.pq.DistanceBetweenCompressedVectors(v1, v2), true, nil
+		return h.quantizer.DistanceBetweenCompressedVectors(v1, v2), true, nil
 	}
 	// TODO: introduce single search/transaction context instead of spawning new
 	// ones
@@ -536,8 +595,15 @@ func (h *hnsw) distBetweenNodeAndVec(node uint64, vecB []float32) (float32, bool
 			return 0, false, fmt.Errorf("got a nil or zero-length vector at docID %d", node)
 		}
 
-		return h.pq.DistanceBetweenCompressedAndUncompressedVectors(vecB, v1), true, nil
+		return h.quantizer.DistanceBetweenCompressedAndUncompressedVectors(vecB, v1), true, nil
 	}
+
+	return h.distBetweenUncompressedNodeAndVec(node, vecB)
+}
+
+// distBetweenUncompressedNodeAndVec always uses the full vector of the node,
+// even if the index is compressed
+func (h *hnsw) distBetweenUncompressedNodeAndVec(node uint64, vecB []float32) (float32, bool, error) {
 	// TODO: introduce single search/transaction context instead of spawning new
 	// ones
 	vecA, err := h.vectorForID(context.Background(), node)
@@ -634,6 +700,13 @@ func (h *hnsw) Drop(ctx context.Context) error {
 		h.cache.drop()
 	}
 
+	if err := os.Remove(accessLogPath(h.rootPath, h.id)); err != nil && !os.IsNotExist(err) {
+		return errors.Wrap(err, "remove access log")
+	}
+	if err := os.Remove(builtMarkerPath(h.rootPath, h.id)); err != nil && !os.IsNotExist(err) {
+		return errors.Wrap(err, "remove built marker")
+	}
+
 	// cancel commit logger last, as the tombstone cleanup cycle might still
 	// write while it's still running
 	err := h.commitLog.Drop(ctx)
@@ -653,6 +726,15 @@ func (h *hnsw) Shutdown(ctx context.Context) error {
 		return errors.Wrap(err, "hnsw shutdown")
 	}
 
+	if h.accessLog.enabled.Load() {
+		// the access log is only a hint for the next startup, failing to persist
+		// it must not fail the shutdown
+		if err := h.accessLog.persist(accessLogPath(h.rootPath, h.id)); err != nil {
+			h.logger.WithField("action", "hnsw_persist_access_log").
+				WithError(err).Warn("could not persist access log")
+		}
+	}
+
 	if h.compressed.Load() {
 		h.compressedVectorsCache.drop()
 		if err := h.compressedStore.Shutdown(ctx); err != nil {
@@ -675,3 +757,14 @@ func (h *hnsw) Entrypoint() uint64 {
 
 	return h.entryPointID
 }
+
+// VectorCacheStats returns the estimated memory used by the active vector
+// cache and the configured byte budget, 0 meaning no budget is set
+func (h *hnsw) VectorCacheStats() (bytes, maxBytes int64) {
+	if h.compressed.Load() {
+		return h.compressedVectorsCache.estimatedBytes(),
+			h.compressedVectorsCache.copyMaxBytes()
+	}
+
+	return h.cache.estimatedBytes(), h.cache.copyMaxBytes()
+}
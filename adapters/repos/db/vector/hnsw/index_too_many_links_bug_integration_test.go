@@ -149,9 +149,9 @@ func Test_NoRace_ManySmallCommitlogs(t *testing.T) {
 			}
 
 			for level, conns := range node.connections {
-				m := index.maximumConnections
+				m := int(index.maximumConnections)
 				if level == 0 {
-					m = index.maximumConnectionsLayerZero
+					m = int(index.maximumConnectionsLayerZero)
 				}
 
 				assert.LessOrEqualf(t, len(conns), m, "node %d at level %d with %d conns",
@@ -200,9 +200,9 @@ func Test_NoRace_ManySmallCommitlogs(t *testing.T) {
 			}
 
 			for level, conns := range node.connections {
-				m := index.maximumConnections
+				m := int(index.maximumConnections)
 				if level == 0 {
-					m = index.maximumConnectionsLayerZero
+					m = int(index.maximumConnectionsLayerZero)
 				}
 
 				assert.LessOrEqualf(t, len(conns), m, "node %d at level %d with %d conns",
@@ -251,9 +251,9 @@ func Test_NoRace_ManySmallCommitlogs(t *testing.T) {
 			}
 
 			for level, conns := range node.connections {
-				m := index.maximumConnections
+				m := int(index.maximumConnections)
 				if level == 0 {
-					m = index.maximumConnectionsLayerZero
+					m = int(index.maximumConnectionsLayerZero)
 				}
 
 				require.LessOrEqualf(t, len(conns), m, "node %d at level %d with %d conns",
@@ -47,6 +47,10 @@ func (h *hnsw) Add(id uint64, vector []float32) error {
 		return errors.Errorf("insert called with nil-vector")
 	}
 
+	if h.deferInserts() {
+		return nil
+	}
+
 	h.metrics.InsertVector()
 	defer h.insertMetrics.total(before)
 
@@ -62,7 +66,12 @@ func (h *hnsw) Add(id uint64, vector []float32) error {
 
 	h.compressActionLock.RLock()
 	defer h.compressActionLock.RUnlock()
-	return h.insert(node, vector)
+	if err := h.insert(node, vector); err != nil {
+		return err
+	}
+
+	h.observeForPQAutoTrain(vector)
+	return nil
 }
 
 func (h *hnsw) insertInitialElement(node *vertex, nodeVec []float32) error {
@@ -76,7 +85,7 @@ func (h *hnsw) insertInitialElement(node *vertex, nodeVec []float32) error {
 	h.entryPointID = node.id
 	h.currentMaximumLayer = 0
 	node.connections = [][]uint64{
-		make([]uint64, 0, h.maximumConnectionsLayerZero),
+		make([]uint64, 0, atomic.LoadInt64(&h.maximumConnectionsLayerZero)),
 	}
 	node.level = 0
 	if err := h.commitLog.AddNode(node); err != nil {
@@ -90,7 +99,7 @@ func (h *hnsw) insertInitialElement(node *vertex, nodeVec []float32) error {
 
 	h.nodes[node.id] = node
 	if h.compressed.Load() {
-		compressed := h.pq.Encode(nodeVec)
+		compressed := h.quantizer.Encode(nodeVec)
 		h.storeCompressedVector(node.id, compressed)
 		h.compressedVectorsCache.preload(node.id, compressed)
 	} else {
@@ -122,32 +131,45 @@ func (h *hnsw) insert(node *vertex, nodeVec []float32) error {
 		return firstInsertError
 	}
 
-	node.markAsMaintenance()
+	h.assignLevel(node, h.randomLevel())
+	return h.insertWithLevel(node, nodeVec, before)
+}
 
-	h.RLock()
-	// initially use the "global" entrypoint which is guaranteed to be on the
-	// currently highest layer
-	entryPointID := h.entryPointID
-	// initially use the level of the entrypoint which is the highest level of
-	// the h-graph in the first iteration
-	currentMaximumLayer := h.currentMaximumLayer
-	h.RUnlock()
+// randomLevel draws the layer of a new node from an exponentially decaying
+// distribution, the level normalizer is inspired by the c++ implementation
+func (h *hnsw) randomLevel() int {
+	maximumConnections := atomic.LoadInt64(&h.maximumConnections)
+	levelNormalizer := 1 / math.Log(float64(maximumConnections))
+	return int(math.Floor(-math.Log(h.randFunc()) * levelNormalizer))
+}
 
-	targetLevel := int(math.Floor(-math.Log(h.randFunc()) * h.levelNormalizer))
+// assignLevel places the node on the target level and allocates its
+// connections on all layers up to that level
+func (h *hnsw) assignLevel(node *vertex, targetLevel int) {
+	maximumConnections := atomic.LoadInt64(&h.maximumConnections)
+	maximumConnectionsLayerZero := atomic.LoadInt64(&h.maximumConnectionsLayerZero)
 
-	// before = time.Now()
-	// m.addBuildingItemLocking(before)
+	node.markAsMaintenance()
 	node.level = targetLevel
 	node.connections = make([][]uint64, targetLevel+1)
 
 	for i := targetLevel; i >= 0; i-- {
-		capacity := h.maximumConnections
+		capacity := maximumConnections
 		if i == 0 {
-			capacity = h.maximumConnectionsLayerZero
+			capacity = maximumConnectionsLayerZero
 		}
 
 		node.connections[i] = make([]uint64, 0, capacity)
 	}
+}
+
+// insertWithLevel inserts a node which already has a level assigned. The
+// caller must hold the deleteVsInsertLock and make sure the graph is not
+// empty.
+func (h *hnsw) insertWithLevel(node *vertex, nodeVec []float32,
+	before time.Time,
+) error {
+	targetLevel := node.level
 
 	if err := h.commitLog.AddNode(node); err != nil {
 		return err
@@ -189,7 +211,7 @@ func (h *hnsw) insert(node *vertex, nodeVec []float32) error {
 	// make sure this new vec is immediately present in the cache, so we don't
 	// have to read it from disk again
 	if h.compressed.Load() {
-		compressed := h.pq.Encode(nodeVec)
+		compressed := h.quantizer.Encode(nodeVec)
 		h.storeCompressedVector(node.id, compressed)
 		h.compressedVectorsCache.preload(node.id, compressed)
 	} else {
@@ -199,8 +221,16 @@ func (h *hnsw) insert(node *vertex, nodeVec []float32) error {
 	h.insertMetrics.prepareAndInsertNode(before)
 	before = time.Now()
 
-	var err error
-	entryPointID, err = h.findBestEntrypointForNode(currentMaximumLayer, targetLevel,
+	h.RLock()
+	// initially use the "global" entrypoint which is guaranteed to be on the
+	// currently highest layer
+	entryPointID := h.entryPointID
+	// initially use the level of the entrypoint which is the highest level of
+	// the h-graph in the first iteration
+	currentMaximumLayer := h.currentMaximumLayer
+	h.RUnlock()
+
+	entryPointID, err := h.findBestEntrypointForNode(currentMaximumLayer, targetLevel,
 		entryPointID, nodeVec)
 	if err != nil {
 		return errors.Wrap(err, "find best entrypoint")
@@ -0,0 +1,200 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"fmt"
+	"runtime"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+)
+
+// batchInsertion is a single node of a batch which passed validation and is
+// waiting to be linked into the graph
+type batchInsertion struct {
+	pos  int
+	node *vertex
+	vec  []float32
+}
+
+// AddBatch inserts many vectors at once. Compared to calling Add for each
+// vector, the global locks are acquired once per batch rather than once per
+// vector:
+//
+//  1. the layers of all nodes are drawn up front, so the nodes which will
+//     become the new entrypoints can be inserted first
+//  2. the index is grown once to the highest id of the batch
+//  3. the neighbor search and link updates run on all cores, each worker
+//     only relies on the striped node locks
+//
+// The returned errors line up with the ids, a nil error means the vector was
+// inserted.
+func (h *hnsw) AddBatch(ids []uint64, vectors [][]float32) []error {
+	errs := make([]error, len(ids))
+	if len(ids) != len(vectors) {
+		for i := range errs {
+			errs[i] = errors.Errorf("batch of %d ids does not match batch of %d vectors",
+				len(ids), len(vectors))
+		}
+		return errs
+	}
+	if h.deferInserts() {
+		return errs
+	}
+
+	h.compressActionLock.RLock()
+	defer h.compressActionLock.RUnlock()
+	h.deleteVsInsertLock.RLock()
+	defer h.deleteVsInsertLock.RUnlock()
+
+	pending := h.prepareBatch(ids, vectors, errs)
+	if len(pending) == 0 {
+		return errs
+	}
+
+	inserted := pending
+	h.initialInsertOnce.Do(func() {
+		if h.isEmpty() {
+			first := pending[0]
+			errs[first.pos] = h.insertInitialElement(first.node, first.vec)
+			pending = pending[1:]
+		}
+	})
+
+	for _, p := range pending {
+		h.assignLevel(p.node, h.randomLevel())
+	}
+
+	// nodes on the highest layers are inserted first, they are the future
+	// entrypoints that all other nodes of the batch navigate through
+	sort.SliceStable(pending, func(a, b int) bool {
+		return pending[a].node.level > pending[b].node.level
+	})
+
+	if err := h.growForBatch(pending); err != nil {
+		for _, p := range pending {
+			errs[p.pos] = err
+		}
+		return errs
+	}
+
+	h.linkBatch(pending, errs)
+	for _, p := range inserted {
+		if errs[p.pos] == nil {
+			h.observeForPQAutoTrain(p.vec)
+		}
+	}
+	return errs
+}
+
+// prepareBatch validates the vectors of the batch and creates their nodes,
+// invalid vectors are marked in errs and left out
+func (h *hnsw) prepareBatch(ids []uint64, vectors [][]float32,
+	errs []error,
+) []batchInsertion {
+	pending := make([]batchInsertion, 0, len(ids))
+	for i, id := range ids {
+		vector := vectors[i]
+		if len(vector) == 0 {
+			errs[i] = errors.Errorf("insert called with nil-vector")
+			continue
+		}
+
+		h.trackDimensionsOnce.Do(func() {
+			atomic.StoreInt32(&h.dims, int32(len(vector)))
+		})
+
+		if h.distancerProvider.Type() == "cosine-dot" {
+			// cosine-dot requires normalized vectors, as the dot product and cosine
+			// similarity are only identical if the vector is normalized
+			vector = distancer.Normalize(vector)
+		}
+
+		h.metrics.InsertVector()
+		pending = append(pending, batchInsertion{
+			pos:  i,
+			node: &vertex{id: id},
+			vec:  vector,
+		})
+	}
+
+	return pending
+}
+
+// growForBatch grows the index once to fit the highest id of the batch, so
+// the workers never need the global write lock to grow it
+func (h *hnsw) growForBatch(pending []batchInsertion) error {
+	maxID := pending[0].node.id
+	for _, p := range pending[1:] {
+		if p.node.id > maxID {
+			maxID = p.node.id
+		}
+	}
+
+	h.Lock()
+	defer h.Unlock()
+
+	if err := h.growIndexToAccomodateNode(maxID, h.logger); err != nil {
+		return errors.Wrapf(err, "grow HNSW index to accommodate node %d", maxID)
+	}
+
+	return nil
+}
+
+// linkBatch searches the neighbors of the nodes and links them into the
+// graph with one worker per core. The workers pick up the nodes in order, so
+// the nodes on the highest layers are linked before the rest.
+func (h *hnsw) linkBatch(pending []batchInsertion, errs []error) {
+	workers := runtime.GOMAXPROCS(0)
+	if workers > len(pending) {
+		workers = len(pending)
+	}
+
+	var next int64 = -1
+	wg := &sync.WaitGroup{}
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				i := atomic.AddInt64(&next, 1)
+				if i >= int64(len(pending)) {
+					return
+				}
+
+				p := pending[i]
+				// each position is only written by a single worker
+				errs[p.pos] = h.linkBatchNode(p)
+			}
+		}()
+	}
+
+	wg.Wait()
+}
+
+func (h *hnsw) linkBatchNode(p batchInsertion) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("insert node %d: %v", p.node.id, r)
+		}
+	}()
+
+	before := time.Now()
+	defer h.insertMetrics.total(before)
+
+	return h.insertWithLevel(p.node, p.vec, before)
+}
@@ -0,0 +1,102 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+)
+
+func TestAddBatch(t *testing.T) {
+	vectors, queries := testinghelpers.RandomVecs(2000, 50, 16)
+	ids := make([]uint64, len(vectors))
+	for i := range ids {
+		ids[i] = uint64(i)
+	}
+
+	t.Run("batches are searchable with a high recall", func(t *testing.T) {
+		index := newTestIndex(t, vectors)
+
+		// the first batch starts with an empty graph
+		for start := 0; start < len(vectors); start += 500 {
+			errs := index.AddBatch(ids[start:start+500], vectors[start:start+500])
+			for _, err := range errs {
+				require.Nil(t, err)
+			}
+		}
+
+		report := index.CheckIntegrity()
+		assert.True(t, report.Healthy())
+		assert.Equal(t, len(vectors), report.Nodes)
+
+		k := 10
+		var relevant uint64
+		distanceFn := func(x, y []float32) float32 {
+			dist, _, _ := distancer.NewL2SquaredProvider().SingleDist(x, y)
+			return dist
+		}
+		for _, query := range queries {
+			truth := testinghelpers.BruteForce(vectors, query, k, distanceFn)
+			results, _, err := index.SearchByVector(query, k, nil)
+			require.Nil(t, err)
+			relevant += testinghelpers.MatchesInLists(truth, results)
+		}
+		recall := float32(relevant) / float32(k*len(queries))
+		assert.Greater(t, recall, float32(0.9))
+	})
+
+	t.Run("batches and single inserts can be mixed", func(t *testing.T) {
+		index := newTestIndex(t, vectors)
+
+		require.Nil(t, index.Add(0, vectors[0]))
+		errs := index.AddBatch(ids[1:100], vectors[1:100])
+		for _, err := range errs {
+			require.Nil(t, err)
+		}
+		require.Nil(t, index.Add(100, vectors[100]))
+
+		results, _, err := index.SearchByVector(vectors[50], 1, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{50}, results)
+
+		allow := helpers.NewAllowList(100)
+		results, _, err = index.SearchByVector(vectors[50], 1, allow)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{100}, results)
+	})
+
+	t.Run("invalid vectors fail without failing the batch", func(t *testing.T) {
+		index := newTestIndex(t, vectors)
+
+		errs := index.AddBatch([]uint64{0, 1, 2}, [][]float32{vectors[0], nil, vectors[2]})
+		require.Len(t, errs, 3)
+		assert.Nil(t, errs[0])
+		assert.EqualError(t, errs[1], "insert called with nil-vector")
+		assert.Nil(t, errs[2])
+		assert.Equal(t, 2, index.CheckIntegrity().Nodes)
+	})
+
+	t.Run("ids and vectors must match", func(t *testing.T) {
+		index := newTestIndex(t, vectors)
+
+		errs := index.AddBatch([]uint64{0, 1}, vectors[:1])
+		require.Len(t, errs, 2)
+		for _, err := range errs {
+			assert.NotNil(t, err)
+		}
+	})
+}
@@ -0,0 +1,327 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+// IntegrityReport contains the findings of a check of the graph of an index.
+//
+// Links in an hnsw graph are directed and the heuristic deliberately drops
+// some of the back links, so a link without a counterpart is not an error.
+// A link is only considered broken if its target does not exist or does not
+// reach the level of the link.
+type IntegrityReport struct {
+	// Nodes is the number of nodes in the graph, including tombstoned ones
+	Nodes int
+
+	// OrphanNodes have no incoming links at all. The entrypoint is never an
+	// orphan.
+	OrphanNodes []uint64
+
+	// BrokenLinks is the number of links which point at a missing node or at a
+	// node below the level of the link
+	BrokenLinks int
+
+	// UnreachableNodes can not be reached from the entrypoint on any level,
+	// thus they can never be returned by a search
+	UnreachableNodes []uint64
+
+	// EntrypointValid is false if the entrypoint does not exist or is not on
+	// the maximum layer of the graph
+	EntrypointValid bool
+
+	// Repaired is set if the damaged portions of the graph have been repaired.
+	// The remaining findings describe the graph before the repair.
+	Repaired bool
+
+	// nodesWithBrokenLinks is used to limit the repair to affected nodes
+	nodesWithBrokenLinks []uint64
+}
+
+// Healthy is true if none of the checks found a problem
+func (r IntegrityReport) Healthy() bool {
+	return len(r.OrphanNodes) == 0 && r.BrokenLinks == 0 &&
+		len(r.UnreachableNodes) == 0 && r.EntrypointValid
+}
+
+// CheckIntegrity validates the graph. It does not block writes, findings for
+// an index which is written to concurrently may thus include nodes which are
+// in the middle of being inserted.
+func (h *hnsw) CheckIntegrity() IntegrityReport {
+	h.RLock()
+	nodes := h.nodes
+	entrypoint := h.entryPointID
+	maxLayer := h.currentMaximumLayer
+	h.RUnlock()
+
+	report := IntegrityReport{EntrypointValid: true}
+
+	// copy the connections, so the node locks are not held for the entire check
+	connections := make(map[uint64][][]uint64)
+	levels := make(map[uint64]int)
+	for _, node := range nodes {
+		if node == nil {
+			continue
+		}
+
+		node.Lock()
+		conns := make([][]uint64, len(node.connections))
+		for level := range node.connections {
+			conns[level] = append([]uint64(nil), node.connections[level]...)
+		}
+		connections[node.id] = conns
+		levels[node.id] = node.level
+		node.Unlock()
+	}
+	report.Nodes = len(connections)
+
+	if report.Nodes == 0 {
+		return report
+	}
+
+	epLevel, ok := levels[entrypoint]
+	if !ok || epLevel != maxLayer {
+		report.EntrypointValid = false
+	}
+
+	incoming := make(map[uint64]int, len(connections))
+	for id, conns := range connections {
+		broken := false
+		for level, atLevel := range conns {
+			for _, target := range atLevel {
+				targetLevel, ok := levels[target]
+				if !ok || target == id || targetLevel < level {
+					report.BrokenLinks++
+					broken = true
+					continue
+				}
+				incoming[target]++
+			}
+		}
+		if broken {
+			report.nodesWithBrokenLinks = append(report.nodesWithBrokenLinks, id)
+		}
+	}
+
+	for id := range connections {
+		if id != entrypoint && incoming[id] == 0 {
+			report.OrphanNodes = append(report.OrphanNodes, id)
+		}
+	}
+
+	// every link is followed regardless of its level, a node which can not be
+	// reached this way can not be reached by any search either
+	visited := make(map[uint64]struct{}, len(connections))
+	if _, ok := connections[entrypoint]; ok {
+		queue := []uint64{entrypoint}
+		visited[entrypoint] = struct{}{}
+		for len(queue) > 0 {
+			current := queue[0]
+			queue = queue[1:]
+			for _, atLevel := range connections[current] {
+				for _, target := range atLevel {
+					if _, ok := connections[target]; !ok {
+						continue
+					}
+					if _, ok := visited[target]; ok {
+						continue
+					}
+					visited[target] = struct{}{}
+					queue = append(queue, target)
+				}
+			}
+		}
+	}
+
+	for id := range connections {
+		if _, ok := visited[id]; !ok {
+			report.UnreachableNodes = append(report.UnreachableNodes, id)
+		}
+	}
+
+	sortIDs(report.OrphanNodes)
+	sortIDs(report.UnreachableNodes)
+	sortIDs(report.nodesWithBrokenLinks)
+	return report
+}
+
+// RepairIntegrity checks the graph and repairs the damaged portions. Broken
+// links are removed, an invalid entrypoint is replaced and unreachable nodes
+// are reconnected as if they were inserted again. All changes are written
+// to the commit log, so they survive a restart.
+func (h *hnsw) RepairIntegrity() (IntegrityReport, error) {
+	// make sure the tombstone cleanup does not modify the graph concurrently
+	h.resetLock.Lock()
+	defer h.resetLock.Unlock()
+
+	report := h.CheckIntegrity()
+	if report.Healthy() {
+		return report, nil
+	}
+
+	for _, id := range report.nodesWithBrokenLinks {
+		if err := h.removeBrokenLinks(id); err != nil {
+			return report, errors.Wrapf(err, "remove broken links of node %d", id)
+		}
+	}
+
+	if !report.EntrypointValid {
+		if err := h.replaceInvalidEntrypoint(); err != nil {
+			return report, errors.Wrap(err, "replace invalid entrypoint")
+		}
+	}
+
+	denyList := h.tombstonesAsDenyList()
+	for _, id := range report.UnreachableNodes {
+		if denyList.Contains(id) {
+			// will be removed by the next tombstone cleanup anyway
+			continue
+		}
+
+		if err := h.reconnectNode(id, denyList); err != nil {
+			return report, errors.Wrapf(err, "reconnect node %d", id)
+		}
+	}
+
+	if err := h.commitLog.Flush(); err != nil {
+		return report, errors.Wrap(err, "flush commit log")
+	}
+
+	report.Repaired = true
+	return report, nil
+}
+
+func (h *hnsw) removeBrokenLinks(id uint64) error {
+	h.RLock()
+	node := h.nodes[id]
+	h.RUnlock()
+	if node == nil {
+		return nil
+	}
+
+	node.Lock()
+	conns := make([][]uint64, len(node.connections))
+	for l := range node.connections {
+		conns[l] = append([]uint64(nil), node.connections[l]...)
+	}
+	node.Unlock()
+
+	for l, atLevel := range conns {
+		valid := make([]uint64, 0, len(atLevel))
+		for _, target := range atLevel {
+			if h.linkIsValid(id, target, l) {
+				valid = append(valid, target)
+			}
+		}
+		if len(valid) == len(atLevel) {
+			continue
+		}
+
+		node.setConnectionsAtLevel(l, valid)
+		if err := h.commitLog.ReplaceLinksAtLevel(id, l, valid); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (h *hnsw) linkIsValid(source, target uint64, level int) bool {
+	if source == target {
+		return false
+	}
+
+	h.RLock()
+	defer h.RUnlock()
+
+	if target >= uint64(len(h.nodes)) || h.nodes[target] == nil {
+		return false
+	}
+
+	targetNode := h.nodes[target]
+	targetNode.Lock()
+	defer targetNode.Unlock()
+	return targetNode.level >= level
+}
+
+// replaceInvalidEntrypoint promotes the node with the highest level to
+// entrypoint, preferring nodes without a tombstone
+func (h *hnsw) replaceInvalidEntrypoint() error {
+	denyList := h.tombstonesAsDenyList()
+
+	h.Lock()
+	defer h.Unlock()
+
+	var candidate *vertex
+	candidateLevel := -1
+	candidateDeleted := true
+	for _, node := range h.nodes {
+		if node == nil {
+			continue
+		}
+
+		node.Lock()
+		level := node.level
+		node.Unlock()
+
+		deleted := denyList.Contains(node.id)
+		if candidateDeleted && !deleted || deleted == candidateDeleted && level > candidateLevel {
+			candidate = node
+			candidateLevel = level
+			candidateDeleted = deleted
+		}
+	}
+
+	if candidate == nil {
+		return nil
+	}
+
+	h.entryPointID = candidate.id
+	h.currentMaximumLayer = candidateLevel
+	return h.commitLog.SetEntryPointWithMaxLayer(candidate.id, candidateLevel)
+}
+
+// reconnectNode replaces all outgoing links of the node with links found by
+// searching the graph, like an insert would. Connecting the node also adds
+// links pointing back to it, which makes it reachable again.
+func (h *hnsw) reconnectNode(id uint64, denyList helpers.AllowList) error {
+	h.RLock()
+	node := h.nodes[id]
+	entrypoint := h.entryPointID
+	h.RUnlock()
+
+	if node == nil || id == entrypoint {
+		return nil
+	}
+
+	vec, err := h.nodeVector(id)
+	if err != nil {
+		var e storobj.ErrNotFound
+		if errors.As(err, &e) {
+			h.handleDeletedNode(e.DocID)
+			return nil
+		}
+		return errors.Wrap(err, "get vector")
+	}
+
+	return h.relink(node, vec, denyList)
+}
+
+func sortIDs(ids []uint64) {
+	sort.Slice(ids, func(a, b int) bool { return ids[a] < ids[b] })
+}
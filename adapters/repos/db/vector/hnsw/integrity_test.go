@@ -0,0 +1,120 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+)
+
+func TestIntegrity(t *testing.T) {
+	vectors, _ := testinghelpers.RandomVecs(300, 0, 16)
+
+	t.Run("an empty index is healthy", func(t *testing.T) {
+		index := newTestIndex(t, nil, withTestImport())
+		report := index.CheckIntegrity()
+		assert.True(t, report.Healthy())
+		assert.Equal(t, 0, report.Nodes)
+	})
+
+	t.Run("a freshly imported index is healthy", func(t *testing.T) {
+		index := newTestIndex(t, vectors, withTestImport())
+		report := index.CheckIntegrity()
+		assert.True(t, report.Healthy())
+		assert.Equal(t, len(vectors), report.Nodes)
+	})
+
+	t.Run("detecting and repairing broken links", func(t *testing.T) {
+		index := newTestIndex(t, vectors, withTestImport())
+		node := index.nodes[10]
+		node.connections[0] = append(node.connections[0], 10, 5000)
+
+		report := index.CheckIntegrity()
+		assert.False(t, report.Healthy())
+		assert.Equal(t, 2, report.BrokenLinks)
+
+		report, err := index.RepairIntegrity()
+		require.Nil(t, err)
+		assert.True(t, report.Repaired)
+		assert.Equal(t, 2, report.BrokenLinks)
+
+		assert.True(t, index.CheckIntegrity().Healthy())
+		assert.NotContains(t, node.connections[0], uint64(10))
+		assert.NotContains(t, node.connections[0], uint64(5000))
+	})
+
+	t.Run("detecting and repairing orphans", func(t *testing.T) {
+		index := newTestIndex(t, vectors, withTestImport())
+		orphan := uint64(42)
+		if index.entryPointID == orphan {
+			orphan++
+		}
+
+		// remove every link pointing to the node
+		for _, node := range index.nodes {
+			if node == nil {
+				continue
+			}
+			for level, conns := range node.connections {
+				filtered := make([]uint64, 0, len(conns))
+				for _, id := range conns {
+					if id != orphan {
+						filtered = append(filtered, id)
+					}
+				}
+				node.connections[level] = filtered
+			}
+		}
+
+		report := index.CheckIntegrity()
+		assert.False(t, report.Healthy())
+		assert.Contains(t, report.OrphanNodes, orphan)
+		assert.Contains(t, report.UnreachableNodes, orphan)
+
+		ids, _, err := index.SearchByVector(vectors[orphan], 1, nil)
+		require.Nil(t, err)
+		assert.NotEqual(t, []uint64{orphan}, ids)
+
+		_, err = index.RepairIntegrity()
+		require.Nil(t, err)
+
+		report = index.CheckIntegrity()
+		assert.NotContains(t, report.OrphanNodes, orphan)
+		assert.NotContains(t, report.UnreachableNodes, orphan)
+
+		ids, _, err = index.SearchByVector(vectors[orphan], 1, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{orphan}, ids)
+	})
+
+	t.Run("detecting and repairing an invalid entrypoint", func(t *testing.T) {
+		index := newTestIndex(t, vectors, withTestImport())
+		maxLayer := index.currentMaximumLayer
+		index.entryPointID = 5000
+
+		report := index.CheckIntegrity()
+		assert.False(t, report.EntrypointValid)
+
+		_, err := index.RepairIntegrity()
+		require.Nil(t, err)
+
+		assert.True(t, index.CheckIntegrity().EntrypointValid)
+		assert.Equal(t, maxLayer, index.currentMaximumLayer)
+
+		ids, _, err := index.SearchByVector(vectors[0], 1, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{0}, ids)
+	})
+}
@@ -0,0 +1,128 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+	"math/rand"
+
+	"github.com/pkg/errors"
+)
+
+// RecallReport contains the result of a recall self-test of an index
+type RecallReport struct {
+	// SampleSize is the number of nodes which were used as queries. It is
+	// lower than requested if the index holds fewer nodes.
+	SampleSize int
+
+	// K is the number of results requested per query
+	K int
+
+	// EF is the search time ef the approximate searches were run with
+	EF int
+
+	// Recall is the share of the exact top k results which were also
+	// returned by the approximate search, averaged over all queries. It is 1
+	// for an empty index.
+	Recall float64
+}
+
+// MeasureRecall samples up to sampleSize nodes of the index and uses their
+// vectors as queries. The results of a regular search with the current ef
+// settings are compared to those of an exact search to measure recall@k.
+//
+// The searches are not recorded in the access log and do not feed the
+// adaptive ef.
+func (h *hnsw) MeasureRecall(ctx context.Context, sampleSize, k int) (RecallReport, error) {
+	if sampleSize <= 0 || k <= 0 {
+		return RecallReport{}, errors.Errorf(
+			"sample size and k must be positive, got %d and %d", sampleSize, k)
+	}
+
+	report := RecallReport{K: k, EF: h.searchTimeEF(k), Recall: 1}
+
+	sample := h.sampleNodes(sampleSize)
+	var found, relevant int
+	for _, id := range sample {
+		if err := ctx.Err(); err != nil {
+			return RecallReport{}, err
+		}
+
+		vec, err := h.vectorForID(ctx, id)
+		if err != nil || len(vec) == 0 {
+			// the node was deleted after it was sampled
+			continue
+		}
+
+		truth, _, err := h.SearchByVectorExact(vec, k, nil)
+		if err != nil {
+			return RecallReport{}, errors.Wrapf(err, "exact search for node %d", id)
+		}
+		res, _, err := h.searchByVector(ctx, vec, k, nil, nil)
+		if err != nil {
+			return RecallReport{}, errors.Wrapf(err, "search for node %d", id)
+		}
+
+		relevant += len(truth)
+		found += matchingIDs(truth, res)
+		report.SampleSize++
+	}
+
+	if relevant > 0 {
+		report.Recall = float64(found) / float64(relevant)
+	}
+	return report, nil
+}
+
+// sampleNodes returns up to n random ids of nodes which exist and are not
+// tombstoned, using reservoir sampling so that the memory required only
+// depends on n
+func (h *hnsw) sampleNodes(n int) []uint64 {
+	h.RLock()
+	defer h.RUnlock()
+
+	sample := make([]uint64, 0, n)
+	seen := 0
+	for i := range h.nodes {
+		id := uint64(i)
+		h.shardedNodeLocks[id%NodeLockStripe].RLock()
+		exists := h.nodes[id] != nil
+		h.shardedNodeLocks[id%NodeLockStripe].RUnlock()
+		if !exists || h.hasTombstone(id) {
+			continue
+		}
+
+		seen++
+		if len(sample) < n {
+			sample = append(sample, id)
+		} else if pos := rand.Intn(seen); pos < n {
+			sample[pos] = id
+		}
+	}
+
+	return sample
+}
+
+func matchingIDs(truth, res []uint64) int {
+	expected := make(map[uint64]struct{}, len(truth))
+	for _, id := range truth {
+		expected[id] = struct{}{}
+	}
+
+	matches := 0
+	for _, id := range res {
+		if _, ok := expected[id]; ok {
+			matches++
+		}
+	}
+	return matches
+}
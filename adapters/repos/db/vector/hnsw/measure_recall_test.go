@@ -0,0 +1,85 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+)
+
+func TestMeasureRecall(t *testing.T) {
+	ctx := context.Background()
+	vectors, _ := testinghelpers.RandomVecs(500, 0, 16)
+
+	t.Run("an empty index has perfect recall", func(t *testing.T) {
+		index := newTestIndex(t, nil, withTestImport())
+		report, err := index.MeasureRecall(ctx, 10, 5)
+		require.Nil(t, err)
+		assert.Equal(t, 0, report.SampleSize)
+		assert.Equal(t, float64(1), report.Recall)
+	})
+
+	t.Run("invalid parameters", func(t *testing.T) {
+		index := newTestIndex(t, nil, withTestImport())
+		_, err := index.MeasureRecall(ctx, 0, 5)
+		assert.NotNil(t, err)
+		_, err = index.MeasureRecall(ctx, 10, 0)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("a healthy index", func(t *testing.T) {
+		index := newTestIndex(t, vectors, withTestImport())
+		report, err := index.MeasureRecall(ctx, 50, 10)
+		require.Nil(t, err)
+		assert.Equal(t, 50, report.SampleSize)
+		assert.Equal(t, 10, report.K)
+		assert.Equal(t, index.searchTimeEF(10), report.EF)
+		assert.Greater(t, report.Recall, 0.9)
+	})
+
+	t.Run("the sample size is capped by the size of the index", func(t *testing.T) {
+		index := newTestIndex(t, vectors[:20], withTestImport())
+		report, err := index.MeasureRecall(ctx, 50, 5)
+		require.Nil(t, err)
+		assert.Equal(t, 20, report.SampleSize)
+	})
+
+	t.Run("deleted nodes are not sampled", func(t *testing.T) {
+		index := newTestIndex(t, vectors, withTestImport())
+		for i := 0; i < len(vectors); i += 2 {
+			require.Nil(t, index.Delete(uint64(i)))
+		}
+
+		sample := index.sampleNodes(len(vectors))
+		assert.Len(t, sample, len(vectors)/2)
+		for _, id := range sample {
+			assert.Equal(t, uint64(1), id%2)
+		}
+
+		report, err := index.MeasureRecall(ctx, 50, 10)
+		require.Nil(t, err)
+		assert.Equal(t, 50, report.SampleSize)
+		assert.Greater(t, report.Recall, 0.9)
+	})
+
+	t.Run("a cancelled context", func(t *testing.T) {
+		index := newTestIndex(t, vectors[:20], withTestImport())
+		cancelled, cancel := context.WithCancel(ctx)
+		cancel()
+		_, err := index.MeasureRecall(cancelled, 10, 5)
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
@@ -27,7 +27,9 @@ type Metrics struct {
 	delete           prometheus.Gauge
 	deleteTime       prometheus.ObserverVec
 	cleaned          prometheus.Counter
+	cleanupRate      prometheus.Gauge
 	size             prometheus.Gauge
+	adaptiveEF       prometheus.Gauge
 	grow             prometheus.Observer
 	startupProgress  prometheus.Gauge
 	startupDurations prometheus.ObserverVec
@@ -61,6 +63,11 @@ func NewMetrics(prom *monitoring.PrometheusMetrics,
 		"shard_name": shardName,
 	})
 
+	cleanupRate := prom.VectorIndexTombstoneCleanupRate.With(prometheus.Labels{
+		"class_name": className,
+		"shard_name": shardName,
+	})
+
 	insert := prom.VectorIndexOperations.With(prometheus.Labels{
 		"class_name": className,
 		"shard_name": shardName,
@@ -107,6 +114,11 @@ func NewMetrics(prom *monitoring.PrometheusMetrics,
 		"shard_name": shardName,
 	})
 
+	adaptiveEF := prom.VectorIndexAdaptiveEF.With(prometheus.Labels{
+		"class_name": className,
+		"shard_name": shardName,
+	})
+
 	startupDiskIO := prom.StartupDiskIO.MustCurryWith(prometheus.Labels{
 		"class_name": className,
 		"shard_name": shardName,
@@ -117,11 +129,13 @@ func NewMetrics(prom *monitoring.PrometheusMetrics,
 		tombstones:       tombstones,
 		threads:          threads,
 		cleaned:          cleaned,
+		cleanupRate:      cleanupRate,
 		insert:           insert,
 		insertTime:       insertTime,
 		delete:           del,
 		deleteTime:       deleteTime,
 		size:             size,
+		adaptiveEF:       adaptiveEF,
 		grow:             grow,
 		startupProgress:  startupProgress,
 		startupDurations: startupDurations,
@@ -169,6 +183,14 @@ func (m *Metrics) CleanedUp() {
 	m.cleaned.Inc()
 }
 
+func (m *Metrics) SetCleanupThroughput(perSecond float64) {
+	if !m.enabled {
+		return
+	}
+
+	m.cleanupRate.Set(perSecond)
+}
+
 func (m *Metrics) InsertVector() {
 	if !m.enabled {
 		return
@@ -193,6 +215,14 @@ func (m *Metrics) SetSize(size int) {
 	m.size.Set(float64(size))
 }
 
+func (m *Metrics) SetAdaptiveEF(ef int) {
+	if !m.enabled {
+		return
+	}
+
+	m.adaptiveEF.Set(float64(ef))
+}
+
 func (m *Metrics) GrowDuration(start time.Time) {
 	if !m.enabled {
 		return
@@ -13,6 +13,7 @@ package hnsw
 
 import (
 	"context"
+	"sync/atomic"
 	"time"
 
 	"github.com/pkg/errors"
@@ -77,7 +78,8 @@ func (n *neighborFinderConnector) doAtLevel(level int) error {
 	eps := priorityqueue.NewMin(1)
 	eps.Insert(n.entryPointID, n.entryPointDist)
 
-	results, err := n.graph.searchLayerByVector(n.nodeVec, eps, n.graph.efConstruction,
+	results, err := n.graph.searchLayerByVector(n.nodeVec, eps,
+		int(atomic.LoadInt64(&n.graph.efConstruction)),
 		level, nil)
 	if err != nil {
 		return errors.Wrapf(err, "search layer at level %d", level)
@@ -87,7 +89,7 @@ func (n *neighborFinderConnector) doAtLevel(level int) error {
 	before = time.Now()
 
 	// max := n.maximumConnections(level)
-	max := n.graph.maximumConnections
+	max := int(atomic.LoadInt64(&n.graph.maximumConnections))
 	if err := n.graph.selectNeighborsHeuristic(results, max, n.denyList); err != nil {
 		return errors.Wrap(err, "heuristic")
 	}
@@ -101,6 +103,11 @@ func (n *neighborFinderConnector) doAtLevel(level int) error {
 	neighbors := make([]uint64, 0, results.Len())
 	for results.Len() > 0 {
 		id := results.Pop().ID
+		if id == n.node.id {
+			// a node which is relinked can be found through the links of its
+			// neighbors, but must not be linked to itself
+			continue
+		}
 		neighbors = append(neighbors, id)
 	}
 
@@ -228,10 +235,10 @@ func (n *neighborFinderConnector) skipNeighbor(neighbor *vertex) bool {
 
 func (n *neighborFinderConnector) maximumConnections(level int) int {
 	if level == 0 {
-		return n.graph.maximumConnectionsLayerZero
+		return int(atomic.LoadInt64(&n.graph.maximumConnectionsLayerZero))
 	}
 
-	return n.graph.maximumConnections
+	return int(atomic.LoadInt64(&n.graph.maximumConnections))
 }
 
 func (n *neighborFinderConnector) pickEntrypoint() error {
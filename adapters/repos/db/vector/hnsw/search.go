@@ -16,6 +16,7 @@ import (
 	"fmt"
 	"math"
 	"sync/atomic"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
@@ -23,11 +24,16 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/priorityqueue"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/visited"
 	ssdhelpers "github.com/weaviate/weaviate/adapters/repos/db/vector/ssdhelpers"
+	"github.com/weaviate/weaviate/entities/searchparams"
 	"github.com/weaviate/weaviate/entities/storobj"
 	"github.com/weaviate/weaviate/usecases/floatcomp"
 )
 
 func (h *hnsw) searchTimeEF(k int) int {
+	if h.adaptiveEF.enabled.Load() {
+		return h.adaptiveEF.searchEF(k)
+	}
+
 	// load atomically, so we can get away with concurrent updates of the
 	// userconfig without having to set a lock each time we try to read - which
 	// can be so common that it would cause considerable overhead
@@ -62,6 +68,35 @@ func (h *hnsw) autoEfFromK(k int) int {
 }
 
 func (h *hnsw) SearchByVector(vector []float32, k int, allowList helpers.AllowList) ([]uint64, []float32, error) {
+	return h.SearchByVectorWithRescore(vector, k, allowList, nil)
+}
+
+// SearchByVectorWithRescore searches like SearchByVector, but lets the query
+// override how many candidates of a compressed index are retrieved and
+// rescored. A nil rescore uses the settings of the index.
+func (h *hnsw) SearchByVectorWithRescore(vector []float32, k int, allowList helpers.AllowList,
+	rescore *searchparams.Rescore,
+) ([]uint64, []float32, error) {
+	return h.SearchByVectorWithContext(context.Background(), vector, k, allowList, rescore)
+}
+
+// SearchByVectorWithContext searches like SearchByVectorWithRescore, but
+// stops traversing the graph once the context is done, e.g. because the
+// query ran into its timeout. The error of the context is returned in that
+// case, there are no partial results.
+func (h *hnsw) SearchByVectorWithContext(ctx context.Context, vector []float32, k int,
+	allowList helpers.AllowList, rescore *searchparams.Rescore,
+) ([]uint64, []float32, error) {
+	ids, dists, err := h.searchByVector(ctx, vector, k, allowList, rescore)
+	if err == nil && h.accessLog.enabled.Load() {
+		h.accessLog.record(ids)
+	}
+	return ids, dists, err
+}
+
+func (h *hnsw) searchByVector(ctx context.Context, vector []float32, k int,
+	allowList helpers.AllowList, rescore *searchparams.Rescore,
+) ([]uint64, []float32, error) {
 	h.compressActionLock.RLock()
 	defer h.compressActionLock.RUnlock()
 
@@ -73,9 +108,30 @@ func (h *hnsw) SearchByVector(vector []float32, k int, allowList helpers.AllowLi
 
 	flatSearchCutoff := int(atomic.LoadInt64(&h.flatSearchCutoff))
 	if allowList != nil && !h.forbidFlat && allowList.Len() < flatSearchCutoff {
+		// the flat search is short, it is only skipped if the query is done
+		// already
+		if err := ctx.Err(); err != nil {
+			return nil, nil, err
+		}
 		return h.flatSearch(vector, k, allowList)
 	}
-	return h.knnSearchByVector(vector, k, h.searchTimeEF(k), allowList)
+
+	if h.adaptiveEF.enabled.Load() {
+		defer h.recordSearchLatency(time.Now())
+	}
+	ef := h.searchTimeEF(k)
+	if rescore != nil && h.compressed.Load() {
+		// oversampling widens the search to have more candidates to rescore
+		ef = h.searchTimeEF(rescore.Candidates(k))
+	}
+	return h.knnSearchByVectorWithRescore(ctx, vector, k, ef, allowList, rescore)
+}
+
+// recordSearchLatency feeds the latency of a search into the adaptive ef
+func (h *hnsw) recordSearchLatency(start time.Time) {
+	if ef, adjusted := h.adaptiveEF.recordSince(start); adjusted {
+		h.metrics.SetAdaptiveEF(ef)
+	}
 }
 
 // SearchByVectorDistance wraps SearchByVector, and calls it recursively until
@@ -157,25 +213,57 @@ func (h *hnsw) SearchByVectorDistance(vector []float32, targetDistance float32,
 	return resultIDs, resultDist, nil
 }
 
-func (h *hnsw) shouldRescore() bool {
-	return h.compressed.Load() && !h.doNotRescore
+func (h *hnsw) shouldRescore(rescore *searchparams.Rescore) bool {
+	if !h.compressed.Load() || h.doNotRescore {
+		return false
+	}
+
+	if _, ok := h.quantizer.(*ssdhelpers.ScalarQuantizer); ok {
+		return atomic.LoadInt64(&h.sqRescoreLimit) > 0 ||
+			(rescore != nil && rescore.Limit > 0)
+	}
+	return true
+}
+
+// rescoreLimit is the number of results which are rescored with the
+// uncompressed vectors. The product quantizer rescores all ef results, the
+// scalar quantizer is precise enough to only rescore the best ones. A query
+// can override the limit, oversampling never rescores fewer than the
+// oversampled candidates.
+func (h *hnsw) rescoreLimit(k, ef int, rescore *searchparams.Rescore) int {
+	limit := ef
+	if _, ok := h.quantizer.(*ssdhelpers.ScalarQuantizer); ok {
+		limit = int(atomic.LoadInt64(&h.sqRescoreLimit))
+	}
+
+	limit = rescore.RescoreLimit(k, limit)
+	if limit < k {
+		limit = k
+	}
+	return limit
 }
 
 func (h *hnsw) searchLayerByVector(queryVector []float32,
 	entrypoints *priorityqueue.Queue, ef int, level int,
 	allowList helpers.AllowList) (*priorityqueue.Queue, error,
 ) {
-	var byteDistancer *ssdhelpers.PQDistancer
+	var byteDistancer ssdhelpers.QuantizerDistancer
 	if h.compressed.Load() {
-		byteDistancer = h.pq.NewDistancer(queryVector)
-		defer h.pq.ReturnDistancer(byteDistancer)
+		byteDistancer = h.quantizer.NewQuantizerDistancer(queryVector)
+		defer h.quantizer.ReturnQuantizerDistancer(byteDistancer)
 	}
-	return h.searchLayerByVectorWithDistancer(queryVector, entrypoints, ef, level, allowList, byteDistancer)
+	return h.searchLayerByVectorWithDistancer(context.Background(), queryVector,
+		entrypoints, ef, level, allowList, byteDistancer)
 }
 
-func (h *hnsw) searchLayerByVectorWithDistancer(queryVector []float32,
+// ctxCheckInterval is the number of candidates a layer search evaluates
+// between checks whether its context is done. Checking on every candidate
+// would add noticeable overhead to the hot loop.
+const ctxCheckInterval = 64
+
+func (h *hnsw) searchLayerByVectorWithDistancer(ctx context.Context, queryVector []float32,
 	entrypoints *priorityqueue.Queue, ef int, level int,
-	allowList helpers.AllowList, byteDistancer *ssdhelpers.PQDistancer) (*priorityqueue.Queue, error,
+	allowList helpers.AllowList, byteDistancer ssdhelpers.QuantizerDistancer) (*priorityqueue.Queue, error,
 ) {
 	h.pools.visitedListsLock.Lock()
 	visited := h.pools.visitedLists.Borrow()
@@ -185,8 +273,8 @@ func (h *hnsw) searchLayerByVectorWithDistancer(queryVector []float32,
 	results := h.pools.pqResults.GetMax(ef)
 	var floatDistancer distancer.Distancer
 	if h.compressed.Load() {
-		byteDistancer = h.pq.NewDistancer(queryVector)
-		defer h.pq.ReturnDistancer(byteDistancer)
+		byteDistancer = h.quantizer.NewQuantizerDistancer(queryVector)
+		defer h.quantizer.ReturnQuantizerDistancer(byteDistancer)
 	} else {
 		floatDistancer = h.distancerProvider.New(queryVector)
 	}
@@ -194,6 +282,39 @@ func (h *hnsw) searchLayerByVectorWithDistancer(queryVector []float32,
 	h.insertViableEntrypointsAsCandidatesAndResults(entrypoints, candidates,
 		results, level, visited, allowList)
 
+	acorn := level == 0 && h.useAcorn(allowList)
+	if acorn {
+		// seed the traversal with allowed nodes, see acornSeeds
+		it := allowList.LimitedIterator(acornSeeds)
+		for id, ok := it.Next(); ok; id, ok = it.Next() {
+			if visited.Visited(id) || h.nodeByID(id) == nil || h.hasTombstone(id) {
+				continue
+			}
+			visited.Visit(id)
+
+			var distance float32
+			var found bool
+			var err error
+			if h.compressed.Load() {
+				distance, found, err = h.distanceToByteNode(byteDistancer, id)
+			} else {
+				distance, found, err = h.distanceToFloatNode(floatDistancer, id)
+			}
+			if err != nil {
+				return nil, errors.Wrap(err, "calculate distance between seed and query")
+			}
+			if !found {
+				continue
+			}
+
+			candidates.Insert(id, distance)
+			results.Insert(id, distance)
+			if results.Len() > ef {
+				results.Pop()
+			}
+		}
+	}
+
 	var worstResultDistance float32
 	var err error
 	if h.compressed.Load() {
@@ -204,9 +325,22 @@ func (h *hnsw) searchLayerByVectorWithDistancer(queryVector []float32,
 	if err != nil {
 		return nil, errors.Wrapf(err, "calculate distance of current last result")
 	}
-	connectionsReusable := make([]uint64, h.maximumConnectionsLayerZero)
+	// read the limit once, it may be updated concurrently
+	maximumConnectionsLayerZero := int(atomic.LoadInt64(&h.maximumConnectionsLayerZero))
+	connectionsReusable := make([]uint64, maximumConnectionsLayerZero)
+	var acornReusable []uint64
+	if acorn {
+		acornReusable = make([]uint64, 0, maximumConnectionsLayerZero)
+	}
+
+	var ctxErr error
+	for iteration := 0; candidates.Len() > 0; iteration++ {
+		if iteration%ctxCheckInterval == 0 {
+			if ctxErr = ctx.Err(); ctxErr != nil {
+				break
+			}
+		}
 
-	for candidates.Len() > 0 {
 		var dist float32
 		candidate := candidates.Pop()
 		dist = candidate.Dist
@@ -235,7 +369,7 @@ func (h *hnsw) searchLayerByVectorWithDistancer(queryVector []float32,
 			continue
 		}
 
-		if len(candidateNode.connections[level]) > h.maximumConnectionsLayerZero {
+		if len(candidateNode.connections[level]) > maximumConnectionsLayerZero {
 			// How is it possible that we could ever have more connections than the
 			// allowed maximum? It is not anymore, but there was a bug that allowed
 			// this to happen in versions prior to v1.12.0:
@@ -255,7 +389,14 @@ func (h *hnsw) searchLayerByVectorWithDistancer(queryVector []float32,
 		copy(connectionsReusable, candidateNode.connections[level])
 		candidateNode.Unlock()
 
-		for _, neighborID := range connectionsReusable {
+		neighbors := connectionsReusable
+		if acorn {
+			acornReusable = h.acornNeighbors(connectionsReusable, allowList, visited,
+				maximumConnectionsLayerZero, acornReusable)
+			neighbors = acornReusable
+		}
+
+		for _, neighborID := range neighbors {
 
 			if ok := visited.Visited(neighborID); ok {
 				// skip if we've already visited this neighbor
@@ -323,6 +464,11 @@ func (h *hnsw) searchLayerByVectorWithDistancer(queryVector []float32,
 	h.pools.visitedLists.Return(visited)
 	h.pools.visitedListsLock.Unlock()
 
+	if ctxErr != nil {
+		h.pools.pqResults.Put(results)
+		return nil, ctxErr
+	}
+
 	return results, nil
 }
 
@@ -378,7 +524,7 @@ func (h *hnsw) currentWorstResultDistanceToFloat(results *priorityqueue.Queue,
 }
 
 func (h *hnsw) currentWorstResultDistanceToByte(results *priorityqueue.Queue,
-	distancer *ssdhelpers.PQDistancer,
+	distancer ssdhelpers.QuantizerDistancer,
 ) (float32, error) {
 	if results.Len() > 0 {
 		item := results.Top()
@@ -405,7 +551,7 @@ func (h *hnsw) currentWorstResultDistanceToByte(results *priorityqueue.Queue,
 	}
 }
 
-func (h *hnsw) distanceToByteNode(distancer *ssdhelpers.PQDistancer,
+func (h *hnsw) distanceToByteNode(distancer ssdhelpers.QuantizerDistancer,
 	nodeID uint64,
 ) (float32, bool, error) {
 	vec, err := h.compressedVectorsCache.get(context.Background(), nodeID)
@@ -422,7 +568,7 @@ func (h *hnsw) distanceToByteNode(distancer *ssdhelpers.PQDistancer,
 	return distancer.Distance(vec)
 }
 
-func (h *hnsw) distanceFromBytesToFloatNode(concreteDistancer *ssdhelpers.PQDistancer, nodeID uint64) (float32, bool, error) {
+func (h *hnsw) distanceFromBytesToFloatNode(concreteDistancer ssdhelpers.QuantizerDistancer, nodeID uint64) (float32, bool, error) {
 	slice := h.pools.tempVectors.Get(int(h.dims))
 	defer h.pools.tempVectors.Put(slice)
 	vec, err := h.TempVectorForIDThunk(context.Background(), nodeID, slice)
@@ -445,6 +591,12 @@ func (h *hnsw) distanceFromBytesToFloatNode(concreteDistancer *ssdhelpers.PQDist
 func (h *hnsw) distanceToFloatNode(distancer distancer.Distancer,
 	nodeID uint64,
 ) (float32, bool, error) {
+	if h.float16Cache != nil {
+		if float16Distancer, ok := asFloat16Distancer(distancer); ok {
+			return h.distanceToFloat16Node(float16Distancer, nodeID)
+		}
+	}
+
 	candidateVec, err := h.vectorForID(context.Background(), nodeID)
 	if err != nil {
 		var e storobj.ErrNotFound
@@ -465,6 +617,37 @@ func (h *hnsw) distanceToFloatNode(distancer distancer.Distancer,
 	return dist, true, nil
 }
 
+func asFloat16Distancer(d distancer.Distancer) (distancer.Float16Distancer, bool) {
+	float16Distancer, ok := d.(distancer.Float16Distancer)
+	return float16Distancer, ok
+}
+
+// distanceToFloat16Node calculates the distance directly against the float16
+// vector in the cache, so that the hot search path does not need to convert
+// every candidate back to float32
+func (h *hnsw) distanceToFloat16Node(distancer distancer.Float16Distancer,
+	nodeID uint64,
+) (float32, bool, error) {
+	candidateVec, err := h.float16Cache.getFloat16(context.Background(), nodeID)
+	if err != nil {
+		var e storobj.ErrNotFound
+		if errors.As(err, &e) {
+			h.handleDeletedNode(e.DocID)
+			return 0, false, nil
+		} else {
+			// not a typed error, we can recover from, return with err
+			return 0, false, errors.Wrapf(err, "get vector of docID %d", nodeID)
+		}
+	}
+
+	dist, _, err := distancer.DistanceToFloat16(candidateVec)
+	if err != nil {
+		return 0, false, errors.Wrap(err, "calculate distance between candidate and query")
+	}
+
+	return dist, true, nil
+}
+
 // the underlying object seems to have been deleted, to recover from
 // this situation let's add a tombstone to the deleted object, so it
 // will be cleaned up and skip this candidate in the current search
@@ -484,6 +667,12 @@ func (h *hnsw) handleDeletedNode(docID uint64) {
 
 func (h *hnsw) knnSearchByVector(searchVec []float32, k int,
 	ef int, allowList helpers.AllowList,
+) ([]uint64, []float32, error) {
+	return h.knnSearchByVectorWithRescore(context.Background(), searchVec, k, ef, allowList, nil)
+}
+
+func (h *hnsw) knnSearchByVectorWithRescore(ctx context.Context, searchVec []float32, k int,
+	ef int, allowList helpers.AllowList, rescore *searchparams.Rescore,
 ) ([]uint64, []float32, error) {
 	if h.isEmpty() {
 		return nil, nil, nil
@@ -508,17 +697,17 @@ func (h *hnsw) knnSearchByVector(searchVec []float32, k int,
 			"it has been flagged for cleanup and should be fixed in the next cleanup cycle")
 	}
 
-	var byteDistancer *ssdhelpers.PQDistancer
+	var byteDistancer ssdhelpers.QuantizerDistancer
 	if h.compressed.Load() {
-		byteDistancer = h.pq.NewDistancer(searchVec)
-		defer h.pq.ReturnDistancer(byteDistancer)
+		byteDistancer = h.quantizer.NewQuantizerDistancer(searchVec)
+		defer h.quantizer.ReturnQuantizerDistancer(byteDistancer)
 	}
 	// stop at layer 1, not 0!
 	for level := maxLayer; level >= 1; level-- {
 		eps := priorityqueue.NewMin(10)
 		eps.Insert(entryPointID, entryPointDistance)
 
-		res, err := h.searchLayerByVectorWithDistancer(searchVec, eps, 1, level, nil, byteDistancer)
+		res, err := h.searchLayerByVectorWithDistancer(ctx, searchVec, eps, 1, level, nil, byteDistancer)
 		if err != nil {
 			return nil, nil, errors.Wrapf(err, "knn search: search layer at level %d", level)
 		}
@@ -561,12 +750,18 @@ func (h *hnsw) knnSearchByVector(searchVec []float32, k int,
 
 	eps := priorityqueue.NewMin(10)
 	eps.Insert(entryPointID, entryPointDistance)
-	res, err := h.searchLayerByVectorWithDistancer(searchVec, eps, ef, 0, allowList, byteDistancer)
+	res, err := h.searchLayerByVectorWithDistancer(ctx, searchVec, eps, ef, 0, allowList, byteDistancer)
 	if err != nil {
 		return nil, nil, errors.Wrapf(err, "knn search: search layer at level %d", 0)
 	}
 
-	if h.shouldRescore() {
+	if h.shouldRescore(rescore) {
+		// the results are a max heap, popping drops the worst ones
+		limit := h.rescoreLimit(k, ef, rescore)
+		for res.Len() > limit {
+			res.Pop()
+		}
+
 		ids := make([]uint64, res.Len())
 		i := len(ids) - 1
 		for res.Len() > 0 {
@@ -89,3 +89,43 @@ func TestNilCheckOnPartiallyCleanedNode(t *testing.T) {
 		assert.True(t, ok)
 	})
 }
+
+func TestSearchByVectorWithContext(t *testing.T) {
+	vectors := [][]float32{{1, 1}, {2, 2}, {3, 3}, {4, 4}}
+
+	index, err := New(Config{
+		RootPath:              "doesnt-matter-as-committlogger-is-mocked-out",
+		ID:                    "search-with-context",
+		MakeCommitLoggerThunk: MakeNoopCommitLogger,
+		DistanceProvider:      distancer.NewL2SquaredProvider(),
+		VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+			return vectors[int(id)], nil
+		},
+	}, ent.UserConfig{
+		MaxConnections:        30,
+		EFConstruction:        128,
+		VectorCacheMaxObjects: 100000,
+	},
+		cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop())
+	require.Nil(t, err)
+
+	for i, vec := range vectors {
+		require.Nil(t, index.Add(uint64(i), vec))
+	}
+
+	t.Run("with an active context", func(t *testing.T) {
+		res, _, err := index.SearchByVectorWithContext(context.Background(),
+			[]float32{1.1, 1.1}, 2, nil, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{0, 1}, res)
+	})
+
+	t.Run("with a cancelled context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		res, _, err := index.SearchByVectorWithContext(ctx, []float32{1.1, 1.1}, 2, nil, nil)
+		require.ErrorIs(t, err, context.Canceled)
+		assert.Nil(t, res)
+	})
+}
@@ -0,0 +1,477 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"hash/crc32"
+	"io"
+	"math"
+	"os"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/visited"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+// A snapshot is a portable copy of the graph and the vectors of an index. It
+// can be imported into an empty index, so that an index which was built
+// offline can be attached without inserting the vectors again. Unlike a
+// checkpoint it contains the vectors, as they are otherwise only held by the
+// objects of a shard.
+//
+// The file starts with a fixed-size header, followed by the body:
+//
+//	magic          [4]byte "HNSW"
+//	version        uint8
+//	dimensions     uint32
+//	graphLength    uint64
+//	vectorCount    uint64
+//	checksum       uint32 (crc32 of the body)
+//
+//	distance       uint8 length + name of the distance metric
+//	graph          graphLength bytes in the commit log format
+//	vectors        vectorCount times: id uint64, dimensions times float32
+//
+// The vectors are stored uncompressed, a compressed index can be exported,
+// but the imported index is uncompressed.
+const (
+	snapshotVersion    = uint8(1)
+	snapshotHeaderSize = 4 + 1 + 4 + 8 + 8 + 4
+)
+
+var snapshotMagic = [4]byte{'H', 'N', 'S', 'W'}
+
+// SnapshotInfo describes an exported or imported snapshot
+type SnapshotInfo struct {
+	// Nodes is the number of nodes in the graph, including tombstoned ones
+	Nodes int
+
+	// Dimensions of the vectors, zero for an empty index
+	Dimensions int
+
+	// Distance is the name of the distance metric of the index
+	Distance string
+}
+
+type snapshotHeader struct {
+	dimensions  uint32
+	graphLength uint64
+	vectorCount uint64
+	checksum    uint32
+}
+
+func (h snapshotHeader) bytes() []byte {
+	out := make([]byte, snapshotHeaderSize)
+	copy(out[0:4], snapshotMagic[:])
+	out[4] = snapshotVersion
+	binary.LittleEndian.PutUint32(out[5:9], h.dimensions)
+	binary.LittleEndian.PutUint64(out[9:17], h.graphLength)
+	binary.LittleEndian.PutUint64(out[17:25], h.vectorCount)
+	binary.LittleEndian.PutUint32(out[25:29], h.checksum)
+	return out
+}
+
+func parseSnapshotHeader(in []byte) (snapshotHeader, error) {
+	if [4]byte(in[0:4]) != snapshotMagic {
+		return snapshotHeader{}, errors.New("not an hnsw snapshot")
+	}
+	if in[4] != snapshotVersion {
+		return snapshotHeader{}, errors.Errorf("unsupported snapshot version %d", in[4])
+	}
+
+	return snapshotHeader{
+		dimensions:  binary.LittleEndian.Uint32(in[5:9]),
+		graphLength: binary.LittleEndian.Uint64(in[9:17]),
+		vectorCount: binary.LittleEndian.Uint64(in[17:25]),
+		checksum:    binary.LittleEndian.Uint32(in[25:29]),
+	}, nil
+}
+
+// ExportSnapshot writes the graph and the vectors of the index to a snapshot
+// at path. Inserts and deletes are blocked while the graph is copied, but not
+// while the vectors are written.
+func (h *hnsw) ExportSnapshot(ctx context.Context, path string) (SnapshotInfo, error) {
+	state := h.copyGraph()
+
+	vectors := make(map[uint64][]float32, len(state.Nodes))
+	dims := 0
+	for _, node := range state.Nodes {
+		if node == nil {
+			continue
+		}
+		if err := ctx.Err(); err != nil {
+			return SnapshotInfo{}, err
+		}
+
+		vec, err := h.vectorForID(ctx, node.id)
+		if err != nil {
+			var e storobj.ErrNotFound
+			if errors.As(err, &e) {
+				// the object has been deleted, but the node has not been
+				// tombstoned yet
+				state.Tombstones[node.id] = struct{}{}
+				continue
+			}
+			return SnapshotInfo{}, errors.Wrapf(err, "get vector of node %d", node.id)
+		}
+		if dims == 0 {
+			dims = len(vec)
+		} else if len(vec) != dims {
+			return SnapshotInfo{}, errors.Errorf("node %d has %d dimensions, expected %d",
+				node.id, len(vec), dims)
+		}
+		vectors[node.id] = vec
+	}
+
+	info := SnapshotInfo{
+		Nodes:      len(state.Nodes) - countNil(state.Nodes),
+		Dimensions: dims,
+		Distance:   h.distancerProvider.Type(),
+	}
+	if err := h.writeSnapshot(path, state, vectors, info); err != nil {
+		return SnapshotInfo{}, err
+	}
+	return info, nil
+}
+
+// copyGraph returns a copy of the graph, which is decoupled from the locks
+// of the index
+func (h *hnsw) copyGraph() *DeserializationResult {
+	h.deleteVsInsertLock.Lock()
+	defer h.deleteVsInsertLock.Unlock()
+
+	h.RLock()
+	nodes := make([]*vertex, len(h.nodes))
+	for i, node := range h.nodes {
+		if node == nil {
+			continue
+		}
+
+		node.Lock()
+		conns := make([][]uint64, len(node.connections))
+		for level := range node.connections {
+			conns[level] = append([]uint64(nil), node.connections[level]...)
+		}
+		nodes[i] = &vertex{id: node.id, level: node.level, connections: conns}
+		node.Unlock()
+	}
+	state := &DeserializationResult{
+		Nodes:             nodes,
+		Entrypoint:        h.entryPointID,
+		Level:             uint16(h.currentMaximumLayer),
+		EntrypointChanged: true,
+	}
+	h.RUnlock()
+
+	h.tombstoneLock.RLock()
+	state.Tombstones = make(map[uint64]struct{}, len(h.tombstones))
+	for id := range h.tombstones {
+		state.Tombstones[id] = struct{}{}
+	}
+	h.tombstoneLock.RUnlock()
+
+	return state
+}
+
+func (h *hnsw) writeSnapshot(path string, state *DeserializationResult,
+	vectors map[uint64][]float32, info SnapshotInfo,
+) error {
+	tmpPath := path + ".tmp"
+	f, err := os.OpenFile(tmpPath, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0o666)
+	if err != nil {
+		return errors.Wrapf(err, "create snapshot %q", tmpPath)
+	}
+	defer f.Close()
+
+	// the header is written last, once the lengths and checksum of the body
+	// are known
+	if _, err := f.Seek(snapshotHeaderSize, io.SeekStart); err != nil {
+		return errors.Wrapf(err, "seek snapshot %q", tmpPath)
+	}
+
+	w := NewWriterSize(f, 1*1024*1024)
+	w.WriteByte(uint8(len(info.Distance)))
+	w.WriteString(info.Distance)
+	if err := w.Flush(); err != nil {
+		return errors.Wrapf(err, "write snapshot %q", tmpPath)
+	}
+	graphStart, err := f.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return errors.Wrapf(err, "seek snapshot %q", tmpPath)
+	}
+
+	c := &MemoryCondensor{logger: h.logger, newLogFile: f, newLog: w}
+	if err := c.writeState(state, true); err != nil {
+		return errors.Wrapf(err, "write graph to snapshot %q", tmpPath)
+	}
+	if err := w.Flush(); err != nil {
+		return errors.Wrapf(err, "write snapshot %q", tmpPath)
+	}
+	graphEnd, err := f.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return errors.Wrapf(err, "seek snapshot %q", tmpPath)
+	}
+
+	buf := make([]byte, 8+4*info.Dimensions)
+	for _, node := range state.Nodes {
+		if node == nil {
+			continue
+		}
+		vec, ok := vectors[node.id]
+		if !ok {
+			continue
+		}
+
+		binary.LittleEndian.PutUint64(buf[0:8], node.id)
+		for i, v := range vec {
+			binary.LittleEndian.PutUint32(buf[8+4*i:], math.Float32bits(v))
+		}
+		w.Write(buf)
+	}
+	if err := w.Flush(); err != nil {
+		return errors.Wrapf(err, "write vectors to snapshot %q", tmpPath)
+	}
+
+	if _, err := f.Seek(snapshotHeaderSize, io.SeekStart); err != nil {
+		return errors.Wrapf(err, "seek snapshot %q", tmpPath)
+	}
+	hash := crc32.NewIEEE()
+	if _, err := io.Copy(hash, bufio.NewReaderSize(f, 256*1024)); err != nil {
+		return errors.Wrapf(err, "checksum snapshot %q", tmpPath)
+	}
+
+	header := snapshotHeader{
+		dimensions:  uint32(info.Dimensions),
+		graphLength: uint64(graphEnd - graphStart),
+		vectorCount: uint64(len(vectors)),
+		checksum:    hash.Sum32(),
+	}
+	if _, err := f.WriteAt(header.bytes(), 0); err != nil {
+		return errors.Wrapf(err, "write header of snapshot %q", tmpPath)
+	}
+
+	if err := f.Sync(); err != nil {
+		return errors.Wrapf(err, "sync snapshot %q", tmpPath)
+	}
+	if err := f.Close(); err != nil {
+		return errors.Wrapf(err, "close snapshot %q", tmpPath)
+	}
+
+	return os.Rename(tmpPath, path)
+}
+
+// ImportSnapshot replaces the graph of an empty, uncompressed index with the
+// snapshot at path. The graph is written to the commit log and the vectors
+// are loaded into the vector cache. Vectors which are evicted from the cache
+// are read from the objects again, so the objects must exist under the ids
+// of the nodes.
+func (h *hnsw) ImportSnapshot(ctx context.Context, path string) (SnapshotInfo, error) {
+	state, vectors, info, err := h.readSnapshot(path)
+	if err != nil {
+		return SnapshotInfo{}, err
+	}
+	if err := ctx.Err(); err != nil {
+		return SnapshotInfo{}, err
+	}
+
+	if info.Distance != h.distancerProvider.Type() {
+		return SnapshotInfo{}, errors.Errorf("snapshot uses distance %q, index uses %q",
+			info.Distance, h.distancerProvider.Type())
+	}
+	if dims := atomic.LoadInt32(&h.dims); dims != 0 && info.Dimensions != 0 &&
+		int(dims) != info.Dimensions {
+		return SnapshotInfo{}, errors.Errorf("snapshot has %d dimensions, index has %d",
+			info.Dimensions, dims)
+	}
+
+	err = h.attachGraph(state, info.Dimensions, func() {
+		for id, vec := range vectors {
+			h.cache.preload(id, vec)
+		}
+	})
+	if err != nil {
+		return SnapshotInfo{}, errors.Wrap(err, "import snapshot")
+	}
+
+	return info, nil
+}
+
+// attachGraph persists state to the commit log and makes it the graph of the
+// index, which has to be empty and uncompressed. preload is called to fill the
+// vector cache once it has been grown to the size of the graph.
+func (h *hnsw) attachGraph(state *DeserializationResult, dims int, preload func()) error {
+	h.deleteVsInsertLock.Lock()
+	defer h.deleteVsInsertLock.Unlock()
+
+	if h.compressed.Load() {
+		return errors.New("cannot attach a graph to a compressed index")
+	}
+	if !h.isEmpty() {
+		return errors.New("cannot attach a graph to a non-empty index")
+	}
+
+	if err := h.persistSnapshot(state); err != nil {
+		return err
+	}
+
+	h.Lock()
+	h.nodes = state.Nodes
+	h.entryPointID = state.Entrypoint
+	h.currentMaximumLayer = int(state.Level)
+	h.Unlock()
+
+	h.tombstoneLock.Lock()
+	h.tombstones = state.Tombstones
+	h.tombstoneLock.Unlock()
+
+	if dims > 0 {
+		h.trackDimensionsOnce.Do(func() {
+			atomic.StoreInt32(&h.dims, int32(dims))
+		})
+	}
+	h.cache.grow(uint64(len(state.Nodes)))
+	preload()
+
+	// make sure the visited list pool fits the current size
+	h.pools.visitedLists.Destroy()
+	h.pools.visitedLists = visited.NewPool(1, len(state.Nodes)+512)
+
+	return nil
+}
+
+func (h *hnsw) readSnapshot(path string,
+) (*DeserializationResult, map[uint64][]float32, SnapshotInfo, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, nil, SnapshotInfo{}, errors.Wrapf(err, "open snapshot %q", path)
+	}
+	defer f.Close()
+
+	r := bufio.NewReaderSize(f, 256*1024)
+	headerBytes := make([]byte, snapshotHeaderSize)
+	if _, err := io.ReadFull(r, headerBytes); err != nil {
+		return nil, nil, SnapshotInfo{}, errors.Wrapf(err, "read header of snapshot %q", path)
+	}
+	header, err := parseSnapshotHeader(headerBytes)
+	if err != nil {
+		return nil, nil, SnapshotInfo{}, errors.Wrapf(err, "snapshot %q", path)
+	}
+
+	hash := crc32.NewIEEE()
+	body := io.TeeReader(r, hash)
+
+	var distLen [1]byte
+	if _, err := io.ReadFull(body, distLen[:]); err != nil {
+		return nil, nil, SnapshotInfo{}, errors.Wrapf(err, "read distance of snapshot %q", path)
+	}
+	dist := make([]byte, distLen[0])
+	if _, err := io.ReadFull(body, dist); err != nil {
+		return nil, nil, SnapshotInfo{}, errors.Wrapf(err, "read distance of snapshot %q", path)
+	}
+
+	graph := &io.LimitedReader{R: body, N: int64(header.graphLength)}
+	state, _, err := NewDeserializer(h.logger).
+		Do(bufio.NewReaderSize(graph, 256*1024), nil, false)
+	if err != nil {
+		return nil, nil, SnapshotInfo{}, errors.Wrapf(err, "deserialize graph of snapshot %q", path)
+	}
+	if graph.N != 0 {
+		return nil, nil, SnapshotInfo{}, errors.Errorf("snapshot %q is corrupt: "+
+			"%d bytes of the graph missing", path, graph.N)
+	}
+
+	vectors := make(map[uint64][]float32, header.vectorCount)
+	buf := make([]byte, 8+4*int(header.dimensions))
+	for i := uint64(0); i < header.vectorCount; i++ {
+		if _, err := io.ReadFull(body, buf); err != nil {
+			return nil, nil, SnapshotInfo{}, errors.Wrapf(err, "read vectors of snapshot %q", path)
+		}
+		vec := make([]float32, header.dimensions)
+		for j := range vec {
+			vec[j] = math.Float32frombits(binary.LittleEndian.Uint32(buf[8+4*j:]))
+		}
+		vectors[binary.LittleEndian.Uint64(buf[0:8])] = vec
+	}
+
+	if n, _ := io.Copy(io.Discard, r); n != 0 || hash.Sum32() != header.checksum {
+		return nil, nil, SnapshotInfo{}, errors.Errorf("snapshot %q is corrupt: "+
+			"%d trailing bytes, checksum %d, expected %d", path, n, hash.Sum32(), header.checksum)
+	}
+
+	if state.Tombstones == nil {
+		state.Tombstones = map[uint64]struct{}{}
+	}
+	for _, node := range state.Nodes {
+		if node == nil {
+			continue
+		}
+		if _, ok := vectors[node.id]; !ok {
+			if _, ok := state.Tombstones[node.id]; !ok {
+				return nil, nil, SnapshotInfo{}, errors.Errorf("snapshot %q is corrupt: "+
+					"no vector for node %d", path, node.id)
+			}
+		}
+	}
+
+	return state, vectors, SnapshotInfo{
+		Nodes:      len(state.Nodes) - countNil(state.Nodes),
+		Dimensions: int(header.dimensions),
+		Distance:   string(dist),
+	}, nil
+}
+
+// persistSnapshot writes the imported graph to the commit log, so that it is
+// restored on startup
+func (h *hnsw) persistSnapshot(state *DeserializationResult) error {
+	for _, node := range state.Nodes {
+		if node == nil {
+			continue
+		}
+
+		if err := h.commitLog.AddNode(node); err != nil {
+			return errors.Wrapf(err, "write node %d to commit log", node.id)
+		}
+		for level, links := range node.connections {
+			if err := h.commitLog.ReplaceLinksAtLevel(node.id, level, links); err != nil {
+				return errors.Wrapf(err,
+					"write links for node %d at level %d to commit log", node.id, level)
+			}
+		}
+	}
+
+	if err := h.commitLog.SetEntryPointWithMaxLayer(state.Entrypoint,
+		int(state.Level)); err != nil {
+		return errors.Wrap(err, "write entrypoint to commit log")
+	}
+
+	for id := range state.Tombstones {
+		if err := h.commitLog.AddTombstone(id); err != nil {
+			return errors.Wrapf(err, "write tombstone for node %d to commit log", id)
+		}
+	}
+
+	return h.commitLog.Flush()
+}
+
+func countNil(nodes []*vertex) int {
+	count := 0
+	for _, node := range nodes {
+		if node == nil {
+			count++
+		}
+	}
+	return count
+}
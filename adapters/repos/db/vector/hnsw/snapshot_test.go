@@ -0,0 +1,127 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+)
+
+func TestSnapshot(t *testing.T) {
+	ctx := context.Background()
+	vectors, queries := testinghelpers.RandomVecs(300, 10, 16)
+	path := filepath.Join(t.TempDir(), "index.snapshot")
+
+	source := newTestIndex(t, vectors, withTestRootPath(t.TempDir(), "snapshot-test"), withTestCommitLog())
+	defer source.Shutdown(ctx)
+	for i, vec := range vectors {
+		require.Nil(t, source.Add(uint64(i), vec))
+	}
+	require.Nil(t, source.Delete(7, 42))
+
+	t.Run("exporting the index", func(t *testing.T) {
+		info, err := source.ExportSnapshot(ctx, path)
+		require.Nil(t, err)
+		assert.Equal(t, SnapshotInfo{Nodes: 300, Dimensions: 16, Distance: "l2-squared"}, info)
+	})
+
+	targetPath := t.TempDir()
+	t.Run("importing into an empty index", func(t *testing.T) {
+		target := newTestIndex(t, vectors, withTestRootPath(targetPath, "snapshot-test"), withTestCommitLog())
+		info, err := target.ImportSnapshot(ctx, path)
+		require.Nil(t, err)
+		assert.Equal(t, 300, info.Nodes)
+
+		assert.True(t, target.hasTombstone(7))
+		assert.True(t, target.hasTombstone(42))
+		for _, q := range queries {
+			expected, _, err := source.SearchByVector(q, 10, nil)
+			require.Nil(t, err)
+			actual, _, err := target.SearchByVector(q, 10, nil)
+			require.Nil(t, err)
+			assert.Equal(t, expected, actual)
+		}
+		require.Nil(t, target.Shutdown(ctx))
+	})
+
+	t.Run("the imported graph is restored on startup", func(t *testing.T) {
+		target := newTestIndex(t, vectors, withTestRootPath(targetPath, "snapshot-test"), withTestCommitLog())
+		defer target.Shutdown(ctx)
+		assert.False(t, target.isEmpty())
+		assert.True(t, target.hasTombstone(42))
+		for _, q := range queries {
+			expected, _, err := source.SearchByVector(q, 10, nil)
+			require.Nil(t, err)
+			actual, _, err := target.SearchByVector(q, 10, nil)
+			require.Nil(t, err)
+			assert.Equal(t, expected, actual)
+		}
+
+		_, err := target.ImportSnapshot(ctx, path)
+		assert.ErrorContains(t, err, "non-empty index")
+	})
+
+	t.Run("importing into an index with a different distance", func(t *testing.T) {
+		target := newTestIndex(t, vectors, withTestRootPath(t.TempDir(), "snapshot-test"), withTestCommitLog(),
+			withTestDistancer(distancer.NewCosineDistanceProvider()))
+		defer target.Shutdown(ctx)
+		_, err := target.ImportSnapshot(ctx, path)
+		assert.ErrorContains(t, err, "distance")
+	})
+
+	t.Run("importing a corrupt snapshot", func(t *testing.T) {
+		data, err := os.ReadFile(path)
+		require.Nil(t, err)
+		data[len(data)-1] ^= 0xff
+		corrupt := filepath.Join(t.TempDir(), "corrupt.snapshot")
+		require.Nil(t, os.WriteFile(corrupt, data, 0o666))
+
+		target := newTestIndex(t, vectors, withTestRootPath(t.TempDir(), "snapshot-test"), withTestCommitLog())
+		defer target.Shutdown(ctx)
+		_, err = target.ImportSnapshot(ctx, corrupt)
+		assert.ErrorContains(t, err, "corrupt")
+		assert.True(t, target.isEmpty())
+	})
+
+	t.Run("importing a file which is not a snapshot", func(t *testing.T) {
+		other := filepath.Join(t.TempDir(), "other")
+		require.Nil(t, os.WriteFile(other, make([]byte, 64), 0o666))
+
+		target := newTestIndex(t, vectors, withTestRootPath(t.TempDir(), "snapshot-test"), withTestCommitLog())
+		defer target.Shutdown(ctx)
+		_, err := target.ImportSnapshot(ctx, other)
+		assert.ErrorContains(t, err, "not an hnsw snapshot")
+	})
+
+	t.Run("exporting and importing an empty index", func(t *testing.T) {
+		emptyPath := filepath.Join(t.TempDir(), "empty.snapshot")
+		empty := newTestIndex(t, nil, withTestRootPath(t.TempDir(), "snapshot-test"), withTestCommitLog())
+		defer empty.Shutdown(ctx)
+		info, err := empty.ExportSnapshot(ctx, emptyPath)
+		require.Nil(t, err)
+		assert.Equal(t, 0, info.Nodes)
+
+		target := newTestIndex(t, vectors, withTestRootPath(t.TempDir(), "snapshot-test"), withTestCommitLog())
+		defer target.Shutdown(ctx)
+		_, err = target.ImportSnapshot(ctx, emptyPath)
+		require.Nil(t, err)
+		assert.True(t, target.isEmpty())
+		require.Nil(t, target.Add(0, vectors[0]))
+	})
+}
@@ -25,14 +25,26 @@ import (
 	ssdhelpers "github.com/weaviate/weaviate/adapters/repos/db/vector/ssdhelpers"
 	"github.com/weaviate/weaviate/entities/cyclemanager"
 	"github.com/weaviate/weaviate/entities/diskio"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 )
 
 func (h *hnsw) init(cfg Config) error {
-	h.pools = newPools(h.maximumConnectionsLayerZero)
+	h.pools = newPools(int(h.maximumConnectionsLayerZero))
 
 	if err := h.restoreFromDisk(); err != nil {
 		return errors.Wrapf(err, "restore hnsw index %q", cfg.ID)
 	}
+	if err := h.restoreBuilt(); err != nil {
+		return errors.Wrapf(err, "restore hnsw index %q", cfg.ID)
+	}
+
+	if h.accessLog.enabled.Load() {
+		ids, err := readAccessLog(accessLogPath(h.rootPath, h.id))
+		if err != nil {
+			return errors.Wrapf(err, "restore access log of hnsw index %q", cfg.ID)
+		}
+		h.accessLog.restore(ids)
+	}
 
 	// init commit logger for future writes
 	cl, err := cfg.MakeCommitLoggerThunk()
@@ -62,7 +74,20 @@ func (h *hnsw) restoreFromDisk() error {
 		return err
 	}
 
-	if len(fileNames) == 0 {
+	// with a checkpoint only the logs after it need to be replayed
+	state, coveredThrough, err := readCheckpoint(checkpointPath(h.rootPath, h.id), h.logger)
+	if err != nil {
+		return errors.Wrap(err, "restore checkpoint")
+	}
+
+	if state != nil {
+		fileNames, err = commitLogsAfterCheckpoint(fileNames, coveredThrough)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(fileNames) == 0 && state == nil {
 		// nothing to do
 		return nil
 	}
@@ -72,7 +97,6 @@ func (h *hnsw) restoreFromDisk() error {
 		return errors.Wrap(err, "corrupted commit log fixer")
 	}
 
-	var state *DeserializationResult
 	for i, fileName := range fileNames {
 		beforeIndividual := time.Now()
 
@@ -136,14 +160,31 @@ func (h *hnsw) restoreFromDisk() error {
 		}
 		h.cache.drop()
 
-		h.pq, err = ssdhelpers.NewProductQuantizerWithEncoders(
-			h.pqConfig,
-			h.distancerProvider,
-			int(state.PQData.Dimensions),
-			state.PQData.Encoders,
-		)
-		if err != nil {
-			return errors.Wrap(err, "Restoring PQ data.")
+		if state.SQCompressed {
+			h.quantizer, err = ssdhelpers.NewScalarQuantizerWithData(
+				h.sqConfig,
+				h.distancerProvider,
+				state.SQData,
+			)
+			if err != nil {
+				return errors.Wrap(err, "Restoring SQ data.")
+			}
+		} else {
+			// segments default to the dimensions at the time of the compression,
+			// which is also the case for an automatically trained index
+			pqConfig := h.pqConfig
+			if pqConfig.Segments <= 0 {
+				pqConfig.Segments = int(state.PQData.M)
+			}
+			h.quantizer, err = ssdhelpers.NewProductQuantizerWithEncoders(
+				pqConfig,
+				h.distancerProvider,
+				int(state.PQData.Dimensions),
+				state.PQData.Encoders,
+			)
+			if err != nil {
+				return errors.Wrap(err, "Restoring PQ data.")
+			}
 		}
 
 		// make sure the compressed cache fits the current size
@@ -177,6 +218,7 @@ func (h *hnsw) tombstoneCleanup(shouldAbort cyclemanager.ShouldAbortCallback) bo
 // getVectorForID.
 func (h *hnsw) PostStartup() {
 	h.prefillCache()
+	h.configurePQAutoTrain(h.pqConfig)
 }
 
 func (h *hnsw) prefillCache() {
@@ -210,7 +252,11 @@ func (h *hnsw) prefillCache() {
 			}
 			cursor.Close()
 		} else {
-			err = newVectorCachePrefiller(h.cache, h, h.logger).Prefill(ctx, limit)
+			policy := ent.VectorCachePrefillUpperLayers
+			if h.accessLog.enabled.Load() {
+				policy = ent.VectorCachePrefillRecentlyAccessed
+			}
+			err = newVectorCachePrefiller(h.cache, h, h.logger, policy).Prefill(ctx, limit)
 		}
 
 		if err != nil {
@@ -0,0 +1,111 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// TombstoneCleanupStatus describes the state of the tombstone cleanup of an
+// index. The counters are kept in memory and start over when the index is
+// loaded.
+type TombstoneCleanupStatus struct {
+	Paused                     bool
+	Concurrency                int
+	RemainingTombstones        int
+	RemovedTombstones          int64
+	LastCycleRemovedTombstones int
+	LastCycleDuration          time.Duration
+	LastCycleAt                time.Time
+}
+
+// LastCycleThroughput is the number of tombstones removed per second by the
+// last completed cycle
+func (s TombstoneCleanupStatus) LastCycleThroughput() float64 {
+	return cleanupThroughput(s.LastCycleRemovedTombstones, s.LastCycleDuration)
+}
+
+func cleanupThroughput(removed int, took time.Duration) float64 {
+	if took <= 0 {
+		return 0
+	}
+	return float64(removed) / took.Seconds()
+}
+
+type tombstoneCleanupStats struct {
+	sync.Mutex
+	removed      int64
+	lastRemoved  int
+	lastDuration time.Duration
+	lastAt       time.Time
+}
+
+func (s *tombstoneCleanupStats) cycleCompleted(removed int, took time.Duration,
+	metrics *Metrics,
+) {
+	s.Lock()
+	defer s.Unlock()
+
+	s.removed += int64(removed)
+	s.lastRemoved = removed
+	s.lastDuration = took
+	s.lastAt = time.Now()
+	metrics.SetCleanupThroughput(cleanupThroughput(removed, took))
+}
+
+// PauseTombstoneCleanup stops the scheduled tombstone cleanup of the index. A
+// running cycle is aborted, the call returns once it has stopped. The pause
+// is not persisted.
+func (h *hnsw) PauseTombstoneCleanup(ctx context.Context) error {
+	h.cleanupPaused.Store(true)
+	if err := h.tombstoneCleanupCallbackCtrl.Deactivate(ctx); err != nil {
+		h.cleanupPaused.Store(false)
+		return errors.Wrap(err, "pause tombstone cleanup")
+	}
+	return nil
+}
+
+// ResumeTombstoneCleanup schedules the tombstone cleanup of the index again
+// after it has been paused
+func (h *hnsw) ResumeTombstoneCleanup() error {
+	if err := h.tombstoneCleanupCallbackCtrl.Activate(); err != nil {
+		return errors.Wrap(err, "resume tombstone cleanup")
+	}
+	h.cleanupPaused.Store(false)
+	return nil
+}
+
+// TombstoneCleanupStatus returns whether the cleanup is paused, how many
+// tombstones are left and the statistics of the last completed cycle
+func (h *hnsw) TombstoneCleanupStatus() TombstoneCleanupStatus {
+	h.tombstoneLock.RLock()
+	remaining := len(h.tombstones)
+	h.tombstoneLock.RUnlock()
+
+	h.cleanupStats.Lock()
+	defer h.cleanupStats.Unlock()
+
+	return TombstoneCleanupStatus{
+		Paused:                     h.cleanupPaused.Load(),
+		Concurrency:                int(atomic.LoadInt64(&h.cleanupConcurrency)),
+		RemainingTombstones:        remaining,
+		RemovedTombstones:          h.cleanupStats.removed,
+		LastCycleRemovedTombstones: h.cleanupStats.lastRemoved,
+		LastCycleDuration:          h.cleanupStats.lastDuration,
+		LastCycleAt:                h.cleanupStats.lastAt,
+	}
+}
@@ -0,0 +1,118 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestTombstoneCleanup_ConcurrentWithControls(t *testing.T) {
+	vectors := vectorsForDeleteTest()
+	logger, _ := test.NewNullLogger()
+	tombstoneCallbacks := cyclemanager.NewCallbackGroup("tombstone_cleanup", logger, 1)
+
+	index, err := New(Config{
+		RootPath:              "doesnt-matter-as-committlogger-is-mocked-out",
+		ID:                    "tombstone-cleanup-test",
+		MakeCommitLoggerThunk: MakeNoopCommitLogger,
+		DistanceProvider:      distancer.NewCosineDistanceProvider(),
+		VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+			return vectors[int(id)], nil
+		},
+		TempVectorForIDThunk: TempVectorForIDThunk(vectors),
+	}, ent.UserConfig{
+		MaxConnections:        30,
+		EFConstruction:        128,
+		CleanupConcurrency:    4,
+		VectorCacheMaxObjects: 100000,
+	}, tombstoneCallbacks, cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop())
+	require.Nil(t, err)
+
+	for i, vec := range vectors {
+		require.Nil(t, index.Add(uint64(i), vec))
+	}
+
+	deleteRange := func(from, to int) {
+		for i := from; i < to; i++ {
+			if i%2 == 0 {
+				require.Nil(t, index.Delete(uint64(i)))
+			}
+		}
+	}
+
+	t.Run("cleanup with multiple workers", func(t *testing.T) {
+		deleteRange(0, len(vectors)/2)
+		deleted := index.TombstoneCleanupStatus().RemainingTombstones
+		require.Greater(t, deleted, 0)
+
+		require.Nil(t, index.CleanUpTombstonedNodes(func() bool { return false }))
+
+		status := index.TombstoneCleanupStatus()
+		assert.False(t, status.Paused)
+		assert.Equal(t, 4, status.Concurrency)
+		assert.Equal(t, 0, status.RemainingTombstones)
+		assert.Equal(t, int64(deleted), status.RemovedTombstones)
+		assert.Equal(t, deleted, status.LastCycleRemovedTombstones)
+		assert.False(t, status.LastCycleAt.IsZero())
+
+		res, _, err := index.SearchByVector([]float32{0.1, 0.1, 0.1}, 20, nil)
+		require.Nil(t, err)
+		require.Len(t, res, 20)
+		for _, id := range res {
+			if int(id) < len(vectors)/2 {
+				assert.NotZero(t, id%2, "deleted node %d must not be returned", id)
+			}
+		}
+	})
+
+	t.Run("paused cleanup keeps the tombstones", func(t *testing.T) {
+		require.Nil(t, index.PauseTombstoneCleanup(context.Background()))
+		assert.True(t, index.TombstoneCleanupStatus().Paused)
+		assert.False(t, index.tombstoneCleanupCallbackCtrl.IsActive())
+
+		deleteRange(len(vectors)/2, len(vectors))
+		remaining := index.TombstoneCleanupStatus().RemainingTombstones
+		require.Greater(t, remaining, 0)
+
+		require.Nil(t, index.CleanUpTombstonedNodes(func() bool { return false }))
+		assert.Equal(t, remaining, index.TombstoneCleanupStatus().RemainingTombstones)
+	})
+
+	t.Run("resumed cleanup removes the tombstones", func(t *testing.T) {
+		require.Nil(t, index.ResumeTombstoneCleanup())
+		assert.True(t, index.tombstoneCleanupCallbackCtrl.IsActive())
+
+		before := index.TombstoneCleanupStatus()
+		require.Nil(t, index.CleanUpTombstonedNodes(func() bool { return false }))
+
+		status := index.TombstoneCleanupStatus()
+		assert.False(t, status.Paused)
+		assert.Equal(t, 0, status.RemainingTombstones)
+		assert.Equal(t, before.RemovedTombstones+int64(before.RemainingTombstones),
+			status.RemovedTombstones)
+	})
+
+	t.Run("concurrency can be updated", func(t *testing.T) {
+		uc := ent.NewDefaultUserConfig()
+		uc.CleanupConcurrency = 2
+		require.Nil(t, index.UpdateUserConfig(uc, func() {}))
+		assert.Equal(t, 2, index.TombstoneCleanupStatus().Concurrency)
+	})
+}
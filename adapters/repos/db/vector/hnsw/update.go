@@ -0,0 +1,118 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+)
+
+// Update replaces the vector of a node without assigning it a new id. The
+// node keeps its level, but its outgoing connections are recomputed for the
+// new vector. Incoming connections of other nodes are kept, they still lead
+// to the node, they were just selected for its previous vector. Ids which are
+// not part of the graph yet are simply added, deleted ones can't be updated.
+func (h *hnsw) Update(id uint64, vector []float32) error {
+	if len(vector) == 0 {
+		return errors.Errorf("update called with nil-vector")
+	}
+
+	if h.hasTombstone(id) {
+		return errors.Errorf("cannot update deleted node %d", id)
+	}
+
+	node := h.nodeByID(id)
+	if node == nil || h.deferInserts() {
+		return h.Add(id, vector)
+	}
+
+	if h.distancerProvider.Type() == "cosine-dot" {
+		// cosine-dot requires normalized vectors, as the dot product and cosine
+		// similarity are only identical if the vector is normalized
+		vector = distancer.Normalize(vector)
+	}
+
+	h.compressActionLock.RLock()
+	defer h.compressActionLock.RUnlock()
+
+	h.deleteVsInsertLock.RLock()
+	defer h.deleteVsInsertLock.RUnlock()
+
+	h.resetLock.RLock()
+	defer h.resetLock.RUnlock()
+
+	// the vector source of the index might not be updated yet, so make sure
+	// the new vector is used from now on
+	if h.compressed.Load() {
+		compressed := h.quantizer.Encode(vector)
+		h.storeCompressedVector(id, compressed)
+		h.compressedVectorsCache.preload(id, compressed)
+	} else {
+		h.cache.preload(id, vector)
+	}
+
+	if h.isOnlyNode(node, helpers.NewAllowList()) {
+		// there is nothing to connect to
+		return nil
+	}
+
+	return h.relink(node, vector, helpers.NewAllowList())
+}
+
+// relink replaces all outgoing links of the node with links to the nearest
+// neighbors of the given vector, like an insert would. Connecting the node
+// also adds links pointing back to it. Nodes in the denyList are not linked.
+func (h *hnsw) relink(node *vertex, vec []float32, denyList helpers.AllowList) error {
+	h.RLock()
+	currentEntrypoint := h.entryPointID
+	currentMaximumLayer := h.currentMaximumLayer
+	h.RUnlock()
+
+	node.Lock()
+	level := node.level
+	node.Unlock()
+
+	entryPointID, err := h.findBestEntrypointForNode(currentMaximumLayer, level,
+		currentEntrypoint, vec)
+	if err != nil {
+		return errors.Wrap(err, "find best entrypoint")
+	}
+
+	if entryPointID == node.id {
+		// the node can't be used to find its own neighbors
+		tmpDenyList := denyList.DeepCopy()
+		tmpDenyList.Insert(node.id)
+
+		alternative, alternativeLevel := h.findNewLocalEntrypoint(tmpDenyList,
+			currentMaximumLayer, entryPointID)
+		if alternativeLevel < level {
+			level = alternativeLevel
+		}
+		entryPointID = alternative
+	}
+
+	node.markAsMaintenance()
+	defer node.unmarkAsMaintenance()
+
+	node.Lock()
+	for l := range node.connections {
+		node.connections[l] = node.connections[l][:0]
+	}
+	node.Unlock()
+	if err := h.commitLog.ClearLinks(node.id); err != nil {
+		return err
+	}
+
+	return h.findAndConnectNeighbors(node, entryPointID, vec, level,
+		currentMaximumLayer, denyList)
+}
@@ -0,0 +1,78 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+)
+
+func TestUpdate(t *testing.T) {
+	// the last vector is not imported, it is used to test updates of unknown ids
+	vectors, updates := testinghelpers.RandomVecs(501, 100, 16)
+	imported := len(vectors) - 1
+
+	index := newTestIndex(t, vectors)
+	for i := 0; i < imported; i++ {
+		require.Nil(t, index.Add(uint64(i), vectors[i]))
+	}
+
+	t.Run("updated nodes are found by their new vector", func(t *testing.T) {
+		entrypoint := index.getEntrypoint()
+		ids := []uint64{entrypoint}
+		for i := 0; i < len(updates)-1; i++ {
+			if uint64(i) != entrypoint {
+				ids = append(ids, uint64(i))
+			}
+		}
+
+		for i, id := range ids {
+			// the vector source is updated before the index, like the object store
+			vectors[id] = updates[i]
+			require.Nil(t, index.Update(id, updates[i]))
+		}
+
+		for i, id := range ids {
+			results, _, err := index.SearchByVector(updates[i], 1, nil)
+			require.Nil(t, err)
+			assert.Equal(t, []uint64{id}, results)
+		}
+
+		// like inserts, relinking can leave a node without incoming links, but
+		// it must never link to missing nodes
+		report := index.CheckIntegrity()
+		assert.Zero(t, report.BrokenLinks)
+		assert.True(t, report.EntrypointValid)
+		assert.Equal(t, imported, report.Nodes)
+	})
+
+	t.Run("unknown ids are added", func(t *testing.T) {
+		id := uint64(imported)
+		require.Nil(t, index.Update(id, vectors[id]))
+
+		results, _, err := index.SearchByVector(vectors[id], 1, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{id}, results)
+		assert.Equal(t, imported+1, index.CheckIntegrity().Nodes)
+	})
+
+	t.Run("deleted ids can't be updated", func(t *testing.T) {
+		require.Nil(t, index.Delete(1))
+
+		err := index.Update(1, vectors[1])
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "cannot update deleted node 1")
+	})
+}
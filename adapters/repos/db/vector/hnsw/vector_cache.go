@@ -28,6 +28,7 @@ type shardedLockCache struct {
 	vectorForID         VectorForID
 	normalizeOnRead     bool
 	maxSize             int64
+	maxBytes            int64
 	count               int64
 	cancel              chan bool
 	logger              logrus.FieldLogger
@@ -226,7 +227,7 @@ func (s *shardedLockCache) watchForDeletion() {
 }
 
 func (s *shardedLockCache) replaceIfFull() {
-	if atomic.LoadInt64(&s.count) >= atomic.LoadInt64(&s.maxSize) {
+	if atomic.LoadInt64(&s.count) >= s.copyMaxSize() {
 		s.maintenanceLock.Lock()
 		s.deleteAllVectors()
 		s.maintenanceLock.Unlock()
@@ -257,10 +258,60 @@ func (s *shardedLockCache) updateMaxSize(size int64) {
 	atomic.StoreInt64(&s.maxSize, size)
 }
 
+// copyMaxSize returns the maximum number of vectors the cache holds, which
+// is lower than the configured maximum if the byte budget is exceeded first
+//
 //nolint:unused
 func (s *shardedLockCache) copyMaxSize() int64 {
-	sizeCopy := atomic.LoadInt64(&s.maxSize)
-	return sizeCopy
+	return effectiveMaxSize(atomic.LoadInt64(&s.maxSize),
+		atomic.LoadInt64(&s.maxBytes), s.vectorBytes())
+}
+
+//nolint:unused
+func (s *shardedLockCache) updateMaxBytes(size int64) {
+	atomic.StoreInt64(&s.maxBytes, size)
+}
+
+//nolint:unused
+func (s *shardedLockCache) copyMaxBytes() int64 {
+	return atomic.LoadInt64(&s.maxBytes)
+}
+
+// vectorBytes is the footprint of a single cached vector, float32 takes 4 bytes per dimension
+func (s *shardedLockCache) vectorBytes() int64 {
+	return vectorFootprint(atomic.LoadInt32(&s.dims), 4)
+}
+
+//nolint:unused
+func (s *shardedLockCache) estimatedBytes() int64 {
+	return atomic.LoadInt64(&s.count) * s.vectorBytes()
+}
+
+// vectorSliceOverhead is the size of the slice header kept for every cached
+// vector, it is part of the footprint regardless of the type of the vector
+const vectorSliceOverhead = int64(unsafe.Sizeof([]float32{}))
+
+// vectorFootprint returns the bytes used by a cached vector of the given
+// dimensions, 0 if the dimensions are not known yet
+func vectorFootprint(dims int32, bytesPerDimension int64) int64 {
+	if dims <= 0 {
+		return 0
+	}
+	return int64(dims)*bytesPerDimension + vectorSliceOverhead
+}
+
+// effectiveMaxSize converts a byte budget into a number of vectors. The budget
+// is ignored as long as the footprint of a vector is not known, or if it is
+// not set at all.
+func effectiveMaxSize(maxSize, maxBytes, vectorBytes int64) int64 {
+	if maxBytes <= 0 || vectorBytes <= 0 {
+		return maxSize
+	}
+
+	if fit := maxBytes / vectorBytes; fit < maxSize {
+		return fit
+	}
+	return maxSize
 }
 
 // noopCache can be helpful in debugging situations, where we want to
@@ -16,12 +16,14 @@ import (
 	"time"
 
 	"github.com/sirupsen/logrus"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 )
 
 type vectorCachePrefiller[T any] struct {
 	cache  cache[T]
 	index  *hnsw
 	logger logrus.FieldLogger
+	policy string
 }
 
 type cache[T any] interface {
@@ -35,21 +37,35 @@ type cache[T any] interface {
 	drop()
 	updateMaxSize(size int64)
 	copyMaxSize() int64
+	updateMaxBytes(size int64)
+	copyMaxBytes() int64
+	estimatedBytes() int64
 	all() [][]T
 }
 
+// newVectorCachePrefiller creates a prefiller which fills the cache in layer
+// order, starting with the upper layers. With the recentlyAccessed policy the
+// nodes of the access log of the index are prefilled first and the remaining
+// space is filled in layer order.
 func newVectorCachePrefiller[T any](cache cache[T], index *hnsw,
-	logger logrus.FieldLogger,
+	logger logrus.FieldLogger, policy string,
 ) *vectorCachePrefiller[T] {
 	return &vectorCachePrefiller[T]{
 		cache:  cache,
 		index:  index,
 		logger: logger,
+		policy: policy,
 	}
 }
 
 func (pf *vectorCachePrefiller[T]) Prefill(ctx context.Context, limit int) error {
 	before := time.Now()
+	if pf.policy == ent.VectorCachePrefillRecentlyAccessed {
+		if err := pf.prefillRecentlyAccessed(ctx, limit); err != nil {
+			return err
+		}
+	}
+
 	for level := pf.maxLevel(); level >= 0; level-- {
 		ok, err := pf.prefillLevel(ctx, level, limit)
 		if err != nil {
@@ -115,6 +131,55 @@ func (pf *vectorCachePrefiller[T]) prefillLevel(ctx context.Context,
 	return true, nil
 }
 
+func (pf *vectorCachePrefiller[T]) prefillRecentlyAccessed(ctx context.Context,
+	limit int,
+) error {
+	before := time.Now()
+	count := 0
+
+	pf.index.Lock()
+	nodesLen := len(pf.index.nodes)
+	pf.index.Unlock()
+
+	for _, id := range pf.index.accessLog.snapshot() {
+		if int(pf.cache.len()) >= limit {
+			break
+		}
+
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		if id >= uint64(nodesLen) {
+			continue
+		}
+
+		pf.index.Lock()
+		pf.index.shardedNodeLocks[id%NodeLockStripe].RLock()
+		node := pf.index.nodes[id]
+		pf.index.shardedNodeLocks[id%NodeLockStripe].RUnlock()
+		pf.index.Unlock()
+
+		// the node may have been deleted since it was accessed
+		if node == nil {
+			continue
+		}
+
+		pf.index.Lock()
+		pf.cache.get(ctx, id)
+		count++
+		pf.index.Unlock()
+	}
+
+	pf.logger.WithFields(logrus.Fields{
+		"action":   "hnsw_vector_cache_prefill_recently_accessed",
+		"count":    count,
+		"took":     time.Since(before),
+		"index_id": pf.index.id,
+	}).Debug("prefilled recently accessed nodes in vector cache")
+	return nil
+}
+
 func (pf *vectorCachePrefiller[T]) logLevel(level, count int, before time.Time) {
 	pf.logger.WithFields(logrus.Fields{
 		"action":     "hnsw_vector_cache_prefill_level",
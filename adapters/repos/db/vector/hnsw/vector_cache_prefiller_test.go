@@ -18,6 +18,7 @@ import (
 
 	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 )
 
 func TestVectorCachePrefilling(t *testing.T) {
@@ -33,7 +34,7 @@ func TestVectorCachePrefilling(t *testing.T) {
 
 	logger, _ := test.NewNullLogger()
 
-	pf := newVectorCachePrefiller[float32](cache, index, logger)
+	pf := newVectorCachePrefiller[float32](cache, index, logger, ent.VectorCachePrefillUpperLayers)
 
 	t.Run("prefill with limit >= graph size", func(t *testing.T) {
 		cache.reset()
@@ -76,6 +77,54 @@ func TestVectorCachePrefilling(t *testing.T) {
 	})
 }
 
+func TestVectorCachePrefillingRecentlyAccessed(t *testing.T) {
+	cache := newFakeCache()
+	index := &hnsw{
+		nodes:               generateDummyVertices(100),
+		currentMaximumLayer: 3,
+		shardedNodeLocks:    make([]sync.RWMutex, NodeLockStripe),
+	}
+	index.accessLog.init(10)
+	// 150 is out of range, as if the log was persisted before a node got lost
+	index.accessLog.record([]uint64{42, 17, 150, 99})
+
+	logger, _ := test.NewNullLogger()
+
+	pf := newVectorCachePrefiller[float32](cache, index, logger,
+		ent.VectorCachePrefillRecentlyAccessed)
+
+	t.Run("limit where only the recently accessed nodes fit", func(t *testing.T) {
+		cache.reset()
+		pf.Prefill(context.Background(), 3)
+		assert.Equal(t, map[uint64]struct{}{
+			42: {},
+			17: {},
+			99: {},
+		}, cache.store)
+	})
+
+	t.Run("the remaining space is filled in layer order", func(t *testing.T) {
+		cache.reset()
+		pf.Prefill(context.Background(), 5)
+		assert.Equal(t, map[uint64]struct{}{
+			// recently accessed
+			42: {},
+			17: {},
+			99: {},
+
+			// layer 3
+			0:  {},
+			15: {},
+		}, cache.store)
+	})
+
+	t.Run("prefill with limit >= graph size", func(t *testing.T) {
+		cache.reset()
+		pf.Prefill(context.Background(), 100)
+		assert.Equal(t, allNumbersUpTo(100), cache.store)
+	})
+}
+
 func newFakeCache() *fakeCache {
 	return &fakeCache{
 		store: map[uint64]struct{}{},
@@ -132,6 +181,21 @@ func (f *fakeCache) copyMaxSize() int64 {
 	return 1e6
 }
 
+//nolint:unused
+func (f *fakeCache) updateMaxBytes(size int64) {
+	panic("not implemented")
+}
+
+//nolint:unused
+func (f *fakeCache) copyMaxBytes() int64 {
+	return 0
+}
+
+//nolint:unused
+func (f *fakeCache) estimatedBytes() int64 {
+	panic("not implemented")
+}
+
 func (f *fakeCache) reset() {
 	f.store = map[uint64]struct{}{}
 }
@@ -76,6 +76,74 @@ func TestCacheCleanup(t *testing.T) {
 	})
 }
 
+func TestCacheByteBudget(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	var vecForId VectorForID = nil
+
+	dims := 32
+	vectorBytes := int64(dims)*4 + vectorSliceOverhead
+	deletionInterval := 200 * time.Millisecond
+	sleepMs := deletionInterval + 100*time.Millisecond
+
+	t.Run("budget is ignored until the dimensions are known", func(t *testing.T) {
+		cache := newShardedLockCache(vecForId, 1000, logger, false, deletionInterval)
+		defer cache.drop()
+		cache.updateMaxBytes(10 * vectorBytes)
+
+		assert.Equal(t, int64(1000), cache.copyMaxSize())
+		assert.Equal(t, int64(0), cache.estimatedBytes())
+	})
+
+	t.Run("budget limits the number of vectors", func(t *testing.T) {
+		cache := newShardedLockCache(vecForId, 1000, logger, false, deletionInterval)
+		defer cache.drop()
+		cache.updateMaxBytes(10 * vectorBytes)
+
+		for i := 0; i < 5; i++ {
+			cache.preload(uint64(i), make([]float32, dims))
+		}
+
+		assert.Equal(t, int64(10), cache.copyMaxSize())
+		assert.Equal(t, 5*vectorBytes, cache.estimatedBytes())
+		assert.Equal(t, 10*vectorBytes, cache.copyMaxBytes())
+	})
+
+	t.Run("max objects still applies if lower than the budget", func(t *testing.T) {
+		cache := newShardedLockCache(vecForId, 3, logger, false, deletionInterval)
+		defer cache.drop()
+		cache.updateMaxBytes(10 * vectorBytes)
+		cache.preload(0, make([]float32, dims))
+
+		assert.Equal(t, int64(3), cache.copyMaxSize())
+	})
+
+	t.Run("cache is cleared when the budget is exceeded", func(t *testing.T) {
+		cache := newShardedLockCache(vecForId, 1000, logger, false, deletionInterval)
+		defer cache.drop()
+		cache.updateMaxBytes(10 * vectorBytes)
+
+		for i := 0; i < 12; i++ {
+			cache.preload(uint64(i), make([]float32, dims))
+		}
+		time.Sleep(sleepMs) // wait for deletion to fire
+
+		assert.Equal(t, 0, int(cache.countVectors()))
+		assert.Equal(t, int64(0), cache.estimatedBytes())
+	})
+
+	t.Run("footprint of float16 and compressed vectors", func(t *testing.T) {
+		float16Cache := newFloat16ShardedLockCache(vecForId, 1000, logger, false, deletionInterval)
+		defer float16Cache.drop()
+		float16Cache.preload(0, make([]float32, dims))
+		assert.Equal(t, int64(dims)*2+vectorSliceOverhead, float16Cache.estimatedBytes())
+
+		compressedCache := newCompressedShardedLockCache(nil, 1000, logger)
+		defer compressedCache.drop()
+		compressedCache.preload(0, make([]byte, dims))
+		assert.Equal(t, int64(dims)+vectorSliceOverhead, compressedCache.estimatedBytes())
+	})
+}
+
 func countCached(c *shardedLockCache) int {
 	c.obtainAllLocks()
 	defer c.releaseAllLocks()
@@ -18,7 +18,10 @@ import (
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/vectorindex/dynamic"
+	"github.com/weaviate/weaviate/entities/vectorindex/flat"
 	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/entities/vectorindex/vamana"
 )
 
 type Index struct{}
@@ -32,6 +35,11 @@ func (i *Index) Add(id uint64, vector []float32) error {
 	return nil
 }
 
+func (i *Index) Update(id uint64, vector []float32) error {
+	// silently ignore
+	return nil
+}
+
 func (i *Index) Delete(id ...uint64) error {
 	// silently ignore
 	return nil
@@ -57,6 +65,21 @@ func (i *Index) UpdateUserConfig(updated schema.VectorIndexConfig, callback func
 		}
 		return errors.Errorf("cannot update vector index config on a non-indexed class. Delete and re-create without skip property")
 
+	case vamana.UserConfig:
+		if t.Skip {
+			return nil
+		}
+		return errors.Errorf("cannot update vector index config on a non-indexed class. Delete and re-create without skip property")
+
+	case flat.UserConfig:
+		if t.Skip {
+			return nil
+		}
+		return errors.Errorf("cannot update vector index config on a non-indexed class. Delete and re-create without skip property")
+
+	case dynamic.UserConfig:
+		return errors.Errorf("cannot update vector index config on a non-indexed class. Delete and re-create without skip property")
+
 	default:
 		return fmt.Errorf("unrecognized vector index config: %T", updated)
 
@@ -16,7 +16,9 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/vectorindex/flat"
 	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/entities/vectorindex/vamana"
 )
 
 func Test_UpdateConfig(t *testing.T) {
@@ -41,6 +43,44 @@ func Test_UpdateConfig(t *testing.T) {
 		assert.Contains(t, err.Error(), "Delete and re-create")
 	})
 
+	t.Run("vamana: with skip==true", func(t *testing.T) {
+		ind := NewIndex()
+		err := ind.UpdateUserConfig(vamana.UserConfig{
+			Skip: true,
+		}, func() {})
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("vamana: with skip==false", func(t *testing.T) {
+		ind := NewIndex()
+		err := ind.UpdateUserConfig(vamana.UserConfig{
+			Skip: false,
+		}, func() {})
+
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "Delete and re-create")
+	})
+
+	t.Run("flat: with skip==true", func(t *testing.T) {
+		ind := NewIndex()
+		err := ind.UpdateUserConfig(flat.UserConfig{
+			Skip: true,
+		}, func() {})
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("flat: with skip==false", func(t *testing.T) {
+		ind := NewIndex()
+		err := ind.UpdateUserConfig(flat.UserConfig{
+			Skip: false,
+		}, func() {})
+
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "Delete and re-create")
+	})
+
 	t.Run("with unrecognized vector index config", func(t *testing.T) {
 		ind := NewIndex()
 		err := ind.UpdateUserConfig(nil, func() {})
@@ -329,6 +329,16 @@ func (pq *ProductQuantizer) ReturnDistancer(d *PQDistancer) {
 	pq.dlutPool.Return(d.lut)
 }
 
+func (pq *ProductQuantizer) NewQuantizerDistancer(a []float32) QuantizerDistancer {
+	return pq.NewDistancer(a)
+}
+
+func (pq *ProductQuantizer) ReturnQuantizerDistancer(d QuantizerDistancer) {
+	if concrete, ok := d.(*PQDistancer); ok {
+		pq.ReturnDistancer(concrete)
+	}
+}
+
 func (d *PQDistancer) Distance(x []byte) (float32, bool, error) {
 	return d.pq.Distance(x, d.lut), true, nil
 }
@@ -0,0 +1,31 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package ssdhelpers
+
+// Quantizer is implemented by the compression schemes of the hnsw index.
+// They encode vectors into byte codes and calculate the distances on the
+// codes, so the uncompressed vectors do not need to be kept in memory.
+type Quantizer interface {
+	Encode(vec []float32) []byte
+	Decode(code []byte) []float32
+	DistanceBetweenCompressedVectors(x, y []byte) float32
+	DistanceBetweenCompressedAndUncompressedVectors(x []float32, encoded []byte) float32
+	NewQuantizerDistancer(vec []float32) QuantizerDistancer
+	ReturnQuantizerDistancer(d QuantizerDistancer)
+}
+
+// QuantizerDistancer calculates the distances of a single query vector to
+// many encoded vectors
+type QuantizerDistancer interface {
+	Distance(x []byte) (float32, bool, error)
+	DistanceToFloat(x []float32) (float32, bool, error)
+}
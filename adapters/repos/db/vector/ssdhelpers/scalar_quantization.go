@@ -0,0 +1,215 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package ssdhelpers
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+const (
+	sqCodeLevels = 255
+	// every code carries the sum of its bytes, which is needed to calculate
+	// the dot product without decoding
+	sqSumBytes = 4
+)
+
+// ScalarQuantizer compresses every dimension of a vector into a single byte.
+// All dimensions share the same range [a, a+b] which is learned from a sample
+// of the vectors. There is no codebook to train, so fitting is cheap, and the
+// distances between two codes are calculated directly on the bytes.
+type ScalarQuantizer struct {
+	a             float32
+	b             float32
+	dimensions    int
+	distance      distancer.Provider
+	trainingLimit int
+}
+
+type SQData struct {
+	A          float32
+	B          float32
+	Dimensions uint16
+}
+
+func NewScalarQuantizer(cfg ent.SQConfig, distance distancer.Provider, dimensions int) (*ScalarQuantizer, error) {
+	switch distance.Type() {
+	case "l2-squared", "dot", "cosine-dot":
+	default:
+		return nil, fmt.Errorf("scalar quantization does not support distance %q", distance.Type())
+	}
+
+	if dimensions <= 0 {
+		return nil, fmt.Errorf("dimensions must be positive, got %d", dimensions)
+	}
+
+	return &ScalarQuantizer{
+		dimensions:    dimensions,
+		distance:      distance,
+		trainingLimit: cfg.TrainingLimit,
+	}, nil
+}
+
+func NewScalarQuantizerWithData(cfg ent.SQConfig, distance distancer.Provider, data SQData) (*ScalarQuantizer, error) {
+	sq, err := NewScalarQuantizer(cfg, distance, int(data.Dimensions))
+	if err != nil {
+		return nil, err
+	}
+
+	sq.a = data.A
+	sq.b = data.B
+	return sq, nil
+}
+
+func (sq *ScalarQuantizer) ExposeFields() SQData {
+	return SQData{
+		A:          sq.a,
+		B:          sq.b,
+		Dimensions: uint16(sq.dimensions),
+	}
+}
+
+// Fit learns the range of the values from the data
+func (sq *ScalarQuantizer) Fit(data [][]float32) {
+	if sq.trainingLimit > 0 && len(data) > sq.trainingLimit {
+		data = data[:sq.trainingLimit]
+	}
+
+	min, max := float32(math.MaxFloat32), float32(-math.MaxFloat32)
+	for _, vec := range data {
+		for _, x := range vec {
+			if x < min {
+				min = x
+			}
+			if x > max {
+				max = x
+			}
+		}
+	}
+
+	if min > max {
+		// no data, there is nothing to learn
+		return
+	}
+
+	sq.a = min
+	sq.b = max - min
+}
+
+func (sq *ScalarQuantizer) codeSize() int {
+	return sq.dimensions + sqSumBytes
+}
+
+func (sq *ScalarQuantizer) Encode(vec []float32) []byte {
+	code := make([]byte, sq.codeSize())
+	var sum uint32
+	for i, x := range vec {
+		c := sq.encodeValue(x)
+		code[i] = c
+		sum += uint32(c)
+	}
+	binary.LittleEndian.PutUint32(code[sq.dimensions:], sum)
+	return code
+}
+
+func (sq *ScalarQuantizer) encodeValue(x float32) byte {
+	if sq.b == 0 {
+		return 0
+	}
+
+	v := (x - sq.a) / sq.b * sqCodeLevels
+	if v <= 0 {
+		return 0
+	}
+	if v >= sqCodeLevels {
+		return sqCodeLevels
+	}
+	return byte(v + 0.5)
+}
+
+func (sq *ScalarQuantizer) Decode(code []byte) []float32 {
+	step := sq.b / sqCodeLevels
+	vec := make([]float32, sq.dimensions)
+	for i := range vec {
+		vec[i] = sq.a + float32(code[i])*step
+	}
+	return vec
+}
+
+func (sq *ScalarQuantizer) codeSum(code []byte) uint32 {
+	return binary.LittleEndian.Uint32(code[sq.dimensions:])
+}
+
+// DistanceBetweenCompressedVectors calculates the distance on the bytes and
+// scales it back into the space of the original vectors. With the step
+// s = b/255, a value x is approximated by a + s*c, so the dot product of two
+// vectors is n*a^2 + a*s*(sum(cx) + sum(cy)) + s^2*dot(cx, cy).
+func (sq *ScalarQuantizer) DistanceBetweenCompressedVectors(x, y []byte) float32 {
+	step := sq.b / sqCodeLevels
+	cx, cy := x[:sq.dimensions], y[:sq.dimensions]
+
+	if sq.distance.Type() == "l2-squared" {
+		return step * step * float32(distancer.L2SquaredByte(cx, cy))
+	}
+
+	dot := float32(sq.dimensions)*sq.a*sq.a +
+		sq.a*step*float32(sq.codeSum(x)+sq.codeSum(y)) +
+		step*step*float32(distancer.DotByte(cx, cy))
+
+	if sq.distance.Type() == "cosine-dot" {
+		return 1 - dot
+	}
+	return -dot
+}
+
+func (sq *ScalarQuantizer) DistanceBetweenCompressedAndUncompressedVectors(x []float32, encoded []byte) float32 {
+	dist, _, _ := sq.distance.SingleDist(x, sq.Decode(encoded))
+	return dist
+}
+
+type SQDistancer struct {
+	x    []float32
+	sq   *ScalarQuantizer
+	code []byte
+}
+
+func (sq *ScalarQuantizer) NewDistancer(a []float32) *SQDistancer {
+	return &SQDistancer{
+		x:    a,
+		sq:   sq,
+		code: sq.Encode(a),
+	}
+}
+
+func (sq *ScalarQuantizer) NewQuantizerDistancer(a []float32) QuantizerDistancer {
+	return sq.NewDistancer(a)
+}
+
+// ReturnQuantizerDistancer is a no-op, the distancer only holds the encoded
+// query which is not worth pooling
+func (sq *ScalarQuantizer) ReturnQuantizerDistancer(d QuantizerDistancer) {}
+
+func (d *SQDistancer) Distance(x []byte) (float32, bool, error) {
+	if len(x) != len(d.code) {
+		return 0, false, fmt.Errorf("code lengths don't match: %d vs %d",
+			len(x), len(d.code))
+	}
+	return d.sq.DistanceBetweenCompressedVectors(d.code, x), true, nil
+}
+
+func (d *SQDistancer) DistanceToFloat(x []float32) (float32, bool, error) {
+	return d.sq.distance.SingleDist(d.x, x)
+}
@@ -0,0 +1,112 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package ssdhelpers_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	ssdhelpers "github.com/weaviate/weaviate/adapters/repos/db/vector/ssdhelpers"
+	testinghelpers "github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestScalarQuantizer(t *testing.T) {
+	dimensions := 64
+	vectors, queries := testinghelpers.RandomVecs(500, 20, dimensions)
+	cfg := ent.SQConfig{Enabled: true, TrainingLimit: 1000}
+
+	providers := []distancer.Provider{
+		distancer.NewL2SquaredProvider(),
+		distancer.NewDotProductProvider(),
+		distancer.NewCosineDistanceProvider(),
+	}
+
+	for _, provider := range providers {
+		t.Run(provider.Type(), func(t *testing.T) {
+			sq, err := ssdhelpers.NewScalarQuantizer(cfg, provider, dimensions)
+			require.Nil(t, err)
+
+			data := vectors
+			qs := queries
+			if provider.Type() == "cosine-dot" {
+				data = normalizeAll(vectors)
+				qs = normalizeAll(queries)
+			}
+			sq.Fit(data)
+
+			encoded := make([][]byte, len(data))
+			for i, vec := range data {
+				encoded[i] = sq.Encode(vec)
+			}
+
+			for _, query := range qs {
+				d := sq.NewQuantizerDistancer(query)
+				for i, vec := range data {
+					expected, _, err := provider.SingleDist(query, vec)
+					require.Nil(t, err)
+
+					actual, ok, err := d.Distance(encoded[i])
+					require.Nil(t, err)
+					require.True(t, ok)
+					assert.InDelta(t, expected, actual, 0.05*abs(expected)+0.05)
+
+					asymmetric := sq.DistanceBetweenCompressedAndUncompressedVectors(query, encoded[i])
+					assert.InDelta(t, expected, asymmetric, 0.05*abs(expected)+0.05)
+				}
+				sq.ReturnQuantizerDistancer(d)
+			}
+		})
+	}
+}
+
+func TestScalarQuantizerEncodeDecode(t *testing.T) {
+	sq, err := ssdhelpers.NewScalarQuantizer(ent.SQConfig{TrainingLimit: 10},
+		distancer.NewL2SquaredProvider(), 4)
+	require.Nil(t, err)
+
+	sq.Fit([][]float32{{-1, 0, 0.5, 1}, {0, 0, 0, 0}})
+
+	code := sq.Encode([]float32{-1, 0, 1, 2})
+	assert.Equal(t, []byte{0, 128, 255, 255}, code[:4])
+
+	decoded := sq.Decode(code)
+	assert.InDeltaSlice(t, []float32{-1, 0, 1, 1}, decoded, 0.01)
+
+	restored, err := ssdhelpers.NewScalarQuantizerWithData(ent.SQConfig{},
+		distancer.NewL2SquaredProvider(), sq.ExposeFields())
+	require.Nil(t, err)
+	assert.Equal(t, code, restored.Encode([]float32{-1, 0, 1, 2}))
+}
+
+func TestScalarQuantizerUnsupportedDistance(t *testing.T) {
+	_, err := ssdhelpers.NewScalarQuantizer(ent.SQConfig{},
+		distancer.NewManhattanProvider(), 4)
+	assert.NotNil(t, err)
+}
+
+func normalizeAll(vectors [][]float32) [][]float32 {
+	out := make([][]float32, len(vectors))
+	for i, vec := range vectors {
+		out[i] = distancer.Normalize(vec)
+	}
+	return out
+}
+
+func abs(x float32) float64 {
+	if x < 0 {
+		return float64(-x)
+	}
+	return float64(x)
+}
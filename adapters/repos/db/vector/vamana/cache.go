@@ -0,0 +1,108 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package vamana
+
+import (
+	"container/list"
+	"sync"
+)
+
+// nodeCache is a size-bounded LRU cache of graph nodes. As every search
+// starts at the entrypoint, the nodes in its vicinity are visited by almost
+// every query and stay in memory, while the bulk of the graph is only read
+// from disk when needed.
+type nodeCache struct {
+	sync.Mutex
+	maxSize int
+	items   map[uint64]*list.Element
+	lru     *list.List
+}
+
+func newNodeCache(maxSize int) *nodeCache {
+	return &nodeCache{
+		maxSize: maxSize,
+		items:   map[uint64]*list.Element{},
+		lru:     list.New(),
+	}
+}
+
+func (c *nodeCache) get(id uint64) (*node, bool) {
+	c.Lock()
+	defer c.Unlock()
+
+	elem, ok := c.items[id]
+	if !ok {
+		return nil, false
+	}
+
+	c.lru.MoveToFront(elem)
+	return elem.Value.(*node), true
+}
+
+func (c *nodeCache) put(n *node) {
+	c.Lock()
+	defer c.Unlock()
+
+	if elem, ok := c.items[n.id]; ok {
+		elem.Value = n
+		c.lru.MoveToFront(elem)
+		return
+	}
+
+	if c.maxSize <= 0 {
+		return
+	}
+
+	c.items[n.id] = c.lru.PushFront(n)
+	c.evictUnlocked()
+}
+
+func (c *nodeCache) delete(id uint64) {
+	c.Lock()
+	defer c.Unlock()
+
+	if elem, ok := c.items[id]; ok {
+		c.lru.Remove(elem)
+		delete(c.items, id)
+	}
+}
+
+func (c *nodeCache) updateMaxSize(size int) {
+	c.Lock()
+	defer c.Unlock()
+
+	c.maxSize = size
+	c.evictUnlocked()
+}
+
+func (c *nodeCache) len() int {
+	c.Lock()
+	defer c.Unlock()
+
+	return c.lru.Len()
+}
+
+func (c *nodeCache) drop() {
+	c.Lock()
+	defer c.Unlock()
+
+	c.items = map[uint64]*list.Element{}
+	c.lru.Init()
+}
+
+func (c *nodeCache) evictUnlocked() {
+	for c.lru.Len() > c.maxSize {
+		oldest := c.lru.Back()
+		c.lru.Remove(oldest)
+		delete(c.items, oldest.Value.(*node).id)
+	}
+}
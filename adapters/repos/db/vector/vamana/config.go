@@ -0,0 +1,108 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package vamana
+
+import (
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/entities/errorcompounder"
+	"github.com/weaviate/weaviate/entities/schema"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/vamana"
+)
+
+// Config for a new vamana index, this contains information that is derived
+// internally, e.g. by the shard. All User-settable config is specified in
+// ent.UserConfig
+type Config struct {
+	// internal
+	RootPath         string
+	ID               string
+	Logger           logrus.FieldLogger
+	DistanceProvider distancer.Provider
+
+	// metadata for monitoring
+	ShardName string
+	ClassName string
+}
+
+func (c Config) Validate() error {
+	ec := &errorcompounder.ErrorCompounder{}
+
+	if c.ID == "" {
+		ec.Addf("id cannot be empty")
+	}
+
+	if c.RootPath == "" {
+		ec.Addf("rootPath cannot be empty")
+	}
+
+	if c.DistanceProvider == nil {
+		ec.Addf("distancerProvider cannot be nil")
+	}
+
+	return ec.ToError()
+}
+
+func ValidateUserConfigUpdate(initial, updated schema.VectorIndexConfig) error {
+	initialParsed, ok := initial.(ent.UserConfig)
+	if !ok {
+		return errors.Errorf("initial is not UserConfig, but %T", initial)
+	}
+
+	updatedParsed, ok := updated.(ent.UserConfig)
+	if !ok {
+		return errors.Errorf("updated is not UserConfig, but %T", updated)
+	}
+
+	immutableFields := []immutableParameter{
+		{
+			// the size of the on-disk node records depends on it
+			name:     "maxDegree",
+			accessor: func(c ent.UserConfig) interface{} { return c.MaxDegree },
+		},
+		{
+			name:     "cleanupIntervalSeconds",
+			accessor: func(c ent.UserConfig) interface{} { return c.CleanupIntervalSeconds },
+		},
+		{
+			name:     "distance",
+			accessor: func(c ent.UserConfig) interface{} { return c.Distance },
+		},
+	}
+
+	for _, u := range immutableFields {
+		if err := validateImmutableField(u, initialParsed, updatedParsed); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type immutableParameter struct {
+	accessor func(c ent.UserConfig) interface{}
+	name     string
+}
+
+func validateImmutableField(u immutableParameter,
+	previous, next ent.UserConfig,
+) error {
+	oldField := u.accessor(previous)
+	newField := u.accessor(next)
+	if oldField != newField {
+		return errors.Errorf("%s is immutable: attempted change from \"%v\" to \"%v\"",
+			u.name, oldField, newField)
+	}
+
+	return nil
+}
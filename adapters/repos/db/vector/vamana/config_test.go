@@ -0,0 +1,71 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package vamana
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/schema"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/vamana"
+)
+
+func TestUserConfigUpdates(t *testing.T) {
+	type test struct {
+		name          string
+		initial       schema.VectorIndexConfig
+		update        schema.VectorIndexConfig
+		expectedError string
+	}
+
+	tests := []test{
+		{
+			name:    "attempting to change maxDegree",
+			initial: ent.UserConfig{MaxDegree: 64},
+			update:  ent.UserConfig{MaxDegree: 32},
+			expectedError: "maxDegree is immutable: " +
+				"attempted change from \"64\" to \"32\"",
+		},
+		{
+			name:    "attempting to change the cleanup interval",
+			initial: ent.UserConfig{CleanupIntervalSeconds: 60},
+			update:  ent.UserConfig{CleanupIntervalSeconds: 90},
+			expectedError: "cleanupIntervalSeconds is immutable: " +
+				"attempted change from \"60\" to \"90\"",
+		},
+		{
+			name:    "attempting to change the distance",
+			initial: ent.UserConfig{Distance: "cosine"},
+			update:  ent.UserConfig{Distance: "l2-squared"},
+			expectedError: "distance is immutable: " +
+				"attempted change from \"cosine\" to \"l2-squared\"",
+		},
+		{
+			name:    "changing the search parameters and the cache size",
+			initial: ent.UserConfig{SearchListSize: 100, Alpha: 1.2, CacheMaxObjects: 10},
+			update:  ent.UserConfig{SearchListSize: 50, Alpha: 1.5, CacheMaxObjects: 1000},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := ValidateUserConfigUpdate(test.initial, test.update)
+			if test.expectedError == "" {
+				assert.Nil(t, err)
+			} else {
+				require.NotNil(t, err)
+				assert.Equal(t, test.expectedError, err.Error())
+			}
+		})
+	}
+}
@@ -0,0 +1,243 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package vamana
+
+import (
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+)
+
+// cleanupBatchSize is the number of nodes the tombstone cleanup processes
+// while holding the index lock
+const cleanupBatchSize = 1000
+
+// Delete marks the nodes as deleted. They are skipped in search results
+// immediately, but stay part of the graph until the next tombstone cleanup
+// has rewired their neighbors. Unknown ids are ignored.
+func (v *vamana) Delete(ids ...uint64) error {
+	v.Lock()
+	defer v.Unlock()
+
+	for _, id := range ids {
+		n, err := v.nodeByID(id)
+		if err != nil {
+			return errors.Wrapf(err, "delete node %d", id)
+		}
+		if n == nil || n.tombstone {
+			continue
+		}
+
+		if err := v.putNode(&node{
+			id:        n.id,
+			vector:    n.vector,
+			neighbors: n.neighbors,
+			tombstone: true,
+		}); err != nil {
+			return errors.Wrapf(err, "delete node %d", id)
+		}
+		v.tombstones[id] = struct{}{}
+	}
+
+	return nil
+}
+
+func (v *vamana) tombstoneCleanup(shouldAbort cyclemanager.ShouldAbortCallback) bool {
+	executed, err := v.cleanUpTombstonedNodes(shouldAbort)
+	if err != nil {
+		v.logger.WithField("action", "vamana_tombstone_cleanup").
+			WithError(err).Error("tombstone cleanup errord")
+	}
+	return executed
+}
+
+// CleanUpTombstonedNodes removes all deleted nodes from the graph
+func (v *vamana) CleanUpTombstonedNodes(shouldAbort cyclemanager.ShouldAbortCallback) error {
+	_, err := v.cleanUpTombstonedNodes(shouldAbort)
+	return err
+}
+
+// cleanUpTombstonedNodes consolidates deletes: Every node that links to a
+// deleted node gets its neighbors pruned again from its remaining neighbors
+// and the neighbors of the deleted ones. Once no node links to a deleted
+// node anymore, the deleted nodes are removed from disk. The graph is
+// processed in batches, so searches and inserts are only blocked briefly.
+func (v *vamana) cleanUpTombstonedNodes(shouldAbort cyclemanager.ShouldAbortCallback) (bool, error) {
+	v.RLock()
+	deleted := make(map[uint64]struct{}, len(v.tombstones))
+	for id := range v.tombstones {
+		deleted[id] = struct{}{}
+	}
+	size, err := v.store.size()
+	v.RUnlock()
+	if err != nil {
+		return false, err
+	}
+
+	if len(deleted) == 0 {
+		return false, nil
+	}
+
+	for from := uint64(0); from < size; from += cleanupBatchSize {
+		if shouldAbort() {
+			// tombstones are kept, the next cycle starts over
+			return true, nil
+		}
+
+		to := from + cleanupBatchSize
+		if to > size {
+			to = size
+		}
+		if err := v.reassignNeighborsInRange(from, to, deleted); err != nil {
+			return true, errors.Wrap(err, "reassign neighbors")
+		}
+	}
+
+	v.Lock()
+	defer v.Unlock()
+
+	if _, ok := deleted[v.store.meta.Entrypoint]; ok && v.store.meta.HasEntrypoint {
+		if err := v.replaceDeletedEntrypoint(deleted); err != nil {
+			return true, errors.Wrap(err, "replace entrypoint")
+		}
+	}
+
+	for id := range deleted {
+		if _, ok := v.tombstones[id]; !ok {
+			// re-added in the meantime
+			continue
+		}
+
+		if err := v.store.clear(id); err != nil {
+			return true, err
+		}
+		v.cache.delete(id)
+		delete(v.tombstones, id)
+	}
+
+	return true, nil
+}
+
+func (v *vamana) reassignNeighborsInRange(from, to uint64, deleted map[uint64]struct{}) error {
+	v.Lock()
+	defer v.Unlock()
+
+	for id := from; id < to; id++ {
+		if _, ok := deleted[id]; ok {
+			continue
+		}
+
+		// read past the cache, the cleanup touches every node once and would
+		// otherwise evict the frequently used ones
+		n, err := v.store.read(id)
+		if err != nil {
+			return err
+		}
+		if n == nil || n.tombstone {
+			continue
+		}
+
+		if err := v.reassignNeighbors(n, deleted); err != nil {
+			return errors.Wrapf(err, "node %d", id)
+		}
+	}
+
+	return nil
+}
+
+func (v *vamana) reassignNeighbors(n *node, deleted map[uint64]struct{}) error {
+	hasDeleted := false
+	ids := make([]uint64, 0, len(n.neighbors))
+	for _, neighbor := range n.neighbors {
+		if _, ok := deleted[neighbor]; !ok {
+			ids = append(ids, neighbor)
+			continue
+		}
+
+		hasDeleted = true
+		deletedNode, err := v.nodeByID(neighbor)
+		if err != nil {
+			return err
+		}
+		if deletedNode != nil {
+			ids = append(ids, deletedNode.neighbors...)
+		}
+	}
+
+	if !hasDeleted {
+		return nil
+	}
+
+	candidates, err := v.candidatesFor(n, ids, deleted)
+	if err != nil {
+		return err
+	}
+
+	pruned, err := v.robustPrune(candidates)
+	if err != nil {
+		return err
+	}
+
+	return v.putNode(&node{
+		id:        n.id,
+		vector:    n.vector,
+		neighbors: candidateIDs(pruned),
+	})
+}
+
+// replaceDeletedEntrypoint prefers a live neighbor of the old entrypoint, so
+// the new one is still close to the center of the graph. If there is none,
+// the first live node is used and if the graph is empty the entrypoint is
+// unset.
+func (v *vamana) replaceDeletedEntrypoint(deleted map[uint64]struct{}) error {
+	isLive := func(id uint64) (bool, error) {
+		if _, ok := deleted[id]; ok {
+			return false, nil
+		}
+		n, err := v.nodeByID(id)
+		if err != nil {
+			return false, err
+		}
+		return n != nil && !n.tombstone, nil
+	}
+
+	old, err := v.nodeByID(v.store.meta.Entrypoint)
+	if err != nil {
+		return err
+	}
+	if old != nil {
+		for _, neighbor := range old.neighbors {
+			live, err := isLive(neighbor)
+			if err != nil {
+				return err
+			}
+			if live {
+				return v.store.setEntrypoint(neighbor, true)
+			}
+		}
+	}
+
+	size, err := v.store.size()
+	if err != nil {
+		return err
+	}
+	for id := uint64(0); id < size; id++ {
+		live, err := isLive(id)
+		if err != nil {
+			return err
+		}
+		if live {
+			return v.store.setEntrypoint(id, true)
+		}
+	}
+
+	return v.store.setEntrypoint(0, false)
+}
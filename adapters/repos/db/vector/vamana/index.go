@@ -0,0 +1,310 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package vamana
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/schema"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/vamana"
+)
+
+// vamana is a disk-backed graph index in the style of DiskANN. In contrast
+// to hnsw it has only a single layer and keeps both the graph and the full
+// vectors on disk, with only a bounded cache of nodes held in memory. This
+// makes it suitable for datasets that don't fit in memory, at the cost of
+// disk reads during search.
+//
+// Inserts and deletes are serialized by the index lock, searches can run
+// concurrently to each other.
+type vamana struct {
+	sync.RWMutex
+
+	id        string
+	rootPath  string
+	className string
+	shardName string
+	logger    logrus.FieldLogger
+
+	distancerProvider distancer.Provider
+
+	store      *nodeStore
+	cache      *nodeCache
+	tombstones map[uint64]struct{}
+
+	maxDegree           int
+	alpha               float32
+	buildSearchListSize int
+	searchListSize      int
+	flatSearchCutoff    int
+
+	tombstoneCleanupCallbackCtrl cyclemanager.CycleCallbackCtrl
+}
+
+// New creates a new vamana index or loads an existing one from disk
+func New(cfg Config, uc ent.UserConfig,
+	tombstoneCallbacks cyclemanager.CycleCallbackGroup,
+) (*vamana, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid config")
+	}
+
+	if cfg.Logger == nil {
+		logger := logrus.New()
+		logger.Out = io.Discard
+		cfg.Logger = logger
+	}
+
+	index := &vamana{
+		id:                  cfg.ID,
+		rootPath:            cfg.RootPath,
+		className:           cfg.ClassName,
+		shardName:           cfg.ShardName,
+		logger:              cfg.Logger,
+		distancerProvider:   cfg.DistanceProvider,
+		cache:               newNodeCache(uc.CacheMaxObjects),
+		tombstones:          map[uint64]struct{}{},
+		maxDegree:           uc.MaxDegree,
+		alpha:               float32(uc.Alpha),
+		buildSearchListSize: uc.BuildSearchListSize,
+		searchListSize:      uc.SearchListSize,
+		flatSearchCutoff:    uc.FlatSearchCutoff,
+	}
+
+	if err := index.init(); err != nil {
+		return nil, errors.Wrapf(err, "init index %q", index.id)
+	}
+
+	id := strings.Join([]string{
+		"vamana", "tombstone_cleanup",
+		index.className, index.shardName, index.id,
+	}, "/")
+	index.tombstoneCleanupCallbackCtrl = tombstoneCallbacks.Register(id, index.tombstoneCleanup)
+
+	return index, nil
+}
+
+func (v *vamana) init() error {
+	store, err := openNodeStore(v.dir(), v.maxDegree)
+	if err != nil {
+		return errors.Wrap(err, "open node store")
+	}
+	v.store = store
+
+	// deletes are only persisted as a flag on the node, collect them so the
+	// next cleanup cycle picks them up
+	return v.store.scanFlags(func(id uint64, tombstone bool) {
+		if tombstone {
+			v.tombstones[id] = struct{}{}
+		}
+	})
+}
+
+func (v *vamana) dir() string {
+	return filepath.Join(v.rootPath, fmt.Sprintf("%s.vamana.d", v.id))
+}
+
+// nodeByID returns nil if there is no node with the given id
+func (v *vamana) nodeByID(id uint64) (*node, error) {
+	if n, ok := v.cache.get(id); ok {
+		return n, nil
+	}
+
+	n, err := v.store.read(id)
+	if err != nil || n == nil {
+		return nil, err
+	}
+
+	v.cache.put(n)
+	return n, nil
+}
+
+// putNode persists the node. Nodes are never modified in place, as they
+// may be shared through the cache, updates always create a new node.
+func (v *vamana) putNode(n *node) error {
+	if err := v.store.write(n); err != nil {
+		return err
+	}
+
+	v.cache.put(n)
+	return nil
+}
+
+func (v *vamana) ValidateBeforeInsert(vector []float32) error {
+	v.RLock()
+	defer v.RUnlock()
+
+	dims := v.store.meta.Dimensions
+	if dims == 0 {
+		return nil
+	}
+
+	if dims != len(vector) {
+		return fmt.Errorf("new node has a vector with length %v. "+
+			"Existing nodes have vectors with length %v", len(vector), dims)
+	}
+
+	return nil
+}
+
+func (v *vamana) UpdateUserConfig(updated schema.VectorIndexConfig, callback func()) error {
+	defer callback()
+
+	parsed, ok := updated.(ent.UserConfig)
+	if !ok {
+		return errors.Errorf("config is not UserConfig, but %T", updated)
+	}
+
+	v.Lock()
+	defer v.Unlock()
+
+	v.alpha = float32(parsed.Alpha)
+	v.buildSearchListSize = parsed.BuildSearchListSize
+	v.searchListSize = parsed.SearchListSize
+	v.flatSearchCutoff = parsed.FlatSearchCutoff
+	v.cache.updateMaxSize(parsed.CacheMaxObjects)
+
+	return nil
+}
+
+// SwitchCommitLogs is a no-op other than making sure everything is on disk,
+// as the index is not built from a commit log but updated in place
+func (v *vamana) SwitchCommitLogs(ctx context.Context) error {
+	return v.Flush()
+}
+
+// ListFiles lists all non-empty files of the index relative to the root
+// path of the shard
+func (v *vamana) ListFiles(ctx context.Context) ([]string, error) {
+	var files []string
+
+	err := filepath.WalkDir(v.dir(), func(pth string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+
+		st, statErr := os.Stat(pth)
+		if statErr != nil {
+			return statErr
+		}
+
+		// only list non-empty files
+		if st.Size() > 0 {
+			rel, relErr := filepath.Rel(v.rootPath, pth)
+			if relErr != nil {
+				return relErr
+			}
+			files = append(files, rel)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Errorf("failed to list files for vamana index: %s", err)
+	}
+
+	return files, nil
+}
+
+// PostStartup is a no-op, the cache is filled lazily by the first searches,
+// which all start at the entrypoint
+func (v *vamana) PostStartup() {}
+
+func (v *vamana) Flush() error {
+	v.RLock()
+	defer v.RUnlock()
+
+	return v.store.sync()
+}
+
+func (v *vamana) Shutdown(ctx context.Context) error {
+	if err := v.tombstoneCleanupCallbackCtrl.Unregister(ctx); err != nil {
+		return errors.Wrap(err, "vamana shutdown")
+	}
+
+	v.Lock()
+	defer v.Unlock()
+
+	v.cache.drop()
+	if err := v.store.close(); err != nil {
+		return errors.Wrap(err, "vamana shutdown")
+	}
+
+	return nil
+}
+
+func (v *vamana) Drop(ctx context.Context) error {
+	// cancel tombstone cleanup goroutine
+	if err := v.tombstoneCleanupCallbackCtrl.Unregister(ctx); err != nil {
+		return errors.Wrap(err, "vamana drop")
+	}
+
+	v.Lock()
+	defer v.Unlock()
+
+	v.cache.drop()
+	if err := v.store.drop(); err != nil {
+		return errors.Wrap(err, "vamana drop")
+	}
+
+	return nil
+}
+
+// Dump to stdout for debugging purposes
+func (v *vamana) Dump(labels ...string) {
+	v.RLock()
+	defer v.RUnlock()
+
+	if len(labels) > 0 {
+		fmt.Printf("--------------------------------------------------\n")
+		fmt.Printf("--  %s\n", strings.Join(labels, ", "))
+	}
+	fmt.Printf("--------------------------------------------------\n")
+	fmt.Printf("ID: %s\n", v.id)
+	fmt.Printf("Entrypoint: %d (set: %t)\n", v.store.meta.Entrypoint, v.store.meta.HasEntrypoint)
+	fmt.Printf("Dimensions: %d\n", v.store.meta.Dimensions)
+	fmt.Printf("Cached nodes: %d\n", v.cache.len())
+	fmt.Printf("Tombstones %v\n", v.tombstones)
+	fmt.Printf("\nNodes and Connections:\n")
+
+	size, err := v.store.size()
+	if err != nil {
+		fmt.Printf("  error: %v\n", err)
+	}
+	for id := uint64(0); id < size; id++ {
+		n, err := v.store.read(id)
+		if err != nil {
+			fmt.Printf("  error: %v\n", err)
+			break
+		}
+		if n == nil {
+			continue
+		}
+		fmt.Printf("  Node %d: Connections: %v\n", n.id, n.neighbors)
+	}
+
+	fmt.Printf("--------------------------------------------------\n")
+}
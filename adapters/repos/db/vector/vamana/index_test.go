@@ -0,0 +1,296 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package vamana
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/vamana"
+)
+
+func newTestIndex(t *testing.T, rootPath string, uc ent.UserConfig) *vamana {
+	index, err := New(Config{
+		RootPath:         rootPath,
+		ID:               "vamana-test",
+		ClassName:        "Class",
+		ShardName:        "shard",
+		DistanceProvider: distancer.NewL2SquaredProvider(),
+	}, uc, cyclemanager.NewCallbackGroupNoop())
+	require.Nil(t, err)
+	return index
+}
+
+func distanceWrapper(provider distancer.Provider) testinghelpers.DistanceFunction {
+	return func(a, b []float32) float32 {
+		d, _, _ := provider.SingleDist(a, b)
+		return d
+	}
+}
+
+func recall(t *testing.T, index *vamana, vectors, queries [][]float32,
+	k int, allowList helpers.AllowList,
+) float32 {
+	var relevant, retrieved uint64
+	for _, query := range queries {
+		truth := testinghelpers.BruteForce(vectors, query, k,
+			distanceWrapper(distancer.NewL2SquaredProvider()))
+		if allowList != nil {
+			truth = bruteForceAllowed(vectors, query, k, allowList)
+		}
+
+		results, _, err := index.SearchByVector(query, k, allowList)
+		require.Nil(t, err)
+		retrieved += uint64(len(truth))
+		relevant += testinghelpers.MatchesInLists(truth, results)
+	}
+
+	return float32(relevant) / float32(retrieved)
+}
+
+func bruteForceAllowed(vectors [][]float32, query []float32, k int,
+	allowList helpers.AllowList,
+) []uint64 {
+	var allowed [][]float32
+	var ids []uint64
+	for i, vec := range vectors {
+		if allowList.Contains(uint64(i)) {
+			allowed = append(allowed, vec)
+			ids = append(ids, uint64(i))
+		}
+	}
+
+	positions := testinghelpers.BruteForce(allowed, query, k,
+		distanceWrapper(distancer.NewL2SquaredProvider()))
+	out := make([]uint64, len(positions))
+	for i, pos := range positions {
+		out[i] = ids[pos]
+	}
+	return out
+}
+
+func TestVamana(t *testing.T) {
+	ctx := context.Background()
+	rootPath := t.TempDir()
+	vectors, queries := testinghelpers.RandomVecs(1000, 50, 32)
+	k := 10
+
+	uc := ent.NewDefaultUserConfig()
+	uc.MaxDegree = 24
+	uc.BuildSearchListSize = 48
+	uc.SearchListSize = 64
+	uc.CacheMaxObjects = 100
+
+	index := newTestIndex(t, rootPath, uc)
+
+	t.Run("searching the empty index", func(t *testing.T) {
+		ids, dists, err := index.SearchByVector(queries[0], k, nil)
+		require.Nil(t, err)
+		assert.Len(t, ids, 0)
+		assert.Len(t, dists, 0)
+	})
+
+	t.Run("importing", func(t *testing.T) {
+		for i, vec := range vectors {
+			require.Nil(t, index.Add(uint64(i), vec))
+		}
+	})
+
+	t.Run("the cache stays within its bounds", func(t *testing.T) {
+		assert.LessOrEqual(t, index.cache.len(), uc.CacheMaxObjects)
+	})
+
+	t.Run("vectors of a different length are rejected", func(t *testing.T) {
+		err := index.ValidateBeforeInsert(make([]float32, 16))
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "new node has a vector with length 16")
+
+		err = index.Add(uint64(len(vectors)), make([]float32, 16))
+		require.NotNil(t, err)
+	})
+
+	t.Run("recall compared to brute force", func(t *testing.T) {
+		assert.Greater(t, recall(t, index, vectors, queries, k, nil), float32(0.9))
+	})
+
+	t.Run("search with a small allow list uses a flat search", func(t *testing.T) {
+		allowList := helpers.NewAllowList()
+		for i := uint64(0); i < uint64(len(vectors)); i += 20 {
+			allowList.Insert(i)
+		}
+		assert.Equal(t, float32(1), recall(t, index, vectors, queries, k, allowList))
+	})
+
+	t.Run("search with a large allow list filters the graph search", func(t *testing.T) {
+		index.flatSearchCutoff = 0
+		defer func() { index.flatSearchCutoff = uc.FlatSearchCutoff }()
+
+		allowList := helpers.NewAllowList()
+		for i := uint64(0); i < uint64(len(vectors)); i += 2 {
+			allowList.Insert(i)
+		}
+		results, _, err := index.SearchByVector(queries[0], k, allowList)
+		require.Nil(t, err)
+		require.Len(t, results, k)
+		for _, id := range results {
+			assert.Equal(t, uint64(0), id%2)
+		}
+	})
+
+	t.Run("search by distance", func(t *testing.T) {
+		_, dists, err := index.SearchByVector(queries[0], 5, nil)
+		require.Nil(t, err)
+		target := dists[4]
+
+		ids, dists, err := index.SearchByVectorDistance(queries[0], target, -1, nil)
+		require.Nil(t, err)
+		assert.Len(t, ids, 5)
+		for _, d := range dists {
+			assert.LessOrEqual(t, d, target)
+		}
+	})
+
+	t.Run("restarting the index from disk", func(t *testing.T) {
+		require.Nil(t, index.Shutdown(ctx))
+		index = newTestIndex(t, rootPath, uc)
+		assert.Greater(t, recall(t, index, vectors, queries, k, nil), float32(0.9))
+	})
+
+	deleted := map[uint64]struct{}{}
+	t.Run("deleting every third node including the entrypoint", func(t *testing.T) {
+		entrypoint := index.store.meta.Entrypoint
+		deleted[entrypoint] = struct{}{}
+		require.Nil(t, index.Delete(entrypoint))
+		for i := uint64(0); i < uint64(len(vectors)); i += 3 {
+			require.Nil(t, index.Delete(i))
+			deleted[i] = struct{}{}
+		}
+	})
+
+	assertNoDeletedResults := func(t *testing.T) {
+		for _, query := range queries {
+			results, _, err := index.SearchByVector(query, k, nil)
+			require.Nil(t, err)
+			require.Len(t, results, k)
+			for _, id := range results {
+				_, ok := deleted[id]
+				assert.False(t, ok, "deleted node %d must not be returned", id)
+			}
+		}
+	}
+
+	t.Run("deleted nodes are not returned", assertNoDeletedResults)
+
+	t.Run("tombstones survive a restart", func(t *testing.T) {
+		require.Nil(t, index.Shutdown(ctx))
+		index = newTestIndex(t, rootPath, uc)
+		assert.Len(t, index.tombstones, len(deleted))
+	})
+
+	t.Run("cleaning up tombstones", func(t *testing.T) {
+		require.Nil(t, index.CleanUpTombstonedNodes(func() bool { return false }))
+		assert.Len(t, index.tombstones, 0)
+
+		_, ok := deleted[index.store.meta.Entrypoint]
+		assert.False(t, ok, "entrypoint must have been replaced")
+
+		size, err := index.store.size()
+		require.Nil(t, err)
+		for id := uint64(0); id < size; id++ {
+			n, err := index.store.read(id)
+			require.Nil(t, err)
+			if _, ok := deleted[id]; ok {
+				assert.Nil(t, n, "node %d must have been removed", id)
+				continue
+			}
+
+			require.NotNil(t, n)
+			for _, neighbor := range n.neighbors {
+				_, ok := deleted[neighbor]
+				assert.False(t, ok, "node %d still links to deleted node %d", id, neighbor)
+			}
+		}
+	})
+
+	t.Run("deleted nodes are still not returned", assertNoDeletedResults)
+
+	t.Run("recall on the remaining nodes", func(t *testing.T) {
+		allowList := helpers.NewAllowList()
+		for i := range vectors {
+			if _, ok := deleted[uint64(i)]; !ok {
+				allowList.Insert(uint64(i))
+			}
+		}
+
+		var relevant, retrieved uint64
+		for _, query := range queries {
+			truth := bruteForceAllowed(vectors, query, k, allowList)
+			results, _, err := index.SearchByVector(query, k, nil)
+			require.Nil(t, err)
+			retrieved += uint64(len(truth))
+			relevant += testinghelpers.MatchesInLists(truth, results)
+		}
+		assert.Greater(t, float32(relevant)/float32(retrieved), float32(0.9))
+	})
+
+	t.Run("listing files", func(t *testing.T) {
+		files, err := index.ListFiles(ctx)
+		require.Nil(t, err)
+		assert.ElementsMatch(t, []string{
+			filepath.Join("vamana-test.vamana.d", "meta.json"),
+			filepath.Join("vamana-test.vamana.d", "nodes"),
+		}, files)
+	})
+
+	t.Run("dropping the index", func(t *testing.T) {
+		require.Nil(t, index.Drop(ctx))
+		_, err := os.Stat(filepath.Join(rootPath, "vamana-test.vamana.d"))
+		assert.True(t, os.IsNotExist(err))
+	})
+}
+
+func TestVamanaDeleteAllNodes(t *testing.T) {
+	ctx := context.Background()
+	index := newTestIndex(t, t.TempDir(), ent.NewDefaultUserConfig())
+
+	vectors, _ := testinghelpers.RandomVecs(50, 0, 8)
+	for i, vec := range vectors {
+		require.Nil(t, index.Add(uint64(i), vec))
+	}
+
+	for i := range vectors {
+		require.Nil(t, index.Delete(uint64(i)))
+	}
+	require.Nil(t, index.CleanUpTombstonedNodes(func() bool { return false }))
+	assert.False(t, index.store.meta.HasEntrypoint)
+
+	ids, _, err := index.SearchByVector(vectors[0], 10, nil)
+	require.Nil(t, err)
+	assert.Len(t, ids, 0)
+
+	t.Run("the index can be filled again", func(t *testing.T) {
+		require.Nil(t, index.Add(100, vectors[0]))
+		ids, _, err := index.SearchByVector(vectors[0], 10, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{100}, ids)
+	})
+
+	require.Nil(t, index.Shutdown(ctx))
+}
@@ -0,0 +1,217 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package vamana
+
+import (
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+)
+
+func (v *vamana) Add(id uint64, vector []float32) error {
+	if len(vector) == 0 {
+		return errors.Errorf("insert called with nil-vector")
+	}
+
+	if v.distancerProvider.Type() == "cosine-dot" {
+		// cosine-dot requires normalized vectors, as the dot product and cosine
+		// similarity are only identical if the vector is normalized
+		vector = distancer.Normalize(vector)
+	}
+
+	v.Lock()
+	defer v.Unlock()
+
+	if err := v.store.ensureDimensions(len(vector)); err != nil {
+		return err
+	}
+
+	// the node may have been deleted before, re-adding it revives it
+	delete(v.tombstones, id)
+
+	n := &node{id: id, vector: vector}
+	if !v.store.meta.HasEntrypoint {
+		if err := v.putNode(n); err != nil {
+			return err
+		}
+		return v.store.setEntrypoint(id, true)
+	}
+
+	_, expanded, err := v.greedySearch(vector, v.buildSearchListSize)
+	if err != nil {
+		return errors.Wrap(err, "search neighbors")
+	}
+
+	candidates := make([]candidate, 0, len(expanded))
+	for _, c := range expanded {
+		// tombstoned nodes are about to be removed from the graph, linking to
+		// them would only create work for the cleanup
+		if c.node.id == id || c.node.tombstone {
+			continue
+		}
+		candidates = append(candidates, c)
+	}
+
+	neighbors, err := v.robustPrune(candidates)
+	if err != nil {
+		return err
+	}
+
+	n.neighbors = candidateIDs(neighbors)
+	if err := v.putNode(n); err != nil {
+		return err
+	}
+
+	for _, neighbor := range neighbors {
+		if err := v.addBackEdge(neighbor.node, n); err != nil {
+			return errors.Wrapf(err, "add edge from %d to %d", neighbor.node.id, id)
+		}
+	}
+
+	return nil
+}
+
+// addBackEdge links target to source. If target has no room for another
+// neighbor, its neighbors are pruned again including the source.
+func (v *vamana) addBackEdge(target, source *node) error {
+	for _, neighbor := range target.neighbors {
+		if neighbor == source.id {
+			return nil
+		}
+	}
+
+	updated := &node{
+		id:        target.id,
+		vector:    target.vector,
+		tombstone: target.tombstone,
+	}
+
+	if len(target.neighbors) < v.store.neighborCapacity() {
+		updated.neighbors = make([]uint64, len(target.neighbors), len(target.neighbors)+1)
+		copy(updated.neighbors, target.neighbors)
+		updated.neighbors = append(updated.neighbors, source.id)
+		return v.putNode(updated)
+	}
+
+	ids := make([]uint64, len(target.neighbors), len(target.neighbors)+1)
+	copy(ids, target.neighbors)
+	candidates, err := v.candidatesFor(target, append(ids, source.id), nil)
+	if err != nil {
+		return err
+	}
+
+	pruned, err := v.robustPrune(candidates)
+	if err != nil {
+		return err
+	}
+
+	updated.neighbors = candidateIDs(pruned)
+	return v.putNode(updated)
+}
+
+// candidatesFor loads the given nodes and orders them by their distance to
+// n. Missing and tombstoned nodes as well as the ones in skip are left out.
+func (v *vamana) candidatesFor(n *node, ids []uint64,
+	skip map[uint64]struct{},
+) ([]candidate, error) {
+	seen := make(map[uint64]struct{}, len(ids))
+	candidates := make([]candidate, 0, len(ids))
+	for _, id := range ids {
+		if id == n.id {
+			continue
+		}
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		if _, ok := skip[id]; ok {
+			continue
+		}
+
+		other, err := v.nodeByID(id)
+		if err != nil {
+			return nil, err
+		}
+		if other == nil || other.tombstone {
+			continue
+		}
+
+		d, _, err := v.distancerProvider.SingleDist(n.vector, other.vector)
+		if err != nil {
+			return nil, errors.Wrapf(err, "calculate distance between %d and %d", n.id, id)
+		}
+		candidates = append(candidates, candidate{node: other, dist: d})
+	}
+
+	sortCandidates(candidates)
+	return candidates, nil
+}
+
+// robustPrune selects at most maxDegree out-neighbors from the candidates,
+// which must be ordered by their distance to the node. A candidate is left
+// out if an already selected neighbor is closer to it than the node itself
+// by a factor of alpha. This keeps the degree bounded while preserving long
+// range edges, which is what makes the graph navigable in few hops.
+func (v *vamana) robustPrune(candidates []candidate) ([]candidate, error) {
+	selected := make([]candidate, 0, v.maxDegree)
+	pruned := make([]bool, len(candidates))
+
+	for i := range candidates {
+		if len(selected) >= v.maxDegree {
+			break
+		}
+		if pruned[i] {
+			continue
+		}
+
+		current := candidates[i]
+		selected = append(selected, current)
+
+		for j := i + 1; j < len(candidates); j++ {
+			if pruned[j] {
+				continue
+			}
+
+			d, _, err := v.distancerProvider.SingleDist(current.node.vector,
+				candidates[j].node.vector)
+			if err != nil {
+				return nil, errors.Wrapf(err, "calculate distance between %d and %d",
+					current.node.id, candidates[j].node.id)
+			}
+
+			if occludes(d, candidates[j].dist, v.alpha) {
+				pruned[j] = true
+			}
+		}
+	}
+
+	return selected, nil
+}
+
+// occludes returns whether a candidate at distance candidateDist from the
+// node is made redundant by a selected neighbor at distance d from the
+// candidate. Distances can be negative (e.g. dot product), so they are scaled
+// towards zero in that case, an alpha above 1 must always prune less.
+func occludes(d, candidateDist, alpha float32) bool {
+	if d < 0 {
+		return d/alpha <= candidateDist
+	}
+
+	return d*alpha <= candidateDist
+}
+
+func candidateIDs(candidates []candidate) []uint64 {
+	ids := make([]uint64, len(candidates))
+	for i, c := range candidates {
+		ids[i] = c.node.id
+	}
+	return ids
+}
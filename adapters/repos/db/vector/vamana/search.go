@@ -0,0 +1,289 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package vamana
+
+import (
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/priorityqueue"
+	"github.com/weaviate/weaviate/usecases/floatcomp"
+)
+
+const (
+	// searchByDistInitialLimit is the number of results the first iteration
+	// of a search by distance asks for, every following iteration asks for
+	// searchByDistLimitMultiplier times as many
+	searchByDistInitialLimit    = 100
+	searchByDistLimitMultiplier = 10
+)
+
+// candidate is a node together with its distance to the current query
+type candidate struct {
+	node *node
+	dist float32
+}
+
+// searchList is the bounded list of the closest candidates found so far,
+// ordered by distance
+type searchList struct {
+	items []searchListItem
+	size  int
+}
+
+type searchListItem struct {
+	candidate
+	expanded bool
+}
+
+func newSearchList(size int) *searchList {
+	return &searchList{items: make([]searchListItem, 0, size+1), size: size}
+}
+
+func (l *searchList) insert(c candidate) {
+	pos := sort.Search(len(l.items), func(i int) bool {
+		return l.items[i].dist > c.dist
+	})
+	if pos >= l.size {
+		return
+	}
+
+	l.items = append(l.items, searchListItem{})
+	copy(l.items[pos+1:], l.items[pos:])
+	l.items[pos] = searchListItem{candidate: c}
+
+	if len(l.items) > l.size {
+		l.items = l.items[:l.size]
+	}
+}
+
+// nextUnexpanded returns the closest candidate that has not been expanded
+// yet and marks it as expanded
+func (l *searchList) nextUnexpanded() (candidate, bool) {
+	for i := range l.items {
+		if !l.items[i].expanded {
+			l.items[i].expanded = true
+			return l.items[i].candidate, true
+		}
+	}
+
+	return candidate{}, false
+}
+
+// greedySearch traverses the graph starting at the entrypoint, always
+// expanding the closest candidate that has not been expanded yet, until the
+// searchListSize closest candidates have all been expanded. It returns every
+// node whose distance to the query was calculated as well as the expanded
+// ones, both ordered by distance. Tombstoned nodes are traversed as well, it
+// is up to the caller to skip them.
+func (v *vamana) greedySearch(query []float32, searchListSize int) (visited, expanded []candidate, err error) {
+	if !v.store.meta.HasEntrypoint {
+		return nil, nil, nil
+	}
+
+	dist := v.distancerProvider.New(query)
+	seen := map[uint64]struct{}{}
+	list := newSearchList(searchListSize)
+
+	visit := func(id uint64) error {
+		if _, ok := seen[id]; ok {
+			return nil
+		}
+		seen[id] = struct{}{}
+
+		n, err := v.nodeByID(id)
+		if err != nil {
+			return err
+		}
+		if n == nil {
+			// removed by a tombstone cleanup
+			return nil
+		}
+
+		d, _, err := dist.Distance(n.vector)
+		if err != nil {
+			return errors.Wrapf(err, "calculate distance to node %d", id)
+		}
+
+		c := candidate{node: n, dist: d}
+		visited = append(visited, c)
+		list.insert(c)
+		return nil
+	}
+
+	if err := visit(v.store.meta.Entrypoint); err != nil {
+		return nil, nil, err
+	}
+
+	for {
+		current, ok := list.nextUnexpanded()
+		if !ok {
+			break
+		}
+		expanded = append(expanded, current)
+
+		for _, neighbor := range current.node.neighbors {
+			if err := visit(neighbor); err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+
+	sortCandidates(visited)
+	sortCandidates(expanded)
+	return visited, expanded, nil
+}
+
+func sortCandidates(candidates []candidate) {
+	sort.Slice(candidates, func(a, b int) bool {
+		return candidates[a].dist < candidates[b].dist
+	})
+}
+
+func (v *vamana) SearchByVector(vector []float32, k int, allowList helpers.AllowList) ([]uint64, []float32, error) {
+	if v.distancerProvider.Type() == "cosine-dot" {
+		// cosine-dot requires normalized vectors, as the dot product and cosine
+		// similarity are only identical if the vector is normalized
+		vector = distancer.Normalize(vector)
+	}
+
+	v.RLock()
+	defer v.RUnlock()
+
+	if allowList != nil && allowList.Len() < v.flatSearchCutoff {
+		return v.flatSearch(vector, k, allowList)
+	}
+
+	searchListSize := v.searchListSize
+	if k > searchListSize {
+		searchListSize = k
+	}
+
+	visited, _, err := v.greedySearch(vector, searchListSize)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "greedy search")
+	}
+
+	// the filter is applied to every node seen during the search rather than
+	// only to the final search list, so restrictive filters still yield
+	// results if the closest nodes don't match
+	ids := make([]uint64, 0, k)
+	dists := make([]float32, 0, k)
+	for _, c := range visited {
+		if len(ids) >= k {
+			break
+		}
+		if c.node.tombstone {
+			continue
+		}
+		if allowList != nil && !allowList.Contains(c.node.id) {
+			continue
+		}
+
+		ids = append(ids, c.node.id)
+		dists = append(dists, c.dist)
+	}
+
+	return ids, dists, nil
+}
+
+// SearchByVectorDistance calls SearchByVector with an increasing limit until
+// the results contain all vectors within the target distance.
+//
+// The maxLimit param places an upper bound on the number of search results
+// returned, a maxLimit of -1 returns all results within the target distance.
+func (v *vamana) SearchByVectorDistance(vector []float32, targetDistance float32, maxLimit int64,
+	allowList helpers.AllowList,
+) ([]uint64, []float32, error) {
+	limit := searchByDistInitialLimit
+	for {
+		if maxLimit >= 0 && int64(limit) > maxLimit {
+			limit = int(maxLimit)
+		}
+
+		ids, dists, err := v.SearchByVector(vector, limit, allowList)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "vector search")
+		}
+
+		cutoff := len(ids)
+		for i := range dists {
+			if dists[i] > targetDistance &&
+				!floatcomp.InDelta(float64(dists[i]), float64(targetDistance), 1e-6) {
+				cutoff = i
+				break
+			}
+		}
+
+		// either a result outside of the target distance was found or the
+		// index has no more results to offer
+		if cutoff < len(ids) || len(ids) < limit {
+			return ids[:cutoff], dists[:cutoff], nil
+		}
+
+		if maxLimit >= 0 && int64(limit) >= maxLimit {
+			v.logger.
+				WithField("action", "unlimited_vector_search").
+				Warnf("maximum search limit of %d results has been reached", maxLimit)
+			return ids, dists, nil
+		}
+
+		limit *= searchByDistLimitMultiplier
+	}
+}
+
+func (v *vamana) flatSearch(queryVector []float32, limit int,
+	allowList helpers.AllowList,
+) ([]uint64, []float32, error) {
+	results := priorityqueue.NewMax(limit)
+	dist := v.distancerProvider.New(queryVector)
+
+	it := allowList.Iterator()
+	for candidate, ok := it.Next(); ok; candidate, ok = it.Next() {
+		n, err := v.nodeByID(candidate)
+		if err != nil {
+			return nil, nil, err
+		}
+		if n == nil || n.tombstone {
+			continue
+		}
+
+		d, _, err := dist.Distance(n.vector)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "calculate distance to node %d", candidate)
+		}
+
+		if results.Len() < limit {
+			results.Insert(candidate, d)
+		} else if results.Top().Dist > d {
+			results.Pop()
+			results.Insert(candidate, d)
+		}
+	}
+
+	ids := make([]uint64, results.Len())
+	dists := make([]float32, results.Len())
+
+	// results is ordered in reverse, we need to flip the order before presenting
+	// to the user!
+	i := len(ids) - 1
+	for results.Len() > 0 {
+		res := results.Pop()
+		ids[i] = res.ID
+		dists[i] = res.Dist
+		i--
+	}
+
+	return ids, dists, nil
+}
@@ -0,0 +1,327 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package vamana
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	nodesFileName = "nodes"
+	metaFileName  = "meta.json"
+
+	flagPresent   = byte(1 << 0)
+	flagTombstone = byte(1 << 1)
+
+	// flags (1 byte), unused (3 bytes), neighbor count (4 bytes)
+	recordHeaderSize = 8
+
+	// neighborSlackFactor allows a node to temporarily hold more than
+	// maxDegree neighbors, so back edges can be added without pruning the
+	// neighbors on every insert
+	neighborSlackFactor = 1.3
+)
+
+// node is a single vertex of the graph together with its full vector
+type node struct {
+	id        uint64
+	vector    []float32
+	neighbors []uint64
+	tombstone bool
+}
+
+// storeMeta is the part of the index state that is not derived from the node
+// records themselves
+type storeMeta struct {
+	Dimensions    int    `json:"dimensions"`
+	MaxDegree     int    `json:"maxDegree"`
+	Entrypoint    uint64 `json:"entrypoint"`
+	HasEntrypoint bool   `json:"hasEntrypoint"`
+}
+
+// nodeStore persists the graph on disk. Every node occupies a fixed-size
+// record at an offset derived from its id, so any node - vector and
+// out-neighbors - can be read with a single positional read:
+//
+//	| flags (1) | unused (3) | neighbor count (4) | vector (4*dims) | neighbors (8*neighborCapacity) |
+//
+// The dimensions are only known once the first vector is inserted. They are
+// kept in a separate metadata file together with the entrypoint of the
+// graph. Records are updated in place, the file is only synced on Flush.
+type nodeStore struct {
+	dir        string
+	file       *os.File
+	meta       storeMeta
+	recordSize int64
+}
+
+func openNodeStore(dir string, maxDegree int) (*nodeStore, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, errors.Wrapf(err, "create dir %q", dir)
+	}
+
+	s := &nodeStore{dir: dir, meta: storeMeta{MaxDegree: maxDegree}}
+	if err := s.readMeta(); err != nil {
+		return nil, err
+	}
+
+	if s.meta.MaxDegree != maxDegree {
+		return nil, errors.Errorf("maxDegree of stored graph is %d, but config has %d",
+			s.meta.MaxDegree, maxDegree)
+	}
+
+	f, err := os.OpenFile(filepath.Join(dir, nodesFileName), os.O_RDWR|os.O_CREATE, 0o666)
+	if err != nil {
+		return nil, errors.Wrap(err, "open nodes file")
+	}
+	s.file = f
+	s.setRecordSize()
+
+	return s, nil
+}
+
+func (s *nodeStore) readMeta() error {
+	data, err := os.ReadFile(filepath.Join(s.dir, metaFileName))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return errors.Wrap(err, "read meta file")
+	}
+
+	if err := json.Unmarshal(data, &s.meta); err != nil {
+		return errors.Wrap(err, "unmarshal meta file")
+	}
+
+	return nil
+}
+
+// writeMeta replaces the metadata file atomically, so a crash can never
+// leave a partially written file behind
+func (s *nodeStore) writeMeta() error {
+	data, err := json.Marshal(s.meta)
+	if err != nil {
+		return errors.Wrap(err, "marshal meta file")
+	}
+
+	tmpPath := filepath.Join(s.dir, metaFileName+".tmp")
+	if err := os.WriteFile(tmpPath, data, 0o666); err != nil {
+		return errors.Wrap(err, "write meta file")
+	}
+
+	if err := os.Rename(tmpPath, filepath.Join(s.dir, metaFileName)); err != nil {
+		return errors.Wrap(err, "rename meta file")
+	}
+
+	return nil
+}
+
+func (s *nodeStore) setRecordSize() {
+	if s.meta.Dimensions == 0 {
+		s.recordSize = 0
+		return
+	}
+
+	s.recordSize = int64(recordHeaderSize + 4*s.meta.Dimensions + 8*s.neighborCapacity())
+}
+
+// neighborCapacity is the maximum number of neighbors a node record can hold
+func (s *nodeStore) neighborCapacity() int {
+	return int(math.Ceil(float64(s.meta.MaxDegree) * neighborSlackFactor))
+}
+
+// ensureDimensions fixes the dimensions of the graph on the first insert and
+// makes sure every subsequent vector matches them
+func (s *nodeStore) ensureDimensions(dims int) error {
+	if s.meta.Dimensions == dims {
+		return nil
+	}
+
+	if s.meta.Dimensions != 0 {
+		return fmt.Errorf("new node has a vector with length %v. "+
+			"Existing nodes have vectors with length %v", dims, s.meta.Dimensions)
+	}
+
+	s.meta.Dimensions = dims
+	s.setRecordSize()
+	return s.writeMeta()
+}
+
+func (s *nodeStore) setEntrypoint(id uint64, ok bool) error {
+	s.meta.Entrypoint = id
+	s.meta.HasEntrypoint = ok
+	return s.writeMeta()
+}
+
+// size returns the number of records the nodes file can hold, this is one
+// more than the highest id ever written
+func (s *nodeStore) size() (uint64, error) {
+	if s.recordSize == 0 {
+		return 0, nil
+	}
+
+	info, err := s.file.Stat()
+	if err != nil {
+		return 0, errors.Wrap(err, "stat nodes file")
+	}
+
+	return uint64(info.Size() / s.recordSize), nil
+}
+
+// read returns nil if no node with the given id exists
+func (s *nodeStore) read(id uint64) (*node, error) {
+	if s.recordSize == 0 {
+		return nil, nil
+	}
+
+	buf := make([]byte, s.recordSize)
+	n, err := s.file.ReadAt(buf, int64(id)*s.recordSize)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, errors.Wrapf(err, "read node %d", id)
+	}
+	if n < len(buf) {
+		// beyond the end of the file
+		return nil, nil
+	}
+
+	return s.decode(id, buf), nil
+}
+
+func (s *nodeStore) write(n *node) error {
+	buf := s.encode(n)
+	if _, err := s.file.WriteAt(buf, int64(n.id)*s.recordSize); err != nil {
+		return errors.Wrapf(err, "write node %d", n.id)
+	}
+
+	return nil
+}
+
+// clear removes the node from the store, only its header is overwritten as
+// a node without flagPresent is ignored on read
+func (s *nodeStore) clear(id uint64) error {
+	if s.recordSize == 0 {
+		return nil
+	}
+
+	buf := make([]byte, recordHeaderSize)
+	if _, err := s.file.WriteAt(buf, int64(id)*s.recordSize); err != nil {
+		return errors.Wrapf(err, "clear node %d", id)
+	}
+
+	return nil
+}
+
+// scanFlags calls fn for every present node in ascending order of ids. It
+// reads the file sequentially and is meant to be used on startup.
+func (s *nodeStore) scanFlags(fn func(id uint64, tombstone bool)) error {
+	if s.recordSize == 0 {
+		return nil
+	}
+
+	if _, err := s.file.Seek(0, io.SeekStart); err != nil {
+		return errors.Wrap(err, "seek nodes file")
+	}
+
+	r := bufio.NewReaderSize(s.file, 1024*1024)
+	buf := make([]byte, s.recordSize)
+	for id := uint64(0); ; id++ {
+		if _, err := io.ReadFull(r, buf); err != nil {
+			if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+				return nil
+			}
+			return errors.Wrap(err, "scan nodes file")
+		}
+
+		if buf[0]&flagPresent == 0 {
+			continue
+		}
+		fn(id, buf[0]&flagTombstone != 0)
+	}
+}
+
+func (s *nodeStore) encode(n *node) []byte {
+	buf := make([]byte, s.recordSize)
+
+	buf[0] = flagPresent
+	if n.tombstone {
+		buf[0] |= flagTombstone
+	}
+	binary.LittleEndian.PutUint32(buf[4:8], uint32(len(n.neighbors)))
+
+	offset := recordHeaderSize
+	for _, f := range n.vector {
+		binary.LittleEndian.PutUint32(buf[offset:], math.Float32bits(f))
+		offset += 4
+	}
+
+	for _, neighbor := range n.neighbors {
+		binary.LittleEndian.PutUint64(buf[offset:], neighbor)
+		offset += 8
+	}
+
+	return buf
+}
+
+func (s *nodeStore) decode(id uint64, buf []byte) *node {
+	if buf[0]&flagPresent == 0 {
+		return nil
+	}
+
+	n := &node{
+		id:        id,
+		tombstone: buf[0]&flagTombstone != 0,
+		vector:    make([]float32, s.meta.Dimensions),
+		neighbors: make([]uint64, binary.LittleEndian.Uint32(buf[4:8])),
+	}
+
+	offset := recordHeaderSize
+	for i := range n.vector {
+		n.vector[i] = math.Float32frombits(binary.LittleEndian.Uint32(buf[offset:]))
+		offset += 4
+	}
+
+	for i := range n.neighbors {
+		n.neighbors[i] = binary.LittleEndian.Uint64(buf[offset:])
+		offset += 8
+	}
+
+	return n
+}
+
+func (s *nodeStore) sync() error {
+	return s.file.Sync()
+}
+
+func (s *nodeStore) close() error {
+	if err := s.file.Sync(); err != nil {
+		return errors.Wrap(err, "sync nodes file")
+	}
+
+	return s.file.Close()
+}
+
+func (s *nodeStore) drop() error {
+	if err := s.file.Close(); err != nil {
+		return errors.Wrap(err, "close nodes file")
+	}
+
+	return os.RemoveAll(s.dir)
+}
@@ -0,0 +1,198 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+// exactGraphBuilder builds the exact k nearest neighbor graph on the CPU, it
+// stands in for the GPU builder
+type exactGraphBuilder struct{}
+
+func (b *exactGraphBuilder) Build(ctx context.Context, vectors [][]float32,
+	degree int, distance string,
+) ([][]uint32, error) {
+	provider := distancer.NewL2SquaredProvider()
+	graph := make([][]uint32, len(vectors))
+	for i := range vectors {
+		dists := make([]float32, len(vectors))
+		neighbors := make([]uint32, 0, len(vectors)-1)
+		for j := range vectors {
+			if i == j {
+				continue
+			}
+			dist, _, err := provider.SingleDist(vectors[i], vectors[j])
+			if err != nil {
+				return nil, err
+			}
+			dists[j] = dist
+			neighbors = append(neighbors, uint32(j))
+		}
+		sort.Slice(neighbors, func(a, b int) bool {
+			return dists[neighbors[a]] < dists[neighbors[b]]
+		})
+		if len(neighbors) > degree {
+			neighbors = neighbors[:degree]
+		}
+		graph[i] = neighbors
+	}
+	return graph, nil
+}
+
+func TestBuildVectorIndex(t *testing.T) {
+	restore := newGraphBuilder
+	newGraphBuilder = func() (hnsw.GraphBuilder, error) { return &exactGraphBuilder{}, nil }
+	defer func() { newGraphBuilder = restore }()
+
+	dirName := t.TempDir()
+	logger, _ := test.NewNullLogger()
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer repo.Shutdown(context.Background())
+
+	migrator := NewMigrator(repo, logger)
+	vectorIndexConfig := enthnsw.NewDefaultUserConfig()
+	vectorIndexConfig.Distance = enthnsw.DistanceL2Squared
+	vectorIndexConfig.IndexBuild = enthnsw.IndexBuildGPU
+	class := &models.Class{
+		Class:               "GPUBuildArticle",
+		VectorIndexConfig:   vectorIndexConfig,
+		InvertedIndexConfig: invertedConfig(),
+		Properties: []*models.Property{
+			{
+				Name:     "headline",
+				DataType: schema.DataTypeText.PropString(),
+			},
+		},
+	}
+	shardName := schemaGetter.shardState.AllPhysicalShards()[0]
+
+	randomVector := func() []float32 {
+		vector := make([]float32, 8)
+		for j := range vector {
+			vector[j] = rand.Float32()
+		}
+		return vector
+	}
+	putObject := func(t *testing.T) {
+		require.Nil(t, repo.PutObject(context.Background(), &models.Object{
+			Class:      class.Class,
+			ID:         strfmt.UUID(uuid.NewString()),
+			Properties: map[string]interface{}{"headline": "article"},
+		}, randomVector(), nil))
+	}
+	search := func(t *testing.T, vector []float32, exact bool) []strfmt.UUID {
+		res, err := repo.VectorSearch(context.Background(), dto.GetParams{
+			ClassName:    class.Class,
+			SearchVector: vector,
+			Pagination:   &filters.Pagination{Limit: 10},
+			Exact:        exact,
+		})
+		require.Nil(t, err)
+		ids := make([]strfmt.UUID, len(res))
+		for i := range res {
+			ids[i] = res[i].ID
+		}
+		return ids
+	}
+
+	t.Run("creating the class and adding objects", func(t *testing.T) {
+		require.Nil(t,
+			migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+		schemaGetter.schema.Objects = &models.Schema{
+			Classes: []*models.Class{class},
+		}
+
+		for i := 0; i < 200; i++ {
+			putObject(t)
+		}
+	})
+
+	t.Run("inserts are deferred until the build", func(t *testing.T) {
+		assert.Empty(t, search(t, randomVector(), false))
+	})
+
+	t.Run("building a shard which is not READONLY", func(t *testing.T) {
+		_, err := migrator.BuildVectorIndex(context.Background(), class.Class, shardName, "")
+		assert.ErrorContains(t, err, "must be READONLY")
+	})
+
+	t.Run("building a non-existing class or shard", func(t *testing.T) {
+		_, err := migrator.BuildVectorIndex(context.Background(), "WrongClass", shardName, "")
+		assert.NotNil(t, err)
+
+		_, err = migrator.BuildVectorIndex(context.Background(), class.Class, "wrongShard", "")
+		assert.NotNil(t, err)
+	})
+
+	t.Run("building the vector index", func(t *testing.T) {
+		require.Nil(t, migrator.UpdateShardStatus(context.Background(),
+			class.Class, shardName, "READONLY"))
+
+		res, err := migrator.BuildVectorIndex(context.Background(), class.Class, shardName, "")
+		require.Nil(t, err)
+		assert.Equal(t, shardName, res.Shard)
+		assert.Equal(t, int64(200), res.Nodes)
+		assert.Equal(t, int64(8), res.Dimensions)
+
+		require.Nil(t, migrator.UpdateShardStatus(context.Background(),
+			class.Class, shardName, "READY"))
+	})
+
+	t.Run("searching the built index", func(t *testing.T) {
+		for i := 0; i < 10; i++ {
+			vector := randomVector()
+			expected := search(t, vector, true)
+			require.Len(t, expected, 10)
+			assert.Equal(t, expected, search(t, vector, false))
+		}
+	})
+
+	t.Run("inserts after the build are indexed", func(t *testing.T) {
+		vector := randomVector()
+		id := strfmt.UUID(uuid.NewString())
+		require.Nil(t, repo.PutObject(context.Background(), &models.Object{
+			Class:      class.Class,
+			ID:         id,
+			Properties: map[string]interface{}{"headline": "article"},
+		}, vector, nil))
+
+		assert.Equal(t, id, search(t, vector, false)[0])
+	})
+}
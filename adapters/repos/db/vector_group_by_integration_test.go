@@ -0,0 +1,143 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestGroupedVectorSearch(t *testing.T) {
+	dirName := t.TempDir()
+
+	logger, _ := test.NewNullLogger()
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer repo.Shutdown(context.Background())
+
+	migrator := NewMigrator(repo, logger)
+	vectorIndexConfig := enthnsw.NewDefaultUserConfig()
+	vectorIndexConfig.Distance = enthnsw.DistanceL2Squared
+	class := &models.Class{
+		Class:               "GroupedArticle",
+		VectorIndexConfig:   vectorIndexConfig,
+		InvertedIndexConfig: invertedConfig(),
+		Properties: []*models.Property{
+			{
+				Name:         "category",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationField,
+			},
+		},
+	}
+
+	// the objects are ordered by their distance to the origin, every 20th
+	// object belongs to the same category
+	objectCount, categoryCount := 200, 20
+
+	t.Run("creating the class and adding objects", func(t *testing.T) {
+		require.Nil(t,
+			migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+		schemaGetter.schema.Objects = &models.Schema{
+			Classes: []*models.Class{class},
+		}
+
+		for i := 0; i < objectCount; i++ {
+			require.Nil(t, repo.PutObject(context.Background(), &models.Object{
+				Class: class.Class,
+				ID:    strfmt.UUID(uuid.NewString()),
+				Properties: map[string]interface{}{
+					"category": fmt.Sprintf("category-%d", i%categoryCount),
+				},
+			}, []float32{float32(i), 0}, nil))
+		}
+	})
+
+	search := func(t *testing.T, groupBy *searchparams.GroupBy, exact bool) []*additional.Group {
+		res, err := repo.VectorSearch(context.Background(), dto.GetParams{
+			ClassName:    class.Class,
+			SearchVector: []float32{0, 0},
+			Pagination:   &filters.Pagination{Limit: groupBy.Groups},
+			GroupBy:      groupBy,
+			Exact:        exact,
+			AdditionalProperties: additional.Properties{
+				Group: true,
+			},
+		})
+		require.Nil(t, err)
+
+		groups := make([]*additional.Group, len(res))
+		for i := range res {
+			group, ok := res[i].AdditionalProperties["group"].(*additional.Group)
+			require.True(t, ok)
+			groups[i] = group
+		}
+		return groups
+	}
+
+	for _, exact := range []bool{false, true} {
+		t.Run(fmt.Sprintf("filling the groups with a limit of the number of groups, exact=%v", exact), func(t *testing.T) {
+			groups := search(t, &searchparams.GroupBy{
+				Property:        "category",
+				Groups:          5,
+				ObjectsPerGroup: 3,
+			}, exact)
+
+			require.Len(t, groups, 5)
+			for i, group := range groups {
+				assert.Equal(t, fmt.Sprintf("category-%d", i), group.GroupedBy.Value)
+				require.Len(t, group.Hits, 3)
+				for j, hit := range group.Hits {
+					pos := float32(i + j*categoryCount)
+					assert.Equal(t, pos*pos, hit["_additional"].(*additional.GroupHitAdditional).Distance)
+				}
+			}
+		})
+	}
+
+	t.Run("requesting more groups than exist", func(t *testing.T) {
+		groups := search(t, &searchparams.GroupBy{
+			Property:        "category",
+			Groups:          30,
+			ObjectsPerGroup: 2,
+		}, false)
+
+		require.Len(t, groups, categoryCount)
+		for _, group := range groups {
+			assert.Len(t, group.Hits, 2)
+		}
+	})
+}
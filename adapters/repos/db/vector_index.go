@@ -14,8 +14,11 @@ package db
 import (
 	"context"
 
+	"github.com/pkg/errors"
+
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/searchparams"
 )
 
 // VectorIndex is anything that indexes vectors efficiently. For an example
@@ -36,3 +39,77 @@ type VectorIndex interface {
 	PostStartup()
 	ValidateBeforeInsert(vector []float32) error
 }
+
+// updatableVectorIndex is implemented by vector indexes which can replace
+// the vector of an id without it being deleted and added with a new id
+type updatableVectorIndex interface {
+	Update(id uint64, vector []float32) error
+}
+
+// rescoringVectorIndex is implemented by vector indexes which rescore the
+// candidates of a compressed index and let a query override how many
+// candidates are considered
+type rescoringVectorIndex interface {
+	SearchByVectorWithRescore(vector []float32, k int, allow helpers.AllowList,
+		rescore *searchparams.Rescore) ([]uint64, []float32, error)
+}
+
+// searchByVectorWithRescore applies the per-query rescore overrides if the
+// vector index supports them and falls back to a regular search otherwise
+func searchByVectorWithRescore(vi VectorIndex, vector []float32, k int,
+	allow helpers.AllowList, rescore *searchparams.Rescore,
+) ([]uint64, []float32, error) {
+	if rescore != nil {
+		if rvi, ok := vi.(rescoringVectorIndex); ok {
+			return rvi.SearchByVectorWithRescore(vector, k, allow, rescore)
+		}
+	}
+	return vi.SearchByVector(vector, k, allow)
+}
+
+// cancellableVectorIndex is implemented by vector indexes which stop a
+// search once the context of the query is done
+type cancellableVectorIndex interface {
+	SearchByVectorWithContext(ctx context.Context, vector []float32, k int,
+		allow helpers.AllowList, rescore *searchparams.Rescore) ([]uint64, []float32, error)
+}
+
+// searchByVectorWithContext aborts the search when the context is done, e.g.
+// because the query timed out. Indexes which can't be interrupted complete
+// the search, but their results are discarded if the context is done by then.
+func searchByVectorWithContext(ctx context.Context, vi VectorIndex, vector []float32,
+	k int, allow helpers.AllowList, rescore *searchparams.Rescore,
+) ([]uint64, []float32, error) {
+	if cvi, ok := vi.(cancellableVectorIndex); ok {
+		return cvi.SearchByVectorWithContext(ctx, vector, k, allow, rescore)
+	}
+
+	ids, dists, err := searchByVectorWithRescore(vi, vector, k, allow, rescore)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, nil, err
+	}
+	return ids, dists, nil
+}
+
+// exactVectorIndex is implemented by vector indexes which can bypass their
+// approximate search and compare the query to every (allowed) vector
+type exactVectorIndex interface {
+	SearchByVectorExact(vector []float32, k int,
+		allow helpers.AllowList) ([]uint64, []float32, error)
+}
+
+// searchByVectorExact brute-forces the search if the vector index supports
+// it. Silently falling back to an approximate search would defeat the purpose
+// of an exact query, so other indexes return an error.
+func searchByVectorExact(vi VectorIndex, vector []float32, k int,
+	allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	evi, ok := vi.(exactVectorIndex)
+	if !ok {
+		return nil, nil, errors.Errorf("vector index %T does not support exact search", vi)
+	}
+	return evi.SearchByVectorExact(vector, k, allow)
+}
@@ -0,0 +1,122 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestVectorIndexIntegrity(t *testing.T) {
+	dirName := t.TempDir()
+
+	logger, _ := test.NewNullLogger()
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer repo.Shutdown(context.Background())
+
+	migrator := NewMigrator(repo, logger)
+	class := &models.Class{
+		Class:               "IntegrityArticle",
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		Properties: []*models.Property{
+			{
+				Name:     "headline",
+				DataType: schema.DataTypeText.PropString(),
+			},
+		},
+	}
+
+	t.Run("creating the class and adding objects", func(t *testing.T) {
+		require.Nil(t,
+			migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+		schemaGetter.schema.Objects = &models.Schema{
+			Classes: []*models.Class{class},
+		}
+
+		ids := []strfmt.UUID{
+			"0b7f5c1a-8c4e-4d2b-9a6f-1e3d5c7b9a01",
+			"0b7f5c1a-8c4e-4d2b-9a6f-1e3d5c7b9a02",
+			"0b7f5c1a-8c4e-4d2b-9a6f-1e3d5c7b9a03",
+		}
+		vectors := [][]float32{{1, 0, 0}, {0.8, 0.2, 0}, {0, 0, 1}}
+		for i, id := range ids {
+			require.Nil(t, repo.PutObject(context.Background(), &models.Object{
+				Class:      class.Class,
+				ID:         id,
+				Properties: map[string]interface{}{"headline": "article"},
+			}, vectors[i], nil))
+		}
+	})
+
+	t.Run("the shards are not reported before they are checked", func(t *testing.T) {
+		status, err := repo.GetNodeStatus(context.Background(), class.Class)
+		require.Nil(t, err)
+		require.Len(t, status, 1)
+		require.Len(t, status[0].Shards, 1)
+		assert.Nil(t, status[0].Shards[0].VectorIndexIntegrity)
+	})
+
+	t.Run("checking a non-existing class", func(t *testing.T) {
+		_, err := migrator.CheckVectorIndexIntegrity(context.Background(), "WrongClass", false)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("checking the class", func(t *testing.T) {
+		res, err := migrator.CheckVectorIndexIntegrity(context.Background(), class.Class, false)
+		require.Nil(t, err)
+		require.Len(t, res, 1)
+		assert.Equal(t, int64(3), res[0].Nodes)
+		assert.Equal(t, int64(0), res[0].OrphanNodes)
+		assert.Equal(t, int64(0), res[0].BrokenLinks)
+		assert.Equal(t, int64(0), res[0].UnreachableNodes)
+		assert.True(t, res[0].EntrypointValid)
+		assert.False(t, res[0].Repaired)
+	})
+
+	t.Run("repairing all classes leaves a healthy graph untouched", func(t *testing.T) {
+		res, err := migrator.CheckVectorIndexIntegrity(context.Background(), "", true)
+		require.Nil(t, err)
+		require.Len(t, res, 1)
+		assert.True(t, res[0].EntrypointValid)
+		assert.False(t, res[0].Repaired)
+	})
+
+	t.Run("the latest result is reported by the nodes API", func(t *testing.T) {
+		status, err := repo.GetNodeStatus(context.Background(), class.Class)
+		require.Nil(t, err)
+		require.Len(t, status[0].Shards, 1)
+		integrity := status[0].Shards[0].VectorIndexIntegrity
+		require.Len(t, integrity, 1)
+		assert.NotZero(t, integrity[0].CheckedAt)
+		assert.Equal(t, int64(3), integrity[0].Nodes)
+	})
+}
@@ -0,0 +1,102 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestVectorIndexRecall(t *testing.T) {
+	dirName := t.TempDir()
+
+	logger, _ := test.NewNullLogger()
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer repo.Shutdown(context.Background())
+
+	migrator := NewMigrator(repo, logger)
+	class := &models.Class{
+		Class:               "RecallArticle",
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		Properties: []*models.Property{
+			{
+				Name:     "headline",
+				DataType: schema.DataTypeText.PropString(),
+			},
+		},
+	}
+
+	t.Run("creating the class and adding objects", func(t *testing.T) {
+		require.Nil(t,
+			migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+		schemaGetter.schema.Objects = &models.Schema{
+			Classes: []*models.Class{class},
+		}
+
+		for i := 0; i < 100; i++ {
+			vector := make([]float32, 8)
+			for j := range vector {
+				vector[j] = rand.Float32()
+			}
+			require.Nil(t, repo.PutObject(context.Background(), &models.Object{
+				Class:      class.Class,
+				ID:         strfmt.UUID(uuid.NewString()),
+				Properties: map[string]interface{}{"headline": "article"},
+			}, vector, nil))
+		}
+	})
+
+	t.Run("measuring a non-existing class", func(t *testing.T) {
+		_, err := migrator.MeasureVectorIndexRecall(context.Background(), "WrongClass", 20, 5)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("measuring the class", func(t *testing.T) {
+		res, err := migrator.MeasureVectorIndexRecall(context.Background(), class.Class, 20, 5)
+		require.Nil(t, err)
+		require.Len(t, res, 1)
+		assert.Equal(t, int64(20), res[0].SampleSize)
+		assert.Equal(t, int64(5), res[0].K)
+		assert.GreaterOrEqual(t, res[0].Ef, int64(5))
+		assert.Greater(t, res[0].Recall, 0.9)
+		assert.NotZero(t, res[0].MeasuredAt)
+	})
+
+	t.Run("measuring all classes", func(t *testing.T) {
+		res, err := migrator.MeasureVectorIndexRecall(context.Background(), "", 200, 5)
+		require.Nil(t, err)
+		require.Len(t, res, 1)
+		assert.Equal(t, int64(100), res[0].SampleSize)
+	})
+}
@@ -0,0 +1,155 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"math/rand"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestVectorIndexSnapshot(t *testing.T) {
+	dirName := t.TempDir()
+	snapshotPath := filepath.Join(t.TempDir(), "source.snapshot")
+
+	logger, _ := test.NewNullLogger()
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer repo.Shutdown(context.Background())
+
+	migrator := NewMigrator(repo, logger)
+	newClass := func(name string) *models.Class {
+		return &models.Class{
+			Class:               name,
+			VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+			InvertedIndexConfig: invertedConfig(),
+			Properties: []*models.Property{
+				{
+					Name:     "headline",
+					DataType: schema.DataTypeText.PropString(),
+				},
+			},
+		}
+	}
+	source := newClass("SnapshotSource")
+	target := newClass("SnapshotTarget")
+	shardName := schemaGetter.shardState.AllPhysicalShards()[0]
+
+	t.Run("creating the classes and adding objects", func(t *testing.T) {
+		for _, class := range []*models.Class{source, target} {
+			require.Nil(t,
+				migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+		}
+		schemaGetter.schema.Objects = &models.Schema{
+			Classes: []*models.Class{source, target},
+		}
+
+		// the target gets the same objects in the same order but without
+		// vectors, as if they were imported ahead of a graph built offline
+		for i := 0; i < 100; i++ {
+			vector := make([]float32, 8)
+			for j := range vector {
+				vector[j] = rand.Float32()
+			}
+			id := strfmt.UUID(uuid.NewString())
+			props := map[string]interface{}{"headline": "article"}
+			require.Nil(t, repo.PutObject(context.Background(), &models.Object{
+				Class: source.Class, ID: id, Properties: props,
+			}, vector, nil))
+			require.Nil(t, repo.PutObject(context.Background(), &models.Object{
+				Class: target.Class, ID: id, Properties: props,
+			}, nil, nil))
+		}
+	})
+
+	t.Run("exporting a non-existing class or shard", func(t *testing.T) {
+		_, err := migrator.ExportVectorIndexSnapshot(context.Background(),
+			"WrongClass", shardName, "", snapshotPath)
+		assert.NotNil(t, err)
+
+		_, err = migrator.ExportVectorIndexSnapshot(context.Background(),
+			source.Class, "wrongShard", "", snapshotPath)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("exporting the source shard", func(t *testing.T) {
+		res, err := migrator.ExportVectorIndexSnapshot(context.Background(),
+			source.Class, shardName, "", snapshotPath)
+		require.Nil(t, err)
+		assert.Equal(t, shardName, res.Shard)
+		assert.Equal(t, int64(100), res.Nodes)
+		assert.Equal(t, int64(8), res.Dimensions)
+		assert.NotEmpty(t, res.Distance)
+	})
+
+	t.Run("importing into the target shard", func(t *testing.T) {
+		res, err := migrator.ImportVectorIndexSnapshot(context.Background(),
+			target.Class, shardName, "", snapshotPath)
+		require.Nil(t, err)
+		assert.Equal(t, int64(100), res.Nodes)
+		assert.Equal(t, int64(8), res.Dimensions)
+	})
+
+	t.Run("searching the target returns the source results", func(t *testing.T) {
+		search := func(className string, vector []float32) []strfmt.UUID {
+			res, err := repo.VectorSearch(context.Background(), dto.GetParams{
+				ClassName:    className,
+				SearchVector: vector,
+				Pagination:   &filters.Pagination{Limit: 10},
+			})
+			require.Nil(t, err)
+			ids := make([]strfmt.UUID, len(res))
+			for i := range res {
+				ids[i] = res[i].ID
+			}
+			return ids
+		}
+
+		for i := 0; i < 10; i++ {
+			vector := make([]float32, 8)
+			for j := range vector {
+				vector[j] = rand.Float32()
+			}
+			expected := search(source.Class, vector)
+			require.Len(t, expected, 10)
+			assert.Equal(t, expected, search(target.Class, vector))
+		}
+	})
+
+	t.Run("importing into a non-empty shard", func(t *testing.T) {
+		_, err := migrator.ImportVectorIndexSnapshot(context.Background(),
+			source.Class, shardName, "", snapshotPath)
+		assert.NotNil(t, err)
+	})
+}
@@ -0,0 +1,140 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestVectorIndexTombstoneCleanup(t *testing.T) {
+	dirName := t.TempDir()
+
+	logger, _ := test.NewNullLogger()
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer repo.Shutdown(context.Background())
+
+	migrator := NewMigrator(repo, logger)
+	class := &models.Class{
+		Class:               "TombstoneCleanupArticle",
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		Properties: []*models.Property{
+			{
+				Name:     "headline",
+				DataType: schema.DataTypeText.PropString(),
+			},
+		},
+	}
+	ids := []strfmt.UUID{
+		"5d1c7a3e-2b4f-4e6a-8c9d-0f1e2d3c4b01",
+		"5d1c7a3e-2b4f-4e6a-8c9d-0f1e2d3c4b02",
+		"5d1c7a3e-2b4f-4e6a-8c9d-0f1e2d3c4b03",
+	}
+
+	t.Run("creating the class and adding objects", func(t *testing.T) {
+		require.Nil(t,
+			migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+		schemaGetter.schema.Objects = &models.Schema{
+			Classes: []*models.Class{class},
+		}
+
+		vectors := [][]float32{{1, 0, 0}, {0.8, 0.2, 0}, {0, 0, 1}}
+		for i, id := range ids {
+			require.Nil(t, repo.PutObject(context.Background(), &models.Object{
+				Class:      class.Class,
+				ID:         id,
+				Properties: map[string]interface{}{"headline": "article"},
+			}, vectors[i], nil))
+		}
+	})
+
+	t.Run("controlling a non-existing class", func(t *testing.T) {
+		_, err := migrator.VectorIndexTombstoneCleanup(context.Background(), "WrongClass", nil)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("pausing the cleanup keeps the tombstones", func(t *testing.T) {
+		paused := true
+		res, err := migrator.VectorIndexTombstoneCleanup(context.Background(), class.Class, &paused)
+		require.Nil(t, err)
+		require.Len(t, res, 1)
+		assert.True(t, res[0].Paused)
+		assert.Equal(t, int64(enthnsw.DefaultCleanupConcurrency), res[0].Concurrency)
+
+		require.Nil(t, repo.DeleteObject(context.Background(), class.Class, ids[0], nil, ""))
+
+		res, err = migrator.VectorIndexTombstoneCleanup(context.Background(), class.Class, nil)
+		require.Nil(t, err)
+		require.Len(t, res, 1)
+		assert.True(t, res[0].Paused)
+		assert.Equal(t, int64(1), res[0].RemainingTombstones)
+	})
+
+	t.Run("the state is reported by the nodes API", func(t *testing.T) {
+		status, err := repo.GetNodeStatus(context.Background(), class.Class)
+		require.Nil(t, err)
+		require.Len(t, status[0].Shards, 1)
+		cleanup := status[0].Shards[0].VectorIndexTombstoneCleanup
+		require.Len(t, cleanup, 1)
+		assert.True(t, cleanup[0].Paused)
+		assert.Equal(t, int64(1), cleanup[0].RemainingTombstones)
+	})
+
+	t.Run("updating the interval and concurrency", func(t *testing.T) {
+		updated := enthnsw.NewDefaultUserConfig()
+		updated.CleanupIntervalSeconds = 1
+		updated.CleanupConcurrency = 2
+		require.Nil(t, migrator.UpdateVectorIndexConfig(context.Background(), class.Class, updated))
+
+		res, err := migrator.VectorIndexTombstoneCleanup(context.Background(), class.Class, nil)
+		require.Nil(t, err)
+		require.Len(t, res, 1)
+		assert.Equal(t, int64(2), res[0].Concurrency)
+		// the restarted cycle keeps the vector index paused
+		assert.True(t, res[0].Paused)
+	})
+
+	t.Run("resuming the cleanup removes the tombstones", func(t *testing.T) {
+		paused := false
+		res, err := migrator.VectorIndexTombstoneCleanup(context.Background(), class.Class, &paused)
+		require.Nil(t, err)
+		require.Len(t, res, 1)
+		assert.False(t, res[0].Paused)
+
+		assert.Eventually(t, func() bool {
+			res, err := migrator.VectorIndexTombstoneCleanup(context.Background(), class.Class, nil)
+			return err == nil && len(res) == 1 && res[0].RemainingTombstones == 0 &&
+				res[0].RemovedTombstones == 1
+		}, 10*time.Second, 100*time.Millisecond)
+	})
+}
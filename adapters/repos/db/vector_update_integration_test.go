@@ -0,0 +1,178 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+func TestUpdateVectorOnly(t *testing.T) {
+	dirName := t.TempDir()
+
+	logger, _ := test.NewNullLogger()
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer repo.Shutdown(context.Background())
+
+	vectorIndexConfig := enthnsw.NewDefaultUserConfig()
+	vectorIndexConfig.Distance = "l2-squared"
+
+	migrator := NewMigrator(repo, logger)
+	class := &models.Class{
+		Class:               "ReEmbeddedArticle",
+		VectorIndexConfig:   vectorIndexConfig,
+		InvertedIndexConfig: invertedConfig(),
+		Properties: []*models.Property{
+			{
+				Name:         "headline",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationWord,
+			},
+		},
+	}
+
+	ids := []strfmt.UUID{
+		"4f0c8a1e-2b3d-4c5e-8f6a-000000000001",
+		"4f0c8a1e-2b3d-4c5e-8f6a-000000000002",
+	}
+
+	nearest := func(t *testing.T, vector []float32) strfmt.UUID {
+		res, err := repo.VectorSearch(context.Background(), dto.GetParams{
+			ClassName:    class.Class,
+			SearchVector: vector,
+			Pagination:   &filters.Pagination{Limit: 1},
+		})
+		require.Nil(t, err)
+		require.Len(t, res, 1)
+		return res[0].ID
+	}
+
+	t.Run("importing objects", func(t *testing.T) {
+		require.Nil(t,
+			migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+		schemaGetter.schema.Objects = &models.Schema{
+			Classes: []*models.Class{class},
+		}
+
+		require.Nil(t, repo.PutObject(context.Background(), &models.Object{
+			Class:      class.Class,
+			ID:         ids[0],
+			Properties: map[string]interface{}{"headline": "alpha"},
+		}, []float32{1, 0, 0}, nil))
+		require.Nil(t, repo.PutObject(context.Background(), &models.Object{
+			Class:      class.Class,
+			ID:         ids[1],
+			Properties: map[string]interface{}{"headline": "beta"},
+		}, []float32{0, 1, 0}, nil))
+
+		assert.Equal(t, ids[0], nearest(t, []float32{1, 0, 0}))
+	})
+
+	docID := func(t *testing.T, id strfmt.UUID) uint64 {
+		obj, err := repo.GetIndex(schema.ClassName(class.Class)).objectByID(
+			context.Background(), id, nil, additional.Properties{}, nil, "")
+		require.Nil(t, err)
+		require.NotNil(t, obj)
+		return obj.DocID()
+	}
+
+	var initialDocID uint64
+
+	t.Run("replacing only the vector", func(t *testing.T) {
+		initialDocID = docID(t, ids[0])
+
+		require.Nil(t, repo.Merge(context.Background(), objects.MergeDocument{
+			Class:      class.Class,
+			ID:         ids[0],
+			Vector:     []float32{0, 0, 1},
+			UpdateTime: 1000,
+		}, nil, ""))
+	})
+
+	t.Run("the new vector is indexed and the old one is gone", func(t *testing.T) {
+		assert.Equal(t, ids[0], nearest(t, []float32{0, 0, 1}))
+		assert.Equal(t, ids[1], nearest(t, []float32{0.9, 0.1, 0}))
+	})
+
+	t.Run("the properties are kept", func(t *testing.T) {
+		res, err := repo.Object(context.Background(), class.Class, ids[0], nil,
+			additional.Properties{Vector: true}, nil, "")
+		require.Nil(t, err)
+		require.NotNil(t, res)
+		assert.Equal(t, []float32{0, 0, 1}, []float32(res.Vector))
+		assert.Equal(t, "alpha", res.Schema.(map[string]interface{})["headline"])
+		assert.Equal(t, int64(1000), res.Updated)
+	})
+
+	t.Run("the object keeps its doc id", func(t *testing.T) {
+		assert.Equal(t, initialDocID, docID(t, ids[0]))
+	})
+
+	t.Run("the inverted index still points to the object", func(t *testing.T) {
+		res, err := repo.Search(context.Background(), dto.GetParams{
+			ClassName:  class.Class,
+			Pagination: &filters.Pagination{Limit: 10},
+			Filters: &filters.LocalFilter{
+				Root: &filters.Clause{
+					Operator: filters.OperatorEqual,
+					On: &filters.Path{
+						Class:    schema.ClassName(class.Class),
+						Property: "headline",
+					},
+					Value: &filters.Value{
+						Value: "alpha",
+						Type:  schema.DataTypeText,
+					},
+				},
+			},
+		})
+		require.Nil(t, err)
+		require.Len(t, res, 1)
+		assert.Equal(t, ids[0], res[0].ID)
+	})
+
+	t.Run("merging properties assigns a new doc id", func(t *testing.T) {
+		require.Nil(t, repo.Merge(context.Background(), objects.MergeDocument{
+			Class:           class.Class,
+			ID:              ids[0],
+			PrimitiveSchema: map[string]interface{}{"headline": "gamma"},
+			Vector:          []float32{0, 0.5, 1},
+			UpdateTime:      2000,
+		}, nil, ""))
+
+		assert.NotEqual(t, initialDocID, docID(t, ids[0]))
+		assert.Equal(t, ids[0], nearest(t, []float32{0, 0.5, 1}))
+	})
+}
@@ -0,0 +1,233 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/weaviate/weaviate/entities/models"
+	ucs "github.com/weaviate/weaviate/usecases/schema"
+	bolt "go.etcd.io/bbolt"
+)
+
+// historyBucket records every mutation of the schema. Entries are keyed by
+// the schema version after the mutation, which is the sequence of the bucket
+var historyBucket = []byte("schema_history")
+
+// historyEntry is a single mutation of the schema
+type historyEntry struct {
+	Timestamp int64    `json:"timestamp"`
+	Operation string   `json:"operation"`
+	Class     string   `json:"class,omitempty"`
+	Shards    []string `json:"shards,omitempty"`
+
+	// Metadata of the class after it has been added or updated
+	Metadata json.RawMessage `json:"metadata,omitempty"`
+
+	// Classes of the complete schema recorded by a snapshot
+	Classes []historyClass `json:"classes,omitempty"`
+}
+
+type historyClass struct {
+	Name     string          `json:"name"`
+	Metadata json.RawMessage `json:"metadata"`
+}
+
+// History returns up to limit schema versions after the given version in
+// ascending order. All versions are returned if limit is not positive.
+func (r *store) History(ctx context.Context, after uint64, limit int) (*models.SchemaVersions, error) {
+	res := &models.SchemaVersions{Versions: []*models.SchemaVersion{}}
+	f := func(tx *bolt.Tx) error {
+		b := tx.Bucket(historyBucket)
+		res.CurrentVersion = int64(b.Sequence())
+		cursor := b.Cursor()
+		for key, value := cursor.Seek(encodeVersion(after + 1)); key != nil; {
+			if limit > 0 && len(res.Versions) == limit {
+				break
+			}
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			var entry historyEntry
+			if err := json.Unmarshal(value, &entry); err != nil {
+				return fmt.Errorf("unmarshal schema version %d: %w", decodeVersion(key), err)
+			}
+			res.Versions = append(res.Versions, &models.SchemaVersion{
+				Version:   int64(decodeVersion(key)),
+				Timestamp: entry.Timestamp,
+				Operation: entry.Operation,
+				Class:     entry.Class,
+				Shards:    entry.Shards,
+			})
+			key, value = cursor.Next()
+		}
+		return nil
+	}
+	if err := r.db.View(f); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// SchemaAtVersion rebuilds the schema as of the given version by replaying
+// the recorded mutations. ucs.ErrNotFound is returned if the version does not
+// exist.
+func (r *store) SchemaAtVersion(ctx context.Context, version uint64) (*models.Schema, error) {
+	var (
+		order   []string
+		classes = map[string]json.RawMessage{}
+	)
+	f := func(tx *bolt.Tx) error {
+		b := tx.Bucket(historyBucket)
+		last := encodeVersion(version)
+		if b.Get(last) == nil {
+			return ucs.ErrNotFound
+		}
+		cursor := b.Cursor()
+		for key, value := cursor.First(); key != nil && bytes.Compare(key, last) <= 0; {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			var entry historyEntry
+			if err := json.Unmarshal(value, &entry); err != nil {
+				return fmt.Errorf("unmarshal schema version %d: %w", decodeVersion(key), err)
+			}
+			switch entry.Operation {
+			case models.SchemaVersionOperationSnapshot:
+				order = order[:0]
+				classes = make(map[string]json.RawMessage, len(entry.Classes))
+				for _, cls := range entry.Classes {
+					order = append(order, cls.Name)
+					classes[cls.Name] = cls.Metadata
+				}
+			case models.SchemaVersionOperationAddClass, models.SchemaVersionOperationUpdateClass:
+				if entry.Metadata == nil {
+					break // only the sharding state was updated
+				}
+				if _, ok := classes[entry.Class]; !ok {
+					order = append(order, entry.Class)
+				}
+				classes[entry.Class] = entry.Metadata
+			case models.SchemaVersionOperationDeleteClass:
+				if _, ok := classes[entry.Class]; !ok {
+					break
+				}
+				delete(classes, entry.Class)
+				for i, name := range order {
+					if name == entry.Class {
+						order = append(order[:i], order[i+1:]...)
+						break
+					}
+				}
+			}
+			key, value = cursor.Next()
+		}
+		return nil
+	}
+	if err := r.db.View(f); err != nil {
+		return nil, err
+	}
+
+	schema := &models.Schema{Classes: make([]*models.Class, 0, len(order))}
+	for _, name := range order {
+		cls := &models.Class{}
+		if err := json.Unmarshal(classes[name], cls); err != nil {
+			return nil, fmt.Errorf("unmarshal class %q", name)
+		}
+		schema.Classes = append(schema.Classes, cls)
+	}
+	return schema, nil
+}
+
+// appendHistory records entry as the next schema version
+func appendHistory(tx *bolt.Tx, entry historyEntry) error {
+	b := tx.Bucket(historyBucket)
+	version, err := b.NextSequence()
+	if err != nil {
+		return fmt.Errorf("next schema version: %w", err)
+	}
+	entry.Timestamp = time.Now().UnixMilli()
+	data, err := json.Marshal(&entry)
+	if err != nil {
+		return fmt.Errorf("marshal schema version %d: %w", version, err)
+	}
+	if err := b.Put(encodeVersion(version), data); err != nil {
+		return fmt.Errorf("write schema version %d: %w", version, err)
+	}
+	return nil
+}
+
+// appendSnapshot records the complete schema as the next schema version,
+// unless no class has changed compared to prev. The first snapshot is always
+// recorded, so that the history of schemas created before it was introduced
+// has a starting point.
+func appendSnapshot(tx *bolt.Tx, root *bolt.Bucket, ss ucs.State, prev map[string][]byte) error {
+	classes := make([]historyClass, 0, len(ss.ObjectSchema.Classes))
+	changed := len(prev) != len(ss.ObjectSchema.Classes) ||
+		tx.Bucket(historyBucket).Sequence() == 0
+	for _, cls := range ss.ObjectSchema.Classes {
+		b := root.Bucket(encodeClassName(cls.Class))
+		if b == nil {
+			return fmt.Errorf("class %q not found", cls.Class)
+		}
+		metadata := b.Get(keyMetaClass)
+		if !bytes.Equal(metadata, prev[cls.Class]) {
+			changed = true
+		}
+		classes = append(classes, historyClass{Name: cls.Class, Metadata: metadata})
+	}
+	if !changed {
+		return nil
+	}
+	return appendHistory(tx, historyEntry{
+		Operation: models.SchemaVersionOperationSnapshot,
+		Classes:   classes,
+	})
+}
+
+// classesMetadata returns a copy of the metadata of all stored classes
+func classesMetadata(root *bolt.Bucket) map[string][]byte {
+	res := make(map[string][]byte)
+	cursor := root.Cursor()
+	for cls, _ := cursor.First(); cls != nil; cls, _ = cursor.Next() {
+		if cls[0] != eTypeClass {
+			continue
+		}
+		if b := root.Bucket(cls); b != nil {
+			res[string(cls[1:])] = append([]byte(nil), b.Get(keyMetaClass)...)
+		}
+	}
+	return res
+}
+
+func shardNames(shards []ucs.KeyValuePair) []string {
+	names := make([]string, len(shards))
+	for i, shard := range shards {
+		names[i] = shard.Key
+	}
+	return names
+}
+
+func encodeVersion(version uint64) []byte {
+	buf := make([]byte, 8)
+	binary.BigEndian.PutUint64(buf, version)
+	return buf
+}
+
+func decodeVersion(key []byte) uint64 {
+	return binary.BigEndian.Uint64(key)
+}
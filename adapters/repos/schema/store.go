@@ -35,6 +35,7 @@ var (
 	keyMetaClass         = []byte{eTypeMeta, 0}
 	keyShardingState     = []byte{eTypeSharingState, 0}
 	keyConfig            = []byte{eTypeConfig, 0}
+	keyStopwordSets      = []byte{eTypeStopwordSets, 0}
 	_Version         int = 2
 )
 
@@ -44,6 +45,7 @@ const (
 	eTypeClass        byte = 2
 	eTypeShard        byte = 4
 	eTypeMeta         byte = 5
+	eTypeStopwordSets byte = 6
 	eTypeSharingState byte = 15
 )
 
@@ -59,6 +61,7 @@ It ensures that each class has a dedicated bucket, which includes metadata, and
 
 Schema Structure:
   - Config: contains metadata related to parsing the schema
+  - Stopword sets: the custom stopword sets which classes can reference
   - Nested buckets for each class
 
 Schema Structure for a class Bucket:
@@ -68,6 +71,9 @@ Schema Structure for a class Bucket:
 
 By organizing the schema in this manner, it facilitates efficient management of class specific data during runtime.
 In addition, old schema are backed up and migrated to the new structure for a seamless transitions
+
+Every mutation of the schema is recorded in a separate history bucket under a monotonically
+increasing version, which allows to rebuild the schema as of any recorded version.
 */
 type store struct {
 	version int    // schema version
@@ -92,6 +98,9 @@ func initBoltDB(filePath string, version int, cfg *config) (*bolt.DB, error) {
 		return nil, fmt.Errorf("open %q: %w", filePath, err)
 	}
 	root := func(tx *bolt.Tx) error {
+		if _, err := tx.CreateBucketIfNotExists(historyBucket); err != nil {
+			return fmt.Errorf("create bucket %q: %w", historyBucket, err)
+		}
 		b, err := tx.CreateBucket(schemaBucket)
 		// A new bucket has been created
 		if err == nil {
@@ -229,7 +238,14 @@ func (r *store) UpdateClass(_ context.Context, data ucs.ClassPayload) error {
 		if b == nil {
 			return fmt.Errorf("class not found")
 		}
-		return r.updateClass(b, data)
+		if err := r.updateClass(b, data); err != nil {
+			return err
+		}
+		return appendHistory(tx, historyEntry{
+			Operation: models.SchemaVersionOperationUpdateClass,
+			Class:     data.Name,
+			Metadata:  data.Metadata,
+		})
 	}
 	return r.db.Update(f)
 }
@@ -242,7 +258,14 @@ func (r *store) NewClass(_ context.Context, data ucs.ClassPayload) error {
 		if err != nil {
 			return err
 		}
-		return r.updateClass(b, data)
+		if err := r.updateClass(b, data); err != nil {
+			return err
+		}
+		return appendHistory(tx, historyEntry{
+			Operation: models.SchemaVersionOperationAddClass,
+			Class:     data.Name,
+			Metadata:  data.Metadata,
+		})
 	}
 	return r.db.Update(f)
 }
@@ -278,10 +301,16 @@ func (r *store) DeleteClass(_ context.Context, class string) error {
 	classKey := encodeClassName(class)
 	f := func(tx *bolt.Tx) error {
 		err := tx.Bucket(schemaBucket).DeleteBucket(classKey)
-		if err != nil && !errors.Is(err, bolt.ErrBucketNotFound) {
+		if errors.Is(err, bolt.ErrBucketNotFound) {
+			return nil
+		}
+		if err != nil {
 			return err
 		}
-		return nil
+		return appendHistory(tx, historyEntry{
+			Operation: models.SchemaVersionOperationDeleteClass,
+			Class:     class,
+		})
 	}
 	return r.db.Update(f)
 }
@@ -294,7 +323,14 @@ func (r *store) NewShards(_ context.Context, class string, shards []ucs.KeyValue
 		if b == nil {
 			return fmt.Errorf("class not found")
 		}
-		return appendShards(b, shards, make([]byte, 1, 68))
+		if err := appendShards(b, shards, make([]byte, 1, 68)); err != nil {
+			return err
+		}
+		return appendHistory(tx, historyEntry{
+			Operation: models.SchemaVersionOperationAddShards,
+			Class:     class,
+			Shards:    shardNames(shards),
+		})
 	}
 	return r.db.Update(f)
 }
@@ -312,7 +348,14 @@ func (r *store) UpdateShards(_ context.Context, class string, shards []ucs.KeyVa
 		if !existShards(b, shards, keyBuf) {
 			return fmt.Errorf("shard not found")
 		}
-		return appendShards(b, shards, keyBuf)
+		if err := appendShards(b, shards, keyBuf); err != nil {
+			return err
+		}
+		return appendHistory(tx, historyEntry{
+			Operation: models.SchemaVersionOperationUpdateShards,
+			Class:     class,
+			Shards:    shardNames(shards),
+		})
 	}
 	return r.db.Update(f)
 }
@@ -327,7 +370,14 @@ func (r *store) DeleteShards(_ context.Context, class string, shards []string) e
 		if b == nil {
 			return nil
 		}
-		return deleteShards(b, shards, make([]byte, 1, 68))
+		if err := deleteShards(b, shards, make([]byte, 1, 68)); err != nil {
+			return err
+		}
+		return appendHistory(tx, historyEntry{
+			Operation: models.SchemaVersionOperationDeleteShards,
+			Class:     class,
+			Shards:    shards,
+		})
 	}
 	return r.db.Update(f)
 }
@@ -363,9 +413,31 @@ func (r *store) Load(ctx context.Context) (ucs.State, error) {
 		state.ObjectSchema.Classes = append(state.ObjectSchema.Classes, &cls)
 		state.ShardingState[cls.Class] = &ss
 	}
+
+	f := func(tx *bolt.Tx) error {
+		data := tx.Bucket(schemaBucket).Get(keyStopwordSets)
+		if data == nil {
+			return nil
+		}
+		if err := json.Unmarshal(data, &state.ObjectSchema.StopwordSets); err != nil {
+			return fmt.Errorf("unmarshal stopword sets: %w", err)
+		}
+		return nil
+	}
+	if err := r.db.View(f); err != nil {
+		return state, err
+	}
 	return state, nil
 }
 
+// UpdateStopwordSets replaces the custom stopword sets
+func (r *store) UpdateStopwordSets(_ context.Context, sets []*models.StopwordSet) error {
+	f := func(tx *bolt.Tx) error {
+		return saveStopwordSets(tx.Bucket(schemaBucket), sets)
+	}
+	return r.db.Update(f)
+}
+
 func (r *store) load(ctx context.Context) <-chan ucs.ClassPayload {
 	ch := make(chan ucs.ClassPayload, 1)
 	f := func(tx *bolt.Tx) (err error) {
@@ -417,6 +489,9 @@ func (r *store) load(ctx context.Context) <-chan ucs.ClassPayload {
 func (r *store) Save(ctx context.Context, ss ucs.State) error {
 	if (ss.ObjectSchema == nil || len(ss.ObjectSchema.Classes) == 0) &&
 		len(ss.ShardingState) == 0 {
+		if ss.ObjectSchema != nil && len(ss.ObjectSchema.StopwordSets) > 0 {
+			return r.UpdateStopwordSets(ctx, ss.ObjectSchema.StopwordSets)
+		}
 		return nil // empty schema nothing to store
 	}
 
@@ -428,7 +503,11 @@ func (r *store) Save(ctx context.Context, ss ucs.State) error {
 
 	f := func(tx *bolt.Tx) error {
 		root := tx.Bucket(schemaBucket)
-		return r.saveAllTx(ctx, root, ss)(tx)
+		prev := classesMetadata(root)
+		if err := r.saveAllTx(ctx, root, ss)(tx); err != nil {
+			return err
+		}
+		return appendSnapshot(tx, root, ss, prev)
 	}
 	return r.db.Update(f)
 }
@@ -463,8 +542,25 @@ func (r *store) saveAllTx(ctx context.Context, root *bolt.Bucket, ss ucs.State)
 			}
 		}
 
+		return saveStopwordSets(root, ss.ObjectSchema.StopwordSets)
+	}
+}
+
+func saveStopwordSets(root *bolt.Bucket, sets []*models.StopwordSet) error {
+	if len(sets) == 0 {
+		if err := root.Delete(keyStopwordSets); err != nil {
+			return fmt.Errorf("delete stopword sets: %w", err)
+		}
 		return nil
 	}
+	data, err := json.Marshal(sets)
+	if err != nil {
+		return fmt.Errorf("marshal stopword sets: %w", err)
+	}
+	if err := root.Put(keyStopwordSets, data); err != nil {
+		return fmt.Errorf("write stopword sets: %w", err)
+	}
+	return nil
 }
 
 func saveConfig(root *bolt.Bucket, cfg config) error {
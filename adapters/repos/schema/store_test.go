@@ -128,6 +128,37 @@ func TestRepositorySaveLoad(t *testing.T) {
 	repo.asserEqualSchema(t, schema, "delete class")
 }
 
+func TestRepositoryStopwordSets(t *testing.T) {
+	var (
+		ctx       = context.Background()
+		logger, _ = test.NewNullLogger()
+		dirName   = t.TempDir()
+	)
+	repo, err := newRepo(dirName, -1, logger)
+	require.Nil(t, err)
+
+	// stopword sets without classes
+	sets := []*models.StopwordSet{{Name: "animals", Words: []string{"dog", "cat"}}}
+	require.Nil(t, repo.UpdateStopwordSets(ctx, sets))
+	res, err := repo.Load(ctx)
+	require.Nil(t, err)
+	assert.Equal(t, sets, res.ObjectSchema.StopwordSets)
+
+	// saving the complete schema replaces them
+	schema := ucs.NewState(1)
+	addClass(&schema, "C1", 0, 1, 0)
+	schema.ObjectSchema.StopwordSets = []*models.StopwordSet{
+		{Name: "colors", Words: []string{"red"}},
+	}
+	require.Nil(t, repo.Save(ctx, schema))
+	repo.asserEqualSchema(t, schema, "save schema")
+
+	// removing all sets
+	require.Nil(t, repo.UpdateStopwordSets(ctx, nil))
+	schema.ObjectSchema.StopwordSets = nil
+	repo.asserEqualSchema(t, schema, "delete stopword sets")
+}
+
 func TestRepositoryUpdateClass(t *testing.T) {
 	var (
 		ctx       = context.Background()
@@ -255,6 +286,114 @@ func TestRepositoryUpdateShards(t *testing.T) {
 	}
 }
 
+func TestRepositoryHistory(t *testing.T) {
+	var (
+		ctx       = context.Background()
+		logger, _ = test.NewNullLogger()
+		dirName   = t.TempDir()
+	)
+	repo, err := newRepo(dirName, -1, logger)
+	require.Nil(t, err)
+
+	// the complete schema is recorded as a snapshot, saving it again is a no-op
+	schema := ucs.NewState(3)
+	addClass(&schema, "C1", 0, 1, 1)
+	addClass(&schema, "C2", 0, 2, 1)
+	require.Nil(t, repo.Save(ctx, schema))
+	require.Nil(t, repo.Save(ctx, schema))
+
+	cls, ss := addClass(&schema, "C3", 0, 1, 0)
+	payload, err := ucs.CreateClassPayload(cls, ss)
+	require.Nil(t, err)
+	require.Nil(t, repo.NewClass(ctx, payload))
+
+	deleteClass(&schema, "C1")
+	cls, ss = addClass(&schema, "C1", 0, 3, 1)
+	payload, err = ucs.CreateClassPayload(cls, ss)
+	require.Nil(t, err)
+	require.Nil(t, repo.UpdateClass(ctx, payload))
+
+	shards := serializeShards(createShards(0, 2, models.TenantActivityStatusHOT))
+	require.Nil(t, repo.NewShards(ctx, "C3", shards))
+	require.Nil(t, repo.DeleteShards(ctx, "C3", []string{shards[0].Key}))
+	require.Nil(t, repo.DeleteClass(ctx, "C2"))
+	require.Nil(t, repo.DeleteClass(ctx, "C2")) // nothing to record
+	deleteClass(&schema, "C2")
+
+	expectedOps := []string{
+		models.SchemaVersionOperationSnapshot,
+		models.SchemaVersionOperationAddClass,
+		models.SchemaVersionOperationUpdateClass,
+		models.SchemaVersionOperationAddShards,
+		models.SchemaVersionOperationDeleteShards,
+		models.SchemaVersionOperationDeleteClass,
+	}
+
+	t.Run("list all versions", func(t *testing.T) {
+		history, err := repo.History(ctx, 0, 0)
+		require.Nil(t, err)
+		assert.Equal(t, int64(len(expectedOps)), history.CurrentVersion)
+		require.Len(t, history.Versions, len(expectedOps))
+		for i, v := range history.Versions {
+			assert.Equal(t, int64(i+1), v.Version)
+			assert.Equal(t, expectedOps[i], v.Operation)
+			assert.NotZero(t, v.Timestamp)
+		}
+		assert.Equal(t, "C3", history.Versions[3].Class)
+		assert.Equal(t, []string{shards[0].Key, shards[1].Key}, history.Versions[3].Shards)
+	})
+
+	t.Run("list a page of versions", func(t *testing.T) {
+		history, err := repo.History(ctx, 2, 2)
+		require.Nil(t, err)
+		require.Len(t, history.Versions, 2)
+		assert.Equal(t, int64(3), history.Versions[0].Version)
+		assert.Equal(t, int64(4), history.Versions[1].Version)
+	})
+
+	t.Run("schema at version", func(t *testing.T) {
+		tests := []struct {
+			version uint64
+			classes []string
+			props   []int
+		}{
+			{version: 1, classes: []string{"C1", "C2"}, props: []int{1, 2}},
+			{version: 2, classes: []string{"C1", "C2", "C3"}, props: []int{1, 2, 1}},
+			{version: 3, classes: []string{"C1", "C2", "C3"}, props: []int{3, 2, 1}},
+			{version: 6, classes: []string{"C1", "C3"}, props: []int{3, 1}},
+		}
+		for _, tt := range tests {
+			versioned, err := repo.SchemaAtVersion(ctx, tt.version)
+			require.Nil(t, err)
+			require.Len(t, versioned.Classes, len(tt.classes))
+			for i, cls := range versioned.Classes {
+				assert.Equal(t, tt.classes[i], cls.Class, "version %d", tt.version)
+				assert.Len(t, cls.Properties, tt.props[i], "version %d", tt.version)
+			}
+		}
+
+		_, err := repo.SchemaAtVersion(ctx, 0)
+		assert.ErrorIs(t, err, ucs.ErrNotFound)
+		_, err = repo.SchemaAtVersion(ctx, 7)
+		assert.ErrorIs(t, err, ucs.ErrNotFound)
+	})
+
+	t.Run("history survives a restart", func(t *testing.T) {
+		repo.Close()
+		repo, err = newRepo(dirName, -1, logger)
+		require.Nil(t, err)
+		defer repo.Close()
+
+		state, err := repo.Load(ctx)
+		require.Nil(t, err)
+		require.Nil(t, repo.Save(ctx, state))
+
+		history, err := repo.History(ctx, 0, 0)
+		require.Nil(t, err)
+		assert.Equal(t, int64(len(expectedOps)), history.CurrentVersion)
+	})
+}
+
 func createClass(name string, start, nProps, nShards int) (models.Class, sharding.State) {
 	cls := models.Class{Class: name}
 	for i := start; i < start+nProps; i++ {
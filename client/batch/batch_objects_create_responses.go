@@ -70,6 +70,18 @@ func (o *BatchObjectsCreateReader) ReadResponse(response runtime.ClientResponse,
 			return nil, err
 		}
 		return nil, result
+	case 423:
+		result := NewBatchObjectsCreateLocked()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 429:
+		result := NewBatchObjectsCreateTooManyRequests()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 500:
 		result := NewBatchObjectsCreateInternalServerError()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -407,6 +419,158 @@ func (o *BatchObjectsCreateUnprocessableEntity) readResponse(response runtime.Cl
 	return nil
 }
 
+// NewBatchObjectsCreateLocked creates a BatchObjectsCreateLocked with default headers values
+func NewBatchObjectsCreateLocked() *BatchObjectsCreateLocked {
+	return &BatchObjectsCreateLocked{}
+}
+
+/*
+BatchObjectsCreateLocked describes a response with status code 423, with default header values.
+
+The class or the node is in read-only mode and rejects writes
+*/
+type BatchObjectsCreateLocked struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this batch objects create locked response has a 2xx status code
+func (o *BatchObjectsCreateLocked) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this batch objects create locked response has a 3xx status code
+func (o *BatchObjectsCreateLocked) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this batch objects create locked response has a 4xx status code
+func (o *BatchObjectsCreateLocked) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this batch objects create locked response has a 5xx status code
+func (o *BatchObjectsCreateLocked) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this batch objects create locked response a status code equal to that given
+func (o *BatchObjectsCreateLocked) IsCode(code int) bool {
+	return code == 423
+}
+
+// Code gets the status code for the batch objects create locked response
+func (o *BatchObjectsCreateLocked) Code() int {
+	return 423
+}
+
+func (o *BatchObjectsCreateLocked) Error() string {
+	return fmt.Sprintf("[POST /batch/objects][%d] batchObjectsCreateLocked  %+v", 423, o.Payload)
+}
+
+func (o *BatchObjectsCreateLocked) String() string {
+	return fmt.Sprintf("[POST /batch/objects][%d] batchObjectsCreateLocked  %+v", 423, o.Payload)
+}
+
+func (o *BatchObjectsCreateLocked) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *BatchObjectsCreateLocked) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewBatchObjectsCreateTooManyRequests creates a BatchObjectsCreateTooManyRequests with default headers values
+func NewBatchObjectsCreateTooManyRequests() *BatchObjectsCreateTooManyRequests {
+	return &BatchObjectsCreateTooManyRequests{}
+}
+
+/*
+BatchObjectsCreateTooManyRequests describes a response with status code 429, with default header values.
+
+Too many heavy operations are running or queued on the node. Retry after the number of seconds in the Retry-After header.
+*/
+type BatchObjectsCreateTooManyRequests struct {
+
+	/* Number of seconds after which the request can be retried
+	 */
+	RetryAfter int64
+
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this batch objects create too many requests response has a 2xx status code
+func (o *BatchObjectsCreateTooManyRequests) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this batch objects create too many requests response has a 3xx status code
+func (o *BatchObjectsCreateTooManyRequests) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this batch objects create too many requests response has a 4xx status code
+func (o *BatchObjectsCreateTooManyRequests) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this batch objects create too many requests response has a 5xx status code
+func (o *BatchObjectsCreateTooManyRequests) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this batch objects create too many requests response a status code equal to that given
+func (o *BatchObjectsCreateTooManyRequests) IsCode(code int) bool {
+	return code == 429
+}
+
+// Code gets the status code for the batch objects create too many requests response
+func (o *BatchObjectsCreateTooManyRequests) Code() int {
+	return 429
+}
+
+func (o *BatchObjectsCreateTooManyRequests) Error() string {
+	return fmt.Sprintf("[POST /batch/objects][%d] batchObjectsCreateTooManyRequests  %+v", 429, o.Payload)
+}
+
+func (o *BatchObjectsCreateTooManyRequests) String() string {
+	return fmt.Sprintf("[POST /batch/objects][%d] batchObjectsCreateTooManyRequests  %+v", 429, o.Payload)
+}
+
+func (o *BatchObjectsCreateTooManyRequests) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *BatchObjectsCreateTooManyRequests) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// hydrates response header Retry-After
+	hdrRetryAfter := response.GetHeader("Retry-After")
+
+	if hdrRetryAfter != "" {
+		valretryAfter, err := swag.ConvertInt64(hdrRetryAfter)
+		if err != nil {
+			return errors.InvalidType("Retry-After", "header", "int64", hdrRetryAfter)
+		}
+		o.RetryAfter = valretryAfter
+	}
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewBatchObjectsCreateInternalServerError creates a BatchObjectsCreateInternalServerError with default headers values
 func NewBatchObjectsCreateInternalServerError() *BatchObjectsCreateInternalServerError {
 	return &BatchObjectsCreateInternalServerError{}
@@ -486,6 +650,13 @@ type BatchObjectsCreateBody struct {
 
 	// objects
 	Objects []*models.Object `json:"objects"`
+
+	// Name of a property which is a `uniqueKey` of the classes of the objects in the batch. The objects get a UUID derived from their class and the value of this property, so importing an object with the same value again updates it instead of creating a duplicate. Objects must be sent without an id.
+	UpsertBy string `json:"upsertBy,omitempty"`
+
+	// Controls which objects of the batch are vectorized by the vectorizer modules of their class. `ifMissing` (default) vectorizes objects which are sent without a vector, `always` vectorizes all objects and ignores the vectors sent with them for vector spaces with a vectorizer, `never` vectorizes no object, objects which already exist keep their stored vectors unless new ones are sent.
+	// Enum: [always ifMissing never]
+	VectorizePolicy string `json:"vectorizePolicy,omitempty"`
 }
 
 // Validate validates this batch objects create body
@@ -500,6 +671,10 @@ func (o *BatchObjectsCreateBody) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := o.validateVectorizePolicy(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -571,6 +746,51 @@ func (o *BatchObjectsCreateBody) validateObjects(formats strfmt.Registry) error
 	return nil
 }
 
+var batchObjectsCreateBodyTypeVectorizePolicyPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["always","ifMissing","never"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		batchObjectsCreateBodyTypeVectorizePolicyPropEnum = append(batchObjectsCreateBodyTypeVectorizePolicyPropEnum, v)
+	}
+}
+
+const (
+
+	// BatchObjectsCreateBodyVectorizePolicyAlways captures enum value "always"
+	BatchObjectsCreateBodyVectorizePolicyAlways string = "always"
+
+	// BatchObjectsCreateBodyVectorizePolicyIfMissing captures enum value "ifMissing"
+	BatchObjectsCreateBodyVectorizePolicyIfMissing string = "ifMissing"
+
+	// BatchObjectsCreateBodyVectorizePolicyNever captures enum value "never"
+	BatchObjectsCreateBodyVectorizePolicyNever string = "never"
+)
+
+// prop value enum
+func (o *BatchObjectsCreateBody) validateVectorizePolicyEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, batchObjectsCreateBodyTypeVectorizePolicyPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *BatchObjectsCreateBody) validateVectorizePolicy(formats strfmt.Registry) error {
+	if swag.IsZero(o.VectorizePolicy) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := o.validateVectorizePolicyEnum("body"+"."+"vectorizePolicy", "body", o.VectorizePolicy); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // ContextValidate validate this batch objects create body based on the context it is used
 func (o *BatchObjectsCreateBody) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
@@ -64,6 +64,12 @@ func (o *BatchObjectsDeleteReader) ReadResponse(response runtime.ClientResponse,
 			return nil, err
 		}
 		return nil, result
+	case 423:
+		result := NewBatchObjectsDeleteLocked()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 500:
 		result := NewBatchObjectsDeleteInternalServerError()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -403,6 +409,74 @@ func (o *BatchObjectsDeleteUnprocessableEntity) readResponse(response runtime.Cl
 	return nil
 }
 
+// NewBatchObjectsDeleteLocked creates a BatchObjectsDeleteLocked with default headers values
+func NewBatchObjectsDeleteLocked() *BatchObjectsDeleteLocked {
+	return &BatchObjectsDeleteLocked{}
+}
+
+/*
+BatchObjectsDeleteLocked describes a response with status code 423, with default header values.
+
+The class or the node is in read-only mode and rejects writes
+*/
+type BatchObjectsDeleteLocked struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this batch objects delete locked response has a 2xx status code
+func (o *BatchObjectsDeleteLocked) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this batch objects delete locked response has a 3xx status code
+func (o *BatchObjectsDeleteLocked) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this batch objects delete locked response has a 4xx status code
+func (o *BatchObjectsDeleteLocked) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this batch objects delete locked response has a 5xx status code
+func (o *BatchObjectsDeleteLocked) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this batch objects delete locked response a status code equal to that given
+func (o *BatchObjectsDeleteLocked) IsCode(code int) bool {
+	return code == 423
+}
+
+// Code gets the status code for the batch objects delete locked response
+func (o *BatchObjectsDeleteLocked) Code() int {
+	return 423
+}
+
+func (o *BatchObjectsDeleteLocked) Error() string {
+	return fmt.Sprintf("[DELETE /batch/objects][%d] batchObjectsDeleteLocked  %+v", 423, o.Payload)
+}
+
+func (o *BatchObjectsDeleteLocked) String() string {
+	return fmt.Sprintf("[DELETE /batch/objects][%d] batchObjectsDeleteLocked  %+v", 423, o.Payload)
+}
+
+func (o *BatchObjectsDeleteLocked) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *BatchObjectsDeleteLocked) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewBatchObjectsDeleteInternalServerError creates a BatchObjectsDeleteInternalServerError with default headers values
 func NewBatchObjectsDeleteInternalServerError() *BatchObjectsDeleteInternalServerError {
 	return &BatchObjectsDeleteInternalServerError{}
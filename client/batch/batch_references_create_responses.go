@@ -64,6 +64,12 @@ func (o *BatchReferencesCreateReader) ReadResponse(response runtime.ClientRespon
 			return nil, err
 		}
 		return nil, result
+	case 423:
+		result := NewBatchReferencesCreateLocked()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 500:
 		result := NewBatchReferencesCreateInternalServerError()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -401,6 +407,74 @@ func (o *BatchReferencesCreateUnprocessableEntity) readResponse(response runtime
 	return nil
 }
 
+// NewBatchReferencesCreateLocked creates a BatchReferencesCreateLocked with default headers values
+func NewBatchReferencesCreateLocked() *BatchReferencesCreateLocked {
+	return &BatchReferencesCreateLocked{}
+}
+
+/*
+BatchReferencesCreateLocked describes a response with status code 423, with default header values.
+
+The class or the node is in read-only mode and rejects writes
+*/
+type BatchReferencesCreateLocked struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this batch references create locked response has a 2xx status code
+func (o *BatchReferencesCreateLocked) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this batch references create locked response has a 3xx status code
+func (o *BatchReferencesCreateLocked) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this batch references create locked response has a 4xx status code
+func (o *BatchReferencesCreateLocked) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this batch references create locked response has a 5xx status code
+func (o *BatchReferencesCreateLocked) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this batch references create locked response a status code equal to that given
+func (o *BatchReferencesCreateLocked) IsCode(code int) bool {
+	return code == 423
+}
+
+// Code gets the status code for the batch references create locked response
+func (o *BatchReferencesCreateLocked) Code() int {
+	return 423
+}
+
+func (o *BatchReferencesCreateLocked) Error() string {
+	return fmt.Sprintf("[POST /batch/references][%d] batchReferencesCreateLocked  %+v", 423, o.Payload)
+}
+
+func (o *BatchReferencesCreateLocked) String() string {
+	return fmt.Sprintf("[POST /batch/references][%d] batchReferencesCreateLocked  %+v", 423, o.Payload)
+}
+
+func (o *BatchReferencesCreateLocked) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *BatchReferencesCreateLocked) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewBatchReferencesCreateInternalServerError creates a BatchReferencesCreateInternalServerError with default headers values
 func NewBatchReferencesCreateInternalServerError() *BatchReferencesCreateInternalServerError {
 	return &BatchReferencesCreateInternalServerError{}
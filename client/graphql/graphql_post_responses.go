@@ -20,8 +20,10 @@ import (
 	"fmt"
 	"io"
 
+	"github.com/go-openapi/errors"
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 
 	"github.com/weaviate/weaviate/entities/models"
 )
@@ -58,6 +60,12 @@ func (o *GraphqlPostReader) ReadResponse(response runtime.ClientResponse, consum
 			return nil, err
 		}
 		return nil, result
+	case 429:
+		result := NewGraphqlPostTooManyRequests()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 500:
 		result := NewGraphqlPostInternalServerError()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -329,6 +337,90 @@ func (o *GraphqlPostUnprocessableEntity) readResponse(response runtime.ClientRes
 	return nil
 }
 
+// NewGraphqlPostTooManyRequests creates a GraphqlPostTooManyRequests with default headers values
+func NewGraphqlPostTooManyRequests() *GraphqlPostTooManyRequests {
+	return &GraphqlPostTooManyRequests{}
+}
+
+/*
+GraphqlPostTooManyRequests describes a response with status code 429, with default header values.
+
+Too many heavy operations are running or queued on the node. Retry after the number of seconds in the Retry-After header.
+*/
+type GraphqlPostTooManyRequests struct {
+
+	/* Number of seconds after which the request can be retried
+	 */
+	RetryAfter int64
+
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this graphql post too many requests response has a 2xx status code
+func (o *GraphqlPostTooManyRequests) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this graphql post too many requests response has a 3xx status code
+func (o *GraphqlPostTooManyRequests) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this graphql post too many requests response has a 4xx status code
+func (o *GraphqlPostTooManyRequests) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this graphql post too many requests response has a 5xx status code
+func (o *GraphqlPostTooManyRequests) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this graphql post too many requests response a status code equal to that given
+func (o *GraphqlPostTooManyRequests) IsCode(code int) bool {
+	return code == 429
+}
+
+// Code gets the status code for the graphql post too many requests response
+func (o *GraphqlPostTooManyRequests) Code() int {
+	return 429
+}
+
+func (o *GraphqlPostTooManyRequests) Error() string {
+	return fmt.Sprintf("[POST /graphql][%d] graphqlPostTooManyRequests  %+v", 429, o.Payload)
+}
+
+func (o *GraphqlPostTooManyRequests) String() string {
+	return fmt.Sprintf("[POST /graphql][%d] graphqlPostTooManyRequests  %+v", 429, o.Payload)
+}
+
+func (o *GraphqlPostTooManyRequests) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *GraphqlPostTooManyRequests) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// hydrates response header Retry-After
+	hdrRetryAfter := response.GetHeader("Retry-After")
+
+	if hdrRetryAfter != "" {
+		valretryAfter, err := swag.ConvertInt64(hdrRetryAfter)
+		if err != nil {
+			return errors.InvalidType("Retry-After", "header", "int64", hdrRetryAfter)
+		}
+		o.RetryAfter = valretryAfter
+	}
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewGraphqlPostInternalServerError creates a GraphqlPostInternalServerError with default headers values
 func NewGraphqlPostInternalServerError() *GraphqlPostInternalServerError {
 	return &GraphqlPostInternalServerError{}
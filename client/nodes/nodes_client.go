@@ -45,6 +45,8 @@ type ClientService interface {
 
 	NodesGetClass(params *NodesGetClassParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*NodesGetClassOK, error)
 
+	NodesReadOnlyUpdate(params *NodesReadOnlyUpdateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*NodesReadOnlyUpdateOK, error)
+
 	SetTransport(transport runtime.ClientTransport)
 }
 
@@ -126,6 +128,47 @@ func (a *Client) NodesGetClass(params *NodesGetClassParams, authInfo runtime.Cli
 	panic(msg)
 }
 
+/*
+NodesReadOnlyUpdate puts this node or a class into read only mode
+
+Rejects all writes to the node, or to a class on this node, with 423 Locked until read-only mode is disabled again, e.g. during migrations or a blue/green cutover. The node is also put into read-only mode automatically when its disk or memory usage exceeds the configured read-only thresholds. Disabling read-only mode for the node also lifts the automatic read-only mode.
+*/
+func (a *Client) NodesReadOnlyUpdate(params *NodesReadOnlyUpdateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*NodesReadOnlyUpdateOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewNodesReadOnlyUpdateParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "nodes.readOnly.update",
+		Method:             "PUT",
+		PathPattern:        "/nodes/read-only",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &NodesReadOnlyUpdateReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*NodesReadOnlyUpdateOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for nodes.readOnly.update: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 // SetTransport changes the transport on the client
 func (a *Client) SetTransport(transport runtime.ClientTransport) {
 	a.transport = transport
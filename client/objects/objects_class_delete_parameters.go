@@ -89,6 +89,12 @@ type ObjectsClassDeleteParams struct {
 	*/
 	ID strfmt.UUID
 
+	/* IfMatch.
+
+	   Only apply the write if the object has not changed since it was read: the ETag of the object as returned by GET /objects/{className}/{id}. Multiple comma-separated ETags are allowed..
+	*/
+	IfMatch *string
+
 	/* Tenant.
 
 	   Specifies the tenant in a request targeting a multi-tenant class
@@ -181,6 +187,17 @@ func (o *ObjectsClassDeleteParams) SetID(id strfmt.UUID) {
 	o.ID = id
 }
 
+// WithIfMatch adds the ifMatch to the objects class delete params
+func (o *ObjectsClassDeleteParams) WithIfMatch(ifMatch *string) *ObjectsClassDeleteParams {
+	o.SetIfMatch(ifMatch)
+	return o
+}
+
+// SetIfMatch adds the ifMatch to the objects class delete params
+func (o *ObjectsClassDeleteParams) SetIfMatch(ifMatch *string) {
+	o.IfMatch = ifMatch
+}
+
 // WithTenant adds the tenant to the objects class delete params
 func (o *ObjectsClassDeleteParams) WithTenant(tenant *string) *ObjectsClassDeleteParams {
 	o.SetTenant(tenant)
@@ -227,6 +244,14 @@ func (o *ObjectsClassDeleteParams) WriteToRequest(r runtime.ClientRequest, reg s
 		return err
 	}
 
+	if o.IfMatch != nil {
+
+		// header param If-Match
+		if err := r.SetHeaderParam("If-Match", *o.IfMatch); err != nil {
+			return err
+		}
+	}
+
 	if o.Tenant != nil {
 
 		// query param tenant
@@ -64,12 +64,24 @@ func (o *ObjectsClassDeleteReader) ReadResponse(response runtime.ClientResponse,
 			return nil, err
 		}
 		return nil, result
+	case 412:
+		result := NewObjectsClassDeletePreconditionFailed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 422:
 		result := NewObjectsClassDeleteUnprocessableEntity()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
 			return nil, err
 		}
 		return nil, result
+	case 423:
+		result := NewObjectsClassDeleteLocked()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 500:
 		result := NewObjectsClassDeleteInternalServerError()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -385,6 +397,74 @@ func (o *ObjectsClassDeleteNotFound) readResponse(response runtime.ClientRespons
 	return nil
 }
 
+// NewObjectsClassDeletePreconditionFailed creates a ObjectsClassDeletePreconditionFailed with default headers values
+func NewObjectsClassDeletePreconditionFailed() *ObjectsClassDeletePreconditionFailed {
+	return &ObjectsClassDeletePreconditionFailed{}
+}
+
+/*
+ObjectsClassDeletePreconditionFailed describes a response with status code 412, with default header values.
+
+The object has changed since it was read, its current ETag does not match the If-Match header.
+*/
+type ObjectsClassDeletePreconditionFailed struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this objects class delete precondition failed response has a 2xx status code
+func (o *ObjectsClassDeletePreconditionFailed) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this objects class delete precondition failed response has a 3xx status code
+func (o *ObjectsClassDeletePreconditionFailed) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this objects class delete precondition failed response has a 4xx status code
+func (o *ObjectsClassDeletePreconditionFailed) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this objects class delete precondition failed response has a 5xx status code
+func (o *ObjectsClassDeletePreconditionFailed) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this objects class delete precondition failed response a status code equal to that given
+func (o *ObjectsClassDeletePreconditionFailed) IsCode(code int) bool {
+	return code == 412
+}
+
+// Code gets the status code for the objects class delete precondition failed response
+func (o *ObjectsClassDeletePreconditionFailed) Code() int {
+	return 412
+}
+
+func (o *ObjectsClassDeletePreconditionFailed) Error() string {
+	return fmt.Sprintf("[DELETE /objects/{className}/{id}][%d] objectsClassDeletePreconditionFailed  %+v", 412, o.Payload)
+}
+
+func (o *ObjectsClassDeletePreconditionFailed) String() string {
+	return fmt.Sprintf("[DELETE /objects/{className}/{id}][%d] objectsClassDeletePreconditionFailed  %+v", 412, o.Payload)
+}
+
+func (o *ObjectsClassDeletePreconditionFailed) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ObjectsClassDeletePreconditionFailed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewObjectsClassDeleteUnprocessableEntity creates a ObjectsClassDeleteUnprocessableEntity with default headers values
 func NewObjectsClassDeleteUnprocessableEntity() *ObjectsClassDeleteUnprocessableEntity {
 	return &ObjectsClassDeleteUnprocessableEntity{}
@@ -453,6 +533,74 @@ func (o *ObjectsClassDeleteUnprocessableEntity) readResponse(response runtime.Cl
 	return nil
 }
 
+// NewObjectsClassDeleteLocked creates a ObjectsClassDeleteLocked with default headers values
+func NewObjectsClassDeleteLocked() *ObjectsClassDeleteLocked {
+	return &ObjectsClassDeleteLocked{}
+}
+
+/*
+ObjectsClassDeleteLocked describes a response with status code 423, with default header values.
+
+The class or the node is in read-only mode and rejects writes
+*/
+type ObjectsClassDeleteLocked struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this objects class delete locked response has a 2xx status code
+func (o *ObjectsClassDeleteLocked) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this objects class delete locked response has a 3xx status code
+func (o *ObjectsClassDeleteLocked) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this objects class delete locked response has a 4xx status code
+func (o *ObjectsClassDeleteLocked) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this objects class delete locked response has a 5xx status code
+func (o *ObjectsClassDeleteLocked) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this objects class delete locked response a status code equal to that given
+func (o *ObjectsClassDeleteLocked) IsCode(code int) bool {
+	return code == 423
+}
+
+// Code gets the status code for the objects class delete locked response
+func (o *ObjectsClassDeleteLocked) Code() int {
+	return 423
+}
+
+func (o *ObjectsClassDeleteLocked) Error() string {
+	return fmt.Sprintf("[DELETE /objects/{className}/{id}][%d] objectsClassDeleteLocked  %+v", 423, o.Payload)
+}
+
+func (o *ObjectsClassDeleteLocked) String() string {
+	return fmt.Sprintf("[DELETE /objects/{className}/{id}][%d] objectsClassDeleteLocked  %+v", 423, o.Payload)
+}
+
+func (o *ObjectsClassDeleteLocked) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ObjectsClassDeleteLocked) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewObjectsClassDeleteInternalServerError creates a ObjectsClassDeleteInternalServerError with default headers values
 func NewObjectsClassDeleteInternalServerError() *ObjectsClassDeleteInternalServerError {
 	return &ObjectsClassDeleteInternalServerError{}
@@ -92,6 +92,11 @@ ObjectsClassGetOK describes a response with status code 200, with default header
 Successful response.
 */
 type ObjectsClassGetOK struct {
+
+	/* Version of the object, can be sent in the If-Match header of a write to only apply the write if the object has not changed since
+	 */
+	ETag string
+
 	Payload *models.Object
 }
 
@@ -139,6 +144,13 @@ func (o *ObjectsClassGetOK) GetPayload() *models.Object {
 
 func (o *ObjectsClassGetOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
 
+	// hydrates response header ETag
+	hdrETag := response.GetHeader("ETag")
+
+	if hdrETag != "" {
+		o.ETag = hdrETag
+	}
+
 	o.Payload = new(models.Object)
 
 	// response payload
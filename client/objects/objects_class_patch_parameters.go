@@ -25,8 +25,6 @@ import (
 	"github.com/go-openapi/runtime"
 	cr "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
-
-	"github.com/weaviate/weaviate/entities/models"
 )
 
 // NewObjectsClassPatchParams creates a new ObjectsClassPatchParams object,
@@ -76,9 +74,9 @@ type ObjectsClassPatchParams struct {
 
 	/* Body.
 
-	   RFC 7396-style patch, the body contains the object to merge into the existing object.
+	   RFC 7396-style patch, the body contains the object to merge into the existing object. With the content type application/json-patch+json the body is a RFC 6902 JSON Patch instead.
 	*/
-	Body *models.Object
+	Body interface{}
 
 	/* ClassName.
 
@@ -100,6 +98,18 @@ type ObjectsClassPatchParams struct {
 	*/
 	ID strfmt.UUID
 
+	/* IfMatch.
+
+	   Only apply the write if the object has not changed since it was read: the ETag of the object as returned by GET /objects/{className}/{id}. Multiple comma-separated ETags are allowed..
+	*/
+	IfMatch *string
+
+	/* Tenant.
+
+	   Specifies the tenant in a request targeting a multi-tenant class
+	*/
+	Tenant *string
+
 	timeout    time.Duration
 	Context    context.Context
 	HTTPClient *http.Client
@@ -154,13 +164,13 @@ func (o *ObjectsClassPatchParams) SetHTTPClient(client *http.Client) {
 }
 
 // WithBody adds the body to the objects class patch params
-func (o *ObjectsClassPatchParams) WithBody(body *models.Object) *ObjectsClassPatchParams {
+func (o *ObjectsClassPatchParams) WithBody(body interface{}) *ObjectsClassPatchParams {
 	o.SetBody(body)
 	return o
 }
 
 // SetBody adds the body to the objects class patch params
-func (o *ObjectsClassPatchParams) SetBody(body *models.Object) {
+func (o *ObjectsClassPatchParams) SetBody(body interface{}) {
 	o.Body = body
 }
 
@@ -197,6 +207,28 @@ func (o *ObjectsClassPatchParams) SetID(id strfmt.UUID) {
 	o.ID = id
 }
 
+// WithIfMatch adds the ifMatch to the objects class patch params
+func (o *ObjectsClassPatchParams) WithIfMatch(ifMatch *string) *ObjectsClassPatchParams {
+	o.SetIfMatch(ifMatch)
+	return o
+}
+
+// SetIfMatch adds the ifMatch to the objects class patch params
+func (o *ObjectsClassPatchParams) SetIfMatch(ifMatch *string) {
+	o.IfMatch = ifMatch
+}
+
+// WithTenant adds the tenant to the objects class patch params
+func (o *ObjectsClassPatchParams) WithTenant(tenant *string) *ObjectsClassPatchParams {
+	o.SetTenant(tenant)
+	return o
+}
+
+// SetTenant adds the tenant to the objects class patch params
+func (o *ObjectsClassPatchParams) SetTenant(tenant *string) {
+	o.Tenant = tenant
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *ObjectsClassPatchParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -237,6 +269,31 @@ func (o *ObjectsClassPatchParams) WriteToRequest(r runtime.ClientRequest, reg st
 		return err
 	}
 
+	if o.IfMatch != nil {
+
+		// header param If-Match
+		if err := r.SetHeaderParam("If-Match", *o.IfMatch); err != nil {
+			return err
+		}
+	}
+
+	if o.Tenant != nil {
+
+		// query param tenant
+		var qrTenant string
+
+		if o.Tenant != nil {
+			qrTenant = *o.Tenant
+		}
+		qTenant := qrTenant
+		if qTenant != "" {
+
+			if err := r.SetQueryParam("tenant", qTenant); err != nil {
+				return err
+			}
+		}
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
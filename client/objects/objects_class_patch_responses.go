@@ -64,12 +64,24 @@ func (o *ObjectsClassPatchReader) ReadResponse(response runtime.ClientResponse,
 			return nil, err
 		}
 		return nil, result
+	case 412:
+		result := NewObjectsClassPatchPreconditionFailed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 422:
 		result := NewObjectsClassPatchUnprocessableEntity()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
 			return nil, err
 		}
 		return nil, result
+	case 423:
+		result := NewObjectsClassPatchLocked()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 500:
 		result := NewObjectsClassPatchInternalServerError()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -385,6 +397,74 @@ func (o *ObjectsClassPatchNotFound) readResponse(response runtime.ClientResponse
 	return nil
 }
 
+// NewObjectsClassPatchPreconditionFailed creates a ObjectsClassPatchPreconditionFailed with default headers values
+func NewObjectsClassPatchPreconditionFailed() *ObjectsClassPatchPreconditionFailed {
+	return &ObjectsClassPatchPreconditionFailed{}
+}
+
+/*
+ObjectsClassPatchPreconditionFailed describes a response with status code 412, with default header values.
+
+The object has changed since it was read, its current ETag does not match the If-Match header.
+*/
+type ObjectsClassPatchPreconditionFailed struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this objects class patch precondition failed response has a 2xx status code
+func (o *ObjectsClassPatchPreconditionFailed) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this objects class patch precondition failed response has a 3xx status code
+func (o *ObjectsClassPatchPreconditionFailed) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this objects class patch precondition failed response has a 4xx status code
+func (o *ObjectsClassPatchPreconditionFailed) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this objects class patch precondition failed response has a 5xx status code
+func (o *ObjectsClassPatchPreconditionFailed) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this objects class patch precondition failed response a status code equal to that given
+func (o *ObjectsClassPatchPreconditionFailed) IsCode(code int) bool {
+	return code == 412
+}
+
+// Code gets the status code for the objects class patch precondition failed response
+func (o *ObjectsClassPatchPreconditionFailed) Code() int {
+	return 412
+}
+
+func (o *ObjectsClassPatchPreconditionFailed) Error() string {
+	return fmt.Sprintf("[PATCH /objects/{className}/{id}][%d] objectsClassPatchPreconditionFailed  %+v", 412, o.Payload)
+}
+
+func (o *ObjectsClassPatchPreconditionFailed) String() string {
+	return fmt.Sprintf("[PATCH /objects/{className}/{id}][%d] objectsClassPatchPreconditionFailed  %+v", 412, o.Payload)
+}
+
+func (o *ObjectsClassPatchPreconditionFailed) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ObjectsClassPatchPreconditionFailed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewObjectsClassPatchUnprocessableEntity creates a ObjectsClassPatchUnprocessableEntity with default headers values
 func NewObjectsClassPatchUnprocessableEntity() *ObjectsClassPatchUnprocessableEntity {
 	return &ObjectsClassPatchUnprocessableEntity{}
@@ -453,6 +533,74 @@ func (o *ObjectsClassPatchUnprocessableEntity) readResponse(response runtime.Cli
 	return nil
 }
 
+// NewObjectsClassPatchLocked creates a ObjectsClassPatchLocked with default headers values
+func NewObjectsClassPatchLocked() *ObjectsClassPatchLocked {
+	return &ObjectsClassPatchLocked{}
+}
+
+/*
+ObjectsClassPatchLocked describes a response with status code 423, with default header values.
+
+The class or the node is in read-only mode and rejects writes
+*/
+type ObjectsClassPatchLocked struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this objects class patch locked response has a 2xx status code
+func (o *ObjectsClassPatchLocked) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this objects class patch locked response has a 3xx status code
+func (o *ObjectsClassPatchLocked) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this objects class patch locked response has a 4xx status code
+func (o *ObjectsClassPatchLocked) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this objects class patch locked response has a 5xx status code
+func (o *ObjectsClassPatchLocked) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this objects class patch locked response a status code equal to that given
+func (o *ObjectsClassPatchLocked) IsCode(code int) bool {
+	return code == 423
+}
+
+// Code gets the status code for the objects class patch locked response
+func (o *ObjectsClassPatchLocked) Code() int {
+	return 423
+}
+
+func (o *ObjectsClassPatchLocked) Error() string {
+	return fmt.Sprintf("[PATCH /objects/{className}/{id}][%d] objectsClassPatchLocked  %+v", 423, o.Payload)
+}
+
+func (o *ObjectsClassPatchLocked) String() string {
+	return fmt.Sprintf("[PATCH /objects/{className}/{id}][%d] objectsClassPatchLocked  %+v", 423, o.Payload)
+}
+
+func (o *ObjectsClassPatchLocked) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ObjectsClassPatchLocked) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewObjectsClassPatchInternalServerError creates a ObjectsClassPatchInternalServerError with default headers values
 func NewObjectsClassPatchInternalServerError() *ObjectsClassPatchInternalServerError {
 	return &ObjectsClassPatchInternalServerError{}
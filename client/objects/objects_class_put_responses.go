@@ -58,12 +58,24 @@ func (o *ObjectsClassPutReader) ReadResponse(response runtime.ClientResponse, co
 			return nil, err
 		}
 		return nil, result
+	case 412:
+		result := NewObjectsClassPutPreconditionFailed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 422:
 		result := NewObjectsClassPutUnprocessableEntity()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
 			return nil, err
 		}
 		return nil, result
+	case 423:
+		result := NewObjectsClassPutLocked()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 500:
 		result := NewObjectsClassPutInternalServerError()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -323,6 +335,74 @@ func (o *ObjectsClassPutNotFound) readResponse(response runtime.ClientResponse,
 	return nil
 }
 
+// NewObjectsClassPutPreconditionFailed creates a ObjectsClassPutPreconditionFailed with default headers values
+func NewObjectsClassPutPreconditionFailed() *ObjectsClassPutPreconditionFailed {
+	return &ObjectsClassPutPreconditionFailed{}
+}
+
+/*
+ObjectsClassPutPreconditionFailed describes a response with status code 412, with default header values.
+
+The object has changed since it was read, its current ETag does not match the If-Match header.
+*/
+type ObjectsClassPutPreconditionFailed struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this objects class put precondition failed response has a 2xx status code
+func (o *ObjectsClassPutPreconditionFailed) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this objects class put precondition failed response has a 3xx status code
+func (o *ObjectsClassPutPreconditionFailed) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this objects class put precondition failed response has a 4xx status code
+func (o *ObjectsClassPutPreconditionFailed) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this objects class put precondition failed response has a 5xx status code
+func (o *ObjectsClassPutPreconditionFailed) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this objects class put precondition failed response a status code equal to that given
+func (o *ObjectsClassPutPreconditionFailed) IsCode(code int) bool {
+	return code == 412
+}
+
+// Code gets the status code for the objects class put precondition failed response
+func (o *ObjectsClassPutPreconditionFailed) Code() int {
+	return 412
+}
+
+func (o *ObjectsClassPutPreconditionFailed) Error() string {
+	return fmt.Sprintf("[PUT /objects/{className}/{id}][%d] objectsClassPutPreconditionFailed  %+v", 412, o.Payload)
+}
+
+func (o *ObjectsClassPutPreconditionFailed) String() string {
+	return fmt.Sprintf("[PUT /objects/{className}/{id}][%d] objectsClassPutPreconditionFailed  %+v", 412, o.Payload)
+}
+
+func (o *ObjectsClassPutPreconditionFailed) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ObjectsClassPutPreconditionFailed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewObjectsClassPutUnprocessableEntity creates a ObjectsClassPutUnprocessableEntity with default headers values
 func NewObjectsClassPutUnprocessableEntity() *ObjectsClassPutUnprocessableEntity {
 	return &ObjectsClassPutUnprocessableEntity{}
@@ -391,6 +471,74 @@ func (o *ObjectsClassPutUnprocessableEntity) readResponse(response runtime.Clien
 	return nil
 }
 
+// NewObjectsClassPutLocked creates a ObjectsClassPutLocked with default headers values
+func NewObjectsClassPutLocked() *ObjectsClassPutLocked {
+	return &ObjectsClassPutLocked{}
+}
+
+/*
+ObjectsClassPutLocked describes a response with status code 423, with default header values.
+
+The class or the node is in read-only mode and rejects writes
+*/
+type ObjectsClassPutLocked struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this objects class put locked response has a 2xx status code
+func (o *ObjectsClassPutLocked) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this objects class put locked response has a 3xx status code
+func (o *ObjectsClassPutLocked) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this objects class put locked response has a 4xx status code
+func (o *ObjectsClassPutLocked) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this objects class put locked response has a 5xx status code
+func (o *ObjectsClassPutLocked) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this objects class put locked response a status code equal to that given
+func (o *ObjectsClassPutLocked) IsCode(code int) bool {
+	return code == 423
+}
+
+// Code gets the status code for the objects class put locked response
+func (o *ObjectsClassPutLocked) Code() int {
+	return 423
+}
+
+func (o *ObjectsClassPutLocked) Error() string {
+	return fmt.Sprintf("[PUT /objects/{className}/{id}][%d] objectsClassPutLocked  %+v", 423, o.Payload)
+}
+
+func (o *ObjectsClassPutLocked) String() string {
+	return fmt.Sprintf("[PUT /objects/{className}/{id}][%d] objectsClassPutLocked  %+v", 423, o.Payload)
+}
+
+func (o *ObjectsClassPutLocked) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ObjectsClassPutLocked) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewObjectsClassPutInternalServerError creates a ObjectsClassPutInternalServerError with default headers values
 func NewObjectsClassPutInternalServerError() *ObjectsClassPutInternalServerError {
 	return &ObjectsClassPutInternalServerError{}
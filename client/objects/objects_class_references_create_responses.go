@@ -70,6 +70,12 @@ func (o *ObjectsClassReferencesCreateReader) ReadResponse(response runtime.Clien
 			return nil, err
 		}
 		return nil, result
+	case 423:
+		result := NewObjectsClassReferencesCreateLocked()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 500:
 		result := NewObjectsClassReferencesCreateInternalServerError()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -453,6 +459,74 @@ func (o *ObjectsClassReferencesCreateUnprocessableEntity) readResponse(response
 	return nil
 }
 
+// NewObjectsClassReferencesCreateLocked creates a ObjectsClassReferencesCreateLocked with default headers values
+func NewObjectsClassReferencesCreateLocked() *ObjectsClassReferencesCreateLocked {
+	return &ObjectsClassReferencesCreateLocked{}
+}
+
+/*
+ObjectsClassReferencesCreateLocked describes a response with status code 423, with default header values.
+
+The class or the node is in read-only mode and rejects writes
+*/
+type ObjectsClassReferencesCreateLocked struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this objects class references create locked response has a 2xx status code
+func (o *ObjectsClassReferencesCreateLocked) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this objects class references create locked response has a 3xx status code
+func (o *ObjectsClassReferencesCreateLocked) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this objects class references create locked response has a 4xx status code
+func (o *ObjectsClassReferencesCreateLocked) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this objects class references create locked response has a 5xx status code
+func (o *ObjectsClassReferencesCreateLocked) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this objects class references create locked response a status code equal to that given
+func (o *ObjectsClassReferencesCreateLocked) IsCode(code int) bool {
+	return code == 423
+}
+
+// Code gets the status code for the objects class references create locked response
+func (o *ObjectsClassReferencesCreateLocked) Code() int {
+	return 423
+}
+
+func (o *ObjectsClassReferencesCreateLocked) Error() string {
+	return fmt.Sprintf("[POST /objects/{className}/{id}/references/{propertyName}][%d] objectsClassReferencesCreateLocked  %+v", 423, o.Payload)
+}
+
+func (o *ObjectsClassReferencesCreateLocked) String() string {
+	return fmt.Sprintf("[POST /objects/{className}/{id}/references/{propertyName}][%d] objectsClassReferencesCreateLocked  %+v", 423, o.Payload)
+}
+
+func (o *ObjectsClassReferencesCreateLocked) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ObjectsClassReferencesCreateLocked) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewObjectsClassReferencesCreateInternalServerError creates a ObjectsClassReferencesCreateInternalServerError with default headers values
 func NewObjectsClassReferencesCreateInternalServerError() *ObjectsClassReferencesCreateInternalServerError {
 	return &ObjectsClassReferencesCreateInternalServerError{}
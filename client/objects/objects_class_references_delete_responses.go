@@ -70,6 +70,12 @@ func (o *ObjectsClassReferencesDeleteReader) ReadResponse(response runtime.Clien
 			return nil, err
 		}
 		return nil, result
+	case 423:
+		result := NewObjectsClassReferencesDeleteLocked()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 500:
 		result := NewObjectsClassReferencesDeleteInternalServerError()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -465,6 +471,74 @@ func (o *ObjectsClassReferencesDeleteUnprocessableEntity) readResponse(response
 	return nil
 }
 
+// NewObjectsClassReferencesDeleteLocked creates a ObjectsClassReferencesDeleteLocked with default headers values
+func NewObjectsClassReferencesDeleteLocked() *ObjectsClassReferencesDeleteLocked {
+	return &ObjectsClassReferencesDeleteLocked{}
+}
+
+/*
+ObjectsClassReferencesDeleteLocked describes a response with status code 423, with default header values.
+
+The class or the node is in read-only mode and rejects writes
+*/
+type ObjectsClassReferencesDeleteLocked struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this objects class references delete locked response has a 2xx status code
+func (o *ObjectsClassReferencesDeleteLocked) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this objects class references delete locked response has a 3xx status code
+func (o *ObjectsClassReferencesDeleteLocked) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this objects class references delete locked response has a 4xx status code
+func (o *ObjectsClassReferencesDeleteLocked) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this objects class references delete locked response has a 5xx status code
+func (o *ObjectsClassReferencesDeleteLocked) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this objects class references delete locked response a status code equal to that given
+func (o *ObjectsClassReferencesDeleteLocked) IsCode(code int) bool {
+	return code == 423
+}
+
+// Code gets the status code for the objects class references delete locked response
+func (o *ObjectsClassReferencesDeleteLocked) Code() int {
+	return 423
+}
+
+func (o *ObjectsClassReferencesDeleteLocked) Error() string {
+	return fmt.Sprintf("[DELETE /objects/{className}/{id}/references/{propertyName}][%d] objectsClassReferencesDeleteLocked  %+v", 423, o.Payload)
+}
+
+func (o *ObjectsClassReferencesDeleteLocked) String() string {
+	return fmt.Sprintf("[DELETE /objects/{className}/{id}/references/{propertyName}][%d] objectsClassReferencesDeleteLocked  %+v", 423, o.Payload)
+}
+
+func (o *ObjectsClassReferencesDeleteLocked) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ObjectsClassReferencesDeleteLocked) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewObjectsClassReferencesDeleteInternalServerError creates a ObjectsClassReferencesDeleteInternalServerError with default headers values
 func NewObjectsClassReferencesDeleteInternalServerError() *ObjectsClassReferencesDeleteInternalServerError {
 	return &ObjectsClassReferencesDeleteInternalServerError{}
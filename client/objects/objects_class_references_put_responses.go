@@ -70,6 +70,12 @@ func (o *ObjectsClassReferencesPutReader) ReadResponse(response runtime.ClientRe
 			return nil, err
 		}
 		return nil, result
+	case 423:
+		result := NewObjectsClassReferencesPutLocked()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 500:
 		result := NewObjectsClassReferencesPutInternalServerError()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -453,6 +459,74 @@ func (o *ObjectsClassReferencesPutUnprocessableEntity) readResponse(response run
 	return nil
 }
 
+// NewObjectsClassReferencesPutLocked creates a ObjectsClassReferencesPutLocked with default headers values
+func NewObjectsClassReferencesPutLocked() *ObjectsClassReferencesPutLocked {
+	return &ObjectsClassReferencesPutLocked{}
+}
+
+/*
+ObjectsClassReferencesPutLocked describes a response with status code 423, with default header values.
+
+The class or the node is in read-only mode and rejects writes
+*/
+type ObjectsClassReferencesPutLocked struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this objects class references put locked response has a 2xx status code
+func (o *ObjectsClassReferencesPutLocked) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this objects class references put locked response has a 3xx status code
+func (o *ObjectsClassReferencesPutLocked) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this objects class references put locked response has a 4xx status code
+func (o *ObjectsClassReferencesPutLocked) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this objects class references put locked response has a 5xx status code
+func (o *ObjectsClassReferencesPutLocked) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this objects class references put locked response a status code equal to that given
+func (o *ObjectsClassReferencesPutLocked) IsCode(code int) bool {
+	return code == 423
+}
+
+// Code gets the status code for the objects class references put locked response
+func (o *ObjectsClassReferencesPutLocked) Code() int {
+	return 423
+}
+
+func (o *ObjectsClassReferencesPutLocked) Error() string {
+	return fmt.Sprintf("[PUT /objects/{className}/{id}/references/{propertyName}][%d] objectsClassReferencesPutLocked  %+v", 423, o.Payload)
+}
+
+func (o *ObjectsClassReferencesPutLocked) String() string {
+	return fmt.Sprintf("[PUT /objects/{className}/{id}/references/{propertyName}][%d] objectsClassReferencesPutLocked  %+v", 423, o.Payload)
+}
+
+func (o *ObjectsClassReferencesPutLocked) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ObjectsClassReferencesPutLocked) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewObjectsClassReferencesPutInternalServerError creates a ObjectsClassReferencesPutInternalServerError with default headers values
 func NewObjectsClassReferencesPutInternalServerError() *ObjectsClassReferencesPutInternalServerError {
 	return &ObjectsClassReferencesPutInternalServerError{}
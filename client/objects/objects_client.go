@@ -18,6 +18,7 @@ package objects
 
 import (
 	"fmt"
+	"io"
 
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/strfmt"
@@ -41,6 +42,10 @@ type ClientOption func(*runtime.ClientOperation)
 
 // ClientService is the interface for Client methods
 type ClientService interface {
+	ObjectsClassBlobsGet(params *ObjectsClassBlobsGetParams, authInfo runtime.ClientAuthInfoWriter, writer io.Writer, opts ...ClientOption) (*ObjectsClassBlobsGetOK, error)
+
+	ObjectsClassBlobsPut(params *ObjectsClassBlobsPutParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ObjectsClassBlobsPutNoContent, error)
+
 	ObjectsClassDelete(params *ObjectsClassDeleteParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ObjectsClassDeleteNoContent, error)
 
 	ObjectsClassGet(params *ObjectsClassGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ObjectsClassGetOK, error)
@@ -57,6 +62,8 @@ type ClientService interface {
 
 	ObjectsClassReferencesPut(params *ObjectsClassReferencesPutParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ObjectsClassReferencesPutOK, error)
 
+	ObjectsClassVectorPut(params *ObjectsClassVectorPutParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ObjectsClassVectorPutNoContent, error)
+
 	ObjectsCreate(params *ObjectsCreateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ObjectsCreateOK, error)
 
 	ObjectsDelete(params *ObjectsDeleteParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ObjectsDeleteNoContent, error)
@@ -82,6 +89,88 @@ type ClientService interface {
 	SetTransport(transport runtime.ClientTransport)
 }
 
+/*
+ObjectsClassBlobsGet downloads the blob of a class-property.
+
+Stream the contents of a blob property of a data object. Blobs stored out-of-band as well as inline blobs are returned as raw bytes, so they don't need to pass through the JSON representation of the object.
+*/
+func (a *Client) ObjectsClassBlobsGet(params *ObjectsClassBlobsGetParams, authInfo runtime.ClientAuthInfoWriter, writer io.Writer, opts ...ClientOption) (*ObjectsClassBlobsGetOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewObjectsClassBlobsGetParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "objects.class.blobs.get",
+		Method:             "GET",
+		PathPattern:        "/objects/{className}/{id}/blobs/{propertyName}",
+		ProducesMediaTypes: []string{"application/octet-stream"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ObjectsClassBlobsGetReader{formats: a.formats, writer: writer},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ObjectsClassBlobsGetOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for objects.class.blobs.get: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+ObjectsClassBlobsPut uploads the blob of a class-property.
+
+Stream the contents of a blob property of an existing data object. The blob is stored out-of-band, so large media doesn't need to pass through the JSON representation of the object. The property is set to a reference to the stored blob.
+*/
+func (a *Client) ObjectsClassBlobsPut(params *ObjectsClassBlobsPutParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ObjectsClassBlobsPutNoContent, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewObjectsClassBlobsPutParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "objects.class.blobs.put",
+		Method:             "PUT",
+		PathPattern:        "/objects/{className}/{id}/blobs/{propertyName}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/octet-stream"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ObjectsClassBlobsPutReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ObjectsClassBlobsPutNoContent)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for objects.class.blobs.put: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 ObjectsClassDelete deletes object based on its class and UUID
 
@@ -208,7 +297,7 @@ func (a *Client) ObjectsClassHead(params *ObjectsClassHeadParams, authInfo runti
 /*
 ObjectsClassPatch updates an object based on its UUID using patch semantics
 
-Update an individual data object based on its class and uuid. This method supports json-merge style patch semantics (RFC 7396). Provided meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.
+Update an individual data object based on its class and uuid. This method supports json-merge style patch semantics (RFC 7396) as well as JSON Patch (RFC 6902), depending on the content type. With application/json every given property replaces its previous value as a whole, with application/merge-patch+json nested objects are merged recursively, and with application/json-patch+json the body is a list of JSON Patch operations. Patches apply to the JSON representation of the object, for example the JSON Patch path of a property is /properties/{propertyName}, and can only change its properties and vector. Provided meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.
 */
 func (a *Client) ObjectsClassPatch(params *ObjectsClassPatchParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ObjectsClassPatchNoContent, error) {
 	// TODO: Validate the params before sending
@@ -220,7 +309,7 @@ func (a *Client) ObjectsClassPatch(params *ObjectsClassPatchParams, authInfo run
 		Method:             "PATCH",
 		PathPattern:        "/objects/{className}/{id}",
 		ProducesMediaTypes: []string{"application/json"},
-		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml", "application/merge-patch+json", "application/json-patch+json"},
 		Schemes:            []string{"https"},
 		Params:             params,
 		Reader:             &ObjectsClassPatchReader{formats: a.formats},
@@ -410,6 +499,47 @@ func (a *Client) ObjectsClassReferencesPut(params *ObjectsClassReferencesPutPara
 	panic(msg)
 }
 
+/*
+ObjectsClassVectorPut replaces the vector s of an object based on its UUID
+
+Replace the vector(s) of an existing data object without resending its properties. The vector index entries and the internal document version are updated, the properties are kept as they are. This is useful when re-embedding objects with a new model.
+*/
+func (a *Client) ObjectsClassVectorPut(params *ObjectsClassVectorPutParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ObjectsClassVectorPutNoContent, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewObjectsClassVectorPutParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "objects.class.vector.put",
+		Method:             "PUT",
+		PathPattern:        "/objects/{className}/{id}/vector",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ObjectsClassVectorPutReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ObjectsClassVectorPutNoContent)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for objects.class.vector.put: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 ObjectsCreate creates objects between two objects object and subject
 
@@ -64,6 +64,12 @@ func (o *ObjectsCreateReader) ReadResponse(response runtime.ClientResponse, cons
 			return nil, err
 		}
 		return nil, result
+	case 423:
+		result := NewObjectsCreateLocked()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 500:
 		result := NewObjectsCreateInternalServerError()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -403,6 +409,74 @@ func (o *ObjectsCreateUnprocessableEntity) readResponse(response runtime.ClientR
 	return nil
 }
 
+// NewObjectsCreateLocked creates a ObjectsCreateLocked with default headers values
+func NewObjectsCreateLocked() *ObjectsCreateLocked {
+	return &ObjectsCreateLocked{}
+}
+
+/*
+ObjectsCreateLocked describes a response with status code 423, with default header values.
+
+The class or the node is in read-only mode and rejects writes
+*/
+type ObjectsCreateLocked struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this objects create locked response has a 2xx status code
+func (o *ObjectsCreateLocked) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this objects create locked response has a 3xx status code
+func (o *ObjectsCreateLocked) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this objects create locked response has a 4xx status code
+func (o *ObjectsCreateLocked) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this objects create locked response has a 5xx status code
+func (o *ObjectsCreateLocked) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this objects create locked response a status code equal to that given
+func (o *ObjectsCreateLocked) IsCode(code int) bool {
+	return code == 423
+}
+
+// Code gets the status code for the objects create locked response
+func (o *ObjectsCreateLocked) Code() int {
+	return 423
+}
+
+func (o *ObjectsCreateLocked) Error() string {
+	return fmt.Sprintf("[POST /objects][%d] objectsCreateLocked  %+v", 423, o.Payload)
+}
+
+func (o *ObjectsCreateLocked) String() string {
+	return fmt.Sprintf("[POST /objects][%d] objectsCreateLocked  %+v", 423, o.Payload)
+}
+
+func (o *ObjectsCreateLocked) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ObjectsCreateLocked) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewObjectsCreateInternalServerError creates a ObjectsCreateInternalServerError with default headers values
 func NewObjectsCreateInternalServerError() *ObjectsCreateInternalServerError {
 	return &ObjectsCreateInternalServerError{}
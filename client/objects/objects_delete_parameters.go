@@ -86,6 +86,12 @@ type ObjectsDeleteParams struct {
 	*/
 	ID strfmt.UUID
 
+	/* IfMatch.
+
+	   Only apply the write if the object has not changed since it was read: the ETag of the object as returned by GET /objects/{className}/{id}. Multiple comma-separated ETags are allowed..
+	*/
+	IfMatch *string
+
 	/* Tenant.
 
 	   Specifies the tenant in a request targeting a multi-tenant class
@@ -167,6 +173,17 @@ func (o *ObjectsDeleteParams) SetID(id strfmt.UUID) {
 	o.ID = id
 }
 
+// WithIfMatch adds the ifMatch to the objects delete params
+func (o *ObjectsDeleteParams) WithIfMatch(ifMatch *string) *ObjectsDeleteParams {
+	o.SetIfMatch(ifMatch)
+	return o
+}
+
+// SetIfMatch adds the ifMatch to the objects delete params
+func (o *ObjectsDeleteParams) SetIfMatch(ifMatch *string) {
+	o.IfMatch = ifMatch
+}
+
 // WithTenant adds the tenant to the objects delete params
 func (o *ObjectsDeleteParams) WithTenant(tenant *string) *ObjectsDeleteParams {
 	o.SetTenant(tenant)
@@ -208,6 +225,14 @@ func (o *ObjectsDeleteParams) WriteToRequest(r runtime.ClientRequest, reg strfmt
 		return err
 	}
 
+	if o.IfMatch != nil {
+
+		// header param If-Match
+		if err := r.SetHeaderParam("If-Match", *o.IfMatch); err != nil {
+			return err
+		}
+	}
+
 	if o.Tenant != nil {
 
 		// query param tenant
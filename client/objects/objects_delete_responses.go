@@ -58,6 +58,18 @@ func (o *ObjectsDeleteReader) ReadResponse(response runtime.ClientResponse, cons
 			return nil, err
 		}
 		return nil, result
+	case 412:
+		result := NewObjectsDeletePreconditionFailed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 423:
+		result := NewObjectsDeleteLocked()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 500:
 		result := NewObjectsDeleteInternalServerError()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -305,6 +317,142 @@ func (o *ObjectsDeleteNotFound) readResponse(response runtime.ClientResponse, co
 	return nil
 }
 
+// NewObjectsDeletePreconditionFailed creates a ObjectsDeletePreconditionFailed with default headers values
+func NewObjectsDeletePreconditionFailed() *ObjectsDeletePreconditionFailed {
+	return &ObjectsDeletePreconditionFailed{}
+}
+
+/*
+ObjectsDeletePreconditionFailed describes a response with status code 412, with default header values.
+
+The object has changed since it was read, its current ETag does not match the If-Match header.
+*/
+type ObjectsDeletePreconditionFailed struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this objects delete precondition failed response has a 2xx status code
+func (o *ObjectsDeletePreconditionFailed) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this objects delete precondition failed response has a 3xx status code
+func (o *ObjectsDeletePreconditionFailed) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this objects delete precondition failed response has a 4xx status code
+func (o *ObjectsDeletePreconditionFailed) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this objects delete precondition failed response has a 5xx status code
+func (o *ObjectsDeletePreconditionFailed) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this objects delete precondition failed response a status code equal to that given
+func (o *ObjectsDeletePreconditionFailed) IsCode(code int) bool {
+	return code == 412
+}
+
+// Code gets the status code for the objects delete precondition failed response
+func (o *ObjectsDeletePreconditionFailed) Code() int {
+	return 412
+}
+
+func (o *ObjectsDeletePreconditionFailed) Error() string {
+	return fmt.Sprintf("[DELETE /objects/{id}][%d] objectsDeletePreconditionFailed  %+v", 412, o.Payload)
+}
+
+func (o *ObjectsDeletePreconditionFailed) String() string {
+	return fmt.Sprintf("[DELETE /objects/{id}][%d] objectsDeletePreconditionFailed  %+v", 412, o.Payload)
+}
+
+func (o *ObjectsDeletePreconditionFailed) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ObjectsDeletePreconditionFailed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewObjectsDeleteLocked creates a ObjectsDeleteLocked with default headers values
+func NewObjectsDeleteLocked() *ObjectsDeleteLocked {
+	return &ObjectsDeleteLocked{}
+}
+
+/*
+ObjectsDeleteLocked describes a response with status code 423, with default header values.
+
+The class or the node is in read-only mode and rejects writes
+*/
+type ObjectsDeleteLocked struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this objects delete locked response has a 2xx status code
+func (o *ObjectsDeleteLocked) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this objects delete locked response has a 3xx status code
+func (o *ObjectsDeleteLocked) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this objects delete locked response has a 4xx status code
+func (o *ObjectsDeleteLocked) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this objects delete locked response has a 5xx status code
+func (o *ObjectsDeleteLocked) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this objects delete locked response a status code equal to that given
+func (o *ObjectsDeleteLocked) IsCode(code int) bool {
+	return code == 423
+}
+
+// Code gets the status code for the objects delete locked response
+func (o *ObjectsDeleteLocked) Code() int {
+	return 423
+}
+
+func (o *ObjectsDeleteLocked) Error() string {
+	return fmt.Sprintf("[DELETE /objects/{id}][%d] objectsDeleteLocked  %+v", 423, o.Payload)
+}
+
+func (o *ObjectsDeleteLocked) String() string {
+	return fmt.Sprintf("[DELETE /objects/{id}][%d] objectsDeleteLocked  %+v", 423, o.Payload)
+}
+
+func (o *ObjectsDeleteLocked) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ObjectsDeleteLocked) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewObjectsDeleteInternalServerError creates a ObjectsDeleteInternalServerError with default headers values
 func NewObjectsDeleteInternalServerError() *ObjectsDeleteInternalServerError {
 	return &ObjectsDeleteInternalServerError{}
@@ -86,6 +86,11 @@ ObjectsGetOK describes a response with status code 200, with default header valu
 Successful response.
 */
 type ObjectsGetOK struct {
+
+	/* Version of the object, can be sent in the If-Match header of a write to only apply the write if the object has not changed since
+	 */
+	ETag string
+
 	Payload *models.Object
 }
 
@@ -133,6 +138,13 @@ func (o *ObjectsGetOK) GetPayload() *models.Object {
 
 func (o *ObjectsGetOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
 
+	// hydrates response header ETag
+	hdrETag := response.GetHeader("ETag")
+
+	if hdrETag != "" {
+		o.ETag = hdrETag
+	}
+
 	o.Payload = new(models.Object)
 
 	// response payload
@@ -113,6 +113,18 @@ type ObjectsListParams struct {
 	*/
 	Order *string
 
+	/* Scroll.
+
+	   Starts or keeps alive a scroll session for the given duration, e.g. '5m'. All pages of a scroll session are read from the same snapshot of the class, which is not affected by concurrent writes. Requires the class and limit parameters.
+	*/
+	Scroll *string
+
+	/* ScrollID.
+
+	   The ID of the scroll session to continue, as returned with the first page.
+	*/
+	ScrollID *string
+
 	/* Sort.
 
 	   Sort parameter to pass an information about the names of the sort fields
@@ -255,6 +267,28 @@ func (o *ObjectsListParams) SetOrder(order *string) {
 	o.Order = order
 }
 
+// WithScroll adds the scroll to the objects list params
+func (o *ObjectsListParams) WithScroll(scroll *string) *ObjectsListParams {
+	o.SetScroll(scroll)
+	return o
+}
+
+// SetScroll adds the scroll to the objects list params
+func (o *ObjectsListParams) SetScroll(scroll *string) {
+	o.Scroll = scroll
+}
+
+// WithScrollID adds the scrollID to the objects list params
+func (o *ObjectsListParams) WithScrollID(scrollID *string) *ObjectsListParams {
+	o.SetScrollID(scrollID)
+	return o
+}
+
+// SetScrollID adds the scrollId to the objects list params
+func (o *ObjectsListParams) SetScrollID(scrollID *string) {
+	o.ScrollID = scrollID
+}
+
 // WithSort adds the sort to the objects list params
 func (o *ObjectsListParams) WithSort(sort *string) *ObjectsListParams {
 	o.SetSort(sort)
@@ -387,6 +421,40 @@ func (o *ObjectsListParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.R
 		}
 	}
 
+	if o.Scroll != nil {
+
+		// query param scroll
+		var qrScroll string
+
+		if o.Scroll != nil {
+			qrScroll = *o.Scroll
+		}
+		qScroll := qrScroll
+		if qScroll != "" {
+
+			if err := r.SetQueryParam("scroll", qScroll); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.ScrollID != nil {
+
+		// query param scrollId
+		var qrScrollID string
+
+		if o.ScrollID != nil {
+			qrScrollID = *o.ScrollID
+		}
+		qScrollID := qrScrollID
+		if qScrollID != "" {
+
+			if err := r.SetQueryParam("scrollId", qScrollID); err != nil {
+				return err
+			}
+		}
+	}
+
 	if o.Sort != nil {
 
 		// query param sort
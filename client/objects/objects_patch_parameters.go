@@ -94,6 +94,12 @@ type ObjectsPatchParams struct {
 	*/
 	ID strfmt.UUID
 
+	/* IfMatch.
+
+	   Only apply the write if the object has not changed since it was read: the ETag of the object as returned by GET /objects/{className}/{id}. Multiple comma-separated ETags are allowed..
+	*/
+	IfMatch *string
+
 	timeout    time.Duration
 	Context    context.Context
 	HTTPClient *http.Client
@@ -180,6 +186,17 @@ func (o *ObjectsPatchParams) SetID(id strfmt.UUID) {
 	o.ID = id
 }
 
+// WithIfMatch adds the ifMatch to the objects patch params
+func (o *ObjectsPatchParams) WithIfMatch(ifMatch *string) *ObjectsPatchParams {
+	o.SetIfMatch(ifMatch)
+	return o
+}
+
+// SetIfMatch adds the ifMatch to the objects patch params
+func (o *ObjectsPatchParams) SetIfMatch(ifMatch *string) {
+	o.IfMatch = ifMatch
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *ObjectsPatchParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -215,6 +232,14 @@ func (o *ObjectsPatchParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.
 		return err
 	}
 
+	if o.IfMatch != nil {
+
+		// header param If-Match
+		if err := r.SetHeaderParam("If-Match", *o.IfMatch); err != nil {
+			return err
+		}
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -64,12 +64,24 @@ func (o *ObjectsPatchReader) ReadResponse(response runtime.ClientResponse, consu
 			return nil, err
 		}
 		return nil, result
+	case 412:
+		result := NewObjectsPatchPreconditionFailed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 422:
 		result := NewObjectsPatchUnprocessableEntity()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
 			return nil, err
 		}
 		return nil, result
+	case 423:
+		result := NewObjectsPatchLocked()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 500:
 		result := NewObjectsPatchInternalServerError()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -373,6 +385,74 @@ func (o *ObjectsPatchNotFound) readResponse(response runtime.ClientResponse, con
 	return nil
 }
 
+// NewObjectsPatchPreconditionFailed creates a ObjectsPatchPreconditionFailed with default headers values
+func NewObjectsPatchPreconditionFailed() *ObjectsPatchPreconditionFailed {
+	return &ObjectsPatchPreconditionFailed{}
+}
+
+/*
+ObjectsPatchPreconditionFailed describes a response with status code 412, with default header values.
+
+The object has changed since it was read, its current ETag does not match the If-Match header.
+*/
+type ObjectsPatchPreconditionFailed struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this objects patch precondition failed response has a 2xx status code
+func (o *ObjectsPatchPreconditionFailed) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this objects patch precondition failed response has a 3xx status code
+func (o *ObjectsPatchPreconditionFailed) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this objects patch precondition failed response has a 4xx status code
+func (o *ObjectsPatchPreconditionFailed) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this objects patch precondition failed response has a 5xx status code
+func (o *ObjectsPatchPreconditionFailed) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this objects patch precondition failed response a status code equal to that given
+func (o *ObjectsPatchPreconditionFailed) IsCode(code int) bool {
+	return code == 412
+}
+
+// Code gets the status code for the objects patch precondition failed response
+func (o *ObjectsPatchPreconditionFailed) Code() int {
+	return 412
+}
+
+func (o *ObjectsPatchPreconditionFailed) Error() string {
+	return fmt.Sprintf("[PATCH /objects/{id}][%d] objectsPatchPreconditionFailed  %+v", 412, o.Payload)
+}
+
+func (o *ObjectsPatchPreconditionFailed) String() string {
+	return fmt.Sprintf("[PATCH /objects/{id}][%d] objectsPatchPreconditionFailed  %+v", 412, o.Payload)
+}
+
+func (o *ObjectsPatchPreconditionFailed) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ObjectsPatchPreconditionFailed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewObjectsPatchUnprocessableEntity creates a ObjectsPatchUnprocessableEntity with default headers values
 func NewObjectsPatchUnprocessableEntity() *ObjectsPatchUnprocessableEntity {
 	return &ObjectsPatchUnprocessableEntity{}
@@ -441,6 +521,74 @@ func (o *ObjectsPatchUnprocessableEntity) readResponse(response runtime.ClientRe
 	return nil
 }
 
+// NewObjectsPatchLocked creates a ObjectsPatchLocked with default headers values
+func NewObjectsPatchLocked() *ObjectsPatchLocked {
+	return &ObjectsPatchLocked{}
+}
+
+/*
+ObjectsPatchLocked describes a response with status code 423, with default header values.
+
+The class or the node is in read-only mode and rejects writes
+*/
+type ObjectsPatchLocked struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this objects patch locked response has a 2xx status code
+func (o *ObjectsPatchLocked) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this objects patch locked response has a 3xx status code
+func (o *ObjectsPatchLocked) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this objects patch locked response has a 4xx status code
+func (o *ObjectsPatchLocked) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this objects patch locked response has a 5xx status code
+func (o *ObjectsPatchLocked) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this objects patch locked response a status code equal to that given
+func (o *ObjectsPatchLocked) IsCode(code int) bool {
+	return code == 423
+}
+
+// Code gets the status code for the objects patch locked response
+func (o *ObjectsPatchLocked) Code() int {
+	return 423
+}
+
+func (o *ObjectsPatchLocked) Error() string {
+	return fmt.Sprintf("[PATCH /objects/{id}][%d] objectsPatchLocked  %+v", 423, o.Payload)
+}
+
+func (o *ObjectsPatchLocked) String() string {
+	return fmt.Sprintf("[PATCH /objects/{id}][%d] objectsPatchLocked  %+v", 423, o.Payload)
+}
+
+func (o *ObjectsPatchLocked) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ObjectsPatchLocked) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewObjectsPatchInternalServerError creates a ObjectsPatchInternalServerError with default headers values
 func NewObjectsPatchInternalServerError() *ObjectsPatchInternalServerError {
 	return &ObjectsPatchInternalServerError{}
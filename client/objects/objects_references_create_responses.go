@@ -58,6 +58,12 @@ func (o *ObjectsReferencesCreateReader) ReadResponse(response runtime.ClientResp
 			return nil, err
 		}
 		return nil, result
+	case 423:
+		result := NewObjectsReferencesCreateLocked()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 500:
 		result := NewObjectsReferencesCreateInternalServerError()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -317,6 +323,74 @@ func (o *ObjectsReferencesCreateUnprocessableEntity) readResponse(response runti
 	return nil
 }
 
+// NewObjectsReferencesCreateLocked creates a ObjectsReferencesCreateLocked with default headers values
+func NewObjectsReferencesCreateLocked() *ObjectsReferencesCreateLocked {
+	return &ObjectsReferencesCreateLocked{}
+}
+
+/*
+ObjectsReferencesCreateLocked describes a response with status code 423, with default header values.
+
+The class or the node is in read-only mode and rejects writes
+*/
+type ObjectsReferencesCreateLocked struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this objects references create locked response has a 2xx status code
+func (o *ObjectsReferencesCreateLocked) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this objects references create locked response has a 3xx status code
+func (o *ObjectsReferencesCreateLocked) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this objects references create locked response has a 4xx status code
+func (o *ObjectsReferencesCreateLocked) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this objects references create locked response has a 5xx status code
+func (o *ObjectsReferencesCreateLocked) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this objects references create locked response a status code equal to that given
+func (o *ObjectsReferencesCreateLocked) IsCode(code int) bool {
+	return code == 423
+}
+
+// Code gets the status code for the objects references create locked response
+func (o *ObjectsReferencesCreateLocked) Code() int {
+	return 423
+}
+
+func (o *ObjectsReferencesCreateLocked) Error() string {
+	return fmt.Sprintf("[POST /objects/{id}/references/{propertyName}][%d] objectsReferencesCreateLocked  %+v", 423, o.Payload)
+}
+
+func (o *ObjectsReferencesCreateLocked) String() string {
+	return fmt.Sprintf("[POST /objects/{id}/references/{propertyName}][%d] objectsReferencesCreateLocked  %+v", 423, o.Payload)
+}
+
+func (o *ObjectsReferencesCreateLocked) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ObjectsReferencesCreateLocked) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewObjectsReferencesCreateInternalServerError creates a ObjectsReferencesCreateInternalServerError with default headers values
 func NewObjectsReferencesCreateInternalServerError() *ObjectsReferencesCreateInternalServerError {
 	return &ObjectsReferencesCreateInternalServerError{}
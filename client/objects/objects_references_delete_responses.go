@@ -58,6 +58,12 @@ func (o *ObjectsReferencesDeleteReader) ReadResponse(response runtime.ClientResp
 			return nil, err
 		}
 		return nil, result
+	case 423:
+		result := NewObjectsReferencesDeleteLocked()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 500:
 		result := NewObjectsReferencesDeleteInternalServerError()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -317,6 +323,74 @@ func (o *ObjectsReferencesDeleteNotFound) readResponse(response runtime.ClientRe
 	return nil
 }
 
+// NewObjectsReferencesDeleteLocked creates a ObjectsReferencesDeleteLocked with default headers values
+func NewObjectsReferencesDeleteLocked() *ObjectsReferencesDeleteLocked {
+	return &ObjectsReferencesDeleteLocked{}
+}
+
+/*
+ObjectsReferencesDeleteLocked describes a response with status code 423, with default header values.
+
+The class or the node is in read-only mode and rejects writes
+*/
+type ObjectsReferencesDeleteLocked struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this objects references delete locked response has a 2xx status code
+func (o *ObjectsReferencesDeleteLocked) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this objects references delete locked response has a 3xx status code
+func (o *ObjectsReferencesDeleteLocked) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this objects references delete locked response has a 4xx status code
+func (o *ObjectsReferencesDeleteLocked) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this objects references delete locked response has a 5xx status code
+func (o *ObjectsReferencesDeleteLocked) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this objects references delete locked response a status code equal to that given
+func (o *ObjectsReferencesDeleteLocked) IsCode(code int) bool {
+	return code == 423
+}
+
+// Code gets the status code for the objects references delete locked response
+func (o *ObjectsReferencesDeleteLocked) Code() int {
+	return 423
+}
+
+func (o *ObjectsReferencesDeleteLocked) Error() string {
+	return fmt.Sprintf("[DELETE /objects/{id}/references/{propertyName}][%d] objectsReferencesDeleteLocked  %+v", 423, o.Payload)
+}
+
+func (o *ObjectsReferencesDeleteLocked) String() string {
+	return fmt.Sprintf("[DELETE /objects/{id}/references/{propertyName}][%d] objectsReferencesDeleteLocked  %+v", 423, o.Payload)
+}
+
+func (o *ObjectsReferencesDeleteLocked) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ObjectsReferencesDeleteLocked) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewObjectsReferencesDeleteInternalServerError creates a ObjectsReferencesDeleteInternalServerError with default headers values
 func NewObjectsReferencesDeleteInternalServerError() *ObjectsReferencesDeleteInternalServerError {
 	return &ObjectsReferencesDeleteInternalServerError{}
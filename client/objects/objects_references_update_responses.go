@@ -58,6 +58,12 @@ func (o *ObjectsReferencesUpdateReader) ReadResponse(response runtime.ClientResp
 			return nil, err
 		}
 		return nil, result
+	case 423:
+		result := NewObjectsReferencesUpdateLocked()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 500:
 		result := NewObjectsReferencesUpdateInternalServerError()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -317,6 +323,74 @@ func (o *ObjectsReferencesUpdateUnprocessableEntity) readResponse(response runti
 	return nil
 }
 
+// NewObjectsReferencesUpdateLocked creates a ObjectsReferencesUpdateLocked with default headers values
+func NewObjectsReferencesUpdateLocked() *ObjectsReferencesUpdateLocked {
+	return &ObjectsReferencesUpdateLocked{}
+}
+
+/*
+ObjectsReferencesUpdateLocked describes a response with status code 423, with default header values.
+
+The class or the node is in read-only mode and rejects writes
+*/
+type ObjectsReferencesUpdateLocked struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this objects references update locked response has a 2xx status code
+func (o *ObjectsReferencesUpdateLocked) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this objects references update locked response has a 3xx status code
+func (o *ObjectsReferencesUpdateLocked) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this objects references update locked response has a 4xx status code
+func (o *ObjectsReferencesUpdateLocked) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this objects references update locked response has a 5xx status code
+func (o *ObjectsReferencesUpdateLocked) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this objects references update locked response a status code equal to that given
+func (o *ObjectsReferencesUpdateLocked) IsCode(code int) bool {
+	return code == 423
+}
+
+// Code gets the status code for the objects references update locked response
+func (o *ObjectsReferencesUpdateLocked) Code() int {
+	return 423
+}
+
+func (o *ObjectsReferencesUpdateLocked) Error() string {
+	return fmt.Sprintf("[PUT /objects/{id}/references/{propertyName}][%d] objectsReferencesUpdateLocked  %+v", 423, o.Payload)
+}
+
+func (o *ObjectsReferencesUpdateLocked) String() string {
+	return fmt.Sprintf("[PUT /objects/{id}/references/{propertyName}][%d] objectsReferencesUpdateLocked  %+v", 423, o.Payload)
+}
+
+func (o *ObjectsReferencesUpdateLocked) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ObjectsReferencesUpdateLocked) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewObjectsReferencesUpdateInternalServerError creates a ObjectsReferencesUpdateInternalServerError with default headers values
 func NewObjectsReferencesUpdateInternalServerError() *ObjectsReferencesUpdateInternalServerError {
 	return &ObjectsReferencesUpdateInternalServerError{}
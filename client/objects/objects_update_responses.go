@@ -58,12 +58,24 @@ func (o *ObjectsUpdateReader) ReadResponse(response runtime.ClientResponse, cons
 			return nil, err
 		}
 		return nil, result
+	case 412:
+		result := NewObjectsUpdatePreconditionFailed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 422:
 		result := NewObjectsUpdateUnprocessableEntity()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
 			return nil, err
 		}
 		return nil, result
+	case 423:
+		result := NewObjectsUpdateLocked()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 500:
 		result := NewObjectsUpdateInternalServerError()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -323,6 +335,74 @@ func (o *ObjectsUpdateNotFound) readResponse(response runtime.ClientResponse, co
 	return nil
 }
 
+// NewObjectsUpdatePreconditionFailed creates a ObjectsUpdatePreconditionFailed with default headers values
+func NewObjectsUpdatePreconditionFailed() *ObjectsUpdatePreconditionFailed {
+	return &ObjectsUpdatePreconditionFailed{}
+}
+
+/*
+ObjectsUpdatePreconditionFailed describes a response with status code 412, with default header values.
+
+The object has changed since it was read, its current ETag does not match the If-Match header.
+*/
+type ObjectsUpdatePreconditionFailed struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this objects update precondition failed response has a 2xx status code
+func (o *ObjectsUpdatePreconditionFailed) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this objects update precondition failed response has a 3xx status code
+func (o *ObjectsUpdatePreconditionFailed) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this objects update precondition failed response has a 4xx status code
+func (o *ObjectsUpdatePreconditionFailed) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this objects update precondition failed response has a 5xx status code
+func (o *ObjectsUpdatePreconditionFailed) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this objects update precondition failed response a status code equal to that given
+func (o *ObjectsUpdatePreconditionFailed) IsCode(code int) bool {
+	return code == 412
+}
+
+// Code gets the status code for the objects update precondition failed response
+func (o *ObjectsUpdatePreconditionFailed) Code() int {
+	return 412
+}
+
+func (o *ObjectsUpdatePreconditionFailed) Error() string {
+	return fmt.Sprintf("[PUT /objects/{id}][%d] objectsUpdatePreconditionFailed  %+v", 412, o.Payload)
+}
+
+func (o *ObjectsUpdatePreconditionFailed) String() string {
+	return fmt.Sprintf("[PUT /objects/{id}][%d] objectsUpdatePreconditionFailed  %+v", 412, o.Payload)
+}
+
+func (o *ObjectsUpdatePreconditionFailed) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ObjectsUpdatePreconditionFailed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewObjectsUpdateUnprocessableEntity creates a ObjectsUpdateUnprocessableEntity with default headers values
 func NewObjectsUpdateUnprocessableEntity() *ObjectsUpdateUnprocessableEntity {
 	return &ObjectsUpdateUnprocessableEntity{}
@@ -391,6 +471,74 @@ func (o *ObjectsUpdateUnprocessableEntity) readResponse(response runtime.ClientR
 	return nil
 }
 
+// NewObjectsUpdateLocked creates a ObjectsUpdateLocked with default headers values
+func NewObjectsUpdateLocked() *ObjectsUpdateLocked {
+	return &ObjectsUpdateLocked{}
+}
+
+/*
+ObjectsUpdateLocked describes a response with status code 423, with default header values.
+
+The class or the node is in read-only mode and rejects writes
+*/
+type ObjectsUpdateLocked struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this objects update locked response has a 2xx status code
+func (o *ObjectsUpdateLocked) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this objects update locked response has a 3xx status code
+func (o *ObjectsUpdateLocked) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this objects update locked response has a 4xx status code
+func (o *ObjectsUpdateLocked) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this objects update locked response has a 5xx status code
+func (o *ObjectsUpdateLocked) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this objects update locked response a status code equal to that given
+func (o *ObjectsUpdateLocked) IsCode(code int) bool {
+	return code == 423
+}
+
+// Code gets the status code for the objects update locked response
+func (o *ObjectsUpdateLocked) Code() int {
+	return 423
+}
+
+func (o *ObjectsUpdateLocked) Error() string {
+	return fmt.Sprintf("[PUT /objects/{id}][%d] objectsUpdateLocked  %+v", 423, o.Payload)
+}
+
+func (o *ObjectsUpdateLocked) String() string {
+	return fmt.Sprintf("[PUT /objects/{id}][%d] objectsUpdateLocked  %+v", 423, o.Payload)
+}
+
+func (o *ObjectsUpdateLocked) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ObjectsUpdateLocked) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewObjectsUpdateInternalServerError creates a ObjectsUpdateInternalServerError with default headers values
 func NewObjectsUpdateInternalServerError() *ObjectsUpdateInternalServerError {
 	return &ObjectsUpdateInternalServerError{}
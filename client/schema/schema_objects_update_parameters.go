@@ -25,6 +25,7 @@ import (
 	"github.com/go-openapi/runtime"
 	cr "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 
 	"github.com/weaviate/weaviate/entities/models"
 )
@@ -77,6 +78,12 @@ type SchemaObjectsUpdateParams struct {
 	// ClassName.
 	ClassName string
 
+	/* DryRun.
+
+	   If true, the update is only validated, but not applied.
+	*/
+	DryRun *bool
+
 	// ObjectClass.
 	ObjectClass *models.Class
 
@@ -97,7 +104,18 @@ func (o *SchemaObjectsUpdateParams) WithDefaults() *SchemaObjectsUpdateParams {
 //
 // All values with no default are reset to their zero value.
 func (o *SchemaObjectsUpdateParams) SetDefaults() {
-	// no default values defined for this parameter
+	var (
+		dryRunDefault = bool(false)
+	)
+
+	val := SchemaObjectsUpdateParams{
+		DryRun: &dryRunDefault,
+	}
+
+	val.timeout = o.timeout
+	val.Context = o.Context
+	val.HTTPClient = o.HTTPClient
+	*o = val
 }
 
 // WithTimeout adds the timeout to the schema objects update params
@@ -144,6 +162,17 @@ func (o *SchemaObjectsUpdateParams) SetClassName(className string) {
 	o.ClassName = className
 }
 
+// WithDryRun adds the dryRun to the schema objects update params
+func (o *SchemaObjectsUpdateParams) WithDryRun(dryRun *bool) *SchemaObjectsUpdateParams {
+	o.SetDryRun(dryRun)
+	return o
+}
+
+// SetDryRun adds the dryRun to the schema objects update params
+func (o *SchemaObjectsUpdateParams) SetDryRun(dryRun *bool) {
+	o.DryRun = dryRun
+}
+
 // WithObjectClass adds the objectClass to the schema objects update params
 func (o *SchemaObjectsUpdateParams) WithObjectClass(objectClass *models.Class) *SchemaObjectsUpdateParams {
 	o.SetObjectClass(objectClass)
@@ -167,6 +196,23 @@ func (o *SchemaObjectsUpdateParams) WriteToRequest(r runtime.ClientRequest, reg
 	if err := r.SetPathParam("className", o.ClassName); err != nil {
 		return err
 	}
+
+	if o.DryRun != nil {
+
+		// query param dryRun
+		var qrDryRun bool
+
+		if o.DryRun != nil {
+			qrDryRun = *o.DryRun
+		}
+		qDryRun := swag.FormatBool(qrDryRun)
+		if qDryRun != "" {
+
+			if err := r.SetQueryParam("dryRun", qDryRun); err != nil {
+				return err
+			}
+		}
+	}
 	if o.ObjectClass != nil {
 		if err := r.SetBodyParam(o.ObjectClass); err != nil {
 			return err
@@ -35,11 +35,16 @@ type Properties struct {
 	ExplainScore       bool                   `json:"explainScore"`
 	IsConsistent       bool                   `json:"isConsistent"`
 	Group              bool                   `json:"group"`
+	Highlights         bool                   `json:"highlights"`
 
 	// The User is not interested in returning props, we can skip any costly
 	// operation that isn't required.
 	NoProps bool `json:"noProps"`
 
+	// The User is not interested in the referenced objects, cross-references
+	// are returned with their target class and id only, without any lookup.
+	NoRefResolution bool `json:"noRefResolution"`
+
 	// ReferenceQuery is used to indicate that a search
 	// is being conducted on behalf of a referenced
 	// property. for example: this is relevant when a
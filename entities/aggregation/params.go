@@ -34,6 +34,41 @@ type Params struct {
 	NearVector       *searchparams.NearVector   `json:"nearVector"`
 	NearObject       *searchparams.NearObject   `json:"nearObject"`
 	Hybrid           *searchparams.HybridSearch `json:"hybrid"`
+	// GroupByProperties groups by the combination of the values of several
+	// properties. It is used instead of GroupBy.
+	GroupByProperties []*filters.Path `json:"groupByProperties"`
+	GroupByOrder      *GroupByOrder   `json:"groupByOrder"`
+	// Explain adds the breakdown of the stages of the aggregation to the
+	// groups of the result
+	Explain bool `json:"explain"`
+}
+
+// Grouped returns whether the aggregation is performed in groups
+func (p Params) Grouped() bool {
+	return p.GroupBy != nil || len(p.GroupByProperties) > 0
+}
+
+// GroupByPaths returns the paths of all properties to group by
+func (p Params) GroupByPaths() []*filters.Path {
+	if p.GroupBy != nil {
+		return []*filters.Path{p.GroupBy}
+	}
+	return p.GroupByProperties
+}
+
+// GroupByOrder orders the groups of a grouped aggregation. The groups are
+// ordered by the result of an aggregator on one of the aggregated properties,
+// or by their count if Property is empty.
+type GroupByOrder struct {
+	Property   schema.PropertyName `json:"property"`
+	Aggregator string              `json:"aggregator"`
+	Ascending  bool                `json:"ascending"`
+}
+
+// ByCountDescending returns whether the groups are ordered by the default
+// order, which allows to select the top groups before they are aggregated
+func (o *GroupByOrder) ByCountDescending() bool {
+	return o == nil || (o.Property == "" && !o.Ascending)
 }
 
 type ParamProperty struct {
@@ -44,6 +79,8 @@ type ParamProperty struct {
 type Aggregator struct {
 	Type  string `json:"type"`
 	Limit *int   `json:"limit"` // used on TopOccurrence Agg
+	// used on Histogram Agg, the width of the buckets in seconds for dates
+	Interval *float64 `json:"interval"`
 }
 
 func (a Aggregator) String() string {
@@ -64,6 +101,16 @@ var (
 	MedianAggregator  = Aggregator{Type: "median"}
 	MaximumAggregator = Aggregator{Type: "maximum"}
 	MinimumAggregator = Aggregator{Type: "minimum"}
+
+	StandardDeviationAggregator = Aggregator{Type: "standardDeviation"}
+	Percentile50Aggregator      = Aggregator{Type: "p50"}
+	Percentile90Aggregator      = Aggregator{Type: "p90"}
+	Percentile99Aggregator      = Aggregator{Type: "p99"}
+)
+
+// Aggregators used in numerical and text props
+var (
+	DistinctCountAggregator = Aggregator{Type: "distinctCount"}
 )
 
 // Aggregators used in boolean props
@@ -82,6 +129,14 @@ func NewTopOccurrencesAggregator(limit *int) Aggregator {
 	return Aggregator{Type: TopOccurrencesType, Limit: limit}
 }
 
+const HistogramType = "histogram"
+
+// NewHistogramAggregator creates a HistogramAggregator, we cannot use a
+// singleton for this as the desired interval can be different each time
+func NewHistogramAggregator(interval *float64) Aggregator {
+	return Aggregator{Type: HistogramType, Interval: interval}
+}
+
 // Aggregators used in ref props
 var (
 	PointingToAggregator = Aggregator{Type: "pointingTo"}
@@ -108,6 +163,22 @@ func ParseAggregatorProp(name string) (Aggregator, error) {
 		return MinimumAggregator, nil
 	case SumAggregator.String():
 		return SumAggregator, nil
+	case StandardDeviationAggregator.String():
+		return StandardDeviationAggregator, nil
+	case Percentile50Aggregator.String():
+		return Percentile50Aggregator, nil
+	case Percentile90Aggregator.String():
+		return Percentile90Aggregator, nil
+	case Percentile99Aggregator.String():
+		return Percentile99Aggregator, nil
+
+	// numerical and text
+	case DistinctCountAggregator.String():
+		return DistinctCountAggregator, nil
+
+	// numerical and date
+	case HistogramType:
+		return NewHistogramAggregator(nil), nil // the interval has to be set
 
 	// boolean
 	case TotalTrueAggregator.String():
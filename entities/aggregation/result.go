@@ -11,6 +11,8 @@
 
 package aggregation
 
+import "github.com/weaviate/weaviate/entities/explain"
+
 type Result struct {
 	Groups []Group `json:"groups"`
 }
@@ -19,6 +21,9 @@ type Group struct {
 	Properties map[string]Property `json:"properties"`
 	GroupedBy  *GroupedBy          `json:"groupedBy"` // optional to support ungrouped aggregations (formerly meta)
 	Count      int                 `json:"count"`
+	// Explain is the breakdown of the stages of the aggregation, it is only
+	// set if the aggregation was explained
+	Explain []explain.Stage `json:"explain,omitempty"`
 }
 
 type Property struct {
@@ -32,8 +37,12 @@ type Property struct {
 }
 
 type Text struct {
-	Items []TextOccurrence `json:"items"`
-	Count int              `json:"count"`
+	Items         []TextOccurrence `json:"items"`
+	Count         int              `json:"count"`
+	DistinctCount int              `json:"distinctCount"`
+	// DistinctCountSketch is the HyperLogLog sketch the distinct count was
+	// estimated from. It is only needed to merge the results of several shards.
+	DistinctCountSketch []byte `json:"distinctCountSketch,omitempty"`
 }
 
 type PropertyType string
@@ -49,6 +58,15 @@ const (
 type GroupedBy struct {
 	Value interface{} `json:"value"`
 	Path  []string    `json:"path"`
+	// Keys contain the value of every property the group was grouped by, if
+	// it was grouped by GroupByProperties. The Value and Path are only set if
+	// it was grouped by a single property.
+	Keys []GroupedByKey `json:"keys"`
+}
+
+type GroupedByKey struct {
+	Value interface{} `json:"value"`
+	Path  []string    `json:"path"`
 }
 
 type TextOccurrence struct {
@@ -67,3 +85,11 @@ type Boolean struct {
 type Reference struct {
 	PointingTo []string `json:"pointingTo"`
 }
+
+// HistogramBucket counts the values between Key and Key plus the interval of
+// the histogram. The Key is a float64 for numeric props and an RFC3339
+// timestamp for date props.
+type HistogramBucket struct {
+	Key   interface{} `json:"key"`
+	Count int         `json:"count"`
+}
@@ -13,6 +13,7 @@ package cyclemanager
 
 import (
 	"context"
+	"sort"
 	"sync"
 	"time"
 
@@ -92,6 +93,8 @@ func (c *cycleCallbackGroup) CycleCallback(shouldAbort ShouldAbortCallback) bool
 }
 
 func (c *cycleCallbackGroup) cycleCallbackSequential(shouldAbort ShouldAbortCallback) bool {
+	c.sortByPriority()
+
 	anyExecuted := false
 	i := 0
 	for {
@@ -153,6 +156,8 @@ func (c *cycleCallbackGroup) cycleCallbackSequential(shouldAbort ShouldAbortCall
 }
 
 func (c *cycleCallbackGroup) cycleCallbackParallel(shouldAbort ShouldAbortCallback, routinesLimit int) bool {
+	c.sortByPriority()
+
 	anyExecuted := false
 	ch := make(chan uint32)
 	lock := new(sync.Mutex)
@@ -241,6 +246,42 @@ func (c *cycleCallbackGroup) cycleCallbackParallel(shouldAbort ShouldAbortCallba
 	return anyExecuted
 }
 
+// sortByPriority orders callbacks registered WithPriority by descending
+// priority, followed by all other callbacks in order of registration.
+// Priorities are evaluated without holding the lock, as they may need to
+// acquire locks of their own.
+func (c *cycleCallbackGroup) sortByPriority() {
+	c.Lock()
+	priorityFns := map[uint32]func() float64{}
+	for callbackId, meta := range c.callbacks {
+		if meta.priority != nil {
+			priorityFns[callbackId] = meta.priority
+		}
+	}
+	c.Unlock()
+
+	if len(priorityFns) == 0 {
+		return
+	}
+
+	priorities := make(map[uint32]float64, len(priorityFns))
+	for callbackId, priority := range priorityFns {
+		priorities[callbackId] = priority()
+	}
+
+	c.Lock()
+	defer c.Unlock()
+
+	sort.SliceStable(c.callbackIds, func(i, j int) bool {
+		pi, iOk := priorities[c.callbackIds[i]]
+		pj, jOk := priorities[c.callbackIds[j]]
+		if iOk != jOk {
+			return iOk
+		}
+		return pi > pj
+	})
+}
+
 func (c *cycleCallbackGroup) recover(callbackCustomId string, cancel context.CancelFunc) {
 	if r := recover(); r != nil {
 		c.logger.WithFields(logrus.Fields{
@@ -356,6 +397,7 @@ type cycleCallbackMeta struct {
 	runningCtx context.Context
 	started    time.Time
 	intervals  CycleIntervals
+	priority   func() float64
 }
 
 type cycleCallbackGroupNoop struct{}
@@ -391,3 +433,15 @@ func WithIntervals(intervals CycleIntervals) RegisterOption {
 		meta.started = time.Now().Add(-intervals.Get())
 	}
 }
+
+// WithPriority runs callbacks of higher priority first within a cycle. The
+// priority is evaluated at the beginning of every cycle. Callbacks without
+// priority run after all prioritized ones.
+func WithPriority(priority func() float64) RegisterOption {
+	if priority == nil {
+		return nil
+	}
+	return func(meta *cycleCallbackMeta) {
+		meta.priority = priority
+	}
+}
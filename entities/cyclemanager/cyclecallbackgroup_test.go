@@ -1727,3 +1727,42 @@ func TestCycleCallback_Sequential_Deactivate(t *testing.T) {
 		assert.GreaterOrEqual(t, d, 50*time.Millisecond)
 	})
 }
+
+func TestCycleCallback_Sequential_Priority(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	shouldNotAbort := func() bool { return false }
+
+	t.Run("callbacks run by descending priority", func(t *testing.T) {
+		var order []string
+		callback := func(id string) CycleCallback {
+			return func(shouldAbort ShouldAbortCallback) bool {
+				order = append(order, id)
+				return true
+			}
+		}
+		priorities := map[string]float64{"c1": 1, "c2": 3, "c3": 2}
+		priority := func(id string) func() float64 {
+			return func() float64 { return priorities[id] }
+		}
+
+		callbacks := NewCallbackGroup("id", logger, 1)
+		callbacks.Register("c0", callback("c0"))
+		callbacks.Register("c1", callback("c1"), WithPriority(priority("c1")))
+		callbacks.Register("c2", callback("c2"), WithPriority(priority("c2")))
+		callbacks.Register("c3", callback("c3"), WithPriority(priority("c3")))
+
+		executed := callbacks.CycleCallback(shouldNotAbort)
+
+		assert.True(t, executed)
+		assert.Equal(t, []string{"c2", "c3", "c1", "c0"}, order)
+
+		t.Run("priorities are evaluated every cycle", func(t *testing.T) {
+			order = nil
+			priorities["c1"] = 4
+
+			callbacks.CycleCallback(shouldNotAbort)
+
+			assert.Equal(t, []string{"c1", "c2", "c3", "c0"}, order)
+		})
+	})
+}
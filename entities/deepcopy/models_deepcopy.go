@@ -19,7 +19,23 @@ func Schema(s *models.Schema) *models.Schema {
 		classes[i] = Class(class)
 	}
 
-	return &models.Schema{Name: s.Name, Maintainer: s.Maintainer, Classes: classes}
+	var stopwordSets []*models.StopwordSet = nil
+	if s.StopwordSets != nil {
+		stopwordSets = make([]*models.StopwordSet, len(s.StopwordSets))
+		for i, set := range s.StopwordSets {
+			stopwordSets[i] = StopwordSet(set)
+		}
+	}
+
+	return &models.Schema{Name: s.Name, Maintainer: s.Maintainer, Classes: classes, StopwordSets: stopwordSets}
+}
+
+func StopwordSet(s *models.StopwordSet) *models.StopwordSet {
+	if s == nil {
+		return nil
+	}
+
+	return &models.StopwordSet{Name: s.Name, Words: s.Words}
 }
 
 func Class(c *models.Class) *models.Class {
@@ -44,6 +60,7 @@ func Class(c *models.Class) *models.Class {
 		Description:         c.Description,
 		ModuleConfig:        c.ModuleConfig,
 		ShardingConfig:      c.ShardingConfig,
+		VectorConfig:        c.VectorConfig,
 		VectorIndexConfig:   c.VectorIndexConfig,
 		VectorIndexType:     c.VectorIndexType,
 		ReplicationConfig:   replicationConf,
@@ -55,13 +72,16 @@ func Class(c *models.Class) *models.Class {
 
 func Prop(p *models.Property) *models.Property {
 	return &models.Property{
-		DataType:        p.DataType,
-		Description:     p.Description,
-		ModuleConfig:    p.ModuleConfig,
-		Name:            p.Name,
-		Tokenization:    p.Tokenization,
-		IndexFilterable: ptrBoolCopy(p.IndexFilterable),
-		IndexSearchable: ptrBoolCopy(p.IndexSearchable),
+		DataType:          p.DataType,
+		Description:       p.Description,
+		ModuleConfig:      p.ModuleConfig,
+		Name:              p.Name,
+		Tokenization:      p.Tokenization,
+		IndexFilterable:   ptrBoolCopy(p.IndexFilterable),
+		IndexSearchable:   ptrBoolCopy(p.IndexSearchable),
+		IndexPositions:    ptrBoolCopy(p.IndexPositions),
+		IndexRangeFilters: ptrBoolCopy(p.IndexRangeFilters),
+		UniqueKey:         p.UniqueKey,
 	}
 }
 
@@ -81,19 +101,41 @@ func InvertedIndexConfig(i *models.InvertedIndexConfig) *models.InvertedIndexCon
 	var bm25 *models.BM25Config = nil
 	if i.Bm25 != nil {
 		bm25 = &models.BM25Config{B: i.Bm25.B, K1: i.Bm25.K1}
+		if i.Bm25.PropertyBoosts != nil {
+			bm25.PropertyBoosts = make(map[string]float32, len(i.Bm25.PropertyBoosts))
+			for prop, boost := range i.Bm25.PropertyBoosts {
+				bm25.PropertyBoosts[prop] = boost
+			}
+		}
 	}
 
 	var stopwords *models.StopwordConfig = nil
 	if i.Stopwords != nil {
-		stopwords = &models.StopwordConfig{Additions: i.Stopwords.Additions, Preset: i.Stopwords.Preset, Removals: i.Stopwords.Removals}
+		stopwords = &models.StopwordConfig{
+			Additions: i.Stopwords.Additions, Preset: i.Stopwords.Preset,
+			Removals: i.Stopwords.Removals, Sets: i.Stopwords.Sets,
+		}
+	}
+
+	var synonyms []*models.SynonymRule = nil
+	if i.Synonyms != nil {
+		synonyms = make([]*models.SynonymRule, len(i.Synonyms))
+		for j, rule := range i.Synonyms {
+			if rule != nil {
+				synonyms[j] = &models.SynonymRule{Terms: rule.Terms, Synonyms: rule.Synonyms}
+			}
+		}
 	}
 
 	return &models.InvertedIndexConfig{
-		Bm25:                   bm25,
-		CleanupIntervalSeconds: i.CleanupIntervalSeconds,
-		IndexNullState:         i.IndexNullState,
-		IndexPropertyLength:    i.IndexPropertyLength,
-		IndexTimestamps:        i.IndexTimestamps,
-		Stopwords:              stopwords,
+		Bm25:                       bm25,
+		CleanupIntervalSeconds:     i.CleanupIntervalSeconds,
+		Compression:                i.Compression,
+		IndexNullState:             i.IndexNullState,
+		IndexPropertyLength:        i.IndexPropertyLength,
+		IndexTimestampRangeFilters: i.IndexTimestampRangeFilters,
+		IndexTimestamps:            i.IndexTimestamps,
+		Stopwords:                  stopwords,
+		Synonyms:                   synonyms,
 	}
 }
@@ -0,0 +1,141 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package diskio
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"unsafe"
+)
+
+// directIOAlignment is the alignment of offsets, lengths and memory addresses
+// required by O_DIRECT on all common block devices
+const directIOAlignment = 4096
+
+// DirectReader reads a file while bypassing the page cache (O_DIRECT where
+// supported). It is meant for large sequential reads, such as compactions and
+// full scans, which would otherwise evict hot pages from the cache. Reads are
+// served from an aligned window which is refilled as needed, so that ReadAt
+// can be called with arbitrary offsets and lengths.
+//
+// A DirectReader is not safe for concurrent use.
+type DirectReader struct {
+	file *os.File
+	size int64
+
+	buf         []byte
+	window      []byte
+	windowStart int64
+}
+
+// NewDirectReader opens the file at path for direct reads. Every read issued
+// against the disk covers windowSize bytes, rounded up to the alignment. It
+// returns an error if the file or the underlying filesystem does not support
+// direct reads, in which case callers are expected to fall back to regular
+// reads.
+func NewDirectReader(path string, windowSize int) (*DirectReader, error) {
+	file, err := os.OpenFile(path, os.O_RDONLY|directIOFlag, 0)
+	if err != nil {
+		return nil, fmt.Errorf("open %q for direct io: %w", path, err)
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, fmt.Errorf("stat %q: %w", path, err)
+	}
+
+	r := &DirectReader{
+		file: file,
+		size: info.Size(),
+		buf:  alignedBuffer(alignUp(windowSize)),
+	}
+
+	// some filesystems accept the flag on open, but reject the reads
+	if err := r.fill(0); err != nil {
+		file.Close()
+		return nil, fmt.Errorf("direct read of %q: %w", path, err)
+	}
+
+	return r, nil
+}
+
+// Size returns the size of the file at the time it was opened
+func (r *DirectReader) Size() int64 {
+	return r.size
+}
+
+// ReadAt implements io.ReaderAt. It fills p entirely unless the end of the
+// file is reached, in which case io.EOF is returned.
+func (r *DirectReader) ReadAt(p []byte, off int64) (int, error) {
+	if off < 0 {
+		return 0, fmt.Errorf("direct read at negative offset %d", off)
+	}
+
+	n := 0
+	for n < len(p) {
+		pos := off + int64(n)
+		if pos >= r.size {
+			return n, io.EOF
+		}
+
+		if pos < r.windowStart || pos >= r.windowStart+int64(len(r.window)) {
+			if err := r.fill(pos); err != nil {
+				return n, err
+			}
+		}
+
+		n += copy(p[n:], r.window[pos-r.windowStart:])
+	}
+
+	return n, nil
+}
+
+// Close closes the underlying file
+func (r *DirectReader) Close() error {
+	return r.file.Close()
+}
+
+// fill reads the aligned window which contains pos
+func (r *DirectReader) fill(pos int64) error {
+	start := pos &^ (directIOAlignment - 1)
+	n, err := r.file.ReadAt(r.buf, start)
+	if err != nil && err != io.EOF {
+		return err
+	}
+
+	r.windowStart = start
+	r.window = r.buf[:n]
+	if pos < r.size && pos >= start+int64(n) {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func alignUp(size int) int {
+	if size < directIOAlignment {
+		return directIOAlignment
+	}
+	return (size + directIOAlignment - 1) &^ (directIOAlignment - 1)
+}
+
+// alignedBuffer allocates a buffer of the given size whose first byte is
+// aligned to directIOAlignment
+func alignedBuffer(size int) []byte {
+	buf := make([]byte, size+directIOAlignment)
+	offset := 0
+	if rem := int(uintptr(unsafe.Pointer(&buf[0])) & (directIOAlignment - 1)); rem != 0 {
+		offset = directIOAlignment - rem
+	}
+	return buf[offset : offset+size : offset+size]
+}
@@ -0,0 +1,24 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build !linux
+
+package diskio
+
+import "os"
+
+// O_DIRECT is not available, reads go through the page cache
+const directIOFlag = 0
+
+// DropPageCache is a no-op on platforms without fadvise
+func DropPageCache(f *os.File) error {
+	return nil
+}
@@ -0,0 +1,71 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package diskio
+
+import (
+	"io"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDirectReader(t *testing.T) {
+	// spans multiple windows and does not end on an aligned offset
+	windowSize := 64 * 1024
+	data := make([]byte, 20*windowSize+12345)
+	rand.Read(data)
+
+	path := filepath.Join(t.TempDir(), "segment.db")
+	require.Nil(t, os.WriteFile(path, data, 0o666))
+
+	r, err := NewDirectReader(path, windowSize)
+	if err != nil {
+		t.Skipf("direct io not supported: %v", err)
+	}
+	defer r.Close()
+
+	assert.Equal(t, int64(len(data)), r.Size())
+
+	t.Run("sequential reads", func(t *testing.T) {
+		out, err := io.ReadAll(io.NewSectionReader(r, 0, r.Size()))
+		require.Nil(t, err)
+		assert.Equal(t, data, out)
+	})
+
+	t.Run("random reads", func(t *testing.T) {
+		for i := 0; i < 1000; i++ {
+			off := rand.Int63n(int64(len(data)))
+			length := rand.Intn(3 * windowSize)
+			if off+int64(length) > int64(len(data)) {
+				length = int(int64(len(data)) - off)
+			}
+
+			p := make([]byte, length)
+			n, err := r.ReadAt(p, off)
+			require.Nil(t, err)
+			require.Equal(t, length, n)
+			require.Equal(t, data[off:off+int64(length)], p)
+		}
+	})
+
+	t.Run("reading past the end", func(t *testing.T) {
+		p := make([]byte, 100)
+		n, err := r.ReadAt(p, int64(len(data))-10)
+		assert.Equal(t, io.EOF, err)
+		assert.Equal(t, 10, n)
+		assert.Equal(t, data[len(data)-10:], p[:n])
+	})
+}
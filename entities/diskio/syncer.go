@@ -0,0 +1,166 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package diskio
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+const (
+	// SyncModeNone leaves it to the OS when written data reaches the disk. This
+	// allows for the highest ingest throughput, but acknowledged writes can be
+	// lost if the machine (not just the process) crashes.
+	SyncModeNone = "none"
+
+	// SyncModeAlways fsyncs on every commit. A write is only acknowledged once
+	// it is on disk.
+	SyncModeAlways = "always"
+
+	// SyncModeGroup collects the commits of concurrent writers for up to
+	// SyncPolicy.MaxDelay and fsyncs them together. A write is only
+	// acknowledged once it is on disk, at the cost of added latency.
+	SyncModeGroup = "group"
+
+	DefaultGroupCommitMaxDelay = 10 * time.Millisecond
+)
+
+// SyncPolicy controls how commits of a write-ahead log are made durable
+type SyncPolicy struct {
+	Mode string
+
+	// MaxDelay is the longest a commit waits for others to join its fsync,
+	// only used with SyncModeGroup
+	MaxDelay time.Duration
+}
+
+// ValidSyncMode returns an error if mode is not a known sync mode. An empty
+// mode is valid and means SyncModeNone.
+func ValidSyncMode(mode string) error {
+	switch mode {
+	case "", SyncModeNone, SyncModeAlways, SyncModeGroup:
+		return nil
+	default:
+		return fmt.Errorf("unrecognized sync mode %q", mode)
+	}
+}
+
+type syncable interface {
+	Sync() error
+}
+
+// syncBatch is a group of commits that are made durable by the same fsync
+type syncBatch struct {
+	done chan struct{}
+	err  error
+}
+
+// Syncer makes commits to a file durable according to a SyncPolicy. It is
+// safe to be used concurrently.
+type Syncer struct {
+	file   syncable
+	policy SyncPolicy
+
+	sync.Mutex
+	pending *syncBatch
+	closed  bool
+}
+
+func NewSyncer(file syncable, policy SyncPolicy) *Syncer {
+	if policy.Mode == SyncModeGroup && policy.MaxDelay <= 0 {
+		policy.MaxDelay = DefaultGroupCommitMaxDelay
+	}
+
+	return &Syncer{file: file, policy: policy}
+}
+
+// Sync returns once everything written to the file before the call is durable
+// according to the policy. Callers must not hold locks that other writers
+// need while waiting, as this would prevent commits from being grouped.
+func (s *Syncer) Sync() error {
+	switch s.policy.Mode {
+	case SyncModeAlways:
+		s.Lock()
+		defer s.Unlock()
+		if s.closed {
+			return nil
+		}
+		return s.file.Sync()
+	case SyncModeGroup:
+		return s.groupSync()
+	default:
+		return nil
+	}
+}
+
+func (s *Syncer) groupSync() error {
+	s.Lock()
+	if s.closed {
+		// everything was synced as part of closing
+		s.Unlock()
+		return nil
+	}
+
+	batch := s.pending
+	if batch == nil {
+		// the first commit of a batch schedules the fsync, any commit until
+		// then joins it
+		batch = &syncBatch{done: make(chan struct{})}
+		s.pending = batch
+		time.AfterFunc(s.policy.MaxDelay, func() {
+			s.Lock()
+			defer s.Unlock()
+
+			if s.pending != batch {
+				// already completed by Close
+				return
+			}
+			s.completePending()
+		})
+	}
+	s.Unlock()
+
+	<-batch.done
+	return batch.err
+}
+
+// not thread-safe on its own, as the assumption is that this is called from a
+// lockholder
+func (s *Syncer) completePending() {
+	batch := s.pending
+	s.pending = nil
+	batch.err = s.file.Sync()
+	close(batch.done)
+}
+
+// Close completes all pending commits. It must be called before the file is
+// closed. Later calls to Sync return immediately.
+func (s *Syncer) Close() error {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.closed {
+		return nil
+	}
+	s.closed = true
+
+	if s.pending != nil {
+		batch := s.pending
+		s.completePending()
+		return batch.err
+	}
+	if s.policy.Mode == SyncModeAlways || s.policy.Mode == SyncModeGroup {
+		return s.file.Sync()
+	}
+	return nil
+}
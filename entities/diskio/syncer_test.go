@@ -0,0 +1,100 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package diskio
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type countingFile struct {
+	syncs atomic.Int32
+}
+
+func (f *countingFile) Sync() error {
+	f.syncs.Add(1)
+	return nil
+}
+
+func TestSyncer(t *testing.T) {
+	t.Run("none", func(t *testing.T) {
+		f := &countingFile{}
+		s := NewSyncer(f, SyncPolicy{Mode: SyncModeNone})
+
+		require.Nil(t, s.Sync())
+		require.Nil(t, s.Close())
+		assert.Equal(t, int32(0), f.syncs.Load())
+	})
+
+	t.Run("always", func(t *testing.T) {
+		f := &countingFile{}
+		s := NewSyncer(f, SyncPolicy{Mode: SyncModeAlways})
+
+		for i := 0; i < 3; i++ {
+			require.Nil(t, s.Sync())
+		}
+		assert.Equal(t, int32(3), f.syncs.Load())
+	})
+
+	t.Run("group shares an fsync between concurrent commits", func(t *testing.T) {
+		f := &countingFile{}
+		s := NewSyncer(f, SyncPolicy{Mode: SyncModeGroup, MaxDelay: 50 * time.Millisecond})
+
+		wg := sync.WaitGroup{}
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				assert.Nil(t, s.Sync())
+			}()
+		}
+		wg.Wait()
+
+		// all commits start well within the delay, but allow for a slow
+		// scheduler
+		assert.Less(t, f.syncs.Load(), int32(10))
+		assert.GreaterOrEqual(t, f.syncs.Load(), int32(1))
+	})
+
+	t.Run("close completes pending commits", func(t *testing.T) {
+		f := &countingFile{}
+		s := NewSyncer(f, SyncPolicy{Mode: SyncModeGroup, MaxDelay: time.Hour})
+
+		done := make(chan error)
+		go func() { done <- s.Sync() }()
+
+		// wait for the commit to be pending
+		require.Eventually(t, func() bool {
+			s.Lock()
+			defer s.Unlock()
+			return s.pending != nil
+		}, time.Second, time.Millisecond)
+
+		require.Nil(t, s.Close())
+		require.Nil(t, <-done)
+		assert.Equal(t, int32(1), f.syncs.Load())
+
+		// the syncer is closed, there is nothing left to sync
+		require.Nil(t, s.Sync())
+		assert.Equal(t, int32(1), f.syncs.Load())
+	})
+
+	t.Run("invalid mode", func(t *testing.T) {
+		assert.NotNil(t, ValidSyncMode("sometimes"))
+		assert.Nil(t, ValidSyncMode(""))
+	})
+}
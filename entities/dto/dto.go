@@ -12,6 +12,8 @@
 package dto
 
 import (
+	"time"
+
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/search"
@@ -36,9 +38,18 @@ type GetParams struct {
 	HybridSearch          *searchparams.HybridSearch
 	GroupBy               *searchparams.GroupBy
 	SearchVector          []float32
+	TargetVector          string
+	Rescore               *searchparams.Rescore
+	Exact                 bool
 	Group                 *GroupParams
 	ModuleParams          map[string]interface{}
 	AdditionalProperties  additional.Properties
 	ReplicationProperties *additional.ReplicationProperties
 	Tenant                string
+	// Timeout overrides the default query timeout of the server, zero uses
+	// the default
+	Timeout time.Duration
+	// Explain adds the breakdown of the stages of the query to the
+	// _additional props of the results
+	Explain bool
 }
@@ -12,8 +12,10 @@
 package errors
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"time"
 )
 
 type ErrGraphQLUser struct {
@@ -53,6 +55,48 @@ func NewErrRateLimit() ErrRateLimit {
 	return ErrRateLimit{errors.New("429 Too many requests")}
 }
 
+// ErrOverloaded is returned when the node rejects an operation because too
+// many heavy operations are running or queued already. The operation can be
+// retried after RetryAfter.
+type ErrOverloaded struct {
+	err        error
+	retryAfter time.Duration
+}
+
+func (e ErrOverloaded) Error() string {
+	return e.err.Error()
+}
+
+func (e ErrOverloaded) RetryAfter() time.Duration {
+	return e.retryAfter
+}
+
+func NewErrOverloaded(operation string, retryAfter time.Duration) ErrOverloaded {
+	return ErrOverloaded{
+		err: fmt.Errorf("429 Too many requests: too many concurrent %s operations, retry after %s",
+			operation, retryAfter),
+		retryAfter: retryAfter,
+	}
+}
+
+// ErrQueryTimeout is returned when a query was aborted because it ran longer
+// than its timeout. It wraps context.DeadlineExceeded.
+type ErrQueryTimeout struct {
+	err error
+}
+
+func (e ErrQueryTimeout) Error() string {
+	return e.err.Error()
+}
+
+func (e ErrQueryTimeout) Unwrap() error {
+	return e.err
+}
+
+func NewErrQueryTimeout(timeout time.Duration) ErrQueryTimeout {
+	return ErrQueryTimeout{fmt.Errorf("query timed out after %s: %w", timeout, context.DeadlineExceeded)}
+}
+
 type ErrLockConnector struct {
 	err error
 }
@@ -0,0 +1,108 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package explain breaks a query down into its stages, with the time spent
+// in each stage and the number of objects it produced. The stages are
+// recorded in a Profile which travels with the context of the query, so that
+// every layer can report its stages without changing its signatures.
+package explain
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+const (
+	StageFilter        = "filter"
+	StageVectorSearch  = "vectorSearch"
+	StageKeywordSearch = "keywordSearch"
+	StageFusion        = "fusion"
+	StageRefResolution = "refResolution"
+	StageRerank        = "rerank"
+	StageAggregation   = "aggregation"
+	StageTotal         = "total"
+)
+
+// Stage is the breakdown of a single stage of a query. A stage which ran
+// several times, e.g. once per shard, is summed up, so its time may exceed
+// the total time of a query which ran the shards in parallel.
+type Stage struct {
+	Name        string  `json:"stage"`
+	TookMs      float64 `json:"tookMs"`
+	Cardinality int     `json:"cardinality"`
+	Runs        int     `json:"runs"`
+}
+
+// Profile collects the stages of a single query. It is safe for concurrent
+// use and all its methods are no-ops on a nil Profile, which is what
+// FromContext returns for queries which are not explained.
+type Profile struct {
+	mu     sync.Mutex
+	stages []*Stage
+}
+
+type contextKey struct{}
+
+// NewContext returns a context which carries a new Profile
+func NewContext(ctx context.Context) (context.Context, *Profile) {
+	p := &Profile{}
+	return context.WithValue(ctx, contextKey{}, p), p
+}
+
+// FromContext returns the Profile of the query, nil if it is not explained
+func FromContext(ctx context.Context) *Profile {
+	p, _ := ctx.Value(contextKey{}).(*Profile)
+	return p
+}
+
+// Track records a run of the stage which started at start and produced
+// cardinality objects
+func (p *Profile) Track(name string, start time.Time, cardinality int) {
+	if p == nil {
+		return
+	}
+	took := float64(time.Since(start).Microseconds()) / 1000
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for _, stage := range p.stages {
+		if stage.Name == name {
+			stage.TookMs += took
+			stage.Cardinality += cardinality
+			stage.Runs++
+			return
+		}
+	}
+	p.stages = append(p.stages, &Stage{
+		Name:        name,
+		TookMs:      took,
+		Cardinality: cardinality,
+		Runs:        1,
+	})
+}
+
+// Stages returns the recorded stages in the order they first ran
+func (p *Profile) Stages() []Stage {
+	if p == nil {
+		return nil
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	out := make([]Stage, len(p.stages))
+	for i, stage := range p.stages {
+		out[i] = *stage
+	}
+	return out
+}
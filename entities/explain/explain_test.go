@@ -0,0 +1,55 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package explain
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProfile(t *testing.T) {
+	t.Run("not explained", func(t *testing.T) {
+		p := FromContext(context.Background())
+		assert.Nil(t, p)
+		p.Track(StageFilter, time.Now(), 3)
+		assert.Nil(t, p.Stages())
+	})
+
+	t.Run("explained", func(t *testing.T) {
+		ctx, p := NewContext(context.Background())
+		require.Same(t, p, FromContext(ctx))
+
+		var wg sync.WaitGroup
+		for i := 0; i < 3; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				FromContext(ctx).Track(StageFilter, time.Now().Add(-time.Millisecond), 10)
+			}()
+		}
+		wg.Wait()
+		p.Track(StageVectorSearch, time.Now(), 5)
+
+		stages := p.Stages()
+		require.Len(t, stages, 2)
+		assert.Equal(t, StageFilter, stages[0].Name)
+		assert.Equal(t, 30, stages[0].Cardinality)
+		assert.Equal(t, 3, stages[0].Runs)
+		assert.GreaterOrEqual(t, stages[0].TookMs, 3.0)
+		assert.Equal(t, Stage{Name: StageVectorSearch, TookMs: stages[1].TookMs, Cardinality: 5, Runs: 1}, stages[1])
+	})
+}
@@ -11,9 +11,34 @@
 
 package filters
 
+import "time"
+
+const (
+	// DefaultScrollTTL is used if a scroll session is continued without a
+	// new keep alive duration
+	DefaultScrollTTL = time.Minute
+	// MaxScrollTTL limits how long a scroll session keeps its snapshot, as the
+	// snapshot prevents compacted segments from being released
+	MaxScrollTTL = time.Hour
+	// MaxScrollSessions limits how many scroll sessions can be open on a
+	// shard at once, further sessions are rejected until one expires
+	MaxScrollSessions = 100
+)
+
 type Cursor struct {
 	After string `json:"after"`
 	Limit int    `json:"limit"`
+
+	// Scroll reads all pages of the cursor from the same snapshot
+	Scroll *Scroll `json:"scroll,omitempty"`
+}
+
+// Scroll identifies a scroll session. Each shard pins a snapshot of its
+// objects on the first page of a session and keeps it until the session was
+// not used for the TTL.
+type Scroll struct {
+	ID  string        `json:"id"`
+	TTL time.Duration `json:"ttl"`
 }
 
 // ExtractCursorFromArgs gets the limit key out of a map. Not specific to
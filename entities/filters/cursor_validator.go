@@ -45,5 +45,14 @@ func ValidateCursor(className schema.ClassName, cursor *Cursor, offset int, filt
 	if cursor.Limit < 0 {
 		return fmt.Errorf("limit parameter must be set")
 	}
+	if cursor.Scroll != nil {
+		if cursor.Scroll.ID == "" {
+			return fmt.Errorf("scroll id cannot be empty")
+		}
+		if cursor.Scroll.TTL <= 0 || cursor.Scroll.TTL > MaxScrollTTL {
+			return fmt.Errorf("scroll duration must be positive and at most %s, got %s",
+				MaxScrollTTL, cursor.Scroll.TTL)
+		}
+	}
 	return nil
 }
@@ -34,6 +34,11 @@ const (
 	OperatorIsNull
 	ContainsAny
 	ContainsAll
+	OperatorWithinCIDR
+	OperatorWithinPolygon
+	OperatorIntersects
+	OperatorFuzzyMatch
+	OperatorNot
 )
 
 func (o Operator) OnValue() bool {
@@ -48,7 +53,11 @@ func (o Operator) OnValue() bool {
 		OperatorLike,
 		OperatorIsNull,
 		ContainsAny,
-		ContainsAll:
+		ContainsAll,
+		OperatorWithinCIDR,
+		OperatorWithinPolygon,
+		OperatorIntersects,
+		OperatorFuzzyMatch:
 		return true
 	default:
 		return false
@@ -83,6 +92,16 @@ func (o Operator) Name() string {
 		return "ContainsAny"
 	case ContainsAll:
 		return "ContainsAll"
+	case OperatorWithinCIDR:
+		return "WithinCIDR"
+	case OperatorWithinPolygon:
+		return "WithinPolygon"
+	case OperatorIntersects:
+		return "Intersects"
+	case OperatorFuzzyMatch:
+		return "FuzzyMatch"
+	case OperatorNot:
+		return "Not"
 	default:
 		panic("Unknown operator")
 	}
@@ -126,14 +145,26 @@ func (v *Value) UnmarshalJSON(data []byte) error {
 		v.Value = temp.Value
 	}
 
+	if v.Type == schema.DataTypeGeoPolygon {
+		temp := struct {
+			Value GeoPolygon `json:"value"`
+		}{}
+
+		if err := json.Unmarshal(data, &temp); err != nil {
+			return err
+		}
+		v.Value = temp.Value
+	}
+
 	return nil
 }
 
 type Clause struct {
-	Operator Operator `json:"operator"`
-	On       *Path    `json:"on"`
-	Value    *Value   `json:"value"`
-	Operands []Clause `json:"operands"`
+	Operator       Operator        `json:"operator"`
+	On             *Path           `json:"on"`
+	Value          *Value          `json:"value"`
+	Operands       []Clause        `json:"operands"`
+	ReferenceCount *ReferenceCount `json:"referenceCount,omitempty"`
 }
 
 // GeoRange to be used with fields of type GeoCoordinates. Identifies a point
@@ -142,3 +173,9 @@ type GeoRange struct {
 	*models.GeoCoordinates
 	Distance float32 `json:"distance"`
 }
+
+// GeoPolygon to be used with the WithinPolygon and Intersects operators on
+// fields of type GeoCoordinates, GeoPolygon and GeoShape.
+type GeoPolygon struct {
+	*models.GeoPolygon
+}
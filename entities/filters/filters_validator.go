@@ -42,6 +42,11 @@ func ValidateFilters(sch schema.Schema, filters *LocalFilter) error {
 func validateClause(sch schema.Schema, cw *clauseWrapper) error {
 	// check if nested
 	if cw.getOperands() != nil {
+		if cw.getOperator() == OperatorNot && len(cw.getOperands()) != 1 {
+			return errors.Errorf("operator Not requires exactly one operand, got %d",
+				len(cw.getOperands()))
+		}
+
 		var errs []error
 
 		for i, child := range cw.getOperands() {
@@ -58,10 +63,22 @@ func validateClause(sch schema.Schema, cw *clauseWrapper) error {
 
 	// validate current
 
+	if cw.clause.ReferenceCount != nil {
+		if err := validateReferenceCount(cw); err != nil {
+			return err
+		}
+	}
+
 	className := cw.getClassName()
 	propName := cw.getPropertyName()
 
 	if IsInternalProperty(propName) {
+		if cw.getOperator() == OperatorWithinCIDR {
+			return errWithinCIDROnNonIP(propName)
+		}
+		if cw.getOperator() == OperatorFuzzyMatch {
+			return errFuzzyMatchOnNonText(propName)
+		}
 		return validateInternalPropertyClause(propName, cw)
 	}
 
@@ -109,10 +126,49 @@ func validateClause(sch schema.Schema, cw *clauseWrapper) error {
 		return nil
 	}
 
+	if cw.getOperator() == OperatorFuzzyMatch {
+		return validateFuzzyMatch(propName, schema.DataType(prop.DataType[0]), cw)
+	}
+
 	if isUUIDType(prop.DataType[0]) {
 		return validateUUIDType(propName, cw)
 	}
 
+	if schema.DataType(prop.DataType[0]) == schema.DataTypeDuration {
+		return validateDurationType(propName, cw)
+	}
+
+	if schema.DataType(prop.DataType[0]) == schema.DataTypeDecimal {
+		return validateDecimalType(propName, cw)
+	}
+
+	if schema.DataType(prop.DataType[0]) == schema.DataTypeIP {
+		return validateIPType(propName, cw)
+	}
+
+	if schema.DataType(prop.DataType[0]) == schema.DataTypePhoneNumber {
+		return validatePhoneNumberType(propName, cw)
+	}
+
+	if cw.getOperator() == OperatorWithinCIDR {
+		return errWithinCIDROnNonIP(propName)
+	}
+
+	if op := cw.getOperator(); op == OperatorWithinPolygon || op == OperatorIntersects {
+		return validateGeoShapeOperator(propName, schema.DataType(prop.DataType[0]), cw)
+	}
+
+	if schema.IsGeoShapeDataType(schema.DataType(prop.DataType[0])) {
+		return fmt.Errorf("operator %q cannot be used on %s props, use %q or %q instead",
+			cw.getOperator().Name(), prop.DataType[0],
+			OperatorWithinPolygon.Name(), OperatorIntersects.Name())
+	}
+
+	if schema.IsVectorDataType(prop.DataType) || schema.IsSparseVectorDataType(prop.DataType) {
+		return errors.Errorf("property %q is of type %q, which is not filterable",
+			propName, prop.DataType[0])
+	}
+
 	if schema.IsRefDataType(prop.DataType) {
 		// bit of an edge case, directly on refs (i.e. not on a primitive prop of a
 		// ref) we only allow valueInt which is what's used to count references
@@ -212,6 +268,150 @@ func validateUUIDOperators(propName schema.PropertyName, cw *clauseWrapper) erro
 	}
 }
 
+func validateDurationType(propName schema.PropertyName, cw *clauseWrapper) error {
+	if !cw.isType(schema.DataTypeText) {
+		return fmt.Errorf("property %q is of type \"duration\": "+
+			"specify duration as string using \"valueText\"", propName)
+	}
+
+	switch op := cw.getOperator(); op {
+	case OperatorEqual, OperatorNotEqual, OperatorLessThan, OperatorLessThanEqual,
+		OperatorGreaterThan, OperatorGreaterThanEqual:
+		// ok
+	default:
+		return fmt.Errorf("operator %q cannot be used on duration props", op.Name())
+	}
+
+	value, ok := cw.getValue().(string)
+	if !ok {
+		return fmt.Errorf("property %q is of type \"duration\": expected string value, got %T",
+			propName, cw.getValue())
+	}
+	if _, err := schema.ParseDuration(value); err != nil {
+		return fmt.Errorf("property %q: %w", propName, err)
+	}
+	return nil
+}
+
+func validateDecimalType(propName schema.PropertyName, cw *clauseWrapper) error {
+	if !cw.isType(schema.DataTypeText) {
+		return fmt.Errorf("property %q is of type \"decimal\": "+
+			"specify decimal as string using \"valueText\"", propName)
+	}
+
+	switch op := cw.getOperator(); op {
+	case OperatorEqual, OperatorNotEqual, OperatorLessThan, OperatorLessThanEqual,
+		OperatorGreaterThan, OperatorGreaterThanEqual:
+		// ok
+	default:
+		return fmt.Errorf("operator %q cannot be used on decimal props", op.Name())
+	}
+
+	value, ok := cw.getValue().(string)
+	if !ok {
+		return fmt.Errorf("property %q is of type \"decimal\": expected string value, got %T",
+			propName, cw.getValue())
+	}
+	if _, err := schema.ParseDecimal(value); err != nil {
+		return fmt.Errorf("property %q: %w", propName, err)
+	}
+	return nil
+}
+
+func validateIPType(propName schema.PropertyName, cw *clauseWrapper) error {
+	if !cw.isType(schema.DataTypeText) {
+		return fmt.Errorf("property %q is of type \"ip\": "+
+			"specify address or CIDR block as string using \"valueText\"", propName)
+	}
+	value, ok := cw.getValue().(string)
+	if !ok {
+		return fmt.Errorf("property %q is of type \"ip\": expected string value, got %T",
+			propName, cw.getValue())
+	}
+
+	switch op := cw.getOperator(); op {
+	case OperatorWithinCIDR:
+		if _, _, err := schema.CIDRRange(value); err != nil {
+			return fmt.Errorf("property %q: %w", propName, err)
+		}
+	case OperatorEqual, OperatorNotEqual, OperatorLessThan, OperatorLessThanEqual,
+		OperatorGreaterThan, OperatorGreaterThanEqual:
+		if _, err := schema.ParseIP(value); err != nil {
+			return fmt.Errorf("property %q: %w", propName, err)
+		}
+	default:
+		return fmt.Errorf("operator %q cannot be used on ip props", op.Name())
+	}
+	return nil
+}
+
+func validatePhoneNumberType(propName schema.PropertyName, cw *clauseWrapper) error {
+	if !cw.isType(schema.DataTypeText) {
+		return fmt.Errorf("property %q is of type \"phoneNumber\": "+
+			"specify the international or national format as string using \"valueText\"", propName)
+	}
+
+	// a phone number is indexed by several representations, so NotEqual would
+	// still match it by the representations which differ from the value
+	switch op := cw.getOperator(); op {
+	case OperatorEqual, OperatorLike:
+		return nil
+	default:
+		return fmt.Errorf("operator %q cannot be used on phoneNumber props", op.Name())
+	}
+}
+
+func validateFuzzyMatch(propName schema.PropertyName, dataType schema.DataType,
+	cw *clauseWrapper,
+) error {
+	if dataType != schema.DataTypeText && dataType != schema.DataTypeTextArray {
+		return errFuzzyMatchOnNonText(propName)
+	}
+	if !cw.isType(schema.DataTypeText) {
+		return fmt.Errorf("operator %q requires a term as \"valueText\", got %q instead",
+			OperatorFuzzyMatch.Name(), cw.getValueNameFromType())
+	}
+	value, ok := cw.getValue().(string)
+	if !ok {
+		return fmt.Errorf("property %q: expected string value, got %T",
+			propName, cw.getValue())
+	}
+	if _, _, err := ParseFuzzyValue(value); err != nil {
+		return fmt.Errorf("property %q: %w", propName, err)
+	}
+	return nil
+}
+
+func errFuzzyMatchOnNonText(propName schema.PropertyName) error {
+	return fmt.Errorf("operator %q can only be used on text props, but %q is not of type \"text\"",
+		OperatorFuzzyMatch.Name(), propName)
+}
+
+func errWithinCIDROnNonIP(propName schema.PropertyName) error {
+	return fmt.Errorf("operator %q can only be used on ip props, but %q is not of type \"ip\"",
+		OperatorWithinCIDR.Name(), propName)
+}
+
+func validateGeoShapeOperator(propName schema.PropertyName, dt schema.DataType, cw *clauseWrapper) error {
+	op := cw.getOperator()
+	if dt != schema.DataTypeGeoCoordinates && !schema.IsGeoShapeDataType(dt) {
+		return fmt.Errorf("operator %q can only be used on geoCoordinates, geoPolygon "+
+			"and geoShape props, but %q is of type %q", op.Name(), propName, dt)
+	}
+	if !cw.isType(schema.DataTypeGeoPolygon) {
+		return fmt.Errorf("operator %q requires a polygon, specify it using %q, got %q instead",
+			op.Name(), valueNameFromDataType(schema.DataTypeGeoPolygon), cw.getValueNameFromType())
+	}
+	polygon, ok := cw.getValue().(GeoPolygon)
+	if !ok {
+		return fmt.Errorf("property %q: expected geo polygon value, got %T", propName, cw.getValue())
+	}
+	if _, err := schema.GeoPolygonShape(polygon.GeoPolygon); err != nil {
+		return fmt.Errorf("property %q: invalid polygon: %w", propName, err)
+	}
+	return nil
+}
+
 type clauseWrapper struct {
 	clause    *Clause
 	origType  schema.DataType
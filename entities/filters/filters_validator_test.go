@@ -72,6 +72,61 @@ func TestValidateIsNullOperator(t *testing.T) {
 	}
 }
 
+func TestValidateNotOperator(t *testing.T) {
+	sch := schema.Schema{Objects: &models.Schema{
+		Classes: []*models.Class{
+			{
+				Class: "Car",
+				Properties: []*models.Property{
+					{Name: "horsepower", DataType: []string{"int"}},
+				},
+			},
+		},
+	}}
+	operand := Clause{
+		Operator: OperatorEqual,
+		Value:    &Value{Value: 100, Type: schema.DataTypeInt},
+		On:       &Path{Class: "Car", Property: "horsepower"},
+	}
+
+	tests := []struct {
+		name     string
+		operands []Clause
+		valid    bool
+	}{
+		{
+			name:     "single operand",
+			operands: []Clause{operand},
+			valid:    true,
+		},
+		{
+			name:     "two operands",
+			operands: []Clause{operand, operand},
+			valid:    false,
+		},
+		{
+			name:     "no operands",
+			operands: []Clause{},
+			valid:    false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cl := Clause{
+				Operator: OperatorNot,
+				Operands: tt.operands,
+			}
+			err := validateClause(sch, newClauseWrapper(&cl))
+			if tt.valid {
+				require.Nil(t, err)
+			} else {
+				require.NotNil(t, err)
+			}
+		})
+	}
+}
+
 func TestValidatePropertyLength(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -213,6 +268,596 @@ func TestValidateUUIDFilter(t *testing.T) {
 	}
 }
 
+func TestValidateDurationFilter(t *testing.T) {
+	tests := []struct {
+		name       string
+		schemaType schema.DataType
+		valid      bool
+		operator   Operator
+		value      interface{}
+	}{
+		{
+			name:       "Valid datatype and operator",
+			schemaType: schema.DataTypeText,
+			valid:      true,
+			operator:   OperatorGreaterThanEqual,
+			value:      "PT1H30M",
+		},
+		{
+			name:       "Wrong data type (int)",
+			schemaType: schema.DataTypeInt,
+			valid:      false,
+			operator:   OperatorEqual,
+			value:      60,
+		},
+		{
+			name:       "Wrong operator (Like)",
+			schemaType: schema.DataTypeText,
+			valid:      false,
+			operator:   OperatorLike,
+			value:      "1h",
+		},
+		{
+			name:       "Invalid duration",
+			schemaType: schema.DataTypeText,
+			valid:      false,
+			operator:   OperatorLessThan,
+			value:      "P1M",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sch := schema.Schema{Objects: &models.Schema{
+				Classes: []*models.Class{
+					{
+						Class: "Flight",
+						Properties: []*models.Property{
+							{Name: "length", DataType: schema.DataTypeDuration.PropString()},
+						},
+					},
+				},
+			}}
+			cl := Clause{
+				Operator: tt.operator,
+				Value:    &Value{Value: tt.value, Type: tt.schemaType},
+				On:       &Path{Class: "Flight", Property: "length"},
+			}
+			err := validateClause(sch, newClauseWrapper(&cl))
+			if tt.valid {
+				require.Nil(t, err)
+			} else {
+				require.NotNil(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateDecimalFilter(t *testing.T) {
+	tests := []struct {
+		name       string
+		schemaType schema.DataType
+		valid      bool
+		operator   Operator
+		value      interface{}
+	}{
+		{
+			name:       "Valid datatype and operator",
+			schemaType: schema.DataTypeText,
+			valid:      true,
+			operator:   OperatorLessThan,
+			value:      "1234.5678",
+		},
+		{
+			name:       "Wrong data type (number)",
+			schemaType: schema.DataTypeNumber,
+			valid:      false,
+			operator:   OperatorEqual,
+			value:      1234.5678,
+		},
+		{
+			name:       "Wrong operator (Like)",
+			schemaType: schema.DataTypeText,
+			valid:      false,
+			operator:   OperatorLike,
+			value:      "12*",
+		},
+		{
+			name:       "Invalid decimal",
+			schemaType: schema.DataTypeText,
+			valid:      false,
+			operator:   OperatorEqual,
+			value:      "12,5",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sch := schema.Schema{Objects: &models.Schema{
+				Classes: []*models.Class{
+					{
+						Class: "Invoice",
+						Properties: []*models.Property{
+							{Name: "amount", DataType: schema.DataTypeDecimal.PropString()},
+						},
+					},
+				},
+			}}
+			cl := Clause{
+				Operator: tt.operator,
+				Value:    &Value{Value: tt.value, Type: tt.schemaType},
+				On:       &Path{Class: "Invoice", Property: "amount"},
+			}
+			err := validateClause(sch, newClauseWrapper(&cl))
+			if tt.valid {
+				require.Nil(t, err)
+			} else {
+				require.NotNil(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateIPFilter(t *testing.T) {
+	tests := []struct {
+		name       string
+		prop       schema.PropertyName
+		schemaType schema.DataType
+		valid      bool
+		operator   Operator
+		value      interface{}
+	}{
+		{
+			name:       "Valid address",
+			prop:       "source",
+			schemaType: schema.DataTypeText,
+			valid:      true,
+			operator:   OperatorEqual,
+			value:      "10.0.0.1",
+		},
+		{
+			name:       "Valid CIDR block",
+			prop:       "source",
+			schemaType: schema.DataTypeText,
+			valid:      true,
+			operator:   OperatorWithinCIDR,
+			value:      "2001:db8::/32",
+		},
+		{
+			name:       "Address instead of CIDR block",
+			prop:       "source",
+			schemaType: schema.DataTypeText,
+			valid:      false,
+			operator:   OperatorWithinCIDR,
+			value:      "10.0.0.1",
+		},
+		{
+			name:       "CIDR block instead of address",
+			prop:       "source",
+			schemaType: schema.DataTypeText,
+			valid:      false,
+			operator:   OperatorGreaterThan,
+			value:      "10.0.0.0/8",
+		},
+		{
+			name:       "Wrong data type (int)",
+			prop:       "source",
+			schemaType: schema.DataTypeInt,
+			valid:      false,
+			operator:   OperatorEqual,
+			value:      10,
+		},
+		{
+			name:       "Wrong operator (Like)",
+			prop:       "source",
+			schemaType: schema.DataTypeText,
+			valid:      false,
+			operator:   OperatorLike,
+			value:      "10.0.0.*",
+		},
+		{
+			name:       "WithinCIDR on a text prop",
+			prop:       "host",
+			schemaType: schema.DataTypeText,
+			valid:      false,
+			operator:   OperatorWithinCIDR,
+			value:      "10.0.0.0/8",
+		},
+		{
+			name:       "WithinCIDR on an internal prop",
+			prop:       InternalPropID,
+			schemaType: schema.DataTypeText,
+			valid:      false,
+			operator:   OperatorWithinCIDR,
+			value:      "10.0.0.0/8",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sch := schema.Schema{Objects: &models.Schema{
+				Classes: []*models.Class{
+					{
+						Class: "Connection",
+						Properties: []*models.Property{
+							{Name: "source", DataType: schema.DataTypeIP.PropString()},
+							{Name: "host", DataType: schema.DataTypeText.PropString()},
+						},
+					},
+				},
+			}}
+			cl := Clause{
+				Operator: tt.operator,
+				Value:    &Value{Value: tt.value, Type: tt.schemaType},
+				On:       &Path{Class: "Connection", Property: tt.prop},
+			}
+			err := validateClause(sch, newClauseWrapper(&cl))
+			if tt.valid {
+				require.Nil(t, err)
+			} else {
+				require.NotNil(t, err)
+			}
+		})
+	}
+}
+
+func TestValidatePhoneNumberFilter(t *testing.T) {
+	tests := []struct {
+		name       string
+		schemaType schema.DataType
+		valid      bool
+		operator   Operator
+		value      interface{}
+	}{
+		{
+			name:       "Valid international format",
+			schemaType: schema.DataTypeText,
+			valid:      true,
+			operator:   OperatorEqual,
+			value:      "+49 171 1234567",
+		},
+		{
+			name:       "Valid national format",
+			schemaType: schema.DataTypeText,
+			valid:      true,
+			operator:   OperatorEqual,
+			value:      "0171 1234567",
+		},
+		{
+			name:       "Valid wildcard",
+			schemaType: schema.DataTypeText,
+			valid:      true,
+			operator:   OperatorLike,
+			value:      "+49 171*",
+		},
+		{
+			name:       "Wrong data type (int)",
+			schemaType: schema.DataTypeInt,
+			valid:      false,
+			operator:   OperatorEqual,
+			value:      491711234567,
+		},
+		{
+			name:       "Wrong operator (NotEqual)",
+			schemaType: schema.DataTypeText,
+			valid:      false,
+			operator:   OperatorNotEqual,
+			value:      "0171 1234567",
+		},
+		{
+			name:       "Wrong operator (GreaterThan)",
+			schemaType: schema.DataTypeText,
+			valid:      false,
+			operator:   OperatorGreaterThan,
+			value:      "+49 171 1234567",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sch := schema.Schema{Objects: &models.Schema{
+				Classes: []*models.Class{
+					{
+						Class: "Contact",
+						Properties: []*models.Property{
+							{Name: "phone", DataType: schema.DataTypePhoneNumber.PropString()},
+						},
+					},
+				},
+			}}
+			cl := Clause{
+				Operator: tt.operator,
+				Value:    &Value{Value: tt.value, Type: tt.schemaType},
+				On:       &Path{Class: "Contact", Property: "phone"},
+			}
+			err := validateClause(sch, newClauseWrapper(&cl))
+			if tt.valid {
+				require.Nil(t, err)
+			} else {
+				require.NotNil(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateGeoShapeFilter(t *testing.T) {
+	ptr := func(f float32) *float32 { return &f }
+	square := GeoPolygon{&models.GeoPolygon{Coordinates: []*models.GeoCoordinates{
+		{Latitude: ptr(10), Longitude: ptr(10)},
+		{Latitude: ptr(10), Longitude: ptr(20)},
+		{Latitude: ptr(20), Longitude: ptr(20)},
+		{Latitude: ptr(20), Longitude: ptr(10)},
+	}}}
+	line := GeoPolygon{&models.GeoPolygon{Coordinates: square.Coordinates[:2]}}
+
+	tests := []struct {
+		name       string
+		prop       schema.PropertyName
+		schemaType schema.DataType
+		valid      bool
+		operator   Operator
+		value      interface{}
+	}{
+		{
+			name:       "WithinPolygon on a geoPolygon prop",
+			prop:       "area",
+			schemaType: schema.DataTypeGeoPolygon,
+			valid:      true,
+			operator:   OperatorWithinPolygon,
+			value:      square,
+		},
+		{
+			name:       "Intersects on a geoShape prop",
+			prop:       "route",
+			schemaType: schema.DataTypeGeoPolygon,
+			valid:      true,
+			operator:   OperatorIntersects,
+			value:      square,
+		},
+		{
+			name:       "WithinPolygon on a geoCoordinates prop",
+			prop:       "location",
+			schemaType: schema.DataTypeGeoPolygon,
+			valid:      true,
+			operator:   OperatorWithinPolygon,
+			value:      square,
+		},
+		{
+			name:       "Polygon with too few coordinates",
+			prop:       "area",
+			schemaType: schema.DataTypeGeoPolygon,
+			valid:      false,
+			operator:   OperatorIntersects,
+			value:      line,
+		},
+		{
+			name:       "Wrong data type (text)",
+			prop:       "area",
+			schemaType: schema.DataTypeText,
+			valid:      false,
+			operator:   OperatorWithinPolygon,
+			value:      "polygon",
+		},
+		{
+			name:       "Wrong operator (Equal)",
+			prop:       "area",
+			schemaType: schema.DataTypeGeoPolygon,
+			valid:      false,
+			operator:   OperatorEqual,
+			value:      square,
+		},
+		{
+			name:       "WithinPolygon on a text prop",
+			prop:       "name",
+			schemaType: schema.DataTypeGeoPolygon,
+			valid:      false,
+			operator:   OperatorWithinPolygon,
+			value:      square,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sch := schema.Schema{Objects: &models.Schema{
+				Classes: []*models.Class{
+					{
+						Class: "Region",
+						Properties: []*models.Property{
+							{Name: "area", DataType: schema.DataTypeGeoPolygon.PropString()},
+							{Name: "route", DataType: schema.DataTypeGeoShape.PropString()},
+							{Name: "location", DataType: schema.DataTypeGeoCoordinates.PropString()},
+							{Name: "name", DataType: schema.DataTypeText.PropString()},
+						},
+					},
+				},
+			}}
+			cl := Clause{
+				Operator: tt.operator,
+				Value:    &Value{Value: tt.value, Type: tt.schemaType},
+				On:       &Path{Class: "Region", Property: tt.prop},
+			}
+			err := validateClause(sch, newClauseWrapper(&cl))
+			if tt.valid {
+				require.Nil(t, err)
+			} else {
+				require.NotNil(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateFuzzyMatchFilter(t *testing.T) {
+	tests := []struct {
+		name       string
+		prop       schema.PropertyName
+		schemaType schema.DataType
+		valid      bool
+		value      interface{}
+	}{
+		{
+			name:       "Valid term",
+			prop:       "title",
+			schemaType: schema.DataTypeText,
+			valid:      true,
+			value:      "weaviate",
+		},
+		{
+			name:       "Valid term with edit distance",
+			prop:       "tags",
+			schemaType: schema.DataTypeText,
+			valid:      true,
+			value:      "weaviate~1",
+		},
+		{
+			name:       "Edit distance too large",
+			prop:       "title",
+			schemaType: schema.DataTypeText,
+			valid:      false,
+			value:      "weaviate~3",
+		},
+		{
+			name:       "Wrong value type (int)",
+			prop:       "title",
+			schemaType: schema.DataTypeInt,
+			valid:      false,
+			value:      10,
+		},
+		{
+			name:       "Non-text prop",
+			prop:       "count",
+			schemaType: schema.DataTypeInt,
+			valid:      false,
+			value:      10,
+		},
+		{
+			name:       "Internal prop",
+			prop:       InternalPropID,
+			schemaType: schema.DataTypeText,
+			valid:      false,
+			value:      "weaviate",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sch := schema.Schema{Objects: &models.Schema{
+				Classes: []*models.Class{
+					{
+						Class: "Article",
+						Properties: []*models.Property{
+							{Name: "title", DataType: schema.DataTypeText.PropString()},
+							{Name: "tags", DataType: schema.DataTypeTextArray.PropString()},
+							{Name: "count", DataType: schema.DataTypeInt.PropString()},
+						},
+					},
+				},
+			}}
+			cl := Clause{
+				Operator: OperatorFuzzyMatch,
+				Value:    &Value{Value: tt.value, Type: tt.schemaType},
+				On:       &Path{Class: "Article", Property: tt.prop},
+			}
+			err := validateClause(sch, newClauseWrapper(&cl))
+			if tt.valid {
+				require.Nil(t, err)
+			} else {
+				require.NotNil(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateReferenceCountFilter(t *testing.T) {
+	sch := schema.Schema{Objects: &models.Schema{
+		Classes: []*models.Class{
+			{
+				Class: "Book",
+				Properties: []*models.Property{
+					{Name: "title", DataType: schema.DataTypeText.PropString(), Tokenization: models.PropertyTokenizationWhitespace},
+					{Name: "authors", DataType: []string{"Author"}},
+				},
+			},
+			{
+				Class: "Author",
+				Properties: []*models.Property{
+					{Name: "name", DataType: schema.DataTypeText.PropString(), Tokenization: models.PropertyTokenizationWhitespace},
+				},
+			},
+		},
+	}}
+	nestedPath := &Path{
+		Class:    "Book",
+		Property: "authors",
+		Child:    &Path{Class: "Author", Property: "name"},
+	}
+
+	tests := []struct {
+		name           string
+		on             *Path
+		referenceCount *ReferenceCount
+		valid          bool
+	}{
+		{
+			name:           "exactly n references",
+			on:             nestedPath,
+			referenceCount: &ReferenceCount{Operator: OperatorEqual, Count: 2},
+			valid:          true,
+		},
+		{
+			name:           "no references",
+			on:             nestedPath,
+			referenceCount: &ReferenceCount{Operator: OperatorLessThanEqual, Count: 0},
+			valid:          true,
+		},
+		{
+			name:           "negative count",
+			on:             nestedPath,
+			referenceCount: &ReferenceCount{Operator: OperatorGreaterThan, Count: -1},
+			valid:          false,
+		},
+		{
+			name:           "unsupported operator",
+			on:             nestedPath,
+			referenceCount: &ReferenceCount{Operator: OperatorLike, Count: 1},
+			valid:          false,
+		},
+		{
+			name:           "path without reference",
+			on:             &Path{Class: "Book", Property: "title"},
+			referenceCount: &ReferenceCount{Operator: OperatorEqual, Count: 1},
+			valid:          false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cl := Clause{
+				Operator:       OperatorEqual,
+				Value:          &Value{Value: "Smith", Type: schema.DataTypeText},
+				On:             tt.on,
+				ReferenceCount: tt.referenceCount,
+			}
+			err := validateClause(sch, newClauseWrapper(&cl))
+			if tt.valid {
+				require.Nil(t, err)
+			} else {
+				require.NotNil(t, err)
+			}
+		})
+	}
+}
+
+func TestReferenceCountMatches(t *testing.T) {
+	rc := &ReferenceCount{Operator: OperatorGreaterThan, Count: 1}
+	assert.False(t, rc.Matches(0))
+	assert.False(t, rc.Matches(1))
+	assert.True(t, rc.Matches(2))
+
+	rc = &ReferenceCount{Operator: OperatorLessThan, Count: 2}
+	assert.True(t, rc.Matches(0))
+	assert.False(t, rc.Matches(2))
+}
+
 func TestClauseWrapper(t *testing.T) {
 	type testCase struct {
 		name         string
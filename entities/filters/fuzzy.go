@@ -0,0 +1,72 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package filters
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// MaxFuzzyDistance is the largest edit distance a FuzzyMatch filter or a
+// fuzzy bm25 query can allow, larger distances match most of the terms of a
+// dictionary anyway
+const MaxFuzzyDistance = 2
+
+// FuzzyDistanceAuto lets the edit distance depend on the length of the
+// term, see FuzzyDistance
+const FuzzyDistanceAuto = -1
+
+// ParseFuzzyValue splits the value of a FuzzyMatch filter into the term and
+// the maximum edit distance, which is given as a "~N" suffix, e.g.
+// "weaviate~1". Without a suffix FuzzyDistanceAuto is returned.
+func ParseFuzzyValue(value string) (string, int, error) {
+	pos := strings.LastIndexByte(value, '~')
+	if pos < 0 {
+		return value, FuzzyDistanceAuto, nil
+	}
+
+	term, suffix := value[:pos], value[pos+1:]
+	if suffix == "" {
+		return term, FuzzyDistanceAuto, nil
+	}
+
+	distance, err := strconv.Atoi(suffix)
+	if err != nil {
+		// the tilde is part of the term
+		return value, FuzzyDistanceAuto, nil
+	}
+	if distance < 0 || distance > MaxFuzzyDistance {
+		return "", 0, fmt.Errorf("edit distance of %q must be between 0 and %d, got %d",
+			value, MaxFuzzyDistance, distance)
+	}
+	return term, distance, nil
+}
+
+// FuzzyDistance resolves FuzzyDistanceAuto for a term: short terms of up to
+// two characters have to match exactly, terms of up to five characters may
+// differ by one edit and longer terms by two
+func FuzzyDistance(term string, distance int) int {
+	if distance != FuzzyDistanceAuto {
+		return distance
+	}
+
+	switch length := utf8.RuneCountInString(term); {
+	case length <= 2:
+		return 0
+	case length <= 5:
+		return 1
+	default:
+		return 2
+	}
+}
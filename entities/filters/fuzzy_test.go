@@ -0,0 +1,55 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package filters
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFuzzyValue(t *testing.T) {
+	for _, tc := range []struct {
+		value            string
+		expectedTerm     string
+		expectedDistance int
+		expectedErr      bool
+	}{
+		{value: "weaviate", expectedTerm: "weaviate", expectedDistance: FuzzyDistanceAuto},
+		{value: "weaviate~1", expectedTerm: "weaviate", expectedDistance: 1},
+		{value: "weaviate~0", expectedTerm: "weaviate", expectedDistance: 0},
+		{value: "weaviate~", expectedTerm: "weaviate", expectedDistance: FuzzyDistanceAuto},
+		{value: "wea~viate", expectedTerm: "wea~viate", expectedDistance: FuzzyDistanceAuto},
+		{value: "weaviate~3", expectedErr: true},
+		{value: "weaviate~-1", expectedErr: true},
+	} {
+		t.Run(tc.value, func(t *testing.T) {
+			term, distance, err := ParseFuzzyValue(tc.value)
+			if tc.expectedErr {
+				require.NotNil(t, err)
+				return
+			}
+			require.Nil(t, err)
+			assert.Equal(t, tc.expectedTerm, term)
+			assert.Equal(t, tc.expectedDistance, distance)
+		})
+	}
+}
+
+func TestFuzzyDistance(t *testing.T) {
+	assert.Equal(t, 0, FuzzyDistance("ab", FuzzyDistanceAuto))
+	assert.Equal(t, 1, FuzzyDistance("abc", FuzzyDistanceAuto))
+	assert.Equal(t, 1, FuzzyDistance("äöüßé", FuzzyDistanceAuto))
+	assert.Equal(t, 2, FuzzyDistance("abcdef", FuzzyDistanceAuto))
+	assert.Equal(t, 2, FuzzyDistance("ab", 2))
+}
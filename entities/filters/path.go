@@ -124,6 +124,15 @@ func ParsePath(pathElements []interface{}, rootClass string) (*Path, error) {
 				return nil, fmt.Errorf("Expected a valid property name in 'path' field for the filter, but got '%s'", lengthPropName)
 			}
 			propertyName = schema.PropertyName(rawPropertyName)
+		} else if strings.Contains(rawPropertyName, ".") {
+			// a dotted path into the nested properties of an object property,
+			// e.g. "address.city"
+			for _, segment := range strings.Split(rawPropertyName, ".") {
+				if _, err := schema.ValidatePropertyName(segment); err != nil {
+					return nil, fmt.Errorf("Expected a valid property name in 'path' field for the filter, but got '%s'", rawPropertyName)
+				}
+			}
+			propertyName = schema.PropertyName(rawPropertyName)
 		} else {
 			propertyName, err = schema.ValidatePropertyName(rawPropertyName)
 			// Invalid property name?
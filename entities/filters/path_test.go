@@ -47,6 +47,25 @@ func Test_ParsePath(t *testing.T) {
 		assert.Equal(t, expectedPath, path, "should parse the path correctly")
 	})
 
+	t.Run("with a nested prop", func(t *testing.T) {
+		rootClass := "City"
+		segments := []interface{}{"address.street.name"}
+		expectedPath := &Path{
+			Class:    "City",
+			Property: "address.street.name",
+		}
+
+		path, err := ParsePath(segments, rootClass)
+
+		require.Nil(t, err, "should not error")
+		assert.Equal(t, expectedPath, path, "should parse the path correctly")
+	})
+
+	t.Run("with an invalid nested prop", func(t *testing.T) {
+		_, err := ParsePath([]interface{}{"address..name"}, "City")
+		assert.NotNil(t, err)
+	})
+
 	t.Run("with nested refs", func(t *testing.T) {
 		rootClass := "City"
 		segments := []interface{}{"inCountry", "Country", "inContinent", "Continent", "onPlanet", "Planet", "name"}
@@ -0,0 +1,68 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package filters
+
+import (
+	"fmt"
+)
+
+// ReferenceCount turns a filter on the props of referenced objects, which
+// matches the objects with at least one reference to a matching object, into
+// one which matches the objects by the number of such references. E.g. "has
+// exactly 2 authors named Smith" is a filter on Book -> authors -> Author ->
+// name with a ReferenceCount of Equal 2.
+type ReferenceCount struct {
+	Operator Operator `json:"operator"`
+	Count    int      `json:"count"`
+}
+
+// Matches returns whether the given number of matching references satisfies
+// the reference count
+func (rc *ReferenceCount) Matches(count int) bool {
+	switch rc.Operator {
+	case OperatorEqual:
+		return count == rc.Count
+	case OperatorNotEqual:
+		return count != rc.Count
+	case OperatorGreaterThan:
+		return count > rc.Count
+	case OperatorGreaterThanEqual:
+		return count >= rc.Count
+	case OperatorLessThan:
+		return count < rc.Count
+	case OperatorLessThanEqual:
+		return count <= rc.Count
+	default:
+		return false
+	}
+}
+
+func validateReferenceCount(cw *clauseWrapper) error {
+	rc := cw.clause.ReferenceCount
+	if cw.clause.On == nil || cw.clause.On.Child == nil {
+		return fmt.Errorf("referenceCount requires a path to a property of the " +
+			"referenced objects")
+	}
+
+	switch rc.Operator {
+	case OperatorEqual, OperatorNotEqual, OperatorGreaterThan, OperatorGreaterThanEqual,
+		OperatorLessThan, OperatorLessThanEqual:
+		// ok
+	default:
+		return fmt.Errorf("referenceCount supports operators (not) equal and "+
+			"greater/less than (equal), got %q instead", rc.Operator.Name())
+	}
+	if rc.Count < 0 {
+		return fmt.Errorf("referenceCount must not be negative, got %d", rc.Count)
+	}
+	return nil
+}
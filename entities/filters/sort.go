@@ -11,10 +11,31 @@
 
 package filters
 
-// Sort contains path and order (asc, desc) information
+// Sort contains path and order (asc, desc) information. Instead of a path,
+// an arithmetic expression on numeric properties can be set to sort by.
 type Sort struct {
-	Path  []string `json:"path"`
-	Order string   `json:"order"`
+	Path       []string `json:"path"`
+	Order      string   `json:"order"`
+	Expression string   `json:"expression,omitempty"`
+}
+
+// The additional properties of search results which can be sorted by, with
+// the path ["_additional", <prop>]
+const (
+	SortAdditionalDistance = "distance"
+	SortAdditionalScore    = "score"
+)
+
+// AdditionalProp returns the additional property the sort is by, or an empty
+// string if it is by a property or expression
+func (s Sort) AdditionalProp() string {
+	if len(s.Path) == 2 && s.Path[0] == "_additional" {
+		switch s.Path[1] {
+		case SortAdditionalDistance, SortAdditionalScore:
+			return s.Path[1]
+		}
+	}
+	return ""
 }
 
 // ExtractSortFromArgs gets the sort parameters
@@ -37,7 +58,11 @@ func ExtractSortFromArgs(in []interface{}) []Sort {
 			if ok {
 				order = orderParam.(string)
 			}
-			args = append(args, Sort{path, order})
+			var expression string
+			if expressionParam, ok := sortFilter["expression"].(string); ok {
+				expression = expressionParam
+			}
+			args = append(args, Sort{Path: path, Order: order, Expression: expression})
 		}
 	}
 
@@ -0,0 +1,219 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package filters
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"unicode"
+)
+
+// SortExpression is an arithmetic expression on numeric properties, such as
+// "price * (1 - discount)". It supports numbers, property names, the
+// operators +, -, * and / and parentheses.
+type SortExpression struct {
+	root       sortExpressionNode
+	properties []string
+}
+
+type sortExpressionNode interface {
+	evaluate(value func(prop string) (float64, bool)) (float64, bool)
+}
+
+// ParseSortExpression parses the expression of a sort parameter
+func ParseSortExpression(in string) (*SortExpression, error) {
+	p := &sortExpressionParser{input: []rune(in)}
+	root, err := p.parseSum()
+	if err != nil {
+		return nil, err
+	}
+	p.skipSpaces()
+	if p.pos < len(p.input) {
+		return nil, fmt.Errorf("invalid sort expression %q: unexpected %q at position %d",
+			in, p.input[p.pos], p.pos)
+	}
+
+	return &SortExpression{root: root, properties: p.properties}, nil
+}
+
+// Properties returns the names of all properties used in the expression
+func (e *SortExpression) Properties() []string {
+	return e.properties
+}
+
+// Evaluate computes the expression with the values of the properties. It
+// returns false if a property has no value or the result is not a finite
+// number, e.g. because of a division by zero.
+func (e *SortExpression) Evaluate(value func(prop string) (float64, bool)) (float64, bool) {
+	res, ok := e.root.evaluate(value)
+	if !ok || math.IsNaN(res) || math.IsInf(res, 0) {
+		return 0, false
+	}
+	return res, true
+}
+
+type sortExpressionNumber float64
+
+func (n sortExpressionNumber) evaluate(func(string) (float64, bool)) (float64, bool) {
+	return float64(n), true
+}
+
+type sortExpressionProperty string
+
+func (p sortExpressionProperty) evaluate(value func(string) (float64, bool)) (float64, bool) {
+	return value(string(p))
+}
+
+type sortExpressionNegation struct {
+	operand sortExpressionNode
+}
+
+func (n sortExpressionNegation) evaluate(value func(string) (float64, bool)) (float64, bool) {
+	res, ok := n.operand.evaluate(value)
+	return -res, ok
+}
+
+type sortExpressionOperation struct {
+	operator    rune
+	left, right sortExpressionNode
+}
+
+func (o sortExpressionOperation) evaluate(value func(string) (float64, bool)) (float64, bool) {
+	left, ok := o.left.evaluate(value)
+	if !ok {
+		return 0, false
+	}
+	right, ok := o.right.evaluate(value)
+	if !ok {
+		return 0, false
+	}
+
+	switch o.operator {
+	case '+':
+		return left + right, true
+	case '-':
+		return left - right, true
+	case '*':
+		return left * right, true
+	default:
+		if right == 0 {
+			return 0, false
+		}
+		return left / right, true
+	}
+}
+
+type sortExpressionParser struct {
+	input      []rune
+	pos        int
+	properties []string
+}
+
+func (p *sortExpressionParser) skipSpaces() {
+	for p.pos < len(p.input) && unicode.IsSpace(p.input[p.pos]) {
+		p.pos++
+	}
+}
+
+// peek returns the next rune which is not a space, or 0 at the end
+func (p *sortExpressionParser) peek() rune {
+	p.skipSpaces()
+	if p.pos >= len(p.input) {
+		return 0
+	}
+	return p.input[p.pos]
+}
+
+func (p *sortExpressionParser) parseSum() (sortExpressionNode, error) {
+	left, err := p.parseProduct()
+	if err != nil {
+		return nil, err
+	}
+
+	for operator := p.peek(); operator == '+' || operator == '-'; operator = p.peek() {
+		p.pos++
+		right, err := p.parseProduct()
+		if err != nil {
+			return nil, err
+		}
+		left = sortExpressionOperation{operator: operator, left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *sortExpressionParser) parseProduct() (sortExpressionNode, error) {
+	left, err := p.parseFactor()
+	if err != nil {
+		return nil, err
+	}
+
+	for operator := p.peek(); operator == '*' || operator == '/'; operator = p.peek() {
+		p.pos++
+		right, err := p.parseFactor()
+		if err != nil {
+			return nil, err
+		}
+		left = sortExpressionOperation{operator: operator, left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *sortExpressionParser) parseFactor() (sortExpressionNode, error) {
+	next := p.peek()
+	switch {
+	case next == 0:
+		return nil, fmt.Errorf("invalid sort expression %q: unexpected end", string(p.input))
+	case next == '-':
+		p.pos++
+		operand, err := p.parseFactor()
+		if err != nil {
+			return nil, err
+		}
+		return sortExpressionNegation{operand: operand}, nil
+	case next == '(':
+		p.pos++
+		inner, err := p.parseSum()
+		if err != nil {
+			return nil, err
+		}
+		if p.peek() != ')' {
+			return nil, fmt.Errorf("invalid sort expression %q: missing closing parenthesis",
+				string(p.input))
+		}
+		p.pos++
+		return inner, nil
+	case unicode.IsDigit(next) || next == '.':
+		start := p.pos
+		for p.pos < len(p.input) && (unicode.IsDigit(p.input[p.pos]) || p.input[p.pos] == '.') {
+			p.pos++
+		}
+		number, err := strconv.ParseFloat(string(p.input[start:p.pos]), 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid sort expression %q: invalid number %q",
+				string(p.input), string(p.input[start:p.pos]))
+		}
+		return sortExpressionNumber(number), nil
+	case unicode.IsLetter(next) || next == '_':
+		start := p.pos
+		for p.pos < len(p.input) && (unicode.IsLetter(p.input[p.pos]) ||
+			unicode.IsDigit(p.input[p.pos]) || p.input[p.pos] == '_') {
+			p.pos++
+		}
+		name := string(p.input[start:p.pos])
+		p.properties = append(p.properties, name)
+		return sortExpressionProperty(name), nil
+	default:
+		return nil, fmt.Errorf("invalid sort expression %q: unexpected %q at position %d",
+			string(p.input), next, p.pos)
+	}
+}
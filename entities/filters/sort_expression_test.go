@@ -0,0 +1,63 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package filters
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSortExpression(t *testing.T) {
+	values := map[string]float64{"price": 200, "discount": 0.25, "quantity": 3, "zero": 0}
+	value := func(prop string) (float64, bool) {
+		v, ok := values[prop]
+		return v, ok
+	}
+
+	tests := []struct {
+		expression string
+		properties []string
+		expected   float64
+		ok         bool
+	}{
+		{expression: "price", properties: []string{"price"}, expected: 200, ok: true},
+		{expression: "1.5", expected: 1.5, ok: true},
+		{expression: "price * (1 - discount)", properties: []string{"price", "discount"}, expected: 150, ok: true},
+		{expression: "price - quantity * 10", properties: []string{"price", "quantity"}, expected: 170, ok: true},
+		{expression: "price / quantity / 2", properties: []string{"price", "quantity"}, expected: 200.0 / 3 / 2, ok: true},
+		{expression: "-price + 10", properties: []string{"price"}, expected: -190, ok: true},
+		{expression: "  price*quantity ", properties: []string{"price", "quantity"}, expected: 600, ok: true},
+		{expression: "price / zero", properties: []string{"price", "zero"}, ok: false},
+		{expression: "price + missing", properties: []string{"price", "missing"}, ok: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.expression, func(t *testing.T) {
+			expression, err := ParseSortExpression(test.expression)
+			require.Nil(t, err)
+
+			assert.Equal(t, test.properties, expression.Properties())
+			res, ok := expression.Evaluate(value)
+			assert.Equal(t, test.ok, ok)
+			assert.InDelta(t, test.expected, res, 1e-9)
+		})
+	}
+
+	t.Run("invalid expressions", func(t *testing.T) {
+		for _, in := range []string{"", "price *", "(price + 1", "price + 1)", "price % 2", "1.2.3", "price quantity"} {
+			_, err := ParseSortExpression(in)
+			assert.NotNil(t, err, in)
+		}
+	})
+}
@@ -46,6 +46,17 @@ func validateSortClause(sch schema.Schema, className schema.ClassName, sort Sort
 			`possible values are: ["asc", "desc"] not: "%s"`, order)
 	}
 
+	if sort.Expression != "" {
+		if len(path) > 0 {
+			return errors.New("path and expression parameters can't be used together")
+		}
+		return validateSortExpression(sch, className, sort.Expression)
+	}
+
+	if sort.AdditionalProp() != "" {
+		return nil
+	}
+
 	switch len(path) {
 	case 0:
 		return errors.New("path parameter cannot be empty")
@@ -81,3 +92,25 @@ func validateSortClause(sch schema.Schema, className schema.ClassName, sort Sort
 			"path must have exactly one argument")
 	}
 }
+
+func validateSortExpression(sch schema.Schema, className schema.ClassName, in string) error {
+	expression, err := ParseSortExpression(in)
+	if err != nil {
+		return err
+	}
+
+	for _, propName := range expression.Properties() {
+		prop, err := sch.GetProperty(className, schema.PropertyName(propName))
+		if err != nil {
+			return err
+		}
+
+		switch schema.DataType(prop.DataType[0]) {
+		case schema.DataTypeNumber, schema.DataTypeInt:
+		default:
+			return errors.Errorf("sort expression %q: prop %q is of type %s, "+
+				"only number and int props can be used in expressions", in, propName, prop.DataType[0])
+		}
+	}
+	return nil
+}
@@ -83,3 +83,76 @@ func TestSortValidation(t *testing.T) {
 		})
 	}
 }
+
+func TestSortValidationOfExpressionsAndAdditionalProps(t *testing.T) {
+	sch := schema.Schema{Objects: &models.Schema{
+		Classes: []*models.Class{
+			{
+				Class: "Car",
+				Properties: []*models.Property{
+					{Name: "modelName", DataType: schema.DataTypeText.PropString(), Tokenization: models.PropertyTokenizationWhitespace},
+					{Name: "horsepower", DataType: []string{"int"}},
+					{Name: "weight", DataType: []string{"number"}},
+				},
+			},
+		},
+	}}
+
+	tests := []struct {
+		name  string
+		sort  Sort
+		valid bool
+	}{
+		{
+			name:  "distance",
+			sort:  Sort{Path: []string{"_additional", "distance"}},
+			valid: true,
+		},
+		{
+			name:  "score",
+			sort:  Sort{Path: []string{"_additional", "score"}, Order: "desc"},
+			valid: true,
+		},
+		{
+			name:  "other additional prop",
+			sort:  Sort{Path: []string{"_additional", "certainty"}},
+			valid: false,
+		},
+		{
+			name:  "expression of numeric props",
+			sort:  Sort{Expression: "weight / horsepower"},
+			valid: true,
+		},
+		{
+			name:  "expression with a text prop",
+			sort:  Sort{Expression: "weight / modelName"},
+			valid: false,
+		},
+		{
+			name:  "expression with a missing prop",
+			sort:  Sort{Expression: "weight / idontexist"},
+			valid: false,
+		},
+		{
+			name:  "invalid expression",
+			sort:  Sort{Expression: "weight /"},
+			valid: false,
+		},
+		{
+			name:  "expression and path",
+			sort:  Sort{Path: []string{"weight"}, Expression: "weight"},
+			valid: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateSort(sch, schema.ClassName("Car"), []Sort{tt.sort})
+			if tt.valid {
+				require.Nil(t, err)
+			} else {
+				require.NotNil(t, err)
+			}
+		})
+	}
+}
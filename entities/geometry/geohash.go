@@ -0,0 +1,134 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package geometry
+
+import (
+	"sort"
+	"strings"
+)
+
+const (
+	geohashAlphabet = "0123456789bcdefghjkmnpqrstuvwxyz"
+
+	// MaxCells is the number of geohash cells a shape is covered with, unless
+	// the cells on the coarsest level already exceed it
+	MaxCells = 16
+	// MaxPrecision is the length of the finest geohash cells used to cover a
+	// shape, which are about 38m by 19m in size
+	MaxPrecision = 8
+)
+
+// Geohash returns the geohash cell of the given precision containing p
+func Geohash(p Point, precision int) string {
+	latRange := [2]float64{-90, 90}
+	lonRange := [2]float64{-180, 180}
+
+	var sb strings.Builder
+	even := true
+	bit, ch := 0, 0
+	for sb.Len() < precision {
+		if even {
+			ch = ch<<1 | bisect(&lonRange, p.Lon)
+		} else {
+			ch = ch<<1 | bisect(&latRange, p.Lat)
+		}
+		even = !even
+
+		if bit++; bit == 5 {
+			sb.WriteByte(geohashAlphabet[ch])
+			bit, ch = 0, 0
+		}
+	}
+	return sb.String()
+}
+
+func bisect(r *[2]float64, value float64) int {
+	mid := (r[0] + r[1]) / 2
+	if value >= mid {
+		r[0] = mid
+		return 1
+	}
+	r[1] = mid
+	return 0
+}
+
+// GeohashBounds returns the area covered by a geohash cell
+func GeohashBounds(hash string) Rect {
+	r := Rect{MinLat: -90, MaxLat: 90, MinLon: -180, MaxLon: 180}
+	even := true
+	for i := 0; i < len(hash); i++ {
+		ch := strings.IndexByte(geohashAlphabet, hash[i])
+		for bit := 4; bit >= 0; bit-- {
+			set := ch>>bit&1 == 1
+			if even {
+				mid := (r.MinLon + r.MaxLon) / 2
+				if set {
+					r.MinLon = mid
+				} else {
+					r.MaxLon = mid
+				}
+			} else {
+				mid := (r.MinLat + r.MaxLat) / 2
+				if set {
+					r.MinLat = mid
+				} else {
+					r.MaxLat = mid
+				}
+			}
+			even = !even
+		}
+	}
+	return r
+}
+
+// Cover returns geohash cells which together contain the whole shape. The
+// cells are refined level by level as long as their number stays within
+// MaxCells. Cells which lie completely within the shape are not refined any
+// further. Two shapes can only intersect, if one of the cells of the first
+// one is a prefix of, or equal to, one of the cells of the second one.
+func Cover(s Shape) []string {
+	var cells, full []string
+	for i := range geohashAlphabet {
+		cell := geohashAlphabet[i : i+1]
+		if s.intersectsRect(GeohashBounds(cell)) {
+			cells = append(cells, cell)
+		}
+	}
+
+	for precision := 2; precision <= MaxPrecision; precision++ {
+		var next, nextFull []string
+		for _, cell := range cells {
+			if s.Kind == KindPolygon && s.containsRect(GeohashBounds(cell)) {
+				nextFull = append(nextFull, cell)
+				continue
+			}
+			for i := range geohashAlphabet {
+				child := cell + geohashAlphabet[i:i+1]
+				if s.intersectsRect(GeohashBounds(child)) {
+					next = append(next, child)
+				}
+			}
+		}
+
+		if len(full)+len(nextFull)+len(next) > MaxCells {
+			break
+		}
+		cells, full = next, append(full, nextFull...)
+		if len(cells) == 0 {
+			break
+		}
+	}
+
+	out := append(full, cells...)
+	sort.Strings(out)
+	return out
+}
@@ -0,0 +1,142 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package geometry
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// square spans from 10,10 to 20,20
+var square = NewPolygon([]Point{{10, 10}, {10, 20}, {20, 20}, {20, 10}})
+
+func TestShapeValidate(t *testing.T) {
+	valid := []Shape{
+		square,
+		{Kind: KindPoint, Points: []Point{{52.5, 13.4}}},
+		{Kind: KindLineString, Points: []Point{{0, 0}, {1, 1}}},
+	}
+	for _, s := range valid {
+		assert.Nil(t, s.Validate())
+	}
+
+	invalid := []Shape{
+		NewPolygon([]Point{{0, 0}, {1, 1}, {0, 0}}),
+		{Kind: KindPoint, Points: nil},
+		{Kind: KindLineString, Points: []Point{{0, 0}}},
+		{Kind: KindPoint, Points: []Point{{91, 0}}},
+		{Kind: KindPoint, Points: []Point{{0, -181}}},
+		{Kind: "circle", Points: []Point{{0, 0}}},
+	}
+	for _, s := range invalid {
+		assert.NotNil(t, s.Validate())
+	}
+}
+
+func TestShapeContains(t *testing.T) {
+	assert.True(t, square.Contains(Point{15, 15}))
+	assert.True(t, square.Contains(Point{10, 15}))
+	assert.False(t, square.Contains(Point{25, 15}))
+	assert.False(t, square.Contains(Point{15, 9.99}))
+}
+
+func TestShapeIntersects(t *testing.T) {
+	tests := []struct {
+		name     string
+		shape    Shape
+		expected bool
+	}{
+		{"point inside", Shape{Kind: KindPoint, Points: []Point{{15, 15}}}, true},
+		{"point outside", Shape{Kind: KindPoint, Points: []Point{{5, 5}}}, false},
+		{"line crossing", Shape{Kind: KindLineString, Points: []Point{{5, 15}, {25, 15}}}, true},
+		{"line outside", Shape{Kind: KindLineString, Points: []Point{{0, 0}, {5, 30}}}, false},
+		{"polygon overlapping", NewPolygon([]Point{{15, 15}, {15, 25}, {25, 25}, {25, 15}}), true},
+		{"polygon around", NewPolygon([]Point{{0, 0}, {0, 30}, {30, 30}, {30, 0}}), true},
+		{"polygon touching", NewPolygon([]Point{{20, 20}, {20, 30}, {30, 30}}), true},
+		{"polygon apart", NewPolygon([]Point{{30, 30}, {30, 40}, {40, 40}}), false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, test.shape.Intersects(square))
+			assert.Equal(t, test.expected, square.Intersects(test.shape))
+		})
+	}
+}
+
+func TestShapeWithin(t *testing.T) {
+	tests := []struct {
+		name     string
+		shape    Shape
+		expected bool
+	}{
+		{"point inside", Shape{Kind: KindPoint, Points: []Point{{15, 15}}}, true},
+		{"point outside", Shape{Kind: KindPoint, Points: []Point{{5, 5}}}, false},
+		{"line inside", Shape{Kind: KindLineString, Points: []Point{{11, 11}, {19, 12}}}, true},
+		{"line crossing", Shape{Kind: KindLineString, Points: []Point{{15, 15}, {25, 15}}}, false},
+		{"polygon inside", NewPolygon([]Point{{12, 12}, {12, 18}, {18, 18}}), true},
+		{"polygon equal", square, true},
+		{"polygon overlapping", NewPolygon([]Point{{15, 15}, {15, 25}, {25, 25}, {25, 15}}), false},
+		{"polygon around", NewPolygon([]Point{{0, 0}, {0, 30}, {30, 30}, {30, 0}}), false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, test.shape.Within(square))
+		})
+	}
+
+	t.Run("within a concave polygon", func(t *testing.T) {
+		// a U shape with its opening between lon 12 and 18
+		u := NewPolygon([]Point{{10, 10}, {20, 10}, {20, 12}, {12, 12}, {12, 18}, {20, 18}, {20, 20}, {10, 20}})
+		line := Shape{Kind: KindLineString, Points: []Point{{15, 11}, {15, 19}}}
+		assert.False(t, line.Within(u))
+		assert.True(t, Shape{Kind: KindPoint, Points: []Point{{11, 15}}}.Within(u))
+		assert.False(t, Shape{Kind: KindPoint, Points: []Point{{15, 15}}}.Within(u))
+	})
+}
+
+func TestGeohash(t *testing.T) {
+	p := Point{Lat: 57.64911, Lon: 10.40744}
+	assert.Equal(t, "u4pruydqqvj", Geohash(p, 11))
+	assert.Equal(t, "u4pru", Geohash(p, 5))
+
+	bounds := GeohashBounds("u4pruydqqvj")
+	assert.True(t, bounds.Contains(p))
+	assert.InDelta(t, 57.64911, bounds.MinLat, 0.0001)
+	assert.InDelta(t, 10.40744, bounds.MinLon, 0.0001)
+}
+
+func TestCover(t *testing.T) {
+	berlin := NewPolygon([]Point{{52.3, 13.0}, {52.3, 13.8}, {52.7, 13.8}, {52.7, 13.0}})
+	cells := Cover(berlin)
+	require.NotEmpty(t, cells)
+	assert.LessOrEqual(t, len(cells), MaxCells)
+
+	covers := func(p Point) bool {
+		hash := Geohash(p, MaxPrecision)
+		for _, cell := range cells {
+			if strings.HasPrefix(hash, cell) {
+				return true
+			}
+		}
+		return false
+	}
+	for _, p := range []Point{{52.5, 13.4}, {52.3, 13.0}, {52.7, 13.8}, {52.31, 13.79}} {
+		assert.True(t, covers(p), "point %v must be covered", p)
+	}
+	assert.False(t, covers(Point{48.1, 11.6}))
+
+	point := Cover(Shape{Kind: KindPoint, Points: []Point{{52.5, 13.4}}})
+	assert.Equal(t, []string{Geohash(Point{52.5, 13.4}, MaxPrecision)}, point)
+}
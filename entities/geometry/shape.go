@@ -0,0 +1,271 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package geometry provides the planar geometry and the geohash cells which
+// back the geoPolygon and geoShape data types. Coordinates are treated as
+// points on a plane of latitude and longitude, shapes crossing the
+// antimeridian are not supported.
+package geometry
+
+import (
+	"fmt"
+	"math"
+)
+
+const (
+	KindPoint      = "point"
+	KindLineString = "lineString"
+	KindPolygon    = "polygon"
+)
+
+// Point is a location given by its latitude and longitude in decimal degrees
+type Point struct {
+	Lat float64
+	Lon float64
+}
+
+// Rect is the area between two latitudes and two longitudes
+type Rect struct {
+	MinLat, MaxLat float64
+	MinLon, MaxLon float64
+}
+
+// Contains returns true if p lies within r or on its border
+func (r Rect) Contains(p Point) bool {
+	return p.Lat >= r.MinLat && p.Lat <= r.MaxLat &&
+		p.Lon >= r.MinLon && p.Lon <= r.MaxLon
+}
+
+func (r Rect) corners() []Point {
+	return []Point{
+		{r.MinLat, r.MinLon}, {r.MinLat, r.MaxLon},
+		{r.MaxLat, r.MaxLon}, {r.MaxLat, r.MinLon},
+	}
+}
+
+func (r Rect) intersects(o Rect) bool {
+	return r.MinLat <= o.MaxLat && o.MinLat <= r.MaxLat &&
+		r.MinLon <= o.MaxLon && o.MinLon <= r.MaxLon
+}
+
+// Shape is a point, a line string or a polygon. The ring of a polygon is
+// closed implicitly, so its last point does not need to repeat the first one.
+type Shape struct {
+	Kind   string
+	Points []Point
+}
+
+// NewPolygon creates a polygon with the given ring
+func NewPolygon(points []Point) Shape {
+	return Shape{Kind: KindPolygon, Points: points}
+}
+
+// Validate makes sure the shape has enough points for its kind and all
+// points are valid coordinates
+func (s Shape) Validate() error {
+	for i, p := range s.Points {
+		if math.IsNaN(p.Lat) || p.Lat < -90 || p.Lat > 90 {
+			return fmt.Errorf("point %d: latitude must be between -90 and 90, got %v", i, p.Lat)
+		}
+		if math.IsNaN(p.Lon) || p.Lon < -180 || p.Lon > 180 {
+			return fmt.Errorf("point %d: longitude must be between -180 and 180, got %v", i, p.Lon)
+		}
+	}
+
+	switch s.Kind {
+	case KindPoint:
+		if len(s.Points) != 1 {
+			return fmt.Errorf("a point requires exactly 1 coordinate, got %d", len(s.Points))
+		}
+	case KindLineString:
+		if len(s.Points) < 2 {
+			return fmt.Errorf("a line string requires at least 2 coordinates, got %d", len(s.Points))
+		}
+	case KindPolygon:
+		if len(s.ring()) < 3 {
+			return fmt.Errorf("a polygon requires at least 3 distinct coordinates, got %d", len(s.ring()))
+		}
+	default:
+		return fmt.Errorf("unknown shape type %q, must be %q, %q or %q",
+			s.Kind, KindPoint, KindLineString, KindPolygon)
+	}
+	return nil
+}
+
+// ring returns the points of a polygon without an explicit closing point
+func (s Shape) ring() []Point {
+	if n := len(s.Points); n > 1 && s.Points[0] == s.Points[n-1] {
+		return s.Points[:n-1]
+	}
+	return s.Points
+}
+
+// Bounds returns the smallest rect containing the shape
+func (s Shape) Bounds() Rect {
+	r := Rect{MinLat: math.Inf(1), MaxLat: math.Inf(-1), MinLon: math.Inf(1), MaxLon: math.Inf(-1)}
+	for _, p := range s.Points {
+		r.MinLat = math.Min(r.MinLat, p.Lat)
+		r.MaxLat = math.Max(r.MaxLat, p.Lat)
+		r.MinLon = math.Min(r.MinLon, p.Lon)
+		r.MaxLon = math.Max(r.MaxLon, p.Lon)
+	}
+	return r
+}
+
+// segments returns the edges of the shape. A point is a single segment of
+// length zero.
+func (s Shape) segments() [][2]Point {
+	switch s.Kind {
+	case KindPoint:
+		return [][2]Point{{s.Points[0], s.Points[0]}}
+	case KindPolygon:
+		ring := s.ring()
+		out := make([][2]Point, len(ring))
+		for i := range ring {
+			out[i] = [2]Point{ring[i], ring[(i+1)%len(ring)]}
+		}
+		return out
+	default:
+		out := make([][2]Point, len(s.Points)-1)
+		for i := range out {
+			out[i] = [2]Point{s.Points[i], s.Points[i+1]}
+		}
+		return out
+	}
+}
+
+// Contains returns true if p lies within the polygon. Shapes which are not
+// polygons do not enclose an area and never contain a point.
+func (s Shape) Contains(p Point) bool {
+	if s.Kind != KindPolygon {
+		return false
+	}
+
+	ring := s.ring()
+	inside := false
+	for i, j := 0, len(ring)-1; i < len(ring); j, i = i, i+1 {
+		a, b := ring[i], ring[j]
+		if onSegment(a, b, p) {
+			return true
+		}
+		if (a.Lat > p.Lat) != (b.Lat > p.Lat) &&
+			p.Lon < (b.Lon-a.Lon)*(p.Lat-a.Lat)/(b.Lat-a.Lat)+a.Lon {
+			inside = !inside
+		}
+	}
+	return inside
+}
+
+// Intersects returns true if the two shapes have at least one point in
+// common
+func (s Shape) Intersects(o Shape) bool {
+	if !s.Bounds().intersects(o.Bounds()) {
+		return false
+	}
+
+	for _, a := range s.segments() {
+		for _, b := range o.segments() {
+			if segmentsIntersect(a[0], a[1], b[0], b[1]) {
+				return true
+			}
+		}
+	}
+
+	// no edges cross, so either shape can only lie completely within the other
+	return o.Contains(s.Points[0]) || s.Contains(o.Points[0])
+}
+
+// Within returns true if s lies completely within the polygon
+func (s Shape) Within(polygon Shape) bool {
+	if polygon.Kind != KindPolygon {
+		return false
+	}
+
+	for _, p := range s.Points {
+		if !polygon.Contains(p) {
+			return false
+		}
+	}
+
+	for _, a := range s.segments() {
+		for _, b := range polygon.segments() {
+			if segmentsCross(a[0], a[1], b[0], b[1]) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// intersectsRect returns true if the shape and the rect have at least one
+// point in common
+func (s Shape) intersectsRect(r Rect) bool {
+	return s.Intersects(NewPolygon(r.corners()))
+}
+
+// containsRect returns true if the rect lies completely within the shape
+func (s Shape) containsRect(r Rect) bool {
+	return NewPolygon(r.corners()).Within(s)
+}
+
+// orientation returns a positive number if a, b and c are in counter
+// clockwise order, a negative one if they are clockwise and 0 if they are
+// collinear
+func orientation(a, b, c Point) float64 {
+	return (b.Lon-a.Lon)*(c.Lat-a.Lat) - (b.Lat-a.Lat)*(c.Lon-a.Lon)
+}
+
+// onSegment returns true if p lies on the segment between a and b
+func onSegment(a, b, p Point) bool {
+	return orientation(a, b, p) == 0 &&
+		p.Lat >= math.Min(a.Lat, b.Lat) && p.Lat <= math.Max(a.Lat, b.Lat) &&
+		p.Lon >= math.Min(a.Lon, b.Lon) && p.Lon <= math.Max(a.Lon, b.Lon)
+}
+
+func sign(f float64) int {
+	switch {
+	case f > 0:
+		return 1
+	case f < 0:
+		return -1
+	default:
+		return 0
+	}
+}
+
+// segmentsIntersect returns true if the segments a1-a2 and b1-b2 have at
+// least one point in common, including touching end points
+func segmentsIntersect(a1, a2, b1, b2 Point) bool {
+	o1 := sign(orientation(a1, a2, b1))
+	o2 := sign(orientation(a1, a2, b2))
+	o3 := sign(orientation(b1, b2, a1))
+	o4 := sign(orientation(b1, b2, a2))
+
+	if o1 != o2 && o3 != o4 {
+		return true
+	}
+
+	return (o1 == 0 && onSegment(a1, a2, b1)) ||
+		(o2 == 0 && onSegment(a1, a2, b2)) ||
+		(o3 == 0 && onSegment(b1, b2, a1)) ||
+		(o4 == 0 && onSegment(b1, b2, a2))
+}
+
+// segmentsCross returns true if the segments a1-a2 and b1-b2 properly cross
+// each other, i.e. each one has points on both sides of the other
+func segmentsCross(a1, a2, b1, b2 Point) bool {
+	o1 := sign(orientation(a1, a2, b1))
+	o2 := sign(orientation(a1, a2, b2))
+	o3 := sign(orientation(b1, b2, a1))
+	o4 := sign(orientation(b1, b2, a2))
+
+	return o1*o2 < 0 && o3*o4 < 0
+}
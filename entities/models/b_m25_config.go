@@ -33,6 +33,9 @@ type BM25Config struct {
 
 	// calibrates term-weight scaling based on the term frequency within a document
 	K1 float32 `json:"k1,omitempty"`
+
+	// default weights of properties in keyword searches by property name. A weight set in the query takes precedence.
+	PropertyBoosts map[string]float32 `json:"propertyBoosts,omitempty"`
 }
 
 // Validate validates this b m25 config
@@ -39,21 +39,39 @@ type Class struct {
 	// inverted index config
 	InvertedIndexConfig *InvertedIndexConfig `json:"invertedIndexConfig,omitempty"`
 
+	// memtable config
+	MemtableConfig *MemtableConfig `json:"memtableConfig,omitempty"`
+
 	// Configuration specific to modules this Weaviate instance has installed
 	ModuleConfig interface{} `json:"moduleConfig,omitempty"`
 
 	// multi tenancy config
 	MultiTenancyConfig *MultiTenancyConfig `json:"multiTenancyConfig,omitempty"`
 
+	// object TTL
+	ObjectTTL *ObjectTTLConfig `json:"objectTTL,omitempty"`
+
 	// The properties of the class.
 	Properties []*Property `json:"properties"`
 
+	// quota config
+	QuotaConfig *QuotaConfig `json:"quotaConfig,omitempty"`
+
 	// replication config
 	ReplicationConfig *ReplicationConfig `json:"replicationConfig,omitempty"`
 
 	// Manage how the index should be sharded and distributed in the cluster
 	ShardingConfig interface{} `json:"shardingConfig,omitempty"`
 
+	// Reject objects containing properties that are not part of the class instead of adding them through auto-schema.
+	StrictProperties bool `json:"strictProperties,omitempty"`
+
+	// Named vector spaces of this class, each with its own vector index and vectorizer
+	VectorConfig map[string]VectorConfig `json:"vectorConfig,omitempty"`
+
+	// Length of the vectors of this class. If left empty, it is taken from the 'dimensions' setting of the vectorizer module or recorded on the first insert. Vectors of a different length are rejected. Named vector spaces record their own dimensions in vectorConfig.
+	VectorDimensions int64 `json:"vectorDimensions,omitempty"`
+
 	// Vector-index config, that is specific to the type of index selected in vectorIndexType
 	VectorIndexConfig interface{} `json:"vectorIndexConfig,omitempty"`
 
@@ -72,18 +90,34 @@ func (m *Class) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateMemtableConfig(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateMultiTenancyConfig(formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.validateObjectTTL(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateProperties(formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.validateQuotaConfig(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateReplicationConfig(formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.validateVectorConfig(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -109,6 +143,25 @@ func (m *Class) validateInvertedIndexConfig(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Class) validateMemtableConfig(formats strfmt.Registry) error {
+	if swag.IsZero(m.MemtableConfig) { // not required
+		return nil
+	}
+
+	if m.MemtableConfig != nil {
+		if err := m.MemtableConfig.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("memtableConfig")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("memtableConfig")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *Class) validateMultiTenancyConfig(formats strfmt.Registry) error {
 	if swag.IsZero(m.MultiTenancyConfig) { // not required
 		return nil
@@ -128,6 +181,25 @@ func (m *Class) validateMultiTenancyConfig(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Class) validateObjectTTL(formats strfmt.Registry) error {
+	if swag.IsZero(m.ObjectTTL) { // not required
+		return nil
+	}
+
+	if m.ObjectTTL != nil {
+		if err := m.ObjectTTL.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("objectTTL")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("objectTTL")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *Class) validateProperties(formats strfmt.Registry) error {
 	if swag.IsZero(m.Properties) { // not required
 		return nil
@@ -154,6 +226,25 @@ func (m *Class) validateProperties(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Class) validateQuotaConfig(formats strfmt.Registry) error {
+	if swag.IsZero(m.QuotaConfig) { // not required
+		return nil
+	}
+
+	if m.QuotaConfig != nil {
+		if err := m.QuotaConfig.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("quotaConfig")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("quotaConfig")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *Class) validateReplicationConfig(formats strfmt.Registry) error {
 	if swag.IsZero(m.ReplicationConfig) { // not required
 		return nil
@@ -173,6 +264,29 @@ func (m *Class) validateReplicationConfig(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Class) validateVectorConfig(formats strfmt.Registry) error {
+	if swag.IsZero(m.VectorConfig) { // not required
+		return nil
+	}
+
+	for k := range m.VectorConfig {
+
+		if val, ok := m.VectorConfig[k]; ok {
+			if err := val.Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("vectorConfig" + "." + k)
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("vectorConfig" + "." + k)
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 // ContextValidate validate this class based on the context it is used
 func (m *Class) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
@@ -181,18 +295,34 @@ func (m *Class) ContextValidate(ctx context.Context, formats strfmt.Registry) er
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateMemtableConfig(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateMultiTenancyConfig(ctx, formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateObjectTTL(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateProperties(ctx, formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateQuotaConfig(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateReplicationConfig(ctx, formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateVectorConfig(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -215,6 +345,22 @@ func (m *Class) contextValidateInvertedIndexConfig(ctx context.Context, formats
 	return nil
 }
 
+func (m *Class) contextValidateMemtableConfig(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.MemtableConfig != nil {
+		if err := m.MemtableConfig.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("memtableConfig")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("memtableConfig")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *Class) contextValidateMultiTenancyConfig(ctx context.Context, formats strfmt.Registry) error {
 
 	if m.MultiTenancyConfig != nil {
@@ -231,6 +377,22 @@ func (m *Class) contextValidateMultiTenancyConfig(ctx context.Context, formats s
 	return nil
 }
 
+func (m *Class) contextValidateObjectTTL(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.ObjectTTL != nil {
+		if err := m.ObjectTTL.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("objectTTL")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("objectTTL")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *Class) contextValidateProperties(ctx context.Context, formats strfmt.Registry) error {
 
 	for i := 0; i < len(m.Properties); i++ {
@@ -251,6 +413,22 @@ func (m *Class) contextValidateProperties(ctx context.Context, formats strfmt.Re
 	return nil
 }
 
+func (m *Class) contextValidateQuotaConfig(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.QuotaConfig != nil {
+		if err := m.QuotaConfig.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("quotaConfig")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("quotaConfig")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *Class) contextValidateReplicationConfig(ctx context.Context, formats strfmt.Registry) error {
 
 	if m.ReplicationConfig != nil {
@@ -267,6 +445,21 @@ func (m *Class) contextValidateReplicationConfig(ctx context.Context, formats st
 	return nil
 }
 
+func (m *Class) contextValidateVectorConfig(ctx context.Context, formats strfmt.Registry) error {
+
+	for k := range m.VectorConfig {
+
+		if val, ok := m.VectorConfig[k]; ok {
+			if err := val.ContextValidate(ctx, formats); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *Class) MarshalBinary() ([]byte, error) {
 	if m == nil {
@@ -18,6 +18,7 @@ package models
 
 import (
 	"context"
+	"strconv"
 
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
@@ -35,17 +36,26 @@ type InvertedIndexConfig struct {
 	// Asynchronous index clean up happens every n seconds
 	CleanupIntervalSeconds int64 `json:"cleanupIntervalSeconds,omitempty"`
 
+	// Block compression of the object and inverted index segments on disk. Options: 'none' (default), 'snappy' or 'zstd'
+	Compression string `json:"compression,omitempty"`
+
 	// Index each object with the null state
 	IndexNullState bool `json:"indexNullState,omitempty"`
 
 	// Index length of properties
 	IndexPropertyLength bool `json:"indexPropertyLength,omitempty"`
 
+	// Index the internal timestamps of each object in a range index, which serves range filters and sorting on them much faster. Requires indexTimestamps
+	IndexTimestampRangeFilters bool `json:"indexTimestampRangeFilters,omitempty"`
+
 	// Index each object by its internal timestamps
 	IndexTimestamps bool `json:"indexTimestamps,omitempty"`
 
 	// stopwords
 	Stopwords *StopwordConfig `json:"stopwords,omitempty"`
+
+	// Synonyms the terms of keyword queries are expanded with
+	Synonyms []*SynonymRule `json:"synonyms"`
 }
 
 // Validate validates this inverted index config
@@ -60,6 +70,10 @@ func (m *InvertedIndexConfig) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateSynonyms(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -104,6 +118,32 @@ func (m *InvertedIndexConfig) validateStopwords(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *InvertedIndexConfig) validateSynonyms(formats strfmt.Registry) error {
+	if swag.IsZero(m.Synonyms) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Synonyms); i++ {
+		if swag.IsZero(m.Synonyms[i]) { // not required
+			continue
+		}
+
+		if m.Synonyms[i] != nil {
+			if err := m.Synonyms[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("synonyms" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("synonyms" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 // ContextValidate validate this inverted index config based on the context it is used
 func (m *InvertedIndexConfig) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
@@ -116,6 +156,10 @@ func (m *InvertedIndexConfig) ContextValidate(ctx context.Context, formats strfm
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateSynonyms(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -154,6 +198,26 @@ func (m *InvertedIndexConfig) contextValidateStopwords(ctx context.Context, form
 	return nil
 }
 
+func (m *InvertedIndexConfig) contextValidateSynonyms(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Synonyms); i++ {
+
+		if m.Synonyms[i] != nil {
+			if err := m.Synonyms[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("synonyms" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("synonyms" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *InvertedIndexConfig) MarshalBinary() ([]byte, error) {
 	if m == nil {
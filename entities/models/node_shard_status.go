@@ -18,7 +18,9 @@ package models
 
 import (
 	"context"
+	"strconv"
 
+	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 )
@@ -31,20 +33,208 @@ type NodeShardStatus struct {
 	// The name of shard's class.
 	Class string `json:"class"`
 
+	// The disk space used by the shard, broken down by its components.
+	DiskUsage *ShardDiskUsage `json:"diskUsage,omitempty"`
+
+	// Whether the shard is LOADED, LOADING or still UNLOADED, if shards are loaded lazily at startup.
+	LoadStatus string `json:"loadStatus"`
+
 	// The name of the shard.
 	Name string `json:"name"`
 
 	// The number of objects in shard.
 	ObjectCount int64 `json:"objectCount"`
+
+	// The number of LSM segments of the shard which failed checksum verification and were quarantined. Their data is no longer served.
+	QuarantinedSegments int64 `json:"quarantinedSegments"`
+
+	// The estimated memory used by the vector caches of the shard, in bytes.
+	VectorCacheBytes int64 `json:"vectorCacheBytes"`
+
+	// The memory budget of the vector caches of the shard in bytes, 0 if the caches are only limited by vectorCacheMaxObjects.
+	VectorCacheMaxBytes int64 `json:"vectorCacheMaxBytes"`
+
+	// The results of the latest vector index integrity check of the shard, if any.
+	VectorIndexIntegrity []*VectorIndexIntegrity `json:"vectorIndexIntegrity"`
+
+	// The state of the tombstone cleanup of the vector indexes of the shard.
+	VectorIndexTombstoneCleanup []*VectorIndexTombstoneCleanup `json:"vectorIndexTombstoneCleanup"`
+
+	// How long the oldest vector of the shard which is not indexed yet has been queued, in milliseconds. Only set if async indexing is enabled.
+	VectorQueueLagMs int64 `json:"vectorQueueLagMs"`
+
+	// The number of vectors of the shard which are queued and not indexed yet. Only set if async indexing is enabled.
+	VectorQueueLength int64 `json:"vectorQueueLength"`
 }
 
 // Validate validates this node shard status
 func (m *NodeShardStatus) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateDiskUsage(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateVectorIndexIntegrity(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateVectorIndexTombstoneCleanup(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *NodeShardStatus) validateDiskUsage(formats strfmt.Registry) error {
+	if swag.IsZero(m.DiskUsage) { // not required
+		return nil
+	}
+
+	if m.DiskUsage != nil {
+		if err := m.DiskUsage.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("diskUsage")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("diskUsage")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *NodeShardStatus) validateVectorIndexIntegrity(formats strfmt.Registry) error {
+	if swag.IsZero(m.VectorIndexIntegrity) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.VectorIndexIntegrity); i++ {
+		if swag.IsZero(m.VectorIndexIntegrity[i]) { // not required
+			continue
+		}
+
+		if m.VectorIndexIntegrity[i] != nil {
+			if err := m.VectorIndexIntegrity[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("vectorIndexIntegrity" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("vectorIndexIntegrity" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *NodeShardStatus) validateVectorIndexTombstoneCleanup(formats strfmt.Registry) error {
+	if swag.IsZero(m.VectorIndexTombstoneCleanup) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.VectorIndexTombstoneCleanup); i++ {
+		if swag.IsZero(m.VectorIndexTombstoneCleanup[i]) { // not required
+			continue
+		}
+
+		if m.VectorIndexTombstoneCleanup[i] != nil {
+			if err := m.VectorIndexTombstoneCleanup[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("vectorIndexTombstoneCleanup" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("vectorIndexTombstoneCleanup" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
 	return nil
 }
 
-// ContextValidate validates this node shard status based on context it is used
+// ContextValidate validate this node shard status based on the context it is used
 func (m *NodeShardStatus) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateDiskUsage(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateVectorIndexIntegrity(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateVectorIndexTombstoneCleanup(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *NodeShardStatus) contextValidateDiskUsage(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.DiskUsage != nil {
+		if err := m.DiskUsage.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("diskUsage")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("diskUsage")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *NodeShardStatus) contextValidateVectorIndexIntegrity(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.VectorIndexIntegrity); i++ {
+
+		if m.VectorIndexIntegrity[i] != nil {
+			if err := m.VectorIndexIntegrity[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("vectorIndexIntegrity" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("vectorIndexIntegrity" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *NodeShardStatus) contextValidateVectorIndexTombstoneCleanup(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.VectorIndexTombstoneCleanup); i++ {
+
+		if m.VectorIndexTombstoneCleanup[i] != nil {
+			if err := m.VectorIndexTombstoneCleanup[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("vectorIndexTombstoneCleanup" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("vectorIndexTombstoneCleanup" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
 	return nil
 }
 
@@ -41,6 +41,9 @@ type NodeStatus struct {
 	// The name of the node.
 	Name string `json:"name,omitempty"`
 
+	// The read-only mode of the node and of the classes on it.
+	ReadOnly *NodeReadOnlyStatus `json:"readOnly,omitempty"`
+
 	// The list of the shards with it's statistics.
 	Shards []*NodeShardStatus `json:"shards"`
 
@@ -63,6 +66,10 @@ func (m *NodeStatus) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateReadOnly(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateShards(formats); err != nil {
 		res = append(res, err)
 	}
@@ -100,6 +107,25 @@ func (m *NodeStatus) validateBatchStats(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *NodeStatus) validateReadOnly(formats strfmt.Registry) error {
+	if swag.IsZero(m.ReadOnly) { // not required
+		return nil
+	}
+
+	if m.ReadOnly != nil {
+		if err := m.ReadOnly.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("readOnly")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("readOnly")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *NodeStatus) validateShards(formats strfmt.Registry) error {
 	if swag.IsZero(m.Shards) { // not required
 		return nil
@@ -198,6 +224,10 @@ func (m *NodeStatus) ContextValidate(ctx context.Context, formats strfmt.Registr
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateReadOnly(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateShards(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -228,6 +258,22 @@ func (m *NodeStatus) contextValidateBatchStats(ctx context.Context, formats strf
 	return nil
 }
 
+func (m *NodeStatus) contextValidateReadOnly(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.ReadOnly != nil {
+		if err := m.ReadOnly.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("readOnly")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("readOnly")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *NodeStatus) contextValidateShards(ctx context.Context, formats strfmt.Registry) error {
 
 	for i := 0; i < len(m.Shards); i++ {
@@ -57,6 +57,9 @@ type Object struct {
 
 	// vector weights
 	VectorWeights VectorWeights `json:"vectorWeights,omitempty"`
+
+	// This object's positions in the named vector spaces of its class. Read-only for vector spaces using a vectorizer module.
+	Vectors Vectors `json:"vectors,omitempty"`
 }
 
 // Validate validates this object
@@ -75,6 +78,10 @@ func (m *Object) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateVectors(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -129,6 +136,25 @@ func (m *Object) validateVector(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Object) validateVectors(formats strfmt.Registry) error {
+	if swag.IsZero(m.Vectors) { // not required
+		return nil
+	}
+
+	if m.Vectors != nil {
+		if err := m.Vectors.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("vectors")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("vectors")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 // ContextValidate validate this object based on the context it is used
 func (m *Object) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
@@ -141,6 +167,10 @@ func (m *Object) ContextValidate(ctx context.Context, formats strfmt.Registry) e
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateVectors(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -175,6 +205,20 @@ func (m *Object) contextValidateVector(ctx context.Context, formats strfmt.Regis
 	return nil
 }
 
+func (m *Object) contextValidateVectors(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := m.Vectors.ContextValidate(ctx, formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("vectors")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("vectors")
+		}
+		return err
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *Object) MarshalBinary() ([]byte, error) {
 	if m == nil {
@@ -36,6 +36,9 @@ type ObjectsListResponse struct {
 	// The actual list of Objects.
 	Objects []*Object `json:"objects"`
 
+	// The ID of the scroll session, if the objects were listed with the scroll parameter.
+	ScrollID string `json:"scrollId,omitempty"`
+
 	// The total number of Objects for the query. The number of items in a response may be smaller due to paging.
 	TotalResults int64 `json:"totalResults,omitempty"`
 }
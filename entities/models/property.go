@@ -19,6 +19,7 @@ package models
 import (
 	"context"
 	"encoding/json"
+	"strconv"
 
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
@@ -31,36 +32,88 @@ import (
 // swagger:model Property
 type Property struct {
 
+	// Optional. Restricts how many objects a cross-reference property can point to. `one` allows at most a single reference, `many` (default) allows any number of references up to `maxCount`. Only applicable to cross-reference properties.
+	// Enum: [one many]
+	Cardinality string `json:"cardinality,omitempty"`
+
 	// Can be a reference to another type when it starts with a capital (for example Person), otherwise "string" or "int".
 	DataType []string `json:"dataType"`
 
+	// Optional. Name of a text property of the same class, which holds the ISO 3166-1 alpha-2 country code to use as the default country when parsing this phone number. A defaultCountry set on the phone number itself takes precedence. Only applicable to phoneNumber properties.
+	DefaultCountryProperty string `json:"defaultCountryProperty,omitempty"`
+
+	// Optional. Value which is used for this property when an object is created without it. Must match the data type of the property. Not supported for cross-references.
+	DefaultValue interface{} `json:"defaultValue,omitempty"`
+
 	// Description of the property.
 	Description string `json:"description,omitempty"`
 
+	// Optional. Expression which derives the value of this property from other properties of the same object whenever the object is written, e.g. `concat(firstName, " ", lastName)`. Supports text and number literals, property names, the arithmetic operators + - * / %, text functions (concat, lower, upper, trim), number functions (abs, ceil, floor, round) and date extraction (year, month, day, hour, minute, weekday, dayOfYear). Only allowed for the data types text, int and number. A value set explicitly for a computed property is ignored and replaced by the computed value.
+	Expression string `json:"expression,omitempty"`
+
 	// Optional. Should this property be indexed in the inverted index. Defaults to true. If you choose false, you will not be able to use this property in where filters. This property has no affect on vectorization decisions done by modules
 	IndexFilterable *bool `json:"indexFilterable,omitempty"`
 
 	// Optional. Should this property be indexed in the inverted index. Defaults to true. If you choose false, you will not be able to use this property in where filters, bm25 or hybrid search. This property has no affect on vectorization decisions done by modules (deprecated as of v1.19; use indexFilterable or/and indexSearchable instead)
 	IndexInverted *bool `json:"indexInverted,omitempty"`
 
+	// Optional. Should the positions of the terms be stored in the inverted index. Defaults to false. Applicable only to searchable properties of data type text and text[]. Phrase ("exact phrase") and proximity ("exact phrase"~2) queries in bm25 or hybrid search only match properties which store the positions, without any of them the terms of a phrase are searched for individually.
+	IndexPositions *bool `json:"indexPositions,omitempty"`
+
+	// Optional. Should this property be indexed in a range index, which serves the range filters GreaterThan, GreaterThanEqual, LessThan and LessThanEqual much faster than the filterable index on large ranges. Defaults to false. Applicable only to properties of data type int, number and date.
+	IndexRangeFilters *bool `json:"indexRangeFilters,omitempty"`
+
 	// Optional. Should this property be indexed in the inverted index. Defaults to true. Applicable only to properties of data type text and text[]. If you choose false, you will not be able to use this property in bm25 or hybrid search. This property has no affect on vectorization decisions done by modules
 	IndexSearchable *bool `json:"indexSearchable,omitempty"`
 
+	// Optional. The maximum number of references of a cross-reference property with cardinality `many`. Unlimited if not set.
+	// Minimum: 1
+	MaxCount *int64 `json:"maxCount,omitempty"`
+
 	// Configuration specific to modules this Weaviate instance has installed
 	ModuleConfig interface{} `json:"moduleConfig,omitempty"`
 
 	// Name of the property as URI relative to the schema URL.
 	Name string `json:"name,omitempty"`
 
-	// Determines tokenization of the property as separate words or whole field. Optional. Applies to text and text[] data types. Allowed values are `word` (default; splits on any non-alphanumerical, lowercases), `lowercase` (splits on white spaces, lowercases), `whitespace` (splits on white spaces), `field` (trims). Not supported for remaining data types
-	// Enum: [word lowercase whitespace field]
+	// The properties of the nested object. Only allowed and required for data types "object" and "object[]".
+	NestedProperties []*NestedProperty `json:"nestedProperties,omitempty"`
+
+	// Optional. Controls what happens to this cross-reference when a referenced object is deleted. `cascade` deletes the referring object, `setNull` removes the reference from the referring object and `restrict` rejects the deletion while the object is still referenced. Dangling references are kept if not set. Only applicable to cross-reference properties which are filterable.
+	// Enum: [cascade setNull restrict]
+	OnDelete string `json:"onDelete,omitempty"`
+
+	// Optional. If set to true, objects can not be created or updated without a value for this property. Defaults to false.
+	Required bool `json:"required,omitempty"`
+
+	// Determines tokenization of the property as separate words or whole field. Optional. Applies to text and text[] data types. Allowed values are `word` (default; splits on any non-alphanumerical, lowercases), `lowercase` (splits on white spaces, lowercases), `whitespace` (splits on white spaces), `field` (trims), `lowercase_keyword` (trims, lowercases), `lowercase_keyword_tr` and `lowercase_keyword_de` (like `lowercase_keyword` with the case folding rules of Turkish or German), `cjk` (splits Chinese, Japanese and Korean text into bigrams of characters, other text like `word`), `stem_en`, `stem_de`, `stem_fr`, `stem_es` and `stem_it` (like `word`, reduces the words to their stem in English, German, French, Spanish or Italian). Not supported for remaining data types
+	// Enum: [word lowercase whitespace field lowercase_keyword lowercase_keyword_tr lowercase_keyword_de cjk stem_en stem_de stem_fr stem_es stem_it]
 	Tokenization string `json:"tokenization,omitempty"`
+
+	// Optional. If set to true, the value of this property identifies an object of the class. Batch imports with `upsertBy` set to this property derive the id of objects sent without one from this value, so importing an object with the same value again updates it instead of creating a duplicate. Defaults to false. Applicable only to properties of data type text, int, number, boolean, date and uuid.
+	UniqueKey bool `json:"uniqueKey,omitempty"`
 }
 
 // Validate validates this property
 func (m *Property) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateCardinality(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateMaxCount(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateNestedProperties(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateOnDelete(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateTokenization(formats); err != nil {
 		res = append(res, err)
 	}
@@ -71,11 +124,136 @@ func (m *Property) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+var propertyTypeCardinalityPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["one","many"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		propertyTypeCardinalityPropEnum = append(propertyTypeCardinalityPropEnum, v)
+	}
+}
+
+const (
+
+	// PropertyCardinalityOne captures enum value "one"
+	PropertyCardinalityOne string = "one"
+
+	// PropertyCardinalityMany captures enum value "many"
+	PropertyCardinalityMany string = "many"
+)
+
+// prop value enum
+func (m *Property) validateCardinalityEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, propertyTypeCardinalityPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *Property) validateCardinality(formats strfmt.Registry) error {
+	if swag.IsZero(m.Cardinality) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateCardinalityEnum("cardinality", "body", m.Cardinality); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Property) validateMaxCount(formats strfmt.Registry) error {
+	if swag.IsZero(m.MaxCount) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("maxCount", "body", *m.MaxCount, 1, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Property) validateNestedProperties(formats strfmt.Registry) error {
+	if swag.IsZero(m.NestedProperties) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.NestedProperties); i++ {
+		if swag.IsZero(m.NestedProperties[i]) { // not required
+			continue
+		}
+
+		if m.NestedProperties[i] != nil {
+			if err := m.NestedProperties[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("nestedProperties" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("nestedProperties" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+var propertyTypeOnDeletePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["cascade","setNull","restrict"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		propertyTypeOnDeletePropEnum = append(propertyTypeOnDeletePropEnum, v)
+	}
+}
+
+const (
+
+	// PropertyOnDeleteCascade captures enum value "cascade"
+	PropertyOnDeleteCascade string = "cascade"
+
+	// PropertyOnDeleteSetNull captures enum value "setNull"
+	PropertyOnDeleteSetNull string = "setNull"
+
+	// PropertyOnDeleteRestrict captures enum value "restrict"
+	PropertyOnDeleteRestrict string = "restrict"
+)
+
+// prop value enum
+func (m *Property) validateOnDeleteEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, propertyTypeOnDeletePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *Property) validateOnDelete(formats strfmt.Registry) error {
+	if swag.IsZero(m.OnDelete) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateOnDeleteEnum("onDelete", "body", m.OnDelete); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 var propertyTypeTokenizationPropEnum []interface{}
 
 func init() {
 	var res []string
-	if err := json.Unmarshal([]byte(`["word","lowercase","whitespace","field"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["word","lowercase","whitespace","field","lowercase_keyword","lowercase_keyword_tr","lowercase_keyword_de","cjk","stem_en","stem_de","stem_fr","stem_es","stem_it"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
@@ -96,6 +274,33 @@ const (
 
 	// PropertyTokenizationField captures enum value "field"
 	PropertyTokenizationField string = "field"
+
+	// PropertyTokenizationLowercaseKeyword captures enum value "lowercase_keyword"
+	PropertyTokenizationLowercaseKeyword string = "lowercase_keyword"
+
+	// PropertyTokenizationLowercaseKeywordTr captures enum value "lowercase_keyword_tr"
+	PropertyTokenizationLowercaseKeywordTr string = "lowercase_keyword_tr"
+
+	// PropertyTokenizationLowercaseKeywordDe captures enum value "lowercase_keyword_de"
+	PropertyTokenizationLowercaseKeywordDe string = "lowercase_keyword_de"
+
+	// PropertyTokenizationCjk captures enum value "cjk"
+	PropertyTokenizationCjk string = "cjk"
+
+	// PropertyTokenizationStemEn captures enum value "stem_en"
+	PropertyTokenizationStemEn string = "stem_en"
+
+	// PropertyTokenizationStemDe captures enum value "stem_de"
+	PropertyTokenizationStemDe string = "stem_de"
+
+	// PropertyTokenizationStemFr captures enum value "stem_fr"
+	PropertyTokenizationStemFr string = "stem_fr"
+
+	// PropertyTokenizationStemEs captures enum value "stem_es"
+	PropertyTokenizationStemEs string = "stem_es"
+
+	// PropertyTokenizationStemIt captures enum value "stem_it"
+	PropertyTokenizationStemIt string = "stem_it"
 )
 
 // prop value enum
@@ -119,8 +324,37 @@ func (m *Property) validateTokenization(formats strfmt.Registry) error {
 	return nil
 }
 
-// ContextValidate validates this property based on context it is used
+// ContextValidate validate this property based on the context it is used
 func (m *Property) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateNestedProperties(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Property) contextValidateNestedProperties(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.NestedProperties); i++ {
+
+		if m.NestedProperties[i] != nil {
+			if err := m.NestedProperties[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("nestedProperties" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("nestedProperties" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
 	return nil
 }
 
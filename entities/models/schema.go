@@ -40,6 +40,9 @@ type Schema struct {
 
 	// Name of the schema.
 	Name string `json:"name,omitempty"`
+
+	// Custom stopword sets which classes can reference in their stopword config.
+	StopwordSets []*StopwordSet `json:"stopwordSets"`
 }
 
 // Validate validates this schema
@@ -54,6 +57,10 @@ func (m *Schema) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateStopwordSets(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -98,6 +105,32 @@ func (m *Schema) validateMaintainer(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Schema) validateStopwordSets(formats strfmt.Registry) error {
+	if swag.IsZero(m.StopwordSets) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.StopwordSets); i++ {
+		if swag.IsZero(m.StopwordSets[i]) { // not required
+			continue
+		}
+
+		if m.StopwordSets[i] != nil {
+			if err := m.StopwordSets[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("stopwordSets" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("stopwordSets" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 // ContextValidate validate this schema based on the context it is used
 func (m *Schema) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
@@ -106,6 +139,10 @@ func (m *Schema) ContextValidate(ctx context.Context, formats strfmt.Registry) e
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateStopwordSets(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -132,6 +169,26 @@ func (m *Schema) contextValidateClasses(ctx context.Context, formats strfmt.Regi
 	return nil
 }
 
+func (m *Schema) contextValidateStopwordSets(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.StopwordSets); i++ {
+
+		if m.StopwordSets[i] != nil {
+			if err := m.StopwordSets[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("stopwordSets" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("stopwordSets" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *Schema) MarshalBinary() ([]byte, error) {
 	if m == nil {
@@ -36,6 +36,9 @@ type StopwordConfig struct {
 
 	// stopwords to be removed from consideration
 	Removals []string `json:"removals"`
+
+	// names of custom stopword sets to be considered additionally, see /schema/stopwords
+	Sets []string `json:"sets"`
 }
 
 // Validate validates this stopword config
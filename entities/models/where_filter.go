@@ -32,12 +32,12 @@ import (
 // swagger:model WhereFilter
 type WhereFilter struct {
 
-	// combine multiple where filters, requires 'And' or 'Or' operator
+	// combine multiple where filters, requires 'And' or 'Or' operator, or negate a single where filter with the 'Not' operator. Operands without an operator are combined with 'And'
 	Operands []*WhereFilter `json:"operands"`
 
 	// operator to use
 	// Example: GreaterThanEqual
-	// Enum: [And Or Equal Like NotEqual GreaterThan GreaterThanEqual LessThan LessThanEqual WithinGeoRange IsNull ContainsAny ContainsAll]
+	// Enum: [And Or Not Equal Like NotEqual GreaterThan GreaterThanEqual LessThan LessThanEqual WithinGeoRange IsNull ContainsAny ContainsAll WithinCIDR WithinPolygon Intersects FuzzyMatch]
 	Operator string `json:"operator,omitempty"`
 
 	// path to the property currently being filtered
@@ -60,6 +60,9 @@ type WhereFilter struct {
 	// Example: TODO
 	ValueDateArray []string `json:"valueDateArray,omitempty"`
 
+	// value as geo polygon
+	ValueGeoPolygon *GeoPolygon `json:"valueGeoPolygon,omitempty"`
+
 	// value as geo coordinates and distance
 	ValueGeoRange *WhereFilterGeoRange `json:"valueGeoRange,omitempty"`
 
@@ -108,6 +111,10 @@ func (m *WhereFilter) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateValueGeoPolygon(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateValueGeoRange(formats); err != nil {
 		res = append(res, err)
 	}
@@ -148,7 +155,7 @@ var whereFilterTypeOperatorPropEnum []interface{}
 
 func init() {
 	var res []string
-	if err := json.Unmarshal([]byte(`["And","Or","Equal","Like","NotEqual","GreaterThan","GreaterThanEqual","LessThan","LessThanEqual","WithinGeoRange","IsNull","ContainsAny","ContainsAll"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["And","Or","Not","Equal","Like","NotEqual","GreaterThan","GreaterThanEqual","LessThan","LessThanEqual","WithinGeoRange","IsNull","ContainsAny","ContainsAll","WithinCIDR","WithinPolygon","Intersects","FuzzyMatch"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
@@ -164,6 +171,9 @@ const (
 	// WhereFilterOperatorOr captures enum value "Or"
 	WhereFilterOperatorOr string = "Or"
 
+	// WhereFilterOperatorNot captures enum value "Not"
+	WhereFilterOperatorNot string = "Not"
+
 	// WhereFilterOperatorEqual captures enum value "Equal"
 	WhereFilterOperatorEqual string = "Equal"
 
@@ -196,6 +206,18 @@ const (
 
 	// WhereFilterOperatorContainsAll captures enum value "ContainsAll"
 	WhereFilterOperatorContainsAll string = "ContainsAll"
+
+	// WhereFilterOperatorWithinCIDR captures enum value "WithinCIDR"
+	WhereFilterOperatorWithinCIDR string = "WithinCIDR"
+
+	// WhereFilterOperatorWithinPolygon captures enum value "WithinPolygon"
+	WhereFilterOperatorWithinPolygon string = "WithinPolygon"
+
+	// WhereFilterOperatorIntersects captures enum value "Intersects"
+	WhereFilterOperatorIntersects string = "Intersects"
+
+	// WhereFilterOperatorFuzzyMatch captures enum value "FuzzyMatch"
+	WhereFilterOperatorFuzzyMatch string = "FuzzyMatch"
 )
 
 // prop value enum
@@ -219,6 +241,25 @@ func (m *WhereFilter) validateOperator(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *WhereFilter) validateValueGeoPolygon(formats strfmt.Registry) error {
+	if swag.IsZero(m.ValueGeoPolygon) { // not required
+		return nil
+	}
+
+	if m.ValueGeoPolygon != nil {
+		if err := m.ValueGeoPolygon.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("valueGeoPolygon")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("valueGeoPolygon")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *WhereFilter) validateValueGeoRange(formats strfmt.Registry) error {
 	if swag.IsZero(m.ValueGeoRange) { // not required
 		return nil
@@ -246,6 +287,10 @@ func (m *WhereFilter) ContextValidate(ctx context.Context, formats strfmt.Regist
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateValueGeoPolygon(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateValueGeoRange(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -276,6 +321,22 @@ func (m *WhereFilter) contextValidateOperands(ctx context.Context, formats strfm
 	return nil
 }
 
+func (m *WhereFilter) contextValidateValueGeoPolygon(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.ValueGeoPolygon != nil {
+		if err := m.ValueGeoPolygon.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("valueGeoPolygon")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("valueGeoPolygon")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *WhereFilter) contextValidateValueGeoRange(ctx context.Context, formats strfmt.Registry) error {
 
 	if m.ValueGeoRange != nil {
@@ -44,4 +44,6 @@ type BackupBackend interface {
 
 	Write(ctx context.Context, backupID, key string, r io.ReadCloser) (int64, error)
 	Read(ctx context.Context, backupID, key string, w io.WriteCloser) (int64, error)
+	// Delete removes the object with key `key`, a missing object is no error
+	Delete(ctx context.Context, backupID, key string) error
 }
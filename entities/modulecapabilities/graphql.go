@@ -13,6 +13,7 @@ package modulecapabilities
 
 import (
 	"github.com/tailor-inc/graphql"
+	"github.com/weaviate/weaviate/entities/searchparams"
 )
 
 // GetArgumentsFn generates get graphql config for a given classname
@@ -34,6 +35,12 @@ type NearParam interface {
 	SimilarityMetricProvided() bool
 }
 
+// RescoreParam defines params which can override how a compressed vector
+// index rescores its candidates
+type RescoreParam interface {
+	GetRescore() *searchparams.Rescore
+}
+
 // ValidateFn validates a given module param
 type ValidateFn = func(param interface{}) error
 
@@ -12,6 +12,9 @@
 package schema
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/weaviate/weaviate/entities/models"
 )
 
@@ -54,6 +57,15 @@ func (s *Schema) GetProperty(className ClassName, propName PropertyName) (*model
 		return nil, err
 	}
 
+	if IsNestedDataType(semProp.DataType) && strings.Contains(string(propName), ".") {
+		// a dotted name on a nested property refers to one of its leaves
+		leaf := GetNestedLeafProperty(semProp, string(propName))
+		if leaf == nil {
+			return nil, fmt.Errorf(ErrorNoSuchProperty, propName, semSchemaClass.Class)
+		}
+		return leaf, nil
+	}
+
 	return semProp, nil
 }
 
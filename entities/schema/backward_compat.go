@@ -104,6 +104,8 @@ func IsValidValueDataType(dt string) bool {
 		string(DataTypeBoolean),
 		string(DataTypeDate),
 		string(DataTypeGeoCoordinates),
+		string(DataTypeGeoPolygon),
+		string(DataTypeGeoShape),
 		string(DataTypePhoneNumber),
 		string(DataTypeBlob),
 		string(DataTypeUUID),
@@ -113,7 +115,15 @@ func IsValidValueDataType(dt string) bool {
 		string(DataTypeIntArray),
 		string(DataTypeNumberArray),
 		string(DataTypeBooleanArray),
-		string(DataTypeDateArray):
+		string(DataTypeDateArray),
+		string(DataTypeVector),
+		string(DataTypeVectorArray),
+		string(DataTypeSparseVector),
+		string(DataTypeDuration),
+		string(DataTypeIP),
+		string(DataTypeDecimal),
+		string(DataTypeObject),
+		string(DataTypeObjectArray):
 		return true
 	}
 	return false
@@ -133,12 +143,24 @@ func IsBlobDataType(dt []string) bool {
 	return false
 }
 
+func IsVectorDataType(dt []string) bool {
+	for i := range dt {
+		switch DataType(dt[i]) {
+		case DataTypeVector, DataTypeVectorArray:
+			return true
+		default:
+			// move to the next loop
+		}
+	}
+	return false
+}
+
 func IsArrayDataType(dt []string) bool {
 	for i := range dt {
 		switch DataType(dt[i]) {
 		case DataTypeStringArray, DataTypeTextArray, DataTypeIntArray,
 			DataTypeNumberArray, DataTypeBooleanArray, DataTypeDateArray,
-			DataTypeUUIDArray:
+			DataTypeUUIDArray, DataTypeVectorArray:
 			return true
 		default:
 			// move to the next loop
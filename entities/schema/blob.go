@@ -0,0 +1,49 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+// BlobReferencePrefix marks the value of a blob property which is not stored
+// inline, but in the blob store of its shard. The prefix is followed by the
+// hex encoded sha256 digest of the blob. As ':' is not part of the base64
+// alphabet, a reference can't be mistaken for an inline value.
+const BlobReferencePrefix = "blob:sha256:"
+
+// BlobReference returns the value a blob property is set to, if the blob
+// with the given sha256 digest is stored out of band
+func BlobReference(digest []byte) string {
+	return BlobReferencePrefix + hex.EncodeToString(digest)
+}
+
+// IsBlobReference returns true if value refers to a blob stored out of band,
+// rather than containing the base64 encoded blob itself
+func IsBlobReference(value string) bool {
+	return strings.HasPrefix(value, BlobReferencePrefix)
+}
+
+// ParseBlobReference returns the sha256 digest of the blob value refers to
+func ParseBlobReference(value string) ([]byte, error) {
+	if !IsBlobReference(value) {
+		return nil, fmt.Errorf("invalid blob reference %q: missing prefix %q",
+			value, BlobReferencePrefix)
+	}
+	digest, err := hex.DecodeString(strings.TrimPrefix(value, BlobReferencePrefix))
+	if err != nil || len(digest) != 32 {
+		return nil, fmt.Errorf("invalid blob reference %q: expected a sha256 digest", value)
+	}
+	return digest, nil
+}
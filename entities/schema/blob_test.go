@@ -0,0 +1,49 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlobReference(t *testing.T) {
+	digest := sha256.Sum256([]byte("some blob"))
+
+	ref := BlobReference(digest[:])
+	assert.True(t, IsBlobReference(ref))
+	assert.Len(t, ref, len(BlobReferencePrefix)+64)
+
+	parsed, err := ParseBlobReference(ref)
+	require.Nil(t, err)
+	assert.Equal(t, digest[:], parsed)
+
+	t.Run("inline value", func(t *testing.T) {
+		assert.False(t, IsBlobReference("c29tZSBibG9i"))
+		_, err := ParseBlobReference("c29tZSBibG9i")
+		assert.NotNil(t, err)
+	})
+
+	t.Run("malformed digest", func(t *testing.T) {
+		for _, value := range []string{
+			BlobReferencePrefix + "zz",
+			BlobReferencePrefix + "abcd",
+		} {
+			assert.True(t, IsBlobReference(value))
+			_, err := ParseBlobReference(value)
+			assert.NotNil(t, err, value)
+		}
+	})
+}
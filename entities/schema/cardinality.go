@@ -0,0 +1,29 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import "github.com/weaviate/weaviate/entities/models"
+
+// MaxReferences returns the maximum number of references the cross-reference
+// property prop can hold, 0 means unlimited
+func MaxReferences(prop *models.Property) int {
+	if prop == nil {
+		return 0
+	}
+	if prop.Cardinality == models.PropertyCardinalityOne {
+		return 1
+	}
+	if prop.MaxCount != nil {
+		return int(*prop.MaxCount)
+	}
+	return 0
+}
@@ -35,6 +35,13 @@ const (
 	// DataTypeGeoCoordinates is used to represent geo coordinates, i.e. latitude
 	// and longitude pairs of locations on earth
 	DataTypeGeoCoordinates DataType = "geoCoordinates"
+	// DataTypeGeoPolygon is an area on earth, given by the geo coordinates of
+	// the ring around it
+	DataTypeGeoPolygon DataType = "geoPolygon"
+	// DataTypeGeoShape is a point, a line string or a polygon on earth. Both
+	// geo shape data types are indexed as the geohash cells covering them, so
+	// they can be filtered with WithinPolygon and Intersects
+	DataTypeGeoShape DataType = "geoShape"
 	// DataTypePhoneNumber represents a parsed/to-be-parsed phone number
 	DataTypePhoneNumber DataType = "phoneNumber"
 	// DataTypeBlob represents a base64 encoded data
@@ -55,6 +62,34 @@ const (
 	DataTypeUUID DataType = "uuid"
 	// DataTypeUUIDArray is the array version of DataTypeUUID
 	DataTypeUUIDArray DataType = "uuid[]"
+	// DataTypeVector is a raw float32 vector stored as a property value. It is
+	// independent of the object's main vector and is not indexed in the
+	// inverted index or a vector index. A vector search with the property as
+	// target vector is brute force, it scans the property values of all
+	// objects on every query and is therefore only suited for small classes
+	DataTypeVector DataType = "vector"
+	// DataTypeVectorArray is the array version of DataTypeVector
+	DataTypeVectorArray DataType = "vector[]"
+	// DataTypeSparseVector is a sparse vector given by the indices and values
+	// of its non-zero dimensions. It is indexed in a sparse vector index of
+	// its own, which is searched with nearSparseVector
+	DataTypeSparseVector DataType = "sparseVector"
+	// DataTypeDuration is a length of time, specified as an ISO-8601 duration
+	// or a Go duration string. It is indexed as int64 nanoseconds, so it can be
+	// filtered by range and aggregated like a number
+	DataTypeDuration DataType = "duration"
+	// DataTypeIP is an IPv4 or IPv6 address. It is indexed in its 16 byte binary
+	// representation, so it can be filtered by range and by CIDR block
+	DataTypeIP DataType = "ip"
+	// DataTypeDecimal is an exact decimal number of arbitrary precision, which
+	// is specified and returned as a string. Unlike DataTypeNumber it does not
+	// lose precision, so it is suited for e.g. monetary amounts
+	DataTypeDecimal DataType = "decimal"
+	// DataTypeObject is a nested JSON sub-document. Its structure is defined by
+	// the nestedProperties of the property
+	DataTypeObject DataType = "object"
+	// DataTypeObjectArray is the array version of DataTypeObject
+	DataTypeObjectArray DataType = "object[]"
 
 	// deprecated as of v1.19, replaced by DataTypeText + relevant tokenization setting
 	// DataTypeString The data type is a value of type string
@@ -76,7 +111,13 @@ var PrimitiveDataTypes []DataType = []DataType{
 	DataTypeText, DataTypeInt, DataTypeNumber, DataTypeBoolean, DataTypeDate,
 	DataTypeGeoCoordinates, DataTypePhoneNumber, DataTypeBlob, DataTypeTextArray,
 	DataTypeIntArray, DataTypeNumberArray, DataTypeBooleanArray, DataTypeDateArray,
-	DataTypeUUID, DataTypeUUIDArray,
+	DataTypeUUID, DataTypeUUIDArray, DataTypeVector, DataTypeVectorArray,
+	DataTypeDuration, DataTypeIP, DataTypeDecimal, DataTypeGeoPolygon, DataTypeGeoShape,
+	DataTypeSparseVector,
+}
+
+var NestedDataTypes []DataType = []DataType{
+	DataTypeObject, DataTypeObjectArray,
 }
 
 var DeprecatedPrimitiveDataTypes []DataType = []DataType{
@@ -132,6 +173,8 @@ func IsArrayType(dt DataType) (DataType, bool) {
 		return DataTypeDate, true
 	case DataTypeUUIDArray:
 		return DataTypeUUID, true
+	case DataTypeVectorArray:
+		return DataTypeVector, true
 
 	default:
 		return "", false
@@ -204,7 +247,7 @@ func (s *Schema) FindPropertyDataTypeWithRefs(
 		return nil, errors.New("dataType must have at least one element")
 	}
 	if len(dataType) == 1 {
-		for _, dt := range append(PrimitiveDataTypes, DeprecatedPrimitiveDataTypes...) {
+		for _, dt := range allNonRefDataTypes() {
 			if dataType[0] == dt.String() {
 				return &propertyDataType{
 					kind:          PropertyKindPrimitive,
@@ -246,7 +289,7 @@ func (s *Schema) FindPropertyDataTypeWithRefs(
 
 func AsPrimitive(dataType []string) (DataType, bool) {
 	if (len(dataType)) == 1 {
-		for _, dt := range append(PrimitiveDataTypes, DeprecatedPrimitiveDataTypes...) {
+		for _, dt := range allNonRefDataTypes() {
 			if dataType[0] == dt.String() {
 				return dt, true
 			}
@@ -259,3 +302,11 @@ func AsPrimitive(dataType []string) (DataType, bool) {
 	}
 	return "", false
 }
+
+func allNonRefDataTypes() []DataType {
+	dts := make([]DataType, 0, len(PrimitiveDataTypes)+len(NestedDataTypes)+
+		len(DeprecatedPrimitiveDataTypes))
+	dts = append(dts, PrimitiveDataTypes...)
+	dts = append(dts, NestedDataTypes...)
+	return append(dts, DeprecatedPrimitiveDataTypes...)
+}
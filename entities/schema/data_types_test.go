@@ -82,7 +82,7 @@ func TestGetPropertyDataType(t *testing.T) {
 		"string", "text", "int", "number", "boolean",
 		"date", "geoCoordinates", "phoneNumber", "blob", "Ref", "invalid",
 		"string[]", "text[]", "int[]", "number[]", "boolean[]", "date[]",
-		"uuid", "uuid[]",
+		"uuid", "uuid[]", "vector", "vector[]", "duration", "ip", "decimal",
 	}
 	class.Properties = make([]*models.Property, len(dataTypes))
 	for i, dtString := range dataTypes {
@@ -167,6 +167,26 @@ func TestGetPropertyDataType(t *testing.T) {
 			propName:         "uuid[]Prop",
 			expectedDataType: ptDataType(DataTypeUUIDArray),
 		},
+		{
+			propName:         "vectorProp",
+			expectedDataType: ptDataType(DataTypeVector),
+		},
+		{
+			propName:         "vector[]Prop",
+			expectedDataType: ptDataType(DataTypeVectorArray),
+		},
+		{
+			propName:         "durationProp",
+			expectedDataType: ptDataType(DataTypeDuration),
+		},
+		{
+			propName:         "ipProp",
+			expectedDataType: ptDataType(DataTypeIP),
+		},
+		{
+			propName:         "decimalProp",
+			expectedDataType: ptDataType(DataTypeDecimal),
+		},
 		{
 			propName:         "RefProp",
 			expectedDataType: ptDataType(DataTypeCRef),
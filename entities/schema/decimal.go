@@ -0,0 +1,159 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// MaxDecimalDigits limits the number of digits before and after the decimal
+// point of a decimal value
+const MaxDecimalDigits = 1000
+
+var decimalRegex = regexp.MustCompile(`^([+-])?(\d*)(?:\.(\d*))?(?:[eE]([+-]?\d+))?$`)
+
+// Decimal is an exact, arbitrary-precision decimal number. It is normalized,
+// so equal numbers are represented identically regardless of their notation:
+// the value is 0.<digits> * 10^exponent with neither leading nor trailing
+// zeros in digits. Zero has no digits.
+type Decimal struct {
+	negative bool
+	digits   string
+	exponent int
+}
+
+// ParseDecimal parses the value of a decimal property, such as "12.30",
+// "-0.001" or "1.5e-3"
+func ParseDecimal(value string) (Decimal, error) {
+	matches := decimalRegex.FindStringSubmatch(value)
+	if matches == nil || matches[2]+matches[3] == "" {
+		return Decimal{}, fmt.Errorf("invalid decimal %q", value)
+	}
+
+	digits := matches[2] + matches[3]
+	exponent := len(matches[2])
+	if matches[4] != "" {
+		exp, err := strconv.Atoi(matches[4])
+		if err != nil || exp > MaxDecimalDigits || exp < -MaxDecimalDigits {
+			return Decimal{}, fmt.Errorf("invalid decimal %q: exponent out of range", value)
+		}
+		exponent += exp
+	}
+
+	trimmed := strings.TrimLeft(digits, "0")
+	exponent -= len(digits) - len(trimmed)
+	trimmed = strings.TrimRight(trimmed, "0")
+	if trimmed == "" {
+		return Decimal{}, nil
+	}
+
+	if exponent > MaxDecimalDigits || len(trimmed)-exponent > MaxDecimalDigits {
+		return Decimal{}, fmt.Errorf("invalid decimal %q: more than %d digits before "+
+			"or after the decimal point", value, MaxDecimalDigits)
+	}
+
+	return Decimal{
+		negative: matches[1] == "-",
+		digits:   trimmed,
+		exponent: exponent,
+	}, nil
+}
+
+// IsZero is true if d is 0
+func (d Decimal) IsZero() bool {
+	return d.digits == ""
+}
+
+// Negative is true if d is less than 0
+func (d Decimal) Negative() bool {
+	return d.negative
+}
+
+// Digits are the significant digits of d, without leading or trailing zeros
+func (d Decimal) Digits() string {
+	return d.digits
+}
+
+// Exponent is the position of the decimal point relative to the first
+// significant digit, i.e. d is 0.<digits> * 10^exponent
+func (d Decimal) Exponent() int {
+	return d.exponent
+}
+
+// String formats d in plain notation, e.g. "-12.5" or "0.001"
+func (d Decimal) String() string {
+	if d.IsZero() {
+		return "0"
+	}
+
+	var sb strings.Builder
+	if d.negative {
+		sb.WriteByte('-')
+	}
+	switch {
+	case d.exponent <= 0:
+		sb.WriteString("0.")
+		sb.WriteString(strings.Repeat("0", -d.exponent))
+		sb.WriteString(d.digits)
+	case d.exponent >= len(d.digits):
+		sb.WriteString(d.digits)
+		sb.WriteString(strings.Repeat("0", d.exponent-len(d.digits)))
+	default:
+		sb.WriteString(d.digits[:d.exponent])
+		sb.WriteByte('.')
+		sb.WriteString(d.digits[d.exponent:])
+	}
+	return sb.String()
+}
+
+// Cmp returns -1 if d is less than other, 0 if they are equal and 1 if d is
+// greater than other
+func (d Decimal) Cmp(other Decimal) int {
+	if sign, otherSign := d.sign(), other.sign(); sign != otherSign {
+		if sign < otherSign {
+			return -1
+		}
+		return 1
+	}
+
+	// same sign, compare the absolute values
+	res := 0
+	switch {
+	case d.exponent != other.exponent:
+		if d.exponent < other.exponent {
+			res = -1
+		} else {
+			res = 1
+		}
+	default:
+		res = strings.Compare(d.digits, other.digits)
+	}
+
+	if d.negative {
+		return -res
+	}
+	return res
+}
+
+func (d Decimal) sign() int {
+	switch {
+	case d.IsZero():
+		return 0
+	case d.negative:
+		return -1
+	default:
+		return 1
+	}
+}
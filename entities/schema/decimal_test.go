@@ -0,0 +1,89 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDecimal(t *testing.T) {
+	valid := []struct {
+		value    string
+		expected string
+	}{
+		{value: "0", expected: "0"},
+		{value: "-0.000", expected: "0"},
+		{value: "12.30", expected: "12.3"},
+		{value: "+12", expected: "12"},
+		{value: "-0.001", expected: "-0.001"},
+		{value: ".5", expected: "0.5"},
+		{value: "5.", expected: "5"},
+		{value: "1200", expected: "1200"},
+		{value: "1.5e-3", expected: "0.0015"},
+		{value: "1.5E3", expected: "1500"},
+		{value: "007.0700", expected: "7.07"},
+		{
+			value:    "12345678901234567890.123456789012345678901",
+			expected: "12345678901234567890.123456789012345678901",
+		},
+	}
+	for _, test := range valid {
+		t.Run(test.value, func(t *testing.T) {
+			d, err := ParseDecimal(test.value)
+			require.Nil(t, err)
+			assert.Equal(t, test.expected, d.String())
+		})
+	}
+
+	invalid := []string{
+		"", ".", "-", "1,5", "1.2.3", "0x10", "1e", "NaN", "Inf",
+		"1e1001", "1" + strings.Repeat("0", MaxDecimalDigits),
+	}
+	for _, value := range invalid {
+		t.Run(value, func(t *testing.T) {
+			_, err := ParseDecimal(value)
+			assert.NotNil(t, err)
+		})
+	}
+}
+
+func TestDecimalCmp(t *testing.T) {
+	// in ascending order
+	values := []string{
+		"-1000", "-1.25", "-1.2", "-0.5", "-0.05", "0", "0.05", "0.1",
+		"0.10000000000000000001", "1", "1.2", "1.25", "9.99", "10", "1000",
+	}
+
+	decimals := make([]Decimal, len(values))
+	for i, value := range values {
+		d, err := ParseDecimal(value)
+		require.Nil(t, err)
+		decimals[i] = d
+	}
+
+	for i := range decimals {
+		for j := range decimals {
+			expected := 0
+			if i < j {
+				expected = -1
+			} else if i > j {
+				expected = 1
+			}
+			assert.Equal(t, expected, decimals[i].Cmp(decimals[j]),
+				"%s compared to %s", values[i], values[j])
+		}
+	}
+}
@@ -0,0 +1,77 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var isoDurationRegex = regexp.MustCompile(`^P(?:(\d+(?:\.\d+)?)W|` +
+	`(?:(\d+(?:\.\d+)?)D)?(?:T(?:(\d+(?:\.\d+)?)H)?(?:(\d+(?:\.\d+)?)M)?(?:(\d+(?:\.\d+)?)S)?)?)$`)
+
+// ParseDuration parses the value of a duration property. Both ISO-8601
+// durations (e.g. "P1DT2H30M") and Go duration strings (e.g. "26h30m") are
+// accepted, optionally with a leading sign. Years and months are rejected in
+// ISO-8601 durations, as their length depends on the date they are applied to.
+func ParseDuration(value string) (time.Duration, error) {
+	trimmed := strings.TrimLeft(value, "+-")
+	if !strings.HasPrefix(trimmed, "P") {
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration %q: must be an ISO-8601 duration "+
+				"or a Go duration string", value)
+		}
+		return d, nil
+	}
+
+	if len(value)-len(trimmed) > 1 {
+		return 0, fmt.Errorf("invalid duration %q: more than one sign", value)
+	}
+	negative := strings.HasPrefix(value, "-")
+
+	matches := isoDurationRegex.FindStringSubmatch(trimmed)
+	if matches == nil || trimmed == "P" || strings.HasSuffix(trimmed, "T") {
+		if strings.ContainsAny(strings.SplitN(trimmed, "T", 2)[0], "YM") {
+			return 0, fmt.Errorf("invalid duration %q: years and months are not "+
+				"supported, as their length is not fixed", value)
+		}
+		return 0, fmt.Errorf("invalid duration %q: must be an ISO-8601 duration "+
+			"or a Go duration string", value)
+	}
+
+	units := []time.Duration{7 * 24 * time.Hour, 24 * time.Hour, time.Hour, time.Minute, time.Second}
+	var total float64
+	for i, unit := range units {
+		if matches[i+1] == "" {
+			continue
+		}
+		n, err := strconv.ParseFloat(matches[i+1], 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration %q: %w", value, err)
+		}
+		total += n * float64(unit)
+	}
+	if total >= math.MaxInt64 {
+		return 0, fmt.Errorf("invalid duration %q: out of range", value)
+	}
+
+	d := time.Duration(math.Round(total))
+	if negative {
+		d = -d
+	}
+	return d, nil
+}
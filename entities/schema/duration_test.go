@@ -0,0 +1,69 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDuration(t *testing.T) {
+	valid := []struct {
+		value    string
+		expected time.Duration
+	}{
+		{value: "1h30m", expected: 90 * time.Minute},
+		{value: "-1.5s", expected: -1500 * time.Millisecond},
+		{value: "250ms", expected: 250 * time.Millisecond},
+		{value: "0s", expected: 0},
+		{value: "PT30M", expected: 30 * time.Minute},
+		{value: "P1DT2H", expected: 26 * time.Hour},
+		{value: "P2W", expected: 14 * 24 * time.Hour},
+		{value: "PT0.5S", expected: 500 * time.Millisecond},
+		{value: "P1DT1H1M1S", expected: 25*time.Hour + time.Minute + time.Second},
+		{value: "-PT1H", expected: -time.Hour},
+		{value: "+P1D", expected: 24 * time.Hour},
+	}
+	for _, test := range valid {
+		t.Run(test.value, func(t *testing.T) {
+			d, err := ParseDuration(test.value)
+			require.Nil(t, err)
+			assert.Equal(t, test.expected, d)
+		})
+	}
+
+	invalid := []struct {
+		value string
+		msg   string
+	}{
+		{value: "", msg: "must be an ISO-8601 duration"},
+		{value: "an hour", msg: "must be an ISO-8601 duration"},
+		{value: "P", msg: "must be an ISO-8601 duration"},
+		{value: "PT", msg: "must be an ISO-8601 duration"},
+		{value: "P1DT", msg: "must be an ISO-8601 duration"},
+		{value: "P1W2D", msg: "must be an ISO-8601 duration"},
+		{value: "--PT1H", msg: "more than one sign"},
+		{value: "P1Y", msg: "years and months are not supported"},
+		{value: "P2M", msg: "years and months are not supported"},
+		{value: "P300000W", msg: "out of range"},
+	}
+	for _, test := range invalid {
+		t.Run(test.value, func(t *testing.T) {
+			_, err := ParseDuration(test.value)
+			require.NotNil(t, err)
+			assert.Contains(t, err.Error(), test.msg)
+		})
+	}
+}
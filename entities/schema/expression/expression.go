@@ -0,0 +1,329 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package expression implements the small expression language of computed
+// properties. An expression derives a value from other properties of the same
+// object, e.g.
+//
+//	concat(firstName, " ", lastName)
+//	price * quantity * (1 - discount)
+//	year(publishedAt)
+//
+// It supports string and number literals, property names, the arithmetic
+// operators + - * / % with the usual precedence, parentheses and the
+// functions listed in Functions.
+package expression
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// Type is the type of a value within an expression
+type Type int
+
+const (
+	TypeText Type = iota + 1
+	TypeNumber
+	TypeDate
+)
+
+func (t Type) String() string {
+	switch t {
+	case TypeText:
+		return "text"
+	case TypeNumber:
+		return "number"
+	case TypeDate:
+		return "date"
+	default:
+		return "unknown"
+	}
+}
+
+// Expression is a parsed expression which can be evaluated against the
+// properties of an object
+type Expression struct {
+	source string
+	root   node
+}
+
+// Parse parses the given expression. It only checks the syntax, use Check to
+// validate the expression against the properties of a class.
+func Parse(source string) (*Expression, error) {
+	p := &parser{lexer: newLexer(source)}
+	root, err := p.parse()
+	if err != nil {
+		return nil, fmt.Errorf("invalid expression %q: %w", source, err)
+	}
+
+	return &Expression{source: source, root: root}, nil
+}
+
+func (e *Expression) String() string {
+	return e.source
+}
+
+// Properties returns the sorted names of all properties the expression
+// depends on
+func (e *Expression) Properties() []string {
+	set := map[string]struct{}{}
+	e.root.properties(set)
+
+	names := make([]string, 0, len(set))
+	for name := range set {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Check validates that all operators and functions are applied to values of
+// a matching type and returns the type of the result. propertyType resolves
+// the type of a referenced property and should return an error if the
+// property does not exist or is not supported.
+func (e *Expression) Check(propertyType func(name string) (Type, error)) (Type, error) {
+	t, err := e.root.check(propertyType)
+	if err != nil {
+		return 0, fmt.Errorf("invalid expression %q: %w", e.source, err)
+	}
+	return t, nil
+}
+
+// Eval evaluates the expression against the given properties. Text values are
+// expected as string, numbers as float64, int64 or json.Number and dates as
+// time.Time or RFC3339 formatted string. The result is a string, float64 or
+// time.Time. If any of the referenced properties is not set, the result is nil.
+func (e *Expression) Eval(props map[string]interface{}) (interface{}, error) {
+	v, err := e.root.eval(props)
+	if err != nil {
+		if err == errMissingProperty {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("evaluate expression %q: %w", e.source, err)
+	}
+	return v, nil
+}
+
+var errMissingProperty = fmt.Errorf("missing property")
+
+type node interface {
+	properties(set map[string]struct{})
+	check(propertyType func(name string) (Type, error)) (Type, error)
+	eval(props map[string]interface{}) (interface{}, error)
+}
+
+type literal struct {
+	value interface{}
+}
+
+func (n *literal) properties(map[string]struct{}) {}
+
+func (n *literal) check(func(string) (Type, error)) (Type, error) {
+	if _, ok := n.value.(string); ok {
+		return TypeText, nil
+	}
+	return TypeNumber, nil
+}
+
+func (n *literal) eval(map[string]interface{}) (interface{}, error) {
+	return n.value, nil
+}
+
+type property struct {
+	name string
+}
+
+func (n *property) properties(set map[string]struct{}) {
+	set[n.name] = struct{}{}
+}
+
+func (n *property) check(propertyType func(string) (Type, error)) (Type, error) {
+	return propertyType(n.name)
+}
+
+func (n *property) eval(props map[string]interface{}) (interface{}, error) {
+	value, ok := props[n.name]
+	if !ok || value == nil {
+		return nil, errMissingProperty
+	}
+
+	switch v := value.(type) {
+	case string, float64, time.Time:
+		return v, nil
+	case int64:
+		return float64(v), nil
+	case int:
+		return float64(v), nil
+	case json.Number:
+		f, err := v.Float64()
+		if err != nil {
+			return nil, fmt.Errorf("property %q: %w", n.name, err)
+		}
+		return f, nil
+	default:
+		return nil, fmt.Errorf("property %q: unsupported value of type %T", n.name, value)
+	}
+}
+
+type unaryMinus struct {
+	operand node
+}
+
+func (n *unaryMinus) properties(set map[string]struct{}) {
+	n.operand.properties(set)
+}
+
+func (n *unaryMinus) check(propertyType func(string) (Type, error)) (Type, error) {
+	t, err := n.operand.check(propertyType)
+	if err != nil {
+		return 0, err
+	}
+	if t != TypeNumber {
+		return 0, fmt.Errorf("operator '-' requires a number, got %s", t)
+	}
+	return TypeNumber, nil
+}
+
+func (n *unaryMinus) eval(props map[string]interface{}) (interface{}, error) {
+	v, err := evalNumber(n.operand, props)
+	if err != nil {
+		return nil, err
+	}
+	return -v, nil
+}
+
+type binary struct {
+	operator    byte
+	left, right node
+}
+
+func (n *binary) properties(set map[string]struct{}) {
+	n.left.properties(set)
+	n.right.properties(set)
+}
+
+func (n *binary) check(propertyType func(string) (Type, error)) (Type, error) {
+	for _, operand := range []node{n.left, n.right} {
+		t, err := operand.check(propertyType)
+		if err != nil {
+			return 0, err
+		}
+		if t != TypeNumber {
+			return 0, fmt.Errorf("operator '%c' requires numbers, got %s "+
+				"(use concat to join text)", n.operator, t)
+		}
+	}
+	return TypeNumber, nil
+}
+
+func (n *binary) eval(props map[string]interface{}) (interface{}, error) {
+	left, err := evalNumber(n.left, props)
+	if err != nil {
+		return nil, err
+	}
+	right, err := evalNumber(n.right, props)
+	if err != nil {
+		return nil, err
+	}
+
+	switch n.operator {
+	case '+':
+		return left + right, nil
+	case '-':
+		return left - right, nil
+	case '*':
+		return left * right, nil
+	case '/':
+		if right == 0 {
+			return nil, fmt.Errorf("division by zero")
+		}
+		return left / right, nil
+	case '%':
+		if right == 0 {
+			return nil, fmt.Errorf("division by zero")
+		}
+		return float64(int64(left) % int64(right)), nil
+	default:
+		return nil, fmt.Errorf("unknown operator '%c'", n.operator)
+	}
+}
+
+type call struct {
+	fn   *function
+	args []node
+}
+
+func (n *call) properties(set map[string]struct{}) {
+	for _, arg := range n.args {
+		arg.properties(set)
+	}
+}
+
+func (n *call) check(propertyType func(string) (Type, error)) (Type, error) {
+	for i, arg := range n.args {
+		t, err := arg.check(propertyType)
+		if err != nil {
+			return 0, err
+		}
+		if n.fn.arg != 0 && t != n.fn.arg {
+			return 0, fmt.Errorf("argument %d of %s() must be %s, got %s",
+				i+1, n.fn.name, n.fn.arg, t)
+		}
+	}
+	return n.fn.result, nil
+}
+
+func (n *call) eval(props map[string]interface{}) (interface{}, error) {
+	args := make([]interface{}, len(n.args))
+	for i, arg := range n.args {
+		v, err := arg.eval(props)
+		if err != nil {
+			return nil, err
+		}
+		if n.fn.arg == TypeDate {
+			if v, err = asDate(v); err != nil {
+				return nil, fmt.Errorf("%s(): %w", n.fn.name, err)
+			}
+		}
+		args[i] = v
+	}
+	return n.fn.eval(args)
+}
+
+func evalNumber(n node, props map[string]interface{}) (float64, error) {
+	v, err := n.eval(props)
+	if err != nil {
+		return 0, err
+	}
+	f, ok := v.(float64)
+	if !ok {
+		return 0, fmt.Errorf("expected a number, got %T", v)
+	}
+	return f, nil
+}
+
+func asDate(v interface{}) (time.Time, error) {
+	switch typed := v.(type) {
+	case time.Time:
+		return typed, nil
+	case string:
+		t, err := time.Parse(time.RFC3339, typed)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("requires a RFC3339 formatted date, got %q", typed)
+		}
+		return t, nil
+	default:
+		return time.Time{}, fmt.Errorf("expected a date, got %T", v)
+	}
+}
@@ -0,0 +1,168 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package expression
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name       string
+		source     string
+		properties []string
+		err        string
+	}{
+		{name: "literal", source: "42"},
+		{name: "property", source: "price", properties: []string{"price"}},
+		{
+			name: "arithmetic with precedence", source: "price * (1 - discount) + price",
+			properties: []string{"discount", "price"},
+		},
+		{
+			name: "nested calls", source: `upper(concat(firstName, ' ', "last"))`,
+			properties: []string{"firstName"},
+		},
+		{name: "empty", source: "  ", err: "empty expression"},
+		{name: "unknown function", source: "foo(a)", err: `unknown function "foo"`},
+		{name: "wrong arity", source: "year(a, b)", err: "year() takes 1 argument(s), got 2"},
+		{name: "concat without args", source: "concat()", err: "takes at least 1 argument(s)"},
+		{name: "unbalanced parenthesis", source: "(a + b", err: "unexpected end of expression"},
+		{name: "trailing tokens", source: "a b", err: `unexpected "b" at position 3`},
+		{name: "unterminated string", source: `concat("abc)`, err: "unterminated string"},
+		{name: "invalid character", source: "a & b", err: `unexpected character '&'`},
+		{name: "invalid number", source: "1.2.3", err: `invalid number "1.2.3"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expr, err := Parse(tt.source)
+			if tt.err != "" {
+				require.NotNil(t, err)
+				assert.Contains(t, err.Error(), tt.err)
+				return
+			}
+			require.Nil(t, err)
+			if tt.properties == nil {
+				tt.properties = []string{}
+			}
+			assert.Equal(t, tt.properties, expr.Properties())
+		})
+	}
+}
+
+func TestCheck(t *testing.T) {
+	types := map[string]Type{
+		"title":     TypeText,
+		"price":     TypeNumber,
+		"published": TypeDate,
+	}
+	propertyType := func(name string) (Type, error) {
+		if t, ok := types[name]; ok {
+			return t, nil
+		}
+		return 0, fmt.Errorf("no such property %q", name)
+	}
+
+	tests := []struct {
+		source string
+		result Type
+		err    string
+	}{
+		{source: "concat(title, price, published)", result: TypeText},
+		{source: "price * 2 - -1", result: TypeNumber},
+		{source: "year(published) % 100", result: TypeNumber},
+		{source: "lower(title)", result: TypeText},
+		{source: "title + price", err: "operator '+' requires numbers, got text"},
+		{source: "-title", err: "operator '-' requires a number"},
+		{source: "year(title)", err: "argument 1 of year() must be date, got text"},
+		{source: "round(title)", err: "argument 1 of round() must be number"},
+		{source: "concat(unknown)", err: `no such property "unknown"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.source, func(t *testing.T) {
+			expr, err := Parse(tt.source)
+			require.Nil(t, err)
+
+			result, err := expr.Check(propertyType)
+			if tt.err != "" {
+				require.NotNil(t, err)
+				assert.Contains(t, err.Error(), tt.err)
+				return
+			}
+			require.Nil(t, err)
+			assert.Equal(t, tt.result, result)
+		})
+	}
+}
+
+func TestEval(t *testing.T) {
+	published := time.Date(2023, time.March, 5, 14, 30, 0, 0, time.UTC)
+	props := map[string]interface{}{
+		"firstName": "Ada",
+		"lastName":  "Lovelace",
+		"price":     12.5,
+		"quantity":  int64(4),
+		"discount":  json.Number("0.2"),
+		"published": published,
+		"updated":   "2023-12-31T23:00:00+02:00",
+	}
+
+	tests := []struct {
+		source   string
+		expected interface{}
+		err      string
+	}{
+		{source: `concat(firstName, " ", lastName)`, expected: "Ada Lovelace"},
+		{source: `concat(quantity, 'x', price)`, expected: "4x12.5"},
+		{source: `concat("on ", published)`, expected: "on 2023-03-05T14:30:00Z"},
+		{source: `upper(trim(concat(" ", firstName)))`, expected: "ADA"},
+		{source: "price * quantity * (1 - discount)", expected: float64(40)},
+		{source: "quantity / 8 + 1", expected: 1.5},
+		{source: "-price % 5", expected: float64(-2)},
+		{source: "round(price) + floor(price) + ceil(price) + abs(-1)", expected: float64(39)},
+		{source: "year(published)", expected: float64(2023)},
+		{source: "month(published) * 100 + day(published)", expected: float64(305)},
+		{source: "hour(published) + minute(published)", expected: float64(44)},
+		{source: "weekday(published)", expected: float64(0)},
+		{source: "dayOfYear(published)", expected: float64(64)},
+		{source: "day(updated)", expected: float64(31)},
+		{source: "hour(updated)", expected: float64(21)},
+		{source: "price * missing", expected: nil},
+		{source: "concat(firstName, missing)", expected: nil},
+		{source: "price / (quantity - 4)", err: "division by zero"},
+		{source: "year(firstName)", err: "requires a RFC3339 formatted date"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.source, func(t *testing.T) {
+			expr, err := Parse(tt.source)
+			require.Nil(t, err)
+
+			result, err := expr.Eval(props)
+			if tt.err != "" {
+				require.NotNil(t, err)
+				assert.Contains(t, err.Error(), tt.err)
+				return
+			}
+			require.Nil(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
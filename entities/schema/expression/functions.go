@@ -0,0 +1,147 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package expression
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type function struct {
+	name string
+	// arg is the required type of all arguments, 0 accepts any type
+	arg     Type
+	minArgs int
+	// maxArgs of -1 allows any number of arguments
+	maxArgs int
+	result  Type
+	eval    func(args []interface{}) (interface{}, error)
+}
+
+var functions = map[string]*function{}
+
+func init() {
+	register := func(fn *function) {
+		functions[fn.name] = fn
+	}
+
+	register(&function{
+		name: "concat", minArgs: 1, maxArgs: -1, result: TypeText,
+		eval: func(args []interface{}) (interface{}, error) {
+			var sb strings.Builder
+			for _, arg := range args {
+				sb.WriteString(format(arg))
+			}
+			return sb.String(), nil
+		},
+	})
+	register(textFunction("lower", strings.ToLower))
+	register(textFunction("upper", strings.ToUpper))
+	register(textFunction("trim", strings.TrimSpace))
+
+	register(numberFunction("abs", math.Abs))
+	register(numberFunction("ceil", math.Ceil))
+	register(numberFunction("floor", math.Floor))
+	register(numberFunction("round", math.Round))
+
+	// date parts are extracted in UTC
+	register(dateFunction("year", func(t time.Time) int { return t.Year() }))
+	register(dateFunction("month", func(t time.Time) int { return int(t.Month()) }))
+	register(dateFunction("day", func(t time.Time) int { return t.Day() }))
+	register(dateFunction("hour", func(t time.Time) int { return t.Hour() }))
+	register(dateFunction("minute", func(t time.Time) int { return t.Minute() }))
+	register(dateFunction("weekday", func(t time.Time) int { return int(t.Weekday()) }))
+	register(dateFunction("dayOfYear", func(t time.Time) int { return t.YearDay() }))
+}
+
+// Functions returns the sorted names of all functions which can be used in
+// expressions
+func Functions() []string {
+	names := make([]string, 0, len(functions))
+	for name := range functions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func lookupFunction(name string, argc int) (*function, error) {
+	fn, ok := functions[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown function %q, available functions are %s",
+			name, strings.Join(Functions(), ", "))
+	}
+	if argc < fn.minArgs || (fn.maxArgs >= 0 && argc > fn.maxArgs) {
+		if fn.minArgs == fn.maxArgs {
+			return nil, fmt.Errorf("%s() takes %d argument(s), got %d", name, fn.minArgs, argc)
+		}
+		return nil, fmt.Errorf("%s() takes at least %d argument(s), got %d", name, fn.minArgs, argc)
+	}
+	return fn, nil
+}
+
+func textFunction(name string, fn func(string) string) *function {
+	return &function{
+		name: name, arg: TypeText, minArgs: 1, maxArgs: 1, result: TypeText,
+		eval: func(args []interface{}) (interface{}, error) {
+			s, ok := args[0].(string)
+			if !ok {
+				return nil, fmt.Errorf("%s(): expected text, got %T", name, args[0])
+			}
+			return fn(s), nil
+		},
+	}
+}
+
+func numberFunction(name string, fn func(float64) float64) *function {
+	return &function{
+		name: name, arg: TypeNumber, minArgs: 1, maxArgs: 1, result: TypeNumber,
+		eval: func(args []interface{}) (interface{}, error) {
+			f, ok := args[0].(float64)
+			if !ok {
+				return nil, fmt.Errorf("%s(): expected a number, got %T", name, args[0])
+			}
+			return fn(f), nil
+		},
+	}
+}
+
+func dateFunction(name string, fn func(time.Time) int) *function {
+	return &function{
+		name: name, arg: TypeDate, minArgs: 1, maxArgs: 1, result: TypeNumber,
+		eval: func(args []interface{}) (interface{}, error) {
+			t, ok := args[0].(time.Time)
+			if !ok {
+				return nil, fmt.Errorf("%s(): expected a date, got %T", name, args[0])
+			}
+			return float64(fn(t.UTC())), nil
+		},
+	}
+}
+
+// format returns the text representation of a value used by concat
+func format(v interface{}) string {
+	switch typed := v.(type) {
+	case string:
+		return typed
+	case float64:
+		return strconv.FormatFloat(typed, 'f', -1, 64)
+	case time.Time:
+		return typed.Format(time.RFC3339)
+	default:
+		return fmt.Sprint(v)
+	}
+}
@@ -0,0 +1,280 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package expression
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenNumber
+	tokenString
+	tokenIdent
+	tokenOperator
+	tokenLParen
+	tokenRParen
+	tokenComma
+)
+
+type token struct {
+	kind  tokenKind
+	text  string
+	value interface{}
+	pos   int
+}
+
+func (t token) String() string {
+	if t.kind == tokenEOF {
+		return "end of expression"
+	}
+	return fmt.Sprintf("%q at position %d", t.text, t.pos+1)
+}
+
+type lexer struct {
+	input []rune
+	pos   int
+}
+
+func newLexer(input string) *lexer {
+	return &lexer{input: []rune(input)}
+}
+
+func (l *lexer) next() (token, error) {
+	for l.pos < len(l.input) && unicode.IsSpace(l.input[l.pos]) {
+		l.pos++
+	}
+	if l.pos >= len(l.input) {
+		return token{kind: tokenEOF, pos: l.pos}, nil
+	}
+
+	start := l.pos
+	r := l.input[l.pos]
+	switch {
+	case r == '"' || r == '\'':
+		return l.string(r)
+	case unicode.IsDigit(r) || (r == '.' && l.pos+1 < len(l.input) && unicode.IsDigit(l.input[l.pos+1])):
+		for l.pos < len(l.input) && (unicode.IsDigit(l.input[l.pos]) || l.input[l.pos] == '.') {
+			l.pos++
+		}
+		text := string(l.input[start:l.pos])
+		f, err := strconv.ParseFloat(text, 64)
+		if err != nil {
+			return token{}, fmt.Errorf("invalid number %q at position %d", text, start+1)
+		}
+		return token{kind: tokenNumber, text: text, value: f, pos: start}, nil
+	case unicode.IsLetter(r) || r == '_':
+		for l.pos < len(l.input) && (unicode.IsLetter(l.input[l.pos]) ||
+			unicode.IsDigit(l.input[l.pos]) || l.input[l.pos] == '_') {
+			l.pos++
+		}
+		return token{kind: tokenIdent, text: string(l.input[start:l.pos]), pos: start}, nil
+	}
+
+	l.pos++
+	switch r {
+	case '+', '-', '*', '/', '%':
+		return token{kind: tokenOperator, text: string(r), pos: start}, nil
+	case '(':
+		return token{kind: tokenLParen, text: "(", pos: start}, nil
+	case ')':
+		return token{kind: tokenRParen, text: ")", pos: start}, nil
+	case ',':
+		return token{kind: tokenComma, text: ",", pos: start}, nil
+	default:
+		return token{}, fmt.Errorf("unexpected character %q at position %d", r, start+1)
+	}
+}
+
+// string reads a string literal enclosed in quote. A backslash escapes the
+// following character.
+func (l *lexer) string(quote rune) (token, error) {
+	start := l.pos
+	l.pos++
+
+	var sb strings.Builder
+	for l.pos < len(l.input) {
+		r := l.input[l.pos]
+		l.pos++
+		switch {
+		case r == '\\' && l.pos < len(l.input):
+			sb.WriteRune(l.input[l.pos])
+			l.pos++
+		case r == quote:
+			return token{
+				kind: tokenString, text: string(l.input[start:l.pos]),
+				value: sb.String(), pos: start,
+			}, nil
+		default:
+			sb.WriteRune(r)
+		}
+	}
+	return token{}, fmt.Errorf("unterminated string starting at position %d", start+1)
+}
+
+// parser is a recursive descent parser of the grammar
+//
+//	expr    = term { ("+" | "-") term }
+//	term    = unary { ("*" | "/" | "%") unary }
+//	unary   = "-" unary | primary
+//	primary = number | string | ident | ident "(" [ expr { "," expr } ] ")" | "(" expr ")"
+type parser struct {
+	lexer   *lexer
+	current token
+}
+
+func (p *parser) parse() (node, error) {
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+	if p.current.kind == tokenEOF {
+		return nil, fmt.Errorf("empty expression")
+	}
+
+	n, err := p.expr()
+	if err != nil {
+		return nil, err
+	}
+	if p.current.kind != tokenEOF {
+		return nil, fmt.Errorf("unexpected %s", p.current)
+	}
+	return n, nil
+}
+
+func (p *parser) advance() error {
+	t, err := p.lexer.next()
+	if err != nil {
+		return err
+	}
+	p.current = t
+	return nil
+}
+
+func (p *parser) expr() (node, error) {
+	return p.binary(p.term, "+-")
+}
+
+func (p *parser) term() (node, error) {
+	return p.binary(p.unary, "*/%")
+}
+
+func (p *parser) binary(operand func() (node, error), operators string) (node, error) {
+	left, err := operand()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.current.kind == tokenOperator && strings.Contains(operators, p.current.text) {
+		operator := p.current.text[0]
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		right, err := operand()
+		if err != nil {
+			return nil, err
+		}
+		left = &binary{operator: operator, left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) unary() (node, error) {
+	if p.current.kind == tokenOperator && p.current.text == "-" {
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		operand, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		return &unaryMinus{operand: operand}, nil
+	}
+	return p.primary()
+}
+
+func (p *parser) primary() (node, error) {
+	t := p.current
+	switch t.kind {
+	case tokenNumber, tokenString:
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		return &literal{value: t.value}, nil
+	case tokenIdent:
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		if p.current.kind == tokenLParen {
+			return p.call(t)
+		}
+		return &property{name: t.text}, nil
+	case tokenLParen:
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		n, err := p.expr()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(tokenRParen); err != nil {
+			return nil, err
+		}
+		return n, nil
+	default:
+		return nil, fmt.Errorf("unexpected %s", t)
+	}
+}
+
+func (p *parser) call(name token) (node, error) {
+	// skip the opening parenthesis
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+
+	var args []node
+	if p.current.kind != tokenRParen {
+		for {
+			arg, err := p.expr()
+			if err != nil {
+				return nil, err
+			}
+			args = append(args, arg)
+			if p.current.kind != tokenComma {
+				break
+			}
+			if err := p.advance(); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if err := p.expect(tokenRParen); err != nil {
+		return nil, err
+	}
+
+	fn, err := lookupFunction(name.text, len(args))
+	if err != nil {
+		return nil, err
+	}
+	return &call{fn: fn, args: args}, nil
+}
+
+func (p *parser) expect(kind tokenKind) error {
+	if p.current.kind != kind {
+		return fmt.Errorf("unexpected %s", p.current)
+	}
+	return p.advance()
+}
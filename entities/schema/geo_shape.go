@@ -0,0 +1,85 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/geometry"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// IsGeoShapeDataType returns true for the data types which are indexed as
+// geohash cells, i.e. geoPolygon and geoShape
+func IsGeoShapeDataType(dt DataType) bool {
+	return dt == DataTypeGeoPolygon || dt == DataTypeGeoShape
+}
+
+// ParseGeoShape turns the value of a geoPolygon or geoShape property into a
+// shape. The value is either the model itself or the map it is stored as.
+func ParseGeoShape(dt DataType, value interface{}) (geometry.Shape, error) {
+	if m, ok := value.(map[string]interface{}); ok {
+		b, err := json.Marshal(m)
+		if err != nil {
+			return geometry.Shape{}, err
+		}
+		switch dt {
+		case DataTypeGeoPolygon:
+			value = &models.GeoPolygon{}
+		case DataTypeGeoShape:
+			value = &models.GeoShape{}
+		}
+		if err := json.Unmarshal(b, value); err != nil {
+			return geometry.Shape{}, fmt.Errorf("invalid %s: %w", dt, err)
+		}
+	}
+
+	switch typed := value.(type) {
+	case *models.GeoPolygon:
+		return GeoPolygonShape(typed)
+	case *models.GeoShape:
+		points, err := geoPoints(typed.Coordinates)
+		if err != nil {
+			return geometry.Shape{}, err
+		}
+		shape := geometry.Shape{Kind: typed.Type, Points: points}
+		return shape, shape.Validate()
+	default:
+		return geometry.Shape{}, fmt.Errorf("invalid %s: unexpected value of type %T", dt, value)
+	}
+}
+
+// GeoPolygonShape turns a polygon, as used by the geoPolygon data type and by
+// the WithinPolygon and Intersects filters, into a shape
+func GeoPolygonShape(polygon *models.GeoPolygon) (geometry.Shape, error) {
+	if polygon == nil {
+		return geometry.Shape{}, fmt.Errorf("invalid geo polygon: no coordinates")
+	}
+	points, err := geoPoints(polygon.Coordinates)
+	if err != nil {
+		return geometry.Shape{}, err
+	}
+	shape := geometry.NewPolygon(points)
+	return shape, shape.Validate()
+}
+
+func geoPoints(coordinates []*models.GeoCoordinates) ([]geometry.Point, error) {
+	points := make([]geometry.Point, len(coordinates))
+	for i, c := range coordinates {
+		if c == nil || c.Latitude == nil || c.Longitude == nil {
+			return nil, fmt.Errorf("coordinate %d: latitude and longitude are required", i)
+		}
+		points[i] = geometry.Point{Lat: float64(*c.Latitude), Lon: float64(*c.Longitude)}
+	}
+	return points, nil
+}
@@ -0,0 +1,67 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/geometry"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestParseGeoShape(t *testing.T) {
+	ptr := func(f float32) *float32 { return &f }
+	coords := []*models.GeoCoordinates{
+		{Latitude: ptr(10), Longitude: ptr(10)},
+		{Latitude: ptr(10), Longitude: ptr(20)},
+		{Latitude: ptr(20), Longitude: ptr(20)},
+	}
+	expected := []geometry.Point{{Lat: 10, Lon: 10}, {Lat: 10, Lon: 20}, {Lat: 20, Lon: 20}}
+
+	t.Run("polygon model", func(t *testing.T) {
+		shape, err := ParseGeoShape(DataTypeGeoPolygon, &models.GeoPolygon{Coordinates: coords})
+		require.Nil(t, err)
+		assert.Equal(t, geometry.NewPolygon(expected), shape)
+	})
+
+	t.Run("stored polygon", func(t *testing.T) {
+		stored := map[string]interface{}{
+			"coordinates": []interface{}{
+				map[string]interface{}{"latitude": 10.0, "longitude": 10.0},
+				map[string]interface{}{"latitude": 10.0, "longitude": 20.0},
+				map[string]interface{}{"latitude": 20.0, "longitude": 20.0},
+			},
+		}
+		shape, err := ParseGeoShape(DataTypeGeoPolygon, stored)
+		require.Nil(t, err)
+		assert.Equal(t, geometry.NewPolygon(expected), shape)
+	})
+
+	t.Run("line string", func(t *testing.T) {
+		shape, err := ParseGeoShape(DataTypeGeoShape, &models.GeoShape{Type: "lineString", Coordinates: coords[:2]})
+		require.Nil(t, err)
+		assert.Equal(t, geometry.Shape{Kind: geometry.KindLineString, Points: expected[:2]}, shape)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := ParseGeoShape(DataTypeGeoPolygon, &models.GeoPolygon{Coordinates: coords[:2]})
+		assert.NotNil(t, err)
+		_, err = ParseGeoShape(DataTypeGeoShape, &models.GeoShape{Type: "point", Coordinates: coords})
+		assert.NotNil(t, err)
+		_, err = ParseGeoShape(DataTypeGeoShape, &models.GeoShape{Type: "point", Coordinates: []*models.GeoCoordinates{{}}})
+		assert.NotNil(t, err)
+		_, err = ParseGeoShape(DataTypeGeoShape, "polygon")
+		assert.NotNil(t, err)
+	})
+}
@@ -14,14 +14,19 @@ package schema
 import "github.com/weaviate/weaviate/entities/models"
 
 type InvertedIndexConfig struct {
-	BM25                BM25Config
-	Stopwords           models.StopwordConfig
-	IndexTimestamps     bool
-	IndexNullState      bool
-	IndexPropertyLength bool
+	BM25                       BM25Config
+	Stopwords                  models.StopwordConfig
+	IndexTimestamps            bool
+	IndexTimestampRangeFilters bool
+	IndexNullState             bool
+	IndexPropertyLength        bool
+	Compression                string
 }
 
 type BM25Config struct {
 	K1 float64
 	B  float64
+	// PropertyBoosts are the default weights of the properties in keyword
+	// searches, which do not set a weight for the property themselves
+	PropertyBoosts map[string]float64
 }
@@ -0,0 +1,61 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"fmt"
+	"net/netip"
+)
+
+// ParseIP parses the value of an ip property, which can be an IPv4 or an
+// IPv6 address. Zoned IPv6 addresses are rejected, as the zone only has a
+// meaning on the host the address was taken from.
+func ParseIP(value string) (netip.Addr, error) {
+	addr, err := netip.ParseAddr(value)
+	if err != nil {
+		return netip.Addr{}, fmt.Errorf("invalid ip address %q", value)
+	}
+	if addr.Zone() != "" {
+		return netip.Addr{}, fmt.Errorf("invalid ip address %q: zones are not supported", value)
+	}
+	return addr, nil
+}
+
+// IPBytes returns the 16 byte representation of addr. IPv4 addresses are
+// mapped into the IPv6 address space, so addresses of both versions can be
+// compared byte by byte.
+func IPBytes(addr netip.Addr) []byte {
+	b := addr.As16()
+	return b[:]
+}
+
+// CIDRRange returns the 16 byte representation of the first and the last
+// address within a CIDR block, such as "10.0.0.0/8" or "2001:db8::/32"
+func CIDRRange(cidr string) (first, last []byte, err error) {
+	prefix, err := netip.ParsePrefix(cidr)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid CIDR block %q", cidr)
+	}
+
+	bits := prefix.Bits()
+	if prefix.Addr().Is4() {
+		bits += 96
+	}
+
+	first = IPBytes(prefix.Masked().Addr())
+	last = make([]byte, len(first))
+	copy(last, first)
+	for i := bits; i < len(last)*8; i++ {
+		last[i/8] |= 1 << (7 - i%8)
+	}
+	return first, last, nil
+}
@@ -0,0 +1,77 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"bytes"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseIP(t *testing.T) {
+	for _, value := range []string{"192.168.0.1", "2001:db8::1", "::ffff:10.0.0.1"} {
+		t.Run(value, func(t *testing.T) {
+			addr, err := ParseIP(value)
+			require.Nil(t, err)
+			assert.Equal(t, netip.MustParseAddr(value), addr)
+		})
+	}
+
+	for _, value := range []string{"", "192.168.0.256", "10.0.0.0/8", "fe80::1%eth0"} {
+		t.Run(value, func(t *testing.T) {
+			_, err := ParseIP(value)
+			assert.NotNil(t, err)
+		})
+	}
+}
+
+func TestIPBytes(t *testing.T) {
+	v4 := IPBytes(netip.MustParseAddr("10.0.0.1"))
+	mapped := IPBytes(netip.MustParseAddr("::ffff:10.0.0.1"))
+
+	assert.Len(t, v4, 16)
+	assert.Equal(t, v4, mapped)
+	assert.Equal(t, -1, bytes.Compare(IPBytes(netip.MustParseAddr("10.0.0.1")),
+		IPBytes(netip.MustParseAddr("10.0.0.2"))))
+}
+
+func TestCIDRRange(t *testing.T) {
+	tests := []struct {
+		cidr  string
+		first string
+		last  string
+	}{
+		{cidr: "10.0.0.0/8", first: "10.0.0.0", last: "10.255.255.255"},
+		{cidr: "192.168.1.77/24", first: "192.168.1.0", last: "192.168.1.255"},
+		{cidr: "172.16.0.0/12", first: "172.16.0.0", last: "172.31.255.255"},
+		{cidr: "10.0.0.1/32", first: "10.0.0.1", last: "10.0.0.1"},
+		{cidr: "2001:db8::/32", first: "2001:db8::", last: "2001:db8:ffff:ffff:ffff:ffff:ffff:ffff"},
+		{cidr: "::/0", first: "::", last: "ffff:ffff:ffff:ffff:ffff:ffff:ffff:ffff"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.cidr, func(t *testing.T) {
+			first, last, err := CIDRRange(test.cidr)
+			require.Nil(t, err)
+			assert.Equal(t, IPBytes(netip.MustParseAddr(test.first)), first)
+			assert.Equal(t, IPBytes(netip.MustParseAddr(test.last)), last)
+		})
+	}
+
+	t.Run("invalid", func(t *testing.T) {
+		_, _, err := CIDRRange("10.0.0.0")
+		assert.NotNil(t, err)
+	})
+}
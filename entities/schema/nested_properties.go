@@ -0,0 +1,207 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"reflect"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// IsNested returns whether the data type describes a nested object, i.e.
+// object or object[]
+func IsNested(dt DataType) bool {
+	return dt == DataTypeObject || dt == DataTypeObjectArray
+}
+
+// IsNestedDataType is the []string equivalent of IsNested to be used
+// directly on the DataType of a property
+func IsNestedDataType(dt []string) bool {
+	for i := range dt {
+		if IsNested(DataType(dt[i])) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetNestedPropertyByName finds a nested property by its name. Names of
+// nested properties are case-sensitive
+func GetNestedPropertyByName(nestedProps []*models.NestedProperty,
+	name string,
+) *models.NestedProperty {
+	for _, np := range nestedProps {
+		if np.Name == name {
+			return np
+		}
+	}
+	return nil
+}
+
+// NewPrimitivePropertyDataType wraps a primitive or nested data type
+// into a PropertyDataType
+func NewPrimitivePropertyDataType(dt DataType) PropertyDataType {
+	return &propertyDataType{
+		kind:          PropertyKindPrimitive,
+		primitiveType: dt,
+	}
+}
+
+// NestedLeafProperties returns the nested properties of an object/object[]
+// property which can be indexed, flattened into properties of their own. A
+// leaf is named by its dotted path within the property, e.g. "address.city".
+// Leaves below an object[] hold the values of all elements, so their data
+// type is the array variant of the nested property's data type. Leaves
+// inherit the filterable index of the property, they are never searchable.
+func NestedLeafProperties(prop *models.Property) []*models.Property {
+	if !IsNestedDataType(prop.DataType) {
+		return nil
+	}
+
+	var leaves []*models.Property
+	appendNestedLeafProperties(&leaves, prop, prop.Name,
+		DataType(prop.DataType[0]) == DataTypeObjectArray, prop.NestedProperties)
+	return leaves
+}
+
+func appendNestedLeafProperties(leaves *[]*models.Property, prop *models.Property,
+	path string, inArray bool, nestedProps []*models.NestedProperty,
+) {
+	vFalse := false
+	for _, np := range nestedProps {
+		if np == nil || len(np.DataType) == 0 {
+			continue
+		}
+
+		dt := DataType(np.DataType[0])
+		if IsNested(dt) {
+			appendNestedLeafProperties(leaves, prop, path+"."+np.Name,
+				inArray || dt == DataTypeObjectArray, np.NestedProperties)
+			continue
+		}
+
+		leafType, ok := nestedLeafDataType(dt, inArray)
+		if !ok {
+			continue
+		}
+
+		*leaves = append(*leaves, &models.Property{
+			Name:            path + "." + np.Name,
+			DataType:        []string{leafType.String()},
+			Tokenization:    np.Tokenization,
+			IndexFilterable: prop.IndexFilterable,
+			IndexSearchable: &vFalse,
+		})
+	}
+}
+
+// nestedLeafDataType returns the data type of a leaf for the data type of a
+// nested property, only primitive types and their arrays can be indexed
+func nestedLeafDataType(dt DataType, inArray bool) (DataType, bool) {
+	switch dt {
+	case DataTypeText, DataTypeTextArray:
+		return pickNestedLeafDataType(dt, DataTypeTextArray, inArray), true
+	case DataTypeInt, DataTypeIntArray:
+		return pickNestedLeafDataType(dt, DataTypeIntArray, inArray), true
+	case DataTypeNumber, DataTypeNumberArray:
+		return pickNestedLeafDataType(dt, DataTypeNumberArray, inArray), true
+	case DataTypeBoolean, DataTypeBooleanArray:
+		return pickNestedLeafDataType(dt, DataTypeBooleanArray, inArray), true
+	case DataTypeDate, DataTypeDateArray:
+		return pickNestedLeafDataType(dt, DataTypeDateArray, inArray), true
+	case DataTypeUUID, DataTypeUUIDArray:
+		return pickNestedLeafDataType(dt, DataTypeUUIDArray, inArray), true
+	default:
+		return "", false
+	}
+}
+
+func pickNestedLeafDataType(dt, arrayDt DataType, inArray bool) DataType {
+	if inArray {
+		return arrayDt
+	}
+	return dt
+}
+
+// GetNestedLeafProperty finds the leaf of a nested property by its dotted
+// path, see NestedLeafProperties
+func GetNestedLeafProperty(prop *models.Property, path string) *models.Property {
+	for _, leaf := range NestedLeafProperties(prop) {
+		if leaf.Name == path {
+			return leaf
+		}
+	}
+	return nil
+}
+
+// FlattenNestedValue maps the value of an object/object[] property to the
+// values of its leaves, see NestedLeafProperties. Leaves without a value are
+// omitted.
+func FlattenNestedValue(prop *models.Property, value interface{}) map[string]interface{} {
+	out := map[string]interface{}{}
+	if !IsNestedDataType(prop.DataType) {
+		return out
+	}
+
+	inArray := DataType(prop.DataType[0]) == DataTypeObjectArray
+	flattenNestedValue(out, prop.Name, inArray, prop.NestedProperties, value)
+	return out
+}
+
+func flattenNestedValue(out map[string]interface{}, path string, inArray bool,
+	nestedProps []*models.NestedProperty, value interface{},
+) {
+	if elems, ok := value.([]interface{}); ok {
+		for _, elem := range elems {
+			flattenNestedValue(out, path, inArray, nestedProps, elem)
+		}
+		return
+	}
+
+	obj, ok := value.(map[string]interface{})
+	if !ok {
+		return
+	}
+
+	for _, np := range nestedProps {
+		if np == nil || len(np.DataType) == 0 {
+			continue
+		}
+		nested, ok := obj[np.Name]
+		if !ok || nested == nil {
+			continue
+		}
+
+		leafPath := path + "." + np.Name
+		dt := DataType(np.DataType[0])
+		if IsNested(dt) {
+			flattenNestedValue(out, leafPath, inArray || dt == DataTypeObjectArray,
+				np.NestedProperties, nested)
+			continue
+		}
+
+		if !inArray {
+			out[leafPath] = nested
+			continue
+		}
+
+		existing, _ := out[leafPath].([]interface{})
+		if rv := reflect.ValueOf(nested); rv.Kind() == reflect.Slice {
+			for i := 0; i < rv.Len(); i++ {
+				existing = append(existing, rv.Index(i).Interface())
+			}
+			out[leafPath] = existing
+		} else {
+			out[leafPath] = append(existing, nested)
+		}
+	}
+}
@@ -0,0 +1,126 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestNestedLeafProperties(t *testing.T) {
+	vFalse := false
+	prop := &models.Property{
+		Name:            "address",
+		DataType:        DataTypeObject.PropString(),
+		IndexFilterable: &vFalse,
+		NestedProperties: []*models.NestedProperty{
+			{Name: "city", DataType: DataTypeText.PropString(), Tokenization: "field"},
+			{Name: "position", DataType: DataTypeGeoCoordinates.PropString()},
+			{
+				Name:     "residents",
+				DataType: DataTypeObjectArray.PropString(),
+				NestedProperties: []*models.NestedProperty{
+					{Name: "name", DataType: DataTypeText.PropString(), Tokenization: "word"},
+					{Name: "ages", DataType: DataTypeIntArray.PropString()},
+				},
+			},
+		},
+	}
+
+	leaves := NestedLeafProperties(prop)
+	require.Len(t, leaves, 3)
+
+	assert.Equal(t, "address.city", leaves[0].Name)
+	assert.Equal(t, DataTypeText.PropString(), leaves[0].DataType)
+	assert.Equal(t, "field", leaves[0].Tokenization)
+	assert.Equal(t, "address.residents.name", leaves[1].Name)
+	assert.Equal(t, DataTypeTextArray.PropString(), leaves[1].DataType)
+	assert.Equal(t, "address.residents.ages", leaves[2].Name)
+	assert.Equal(t, DataTypeIntArray.PropString(), leaves[2].DataType)
+	for _, leaf := range leaves {
+		assert.False(t, *leaf.IndexFilterable)
+		assert.False(t, *leaf.IndexSearchable)
+	}
+
+	assert.Nil(t, NestedLeafProperties(&models.Property{
+		Name: "city", DataType: DataTypeText.PropString(),
+	}))
+	assert.Nil(t, GetNestedLeafProperty(prop, "address.position"))
+	assert.Equal(t, leaves[1], GetNestedLeafProperty(prop, "address.residents.name"))
+}
+
+func TestFlattenNestedValue(t *testing.T) {
+	prop := &models.Property{
+		Name:     "visits",
+		DataType: DataTypeObjectArray.PropString(),
+		NestedProperties: []*models.NestedProperty{
+			{Name: "city", DataType: DataTypeText.PropString()},
+			{Name: "tags", DataType: DataTypeTextArray.PropString()},
+			{
+				Name:     "guide",
+				DataType: DataTypeObject.PropString(),
+				NestedProperties: []*models.NestedProperty{
+					{Name: "name", DataType: DataTypeText.PropString()},
+				},
+			},
+		},
+	}
+
+	value := []interface{}{
+		map[string]interface{}{
+			"city":  "Berlin",
+			"tags":  []string{"work", "rain"},
+			"guide": map[string]interface{}{"name": "Anna"},
+		},
+		map[string]interface{}{
+			"city": "Paris",
+			"tags": []interface{}{"holiday"},
+		},
+	}
+
+	assert.Equal(t, map[string]interface{}{
+		"visits.city":       []interface{}{"Berlin", "Paris"},
+		"visits.tags":       []interface{}{"work", "rain", "holiday"},
+		"visits.guide.name": []interface{}{"Anna"},
+	}, FlattenNestedValue(prop, value))
+}
+
+func TestGetPropertyOfNestedLeaf(t *testing.T) {
+	sch := Schema{Objects: &models.Schema{Classes: []*models.Class{{
+		Class: "City",
+		Properties: []*models.Property{
+			{
+				Name:     "address",
+				DataType: DataTypeObject.PropString(),
+				NestedProperties: []*models.NestedProperty{
+					{Name: "street", DataType: DataTypeText.PropString()},
+				},
+			},
+			{Name: "name", DataType: DataTypeText.PropString()},
+		},
+	}}}}
+
+	prop, err := sch.GetProperty("City", "address.street")
+	require.Nil(t, err)
+	assert.Equal(t, "address.street", prop.Name)
+	assert.Equal(t, DataTypeText.PropString(), prop.DataType)
+
+	prop, err = sch.GetProperty("City", "address")
+	require.Nil(t, err)
+	assert.Equal(t, "address", prop.Name)
+
+	_, err = sch.GetProperty("City", "address.number")
+	assert.NotNil(t, err)
+}
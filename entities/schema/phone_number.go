@@ -0,0 +1,53 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ParsePhoneNumber returns the parsed phone number of a phoneNumber property.
+// The value is either the model itself or the map it is stored as.
+func ParsePhoneNumber(value interface{}) (*models.PhoneNumber, error) {
+	switch typed := value.(type) {
+	case *models.PhoneNumber:
+		return typed, nil
+	case map[string]interface{}:
+		b, err := json.Marshal(typed)
+		if err != nil {
+			return nil, err
+		}
+		phone := &models.PhoneNumber{}
+		if err := json.Unmarshal(b, phone); err != nil {
+			return nil, fmt.Errorf("invalid phoneNumber: %w", err)
+		}
+		return phone, nil
+	default:
+		return nil, fmt.Errorf("invalid phoneNumber: unexpected value of type %T", value)
+	}
+}
+
+// PhoneNumberRepresentations returns the parsed representations a phone
+// number can be filtered by, i.e. its international and its national format
+func PhoneNumberRepresentations(phone *models.PhoneNumber) []string {
+	var out []string
+	for _, repr := range []string{phone.InternationalFormatted, phone.NationalFormatted} {
+		if repr == "" || (len(out) > 0 && out[0] == repr) {
+			continue
+		}
+		out = append(out, repr)
+	}
+	return out
+}
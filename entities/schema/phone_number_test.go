@@ -0,0 +1,70 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestParsePhoneNumber(t *testing.T) {
+	expected := &models.PhoneNumber{
+		Input:                  "0171 1234567",
+		DefaultCountry:         "DE",
+		CountryCode:            49,
+		National:               1711234567,
+		NationalFormatted:      "0171 1234567",
+		InternationalFormatted: "+49 171 1234567",
+		Valid:                  true,
+	}
+
+	t.Run("from the model", func(t *testing.T) {
+		phone, err := ParsePhoneNumber(expected)
+		require.Nil(t, err)
+		assert.Equal(t, expected, phone)
+	})
+
+	t.Run("from the stored map", func(t *testing.T) {
+		phone, err := ParsePhoneNumber(map[string]interface{}{
+			"input":                  "0171 1234567",
+			"defaultCountry":         "DE",
+			"countryCode":            float64(49),
+			"national":               float64(1711234567),
+			"nationalFormatted":      "0171 1234567",
+			"internationalFormatted": "+49 171 1234567",
+			"valid":                  true,
+		})
+		require.Nil(t, err)
+		assert.Equal(t, expected, phone)
+	})
+
+	t.Run("of wrong type", func(t *testing.T) {
+		_, err := ParsePhoneNumber("+49 171 1234567")
+		assert.NotNil(t, err)
+	})
+}
+
+func TestPhoneNumberRepresentations(t *testing.T) {
+	assert.Equal(t, []string{"+49 171 1234567", "0171 1234567"},
+		PhoneNumberRepresentations(&models.PhoneNumber{
+			InternationalFormatted: "+49 171 1234567",
+			NationalFormatted:      "0171 1234567",
+		}))
+	assert.Equal(t, []string{"+800 1234 5678"},
+		PhoneNumberRepresentations(&models.PhoneNumber{
+			InternationalFormatted: "+800 1234 5678",
+			NationalFormatted:      "+800 1234 5678",
+		}))
+}
@@ -0,0 +1,80 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// IsSparseVectorDataType returns true for the data type which is indexed in a
+// sparse vector index
+func IsSparseVectorDataType(dt []string) bool {
+	return len(dt) == 1 && DataType(dt[0]) == DataTypeSparseVector
+}
+
+// ParseSparseVector turns the value of a sparseVector property into a sparse
+// vector and validates it. The value is either the model itself or the map
+// it is stored as.
+func ParseSparseVector(value interface{}) (*models.SparseVector, error) {
+	if m, ok := value.(map[string]interface{}); ok {
+		b, err := json.Marshal(m)
+		if err != nil {
+			return nil, err
+		}
+		var parsed models.SparseVector
+		if err := json.Unmarshal(b, &parsed); err != nil {
+			return nil, fmt.Errorf("invalid sparse vector: %w", err)
+		}
+		value = &parsed
+	}
+
+	vector, ok := value.(*models.SparseVector)
+	if !ok {
+		return nil, fmt.Errorf("invalid sparse vector: unexpected value of type %T", value)
+	}
+	if err := ValidateSparseVector(vector.Indices, vector.Values); err != nil {
+		return nil, err
+	}
+	return vector, nil
+}
+
+// ValidateSparseVector checks that every index is a unique uint32 and has a
+// finite value. The values must not be negative, which lets the sparse vector
+// index bound the scores of its blocks by their maximum value.
+func ValidateSparseVector(indices []int64, values []float32) error {
+	if len(indices) != len(values) {
+		return fmt.Errorf("invalid sparse vector: %d indices, but %d values",
+			len(indices), len(values))
+	}
+
+	seen := make(map[int64]struct{}, len(indices))
+	for i, index := range indices {
+		if index < 0 || index > math.MaxUint32 {
+			return fmt.Errorf("invalid sparse vector: index %d out of range", index)
+		}
+		if _, ok := seen[index]; ok {
+			return fmt.Errorf("invalid sparse vector: duplicate index %d", index)
+		}
+		seen[index] = struct{}{}
+
+		value := float64(values[i])
+		if math.IsNaN(value) || math.IsInf(value, 0) || value < 0 {
+			return fmt.Errorf("invalid sparse vector: value of index %d must be "+
+				"a finite, non-negative number", index)
+		}
+	}
+	return nil
+}
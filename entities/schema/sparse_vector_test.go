@@ -0,0 +1,59 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestParseSparseVector(t *testing.T) {
+	expected := &models.SparseVector{Indices: []int64{3, 17, 2048}, Values: []float32{0.5, 1.25, 2}}
+
+	t.Run("model", func(t *testing.T) {
+		vector, err := ParseSparseVector(expected)
+		require.Nil(t, err)
+		assert.Equal(t, expected, vector)
+	})
+
+	t.Run("stored", func(t *testing.T) {
+		stored := map[string]interface{}{
+			"indices": []interface{}{3.0, 17.0, 2048.0},
+			"values":  []interface{}{0.5, 1.25, 2.0},
+		}
+		vector, err := ParseSparseVector(stored)
+		require.Nil(t, err)
+		assert.Equal(t, expected, vector)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, vector := range []*models.SparseVector{
+			{Indices: []int64{1, 2}, Values: []float32{1}},
+			{Indices: []int64{-1}, Values: []float32{1}},
+			{Indices: []int64{math.MaxUint32 + 1}, Values: []float32{1}},
+			{Indices: []int64{4, 4}, Values: []float32{1, 2}},
+			{Indices: []int64{4}, Values: []float32{-1}},
+			{Indices: []int64{4}, Values: []float32{float32(math.NaN())}},
+			{Indices: []int64{4}, Values: []float32{float32(math.Inf(1))}},
+		} {
+			_, err := ParseSparseVector(vector)
+			assert.NotNil(t, err, "%v", vector)
+		}
+
+		_, err := ParseSparseVector("sparse")
+		assert.NotNil(t, err)
+	})
+}
@@ -0,0 +1,64 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// VectorizerNone is the vectorizer of a named vector space, which expects the
+// user to provide its vectors at import time
+const VectorizerNone = "none"
+
+// TargetVectorNames returns the sorted names of the named vector spaces of
+// the class
+func TargetVectorNames(class *models.Class) []string {
+	names := make([]string, 0, len(class.VectorConfig))
+	for name := range class.VectorConfig {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// TargetVectorizer returns the name of the vectorizer module of a named
+// vector space along with the module's config. The vectorizer config must
+// contain exactly one entry, keyed by the module name. No vectorizer at all
+// is identical to "none".
+func TargetVectorizer(cfg models.VectorConfig) (string, map[string]interface{}, error) {
+	if cfg.Vectorizer == nil {
+		return VectorizerNone, map[string]interface{}{}, nil
+	}
+
+	asMap, ok := cfg.Vectorizer.(map[string]interface{})
+	if !ok || len(asMap) != 1 {
+		return "", nil, fmt.Errorf("vectorizer must be an object with exactly one "+
+			"entry keyed by the module name, got %v", cfg.Vectorizer)
+	}
+
+	for name, modCfg := range asMap {
+		if modCfg == nil {
+			return name, map[string]interface{}{}, nil
+		}
+		asModCfg, ok := modCfg.(map[string]interface{})
+		if !ok {
+			return "", nil, fmt.Errorf("config of vectorizer %q must be an object, got %T",
+				name, modCfg)
+		}
+		return name, asModCfg, nil
+	}
+
+	return "", nil, nil
+}
@@ -0,0 +1,72 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestTargetVectorNames(t *testing.T) {
+	class := &models.Class{
+		VectorConfig: map[string]models.VectorConfig{
+			"title_vec": {},
+			"image_vec": {},
+		},
+	}
+	assert.Equal(t, []string{"image_vec", "title_vec"}, TargetVectorNames(class))
+	assert.Empty(t, TargetVectorNames(&models.Class{}))
+}
+
+func TestTargetVectorizer(t *testing.T) {
+	t.Run("no vectorizer", func(t *testing.T) {
+		name, cfg, err := TargetVectorizer(models.VectorConfig{})
+		require.Nil(t, err)
+		assert.Equal(t, VectorizerNone, name)
+		assert.Empty(t, cfg)
+	})
+
+	t.Run("module with config", func(t *testing.T) {
+		name, cfg, err := TargetVectorizer(models.VectorConfig{
+			Vectorizer: map[string]interface{}{
+				"text2vec-contextionary": map[string]interface{}{"vectorizeClassName": false},
+			},
+		})
+		require.Nil(t, err)
+		assert.Equal(t, "text2vec-contextionary", name)
+		assert.Equal(t, map[string]interface{}{"vectorizeClassName": false}, cfg)
+	})
+
+	t.Run("module without config", func(t *testing.T) {
+		name, cfg, err := TargetVectorizer(models.VectorConfig{
+			Vectorizer: map[string]interface{}{"none": nil},
+		})
+		require.Nil(t, err)
+		assert.Equal(t, "none", name)
+		assert.Empty(t, cfg)
+	})
+
+	t.Run("more than one module", func(t *testing.T) {
+		_, _, err := TargetVectorizer(models.VectorConfig{
+			Vectorizer: map[string]interface{}{"a": nil, "b": nil},
+		})
+		assert.NotNil(t, err)
+	})
+
+	t.Run("not an object", func(t *testing.T) {
+		_, _, err := TargetVectorizer(models.VectorConfig{Vectorizer: "none"})
+		assert.NotNil(t, err)
+	})
+}
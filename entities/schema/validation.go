@@ -19,6 +19,7 @@ import (
 var (
 	validateClassNameRegex    *regexp.Regexp
 	validatePropertyNameRegex *regexp.Regexp
+	validateTargetVectorRegex *regexp.Regexp
 	reservedPropertyNames     []string
 )
 
@@ -30,6 +31,7 @@ const (
 func init() {
 	validateClassNameRegex = regexp.MustCompile(`^` + ClassNameRegexCore + `$`)
 	validatePropertyNameRegex = regexp.MustCompile(`^[_A-Za-z][_0-9A-Za-z]*$`)
+	validateTargetVectorRegex = regexp.MustCompile(`^[_A-Za-z][_0-9A-Za-z]{0,63}$`)
 	reservedPropertyNames = []string{"_additional", "_id", "id"}
 }
 
@@ -51,6 +53,16 @@ func ValidatePropertyName(name string) (PropertyName, error) {
 		"which must be “/[_A-Za-z][_0-9A-Za-z]*/”.", name)
 }
 
+// ValidateTargetVectorName validates that this string is a valid name for a
+// named vector space
+func ValidateTargetVectorName(name string) error {
+	if validateTargetVectorRegex.MatchString(name) {
+		return nil
+	}
+	return fmt.Errorf("'%s' is not a valid target vector name. "+
+		"Target vector names must match “/[_A-Za-z][_0-9A-Za-z]{0,63}/”.", name)
+}
+
 // ValidateReservedPropertyName validates that a string is not a reserved property name
 func ValidateReservedPropertyName(name string) error {
 	for i := range reservedPropertyNames {
@@ -12,6 +12,7 @@
 package schema
 
 import (
+	"strings"
 	"testing"
 )
 
@@ -149,6 +150,20 @@ func TestFailValidateBadPropertyName(t *testing.T) {
 	}
 }
 
+func TestValidateTargetVectorName(t *testing.T) {
+	for _, name := range []string{"title_vec", "_image", "V2"} {
+		if err := ValidateTargetVectorName(name); err != nil {
+			t.Errorf("expected %q to be valid: %v", name, err)
+		}
+	}
+
+	for _, name := range []string{"", "title-vec", "1vec", "../vec", strings.Repeat("a", 65)} {
+		if err := ValidateTargetVectorName(name); err == nil {
+			t.Errorf("expected %q to be invalid", name)
+		}
+	}
+}
+
 func TestValidateReservedPropertyName(t *testing.T) {
 	type args struct {
 		name string
@@ -27,6 +27,7 @@ type Result struct {
 	ExplainScore         string
 	Dist                 float32
 	Vector               []float32
+	Vectors              map[string][]float32
 	Beacon               string
 	Certainty            float32
 	Schema               models.PropertySchema
@@ -69,6 +70,12 @@ func (r Result) ObjectWithVector(includeVector bool) *models.Object {
 
 	if includeVector {
 		t.Vector = r.Vector
+		if len(r.Vectors) > 0 {
+			t.Vectors = make(models.Vectors, len(r.Vectors))
+			for name, vec := range r.Vectors {
+				t.Vectors[name] = vec
+			}
+		}
 	}
 
 	return t
@@ -73,6 +73,25 @@ func (sp SelectProperties) HasRefs() bool {
 	return false
 }
 
+// RefDepth returns the number of cross-reference levels that need to be
+// resolved for this selection, 0 if only primitive props are selected
+func (sp SelectProperties) RefDepth() int {
+	depth := 0
+	for _, p := range sp {
+		if p.IsPrimitive {
+			continue
+		}
+
+		for _, selectClass := range p.Refs {
+			if refDepth := 1 + selectClass.RefProperties.RefDepth(); refDepth > depth {
+				depth = refDepth
+			}
+		}
+	}
+
+	return depth
+}
+
 func (sp SelectProperties) ShouldResolve(path []string) (bool, error) {
 	if len(path)%2 != 0 || len(path) == 0 {
 		return false, fmt.Errorf("used incorrectly: path must have even number of segments in the form of " +
@@ -0,0 +1,83 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package searchparams
+
+import (
+	"fmt"
+	"math"
+)
+
+// Rescore overrides how a compressed vector index rescores its candidates
+// for a single query. Zero values fall back to the settings of the index.
+type Rescore struct {
+	// Limit is the number of candidates which are rescored with the
+	// uncompressed vectors
+	Limit int `json:"limit"`
+	// Oversampling multiplies the requested limit to retrieve more candidates
+	// from the compressed index before rescoring
+	Oversampling float64 `json:"oversampling"`
+}
+
+func (r *Rescore) Validate() error {
+	if r == nil {
+		return nil
+	}
+
+	if r.Limit < 0 {
+		return fmt.Errorf("rescoreLimit must be 0 (index default) or a positive integer, got %d",
+			r.Limit)
+	}
+
+	if r.Oversampling != 0 && r.Oversampling < 1 {
+		return fmt.Errorf("oversampling must be 0 (index default) or at least 1, got %v",
+			r.Oversampling)
+	}
+
+	return nil
+}
+
+// Candidates returns how many candidates should be retrieved from the
+// compressed index for a query with limit k
+func (r *Rescore) Candidates(k int) int {
+	if r == nil || r.Oversampling <= 1 {
+		return k
+	}
+
+	return int(math.Ceil(float64(k) * r.Oversampling))
+}
+
+// RescoreLimit returns the override for the rescore limit combined with the
+// oversampled candidates, or fallback if the query does not override it
+func (r *Rescore) RescoreLimit(k, fallback int) int {
+	if r == nil {
+		return fallback
+	}
+
+	limit := fallback
+	if r.Limit > 0 {
+		limit = r.Limit
+	}
+	if candidates := r.Candidates(k); candidates > limit {
+		limit = candidates
+	}
+
+	return limit
+}
+
+// GetRescore returns the rescore overrides of the nearVector search, or nil
+// if the index settings apply
+func (n NearVector) GetRescore() *Rescore {
+	if n.RescoreLimit == 0 && n.Oversampling == 0 {
+		return nil
+	}
+	return &Rescore{Limit: n.RescoreLimit, Oversampling: n.Oversampling}
+}
@@ -16,6 +16,10 @@ type NearVector struct {
 	Certainty    float64   `json:"certainty"`
 	Distance     float64   `json:"distance"`
 	WithDistance bool      `json:"-"`
+	TargetVector string    `json:"targetVector"`
+	RescoreLimit int       `json:"rescoreLimit"`
+	Oversampling float64   `json:"oversampling"`
+	Exact        bool      `json:"exact"`
 }
 
 type KeywordRanking struct {
@@ -23,6 +27,24 @@ type KeywordRanking struct {
 	Properties             []string `json:"properties"`
 	Query                  string   `json:"query"`
 	AdditionalExplanations bool     `json:"additionalExplanations"`
+	// Fuzzy is the edit distance within which terms of the properties match
+	// the terms of the query, 0 matches them exactly
+	Fuzzy int `json:"fuzzy,omitempty"`
+	// Highlight is set if the matching terms should be marked in fragments
+	// of the searched properties
+	Highlight *Highlight `json:"highlight,omitempty"`
+	// SparseVector is the query of keyword rankings of type "sparseVector",
+	// which rank by the sparse vector index of a single property
+	SparseVector *NearSparseVector `json:"sparseVector,omitempty"`
+}
+
+// Highlight configures the fragments returned for the results of keyword
+// searches. Unset values fall back to the defaults of the searcher.
+type Highlight struct {
+	// FragmentSize is the maximum number of characters of a fragment
+	FragmentSize int    `json:"fragmentSize,omitempty"`
+	PreTag       string `json:"preTag,omitempty"`
+	PostTag      string `json:"postTag,omitempty"`
 }
 
 type WeightedSearchResult struct {
@@ -36,9 +58,36 @@ type HybridSearch struct {
 	Type            string      `json:"type"`
 	Alpha           float64     `json:"alpha"`
 	Query           string      `json:"query"`
+	KeywordQuery    string      `json:"keywordQuery,omitempty"`
+	VectorQuery     string      `json:"vectorQuery,omitempty"`
 	Vector          []float32   `json:"vector"`
 	Properties      []string    `json:"properties"`
 	FusionAlgorithm int         `json:"fusionalgorithm"`
+	RankConstant    int         `json:"rankConstant,omitempty"`
+	Exact           bool        `json:"exact"`
+	Highlight       *Highlight  `json:"highlight,omitempty"`
+	// SparseVector replaces the keyword search with a search of the sparse
+	// vector index of a property
+	SparseVector *NearSparseVector `json:"sparseVector,omitempty"`
+}
+
+// GetKeywordQuery returns the text of the keyword search, which defaults to
+// the query if no distinct text was set for the keyword search
+func (h HybridSearch) GetKeywordQuery() string {
+	if h.KeywordQuery != "" {
+		return h.KeywordQuery
+	}
+	return h.Query
+}
+
+// GetVectorQuery returns the text which is vectorized for the vector search,
+// which defaults to the query if no distinct text was set for the vector
+// search
+func (h HybridSearch) GetVectorQuery() string {
+	if h.VectorQuery != "" {
+		return h.VectorQuery
+	}
+	return h.Query
 }
 
 type NearObject struct {
@@ -47,6 +96,7 @@ type NearObject struct {
 	Certainty    float64 `json:"certainty"`
 	Distance     float64 `json:"distance"`
 	WithDistance bool    `json:"-"`
+	TargetVector string  `json:"targetVector"`
 }
 
 type ObjectMove struct {
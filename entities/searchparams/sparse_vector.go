@@ -0,0 +1,36 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package searchparams
+
+import (
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// NearSparseVector ranks objects by the dot product of their sparse vector in
+// Property with the query vector given by Indices and Values
+type NearSparseVector struct {
+	Property string    `json:"property"`
+	Indices  []int64   `json:"indices"`
+	Values   []float32 `json:"values"`
+}
+
+func (n *NearSparseVector) Validate() error {
+	if n.Property == "" {
+		return fmt.Errorf("nearSparseVector requires a property")
+	}
+	if len(n.Indices) == 0 {
+		return fmt.Errorf("nearSparseVector requires at least one index")
+	}
+	return schema.ValidateSparseVector(n.Indices, n.Values)
+}
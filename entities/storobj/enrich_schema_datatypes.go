@@ -17,86 +17,185 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
 )
 
-func (ko *Object) enrichSchemaTypes(schema map[string]interface{}) error {
-	if schema == nil {
+// enrichSchemaTypes restores the types which are lost in the json
+// representation of the properties. If the class is known, the value of each
+// property is decoded according to its data type. Otherwise, the type has to
+// be guessed from the shape of the value.
+func (ko *Object) enrichSchemaTypes(props map[string]interface{},
+	class *models.Class,
+) error {
+	if props == nil {
 		return nil
 	}
 
-	for propName, value := range schema {
-		switch typed := value.(type) {
-		case []interface{}:
-			if isArrayValue(typed) {
-				switch typed[0].(type) {
-				case float64:
-					parsed, err := parseNumberArrayValue(typed)
-					if err != nil {
-						return errors.Wrapf(err, "property %q of type string array", propName)
-					}
-
-					schema[propName] = parsed
-				case bool:
-					parsed, err := parseBoolArrayValue(typed)
-					if err != nil {
-						return errors.Wrapf(err, "property %q of type boolean array", propName)
-					}
-
-					schema[propName] = parsed
-				default:
-					parsed, err := parseStringArrayValue(typed)
-					if err != nil {
-						return errors.Wrapf(err, "property %q of type string array", propName)
-					}
-
-					schema[propName] = parsed
-				}
-			} else if len(typed) == 0 {
-				// empty arrays. Here we use []interface{} as a placeholder
-				// type for an empty array, since we cannot determine its
-				// actual type. in the future, we should persist the schema
-				// property type information alongside the value to avoid
-				// this situation
-				schema[propName] = typed
-			} else {
-				parsed, err := parseCrossRef(typed)
+	for propName, value := range props {
+		if class != nil {
+			if prop, err := schema.GetPropertyByName(class, propName); err == nil {
+				parsed, err := parsePropValue(prop, value)
 				if err != nil {
-					return errors.Wrapf(err, "property %q of type cross-ref", propName)
+					return errors.Wrapf(err, "property %q of type %v", propName, prop.DataType)
 				}
 
-				schema[propName] = parsed
-			}
-		case map[string]interface{}:
-			parsed, err := parseMapProp(typed)
-			if err != nil {
-				return errors.Wrapf(err, "property %q of type map", propName)
+				props[propName] = parsed
+				continue
 			}
+		}
 
-			schema[propName] = parsed
-		default:
-			continue
+		parsed, err := guessPropValue(propName, value)
+		if err != nil {
+			return err
 		}
+
+		props[propName] = parsed
 	}
 
 	return nil
 }
 
-func parseMapProp(input map[string]interface{}) (interface{}, error) {
+// parsePropValue decodes the value based on the data type of the property.
+// Only the types which cannot be told apart by the shape of their value are
+// handled explicitly, all others are decoded like a value of an unknown
+// property.
+func parsePropValue(prop *models.Property, value interface{}) (interface{}, error) {
+	switch {
+	case schema.IsNestedDataType(prop.DataType):
+		// nested objects are stored as they are, their nested properties may
+		// use any key, including the ones of geo coordinates or phone numbers
+		return value, nil
+	case schema.IsRefDataType(prop.DataType):
+		typed, ok := value.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("expected cross-ref to be array, but is %T", value)
+		}
+		if len(typed) == 0 {
+			// empty arrays are kept as they are, just like for unknown
+			// properties
+			return typed, nil
+		}
+		return parseCrossRef(typed)
+	}
+
+	switch schema.DataType(prop.DataType[0]) {
+	case schema.DataTypeGeoCoordinates:
+		typed, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("expected geo coordinates to be map, but is %T", value)
+		}
+		return parseGeoProp(typed["latitude"], typed["longitude"])
+	case schema.DataTypePhoneNumber:
+		typed, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("expected phone number to be map, but is %T", value)
+		}
+		return parsePhoneNumber(typed)
+	case schema.DataTypeGeoPolygon, schema.DataTypeGeoShape, schema.DataTypeSparseVector:
+		return value, nil
+	default:
+		return guessPropValue(prop.Name, value)
+	}
+}
+
+// guessPropValue decodes the value of a property whose data type is not
+// known, e.g. because the class is not at hand when the object is unmarshalled
+func guessPropValue(propName string, value interface{}) (interface{}, error) {
+	switch typed := value.(type) {
+	case []interface{}:
+		if isArrayValue(typed) {
+			switch typed[0].(type) {
+			case float64:
+				parsed, err := parseNumberArrayValue(typed)
+				if err != nil {
+					return nil, errors.Wrapf(err, "property %q of type string array", propName)
+				}
+				return parsed, nil
+			case []interface{}:
+				parsed, err := parseVectorArrayValue(typed)
+				if err != nil {
+					return nil, errors.Wrapf(err, "property %q of type vector array", propName)
+				}
+				return parsed, nil
+			case bool:
+				parsed, err := parseBoolArrayValue(typed)
+				if err != nil {
+					return nil, errors.Wrapf(err, "property %q of type boolean array", propName)
+				}
+				return parsed, nil
+			default:
+				parsed, err := parseStringArrayValue(typed)
+				if err != nil {
+					return nil, errors.Wrapf(err, "property %q of type string array", propName)
+				}
+				return parsed, nil
+			}
+		} else if len(typed) == 0 {
+			// empty arrays. Here we use []interface{} as a placeholder
+			// type for an empty array, since we cannot determine its
+			// actual type. in the future, we should persist the schema
+			// property type information alongside the value to avoid
+			// this situation
+			return typed, nil
+		} else if isObjectArrayValue(typed) {
+			// nested objects are stored as they are
+			return typed, nil
+		}
+
+		parsed, err := parseCrossRef(typed)
+		if err != nil {
+			return nil, errors.Wrapf(err, "property %q of type cross-ref", propName)
+		}
+		return parsed, nil
+	case map[string]interface{}:
+		return parseMapProp(typed), nil
+	default:
+		return value, nil
+	}
+}
+
+// parseMapProp guesses the type of a map value by its keys. Only maps which
+// consist of nothing but the keys of a geo coordinate or a phone number are
+// parsed as such, any other map must be a nested object which is kept as it
+// is.
+func parseMapProp(input map[string]interface{}) interface{} {
 	lat, latOK := input["latitude"]
 	lon, lonOK := input["longitude"]
-	_, phoneInputOK := input["input"]
 
-	if latOK && lonOK {
-		// this is a geoCoordinates prop
-		return parseGeoProp(lat, lon)
+	if latOK && lonOK && len(input) == 2 {
+		// this is probably a geoCoordinates prop
+		if parsed, err := parseGeoProp(lat, lon); err == nil {
+			return parsed
+		}
 	}
 
-	if phoneInputOK {
-		// this is a phone number
-		return parsePhoneNumber(input)
+	if _, ok := input["input"]; ok && hasOnlyPhoneNumberKeys(input) {
+		// this is probably a phone number
+		if parsed, err := parsePhoneNumber(input); err == nil {
+			return parsed
+		}
 	}
 
-	return nil, fmt.Errorf("unknown map prop which is not a geo prop or phone: %v", input)
+	return input
+}
+
+var phoneNumberKeys = map[string]struct{}{
+	"input":                  {},
+	"internationalFormatted": {},
+	"nationalFormatted":      {},
+	"national":               {},
+	"countryCode":            {},
+	"defaultCountry":         {},
+	"valid":                  {},
+}
+
+func hasOnlyPhoneNumberKeys(input map[string]interface{}) bool {
+	for key := range input {
+		if _, ok := phoneNumberKeys[key]; !ok {
+			return false
+		}
+	}
+	return true
 }
 
 func parseGeoProp(lat interface{}, lon interface{}) (*models.GeoCoordinates, error) {
@@ -215,6 +314,20 @@ func isArrayValue(value []interface{}) bool {
 	return false
 }
 
+// isObjectArrayValue distinguishes an object[] value from a cross-ref value.
+// Both are stored as arrays of maps, but only cross-refs contain a beacon
+func isObjectArrayValue(value []interface{}) bool {
+	if len(value) > 0 {
+		asMap, ok := value[0].(map[string]interface{})
+		if !ok {
+			return false
+		}
+		_, isRef := asMap["beacon"]
+		return !isRef
+	}
+	return false
+}
+
 func parseStringArrayValue(value []interface{}) ([]string, error) {
 	parsed := make([]string, len(value))
 	for i := range value {
@@ -239,6 +352,22 @@ func parseNumberArrayValue(value []interface{}) ([]float64, error) {
 	return parsed, nil
 }
 
+func parseVectorArrayValue(value []interface{}) ([][]float64, error) {
+	parsed := make([][]float64, len(value))
+	for i := range value {
+		asSlice, ok := value[i].([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("vector array: expected element %d to be array - got %T", i, value[i])
+		}
+		vector, err := parseNumberArrayValue(asSlice)
+		if err != nil {
+			return nil, fmt.Errorf("vector array: element %d: %w", i, err)
+		}
+		parsed[i] = vector
+	}
+	return parsed, nil
+}
+
 func parseBoolArrayValue(value []interface{}) ([]bool, error) {
 	parsed := make([]bool, len(value))
 	for i := range value {
@@ -67,6 +67,50 @@ func ParseAndExtractBoolArrayProp(data []byte, propName string) ([]bool, bool, e
 	return vals, true, nil
 }
 
+// ParseAndExtractVectorProp extracts the vectors of a property of data type
+// vector or vector[] without parsing the remaining properties. A vector
+// property yields a single vector. It returns false if the property is not
+// set.
+func ParseAndExtractVectorProp(data []byte, propName string) ([][]float64, bool, error) {
+	propsBytes, err := extractPropsBytes(data)
+	if err != nil {
+		return nil, false, err
+	}
+
+	val, t, _, err := jsonparser.Get(propsBytes, propName)
+	if err != nil {
+		if errors.Is(err, jsonparser.KeyPathNotFoundError) {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+	if t != jsonparser.Array {
+		return nil, false, nil
+	}
+
+	var vectors [][]float64
+	var vector []float64
+	_, err = jsonparser.ArrayEach(val, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+		switch dataType {
+		case jsonparser.Number:
+			vector = append(vector, mustExtractNumber(value))
+		case jsonparser.Array:
+			inner := []float64{}
+			jsonparser.ArrayEach(value, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+				inner = append(inner, mustExtractNumber(value))
+			})
+			vectors = append(vectors, inner)
+		}
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	if vector != nil {
+		vectors = append(vectors, vector)
+	}
+	return vectors, len(vectors) > 0, nil
+}
+
 func parseAndExtractValueProp(data []byte, propName string, valueFn func(value []byte)) error {
 	propsBytes, err := extractPropsBytes(data)
 	if err != nil {
@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"io"
 	"math"
+	"sort"
 
 	"github.com/buger/jsonparser"
 
@@ -34,12 +35,13 @@ import (
 
 type Object struct {
 	MarshallerVersion uint8
-	Object            models.Object `json:"object"`
-	Vector            []float32     `json:"vector"`
-	VectorLen         int           `json:"-"`
-	BelongsToNode     string        `json:"-"`
-	BelongsToShard    string        `json:"-"`
-	IsConsistent      bool          `json:"-"`
+	Object            models.Object        `json:"object"`
+	Vector            []float32            `json:"vector"`
+	VectorLen         int                  `json:"-"`
+	Vectors           map[string][]float32 `json:"vectors"`
+	BelongsToNode     string               `json:"-"`
+	BelongsToShard    string               `json:"-"`
+	IsConsistent      bool                 `json:"-"`
 
 	docID uint64
 }
@@ -63,17 +65,37 @@ func FromObject(object *models.Object, vector []float32) *Object {
 		object.Properties = properties
 	}
 
-	return &Object{
+	var vectors map[string][]float32
+	if len(object.Vectors) > 0 {
+		vectors = make(map[string][]float32, len(object.Vectors))
+		for name, vec := range object.Vectors {
+			vectors[name] = vec
+		}
+	}
+
+	ko := &Object{
 		Object:            *object,
 		Vector:            vector,
 		MarshallerVersion: 1,
 		VectorLen:         len(vector),
+		Vectors:           vectors,
 	}
+	// named vectors are stored next to the object, just like the vector
+	ko.Object.Vectors = nil
+	return ko
 }
 
 func FromBinary(data []byte) (*Object, error) {
+	return FromBinaryWithClass(data, nil)
+}
+
+// FromBinaryWithClass unmarshals the object and decodes its properties
+// according to the data types of the given class. Without a class, the types
+// of the properties have to be guessed from their values, which is ambiguous
+// for nested objects.
+func FromBinaryWithClass(data []byte, class *models.Class) (*Object, error) {
 	ko := &Object{}
-	if err := ko.UnmarshalBinary(data); err != nil {
+	if err := ko.unmarshalBinary(data, class); err != nil {
 		return nil, err
 	}
 
@@ -109,7 +131,7 @@ func FromBinaryUUIDOnly(data []byte) (*Object, error) {
 }
 
 func FromBinaryOptional(data []byte,
-	addProp additional.Properties,
+	addProp additional.Properties, class *models.Class,
 ) (*Object, error) {
 	if addProp.NoProps {
 		return FromBinaryUUIDOnly(data)
@@ -180,6 +202,20 @@ func FromBinaryOptional(data []byte,
 	_, err = r.Read(vectorWeights)
 	ec.AddWrap(err, "vector weights")
 
+	// objects written before named vectors were introduced end here
+	if r.Len() > 0 {
+		var targetVectorsLength uint32
+		ec.AddWrap(binary.Read(r, le, &targetVectorsLength), "target vectors length")
+		if addProp.Vector && targetVectorsLength > 0 {
+			targetVectors := make([]byte, targetVectorsLength)
+			_, err = r.Read(targetVectors)
+			ec.AddWrap(err, "target vectors")
+			ko.Vectors = unmarshalTargetVectors(targetVectors)
+		} else {
+			io.CopyN(io.Discard, r, int64(targetVectorsLength))
+		}
+	}
+
 	if err := ec.ToError(); err != nil {
 		return nil, errors.Wrap(err, "compound err")
 	}
@@ -197,6 +233,7 @@ func FromBinaryOptional(data []byte,
 		schema,
 		meta,
 		vectorWeights,
+		class,
 	); err != nil {
 		return nil, errors.Wrap(err, "parse")
 	}
@@ -209,7 +246,7 @@ type bucket interface {
 }
 
 func ObjectsByDocID(bucket bucket, ids []uint64,
-	additional additional.Properties,
+	additional additional.Properties, class *models.Class,
 ) ([]*Object, error) {
 	if bucket == nil {
 		return nil, fmt.Errorf("objects bucket not found")
@@ -232,7 +269,7 @@ func ObjectsByDocID(bucket bucket, ids []uint64,
 			continue
 		}
 
-		unmarshalled, err := FromBinaryOptional(res, additional)
+		unmarshalled, err := FromBinaryOptional(res, additional, class)
 		if err != nil {
 			return nil, errors.Wrapf(err, "unmarshal data object at position %d", i)
 		}
@@ -282,6 +319,16 @@ func (ko *Object) ExplainScore() string {
 	return ""
 }
 
+func (ko *Object) Highlights() []*additional.Highlight {
+	props := ko.AdditionalProperties()
+	if props != nil {
+		if highlights, ok := props["highlights"].([]*additional.Highlight); ok {
+			return highlights
+		}
+	}
+	return nil
+}
+
 func (ko *Object) ID() strfmt.UUID {
 	return ko.Object.ID
 }
@@ -373,6 +420,9 @@ func (ko *Object) SearchResult(additional additional.Properties, tenant string)
 	if ko.ExplainScore() != "" {
 		additionalProperties["explainScore"] = ko.ExplainScore()
 	}
+	if highlights := ko.Highlights(); highlights != nil {
+		additionalProperties["highlights"] = highlights
+	}
 
 	return &search.Result{
 		ID:        ko.ID(),
@@ -380,6 +430,7 @@ func (ko *Object) SearchResult(additional additional.Properties, tenant string)
 		Schema:    ko.Properties(),
 		Vector:    ko.Vector,
 		Dims:      ko.VectorLen,
+		Vectors:   ko.Vectors,
 		// VectorWeights: ko.VectorWeights(), // TODO: add vector weights
 		Created:              ko.CreationTimeUnix(),
 		Updated:              ko.LastUpdateTimeUnix(),
@@ -443,6 +494,46 @@ func DocIDFromBinary(in []byte) (uint64, error) {
 	return docID, err
 }
 
+// CreationTimeFromBinary extracts only the creation time (unix millis) from
+// the binary representation without parsing the remaining object
+func CreationTimeFromBinary(in []byte) (int64, error) {
+	if len(in) < 1 {
+		return 0, errors.Errorf("empty binary object")
+	}
+
+	if version := in[0]; version != 1 {
+		return 0, errors.Errorf("unsupported binary marshaller version %d", version)
+	}
+
+	// version (1) + docID (8) + kind (1) + uuid (16)
+	offset := 26
+	if len(in) < offset+8 {
+		return 0, errors.Errorf("binary object too short: %d bytes", len(in))
+	}
+
+	return int64(binary.LittleEndian.Uint64(in[offset : offset+8])), nil
+}
+
+// LastUpdateTimeFromBinary extracts only the last update time (unix millis)
+// from the binary representation without parsing the remaining object
+func LastUpdateTimeFromBinary(in []byte) (int64, error) {
+	if len(in) < 1 {
+		return 0, errors.Errorf("empty binary object")
+	}
+
+	if version := in[0]; version != 1 {
+		return 0, errors.Errorf("unsupported binary marshaller version %d", version)
+	}
+
+	// version (1) + docID (8) + kind (1) + uuid (16) + creation time (8)
+	offset := 34
+	if len(in) < offset+8 {
+		return 0, errors.Errorf("binary object too short: %d bytes", len(in))
+	}
+
+	return int64(binary.LittleEndian.Uint64(in[offset : offset+8])), nil
+}
+
 // MarshalBinary creates the binary representation of a kind object. Regardless
 // of the marshaller version the first byte is a uint8 indicating the version
 // followed by the payload which depends on the specific version
@@ -466,6 +557,11 @@ func DocIDFromBinary(in []byte) (uint64, error) {
 // n          | []byte    | meta as json
 // 2          | uint32    | length of vectorweights json
 // n          | []byte    | vectorweights as json
+// 4          | uint32    | length of target vectors
+// n          | []byte    | target vectors, see marshalTargetVectors
+//
+// The target vectors were appended later on, objects which were written
+// before end after the vector weights and have no target vectors.
 func (ko *Object) MarshalBinary() ([]byte, error) {
 	if ko.MarshallerVersion != 1 {
 		return nil, errors.Errorf("unsupported marshaller version %d", ko.MarshallerVersion)
@@ -501,8 +597,10 @@ func (ko *Object) MarshalBinary() ([]byte, error) {
 		return nil, err
 	}
 	vectorWeightsLength := uint32(len(vectorWeights))
+	targetVectors := marshalTargetVectors(ko.Vectors)
+	targetVectorsLength := uint32(len(targetVectors))
 
-	totalBufferLength := 1 + 8 + 1 + 16 + 8 + 8 + 2 + vectorLength*4 + 2 + classNameLength + 4 + schemaLength + 4 + metaLength + 4 + vectorWeightsLength
+	totalBufferLength := 1 + 8 + 1 + 16 + 8 + 8 + 2 + vectorLength*4 + 2 + classNameLength + 4 + schemaLength + 4 + metaLength + 4 + vectorWeightsLength + 4 + targetVectorsLength
 	byteBuffer := make([]byte, totalBufferLength)
 	rw := byteops.NewReadWriter(byteBuffer)
 	rw.WriteByte(ko.MarshallerVersion)
@@ -541,10 +639,74 @@ func (ko *Object) MarshalBinary() ([]byte, error) {
 	if err != nil {
 		return byteBuffer, errors.Wrap(err, "Could not copy vectorWeights")
 	}
+	rw.WriteUint32(targetVectorsLength)
+	err = rw.CopyBytesToBuffer(targetVectors)
+	if err != nil {
+		return byteBuffer, errors.Wrap(err, "Could not copy target vectors")
+	}
 
 	return byteBuffer, nil
 }
 
+// marshalTargetVectors encodes the named vectors of an object as
+//
+// No. of B   | Type      | Content
+// ------------------------------------------------
+// 2          | uint16    | number of target vectors
+// then for each target vector, sorted by name
+// 2          | uint16    | length of name
+// n          | []byte    | name
+// 2          | uint16    | vector length
+// n*4        | []float32 | vector of length n
+func marshalTargetVectors(vectors map[string][]float32) []byte {
+	if len(vectors) == 0 {
+		return nil
+	}
+
+	names := make([]string, 0, len(vectors))
+	length := 2
+	for name, vec := range vectors {
+		names = append(names, name)
+		length += 2 + len(name) + 2 + len(vec)*4
+	}
+	sort.Strings(names)
+
+	buf := make([]byte, length)
+	rw := byteops.NewReadWriter(buf)
+	rw.WriteUint16(uint16(len(names)))
+	for _, name := range names {
+		rw.WriteUint16(uint16(len(name)))
+		rw.CopyBytesToBuffer([]byte(name))
+		vec := vectors[name]
+		rw.WriteUint16(uint16(len(vec)))
+		for _, v := range vec {
+			rw.WriteUint32(math.Float32bits(v))
+		}
+	}
+
+	return buf
+}
+
+func unmarshalTargetVectors(data []byte) map[string][]float32 {
+	if len(data) == 0 {
+		return nil
+	}
+
+	rw := byteops.NewReadWriter(data)
+	count := int(rw.ReadUint16())
+	vectors := make(map[string][]float32, count)
+	for i := 0; i < count; i++ {
+		name := string(rw.ReadBytesFromBuffer(uint64(rw.ReadUint16())))
+		vec := make([]float32, rw.ReadUint16())
+		for j := range vec {
+			vec[j] = math.Float32frombits(rw.ReadUint32())
+		}
+		vectors[name] = vec
+	}
+
+	return vectors
+}
+
 // UnmarshalPropertiesFromObject only unmarshals and returns the properties part of the object
 //
 // Check MarshalBinary for the order of elements in the input array
@@ -634,6 +796,10 @@ func parseValues(dt jsonparser.ValueType, value []byte) (interface{}, error) {
 // UnmarshalBinary is the versioned way to unmarshal a kind object from binary,
 // see MarshalBinary for the exact contents of each version
 func (ko *Object) UnmarshalBinary(data []byte) error {
+	return ko.unmarshalBinary(data, nil)
+}
+
+func (ko *Object) unmarshalBinary(data []byte, class *models.Class) error {
 	version := data[0]
 	if version != 1 {
 		return errors.Errorf("unsupported binary marshaller version %d", version)
@@ -684,6 +850,12 @@ func (ko *Object) UnmarshalBinary(data []byte) error {
 		return errors.Wrap(err, "Could not copy vectorWeights")
 	}
 
+	// objects written before named vectors were introduced end here
+	if rw.Position < uint64(len(data)) {
+		targetVectorsLength := uint64(rw.ReadUint32())
+		ko.Vectors = unmarshalTargetVectors(rw.ReadBytesFromBuffer(targetVectorsLength))
+	}
+
 	return ko.parseObject(
 		strfmt.UUID(uuidParsed.String()),
 		createTime,
@@ -692,6 +864,7 @@ func (ko *Object) UnmarshalBinary(data []byte) error {
 		schema,
 		meta,
 		vectorWeights,
+		class,
 	)
 }
 
@@ -730,15 +903,43 @@ func VectorFromBinary(in []byte, buffer []float32) ([]float32, error) {
 	return out, nil
 }
 
+// TargetVectorFromBinary returns the named vector of a marshalled object
+// without unmarshalling anything else. The vector is nil if the object has
+// no vector with that name.
+func TargetVectorFromBinary(in []byte, name string) ([]float32, error) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+
+	version := in[0]
+	if version != 1 {
+		return nil, errors.Errorf("unsupported marshaller version %d", version)
+	}
+
+	rw := byteops.NewReadWriter(in, byteops.WithPosition(42))
+	rw.MoveBufferPositionForward(uint64(rw.ReadUint16()) * 4) // vector
+	rw.MoveBufferPositionForward(uint64(rw.ReadUint16()))     // class name
+	rw.MoveBufferPositionForward(uint64(rw.ReadUint32()))     // schema
+	rw.MoveBufferPositionForward(uint64(rw.ReadUint32()))     // meta
+	rw.MoveBufferPositionForward(uint64(rw.ReadUint32()))     // vector weights
+	if rw.Position >= uint64(len(in)) {
+		return nil, nil
+	}
+
+	targetVectorsLength := uint64(rw.ReadUint32())
+	vectors := unmarshalTargetVectors(rw.ReadBytesFromBuffer(targetVectorsLength))
+	return vectors[name], nil
+}
+
 func (ko *Object) parseObject(uuid strfmt.UUID, create, update int64, className string,
-	schemaB []byte, additionalB []byte, vectorWeightsB []byte,
+	schemaB []byte, additionalB []byte, vectorWeightsB []byte, class *models.Class,
 ) error {
 	var schema map[string]interface{}
 	if err := json.Unmarshal(schemaB, &schema); err != nil {
 		return err
 	}
 
-	if err := ko.enrichSchemaTypes(schema); err != nil {
+	if err := ko.enrichSchemaTypes(schema, class); err != nil {
 		return errors.Wrap(err, "enrich schema datatypes")
 	}
 
@@ -793,6 +994,21 @@ func (ko *Object) parseObject(uuid strfmt.UUID, create, update int64, className
 				additionalProperties["group"] = &group
 			}
 		}
+
+		if prop, ok := additionalProperties["highlights"]; ok {
+			if highlightsSlice, ok := prop.([]interface{}); ok {
+				marshalled, err := json.Marshal(highlightsSlice)
+				if err != nil {
+					return err
+				}
+				var highlights []*additional.Highlight
+				err = json.Unmarshal(marshalled, &highlights)
+				if err != nil {
+					return err
+				}
+				additionalProperties["highlights"] = highlights
+			}
+		}
 	}
 
 	var vectorWeights interface{}
@@ -825,6 +1041,7 @@ func (ko *Object) DeepCopyDangerous() *Object {
 		docID:             ko.docID,
 		Object:            deepCopyObject(ko.Object),
 		Vector:            deepCopyVector(ko.Vector),
+		Vectors:           deepCopyVectors(ko.Vectors),
 	}
 }
 
@@ -841,6 +1058,17 @@ func deepCopyVector(orig []float32) []float32 {
 	return out
 }
 
+func deepCopyVectors(orig map[string][]float32) map[string][]float32 {
+	if orig == nil {
+		return nil
+	}
+	out := make(map[string][]float32, len(orig))
+	for name, vec := range orig {
+		out[name] = deepCopyVector(vec)
+	}
+	return out
+}
+
 func deepCopyObject(orig models.Object) models.Object {
 	return models.Object{
 		Class:              orig.Class,
@@ -70,6 +70,18 @@ func TestStorageObjectMarshalling(t *testing.T) {
 		assert.Equal(t, uint64(7), id)
 	})
 
+	t.Run("extract only creation time and compare", func(t *testing.T) {
+		created, err := CreationTimeFromBinary(asBinary)
+		require.Nil(t, err)
+		assert.Equal(t, int64(123456), created)
+	})
+
+	t.Run("extract only last update time and compare", func(t *testing.T) {
+		updated, err := LastUpdateTimeFromBinary(asBinary)
+		require.Nil(t, err)
+		assert.Equal(t, int64(56789), updated)
+	})
+
 	t.Run("extract single text prop", func(t *testing.T) {
 		prop, ok, err := ParseAndExtractTextProp(asBinary, "name")
 		require.Nil(t, err)
@@ -86,6 +98,119 @@ func TestStorageObjectMarshalling(t *testing.T) {
 	})
 }
 
+func TestStorageObjectExtractVectorProp(t *testing.T) {
+	obj := FromObject(
+		&models.Object{
+			Class: "MyFavoriteClass",
+			ID:    strfmt.UUID("73f2eb5f-5abf-447a-81ca-74b1dd168247"),
+			Properties: map[string]interface{}{
+				"name":      "MyName",
+				"embedding": []float64{1, 2.5, 3},
+				"chunks":    [][]float64{{1, 2}, {3, 4}},
+			},
+		},
+		[]float32{1, 2, 0.7},
+	)
+	asBinary, err := obj.MarshalBinary()
+	require.Nil(t, err)
+
+	t.Run("vector", func(t *testing.T) {
+		vectors, ok, err := ParseAndExtractVectorProp(asBinary, "embedding")
+		require.Nil(t, err)
+		require.True(t, ok)
+		assert.Equal(t, [][]float64{{1, 2.5, 3}}, vectors)
+	})
+
+	t.Run("vector array", func(t *testing.T) {
+		vectors, ok, err := ParseAndExtractVectorProp(asBinary, "chunks")
+		require.Nil(t, err)
+		require.True(t, ok)
+		assert.Equal(t, [][]float64{{1, 2}, {3, 4}}, vectors)
+	})
+
+	t.Run("non-existing prop", func(t *testing.T) {
+		vectors, ok, err := ParseAndExtractVectorProp(asBinary, "IDoNotExist")
+		require.Nil(t, err)
+		assert.False(t, ok)
+		assert.Empty(t, vectors)
+	})
+}
+
+func TestStorageObjectMarshallingWithTargetVectors(t *testing.T) {
+	before := FromObject(
+		&models.Object{
+			Class:              "MyFavoriteClass",
+			CreationTimeUnix:   123456,
+			LastUpdateTimeUnix: 56789,
+			ID:                 strfmt.UUID("73f2eb5f-5abf-447a-81ca-74b1dd168247"),
+			Properties: map[string]interface{}{
+				"name": "MyName",
+			},
+			Vectors: models.Vectors{
+				"title_vec": {1, 2, 3},
+				"image_vec": {0.5, 0.25},
+			},
+		},
+		[]float32{1, 2, 0.7},
+	)
+	before.SetDocID(7)
+	assert.Nil(t, before.Object.Vectors)
+
+	asBinary, err := before.MarshalBinary()
+	require.Nil(t, err)
+
+	t.Run("unmarshal", func(t *testing.T) {
+		after, err := FromBinary(asBinary)
+		require.Nil(t, err)
+		assert.Equal(t, before, after)
+	})
+
+	t.Run("unmarshal optional with vector", func(t *testing.T) {
+		after, err := FromBinaryOptional(asBinary, additional.Properties{Vector: true}, nil)
+		require.Nil(t, err)
+		assert.Equal(t, before.Vectors, after.Vectors)
+		assert.Equal(t, before.Object.Properties, after.Object.Properties)
+	})
+
+	t.Run("unmarshal optional without vector", func(t *testing.T) {
+		after, err := FromBinaryOptional(asBinary, additional.Properties{}, nil)
+		require.Nil(t, err)
+		assert.Nil(t, after.Vectors)
+		assert.Equal(t, before.Object.Properties, after.Object.Properties)
+	})
+
+	t.Run("extract single target vector", func(t *testing.T) {
+		vec, err := TargetVectorFromBinary(asBinary, "image_vec")
+		require.Nil(t, err)
+		assert.Equal(t, []float32{0.5, 0.25}, vec)
+
+		vec, err = TargetVectorFromBinary(asBinary, "unknown")
+		require.Nil(t, err)
+		assert.Nil(t, vec)
+	})
+
+	t.Run("objects without target vectors segment", func(t *testing.T) {
+		withoutTargetVectors := FromObject(&models.Object{
+			Class: "MyFavoriteClass",
+			ID:    strfmt.UUID("73f2eb5f-5abf-447a-81ca-74b1dd168247"),
+		}, []float32{1, 2})
+		asBinary, err := withoutTargetVectors.MarshalBinary()
+		require.Nil(t, err)
+		// strip the target vectors segment, which is what objects written
+		// before named vectors existed look like
+		asBinary = asBinary[:len(asBinary)-4]
+
+		after, err := FromBinary(asBinary)
+		require.Nil(t, err)
+		assert.Nil(t, after.Vectors)
+		assert.Equal(t, []float32{1, 2}, after.Vector)
+
+		vec, err := TargetVectorFromBinary(asBinary, "title_vec")
+		require.Nil(t, err)
+		assert.Nil(t, vec)
+	})
+}
+
 func TestFilteringNilProperty(t *testing.T) {
 	object := FromObject(
 		&models.Object{
@@ -142,7 +267,7 @@ func TestStorageObjectUnmarshallingSpecificProps(t *testing.T) {
 	require.Nil(t, err)
 
 	t.Run("without any optional", func(t *testing.T) {
-		after, err := FromBinaryOptional(asBinary, additional.Properties{})
+		after, err := FromBinaryOptional(asBinary, additional.Properties{}, nil)
 		require.Nil(t, err)
 
 		t.Run("compare", func(t *testing.T) {
@@ -501,6 +626,32 @@ func TestStorageObjectMarshallingWithGroup(t *testing.T) {
 	})
 }
 
+func TestStorageObjectMarshallingWithHighlights(t *testing.T) {
+	highlights := []*additional.Highlight{
+		{Property: "title", Fragments: []string{"a <em>red</em> car"}},
+		{Property: "tags", Fragments: []string{"<em>red</em>", "dark <em>red</em>"}},
+	}
+	before := FromObject(
+		&models.Object{
+			Class:      "MyFavoriteClass",
+			ID:         strfmt.UUID("73f2eb5f-5abf-447a-81ca-74b1dd168247"),
+			Additional: models.AdditionalProperties{"highlights": highlights},
+			Properties: map[string]interface{}{"title": "a red car"},
+		},
+		[]float32{1, 2, 0.7},
+	)
+
+	asBinary, err := before.MarshalBinary()
+	require.Nil(t, err)
+
+	after, err := FromBinary(asBinary)
+	require.Nil(t, err)
+
+	assert.Equal(t, highlights, after.Highlights())
+	assert.Equal(t, highlights,
+		after.SearchResult(additional.Properties{}, "").AdditionalProperties["highlights"])
+}
+
 func TestStorageMaxVectorDimensionsObjectMarshalling(t *testing.T) {
 	generateVector := func(dims uint16) []float32 {
 		vector := make([]float32, dims)
@@ -565,7 +716,7 @@ func TestStorageMaxVectorDimensionsObjectMarshalling(t *testing.T) {
 				require.Nil(t, err)
 
 				t.Run("get without additional properties", func(t *testing.T) {
-					after, err := FromBinaryOptional(asBinary, additional.Properties{})
+					after, err := FromBinaryOptional(asBinary, additional.Properties{}, nil)
 					require.Nil(t, err)
 					// modify before to match expectations of after
 					before.Object.Additional = nil
@@ -581,7 +732,7 @@ func TestStorageMaxVectorDimensionsObjectMarshalling(t *testing.T) {
 				})
 
 				t.Run("get with additional property vector", func(t *testing.T) {
-					after, err := FromBinaryOptional(asBinary, additional.Properties{Vector: true})
+					after, err := FromBinaryOptional(asBinary, additional.Properties{Vector: true}, nil)
 					require.Nil(t, err)
 					// modify before to match expectations of after
 					before.Object.Additional = nil
@@ -600,3 +751,123 @@ func TestStorageMaxVectorDimensionsObjectMarshalling(t *testing.T) {
 		})
 	}
 }
+
+func TestStorageNestedObjectMarshalling(t *testing.T) {
+	before := FromObject(
+		&models.Object{
+			Class:              "MyFavoriteClass",
+			CreationTimeUnix:   123456,
+			LastUpdateTimeUnix: 56789,
+			ID:                 strfmt.UUID("73f2eb5f-5abf-447a-81ca-74b1dd168247"),
+			Properties: map[string]interface{}{
+				"address": map[string]interface{}{
+					"city": "Amsterdam",
+					"zip":  float64(1011),
+				},
+				"previousAddresses": []interface{}{
+					map[string]interface{}{"city": "Berlin"},
+					map[string]interface{}{"city": "Paris"},
+				},
+				"embedding":  []float64{0.1, 0.2},
+				"embeddings": [][]float64{{0.1, 0.2}, {0.3, 0.4}},
+			},
+		},
+		[]float32{1, 2, 0.7},
+	)
+	before.SetDocID(7)
+
+	asBinary, err := before.MarshalBinary()
+	require.Nil(t, err)
+
+	after, err := FromBinary(asBinary)
+	require.Nil(t, err)
+
+	assert.Equal(t, before, after)
+}
+
+func TestStorageNestedObjectMarshallingWithClass(t *testing.T) {
+	class := &models.Class{
+		Class: "MyFavoriteClass",
+		Properties: []*models.Property{
+			{
+				Name:     "location",
+				DataType: []string{"object"},
+				NestedProperties: []*models.NestedProperty{
+					{Name: "latitude", DataType: []string{"number"}},
+					{Name: "longitude", DataType: []string{"number"}},
+				},
+			},
+			{
+				Name:     "form",
+				DataType: []string{"object"},
+				NestedProperties: []*models.NestedProperty{
+					{Name: "input", DataType: []string{"int"}},
+				},
+			},
+			{
+				Name:     "position",
+				DataType: []string{"geoCoordinates"},
+			},
+			{
+				Name:     "phone",
+				DataType: []string{"phoneNumber"},
+			},
+		},
+	}
+
+	before := FromObject(
+		&models.Object{
+			Class:              "MyFavoriteClass",
+			CreationTimeUnix:   123456,
+			LastUpdateTimeUnix: 56789,
+			ID:                 strfmt.UUID("73f2eb5f-5abf-447a-81ca-74b1dd168247"),
+			Properties: map[string]interface{}{
+				"location": map[string]interface{}{
+					"latitude":  float64(52.37),
+					"longitude": float64(4.89),
+				},
+				"form": map[string]interface{}{
+					"input": float64(5),
+				},
+				"position": &models.GeoCoordinates{
+					Latitude:  ptFloat32(1),
+					Longitude: ptFloat32(2),
+				},
+				"phone": &models.PhoneNumber{
+					Input:          "020 1234567",
+					DefaultCountry: "nl",
+					CountryCode:    31,
+					Valid:          true,
+				},
+			},
+		},
+		[]float32{1, 2, 0.7},
+	)
+	before.SetDocID(7)
+
+	asBinary, err := before.MarshalBinary()
+	require.Nil(t, err)
+
+	t.Run("with class", func(t *testing.T) {
+		after, err := FromBinaryWithClass(asBinary, class)
+		require.Nil(t, err)
+		assert.Equal(t, before, after)
+	})
+
+	t.Run("with class and optional", func(t *testing.T) {
+		after, err := FromBinaryOptional(asBinary, additional.Properties{Vector: true}, class)
+		require.Nil(t, err)
+		assert.Equal(t, before.Properties(), after.Properties())
+	})
+
+	t.Run("without class", func(t *testing.T) {
+		// the nested object which looks like a geo coordinate cannot be told
+		// apart without the class, but none of the objects must fail to load
+		after, err := FromBinary(asBinary)
+		require.Nil(t, err)
+		props := after.Properties().(map[string]interface{})
+		assert.Equal(t, before.Properties().(map[string]interface{})["form"], props["form"])
+		assert.Equal(t, before.Properties().(map[string]interface{})["position"], props["position"])
+		assert.Equal(t, before.Properties().(map[string]interface{})["phone"], props["phone"])
+	})
+}
@@ -0,0 +1,73 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package vectorindex
+
+import (
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/vectorindex/dynamic"
+	"github.com/weaviate/weaviate/entities/vectorindex/flat"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/entities/vectorindex/vamana"
+)
+
+const (
+	VectorIndexTypeHNSW    = "hnsw"
+	VectorIndexTypeVamana  = "vamana"
+	VectorIndexTypeFlat    = "flat"
+	VectorIndexTypeDynamic = "dynamic"
+
+	DefaultVectorIndexType = VectorIndexTypeHNSW
+)
+
+// ParseAndValidateConfig from an unknown input value into the user config of
+// the given vector index type
+func ParseAndValidateConfig(input interface{}, vectorIndexType string) (schema.VectorIndexConfig, error) {
+	switch vectorIndexType {
+	case VectorIndexTypeHNSW:
+		return hnsw.ParseAndValidateConfig(input)
+	case VectorIndexTypeVamana:
+		return vamana.ParseAndValidateConfig(input)
+	case VectorIndexTypeFlat:
+		return flat.ParseAndValidateConfig(input)
+	case VectorIndexTypeDynamic:
+		return dynamic.ParseAndValidateConfig(input)
+	default:
+		return nil, fmt.Errorf("unsupported vector index type: %q", vectorIndexType)
+	}
+}
+
+// IsSupportedType returns whether a vector index of the given type can be
+// created
+func IsSupportedType(vectorIndexType string) bool {
+	switch vectorIndexType {
+	case VectorIndexTypeHNSW, VectorIndexTypeVamana, VectorIndexTypeFlat,
+		VectorIndexTypeDynamic:
+		return true
+	default:
+		return false
+	}
+}
+
+// TypeAssertVectorIndex returns the parsed vector index config of the class,
+// regardless of the type of its vector index
+func TypeAssertVectorIndex(class *models.Class) (schema.VectorIndexConfig, error) {
+	cfg, ok := class.VectorIndexConfig.(schema.VectorIndexConfig)
+	if !ok {
+		return nil, fmt.Errorf("class '%s' vector index: config is not a parsed vector index config: %T",
+			class.Class, class.VectorIndexConfig)
+	}
+
+	return cfg, nil
+}
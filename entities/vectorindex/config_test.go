@@ -0,0 +1,79 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package vectorindex
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/vectorindex/dynamic"
+	"github.com/weaviate/weaviate/entities/vectorindex/flat"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/entities/vectorindex/vamana"
+)
+
+func TestParseAndValidateConfig(t *testing.T) {
+	t.Run("hnsw", func(t *testing.T) {
+		cfg, err := ParseAndValidateConfig(map[string]interface{}{"distance": "dot"}, "hnsw")
+		require.Nil(t, err)
+		assert.IsType(t, hnsw.UserConfig{}, cfg)
+		assert.Equal(t, "hnsw", cfg.IndexType())
+		assert.Equal(t, hnsw.DistanceDot, cfg.DistanceName())
+	})
+
+	t.Run("vamana", func(t *testing.T) {
+		cfg, err := ParseAndValidateConfig(map[string]interface{}{"distance": "dot"}, "vamana")
+		require.Nil(t, err)
+		assert.IsType(t, vamana.UserConfig{}, cfg)
+		assert.Equal(t, "vamana", cfg.IndexType())
+		assert.Equal(t, hnsw.DistanceDot, cfg.DistanceName())
+	})
+
+	t.Run("flat", func(t *testing.T) {
+		cfg, err := ParseAndValidateConfig(map[string]interface{}{"distance": "dot"}, "flat")
+		require.Nil(t, err)
+		assert.IsType(t, flat.UserConfig{}, cfg)
+		assert.Equal(t, "flat", cfg.IndexType())
+		assert.Equal(t, hnsw.DistanceDot, cfg.DistanceName())
+	})
+
+	t.Run("dynamic", func(t *testing.T) {
+		cfg, err := ParseAndValidateConfig(map[string]interface{}{"distance": "dot"}, "dynamic")
+		require.Nil(t, err)
+		assert.IsType(t, dynamic.UserConfig{}, cfg)
+		assert.Equal(t, "dynamic", cfg.IndexType())
+		assert.Equal(t, hnsw.DistanceDot, cfg.DistanceName())
+	})
+
+	t.Run("unsupported type", func(t *testing.T) {
+		_, err := ParseAndValidateConfig(nil, "ivf")
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "unsupported vector index type")
+	})
+}
+
+func TestTypeAssertVectorIndex(t *testing.T) {
+	cfg, err := TypeAssertVectorIndex(&models.Class{
+		Class:             "Article",
+		VectorIndexConfig: vamana.NewDefaultUserConfig(),
+	})
+	require.Nil(t, err)
+	assert.Equal(t, vamana.DefaultDistanceMetric, cfg.DistanceName())
+
+	_, err = TypeAssertVectorIndex(&models.Class{
+		Class:             "Article",
+		VectorIndexConfig: map[string]interface{}{},
+	})
+	require.NotNil(t, err)
+}
@@ -0,0 +1,162 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package dynamic
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/vectorindex/common"
+	"github.com/weaviate/weaviate/entities/vectorindex/flat"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+const (
+	// Set these defaults if the user leaves them blank
+	DefaultThreshold      = 10000
+	DefaultDistanceMetric = hnsw.DistanceCosine
+)
+
+// UserConfig bundles all values settable by a user in the per-class settings.
+// The index starts out as a flat index and is upgraded to an hnsw index once
+// it holds Threshold objects. The nested configs are used for the respective
+// phase and always share the top-level distance.
+type UserConfig struct {
+	Distance  string          `json:"distance"`
+	Threshold int             `json:"threshold"`
+	HNSW      hnsw.UserConfig `json:"hnsw"`
+	Flat      flat.UserConfig `json:"flat"`
+}
+
+// IndexType returns the type of the underlying vector index, thus making sure
+// the schema.VectorIndexConfig interface is implemented
+func (u UserConfig) IndexType() string {
+	return "dynamic"
+}
+
+// DistanceName returns the distance metric used by the index
+func (u UserConfig) DistanceName() string {
+	return u.Distance
+}
+
+// SetDefaults in the user-specifyable part of the config
+func (u *UserConfig) SetDefaults() {
+	u.Distance = DefaultDistanceMetric
+	u.Threshold = DefaultThreshold
+	u.HNSW = hnsw.NewDefaultUserConfig()
+	u.Flat = flat.NewDefaultUserConfig()
+}
+
+// ParseAndValidateConfig from an unknown input value, as this is not further
+// specified in the API to allow of exchanging the index type
+func ParseAndValidateConfig(input interface{}) (schema.VectorIndexConfig, error) {
+	uc := UserConfig{}
+	uc.SetDefaults()
+
+	if input == nil {
+		return uc, nil
+	}
+
+	asMap, ok := input.(map[string]interface{})
+	if !ok || asMap == nil {
+		return uc, fmt.Errorf("input must be a non-nil map")
+	}
+
+	if err := common.OptionalStringFromMap(asMap, "distance", func(v string) {
+		uc.Distance = v
+	}); err != nil {
+		return uc, err
+	}
+
+	if err := common.OptionalIntFromMap(asMap, "threshold", func(v int) {
+		uc.Threshold = v
+	}); err != nil {
+		return uc, err
+	}
+
+	hnswInput, err := nestedMap(asMap, "hnsw", uc.Distance)
+	if err != nil {
+		return uc, err
+	}
+	hnswParsed, err := hnsw.ParseAndValidateConfig(hnswInput)
+	if err != nil {
+		return uc, fmt.Errorf("hnsw: %w", err)
+	}
+	uc.HNSW = hnswParsed.(hnsw.UserConfig)
+
+	flatInput, err := nestedMap(asMap, "flat", uc.Distance)
+	if err != nil {
+		return uc, err
+	}
+	flatParsed, err := flat.ParseAndValidateConfig(flatInput)
+	if err != nil {
+		return uc, fmt.Errorf("flat: %w", err)
+	}
+	uc.Flat = flatParsed.(flat.UserConfig)
+
+	return uc, uc.validate()
+}
+
+// nestedMap returns a copy of the nested config with the given name that
+// carries the top-level distance. A nested distance is only accepted if it
+// matches the top-level one, as both phases must produce comparable results.
+func nestedMap(in map[string]interface{}, name, distance string) (map[string]interface{}, error) {
+	out := map[string]interface{}{}
+	if value, ok := in[name]; ok && value != nil {
+		asMap, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("%s must be a map, got %T", name, value)
+		}
+		for k, v := range asMap {
+			out[k] = v
+		}
+	}
+
+	if nested, ok := out["distance"]; ok && nested != distance {
+		return nil, fmt.Errorf("%s.distance must match distance %q, got %v",
+			name, distance, nested)
+	}
+	out["distance"] = distance
+
+	return out, nil
+}
+
+func (u *UserConfig) validate() error {
+	var errMsgs []string
+	if u.Threshold <= 0 {
+		errMsgs = append(errMsgs, "threshold must be a positive integer")
+	}
+
+	if u.HNSW.Skip || u.Flat.Skip {
+		errMsgs = append(errMsgs, "skip is not supported for the nested hnsw and flat configs")
+	}
+
+	if u.HNSW.IndexBuild == hnsw.IndexBuildGPU {
+		// the upgrade inserts the vectors one by one into a live index
+		errMsgs = append(errMsgs, fmt.Sprintf("hnsw.indexBuild %q is not supported",
+			hnsw.IndexBuildGPU))
+	}
+
+	if len(errMsgs) > 0 {
+		return fmt.Errorf("invalid dynamic config: %s",
+			strings.Join(errMsgs, ", "))
+	}
+
+	return nil
+}
+
+func NewDefaultUserConfig() UserConfig {
+	uc := UserConfig{}
+	uc.SetDefaults()
+	return uc
+}
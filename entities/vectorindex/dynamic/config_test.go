@@ -0,0 +1,145 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package dynamic
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/vectorindex/flat"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func Test_UserConfig(t *testing.T) {
+	type test struct {
+		name         string
+		input        interface{}
+		expected     func() UserConfig
+		expectErr    bool
+		expectErrMsg string
+	}
+
+	tests := []test{
+		{
+			name:     "nothing specified, all defaults",
+			input:    nil,
+			expected: NewDefaultUserConfig,
+		},
+		{
+			name: "with all optional fields",
+			input: map[string]interface{}{
+				"distance":  "l2-squared",
+				"threshold": json.Number("500"),
+				"hnsw": map[string]interface{}{
+					"efConstruction": json.Number("64"),
+				},
+				"flat": map[string]interface{}{
+					"bq": map[string]interface{}{
+						"enabled": true,
+					},
+				},
+			},
+			expected: func() UserConfig {
+				uc := NewDefaultUserConfig()
+				uc.Distance = hnsw.DistanceL2Squared
+				uc.Threshold = 500
+				uc.HNSW.Distance = hnsw.DistanceL2Squared
+				uc.HNSW.EFConstruction = 64
+				uc.Flat.Distance = hnsw.DistanceL2Squared
+				uc.Flat.BQ.Enabled = true
+				return uc
+			},
+		},
+		{
+			name: "with a matching nested distance",
+			input: map[string]interface{}{
+				"distance": "dot",
+				"flat": map[string]interface{}{
+					"distance": "dot",
+				},
+			},
+			expected: func() UserConfig {
+				uc := NewDefaultUserConfig()
+				uc.Distance = hnsw.DistanceDot
+				uc.HNSW.Distance = hnsw.DistanceDot
+				uc.Flat = flat.NewDefaultUserConfig()
+				uc.Flat.Distance = hnsw.DistanceDot
+				return uc
+			},
+		},
+		{
+			name: "with a conflicting nested distance",
+			input: map[string]interface{}{
+				"distance": "dot",
+				"hnsw": map[string]interface{}{
+					"distance": "l2-squared",
+				},
+			},
+			expectErr:    true,
+			expectErrMsg: "hnsw.distance must match distance",
+		},
+		{
+			name: "invalid threshold",
+			input: map[string]interface{}{
+				"threshold": json.Number("0"),
+			},
+			expectErr:    true,
+			expectErrMsg: "threshold must be a positive integer",
+		},
+		{
+			name: "invalid nested config",
+			input: map[string]interface{}{
+				"flat": map[string]interface{}{
+					"maxObjects": json.Number("-1"),
+				},
+			},
+			expectErr:    true,
+			expectErrMsg: "maxObjects must not be negative",
+		},
+		{
+			name: "nested skip",
+			input: map[string]interface{}{
+				"hnsw": map[string]interface{}{
+					"skip": true,
+				},
+			},
+			expectErr:    true,
+			expectErrMsg: "skip is not supported",
+		},
+		{
+			name: "gpu index build",
+			input: map[string]interface{}{
+				"hnsw": map[string]interface{}{
+					"indexBuild": "gpu",
+				},
+			},
+			expectErr:    true,
+			expectErrMsg: "hnsw.indexBuild \"gpu\" is not supported",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg, err := ParseAndValidateConfig(test.input)
+			if test.expectErr {
+				require.NotNil(t, err)
+				assert.Contains(t, err.Error(), test.expectErrMsg)
+				return
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, test.expected(), cfg)
+			}
+		})
+	}
+}
@@ -0,0 +1,156 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package flat
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/vectorindex/common"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+const (
+	// Set these defaults if the user leaves them blank
+	DefaultSkip           = false
+	DefaultMaxObjects     = 0
+	DefaultDistanceMetric = hnsw.DistanceCosine
+	DefaultBQEnabled      = false
+	DefaultBQRescoreLimit = 100
+)
+
+// UserConfig bundles all values settable by a user in the per-class settings
+type UserConfig struct {
+	Skip       bool     `json:"skip"`
+	MaxObjects int      `json:"maxObjects"`
+	Distance   string   `json:"distance"`
+	BQ         BQConfig `json:"bq"`
+}
+
+// Binary Quantization configuration
+type BQConfig struct {
+	Enabled      bool `json:"enabled"`
+	RescoreLimit int  `json:"rescoreLimit"`
+}
+
+// IndexType returns the type of the underlying vector index, thus making sure
+// the schema.VectorIndexConfig interface is implemented
+func (u UserConfig) IndexType() string {
+	return "flat"
+}
+
+// DistanceName returns the distance metric used by the index
+func (u UserConfig) DistanceName() string {
+	return u.Distance
+}
+
+// SetDefaults in the user-specifyable part of the config
+func (u *UserConfig) SetDefaults() {
+	u.Skip = DefaultSkip
+	u.MaxObjects = DefaultMaxObjects
+	u.Distance = DefaultDistanceMetric
+	u.BQ = BQConfig{
+		Enabled:      DefaultBQEnabled,
+		RescoreLimit: DefaultBQRescoreLimit,
+	}
+}
+
+// ParseAndValidateConfig from an unknown input value, as this is not further
+// specified in the API to allow of exchanging the index type
+func ParseAndValidateConfig(input interface{}) (schema.VectorIndexConfig, error) {
+	uc := UserConfig{}
+	uc.SetDefaults()
+
+	if input == nil {
+		return uc, nil
+	}
+
+	asMap, ok := input.(map[string]interface{})
+	if !ok || asMap == nil {
+		return uc, fmt.Errorf("input must be a non-nil map")
+	}
+
+	if err := common.OptionalBoolFromMap(asMap, "skip", func(v bool) {
+		uc.Skip = v
+	}); err != nil {
+		return uc, err
+	}
+
+	if err := common.OptionalIntFromMap(asMap, "maxObjects", func(v int) {
+		uc.MaxObjects = v
+	}); err != nil {
+		return uc, err
+	}
+
+	if err := common.OptionalStringFromMap(asMap, "distance", func(v string) {
+		uc.Distance = v
+	}); err != nil {
+		return uc, err
+	}
+
+	if err := parseBQMap(asMap, &uc.BQ); err != nil {
+		return uc, err
+	}
+
+	return uc, uc.validate()
+}
+
+func parseBQMap(in map[string]interface{}, bq *BQConfig) error {
+	bqConfigValue, ok := in["bq"]
+	if !ok {
+		return nil
+	}
+
+	bqConfigMap, ok := bqConfigValue.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	if err := common.OptionalBoolFromMap(bqConfigMap, "enabled", func(v bool) {
+		bq.Enabled = v
+	}); err != nil {
+		return err
+	}
+
+	if err := common.OptionalIntFromMap(bqConfigMap, "rescoreLimit", func(v int) {
+		bq.RescoreLimit = v
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (u *UserConfig) validate() error {
+	var errMsgs []string
+	if u.MaxObjects < 0 {
+		errMsgs = append(errMsgs, "maxObjects must not be negative")
+	}
+
+	if u.BQ.RescoreLimit < 0 {
+		errMsgs = append(errMsgs, "bq.rescoreLimit must not be negative")
+	}
+
+	if len(errMsgs) > 0 {
+		return fmt.Errorf("invalid flat config: %s",
+			strings.Join(errMsgs, ", "))
+	}
+
+	return nil
+}
+
+func NewDefaultUserConfig() UserConfig {
+	uc := UserConfig{}
+	uc.SetDefaults()
+	return uc
+}
@@ -0,0 +1,109 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package flat
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func Test_UserConfig(t *testing.T) {
+	type test struct {
+		name         string
+		input        interface{}
+		expected     UserConfig
+		expectErr    bool
+		expectErrMsg string
+	}
+
+	tests := []test{
+		{
+			name:     "nothing specified, all defaults",
+			input:    nil,
+			expected: NewDefaultUserConfig(),
+		},
+		{
+			name: "with all optional fields",
+			input: map[string]interface{}{
+				"skip":       true,
+				"maxObjects": json.Number("5000"),
+				"distance":   "l2-squared",
+				"bq": map[string]interface{}{
+					"enabled":      true,
+					"rescoreLimit": json.Number("250"),
+				},
+			},
+			expected: UserConfig{
+				Skip:       true,
+				MaxObjects: 5000,
+				Distance:   hnsw.DistanceL2Squared,
+				BQ: BQConfig{
+					Enabled:      true,
+					RescoreLimit: 250,
+				},
+			},
+		},
+		{
+			name: "with raw data as floats",
+			input: map[string]interface{}{
+				"maxObjects": float64(100),
+				"bq": map[string]interface{}{
+					"enabled": true,
+				},
+			},
+			expected: UserConfig{
+				MaxObjects: 100,
+				Distance:   DefaultDistanceMetric,
+				BQ: BQConfig{
+					Enabled:      true,
+					RescoreLimit: DefaultBQRescoreLimit,
+				},
+			},
+		},
+		{
+			name: "invalid maxObjects",
+			input: map[string]interface{}{
+				"maxObjects": json.Number("-1"),
+			},
+			expectErr:    true,
+			expectErrMsg: "maxObjects must not be negative",
+		},
+		{
+			name: "invalid rescoreLimit",
+			input: map[string]interface{}{
+				"bq": map[string]interface{}{
+					"rescoreLimit": json.Number("-10"),
+				},
+			},
+			expectErr:    true,
+			expectErrMsg: "bq.rescoreLimit must not be negative",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg, err := ParseAndValidateConfig(test.input)
+			if test.expectErr {
+				require.NotNil(t, err)
+				assert.Contains(t, err.Error(), test.expectErrMsg)
+				return
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, test.expected, cfg)
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"fmt"
+)
+
+const (
+	DefaultAdaptiveEFEnabled          = false
+	DefaultAdaptiveEFTargetLatencyMs  = 50
+	DefaultAdaptiveEFTargetPercentile = 95
+)
+
+// AdaptiveEFConfig configures the adaptive search mode. Instead of using a
+// fixed or k-derived ef, the index measures its query latency and picks the
+// highest ef between dynamicEfMin and dynamicEfMax for which the latency
+// percentile stays below the target. As a higher ef means a higher recall,
+// the ef itself serves as the recall proxy.
+type AdaptiveEFConfig struct {
+	Enabled          bool `json:"enabled"`
+	TargetLatencyMs  int  `json:"targetLatencyMs"`
+	TargetPercentile int  `json:"targetPercentile"`
+}
+
+func ValidateAdaptiveEFConfig(cfg AdaptiveEFConfig) error {
+	if !cfg.Enabled {
+		return nil
+	}
+
+	if cfg.TargetLatencyMs < 1 {
+		return fmt.Errorf("adaptiveEf.targetLatencyMs must be a positive integer, got %d",
+			cfg.TargetLatencyMs)
+	}
+
+	if cfg.TargetPercentile < 1 || cfg.TargetPercentile > 100 {
+		return fmt.Errorf("adaptiveEf.targetPercentile must be between 1 and 100, got %d",
+			cfg.TargetPercentile)
+	}
+
+	return nil
+}
+
+func parseAdaptiveEFMap(in map[string]interface{}, cfg *AdaptiveEFConfig) error {
+	value, ok := in["adaptiveEf"]
+	if !ok {
+		return nil
+	}
+
+	asMap, ok := value.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	if err := optionalBoolFromMap(asMap, "enabled", func(v bool) {
+		cfg.Enabled = v
+	}); err != nil {
+		return err
+	}
+
+	if err := optionalIntFromMap(asMap, "targetLatencyMs", func(v int) {
+		cfg.TargetLatencyMs = v
+	}); err != nil {
+		return err
+	}
+
+	if err := optionalIntFromMap(asMap, "targetPercentile", func(v int) {
+		cfg.TargetPercentile = v
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
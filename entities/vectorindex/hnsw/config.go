@@ -30,11 +30,34 @@ const (
 	DistanceL2Squared = "l2-squared"
 	DistanceManhattan = "manhattan"
 	DistanceHamming   = "hamming"
+	DistanceJaccard   = "jaccard"
+)
+
+const (
+	VectorCacheDTypeFloat32 = "float32"
+	VectorCacheDTypeFloat16 = "float16"
+)
+
+const (
+	// prefill the vector cache with the nodes of the upper layers first
+	VectorCachePrefillUpperLayers = "upperLayers"
+	// prefill the vector cache with the most recently accessed nodes first,
+	// the access log is persisted on shutdown
+	VectorCachePrefillRecentlyAccessed = "recentlyAccessed"
+)
+
+const (
+	// insert every vector into the graph as it is imported
+	IndexBuildCPU = "cpu"
+	// defer the inserts into an empty index until its graph is built in bulk
+	// on the GPU, which requires weaviate to be built with the cuvs tag
+	IndexBuildGPU = "gpu"
 )
 
 const (
 	// Set these defaults if the user leaves them blank
 	DefaultCleanupIntervalSeconds = 5 * 60
+	DefaultCleanupConcurrency     = 1
 	DefaultMaxConnections         = 64
 	DefaultEFConstruction         = 128
 	DefaultEF                     = -1 // indicates "let Weaviate pick"
@@ -42,9 +65,14 @@ const (
 	DefaultDynamicEFMax           = 500
 	DefaultDynamicEFFactor        = 8
 	DefaultVectorCacheMaxObjects  = 1e12
+	DefaultVectorCacheMaxBytes    = 0 // no byte budget, only limit by count
 	DefaultSkip                   = false
 	DefaultFlatSearchCutoff       = 40000
+	DefaultAcornFilterRatio       = 0.4
 	DefaultDistanceMetric         = DistanceCosine
+	DefaultVectorCacheDType       = VectorCacheDTypeFloat32
+	DefaultVectorCachePrefill     = VectorCachePrefillUpperLayers
+	DefaultIndexBuild             = IndexBuildCPU
 
 	// Fail validation if those criteria are not met
 	MinmumMaxConnections = 4
@@ -53,18 +81,26 @@ const (
 
 // UserConfig bundles all values settable by a user in the per-class settings
 type UserConfig struct {
-	Skip                   bool     `json:"skip"`
-	CleanupIntervalSeconds int      `json:"cleanupIntervalSeconds"`
-	MaxConnections         int      `json:"maxConnections"`
-	EFConstruction         int      `json:"efConstruction"`
-	EF                     int      `json:"ef"`
-	DynamicEFMin           int      `json:"dynamicEfMin"`
-	DynamicEFMax           int      `json:"dynamicEfMax"`
-	DynamicEFFactor        int      `json:"dynamicEfFactor"`
-	VectorCacheMaxObjects  int      `json:"vectorCacheMaxObjects"`
-	FlatSearchCutoff       int      `json:"flatSearchCutoff"`
-	Distance               string   `json:"distance"`
-	PQ                     PQConfig `json:"pq"`
+	Skip                   bool             `json:"skip"`
+	CleanupIntervalSeconds int              `json:"cleanupIntervalSeconds"`
+	CleanupConcurrency     int              `json:"cleanupConcurrency"`
+	MaxConnections         int              `json:"maxConnections"`
+	EFConstruction         int              `json:"efConstruction"`
+	EF                     int              `json:"ef"`
+	DynamicEFMin           int              `json:"dynamicEfMin"`
+	DynamicEFMax           int              `json:"dynamicEfMax"`
+	DynamicEFFactor        int              `json:"dynamicEfFactor"`
+	VectorCacheMaxObjects  int              `json:"vectorCacheMaxObjects"`
+	VectorCacheMaxBytes    int              `json:"vectorCacheMaxBytes"`
+	VectorCacheDType       string           `json:"vectorCacheDType"`
+	VectorCachePrefill     string           `json:"vectorCachePrefill"`
+	FlatSearchCutoff       int              `json:"flatSearchCutoff"`
+	AcornFilterRatio       float64          `json:"acornFilterRatio"`
+	Distance               string           `json:"distance"`
+	IndexBuild             string           `json:"indexBuild"`
+	PQ                     PQConfig         `json:"pq"`
+	SQ                     SQConfig         `json:"sq"`
+	AdaptiveEF             AdaptiveEFConfig `json:"adaptiveEf"`
 }
 
 // IndexType returns the type of the underlying vector index, thus making sure
@@ -73,30 +109,53 @@ func (u UserConfig) IndexType() string {
 	return "hnsw"
 }
 
+// DistanceName returns the distance metric used by the index
+func (u UserConfig) DistanceName() string {
+	return u.Distance
+}
+
 // SetDefaults in the user-specifyable part of the config
 func (u *UserConfig) SetDefaults() {
 	u.MaxConnections = DefaultMaxConnections
 	u.EFConstruction = DefaultEFConstruction
 	u.CleanupIntervalSeconds = DefaultCleanupIntervalSeconds
+	u.CleanupConcurrency = DefaultCleanupConcurrency
 	u.VectorCacheMaxObjects = DefaultVectorCacheMaxObjects
+	u.VectorCacheMaxBytes = DefaultVectorCacheMaxBytes
+	u.VectorCacheDType = DefaultVectorCacheDType
+	u.VectorCachePrefill = DefaultVectorCachePrefill
 	u.EF = DefaultEF
 	u.DynamicEFFactor = DefaultDynamicEFFactor
 	u.DynamicEFMax = DefaultDynamicEFMax
 	u.DynamicEFMin = DefaultDynamicEFMin
 	u.Skip = DefaultSkip
 	u.FlatSearchCutoff = DefaultFlatSearchCutoff
+	u.AcornFilterRatio = DefaultAcornFilterRatio
 	u.Distance = DefaultDistanceMetric
+	u.IndexBuild = DefaultIndexBuild
 	u.PQ = PQConfig{
-		Enabled:        DefaultPQEnabled,
-		BitCompression: DefaultPQBitCompression,
-		Segments:       DefaultPQSegments,
-		Centroids:      DefaultPQCentroids,
-		TrainingLimit:  DefaultPQTrainingLimit,
+		Enabled:            DefaultPQEnabled,
+		BitCompression:     DefaultPQBitCompression,
+		Segments:           DefaultPQSegments,
+		Centroids:          DefaultPQCentroids,
+		TrainingLimit:      DefaultPQTrainingLimit,
+		AutoTrain:          DefaultPQAutoTrain,
+		AutoTrainThreshold: DefaultPQAutoTrainThreshold,
 		Encoder: PQEncoder{
 			Type:         DefaultPQEncoderType,
 			Distribution: DefaultPQEncoderDistribution,
 		},
 	}
+	u.SQ = SQConfig{
+		Enabled:       DefaultSQEnabled,
+		TrainingLimit: DefaultSQTrainingLimit,
+		RescoreLimit:  DefaultSQRescoreLimit,
+	}
+	u.AdaptiveEF = AdaptiveEFConfig{
+		Enabled:          DefaultAdaptiveEFEnabled,
+		TargetLatencyMs:  DefaultAdaptiveEFTargetLatencyMs,
+		TargetPercentile: DefaultAdaptiveEFTargetPercentile,
+	}
 }
 
 // ParseAndValidateConfig from an unknown input value, as this is not further
@@ -126,6 +185,12 @@ func ParseAndValidateConfig(input interface{}) (schema.VectorIndexConfig, error)
 		return uc, err
 	}
 
+	if err := optionalIntFromMap(asMap, "cleanupConcurrency", func(v int) {
+		uc.CleanupConcurrency = v
+	}); err != nil {
+		return uc, err
+	}
+
 	if err := optionalIntFromMap(asMap, "efConstruction", func(v int) {
 		uc.EFConstruction = v
 	}); err != nil {
@@ -162,12 +227,36 @@ func ParseAndValidateConfig(input interface{}) (schema.VectorIndexConfig, error)
 		return uc, err
 	}
 
+	if err := optionalIntFromMap(asMap, "vectorCacheMaxBytes", func(v int) {
+		uc.VectorCacheMaxBytes = v
+	}); err != nil {
+		return uc, err
+	}
+
+	if err := optionalStringFromMap(asMap, "vectorCacheDType", func(v string) {
+		uc.VectorCacheDType = v
+	}); err != nil {
+		return uc, err
+	}
+
+	if err := optionalStringFromMap(asMap, "vectorCachePrefill", func(v string) {
+		uc.VectorCachePrefill = v
+	}); err != nil {
+		return uc, err
+	}
+
 	if err := optionalIntFromMap(asMap, "flatSearchCutoff", func(v int) {
 		uc.FlatSearchCutoff = v
 	}); err != nil {
 		return uc, err
 	}
 
+	if err := optionalFloatFromMap(asMap, "acornFilterRatio", func(v float64) {
+		uc.AcornFilterRatio = v
+	}); err != nil {
+		return uc, err
+	}
+
 	if err := optionalBoolFromMap(asMap, "skip", func(v bool) {
 		uc.Skip = v
 	}); err != nil {
@@ -180,10 +269,24 @@ func ParseAndValidateConfig(input interface{}) (schema.VectorIndexConfig, error)
 		return uc, err
 	}
 
+	if err := optionalStringFromMap(asMap, "indexBuild", func(v string) {
+		uc.IndexBuild = v
+	}); err != nil {
+		return uc, err
+	}
+
 	if err := parsePQMap(asMap, &uc.PQ); err != nil {
 		return uc, err
 	}
 
+	if err := parseSQMap(asMap, &uc.SQ); err != nil {
+		return uc, err
+	}
+
+	if err := parseAdaptiveEFMap(asMap, &uc.AdaptiveEF); err != nil {
+		return uc, err
+	}
+
 	return uc, uc.validate()
 }
 
@@ -203,6 +306,99 @@ func (u *UserConfig) validate() error {
 		))
 	}
 
+	if u.AcornFilterRatio < 0 || u.AcornFilterRatio > 1 {
+		errMsgs = append(errMsgs, fmt.Sprintf(
+			"acornFilterRatio must be between 0 (disabled) and 1, got %v",
+			u.AcornFilterRatio,
+		))
+	}
+
+	if u.CleanupIntervalSeconds < 1 {
+		errMsgs = append(errMsgs,
+			"cleanupIntervalSeconds must be a positive integer")
+	}
+
+	if u.CleanupConcurrency < 1 {
+		errMsgs = append(errMsgs,
+			"cleanupConcurrency must be a positive integer")
+	}
+
+	if u.PQ.AutoTrainThreshold < 1 {
+		errMsgs = append(errMsgs,
+			"pq.autoTrainThreshold must be a positive integer")
+	}
+
+	if err := ValidateSQConfig(u.SQ); err != nil {
+		errMsgs = append(errMsgs, err.Error())
+	}
+
+	if u.SQ.Enabled && (u.PQ.Enabled || u.PQ.AutoTrain) {
+		errMsgs = append(errMsgs,
+			"sq cannot be enabled together with pq or pq.autoTrain")
+	}
+
+	switch u.Distance {
+	case DistanceCosine, DistanceDot, DistanceL2Squared, DistanceManhattan,
+		DistanceHamming, DistanceJaccard:
+	default:
+		errMsgs = append(errMsgs, fmt.Sprintf(
+			"distance must be one of %q, %q, %q, %q, %q or %q, got %q",
+			DistanceCosine, DistanceDot, DistanceL2Squared, DistanceManhattan,
+			DistanceHamming, DistanceJaccard, u.Distance,
+		))
+	}
+
+	// the jaccard distance of a full vector cannot be assembled from the
+	// distances of its segments
+	if u.Distance == DistanceJaccard && (u.PQ.Enabled || u.PQ.AutoTrain) {
+		errMsgs = append(errMsgs,
+			"pq cannot be enabled together with distance \"jaccard\"")
+	}
+
+	if u.VectorCacheMaxBytes < 0 {
+		errMsgs = append(errMsgs,
+			"vectorCacheMaxBytes must be 0 (disabled) or a positive integer")
+	}
+
+	switch u.VectorCacheDType {
+	case VectorCacheDTypeFloat32, VectorCacheDTypeFloat16:
+	default:
+		errMsgs = append(errMsgs, fmt.Sprintf(
+			"vectorCacheDType must be one of %q or %q, got %q",
+			VectorCacheDTypeFloat32, VectorCacheDTypeFloat16, u.VectorCacheDType,
+		))
+	}
+
+	switch u.VectorCachePrefill {
+	case VectorCachePrefillUpperLayers, VectorCachePrefillRecentlyAccessed:
+	default:
+		errMsgs = append(errMsgs, fmt.Sprintf(
+			"vectorCachePrefill must be one of %q or %q, got %q",
+			VectorCachePrefillUpperLayers, VectorCachePrefillRecentlyAccessed,
+			u.VectorCachePrefill,
+		))
+	}
+
+	switch u.IndexBuild {
+	case IndexBuildCPU:
+	case IndexBuildGPU:
+		// the graph is built in bulk from the uncompressed vectors
+		if u.PQ.Enabled || u.PQ.AutoTrain || u.SQ.Enabled {
+			errMsgs = append(errMsgs, fmt.Sprintf(
+				"indexBuild %q cannot be combined with pq, pq.autoTrain or sq",
+				IndexBuildGPU))
+		}
+	default:
+		errMsgs = append(errMsgs, fmt.Sprintf(
+			"indexBuild must be one of %q or %q, got %q",
+			IndexBuildCPU, IndexBuildGPU, u.IndexBuild,
+		))
+	}
+
+	if err := ValidateAdaptiveEFConfig(u.AdaptiveEF); err != nil {
+		errMsgs = append(errMsgs, err.Error())
+	}
+
 	if len(errMsgs) > 0 {
 		return fmt.Errorf("invalid hnsw config: %s",
 			strings.Join(errMsgs, ", "))
@@ -247,6 +443,33 @@ func optionalIntFromMap(in map[string]interface{}, name string,
 	return nil
 }
 
+func optionalFloatFromMap(in map[string]interface{}, name string,
+	setFn func(v float64),
+) error {
+	value, ok := in[name]
+	if !ok {
+		return nil
+	}
+
+	var asFloat64 float64
+	var err error
+
+	// depending on whether we get the results from disk or from the REST API,
+	// numbers may be represented slightly differently
+	switch typed := value.(type) {
+	case json.Number:
+		asFloat64, err = typed.Float64()
+	case float64:
+		asFloat64 = typed
+	}
+	if err != nil {
+		return errors.Wrapf(err, "json.Number to float64 for %q", name)
+	}
+
+	setFn(asFloat64)
+	return nil
+}
+
 func optionalBoolFromMap(in map[string]interface{}, name string,
 	setFn func(v bool),
 ) error {
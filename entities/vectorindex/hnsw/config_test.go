@@ -35,27 +35,149 @@ func Test_UserConfig(t *testing.T) {
 			input: nil,
 			expected: UserConfig{
 				CleanupIntervalSeconds: DefaultCleanupIntervalSeconds,
+				CleanupConcurrency:     DefaultCleanupConcurrency,
 				MaxConnections:         DefaultMaxConnections,
 				EFConstruction:         DefaultEFConstruction,
 				VectorCacheMaxObjects:  DefaultVectorCacheMaxObjects,
+				VectorCacheMaxBytes:    DefaultVectorCacheMaxBytes,
+				VectorCacheDType:       DefaultVectorCacheDType,
+				VectorCachePrefill:     DefaultVectorCachePrefill,
 				EF:                     DefaultEF,
 				Skip:                   DefaultSkip,
 				FlatSearchCutoff:       DefaultFlatSearchCutoff,
+				AcornFilterRatio:       DefaultAcornFilterRatio,
 				DynamicEFMin:           DefaultDynamicEFMin,
 				DynamicEFMax:           DefaultDynamicEFMax,
 				DynamicEFFactor:        DefaultDynamicEFFactor,
 				Distance:               DefaultDistanceMetric,
+				IndexBuild:             DefaultIndexBuild,
 				PQ: PQConfig{
-					Enabled:        DefaultPQEnabled,
-					BitCompression: DefaultPQBitCompression,
-					Segments:       DefaultPQSegments,
-					Centroids:      DefaultPQCentroids,
-					TrainingLimit:  DefaultPQTrainingLimit,
+					Enabled:            DefaultPQEnabled,
+					BitCompression:     DefaultPQBitCompression,
+					Segments:           DefaultPQSegments,
+					Centroids:          DefaultPQCentroids,
+					TrainingLimit:      DefaultPQTrainingLimit,
+					AutoTrainThreshold: DefaultPQAutoTrainThreshold,
 					Encoder: PQEncoder{
 						Type:         DefaultPQEncoderType,
 						Distribution: DefaultPQEncoderDistribution,
 					},
 				},
+				SQ: SQConfig{
+					Enabled:       DefaultSQEnabled,
+					TrainingLimit: DefaultSQTrainingLimit,
+					RescoreLimit:  DefaultSQRescoreLimit,
+				},
+				AdaptiveEF: AdaptiveEFConfig{
+					Enabled:          DefaultAdaptiveEFEnabled,
+					TargetLatencyMs:  DefaultAdaptiveEFTargetLatencyMs,
+					TargetPercentile: DefaultAdaptiveEFTargetPercentile,
+				},
+			},
+		},
+
+		{
+			name: "with pq auto train",
+			input: map[string]interface{}{
+				"pq": map[string]interface{}{
+					"autoTrain":          true,
+					"autoTrainThreshold": json.Number("5000"),
+				},
+			},
+			expected: UserConfig{
+				CleanupIntervalSeconds: DefaultCleanupIntervalSeconds,
+				CleanupConcurrency:     DefaultCleanupConcurrency,
+				MaxConnections:         DefaultMaxConnections,
+				EFConstruction:         DefaultEFConstruction,
+				VectorCacheMaxObjects:  DefaultVectorCacheMaxObjects,
+				VectorCacheMaxBytes:    DefaultVectorCacheMaxBytes,
+				VectorCacheDType:       DefaultVectorCacheDType,
+				VectorCachePrefill:     DefaultVectorCachePrefill,
+				EF:                     DefaultEF,
+				Skip:                   DefaultSkip,
+				FlatSearchCutoff:       DefaultFlatSearchCutoff,
+				AcornFilterRatio:       DefaultAcornFilterRatio,
+				DynamicEFMin:           DefaultDynamicEFMin,
+				DynamicEFMax:           DefaultDynamicEFMax,
+				DynamicEFFactor:        DefaultDynamicEFFactor,
+				Distance:               DefaultDistanceMetric,
+				IndexBuild:             DefaultIndexBuild,
+				PQ: PQConfig{
+					Enabled:            DefaultPQEnabled,
+					BitCompression:     DefaultPQBitCompression,
+					Segments:           DefaultPQSegments,
+					Centroids:          DefaultPQCentroids,
+					TrainingLimit:      DefaultPQTrainingLimit,
+					AutoTrain:          true,
+					AutoTrainThreshold: 5000,
+					Encoder: PQEncoder{
+						Type:         DefaultPQEncoderType,
+						Distribution: DefaultPQEncoderDistribution,
+					},
+				},
+				SQ: SQConfig{
+					Enabled:       DefaultSQEnabled,
+					TrainingLimit: DefaultSQTrainingLimit,
+					RescoreLimit:  DefaultSQRescoreLimit,
+				},
+				AdaptiveEF: AdaptiveEFConfig{
+					Enabled:          DefaultAdaptiveEFEnabled,
+					TargetLatencyMs:  DefaultAdaptiveEFTargetLatencyMs,
+					TargetPercentile: DefaultAdaptiveEFTargetPercentile,
+				},
+			},
+		},
+
+		{
+			name: "with sq",
+			input: map[string]interface{}{
+				"sq": map[string]interface{}{
+					"enabled":       true,
+					"trainingLimit": json.Number("5000"),
+					"rescoreLimit":  json.Number("0"),
+				},
+			},
+			expected: UserConfig{
+				CleanupIntervalSeconds: DefaultCleanupIntervalSeconds,
+				CleanupConcurrency:     DefaultCleanupConcurrency,
+				MaxConnections:         DefaultMaxConnections,
+				EFConstruction:         DefaultEFConstruction,
+				VectorCacheMaxObjects:  DefaultVectorCacheMaxObjects,
+				VectorCacheMaxBytes:    DefaultVectorCacheMaxBytes,
+				VectorCacheDType:       DefaultVectorCacheDType,
+				VectorCachePrefill:     DefaultVectorCachePrefill,
+				EF:                     DefaultEF,
+				Skip:                   DefaultSkip,
+				FlatSearchCutoff:       DefaultFlatSearchCutoff,
+				AcornFilterRatio:       DefaultAcornFilterRatio,
+				DynamicEFMin:           DefaultDynamicEFMin,
+				DynamicEFMax:           DefaultDynamicEFMax,
+				DynamicEFFactor:        DefaultDynamicEFFactor,
+				Distance:               DefaultDistanceMetric,
+				IndexBuild:             DefaultIndexBuild,
+				PQ: PQConfig{
+					Enabled:            DefaultPQEnabled,
+					BitCompression:     DefaultPQBitCompression,
+					Segments:           DefaultPQSegments,
+					Centroids:          DefaultPQCentroids,
+					TrainingLimit:      DefaultPQTrainingLimit,
+					AutoTrain:          DefaultPQAutoTrain,
+					AutoTrainThreshold: DefaultPQAutoTrainThreshold,
+					Encoder: PQEncoder{
+						Type:         DefaultPQEncoderType,
+						Distribution: DefaultPQEncoderDistribution,
+					},
+				},
+				SQ: SQConfig{
+					Enabled:       true,
+					TrainingLimit: 5000,
+					RescoreLimit:  0,
+				},
+				AdaptiveEF: AdaptiveEFConfig{
+					Enabled:          DefaultAdaptiveEFEnabled,
+					TargetLatencyMs:  DefaultAdaptiveEFTargetLatencyMs,
+					TargetPercentile: DefaultAdaptiveEFTargetPercentile,
+				},
 			},
 		},
 
@@ -66,26 +188,43 @@ func Test_UserConfig(t *testing.T) {
 			},
 			expected: UserConfig{
 				CleanupIntervalSeconds: DefaultCleanupIntervalSeconds,
+				CleanupConcurrency:     DefaultCleanupConcurrency,
 				MaxConnections:         100,
 				EFConstruction:         DefaultEFConstruction,
 				VectorCacheMaxObjects:  DefaultVectorCacheMaxObjects,
+				VectorCacheMaxBytes:    DefaultVectorCacheMaxBytes,
+				VectorCacheDType:       DefaultVectorCacheDType,
+				VectorCachePrefill:     DefaultVectorCachePrefill,
 				EF:                     DefaultEF,
 				FlatSearchCutoff:       DefaultFlatSearchCutoff,
+				AcornFilterRatio:       DefaultAcornFilterRatio,
 				DynamicEFMin:           DefaultDynamicEFMin,
 				DynamicEFMax:           DefaultDynamicEFMax,
 				DynamicEFFactor:        DefaultDynamicEFFactor,
 				Distance:               DefaultDistanceMetric,
+				IndexBuild:             DefaultIndexBuild,
 				PQ: PQConfig{
-					Enabled:        DefaultPQEnabled,
-					BitCompression: DefaultPQBitCompression,
-					Segments:       DefaultPQSegments,
-					Centroids:      DefaultPQCentroids,
-					TrainingLimit:  DefaultPQTrainingLimit,
+					Enabled:            DefaultPQEnabled,
+					BitCompression:     DefaultPQBitCompression,
+					Segments:           DefaultPQSegments,
+					Centroids:          DefaultPQCentroids,
+					TrainingLimit:      DefaultPQTrainingLimit,
+					AutoTrainThreshold: DefaultPQAutoTrainThreshold,
 					Encoder: PQEncoder{
 						Type:         DefaultPQEncoderType,
 						Distribution: DefaultPQEncoderDistribution,
 					},
 				},
+				SQ: SQConfig{
+					Enabled:       DefaultSQEnabled,
+					TrainingLimit: DefaultSQTrainingLimit,
+					RescoreLimit:  DefaultSQRescoreLimit,
+				},
+				AdaptiveEF: AdaptiveEFConfig{
+					Enabled:          DefaultAdaptiveEFEnabled,
+					TargetLatencyMs:  DefaultAdaptiveEFTargetLatencyMs,
+					TargetPercentile: DefaultAdaptiveEFTargetPercentile,
+				},
 			},
 		},
 
@@ -106,27 +245,44 @@ func Test_UserConfig(t *testing.T) {
 			},
 			expected: UserConfig{
 				CleanupIntervalSeconds: 11,
+				CleanupConcurrency:     DefaultCleanupConcurrency,
 				MaxConnections:         12,
 				EFConstruction:         13,
 				VectorCacheMaxObjects:  14,
+				VectorCacheMaxBytes:    DefaultVectorCacheMaxBytes,
+				VectorCacheDType:       DefaultVectorCacheDType,
+				VectorCachePrefill:     DefaultVectorCachePrefill,
 				EF:                     15,
 				FlatSearchCutoff:       16,
+				AcornFilterRatio:       DefaultAcornFilterRatio,
 				DynamicEFMin:           17,
 				DynamicEFMax:           18,
 				DynamicEFFactor:        19,
 				Skip:                   true,
 				Distance:               "l2-squared",
+				IndexBuild:             DefaultIndexBuild,
 				PQ: PQConfig{
-					Enabled:        DefaultPQEnabled,
-					BitCompression: DefaultPQBitCompression,
-					Segments:       DefaultPQSegments,
-					Centroids:      DefaultPQCentroids,
-					TrainingLimit:  DefaultPQTrainingLimit,
+					Enabled:            DefaultPQEnabled,
+					BitCompression:     DefaultPQBitCompression,
+					Segments:           DefaultPQSegments,
+					Centroids:          DefaultPQCentroids,
+					TrainingLimit:      DefaultPQTrainingLimit,
+					AutoTrainThreshold: DefaultPQAutoTrainThreshold,
 					Encoder: PQEncoder{
 						Type:         DefaultPQEncoderType,
 						Distribution: DefaultPQEncoderDistribution,
 					},
 				},
+				SQ: SQConfig{
+					Enabled:       DefaultSQEnabled,
+					TrainingLimit: DefaultSQTrainingLimit,
+					RescoreLimit:  DefaultSQRescoreLimit,
+				},
+				AdaptiveEF: AdaptiveEFConfig{
+					Enabled:          DefaultAdaptiveEFEnabled,
+					TargetLatencyMs:  DefaultAdaptiveEFTargetLatencyMs,
+					TargetPercentile: DefaultAdaptiveEFTargetPercentile,
+				},
 			},
 		},
 
@@ -147,27 +303,44 @@ func Test_UserConfig(t *testing.T) {
 			},
 			expected: UserConfig{
 				CleanupIntervalSeconds: 11,
+				CleanupConcurrency:     DefaultCleanupConcurrency,
 				MaxConnections:         12,
 				EFConstruction:         13,
 				VectorCacheMaxObjects:  14,
+				VectorCacheMaxBytes:    DefaultVectorCacheMaxBytes,
+				VectorCacheDType:       DefaultVectorCacheDType,
+				VectorCachePrefill:     DefaultVectorCachePrefill,
 				EF:                     15,
 				FlatSearchCutoff:       16,
+				AcornFilterRatio:       DefaultAcornFilterRatio,
 				DynamicEFMin:           17,
 				DynamicEFMax:           18,
 				DynamicEFFactor:        19,
 				Skip:                   true,
 				Distance:               "manhattan",
+				IndexBuild:             DefaultIndexBuild,
 				PQ: PQConfig{
-					Enabled:        DefaultPQEnabled,
-					BitCompression: DefaultPQBitCompression,
-					Segments:       DefaultPQSegments,
-					Centroids:      DefaultPQCentroids,
-					TrainingLimit:  DefaultPQTrainingLimit,
+					Enabled:            DefaultPQEnabled,
+					BitCompression:     DefaultPQBitCompression,
+					Segments:           DefaultPQSegments,
+					Centroids:          DefaultPQCentroids,
+					TrainingLimit:      DefaultPQTrainingLimit,
+					AutoTrainThreshold: DefaultPQAutoTrainThreshold,
 					Encoder: PQEncoder{
 						Type:         DefaultPQEncoderType,
 						Distribution: DefaultPQEncoderDistribution,
 					},
 				},
+				SQ: SQConfig{
+					Enabled:       DefaultSQEnabled,
+					TrainingLimit: DefaultSQTrainingLimit,
+					RescoreLimit:  DefaultSQRescoreLimit,
+				},
+				AdaptiveEF: AdaptiveEFConfig{
+					Enabled:          DefaultAdaptiveEFEnabled,
+					TargetLatencyMs:  DefaultAdaptiveEFTargetLatencyMs,
+					TargetPercentile: DefaultAdaptiveEFTargetPercentile,
+				},
 			},
 		},
 
@@ -188,27 +361,44 @@ func Test_UserConfig(t *testing.T) {
 			},
 			expected: UserConfig{
 				CleanupIntervalSeconds: 11,
+				CleanupConcurrency:     DefaultCleanupConcurrency,
 				MaxConnections:         12,
 				EFConstruction:         13,
 				VectorCacheMaxObjects:  14,
+				VectorCacheMaxBytes:    DefaultVectorCacheMaxBytes,
+				VectorCacheDType:       DefaultVectorCacheDType,
+				VectorCachePrefill:     DefaultVectorCachePrefill,
 				EF:                     15,
 				FlatSearchCutoff:       16,
+				AcornFilterRatio:       DefaultAcornFilterRatio,
 				DynamicEFMin:           17,
 				DynamicEFMax:           18,
 				DynamicEFFactor:        19,
 				Skip:                   true,
 				Distance:               "hamming",
+				IndexBuild:             DefaultIndexBuild,
 				PQ: PQConfig{
-					Enabled:        DefaultPQEnabled,
-					BitCompression: DefaultPQBitCompression,
-					Segments:       DefaultPQSegments,
-					Centroids:      DefaultPQCentroids,
-					TrainingLimit:  DefaultPQTrainingLimit,
+					Enabled:            DefaultPQEnabled,
+					BitCompression:     DefaultPQBitCompression,
+					Segments:           DefaultPQSegments,
+					Centroids:          DefaultPQCentroids,
+					TrainingLimit:      DefaultPQTrainingLimit,
+					AutoTrainThreshold: DefaultPQAutoTrainThreshold,
 					Encoder: PQEncoder{
 						Type:         DefaultPQEncoderType,
 						Distribution: DefaultPQEncoderDistribution,
 					},
 				},
+				SQ: SQConfig{
+					Enabled:       DefaultSQEnabled,
+					TrainingLimit: DefaultSQTrainingLimit,
+					RescoreLimit:  DefaultSQRescoreLimit,
+				},
+				AdaptiveEF: AdaptiveEFConfig{
+					Enabled:          DefaultAdaptiveEFEnabled,
+					TargetLatencyMs:  DefaultAdaptiveEFTargetLatencyMs,
+					TargetPercentile: DefaultAdaptiveEFTargetPercentile,
+				},
 			},
 		},
 
@@ -228,26 +418,43 @@ func Test_UserConfig(t *testing.T) {
 			},
 			expected: UserConfig{
 				CleanupIntervalSeconds: 11,
+				CleanupConcurrency:     DefaultCleanupConcurrency,
 				MaxConnections:         12,
 				EFConstruction:         13,
 				VectorCacheMaxObjects:  14,
+				VectorCacheMaxBytes:    DefaultVectorCacheMaxBytes,
+				VectorCacheDType:       DefaultVectorCacheDType,
+				VectorCachePrefill:     DefaultVectorCachePrefill,
 				EF:                     15,
 				FlatSearchCutoff:       16,
+				AcornFilterRatio:       DefaultAcornFilterRatio,
 				DynamicEFMin:           17,
 				DynamicEFMax:           18,
 				DynamicEFFactor:        19,
 				Distance:               DefaultDistanceMetric,
+				IndexBuild:             DefaultIndexBuild,
 				PQ: PQConfig{
-					Enabled:        DefaultPQEnabled,
-					BitCompression: DefaultPQBitCompression,
-					Segments:       DefaultPQSegments,
-					Centroids:      DefaultPQCentroids,
-					TrainingLimit:  DefaultPQTrainingLimit,
+					Enabled:            DefaultPQEnabled,
+					BitCompression:     DefaultPQBitCompression,
+					Segments:           DefaultPQSegments,
+					Centroids:          DefaultPQCentroids,
+					TrainingLimit:      DefaultPQTrainingLimit,
+					AutoTrainThreshold: DefaultPQAutoTrainThreshold,
 					Encoder: PQEncoder{
 						Type:         DefaultPQEncoderType,
 						Distribution: DefaultPQEncoderDistribution,
 					},
 				},
+				SQ: SQConfig{
+					Enabled:       DefaultSQEnabled,
+					TrainingLimit: DefaultSQTrainingLimit,
+					RescoreLimit:  DefaultSQRescoreLimit,
+				},
+				AdaptiveEF: AdaptiveEFConfig{
+					Enabled:          DefaultAdaptiveEFEnabled,
+					TargetLatencyMs:  DefaultAdaptiveEFTargetLatencyMs,
+					TargetPercentile: DefaultAdaptiveEFTargetPercentile,
+				},
 			},
 		},
 
@@ -277,25 +484,42 @@ func Test_UserConfig(t *testing.T) {
 			},
 			expected: UserConfig{
 				CleanupIntervalSeconds: 11,
+				CleanupConcurrency:     DefaultCleanupConcurrency,
 				MaxConnections:         12,
 				EFConstruction:         13,
 				VectorCacheMaxObjects:  14,
+				VectorCacheMaxBytes:    DefaultVectorCacheMaxBytes,
+				VectorCacheDType:       DefaultVectorCacheDType,
+				VectorCachePrefill:     DefaultVectorCachePrefill,
 				EF:                     15,
 				FlatSearchCutoff:       16,
+				AcornFilterRatio:       DefaultAcornFilterRatio,
 				DynamicEFMin:           17,
 				DynamicEFMax:           18,
 				DynamicEFFactor:        19,
 				Distance:               DefaultDistanceMetric,
+				IndexBuild:             DefaultIndexBuild,
 				PQ: PQConfig{
-					Enabled:       true,
-					Segments:      64,
-					Centroids:     DefaultPQCentroids,
-					TrainingLimit: DefaultPQTrainingLimit,
+					Enabled:            true,
+					Segments:           64,
+					Centroids:          DefaultPQCentroids,
+					TrainingLimit:      DefaultPQTrainingLimit,
+					AutoTrainThreshold: DefaultPQAutoTrainThreshold,
 					Encoder: PQEncoder{
 						Type:         "tile",
 						Distribution: "normal",
 					},
 				},
+				SQ: SQConfig{
+					Enabled:       DefaultSQEnabled,
+					TrainingLimit: DefaultSQTrainingLimit,
+					RescoreLimit:  DefaultSQRescoreLimit,
+				},
+				AdaptiveEF: AdaptiveEFConfig{
+					Enabled:          DefaultAdaptiveEFEnabled,
+					TargetLatencyMs:  DefaultAdaptiveEFTargetLatencyMs,
+					TargetPercentile: DefaultAdaptiveEFTargetPercentile,
+				},
 			},
 		},
 
@@ -324,25 +548,42 @@ func Test_UserConfig(t *testing.T) {
 			},
 			expected: UserConfig{
 				CleanupIntervalSeconds: 11,
+				CleanupConcurrency:     DefaultCleanupConcurrency,
 				MaxConnections:         12,
 				EFConstruction:         13,
 				VectorCacheMaxObjects:  14,
+				VectorCacheMaxBytes:    DefaultVectorCacheMaxBytes,
+				VectorCacheDType:       DefaultVectorCacheDType,
+				VectorCachePrefill:     DefaultVectorCachePrefill,
 				EF:                     15,
 				FlatSearchCutoff:       16,
+				AcornFilterRatio:       DefaultAcornFilterRatio,
 				DynamicEFMin:           17,
 				DynamicEFMax:           18,
 				DynamicEFFactor:        19,
 				Distance:               DefaultDistanceMetric,
+				IndexBuild:             DefaultIndexBuild,
 				PQ: PQConfig{
-					Enabled:       true,
-					Segments:      64,
-					Centroids:     DefaultPQCentroids,
-					TrainingLimit: DefaultPQTrainingLimit,
+					Enabled:            true,
+					Segments:           64,
+					Centroids:          DefaultPQCentroids,
+					TrainingLimit:      DefaultPQTrainingLimit,
+					AutoTrainThreshold: DefaultPQAutoTrainThreshold,
 					Encoder: PQEncoder{
 						Type:         DefaultPQEncoderType,
 						Distribution: DefaultPQEncoderDistribution,
 					},
 				},
+				SQ: SQConfig{
+					Enabled:       DefaultSQEnabled,
+					TrainingLimit: DefaultSQTrainingLimit,
+					RescoreLimit:  DefaultSQRescoreLimit,
+				},
+				AdaptiveEF: AdaptiveEFConfig{
+					Enabled:          DefaultAdaptiveEFEnabled,
+					TargetLatencyMs:  DefaultAdaptiveEFTargetLatencyMs,
+					TargetPercentile: DefaultAdaptiveEFTargetPercentile,
+				},
 			},
 		},
 
@@ -390,27 +631,472 @@ func Test_UserConfig(t *testing.T) {
 			},
 			expected: UserConfig{
 				CleanupIntervalSeconds: 11,
+				CleanupConcurrency:     DefaultCleanupConcurrency,
 				MaxConnections:         12,
 				EFConstruction:         13,
 				VectorCacheMaxObjects:  math.MaxInt64,
+				VectorCacheMaxBytes:    DefaultVectorCacheMaxBytes,
+				VectorCacheDType:       DefaultVectorCacheDType,
+				VectorCachePrefill:     DefaultVectorCachePrefill,
 				EF:                     15,
 				FlatSearchCutoff:       16,
+				AcornFilterRatio:       DefaultAcornFilterRatio,
 				DynamicEFMin:           17,
 				DynamicEFMax:           18,
 				DynamicEFFactor:        19,
 				Distance:               DefaultDistanceMetric,
+				IndexBuild:             DefaultIndexBuild,
+				PQ: PQConfig{
+					Enabled:            DefaultPQEnabled,
+					BitCompression:     DefaultPQBitCompression,
+					Segments:           DefaultPQSegments,
+					Centroids:          DefaultPQCentroids,
+					TrainingLimit:      DefaultPQTrainingLimit,
+					AutoTrainThreshold: DefaultPQAutoTrainThreshold,
+					Encoder: PQEncoder{
+						Type:         DefaultPQEncoderType,
+						Distribution: DefaultPQEncoderDistribution,
+					},
+				},
+				SQ: SQConfig{
+					Enabled:       DefaultSQEnabled,
+					TrainingLimit: DefaultSQTrainingLimit,
+					RescoreLimit:  DefaultSQRescoreLimit,
+				},
+				AdaptiveEF: AdaptiveEFConfig{
+					Enabled:          DefaultAdaptiveEFEnabled,
+					TargetLatencyMs:  DefaultAdaptiveEFTargetLatencyMs,
+					TargetPercentile: DefaultAdaptiveEFTargetPercentile,
+				},
+			},
+		},
+		{
+			name: "with float16 vector cache",
+			input: map[string]interface{}{
+				"vectorCacheDType": "float16",
+			},
+			expected: UserConfig{
+				CleanupIntervalSeconds: DefaultCleanupIntervalSeconds,
+				CleanupConcurrency:     DefaultCleanupConcurrency,
+				MaxConnections:         DefaultMaxConnections,
+				EFConstruction:         DefaultEFConstruction,
+				VectorCacheMaxObjects:  DefaultVectorCacheMaxObjects,
+				VectorCacheMaxBytes:    DefaultVectorCacheMaxBytes,
+				VectorCacheDType:       VectorCacheDTypeFloat16,
+				VectorCachePrefill:     DefaultVectorCachePrefill,
+				EF:                     DefaultEF,
+				FlatSearchCutoff:       DefaultFlatSearchCutoff,
+				AcornFilterRatio:       DefaultAcornFilterRatio,
+				DynamicEFMin:           DefaultDynamicEFMin,
+				DynamicEFMax:           DefaultDynamicEFMax,
+				DynamicEFFactor:        DefaultDynamicEFFactor,
+				Distance:               DefaultDistanceMetric,
+				IndexBuild:             DefaultIndexBuild,
+				PQ: PQConfig{
+					Enabled:            DefaultPQEnabled,
+					BitCompression:     DefaultPQBitCompression,
+					Segments:           DefaultPQSegments,
+					Centroids:          DefaultPQCentroids,
+					TrainingLimit:      DefaultPQTrainingLimit,
+					AutoTrainThreshold: DefaultPQAutoTrainThreshold,
+					Encoder: PQEncoder{
+						Type:         DefaultPQEncoderType,
+						Distribution: DefaultPQEncoderDistribution,
+					},
+				},
+				SQ: SQConfig{
+					Enabled:       DefaultSQEnabled,
+					TrainingLimit: DefaultSQTrainingLimit,
+					RescoreLimit:  DefaultSQRescoreLimit,
+				},
+				AdaptiveEF: AdaptiveEFConfig{
+					Enabled:          DefaultAdaptiveEFEnabled,
+					TargetLatencyMs:  DefaultAdaptiveEFTargetLatencyMs,
+					TargetPercentile: DefaultAdaptiveEFTargetPercentile,
+				},
+			},
+		},
+		{
+			name: "with recently accessed vector cache prefill",
+			input: map[string]interface{}{
+				"vectorCachePrefill": "recentlyAccessed",
+			},
+			expected: UserConfig{
+				CleanupIntervalSeconds: DefaultCleanupIntervalSeconds,
+				CleanupConcurrency:     DefaultCleanupConcurrency,
+				MaxConnections:         DefaultMaxConnections,
+				EFConstruction:         DefaultEFConstruction,
+				VectorCacheMaxObjects:  DefaultVectorCacheMaxObjects,
+				VectorCacheMaxBytes:    DefaultVectorCacheMaxBytes,
+				VectorCacheDType:       DefaultVectorCacheDType,
+				VectorCachePrefill:     VectorCachePrefillRecentlyAccessed,
+				EF:                     DefaultEF,
+				FlatSearchCutoff:       DefaultFlatSearchCutoff,
+				AcornFilterRatio:       DefaultAcornFilterRatio,
+				DynamicEFMin:           DefaultDynamicEFMin,
+				DynamicEFMax:           DefaultDynamicEFMax,
+				DynamicEFFactor:        DefaultDynamicEFFactor,
+				Distance:               DefaultDistanceMetric,
+				IndexBuild:             DefaultIndexBuild,
+				PQ: PQConfig{
+					Enabled:            DefaultPQEnabled,
+					BitCompression:     DefaultPQBitCompression,
+					Segments:           DefaultPQSegments,
+					Centroids:          DefaultPQCentroids,
+					TrainingLimit:      DefaultPQTrainingLimit,
+					AutoTrainThreshold: DefaultPQAutoTrainThreshold,
+					Encoder: PQEncoder{
+						Type:         DefaultPQEncoderType,
+						Distribution: DefaultPQEncoderDistribution,
+					},
+				},
+				SQ: SQConfig{
+					Enabled:       DefaultSQEnabled,
+					TrainingLimit: DefaultSQTrainingLimit,
+					RescoreLimit:  DefaultSQRescoreLimit,
+				},
+				AdaptiveEF: AdaptiveEFConfig{
+					Enabled:          DefaultAdaptiveEFEnabled,
+					TargetLatencyMs:  DefaultAdaptiveEFTargetLatencyMs,
+					TargetPercentile: DefaultAdaptiveEFTargetPercentile,
+				},
+			},
+		},
+		{
+			name: "with gpu index build",
+			input: map[string]interface{}{
+				"indexBuild": "gpu",
+			},
+			expected: UserConfig{
+				CleanupIntervalSeconds: DefaultCleanupIntervalSeconds,
+				CleanupConcurrency:     DefaultCleanupConcurrency,
+				MaxConnections:         DefaultMaxConnections,
+				EFConstruction:         DefaultEFConstruction,
+				VectorCacheMaxObjects:  DefaultVectorCacheMaxObjects,
+				VectorCacheMaxBytes:    DefaultVectorCacheMaxBytes,
+				VectorCacheDType:       DefaultVectorCacheDType,
+				VectorCachePrefill:     DefaultVectorCachePrefill,
+				EF:                     DefaultEF,
+				FlatSearchCutoff:       DefaultFlatSearchCutoff,
+				AcornFilterRatio:       DefaultAcornFilterRatio,
+				DynamicEFMin:           DefaultDynamicEFMin,
+				DynamicEFMax:           DefaultDynamicEFMax,
+				DynamicEFFactor:        DefaultDynamicEFFactor,
+				Distance:               DefaultDistanceMetric,
+				IndexBuild:             IndexBuildGPU,
+				PQ: PQConfig{
+					Enabled:            DefaultPQEnabled,
+					BitCompression:     DefaultPQBitCompression,
+					Segments:           DefaultPQSegments,
+					Centroids:          DefaultPQCentroids,
+					TrainingLimit:      DefaultPQTrainingLimit,
+					AutoTrainThreshold: DefaultPQAutoTrainThreshold,
+					Encoder: PQEncoder{
+						Type:         DefaultPQEncoderType,
+						Distribution: DefaultPQEncoderDistribution,
+					},
+				},
+				SQ: SQConfig{
+					Enabled:       DefaultSQEnabled,
+					TrainingLimit: DefaultSQTrainingLimit,
+					RescoreLimit:  DefaultSQRescoreLimit,
+				},
+				AdaptiveEF: AdaptiveEFConfig{
+					Enabled:          DefaultAdaptiveEFEnabled,
+					TargetLatencyMs:  DefaultAdaptiveEFTargetLatencyMs,
+					TargetPercentile: DefaultAdaptiveEFTargetPercentile,
+				},
+			},
+		},
+		{
+			name: "with adaptive ef",
+			input: map[string]interface{}{
+				"adaptiveEf": map[string]interface{}{
+					"enabled":          true,
+					"targetLatencyMs":  json.Number("20"),
+					"targetPercentile": float64(99),
+				},
+			},
+			expected: UserConfig{
+				CleanupIntervalSeconds: DefaultCleanupIntervalSeconds,
+				CleanupConcurrency:     DefaultCleanupConcurrency,
+				MaxConnections:         DefaultMaxConnections,
+				EFConstruction:         DefaultEFConstruction,
+				VectorCacheMaxObjects:  DefaultVectorCacheMaxObjects,
+				VectorCacheMaxBytes:    DefaultVectorCacheMaxBytes,
+				VectorCacheDType:       DefaultVectorCacheDType,
+				VectorCachePrefill:     DefaultVectorCachePrefill,
+				EF:                     DefaultEF,
+				FlatSearchCutoff:       DefaultFlatSearchCutoff,
+				AcornFilterRatio:       DefaultAcornFilterRatio,
+				DynamicEFMin:           DefaultDynamicEFMin,
+				DynamicEFMax:           DefaultDynamicEFMax,
+				DynamicEFFactor:        DefaultDynamicEFFactor,
+				Distance:               DefaultDistanceMetric,
+				IndexBuild:             DefaultIndexBuild,
+				PQ: PQConfig{
+					Enabled:            DefaultPQEnabled,
+					BitCompression:     DefaultPQBitCompression,
+					Segments:           DefaultPQSegments,
+					Centroids:          DefaultPQCentroids,
+					TrainingLimit:      DefaultPQTrainingLimit,
+					AutoTrainThreshold: DefaultPQAutoTrainThreshold,
+					Encoder: PQEncoder{
+						Type:         DefaultPQEncoderType,
+						Distribution: DefaultPQEncoderDistribution,
+					},
+				},
+				SQ: SQConfig{
+					Enabled:       DefaultSQEnabled,
+					TrainingLimit: DefaultSQTrainingLimit,
+					RescoreLimit:  DefaultSQRescoreLimit,
+				},
+				AdaptiveEF: AdaptiveEFConfig{
+					Enabled:          true,
+					TargetLatencyMs:  20,
+					TargetPercentile: 99,
+				},
+			},
+		},
+		{
+			name: "invalid adaptive ef target latency",
+			input: map[string]interface{}{
+				"adaptiveEf": map[string]interface{}{
+					"enabled":         true,
+					"targetLatencyMs": json.Number("0"),
+				},
+			},
+			expectErr:    true,
+			expectErrMsg: "adaptiveEf.targetLatencyMs must be a positive integer, got 0",
+		},
+		{
+			name: "invalid adaptive ef target percentile",
+			input: map[string]interface{}{
+				"adaptiveEf": map[string]interface{}{
+					"enabled":          true,
+					"targetPercentile": json.Number("101"),
+				},
+			},
+			expectErr:    true,
+			expectErrMsg: "adaptiveEf.targetPercentile must be between 1 and 100, got 101",
+		},
+		{
+			name: "with a vector cache byte budget",
+			input: map[string]interface{}{
+				"vectorCacheMaxBytes": json.Number("1073741824"),
+			},
+			expected: UserConfig{
+				CleanupIntervalSeconds: DefaultCleanupIntervalSeconds,
+				CleanupConcurrency:     DefaultCleanupConcurrency,
+				MaxConnections:         DefaultMaxConnections,
+				EFConstruction:         DefaultEFConstruction,
+				VectorCacheMaxObjects:  DefaultVectorCacheMaxObjects,
+				VectorCacheMaxBytes:    1 << 30,
+				VectorCacheDType:       DefaultVectorCacheDType,
+				VectorCachePrefill:     DefaultVectorCachePrefill,
+				EF:                     DefaultEF,
+				FlatSearchCutoff:       DefaultFlatSearchCutoff,
+				AcornFilterRatio:       DefaultAcornFilterRatio,
+				DynamicEFMin:           DefaultDynamicEFMin,
+				DynamicEFMax:           DefaultDynamicEFMax,
+				DynamicEFFactor:        DefaultDynamicEFFactor,
+				Distance:               DefaultDistanceMetric,
+				IndexBuild:             DefaultIndexBuild,
 				PQ: PQConfig{
-					Enabled:        DefaultPQEnabled,
-					BitCompression: DefaultPQBitCompression,
-					Segments:       DefaultPQSegments,
-					Centroids:      DefaultPQCentroids,
-					TrainingLimit:  DefaultPQTrainingLimit,
+					Enabled:            DefaultPQEnabled,
+					BitCompression:     DefaultPQBitCompression,
+					Segments:           DefaultPQSegments,
+					Centroids:          DefaultPQCentroids,
+					TrainingLimit:      DefaultPQTrainingLimit,
+					AutoTrainThreshold: DefaultPQAutoTrainThreshold,
 					Encoder: PQEncoder{
 						Type:         DefaultPQEncoderType,
 						Distribution: DefaultPQEncoderDistribution,
 					},
 				},
+				SQ: SQConfig{
+					Enabled:       DefaultSQEnabled,
+					TrainingLimit: DefaultSQTrainingLimit,
+					RescoreLimit:  DefaultSQRescoreLimit,
+				},
+				AdaptiveEF: AdaptiveEFConfig{
+					Enabled:          DefaultAdaptiveEFEnabled,
+					TargetLatencyMs:  DefaultAdaptiveEFTargetLatencyMs,
+					TargetPercentile: DefaultAdaptiveEFTargetPercentile,
+				},
+			},
+		},
+		{
+			name: "with an acorn filter ratio",
+			input: map[string]interface{}{
+				"acornFilterRatio": json.Number("0.1"),
+			},
+			expected: UserConfig{
+				CleanupIntervalSeconds: DefaultCleanupIntervalSeconds,
+				CleanupConcurrency:     DefaultCleanupConcurrency,
+				MaxConnections:         DefaultMaxConnections,
+				EFConstruction:         DefaultEFConstruction,
+				VectorCacheMaxObjects:  DefaultVectorCacheMaxObjects,
+				VectorCacheMaxBytes:    DefaultVectorCacheMaxBytes,
+				VectorCacheDType:       DefaultVectorCacheDType,
+				VectorCachePrefill:     DefaultVectorCachePrefill,
+				EF:                     DefaultEF,
+				FlatSearchCutoff:       DefaultFlatSearchCutoff,
+				AcornFilterRatio:       0.1,
+				DynamicEFMin:           DefaultDynamicEFMin,
+				DynamicEFMax:           DefaultDynamicEFMax,
+				DynamicEFFactor:        DefaultDynamicEFFactor,
+				Distance:               DefaultDistanceMetric,
+				IndexBuild:             DefaultIndexBuild,
+				PQ: PQConfig{
+					Enabled:            DefaultPQEnabled,
+					BitCompression:     DefaultPQBitCompression,
+					Segments:           DefaultPQSegments,
+					Centroids:          DefaultPQCentroids,
+					TrainingLimit:      DefaultPQTrainingLimit,
+					AutoTrainThreshold: DefaultPQAutoTrainThreshold,
+					Encoder: PQEncoder{
+						Type:         DefaultPQEncoderType,
+						Distribution: DefaultPQEncoderDistribution,
+					},
+				},
+				SQ: SQConfig{
+					Enabled:       DefaultSQEnabled,
+					TrainingLimit: DefaultSQTrainingLimit,
+					RescoreLimit:  DefaultSQRescoreLimit,
+				},
+				AdaptiveEF: AdaptiveEFConfig{
+					Enabled:          DefaultAdaptiveEFEnabled,
+					TargetLatencyMs:  DefaultAdaptiveEFTargetLatencyMs,
+					TargetPercentile: DefaultAdaptiveEFTargetPercentile,
+				},
+			},
+		},
+		{
+			name: "invalid acorn filter ratio",
+			input: map[string]interface{}{
+				"acornFilterRatio": float64(1.5),
+			},
+			expectErr:    true,
+			expectErrMsg: "acornFilterRatio must be between 0 (disabled) and 1, got 1.5",
+		},
+		{
+			name: "invalid vector cache byte budget",
+			input: map[string]interface{}{
+				"vectorCacheMaxBytes": json.Number("-1"),
+			},
+			expectErr:    true,
+			expectErrMsg: "vectorCacheMaxBytes must be 0 (disabled) or a positive integer",
+		},
+		{
+			name: "invalid pq auto train threshold",
+			input: map[string]interface{}{
+				"pq": map[string]interface{}{
+					"autoTrain":          true,
+					"autoTrainThreshold": json.Number("0"),
+				},
+			},
+			expectErr:    true,
+			expectErrMsg: "pq.autoTrainThreshold must be a positive integer",
+		},
+		{
+			name: "invalid sq training limit",
+			input: map[string]interface{}{
+				"sq": map[string]interface{}{
+					"enabled":       true,
+					"trainingLimit": json.Number("0"),
+				},
+			},
+			expectErr:    true,
+			expectErrMsg: "sq.trainingLimit must be a positive integer, got 0",
+		},
+		{
+			name: "invalid sq rescore limit",
+			input: map[string]interface{}{
+				"sq": map[string]interface{}{
+					"rescoreLimit": json.Number("-1"),
+				},
+			},
+			expectErr:    true,
+			expectErrMsg: "sq.rescoreLimit must be 0 (disabled) or a positive integer, got -1",
+		},
+		{
+			name: "sq together with pq",
+			input: map[string]interface{}{
+				"pq": map[string]interface{}{
+					"enabled": true,
+				},
+				"sq": map[string]interface{}{
+					"enabled": true,
+				},
+			},
+			expectErr:    true,
+			expectErrMsg: "sq cannot be enabled together with pq or pq.autoTrain",
+		},
+		{
+			name: "invalid distance",
+			input: map[string]interface{}{
+				"distance": "tanimoto",
+			},
+			expectErr: true,
+			expectErrMsg: "distance must be one of \"cosine\", \"dot\", \"l2-squared\", " +
+				"\"manhattan\", \"hamming\" or \"jaccard\", got \"tanimoto\"",
+		},
+		{
+			name: "jaccard together with pq",
+			input: map[string]interface{}{
+				"distance": "jaccard",
+				"pq": map[string]interface{}{
+					"enabled": true,
+				},
+			},
+			expectErr:    true,
+			expectErrMsg: "pq cannot be enabled together with distance \"jaccard\"",
+		},
+		{
+			name: "invalid cleanup concurrency",
+			input: map[string]interface{}{
+				"cleanupConcurrency": json.Number("0"),
 			},
+			expectErr:    true,
+			expectErrMsg: "cleanupConcurrency must be a positive integer",
+		},
+		{
+			name: "invalid vector cache prefill policy",
+			input: map[string]interface{}{
+				"vectorCachePrefill": "random",
+			},
+			expectErr: true,
+			expectErrMsg: "vectorCachePrefill must be one of \"upperLayers\" or " +
+				"\"recentlyAccessed\", got \"random\"",
+		},
+		{
+			name: "invalid index build",
+			input: map[string]interface{}{
+				"indexBuild": "tpu",
+			},
+			expectErr:    true,
+			expectErrMsg: "indexBuild must be one of \"cpu\" or \"gpu\", got \"tpu\"",
+		},
+		{
+			name: "gpu index build with sq",
+			input: map[string]interface{}{
+				"indexBuild": "gpu",
+				"sq": map[string]interface{}{
+					"enabled": true,
+				},
+			},
+			expectErr:    true,
+			expectErrMsg: "indexBuild \"gpu\" cannot be combined with pq, pq.autoTrain or sq",
+		},
+		{
+			name: "invalid vector cache dtype",
+			input: map[string]interface{}{
+				"vectorCacheDType": "bfloat16",
+			},
+			expectErr: true,
+			expectErrMsg: "vectorCacheDType must be one of \"float32\" or \"float16\", " +
+				"got \"bfloat16\"",
 		},
 		{
 			name: "invalid max connections (json)",
@@ -30,6 +30,8 @@ const (
 	DefaultPQEncoderDistribution = PQEncoderDistributionLogNormal
 	DefaultPQCentroids           = 256
 	DefaultPQTrainingLimit       = 100000
+	DefaultPQAutoTrain           = false
+	DefaultPQAutoTrainThreshold  = 100000
 )
 
 // Product Quantization encoder configuration
@@ -46,6 +48,11 @@ type PQConfig struct {
 	Centroids      int       `json:"centroids"`
 	TrainingLimit  int       `json:"trainingLimit"`
 	Encoder        PQEncoder `json:"encoder"`
+	// AutoTrain compresses the index without user action once
+	// AutoTrainThreshold vectors have been imported. The codebook is trained
+	// in the background on a sample of up to TrainingLimit vectors.
+	AutoTrain          bool `json:"autoTrain"`
+	AutoTrainThreshold int  `json:"autoTrainThreshold"`
 }
 
 func validEncoder(v string) error {
@@ -165,6 +172,18 @@ func parsePQMap(in map[string]interface{}, pq *PQConfig) error {
 		return err
 	}
 
+	if err := optionalBoolFromMap(pqConfigMap, "autoTrain", func(v bool) {
+		pq.AutoTrain = v
+	}); err != nil {
+		return err
+	}
+
+	if err := optionalIntFromMap(pqConfigMap, "autoTrainThreshold", func(v int) {
+		pq.AutoTrainThreshold = v
+	}); err != nil {
+		return err
+	}
+
 	pqEncoderValue, ok := pqConfigMap["encoder"]
 	if !ok {
 		return nil
@@ -0,0 +1,80 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"fmt"
+)
+
+const (
+	DefaultSQEnabled       = false
+	DefaultSQTrainingLimit = 100000
+	DefaultSQRescoreLimit  = 20
+)
+
+// Scalar Quantization configuration. Each dimension of a vector is
+// compressed into a single byte, the range of the values is learned from up
+// to TrainingLimit vectors. As the distances on the compressed vectors are
+// only an approximation, the best RescoreLimit results of a search are
+// rescored with the uncompressed vectors. A RescoreLimit of 0 turns the
+// rescoring off.
+type SQConfig struct {
+	Enabled       bool `json:"enabled"`
+	TrainingLimit int  `json:"trainingLimit"`
+	RescoreLimit  int  `json:"rescoreLimit"`
+}
+
+func ValidateSQConfig(cfg SQConfig) error {
+	if cfg.TrainingLimit < 1 {
+		return fmt.Errorf("sq.trainingLimit must be a positive integer, got %d",
+			cfg.TrainingLimit)
+	}
+
+	if cfg.RescoreLimit < 0 {
+		return fmt.Errorf("sq.rescoreLimit must be 0 (disabled) or a positive integer, got %d",
+			cfg.RescoreLimit)
+	}
+
+	return nil
+}
+
+func parseSQMap(in map[string]interface{}, sq *SQConfig) error {
+	value, ok := in["sq"]
+	if !ok {
+		return nil
+	}
+
+	asMap, ok := value.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	if err := optionalBoolFromMap(asMap, "enabled", func(v bool) {
+		sq.Enabled = v
+	}); err != nil {
+		return err
+	}
+
+	if err := optionalIntFromMap(asMap, "trainingLimit", func(v int) {
+		sq.TrainingLimit = v
+	}); err != nil {
+		return err
+	}
+
+	if err := optionalIntFromMap(asMap, "rescoreLimit", func(v int) {
+		sq.RescoreLimit = v
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,196 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package vamana
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/vectorindex/common"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+const (
+	// Set these defaults if the user leaves them blank
+	DefaultCleanupIntervalSeconds = 5 * 60
+	DefaultMaxDegree              = 64
+	DefaultBuildSearchListSize    = 128
+	DefaultSearchListSize         = 100
+	DefaultAlpha                  = 1.2
+	DefaultCacheMaxObjects        = 10000
+	DefaultSkip                   = false
+	DefaultFlatSearchCutoff       = 40000
+	DefaultDistanceMetric         = hnsw.DistanceCosine
+
+	// Fail validation if those criteria are not met
+	MinimumMaxDegree           = 4
+	MinimumBuildSearchListSize = 4
+	MinimumSearchListSize      = 1
+	MinimumAlpha               = 1.0
+)
+
+// UserConfig bundles all values settable by a user in the per-class settings
+type UserConfig struct {
+	Skip                   bool    `json:"skip"`
+	CleanupIntervalSeconds int     `json:"cleanupIntervalSeconds"`
+	MaxDegree              int     `json:"maxDegree"`
+	BuildSearchListSize    int     `json:"buildSearchListSize"`
+	SearchListSize         int     `json:"searchListSize"`
+	Alpha                  float64 `json:"alpha"`
+	CacheMaxObjects        int     `json:"cacheMaxObjects"`
+	FlatSearchCutoff       int     `json:"flatSearchCutoff"`
+	Distance               string  `json:"distance"`
+}
+
+// IndexType returns the type of the underlying vector index, thus making sure
+// the schema.VectorIndexConfig interface is implemented
+func (u UserConfig) IndexType() string {
+	return "vamana"
+}
+
+// DistanceName returns the distance metric used by the index
+func (u UserConfig) DistanceName() string {
+	return u.Distance
+}
+
+// SetDefaults in the user-specifyable part of the config
+func (u *UserConfig) SetDefaults() {
+	u.Skip = DefaultSkip
+	u.CleanupIntervalSeconds = DefaultCleanupIntervalSeconds
+	u.MaxDegree = DefaultMaxDegree
+	u.BuildSearchListSize = DefaultBuildSearchListSize
+	u.SearchListSize = DefaultSearchListSize
+	u.Alpha = DefaultAlpha
+	u.CacheMaxObjects = DefaultCacheMaxObjects
+	u.FlatSearchCutoff = DefaultFlatSearchCutoff
+	u.Distance = DefaultDistanceMetric
+}
+
+// ParseAndValidateConfig from an unknown input value, as this is not further
+// specified in the API to allow of exchanging the index type
+func ParseAndValidateConfig(input interface{}) (schema.VectorIndexConfig, error) {
+	uc := UserConfig{}
+	uc.SetDefaults()
+
+	if input == nil {
+		return uc, nil
+	}
+
+	asMap, ok := input.(map[string]interface{})
+	if !ok || asMap == nil {
+		return uc, fmt.Errorf("input must be a non-nil map")
+	}
+
+	if err := common.OptionalBoolFromMap(asMap, "skip", func(v bool) {
+		uc.Skip = v
+	}); err != nil {
+		return uc, err
+	}
+
+	if err := common.OptionalIntFromMap(asMap, "cleanupIntervalSeconds", func(v int) {
+		uc.CleanupIntervalSeconds = v
+	}); err != nil {
+		return uc, err
+	}
+
+	if err := common.OptionalIntFromMap(asMap, "maxDegree", func(v int) {
+		uc.MaxDegree = v
+	}); err != nil {
+		return uc, err
+	}
+
+	if err := common.OptionalIntFromMap(asMap, "buildSearchListSize", func(v int) {
+		uc.BuildSearchListSize = v
+	}); err != nil {
+		return uc, err
+	}
+
+	if err := common.OptionalIntFromMap(asMap, "searchListSize", func(v int) {
+		uc.SearchListSize = v
+	}); err != nil {
+		return uc, err
+	}
+
+	if err := common.OptionalFloatFromMap(asMap, "alpha", func(v float64) {
+		uc.Alpha = v
+	}); err != nil {
+		return uc, err
+	}
+
+	if err := common.OptionalIntFromMap(asMap, "cacheMaxObjects", func(v int) {
+		uc.CacheMaxObjects = v
+	}); err != nil {
+		return uc, err
+	}
+
+	if err := common.OptionalIntFromMap(asMap, "flatSearchCutoff", func(v int) {
+		uc.FlatSearchCutoff = v
+	}); err != nil {
+		return uc, err
+	}
+
+	if err := common.OptionalStringFromMap(asMap, "distance", func(v string) {
+		uc.Distance = v
+	}); err != nil {
+		return uc, err
+	}
+
+	return uc, uc.validate()
+}
+
+func (u *UserConfig) validate() error {
+	var errMsgs []string
+	if u.MaxDegree < MinimumMaxDegree {
+		errMsgs = append(errMsgs, fmt.Sprintf(
+			"maxDegree must be a positive integer with a minimum of %d",
+			MinimumMaxDegree,
+		))
+	}
+
+	if u.BuildSearchListSize < MinimumBuildSearchListSize {
+		errMsgs = append(errMsgs, fmt.Sprintf(
+			"buildSearchListSize must be a positive integer with a minimum of %d",
+			MinimumBuildSearchListSize,
+		))
+	}
+
+	if u.SearchListSize < MinimumSearchListSize {
+		errMsgs = append(errMsgs, fmt.Sprintf(
+			"searchListSize must be a positive integer with a minimum of %d",
+			MinimumSearchListSize,
+		))
+	}
+
+	if u.Alpha < MinimumAlpha {
+		errMsgs = append(errMsgs, fmt.Sprintf(
+			"alpha must be a number with a minimum of %v", MinimumAlpha,
+		))
+	}
+
+	if u.CacheMaxObjects < 0 {
+		errMsgs = append(errMsgs, "cacheMaxObjects must not be negative")
+	}
+
+	if len(errMsgs) > 0 {
+		return fmt.Errorf("invalid vamana config: %s",
+			strings.Join(errMsgs, ", "))
+	}
+
+	return nil
+}
+
+func NewDefaultUserConfig() UserConfig {
+	uc := UserConfig{}
+	uc.SetDefaults()
+	return uc
+}
@@ -0,0 +1,136 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package vamana
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func Test_UserConfig(t *testing.T) {
+	type test struct {
+		name         string
+		input        interface{}
+		expected     UserConfig
+		expectErr    bool
+		expectErrMsg string
+	}
+
+	tests := []test{
+		{
+			name:     "nothing specified, all defaults",
+			input:    nil,
+			expected: NewDefaultUserConfig(),
+		},
+		{
+			name: "with all optional fields",
+			input: map[string]interface{}{
+				"skip":                   true,
+				"cleanupIntervalSeconds": json.Number("11"),
+				"maxDegree":              json.Number("32"),
+				"buildSearchListSize":    json.Number("75"),
+				"searchListSize":         json.Number("50"),
+				"alpha":                  json.Number("1.5"),
+				"cacheMaxObjects":        json.Number("500"),
+				"flatSearchCutoff":       json.Number("1000"),
+				"distance":               "l2-squared",
+			},
+			expected: UserConfig{
+				Skip:                   true,
+				CleanupIntervalSeconds: 11,
+				MaxDegree:              32,
+				BuildSearchListSize:    75,
+				SearchListSize:         50,
+				Alpha:                  1.5,
+				CacheMaxObjects:        500,
+				FlatSearchCutoff:       1000,
+				Distance:               hnsw.DistanceL2Squared,
+			},
+		},
+		{
+			name: "with raw data as floats",
+			input: map[string]interface{}{
+				"maxDegree":      float64(16),
+				"searchListSize": float64(20),
+				"alpha":          float64(1),
+			},
+			expected: UserConfig{
+				CleanupIntervalSeconds: DefaultCleanupIntervalSeconds,
+				MaxDegree:              16,
+				BuildSearchListSize:    DefaultBuildSearchListSize,
+				SearchListSize:         20,
+				Alpha:                  1,
+				CacheMaxObjects:        DefaultCacheMaxObjects,
+				FlatSearchCutoff:       DefaultFlatSearchCutoff,
+				Distance:               DefaultDistanceMetric,
+			},
+		},
+		{
+			name: "invalid maxDegree",
+			input: map[string]interface{}{
+				"maxDegree": json.Number("2"),
+			},
+			expectErr:    true,
+			expectErrMsg: "maxDegree must be a positive integer with a minimum of 4",
+		},
+		{
+			name: "invalid buildSearchListSize",
+			input: map[string]interface{}{
+				"buildSearchListSize": json.Number("0"),
+			},
+			expectErr:    true,
+			expectErrMsg: "buildSearchListSize must be a positive integer with a minimum of 4",
+		},
+		{
+			name: "invalid searchListSize",
+			input: map[string]interface{}{
+				"searchListSize": json.Number("-1"),
+			},
+			expectErr:    true,
+			expectErrMsg: "searchListSize must be a positive integer with a minimum of 1",
+		},
+		{
+			name: "invalid alpha",
+			input: map[string]interface{}{
+				"alpha": json.Number("0.8"),
+			},
+			expectErr:    true,
+			expectErrMsg: "alpha must be a number with a minimum of 1",
+		},
+		{
+			name: "invalid cacheMaxObjects",
+			input: map[string]interface{}{
+				"cacheMaxObjects": json.Number("-5"),
+			},
+			expectErr:    true,
+			expectErrMsg: "cacheMaxObjects must not be negative",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg, err := ParseAndValidateConfig(test.input)
+			if test.expectErr {
+				require.NotNil(t, err)
+				assert.Contains(t, err.Error(), test.expectErrMsg)
+				return
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, test.expected, cfg)
+			}
+		})
+	}
+}
@@ -0,0 +1,496 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package protocol
+
+import (
+	reflect "reflect"
+	sync "sync"
+
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type BatchGetObjectsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClassName            string                           `protobuf:"bytes,1,opt,name=class_name,json=className,proto3" json:"class_name,omitempty"`
+	Objects              []*BatchGetObjectsRequest_Object `protobuf:"bytes,2,rep,name=objects,proto3" json:"objects,omitempty"`
+	Tenant               string                           `protobuf:"bytes,3,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	ConsistencyLevel     *ConsistencyLevel                `protobuf:"varint,4,opt,name=consistency_level,json=consistencyLevel,proto3,enum=weaviategrpc.ConsistencyLevel,oneof" json:"consistency_level,omitempty"`
+	Properties           *Properties                      `protobuf:"bytes,5,opt,name=properties,proto3" json:"properties,omitempty"`
+	AdditionalProperties *AdditionalProperties            `protobuf:"bytes,6,opt,name=additional_properties,json=additionalProperties,proto3" json:"additional_properties,omitempty"`
+}
+
+func (x *BatchGetObjectsRequest) Reset() {
+	*x = BatchGetObjectsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_batch_get_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchGetObjectsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetObjectsRequest) ProtoMessage() {}
+
+func (x *BatchGetObjectsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_batch_get_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetObjectsRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetObjectsRequest) Descriptor() ([]byte, []int) {
+	return file_batch_get_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *BatchGetObjectsRequest) GetClassName() string {
+	if x != nil {
+		return x.ClassName
+	}
+	return ""
+}
+
+func (x *BatchGetObjectsRequest) GetObjects() []*BatchGetObjectsRequest_Object {
+	if x != nil {
+		return x.Objects
+	}
+	return nil
+}
+
+func (x *BatchGetObjectsRequest) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+func (x *BatchGetObjectsRequest) GetConsistencyLevel() ConsistencyLevel {
+	if x != nil && x.ConsistencyLevel != nil {
+		return *x.ConsistencyLevel
+	}
+	return ConsistencyLevel_CONSISTENCY_LEVEL_UNSPECIFIED
+}
+
+func (x *BatchGetObjectsRequest) GetProperties() *Properties {
+	if x != nil {
+		return x.Properties
+	}
+	return nil
+}
+
+func (x *BatchGetObjectsRequest) GetAdditionalProperties() *AdditionalProperties {
+	if x != nil {
+		return x.AdditionalProperties
+	}
+	return nil
+}
+
+type BatchGetObjectsReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// one entry per requested object, in the order of the request
+	Results []*BatchGetObjectsReply_BatchResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Took    float32                             `protobuf:"fixed32,2,opt,name=took,proto3" json:"took,omitempty"`
+}
+
+func (x *BatchGetObjectsReply) Reset() {
+	*x = BatchGetObjectsReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_batch_get_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchGetObjectsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetObjectsReply) ProtoMessage() {}
+
+func (x *BatchGetObjectsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_batch_get_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetObjectsReply.ProtoReflect.Descriptor instead.
+func (*BatchGetObjectsReply) Descriptor() ([]byte, []int) {
+	return file_batch_get_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BatchGetObjectsReply) GetResults() []*BatchGetObjectsReply_BatchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *BatchGetObjectsReply) GetTook() float32 {
+	if x != nil {
+		return x.Took
+	}
+	return 0
+}
+
+type BatchGetObjectsRequest_Object struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// overrides the properties selected for all objects of the request
+	Properties *Properties `protobuf:"bytes,2,opt,name=properties,proto3" json:"properties,omitempty"`
+	// overrides the metadata (eg the vector) selected for all objects of the
+	// request
+	AdditionalProperties *AdditionalProperties `protobuf:"bytes,3,opt,name=additional_properties,json=additionalProperties,proto3" json:"additional_properties,omitempty"`
+}
+
+func (x *BatchGetObjectsRequest_Object) Reset() {
+	*x = BatchGetObjectsRequest_Object{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_batch_get_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchGetObjectsRequest_Object) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetObjectsRequest_Object) ProtoMessage() {}
+
+func (x *BatchGetObjectsRequest_Object) ProtoReflect() protoreflect.Message {
+	mi := &file_batch_get_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetObjectsRequest_Object.ProtoReflect.Descriptor instead.
+func (*BatchGetObjectsRequest_Object) Descriptor() ([]byte, []int) {
+	return file_batch_get_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *BatchGetObjectsRequest_Object) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *BatchGetObjectsRequest_Object) GetProperties() *Properties {
+	if x != nil {
+		return x.Properties
+	}
+	return nil
+}
+
+func (x *BatchGetObjectsRequest_Object) GetAdditionalProperties() *AdditionalProperties {
+	if x != nil {
+		return x.AdditionalProperties
+	}
+	return nil
+}
+
+type BatchGetObjectsReply_BatchResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// position of the object in the request
+	Index  int32         `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Uuid   string        `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Found  bool          `protobuf:"varint,3,opt,name=found,proto3" json:"found,omitempty"`
+	Result *SearchResult `protobuf:"bytes,4,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *BatchGetObjectsReply_BatchResult) Reset() {
+	*x = BatchGetObjectsReply_BatchResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_batch_get_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchGetObjectsReply_BatchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetObjectsReply_BatchResult) ProtoMessage() {}
+
+func (x *BatchGetObjectsReply_BatchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_batch_get_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetObjectsReply_BatchResult.ProtoReflect.Descriptor instead.
+func (*BatchGetObjectsReply_BatchResult) Descriptor() ([]byte, []int) {
+	return file_batch_get_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *BatchGetObjectsReply_BatchResult) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *BatchGetObjectsReply_BatchResult) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *BatchGetObjectsReply_BatchResult) GetFound() bool {
+	if x != nil {
+		return x.Found
+	}
+	return false
+}
+
+func (x *BatchGetObjectsReply_BatchResult) GetResult() *SearchResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+var File_batch_get_proto protoreflect.FileDescriptor
+
+var file_batch_get_proto_rawDesc = []byte{
+	0x0a, 0x0f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x67, 0x65, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x0c, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x1a,
+	0x0a, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x10, 0x73, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x5f, 0x67, 0x65, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc3, 0x04,
+	0x0a, 0x16, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x61, 0x73,
+	0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6c,
+	0x61, 0x73, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x45, 0x0a, 0x07, 0x6f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69,
+	0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74,
+	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x07, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x50, 0x0a, 0x11, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73,
+	0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1e, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63,
+	0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4c, 0x65, 0x76, 0x65,
+	0x6c, 0x48, 0x00, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79,
+	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x38, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70,
+	0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x77,
+	0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x72, 0x6f, 0x70,
+	0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69,
+	0x65, 0x73, 0x12, 0x57, 0x0a, 0x15, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c,
+	0x5f, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x22, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63,
+	0x2e, 0x41, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x70, 0x65,
+	0x72, 0x74, 0x69, 0x65, 0x73, 0x52, 0x14, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61,
+	0x6c, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x1a, 0xaf, 0x01, 0x0a, 0x06,
+	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x38, 0x0a, 0x0a, 0x70, 0x72,
+	0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x72,
+	0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72,
+	0x74, 0x69, 0x65, 0x73, 0x12, 0x57, 0x0a, 0x15, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x61, 0x6c, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72,
+	0x70, 0x63, 0x2e, 0x41, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x6f,
+	0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x52, 0x14, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x42, 0x14, 0x0a,
+	0x12, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6c, 0x65,
+	0x76, 0x65, 0x6c, 0x22, 0xf8, 0x01, 0x0a, 0x14, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74,
+	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x48, 0x0a, 0x07,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e,
+	0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x47, 0x65, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x6f, 0x6f, 0x6b, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x04, 0x74, 0x6f, 0x6f, 0x6b, 0x1a, 0x81, 0x01, 0x0a, 0x0b, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x75, 0x75, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x32, 0x0a, 0x06, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x77, 0x65, 0x61,
+	0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42, 0x68,
+	0x0a, 0x19, 0x69, 0x6f, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2e, 0x67, 0x72,
+	0x70, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x42, 0x15, 0x57, 0x65, 0x61,
+	0x76, 0x69, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47,
+	0x65, 0x74, 0x5a, 0x34, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x77,
+	0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2f, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65,
+	0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x3b,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_batch_get_proto_rawDescOnce sync.Once
+	file_batch_get_proto_rawDescData = file_batch_get_proto_rawDesc
+)
+
+func file_batch_get_proto_rawDescGZIP() []byte {
+	file_batch_get_proto_rawDescOnce.Do(func() {
+		file_batch_get_proto_rawDescData = protoimpl.X.CompressGZIP(file_batch_get_proto_rawDescData)
+	})
+	return file_batch_get_proto_rawDescData
+}
+
+var (
+	file_batch_get_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+	file_batch_get_proto_goTypes  = []interface{}{
+		(*BatchGetObjectsRequest)(nil),           // 0: weaviategrpc.BatchGetObjectsRequest
+		(*BatchGetObjectsReply)(nil),             // 1: weaviategrpc.BatchGetObjectsReply
+		(*BatchGetObjectsRequest_Object)(nil),    // 2: weaviategrpc.BatchGetObjectsRequest.Object
+		(*BatchGetObjectsReply_BatchResult)(nil), // 3: weaviategrpc.BatchGetObjectsReply.BatchResult
+		(ConsistencyLevel)(0),                    // 4: weaviategrpc.ConsistencyLevel
+		(*Properties)(nil),                       // 5: weaviategrpc.Properties
+		(*AdditionalProperties)(nil),             // 6: weaviategrpc.AdditionalProperties
+		(*SearchResult)(nil),                     // 7: weaviategrpc.SearchResult
+	}
+)
+
+var file_batch_get_proto_depIdxs = []int32{
+	2, // 0: weaviategrpc.BatchGetObjectsRequest.objects:type_name -> weaviategrpc.BatchGetObjectsRequest.Object
+	4, // 1: weaviategrpc.BatchGetObjectsRequest.consistency_level:type_name -> weaviategrpc.ConsistencyLevel
+	5, // 2: weaviategrpc.BatchGetObjectsRequest.properties:type_name -> weaviategrpc.Properties
+	6, // 3: weaviategrpc.BatchGetObjectsRequest.additional_properties:type_name -> weaviategrpc.AdditionalProperties
+	3, // 4: weaviategrpc.BatchGetObjectsReply.results:type_name -> weaviategrpc.BatchGetObjectsReply.BatchResult
+	5, // 5: weaviategrpc.BatchGetObjectsRequest.Object.properties:type_name -> weaviategrpc.Properties
+	6, // 6: weaviategrpc.BatchGetObjectsRequest.Object.additional_properties:type_name -> weaviategrpc.AdditionalProperties
+	7, // 7: weaviategrpc.BatchGetObjectsReply.BatchResult.result:type_name -> weaviategrpc.SearchResult
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_batch_get_proto_init() }
+func file_batch_get_proto_init() {
+	if File_batch_get_proto != nil {
+		return
+	}
+	file_base_proto_init()
+	file_search_get_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_batch_get_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchGetObjectsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_batch_get_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchGetObjectsReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_batch_get_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchGetObjectsRequest_Object); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_batch_get_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchGetObjectsReply_BatchResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_batch_get_proto_msgTypes[0].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_batch_get_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_batch_get_proto_goTypes,
+		DependencyIndexes: file_batch_get_proto_depIdxs,
+		MessageInfos:      file_batch_get_proto_msgTypes,
+	}.Build()
+	File_batch_get_proto = out.File
+	file_batch_get_proto_rawDesc = nil
+	file_batch_get_proto_goTypes = nil
+	file_batch_get_proto_depIdxs = nil
+}
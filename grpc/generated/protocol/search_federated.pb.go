@@ -0,0 +1,341 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package protocol
+
+import (
+	reflect "reflect"
+	sync "sync"
+
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type FederatedSearchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the classes to search, for a near_vector search they need to share the
+	// same vector space
+	Targets []*FederatedSearchRequest_Target `protobuf:"bytes,1,rep,name=targets,proto3" json:"targets,omitempty"`
+	Limit   uint32                           `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// exactly one of near_vector and hybrid_search is required
+	NearVector           *NearVectorParams     `protobuf:"bytes,3,opt,name=near_vector,json=nearVector,proto3" json:"near_vector,omitempty"`
+	HybridSearch         *HybridSearchParams   `protobuf:"bytes,4,opt,name=hybrid_search,json=hybridSearch,proto3" json:"hybrid_search,omitempty"`
+	AdditionalProperties *AdditionalProperties `protobuf:"bytes,5,opt,name=additional_properties,json=additionalProperties,proto3" json:"additional_properties,omitempty"`
+	ConsistencyLevel     *ConsistencyLevel     `protobuf:"varint,6,opt,name=consistency_level,json=consistencyLevel,proto3,enum=weaviategrpc.ConsistencyLevel,oneof" json:"consistency_level,omitempty"`
+}
+
+func (x *FederatedSearchRequest) Reset() {
+	*x = FederatedSearchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_search_federated_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FederatedSearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FederatedSearchRequest) ProtoMessage() {}
+
+func (x *FederatedSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_search_federated_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FederatedSearchRequest.ProtoReflect.Descriptor instead.
+func (*FederatedSearchRequest) Descriptor() ([]byte, []int) {
+	return file_search_federated_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *FederatedSearchRequest) GetTargets() []*FederatedSearchRequest_Target {
+	if x != nil {
+		return x.Targets
+	}
+	return nil
+}
+
+func (x *FederatedSearchRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *FederatedSearchRequest) GetNearVector() *NearVectorParams {
+	if x != nil {
+		return x.NearVector
+	}
+	return nil
+}
+
+func (x *FederatedSearchRequest) GetHybridSearch() *HybridSearchParams {
+	if x != nil {
+		return x.HybridSearch
+	}
+	return nil
+}
+
+func (x *FederatedSearchRequest) GetAdditionalProperties() *AdditionalProperties {
+	if x != nil {
+		return x.AdditionalProperties
+	}
+	return nil
+}
+
+func (x *FederatedSearchRequest) GetConsistencyLevel() ConsistencyLevel {
+	if x != nil && x.ConsistencyLevel != nil {
+		return *x.ConsistencyLevel
+	}
+	return ConsistencyLevel_CONSISTENCY_LEVEL_UNSPECIFIED
+}
+
+type FederatedSearchRequest_Target struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClassName  string      `protobuf:"bytes,1,opt,name=class_name,json=className,proto3" json:"class_name,omitempty"`
+	Properties *Properties `protobuf:"bytes,2,opt,name=properties,proto3" json:"properties,omitempty"`
+	Tenant     string      `protobuf:"bytes,3,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Filters    *Filters    `protobuf:"bytes,4,opt,name=filters,proto3,oneof" json:"filters,omitempty"`
+}
+
+func (x *FederatedSearchRequest_Target) Reset() {
+	*x = FederatedSearchRequest_Target{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_search_federated_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FederatedSearchRequest_Target) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FederatedSearchRequest_Target) ProtoMessage() {}
+
+func (x *FederatedSearchRequest_Target) ProtoReflect() protoreflect.Message {
+	mi := &file_search_federated_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FederatedSearchRequest_Target.ProtoReflect.Descriptor instead.
+func (*FederatedSearchRequest_Target) Descriptor() ([]byte, []int) {
+	return file_search_federated_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *FederatedSearchRequest_Target) GetClassName() string {
+	if x != nil {
+		return x.ClassName
+	}
+	return ""
+}
+
+func (x *FederatedSearchRequest_Target) GetProperties() *Properties {
+	if x != nil {
+		return x.Properties
+	}
+	return nil
+}
+
+func (x *FederatedSearchRequest_Target) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+func (x *FederatedSearchRequest_Target) GetFilters() *Filters {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+var File_search_federated_proto protoreflect.FileDescriptor
+
+var file_search_federated_proto_rawDesc = []byte{
+	0x0a, 0x16, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x66, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74,
+	0x65, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61,
+	0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x1a, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x10, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x67, 0x65, 0x74, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xfc, 0x04, 0x0a, 0x16, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74,
+	0x65, 0x64, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x45, 0x0a, 0x07, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2b, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e,
+	0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x07, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x3f, 0x0a, 0x0b,
+	0x6e, 0x65, 0x61, 0x72, 0x5f, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63,
+	0x2e, 0x4e, 0x65, 0x61, 0x72, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x52, 0x0a, 0x6e, 0x65, 0x61, 0x72, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x45, 0x0a,
+	0x0d, 0x68, 0x79, 0x62, 0x72, 0x69, 0x64, 0x5f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67,
+	0x72, 0x70, 0x63, 0x2e, 0x48, 0x79, 0x62, 0x72, 0x69, 0x64, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x0c, 0x68, 0x79, 0x62, 0x72, 0x69, 0x64, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x12, 0x57, 0x0a, 0x15, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x61, 0x6c, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72,
+	0x70, 0x63, 0x2e, 0x41, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x6f,
+	0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x52, 0x14, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x50, 0x0a,
+	0x11, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6c, 0x65, 0x76,
+	0x65, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69,
+	0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65,
+	0x6e, 0x63, 0x79, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x48, 0x00, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x73,
+	0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x88, 0x01, 0x01, 0x1a,
+	0xbb, 0x01, 0x0a, 0x06, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c,
+	0x61, 0x73, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x63, 0x6c, 0x61, 0x73, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x38, 0x0a, 0x0a, 0x70, 0x72, 0x6f,
+	0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x72, 0x6f,
+	0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74,
+	0x69, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x34, 0x0a, 0x07, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x77,
+	0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x73, 0x48, 0x00, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x88, 0x01,
+	0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x42, 0x14, 0x0a,
+	0x12, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6c, 0x65,
+	0x76, 0x65, 0x6c, 0x42, 0x6f, 0x0a, 0x19, 0x69, 0x6f, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61,
+	0x74, 0x65, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x42, 0x1c, 0x57, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x5a, 0x34,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x77, 0x65, 0x61, 0x76, 0x69,
+	0x61, 0x74, 0x65, 0x2f, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2f, 0x67, 0x72, 0x70,
+	0x63, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x3b, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_search_federated_proto_rawDescOnce sync.Once
+	file_search_federated_proto_rawDescData = file_search_federated_proto_rawDesc
+)
+
+func file_search_federated_proto_rawDescGZIP() []byte {
+	file_search_federated_proto_rawDescOnce.Do(func() {
+		file_search_federated_proto_rawDescData = protoimpl.X.CompressGZIP(file_search_federated_proto_rawDescData)
+	})
+	return file_search_federated_proto_rawDescData
+}
+
+var (
+	file_search_federated_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+	file_search_federated_proto_goTypes  = []interface{}{
+		(*FederatedSearchRequest)(nil),        // 0: weaviategrpc.FederatedSearchRequest
+		(*FederatedSearchRequest_Target)(nil), // 1: weaviategrpc.FederatedSearchRequest.Target
+		(*NearVectorParams)(nil),              // 2: weaviategrpc.NearVectorParams
+		(*HybridSearchParams)(nil),            // 3: weaviategrpc.HybridSearchParams
+		(*AdditionalProperties)(nil),          // 4: weaviategrpc.AdditionalProperties
+		(ConsistencyLevel)(0),                 // 5: weaviategrpc.ConsistencyLevel
+		(*Properties)(nil),                    // 6: weaviategrpc.Properties
+		(*Filters)(nil),                       // 7: weaviategrpc.Filters
+	}
+)
+
+var file_search_federated_proto_depIdxs = []int32{
+	1, // 0: weaviategrpc.FederatedSearchRequest.targets:type_name -> weaviategrpc.FederatedSearchRequest.Target
+	2, // 1: weaviategrpc.FederatedSearchRequest.near_vector:type_name -> weaviategrpc.NearVectorParams
+	3, // 2: weaviategrpc.FederatedSearchRequest.hybrid_search:type_name -> weaviategrpc.HybridSearchParams
+	4, // 3: weaviategrpc.FederatedSearchRequest.additional_properties:type_name -> weaviategrpc.AdditionalProperties
+	5, // 4: weaviategrpc.FederatedSearchRequest.consistency_level:type_name -> weaviategrpc.ConsistencyLevel
+	6, // 5: weaviategrpc.FederatedSearchRequest.Target.properties:type_name -> weaviategrpc.Properties
+	7, // 6: weaviategrpc.FederatedSearchRequest.Target.filters:type_name -> weaviategrpc.Filters
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_search_federated_proto_init() }
+func file_search_federated_proto_init() {
+	if File_search_federated_proto != nil {
+		return
+	}
+	file_base_proto_init()
+	file_search_get_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_search_federated_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FederatedSearchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_search_federated_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FederatedSearchRequest_Target); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_search_federated_proto_msgTypes[0].OneofWrappers = []interface{}{}
+	file_search_federated_proto_msgTypes[1].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_search_federated_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_search_federated_proto_goTypes,
+		DependencyIndexes: file_search_federated_proto_depIdxs,
+		MessageInfos:      file_search_federated_proto_msgTypes,
+	}.Build()
+	File_search_federated_proto = out.File
+	file_search_federated_proto_rawDesc = nil
+	file_search_federated_proto_goTypes = nil
+	file_search_federated_proto_depIdxs = nil
+}
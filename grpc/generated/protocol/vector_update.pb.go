@@ -0,0 +1,358 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package protocol
+
+import (
+	reflect "reflect"
+	sync "sync"
+
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type UpdateVectorsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClassName        string            `protobuf:"bytes,1,opt,name=class_name,json=className,proto3" json:"class_name,omitempty"`
+	Uuid             string            `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Tenant           string            `protobuf:"bytes,3,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	ConsistencyLevel *ConsistencyLevel `protobuf:"varint,4,opt,name=consistency_level,json=consistencyLevel,proto3,enum=weaviategrpc.ConsistencyLevel,oneof" json:"consistency_level,omitempty"`
+	// replaces the vector of the object
+	// protolint:disable:next REPEATED_FIELD_NAMES_PLURALIZED
+	Vector []float32 `protobuf:"fixed32,5,rep,packed,name=vector,proto3" json:"vector,omitempty"`
+	// replaces the named vectors which are sent, all others are kept
+	Vectors []*UpdateVectorsRequest_NamedVector `protobuf:"bytes,6,rep,name=vectors,proto3" json:"vectors,omitempty"`
+}
+
+func (x *UpdateVectorsRequest) Reset() {
+	*x = UpdateVectorsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vector_update_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateVectorsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateVectorsRequest) ProtoMessage() {}
+
+func (x *UpdateVectorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vector_update_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateVectorsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateVectorsRequest) Descriptor() ([]byte, []int) {
+	return file_vector_update_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *UpdateVectorsRequest) GetClassName() string {
+	if x != nil {
+		return x.ClassName
+	}
+	return ""
+}
+
+func (x *UpdateVectorsRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *UpdateVectorsRequest) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+func (x *UpdateVectorsRequest) GetConsistencyLevel() ConsistencyLevel {
+	if x != nil && x.ConsistencyLevel != nil {
+		return *x.ConsistencyLevel
+	}
+	return ConsistencyLevel_CONSISTENCY_LEVEL_UNSPECIFIED
+}
+
+func (x *UpdateVectorsRequest) GetVector() []float32 {
+	if x != nil {
+		return x.Vector
+	}
+	return nil
+}
+
+func (x *UpdateVectorsRequest) GetVectors() []*UpdateVectorsRequest_NamedVector {
+	if x != nil {
+		return x.Vectors
+	}
+	return nil
+}
+
+type UpdateVectorsReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Took float32 `protobuf:"fixed32,1,opt,name=took,proto3" json:"took,omitempty"`
+}
+
+func (x *UpdateVectorsReply) Reset() {
+	*x = UpdateVectorsReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vector_update_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateVectorsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateVectorsReply) ProtoMessage() {}
+
+func (x *UpdateVectorsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_vector_update_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateVectorsReply.ProtoReflect.Descriptor instead.
+func (*UpdateVectorsReply) Descriptor() ([]byte, []int) {
+	return file_vector_update_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *UpdateVectorsReply) GetTook() float32 {
+	if x != nil {
+		return x.Took
+	}
+	return 0
+}
+
+type UpdateVectorsRequest_NamedVector struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// protolint:disable:next REPEATED_FIELD_NAMES_PLURALIZED
+	Vector []float32 `protobuf:"fixed32,2,rep,packed,name=vector,proto3" json:"vector,omitempty"`
+}
+
+func (x *UpdateVectorsRequest_NamedVector) Reset() {
+	*x = UpdateVectorsRequest_NamedVector{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vector_update_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateVectorsRequest_NamedVector) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateVectorsRequest_NamedVector) ProtoMessage() {}
+
+func (x *UpdateVectorsRequest_NamedVector) ProtoReflect() protoreflect.Message {
+	mi := &file_vector_update_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateVectorsRequest_NamedVector.ProtoReflect.Descriptor instead.
+func (*UpdateVectorsRequest_NamedVector) Descriptor() ([]byte, []int) {
+	return file_vector_update_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *UpdateVectorsRequest_NamedVector) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateVectorsRequest_NamedVector) GetVector() []float32 {
+	if x != nil {
+		return x.Vector
+	}
+	return nil
+}
+
+var File_vector_update_proto protoreflect.FileDescriptor
+
+var file_vector_update_proto_rawDesc = []byte{
+	0x0a, 0x13, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67,
+	0x72, 0x70, 0x63, 0x1a, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0xe6, 0x02, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x61, 0x73,
+	0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6c,
+	0x61, 0x73, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x74,
+	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x6e,
+	0x61, 0x6e, 0x74, 0x12, 0x50, 0x0a, 0x11, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e,
+	0x63, 0x79, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e,
+	0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6f,
+	0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x48, 0x00,
+	0x52, 0x10, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4c, 0x65, 0x76,
+	0x65, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x02, 0x52, 0x06, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x48, 0x0a,
+	0x07, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e,
+	0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x64, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x07,
+	0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x4e, 0x61, 0x6d, 0x65, 0x64,
+	0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x03, 0x28, 0x02, 0x52, 0x06, 0x76, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e,
+	0x63, 0x79, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0x28, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x6f, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x04, 0x74, 0x6f,
+	0x6f, 0x6b, 0x42, 0x6c, 0x0a, 0x19, 0x69, 0x6f, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74,
+	0x65, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x42,
+	0x19, 0x57, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x56, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5a, 0x34, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2f,
+	0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x67, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x3b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_vector_update_proto_rawDescOnce sync.Once
+	file_vector_update_proto_rawDescData = file_vector_update_proto_rawDesc
+)
+
+func file_vector_update_proto_rawDescGZIP() []byte {
+	file_vector_update_proto_rawDescOnce.Do(func() {
+		file_vector_update_proto_rawDescData = protoimpl.X.CompressGZIP(file_vector_update_proto_rawDescData)
+	})
+	return file_vector_update_proto_rawDescData
+}
+
+var (
+	file_vector_update_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+	file_vector_update_proto_goTypes  = []interface{}{
+		(*UpdateVectorsRequest)(nil),             // 0: weaviategrpc.UpdateVectorsRequest
+		(*UpdateVectorsReply)(nil),               // 1: weaviategrpc.UpdateVectorsReply
+		(*UpdateVectorsRequest_NamedVector)(nil), // 2: weaviategrpc.UpdateVectorsRequest.NamedVector
+		(ConsistencyLevel)(0),                    // 3: weaviategrpc.ConsistencyLevel
+	}
+)
+
+var file_vector_update_proto_depIdxs = []int32{
+	3, // 0: weaviategrpc.UpdateVectorsRequest.consistency_level:type_name -> weaviategrpc.ConsistencyLevel
+	2, // 1: weaviategrpc.UpdateVectorsRequest.vectors:type_name -> weaviategrpc.UpdateVectorsRequest.NamedVector
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_vector_update_proto_init() }
+func file_vector_update_proto_init() {
+	if File_vector_update_proto != nil {
+		return
+	}
+	file_base_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_vector_update_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateVectorsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vector_update_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateVectorsReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vector_update_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateVectorsRequest_NamedVector); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_vector_update_proto_msgTypes[0].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_vector_update_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_vector_update_proto_goTypes,
+		DependencyIndexes: file_vector_update_proto_depIdxs,
+		MessageInfos:      file_vector_update_proto_msgTypes,
+	}.Build()
+	File_vector_update_proto = out.File
+	file_vector_update_proto_rawDesc = nil
+	file_vector_update_proto_goTypes = nil
+	file_vector_update_proto_depIdxs = nil
+}
@@ -32,6 +32,10 @@ const _ = grpc.SupportPackageIsVersion7
 type WeaviateClient interface {
 	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchReply, error)
 	BatchObjects(ctx context.Context, in *BatchObjectsRequest, opts ...grpc.CallOption) (*BatchObjectsReply, error)
+	SearchStream(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (Weaviate_SearchStreamClient, error)
+	BatchGetObjects(ctx context.Context, in *BatchGetObjectsRequest, opts ...grpc.CallOption) (*BatchGetObjectsReply, error)
+	FederatedSearch(ctx context.Context, in *FederatedSearchRequest, opts ...grpc.CallOption) (*SearchReply, error)
+	UpdateVectors(ctx context.Context, in *UpdateVectorsRequest, opts ...grpc.CallOption) (*UpdateVectorsReply, error)
 }
 
 type weaviateClient struct {
@@ -60,12 +64,75 @@ func (c *weaviateClient) BatchObjects(ctx context.Context, in *BatchObjectsReque
 	return out, nil
 }
 
+func (c *weaviateClient) SearchStream(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (Weaviate_SearchStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Weaviate_ServiceDesc.Streams[0], "/weaviategrpc.Weaviate/SearchStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &weaviateSearchStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Weaviate_SearchStreamClient interface {
+	Recv() (*SearchReply, error)
+	grpc.ClientStream
+}
+
+type weaviateSearchStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *weaviateSearchStreamClient) Recv() (*SearchReply, error) {
+	m := new(SearchReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *weaviateClient) BatchGetObjects(ctx context.Context, in *BatchGetObjectsRequest, opts ...grpc.CallOption) (*BatchGetObjectsReply, error) {
+	out := new(BatchGetObjectsReply)
+	err := c.cc.Invoke(ctx, "/weaviategrpc.Weaviate/BatchGetObjects", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) FederatedSearch(ctx context.Context, in *FederatedSearchRequest, opts ...grpc.CallOption) (*SearchReply, error) {
+	out := new(SearchReply)
+	err := c.cc.Invoke(ctx, "/weaviategrpc.Weaviate/FederatedSearch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) UpdateVectors(ctx context.Context, in *UpdateVectorsRequest, opts ...grpc.CallOption) (*UpdateVectorsReply, error) {
+	out := new(UpdateVectorsReply)
+	err := c.cc.Invoke(ctx, "/weaviategrpc.Weaviate/UpdateVectors", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WeaviateServer is the server API for Weaviate service.
 // All implementations must embed UnimplementedWeaviateServer
 // for forward compatibility
 type WeaviateServer interface {
 	Search(context.Context, *SearchRequest) (*SearchReply, error)
 	BatchObjects(context.Context, *BatchObjectsRequest) (*BatchObjectsReply, error)
+	SearchStream(*SearchRequest, Weaviate_SearchStreamServer) error
+	BatchGetObjects(context.Context, *BatchGetObjectsRequest) (*BatchGetObjectsReply, error)
+	FederatedSearch(context.Context, *FederatedSearchRequest) (*SearchReply, error)
+	UpdateVectors(context.Context, *UpdateVectorsRequest) (*UpdateVectorsReply, error)
 	mustEmbedUnimplementedWeaviateServer()
 }
 
@@ -79,6 +146,18 @@ func (UnimplementedWeaviateServer) Search(context.Context, *SearchRequest) (*Sea
 func (UnimplementedWeaviateServer) BatchObjects(context.Context, *BatchObjectsRequest) (*BatchObjectsReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BatchObjects not implemented")
 }
+func (UnimplementedWeaviateServer) SearchStream(*SearchRequest, Weaviate_SearchStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method SearchStream not implemented")
+}
+func (UnimplementedWeaviateServer) BatchGetObjects(context.Context, *BatchGetObjectsRequest) (*BatchGetObjectsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetObjects not implemented")
+}
+func (UnimplementedWeaviateServer) FederatedSearch(context.Context, *FederatedSearchRequest) (*SearchReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FederatedSearch not implemented")
+}
+func (UnimplementedWeaviateServer) UpdateVectors(context.Context, *UpdateVectorsRequest) (*UpdateVectorsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateVectors not implemented")
+}
 func (UnimplementedWeaviateServer) mustEmbedUnimplementedWeaviateServer() {}
 
 // UnsafeWeaviateServer may be embedded to opt out of forward compatibility for this service.
@@ -128,6 +207,81 @@ func _Weaviate_BatchObjects_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Weaviate_SearchStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SearchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WeaviateServer).SearchStream(m, &weaviateSearchStreamServer{stream})
+}
+
+type Weaviate_SearchStreamServer interface {
+	Send(*SearchReply) error
+	grpc.ServerStream
+}
+
+type weaviateSearchStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *weaviateSearchStreamServer) Send(m *SearchReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Weaviate_BatchGetObjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetObjectsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).BatchGetObjects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviategrpc.Weaviate/BatchGetObjects",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).BatchGetObjects(ctx, req.(*BatchGetObjectsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_FederatedSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FederatedSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).FederatedSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviategrpc.Weaviate/FederatedSearch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).FederatedSearch(ctx, req.(*FederatedSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_UpdateVectors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateVectorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).UpdateVectors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviategrpc.Weaviate/UpdateVectors",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).UpdateVectors(ctx, req.(*UpdateVectorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Weaviate_ServiceDesc is the grpc.ServiceDesc for Weaviate service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -143,7 +297,25 @@ var Weaviate_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BatchObjects",
 			Handler:    _Weaviate_BatchObjects_Handler,
 		},
+		{
+			MethodName: "BatchGetObjects",
+			Handler:    _Weaviate_BatchGetObjects_Handler,
+		},
+		{
+			MethodName: "FederatedSearch",
+			Handler:    _Weaviate_FederatedSearch_Handler,
+		},
+		{
+			MethodName: "UpdateVectors",
+			Handler:    _Weaviate_UpdateVectors_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SearchStream",
+			Handler:       _Weaviate_SearchStream_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "weaviate.proto",
 }
@@ -259,6 +259,15 @@ func (a *azureClient) Read(ctx context.Context, backupID, key string, w io.Write
 	return read, nil
 }
 
+func (a *azureClient) Delete(ctx context.Context, backupID, key string) error {
+	path := a.makeObjectName(backupID, key)
+	if _, err := a.client.DeleteBlob(ctx, a.config.Container, path, nil); err != nil &&
+		!bloberror.HasCode(err, bloberror.BlobNotFound) {
+		return fmt.Errorf("delete object %q: %w", path, err)
+	}
+	return nil
+}
+
 func (a *azureClient) SourceDataPath() string {
 	return a.dataPath
 }
@@ -159,7 +159,7 @@ func (m *Module) Write(ctx context.Context, backupID, key string, r io.ReadClose
 	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
 		return 0, fmt.Errorf("make dir %q: %w", dir, err)
 	}
-	f, err := os.OpenFile(backupPath, os.O_RDWR|os.O_CREATE, os.ModePerm)
+	f, err := os.OpenFile(backupPath, os.O_RDWR|os.O_CREATE|os.O_TRUNC, os.ModePerm)
 	if err != nil {
 		return 0, fmt.Errorf("open file %q: %w", backupPath, err)
 	}
@@ -204,6 +204,14 @@ func (m *Module) Read(ctx context.Context, backupID, key string, w io.WriteClose
 	return read, err
 }
 
+func (m *Module) Delete(ctx context.Context, backupID, key string) error {
+	backupPath := path.Join(m.makeBackupDirPath(backupID), key)
+	if err := os.Remove(backupPath); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("remove file %q: %w", backupPath, err)
+	}
+	return nil
+}
+
 func (m *Module) SourceDataPath() string {
 	return m.dataPath
 }
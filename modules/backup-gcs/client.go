@@ -368,6 +368,19 @@ func (g *gcsClient) Read(ctx context.Context, backupID, key string, w io.WriteCl
 	return read, nil
 }
 
+func (g *gcsClient) Delete(ctx context.Context, backupID, key string) error {
+	bucket, err := g.findBucket(ctx)
+	if err != nil {
+		return fmt.Errorf("find bucket: %w", err)
+	}
+
+	path := g.makeObjectName(backupID, key)
+	if err := bucket.Object(path).Delete(ctx); err != nil && !errors.Is(err, storage.ErrObjectNotExist) {
+		return fmt.Errorf("delete object %q: %w", path, err)
+	}
+	return nil
+}
+
 func (g *gcsClient) SourceDataPath() string {
 	return g.dataPath
 }
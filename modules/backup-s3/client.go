@@ -224,6 +224,14 @@ func (s *s3Client) Read(ctx context.Context, backupID, key string, w io.WriteClo
 	return read, nil
 }
 
+func (s *s3Client) Delete(ctx context.Context, backupID, key string) error {
+	path := s.makeObjectName(backupID, key)
+	if err := s.client.RemoveObject(ctx, s.config.Bucket, path, minio.RemoveObjectOptions{}); err != nil {
+		return fmt.Errorf("remove object %q: %w", path, err)
+	}
+	return nil
+}
+
 func (s *s3Client) SourceDataPath() string {
 	return s.dataPath
 }
@@ -65,6 +65,14 @@ func (g *GraphQLArgumentsProvider) nearTextFields(prefix string) graphql.InputOb
 			Description: descriptions.Distance,
 			Type:        graphql.Float,
 		},
+		"rescoreLimit": &graphql.InputObjectFieldConfig{
+			Description: descriptions.RescoreLimit,
+			Type:        graphql.Int,
+		},
+		"oversampling": &graphql.InputObjectFieldConfig{
+			Description: descriptions.Oversampling,
+			Type:        graphql.Float,
+		},
 		"moveAwayFrom": &graphql.InputObjectFieldConfig{
 			Description: descriptions.VectorMovement,
 			Type: graphql.NewInputObject(
@@ -53,7 +53,7 @@ func TestNearTextGraphQLArgument(t *testing.T) {
 		nearTextFields, ok := nearText.Type.(*graphql.InputObject)
 		assert.True(t, ok)
 		assert.NotNil(t, nearTextFields)
-		assert.Equal(t, 5, len(nearTextFields.Fields()))
+		assert.Equal(t, 7, len(nearTextFields.Fields()))
 		fields := nearTextFields.Fields()
 		concepts := fields["concepts"]
 		conceptsNonNull, conceptsNonNullOK := concepts.Type.(*graphql.NonNull)
@@ -67,6 +67,8 @@ func TestNearTextGraphQLArgument(t *testing.T) {
 		assert.NotNil(t, conceptsType)
 		assert.NotNil(t, fields["certainty"])
 		assert.NotNil(t, fields["distance"])
+		assert.NotNil(t, fields["rescoreLimit"])
+		assert.NotNil(t, fields["oversampling"])
 		assert.NotNil(t, fields["moveTo"])
 		moveTo, moveToOK := fields["moveTo"].Type.(*graphql.InputObject)
 		assert.True(t, moveToOK)
@@ -141,7 +143,7 @@ func TestNearTextGraphQLArgumentWithAutocorrect(t *testing.T) {
 		nearTextFields, ok := nearText.Type.(*graphql.InputObject)
 		assert.True(t, ok)
 		assert.NotNil(t, nearTextFields)
-		assert.Equal(t, 6, len(nearTextFields.Fields()))
+		assert.Equal(t, 8, len(nearTextFields.Fields()))
 		fields := nearTextFields.Fields()
 		concepts := fields["concepts"]
 		conceptsNonNull, conceptsNonNullOK := concepts.Type.(*graphql.NonNull)
@@ -58,6 +58,16 @@ func (g *GraphQLArgumentsProvider) extractNearTextFn(source map[string]interface
 		args.WithDistance = true
 	}
 
+	rescoreLimit, ok := source["rescoreLimit"]
+	if ok {
+		args.RescoreLimit = rescoreLimit.(int)
+	}
+
+	oversampling, ok := source["oversampling"]
+	if ok {
+		args.Oversampling = oversampling.(float64)
+	}
+
 	// moveTo is an optional arg, so it could be nil
 	moveTo, ok := source["moveTo"]
 	if ok {
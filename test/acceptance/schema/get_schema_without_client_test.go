@@ -40,19 +40,26 @@ func testGetSchemaWithoutClient(t *testing.T) {
 				"vectorIndexConfig": map[string]interface{}{ // from default
 					"skip":                   false,
 					"cleanupIntervalSeconds": float64(300),
+					"cleanupConcurrency":     float64(1),
 					"efConstruction":         float64(128),
 					"flatSearchCutoff":       float64(40000),
+					"acornFilterRatio":       float64(0.4),
 					"ef":                     float64(-1),
 					"maxConnections":         float64(64),
 					"vectorCacheMaxObjects":  float64(1e12),
+					"vectorCacheMaxBytes":    float64(0),
+					"vectorCacheDType":       "float32",
+					"vectorCachePrefill":     "upperLayers",
 					"dynamicEfMin":           float64(100),
 					"dynamicEfMax":           float64(500),
 					"dynamicEfFactor":        float64(8),
 					"distance":               "cosine",
 					"pq": map[string]interface{}{
-						"bitCompression": false,
-						"centroids":      float64(256),
-						"enabled":        false,
+						"autoTrain":          false,
+						"autoTrainThreshold": float64(100000),
+						"bitCompression":     false,
+						"centroids":          float64(256),
+						"enabled":            false,
 						"encoder": map[string]interface{}{
 							"distribution": "log-normal",
 							"type":         "kmeans",
@@ -60,6 +67,16 @@ func testGetSchemaWithoutClient(t *testing.T) {
 						"segments":      float64(0),
 						"trainingLimit": float64(100000),
 					},
+					"sq": map[string]interface{}{
+						"enabled":       false,
+						"rescoreLimit":  float64(20),
+						"trainingLimit": float64(100000),
+					},
+					"adaptiveEf": map[string]interface{}{
+						"enabled":          false,
+						"targetLatencyMs":  float64(50),
+						"targetPercentile": float64(95),
+					},
 				},
 				"shardingConfig": map[string]interface{}{
 					"actualCount":         float64(1),
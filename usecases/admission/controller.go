@@ -0,0 +1,197 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package admission
+
+import (
+	"container/heap"
+	"context"
+	"sync"
+	"time"
+
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+)
+
+// Kind is the kind of a heavy operation which is subject to admission control
+type Kind int
+
+const (
+	VectorSearch Kind = iota
+	Aggregation
+	BatchWrite
+)
+
+func (k Kind) String() string {
+	switch k {
+	case VectorSearch:
+		return "vector search"
+	case Aggregation:
+		return "aggregation"
+	case BatchWrite:
+		return "batch write"
+	default:
+		return "unknown"
+	}
+}
+
+// Config of the Controller. A MaxConcurrent of zero or less disables
+// admission control, all operations are admitted right away.
+type Config struct {
+	// MaxConcurrent is the number of heavy operations which run at the same
+	// time, regardless of their kind
+	MaxConcurrent int
+	// MaxQueued is the number of operations which wait for a slot, any further
+	// operation is rejected
+	MaxQueued int
+	// QueueTimeout is how long an operation waits for a slot before it is
+	// rejected. Zero waits until the context of the operation is done.
+	QueueTimeout time.Duration
+	// Priorities of the kinds, queued operations with a higher priority are
+	// admitted first. Operations of the same priority are admitted in order.
+	Priorities map[Kind]int
+}
+
+// Controller limits the number of concurrent heavy operations per node, so
+// that spikes are queued or rejected instead of exhausting the memory of the
+// node. Rejected operations fail with an ErrOverloaded.
+type Controller struct {
+	config Config
+
+	mu      sync.Mutex
+	running int
+	queue   waitQueue
+	seq     uint64
+}
+
+func New(config Config) *Controller {
+	return &Controller{config: config}
+}
+
+// Admit blocks until the operation may run and returns a func which must be
+// called once it is done. A nil Controller admits every operation.
+func (c *Controller) Admit(ctx context.Context, kind Kind) (func(), error) {
+	if c == nil || c.config.MaxConcurrent <= 0 {
+		return func() {}, nil
+	}
+
+	c.mu.Lock()
+	if c.running < c.config.MaxConcurrent {
+		c.running++
+		c.mu.Unlock()
+		return c.releaseOnce(), nil
+	}
+	if c.queue.Len() >= c.config.MaxQueued {
+		c.mu.Unlock()
+		return nil, c.overloaded(kind)
+	}
+	w := &waiter{priority: c.config.Priorities[kind], seq: c.seq, ready: make(chan struct{})}
+	c.seq++
+	heap.Push(&c.queue, w)
+	c.mu.Unlock()
+
+	var timeout <-chan time.Time
+	if c.config.QueueTimeout > 0 {
+		timer := time.NewTimer(c.config.QueueTimeout)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+
+	var err error
+	select {
+	case <-w.ready:
+		return c.releaseOnce(), nil
+	case <-timeout:
+		err = c.overloaded(kind)
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+
+	c.mu.Lock()
+	if w.index < 0 {
+		// the slot was handed over while giving up, pass it on
+		c.mu.Unlock()
+		c.release()
+		return nil, err
+	}
+	heap.Remove(&c.queue, w.index)
+	c.mu.Unlock()
+	return nil, err
+}
+
+func (c *Controller) releaseOnce() func() {
+	var once sync.Once
+	return func() { once.Do(c.release) }
+}
+
+// release hands the slot over to the next queued operation, or frees it if
+// none is waiting
+func (c *Controller) release() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.queue.Len() > 0 {
+		w := heap.Pop(&c.queue).(*waiter)
+		close(w.ready)
+		return
+	}
+	c.running--
+}
+
+// overloaded suggests to retry once the queue had the time to drain
+func (c *Controller) overloaded(kind Kind) error {
+	retryAfter := c.config.QueueTimeout.Round(time.Second)
+	if retryAfter < time.Second {
+		retryAfter = time.Second
+	}
+	return enterrors.NewErrOverloaded(kind.String(), retryAfter)
+}
+
+type waiter struct {
+	priority int
+	seq      uint64
+	ready    chan struct{}
+	// index in the queue, -1 once the waiter is admitted
+	index int
+}
+
+// waitQueue is a heap of the waiting operations, ordered by priority and
+// then by arrival
+type waitQueue []*waiter
+
+func (q waitQueue) Len() int { return len(q) }
+
+func (q waitQueue) Less(i, j int) bool {
+	if q[i].priority != q[j].priority {
+		return q[i].priority > q[j].priority
+	}
+	return q[i].seq < q[j].seq
+}
+
+func (q waitQueue) Swap(i, j int) {
+	q[i], q[j] = q[j], q[i]
+	q[i].index = i
+	q[j].index = j
+}
+
+func (q *waitQueue) Push(x interface{}) {
+	w := x.(*waiter)
+	w.index = len(*q)
+	*q = append(*q, w)
+}
+
+func (q *waitQueue) Pop() interface{} {
+	old := *q
+	w := old[len(old)-1]
+	old[len(old)-1] = nil
+	w.index = -1
+	*q = old[:len(old)-1]
+	return w
+}
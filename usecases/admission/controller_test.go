@@ -0,0 +1,127 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package admission
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+)
+
+func TestController_Disabled(t *testing.T) {
+	for _, c := range []*Controller{nil, New(Config{})} {
+		for i := 0; i < 10; i++ {
+			release, err := c.Admit(context.Background(), VectorSearch)
+			require.Nil(t, err)
+			defer release()
+		}
+	}
+}
+
+func TestController_RejectsWhenQueueIsFull(t *testing.T) {
+	c := New(Config{MaxConcurrent: 1, MaxQueued: 0, QueueTimeout: 3 * time.Second})
+
+	release, err := c.Admit(context.Background(), VectorSearch)
+	require.Nil(t, err)
+
+	_, err = c.Admit(context.Background(), BatchWrite)
+	var overloaded enterrors.ErrOverloaded
+	require.True(t, errors.As(err, &overloaded))
+	assert.Equal(t, 3*time.Second, overloaded.RetryAfter())
+	assert.Contains(t, err.Error(), "batch write")
+
+	release()
+	release() // releasing twice must not free a second slot
+
+	release, err = c.Admit(context.Background(), BatchWrite)
+	require.Nil(t, err)
+	_, err = c.Admit(context.Background(), BatchWrite)
+	require.NotNil(t, err)
+	release()
+}
+
+func TestController_QueueTimeout(t *testing.T) {
+	c := New(Config{MaxConcurrent: 1, MaxQueued: 1, QueueTimeout: 10 * time.Millisecond})
+
+	release, err := c.Admit(context.Background(), VectorSearch)
+	require.Nil(t, err)
+	defer release()
+
+	_, err = c.Admit(context.Background(), VectorSearch)
+	var overloaded enterrors.ErrOverloaded
+	require.True(t, errors.As(err, &overloaded))
+	assert.Equal(t, time.Second, overloaded.RetryAfter())
+	assert.Equal(t, 0, c.queue.Len())
+}
+
+func TestController_ContextDone(t *testing.T) {
+	c := New(Config{MaxConcurrent: 1, MaxQueued: 1})
+
+	release, err := c.Admit(context.Background(), VectorSearch)
+	require.Nil(t, err)
+	defer release()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = c.Admit(ctx, VectorSearch)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Equal(t, 0, c.queue.Len())
+}
+
+func TestController_Priorities(t *testing.T) {
+	c := New(Config{
+		MaxConcurrent: 1,
+		MaxQueued:     10,
+		Priorities:    map[Kind]int{VectorSearch: 2, Aggregation: 1, BatchWrite: 0},
+	})
+
+	release, err := c.Admit(context.Background(), VectorSearch)
+	require.Nil(t, err)
+
+	var (
+		lock     sync.Mutex
+		admitted []Kind
+		wg       sync.WaitGroup
+	)
+	for i, kind := range []Kind{BatchWrite, Aggregation, BatchWrite, VectorSearch} {
+		i, kind := i, kind
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			release, err := c.Admit(context.Background(), kind)
+			require.Nil(t, err)
+			lock.Lock()
+			admitted = append(admitted, kind)
+			lock.Unlock()
+			release()
+		}()
+
+		// wait until the operation is queued, so the order of arrival is known
+		require.Eventually(t, func() bool {
+			c.mu.Lock()
+			defer c.mu.Unlock()
+			return c.queue.Len() == i+1
+		}, time.Second, time.Millisecond)
+	}
+
+	release()
+	wg.Wait()
+
+	assert.Equal(t, []Kind{VectorSearch, Aggregation, BatchWrite, BatchWrite}, admitted)
+	assert.Equal(t, 0, c.running)
+}
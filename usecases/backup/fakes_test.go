@@ -179,6 +179,14 @@ func (fb *fakeBackend) Read(ctx context.Context, backupID, key string, w io.Writ
 	return 0, args.Error(1)
 }
 
+func (fb *fakeBackend) Delete(ctx context.Context, backupID, key string) error {
+	fb.Lock()
+	defer fb.Unlock()
+
+	delete(fb.files, backupID+"/"+key)
+	return nil
+}
+
 func (fb *fakeBackend) Write(ctx context.Context, backupID, key string, r io.ReadCloser) (int64, error) {
 	fb.Lock()
 	defer fb.Unlock()
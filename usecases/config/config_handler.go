@@ -75,6 +75,8 @@ type Config struct {
 	QueryDefaults                       QueryDefaults            `json:"query_defaults" yaml:"query_defaults"`
 	QueryMaximumResults                 int64                    `json:"query_maximum_results" yaml:"query_maximum_results"`
 	QueryNestedCrossReferenceLimit      int64                    `json:"query_nested_cross_reference_limit" yaml:"query_nested_cross_reference_limit"`
+	QueryCrossReferenceDepthLimit       int64                    `json:"query_cross_reference_depth_limit" yaml:"query_cross_reference_depth_limit"`
+	QueryTimeout                        time.Duration            `json:"query_timeout" yaml:"query_timeout"`
 	Contextionary                       Contextionary            `json:"contextionary" yaml:"contextionary"`
 	Authentication                      Authentication           `json:"authentication" yaml:"authentication"`
 	Authorization                       Authorization            `json:"authorization" yaml:"authorization"`
@@ -98,9 +100,17 @@ type Config struct {
 	ReindexVectorDimensionsAtStartup    bool                     `json:"reindex_vector_dimensions_at_startup" yaml:"reindex_vector_dimensions_at_startup"`
 	RecountPropertiesAtStartup          bool                     `json:"recount_properties_at_startup" yaml:"recount_properties_at_startup"`
 	ReindexSetToRoaringsetAtStartup     bool                     `json:"reindex_set_to_roaringset_at_startup" yaml:"reindex_set_to_roaringset_at_startup"`
+	MigrateSetToRoaringsetOnline        bool                     `json:"migrate_set_to_roaringset_online" yaml:"migrate_set_to_roaringset_online"`
 	IndexMissingTextFilterableAtStartup bool                     `json:"index_missing_text_filterable_at_startup" yaml:"index_missing_text_filterable_at_startup"`
+	CheckHNSWIntegrityAtStartup         bool                     `json:"check_hnsw_integrity_at_startup" yaml:"check_hnsw_integrity_at_startup"`
+	RepairHNSWIntegrityAtStartup        bool                     `json:"repair_hnsw_integrity_at_startup" yaml:"repair_hnsw_integrity_at_startup"`
 	DisableGraphQL                      bool                     `json:"disable_graphql" yaml:"disable_graphql"`
 	AvoidMmap                           bool                     `json:"avoid_mmap" yaml:"avoid_mmap"`
+	LazyLoadShards                      bool                     `json:"lazy_load_shards" yaml:"lazy_load_shards"`
+	ForceScalarDistancer                bool                     `json:"force_scalar_distancer" yaml:"force_scalar_distancer"`
+	AsyncIndexing                       AsyncIndexing            `json:"async_indexing" yaml:"async_indexing"`
+	AdmissionControl                    AdmissionControl         `json:"admission_control" yaml:"admission_control"`
+	QueryCache                          QueryCache               `json:"query_cache" yaml:"query_cache"`
 }
 
 type moduleProvider interface {
@@ -131,10 +141,10 @@ func (c Config) validateDefaultVectorizerModule(modProv moduleProvider) error {
 
 func (c Config) validateDefaultVectorDistanceMetric() error {
 	switch c.DefaultVectorDistanceMetric {
-	case "", hnsw.DistanceCosine, hnsw.DistanceDot, hnsw.DistanceL2Squared, hnsw.DistanceManhattan, hnsw.DistanceHamming:
+	case "", hnsw.DistanceCosine, hnsw.DistanceDot, hnsw.DistanceL2Squared, hnsw.DistanceManhattan, hnsw.DistanceHamming, hnsw.DistanceJaccard:
 		return nil
 	default:
-		return fmt.Errorf("must be one of [\"cosine\", \"dot\", \"l2-squared\", \"manhattan\",\"hamming\"]")
+		return fmt.Errorf("must be one of [\"cosine\", \"dot\", \"l2-squared\", \"manhattan\",\"hamming\",\"jaccard\"]")
 	}
 }
 
@@ -188,11 +198,23 @@ type Profiling struct {
 }
 
 type Persistence struct {
-	DataPath                          string `json:"dataPath" yaml:"dataPath"`
-	FlushIdleMemtablesAfter           int    `json:"flushIdleMemtablesAfter" yaml:"flushIdleMemtablesAfter"`
-	MemtablesMaxSizeMB                int    `json:"memtablesMaxSizeMB" yaml:"memtablesMaxSizeMB"`
-	MemtablesMinActiveDurationSeconds int    `json:"memtablesMinActiveDurationSeconds" yaml:"memtablesMinActiveDurationSeconds"`
-	MemtablesMaxActiveDurationSeconds int    `json:"memtablesMaxActiveDurationSeconds" yaml:"memtablesMaxActiveDurationSeconds"`
+	DataPath                          string  `json:"dataPath" yaml:"dataPath"`
+	FlushIdleMemtablesAfter           int     `json:"flushIdleMemtablesAfter" yaml:"flushIdleMemtablesAfter"`
+	MemtablesMaxSizeMB                int     `json:"memtablesMaxSizeMB" yaml:"memtablesMaxSizeMB"`
+	MemtablesMinActiveDurationSeconds int     `json:"memtablesMinActiveDurationSeconds" yaml:"memtablesMinActiveDurationSeconds"`
+	MemtablesMaxActiveDurationSeconds int     `json:"memtablesMaxActiveDurationSeconds" yaml:"memtablesMaxActiveDurationSeconds"`
+	MemtablesMaxAgeSeconds            int     `json:"memtablesMaxAgeSeconds" yaml:"memtablesMaxAgeSeconds"`
+	MemtablesMaxWALSizeMB             int     `json:"memtablesMaxWALSizeMB" yaml:"memtablesMaxWALSizeMB"`
+	HNSWCheckpointIntervalSeconds     int     `json:"hnswCheckpointIntervalSeconds" yaml:"hnswCheckpointIntervalSeconds"`
+	VectorIndexSnapshotPath           string  `json:"vectorIndexSnapshotPath" yaml:"vectorIndexSnapshotPath"`
+	LSMCompactionStrategy             string  `json:"lsmCompactionStrategy" yaml:"lsmCompactionStrategy"`
+	LSMCompactionSizeRatio            float64 `json:"lsmCompactionSizeRatio" yaml:"lsmCompactionSizeRatio"`
+	WALSyncMode                       string  `json:"walSyncMode" yaml:"walSyncMode"`
+	LSMBlockCacheSizeMB               int     `json:"lsmBlockCacheSizeMB" yaml:"lsmBlockCacheSizeMB"`
+	WALGroupCommitMaxDelayMs          int     `json:"walGroupCommitMaxDelayMs" yaml:"walGroupCommitMaxDelayMs"`
+	OffloadBackend                    string  `json:"offloadBackend" yaml:"offloadBackend"`
+	LSMDirectIO                       bool    `json:"lsmDirectIO" yaml:"lsmDirectIO"`
+	LSMScrubIntervalHours             int     `json:"lsmScrubIntervalHours" yaml:"lsmScrubIntervalHours"`
 }
 
 func (p Persistence) Validate() error {
@@ -203,6 +225,46 @@ func (p Persistence) Validate() error {
 	return nil
 }
 
+// AsyncIndexing decouples the vector indexing from the object ingestion.
+// Vectors are queued durably per shard and inserted into the vector index in
+// the background.
+type AsyncIndexing struct {
+	Enabled bool `json:"enabled" yaml:"enabled"`
+	// QueueMaxSize is the number of vectors a queue holds before writes block
+	QueueMaxSize int `json:"queue_max_size" yaml:"queue_max_size"`
+	// BatchSize is the number of vectors indexed at once
+	BatchSize int `json:"batch_size" yaml:"batch_size"`
+	// SearchUnindexed makes vector searches brute-force the queued vectors
+	// and merge them into the results of the vector index
+	SearchUnindexed bool `json:"search_unindexed" yaml:"search_unindexed"`
+}
+
+// AdmissionControl limits the concurrent heavy operations of the node, i.e.
+// vector searches, aggregations and batch writes. Operations beyond the limit
+// are queued by priority, or rejected once the queue is full. A MaxConcurrent
+// of zero disables it.
+type AdmissionControl struct {
+	MaxConcurrent int `json:"max_concurrent" yaml:"max_concurrent"`
+	// MaxQueued is the number of operations which wait for a slot
+	MaxQueued int `json:"max_queued" yaml:"max_queued"`
+	// QueueTimeout is how long an operation waits before it is rejected
+	QueueTimeout time.Duration `json:"queue_timeout" yaml:"queue_timeout"`
+	// The priorities of the kinds of operations, higher ones are admitted
+	// first
+	VectorSearchPriority int `json:"vector_search_priority" yaml:"vector_search_priority"`
+	AggregationPriority  int `json:"aggregation_priority" yaml:"aggregation_priority"`
+	BatchWritePriority   int `json:"batch_write_priority" yaml:"batch_write_priority"`
+}
+
+// QueryCache caches the results of repeated Get and Aggregate queries on
+// this node. The results of a class are invalidated by every write to its
+// shards. A MaxEntries of zero disables it.
+type QueryCache struct {
+	MaxEntries int `json:"max_entries" yaml:"max_entries"`
+	// TTL is how long a result is served from the cache
+	TTL time.Duration `json:"ttl" yaml:"ttl"`
+}
+
 type DiskUse struct {
 	WarningPercentage  uint64 `json:"warning_percentage" yaml:"warning_percentage"`
 	ReadOnlyPercentage uint64 `json:"readonly_percentage" yaml:"readonly_percentage"`
@@ -65,10 +65,24 @@ func FromEnv(config *Config) error {
 		config.ReindexSetToRoaringsetAtStartup = true
 	}
 
+	if enabled(os.Getenv("MIGRATE_SET_TO_ROARINGSET_ONLINE")) {
+		config.MigrateSetToRoaringsetOnline = true
+	}
+
 	if enabled(os.Getenv("INDEX_MISSING_TEXT_FILTERABLE_AT_STARTUP")) {
 		config.IndexMissingTextFilterableAtStartup = true
 	}
 
+	// Validate the hnsw graphs at startup, repairing them implies checking them
+	if enabled(os.Getenv("CHECK_HNSW_INTEGRITY_AT_STARTUP")) {
+		config.CheckHNSWIntegrityAtStartup = true
+	}
+
+	if enabled(os.Getenv("REPAIR_HNSW_INTEGRITY_AT_STARTUP")) {
+		config.CheckHNSWIntegrityAtStartup = true
+		config.RepairHNSWIntegrityAtStartup = true
+	}
+
 	if v := os.Getenv("PROMETHEUS_MONITORING_PORT"); v != "" {
 		asInt, err := strconv.Atoi(v)
 		if err != nil {
@@ -142,6 +156,12 @@ func FromEnv(config *Config) error {
 		config.AvoidMmap = true
 	}
 
+	// Only load the metadata of shards at startup, their stores and vector
+	// indexes are opened on first access or by a background warmer
+	if enabled(os.Getenv("LAZY_LOAD_SHARDS")) {
+		config.LazyLoadShards = true
+	}
+
 	clusterCfg, err := parseClusterConfig()
 	if err != nil {
 		return err
@@ -152,10 +172,34 @@ func FromEnv(config *Config) error {
 		config.Persistence.DataPath = v
 	}
 
+	if v := os.Getenv("PERSISTENCE_VECTOR_INDEX_SNAPSHOT_PATH"); v != "" {
+		config.Persistence.VectorIndexSnapshotPath = v
+	}
+
+	// names the backup backend, e.g. "s3", which FROZEN tenants are offloaded
+	// to. Unset, tenants cannot be frozen.
+	if v := os.Getenv("PERSISTENCE_OFFLOAD_BACKEND"); v != "" {
+		config.Persistence.OffloadBackend = v
+	}
+
+	// compactions and full scans of lsm segments bypass the page cache, so
+	// that they do not evict hot data. Point reads keep using mmap.
+	if enabled(os.Getenv("PERSISTENCE_LSM_DIRECT_IO")) {
+		config.Persistence.LSMDirectIO = true
+	}
+
 	if err := config.parseMemtableConfig(); err != nil {
 		return err
 	}
 
+	if err := config.parseLSMCompactionConfig(); err != nil {
+		return err
+	}
+
+	if err := config.parseWALSyncConfig(); err != nil {
+		return err
+	}
+
 	if v := os.Getenv("ORIGIN"); v != "" {
 		config.Origin = v
 	}
@@ -196,6 +240,28 @@ func FromEnv(config *Config) error {
 		config.QueryNestedCrossReferenceLimit = DefaultQueryNestedCrossReferenceLimit
 	}
 
+	if v := os.Getenv("QUERY_CROSS_REFERENCE_DEPTH_LIMIT"); v != "" {
+		limit, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return errors.Wrapf(err, "parse QUERY_CROSS_REFERENCE_DEPTH_LIMIT as int")
+		} else if limit <= 0 {
+			limit = math.MaxInt
+		}
+		config.QueryCrossReferenceDepthLimit = limit
+	} else {
+		config.QueryCrossReferenceDepthLimit = DefaultQueryCrossReferenceDepthLimit
+	}
+
+	if v := os.Getenv("QUERY_TIMEOUT"); v != "" {
+		timeout, err := time.ParseDuration(v)
+		if err != nil {
+			return errors.Wrapf(err, "parse QUERY_TIMEOUT as time.Duration")
+		} else if timeout < 0 {
+			return errors.New("negative QUERY_TIMEOUT")
+		}
+		config.QueryTimeout = timeout
+	}
+
 	if v := os.Getenv("MAX_IMPORT_GOROUTINES_FACTOR"); v != "" {
 		asFloat, err := strconv.ParseFloat(v, 64)
 		if err != nil {
@@ -298,6 +364,22 @@ func FromEnv(config *Config) error {
 
 	config.DisableGraphQL = enabled(os.Getenv("DISABLE_GRAPHQL"))
 
+	// Calculate all vector distances in pure go, to rule out the SIMD kernels
+	// when debugging
+	config.ForceScalarDistancer = enabled(os.Getenv("DISTANCER_FORCE_SCALAR"))
+
+	if err := config.parseAsyncIndexingConfig(); err != nil {
+		return err
+	}
+
+	if err := config.parseAdmissionControlConfig(); err != nil {
+		return err
+	}
+
+	if err := config.parseQueryCacheConfig(); err != nil {
+		return err
+	}
+
 	if err := parsePositiveInt(
 		"REPLICATION_MINIMUM_FACTOR",
 		func(val int) { config.Replication.MinimumFactor = val },
@@ -351,6 +433,200 @@ func (c *Config) parseMemtableConfig() error {
 		return err
 	}
 
+	// unset disables the threshold, memtables are then only flushed by size,
+	// idleness or the size of their WAL
+	if err := parsePositiveInt(
+		"PERSISTENCE_MEMTABLES_MAX_AGE_SECONDS",
+		func(val int) { c.Persistence.MemtablesMaxAgeSeconds = val },
+		0,
+	); err != nil {
+		return err
+	}
+
+	if err := parsePositiveInt(
+		"PERSISTENCE_MEMTABLES_MAX_WAL_SIZE_MB",
+		func(val int) { c.Persistence.MemtablesMaxWALSizeMB = val },
+		0,
+	); err != nil {
+		return err
+	}
+
+	// unset disables the block cache, every segment then holds its bloom
+	// filters itself
+	if err := parsePositiveInt(
+		"PERSISTENCE_LSM_BLOCK_CACHE_SIZE_MB",
+		func(val int) { c.Persistence.LSMBlockCacheSizeMB = val },
+		0,
+	); err != nil {
+		return err
+	}
+
+	if err := parsePositiveInt(
+		"PERSISTENCE_HNSW_CHECKPOINT_INTERVAL_SECONDS",
+		func(val int) { c.Persistence.HNSWCheckpointIntervalSeconds = val },
+		DefaultPersistenceHNSWCheckpointInterval,
+	); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *Config) parseLSMCompactionConfig() error {
+	switch v := os.Getenv("PERSISTENCE_LSM_COMPACTION_STRATEGY"); v {
+	case "":
+		c.Persistence.LSMCompactionStrategy = DefaultPersistenceLSMCompactionStrategy
+	case "leveled", "tiered":
+		c.Persistence.LSMCompactionStrategy = v
+	default:
+		return fmt.Errorf("PERSISTENCE_LSM_COMPACTION_STRATEGY must be one of "+
+			"\"leveled\" or \"tiered\", got %q", v)
+	}
+
+	if v := os.Getenv("PERSISTENCE_LSM_COMPACTION_SIZE_RATIO"); v != "" {
+		asFloat, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return fmt.Errorf("parse PERSISTENCE_LSM_COMPACTION_SIZE_RATIO as float: %w", err)
+		} else if asFloat < 1 {
+			return fmt.Errorf("PERSISTENCE_LSM_COMPACTION_SIZE_RATIO must be >= 1")
+		}
+
+		c.Persistence.LSMCompactionSizeRatio = asFloat
+	} else {
+		c.Persistence.LSMCompactionSizeRatio = DefaultPersistenceLSMCompactionSizeRatio
+	}
+
+	// 0 disables the verification of segment checksums in the background
+	if v := os.Getenv("PERSISTENCE_LSM_SCRUB_INTERVAL_HOURS"); v != "" {
+		asInt, err := strconv.Atoi(v)
+		if err != nil {
+			return fmt.Errorf("parse PERSISTENCE_LSM_SCRUB_INTERVAL_HOURS as int: %w", err)
+		} else if asInt < 0 {
+			return fmt.Errorf("PERSISTENCE_LSM_SCRUB_INTERVAL_HOURS must not be negative")
+		}
+
+		c.Persistence.LSMScrubIntervalHours = asInt
+	} else {
+		c.Persistence.LSMScrubIntervalHours = DefaultPersistenceLSMScrubIntervalHours
+	}
+
+	return nil
+}
+
+func (c *Config) parseWALSyncConfig() error {
+	switch v := os.Getenv("PERSISTENCE_WAL_SYNC_MODE"); v {
+	case "":
+		c.Persistence.WALSyncMode = DefaultPersistenceWALSyncMode
+	case "none", "always", "group":
+		c.Persistence.WALSyncMode = v
+	default:
+		return fmt.Errorf("PERSISTENCE_WAL_SYNC_MODE must be one of "+
+			"\"none\", \"always\" or \"group\", got %q", v)
+	}
+
+	return parsePositiveInt(
+		"PERSISTENCE_WAL_GROUP_COMMIT_MAX_DELAY_MS",
+		func(val int) { c.Persistence.WALGroupCommitMaxDelayMs = val },
+		DefaultPersistenceWALGroupCommitMaxDelayMs,
+	)
+}
+
+func (c *Config) parseAsyncIndexingConfig() error {
+	c.AsyncIndexing.Enabled = enabled(os.Getenv("ASYNC_INDEXING"))
+	c.AsyncIndexing.SearchUnindexed = enabled(os.Getenv("ASYNC_INDEXING_SEARCH_UNINDEXED"))
+
+	if err := parsePositiveInt(
+		"ASYNC_INDEXING_QUEUE_MAX_SIZE",
+		func(val int) { c.AsyncIndexing.QueueMaxSize = val },
+		DefaultAsyncIndexingQueueMaxSize,
+	); err != nil {
+		return err
+	}
+
+	if err := parsePositiveInt(
+		"ASYNC_INDEXING_BATCH_SIZE",
+		func(val int) { c.AsyncIndexing.BatchSize = val },
+		DefaultAsyncIndexingBatchSize,
+	); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *Config) parseAdmissionControlConfig() error {
+	// not set means no limit
+	if err := parsePositiveInt(
+		"ADMISSION_CONTROL_MAX_CONCURRENT",
+		func(val int) { c.AdmissionControl.MaxConcurrent = val },
+		0,
+	); err != nil {
+		return err
+	}
+
+	ints := []struct {
+		varName      string
+		target       *int
+		defaultValue int
+		nonNegative  bool
+	}{
+		{"ADMISSION_CONTROL_MAX_QUEUED", &c.AdmissionControl.MaxQueued, DefaultAdmissionControlMaxQueued, true},
+		{"ADMISSION_CONTROL_PRIORITY_VECTOR_SEARCH", &c.AdmissionControl.VectorSearchPriority, DefaultAdmissionControlVectorSearchPriority, false},
+		{"ADMISSION_CONTROL_PRIORITY_AGGREGATION", &c.AdmissionControl.AggregationPriority, DefaultAdmissionControlAggregationPriority, false},
+		{"ADMISSION_CONTROL_PRIORITY_BATCH_WRITE", &c.AdmissionControl.BatchWritePriority, DefaultAdmissionControlBatchWritePriority, false},
+	}
+	for _, i := range ints {
+		v := os.Getenv(i.varName)
+		if v == "" {
+			*i.target = i.defaultValue
+			continue
+		}
+		asInt, err := strconv.Atoi(v)
+		if err != nil {
+			return fmt.Errorf("parse %s as int: %w", i.varName, err)
+		} else if i.nonNegative && asInt < 0 {
+			return fmt.Errorf("%s must not be negative", i.varName)
+		}
+		*i.target = asInt
+	}
+
+	if v := os.Getenv("ADMISSION_CONTROL_QUEUE_TIMEOUT"); v != "" {
+		timeout, err := time.ParseDuration(v)
+		if err != nil {
+			return errors.Wrapf(err, "parse ADMISSION_CONTROL_QUEUE_TIMEOUT as time.Duration")
+		} else if timeout < 0 {
+			return errors.New("negative ADMISSION_CONTROL_QUEUE_TIMEOUT")
+		}
+		c.AdmissionControl.QueueTimeout = timeout
+	} else {
+		c.AdmissionControl.QueueTimeout = DefaultAdmissionControlQueueTimeout
+	}
+
+	return nil
+}
+
+func (c *Config) parseQueryCacheConfig() error {
+	// not set means no cache
+	if err := parsePositiveInt(
+		"QUERY_CACHE_MAX_ENTRIES",
+		func(val int) { c.QueryCache.MaxEntries = val },
+		0,
+	); err != nil {
+		return err
+	}
+
+	if v := os.Getenv("QUERY_CACHE_TTL"); v != "" {
+		ttl, err := time.ParseDuration(v)
+		if err != nil {
+			return errors.Wrapf(err, "parse QUERY_CACHE_TTL as time.Duration")
+		} else if ttl < 0 {
+			return errors.New("negative QUERY_CACHE_TTL")
+		}
+		c.QueryCache.TTL = ttl
+	} else {
+		c.QueryCache.TTL = DefaultQueryCacheTTL
+	}
+
 	return nil
 }
 
@@ -374,6 +650,7 @@ func parsePositiveInt(varName string, cb func(val int), defaultValue int) error
 const (
 	DefaultQueryMaximumResults            = int64(10000)
 	DefaultQueryNestedCrossReferenceLimit = int64(100000)
+	DefaultQueryCrossReferenceDepthLimit  = int64(10)
 )
 
 const (
@@ -381,11 +658,30 @@ const (
 	DefaultPersistenceMemtablesMaxSize        = 200
 	DefaultPersistenceMemtablesMinDuration    = 15
 	DefaultPersistenceMemtablesMaxDuration    = 45
-	DefaultMaxConcurrentGetRequests           = 0
-	DefaultGRPCPort                           = 50051
-	DefaultMinimumReplicationFactor           = 1
+	// 0 disables hnsw checkpoints, the full commit log is replayed on startup
+	DefaultPersistenceHNSWCheckpointInterval   = 0
+	DefaultPersistenceLSMCompactionStrategy    = "leveled"
+	DefaultPersistenceLSMCompactionSizeRatio   = 4.0
+	DefaultPersistenceLSMScrubIntervalHours    = 24
+	DefaultPersistenceWALSyncMode              = "none"
+	DefaultPersistenceWALGroupCommitMaxDelayMs = 10
+	DefaultMaxConcurrentGetRequests            = 0
+	DefaultGRPCPort                            = 50051
+	DefaultMinimumReplicationFactor            = 1
+	DefaultAsyncIndexingQueueMaxSize           = 100000
+	DefaultAsyncIndexingBatchSize              = 1000
 )
 
+const (
+	DefaultAdmissionControlMaxQueued            = 100
+	DefaultAdmissionControlQueueTimeout         = 10 * time.Second
+	DefaultAdmissionControlVectorSearchPriority = 2
+	DefaultAdmissionControlAggregationPriority  = 1
+	DefaultAdmissionControlBatchWritePriority   = 0
+)
+
+const DefaultQueryCacheTTL = time.Minute
+
 const VectorizerModuleNone = "none"
 
 // DefaultGossipBindPort uses the hashicorp/memberlist default
@@ -13,8 +13,10 @@ package config
 
 import (
 	"errors"
+	"math"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -217,6 +219,103 @@ func TestEnvironmentMemtable_MaxDuration(t *testing.T) {
 	}
 }
 
+func TestEnvironmentHNSWCheckpointInterval(t *testing.T) {
+	factors := []struct {
+		name        string
+		value       []string
+		expected    int
+		expectedErr bool
+	}{
+		{"Valid", []string{"3600"}, 3600, false},
+		{"not given", []string{}, DefaultPersistenceHNSWCheckpointInterval, false},
+		{"invalid interval", []string{"-1"}, -1, true},
+		{"zero interval", []string{"0"}, -1, true},
+		{"not parsable", []string{"I'm not a number"}, -1, true},
+	}
+	for _, tt := range factors {
+		t.Run(tt.name, func(t *testing.T) {
+			if len(tt.value) == 1 {
+				t.Setenv("PERSISTENCE_HNSW_CHECKPOINT_INTERVAL_SECONDS", tt.value[0])
+			}
+			conf := Config{}
+			err := FromEnv(&conf)
+
+			if tt.expectedErr {
+				require.NotNil(t, err)
+			} else {
+				require.Equal(t, tt.expected, conf.Persistence.HNSWCheckpointIntervalSeconds)
+			}
+		})
+	}
+}
+
+func TestEnvironmentVectorIndexSnapshotPath(t *testing.T) {
+	t.Run("not given", func(t *testing.T) {
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		require.Equal(t, "", conf.Persistence.VectorIndexSnapshotPath)
+	})
+
+	t.Run("given", func(t *testing.T) {
+		t.Setenv("PERSISTENCE_VECTOR_INDEX_SNAPSHOT_PATH", "/var/lib/weaviate/snapshots")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		require.Equal(t, "/var/lib/weaviate/snapshots", conf.Persistence.VectorIndexSnapshotPath)
+	})
+}
+
+func TestEnvironmentOffloadBackend(t *testing.T) {
+	t.Run("not given", func(t *testing.T) {
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		require.Equal(t, "", conf.Persistence.OffloadBackend)
+	})
+
+	t.Run("given", func(t *testing.T) {
+		t.Setenv("PERSISTENCE_OFFLOAD_BACKEND", "s3")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		require.Equal(t, "s3", conf.Persistence.OffloadBackend)
+	})
+}
+
+func TestEnvironmentLSMDirectIO(t *testing.T) {
+	t.Run("not given", func(t *testing.T) {
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		require.False(t, conf.Persistence.LSMDirectIO)
+	})
+
+	t.Run("given", func(t *testing.T) {
+		t.Setenv("PERSISTENCE_LSM_DIRECT_IO", "true")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		require.True(t, conf.Persistence.LSMDirectIO)
+	})
+}
+
+func TestEnvironmentLSMScrubInterval(t *testing.T) {
+	t.Run("not given", func(t *testing.T) {
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		require.Equal(t, DefaultPersistenceLSMScrubIntervalHours,
+			conf.Persistence.LSMScrubIntervalHours)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		t.Setenv("PERSISTENCE_LSM_SCRUB_INTERVAL_HOURS", "0")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		require.Equal(t, 0, conf.Persistence.LSMScrubIntervalHours)
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		t.Setenv("PERSISTENCE_LSM_SCRUB_INTERVAL_HOURS", "-1")
+		conf := Config{}
+		require.NotNil(t, FromEnv(&conf))
+	})
+}
+
 func TestEnvironmentParseClusterConfig(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -345,6 +444,64 @@ func TestEnvironmentMaxConcurrentGetRequests(t *testing.T) {
 	}
 }
 
+func TestEnvironmentCrossReferenceDepthLimit(t *testing.T) {
+	factors := []struct {
+		name        string
+		value       []string
+		expected    int64
+		expectedErr bool
+	}{
+		{"Valid", []string{"3"}, 3, false},
+		{"not given", []string{}, DefaultQueryCrossReferenceDepthLimit, false},
+		{"unlimited", []string{"0"}, math.MaxInt, false},
+		{"not parsable", []string{"I'm not a number"}, -1, true},
+	}
+	for _, tt := range factors {
+		t.Run(tt.name, func(t *testing.T) {
+			if len(tt.value) == 1 {
+				t.Setenv("QUERY_CROSS_REFERENCE_DEPTH_LIMIT", tt.value[0])
+			}
+			conf := Config{}
+			err := FromEnv(&conf)
+
+			if tt.expectedErr {
+				require.NotNil(t, err)
+			} else {
+				require.Equal(t, tt.expected, conf.QueryCrossReferenceDepthLimit)
+			}
+		})
+	}
+}
+
+func TestEnvironmentQueryTimeout(t *testing.T) {
+	factors := []struct {
+		name        string
+		value       []string
+		expected    time.Duration
+		expectedErr bool
+	}{
+		{"Valid", []string{"1500ms"}, 1500 * time.Millisecond, false},
+		{"not given", []string{}, 0, false},
+		{"negative", []string{"-1s"}, -1, true},
+		{"not parsable", []string{"I'm not a duration"}, -1, true},
+	}
+	for _, tt := range factors {
+		t.Run(tt.name, func(t *testing.T) {
+			if len(tt.value) == 1 {
+				t.Setenv("QUERY_TIMEOUT", tt.value[0])
+			}
+			conf := Config{}
+			err := FromEnv(&conf)
+
+			if tt.expectedErr {
+				require.NotNil(t, err)
+			} else {
+				require.Equal(t, tt.expected, conf.QueryTimeout)
+			}
+		})
+	}
+}
+
 func TestEnvironmentGRPCPort(t *testing.T) {
 	factors := []struct {
 		name        string
@@ -502,3 +659,166 @@ func TestEnvironmentMinimumReplicationFactor(t *testing.T) {
 		})
 	}
 }
+
+func TestEnvironmentAsyncIndexing(t *testing.T) {
+	t.Run("not given", func(t *testing.T) {
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		require.Equal(t, AsyncIndexing{
+			QueueMaxSize: DefaultAsyncIndexingQueueMaxSize,
+			BatchSize:    DefaultAsyncIndexingBatchSize,
+		}, conf.AsyncIndexing)
+	})
+
+	t.Run("given", func(t *testing.T) {
+		t.Setenv("ASYNC_INDEXING", "true")
+		t.Setenv("ASYNC_INDEXING_SEARCH_UNINDEXED", "true")
+		t.Setenv("ASYNC_INDEXING_QUEUE_MAX_SIZE", "5000")
+		t.Setenv("ASYNC_INDEXING_BATCH_SIZE", "50")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		require.Equal(t, AsyncIndexing{
+			Enabled:         true,
+			QueueMaxSize:    5000,
+			BatchSize:       50,
+			SearchUnindexed: true,
+		}, conf.AsyncIndexing)
+	})
+
+	t.Run("invalid queue size", func(t *testing.T) {
+		t.Setenv("ASYNC_INDEXING_QUEUE_MAX_SIZE", "0")
+		conf := Config{}
+		require.NotNil(t, FromEnv(&conf))
+	})
+}
+
+func TestEnvironmentAdmissionControl(t *testing.T) {
+	t.Run("not given", func(t *testing.T) {
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		require.Equal(t, AdmissionControl{
+			MaxQueued:            DefaultAdmissionControlMaxQueued,
+			QueueTimeout:         DefaultAdmissionControlQueueTimeout,
+			VectorSearchPriority: DefaultAdmissionControlVectorSearchPriority,
+			AggregationPriority:  DefaultAdmissionControlAggregationPriority,
+			BatchWritePriority:   DefaultAdmissionControlBatchWritePriority,
+		}, conf.AdmissionControl)
+	})
+
+	t.Run("given", func(t *testing.T) {
+		t.Setenv("ADMISSION_CONTROL_MAX_CONCURRENT", "8")
+		t.Setenv("ADMISSION_CONTROL_MAX_QUEUED", "0")
+		t.Setenv("ADMISSION_CONTROL_QUEUE_TIMEOUT", "2s")
+		t.Setenv("ADMISSION_CONTROL_PRIORITY_VECTOR_SEARCH", "0")
+		t.Setenv("ADMISSION_CONTROL_PRIORITY_AGGREGATION", "-1")
+		t.Setenv("ADMISSION_CONTROL_PRIORITY_BATCH_WRITE", "5")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		require.Equal(t, AdmissionControl{
+			MaxConcurrent:        8,
+			MaxQueued:            0,
+			QueueTimeout:         2 * time.Second,
+			VectorSearchPriority: 0,
+			AggregationPriority:  -1,
+			BatchWritePriority:   5,
+		}, conf.AdmissionControl)
+	})
+
+	t.Run("invalid max concurrent", func(t *testing.T) {
+		t.Setenv("ADMISSION_CONTROL_MAX_CONCURRENT", "0")
+		conf := Config{}
+		require.NotNil(t, FromEnv(&conf))
+	})
+
+	t.Run("negative max queued", func(t *testing.T) {
+		t.Setenv("ADMISSION_CONTROL_MAX_QUEUED", "-1")
+		conf := Config{}
+		require.NotNil(t, FromEnv(&conf))
+	})
+
+	t.Run("invalid queue timeout", func(t *testing.T) {
+		t.Setenv("ADMISSION_CONTROL_QUEUE_TIMEOUT", "soon")
+		conf := Config{}
+		require.NotNil(t, FromEnv(&conf))
+	})
+}
+
+func TestEnvironmentQueryCache(t *testing.T) {
+	t.Run("not given", func(t *testing.T) {
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		require.Equal(t, QueryCache{TTL: DefaultQueryCacheTTL}, conf.QueryCache)
+	})
+
+	t.Run("given", func(t *testing.T) {
+		t.Setenv("QUERY_CACHE_MAX_ENTRIES", "500")
+		t.Setenv("QUERY_CACHE_TTL", "30s")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		require.Equal(t, QueryCache{MaxEntries: 500, TTL: 30 * time.Second}, conf.QueryCache)
+	})
+
+	t.Run("invalid max entries", func(t *testing.T) {
+		t.Setenv("QUERY_CACHE_MAX_ENTRIES", "many")
+		conf := Config{}
+		require.NotNil(t, FromEnv(&conf))
+	})
+
+	t.Run("negative ttl", func(t *testing.T) {
+		t.Setenv("QUERY_CACHE_TTL", "-1s")
+		conf := Config{}
+		require.NotNil(t, FromEnv(&conf))
+	})
+}
+
+func TestEnvironmentWALSync(t *testing.T) {
+	t.Run("not given", func(t *testing.T) {
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		require.Equal(t, DefaultPersistenceWALSyncMode, conf.Persistence.WALSyncMode)
+		require.Equal(t, DefaultPersistenceWALGroupCommitMaxDelayMs,
+			conf.Persistence.WALGroupCommitMaxDelayMs)
+	})
+
+	t.Run("given", func(t *testing.T) {
+		t.Setenv("PERSISTENCE_WAL_SYNC_MODE", "group")
+		t.Setenv("PERSISTENCE_WAL_GROUP_COMMIT_MAX_DELAY_MS", "25")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		require.Equal(t, "group", conf.Persistence.WALSyncMode)
+		require.Equal(t, 25, conf.Persistence.WALGroupCommitMaxDelayMs)
+	})
+
+	t.Run("invalid mode", func(t *testing.T) {
+		t.Setenv("PERSISTENCE_WAL_SYNC_MODE", "sometimes")
+		conf := Config{}
+		require.NotNil(t, FromEnv(&conf))
+	})
+
+	t.Run("invalid max delay", func(t *testing.T) {
+		t.Setenv("PERSISTENCE_WAL_GROUP_COMMIT_MAX_DELAY_MS", "-1")
+		conf := Config{}
+		require.NotNil(t, FromEnv(&conf))
+	})
+}
+
+func TestEnvironmentLSMBlockCacheSize(t *testing.T) {
+	t.Run("not given", func(t *testing.T) {
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		require.Equal(t, 0, conf.Persistence.LSMBlockCacheSizeMB)
+	})
+
+	t.Run("given", func(t *testing.T) {
+		t.Setenv("PERSISTENCE_LSM_BLOCK_CACHE_SIZE_MB", "512")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		require.Equal(t, 512, conf.Persistence.LSMBlockCacheSizeMB)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Setenv("PERSISTENCE_LSM_BLOCK_CACHE_SIZE_MB", "-1")
+		conf := Config{}
+		require.NotNil(t, FromEnv(&conf))
+	})
+}
@@ -11,7 +11,10 @@
 
 package nearText
 
-import "github.com/pkg/errors"
+import (
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/searchparams"
+)
 
 type ObjectMove struct {
 	ID     string
@@ -35,6 +38,8 @@ type NearTextParams struct {
 	WithDistance bool
 	Network      bool
 	Autocorrect  bool
+	RescoreLimit int
+	Oversampling float64
 }
 
 func (n NearTextParams) GetCertainty() float64 {
@@ -49,6 +54,13 @@ func (n NearTextParams) SimilarityMetricProvided() bool {
 	return n.Certainty != 0 || n.WithDistance
 }
 
+func (n NearTextParams) GetRescore() *searchparams.Rescore {
+	if n.RescoreLimit == 0 && n.Oversampling == 0 {
+		return nil
+	}
+	return &searchparams.Rescore{Limit: n.RescoreLimit, Oversampling: n.Oversampling}
+}
+
 func (n NearTextParams) Validate() error {
 	if n.MoveTo.Force > 0 &&
 		n.MoveTo.Values == nil && n.MoveTo.Objects == nil {
@@ -67,5 +79,9 @@ func (n NearTextParams) Validate() error {
 			"nearText cannot provide both distance and certainty")
 	}
 
+	if err := n.GetRescore().Validate(); err != nil {
+		return errors.Wrap(err, "nearText")
+	}
+
 	return nil
 }
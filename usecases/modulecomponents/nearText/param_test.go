@@ -53,6 +53,31 @@ func Test_validateNearText(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"With rescore limit and oversampling",
+			NearTextParams{
+				Values:       []string{"foobar"},
+				RescoreLimit: 50,
+				Oversampling: 2,
+			},
+			false,
+		},
+		{
+			"With negative rescore limit",
+			NearTextParams{
+				Values:       []string{"foobar"},
+				RescoreLimit: -1,
+			},
+			true,
+		},
+		{
+			"With oversampling below 1",
+			NearTextParams{
+				Values:       []string{"foobar"},
+				Oversampling: 0.5,
+			},
+			true,
+		},
 		{
 			"With certainty and distance",
 			NearTextParams{
@@ -524,6 +524,10 @@ func (m *dummyBackupModuleWithAltNames) Read(ctx context.Context, backupID, key
 	return 0, nil
 }
 
+func (m *dummyBackupModuleWithAltNames) Delete(ctx context.Context, backupID, key string) error {
+	return nil
+}
+
 func (m *dummyBackupModuleWithAltNames) SourceDataPath() string {
 	return ""
 }
@@ -21,7 +21,10 @@ import (
 	"github.com/weaviate/weaviate/entities/modulecapabilities"
 	"github.com/weaviate/weaviate/entities/moduletools"
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/vectorindex/dynamic"
+	"github.com/weaviate/weaviate/entities/vectorindex/flat"
 	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/entities/vectorindex/vamana"
 	"github.com/weaviate/weaviate/usecases/config"
 )
 
@@ -29,8 +32,8 @@ const (
 	errorVectorizerCapability = "module %q exists, but does not provide the " +
 		"Vectorizer or ReferenceVectorizer capability"
 
-	errorVectorIndexType = "vector index config (%T) is not of type HNSW, " +
-		"but objects manager is restricted to HNSW"
+	errorVectorIndexType = "vector index config (%T) is not of type HNSW, Vamana, Flat or Dynamic, " +
+		"but objects manager is restricted to HNSW, Vamana, Flat and Dynamic"
 
 	warningVectorIgnored = "This vector will be ignored. If you meant to index " +
 		"the vector, make sure to set vectorIndexConfig.skip to 'false'. If the previous " +
@@ -84,13 +87,24 @@ func (p *Provider) UpdateVector(ctx context.Context, object *models.Object, clas
 	objectDiff *moduletools.ObjectDiff, findObjectFn modulecapabilities.FindObjectFn,
 	logger logrus.FieldLogger,
 ) error {
-	hnswConfig, ok := class.VectorIndexConfig.(hnsw.UserConfig)
-	if !ok {
-		return fmt.Errorf(errorVectorIndexType, class.VectorIndexConfig)
+	if err := p.updateTargetVectors(ctx, object, class, findObjectFn); err != nil {
+		return err
+	}
+
+	return p.updateClassVector(ctx, object, class, objectDiff, findObjectFn, logger)
+}
+
+func (p *Provider) updateClassVector(ctx context.Context, object *models.Object, class *models.Class,
+	objectDiff *moduletools.ObjectDiff, findObjectFn modulecapabilities.FindObjectFn,
+	logger logrus.FieldLogger,
+) error {
+	skip, err := skipVectorIndex(class.VectorIndexConfig)
+	if err != nil {
+		return err
 	}
 
 	if class.Vectorizer == config.VectorizerModuleNone {
-		if hnswConfig.Skip && len(object.Vector) > 0 {
+		if skip && len(object.Vector) > 0 {
 			logger.WithField("className", object.Class).
 				Warningf(warningSkipVectorProvided)
 		}
@@ -98,7 +112,7 @@ func (p *Provider) UpdateVector(ctx context.Context, object *models.Object, clas
 		return nil
 	}
 
-	if hnswConfig.Skip {
+	if skip {
 		logger.WithField("className", object.Class).
 			WithField("vectorizer", class.Vectorizer).
 			Warningf(warningSkipVectorGenerated, class.Vectorizer)
@@ -140,6 +154,72 @@ func (p *Provider) UpdateVector(ctx context.Context, object *models.Object, clas
 	return nil
 }
 
+// updateTargetVectors generates the named vectors of the object which were
+// not explicitly provided and whose vector space has a vectorizer configured
+func (p *Provider) updateTargetVectors(ctx context.Context, object *models.Object,
+	class *models.Class, findObjectFn modulecapabilities.FindObjectFn,
+) error {
+	for _, name := range schema.TargetVectorNames(class) {
+		if _, ok := object.Vectors[name]; ok {
+			continue
+		}
+
+		modName, modConfig, err := schema.TargetVectorizer(class.VectorConfig[name])
+		if err != nil {
+			return fmt.Errorf("target vector %q: %w", name, err)
+		}
+		if modName == schema.VectorizerNone {
+			continue
+		}
+
+		vector, err := p.vectorizeTargetVector(ctx, object, class, modName, modConfig, findObjectFn)
+		if err != nil {
+			return fmt.Errorf("update target vector %q: %w", name, err)
+		}
+		if object.Vectors == nil {
+			object.Vectors = models.Vectors{}
+		}
+		object.Vectors[name] = vector
+	}
+
+	return nil
+}
+
+// vectorizeTargetVector runs the vectorizer of a named vector space against
+// a copy of the object, so that the module sees its own config as if it was
+// the vectorizer of the class
+func (p *Provider) vectorizeTargetVector(ctx context.Context, object *models.Object,
+	class *models.Class, modName string, modConfig map[string]interface{},
+	findObjectFn modulecapabilities.FindObjectFn,
+) ([]float32, error) {
+	if err := p.ValidateVectorizer(modName); err != nil {
+		return nil, err
+	}
+
+	shadowClass := &models.Class{
+		Class:        class.Class,
+		Properties:   class.Properties,
+		ModuleConfig: map[string]interface{}{modName: modConfig},
+	}
+	shadowObject := *object
+	shadowObject.Vector = nil
+	cfg := NewClassBasedModuleConfig(shadowClass, modName, object.Tenant)
+
+	found := p.GetByName(modName)
+	if vectorizer, ok := found.(modulecapabilities.Vectorizer); ok {
+		if err := vectorizer.VectorizeObject(ctx, &shadowObject, nil, cfg); err != nil {
+			return nil, err
+		}
+	} else {
+		refVectorizer := found.(modulecapabilities.ReferenceVectorizer)
+		if err := refVectorizer.VectorizeObject(ctx, &shadowObject, cfg, findObjectFn); err != nil {
+			return nil, err
+		}
+	}
+
+	return shadowObject.Vector, nil
+}
+
 func (p *Provider) VectorizerName(className string) (string, error) {
 	name, _, err := p.getClassVectorizer(className)
 	if err != nil {
@@ -160,3 +240,21 @@ func (p *Provider) getClassVectorizer(className string) (string, interface{}, er
 
 	return class.Vectorizer, class.VectorIndexConfig, nil
 }
+
+// skipVectorIndex returns whether the vector index described by the parsed
+// config is configured to skip indexing
+func skipVectorIndex(cfg interface{}) (bool, error) {
+	switch typed := cfg.(type) {
+	case hnsw.UserConfig:
+		return typed.Skip, nil
+	case vamana.UserConfig:
+		return typed.Skip, nil
+	case flat.UserConfig:
+		return typed.Skip, nil
+	case dynamic.UserConfig:
+		// a dynamic index always indexes, skip is rejected in its nested configs
+		return false, nil
+	default:
+		return false, fmt.Errorf(errorVectorIndexType, cfg)
+	}
+}
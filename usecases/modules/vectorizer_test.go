@@ -250,8 +250,8 @@ func TestProvider_UpdateVector(t *testing.T) {
 
 		obj := &models.Object{Class: className, ID: newUUID()}
 		err := p.UpdateVector(ctx, obj, class, nil, repo.Object, logger)
-		expectedErr := "vector index config (struct {}) is not of type HNSW, " +
-			"but objects manager is restricted to HNSW"
+		expectedErr := "vector index config (struct {}) is not of type HNSW, Vamana, Flat or Dynamic, " +
+			"but objects manager is restricted to HNSW, Vamana, Flat and Dynamic"
 		assert.EqualError(t, err, expectedErr)
 	})
 }
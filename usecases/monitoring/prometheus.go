@@ -31,12 +31,19 @@ type PrometheusMetrics struct {
 	LSMSegmentSize                     *prometheus.GaugeVec
 	LSMMemtableSize                    *prometheus.GaugeVec
 	LSMMemtableDurations               *prometheus.SummaryVec
+	LSMBlockCacheRequests              *prometheus.CounterVec
+	LSMBlockCacheEvictions             *prometheus.CounterVec
+	LSMBlockCacheSize                  prometheus.Gauge
+	LSMSegmentChecksumFailures         *prometheus.CounterVec
+	LSMQuarantinedSegments             *prometheus.GaugeVec
 	VectorIndexTombstones              *prometheus.GaugeVec
 	VectorIndexTombstoneCleanupThreads *prometheus.GaugeVec
 	VectorIndexTombstoneCleanedCount   *prometheus.CounterVec
+	VectorIndexTombstoneCleanupRate    *prometheus.GaugeVec
 	VectorIndexOperations              *prometheus.GaugeVec
 	VectorIndexDurations               *prometheus.SummaryVec
 	VectorIndexSize                    *prometheus.GaugeVec
+	VectorIndexAdaptiveEF              *prometheus.GaugeVec
 	VectorIndexMaintenanceDurations    *prometheus.SummaryVec
 	ObjectCount                        *prometheus.GaugeVec
 	QueriesCount                       *prometheus.GaugeVec
@@ -45,6 +52,7 @@ type PrometheusMetrics struct {
 	QueriesFilteredVectorDurations     *prometheus.SummaryVec
 	QueryDimensions                    *prometheus.CounterVec
 	QueryDimensionsCombined            prometheus.Counter
+	QueryCacheLookups                  *prometheus.CounterVec
 	GoroutinesCount                    *prometheus.GaugeVec
 	BackupRestoreDurations             *prometheus.SummaryVec
 	BackupStoreDurations               *prometheus.SummaryVec
@@ -55,6 +63,8 @@ type PrometheusMetrics struct {
 	BackupRestoreDataTransferred       *prometheus.CounterVec
 	BackupStoreDataTransferred         *prometheus.CounterVec
 	VectorDimensionsSum                *prometheus.GaugeVec
+	QuotaUsage                         *prometheus.GaugeVec
+	QuotaRejectedWrites                *prometheus.CounterVec
 
 	StartupProgress  *prometheus.GaugeVec
 	StartupDurations *prometheus.SummaryVec
@@ -152,6 +162,14 @@ func newPrometheusMetrics() *PrometheusMetrics {
 			Name: "lsm_segment_count",
 			Help: "Number of segments by level",
 		}, []string{"strategy", "class_name", "shard_name", "path", "level"}),
+		LSMSegmentChecksumFailures: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "lsm_segment_checksum_failures",
+			Help: "Number of segments which failed checksum verification",
+		}, []string{"strategy", "class_name", "shard_name", "path"}),
+		LSMQuarantinedSegments: promauto.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "lsm_quarantined_segments",
+			Help: "Number of corrupt segments moved to quarantine per bucket",
+		}, []string{"strategy", "class_name", "shard_name", "path"}),
 		LSMMemtableSize: promauto.NewGaugeVec(prometheus.GaugeOpts{
 			Name: "lsm_memtable_size",
 			Help: "Size of memtable by path",
@@ -160,6 +178,18 @@ func newPrometheusMetrics() *PrometheusMetrics {
 			Name: "lsm_memtable_durations_ms",
 			Help: "Time in ms for a bucket operation to complete",
 		}, []string{"strategy", "class_name", "shard_name", "path", "operation"}),
+		LSMBlockCacheRequests: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "lsm_block_cache_requests_total",
+			Help: "Lookups in the block cache shared by all segments, by kind and hit or miss",
+		}, []string{"kind", "result"}),
+		LSMBlockCacheEvictions: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "lsm_block_cache_evictions_total",
+			Help: "Entries evicted from the block cache to stay within its memory budget",
+		}, []string{"kind"}),
+		LSMBlockCacheSize: promauto.NewGauge(prometheus.GaugeOpts{
+			Name: "lsm_block_cache_size_bytes",
+			Help: "Memory held by the block cache shared by all segments",
+		}),
 
 		VectorIndexTombstones: promauto.NewGaugeVec(prometheus.GaugeOpts{
 			Name: "vector_index_tombstones",
@@ -173,6 +203,10 @@ func newPrometheusMetrics() *PrometheusMetrics {
 			Name: "vector_index_tombstone_cleaned",
 			Help: "Total number of deleted objects that have been cleaned up",
 		}, []string{"class_name", "shard_name"}),
+		VectorIndexTombstoneCleanupRate: promauto.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "vector_index_tombstone_cleanup_throughput",
+			Help: "Number of deleted objects cleaned up per second by the last tombstone cleanup cycle",
+		}, []string{"class_name", "shard_name"}),
 		VectorIndexOperations: promauto.NewGaugeVec(prometheus.GaugeOpts{
 			Name: "vector_index_operations",
 			Help: "Total number of mutating operations on the vector index",
@@ -181,6 +215,10 @@ func newPrometheusMetrics() *PrometheusMetrics {
 			Name: "vector_index_size",
 			Help: "The size of the vector index. Typically larger than number of vectors, as it grows proactively.",
 		}, []string{"class_name", "shard_name"}),
+		VectorIndexAdaptiveEF: promauto.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "vector_index_adaptive_ef",
+			Help: "The ef currently picked by the adaptive search mode of the vector index",
+		}, []string{"class_name", "shard_name"}),
 		VectorIndexMaintenanceDurations: promauto.NewSummaryVec(prometheus.SummaryOpts{
 			Name: "vector_index_maintenance_durations_ms",
 			Help: "Duration of a sync or async vector index maintenance operation",
@@ -193,6 +231,14 @@ func newPrometheusMetrics() *PrometheusMetrics {
 			Name: "vector_dimensions_sum",
 			Help: "Total dimensions in a shard",
 		}, []string{"class_name", "shard_name"}),
+		QuotaUsage: promauto.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "quota_usage_ratio",
+			Help: "Ratio of a storage quota of a class or tenant which is in use",
+		}, []string{"class_name", "shard_name", "quota"}),
+		QuotaRejectedWrites: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "quota_rejected_writes_total",
+			Help: "Number of writes rejected because they exceeded a storage quota",
+		}, []string{"class_name", "shard_name", "quota"}),
 
 		StartupProgress: promauto.NewGaugeVec(prometheus.GaugeOpts{
 			Name: "startup_progress",
@@ -214,6 +260,10 @@ func newPrometheusMetrics() *PrometheusMetrics {
 			Name: "query_dimensions_combined_total",
 			Help: "The vector dimensions used by any read-query that involves vectors, aggregated across all classes and shards. The sum of all labels for query_dimensions_total should always match this labelless metric",
 		}),
+		QueryCacheLookups: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "query_cache_lookups_total",
+			Help: "Number of lookups in the query result cache, by whether the result was cached",
+		}, []string{"class_name", "query_type", "result"}),
 		BackupRestoreDurations: promauto.NewSummaryVec(prometheus.SummaryOpts{
 			Name: "backup_restore_ms",
 			Help: "Duration of a backup restore",
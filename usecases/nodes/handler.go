@@ -25,6 +25,7 @@ type authorizer interface {
 
 type db interface {
 	GetNodeStatus(ctx context.Context, className string) ([]*models.NodeStatus, error)
+	SetReadOnly(className string, readOnly bool) (*models.NodeReadOnlyStatus, error)
 }
 
 type Manager struct {
@@ -48,3 +49,14 @@ func (m *Manager) GetNodeStatus(ctx context.Context,
 	}
 	return m.db.GetNodeStatus(ctx, className)
 }
+
+// UpdateReadOnly puts this node, or a class on this node, into read-only
+// mode or lifts it
+func (m *Manager) UpdateReadOnly(ctx context.Context,
+	principal *models.Principal, mode *models.ReadOnlyMode,
+) (*models.NodeReadOnlyStatus, error) {
+	if err := m.authorizer.Authorize(principal, "update", "nodes"); err != nil {
+		return nil, err
+	}
+	return m.db.SetReadOnly(mode.Class, mode.ReadOnly)
+}
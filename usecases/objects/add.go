@@ -33,6 +33,8 @@ type schemaManager interface {
 	) (*models.Class, error)
 	AddClassProperty(ctx context.Context, principal *models.Principal,
 		class string, property *models.Property) error
+	LockVectorDimensions(ctx context.Context, className, targetVector string,
+		dimensions int64) (int64, error)
 }
 
 // AddObject Class Instance to the connected DB.
@@ -123,6 +125,10 @@ func (m *Manager) addObjectToConnectorAndSchema(ctx context.Context, principal *
 	if err != nil {
 		return nil, err
 	}
+	if err := validateVectorDimensions(ctx, m.schemaManager, class, object.Vector,
+		object.Vectors); err != nil {
+		return nil, NewErrInvalidUserInput("invalid object: %v", err)
+	}
 
 	err = m.vectorRepo.PutObject(ctx, object, object.Vector, repl)
 	if err != nil {
@@ -145,6 +151,19 @@ func (m *Manager) validateObjectAndNormalizeNames(ctx context.Context,
 		Object(ctx, class, incoming, existing)
 }
 
+func (m *Manager) validateMergeObjectAndNormalizeNames(ctx context.Context,
+	principal *models.Principal, repl *additional.ReplicationProperties,
+	incoming *models.Object, existing *models.Object,
+) error {
+	class, err := m.validateSchema(ctx, principal, incoming)
+	if err != nil {
+		return err
+	}
+
+	return validation.New(m.vectorRepo.Exists, m.config, repl).
+		ObjectMerge(ctx, class, incoming, existing)
+}
+
 func (m *Manager) validateSchema(ctx context.Context,
 	principal *models.Principal, obj *models.Object,
 ) (*models.Class, error) {
@@ -15,6 +15,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"reflect"
 	"testing"
 
@@ -87,6 +88,16 @@ func Test_Kinds_Authorization(t *testing.T) {
 			additionalArgs: []interface{}{
 				&models.Object{Class: "class", ID: "foo"},
 				(*additional.ReplicationProperties)(nil),
+				(*VersionPrecondition)(nil),
+			},
+			expectedVerb:     "update",
+			expectedResource: "objects/class/foo",
+		},
+		{
+			methodName: "PatchObject",
+			additionalArgs: []interface{}{
+				"class", strfmt.UUID("foo"), (ObjectPatch)(nil),
+				(*additional.ReplicationProperties)(nil), "", (*VersionPrecondition)(nil),
 			},
 			expectedVerb:     "update",
 			expectedResource: "objects/class/foo",
@@ -150,6 +161,36 @@ func Test_Kinds_Authorization(t *testing.T) {
 			expectedVerb:     "update",
 			expectedResource: "objects/class/foo",
 		},
+		{
+			methodName:       "AuditReferences",
+			additionalArgs:   []interface{}{"class", "", false},
+			expectedVerb:     "get",
+			expectedResource: "objects/class",
+		},
+		{
+			methodName:       "AuditReferences",
+			additionalArgs:   []interface{}{"class", "", true},
+			expectedVerb:     "update",
+			expectedResource: "objects/class",
+		},
+		{
+			methodName:       "PutObjectBlob",
+			additionalArgs:   []interface{}{"class", strfmt.UUID("foo"), "prop", (io.Reader)(nil), (*additional.ReplicationProperties)(nil), ""},
+			expectedVerb:     "update",
+			expectedResource: "objects/class/foo",
+		},
+		{
+			methodName:       "GetObjectBlob",
+			additionalArgs:   []interface{}{"class", strfmt.UUID("foo"), "prop", (*additional.ReplicationProperties)(nil), ""},
+			expectedVerb:     "get",
+			expectedResource: "objects/class/foo",
+		},
+		{
+			methodName:       "UpdateObjectVector",
+			additionalArgs:   []interface{}{"class", strfmt.UUID("foo"), []float32{1}, models.Vectors(nil), (*additional.ReplicationProperties)(nil), ""},
+			expectedVerb:     "update",
+			expectedResource: "objects/class/foo",
+		},
 	}
 
 	t.Run("verify that a test for every public method exists", func(t *testing.T) {
@@ -212,6 +253,8 @@ func Test_BatchKinds_Authorization(t *testing.T) {
 				[]*models.Object{},
 				[]*string{},
 				&additional.ReplicationProperties{},
+				VectorizeIfMissing,
+				"",
 			},
 			expectedVerb:     "create",
 			expectedResource: "batch/objects",
@@ -262,7 +305,7 @@ func Test_BatchKinds_Authorization(t *testing.T) {
 			authorizer := &authDenier{}
 			vectorRepo := &fakeVectorRepo{}
 			modulesProvider := getFakeModulesProvider()
-			manager := NewBatchManager(vectorRepo, modulesProvider, locks, schemaManager, cfg, logger, authorizer, nil)
+			manager := NewBatchManager(vectorRepo, modulesProvider, locks, schemaManager, cfg, logger, authorizer, nil, nil)
 
 			args := append([]interface{}{context.Background(), principal}, test.additionalArgs...)
 			out, _ := callFuncByName(manager, test.methodName, args...)
@@ -15,6 +15,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
+	"strings"
 	"sync"
 	"time"
 
@@ -80,7 +82,7 @@ func (m *autoSchemaManager) performAutoSchema(ctx context.Context, principal *mo
 	if schemaClass == nil {
 		return m.createClass(ctx, principal, object.Class, properties)
 	}
-	return m.updateClass(ctx, principal, object.Class, properties, schemaClass.Properties)
+	return m.updateClass(ctx, principal, schemaClass, properties)
 }
 
 func (m *autoSchemaManager) getClass(principal *models.Principal,
@@ -110,12 +112,13 @@ func (m *autoSchemaManager) createClass(ctx context.Context, principal *models.P
 }
 
 func (m *autoSchemaManager) updateClass(ctx context.Context, principal *models.Principal,
-	className string, properties []*models.Property, existingProperties []*models.Property,
+	class *models.Class, properties []*models.Property,
 ) error {
+	className := class.Class
 	propertiesToAdd := []*models.Property{}
 	for _, prop := range properties {
 		found := false
-		for _, classProp := range existingProperties {
+		for _, classProp := range class.Properties {
 			if classProp.Name == schema.LowercaseFirstLetter(prop.Name) {
 				found = true
 				break
@@ -125,6 +128,15 @@ func (m *autoSchemaManager) updateClass(ctx context.Context, principal *models.P
 			propertiesToAdd = append(propertiesToAdd, prop)
 		}
 	}
+	if class.StrictProperties && len(propertiesToAdd) > 0 {
+		names := make([]string, len(propertiesToAdd))
+		for i, prop := range propertiesToAdd {
+			names[i] = prop.Name
+		}
+		sort.Strings(names)
+		return fmt.Errorf("class %q has strictProperties enabled, unknown properties: %s",
+			className, strings.Join(names, ", "))
+	}
 	for _, newProp := range propertiesToAdd {
 		m.logger.
 			WithField("auto_schema", "updateClass").
@@ -475,6 +475,67 @@ func Test_autoSchemaManager_autoSchema_update(t *testing.T) {
 	assert.Equal(t, "int[]", getProperty((schemaAfter.Objects.Classes)[0].Properties, "numberArray").DataType[0])
 }
 
+func Test_autoSchemaManager_autoSchema_strictProperties(t *testing.T) {
+	// given
+	logger, _ := test.NewNullLogger()
+	schemaManager := &fakeSchemaManager{
+		GetSchemaResponse: schema.Schema{
+			Objects: &models.Schema{
+				Classes: []*models.Class{
+					{
+						Class:            "Publication",
+						StrictProperties: true,
+						Properties: []*models.Property{
+							{
+								Name:     "age",
+								DataType: []string{"int"},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	autoSchemaManager := &autoSchemaManager{
+		schemaManager: schemaManager,
+		vectorRepo:    &fakeVectorRepo{},
+		config: config.AutoSchema{
+			Enabled:       true,
+			DefaultString: schema.DataTypeText.String(),
+			DefaultNumber: "int",
+			DefaultDate:   "date",
+		},
+		logger: logger,
+	}
+
+	t.Run("known properties only", func(t *testing.T) {
+		obj := &models.Object{
+			Class:      "Publication",
+			Properties: map[string]interface{}{"age": json.Number("30")},
+		}
+		err := autoSchemaManager.autoSchema(context.Background(), &models.Principal{}, obj)
+		require.Nil(t, err)
+	})
+
+	t.Run("unknown properties are rejected", func(t *testing.T) {
+		obj := &models.Object{
+			Class: "Publication",
+			Properties: map[string]interface{}{
+				"age":  json.Number("30"),
+				"nmae": "Jodie Sparrow",
+				"city": "Amsterdam",
+			},
+		}
+		err := autoSchemaManager.autoSchema(context.Background(), &models.Principal{}, obj)
+		require.NotNil(t, err)
+		assert.Equal(t, `class "Publication" has strictProperties enabled, unknown properties: city, nmae`,
+			err.Error())
+
+		properties := schemaManager.GetSchemaResponse.Objects.Classes[0].Properties
+		assert.Len(t, properties, 1)
+	})
+}
+
 func getProperty(properties []*models.Property, name string) *models.Property {
 	for _, prop := range properties {
 		if prop.Name == name {
@@ -13,8 +13,10 @@ package objects
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"runtime"
+	"strconv"
 	"time"
 
 	"github.com/go-openapi/strfmt"
@@ -22,19 +24,32 @@ import (
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/errorcompounder"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/admission"
+	"github.com/weaviate/weaviate/usecases/config"
 	"github.com/weaviate/weaviate/usecases/objects/validation"
 	"golang.org/x/sync/errgroup"
 )
 
-// AddObjects Class Instances in batch to the connected DB
+// AddObjects Class Instances in batch to the connected DB. The policy
+// controls which objects are vectorized, the empty policy is
+// VectorizeIfMissing. If upsertBy names a unique key, the objects must not
+// have an id and get one derived from the value of the key, see upsertUUID.
 func (b *BatchManager) AddObjects(ctx context.Context, principal *models.Principal,
 	objects []*models.Object, fields []*string, repl *additional.ReplicationProperties,
+	policy VectorizePolicy, upsertBy string,
 ) (BatchObjects, error) {
 	err := b.authorizer.Authorize(principal, "create", "batch/objects")
 	if err != nil {
 		return nil, err
 	}
 
+	release, err := b.admission.Admit(ctx, admission.BatchWrite)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
 	unlock, err := b.locks.LockConnector()
 	if err != nil {
 		return nil, NewErrInternal("could not acquire lock: %v", err)
@@ -46,18 +61,24 @@ func (b *BatchManager) AddObjects(ctx context.Context, principal *models.Princip
 	defer b.metrics.BatchOp("total_uc_level", before.UnixNano())
 	defer b.metrics.BatchDec()
 
-	return b.addObjects(ctx, principal, objects, fields, repl)
+	return b.addObjects(ctx, principal, objects, fields, repl, policy, upsertBy)
 }
 
 func (b *BatchManager) addObjects(ctx context.Context, principal *models.Principal,
 	classes []*models.Object, fields []*string, repl *additional.ReplicationProperties,
+	policy VectorizePolicy, upsertBy string,
 ) (BatchObjects, error) {
 	beforePreProcessing := time.Now()
 	if err := b.validateObjectForm(classes); err != nil {
 		return nil, NewErrInvalidUserInput("invalid param 'objects': %v", err)
 	}
+	if err := policy.validate(); err != nil {
+		return nil, NewErrInvalidUserInput("invalid param 'vectorizePolicy': %v", err)
+	}
 
-	batchObjects := b.validateObjectsConcurrently(ctx, principal, classes, fields, repl)
+	batchObjects := b.validateObjectsConcurrently(ctx, principal, classes, fields, repl,
+		policy, upsertBy)
+	b.validateVectorDimensions(ctx, principal, batchObjects)
 	b.metrics.BatchOp("total_preprocessing", beforePreProcessing.UnixNano())
 
 	var (
@@ -68,6 +89,9 @@ func (b *BatchManager) addObjects(ctx context.Context, principal *models.Princip
 	beforePersistence := time.Now()
 	defer b.metrics.BatchOp("total_persistence_level", beforePersistence.UnixNano())
 	if res, err = b.vectorRepo.BatchPutObjects(ctx, batchObjects, repl); err != nil {
+		if errors.As(err, &ErrReadOnly{}) || errors.As(err, &ErrQuotaExceeded{}) {
+			return nil, err
+		}
 		return nil, NewErrInternal("batch objects: %#v", err)
 	}
 
@@ -84,6 +108,7 @@ func (b *BatchManager) validateObjectForm(classes []*models.Object) error {
 
 func (b *BatchManager) validateObjectsConcurrently(ctx context.Context, principal *models.Principal,
 	objects []*models.Object, fields []*string, repl *additional.ReplicationProperties,
+	policy VectorizePolicy, upsertBy string,
 ) BatchObjects {
 	fieldsToKeep := determineResponseFields(fields)
 	c := make(chan BatchObject, len(objects))
@@ -102,7 +127,7 @@ func (b *BatchManager) validateObjectsConcurrently(ctx context.Context, principa
 		i := i
 		object := object
 		eg.Go(func() error {
-			b.validateObject(ctx, principal, object, i, &c, fieldsToKeep, repl)
+			b.validateObject(ctx, principal, object, i, &c, fieldsToKeep, repl, policy, upsertBy)
 			return nil
 		})
 	}
@@ -115,6 +140,7 @@ func (b *BatchManager) validateObjectsConcurrently(ctx context.Context, principa
 func (b *BatchManager) validateObject(ctx context.Context, principal *models.Principal,
 	concept *models.Object, originalIndex int, resultsC *chan BatchObject,
 	fieldsToKeep map[string]struct{}, repl *additional.ReplicationProperties,
+	policy VectorizePolicy, upsertBy string,
 ) {
 	var id strfmt.UUID
 
@@ -124,7 +150,12 @@ func (b *BatchManager) validateObject(ctx context.Context, principal *models.Pri
 	err := b.autoSchemaManager.autoSchema(ctx, principal, concept)
 	ec.Add(err)
 
-	if concept.ID == "" {
+	if upsertBy != "" {
+		// the id is derived from the validated value of the unique key below
+		if concept.ID != "" {
+			ec.Add(fmt.Errorf("upsert by '%s': objects must not have an id", upsertBy))
+		}
+	} else if concept.ID == "" {
 		// Generate UUID for the new object
 		uid, err := generateUUID()
 		id = uid
@@ -140,6 +171,7 @@ func (b *BatchManager) validateObject(ctx context.Context, principal *models.Pri
 	object.LastUpdateTimeUnix = 0
 	object.ID = id
 	object.Vector = concept.Vector
+	object.Vectors = concept.Vectors
 	object.Tenant = concept.Tenant
 
 	if _, ok := fieldsToKeep["class"]; ok {
@@ -168,9 +200,15 @@ func (b *BatchManager) validateObject(ctx context.Context, principal *models.Pri
 			Object(ctx, class, object, nil)
 		ec.Add(err)
 
+		if err == nil && upsertBy != "" {
+			id, err = upsertUUID(class, object, upsertBy)
+			object.ID = id
+			ec.Add(err)
+		}
+
 		if err == nil {
 			// update vector only if we passed validation
-			err = b.modulesProvider.UpdateVector(ctx, object, class, nil, b.findObject, b.logger)
+			err = b.vectorizeObject(ctx, object, class, repl, policy)
 			ec.Add(err)
 		}
 	}
@@ -184,6 +222,124 @@ func (b *BatchManager) validateObject(ctx context.Context, principal *models.Pri
 	}
 }
 
+// vectorizeObject sets the vectors of object according to the policy
+func (b *BatchManager) vectorizeObject(ctx context.Context, object *models.Object,
+	class *models.Class, repl *additional.ReplicationProperties, policy VectorizePolicy,
+) error {
+	switch policy {
+	case VectorizeNever:
+		return b.keepStoredVectors(ctx, object, class, repl)
+	case VectorizeAlways:
+		dropVectorizedVectors(object, class)
+	}
+	return b.modulesProvider.UpdateVector(ctx, object, class, nil, b.findObject, b.logger)
+}
+
+// dropVectorizedVectors removes the vectors from object which a vectorizer
+// module generates again. Vectors of vector spaces without a vectorizer can
+// only be sent by the user, so they are kept.
+func dropVectorizedVectors(object *models.Object, class *models.Class) {
+	if class.Vectorizer != config.VectorizerModuleNone {
+		object.Vector = nil
+	}
+	if len(object.Vectors) == 0 {
+		return
+	}
+
+	kept := models.Vectors{}
+	for name, vector := range object.Vectors {
+		vectorizer, _, err := schema.TargetVectorizer(class.VectorConfig[name])
+		if err != nil || vectorizer == schema.VectorizerNone {
+			kept[name] = vector
+		}
+	}
+	object.Vectors = kept
+}
+
+// keepStoredVectors sets the vectors which are missing on object to the ones
+// stored for the object, if it exists already. Otherwise the object is put
+// without them and so would lose them.
+func (b *BatchManager) keepStoredVectors(ctx context.Context, object *models.Object,
+	class *models.Class, repl *additional.ReplicationProperties,
+) error {
+	targetVectors := schema.TargetVectorNames(class)
+	missing := len(object.Vector) == 0 && len(targetVectors) == 0
+	for _, name := range targetVectors {
+		if _, ok := object.Vectors[name]; !ok {
+			missing = true
+		}
+	}
+	if !missing {
+		return nil
+	}
+
+	stored, err := b.vectorRepo.Object(ctx, object.Class, object.ID, nil,
+		additional.Properties{}, repl, object.Tenant)
+	if err != nil {
+		return fmt.Errorf("get stored vectors: %w", err)
+	}
+	if stored == nil {
+		return nil
+	}
+
+	if len(object.Vector) == 0 {
+		object.Vector = stored.Vector
+	}
+	for _, name := range targetVectors {
+		if _, ok := object.Vectors[name]; ok {
+			continue
+		}
+		if vec, ok := stored.Vectors[name]; ok {
+			if object.Vectors == nil {
+				object.Vectors = models.Vectors{}
+			}
+			object.Vectors[name] = vec
+		}
+	}
+	return nil
+}
+
+// upsertUUID derives the id of object from its class and the value of the
+// unique key upsertBy. Objects with the same value therefore share an id and
+// importing them again updates the existing object. The value must already
+// be validated, so that equal values of a data type are formatted alike,
+// e.g. the numbers 1 and 1.0.
+func upsertUUID(class *models.Class, object *models.Object, upsertBy string) (strfmt.UUID, error) {
+	prop, err := schema.GetPropertyByName(class, schema.LowercaseFirstLetter(upsertBy))
+	if err != nil {
+		return "", fmt.Errorf("upsert by '%s': %w", upsertBy, err)
+	}
+	if !prop.UniqueKey {
+		return "", fmt.Errorf("upsert by '%s': property is not a unique key of class '%s'",
+			prop.Name, class.Class)
+	}
+
+	props, _ := object.Properties.(map[string]interface{})
+	var value string
+	switch v := props[prop.Name].(type) {
+	case nil:
+		return "", fmt.Errorf("upsert by '%s': property is not set", prop.Name)
+	case string:
+		value = v
+	case bool:
+		value = strconv.FormatBool(v)
+	case int64:
+		value = strconv.FormatInt(v, 10)
+	case float64:
+		value = strconv.FormatFloat(v, 'g', -1, 64)
+	case time.Time:
+		value = v.UTC().Format(time.RFC3339Nano)
+	case uuid.UUID:
+		value = v.String()
+	default:
+		return "", fmt.Errorf("upsert by '%s': unsupported value of type %T",
+			prop.Name, v)
+	}
+
+	name := class.Class + "/" + prop.Name + "/" + value
+	return strfmt.UUID(uuid.NewSHA1(uuid.NameSpaceOID, []byte(name)).String()), nil
+}
+
 func objectsChanToSlice(c chan BatchObject) BatchObjects {
 	result := make([]BatchObject, len(c))
 	for object := range c {
@@ -13,20 +13,157 @@ package objects
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
 	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/admission"
 	"github.com/weaviate/weaviate/usecases/config"
 )
 
+func Test_BatchManager_AddObjects_UpsertBy(t *testing.T) {
+	var (
+		vectorRepo *fakeVectorRepo
+		manager    *BatchManager
+	)
+
+	schema := schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{
+				{
+					Vectorizer:        config.VectorizerModuleNone,
+					Class:             "Foo",
+					VectorIndexConfig: hnsw.UserConfig{},
+					Properties: []*models.Property{
+						{
+							Name:         "name",
+							DataType:     schema.DataTypeText.PropString(),
+							Tokenization: models.PropertyTokenizationWhitespace,
+							UniqueKey:    true,
+						},
+						{
+							Name:      "rank",
+							DataType:  schema.DataTypeNumber.PropString(),
+							UniqueKey: true,
+						},
+						{
+							Name:         "nickname",
+							DataType:     schema.DataTypeText.PropString(),
+							Tokenization: models.PropertyTokenizationWhitespace,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	reset := func() {
+		vectorRepo = &fakeVectorRepo{}
+		vectorRepo.On("BatchPutObjects", mock.Anything).Return(nil).Once()
+		schemaManager := &fakeSchemaManager{
+			GetSchemaResponse: schema,
+		}
+		logger, _ := test.NewNullLogger()
+		modulesProvider := getFakeModulesProvider()
+		modulesProvider.On("UpdateVector", mock.Anything, mock.AnythingOfType(FindObjectFn)).
+			Return(nil, nil)
+		manager = NewBatchManager(vectorRepo, modulesProvider, &fakeLocks{},
+			schemaManager, &config.WeaviateConfig{}, logger, &fakeAuthorizer{}, nil, nil)
+	}
+
+	addObjects := func(t *testing.T, upsertBy string, objects []*models.Object) BatchObjects {
+		_, err := manager.AddObjects(context.Background(), nil, objects, []*string{}, nil, "", upsertBy)
+		require.Nil(t, err)
+		repoCalledWithObjects := vectorRepo.Calls[0].Arguments[0].(BatchObjects)
+		require.Len(t, repoCalledWithObjects, len(objects))
+		return repoCalledWithObjects
+	}
+
+	t.Run("objects with the same value get the same id", func(t *testing.T) {
+		reset()
+		first := addObjects(t, "name", []*models.Object{
+			{Class: "Foo", Properties: map[string]interface{}{"name": "alice"}},
+			{Class: "Foo", Properties: map[string]interface{}{"name": "bob"}},
+		})
+		reset()
+		second := addObjects(t, "name", []*models.Object{
+			{Class: "Foo", Properties: map[string]interface{}{"name": "alice"}},
+		})
+
+		require.Nil(t, first[0].Err)
+		require.Nil(t, first[1].Err)
+		require.Nil(t, second[0].Err)
+		assert.Equal(t, first[0].UUID, second[0].UUID)
+		assert.NotEqual(t, first[0].UUID, first[1].UUID)
+		assert.Equal(t, first[0].UUID, first[0].Object.ID)
+	})
+
+	t.Run("equal numbers get the same id", func(t *testing.T) {
+		reset()
+		res := addObjects(t, "rank", []*models.Object{
+			{Class: "Foo", Properties: map[string]interface{}{"rank": json.Number("1")}},
+			{Class: "Foo", Properties: map[string]interface{}{"rank": json.Number("1.0")}},
+			{Class: "Foo", Properties: map[string]interface{}{"rank": float64(1)}},
+		})
+
+		for _, obj := range res {
+			require.Nil(t, obj.Err)
+			assert.Equal(t, res[0].UUID, obj.UUID)
+		}
+	})
+
+	t.Run("a user-specified id is rejected", func(t *testing.T) {
+		reset()
+		id := strfmt.UUID("cf918366-3d3b-4b90-9bc6-bc5ea8762ff6")
+		res := addObjects(t, "name", []*models.Object{
+			{ID: id, Class: "Foo", Properties: map[string]interface{}{"name": "alice"}},
+		})
+
+		require.NotNil(t, res[0].Err)
+		assert.Contains(t, res[0].Err.Error(), "objects must not have an id")
+	})
+
+	t.Run("a property which is not a unique key is rejected", func(t *testing.T) {
+		reset()
+		res := addObjects(t, "nickname", []*models.Object{
+			{Class: "Foo", Properties: map[string]interface{}{"nickname": "al"}},
+		})
+
+		require.NotNil(t, res[0].Err)
+		assert.EqualError(t, res[0].Err,
+			"upsert by 'nickname': property is not a unique key of class 'Foo'")
+	})
+
+	t.Run("objects without a usable value fail", func(t *testing.T) {
+		reset()
+		res := addObjects(t, "name", []*models.Object{
+			{Class: "Foo"},
+			{Class: "Foo", Properties: map[string]interface{}{"nickname": "al"}},
+			{Class: "Foo", Properties: map[string]interface{}{
+				"name": []interface{}{"a"},
+			}},
+		})
+
+		for _, obj := range res {
+			require.NotNil(t, obj.Err)
+			assert.Empty(t, obj.UUID)
+		}
+		assert.EqualError(t, res[0].Err, "upsert by 'name': property is not set")
+	})
+}
+
 func Test_BatchManager_AddObjects_WithNoVectorizerModule(t *testing.T) {
 	var (
 		vectorRepo      *fakeVectorRepo
@@ -71,7 +208,7 @@ func Test_BatchManager_AddObjects_WithNoVectorizerModule(t *testing.T) {
 		authorizer := &fakeAuthorizer{}
 		modulesProvider = getFakeModulesProvider()
 		manager = NewBatchManager(vectorRepo, modulesProvider, locks,
-			schemaManager, config, logger, authorizer, nil)
+			schemaManager, config, logger, authorizer, nil, nil)
 	}
 
 	reset := func() {
@@ -84,7 +221,7 @@ func Test_BatchManager_AddObjects_WithNoVectorizerModule(t *testing.T) {
 		expectedErr := NewErrInvalidUserInput("invalid param 'objects': cannot be empty, need at least" +
 			" one object for batching")
 
-		_, err := manager.AddObjects(ctx, nil, []*models.Object{}, []*string{}, nil)
+		_, err := manager.AddObjects(ctx, nil, []*models.Object{}, []*string{}, nil, "", "")
 
 		assert.Equal(t, expectedErr, err)
 	})
@@ -108,7 +245,7 @@ func Test_BatchManager_AddObjects_WithNoVectorizerModule(t *testing.T) {
 				Return(nil, nil)
 		}
 
-		_, err := manager.AddObjects(ctx, nil, objects, []*string{}, nil)
+		_, err := manager.AddObjects(ctx, nil, objects, []*string{}, nil, "", "")
 		repoCalledWithObjects := vectorRepo.Calls[0].Arguments[0].(BatchObjects)
 
 		assert.Nil(t, err)
@@ -144,7 +281,7 @@ func Test_BatchManager_AddObjects_WithNoVectorizerModule(t *testing.T) {
 				Return(nil, nil)
 		}
 
-		_, err := manager.AddObjects(ctx, nil, objects, []*string{}, nil)
+		_, err := manager.AddObjects(ctx, nil, objects, []*string{}, nil, "", "")
 		repoCalledWithObjects := vectorRepo.Calls[0].Arguments[0].(BatchObjects)
 
 		assert.Nil(t, err)
@@ -184,7 +321,7 @@ func Test_BatchManager_AddObjects_WithNoVectorizerModule(t *testing.T) {
 				Return(nil, nil)
 		}
 
-		_, err := manager.AddObjects(ctx, nil, objects, []*string{}, nil)
+		_, err := manager.AddObjects(ctx, nil, objects, []*string{}, nil, "", "")
 		repoCalledWithObjects := vectorRepo.Calls[0].Arguments[0].(BatchObjects)
 
 		assert.Nil(t, err)
@@ -222,7 +359,7 @@ func Test_BatchManager_AddObjects_WithNoVectorizerModule(t *testing.T) {
 				Return(nil, nil)
 		}
 
-		_, err := manager.AddObjects(ctx, nil, objects, []*string{}, nil)
+		_, err := manager.AddObjects(ctx, nil, objects, []*string{}, nil, "", "")
 		repoCalledWithObjects := vectorRepo.Calls[0].Arguments[0].(BatchObjects)
 
 		assert.Nil(t, err)
@@ -255,7 +392,7 @@ func Test_BatchManager_AddObjects_WithNoVectorizerModule(t *testing.T) {
 				Return(nil, nil)
 		}
 
-		_, err := manager.AddObjects(ctx, nil, objects, []*string{}, nil)
+		_, err := manager.AddObjects(ctx, nil, objects, []*string{}, nil, "", "")
 		repoCalledWithObjects := vectorRepo.Calls[0].Arguments[0].(BatchObjects)
 
 		assert.Nil(t, err)
@@ -263,6 +400,23 @@ func Test_BatchManager_AddObjects_WithNoVectorizerModule(t *testing.T) {
 		assert.Nil(t, repoCalledWithObjects[0].Err)
 		assert.Nil(t, repoCalledWithObjects[1].Err)
 	})
+
+	t.Run("with policy always", func(t *testing.T) {
+		reset()
+		vectorRepo.On("BatchPutObjects", mock.Anything).Return(nil).Once()
+		vector := []float32{0.1, 0.1, 0.1111}
+		objects := []*models.Object{{Class: "Foo", Vector: vector}}
+		modulesProvider.On("UpdateVector", mock.Anything, mock.AnythingOfType(FindObjectFn)).
+			Return(nil, nil)
+
+		_, err := manager.AddObjects(ctx, nil, objects, []*string{}, nil, VectorizeAlways, "")
+		repoCalledWithObjects := vectorRepo.Calls[0].Arguments[0].(BatchObjects)
+
+		assert.Nil(t, err)
+		require.Len(t, repoCalledWithObjects, 1)
+		assert.Equal(t, vector, repoCalledWithObjects[0].Vector,
+			"the vector was kept as there is no vectorizer to replace it")
+	})
 }
 
 func Test_BatchManager_AddObjects_WithExternalVectorizerModule(t *testing.T) {
@@ -280,6 +434,21 @@ func Test_BatchManager_AddObjects_WithExternalVectorizerModule(t *testing.T) {
 					VectorIndexConfig: hnsw.UserConfig{},
 					Class:             "Foo",
 				},
+				{
+					Class: "FooNamed",
+					VectorConfig: map[string]models.VectorConfig{
+						"byUser": {
+							Vectorizer:        map[string]interface{}{"none": map[string]interface{}{}},
+							VectorIndexConfig: hnsw.UserConfig{},
+						},
+						"byModule": {
+							Vectorizer: map[string]interface{}{
+								config.VectorizerModuleText2VecContextionary: map[string]interface{}{},
+							},
+							VectorIndexConfig: hnsw.UserConfig{},
+						},
+					},
+				},
 			},
 		},
 	}
@@ -295,7 +464,7 @@ func Test_BatchManager_AddObjects_WithExternalVectorizerModule(t *testing.T) {
 		authorizer := &fakeAuthorizer{}
 		modulesProvider = getFakeModulesProvider()
 		manager = NewBatchManager(vectorRepo, modulesProvider, locks,
-			schemaManager, config, logger, authorizer, nil)
+			schemaManager, config, logger, authorizer, nil, nil)
 	}
 
 	ctx := context.Background()
@@ -305,7 +474,7 @@ func Test_BatchManager_AddObjects_WithExternalVectorizerModule(t *testing.T) {
 		expectedErr := NewErrInvalidUserInput("invalid param 'objects': cannot be empty, need at least" +
 			" one object for batching")
 
-		_, err := manager.AddObjects(ctx, nil, []*models.Object{}, []*string{}, nil)
+		_, err := manager.AddObjects(ctx, nil, []*models.Object{}, []*string{}, nil, "", "")
 
 		assert.Equal(t, expectedErr, err)
 	})
@@ -328,7 +497,7 @@ func Test_BatchManager_AddObjects_WithExternalVectorizerModule(t *testing.T) {
 				Return(expectedVector, nil)
 		}
 
-		_, err := manager.AddObjects(ctx, nil, objects, []*string{}, nil)
+		_, err := manager.AddObjects(ctx, nil, objects, []*string{}, nil, "", "")
 		repoCalledWithObjects := vectorRepo.Calls[0].Arguments[0].(BatchObjects)
 
 		assert.Nil(t, err)
@@ -364,7 +533,7 @@ func Test_BatchManager_AddObjects_WithExternalVectorizerModule(t *testing.T) {
 				Return(nil, nil)
 		}
 
-		_, err := manager.AddObjects(ctx, nil, objects, []*string{}, nil)
+		_, err := manager.AddObjects(ctx, nil, objects, []*string{}, nil, "", "")
 		repoCalledWithObjects := vectorRepo.Calls[0].Arguments[0].(BatchObjects)
 
 		assert.Nil(t, err)
@@ -394,7 +563,7 @@ func Test_BatchManager_AddObjects_WithExternalVectorizerModule(t *testing.T) {
 				Return(nil, nil)
 		}
 
-		_, err := manager.AddObjects(ctx, nil, objects, []*string{}, nil)
+		_, err := manager.AddObjects(ctx, nil, objects, []*string{}, nil, "", "")
 		repoCalledWithObjects := vectorRepo.Calls[0].Arguments[0].(BatchObjects)
 
 		assert.Nil(t, err)
@@ -402,6 +571,117 @@ func Test_BatchManager_AddObjects_WithExternalVectorizerModule(t *testing.T) {
 		assert.Equal(t, repoCalledWithObjects[0].Err.Error(), fmt.Sprintf("invalid UUID length: %d", len(id1)))
 		assert.Equal(t, id2, repoCalledWithObjects[1].UUID, "the user-specified uuid was used")
 	})
+
+	t.Run("with an invalid vectorize policy", func(t *testing.T) {
+		reset()
+		objects := []*models.Object{{Class: "Foo"}}
+
+		_, err := manager.AddObjects(ctx, nil, objects, []*string{}, nil, "sometimes", "")
+
+		var invalid ErrInvalidUserInput
+		assert.True(t, errors.As(err, &invalid))
+	})
+
+	t.Run("with policy always and a user-specified vector", func(t *testing.T) {
+		reset()
+		vectorRepo.On("BatchPutObjects", mock.Anything).Return(nil).Once()
+		expectedVector := []float32{0, 1, 2}
+		objects := []*models.Object{
+			{
+				Class:  "Foo",
+				Vector: []float32{7, 8, 9},
+			},
+		}
+		modulesProvider.On("UpdateVector", mock.Anything, mock.AnythingOfType(FindObjectFn)).
+			Return(expectedVector, nil).Once()
+
+		_, err := manager.AddObjects(ctx, nil, objects, []*string{}, nil, VectorizeAlways, "")
+		repoCalledWithObjects := vectorRepo.Calls[0].Arguments[0].(BatchObjects)
+
+		assert.Nil(t, err)
+		require.Len(t, repoCalledWithObjects, 1)
+		assert.Equal(t, expectedVector, repoCalledWithObjects[0].Vector,
+			"the object was re-vectorized")
+	})
+
+	t.Run("with policy always and user-specified named vectors", func(t *testing.T) {
+		reset()
+		vectorRepo.On("BatchPutObjects", mock.Anything).Return(nil).Once()
+		objects := []*models.Object{
+			{
+				Class: "FooNamed",
+				Vectors: models.Vectors{
+					"byUser":   []float32{1, 2, 3},
+					"byModule": []float32{4, 5, 6},
+				},
+			},
+		}
+		modulesProvider.On("UpdateVector", mock.Anything, mock.AnythingOfType(FindObjectFn)).
+			Return(nil, nil).Once()
+
+		_, err := manager.AddObjects(ctx, nil, objects, []*string{}, nil, VectorizeAlways, "")
+		repoCalledWithObjects := vectorRepo.Calls[0].Arguments[0].(BatchObjects)
+
+		assert.Nil(t, err)
+		require.Len(t, repoCalledWithObjects, 1)
+		assert.Equal(t, models.Vectors{"byUser": []float32{1, 2, 3}},
+			repoCalledWithObjects[0].Object.Vectors,
+			"only the vector without a vectorizer was kept")
+	})
+
+	t.Run("with policy never", func(t *testing.T) {
+		reset()
+		vectorRepo.On("BatchPutObjects", mock.Anything).Return(nil).Once()
+		id1 := strfmt.UUID("2d3942c3-b412-4d80-9dfa-99a646629cd2")
+		id2 := strfmt.UUID("cf918366-3d3b-4b90-9bc6-bc5ea8762ff6")
+		storedVector := []float32{4, 5, 6}
+		vectorRepo.On("Object", "Foo", id1, mock.Anything, mock.Anything, "").
+			Return(&search.Result{ID: id1, Vector: storedVector}, nil).Once()
+		vectorRepo.On("Object", "Foo", id2, mock.Anything, mock.Anything, "").
+			Return(nil, nil).Once()
+		objects := []*models.Object{
+			{
+				ID:    id1,
+				Class: "Foo",
+			},
+			{
+				ID:    id2,
+				Class: "Foo",
+			},
+		}
+
+		_, err := manager.AddObjects(ctx, nil, objects, []*string{}, nil, VectorizeNever, "")
+		repoCalledWithObjects := vectorRepo.Calls[len(vectorRepo.Calls)-1].Arguments[0].(BatchObjects)
+
+		assert.Nil(t, err)
+		require.Len(t, repoCalledWithObjects, 2)
+		assert.Equal(t, storedVector, repoCalledWithObjects[0].Vector,
+			"the stored vector was kept")
+		assert.Nil(t, repoCalledWithObjects[1].Vector, "a new object is not vectorized")
+		modulesProvider.AssertNotCalled(t, "UpdateVector", mock.Anything, mock.Anything)
+	})
+
+	t.Run("with user-specified named vectors", func(t *testing.T) {
+		reset()
+		vectorRepo.On("BatchPutObjects", mock.Anything).Return(nil).Once()
+		vectors := models.Vectors{"custom": []float32{1, 2, 3}}
+		objects := []*models.Object{
+			{
+				Class:   "Foo",
+				Vectors: vectors,
+			},
+		}
+		modulesProvider.On("UpdateVector", mock.Anything, mock.AnythingOfType(FindObjectFn)).
+			Return(nil, nil).Once()
+
+		_, err := manager.AddObjects(ctx, nil, objects, []*string{}, nil, VectorizeIfMissing, "")
+		repoCalledWithObjects := vectorRepo.Calls[0].Arguments[0].(BatchObjects)
+
+		assert.Nil(t, err)
+		require.Len(t, repoCalledWithObjects, 1)
+		assert.Equal(t, vectors, repoCalledWithObjects[0].Object.Vectors,
+			"the named vectors were kept")
+	})
 }
 
 func Test_BatchManager_AddObjectsEmptyProperties(t *testing.T) {
@@ -440,7 +720,7 @@ func Test_BatchManager_AddObjectsEmptyProperties(t *testing.T) {
 		authorizer := &fakeAuthorizer{}
 		modulesProvider = getFakeModulesProvider()
 		manager = NewBatchManager(vectorRepo, modulesProvider, locks,
-			schemaManager, config, logger, authorizer, nil)
+			schemaManager, config, logger, authorizer, nil, nil)
 	}
 	reset()
 	objects := []*models.Object{
@@ -464,9 +744,30 @@ func Test_BatchManager_AddObjectsEmptyProperties(t *testing.T) {
 		modulesProvider.On("UpdateVector", mock.Anything, mock.AnythingOfType(FindObjectFn)).
 			Return(nil, nil)
 	}
-	addedObjects, err := manager.AddObjects(ctx, nil, objects, []*string{}, nil)
+	addedObjects, err := manager.AddObjects(ctx, nil, objects, []*string{}, nil, "", "")
 	assert.Nil(t, err)
 	require.Len(t, addedObjects, 2)
 	require.NotNil(t, addedObjects[0].Object.Properties)
 	require.NotNil(t, addedObjects[1].Object.Properties)
 }
+
+func Test_BatchManager_AddObjects_Admission(t *testing.T) {
+	controller := admission.New(admission.Config{
+		MaxConcurrent: 1,
+		QueueTimeout:  2 * time.Second,
+	})
+	logger, _ := test.NewNullLogger()
+	manager := NewBatchManager(&fakeVectorRepo{}, getFakeModulesProvider(), &fakeLocks{},
+		&fakeSchemaManager{}, &config.WeaviateConfig{}, logger, &fakeAuthorizer{}, nil, controller)
+
+	// occupy the only slot
+	release, err := controller.Admit(context.Background(), admission.VectorSearch)
+	require.Nil(t, err)
+	defer release()
+
+	_, err = manager.AddObjects(context.Background(), nil,
+		[]*models.Object{{Class: "Foo"}}, []*string{}, nil, "", "")
+	var overloaded enterrors.ErrOverloaded
+	require.True(t, errors.As(err, &overloaded))
+	assert.Equal(t, 2*time.Second, overloaded.RetryAfter())
+}
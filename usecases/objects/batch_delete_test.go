@@ -65,7 +65,7 @@ func Test_BatchDelete_RequestValidation(t *testing.T) {
 		authorizer := &fakeAuthorizer{}
 		modulesProvider := getFakeModulesProvider()
 		manager = NewBatchManager(vectorRepo, modulesProvider, locks,
-			schemaManager, config, logger, authorizer, nil)
+			schemaManager, config, logger, authorizer, nil, nil)
 	}
 
 	reset := func() {
@@ -16,6 +16,7 @@ import (
 
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/usecases/admission"
 	"github.com/weaviate/weaviate/usecases/config"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 )
@@ -32,6 +33,7 @@ type BatchManager struct {
 	modulesProvider   ModulesProvider
 	autoSchemaManager *autoSchemaManager
 	metrics           *Metrics
+	admission         *admission.Controller
 }
 
 type BatchVectorRepo interface {
@@ -48,11 +50,12 @@ type batchRepoNew interface {
 		repl *additional.ReplicationProperties) (BatchReferences, error)
 }
 
-// NewBatchManager creates a new manager
+// NewBatchManager creates a new manager. The admission controller limits the
+// concurrent batch writes, nil disables it.
 func NewBatchManager(vectorRepo BatchVectorRepo, modulesProvider ModulesProvider,
 	locks locks, schemaManager schemaManager, config *config.WeaviateConfig,
 	logger logrus.FieldLogger, authorizer authorizer,
-	prom *monitoring.PrometheusMetrics,
+	prom *monitoring.PrometheusMetrics, admission *admission.Controller,
 ) *BatchManager {
 	return &BatchManager{
 		config:            config,
@@ -64,5 +67,6 @@ func NewBatchManager(vectorRepo BatchVectorRepo, modulesProvider ModulesProvider
 		authorizer:        authorizer,
 		autoSchemaManager: newAutoSchemaManager(schemaManager, vectorRepo, config, logger),
 		metrics:           NewMetrics(prom),
+		admission:         admission,
 	}
 }
@@ -13,6 +13,7 @@ package objects
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 	"sync"
@@ -22,6 +23,7 @@ import (
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/schema/crossref"
+	"github.com/weaviate/weaviate/usecases/objects/validation"
 )
 
 // AddReferences Class Instances in batch to the connected DB
@@ -58,7 +60,14 @@ func (b *BatchManager) addReferences(ctx context.Context, principal *models.Prin
 		return nil, err
 	}
 
+	if err := b.validateReferenceCardinality(ctx, principal, batchReferences, repl); err != nil {
+		return nil, err
+	}
+
 	if res, err := b.vectorRepo.AddBatchReferences(ctx, batchReferences, repl); err != nil {
+		if errors.As(err, &ErrReadOnly{}) || errors.As(err, &ErrQuotaExceeded{}) {
+			return nil, err
+		}
 		return nil, NewErrInternal("could not add batch request to connector: %v", err)
 	} else {
 		return res, nil
@@ -132,6 +141,62 @@ func (b *BatchManager) autodetectToClass(ctx context.Context,
 	return nil
 }
 
+// validateReferenceCardinality flags every reference which would exceed the
+// cardinality of its source property. References to the same source object
+// are accepted in the order of the batch until the limit is reached.
+func (b *BatchManager) validateReferenceCardinality(ctx context.Context,
+	principal *models.Principal, batchReferences BatchReferences,
+	repl *additional.ReplicationProperties,
+) error {
+	scheme, err := b.schemaManager.GetSchema(principal)
+	if err != nil {
+		return NewErrInvalidUserInput("get schema: %v", err)
+	}
+
+	counts := make(map[string]int)
+	for i, ref := range batchReferences {
+		if ref.Err != nil {
+			continue
+		}
+		class := scheme.FindClassByName(ref.From.Class)
+		if class == nil {
+			continue
+		}
+		prop, err := schema.GetPropertyByName(class, schema.LowercaseFirstLetter(string(ref.From.Property)))
+		if err != nil {
+			continue
+		}
+		max := schema.MaxReferences(prop)
+		if max == 0 {
+			continue
+		}
+
+		key := strings.Join([]string{class.Class, ref.From.TargetID.String(), prop.Name, ref.Tenant}, "/")
+		count, ok := counts[key]
+		if !ok {
+			res, err := b.vectorRepo.Object(ctx, class.Class, ref.From.TargetID, nil,
+				additional.Properties{}, repl, ref.Tenant)
+			if err != nil {
+				batchReferences[i].Err = fmt.Errorf("source object: %w", err)
+				continue
+			}
+			if res != nil {
+				props, _ := res.Object().Properties.(map[string]interface{})
+				count = validation.CountReferences(props[prop.Name])
+			}
+		}
+
+		if count >= max {
+			batchReferences[i].Err = fmt.Errorf("property '%s' of %s/%s allows at most %d reference(s)",
+				prop.Name, class.Class, ref.From.TargetID, max)
+			counts[key] = count
+			continue
+		}
+		counts[key] = count + 1
+	}
+	return nil
+}
+
 func (b *BatchManager) validateReference(ctx context.Context, principal *models.Principal,
 	wg *sync.WaitGroup, ref *models.BatchReference, i int, resultsC *chan BatchReference,
 ) {
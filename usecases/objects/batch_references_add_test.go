@@ -0,0 +1,122 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+func Test_BatchManager_AddReferences_Cardinality(t *testing.T) {
+	var (
+		vectorRepo *fakeVectorRepo
+		manager    *BatchManager
+		maxCount   = int64(2)
+		zooID      = strfmt.UUID("d18c8e5e-0000-0000-0000-56b0cfe33ce7")
+		otherZooID = strfmt.UUID("d18c8e5e-0000-0000-0000-56b0cfe33ce8")
+		animalRef  = "weaviate://localhost/Animal/d18c8e5e-a339-4c15-8af6-56b0cfe33ce7"
+	)
+
+	reset := func() {
+		sch := zooAnimalSchemaForTest()
+		for _, prop := range sch.FindClassByName("Zoo").Properties {
+			if prop.Name == "hasAnimals" {
+				prop.MaxCount = &maxCount
+			}
+		}
+
+		vectorRepo = &fakeVectorRepo{}
+		schemaManager := &fakeSchemaManager{GetSchemaResponse: sch}
+		logger, _ := test.NewNullLogger()
+		manager = NewBatchManager(vectorRepo, getFakeModulesProvider(), &fakeLocks{},
+			schemaManager, &config.WeaviateConfig{}, logger, &fakeAuthorizer{}, nil, nil)
+	}
+
+	zooRef := func(id strfmt.UUID) *models.BatchReference {
+		return &models.BatchReference{
+			From: strfmt.URI("weaviate://localhost/Zoo/" + id + "/hasAnimals"),
+			To:   strfmt.URI(animalRef),
+		}
+	}
+
+	zoo := func(id strfmt.UUID, refs int) *search.Result {
+		props := map[string]interface{}{}
+		if refs > 0 {
+			multiRef := make(models.MultipleRef, refs)
+			for i := range multiRef {
+				multiRef[i] = &models.SingleRef{Beacon: strfmt.URI(animalRef)}
+			}
+			props["hasAnimals"] = multiRef
+		}
+		return &search.Result{ID: id, ClassName: "Zoo", Schema: props}
+	}
+
+	ctx := context.Background()
+
+	t.Run("references within the limit", func(t *testing.T) {
+		reset()
+		vectorRepo.On("Object", "Zoo", zooID, mock.Anything, additional.Properties{}, "").
+			Return(zoo(zooID, 0), nil).Once()
+		vectorRepo.On("AddBatchReferences", mock.Anything).Return(nil)
+
+		res, err := manager.AddReferences(ctx, nil, []*models.BatchReference{zooRef(zooID), zooRef(zooID)}, nil)
+		require.Nil(t, err)
+		require.Len(t, res, 2)
+		assert.Nil(t, res[0].Err)
+		assert.Nil(t, res[1].Err)
+		vectorRepo.AssertExpectations(t)
+	})
+
+	t.Run("references exceeding the limit in the batch", func(t *testing.T) {
+		reset()
+		vectorRepo.On("Object", "Zoo", zooID, mock.Anything, additional.Properties{}, "").
+			Return(zoo(zooID, 1), nil).Once()
+		vectorRepo.On("Object", "Zoo", otherZooID, mock.Anything, additional.Properties{}, "").
+			Return(zoo(otherZooID, 0), nil).Once()
+		vectorRepo.On("AddBatchReferences", mock.Anything).Return(nil)
+
+		refs := []*models.BatchReference{zooRef(zooID), zooRef(otherZooID), zooRef(zooID)}
+		res, err := manager.AddReferences(ctx, nil, refs, nil)
+		require.Nil(t, err)
+		require.Len(t, res, 3)
+		assert.Nil(t, res[0].Err)
+		assert.Nil(t, res[1].Err)
+		require.NotNil(t, res[2].Err)
+		assert.Contains(t, res[2].Err.Error(), "allows at most 2 reference(s)")
+		vectorRepo.AssertExpectations(t)
+	})
+
+	t.Run("unlimited property is not checked", func(t *testing.T) {
+		reset()
+		for _, prop := range manager.schemaManager.(*fakeSchemaManager).GetSchemaResponse.
+			FindClassByName(schema.ClassName("Zoo")).Properties {
+			prop.MaxCount = nil
+		}
+		vectorRepo.On("AddBatchReferences", mock.Anything).Return(nil)
+
+		res, err := manager.AddReferences(ctx, nil, []*models.BatchReference{zooRef(zooID)}, nil)
+		require.Nil(t, err)
+		assert.Nil(t, res[0].Err)
+		vectorRepo.AssertNotCalled(t, "Object", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
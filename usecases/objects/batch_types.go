@@ -12,6 +12,8 @@
 package objects
 
 import (
+	"fmt"
+
 	"github.com/go-openapi/strfmt"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
@@ -19,6 +21,33 @@ import (
 	"github.com/weaviate/weaviate/entities/schema/crossref"
 )
 
+// VectorizePolicy controls which objects of a batch are vectorized by the
+// vectorizer modules of their class
+type VectorizePolicy string
+
+const (
+	// VectorizeIfMissing vectorizes the objects which are sent without a
+	// vector, this is the default
+	VectorizeIfMissing VectorizePolicy = "ifMissing"
+	// VectorizeAlways vectorizes all objects, vectors sent with them are
+	// ignored unless their vector space has no vectorizer
+	VectorizeAlways VectorizePolicy = "always"
+	// VectorizeNever vectorizes no object. Objects which are sent without a
+	// vector keep the vector they have stored if they already exist, so
+	// re-imports don't need to send the vectors again.
+	VectorizeNever VectorizePolicy = "never"
+)
+
+func (p VectorizePolicy) validate() error {
+	switch p {
+	case "", VectorizeIfMissing, VectorizeAlways, VectorizeNever:
+		return nil
+	default:
+		return fmt.Errorf("unknown vectorize policy %q, must be one of %q, %q or %q",
+			p, VectorizeIfMissing, VectorizeAlways, VectorizeNever)
+	}
+}
+
 // BatchObject is a helper type that groups all the info about one object in a
 // batch that belongs together, i.e. uuid, object body and error state.
 //
@@ -0,0 +1,134 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// PutObjectBlob streams body into the blob property of an existing object.
+// The blob is stored out-of-band, so it never passes through the JSON
+// representation of the object.
+func (m *Manager) PutObjectBlob(ctx context.Context, principal *models.Principal,
+	className string, id strfmt.UUID, propName string, body io.Reader,
+	repl *additional.ReplicationProperties, tenant string,
+) *Error {
+	path := fmt.Sprintf("objects/%s/%s", className, id)
+	if err := m.authorizer.Authorize(principal, "update", path); err != nil {
+		return &Error{path, StatusForbidden, err}
+	}
+
+	unlock, err := m.locks.LockSchema()
+	if err != nil {
+		return &Error{"cannot lock", StatusInternalServerError, err}
+	}
+	defer unlock()
+
+	if err := m.validateBlobProperty(ctx, principal, className, propName); err != nil {
+		return err
+	}
+
+	exists, err := m.vectorRepo.Exists(ctx, className, id, repl, tenant)
+	if err != nil {
+		if errors.As(err, &ErrMultiTenancy{}) {
+			return &Error{"source object", StatusUnprocessableEntity, err}
+		}
+		return &Error{"source object", StatusInternalServerError, err}
+	}
+	if !exists {
+		return &Error{"source object", StatusNotFound, fmt.Errorf("object %s/%s not found", className, id)}
+	}
+
+	err = m.vectorRepo.PutObjectBlob(ctx, className, id, propName, body,
+		m.timeSource.Now(), tenant)
+	if err != nil {
+		if errors.As(err, &ErrMultiTenancy{}) {
+			return &Error{"put blob", StatusUnprocessableEntity, err}
+		}
+		if errors.As(err, &ErrReadOnly{}) {
+			return &Error{"put blob", StatusLocked, err}
+		}
+		if errors.As(err, &ErrQuotaExceeded{}) {
+			return &Error{"put blob", StatusUnprocessableEntity, err}
+		}
+		return &Error{"put blob", StatusInternalServerError, err}
+	}
+
+	return nil
+}
+
+// GetObjectBlob returns a reader streaming the blob property of an object
+// and the size of the blob. The caller needs to close the reader.
+func (m *Manager) GetObjectBlob(ctx context.Context, principal *models.Principal,
+	className string, id strfmt.UUID, propName string,
+	repl *additional.ReplicationProperties, tenant string,
+) (io.ReadCloser, int64, *Error) {
+	path := fmt.Sprintf("objects/%s/%s", className, id)
+	if err := m.authorizer.Authorize(principal, "get", path); err != nil {
+		return nil, 0, &Error{path, StatusForbidden, err}
+	}
+
+	unlock, err := m.locks.LockConnector()
+	if err != nil {
+		return nil, 0, &Error{"cannot lock", StatusInternalServerError, err}
+	}
+	defer unlock()
+
+	if err := m.validateBlobProperty(ctx, principal, className, propName); err != nil {
+		return nil, 0, err
+	}
+
+	r, size, err := m.vectorRepo.ObjectBlob(ctx, className, id, propName, repl, tenant)
+	if err != nil {
+		if errors.As(err, &ErrMultiTenancy{}) {
+			return nil, 0, &Error{"get blob", StatusUnprocessableEntity, err}
+		}
+		return nil, 0, &Error{"get blob", StatusInternalServerError, err}
+	}
+	if r == nil {
+		return nil, 0, &Error{"get blob", StatusNotFound,
+			fmt.Errorf("no blob for property %q of object %s/%s", propName, className, id)}
+	}
+
+	return r, size, nil
+}
+
+func (m *Manager) validateBlobProperty(ctx context.Context, principal *models.Principal,
+	className, propName string,
+) *Error {
+	class, err := m.schemaManager.GetClass(ctx, principal, className)
+	if err != nil {
+		return &Error{"cannot get class", StatusInternalServerError, err}
+	}
+	if class == nil {
+		return &Error{"class not found " + className, StatusNotFound, nil}
+	}
+
+	prop, err := schema.GetPropertyByName(class, propName)
+	if err != nil {
+		return &Error{"property not found " + propName, StatusNotFound, err}
+	}
+	if len(prop.DataType) != 1 || prop.DataType[0] != string(schema.DataTypeBlob) {
+		return &Error{"bad inputs", StatusUnprocessableEntity,
+			fmt.Errorf("property %q is of type %v, not blob", propName, prop.DataType)}
+	}
+
+	return nil
+}
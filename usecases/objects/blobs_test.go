@@ -0,0 +1,105 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+func Test_ObjectBlobs(t *testing.T) {
+	t.Parallel()
+	var (
+		cls = "Photo"
+		id  = strfmt.UUID("d18c8e5e-a339-4c15-8af6-56b0cfe33ce7")
+		sch = schema.Schema{
+			Objects: &models.Schema{
+				Classes: []*models.Class{{
+					Class: cls,
+					Properties: []*models.Property{
+						{Name: "image", DataType: schema.DataTypeBlob.PropString()},
+						{Name: "title", DataType: schema.DataTypeText.PropString()},
+					},
+				}},
+			},
+		}
+	)
+
+	t.Run("put blob", func(t *testing.T) {
+		m := newFakeGetManager(sch)
+		m.repo.On("Exists", cls, id).Return(true, nil).Once()
+		m.repo.On("PutObjectBlob", cls, id, "image").Return(nil).Once()
+
+		err := m.PutObjectBlob(context.Background(), nil, cls, id, "image",
+			strings.NewReader("some blob"), nil, "")
+		require.Nil(t, err)
+		m.repo.AssertExpectations(t)
+	})
+
+	t.Run("put blob into missing object", func(t *testing.T) {
+		m := newFakeGetManager(sch)
+		m.repo.On("Exists", cls, id).Return(false, nil).Once()
+
+		err := m.PutObjectBlob(context.Background(), nil, cls, id, "image",
+			strings.NewReader("some blob"), nil, "")
+		require.NotNil(t, err)
+		assert.Equal(t, StatusNotFound, err.Code)
+		m.repo.AssertNotCalled(t, "PutObjectBlob")
+	})
+
+	t.Run("put blob into non-blob property", func(t *testing.T) {
+		m := newFakeGetManager(sch)
+
+		err := m.PutObjectBlob(context.Background(), nil, cls, id, "title",
+			strings.NewReader("some blob"), nil, "")
+		require.NotNil(t, err)
+		assert.Equal(t, StatusUnprocessableEntity, err.Code)
+	})
+
+	t.Run("get blob", func(t *testing.T) {
+		m := newFakeGetManager(sch)
+		m.repo.On("ObjectBlob", cls, id, "image").
+			Return(io.NopCloser(strings.NewReader("some blob")), int64(9), nil).Once()
+
+		r, size, err := m.GetObjectBlob(context.Background(), nil, cls, id, "image", nil, "")
+		require.Nil(t, err)
+		defer r.Close()
+		blob, _ := io.ReadAll(r)
+		assert.Equal(t, "some blob", string(blob))
+		assert.Equal(t, int64(9), size)
+	})
+
+	t.Run("get missing blob", func(t *testing.T) {
+		m := newFakeGetManager(sch)
+		m.repo.On("ObjectBlob", cls, id, "image").Return(nil, int64(0), nil).Once()
+
+		_, _, err := m.GetObjectBlob(context.Background(), nil, cls, id, "image", nil, "")
+		require.NotNil(t, err)
+		assert.Equal(t, StatusNotFound, err.Code)
+	})
+
+	t.Run("get blob of unknown property", func(t *testing.T) {
+		m := newFakeGetManager(sch)
+
+		_, _, err := m.GetObjectBlob(context.Background(), nil, cls, id, "unknown", nil, "")
+		require.NotNil(t, err)
+		assert.Equal(t, StatusNotFound, err.Code)
+	})
+}
@@ -13,6 +13,7 @@ package objects
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/go-openapi/strfmt"
@@ -24,9 +25,13 @@ import (
 //
 // if class == "" it will delete all object with same id regardless of the class name.
 // This is due to backward compatibility reasons and should be removed in the future
+//
+// If precondition is set, the object is only deleted if it has one of the
+// expected versions.
 func (m *Manager) DeleteObject(ctx context.Context,
 	principal *models.Principal, class string, id strfmt.UUID,
 	repl *additional.ReplicationProperties, tenant string,
+	precondition *VersionPrecondition,
 ) error {
 	path := fmt.Sprintf("objects/%s/%s", class, id)
 	if class == "" {
@@ -47,24 +52,51 @@ func (m *Manager) DeleteObject(ctx context.Context,
 	defer m.metrics.DeleteObjectDec()
 
 	if class == "" { // deprecated
+		if precondition != nil {
+			return NewErrInvalidUserInput("conditional deletes require the class name")
+		}
 		return m.deleteObjectFromRepo(ctx, id)
 	}
 
-	ok, err := m.vectorRepo.Exists(ctx, class, id, repl, tenant)
-	if err != nil {
-		switch err.(type) {
-		case ErrMultiTenancy:
-			return NewErrMultiTenancy(fmt.Errorf("check object existence: %w", err))
-		default:
-			return NewErrInternal("check object existence: %v", err)
+	if precondition != nil {
+		// the version is only known after reading the whole object
+		obj, err := m.getObjectFromRepo(ctx, class, id, additional.Properties{}, repl, tenant)
+		if err != nil {
+			return err
+		}
+		if err := checkPrecondition(obj, precondition); err != nil {
+			return err
+		}
+		ctx = WithPrecondition(ctx, id, precondition)
+	} else {
+		ok, err := m.vectorRepo.Exists(ctx, class, id, repl, tenant)
+		if err != nil {
+			switch err.(type) {
+			case ErrMultiTenancy:
+				return NewErrMultiTenancy(fmt.Errorf("check object existence: %w", err))
+			default:
+				return NewErrInternal("check object existence: %v", err)
+			}
+		}
+		if !ok {
+			return NewErrNotFound("object %v could not be found", path)
 		}
-	}
-	if !ok {
-		return NewErrNotFound("object %v could not be found", path)
 	}
 
 	err = m.vectorRepo.DeleteObject(ctx, class, id, repl, tenant)
 	if err != nil {
+		var errInput ErrInvalidUserInput
+		if errors.As(err, &errInput) {
+			// rejected by the onDelete policy of a referring object
+			return NewErrInvalidUserInput("could not delete object: %v", err)
+		}
+		if errors.As(err, &ErrReadOnly{}) {
+			return err
+		}
+		var errPrecondition ErrPreconditionFailed
+		if errors.As(err, &errPrecondition) {
+			return errPrecondition
+		}
 		return NewErrInternal("could not delete object from vector repo: %v", err)
 	}
 	return nil
@@ -37,7 +37,7 @@ func Test_DeleteObjectsWithSameId(t *testing.T) {
 	vectorRepo.On("ObjectByID", mock.Anything, mock.Anything, mock.Anything).Return(nil, nil).Once()
 	vectorRepo.On("DeleteObject", cls, id).Return(nil).Once()
 
-	err := manager.DeleteObject(context.Background(), nil, "", id, nil, "")
+	err := manager.DeleteObject(context.Background(), nil, "", id, nil, "", nil)
 	assert.Nil(t, err)
 	vectorRepo.AssertExpectations(t)
 }
@@ -53,13 +53,13 @@ func Test_DeleteObject(t *testing.T) {
 	repo.On("DeleteObject", cls, id).Return(nil).Once()
 	repo.On("Exists", cls, id).Return(true, nil).Once()
 
-	err := manager.DeleteObject(context.Background(), nil, cls, id, nil, "")
+	err := manager.DeleteObject(context.Background(), nil, cls, id, nil, "", nil)
 	assert.Nil(t, err)
 	repo.AssertExpectations(t)
 
 	// delete non existing object
 	repo.On("Exists", cls, id).Return(false, nil).Once()
-	err = manager.DeleteObject(context.Background(), nil, cls, id, nil, "")
+	err = manager.DeleteObject(context.Background(), nil, cls, id, nil, "", nil)
 	if _, ok := err.(ErrNotFound); !ok {
 		t.Errorf("error type got: %T want: ErrNotFound", err)
 	}
@@ -67,7 +67,7 @@ func Test_DeleteObject(t *testing.T) {
 
 	// return internal error if exists() fails
 	repo.On("Exists", cls, id).Return(false, errNotFound).Once()
-	err = manager.DeleteObject(context.Background(), nil, cls, id, nil, "")
+	err = manager.DeleteObject(context.Background(), nil, cls, id, nil, "", nil)
 	if _, ok := err.(ErrInternal); !ok {
 		t.Errorf("error type got: %T want: ErrInternal", err)
 	}
@@ -76,7 +76,7 @@ func Test_DeleteObject(t *testing.T) {
 	// return internal error if deleteObject() fails
 	repo.On("DeleteObject", cls, id).Return(errNotFound).Once()
 	repo.On("Exists", cls, id).Return(true, nil).Once()
-	err = manager.DeleteObject(context.Background(), nil, cls, id, nil, "")
+	err = manager.DeleteObject(context.Background(), nil, cls, id, nil, "", nil)
 	if _, ok := err.(ErrInternal); !ok {
 		t.Errorf("error type got: %T want: ErrInternal", err)
 	}
@@ -21,6 +21,8 @@ const (
 	StatusBadRequest          = 400
 	StatusNotFound            = 404
 	StatusUnprocessableEntity = 422
+	StatusPreconditionFailed  = 412
+	StatusLocked              = 423
 	StatusInternalServerError = 500
 )
 
@@ -56,6 +58,14 @@ func (e *Error) UnprocessableEntity() bool {
 	return e.Code == StatusUnprocessableEntity
 }
 
+func (e *Error) Locked() bool {
+	return e.Code == StatusLocked
+}
+
+func (e *Error) PreconditionFailed() bool {
+	return e.Code == StatusPreconditionFailed
+}
+
 // ErrInvalidUserInput indicates a client-side error
 type ErrInvalidUserInput struct {
 	msg string
@@ -110,3 +120,47 @@ func (e ErrMultiTenancy) Error() string {
 func NewErrMultiTenancy(err error) ErrMultiTenancy {
 	return ErrMultiTenancy{err}
 }
+
+// ErrReadOnly indicates that the class or the node rejects writes
+type ErrReadOnly struct {
+	msg string
+}
+
+func (e ErrReadOnly) Error() string {
+	return e.msg
+}
+
+// NewErrReadOnly with Errorf signature
+func NewErrReadOnly(format string, args ...interface{}) ErrReadOnly {
+	return ErrReadOnly{msg: fmt.Sprintf(format, args...)}
+}
+
+// ErrQuotaExceeded indicates that a write would exceed a storage quota of the
+// class or tenant
+type ErrQuotaExceeded struct {
+	msg string
+}
+
+func (e ErrQuotaExceeded) Error() string {
+	return e.msg
+}
+
+// NewErrQuotaExceeded with Errorf signature
+func NewErrQuotaExceeded(format string, args ...interface{}) ErrQuotaExceeded {
+	return ErrQuotaExceeded{msg: fmt.Sprintf(format, args...)}
+}
+
+// ErrPreconditionFailed indicates that the object does not have the version a
+// conditional write expects
+type ErrPreconditionFailed struct {
+	msg string
+}
+
+func (e ErrPreconditionFailed) Error() string {
+	return e.msg
+}
+
+// NewErrPreconditionFailed with Errorf signature
+func NewErrPreconditionFailed(format string, args ...interface{}) ErrPreconditionFailed {
+	return ErrPreconditionFailed{msg: fmt.Sprintf(format, args...)}
+}
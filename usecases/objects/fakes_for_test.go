@@ -14,6 +14,7 @@ package objects
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/http"
 
 	"github.com/go-openapi/strfmt"
@@ -119,6 +120,32 @@ func (f *fakeSchemaManager) AddClassProperty(ctx context.Context, principal *mod
 	return nil
 }
 
+func (f *fakeSchemaManager) LockVectorDimensions(ctx context.Context, className,
+	targetVector string, dimensions int64,
+) (int64, error) {
+	for _, c := range f.GetSchemaResponse.Objects.Classes {
+		if c.Class != className {
+			continue
+		}
+		if targetVector == "" {
+			if c.VectorDimensions == 0 {
+				c.VectorDimensions = dimensions
+			}
+			return c.VectorDimensions, nil
+		}
+		cfg, ok := c.VectorConfig[targetVector]
+		if !ok {
+			return 0, fmt.Errorf("class %q has no target vector %q", className, targetVector)
+		}
+		if cfg.Dimensions == 0 {
+			cfg.Dimensions = dimensions
+			c.VectorConfig[targetVector] = cfg
+		}
+		return cfg.Dimensions, nil
+	}
+	return 0, fmt.Errorf("class %q not found", className)
+}
+
 type fakeLocks struct {
 	Err error
 }
@@ -216,6 +243,23 @@ func (f *fakeVectorRepo) Merge(ctx context.Context, merge MergeDocument, repl *a
 	return args.Error(0)
 }
 
+func (f *fakeVectorRepo) PutObjectBlob(ctx context.Context, class string, id strfmt.UUID,
+	propName string, r io.Reader, updateTime int64, tenant string,
+) error {
+	args := f.Called(class, id, propName)
+	return args.Error(0)
+}
+
+func (f *fakeVectorRepo) ObjectBlob(ctx context.Context, class string, id strfmt.UUID,
+	propName string, repl *additional.ReplicationProperties, tenant string,
+) (io.ReadCloser, int64, error) {
+	args := f.Called(class, id, propName)
+	if args.Get(0) != nil {
+		return args.Get(0).(io.ReadCloser), args.Get(1).(int64), args.Error(2)
+	}
+	return nil, 0, args.Error(2)
+}
+
 func (f *fakeVectorRepo) DeleteObject(ctx context.Context, className string,
 	id strfmt.UUID, repl *additional.ReplicationProperties, tenant string,
 ) error {
@@ -297,6 +341,7 @@ type fakeModulesProvider struct {
 	mock.Mock
 	customExtender  *fakeExtender
 	customProjector *fakeProjector
+	objectDiff      *moduletools.ObjectDiff
 }
 
 func (p *fakeModulesProvider) GetObjectAdditionalExtend(ctx context.Context,
@@ -323,6 +368,7 @@ func (p *fakeModulesProvider) UsingRef2Vec(moduleName string) bool {
 func (p *fakeModulesProvider) UpdateVector(ctx context.Context, object *models.Object, class *models.Class,
 	objectDiff *moduletools.ObjectDiff, findObjFn modulecapabilities.FindObjectFn, logger logrus.FieldLogger,
 ) error {
+	p.objectDiff = objectDiff
 	args := p.Called(object, findObjFn)
 	switch vec := args.Get(0).(type) {
 	case models.C11yVector:
@@ -718,7 +764,7 @@ func getFakeModulesProviderWithCustomExtenders(
 	customProjector *fakeProjector,
 	opts ...func(provider *fakeModulesProvider),
 ) *fakeModulesProvider {
-	p := &fakeModulesProvider{mock.Mock{}, customExtender, customProjector}
+	p := &fakeModulesProvider{mock.Mock{}, customExtender, customProjector, nil}
 	p.applyOptions(opts...)
 	return p
 }
@@ -16,6 +16,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/weaviate/weaviate/entities/additional"
@@ -25,23 +26,24 @@ import (
 	"github.com/weaviate/weaviate/entities/search"
 )
 
-// GetObject Class from the connected DB
+// GetObject Class from the connected DB. Next to the object it returns its
+// current version, see VersionPrecondition
 func (m *Manager) GetObject(ctx context.Context, principal *models.Principal,
 	class string, id strfmt.UUID, additional additional.Properties,
 	replProps *additional.ReplicationProperties, tenant string,
-) (*models.Object, error) {
+) (*models.Object, int64, error) {
 	path := fmt.Sprintf("objects/%s", id)
 	if class != "" {
 		path = fmt.Sprintf("objects/%s/%s", class, id)
 	}
 	err := m.authorizer.Authorize(principal, "get", path)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
 	unlock, err := m.locks.LockConnector()
 	if err != nil {
-		return nil, NewErrInternal("could not acquire lock: %v", err)
+		return nil, 0, NewErrInternal("could not acquire lock: %v", err)
 	}
 	defer unlock()
 
@@ -50,14 +52,14 @@ func (m *Manager) GetObject(ctx context.Context, principal *models.Principal,
 
 	res, err := m.getObjectFromRepo(ctx, class, id, additional, replProps, tenant)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
 	if additional.Vector {
 		m.trackUsageSingle(res)
 	}
 
-	return res.ObjectWithVector(additional.Vector), nil
+	return res.ObjectWithVector(additional.Vector), res.Updated, nil
 }
 
 // GetObjects Class from the connected DB
@@ -258,13 +260,35 @@ func (m *Manager) trackUsageList(res search.Results) {
 	m.metrics.AddUsageDimensions(res[0].ClassName, "get_rest", "list_include_vector", res[0].Dims)
 }
 
-func (m *Manager) getCursor(after *string, limit *int64) *filters.Cursor {
-	if after != nil {
+func (m *Manager) getCursor(after *string, limit *int64, scroll *filters.Scroll) *filters.Cursor {
+	if after != nil || scroll != nil {
+		// the first page of a scroll session has no after parameter
+		cursor := &filters.Cursor{Scroll: scroll}
+		if after != nil {
+			cursor.After = *after
+		}
 		if limit == nil {
 			// limit -1 means that no limit param was set
-			return &filters.Cursor{After: *after, Limit: -1}
+			cursor.Limit = -1
+		} else {
+			cursor.Limit = int(*limit)
 		}
-		return &filters.Cursor{After: *after, Limit: int(*limit)}
+		return cursor
 	}
 	return nil
 }
+
+func (m *Manager) getScroll(scroll, scrollID *string) (*filters.Scroll, error) {
+	if scrollID == nil {
+		return nil, nil
+	}
+
+	ttl := filters.DefaultScrollTTL
+	if scroll != nil {
+		var err error
+		if ttl, err = time.ParseDuration(*scroll); err != nil {
+			return nil, fmt.Errorf("invalid scroll duration %q: %w", *scroll, err)
+		}
+	}
+	return &filters.Scroll{ID: *scrollID, TTL: ttl}, nil
+}
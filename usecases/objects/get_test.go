@@ -73,7 +73,7 @@ func Test_GetAction(t *testing.T) {
 
 		vectorRepo.On("ObjectByID", id, mock.Anything, mock.Anything).Return((*search.Result)(nil), nil).Once()
 
-		_, err := manager.GetObject(context.Background(), &models.Principal{}, "",
+		_, _, err := manager.GetObject(context.Background(), &models.Principal{}, "",
 			id, additional.Properties{}, nil, "")
 		assert.Equal(t, NewErrNotFound("no object with id '99ee9968-22ec-416a-9032-cff80f2f7fdf'"), err)
 	})
@@ -96,7 +96,7 @@ func Test_GetAction(t *testing.T) {
 			VectorWeights: (map[string]string)(nil),
 		}
 
-		res, err := manager.GetObject(context.Background(), &models.Principal{}, "",
+		res, _, err := manager.GetObject(context.Background(), &models.Principal{}, "",
 			id, additional.Properties{}, nil, "")
 		require.Nil(t, err)
 		assert.Equal(t, expected, res)
@@ -125,7 +125,7 @@ func Test_GetAction(t *testing.T) {
 
 		metrics.On("AddUsageDimensions", "ActionClass", "get_rest", "single_include_vector", 3)
 
-		res, err := manager.GetObject(context.Background(), &models.Principal{}, "",
+		res, _, err := manager.GetObject(context.Background(), &models.Principal{}, "",
 			id, additional.Properties{Vector: true}, nil, "")
 		require.Nil(t, err)
 		assert.Equal(t, expected, res)
@@ -155,7 +155,7 @@ func Test_GetAction(t *testing.T) {
 
 		metrics.On("AddUsageDimensions", "ActionClass", "get_rest", "single_include_vector", 3)
 
-		res, err := manager.GetObject(context.Background(), &models.Principal{},
+		res, _, err := manager.GetObject(context.Background(), &models.Principal{},
 			"ActionClass", id, additional.Properties{Vector: true}, nil, "")
 		require.Nil(t, err)
 		assert.Equal(t, expected, res)
@@ -286,7 +286,7 @@ func Test_GetAction(t *testing.T) {
 					Schema:    map[string]interface{}{"foo": "bar"},
 				}
 				vectorRepo.On("ObjectByID", id, mock.Anything, mock.Anything).Return(result, nil).Once()
-				_, err := manager.GetObject(context.Background(), &models.Principal{}, "",
+				_, _, err := manager.GetObject(context.Background(), &models.Principal{}, "",
 					id, additional.Properties{
 						ModuleParams: map[string]interface{}{
 							"featureProjection": getDefaultParam("featureProjection"),
@@ -305,7 +305,7 @@ func Test_GetAction(t *testing.T) {
 					Schema:    map[string]interface{}{"foo": "bar"},
 				}
 				vectorRepo.On("ObjectByID", id, mock.Anything, mock.Anything).Return(result, nil).Once()
-				_, err := manager.GetObject(context.Background(), &models.Principal{}, "",
+				_, _, err := manager.GetObject(context.Background(), &models.Principal{}, "",
 					id, additional.Properties{
 						ModuleParams: map[string]interface{}{
 							"semanticPath": getDefaultParam("semanticPath"),
@@ -359,7 +359,7 @@ func Test_GetAction(t *testing.T) {
 					},
 				}
 
-				res, err := manager.GetObject(context.Background(), &models.Principal{}, "",
+				res, _, err := manager.GetObject(context.Background(), &models.Principal{}, "",
 					id, additional.Properties{
 						ModuleParams: map[string]interface{}{
 							"nearestNeighbors": true,
@@ -702,7 +702,7 @@ func Test_GetThing(t *testing.T) {
 
 		vectorRepo.On("ObjectByID", id, mock.Anything, mock.Anything).Return((*search.Result)(nil), nil).Once()
 
-		_, err := manager.GetObject(context.Background(), &models.Principal{}, "", id,
+		_, _, err := manager.GetObject(context.Background(), &models.Principal{}, "", id,
 			additional.Properties{}, nil, "")
 		assert.Equal(t, NewErrNotFound("no object with id '99ee9968-22ec-416a-9032-cff80f2f7fdf'"), err)
 	})
@@ -725,7 +725,7 @@ func Test_GetThing(t *testing.T) {
 			VectorWeights: (map[string]string)(nil),
 		}
 
-		res, err := manager.GetObject(context.Background(), &models.Principal{}, "", id,
+		res, _, err := manager.GetObject(context.Background(), &models.Principal{}, "", id,
 			additional.Properties{}, nil, "")
 		require.Nil(t, err)
 		assert.Equal(t, expected, res)
@@ -771,7 +771,7 @@ func Test_GetThing(t *testing.T) {
 					Schema:    map[string]interface{}{"foo": "bar"},
 				}
 				vectorRepo.On("ObjectByID", id, mock.Anything, mock.Anything).Return(result, nil).Once()
-				_, err := manager.GetObject(context.Background(), &models.Principal{}, "",
+				_, _, err := manager.GetObject(context.Background(), &models.Principal{}, "",
 					id, additional.Properties{
 						ModuleParams: map[string]interface{}{
 							"featureProjection": getDefaultParam("featureProjection"),
@@ -825,7 +825,7 @@ func Test_GetThing(t *testing.T) {
 					},
 				}
 
-				res, err := manager.GetObject(context.Background(), &models.Principal{}, "",
+				res, _, err := manager.GetObject(context.Background(), &models.Principal{}, "",
 					id, additional.Properties{
 						ModuleParams: map[string]interface{}{
 							"nearestNeighbors": true,
@@ -973,7 +973,7 @@ func Test_GetObject(t *testing.T) {
 	t.Run("without projection", func(t *testing.T) {
 		m := newFakeGetManager(schema)
 		m.repo.On("Object", className, id, mock.Anything, mock.Anything, "").Return((*search.Result)(nil), nil).Once()
-		_, err := m.GetObject(context.Background(), &principal, className, id, adds, nil, "")
+		_, _, err := m.GetObject(context.Background(), &principal, className, id, adds, nil, "")
 		if err == nil {
 			t.Errorf("GetObject() must return an error for non existing object")
 		}
@@ -986,7 +986,7 @@ func Test_GetObject(t *testing.T) {
 			VectorWeights: (map[string]string)(nil),
 		}
 
-		got, err := m.GetObject(context.Background(), &principal, className, id, adds, nil, "")
+		got, _, err := m.GetObject(context.Background(), &principal, className, id, adds, nil, "")
 		require.Nil(t, err)
 		assert.Equal(t, expected, got)
 	})
@@ -1011,7 +1011,7 @@ func Test_GetObject(t *testing.T) {
 			},
 		}
 		m.repo.On("Object", className, id, mock.Anything, mock.Anything, "").Return(result, nil).Once()
-		_, err := m.GetObject(context.Background(), &principal, className, id,
+		_, _, err := m.GetObject(context.Background(), &principal, className, id,
 			additional.Properties{
 				ModuleParams: map[string]interface{}{
 					"Unknown": getDefaultParam("Unknown"),
@@ -1039,7 +1039,7 @@ func Test_GetObject(t *testing.T) {
 			},
 		}
 
-		res, err := m.GetObject(context.Background(), &principal, className, id,
+		res, _, err := m.GetObject(context.Background(), &principal, className, id,
 			additional.Properties{
 				ModuleParams: map[string]interface{}{
 					"nearestNeighbors": true,
@@ -0,0 +1,413 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// JSONPatch is a JSON Patch document as defined in RFC 6902. The operations
+// are applied in order and the patch fails as a whole if one of them fails.
+type JSONPatch []JSONPatchOperation
+
+// JSONPatchOperation is a single operation of a JSON Patch. Value is only
+// used by add, replace and test, From only by move and copy.
+type JSONPatchOperation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path"`
+	From  string          `json:"from,omitempty"`
+	Value json.RawMessage `json:"value,omitempty"`
+
+	value interface{}
+}
+
+// ParseJSONPatch parses and validates a JSON Patch document
+func ParseJSONPatch(data []byte) (JSONPatch, error) {
+	var patch JSONPatch
+	if err := json.Unmarshal(data, &patch); err != nil {
+		return nil, NewErrInvalidUserInput("invalid json patch: %v", err)
+	}
+
+	for i := range patch {
+		op := &patch[i]
+		if _, err := parseJSONPointer(op.Path); err != nil {
+			return nil, NewErrInvalidUserInput("operation %d: path: %v", i, err)
+		}
+
+		switch op.Op {
+		case "add", "replace", "test":
+			if op.Value == nil {
+				return nil, NewErrInvalidUserInput("operation %d: %s requires a value", i, op.Op)
+			}
+			value, err := decodeJSON(op.Value)
+			if err != nil {
+				return nil, NewErrInvalidUserInput("operation %d: value: %v", i, err)
+			}
+			op.value = value
+		case "move", "copy":
+			if _, err := parseJSONPointer(op.From); err != nil {
+				return nil, NewErrInvalidUserInput("operation %d: from: %v", i, err)
+			}
+		case "remove":
+		default:
+			return nil, NewErrInvalidUserInput("operation %d: unknown op %q", i, op.Op)
+		}
+	}
+
+	return patch, nil
+}
+
+func (p JSONPatch) apply(doc interface{}) (interface{}, error) {
+	var err error
+	for i, op := range p {
+		doc, err = op.apply(doc)
+		if err != nil {
+			return nil, NewErrInvalidUserInput("operation %d (%s %s): %v", i, op.Op, op.Path, err)
+		}
+	}
+	return doc, nil
+}
+
+func (op JSONPatchOperation) apply(doc interface{}) (interface{}, error) {
+	// pointers have been validated by ParseJSONPatch
+	path, _ := parseJSONPointer(op.Path)
+
+	switch op.Op {
+	case "add":
+		return addJSONValue(doc, path, copyJSONValue(op.value))
+	case "remove":
+		doc, _, err := removeJSONValue(doc, path)
+		return doc, err
+	case "replace":
+		if _, err := getJSONValue(doc, path); err != nil {
+			return nil, err
+		}
+		doc, _, err := removeJSONValue(doc, path)
+		if err != nil {
+			return nil, err
+		}
+		return addJSONValue(doc, path, copyJSONValue(op.value))
+	case "move":
+		from, _ := parseJSONPointer(op.From)
+		if op.Path != op.From && strings.HasPrefix(op.Path, op.From+"/") {
+			return nil, fmt.Errorf("cannot move a value into one of its children")
+		}
+		doc, value, err := removeJSONValue(doc, from)
+		if err != nil {
+			return nil, err
+		}
+		return addJSONValue(doc, path, value)
+	case "copy":
+		from, _ := parseJSONPointer(op.From)
+		value, err := getJSONValue(doc, from)
+		if err != nil {
+			return nil, err
+		}
+		return addJSONValue(doc, path, copyJSONValue(value))
+	case "test":
+		value, err := getJSONValue(doc, path)
+		if err != nil {
+			return nil, err
+		}
+		if !jsonEqual(value, op.value) {
+			return nil, fmt.Errorf("test failed: value differs")
+		}
+		return doc, nil
+	default:
+		return nil, fmt.Errorf("unknown op %q", op.Op)
+	}
+}
+
+// JSONMergePatch is a JSON Merge Patch document as defined in RFC 7396.
+// Unlike the merge of MergeObject, which replaces properties as a whole,
+// nested objects are merged recursively.
+type JSONMergePatch map[string]interface{}
+
+// ParseJSONMergePatch parses a JSON Merge Patch document, which must be a
+// JSON object
+func ParseJSONMergePatch(data []byte) (JSONMergePatch, error) {
+	value, err := decodeJSON(data)
+	if err != nil {
+		return nil, NewErrInvalidUserInput("invalid json merge patch: %v", err)
+	}
+	patch, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, NewErrInvalidUserInput("invalid json merge patch: expected an object")
+	}
+	return patch, nil
+}
+
+func (p JSONMergePatch) apply(doc interface{}) (interface{}, error) {
+	return mergeJSONValue(doc, map[string]interface{}(p)), nil
+}
+
+func mergeJSONValue(target, patch interface{}) interface{} {
+	patchMap, ok := patch.(map[string]interface{})
+	if !ok {
+		return copyJSONValue(patch)
+	}
+
+	targetMap, ok := target.(map[string]interface{})
+	if !ok {
+		targetMap = map[string]interface{}{}
+	}
+	for key, value := range patchMap {
+		if value == nil {
+			delete(targetMap, key)
+			continue
+		}
+		targetMap[key] = mergeJSONValue(targetMap[key], value)
+	}
+	return targetMap
+}
+
+// parseJSONPointer splits a JSON Pointer (RFC 6901) into its unescaped
+// reference tokens. The empty pointer references the whole document.
+func parseJSONPointer(pointer string) ([]string, error) {
+	if pointer == "" {
+		return nil, nil
+	}
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, fmt.Errorf("json pointer %q must start with /", pointer)
+	}
+
+	tokens := strings.Split(pointer[1:], "/")
+	for i, token := range tokens {
+		tokens[i] = strings.NewReplacer("~1", "/", "~0", "~").Replace(token)
+	}
+	return tokens, nil
+}
+
+func getJSONValue(doc interface{}, path []string) (interface{}, error) {
+	for _, token := range path {
+		switch node := doc.(type) {
+		case map[string]interface{}:
+			value, ok := node[token]
+			if !ok {
+				return nil, fmt.Errorf("member %q does not exist", token)
+			}
+			doc = value
+		case []interface{}:
+			idx, err := arrayIndex(token, len(node)-1)
+			if err != nil {
+				return nil, err
+			}
+			doc = node[idx]
+		default:
+			return nil, fmt.Errorf("cannot reference %q in a scalar value", token)
+		}
+	}
+	return doc, nil
+}
+
+// updateJSONValue replaces the value at path in doc with the result of fn
+// and returns the updated doc
+func updateJSONValue(doc interface{}, path []string,
+	fn func(value interface{}) (interface{}, error),
+) (interface{}, error) {
+	if len(path) == 0 {
+		return fn(doc)
+	}
+
+	switch node := doc.(type) {
+	case map[string]interface{}:
+		value, ok := node[path[0]]
+		if !ok {
+			return nil, fmt.Errorf("member %q does not exist", path[0])
+		}
+		updated, err := updateJSONValue(value, path[1:], fn)
+		if err != nil {
+			return nil, err
+		}
+		node[path[0]] = updated
+		return node, nil
+	case []interface{}:
+		idx, err := arrayIndex(path[0], len(node)-1)
+		if err != nil {
+			return nil, err
+		}
+		updated, err := updateJSONValue(node[idx], path[1:], fn)
+		if err != nil {
+			return nil, err
+		}
+		node[idx] = updated
+		return node, nil
+	default:
+		return nil, fmt.Errorf("cannot reference %q in a scalar value", path[0])
+	}
+}
+
+func addJSONValue(doc interface{}, path []string, value interface{}) (interface{}, error) {
+	if len(path) == 0 {
+		return value, nil
+	}
+
+	last := path[len(path)-1]
+	return updateJSONValue(doc, path[:len(path)-1], func(parent interface{}) (interface{}, error) {
+		switch node := parent.(type) {
+		case map[string]interface{}:
+			node[last] = value
+			return node, nil
+		case []interface{}:
+			idx := len(node)
+			if last != "-" {
+				var err error
+				if idx, err = arrayIndex(last, len(node)); err != nil {
+					return nil, err
+				}
+			}
+			node = append(node, nil)
+			copy(node[idx+1:], node[idx:])
+			node[idx] = value
+			return node, nil
+		default:
+			return nil, fmt.Errorf("cannot add %q to a scalar value", last)
+		}
+	})
+}
+
+func removeJSONValue(doc interface{}, path []string) (interface{}, interface{}, error) {
+	if len(path) == 0 {
+		return nil, nil, fmt.Errorf("cannot remove the whole document")
+	}
+
+	var removed interface{}
+	last := path[len(path)-1]
+	doc, err := updateJSONValue(doc, path[:len(path)-1], func(parent interface{}) (interface{}, error) {
+		switch node := parent.(type) {
+		case map[string]interface{}:
+			value, ok := node[last]
+			if !ok {
+				return nil, fmt.Errorf("member %q does not exist", last)
+			}
+			removed = value
+			delete(node, last)
+			return node, nil
+		case []interface{}:
+			idx, err := arrayIndex(last, len(node)-1)
+			if err != nil {
+				return nil, err
+			}
+			removed = node[idx]
+			return append(node[:idx], node[idx+1:]...), nil
+		default:
+			return nil, fmt.Errorf("cannot remove %q from a scalar value", last)
+		}
+	})
+	return doc, removed, err
+}
+
+// arrayIndex parses an array index token which must not exceed max
+func arrayIndex(token string, max int) (int, error) {
+	if token == "" || (len(token) > 1 && token[0] == '0') {
+		return 0, fmt.Errorf("invalid array index %q", token)
+	}
+	idx, err := strconv.Atoi(token)
+	if err != nil || idx < 0 {
+		return 0, fmt.Errorf("invalid array index %q", token)
+	}
+	if idx > max {
+		return 0, fmt.Errorf("array index %d out of bounds", idx)
+	}
+	return idx, nil
+}
+
+// decodeJSON decodes data into its generic representation. Numbers are kept
+// as json.Number so they are not altered by a round trip.
+func decodeJSON(data []byte) (interface{}, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var value interface{}
+	if err := dec.Decode(&value); err != nil {
+		return nil, err
+	}
+	if dec.More() {
+		return nil, fmt.Errorf("unexpected data after the json value")
+	}
+	return value, nil
+}
+
+// toJSONValue converts v into its generic JSON representation
+func toJSONValue(v interface{}) (interface{}, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return decodeJSON(data)
+}
+
+func copyJSONValue(v interface{}) interface{} {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(val))
+		for key, elem := range val {
+			out[key] = copyJSONValue(elem)
+		}
+		return out
+	case []interface{}:
+		out := make([]interface{}, len(val))
+		for i, elem := range val {
+			out[i] = copyJSONValue(elem)
+		}
+		return out
+	default:
+		return v
+	}
+}
+
+// jsonEqual compares two generic JSON values, numbers are equal if they
+// have the same value regardless of their notation
+func jsonEqual(a, b interface{}) bool {
+	switch x := a.(type) {
+	case json.Number:
+		y, ok := b.(json.Number)
+		if !ok {
+			return false
+		}
+		if x == y {
+			return true
+		}
+		fx, errx := x.Float64()
+		fy, erry := y.Float64()
+		return errx == nil && erry == nil && fx == fy
+	case map[string]interface{}:
+		y, ok := b.(map[string]interface{})
+		if !ok || len(x) != len(y) {
+			return false
+		}
+		for key, value := range x {
+			other, ok := y[key]
+			if !ok || !jsonEqual(value, other) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		y, ok := b.([]interface{})
+		if !ok || len(x) != len(y) {
+			return false
+		}
+		for i := range x {
+			if !jsonEqual(x[i], y[i]) {
+				return false
+			}
+		}
+		return true
+	default:
+		return reflect.DeepEqual(a, b)
+	}
+}
@@ -0,0 +1,212 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJSONPatch(t *testing.T) {
+	// examples from appendix A of RFC 6902
+	tests := []struct {
+		name     string
+		doc      string
+		patch    string
+		expected string
+		wantErr  bool
+	}{
+		{
+			name:     "add an object member",
+			doc:      `{"foo":"bar"}`,
+			patch:    `[{"op":"add","path":"/baz","value":"qux"}]`,
+			expected: `{"baz":"qux","foo":"bar"}`,
+		},
+		{
+			name:     "add an array element",
+			doc:      `{"foo":["bar","baz"]}`,
+			patch:    `[{"op":"add","path":"/foo/1","value":"qux"}]`,
+			expected: `{"foo":["bar","qux","baz"]}`,
+		},
+		{
+			name:     "append an array element",
+			doc:      `{"foo":["bar"]}`,
+			patch:    `[{"op":"add","path":"/foo/-","value":["abc","def"]}]`,
+			expected: `{"foo":["bar",["abc","def"]]}`,
+		},
+		{
+			name:     "remove an object member",
+			doc:      `{"baz":"qux","foo":"bar"}`,
+			patch:    `[{"op":"remove","path":"/baz"}]`,
+			expected: `{"foo":"bar"}`,
+		},
+		{
+			name:     "remove an array element",
+			doc:      `{"foo":["bar","qux","baz"]}`,
+			patch:    `[{"op":"remove","path":"/foo/1"}]`,
+			expected: `{"foo":["bar","baz"]}`,
+		},
+		{
+			name:     "replace a value",
+			doc:      `{"baz":"qux","foo":"bar"}`,
+			patch:    `[{"op":"replace","path":"/baz","value":"boo"}]`,
+			expected: `{"baz":"boo","foo":"bar"}`,
+		},
+		{
+			name:     "move a value",
+			doc:      `{"foo":{"bar":"baz","waldo":"fred"},"qux":{"corge":"grault"}}`,
+			patch:    `[{"op":"move","from":"/foo/waldo","path":"/qux/thud"}]`,
+			expected: `{"foo":{"bar":"baz"},"qux":{"corge":"grault","thud":"fred"}}`,
+		},
+		{
+			name:     "move an array element",
+			doc:      `{"foo":["all","grass","cows","eat"]}`,
+			patch:    `[{"op":"move","from":"/foo/1","path":"/foo/3"}]`,
+			expected: `{"foo":["all","cows","eat","grass"]}`,
+		},
+		{
+			name:     "copy a value",
+			doc:      `{"foo":{"bar":[1]}}`,
+			patch:    `[{"op":"copy","from":"/foo/bar","path":"/baz"},{"op":"add","path":"/baz/-","value":2}]`,
+			expected: `{"baz":[1,2],"foo":{"bar":[1]}}`,
+		},
+		{
+			name:     "test a value",
+			doc:      `{"baz":"qux","foo":["a",2,"c"]}`,
+			patch:    `[{"op":"test","path":"/baz","value":"qux"},{"op":"test","path":"/foo/1","value":2.0}]`,
+			expected: `{"baz":"qux","foo":["a",2,"c"]}`,
+		},
+		{
+			name:    "test a value fails",
+			doc:     `{"baz":"qux"}`,
+			patch:   `[{"op":"test","path":"/baz","value":"bar"}]`,
+			wantErr: true,
+		},
+		{
+			name:     "escaped pointer",
+			doc:      `{"/":9,"~1":10}`,
+			patch:    `[{"op":"test","path":"/~01","value":10},{"op":"remove","path":"/~1"}]`,
+			expected: `{"~1":10}`,
+		},
+		{
+			name:    "add to a nonexistent target",
+			doc:     `{"foo":"bar"}`,
+			patch:   `[{"op":"add","path":"/baz/bat","value":"qux"}]`,
+			wantErr: true,
+		},
+		{
+			name:    "remove a nonexistent member",
+			doc:     `{"foo":"bar"}`,
+			patch:   `[{"op":"remove","path":"/baz"}]`,
+			wantErr: true,
+		},
+		{
+			name:    "array index out of bounds",
+			doc:     `{"foo":["bar"]}`,
+			patch:   `[{"op":"add","path":"/foo/2","value":"qux"}]`,
+			wantErr: true,
+		},
+		{
+			name:    "array index with leading zero",
+			doc:     `{"foo":["bar","baz"]}`,
+			patch:   `[{"op":"replace","path":"/foo/01","value":"qux"}]`,
+			wantErr: true,
+		},
+		{
+			name:    "move into a child",
+			doc:     `{"foo":{"bar":"baz"}}`,
+			patch:   `[{"op":"move","from":"/foo","path":"/foo/bar/baz"}]`,
+			wantErr: true,
+		},
+		{
+			name:     "operations are applied in order",
+			doc:      `{"foo":"bar"}`,
+			patch:    `[{"op":"add","path":"/baz","value":null},{"op":"replace","path":"/baz","value":{"a":1}},{"op":"add","path":"/baz/b","value":2}]`,
+			expected: `{"baz":{"a":1,"b":2},"foo":"bar"}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			doc, err := decodeJSON([]byte(test.doc))
+			require.Nil(t, err)
+			patch, err := ParseJSONPatch([]byte(test.patch))
+			require.Nil(t, err)
+
+			res, err := patch.apply(doc)
+			if test.wantErr {
+				assert.IsType(t, ErrInvalidUserInput{}, err)
+				return
+			}
+			require.Nil(t, err)
+			actual, err := json.Marshal(res)
+			require.Nil(t, err)
+			assert.JSONEq(t, test.expected, string(actual))
+		})
+	}
+}
+
+func TestParseJSONPatch(t *testing.T) {
+	invalid := []string{
+		`{"op":"add","path":"/foo","value":1}`,
+		`[{"op":"add","path":"/foo"}]`,
+		`[{"op":"replace","path":"foo","value":1}]`,
+		`[{"op":"move","from":"foo","path":"/foo"}]`,
+		`[{"op":"update","path":"/foo","value":1}]`,
+	}
+	for _, patch := range invalid {
+		_, err := ParseJSONPatch([]byte(patch))
+		assert.IsType(t, ErrInvalidUserInput{}, err, patch)
+	}
+
+	patch, err := ParseJSONPatch([]byte(`[{"op":"add","path":"/foo","value":null}]`))
+	require.Nil(t, err)
+	require.Len(t, patch, 1)
+	assert.Nil(t, patch[0].value)
+}
+
+func TestJSONMergePatch(t *testing.T) {
+	// examples from appendix A of RFC 7396
+	tests := []struct {
+		doc, patch, expected string
+	}{
+		{`{"a":"b"}`, `{"a":"c"}`, `{"a":"c"}`},
+		{`{"a":"b"}`, `{"b":"c"}`, `{"a":"b","b":"c"}`},
+		{`{"a":"b"}`, `{"a":null}`, `{}`},
+		{`{"a":"b","b":"c"}`, `{"a":null}`, `{"b":"c"}`},
+		{`{"a":["b"]}`, `{"a":"c"}`, `{"a":"c"}`},
+		{`{"a":"c"}`, `{"a":["b"]}`, `{"a":["b"]}`},
+		{`{"a":{"b":"c"}}`, `{"a":{"b":"d","c":null}}`, `{"a":{"b":"d"}}`},
+		{`{"a":[{"b":"c"}]}`, `{"a":[1]}`, `{"a":[1]}`},
+		{`{"e":null}`, `{"a":1}`, `{"a":1,"e":null}`},
+		{`{}`, `{"a":{"bb":{"ccc":null}}}`, `{"a":{"bb":{}}}`},
+	}
+
+	for _, test := range tests {
+		doc, err := decodeJSON([]byte(test.doc))
+		require.Nil(t, err)
+		patch, err := ParseJSONMergePatch([]byte(test.patch))
+		require.Nil(t, err)
+
+		res, err := patch.apply(doc)
+		require.Nil(t, err)
+		actual, err := json.Marshal(res)
+		require.Nil(t, err)
+		assert.JSONEq(t, test.expected, string(actual), test.patch)
+	}
+
+	_, err := ParseJSONMergePatch([]byte(`["a"]`))
+	assert.IsType(t, ErrInvalidUserInput{}, err)
+}
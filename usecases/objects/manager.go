@@ -18,6 +18,7 @@ package objects
 import (
 	"context"
 	"fmt"
+	"io"
 	"time"
 
 	"github.com/go-openapi/strfmt"
@@ -108,6 +109,10 @@ type VectorRepo interface {
 	AddReference(ctx context.Context, source *crossref.RefSource,
 		target *crossref.Ref, repl *additional.ReplicationProperties, tenant string) error
 	Merge(ctx context.Context, merge MergeDocument, repl *additional.ReplicationProperties, tenant string) error
+	PutObjectBlob(ctx context.Context, class string, id strfmt.UUID, propName string,
+		r io.Reader, updateTime int64, tenant string) error
+	ObjectBlob(ctx context.Context, class string, id strfmt.UUID, propName string,
+		repl *additional.ReplicationProperties, tenant string) (io.ReadCloser, int64, error)
 	Query(context.Context, *QueryInput) (search.Results, *Error)
 }
 
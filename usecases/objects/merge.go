@@ -13,6 +13,7 @@ package objects
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/go-openapi/strfmt"
@@ -31,13 +32,17 @@ type MergeDocument struct {
 	PrimitiveSchema      map[string]interface{}      `json:"primitiveSchema"`
 	References           BatchReferences             `json:"references"`
 	Vector               []float32                   `json:"vector"`
+	Vectors              map[string][]float32        `json:"vectors"`
 	UpdateTime           int64                       `json:"updateTime"`
 	AdditionalProperties models.AdditionalProperties `json:"additionalProperties"`
 	PropertiesToDelete   []string                    `json:"propertiesToDelete"`
 }
 
+// MergeObject merges updates into the existing object. If precondition is
+// set, the object is only updated if it has one of the expected versions.
 func (m *Manager) MergeObject(ctx context.Context, principal *models.Principal,
 	updates *models.Object, repl *additional.ReplicationProperties,
+	precondition *VersionPrecondition,
 ) *Error {
 	if err := m.validateInputs(updates); err != nil {
 		return &Error{"bad request", StatusBadRequest, err}
@@ -63,7 +68,18 @@ func (m *Manager) MergeObject(ctx context.Context, principal *models.Principal,
 	if obj == nil {
 		return &Error{"not found", StatusNotFound, err}
 	}
+	if err := checkPrecondition(obj, precondition); err != nil {
+		return &Error{"precondition failed", StatusPreconditionFailed, err}
+	}
+	ctx = WithPrecondition(ctx, obj.ID, precondition)
+
+	return m.mergeObject(ctx, principal, obj, updates, repl)
+}
 
+// mergeObject validates updates and merges them into the existing object obj
+func (m *Manager) mergeObject(ctx context.Context, principal *models.Principal,
+	obj *search.Result, updates *models.Object, repl *additional.ReplicationProperties,
+) *Error {
 	var propertiesToDelete []string
 	if updates.Properties != nil {
 		for key, val := range updates.Properties.(map[string]interface{}) {
@@ -73,7 +89,7 @@ func (m *Manager) MergeObject(ctx context.Context, principal *models.Principal,
 		}
 	}
 
-	if err := m.validateObjectAndNormalizeNames(
+	if err := m.validateMergeObjectAndNormalizeNames(
 		ctx, principal, repl, updates, obj.Object()); err != nil {
 		return &Error{"bad request", StatusBadRequest, err}
 	}
@@ -81,6 +97,13 @@ func (m *Manager) MergeObject(ctx context.Context, principal *models.Principal,
 	if updates.Properties == nil {
 		updates.Properties = map[string]interface{}{}
 	}
+	// validation sets computed properties to nil whose inputs were deleted
+	for key, val := range updates.Properties.(map[string]interface{}) {
+		if val == nil {
+			propertiesToDelete = append(propertiesToDelete, key)
+			delete(updates.Properties.(map[string]interface{}), key)
+		}
+	}
 
 	return m.patchObject(ctx, principal, obj, updates, repl, propertiesToDelete, updates.Tenant)
 }
@@ -93,16 +116,25 @@ func (m *Manager) patchObject(ctx context.Context, principal *models.Principal,
 	cls, id := updates.Class, updates.ID
 	primitive, refs := m.splitPrimitiveAndRefs(updates.Properties.(map[string]interface{}), cls, id)
 	objWithVec, err := m.mergeObjectSchemaAndVectorize(ctx, cls, obj.Schema,
-		primitive, principal, obj.Vector, updates.Vector)
+		primitive, propertiesToDelete, principal, obj.Vector, updates.Vector, updates.Vectors)
 	if err != nil {
 		return &Error{"merge and vectorize", StatusInternalServerError, err}
 	}
+	class, err := m.schemaManager.GetClass(ctx, principal, cls)
+	if err != nil {
+		return &Error{"merge and vectorize", StatusInternalServerError, err}
+	}
+	if err := validateVectorDimensions(ctx, m.schemaManager, class, objWithVec.Vector,
+		objWithVec.Vectors); err != nil {
+		return &Error{"bad request", StatusUnprocessableEntity, err}
+	}
 	mergeDoc := MergeDocument{
 		Class:              cls,
 		ID:                 id,
 		PrimitiveSchema:    primitive,
 		References:         refs,
 		Vector:             objWithVec.Vector,
+		Vectors:            mergeVectors(objWithVec.Vectors),
 		UpdateTime:         m.timeSource.Now(),
 		PropertiesToDelete: propertiesToDelete,
 	}
@@ -112,6 +144,15 @@ func (m *Manager) patchObject(ctx context.Context, principal *models.Principal,
 	}
 
 	if err := m.vectorRepo.Merge(ctx, mergeDoc, repl, tenant); err != nil {
+		if errors.As(err, &ErrReadOnly{}) {
+			return &Error{"repo.merge", StatusLocked, err}
+		}
+		if errors.As(err, &ErrQuotaExceeded{}) {
+			return &Error{"repo.merge", StatusUnprocessableEntity, err}
+		}
+		if errors.As(err, &ErrPreconditionFailed{}) {
+			return &Error{"repo.merge", StatusPreconditionFailed, err}
+		}
 		return &Error{"repo.merge", StatusInternalServerError, err}
 	}
 
@@ -132,8 +173,8 @@ func (m *Manager) validateInputs(updates *models.Object) error {
 }
 
 func (m *Manager) mergeObjectSchemaAndVectorize(ctx context.Context, className string,
-	old interface{}, new map[string]interface{},
-	principal *models.Principal, oldVec, newVec []float32,
+	old interface{}, new map[string]interface{}, deleted []string,
+	principal *models.Principal, oldVec, newVec []float32, newVectors models.Vectors,
 ) (*models.Object, error) {
 	var merged map[string]interface{}
 	var vector []float32
@@ -153,6 +194,15 @@ func (m *Manager) mergeObjectSchemaAndVectorize(ctx context.Context, className s
 			objDiff.WithProp(key, oldMap[key], value)
 			oldMap[key] = value
 		}
+		for _, key := range deleted {
+			// vectorizers only look at the properties present on the merged
+			// object, so the removal of text they might have vectorized can
+			// only be accounted for by dropping the previous vector
+			if isText(oldMap[key]) {
+				objDiff = moduletools.NewObjectDiff(nil)
+			}
+			delete(oldMap, key)
+		}
 
 		merged = oldMap
 		if newVec != nil {
@@ -170,7 +220,7 @@ func (m *Manager) mergeObjectSchemaAndVectorize(ctx context.Context, className s
 
 	// Note: vector could be a nil vector in case a vectorizer is configured,
 	// then the vectorizer will set it
-	obj := &models.Object{Class: className, Properties: merged, Vector: vector}
+	obj := &models.Object{Class: className, Properties: merged, Vector: vector, Vectors: newVectors}
 	class, err := m.schemaManager.GetClass(ctx, principal, className)
 	if err != nil {
 		return nil, err
@@ -182,6 +232,37 @@ func (m *Manager) mergeObjectSchemaAndVectorize(ctx context.Context, className s
 	return obj, nil
 }
 
+// isText reports whether v is a text value or a list of text values, which
+// are the only kinds of values vectorizers consider
+func isText(v interface{}) bool {
+	switch val := v.(type) {
+	case string:
+		return true
+	case []string:
+		return len(val) > 0
+	case []interface{}:
+		for _, elem := range val {
+			if _, ok := elem.(string); ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// mergeVectors converts the named vectors of a merged object into the
+// representation used by MergeDocument
+func mergeVectors(in models.Vectors) map[string][]float32 {
+	if len(in) == 0 {
+		return nil
+	}
+	out := make(map[string][]float32, len(in))
+	for name, vec := range in {
+		out[name] = vec
+	}
+	return out
+}
+
 func (m *Manager) splitPrimitiveAndRefs(in map[string]interface{}, sourceClass string,
 	sourceID strfmt.UUID,
 ) (map[string]interface{}, BatchReferences) {
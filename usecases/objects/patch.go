@@ -0,0 +1,187 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/search"
+)
+
+// ObjectPatch is a patch document applied to an object by PatchObject, either
+// a JSONPatch or a JSONMergePatch.
+//
+// A patch is applied to the JSON representation of the object with the
+// members "class", "id", "tenant", "properties" and "vector", for example
+// the JSON Patch path of a property is "/properties/<name>". Only the
+// properties and the vector can be changed.
+type ObjectPatch interface {
+	apply(doc interface{}) (interface{}, error)
+}
+
+// PatchObject applies patch to an existing object. If precondition is set,
+// the object is only updated if it has one of the expected versions.
+//
+// The properties changed by the patch are merged into the object like with
+// MergeObject, so the object is only re-vectorized if the patch changes
+// properties the vectorizer considers. A vector set by the patch is used
+// as is.
+func (m *Manager) PatchObject(ctx context.Context, principal *models.Principal,
+	className string, id strfmt.UUID, patch ObjectPatch,
+	repl *additional.ReplicationProperties, tenant string,
+	precondition *VersionPrecondition,
+) *Error {
+	path := fmt.Sprintf("objects/%s/%s", className, id)
+	if err := m.authorizer.Authorize(principal, "update", path); err != nil {
+		return &Error{path, StatusForbidden, err}
+	}
+
+	m.metrics.MergeObjectInc()
+	defer m.metrics.MergeObjectDec()
+
+	obj, err := m.vectorRepo.Object(ctx, className, id, nil, additional.Properties{}, repl, tenant)
+	if err != nil {
+		switch err.(type) {
+		case ErrMultiTenancy:
+			return &Error{"repo.object", StatusUnprocessableEntity, err}
+		default:
+			return &Error{"repo.object", StatusInternalServerError, err}
+		}
+	}
+	if obj == nil {
+		return &Error{"not found", StatusNotFound, err}
+	}
+	if err := checkPrecondition(obj, precondition); err != nil {
+		return &Error{"precondition failed", StatusPreconditionFailed, err}
+	}
+	ctx = WithPrecondition(ctx, obj.ID, precondition)
+
+	updates, err := patchedObject(obj, patch, tenant)
+	if err != nil {
+		if errors.As(err, &ErrInvalidUserInput{}) {
+			return &Error{"apply patch", StatusUnprocessableEntity, err}
+		}
+		return &Error{"apply patch", StatusInternalServerError, err}
+	}
+
+	return m.mergeObject(ctx, principal, obj, updates, repl)
+}
+
+// patchedObject applies patch to obj and returns the changes as updates for
+// mergeObject: the properties which differ after applying the patch, with
+// removed properties set to nil, and the vector if it has changed.
+func patchedObject(obj *search.Result, patch ObjectPatch, tenant string) (*models.Object, error) {
+	doc := map[string]interface{}{
+		"class":      obj.ClassName,
+		"id":         obj.ID,
+		"properties": obj.Schema,
+	}
+	if obj.Schema == nil {
+		doc["properties"] = map[string]interface{}{}
+	}
+	if tenant != "" {
+		doc["tenant"] = tenant
+	}
+	if len(obj.Vector) > 0 {
+		doc["vector"] = obj.Vector
+	}
+
+	before, err := toJSONValue(doc)
+	if err != nil {
+		return nil, NewErrInternal("encode object: %v", err)
+	}
+	after, err := toJSONValue(doc)
+	if err != nil {
+		return nil, NewErrInternal("encode object: %v", err)
+	}
+	if after, err = patch.apply(after); err != nil {
+		return nil, err
+	}
+
+	oldDoc := before.(map[string]interface{})
+	newDoc, ok := after.(map[string]interface{})
+	if !ok {
+		return nil, NewErrInvalidUserInput("patched object is not a json object")
+	}
+	for _, key := range []string{"class", "id", "tenant"} {
+		if !jsonEqual(oldDoc[key], newDoc[key]) {
+			return nil, NewErrInvalidUserInput("%s of the object cannot be changed", key)
+		}
+	}
+	for key := range newDoc {
+		switch key {
+		case "class", "id", "tenant", "properties", "vector":
+		default:
+			return nil, NewErrInvalidUserInput("unknown member %q of the object, only properties and vector can be patched", key)
+		}
+	}
+
+	oldProps, _ := oldDoc["properties"].(map[string]interface{})
+	newProps, ok := newDoc["properties"].(map[string]interface{})
+	if !ok && newDoc["properties"] != nil {
+		return nil, NewErrInvalidUserInput("properties of the object must be a json object")
+	}
+	changed := map[string]interface{}{}
+	for key, value := range newProps {
+		if old, ok := oldProps[key]; !ok || !jsonEqual(old, value) {
+			changed[key] = value
+		}
+	}
+	for key := range oldProps {
+		if _, ok := newProps[key]; !ok {
+			changed[key] = nil
+		}
+	}
+
+	updates := &models.Object{
+		Class:      obj.ClassName,
+		ID:         obj.ID,
+		Tenant:     tenant,
+		Properties: changed,
+	}
+	if !jsonEqual(oldDoc["vector"], newDoc["vector"]) {
+		if updates.Vector, err = patchedVector(newDoc["vector"]); err != nil {
+			return nil, err
+		}
+	}
+	return updates, nil
+}
+
+func patchedVector(value interface{}) (models.C11yVector, error) {
+	if value == nil {
+		return nil, NewErrInvalidUserInput("vector of the object cannot be removed")
+	}
+	elems, ok := value.([]interface{})
+	if !ok || len(elems) == 0 {
+		return nil, NewErrInvalidUserInput("vector of the object must be a non-empty list of numbers")
+	}
+
+	vector := make(models.C11yVector, len(elems))
+	for i, elem := range elems {
+		num, ok := elem.(json.Number)
+		if !ok {
+			return nil, NewErrInvalidUserInput("vector of the object must be a non-empty list of numbers")
+		}
+		f, err := num.Float64()
+		if err != nil {
+			return nil, NewErrInvalidUserInput("vector element %d: %v", i, err)
+		}
+		vector[i] = float32(f)
+	}
+	return vector, nil
+}
@@ -0,0 +1,189 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/search"
+)
+
+func Test_PatchObject(t *testing.T) {
+	var (
+		cls = "ZooAction"
+		id  = strfmt.UUID("dd59815b-142b-4c54-9b12-482434bd54ca")
+		ctx = context.Background()
+	)
+
+	// vectorizer is the vector the fake vectorizer sets, nil keeps the vector
+	// of the object
+	setup := func(t *testing.T, vectorizer []float32) (fakeGetManager, *MergeDocument) {
+		m := newFakeGetManager(zooAnimalSchemaForTest())
+		m.timeSource = fakeTimeSource{}
+		m.repo.On("Object", cls, id, search.SelectProperties(nil), additional.Properties{}, "").
+			Return(&search.Result{
+				ID:        id,
+				ClassName: cls,
+				Schema: map[string]interface{}{
+					"name":      "My little pony zoo",
+					"employees": int64(40),
+				},
+				Vector:  []float32{1, 2, 3},
+				Updated: 7,
+			}, nil)
+		m.modulesProvider.On("VectorizerName", cls).Return("some-module", nil).Maybe()
+		var vec interface{}
+		if vectorizer != nil {
+			vec = vectorizer
+		}
+		m.modulesProvider.On("UpdateVector", mock.Anything, mock.AnythingOfType(FindObjectFn)).
+			Return(vec, nil).Maybe()
+
+		var merged MergeDocument
+		m.repo.On("Merge", mock.Anything).Run(func(args mock.Arguments) {
+			merged = args.Get(0).(MergeDocument)
+		}).Return(nil).Maybe()
+		return m, &merged
+	}
+
+	jsonPatch := func(t *testing.T, doc string) ObjectPatch {
+		patch, err := ParseJSONPatch([]byte(doc))
+		require.Nil(t, err)
+		return patch
+	}
+
+	t.Run("patch a property which is not vectorized", func(t *testing.T) {
+		m, merged := setup(t, []float32{4, 5, 6})
+		err := m.PatchObject(ctx, nil, cls, id,
+			jsonPatch(t, `[{"op":"test","path":"/properties/employees","value":40},
+				{"op":"replace","path":"/properties/employees","value":41}]`),
+			nil, "", nil)
+		require.Nil(t, err)
+
+		assert.Equal(t, map[string]interface{}{"employees": int64(41)}, merged.PrimitiveSchema)
+		assert.Empty(t, merged.PropertiesToDelete)
+		require.NotNil(t, m.modulesProvider.objectDiff)
+		assert.Equal(t, []float32{1, 2, 3}, m.modulesProvider.objectDiff.GetVec())
+		assert.False(t, m.modulesProvider.objectDiff.IsChangedProp("name"))
+		assert.True(t, m.modulesProvider.objectDiff.IsChangedProp("employees"))
+	})
+
+	t.Run("patch a text property", func(t *testing.T) {
+		m, merged := setup(t, []float32{4, 5, 6})
+		err := m.PatchObject(ctx, nil, cls, id,
+			jsonPatch(t, `[{"op":"replace","path":"/properties/name","value":"My big zoo"}]`),
+			nil, "", nil)
+		require.Nil(t, err)
+
+		assert.Equal(t, map[string]interface{}{"name": "My big zoo"}, merged.PrimitiveSchema)
+		assert.True(t, m.modulesProvider.objectDiff.IsChangedProp("name"))
+		assert.Equal(t, []float32{4, 5, 6}, merged.Vector)
+	})
+
+	t.Run("remove a text property", func(t *testing.T) {
+		m, merged := setup(t, []float32{4, 5, 6})
+		err := m.PatchObject(ctx, nil, cls, id,
+			jsonPatch(t, `[{"op":"remove","path":"/properties/name"}]`),
+			nil, "", nil)
+		require.Nil(t, err)
+
+		assert.Equal(t, []string{"name"}, merged.PropertiesToDelete)
+		// the previous vector must not be reused as it covers the removed text
+		assert.Nil(t, m.modulesProvider.objectDiff.GetVec())
+	})
+
+	t.Run("patch the vector", func(t *testing.T) {
+		m, merged := setup(t, nil)
+		err := m.PatchObject(ctx, nil, cls, id,
+			jsonPatch(t, `[{"op":"replace","path":"/vector/1","value":2.5}]`),
+			nil, "", nil)
+		require.Nil(t, err)
+
+		assert.Equal(t, []float32{1, 2.5, 3}, merged.Vector)
+		assert.Empty(t, merged.PrimitiveSchema)
+	})
+
+	t.Run("merge patch", func(t *testing.T) {
+		m, merged := setup(t, []float32{4, 5, 6})
+		patch, err := ParseJSONMergePatch([]byte(`{"properties":{"name":null,"area":12.5}}`))
+		require.Nil(t, err)
+		objErr := m.PatchObject(ctx, nil, cls, id, patch, nil, "", nil)
+		require.Nil(t, objErr)
+
+		assert.Equal(t, map[string]interface{}{"area": 12.5}, merged.PrimitiveSchema)
+		assert.Equal(t, []string{"name"}, merged.PropertiesToDelete)
+	})
+
+	t.Run("failing test operation", func(t *testing.T) {
+		m, _ := setup(t, []float32{4, 5, 6})
+		err := m.PatchObject(ctx, nil, cls, id,
+			jsonPatch(t, `[{"op":"test","path":"/properties/employees","value":39},
+				{"op":"replace","path":"/properties/employees","value":41}]`),
+			nil, "", nil)
+		require.NotNil(t, err)
+		assert.True(t, err.UnprocessableEntity(), "got %v", err)
+		m.repo.AssertNotCalled(t, "Merge", mock.Anything)
+	})
+
+	t.Run("immutable members", func(t *testing.T) {
+		for _, patch := range []string{
+			`[{"op":"replace","path":"/class","value":"Zoo"}]`,
+			`[{"op":"remove","path":"/id"}]`,
+			`[{"op":"add","path":"/tenant","value":"tenant1"}]`,
+			`[{"op":"add","path":"/creationTimeUnix","value":1}]`,
+			`[{"op":"remove","path":"/vector"}]`,
+			`[{"op":"replace","path":"/properties","value":["name"]}]`,
+		} {
+			m, _ := setup(t, []float32{4, 5, 6})
+			err := m.PatchObject(ctx, nil, cls, id, jsonPatch(t, patch), nil, "", nil)
+			require.NotNil(t, err, patch)
+			assert.True(t, err.UnprocessableEntity(), "%s: got %v", patch, err)
+			m.repo.AssertNotCalled(t, "Merge", mock.Anything)
+		}
+	})
+
+	t.Run("invalid property value", func(t *testing.T) {
+		m, _ := setup(t, []float32{4, 5, 6})
+		err := m.PatchObject(ctx, nil, cls, id,
+			jsonPatch(t, `[{"op":"replace","path":"/properties/employees","value":"many"}]`),
+			nil, "", nil)
+		require.NotNil(t, err)
+		assert.True(t, err.BadRequest(), "got %v", err)
+		m.repo.AssertNotCalled(t, "Merge", mock.Anything)
+	})
+
+	t.Run("precondition", func(t *testing.T) {
+		m, _ := setup(t, []float32{4, 5, 6})
+		err := m.PatchObject(ctx, nil, cls, id,
+			jsonPatch(t, `[{"op":"replace","path":"/properties/employees","value":41}]`),
+			nil, "", &VersionPrecondition{Versions: []int64{6}})
+		require.NotNil(t, err)
+		assert.True(t, err.PreconditionFailed(), "got %v", err)
+		m.repo.AssertNotCalled(t, "Merge", mock.Anything)
+	})
+
+	t.Run("object not found", func(t *testing.T) {
+		m := newFakeGetManager(zooAnimalSchemaForTest())
+		m.repo.On("Object", cls, id, search.SelectProperties(nil), additional.Properties{}, "").
+			Return((*search.Result)(nil), nil)
+		err := m.PatchObject(ctx, nil, cls, id,
+			jsonPatch(t, `[{"op":"remove","path":"/properties/name"}]`), nil, "", nil)
+		require.NotNil(t, err)
+		assert.True(t, err.NotFound(), "got %v", err)
+	})
+}
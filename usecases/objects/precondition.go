@@ -0,0 +1,127 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/entities/search"
+)
+
+// VersionPrecondition makes a write conditional on the current version of the
+// object, see If-Match in RFC 9110. The version of an object is the time of
+// its last update in unix millis. The time is set once by the node accepting
+// the write, so all replicas of an object share its version. A nil
+// precondition makes the write unconditional.
+type VersionPrecondition struct {
+	// Versions the object may have, the write is rejected if the object has
+	// none of them. An empty list never matches.
+	Versions []int64
+}
+
+func (p *VersionPrecondition) matches(version int64) bool {
+	if p == nil {
+		return true
+	}
+	for _, v := range p.Versions {
+		if v == version {
+			return true
+		}
+	}
+	return false
+}
+
+// Check returns ErrPreconditionFailed unless the object id exists with one of
+// the expected versions. The shards call it under the lock of the object, so
+// that nothing is written in between the check and the write.
+func (p *VersionPrecondition) Check(id strfmt.UUID, exists bool, version int64) error {
+	if p == nil {
+		return nil
+	}
+	if !exists {
+		return NewErrPreconditionFailed("object %s does not exist", id)
+	}
+	if !p.matches(version) {
+		return NewErrPreconditionFailed("object %s has changed: current version is %d",
+			id, version)
+	}
+	return nil
+}
+
+// IfMatch formats p as the value of an If-Match header, see ParseIfMatch
+func (p *VersionPrecondition) IfMatch() string {
+	tags := make([]string, len(p.Versions))
+	for i, v := range p.Versions {
+		tags[i] = ETag(v)
+	}
+	return strings.Join(tags, ", ")
+}
+
+// ETag formats the version of an object as a strong entity tag
+func ETag(version int64) string {
+	return strconv.Quote(strconv.FormatInt(version, 10))
+}
+
+// ParseIfMatch parses the If-Match header of a conditional write. "*"
+// matches any existing object, which is required by every write anyway. Weak
+// and malformed entity tags never match, see RFC 9110 section 13.1.1
+func ParseIfMatch(ifMatch string) *VersionPrecondition {
+	if strings.TrimSpace(ifMatch) == "*" {
+		return nil
+	}
+
+	precondition := &VersionPrecondition{}
+	for _, tag := range strings.Split(ifMatch, ",") {
+		tag = strings.TrimSpace(tag)
+		if len(tag) < 2 || tag[0] != '"' || tag[len(tag)-1] != '"' {
+			continue
+		}
+		if version, err := strconv.ParseInt(tag[1:len(tag)-1], 10, 64); err == nil {
+			precondition.Versions = append(precondition.Versions, version)
+		}
+	}
+	return precondition
+}
+
+type preconditionKey struct {
+	id strfmt.UUID
+}
+
+// WithPrecondition returns a copy of ctx which makes the write of the object
+// id conditional. Writes of other objects with ctx, e.g. by onDelete
+// policies, are not affected.
+func WithPrecondition(ctx context.Context, id strfmt.UUID,
+	precondition *VersionPrecondition,
+) context.Context {
+	if precondition == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, preconditionKey{id}, precondition)
+}
+
+// PreconditionFromContext returns the precondition for the write of the
+// object id, nil if the write is unconditional
+func PreconditionFromContext(ctx context.Context, id strfmt.UUID) *VersionPrecondition {
+	p, _ := ctx.Value(preconditionKey{id}).(*VersionPrecondition)
+	return p
+}
+
+// checkPrecondition rejects the write of obj early if its version does not
+// match the precondition. It saves the vectorization and on delete the
+// onDelete policies of a write which is bound to fail. The write itself is
+// only applied if the precondition still holds in the shard.
+func checkPrecondition(obj *search.Result, precondition *VersionPrecondition) error {
+	return precondition.Check(obj.ID, true, obj.Updated)
+}
@@ -0,0 +1,171 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestParseIfMatch(t *testing.T) {
+	tests := []struct {
+		ifMatch  string
+		expected *VersionPrecondition
+	}{
+		{ifMatch: "*", expected: nil},
+		{ifMatch: `"12"`, expected: &VersionPrecondition{Versions: []int64{12}}},
+		{ifMatch: `"12" , "13"`, expected: &VersionPrecondition{Versions: []int64{12, 13}}},
+		{ifMatch: `W/"12"`, expected: &VersionPrecondition{}},
+		{ifMatch: `12`, expected: &VersionPrecondition{}},
+		{ifMatch: `"abc", "14"`, expected: &VersionPrecondition{Versions: []int64{14}}},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.expected, ParseIfMatch(test.ifMatch))
+	}
+	assert.Equal(t, `"12"`, ETag(12))
+
+	p := &VersionPrecondition{Versions: []int64{12, 13}}
+	assert.Equal(t, p, ParseIfMatch(p.IfMatch()))
+	assert.Equal(t, &VersionPrecondition{}, ParseIfMatch((&VersionPrecondition{}).IfMatch()))
+}
+
+func TestPreconditionFromContext(t *testing.T) {
+	var (
+		id    = strfmt.UUID("34e9df15-0c3b-468d-ab99-f929662834c7")
+		other = strfmt.UUID("c4a38f05-69c7-4ef4-a0f3-8c2a1a4f0f8e")
+		p     = &VersionPrecondition{Versions: []int64{7}}
+		ctx   = WithPrecondition(context.Background(), id, p)
+	)
+
+	assert.Equal(t, p, PreconditionFromContext(ctx, id))
+	assert.Nil(t, PreconditionFromContext(ctx, other))
+	assert.Nil(t, PreconditionFromContext(context.Background(), id))
+
+	assert.Nil(t, p.Check(id, true, 7))
+	assert.IsType(t, ErrPreconditionFailed{}, p.Check(id, true, 8))
+	assert.IsType(t, ErrPreconditionFailed{}, p.Check(id, false, 0))
+	assert.Nil(t, (*VersionPrecondition)(nil).Check(id, false, 0))
+}
+
+func Test_ConditionalWrites(t *testing.T) {
+	var (
+		cls = "MyClass"
+		id  = strfmt.UUID("34e9df15-0c3b-468d-ab99-f929662834c7")
+		ctx = context.Background()
+	)
+
+	schema := schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{
+				{
+					Class:             cls,
+					VectorIndexConfig: enthnsw.NewDefaultUserConfig(),
+					Properties: []*models.Property{{
+						DataType:     schema.DataTypeText.PropString(),
+						Tokenization: models.PropertyTokenizationWhitespace,
+						Name:         "foo",
+					}},
+				},
+			},
+		},
+	}
+	stored := &search.Result{
+		ID:        id,
+		ClassName: cls,
+		Schema:    map[string]interface{}{"foo": "bar"},
+		Updated:   7,
+	}
+	// changed is returned by the repo if the object changed after it was read
+	changed := NewErrPreconditionFailed("object %s has changed: current version is 8", id)
+
+	t.Run("update", func(t *testing.T) {
+		m := newFakeGetManager(schema)
+		payload := func() *models.Object {
+			return &models.Object{Class: cls, ID: id, Properties: map[string]interface{}{"foo": "baz"}}
+		}
+
+		m.repo.On("Object", cls, id, mock.Anything, mock.Anything, "").Return(stored, nil).Once()
+		_, err := m.UpdateObject(ctx, nil, cls, id, payload(), nil,
+			&VersionPrecondition{Versions: []int64{6}})
+		assert.True(t, errors.As(err, &ErrPreconditionFailed{}), "got %v", err)
+
+		m.repo.On("Object", cls, id, mock.Anything, mock.Anything, "").Return(stored, nil).Once()
+		m.modulesProvider.On("UpdateVector", mock.Anything, mock.AnythingOfType(FindObjectFn)).
+			Return(nil, nil)
+		m.repo.On("PutObject", mock.Anything, mock.Anything).Return(nil).Once()
+		_, err = m.UpdateObject(ctx, nil, cls, id, payload(), nil,
+			&VersionPrecondition{Versions: []int64{6, 7}})
+		require.Nil(t, err)
+
+		m.repo.On("Object", cls, id, mock.Anything, mock.Anything, "").Return(stored, nil).Once()
+		m.repo.On("PutObject", mock.Anything, mock.Anything).Return(changed).Once()
+		_, err = m.UpdateObject(ctx, nil, cls, id, payload(), nil,
+			&VersionPrecondition{Versions: []int64{7}})
+		assert.True(t, errors.As(err, &ErrPreconditionFailed{}), "got %v", err)
+		m.repo.AssertExpectations(t)
+	})
+
+	t.Run("merge", func(t *testing.T) {
+		m := newFakeGetManager(schema)
+		m.repo.On("Object", cls, id, mock.Anything, mock.Anything, "").Return(stored, nil).Once()
+
+		err := m.MergeObject(ctx, nil, &models.Object{Class: cls, ID: id}, nil,
+			&VersionPrecondition{})
+		require.NotNil(t, err)
+		assert.True(t, err.PreconditionFailed(), "got %v", err)
+		m.repo.AssertNotCalled(t, "Merge", mock.Anything)
+
+		m.repo.On("Object", cls, id, mock.Anything, mock.Anything, "").Return(stored, nil).Once()
+		m.modulesProvider.On("UpdateVector", mock.Anything, mock.AnythingOfType(FindObjectFn)).
+			Return(nil, nil)
+		m.modulesProvider.On("VectorizerName", cls).Return("", nil)
+		m.repo.On("Merge", mock.Anything).Return(changed).Once()
+		err = m.MergeObject(ctx, nil, &models.Object{Class: cls, ID: id}, nil,
+			&VersionPrecondition{Versions: []int64{7}})
+		require.NotNil(t, err)
+		assert.True(t, err.PreconditionFailed(), "got %v", err)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		m := newFakeGetManager(schema)
+
+		m.repo.On("Object", cls, id, mock.Anything, mock.Anything, "").Return(stored, nil).Once()
+		err := m.DeleteObject(ctx, nil, cls, id, nil, "", &VersionPrecondition{Versions: []int64{8}})
+		assert.IsType(t, ErrPreconditionFailed{}, err)
+
+		m.repo.On("Object", cls, id, mock.Anything, mock.Anything, "").Return(stored, nil).Once()
+		m.repo.On("DeleteObject", cls, id).Return(nil).Once()
+		err = m.DeleteObject(ctx, nil, cls, id, nil, "", &VersionPrecondition{Versions: []int64{7}})
+		require.Nil(t, err)
+
+		m.repo.On("Object", cls, id, mock.Anything, mock.Anything, "").Return(stored, nil).Once()
+		m.repo.On("DeleteObject", cls, id).Return(fmt.Errorf("delete: %w", changed)).Once()
+		err = m.DeleteObject(ctx, nil, cls, id, nil, "", &VersionPrecondition{Versions: []int64{7}})
+		assert.IsType(t, ErrPreconditionFailed{}, err)
+		m.repo.AssertExpectations(t)
+
+		err = m.DeleteObject(ctx, nil, "", id, nil, "", &VersionPrecondition{Versions: []int64{7}})
+		assert.IsType(t, ErrInvalidUserInput{}, err)
+	})
+}
@@ -15,6 +15,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/google/uuid"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
@@ -40,6 +41,12 @@ type QueryParams struct {
 	Order      *string
 	Tenant     *string
 	Additional additional.Properties
+
+	// Scroll is the keep alive duration of a scroll session, e.g. "5m"
+	Scroll *string
+	// ScrollID continues a scroll session. It is set by Query if a new session
+	// is started with Scroll.
+	ScrollID *string
 }
 
 func (q *QueryParams) inputs(m *Manager) (*QueryInput, error) {
@@ -48,7 +55,11 @@ func (q *QueryParams) inputs(m *Manager) (*QueryInput, error) {
 		return nil, err
 	}
 	sort := m.getSort(q.Sort, q.Order)
-	cursor := m.getCursor(q.After, q.Limit)
+	scroll, err := m.getScroll(q.Scroll, q.ScrollID)
+	if err != nil {
+		return nil, err
+	}
+	cursor := m.getCursor(q.After, q.Limit, scroll)
 	tenant := ""
 	if q.Tenant != nil {
 		tenant = *q.Tenant
@@ -79,9 +90,14 @@ func (m *Manager) Query(ctx context.Context, principal *models.Principal, params
 	m.metrics.GetObjectInc()
 	defer m.metrics.GetObjectDec()
 
+	if params.Scroll != nil && params.ScrollID == nil {
+		scrollID := uuid.NewString()
+		params.ScrollID = &scrollID
+	}
+
 	q, err := params.inputs(m)
 	if err != nil {
-		return nil, &Error{"offset or limit", StatusBadRequest, err}
+		return nil, &Error{"query params", StatusBadRequest, err}
 	}
 	res, rerr := m.vectorRepo.Query(ctx, q)
 	if rerr != nil {
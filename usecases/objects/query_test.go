@@ -15,9 +15,13 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/search"
@@ -161,3 +165,49 @@ func TestQuery(t *testing.T) {
 		})
 	}
 }
+
+func TestQueryScroll(t *testing.T) {
+	cls := "MyClass"
+	scrollInput := func(after string, scroll *filters.Scroll) interface{} {
+		return mock.MatchedBy(func(q *QueryInput) bool {
+			return q.Cursor != nil && q.Cursor.After == after && q.Cursor.Limit == 10 &&
+				assert.ObjectsAreEqual(scroll, q.Cursor.Scroll)
+		})
+	}
+
+	t.Run("start a scroll session", func(t *testing.T) {
+		m := newFakeGetManager(schema.Schema{})
+		params := QueryParams{Class: cls, Limit: ptInt64(10), Scroll: ptString("5m")}
+		m.repo.On("Query", mock.Anything).Return([]search.Result{}, (*Error)(nil)).Once()
+
+		_, err := m.Manager.Query(context.Background(), nil, &params)
+		require.Nil(t, err)
+		require.NotNil(t, params.ScrollID)
+		m.repo.AssertCalled(t, "Query", scrollInput("",
+			&filters.Scroll{ID: *params.ScrollID, TTL: 5 * time.Minute}))
+	})
+
+	t.Run("continue a scroll session with the default duration", func(t *testing.T) {
+		m := newFakeGetManager(schema.Schema{})
+		params := QueryParams{
+			Class: cls, Limit: ptInt64(10), After: ptString("7c8183ae-150d-433f-92b6-ed095b000001"),
+			ScrollID: ptString("my-scroll"),
+		}
+		m.repo.On("Query", mock.Anything).Return([]search.Result{}, (*Error)(nil)).Once()
+
+		_, err := m.Manager.Query(context.Background(), nil, &params)
+		require.Nil(t, err)
+		m.repo.AssertCalled(t, "Query", scrollInput("7c8183ae-150d-433f-92b6-ed095b000001",
+			&filters.Scroll{ID: "my-scroll", TTL: filters.DefaultScrollTTL}))
+	})
+
+	t.Run("invalid scroll duration", func(t *testing.T) {
+		m := newFakeGetManager(schema.Schema{})
+		params := QueryParams{Class: cls, Limit: ptInt64(10), Scroll: ptString("five minutes")}
+
+		_, err := m.Manager.Query(context.Background(), nil, &params)
+		require.NotNil(t, err)
+		assert.Equal(t, StatusBadRequest, err.Code)
+		m.repo.AssertNotCalled(t, "Query", mock.Anything)
+	})
+}
@@ -13,11 +13,14 @@ package objects
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/schema/crossref"
+	"github.com/weaviate/weaviate/usecases/objects/validation"
 )
 
 func (m *Manager) autodetectToClass(ctx context.Context, principal *models.Principal, fromClass, fromProperty string, beaconRef *crossref.Ref) (strfmt.URI, strfmt.URI, bool, *Error) {
@@ -39,3 +42,40 @@ func (m *Manager) autodetectToClass(ctx context.Context, principal *models.Princ
 
 	return strfmt.URI(toClass), strfmt.URI(toBeacon), true, nil
 }
+
+// validateReferenceCardinality makes sure one more reference can be added to
+// the property of the source object without exceeding its cardinality
+func (m *Manager) validateReferenceCardinality(ctx context.Context, principal *models.Principal,
+	fromClass string, id strfmt.UUID, fromProperty string,
+	repl *additional.ReplicationProperties, tenant string,
+) *Error {
+	class, err := m.schemaManager.GetClass(ctx, principal, fromClass)
+	if err != nil {
+		return &Error{"cannot get class", StatusInternalServerError, err}
+	}
+	prop, err := schema.GetPropertyByName(class, schema.LowercaseFirstLetter(fromProperty))
+	if err != nil {
+		return &Error{"cannot get property", StatusInternalServerError, err}
+	}
+	max := schema.MaxReferences(prop)
+	if max == 0 {
+		return nil
+	}
+
+	res, err := m.vectorRepo.Object(ctx, fromClass, id, nil, additional.Properties{}, repl, tenant)
+	if err != nil {
+		return &Error{"source object", StatusInternalServerError, err}
+	}
+	if res == nil {
+		return nil
+	}
+
+	props, _ := res.Object().Properties.(map[string]interface{})
+	if count := validation.CountReferences(props[prop.Name]); count >= max {
+		return &Error{"validate cardinality", StatusUnprocessableEntity, fmt.Errorf(
+			"property '%s' of %s/%s already holds %d of at most %d reference(s)",
+			prop.Name, fromClass, id, count, max)}
+	}
+
+	return nil
+}
@@ -98,6 +98,11 @@ func (m *Manager) AddObjectReference(ctx context.Context, principal *models.Prin
 		}
 	}
 
+	if err := m.validateReferenceCardinality(ctx, principal, input.Class, input.ID,
+		input.Property, repl, tenant); err != nil {
+		return err
+	}
+
 	source := crossref.NewSource(schema.ClassName(input.Class),
 		schema.PropertyName(input.Property), input.ID)
 
@@ -115,6 +120,12 @@ func (m *Manager) AddObjectReference(ctx context.Context, principal *models.Prin
 	}
 
 	if err := m.vectorRepo.AddReference(ctx, source, target, repl, tenant); err != nil {
+		if errors.As(err, &ErrReadOnly{}) {
+			return &Error{"add reference to repo", StatusLocked, err}
+		}
+		if errors.As(err, &ErrQuotaExceeded{}) {
+			return &Error{"add reference to repo", StatusUnprocessableEntity, err}
+		}
 		return &Error{"add reference to repo", StatusInternalServerError, err}
 	}
 
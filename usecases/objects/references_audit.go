@@ -0,0 +1,262 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/schema/crossref"
+	"github.com/weaviate/weaviate/entities/search"
+)
+
+// referenceAuditPageSize is the number of objects fetched at once while
+// scanning a class for dangling references
+const referenceAuditPageSize = 1000
+
+// AuditReferences scans all reference properties of the objects of a class
+// and reports every reference pointing at an object which does not exist. If
+// prune is set, the dangling references are removed from their objects.
+func (m *Manager) AuditReferences(ctx context.Context, principal *models.Principal,
+	className, tenant string, prune bool,
+) (*models.ReferenceAudit, *Error) {
+	path := fmt.Sprintf("objects/%s", className)
+	verb := "get"
+	if prune {
+		verb = "update"
+	}
+	if err := m.authorizer.Authorize(principal, verb, path); err != nil {
+		return nil, &Error{path, StatusForbidden, err}
+	}
+
+	unlock, err := m.locks.LockConnector()
+	if err != nil {
+		return nil, &Error{"cannot lock", StatusInternalServerError, err}
+	}
+	defer unlock()
+
+	class, err := m.schemaManager.GetClass(ctx, principal, className)
+	if err != nil {
+		return nil, &Error{"cannot get class", StatusInternalServerError, err}
+	}
+	if class == nil {
+		return nil, &Error{"class not found " + className, StatusNotFound, nil}
+	}
+
+	var refProps []*models.Property
+	for _, prop := range class.Properties {
+		if len(prop.DataType) > 0 && prop.DataType[0] != "" && schema.IsRefDataType(prop.DataType) {
+			refProps = append(refProps, prop)
+		}
+	}
+
+	audit := &models.ReferenceAudit{
+		ClassName:          className,
+		Tenant:             tenant,
+		DanglingReferences: []*models.DanglingReference{},
+		Pruned:             prune,
+	}
+	if len(refProps) == 0 {
+		return audit, nil
+	}
+
+	a := &referenceAuditor{
+		m:       m,
+		ctx:     ctx,
+		tenant:  tenant,
+		exists:  map[string]bool{},
+		classes: map[string]*models.Class{},
+	}
+	after := ""
+	for {
+		res, rerr := m.vectorRepo.Query(ctx, &QueryInput{
+			Class:      className,
+			Limit:      referenceAuditPageSize,
+			Cursor:     &filters.Cursor{After: after, Limit: referenceAuditPageSize},
+			Tenant:     tenant,
+			Additional: additional.Properties{Vector: true},
+		})
+		if rerr != nil {
+			return nil, rerr
+		}
+
+		for i := range res {
+			if err := a.auditObject(principal, res[i], refProps, audit, prune); err != nil {
+				return nil, err
+			}
+		}
+
+		if len(res) < referenceAuditPageSize {
+			break
+		}
+		after = res[len(res)-1].ID.String()
+	}
+
+	return audit, nil
+}
+
+// referenceAuditor caches the existence of reference targets while a class
+// is audited, so every target is only looked up once
+type referenceAuditor struct {
+	m       *Manager
+	ctx     context.Context
+	tenant  string
+	exists  map[string]bool
+	classes map[string]*models.Class
+}
+
+func (a *referenceAuditor) auditObject(principal *models.Principal, res search.Result,
+	refProps []*models.Property, audit *models.ReferenceAudit, prune bool,
+) *Error {
+	audit.ObjectsScanned++
+
+	props, _ := res.Schema.(map[string]interface{})
+	changed := false
+	for _, prop := range refProps {
+		refs := singleRefs(props[prop.Name])
+		if len(refs) == 0 {
+			continue
+		}
+
+		kept := make(models.MultipleRef, 0, len(refs))
+		for _, ref := range refs {
+			audit.ReferencesScanned++
+			ok, err := a.targetExists(principal, prop, ref)
+			if err != nil {
+				return err
+			}
+			if ok {
+				kept = append(kept, ref)
+				continue
+			}
+			audit.DanglingReferences = append(audit.DanglingReferences, &models.DanglingReference{
+				ID:       res.ID,
+				Property: prop.Name,
+				Beacon:   ref.Beacon,
+			})
+		}
+
+		if len(kept) < len(refs) {
+			props[prop.Name] = kept
+			changed = true
+		}
+	}
+
+	if !prune || !changed {
+		return nil
+	}
+
+	obj := res.Object()
+	obj.Properties = props
+	obj.Tenant = a.tenant
+	obj.LastUpdateTimeUnix = a.m.timeSource.Now()
+	if err := a.m.vectorRepo.PutObject(a.ctx, obj, res.Vector, nil); err != nil {
+		return &Error{"prune references", StatusInternalServerError, err}
+	}
+	return nil
+}
+
+// targetExists checks if the object a reference points to exists. Beacons
+// without a class name are resolved against the data type of the property.
+// A malformed beacon is considered dangling.
+func (a *referenceAuditor) targetExists(principal *models.Principal,
+	prop *models.Property, ref *models.SingleRef,
+) (bool, *Error) {
+	parsed, err := crossref.ParseSingleRef(ref)
+	if err != nil {
+		return false, nil
+	}
+
+	targetClass := parsed.Class
+	if targetClass == "" && len(prop.DataType) == 1 {
+		targetClass = prop.DataType[0]
+	}
+
+	key := targetClass + "/" + parsed.TargetID.String()
+	if ok, cached := a.exists[key]; cached {
+		return ok, nil
+	}
+
+	tenant, serr := a.targetTenant(principal, targetClass)
+	if serr != nil {
+		return false, serr
+	}
+
+	var ok bool
+	if targetClass == "" {
+		res, err := a.m.vectorRepo.ObjectByID(a.ctx, parsed.TargetID, nil, additional.Properties{}, tenant)
+		if err != nil {
+			return false, &Error{"check reference target", StatusInternalServerError, err}
+		}
+		ok = res != nil
+	} else {
+		ok, err = a.m.vectorRepo.Exists(a.ctx, targetClass, parsed.TargetID, nil, tenant)
+		if err != nil {
+			return false, &Error{"check reference target", StatusInternalServerError, err}
+		}
+	}
+
+	a.exists[key] = ok
+	return ok, nil
+}
+
+// targetTenant returns the tenant to look up a target object with. Targets
+// of multi-tenant classes share the tenant of the audited object.
+func (a *referenceAuditor) targetTenant(principal *models.Principal, className string) (string, *Error) {
+	if className == "" || a.tenant == "" {
+		return "", nil
+	}
+
+	class, ok := a.classes[className]
+	if !ok {
+		var err error
+		class, err = a.m.schemaManager.GetClass(a.ctx, principal, className)
+		if err != nil {
+			return "", &Error{"cannot get class", StatusInternalServerError, err}
+		}
+		a.classes[className] = class
+	}
+	if class == nil || !schema.MultiTenancyEnabled(class) {
+		return "", nil
+	}
+	return a.tenant, nil
+}
+
+// singleRefs returns the references held by a property value, regardless of
+// whether it was already parsed.
+func singleRefs(value interface{}) models.MultipleRef {
+	switch v := value.(type) {
+	case models.MultipleRef:
+		return v
+	case []*models.SingleRef:
+		return v
+	case []interface{}:
+		refs := make(models.MultipleRef, 0, len(v))
+		for _, elem := range v {
+			switch ref := elem.(type) {
+			case *models.SingleRef:
+				refs = append(refs, ref)
+			case map[string]interface{}:
+				beacon, _ := ref["beacon"].(string)
+				refs = append(refs, &models.SingleRef{Beacon: strfmt.URI(beacon)})
+			}
+		}
+		return refs
+	default:
+		return nil
+	}
+}
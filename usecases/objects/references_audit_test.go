@@ -0,0 +1,107 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/search"
+)
+
+func Test_AuditReferences(t *testing.T) {
+	t.Parallel()
+	var (
+		cls       = "Zoo"
+		prop      = "hasAnimals"
+		id        = strfmt.UUID("d18c8e5e-0000-0000-0000-56b0cfe33ce7")
+		existing  = strfmt.UUID("d18c8e5e-a339-4c15-8af6-56b0cfe33ce7")
+		missing   = strfmt.UUID("d18c8e5e-a339-4c15-8af6-56b0cfe33ce8")
+		existingB = strfmt.URI("weaviate://localhost/Animal/" + existing)
+		missingB  = strfmt.URI("weaviate://localhost/" + missing)
+	)
+
+	zoo := func() []search.Result {
+		return []search.Result{{
+			ID:        id,
+			ClassName: cls,
+			Schema: map[string]interface{}{
+				"name": "MyZoo",
+				prop: models.MultipleRef{
+					{Beacon: existingB},
+					{Beacon: missingB},
+				},
+			},
+			Vector: []float32{1, 2, 3},
+		}}
+	}
+
+	t.Run("report dangling references", func(t *testing.T) {
+		m := newFakeGetManager(zooAnimalSchemaForTest())
+		m.repo.On("Query", mock.Anything).Return(zoo(), (*Error)(nil)).Once()
+		m.repo.On("Exists", "Animal", existing).Return(true, nil).Once()
+		m.repo.On("Exists", "Animal", missing).Return(false, nil).Once()
+
+		audit, err := m.AuditReferences(context.Background(), nil, cls, "", false)
+		require.Nil(t, err)
+		assert.Equal(t, &models.ReferenceAudit{
+			ClassName:         cls,
+			ObjectsScanned:    1,
+			ReferencesScanned: 2,
+			DanglingReferences: []*models.DanglingReference{
+				{ID: id, Property: prop, Beacon: missingB},
+			},
+		}, audit)
+		m.repo.AssertExpectations(t)
+		m.repo.AssertNotCalled(t, "PutObject", mock.Anything, mock.Anything)
+	})
+
+	t.Run("prune dangling references", func(t *testing.T) {
+		m := newFakeGetManager(zooAnimalSchemaForTest())
+		m.repo.On("Query", mock.Anything).Return(zoo(), (*Error)(nil)).Once()
+		m.repo.On("Exists", "Animal", existing).Return(true, nil).Once()
+		m.repo.On("Exists", "Animal", missing).Return(false, nil).Once()
+		m.repo.On("PutObject", mock.MatchedBy(func(obj *models.Object) bool {
+			props := obj.Properties.(map[string]interface{})
+			return obj.ID == id && assert.ObjectsAreEqual(
+				models.MultipleRef{{Beacon: existingB}}, props[prop])
+		}), []float32{1, 2, 3}).Return(nil).Once()
+
+		audit, err := m.AuditReferences(context.Background(), nil, cls, "", true)
+		require.Nil(t, err)
+		assert.True(t, audit.Pruned)
+		assert.Len(t, audit.DanglingReferences, 1)
+		m.repo.AssertExpectations(t)
+	})
+
+	t.Run("class without references", func(t *testing.T) {
+		m := newFakeGetManager(zooAnimalSchemaForTest())
+
+		audit, err := m.AuditReferences(context.Background(), nil, "Animal", "", false)
+		require.Nil(t, err)
+		assert.Equal(t, int64(0), audit.ObjectsScanned)
+		m.repo.AssertNotCalled(t, "Query", mock.Anything)
+	})
+
+	t.Run("class not found", func(t *testing.T) {
+		m := newFakeGetManager(zooAnimalSchemaForTest())
+
+		_, err := m.AuditReferences(context.Background(), nil, "Unknown", "", false)
+		require.NotNil(t, err)
+		assert.True(t, err.NotFound())
+	})
+}
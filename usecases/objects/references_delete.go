@@ -103,6 +103,9 @@ func (m *Manager) DeleteObjectReference(ctx context.Context, principal *models.P
 
 	err = m.vectorRepo.PutObject(ctx, obj, res.Vector, repl)
 	if err != nil {
+		if errors.As(err, &ErrReadOnly{}) {
+			return &Error{"repo.putobject", StatusLocked, err}
+		}
 		return &Error{"repo.putobject", StatusInternalServerError, err}
 	}
 
@@ -636,6 +636,72 @@ func Test_ReferenceAdd_Ref2Vec(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func Test_ReferenceAdd_Cardinality(t *testing.T) {
+	t.Parallel()
+	var (
+		cls    = "Zoo"
+		prop   = "hasAnimals"
+		id     = strfmt.UUID("d18c8e5e-000-0000-0000-56b0cfe33ce7")
+		refID  = strfmt.UUID("d18c8e5e-a339-4c15-8af6-56b0cfe33ce7")
+		source = crossref.NewSource(schema.ClassName(cls), schema.PropertyName(prop), id)
+		target = crossref.New("localhost", "Animal", refID)
+		req    = AddReferenceInput{
+			Class:    cls,
+			ID:       id,
+			Property: prop,
+			Ref:      models.SingleRef{Beacon: strfmt.URI("weaviate://localhost/Animal/" + refID)},
+		}
+	)
+
+	singleValuedSchema := func() schema.Schema {
+		sch := zooAnimalSchemaForTest()
+		class := sch.FindClassByName(schema.ClassName(cls))
+		for _, p := range class.Properties {
+			if p.Name == prop {
+				p.Cardinality = models.PropertyCardinalityOne
+			}
+		}
+		return sch
+	}
+
+	sourceObject := func(refs models.MultipleRef) *search.Result {
+		props := map[string]interface{}{"name": "MyZoo"}
+		if refs != nil {
+			props[prop] = refs
+		}
+		return &search.Result{ID: id, ClassName: cls, Schema: props}
+	}
+
+	t.Run("without prior refs", func(t *testing.T) {
+		m := newFakeGetManager(singleValuedSchema())
+		m.modulesProvider.On("UsingRef2Vec", mock.Anything).Return(false)
+		m.repo.On("Exists", "Animal", refID).Return(true, nil)
+		m.repo.On("Exists", cls, id).Return(true, nil)
+		m.repo.On("Object", cls, id, mock.Anything, additional.Properties{}, "").
+			Return(sourceObject(nil), nil)
+		m.repo.On("AddReference", source, target).Return(nil)
+
+		err := m.AddObjectReference(context.Background(), nil, &req, nil, "")
+		require.Nil(t, err)
+		m.repo.AssertExpectations(t)
+	})
+
+	t.Run("with the maximum number of refs", func(t *testing.T) {
+		m := newFakeGetManager(singleValuedSchema())
+		m.modulesProvider.On("UsingRef2Vec", mock.Anything).Return(false)
+		m.repo.On("Exists", "Animal", refID).Return(true, nil)
+		m.repo.On("Exists", cls, id).Return(true, nil)
+		m.repo.On("Object", cls, id, mock.Anything, additional.Properties{}, "").
+			Return(sourceObject(models.MultipleRef{&models.SingleRef{Beacon: req.Ref.Beacon}}), nil)
+
+		err := m.AddObjectReference(context.Background(), nil, &req, nil, "")
+		require.NotNil(t, err)
+		assert.Equal(t, StatusUnprocessableEntity, err.Code)
+		assert.Contains(t, err.Error(), "at most 1 reference(s)")
+		m.repo.AssertNotCalled(t, "AddReference", source, target)
+	})
+}
+
 func Test_ReferenceDelete_Ref2Vec(t *testing.T) {
 	t.Parallel()
 
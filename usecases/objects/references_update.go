@@ -108,6 +108,12 @@ func (m *Manager) UpdateObjectReferences(ctx context.Context, principal *models.
 	obj.LastUpdateTimeUnix = m.timeSource.Now()
 	err = m.vectorRepo.PutObject(ctx, obj, res.Vector, repl)
 	if err != nil {
+		if errors.As(err, &ErrReadOnly{}) {
+			return &Error{"repo.putobject", StatusLocked, err}
+		}
+		if errors.As(err, &ErrQuotaExceeded{}) {
+			return &Error{"repo.putobject", StatusUnprocessableEntity, err}
+		}
 		return &Error{"repo.putobject", StatusInternalServerError, err}
 	}
 	return nil
@@ -23,9 +23,11 @@ import (
 // UpdateObject updates object of class.
 // If the class contains a network ref, it has a side-effect on the schema: The schema will be updated to
 // include this particular network ref class.
+// If precondition is set, the object is only updated if it has one of the
+// expected versions.
 func (m *Manager) UpdateObject(ctx context.Context, principal *models.Principal,
 	class string, id strfmt.UUID, updates *models.Object,
-	repl *additional.ReplicationProperties,
+	repl *additional.ReplicationProperties, precondition *VersionPrecondition,
 ) (*models.Object, error) {
 	path := fmt.Sprintf("objects/%s/%s", class, id)
 	if class == "" {
@@ -45,12 +47,12 @@ func (m *Manager) UpdateObject(ctx context.Context, principal *models.Principal,
 	}
 	defer unlock()
 
-	return m.updateObjectToConnectorAndSchema(ctx, principal, class, id, updates, repl)
+	return m.updateObjectToConnectorAndSchema(ctx, principal, class, id, updates, repl, precondition)
 }
 
 func (m *Manager) updateObjectToConnectorAndSchema(ctx context.Context,
 	principal *models.Principal, className string, id strfmt.UUID, updates *models.Object,
-	repl *additional.ReplicationProperties,
+	repl *additional.ReplicationProperties, precondition *VersionPrecondition,
 ) (*models.Object, error) {
 	if id != updates.ID {
 		return nil, NewErrInvalidUserInput("invalid update: field 'id' is immutable")
@@ -60,6 +62,9 @@ func (m *Manager) updateObjectToConnectorAndSchema(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
+	if err := checkPrecondition(obj, precondition); err != nil {
+		return nil, err
+	}
 
 	m.logger.
 		WithField("object", "kinds_update_requested").
@@ -89,8 +94,13 @@ func (m *Manager) updateObjectToConnectorAndSchema(ctx context.Context,
 	if err != nil {
 		return nil, NewErrInternal("update object: %v", err)
 	}
+	if err := validateVectorDimensions(ctx, m.schemaManager, class, updates.Vector,
+		updates.Vectors); err != nil {
+		return nil, NewErrInvalidUserInput("invalid object: %v", err)
+	}
 
-	err = m.vectorRepo.PutObject(ctx, updates, updates.Vector, repl)
+	err = m.vectorRepo.PutObject(WithPrecondition(ctx, id, precondition),
+		updates, updates.Vector, repl)
 	if err != nil {
 		return nil, fmt.Errorf("put object: %w", err)
 	}
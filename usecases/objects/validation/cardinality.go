@@ -0,0 +1,64 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package validation
+
+import (
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// ErrorTooManyReferences message
+const ErrorTooManyReferences string = "class '%s' with property '%s' allows at most %d reference(s), got %d"
+
+// referenceCardinality makes sure no reference property of the class holds
+// more references than its cardinality allows. If existing is set (merge
+// semantics), the incoming references are appended to the existing ones.
+func referenceCardinality(class *models.Class, props, existing interface{}) error {
+	propsMap, _ := props.(map[string]interface{})
+	existingMap, _ := existing.(map[string]interface{})
+
+	for _, prop := range class.Properties {
+		max := schema.MaxReferences(prop)
+		if max == 0 {
+			continue
+		}
+
+		value, ok := propsMap[prop.Name]
+		if !ok {
+			continue
+		}
+
+		count := CountReferences(value) + CountReferences(existingMap[prop.Name])
+		if count > max {
+			return fmt.Errorf(ErrorTooManyReferences, class.Class, prop.Name, max, count)
+		}
+	}
+
+	return nil
+}
+
+// CountReferences returns the number of references held by the given
+// property value, regardless of whether it was already parsed.
+func CountReferences(value interface{}) int {
+	switch refs := value.(type) {
+	case models.MultipleRef:
+		return len(refs)
+	case []*models.SingleRef:
+		return len(refs)
+	case []interface{}:
+		return len(refs)
+	default:
+		return 0
+	}
+}
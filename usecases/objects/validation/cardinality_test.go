@@ -0,0 +1,82 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package validation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+func TestValidator_ReferenceCardinality(t *testing.T) {
+	maxCount := int64(2)
+	class := &models.Class{
+		Class: "MyClass",
+		Properties: []*models.Property{
+			{Name: "owner", DataType: []string{"Person"}, Cardinality: "one"},
+			{Name: "reviewers", DataType: []string{"Person"}, MaxCount: &maxCount},
+			{Name: "related", DataType: []string{"Person"}},
+			{Name: "name", DataType: schema.DataTypeText.PropString()},
+		},
+	}
+
+	refs := func(n int) models.MultipleRef {
+		out := make(models.MultipleRef, n)
+		for i := range out {
+			out[i] = &models.SingleRef{Beacon: "weaviate://localhost/Person/8c53a8d4-8b61-4e1e-9ae3-ef8cbaac6d5d"}
+		}
+		return out
+	}
+
+	t.Run("within limits", func(t *testing.T) {
+		props := map[string]interface{}{
+			"owner":     refs(1),
+			"reviewers": refs(2),
+			"related":   refs(5),
+			"name":      "foo",
+		}
+		require.Nil(t, referenceCardinality(class, props, nil))
+	})
+
+	t.Run("single valued property with many references", func(t *testing.T) {
+		props := map[string]interface{}{"owner": refs(2)}
+		err := referenceCardinality(class, props, nil)
+		require.NotNil(t, err)
+		assert.Equal(t, "class 'MyClass' with property 'owner' allows at most 1 reference(s), got 2", err.Error())
+	})
+
+	t.Run("more references than max count", func(t *testing.T) {
+		props := map[string]interface{}{"reviewers": []interface{}{
+			map[string]interface{}{}, map[string]interface{}{}, map[string]interface{}{},
+		}}
+		err := referenceCardinality(class, props, nil)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "'reviewers' allows at most 2 reference(s), got 3")
+	})
+
+	t.Run("merge appends to existing references", func(t *testing.T) {
+		props := map[string]interface{}{"reviewers": refs(1)}
+		existing := map[string]interface{}{"reviewers": refs(2)}
+		err := referenceCardinality(class, props, existing)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "got 3")
+	})
+
+	t.Run("merge without touching the property", func(t *testing.T) {
+		props := map[string]interface{}{"name": "bar"}
+		existing := map[string]interface{}{"owner": refs(1)}
+		require.Nil(t, referenceCardinality(class, props, existing))
+	})
+}
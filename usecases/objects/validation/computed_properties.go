@@ -0,0 +1,249 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package validation
+
+import (
+	"fmt"
+	"math"
+	"sync"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/schema/expression"
+)
+
+// ValidateExpression makes sure the expression of a computed property (if
+// set) is valid for the given class: it may only reference existing, non
+// computed properties of a supported data type and its result must match the
+// data type of the property.
+func ValidateExpression(class *models.Class, prop *models.Property) error {
+	if prop.Expression == "" {
+		return nil
+	}
+
+	dataType, ok := schema.AsPrimitive(prop.DataType)
+	if !ok {
+		return fmt.Errorf("property '%s': expression is not supported for data type %v",
+			prop.Name, prop.DataType)
+	}
+	var expected expression.Type
+	switch dataType {
+	case schema.DataTypeText, schema.DataTypeString:
+		expected = expression.TypeText
+	case schema.DataTypeInt, schema.DataTypeNumber:
+		expected = expression.TypeNumber
+	default:
+		return fmt.Errorf("property '%s': expression is not supported for data type %v",
+			prop.Name, prop.DataType)
+	}
+
+	if prop.DefaultValue != nil {
+		return fmt.Errorf("property '%s': defaultValue can not be combined with expression",
+			prop.Name)
+	}
+
+	expr, err := expression.Parse(prop.Expression)
+	if err != nil {
+		return fmt.Errorf("property '%s': %w", prop.Name, err)
+	}
+
+	result, err := expr.Check(func(name string) (expression.Type, error) {
+		return referencedPropertyType(class, prop, name)
+	})
+	if err != nil {
+		return fmt.Errorf("property '%s': %w", prop.Name, err)
+	}
+	if result != expected {
+		return fmt.Errorf("property '%s': expression results in %s, "+
+			"which does not match data type %v", prop.Name, result, prop.DataType)
+	}
+
+	return nil
+}
+
+func referencedPropertyType(class *models.Class, computed *models.Property,
+	name string,
+) (expression.Type, error) {
+	if name == computed.Name {
+		return 0, fmt.Errorf("property '%s' can not reference itself", name)
+	}
+
+	prop, err := schema.GetPropertyByName(class, name)
+	if err != nil {
+		return 0, fmt.Errorf("property '%s' does not exist in class '%s'", name, class.Class)
+	}
+	if prop.Expression != "" {
+		return 0, fmt.Errorf("property '%s' is computed and can not be referenced "+
+			"by another expression", name)
+	}
+
+	dataType, _ := schema.AsPrimitive(prop.DataType)
+	switch dataType {
+	case schema.DataTypeText, schema.DataTypeString:
+		return expression.TypeText, nil
+	case schema.DataTypeInt, schema.DataTypeNumber:
+		return expression.TypeNumber, nil
+	case schema.DataTypeDate:
+		return expression.TypeDate, nil
+	default:
+		return 0, fmt.Errorf("property '%s' of data type %v can not be used in expressions",
+			name, prop.DataType)
+	}
+}
+
+// dropComputedProperties removes the values of computed properties from the
+// incoming properties, as they are replaced by the computed values anyway.
+// This way an object which was read can be written back unchanged.
+func dropComputedProperties(class *models.Class, props interface{}) {
+	propsMap, _ := props.(map[string]interface{})
+	for key := range propsMap {
+		prop, err := schema.GetPropertyByName(class, schema.LowercaseFirstLetter(key))
+		if err != nil {
+			// unknown properties are reported by the property validation
+			continue
+		}
+		if prop.Expression != "" {
+			delete(propsMap, key)
+		}
+	}
+}
+
+// parsedExpressions caches the parsed expressions of computed properties by
+// their source, so they are not parsed again for every object written
+var parsedExpressions sync.Map
+
+func parseExpression(source string) (*expression.Expression, error) {
+	if expr, ok := parsedExpressions.Load(source); ok {
+		return expr.(*expression.Expression), nil
+	}
+
+	expr, err := expression.Parse(source)
+	if err != nil {
+		return nil, err
+	}
+	parsedExpressions.Store(source, expr)
+	return expr, nil
+}
+
+// setComputedValues evaluates the expressions of all computed properties and
+// sets the results on the incoming object, which must already be validated.
+// If fallback is set (merge semantics), properties which are not part of the
+// incoming object are looked up in fallback instead. A computed property whose
+// inputs are not all set is left out, or set to nil on a merge to remove the
+// previous value.
+func setComputedValues(class *models.Class, incoming *models.Object, fallback interface{}) error {
+	var computed []*models.Property
+	for _, prop := range class.Properties {
+		if prop.Expression != "" {
+			computed = append(computed, prop)
+		}
+	}
+	if len(computed) == 0 {
+		return nil
+	}
+
+	props, _ := incoming.Properties.(map[string]interface{})
+	if props == nil {
+		props = map[string]interface{}{}
+	}
+	fallbackMap, _ := fallback.(map[string]interface{})
+
+	inputs := make(map[string]interface{}, len(fallbackMap)+len(props))
+	for key, value := range fallbackMap {
+		inputs[key] = value
+	}
+	for key, value := range props {
+		inputs[key] = value
+	}
+
+	for _, prop := range computed {
+		expr, err := parseExpression(prop.Expression)
+		if err != nil {
+			return fmt.Errorf("property '%s': %w", prop.Name, err)
+		}
+		result, err := expr.Eval(inputs)
+		if err != nil {
+			return fmt.Errorf("property '%s': %w", prop.Name, err)
+		}
+
+		if result == nil {
+			if hasValue(fallbackMap, prop.Name) {
+				props[prop.Name] = nil
+			}
+			continue
+		}
+
+		value, err := computedValue(prop, result)
+		if err != nil {
+			return err
+		}
+		props[prop.Name] = value
+	}
+
+	incoming.Properties = props
+	return nil
+}
+
+// withoutDeletedProperties returns a copy of the existing properties without
+// the ones explicitly set to nil by the incoming properties of a merge
+func withoutDeletedProperties(existing, incoming interface{}) map[string]interface{} {
+	existingMap, _ := existing.(map[string]interface{})
+	incomingMap, _ := incoming.(map[string]interface{})
+
+	remaining := make(map[string]interface{}, len(existingMap))
+	for key, value := range existingMap {
+		remaining[key] = value
+	}
+	for key, value := range incomingMap {
+		if value == nil {
+			delete(remaining, schema.LowercaseFirstLetter(key))
+		}
+	}
+	return remaining
+}
+
+// computedValue converts the result of an expression to the representation
+// of the data type of the property
+func computedValue(prop *models.Property, result interface{}) (interface{}, error) {
+	dataType, _ := schema.AsPrimitive(prop.DataType)
+	switch dataType {
+	case schema.DataTypeInt:
+		f, ok := result.(float64)
+		if !ok {
+			return nil, fmt.Errorf("property '%s': expression result %v is not a number",
+				prop.Name, result)
+		}
+		if f != math.Trunc(f) || f > math.MaxInt64 || f < math.MinInt64 {
+			return nil, fmt.Errorf("property '%s': expression result %v is not an integer "+
+				"(use round, floor or ceil)", prop.Name, f)
+		}
+		return int64(f), nil
+	case schema.DataTypeNumber:
+		f, ok := result.(float64)
+		if !ok {
+			return nil, fmt.Errorf("property '%s': expression result %v is not a number",
+				prop.Name, result)
+		}
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			return nil, fmt.Errorf("property '%s': expression result %v is not a valid number",
+				prop.Name, f)
+		}
+		return f, nil
+	default:
+		s, ok := result.(string)
+		if !ok {
+			return nil, fmt.Errorf("property '%s': expression result %v is not text",
+				prop.Name, result)
+		}
+		return s, nil
+	}
+}
@@ -0,0 +1,291 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package validation
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+func computedTestClass() *models.Class {
+	return &models.Class{
+		Class: "Order",
+		Properties: []*models.Property{
+			{Name: "firstName", DataType: schema.DataTypeText.PropString()},
+			{Name: "lastName", DataType: schema.DataTypeText.PropString()},
+			{Name: "price", DataType: schema.DataTypeNumber.PropString()},
+			{Name: "quantity", DataType: schema.DataTypeInt.PropString()},
+			{Name: "orderedAt", DataType: schema.DataTypeDate.PropString()},
+			{Name: "tags", DataType: schema.DataTypeTextArray.PropString()},
+			{
+				Name: "fullName", DataType: schema.DataTypeText.PropString(),
+				Expression: `concat(firstName, " ", lastName)`,
+			},
+			{
+				Name: "total", DataType: schema.DataTypeNumber.PropString(),
+				Expression: "price * quantity",
+			},
+			{
+				Name: "orderYear", DataType: schema.DataTypeInt.PropString(),
+				Expression: "year(orderedAt)",
+			},
+		},
+	}
+}
+
+func TestValidateExpression(t *testing.T) {
+	type test struct {
+		name        string
+		prop        *models.Property
+		expectedErr string
+	}
+
+	tests := []test{
+		{
+			name: "no expression",
+			prop: &models.Property{Name: "other", DataType: schema.DataTypeText.PropString()},
+		},
+		{
+			name: "valid text expression",
+			prop: &models.Property{
+				Name: "initials", DataType: schema.DataTypeText.PropString(),
+				Expression: "upper(concat(firstName, lastName))",
+			},
+		},
+		{
+			name: "valid int expression",
+			prop: &models.Property{
+				Name: "orderMonth", DataType: schema.DataTypeInt.PropString(),
+				Expression: "month(orderedAt)",
+			},
+		},
+		{
+			name: "unsupported data type of the computed property",
+			prop: &models.Property{
+				Name: "flag", DataType: schema.DataTypeBoolean.PropString(),
+				Expression: "price",
+			},
+			expectedErr: "expression is not supported for data type",
+		},
+		{
+			name: "combined with defaultValue",
+			prop: &models.Property{
+				Name: "other", DataType: schema.DataTypeText.PropString(),
+				Expression: "lower(firstName)", DefaultValue: "foo",
+			},
+			expectedErr: "defaultValue can not be combined with expression",
+		},
+		{
+			name: "syntax error",
+			prop: &models.Property{
+				Name: "other", DataType: schema.DataTypeText.PropString(),
+				Expression: "concat(firstName",
+			},
+			expectedErr: "invalid expression",
+		},
+		{
+			name: "unknown property",
+			prop: &models.Property{
+				Name: "other", DataType: schema.DataTypeText.PropString(),
+				Expression: "lower(middleName)",
+			},
+			expectedErr: "property 'middleName' does not exist",
+		},
+		{
+			name: "self reference",
+			prop: &models.Property{
+				Name: "other", DataType: schema.DataTypeText.PropString(),
+				Expression: "lower(other)",
+			},
+			expectedErr: "can not reference itself",
+		},
+		{
+			name: "reference to a computed property",
+			prop: &models.Property{
+				Name: "other", DataType: schema.DataTypeText.PropString(),
+				Expression: "lower(fullName)",
+			},
+			expectedErr: "property 'fullName' is computed",
+		},
+		{
+			name: "unsupported input data type",
+			prop: &models.Property{
+				Name: "other", DataType: schema.DataTypeText.PropString(),
+				Expression: "concat(tags)",
+			},
+			expectedErr: "can not be used in expressions",
+		},
+		{
+			name: "result does not match the data type",
+			prop: &models.Property{
+				Name: "other", DataType: schema.DataTypeNumber.PropString(),
+				Expression: "concat(price)",
+			},
+			expectedErr: "expression results in text",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := ValidateExpression(computedTestClass(), test.prop)
+			if test.expectedErr == "" {
+				require.Nil(t, err)
+				return
+			}
+			require.NotNil(t, err)
+			assert.Contains(t, err.Error(), test.expectedErr)
+		})
+	}
+}
+
+func TestValidator_ComputedProperties(t *testing.T) {
+	class := computedTestClass()
+
+	t.Run("values are computed on create", func(t *testing.T) {
+		obj := &models.Object{
+			Class: "Order",
+			Properties: map[string]interface{}{
+				"firstName": "Ada",
+				"lastName":  "Lovelace",
+				"price":     json.Number("2.5"),
+				"quantity":  json.Number("4"),
+				"orderedAt": "2023-03-05T14:30:00Z",
+			},
+		}
+
+		err := (&Validator{}).Object(context.Background(), class, obj, nil)
+		require.Nil(t, err)
+
+		props := obj.Properties.(map[string]interface{})
+		assert.Equal(t, "Ada Lovelace", props["fullName"])
+		assert.Equal(t, float64(10), props["total"])
+		assert.Equal(t, int64(2023), props["orderYear"])
+	})
+
+	t.Run("computed properties with missing inputs are left out", func(t *testing.T) {
+		obj := &models.Object{
+			Class:      "Order",
+			Properties: map[string]interface{}{"firstName": "Ada"},
+		}
+
+		err := (&Validator{}).Object(context.Background(), class, obj, nil)
+		require.Nil(t, err)
+
+		assert.Equal(t, map[string]interface{}{"firstName": "Ada"}, obj.Properties)
+	})
+
+	t.Run("explicit values of computed properties are replaced", func(t *testing.T) {
+		obj := &models.Object{
+			Class: "Order",
+			Properties: map[string]interface{}{
+				"firstName": "Ada",
+				"lastName":  "Lovelace",
+				"FullName":  "someone else",
+				"total":     float64(10),
+			},
+		}
+
+		err := (&Validator{}).Object(context.Background(), class, obj, nil)
+		require.Nil(t, err)
+
+		assert.Equal(t, map[string]interface{}{
+			"firstName": "Ada",
+			"lastName":  "Lovelace",
+			"fullName":  "Ada Lovelace",
+		}, obj.Properties)
+	})
+
+	t.Run("an object which was read can be written back", func(t *testing.T) {
+		obj := &models.Object{
+			Class: "Order",
+			Properties: map[string]interface{}{
+				"firstName": "Ada",
+				"lastName":  "Lovelace",
+				"fullName":  "Ada Lovelace",
+			},
+		}
+
+		err := (&Validator{}).Object(context.Background(), class, obj, nil)
+		require.Nil(t, err)
+		assert.Equal(t, "Ada Lovelace", obj.Properties.(map[string]interface{})["fullName"])
+	})
+
+	t.Run("non integer result for int property", func(t *testing.T) {
+		class := computedTestClass()
+		class.Properties = append(class.Properties, &models.Property{
+			Name: "half", DataType: schema.DataTypeInt.PropString(),
+			Expression: "quantity / 2",
+		})
+		obj := &models.Object{
+			Class:      "Order",
+			Properties: map[string]interface{}{"quantity": json.Number("3")},
+		}
+
+		err := (&Validator{}).Object(context.Background(), class, obj, nil)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "is not an integer")
+	})
+
+	t.Run("merge combines incoming and existing values", func(t *testing.T) {
+		existing := &models.Object{
+			Class: "Order",
+			Properties: map[string]interface{}{
+				"firstName": "Ada",
+				"lastName":  "Lovelace",
+				"fullName":  "Ada Lovelace",
+				"price":     2.5,
+				"quantity":  float64(4),
+				"total":     float64(10),
+			},
+		}
+		obj := &models.Object{
+			Class:      "Order",
+			Properties: map[string]interface{}{"lastName": "Byron", "quantity": json.Number("2")},
+		}
+
+		err := (&Validator{}).ObjectMerge(context.Background(), class, obj, existing)
+		require.Nil(t, err)
+
+		assert.Equal(t, map[string]interface{}{
+			"lastName": "Byron",
+			"quantity": int64(2),
+			"fullName": "Ada Byron",
+			"total":    float64(5),
+		}, obj.Properties)
+	})
+
+	t.Run("merge deleting an input removes the computed value", func(t *testing.T) {
+		existing := &models.Object{
+			Class: "Order",
+			Properties: map[string]interface{}{
+				"firstName": "Ada",
+				"lastName":  "Lovelace",
+				"fullName":  "Ada Lovelace",
+			},
+		}
+		obj := &models.Object{
+			Class:      "Order",
+			Properties: map[string]interface{}{"lastName": nil},
+		}
+
+		err := (&Validator{}).ObjectMerge(context.Background(), class, obj, existing)
+		require.Nil(t, err)
+
+		assert.Equal(t, map[string]interface{}{"fullName": nil}, obj.Properties)
+	})
+}
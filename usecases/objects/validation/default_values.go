@@ -0,0 +1,116 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package validation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// ValidateDefaultValue makes sure the defaultValue of a property (if set)
+// matches the declared data type of that property
+func ValidateDefaultValue(ctx context.Context, className string, prop *models.Property) error {
+	if prop.DefaultValue == nil {
+		return nil
+	}
+
+	if len(prop.DataType) == 0 || schema.IsRefDataType(prop.DataType) {
+		return fmt.Errorf("property '%s': defaultValue is not supported for cross-references",
+			prop.Name)
+	}
+
+	dataType, ok := schema.AsPrimitive(prop.DataType)
+	if !ok || dataType == "" {
+		return fmt.Errorf("property '%s': defaultValue is not supported for data type %v",
+			prop.Name, prop.DataType)
+	}
+
+	v := &Validator{}
+	var err error
+	if schema.IsNested(dataType) {
+		_, err = v.extractAndValidateNestedProperty(ctx, prop.Name,
+			copyDefaultValue(prop.DefaultValue), className, dataType, prop.NestedProperties)
+	} else {
+		_, err = v.extractAndValidateProperty(ctx, prop.Name,
+			copyDefaultValue(prop.DefaultValue), className, &dataType)
+	}
+	if err != nil {
+		return fmt.Errorf("invalid defaultValue: %w", err)
+	}
+
+	return nil
+}
+
+// setDefaultValues populates all properties missing on the incoming object
+// with the defaultValue of the respective class property. The values are
+// validated along with all other properties afterwards
+func setDefaultValues(class *models.Class, incoming *models.Object) error {
+	var props map[string]interface{}
+	if incoming.Properties == nil {
+		props = map[string]interface{}{}
+	} else {
+		asMap, ok := incoming.Properties.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf(ErrorInvalidProperties, incoming.Properties)
+		}
+		props = asMap
+	}
+
+	present := make(map[string]struct{}, len(props))
+	for key, value := range props {
+		if value != nil {
+			present[schema.LowercaseFirstLetter(key)] = struct{}{}
+		}
+	}
+
+	set := false
+	for _, prop := range class.Properties {
+		if prop.DefaultValue == nil {
+			continue
+		}
+		if _, ok := present[prop.Name]; ok {
+			continue
+		}
+
+		props[prop.Name] = copyDefaultValue(prop.DefaultValue)
+		set = true
+	}
+
+	if set {
+		incoming.Properties = props
+	}
+	return nil
+}
+
+// copyDefaultValue copies slices and maps of a default value, as property
+// validation may alter the given value in place. Other values are immutable
+func copyDefaultValue(in interface{}) interface{} {
+	switch typed := in.(type) {
+	case []interface{}:
+		out := make([]interface{}, len(typed))
+		for i := range typed {
+			out[i] = copyDefaultValue(typed[i])
+		}
+		return out
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(typed))
+		for key, value := range typed {
+			out[key] = copyDefaultValue(value)
+		}
+		return out
+	default:
+		return in
+	}
+}
@@ -0,0 +1,137 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package validation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+func TestValidateDefaultValue(t *testing.T) {
+	type test struct {
+		name        string
+		prop        *models.Property
+		expectedErr string
+	}
+
+	tests := []test{
+		{
+			name: "no default",
+			prop: &models.Property{Name: "name", DataType: schema.DataTypeText.PropString()},
+		},
+		{
+			name: "valid text default",
+			prop: &models.Property{
+				Name: "name", DataType: schema.DataTypeText.PropString(),
+				DefaultValue: "unknown",
+			},
+		},
+		{
+			name: "valid number array default",
+			prop: &models.Property{
+				Name: "scores", DataType: schema.DataTypeNumberArray.PropString(),
+				DefaultValue: []interface{}{float64(1), float64(2.5)},
+			},
+		},
+		{
+			name: "int default with decimals",
+			prop: &models.Property{
+				Name: "count", DataType: schema.DataTypeInt.PropString(),
+				DefaultValue: float64(1.5),
+			},
+			expectedErr: "invalid defaultValue",
+		},
+		{
+			name: "default on a reference",
+			prop: &models.Property{
+				Name: "ofCity", DataType: []string{"City"},
+				DefaultValue: "foo",
+			},
+			expectedErr: "not supported for cross-references",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := ValidateDefaultValue(context.Background(), "MyClass", test.prop)
+			if test.expectedErr == "" {
+				require.Nil(t, err)
+				return
+			}
+			require.NotNil(t, err)
+			assert.Contains(t, err.Error(), test.expectedErr)
+		})
+	}
+}
+
+func TestValidator_DefaultValues(t *testing.T) {
+	defaultTags := []interface{}{"a", "b"}
+	class := &models.Class{
+		Class: "MyClass",
+		Properties: []*models.Property{
+			{Name: "name", DataType: schema.DataTypeText.PropString(), DefaultValue: "unknown"},
+			{Name: "count", DataType: schema.DataTypeInt.PropString(), DefaultValue: float64(3)},
+			{Name: "tags", DataType: schema.DataTypeTextArray.PropString(), DefaultValue: defaultTags},
+			{Name: "description", DataType: schema.DataTypeText.PropString()},
+		},
+	}
+
+	t.Run("missing properties are populated on create", func(t *testing.T) {
+		obj := &models.Object{
+			Class:      "MyClass",
+			Properties: map[string]interface{}{"Name": "foo", "count": nil},
+		}
+
+		err := (&Validator{}).Object(context.Background(), class, obj, nil)
+		require.Nil(t, err)
+
+		assert.Equal(t, map[string]interface{}{
+			"name":  "foo",
+			"count": float64(3),
+			"tags":  []interface{}{"a", "b"},
+		}, obj.Properties)
+	})
+
+	t.Run("defaults are populated without any properties", func(t *testing.T) {
+		obj := &models.Object{Class: "MyClass"}
+
+		err := (&Validator{}).Object(context.Background(), class, obj, nil)
+		require.Nil(t, err)
+
+		assert.Equal(t, map[string]interface{}{
+			"name":  "unknown",
+			"count": float64(3),
+			"tags":  []interface{}{"a", "b"},
+		}, obj.Properties)
+	})
+
+	t.Run("defaults are not applied to existing objects", func(t *testing.T) {
+		obj := &models.Object{
+			Class:      "MyClass",
+			Properties: map[string]interface{}{"name": "foo"},
+		}
+
+		err := (&Validator{}).Object(context.Background(), class, obj, &models.Object{})
+		require.Nil(t, err)
+
+		assert.Equal(t, map[string]interface{}{"name": "foo"}, obj.Properties)
+	})
+
+	t.Run("schema default is not shared with objects", func(t *testing.T) {
+		assert.Equal(t, []interface{}{"a", "b"}, defaultTags)
+	})
+}
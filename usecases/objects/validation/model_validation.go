@@ -84,7 +84,76 @@ func (v *Validator) Object(ctx context.Context, class *models.Class,
 		return err
 	}
 
-	return v.properties(ctx, class, incoming, existing)
+	dropComputedProperties(class, incoming.Properties)
+
+	if existing == nil {
+		// only newly created objects are populated with default values
+		if err := setDefaultValues(class, incoming); err != nil {
+			return err
+		}
+	}
+
+	if err := setDefaultCountries(class, incoming.Properties, nil); err != nil {
+		return err
+	}
+
+	if err := v.properties(ctx, class, incoming, existing); err != nil {
+		return err
+	}
+
+	if err := referenceCardinality(class, incoming.Properties, nil); err != nil {
+		return err
+	}
+
+	if err := setComputedValues(class, incoming, nil); err != nil {
+		return err
+	}
+
+	return requiredProperties(class, incoming.Properties, nil)
+}
+
+// ObjectMerge validates a partial update of the existing object. Contrary
+// to Object, required properties may be omitted from the incoming object as
+// long as they are set on the existing one
+func (v *Validator) ObjectMerge(ctx context.Context, class *models.Class,
+	incoming *models.Object, existing *models.Object,
+) error {
+	if err := validateClass(incoming.Class); err != nil {
+		return err
+	}
+
+	if err := requiredPropertiesNotDeleted(class, incoming.Properties); err != nil {
+		return err
+	}
+
+	dropComputedProperties(class, incoming.Properties)
+
+	// computed properties are evaluated on the merged object, excluding the
+	// properties deleted by this merge. Deletions are dropped from the
+	// incoming properties during validation, so they are collected upfront
+	var existingProps interface{}
+	if existing != nil {
+		existingProps = existing.Properties
+	}
+	remainingProps := withoutDeletedProperties(existingProps, incoming.Properties)
+
+	if err := setDefaultCountries(class, incoming.Properties, remainingProps); err != nil {
+		return err
+	}
+
+	if err := v.properties(ctx, class, incoming, existing); err != nil {
+		return err
+	}
+
+	if err := referenceCardinality(class, incoming.Properties, remainingProps); err != nil {
+		return err
+	}
+
+	if err := setComputedValues(class, incoming, remainingProps); err != nil {
+		return err
+	}
+
+	return requiredProperties(class, incoming.Properties, existingProps)
 }
 
 func validateClass(class string) error {
@@ -0,0 +1,101 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package validation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// extractAndValidateNestedProperty validates an object or object[] value
+// against the nestedProperties definition of the property. The propertyName
+// is the full path of the value (e.g. "address.city") to produce meaningful
+// error messages
+func (v *Validator) extractAndValidateNestedProperty(ctx context.Context, propertyName string,
+	pv interface{}, className string, dataType schema.DataType,
+	nestedProps []*models.NestedProperty,
+) (interface{}, error) {
+	switch dataType {
+	case schema.DataTypeObject:
+		return v.nestedObjectVal(ctx, propertyName, pv, className, nestedProps)
+	case schema.DataTypeObjectArray:
+		typed, ok := pv.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("invalid object array property '%s' on class '%s': "+
+				"not an array, but %T", propertyName, className, pv)
+		}
+
+		out := make([]interface{}, len(typed))
+		for i := range typed {
+			data, err := v.nestedObjectVal(ctx, fmt.Sprintf("%s[%d]", propertyName, i),
+				typed[i], className, nestedProps)
+			if err != nil {
+				return nil, err
+			}
+			out[i] = data
+		}
+		return out, nil
+	default:
+		return nil, fmt.Errorf("unrecognized nested data type '%s'", dataType)
+	}
+}
+
+func (v *Validator) nestedObjectVal(ctx context.Context, propertyName string,
+	pv interface{}, className string, nestedProps []*models.NestedProperty,
+) (map[string]interface{}, error) {
+	typed, ok := pv.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("invalid object property '%s' on class '%s': "+
+			"not an object, but %T", propertyName, className, pv)
+	}
+
+	out := make(map[string]interface{}, len(typed))
+	for key, value := range typed {
+		if value == nil {
+			continue // nil values are removed and filtered out
+		}
+
+		path := propertyName + "." + key
+		nestedProp := schema.GetNestedPropertyByName(nestedProps, key)
+		if nestedProp == nil {
+			return nil, fmt.Errorf("invalid object property '%s' on class '%s': "+
+				"no such nested property '%s'", propertyName, className, key)
+		}
+
+		dataType, ok := schema.AsPrimitive(nestedProp.DataType)
+		if !ok || dataType == "" {
+			return nil, fmt.Errorf("invalid object property '%s' on class '%s': "+
+				"unsupported data type %v", path, className, nestedProp.DataType)
+		}
+
+		var (
+			data interface{}
+			err  error
+		)
+		if schema.IsNested(dataType) {
+			data, err = v.extractAndValidateNestedProperty(ctx, path, value, className,
+				dataType, nestedProp.NestedProperties)
+		} else {
+			data, err = v.extractAndValidateProperty(ctx, path, value, className, &dataType)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		out[key] = data
+	}
+
+	return out, nil
+}
@@ -0,0 +1,120 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package validation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+func TestValidator_NestedProperties(t *testing.T) {
+	nestedProps := []*models.NestedProperty{
+		{Name: "city", DataType: schema.DataTypeText.PropString()},
+		{Name: "zip", DataType: schema.DataTypeInt.PropString()},
+		{
+			Name:     "tags",
+			DataType: schema.DataTypeObjectArray.PropString(),
+			NestedProperties: []*models.NestedProperty{
+				{Name: "label", DataType: schema.DataTypeText.PropString()},
+			},
+		},
+	}
+
+	type test struct {
+		name        string
+		dataType    schema.DataType
+		value       interface{}
+		expected    interface{}
+		expectedErr string
+	}
+
+	tests := []test{
+		{
+			name:     "valid object",
+			dataType: schema.DataTypeObject,
+			value: map[string]interface{}{
+				"city": "Amsterdam",
+				"zip":  float64(1011),
+				"tags": []interface{}{
+					map[string]interface{}{"label": "capital"},
+				},
+			},
+			expected: map[string]interface{}{
+				"city": "Amsterdam",
+				"zip":  float64(1011),
+				"tags": []interface{}{
+					map[string]interface{}{"label": "capital"},
+				},
+			},
+		},
+		{
+			name:     "valid object array",
+			dataType: schema.DataTypeObjectArray,
+			value: []interface{}{
+				map[string]interface{}{"city": "Amsterdam"},
+				map[string]interface{}{"city": "Berlin", "zip": nil},
+			},
+			expected: []interface{}{
+				map[string]interface{}{"city": "Amsterdam"},
+				map[string]interface{}{"city": "Berlin"},
+			},
+		},
+		{
+			name:        "unknown nested property",
+			dataType:    schema.DataTypeObject,
+			value:       map[string]interface{}{"country": "NL"},
+			expectedErr: "no such nested property 'country'",
+		},
+		{
+			name:        "wrong nested type",
+			dataType:    schema.DataTypeObject,
+			value:       map[string]interface{}{"zip": "1011AB"},
+			expectedErr: "invalid integer property 'address.zip'",
+		},
+		{
+			name:     "wrong type in deeply nested property",
+			dataType: schema.DataTypeObject,
+			value: map[string]interface{}{
+				"tags": []interface{}{
+					map[string]interface{}{"label": true},
+				},
+			},
+			expectedErr: "invalid text property 'address.tags[0].label'",
+		},
+		{
+			name:        "not an object",
+			dataType:    schema.DataTypeObject,
+			value:       "Amsterdam",
+			expectedErr: "not an object",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			v := &Validator{}
+			res, err := v.extractAndValidateNestedProperty(context.Background(), "address",
+				test.value, "NestedClass", test.dataType, nestedProps)
+			if test.expectedErr != "" {
+				require.NotNil(t, err)
+				assert.Contains(t, err.Error(), test.expectedErr)
+				return
+			}
+			require.Nil(t, err)
+			assert.Equal(t, test.expected, res)
+		})
+	}
+}
@@ -17,6 +17,7 @@ import (
 
 	"github.com/nyaruka/phonenumbers"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
 )
 
 func parsePhoneNumber(input, defaultCountry string) (*models.PhoneNumber, error) {
@@ -41,3 +42,84 @@ func parsePhoneNumber(input, defaultCountry string) (*models.PhoneNumber, error)
 		Valid:                  phonenumbers.IsValidNumber(num),
 	}, nil
 }
+
+// ValidateDefaultCountryProperty makes sure the default country property of
+// prop (if set) refers to another text property of the given class
+func ValidateDefaultCountryProperty(class *models.Class, prop *models.Property) error {
+	if prop.DefaultCountryProperty == "" {
+		return nil
+	}
+
+	if dt, ok := schema.AsPrimitive(prop.DataType); !ok || dt != schema.DataTypePhoneNumber {
+		return fmt.Errorf("property '%s': defaultCountryProperty is only allowed for "+
+			"data type phoneNumber", prop.Name)
+	}
+	if prop.DefaultCountryProperty == prop.Name {
+		return fmt.Errorf("property '%s' can not be its own defaultCountryProperty", prop.Name)
+	}
+
+	country, err := schema.GetPropertyByName(class, prop.DefaultCountryProperty)
+	if err != nil {
+		return fmt.Errorf("property '%s': defaultCountryProperty '%s' does not exist in class '%s'",
+			prop.Name, prop.DefaultCountryProperty, class.Class)
+	}
+	switch dt, _ := schema.AsPrimitive(country.DataType); dt {
+	case schema.DataTypeText, schema.DataTypeString:
+		return nil
+	default:
+		return fmt.Errorf("property '%s': defaultCountryProperty '%s' must be of data type text, "+
+			"got %v", prop.Name, country.Name, country.DataType)
+	}
+}
+
+// setDefaultCountries sets the default country of every incoming phone number
+// whose property has a defaultCountryProperty, unless the phone number
+// specifies a default country itself. If fallback is set (merge semantics),
+// the country is looked up in fallback when it is not part of the incoming
+// properties.
+func setDefaultCountries(class *models.Class, props, fallback interface{}) error {
+	propsMap, _ := props.(map[string]interface{})
+	fallbackMap, _ := fallback.(map[string]interface{})
+
+	for key, value := range propsMap {
+		phone, ok := value.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		prop, err := schema.GetPropertyByName(class, schema.LowercaseFirstLetter(key))
+		if err != nil || prop.DefaultCountryProperty == "" {
+			continue
+		}
+		if country, ok := phone["defaultCountry"]; ok && country != "" {
+			continue
+		}
+
+		country, ok := incomingValue(propsMap, prop.DefaultCountryProperty)
+		if !ok {
+			country = fallbackMap[prop.DefaultCountryProperty]
+		}
+		if country == nil {
+			continue
+		}
+		countryString, ok := country.(string)
+		if !ok {
+			return fmt.Errorf("invalid phoneNumber property '%s' on class '%s': "+
+				"defaultCountryProperty '%s' must be a string, but got: %T",
+				prop.Name, class.Class, prop.DefaultCountryProperty, country)
+		}
+		phone["defaultCountry"] = countryString
+	}
+
+	return nil
+}
+
+// incomingValue looks up the value of the property called name, the first
+// letter of incoming property names is not necessarily lower case yet
+func incomingValue(props map[string]interface{}, name string) (interface{}, bool) {
+	for key, value := range props {
+		if schema.LowercaseFirstLetter(key) == name {
+			return value, true
+		}
+	}
+	return nil, false
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/usecases/config"
 )
 
@@ -179,3 +180,169 @@ func TestPropertyOfTypePhoneNumberValidation(t *testing.T) {
 		})
 	}
 }
+
+func defaultCountryTestClass() *models.Class {
+	return &models.Class{
+		Class: "Contact",
+		Properties: []*models.Property{
+			{Name: "countryCode", DataType: schema.DataTypeText.PropString()},
+			{Name: "age", DataType: schema.DataTypeInt.PropString()},
+			{
+				Name: "phone", DataType: schema.DataTypePhoneNumber.PropString(),
+				DefaultCountryProperty: "countryCode",
+			},
+		},
+	}
+}
+
+func TestValidateDefaultCountryProperty(t *testing.T) {
+	type test struct {
+		name        string
+		prop        *models.Property
+		expectedErr string
+	}
+
+	tests := []test{
+		{
+			name: "no default country property",
+			prop: &models.Property{Name: "other", DataType: schema.DataTypePhoneNumber.PropString()},
+		},
+		{
+			name: "valid default country property",
+			prop: &models.Property{
+				Name: "other", DataType: schema.DataTypePhoneNumber.PropString(),
+				DefaultCountryProperty: "countryCode",
+			},
+		},
+		{
+			name: "not a phone number",
+			prop: &models.Property{
+				Name: "other", DataType: schema.DataTypeText.PropString(),
+				DefaultCountryProperty: "countryCode",
+			},
+			expectedErr: "only allowed for data type phoneNumber",
+		},
+		{
+			name: "self reference",
+			prop: &models.Property{
+				Name: "other", DataType: schema.DataTypePhoneNumber.PropString(),
+				DefaultCountryProperty: "other",
+			},
+			expectedErr: "can not be its own defaultCountryProperty",
+		},
+		{
+			name: "unknown property",
+			prop: &models.Property{
+				Name: "other", DataType: schema.DataTypePhoneNumber.PropString(),
+				DefaultCountryProperty: "country",
+			},
+			expectedErr: "defaultCountryProperty 'country' does not exist",
+		},
+		{
+			name: "property is not text",
+			prop: &models.Property{
+				Name: "other", DataType: schema.DataTypePhoneNumber.PropString(),
+				DefaultCountryProperty: "age",
+			},
+			expectedErr: "must be of data type text",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := ValidateDefaultCountryProperty(defaultCountryTestClass(), test.prop)
+			if test.expectedErr == "" {
+				require.Nil(t, err)
+				return
+			}
+			require.NotNil(t, err)
+			assert.Contains(t, err.Error(), test.expectedErr)
+		})
+	}
+}
+
+func TestValidator_DefaultCountryProperty(t *testing.T) {
+	class := defaultCountryTestClass()
+
+	t.Run("country is taken from the sibling property", func(t *testing.T) {
+		obj := &models.Object{
+			Class: "Contact",
+			Properties: map[string]interface{}{
+				"countryCode": "de",
+				"phone":       map[string]interface{}{"input": "0171 1234567"},
+			},
+		}
+
+		err := (&Validator{}).Object(context.Background(), class, obj, nil)
+		require.Nil(t, err)
+
+		phone := obj.Properties.(map[string]interface{})["phone"].(*models.PhoneNumber)
+		assert.Equal(t, "DE", phone.DefaultCountry)
+		assert.Equal(t, "+49 171 1234567", phone.InternationalFormatted)
+	})
+
+	t.Run("explicit default country takes precedence", func(t *testing.T) {
+		obj := &models.Object{
+			Class: "Contact",
+			Properties: map[string]interface{}{
+				"countryCode": "de",
+				"phone": map[string]interface{}{
+					"input": "020 7946 0018", "defaultCountry": "gb",
+				},
+			},
+		}
+
+		err := (&Validator{}).Object(context.Background(), class, obj, nil)
+		require.Nil(t, err)
+
+		phone := obj.Properties.(map[string]interface{})["phone"].(*models.PhoneNumber)
+		assert.Equal(t, "GB", phone.DefaultCountry)
+		assert.Equal(t, uint64(44), phone.CountryCode)
+	})
+
+	t.Run("national number without country fails", func(t *testing.T) {
+		obj := &models.Object{
+			Class: "Contact",
+			Properties: map[string]interface{}{
+				"phone": map[string]interface{}{"input": "0171 1234567"},
+			},
+		}
+
+		err := (&Validator{}).Object(context.Background(), class, obj, nil)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "invalid or missing defaultCountry")
+	})
+
+	t.Run("merge takes the country from the existing object", func(t *testing.T) {
+		existing := &models.Object{
+			Class:      "Contact",
+			Properties: map[string]interface{}{"countryCode": "de"},
+		}
+		obj := &models.Object{
+			Class: "Contact",
+			Properties: map[string]interface{}{
+				"phone": map[string]interface{}{"input": "0171 1234567"},
+			},
+		}
+
+		err := (&Validator{}).ObjectMerge(context.Background(), class, obj, existing)
+		require.Nil(t, err)
+
+		phone := obj.Properties.(map[string]interface{})["phone"].(*models.PhoneNumber)
+		assert.Equal(t, "DE", phone.DefaultCountry)
+	})
+
+	t.Run("country of wrong type", func(t *testing.T) {
+		obj := &models.Object{
+			Class: "Contact",
+			Properties: map[string]interface{}{
+				"countryCode": 49,
+				"phone":       map[string]interface{}{"input": "0171 1234567"},
+			},
+		}
+
+		err := (&Validator{}).Object(context.Background(), class, obj, nil)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "defaultCountryProperty 'countryCode' must be a string")
+	})
+}
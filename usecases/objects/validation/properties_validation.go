@@ -16,6 +16,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
@@ -115,9 +116,22 @@ func (v *Validator) properties(ctx context.Context, class *models.Class,
 			}
 		}
 
-		data, err := v.extractAndValidateProperty(ctx, propertyKeyLowerCase, propertyValue, className, dataType)
-		if err != nil {
-			return err
+		var data interface{}
+		if schema.IsNested(*dataType) {
+			prop, err := schema.GetPropertyByName(class, propertyKeyLowerCase)
+			if err != nil {
+				return err
+			}
+			data, err = v.extractAndValidateNestedProperty(ctx, propertyKeyLowerCase,
+				propertyValue, className, *dataType, prop.NestedProperties)
+			if err != nil {
+				return err
+			}
+		} else {
+			data, err = v.extractAndValidateProperty(ctx, propertyKeyLowerCase, propertyValue, className, dataType)
+			if err != nil {
+				return err
+			}
 		}
 
 		returnSchema[propertyKeyLowerCase] = data
@@ -178,11 +192,36 @@ func (v *Validator) extractAndValidateProperty(ctx context.Context, propertyName
 		if err != nil {
 			return nil, fmt.Errorf("invalid date property '%s' on class '%s': %s", propertyName, className, err)
 		}
+	case schema.DataTypeDuration:
+		data, err = durationVal(pv)
+		if err != nil {
+			return nil, fmt.Errorf("invalid duration property '%s' on class '%s': %s", propertyName, className, err)
+		}
+	case schema.DataTypeIP:
+		data, err = ipVal(pv)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ip property '%s' on class '%s': %s", propertyName, className, err)
+		}
+	case schema.DataTypeDecimal:
+		data, err = decimalVal(pv)
+		if err != nil {
+			return nil, fmt.Errorf("invalid decimal property '%s' on class '%s': %s", propertyName, className, err)
+		}
 	case schema.DataTypeGeoCoordinates:
 		data, err = geoCoordinates(pv)
 		if err != nil {
 			return nil, fmt.Errorf("invalid geoCoordinates property '%s' on class '%s': %s", propertyName, className, err)
 		}
+	case schema.DataTypeGeoPolygon:
+		data, err = geoPolygon(pv)
+		if err != nil {
+			return nil, fmt.Errorf("invalid geoPolygon property '%s' on class '%s': %s", propertyName, className, err)
+		}
+	case schema.DataTypeGeoShape:
+		data, err = geoShape(pv)
+		if err != nil {
+			return nil, fmt.Errorf("invalid geoShape property '%s' on class '%s': %s", propertyName, className, err)
+		}
 	case schema.DataTypePhoneNumber:
 		data, err = phoneNumber(pv)
 		if err != nil {
@@ -223,6 +262,21 @@ func (v *Validator) extractAndValidateProperty(ctx context.Context, propertyName
 		if err != nil {
 			return nil, fmt.Errorf("invalid uuid array property '%s' on class '%s': %s", propertyName, className, err)
 		}
+	case schema.DataTypeVector:
+		data, err = vectorVal(pv)
+		if err != nil {
+			return nil, fmt.Errorf("invalid vector property '%s' on class '%s': %s", propertyName, className, err)
+		}
+	case schema.DataTypeVectorArray:
+		data, err = vectorArrayVal(pv)
+		if err != nil {
+			return nil, fmt.Errorf("invalid vector array property '%s' on class '%s': %s", propertyName, className, err)
+		}
+	case schema.DataTypeSparseVector:
+		data, err = sparseVectorVal(pv)
+		if err != nil {
+			return nil, fmt.Errorf("invalid sparseVector property '%s' on class '%s': %s", propertyName, className, err)
+		}
 	// deprecated string
 	case schema.DataTypeString:
 		data, err = stringVal(pv)
@@ -313,6 +367,63 @@ func dateVal(val interface{}) (time.Time, error) {
 	return data, nil
 }
 
+// durationVal returns the duration in its canonical form, so it is stored
+// the same way regardless of the notation it was specified in
+func durationVal(val interface{}) (string, error) {
+	durationString, ok := val.(string)
+	if !ok {
+		return "", fmt.Errorf("requires a string with an ISO-8601 or Go duration, "+
+			"but the given value is '%v'", val)
+	}
+
+	d, err := schema.ParseDuration(durationString)
+	if err != nil {
+		return "", err
+	}
+	return d.String(), nil
+}
+
+// ipVal returns the address in its canonical form, e.g. with IPv6 zeros
+// compressed
+func ipVal(val interface{}) (string, error) {
+	ipString, ok := val.(string)
+	if !ok {
+		return "", fmt.Errorf("requires a string with an IPv4 or IPv6 address, "+
+			"but the given value is '%v'", val)
+	}
+
+	addr, err := schema.ParseIP(ipString)
+	if err != nil {
+		return "", err
+	}
+	return addr.String(), nil
+}
+
+// decimalVal returns the decimal in its normalized plain notation. It should be
+// specified as a string, as JSON numbers are usually parsed as float64 by
+// clients. A JSON number is still accepted though, formatted with the fewest
+// digits that represent it.
+func decimalVal(val interface{}) (string, error) {
+	var decimalString string
+	switch v := val.(type) {
+	case string:
+		decimalString = v
+	case json.Number:
+		decimalString = v.String()
+	case float64:
+		decimalString = strconv.FormatFloat(v, 'g', -1, 64)
+	default:
+		return "", fmt.Errorf("requires a string with a decimal number, "+
+			"but the given value is '%v'", val)
+	}
+
+	d, err := schema.ParseDecimal(decimalString)
+	if err != nil {
+		return "", err
+	}
+	return d.String(), nil
+}
+
 func intVal(val interface{}) (interface{}, error) {
 	var data interface{}
 	var ok bool
@@ -399,6 +510,112 @@ func geoCoordinates(input interface{}) (*models.GeoCoordinates, error) {
 	}, nil
 }
 
+func geoPolygon(input interface{}) (*models.GeoPolygon, error) {
+	inputMap, ok := input.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("geoPolygon must be a map, but got: %T", input)
+	}
+
+	coordinates, err := geoCoordinatesList(inputMap["coordinates"])
+	if err != nil {
+		return nil, err
+	}
+
+	polygon := &models.GeoPolygon{Coordinates: coordinates}
+	if _, err := schema.ParseGeoShape(schema.DataTypeGeoPolygon, polygon); err != nil {
+		return nil, err
+	}
+	return polygon, nil
+}
+
+func geoShape(input interface{}) (*models.GeoShape, error) {
+	inputMap, ok := input.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("geoShape must be a map, but got: %T", input)
+	}
+
+	shapeType, ok := inputMap["type"].(string)
+	if !ok {
+		return nil, fmt.Errorf("geoShape is missing required field 'type'")
+	}
+
+	coordinates, err := geoCoordinatesList(inputMap["coordinates"])
+	if err != nil {
+		return nil, err
+	}
+
+	shape := &models.GeoShape{Type: shapeType, Coordinates: coordinates}
+	if _, err := schema.ParseGeoShape(schema.DataTypeGeoShape, shape); err != nil {
+		return nil, err
+	}
+	return shape, nil
+}
+
+func sparseVectorVal(input interface{}) (*models.SparseVector, error) {
+	if parsed, ok := input.(*models.SparseVector); ok {
+		return schema.ParseSparseVector(parsed)
+	}
+
+	inputMap, ok := input.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("sparseVector must be a map, but got: %T", input)
+	}
+
+	rawIndices, ok := inputMap["indices"].([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("sparseVector is missing required field 'indices'")
+	}
+	rawValues, ok := inputMap["values"].([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("sparseVector is missing required field 'values'")
+	}
+
+	indices := make([]int64, len(rawIndices))
+	for i := range rawIndices {
+		index, err := intVal(rawIndices[i])
+		if err != nil {
+			return nil, fmt.Errorf("invalid sparseVector index at pos %d: %s", i, err)
+		}
+		switch typed := index.(type) {
+		case int64:
+			indices[i] = typed
+		case float64:
+			indices[i] = int64(typed)
+		}
+	}
+
+	values := make([]float32, len(rawValues))
+	for i := range rawValues {
+		value, err := numberVal(rawValues[i])
+		if err != nil {
+			return nil, fmt.Errorf("invalid sparseVector value at pos %d: %s", i, err)
+		}
+		values[i] = float32(value.(float64))
+	}
+
+	return schema.ParseSparseVector(&models.SparseVector{Indices: indices, Values: values})
+}
+
+func geoCoordinatesList(input interface{}) ([]*models.GeoCoordinates, error) {
+	if input == nil {
+		return nil, fmt.Errorf("missing required field 'coordinates'")
+	}
+	list, ok := input.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("coordinates must be a list, but got: %T", input)
+	}
+
+	coordinates := make([]*models.GeoCoordinates, len(list))
+	for i := range list {
+		c, err := geoCoordinates(list[i])
+		if err != nil {
+			return nil, fmt.Errorf("coordinate %d: %s", i, err)
+		}
+		coordinates[i] = c
+	}
+	return coordinates, nil
+}
+
 func ptFloat32(in float32) *float32 {
 	return &in
 }
@@ -454,6 +671,14 @@ func blobVal(val interface{}) (string, error) {
 		return "", fmt.Errorf("not a blob base64 string, but %T", val)
 	}
 
+	// large blobs are stored out-of-band and referenced by their digest
+	if schema.IsBlobReference(typed) {
+		if _, err := schema.ParseBlobReference(typed); err != nil {
+			return "", err
+		}
+		return typed, nil
+	}
+
 	base64Regex := regexp.MustCompile(`^(?:[A-Za-z0-9+/]{4})*(?:[A-Za-z0-9+/]{2}==|[A-Za-z0-9+/]{3}=|[A-Za-z0-9+/]{4})$`)
 	ok = base64Regex.MatchString(typed)
 	if !ok {
@@ -640,3 +865,60 @@ func ParseUUIDArray(in any) ([]uuid.UUID, error) {
 
 	return d, nil
 }
+
+func vectorVal(val interface{}) ([]float32, error) {
+	if parsed, ok := val.([]float32); ok {
+		if len(parsed) == 0 {
+			return nil, fmt.Errorf("vector must not be empty")
+		}
+		return parsed, nil
+	}
+
+	typed, ok := val.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("not a vector, but %T", val)
+	}
+	if len(typed) == 0 {
+		return nil, fmt.Errorf("vector must not be empty")
+	}
+
+	out := make([]float32, len(typed))
+	for i := range typed {
+		data, err := numberVal(typed[i])
+		if err != nil {
+			return nil, fmt.Errorf("invalid vector value at pos %d: %v", i, typed[i])
+		}
+		out[i] = float32(data.(float64))
+	}
+
+	return out, nil
+}
+
+func vectorArrayVal(val interface{}) ([][]float32, error) {
+	typed, ok := val.([]interface{})
+	if !ok {
+		if parsed, ok := val.([][]float32); ok {
+			typed = make([]interface{}, len(parsed))
+			for i := range parsed {
+				typed[i] = parsed[i]
+			}
+		} else {
+			return nil, fmt.Errorf("not a vector array, but %T", val)
+		}
+	}
+
+	out := make([][]float32, len(typed))
+	for i := range typed {
+		vec, err := vectorVal(typed[i])
+		if err != nil {
+			return nil, fmt.Errorf("invalid vector array value at pos %d: %s", i, err)
+		}
+		if i > 0 && len(vec) != len(out[0]) {
+			return nil, fmt.Errorf("vector array values must have the same dimensions, "+
+				"got %d at pos 0 and %d at pos %d", len(out[0]), len(vec), i)
+		}
+		out[i] = vec
+	}
+
+	return out, nil
+}
@@ -13,7 +13,9 @@ package validation
 
 import (
 	"context"
+	"encoding/json"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/weaviate/weaviate/entities/models"
@@ -123,6 +125,378 @@ func TestValidator_extractAndValidateProperty(t *testing.T) {
 			want:    nil,
 			wantErr: true,
 		},
+		{
+			name:   "Validate blob - reference to a blob stored out-of-band",
+			fields: validatorFields,
+			args: args{
+				ctx:          context.Background(),
+				propertyName: "blobProperty",
+				pv:           schema.BlobReferencePrefix + strings.Repeat("ab", 32),
+				className:    "BlobClass",
+				dataType:     getDataType(schema.DataTypeBlob),
+			},
+			want:    schema.BlobReferencePrefix + strings.Repeat("ab", 32),
+			wantErr: false,
+		},
+		{
+			name:   "Validate blob - malformed reference",
+			fields: validatorFields,
+			args: args{
+				ctx:          context.Background(),
+				propertyName: "blobProperty",
+				pv:           schema.BlobReferencePrefix + "abc",
+				className:    "BlobClass",
+				dataType:     getDataType(schema.DataTypeBlob),
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name:   "Validate vector - valid",
+			fields: validatorFields,
+			args: args{
+				ctx:          context.Background(),
+				propertyName: "vectorProperty",
+				pv:           []interface{}{json.Number("0.5"), float64(1), int64(2)},
+				className:    "VectorClass",
+				dataType:     getDataType(schema.DataTypeVector),
+			},
+			want:    []float32{0.5, 1, 2},
+			wantErr: false,
+		},
+		{
+			name:   "Validate vector - empty",
+			fields: validatorFields,
+			args: args{
+				ctx:          context.Background(),
+				propertyName: "vectorProperty",
+				pv:           []interface{}{},
+				className:    "VectorClass",
+				dataType:     getDataType(schema.DataTypeVector),
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name:   "Validate vector - non-numeric value",
+			fields: validatorFields,
+			args: args{
+				ctx:          context.Background(),
+				propertyName: "vectorProperty",
+				pv:           []interface{}{float64(1), "foo"},
+				className:    "VectorClass",
+				dataType:     getDataType(schema.DataTypeVector),
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name:   "Validate vector array - valid",
+			fields: validatorFields,
+			args: args{
+				ctx:          context.Background(),
+				propertyName: "vectorsProperty",
+				pv: []interface{}{
+					[]interface{}{float64(1), float64(2)},
+					[]interface{}{float64(3), float64(4)},
+				},
+				className: "VectorClass",
+				dataType:  getDataType(schema.DataTypeVectorArray),
+			},
+			want:    [][]float32{{1, 2}, {3, 4}},
+			wantErr: false,
+		},
+		{
+			name:   "Validate vector array - mismatching dimensions",
+			fields: validatorFields,
+			args: args{
+				ctx:          context.Background(),
+				propertyName: "vectorsProperty",
+				pv: []interface{}{
+					[]interface{}{float64(1), float64(2)},
+					[]interface{}{float64(3)},
+				},
+				className: "VectorClass",
+				dataType:  getDataType(schema.DataTypeVectorArray),
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name:   "Validate duration - ISO-8601",
+			fields: validatorFields,
+			args: args{
+				ctx:          context.Background(),
+				propertyName: "durationProperty",
+				pv:           "P1DT2H30M",
+				className:    "DurationClass",
+				dataType:     getDataType(schema.DataTypeDuration),
+			},
+			want:    "26h30m0s",
+			wantErr: false,
+		},
+		{
+			name:   "Validate duration - Go duration",
+			fields: validatorFields,
+			args: args{
+				ctx:          context.Background(),
+				propertyName: "durationProperty",
+				pv:           "90m",
+				className:    "DurationClass",
+				dataType:     getDataType(schema.DataTypeDuration),
+			},
+			want:    "1h30m0s",
+			wantErr: false,
+		},
+		{
+			name:   "Validate duration - months",
+			fields: validatorFields,
+			args: args{
+				ctx:          context.Background(),
+				propertyName: "durationProperty",
+				pv:           "P1M",
+				className:    "DurationClass",
+				dataType:     getDataType(schema.DataTypeDuration),
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name:   "Validate duration - not a string",
+			fields: validatorFields,
+			args: args{
+				ctx:          context.Background(),
+				propertyName: "durationProperty",
+				pv:           float64(60),
+				className:    "DurationClass",
+				dataType:     getDataType(schema.DataTypeDuration),
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name:   "Validate ip - IPv4",
+			fields: validatorFields,
+			args: args{
+				ctx:          context.Background(),
+				propertyName: "ipProperty",
+				pv:           "10.0.0.1",
+				className:    "IPClass",
+				dataType:     getDataType(schema.DataTypeIP),
+			},
+			want:    "10.0.0.1",
+			wantErr: false,
+		},
+		{
+			name:   "Validate ip - IPv6",
+			fields: validatorFields,
+			args: args{
+				ctx:          context.Background(),
+				propertyName: "ipProperty",
+				pv:           "2001:0db8:0000:0000:0000:0000:0000:0001",
+				className:    "IPClass",
+				dataType:     getDataType(schema.DataTypeIP),
+			},
+			want:    "2001:db8::1",
+			wantErr: false,
+		},
+		{
+			name:   "Validate ip - invalid address",
+			fields: validatorFields,
+			args: args{
+				ctx:          context.Background(),
+				propertyName: "ipProperty",
+				pv:           "10.0.0.256",
+				className:    "IPClass",
+				dataType:     getDataType(schema.DataTypeIP),
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name:   "Validate ip - CIDR block",
+			fields: validatorFields,
+			args: args{
+				ctx:          context.Background(),
+				propertyName: "ipProperty",
+				pv:           "10.0.0.0/8",
+				className:    "IPClass",
+				dataType:     getDataType(schema.DataTypeIP),
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name:   "Validate decimal - string",
+			fields: validatorFields,
+			args: args{
+				ctx:          context.Background(),
+				propertyName: "decimalProperty",
+				pv:           "12345678901234567890.10",
+				className:    "DecimalClass",
+				dataType:     getDataType(schema.DataTypeDecimal),
+			},
+			want:    "12345678901234567890.1",
+			wantErr: false,
+		},
+		{
+			name:   "Validate decimal - json number",
+			fields: validatorFields,
+			args: args{
+				ctx:          context.Background(),
+				propertyName: "decimalProperty",
+				pv:           json.Number("0.30"),
+				className:    "DecimalClass",
+				dataType:     getDataType(schema.DataTypeDecimal),
+			},
+			want:    "0.3",
+			wantErr: false,
+		},
+		{
+			name:   "Validate decimal - float",
+			fields: validatorFields,
+			args: args{
+				ctx:          context.Background(),
+				propertyName: "decimalProperty",
+				pv:           float64(0.1),
+				className:    "DecimalClass",
+				dataType:     getDataType(schema.DataTypeDecimal),
+			},
+			want:    "0.1",
+			wantErr: false,
+		},
+		{
+			name:   "Validate decimal - invalid",
+			fields: validatorFields,
+			args: args{
+				ctx:          context.Background(),
+				propertyName: "decimalProperty",
+				pv:           "12,5",
+				className:    "DecimalClass",
+				dataType:     getDataType(schema.DataTypeDecimal),
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name:   "Validate geoPolygon",
+			fields: validatorFields,
+			args: args{
+				ctx:          context.Background(),
+				propertyName: "areaProperty",
+				pv: map[string]interface{}{"coordinates": []interface{}{
+					map[string]interface{}{"latitude": 10.0, "longitude": 10.0},
+					map[string]interface{}{"latitude": 10.0, "longitude": 20.0},
+					map[string]interface{}{"latitude": 20.0, "longitude": 20.0},
+				}},
+				className: "GeoClass",
+				dataType:  getDataType(schema.DataTypeGeoPolygon),
+			},
+			want: &models.GeoPolygon{Coordinates: []*models.GeoCoordinates{
+				{Latitude: ptFloat32(10), Longitude: ptFloat32(10)},
+				{Latitude: ptFloat32(10), Longitude: ptFloat32(20)},
+				{Latitude: ptFloat32(20), Longitude: ptFloat32(20)},
+			}},
+			wantErr: false,
+		},
+		{
+			name:   "Validate geoPolygon - too few coordinates",
+			fields: validatorFields,
+			args: args{
+				ctx:          context.Background(),
+				propertyName: "areaProperty",
+				pv: map[string]interface{}{"coordinates": []interface{}{
+					map[string]interface{}{"latitude": 10.0, "longitude": 10.0},
+					map[string]interface{}{"latitude": 10.0, "longitude": 20.0},
+				}},
+				className: "GeoClass",
+				dataType:  getDataType(schema.DataTypeGeoPolygon),
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name:   "Validate geoShape - line string",
+			fields: validatorFields,
+			args: args{
+				ctx:          context.Background(),
+				propertyName: "routeProperty",
+				pv: map[string]interface{}{"type": "lineString", "coordinates": []interface{}{
+					map[string]interface{}{"latitude": 10.0, "longitude": 10.0},
+					map[string]interface{}{"latitude": 10.0, "longitude": 20.0},
+				}},
+				className: "GeoClass",
+				dataType:  getDataType(schema.DataTypeGeoShape),
+			},
+			want: &models.GeoShape{Type: "lineString", Coordinates: []*models.GeoCoordinates{
+				{Latitude: ptFloat32(10), Longitude: ptFloat32(10)},
+				{Latitude: ptFloat32(10), Longitude: ptFloat32(20)},
+			}},
+			wantErr: false,
+		},
+		{
+			name:   "Validate geoShape - unknown type",
+			fields: validatorFields,
+			args: args{
+				ctx:          context.Background(),
+				propertyName: "routeProperty",
+				pv: map[string]interface{}{"type": "circle", "coordinates": []interface{}{
+					map[string]interface{}{"latitude": 10.0, "longitude": 10.0},
+				}},
+				className: "GeoClass",
+				dataType:  getDataType(schema.DataTypeGeoShape),
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name:   "Validate sparseVector",
+			fields: validatorFields,
+			args: args{
+				ctx:          context.Background(),
+				propertyName: "termWeights",
+				pv: map[string]interface{}{
+					"indices": []interface{}{json.Number("7"), json.Number("1024")},
+					"values":  []interface{}{json.Number("0.5"), 1.5},
+				},
+				className: "SparseClass",
+				dataType:  getDataType(schema.DataTypeSparseVector),
+			},
+			want:    &models.SparseVector{Indices: []int64{7, 1024}, Values: []float32{0.5, 1.5}},
+			wantErr: false,
+		},
+		{
+			name:   "Validate sparseVector - duplicate index",
+			fields: validatorFields,
+			args: args{
+				ctx:          context.Background(),
+				propertyName: "termWeights",
+				pv: map[string]interface{}{
+					"indices": []interface{}{json.Number("7"), json.Number("7")},
+					"values":  []interface{}{json.Number("0.5"), json.Number("1")},
+				},
+				className: "SparseClass",
+				dataType:  getDataType(schema.DataTypeSparseVector),
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name:   "Validate sparseVector - fractional index",
+			fields: validatorFields,
+			args: args{
+				ctx:          context.Background(),
+				propertyName: "termWeights",
+				pv: map[string]interface{}{
+					"indices": []interface{}{7.5},
+					"values":  []interface{}{json.Number("0.5")},
+				},
+				className: "SparseClass",
+				dataType:  getDataType(schema.DataTypeSparseVector),
+			},
+			want:    nil,
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
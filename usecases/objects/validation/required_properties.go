@@ -0,0 +1,89 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package validation
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// ErrorMissingRequiredProperties message
+const ErrorMissingRequiredProperties string = "class '%s' requires properties which are missing: %s"
+
+// requiredProperties makes sure every required property of the class is set
+// on the given properties. If fallback is set (merge semantics), properties
+// which are not part of props are looked up in fallback instead.
+func requiredProperties(class *models.Class, props, fallback interface{}) error {
+	propsMap, _ := props.(map[string]interface{})
+	fallbackMap, _ := fallback.(map[string]interface{})
+
+	var missing []string
+	for _, prop := range class.Properties {
+		if !prop.Required {
+			continue
+		}
+
+		if hasValue(propsMap, prop.Name) {
+			continue
+		}
+		if _, ok := propsMap[prop.Name]; !ok && hasValue(fallbackMap, prop.Name) {
+			continue
+		}
+
+		missing = append(missing, prop.Name)
+	}
+
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return fmt.Errorf(ErrorMissingRequiredProperties, class.Class,
+			strings.Join(missing, ", "))
+	}
+
+	return nil
+}
+
+// requiredPropertiesNotDeleted makes sure a merge does not explicitly remove
+// (set to null) any required property
+func requiredPropertiesNotDeleted(class *models.Class, props interface{}) error {
+	propsMap, _ := props.(map[string]interface{})
+
+	var deleted []string
+	for key, value := range propsMap {
+		if value != nil {
+			continue
+		}
+		prop, err := schema.GetPropertyByName(class, schema.LowercaseFirstLetter(key))
+		if err == nil && prop.Required {
+			deleted = append(deleted, prop.Name)
+		}
+	}
+
+	if len(deleted) > 0 {
+		sort.Strings(deleted)
+		return fmt.Errorf("class '%s' requires properties which can not be removed: %s",
+			class.Class, strings.Join(deleted, ", "))
+	}
+
+	return nil
+}
+
+func hasValue(props map[string]interface{}, name string) bool {
+	if props == nil {
+		return false
+	}
+	value, ok := props[name]
+	return ok && value != nil
+}
@@ -0,0 +1,117 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package validation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+func TestValidator_RequiredProperties(t *testing.T) {
+	class := &models.Class{
+		Class: "MyClass",
+		Properties: []*models.Property{
+			{Name: "name", DataType: schema.DataTypeText.PropString(), Required: true},
+			{Name: "count", DataType: schema.DataTypeInt.PropString(), Required: true, DefaultValue: float64(0)},
+			{Name: "description", DataType: schema.DataTypeText.PropString()},
+		},
+	}
+
+	t.Run("create with all required properties", func(t *testing.T) {
+		obj := &models.Object{
+			Class:      "MyClass",
+			Properties: map[string]interface{}{"name": "foo"},
+		}
+		err := (&Validator{}).Object(context.Background(), class, obj, nil)
+		require.Nil(t, err)
+	})
+
+	t.Run("create without required property", func(t *testing.T) {
+		obj := &models.Object{
+			Class:      "MyClass",
+			Properties: map[string]interface{}{"description": "foo"},
+		}
+		err := (&Validator{}).Object(context.Background(), class, obj, nil)
+		require.NotNil(t, err)
+		assert.Equal(t, "class 'MyClass' requires properties which are missing: name", err.Error())
+	})
+
+	t.Run("create with required property set to null", func(t *testing.T) {
+		obj := &models.Object{
+			Class:      "MyClass",
+			Properties: map[string]interface{}{"name": nil},
+		}
+		err := (&Validator{}).Object(context.Background(), class, obj, nil)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "missing: name")
+	})
+
+	t.Run("replace without required properties", func(t *testing.T) {
+		obj := &models.Object{
+			Class:      "MyClass",
+			Properties: map[string]interface{}{"description": "foo"},
+		}
+		existing := &models.Object{
+			Class:      "MyClass",
+			Properties: map[string]interface{}{"name": "foo", "count": float64(1)},
+		}
+		err := (&Validator{}).Object(context.Background(), class, obj, existing)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "missing: count, name")
+	})
+
+	t.Run("merge without required properties set on existing object", func(t *testing.T) {
+		obj := &models.Object{
+			Class:      "MyClass",
+			Properties: map[string]interface{}{"description": "foo"},
+		}
+		existing := &models.Object{
+			Class:      "MyClass",
+			Properties: map[string]interface{}{"name": "foo", "count": float64(1)},
+		}
+		err := (&Validator{}).ObjectMerge(context.Background(), class, obj, existing)
+		require.Nil(t, err)
+	})
+
+	t.Run("merge without required properties missing on existing object", func(t *testing.T) {
+		obj := &models.Object{
+			Class:      "MyClass",
+			Properties: map[string]interface{}{"description": "foo"},
+		}
+		existing := &models.Object{
+			Class:      "MyClass",
+			Properties: map[string]interface{}{"name": "foo"},
+		}
+		err := (&Validator{}).ObjectMerge(context.Background(), class, obj, existing)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "missing: count")
+	})
+
+	t.Run("merge removing a required property", func(t *testing.T) {
+		obj := &models.Object{
+			Class:      "MyClass",
+			Properties: map[string]interface{}{"Name": nil},
+		}
+		existing := &models.Object{
+			Class:      "MyClass",
+			Properties: map[string]interface{}{"name": "foo", "count": float64(1)},
+		}
+		err := (&Validator{}).ObjectMerge(context.Background(), class, obj, existing)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "can not be removed: name")
+	})
+}
@@ -0,0 +1,44 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package validation
+
+import (
+	"fmt"
+)
+
+// VectorDimensions makes sure the vector has the length recorded for its
+// class. A class without recorded dimensions accepts vectors of any length.
+func VectorDimensions(className string, dimensions int64, vector []float32) error {
+	if dimensions == 0 || len(vector) == 0 {
+		return nil
+	}
+	if int64(len(vector)) != dimensions {
+		return fmt.Errorf("vector has %d dimensions, but class %q expects %d",
+			len(vector), className, dimensions)
+	}
+	return nil
+}
+
+// TargetVectorDimensions is the counterpart of VectorDimensions for the named
+// vector space targetVector of a class
+func TargetVectorDimensions(className, targetVector string, dimensions int64,
+	vector []float32,
+) error {
+	if dimensions == 0 || len(vector) == 0 {
+		return nil
+	}
+	if int64(len(vector)) != dimensions {
+		return fmt.Errorf("vector %q has %d dimensions, but class %q expects %d",
+			targetVector, len(vector), className, dimensions)
+	}
+	return nil
+}
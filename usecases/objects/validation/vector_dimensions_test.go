@@ -0,0 +1,55 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package validation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVectorDimensions(t *testing.T) {
+	t.Run("matching", func(t *testing.T) {
+		assert.Nil(t, VectorDimensions("Article", 3, []float32{1, 2, 3}))
+	})
+
+	t.Run("no dimensions recorded", func(t *testing.T) {
+		assert.Nil(t, VectorDimensions("Article", 0, []float32{1, 2}))
+	})
+
+	t.Run("no vector", func(t *testing.T) {
+		assert.Nil(t, VectorDimensions("Article", 3, nil))
+	})
+
+	t.Run("mismatch", func(t *testing.T) {
+		err := VectorDimensions("Article", 3, []float32{1, 2})
+		require.NotNil(t, err)
+		assert.Equal(t, `vector has 2 dimensions, but class "Article" expects 3`, err.Error())
+	})
+}
+
+func TestTargetVectorDimensions(t *testing.T) {
+	t.Run("matching", func(t *testing.T) {
+		assert.Nil(t, TargetVectorDimensions("Article", "title", 3, []float32{1, 2, 3}))
+	})
+
+	t.Run("no dimensions recorded", func(t *testing.T) {
+		assert.Nil(t, TargetVectorDimensions("Article", "title", 0, []float32{1, 2}))
+	})
+
+	t.Run("mismatch", func(t *testing.T) {
+		err := TargetVectorDimensions("Article", "title", 3, []float32{1, 2})
+		require.NotNil(t, err)
+		assert.Equal(t, `vector "title" has 2 dimensions, but class "Article" expects 3`, err.Error())
+	})
+}
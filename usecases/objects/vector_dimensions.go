@@ -0,0 +1,176 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/objects/validation"
+)
+
+// validateVectorDimensions rejects a vector whose length differs from the
+// dimensions of the class and named vectors whose length differs from the
+// dimensions of their vector space. If none are recorded yet, the length of
+// the vector is recorded, so that it applies to all following inserts.
+func validateVectorDimensions(ctx context.Context, sm schemaManager,
+	class *models.Class, vector []float32, vectors models.Vectors,
+) error {
+	if class == nil {
+		return nil
+	}
+
+	if len(vector) > 0 {
+		dims := class.VectorDimensions
+		if dims == 0 {
+			locked, err := sm.LockVectorDimensions(ctx, class.Class, "", int64(len(vector)))
+			if err != nil {
+				return err
+			}
+			dims = locked
+		}
+		if err := validation.VectorDimensions(class.Class, dims, vector); err != nil {
+			return err
+		}
+	}
+
+	for _, name := range targetVectorsToValidate(class, vectors) {
+		dims := class.VectorConfig[name].Dimensions
+		if dims == 0 {
+			locked, err := sm.LockVectorDimensions(ctx, class.Class, name,
+				int64(len(vectors[name])))
+			if err != nil {
+				return err
+			}
+			dims = locked
+		}
+		if err := validation.TargetVectorDimensions(class.Class, name, dims,
+			vectors[name]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// targetVectorsToValidate returns the sorted names of the non-empty vectors
+// which belong to a named vector space of the class. Vectors of unknown
+// vector spaces are rejected by the validation of the object.
+func targetVectorsToValidate(class *models.Class, vectors models.Vectors) []string {
+	names := make([]string, 0, len(vectors))
+	for name, vector := range vectors {
+		if _, ok := class.VectorConfig[name]; ok && len(vector) > 0 {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// validateVectorDimensions is the batch counterpart of the function of the
+// same name. It runs once the objects are vectorized, so that a mismatch is
+// reported for the individual object instead of failing the whole batch
+// inside the vector index. Within a batch, the first vector of a class or of
+// a named vector space determines the dimensions if none are recorded yet.
+func (b *BatchManager) validateVectorDimensions(ctx context.Context,
+	principal *models.Principal, batch BatchObjects,
+) {
+	type vectorSpace struct {
+		class        string
+		targetVector string
+	}
+	type spaceDims struct {
+		dims int64
+		err  error
+	}
+	dimsBySpace := map[vectorSpace]spaceDims{}
+	classes := map[string]*models.Class{}
+
+	dimsOf := func(class *models.Class, targetVector string, vector []float32) spaceDims {
+		space := vectorSpace{class.Class, targetVector}
+		sd, ok := dimsBySpace[space]
+		if !ok {
+			sd.dims, sd.err = recordedVectorDimensions(class, targetVector)
+			if sd.err == nil && sd.dims == 0 {
+				sd.dims, sd.err = b.schemaManager.LockVectorDimensions(ctx,
+					class.Class, targetVector, int64(len(vector)))
+			}
+			dimsBySpace[space] = sd
+		}
+		return sd
+	}
+
+	for i := range batch {
+		obj := batch[i].Object
+		if batch[i].Err != nil || obj == nil ||
+			(len(obj.Vector) == 0 && len(obj.Vectors) == 0) {
+			continue
+		}
+
+		class, ok := classes[obj.Class]
+		if !ok {
+			var err error
+			class, err = b.schemaManager.GetClass(ctx, principal, obj.Class)
+			if err != nil {
+				batch[i].Err = err
+				continue
+			}
+			classes[obj.Class] = class
+		}
+		if class == nil {
+			continue
+		}
+
+		if len(obj.Vector) > 0 {
+			sd := dimsOf(class, "", obj.Vector)
+			if sd.err != nil {
+				batch[i].Err = sd.err
+				continue
+			}
+			err := validation.VectorDimensions(obj.Class, sd.dims, obj.Vector)
+			if err != nil {
+				batch[i].Err = err
+				continue
+			}
+		}
+
+		for _, name := range targetVectorsToValidate(class, obj.Vectors) {
+			sd := dimsOf(class, name, obj.Vectors[name])
+			if sd.err != nil {
+				batch[i].Err = sd.err
+				break
+			}
+			err := validation.TargetVectorDimensions(obj.Class, name, sd.dims,
+				obj.Vectors[name])
+			if err != nil {
+				batch[i].Err = err
+				break
+			}
+		}
+	}
+}
+
+// recordedVectorDimensions returns the dimensions recorded for the
+// class-level vector if targetVector is empty, otherwise the ones of the
+// named vector space
+func recordedVectorDimensions(class *models.Class, targetVector string) (int64, error) {
+	if targetVector == "" {
+		return class.VectorDimensions, nil
+	}
+	cfg, ok := class.VectorConfig[targetVector]
+	if !ok {
+		return 0, fmt.Errorf("class %q has no target vector %q", class.Class, targetVector)
+	}
+	return cfg.Dimensions, nil
+}
@@ -0,0 +1,178 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+func Test_BatchManager_AddObjects_VectorDimensions(t *testing.T) {
+	ctx := context.Background()
+	vectorRepo := &fakeVectorRepo{}
+	modulesProvider := getFakeModulesProvider()
+	schemaManager := &fakeSchemaManager{
+		GetSchemaResponse: schema.Schema{
+			Objects: &models.Schema{
+				Classes: []*models.Class{
+					{
+						Class:             "Unlocked",
+						Vectorizer:        config.VectorizerModuleNone,
+						VectorIndexConfig: hnsw.UserConfig{},
+					},
+					{
+						Class:             "Locked",
+						Vectorizer:        config.VectorizerModuleNone,
+						VectorIndexConfig: hnsw.UserConfig{},
+						VectorDimensions:  2,
+					},
+					{
+						Class: "Named",
+						VectorConfig: map[string]models.VectorConfig{
+							"title": {
+								Vectorizer:        map[string]interface{}{"none": map[string]interface{}{}},
+								VectorIndexConfig: hnsw.UserConfig{},
+							},
+							"body": {
+								Dimensions:        2,
+								Vectorizer:        map[string]interface{}{"none": map[string]interface{}{}},
+								VectorIndexConfig: hnsw.UserConfig{},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	logger, _ := test.NewNullLogger()
+	manager := NewBatchManager(vectorRepo, modulesProvider, &fakeLocks{},
+		schemaManager, &config.WeaviateConfig{}, logger, &fakeAuthorizer{}, nil, nil)
+	modulesProvider.On("UpdateVector", mock.Anything, mock.AnythingOfType(FindObjectFn)).
+		Return(nil, nil)
+
+	addObjects := func(t *testing.T, objects []*models.Object) BatchObjects {
+		vectorRepo.On("BatchPutObjects", mock.Anything).Return(nil).Once()
+		_, err := manager.AddObjects(ctx, nil, objects, []*string{}, nil, "", "")
+		require.Nil(t, err)
+		return vectorRepo.Calls[len(vectorRepo.Calls)-1].Arguments[0].(BatchObjects)
+	}
+
+	t.Run("the first vector of a batch locks the dimensions", func(t *testing.T) {
+		res := addObjects(t, []*models.Object{
+			{Class: "Unlocked", Vector: []float32{1, 2, 3}},
+			{Class: "Unlocked", Vector: []float32{1, 2}},
+			{Class: "Unlocked", Vector: []float32{3, 2, 1}},
+		})
+
+		require.Len(t, res, 3)
+		assert.Nil(t, res[0].Err)
+		require.NotNil(t, res[1].Err)
+		assert.Equal(t, `vector has 2 dimensions, but class "Unlocked" expects 3`,
+			res[1].Err.Error())
+		assert.Nil(t, res[2].Err)
+
+		class, err := schemaManager.GetClass(ctx, nil, "Unlocked")
+		require.Nil(t, err)
+		assert.Equal(t, int64(3), class.VectorDimensions)
+	})
+
+	t.Run("following batches are validated against the locked dimensions", func(t *testing.T) {
+		res := addObjects(t, []*models.Object{
+			{Class: "Unlocked", Vector: []float32{1, 2}},
+			{Class: "Locked", Vector: []float32{1, 2}},
+			{Class: "Locked", Vector: []float32{1, 2, 3, 4}},
+			{Class: "Locked"},
+		})
+
+		require.Len(t, res, 4)
+		require.NotNil(t, res[0].Err)
+		assert.Contains(t, res[0].Err.Error(), "expects 3")
+		assert.Nil(t, res[1].Err)
+		require.NotNil(t, res[2].Err)
+		assert.Equal(t, `vector has 4 dimensions, but class "Locked" expects 2`,
+			res[2].Err.Error())
+		assert.Nil(t, res[3].Err, "objects without a vector are not affected")
+	})
+	t.Run("named vectors are validated against their vector space", func(t *testing.T) {
+		res := addObjects(t, []*models.Object{
+			{Class: "Named", Vectors: models.Vectors{"title": {1, 2, 3}}},
+			{Class: "Named", Vectors: models.Vectors{"title": {1, 2}}},
+			{Class: "Named", Vectors: models.Vectors{"body": {1, 2, 3}}},
+			{Class: "Named", Vectors: models.Vectors{"title": {3, 2, 1}, "body": {1, 2}}},
+		})
+
+		require.Len(t, res, 4)
+		assert.Nil(t, res[0].Err)
+		require.NotNil(t, res[1].Err)
+		assert.Equal(t, `vector "title" has 2 dimensions, but class "Named" expects 3`,
+			res[1].Err.Error())
+		require.NotNil(t, res[2].Err)
+		assert.Equal(t, `vector "body" has 3 dimensions, but class "Named" expects 2`,
+			res[2].Err.Error())
+		assert.Nil(t, res[3].Err)
+
+		class, err := schemaManager.GetClass(ctx, nil, "Named")
+		require.Nil(t, err)
+		assert.Equal(t, int64(3), class.VectorConfig["title"].Dimensions)
+		assert.Equal(t, int64(0), class.VectorDimensions)
+	})
+}
+
+func Test_validateVectorDimensions_NamedVectors(t *testing.T) {
+	ctx := context.Background()
+	class := &models.Class{
+		Class:            "Named",
+		VectorDimensions: 2,
+		VectorConfig: map[string]models.VectorConfig{
+			"title": {Vectorizer: map[string]interface{}{"none": map[string]interface{}{}}},
+			"body": {
+				Dimensions: 2,
+				Vectorizer: map[string]interface{}{"none": map[string]interface{}{}},
+			},
+		},
+	}
+	sm := &fakeSchemaManager{
+		GetSchemaResponse: schema.Schema{
+			Objects: &models.Schema{Classes: []*models.Class{class}},
+		},
+	}
+
+	t.Run("matching vectors", func(t *testing.T) {
+		err := validateVectorDimensions(ctx, sm, class, []float32{1, 2},
+			models.Vectors{"body": {1, 2}})
+		assert.Nil(t, err)
+	})
+
+	t.Run("the first named vector records the dimensions", func(t *testing.T) {
+		err := validateVectorDimensions(ctx, sm, class, nil,
+			models.Vectors{"title": {1, 2, 3}})
+		require.Nil(t, err)
+		assert.Equal(t, int64(3), class.VectorConfig["title"].Dimensions)
+	})
+
+	t.Run("a named vector with the wrong length", func(t *testing.T) {
+		err := validateVectorDimensions(ctx, sm, class, []float32{1, 2},
+			models.Vectors{"title": {1, 2, 3}, "body": {1, 2, 3}})
+		require.NotNil(t, err)
+		assert.Equal(t, `vector "body" has 3 dimensions, but class "Named" expects 2`,
+			err.Error())
+	})
+}
@@ -0,0 +1,103 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// UpdateObjectVector replaces the vector and/or named vectors of an existing
+// object without touching its properties. Named vectors which are not part of
+// the update are kept. This allows re-embedding objects with a new model
+// without sending the full object again. The vectors are replaced in place,
+// the object keeps its doc id and its properties are not reindexed.
+func (m *Manager) UpdateObjectVector(ctx context.Context, principal *models.Principal,
+	className string, id strfmt.UUID, vector []float32, vectors models.Vectors,
+	repl *additional.ReplicationProperties, tenant string,
+) *Error {
+	path := fmt.Sprintf("objects/%s/%s", className, id)
+	if err := m.authorizer.Authorize(principal, "update", path); err != nil {
+		return &Error{path, StatusForbidden, err}
+	}
+
+	if len(vector) == 0 && len(vectors) == 0 {
+		return &Error{"bad request", StatusBadRequest,
+			fmt.Errorf("empty update, neither vector nor vectors are set")}
+	}
+
+	unlock, err := m.locks.LockSchema()
+	if err != nil {
+		return &Error{"cannot lock", StatusInternalServerError, err}
+	}
+	defer unlock()
+
+	m.metrics.MergeObjectInc()
+	defer m.metrics.MergeObjectDec()
+
+	class, err := m.schemaManager.GetClass(ctx, principal, className)
+	if err != nil {
+		return &Error{"cannot get class", StatusInternalServerError, err}
+	}
+	if class == nil {
+		return &Error{"class not found " + className, StatusNotFound, nil}
+	}
+
+	for name := range vectors {
+		if _, ok := class.VectorConfig[name]; !ok {
+			return &Error{"bad request", StatusUnprocessableEntity,
+				fmt.Errorf("class %q has no named vector %q", className, name)}
+		}
+	}
+
+	exists, err := m.vectorRepo.Exists(ctx, className, id, repl, tenant)
+	if err != nil {
+		if errors.As(err, &ErrMultiTenancy{}) {
+			return &Error{"source object", StatusUnprocessableEntity, err}
+		}
+		return &Error{"source object", StatusInternalServerError, err}
+	}
+	if !exists {
+		return &Error{"source object", StatusNotFound, fmt.Errorf("object %s/%s not found", className, id)}
+	}
+
+	if err := validateVectorDimensions(ctx, m.schemaManager, class, vector, vectors); err != nil {
+		return &Error{"bad request", StatusUnprocessableEntity, err}
+	}
+
+	mergeDoc := MergeDocument{
+		Class:      className,
+		ID:         id,
+		Vector:     vector,
+		Vectors:    mergeVectors(vectors),
+		UpdateTime: m.timeSource.Now(),
+	}
+	if err := m.vectorRepo.Merge(ctx, mergeDoc, repl, tenant); err != nil {
+		if errors.As(err, &ErrMultiTenancy{}) {
+			return &Error{"repo.merge", StatusUnprocessableEntity, err}
+		}
+		if errors.As(err, &ErrReadOnly{}) {
+			return &Error{"repo.merge", StatusLocked, err}
+		}
+		if errors.As(err, &ErrQuotaExceeded{}) {
+			return &Error{"repo.merge", StatusUnprocessableEntity, err}
+		}
+		return &Error{"repo.merge", StatusInternalServerError, err}
+	}
+
+	return nil
+}
@@ -0,0 +1,124 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+func Test_UpdateObjectVector(t *testing.T) {
+	t.Parallel()
+	var (
+		cls = "Article"
+		id  = strfmt.UUID("a9e3a6f4-1b2f-4a0e-8f55-6b4f7e6b3c01")
+		sch = schema.Schema{
+			Objects: &models.Schema{
+				Classes: []*models.Class{{
+					Class:            cls,
+					VectorDimensions: 3,
+					VectorConfig: map[string]models.VectorConfig{
+						"title": {VectorIndexType: "hnsw"},
+						"body":  {VectorIndexType: "hnsw", Dimensions: 2},
+					},
+					Properties: []*models.Property{
+						{Name: "title", DataType: schema.DataTypeText.PropString()},
+					},
+				}},
+			},
+		}
+	)
+
+	t.Run("replace vectors", func(t *testing.T) {
+		m := newFakeGetManager(sch)
+		m.repo.On("Exists", cls, id).Return(true, nil).Once()
+		m.repo.On("Merge", mock.MatchedBy(func(doc MergeDocument) bool {
+			return doc.Class == cls && doc.ID == id &&
+				assert.ObjectsAreEqual([]float32{1, 2, 3}, doc.Vector) &&
+				assert.ObjectsAreEqual(map[string][]float32{"title": {4, 5}}, doc.Vectors) &&
+				len(doc.PrimitiveSchema) == 0 && len(doc.References) == 0
+		})).Return(nil).Once()
+
+		err := m.UpdateObjectVector(context.Background(), nil, cls, id,
+			[]float32{1, 2, 3}, models.Vectors{"title": {4, 5}}, nil, "")
+		require.Nil(t, err)
+		m.repo.AssertExpectations(t)
+	})
+
+	t.Run("empty update", func(t *testing.T) {
+		m := newFakeGetManager(sch)
+
+		err := m.UpdateObjectVector(context.Background(), nil, cls, id,
+			nil, nil, nil, "")
+		require.NotNil(t, err)
+		assert.Equal(t, StatusBadRequest, err.Code)
+	})
+
+	t.Run("missing object", func(t *testing.T) {
+		m := newFakeGetManager(sch)
+		m.repo.On("Exists", cls, id).Return(false, nil).Once()
+
+		err := m.UpdateObjectVector(context.Background(), nil, cls, id,
+			[]float32{1, 2, 3}, nil, nil, "")
+		require.NotNil(t, err)
+		assert.Equal(t, StatusNotFound, err.Code)
+	})
+
+	t.Run("missing class", func(t *testing.T) {
+		m := newFakeGetManager(sch)
+
+		err := m.UpdateObjectVector(context.Background(), nil, "Unknown", id,
+			[]float32{1, 2, 3}, nil, nil, "")
+		require.NotNil(t, err)
+		assert.Equal(t, StatusNotFound, err.Code)
+	})
+
+	t.Run("unknown named vector", func(t *testing.T) {
+		m := newFakeGetManager(sch)
+
+		err := m.UpdateObjectVector(context.Background(), nil, cls, id,
+			nil, models.Vectors{"summary": {1, 2}}, nil, "")
+		require.NotNil(t, err)
+		assert.Equal(t, StatusUnprocessableEntity, err.Code)
+	})
+
+	t.Run("mismatching dimensions", func(t *testing.T) {
+		m := newFakeGetManager(sch)
+		m.repo.On("Exists", cls, id).Return(true, nil).Once()
+
+		err := m.UpdateObjectVector(context.Background(), nil, cls, id,
+			[]float32{1, 2}, nil, nil, "")
+		require.NotNil(t, err)
+		assert.Equal(t, StatusUnprocessableEntity, err.Code)
+		m.repo.AssertExpectations(t)
+	})
+
+	t.Run("mismatching dimensions of a named vector", func(t *testing.T) {
+		m := newFakeGetManager(sch)
+		m.repo.On("Exists", cls, id).Return(true, nil).Once()
+
+		err := m.UpdateObjectVector(context.Background(), nil, cls, id,
+			[]float32{1, 2, 3}, models.Vectors{"body": {1, 2, 3}}, nil, "")
+		require.NotNil(t, err)
+		assert.Equal(t, StatusUnprocessableEntity, err.Code)
+		assert.EqualError(t, err.Err,
+			`vector "body" has 3 dimensions, but class "Article" expects 2`)
+		m.repo.AssertExpectations(t)
+	})
+}
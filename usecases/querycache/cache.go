@@ -0,0 +1,273 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package querycache
+
+import (
+	"bytes"
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Config of the Cache. A MaxEntries of zero or less disables the cache.
+type Config struct {
+	// MaxEntries is the number of results which are kept, the least recently
+	// used result is evicted first
+	MaxEntries int
+	// TTL is how long a result is served from the cache. Zero keeps results
+	// until they are evicted or invalidated.
+	TTL time.Duration
+}
+
+// Cache holds the results of recently repeated queries of this node. The
+// results are stored per class along with the data version of the class at
+// the time the query ran. As soon as a newer version of a class is seen, all
+// results of the class are invalidated. The version must change with every
+// write to a shard of the class and every change of its schema.
+//
+// The cached results are shared by all readers and must not be modified.
+type Cache struct {
+	config Config
+	now    func() time.Time
+
+	mu       sync.Mutex
+	lru      *list.List
+	classes  map[string]map[string]*list.Element
+	versions map[string]uint64
+}
+
+type entry struct {
+	class   string
+	key     string
+	value   interface{}
+	expires time.Time
+}
+
+func New(config Config) *Cache {
+	return &Cache{
+		config:   config,
+		now:      time.Now,
+		lru:      list.New(),
+		classes:  map[string]map[string]*list.Element{},
+		versions: map[string]uint64{},
+	}
+}
+
+// Enabled tells whether results are cached at all. A nil Cache is disabled.
+func (c *Cache) Enabled() bool {
+	return c != nil && c.config.MaxEntries > 0
+}
+
+// Key hashes the parameters of a query, two queries with equal parameters
+// have the same key. All fields are hashed, including unexported ones and
+// ones which are not marshalled to json. Parameters which cannot be hashed,
+// such as funcs, are not cached.
+func Key(params interface{}) (string, bool) {
+	var e keyEncoder
+	if !e.encode(reflect.ValueOf(params), 0) {
+		return "", false
+	}
+	sum := sha256.Sum256(e.buf.Bytes())
+	return hex.EncodeToString(sum[:]), true
+}
+
+// maxKeyDepth protects against cyclic parameters, they are not cached
+const maxKeyDepth = 64
+
+type keyEncoder struct {
+	buf bytes.Buffer
+}
+
+func (e *keyEncoder) encode(v reflect.Value, depth int) bool {
+	if depth > maxKeyDepth {
+		return false
+	}
+	if !v.IsValid() {
+		e.buf.WriteString("nil;")
+		return true
+	}
+
+	e.buf.WriteString(v.Type().String())
+	e.buf.WriteByte(':')
+	switch v.Kind() {
+	case reflect.Bool:
+		e.buf.WriteString(strconv.FormatBool(v.Bool()))
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		e.buf.WriteString(strconv.FormatInt(v.Int(), 10))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Uintptr:
+		e.buf.WriteString(strconv.FormatUint(v.Uint(), 10))
+	case reflect.Float32, reflect.Float64:
+		e.buf.WriteString(strconv.FormatUint(math.Float64bits(v.Float()), 16))
+	case reflect.Complex64, reflect.Complex128:
+		c := v.Complex()
+		e.buf.WriteString(strconv.FormatUint(math.Float64bits(real(c)), 16))
+		e.buf.WriteByte(',')
+		e.buf.WriteString(strconv.FormatUint(math.Float64bits(imag(c)), 16))
+	case reflect.String:
+		e.buf.WriteString(strconv.Quote(v.String()))
+	case reflect.Pointer, reflect.Interface:
+		if v.IsNil() {
+			e.buf.WriteString("nil")
+		} else if !e.encode(v.Elem(), depth+1) {
+			return false
+		}
+	case reflect.Slice, reflect.Array:
+		e.buf.WriteString(strconv.Itoa(v.Len()))
+		e.buf.WriteByte('[')
+		for i := 0; i < v.Len(); i++ {
+			if !e.encode(v.Index(i), depth+1) {
+				return false
+			}
+		}
+		e.buf.WriteByte(']')
+	case reflect.Struct:
+		e.buf.WriteByte('{')
+		for i := 0; i < v.NumField(); i++ {
+			e.buf.WriteString(v.Type().Field(i).Name)
+			e.buf.WriteByte('=')
+			if !e.encode(v.Field(i), depth+1) {
+				return false
+			}
+		}
+		e.buf.WriteByte('}')
+	case reflect.Map:
+		// the entries are sorted by their encoding, as the iteration order of
+		// maps is random
+		entries := make([]string, 0, v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			var entry keyEncoder
+			if !entry.encode(iter.Key(), depth+1) || !entry.encode(iter.Value(), depth+1) {
+				return false
+			}
+			entries = append(entries, entry.buf.String())
+		}
+		sort.Strings(entries)
+		e.buf.WriteString(strconv.Itoa(len(entries)))
+		e.buf.WriteByte('{')
+		for _, entry := range entries {
+			e.buf.WriteString(strconv.Quote(entry))
+		}
+		e.buf.WriteByte('}')
+	default:
+		// funcs, channels and unsafe pointers
+		return false
+	}
+	e.buf.WriteByte(';')
+	return true
+}
+
+// Get returns the result of the query with the given key if it ran against
+// the given version of the class
+func (c *Cache) Get(class string, version uint64, key string) (interface{}, bool) {
+	if !c.Enabled() {
+		return nil, false
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !c.observe(class, version) {
+		return nil, false
+	}
+
+	elem, ok := c.classes[class][key]
+	if !ok {
+		return nil, false
+	}
+	e := elem.Value.(*entry)
+	if c.config.TTL > 0 && !c.now().Before(e.expires) {
+		c.remove(elem)
+		return nil, false
+	}
+	c.lru.MoveToFront(elem)
+	return e.value, true
+}
+
+// Put stores the result of the query with the given key which ran against the
+// given version of the class. Results of outdated versions are discarded.
+func (c *Cache) Put(class string, version uint64, key string, value interface{}) {
+	if !c.Enabled() {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !c.observe(class, version) {
+		return
+	}
+
+	e := &entry{class: class, key: key, value: value}
+	if c.config.TTL > 0 {
+		e.expires = c.now().Add(c.config.TTL)
+	}
+
+	if elem, ok := c.classes[class][key]; ok {
+		elem.Value = e
+		c.lru.MoveToFront(elem)
+		return
+	}
+
+	if c.classes[class] == nil {
+		c.classes[class] = map[string]*list.Element{}
+	}
+	c.classes[class][key] = c.lru.PushFront(e)
+
+	for c.lru.Len() > c.config.MaxEntries {
+		c.remove(c.lru.Back())
+	}
+}
+
+// Len is the number of cached results
+func (c *Cache) Len() int {
+	if !c.Enabled() {
+		return 0
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.lru.Len()
+}
+
+// observe invalidates all results of the class if the version is newer than
+// the one of the results. It returns false if the version is outdated.
+func (c *Cache) observe(class string, version uint64) bool {
+	current, ok := c.versions[class]
+	if ok && version < current {
+		return false
+	}
+	if !ok || version > current {
+		for _, elem := range c.classes[class] {
+			c.lru.Remove(elem)
+		}
+		delete(c.classes, class)
+		c.versions[class] = version
+	}
+	return true
+}
+
+func (c *Cache) remove(elem *list.Element) {
+	e := elem.Value.(*entry)
+	c.lru.Remove(elem)
+	delete(c.classes[e.class], e.key)
+	if len(c.classes[e.class]) == 0 {
+		delete(c.classes, e.class)
+	}
+}
@@ -0,0 +1,158 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package querycache
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCache_Disabled(t *testing.T) {
+	for _, c := range []*Cache{nil, New(Config{})} {
+		c.Put("Foo", 1, "key", "result")
+		_, ok := c.Get("Foo", 1, "key")
+		assert.False(t, ok)
+		assert.Equal(t, 0, c.Len())
+	}
+}
+
+func TestCache_Key(t *testing.T) {
+	type params struct {
+		Class string
+		Limit int
+	}
+
+	a, ok := Key(params{Class: "Foo", Limit: 10})
+	require.True(t, ok)
+	b, ok := Key(params{Class: "Foo", Limit: 10})
+	require.True(t, ok)
+	c, ok := Key(params{Class: "Foo", Limit: 20})
+	require.True(t, ok)
+
+	assert.Equal(t, a, b)
+	assert.NotEqual(t, a, c)
+
+	_, ok = Key(func() {})
+	assert.False(t, ok)
+
+	t.Run("fields which are not marshalled to json", func(t *testing.T) {
+		type params struct {
+			Distance     float64
+			WithDistance bool `json:"-"`
+			limit        int
+		}
+
+		a, ok := Key(params{Distance: 0.5, WithDistance: true})
+		require.True(t, ok)
+		b, ok := Key(params{Distance: 0.5, WithDistance: false})
+		require.True(t, ok)
+		c, ok := Key(params{Distance: 0.5, WithDistance: true, limit: 1})
+		require.True(t, ok)
+
+		assert.NotEqual(t, a, b)
+		assert.NotEqual(t, a, c)
+	})
+
+	t.Run("maps are hashed independent of their order", func(t *testing.T) {
+		m := map[string]interface{}{}
+		for i := 0; i < 20; i++ {
+			m[fmt.Sprintf("key%d", i)] = i
+		}
+		a, ok := Key(m)
+		require.True(t, ok)
+		for i := 0; i < 10; i++ {
+			b, ok := Key(m)
+			require.True(t, ok)
+			assert.Equal(t, a, b)
+		}
+	})
+
+	t.Run("funcs within params", func(t *testing.T) {
+		_, ok := Key(map[string]interface{}{"fn": func() {}})
+		assert.False(t, ok)
+	})
+}
+
+func TestCache_Invalidation(t *testing.T) {
+	c := New(Config{MaxEntries: 10})
+
+	c.Put("Foo", 1, "key", "foo")
+	c.Put("Bar", 1, "key", "bar")
+
+	res, ok := c.Get("Foo", 1, "key")
+	require.True(t, ok)
+	assert.Equal(t, "foo", res)
+
+	t.Run("a newer version invalidates the class", func(t *testing.T) {
+		_, ok := c.Get("Foo", 2, "key")
+		assert.False(t, ok)
+		assert.Equal(t, 1, c.Len())
+
+		_, ok = c.Get("Bar", 1, "key")
+		assert.True(t, ok)
+	})
+
+	t.Run("results of an outdated version are discarded", func(t *testing.T) {
+		c.Put("Foo", 1, "key", "outdated")
+		_, ok := c.Get("Foo", 1, "key")
+		assert.False(t, ok)
+		_, ok = c.Get("Foo", 2, "key")
+		assert.False(t, ok)
+
+		c.Put("Foo", 2, "key", "foo")
+		res, ok := c.Get("Foo", 2, "key")
+		require.True(t, ok)
+		assert.Equal(t, "foo", res)
+	})
+}
+
+func TestCache_MaxEntries(t *testing.T) {
+	c := New(Config{MaxEntries: 3})
+
+	for i := 0; i < 3; i++ {
+		c.Put("Foo", 1, fmt.Sprint(i), i)
+	}
+	// mark the oldest entry as recently used
+	_, ok := c.Get("Foo", 1, "0")
+	require.True(t, ok)
+
+	c.Put("Foo", 1, "3", 3)
+	assert.Equal(t, 3, c.Len())
+
+	_, ok = c.Get("Foo", 1, "1")
+	assert.False(t, ok, "least recently used entry is evicted")
+	for _, key := range []string{"0", "2", "3"} {
+		_, ok = c.Get("Foo", 1, key)
+		assert.True(t, ok, key)
+	}
+}
+
+func TestCache_TTL(t *testing.T) {
+	now := time.Now()
+	c := New(Config{MaxEntries: 10, TTL: time.Minute})
+	c.now = func() time.Time { return now }
+
+	c.Put("Foo", 1, "key", "foo")
+
+	now = now.Add(59 * time.Second)
+	_, ok := c.Get("Foo", 1, "key")
+	assert.True(t, ok)
+
+	now = now.Add(time.Second)
+	_, ok = c.Get("Foo", 1, "key")
+	assert.False(t, ok)
+	assert.Equal(t, 0, c.Len())
+}
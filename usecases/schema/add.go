@@ -31,8 +31,10 @@ import (
 	"github.com/weaviate/weaviate/entities/backup"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/vectorindex"
 	"github.com/weaviate/weaviate/usecases/config"
 	"github.com/weaviate/weaviate/usecases/monitoring"
+	"github.com/weaviate/weaviate/usecases/objects/validation"
 	"github.com/weaviate/weaviate/usecases/replica"
 	"github.com/weaviate/weaviate/usecases/sharding"
 )
@@ -164,6 +166,11 @@ func (m *Manager) addClass(ctx context.Context, class *models.Class,
 		return nil, err
 	}
 
+	err = m.validateStopwordSetsExist(class.InvertedIndexConfig)
+	if err != nil {
+		return nil, err
+	}
+
 	shardState, err := sharding.InitState(class.Class,
 		class.ShardingConfig.(sharding.Config),
 		m.clusterState, class.ReplicationConfig.Factor,
@@ -231,7 +238,7 @@ func (m *Manager) setClassDefaults(class *models.Class) {
 	}
 
 	if class.VectorIndexType == "" {
-		class.VectorIndexType = "hnsw"
+		class.VectorIndexType = vectorindex.DefaultVectorIndexType
 	}
 
 	if m.config.DefaultVectorDistanceMetric != "" {
@@ -242,17 +249,45 @@ func (m *Manager) setClassDefaults(class *models.Class) {
 		}
 	}
 
+	m.setTargetVectorDefaults(class)
+
 	setInvertedConfigDefaults(class)
 	for _, prop := range class.Properties {
 		setPropertyDefaults(prop)
 	}
 
 	m.moduleConfig.SetClassDefaults(class)
+	setVectorDimensionsDefault(class)
+}
+
+// setTargetVectorDefaults applies the class-level vector defaults to every
+// named vector space of the class
+func (m *Manager) setTargetVectorDefaults(class *models.Class) {
+	for name, cfg := range class.VectorConfig {
+		if cfg.Vectorizer == nil {
+			cfg.Vectorizer = map[string]interface{}{schema.VectorizerNone: map[string]interface{}{}}
+		}
+
+		if cfg.VectorIndexType == "" {
+			cfg.VectorIndexType = vectorindex.DefaultVectorIndexType
+		}
+
+		if m.config.DefaultVectorDistanceMetric != "" {
+			if cfg.VectorIndexConfig == nil {
+				cfg.VectorIndexConfig = map[string]interface{}{"distance": m.config.DefaultVectorDistanceMetric}
+			} else if asMap, ok := cfg.VectorIndexConfig.(map[string]interface{}); ok && asMap["distance"] == nil {
+				asMap["distance"] = m.config.DefaultVectorDistanceMetric
+			}
+		}
+
+		class.VectorConfig[name] = cfg
+	}
 }
 
 func setPropertyDefaults(prop *models.Property) {
 	setPropertyDefaultTokenization(prop)
 	setPropertyDefaultIndexing(prop)
+	setNestedPropertiesDefaults(prop.NestedProperties)
 }
 
 func setPropertyDefaultTokenization(prop *models.Property) {
@@ -283,7 +318,12 @@ func setPropertyDefaultIndexing(prop *models.Property) {
 
 	vTrue := true
 	if prop.IndexFilterable == nil {
-		prop.IndexFilterable = &vTrue
+		if schema.IsVectorDataType(prop.DataType) || schema.IsSparseVectorDataType(prop.DataType) {
+			vFalse := false
+			prop.IndexFilterable = &vFalse
+		} else {
+			prop.IndexFilterable = &vTrue
+		}
 	}
 	if prop.IndexSearchable == nil {
 		switch dataType, _ := schema.AsPrimitive(prop.DataType); dataType {
@@ -370,12 +410,24 @@ func (m *Manager) validateCanAddClass(
 
 	existingPropertyNames := map[string]bool{}
 	for _, property := range class.Properties {
-		if err := m.validateProperty(property, class.Class, existingPropertyNames, relaxCrossRefValidation); err != nil {
+		if err := m.validateProperty(ctx, property, class.Class, existingPropertyNames, relaxCrossRefValidation); err != nil {
 			return err
 		}
 		existingPropertyNames[strings.ToLower(property.Name)] = true
 	}
 
+	// expressions and default country properties may reference any other
+	// property of the class, so they can only be validated once all properties
+	// are known
+	for _, property := range class.Properties {
+		if err := validation.ValidateExpression(class, property); err != nil {
+			return err
+		}
+		if err := validation.ValidateDefaultCountryProperty(class, property); err != nil {
+			return err
+		}
+	}
+
 	if err := m.validateVectorSettings(ctx, class); err != nil {
 		return err
 	}
@@ -388,11 +440,28 @@ func (m *Manager) validateCanAddClass(
 		return err
 	}
 
+	if err := validateObjectTTLConfig(class.ObjectTTL); err != nil {
+		return err
+	}
+
+	if err := validateMemtableConfig(class.MemtableConfig); err != nil {
+		return err
+	}
+
+	if err := validateQuotaConfig(class.QuotaConfig,
+		schema.MultiTenancyEnabled(class)); err != nil {
+		return err
+	}
+
+	if err := validateBM25PropertyBoosts(class); err != nil {
+		return err
+	}
+
 	// all is fine!
 	return nil
 }
 
-func (m *Manager) validateProperty(
+func (m *Manager) validateProperty(ctx context.Context,
 	property *models.Property, className string,
 	existingPropertyNames map[string]bool, relaxCrossRefValidation bool,
 ) error {
@@ -425,6 +494,26 @@ func (m *Manager) validateProperty(
 		return err
 	}
 
+	if err := validateNestedProperties(property); err != nil {
+		return fmt.Errorf("property '%s': %w", property.Name, err)
+	}
+
+	if err := validatePropertyCardinality(property); err != nil {
+		return fmt.Errorf("property '%s': %w", property.Name, err)
+	}
+
+	if err := validatePropertyOnDelete(property); err != nil {
+		return fmt.Errorf("property '%s': %w", property.Name, err)
+	}
+
+	if err := validatePropertyUniqueKey(property); err != nil {
+		return fmt.Errorf("property '%s': %w", property.Name, err)
+	}
+
+	if err := validation.ValidateDefaultValue(ctx, className, property); err != nil {
+		return err
+	}
+
 	// all is fine!
 	return nil
 }
@@ -432,19 +521,35 @@ func (m *Manager) validateProperty(
 func (m *Manager) parseVectorIndexConfig(ctx context.Context,
 	class *models.Class,
 ) error {
-	if class.VectorIndexType != "hnsw" {
+	if !vectorindex.IsSupportedType(class.VectorIndexType) {
 		return errors.Errorf(
 			"parse vector index config: unsupported vector index type: %q",
 			class.VectorIndexType)
 	}
 
-	parsed, err := m.hnswConfigParser(class.VectorIndexConfig)
+	parsed, err := m.vectorIndexConfigParser(class.VectorIndexConfig, class.VectorIndexType)
 	if err != nil {
 		return errors.Wrap(err, "parse vector index config")
 	}
 
 	class.VectorIndexConfig = parsed
 
+	for name, cfg := range class.VectorConfig {
+		if !vectorindex.IsSupportedType(cfg.VectorIndexType) {
+			return errors.Errorf(
+				"parse vector index config of target vector %q: unsupported vector index type: %q",
+				name, cfg.VectorIndexType)
+		}
+
+		parsed, err := m.vectorIndexConfigParser(cfg.VectorIndexConfig, cfg.VectorIndexType)
+		if err != nil {
+			return errors.Wrapf(err, "parse vector index config of target vector %q", name)
+		}
+
+		cfg.VectorIndexConfig = parsed
+		class.VectorConfig[name] = cfg
+	}
+
 	return nil
 }
 
@@ -21,6 +21,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/objects/validation"
 )
 
 // AddClassProperty to an existing Class
@@ -55,7 +56,13 @@ func (m *Manager) addClassProperty(ctx context.Context,
 	if err := m.setNewPropDefaults(class, prop); err != nil {
 		return err
 	}
-	if err := m.validateProperty(prop, className, existingPropertyNames, false); err != nil {
+	if err := m.validateProperty(ctx, prop, className, existingPropertyNames, false); err != nil {
+		return err
+	}
+	if err := validation.ValidateExpression(class, prop); err != nil {
+		return err
+	}
+	if err := validation.ValidateDefaultCountryProperty(class, prop); err != nil {
 		return err
 	}
 	// migrate only after validation in completed
@@ -66,6 +66,41 @@ func Test_Schema_Authorization(t *testing.T) {
 			expectedVerb:     "update",
 			expectedResource: "schema/objects",
 		},
+		{
+			methodName:       "ValidateUpdateClass",
+			additionalArgs:   []interface{}{"somename", &models.Class{}},
+			expectedVerb:     "update",
+			expectedResource: "schema/objects",
+		},
+		{
+			methodName:       "GetSchemaHistory",
+			additionalArgs:   []interface{}{int64(0), int64(100)},
+			expectedVerb:     "list",
+			expectedResource: "schema/*",
+		},
+		{
+			methodName:       "GetSchemaAtVersion",
+			additionalArgs:   []interface{}{int64(1)},
+			expectedVerb:     "list",
+			expectedResource: "schema/*",
+		},
+		{
+			methodName:       "DiffSchema",
+			additionalArgs:   []interface{}{&models.Schema{}},
+			expectedVerb:     "list",
+			expectedResource: "schema/*",
+		},
+		{
+			methodName:       "ExportSchema",
+			expectedVerb:     "list",
+			expectedResource: "schema/*",
+		},
+		{
+			methodName:       "ImportSchema",
+			additionalArgs:   []interface{}{&models.SchemaExport{}},
+			expectedVerb:     "create",
+			expectedResource: "schema/objects",
+		},
 		{
 			methodName:       "DeleteClass",
 			additionalArgs:   []interface{}{"somename"},
@@ -84,6 +119,107 @@ func Test_Schema_Authorization(t *testing.T) {
 			expectedVerb:     "update",
 			expectedResource: "schema/objects",
 		},
+		{
+			methodName:       "RenameClassProperty",
+			additionalArgs:   []interface{}{"somename", "someprop", "newprop"},
+			expectedVerb:     "update",
+			expectedResource: "schema/objects",
+		},
+		{
+			methodName:       "UpdateClassPropertyTokenization",
+			additionalArgs:   []interface{}{"somename", "someprop", "field"},
+			expectedVerb:     "update",
+			expectedResource: "schema/objects",
+		},
+		{
+			methodName:       "GetPropertyMigrations",
+			additionalArgs:   []interface{}{"className"},
+			expectedVerb:     "list",
+			expectedResource: "schema/className/migrations",
+		},
+		{
+			methodName:       "CheckVectorIndexIntegrity",
+			additionalArgs:   []interface{}{"className", false},
+			expectedVerb:     "list",
+			expectedResource: "schema/className/shards",
+		},
+		{
+			methodName:       "MeasureVectorIndexRecall",
+			additionalArgs:   []interface{}{"className", 100, 10},
+			expectedVerb:     "list",
+			expectedResource: "schema/className/shards",
+		},
+		{
+			methodName:       "ExportVectorIndexSnapshot",
+			additionalArgs:   []interface{}{"className", "shardName", "", "graph.snapshot"},
+			expectedVerb:     "update",
+			expectedResource: "schema/className/shards/shardName",
+		},
+		{
+			methodName:       "ImportVectorIndexSnapshot",
+			additionalArgs:   []interface{}{"className", "shardName", "", "graph.snapshot"},
+			expectedVerb:     "update",
+			expectedResource: "schema/className/shards/shardName",
+		},
+		{
+			methodName:       "BuildVectorIndex",
+			additionalArgs:   []interface{}{"className", "shardName", ""},
+			expectedVerb:     "update",
+			expectedResource: "schema/className/shards/shardName",
+		},
+		{
+			methodName:       "CompactShard",
+			additionalArgs:   []interface{}{"className", "shardName"},
+			expectedVerb:     "update",
+			expectedResource: "schema/className/shards/shardName",
+		},
+		{
+			methodName:       "StopwordSets",
+			expectedVerb:     "list",
+			expectedResource: "schema/stopwords",
+		},
+		{
+			methodName:       "PutStopwordSet",
+			additionalArgs:   []interface{}{"setName", &models.StopwordSet{}},
+			expectedVerb:     "update",
+			expectedResource: "schema/stopwords",
+		},
+		{
+			methodName:       "DeleteStopwordSet",
+			additionalArgs:   []interface{}{"setName"},
+			expectedVerb:     "delete",
+			expectedResource: "schema/stopwords",
+		},
+		{
+			methodName:       "Synonyms",
+			additionalArgs:   []interface{}{"className"},
+			expectedVerb:     "get",
+			expectedResource: "schema/className/synonyms",
+		},
+		{
+			methodName:       "UpdateSynonyms",
+			additionalArgs:   []interface{}{"className", []*models.SynonymRule{}},
+			expectedVerb:     "update",
+			expectedResource: "schema/className/synonyms",
+		},
+		{
+			methodName:       "VectorIndexTombstoneCleanup",
+			additionalArgs:   []interface{}{"className"},
+			expectedVerb:     "list",
+			expectedResource: "schema/className/shards",
+		},
+		{
+			methodName:       "PauseVectorIndexTombstoneCleanup",
+			additionalArgs:   []interface{}{"className"},
+			expectedVerb:     "update",
+			expectedResource: "schema/className/shards",
+		},
+		{
+			methodName:       "ResumeVectorIndexTombstoneCleanup",
+			additionalArgs:   []interface{}{"className"},
+			expectedVerb:     "update",
+			expectedResource: "schema/className/shards",
+		},
 		{
 			methodName:       "UpdateShardStatus",
 			additionalArgs:   []interface{}{"className", "shardName", "targetStatus"},
@@ -130,7 +266,7 @@ func Test_Schema_Authorization(t *testing.T) {
 				"UpdateMeta", "GetSchemaSkipAuth", "IndexedInverted", "RLock", "RUnlock", "Lock", "Unlock",
 				"TryLock", "RLocker", "TryRLock", // introduced by sync.Mutex in go 1.18
 				"Nodes", "NodeName", "ClusterHealthScore", "ClusterStatus", "ResolveParentNodes",
-				"CopyShardingState", "TxManager", "RestoreClass",
+				"CopyShardingState", "TxManager", "RestoreClass", "LockVectorDimensions",
 				"ShardOwner", "TenantShard", "ShardFromUUID", "LockGuard", "RLockGuard", "ShardReplicas",
 				"StartServing", "Shutdown": // internal methods to indicate readiness state
 				// don't require auth on methods which are exported because other
@@ -0,0 +1,93 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+func TestAddClassBM25PropertyBoosts(t *testing.T) {
+	ctx := context.Background()
+	vFalse := false
+	classWithBoosts := func(boosts map[string]float32) *models.Class {
+		return &models.Class{
+			Class: "Article",
+			Properties: []*models.Property{
+				{Name: "title", DataType: schema.DataTypeText.PropString()},
+				{Name: "tags", DataType: schema.DataTypeTextArray.PropString()},
+				{Name: "wordCount", DataType: schema.DataTypeInt.PropString()},
+				{
+					Name: "slug", DataType: schema.DataTypeText.PropString(),
+					IndexSearchable: &vFalse,
+				},
+			},
+			InvertedIndexConfig: &models.InvertedIndexConfig{
+				Bm25: &models.BM25Config{K1: 1.2, B: 0.75, PropertyBoosts: boosts},
+			},
+		}
+	}
+
+	t.Run("boosts of text properties", func(t *testing.T) {
+		boosts := map[string]float32{"title": 3, "tags": 0.5}
+		sm := newSchemaManager()
+		require.Nil(t, sm.AddClass(ctx, nil, classWithBoosts(boosts)))
+
+		class, err := sm.GetClass(ctx, nil, "Article")
+		require.Nil(t, err)
+		assert.Equal(t, boosts, class.InvertedIndexConfig.Bm25.PropertyBoosts)
+	})
+
+	for _, tc := range []struct {
+		name   string
+		boosts map[string]float32
+		err    string
+	}{
+		{"an unknown property", map[string]float32{"body": 2}, "no such prop"},
+		{"a property which is not text", map[string]float32{"wordCount": 2}, "is not of type text"},
+		{"a property which is not searchable", map[string]float32{"slug": 2}, "is not searchable"},
+		{"a boost which is not positive", map[string]float32{"title": -1}, "must be greater than 0"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := newSchemaManager().AddClass(ctx, nil, classWithBoosts(tc.boosts))
+			require.NotNil(t, err)
+			assert.Contains(t, err.Error(), tc.err)
+		})
+	}
+}
+
+func TestRenameBM25BoostedProperty(t *testing.T) {
+	ctx := context.Background()
+	sm := newSchemaManager()
+	sm.migrator = &renameMigrator{renamed: map[string]string{}}
+
+	require.Nil(t, sm.AddClass(ctx, nil, &models.Class{
+		Class: "Article",
+		Properties: []*models.Property{
+			{Name: "title", DataType: schema.DataTypeText.PropString()},
+		},
+		InvertedIndexConfig: &models.InvertedIndexConfig{
+			Bm25: &models.BM25Config{
+				K1: 1.2, B: 0.75, PropertyBoosts: map[string]float32{"title": 2},
+			},
+		},
+	}))
+
+	_, err := sm.RenameClassProperty(ctx, nil, "Article", "title", "headline")
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "referenced in the bm25 propertyBoosts")
+}
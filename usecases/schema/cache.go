@@ -230,6 +230,147 @@ func (s *schemaCache) addProperty(class string, p *models.Property) (models.Clas
 	return *c, nil
 }
 
+func (s *schemaCache) renameProperty(class, propName, newName string) (models.Class, error) {
+	return s.updateProperty(class, propName, func(prop *models.Property) {
+		prop.Name = newName
+	})
+}
+
+func (s *schemaCache) updatePropertyTokenization(class, propName, tokenization string) (models.Class, error) {
+	return s.updateProperty(class, propName, func(prop *models.Property) {
+		prop.Tokenization = tokenization
+	})
+}
+
+// lockVectorDimensions sets the vector dimensions of the class or of its
+// named vector space targetVector, unless they are set already. It returns
+// the recorded dimensions and whether the class was changed.
+func (s *schemaCache) lockVectorDimensions(class, targetVector string,
+	dimensions int64,
+) (models.Class, int64, bool, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	c := s.unsafeFindClass(class)
+	if c == nil {
+		return models.Class{}, 0, false, errClassNotFound
+	}
+	recorded, err := recordedVectorDimensions(c, targetVector)
+	if err != nil {
+		return models.Class{}, 0, false, err
+	}
+	if recorded > 0 {
+		return *c, recorded, false, nil
+	}
+
+	if targetVector == "" {
+		c.VectorDimensions = dimensions
+		return *c, dimensions, true, nil
+	}
+
+	// readers share the map of the copies they got, so it is replaced
+	// instead of written to
+	vectorConfig := make(map[string]models.VectorConfig, len(c.VectorConfig))
+	for name, cfg := range c.VectorConfig {
+		vectorConfig[name] = cfg
+	}
+	cfg := vectorConfig[targetVector]
+	cfg.Dimensions = dimensions
+	vectorConfig[targetVector] = cfg
+	c.VectorConfig = vectorConfig
+	return *c, dimensions, true, nil
+}
+
+// updateProperty applies update to a copy of the property, which then
+// replaces the property in the class
+func (s *schemaCache) updateProperty(class, propName string,
+	update func(prop *models.Property),
+) (models.Class, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	c := s.unsafeFindClass(class)
+	if c == nil {
+		return models.Class{}, errClassNotFound
+	}
+
+	// update all at once to prevent race condition with concurrent readers
+	dest := make([]*models.Property, len(c.Properties))
+	for i, prop := range c.Properties {
+		if prop.Name == propName {
+			updated := *prop
+			update(&updated)
+			prop = &updated
+		}
+		dest[i] = prop
+	}
+	c.Properties = dest
+	return *c, nil
+}
+
+// stopwordSets returns the custom stopword sets, which must not be changed
+func (s *schemaCache) stopwordSets() []*models.StopwordSet {
+	s.RLock()
+	defer s.RUnlock()
+	return s.ObjectSchema.StopwordSets
+}
+
+// putStopwordSet adds the set or replaces the one with the same name and
+// returns the resulting sets. The sets are copied, as they are shared with
+// readers of the schema.
+func (s *schemaCache) putStopwordSet(set *models.StopwordSet) []*models.StopwordSet {
+	s.Lock()
+	defer s.Unlock()
+
+	sets := make([]*models.StopwordSet, 0, len(s.ObjectSchema.StopwordSets)+1)
+	sets = append(sets, s.ObjectSchema.StopwordSets...)
+	if i := findStopwordSet(sets, set.Name); i >= 0 {
+		sets[i] = set
+	} else {
+		sets = append(sets, set)
+	}
+	s.ObjectSchema.StopwordSets = sets
+	return sets
+}
+
+// deleteStopwordSet removes the set with the given name and returns the
+// remaining sets, false is returned if there is no such set
+func (s *schemaCache) deleteStopwordSet(name string) ([]*models.StopwordSet, bool) {
+	s.Lock()
+	defer s.Unlock()
+
+	i := findStopwordSet(s.ObjectSchema.StopwordSets, name)
+	if i < 0 {
+		return s.ObjectSchema.StopwordSets, false
+	}
+	sets := make([]*models.StopwordSet, 0, len(s.ObjectSchema.StopwordSets)-1)
+	sets = append(sets, s.ObjectSchema.StopwordSets[:i]...)
+	sets = append(sets, s.ObjectSchema.StopwordSets[i+1:]...)
+	s.ObjectSchema.StopwordSets = sets
+	return sets, true
+}
+
+// stopwordSetUsers returns the names of the classes whose stopword config
+// references the set
+func (s *schemaCache) stopwordSetUsers(name string) []string {
+	s.RLock()
+	defer s.RUnlock()
+
+	var classes []string
+	for _, class := range s.ObjectSchema.Classes {
+		if class.InvertedIndexConfig == nil || class.InvertedIndexConfig.Stopwords == nil {
+			continue
+		}
+		for _, set := range class.InvertedIndexConfig.Stopwords.Sets {
+			if set == name {
+				classes = append(classes, class.Class)
+				break
+			}
+		}
+	}
+	return classes
+}
+
 // readOnlySchema returns a read only schema
 // Changing the schema outside this package might lead to undefined behavior.
 func (s *schemaCache) readOnlySchema() *models.Schema {
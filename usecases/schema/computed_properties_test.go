@@ -0,0 +1,96 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+func TestComputedProperties(t *testing.T) {
+	ctx := context.Background()
+
+	personClass := func(expression string) *models.Class {
+		return &models.Class{
+			Class: "Person",
+			Properties: []*models.Property{
+				{Name: "firstName", DataType: schema.DataTypeText.PropString()},
+				{Name: "lastName", DataType: schema.DataTypeText.PropString()},
+				{
+					Name: "fullName", DataType: schema.DataTypeText.PropString(),
+					Expression: expression,
+				},
+			},
+		}
+	}
+
+	t.Run("add class with a valid expression", func(t *testing.T) {
+		sm := newSchemaManager()
+		require.Nil(t, sm.AddClass(ctx, nil, personClass(`concat(firstName, " ", lastName)`)))
+
+		class, err := sm.GetClass(ctx, nil, "Person")
+		require.Nil(t, err)
+		prop, err := schema.GetPropertyByName(class, "fullName")
+		require.Nil(t, err)
+		assert.Equal(t, `concat(firstName, " ", lastName)`, prop.Expression)
+	})
+
+	t.Run("add class referencing a property defined later", func(t *testing.T) {
+		class := personClass(`concat(firstName, " ", lastName)`)
+		class.Properties = []*models.Property{
+			class.Properties[2], class.Properties[0], class.Properties[1],
+		}
+		require.Nil(t, newSchemaManager().AddClass(ctx, nil, class))
+	})
+
+	t.Run("add class with an invalid expression", func(t *testing.T) {
+		err := newSchemaManager().AddClass(ctx, nil, personClass("concat(middleName)"))
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "property 'middleName' does not exist")
+	})
+
+	t.Run("add computed property to an existing class", func(t *testing.T) {
+		sm := newSchemaManager()
+		require.Nil(t, sm.AddClass(ctx, nil, personClass("")))
+
+		err := sm.AddClassProperty(ctx, nil, "Person", &models.Property{
+			Name: "initial", DataType: schema.DataTypeText.PropString(),
+			Expression: "upper(firstName)",
+		})
+		require.Nil(t, err)
+
+		err = sm.AddClassProperty(ctx, nil, "Person", &models.Property{
+			Name: "age", DataType: schema.DataTypeInt.PropString(),
+			Expression: "lower(firstName)",
+		})
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "expression results in text")
+	})
+
+	t.Run("rename an input of a computed property", func(t *testing.T) {
+		sm := newSchemaManager()
+		sm.migrator = &renameMigrator{renamed: map[string]string{}}
+		require.Nil(t, sm.AddClass(ctx, nil, personClass(`concat(firstName, " ", lastName)`)))
+
+		_, err := sm.RenameClassProperty(ctx, nil, "Person", "lastName", "surname")
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), `referenced in the expression of computed property "fullName"`)
+
+		_, err = sm.RenameClassProperty(ctx, nil, "Person", "fullName", "name")
+		require.Nil(t, err)
+	})
+}
@@ -0,0 +1,296 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/objects/validation"
+	"github.com/weaviate/weaviate/usecases/replica"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+// immutableClassSettings can only be changed by deleting and recreating the
+// class, see validateImmutableFields
+var immutableClassSettings = map[string]bool{
+	"moduleConfig":    true,
+	"vectorIndexType": true,
+	"vectorizer":      true,
+}
+
+// DiffSchema compares the current schema with target without applying any
+// change. The classes of target are normalized with the same defaults which
+// would be set when creating or updating them, so that only actual changes
+// are reported. Definitions which could not be created at all are returned
+// as an error.
+func (m *Manager) DiffSchema(ctx context.Context, principal *models.Principal,
+	target *models.Schema,
+) (*models.SchemaDiff, error) {
+	err := m.Authorizer.Authorize(principal, "list", "schema/*")
+	if err != nil {
+		return nil, err
+	}
+
+	if target == nil {
+		target = &models.Schema{}
+	}
+
+	m.RLock()
+	defer m.RUnlock()
+
+	for _, class := range target.Classes {
+		if err := m.normalizeTargetClass(ctx, class); err != nil {
+			return nil, fmt.Errorf("class %q: %w", class.Class, err)
+		}
+	}
+
+	diff := diffSchema(m.getSchema().Objects, target)
+
+	targetClasses := map[string]*models.Class{}
+	for _, class := range target.Classes {
+		targetClasses[class.Class] = class
+	}
+	for _, classDiff := range diff.ClassesChanged {
+		if len(classDiff.IncompatibleChanges) > 0 {
+			// the config could never be applied anyway
+			continue
+		}
+
+		initial := m.getClassByName(classDiff.Class)
+		updated := targetClasses[classDiff.Class]
+		if err := m.validateClassConfigUpdate(ctx, initial, updated,
+			schema.MultiTenancyEnabled(initial)); err != nil {
+			classDiff.IncompatibleChanges = append(classDiff.IncompatibleChanges, err.Error())
+			diff.Compatible = false
+		}
+	}
+
+	return diff, nil
+}
+
+// normalizeTargetClass sets the defaults of the class and validates it the
+// same way as AddClass or UpdateClass would
+func (m *Manager) normalizeTargetClass(ctx context.Context, class *models.Class) error {
+	class.Class = schema.UppercaseClassName(class.Class)
+	class.Properties = schema.LowercaseAllPropertyNames(class.Properties)
+	if class.ShardingConfig != nil && schema.MultiTenancyEnabled(class) {
+		return fmt.Errorf("cannot have both shardingConfig and multiTenancyConfig")
+	} else if class.MultiTenancyConfig == nil {
+		class.MultiTenancyConfig = &models.MultiTenancyConfig{}
+	} else if class.MultiTenancyConfig.Enabled {
+		class.ShardingConfig = sharding.Config{DesiredCount: 0}
+	}
+	m.setClassDefaults(class)
+
+	if initial := m.getClassByName(class.Class); initial == nil {
+		// cross-references may point to other classes of the target schema,
+		// which do not exist yet
+		if err := m.validateCanAddClass(ctx, class, true); err != nil {
+			return err
+		}
+	} else {
+		existingPropertyNames := map[string]bool{}
+		for _, prop := range initial.Properties {
+			existingPropertyNames[strings.ToLower(prop.Name)] = true
+		}
+		for _, prop := range class.Properties {
+			if _, err := schema.GetPropertyByName(initial, prop.Name); err == nil {
+				continue
+			}
+			if err := m.validateProperty(ctx, prop, class.Class,
+				existingPropertyNames, true); err != nil {
+				return err
+			}
+			if err := validation.ValidateExpression(class, prop); err != nil {
+				return err
+			}
+			if err := validation.ValidateDefaultCountryProperty(class, prop); err != nil {
+				return err
+			}
+			existingPropertyNames[strings.ToLower(prop.Name)] = true
+		}
+		if err := replica.ValidateConfig(class, m.config.Replication); err != nil {
+			return err
+		}
+	}
+
+	if err := m.parseVectorIndexConfig(ctx, class); err != nil {
+		return err
+	}
+
+	return m.parseShardingConfig(ctx, class)
+}
+
+// diffSchema computes the structural differences between current and target.
+// Both schemas are expected to be normalized.
+func diffSchema(current, target *models.Schema) *models.SchemaDiff {
+	diff := &models.SchemaDiff{
+		ClassesAdded:   []string{},
+		ClassesRemoved: []string{},
+		ClassesChanged: []*models.SchemaClassDiff{},
+		Compatible:     true,
+	}
+
+	currentClasses := map[string]*models.Class{}
+	if current != nil {
+		for _, class := range current.Classes {
+			currentClasses[class.Class] = class
+		}
+	}
+
+	targetClasses := map[string]*models.Class{}
+	for _, class := range target.Classes {
+		targetClasses[class.Class] = class
+
+		initial, ok := currentClasses[class.Class]
+		if !ok {
+			diff.ClassesAdded = append(diff.ClassesAdded, class.Class)
+			continue
+		}
+
+		if classDiff := diffClass(initial, class); classDiff != nil {
+			if len(classDiff.IncompatibleChanges) > 0 {
+				diff.Compatible = false
+			}
+			diff.ClassesChanged = append(diff.ClassesChanged, classDiff)
+		}
+	}
+
+	for name := range currentClasses {
+		if _, ok := targetClasses[name]; !ok {
+			diff.ClassesRemoved = append(diff.ClassesRemoved, name)
+		}
+	}
+
+	sort.Strings(diff.ClassesAdded)
+	sort.Strings(diff.ClassesRemoved)
+	sort.Slice(diff.ClassesChanged, func(i, j int) bool {
+		return diff.ClassesChanged[i].Class < diff.ClassesChanged[j].Class
+	})
+
+	return diff
+}
+
+// diffClass returns nil if both classes are identical
+func diffClass(initial, target *models.Class) *models.SchemaClassDiff {
+	diff := &models.SchemaClassDiff{
+		Class:               initial.Class,
+		PropertiesAdded:     []string{},
+		PropertiesRemoved:   []string{},
+		PropertiesChanged:   []string{},
+		SettingsChanged:     []string{},
+		IncompatibleChanges: []string{},
+	}
+
+	if _, err := validateUpdatingMT(initial, target); err != nil {
+		diff.IncompatibleChanges = append(diff.IncompatibleChanges, err.Error())
+	}
+
+	for _, setting := range changedFields(initial, target) {
+		if setting == "class" || setting == "properties" {
+			continue
+		}
+		diff.SettingsChanged = append(diff.SettingsChanged, setting)
+		if immutableClassSettings[setting] {
+			diff.IncompatibleChanges = append(diff.IncompatibleChanges,
+				fmt.Sprintf("%s is immutable", setting))
+		}
+	}
+
+	initialProps := map[string]*models.Property{}
+	for _, prop := range initial.Properties {
+		initialProps[prop.Name] = prop
+	}
+	targetProps := map[string]*models.Property{}
+	for _, prop := range target.Properties {
+		targetProps[prop.Name] = prop
+
+		initialProp, ok := initialProps[prop.Name]
+		if !ok {
+			diff.PropertiesAdded = append(diff.PropertiesAdded, prop.Name)
+			continue
+		}
+
+		// there is no way to update an existing property
+		if fields := changedFields(initialProp, prop); len(fields) > 0 {
+			diff.PropertiesChanged = append(diff.PropertiesChanged, prop.Name)
+			diff.IncompatibleChanges = append(diff.IncompatibleChanges,
+				fmt.Sprintf("property %q can not be changed: %s differ",
+					prop.Name, strings.Join(fields, ", ")))
+		}
+	}
+
+	for _, prop := range initial.Properties {
+		if _, ok := targetProps[prop.Name]; !ok {
+			diff.PropertiesRemoved = append(diff.PropertiesRemoved, prop.Name)
+			diff.IncompatibleChanges = append(diff.IncompatibleChanges,
+				fmt.Sprintf("property %q can not be removed", prop.Name))
+		}
+	}
+
+	if len(diff.PropertiesAdded) == 0 && len(diff.PropertiesRemoved) == 0 &&
+		len(diff.PropertiesChanged) == 0 && len(diff.SettingsChanged) == 0 &&
+		len(diff.IncompatibleChanges) == 0 {
+		return nil
+	}
+
+	sort.Strings(diff.PropertiesAdded)
+	sort.Strings(diff.PropertiesRemoved)
+	sort.Strings(diff.PropertiesChanged)
+
+	return diff
+}
+
+// changedFields returns the sorted json names of all top-level fields which
+// differ between left and right
+func changedFields(left, right any) []string {
+	lf, rf := jsonFields(left), jsonFields(right)
+
+	var fields []string
+	for name, lv := range lf {
+		if rv, ok := rf[name]; !ok || !bytes.Equal(lv, rv) {
+			fields = append(fields, name)
+		}
+	}
+	for name := range rf {
+		if _, ok := lf[name]; !ok {
+			fields = append(fields, name)
+		}
+	}
+
+	sort.Strings(fields)
+	return fields
+}
+
+func jsonFields(in any) map[string]json.RawMessage {
+	fields := map[string]json.RawMessage{}
+	b, err := json.Marshal(in)
+	if err != nil {
+		return fields
+	}
+	if err := json.Unmarshal(b, &fields); err != nil {
+		return fields
+	}
+	for name, value := range fields {
+		if string(value) == "null" {
+			delete(fields, name)
+		}
+	}
+	return fields
+}
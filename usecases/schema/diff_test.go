@@ -0,0 +1,196 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+func TestDiffSchemaStructure(t *testing.T) {
+	textProp := func(name string) *models.Property {
+		return &models.Property{
+			Name:         name,
+			DataType:     schema.DataTypeText.PropString(),
+			Tokenization: models.PropertyTokenizationWord,
+		}
+	}
+
+	current := &models.Schema{Classes: []*models.Class{
+		{Class: "Unchanged", Properties: []*models.Property{textProp("name")}},
+		{Class: "Removed"},
+		{
+			Class:       "Changed",
+			Description: "old",
+			Vectorizer:  "model1",
+			Properties:  []*models.Property{textProp("kept"), textProp("dropped"), textProp("retyped")},
+		},
+	}}
+
+	retyped := textProp("retyped")
+	retyped.DataType = schema.DataTypeInt.PropString()
+	retyped.Tokenization = ""
+	target := &models.Schema{Classes: []*models.Class{
+		{Class: "Unchanged", Properties: []*models.Property{textProp("name")}},
+		{Class: "Added"},
+		{
+			Class:       "Changed",
+			Description: "new",
+			Vectorizer:  "model2",
+			Properties:  []*models.Property{textProp("kept"), retyped, textProp("added")},
+		},
+	}}
+
+	diff := diffSchema(current, target)
+
+	assert.Equal(t, []string{"Added"}, diff.ClassesAdded)
+	assert.Equal(t, []string{"Removed"}, diff.ClassesRemoved)
+	assert.False(t, diff.Compatible)
+	require.Len(t, diff.ClassesChanged, 1)
+
+	changed := diff.ClassesChanged[0]
+	assert.Equal(t, "Changed", changed.Class)
+	assert.Equal(t, []string{"added"}, changed.PropertiesAdded)
+	assert.Equal(t, []string{"dropped"}, changed.PropertiesRemoved)
+	assert.Equal(t, []string{"retyped"}, changed.PropertiesChanged)
+	assert.Equal(t, []string{"description", "vectorizer"}, changed.SettingsChanged)
+	assert.ElementsMatch(t, []string{
+		"vectorizer is immutable",
+		`property "retyped" can not be changed: dataType, tokenization differ`,
+		`property "dropped" can not be removed`,
+	}, changed.IncompatibleChanges)
+}
+
+func TestDiffSchemaCompatibleChanges(t *testing.T) {
+	current := &models.Schema{Classes: []*models.Class{
+		{Class: "Article", Description: "old"},
+	}}
+	target := &models.Schema{Classes: []*models.Class{
+		{Class: "Article", Description: "new", Properties: []*models.Property{
+			{Name: "title", DataType: schema.DataTypeText.PropString()},
+		}},
+	}}
+
+	diff := diffSchema(current, target)
+
+	assert.True(t, diff.Compatible)
+	require.Len(t, diff.ClassesChanged, 1)
+	assert.Equal(t, []string{"description"}, diff.ClassesChanged[0].SettingsChanged)
+	assert.Equal(t, []string{"title"}, diff.ClassesChanged[0].PropertiesAdded)
+	assert.Empty(t, diff.ClassesChanged[0].IncompatibleChanges)
+}
+
+func TestManagerDiffSchema(t *testing.T) {
+	ctx := context.Background()
+	sm := newSchemaManager()
+	require.Nil(t, sm.AddClass(ctx, nil, &models.Class{
+		Class: "Article",
+		Properties: []*models.Property{
+			{Name: "title", DataType: schema.DataTypeText.PropString()},
+		},
+	}))
+
+	t.Run("identical schema without defaults set", func(t *testing.T) {
+		diff, err := sm.DiffSchema(ctx, nil, &models.Schema{Classes: []*models.Class{
+			{
+				Class: "Article",
+				Properties: []*models.Property{
+					{Name: "title", DataType: schema.DataTypeText.PropString()},
+				},
+			},
+		}})
+		require.Nil(t, err)
+		assert.True(t, diff.Compatible)
+		assert.Empty(t, diff.ClassesAdded)
+		assert.Empty(t, diff.ClassesRemoved)
+		assert.Empty(t, diff.ClassesChanged)
+	})
+
+	t.Run("new class and removed class", func(t *testing.T) {
+		diff, err := sm.DiffSchema(ctx, nil, &models.Schema{Classes: []*models.Class{
+			{Class: "author"},
+		}})
+		require.Nil(t, err)
+		assert.True(t, diff.Compatible)
+		assert.Equal(t, []string{"Author"}, diff.ClassesAdded)
+		assert.Equal(t, []string{"Article"}, diff.ClassesRemoved)
+	})
+
+	t.Run("invalid target class", func(t *testing.T) {
+		_, err := sm.DiffSchema(ctx, nil, &models.Schema{Classes: []*models.Class{
+			{
+				Class: "Author",
+				Properties: []*models.Property{
+					{Name: "name", DataType: []string{"unknown"}},
+				},
+			},
+		}})
+		assert.NotNil(t, err)
+	})
+
+	t.Run("config which the migrator rejects", func(t *testing.T) {
+		sm.migrator = &configMigrator{
+			vectorConfigValidationError: errors.Errorf("don't think so!"),
+		}
+		defer func() { sm.migrator = &NilMigrator{} }()
+
+		diff, err := sm.DiffSchema(ctx, nil, &models.Schema{Classes: []*models.Class{
+			{
+				Class:       "Article",
+				Description: "updated",
+				Properties: []*models.Property{
+					{Name: "title", DataType: schema.DataTypeText.PropString()},
+				},
+			},
+		}})
+		require.Nil(t, err)
+		assert.False(t, diff.Compatible)
+		require.Len(t, diff.ClassesChanged, 1)
+		assert.Equal(t, []string{"vector index config: don't think so!"},
+			diff.ClassesChanged[0].IncompatibleChanges)
+	})
+}
+
+func TestValidateUpdateClass(t *testing.T) {
+	ctx := context.Background()
+	sm := newSchemaManager()
+	initial := &models.Class{Class: "Article", Vectorizer: "model1"}
+	require.Nil(t, sm.AddClass(ctx, nil, initial))
+
+	t.Run("a class which doesn't exist", func(t *testing.T) {
+		err := sm.ValidateUpdateClass(ctx, nil, "WrongClass", &models.Class{})
+		assert.Equal(t, ErrNotFound, err)
+	})
+
+	t.Run("an invalid update", func(t *testing.T) {
+		err := sm.ValidateUpdateClass(ctx, nil, "Article",
+			&models.Class{Class: "Article", Vectorizer: "model2"})
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "vectorizer is immutable")
+	})
+
+	t.Run("a valid update is not applied", func(t *testing.T) {
+		err := sm.ValidateUpdateClass(ctx, nil, "Article",
+			&models.Class{Class: "Article", Vectorizer: "model1", Description: "updated"})
+		require.Nil(t, err)
+
+		class, err := sm.GetClass(ctx, nil, "Article")
+		require.Nil(t, err)
+		assert.Empty(t, class.Description)
+	})
+}
@@ -13,4 +13,19 @@ package schema
 
 import "errors"
 
-var ErrNotFound = errors.New("not found")
+var (
+	ErrNotFound = errors.New("not found")
+
+	// ErrInvalidRecallParams is returned if the sample size or k of a recall
+	// measurement is not positive
+	ErrInvalidRecallParams = errors.New("sample size and k must be positive")
+
+	// ErrSnapshotsDisabled is returned by vector index snapshot operations if
+	// no snapshot directory is configured
+	ErrSnapshotsDisabled = errors.New("vector index snapshots are disabled, " +
+		"set PERSISTENCE_VECTOR_INDEX_SNAPSHOT_PATH to enable them")
+
+	// ErrInvalidSnapshotFile is returned if the name of a snapshot file is not
+	// a plain file name within the snapshot directory
+	ErrInvalidSnapshotFile = errors.New("snapshot file must be a plain file name")
+)
@@ -0,0 +1,276 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// exportFormatVersion is the version of the document written by
+// ExportSchema. ImportSchema rejects documents of newer versions.
+const exportFormatVersion = 1
+
+// ExportSchema returns all classes including their configs together with the
+// tenants of all multi-tenant classes as a single portable document
+func (m *Manager) ExportSchema(ctx context.Context, principal *models.Principal,
+) (*models.SchemaExport, error) {
+	err := m.Authorizer.Authorize(principal, "list", "schema/*")
+	if err != nil {
+		return nil, err
+	}
+
+	doc := &models.SchemaExport{
+		Version: exportFormatVersion,
+		Classes: []*models.Class{},
+	}
+	if s := m.getSchema().Objects; s != nil {
+		doc.Classes = append(doc.Classes, s.Classes...)
+	}
+	sort.Slice(doc.Classes, func(i, j int) bool {
+		return doc.Classes[i].Class < doc.Classes[j].Class
+	})
+
+	for _, class := range doc.Classes {
+		if !schema.MultiTenancyEnabled(class) {
+			continue
+		}
+		tenants, err := m.GetTenants(ctx, principal, class.Class)
+		if err != nil {
+			return nil, fmt.Errorf("class %q: %w", class.Class, err)
+		}
+		sort.Slice(tenants, func(i, j int) bool {
+			return tenants[i].Name < tenants[j].Name
+		})
+		if doc.Tenants == nil {
+			doc.Tenants = map[string][]*models.Tenant{}
+		}
+		doc.Tenants[class.Class] = tenants
+	}
+
+	return doc, nil
+}
+
+// ImportSchema recreates the classes and tenants of a document written by
+// ExportSchema. Classes and properties which do not exist yet are created and
+// changed settings of existing classes are updated, everything else is left
+// untouched. This makes importing the same document multiple times a no-op.
+//
+// The document is compared with the current schema upfront, see DiffSchema,
+// so nothing is applied if any of its changes could not be applied.
+func (m *Manager) ImportSchema(ctx context.Context, principal *models.Principal,
+	doc *models.SchemaExport,
+) (*models.SchemaImportResult, error) {
+	err := m.Authorizer.Authorize(principal, "create", "schema/objects")
+	if err != nil {
+		return nil, err
+	}
+
+	if doc == nil {
+		doc = &models.SchemaExport{}
+	}
+	if doc.Version > exportFormatVersion {
+		return nil, fmt.Errorf("unsupported export format version %d", doc.Version)
+	}
+
+	for _, class := range doc.Classes {
+		if class != nil && schema.MultiTenancyEnabled(class) {
+			// derived from the multi-tenancy config when the class is created,
+			// see addClass
+			class.ShardingConfig = nil
+		}
+	}
+
+	target, err := copyClasses(doc.Classes)
+	if err != nil {
+		return nil, err
+	}
+	diff, err := m.DiffSchema(ctx, principal, &models.Schema{Classes: target})
+	if err != nil {
+		return nil, err
+	}
+	if !diff.Compatible {
+		var msgs []string
+		for _, classDiff := range diff.ClassesChanged {
+			for _, change := range classDiff.IncompatibleChanges {
+				msgs = append(msgs, fmt.Sprintf("class %q: %s", classDiff.Class, change))
+			}
+		}
+		return nil, fmt.Errorf("incompatible changes: %s", strings.Join(msgs, "; "))
+	}
+
+	res := &models.SchemaImportResult{
+		ClassesCreated:   []string{},
+		ClassesUpdated:   []string{},
+		ClassesUnchanged: []string{},
+	}
+
+	classes := map[string]*models.Class{}
+	for _, class := range doc.Classes {
+		class.Class = schema.UppercaseClassName(class.Class)
+		classes[class.Class] = class
+	}
+
+	added := map[string]bool{}
+	for _, name := range diff.ClassesAdded {
+		added[name] = true
+	}
+
+	// cross-references to classes which are created by this import can only
+	// be added once all of them exist
+	deferred := map[string][]*models.Property{}
+	for _, name := range diff.ClassesAdded {
+		class := classes[name]
+		var props []*models.Property
+		for _, prop := range class.Properties {
+			if referencesAny(prop, added) {
+				deferred[name] = append(deferred[name], prop)
+				continue
+			}
+			props = append(props, prop)
+		}
+		if len(deferred[name]) > 0 {
+			class.Properties = props
+		}
+
+		if err := m.AddClass(ctx, principal, class); err != nil {
+			return nil, fmt.Errorf("create class %q: %w", name, err)
+		}
+		res.ClassesCreated = append(res.ClassesCreated, name)
+	}
+	for _, name := range diff.ClassesAdded {
+		for _, prop := range deferred[name] {
+			if err := m.AddClassProperty(ctx, principal, name, prop); err != nil {
+				return nil, fmt.Errorf("class %q: add property %q: %w", name, prop.Name, err)
+			}
+		}
+	}
+
+	changed := map[string]bool{}
+	for _, classDiff := range diff.ClassesChanged {
+		class := classes[classDiff.Class]
+		changed[classDiff.Class] = true
+
+		for _, propName := range classDiff.PropertiesAdded {
+			prop, err := schema.GetPropertyByName(class, propName)
+			if err != nil {
+				return nil, fmt.Errorf("class %q: %w", classDiff.Class, err)
+			}
+			if err := m.AddClassProperty(ctx, principal, classDiff.Class, prop); err != nil {
+				return nil, fmt.Errorf("class %q: add property %q: %w",
+					classDiff.Class, propName, err)
+			}
+		}
+
+		if len(classDiff.SettingsChanged) > 0 {
+			// properties can not be updated, the new ones have been added above
+			current, err := m.schemaCache.readOnlyClass(classDiff.Class)
+			if err != nil {
+				return nil, err
+			}
+			class.Properties = current.Properties
+			if err := m.UpdateClass(ctx, principal, classDiff.Class, class); err != nil {
+				return nil, fmt.Errorf("update class %q: %w", classDiff.Class, err)
+			}
+		}
+		res.ClassesUpdated = append(res.ClassesUpdated, classDiff.Class)
+	}
+
+	for name := range classes {
+		if !added[name] && !changed[name] {
+			res.ClassesUnchanged = append(res.ClassesUnchanged, name)
+		}
+	}
+	sort.Strings(res.ClassesUnchanged)
+
+	created, err := m.importTenants(ctx, principal, doc.Tenants)
+	if err != nil {
+		return nil, err
+	}
+	res.TenantsCreated = int64(created)
+
+	return res, nil
+}
+
+// importTenants adds all tenants which do not exist yet and returns their
+// number
+func (m *Manager) importTenants(ctx context.Context, principal *models.Principal,
+	tenants map[string][]*models.Tenant,
+) (int, error) {
+	classNames := make([]string, 0, len(tenants))
+	for name := range tenants {
+		classNames = append(classNames, name)
+	}
+	sort.Strings(classNames)
+
+	created := 0
+	for _, name := range classNames {
+		className := schema.UppercaseClassName(name)
+		existing, err := m.GetTenants(ctx, principal, className)
+		if err != nil {
+			return created, fmt.Errorf("class %q: %w", className, err)
+		}
+		exists := map[string]bool{}
+		for _, tenant := range existing {
+			exists[tenant.Name] = true
+		}
+
+		var missing []*models.Tenant
+		for _, tenant := range tenants[name] {
+			if tenant != nil && !exists[tenant.Name] {
+				missing = append(missing, tenant)
+			}
+		}
+		if len(missing) == 0 {
+			continue
+		}
+		if _, err := m.AddTenants(ctx, principal, className, missing); err != nil {
+			return created, fmt.Errorf("class %q: add tenants: %w", className, err)
+		}
+		created += len(missing)
+	}
+
+	return created, nil
+}
+
+// referencesAny returns whether prop is a cross-reference to any of classes
+func referencesAny(prop *models.Property, classes map[string]bool) bool {
+	if len(prop.DataType) == 0 || !schema.IsRefDataType(prop.DataType) {
+		return false
+	}
+	for _, dt := range prop.DataType {
+		if classes[schema.UppercaseClassName(dt)] {
+			return true
+		}
+	}
+	return false
+}
+
+// copyClasses returns deep copies of classes, so that they can be normalized
+// without altering the originals
+func copyClasses(classes []*models.Class) ([]*models.Class, error) {
+	b, err := json.Marshal(classes)
+	if err != nil {
+		return nil, fmt.Errorf("marshal classes: %w", err)
+	}
+	var copied []*models.Class
+	if err := json.Unmarshal(b, &copied); err != nil {
+		return nil, fmt.Errorf("unmarshal classes: %w", err)
+	}
+	return copied, nil
+}
@@ -0,0 +1,145 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+func TestExportImportSchema(t *testing.T) {
+	ctx := context.Background()
+	source := newSchemaManager()
+	require.Nil(t, source.AddClass(ctx, nil, &models.Class{
+		Class:       "Author",
+		Description: "the author of an article",
+		Properties: []*models.Property{
+			{Name: "name", DataType: schema.DataTypeText.PropString()},
+		},
+	}))
+	require.Nil(t, source.AddClass(ctx, nil, &models.Class{
+		Class: "Article",
+		Properties: []*models.Property{
+			{Name: "title", DataType: schema.DataTypeText.PropString()},
+			{Name: "writtenBy", DataType: []string{"Author"}},
+		},
+	}))
+	require.Nil(t, source.AddClass(ctx, nil, &models.Class{
+		Class:              "Session",
+		MultiTenancyConfig: &models.MultiTenancyConfig{Enabled: true},
+	}))
+	_, err := source.AddTenants(ctx, nil, "Session", []*models.Tenant{
+		{Name: "tenant2"}, {Name: "tenant1", ActivityStatus: models.TenantActivityStatusCOLD},
+	})
+	require.Nil(t, err)
+
+	exported, err := source.ExportSchema(ctx, nil)
+	require.Nil(t, err)
+	assert.Equal(t, int64(exportFormatVersion), exported.Version)
+	require.Len(t, exported.Classes, 3)
+	assert.Equal(t, "Article", exported.Classes[0].Class)
+	assert.Equal(t, "Author", exported.Classes[1].Class)
+	assert.Equal(t, "Session", exported.Classes[2].Class)
+	assert.Equal(t, []*models.Tenant{
+		{Name: "tenant1", ActivityStatus: models.TenantActivityStatusCOLD},
+		{Name: "tenant2", ActivityStatus: models.TenantActivityStatusHOT},
+	}, exported.Tenants["Session"])
+
+	// the document is transferred to the other cluster as json
+	transfer := func(t *testing.T, doc *models.SchemaExport) *models.SchemaExport {
+		b, err := json.Marshal(doc)
+		require.Nil(t, err)
+		var out models.SchemaExport
+		require.Nil(t, json.Unmarshal(b, &out))
+		return &out
+	}
+
+	target := newSchemaManager()
+
+	t.Run("import into empty schema", func(t *testing.T) {
+		res, err := target.ImportSchema(ctx, nil, transfer(t, exported))
+		require.Nil(t, err)
+		assert.Equal(t, []string{"Article", "Author", "Session"}, res.ClassesCreated)
+		assert.Empty(t, res.ClassesUpdated)
+		assert.Empty(t, res.ClassesUnchanged)
+		assert.Equal(t, int64(2), res.TenantsCreated)
+
+		reexported, err := target.ExportSchema(ctx, nil)
+		require.Nil(t, err)
+		assert.JSONEq(t, mustJSON(t, exported), mustJSON(t, reexported))
+	})
+
+	t.Run("importing again does not change anything", func(t *testing.T) {
+		res, err := target.ImportSchema(ctx, nil, transfer(t, exported))
+		require.Nil(t, err)
+		assert.Empty(t, res.ClassesCreated)
+		assert.Empty(t, res.ClassesUpdated)
+		assert.Equal(t, []string{"Article", "Author", "Session"}, res.ClassesUnchanged)
+		assert.Equal(t, int64(0), res.TenantsCreated)
+	})
+
+	t.Run("import compatible changes", func(t *testing.T) {
+		doc := transfer(t, exported)
+		doc.Classes = doc.Classes[1:2]
+		doc.Classes[0].Description = "the author of many articles"
+		doc.Classes[0].Properties = append(doc.Classes[0].Properties,
+			&models.Property{Name: "bio", DataType: schema.DataTypeText.PropString()})
+		doc.Tenants["Session"] = append(doc.Tenants["Session"], &models.Tenant{Name: "tenant3"})
+
+		res, err := target.ImportSchema(ctx, nil, doc)
+		require.Nil(t, err)
+		assert.Empty(t, res.ClassesCreated)
+		assert.Equal(t, []string{"Author"}, res.ClassesUpdated)
+		assert.Empty(t, res.ClassesUnchanged)
+		assert.Equal(t, int64(1), res.TenantsCreated)
+
+		author := target.getClassByName("Author")
+		require.NotNil(t, author)
+		assert.Equal(t, "the author of many articles", author.Description)
+		_, err = schema.GetPropertyByName(author, "bio")
+		assert.Nil(t, err)
+		require.NotNil(t, target.getClassByName("Article"), "classes missing in the document are kept")
+	})
+
+	t.Run("reject incompatible changes", func(t *testing.T) {
+		doc := transfer(t, exported)
+		doc.Classes[0].Properties[0].DataType = schema.DataTypeInt.PropString()
+		doc.Classes[0].Properties[0].Tokenization = ""
+		doc.Classes[0].Properties[0].IndexSearchable = nil
+		doc.Classes = append(doc.Classes, &models.Class{Class: "Comment"})
+
+		_, err := target.ImportSchema(ctx, nil, doc)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), `property "title" can not be changed`)
+		assert.Nil(t, target.getClassByName("Comment"), "nothing is applied")
+	})
+
+	t.Run("reject unsupported version", func(t *testing.T) {
+		doc := transfer(t, exported)
+		doc.Version = exportFormatVersion + 1
+
+		_, err := target.ImportSchema(ctx, nil, doc)
+		assert.NotNil(t, err)
+	})
+}
+
+func mustJSON(t *testing.T, in any) string {
+	b, err := json.Marshal(in)
+	require.Nil(t, err)
+	return string(b)
+}
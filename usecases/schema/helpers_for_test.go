@@ -61,6 +61,19 @@ func (f *fakeRepo) DeleteShards(_ context.Context, class string, _ []string) err
 	return nil
 }
 
+func (f *fakeRepo) History(ctx context.Context, after uint64, limit int) (*models.SchemaVersions, error) {
+	return &models.SchemaVersions{}, nil
+}
+
+func (f *fakeRepo) SchemaAtVersion(ctx context.Context, version uint64) (*models.Schema, error) {
+	return nil, ErrNotFound
+}
+
+func (f *fakeRepo) UpdateStopwordSets(ctx context.Context, sets []*models.StopwordSet) error {
+	f.schema.ObjectSchema.StopwordSets = sets
+	return nil
+}
+
 type fakeAuthorizer struct{}
 
 func (f *fakeAuthorizer) Authorize(principal *models.Principal, verb, resource string) error {
@@ -75,7 +88,11 @@ func (f fakeVectorConfig) IndexType() string {
 	return "fake"
 }
 
-func dummyParseVectorConfig(in interface{}) (schema.VectorIndexConfig, error) {
+func (f fakeVectorConfig) DistanceName() string {
+	return ""
+}
+
+func dummyParseVectorConfig(in interface{}, vectorIndexType string) (schema.VectorIndexConfig, error) {
 	return fakeVectorConfig{raw: in}, nil
 }
 
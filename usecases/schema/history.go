@@ -0,0 +1,49 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// GetSchemaHistory returns up to limit recorded schema versions of this node
+// after the given version. The versions are local to each node.
+func (m *Manager) GetSchemaHistory(ctx context.Context, principal *models.Principal,
+	after, limit int64,
+) (*models.SchemaVersions, error) {
+	err := m.Authorizer.Authorize(principal, "list", "schema/*")
+	if err != nil {
+		return nil, err
+	}
+
+	if after < 0 {
+		after = 0
+	}
+	return m.repo.History(ctx, uint64(after), int(limit))
+}
+
+// GetSchemaAtVersion returns the schema of this node as of the given version
+func (m *Manager) GetSchemaAtVersion(ctx context.Context, principal *models.Principal,
+	version int64,
+) (*models.Schema, error) {
+	err := m.Authorizer.Authorize(principal, "list", "schema/*")
+	if err != nil {
+		return nil, err
+	}
+
+	if version <= 0 {
+		return nil, ErrNotFound
+	}
+	return m.repo.SchemaAtVersion(ctx, uint64(version))
+}
@@ -26,6 +26,16 @@ func (m *Manager) handleCommit(ctx context.Context, tx *cluster.Transaction) err
 		return m.handleAddClassCommit(ctx, tx)
 	case AddProperty:
 		return m.handleAddPropertyCommit(ctx, tx)
+	case RenameProperty:
+		return m.handleRenamePropertyCommit(ctx, tx)
+	case UpdatePropertyTokenization:
+		return m.handleUpdatePropertyTokenizationCommit(ctx, tx)
+	case LockVectorDimensions:
+		return m.handleLockVectorDimensionsCommit(ctx, tx)
+	case PutStopwordSet:
+		return m.handlePutStopwordSetCommit(ctx, tx)
+	case DeleteStopwordSet:
+		return m.handleDeleteStopwordSetCommit(ctx, tx)
 	case DeleteClass:
 		return m.handleDeleteClassCommit(ctx, tx)
 	case UpdateClass:
@@ -121,6 +131,84 @@ func (m *Manager) handleAddPropertyCommit(ctx context.Context,
 	return m.addClassPropertyApplyChanges(ctx, pl.ClassName, pl.Property)
 }
 
+func (m *Manager) handleRenamePropertyCommit(ctx context.Context,
+	tx *cluster.Transaction,
+) error {
+	m.Lock()
+	defer m.Unlock()
+
+	pl, ok := tx.Payload.(RenamePropertyPayload)
+	if !ok {
+		return errors.Errorf("expected commit payload to be RenamePropertyPayload, but got %T",
+			tx.Payload)
+	}
+
+	return m.renameClassPropertyApplyChanges(ctx, pl.ClassName, pl.PropertyName, pl.NewName)
+}
+
+func (m *Manager) handleUpdatePropertyTokenizationCommit(ctx context.Context,
+	tx *cluster.Transaction,
+) error {
+	m.Lock()
+	defer m.Unlock()
+
+	pl, ok := tx.Payload.(UpdatePropertyTokenizationPayload)
+	if !ok {
+		return errors.Errorf("expected commit payload to be UpdatePropertyTokenizationPayload, but got %T",
+			tx.Payload)
+	}
+
+	return m.updatePropertyTokenizationApplyChanges(ctx, pl.ClassName, pl.PropertyName,
+		pl.Tokenization, pl.Rollback)
+}
+
+func (m *Manager) handleLockVectorDimensionsCommit(ctx context.Context,
+	tx *cluster.Transaction,
+) error {
+	m.Lock()
+	defer m.Unlock()
+
+	pl, ok := tx.Payload.(LockVectorDimensionsPayload)
+	if !ok {
+		return errors.Errorf("expected commit payload to be LockVectorDimensionsPayload, but got %T",
+			tx.Payload)
+	}
+
+	_, err := m.lockVectorDimensionsApplyChanges(ctx, pl.ClassName, pl.TargetVector,
+		pl.Dimensions)
+	return err
+}
+
+func (m *Manager) handlePutStopwordSetCommit(ctx context.Context,
+	tx *cluster.Transaction,
+) error {
+	m.Lock()
+	defer m.Unlock()
+
+	pl, ok := tx.Payload.(PutStopwordSetPayload)
+	if !ok {
+		return errors.Errorf("expected commit payload to be PutStopwordSetPayload, but got %T",
+			tx.Payload)
+	}
+
+	return m.putStopwordSetApplyChanges(ctx, pl.Set)
+}
+
+func (m *Manager) handleDeleteStopwordSetCommit(ctx context.Context,
+	tx *cluster.Transaction,
+) error {
+	m.Lock()
+	defer m.Unlock()
+
+	pl, ok := tx.Payload.(DeleteStopwordSetPayload)
+	if !ok {
+		return errors.Errorf("expected commit payload to be DeleteStopwordSetPayload, but got %T",
+			tx.Payload)
+	}
+
+	return m.deleteStopwordSetApplyChanges(ctx, pl.Name)
+}
+
 func (m *Manager) handleDeleteClassCommit(ctx context.Context,
 	tx *cluster.Transaction,
 ) error {
@@ -116,6 +116,57 @@ func TestIncommingTxCommit(t *testing.T) {
 			},
 			expectedErrContains: "expected commit payload to be",
 		},
+		{
+			name: "rename property with incorrect payload",
+			tx: &cluster.Transaction{
+				Type:    RenameProperty,
+				Payload: "wrong-payload",
+			},
+			expectedErrContains: "expected commit payload to be",
+		},
+		{
+			name: "update property tokenization with incorrect payload",
+			tx: &cluster.Transaction{
+				Type:    UpdatePropertyTokenization,
+				Payload: "wrong-payload",
+			},
+			expectedErrContains: "expected commit payload to be",
+		},
+		{
+			name: "successful lock vector dimensions",
+			tx: &cluster.Transaction{
+				Type: LockVectorDimensions,
+				Payload: LockVectorDimensionsPayload{
+					ClassName:  "FirstClass",
+					Dimensions: 3,
+				},
+			},
+			assertSchema: func(t *testing.T, sm *Manager) {
+				class, err := sm.GetClass(context.Background(), nil, "FirstClass")
+				require.Nil(t, err)
+				assert.Equal(t, int64(3), class.VectorDimensions)
+			},
+		},
+		{
+			name: "lock vector dimensions of an unknown target vector",
+			tx: &cluster.Transaction{
+				Type: LockVectorDimensions,
+				Payload: LockVectorDimensionsPayload{
+					ClassName:    "FirstClass",
+					TargetVector: "title",
+					Dimensions:   3,
+				},
+			},
+			expectedErrContains: "has no target vector \"title\"",
+		},
+		{
+			name: "lock vector dimensions with incorrect payload",
+			tx: &cluster.Transaction{
+				Type:    LockVectorDimensions,
+				Payload: "wrong-payload",
+			},
+			expectedErrContains: "expected commit payload to be",
+		},
 		{
 			name: "successful delete class",
 			tx: &cluster.Transaction{
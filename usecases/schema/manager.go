@@ -42,7 +42,7 @@ type Manager struct {
 	moduleConfig            ModuleConfig
 	cluster                 *cluster.TxManager
 	clusterState            clusterState
-	hnswConfigParser        VectorConfigParser
+	vectorIndexConfigParser VectorConfigParser
 	invertedConfigValidator InvertedConfigValidator
 	scaleOut                scaleOut
 	RestoreStatus           sync.Map
@@ -58,7 +58,7 @@ type Manager struct {
 	schemaCache
 }
 
-type VectorConfigParser func(in interface{}) (schema.VectorIndexConfig, error)
+type VectorConfigParser func(in interface{}, vectorIndexType string) (schema.VectorIndexConfig, error)
 
 type InvertedConfigValidator func(in *models.InvertedIndexConfig) error
 
@@ -114,6 +114,17 @@ type SchemaStore interface {
 	// DeleteShards deletes shards from a class
 	// If the class or a shard does not exist then nothing is done and a nil error is returned
 	DeleteShards(ctx context.Context, class string, shards []string) error
+
+	// History returns up to limit schema versions after the given version in ascending order
+	// All versions are returned if limit is not positive
+	History(ctx context.Context, after uint64, limit int) (*models.SchemaVersions, error)
+
+	// SchemaAtVersion returns the schema as of the given version
+	// ErrNotFound is returned if the version does not exist
+	SchemaAtVersion(ctx context.Context, version uint64) (*models.Schema, error)
+
+	// UpdateStopwordSets replaces the custom stopword sets
+	UpdateStopwordSets(ctx context.Context, sets []*models.StopwordSet) error
 }
 
 // KeyValuePair is used to serialize shards updates
@@ -158,7 +169,7 @@ type scaleOut interface {
 // NewManager creates a new manager
 func NewManager(migrator migrate.Migrator, repo SchemaStore,
 	logger logrus.FieldLogger, authorizer authorizer, config config.Config,
-	hnswConfigParser VectorConfigParser, vectorizerValidator VectorizerValidator,
+	vectorIndexConfigParser VectorConfigParser, vectorizerValidator VectorizerValidator,
 	invertedConfigValidator InvertedConfigValidator,
 	moduleConfig ModuleConfig, clusterState clusterState,
 	txClient cluster.Client, txPersistence cluster.Persistence,
@@ -172,7 +183,7 @@ func NewManager(migrator migrate.Migrator, repo SchemaStore,
 		schemaCache:             schemaCache{State: State{}},
 		logger:                  logger,
 		Authorizer:              authorizer,
-		hnswConfigParser:        hnswConfigParser,
+		vectorIndexConfigParser: vectorIndexConfigParser,
 		vectorizerValidator:     vectorizerValidator,
 		invertedConfigValidator: invertedConfigValidator,
 		moduleConfig:            moduleConfig,
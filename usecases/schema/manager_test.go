@@ -73,6 +73,42 @@ func (n *NilMigrator) UpdateProperty(ctx context.Context, className string, prop
 	return nil
 }
 
+func (n *NilMigrator) UpdatePropertyTokenization(ctx context.Context, className string, prop *models.Property, tokenization string, onCommit func(), onFailure func(err error)) error {
+	return nil
+}
+
+func (n *NilMigrator) GetPropertyMigrations(ctx context.Context, className string) ([]*models.PropertyMigration, error) {
+	return nil, nil
+}
+
+func (n *NilMigrator) CheckVectorIndexIntegrity(ctx context.Context, className string, repair bool) ([]*models.VectorIndexIntegrity, error) {
+	return nil, nil
+}
+
+func (n *NilMigrator) MeasureVectorIndexRecall(ctx context.Context, className string, sampleSize, k int) ([]*models.VectorIndexRecall, error) {
+	return nil, nil
+}
+
+func (n *NilMigrator) ExportVectorIndexSnapshot(ctx context.Context, className, shardName, targetVector, path string) (*models.VectorIndexSnapshot, error) {
+	return nil, nil
+}
+
+func (n *NilMigrator) ImportVectorIndexSnapshot(ctx context.Context, className, shardName, targetVector, path string) (*models.VectorIndexSnapshot, error) {
+	return nil, nil
+}
+
+func (n *NilMigrator) BuildVectorIndex(ctx context.Context, className, shardName, targetVector string) (*models.VectorIndexBuild, error) {
+	return nil, nil
+}
+
+func (n *NilMigrator) CompactShard(ctx context.Context, className, shardName string) (*models.ShardCompaction, error) {
+	return nil, nil
+}
+
+func (n *NilMigrator) VectorIndexTombstoneCleanup(ctx context.Context, className string, paused *bool) ([]*models.VectorIndexTombstoneCleanup, error) {
+	return nil, nil
+}
+
 func (n *NilMigrator) UpdatePropertyAddDataType(ctx context.Context, className string, propName string, newDataType string) error {
 	return nil
 }
@@ -97,6 +133,10 @@ func (n *NilMigrator) UpdateInvertedIndexConfig(ctx context.Context, className s
 	return nil
 }
 
+func (n *NilMigrator) UpdateMemtableConfig(ctx context.Context, className string, updated *models.MemtableConfig) error {
+	return nil
+}
+
 func (n *NilMigrator) RecalculateVectorDimensions(ctx context.Context) error {
 	return nil
 }
@@ -0,0 +1,77 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestAddClassMemtableConfig(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("all thresholds set", func(t *testing.T) {
+		cfg := &models.MemtableConfig{MaxSizeMB: 64, MaxAgeSeconds: 300, MaxWALSizeMB: 256}
+		sm := newSchemaManager()
+		require.Nil(t, sm.AddClass(ctx, nil, &models.Class{
+			Class:          "Event",
+			MemtableConfig: cfg,
+		}))
+
+		class, err := sm.GetClass(ctx, nil, "Event")
+		require.Nil(t, err)
+		assert.Equal(t, cfg, class.MemtableConfig)
+	})
+
+	for _, cfg := range []*models.MemtableConfig{
+		{MaxSizeMB: -1},
+		{MaxAgeSeconds: -1},
+		{MaxWALSizeMB: -1},
+	} {
+		err := newSchemaManager().AddClass(ctx, nil, &models.Class{
+			Class:          "Event",
+			MemtableConfig: cfg,
+		})
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "must not be negative")
+	}
+}
+
+func TestUpdateClassMemtableConfig(t *testing.T) {
+	ctx := context.Background()
+	sm := newSchemaManager()
+	require.Nil(t, sm.AddClass(ctx, nil, &models.Class{Class: "Event"}))
+
+	updated := func(cfg *models.MemtableConfig) *models.Class {
+		return &models.Class{Class: "Event", MemtableConfig: cfg}
+	}
+
+	t.Run("set thresholds", func(t *testing.T) {
+		cfg := &models.MemtableConfig{MaxAgeSeconds: 60}
+		require.Nil(t, sm.UpdateClass(ctx, nil, "Event", updated(cfg)))
+
+		class, err := sm.GetClass(ctx, nil, "Event")
+		require.Nil(t, err)
+		assert.Equal(t, cfg, class.MemtableConfig)
+	})
+
+	t.Run("negative threshold", func(t *testing.T) {
+		cfg := &models.MemtableConfig{MaxWALSizeMB: -5}
+		err := sm.UpdateClass(ctx, nil, "Event", updated(cfg))
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "maxWALSizeMB must not be negative")
+	})
+}
@@ -44,6 +44,23 @@ type Migrator interface {
 		prop *models.Property) error
 	UpdateProperty(ctx context.Context, className string,
 		propName string, newName *string) error
+	UpdatePropertyTokenization(ctx context.Context, className string,
+		prop *models.Property, tokenization string, onCommit func(), onFailure func(err error)) error
+	GetPropertyMigrations(ctx context.Context, className string) ([]*models.PropertyMigration, error)
+	CheckVectorIndexIntegrity(ctx context.Context, className string,
+		repair bool) ([]*models.VectorIndexIntegrity, error)
+	MeasureVectorIndexRecall(ctx context.Context, className string,
+		sampleSize, k int) ([]*models.VectorIndexRecall, error)
+	ExportVectorIndexSnapshot(ctx context.Context, className, shardName,
+		targetVector, path string) (*models.VectorIndexSnapshot, error)
+	ImportVectorIndexSnapshot(ctx context.Context, className, shardName,
+		targetVector, path string) (*models.VectorIndexSnapshot, error)
+	BuildVectorIndex(ctx context.Context, className, shardName,
+		targetVector string) (*models.VectorIndexBuild, error)
+	CompactShard(ctx context.Context, className,
+		shardName string) (*models.ShardCompaction, error)
+	VectorIndexTombstoneCleanup(ctx context.Context, className string,
+		paused *bool) ([]*models.VectorIndexTombstoneCleanup, error)
 
 	NewTenants(ctx context.Context, class *models.Class, creates []*CreateTenantPayload) (commit func(success bool), err error)
 	UpdateTenants(ctx context.Context, class *models.Class, updates []*UpdateTenantPayload) (commit func(success bool), err error)
@@ -57,6 +74,8 @@ type Migrator interface {
 		old, updated *models.InvertedIndexConfig) error
 	UpdateInvertedIndexConfig(ctx context.Context, className string,
 		updated *models.InvertedIndexConfig) error
+	UpdateMemtableConfig(ctx context.Context, className string,
+		updated *models.MemtableConfig) error
 	RecalculateVectorDimensions(ctx context.Context) error
 	RecountProperties(ctx context.Context) error
 	InvertedReindex(ctx context.Context, taskNames ...string) error
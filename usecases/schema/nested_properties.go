@@ -0,0 +1,114 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// validateNestedProperties makes sure nestedProperties are set if and only
+// if the property is of type object/object[] and that every nested property
+// has a valid name and a supported data type
+func validateNestedProperties(prop *models.Property) error {
+	return validateNestedPropertiesOf(prop.DataType, prop.NestedProperties)
+}
+
+func validateNestedPropertiesOf(dataType []string, nestedProps []*models.NestedProperty) error {
+	if !schema.IsNestedDataType(dataType) {
+		if len(nestedProps) > 0 {
+			return fmt.Errorf("nestedProperties are only allowed for data types %q and %q",
+				schema.DataTypeObject, schema.DataTypeObjectArray)
+		}
+		return nil
+	}
+
+	if len(nestedProps) == 0 {
+		return fmt.Errorf("nestedProperties must be set for data type %q", dataType[0])
+	}
+
+	names := map[string]bool{}
+	for _, np := range nestedProps {
+		if np == nil {
+			return fmt.Errorf("nested property must not be null")
+		}
+		if _, err := schema.ValidatePropertyName(np.Name); err != nil {
+			return err
+		}
+		if names[strings.ToLower(np.Name)] {
+			return fmt.Errorf("nested property %q: already in use or provided multiple times", np.Name)
+		}
+		names[strings.ToLower(np.Name)] = true
+
+		if err := validateNestedPropertyDataType(np); err != nil {
+			return fmt.Errorf("nested property %q: %w", np.Name, err)
+		}
+		if err := validateNestedPropertiesOf(np.DataType, np.NestedProperties); err != nil {
+			return fmt.Errorf("nested property %q: %w", np.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func validateNestedPropertyDataType(np *models.NestedProperty) error {
+	if len(np.DataType) != 1 {
+		return fmt.Errorf("invalid dataType: exactly one data type must be set")
+	}
+
+	dataType, ok := schema.AsPrimitive(np.DataType)
+	if !ok || dataType == "" {
+		return fmt.Errorf("invalid dataType: cross-references are not supported in nested properties")
+	}
+
+	switch dataType {
+	case schema.DataTypeString, schema.DataTypeStringArray:
+		return fmt.Errorf("invalid dataType: deprecated data type %q is not supported in "+
+			"nested properties, use %q instead", dataType, schema.DataTypeText)
+	case schema.DataTypeText, schema.DataTypeTextArray:
+		switch np.Tokenization {
+		case "", models.PropertyTokenizationField, models.PropertyTokenizationWord,
+			models.PropertyTokenizationWhitespace, models.PropertyTokenizationLowercase,
+			models.PropertyTokenizationLowercaseKeyword, models.PropertyTokenizationLowercaseKeywordTr,
+			models.PropertyTokenizationLowercaseKeywordDe, models.PropertyTokenizationCjk,
+			models.PropertyTokenizationStemEn, models.PropertyTokenizationStemDe, models.PropertyTokenizationStemFr,
+			models.PropertyTokenizationStemEs, models.PropertyTokenizationStemIt:
+			return nil
+		}
+		return fmt.Errorf("Tokenization '%s' is not allowed for data type '%s'", np.Tokenization, dataType)
+	default:
+		if np.Tokenization != "" {
+			return fmt.Errorf("Tokenization is not allowed for data type '%s'", dataType)
+		}
+		return nil
+	}
+}
+
+func setNestedPropertiesDefaults(nestedProps []*models.NestedProperty) {
+	for _, np := range nestedProps {
+		if np == nil {
+			continue
+		}
+		switch dataType, _ := schema.AsPrimitive(np.DataType); dataType {
+		case schema.DataTypeText, schema.DataTypeTextArray:
+			if np.Tokenization == "" {
+				np.Tokenization = models.PropertyTokenizationWord
+			}
+		default:
+			// tokenization not supported for other data types
+		}
+		setNestedPropertiesDefaults(np.NestedProperties)
+	}
+}
@@ -0,0 +1,140 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+func Test_Validation_NestedProperties(t *testing.T) {
+	type testCase struct {
+		name        string
+		prop        *models.Property
+		expectedErr string
+	}
+
+	tests := []testCase{
+		{
+			name: "object with nested properties",
+			prop: &models.Property{
+				Name:     "address",
+				DataType: schema.DataTypeObject.PropString(),
+				NestedProperties: []*models.NestedProperty{
+					{Name: "city", DataType: schema.DataTypeText.PropString()},
+					{
+						Name:     "geo",
+						DataType: schema.DataTypeObjectArray.PropString(),
+						NestedProperties: []*models.NestedProperty{
+							{Name: "lat", DataType: schema.DataTypeNumber.PropString()},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "object without nested properties",
+			prop: &models.Property{
+				Name:     "address",
+				DataType: schema.DataTypeObject.PropString(),
+			},
+			expectedErr: "nestedProperties must be set",
+		},
+		{
+			name: "nested properties on a primitive",
+			prop: &models.Property{
+				Name:     "address",
+				DataType: schema.DataTypeText.PropString(),
+				NestedProperties: []*models.NestedProperty{
+					{Name: "city", DataType: schema.DataTypeText.PropString()},
+				},
+			},
+			expectedErr: "nestedProperties are only allowed",
+		},
+		{
+			name: "duplicate nested property",
+			prop: &models.Property{
+				Name:     "address",
+				DataType: schema.DataTypeObject.PropString(),
+				NestedProperties: []*models.NestedProperty{
+					{Name: "city", DataType: schema.DataTypeText.PropString()},
+					{Name: "City", DataType: schema.DataTypeText.PropString()},
+				},
+			},
+			expectedErr: "already in use",
+		},
+		{
+			name: "reference as nested property",
+			prop: &models.Property{
+				Name:     "address",
+				DataType: schema.DataTypeObject.PropString(),
+				NestedProperties: []*models.NestedProperty{
+					{Name: "city", DataType: []string{"City"}},
+				},
+			},
+			expectedErr: "cross-references are not supported",
+		},
+		{
+			name: "tokenization on nested non-text property",
+			prop: &models.Property{
+				Name:     "address",
+				DataType: schema.DataTypeObject.PropString(),
+				NestedProperties: []*models.NestedProperty{
+					{
+						Name:         "zip",
+						DataType:     schema.DataTypeInt.PropString(),
+						Tokenization: models.PropertyTokenizationWord,
+					},
+				},
+			},
+			expectedErr: "Tokenization is not allowed",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			m := newSchemaManager()
+			err := m.AddClass(context.Background(), nil, &models.Class{
+				Class:      "NestedClass",
+				Vectorizer: "none",
+				Properties: []*models.Property{test.prop},
+			})
+			if test.expectedErr == "" {
+				require.Nil(t, err)
+				return
+			}
+			require.NotNil(t, err)
+			assert.Contains(t, err.Error(), test.expectedErr)
+		})
+	}
+
+	t.Run("defaults are set on nested properties", func(t *testing.T) {
+		m := newSchemaManager()
+		err := m.AddClass(context.Background(), nil, &models.Class{
+			Class:      "NestedClass",
+			Vectorizer: "none",
+			Properties: []*models.Property{tests[0].prop},
+		})
+		require.Nil(t, err)
+
+		class := m.getClassByName("NestedClass")
+		require.NotNil(t, class)
+		prop := class.Properties[0]
+		assert.True(t, *prop.IndexFilterable)
+		assert.Equal(t, models.PropertyTokenizationWord, prop.NestedProperties[0].Tokenization)
+	})
+}
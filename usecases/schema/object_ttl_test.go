@@ -0,0 +1,91 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestAddClassObjectTTL(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("enabled with a positive ttl", func(t *testing.T) {
+		sm := newSchemaManager()
+		err := sm.AddClass(ctx, nil, &models.Class{
+			Class:     "Session",
+			ObjectTTL: &models.ObjectTTLConfig{Enabled: true, TTLSeconds: 3600},
+		})
+		require.Nil(t, err)
+
+		class, err := sm.GetClass(ctx, nil, "Session")
+		require.Nil(t, err)
+		assert.Equal(t, &models.ObjectTTLConfig{Enabled: true, TTLSeconds: 3600}, class.ObjectTTL)
+	})
+
+	t.Run("enabled without a ttl", func(t *testing.T) {
+		err := newSchemaManager().AddClass(ctx, nil, &models.Class{
+			Class:     "Session",
+			ObjectTTL: &models.ObjectTTLConfig{Enabled: true},
+		})
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "ttlSeconds must be greater than 0")
+	})
+
+	t.Run("negative ttl", func(t *testing.T) {
+		err := newSchemaManager().AddClass(ctx, nil, &models.Class{
+			Class:     "Session",
+			ObjectTTL: &models.ObjectTTLConfig{TTLSeconds: -1},
+		})
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "must not be negative")
+	})
+}
+
+func TestUpdateClassObjectTTL(t *testing.T) {
+	ctx := context.Background()
+	sm := newSchemaManager()
+	require.Nil(t, sm.AddClass(ctx, nil, &models.Class{Class: "Session"}))
+
+	updated := func(cfg *models.ObjectTTLConfig) *models.Class {
+		return &models.Class{Class: "Session", ObjectTTL: cfg}
+	}
+
+	t.Run("enable ttl", func(t *testing.T) {
+		cfg := &models.ObjectTTLConfig{Enabled: true, TTLSeconds: 60}
+		require.Nil(t, sm.UpdateClass(ctx, nil, "Session", updated(cfg)))
+
+		class, err := sm.GetClass(ctx, nil, "Session")
+		require.Nil(t, err)
+		assert.Equal(t, cfg, class.ObjectTTL)
+	})
+
+	t.Run("enable ttl without a ttl", func(t *testing.T) {
+		cfg := &models.ObjectTTLConfig{Enabled: true}
+		err := sm.UpdateClass(ctx, nil, "Session", updated(cfg))
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "ttlSeconds must be greater than 0")
+	})
+
+	t.Run("disable ttl", func(t *testing.T) {
+		cfg := &models.ObjectTTLConfig{Enabled: false, TTLSeconds: 60}
+		require.Nil(t, sm.UpdateClass(ctx, nil, "Session", updated(cfg)))
+
+		class, err := sm.GetClass(ctx, nil, "Session")
+		require.Nil(t, err)
+		assert.False(t, class.ObjectTTL.Enabled)
+	})
+}
@@ -0,0 +1,237 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// UpdateClassPropertyTokenization changes the tokenization of a text
+// property. The inverted index of the property is rebuilt in the background.
+// Until this is completed writes to the class are rejected, the progress can
+// be retrieved with GetPropertyMigrations. The schema of each node changes to
+// the new tokenization once its index has been rebuilt, so the returned
+// property still has the previous one. If the index can not be rebuilt on any
+// node, the previous tokenization is restored.
+func (m *Manager) UpdateClassPropertyTokenization(ctx context.Context, principal *models.Principal,
+	className, propName, tokenization string,
+) (*models.Property, error) {
+	err := m.Authorizer.Authorize(principal, "update", "schema/objects")
+	if err != nil {
+		return nil, err
+	}
+
+	return m.updateClassPropertyTokenization(ctx, className, propName, tokenization)
+}
+
+func (m *Manager) updateClassPropertyTokenization(ctx context.Context,
+	className, propName, tokenization string,
+) (*models.Property, error) {
+	m.Lock()
+	defer m.Unlock()
+
+	class, err := m.schemaCache.readOnlyClass(className)
+	if err != nil {
+		return nil, err
+	}
+	prop, err := schema.GetPropertyByName(class, propName)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.validatePropertyTokenizationUpdate(class, prop, tokenization); err != nil {
+		return nil, err
+	}
+	if prop.Tokenization == tokenization {
+		return prop, nil
+	}
+
+	// writes are blocked while a migration is running, so they must not
+	// overlap. Other nodes would only reject it once the change is committed
+	migrations, err := m.migrator.GetPropertyMigrations(ctx, className)
+	if err != nil {
+		return nil, err
+	}
+	for _, migration := range migrations {
+		if migration.Status == models.PropertyMigrationStatusSTARTED {
+			return nil, fmt.Errorf("migration of property %q is still running", migration.Property)
+		}
+	}
+
+	tx, err := m.cluster.BeginTransaction(ctx, UpdatePropertyTokenization,
+		UpdatePropertyTokenizationPayload{className, prop.Name, tokenization, false}, DefaultTxTTL)
+	if err != nil {
+		// possible causes for errors could be nodes down (we expect every node to
+		// the up for a schema transaction) or concurrent transactions from other
+		// nodes
+		return nil, errors.Wrap(err, "open cluster-wide transaction")
+	}
+
+	if err := m.cluster.CommitWriteTransaction(ctx, tx); err != nil {
+		// Only log the commit error, but do not abort the changes locally. Once
+		// we've told others to commit, we also need to commit ourselves!
+		m.logger.WithError(err).Errorf("not every node was able to commit")
+	}
+
+	if err := m.updatePropertyTokenizationApplyChanges(ctx, className, prop.Name,
+		tokenization, false); err != nil {
+		return nil, err
+	}
+
+	return prop, nil
+}
+
+func (m *Manager) validatePropertyTokenizationUpdate(class *models.Class,
+	prop *models.Property, tokenization string,
+) error {
+	switch dt, _ := schema.AsPrimitive(prop.DataType); dt {
+	case schema.DataTypeText, schema.DataTypeTextArray:
+	default:
+		return fmt.Errorf("tokenization of property %q can not be changed: only supported for %s and %s",
+			prop.Name, schema.DataTypeText, schema.DataTypeTextArray)
+	}
+
+	sch := m.getSchema()
+	propertyDataType, err := (&sch).FindPropertyDataTypeWithRefs(prop.DataType,
+		false, schema.ClassName(class.Class))
+	if err != nil {
+		return fmt.Errorf("property '%s': invalid dataType: %v", prop.Name, err)
+	}
+	if err := m.validatePropertyTokenization(tokenization, propertyDataType); err != nil {
+		return err
+	}
+
+	// the index of inactive tenants can not be rebuilt
+	if schema.MultiTenancyEnabled(class) {
+		return fmt.Errorf("changing the tokenization of multi-tenant classes is not supported")
+	}
+
+	return nil
+}
+
+func (m *Manager) updatePropertyTokenizationApplyChanges(ctx context.Context,
+	className, propName, tokenization string, rollback bool,
+) error {
+	class, err := m.schemaCache.readOnlyClass(className)
+	if err != nil {
+		return err
+	}
+	prop, err := schema.GetPropertyByName(class, propName)
+	if err != nil {
+		return err
+	}
+
+	var onFailure func(err error)
+	if !rollback {
+		previous := prop.Tokenization
+		onFailure = func(err error) {
+			go m.rollbackPropertyTokenization(className, propName, previous, err)
+		}
+	}
+	onCommit := func() {
+		m.commitPropertyTokenization(className, propName, tokenization)
+	}
+
+	return m.migrator.UpdatePropertyTokenization(ctx, className, prop,
+		tokenization, onCommit, onFailure)
+}
+
+// commitPropertyTokenization changes the tokenization in the schema, once
+// the index of the property has been rebuilt with it. It is called by the
+// migrator while writes to the class are still blocked, so writes always use
+// the tokenization of the index.
+func (m *Manager) commitPropertyTokenization(className, propName, tokenization string) {
+	logger := m.logger.
+		WithField("action", "schema.update_property_tokenization").
+		WithField("class", className).
+		WithField("property", propName).
+		WithField("tokenization", tokenization)
+
+	ctx, cancel := context.WithTimeout(context.Background(), DefaultTxTTL)
+	defer cancel()
+
+	m.Lock()
+	defer m.Unlock()
+
+	updated, err := m.schemaCache.updatePropertyTokenization(className, propName, tokenization)
+	if err != nil {
+		logger.WithError(err).Error("changing the tokenization in the schema failed")
+		return
+	}
+	metadata, err := json.Marshal(&updated)
+	if err != nil {
+		logger.WithError(err).Error("changing the tokenization in the schema failed")
+		return
+	}
+	logger.Debug("saving updated schema to configuration store")
+	err = m.repo.UpdateClass(ctx, ClassPayload{Name: className, Metadata: metadata})
+	if err != nil {
+		logger.WithError(err).Error("changing the tokenization in the schema failed")
+		return
+	}
+	m.triggerSchemaUpdateCallbacks()
+}
+
+// rollbackPropertyTokenization restores the previous tokenization on all
+// nodes, after the index of the property could not be rebuilt on this one
+func (m *Manager) rollbackPropertyTokenization(className, propName, previous string,
+	cause error,
+) {
+	logger := m.logger.
+		WithField("action", "schema.rollback_property_tokenization").
+		WithField("class", className).
+		WithField("property", propName).
+		WithField("tokenization", previous)
+	logger.WithError(cause).Warn("rebuilding the index failed, restoring previous tokenization")
+
+	ctx, cancel := context.WithTimeout(context.Background(), DefaultTxTTL)
+	defer cancel()
+
+	m.Lock()
+	defer m.Unlock()
+
+	// the schema of this node still has the previous tokenization, as the
+	// index was not rebuilt, but other nodes may have changed it already
+	migrations, err := m.migrator.GetPropertyMigrations(ctx, className)
+	if err != nil {
+		logger.WithError(err).Error("restoring previous tokenization failed")
+		return
+	}
+	for _, migration := range migrations {
+		if migration.Property == propName && migration.NewValue == previous &&
+			migration.Status != models.PropertyMigrationStatusFAILED {
+			// already restored because of a failure on another node
+			return
+		}
+	}
+
+	tx, err := m.cluster.BeginTransaction(ctx, UpdatePropertyTokenization,
+		UpdatePropertyTokenizationPayload{className, propName, previous, true}, DefaultTxTTL)
+	if err != nil {
+		logger.WithError(err).Error("restoring previous tokenization failed: open cluster-wide transaction")
+		return
+	}
+	if err := m.cluster.CommitWriteTransaction(ctx, tx); err != nil {
+		logger.WithError(err).Errorf("not every node was able to commit")
+	}
+
+	if err := m.updatePropertyTokenizationApplyChanges(ctx, className, propName,
+		previous, true); err != nil {
+		logger.WithError(err).Error("restoring previous tokenization failed")
+	}
+}
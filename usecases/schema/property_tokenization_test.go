@@ -0,0 +1,188 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+type tokenizationMigrator struct {
+	NilMigrator
+	sync.Mutex
+	updates    []tokenizationUpdate
+	migrations []*models.PropertyMigration
+}
+
+type tokenizationUpdate struct {
+	previous  string
+	next      string
+	onCommit  func()
+	onFailure func(err error)
+}
+
+func (m *tokenizationMigrator) UpdatePropertyTokenization(ctx context.Context,
+	className string, prop *models.Property, tokenization string, onCommit func(),
+	onFailure func(err error),
+) error {
+	m.Lock()
+	defer m.Unlock()
+
+	m.updates = append(m.updates,
+		tokenizationUpdate{prop.Tokenization, tokenization, onCommit, onFailure})
+	return nil
+}
+
+func (m *tokenizationMigrator) GetPropertyMigrations(ctx context.Context,
+	className string,
+) ([]*models.PropertyMigration, error) {
+	return m.migrations, nil
+}
+
+func (m *tokenizationMigrator) lastUpdate() tokenizationUpdate {
+	m.Lock()
+	defer m.Unlock()
+
+	return m.updates[len(m.updates)-1]
+}
+
+func (m *tokenizationMigrator) updateCount() int {
+	m.Lock()
+	defer m.Unlock()
+
+	return len(m.updates)
+}
+
+func TestUpdateClassPropertyTokenization(t *testing.T) {
+	ctx := context.Background()
+	sm := newSchemaManager()
+	migrator := &tokenizationMigrator{}
+	sm.migrator = migrator
+
+	require.Nil(t, sm.AddClass(ctx, nil, &models.Class{
+		Class: "Article",
+		Properties: []*models.Property{
+			{Name: "title", DataType: schema.DataTypeText.PropString()},
+			{Name: "tags", DataType: schema.DataTypeTextArray.PropString()},
+			{Name: "count", DataType: schema.DataTypeInt.PropString()},
+		},
+	}))
+
+	tokenizationOf := func(t *testing.T, propName string) string {
+		class, err := sm.GetClass(ctx, nil, "Article")
+		require.Nil(t, err)
+		prop, err := schema.GetPropertyByName(class, propName)
+		require.Nil(t, err)
+		return prop.Tokenization
+	}
+
+	t.Run("a property which doesn't exist", func(t *testing.T) {
+		_, err := sm.UpdateClassPropertyTokenization(ctx, nil, "Article", "wrong",
+			models.PropertyTokenizationField)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("a property which is not text", func(t *testing.T) {
+		_, err := sm.UpdateClassPropertyTokenization(ctx, nil, "Article", "count",
+			models.PropertyTokenizationField)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "only supported for")
+	})
+
+	t.Run("an invalid tokenization", func(t *testing.T) {
+		_, err := sm.UpdateClassPropertyTokenization(ctx, nil, "Article", "title", "unknown")
+		assert.NotNil(t, err)
+	})
+
+	t.Run("while another migration is running", func(t *testing.T) {
+		migrator.migrations = []*models.PropertyMigration{
+			{Property: "other", Status: models.PropertyMigrationStatusSTARTED},
+		}
+		defer func() { migrator.migrations = nil }()
+
+		_, err := sm.UpdateClassPropertyTokenization(ctx, nil, "Article", "title",
+			models.PropertyTokenizationField)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "still running")
+	})
+
+	t.Run("the current tokenization", func(t *testing.T) {
+		prop, err := sm.UpdateClassPropertyTokenization(ctx, nil, "Article", "title",
+			models.PropertyTokenizationWord)
+		require.Nil(t, err)
+		assert.Equal(t, models.PropertyTokenizationWord, prop.Tokenization)
+		assert.Empty(t, migrator.updates)
+	})
+
+	t.Run("a valid update", func(t *testing.T) {
+		prop, err := sm.UpdateClassPropertyTokenization(ctx, nil, "Article", "tags",
+			models.PropertyTokenizationField)
+		require.Nil(t, err)
+		assert.Equal(t, models.PropertyTokenizationWord, prop.Tokenization)
+
+		update := migrator.lastUpdate()
+		assert.Equal(t, models.PropertyTokenizationWord, update.previous)
+		assert.Equal(t, models.PropertyTokenizationField, update.next)
+		assert.NotNil(t, update.onFailure)
+
+		// the schema only changes once the index has been rebuilt
+		assert.Equal(t, models.PropertyTokenizationWord, tokenizationOf(t, "tags"))
+		update.onCommit()
+		assert.Equal(t, models.PropertyTokenizationField, tokenizationOf(t, "tags"))
+	})
+
+	t.Run("the previous tokenization is restored if the index fails", func(t *testing.T) {
+		_, err := sm.UpdateClassPropertyTokenization(ctx, nil, "Article", "title",
+			models.PropertyTokenizationLowercase)
+		require.Nil(t, err)
+		updates := migrator.updateCount()
+
+		migrator.lastUpdate().onFailure(errors.New("disk full"))
+
+		assert.Eventually(t, func() bool {
+			return migrator.updateCount() > updates
+		}, 5*time.Second, 10*time.Millisecond)
+		assert.Equal(t, models.PropertyTokenizationWord, tokenizationOf(t, "title"))
+
+		// the rollback makes other nodes rebuild their index again, while the
+		// schema of this node was never changed
+		update := migrator.lastUpdate()
+		assert.Equal(t, models.PropertyTokenizationWord, update.previous)
+		assert.Equal(t, models.PropertyTokenizationWord, update.next)
+		assert.Nil(t, update.onFailure)
+	})
+}
+
+func TestUpdateClassPropertyTokenizationMultiTenancy(t *testing.T) {
+	ctx := context.Background()
+	sm := newSchemaManager()
+	require.Nil(t, sm.AddClass(ctx, nil, &models.Class{
+		Class:              "Article",
+		MultiTenancyConfig: &models.MultiTenancyConfig{Enabled: true},
+		Properties: []*models.Property{
+			{Name: "title", DataType: schema.DataTypeText.PropString()},
+		},
+	}))
+
+	_, err := sm.UpdateClassPropertyTokenization(ctx, nil, "Article", "title",
+		models.PropertyTokenizationField)
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "multi-tenant")
+}
@@ -0,0 +1,103 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestAddClassQuotaConfig(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("class and tenant quotas", func(t *testing.T) {
+		cfg := &models.QuotaConfig{
+			MaxObjects:         1000,
+			MaxDiskBytes:       1 << 30,
+			TenantMaxObjects:   100,
+			TenantMaxDiskBytes: 1 << 20,
+			WarningPercentage:  90,
+		}
+		sm := newSchemaManager()
+		require.Nil(t, sm.AddClass(ctx, nil, &models.Class{
+			Class:              "Event",
+			MultiTenancyConfig: &models.MultiTenancyConfig{Enabled: true},
+			QuotaConfig:        cfg,
+		}))
+
+		class, err := sm.GetClass(ctx, nil, "Event")
+		require.Nil(t, err)
+		assert.Equal(t, cfg, class.QuotaConfig)
+	})
+
+	t.Run("tenant quotas without multi-tenancy", func(t *testing.T) {
+		err := newSchemaManager().AddClass(ctx, nil, &models.Class{
+			Class:       "Event",
+			QuotaConfig: &models.QuotaConfig{TenantMaxObjects: 100},
+		})
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "tenant quotas require multi-tenancy")
+	})
+
+	for _, cfg := range []*models.QuotaConfig{
+		{MaxObjects: -1},
+		{MaxDiskBytes: -1},
+		{TenantMaxObjects: -1},
+		{TenantMaxDiskBytes: -1},
+	} {
+		err := newSchemaManager().AddClass(ctx, nil, &models.Class{
+			Class:       "Event",
+			QuotaConfig: cfg,
+		})
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "must not be negative")
+	}
+
+	t.Run("warning percentage out of range", func(t *testing.T) {
+		err := newSchemaManager().AddClass(ctx, nil, &models.Class{
+			Class:       "Event",
+			QuotaConfig: &models.QuotaConfig{WarningPercentage: 101},
+		})
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "warningPercentage must be between 0 and 100")
+	})
+}
+
+func TestUpdateClassQuotaConfig(t *testing.T) {
+	ctx := context.Background()
+	sm := newSchemaManager()
+	require.Nil(t, sm.AddClass(ctx, nil, &models.Class{Class: "Event"}))
+
+	updated := func(cfg *models.QuotaConfig) *models.Class {
+		return &models.Class{Class: "Event", QuotaConfig: cfg}
+	}
+
+	t.Run("set a class quota", func(t *testing.T) {
+		cfg := &models.QuotaConfig{MaxObjects: 10}
+		require.Nil(t, sm.UpdateClass(ctx, nil, "Event", updated(cfg)))
+
+		class, err := sm.GetClass(ctx, nil, "Event")
+		require.Nil(t, err)
+		assert.Equal(t, cfg, class.QuotaConfig)
+	})
+
+	t.Run("set a tenant quota without multi-tenancy", func(t *testing.T) {
+		cfg := &models.QuotaConfig{TenantMaxDiskBytes: 1024}
+		err := sm.UpdateClass(ctx, nil, "Event", updated(cfg))
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "tenant quotas require multi-tenancy")
+	})
+}
@@ -0,0 +1,232 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/schema/expression"
+)
+
+// RenameClassProperty renames an existing property. The indexed data is
+// retained, the stored objects are rewritten in the background. Until this is
+// completed writes to the class are rejected, the progress can be retrieved
+// with GetPropertyMigrations.
+func (m *Manager) RenameClassProperty(ctx context.Context, principal *models.Principal,
+	className, propName, newName string,
+) (*models.Property, error) {
+	err := m.Authorizer.Authorize(principal, "update", "schema/objects")
+	if err != nil {
+		return nil, err
+	}
+
+	return m.renameClassProperty(ctx, className, propName, newName)
+}
+
+func (m *Manager) renameClassProperty(ctx context.Context,
+	className, propName, newName string,
+) (*models.Property, error) {
+	m.Lock()
+	defer m.Unlock()
+
+	class, err := m.schemaCache.readOnlyClass(className)
+	if err != nil {
+		return nil, err
+	}
+	newName = schema.LowercaseFirstLetter(newName)
+
+	if err := validatePropertyRename(class, propName, newName); err != nil {
+		return nil, err
+	}
+
+	// writes are blocked while a migration is running, so they must not
+	// overlap. The schema would already be changed if the migrator rejected it
+	migrations, err := m.migrator.GetPropertyMigrations(ctx, className)
+	if err != nil {
+		return nil, err
+	}
+	for _, migration := range migrations {
+		if migration.Status == models.PropertyMigrationStatusSTARTED {
+			return nil, fmt.Errorf("migration of property %q is still running", migration.Property)
+		}
+	}
+
+	tx, err := m.cluster.BeginTransaction(ctx, RenameProperty,
+		RenamePropertyPayload{className, propName, newName}, DefaultTxTTL)
+	if err != nil {
+		// possible causes for errors could be nodes down (we expect every node to
+		// the up for a schema transaction) or concurrent transactions from other
+		// nodes
+		return nil, errors.Wrap(err, "open cluster-wide transaction")
+	}
+
+	if err := m.cluster.CommitWriteTransaction(ctx, tx); err != nil {
+		// Only log the commit error, but do not abort the changes locally. Once
+		// we've told others to commit, we also need to commit ourselves!
+		m.logger.WithError(err).Errorf("not every node was able to commit")
+	}
+
+	if err := m.renameClassPropertyApplyChanges(ctx, className, propName, newName); err != nil {
+		return nil, err
+	}
+
+	class, err = m.schemaCache.readOnlyClass(className)
+	if err != nil {
+		return nil, err
+	}
+	return schema.GetPropertyByName(class, newName)
+}
+
+func validatePropertyRename(class *models.Class, propName, newName string) error {
+	prop, err := schema.GetPropertyByName(class, propName)
+	if err != nil {
+		return err
+	}
+
+	if _, err := schema.ValidatePropertyName(newName); err != nil {
+		return err
+	}
+	if err := schema.ValidateReservedPropertyName(newName); err != nil {
+		return err
+	}
+	for _, existing := range class.Properties {
+		if strings.EqualFold(existing.Name, newName) {
+			return fmt.Errorf("class %q: conflict for property %q: already in use",
+				class.Class, newName)
+		}
+	}
+
+	// the objects of inactive tenants can not be rewritten
+	if schema.MultiTenancyEnabled(class) {
+		return fmt.Errorf("renaming properties of multi-tenant classes is not supported")
+	}
+
+	// the geo index is not stored in a bucket which could be renamed
+	if dt, ok := schema.AsPrimitive(prop.DataType); ok && dt == schema.DataTypeGeoCoordinates {
+		return fmt.Errorf("renaming %s properties is not supported", dt)
+	}
+
+	// module settings such as the fields to vectorize refer to properties
+	// by name and would silently break
+	if configReferencesProperty(reflect.ValueOf(class.ModuleConfig), propName) {
+		return fmt.Errorf("property %q is referenced in the moduleConfig of class %q",
+			propName, class.Class)
+	}
+
+	// the default weights of keyword searches refer to properties by name
+	if cfg := class.InvertedIndexConfig; cfg != nil && cfg.Bm25 != nil {
+		if _, ok := cfg.Bm25.PropertyBoosts[propName]; ok {
+			return fmt.Errorf("property %q is referenced in the bm25 propertyBoosts "+
+				"of class %q", propName, class.Class)
+		}
+	}
+
+	// expressions of computed properties refer to their inputs by name
+	for _, computed := range class.Properties {
+		if computed.Expression == "" {
+			continue
+		}
+		expr, err := expression.Parse(computed.Expression)
+		if err != nil {
+			return err
+		}
+		for _, input := range expr.Properties() {
+			if input == propName {
+				return fmt.Errorf("property %q is referenced in the expression of "+
+					"computed property %q", propName, computed.Name)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *Manager) renameClassPropertyApplyChanges(ctx context.Context,
+	className, propName, newName string,
+) error {
+	class, err := m.schemaCache.renameProperty(className, propName, newName)
+	if err != nil {
+		return err
+	}
+	metadata, err := json.Marshal(&class)
+	if err != nil {
+		return fmt.Errorf("marshal class %s: %w", className, err)
+	}
+	m.logger.
+		WithField("action", "schema.rename_property").
+		Debug("saving updated schema to configuration store")
+	err = m.repo.UpdateClass(ctx, ClassPayload{Name: className, Metadata: metadata})
+	if err != nil {
+		return err
+	}
+	m.triggerSchemaUpdateCallbacks()
+
+	// will result in a mismatch between schema and index if function below fails
+	return m.migrator.UpdateProperty(ctx, className, propName, &newName)
+}
+
+// GetPropertyMigrations returns the progress of the background migrations of
+// the stored data of properties of a class on this node
+func (m *Manager) GetPropertyMigrations(ctx context.Context, principal *models.Principal,
+	className string,
+) ([]*models.PropertyMigration, error) {
+	err := m.Authorizer.Authorize(principal, "list", fmt.Sprintf("schema/%s/migrations", className))
+	if err != nil {
+		return nil, err
+	}
+
+	if !m.schemaCache.classExist(className) {
+		return nil, ErrNotFound
+	}
+
+	return m.migrator.GetPropertyMigrations(ctx, className)
+}
+
+// configReferencesProperty returns whether any value of the parsed module
+// config equals the name of the property. Keys are the names of modules and
+// their settings, so they are not considered.
+func configReferencesProperty(config reflect.Value, propName string) bool {
+	switch config.Kind() {
+	case reflect.Interface, reflect.Pointer:
+		return !config.IsNil() && configReferencesProperty(config.Elem(), propName)
+	case reflect.String:
+		return config.String() == propName
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < config.Len(); i++ {
+			if configReferencesProperty(config.Index(i), propName) {
+				return true
+			}
+		}
+	case reflect.Map:
+		iter := config.MapRange()
+		for iter.Next() {
+			if configReferencesProperty(iter.Value(), propName) {
+				return true
+			}
+		}
+	case reflect.Struct:
+		for i := 0; i < config.NumField(); i++ {
+			if config.Type().Field(i).IsExported() &&
+				configReferencesProperty(config.Field(i), propName) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,163 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+type renameMigrator struct {
+	NilMigrator
+	renamed    map[string]string
+	migrations []*models.PropertyMigration
+}
+
+func (m *renameMigrator) UpdateProperty(ctx context.Context, className string,
+	propName string, newName *string,
+) error {
+	m.renamed[propName] = *newName
+	return nil
+}
+
+func (m *renameMigrator) GetPropertyMigrations(ctx context.Context,
+	className string,
+) ([]*models.PropertyMigration, error) {
+	return m.migrations, nil
+}
+
+func TestRenameClassProperty(t *testing.T) {
+	ctx := context.Background()
+	sm := newSchemaManager()
+	migrator := &renameMigrator{renamed: map[string]string{}}
+	sm.migrator = migrator
+
+	require.Nil(t, sm.AddClass(ctx, nil, &models.Class{
+		Class: "Article",
+		Properties: []*models.Property{
+			{Name: "title", DataType: schema.DataTypeText.PropString()},
+			{Name: "body", DataType: schema.DataTypeText.PropString()},
+			{Name: "location", DataType: schema.DataTypeGeoCoordinates.PropString()},
+		},
+	}))
+
+	t.Run("a property which doesn't exist", func(t *testing.T) {
+		_, err := sm.RenameClassProperty(ctx, nil, "Article", "wrong", "other")
+		assert.NotNil(t, err)
+	})
+
+	t.Run("to the name of another property", func(t *testing.T) {
+		_, err := sm.RenameClassProperty(ctx, nil, "Article", "title", "Body")
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "already in use")
+	})
+
+	t.Run("to a reserved name", func(t *testing.T) {
+		_, err := sm.RenameClassProperty(ctx, nil, "Article", "title", "_id")
+		assert.NotNil(t, err)
+	})
+
+	t.Run("a geo property", func(t *testing.T) {
+		_, err := sm.RenameClassProperty(ctx, nil, "Article", "location", "place")
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "not supported")
+	})
+
+	t.Run("while another migration is running", func(t *testing.T) {
+		migrator.migrations = []*models.PropertyMigration{
+			{Property: "other", Status: models.PropertyMigrationStatusSTARTED},
+		}
+		defer func() { migrator.migrations = nil }()
+
+		_, err := sm.RenameClassProperty(ctx, nil, "Article", "title", "headline")
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "still running")
+	})
+
+	t.Run("a valid rename", func(t *testing.T) {
+		prop, err := sm.RenameClassProperty(ctx, nil, "Article", "title", "Headline")
+		require.Nil(t, err)
+		assert.Equal(t, "headline", prop.Name)
+
+		class, err := sm.GetClass(ctx, nil, "Article")
+		require.Nil(t, err)
+		names := []string{}
+		for _, prop := range class.Properties {
+			names = append(names, prop.Name)
+		}
+		assert.Equal(t, []string{"headline", "body", "location"}, names)
+		assert.Equal(t, map[string]string{"title": "headline"}, migrator.renamed)
+	})
+}
+
+func TestValidatePropertyRenameModuleConfig(t *testing.T) {
+	class := &models.Class{
+		Class: "Review",
+		ModuleConfig: map[string]interface{}{
+			"generative-openai": map[string]interface{}{
+				"properties": []interface{}{"summary"},
+				"model":      "gpt-summary",
+			},
+		},
+		Properties: []*models.Property{
+			{Name: "model", DataType: schema.DataTypeText.PropString()},
+			{Name: "summary", DataType: schema.DataTypeText.PropString()},
+		},
+	}
+
+	t.Run("a property referenced in the moduleConfig", func(t *testing.T) {
+		err := validatePropertyRename(class, "summary", "abstract")
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "moduleConfig")
+	})
+
+	t.Run("a property named like a setting", func(t *testing.T) {
+		err := validatePropertyRename(class, "model", "product")
+		assert.Nil(t, err)
+	})
+}
+
+func TestRenameClassPropertyMultiTenancy(t *testing.T) {
+	ctx := context.Background()
+	sm := newSchemaManager()
+	require.Nil(t, sm.AddClass(ctx, nil, &models.Class{
+		Class:              "Article",
+		MultiTenancyConfig: &models.MultiTenancyConfig{Enabled: true},
+		Properties: []*models.Property{
+			{Name: "title", DataType: schema.DataTypeText.PropString()},
+		},
+	}))
+
+	_, err := sm.RenameClassProperty(ctx, nil, "Article", "title", "headline")
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "multi-tenant")
+}
+
+func TestGetPropertyMigrations(t *testing.T) {
+	ctx := context.Background()
+	sm := newSchemaManager()
+	migrations := []*models.PropertyMigration{{Property: "headline"}}
+	sm.migrator = &renameMigrator{migrations: migrations}
+	require.Nil(t, sm.AddClass(ctx, nil, &models.Class{Class: "Article"}))
+
+	_, err := sm.GetPropertyMigrations(ctx, nil, "WrongClass")
+	assert.Equal(t, ErrNotFound, err)
+
+	res, err := sm.GetPropertyMigrations(ctx, nil, "Article")
+	require.Nil(t, err)
+	assert.Equal(t, migrations, res)
+}
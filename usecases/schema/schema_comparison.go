@@ -193,12 +193,24 @@ func (ccc *classConfigComparison) diff() []string {
 	ccc.compare(ccc.left.Description, ccc.right.Description, "description")
 	ccc.compare(ccc.left.InvertedIndexConfig,
 		ccc.right.InvertedIndexConfig, "inverted index config")
+	ccc.compare(ccc.left.MemtableConfig,
+		ccc.right.MemtableConfig, "memtable config")
 	ccc.compare(ccc.left.ModuleConfig,
 		ccc.right.ModuleConfig, "module config")
+	ccc.compare(ccc.left.ObjectTTL,
+		ccc.right.ObjectTTL, "object ttl config")
+	ccc.compare(ccc.left.QuotaConfig,
+		ccc.right.QuotaConfig, "quota config")
 	ccc.compare(ccc.left.ReplicationConfig,
 		ccc.right.ReplicationConfig, "replication config")
 	ccc.compare(ccc.left.ShardingConfig,
 		ccc.right.ShardingConfig, "sharding config")
+	ccc.compare(ccc.left.StrictProperties,
+		ccc.right.StrictProperties, "strict properties")
+	ccc.compare(ccc.left.VectorConfig,
+		ccc.right.VectorConfig, "vector config")
+	ccc.compare(ccc.left.VectorDimensions,
+		ccc.right.VectorDimensions, "vector dimensions")
 	ccc.compare(ccc.left.VectorIndexConfig,
 		ccc.right.VectorIndexConfig, "vector index config")
 	ccc.compare(ccc.left.VectorIndexType,
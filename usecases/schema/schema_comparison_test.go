@@ -233,8 +233,8 @@ func Test_SchemaComparison_VariousMismatches(t *testing.T) {
 		"class Foo: description mismatch: " +
 			"L has \"foo\", but R has \"\"",
 		"class Foo: inverted index config mismatch: " +
-			"L has {\"indexPropertyLength\":true}, " +
-			"but R has {\"indexTimestamps\":true}",
+			"L has {\"indexPropertyLength\":true,\"synonyms\":null}, " +
+			"but R has {\"indexTimestamps\":true,\"synonyms\":null}",
 		"class Foo: module config mismatch: " +
 			"L has \"bar\", but R has null",
 		"class Foo: replication config mismatch: " +
@@ -0,0 +1,44 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// CompactShard forces a full compaction of all LSM buckets and vector index
+// commit logs of a shard held by this node and reports the reclaimed bytes
+func (m *Manager) CompactShard(ctx context.Context, principal *models.Principal,
+	className, shardName string,
+) (*models.ShardCompaction, error) {
+	err := m.Authorizer.Authorize(principal, "update",
+		fmt.Sprintf("schema/%s/shards/%s", className, shardName))
+	if err != nil {
+		return nil, err
+	}
+
+	if !m.schemaCache.classExist(className) {
+		return nil, ErrNotFound
+	}
+
+	compaction, err := m.migrator.CompactShard(ctx, className, shardName)
+	if err != nil {
+		return nil, err
+	}
+
+	compaction.ClassName = className
+	compaction.Node = m.clusterState.LocalName()
+	return compaction, nil
+}
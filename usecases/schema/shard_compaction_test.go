@@ -0,0 +1,54 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+type compactionMigrator struct {
+	NilMigrator
+}
+
+func (m *compactionMigrator) CompactShard(ctx context.Context,
+	className, shardName string,
+) (*models.ShardCompaction, error) {
+	return &models.ShardCompaction{
+		Shard: shardName, BytesBefore: 100, BytesAfter: 40, ReclaimedBytes: 60,
+	}, nil
+}
+
+func TestCompactShard(t *testing.T) {
+	ctx := context.Background()
+	sm := newSchemaManager()
+	sm.migrator = &compactionMigrator{}
+	require.Nil(t, sm.AddClass(ctx, nil, &models.Class{Class: "Article"}))
+
+	_, err := sm.CompactShard(ctx, nil, "WrongClass", "shard1")
+	assert.Equal(t, ErrNotFound, err)
+
+	compaction, err := sm.CompactShard(ctx, nil, "Article", "shard1")
+	require.Nil(t, err)
+	assert.Equal(t, &models.ShardCompaction{
+		ClassName:      "Article",
+		Node:           "node1",
+		Shard:          "shard1",
+		BytesBefore:    100,
+		BytesAfter:     40,
+		ReclaimedBytes: 60,
+	}, compaction)
+}
@@ -0,0 +1,199 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+var validateStopwordSetNameRegex = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+
+// ErrStopwordSetInUse is returned when deleting a stopword set which is
+// still referenced by the stopword config of a class
+var ErrStopwordSetInUse = errors.New("stopword set is in use")
+
+// StopwordSets returns the custom stopword sets
+func (m *Manager) StopwordSets(ctx context.Context,
+	principal *models.Principal,
+) ([]*models.StopwordSet, error) {
+	err := m.Authorizer.Authorize(principal, "list", "schema/stopwords")
+	if err != nil {
+		return nil, err
+	}
+
+	return m.schemaCache.stopwordSets(), nil
+}
+
+// PutStopwordSet creates the stopword set with the given name or replaces
+// its words. The stopwords of classes referencing the set change right away,
+// as stopwords are only considered when querying.
+func (m *Manager) PutStopwordSet(ctx context.Context, principal *models.Principal,
+	name string, set *models.StopwordSet,
+) (*models.StopwordSet, error) {
+	err := m.Authorizer.Authorize(principal, "update", "schema/stopwords")
+	if err != nil {
+		return nil, err
+	}
+
+	if set == nil {
+		set = &models.StopwordSet{}
+	}
+	if set.Name != "" && set.Name != name {
+		return nil, fmt.Errorf("stopword set name %q does not match %q", set.Name, name)
+	}
+	set = &models.StopwordSet{Name: name, Words: set.Words}
+	if err := validateStopwordSet(set); err != nil {
+		return nil, err
+	}
+
+	m.Lock()
+	defer m.Unlock()
+
+	tx, err := m.cluster.BeginTransaction(ctx, PutStopwordSet,
+		PutStopwordSetPayload{set}, DefaultTxTTL)
+	if err != nil {
+		// possible causes for errors could be nodes down (we expect every node to
+		// be up for a schema transaction) or concurrent transactions from other
+		// nodes
+		return nil, errors.Wrap(err, "open cluster-wide transaction")
+	}
+
+	if err := m.cluster.CommitWriteTransaction(ctx, tx); err != nil {
+		// Only log the commit error, but do not abort the changes locally. Once
+		// we've told others to commit, we also need to commit ourselves!
+		m.logger.WithError(err).Errorf("not every node was able to commit")
+	}
+
+	return set, m.putStopwordSetApplyChanges(ctx, set)
+}
+
+// DeleteStopwordSet deletes the stopword set with the given name. Deleting a
+// set which does not exist is not an error. ErrStopwordSetInUse is returned
+// if a class still references the set.
+func (m *Manager) DeleteStopwordSet(ctx context.Context, principal *models.Principal,
+	name string,
+) error {
+	err := m.Authorizer.Authorize(principal, "delete", "schema/stopwords")
+	if err != nil {
+		return err
+	}
+
+	m.Lock()
+	defer m.Unlock()
+
+	if classes := m.schemaCache.stopwordSetUsers(name); len(classes) > 0 {
+		return fmt.Errorf("%w: referenced by classes %s", ErrStopwordSetInUse,
+			strings.Join(classes, ", "))
+	}
+
+	tx, err := m.cluster.BeginTransaction(ctx, DeleteStopwordSet,
+		DeleteStopwordSetPayload{name}, DefaultTxTTL)
+	if err != nil {
+		return errors.Wrap(err, "open cluster-wide transaction")
+	}
+
+	if err := m.cluster.CommitWriteTransaction(ctx, tx); err != nil {
+		m.logger.WithError(err).Errorf("not every node was able to commit")
+	}
+
+	return m.deleteStopwordSetApplyChanges(ctx, name)
+}
+
+func (m *Manager) putStopwordSetApplyChanges(ctx context.Context,
+	set *models.StopwordSet,
+) error {
+	sets := m.schemaCache.putStopwordSet(set)
+	m.logger.
+		WithField("action", "schema.put_stopword_set").
+		Debug("saving updated schema to configuration store")
+	if err := m.repo.UpdateStopwordSets(ctx, sets); err != nil {
+		return err
+	}
+	m.triggerSchemaUpdateCallbacks()
+
+	// the indexes build their stopword detectors from the config of their
+	// class, which needs to be refreshed for the new words to be considered
+	for _, className := range m.schemaCache.stopwordSetUsers(set.Name) {
+		class, err := m.schemaCache.readOnlyClass(className)
+		if err != nil {
+			continue
+		}
+		if err := m.migrator.UpdateInvertedIndexConfig(ctx, className,
+			class.InvertedIndexConfig); err != nil {
+			return errors.Wrapf(err, "inverted index config of class %q", className)
+		}
+	}
+
+	return nil
+}
+
+func (m *Manager) deleteStopwordSetApplyChanges(ctx context.Context,
+	name string,
+) error {
+	sets, ok := m.schemaCache.deleteStopwordSet(name)
+	if !ok {
+		return nil
+	}
+	m.logger.
+		WithField("action", "schema.delete_stopword_set").
+		Debug("saving updated schema to configuration store")
+	if err := m.repo.UpdateStopwordSets(ctx, sets); err != nil {
+		return err
+	}
+	m.triggerSchemaUpdateCallbacks()
+
+	return nil
+}
+
+func validateStopwordSet(set *models.StopwordSet) error {
+	if !validateStopwordSetNameRegex.MatchString(set.Name) {
+		return fmt.Errorf("stopword set name %q must match %s", set.Name,
+			validateStopwordSetNameRegex)
+	}
+	for _, word := range set.Words {
+		if strings.TrimSpace(word) == "" {
+			return fmt.Errorf("cannot use whitespace in stopword set %q", set.Name)
+		}
+	}
+	return nil
+}
+
+// validateStopwordSetsExist checks that the stopword sets the inverted index
+// config references exist
+func (m *Manager) validateStopwordSetsExist(config *models.InvertedIndexConfig) error {
+	if config == nil || config.Stopwords == nil {
+		return nil
+	}
+
+	sets := m.schemaCache.stopwordSets()
+	for _, name := range config.Stopwords.Sets {
+		if findStopwordSet(sets, name) < 0 {
+			return fmt.Errorf("stopword set %q does not exist", name)
+		}
+	}
+	return nil
+}
+
+func findStopwordSet(sets []*models.StopwordSet, name string) int {
+	for i, set := range sets {
+		if set.Name == name {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,90 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestStopwordSets(t *testing.T) {
+	ctx := context.Background()
+	sm := newSchemaManager()
+
+	t.Run("invalid name", func(t *testing.T) {
+		_, err := sm.PutStopwordSet(ctx, nil, "my set", &models.StopwordSet{})
+		require.NotNil(t, err)
+	})
+
+	t.Run("whitespace word", func(t *testing.T) {
+		_, err := sm.PutStopwordSet(ctx, nil, "animals",
+			&models.StopwordSet{Words: []string{"dog", " "}})
+		require.NotNil(t, err)
+	})
+
+	t.Run("class referencing an unknown set", func(t *testing.T) {
+		err := sm.AddClass(ctx, nil, &models.Class{
+			Class: "Article",
+			InvertedIndexConfig: &models.InvertedIndexConfig{
+				Stopwords: &models.StopwordConfig{Sets: []string{"animals"}},
+			},
+		})
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), `stopword set "animals" does not exist`)
+	})
+
+	t.Run("put", func(t *testing.T) {
+		set, err := sm.PutStopwordSet(ctx, nil, "animals",
+			&models.StopwordSet{Words: []string{"dog"}})
+		require.Nil(t, err)
+		assert.Equal(t, "animals", set.Name)
+
+		set, err = sm.PutStopwordSet(ctx, nil, "animals",
+			&models.StopwordSet{Words: []string{"dog", "cat"}})
+		require.Nil(t, err)
+
+		sets, err := sm.StopwordSets(ctx, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []*models.StopwordSet{set}, sets)
+		assert.Equal(t, sets, sm.repo.(*fakeRepo).schema.ObjectSchema.StopwordSets)
+	})
+
+	t.Run("class referencing the set", func(t *testing.T) {
+		require.Nil(t, sm.AddClass(ctx, nil, &models.Class{
+			Class: "Article",
+			InvertedIndexConfig: &models.InvertedIndexConfig{
+				Stopwords: &models.StopwordConfig{Sets: []string{"animals"}},
+			},
+		}))
+	})
+
+	t.Run("delete a set in use", func(t *testing.T) {
+		err := sm.DeleteStopwordSet(ctx, nil, "animals")
+		require.NotNil(t, err)
+		assert.True(t, errors.Is(err, ErrStopwordSetInUse))
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		require.Nil(t, sm.DeleteClass(ctx, nil, "Article"))
+		require.Nil(t, sm.DeleteStopwordSet(ctx, nil, "animals"))
+		require.Nil(t, sm.DeleteStopwordSet(ctx, nil, "animals"))
+
+		sets, err := sm.StopwordSets(ctx, nil)
+		require.Nil(t, err)
+		assert.Empty(t, sets)
+	})
+}
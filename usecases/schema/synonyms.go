@@ -0,0 +1,95 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// Synonyms returns the synonym rules the keyword queries of the class are
+// expanded with
+func (m *Manager) Synonyms(ctx context.Context, principal *models.Principal,
+	className string,
+) ([]*models.SynonymRule, error) {
+	err := m.Authorizer.Authorize(principal, "get", synonymsPath(className))
+	if err != nil {
+		return nil, err
+	}
+
+	class := m.getClassByName(className)
+	if class == nil {
+		return nil, fmt.Errorf("class %q: %w", className, ErrNotFound)
+	}
+	if class.InvertedIndexConfig == nil || class.InvertedIndexConfig.Synonyms == nil {
+		return []*models.SynonymRule{}, nil
+	}
+	return class.InvertedIndexConfig.Synonyms, nil
+}
+
+// UpdateSynonyms replaces the synonym rules of the class. Synonyms are only
+// considered when querying, the stored objects are therefore not affected.
+func (m *Manager) UpdateSynonyms(ctx context.Context, principal *models.Principal,
+	className string, rules []*models.SynonymRule,
+) ([]*models.SynonymRule, error) {
+	err := m.Authorizer.Authorize(principal, "update", synonymsPath(className))
+	if err != nil {
+		return nil, err
+	}
+
+	m.Lock()
+	defer m.Unlock()
+
+	initial := m.getClassByName(className)
+	if initial == nil {
+		return nil, fmt.Errorf("class %q: %w", className, ErrNotFound)
+	}
+
+	if rules == nil {
+		rules = []*models.SynonymRule{}
+	}
+	updated := *initial
+	invertedConfig := models.InvertedIndexConfig{}
+	if initial.InvertedIndexConfig != nil {
+		invertedConfig = *initial.InvertedIndexConfig
+	}
+	invertedConfig.Synonyms = rules
+	updated.InvertedIndexConfig = &invertedConfig
+	if err := m.invertedConfigValidator(updated.InvertedIndexConfig); err != nil {
+		return nil, errors.Wrap(err, "synonyms")
+	}
+
+	tx, err := m.cluster.BeginTransaction(ctx, UpdateClass,
+		UpdateClassPayload{className, &updated, nil}, DefaultTxTTL)
+	if err != nil {
+		// possible causes for errors could be nodes down (we expect every node to
+		// be up for a schema transaction) or concurrent transactions from other
+		// nodes
+		return nil, errors.Wrap(err, "open cluster-wide transaction")
+	}
+
+	if err := m.cluster.CommitWriteTransaction(ctx, tx); err != nil {
+		return nil, errors.Wrap(err, "commit cluster-wide transaction")
+	}
+
+	if err := m.updateClassApplyChanges(ctx, className, &updated, nil); err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+func synonymsPath(className string) string {
+	return fmt.Sprintf("schema/%s/synonyms", className)
+}
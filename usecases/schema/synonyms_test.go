@@ -0,0 +1,76 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestSynonyms(t *testing.T) {
+	ctx := context.Background()
+	sm := newSchemaManager()
+
+	t.Run("unknown class", func(t *testing.T) {
+		_, err := sm.Synonyms(ctx, nil, "Car")
+		assert.True(t, errors.Is(err, ErrNotFound))
+
+		_, err = sm.UpdateSynonyms(ctx, nil, "Car", nil)
+		assert.True(t, errors.Is(err, ErrNotFound))
+	})
+
+	require.Nil(t, sm.AddClass(ctx, nil, &models.Class{
+		Class: "Car",
+		Properties: []*models.Property{
+			{Name: "description", DataType: []string{"text"}},
+		},
+	}))
+
+	t.Run("class without synonyms", func(t *testing.T) {
+		rules, err := sm.Synonyms(ctx, nil, "Car")
+		require.Nil(t, err)
+		assert.Empty(t, rules)
+	})
+
+	t.Run("update", func(t *testing.T) {
+		rules := []*models.SynonymRule{
+			{Terms: []string{"car", "automobile"}},
+			{Terms: []string{"suv"}, Synonyms: []string{"sport utility vehicle"}},
+		}
+		updated, err := sm.UpdateSynonyms(ctx, nil, "Car", rules)
+		require.Nil(t, err)
+		assert.Equal(t, rules, updated)
+
+		got, err := sm.Synonyms(ctx, nil, "Car")
+		require.Nil(t, err)
+		assert.Equal(t, rules, got)
+
+		class := sm.getClassByName("Car")
+		require.NotNil(t, class)
+		assert.Equal(t, rules, class.InvertedIndexConfig.Synonyms)
+		assert.NotNil(t, class.InvertedIndexConfig.Bm25, "the rest of the config is kept")
+	})
+
+	t.Run("remove all synonyms", func(t *testing.T) {
+		_, err := sm.UpdateSynonyms(ctx, nil, "Car", nil)
+		require.Nil(t, err)
+
+		rules, err := sm.Synonyms(ctx, nil, "Car")
+		require.Nil(t, err)
+		assert.Empty(t, rules)
+	})
+}
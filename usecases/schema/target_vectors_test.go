@@ -0,0 +1,124 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestAddClassTargetVectors(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("defaults are applied to every named vector", func(t *testing.T) {
+		sm := newSchemaManager()
+		err := sm.AddClass(ctx, nil, &models.Class{
+			Class: "Article",
+			VectorConfig: map[string]models.VectorConfig{
+				"title": {
+					Vectorizer: map[string]interface{}{"model1": map[string]interface{}{}},
+				},
+				"image": {},
+			},
+		})
+		require.Nil(t, err)
+
+		class, err := sm.GetClass(ctx, nil, "Article")
+		require.Nil(t, err)
+		require.Len(t, class.VectorConfig, 2)
+		assert.Equal(t, "hnsw", class.VectorConfig["title"].VectorIndexType)
+		assert.Equal(t, map[string]interface{}{"model1": map[string]interface{}{}},
+			class.VectorConfig["title"].Vectorizer)
+		assert.Equal(t, "hnsw", class.VectorConfig["image"].VectorIndexType)
+		assert.Equal(t, map[string]interface{}{"none": map[string]interface{}{}},
+			class.VectorConfig["image"].Vectorizer)
+	})
+
+	t.Run("invalid name", func(t *testing.T) {
+		err := newSchemaManager().AddClass(ctx, nil, &models.Class{
+			Class:        "Article",
+			VectorConfig: map[string]models.VectorConfig{"title-vector": {}},
+		})
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "title-vector")
+	})
+
+	t.Run("unsupported vector index type", func(t *testing.T) {
+		err := newSchemaManager().AddClass(ctx, nil, &models.Class{
+			Class: "Article",
+			VectorConfig: map[string]models.VectorConfig{
+				"title": {VectorIndexType: "ivf"},
+			},
+		})
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "unsupported vectorIndexType")
+	})
+
+	t.Run("unknown vectorizer", func(t *testing.T) {
+		err := newSchemaManager().AddClass(ctx, nil, &models.Class{
+			Class: "Article",
+			VectorConfig: map[string]models.VectorConfig{
+				"title": {
+					Vectorizer: map[string]interface{}{"unknown-module": map[string]interface{}{}},
+				},
+			},
+		})
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "invalid vectorizer")
+	})
+
+	t.Run("more than one vectorizer", func(t *testing.T) {
+		err := newSchemaManager().AddClass(ctx, nil, &models.Class{
+			Class: "Article",
+			VectorConfig: map[string]models.VectorConfig{
+				"title": {
+					Vectorizer: map[string]interface{}{
+						"model1": map[string]interface{}{},
+						"model2": map[string]interface{}{},
+					},
+				},
+			},
+		})
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "exactly one entry")
+	})
+}
+
+func TestUpdateClassTargetVectors(t *testing.T) {
+	ctx := context.Background()
+	sm := newSchemaManager()
+	require.Nil(t, sm.AddClass(ctx, nil, &models.Class{
+		Class:        "Article",
+		VectorConfig: map[string]models.VectorConfig{"title": {}},
+	}))
+
+	t.Run("unchanged", func(t *testing.T) {
+		err := sm.UpdateClass(ctx, nil, "Article", &models.Class{
+			Class:        "Article",
+			VectorConfig: map[string]models.VectorConfig{"title": {}},
+		})
+		require.Nil(t, err)
+	})
+
+	t.Run("add a named vector", func(t *testing.T) {
+		err := sm.UpdateClass(ctx, nil, "Article", &models.Class{
+			Class:        "Article",
+			VectorConfig: map[string]models.VectorConfig{"title": {}, "image": {}},
+		})
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "vector config of named vectors is immutable")
+	})
+}
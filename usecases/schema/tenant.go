@@ -45,7 +45,7 @@ func (m *Manager) AddTenants(ctx context.Context,
 	if err != nil {
 		return
 	}
-	if err = validateActivityStatuses(validated, true); err != nil {
+	if err = validateActivityStatuses(validated, true, false); err != nil {
 		return
 	}
 	cls := m.getClassByName(class)
@@ -150,14 +150,22 @@ func validateTenants(tenants []*models.Tenant) (validated []*models.Tenant, err
 	return
 }
 
-func validateActivityStatuses(tenants []*models.Tenant, allowEmpty bool) error {
+// validateActivityStatuses validates the requested statuses of tenants.
+// Tenants can only be frozen once they exist, as freezing offloads their data.
+func validateActivityStatuses(tenants []*models.Tenant, allowEmpty, allowFrozen bool) error {
 	msgs := make([]string, 0, len(tenants))
 
 	for _, tenant := range tenants {
 		switch status := tenant.ActivityStatus; status {
 		case models.TenantActivityStatusHOT, models.TenantActivityStatusCOLD:
 			// ok
-		case models.TenantActivityStatusWARM, models.TenantActivityStatusFROZEN:
+		case models.TenantActivityStatusFROZEN:
+			if allowFrozen {
+				continue
+			}
+			msgs = append(msgs, fmt.Sprintf(
+				"tenant %q cannot be created with activity status '%s'", tenant.Name, status))
+		case models.TenantActivityStatusWARM:
 			msgs = append(msgs, fmt.Sprintf(
 				"not yet supported activity status '%s' for tenant %q", status, tenant.Name))
 		default:
@@ -239,7 +247,7 @@ func (m *Manager) UpdateTenants(ctx context.Context, principal *models.Principal
 	if err != nil {
 		return err
 	}
-	if err := validateActivityStatuses(validated, false); err != nil {
+	if err := validateActivityStatuses(validated, false, true); err != nil {
 		return err
 	}
 	cls := m.getClassByName(class)
@@ -327,6 +335,7 @@ func (m *Manager) onUpdateTenants(ctx context.Context, class *models.Class, requ
 	if err != nil {
 		m.logger.WithField("action", "update_tenants").
 			WithField("class", request.Class).Error(err)
+		return err
 	}
 
 	m.logger.
@@ -126,6 +126,7 @@ func TestAddTenants(t *testing.T) {
 			errMsgs: []string{
 				"not yet supported activity status",
 				models.TenantActivityStatusWARM,
+				"tenant \"Bbbb\" cannot be created with activity status",
 				models.TenantActivityStatusFROZEN,
 			},
 		},
@@ -269,7 +270,6 @@ func TestUpdateTenants(t *testing.T) {
 			Class: cls,
 			updateTenants: []*models.Tenant{
 				{Name: tenants[0].Name, ActivityStatus: models.TenantActivityStatusWARM},
-				{Name: tenants[1].Name, ActivityStatus: models.TenantActivityStatusFROZEN},
 			},
 			initial: &models.Class{
 				Class:              cls,
@@ -280,7 +280,6 @@ func TestUpdateTenants(t *testing.T) {
 			errMsgs: []string{
 				"not yet supported activity status",
 				models.TenantActivityStatusWARM,
-				models.TenantActivityStatusFROZEN,
 			},
 		},
 		{
@@ -303,7 +302,7 @@ func TestUpdateTenants(t *testing.T) {
 			Class: cls,
 			updateTenants: []*models.Tenant{
 				{Name: tenants[0].Name, ActivityStatus: models.TenantActivityStatusCOLD},
-				{Name: tenants[1].Name, ActivityStatus: models.TenantActivityStatusCOLD},
+				{Name: tenants[1].Name, ActivityStatus: models.TenantActivityStatusFROZEN},
 			},
 			initial: &models.Class{
 				Class:              cls,
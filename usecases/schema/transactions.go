@@ -26,6 +26,12 @@ const (
 	AddClass    cluster.TransactionType = "add_class"
 	AddProperty cluster.TransactionType = "add_property"
 
+	RenameProperty             cluster.TransactionType = "rename_property"
+	UpdatePropertyTokenization cluster.TransactionType = "update_property_tokenization"
+	LockVectorDimensions       cluster.TransactionType = "lock_vector_dimensions"
+	PutStopwordSet             cluster.TransactionType = "put_stopword_set"
+	DeleteStopwordSet          cluster.TransactionType = "delete_stopword_set"
+
 	// tenant types
 	addTenants    cluster.TransactionType = "add_tenants"
 	updateTenants cluster.TransactionType = "update_tenants"
@@ -62,6 +68,35 @@ type AddPropertyPayload struct {
 	Property  *models.Property `json:"property"`
 }
 
+type RenamePropertyPayload struct {
+	ClassName    string `json:"className"`
+	PropertyName string `json:"propertyName"`
+	NewName      string `json:"newName"`
+}
+
+type UpdatePropertyTokenizationPayload struct {
+	ClassName    string `json:"className"`
+	PropertyName string `json:"propertyName"`
+	Tokenization string `json:"tokenization"`
+	// Rollback is set when the previous tokenization is restored, because
+	// the index could not be rebuilt on some node
+	Rollback bool `json:"rollback"`
+}
+
+type LockVectorDimensionsPayload struct {
+	ClassName    string `json:"className"`
+	TargetVector string `json:"targetVector,omitempty"`
+	Dimensions   int64  `json:"dimensions"`
+}
+
+type PutStopwordSetPayload struct {
+	Set *models.StopwordSet `json:"set"`
+}
+
+type DeleteStopwordSetPayload struct {
+	Name string `json:"name"`
+}
+
 // TenantCreate represents properties of a specific tenant (physical shard)
 type TenantCreate struct {
 	Name   string   `json:"name"`
@@ -117,6 +152,16 @@ func UnmarshalTransaction(txType cluster.TransactionType,
 		return unmarshalRawJson[AddClassPayload](payload)
 	case AddProperty:
 		return unmarshalRawJson[AddPropertyPayload](payload)
+	case RenameProperty:
+		return unmarshalRawJson[RenamePropertyPayload](payload)
+	case UpdatePropertyTokenization:
+		return unmarshalRawJson[UpdatePropertyTokenizationPayload](payload)
+	case LockVectorDimensions:
+		return unmarshalRawJson[LockVectorDimensionsPayload](payload)
+	case PutStopwordSet:
+		return unmarshalRawJson[PutStopwordSetPayload](payload)
+	case DeleteStopwordSet:
+		return unmarshalRawJson[DeleteStopwordSetPayload](payload)
 	case DeleteClass:
 		return unmarshalRawJson[DeleteClassPayload](payload)
 	case UpdateClass:
@@ -38,6 +38,60 @@ func (m *Manager) UpdateClass(ctx context.Context, principal *models.Principal,
 	if initial == nil {
 		return ErrNotFound
 	}
+	if err := m.validateClassUpdate(ctx, initial, updated); err != nil {
+		return err
+	}
+
+	updatedSharding := updated.ShardingConfig.(sharding.Config)
+	initialRF := initial.ReplicationConfig.Factor
+	updatedRF := updated.ReplicationConfig.Factor
+	var updatedState *sharding.State
+	if initialRF != updatedRF {
+		uss, err := m.scaleOut.Scale(ctx, className, updatedSharding, initialRF, updatedRF)
+		if err != nil {
+			return errors.Wrapf(err, "scale out from %d to %d replicas",
+				initialRF, updatedRF)
+		}
+		updatedState = uss
+	}
+
+	tx, err := m.cluster.BeginTransaction(ctx, UpdateClass,
+		UpdateClassPayload{className, updated, updatedState}, DefaultTxTTL)
+	if err != nil {
+		// possible causes for errors could be nodes down (we expect every node to
+		// the up for a schema transaction) or concurrent transactions from other
+		// nodes
+		return errors.Wrap(err, "open cluster-wide transaction")
+	}
+
+	if err := m.cluster.CommitWriteTransaction(ctx, tx); err != nil {
+		return errors.Wrap(err, "commit cluster-wide transaction")
+	}
+
+	return m.updateClassApplyChanges(ctx, className, updated, updatedState)
+}
+
+// ValidateUpdateClass runs all the checks of UpdateClass without applying
+// the update. It allows to dry-run a class update before migrating.
+func (m *Manager) ValidateUpdateClass(ctx context.Context, principal *models.Principal,
+	className string, updated *models.Class,
+) error {
+	m.RLock()
+	defer m.RUnlock()
+
+	err := m.Authorizer.Authorize(principal, "update", "schema/objects")
+	if err != nil {
+		return err
+	}
+
+	initial := m.getClassByName(className)
+	if initial == nil {
+		return ErrNotFound
+	}
+	return m.validateClassUpdate(ctx, initial, updated)
+}
+
+func (m *Manager) validateClassUpdate(ctx context.Context, initial, updated *models.Class) error {
 	mtEnabled, err := validateUpdatingMT(initial, updated)
 	if err != nil {
 		return err
@@ -46,6 +100,16 @@ func (m *Manager) UpdateClass(ctx context.Context, principal *models.Principal,
 	// make sure unset optionals on 'updated' don't lead to an error, as all
 	// optionals would have been set with defaults on the initial already
 	m.setClassDefaults(updated)
+	if updated.VectorDimensions == 0 {
+		// recorded on the first insert, the user does not need to repeat it
+		updated.VectorDimensions = initial.VectorDimensions
+	}
+	for name, cfg := range updated.VectorConfig {
+		if initialCfg, ok := initial.VectorConfig[name]; ok && cfg.Dimensions == 0 {
+			cfg.Dimensions = initialCfg.Dimensions
+			updated.VectorConfig[name] = cfg
+		}
+	}
 
 	if err := m.validateImmutableFields(initial, updated); err != nil {
 		return err
@@ -59,6 +123,14 @@ func (m *Manager) UpdateClass(ctx context.Context, principal *models.Principal,
 		return err
 	}
 
+	return m.validateClassConfigUpdate(ctx, initial, updated, mtEnabled)
+}
+
+// validateClassConfigUpdate checks whether the already parsed configs of
+// updated can be applied to the initial class
+func (m *Manager) validateClassConfigUpdate(ctx context.Context,
+	initial, updated *models.Class, mtEnabled bool,
+) error {
 	if err := m.migrator.ValidateVectorIndexConfigUpdate(ctx,
 		initial.VectorIndexConfig.(schema.VectorIndexConfig),
 		updated.VectorIndexConfig.(schema.VectorIndexConfig)); err != nil {
@@ -69,6 +141,9 @@ func (m *Manager) UpdateClass(ctx context.Context, principal *models.Principal,
 		initial.InvertedIndexConfig, updated.InvertedIndexConfig); err != nil {
 		return errors.Wrap(err, "inverted index config")
 	}
+	if err := m.validateStopwordSetsExist(updated.InvertedIndexConfig); err != nil {
+		return errors.Wrap(err, "inverted index config")
+	}
 	if err := validateShardingConfig(initial, updated, mtEnabled, m.clusterState); err != nil {
 		return fmt.Errorf("validate sharding config: %w", err)
 	}
@@ -77,33 +152,27 @@ func (m *Manager) UpdateClass(ctx context.Context, principal *models.Principal,
 		return fmt.Errorf("replication config: %w", err)
 	}
 
-	updatedSharding := updated.ShardingConfig.(sharding.Config)
-	initialRF := initial.ReplicationConfig.Factor
-	updatedRF := updated.ReplicationConfig.Factor
-	var updatedState *sharding.State
-	if initialRF != updatedRF {
-		uss, err := m.scaleOut.Scale(ctx, className, updatedSharding, initialRF, updatedRF)
-		if err != nil {
-			return errors.Wrapf(err, "scale out from %d to %d replicas",
-				initialRF, updatedRF)
-		}
-		updatedState = uss
+	if err := validateObjectTTLConfig(updated.ObjectTTL); err != nil {
+		return err
 	}
 
-	tx, err := m.cluster.BeginTransaction(ctx, UpdateClass,
-		UpdateClassPayload{className, updated, updatedState}, DefaultTxTTL)
-	if err != nil {
-		// possible causes for errors could be nodes down (we expect every node to
-		// the up for a schema transaction) or concurrent transactions from other
-		// nodes
-		return errors.Wrap(err, "open cluster-wide transaction")
+	if err := validateMemtableConfig(updated.MemtableConfig); err != nil {
+		return err
 	}
 
-	if err := m.cluster.CommitWriteTransaction(ctx, tx); err != nil {
-		return errors.Wrap(err, "commit cluster-wide transaction")
+	if err := validateQuotaConfig(updated.QuotaConfig, mtEnabled); err != nil {
+		return err
 	}
 
-	return m.updateClassApplyChanges(ctx, className, updated, updatedState)
+	if err := validateBM25PropertyBoosts(updated); err != nil {
+		return err
+	}
+
+	if !reflect.DeepEqual(initial.VectorConfig, updated.VectorConfig) {
+		return errors.Errorf("vector config of named vectors is immutable")
+	}
+
+	return nil
 }
 
 // validateUpdatingMT validates toggling MT and returns whether mt is enabled
@@ -155,6 +224,11 @@ func (m *Manager) updateClassApplyChanges(ctx context.Context, className string,
 		return errors.Wrap(err, "inverted index config")
 	}
 
+	if err := m.migrator.UpdateMemtableConfig(ctx, className,
+		updated.MemtableConfig); err != nil {
+		return errors.Wrap(err, "memtable config")
+	}
+
 	if !m.schemaCache.classExist(className) {
 		return ErrNotFound
 	}
@@ -212,6 +286,11 @@ func (m *Manager) validateImmutableFields(initial, updated *models.Class) error
 		return errors.Errorf("module config is immutable")
 	}
 
+	if initial.VectorDimensions != 0 && initial.VectorDimensions != updated.VectorDimensions {
+		return errors.Errorf("vector dimensions are immutable: attempted change from %d to %d",
+			initial.VectorDimensions, updated.VectorDimensions)
+	}
+
 	return nil
 }
 
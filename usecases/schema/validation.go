@@ -19,6 +19,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/vectorindex"
 	"github.com/weaviate/weaviate/usecases/config"
 )
 
@@ -66,7 +67,11 @@ func (m *Manager) validatePropertyTokenization(tokenization string, propertyData
 		case schema.DataTypeText, schema.DataTypeTextArray:
 			switch tokenization {
 			case models.PropertyTokenizationField, models.PropertyTokenizationWord,
-				models.PropertyTokenizationWhitespace, models.PropertyTokenizationLowercase:
+				models.PropertyTokenizationWhitespace, models.PropertyTokenizationLowercase,
+				models.PropertyTokenizationLowercaseKeyword, models.PropertyTokenizationLowercaseKeywordTr,
+				models.PropertyTokenizationLowercaseKeywordDe, models.PropertyTokenizationCjk,
+				models.PropertyTokenizationStemEn, models.PropertyTokenizationStemDe, models.PropertyTokenizationStemFr,
+				models.PropertyTokenizationStemEs, models.PropertyTokenizationStemIt:
 				return nil
 			}
 		default:
@@ -84,6 +89,82 @@ func (m *Manager) validatePropertyTokenization(tokenization string, propertyData
 	return fmt.Errorf("Tokenization is not allowed for reference data type")
 }
 
+// validatePropertyCardinality checks that the cardinality constraints are
+// only set for cross-references and do not contradict each other
+func validatePropertyCardinality(prop *models.Property) error {
+	if prop.Cardinality == "" && prop.MaxCount == nil {
+		return nil
+	}
+	if len(prop.DataType) == 0 || !schema.IsRefDataType(prop.DataType) {
+		return fmt.Errorf("cardinality and maxCount are only allowed for cross-references")
+	}
+
+	switch prop.Cardinality {
+	case "", models.PropertyCardinalityMany:
+	case models.PropertyCardinalityOne:
+		if prop.MaxCount != nil && *prop.MaxCount != 1 {
+			return fmt.Errorf("maxCount must be 1 or unset for cardinality %q, got %d",
+				models.PropertyCardinalityOne, *prop.MaxCount)
+		}
+	default:
+		return fmt.Errorf("invalid cardinality %q, must be %q or %q", prop.Cardinality,
+			models.PropertyCardinalityOne, models.PropertyCardinalityMany)
+	}
+
+	if prop.MaxCount != nil && *prop.MaxCount < 1 {
+		return fmt.Errorf("maxCount must be at least 1, got %d", *prop.MaxCount)
+	}
+	return nil
+}
+
+func validatePropertyOnDelete(prop *models.Property) error {
+	if prop.OnDelete == "" {
+		return nil
+	}
+	if len(prop.DataType) == 0 || !schema.IsRefDataType(prop.DataType) {
+		return fmt.Errorf("onDelete is only allowed for cross-references")
+	}
+	// the referring objects are looked up with a filter on the property
+	if !hasFilterableIndex(prop) {
+		return fmt.Errorf("onDelete requires the property to be filterable, " +
+			"`indexFilterable` must not be false")
+	}
+
+	switch prop.OnDelete {
+	case models.PropertyOnDeleteCascade, models.PropertyOnDeleteSetNull, models.PropertyOnDeleteRestrict:
+		return nil
+	default:
+		return fmt.Errorf("invalid onDelete %q, must be %q, %q or %q", prop.OnDelete,
+			models.PropertyOnDeleteCascade, models.PropertyOnDeleteSetNull, models.PropertyOnDeleteRestrict)
+	}
+}
+
+// validatePropertyUniqueKey checks that a unique key holds a single primitive
+// value, which batch imports derive the ids of the objects from
+func validatePropertyUniqueKey(prop *models.Property) error {
+	if !prop.UniqueKey {
+		return nil
+	}
+	switch dataType, _ := schema.AsPrimitive(prop.DataType); dataType {
+	case schema.DataTypeText, schema.DataTypeInt, schema.DataTypeNumber,
+		schema.DataTypeBoolean, schema.DataTypeDate, schema.DataTypeUUID:
+		return nil
+	default:
+		return fmt.Errorf("uniqueKey is only allowed for the data types text, int, " +
+			"number, boolean, date and uuid")
+	}
+}
+
+// hasFilterableIndex returns whether the property will be indexed as
+// filterable, also if it is still configured with the deprecated
+// indexInverted setting
+func hasFilterableIndex(prop *models.Property) bool {
+	if prop.IndexFilterable != nil {
+		return *prop.IndexFilterable
+	}
+	return prop.IndexInverted == nil || *prop.IndexInverted
+}
+
 func (m *Manager) validatePropertyIndexing(prop *models.Property) error {
 	if prop.IndexInverted != nil {
 		if prop.IndexFilterable != nil || prop.IndexSearchable != nil {
@@ -91,6 +172,13 @@ func (m *Manager) validatePropertyIndexing(prop *models.Property) error {
 		}
 	}
 
+	if prop.IndexFilterable != nil && *prop.IndexFilterable {
+		if schema.IsVectorDataType(prop.DataType) || schema.IsSparseVectorDataType(prop.DataType) {
+			return fmt.Errorf("`indexFilterable` is not allowed for vector/vector[] and " +
+				"sparseVector data types. Set false or leave empty")
+		}
+	}
+
 	if prop.IndexSearchable != nil {
 		switch dataType, _ := schema.AsPrimitive(prop.DataType); dataType {
 		case schema.DataTypeString, schema.DataTypeStringArray:
@@ -107,6 +195,30 @@ func (m *Manager) validatePropertyIndexing(prop *models.Property) error {
 		}
 	}
 
+	if prop.IndexPositions != nil && *prop.IndexPositions {
+		switch dataType, _ := schema.AsPrimitive(prop.DataType); dataType {
+		case schema.DataTypeString, schema.DataTypeStringArray,
+			schema.DataTypeText, schema.DataTypeTextArray:
+			if (prop.IndexSearchable != nil && !*prop.IndexSearchable) ||
+				(prop.IndexInverted != nil && !*prop.IndexInverted) {
+				return fmt.Errorf("`indexPositions` requires `indexSearchable` to be enabled")
+			}
+		default:
+			return fmt.Errorf("`indexPositions` is allowed only for text/text[] data types. " +
+				"For other data types set false or leave empty")
+		}
+	}
+
+	if prop.IndexRangeFilters != nil && *prop.IndexRangeFilters {
+		switch dataType, _ := schema.AsPrimitive(prop.DataType); dataType {
+		case schema.DataTypeInt, schema.DataTypeNumber, schema.DataTypeDate:
+			// true or false allowed
+		default:
+			return fmt.Errorf("`indexRangeFilters` is allowed only for int, number and date data types. " +
+				"For other data types set false or leave empty")
+		}
+	}
+
 	return nil
 }
 
@@ -119,6 +231,43 @@ func (m *Manager) validateVectorSettings(ctx context.Context, class *models.Clas
 		return err
 	}
 
+	if err := m.validateTargetVectors(ctx, class); err != nil {
+		return err
+	}
+
+	if err := validateVectorDimensions(class); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateTargetVectors makes sure every named vector space of the class has
+// a valid name, a supported vector index and a vectorizer module that exists
+func (m *Manager) validateTargetVectors(ctx context.Context, class *models.Class) error {
+	for _, name := range schema.TargetVectorNames(class) {
+		cfg := class.VectorConfig[name]
+		if err := schema.ValidateTargetVectorName(name); err != nil {
+			return err
+		}
+
+		if !vectorindex.IsSupportedType(cfg.VectorIndexType) {
+			return errors.Errorf("target vector %q: unrecognized or unsupported vectorIndexType %q",
+				name, cfg.VectorIndexType)
+		}
+
+		vectorizer, _, err := schema.TargetVectorizer(cfg)
+		if err != nil {
+			return errors.Wrapf(err, "target vector %q", name)
+		}
+		if vectorizer == config.VectorizerModuleNone {
+			continue
+		}
+		if err := m.vectorizerValidator.ValidateVectorizer(vectorizer); err != nil {
+			return errors.Wrapf(err, "target vector %q: vectorizer", name)
+		}
+	}
+
 	return nil
 }
 
@@ -136,10 +285,103 @@ func (m *Manager) validateVectorizer(ctx context.Context, class *models.Class) e
 
 func (m *Manager) validateVectorIndex(ctx context.Context, class *models.Class) error {
 	switch class.VectorIndexType {
-	case "hnsw":
+	case vectorindex.VectorIndexTypeHNSW, vectorindex.VectorIndexTypeVamana,
+		vectorindex.VectorIndexTypeFlat, vectorindex.VectorIndexTypeDynamic:
 		return nil
 	default:
 		return errors.Errorf("unrecognized or unsupported vectorIndexType %q",
 			class.VectorIndexType)
 	}
 }
+
+// validateObjectTTLConfig makes sure an enabled object TTL has a positive
+// time to live. A nil config means objects never expire.
+func validateObjectTTLConfig(cfg *models.ObjectTTLConfig) error {
+	if cfg == nil {
+		return nil
+	}
+	if cfg.TTLSeconds < 0 {
+		return fmt.Errorf("objectTTL: ttlSeconds must not be negative, got %d", cfg.TTLSeconds)
+	}
+	if cfg.Enabled && cfg.TTLSeconds == 0 {
+		return fmt.Errorf("objectTTL: ttlSeconds must be greater than 0 if enabled")
+	}
+	return nil
+}
+
+// validateBM25PropertyBoosts makes sure the default weights of the bm25
+// config refer to searchable text properties of the class and are positive
+func validateBM25PropertyBoosts(class *models.Class) error {
+	if class.InvertedIndexConfig == nil || class.InvertedIndexConfig.Bm25 == nil {
+		return nil
+	}
+	for propName, boost := range class.InvertedIndexConfig.Bm25.PropertyBoosts {
+		prop, err := schema.GetPropertyByName(class, propName)
+		if err != nil {
+			return fmt.Errorf("invertedIndexConfig: bm25 propertyBoosts: %w", err)
+		}
+		if dt, ok := schema.AsPrimitive(prop.DataType); !ok ||
+			(dt != schema.DataTypeText && dt != schema.DataTypeTextArray) {
+			return fmt.Errorf("invertedIndexConfig: bm25 propertyBoosts: property %q "+
+				"is not of type text or text[]", propName)
+		}
+		if prop.IndexSearchable != nil && !*prop.IndexSearchable {
+			return fmt.Errorf("invertedIndexConfig: bm25 propertyBoosts: property %q "+
+				"is not searchable", propName)
+		}
+		if boost <= 0 {
+			return fmt.Errorf("invertedIndexConfig: bm25 propertyBoosts: boost of "+
+				"property %q must be greater than 0, got %v", propName, boost)
+		}
+	}
+	return nil
+}
+
+// validateQuotaConfig makes sure all quotas are non-negative and tenant
+// quotas are only set for classes with multi-tenancy. A nil config or zero
+// values mean unlimited.
+func validateQuotaConfig(cfg *models.QuotaConfig, mtEnabled bool) error {
+	if cfg == nil {
+		return nil
+	}
+	for _, quota := range []struct {
+		name  string
+		value int64
+	}{
+		{"maxObjects", cfg.MaxObjects},
+		{"maxDiskBytes", cfg.MaxDiskBytes},
+		{"tenantMaxObjects", cfg.TenantMaxObjects},
+		{"tenantMaxDiskBytes", cfg.TenantMaxDiskBytes},
+	} {
+		if quota.value < 0 {
+			return fmt.Errorf("quotaConfig: %s must not be negative, got %d",
+				quota.name, quota.value)
+		}
+	}
+	if cfg.WarningPercentage < 0 || cfg.WarningPercentage > 100 {
+		return fmt.Errorf("quotaConfig: warningPercentage must be between 0 and 100, got %d",
+			cfg.WarningPercentage)
+	}
+	if !mtEnabled && (cfg.TenantMaxObjects > 0 || cfg.TenantMaxDiskBytes > 0) {
+		return fmt.Errorf("quotaConfig: tenant quotas require multi-tenancy to be enabled")
+	}
+	return nil
+}
+
+// validateMemtableConfig makes sure all flush thresholds are non-negative. A
+// nil config or zero values fall back to the global configuration.
+func validateMemtableConfig(cfg *models.MemtableConfig) error {
+	if cfg == nil {
+		return nil
+	}
+	if cfg.MaxSizeMB < 0 {
+		return fmt.Errorf("memtableConfig: maxSizeMB must not be negative, got %d", cfg.MaxSizeMB)
+	}
+	if cfg.MaxAgeSeconds < 0 {
+		return fmt.Errorf("memtableConfig: maxAgeSeconds must not be negative, got %d", cfg.MaxAgeSeconds)
+	}
+	if cfg.MaxWALSizeMB < 0 {
+		return fmt.Errorf("memtableConfig: maxWALSizeMB must not be negative, got %d", cfg.MaxWALSizeMB)
+	}
+	return nil
+}
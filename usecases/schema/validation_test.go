@@ -527,6 +527,77 @@ func Test_Validation_PropertyIndexing(t *testing.T) {
 			})
 		}
 	})
+
+	t.Run("validates indexPositions", func(t *testing.T) {
+		vFalse := false
+		vTrue := true
+
+		for _, tc := range []struct {
+			name            string
+			dataType        schema.DataType
+			indexSearchable *bool
+			expectedErrMsg  string
+		}{
+			{"text", schema.DataTypeText, nil, ""},
+			{"searchable text[]", schema.DataTypeTextArray, &vTrue, ""},
+			{
+				"text which is not searchable", schema.DataTypeText, &vFalse,
+				"`indexPositions` requires `indexSearchable` to be enabled",
+			},
+			{
+				"int", schema.DataTypeInt, nil,
+				"`indexPositions` is allowed only for text/text[] data types. " +
+					"For other data types set false or leave empty",
+			},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				err := newSchemaManager().validatePropertyIndexing(&models.Property{
+					Name:            "prop",
+					DataType:        tc.dataType.PropString(),
+					IndexSearchable: tc.indexSearchable,
+					IndexPositions:  &vTrue,
+				})
+
+				if tc.expectedErrMsg != "" {
+					assert.EqualError(t, err, tc.expectedErrMsg)
+				} else {
+					require.Nil(t, err)
+				}
+			})
+		}
+	})
+
+	t.Run("validates indexRangeFilters", func(t *testing.T) {
+		vTrue := true
+		errMsg := "`indexRangeFilters` is allowed only for int, number and date data types. " +
+			"For other data types set false or leave empty"
+
+		for _, tc := range []struct {
+			dataType       schema.DataType
+			expectedErrMsg string
+		}{
+			{schema.DataTypeInt, ""},
+			{schema.DataTypeNumber, ""},
+			{schema.DataTypeDate, ""},
+			{schema.DataTypeIntArray, errMsg},
+			{schema.DataTypeText, errMsg},
+			{schema.DataTypeBoolean, errMsg},
+		} {
+			t.Run(string(tc.dataType), func(t *testing.T) {
+				err := newSchemaManager().validatePropertyIndexing(&models.Property{
+					Name:              "prop",
+					DataType:          tc.dataType.PropString(),
+					IndexRangeFilters: &vTrue,
+				})
+
+				if tc.expectedErrMsg != "" {
+					assert.EqualError(t, err, tc.expectedErrMsg)
+				} else {
+					require.Nil(t, err)
+				}
+			})
+		}
+	})
 }
 
 type fakePropertyDataType struct {
@@ -566,3 +637,166 @@ func (pdt *fakePropertyDataType) Classes() []schema.ClassName {
 func (pdt *fakePropertyDataType) ContainsClass(name schema.ClassName) bool {
 	return false
 }
+
+func Test_Validation_PropertyCardinality(t *testing.T) {
+	one, two, zero := int64(1), int64(2), int64(0)
+
+	testCases := []struct {
+		name           string
+		dataType       []string
+		cardinality    string
+		maxCount       *int64
+		expectedErrMsg string
+	}{
+		{name: "ref without constraints", dataType: []string{"Person"}},
+		{name: "text without constraints", dataType: schema.DataTypeText.PropString()},
+		{name: "single valued ref", dataType: []string{"Person"}, cardinality: "one"},
+		{name: "single valued ref with maxCount 1", dataType: []string{"Person"}, cardinality: "one", maxCount: &one},
+		{name: "multi valued ref", dataType: []string{"Person"}, cardinality: "many"},
+		{name: "ref with maxCount", dataType: []string{"Person", "Company"}, maxCount: &two},
+		{
+			name: "single valued ref with maxCount 2", dataType: []string{"Person"}, cardinality: "one", maxCount: &two,
+			expectedErrMsg: `maxCount must be 1 or unset for cardinality "one", got 2`,
+		},
+		{
+			name: "ref with maxCount 0", dataType: []string{"Person"}, maxCount: &zero,
+			expectedErrMsg: "maxCount must be at least 1, got 0",
+		},
+		{
+			name: "invalid cardinality", dataType: []string{"Person"}, cardinality: "few",
+			expectedErrMsg: `invalid cardinality "few", must be "one" or "many"`,
+		},
+		{
+			name: "text with cardinality", dataType: schema.DataTypeText.PropString(), cardinality: "one",
+			expectedErrMsg: "cardinality and maxCount are only allowed for cross-references",
+		},
+		{
+			name: "int array with maxCount", dataType: schema.DataTypeIntArray.PropString(), maxCount: &two,
+			expectedErrMsg: "cardinality and maxCount are only allowed for cross-references",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validatePropertyCardinality(&models.Property{
+				Name:        "prop",
+				DataType:    tc.dataType,
+				Cardinality: tc.cardinality,
+				MaxCount:    tc.maxCount,
+			})
+			if tc.expectedErrMsg == "" {
+				assert.Nil(t, err)
+			} else {
+				require.NotNil(t, err)
+				assert.EqualError(t, err, tc.expectedErrMsg)
+			}
+		})
+	}
+}
+
+func Test_Validation_PropertyOnDelete(t *testing.T) {
+	vFalse := false
+	vTrue := true
+	testCases := []struct {
+		name            string
+		dataType        []string
+		onDelete        string
+		indexFilterable *bool
+		indexInverted   *bool
+		expectedErrMsg  string
+	}{
+		{name: "ref without policy", dataType: []string{"Person"}},
+		{name: "ref with cascade", dataType: []string{"Person"}, onDelete: "cascade"},
+		{name: "ref with setNull", dataType: []string{"Person", "Company"}, onDelete: "setNull"},
+		{name: "ref with restrict", dataType: []string{"Person"}, onDelete: "restrict"},
+		{
+			name: "ref with invalid policy", dataType: []string{"Person"}, onDelete: "ignore",
+			expectedErrMsg: `invalid onDelete "ignore", must be "cascade", "setNull" or "restrict"`,
+		},
+		{
+			name: "text with policy", dataType: schema.DataTypeText.PropString(), onDelete: "cascade",
+			expectedErrMsg: "onDelete is only allowed for cross-references",
+		},
+		{
+			name: "filterable ref with policy", dataType: []string{"Person"}, onDelete: "cascade",
+			indexFilterable: &vTrue,
+		},
+		{
+			name: "not filterable ref with policy", dataType: []string{"Person"}, onDelete: "cascade",
+			indexFilterable: &vFalse,
+			expectedErrMsg:  "onDelete requires the property to be filterable, `indexFilterable` must not be false",
+		},
+		{
+			name: "not inverted ref with policy", dataType: []string{"Person"}, onDelete: "restrict",
+			indexInverted:  &vFalse,
+			expectedErrMsg: "onDelete requires the property to be filterable, `indexFilterable` must not be false",
+		},
+		{
+			name: "not filterable ref without policy", dataType: []string{"Person"},
+			indexFilterable: &vFalse,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validatePropertyOnDelete(&models.Property{
+				Name:            "prop",
+				DataType:        tc.dataType,
+				OnDelete:        tc.onDelete,
+				IndexFilterable: tc.indexFilterable,
+				IndexInverted:   tc.indexInverted,
+			})
+			if tc.expectedErrMsg == "" {
+				assert.Nil(t, err)
+			} else {
+				require.NotNil(t, err)
+				assert.EqualError(t, err, tc.expectedErrMsg)
+			}
+		})
+	}
+}
+
+func Test_Validation_PropertyUniqueKey(t *testing.T) {
+	errMsg := "uniqueKey is only allowed for the data types text, int, " +
+		"number, boolean, date and uuid"
+
+	testCases := []struct {
+		name           string
+		dataType       []string
+		uniqueKey      bool
+		expectedErrMsg string
+	}{
+		{name: "text", dataType: schema.DataTypeText.PropString(), uniqueKey: true},
+		{name: "int", dataType: schema.DataTypeInt.PropString(), uniqueKey: true},
+		{name: "uuid", dataType: schema.DataTypeUUID.PropString(), uniqueKey: true},
+		{
+			name: "text array", dataType: schema.DataTypeTextArray.PropString(), uniqueKey: true,
+			expectedErrMsg: errMsg,
+		},
+		{
+			name: "geo coordinates", dataType: schema.DataTypeGeoCoordinates.PropString(), uniqueKey: true,
+			expectedErrMsg: errMsg,
+		},
+		{
+			name: "ref", dataType: []string{"Person"}, uniqueKey: true,
+			expectedErrMsg: errMsg,
+		},
+		{name: "ref without unique key", dataType: []string{"Person"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validatePropertyUniqueKey(&models.Property{
+				Name:      "prop",
+				DataType:  tc.dataType,
+				UniqueKey: tc.uniqueKey,
+			})
+			if tc.expectedErrMsg == "" {
+				assert.Nil(t, err)
+			} else {
+				require.NotNil(t, err)
+				assert.EqualError(t, err, tc.expectedErrMsg)
+			}
+		})
+	}
+}
@@ -0,0 +1,197 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// LockVectorDimensions records the length of the vectors of a class on the
+// first insert. Once recorded, vectors of a different length are rejected
+// before they reach the vector index. An empty targetVector refers to the
+// class-level vector, otherwise the dimensions are recorded for the named
+// vector space. If the dimensions are already set, for example by a
+// concurrent insert, they are returned unchanged.
+func (m *Manager) LockVectorDimensions(ctx context.Context, className,
+	targetVector string, dimensions int64,
+) (int64, error) {
+	if dimensions <= 0 {
+		return 0, fmt.Errorf("vector dimensions must be positive, got %d", dimensions)
+	}
+
+	m.Lock()
+	defer m.Unlock()
+
+	class, err := m.schemaCache.readOnlyClass(className)
+	if err != nil {
+		return 0, err
+	}
+	recorded, err := recordedVectorDimensions(class, targetVector)
+	if err != nil {
+		return 0, err
+	}
+	if recorded > 0 {
+		return recorded, nil
+	}
+
+	tx, err := m.cluster.BeginTransaction(ctx, LockVectorDimensions,
+		LockVectorDimensionsPayload{className, targetVector, dimensions}, DefaultTxTTL)
+	if err != nil {
+		// possible causes for errors could be nodes down (we expect every node to
+		// the up for a schema transaction) or concurrent transactions from other
+		// nodes
+		return 0, errors.Wrap(err, "open cluster-wide transaction")
+	}
+
+	if err := m.cluster.CommitWriteTransaction(ctx, tx); err != nil {
+		// Only log the commit error, but do not abort the changes locally. Once
+		// we've told others to commit, we also need to commit ourselves!
+		m.logger.WithError(err).Errorf("not every node was able to commit")
+	}
+
+	return m.lockVectorDimensionsApplyChanges(ctx, className, targetVector, dimensions)
+}
+
+// lockVectorDimensionsApplyChanges keeps dimensions which were recorded
+// before, so that the first committed transaction wins on every node
+func (m *Manager) lockVectorDimensionsApplyChanges(ctx context.Context,
+	className, targetVector string, dimensions int64,
+) (int64, error) {
+	class, recorded, changed, err := m.schemaCache.lockVectorDimensions(className,
+		targetVector, dimensions)
+	if err != nil {
+		return 0, err
+	}
+	if !changed {
+		return recorded, nil
+	}
+
+	metadata, err := json.Marshal(&class)
+	if err != nil {
+		return 0, fmt.Errorf("marshal class %s: %w", className, err)
+	}
+	m.logger.
+		WithField("action", "schema.lock_vector_dimensions").
+		Debug("saving updated schema to configuration store")
+	err = m.repo.UpdateClass(ctx, ClassPayload{Name: className, Metadata: metadata})
+	if err != nil {
+		return 0, err
+	}
+	m.triggerSchemaUpdateCallbacks()
+
+	return recorded, nil
+}
+
+// recordedVectorDimensions returns the dimensions recorded for the
+// class-level vector if targetVector is empty, otherwise the ones of the
+// named vector space
+func recordedVectorDimensions(class *models.Class, targetVector string) (int64, error) {
+	if targetVector == "" {
+		return class.VectorDimensions, nil
+	}
+	cfg, ok := class.VectorConfig[targetVector]
+	if !ok {
+		return 0, fmt.Errorf("class %q has no target vector %q", class.Class, targetVector)
+	}
+	return cfg.Dimensions, nil
+}
+
+// setVectorDimensionsDefault takes the vector dimensions of the class and of
+// its named vector spaces from the "dimensions" setting of their vectorizer
+// module, if the module has one
+func setVectorDimensionsDefault(class *models.Class) {
+	if class.VectorDimensions == 0 {
+		class.VectorDimensions = moduleVectorDimensions(class)
+	}
+	for name, cfg := range class.VectorConfig {
+		if cfg.Dimensions == 0 {
+			cfg.Dimensions = targetVectorModuleDimensions(cfg)
+			class.VectorConfig[name] = cfg
+		}
+	}
+}
+
+// moduleVectorDimensions returns the "dimensions" setting of the vectorizer
+// module of the class or 0 if it is not set
+func moduleVectorDimensions(class *models.Class) int64 {
+	moduleConfig, ok := class.ModuleConfig.(map[string]interface{})
+	if !ok {
+		return 0
+	}
+	vectorizerConfig, ok := moduleConfig[class.Vectorizer].(map[string]interface{})
+	if !ok {
+		return 0
+	}
+	return dimensionsSetting(vectorizerConfig)
+}
+
+// targetVectorModuleDimensions returns the "dimensions" setting of the
+// vectorizer module of a named vector space or 0 if it is not set
+func targetVectorModuleDimensions(cfg models.VectorConfig) int64 {
+	_, vectorizerConfig, err := schema.TargetVectorizer(cfg)
+	if err != nil {
+		return 0
+	}
+	return dimensionsSetting(vectorizerConfig)
+}
+
+func dimensionsSetting(vectorizerConfig map[string]interface{}) int64 {
+	switch dims := vectorizerConfig["dimensions"].(type) {
+	case json.Number:
+		asInt, err := dims.Int64()
+		if err != nil {
+			return 0
+		}
+		return asInt
+	case float64:
+		return int64(dims)
+	case int:
+		return int64(dims)
+	case int64:
+		return dims
+	default:
+		return 0
+	}
+}
+
+func validateVectorDimensions(class *models.Class) error {
+	if class.VectorDimensions < 0 {
+		return fmt.Errorf("vectorDimensions must not be negative, got %d",
+			class.VectorDimensions)
+	}
+
+	if dims := moduleVectorDimensions(class); dims != 0 && dims != class.VectorDimensions {
+		return fmt.Errorf("vectorDimensions %d do not match the dimensions %d of vectorizer %q",
+			class.VectorDimensions, dims, class.Vectorizer)
+	}
+
+	for _, name := range schema.TargetVectorNames(class) {
+		cfg := class.VectorConfig[name]
+		if cfg.Dimensions < 0 {
+			return fmt.Errorf("target vector %q: dimensions must not be negative, got %d",
+				name, cfg.Dimensions)
+		}
+		if dims := targetVectorModuleDimensions(cfg); dims != 0 && dims != cfg.Dimensions {
+			vectorizer, _, _ := schema.TargetVectorizer(cfg)
+			return fmt.Errorf("target vector %q: dimensions %d do not match the dimensions %d of vectorizer %q",
+				name, cfg.Dimensions, dims, vectorizer)
+		}
+	}
+
+	return nil
+}
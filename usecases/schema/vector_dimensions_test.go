@@ -0,0 +1,236 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestAddClassVectorDimensions(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("explicitly set", func(t *testing.T) {
+		sm := newSchemaManager()
+		require.Nil(t, sm.AddClass(ctx, nil, &models.Class{
+			Class:            "Article",
+			VectorDimensions: 384,
+		}))
+
+		class, err := sm.GetClass(ctx, nil, "Article")
+		require.Nil(t, err)
+		assert.Equal(t, int64(384), class.VectorDimensions)
+	})
+
+	t.Run("from the module config", func(t *testing.T) {
+		sm := newSchemaManager()
+		require.Nil(t, sm.AddClass(ctx, nil, &models.Class{
+			Class:      "Article",
+			Vectorizer: "model1",
+			ModuleConfig: map[string]interface{}{
+				"my-module1": map[string]interface{}{
+					"my-setting": "value",
+				},
+				"model1": map[string]interface{}{
+					"dimensions": json.Number("300"),
+				},
+			},
+		}))
+
+		class, err := sm.GetClass(ctx, nil, "Article")
+		require.Nil(t, err)
+		assert.Equal(t, int64(300), class.VectorDimensions)
+	})
+
+	t.Run("conflicting with the module config", func(t *testing.T) {
+		err := newSchemaManager().AddClass(ctx, nil, &models.Class{
+			Class:            "Article",
+			Vectorizer:       "model1",
+			VectorDimensions: 384,
+			ModuleConfig: map[string]interface{}{
+				"my-module1": map[string]interface{}{
+					"my-setting": "value",
+				},
+				"model1": map[string]interface{}{
+					"dimensions": float64(300),
+				},
+			},
+		})
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "do not match the dimensions 300")
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		err := newSchemaManager().AddClass(ctx, nil, &models.Class{
+			Class:            "Article",
+			VectorDimensions: -1,
+		})
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "must not be negative")
+	})
+}
+
+func TestLockVectorDimensions(t *testing.T) {
+	ctx := context.Background()
+	sm := newSchemaManager()
+	require.Nil(t, sm.AddClass(ctx, nil, &models.Class{Class: "Article"}))
+
+	t.Run("the first insert records the dimensions", func(t *testing.T) {
+		dims, err := sm.LockVectorDimensions(ctx, "Article", "", 3)
+		require.Nil(t, err)
+		assert.Equal(t, int64(3), dims)
+
+		class, err := sm.GetClass(ctx, nil, "Article")
+		require.Nil(t, err)
+		assert.Equal(t, int64(3), class.VectorDimensions)
+	})
+
+	t.Run("recorded dimensions are kept", func(t *testing.T) {
+		dims, err := sm.LockVectorDimensions(ctx, "Article", "", 4)
+		require.Nil(t, err)
+		assert.Equal(t, int64(3), dims)
+	})
+
+	t.Run("unknown class", func(t *testing.T) {
+		_, err := sm.LockVectorDimensions(ctx, "Unknown", "", 3)
+		require.NotNil(t, err)
+	})
+
+	t.Run("updating the class keeps the dimensions", func(t *testing.T) {
+		require.Nil(t, sm.UpdateClass(ctx, nil, "Article", &models.Class{Class: "Article"}))
+
+		class, err := sm.GetClass(ctx, nil, "Article")
+		require.Nil(t, err)
+		assert.Equal(t, int64(3), class.VectorDimensions)
+	})
+
+	t.Run("the dimensions can not be changed", func(t *testing.T) {
+		err := sm.UpdateClass(ctx, nil, "Article", &models.Class{
+			Class:            "Article",
+			VectorDimensions: 4,
+		})
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "vector dimensions are immutable")
+	})
+}
+
+func TestTargetVectorDimensions(t *testing.T) {
+	ctx := context.Background()
+	none := map[string]interface{}{"none": map[string]interface{}{}}
+
+	t.Run("from the module config", func(t *testing.T) {
+		sm := newSchemaManager()
+		require.Nil(t, sm.AddClass(ctx, nil, &models.Class{
+			Class: "Article",
+			VectorConfig: map[string]models.VectorConfig{
+				"title": {Vectorizer: map[string]interface{}{
+					"model1": map[string]interface{}{"dimensions": json.Number("300")},
+				}},
+			},
+		}))
+
+		class, err := sm.GetClass(ctx, nil, "Article")
+		require.Nil(t, err)
+		assert.Equal(t, int64(300), class.VectorConfig["title"].Dimensions)
+	})
+
+	t.Run("conflicting with the module config", func(t *testing.T) {
+		err := newSchemaManager().AddClass(ctx, nil, &models.Class{
+			Class: "Article",
+			VectorConfig: map[string]models.VectorConfig{
+				"title": {
+					Dimensions: 384,
+					Vectorizer: map[string]interface{}{
+						"model1": map[string]interface{}{"dimensions": float64(300)},
+					},
+				},
+			},
+		})
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "target vector \"title\": dimensions 384 do not match the dimensions 300")
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		err := newSchemaManager().AddClass(ctx, nil, &models.Class{
+			Class: "Article",
+			VectorConfig: map[string]models.VectorConfig{
+				"title": {Dimensions: -1, Vectorizer: none},
+			},
+		})
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "must not be negative")
+	})
+
+	sm := newSchemaManager()
+	require.Nil(t, sm.AddClass(ctx, nil, &models.Class{
+		Class: "Article",
+		VectorConfig: map[string]models.VectorConfig{
+			"title": {Vectorizer: none},
+			"body":  {Vectorizer: none},
+		},
+	}))
+
+	t.Run("the first insert records the dimensions of the target vector", func(t *testing.T) {
+		dims, err := sm.LockVectorDimensions(ctx, "Article", "title", 3)
+		require.Nil(t, err)
+		assert.Equal(t, int64(3), dims)
+
+		class, err := sm.GetClass(ctx, nil, "Article")
+		require.Nil(t, err)
+		assert.Equal(t, int64(3), class.VectorConfig["title"].Dimensions)
+		assert.Equal(t, int64(0), class.VectorConfig["body"].Dimensions)
+		assert.Equal(t, int64(0), class.VectorDimensions)
+	})
+
+	t.Run("recorded dimensions are kept", func(t *testing.T) {
+		dims, err := sm.LockVectorDimensions(ctx, "Article", "title", 4)
+		require.Nil(t, err)
+		assert.Equal(t, int64(3), dims)
+	})
+
+	t.Run("unknown target vector", func(t *testing.T) {
+		_, err := sm.LockVectorDimensions(ctx, "Article", "unknown", 3)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "has no target vector \"unknown\"")
+	})
+
+	t.Run("updating the class keeps the dimensions", func(t *testing.T) {
+		class, err := sm.GetClass(ctx, nil, "Article")
+		require.Nil(t, err)
+		updated := models.Class{Class: "Article", VectorConfig: map[string]models.VectorConfig{}}
+		for name := range class.VectorConfig {
+			updated.VectorConfig[name] = models.VectorConfig{Dimensions: 0, Vectorizer: none}
+		}
+		require.Nil(t, sm.UpdateClass(ctx, nil, "Article", &updated))
+
+		class, err = sm.GetClass(ctx, nil, "Article")
+		require.Nil(t, err)
+		assert.Equal(t, int64(3), class.VectorConfig["title"].Dimensions)
+	})
+
+	t.Run("the dimensions can not be changed", func(t *testing.T) {
+		class, err := sm.GetClass(ctx, nil, "Article")
+		require.Nil(t, err)
+		updated := models.Class{Class: "Article", VectorConfig: map[string]models.VectorConfig{}}
+		for name := range class.VectorConfig {
+			updated.VectorConfig[name] = models.VectorConfig{Dimensions: 4, Vectorizer: none}
+		}
+		err = sm.UpdateClass(ctx, nil, "Article", &updated)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "vector config of named vectors is immutable")
+	})
+}
@@ -0,0 +1,46 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// BuildVectorIndex builds the graph of the empty vector index of a shard held
+// by this node in bulk from the vectors of its objects. This is used by
+// classes with indexBuild "gpu", whose inserts are deferred until the build.
+// An empty targetVector selects the class-level vector.
+func (m *Manager) BuildVectorIndex(ctx context.Context, principal *models.Principal,
+	className, shardName, targetVector string,
+) (*models.VectorIndexBuild, error) {
+	err := m.Authorizer.Authorize(principal, "update",
+		fmt.Sprintf("schema/%s/shards/%s", className, shardName))
+	if err != nil {
+		return nil, err
+	}
+
+	if !m.schemaCache.classExist(className) {
+		return nil, ErrNotFound
+	}
+
+	build, err := m.migrator.BuildVectorIndex(ctx, className, shardName, targetVector)
+	if err != nil {
+		return nil, err
+	}
+
+	build.ClassName = className
+	build.Node = m.clusterState.LocalName()
+	return build, nil
+}
@@ -0,0 +1,55 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+type buildMigrator struct {
+	NilMigrator
+	targetVector string
+}
+
+func (m *buildMigrator) BuildVectorIndex(ctx context.Context,
+	className, shardName, targetVector string,
+) (*models.VectorIndexBuild, error) {
+	m.targetVector = targetVector
+	return &models.VectorIndexBuild{Shard: shardName, TargetVector: targetVector, Nodes: 10}, nil
+}
+
+func TestBuildVectorIndex(t *testing.T) {
+	ctx := context.Background()
+	sm := newSchemaManager()
+	migrator := &buildMigrator{}
+	sm.migrator = migrator
+	require.Nil(t, sm.AddClass(ctx, nil, &models.Class{Class: "Article"}))
+
+	_, err := sm.BuildVectorIndex(ctx, nil, "WrongClass", "shard1", "")
+	assert.Equal(t, ErrNotFound, err)
+
+	build, err := sm.BuildVectorIndex(ctx, nil, "Article", "shard1", "title")
+	require.Nil(t, err)
+	assert.Equal(t, "title", migrator.targetVector)
+	assert.Equal(t, &models.VectorIndexBuild{
+		ClassName:    "Article",
+		Node:         "node1",
+		Shard:        "shard1",
+		TargetVector: "title",
+		Nodes:        10,
+	}, build)
+}
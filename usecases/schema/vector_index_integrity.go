@@ -0,0 +1,50 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// CheckVectorIndexIntegrity validates the vector index graphs of the shards
+// of a class held by this node. If repair is set, the damaged portions of the
+// graphs are repaired.
+func (m *Manager) CheckVectorIndexIntegrity(ctx context.Context, principal *models.Principal,
+	className string, repair bool,
+) (*models.VectorIndexIntegrityReport, error) {
+	verb := "list"
+	if repair {
+		verb = "update"
+	}
+	err := m.Authorizer.Authorize(principal, verb, fmt.Sprintf("schema/%s/shards", className))
+	if err != nil {
+		return nil, err
+	}
+
+	if !m.schemaCache.classExist(className) {
+		return nil, ErrNotFound
+	}
+
+	results, err := m.migrator.CheckVectorIndexIntegrity(ctx, className, repair)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.VectorIndexIntegrityReport{
+		ClassName: className,
+		Node:      m.clusterState.LocalName(),
+		Results:   results,
+	}, nil
+}
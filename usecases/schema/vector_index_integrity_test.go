@@ -0,0 +1,55 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+type integrityMigrator struct {
+	NilMigrator
+	repaired bool
+	results  []*models.VectorIndexIntegrity
+}
+
+func (m *integrityMigrator) CheckVectorIndexIntegrity(ctx context.Context,
+	className string, repair bool,
+) ([]*models.VectorIndexIntegrity, error) {
+	m.repaired = repair
+	return m.results, nil
+}
+
+func TestCheckVectorIndexIntegrity(t *testing.T) {
+	ctx := context.Background()
+	sm := newSchemaManager()
+	results := []*models.VectorIndexIntegrity{{Shard: "shard1", Nodes: 3, EntrypointValid: true}}
+	migrator := &integrityMigrator{results: results}
+	sm.migrator = migrator
+	require.Nil(t, sm.AddClass(ctx, nil, &models.Class{Class: "Article"}))
+
+	_, err := sm.CheckVectorIndexIntegrity(ctx, nil, "WrongClass", false)
+	assert.Equal(t, ErrNotFound, err)
+
+	report, err := sm.CheckVectorIndexIntegrity(ctx, nil, "Article", true)
+	require.Nil(t, err)
+	assert.True(t, migrator.repaired)
+	assert.Equal(t, &models.VectorIndexIntegrityReport{
+		ClassName: "Article",
+		Node:      "node1",
+		Results:   results,
+	}, report)
+}
@@ -0,0 +1,50 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// MeasureVectorIndexRecall measures the recall@k of the vector indexes of the
+// shards of a class held by this node with the current ef settings. Up to
+// sampleSize indexed vectors per shard are used as queries.
+func (m *Manager) MeasureVectorIndexRecall(ctx context.Context, principal *models.Principal,
+	className string, sampleSize, k int,
+) (*models.VectorIndexRecallReport, error) {
+	err := m.Authorizer.Authorize(principal, "list", fmt.Sprintf("schema/%s/shards", className))
+	if err != nil {
+		return nil, err
+	}
+
+	if sampleSize <= 0 || k <= 0 {
+		return nil, ErrInvalidRecallParams
+	}
+
+	if !m.schemaCache.classExist(className) {
+		return nil, ErrNotFound
+	}
+
+	results, err := m.migrator.MeasureVectorIndexRecall(ctx, className, sampleSize, k)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.VectorIndexRecallReport{
+		ClassName: className,
+		Node:      m.clusterState.LocalName(),
+		Results:   results,
+	}, nil
+}
@@ -0,0 +1,64 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+type recallMigrator struct {
+	NilMigrator
+	sampleSize int
+	k          int
+	results    []*models.VectorIndexRecall
+}
+
+func (m *recallMigrator) MeasureVectorIndexRecall(ctx context.Context,
+	className string, sampleSize, k int,
+) ([]*models.VectorIndexRecall, error) {
+	m.sampleSize = sampleSize
+	m.k = k
+	return m.results, nil
+}
+
+func TestMeasureVectorIndexRecall(t *testing.T) {
+	ctx := context.Background()
+	sm := newSchemaManager()
+	results := []*models.VectorIndexRecall{{Shard: "shard1", SampleSize: 50, K: 10, Ef: 64, Recall: 0.98}}
+	migrator := &recallMigrator{results: results}
+	sm.migrator = migrator
+	require.Nil(t, sm.AddClass(ctx, nil, &models.Class{Class: "Article"}))
+
+	_, err := sm.MeasureVectorIndexRecall(ctx, nil, "WrongClass", 50, 10)
+	assert.Equal(t, ErrNotFound, err)
+
+	_, err = sm.MeasureVectorIndexRecall(ctx, nil, "Article", 0, 10)
+	assert.Equal(t, ErrInvalidRecallParams, err)
+
+	_, err = sm.MeasureVectorIndexRecall(ctx, nil, "Article", 50, -1)
+	assert.Equal(t, ErrInvalidRecallParams, err)
+
+	report, err := sm.MeasureVectorIndexRecall(ctx, nil, "Article", 50, 10)
+	require.Nil(t, err)
+	assert.Equal(t, 50, migrator.sampleSize)
+	assert.Equal(t, 10, migrator.k)
+	assert.Equal(t, &models.VectorIndexRecallReport{
+		ClassName: "Article",
+		Node:      "node1",
+		Results:   results,
+	}, report)
+}
@@ -0,0 +1,93 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ExportVectorIndexSnapshot writes the graph and vectors of the vector index
+// of a shard held by this node to a portable snapshot file in the configured
+// snapshot directory. An empty targetVector selects the class-level vector.
+func (m *Manager) ExportVectorIndexSnapshot(ctx context.Context, principal *models.Principal,
+	className, shardName, targetVector, file string,
+) (*models.VectorIndexSnapshot, error) {
+	path, err := m.authorizeVectorIndexSnapshot(principal, className, shardName, file)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot, err := m.migrator.ExportVectorIndexSnapshot(ctx, className, shardName, targetVector, path)
+	if err != nil {
+		return nil, err
+	}
+	return m.vectorIndexSnapshot(snapshot, className, file), nil
+}
+
+// ImportVectorIndexSnapshot attaches a portable snapshot file from the
+// configured snapshot directory to the empty vector index of a shard held by
+// this node, so that indexes built offline don't need to be re-inserted.
+func (m *Manager) ImportVectorIndexSnapshot(ctx context.Context, principal *models.Principal,
+	className, shardName, targetVector, file string,
+) (*models.VectorIndexSnapshot, error) {
+	path, err := m.authorizeVectorIndexSnapshot(principal, className, shardName, file)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot, err := m.migrator.ImportVectorIndexSnapshot(ctx, className, shardName, targetVector, path)
+	if err != nil {
+		return nil, err
+	}
+	return m.vectorIndexSnapshot(snapshot, className, file), nil
+}
+
+// authorizeVectorIndexSnapshot validates a snapshot request and returns the
+// path of the snapshot file. Snapshot files can only be read from and written
+// to the configured snapshot directory.
+func (m *Manager) authorizeVectorIndexSnapshot(principal *models.Principal,
+	className, shardName, file string,
+) (string, error) {
+	err := m.Authorizer.Authorize(principal, "update",
+		fmt.Sprintf("schema/%s/shards/%s", className, shardName))
+	if err != nil {
+		return "", err
+	}
+
+	dir := m.config.Persistence.VectorIndexSnapshotPath
+	if dir == "" {
+		return "", ErrSnapshotsDisabled
+	}
+
+	if file == "" || file == "." || file == ".." || filepath.Base(file) != file {
+		return "", ErrInvalidSnapshotFile
+	}
+
+	if !m.schemaCache.classExist(className) {
+		return "", ErrNotFound
+	}
+
+	return filepath.Join(dir, file), nil
+}
+
+func (m *Manager) vectorIndexSnapshot(snapshot *models.VectorIndexSnapshot,
+	className, file string,
+) *models.VectorIndexSnapshot {
+	snapshot.ClassName = className
+	snapshot.Node = m.clusterState.LocalName()
+	snapshot.File = file
+	return snapshot
+}
@@ -0,0 +1,97 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+type snapshotMigrator struct {
+	NilMigrator
+	exported string
+	imported string
+}
+
+func (m *snapshotMigrator) ExportVectorIndexSnapshot(ctx context.Context,
+	className, shardName, targetVector, path string,
+) (*models.VectorIndexSnapshot, error) {
+	m.exported = path
+	return &models.VectorIndexSnapshot{Shard: shardName, TargetVector: targetVector, Nodes: 10}, nil
+}
+
+func (m *snapshotMigrator) ImportVectorIndexSnapshot(ctx context.Context,
+	className, shardName, targetVector, path string,
+) (*models.VectorIndexSnapshot, error) {
+	m.imported = path
+	return &models.VectorIndexSnapshot{Shard: shardName, TargetVector: targetVector, Nodes: 10}, nil
+}
+
+func TestVectorIndexSnapshot(t *testing.T) {
+	ctx := context.Background()
+	sm := newSchemaManager()
+	migrator := &snapshotMigrator{}
+	sm.migrator = migrator
+	require.Nil(t, sm.AddClass(ctx, nil, &models.Class{Class: "Article"}))
+
+	t.Run("disabled without a snapshot directory", func(t *testing.T) {
+		_, err := sm.ExportVectorIndexSnapshot(ctx, nil, "Article", "shard1", "", "graph.snapshot")
+		assert.Equal(t, ErrSnapshotsDisabled, err)
+
+		_, err = sm.ImportVectorIndexSnapshot(ctx, nil, "Article", "shard1", "", "graph.snapshot")
+		assert.Equal(t, ErrSnapshotsDisabled, err)
+	})
+
+	sm.config.Persistence.VectorIndexSnapshotPath = "/snapshots"
+
+	t.Run("invalid file names", func(t *testing.T) {
+		for _, file := range []string{"", ".", "..", "../graph.snapshot", "dir/graph.snapshot", "/graph.snapshot"} {
+			_, err := sm.ExportVectorIndexSnapshot(ctx, nil, "Article", "shard1", "", file)
+			assert.Equal(t, ErrInvalidSnapshotFile, err, file)
+
+			_, err = sm.ImportVectorIndexSnapshot(ctx, nil, "Article", "shard1", "", file)
+			assert.Equal(t, ErrInvalidSnapshotFile, err, file)
+		}
+	})
+
+	t.Run("non-existing class", func(t *testing.T) {
+		_, err := sm.ExportVectorIndexSnapshot(ctx, nil, "WrongClass", "shard1", "", "graph.snapshot")
+		assert.Equal(t, ErrNotFound, err)
+	})
+
+	expected := &models.VectorIndexSnapshot{
+		ClassName:    "Article",
+		Node:         "node1",
+		Shard:        "shard1",
+		TargetVector: "title",
+		File:         "graph.snapshot",
+		Nodes:        10,
+	}
+
+	t.Run("export", func(t *testing.T) {
+		snapshot, err := sm.ExportVectorIndexSnapshot(ctx, nil, "Article", "shard1", "title", "graph.snapshot")
+		require.Nil(t, err)
+		assert.Equal(t, "/snapshots/graph.snapshot", migrator.exported)
+		assert.Equal(t, expected, snapshot)
+	})
+
+	t.Run("import", func(t *testing.T) {
+		snapshot, err := sm.ImportVectorIndexSnapshot(ctx, nil, "Article", "shard1", "title", "graph.snapshot")
+		require.Nil(t, err)
+		assert.Equal(t, "/snapshots/graph.snapshot", migrator.imported)
+		assert.Equal(t, expected, snapshot)
+	})
+}
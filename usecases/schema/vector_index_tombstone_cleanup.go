@@ -0,0 +1,70 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// VectorIndexTombstoneCleanup returns the state of the tombstone cleanup of
+// the vector indexes of the shards of a class held by this node
+func (m *Manager) VectorIndexTombstoneCleanup(ctx context.Context, principal *models.Principal,
+	className string,
+) (*models.VectorIndexTombstoneCleanupReport, error) {
+	return m.vectorIndexTombstoneCleanup(ctx, principal, "list", className, nil)
+}
+
+// PauseVectorIndexTombstoneCleanup stops scheduling the tombstone cleanup of
+// the vector indexes of the shards of a class held by this node until it is
+// resumed or the shards are reloaded
+func (m *Manager) PauseVectorIndexTombstoneCleanup(ctx context.Context, principal *models.Principal,
+	className string,
+) (*models.VectorIndexTombstoneCleanupReport, error) {
+	paused := true
+	return m.vectorIndexTombstoneCleanup(ctx, principal, "update", className, &paused)
+}
+
+// ResumeVectorIndexTombstoneCleanup schedules the tombstone cleanup of the
+// vector indexes of the shards of a class held by this node again
+func (m *Manager) ResumeVectorIndexTombstoneCleanup(ctx context.Context, principal *models.Principal,
+	className string,
+) (*models.VectorIndexTombstoneCleanupReport, error) {
+	paused := false
+	return m.vectorIndexTombstoneCleanup(ctx, principal, "update", className, &paused)
+}
+
+func (m *Manager) vectorIndexTombstoneCleanup(ctx context.Context, principal *models.Principal,
+	verb, className string, paused *bool,
+) (*models.VectorIndexTombstoneCleanupReport, error) {
+	err := m.Authorizer.Authorize(principal, verb, fmt.Sprintf("schema/%s/shards", className))
+	if err != nil {
+		return nil, err
+	}
+
+	if !m.schemaCache.classExist(className) {
+		return nil, ErrNotFound
+	}
+
+	results, err := m.migrator.VectorIndexTombstoneCleanup(ctx, className, paused)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.VectorIndexTombstoneCleanupReport{
+		ClassName: className,
+		Node:      m.clusterState.LocalName(),
+		Results:   results,
+	}, nil
+}
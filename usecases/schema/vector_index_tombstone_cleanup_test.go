@@ -0,0 +1,68 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+type tombstoneCleanupMigrator struct {
+	NilMigrator
+	paused *bool
+}
+
+func (m *tombstoneCleanupMigrator) VectorIndexTombstoneCleanup(ctx context.Context,
+	className string, paused *bool,
+) ([]*models.VectorIndexTombstoneCleanup, error) {
+	if paused != nil {
+		m.paused = paused
+	}
+	isPaused := m.paused != nil && *m.paused
+	return []*models.VectorIndexTombstoneCleanup{{Shard: "shard1", Paused: isPaused}}, nil
+}
+
+func TestVectorIndexTombstoneCleanup(t *testing.T) {
+	ctx := context.Background()
+	sm := newSchemaManager()
+	sm.migrator = &tombstoneCleanupMigrator{}
+	require.Nil(t, sm.AddClass(ctx, nil, &models.Class{Class: "Article"}))
+
+	_, err := sm.VectorIndexTombstoneCleanup(ctx, nil, "WrongClass")
+	assert.Equal(t, ErrNotFound, err)
+	_, err = sm.PauseVectorIndexTombstoneCleanup(ctx, nil, "WrongClass")
+	assert.Equal(t, ErrNotFound, err)
+
+	report, err := sm.VectorIndexTombstoneCleanup(ctx, nil, "Article")
+	require.Nil(t, err)
+	assert.Equal(t, &models.VectorIndexTombstoneCleanupReport{
+		ClassName: "Article",
+		Node:      "node1",
+		Results:   []*models.VectorIndexTombstoneCleanup{{Shard: "shard1"}},
+	}, report)
+
+	report, err = sm.PauseVectorIndexTombstoneCleanup(ctx, nil, "Article")
+	require.Nil(t, err)
+	assert.True(t, report.Results[0].Paused)
+
+	report, err = sm.VectorIndexTombstoneCleanup(ctx, nil, "Article")
+	require.Nil(t, err)
+	assert.True(t, report.Results[0].Paused)
+
+	report, err = sm.ResumeVectorIndexTombstoneCleanup(ctx, nil, "Article")
+	require.Nil(t, err)
+	assert.False(t, report.Results[0].Paused)
+}
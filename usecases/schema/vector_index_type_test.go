@@ -0,0 +1,77 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestAddClassVectorIndexType(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("vamana", func(t *testing.T) {
+		sm := newSchemaManager()
+		err := sm.AddClass(ctx, nil, &models.Class{
+			Class:           "Article",
+			VectorIndexType: "vamana",
+			VectorConfig: map[string]models.VectorConfig{
+				"title": {VectorIndexType: "vamana"},
+			},
+		})
+		require.Nil(t, err)
+
+		class, err := sm.GetClass(ctx, nil, "Article")
+		require.Nil(t, err)
+		assert.Equal(t, "vamana", class.VectorIndexType)
+		assert.Equal(t, "vamana", class.VectorConfig["title"].VectorIndexType)
+	})
+
+	t.Run("flat", func(t *testing.T) {
+		sm := newSchemaManager()
+		err := sm.AddClass(ctx, nil, &models.Class{
+			Class:           "Article",
+			VectorIndexType: "flat",
+		})
+		require.Nil(t, err)
+
+		class, err := sm.GetClass(ctx, nil, "Article")
+		require.Nil(t, err)
+		assert.Equal(t, "flat", class.VectorIndexType)
+	})
+
+	t.Run("dynamic", func(t *testing.T) {
+		sm := newSchemaManager()
+		err := sm.AddClass(ctx, nil, &models.Class{
+			Class:           "Article",
+			VectorIndexType: "dynamic",
+		})
+		require.Nil(t, err)
+
+		class, err := sm.GetClass(ctx, nil, "Article")
+		require.Nil(t, err)
+		assert.Equal(t, "dynamic", class.VectorIndexType)
+	})
+
+	t.Run("unsupported vector index type", func(t *testing.T) {
+		err := newSchemaManager().AddClass(ctx, nil, &models.Class{
+			Class:           "Article",
+			VectorIndexType: "ivf",
+		})
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "unrecognized or unsupported vectorIndexType \"ivf\"")
+	})
+}
@@ -76,9 +76,9 @@ type RemoteIndexClient interface {
 	MultiGetObjects(ctx context.Context, hostname, indexName, shardName string,
 		ids []strfmt.UUID) ([]*storobj.Object, error)
 	SearchShard(ctx context.Context, hostname, indexName, shardName string,
-		searchVector []float32, limit int, filters *filters.LocalFilter,
+		searchVector []float32, targetVector string, limit int, filters *filters.LocalFilter,
 		keywordRanking *searchparams.KeywordRanking, sort []filters.Sort,
-		cursor *filters.Cursor, groupBy *searchparams.GroupBy,
+		cursor *filters.Cursor, groupBy *searchparams.GroupBy, rescore *searchparams.Rescore, exact bool,
 		additional additional.Properties,
 	) ([]*storobj.Object, []float32, error)
 	Aggregate(ctx context.Context, hostname, indexName, shardName string,
@@ -240,12 +240,14 @@ func (ri *RemoteIndex) MultiGetObjects(ctx context.Context, shardName string,
 
 func (ri *RemoteIndex) SearchShard(ctx context.Context, shard string,
 	queryVec []float32,
+	targetVector string,
 	limit int,
 	filters *filters.LocalFilter,
 	keywordRanking *searchparams.KeywordRanking,
 	sort []filters.Sort,
 	cursor *filters.Cursor,
 	groupBy *searchparams.GroupBy,
+	rescore *searchparams.Rescore, exact bool,
 	adds additional.Properties,
 	replEnabled bool,
 ) ([]*storobj.Object, []float32, error) {
@@ -255,7 +257,7 @@ func (ri *RemoteIndex) SearchShard(ctx context.Context, shard string,
 	}
 	f := func(node, host string) (interface{}, error) {
 		objs, scores, err := ri.client.SearchShard(ctx, host, ri.class, shard,
-			queryVec, limit, filters, keywordRanking, sort, cursor, groupBy, adds)
+			queryVec, targetVector, limit, filters, keywordRanking, sort, cursor, groupBy, rescore, exact, adds)
 		if err != nil {
 			return nil, err
 		}
@@ -46,6 +46,20 @@ func Test_Traverser_Authorization(t *testing.T) {
 			expectedResource: "traversal/*",
 		},
 
+		{
+			methodName:       "GetClassStream",
+			additionalArgs:   []interface{}{dto.GetParams{}, func(res []interface{}) error { return nil }},
+			expectedVerb:     "get",
+			expectedResource: "traversal/*",
+		},
+
+		{
+			methodName:       "FederatedSearch",
+			additionalArgs:   []interface{}{FederatedSearchParams{}},
+			expectedVerb:     "get",
+			expectedResource: "traversal/*",
+		},
+
 		{
 			methodName:       "Aggregate",
 			additionalArgs:   []interface{}{&aggregation.Params{}},
@@ -83,7 +97,7 @@ func Test_Traverser_Authorization(t *testing.T) {
 			schemaGetter := &fakeSchemaGetter{}
 
 			manager := NewTraverser(&config.WeaviateConfig{}, locks, logger, authorizer,
-				vectorRepo, explorer, schemaGetter, nil, nil, -1)
+				vectorRepo, explorer, schemaGetter, nil, nil, -1, nil, nil)
 
 			args := append([]interface{}{context.Background(), principal}, test.additionalArgs...)
 			out, _ := callFuncByName(manager, test.methodName, args...)
@@ -15,6 +15,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/weaviate/weaviate/entities/autocut"
 
@@ -31,6 +32,7 @@ import (
 	"github.com/weaviate/weaviate/entities/search"
 	"github.com/weaviate/weaviate/entities/searchparams"
 	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/entities/vectorindex"
 	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 	"github.com/weaviate/weaviate/usecases/config"
 	"github.com/weaviate/weaviate/usecases/floatcomp"
@@ -77,6 +79,7 @@ type objectsSearcher interface {
 
 	// GraphQL Get{} queries
 	Search(ctx context.Context, params dto.GetParams) ([]search.Result, error)
+	SearchStream(ctx context.Context, params dto.GetParams, fn func(res search.Results) error) error
 	VectorSearch(ctx context.Context, params dto.GetParams) ([]search.Result, error)
 
 	// GraphQL Explore{} queries
@@ -93,7 +96,7 @@ type objectsSearcher interface {
 type hybridSearcher interface {
 	SparseObjectSearch(ctx context.Context, params dto.GetParams) ([]*storobj.Object, []float32, error)
 	DenseObjectSearch(context.Context, string, []float32, int, int,
-		*filters.LocalFilter, additional.Properties, string) ([]*storobj.Object, []float32, error)
+		*filters.LocalFilter, bool, additional.Properties, string) ([]*storobj.Object, []float32, error)
 	ResolveReferences(ctx context.Context, objs search.Results, props search.SelectProperties,
 		groupBy *searchparams.GroupBy, additional additional.Properties, tenant string) (search.Results, error)
 }
@@ -118,6 +121,18 @@ func (e *Explorer) SetSchemaGetter(sg uc.SchemaGetter) {
 // GetClass from search and connector repo
 func (e *Explorer) GetClass(ctx context.Context,
 	params dto.GetParams,
+) ([]interface{}, error) {
+	var res []interface{}
+	err := e.withQueryTimeout(ctx, params, func(ctx context.Context) error {
+		var err error
+		res, err = e.getClass(ctx, params)
+		return err
+	})
+	return res, err
+}
+
+func (e *Explorer) getClass(ctx context.Context,
+	params dto.GetParams,
 ) ([]interface{}, error) {
 	if params.Pagination == nil {
 		params.Pagination = &filters.Pagination{
@@ -134,10 +149,18 @@ func (e *Explorer) GetClass(ctx context.Context,
 		return nil, errors.Wrap(err, "invalid 'sort' parameter")
 	}
 
+	if err := e.validateSortBySearch(params); err != nil {
+		return nil, errors.Wrap(err, "invalid 'sort' parameter")
+	}
+
 	if err := e.validateCursor(params); err != nil {
 		return nil, errors.Wrap(err, "cursor api: invalid 'after' parameter")
 	}
 
+	if err := e.validateReferenceDepth(params); err != nil {
+		return nil, errors.Wrap(err, "invalid cross-reference selection")
+	}
+
 	if params.KeywordRanking != nil {
 		return e.getClassKeywordBased(ctx, params)
 	}
@@ -154,10 +177,22 @@ func (e *Explorer) getClassKeywordBased(ctx context.Context, params dto.GetParam
 		return nil, errors.Errorf("conflict: both near<Media> and keyword-based (bm25) arguments present, choose one")
 	}
 
-	if len(params.KeywordRanking.Query) == 0 {
+	if params.KeywordRanking.Type == "sparseVector" {
+		if params.KeywordRanking.SparseVector == nil {
+			return nil, errors.Errorf("sparse vector search must have a sparse vector set")
+		}
+		if err := params.KeywordRanking.SparseVector.Validate(); err != nil {
+			return nil, errors.Wrap(err, "invalid 'nearSparseVector' parameter")
+		}
+	} else if len(params.KeywordRanking.Query) == 0 {
 		return nil, errors.Errorf("keyword search (bm25) must have query set")
 	}
 
+	if fuzzy := params.KeywordRanking.Fuzzy; fuzzy < 0 || fuzzy > filters.MaxFuzzyDistance {
+		return nil, errors.Errorf("keyword search (bm25) fuzzy must be between 0 and %d, got %d",
+			filters.MaxFuzzyDistance, fuzzy)
+	}
+
 	if len(params.AdditionalProperties.ModuleParams) > 0 {
 		// if a module-specific additional prop is set, assume it needs the vector
 		// present for backward-compatibility. This could be improved by actually
@@ -184,11 +219,13 @@ func (e *Explorer) getClassKeywordBased(ctx context.Context, params dto.GetParam
 	}
 
 	if e.modulesProvider != nil {
+		beforeExtend := time.Now()
 		res, err = e.modulesProvider.GetExploreAdditionalExtend(ctx, res,
 			params.AdditionalProperties.ModuleParams, nil, params.ModuleParams)
 		if err != nil {
 			return nil, errors.Errorf("explorer: get class: extend: %v", err)
 		}
+		trackRerank(ctx, params, beforeExtend, len(res))
 	}
 
 	return e.searchResultsToGetResponse(ctx, res, nil, params)
@@ -203,6 +240,11 @@ func (e *Explorer) getClassVectorSearch(ctx context.Context,
 	}
 
 	params.SearchVector = searchVector
+	params.TargetVector = targetVectorFromParams(params)
+	params.Rescore = rescoreFromParams(params)
+	if params.NearVector != nil {
+		params.Exact = params.NearVector.Exact
+	}
 
 	if len(params.AdditionalProperties.ModuleParams) > 0 || params.Group != nil {
 		// if a module-specific additional prop is set, assume it needs the vector
@@ -236,11 +278,13 @@ func (e *Explorer) getClassVectorSearch(ctx context.Context,
 	}
 
 	if e.modulesProvider != nil {
+		beforeExtend := time.Now()
 		res, err = e.modulesProvider.GetExploreAdditionalExtend(ctx, res,
 			params.AdditionalProperties.ModuleParams, searchVector, params.ModuleParams)
 		if err != nil {
 			return nil, errors.Errorf("explorer: get class: extend: %v", err)
 		}
+		trackRerank(ctx, params, beforeExtend, len(res))
 	}
 
 	e.trackUsageGet(res, params)
@@ -284,10 +328,19 @@ func (e *Explorer) CalculateTotalLimit(pagination *filters.Pagination) (int, err
 
 func (e *Explorer) Hybrid(ctx context.Context, params dto.GetParams) ([]search.Result, error) {
 	sparseSearch := func() ([]*storobj.Object, []float32, error) {
-		params.KeywordRanking = &searchparams.KeywordRanking{
-			Query:      params.HybridSearch.Query,
-			Type:       "bm25",
-			Properties: params.HybridSearch.Properties,
+		if sparseVector := params.HybridSearch.SparseVector; sparseVector != nil {
+			params.KeywordRanking = &searchparams.KeywordRanking{
+				Type:         "sparseVector",
+				Properties:   []string{sparseVector.Property},
+				SparseVector: sparseVector,
+			}
+		} else {
+			params.KeywordRanking = &searchparams.KeywordRanking{
+				Query:      params.HybridSearch.GetKeywordQuery(),
+				Type:       "bm25",
+				Properties: params.HybridSearch.Properties,
+				Highlight:  params.HybridSearch.Highlight,
+			}
 		}
 
 		if params.Pagination == nil {
@@ -323,7 +376,7 @@ func (e *Explorer) Hybrid(ctx context.Context, params dto.GetParams) ([]search.R
 		}
 		res, dists, err := e.searcher.DenseObjectSearch(ctx,
 			params.ClassName, vec, 0, hybridSearchLimit, params.Filters,
-			params.AdditionalProperties, params.Tenant)
+			params.HybridSearch.Exact, params.AdditionalProperties, params.Tenant)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -430,11 +483,13 @@ func (e *Explorer) getClassList(ctx context.Context,
 	}
 
 	if e.modulesProvider != nil {
+		beforeExtend := time.Now()
 		res, err = e.modulesProvider.ListExploreAdditionalExtend(ctx, res,
 			params.AdditionalProperties.ModuleParams, params.ModuleParams)
 		if err != nil {
 			return nil, errors.Errorf("explorer: list class: extend: %v", err)
 		}
+		trackRerank(ctx, params, beforeExtend, len(res))
 	}
 
 	if userSetAdditionalVector {
@@ -683,6 +738,32 @@ func (e *Explorer) validateExploreParams(params ExploreParams) error {
 	return nil
 }
 
+// targetVectorFromParams returns the named vector the near params ask to
+// search, or "" for the class-level vector
+func targetVectorFromParams(params dto.GetParams) string {
+	if params.NearVector != nil {
+		return params.NearVector.TargetVector
+	}
+	if params.NearObject != nil {
+		return params.NearObject.TargetVector
+	}
+	return ""
+}
+
+// rescoreFromParams returns the per-query rescore overrides of the near
+// params, or nil if the settings of the vector index apply
+func rescoreFromParams(params dto.GetParams) *searchparams.Rescore {
+	if params.NearVector != nil {
+		return params.NearVector.GetRescore()
+	}
+	for _, param := range params.ModuleParams {
+		if rescoreParam, ok := param.(modulecapabilities.RescoreParam); ok {
+			return rescoreParam.GetRescore()
+		}
+	}
+	return nil
+}
+
 func (e *Explorer) vectorFromParams(ctx context.Context,
 	params dto.GetParams,
 ) ([]float32, error) {
@@ -748,12 +829,12 @@ func (e *Explorer) checkCertaintyCompatibility(className string) error {
 	if class == nil {
 		return errors.Errorf("failed to get class: %s", className)
 	}
-	hnswConfig, err := hnsw.TypeAssertVectorIndex(class)
+	vectorIndexConfig, err := vectorindex.TypeAssertVectorIndex(class)
 	if err != nil {
 		return err
 	}
-	if hnswConfig.Distance != hnsw.DistanceCosine {
-		return certaintyUnsupportedError(hnswConfig.Distance)
+	if vectorIndexConfig.DistanceName() != hnsw.DistanceCosine {
+		return certaintyUnsupportedError(vectorIndexConfig.DistanceName())
 	}
 
 	return nil
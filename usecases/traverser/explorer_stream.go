@@ -0,0 +1,115 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/inverted"
+	"github.com/weaviate/weaviate/entities/search"
+)
+
+// GetClassStream is the streaming counterpart of GetClass. Plain list queries
+// are searched shard by shard and fn is called with the results of every
+// shard as soon as they are available, so neither the time to the first
+// result nor the memory usage grows with the number of shards. Queries which
+// need all results before the first one is final, i.e. anything ranked,
+// sorted, grouped or paginated, are run through GetClass and fn is called
+// once with all results.
+func (e *Explorer) GetClassStream(ctx context.Context, params dto.GetParams,
+	fn func(res []interface{}) error,
+) error {
+	return e.withQueryTimeout(ctx, params, func(ctx context.Context) error {
+		return e.getClassStream(ctx, params, fn)
+	})
+}
+
+func (e *Explorer) getClassStream(ctx context.Context, params dto.GetParams,
+	fn func(res []interface{}) error,
+) error {
+	if !streamableShardByShard(params) {
+		res, err := e.getClass(ctx, params)
+		if err != nil {
+			return err
+		}
+		return fn(res)
+	}
+
+	if params.Pagination == nil {
+		params.Pagination = &filters.Pagination{
+			Offset: 0,
+			Limit:  100,
+		}
+	}
+
+	if err := e.validateFilters(params.Filters); err != nil {
+		return errors.Wrap(err, "invalid 'where' filter")
+	}
+
+	if err := e.validateReferenceDepth(params); err != nil {
+		return errors.Wrap(err, "invalid cross-reference selection")
+	}
+
+	err := e.searcher.SearchStream(ctx, params, func(res search.Results) error {
+		var err error
+		if e.modulesProvider != nil {
+			res, err = e.modulesProvider.ListExploreAdditionalExtend(ctx, res,
+				params.AdditionalProperties.ModuleParams, params.ModuleParams)
+			if err != nil {
+				return errors.Errorf("explorer: list class: extend: %v", err)
+			}
+		}
+
+		if params.AdditionalProperties.Vector {
+			e.trackUsageGetExplicitVector(res, params)
+		}
+
+		out, err := e.searchResultsToGetResponse(ctx, res, nil, params)
+		if err != nil {
+			return err
+		}
+		return fn(out)
+	})
+	if err != nil {
+		var e inverted.MissingIndexError
+		if errors.As(err, &e) {
+			return e
+		}
+		return errors.Errorf("explorer: list class: search stream: %v", err)
+	}
+
+	return nil
+}
+
+// streamableShardByShard is true for queries whose results don't depend on
+// the results of other shards, so they can be returned as soon as a single
+// shard is done.
+func streamableShardByShard(params dto.GetParams) bool {
+	if params.NearVector != nil || params.NearObject != nil || len(params.ModuleParams) > 0 ||
+		params.KeywordRanking != nil || params.HybridSearch != nil {
+		return false
+	}
+
+	if len(params.Sort) > 0 || params.Cursor != nil || params.Group != nil || params.GroupBy != nil {
+		return false
+	}
+
+	if params.Pagination != nil && (params.Pagination.Offset > 0 || params.Pagination.Autocut > 0 ||
+		params.Pagination.Limit < 0) {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,111 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+)
+
+func Test_Explorer_GetClassStream(t *testing.T) {
+	newExplorer := func() (*Explorer, *fakeVectorSearcher) {
+		searcher := &fakeVectorSearcher{}
+		log, _ := test.NewNullLogger()
+		explorer := NewExplorer(searcher, log, getFakeModulesProvider(), &fakeMetrics{}, defaultConfig)
+		explorer.SetSchemaGetter(&fakeSchemaGetter{
+			schema: schema.Schema{Objects: &models.Schema{Classes: []*models.Class{
+				{Class: "BestClass", Properties: []*models.Property{
+					{Name: "name", DataType: schema.DataTypeText.PropString()},
+				}},
+			}}},
+		})
+		return explorer, searcher
+	}
+
+	resultWithName := func(name string) search.Result {
+		return search.Result{ID: strfmt.UUID("id-" + name), Schema: map[string]interface{}{"name": name}}
+	}
+
+	t.Run("list query is streamed shard by shard", func(t *testing.T) {
+		explorer, searcher := newExplorer()
+		params := dto.GetParams{
+			ClassName:  "BestClass",
+			Pagination: &filters.Pagination{Limit: 100},
+		}
+		searcher.On("SearchStream", params).Return([]search.Results{
+			{resultWithName("Foo"), resultWithName("Bar")},
+			{resultWithName("Baz")},
+		}, nil)
+
+		var batches [][]interface{}
+		err := explorer.GetClassStream(context.Background(), params, func(res []interface{}) error {
+			batches = append(batches, res)
+			return nil
+		})
+		require.Nil(t, err)
+		searcher.AssertExpectations(t)
+		assert.Equal(t, [][]interface{}{
+			{map[string]interface{}{"name": "Foo"}, map[string]interface{}{"name": "Bar"}},
+			{map[string]interface{}{"name": "Baz"}},
+		}, batches)
+	})
+
+	t.Run("sorted query is returned at once", func(t *testing.T) {
+		explorer, searcher := newExplorer()
+		params := dto.GetParams{
+			ClassName:  "BestClass",
+			Pagination: &filters.Pagination{Limit: 100},
+			Sort:       []filters.Sort{{Path: []string{"name"}, Order: "asc"}},
+		}
+		searcher.On("Search", params).Return([]search.Result{
+			resultWithName("Bar"), resultWithName("Baz"), resultWithName("Foo"),
+		}, nil)
+
+		var batches [][]interface{}
+		err := explorer.GetClassStream(context.Background(), params, func(res []interface{}) error {
+			batches = append(batches, res)
+			return nil
+		})
+		require.Nil(t, err)
+		searcher.AssertExpectations(t)
+		require.Len(t, batches, 1)
+		assert.Len(t, batches[0], 3)
+	})
+
+	t.Run("query with an offset is returned at once", func(t *testing.T) {
+		explorer, searcher := newExplorer()
+		params := dto.GetParams{
+			ClassName:  "BestClass",
+			Pagination: &filters.Pagination{Offset: 1, Limit: 100},
+		}
+		searcher.On("Search", params).Return([]search.Result{resultWithName("Foo")}, nil)
+
+		calls := 0
+		err := explorer.GetClassStream(context.Background(), params, func(res []interface{}) error {
+			calls++
+			return nil
+		})
+		require.Nil(t, err)
+		searcher.AssertExpectations(t)
+		assert.Equal(t, 1, calls)
+	})
+}
@@ -0,0 +1,57 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/weaviate/weaviate/entities/dto"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+)
+
+// queryTimeout is the timeout of the query, the timeout of the request takes
+// precedence over the default of the server. Zero means no timeout.
+func (e *Explorer) queryTimeout(params dto.GetParams) time.Duration {
+	if params.Timeout > 0 {
+		return params.Timeout
+	}
+	return e.config.QueryTimeout
+}
+
+// withQueryTimeout runs the query with a deadline. The deadline is checked
+// by the shards, e.g. while the vector index is traversed or the filters are
+// merged, so a query is aborted instead of completing in the background. A
+// query which runs into its timeout has no partial results, it fails with an
+// ErrQueryTimeout.
+func (e *Explorer) withQueryTimeout(ctx context.Context, params dto.GetParams,
+	query func(ctx context.Context) error,
+) error {
+	timeout := e.queryTimeout(params)
+	if timeout <= 0 {
+		return query(ctx)
+	}
+
+	queryCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	err := query(queryCtx)
+	// the errors of the searcher are not always wrapped, the deadline of the
+	// context tells reliably whether the query failed because of the timeout.
+	// A caller which gave up earlier is not a timeout of the query.
+	if err != nil && ctx.Err() == nil &&
+		errors.Is(queryCtx.Err(), context.DeadlineExceeded) {
+		return enterrors.NewErrQueryTimeout(timeout)
+	}
+	return err
+}
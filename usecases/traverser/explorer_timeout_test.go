@@ -0,0 +1,96 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/dto"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+func Test_Explorer_GetClass_Timeout(t *testing.T) {
+	newExplorer := func(timeout time.Duration) (*Explorer, *fakeVectorSearcher) {
+		searcher := &fakeVectorSearcher{}
+		log, _ := test.NewNullLogger()
+		cfg := config.Config{QueryMaximumResults: 100, QueryTimeout: timeout}
+		explorer := NewExplorer(searcher, log, getFakeModulesProvider(), &fakeMetrics{}, cfg)
+		explorer.SetSchemaGetter(&fakeSchemaGetter{
+			schema: schema.Schema{Objects: &models.Schema{Classes: []*models.Class{
+				{Class: "Car"},
+			}}},
+		})
+		return explorer, searcher
+	}
+
+	newParams := func(timeout time.Duration) dto.GetParams {
+		return dto.GetParams{
+			ClassName:  "Car",
+			Pagination: &filters.Pagination{Limit: 100},
+			Timeout:    timeout,
+		}
+	}
+
+	t.Run("default timeout exceeded", func(t *testing.T) {
+		explorer, searcher := newExplorer(10 * time.Millisecond)
+		params := newParams(0)
+		searcher.On("Search", params).After(50*time.Millisecond).
+			Return([]search.Result{}, context.DeadlineExceeded)
+
+		_, err := explorer.GetClass(context.Background(), params)
+		var timeoutErr enterrors.ErrQueryTimeout
+		require.True(t, errors.As(err, &timeoutErr))
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Contains(t, err.Error(), "query timed out after 10ms")
+	})
+
+	t.Run("request timeout overrides default", func(t *testing.T) {
+		explorer, searcher := newExplorer(time.Hour)
+		params := newParams(10 * time.Millisecond)
+		searcher.On("Search", params).After(50*time.Millisecond).
+			Return([]search.Result{}, context.DeadlineExceeded)
+
+		_, err := explorer.GetClass(context.Background(), params)
+		assert.Contains(t, err.Error(), "query timed out after 10ms")
+	})
+
+	t.Run("within the timeout", func(t *testing.T) {
+		explorer, searcher := newExplorer(time.Hour)
+		params := newParams(0)
+		searcher.On("Search", params).Return([]search.Result{}, nil)
+
+		_, err := explorer.GetClass(context.Background(), params)
+		require.Nil(t, err)
+	})
+
+	t.Run("other errors are not a timeout", func(t *testing.T) {
+		explorer, searcher := newExplorer(time.Hour)
+		params := newParams(0)
+		searcher.On("Search", params).Return([]search.Result{}, errors.New("boom"))
+
+		_, err := explorer.GetClass(context.Background(), params)
+		require.NotNil(t, err)
+		var timeoutErr enterrors.ErrQueryTimeout
+		assert.False(t, errors.As(err, &timeoutErr))
+	})
+}
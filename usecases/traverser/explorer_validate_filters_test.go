@@ -65,6 +65,25 @@ func Test_Explorer_GetClass_WithFilters(t *testing.T) {
 		return out
 	}
 
+	// phone numbers are filtered by their international or national format,
+	// so every value type but text is invalid
+	buildInvalidPhoneNumberTests := func(op filters.Operator, path []interface{},
+		dts []schema.DataType, value interface{},
+	) []test {
+		out := make([]test, len(dts))
+		for i, dt := range dts {
+			out[i] = test{
+				name:    fmt.Sprintf("invalid phoneNumber filter - using %s", dt),
+				filters: buildFilter(op, path, dt, value),
+				expectedError: errors.Errorf("invalid 'where' filter: property %q is of type "+
+					"\"phoneNumber\": specify the international or national format as string "+
+					"using \"valueText\"", path[0]),
+			}
+		}
+
+		return out
+	}
+
 	buildInvalidRefCountTests := func(op filters.Operator, path []interface{},
 		correctDt schema.DataType, dts []schema.DataType, value interface{},
 	) []test {
@@ -240,12 +259,12 @@ func Test_Explorer_GetClass_WithFilters(t *testing.T) {
 			{
 				name: "valid phoneNumber search",
 				filters: buildFilter(filters.OperatorEqual, []interface{}{"phone_prop"},
-					schema.DataTypePhoneNumber, "foo"),
+					schema.DataTypeText, "+31 1234567"),
 				expectedError: nil,
 			},
 		},
-		buildInvalidTests(filters.OperatorEqual, []interface{}{"phone_prop"},
-			schema.DataTypePhoneNumber, allValueTypesExcept(schema.DataTypePhoneNumber), "foo"),
+		buildInvalidPhoneNumberTests(filters.OperatorEqual, []interface{}{"phone_prop"},
+			allValueTypesExcept(schema.DataTypeText, schema.DataTypeString), "foo"),
 
 		// nested filters
 		{
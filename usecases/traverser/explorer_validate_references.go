@@ -0,0 +1,34 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/dto"
+)
+
+// validateReferenceDepth rejects selections which resolve cross-references
+// deeper than configured with QUERY_CROSS_REFERENCE_DEPTH_LIMIT. Queries which
+// skip reference resolution are not limited, as they never join.
+func (e *Explorer) validateReferenceDepth(params dto.GetParams) error {
+	limit := e.config.QueryCrossReferenceDepthLimit
+	if limit <= 0 || params.AdditionalProperties.NoRefResolution {
+		return nil
+	}
+
+	if depth := params.Properties.RefDepth(); int64(depth) > limit {
+		return fmt.Errorf("selection resolves cross-references %d levels deep, "+
+			"but the limit is %d", depth, limit)
+	}
+	return nil
+}
@@ -0,0 +1,105 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+func Test_Explorer_GetClass_ReferenceDepth(t *testing.T) {
+	// Car -> ofManufacturer -> Manufacturer -> inCountry -> Country
+	properties := search.SelectProperties{
+		{Name: "name", IsPrimitive: true},
+		{
+			Name: "ofManufacturer",
+			Refs: []search.SelectClass{{
+				ClassName: "Manufacturer",
+				RefProperties: search.SelectProperties{
+					{
+						Name: "inCountry",
+						Refs: []search.SelectClass{{
+							ClassName:     "Country",
+							RefProperties: search.SelectProperties{{Name: "name", IsPrimitive: true}},
+						}},
+					},
+				},
+			}},
+		},
+	}
+	require.Equal(t, 2, properties.RefDepth())
+
+	newExplorer := func(limit int64) (*Explorer, *fakeVectorSearcher) {
+		searcher := &fakeVectorSearcher{}
+		log, _ := test.NewNullLogger()
+		cfg := config.Config{QueryMaximumResults: 100, QueryCrossReferenceDepthLimit: limit}
+		explorer := NewExplorer(searcher, log, getFakeModulesProvider(), &fakeMetrics{}, cfg)
+		explorer.SetSchemaGetter(&fakeSchemaGetter{
+			schema: schema.Schema{Objects: &models.Schema{Classes: []*models.Class{
+				{Class: "Car"},
+			}}},
+		})
+		return explorer, searcher
+	}
+
+	t.Run("within the limit", func(t *testing.T) {
+		explorer, searcher := newExplorer(2)
+		params := dto.GetParams{
+			ClassName:  "Car",
+			Pagination: &filters.Pagination{Limit: 100},
+			Properties: properties,
+		}
+		searcher.On("Search", params).Return([]search.Result{}, nil)
+
+		_, err := explorer.GetClass(context.Background(), params)
+		require.Nil(t, err)
+	})
+
+	t.Run("exceeding the limit", func(t *testing.T) {
+		explorer, _ := newExplorer(1)
+		params := dto.GetParams{
+			ClassName:  "Car",
+			Pagination: &filters.Pagination{Limit: 100},
+			Properties: properties,
+		}
+
+		_, err := explorer.GetClass(context.Background(), params)
+		require.NotNil(t, err)
+		assert.Equal(t, "invalid cross-reference selection: selection resolves "+
+			"cross-references 2 levels deep, but the limit is 1", err.Error())
+	})
+
+	t.Run("exceeding the limit without resolving references", func(t *testing.T) {
+		explorer, searcher := newExplorer(1)
+		params := dto.GetParams{
+			ClassName:            "Car",
+			Pagination:           &filters.Pagination{Limit: 100},
+			Properties:           properties,
+			AdditionalProperties: additional.Properties{NoRefResolution: true},
+		}
+		searcher.On("Search", params).Return([]search.Result{}, nil)
+
+		_, err := explorer.GetClass(context.Background(), params)
+		require.Nil(t, err)
+	})
+}
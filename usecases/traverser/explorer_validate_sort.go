@@ -12,6 +12,9 @@
 package traverser
 
 import (
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/dto"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/schema"
 )
@@ -23,3 +26,24 @@ func (e *Explorer) validateSort(className string, sort []filters.Sort) error {
 	sch := e.schemaGetter.GetSchemaSkipAuth()
 	return filters.ValidateSort(sch, schema.ClassName(className), sort)
 }
+
+// validateSortBySearch makes sure the results are only sorted by the distance
+// of vector searches and the score of keyword searches
+func (e *Explorer) validateSortBySearch(params dto.GetParams) error {
+	vectorSearch := params.KeywordRanking == nil && params.HybridSearch == nil &&
+		(params.NearVector != nil || params.NearObject != nil || len(params.ModuleParams) > 0)
+
+	for _, srt := range params.Sort {
+		switch srt.AdditionalProp() {
+		case filters.SortAdditionalDistance:
+			if !vectorSearch {
+				return fmt.Errorf("sorting by _additional.distance requires a near<Media> search")
+			}
+		case filters.SortAdditionalScore:
+			if params.KeywordRanking == nil {
+				return fmt.Errorf("sorting by _additional.score requires a bm25 search")
+			}
+		}
+	}
+	return nil
+}
@@ -91,6 +91,24 @@ func Test_Explorer_GetClass_WithSort(t *testing.T) {
 				"sorting by reference not supported, " +
 				"path must have exactly one argument"),
 		},
+		{
+			name: "distance without vector search",
+			params: dto.GetParams{
+				ClassName: "ClassOne",
+				Sort:      []filters.Sort{{Path: []string{"_additional", "distance"}, Order: "asc"}},
+			},
+			expectedError: errors.New("invalid 'sort' parameter: " +
+				"sorting by _additional.distance requires a near<Media> search"),
+		},
+		{
+			name: "score without bm25 search",
+			params: dto.GetParams{
+				ClassName: "ClassOne",
+				Sort:      []filters.Sort{{Path: []string{"_additional", "score"}, Order: "asc"}},
+			},
+			expectedError: errors.New("invalid 'sort' parameter: " +
+				"sorting by _additional.score requires a bm25 search"),
+		},
 		{
 			name: "invalid order parameter",
 			params: dto.GetParams{
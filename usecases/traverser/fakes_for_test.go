@@ -117,6 +117,18 @@ func (f *fakeVectorSearcher) Search(ctx context.Context,
 	return args.Get(0).([]search.Result), args.Error(1)
 }
 
+func (f *fakeVectorSearcher) SearchStream(ctx context.Context,
+	params dto.GetParams, fn func(res search.Results) error,
+) error {
+	args := f.Called(params)
+	for _, res := range args.Get(0).([]search.Results) {
+		if err := fn(res); err != nil {
+			return err
+		}
+	}
+	return args.Error(1)
+}
+
 func (f *fakeVectorSearcher) Object(ctx context.Context,
 	className string, id strfmt.UUID, props search.SelectProperties,
 	additional additional.Properties, repl *additional.ReplicationProperties,
@@ -140,7 +152,7 @@ func (f *fakeVectorSearcher) SparseObjectSearch(ctx context.Context,
 }
 
 func (f *fakeVectorSearcher) DenseObjectSearch(context.Context, string,
-	[]float32, int, int, *filters.LocalFilter, additional.Properties, string,
+	[]float32, int, int, *filters.LocalFilter, bool, additional.Properties, string,
 ) ([]*storobj.Object, []float32, error) {
 	return nil, nil, nil
 }
@@ -197,6 +209,12 @@ func (f *fakeExplorer) GetClass(ctx context.Context, p dto.GetParams) ([]interfa
 	return nil, nil
 }
 
+func (f *fakeExplorer) GetClassStream(ctx context.Context, p dto.GetParams,
+	fn func(res []interface{}) error,
+) error {
+	return nil
+}
+
 func (f *fakeExplorer) CrossClassVectorSearch(ctx context.Context, p ExploreParams) ([]search.Result, error) {
 	return nil, nil
 }
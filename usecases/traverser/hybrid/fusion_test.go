@@ -19,6 +19,7 @@ import (
 
 	"github.com/go-openapi/strfmt"
 	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/search"
 )
 
@@ -66,6 +67,69 @@ func TestFusionRelativeScore(t *testing.T) {
 	}
 }
 
+func TestFusionZScore(t *testing.T) {
+	cases := []struct {
+		name           string
+		weights        []float64
+		inputScores    [][]float32
+		expectedScores []float32
+		expectedOrder  []uint64
+	}{
+		{name: "single set", weights: []float64{1}, inputScores: [][]float32{{1, 2, 3}}, expectedScores: []float32{1.2247, 0, -1.2247}, expectedOrder: []uint64{2, 1, 0}},
+		{name: "two sets", weights: []float64{0.5, 0.5}, inputScores: [][]float32{{1, 2, 3}, {0, 1, 5}}, expectedScores: []float32{1.3067, -0.2315, -1.0753}, expectedOrder: []uint64{2, 1, 0}},
+		{name: "identical scores", weights: []float64{0.75, 0.25}, inputScores: [][]float32{{1, 1}, {}}, expectedScores: []float32{0, 0}},
+		{name: "empty", weights: []float64{0.75, 0.25}, inputScores: [][]float32{{}, {}}, expectedScores: []float32{}, expectedOrder: []uint64{}},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			var results [][]*Result
+			for i := range tt.inputScores {
+				var result []*Result
+				for j, score := range tt.inputScores[i] {
+					result = append(result, &Result{uint64(j), &search.Result{SecondarySortValue: score, ID: strfmt.UUID(fmt.Sprint(j))}})
+				}
+				results = append(results, result)
+			}
+			fused := FusionZScore(tt.weights, results)
+			fusedScores := []float32{}
+			fusedOrder := []uint64{}
+
+			for _, score := range fused {
+				fusedScores = append(fusedScores, score.Score)
+				fusedOrder = append(fusedOrder, score.DocID)
+			}
+
+			assert.InDeltaSlice(t, tt.expectedScores, fusedScores, 0.0001)
+			if tt.expectedOrder != nil {
+				assert.Equal(t, tt.expectedOrder, fusedOrder)
+			}
+		})
+	}
+}
+
+func TestFusionRankedWithConstant(t *testing.T) {
+	results := func() [][]*Result {
+		return [][]*Result{{
+			{uint64(0), &search.Result{ID: strfmt.UUID(fmt.Sprint(0))}},
+			{uint64(1), &search.Result{ID: strfmt.UUID(fmt.Sprint(1))}},
+		}}
+	}
+
+	t.Run("default constant", func(t *testing.T) {
+		fused := FusionRanked([]float64{1}, results())
+		require.Len(t, fused, 2)
+		assert.InDelta(t, 1.0/61, fused[0].Score, 0.0001)
+		assert.InDelta(t, 1.0/62, fused[1].Score, 0.0001)
+	})
+
+	t.Run("custom constant", func(t *testing.T) {
+		fused := FusionRankedWithConstant([]float64{1}, 1, results())
+		require.Len(t, fused, 2)
+		assert.InDelta(t, 0.5, fused[0].Score, 0.0001)
+		assert.InDelta(t, 1.0/3, fused[1].Score, 0.0001)
+	})
+}
+
 func TestFusionRelativeScoreExplain(t *testing.T) {
 	result1 := []*Result{
 		{uint64(1), &search.Result{SecondarySortValue: 0.5, ID: strfmt.UUID(fmt.Sprint(1)), ExplainScore: "keyword"}},
@@ -80,3 +144,34 @@ func TestFusionRelativeScoreExplain(t *testing.T) {
 	require.Contains(t, fused[0].ExplainScore, "keyword: original score 0.5, normalized score: 0.5")
 	require.Contains(t, fused[0].ExplainScore, "vector: original score 2, normalized score: 0.5 - keyword: original score 0.5, normalized score: 0.5")
 }
+
+func TestFusionKeepsAdditional(t *testing.T) {
+	highlights := []*additional.Highlight{{Property: "title", Fragments: []string{"<em>fox</em>"}}}
+	results := func() [][]*Result {
+		return [][]*Result{
+			{{uint64(1), &search.Result{
+				SecondarySortValue: 0.5, ID: strfmt.UUID(fmt.Sprint(1)),
+				AdditionalProperties: map[string]interface{}{"highlights": highlights, "keywordScore": float32(0.5)},
+			}}},
+			{{uint64(1), &search.Result{
+				SecondarySortValue: 2, ID: strfmt.UUID(fmt.Sprint(1)),
+				AdditionalProperties: map[string]interface{}{"vectorScore": float32(2)},
+			}}},
+		}
+	}
+
+	fusions := map[string]func([]float64, [][]*Result) []*Result{
+		"relative score": FusionRelativeScore,
+		"ranked":         FusionRanked,
+		"z-score":        FusionZScore,
+	}
+	for name, fusion := range fusions {
+		t.Run(name, func(t *testing.T) {
+			fused := fusion([]float64{0.5, 0.5}, results())
+			require.Len(t, fused, 1)
+			assert.Equal(t, highlights, fused[0].AdditionalProperties["highlights"])
+			assert.Equal(t, float32(0.5), fused[0].AdditionalProperties["keywordScore"])
+			assert.Equal(t, float32(2), fused[0].AdditionalProperties["vectorScore"])
+		})
+	}
+}
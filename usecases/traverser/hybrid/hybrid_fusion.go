@@ -13,18 +13,31 @@ package hybrid
 
 import (
 	"fmt"
+	"math"
 	"sort"
 
 	"github.com/go-openapi/strfmt"
 )
 
+// DefaultRankConstant is the constant k of reciprocal rank fusion which
+// dampens the influence of the top ranked results
+const DefaultRankConstant = 60
+
+// FusionRanked combines the results using reciprocal rank fusion with the
+// default rank constant
 func FusionRanked(weights []float64, results [][]*Result) []*Result {
+	return FusionRankedWithConstant(weights, DefaultRankConstant, results)
+}
+
+// FusionRankedWithConstant combines the results using reciprocal rank fusion,
+// every result contributes weight / (rank + k + 1) to the score of an object.
+func FusionRankedWithConstant(weights []float64, k int, results [][]*Result) []*Result {
 	mapResults := map[strfmt.UUID]*Result{}
 	for resultSetIndex, result := range results {
 		for i, res := range result {
 			tempResult := res
 			docId := tempResult.ID
-			score := weights[resultSetIndex] / float64(i+60+1) // TODO replace 60 with a class configured variable
+			score := weights[resultSetIndex] / float64(i+k+1)
 
 			if tempResult.AdditionalProperties == nil {
 				tempResult.AdditionalProperties = map[string]interface{}{}
@@ -37,6 +50,7 @@ func FusionRanked(weights []float64, results [][]*Result) []*Result {
 					"%v\n(hybrid) Document %v contributed %v to the score",
 					previousResult.AdditionalProperties["explainScore"], tempResult.ID, score)
 				score += float64(previousResult.Score)
+				keepAdditional(tempResult, previousResult)
 			} else {
 				tempResult.AdditionalProperties["explainScore"] = fmt.Sprintf(
 					"%v\n(hybrid) Document %v contributed %v to the score",
@@ -129,6 +143,66 @@ func FusionRelativeScore(weights []float64, results [][]*Result) []*Result {
 			if ok {
 				score += previousResult.Score
 				explainScore += " - " + previousResult.ExplainScore
+				keepAdditional(res, previousResult)
+			}
+			res.Score = score
+			res.ExplainScore = explainScore
+
+			mapResults[res.ID] = res
+		}
+	}
+
+	concat := make([]*Result, 0, len(mapResults))
+	for _, res := range mapResults {
+		concat = append(concat, res)
+	}
+
+	sort.Slice(concat, func(i, j int) bool {
+		a_b := float64(concat[j].Score - concat[i].Score)
+		if a_b*a_b < 1e-14 {
+			return concat[i].SecondarySortValue > concat[j].SecondarySortValue
+		}
+		return float64(concat[i].Score) > float64(concat[j].Score)
+	})
+	return concat
+}
+
+// FusionZScore standardizes the scores of each result set to their z-score,
+// e.g. the number of standard deviations they lie above or below the mean
+// score of the set, and sums up the weighted z-scores of every object.
+// Contrary to relative score fusion a single outlier does not compress the
+// remaining scores of its set.
+//
+// If all scores of a set are identical they all have a z-score of 0. An object
+// missing from a result set does not contribute to the score from that set,
+// which is the same as scoring the mean of that set.
+func FusionZScore(weights []float64, results [][]*Result) []*Result {
+	numResults := 0
+	for i := range results {
+		if len(results[i]) > numResults {
+			numResults = len(results[i])
+		}
+	}
+	if numResults == 0 {
+		return []*Result{}
+	}
+
+	mapResults := make(map[strfmt.UUID]*Result, numResults)
+	for i := range results {
+		mean, stdDev := meanAndStdDev(results[i])
+		for _, res := range results[i] {
+			var zScore float64
+			if stdDev > 0 {
+				zScore = (float64(res.SecondarySortValue) - mean) / stdDev
+			}
+			score := float32(weights[i] * zScore)
+
+			previousResult, ok := mapResults[res.ID]
+			explainScore := res.ExplainScore + fmt.Sprintf(": original score %v, z-score: %v", res.SecondarySortValue, zScore)
+			if ok {
+				score += previousResult.Score
+				explainScore += " - " + previousResult.ExplainScore
+				keepAdditional(res, previousResult)
 			}
 			res.Score = score
 			res.ExplainScore = explainScore
@@ -151,3 +225,44 @@ func FusionRelativeScore(weights []float64, results [][]*Result) []*Result {
 	})
 	return concat
 }
+
+func meanAndStdDev(results []*Result) (float64, float64) {
+	if len(results) == 0 {
+		return 0, 0
+	}
+
+	var sum float64
+	for _, res := range results {
+		sum += float64(res.SecondarySortValue)
+	}
+	mean := sum / float64(len(results))
+
+	var variance float64
+	for _, res := range results {
+		diff := float64(res.SecondarySortValue) - mean
+		variance += diff * diff
+	}
+	return mean, math.Sqrt(variance / float64(len(results)))
+}
+
+// keptAdditional are the additional properties which only one of the searches
+// of a hybrid search sets and which need to survive the fusion
+var keptAdditional = []string{"highlights", "keywordScore", "vectorScore"}
+
+// keepAdditional carries the additional properties set by one search, e.g. the
+// highlights and raw score of the keyword search, over to the result of the
+// other search an object was found by as well
+func keepAdditional(res, previousResult *Result) {
+	for _, name := range keptAdditional {
+		value, ok := previousResult.AdditionalProperties[name]
+		if !ok {
+			continue
+		}
+		if res.AdditionalProperties == nil {
+			res.AdditionalProperties = map[string]interface{}{}
+		}
+		if _, ok := res.AdditionalProperties[name]; !ok {
+			res.AdditionalProperties[name] = value
+		}
+	}
+}
@@ -14,6 +14,7 @@ package hybrid
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/weaviate/weaviate/adapters/handlers/graphql/local/common_filters"
 
@@ -21,6 +22,7 @@ import (
 
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/explain"
 	"github.com/weaviate/weaviate/entities/search"
 	"github.com/weaviate/weaviate/entities/searchparams"
 	"github.com/weaviate/weaviate/entities/storobj"
@@ -78,13 +80,17 @@ type modulesProvider interface {
 }
 
 // Search executes sparse and dense searches and combines the result sets using Reciprocal Rank Fusion
+//
+// The query of the dense search is vectorized here, exactly once per query,
+// unless a vector was passed along. Sub searches are vectorized one by one.
 func Search(ctx context.Context, params *Params, logger logrus.FieldLogger, sparseSearch sparseSearchFunc, denseSearch denseSearchFunc, postProc postProcFunc, modules modulesProvider) (Results, error) {
 	var (
 		found   [][]*Result
 		weights []float64
 	)
 
-	if params.Query != "" {
+	if params.GetKeywordQuery() != "" || params.GetVectorQuery() != "" || len(params.Vector) > 0 ||
+		params.SparseVector != nil {
 		alpha := params.Alpha
 
 		if alpha < 1 {
@@ -107,15 +113,10 @@ func Search(ctx context.Context, params *Params, logger logrus.FieldLogger, spar
 			weights = append(weights, alpha)
 		}
 	} else {
-		ss := params.SubSearches
-
-		// To catch error if ss is empty
-		_, err := decideSearchVector(ctx, params, modules)
-		if err != nil {
-			return nil, err
-		}
-
-		for _, subsearch := range ss.([]searchparams.WeightedSearchResult) {
+		// every sub search is vectorized on its own, there is no query to
+		// vectorize up front
+		ss, _ := params.SubSearches.([]searchparams.WeightedSearchResult)
+		for _, subsearch := range ss {
 			res, weight, err := handleSubSearch(ctx, &subsearch, denseSearch, sparseSearch, params, modules)
 			if err != nil {
 				return nil, err
@@ -133,14 +134,23 @@ func Search(ctx context.Context, params *Params, logger logrus.FieldLogger, spar
 		return nil, fmt.Errorf("length of weights and results do not match for hybrid search %v vs. %v", len(weights), len(found))
 	}
 
+	beforeFusion := time.Now()
 	var fused []*Result
-	if params.FusionAlgorithm == common_filters.HybridRankedFusion {
-		fused = FusionRanked(weights, found)
-	} else if params.FusionAlgorithm == common_filters.HybridRelativeScoreFusion {
+	switch params.FusionAlgorithm {
+	case common_filters.HybridRankedFusion:
+		rankConstant := params.RankConstant
+		if rankConstant == 0 {
+			rankConstant = DefaultRankConstant
+		}
+		fused = FusionRankedWithConstant(weights, rankConstant, found)
+	case common_filters.HybridRelativeScoreFusion:
 		fused = FusionRelativeScore(weights, found)
-	} else {
+	case common_filters.HybridZScoreFusion:
+		fused = FusionZScore(weights, found)
+	default:
 		return nil, fmt.Errorf("unknown ranking algorithm %v for hybrid search", params.FusionAlgorithm)
 	}
+	explain.FromContext(ctx).Track(explain.StageFusion, beforeFusion, len(fused))
 
 	if postProc != nil {
 		sr, err := postProc(fused)
@@ -173,6 +183,7 @@ func processSparseSearch(results []*storobj.Object, weights []float32, err error
 		sr := obj.SearchResultWithDist(additional.Properties{}, weights[i])
 		sr.SecondarySortValue = sr.Score
 		sr.ExplainScore = "(bm25)" + sr.ExplainScore
+		setRawScore(&sr, "keywordScore", sr.Score)
 		out[i] = &Result{obj.DocID(), &sr}
 	}
 	return out, nil
@@ -196,11 +207,21 @@ func processDenseSearch(ctx context.Context, denseSearch denseSearchFunc, params
 		sr.ExplainScore = fmt.Sprintf(
 			"(vector) %v %v ", truncateVectorString(10, vector),
 			res[i].ExplainScore())
+		setRawScore(&sr, "vectorScore", sr.SecondarySortValue)
 		out[i] = &Result{obj.DocID(), &sr}
 	}
 	return out, nil
 }
 
+// setRawScore keeps the score of a single search before fusion in the
+// additional properties, so the contribution of each search can be inspected
+func setRawScore(sr *search.Result, name string, score float32) {
+	if sr.AdditionalProperties == nil {
+		sr.AdditionalProperties = map[string]interface{}{}
+	}
+	sr.AdditionalProperties[name] = score
+}
+
 func handleSubSearch(ctx context.Context, subsearch *searchparams.WeightedSearchResult, denseSearch denseSearchFunc, sparseSearch sparseSearchFunc, params *Params, modules modulesProvider) ([]*Result, float64, error) {
 	switch subsearch.Type {
 	case "bm25":
@@ -229,6 +250,7 @@ func sparseSubSearch(subsearch *searchparams.WeightedSearchResult, params *Param
 	for i, obj := range res {
 		sr := obj.SearchResultWithDist(additional.Properties{}, dists[i])
 		sr.ExplainScore = "(bm25)" + sr.ExplainScore
+		setRawScore(&sr, "keywordScore", sr.Score)
 		out[i] = &Result{obj.DocID(), &sr}
 	}
 
@@ -256,6 +278,7 @@ func nearTextSubSearch(ctx context.Context, subsearch *searchparams.WeightedSear
 		sr := obj.SearchResultWithDist(additional.Properties{}, dists[i])
 		sr.ExplainScore = fmt.Sprintf("(vector) %v %v ",
 			truncateVectorString(10, vector), res[i].ExplainScore())
+		setRawScore(&sr, "vectorScore", 1-sr.Dist)
 		out[i] = &Result{obj.DocID(), &sr}
 	}
 
@@ -275,6 +298,7 @@ func nearVectorSubSearch(subsearch *searchparams.WeightedSearchResult, denseSear
 		sr := obj.SearchResultWithDist(additional.Properties{}, dists[i])
 		sr.ExplainScore = fmt.Sprintf("(vector) %v %v ",
 			truncateVectorString(10, sp.Vector), res[i].ExplainScore())
+		setRawScore(&sr, "vectorScore", 1-sr.Dist)
 		out[i] = &Result{obj.DocID(), &sr}
 	}
 
@@ -291,7 +315,7 @@ func decideSearchVector(ctx context.Context, params *Params, modules modulesProv
 		vector = params.Vector
 	} else {
 		if modules != nil {
-			vector, err = vectorFromModuleInput(ctx, params.Class, params.Query, modules)
+			vector, err = vectorFromModuleInput(ctx, params.Class, params.GetVectorQuery(), modules)
 			if err != nil {
 				return nil, err
 			}
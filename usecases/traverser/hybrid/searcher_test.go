@@ -51,6 +51,62 @@ func TestSearcher(t *testing.T) {
 
 				_, err := Search(ctx, params, logger, sparse, dense, nil, provider)
 				require.Nil(t, err)
+				provider.AssertNumberOfCalls(t, "VectorFromInput", 1)
+			},
+		},
+		{
+			name: "with distinct keyword and vector query",
+			f: func(t *testing.T) {
+				params := &Params{
+					HybridSearch: &searchparams.HybridSearch{
+						Type:         "hybrid",
+						Alpha:        0.5,
+						Query:        "some query",
+						KeywordQuery: "some keywords",
+						VectorQuery:  "some vector query",
+					},
+					Class: class,
+				}
+				sparse := func() ([]*storobj.Object, []float32, error) { return nil, nil, nil }
+				var searchVector []float32
+				dense := func(vec []float32) ([]*storobj.Object, []float32, error) {
+					searchVector = vec
+					return nil, nil, nil
+				}
+				provider := &fakeModuleProvider{}
+				provider.On("VectorFromInput", ctx, class, "some vector query").Return([]float32{4, 5, 6}, nil)
+
+				_, err := Search(ctx, params, logger, sparse, dense, nil, provider)
+				require.Nil(t, err)
+				provider.AssertNumberOfCalls(t, "VectorFromInput", 1)
+				assert.Equal(t, []float32{4, 5, 6}, searchVector)
+				assert.Equal(t, "some keywords", params.GetKeywordQuery())
+			},
+		},
+		{
+			name: "with vector override",
+			f: func(t *testing.T) {
+				params := &Params{
+					HybridSearch: &searchparams.HybridSearch{
+						Type:        "hybrid",
+						Alpha:       0.5,
+						VectorQuery: "some vector query",
+						Vector:      []float32{1, 2, 3},
+					},
+					Class: class,
+				}
+				sparse := func() ([]*storobj.Object, []float32, error) { return nil, nil, nil }
+				var searchVector []float32
+				dense := func(vec []float32) ([]*storobj.Object, []float32, error) {
+					searchVector = vec
+					return nil, nil, nil
+				}
+				provider := &fakeModuleProvider{}
+
+				_, err := Search(ctx, params, logger, sparse, dense, nil, provider)
+				require.Nil(t, err)
+				provider.AssertNumberOfCalls(t, "VectorFromInput", 0)
+				assert.Equal(t, []float32{1, 2, 3}, searchVector)
 			},
 		},
 		{
@@ -281,9 +337,9 @@ func TestSearcher(t *testing.T) {
 				provider.On("VectorFromInput", ctx, class,
 					params.HybridSearch.SubSearches.([]searchparams.WeightedSearchResult)[0].
 						SearchParams.(searchparams.NearTextParams).Values[0]).Return([]float32{1, 2, 3}, nil)
-				provider.On("VectorFromInput", ctx, class, "").Return([]float32{1, 2, 3}, nil)
 				res, err := Search(ctx, params, logger, sparse, dense, nil, provider)
 				require.Nil(t, err)
+				provider.AssertNumberOfCalls(t, "VectorFromInput", 1)
 				assert.Len(t, res, 1)
 				assert.NotNil(t, res[0])
 				assert.Contains(t, res[0].Result.ExplainScore, "(vector)")
@@ -331,7 +387,6 @@ func TestSearcher(t *testing.T) {
 				provider.On("VectorFromInput", ctx, class,
 					params.HybridSearch.SubSearches.([]searchparams.WeightedSearchResult)[0].
 						SearchParams.(searchparams.NearVector).Vector).Return([]float32{1, 2, 3}, nil)
-				provider.On("VectorFromInput", ctx, class, "").Return([]float32{1, 2, 3}, nil)
 				res, err := Search(ctx, params, logger, sparse, dense, nil, provider)
 				require.Nil(t, err)
 				assert.Len(t, res, 1)
@@ -413,7 +468,6 @@ func TestSearcher(t *testing.T) {
 				provider.On("VectorFromInput", ctx, class,
 					params.HybridSearch.SubSearches.([]searchparams.WeightedSearchResult)[1].
 						SearchParams.(searchparams.NearTextParams).Values[0]).Return([]float32{1, 2, 3}, nil)
-				provider.On("VectorFromInput", ctx, class, "").Return([]float32{1, 2, 3}, nil)
 				res, err := Search(ctx, params, logger, sparse, dense, nil, provider)
 				require.Nil(t, err)
 				assert.Len(t, res, 2)
@@ -23,6 +23,7 @@ type Metrics struct {
 	queriesDurations   *prometheus.HistogramVec
 	dimensions         *prometheus.CounterVec
 	dimensionsCombined prometheus.Counter
+	cacheLookups       *prometheus.CounterVec
 	groupClasses       bool
 }
 
@@ -36,6 +37,7 @@ func NewMetrics(prom *monitoring.PrometheusMetrics) *Metrics {
 		queriesDurations:   prom.QueriesDurations,
 		dimensions:         prom.QueryDimensions,
 		dimensionsCombined: prom.QueryDimensionsCombined,
+		cacheLookups:       prom.QueryCacheLookups,
 		groupClasses:       prom.Group,
 	}
 }
@@ -133,3 +135,24 @@ func (m *Metrics) AddUsageDimensions(className, queryType, operation string, dim
 	}).Add(float64(dims))
 	m.dimensionsCombined.Add(float64(dims))
 }
+
+func (m *Metrics) QueryCacheLookup(className, queryType string, hit bool) {
+	if m == nil {
+		return
+	}
+
+	if m.groupClasses {
+		className = "n/a"
+	}
+
+	result := "miss"
+	if hit {
+		result = "hit"
+	}
+
+	m.cacheLookups.With(prometheus.Labels{
+		"class_name": className,
+		"query_type": queryType,
+		"result":     result,
+	}).Inc()
+}
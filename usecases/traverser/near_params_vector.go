@@ -139,6 +139,13 @@ func (v *nearParamsVector) validateNearParams(nearVector *searchparams.NearVecto
 		}
 	}
 
+	if len(className) != 1 {
+		if (nearVector != nil && nearVector.TargetVector != "") ||
+			(nearObject != nil && nearObject.TargetVector != "") {
+			return errors.Errorf("'targetVector' can only be used when searching a single class")
+		}
+	}
+
 	return nil
 }
 
@@ -228,9 +235,42 @@ func (v *nearParamsVector) vectorFromNearObjectParams(ctx context.Context,
 		}
 	}
 
+	if params.TargetVector != "" {
+		return v.classFindTargetVector(ctx, targetClassName, params.TargetVector, id, tenant)
+	}
+
 	return v.findVector(ctx, targetClassName, id, tenant)
 }
 
+func (v *nearParamsVector) classFindTargetVector(ctx context.Context, className,
+	targetVector string, id strfmt.UUID, tenant string,
+) ([]float32, error) {
+	if className == "" {
+		return nil, errors.New("'targetVector' can only be used when searching a single class")
+	}
+	res, err := v.search.Object(ctx, className, id, search.SelectProperties{}, additional.Properties{}, nil, tenant)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, errors.New("vector not found")
+	}
+	if vector, ok := res.Vectors[targetVector]; ok {
+		return vector, nil
+	}
+	// the target vector can also be a property of type vector
+	if props, ok := res.Schema.(map[string]interface{}); ok {
+		if vector, ok := props[targetVector].([]float64); ok {
+			out := make([]float32, len(vector))
+			for i := range vector {
+				out[i] = float32(vector[i])
+			}
+			return out, nil
+		}
+	}
+	return nil, errors.Errorf("target vector %q not found", targetVector)
+}
+
 func (v *nearParamsVector) extractCertaintyFromParams(nearVector *searchparams.NearVector,
 	nearObject *searchparams.NearObject, moduleParams map[string]interface{},
 ) float64 {
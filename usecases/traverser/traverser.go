@@ -21,7 +21,9 @@ import (
 	"github.com/weaviate/weaviate/entities/dto"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/usecases/admission"
 	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/querycache"
 	"github.com/weaviate/weaviate/usecases/ratelimiter"
 	"github.com/weaviate/weaviate/usecases/schema"
 )
@@ -47,6 +49,9 @@ type Traverser struct {
 	nearParamsVector *nearParamsVector
 	metrics          *Metrics
 	ratelimiter      *ratelimiter.Limiter
+	admission        *admission.Controller
+	cache            *querycache.Cache
+	dataVersions     dataVersioner
 }
 
 type VectorSearcher interface {
@@ -60,17 +65,23 @@ type VectorSearcher interface {
 
 type explorer interface {
 	GetClass(ctx context.Context, params dto.GetParams) ([]interface{}, error)
+	GetClassStream(ctx context.Context, params dto.GetParams, fn func(res []interface{}) error) error
 	CrossClassVectorSearch(ctx context.Context, params ExploreParams) ([]search.Result, error)
 }
 
-// NewTraverser to traverse the knowledge graph
+// NewTraverser to traverse the knowledge graph. The admission controller
+// limits the concurrent vector searches and aggregations, nil disables it.
+// The cache holds the results of repeated Get and Aggregate queries, it is
+// only used if the vectorSearcher tells the data versions of the classes.
 func NewTraverser(config *config.WeaviateConfig, locks locks,
 	logger logrus.FieldLogger, authorizer authorizer,
 	vectorSearcher VectorSearcher,
 	explorer explorer, schemaGetter schema.SchemaGetter,
 	modulesProvider ModulesProvider,
 	metrics *Metrics, maxGetRequests int,
+	admission *admission.Controller, cache *querycache.Cache,
 ) *Traverser {
+	dataVersions, _ := vectorSearcher.(dataVersioner)
 	return &Traverser{
 		config:           config,
 		locks:            locks,
@@ -82,6 +93,9 @@ func NewTraverser(config *config.WeaviateConfig, locks locks,
 		nearParamsVector: newNearParamsVector(modulesProvider, vectorSearcher),
 		metrics:          metrics,
 		ratelimiter:      ratelimiter.New(maxGetRequests),
+		admission:        admission,
+		cache:            cache,
+		dataVersions:     dataVersions,
 	}
 }
 
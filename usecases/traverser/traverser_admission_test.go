@@ -0,0 +1,69 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/dto"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	"github.com/weaviate/weaviate/usecases/admission"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+func TestGetClassAdmission(t *testing.T) {
+	controller := admission.New(admission.Config{
+		MaxConcurrent: 1,
+		QueueTimeout:  time.Second,
+	})
+	logger, _ := test.NewNullLogger()
+	traverser := NewTraverser(&config.WeaviateConfig{}, &fakeLocks{}, logger, &fakeAuthorizer{},
+		&fakeVectorSearcher{}, &fakeFederatedExplorer{}, &fakeSchemaGetter{}, nil, nil, -1, controller, nil)
+
+	// occupy the only slot
+	release, err := controller.Admit(context.Background(), admission.BatchWrite)
+	require.Nil(t, err)
+
+	t.Run("vector search is rejected", func(t *testing.T) {
+		_, err := traverser.GetClass(context.Background(), nil, dto.GetParams{
+			ClassName:  "Car",
+			NearVector: &searchparams.NearVector{Vector: []float32{1, 2, 3}},
+		})
+		var overloaded enterrors.ErrOverloaded
+		require.True(t, errors.As(err, &overloaded))
+		assert.Equal(t, time.Second, overloaded.RetryAfter())
+	})
+
+	t.Run("list query is not subject to admission control", func(t *testing.T) {
+		_, err := traverser.GetClass(context.Background(), nil, dto.GetParams{
+			ClassName: "Car",
+		})
+		require.Nil(t, err)
+	})
+
+	release()
+
+	t.Run("vector search is admitted once the slot is free", func(t *testing.T) {
+		_, err := traverser.GetClass(context.Background(), nil, dto.GetParams{
+			ClassName:  "Car",
+			NearVector: &searchparams.NearVector{Vector: []float32{1, 2, 3}},
+		})
+		require.Nil(t, err)
+	})
+}
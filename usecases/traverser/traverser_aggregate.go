@@ -14,12 +14,15 @@ package traverser
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/entities/aggregation"
 	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/explain"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/admission"
 )
 
 // Aggregate resolves meta queries
@@ -34,6 +37,23 @@ func (t *Traverser) Aggregate(ctx context.Context, principal *models.Principal,
 		return nil, err
 	}
 
+	if params.Explain {
+		return t.aggregateExplained(ctx, params)
+	}
+
+	classes := aggregateQueriedClasses(params)
+	return t.cachedQuery("aggregate", params.ClassName.String(), classes, params, func() (interface{}, error) {
+		return t.aggregate(ctx, params)
+	})
+}
+
+func (t *Traverser) aggregate(ctx context.Context, params *aggregation.Params) (interface{}, error) {
+	release, err := t.admission.Admit(ctx, admission.Aggregation)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
 	unlock, err := t.locks.LockConnector()
 	if err != nil {
 		return nil, enterrors.NewErrLockConnector(err)
@@ -49,6 +69,10 @@ func (t *Traverser) Aggregate(ctx context.Context, principal *models.Principal,
 		if err != nil {
 			return nil, err
 		}
+		if (params.NearVector != nil && params.NearVector.TargetVector != "") ||
+			(params.NearObject != nil && params.NearObject.TargetVector != "") {
+			return nil, fmt.Errorf("'targetVector' is not supported in Aggregate queries")
+		}
 		searchVector, err := t.nearParamsVector.vectorFromParams(ctx,
 			params.NearVector, params.NearObject, params.ModuleParams, className, params.Tenant)
 		if err != nil {
@@ -64,9 +88,11 @@ func (t *Traverser) Aggregate(ctx context.Context, principal *models.Principal,
 		params.Certainty = certainty
 	}
 
-	if params.Hybrid != nil && params.Hybrid.Vector == nil && params.Hybrid.Query != "" {
+	// the aggregation runs the hybrid search on every shard, vectorize the
+	// query once up front
+	if params.Hybrid != nil && params.Hybrid.Vector == nil && params.Hybrid.GetVectorQuery() != "" {
 		vec, err := t.nearParamsVector.modulesProvider.
-			VectorFromInput(ctx, params.ClassName.String(), params.Hybrid.Query)
+			VectorFromInput(ctx, params.ClassName.String(), params.Hybrid.GetVectorQuery())
 		if err != nil {
 			return nil, err
 		}
@@ -79,10 +105,12 @@ func (t *Traverser) Aggregate(ctx context.Context, principal *models.Principal,
 		}
 	}
 
+	before := time.Now()
 	res, err := t.vectorSearcher.Aggregate(ctx, *params)
 	if err != nil || res == nil {
 		return nil, err
 	}
+	explain.FromContext(ctx).Track(explain.StageAggregation, before, len(res.Groups))
 
 	return inspector.WithTypes(res, *params)
 }
@@ -0,0 +1,93 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"context"
+	"time"
+
+	"github.com/weaviate/weaviate/entities/aggregation"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/explain"
+)
+
+// getClassExplained runs the query with a profile in its context, each layer
+// records its stages in the profile. The breakdown is added to the
+// _additional props of every result. Explained queries bypass the query
+// cache, the breakdown is always the one of this run.
+func (t *Traverser) getClassExplained(ctx context.Context,
+	params dto.GetParams,
+) ([]interface{}, error) {
+	ctx, profile := explain.NewContext(ctx)
+	before := time.Now()
+
+	done, err := t.beginGetClassAuthorized(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	defer done()
+
+	res, err := t.explorer.GetClass(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	profile.Track(explain.StageTotal, before, len(res))
+
+	stages := profile.Stages()
+	for _, item := range res {
+		obj, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		additionalProps, ok := obj["_additional"].(map[string]interface{})
+		if !ok {
+			additionalProps = map[string]interface{}{}
+			obj["_additional"] = additionalProps
+		}
+		additionalProps["explain"] = stages
+	}
+	return res, nil
+}
+
+// aggregateExplained is the Aggregate counterpart of getClassExplained, the
+// breakdown is added to every group of the result
+func (t *Traverser) aggregateExplained(ctx context.Context,
+	params *aggregation.Params,
+) (interface{}, error) {
+	ctx, profile := explain.NewContext(ctx)
+	before := time.Now()
+
+	res, err := t.aggregate(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result, ok := res.(*aggregation.Result)
+	if !ok || result == nil {
+		return res, nil
+	}
+	profile.Track(explain.StageTotal, before, len(result.Groups))
+
+	stages := profile.Stages()
+	for i := range result.Groups {
+		result.Groups[i].Explain = stages
+	}
+	return result, nil
+}
+
+// trackRerank records the reranking of the results, which is done by the
+// module-specific additional property of the reranker modules
+func trackRerank(ctx context.Context, params dto.GetParams, start time.Time, cardinality int) {
+	if _, ok := params.AdditionalProperties.ModuleParams["rerank"]; ok {
+		explain.FromContext(ctx).Track(explain.StageRerank, start, cardinality)
+	}
+}
@@ -0,0 +1,53 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/explain"
+	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/querycache"
+)
+
+func TestGetClassExplained(t *testing.T) {
+	searcher := &fakeVersionedVectorSearcher{version: 1, known: true}
+	explorer := &fakeFederatedExplorer{results: map[string][]interface{}{
+		"Car": {map[string]interface{}{"name": "car"}},
+	}}
+	logger, _ := test.NewNullLogger()
+	traverser := NewTraverser(&config.WeaviateConfig{}, &fakeLocks{}, logger, &fakeAuthorizer{},
+		searcher, explorer, &fakeSchemaGetter{}, nil, nil, -1, nil,
+		querycache.New(querycache.Config{MaxEntries: 10}))
+
+	params := dto.GetParams{ClassName: "Car", Explain: true}
+	for i := 0; i < 2; i++ {
+		res, err := traverser.GetClass(context.Background(), nil, params)
+		require.Nil(t, err)
+		require.Len(t, res, 1)
+
+		additionalProps := res[0].(map[string]interface{})["_additional"].(map[string]interface{})
+		stages, ok := additionalProps["explain"].([]explain.Stage)
+		require.True(t, ok)
+		require.Len(t, stages, 1)
+		assert.Equal(t, explain.StageTotal, stages[0].Name)
+		assert.Equal(t, 1, stages[0].Cardinality)
+	}
+
+	// explained queries are never served from the query cache
+	assert.Len(t, explorer.params, 2)
+}
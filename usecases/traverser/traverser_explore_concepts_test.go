@@ -35,7 +35,7 @@ func Test_ExploreConcepts(t *testing.T) {
 		explorer := NewExplorer(vectorSearcher, log, getFakeModulesProvider(), metrics, defaultConfig)
 		schemaGetter := &fakeSchemaGetter{}
 		traverser := NewTraverser(&config.WeaviateConfig{}, locks, logger, authorizer,
-			vectorSearcher, explorer, schemaGetter, getFakeModulesProvider(), nil, -1)
+			vectorSearcher, explorer, schemaGetter, getFakeModulesProvider(), nil, -1, nil, nil)
 		params := ExploreParams{}
 
 		_, err := traverser.Explore(context.Background(), nil, params)
@@ -52,7 +52,7 @@ func Test_ExploreConcepts(t *testing.T) {
 		explorer := NewExplorer(vectorSearcher, log, getFakeModulesProvider(), metrics, defaultConfig)
 		schemaGetter := &fakeSchemaGetter{}
 		traverser := NewTraverser(&config.WeaviateConfig{}, locks, logger, authorizer,
-			vectorSearcher, explorer, schemaGetter, nil, nil, -1)
+			vectorSearcher, explorer, schemaGetter, nil, nil, -1, nil, nil)
 		params := ExploreParams{
 			NearVector: &searchparams.NearVector{},
 			ModuleParams: map[string]interface{}{
@@ -73,7 +73,7 @@ func Test_ExploreConcepts(t *testing.T) {
 		explorer := NewExplorer(vectorSearcher, log, getFakeModulesProvider(), metrics, defaultConfig)
 		schemaGetter := &fakeSchemaGetter{}
 		traverser := NewTraverser(&config.WeaviateConfig{}, locks, logger, authorizer,
-			vectorSearcher, explorer, schemaGetter, getFakeModulesProvider(), nil, -1)
+			vectorSearcher, explorer, schemaGetter, getFakeModulesProvider(), nil, -1, nil, nil)
 		params := ExploreParams{
 			ModuleParams: map[string]interface{}{
 				"nearCustomText": extractNearCustomTextParam(map[string]interface{}{
@@ -136,7 +136,7 @@ func Test_ExploreConcepts(t *testing.T) {
 		explorer := NewExplorer(vectorSearcher, log, getFakeModulesProvider(), metrics, defaultConfig)
 		schemaGetter := &fakeSchemaGetter{}
 		traverser := NewTraverser(&config.WeaviateConfig{}, locks, logger, authorizer,
-			vectorSearcher, explorer, schemaGetter, nil, nil, -1)
+			vectorSearcher, explorer, schemaGetter, nil, nil, -1, nil, nil)
 		params := ExploreParams{
 			NearVector: &searchparams.NearVector{
 				Vector: []float32{7.8, 9},
@@ -196,7 +196,7 @@ func Test_ExploreConcepts(t *testing.T) {
 		explorer := NewExplorer(vectorSearcher, log, getFakeModulesProvider(), metrics, defaultConfig)
 		schemaGetter := &fakeSchemaGetter{}
 		traverser := NewTraverser(&config.WeaviateConfig{}, locks, logger, authorizer,
-			vectorSearcher, explorer, schemaGetter, nil, nil, -1)
+			vectorSearcher, explorer, schemaGetter, nil, nil, -1, nil, nil)
 		params := ExploreParams{
 			NearObject: &searchparams.NearObject{
 				ID: "bd3d1560-3f0e-4b39-9d62-38b4a3c4f23a",
@@ -262,7 +262,7 @@ func Test_ExploreConcepts(t *testing.T) {
 		explorer := NewExplorer(vectorSearcher, log, getFakeModulesProvider(), metrics, defaultConfig)
 		schemaGetter := &fakeSchemaGetter{}
 		traverser := NewTraverser(&config.WeaviateConfig{}, locks, logger, authorizer,
-			vectorSearcher, explorer, schemaGetter, nil, nil, -1)
+			vectorSearcher, explorer, schemaGetter, nil, nil, -1, nil, nil)
 		params := ExploreParams{
 			NearObject: &searchparams.NearObject{
 				Beacon: "weaviate://localhost/bd3d1560-3f0e-4b39-9d62-38b4a3c4f23a",
@@ -328,7 +328,7 @@ func Test_ExploreConcepts(t *testing.T) {
 		explorer := NewExplorer(vectorSearcher, log, getFakeModulesProvider(), metrics, defaultConfig)
 		schemaGetter := &fakeSchemaGetter{}
 		traverser := NewTraverser(&config.WeaviateConfig{}, locks, logger, authorizer,
-			vectorSearcher, explorer, schemaGetter, getFakeModulesProvider(), nil, -1)
+			vectorSearcher, explorer, schemaGetter, getFakeModulesProvider(), nil, -1, nil, nil)
 		params := ExploreParams{
 			Limit: 100,
 			NearVector: &searchparams.NearVector{
@@ -372,7 +372,7 @@ func Test_ExploreConcepts(t *testing.T) {
 		explorer := NewExplorer(vectorSearcher, log, getFakeModulesProvider(), metrics, defaultConfig)
 		schemaGetter := &fakeSchemaGetter{}
 		traverser := NewTraverser(&config.WeaviateConfig{}, locks, logger, authorizer,
-			vectorSearcher, explorer, schemaGetter, getFakeModulesProvider(), nil, -1)
+			vectorSearcher, explorer, schemaGetter, getFakeModulesProvider(), nil, -1, nil, nil)
 		params := ExploreParams{
 			Limit: 100,
 			NearVector: &searchparams.NearVector{
@@ -413,7 +413,7 @@ func Test_ExploreConcepts(t *testing.T) {
 		explorer := NewExplorer(vectorSearcher, log, getFakeModulesProvider(), metrics, defaultConfig)
 		schemaGetter := &fakeSchemaGetter{}
 		traverser := NewTraverser(&config.WeaviateConfig{}, locks, logger, authorizer,
-			vectorSearcher, explorer, schemaGetter, getFakeModulesProvider(), nil, -1)
+			vectorSearcher, explorer, schemaGetter, getFakeModulesProvider(), nil, -1, nil, nil)
 		params := ExploreParams{
 			ModuleParams: map[string]interface{}{
 				"nearCustomText": extractNearCustomTextParam(map[string]interface{}{
@@ -442,7 +442,7 @@ func Test_ExploreConcepts(t *testing.T) {
 		explorer := NewExplorer(vectorSearcher, log, getFakeModulesProvider(), metrics, defaultConfig)
 		schemaGetter := &fakeSchemaGetter{}
 		traverser := NewTraverser(&config.WeaviateConfig{}, locks, logger, authorizer,
-			vectorSearcher, explorer, schemaGetter, getFakeModulesProvider(), nil, -1)
+			vectorSearcher, explorer, schemaGetter, getFakeModulesProvider(), nil, -1, nil, nil)
 		params := ExploreParams{
 			ModuleParams: map[string]interface{}{
 				"nearCustomText": extractNearCustomTextParam(map[string]interface{}{
@@ -483,7 +483,7 @@ func Test_ExploreConcepts(t *testing.T) {
 		explorer := NewExplorer(vectorSearcher, log, getFakeModulesProvider(), metrics, defaultConfig)
 		schemaGetter := &fakeSchemaGetter{}
 		traverser := NewTraverser(&config.WeaviateConfig{}, locks, logger, authorizer,
-			vectorSearcher, explorer, schemaGetter, getFakeModulesProvider(), nil, -1)
+			vectorSearcher, explorer, schemaGetter, getFakeModulesProvider(), nil, -1, nil, nil)
 		params := ExploreParams{
 			Limit: 100,
 			ModuleParams: map[string]interface{}{
@@ -556,7 +556,7 @@ func Test_ExploreConcepts(t *testing.T) {
 		explorer := NewExplorer(vectorSearcher, log, getFakeModulesProvider(), metrics, defaultConfig)
 		schemaGetter := &fakeSchemaGetter{}
 		traverser := NewTraverser(&config.WeaviateConfig{}, locks, logger, authorizer,
-			vectorSearcher, explorer, schemaGetter, getFakeModulesProvider(), nil, -1)
+			vectorSearcher, explorer, schemaGetter, getFakeModulesProvider(), nil, -1, nil, nil)
 
 		params := ExploreParams{
 			Limit: 100,
@@ -0,0 +1,253 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/vectorindex"
+	"golang.org/x/sync/errgroup"
+)
+
+const defaultFederatedSearchLimit = 20
+
+// FederatedSearchParams are the parameters of a search across several
+// classes. Every target is the search of a single class, all targets must
+// either be a nearVector or a hybrid search.
+type FederatedSearchParams struct {
+	Targets []dto.GetParams
+	Limit   int
+}
+
+// FederatedResult is a single hit of a federated search
+type FederatedResult struct {
+	// Target is the position of the target the hit was found by
+	Target    int
+	ClassName string
+	// Score is the normalized score the hits of all targets are ranked by. It
+	// is between 0 and 1, higher is better.
+	Score  float32
+	Object interface{}
+}
+
+// FederatedSearch runs the search of every target and merges the hits into a
+// single list, ordered by their normalized score.
+//
+// The distances of a nearVector search are comparable across classes which
+// share a vector space, so they are normalized across all hits. The scores of
+// a hybrid search depend on the corpus of each class, so they are normalized
+// per target.
+func (t *Traverser) FederatedSearch(ctx context.Context, principal *models.Principal,
+	params FederatedSearchParams,
+) ([]FederatedResult, error) {
+	err := t.authorizer.Authorize(principal, "get", "traversal/*")
+	if err != nil {
+		return nil, err
+	}
+
+	if params.Limit == 0 {
+		params.Limit = defaultFederatedSearchLimit
+	}
+
+	if err := t.validateFederatedSearch(params); err != nil {
+		return nil, fmt.Errorf("invalid federated search: %w", err)
+	}
+
+	byTarget := make([][]interface{}, len(params.Targets))
+	eg, ctx := errgroup.WithContext(ctx)
+	for i := range params.Targets {
+		i, target := i, params.Targets[i]
+		target.Pagination = &filters.Pagination{Limit: params.Limit}
+		if target.NearVector != nil {
+			target.AdditionalProperties.Distance = true
+		} else {
+			target.AdditionalProperties.Score = true
+		}
+
+		eg.Go(func() error {
+			done, err := t.beginGetClassAuthorized(ctx, target)
+			if err != nil {
+				return err
+			}
+			defer done()
+
+			res, err := t.explorer.GetClass(ctx, target)
+			if err != nil {
+				return fmt.Errorf("search %s: %w", target.ClassName, err)
+			}
+			byTarget[i] = res
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+
+	return mergeFederatedResults(params, byTarget)
+}
+
+func (t *Traverser) validateFederatedSearch(params FederatedSearchParams) error {
+	if len(params.Targets) == 0 {
+		return fmt.Errorf("no target classes")
+	}
+	if params.Limit < 0 {
+		return fmt.Errorf("limit must not be negative, got %d", params.Limit)
+	}
+
+	nearVector := params.Targets[0].NearVector != nil
+	for i, target := range params.Targets {
+		if (target.NearVector != nil) == (target.HybridSearch != nil) {
+			return fmt.Errorf("target %d (%s): exactly one of nearVector and hybrid is required",
+				i, target.ClassName)
+		}
+		if (target.NearVector != nil) != nearVector {
+			return fmt.Errorf("target %d (%s): cannot combine nearVector and hybrid targets",
+				i, target.ClassName)
+		}
+		if target.NearObject != nil || len(target.ModuleParams) > 0 ||
+			target.KeywordRanking != nil || target.Group != nil || target.GroupBy != nil ||
+			len(target.Sort) > 0 || target.Cursor != nil {
+			return fmt.Errorf("target %d (%s): only nearVector or hybrid and filters are supported",
+				i, target.ClassName)
+		}
+	}
+
+	if nearVector {
+		return t.validateFederatedDistanceCompatibility(params.Targets)
+	}
+	return nil
+}
+
+// validateFederatedDistanceCompatibility ensures that the distances of all
+// targets can be compared with each other
+func (t *Traverser) validateFederatedDistanceCompatibility(targets []dto.GetParams) error {
+	sch := t.schemaGetter.GetSchemaSkipAuth()
+
+	classDistanceConfigs := make(map[string]string, len(targets))
+	distancerTypes := make(map[string]struct{})
+	for _, target := range targets {
+		class := sch.GetClass(schema.ClassName(target.ClassName))
+		if class == nil {
+			return fmt.Errorf("failed to find class '%s' in schema", target.ClassName)
+		}
+
+		vectorIndexConfig, err := vectorindex.TypeAssertVectorIndex(class)
+		if err != nil {
+			return err
+		}
+		classDistanceConfigs[class.Class] = vectorIndexConfig.DistanceName()
+		distancerTypes[vectorIndexConfig.DistanceName()] = struct{}{}
+	}
+
+	if len(distancerTypes) > 1 {
+		return crossClassDistCompatError(classDistanceConfigs)
+	}
+	return nil
+}
+
+func mergeFederatedResults(params FederatedSearchParams,
+	byTarget [][]interface{},
+) ([]FederatedResult, error) {
+	nearVector := params.Targets[0].NearVector != nil
+
+	var merged []FederatedResult
+	for i, res := range byTarget {
+		hits := make([]FederatedResult, len(res))
+		for j, obj := range res {
+			key := "score"
+			if nearVector {
+				key = "distance"
+			}
+			value, err := federatedAdditionalFloat(obj, key)
+			if err != nil {
+				return nil, fmt.Errorf("target %d (%s): %w", i, params.Targets[i].ClassName, err)
+			}
+			hits[j] = FederatedResult{
+				Target:    i,
+				ClassName: params.Targets[i].ClassName,
+				Score:     value,
+				Object:    obj,
+			}
+		}
+
+		if !nearVector {
+			normalizeFederatedScores(hits, false)
+		}
+		merged = append(merged, hits...)
+	}
+
+	if nearVector {
+		normalizeFederatedScores(merged, true)
+	}
+
+	sort.SliceStable(merged, func(a, b int) bool {
+		return merged[a].Score > merged[b].Score
+	})
+	if len(merged) > params.Limit {
+		merged = merged[:params.Limit]
+	}
+
+	return merged, nil
+}
+
+// normalizeFederatedScores scales the scores of the hits to 0..1 by their min
+// and max value. Distances are inverted, so that the closest hit has the
+// highest score.
+func normalizeFederatedScores(hits []FederatedResult, isDistance bool) {
+	if len(hits) == 0 {
+		return
+	}
+
+	min, max := hits[0].Score, hits[0].Score
+	for _, hit := range hits[1:] {
+		if hit.Score < min {
+			min = hit.Score
+		}
+		if hit.Score > max {
+			max = hit.Score
+		}
+	}
+
+	for i := range hits {
+		if max == min {
+			hits[i].Score = 1
+			continue
+		}
+		normalized := (hits[i].Score - min) / (max - min)
+		if isDistance {
+			normalized = 1 - normalized
+		}
+		hits[i].Score = normalized
+	}
+}
+
+func federatedAdditionalFloat(obj interface{}, key string) (float32, error) {
+	asMap, ok := obj.(map[string]interface{})
+	if !ok {
+		return 0, fmt.Errorf("unexpected result type %T", obj)
+	}
+	additional, ok := asMap["_additional"].(map[string]interface{})
+	if !ok {
+		return 0, fmt.Errorf("result has no _additional properties")
+	}
+	value, ok := additional[key].(float32)
+	if !ok {
+		return 0, fmt.Errorf("result has no %s", key)
+	}
+	return value, nil
+}
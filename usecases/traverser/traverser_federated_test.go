@@ -0,0 +1,223 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+type fakeFederatedExplorer struct {
+	sync.Mutex
+	results map[string][]interface{}
+	params  []dto.GetParams
+}
+
+func (f *fakeFederatedExplorer) GetClass(ctx context.Context, p dto.GetParams) ([]interface{}, error) {
+	f.Lock()
+	defer f.Unlock()
+	f.params = append(f.params, p)
+	return f.results[p.ClassName], nil
+}
+
+func (f *fakeFederatedExplorer) GetClassStream(ctx context.Context, p dto.GetParams,
+	fn func(res []interface{}) error,
+) error {
+	return nil
+}
+
+func (f *fakeFederatedExplorer) CrossClassVectorSearch(ctx context.Context, p ExploreParams) ([]search.Result, error) {
+	return nil, nil
+}
+
+func federatedHit(name string, key string, value float32) map[string]interface{} {
+	return map[string]interface{}{
+		"name":        name,
+		"_additional": map[string]interface{}{key: value},
+	}
+}
+
+func TestFederatedSearch(t *testing.T) {
+	newTraverser := func(explorer explorer, distances ...string) *Traverser {
+		classes := make([]*models.Class, len(distances))
+		for i, distance := range distances {
+			classes[i] = &models.Class{
+				Class:             []string{"Article", "Paragraph", "Comment"}[i],
+				VectorIndexConfig: hnsw.UserConfig{Distance: distance},
+			}
+		}
+		schemaGetter := &fakeSchemaGetter{schema: schema.Schema{
+			Objects: &models.Schema{Classes: classes},
+		}}
+		logger, _ := test.NewNullLogger()
+		return NewTraverser(&config.WeaviateConfig{}, &fakeLocks{}, logger, &fakeAuthorizer{},
+			&fakeVectorSearcher{}, explorer, schemaGetter, nil, nil, -1, nil, nil)
+	}
+
+	t.Run("near vector hits are ranked by distance across classes", func(t *testing.T) {
+		explorer := &fakeFederatedExplorer{results: map[string][]interface{}{
+			"Article": {
+				federatedHit("a1", "distance", 0.1),
+				federatedHit("a2", "distance", 0.5),
+			},
+			"Paragraph": {
+				federatedHit("p1", "distance", 0.3),
+				federatedHit("p2", "distance", 0.9),
+			},
+		}}
+		traverser := newTraverser(explorer, hnsw.DistanceCosine, hnsw.DistanceCosine)
+
+		nearVector := &searchparams.NearVector{Vector: []float32{1, 2, 3}}
+		res, err := traverser.FederatedSearch(context.Background(), nil, FederatedSearchParams{
+			Targets: []dto.GetParams{
+				{ClassName: "Article", NearVector: nearVector},
+				{ClassName: "Paragraph", NearVector: nearVector},
+			},
+			Limit: 3,
+		})
+		require.Nil(t, err)
+		require.Len(t, res, 3)
+
+		assert.Equal(t, "Article", res[0].ClassName)
+		assert.Equal(t, 0, res[0].Target)
+		assert.Equal(t, "a1", res[0].Object.(map[string]interface{})["name"])
+		assert.InDelta(t, 1, res[0].Score, 1e-6)
+
+		assert.Equal(t, "Paragraph", res[1].ClassName)
+		assert.Equal(t, 1, res[1].Target)
+		assert.Equal(t, "p1", res[1].Object.(map[string]interface{})["name"])
+		assert.InDelta(t, 0.75, res[1].Score, 1e-6)
+
+		assert.Equal(t, "a2", res[2].Object.(map[string]interface{})["name"])
+		assert.InDelta(t, 0.5, res[2].Score, 1e-6)
+
+		require.Len(t, explorer.params, 2)
+		for _, params := range explorer.params {
+			assert.True(t, params.AdditionalProperties.Distance)
+			assert.Equal(t, 3, params.Pagination.Limit)
+		}
+	})
+
+	t.Run("hybrid scores are normalized per class", func(t *testing.T) {
+		explorer := &fakeFederatedExplorer{results: map[string][]interface{}{
+			"Article": {
+				federatedHit("a1", "score", 0.9),
+				federatedHit("a2", "score", 0.6),
+				federatedHit("a3", "score", 0.3),
+			},
+			"Paragraph": {
+				federatedHit("p1", "score", 0.04),
+				federatedHit("p2", "score", 0.02),
+			},
+		}}
+		traverser := newTraverser(explorer, hnsw.DistanceCosine, hnsw.DistanceDot)
+
+		hybrid := &searchparams.HybridSearch{Query: "foo", Alpha: 0.5}
+		res, err := traverser.FederatedSearch(context.Background(), nil, FederatedSearchParams{
+			Targets: []dto.GetParams{
+				{ClassName: "Article", HybridSearch: hybrid},
+				{ClassName: "Paragraph", HybridSearch: hybrid},
+			},
+		})
+		require.Nil(t, err)
+		require.Len(t, res, 5)
+
+		names := make([]string, len(res))
+		scores := make([]float32, len(res))
+		for i := range res {
+			names[i] = res[i].Object.(map[string]interface{})["name"].(string)
+			scores[i] = res[i].Score
+		}
+		assert.Equal(t, []string{"a1", "p1", "a2", "a3", "p2"}, names)
+		assert.InDeltaSlice(t, []float32{1, 1, 0.5, 0, 0}, scores, 1e-6)
+
+		for _, params := range explorer.params {
+			assert.True(t, params.AdditionalProperties.Score)
+			assert.Equal(t, defaultFederatedSearchLimit, params.Pagination.Limit)
+		}
+	})
+
+	t.Run("invalid params", func(t *testing.T) {
+		nearVector := &searchparams.NearVector{Vector: []float32{1, 2, 3}}
+		hybrid := &searchparams.HybridSearch{Query: "foo"}
+
+		tests := []struct {
+			name        string
+			distances   []string
+			params      FederatedSearchParams
+			expectedErr string
+		}{
+			{
+				name:        "no targets",
+				distances:   []string{hnsw.DistanceCosine},
+				params:      FederatedSearchParams{},
+				expectedErr: "no target classes",
+			},
+			{
+				name:      "neither near vector nor hybrid",
+				distances: []string{hnsw.DistanceCosine},
+				params: FederatedSearchParams{Targets: []dto.GetParams{
+					{ClassName: "Article"},
+				}},
+				expectedErr: "exactly one of nearVector and hybrid is required",
+			},
+			{
+				name:      "near vector and hybrid targets mixed",
+				distances: []string{hnsw.DistanceCosine, hnsw.DistanceCosine},
+				params: FederatedSearchParams{Targets: []dto.GetParams{
+					{ClassName: "Article", NearVector: nearVector},
+					{ClassName: "Paragraph", HybridSearch: hybrid},
+				}},
+				expectedErr: "cannot combine nearVector and hybrid targets",
+			},
+			{
+				name:      "with sorting",
+				distances: []string{hnsw.DistanceCosine},
+				params: FederatedSearchParams{Targets: []dto.GetParams{
+					{ClassName: "Article", HybridSearch: hybrid, Sort: []filters.Sort{{Path: []string{"name"}}}},
+				}},
+				expectedErr: "only nearVector or hybrid and filters are supported",
+			},
+			{
+				name:      "different distance metrics",
+				distances: []string{hnsw.DistanceCosine, hnsw.DistanceDot},
+				params: FederatedSearchParams{Targets: []dto.GetParams{
+					{ClassName: "Article", NearVector: nearVector},
+					{ClassName: "Paragraph", NearVector: nearVector},
+				}},
+				expectedErr: "found different distance metrics",
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				traverser := newTraverser(&fakeFederatedExplorer{}, test.distances...)
+				_, err := traverser.FederatedSearch(context.Background(), nil, test.params)
+				require.NotNil(t, err)
+				assert.Contains(t, err.Error(), test.expectedErr)
+			})
+		}
+	})
+}
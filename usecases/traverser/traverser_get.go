@@ -18,11 +18,70 @@ import (
 	"github.com/weaviate/weaviate/entities/dto"
 	enterrors "github.com/weaviate/weaviate/entities/errors"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/admission"
 )
 
 func (t *Traverser) GetClass(ctx context.Context, principal *models.Principal,
 	params dto.GetParams,
 ) ([]interface{}, error) {
+	err := t.authorizer.Authorize(principal, "get", "traversal/*")
+	if err != nil {
+		return nil, err
+	}
+
+	if params.Explain {
+		return t.getClassExplained(ctx, params)
+	}
+
+	// cached results are neither limited nor admitted, they are cheap
+	res, err := t.cachedQuery("get", params.ClassName, getQueriedClasses(params), params, func() (interface{}, error) {
+		done, err := t.beginGetClassAuthorized(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+		defer done()
+
+		return t.explorer.GetClass(ctx, params)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.([]interface{}), nil
+}
+
+// GetClassStream is the streaming counterpart of GetClass, see
+// Explorer.GetClassStream for which queries are streamed shard by shard.
+func (t *Traverser) GetClassStream(ctx context.Context, principal *models.Principal,
+	params dto.GetParams, fn func(res []interface{}) error,
+) error {
+	done, err := t.beginGetClass(ctx, principal, params)
+	if err != nil {
+		return err
+	}
+	defer done()
+
+	return t.explorer.GetClassStream(ctx, params, fn)
+}
+
+// beginGetClass runs the authorization, rate limiting, monitoring and
+// validation shared by all Get queries. The returned func must be called once
+// the query is done.
+func (t *Traverser) beginGetClass(ctx context.Context, principal *models.Principal,
+	params dto.GetParams,
+) (func(), error) {
+	err := t.authorizer.Authorize(principal, "get", "traversal/*")
+	if err != nil {
+		return nil, err
+	}
+
+	return t.beginGetClassAuthorized(ctx, params)
+}
+
+// beginGetClassAuthorized is beginGetClass for callers which already
+// authorized the principal, e.g. once for several classes.
+func (t *Traverser) beginGetClassAuthorized(ctx context.Context,
+	params dto.GetParams,
+) (func(), error) {
 	before := time.Now()
 
 	ok := t.ratelimiter.TryInc()
@@ -33,22 +92,29 @@ func (t *Traverser) GetClass(ctx context.Context, principal *models.Principal,
 		return nil, enterrors.NewErrRateLimit()
 	}
 
-	defer t.ratelimiter.Dec()
+	release := func() {}
+	if isVectorSearch(params) {
+		var err error
+		release, err = t.admission.Admit(ctx, admission.VectorSearch)
+		if err != nil {
+			t.ratelimiter.Dec()
+			return nil, err
+		}
+	}
 
 	t.metrics.QueriesGetInc(params.ClassName)
-	defer t.metrics.QueriesGetDec(params.ClassName)
-	defer t.metrics.QueriesObserveDuration(params.ClassName, before.UnixMilli())
-
-	err := t.authorizer.Authorize(principal, "get", "traversal/*")
-	if err != nil {
-		return nil, err
+	done := func() {
+		t.metrics.QueriesObserveDuration(params.ClassName, before.UnixMilli())
+		t.metrics.QueriesGetDec(params.ClassName)
+		release()
+		t.ratelimiter.Dec()
 	}
 
 	unlock, err := t.locks.LockConnector()
 	if err != nil {
+		done()
 		return nil, enterrors.NewErrLockConnector(err)
 	}
-	defer unlock()
 
 	certainty := ExtractCertaintyFromParams(params)
 	if certainty != 0 || params.AdditionalProperties.Certainty {
@@ -56,9 +122,21 @@ func (t *Traverser) GetClass(ctx context.Context, principal *models.Principal,
 		// that the vector index is configured to use cosine
 		// distance
 		if err := t.validateGetDistanceParams(params); err != nil {
+			unlock()
+			done()
 			return nil, err
 		}
 	}
 
-	return t.explorer.GetClass(ctx, params)
+	return func() {
+		unlock()
+		done()
+	}, nil
+}
+
+// isVectorSearch tells whether the query searches the vector index, which
+// makes it a heavy operation subject to admission control
+func isVectorSearch(params dto.GetParams) bool {
+	return params.NearVector != nil || params.NearObject != nil ||
+		len(params.ModuleParams) > 0 || params.HybridSearch != nil
 }
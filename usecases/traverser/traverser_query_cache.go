@@ -0,0 +1,160 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"sort"
+
+	"github.com/weaviate/weaviate/entities/aggregation"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/schema/crossref"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	"github.com/weaviate/weaviate/usecases/querycache"
+)
+
+// dataVersioner tells the version of the data of a class on this node, see
+// querycache.Cache
+type dataVersioner interface {
+	DataVersion(className string) (uint64, bool)
+}
+
+// cachedQuery serves the results of a query from the query cache or runs the
+// query and caches its results. The key of the results is the hash of the
+// params of the query and of the data versions of the other classes the query
+// reads, e.g. through cross-references. Queries are only cached if the data
+// versions of all these classes are known, the versions are taken before the
+// query runs, so results are never cached for a version which is newer than
+// the data they were built from.
+func (t *Traverser) cachedQuery(queryType, className string, otherClasses []string,
+	params interface{}, query func() (interface{}, error),
+) (interface{}, error) {
+	if !t.cache.Enabled() || t.dataVersions == nil {
+		return query()
+	}
+
+	version, ok := t.dataVersions.DataVersion(className)
+	if !ok {
+		return query()
+	}
+	otherVersions := make(map[string]uint64, len(otherClasses))
+	for _, other := range otherClasses {
+		if other == className {
+			continue
+		}
+		v, ok := t.dataVersions.DataVersion(other)
+		if !ok {
+			return query()
+		}
+		otherVersions[other] = v
+	}
+	key, ok := querycache.Key(struct {
+		Type          string
+		Params        interface{}
+		OtherVersions map[string]uint64
+	}{queryType, params, otherVersions})
+	if !ok {
+		return query()
+	}
+
+	if res, ok := t.cache.Get(className, version, key); ok {
+		t.metrics.QueryCacheLookup(className, queryType, true)
+		return res, nil
+	}
+	t.metrics.QueryCacheLookup(className, queryType, false)
+
+	res, err := query()
+	if err != nil {
+		return nil, err
+	}
+	t.cache.Put(className, version, key, res)
+	return res, nil
+}
+
+// queriedClasses collects the classes a query reads from
+type queriedClasses map[string]struct{}
+
+func (c queriedClasses) list() []string {
+	classes := make([]string, 0, len(c))
+	for class := range c {
+		classes = append(classes, class)
+	}
+	sort.Strings(classes)
+	return classes
+}
+
+func (c queriedClasses) addProperties(props search.SelectProperties) {
+	for _, prop := range props {
+		for _, ref := range prop.Refs {
+			c[ref.ClassName] = struct{}{}
+			c.addProperties(ref.RefProperties)
+		}
+	}
+}
+
+func (c queriedClasses) addFilters(filter *filters.LocalFilter) {
+	if filter != nil {
+		c.addClause(filter.Root)
+	}
+}
+
+func (c queriedClasses) addClause(clause *filters.Clause) {
+	if clause == nil {
+		return
+	}
+	c.addPath(clause.On)
+	for i := range clause.Operands {
+		c.addClause(&clause.Operands[i])
+	}
+}
+
+func (c queriedClasses) addPath(path *filters.Path) {
+	for ; path != nil; path = path.Child {
+		if path.Class != "" {
+			c[path.Class.String()] = struct{}{}
+		}
+	}
+}
+
+// addNearObject adds the class of the object a nearObject search starts
+// from, if it is given by its beacon
+func (c queriedClasses) addNearObject(nearObject *searchparams.NearObject) {
+	if nearObject == nil || nearObject.Beacon == "" {
+		return
+	}
+	if ref, err := crossref.Parse(nearObject.Beacon); err == nil && ref.Class != "" {
+		c[ref.Class] = struct{}{}
+	}
+}
+
+// getQueriedClasses returns the classes a Get query reads from besides its
+// own class
+func getQueriedClasses(params dto.GetParams) []string {
+	classes := queriedClasses{}
+	classes.addProperties(params.Properties)
+	classes.addFilters(params.Filters)
+	classes.addNearObject(params.NearObject)
+	return classes.list()
+}
+
+// aggregateQueriedClasses returns the classes an Aggregate query reads from
+// besides its own class
+func aggregateQueriedClasses(params *aggregation.Params) []string {
+	classes := queriedClasses{}
+	classes.addFilters(params.Filters)
+	classes.addNearObject(params.NearObject)
+	for _, path := range params.GroupByPaths() {
+		classes.addPath(path)
+	}
+	return classes.list()
+}
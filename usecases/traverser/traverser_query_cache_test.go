@@ -0,0 +1,126 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/querycache"
+)
+
+type fakeVersionedVectorSearcher struct {
+	fakeVectorSearcher
+	version uint64
+	known   bool
+	// versions of other classes than the queried one
+	others map[string]uint64
+}
+
+func (f *fakeVersionedVectorSearcher) DataVersion(className string) (uint64, bool) {
+	if version, ok := f.others[className]; ok {
+		return version, f.known
+	}
+	return f.version, f.known
+}
+
+func TestGetClassQueryCache(t *testing.T) {
+	searcher := &fakeVersionedVectorSearcher{version: 1, known: true}
+	explorer := &fakeFederatedExplorer{results: map[string][]interface{}{
+		"Car": {map[string]interface{}{"name": "car"}},
+	}}
+	logger, _ := test.NewNullLogger()
+	traverser := NewTraverser(&config.WeaviateConfig{}, &fakeLocks{}, logger, &fakeAuthorizer{},
+		searcher, explorer, &fakeSchemaGetter{}, nil, nil, -1, nil,
+		querycache.New(querycache.Config{MaxEntries: 10}))
+
+	get := func(t *testing.T, limit int) {
+		res, err := traverser.GetClass(context.Background(), nil, dto.GetParams{
+			ClassName:  "Car",
+			Pagination: &filters.Pagination{Limit: limit},
+		})
+		require.Nil(t, err)
+		assert.Equal(t, explorer.results["Car"], res)
+	}
+
+	t.Run("repeated query is served from the cache", func(t *testing.T) {
+		get(t, 10)
+		get(t, 10)
+		assert.Len(t, explorer.params, 1)
+	})
+
+	t.Run("different query is not", func(t *testing.T) {
+		get(t, 20)
+		assert.Len(t, explorer.params, 2)
+	})
+
+	t.Run("write to the class invalidates the results", func(t *testing.T) {
+		searcher.version = 2
+		get(t, 10)
+		get(t, 10)
+		assert.Len(t, explorer.params, 3)
+	})
+
+	t.Run("write to a referenced class invalidates the results", func(t *testing.T) {
+		searcher.others = map[string]uint64{"Brand": 1}
+		params := dto.GetParams{
+			ClassName: "Car",
+			Properties: search.SelectProperties{{
+				Name: "ofBrand",
+				Refs: []search.SelectClass{{ClassName: "Brand"}},
+			}},
+		}
+		getWith := func() {
+			_, err := traverser.GetClass(context.Background(), nil, params)
+			require.Nil(t, err)
+		}
+
+		getWith()
+		getWith()
+		assert.Len(t, explorer.params, 4)
+
+		searcher.others["Brand"] = 2
+		getWith()
+		assert.Len(t, explorer.params, 5)
+	})
+
+	t.Run("search params which are not marshalled to json", func(t *testing.T) {
+		nearVector := func(withDistance bool) {
+			_, err := traverser.GetClass(context.Background(), nil, dto.GetParams{
+				ClassName: "Car",
+				NearVector: &searchparams.NearVector{
+					Vector: []float32{1, 2}, Distance: 0.5, WithDistance: withDistance,
+				},
+			})
+			require.Nil(t, err)
+		}
+
+		nearVector(true)
+		nearVector(false)
+		assert.Len(t, explorer.params, 7)
+	})
+
+	t.Run("unknown data version is never cached", func(t *testing.T) {
+		searcher.known = false
+		get(t, 10)
+		get(t, 10)
+		assert.Len(t, explorer.params, 9)
+	})
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/entities/dto"
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/vectorindex"
 	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 )
 
@@ -57,14 +58,14 @@ func (t *Traverser) validateCrossClassDistanceCompatibility() (distType string,
 			continue
 		}
 
-		hnswConfig, assertErr := hnsw.TypeAssertVectorIndex(class)
+		vectorIndexConfig, assertErr := vectorindex.TypeAssertVectorIndex(class)
 		if assertErr != nil {
 			err = assertErr
 			return
 		}
 
-		distancerTypes[hnswConfig.Distance] = struct{}{}
-		classDistanceConfigs[class.Class] = hnswConfig.Distance
+		distancerTypes[vectorIndexConfig.DistanceName()] = struct{}{}
+		classDistanceConfigs[class.Class] = vectorIndexConfig.DistanceName()
 	}
 
 	if len(distancerTypes) != 1 {
@@ -102,13 +103,13 @@ func (t *Traverser) validateGetDistanceParams(params dto.GetParams) error {
 		return fmt.Errorf("failed to find class '%s' in schema", params.ClassName)
 	}
 
-	hnswConfig, err := hnsw.TypeAssertVectorIndex(class)
+	vectorIndexConfig, err := vectorindex.TypeAssertVectorIndex(class)
 	if err != nil {
 		return err
 	}
 
-	if hnswConfig.Distance != hnsw.DistanceCosine {
-		return certaintyUnsupportedError(hnswConfig.Distance)
+	if vectorIndexConfig.DistanceName() != hnsw.DistanceCosine {
+		return certaintyUnsupportedError(vectorIndexConfig.DistanceName())
 	}
 
 	return nil